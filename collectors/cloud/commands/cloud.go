@@ -16,12 +16,18 @@
 package commands
 
 import (
+	"cmp"
 	"context"
+	"errors"
+	"os"
 	"os/signal"
 	"syscall"
 	"time"
 
+	"confirmate.io/collectors/cloud/internal/config"
+	"confirmate.io/collectors/cloud/internal/quota"
 	cloud "confirmate.io/collectors/cloud/service"
+	"confirmate.io/core/api"
 	"confirmate.io/core/service"
 	"github.com/urfave/cli/v3"
 )
@@ -30,8 +36,14 @@ var cloudCollectorFlags = []cli.Flag{
 	&cli.StringFlag{
 		Name:     "collector-provider",
 		Aliases:  []string{"p"},
-		Usage:    "Cloud provider (aws, azure, openstack, k8s, csaf)",
-		Required: true,
+		Usage:    "Cloud provider (aws, azure, openstack, k8s, csaf, sbom, terraform). Required, unless a configuration file is given.",
+		Required: false,
+	},
+	&cli.StringFlag{
+		Name: "collector-config",
+		Usage: "Path to a YAML or JSON configuration file declaring the collectors to run. It replaces the provider, " +
+			"target of evaluation, interval and filter flags and is reloaded on SIGHUP or when it changes.",
+		Required: false,
 	},
 	&cli.StringFlag{
 		Name:     "collector-tool-id",
@@ -51,6 +63,50 @@ var cloudCollectorFlags = []cli.Flag{
 		Usage:    "CSAF domain to fetch the CSAF documents from.",
 		Required: false,
 	},
+	&cli.StringSliceFlag{
+		Name: "collector-account",
+		Usage: "Account to collect in the form <account>=<target-of-evaluation-id>. For AWS, the account is a role ARN " +
+			"or a chain of role ARNs separated by '>' to assume. For Azure, it is a subscription ID or a management " +
+			"group in the form mg:<name>. Can be specified multiple times.",
+		Required: false,
+	},
+	&cli.StringFlag{
+		Name:     "collector-environment",
+		Usage:    "Environment (e.g. prod or staging) of the collected resources, which is attached to their evidences.",
+		Required: false,
+	},
+	&cli.StringSliceFlag{
+		Name:     "collector-sbom-url",
+		Usage:    "URL of a CycloneDX or SPDX SBOM (JSON) to collect. Can be specified multiple times.",
+		Required: false,
+	},
+	&cli.StringSliceFlag{
+		Name:     "collector-terraform-source",
+		Usage:    "Path or HTTP(S) URL of a Terraform state or plan (JSON) to collect. Can be specified multiple times.",
+		Required: false,
+	},
+	&cli.IntFlag{
+		Name:     "collector-max-api-calls",
+		Usage:    "Maximum number of API calls to the provider in a single collector run. (Default: 0 for unlimited)",
+		Required: false,
+	},
+	&cli.FloatFlag{
+		Name:     "collector-api-rate",
+		Usage:    "Maximum number of API calls to the provider per second. (Default: 0 for unlimited)",
+		Required: false,
+	},
+	&cli.IntFlag{
+		Name:     "collector-max-retries",
+		Usage:    "Maximum number of retries of an API call that was throttled by the provider.",
+		Value:    quota.DefaultMaxRetries,
+		Required: false,
+	},
+	&cli.DurationFlag{
+		Name:     "collector-max-backoff",
+		Usage:    "Upper bound of the exponential backoff between retries, if the provider does not send a Retry-After.",
+		Value:    quota.DefaultMaxBackoff,
+		Required: false,
+	},
 }
 
 var cloudStandaloneFlags = []cli.Flag{
@@ -84,6 +140,38 @@ var cloudStandaloneFlags = []cli.Flag{
 		Usage:    "Address of the evidence store to send collected evidence to. (default: localhost:9092)",
 		Required: false,
 	},
+	&cli.StringFlag{
+		Name:     "collector-evidence-store-token",
+		Usage:    "Collector token issued by the evidence store, which identifies this collector",
+		Required: false,
+	},
+	&cli.StringFlag{
+		Name:     "collector-orchestrator-address",
+		Usage:    "Address of the orchestrator to register the resource inventory of each collector run with. If not set, no inventory is registered",
+		Required: false,
+	},
+	&cli.StringFlag{
+		Name:     "collector-orchestrator-token",
+		Usage:    "Token used to authenticate against the orchestrator",
+		Required: false,
+	},
+	&cli.BoolFlag{
+		Name:     "collector-diff",
+		Usage:    "Only send the evidences of resources whose state changed since their previously sent evidence. (Default: false)",
+		Required: false,
+	},
+	&cli.StringSliceFlag{
+		Name:     "collector-diff-ignore-field",
+		Usage:    "Resource field ignored when comparing the state of a resource with evidence diffing, e.g., creation_time. Can be specified multiple times",
+		Value:    cloud.DefaultDiffIgnoreFields,
+		Required: false,
+	},
+	&cli.DurationFlag{
+		Name:     "collector-diff-full-refresh",
+		Usage:    "Interval at which all evidences are sent regardless of their changes with evidence diffing. (0 disables full refreshes)",
+		Value:    cloud.DefaultDiffFullRefresh,
+		Required: false,
+	},
 }
 
 func cloudServiceOptionsFromCommand(cmd *cli.Command, targetOfEvaluationID string) (opts []service.Option[cloud.Service]) {
@@ -96,13 +184,40 @@ func cloudServiceOptionsFromCommand(cmd *cli.Command, targetOfEvaluationID strin
 	if cmd.String("collector-tool-id") != "" {
 		opts = append(opts, cloud.WithCollectorToolID(cmd.String("collector-tool-id")))
 	}
+	if cmd.String("collector-environment") != "" {
+		opts = append(opts, cloud.WithEnvironment(cmd.String("collector-environment")))
+	}
 	if cmd.Int("collector-interval") != 0 {
 		opts = append(opts, cloud.WithCollectorInterval(time.Duration(cmd.Int("collector-interval"))*time.Minute))
 	}
-	if cmd.String("collector-evidence-store-address") != "" {
-		opts = append(opts, cloud.WithEvidenceStoreAddress(cmd.String("collector-evidence-store-address"), service.DefaultHTTPClient))
+	if cmd.String("collector-evidence-store-address") != "" || cmd.String("collector-evidence-store-token") != "" {
+		opts = append(opts, cloud.WithEvidenceStoreAddress(
+			cmp.Or(cmd.String("collector-evidence-store-address"), cloud.DefaultEvidenceStoreURL),
+			api.NewOAuthHTTPClient(service.DefaultHTTPClient, api.NewStaticAuthorizer(cmd.String("collector-evidence-store-token"))),
+		))
+	}
+	if cmd.String("collector-orchestrator-address") != "" {
+		opts = append(opts, cloud.WithOrchestratorAddress(
+			cmd.String("collector-orchestrator-address"),
+			api.NewOAuthHTTPClient(service.DefaultHTTPClient, api.NewStaticAuthorizer(cmd.String("collector-orchestrator-token"))),
+		))
+	}
+
+	if cmd.Bool("collector-diff") {
+		opts = append(opts, cloud.WithEvidenceDiffing(cloud.DiffConfig{
+			IgnoreFields: cmd.StringSlice("collector-diff-ignore-field"),
+			FullRefresh:  cmd.Duration("collector-diff-full-refresh"),
+		}))
 	}
 
+	opts = append(opts, cloud.WithQuotaConfig(quota.Config{
+		MaxCallsPerRun:    cmd.Int("collector-max-api-calls"),
+		RequestsPerSecond: cmd.Float("collector-api-rate"),
+		MaxRetries:        cmd.Int("collector-max-retries"),
+		InitialBackoff:    quota.DefaultInitialBackoff,
+		MaxBackoff:        cmd.Duration("collector-max-backoff"),
+	}))
+
 	return opts
 }
 
@@ -114,10 +229,25 @@ var CloudCollectorCommand = &cli.Command{
 		var (
 			svc  *cloud.Service
 			opts []service.Option[cloud.Service]
+			path = cmd.String("collector-config")
+			file *config.File
+			err  error
 		)
 
+		if path == "" && cmd.String("collector-provider") == "" {
+			return errors.New("either a provider or a configuration file is required")
+		}
+
 		opts = cloudServiceOptionsFromCommand(cmd, cmd.String("target-of-evaluation-id"))
 
+		if path != "" {
+			file, err = config.LoadFile(path)
+			if err != nil {
+				return err
+			}
+			opts = append(opts, cloud.WithConfigFile(file))
+		}
+
 		svc = cloud.NewService(opts...)
 		svc.Init(ctx, cmd)
 
@@ -125,8 +255,20 @@ var CloudCollectorCommand = &cli.Command{
 		sigCtx, stop := signal.NotifyContext(ctx, syscall.SIGINT, syscall.SIGTERM)
 		defer stop()
 
+		// The configuration file is reloaded on SIGHUP and whenever it changes
+		if path != "" {
+			reload := make(chan os.Signal, 1)
+			signal.Notify(reload, syscall.SIGHUP)
+			defer signal.Stop(reload)
+
+			go config.Watch(sigCtx, path, config.DefaultWatchInterval, reload, svc.Reload)
+		}
+
 		<-sigCtx.Done() // Wait until signal
 
-		return nil
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		return svc.Shutdown(shutdownCtx)
 	},
 }
@@ -45,6 +45,7 @@ import (
 // - 2024-12-10: Add function HandleNetworkListSuccessfully() and add second path for "/networks" based on the HandleFunc in https://github.com/gophercloud/gophercloud/blob/5770765aa037e1572cbaa9474113010a1397e822/openstack/networking/v2/networks/testing/requests_test.go (anatheka)
 // - 2025-01-28: Add ProjectID property to all network objects (anatheka)
 // - 2026-05-13: Add fake server as parameter to functions, necessary since v2.12.0. (@anatheka)
+// - 2026-10-16: Add SecurityGroupListResponse and HandleSecurityGroupListSuccessfully() based on https://github.com/gophercloud/gophercloud/blob/master/openstack/networking/v2/extensions/security/groups/testing/fixtures.go
 
 const ListResponse = `
 {
@@ -294,3 +295,91 @@ func HandleNetworkListSuccessfully(t *testing.T, fakeServer th.FakeServer) {
 		fmt.Fprint(w, ListResponse)
 	})
 }
+
+const SecurityGroupListResponse = `
+{
+    "security_groups": [
+        {
+            "description": "default",
+            "id": "85cc3048-abc3-43cc-89b3-377341426ac5",
+            "name": "default",
+            "security_group_rules": [
+                {
+                    "direction": "egress",
+                    "ethertype": "IPv4",
+                    "id": "93aa42e5-80db-4581-9391-3a608bd0e448",
+                    "port_range_max": null,
+                    "port_range_min": null,
+                    "protocol": null,
+                    "remote_group_id": null,
+                    "remote_ip_prefix": null,
+                    "security_group_id": "85cc3048-abc3-43cc-89b3-377341426ac5",
+                    "tenant_id": "e4f50856753b4dc6afee5fa6b9b6c550"
+                },
+                {
+                    "direction": "ingress",
+                    "ethertype": "IPv4",
+                    "id": "a4c2b8b6-7d2e-4b1a-9a8c-3c4f1d2e5b6a",
+                    "port_range_max": null,
+                    "port_range_min": null,
+                    "protocol": null,
+                    "remote_group_id": "85cc3048-abc3-43cc-89b3-377341426ac5",
+                    "remote_ip_prefix": null,
+                    "security_group_id": "85cc3048-abc3-43cc-89b3-377341426ac5",
+                    "tenant_id": "e4f50856753b4dc6afee5fa6b9b6c550"
+                }
+            ],
+            "tenant_id": "e4f50856753b4dc6afee5fa6b9b6c550",
+            "project_id": "e4f50856753b4dc6afee5fa6b9b6c550",
+            "created_at": "2019-06-30T04:15:37Z",
+            "updated_at": "2019-06-30T05:18:49Z",
+            "tags": []
+        },
+        {
+            "description": "web",
+            "id": "2076db17-a522-4506-91de-c6dd8e837028",
+            "name": "web",
+            "security_group_rules": [
+                {
+                    "direction": "ingress",
+                    "ethertype": "IPv4",
+                    "id": "5fd1e4a1-4c5b-4bb0-8a6e-0a1b2c3d4e5f",
+                    "port_range_max": 443,
+                    "port_range_min": 443,
+                    "protocol": "tcp",
+                    "remote_group_id": null,
+                    "remote_ip_prefix": "0.0.0.0/0",
+                    "security_group_id": "2076db17-a522-4506-91de-c6dd8e837028",
+                    "tenant_id": "e4f50856753b4dc6afee5fa6b9b6c550"
+                }
+            ],
+            "tenant_id": "e4f50856753b4dc6afee5fa6b9b6c550",
+            "project_id": "e4f50856753b4dc6afee5fa6b9b6c550",
+            "created_at": "2019-06-30T04:15:37Z",
+            "updated_at": "2019-06-30T05:18:49Z",
+            "tags": ["web"]
+        }
+    ]
+}`
+
+func HandleSecurityGroupListSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/security-groups", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprint(w, SecurityGroupListResponse)
+	})
+
+	fakeServer.Mux.HandleFunc("/v2.0/security-groups", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprint(w, SecurityGroupListResponse)
+	})
+}
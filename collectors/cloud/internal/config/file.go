@@ -0,0 +1,231 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package config
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"confirmate.io/collectors/cloud/internal/logconfig"
+
+	"github.com/google/uuid"
+	"github.com/lmittmann/tint"
+	"go.yaml.in/yaml/v3"
+)
+
+// DefaultWatchInterval is the default interval at which [Watch] checks the configuration file for changes.
+const DefaultWatchInterval = 10 * time.Second
+
+// File is the configuration file of the cloud collector. It declares the collectors to run, each with its provider,
+// interval, filters and the targets of evaluation its resources are collected for. The file is written in YAML; since
+// YAML is a superset of JSON, JSON files are accepted as well.
+type File struct {
+	// TargetOfEvaluationID is the default target of evaluation of the collectors that do not declare their own.
+	TargetOfEvaluationID string `yaml:"targetOfEvaluationId"`
+
+	// Interval is the default interval of the collectors that do not declare their own, e.g., "10m". If it is not
+	// set, the interval of the collector service is used.
+	Interval time.Duration `yaml:"interval"`
+
+	// Environment is the default environment (e.g., "prod" or "staging") of the collectors that do not declare their
+	// own.
+	Environment string `yaml:"environment"`
+
+	// Collectors are the collectors to run.
+	Collectors []Collector `yaml:"collectors"`
+}
+
+// Collector is the configuration of a collector of a single provider in the [File].
+type Collector struct {
+	// Provider is the cloud provider to collect, e.g., "aws" or "azure".
+	Provider string `yaml:"provider"`
+
+	// TargetOfEvaluationID is the target of evaluation the resources are collected for, unless an account declares
+	// its own.
+	TargetOfEvaluationID string `yaml:"targetOfEvaluationId"`
+
+	// Interval is the interval at which the collector runs, e.g., "1h".
+	Interval time.Duration `yaml:"interval"`
+
+	// Environment is the environment (e.g., "prod" or "staging") of the collected resources. It is attached to their
+	// evidences, so that audit scopes can be restricted to an environment.
+	Environment string `yaml:"environment"`
+
+	// ResourceGroup limits an Azure collector to a specific resource group.
+	ResourceGroup string `yaml:"resourceGroup"`
+
+	// CSAFDomain is the domain of the CSAF trusted provider to collect.
+	CSAFDomain string `yaml:"csafDomain"`
+
+	// SBOMURLs are the URLs of the SBOMs to collect.
+	SBOMURLs []string `yaml:"sbomUrls"`
+
+	// TerraformSources are the paths or HTTP(S) URLs of the Terraform states and plans to collect.
+	TerraformSources []string `yaml:"terraformSources"`
+
+	// Accounts are the AWS accounts or Azure subscriptions to collect, each for its own target of evaluation.
+	Accounts []Account `yaml:"accounts"`
+}
+
+// Account is an account of the provider in the configuration of a [Collector].
+type Account struct {
+	// ID identifies the account, see the "collector-account" flag of the cloud collector.
+	ID string `yaml:"id"`
+
+	// TargetOfEvaluationID is the target of evaluation the resources of the account are collected for. If it is not
+	// set, the target of evaluation of the collector is used.
+	TargetOfEvaluationID string `yaml:"targetOfEvaluationId"`
+}
+
+// LoadFile reads and validates the configuration file at the given path. The targets of evaluation, intervals and
+// environments of the file are passed down to the collectors and accounts that do not declare their own.
+func LoadFile(path string) (f *File, err error) {
+	var (
+		b   []byte
+		dec *yaml.Decoder
+	)
+
+	b, err = os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("could not read configuration file: %w", err)
+	}
+
+	f = new(File)
+
+	// Unknown fields are most likely typos, which would otherwise silently disable a setting
+	dec = yaml.NewDecoder(bytes.NewReader(b))
+	dec.KnownFields(true)
+	if err = dec.Decode(f); err != nil {
+		return nil, fmt.Errorf("could not parse configuration file %s: %w", path, err)
+	}
+
+	if err = f.validate(); err != nil {
+		return nil, fmt.Errorf("invalid configuration file %s: %w", path, err)
+	}
+
+	return f, nil
+}
+
+// validate checks the configuration and passes the defaults of the file down to its collectors and accounts.
+func (f *File) validate() (err error) {
+	if len(f.Collectors) == 0 {
+		return errors.New("at least one collector must be configured")
+	}
+	if f.Interval < 0 {
+		return errors.New("interval must not be negative")
+	}
+	if err = validateTargetOfEvaluationID(f.TargetOfEvaluationID); err != nil {
+		return err
+	}
+
+	for i := range f.Collectors {
+		c := &f.Collectors[i]
+
+		if c.Provider == "" {
+			return fmt.Errorf("collector %d: provider must not be empty", i)
+		}
+		if c.Interval < 0 {
+			return fmt.Errorf("collector %d: interval must not be negative", i)
+		} else if c.Interval == 0 {
+			c.Interval = f.Interval
+		}
+		if c.Environment == "" {
+			c.Environment = f.Environment
+		}
+		if c.TargetOfEvaluationID == "" {
+			c.TargetOfEvaluationID = f.TargetOfEvaluationID
+		} else if err = validateTargetOfEvaluationID(c.TargetOfEvaluationID); err != nil {
+			return fmt.Errorf("collector %d: %w", i, err)
+		}
+
+		for j := range c.Accounts {
+			a := &c.Accounts[j]
+
+			if a.ID == "" {
+				return fmt.Errorf("collector %d: account %d: id must not be empty", i, j)
+			}
+			if a.TargetOfEvaluationID == "" {
+				a.TargetOfEvaluationID = c.TargetOfEvaluationID
+			} else if err = validateTargetOfEvaluationID(a.TargetOfEvaluationID); err != nil {
+				return fmt.Errorf("collector %d: account %d: %w", i, j, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateTargetOfEvaluationID checks that the ID of a target of evaluation is either empty or a UUID.
+func validateTargetOfEvaluationID(id string) (err error) {
+	if id == "" {
+		return nil
+	}
+
+	if _, err = uuid.Parse(id); err != nil {
+		return fmt.Errorf("invalid target of evaluation ID: %w", err)
+	}
+
+	return nil
+}
+
+// Watch reloads the configuration file at the given path whenever its modification time or size changes, which is
+// checked at the given interval, or whenever a value is received from reload, e.g., on SIGHUP. Each successfully loaded
+// configuration is passed to apply. If the file is invalid or apply fails, the error is logged and the previous
+// configuration stays in effect. Watch blocks until the context is done.
+func Watch(ctx context.Context, path string, interval time.Duration, reload <-chan os.Signal, apply func(f *File) error) {
+	var (
+		log    = logconfig.GetLogger()
+		ticker = time.NewTicker(interval)
+		last   os.FileInfo
+		err    error
+	)
+	defer ticker.Stop()
+
+	// The file was already loaded on startup, so only later changes are of interest
+	last, err = os.Stat(path)
+	if err != nil {
+		log.Error("Could not watch configuration file", "path", path, tint.Err(err))
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-reload:
+			log.Info("Reloading configuration file", "path", path)
+		case <-ticker.C:
+			info, err := os.Stat(path)
+			if err != nil || (last != nil && info.ModTime().Equal(last.ModTime()) && info.Size() == last.Size()) {
+				continue
+			}
+			last = info
+
+			log.Info("Configuration file changed, reloading", "path", path)
+		}
+
+		f, err := LoadFile(path)
+		if err == nil {
+			err = apply(f)
+		}
+		if err != nil {
+			log.Error("Could not reload configuration file, keeping the previous configuration", "path", path, tint.Err(err))
+		}
+	}
+}
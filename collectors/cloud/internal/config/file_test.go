@@ -0,0 +1,201 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package config
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"confirmate.io/collectors/cloud/internal/testdata"
+	"confirmate.io/core/util/assert"
+)
+
+// writeFile writes the content to a configuration file in a temporary directory and returns its path.
+func writeFile(t *testing.T, name string, content string) string {
+	path := filepath.Join(t.TempDir(), name)
+	assert.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+
+	return path
+}
+
+func TestLoadFile(t *testing.T) {
+	tests := []struct {
+		name    string
+		file    string
+		content string
+		want    assert.Want[*File]
+		wantErr assert.WantErr
+	}{
+		{
+			name: "YAML with defaults",
+			file: "collector.yaml",
+			content: `
+targetOfEvaluationId: ` + testdata.MockTargetOfEvaluationID1 + `
+interval: 10m
+environment: prod
+collectors:
+  - provider: azure
+    resourceGroup: production
+    accounts:
+      - id: mg:production
+      - id: 00000000-0000-0000-0000-000000000001
+        targetOfEvaluationId: ` + testdata.MockTargetOfEvaluationID2 + `
+  - provider: sbom
+    interval: 1h
+    environment: staging
+    sbomUrls:
+      - https://example.com/sbom.json
+  - provider: terraform
+    terraformSources:
+      - plan.json
+`,
+			want: func(t *testing.T, got *File, msgAndArgs ...any) bool {
+				azure := got.Collectors[0]
+				sbom := got.Collectors[1]
+
+				return assert.Equal(t, 10*time.Minute, azure.Interval) &&
+					assert.Equal(t, "production", azure.ResourceGroup) &&
+					assert.Equal(t, testdata.MockTargetOfEvaluationID1, azure.TargetOfEvaluationID) &&
+					assert.Equal(t, testdata.MockTargetOfEvaluationID1, azure.Accounts[0].TargetOfEvaluationID) &&
+					assert.Equal(t, testdata.MockTargetOfEvaluationID2, azure.Accounts[1].TargetOfEvaluationID) &&
+					assert.Equal(t, "prod", azure.Environment) &&
+					assert.Equal(t, time.Hour, sbom.Interval) &&
+					assert.Equal(t, "staging", sbom.Environment) &&
+					assert.Equal(t, []string{"https://example.com/sbom.json"}, sbom.SBOMURLs) &&
+					assert.Equal(t, []string{"plan.json"}, got.Collectors[2].TerraformSources)
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name:    "JSON",
+			file:    "collector.json",
+			content: `{"collectors": [{"provider": "csaf", "csafDomain": "example.com", "interval": "30m"}]}`,
+			want: func(t *testing.T, got *File, msgAndArgs ...any) bool {
+				return assert.Equal(t, "csaf", got.Collectors[0].Provider) &&
+					assert.Equal(t, "example.com", got.Collectors[0].CSAFDomain) &&
+					assert.Equal(t, 30*time.Minute, got.Collectors[0].Interval)
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name:    "unknown field",
+			file:    "collector.yaml",
+			content: "collectors:\n  - provider: aws\n    resourcegroup: production\n",
+			want:    assert.Nil[*File],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.ErrorContains(t, err, "field resourcegroup not found")
+			},
+		},
+		{
+			name:    "no collectors",
+			file:    "collector.yaml",
+			content: "interval: 5m\n",
+			want:    assert.Nil[*File],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.ErrorContains(t, err, "at least one collector must be configured")
+			},
+		},
+		{
+			name:    "missing provider",
+			file:    "collector.yaml",
+			content: "collectors:\n  - interval: 5m\n",
+			want:    assert.Nil[*File],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.ErrorContains(t, err, "collector 0: provider must not be empty")
+			},
+		},
+		{
+			name:    "invalid target of evaluation of account",
+			file:    "collector.yaml",
+			content: "collectors:\n  - provider: aws\n    accounts:\n      - id: arn:aws:iam::111111111111:role/collector\n        targetOfEvaluationId: toe\n",
+			want:    assert.Nil[*File],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.ErrorContains(t, err, "collector 0: account 0: invalid target of evaluation ID")
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := LoadFile(writeFile(t, tt.file, tt.content))
+
+			tt.want(t, got)
+			tt.wantErr(t, err)
+		})
+	}
+}
+
+func TestWatch(t *testing.T) {
+	var (
+		path    = writeFile(t, "collector.yaml", "collectors:\n  - provider: aws\n")
+		applied = make(chan *File, 1)
+		calls   atomic.Int32
+	)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	go Watch(ctx, path, 10*time.Millisecond, nil, func(f *File) error {
+		calls.Add(1)
+		applied <- f
+		return nil
+	})
+
+	// Give the watcher time to record the initial state of the file
+	time.Sleep(50 * time.Millisecond)
+
+	// A change of the file is picked up by polling
+	assert.NoError(t, os.WriteFile(path, []byte("collectors:\n  - provider: azure\n"), 0o600))
+	select {
+	case f := <-applied:
+		assert.Equal(t, "azure", f.Collectors[0].Provider)
+	case <-time.After(time.Second):
+		t.Fatal("timeout waiting for reload after file change")
+	}
+
+	// An invalid file is not applied
+	assert.NoError(t, os.WriteFile(path, []byte("collectors: []\n"), 0o600))
+	time.Sleep(50 * time.Millisecond)
+	assert.Equal(t, int32(1), calls.Load())
+}
+
+func TestWatch_reload(t *testing.T) {
+	var (
+		path    = writeFile(t, "collector.yaml", "collectors:\n  - provider: aws\n")
+		reload  = make(chan os.Signal)
+		applied = make(chan *File, 1)
+	)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// The file is not polled, so it is only reloaded on signal
+	go Watch(ctx, path, time.Hour, reload, func(f *File) error {
+		applied <- f
+		return nil
+	})
+
+	reload <- os.Interrupt
+	select {
+	case f := <-applied:
+		assert.Equal(t, "aws", f.Collectors[0].Provider)
+	case <-time.After(time.Second):
+		t.Fatal("timeout waiting for reload after signal")
+	}
+}
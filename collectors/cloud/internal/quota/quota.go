@@ -0,0 +1,365 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+// Package quota guards the API calls of the cloud collectors against the rate limits and quotas of the cloud
+// providers. A [Guard] limits the rate of API calls, enforces a budget of API calls per collector run and retries
+// throttled calls, respecting the Retry-After header of the provider or falling back to an exponential backoff.
+package quota
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultMaxRetries is the default number of retries of a throttled API call.
+	DefaultMaxRetries = 5
+
+	// DefaultInitialBackoff is the default backoff before the first retry of a throttled API call, if the provider
+	// does not specify one.
+	DefaultInitialBackoff = time.Second
+
+	// DefaultMaxBackoff is the default upper bound of the exponential backoff.
+	DefaultMaxBackoff = time.Minute
+)
+
+// ErrBudgetExceeded is returned if the budget of API calls of a collector run is exhausted.
+var ErrBudgetExceeded = errors.New("budget of API calls exceeded")
+
+// DefaultConfig is the default [Config], which retries throttled API calls but neither limits the rate nor the
+// number of API calls.
+var DefaultConfig = Config{
+	MaxRetries:     DefaultMaxRetries,
+	InitialBackoff: DefaultInitialBackoff,
+	MaxBackoff:     DefaultMaxBackoff,
+}
+
+// Config configures a [Guard].
+type Config struct {
+	// MaxCallsPerRun is the maximum number of API calls (including retries) in a single collector run. Zero means
+	// unlimited.
+	MaxCallsPerRun int
+
+	// RequestsPerSecond is the maximum rate of API calls. Zero means unlimited.
+	RequestsPerSecond float64
+
+	// MaxRetries is the maximum number of retries of a throttled API call.
+	MaxRetries int
+
+	// InitialBackoff is the backoff before the first retry of a throttled API call, if the provider does not specify
+	// a Retry-After. It is doubled for every further retry.
+	InitialBackoff time.Duration
+
+	// MaxBackoff is the upper bound of the exponential backoff.
+	MaxBackoff time.Duration
+}
+
+// Stats contains the throttling metrics of the current collector run.
+type Stats struct {
+	// Calls is the number of API calls, including retries.
+	Calls int
+
+	// Throttled is the number of API calls that were throttled by the provider.
+	Throttled int
+
+	// Retries is the number of retried API calls.
+	Retries int
+
+	// Rejected is the number of API calls that were rejected because the budget was exceeded.
+	Rejected int
+
+	// Waited is the total time API calls waited because of the rate limit or throttling.
+	Waited time.Duration
+}
+
+// Guarded is implemented by collectors whose API calls are guarded by a [Guard].
+type Guarded interface {
+	// QuotaGuard returns the guard of the collector or nil, if its API calls are not guarded.
+	QuotaGuard() *Guard
+}
+
+// Doer sends HTTP requests. It is implemented by [http.Client] and matches the transport interfaces of the Azure
+// and AWS SDKs.
+type Doer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// DoerFunc is an adapter to use a function as [Doer].
+type DoerFunc func(req *http.Request) (*http.Response, error)
+
+// Do calls f(req).
+func (f DoerFunc) Do(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// Guard guards the API calls to a cloud provider. It is safe for concurrent use; concurrent API calls share the rate
+// limit, the budget and the backoff after a throttled call.
+type Guard struct {
+	provider string
+	cfg      Config
+
+	mu    sync.Mutex
+	stats Stats
+	// next is the earliest time at which the next API call may be sent.
+	next time.Time
+
+	now   func() time.Time
+	sleep func(ctx context.Context, d time.Duration) error
+}
+
+// budgetError is returned if the budget is exceeded. It signals the retry policies of the provider SDKs that the
+// call must not be retried.
+type budgetError struct {
+	provider string
+}
+
+func (e *budgetError) Error() string {
+	return fmt.Sprintf("%s: %s", e.provider, ErrBudgetExceeded)
+}
+
+func (*budgetError) Unwrap() error {
+	return ErrBudgetExceeded
+}
+
+// NonRetriable marks the error as non-retriable for the Azure SDK.
+func (*budgetError) NonRetriable() {}
+
+// RetryableError marks the error as non-retryable for the AWS SDK.
+func (*budgetError) RetryableError() bool {
+	return false
+}
+
+// NewGuard creates a new [Guard] for the API calls to the given provider.
+func NewGuard(provider string, cfg Config) *Guard {
+	return &Guard{
+		provider: provider,
+		cfg:      cfg,
+		now:      time.Now,
+		sleep:    sleep,
+	}
+}
+
+// StartRun starts a new collector run, which resets the budget and the [Stats].
+func (g *Guard) StartRun() {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.stats = Stats{}
+}
+
+// Stats returns the [Stats] of the current collector run.
+func (g *Guard) Stats() Stats {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	return g.stats
+}
+
+// Doer returns a [Doer] that guards the requests sent by next. If next is nil, [http.DefaultClient] is used.
+func (g *Guard) Doer(next Doer) Doer {
+	if next == nil {
+		next = http.DefaultClient
+	}
+
+	return DoerFunc(func(req *http.Request) (*http.Response, error) {
+		return g.do(req, next.Do)
+	})
+}
+
+// do sends the request using send. Throttled requests are retried up to [Config.MaxRetries] times. If the request
+// is still throttled afterwards, the throttled response is returned.
+func (g *Guard) do(req *http.Request, send func(req *http.Request) (*http.Response, error)) (res *http.Response, err error) {
+	for attempt := 0; ; attempt++ {
+		if attempt > 0 {
+			if req, err = rewind(req); err != nil {
+				return nil, err
+			}
+		}
+
+		err = g.acquire(req.Context(), attempt > 0)
+		if err != nil {
+			return nil, err
+		}
+
+		res, err = send(req)
+		if err != nil || !isThrottled(res) {
+			return res, err
+		}
+
+		g.throttled(res, attempt)
+
+		// Give up and let the caller handle the throttled response. The same is true if the body of the request
+		// cannot be sent again.
+		if attempt >= g.cfg.MaxRetries || (req.Body != nil && req.Body != http.NoBody && req.GetBody == nil) {
+			return res, nil
+		}
+
+		// Drain the body, so that the connection can be re-used
+		_, _ = io.Copy(io.Discard, res.Body)
+		_ = res.Body.Close()
+	}
+}
+
+// acquire accounts an API call against the budget and waits until it may be sent according to the rate limit and
+// the backoff of previously throttled calls.
+func (g *Guard) acquire(ctx context.Context, retry bool) (err error) {
+	var (
+		now  time.Time
+		wait time.Duration
+	)
+
+	g.mu.Lock()
+
+	if g.cfg.MaxCallsPerRun > 0 && g.stats.Calls >= g.cfg.MaxCallsPerRun {
+		g.stats.Rejected++
+		g.mu.Unlock()
+		return &budgetError{provider: g.provider}
+	}
+
+	g.stats.Calls++
+	if retry {
+		g.stats.Retries++
+	}
+
+	now = g.now()
+	if g.next.After(now) {
+		wait = g.next.Sub(now)
+	} else {
+		g.next = now
+	}
+	if g.cfg.RequestsPerSecond > 0 {
+		g.next = g.next.Add(time.Duration(float64(time.Second) / g.cfg.RequestsPerSecond))
+	}
+	g.stats.Waited += wait
+
+	g.mu.Unlock()
+
+	if wait > 0 {
+		return g.sleep(ctx, wait)
+	}
+
+	return nil
+}
+
+// throttled records a throttled API call and delays all further calls by the Retry-After of the response or by the
+// exponential backoff.
+func (g *Guard) throttled(res *http.Response, attempt int) {
+	var (
+		wait time.Duration
+		ok   bool
+	)
+
+	wait, ok = retryAfter(res, g.now())
+	if !ok {
+		wait = g.backoff(attempt)
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.stats.Throttled++
+	if until := g.now().Add(wait); until.After(g.next) {
+		g.next = until
+	}
+}
+
+// backoff returns the exponential backoff for the given attempt.
+func (g *Guard) backoff(attempt int) (d time.Duration) {
+	d = g.cfg.InitialBackoff
+	for i := 0; i < attempt && (g.cfg.MaxBackoff <= 0 || d < g.cfg.MaxBackoff); i++ {
+		d *= 2
+	}
+
+	if g.cfg.MaxBackoff > 0 && d > g.cfg.MaxBackoff {
+		d = g.cfg.MaxBackoff
+	}
+
+	return d
+}
+
+// isThrottled returns true, if the provider throttled the request.
+func isThrottled(res *http.Response) bool {
+	return res.StatusCode == http.StatusTooManyRequests || res.StatusCode == http.StatusServiceUnavailable
+}
+
+// retryAfter returns the delay requested by the provider. Besides the standard Retry-After header (in seconds or as
+// HTTP date), the millisecond headers used by Azure are supported.
+func retryAfter(res *http.Response, now time.Time) (d time.Duration, ok bool) {
+	var (
+		v   string
+		n   int64
+		t   time.Time
+		err error
+	)
+
+	for _, header := range []string{"Retry-After-Ms", "X-Ms-Retry-After-Ms"} {
+		if v = res.Header.Get(header); v != "" {
+			if n, err = strconv.ParseInt(v, 10, 64); err == nil && n >= 0 {
+				return time.Duration(n) * time.Millisecond, true
+			}
+		}
+	}
+
+	v = res.Header.Get("Retry-After")
+	if v == "" {
+		return 0, false
+	}
+
+	if n, err = strconv.ParseInt(v, 10, 64); err == nil && n >= 0 {
+		return time.Duration(n) * time.Second, true
+	}
+
+	if t, err = http.ParseTime(v); err == nil {
+		return max(t.Sub(now), 0), true
+	}
+
+	return 0, false
+}
+
+// rewind prepares the request to be sent again.
+func rewind(req *http.Request) (*http.Request, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return req, nil
+	}
+
+	body, err := req.GetBody()
+	if err != nil {
+		return nil, fmt.Errorf("could not rewind request body: %w", err)
+	}
+
+	req = req.Clone(req.Context())
+	req.Body = body
+
+	return req, nil
+}
+
+// sleep waits for the given duration or until the context is done.
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
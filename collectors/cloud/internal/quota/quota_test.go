@@ -0,0 +1,262 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package quota
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"confirmate.io/core/util/assert"
+)
+
+// newTestGuard returns a [Guard] with a fixed clock, which does not actually sleep but records the waits instead.
+func newTestGuard(cfg Config) (g *Guard, waits *[]time.Duration) {
+	var (
+		now = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	)
+
+	waits = new([]time.Duration)
+	g = NewGuard("test", cfg)
+	g.now = func() time.Time { return now }
+	g.sleep = func(_ context.Context, d time.Duration) error {
+		*waits = append(*waits, d)
+		return nil
+	}
+
+	return g, waits
+}
+
+// newThrottlingServer returns a server that throttles the first n requests with the given headers.
+func newThrottlingServer(t *testing.T, n int32, status int, header http.Header) *httptest.Server {
+	var count atomic.Int32
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if count.Add(1) <= n {
+			for k, v := range header {
+				w.Header()[k] = v
+			}
+			w.WriteHeader(status)
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(srv.Close)
+
+	return srv
+}
+
+func TestGuard_Doer(t *testing.T) {
+	type args struct {
+		throttled int32
+		status    int
+		header    http.Header
+		method    string
+		body      string
+	}
+	tests := []struct {
+		name       string
+		cfg        Config
+		args       args
+		wantStatus int
+		wantStats  Stats
+		wantWaits  []time.Duration
+	}{
+		{
+			name:       "happy path: not throttled",
+			cfg:        DefaultConfig,
+			args:       args{method: http.MethodGet},
+			wantStatus: http.StatusOK,
+			wantStats:  Stats{Calls: 1},
+		},
+		{
+			name:       "happy path: retry after",
+			cfg:        DefaultConfig,
+			args:       args{throttled: 2, status: http.StatusTooManyRequests, header: http.Header{"Retry-After": {"7"}}, method: http.MethodGet},
+			wantStatus: http.StatusOK,
+			wantStats:  Stats{Calls: 3, Throttled: 2, Retries: 2, Waited: 14 * time.Second},
+			wantWaits:  []time.Duration{7 * time.Second, 7 * time.Second},
+		},
+		{
+			name:       "happy path: exponential backoff",
+			cfg:        DefaultConfig,
+			args:       args{throttled: 3, status: http.StatusServiceUnavailable, method: http.MethodGet},
+			wantStatus: http.StatusOK,
+			wantStats:  Stats{Calls: 4, Throttled: 3, Retries: 3, Waited: 7 * time.Second},
+			wantWaits:  []time.Duration{time.Second, 2 * time.Second, 4 * time.Second},
+		},
+		{
+			name:       "happy path: request body is sent again",
+			cfg:        DefaultConfig,
+			args:       args{throttled: 1, status: http.StatusTooManyRequests, header: http.Header{"Retry-After-Ms": {"500"}}, method: http.MethodPost, body: "body"},
+			wantStatus: http.StatusOK,
+			wantStats:  Stats{Calls: 2, Throttled: 1, Retries: 1, Waited: 500 * time.Millisecond},
+			wantWaits:  []time.Duration{500 * time.Millisecond},
+		},
+		{
+			name:       "max retries exceeded",
+			cfg:        Config{MaxRetries: 1, InitialBackoff: time.Second},
+			args:       args{throttled: 5, status: http.StatusTooManyRequests, method: http.MethodGet},
+			wantStatus: http.StatusTooManyRequests,
+			wantStats:  Stats{Calls: 2, Throttled: 2, Retries: 1, Waited: time.Second},
+			wantWaits:  []time.Duration{time.Second},
+		},
+		{
+			name:       "other errors are not retried",
+			cfg:        DefaultConfig,
+			args:       args{throttled: 5, status: http.StatusInternalServerError, method: http.MethodGet},
+			wantStatus: http.StatusInternalServerError,
+			wantStats:  Stats{Calls: 1},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var body *strings.Reader
+
+			srv := newThrottlingServer(t, tt.args.throttled, tt.args.status, tt.args.header)
+			g, waits := newTestGuard(tt.cfg)
+
+			body = strings.NewReader(tt.args.body)
+			req, err := http.NewRequest(tt.args.method, srv.URL, body)
+			assert.NoError(t, err)
+
+			res, err := g.Doer(nil).Do(req)
+			assert.NoError(t, err)
+			_ = res.Body.Close()
+
+			assert.Equal(t, tt.wantStatus, res.StatusCode)
+			assert.Equal(t, tt.wantStats, g.Stats())
+			assert.Equal(t, tt.wantWaits, *waits)
+		})
+	}
+}
+
+func TestGuard_budget(t *testing.T) {
+	srv := newThrottlingServer(t, 0, 0, nil)
+	g, _ := newTestGuard(Config{MaxCallsPerRun: 2})
+	doer := g.Doer(srv.Client())
+
+	req, err := http.NewRequest(http.MethodGet, srv.URL, nil)
+	assert.NoError(t, err)
+
+	for range 2 {
+		res, err := doer.Do(req)
+		assert.NoError(t, err)
+		_ = res.Body.Close()
+	}
+
+	// The third call exceeds the budget
+	_, err = doer.Do(req)
+	assert.ErrorIs(t, err, ErrBudgetExceeded)
+	assert.Equal(t, Stats{Calls: 2, Rejected: 1}, g.Stats())
+
+	// The error must not be retried by the provider SDKs
+	var retryable interface{ RetryableError() bool }
+	assert.True(t, errors.As(err, &retryable))
+	assert.False(t, retryable.RetryableError())
+
+	// A new run resets the budget
+	g.StartRun()
+	res, err := doer.Do(req)
+	assert.NoError(t, err)
+	_ = res.Body.Close()
+	assert.Equal(t, Stats{Calls: 1}, g.Stats())
+}
+
+func TestGuard_rateLimit(t *testing.T) {
+	srv := newThrottlingServer(t, 0, 0, nil)
+	g, waits := newTestGuard(Config{RequestsPerSecond: 10})
+	doer := g.Doer(srv.Client())
+
+	for range 3 {
+		req, err := http.NewRequest(http.MethodGet, srv.URL, nil)
+		assert.NoError(t, err)
+
+		res, err := doer.Do(req)
+		assert.NoError(t, err)
+		_ = res.Body.Close()
+	}
+
+	assert.Equal(t, []time.Duration{100 * time.Millisecond, 200 * time.Millisecond}, *waits)
+	assert.Equal(t, Stats{Calls: 3, Waited: 300 * time.Millisecond}, g.Stats())
+}
+
+func TestGuard_backoff(t *testing.T) {
+	g := NewGuard("test", Config{InitialBackoff: time.Second, MaxBackoff: 5 * time.Second})
+
+	assert.Equal(t, time.Second, g.backoff(0))
+	assert.Equal(t, 2*time.Second, g.backoff(1))
+	assert.Equal(t, 4*time.Second, g.backoff(2))
+	assert.Equal(t, 5*time.Second, g.backoff(3))
+	assert.Equal(t, 5*time.Second, g.backoff(100))
+}
+
+func Test_retryAfter(t *testing.T) {
+	var now = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		header http.Header
+		want   time.Duration
+		wantOk bool
+	}{
+		{
+			name:   "no header",
+			header: http.Header{},
+		},
+		{
+			name:   "seconds",
+			header: http.Header{"Retry-After": {"30"}},
+			want:   30 * time.Second,
+			wantOk: true,
+		},
+		{
+			name:   "HTTP date",
+			header: http.Header{"Retry-After": {now.Add(time.Minute).Format(http.TimeFormat)}},
+			want:   time.Minute,
+			wantOk: true,
+		},
+		{
+			name:   "HTTP date in the past",
+			header: http.Header{"Retry-After": {now.Add(-time.Minute).Format(http.TimeFormat)}},
+			want:   0,
+			wantOk: true,
+		},
+		{
+			name:   "Azure milliseconds",
+			header: http.Header{"X-Ms-Retry-After-Ms": {"1500"}, "Retry-After": {"2"}},
+			want:   1500 * time.Millisecond,
+			wantOk: true,
+		},
+		{
+			name:   "invalid",
+			header: http.Header{"Retry-After": {"soon"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := retryAfter(&http.Response{Header: tt.header}, now)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantOk, ok)
+		})
+	}
+}
@@ -58,6 +58,10 @@ const (
 	MockOpenstackNetworkID1   = "00000000000000000000000000000004"
 	MockOpenstackNetworkName1 = "Network 1"
 
+	// security group
+	MockOpenstackSecurityGroupID1   = "00000000000000000000000000000005"
+	MockOpenstackSecurityGroupName1 = "Security Group 1"
+
 	// Audit Scope
 	MockAuditScopeID1   = "11111111-1111-1111-1111-111111111123"
 	MockAuditScopeName1 = "Mock Audit Scope 1"
@@ -0,0 +1,57 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package cloud
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// account is an account of the provider whose resources are collected for a target of evaluation. Enterprises usually
+// spread their resources across many accounts, which can be collected by a single collector this way.
+type account struct {
+	// id identifies the account. For AWS, this is the ARN of the role to assume or a chain of role ARNs separated by
+	// ">". For Azure, this is the ID of a subscription or the name of a management group prefixed with "mg:".
+	id string
+
+	// targetOfEvaluationID is the target of evaluation the resources of the account are collected for.
+	targetOfEvaluationID string
+}
+
+// parseAccounts parses the accounts given in the form "<account>=<target-of-evaluation-id>". If the target of evaluation
+// is omitted, the resources of the account are collected for the default target of evaluation.
+func parseAccounts(values []string, defaultTargetOfEvaluationID string) (accounts []account, err error) {
+	for _, value := range values {
+		var a account
+
+		a.id, a.targetOfEvaluationID, _ = strings.Cut(value, "=")
+		if a.id == "" {
+			return nil, fmt.Errorf("account %q: account must not be empty", value)
+		}
+
+		if a.targetOfEvaluationID == "" {
+			a.targetOfEvaluationID = defaultTargetOfEvaluationID
+		} else if _, err = uuid.Parse(a.targetOfEvaluationID); err != nil {
+			return nil, fmt.Errorf("account %q: invalid target of evaluation ID: %w", value, err)
+		}
+
+		accounts = append(accounts, a)
+	}
+
+	return accounts, nil
+}
@@ -0,0 +1,91 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package cloud
+
+import (
+	"testing"
+
+	"confirmate.io/collectors/cloud/internal/config"
+	"confirmate.io/collectors/cloud/internal/testdata"
+	"confirmate.io/core/util/assert"
+)
+
+func Test_parseAccounts(t *testing.T) {
+	type args struct {
+		values []string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    []account
+		wantErr assert.WantErr
+	}{
+		{
+			name:    "no accounts",
+			args:    args{},
+			want:    nil,
+			wantErr: assert.NoError,
+		},
+		{
+			name: "happy path",
+			args: args{
+				values: []string{
+					"arn:aws:iam::111111111111:role/hub>arn:aws:iam::222222222222:role/collector=" + testdata.MockTargetOfEvaluationID1,
+					"mg:production",
+				},
+			},
+			want: []account{
+				{
+					id:                   "arn:aws:iam::111111111111:role/hub>arn:aws:iam::222222222222:role/collector",
+					targetOfEvaluationID: testdata.MockTargetOfEvaluationID1,
+				},
+				{
+					id:                   "mg:production",
+					targetOfEvaluationID: config.DefaultTargetOfEvaluationID,
+				},
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "empty account",
+			args: args{
+				values: []string{"=" + testdata.MockTargetOfEvaluationID1},
+			},
+			want: nil,
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.ErrorContains(t, err, "account must not be empty")
+			},
+		},
+		{
+			name: "invalid target of evaluation",
+			args: args{
+				values: []string{"00000000-0000-0000-0000-000000000001=not-a-uuid"},
+			},
+			want: nil,
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.ErrorContains(t, err, "invalid target of evaluation ID")
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseAccounts(tt.args.values, config.DefaultTargetOfEvaluationID)
+
+			assert.Equal(t, tt.want, got, assert.CompareAllUnexported())
+			tt.wantErr(t, err)
+		})
+	}
+}
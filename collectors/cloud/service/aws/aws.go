@@ -22,10 +22,13 @@ import (
 	"log/slog"
 
 	"confirmate.io/collectors/cloud/internal/logconfig"
+	"confirmate.io/collectors/cloud/internal/quota"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
 	"github.com/aws/aws-sdk-go-v2/service/sts"
 	"github.com/aws/smithy-go"
+	"github.com/google/uuid"
 )
 
 var (
@@ -36,8 +39,16 @@ var (
 
 	// newFromConfigSTS holds sts.NewFromConfig() so that NewClient() can use it and test function can mock it
 	newFromConfigSTS = loadSTSClient
+
+	// newAssumeRoleClient holds sts.NewFromConfig() so that NewClient() can use it to assume roles and test function can
+	// mock it
+	newAssumeRoleClient = loadAssumeRoleClient
 )
 
+// RoleSessionName is the name of the sessions of the roles assumed by the collector, so that its API calls can be
+// identified in CloudTrail.
+const RoleSessionName = "confirmate-collector"
+
 // Client holds configurations across all services within AWS
 type Client struct {
 	// cfg holds AWS SDK configuration
@@ -45,6 +56,33 @@ type Client struct {
 
 	// accountID is needed for ARN creation
 	accountID *string
+
+	// quota optionally holds the configuration of the [quota.Guard] of each collector.
+	quota *quota.Config
+
+	// roles optionally holds the ARNs of the roles that are assumed one after another to access the account.
+	roles []string
+}
+
+// ClientOption is a functional option for [NewClient].
+type ClientOption func(c *Client)
+
+// WithQuotaConfig is a [ClientOption] that guards the API calls of each collector created with the client by a
+// separate [quota.Guard] using the given configuration.
+func WithQuotaConfig(cfg quota.Config) ClientOption {
+	return func(c *Client) {
+		c.quota = &cfg
+	}
+}
+
+// WithAssumeRoleChain is a [ClientOption] that accesses the account by assuming the given roles one after another,
+// starting with the default credentials. Each role must be assumable with the credentials of the previous one. This
+// allows a single collector to collect the resources of several accounts, e.g., by assuming a role in each member
+// account of an organization.
+func WithAssumeRoleChain(roleARNs ...string) ClientOption {
+	return func(c *Client) {
+		c.roles = roleARNs
+	}
 }
 
 // STSAPI describes the STS api interface which is implemented by the official AWS client and mock clients in tests
@@ -58,14 +96,25 @@ func init() {
 
 // NewClient constructs a new AwsClient
 // TODO(lebogg): "Overload" (switch) with staticCredentialsProvider
-func NewClient() (*Client, error) {
+func NewClient(opts ...ClientOption) (*Client, error) {
 	c := &Client{}
 
+	for _, opt := range opts {
+		opt(c)
+	}
+
 	// load configuration
 	cfg, err := loadDefaultConfig(context.TODO())
 	if err != nil {
 		return nil, fmt.Errorf("could not load default config: %w", err)
 	}
+	// assume the roles of the chain, if any
+	for _, role := range c.roles {
+		cfg.Credentials = aws.NewCredentialsCache(stscreds.NewAssumeRoleProvider(newAssumeRoleClient(cfg), role,
+			func(o *stscreds.AssumeRoleOptions) {
+				o.RoleSessionName = RoleSessionName
+			}))
+	}
 	c.cfg = cfg
 
 	// load accountID
@@ -79,6 +128,32 @@ func NewClient() (*Client, error) {
 	return c, err
 }
 
+// collectorID returns a stable ID of a collector of the given kind for the target of evaluation. If the client assumes
+// roles, the ID also depends on the account, so that the collectors of several accounts of the same target of
+// evaluation can be told apart.
+func (c *Client) collectorID(kind string, targetOfEvaluationID string) string {
+	seed := kind + "::" + targetOfEvaluationID
+	if len(c.roles) > 0 {
+		seed += "::" + aws.ToString(c.accountID)
+	}
+
+	return uuid.NewSHA1(uuid.NameSpaceOID, []byte(seed)).String()
+}
+
+// guardedConfig returns a copy of the AWS configuration whose API calls are guarded by a new [quota.Guard], if the
+// client has a quota configuration. Otherwise, the configuration itself and a nil guard are returned.
+func (c *Client) guardedConfig(name string) (cfg aws.Config, guard *quota.Guard) {
+	if c.quota == nil {
+		return c.cfg, nil
+	}
+
+	guard = quota.NewGuard(name, *c.quota)
+	cfg = c.cfg.Copy()
+	cfg.HTTPClient = guard.Doer(cfg.HTTPClient)
+
+	return cfg, guard
+}
+
 // formatError returns AWS API specific error code transformed into the default error type
 func formatError(ae smithy.APIError) error {
 	return fmt.Errorf("code: %v, fault: %v, message: %v", ae.ErrorCode(), ae.ErrorFault(), ae.ErrorMessage())
@@ -98,3 +173,9 @@ func loadSTSClient(cfg aws.Config) STSAPI {
 	client := sts.NewFromConfig(cfg)
 	return client
 }
+
+// loadAssumeRoleClient creates the STS client that is used to assume a role with the credentials of the given
+// configuration
+func loadAssumeRoleClient(cfg aws.Config) stscreds.AssumeRoleAPIClient {
+	return sts.NewFromConfig(cfg)
+}
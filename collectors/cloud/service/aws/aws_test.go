@@ -19,12 +19,17 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
+	"confirmate.io/collectors/cloud/internal/quota"
 	"confirmate.io/core/util/assert"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
 	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/aws/aws-sdk-go-v2/service/sts/types"
 	"github.com/aws/smithy-go"
+	"github.com/google/uuid"
 )
 
 const mockRegion = "mockRegion"
@@ -84,6 +89,86 @@ func TestNewClient(t *testing.T) {
 
 }
 
+// TestNewClient_assumeRoleChain tests that NewClient assumes the roles of the chain one after another
+func TestNewClient_assumeRoleChain(t *testing.T) {
+	var clients int
+
+	oldLoadDefaultConfig := loadDefaultConfig
+	defer func() { loadDefaultConfig = oldLoadDefaultConfig }()
+	oldNewFromConfigSTS := newFromConfigSTS
+	defer func() { newFromConfigSTS = oldNewFromConfigSTS }()
+	oldNewAssumeRoleClient := newAssumeRoleClient
+	defer func() { newAssumeRoleClient = oldNewAssumeRoleClient }()
+
+	loadDefaultConfig = func(ctx context.Context,
+		opt ...func(options *config.LoadOptions) error) (cfg aws.Config, err error) {
+		return aws.Config{Region: mockRegion}, nil
+	}
+	newFromConfigSTS = func(cfg aws.Config) STSAPI {
+		return mockSTSClient{}
+	}
+	newAssumeRoleClient = func(cfg aws.Config) stscreds.AssumeRoleAPIClient {
+		clients++
+		return mockAssumeRoleClient{}
+	}
+
+	client, err := NewClient(WithAssumeRoleChain(
+		"arn:aws:iam::111111111111:role/hub",
+		"arn:aws:iam::222222222222:role/collector",
+	))
+	assert.NoError(t, err)
+	assert.Equal(t, 2, clients)
+
+	// The credentials of the client are the ones of the last role of the chain
+	creds, err := client.cfg.Credentials.Retrieve(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, "arn:aws:iam::222222222222:role/collector", creds.AccessKeyID)
+}
+
+func TestClient_collectorID(t *testing.T) {
+	const toeID = "00000000-0000-0000-0000-000000000000"
+
+	var (
+		single   = &Client{accountID: aws.String("111111111111")}
+		account1 = &Client{accountID: aws.String("111111111111"), roles: []string{"arn:aws:iam::111111111111:role/collector"}}
+		account2 = &Client{accountID: aws.String("222222222222"), roles: []string{"arn:aws:iam::222222222222:role/collector"}}
+	)
+
+	// Without assumed roles, the ID only depends on the kind of collector and the target of evaluation
+	assert.Equal(t, uuid.NewSHA1(uuid.NameSpaceOID, []byte("aws-compute::"+toeID)).String(), single.collectorID("aws-compute", toeID))
+	assert.NotEqual(t, account1.collectorID("aws-compute", toeID), account2.collectorID("aws-compute", toeID))
+}
+
+func TestClient_guardedConfig(t *testing.T) {
+	tests := []struct {
+		name      string
+		client    *Client
+		wantGuard bool
+	}{
+		{
+			name:   "without quota configuration",
+			client: &Client{cfg: aws.Config{Region: mockRegion}},
+		},
+		{
+			name:      "with quota configuration",
+			client:    &Client{cfg: aws.Config{Region: mockRegion}, quota: &quota.DefaultConfig},
+			wantGuard: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, guard := tt.client.guardedConfig("test")
+
+			assert.Equal(t, mockRegion, cfg.Region)
+			assert.Equal(t, tt.wantGuard, guard != nil)
+			assert.Equal(t, tt.wantGuard, cfg.HTTPClient != nil)
+
+			// The configuration of the client itself must not be modified
+			assert.Nil(t, tt.client.cfg.HTTPClient)
+		})
+	}
+}
+
 type mockSTSClient struct{}
 
 func (mockSTSClient) GetCallerIdentity(_ context.Context,
@@ -103,3 +188,17 @@ func (mockSTSClientWithAPIError) GetCallerIdentity(_ context.Context,
 		Err:           errors.New("MaxAttemptsError"),
 	}
 }
+
+type mockAssumeRoleClient struct{}
+
+func (mockAssumeRoleClient) AssumeRole(_ context.Context,
+	params *sts.AssumeRoleInput, _ ...func(*sts.Options)) (*sts.AssumeRoleOutput, error) {
+	return &sts.AssumeRoleOutput{
+		Credentials: &types.Credentials{
+			AccessKeyId:     params.RoleArn,
+			SecretAccessKey: aws.String("secret"),
+			SessionToken:    aws.String("token"),
+			Expiration:      aws.Time(time.Now().Add(time.Hour)),
+		},
+	}, nil
+}
@@ -21,6 +21,7 @@ import (
 	"log/slog"
 
 	collector "confirmate.io/collectors/cloud/internal/collector"
+	"confirmate.io/collectors/cloud/internal/quota"
 	"confirmate.io/core/api/ontology"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
@@ -29,7 +30,6 @@ import (
 	typesEC2 "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/aws/aws-sdk-go-v2/service/lambda"
 	typesLambda "github.com/aws/aws-sdk-go-v2/service/lambda/types"
-	"github.com/google/uuid"
 )
 
 // computeCollector handles the AWS API requests regarding the computing services (EC2 and Lambda)
@@ -40,6 +40,7 @@ type computeCollector struct {
 	awsConfig         *Client
 	ctID              string
 	id                string
+	guard             *quota.Guard
 }
 
 // EC2API describes the EC2 api interface which is implemented by the official AWS client and mock clients in tests
@@ -71,15 +72,16 @@ var newFromConfigLambda = lambda.NewFromConfig
 
 // NewAwsComputeCollector constructs a new awsS3Collector initializing the s3-virtualMachineAPI and isCollecting with true
 func NewAwsComputeCollector(client *Client, TargetOfEvaluationID string) collector.Collector {
-	seed := "aws-compute::" + TargetOfEvaluationID
+	cfg, guard := client.guardedConfig("aws-compute")
 
 	return &computeCollector{
-		virtualMachineAPI: newFromConfigEC2(client.cfg),
-		functionAPI:       newFromConfigLambda(client.cfg),
+		virtualMachineAPI: newFromConfigEC2(cfg),
+		functionAPI:       newFromConfigLambda(cfg),
 		isCollecting:      true,
 		awsConfig:         client,
 		ctID:              TargetOfEvaluationID,
-		id:                uuid.NewSHA1(uuid.NameSpaceOID, []byte(seed)).String(),
+		id:                client.collectorID("aws-compute", TargetOfEvaluationID),
+		guard:             guard,
 	}
 }
 
@@ -143,6 +145,11 @@ func (d *computeCollector) TargetOfEvaluationID() string {
 	return d.ctID
 }
 
+// QuotaGuard implements [quota.Guarded].
+func (d *computeCollector) QuotaGuard() *quota.Guard {
+	return d.guard
+}
+
 // collectVolumes collects all volumes (in the current region)
 func (d *computeCollector) collectVolumes() ([]*ontology.BlockStorage, error) {
 	res, err := d.virtualMachineAPI.DescribeVolumes(context.TODO(), &ec2.DescribeVolumesInput{})
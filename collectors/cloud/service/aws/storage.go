@@ -24,6 +24,7 @@ import (
 	"time"
 
 	collector "confirmate.io/collectors/cloud/internal/collector"
+	"confirmate.io/collectors/cloud/internal/quota"
 	"confirmate.io/core/api/ontology"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
@@ -31,7 +32,6 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/aws/aws-sdk-go-v2/service/s3/types"
 	"github.com/aws/smithy-go"
-	"github.com/google/uuid"
 )
 
 // awsS3Collector handles the AWS API requests regarding the S3 service
@@ -41,6 +41,7 @@ type awsS3Collector struct {
 	awsConfig    *Client
 	ctID         string
 	id           string
+	guard        *quota.Guard
 }
 
 // bucket contains metadata about a S3 bucket
@@ -196,20 +197,26 @@ func (d *awsS3Collector) TargetOfEvaluationID() string {
 	return d.ctID
 }
 
+// QuotaGuard implements [quota.Guarded].
+func (d *awsS3Collector) QuotaGuard() *quota.Guard {
+	return d.guard
+}
+
 func (b *bucket) String() string {
 	return fmt.Sprintf("[ARN: %v, Name: %v, Creation Time: %v]", b.arn, b.name, b.creationTime)
 }
 
 // NewAwsStorageCollector constructs a new awsS3Collector initializing the s3-api and isCollecting with true
 func NewAwsStorageCollector(client *Client, TargetOfEvaluationID string) collector.Collector {
-	seed := "aws-storage::" + TargetOfEvaluationID
+	cfg, guard := client.guardedConfig("aws-storage")
 
 	return &awsS3Collector{
-		storageAPI:   s3.NewFromConfig(client.cfg),
+		storageAPI:   s3.NewFromConfig(cfg),
 		isCollecting: true,
 		awsConfig:    client,
 		ctID:         TargetOfEvaluationID,
-		id:           uuid.NewSHA1(uuid.NameSpaceOID, []byte(seed)).String(),
+		id:           client.collectorID("aws-storage", TargetOfEvaluationID),
+		guard:        guard,
 	}
 }
 
@@ -335,7 +342,7 @@ func (d *awsS3Collector) getTransportEncryption(bucket string) (*ontology.Transp
 	var policy BucketPolicy
 	err = json.Unmarshal([]byte(aws.ToString(resp.Policy)), &policy)
 	if err != nil {
-		return nil, resp, fmt.Errorf("error occurred while unmarshalling the bucket policy: %v", err)
+		return nil, resp, fmt.Errorf("error occurred while unmarshalling the bucket policy: %w", err)
 	}
 	// one statement has set https only -> default encryption is set
 	for _, statement := range policy.Statement {
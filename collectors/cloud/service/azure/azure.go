@@ -20,12 +20,15 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"net/http"
+	"slices"
 	"time"
 
 	collector "confirmate.io/collectors/cloud/internal/collector"
 	"confirmate.io/collectors/cloud/internal/config"
 	"confirmate.io/collectors/cloud/internal/logconfig"
 	"confirmate.io/collectors/cloud/internal/pointer"
+	"confirmate.io/collectors/cloud/internal/quota"
 	"confirmate.io/core/api/ontology"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
@@ -110,6 +113,22 @@ func WithResourceGroup(rg string) CollectorOption {
 	}
 }
 
+// WithSubscription is a [CollectorOption] that collects the resources of the subscription with the given ID. Otherwise,
+// the first subscription accessible with the credentials is used.
+func WithSubscription(subscriptionID string) CollectorOption {
+	return func(d *azureCollector) {
+		d.subID = &subscriptionID
+	}
+}
+
+// WithQuotaConfig is a [CollectorOption] that guards the API calls of the collector with a [quota.Guard] using the
+// given configuration.
+func WithQuotaConfig(cfg quota.Config) CollectorOption {
+	return func(d *azureCollector) {
+		d.guard = quota.NewGuard("azure", cfg)
+	}
+}
+
 func init() {
 	log = logconfig.GetLogger().With("component", "azure-collector")
 }
@@ -119,6 +138,9 @@ type azureCollector struct {
 
 	sub  *armsubscription.Subscription
 	cred azcore.TokenCredential
+	// subID optionally contains the ID of the subscription to collect. If this is nil, the first subscription accessible
+	// with the credentials is collected.
+	subID *string
 	// rg optionally contains the name of a resource group. If this is not nil, all collector calls will be scoped to the particular resource group.
 	rg                 *string
 	clientOptions      arm.ClientOptions
@@ -127,6 +149,9 @@ type azureCollector struct {
 	id                 string
 	backupMap          map[string]*backup
 	defenderProperties map[string]*defenderProperties
+
+	// guard optionally guards the API calls against the rate limits of Azure.
+	guard *quota.Guard
 }
 
 type defenderProperties struct {
@@ -195,7 +220,23 @@ func NewAzureCollector(opts ...CollectorOption) collector.Collector {
 		opt(d)
 	}
 
+	// Route all API calls through the guard. Throttled calls are retried by the guard, so the retry policy of the SDK
+	// only needs to take care of the remaining transient errors.
+	if d.guard != nil {
+		d.clientOptions.Transport = d.guard.Doer(d.clientOptions.Transport)
+		d.clientOptions.Retry.StatusCodes = []int{
+			http.StatusRequestTimeout,
+			http.StatusInternalServerError,
+			http.StatusBadGateway,
+			http.StatusGatewayTimeout,
+		}
+	}
+
+	// Several subscriptions can be collected for the same target of evaluation
 	seed := "azure::" + d.ctID
+	if d.subID != nil {
+		seed += "::" + *d.subID
+	}
 	d.id = uuid.NewSHA1(uuid.NameSpaceOID, []byte(seed)).String()
 
 	return d
@@ -328,6 +369,11 @@ func (d *azureCollector) TargetOfEvaluationID() string {
 	return d.ctID
 }
 
+// QuotaGuard implements [quota.Guarded].
+func (d *azureCollector) QuotaGuard() *quota.Guard {
+	return d.guard
+}
+
 func (d *azureCollector) authorize() (err error) {
 	if d.isAuthorized {
 		return
@@ -363,8 +409,18 @@ func (d *azureCollector) authorize() (err error) {
 		return
 	}
 
-	// get first subscription
+	// get the configured or the first subscription
 	d.sub = subList[0]
+	if d.subID != nil {
+		i := slices.IndexFunc(subList, func(sub *armsubscription.Subscription) bool {
+			return pointer.Deref(sub.SubscriptionID) == *d.subID
+		})
+		if i == -1 {
+			return fmt.Errorf("%w: %s", ErrSubscriptionNotFound, *d.subID)
+		}
+
+		d.sub = subList[i]
+	}
 
 	log.Info("Azure collector uses subscription", "subscriptionID", pointer.Deref(d.sub.SubscriptionID))
 
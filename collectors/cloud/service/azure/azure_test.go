@@ -28,6 +28,7 @@ import (
 
 	collector "confirmate.io/collectors/cloud/internal/collector"
 	"confirmate.io/collectors/cloud/internal/config"
+	"confirmate.io/collectors/cloud/internal/quota"
 	"confirmate.io/collectors/cloud/internal/testdata"
 	"confirmate.io/core/api/ontology"
 	"confirmate.io/core/util/assert"
@@ -1239,6 +1240,18 @@ func TestNewAzureCollector(t *testing.T) {
 				defenderProperties: make(map[string]*defenderProperties),
 			},
 		},
+		{
+			name: "Happy path: with subscription",
+			args: args{
+				opts: []CollectorOption{WithSubscription("00000000-0000-0000-0000-000000000000")},
+			},
+			want: &azureCollector{
+				subID:              new("00000000-0000-0000-0000-000000000000"),
+				ctID:               config.DefaultTargetOfEvaluationID,
+				backupMap:          make(map[string]*backup),
+				defenderProperties: make(map[string]*defenderProperties),
+			},
+		},
 		{
 			name: "Happy path: with sender",
 			args: args{
@@ -1286,6 +1299,7 @@ func TestNewAzureCollector(t *testing.T) {
 
 			assert.Equal(t, expected.ctID, collector.ctID)
 			assert.Equal(t, expected.rg, collector.rg)
+			assert.Equal(t, expected.subID, collector.subID)
 			assert.Equal(t, expected.cred, collector.cred)
 			assert.Equal(t, expected.clientOptions, collector.clientOptions, assert.CompareAllUnexported())
 			assert.NotNil(t, collector.backupMap)
@@ -1308,6 +1322,12 @@ func Test_azureCollector_ID(t *testing.T) {
 	expectedID := uuid.NewSHA1(uuid.NameSpaceOID, []byte(seed)).String()
 
 	assert.Equal(t, expectedID, collector.ID())
+
+	// Collectors of different subscriptions of the same target of evaluation have different IDs
+	sub1 := NewAzureCollector(WithTargetOfEvaluationID(testdata.MockTargetOfEvaluationID1), WithSubscription("sub1"))
+	sub2 := NewAzureCollector(WithTargetOfEvaluationID(testdata.MockTargetOfEvaluationID1), WithSubscription("sub2"))
+	assert.NotEqual(t, sub1.ID(), sub2.ID())
+	assert.NotEqual(t, expectedID, sub1.ID())
 }
 
 func Test_azureCollector_List(t *testing.T) {
@@ -1414,6 +1434,7 @@ func Test_azureCollector_authorize(t *testing.T) {
 	type fields struct {
 		isAuthorized  bool
 		sub           *armsubscription.Subscription
+		subID         *string
 		cred          azcore.TokenCredential
 		clientOptions arm.ClientOptions
 	}
@@ -1465,12 +1486,45 @@ func Test_azureCollector_authorize(t *testing.T) {
 				return assert.NoError(t, err)
 			},
 		},
+		{
+			name: "With configured subscription",
+			fields: fields{
+				isAuthorized: false,
+				subID:        new("00000000-0000-0000-0000-000000000000"),
+				cred:         &mockAuthorizer{},
+				clientOptions: arm.ClientOptions{
+					ClientOptions: policy.ClientOptions{
+						Transport: mockSender{},
+					},
+				},
+			},
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.NoError(t, err)
+			},
+		},
+		{
+			name: "Configured subscription not found",
+			fields: fields{
+				isAuthorized: false,
+				subID:        new("11111111-1111-1111-1111-111111111111"),
+				cred:         &mockAuthorizer{},
+				clientOptions: arm.ClientOptions{
+					ClientOptions: policy.ClientOptions{
+						Transport: mockSender{},
+					},
+				},
+			},
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.ErrorIs(t, err, ErrSubscriptionNotFound)
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			a := &azureCollector{
 				isAuthorized:  tt.fields.isAuthorized,
 				sub:           tt.fields.sub,
+				subID:         tt.fields.subID,
 				cred:          tt.fields.cred,
 				clientOptions: tt.fields.clientOptions,
 			}
@@ -1479,6 +1533,59 @@ func Test_azureCollector_authorize(t *testing.T) {
 	}
 }
 
+// throttlingSender throttles the first request and forwards all further requests to [mockSender].
+type throttlingSender struct {
+	count int
+}
+
+func (s *throttlingSender) Do(req *http.Request) (res *http.Response, err error) {
+	s.count++
+	if s.count == 1 {
+		return createResponse(req, map[string]interface{}{}, http.StatusTooManyRequests)
+	}
+
+	return mockSender{}.Do(req)
+}
+
+func Test_azureCollector_authorize_withQuotaGuard(t *testing.T) {
+	tests := []struct {
+		name      string
+		cfg       quota.Config
+		wantStats quota.Stats
+		wantErr   assert.WantErr
+	}{
+		{
+			name:      "happy path: throttled call is retried",
+			cfg:       quota.Config{MaxRetries: 1, InitialBackoff: time.Millisecond},
+			wantStats: quota.Stats{Calls: 2, Throttled: 1, Retries: 1},
+			wantErr:   assert.NoError,
+		},
+		{
+			name:      "budget exceeded",
+			cfg:       quota.Config{MaxCallsPerRun: 1, MaxRetries: 1, InitialBackoff: time.Millisecond},
+			wantStats: quota.Stats{Calls: 1, Throttled: 1, Rejected: 1},
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.ErrorIs(t, err, quota.ErrBudgetExceeded)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := NewAzureCollector(
+				WithSender(&throttlingSender{}),
+				WithAuthorizer(&mockAuthorizer{}),
+				WithQuotaConfig(tt.cfg),
+			).(*azureCollector)
+
+			tt.wantErr(t, d.authorize())
+
+			stats := d.QuotaGuard().Stats()
+			stats.Waited = 0
+			assert.Equal(t, tt.wantStats, stats)
+		})
+	}
+}
+
 func TestGetResourceGroupName(t *testing.T) {
 	accountId := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/res1/providers/Microsoft.Storage/storageAccounts/account3"
 	result := resourceGroupName(accountId)
@@ -65,7 +65,7 @@ func (d *azureCollector) collectBackupVaults() error {
 		func(vault *armdataprotection.BackupVaultResource) error {
 			instances, err := d.collectBackupInstances(resourceGroupName(pointer.Deref(vault.ID)), pointer.Deref(vault.Name))
 			if err != nil {
-				err := fmt.Errorf("could not collect backup instances: %v", err)
+				err := fmt.Errorf("could not collect backup instances: %w", err)
 				return err
 			}
 
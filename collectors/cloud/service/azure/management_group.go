@@ -0,0 +1,106 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package azure
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
+)
+
+const (
+	// ManagementGroupPrefix is the prefix that denotes a management group instead of a single subscription in the
+	// accounts of the collector, e.g., "mg:production".
+	ManagementGroupPrefix = "mg:"
+
+	// managementGroupsAPIVersion is the version of the management groups API used to list the descendants of a
+	// management group.
+	managementGroupsAPIVersion = "2020-05-01"
+)
+
+// managementGroupDescendants is a page of the descendants of a management group.
+type managementGroupDescendants struct {
+	Value []struct {
+		// Name is the ID of the subscription or the name of the management group.
+		Name string `json:"name"`
+
+		// Type is either "/subscriptions" or "Microsoft.Management/managementGroups".
+		Type string `json:"type"`
+	} `json:"value"`
+	NextLink string `json:"nextLink"`
+}
+
+// ManagementGroupSubscriptions returns the IDs of all subscriptions below the management group with the given name,
+// including the ones of nested management groups. This allows a single collector to collect all subscriptions of a
+// management group, each as a separate collector created with [WithSubscription].
+func ManagementGroupSubscriptions(cred azcore.TokenCredential, group string, options *arm.ClientOptions) (subscriptionIDs []string, err error) {
+	var (
+		client *arm.Client
+		next   string
+	)
+
+	client, err = arm.NewClient("confirmate-collector", "v1.0.0", cred, options)
+	if err != nil {
+		return nil, fmt.Errorf("could not create management groups client: %w", err)
+	}
+
+	next = fmt.Sprintf("%s/providers/Microsoft.Management/managementGroups/%s/descendants?api-version=%s",
+		strings.TrimSuffix(client.Endpoint(), "/"), url.PathEscape(group), managementGroupsAPIVersion)
+	for next != "" {
+		var page managementGroupDescendants
+
+		page, err = listManagementGroupDescendants(client, next)
+		if err != nil {
+			return nil, fmt.Errorf("could not list descendants of management group %s: %w", group, err)
+		}
+
+		for _, item := range page.Value {
+			if strings.HasSuffix(item.Type, "/subscriptions") {
+				subscriptionIDs = append(subscriptionIDs, item.Name)
+			}
+		}
+
+		next = page.NextLink
+	}
+
+	return subscriptionIDs, nil
+}
+
+// listManagementGroupDescendants retrieves a single page of the descendants of a management group.
+func listManagementGroupDescendants(client *arm.Client, link string) (page managementGroupDescendants, err error) {
+	req, err := runtime.NewRequest(context.Background(), http.MethodGet, link)
+	if err != nil {
+		return page, err
+	}
+
+	resp, err := client.Pipeline().Do(req)
+	if err != nil {
+		return page, err
+	}
+
+	if !runtime.HasStatusCode(resp, http.StatusOK) {
+		return page, runtime.NewResponseError(resp)
+	}
+
+	err = runtime.UnmarshalAsJSON(resp, &page)
+	return page, err
+}
@@ -0,0 +1,94 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package azure
+
+import (
+	"net/http"
+	"testing"
+
+	"confirmate.io/core/util/assert"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+)
+
+// managementGroupSender returns the descendants of the management group "mg1" in two pages.
+type managementGroupSender struct{}
+
+func (managementGroupSender) Do(req *http.Request) (res *http.Response, err error) {
+	if req.URL.Path != "/providers/Microsoft.Management/managementGroups/mg1/descendants" {
+		return createResponse(req, map[string]interface{}{}, http.StatusNotFound)
+	}
+
+	if req.URL.Query().Get("page") == "" {
+		return createResponse(req, map[string]interface{}{
+			"value": []map[string]interface{}{
+				{"name": "00000000-0000-0000-0000-000000000001", "type": "/subscriptions"},
+				{"name": "mg2", "type": "Microsoft.Management/managementGroups"},
+			},
+			"nextLink": "https://management.azure.com/providers/Microsoft.Management/managementGroups/mg1/descendants?api-version=2020-05-01&page=2",
+		}, http.StatusOK)
+	}
+
+	return createResponse(req, map[string]interface{}{
+		"value": []map[string]interface{}{
+			{"name": "00000000-0000-0000-0000-000000000002", "type": "/subscriptions"},
+		},
+	}, http.StatusOK)
+}
+
+func TestManagementGroupSubscriptions(t *testing.T) {
+	type args struct {
+		group string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    []string
+		wantErr assert.WantErr
+	}{
+		{
+			name: "happy path",
+			args: args{
+				group: "mg1",
+			},
+			want:    []string{"00000000-0000-0000-0000-000000000001", "00000000-0000-0000-0000-000000000002"},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "management group not found",
+			args: args{
+				group: "unknown",
+			},
+			want: nil,
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.ErrorContains(t, err, "could not list descendants of management group unknown")
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ManagementGroupSubscriptions(&mockAuthorizer{}, tt.args.group, &arm.ClientOptions{
+				ClientOptions: policy.ClientOptions{
+					Transport: managementGroupSender{},
+				},
+			})
+
+			assert.Equal(t, tt.want, got)
+			tt.wantErr(t, err)
+		})
+	}
+}
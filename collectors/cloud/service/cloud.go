@@ -16,6 +16,7 @@
 package cloud
 
 import (
+	"cmp"
 	"context"
 	"errors"
 	"fmt"
@@ -23,20 +24,25 @@ import (
 	"log/slog"
 	"net/http"
 	"slices"
+	"strings"
 	"sync"
 	"time"
 
 	collector "confirmate.io/collectors/cloud/internal/collector"
 	"confirmate.io/collectors/cloud/internal/config"
 	"confirmate.io/collectors/cloud/internal/logconfig"
+	"confirmate.io/collectors/cloud/internal/quota"
 	"confirmate.io/collectors/cloud/service/aws"
 	"confirmate.io/collectors/cloud/service/azure"
 	"confirmate.io/collectors/cloud/service/extra/csaf"
+	"confirmate.io/collectors/cloud/service/extra/sbom"
+	"confirmate.io/collectors/cloud/service/extra/terraform"
 	"confirmate.io/collectors/cloud/service/k8s"
 	"confirmate.io/collectors/cloud/service/openstack"
 	"confirmate.io/core/api/evidence"
 	"confirmate.io/core/api/evidence/evidenceconnect"
 	"confirmate.io/core/api/ontology"
+	"confirmate.io/core/api/orchestrator/orchestratorconnect"
 	"confirmate.io/core/service"
 	"confirmate.io/core/service/collection"
 	"connectrpc.com/connect"
@@ -54,6 +60,8 @@ const (
 	ProviderAzure     = "azure"
 	ProviderOpenstack = "openstack"
 	ProviderCSAF      = "csaf"
+	ProviderSBOM      = "sbom"
+	ProviderTerraform = "terraform"
 
 	// CloudCollectorStart is emitted at the start of a collector run.
 	CloudCollectorStart CollectorEventType = iota
@@ -86,14 +94,48 @@ type CloudCollectorConfig struct {
 	// collectorInterval is the interval at which collector runs are scheduled.
 	collectorInterval time.Duration
 
+	// environment is the environment (e.g., prod or staging) of the collected resources, which is attached to their
+	// evidences.
+	environment string
+
 	//evStreamConfig holds the configuration for the evidence store stream.
 	evStreamConfig EvidenceStoreStreamConfig
+
+	// quota holds the configuration of the guards against the rate limits and quotas of the provider APIs.
+	quota quota.Config
+
+	// file holds the configuration file of the collector. If set, its collectors replace the ones configured by the
+	// provider, target of evaluation, interval and filter options.
+	file *config.File
+}
+
+// providerConfig holds the configuration of the collectors of a single provider, either given by the options and
+// flags of the collector or by a collector in the configuration file.
+type providerConfig struct {
+	provider             string
+	targetOfEvaluationID string
+	interval             time.Duration
+	environment          string
+	resourceGroup        string
+	csafDomain           string
+	sbomURLs             []string
+	terraformSources     []string
+	accounts             []account
+}
+
+// collectorJob is a collector together with the interval at which its runs are scheduled and the environment of its
+// resources.
+type collectorJob struct {
+	collector   collector.Collector
+	interval    time.Duration
+	environment string
 }
 
 // EvidenceStoreStreamConfig holds the configuration for the evidence store stream.
 type EvidenceStoreStreamConfig struct {
 	targetAddress string
 	client        *http.Client
+	transport     service.TransportConfig
 }
 
 // CollectorEventType defines the event types for [CollectorEvent].
@@ -107,6 +149,10 @@ type CollectorEvent struct {
 	CollectorName  string
 	CollectedItems int
 	Time           time.Time
+
+	// Quota contains the throttling metrics of the collector run, if the API calls of the collector are guarded by a
+	// [quota.Guard]. It is only set for [CloudCollectorFinished].
+	Quota *quota.Stats
 }
 
 // Service is an implementation of the Clouditor Collector service (plus its experimental extensions). It should not be
@@ -118,6 +164,10 @@ type Service struct {
 	// evidenceStoreStream is the stream used to send evidences to the evidence store service.
 	evidenceStoreStream *connect.BidiStreamForClient[evidence.StoreEvidenceRequest, evidence.StoreEvidencesResponse]
 
+	// orchestratorClient holds the client to register the resource inventories of the collector runs with the
+	// orchestrator. If nil, no inventories are registered.
+	orchestratorClient orchestratorconnect.OrchestratorClient
+
 	// dead indicates whether the stream is dead.
 	dead bool
 
@@ -130,11 +180,22 @@ type Service struct {
 	// collectors is the list of collectors to use for collecting resources.
 	collectors []collector.Collector
 
+	// additionalCollectors are the collectors added by [WithAdditionalCollectors]. They are kept when the
+	// configuration is reloaded.
+	additionalCollectors []collector.Collector
+
+	// configMu synchronizes starting the collector with reloading its configuration.
+	configMu sync.Mutex
+
 	// Events is a channel that emits collector events.
 	Events chan *CollectorEvent
 
 	// cloudConfig holds the configuration for the cloud collector.
 	cloudConfig CloudCollectorConfig
+
+	// diff remembers the resources whose evidences were sent, if evidence diffing is enabled (see
+	// [WithEvidenceDiffing]). If nil, the evidences of all resources are sent in every run.
+	diff *evidenceDiff
 }
 
 func init() {
@@ -152,6 +213,25 @@ func WithEvidenceStoreAddress(target string, client *http.Client) service.Option
 	}
 }
 
+// WithEvidenceStoreTransport is an option to configure the message size limits and the compression of the evidence
+// store stream.
+func WithEvidenceStoreTransport(transport service.TransportConfig) service.Option[Service] {
+	return func(s *Service) {
+		s.cloudConfig.evStreamConfig.transport = transport
+	}
+}
+
+// WithOrchestratorAddress is an option to configure the address of the orchestrator service. If set, the resource
+// inventory of each collector run (the number of resources per type, their regions and accounts) is registered with
+// the orchestrator, so that it can be compared with the assessed resources.
+func WithOrchestratorAddress(target string, client *http.Client) service.Option[Service] {
+	return func(s *Service) {
+		log.Info("Orchestrator URL is set", slog.String("target", target))
+
+		s.orchestratorClient = orchestratorconnect.NewOrchestratorClient(client, target)
+	}
+}
+
 // WithTargetOfEvaluationID is an option to configure the target of evaluation ID for which resources will be collected.
 func WithTargetOfEvaluationID(ID string) service.Option[Service] {
 	return func(svc *Service) {
@@ -177,11 +257,43 @@ func WithProvider(provider string) service.Option[Service] {
 	}
 }
 
+// WithEnvironment is an option to configure the environment (e.g., prod or staging) of the collected resources. It is
+// attached to their evidences, so that audit scopes can be restricted to an environment.
+func WithEnvironment(environment string) service.Option[Service] {
+	return func(svc *Service) {
+		log.Info("Environment is set", "environment", environment)
+
+		svc.cloudConfig.environment = environment
+	}
+}
+
 // WithAdditionalCollectors is an option to add additional collectors for collecting. Note: These are added in
 // addition to the one created by [WithProvider].
 func WithAdditionalCollectors(collectors []collector.Collector) service.Option[Service] {
 	return func(s *Service) {
 		s.collectors = append(s.collectors, collectors...)
+		s.additionalCollectors = append(s.additionalCollectors, collectors...)
+	}
+}
+
+// WithConfigFile is an option to configure the collectors by a configuration file (see [config.LoadFile]). Its
+// collectors replace the ones configured by [WithProvider] and the filter flags. The file can be reloaded later on
+// with [Service.Reload].
+func WithConfigFile(file *config.File) service.Option[Service] {
+	return func(svc *Service) {
+		log.Info("Configuration file is set", "collectors", len(file.Collectors))
+
+		svc.cloudConfig.file = file
+	}
+}
+
+// WithEvidenceDiffing is an option to only send the evidences of resources whose state changed since their previously
+// sent evidence, with a periodic full refresh, see [DiffConfig].
+func WithEvidenceDiffing(cfg DiffConfig) service.Option[Service] {
+	return func(svc *Service) {
+		log.Info("Evidence diffing is enabled", "ignoreFields", cfg.IgnoreFields, "fullRefresh", cfg.FullRefresh)
+
+		svc.diff = newEvidenceDiff(cfg)
 	}
 }
 
@@ -192,6 +304,14 @@ func WithCollectorInterval(interval time.Duration) service.Option[Service] {
 	}
 }
 
+// WithQuotaConfig is an option to configure the rate limiting, the budget of API calls per run and the retries of
+// throttled API calls of the provider collectors. If not set, [quota.DefaultConfig] is used.
+func WithQuotaConfig(cfg quota.Config) service.Option[Service] {
+	return func(svc *Service) {
+		svc.cloudConfig.quota = cfg
+	}
+}
+
 func NewService(opts ...service.Option[Service]) *Service {
 	var s *Service
 
@@ -216,8 +336,10 @@ func newService(opts ...service.Option[Service]) *Service {
 			evStreamConfig: EvidenceStoreStreamConfig{
 				targetAddress: DefaultEvidenceStoreURL,
 				client:        service.DefaultHTTPClient,
+				transport:     service.DefaultTransportConfig,
 			},
 			collectorInterval: 5 * time.Minute, // Default collector interval is 5 minutes
+			quota:             quota.DefaultConfig,
 		},
 	}
 
@@ -264,26 +386,160 @@ func (svc *Service) Init(ctx context.Context, cmd *cli.Command) {
 	}
 }
 
-func (svc *Service) Shutdown() {
+// Shutdown closes the stream to the evidence store and stops the scheduled collectors, waiting until the running ones
+// returned or ctx is done.
+func (svc *Service) Shutdown(ctx context.Context) (err error) {
+	var done = make(chan struct{})
+
 	svc.evidenceStoreStream.CloseRequest()
-	svc.scheduler.Stop()
+
+	go func() {
+		svc.scheduler.Stop()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("could not stop collectors: %w", ctx.Err())
+	}
 }
 
+// buildCollectors creates the configured collectors, i.e., the additional collectors and the collectors of the
+// configured providers.
 func (svc *Service) buildCollectors(cmd *cli.Command) (collectors []collector.Collector, err error) {
+	var jobs []collectorJob
+
+	jobs, err = svc.buildJobs(cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, job := range jobs {
+		collectors = append(collectors, job.collector)
+	}
+
+	return collectors, nil
+}
+
+// buildJobs creates the configured collectors together with the intervals at which they are scheduled.
+func (svc *Service) buildJobs(cmd *cli.Command) (jobs []collectorJob, err error) {
 	var (
-		provider      string
+		configs    []providerConfig
+		collectors []collector.Collector
+	)
+
+	for _, c := range svc.additionalCollectors {
+		jobs = append(jobs, collectorJob{
+			collector:   c,
+			interval:    svc.cloudConfig.collectorInterval,
+			environment: svc.cloudConfig.environment,
+		})
+	}
+
+	configs, err = svc.providerConfigs(cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, p := range configs {
+		collectors, err = svc.buildProviderCollectors(p)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, c := range collectors {
+			jobs = append(jobs, collectorJob{collector: c, interval: p.interval, environment: p.environment})
+		}
+	}
+
+	return jobs, nil
+}
+
+// providerConfigs returns the configurations of the providers to collect. These are the collectors of the
+// configuration file, if set, and otherwise the provider given by [WithProvider] together with the filter flags of the
+// command.
+func (svc *Service) providerConfigs(cmd *cli.Command) (configs []providerConfig, err error) {
+	var accounts []account
+
+	if svc.cloudConfig.file != nil {
+		return svc.fileProviderConfigs(svc.cloudConfig.file), nil
+	}
+
+	if svc.cloudConfig.provider == "" {
+		return nil, nil
+	}
+
+	accounts, err = parseAccounts(cmd.StringSlice("collector-account"), svc.cloudConfig.targetOfEvaluationID)
+	if err != nil {
+		log.Error("invalid account", tint.Err(err))
+		return nil, err
+	}
+
+	return []providerConfig{{
+		provider:             svc.cloudConfig.provider,
+		targetOfEvaluationID: svc.cloudConfig.targetOfEvaluationID,
+		interval:             svc.cloudConfig.collectorInterval,
+		environment:          svc.cloudConfig.environment,
+		resourceGroup:        cmd.String("collector-resource-group"),
+		csafDomain:           cmd.String("collector-csaf-domain"),
+		sbomURLs:             cmd.StringSlice("collector-sbom-url"),
+		terraformSources:     cmd.StringSlice("collector-terraform-source"),
+		accounts:             accounts,
+	}}, nil
+}
+
+// fileProviderConfigs returns the configurations of the collectors of the configuration file. Targets of evaluation,
+// intervals and environments that are not set in the file fall back to the ones of the collector service.
+func (svc *Service) fileProviderConfigs(file *config.File) (configs []providerConfig) {
+	for _, c := range file.Collectors {
+		var p = providerConfig{
+			provider:             c.Provider,
+			targetOfEvaluationID: cmp.Or(c.TargetOfEvaluationID, svc.cloudConfig.targetOfEvaluationID),
+			interval:             cmp.Or(c.Interval, svc.cloudConfig.collectorInterval),
+			environment:          cmp.Or(c.Environment, svc.cloudConfig.environment),
+			resourceGroup:        c.ResourceGroup,
+			csafDomain:           c.CSAFDomain,
+			sbomURLs:             c.SBOMURLs,
+			terraformSources:     c.TerraformSources,
+		}
+
+		for _, a := range c.Accounts {
+			p.accounts = append(p.accounts, account{
+				id:                   a.ID,
+				targetOfEvaluationID: cmp.Or(a.TargetOfEvaluationID, p.targetOfEvaluationID),
+			})
+		}
+
+		configs = append(configs, p)
+	}
+
+	return configs
+}
+
+// buildProviderCollectors creates the collectors of a single provider.
+func (svc *Service) buildProviderCollectors(p providerConfig) (collectors []collector.Collector, err error) {
+	var (
+		accounts      = p.accounts
 		optsAzure     = []azure.CollectorOption{}
 		optsOpenstack = []openstack.CollectorOption{}
 	)
 
-	collectors = append(collectors, svc.collectors...)
-	provider = svc.cloudConfig.provider
-	if provider == "" {
-		return collectors, nil
+	if len(accounts) > 0 && p.provider != ProviderAWS && p.provider != ProviderAzure {
+		err = fmt.Errorf("provider '%s' does not support multiple accounts", p.provider)
+		log.Error("accounts not supported", "provider", p.provider, "error", err)
+		return nil, err
+	}
+
+	// Without configured accounts, the account of the default credentials is collected for the target of evaluation
+	// of the collector
+	if len(accounts) == 0 {
+		accounts = []account{{targetOfEvaluationID: p.targetOfEvaluationID}}
 	}
 
 	switch {
-	case provider == ProviderAzure:
+	case p.provider == ProviderAzure:
 		authorizer, authErr := azure.NewAuthorizer()
 		if authErr != nil {
 			err = fmt.Errorf("%v: %v", ErrAzureAuth, authErr)
@@ -291,12 +547,34 @@ func (svc *Service) buildCollectors(cmd *cli.Command) (collectors []collector.Co
 			return nil, err
 		}
 
-		optsAzure = append(optsAzure, azure.WithAuthorizer(authorizer), azure.WithTargetOfEvaluationID(svc.cloudConfig.targetOfEvaluationID))
-		if rg := cmd.String("collector-resource-group"); rg != "" {
-			optsAzure = append(optsAzure, azure.WithResourceGroup(rg))
+		optsAzure = append(optsAzure,
+			azure.WithAuthorizer(authorizer),
+			azure.WithQuotaConfig(svc.cloudConfig.quota))
+		if p.resourceGroup != "" {
+			optsAzure = append(optsAzure, azure.WithResourceGroup(p.resourceGroup))
+		}
+
+		for _, a := range accounts {
+			var subscriptions = []string{a.id}
+
+			// A management group is collected as all of its subscriptions
+			if group, ok := strings.CutPrefix(a.id, azure.ManagementGroupPrefix); ok {
+				subscriptions, err = azure.ManagementGroupSubscriptions(authorizer, group, nil)
+				if err != nil {
+					log.Error("could not resolve management group", "managementGroup", group, tint.Err(err))
+					return nil, err
+				}
+			}
+
+			for _, subscription := range subscriptions {
+				opts := append(slices.Clone(optsAzure), azure.WithTargetOfEvaluationID(a.targetOfEvaluationID))
+				if subscription != "" {
+					opts = append(opts, azure.WithSubscription(subscription))
+				}
+				collectors = append(collectors, azure.NewAzureCollector(opts...))
+			}
 		}
-		collectors = append(collectors, azure.NewAzureCollector(optsAzure...))
-	case provider == ProviderK8S:
+	case p.provider == ProviderK8S:
 		k8sClient, authErr := k8s.AuthFromKubeConfig()
 		if authErr != nil {
 			err = fmt.Errorf("%v: %v", ErrK8sAuth, authErr)
@@ -304,20 +582,30 @@ func (svc *Service) buildCollectors(cmd *cli.Command) (collectors []collector.Co
 			return nil, err
 		}
 		collectors = append(collectors,
-			k8s.NewKubernetesComputeCollector(k8sClient, svc.cloudConfig.targetOfEvaluationID),
-			k8s.NewKubernetesNetworkCollector(k8sClient, svc.cloudConfig.targetOfEvaluationID),
-			k8s.NewKubernetesStorageCollector(k8sClient, svc.cloudConfig.targetOfEvaluationID))
-	case provider == ProviderAWS:
-		awsClient, authErr := aws.NewClient()
-		if authErr != nil {
-			err = fmt.Errorf("%v: %v", ErrAWSAuth, authErr)
-			log.Error("authorization error", tint.Err(err))
-			return nil, err
+			k8s.NewKubernetesComputeCollector(k8sClient, p.targetOfEvaluationID),
+			k8s.NewKubernetesNetworkCollector(k8sClient, p.targetOfEvaluationID),
+			k8s.NewKubernetesStorageCollector(k8sClient, p.targetOfEvaluationID),
+			k8s.NewKubernetesRBACCollector(k8sClient, p.targetOfEvaluationID))
+	case p.provider == ProviderAWS:
+		for _, a := range accounts {
+			var optsAWS = []aws.ClientOption{aws.WithQuotaConfig(svc.cloudConfig.quota)}
+
+			// An account is accessed by assuming the roles of its chain one after another
+			if a.id != "" {
+				optsAWS = append(optsAWS, aws.WithAssumeRoleChain(strings.Split(a.id, ">")...))
+			}
+
+			awsClient, authErr := aws.NewClient(optsAWS...)
+			if authErr != nil {
+				err = fmt.Errorf("%v: %v", ErrAWSAuth, authErr)
+				log.Error("authorization error", tint.Err(err))
+				return nil, err
+			}
+			collectors = append(collectors,
+				aws.NewAwsStorageCollector(awsClient, a.targetOfEvaluationID),
+				aws.NewAwsComputeCollector(awsClient, a.targetOfEvaluationID))
 		}
-		collectors = append(collectors,
-			aws.NewAwsStorageCollector(awsClient, svc.cloudConfig.targetOfEvaluationID),
-			aws.NewAwsComputeCollector(awsClient, svc.cloudConfig.targetOfEvaluationID))
-	case provider == ProviderOpenstack:
+	case p.provider == ProviderOpenstack:
 		authorizer, authErr := openstack.NewAuthorizer()
 		if authErr != nil {
 			err = fmt.Errorf("%v: %v", ErrOpenstackAuth, authErr)
@@ -325,22 +613,43 @@ func (svc *Service) buildCollectors(cmd *cli.Command) (collectors []collector.Co
 			return nil, err
 		}
 
-		optsOpenstack = append(optsOpenstack, openstack.WithAuthorizer(authorizer), openstack.WithTargetOfEvaluationID(svc.cloudConfig.targetOfEvaluationID))
+		optsOpenstack = append(optsOpenstack, openstack.WithAuthorizer(authorizer), openstack.WithTargetOfEvaluationID(p.targetOfEvaluationID))
 		collectors = append(collectors, openstack.NewOpenstackCollector(optsOpenstack...))
-	case provider == ProviderCSAF:
-		var (
-			domain string
-			opts   []csaf.CollectorOption
-		)
+	case p.provider == ProviderCSAF:
+		var opts = []csaf.CollectorOption{csaf.WithTargetOfEvaluationID(p.targetOfEvaluationID)}
 
-		domain = cmd.String("collector-csaf-domain")
-		if domain != "" {
-			opts = append(opts, csaf.WithProviderDomain(domain))
+		if p.csafDomain != "" {
+			opts = append(opts, csaf.WithProviderDomain(p.csafDomain))
 		}
 		collectors = append(collectors, csaf.NewTrustedProviderCollector(opts...))
+
+		// The SBOMs of the product complement its security advisories
+		if len(p.sbomURLs) > 0 {
+			collectors = append(collectors, sbom.NewSBOMCollector(
+				sbom.WithURLs(p.sbomURLs...),
+				sbom.WithTargetOfEvaluationID(p.targetOfEvaluationID)))
+		}
+	case p.provider == ProviderSBOM:
+		if len(p.sbomURLs) == 0 {
+			err = errors.New("at least one SBOM URL must be provided")
+			log.Error("SBOM URLs missing", "provider", p.provider, "error", err)
+			return nil, err
+		}
+		collectors = append(collectors, sbom.NewSBOMCollector(
+			sbom.WithURLs(p.sbomURLs...),
+			sbom.WithTargetOfEvaluationID(p.targetOfEvaluationID)))
+	case p.provider == ProviderTerraform:
+		if len(p.terraformSources) == 0 {
+			err = errors.New("at least one Terraform state or plan must be provided")
+			log.Error("Terraform sources missing", "provider", p.provider, "error", err)
+			return nil, err
+		}
+		collectors = append(collectors, terraform.NewTerraformCollector(
+			terraform.WithSources(p.terraformSources...),
+			terraform.WithTargetOfEvaluationID(p.targetOfEvaluationID)))
 	default:
-		err = fmt.Errorf("provider '%s' not known", provider)
-		log.Error("provider not known", "provider", provider, "error", err)
+		err = fmt.Errorf("provider '%s' not known", p.provider)
+		log.Error("provider not known", "provider", p.provider, "error", err)
 		return nil, err
 	}
 
@@ -349,21 +658,81 @@ func (svc *Service) buildCollectors(cmd *cli.Command) (collectors []collector.Co
 
 // Start collector
 func (svc *Service) Start(cmd *cli.Command) (err error) {
+	var jobs []collectorJob
+
 	log.Info("Starting collector")
 	svc.scheduler.TagsUnique()
 
-	svc.collectors, err = svc.buildCollectors(cmd)
+	svc.configMu.Lock()
+	defer svc.configMu.Unlock()
+
+	jobs, err = svc.buildJobs(cmd)
+	if err != nil {
+		return err
+	}
+
+	err = svc.schedule(jobs)
+	if err != nil {
+		return err
+	}
+
+	svc.scheduler.StartAsync()
+
+	return nil
+}
+
+// Reload replaces the configuration file of the collector. If the collector is already started, its collectors are
+// rebuilt and rescheduled according to the new configuration. If the collectors cannot be built, e.g., because of an
+// unknown provider, the previous configuration stays in effect.
+func (svc *Service) Reload(file *config.File) (err error) {
+	var (
+		previous *config.File
+		jobs     []collectorJob
+	)
+
+	svc.configMu.Lock()
+	defer svc.configMu.Unlock()
+
+	previous = svc.cloudConfig.file
+	svc.cloudConfig.file = file
+
+	jobs, err = svc.buildJobs(nil)
+	if err != nil {
+		svc.cloudConfig.file = previous
+		return err
+	}
+
+	// Without a running scheduler, the configuration is used once the collector is started
+	if !svc.scheduler.IsRunning() {
+		return nil
+	}
+
+	svc.scheduler.Clear()
+
+	err = svc.schedule(jobs)
 	if err != nil {
 		return err
 	}
 
-	for _, v := range svc.collectors {
-		log.Info("Scheduling collector", "name", v.Name(), "id", v.ID(), "interval_min", svc.cloudConfig.collectorInterval.Minutes())
+	log.Info("Reloaded collector configuration", "collectors", len(jobs))
+
+	return nil
+}
+
+// schedule schedules the periodic runs of the given collectors.
+func (svc *Service) schedule(jobs []collectorJob) (err error) {
+	svc.collectors = nil
+
+	for _, job := range jobs {
+		v := job.collector
+		svc.collectors = append(svc.collectors, v)
+
+		log.Info("Scheduling collector", "name", v.Name(), "id", v.ID(), "interval_min", job.interval.Minutes())
 
 		_, err = svc.scheduler.
-			Every(svc.cloudConfig.collectorInterval).
+			Every(job.interval).
 			Tag(v.ID()).
-			Do(svc.StartCollector, v)
+			Do(svc.collect, v, job.environment)
 		if err != nil {
 			newError := fmt.Errorf("could not schedule job for {%s}: %v", v.Name(), err)
 			log.Error("schedule error", "collector", v.Name(), "error", newError)
@@ -371,16 +740,29 @@ func (svc *Service) Start(cmd *cli.Command) (err error) {
 		}
 	}
 
-	svc.scheduler.StartAsync()
-
 	return nil
 }
 
+// StartCollector runs the given collector once and sends the evidences of the collected resources to the evidence
+// store.
 func (svc *Service) StartCollector(collector collector.Collector) {
+	svc.collect(collector, svc.cloudConfig.environment)
+}
+
+// collect runs the given collector once and sends the evidences of the collected resources, attributed to the given
+// environment, to the evidence store.
+func (svc *Service) collect(collector collector.Collector, environment string) {
 	var (
-		err  error
-		list []ontology.IsResource
-		ev   *evidence.Evidence
+		err   error
+		list  []ontology.IsResource
+		ev    *evidence.Evidence
+		guard *quota.Guard
+		stats *quota.Stats
+
+		full        bool
+		fingerprint string
+		skipped     int
+		ids         []string
 	)
 
 	go func() {
@@ -391,8 +773,27 @@ func (svc *Service) StartCollector(collector collector.Collector) {
 		}
 	}()
 
+	// Start a new run of the quota guard, so that the budget and the throttling metrics are per run
+	if guarded, ok := collector.(quota.Guarded); ok {
+		guard = guarded.QuotaGuard()
+	}
+	if guard != nil {
+		guard.StartRun()
+	}
+
 	list, err = collector.Collect()
 
+	if guard != nil {
+		stats = new(guard.Stats())
+		log.Info("API calls of collector run",
+			"collector", collector.Name(),
+			"calls", stats.Calls,
+			"throttled", stats.Throttled,
+			"retries", stats.Retries,
+			"rejected", stats.Rejected,
+			"waited", stats.Waited)
+	}
+
 	if err != nil {
 		log.Error("Could not retrieve resources from collector", "collector", collector.Name(), tint.Err(err))
 		return
@@ -405,16 +806,37 @@ func (svc *Service) StartCollector(collector collector.Collector) {
 			CollectorName:  collector.Name(),
 			CollectedItems: len(list),
 			Time:           time.Now(),
+			Quota:          stats,
 		}
 	}()
 
+	svc.storeInventory(collector, environment, list)
+
+	// With evidence diffing, only the evidences of changed resources are sent, unless a full refresh is due
+	full = svc.diff.startRun(collector.ID(), time.Now())
+
 	for _, resource := range list {
+		r := ontology.ProtoResource(resource)
+		ids = append(ids, resource.GetId())
+
+		fingerprint, err = svc.diff.fingerprint(r)
+		if err != nil {
+			log.Warn("Could not compare resource with its previous evidence", "resource", resource.GetId(), tint.Err(err))
+		} else if !full && svc.diff.unchanged(collector.ID(), resource.GetId(), fingerprint) {
+			skipped++
+			continue
+		}
+
 		ev = &evidence.Evidence{
 			Id:                   uuid.New().String(),
-			TargetOfEvaluationId: svc.GetTargetOfEvaluationId(),
+			TargetOfEvaluationId: cmp.Or(collector.TargetOfEvaluationID(), svc.GetTargetOfEvaluationId()),
 			Timestamp:            timestamppb.Now(),
 			ToolId:               svc.cloudConfig.collectorToolID,
-			Resource:             ontology.ProtoResource(resource),
+			Resource:             r,
+		}
+
+		if environment != "" {
+			ev.Environment = &environment
 		}
 
 		// Only enabled related evidences for some specific resources for now
@@ -429,6 +851,16 @@ func (svc *Service) StartCollector(collector collector.Collector) {
 		if err != nil {
 			continue
 		}
+
+		// The evidence is sent again in the next run, if it could not be stored
+		if fingerprint != "" {
+			svc.diff.remember(collector.ID(), resource.GetId(), fingerprint)
+		}
+	}
+
+	if svc.diff != nil {
+		svc.diff.retain(collector.ID(), ids)
+		log.Info("Skipped evidences of unchanged resources", "collector", collector.Name(), "skipped", skipped, "fullRefresh", full)
 	}
 }
 
@@ -476,7 +908,11 @@ func (svc *Service) GetStream() *connect.BidiStreamForClient[evidence.StoreEvide
 	defer svc.streamMu.Unlock()
 
 	if svc.evidenceStoreClient == nil {
-		svc.evidenceStoreClient = evidenceconnect.NewEvidenceStoreClient(svc.cloudConfig.evStreamConfig.client, svc.cloudConfig.evStreamConfig.targetAddress)
+		svc.evidenceStoreClient = evidenceconnect.NewEvidenceStoreClient(
+			svc.cloudConfig.evStreamConfig.client,
+			svc.cloudConfig.evStreamConfig.targetAddress,
+			svc.cloudConfig.evStreamConfig.transport.ClientOptions()...,
+		)
 	}
 
 	stream := svc.evidenceStoreStream
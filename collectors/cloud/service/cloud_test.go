@@ -26,6 +26,7 @@ import (
 	collector "confirmate.io/collectors/cloud/internal/collector"
 	"confirmate.io/collectors/cloud/internal/collectortest"
 	"confirmate.io/collectors/cloud/internal/config"
+	"confirmate.io/collectors/cloud/internal/quota"
 	"confirmate.io/collectors/cloud/internal/testdata"
 	"confirmate.io/core/api/evidence"
 	"confirmate.io/core/api/evidence/evidenceconnect"
@@ -131,6 +132,17 @@ func TestNewService(t *testing.T) {
 				return assert.Equal(t, time.Duration(8), got.cloudConfig.collectorInterval)
 			},
 		},
+		{
+			name: "Create service with option 'WithQuotaConfig'",
+			args: args{
+				opts: []service.Option[Service]{
+					WithQuotaConfig(quota.Config{MaxCallsPerRun: 100, MaxRetries: 1}),
+				},
+			},
+			want: func(t *testing.T, got *Service, msgAndArgs ...any) bool {
+				return assert.Equal(t, quota.Config{MaxCallsPerRun: 100, MaxRetries: 1}, got.cloudConfig.quota)
+			},
+		},
 		{
 			name: "Create service without any option",
 			args: args{},
@@ -266,12 +278,13 @@ func TestService_StartCollector(t *testing.T) {
 	}
 
 	tests := []struct {
-		name         string
-		fields       fields
-		want         assert.Want[*Service]
-		wantEvent    []CollectorEventType
-		wantCount    int
-		responseFunc func(*evidence.StoreEvidenceRequest) (*evidence.StoreEvidencesResponse, error)
+		name            string
+		fields          fields
+		want            assert.Want[*Service]
+		wantEvent       []CollectorEventType
+		wantCount       int
+		wantEnvironment string
+		responseFunc    func(*evidence.StoreEvidenceRequest) (*evidence.StoreEvidencesResponse, error)
 	}{
 		{
 			name: "collector error emits start event without evidence",
@@ -299,6 +312,7 @@ func TestService_StartCollector(t *testing.T) {
 				opts: []service.Option[Service]{
 					WithTargetOfEvaluationID(testdata.MockTargetOfEvaluationID1),
 					WithCollectorToolID(testdata.MockEvidenceToolID1),
+					WithEnvironment("prod"),
 				},
 				collector: &startCollectorTestCollector{
 					name:                 "successful-collector",
@@ -313,8 +327,9 @@ func TestService_StartCollector(t *testing.T) {
 			want: func(t *testing.T, got *Service, msgAndArgs ...any) bool {
 				return assert.False(t, got.dead)
 			},
-			wantEvent: []CollectorEventType{CloudCollectorStart, CloudCollectorFinished},
-			wantCount: 2,
+			wantEvent:       []CollectorEventType{CloudCollectorStart, CloudCollectorFinished},
+			wantCount:       2,
+			wantEnvironment: "prod",
 		},
 		{
 			name: "evidence-store rejection marks stream dead and later sends reopen it",
@@ -366,7 +381,7 @@ func TestService_StartCollector(t *testing.T) {
 
 			tt.fields.opts = append(tt.fields.opts, WithEvidenceStoreAddress(testSrv.URL, testSrv.Client()))
 			svc = NewService(tt.fields.opts...)
-			defer svc.Shutdown()
+			defer svc.Shutdown(context.Background())
 			svc.Events = make(chan *CollectorEvent, len(tt.wantEvent))
 
 			svc.StartCollector(tt.fields.collector)
@@ -395,6 +410,7 @@ func TestService_StartCollector(t *testing.T) {
 			assert.Equal(t, tt.wantCount, len(requests))
 			assert.Equal(t, testdata.MockTargetOfEvaluationID1, requests[0].GetEvidence().GetTargetOfEvaluationId())
 			assert.Equal(t, testdata.MockEvidenceToolID1, requests[0].GetEvidence().GetToolId())
+			assert.Equal(t, tt.wantEnvironment, requests[0].GetEvidence().GetEnvironment())
 			assert.Equal(t, "vm-1", requests[0].GetEvidence().GetResource().GetVirtualMachine().GetId())
 			if len(requests) > 1 {
 				assert.Equal(t, "storage-1", requests[1].GetEvidence().GetResource().GetObjectStorage().GetId())
@@ -427,7 +443,7 @@ func waitForStoredRequests(t *testing.T, handler *mockEvidenceStoreHandler, want
 
 func TestService_Shutdown(t *testing.T) {
 	service := NewService()
-	service.Shutdown()
+	assert.NoError(t, service.Shutdown(context.Background()))
 
 	assert.False(t, service.scheduler.IsRunning())
 
@@ -773,6 +789,96 @@ func TestService_Start(t *testing.T) {
 			},
 			wantErr: assert.Nil[error],
 		},
+		{
+			name: "Happy path: CSAF with SBOM",
+			fields: fields{
+				scheduler: gocron.NewScheduler(time.UTC),
+				cloudConfig: CloudCollectorConfig{
+					provider:          ProviderCSAF,
+					collectorInterval: time.Duration(5 * time.Minute),
+				},
+			},
+			args: args{
+				cmd: &cli.Command{
+					Flags: []cli.Flag{
+						&cli.StringSliceFlag{
+							Name:  "collector-sbom-url",
+							Value: []string{"https://example.com/sbom.json"},
+						},
+					},
+				},
+			},
+			want: func(t *testing.T, got *Service, msgAndArgs ...any) bool {
+				assert.Equal(t, ProviderCSAF, got.cloudConfig.provider)
+				assert.Equal(t, 2, len(got.collectors))
+				return assert.True(t, got.scheduler.IsRunning())
+			},
+			wantErr: assert.Nil[error],
+		},
+		{
+			name: "SBOM without URLs",
+			fields: fields{
+				scheduler: gocron.NewScheduler(time.UTC),
+				cloudConfig: CloudCollectorConfig{
+					provider:          ProviderSBOM,
+					collectorInterval: time.Duration(5 * time.Minute),
+				},
+			},
+			args: args{
+				cmd: &cli.Command{},
+			},
+			want: func(t *testing.T, got *Service, msgAndArgs ...any) bool {
+				return assert.False(t, got.scheduler.IsRunning())
+			},
+			wantErr: func(t *testing.T, gotErr error, msgAndArgs ...any) bool {
+				return assert.ErrorContains(t, gotErr, "at least one SBOM URL must be provided")
+			},
+		},
+		{
+			name: "Happy path: Terraform",
+			fields: fields{
+				scheduler: gocron.NewScheduler(time.UTC),
+				cloudConfig: CloudCollectorConfig{
+					provider:          ProviderTerraform,
+					collectorInterval: time.Duration(5 * time.Minute),
+				},
+			},
+			args: args{
+				cmd: &cli.Command{
+					Flags: []cli.Flag{
+						&cli.StringSliceFlag{
+							Name:  "collector-terraform-source",
+							Value: []string{"plan.json"},
+						},
+					},
+				},
+			},
+			want: func(t *testing.T, got *Service, msgAndArgs ...any) bool {
+				assert.Equal(t, ProviderTerraform, got.cloudConfig.provider)
+				assert.Equal(t, 1, len(got.collectors))
+				return assert.True(t, got.scheduler.IsRunning())
+			},
+			wantErr: assert.Nil[error],
+		},
+		{
+			name: "Terraform without sources",
+			fields: fields{
+				scheduler: gocron.NewScheduler(time.UTC),
+				cloudConfig: CloudCollectorConfig{
+					provider:          ProviderTerraform,
+					collectorInterval: time.Duration(5 * time.Minute),
+				},
+			},
+			args: args{
+				cmd: &cli.Command{},
+			},
+			want: func(t *testing.T, got *Service, msgAndArgs ...any) bool {
+				return assert.False(t, got.scheduler.IsRunning())
+			},
+			wantErr: func(t *testing.T, gotErr error, msgAndArgs ...any) bool {
+				return assert.ErrorContains(t, gotErr, "at least one Terraform state or plan must be provided")
+			},
+		},
 		// Note: Currently not possible to test K8S, because it requires a kubconfig file to be present in the environment.
 		// {
 		// 	name: "Happy path: K8S",
@@ -854,6 +960,49 @@ func TestService_Start(t *testing.T) {
 	}
 }
 
+func TestService_Reload(t *testing.T) {
+	var (
+		sbomCollector = func(toeID string, urls ...string) config.Collector {
+			return config.Collector{
+				Provider:             ProviderSBOM,
+				TargetOfEvaluationID: toeID,
+				Interval:             time.Hour,
+				SBOMURLs:             urls,
+			}
+		}
+		svc = newService(WithConfigFile(&config.File{
+			Collectors: []config.Collector{sbomCollector(testdata.MockTargetOfEvaluationID1, "https://example.com/sbom.json")},
+		}))
+	)
+	defer svc.scheduler.Stop()
+
+	err := svc.Start(&cli.Command{})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(svc.collectors))
+	assert.Equal(t, testdata.MockTargetOfEvaluationID1, svc.collectors[0].TargetOfEvaluationID())
+
+	// The collectors are rebuilt and rescheduled according to the new configuration
+	err = svc.Reload(&config.File{
+		Collectors: []config.Collector{
+			sbomCollector(testdata.MockTargetOfEvaluationID1, "https://example.com/sbom.json"),
+			sbomCollector(testdata.MockTargetOfEvaluationID2, "https://example.com/other.json"),
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(svc.collectors))
+	assert.Equal(t, testdata.MockTargetOfEvaluationID2, svc.collectors[1].TargetOfEvaluationID())
+	assert.Equal(t, 2, len(svc.scheduler.Jobs()))
+
+	// An invalid configuration keeps the previous one in effect
+	err = svc.Reload(&config.File{
+		Collectors: []config.Collector{{Provider: "unknown"}},
+	})
+	assert.ErrorContains(t, err, "'unknown' not known")
+	assert.Equal(t, 2, len(svc.collectors))
+	assert.Equal(t, 2, len(svc.scheduler.Jobs()))
+	assert.Equal(t, ProviderSBOM, svc.cloudConfig.file.Collectors[0].Provider)
+}
+
 func TestService_GetTargetOfEvaluationId(t *testing.T) {
 	tests := []struct {
 		name string // description of this test case
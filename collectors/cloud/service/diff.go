@@ -0,0 +1,200 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package cloud
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"sync"
+	"time"
+
+	"confirmate.io/core/api/ontology"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// DefaultDiffFullRefresh is the default interval of the full refreshes of a collector with evidence diffing, see
+// [DiffConfig].
+const DefaultDiffFullRefresh = 24 * time.Hour
+
+// DefaultDiffIgnoreFields are the resource fields that are ignored by default when comparing the state of a resource
+// with its previously sent evidence. They change without the state of the resource changing.
+var DefaultDiffIgnoreFields = []string{"raw", "last_activity"}
+
+// DiffConfig holds the configuration of the evidence diffing. With diffing, an evidence is only sent if the state of
+// its resource changed since the previously sent evidence of the resource, which reduces the load of the assessment
+// and the evidence store.
+type DiffConfig struct {
+	// IgnoreFields are the names of the resource fields (e.g., "creation_time") that are ignored when comparing the
+	// state of a resource. They are ignored in nested messages as well.
+	IgnoreFields []string
+
+	// FullRefresh is the interval at which a collector sends the evidences of all of its resources, regardless of
+	// whether they changed. A value of 0 disables full refreshes.
+	FullRefresh time.Duration
+}
+
+// evidenceDiff remembers the state of the resources whose evidences a collector sent last, so that unchanged resources
+// can be skipped. A nil evidenceDiff sends every evidence.
+type evidenceDiff struct {
+	cfg    DiffConfig
+	ignore map[protoreflect.Name]bool
+
+	mu sync.Mutex
+
+	// fingerprints contains the fingerprints of the last sent resources by the ID of their collector and resource.
+	fingerprints map[string]map[string]string
+
+	// refreshedAt contains the time of the last full refresh by the ID of the collector.
+	refreshedAt map[string]time.Time
+}
+
+// newEvidenceDiff creates a new evidenceDiff with the given configuration.
+func newEvidenceDiff(cfg DiffConfig) (d *evidenceDiff) {
+	d = &evidenceDiff{
+		cfg:          cfg,
+		ignore:       make(map[protoreflect.Name]bool, len(cfg.IgnoreFields)),
+		fingerprints: make(map[string]map[string]string),
+		refreshedAt:  make(map[string]time.Time),
+	}
+
+	for _, name := range cfg.IgnoreFields {
+		d.ignore[protoreflect.Name(name)] = true
+	}
+
+	return d
+}
+
+// startRun starts a run of the given collector and returns whether it is a full refresh, in which the evidences of all
+// resources are sent. The first run of a collector is always a full refresh.
+func (d *evidenceDiff) startRun(collectorID string, now time.Time) (full bool) {
+	if d == nil {
+		return true
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	refreshedAt, ok := d.refreshedAt[collectorID]
+	if ok && (d.cfg.FullRefresh <= 0 || now.Sub(refreshedAt) < d.cfg.FullRefresh) {
+		return false
+	}
+
+	d.refreshedAt[collectorID] = now
+
+	return true
+}
+
+// fingerprint returns the fingerprint of the state of the resource, which ignores the configured fields.
+func (d *evidenceDiff) fingerprint(resource *ontology.Resource) (fingerprint string, err error) {
+	var (
+		b   []byte
+		sum [sha256.Size]byte
+	)
+
+	if d == nil {
+		return "", nil
+	}
+
+	resource = proto.Clone(resource).(*ontology.Resource)
+	clearFields(resource.ProtoReflect(), d.ignore)
+
+	b, err = proto.MarshalOptions{Deterministic: true}.Marshal(resource)
+	if err != nil {
+		return "", err
+	}
+
+	sum = sha256.Sum256(b)
+
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// unchanged returns whether the collector already sent an evidence of the resource with the given fingerprint.
+func (d *evidenceDiff) unchanged(collectorID string, resourceID string, fingerprint string) bool {
+	if d == nil {
+		return false
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	previous, ok := d.fingerprints[collectorID][resourceID]
+
+	return ok && previous == fingerprint
+}
+
+// remember records that the collector sent an evidence of the resource with the given fingerprint.
+func (d *evidenceDiff) remember(collectorID string, resourceID string, fingerprint string) {
+	if d == nil {
+		return
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.fingerprints[collectorID] == nil {
+		d.fingerprints[collectorID] = make(map[string]string)
+	}
+	d.fingerprints[collectorID][resourceID] = fingerprint
+}
+
+// retain forgets the resources of the collector that are not among the given resource IDs, e.g., because they were
+// deleted. An evidence is sent again, once they reappear.
+func (d *evidenceDiff) retain(collectorID string, resourceIDs []string) {
+	if d == nil {
+		return
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	retained := make(map[string]string, len(resourceIDs))
+	for _, id := range resourceIDs {
+		if fingerprint, ok := d.fingerprints[collectorID][id]; ok {
+			retained[id] = fingerprint
+		}
+	}
+	d.fingerprints[collectorID] = retained
+}
+
+// clearFields clears the fields with the given names in the message and all of its nested messages.
+func clearFields(m protoreflect.Message, names map[protoreflect.Name]bool) {
+	var cleared []protoreflect.FieldDescriptor
+
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		switch {
+		case names[fd.Name()]:
+			cleared = append(cleared, fd)
+		case fd.IsList() && fd.Message() != nil:
+			for i := range v.List().Len() {
+				clearFields(v.List().Get(i).Message(), names)
+			}
+		case fd.IsMap() && fd.MapValue().Message() != nil:
+			v.Map().Range(func(_ protoreflect.MapKey, v protoreflect.Value) bool {
+				clearFields(v.Message(), names)
+				return true
+			})
+		case fd.Message() != nil && !fd.IsMap():
+			clearFields(v.Message(), names)
+		}
+		return true
+	})
+
+	for _, fd := range cleared {
+		m.Clear(fd)
+	}
+}
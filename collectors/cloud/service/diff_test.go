@@ -0,0 +1,218 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package cloud
+
+import (
+	"testing"
+	"time"
+
+	"confirmate.io/core/api/ontology"
+	"confirmate.io/core/util/assert"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// newDiffTestResource returns a virtual machine with the given raw data, creation time and update interval.
+func newDiffTestResource(raw string, createdAt time.Time, interval time.Duration) *ontology.Resource {
+	return ontology.ProtoResource(&ontology.VirtualMachine{
+		Id:           "vm-1",
+		Name:         "vm-1",
+		Raw:          raw,
+		CreationTime: timestamppb.New(createdAt),
+		AutomaticUpdates: &ontology.AutomaticUpdates{
+			Enabled:  true,
+			Interval: durationpb.New(interval),
+		},
+	})
+}
+
+func Test_evidenceDiff_fingerprint(t *testing.T) {
+	var (
+		createdAt = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+		resource  = newDiffTestResource("raw", createdAt, time.Hour)
+	)
+
+	type fields struct {
+		cfg DiffConfig
+	}
+	type args struct {
+		other *ontology.Resource
+	}
+	tests := []struct {
+		name      string
+		fields    fields
+		args      args
+		wantEqual bool
+	}{
+		{
+			name: "same state",
+			args: args{
+				other: newDiffTestResource("raw", createdAt, time.Hour),
+			},
+			wantEqual: true,
+		},
+		{
+			name: "ignored field changed",
+			fields: fields{
+				cfg: DiffConfig{IgnoreFields: []string{"raw", "creation_time"}},
+			},
+			args: args{
+				other: newDiffTestResource("other", createdAt.Add(time.Hour), time.Hour),
+			},
+			wantEqual: true,
+		},
+		{
+			name: "field changed",
+			fields: fields{
+				cfg: DiffConfig{IgnoreFields: []string{"raw"}},
+			},
+			args: args{
+				other: newDiffTestResource("raw", createdAt.Add(time.Hour), time.Hour),
+			},
+			wantEqual: false,
+		},
+		{
+			name: "nested field changed",
+			fields: fields{
+				cfg: DiffConfig{IgnoreFields: []string{"raw", "creation_time"}},
+			},
+			args: args{
+				other: newDiffTestResource("raw", createdAt, 2*time.Hour),
+			},
+			wantEqual: false,
+		},
+		{
+			name: "nested field ignored",
+			fields: fields{
+				cfg: DiffConfig{IgnoreFields: []string{"interval"}},
+			},
+			args: args{
+				other: newDiffTestResource("raw", createdAt, 2*time.Hour),
+			},
+			wantEqual: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := newEvidenceDiff(tt.fields.cfg)
+
+			got, err := d.fingerprint(resource)
+			assert.NoError(t, err)
+			other, err := d.fingerprint(tt.args.other)
+			assert.NoError(t, err)
+
+			assert.Equal(t, tt.wantEqual, got == other)
+
+			// The resource itself must not be modified
+			assert.Equal(t, "raw", resource.GetVirtualMachine().GetRaw())
+		})
+	}
+}
+
+func Test_evidenceDiff_startRun(t *testing.T) {
+	var now = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	type fields struct {
+		diff *evidenceDiff
+	}
+	type args struct {
+		now time.Time
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		want   bool
+	}{
+		{
+			name: "without diffing",
+			args: args{now: now},
+			want: true,
+		},
+		{
+			name: "first run",
+			fields: fields{
+				diff: newEvidenceDiff(DiffConfig{FullRefresh: time.Hour}),
+			},
+			args: args{now: now},
+			want: true,
+		},
+		{
+			name: "before the full refresh",
+			fields: fields{
+				diff: func() *evidenceDiff {
+					d := newEvidenceDiff(DiffConfig{FullRefresh: time.Hour})
+					d.startRun("collector-1", now)
+					return d
+				}(),
+			},
+			args: args{now: now.Add(30 * time.Minute)},
+			want: false,
+		},
+		{
+			name: "full refresh due",
+			fields: fields{
+				diff: func() *evidenceDiff {
+					d := newEvidenceDiff(DiffConfig{FullRefresh: time.Hour})
+					d.startRun("collector-1", now)
+					return d
+				}(),
+			},
+			args: args{now: now.Add(time.Hour)},
+			want: true,
+		},
+		{
+			name: "full refreshes disabled",
+			fields: fields{
+				diff: func() *evidenceDiff {
+					d := newEvidenceDiff(DiffConfig{})
+					d.startRun("collector-1", now)
+					return d
+				}(),
+			},
+			args: args{now: now.Add(48 * time.Hour)},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.fields.diff.startRun("collector-1", tt.args.now)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_evidenceDiff_unchanged(t *testing.T) {
+	var d = newEvidenceDiff(DiffConfig{})
+
+	// Nothing was sent yet
+	assert.False(t, d.unchanged("collector-1", "vm-1", "a"))
+
+	d.remember("collector-1", "vm-1", "a")
+	d.remember("collector-1", "vm-2", "b")
+	assert.True(t, d.unchanged("collector-1", "vm-1", "a"))
+	assert.False(t, d.unchanged("collector-1", "vm-1", "c"))
+	assert.False(t, d.unchanged("collector-2", "vm-1", "a"))
+
+	// Resources that disappeared are sent again, once they reappear
+	d.retain("collector-1", []string{"vm-1"})
+	assert.True(t, d.unchanged("collector-1", "vm-1", "a"))
+	assert.False(t, d.unchanged("collector-1", "vm-2", "b"))
+
+	// Without diffing, every resource is sent
+	assert.False(t, (*evidenceDiff)(nil).unchanged("collector-1", "vm-1", "a"))
+}
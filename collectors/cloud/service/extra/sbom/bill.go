@@ -0,0 +1,169 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package sbom
+
+import (
+	"time"
+
+	collector "confirmate.io/collectors/cloud/internal/collector"
+	"confirmate.io/core/api/ontology"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// bill is the format-independent representation of an SBOM, into which both CycloneDX and SPDX documents are parsed.
+type bill struct {
+	// id is the globally unique identifier of the SBOM, i.e., the serial number of a CycloneDX BOM or the document
+	// namespace of an SPDX document.
+	id string
+
+	// name is the name of the product that the SBOM describes.
+	name string
+
+	// created is the creation time of the SBOM, if it is specified.
+	created *time.Time
+
+	// format is the name of the format, i.e., "CycloneDX" or "SPDX".
+	format string
+
+	// specVersion is the version of the specification of the format.
+	specVersion string
+
+	// schemaURL is the URL of the JSON schema of the format.
+	schemaURL string
+
+	// errors contains the violations of the format that were found while parsing.
+	errors []string
+
+	// components contains the components of the product.
+	components []*component
+}
+
+// component is a software component listed in an SBOM.
+type component struct {
+	// ref identifies the component within the SBOM, e.g., the bom-ref in CycloneDX or the SPDXID in SPDX.
+	ref string
+
+	name        string
+	version     string
+	description string
+
+	// purl is the package URL of the component, if it is specified.
+	purl string
+
+	// kind is the type of the component, e.g., "library" or "application".
+	kind string
+
+	// dependsOn contains the refs of the components this component depends on.
+	dependsOn []string
+
+	// vulnerabilities contains the known vulnerabilities of the component.
+	vulnerabilities []*ontology.Vulnerability
+
+	// raw is the component as it is specified in the SBOM.
+	raw any
+}
+
+// resources converts the SBOM into an [ontology.SBOMDocument] and an [ontology.Library] for each of its components. The
+// libraries reference the SBOM document as their parent and the libraries they depend on.
+func (b *bill) resources(location *ontology.RemoteDataLocation, raw string) (resources []ontology.IsResource) {
+	var (
+		doc *ontology.SBOMDocument
+		ids = make(map[string]string, len(b.components))
+	)
+
+	doc = &ontology.SBOMDocument{
+		Id:       b.id,
+		Name:     b.name,
+		Filetype: "JSON",
+		Labels: map[string]string{
+			"format":      b.format,
+			"specVersion": b.specVersion,
+		},
+		DataLocation: &ontology.DataLocation{
+			Type: &ontology.DataLocation_RemoteDataLocation{
+				RemoteDataLocation: location,
+			},
+		},
+		ValidatedBy: &ontology.SchemaValidation{
+			SchemaUrl: b.schemaURL,
+			Format:    b.format,
+			Errors:    validationErrors(b.errors),
+		},
+		Raw: raw,
+	}
+	if b.created != nil {
+		doc.CreationTime = timestamppb.New(*b.created)
+	}
+
+	resources = append(resources, doc)
+
+	// The refs are only unique within the SBOM, so we prefer the package URL as ID of a library
+	for _, c := range b.components {
+		if c.purl != "" {
+			ids[c.ref] = c.purl
+		} else {
+			ids[c.ref] = b.id + "#" + c.ref
+		}
+	}
+
+	for _, c := range b.components {
+		lib := &ontology.Library{
+			Id:              ids[c.ref],
+			Name:            c.name,
+			Description:     c.description,
+			Labels:          make(map[string]string),
+			Vulnerabilities: c.vulnerabilities,
+			ParentId:        &doc.Id,
+			Raw:             collector.Raw(c.raw),
+		}
+
+		for key, value := range map[string]string{"version": c.version, "purl": c.purl, "type": c.kind} {
+			if value != "" {
+				lib.Labels[key] = value
+			}
+		}
+
+		for _, ref := range c.dependsOn {
+			if id, ok := ids[ref]; ok {
+				lib.LibraryIds = append(lib.LibraryIds, id)
+			}
+		}
+
+		resources = append(resources, lib)
+	}
+
+	return
+}
+
+// validationErrors converts the violations of the format into [ontology.Error] objects.
+func validationErrors(messages []string) (errs []*ontology.Error) {
+	for _, m := range messages {
+		errs = append(errs, &ontology.Error{Message: m})
+	}
+
+	return
+}
+
+// parseTime parses the RFC 3339 timestamp of an SBOM. It returns nil if the timestamp is empty or invalid.
+func parseTime(s string) *time.Time {
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return nil
+	}
+
+	return &t
+}
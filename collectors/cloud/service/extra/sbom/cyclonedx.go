@@ -0,0 +1,190 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package sbom
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"confirmate.io/core/api/ontology"
+)
+
+// cycloneDXSchemaURL is the URL of the JSON schema of CycloneDX BOMs.
+const cycloneDXSchemaURL = "http://cyclonedx.org/schema/bom-1.6.schema.json"
+
+// cycloneDXBOM contains the parts of a CycloneDX BOM that we are interested in.
+type cycloneDXBOM struct {
+	BOMFormat    string `json:"bomFormat"`
+	SpecVersion  string `json:"specVersion"`
+	SerialNumber string `json:"serialNumber"`
+	Metadata     struct {
+		Timestamp string              `json:"timestamp"`
+		Component *cycloneDXComponent `json:"component"`
+	} `json:"metadata"`
+	Components   []*cycloneDXComponent `json:"components"`
+	Dependencies []struct {
+		Ref       string   `json:"ref"`
+		DependsOn []string `json:"dependsOn"`
+	} `json:"dependencies"`
+	Vulnerabilities []*cycloneDXVulnerability `json:"vulnerabilities"`
+}
+
+type cycloneDXComponent struct {
+	BOMRef      string                `json:"bom-ref"`
+	Type        string                `json:"type"`
+	Name        string                `json:"name"`
+	Version     string                `json:"version"`
+	Description string                `json:"description"`
+	PURL        string                `json:"purl"`
+	Components  []*cycloneDXComponent `json:"components"`
+}
+
+type cycloneDXVulnerability struct {
+	ID          string `json:"id"`
+	Description string `json:"description"`
+	Source      struct {
+		URL string `json:"url"`
+	} `json:"source"`
+	Ratings []struct {
+		Severity string `json:"severity"`
+	} `json:"ratings"`
+	CWEs     []int `json:"cwes"`
+	Analysis *struct {
+		State string `json:"state"`
+	} `json:"analysis"`
+	Affects []struct {
+		Ref string `json:"ref"`
+	} `json:"affects"`
+}
+
+// severities ranks the severities of CycloneDX vulnerability ratings.
+var severities = map[string]int{
+	"none":     1,
+	"info":     2,
+	"low":      3,
+	"medium":   4,
+	"high":     5,
+	"critical": 6,
+}
+
+// notExploitable contains the analysis states of CycloneDX (VEX) in which a vulnerability cannot be exploited.
+var notExploitable = map[string]bool{
+	"resolved":               true,
+	"resolved_with_pedigree": true,
+	"false_positive":         true,
+	"not_affected":           true,
+}
+
+// parseCycloneDX parses a CycloneDX BOM in the JSON format. Nested components are flattened.
+func parseCycloneDX(body []byte) (b *bill, err error) {
+	var (
+		bom   cycloneDXBOM
+		byRef = make(map[string]*component)
+		add   func(components []*cycloneDXComponent)
+	)
+
+	if err = json.Unmarshal(body, &bom); err != nil {
+		return nil, fmt.Errorf("could not parse CycloneDX BOM: %w", err)
+	}
+
+	b = &bill{
+		id:          bom.SerialNumber,
+		created:     parseTime(bom.Metadata.Timestamp),
+		format:      "CycloneDX",
+		specVersion: bom.SpecVersion,
+		schemaURL:   cycloneDXSchemaURL,
+	}
+
+	if bom.SpecVersion == "" {
+		b.errors = append(b.errors, "specVersion is missing")
+	}
+	if bom.SerialNumber == "" {
+		b.errors = append(b.errors, "serialNumber is missing")
+	}
+	if bom.Metadata.Component != nil {
+		b.name = bom.Metadata.Component.Name
+	}
+
+	add = func(components []*cycloneDXComponent) {
+		for _, c := range components {
+			if c.Name == "" {
+				b.errors = append(b.errors, fmt.Sprintf("component %q has no name", c.BOMRef))
+			}
+
+			comp := &component{
+				ref:         c.BOMRef,
+				name:        c.Name,
+				version:     c.Version,
+				description: c.Description,
+				purl:        c.PURL,
+				kind:        c.Type,
+				raw:         c,
+			}
+			if comp.ref == "" {
+				comp.ref = c.Name + "@" + c.Version
+			}
+
+			b.components = append(b.components, comp)
+			byRef[comp.ref] = comp
+
+			add(c.Components)
+		}
+	}
+	add(bom.Components)
+
+	for _, dep := range bom.Dependencies {
+		if c, ok := byRef[dep.Ref]; ok {
+			c.dependsOn = append(c.dependsOn, dep.DependsOn...)
+		}
+	}
+
+	for _, v := range bom.Vulnerabilities {
+		for _, affected := range v.Affects {
+			if c, ok := byRef[affected.Ref]; ok {
+				c.vulnerabilities = append(c.vulnerabilities, v.vulnerability())
+			}
+		}
+	}
+
+	return b, nil
+}
+
+// vulnerability converts the CycloneDX vulnerability into an [ontology.Vulnerability]. Its criticality is the highest
+// severity of its ratings. Unless an analysis states otherwise, we assume that the vulnerability is exploitable.
+func (v *cycloneDXVulnerability) vulnerability() (vuln *ontology.Vulnerability) {
+	vuln = &ontology.Vulnerability{
+		Description: v.Description,
+		Url:         v.Source.URL,
+		Exploitable: v.Analysis == nil || !notExploitable[v.Analysis.State],
+	}
+
+	if strings.HasPrefix(v.ID, "CVE-") {
+		vuln.Cve = v.ID
+	}
+
+	for _, r := range v.Ratings {
+		if severities[r.Severity] > severities[vuln.Criticality] {
+			vuln.Criticality = r.Severity
+		}
+	}
+
+	for _, cwe := range v.CWEs {
+		vuln.Cwe = append(vuln.Cwe, fmt.Sprintf("CWE-%d", cwe))
+	}
+
+	return
+}
@@ -0,0 +1,97 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package sbom
+
+import (
+	"os"
+	"testing"
+
+	"confirmate.io/core/api/ontology"
+	"confirmate.io/core/util/assert"
+)
+
+func Test_parseCycloneDX(t *testing.T) {
+	body, err := os.ReadFile("testdata/cyclonedx.json")
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		body    []byte
+		want    assert.Want[*bill]
+		wantErr assert.WantErr
+	}{
+		{
+			name: "happy path",
+			body: body,
+			want: func(t *testing.T, got *bill, msgAndArgs ...any) bool {
+				var crypto = got.components[2]
+
+				return assert.Equal(t, "urn:uuid:3e671687-395b-41f5-a30f-a58921a69b79", got.id) &&
+					assert.Equal(t, "Test Product", got.name) &&
+					assert.Equal(t, "1.6", got.specVersion) &&
+					assert.Empty(t, got.errors) &&
+					// Nested components are flattened
+					assert.Equal(t, 3, len(got.components)) &&
+					assert.Equal(t, "web-internal", got.components[1].ref) &&
+					assert.Equal(t, []string{"pkg:golang/example.com/crypto@v0.1.0", "web-internal"}, got.components[0].dependsOn) &&
+					assert.Equal(t, []*ontology.Vulnerability{
+						{
+							Cve:         "CVE-2026-0001",
+							Description: "Weak key derivation",
+							Url:         "https://nvd.nist.gov/vuln/detail/CVE-2026-0001",
+							Criticality: "critical",
+							Cwe:         []string{"CWE-916"},
+							Exploitable: true,
+						},
+					}, crypto.vulnerabilities) &&
+					assert.Equal(t, []*ontology.Vulnerability{
+						{
+							Criticality: "low",
+							Exploitable: false,
+						},
+					}, got.components[0].vulnerabilities)
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "missing fields",
+			body: []byte(`{"bomFormat": "CycloneDX", "components": [{"bom-ref": "a"}]}`),
+			want: func(t *testing.T, got *bill, msgAndArgs ...any) bool {
+				return assert.Equal(t, []string{
+					"specVersion is missing",
+					"serialNumber is missing",
+					`component "a" has no name`,
+				}, got.errors)
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "invalid JSON",
+			body: []byte(`{"bomFormat": "CycloneDX", "components": {}}`),
+			want: assert.Nil[*bill],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.ErrorContains(t, err, "could not parse CycloneDX BOM")
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseCycloneDX(tt.body)
+			tt.wantErr(t, err)
+			tt.want(t, got)
+		})
+	}
+}
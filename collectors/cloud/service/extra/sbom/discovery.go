@@ -0,0 +1,202 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+// Package sbom contains a collector that ingests software bills of materials (SBOMs) in the CycloneDX or SPDX JSON
+// format. It complements the CSAF collector, which retrieves the security advisories of a vendor, by describing the
+// components that a product is made of and their known vulnerabilities.
+package sbom
+
+import (
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	collector "confirmate.io/collectors/cloud/internal/collector"
+	"confirmate.io/collectors/cloud/internal/config"
+	"confirmate.io/collectors/cloud/internal/constants"
+	"confirmate.io/collectors/cloud/internal/logconfig"
+	"confirmate.io/core/api/ontology"
+
+	"github.com/google/uuid"
+)
+
+var log *slog.Logger
+
+func init() {
+	log = logconfig.GetLogger().With("component", "sbom-collector")
+}
+
+type sbomCollector struct {
+	urls   []string
+	ctID   string
+	id     string
+	client *http.Client
+}
+
+type CollectorOption func(d *sbomCollector)
+
+// WithURLs sets the URLs of the SBOMs to collect.
+func WithURLs(urls ...string) CollectorOption {
+	return func(d *sbomCollector) {
+		d.urls = append(d.urls, urls...)
+	}
+}
+
+func WithTargetOfEvaluationID(ctID string) CollectorOption {
+	return func(d *sbomCollector) {
+		d.ctID = ctID
+	}
+}
+
+// WithHTTPClient sets the HTTP client that is used to retrieve the SBOMs.
+func WithHTTPClient(client *http.Client) CollectorOption {
+	return func(d *sbomCollector) {
+		d.client = client
+	}
+}
+
+func NewSBOMCollector(opts ...CollectorOption) collector.Collector {
+	d := &sbomCollector{
+		ctID:   config.DefaultTargetOfEvaluationID,
+		client: http.DefaultClient,
+	}
+
+	// Apply options
+	for _, opt := range opts {
+		opt(d)
+	}
+
+	seed := "sbom::" + d.ctID + "::" + strings.Join(d.urls, ",")
+	d.id = uuid.NewSHA1(uuid.NameSpaceOID, []byte(seed)).String()
+
+	return d
+}
+
+func (*sbomCollector) Name() string {
+	return "SBOM Collector"
+}
+
+func (*sbomCollector) Description() string {
+	return "Collector for CycloneDX and SPDX software bills of materials"
+}
+
+func (d *sbomCollector) TargetOfEvaluationID() string {
+	return d.ctID
+}
+
+func (d *sbomCollector) ID() string {
+	return d.id
+}
+
+func (d *sbomCollector) List() (list []ontology.IsResource, err error) {
+	var resources []ontology.IsResource
+
+	for _, url := range d.urls {
+		log.Info("fetching SBOM", slog.String("url", url))
+
+		resources, err = d.collectSBOM(url)
+		if err != nil {
+			return nil, fmt.Errorf("could not collect SBOM from %s: %w", url, err)
+		}
+
+		list = append(list, resources...)
+	}
+
+	return
+}
+
+// Collect is the core collection contract and delegates to the existing List implementation.
+func (d *sbomCollector) Collect() (list []ontology.IsResource, err error) {
+	return d.List()
+}
+
+// collectSBOM retrieves the SBOM from the URL and converts it into an [ontology.SBOMDocument] and an
+// [ontology.Library] for each of its components.
+func (d *sbomCollector) collectSBOM(url string) (resources []ontology.IsResource, err error) {
+	var (
+		res  *http.Response
+		body []byte
+		raw  map[string]any
+		b    *bill
+	)
+
+	res, err = d.client.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code %d", res.StatusCode)
+	}
+
+	body, err = io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(body, &raw)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse SBOM: %w", err)
+	}
+
+	// Both formats identify themselves with a top-level property
+	switch {
+	case raw["bomFormat"] == "CycloneDX":
+		b, err = parseCycloneDX(body)
+	case raw["spdxVersion"] != nil:
+		b, err = parseSPDX(body)
+	default:
+		return nil, fmt.Errorf("unknown SBOM format: only CycloneDX and SPDX in JSON are supported")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Fall back to the URL, if the SBOM does not identify itself or its product
+	if b.id == "" {
+		b.id = url
+	}
+	if b.name == "" {
+		b.name = url
+	}
+
+	return b.resources(&ontology.RemoteDataLocation{
+		Path:                url,
+		TransportEncryption: transportEncryption(res.TLS),
+	}, collector.Raw(raw)), nil
+}
+
+// transportEncryption returns the [ontology.TransportEncryption] of the connection the SBOM was retrieved with.
+func transportEncryption(state *tls.ConnectionState) (te *ontology.TransportEncryption) {
+	te = &ontology.TransportEncryption{}
+
+	if state != nil {
+		te.Enabled = true
+		te.Protocol = constants.TLS
+		te.ProtocolVersion = map[uint16]float32{
+			tls.VersionTLS10: 1.0,
+			tls.VersionTLS11: 1.1,
+			tls.VersionTLS12: 1.2,
+			tls.VersionTLS13: 1.3,
+		}[state.Version]
+	}
+
+	return te
+}
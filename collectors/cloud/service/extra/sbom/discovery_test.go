@@ -0,0 +1,112 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package sbom
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"confirmate.io/collectors/cloud/internal/config"
+	"confirmate.io/core/api/ontology"
+	"confirmate.io/core/util/assert"
+
+	"github.com/google/uuid"
+)
+
+func TestNewSBOMCollector(t *testing.T) {
+	var d = NewSBOMCollector(WithURLs("https://example.com/a.json", "https://example.com/b.json"))
+
+	assert.Equal(t, config.DefaultTargetOfEvaluationID, d.TargetOfEvaluationID())
+	assert.Equal(t, uuid.NewSHA1(uuid.NameSpaceOID,
+		[]byte("sbom::"+config.DefaultTargetOfEvaluationID+"::https://example.com/a.json,https://example.com/b.json")).String(), d.ID())
+}
+
+func Test_sbomCollector_List(t *testing.T) {
+	srv := httptest.NewServer(http.FileServer(http.Dir("testdata")))
+	defer srv.Close()
+
+	tests := []struct {
+		name    string
+		urls    []string
+		want    assert.Want[[]ontology.IsResource]
+		wantErr assert.WantErr
+	}{
+		{
+			name: "CycloneDX",
+			urls: []string{srv.URL + "/cyclonedx.json"},
+			want: func(t *testing.T, got []ontology.IsResource, msgAndArgs ...any) bool {
+				var (
+					doc = assert.Is[*ontology.SBOMDocument](t, got[0])
+					web = assert.Is[*ontology.Library](t, got[1])
+				)
+
+				return assert.Equal(t, 4, len(got)) &&
+					assert.Equal(t, "urn:uuid:3e671687-395b-41f5-a30f-a58921a69b79", doc.Id) &&
+					assert.Equal(t, "CycloneDX", doc.Labels["format"]) &&
+					assert.Equal(t, srv.URL+"/cyclonedx.json", doc.GetDataLocation().GetRemoteDataLocation().GetPath()) &&
+					assert.False(t, doc.GetDataLocation().GetRemoteDataLocation().GetTransportEncryption().GetEnabled()) &&
+					assert.Equal(t, "pkg:golang/example.com/web@v1.2.0", web.Id) &&
+					assert.Equal(t, doc.Id, web.GetParentId()) &&
+					assert.Equal(t, "v1.2.0", web.Labels["version"]) &&
+					// Components without package URL are identified by the SBOM and their ref
+					assert.Equal(t, []string{
+						"pkg:golang/example.com/crypto@v0.1.0",
+						"urn:uuid:3e671687-395b-41f5-a30f-a58921a69b79#web-internal",
+					}, web.LibraryIds)
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "SPDX",
+			urls: []string{srv.URL + "/spdx.json"},
+			want: func(t *testing.T, got []ontology.IsResource, msgAndArgs ...any) bool {
+				var crypto = assert.Is[*ontology.Library](t, got[2])
+
+				return assert.Equal(t, 3, len(got)) &&
+					assert.Equal(t, "SPDX", assert.Is[*ontology.SBOMDocument](t, got[0]).Labels["format"]) &&
+					assert.Equal(t, "https://example.com/spdx/test-product-1.0.0#SPDXRef-Package-crypto", crypto.Id) &&
+					assert.Equal(t, "CVE-2026-0001", crypto.Vulnerabilities[0].Cve)
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "unknown format",
+			urls: []string{srv.URL + "/unknown.json"},
+			want: assert.Nil[[]ontology.IsResource],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.ErrorContains(t, err, "unknown SBOM format")
+			},
+		},
+		{
+			name: "not found",
+			urls: []string{srv.URL + "/cyclonedx.json", srv.URL + "/missing.json"},
+			want: assert.Nil[[]ontology.IsResource],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.ErrorContains(t, err, "missing.json: unexpected status code 404")
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := NewSBOMCollector(WithURLs(tt.urls...), WithHTTPClient(srv.Client()))
+
+			got, err := d.List()
+			tt.wantErr(t, err)
+			tt.want(t, got)
+		})
+	}
+}
@@ -0,0 +1,139 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package sbom
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"confirmate.io/core/api/ontology"
+)
+
+// spdxSchemaURL is the URL of the JSON schema of SPDX documents.
+const spdxSchemaURL = "https://raw.githubusercontent.com/spdx/spdx-spec/v2.3/schemas/spdx-schema.json"
+
+// cvePattern matches a CVE identifier, e.g., in the locator of an advisory reference.
+var cvePattern = regexp.MustCompile(`CVE-\d{4}-\d{4,}`)
+
+// spdxDocument contains the parts of an SPDX document that we are interested in.
+type spdxDocument struct {
+	SPDXVersion       string `json:"spdxVersion"`
+	SPDXID            string `json:"SPDXID"`
+	Name              string `json:"name"`
+	DocumentNamespace string `json:"documentNamespace"`
+	CreationInfo      struct {
+		Created string `json:"created"`
+	} `json:"creationInfo"`
+	Packages      []*spdxPackage `json:"packages"`
+	Relationships []struct {
+		SPDXElementID      string `json:"spdxElementId"`
+		RelationshipType   string `json:"relationshipType"`
+		RelatedSPDXElement string `json:"relatedSpdxElement"`
+	} `json:"relationships"`
+}
+
+type spdxPackage struct {
+	SPDXID                string `json:"SPDXID"`
+	Name                  string `json:"name"`
+	VersionInfo           string `json:"versionInfo"`
+	Description           string `json:"description"`
+	PrimaryPackagePurpose string `json:"primaryPackagePurpose"`
+	ExternalRefs          []struct {
+		ReferenceCategory string `json:"referenceCategory"`
+		ReferenceType     string `json:"referenceType"`
+		ReferenceLocator  string `json:"referenceLocator"`
+	} `json:"externalRefs"`
+}
+
+// parseSPDX parses an SPDX document in the JSON format. Since SPDX 2 has no dedicated vulnerability section, the
+// advisory references of the packages are used as their known vulnerabilities.
+func parseSPDX(body []byte) (b *bill, err error) {
+	var (
+		doc   spdxDocument
+		byRef = make(map[string]*component)
+	)
+
+	if err = json.Unmarshal(body, &doc); err != nil {
+		return nil, fmt.Errorf("could not parse SPDX document: %w", err)
+	}
+
+	b = &bill{
+		id:          doc.DocumentNamespace,
+		name:        doc.Name,
+		created:     parseTime(doc.CreationInfo.Created),
+		format:      "SPDX",
+		specVersion: strings.TrimPrefix(doc.SPDXVersion, "SPDX-"),
+		schemaURL:   spdxSchemaURL,
+	}
+
+	if doc.SPDXID != "SPDXRef-DOCUMENT" {
+		b.errors = append(b.errors, "SPDXID of the document must be SPDXRef-DOCUMENT")
+	}
+	if doc.DocumentNamespace == "" {
+		b.errors = append(b.errors, "documentNamespace is missing")
+	}
+	if doc.CreationInfo.Created == "" {
+		b.errors = append(b.errors, "creationInfo.created is missing")
+	}
+
+	for _, p := range doc.Packages {
+		if p.Name == "" {
+			b.errors = append(b.errors, fmt.Sprintf("package %q has no name", p.SPDXID))
+		}
+
+		comp := &component{
+			ref:         p.SPDXID,
+			name:        p.Name,
+			version:     p.VersionInfo,
+			description: p.Description,
+			kind:        strings.ToLower(p.PrimaryPackagePurpose),
+			raw:         p,
+		}
+
+		for _, ref := range p.ExternalRefs {
+			switch {
+			case ref.ReferenceType == "purl":
+				comp.purl = ref.ReferenceLocator
+			case ref.ReferenceCategory == "SECURITY" && ref.ReferenceType == "advisory":
+				comp.vulnerabilities = append(comp.vulnerabilities, &ontology.Vulnerability{
+					Cve:         cvePattern.FindString(ref.ReferenceLocator),
+					Url:         ref.ReferenceLocator,
+					Exploitable: true,
+				})
+			}
+		}
+
+		b.components = append(b.components, comp)
+		byRef[comp.ref] = comp
+	}
+
+	for _, rel := range doc.Relationships {
+		switch rel.RelationshipType {
+		case "DEPENDS_ON":
+			if c, ok := byRef[rel.SPDXElementID]; ok {
+				c.dependsOn = append(c.dependsOn, rel.RelatedSPDXElement)
+			}
+		case "DEPENDENCY_OF":
+			if c, ok := byRef[rel.RelatedSPDXElement]; ok {
+				c.dependsOn = append(c.dependsOn, rel.SPDXElementID)
+			}
+		}
+	}
+
+	return b, nil
+}
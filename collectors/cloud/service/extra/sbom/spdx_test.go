@@ -0,0 +1,78 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package sbom
+
+import (
+	"os"
+	"testing"
+
+	"confirmate.io/core/api/ontology"
+	"confirmate.io/core/util/assert"
+)
+
+func Test_parseSPDX(t *testing.T) {
+	body, err := os.ReadFile("testdata/spdx.json")
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		body    []byte
+		want    assert.Want[*bill]
+		wantErr assert.WantErr
+	}{
+		{
+			name: "happy path",
+			body: body,
+			want: func(t *testing.T, got *bill, msgAndArgs ...any) bool {
+				return assert.Equal(t, "https://example.com/spdx/test-product-1.0.0", got.id) &&
+					assert.Equal(t, "2.3", got.specVersion) &&
+					assert.Empty(t, got.errors) &&
+					assert.Equal(t, 2, len(got.components)) &&
+					assert.Equal(t, "pkg:npm/web@1.2.0", got.components[0].purl) &&
+					assert.Equal(t, "library", got.components[0].kind) &&
+					assert.Equal(t, []string{"SPDXRef-Package-crypto"}, got.components[0].dependsOn) &&
+					assert.Equal(t, []*ontology.Vulnerability{
+						{
+							Cve:         "CVE-2026-0001",
+							Url:         "https://nvd.nist.gov/vuln/detail/CVE-2026-0001",
+							Exploitable: true,
+						},
+					}, got.components[1].vulnerabilities)
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "missing fields",
+			body: []byte(`{"spdxVersion": "SPDX-2.3", "packages": [{"SPDXID": "SPDXRef-a"}]}`),
+			want: func(t *testing.T, got *bill, msgAndArgs ...any) bool {
+				return assert.Equal(t, []string{
+					"SPDXID of the document must be SPDXRef-DOCUMENT",
+					"documentNamespace is missing",
+					"creationInfo.created is missing",
+					`package "SPDXRef-a" has no name`,
+				}, got.errors)
+			},
+			wantErr: assert.NoError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseSPDX(tt.body)
+			tt.wantErr(t, err)
+			tt.want(t, got)
+		})
+	}
+}
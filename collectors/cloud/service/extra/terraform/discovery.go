@@ -0,0 +1,161 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+// Package terraform contains a collector that parses Terraform states and plans and emits the resources declared in
+// them. Collecting a plan allows assessing the compliance of the infrastructure before it is deployed ("shift-left"),
+// using the same metrics as the collectors of the cloud providers at runtime.
+package terraform
+
+import (
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"os"
+	"strings"
+
+	collector "confirmate.io/collectors/cloud/internal/collector"
+	"confirmate.io/collectors/cloud/internal/config"
+	"confirmate.io/collectors/cloud/internal/logconfig"
+	"confirmate.io/core/api/ontology"
+
+	"github.com/google/uuid"
+)
+
+var log *slog.Logger
+
+func init() {
+	log = logconfig.GetLogger().With("component", "terraform-collector")
+}
+
+type terraformCollector struct {
+	sources []string
+	ctID    string
+	id      string
+	client  *http.Client
+}
+
+type CollectorOption func(d *terraformCollector)
+
+// WithSources sets the states and plans to collect. A source is either the path of a local file or the HTTP(S) URL
+// of a remote backend, e.g., the state endpoint of an HTTP backend.
+func WithSources(sources ...string) CollectorOption {
+	return func(d *terraformCollector) {
+		d.sources = append(d.sources, sources...)
+	}
+}
+
+func WithTargetOfEvaluationID(ctID string) CollectorOption {
+	return func(d *terraformCollector) {
+		d.ctID = ctID
+	}
+}
+
+// WithHTTPClient sets the HTTP client that is used to retrieve the states and plans of remote backends.
+func WithHTTPClient(client *http.Client) CollectorOption {
+	return func(d *terraformCollector) {
+		d.client = client
+	}
+}
+
+func NewTerraformCollector(opts ...CollectorOption) collector.Collector {
+	d := &terraformCollector{
+		ctID:   config.DefaultTargetOfEvaluationID,
+		client: http.DefaultClient,
+	}
+
+	// Apply options
+	for _, opt := range opts {
+		opt(d)
+	}
+
+	seed := "terraform::" + d.ctID + "::" + strings.Join(d.sources, ",")
+	d.id = uuid.NewSHA1(uuid.NameSpaceOID, []byte(seed)).String()
+
+	return d
+}
+
+func (*terraformCollector) Name() string {
+	return "Terraform Collector"
+}
+
+func (*terraformCollector) Description() string {
+	return "Collector for the infrastructure declared in Terraform states and plans"
+}
+
+func (d *terraformCollector) TargetOfEvaluationID() string {
+	return d.ctID
+}
+
+func (d *terraformCollector) ID() string {
+	return d.id
+}
+
+func (d *terraformCollector) List() (list []ontology.IsResource, err error) {
+	var (
+		body []byte
+		doc  *document
+	)
+
+	for _, source := range d.sources {
+		log.Info("reading Terraform document", slog.String("source", source))
+
+		body, err = d.read(source)
+		if err != nil {
+			return nil, fmt.Errorf("could not read Terraform document from %s: %w", source, err)
+		}
+
+		doc, err = parse(body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid Terraform document %s: %w", source, err)
+		}
+
+		log.Debug("parsed Terraform document",
+			slog.String("source", source),
+			slog.String("kind", doc.kind),
+			slog.String("terraformVersion", doc.terraformVersion),
+			slog.Int("resources", len(doc.resources)))
+
+		list = append(list, doc.ontologyResources(source)...)
+	}
+
+	return
+}
+
+// Collect is the core collection contract and delegates to the existing List implementation.
+func (d *terraformCollector) Collect() (list []ontology.IsResource, err error) {
+	return d.List()
+}
+
+// read reads the document from the source, which is either an HTTP(S) URL or the path of a local file.
+func (d *terraformCollector) read(source string) (body []byte, err error) {
+	var res *http.Response
+
+	if !strings.HasPrefix(source, "http://") && !strings.HasPrefix(source, "https://") {
+		return os.ReadFile(source)
+	}
+
+	res, err = d.client.Get(source)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code %d", res.StatusCode)
+	}
+
+	return io.ReadAll(res.Body)
+}
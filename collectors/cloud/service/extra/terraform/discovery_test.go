@@ -0,0 +1,144 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package terraform
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"confirmate.io/collectors/cloud/internal/config"
+	"confirmate.io/core/api/ontology"
+	"confirmate.io/core/util/assert"
+
+	"github.com/google/uuid"
+)
+
+func TestNewTerraformCollector(t *testing.T) {
+	var d = NewTerraformCollector(WithSources("testdata/plan.json", "https://example.com/state"))
+
+	assert.Equal(t, config.DefaultTargetOfEvaluationID, d.TargetOfEvaluationID())
+	assert.Equal(t, uuid.NewSHA1(uuid.NameSpaceOID,
+		[]byte("terraform::"+config.DefaultTargetOfEvaluationID+"::testdata/plan.json,https://example.com/state")).String(), d.ID())
+}
+
+func Test_terraformCollector_List(t *testing.T) {
+	srv := httptest.NewServer(http.FileServer(http.Dir("testdata")))
+	defer srv.Close()
+
+	tests := []struct {
+		name    string
+		sources []string
+		want    assert.Want[[]ontology.IsResource]
+		wantErr assert.WantErr
+	}{
+		{
+			name:    "local state",
+			sources: []string{"testdata/state.json"},
+			want: func(t *testing.T, got []ontology.IsResource, msgAndArgs ...any) bool {
+				var (
+					vm     = assert.Is[*ontology.VirtualMachine](t, got[0])
+					bucket = assert.Is[*ontology.ObjectStorage](t, got[1])
+					volume = assert.Is[*ontology.BlockStorage](t, got[2])
+				)
+
+				// Data sources and resource types without counterpart in the ontology are skipped
+				return assert.Equal(t, 3, len(got)) &&
+					assert.Equal(t, "arn:aws:ec2:eu-central-1:123456789012:instance/i-0123456789abcdef0", vm.Id) &&
+					assert.Equal(t, "web", vm.Name) &&
+					assert.Equal(t, "eu-central-1", vm.GetGeoLocation().GetRegion()) &&
+					assert.Equal(t, "platform", vm.Labels["team"]) &&
+					assert.Equal(t, "AES256", bucket.GetAtRestEncryption().GetManagedKeyEncryption().GetAlgorithm()) &&
+					assert.True(t, volume.GetAtRestEncryption().GetManagedKeyEncryption().GetEnabled()) &&
+					assert.Equal(t, "eu-central-1", volume.GetGeoLocation().GetRegion())
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name:    "remote plan",
+			sources: []string{srv.URL + "/plan.json"},
+			want: func(t *testing.T, got []ontology.IsResource, msgAndArgs ...any) bool {
+				var (
+					vm      = assert.Is[*ontology.VirtualMachine](t, got[0])
+					disk    = assert.Is[*ontology.BlockStorage](t, got[1])
+					account = assert.Is[*ontology.ObjectStorageService](t, got[2])
+				)
+
+				// Resources that are not deployed yet are identified by the source and their address
+				return assert.Equal(t, 3, len(got)) &&
+					assert.Equal(t, srv.URL+"/plan.json#azurerm_linux_virtual_machine.app", vm.Id) &&
+					assert.Equal(t, "app-vm", vm.Name) &&
+					assert.True(t, vm.GetBootLogging().GetEnabled()) &&
+					assert.Equal(t, "westeurope", vm.GetGeoLocation().GetRegion()) &&
+					assert.True(t, disk.GetAtRestEncryption().GetCustomerKeyEncryption().GetEnabled()) &&
+					assert.True(t, account.GetTransportEncryption().GetEnforced()) &&
+					assert.Equal(t, float32(1.2), account.GetTransportEncryption().GetProtocolVersion())
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name:    "state file",
+			sources: []string{"testdata/terraform.tfstate"},
+			want: func(t *testing.T, got []ontology.IsResource, msgAndArgs ...any) bool {
+				var (
+					bucket   = assert.Is[*ontology.ObjectStorage](t, got[0])
+					function = assert.Is[*ontology.Function](t, got[1])
+				)
+
+				return assert.Equal(t, 2, len(got)) &&
+					assert.Equal(t, "arn:aws:s3:::example-assets-eu", bucket.Id) &&
+					// The encryption of the bucket is declared by a resource of its own
+					assert.Equal(t, "arn:aws:kms:eu-central-1:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab",
+						bucket.GetAtRestEncryption().GetCustomerKeyEncryption().GetKeyUrl()) &&
+					assert.Equal(t, "handler", function.Name)
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name:    "unknown format",
+			sources: []string{"testdata/unknown.json"},
+			want:    assert.Nil[[]ontology.IsResource],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.ErrorContains(t, err, "unknown Terraform format")
+			},
+		},
+		{
+			name:    "not found",
+			sources: []string{"testdata/state.json", srv.URL + "/missing.json"},
+			want:    assert.Nil[[]ontology.IsResource],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.ErrorContains(t, err, "missing.json: unexpected status code 404")
+			},
+		},
+		{
+			name:    "missing file",
+			sources: []string{"testdata/missing.json"},
+			want:    assert.Nil[[]ontology.IsResource],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.ErrorContains(t, err, "could not read Terraform document from testdata/missing.json")
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := NewTerraformCollector(WithSources(tt.sources...), WithHTTPClient(srv.Client()))
+
+			got, err := d.List()
+			tt.wantErr(t, err)
+			tt.want(t, got)
+		})
+	}
+}
@@ -0,0 +1,262 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package terraform
+
+import (
+	"cmp"
+	"strings"
+
+	collector "confirmate.io/collectors/cloud/internal/collector"
+	"confirmate.io/collectors/cloud/internal/constants"
+	"confirmate.io/core/api/ontology"
+)
+
+// resources converts the declared resources of the document into ontology resources. Resource types that have no
+// counterpart in the ontology are skipped. Resources that are not deployed yet, and therefore have no ID in the cloud,
+// are identified by the source of the document and their address.
+func (doc *document) ontologyResources(source string) (list []ontology.IsResource) {
+	var encryptions = doc.bucketEncryptions()
+
+	for _, r := range doc.resources {
+		var (
+			id       = cmp.Or(r.str("arn"), r.str("id"), source+"#"+r.Address)
+			name     = cmp.Or(r.str("name"), r.str("bucket"), r.str("function_name"), r.tags()["Name"], r.Name)
+			location = &ontology.GeoLocation{Region: r.region()}
+			raw      = collector.Raw(r)
+		)
+
+		switch r.Type {
+		case "aws_instance", "azurerm_linux_virtual_machine", "azurerm_windows_virtual_machine":
+			list = append(list, &ontology.VirtualMachine{
+				Id:          id,
+				Name:        name,
+				GeoLocation: location,
+				Labels:      r.tags(),
+				BootLogging: &ontology.BootLogging{
+					Enabled: r.block("boot_diagnostics") != nil,
+				},
+				Raw: raw,
+			})
+		case "aws_ebs_volume", "azurerm_managed_disk":
+			list = append(list, &ontology.BlockStorage{
+				Id:               id,
+				Name:             name,
+				GeoLocation:      location,
+				Labels:           r.tags(),
+				AtRestEncryption: r.volumeEncryption(),
+				Raw:              raw,
+			})
+		case "aws_s3_bucket":
+			var enc = r
+
+			// Since version 4 of the AWS provider, the encryption of a bucket is configured by a resource of its own
+			if e, ok := encryptions[r.str("bucket")]; ok {
+				enc = e
+			}
+
+			list = append(list, &ontology.ObjectStorage{
+				Id:               id,
+				Name:             name,
+				GeoLocation:      location,
+				Labels:           r.tags(),
+				AtRestEncryption: enc.bucketEncryption(),
+				Raw:              raw,
+			})
+		case "azurerm_storage_account":
+			list = append(list, &ontology.ObjectStorageService{
+				Id:                  id,
+				Name:                name,
+				GeoLocation:         location,
+				Labels:              r.tags(),
+				TransportEncryption: r.transportEncryption(),
+				Raw:                 raw,
+			})
+		case "aws_lambda_function", "azurerm_linux_function_app", "azurerm_windows_function_app":
+			list = append(list, &ontology.Function{
+				Id:          id,
+				Name:        name,
+				GeoLocation: location,
+				Labels:      r.tags(),
+				Raw:         raw,
+			})
+		default:
+			log.Debug("skipping unsupported resource type", "type", r.Type, "address", r.Address)
+		}
+	}
+
+	return list
+}
+
+// bucketEncryptions returns the aws_s3_bucket_server_side_encryption_configuration resources of the document by the
+// name of their bucket.
+func (doc *document) bucketEncryptions() (encryptions map[string]*resource) {
+	encryptions = make(map[string]*resource)
+
+	for _, r := range doc.resources {
+		if r.Type == "aws_s3_bucket_server_side_encryption_configuration" && r.str("bucket") != "" {
+			encryptions[r.str("bucket")] = r
+		}
+	}
+
+	return encryptions
+}
+
+// volumeEncryption returns the [ontology.AtRestEncryption] of an aws_ebs_volume or azurerm_managed_disk. Azure always
+// encrypts managed disks, either with a platform key or with the customer key of a disk encryption set.
+func (r *resource) volumeEncryption() *ontology.AtRestEncryption {
+	if set := r.str("disk_encryption_set_id"); set != "" {
+		return &ontology.AtRestEncryption{
+			Type: &ontology.AtRestEncryption_CustomerKeyEncryption{
+				CustomerKeyEncryption: &ontology.CustomerKeyEncryption{
+					Enabled: true,
+					KeyUrl:  set,
+				},
+			},
+		}
+	}
+
+	var atRest = &ontology.ManagedKeyEncryption{
+		Enabled: r.Type == "azurerm_managed_disk" || r.bool("encrypted"),
+	}
+	if atRest.Enabled {
+		atRest.Algorithm = constants.AES256
+	}
+
+	return &ontology.AtRestEncryption{
+		Type: &ontology.AtRestEncryption_ManagedKeyEncryption{
+			ManagedKeyEncryption: atRest,
+		},
+	}
+}
+
+// bucketEncryption returns the [ontology.AtRestEncryption] of an aws_s3_bucket or
+// aws_s3_bucket_server_side_encryption_configuration. It returns nil, if no default encryption is declared.
+func (r *resource) bucketEncryption() *ontology.AtRestEncryption {
+	var byDefault = r.block("server_side_encryption_configuration", "rule", "apply_server_side_encryption_by_default")
+	if byDefault == nil {
+		byDefault = r.block("rule", "apply_server_side_encryption_by_default")
+	}
+	if byDefault == nil {
+		return nil
+	}
+
+	if alg, _ := byDefault["sse_algorithm"].(string); alg == constants.AES256 {
+		return &ontology.AtRestEncryption{
+			Type: &ontology.AtRestEncryption_ManagedKeyEncryption{
+				ManagedKeyEncryption: &ontology.ManagedKeyEncryption{
+					Algorithm: alg,
+					Enabled:   true,
+				},
+			},
+		}
+	}
+
+	key, _ := byDefault["kms_master_key_id"].(string)
+
+	return &ontology.AtRestEncryption{
+		Type: &ontology.AtRestEncryption_CustomerKeyEncryption{
+			CustomerKeyEncryption: &ontology.CustomerKeyEncryption{
+				Enabled: true,
+				KeyUrl:  key,
+			},
+		},
+	}
+}
+
+// transportEncryption returns the [ontology.TransportEncryption] of an azurerm_storage_account. The attribute that
+// enforces HTTPS was renamed in version 4 of the Azure provider.
+func (r *resource) transportEncryption() *ontology.TransportEncryption {
+	var (
+		https = r.bool("https_traffic_only_enabled") || r.bool("enable_https_traffic_only")
+		te    = &ontology.TransportEncryption{
+			Enabled:  https,
+			Enforced: https,
+		}
+	)
+
+	if https {
+		te.Protocol = constants.TLS
+		te.ProtocolVersion = map[string]float32{
+			"TLS1_0": 1.0,
+			"TLS1_1": 1.1,
+			"TLS1_2": 1.2,
+			"TLS1_3": 1.3,
+		}[r.str("min_tls_version")]
+	}
+
+	return te
+}
+
+// region returns the region of the resource. AWS resources only declare their availability zone, if the provider
+// does not add the region to their attributes.
+func (r *resource) region() string {
+	if zone := r.str("availability_zone"); zone != "" {
+		return cmp.Or(r.str("region"), strings.TrimRight(zone, "abcdefghijklmnopqrstuvwxyz"))
+	}
+
+	return cmp.Or(r.str("region"), r.str("location"))
+}
+
+// tags returns the tags of the resource, which become the labels of the ontology resource.
+func (r *resource) tags() (tags map[string]string) {
+	m, _ := r.Values["tags"].(map[string]any)
+	if len(m) == 0 {
+		return nil
+	}
+
+	tags = make(map[string]string, len(m))
+	for k, v := range m {
+		tags[k], _ = v.(string)
+	}
+
+	return tags
+}
+
+// str returns the string attribute with the given key. It returns an empty string, if the attribute is not set or
+// is only known after the apply.
+func (r *resource) str(key string) (s string) {
+	s, _ = r.Values[key].(string)
+	return
+}
+
+// bool returns the boolean attribute with the given key.
+func (r *resource) bool(key string) (b bool) {
+	b, _ = r.Values[key].(bool)
+	return
+}
+
+// block returns the nested block at the given path of keys. Nested blocks are lists of objects in the JSON
+// representation, of which the first one is used. It returns nil, if the block is not declared.
+func (r *resource) block(path ...string) (block map[string]any) {
+	var v any = r.Values
+
+	for _, key := range path {
+		m, ok := v.(map[string]any)
+		if !ok {
+			return nil
+		}
+
+		blocks, ok := m[key].([]any)
+		if !ok || len(blocks) == 0 {
+			return nil
+		}
+
+		v = blocks[0]
+	}
+
+	block, _ = v.(map[string]any)
+	return block
+}
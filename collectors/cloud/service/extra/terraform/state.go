@@ -0,0 +1,226 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package terraform
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// supportedStateVersion is the version of the state file format that can be parsed.
+const supportedStateVersion = 4
+
+// document is the format-independent representation of a Terraform state or plan, into which both the output of
+// "terraform show -json" and state files are parsed.
+type document struct {
+	// kind is either "state" or "plan".
+	kind string
+
+	// terraformVersion is the version of Terraform that wrote the document.
+	terraformVersion string
+
+	// resources contains the managed resources that are declared in the document. Data sources are not included.
+	resources []*resource
+}
+
+// resource is a managed resource that is declared in a Terraform state or plan.
+type resource struct {
+	// Address is the address of the resource in the configuration, e.g., "module.web.aws_instance.app[0]".
+	Address string `json:"address"`
+
+	// Mode is either "managed" for resources or "data" for data sources.
+	Mode string `json:"mode"`
+
+	// Type is the type of the resource, e.g., "aws_instance".
+	Type string `json:"type"`
+
+	// Name is the name of the resource in the configuration.
+	Name string `json:"name"`
+
+	// Values contains the attributes of the resource. In a plan, attributes that are only known after the apply are
+	// missing.
+	Values map[string]any `json:"values"`
+}
+
+// showOutput is the JSON representation of a state or plan as it is printed by "terraform show -json".
+type showOutput struct {
+	FormatVersion    string  `json:"format_version"`
+	TerraformVersion string  `json:"terraform_version"`
+	Values           *values `json:"values"`
+	PlannedValues    *values `json:"planned_values"`
+}
+
+// values contains the resources of a state or the planned resources of a plan.
+type values struct {
+	RootModule *module `json:"root_module"`
+}
+
+// module contains the resources of a module and its child modules.
+type module struct {
+	Resources    []*resource `json:"resources"`
+	ChildModules []*module   `json:"child_modules"`
+}
+
+// stateFile is a state file as it is stored by the local and remote backends, e.g., in a terraform.tfstate file.
+type stateFile struct {
+	Version          int              `json:"version"`
+	TerraformVersion string           `json:"terraform_version"`
+	Resources        []*stateResource `json:"resources"`
+}
+
+// stateResource is a resource in a [stateFile] with all its instances, e.g., the instances created by count or
+// for_each.
+type stateResource struct {
+	Module    string           `json:"module"`
+	Mode      string           `json:"mode"`
+	Type      string           `json:"type"`
+	Name      string           `json:"name"`
+	Instances []*stateInstance `json:"instances"`
+}
+
+// stateInstance is an instance of a [stateResource].
+type stateInstance struct {
+	IndexKey   any            `json:"index_key"`
+	Attributes map[string]any `json:"attributes"`
+}
+
+// parse parses the output of "terraform show -json" for a state or plan, or a state file.
+func parse(body []byte) (doc *document, err error) {
+	var probe map[string]json.RawMessage
+
+	err = json.Unmarshal(body, &probe)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse Terraform document: %w", err)
+	}
+
+	// Both formats identify themselves with a top-level property
+	switch {
+	case probe["format_version"] != nil:
+		return parseShowOutput(body)
+	case probe["version"] != nil && probe["resources"] != nil:
+		return parseStateFile(body)
+	default:
+		return nil, errors.New("unknown Terraform format: only the JSON output of \"terraform show -json\" and state files are supported")
+	}
+}
+
+// parseShowOutput parses the output of "terraform show -json" for a state or plan.
+func parseShowOutput(body []byte) (doc *document, err error) {
+	var (
+		out  showOutput
+		root *module
+	)
+
+	err = json.Unmarshal(body, &out)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse Terraform document: %w", err)
+	}
+
+	doc = &document{
+		kind:             "state",
+		terraformVersion: out.TerraformVersion,
+	}
+
+	// A plan describes the resources as they will be after the apply
+	if out.PlannedValues != nil {
+		doc.kind = "plan"
+		root = out.PlannedValues.RootModule
+	} else if out.Values != nil {
+		root = out.Values.RootModule
+	}
+
+	doc.addModule(root)
+
+	return doc, nil
+}
+
+// addModule adds the managed resources of the module and its child modules to the document.
+func (doc *document) addModule(m *module) {
+	if m == nil {
+		return
+	}
+
+	for _, r := range m.Resources {
+		if r.Mode == "managed" {
+			doc.resources = append(doc.resources, r)
+		}
+	}
+
+	for _, child := range m.ChildModules {
+		doc.addModule(child)
+	}
+}
+
+// parseStateFile parses a state file. Each instance of a resource is added as a resource of its own.
+func parseStateFile(body []byte) (doc *document, err error) {
+	var state stateFile
+
+	err = json.Unmarshal(body, &state)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse Terraform state file: %w", err)
+	}
+
+	if state.Version != supportedStateVersion {
+		return nil, fmt.Errorf("unsupported state file version %d: only version %d is supported", state.Version, supportedStateVersion)
+	}
+
+	doc = &document{
+		kind:             "state",
+		terraformVersion: state.TerraformVersion,
+	}
+
+	for _, r := range state.Resources {
+		if r.Mode != "managed" {
+			continue
+		}
+
+		for _, i := range r.Instances {
+			doc.resources = append(doc.resources, &resource{
+				Address: r.address(i.IndexKey),
+				Mode:    r.Mode,
+				Type:    r.Type,
+				Name:    r.Name,
+				Values:  i.Attributes,
+			})
+		}
+	}
+
+	return doc, nil
+}
+
+// address returns the address of the instance of the resource with the given index key, using the same notation as
+// "terraform show -json".
+func (r *stateResource) address(indexKey any) string {
+	var b strings.Builder
+
+	if r.Module != "" {
+		b.WriteString(r.Module + ".")
+	}
+	b.WriteString(r.Type + "." + r.Name)
+
+	// Instances created by count have a numeric index, the ones created by for_each a string key
+	switch key := indexKey.(type) {
+	case float64:
+		b.WriteString("[" + strconv.FormatFloat(key, 'f', -1, 64) + "]")
+	case string:
+		b.WriteString("[" + strconv.Quote(key) + "]")
+	}
+
+	return b.String()
+}
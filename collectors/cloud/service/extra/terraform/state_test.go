@@ -0,0 +1,83 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package terraform
+
+import (
+	"os"
+	"testing"
+
+	"confirmate.io/core/util/assert"
+)
+
+func Test_parse(t *testing.T) {
+	tests := []struct {
+		name    string
+		file    string
+		want    assert.Want[*document]
+		wantErr assert.WantErr
+	}{
+		{
+			name: "state",
+			file: "testdata/state.json",
+			want: func(t *testing.T, got *document, msgAndArgs ...any) bool {
+				return assert.Equal(t, "state", got.kind) &&
+					assert.Equal(t, "1.9.5", got.terraformVersion) &&
+					assert.Equal(t, 4, len(got.resources)) &&
+					assert.Equal(t, "module.storage.aws_ebs_volume.data", got.resources[2].Address)
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "plan",
+			file: "testdata/plan.json",
+			want: func(t *testing.T, got *document, msgAndArgs ...any) bool {
+				return assert.Equal(t, "plan", got.kind) &&
+					assert.Equal(t, 3, len(got.resources))
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "state file",
+			file: "testdata/terraform.tfstate",
+			want: func(t *testing.T, got *document, msgAndArgs ...any) bool {
+				return assert.Equal(t, "state", got.kind) &&
+					assert.Equal(t, 3, len(got.resources)) &&
+					assert.Equal(t, `aws_s3_bucket.assets["eu"]`, got.resources[0].Address) &&
+					assert.Equal(t, "module.functions.aws_lambda_function.handler[0]", got.resources[2].Address) &&
+					assert.Equal(t, "example-assets-eu", got.resources[0].Values["bucket"])
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "unsupported state file version",
+			file: "testdata/old.tfstate",
+			want: assert.Nil[*document],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.ErrorContains(t, err, "unsupported state file version 3")
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			body, err := os.ReadFile(tt.file)
+			assert.NoError(t, err)
+
+			got, err := parse(body)
+			tt.wantErr(t, err)
+			tt.want(t, got)
+		})
+	}
+}
@@ -0,0 +1,119 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package cloud
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"time"
+
+	collector "confirmate.io/collectors/cloud/internal/collector"
+	"confirmate.io/core/api/ontology"
+	"confirmate.io/core/api/orchestrator"
+
+	"connectrpc.com/connect"
+	"github.com/lmittmann/tint"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// inventoryAccountTypes are the resource types whose resources are listed as accounts in a resource inventory.
+var inventoryAccountTypes = []string{"Account", "ResourceGroup"}
+
+// newResourceInventory builds the resource inventory of the given resources collected by a collector. The resources
+// are counted per their most specific resource type. Regions and accounts are sorted and contain no duplicates.
+func newResourceInventory(targetOfEvaluationID string, collectorID string, toolID string, environment string, list []ontology.IsResource, collectedAt time.Time) (inventory *orchestrator.ResourceInventory) {
+	var (
+		counts = make(map[string]int64)
+	)
+
+	inventory = &orchestrator.ResourceInventory{
+		TargetOfEvaluationId: targetOfEvaluationID,
+		CollectorId:          collectorID,
+		ToolId:               toolID,
+		CollectedAt:          timestamppb.New(collectedAt),
+	}
+
+	if environment != "" {
+		inventory.Environment = &environment
+	}
+
+	for _, resource := range list {
+		types := ontology.ResourceTypes(resource)
+		if len(types) == 0 {
+			continue
+		}
+
+		counts[types[0]]++
+
+		if located, ok := resource.(interface{ GetGeoLocation() *ontology.GeoLocation }); ok && located.GetGeoLocation().GetRegion() != "" {
+			inventory.Regions = append(inventory.Regions, located.GetGeoLocation().GetRegion())
+		}
+
+		if slices.ContainsFunc(types, func(t string) bool { return slices.Contains(inventoryAccountTypes, t) }) {
+			inventory.Accounts = append(inventory.Accounts, resource.GetId())
+		}
+	}
+
+	for resourceType, count := range counts {
+		inventory.ResourceTypes = append(inventory.ResourceTypes, &orchestrator.ResourceTypeCount{
+			ResourceType: resourceType,
+			Count:        count,
+		})
+	}
+	slices.SortFunc(inventory.ResourceTypes, func(a, b *orchestrator.ResourceTypeCount) int {
+		return cmp.Compare(a.GetResourceType(), b.GetResourceType())
+	})
+
+	slices.Sort(inventory.Regions)
+	inventory.Regions = slices.Compact(inventory.Regions)
+	slices.Sort(inventory.Accounts)
+	inventory.Accounts = slices.Compact(inventory.Accounts)
+
+	return inventory
+}
+
+// storeInventory registers the resource inventory of a collector run with the orchestrator, if an orchestrator is
+// configured. Since the inventory is only informational, errors are logged but do not fail the collector run.
+func (svc *Service) storeInventory(collector collector.Collector, environment string, list []ontology.IsResource) {
+	var (
+		inventory *orchestrator.ResourceInventory
+		err       error
+	)
+
+	if svc.orchestratorClient == nil {
+		return
+	}
+
+	inventory = newResourceInventory(
+		cmp.Or(collector.TargetOfEvaluationID(), svc.GetTargetOfEvaluationId()),
+		collector.ID(),
+		svc.cloudConfig.collectorToolID,
+		environment,
+		list,
+		time.Now(),
+	)
+
+	_, err = svc.orchestratorClient.StoreResourceInventory(context.Background(), connect.NewRequest(&orchestrator.StoreResourceInventoryRequest{
+		Inventory: inventory,
+	}))
+	if err != nil {
+		log.Error("Could not store resource inventory in orchestrator", "collector", collector.Name(), tint.Err(err))
+		return
+	}
+
+	log.Debug("Stored resource inventory in orchestrator", "collector", collector.Name(), "types", len(inventory.ResourceTypes))
+}
@@ -0,0 +1,87 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package cloud
+
+import (
+	"testing"
+	"time"
+
+	"confirmate.io/collectors/cloud/internal/testdata"
+	"confirmate.io/core/api/ontology"
+	"confirmate.io/core/api/orchestrator"
+	"confirmate.io/core/util/assert"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func Test_newResourceInventory(t *testing.T) {
+	var collectedAt = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	type args struct {
+		environment string
+		list        []ontology.IsResource
+	}
+	tests := []struct {
+		name string
+		args args
+		want *orchestrator.ResourceInventory
+	}{
+		{
+			name: "no resources",
+			args: args{},
+			want: &orchestrator.ResourceInventory{
+				TargetOfEvaluationId: testdata.MockTargetOfEvaluationID1,
+				CollectorId:          "aws-collector",
+				ToolId:               testdata.MockEvidenceToolID1,
+				CollectedAt:          timestamppb.New(collectedAt),
+			},
+		},
+		{
+			name: "happy path",
+			args: args{
+				environment: "prod",
+				list: []ontology.IsResource{
+					&ontology.Account{Id: "account-1", GeoLocation: &ontology.GeoLocation{Region: "eu-central-1"}},
+					&ontology.VirtualMachine{Id: "vm-1", GeoLocation: &ontology.GeoLocation{Region: "eu-central-1"}},
+					&ontology.VirtualMachine{Id: "vm-2", GeoLocation: &ontology.GeoLocation{Region: "eu-west-1"}},
+					&ontology.ObjectStorage{Id: "storage-1"},
+				},
+			},
+			want: &orchestrator.ResourceInventory{
+				TargetOfEvaluationId: testdata.MockTargetOfEvaluationID1,
+				CollectorId:          "aws-collector",
+				ToolId:               testdata.MockEvidenceToolID1,
+				Environment:          new("prod"),
+				ResourceTypes: []*orchestrator.ResourceTypeCount{
+					{ResourceType: "Account", Count: 1},
+					{ResourceType: "ObjectStorage", Count: 1},
+					{ResourceType: "VirtualMachine", Count: 2},
+				},
+				Regions:     []string{"eu-central-1", "eu-west-1"},
+				Accounts:    []string{"account-1"},
+				CollectedAt: timestamppb.New(collectedAt),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := newResourceInventory(testdata.MockTargetOfEvaluationID1, "aws-collector", testdata.MockEvidenceToolID1,
+				tt.args.environment, tt.args.list, collectedAt)
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
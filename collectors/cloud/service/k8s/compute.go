@@ -19,6 +19,9 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"maps"
+	"slices"
+	"strconv"
 	"strings"
 
 	collector "confirmate.io/collectors/cloud/internal/collector"
@@ -26,7 +29,11 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	v1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/lmittmann/tint"
 	"k8s.io/client-go/kubernetes"
 )
 
@@ -54,12 +61,18 @@ func (d *k8sComputeCollector) List() ([]ontology.IsResource, error) {
 	// Get pods
 	pods, err := d.intf.CoreV1().Pods("").List(context.TODO(), metav1.ListOptions{})
 	if err != nil {
-		return nil, fmt.Errorf("could not list ingresses: %v", err)
+		return nil, fmt.Errorf("could not list ingresses: %w", err)
+	}
+
+	// Get network policies to check whether the ingress traffic of the pods is restricted
+	policies, err := d.intf.NetworkingV1().NetworkPolicies("").List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("could not list network policies: %w", err)
 	}
 
 	for i := range pods.Items {
 		// Get virtual machines
-		c := d.handlePod(&pods.Items[i])
+		c := d.handlePod(&pods.Items[i], policies.Items)
 		log.Info("Adding container", slog.String("id", c.GetId()))
 		list = append(list, c)
 
@@ -80,13 +93,23 @@ func (d *k8sComputeCollector) Collect() ([]ontology.IsResource, error) {
 	return d.List()
 }
 
-// handlePod returns all existing pods
-func (d *k8sComputeCollector) handlePod(pod *v1.Pod) *ontology.Container {
+// handlePod returns all existing pods. Since the ontology has no properties for the security context of a workload,
+// it is added as labels (see [LabelRunAsNonRoot], [LabelPrivileged], [LabelHostPath] and [LabelNetworkPolicy]) to the
+// labels of the pod.
+func (d *k8sComputeCollector) handlePod(pod *v1.Pod, policies []networkingv1.NetworkPolicy) *ontology.Container {
+	podLabels := make(map[string]string, len(pod.Labels)+4)
+	maps.Copy(podLabels, pod.Labels)
+
+	podLabels[LabelRunAsNonRoot] = strconv.FormatBool(runsAsNonRoot(pod))
+	podLabels[LabelPrivileged] = strconv.FormatBool(isPrivileged(pod))
+	podLabels[LabelHostPath] = strconv.FormatBool(mountsHostPath(pod))
+	podLabels[LabelNetworkPolicy] = strconv.FormatBool(hasIngressPolicy(pod, policies))
+
 	r := &ontology.Container{
 		Id:                  getContainerResourceID(pod),
 		Name:                pod.Name,
 		CreationTime:        timestamppb.New(pod.CreationTimestamp.Time),
-		Labels:              pod.Labels,
+		Labels:              podLabels,
 		Raw:                 collector.Raw(pod),
 		NetworkInterfaceIds: []string{},
 	}
@@ -94,6 +117,96 @@ func (d *k8sComputeCollector) handlePod(pod *v1.Pod) *ontology.Container {
 	return r
 }
 
+// runsAsNonRoot checks whether all containers of the pod must run as a non-root user. The security context of a
+// container takes precedence over the security context of the pod.
+func runsAsNonRoot(pod *v1.Pod) bool {
+	var (
+		nonRoot    *bool
+		user       *int64
+		containers = podContainers(pod)
+	)
+
+	if len(containers) == 0 {
+		return false
+	}
+
+	if psc := pod.Spec.SecurityContext; psc != nil {
+		nonRoot = psc.RunAsNonRoot
+		user = psc.RunAsUser
+	}
+
+	for _, c := range containers {
+		cNonRoot, cUser := nonRoot, user
+
+		if sc := c.SecurityContext; sc != nil {
+			if sc.RunAsNonRoot != nil {
+				cNonRoot = sc.RunAsNonRoot
+			}
+			if sc.RunAsUser != nil {
+				cUser = sc.RunAsUser
+			}
+		}
+
+		// An explicit non-zero user ID also prevents running as root
+		if (cNonRoot == nil || !*cNonRoot) && (cUser == nil || *cUser == 0) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// isPrivileged checks whether any container of the pod runs in privileged mode.
+func isPrivileged(pod *v1.Pod) bool {
+	for _, c := range podContainers(pod) {
+		if c.SecurityContext != nil && c.SecurityContext.Privileged != nil && *c.SecurityContext.Privileged {
+			return true
+		}
+	}
+
+	return false
+}
+
+// mountsHostPath checks whether the pod mounts a path of the host node.
+func mountsHostPath(pod *v1.Pod) bool {
+	for _, vol := range pod.Spec.Volumes {
+		if vol.HostPath != nil {
+			return true
+		}
+	}
+
+	return false
+}
+
+// hasIngressPolicy checks whether a network policy in the namespace of the pod selects the pod and restricts its
+// ingress traffic.
+func hasIngressPolicy(pod *v1.Pod, policies []networkingv1.NetworkPolicy) bool {
+	for i := range policies {
+		policy := &policies[i]
+
+		if policy.Namespace != pod.Namespace || !restrictsIngress(policy) {
+			continue
+		}
+
+		selector, err := metav1.LabelSelectorAsSelector(&policy.Spec.PodSelector)
+		if err != nil {
+			log.Warn("Could not parse pod selector of network policy", slog.String("name", policy.Name), tint.Err(err))
+			continue
+		}
+
+		if selector.Matches(labels.Set(pod.Labels)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// podContainers returns the init containers and containers of the pod.
+func podContainers(pod *v1.Pod) []v1.Container {
+	return append(slices.Clone(pod.Spec.InitContainers), pod.Spec.Containers...)
+}
+
 func getContainerResourceID(pod *v1.Pod) string {
 	return fmt.Sprintf("/namespaces/%s/containers/%s", pod.Namespace, pod.Name)
 }
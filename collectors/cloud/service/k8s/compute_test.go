@@ -26,6 +26,7 @@ import (
 
 	"google.golang.org/protobuf/testing/protocmp"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/kubernetes/fake"
@@ -130,9 +131,15 @@ func Test_k8sComputeCollector_List(t *testing.T) {
 				}
 				// Create expected ontology.Container
 				expectedContainer := &ontology.Container{
-					Id:                  podID,
-					Name:                podName,
-					Labels:              podLabel,
+					Id:   podID,
+					Name: podName,
+					Labels: map[string]string{
+						"my":               "label",
+						LabelRunAsNonRoot:  "false",
+						LabelPrivileged:    "false",
+						LabelHostPath:      "false",
+						LabelNetworkPolicy: "false",
+					},
 					NetworkInterfaceIds: []string{},
 				}
 
@@ -269,3 +276,169 @@ func Test_k8sComputeCollector_handlePodVolume(t *testing.T) {
 		})
 	}
 }
+
+func Test_runsAsNonRoot(t *testing.T) {
+	tests := []struct {
+		name string
+		pod  *corev1.Pod
+		want bool
+	}{
+		{
+			name: "no containers",
+			pod:  &corev1.Pod{},
+			want: false,
+		},
+		{
+			name: "no security context",
+			pod: &corev1.Pod{Spec: corev1.PodSpec{
+				Containers: []corev1.Container{{Name: "app"}},
+			}},
+			want: false,
+		},
+		{
+			name: "pod security context",
+			pod: &corev1.Pod{Spec: corev1.PodSpec{
+				SecurityContext: &corev1.PodSecurityContext{RunAsNonRoot: new(true)},
+				Containers:      []corev1.Container{{Name: "app"}},
+			}},
+			want: true,
+		},
+		{
+			name: "container overrides pod security context",
+			pod: &corev1.Pod{Spec: corev1.PodSpec{
+				SecurityContext: &corev1.PodSecurityContext{RunAsNonRoot: new(true)},
+				Containers: []corev1.Container{
+					{Name: "app"},
+					{Name: "sidecar", SecurityContext: &corev1.SecurityContext{RunAsNonRoot: new(false)}},
+				},
+			}},
+			want: false,
+		},
+		{
+			name: "non-zero user ID",
+			pod: &corev1.Pod{Spec: corev1.PodSpec{
+				InitContainers: []corev1.Container{{Name: "init", SecurityContext: &corev1.SecurityContext{RunAsUser: new(int64(1000))}}},
+				Containers:     []corev1.Container{{Name: "app", SecurityContext: &corev1.SecurityContext{RunAsUser: new(int64(1000))}}},
+			}},
+			want: true,
+		},
+		{
+			name: "init container runs as root",
+			pod: &corev1.Pod{Spec: corev1.PodSpec{
+				SecurityContext: &corev1.PodSecurityContext{RunAsUser: new(int64(1000))},
+				InitContainers:  []corev1.Container{{Name: "init", SecurityContext: &corev1.SecurityContext{RunAsUser: new(int64(0))}}},
+				Containers:      []corev1.Container{{Name: "app"}},
+			}},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, runsAsNonRoot(tt.pod))
+		})
+	}
+}
+
+func Test_isPrivileged(t *testing.T) {
+	tests := []struct {
+		name string
+		pod  *corev1.Pod
+		want bool
+	}{
+		{
+			name: "not privileged",
+			pod: &corev1.Pod{Spec: corev1.PodSpec{
+				Containers: []corev1.Container{{Name: "app", SecurityContext: &corev1.SecurityContext{Privileged: new(false)}}},
+			}},
+			want: false,
+		},
+		{
+			name: "privileged container",
+			pod: &corev1.Pod{Spec: corev1.PodSpec{
+				Containers: []corev1.Container{
+					{Name: "app"},
+					{Name: "agent", SecurityContext: &corev1.SecurityContext{Privileged: new(true)}},
+				},
+			}},
+			want: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, isPrivileged(tt.pod))
+		})
+	}
+}
+
+func Test_hasIngressPolicy(t *testing.T) {
+	var (
+		pod = &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "my-pod",
+				Namespace: "my-namespace",
+				Labels:    map[string]string{"app": "web"},
+			},
+		}
+	)
+
+	tests := []struct {
+		name     string
+		policies []networkingv1.NetworkPolicy
+		want     bool
+	}{
+		{
+			name: "no policies",
+			want: false,
+		},
+		{
+			name: "policy in other namespace",
+			policies: []networkingv1.NetworkPolicy{{
+				ObjectMeta: metav1.ObjectMeta{Name: "deny-all", Namespace: "other"},
+			}},
+			want: false,
+		},
+		{
+			name: "egress policy only",
+			policies: []networkingv1.NetworkPolicy{{
+				ObjectMeta: metav1.ObjectMeta{Name: "egress", Namespace: "my-namespace"},
+				Spec: networkingv1.NetworkPolicySpec{
+					PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeEgress},
+				},
+			}},
+			want: false,
+		},
+		{
+			name: "selector does not match",
+			policies: []networkingv1.NetworkPolicy{{
+				ObjectMeta: metav1.ObjectMeta{Name: "db", Namespace: "my-namespace"},
+				Spec: networkingv1.NetworkPolicySpec{
+					PodSelector: metav1.LabelSelector{MatchLabels: map[string]string{"app": "db"}},
+				},
+			}},
+			want: false,
+		},
+		{
+			name: "empty selector matches all pods",
+			policies: []networkingv1.NetworkPolicy{{
+				ObjectMeta: metav1.ObjectMeta{Name: "deny-all", Namespace: "my-namespace"},
+			}},
+			want: true,
+		},
+		{
+			name: "selector matches",
+			policies: []networkingv1.NetworkPolicy{{
+				ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "my-namespace"},
+				Spec: networkingv1.NetworkPolicySpec{
+					PodSelector: metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}},
+					PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeIngress},
+				},
+			}},
+			want: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, hasIngressPolicy(pod, tt.policies))
+		})
+	}
+}
@@ -29,6 +29,19 @@ import (
 	"k8s.io/client-go/util/homedir"
 )
 
+// Labels describing the security context of a workload. The ontology has no properties for these, so they are added
+// to the labels of the container resource of a pod with the values "true" or "false".
+const (
+	// LabelRunAsNonRoot states whether all containers of the pod must run as a non-root user.
+	LabelRunAsNonRoot = "security.confirmate.io/run-as-non-root"
+	// LabelPrivileged states whether a container of the pod runs in privileged mode.
+	LabelPrivileged = "security.confirmate.io/privileged"
+	// LabelHostPath states whether the pod mounts a path of the host node.
+	LabelHostPath = "security.confirmate.io/host-path"
+	// LabelNetworkPolicy states whether the ingress traffic of the pod is restricted by a network policy.
+	LabelNetworkPolicy = "security.confirmate.io/network-policy"
+)
+
 var log *slog.Logger
 
 func init() {
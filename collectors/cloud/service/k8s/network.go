@@ -19,6 +19,7 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"slices"
 
 	collector "confirmate.io/collectors/cloud/internal/collector"
 	"confirmate.io/core/api/ontology"
@@ -77,6 +78,19 @@ func (d *k8sNetworkCollector) List() ([]ontology.IsResource, error) {
 		list = append(list, c)
 	}
 
+	policies, err := d.intf.NetworkingV1().NetworkPolicies("").List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		return list, fmt.Errorf("could not list network policies: %w", err)
+	}
+
+	for i := range policies.Items {
+		c := d.handleNetworkPolicy(&policies.Items[i])
+
+		log.Info("Adding network policy", slog.String("id", c.GetId()))
+
+		list = append(list, c)
+	}
+
 	return list, nil
 }
 
@@ -152,3 +166,48 @@ func (d *k8sNetworkCollector) handleIngress(ingress *v1.Ingress) ontology.IsReso
 func getLoadBalancerResourceID(ingress *v1.Ingress) string {
 	return fmt.Sprintf("/namespaces/%s/ingresses/%s", ingress.Namespace, ingress.Name)
 }
+
+// handleNetworkPolicy returns a network security group for a network policy. The network policy is internet accessible
+// if it allows ingress traffic from any source.
+func (d *k8sNetworkCollector) handleNetworkPolicy(policy *v1.NetworkPolicy) ontology.IsResource {
+	return &ontology.NetworkSecurityGroup{
+		Id:                         getNetworkSecurityGroupResourceID(policy),
+		Name:                       policy.Name,
+		CreationTime:               timestamppb.New(policy.CreationTimestamp.Time),
+		Labels:                     policy.Labels,
+		Raw:                        collector.Raw(policy),
+		InternetAccessibleEndpoint: allowsIngressFromAnywhere(policy),
+	}
+}
+
+func getNetworkSecurityGroupResourceID(policy *v1.NetworkPolicy) string {
+	return fmt.Sprintf("/namespaces/%s/networkpolicies/%s", policy.Namespace, policy.Name)
+}
+
+// restrictsIngress checks whether the network policy restricts ingress traffic. If no policy types are specified,
+// Kubernetes treats the policy as an ingress policy.
+func restrictsIngress(policy *v1.NetworkPolicy) bool {
+	return len(policy.Spec.PolicyTypes) == 0 || slices.Contains(policy.Spec.PolicyTypes, v1.PolicyTypeIngress)
+}
+
+// allowsIngressFromAnywhere checks whether the network policy contains an ingress rule that allows traffic from any
+// source, i.e., a rule without peers or with an IP block covering all addresses.
+func allowsIngressFromAnywhere(policy *v1.NetworkPolicy) bool {
+	if !restrictsIngress(policy) {
+		return false
+	}
+
+	for _, rule := range policy.Spec.Ingress {
+		if len(rule.From) == 0 {
+			return true
+		}
+
+		for _, peer := range rule.From {
+			if peer.IPBlock != nil && (peer.IPBlock.CIDR == "0.0.0.0/0" || peer.IPBlock.CIDR == "::/0") {
+				return true
+			}
+		}
+	}
+
+	return false
+}
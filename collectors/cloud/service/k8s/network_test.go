@@ -144,3 +144,82 @@ func TestListIngresses(t *testing.T) {
 	assert.Equal(t, "https://myhost/test", lb.HttpEndpoints[0].Url)
 	assert.NotNil(t, (lb.HttpEndpoints)[0].TransportEncryption)
 }
+
+func TestListNetworkPolicies(t *testing.T) {
+	client := fake.NewSimpleClientset()
+
+	_, err := client.NetworkingV1().NetworkPolicies("my-namespace").Create(context.TODO(), &networkingv1.NetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "allow-all", CreationTimestamp: metav1.Now()},
+		Spec: networkingv1.NetworkPolicySpec{
+			Ingress: []networkingv1.NetworkPolicyIngressRule{{}},
+		},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		t.Fatalf("error injecting network policy add: %v", err)
+	}
+
+	d := NewKubernetesNetworkCollector(client, testdata.MockTargetOfEvaluationID1)
+
+	list, err := d.List()
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(list))
+
+	nsg := assert.Is[*ontology.NetworkSecurityGroup](t, list[0])
+	assert.Equal(t, "allow-all", nsg.Name)
+	assert.Equal(t, "/namespaces/my-namespace/networkpolicies/allow-all", nsg.Id)
+	assert.True(t, nsg.InternetAccessibleEndpoint)
+	assert.NotEmpty(t, nsg.Raw)
+}
+
+func Test_allowsIngressFromAnywhere(t *testing.T) {
+	tests := []struct {
+		name   string
+		policy *networkingv1.NetworkPolicy
+		want   bool
+	}{
+		{
+			name:   "deny all ingress",
+			policy: &networkingv1.NetworkPolicy{},
+			want:   false,
+		},
+		{
+			name: "egress policy only",
+			policy: &networkingv1.NetworkPolicy{Spec: networkingv1.NetworkPolicySpec{
+				PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeEgress},
+				Ingress:     []networkingv1.NetworkPolicyIngressRule{{}},
+			}},
+			want: false,
+		},
+		{
+			name: "rule without peers",
+			policy: &networkingv1.NetworkPolicy{Spec: networkingv1.NetworkPolicySpec{
+				Ingress: []networkingv1.NetworkPolicyIngressRule{{}},
+			}},
+			want: true,
+		},
+		{
+			name: "rule with restricted IP block",
+			policy: &networkingv1.NetworkPolicy{Spec: networkingv1.NetworkPolicySpec{
+				Ingress: []networkingv1.NetworkPolicyIngressRule{{
+					From: []networkingv1.NetworkPolicyPeer{{IPBlock: &networkingv1.IPBlock{CIDR: "10.0.0.0/8"}}},
+				}},
+			}},
+			want: false,
+		},
+		{
+			name: "rule with unrestricted IP block",
+			policy: &networkingv1.NetworkPolicy{Spec: networkingv1.NetworkPolicySpec{
+				Ingress: []networkingv1.NetworkPolicyIngressRule{{
+					From: []networkingv1.NetworkPolicyPeer{{IPBlock: &networkingv1.IPBlock{CIDR: "0.0.0.0/0"}}},
+				}},
+			}},
+			want: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, allowsIngressFromAnywhere(tt.policy))
+		})
+	}
+}
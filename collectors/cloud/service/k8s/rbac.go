@@ -0,0 +1,203 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	collector "confirmate.io/collectors/cloud/internal/collector"
+	"confirmate.io/core/api/ontology"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// clusterAdminRole is the name of the built-in cluster role that grants unrestricted access to the cluster.
+const clusterAdminRole = "cluster-admin"
+
+type k8sRBACCollector struct{ k8sCollector }
+
+func NewKubernetesRBACCollector(intf kubernetes.Interface, TargetOfEvaluationID string) collector.Collector {
+	return &k8sRBACCollector{k8sCollector{
+		intf: intf,
+		ctID: TargetOfEvaluationID,
+		id:   collectorID("k8s-rbac", TargetOfEvaluationID),
+	}}
+}
+
+func (*k8sRBACCollector) Name() string {
+	return "Kubernetes RBAC"
+}
+
+func (*k8sRBACCollector) Description() string {
+	return "Collect Kubernetes RBAC bindings and service accounts."
+}
+
+func (d *k8sRBACCollector) List() ([]ontology.IsResource, error) {
+	var (
+		list       []ontology.IsResource
+		privileged = make(map[string]bool)
+	)
+
+	roleBindings, err := d.intf.RbacV1().RoleBindings("").List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("could not list role bindings: %w", err)
+	}
+
+	for i := range roleBindings.Items {
+		rb := &roleBindings.Items[i]
+		c := d.handleRoleBinding(rb)
+
+		log.Info("Adding role binding", slog.String("id", c.GetId()))
+
+		list = append(list, c)
+		markPrivileged(privileged, rb.RoleRef, rb.Subjects, rb.Namespace)
+	}
+
+	clusterRoleBindings, err := d.intf.RbacV1().ClusterRoleBindings().List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("could not list cluster role bindings: %w", err)
+	}
+
+	for i := range clusterRoleBindings.Items {
+		crb := &clusterRoleBindings.Items[i]
+		c := d.handleClusterRoleBinding(crb)
+
+		log.Info("Adding cluster role binding", slog.String("id", c.GetId()))
+
+		list = append(list, c)
+		markPrivileged(privileged, crb.RoleRef, crb.Subjects, "")
+	}
+
+	serviceAccounts, err := d.intf.CoreV1().ServiceAccounts("").List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("could not list service accounts: %w", err)
+	}
+
+	for i := range serviceAccounts.Items {
+		sa := &serviceAccounts.Items[i]
+		c := d.handleServiceAccount(sa, privileged[getIdentityResourceID(sa.Namespace, sa.Name)])
+
+		log.Info("Adding service account", slog.String("id", c.GetId()))
+
+		list = append(list, c)
+	}
+
+	return list, nil
+}
+
+// Collect is the core collection contract and delegates to the existing List implementation.
+func (d *k8sRBACCollector) Collect() ([]ontology.IsResource, error) {
+	return d.List()
+}
+
+// handleRoleBinding returns a role assignment for a namespaced role binding.
+func (d *k8sRBACCollector) handleRoleBinding(rb *rbacv1.RoleBinding) ontology.IsResource {
+	return &ontology.RoleAssignment{
+		Id:            fmt.Sprintf("/namespaces/%s/rolebindings/%s", rb.Namespace, rb.Name),
+		Name:          rb.Name,
+		Description:   roleBindingDescription(rb.RoleRef, rb.Subjects),
+		CreationTime:  timestamppb.New(rb.CreationTimestamp.Time),
+		Labels:        rb.Labels,
+		Raw:           collector.Raw(rb),
+		Activated:     true,
+		Authorization: rbacAuthorization(),
+	}
+}
+
+// handleClusterRoleBinding returns a role assignment for a cluster-wide role binding.
+func (d *k8sRBACCollector) handleClusterRoleBinding(crb *rbacv1.ClusterRoleBinding) ontology.IsResource {
+	return &ontology.RoleAssignment{
+		Id:            fmt.Sprintf("/clusterrolebindings/%s", crb.Name),
+		Name:          crb.Name,
+		Description:   roleBindingDescription(crb.RoleRef, crb.Subjects),
+		CreationTime:  timestamppb.New(crb.CreationTimestamp.Time),
+		Labels:        crb.Labels,
+		Raw:           collector.Raw(crb),
+		Activated:     true,
+		Authorization: rbacAuthorization(),
+	}
+}
+
+// handleServiceAccount returns an identity for a service account. The identity is privileged if the service account is
+// bound to the cluster-admin role.
+func (d *k8sRBACCollector) handleServiceAccount(sa *corev1.ServiceAccount, privileged bool) ontology.IsResource {
+	return &ontology.Identity{
+		Id:           getIdentityResourceID(sa.Namespace, sa.Name),
+		Name:         sa.Name,
+		CreationTime: timestamppb.New(sa.CreationTimestamp.Time),
+		Labels:       sa.Labels,
+		Raw:          collector.Raw(sa),
+		Activated:    true,
+		Privileged:   privileged,
+	}
+}
+
+func getIdentityResourceID(namespace, name string) string {
+	return fmt.Sprintf("/namespaces/%s/serviceaccounts/%s", namespace, name)
+}
+
+// rbacAuthorization returns the authorization of a role assignment, which is always role-based in Kubernetes.
+func rbacAuthorization() *ontology.Authorization {
+	return &ontology.Authorization{
+		Type: &ontology.Authorization_Rbac{
+			Rbac: &ontology.RBAC{},
+		},
+	}
+}
+
+// roleBindingDescription describes which subjects are bound to which role, e.g., "ClusterRole cluster-admin bound to
+// ServiceAccount kube-system/default".
+func roleBindingDescription(ref rbacv1.RoleRef, subjects []rbacv1.Subject) string {
+	var names []string
+
+	for _, s := range subjects {
+		if s.Namespace != "" {
+			names = append(names, fmt.Sprintf("%s %s/%s", s.Kind, s.Namespace, s.Name))
+		} else {
+			names = append(names, fmt.Sprintf("%s %s", s.Kind, s.Name))
+		}
+	}
+
+	return fmt.Sprintf("%s %s bound to %s", ref.Kind, ref.Name, strings.Join(names, ", "))
+}
+
+// markPrivileged marks all service accounts of the subjects as privileged if the binding refers to the cluster-admin
+// role. Service account subjects without namespace belong to the namespace of the binding.
+func markPrivileged(privileged map[string]bool, ref rbacv1.RoleRef, subjects []rbacv1.Subject, namespace string) {
+	if ref.Kind != "ClusterRole" || ref.Name != clusterAdminRole {
+		return
+	}
+
+	for _, s := range subjects {
+		if s.Kind != rbacv1.ServiceAccountKind {
+			continue
+		}
+
+		ns := s.Namespace
+		if ns == "" {
+			ns = namespace
+		}
+
+		privileged[getIdentityResourceID(ns, s.Name)] = true
+	}
+}
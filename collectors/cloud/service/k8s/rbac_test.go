@@ -0,0 +1,164 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package k8s
+
+import (
+	"context"
+	"testing"
+
+	collector "confirmate.io/collectors/cloud/internal/collector"
+	"confirmate.io/collectors/cloud/internal/testdata"
+	"confirmate.io/core/api/ontology"
+	"confirmate.io/core/util/assert"
+
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestNewKubernetesRBACCollector(t *testing.T) {
+	type args struct {
+		intf                 kubernetes.Interface
+		TargetOfEvaluationID string
+	}
+	tests := []struct {
+		name string
+		args args
+		want collector.Collector
+	}{
+		{
+			name: "Happy path",
+			args: args{
+				intf:                 &fake.Clientset{},
+				TargetOfEvaluationID: testdata.MockTargetOfEvaluationID1,
+			},
+			want: &k8sRBACCollector{
+				k8sCollector: k8sCollector{
+					intf: &fake.Clientset{},
+					ctID: testdata.MockTargetOfEvaluationID1,
+					id:   collectorID("k8s-rbac", testdata.MockTargetOfEvaluationID1),
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NewKubernetesRBACCollector(tt.args.intf, tt.args.TargetOfEvaluationID)
+			assert.Equal(t, tt.want, got, assert.CompareAllUnexported())
+			assert.Equal(t, "Kubernetes RBAC", got.Name())
+		})
+	}
+}
+
+func Test_k8sRBACCollector_List(t *testing.T) {
+	client := fake.NewSimpleClientset()
+
+	_, err := client.RbacV1().RoleBindings("my-namespace").Create(context.TODO(), &rbacv1.RoleBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: "read-pods", Namespace: "my-namespace"},
+		RoleRef:    rbacv1.RoleRef{Kind: "Role", Name: "pod-reader"},
+		Subjects:   []rbacv1.Subject{{Kind: rbacv1.UserKind, Name: "jane"}},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		t.Fatalf("error injecting role binding add: %v", err)
+	}
+
+	_, err = client.RbacV1().ClusterRoleBindings().Create(context.TODO(), &rbacv1.ClusterRoleBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: "admin-binding"},
+		RoleRef:    rbacv1.RoleRef{Kind: "ClusterRole", Name: clusterAdminRole},
+		Subjects:   []rbacv1.Subject{{Kind: rbacv1.ServiceAccountKind, Namespace: "my-namespace", Name: "deployer"}},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		t.Fatalf("error injecting cluster role binding add: %v", err)
+	}
+
+	for _, name := range []string{"default", "deployer"} {
+		_, err = client.CoreV1().ServiceAccounts("my-namespace").Create(context.TODO(), &corev1.ServiceAccount{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "my-namespace"},
+		}, metav1.CreateOptions{})
+		if err != nil {
+			t.Fatalf("error injecting service account add: %v", err)
+		}
+	}
+
+	d := NewKubernetesRBACCollector(client, testdata.MockTargetOfEvaluationID1)
+
+	list, err := d.List()
+	assert.NoError(t, err)
+	assert.Equal(t, 4, len(list))
+
+	// The order of resources of the same kind is not guaranteed by the fake client
+	resources := make(map[string]ontology.IsResource)
+	for _, r := range list {
+		resources[r.GetId()] = r
+	}
+
+	rb := assert.Is[*ontology.RoleAssignment](t, resources["/namespaces/my-namespace/rolebindings/read-pods"])
+	assert.Equal(t, "Role pod-reader bound to User jane", rb.Description)
+	assert.NotNil(t, rb.Authorization.GetRbac())
+
+	crb := assert.Is[*ontology.RoleAssignment](t, resources["/clusterrolebindings/admin-binding"])
+	assert.Equal(t, "ClusterRole cluster-admin bound to ServiceAccount my-namespace/deployer", crb.Description)
+
+	sa := assert.Is[*ontology.Identity](t, resources["/namespaces/my-namespace/serviceaccounts/default"])
+	assert.False(t, sa.Privileged)
+
+	sa = assert.Is[*ontology.Identity](t, resources["/namespaces/my-namespace/serviceaccounts/deployer"])
+	assert.True(t, sa.Privileged)
+}
+
+func Test_markPrivileged(t *testing.T) {
+	type args struct {
+		ref       rbacv1.RoleRef
+		subjects  []rbacv1.Subject
+		namespace string
+	}
+	tests := []struct {
+		name string
+		args args
+		want map[string]bool
+	}{
+		{
+			name: "not cluster-admin",
+			args: args{
+				ref:      rbacv1.RoleRef{Kind: "ClusterRole", Name: "view"},
+				subjects: []rbacv1.Subject{{Kind: rbacv1.ServiceAccountKind, Namespace: "ns", Name: "sa"}},
+			},
+			want: map[string]bool{},
+		},
+		{
+			name: "cluster-admin in role binding",
+			args: args{
+				ref: rbacv1.RoleRef{Kind: "ClusterRole", Name: clusterAdminRole},
+				subjects: []rbacv1.Subject{
+					{Kind: rbacv1.UserKind, Name: "jane"},
+					{Kind: rbacv1.ServiceAccountKind, Name: "sa"},
+				},
+				namespace: "ns",
+			},
+			want: map[string]bool{"/namespaces/ns/serviceaccounts/sa": true},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := make(map[string]bool)
+
+			markPrivileged(got, tt.args.ref, tt.args.subjects, tt.args.namespace)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -53,7 +53,7 @@ func (d *k8sStorageCollector) List() ([]ontology.IsResource, error) {
 	// Note: Volumes exist in the context of a pod and cannot be created on its own, PersistentVolumes are first class objects with its own lifecycle.
 	pvc, err := d.intf.CoreV1().PersistentVolumes().List(context.TODO(), metav1.ListOptions{})
 	if err != nil {
-		return nil, fmt.Errorf("could not list ingresses: %v", err)
+		return nil, fmt.Errorf("could not list ingresses: %w", err)
 	}
 
 	for i := range pvc.Items {
@@ -35,7 +35,7 @@ func (d *openstackCollector) collectDomains() (list []ontology.IsResource, err e
 		log.Debug("Could not collect domains due to insufficient permissions, but we can proceed with less domain information", tint.Err(err))
 
 		if d.domain.domainID == "" {
-			err := fmt.Errorf("domain ID is not available: %v", err)
+			err := fmt.Errorf("domain ID is not available: %w", err)
 			return nil, err
 		}
 
@@ -61,7 +61,7 @@ func (d *openstackCollector) collectProjects() (list []ontology.IsResource, err
 		log.Debug("Could not collect projects/tenants due to insufficient permissions, but we can proceed with less project/tenant information", tint.Err(err))
 
 		if d.project.projectID == "" {
-			err := fmt.Errorf("domain ID is not available: %v", err)
+			err := fmt.Errorf("domain ID is not available: %w", err)
 			return nil, err
 		}
 
@@ -18,13 +18,21 @@ package openstack
 import (
 	"confirmate.io/core/api/ontology"
 
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/security/groups"
 	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/networks"
 )
 
 // collectNetworkInterfaces collects network interfaces
 func (d *openstackCollector) collectNetworkInterfaces() (list []ontology.IsResource, err error) {
 	var opts networks.ListOptsBuilder = &networks.ListOpts{}
-	list, err = genericList(d, d.networkClient, networks.List, d.handleNetworkInterfaces, networks.ExtractNetworks, opts)
+	list, err = genericList(d, d.networkClient, networks.List, d.handleNetworkInterfaces, extractNetworks, opts)
+
+	return
+}
+
+// collectSecurityGroups collects network security groups
+func (d *openstackCollector) collectSecurityGroups() (list []ontology.IsResource, err error) {
+	list, err = genericList(d, d.networkClient, groups.List, d.handleSecurityGroup, groups.ExtractGroups, groups.ListOpts{})
 
 	return
 }
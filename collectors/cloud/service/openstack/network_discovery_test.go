@@ -83,8 +83,17 @@ func Test_openstackCollector_collectNetworkInterfaces(t *testing.T) {
 					GeoLocation: &ontology.GeoLocation{
 						Region: "test region",
 					},
-					Labels:   map[string]string{},
-					ParentId: new("4fd44f30292945e481c7b8a0c8908869"),
+					Labels:                     map[string]string{},
+					ParentId:                   new("4fd44f30292945e481c7b8a0c8908869"),
+					InternetAccessibleEndpoint: true,
+					AccessRestriction: &ontology.AccessRestriction{
+						Type: &ontology.AccessRestriction_L3Firewall{
+							L3Firewall: &ontology.L3Firewall{
+								Enabled: true,
+								Inbound: true,
+							},
+						},
+					},
 				}
 
 				got0 := got[0].(*ontology.NetworkInterface)
@@ -113,3 +122,94 @@ func Test_openstackCollector_collectNetworkInterfaces(t *testing.T) {
 		})
 	}
 }
+
+func Test_openstackCollector_collectSecurityGroups(t *testing.T) {
+	fakeServer := testhelper.SetupHTTP()
+	defer fakeServer.Teardown()
+	openstacktest.HandleSecurityGroupListSuccessfully(t, fakeServer)
+
+	type fields struct {
+		clients clients
+		region  string
+		domain  *domain
+		project *project
+	}
+	tests := []struct {
+		name     string
+		fields   fields
+		wantList assert.Want[[]ontology.IsResource]
+		wantErr  assert.WantErr
+	}{
+		{
+			name: "error: network client not initialized",
+			fields: fields{
+				region:  "test region",
+				domain:  &domain{},
+				project: &project{},
+			},
+			wantList: assert.Nil[[]ontology.IsResource],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.ErrorContains(t, err, "network client not initialized")
+			},
+		},
+		{
+			name: "Happy path",
+			fields: fields{
+				clients: clients{
+					provider: &gophercloud.ProviderClient{
+						TokenID: client.TokenID,
+						EndpointLocator: func(eo gophercloud.EndpointOpts) (string, error) {
+							return fakeServer.Endpoint(), nil
+						},
+					},
+					networkClient: client.ServiceClient(fakeServer),
+				},
+				region:  "test region",
+				domain:  &domain{},
+				project: &project{},
+			},
+			wantList: func(t *testing.T, got []ontology.IsResource, msgAndArgs ...any) bool {
+				assert.Equal(t, 2, len(got))
+
+				t1, err := time.Parse(time.RFC3339, "2019-06-30T04:15:37Z")
+				assert.NoError(t, err)
+
+				want := &ontology.NetworkSecurityGroup{
+					Id:                         "2076db17-a522-4506-91de-c6dd8e837028",
+					Name:                       "web",
+					Description:                "web",
+					CreationTime:               timestamppb.New(t1),
+					InternetAccessibleEndpoint: true,
+					GeoLocation: &ontology.GeoLocation{
+						Region: "test region",
+					},
+					Labels:   map[string]string{"web": ""},
+					ParentId: new("e4f50856753b4dc6afee5fa6b9b6c550"),
+				}
+
+				assert.False(t, got[0].(*ontology.NetworkSecurityGroup).GetInternetAccessibleEndpoint())
+
+				got1 := got[1].(*ontology.NetworkSecurityGroup)
+
+				assert.NotEmpty(t, got1.GetRaw())
+				got1.Raw = ""
+				return assert.Equal(t, want, got1)
+			},
+			wantErr: assert.NoError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := &openstackCollector{
+				clients: tt.fields.clients,
+				region:  tt.fields.region,
+				domain:  tt.fields.domain,
+				project: tt.fields.project,
+			}
+			gotList, err := d.collectSecurityGroups()
+
+			tt.wantList(t, gotList)
+			tt.wantErr(t, err)
+		})
+	}
+}
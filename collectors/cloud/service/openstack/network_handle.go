@@ -21,26 +21,58 @@ import (
 	collector "confirmate.io/collectors/cloud/internal/collector"
 	"confirmate.io/core/api/ontology"
 
-	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/networks"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/security/groups"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
-// handleNetworkInterfaces creates a network interface resource based on the CSC Hub Ontology
-func (d *openstackCollector) handleNetworkInterfaces(network *networks.Network) (ontology.IsResource, error) {
+// handleNetworkInterfaces creates a network interface resource based on the CSC Hub Ontology. External networks are
+// reachable from the internet and the port security of a network determines whether the security groups are enforced on
+// its ports.
+func (d *openstackCollector) handleNetworkInterfaces(network *networkWithExtensions) (ontology.IsResource, error) {
 	r := &ontology.NetworkInterface{
-		Id:           network.ID,
-		Name:         network.Name,
-		Description:  network.Description,
-		CreationTime: timestamppb.New(network.CreatedAt),
+		Id:                         network.ID,
+		Name:                       network.Name,
+		Description:                network.Description,
+		CreationTime:               timestamppb.New(network.CreatedAt),
+		InternetAccessibleEndpoint: network.External,
 		GeoLocation: &ontology.GeoLocation{
 			Region: d.region,
 		},
 		Labels:   labels(new(network.Tags)),
 		ParentId: new(network.ProjectID),
-		Raw:      collector.Raw(network),
+		AccessRestriction: &ontology.AccessRestriction{
+			Type: &ontology.AccessRestriction_L3Firewall{
+				L3Firewall: &ontology.L3Firewall{
+					Enabled: network.PortSecurityEnabled,
+					Inbound: network.PortSecurityEnabled,
+				},
+			},
+		},
+		Raw: collector.Raw(network),
 	}
 
 	log.Info("Adding network interface", slog.String("name", network.Name))
 
 	return r, nil
 }
+
+// handleSecurityGroup creates a network security group resource based on the CSC Hub Ontology
+func (d *openstackCollector) handleSecurityGroup(group *groups.SecGroup) (ontology.IsResource, error) {
+	r := &ontology.NetworkSecurityGroup{
+		Id:                         group.ID,
+		Name:                       group.Name,
+		Description:                group.Description,
+		CreationTime:               timestamppb.New(group.CreatedAt),
+		InternetAccessibleEndpoint: internetAccessible(group),
+		GeoLocation: &ontology.GeoLocation{
+			Region: d.region,
+		},
+		Labels:   labels(new(group.Tags)),
+		ParentId: new(projectID(group)),
+		Raw:      collector.Raw(group),
+	}
+
+	log.Info("Adding network security group", slog.String("name", group.Name))
+
+	return r, nil
+}
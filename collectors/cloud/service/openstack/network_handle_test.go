@@ -23,6 +23,10 @@ import (
 	"confirmate.io/core/api/ontology"
 	"confirmate.io/core/util/assert"
 	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/external"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/portsecurity"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/security/groups"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/security/rules"
 	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/networks"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -39,7 +43,7 @@ func Test_openstackCollector_handleNetworkInterfaces(t *testing.T) {
 		project  *project
 	}
 	type args struct {
-		network *networks.Network
+		network *networkWithExtensions
 	}
 	tests := []struct {
 		name    string
@@ -54,11 +58,19 @@ func Test_openstackCollector_handleNetworkInterfaces(t *testing.T) {
 				region: "test region",
 			},
 			args: args{
-				network: &networks.Network{
-					ID:        testdata.MockOpenstackNetworkID1,
-					Name:      testdata.MockOpenstackNetworkName1,
-					ProjectID: testdata.MockOpenstackServerTenantID,
-					CreatedAt: testTime,
+				network: &networkWithExtensions{
+					Network: networks.Network{
+						ID:        testdata.MockOpenstackNetworkID1,
+						Name:      testdata.MockOpenstackNetworkName1,
+						ProjectID: testdata.MockOpenstackServerTenantID,
+						CreatedAt: testTime,
+					},
+					NetworkExternalExt: external.NetworkExternalExt{
+						External: true,
+					},
+					PortSecurityExt: portsecurity.PortSecurityExt{
+						PortSecurityEnabled: true,
+					},
 				},
 			},
 			want: func(t *testing.T, got ontology.IsResource, msgAndArgs ...any) bool {
@@ -69,7 +81,16 @@ func Test_openstackCollector_handleNetworkInterfaces(t *testing.T) {
 					GeoLocation: &ontology.GeoLocation{
 						Region: "test region",
 					},
-					ParentId: new(testdata.MockOpenstackServerTenantID),
+					ParentId:                   new(testdata.MockOpenstackServerTenantID),
+					InternetAccessibleEndpoint: true,
+					AccessRestriction: &ontology.AccessRestriction{
+						Type: &ontology.AccessRestriction_L3Firewall{
+							L3Firewall: &ontology.L3Firewall{
+								Enabled: true,
+								Inbound: true,
+							},
+						},
+					},
 				}
 
 				gotNew := got.(*ontology.NetworkInterface)
@@ -98,3 +119,119 @@ func Test_openstackCollector_handleNetworkInterfaces(t *testing.T) {
 		})
 	}
 }
+
+func Test_openstackCollector_handleSecurityGroup(t *testing.T) {
+	testTime := time.Date(2000, 01, 20, 9, 20, 12, 123, time.UTC)
+
+	type fields struct {
+		region string
+	}
+	type args struct {
+		group *groups.SecGroup
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    assert.Want[ontology.IsResource]
+		wantErr assert.WantErr
+	}{
+		{
+			name: "Happy path: ingress restricted to remote group",
+			fields: fields{
+				region: "test region",
+			},
+			args: args{
+				group: &groups.SecGroup{
+					ID:        testdata.MockOpenstackSecurityGroupID1,
+					Name:      testdata.MockOpenstackSecurityGroupName1,
+					TenantID:  testdata.MockOpenstackServerTenantID,
+					CreatedAt: testTime,
+					Rules: []rules.SecGroupRule{
+						{
+							Direction:     string(rules.DirIngress),
+							RemoteGroupID: testdata.MockOpenstackSecurityGroupID1,
+						},
+						{
+							Direction: string(rules.DirEgress),
+						},
+					},
+				},
+			},
+			want: func(t *testing.T, got ontology.IsResource, msgAndArgs ...any) bool {
+				want := &ontology.NetworkSecurityGroup{
+					Id:           testdata.MockOpenstackSecurityGroupID1,
+					Name:         testdata.MockOpenstackSecurityGroupName1,
+					CreationTime: timestamppb.New(testTime),
+					GeoLocation: &ontology.GeoLocation{
+						Region: "test region",
+					},
+					ParentId: new(testdata.MockOpenstackServerTenantID),
+				}
+
+				gotNew := got.(*ontology.NetworkSecurityGroup)
+
+				assert.NotEmpty(t, gotNew.GetRaw())
+				gotNew.Raw = ""
+				return assert.Equal(t, want, gotNew)
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "Happy path: ingress from the internet",
+			fields: fields{
+				region: "test region",
+			},
+			args: args{
+				group: &groups.SecGroup{
+					ID:        testdata.MockOpenstackSecurityGroupID1,
+					Name:      testdata.MockOpenstackSecurityGroupName1,
+					ProjectID: testdata.MockOpenstackProjectID1,
+					TenantID:  testdata.MockOpenstackServerTenantID,
+					CreatedAt: testTime,
+					Tags:      []string{"web"},
+					Rules: []rules.SecGroupRule{
+						{
+							Direction:      string(rules.DirIngress),
+							Protocol:       "tcp",
+							PortRangeMin:   443,
+							PortRangeMax:   443,
+							RemoteIPPrefix: "::/0",
+						},
+					},
+				},
+			},
+			want: func(t *testing.T, got ontology.IsResource, msgAndArgs ...any) bool {
+				want := &ontology.NetworkSecurityGroup{
+					Id:                         testdata.MockOpenstackSecurityGroupID1,
+					Name:                       testdata.MockOpenstackSecurityGroupName1,
+					CreationTime:               timestamppb.New(testTime),
+					InternetAccessibleEndpoint: true,
+					GeoLocation: &ontology.GeoLocation{
+						Region: "test region",
+					},
+					Labels:   map[string]string{"web": ""},
+					ParentId: new(testdata.MockOpenstackProjectID1),
+				}
+
+				gotNew := got.(*ontology.NetworkSecurityGroup)
+
+				assert.NotEmpty(t, gotNew.GetRaw())
+				gotNew.Raw = ""
+				return assert.Equal(t, want, gotNew)
+			},
+			wantErr: assert.NoError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := &openstackCollector{
+				region: tt.fields.region,
+			}
+			got, err := d.handleSecurityGroup(tt.args.group)
+
+			tt.want(t, got)
+			tt.wantErr(t, err)
+		})
+	}
+}
@@ -14,3 +14,56 @@
 // This file is part of Confirmate Core.
 
 package openstack
+
+import (
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/external"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/portsecurity"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/security/groups"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/security/rules"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/networks"
+	"github.com/gophercloud/gophercloud/v2/pagination"
+)
+
+// networkWithExtensions is a Neutron network including the attributes of the external network and port security extensions.
+type networkWithExtensions struct {
+	networks.Network
+	external.NetworkExternalExt
+	portsecurity.PortSecurityExt
+}
+
+// extractNetworks extracts the networks including their extension attributes from a page.
+func extractNetworks(p pagination.Page) (list []networkWithExtensions, err error) {
+	err = networks.ExtractNetworksInto(p, &list)
+
+	return
+}
+
+// internetAccessible checks if the security group contains an ingress rule that allows traffic from any IP address.
+// A rule without remote IP prefix and remote group allows traffic from any IP address as well.
+func internetAccessible(group *groups.SecGroup) bool {
+	for _, rule := range group.Rules {
+		if rule.Direction != string(rules.DirIngress) {
+			continue
+		}
+
+		switch rule.RemoteIPPrefix {
+		case "0.0.0.0/0", "::/0":
+			return true
+		case "":
+			if rule.RemoteGroupID == "" {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// projectID returns the project ID of the security group and falls back to the tenant ID for older Neutron versions.
+func projectID(group *groups.SecGroup) string {
+	if group.ProjectID != "" {
+		return group.ProjectID
+	}
+
+	return group.TenantID
+}
@@ -222,17 +222,19 @@ func NewAuthorizer() (gophercloud.AuthOptions, error) {
 // List collects the following OpenStack resource types and translates them into the CSC Hub Ontology:
 // * Servers
 // * Network interfaces
+// * Network security groups
 // * Block storages
 // * Domains
 // * Projects
 func (d *openstackCollector) List() (list []ontology.IsResource, err error) {
 	var (
-		servers  []ontology.IsResource
-		networks []ontology.IsResource
-		storages []ontology.IsResource
-		projects []ontology.IsResource
-		domains  []ontology.IsResource
-		clusters []ontology.IsResource
+		servers        []ontology.IsResource
+		networks       []ontology.IsResource
+		securityGroups []ontology.IsResource
+		storages       []ontology.IsResource
+		projects       []ontology.IsResource
+		domains        []ontology.IsResource
+		clusters       []ontology.IsResource
 	)
 
 	if err = d.authorize(); err != nil {
@@ -254,6 +256,13 @@ func (d *openstackCollector) List() (list []ontology.IsResource, err error) {
 	}
 	list = append(list, networks...)
 
+	// Collect network security groups
+	securityGroups, err = d.collectSecurityGroups()
+	if err != nil {
+		log.Error("could not collect network security groups", tint.Err(err))
+	}
+	list = append(list, securityGroups...)
+
 	// Collect block storage
 	storages, err = d.collectBlockStorage()
 	if err != nil {
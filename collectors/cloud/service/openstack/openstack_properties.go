@@ -24,7 +24,7 @@ import (
 	"github.com/gophercloud/gophercloud/v2/openstack/compute/v2/attachinterfaces"
 	"github.com/gophercloud/gophercloud/v2/openstack/compute/v2/servers"
 	"github.com/gophercloud/gophercloud/v2/openstack/containerinfra/v1/clusters"
-	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/networks"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/security/groups"
 	"github.com/gophercloud/gophercloud/v2/pagination"
 )
 
@@ -80,9 +80,12 @@ func (d *openstackCollector) setProjectInfo(x interface{}) {
 	case []servers.Server:
 		d.project.projectID = v[0].TenantID
 		d.project.projectName = v[0].TenantID // it is not possible to extract the project name
-	case []networks.Network:
+	case []networkWithExtensions:
 		d.project.projectID = v[0].TenantID
 		d.project.projectName = v[0].TenantID // it is not possible to extract the project name
+	case []groups.SecGroup:
+		d.project.projectID = projectID(&v[0])
+		d.project.projectName = projectID(&v[0]) // it is not possible to extract the project name
 	case []clusters.Cluster:
 		d.project.projectID = v[0].ProjectID
 		d.project.projectName = v[0].ProjectID // it is not possible to extract the project name
@@ -458,6 +458,24 @@ func Test_openstackCollector_List(t *testing.T) {
 			},
 			wantErr: assert.NoError,
 		},
+		{
+			name: "error collect network security groups",
+			fields: fields{
+				testhelper: "securitygroups",
+				authOpts: &gophercloud.AuthOptions{
+					IdentityEndpoint: testdata.MockOpenstackIdentityEndpoint,
+					Username:         testdata.MockOpenstackUsername,
+					Password:         testdata.MockOpenstackPassword,
+					TenantName:       testdata.MockOpenstackTenantName,
+				},
+				project: &project{},
+				domain:  &domain{},
+			},
+			want: func(t *testing.T, got []ontology.IsResource, msgAndArgs ...any) bool {
+				return assert.Equal(t, 6, len(got))
+			},
+			wantErr: assert.NoError,
+		},
 		{
 			name: "error collect block storage",
 			fields: fields{
@@ -472,7 +490,7 @@ func Test_openstackCollector_List(t *testing.T) {
 				domain:  &domain{},
 			},
 			want: func(t *testing.T, got []ontology.IsResource, msgAndArgs ...any) bool {
-				return assert.Equal(t, 6, len(got))
+				return assert.Equal(t, 8, len(got))
 			},
 			wantErr: assert.NoError,
 		},
@@ -490,7 +508,7 @@ func Test_openstackCollector_List(t *testing.T) {
 				domain:  &domain{},
 			},
 			want: func(t *testing.T, got []ontology.IsResource, msgAndArgs ...any) bool {
-				return assert.Equal(t, 8, len(got))
+				return assert.Equal(t, 10, len(got))
 			},
 			wantErr: assert.NoError,
 		},
@@ -517,7 +535,7 @@ func Test_openstackCollector_List(t *testing.T) {
 					Raw:      "",
 				}
 
-				got0 := got[9].(*ontology.ResourceGroup)
+				got0 := got[11].(*ontology.ResourceGroup)
 				assert.NotEmpty(t, got0.GetRaw())
 				got0.Raw = ""
 				return assert.Equal(t, want, got0)
@@ -538,7 +556,7 @@ func Test_openstackCollector_List(t *testing.T) {
 				domain:  &domain{},
 			},
 			want: func(t *testing.T, got []ontology.IsResource, msgAndArgs ...any) bool {
-				return assert.Equal(t, 10, len(got))
+				return assert.Equal(t, 12, len(got))
 			},
 			wantErr: assert.NoError,
 		},
@@ -558,7 +576,7 @@ func Test_openstackCollector_List(t *testing.T) {
 				},
 			},
 			want: func(t *testing.T, got []ontology.IsResource, msgAndArgs ...any) bool {
-				return assert.Equal(t, 11, len(got))
+				return assert.Equal(t, 13, len(got))
 			},
 			wantErr: assert.NoError,
 		},
@@ -606,6 +624,7 @@ func Test_openstackCollector_List(t *testing.T) {
 				openstacktest.HandleShowConsoleOutputSuccessfully(t, ConsoleOutputBody, fakeServer)
 				openstacktest.HandleInterfaceListSuccessfully(t, fakeServer)
 				openstacktest.HandleNetworkListSuccessfully(t, fakeServer)
+				openstacktest.HandleSecurityGroupListSuccessfully(t, fakeServer)
 				openstacktest.MockStorageListResponse(t, fakeServer)
 				openstacktest.HandleListClusterSuccessfully(t, fakeServer)
 			case "domain":
@@ -618,6 +637,7 @@ func Test_openstackCollector_List(t *testing.T) {
 				openstacktest.HandleShowConsoleOutputSuccessfully(t, ConsoleOutputBody, fakeServer)
 				openstacktest.HandleInterfaceListSuccessfully(t, fakeServer)
 				openstacktest.HandleNetworkListSuccessfully(t, fakeServer)
+				openstacktest.HandleSecurityGroupListSuccessfully(t, fakeServer)
 				openstacktest.MockStorageListResponse(t, fakeServer)
 				openstacktest.HandleListClusterSuccessfully(t, fakeServer)
 			case "project":
@@ -630,6 +650,7 @@ func Test_openstackCollector_List(t *testing.T) {
 				openstacktest.HandleShowConsoleOutputSuccessfully(t, ConsoleOutputBody, fakeServer)
 				openstacktest.HandleInterfaceListSuccessfully(t, fakeServer)
 				openstacktest.HandleNetworkListSuccessfully(t, fakeServer)
+				openstacktest.HandleSecurityGroupListSuccessfully(t, fakeServer)
 				openstacktest.MockStorageListResponse(t, fakeServer)
 				openstacktest.HandleListClusterSuccessfully(t, fakeServer)
 			case "clusters":
@@ -642,6 +663,7 @@ func Test_openstackCollector_List(t *testing.T) {
 				openstacktest.HandleShowConsoleOutputSuccessfully(t, ConsoleOutputBody, fakeServer)
 				openstacktest.HandleInterfaceListSuccessfully(t, fakeServer)
 				openstacktest.HandleNetworkListSuccessfully(t, fakeServer)
+				openstacktest.HandleSecurityGroupListSuccessfully(t, fakeServer)
 				openstacktest.MockStorageListResponse(t, fakeServer)
 			case "storage":
 				fmt.Println("Setting up handlers to get an error for storage resources")
@@ -649,6 +671,17 @@ func Test_openstackCollector_List(t *testing.T) {
 					"output": "output test"
 				}`
 
+				openstacktest.HandleServerListSuccessfully(t, fakeServer)
+				openstacktest.HandleShowConsoleOutputSuccessfully(t, ConsoleOutputBody, fakeServer)
+				openstacktest.HandleInterfaceListSuccessfully(t, fakeServer)
+				openstacktest.HandleNetworkListSuccessfully(t, fakeServer)
+				openstacktest.HandleSecurityGroupListSuccessfully(t, fakeServer)
+			case "securitygroups":
+				fmt.Println("Setting up handlers to get an error for network security group resources")
+				const ConsoleOutputBody = `{
+					"output": "output test"
+				}`
+
 				openstacktest.HandleServerListSuccessfully(t, fakeServer)
 				openstacktest.HandleShowConsoleOutputSuccessfully(t, ConsoleOutputBody, fakeServer)
 				openstacktest.HandleInterfaceListSuccessfully(t, fakeServer)
@@ -87,6 +87,13 @@ func Test_openstackCollector_collectBlockStorage(t *testing.T) {
 					},
 					ParentId: new("83ec2e3b-4321-422b-8706-a84185f52a0a"),
 					Labels:   map[string]string{},
+					AtRestEncryption: &ontology.AtRestEncryption{
+						Type: &ontology.AtRestEncryption_ManagedKeyEncryption{
+							ManagedKeyEncryption: &ontology.ManagedKeyEncryption{
+								Enabled: false,
+							},
+						},
+					},
 				}
 
 				got0 := got[0].(*ontology.BlockStorage)
@@ -41,9 +41,10 @@ func (d *openstackCollector) handleBlockStorage(volume *volumes.Volume) (ontolog
 		GeoLocation: &ontology.GeoLocation{
 			Region: d.region,
 		},
-		ParentId: new(getParentID(volume)),
-		Labels:   map[string]string{}, // Not available
-		Raw:      collector.Raw(volume),
+		ParentId:         new(getParentID(volume)),
+		Labels:           map[string]string{}, // Not available
+		AtRestEncryption: atRestEncryption(volume),
+		Raw:              collector.Raw(volume),
 	}
 
 	log.Info("Adding block storage", slog.String("name", volume.Name))
@@ -70,6 +70,13 @@ func Test_openstackCollector_handleBlockStorage(t *testing.T) {
 						Region: "test region",
 					},
 					ParentId: new(testdata.MockOpenstackVolumeTenantID),
+					AtRestEncryption: &ontology.AtRestEncryption{
+						Type: &ontology.AtRestEncryption_ManagedKeyEncryption{
+							ManagedKeyEncryption: &ontology.ManagedKeyEncryption{
+								Enabled: false,
+							},
+						},
+					},
 				}
 
 				gotNew := got.(*ontology.BlockStorage)
@@ -102,6 +109,53 @@ func Test_openstackCollector_handleBlockStorage(t *testing.T) {
 						Region: "test region",
 					},
 					ParentId: new(testdata.MockOpenstackVolumeTenantID),
+					AtRestEncryption: &ontology.AtRestEncryption{
+						Type: &ontology.AtRestEncryption_ManagedKeyEncryption{
+							ManagedKeyEncryption: &ontology.ManagedKeyEncryption{
+								Enabled: false,
+							},
+						},
+					},
+				}
+
+				gotNew := got.(*ontology.BlockStorage)
+
+				assert.NotEmpty(t, gotNew.GetRaw())
+				gotNew.Raw = ""
+				return assert.Equal(t, want, gotNew)
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "Happy path: encrypted volume",
+			fields: fields{
+				region: "test region",
+			},
+			args: args{
+				volume: &volumes.Volume{
+					ID:        testdata.MockOpenstackVolumeID1,
+					Name:      testdata.MockOpenstackVolumeName1,
+					TenantID:  testdata.MockOpenstackVolumeTenantID,
+					CreatedAt: testTime,
+					Encrypted: true,
+				},
+			},
+			want: func(t *testing.T, got ontology.IsResource, msgAndArgs ...any) bool {
+				want := &ontology.BlockStorage{
+					Id:           testdata.MockOpenstackVolumeID1,
+					Name:         testdata.MockOpenstackVolumeName1,
+					CreationTime: timestamppb.New(testTime),
+					GeoLocation: &ontology.GeoLocation{
+						Region: "test region",
+					},
+					ParentId: new(testdata.MockOpenstackVolumeTenantID),
+					AtRestEncryption: &ontology.AtRestEncryption{
+						Type: &ontology.AtRestEncryption_ManagedKeyEncryption{
+							ManagedKeyEncryption: &ontology.ManagedKeyEncryption{
+								Enabled: true,
+							},
+						},
+					},
 				}
 
 				gotNew := got.(*ontology.BlockStorage)
@@ -16,6 +16,8 @@
 package openstack
 
 import (
+	"confirmate.io/core/api/ontology"
+
 	"github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/volumes"
 )
 
@@ -31,3 +33,17 @@ func getParentID(volume *volumes.Volume) string {
 	// If no attachment is available, we attach it to the project ID
 	return volume.TenantID
 }
+
+// atRestEncryption returns the at-rest encryption of a volume. Cinder encrypts volumes of an encrypted volume type with
+// a key that is created and stored by the key manager service (Barbican) on behalf of the user, so we map it to a
+// managed key encryption.
+func atRestEncryption(volume *volumes.Volume) *ontology.AtRestEncryption {
+	return &ontology.AtRestEncryption{
+		Type: &ontology.AtRestEncryption_ManagedKeyEncryption{
+			ManagedKeyEncryption: &ontology.ManagedKeyEncryption{
+				Algorithm: "", // The cipher of the volume type is only available with admin privileges
+				Enabled:   volume.Encrypted,
+			},
+		},
+	}
+}
@@ -0,0 +1,127 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package commands
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+
+	host "confirmate.io/collectors/host/service"
+	"confirmate.io/core/api"
+	"confirmate.io/core/log"
+	"confirmate.io/core/service"
+	"confirmate.io/core/service/collection"
+
+	"github.com/urfave/cli/v3"
+)
+
+// hostCollectorFlags contains the flags of the host collector.
+var hostCollectorFlags = []cli.Flag{
+	&cli.StringFlag{
+		Name:  "log-level",
+		Usage: "Log level (TRACE, DEBUG, INFO, WARN, ERROR)",
+		Value: "INFO",
+	},
+	&cli.DurationFlag{
+		Name:  "collection-interval",
+		Usage: "Interval between collection runs",
+		Value: collection.DefaultConfig.Interval,
+	},
+	&cli.StringFlag{
+		Name:  "evidence-store-address",
+		Usage: "Evidence store base URL for forwarding collected resources (empty disables forwarding)",
+		Value: collection.DefaultConfig.EvidenceStoreAddress,
+	},
+	&cli.StringFlag{
+		Name:  "evidence-store-token",
+		Usage: "Collector token issued by the evidence store, which identifies this collector",
+	},
+	&cli.StringFlag{
+		Name:  "target-of-evaluation-id",
+		Usage: "Target of evaluation UUID used when creating evidence records",
+	},
+	&cli.StringFlag{
+		Name:  "tool-id",
+		Usage: "Tool ID used when creating evidence records (default: derived from the hostname)",
+	},
+	&cli.StringFlag{
+		Name:  "host-root",
+		Usage: "Root of the host file system, e.g., when running inside a container with the host mounted (Linux only)",
+		Value: "/",
+	},
+}
+
+// HostCollectorCommand is the command to start the host collector.
+var HostCollectorCommand = &cli.Command{
+	Name:  "host-collector",
+	Usage: "Launches the host collector, which collects OS-level evidence of the host it runs on",
+	Flags: hostCollectorFlags,
+	Action: func(ctx context.Context, cmd *cli.Command) (err error) {
+		var (
+			runCtx   context.Context
+			cancel   context.CancelFunc
+			svc      *collection.Service
+			resultCh <-chan collection.CollectionResult
+
+			evidenceStoreClient *http.Client
+		)
+
+		runCtx, cancel = signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+		defer cancel()
+
+		if err = log.Configure(cmd.String("log-level")); err != nil {
+			return err
+		}
+
+		// Identify this collector to the evidence store, if it has been issued a token
+		evidenceStoreClient = api.NewOAuthHTTPClient(collection.DefaultConfig.EvidenceStoreHTTPClient,
+			api.NewStaticAuthorizer(cmd.String("evidence-store-token")))
+
+		svc, err = collection.NewService(
+			collection.WithConfig(collection.Config{
+				Interval:                cmd.Duration("collection-interval"),
+				EvidenceStoreAddress:    cmd.String("evidence-store-address"),
+				EvidenceStoreHTTPClient: evidenceStoreClient,
+				TargetOfEvaluationID:    cmd.String("target-of-evaluation-id"),
+				ToolID:                  cmd.String("tool-id"),
+				Transport:               service.DefaultTransportConfig,
+				Collectors: []collection.Collector{
+					host.NewHostCollector(host.WithRoot(os.DirFS(cmd.String("host-root")))),
+				},
+			}),
+		)
+		if err != nil {
+			return err
+		}
+
+		resultCh = svc.Run(runCtx)
+		for result := range resultCh {
+			for _, r := range result.CollectorResults {
+				if r.Err != nil {
+					slog.Warn("Host facts are incomplete", slog.String("collector", r.CollectorName), log.Err(r.Err))
+				}
+
+				slog.Info("Collected host evidence", slog.String("collector", r.CollectorName), slog.Int("resources", len(r.Resources)))
+			}
+		}
+
+		return nil
+	},
+}
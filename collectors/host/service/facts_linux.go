@@ -0,0 +1,341 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package host
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	// unattendedUpgradeRegexp matches the APT setting enabling unattended upgrades and captures its interval in days.
+	unattendedUpgradeRegexp = regexp.MustCompile(`APT::Periodic::Unattended-Upgrade\s+"([^"]*)"`)
+
+	// mountEscapes replaces the octal escapes used in /proc/mounts.
+	mountEscapes = strings.NewReplacer(`\040`, " ", `\011`, "\t", `\012`, "\n", `\134`, `\`)
+)
+
+// gatherFacts gathers the facts of a Linux host by inspecting its file system, including /proc and /sys. All paths are
+// relative to the given root. Missing files are not considered an error, but an indication that a feature is not
+// available on the host.
+func gatherFacts(root fs.FS) (facts *Facts, err error) {
+	var errs []error
+
+	facts = &Facts{
+		Hostname:  readTrimmed(root, "proc/sys/kernel/hostname"),
+		MachineID: readTrimmed(root, "etc/machine-id"),
+	}
+
+	facts.OS = linuxOS(root)
+	facts.Updates = linuxUpdates(root)
+	facts.Firewall = linuxFirewall(root)
+	facts.Audit = linuxAudit(root)
+
+	facts.Disks, err = linuxDisks(root)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("could not gather disks: %w", err))
+	}
+
+	return facts, errors.Join(errs...)
+}
+
+// linuxOS reads the operating system from /etc/os-release and the kernel version from /proc.
+func linuxOS(root fs.FS) (facts OSFacts) {
+	var release = parseKeyValues(root, "etc/os-release")
+
+	facts.Name = release["PRETTY_NAME"]
+	if facts.Name == "" {
+		facts.Name = release["NAME"]
+	}
+	facts.Version = release["VERSION_ID"]
+	facts.Kernel = readTrimmed(root, "proc/sys/kernel/osrelease")
+
+	return facts
+}
+
+// linuxUpdates detects automatic updates using unattended-upgrades (Debian, Ubuntu) or dnf-automatic (Fedora, RHEL).
+func linuxUpdates(root fs.FS) (updates UpdateFacts) {
+	var (
+		files []string
+		conf  map[string]string
+	)
+
+	// Later files of apt.conf.d override earlier ones
+	files, _ = fs.Glob(root, "etc/apt/apt.conf.d/*")
+	for _, file := range files {
+		data, err := fs.ReadFile(root, file)
+		if err != nil {
+			continue
+		}
+
+		for _, m := range unattendedUpgradeRegexp.FindAllSubmatch(data, -1) {
+			days, _ := strconv.Atoi(string(m[1]))
+			updates.Automatic = days > 0
+			updates.Interval = time.Duration(days) * 24 * time.Hour
+		}
+	}
+	if updates.Automatic {
+		updates.SecurityOnly = securityOnlyOrigins(root, "etc/apt/apt.conf.d/50unattended-upgrades")
+	}
+
+	conf = parseKeyValues(root, "etc/dnf/automatic.conf")
+	if !updates.Automatic && conf["apply_updates"] == "yes" && serviceEnabled(root, "dnf-automatic*.timer") {
+		updates.Automatic = true
+		updates.SecurityOnly = conf["upgrade_type"] == "security"
+		updates.Interval = 24 * time.Hour
+	}
+
+	updates.RebootRequired = exists(root, "run/reboot-required") || exists(root, "var/run/reboot-required")
+
+	return updates
+}
+
+// securityOnlyOrigins returns true if all origins unattended-upgrades is allowed to install updates from are security
+// origins.
+func securityOnlyOrigins(root fs.FS, file string) bool {
+	var (
+		data    []byte
+		err     error
+		inBlock bool
+		origins int
+	)
+
+	data, err = fs.ReadFile(root, file)
+	if err != nil {
+		return false
+	}
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		switch {
+		case strings.HasPrefix(line, "//"):
+			continue
+		case strings.HasPrefix(line, "Unattended-Upgrade::Allowed-Origins") ||
+			strings.HasPrefix(line, "Unattended-Upgrade::Origins-Pattern"):
+			inBlock = true
+		case inBlock && strings.HasPrefix(line, "}"):
+			inBlock = false
+		case inBlock && strings.HasPrefix(line, `"`):
+			if !strings.Contains(line, "security") {
+				return false
+			}
+			origins++
+		}
+	}
+
+	return origins > 0
+}
+
+// linuxFirewall detects an active local firewall, i.e., ufw, firewalld or a persistent nftables or iptables ruleset.
+func linuxFirewall(root fs.FS) (firewall FirewallFacts) {
+	if parseKeyValues(root, "etc/ufw/ufw.conf")["ENABLED"] == "yes" {
+		return FirewallFacts{Enabled: true, Name: "ufw"}
+	}
+
+	for _, name := range []string{"firewalld", "nftables", "iptables", "netfilter-persistent"} {
+		if serviceEnabled(root, name+".service") {
+			return FirewallFacts{Enabled: true, Name: name}
+		}
+	}
+
+	return firewall
+}
+
+// linuxAudit detects whether the Linux audit daemon is enabled and counts its rules.
+func linuxAudit(root fs.FS) (audit AuditFacts) {
+	var files []string
+
+	if !exists(root, "etc/audit/auditd.conf") || !serviceEnabled(root, "auditd.service") {
+		return audit
+	}
+
+	audit = AuditFacts{Enabled: true, Name: "auditd"}
+
+	files, _ = fs.Glob(root, "etc/audit/rules.d/*.rules")
+	if len(files) == 0 {
+		files = []string{"etc/audit/audit.rules"}
+	}
+	for _, file := range files {
+		data, err := fs.ReadFile(root, file)
+		if err != nil {
+			continue
+		}
+
+		for line := range strings.Lines(string(data)) {
+			// Control rules, such as -D or -b, do not audit anything
+			if strings.HasPrefix(line, "-a") || strings.HasPrefix(line, "-w") {
+				audit.Rules++
+			}
+		}
+	}
+
+	return audit
+}
+
+// linuxDisks returns the block devices mounted on the host. A device counts as encrypted if it is a dm-crypt device or
+// is stacked, e.g., using LVM, on top of one.
+func linuxDisks(root fs.FS) (disks []DiskFacts, err error) {
+	var (
+		data    []byte
+		seen    = make(map[string]bool)
+		mappers map[string]string
+	)
+
+	data, err = fs.ReadFile(root, "proc/mounts")
+	if err != nil {
+		return nil, err
+	}
+
+	mappers = deviceMappers(root)
+
+	for line := range strings.Lines(string(data)) {
+		fields := strings.Fields(line)
+		if len(fields) < 3 {
+			continue
+		}
+
+		device, mountPoint, fsType := mountEscapes.Replace(fields[0]), mountEscapes.Replace(fields[1]), fields[2]
+		if !strings.HasPrefix(device, "/dev/") || strings.HasPrefix(device, "/dev/loop") || fsType == "squashfs" || seen[device] {
+			continue
+		}
+		seen[device] = true
+
+		disk := DiskFacts{Device: device, MountPoint: mountPoint}
+
+		// Device mapper devices are either mounted by their name or by their kernel name
+		dm := mappers[path.Base(device)]
+		if strings.HasPrefix(path.Base(device), "dm-") {
+			dm = path.Base(device)
+		}
+		if dm != "" {
+			disk.Algorithm, disk.Encrypted = dmCrypt(root, dm, 0)
+		}
+
+		disks = append(disks, disk)
+	}
+
+	return disks, nil
+}
+
+// deviceMappers returns the kernel names (e.g., "dm-0") of all device mapper devices by their name (e.g., "root").
+func deviceMappers(root fs.FS) (mappers map[string]string) {
+	var dirs []string
+
+	mappers = make(map[string]string)
+
+	dirs, _ = fs.Glob(root, "sys/block/dm-*")
+	for _, dir := range dirs {
+		if name := readTrimmed(root, dir+"/dm/name"); name != "" {
+			mappers[name] = path.Base(dir)
+		}
+	}
+
+	return mappers
+}
+
+// dmCrypt returns whether the given device mapper device, or any device it is stacked on, is a dm-crypt device, as well
+// as the scheme of the encryption, e.g., "LUKS2".
+func dmCrypt(root fs.FS, dm string, depth int) (scheme string, ok bool) {
+	var (
+		uuid   string
+		slaves []fs.DirEntry
+	)
+
+	// Guard against cycles of (broken) sysfs links
+	if depth > 8 {
+		return "", false
+	}
+
+	uuid = readTrimmed(root, "sys/block/"+dm+"/dm/uuid")
+	if rest, found := strings.CutPrefix(uuid, "CRYPT-"); found {
+		scheme, _, _ = strings.Cut(rest, "-")
+		return scheme, true
+	}
+
+	slaves, _ = fs.ReadDir(root, "sys/block/"+dm+"/slaves")
+	for _, slave := range slaves {
+		if !strings.HasPrefix(slave.Name(), "dm-") {
+			continue
+		}
+
+		if scheme, ok = dmCrypt(root, slave.Name(), depth+1); ok {
+			return scheme, true
+		}
+	}
+
+	return "", false
+}
+
+// serviceEnabled returns true if a systemd unit matching the given pattern is enabled, i.e., it is wanted by any
+// target.
+func serviceEnabled(root fs.FS, pattern string) bool {
+	var matches []string
+
+	for _, dir := range []string{"etc/systemd/system", "usr/lib/systemd/system", "lib/systemd/system"} {
+		matches, _ = fs.Glob(root, dir+"/*.wants/"+pattern)
+		if len(matches) > 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+// parseKeyValues parses a file of KEY=value lines, such as /etc/os-release or an INI file, ignoring sections and
+// comments. Quotes around values are removed.
+func parseKeyValues(root fs.FS, file string) (values map[string]string) {
+	var data []byte
+
+	values = make(map[string]string)
+
+	data, _ = fs.ReadFile(root, file)
+	for line := range strings.Lines(string(data)) {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, "[") {
+			continue
+		}
+
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			continue
+		}
+
+		values[strings.TrimSpace(key)] = strings.Trim(strings.TrimSpace(value), `"'`)
+	}
+
+	return values
+}
+
+// readTrimmed returns the content of a file without surrounding whitespace, or an empty string if it cannot be read.
+func readTrimmed(root fs.FS, file string) string {
+	data, _ := fs.ReadFile(root, file)
+	return strings.TrimSpace(string(data))
+}
+
+// exists returns true if the given file exists.
+func exists(root fs.FS, file string) bool {
+	_, err := fs.Stat(root, file)
+	return err == nil
+}
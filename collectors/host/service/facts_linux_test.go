@@ -0,0 +1,123 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+//go:build linux
+
+package host
+
+import (
+	"testing"
+	"testing/fstest"
+	"time"
+
+	"confirmate.io/core/util/assert"
+)
+
+func Test_gatherFacts(t *testing.T) {
+	tests := []struct {
+		name    string
+		root    fstest.MapFS
+		want    assert.Want[*Facts]
+		wantErr assert.WantErr
+	}{
+		{
+			name: "Hardened Ubuntu host",
+			root: fstest.MapFS{
+				"proc/sys/kernel/hostname":  {Data: []byte("web-1\n")},
+				"proc/sys/kernel/osrelease": {Data: []byte("6.8.0-45-generic\n")},
+				"etc/machine-id":            {Data: []byte("4c4c4544\n")},
+				"etc/os-release":            {Data: []byte("NAME=\"Ubuntu\"\nPRETTY_NAME=\"Ubuntu 24.04.1 LTS\"\nVERSION_ID=\"24.04\"\n")},
+				"etc/apt/apt.conf.d/20auto-upgrades": {Data: []byte(
+					"APT::Periodic::Update-Package-Lists \"1\";\nAPT::Periodic::Unattended-Upgrade \"1\";\n")},
+				"etc/apt/apt.conf.d/50unattended-upgrades": {Data: []byte(
+					"Unattended-Upgrade::Allowed-Origins {\n\t\"${distro_id}:${distro_codename}-security\";\n//\t\"${distro_id}:${distro_codename}-updates\";\n};\n")},
+				"var/run/reboot-required": {},
+				"proc/mounts": {Data: []byte(
+					"sysfs /sys sysfs rw 0 0\n" +
+						"/dev/mapper/vg-root / ext4 rw,relatime 0 0\n" +
+						"/dev/nvme0n1p1 /boot\\040efi vfat rw 0 0\n" +
+						"/dev/loop0 /snap/core squashfs ro 0 0\n" +
+						"/dev/mapper/vg-root /var/lib/docker ext4 rw 0 0\n")},
+				"sys/block/dm-0/dm/name":     {Data: []byte("nvme0n1p3_crypt\n")},
+				"sys/block/dm-0/dm/uuid":     {Data: []byte("CRYPT-LUKS2-0f2b6a2e-nvme0n1p3_crypt\n")},
+				"sys/block/dm-1/dm/name":     {Data: []byte("vg-root\n")},
+				"sys/block/dm-1/dm/uuid":     {Data: []byte("LVM-abcdef\n")},
+				"sys/block/dm-1/slaves/dm-0": {},
+				"etc/ufw/ufw.conf":           {Data: []byte("# comment\nENABLED=yes\nLOGLEVEL=low\n")},
+				"etc/audit/auditd.conf":      {},
+				"etc/systemd/system/multi-user.target.wants/auditd.service": {},
+				"etc/audit/rules.d/audit.rules": {Data: []byte(
+					"-D\n-b 8192\n-w /etc/passwd -p wa -k identity\n-a always,exit -F arch=b64 -S execve\n")},
+			},
+			want: func(t *testing.T, got *Facts, msgAndArgs ...any) bool {
+				return assert.Equal(t, &Facts{
+					Hostname:  "web-1",
+					MachineID: "4c4c4544",
+					OS:        OSFacts{Name: "Ubuntu 24.04.1 LTS", Version: "24.04", Kernel: "6.8.0-45-generic"},
+					Updates:   UpdateFacts{Automatic: true, SecurityOnly: true, Interval: 24 * time.Hour, RebootRequired: true},
+					Disks: []DiskFacts{
+						{Device: "/dev/mapper/vg-root", MountPoint: "/", Encrypted: true, Algorithm: "LUKS2"},
+						{Device: "/dev/nvme0n1p1", MountPoint: "/boot efi"},
+					},
+					Firewall: FirewallFacts{Enabled: true, Name: "ufw"},
+					Audit:    AuditFacts{Enabled: true, Name: "auditd", Rules: 2},
+				}, got)
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "RHEL host with dnf-automatic and firewalld",
+			root: fstest.MapFS{
+				"proc/sys/kernel/hostname": {Data: []byte("db-1")},
+				"etc/os-release":           {Data: []byte("NAME=\"Red Hat Enterprise Linux\"\nVERSION_ID=\"9.4\"\n")},
+				"etc/dnf/automatic.conf":   {Data: []byte("[commands]\nupgrade_type = security\napply_updates = yes\n")},
+				"etc/systemd/system/timers.target.wants/dnf-automatic.timer":   {},
+				"etc/systemd/system/multi-user.target.wants/firewalld.service": {},
+				"proc/mounts": {Data: []byte("/dev/sda1 / xfs rw 0 0\n")},
+			},
+			want: func(t *testing.T, got *Facts, msgAndArgs ...any) bool {
+				return assert.Equal(t, &Facts{
+					Hostname: "db-1",
+					OS:       OSFacts{Name: "Red Hat Enterprise Linux", Version: "9.4"},
+					Updates:  UpdateFacts{Automatic: true, SecurityOnly: true, Interval: 24 * time.Hour},
+					Disks:    []DiskFacts{{Device: "/dev/sda1", MountPoint: "/"}},
+					Firewall: FirewallFacts{Enabled: true, Name: "firewalld"},
+				}, got)
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "Mounts not readable",
+			root: fstest.MapFS{
+				"proc/sys/kernel/hostname": {Data: []byte("minimal")},
+			},
+			want: func(t *testing.T, got *Facts, msgAndArgs ...any) bool {
+				assert.Equal(t, "minimal", got.Hostname)
+				return assert.Empty(t, got.Disks)
+			},
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.ErrorContains(t, err, "could not gather disks")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := gatherFacts(tt.root)
+			tt.want(t, got)
+			tt.wantErr(t, err)
+		})
+	}
+}
@@ -0,0 +1,141 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+//go:build windows
+
+package host
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"os/exec"
+	"time"
+)
+
+// powerShell runs the given PowerShell script and returns its output. It is replaced in tests.
+var powerShell = func(script string) (out []byte, err error) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	return exec.CommandContext(ctx, "powershell.exe", "-NoProfile", "-NonInteractive", "-Command", script).Output()
+}
+
+// gatherFacts gathers the facts of a Windows host using PowerShell. The root is ignored, since Windows does not expose
+// its configuration through the file system.
+func gatherFacts(_ fs.FS) (facts *Facts, err error) {
+	var (
+		errs []error
+		win  struct {
+			Caption       string
+			Version       string
+			BuildNumber   string
+			MachineGuid   string
+			AUOptions     *int
+			Scheduled     *int
+			RebootPending bool
+			EventLog      string
+		}
+		volumes []struct {
+			MountPoint       string
+			ProtectionStatus int
+			EncryptionMethod int
+		}
+		profiles []struct {
+			Name    string
+			Enabled bool
+		}
+	)
+
+	facts = &Facts{}
+	facts.Hostname, _ = os.Hostname()
+
+	errs = append(errs, runJSON(`
+$os = Get-CimInstance Win32_OperatingSystem
+$au = Get-ItemProperty -ErrorAction SilentlyContinue 'HKLM:\SOFTWARE\Policies\Microsoft\Windows\WindowsUpdate\AU'
+[pscustomobject]@{
+  Caption = $os.Caption
+  Version = $os.Version
+  BuildNumber = $os.BuildNumber
+  MachineGuid = (Get-ItemProperty 'HKLM:\SOFTWARE\Microsoft\Cryptography').MachineGuid
+  AUOptions = $au.AUOptions
+  Scheduled = $au.ScheduledInstallDay
+  RebootPending = Test-Path 'HKLM:\SOFTWARE\Microsoft\Windows\CurrentVersion\WindowsUpdate\Auto Update\RebootRequired'
+  EventLog = (Get-Service EventLog).Status.ToString()
+} | ConvertTo-Json`, &win))
+
+	facts.MachineID = win.MachineGuid
+	facts.OS = OSFacts{Name: win.Caption, Version: win.Version, Kernel: win.BuildNumber}
+
+	// AUOptions 4 is "Auto download and schedule the install", a ScheduledInstallDay of 0 means every day
+	if win.AUOptions != nil && *win.AUOptions == 4 {
+		facts.Updates.Automatic = true
+		facts.Updates.Interval = 24 * time.Hour
+		if win.Scheduled != nil && *win.Scheduled != 0 {
+			facts.Updates.Interval = 7 * 24 * time.Hour
+		}
+	}
+	facts.Updates.RebootRequired = win.RebootPending
+
+	facts.Audit = AuditFacts{Enabled: win.EventLog == "Running", Name: "EventLog"}
+
+	// Get-BitLockerVolume requires administrative privileges, we still report the remaining facts without it
+	if err = runJSON(`@(Get-BitLockerVolume | Select-Object MountPoint, @{n='ProtectionStatus';e={[int]$_.ProtectionStatus}}, @{n='EncryptionMethod';e={[int]$_.EncryptionMethod}}) | ConvertTo-Json`, &volumes); err != nil {
+		errs = append(errs, fmt.Errorf("could not gather BitLocker volumes: %w", err))
+	}
+	for _, v := range volumes {
+		disk := DiskFacts{Device: v.MountPoint, MountPoint: v.MountPoint, Encrypted: v.ProtectionStatus == 1}
+		if disk.Encrypted {
+			disk.Algorithm = bitLockerMethods[v.EncryptionMethod]
+		}
+		facts.Disks = append(facts.Disks, disk)
+	}
+
+	errs = append(errs, runJSON(`@(Get-NetFirewallProfile | Select-Object Name, @{n='Enabled';e={[bool]$_.Enabled}}) | ConvertTo-Json`, &profiles))
+
+	// The firewall only counts as enabled if it is enabled for all profiles
+	facts.Firewall = FirewallFacts{Enabled: len(profiles) > 0, Name: "Windows Defender Firewall"}
+	for _, p := range profiles {
+		facts.Firewall.Enabled = facts.Firewall.Enabled && p.Enabled
+	}
+
+	return facts, errors.Join(errs...)
+}
+
+// bitLockerMethods contains the names of the BitLocker encryption methods by their numeric value.
+var bitLockerMethods = map[int]string{
+	1: "Aes128Diffuser",
+	2: "Aes256Diffuser",
+	3: "Aes128",
+	4: "Aes256",
+	5: "Hardware",
+	6: "XtsAes128",
+	7: "XtsAes256",
+}
+
+// runJSON runs the given PowerShell script and unmarshals its JSON output into v.
+func runJSON(script string, v any) (err error) {
+	var out []byte
+
+	out, err = powerShell(script)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(out, v)
+}
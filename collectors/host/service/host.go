@@ -0,0 +1,282 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+// Package host contains a collector that gathers OS-level evidence, such as the patch level, the disk encryption, the
+// local firewall and the audit logging, of the host it runs on. Linux and Windows hosts are supported.
+package host
+
+import (
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"os"
+	"strings"
+	"time"
+
+	"confirmate.io/core/api/ontology"
+	"confirmate.io/core/service/collection"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// ErrUnsupportedPlatform is returned by the collector if it runs on an operating system it cannot inspect.
+var ErrUnsupportedPlatform = errors.New("host collector does not support this platform")
+
+// Facts are the OS-level facts gathered from a host. They are translated into ontology resources by the collector.
+type Facts struct {
+	// Hostname is the name of the host.
+	Hostname string `json:"hostname"`
+
+	// MachineID is a stable, unique identifier of the host, if the operating system provides one.
+	MachineID string `json:"machineId,omitempty"`
+
+	// OS describes the operating system of the host.
+	OS OSFacts `json:"os"`
+
+	// Updates describes how the host is patched.
+	Updates UpdateFacts `json:"updates"`
+
+	// Disks contains the (mounted) volumes of the host.
+	Disks []DiskFacts `json:"disks"`
+
+	// Firewall describes the local firewall of the host.
+	Firewall FirewallFacts `json:"firewall"`
+
+	// Audit describes the audit logging of the host.
+	Audit AuditFacts `json:"audit"`
+}
+
+// OSFacts describe the operating system of a host.
+type OSFacts struct {
+	// Name is the human-readable name of the operating system, e.g., "Ubuntu 24.04 LTS".
+	Name string `json:"name"`
+
+	// Version is the version of the operating system.
+	Version string `json:"version"`
+
+	// Kernel is the version of the kernel, if it differs from the version of the operating system.
+	Kernel string `json:"kernel,omitempty"`
+}
+
+// UpdateFacts describe how a host is patched.
+type UpdateFacts struct {
+	// Automatic is true if updates are installed automatically.
+	Automatic bool `json:"automatic"`
+
+	// SecurityOnly is true if only security updates are installed automatically.
+	SecurityOnly bool `json:"securityOnly"`
+
+	// Interval is the interval in which updates are installed automatically.
+	Interval time.Duration `json:"interval,omitempty"`
+
+	// RebootRequired is true if installed updates only take effect after a reboot.
+	RebootRequired bool `json:"rebootRequired"`
+}
+
+// DiskFacts describe a volume of a host.
+type DiskFacts struct {
+	// Device is the device the volume is stored on, e.g., "/dev/mapper/root" or "C:".
+	Device string `json:"device"`
+
+	// MountPoint is the path the volume is mounted at.
+	MountPoint string `json:"mountPoint"`
+
+	// Encrypted is true if the volume is encrypted at rest.
+	Encrypted bool `json:"encrypted"`
+
+	// Algorithm is the encryption scheme or algorithm, e.g., "LUKS2" or "XtsAes256", if the volume is encrypted.
+	Algorithm string `json:"algorithm,omitempty"`
+}
+
+// FirewallFacts describe the local firewall of a host.
+type FirewallFacts struct {
+	// Enabled is true if a local firewall is active.
+	Enabled bool `json:"enabled"`
+
+	// Name is the name of the firewall, e.g., "ufw" or "firewalld".
+	Name string `json:"name,omitempty"`
+}
+
+// AuditFacts describe the audit logging of a host.
+type AuditFacts struct {
+	// Enabled is true if the audit logging is active.
+	Enabled bool `json:"enabled"`
+
+	// Name is the name of the audit system, e.g., "auditd".
+	Name string `json:"name,omitempty"`
+
+	// Rules is the number of configured audit rules, if known.
+	Rules int `json:"rules,omitempty"`
+}
+
+// hostCollector collects the [Facts] of the host it runs on.
+type hostCollector struct {
+	id   string
+	root fs.FS
+
+	// gather gathers the facts of the host. It is replaced in tests.
+	gather func(root fs.FS) (facts *Facts, err error)
+}
+
+// CollectorOption is an option to configure the host collector.
+type CollectorOption func(c *hostCollector)
+
+// WithRoot is an option to set the root file system the collector inspects on Linux hosts. This is useful if the
+// collector runs in a container with the file system of the host mounted, e.g., at "/host". It has no effect on Windows
+// hosts.
+func WithRoot(root fs.FS) CollectorOption {
+	return func(c *hostCollector) {
+		c.root = root
+	}
+}
+
+// NewHostCollector creates a new collector for the host it runs on.
+func NewHostCollector(opts ...CollectorOption) collection.Collector {
+	var (
+		c        *hostCollector
+		hostname string
+	)
+
+	c = &hostCollector{
+		root:   os.DirFS("/"),
+		gather: gatherFacts,
+	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	hostname, _ = os.Hostname()
+	c.id = uuid.NewSHA1(uuid.NameSpaceOID, []byte("host::"+hostname)).String()
+
+	return c
+}
+
+// Name implements [collection.Collector].
+func (*hostCollector) Name() string {
+	return "Host Collector"
+}
+
+// ID implements [collection.Collector].
+func (c *hostCollector) ID() string {
+	return c.id
+}
+
+// Collect implements [collection.Collector]. Facts that cannot be gathered are reported as error, while the resources
+// are built from all remaining facts.
+func (c *hostCollector) Collect() (list []ontology.IsResource, err error) {
+	var facts *Facts
+
+	facts, err = c.gather(c.root)
+	if facts == nil {
+		return nil, err
+	}
+
+	return facts.Resources(), err
+}
+
+// Resources translates the facts into ontology resources: a [ontology.VirtualMachine] representing the host itself,
+// a [ontology.BlockStorage] for each of its volumes and a [ontology.NetworkInterface] holding its local firewall.
+func (f *Facts) Resources() (list []ontology.IsResource) {
+	var (
+		id  = f.resourceID()
+		vm  *ontology.VirtualMachine
+		nic *ontology.NetworkInterface
+		raw = f.raw()
+	)
+
+	nic = &ontology.NetworkInterface{
+		Id:       id + "/network",
+		Name:     f.Hostname + " network",
+		ParentId: &id,
+		Raw:      raw,
+		AccessRestriction: &ontology.AccessRestriction{
+			Type: &ontology.AccessRestriction_L3Firewall{
+				L3Firewall: &ontology.L3Firewall{
+					Enabled: f.Firewall.Enabled,
+					Inbound: f.Firewall.Enabled,
+				},
+			},
+		},
+	}
+
+	vm = &ontology.VirtualMachine{
+		Id:   id,
+		Name: f.Hostname,
+		Labels: map[string]string{
+			"os":         f.OS.Name,
+			"os_version": f.OS.Version,
+		},
+		Raw: raw,
+		AutomaticUpdates: &ontology.AutomaticUpdates{
+			Enabled:      f.Updates.Automatic,
+			SecurityOnly: f.Updates.SecurityOnly,
+		},
+		OsLogging: &ontology.OSLogging{
+			Enabled: f.Audit.Enabled,
+		},
+		NetworkInterfaceIds: []string{nic.Id},
+	}
+	if f.OS.Kernel != "" {
+		vm.Labels["kernel_version"] = f.OS.Kernel
+	}
+	if f.Updates.RebootRequired {
+		vm.Labels["reboot_required"] = "true"
+	}
+	if f.Updates.Interval > 0 {
+		vm.AutomaticUpdates.Interval = durationpb.New(f.Updates.Interval)
+	}
+
+	list = append(list, vm, nic)
+
+	for _, disk := range f.Disks {
+		storage := &ontology.BlockStorage{
+			Id:       id + "/disks/" + strings.TrimPrefix(disk.Device, "/"),
+			Name:     disk.MountPoint,
+			ParentId: &id,
+			Raw:      raw,
+			AtRestEncryption: &ontology.AtRestEncryption{
+				Type: &ontology.AtRestEncryption_DiskEncryption{
+					DiskEncryption: &ontology.DiskEncryption{
+						Enabled:   disk.Encrypted,
+						Algorithm: disk.Algorithm,
+					},
+				},
+			},
+		}
+
+		vm.BlockStorageIds = append(vm.BlockStorageIds, storage.Id)
+		list = append(list, storage)
+	}
+
+	return list
+}
+
+// resourceID returns the ID of the resource representing the host. The machine ID is preferred over the hostname,
+// since it is stable across renames.
+func (f *Facts) resourceID() string {
+	if f.MachineID != "" {
+		return "host://" + f.MachineID
+	}
+
+	return "host://" + f.Hostname
+}
+
+// raw returns the facts serialized as JSON for the raw field of the resources.
+func (f *Facts) raw() string {
+	b, _ := json.Marshal(map[string]any{"*host.Facts": []any{f}})
+	return string(b)
+}
@@ -0,0 +1,152 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package host
+
+import (
+	"errors"
+	"io/fs"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	"confirmate.io/core/api/ontology"
+	"confirmate.io/core/util/assert"
+)
+
+func TestFacts_Resources(t *testing.T) {
+	tests := []struct {
+		name  string
+		facts *Facts
+		want  assert.Want[[]ontology.IsResource]
+	}{
+		{
+			name: "Host with encrypted disk, firewall and audit daemon",
+			facts: &Facts{
+				Hostname:  "web-1",
+				MachineID: "4c4c4544",
+				OS:        OSFacts{Name: "Ubuntu 24.04 LTS", Version: "24.04", Kernel: "6.8.0-45-generic"},
+				Updates:   UpdateFacts{Automatic: true, SecurityOnly: true, Interval: 24 * time.Hour, RebootRequired: true},
+				Disks:     []DiskFacts{{Device: "/dev/mapper/root", MountPoint: "/", Encrypted: true, Algorithm: "LUKS2"}},
+				Firewall:  FirewallFacts{Enabled: true, Name: "ufw"},
+				Audit:     AuditFacts{Enabled: true, Name: "auditd", Rules: 12},
+			},
+			want: func(t *testing.T, got []ontology.IsResource, msgAndArgs ...any) bool {
+				if !assert.Equal(t, 3, len(got)) {
+					return false
+				}
+
+				vm := assert.Is[*ontology.VirtualMachine](t, got[0])
+				assert.Equal(t, "host://4c4c4544", vm.Id)
+				assert.Equal(t, "web-1", vm.Name)
+				assert.Equal(t, "6.8.0-45-generic", vm.Labels["kernel_version"])
+				assert.Equal(t, "true", vm.Labels["reboot_required"])
+				assert.True(t, vm.AutomaticUpdates.Enabled)
+				assert.True(t, vm.AutomaticUpdates.SecurityOnly)
+				assert.Equal(t, 24*time.Hour, vm.AutomaticUpdates.Interval.AsDuration())
+				assert.True(t, vm.OsLogging.Enabled)
+				assert.Equal(t, []string{"host://4c4c4544/network"}, vm.NetworkInterfaceIds)
+				assert.Equal(t, []string{"host://4c4c4544/disks/dev/mapper/root"}, vm.BlockStorageIds)
+
+				nic := assert.Is[*ontology.NetworkInterface](t, got[1])
+				assert.True(t, nic.GetAccessRestriction().GetL3Firewall().GetEnabled())
+
+				storage := assert.Is[*ontology.BlockStorage](t, got[2])
+				assert.Equal(t, "host://4c4c4544", storage.GetParentId())
+				assert.Equal(t, "LUKS2", storage.GetAtRestEncryption().GetDiskEncryption().GetAlgorithm())
+				return assert.True(t, storage.GetAtRestEncryption().GetDiskEncryption().GetEnabled())
+			},
+		},
+		{
+			name:  "Host without machine ID or any protection",
+			facts: &Facts{Hostname: "legacy"},
+			want: func(t *testing.T, got []ontology.IsResource, msgAndArgs ...any) bool {
+				if !assert.Equal(t, 2, len(got)) {
+					return false
+				}
+
+				vm := assert.Is[*ontology.VirtualMachine](t, got[0])
+				assert.Equal(t, "host://legacy", vm.Id)
+				assert.Nil(t, vm.AutomaticUpdates.Interval)
+				assert.False(t, vm.AutomaticUpdates.Enabled)
+				assert.False(t, vm.OsLogging.Enabled)
+
+				nic := assert.Is[*ontology.NetworkInterface](t, got[1])
+				return assert.False(t, nic.GetAccessRestriction().GetL3Firewall().GetEnabled())
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.want(t, tt.facts.Resources())
+		})
+	}
+}
+
+func Test_hostCollector_Collect(t *testing.T) {
+	var root = fstest.MapFS{}
+
+	tests := []struct {
+		name    string
+		gather  func(root fs.FS) (*Facts, error)
+		want    assert.Want[[]ontology.IsResource]
+		wantErr assert.WantErr
+	}{
+		{
+			name: "Facts gathered",
+			gather: func(fs.FS) (*Facts, error) {
+				return &Facts{Hostname: "web-1"}, nil
+			},
+			want: func(t *testing.T, got []ontology.IsResource, msgAndArgs ...any) bool {
+				return assert.Equal(t, 2, len(got))
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "Partial facts are still returned",
+			gather: func(fs.FS) (*Facts, error) {
+				return &Facts{Hostname: "web-1", Disks: []DiskFacts{{Device: "/dev/sda1", MountPoint: "/"}}}, errors.New("permission denied")
+			},
+			want: func(t *testing.T, got []ontology.IsResource, msgAndArgs ...any) bool {
+				return assert.Equal(t, 3, len(got))
+			},
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.ErrorContains(t, err, "permission denied")
+			},
+		},
+		{
+			name: "Unsupported platform",
+			gather: func(fs.FS) (*Facts, error) {
+				return nil, ErrUnsupportedPlatform
+			},
+			want: assert.Empty[[]ontology.IsResource],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.ErrorIs(t, err, ErrUnsupportedPlatform)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewHostCollector(WithRoot(root)).(*hostCollector)
+			c.gather = tt.gather
+
+			got, err := c.Collect()
+			tt.want(t, got)
+			tt.wantErr(t, err)
+		})
+	}
+}
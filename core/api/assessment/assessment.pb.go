@@ -29,7 +29,7 @@ import (
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
 	_ "google.golang.org/protobuf/types/known/structpb"
-	_ "google.golang.org/protobuf/types/known/timestamppb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -257,6 +257,10 @@ type AssessEvidencesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Status        AssessmentStatus       `protobuf:"varint,1,opt,name=status,proto3,enum=confirmate.assessment.v1.AssessmentStatus" json:"status,omitempty"`
 	StatusMessage string                 `protobuf:"bytes,2,opt,name=status_message,json=statusMessage,proto3" json:"status_message,omitempty"`
+	// The ID of the evidence this response belongs to. Since evidences are
+	// assessed concurrently, responses are not necessarily sent in the order
+	// the evidences were received.
+	EvidenceId    string `protobuf:"bytes,3,opt,name=evidence_id,json=evidenceId,proto3" json:"evidence_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -305,6 +309,131 @@ func (x *AssessEvidencesResponse) GetStatusMessage() string {
 	return ""
 }
 
+func (x *AssessEvidencesResponse) GetEvidenceId() string {
+	if x != nil {
+		return x.EvidenceId
+	}
+	return ""
+}
+
+type ReassessEvidencesRequest struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	TargetOfEvaluationId string                 `protobuf:"bytes,1,opt,name=target_of_evaluation_id,json=targetOfEvaluationId,proto3" json:"target_of_evaluation_id,omitempty"`
+	// Optional. Re-assesses only evidences with a timestamp at or after the
+	// given time.
+	TimestampAfter *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=timestamp_after,json=timestampAfter,proto3,oneof" json:"timestamp_after,omitempty"`
+	// Optional. Re-assesses only evidences with a timestamp before the given
+	// time.
+	TimestampBefore *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=timestamp_before,json=timestampBefore,proto3,oneof" json:"timestamp_before,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ReassessEvidencesRequest) Reset() {
+	*x = ReassessEvidencesRequest{}
+	mi := &file_api_assessment_assessment_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReassessEvidencesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReassessEvidencesRequest) ProtoMessage() {}
+
+func (x *ReassessEvidencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_assessment_assessment_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReassessEvidencesRequest.ProtoReflect.Descriptor instead.
+func (*ReassessEvidencesRequest) Descriptor() ([]byte, []int) {
+	return file_api_assessment_assessment_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ReassessEvidencesRequest) GetTargetOfEvaluationId() string {
+	if x != nil {
+		return x.TargetOfEvaluationId
+	}
+	return ""
+}
+
+func (x *ReassessEvidencesRequest) GetTimestampAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.TimestampAfter
+	}
+	return nil
+}
+
+func (x *ReassessEvidencesRequest) GetTimestampBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.TimestampBefore
+	}
+	return nil
+}
+
+type ReassessEvidencesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The number of evidences that were re-assessed.
+	Assessed int32 `protobuf:"varint,1,opt,name=assessed,proto3" json:"assessed,omitempty"`
+	// The number of evidences that could not be re-assessed.
+	Failed        int32 `protobuf:"varint,2,opt,name=failed,proto3" json:"failed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReassessEvidencesResponse) Reset() {
+	*x = ReassessEvidencesResponse{}
+	mi := &file_api_assessment_assessment_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReassessEvidencesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReassessEvidencesResponse) ProtoMessage() {}
+
+func (x *ReassessEvidencesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_assessment_assessment_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReassessEvidencesResponse.ProtoReflect.Descriptor instead.
+func (*ReassessEvidencesResponse) Descriptor() ([]byte, []int) {
+	return file_api_assessment_assessment_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ReassessEvidencesResponse) GetAssessed() int32 {
+	if x != nil {
+		return x.Assessed
+	}
+	return 0
+}
+
+func (x *ReassessEvidencesResponse) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
 var File_api_assessment_assessment_proto protoreflect.FileDescriptor
 
 const file_api_assessment_assessment_proto_rawDesc = "" +
@@ -318,15 +447,27 @@ const file_api_assessment_assessment_proto_rawDesc = "" +
 	"\x15AssessEvidenceRequest\x12D\n" +
 	"\bevidence\x18\x01 \x01(\v2 .confirmate.evidence.v1.EvidenceB\x06\xbaH\x03\xc8\x01\x01R\bevidence\"\\\n" +
 	"\x16AssessEvidenceResponse\x12B\n" +
-	"\x06status\x18\x01 \x01(\x0e2*.confirmate.assessment.v1.AssessmentStatusR\x06status\"\x84\x01\n" +
+	"\x06status\x18\x01 \x01(\x0e2*.confirmate.assessment.v1.AssessmentStatusR\x06status\"\xa5\x01\n" +
 	"\x17AssessEvidencesResponse\x12B\n" +
 	"\x06status\x18\x01 \x01(\x0e2*.confirmate.assessment.v1.AssessmentStatusR\x06status\x12%\n" +
-	"\x0estatus_message\x18\x02 \x01(\tR\rstatusMessage2\x92\x03\n" +
+	"\x0estatus_message\x18\x02 \x01(\tR\rstatusMessage\x12\x1f\n" +
+	"\vevidence_id\x18\x03 \x01(\tR\n" +
+	"evidenceId\"\x9a\x02\n" +
+	"\x18ReassessEvidencesRequest\x12?\n" +
+	"\x17target_of_evaluation_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x14targetOfEvaluationId\x12H\n" +
+	"\x0ftimestamp_after\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\x0etimestampAfter\x88\x01\x01\x12J\n" +
+	"\x10timestamp_before\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampH\x01R\x0ftimestampBefore\x88\x01\x01B\x12\n" +
+	"\x10_timestamp_afterB\x13\n" +
+	"\x11_timestamp_before\"O\n" +
+	"\x19ReassessEvidencesResponse\x12\x1a\n" +
+	"\bassessed\x18\x01 \x01(\x05R\bassessed\x12\x16\n" +
+	"\x06failed\x18\x02 \x01(\x05R\x06failed2\xba\x04\n" +
 	"\n" +
 	"Assessment\x12e\n" +
 	"\x13CalculateCompliance\x124.confirmate.assessment.v1.CalculateComplianceRequest\x1a\x16.google.protobuf.Empty\"\x00\x12\x9f\x01\n" +
 	"\x0eAssessEvidence\x12/.confirmate.assessment.v1.AssessEvidenceRequest\x1a0.confirmate.assessment.v1.AssessEvidenceResponse\"*\x82\xd3\xe4\x93\x02$:\bevidence\"\x18/v1/assessment/evidences\x12{\n" +
-	"\x0fAssessEvidences\x12/.confirmate.assessment.v1.AssessEvidenceRequest\x1a1.confirmate.assessment.v1.AssessEvidencesResponse\"\x00(\x010\x01B#Z!confirmate.io/core/api/assessmentb\x06proto3"
+	"\x0fAssessEvidences\x12/.confirmate.assessment.v1.AssessEvidenceRequest\x1a1.confirmate.assessment.v1.AssessEvidencesResponse\"\x00(\x010\x01\x12\xa5\x01\n" +
+	"\x11ReassessEvidences\x122.confirmate.assessment.v1.ReassessEvidencesRequest\x1a3.confirmate.assessment.v1.ReassessEvidencesResponse\"'\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/v1/assessment/reassessmentsB#Z!confirmate.io/core/api/assessmentb\x06proto3"
 
 var (
 	file_api_assessment_assessment_proto_rawDescOnce sync.Once
@@ -340,7 +481,7 @@ func file_api_assessment_assessment_proto_rawDescGZIP() []byte {
 	return file_api_assessment_assessment_proto_rawDescData
 }
 
-var file_api_assessment_assessment_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_api_assessment_assessment_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
 var file_api_assessment_assessment_proto_goTypes = []any{
 	(*ConfigureAssessmentRequest)(nil),  // 0: confirmate.assessment.v1.ConfigureAssessmentRequest
 	(*ConfigureAssessmentResponse)(nil), // 1: confirmate.assessment.v1.ConfigureAssessmentResponse
@@ -348,25 +489,32 @@ var file_api_assessment_assessment_proto_goTypes = []any{
 	(*AssessEvidenceRequest)(nil),       // 3: confirmate.assessment.v1.AssessEvidenceRequest
 	(*AssessEvidenceResponse)(nil),      // 4: confirmate.assessment.v1.AssessEvidenceResponse
 	(*AssessEvidencesResponse)(nil),     // 5: confirmate.assessment.v1.AssessEvidencesResponse
-	(*evidence.Evidence)(nil),           // 6: confirmate.evidence.v1.Evidence
-	(AssessmentStatus)(0),               // 7: confirmate.assessment.v1.AssessmentStatus
-	(*emptypb.Empty)(nil),               // 8: google.protobuf.Empty
+	(*ReassessEvidencesRequest)(nil),    // 6: confirmate.assessment.v1.ReassessEvidencesRequest
+	(*ReassessEvidencesResponse)(nil),   // 7: confirmate.assessment.v1.ReassessEvidencesResponse
+	(*evidence.Evidence)(nil),           // 8: confirmate.evidence.v1.Evidence
+	(AssessmentStatus)(0),               // 9: confirmate.assessment.v1.AssessmentStatus
+	(*timestamppb.Timestamp)(nil),       // 10: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),               // 11: google.protobuf.Empty
 }
 var file_api_assessment_assessment_proto_depIdxs = []int32{
-	6, // 0: confirmate.assessment.v1.AssessEvidenceRequest.evidence:type_name -> confirmate.evidence.v1.Evidence
-	7, // 1: confirmate.assessment.v1.AssessEvidenceResponse.status:type_name -> confirmate.assessment.v1.AssessmentStatus
-	7, // 2: confirmate.assessment.v1.AssessEvidencesResponse.status:type_name -> confirmate.assessment.v1.AssessmentStatus
-	2, // 3: confirmate.assessment.v1.Assessment.CalculateCompliance:input_type -> confirmate.assessment.v1.CalculateComplianceRequest
-	3, // 4: confirmate.assessment.v1.Assessment.AssessEvidence:input_type -> confirmate.assessment.v1.AssessEvidenceRequest
-	3, // 5: confirmate.assessment.v1.Assessment.AssessEvidences:input_type -> confirmate.assessment.v1.AssessEvidenceRequest
-	8, // 6: confirmate.assessment.v1.Assessment.CalculateCompliance:output_type -> google.protobuf.Empty
-	4, // 7: confirmate.assessment.v1.Assessment.AssessEvidence:output_type -> confirmate.assessment.v1.AssessEvidenceResponse
-	5, // 8: confirmate.assessment.v1.Assessment.AssessEvidences:output_type -> confirmate.assessment.v1.AssessEvidencesResponse
-	6, // [6:9] is the sub-list for method output_type
-	3, // [3:6] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	8,  // 0: confirmate.assessment.v1.AssessEvidenceRequest.evidence:type_name -> confirmate.evidence.v1.Evidence
+	9,  // 1: confirmate.assessment.v1.AssessEvidenceResponse.status:type_name -> confirmate.assessment.v1.AssessmentStatus
+	9,  // 2: confirmate.assessment.v1.AssessEvidencesResponse.status:type_name -> confirmate.assessment.v1.AssessmentStatus
+	10, // 3: confirmate.assessment.v1.ReassessEvidencesRequest.timestamp_after:type_name -> google.protobuf.Timestamp
+	10, // 4: confirmate.assessment.v1.ReassessEvidencesRequest.timestamp_before:type_name -> google.protobuf.Timestamp
+	2,  // 5: confirmate.assessment.v1.Assessment.CalculateCompliance:input_type -> confirmate.assessment.v1.CalculateComplianceRequest
+	3,  // 6: confirmate.assessment.v1.Assessment.AssessEvidence:input_type -> confirmate.assessment.v1.AssessEvidenceRequest
+	3,  // 7: confirmate.assessment.v1.Assessment.AssessEvidences:input_type -> confirmate.assessment.v1.AssessEvidenceRequest
+	6,  // 8: confirmate.assessment.v1.Assessment.ReassessEvidences:input_type -> confirmate.assessment.v1.ReassessEvidencesRequest
+	11, // 9: confirmate.assessment.v1.Assessment.CalculateCompliance:output_type -> google.protobuf.Empty
+	4,  // 10: confirmate.assessment.v1.Assessment.AssessEvidence:output_type -> confirmate.assessment.v1.AssessEvidenceResponse
+	5,  // 11: confirmate.assessment.v1.Assessment.AssessEvidences:output_type -> confirmate.assessment.v1.AssessEvidencesResponse
+	7,  // 12: confirmate.assessment.v1.Assessment.ReassessEvidences:output_type -> confirmate.assessment.v1.ReassessEvidencesResponse
+	9,  // [9:13] is the sub-list for method output_type
+	5,  // [5:9] is the sub-list for method input_type
+	5,  // [5:5] is the sub-list for extension type_name
+	5,  // [5:5] is the sub-list for extension extendee
+	0,  // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_api_assessment_assessment_proto_init() }
@@ -376,13 +524,14 @@ func file_api_assessment_assessment_proto_init() {
 	}
 	file_api_assessment_metric_proto_init()
 	file_api_assessment_result_proto_init()
+	file_api_assessment_assessment_proto_msgTypes[6].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_assessment_assessment_proto_rawDesc), len(file_api_assessment_assessment_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   6,
+			NumMessages:   8,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
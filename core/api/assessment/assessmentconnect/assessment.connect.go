@@ -57,6 +57,9 @@ const (
 	// AssessmentAssessEvidencesProcedure is the fully-qualified name of the Assessment's
 	// AssessEvidences RPC.
 	AssessmentAssessEvidencesProcedure = "/confirmate.assessment.v1.Assessment/AssessEvidences"
+	// AssessmentReassessEvidencesProcedure is the fully-qualified name of the Assessment's
+	// ReassessEvidences RPC.
+	AssessmentReassessEvidencesProcedure = "/confirmate.assessment.v1.Assessment/ReassessEvidences"
 )
 
 // AssessmentClient is a client for the confirmate.assessment.v1.Assessment service.
@@ -68,8 +71,16 @@ type AssessmentClient interface {
 	// exposed as REST.
 	AssessEvidence(context.Context, *connect.Request[assessment.AssessEvidenceRequest]) (*connect.Response[assessment.AssessEvidenceResponse], error)
 	// Assesses stream of evidences sent by the discovery and returns a response
-	// stream. Part of the public API. Not exposed as REST.
+	// stream. Evidences are assessed concurrently and a response is sent as soon
+	// as the assessment of an evidence is finished. If the server cannot keep
+	// up, it stops receiving evidences, so that the client is slowed down.
+	// Part of the public API. Not exposed as REST.
 	AssessEvidences(context.Context) *connect.BidiStreamForClient[assessment.AssessEvidenceRequest, assessment.AssessEvidencesResponse]
+	// Re-assesses the evidences of a target of evaluation that are stored in
+	// the evidence store against the current metrics, e.g., after a broken
+	// metric implementation was fixed. Part of the public API, also exposed as
+	// REST.
+	ReassessEvidences(context.Context, *connect.Request[assessment.ReassessEvidencesRequest]) (*connect.Response[assessment.ReassessEvidencesResponse], error)
 }
 
 // NewAssessmentClient constructs a client for the confirmate.assessment.v1.Assessment service. By
@@ -101,6 +112,12 @@ func NewAssessmentClient(httpClient connect.HTTPClient, baseURL string, opts ...
 			connect.WithSchema(assessmentMethods.ByName("AssessEvidences")),
 			connect.WithClientOptions(opts...),
 		),
+		reassessEvidences: connect.NewClient[assessment.ReassessEvidencesRequest, assessment.ReassessEvidencesResponse](
+			httpClient,
+			baseURL+AssessmentReassessEvidencesProcedure,
+			connect.WithSchema(assessmentMethods.ByName("ReassessEvidences")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
@@ -109,6 +126,7 @@ type assessmentClient struct {
 	calculateCompliance *connect.Client[assessment.CalculateComplianceRequest, emptypb.Empty]
 	assessEvidence      *connect.Client[assessment.AssessEvidenceRequest, assessment.AssessEvidenceResponse]
 	assessEvidences     *connect.Client[assessment.AssessEvidenceRequest, assessment.AssessEvidencesResponse]
+	reassessEvidences   *connect.Client[assessment.ReassessEvidencesRequest, assessment.ReassessEvidencesResponse]
 }
 
 // CalculateCompliance calls confirmate.assessment.v1.Assessment.CalculateCompliance.
@@ -126,6 +144,11 @@ func (c *assessmentClient) AssessEvidences(ctx context.Context) *connect.BidiStr
 	return c.assessEvidences.CallBidiStream(ctx)
 }
 
+// ReassessEvidences calls confirmate.assessment.v1.Assessment.ReassessEvidences.
+func (c *assessmentClient) ReassessEvidences(ctx context.Context, req *connect.Request[assessment.ReassessEvidencesRequest]) (*connect.Response[assessment.ReassessEvidencesResponse], error) {
+	return c.reassessEvidences.CallUnary(ctx, req)
+}
+
 // AssessmentHandler is an implementation of the confirmate.assessment.v1.Assessment service.
 type AssessmentHandler interface {
 	// Triggers the compliance calculation. Part of the private API. Not exposed
@@ -135,8 +158,16 @@ type AssessmentHandler interface {
 	// exposed as REST.
 	AssessEvidence(context.Context, *connect.Request[assessment.AssessEvidenceRequest]) (*connect.Response[assessment.AssessEvidenceResponse], error)
 	// Assesses stream of evidences sent by the discovery and returns a response
-	// stream. Part of the public API. Not exposed as REST.
+	// stream. Evidences are assessed concurrently and a response is sent as soon
+	// as the assessment of an evidence is finished. If the server cannot keep
+	// up, it stops receiving evidences, so that the client is slowed down.
+	// Part of the public API. Not exposed as REST.
 	AssessEvidences(context.Context, *connect.BidiStream[assessment.AssessEvidenceRequest, assessment.AssessEvidencesResponse]) error
+	// Re-assesses the evidences of a target of evaluation that are stored in
+	// the evidence store against the current metrics, e.g., after a broken
+	// metric implementation was fixed. Part of the public API, also exposed as
+	// REST.
+	ReassessEvidences(context.Context, *connect.Request[assessment.ReassessEvidencesRequest]) (*connect.Response[assessment.ReassessEvidencesResponse], error)
 }
 
 // NewAssessmentHandler builds an HTTP handler from the service implementation. It returns the path
@@ -164,6 +195,12 @@ func NewAssessmentHandler(svc AssessmentHandler, opts ...connect.HandlerOption)
 		connect.WithSchema(assessmentMethods.ByName("AssessEvidences")),
 		connect.WithHandlerOptions(opts...),
 	)
+	assessmentReassessEvidencesHandler := connect.NewUnaryHandler(
+		AssessmentReassessEvidencesProcedure,
+		svc.ReassessEvidences,
+		connect.WithSchema(assessmentMethods.ByName("ReassessEvidences")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/confirmate.assessment.v1.Assessment/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case AssessmentCalculateComplianceProcedure:
@@ -172,6 +209,8 @@ func NewAssessmentHandler(svc AssessmentHandler, opts ...connect.HandlerOption)
 			assessmentAssessEvidenceHandler.ServeHTTP(w, r)
 		case AssessmentAssessEvidencesProcedure:
 			assessmentAssessEvidencesHandler.ServeHTTP(w, r)
+		case AssessmentReassessEvidencesProcedure:
+			assessmentReassessEvidencesHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -192,3 +231,7 @@ func (UnimplementedAssessmentHandler) AssessEvidence(context.Context, *connect.R
 func (UnimplementedAssessmentHandler) AssessEvidences(context.Context, *connect.BidiStream[assessment.AssessEvidenceRequest, assessment.AssessEvidencesResponse]) error {
 	return connect.NewError(connect.CodeUnimplemented, errors.New("confirmate.assessment.v1.Assessment.AssessEvidences is not implemented"))
 }
+
+func (UnimplementedAssessmentHandler) ReassessEvidences(context.Context, *connect.Request[assessment.ReassessEvidencesRequest]) (*connect.Response[assessment.ReassessEvidencesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("confirmate.assessment.v1.Assessment.ReassessEvidences is not implemented"))
+}
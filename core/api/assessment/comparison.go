@@ -0,0 +1,100 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package assessment
+
+import (
+	"errors"
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// ErrUnsupportedOperator is returned by [Compare] if the operator is not supported.
+var ErrUnsupportedOperator = errors.New("unsupported operator")
+
+// Compare compares the value with the target value using the operator of a [MetricConfiguration], in the same way as
+// the policies of the metrics do. The operators "<", ">", "<=" and ">=" require numbers, "isIn" requires a list as
+// target value and "allIn" requires lists as value and target value.
+func Compare(value *structpb.Value, operator string, target *structpb.Value) (ok bool, err error) {
+	switch operator {
+	case "==":
+		return proto.Equal(value, target), nil
+	case "!=":
+		return !proto.Equal(value, target), nil
+	case "<", ">", "<=", ">=":
+		return compareNumbers(value, operator, target)
+	case "isIn":
+		if target.GetListValue() == nil {
+			return false, fmt.Errorf("operator %s requires a list as target value", operator)
+		}
+
+		return contains(target.GetListValue(), value), nil
+	case "allIn":
+		if value.GetListValue() == nil || target.GetListValue() == nil {
+			return false, fmt.Errorf("operator %s requires lists as value and target value", operator)
+		}
+
+		for _, v := range value.GetListValue().GetValues() {
+			if !contains(target.GetListValue(), v) {
+				return false, nil
+			}
+		}
+
+		return true, nil
+	default:
+		return false, fmt.Errorf("%w: %s", ErrUnsupportedOperator, operator)
+	}
+}
+
+// compareNumbers compares two number values using one of the operators "<", ">", "<=" and ">=".
+func compareNumbers(value *structpb.Value, operator string, target *structpb.Value) (ok bool, err error) {
+	var v, t float64
+
+	if _, isNumber := value.GetKind().(*structpb.Value_NumberValue); !isNumber {
+		return false, fmt.Errorf("operator %s requires a number as value", operator)
+	}
+	if _, isNumber := target.GetKind().(*structpb.Value_NumberValue); !isNumber {
+		return false, fmt.Errorf("operator %s requires a number as target value", operator)
+	}
+
+	v = value.GetNumberValue()
+	t = target.GetNumberValue()
+
+	switch operator {
+	case "<":
+		ok = v < t
+	case ">":
+		ok = v > t
+	case "<=":
+		ok = v <= t
+	case ">=":
+		ok = v >= t
+	}
+
+	return ok, nil
+}
+
+// contains checks whether the list contains the value.
+func contains(list *structpb.ListValue, value *structpb.Value) bool {
+	for _, v := range list.GetValues() {
+		if proto.Equal(v, value) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,112 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package assessment
+
+import (
+	"testing"
+
+	"confirmate.io/core/util/assert"
+
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func TestCompare(t *testing.T) {
+	var (
+		list = func(values ...any) *structpb.Value {
+			v, err := structpb.NewList(values)
+			assert.NoError(t, err)
+			return structpb.NewListValue(v)
+		}
+	)
+
+	type args struct {
+		value    *structpb.Value
+		operator string
+		target   *structpb.Value
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    bool
+		wantErr assert.WantErr
+	}{
+		{
+			name:    "== true",
+			args:    args{value: structpb.NewBoolValue(true), operator: "==", target: structpb.NewBoolValue(true)},
+			want:    true,
+			wantErr: assert.NoError,
+		},
+		{
+			name:    "!= true",
+			args:    args{value: structpb.NewStringValue("a"), operator: "!=", target: structpb.NewStringValue("b")},
+			want:    true,
+			wantErr: assert.NoError,
+		},
+		{
+			name:    ">= false",
+			args:    args{value: structpb.NewNumberValue(30), operator: ">=", target: structpb.NewNumberValue(90)},
+			want:    false,
+			wantErr: assert.NoError,
+		},
+		{
+			name:    "< true",
+			args:    args{value: structpb.NewNumberValue(1.1), operator: "<", target: structpb.NewNumberValue(1.2)},
+			want:    true,
+			wantErr: assert.NoError,
+		},
+		{
+			name: "> requires numbers",
+			args: args{value: structpb.NewStringValue("1"), operator: ">", target: structpb.NewNumberValue(0)},
+			want: false,
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.ErrorContains(t, err, "requires a number as value")
+			},
+		},
+		{
+			name:    "isIn true",
+			args:    args{value: structpb.NewStringValue("TLS1.3"), operator: "isIn", target: list("TLS1.2", "TLS1.3")},
+			want:    true,
+			wantErr: assert.NoError,
+		},
+		{
+			name:    "allIn false",
+			args:    args{value: list("TLS1.1", "TLS1.3"), operator: "allIn", target: list("TLS1.2", "TLS1.3")},
+			want:    false,
+			wantErr: assert.NoError,
+		},
+		{
+			name:    "allIn true",
+			args:    args{value: list("TLS1.3"), operator: "allIn", target: list("TLS1.2", "TLS1.3")},
+			want:    true,
+			wantErr: assert.NoError,
+		},
+		{
+			name: "unsupported operator",
+			args: args{value: structpb.NewNumberValue(1), operator: "~", target: structpb.NewNumberValue(1)},
+			want: false,
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.ErrorIs(t, err, ErrUnsupportedOperator)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Compare(tt.args.value, tt.args.operator, tt.args.target)
+			tt.wantErr(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
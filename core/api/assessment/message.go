@@ -0,0 +1,80 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package assessment
+
+import (
+	"strings"
+	"text/template"
+)
+
+// MessageData is the data that is available to the message templates of a [Metric].
+type MessageData struct {
+	// Resource contains the properties of the assessed resource, as they are passed to the policy of the metric.
+	Resource map[string]any
+	// Operator is the operator of the metric configuration, e.g., "==".
+	Operator string
+	// TargetValue is the target value of the metric configuration.
+	TargetValue any
+}
+
+// NewMessageData creates the [MessageData] of an assessment of the given resource map with the given metric
+// configuration.
+func NewMessageData(resource map[string]any, config *MetricConfiguration) *MessageData {
+	return &MessageData{
+		Resource:    resource,
+		Operator:    config.GetOperator(),
+		TargetValue: config.GetTargetValue().AsInterface(),
+	}
+}
+
+// ParseMessageTemplate parses a message template of a metric. Templates referring to properties the data does not
+// contain fail to render instead of rendering "<no value>".
+func ParseMessageTemplate(text string) (tmpl *template.Template, err error) {
+	return template.New("message").Option("missingkey=error").Parse(text)
+}
+
+// Message returns the message of an assessment result of the metric. If the metric has a message template for the
+// outcome of the assessment, it is rendered with the given data. Otherwise, or if the template cannot be rendered, the
+// generic [DefaultCompliantMessage] or [DefaultNonCompliantMessage] is returned.
+func (x *Metric) Message(compliant bool, data *MessageData) string {
+	var (
+		text     = x.GetNonCompliantMessageTemplate()
+		fallback = DefaultNonCompliantMessage
+		tmpl     *template.Template
+		b        strings.Builder
+		err      error
+	)
+
+	if compliant {
+		text = x.GetCompliantMessageTemplate()
+		fallback = DefaultCompliantMessage
+	}
+
+	if text == "" {
+		return fallback
+	}
+
+	tmpl, err = ParseMessageTemplate(text)
+	if err != nil {
+		return fallback
+	}
+
+	if err = tmpl.Execute(&b, data); err != nil {
+		return fallback
+	}
+
+	return b.String()
+}
@@ -0,0 +1,97 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package assessment
+
+import (
+	"testing"
+
+	"confirmate.io/core/util/assert"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func TestMetric_Message(t *testing.T) {
+	var data = NewMessageData(map[string]any{
+		"name":        "my-vm",
+		"bootLogging": map[string]any{"enabled": false},
+	}, &MetricConfiguration{
+		Operator:    "==",
+		TargetValue: structpb.NewBoolValue(true),
+	})
+
+	type args struct {
+		compliant bool
+		data      *MessageData
+	}
+	tests := []struct {
+		name   string
+		metric *Metric
+		args   args
+		want   string
+	}{
+		{
+			name:   "no template, compliant",
+			metric: &Metric{},
+			args:   args{compliant: true, data: data},
+			want:   DefaultCompliantMessage,
+		},
+		{
+			name:   "no template, non-compliant",
+			metric: &Metric{NonCompliantMessageTemplate: nil},
+			args:   args{compliant: false, data: data},
+			want:   DefaultNonCompliantMessage,
+		},
+		{
+			name: "compliant template",
+			metric: &Metric{
+				CompliantMessageTemplate:    new("Boot logging of {{.Resource.name}} is enabled."),
+				NonCompliantMessageTemplate: new("Boot logging of {{.Resource.name}} is disabled."),
+			},
+			args: args{compliant: true, data: data},
+			want: "Boot logging of my-vm is enabled.",
+		},
+		{
+			name: "non-compliant template with target value",
+			metric: &Metric{
+				NonCompliantMessageTemplate: new("Boot logging of {{.Resource.name}} is {{.Resource.bootLogging.enabled}}, " +
+					"but must be {{.Operator}} {{.TargetValue}}."),
+			},
+			args: args{compliant: false, data: data},
+			want: "Boot logging of my-vm is false, but must be == true.",
+		},
+		{
+			name: "missing property falls back to the default message",
+			metric: &Metric{
+				NonCompliantMessageTemplate: new("Owner is {{.Resource.owner}}."),
+			},
+			args: args{compliant: false, data: data},
+			want: DefaultNonCompliantMessage,
+		},
+		{
+			name: "invalid template falls back to the default message",
+			metric: &Metric{
+				CompliantMessageTemplate: new("{{.Resource.name"),
+			},
+			args: args{compliant: true, data: data},
+			want: DefaultCompliantMessage,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.metric.Message(tt.args.compliant, tt.args.data)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -18,10 +18,60 @@ package assessment
 import (
 	"encoding/base64"
 	"fmt"
+	"slices"
 
 	"google.golang.org/protobuf/encoding/protojson"
 )
 
+// AppliesTo checks whether the metric is potentially applicable to a resource with the given ontology resource types.
+// Metrics that do not declare any resource types are potentially applicable to all resources.
+func (x *Metric) AppliesTo(types []string) bool {
+	if len(x.GetResourceTypes()) == 0 {
+		return true
+	}
+
+	return slices.ContainsFunc(x.GetResourceTypes(), func(typ string) bool {
+		return slices.Contains(types, typ)
+	})
+}
+
+// Combine combines the compliance of the results of the metrics of the composite metric, keyed by the metric ID.
+// Metrics without a result are not considered. If none of the metrics has a result, the composite metric is not
+// applicable.
+func (x *CompositeMetric) Combine(compliance map[string]bool) (applicable bool, compliant bool) {
+	var (
+		results int
+		passed  int
+	)
+
+	for _, id := range x.GetMetricIds() {
+		c, ok := compliance[id]
+		if !ok {
+			continue
+		}
+
+		results++
+		if c {
+			passed++
+		}
+	}
+
+	if results == 0 {
+		return false, false
+	}
+
+	switch x.GetOperator() {
+	case CompositeOperator_COMPOSITE_OPERATOR_AND:
+		return true, passed == results
+	case CompositeOperator_COMPOSITE_OPERATOR_OR:
+		return true, passed > 0
+	case CompositeOperator_COMPOSITE_OPERATOR_THRESHOLD:
+		return true, passed >= int(x.GetThreshold())
+	default:
+		return true, false
+	}
+}
+
 // Hash provides a simple string based hash for this metric configuration. It can be used
 // to provide a key for a map or a cache.
 func (x *MetricConfiguration) Hash() string {
@@ -41,6 +41,121 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// CompositeOperator defines how a composite metric combines the results of its metrics. Metrics without a result for a
+// resource, e.g., because they are not applicable to it, are not considered. If none of the metrics has a result, the
+// composite metric is not applicable either.
+type CompositeOperator int32
+
+const (
+	CompositeOperator_COMPOSITE_OPERATOR_UNSPECIFIED CompositeOperator = 0
+	// All results must be compliant.
+	CompositeOperator_COMPOSITE_OPERATOR_AND CompositeOperator = 1
+	// At least one result must be compliant.
+	CompositeOperator_COMPOSITE_OPERATOR_OR CompositeOperator = 2
+	// At least threshold results must be compliant.
+	CompositeOperator_COMPOSITE_OPERATOR_THRESHOLD CompositeOperator = 3
+)
+
+// Enum value maps for CompositeOperator.
+var (
+	CompositeOperator_name = map[int32]string{
+		0: "COMPOSITE_OPERATOR_UNSPECIFIED",
+		1: "COMPOSITE_OPERATOR_AND",
+		2: "COMPOSITE_OPERATOR_OR",
+		3: "COMPOSITE_OPERATOR_THRESHOLD",
+	}
+	CompositeOperator_value = map[string]int32{
+		"COMPOSITE_OPERATOR_UNSPECIFIED": 0,
+		"COMPOSITE_OPERATOR_AND":         1,
+		"COMPOSITE_OPERATOR_OR":          2,
+		"COMPOSITE_OPERATOR_THRESHOLD":   3,
+	}
+)
+
+func (x CompositeOperator) Enum() *CompositeOperator {
+	p := new(CompositeOperator)
+	*p = x
+	return p
+}
+
+func (x CompositeOperator) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CompositeOperator) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_assessment_metric_proto_enumTypes[0].Descriptor()
+}
+
+func (CompositeOperator) Type() protoreflect.EnumType {
+	return &file_api_assessment_metric_proto_enumTypes[0]
+}
+
+func (x CompositeOperator) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CompositeOperator.Descriptor instead.
+func (CompositeOperator) EnumDescriptor() ([]byte, []int) {
+	return file_api_assessment_metric_proto_rawDescGZIP(), []int{0}
+}
+
+// MetricConfigurationSource describes the layer a metric configuration was
+// resolved from.
+type MetricConfigurationSource int32
+
+const (
+	MetricConfigurationSource_METRIC_CONFIGURATION_SOURCE_UNSPECIFIED MetricConfigurationSource = 0
+	// The global default configuration of the metric.
+	MetricConfigurationSource_METRIC_CONFIGURATION_SOURCE_DEFAULT MetricConfigurationSource = 1
+	// The default configuration of a catalog.
+	MetricConfigurationSource_METRIC_CONFIGURATION_SOURCE_CATALOG_DEFAULT MetricConfigurationSource = 2
+	// An explicit configuration of the target of evaluation.
+	MetricConfigurationSource_METRIC_CONFIGURATION_SOURCE_TARGET_OF_EVALUATION MetricConfigurationSource = 3
+)
+
+// Enum value maps for MetricConfigurationSource.
+var (
+	MetricConfigurationSource_name = map[int32]string{
+		0: "METRIC_CONFIGURATION_SOURCE_UNSPECIFIED",
+		1: "METRIC_CONFIGURATION_SOURCE_DEFAULT",
+		2: "METRIC_CONFIGURATION_SOURCE_CATALOG_DEFAULT",
+		3: "METRIC_CONFIGURATION_SOURCE_TARGET_OF_EVALUATION",
+	}
+	MetricConfigurationSource_value = map[string]int32{
+		"METRIC_CONFIGURATION_SOURCE_UNSPECIFIED":          0,
+		"METRIC_CONFIGURATION_SOURCE_DEFAULT":              1,
+		"METRIC_CONFIGURATION_SOURCE_CATALOG_DEFAULT":      2,
+		"METRIC_CONFIGURATION_SOURCE_TARGET_OF_EVALUATION": 3,
+	}
+)
+
+func (x MetricConfigurationSource) Enum() *MetricConfigurationSource {
+	p := new(MetricConfigurationSource)
+	*p = x
+	return p
+}
+
+func (x MetricConfigurationSource) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MetricConfigurationSource) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_assessment_metric_proto_enumTypes[1].Descriptor()
+}
+
+func (MetricConfigurationSource) Type() protoreflect.EnumType {
+	return &file_api_assessment_metric_proto_enumTypes[1]
+}
+
+func (x MetricConfigurationSource) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MetricConfigurationSource.Descriptor instead.
+func (MetricConfigurationSource) EnumDescriptor() ([]byte, []int) {
+	return file_api_assessment_metric_proto_rawDescGZIP(), []int{1}
+}
+
 type MetricImplementation_Language int32
 
 const (
@@ -71,11 +186,11 @@ func (x MetricImplementation_Language) String() string {
 }
 
 func (MetricImplementation_Language) Descriptor() protoreflect.EnumDescriptor {
-	return file_api_assessment_metric_proto_enumTypes[0].Descriptor()
+	return file_api_assessment_metric_proto_enumTypes[2].Descriptor()
 }
 
 func (MetricImplementation_Language) Type() protoreflect.EnumType {
-	return &file_api_assessment_metric_proto_enumTypes[0]
+	return &file_api_assessment_metric_proto_enumTypes[2]
 }
 
 func (x MetricImplementation_Language) Number() protoreflect.EnumNumber {
@@ -84,7 +199,7 @@ func (x MetricImplementation_Language) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use MetricImplementation_Language.Descriptor instead.
 func (MetricImplementation_Language) EnumDescriptor() ([]byte, []int) {
-	return file_api_assessment_metric_proto_rawDescGZIP(), []int{2, 0}
+	return file_api_assessment_metric_proto_rawDescGZIP(), []int{8, 0}
 }
 
 // A metric resource
@@ -108,8 +223,29 @@ type Metric struct {
 	// Optional, but required if the metric is removed. The metric is not deleted
 	// for backward compatibility and the timestamp is set to the time of removal.
 	DeprecatedSince *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=deprecated_since,json=deprecatedSince,proto3,oneof" json:"deprecated_since,omitempty" gorm:"serializer:timestamppb;type:timestamp"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	// The ontology resource types, e.g., "VirtualMachine", this metric applies to. If empty, the metric is potentially
+	// applicable to all resource types.
+	ResourceTypes []string `protobuf:"bytes,9,rep,name=resource_types,json=resourceTypes,proto3" json:"resource_types,omitempty" gorm:"serializer:json" yaml:"resourceTypes"`
+	// The fields of the evidence resource this metric reads, e.g., "bootLogging.enabled". They describe which
+	// information a collector needs to provide in order for the metric to be assessed.
+	EvidenceFields []string `protobuf:"bytes,10,rep,name=evidence_fields,json=evidenceFields,proto3" json:"evidence_fields,omitempty" gorm:"serializer:json" yaml:"evidenceFields"`
+	// The template of the message of compliant assessment results, written in the syntax of Go's text/template. It can
+	// refer to the properties of the resource (e.g., {{.Resource.name}}) as well as to the operator ({{.Operator}}) and
+	// the target value ({{.TargetValue}}) of the metric configuration. If not set, a generic message is used.
+	CompliantMessageTemplate *string `protobuf:"bytes,11,opt,name=compliant_message_template,json=compliantMessageTemplate,proto3,oneof" json:"compliant_message_template,omitempty" yaml:"compliantMessageTemplate"`
+	// The template of the message of non-compliant assessment results, see compliant_message_template.
+	NonCompliantMessageTemplate *string `protobuf:"bytes,12,opt,name=non_compliant_message_template,json=nonCompliantMessageTemplate,proto3,oneof" json:"non_compliant_message_template,omitempty" yaml:"nonCompliantMessageTemplate"`
+	// Optional. If set, the metric is a composite metric. Its results are computed from the results of other metrics for
+	// the same resource instead of an implementation of its own.
+	Composite *CompositeMetric `protobuf:"bytes,13,opt,name=composite,proto3,oneof" json:"composite,omitempty" gorm:"serializer:json" yaml:"composite"`
+	// Optional. If set, the metric correlates the evidences of several tools for the same resource, e.g., a log evidence
+	// and a configuration evidence.
+	Correlation *EvidenceCorrelation `protobuf:"bytes,14,opt,name=correlation,proto3,oneof" json:"correlation,omitempty" gorm:"serializer:json" yaml:"correlation"`
+	// Optional. Guidance on how to fix non-compliant resources of this metric. It is included in the failing metrics of
+	// evaluation results.
+	Remediation   *Remediation `protobuf:"bytes,15,opt,name=remediation,proto3,oneof" json:"remediation,omitempty" gorm:"serializer:json" yaml:"remediation"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Metric) Reset() {
@@ -198,6 +334,344 @@ func (x *Metric) GetDeprecatedSince() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Metric) GetResourceTypes() []string {
+	if x != nil {
+		return x.ResourceTypes
+	}
+	return nil
+}
+
+func (x *Metric) GetEvidenceFields() []string {
+	if x != nil {
+		return x.EvidenceFields
+	}
+	return nil
+}
+
+func (x *Metric) GetCompliantMessageTemplate() string {
+	if x != nil && x.CompliantMessageTemplate != nil {
+		return *x.CompliantMessageTemplate
+	}
+	return ""
+}
+
+func (x *Metric) GetNonCompliantMessageTemplate() string {
+	if x != nil && x.NonCompliantMessageTemplate != nil {
+		return *x.NonCompliantMessageTemplate
+	}
+	return ""
+}
+
+func (x *Metric) GetComposite() *CompositeMetric {
+	if x != nil {
+		return x.Composite
+	}
+	return nil
+}
+
+func (x *Metric) GetCorrelation() *EvidenceCorrelation {
+	if x != nil {
+		return x.Correlation
+	}
+	return nil
+}
+
+func (x *Metric) GetRemediation() *Remediation {
+	if x != nil {
+		return x.Remediation
+	}
+	return nil
+}
+
+// A Remediation describes how to fix a finding, e.g., of a metric or a control, so that engineers see it right where
+// the finding is reported.
+type Remediation struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The guidance, formatted as Markdown.
+	Text string `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty" yaml:"text"`
+	// Links to further documentation, e.g., of the cloud provider.
+	Links []*RemediationLink `protobuf:"bytes,2,rep,name=links,proto3" json:"links,omitempty" yaml:"links"`
+	// Optional. A snippet of infrastructure as code that fixes the finding.
+	IacSnippet    *IacSnippet `protobuf:"bytes,3,opt,name=iac_snippet,json=iacSnippet,proto3,oneof" json:"iac_snippet,omitempty" yaml:"iacSnippet"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Remediation) Reset() {
+	*x = Remediation{}
+	mi := &file_api_assessment_metric_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Remediation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Remediation) ProtoMessage() {}
+
+func (x *Remediation) ProtoReflect() protoreflect.Message {
+	mi := &file_api_assessment_metric_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Remediation.ProtoReflect.Descriptor instead.
+func (*Remediation) Descriptor() ([]byte, []int) {
+	return file_api_assessment_metric_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Remediation) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *Remediation) GetLinks() []*RemediationLink {
+	if x != nil {
+		return x.Links
+	}
+	return nil
+}
+
+func (x *Remediation) GetIacSnippet() *IacSnippet {
+	if x != nil {
+		return x.IacSnippet
+	}
+	return nil
+}
+
+// A RemediationLink is a link to further documentation of a remediation.
+type RemediationLink struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The title of the link, e.g., "Enable boot diagnostics"
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty" yaml:"title"`
+	// The URL of the link
+	Url           string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty" yaml:"url"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemediationLink) Reset() {
+	*x = RemediationLink{}
+	mi := &file_api_assessment_metric_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemediationLink) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemediationLink) ProtoMessage() {}
+
+func (x *RemediationLink) ProtoReflect() protoreflect.Message {
+	mi := &file_api_assessment_metric_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemediationLink.ProtoReflect.Descriptor instead.
+func (*RemediationLink) Descriptor() ([]byte, []int) {
+	return file_api_assessment_metric_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *RemediationLink) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *RemediationLink) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+// An IacSnippet is a snippet of infrastructure as code, e.g., a Terraform resource.
+type IacSnippet struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The language of the snippet, e.g., "terraform", "bicep" or "cloudformation"
+	Language string `protobuf:"bytes,1,opt,name=language,proto3" json:"language,omitempty" yaml:"language"`
+	// The code of the snippet
+	Code          string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty" yaml:"code"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IacSnippet) Reset() {
+	*x = IacSnippet{}
+	mi := &file_api_assessment_metric_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IacSnippet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IacSnippet) ProtoMessage() {}
+
+func (x *IacSnippet) ProtoReflect() protoreflect.Message {
+	mi := &file_api_assessment_metric_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IacSnippet.ProtoReflect.Descriptor instead.
+func (*IacSnippet) Descriptor() ([]byte, []int) {
+	return file_api_assessment_metric_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *IacSnippet) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *IacSnippet) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+// An EvidenceCorrelation requires evidences of a resource collected by several tools. The metric is only assessed,
+// once an evidence of each tool was observed for the resource within the correlation window of the assessment service.
+// The resources of the evidences of the other tools are available to the implementation in "correlated", keyed by the
+// ID of the tool.
+type EvidenceCorrelation struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The IDs of the tools whose evidences are correlated.
+	ToolIds       []string `protobuf:"bytes,1,rep,name=tool_ids,json=toolIds,proto3" json:"tool_ids,omitempty" yaml:"toolIds"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EvidenceCorrelation) Reset() {
+	*x = EvidenceCorrelation{}
+	mi := &file_api_assessment_metric_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EvidenceCorrelation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EvidenceCorrelation) ProtoMessage() {}
+
+func (x *EvidenceCorrelation) ProtoReflect() protoreflect.Message {
+	mi := &file_api_assessment_metric_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EvidenceCorrelation.ProtoReflect.Descriptor instead.
+func (*EvidenceCorrelation) Descriptor() ([]byte, []int) {
+	return file_api_assessment_metric_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *EvidenceCorrelation) GetToolIds() []string {
+	if x != nil {
+		return x.ToolIds
+	}
+	return nil
+}
+
+// A CompositeMetric combines the results of other metrics, e.g., to require that encryption is enabled AND uses a
+// strong algorithm AND its keys are rotated, without duplicating their implementations.
+type CompositeMetric struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The IDs of the metrics whose results are combined. They cannot be composite metrics themselves.
+	MetricIds []string `protobuf:"bytes,1,rep,name=metric_ids,json=metricIds,proto3" json:"metric_ids,omitempty" yaml:"metricIds"`
+	// The operator that combines the compliance of the results.
+	Operator CompositeOperator `protobuf:"varint,2,opt,name=operator,proto3,enum=confirmate.assessment.v1.CompositeOperator" json:"operator,omitempty"`
+	// The minimum number of compliant results, only used by COMPOSITE_OPERATOR_THRESHOLD.
+	Threshold     *int32 `protobuf:"varint,3,opt,name=threshold,proto3,oneof" json:"threshold,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompositeMetric) Reset() {
+	*x = CompositeMetric{}
+	mi := &file_api_assessment_metric_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompositeMetric) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompositeMetric) ProtoMessage() {}
+
+func (x *CompositeMetric) ProtoReflect() protoreflect.Message {
+	mi := &file_api_assessment_metric_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompositeMetric.ProtoReflect.Descriptor instead.
+func (*CompositeMetric) Descriptor() ([]byte, []int) {
+	return file_api_assessment_metric_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CompositeMetric) GetMetricIds() []string {
+	if x != nil {
+		return x.MetricIds
+	}
+	return nil
+}
+
+func (x *CompositeMetric) GetOperator() CompositeOperator {
+	if x != nil {
+		return x.Operator
+	}
+	return CompositeOperator_COMPOSITE_OPERATOR_UNSPECIFIED
+}
+
+func (x *CompositeMetric) GetThreshold() int32 {
+	if x != nil && x.Threshold != nil {
+		return *x.Threshold
+	}
+	return 0
+}
+
 // Defines the operator and a target value for an individual metric
 type MetricConfiguration struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -213,13 +687,26 @@ type MetricConfiguration struct {
 	MetricId string `protobuf:"bytes,5,opt,name=metric_id,json=metricId,proto3" json:"metric_id,omitempty" gorm:"primaryKey"`
 	// The target of evaluation this configuration belongs to.
 	TargetOfEvaluationId string `protobuf:"bytes,6,opt,name=target_of_evaluation_id,json=targetOfEvaluationId,proto3" json:"target_of_evaluation_id,omitempty" gorm:"primaryKey"`
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
+	// The layer this configuration was resolved from. Configurations are
+	// resolved in the following order: an explicit configuration of the target
+	// of evaluation, the default configuration of the catalog and finally the
+	// global default configuration of the metric.
+	Source MetricConfigurationSource `protobuf:"varint,7,opt,name=source,proto3,enum=confirmate.assessment.v1.MetricConfigurationSource" json:"source,omitempty" gorm:"-"`
+	// The catalog whose default configuration was used, if the source is
+	// METRIC_CONFIGURATION_SOURCE_CATALOG_DEFAULT.
+	CatalogId *string `protobuf:"bytes,8,opt,name=catalog_id,json=catalogId,proto3,oneof" json:"catalog_id,omitempty" gorm:"-"`
+	// The maximum age in hours of the evidence of an assessment result of this
+	// metric. Older assessment results are considered to be stale. It takes
+	// precedence over the maximum evidence age of the resource types configured
+	// in the assessment service.
+	MaxEvidenceAgeHours *uint32 `protobuf:"varint,9,opt,name=max_evidence_age_hours,json=maxEvidenceAgeHours,proto3,oneof" json:"max_evidence_age_hours,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
 func (x *MetricConfiguration) Reset() {
 	*x = MetricConfiguration{}
-	mi := &file_api_assessment_metric_proto_msgTypes[1]
+	mi := &file_api_assessment_metric_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -231,7 +718,7 @@ func (x *MetricConfiguration) String() string {
 func (*MetricConfiguration) ProtoMessage() {}
 
 func (x *MetricConfiguration) ProtoReflect() protoreflect.Message {
-	mi := &file_api_assessment_metric_proto_msgTypes[1]
+	mi := &file_api_assessment_metric_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -244,7 +731,7 @@ func (x *MetricConfiguration) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MetricConfiguration.ProtoReflect.Descriptor instead.
 func (*MetricConfiguration) Descriptor() ([]byte, []int) {
-	return file_api_assessment_metric_proto_rawDescGZIP(), []int{1}
+	return file_api_assessment_metric_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *MetricConfiguration) GetOperator() string {
@@ -289,6 +776,112 @@ func (x *MetricConfiguration) GetTargetOfEvaluationId() string {
 	return ""
 }
 
+func (x *MetricConfiguration) GetSource() MetricConfigurationSource {
+	if x != nil {
+		return x.Source
+	}
+	return MetricConfigurationSource_METRIC_CONFIGURATION_SOURCE_UNSPECIFIED
+}
+
+func (x *MetricConfiguration) GetCatalogId() string {
+	if x != nil && x.CatalogId != nil {
+		return *x.CatalogId
+	}
+	return ""
+}
+
+func (x *MetricConfiguration) GetMaxEvidenceAgeHours() uint32 {
+	if x != nil && x.MaxEvidenceAgeHours != nil {
+		return *x.MaxEvidenceAgeHours
+	}
+	return 0
+}
+
+// CatalogMetricConfiguration defines the default operator and target value of
+// an individual metric for all targets of evaluation that are assessed
+// against a specific catalog, e.g., a catalog that requires a stronger
+// encryption algorithm than the global default.
+type CatalogMetricConfiguration struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The catalog this configuration belongs to.
+	CatalogId string `protobuf:"bytes,1,opt,name=catalog_id,json=catalogId,proto3" json:"catalog_id,omitempty" gorm:"primaryKey"`
+	// The metric this configuration belongs to.
+	MetricId string `protobuf:"bytes,2,opt,name=metric_id,json=metricId,proto3" json:"metric_id,omitempty" gorm:"primaryKey"`
+	// The operator to compare the metric, such as "==" or ">"
+	Operator string `protobuf:"bytes,3,opt,name=operator,proto3" json:"operator,omitempty"`
+	// The target value
+	TargetValue *structpb.Value `protobuf:"bytes,4,opt,name=target_value,json=targetValue,proto3" json:"target_value,omitempty" gorm:"serializer:json"`
+	// The last time of update
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty" gorm:"serializer:timestamppb;type:timestamp"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CatalogMetricConfiguration) Reset() {
+	*x = CatalogMetricConfiguration{}
+	mi := &file_api_assessment_metric_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CatalogMetricConfiguration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CatalogMetricConfiguration) ProtoMessage() {}
+
+func (x *CatalogMetricConfiguration) ProtoReflect() protoreflect.Message {
+	mi := &file_api_assessment_metric_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CatalogMetricConfiguration.ProtoReflect.Descriptor instead.
+func (*CatalogMetricConfiguration) Descriptor() ([]byte, []int) {
+	return file_api_assessment_metric_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CatalogMetricConfiguration) GetCatalogId() string {
+	if x != nil {
+		return x.CatalogId
+	}
+	return ""
+}
+
+func (x *CatalogMetricConfiguration) GetMetricId() string {
+	if x != nil {
+		return x.MetricId
+	}
+	return ""
+}
+
+func (x *CatalogMetricConfiguration) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+func (x *CatalogMetricConfiguration) GetTargetValue() *structpb.Value {
+	if x != nil {
+		return x.TargetValue
+	}
+	return nil
+}
+
+func (x *CatalogMetricConfiguration) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
 // MetricImplementation defines the implementation of an individual metric.
 type MetricImplementation struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -306,7 +899,7 @@ type MetricImplementation struct {
 
 func (x *MetricImplementation) Reset() {
 	*x = MetricImplementation{}
-	mi := &file_api_assessment_metric_proto_msgTypes[2]
+	mi := &file_api_assessment_metric_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -318,7 +911,7 @@ func (x *MetricImplementation) String() string {
 func (*MetricImplementation) ProtoMessage() {}
 
 func (x *MetricImplementation) ProtoReflect() protoreflect.Message {
-	mi := &file_api_assessment_metric_proto_msgTypes[2]
+	mi := &file_api_assessment_metric_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -331,7 +924,7 @@ func (x *MetricImplementation) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MetricImplementation.ProtoReflect.Descriptor instead.
 func (*MetricImplementation) Descriptor() ([]byte, []int) {
-	return file_api_assessment_metric_proto_rawDescGZIP(), []int{2}
+	return file_api_assessment_metric_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *MetricImplementation) GetMetricId() string {
@@ -362,11 +955,78 @@ func (x *MetricImplementation) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+// MetricBundle contains everything the assessment service needs to assess evidences without a connection to the
+// orchestrator, e.g., inside an isolated network.
+type MetricBundle struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The metrics to assess
+	Metrics []*Metric `protobuf:"bytes,1,rep,name=metrics,proto3" json:"metrics,omitempty"`
+	// The implementations of the metrics
+	Implementations []*MetricImplementation `protobuf:"bytes,2,rep,name=implementations,proto3" json:"implementations,omitempty"`
+	// The configurations of the metrics. Each configuration applies to the metric and target of evaluation it
+	// references. A configuration with is_default set also applies to all other targets of evaluation without an
+	// explicit configuration of the metric.
+	Configurations []*MetricConfiguration `protobuf:"bytes,3,rep,name=configurations,proto3" json:"configurations,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *MetricBundle) Reset() {
+	*x = MetricBundle{}
+	mi := &file_api_assessment_metric_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MetricBundle) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MetricBundle) ProtoMessage() {}
+
+func (x *MetricBundle) ProtoReflect() protoreflect.Message {
+	mi := &file_api_assessment_metric_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MetricBundle.ProtoReflect.Descriptor instead.
+func (*MetricBundle) Descriptor() ([]byte, []int) {
+	return file_api_assessment_metric_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *MetricBundle) GetMetrics() []*Metric {
+	if x != nil {
+		return x.Metrics
+	}
+	return nil
+}
+
+func (x *MetricBundle) GetImplementations() []*MetricImplementation {
+	if x != nil {
+		return x.Implementations
+	}
+	return nil
+}
+
+func (x *MetricBundle) GetConfigurations() []*MetricConfiguration {
+	if x != nil {
+		return x.Configurations
+	}
+	return nil
+}
+
 var File_api_assessment_metric_proto protoreflect.FileDescriptor
 
 const file_api_assessment_metric_proto_rawDesc = "" +
 	"\n" +
-	"\x1bapi/assessment/metric.proto\x12\x18confirmate.assessment.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x13tagger/tagger.proto\"\xe1\x03\n" +
+	"\x1bapi/assessment/metric.proto\x12\x18confirmate.assessment.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x13tagger/tagger.proto\"\x89\v\n" +
 	"\x06Metric\x12\x1b\n" +
 	"\x02id\x18\x01 \x01(\tB\v\xe0A\x02\xbaH\x05r\x03\xb0\x01\x01R\x02id\x12\x1e\n" +
 	"\x04name\x18\x02 \x01(\tB\n" +
@@ -379,9 +1039,48 @@ const file_api_assessment_metric_proto_rawDesc = "" +
 	"\bcategory\x18\x06 \x01(\tB\n" +
 	"\xe0A\x02\xbaH\x04r\x02\x10\x01R\bcategory\x12[\n" +
 	"\x0eimplementation\x18\a \x01(\v2..confirmate.assessment.v1.MetricImplementationH\x00R\x0eimplementation\x88\x01\x01\x12}\n" +
-	"\x10deprecated_since\x18\b \x01(\v2\x1a.google.protobuf.TimestampB1\x9a\x84\x9e\x03,gorm:\"serializer:timestamppb;type:timestamp\"H\x01R\x0fdeprecatedSince\x88\x01\x01B\x11\n" +
+	"\x10deprecated_since\x18\b \x01(\v2\x1a.google.protobuf.TimestampB1\x9a\x84\x9e\x03,gorm:\"serializer:timestamppb;type:timestamp\"H\x01R\x0fdeprecatedSince\x88\x01\x01\x12c\n" +
+	"\x0eresource_types\x18\t \x03(\tB<\xbaH\t\x92\x01\x06\"\x04r\x02\x10\x01\x9a\x84\x9e\x03+gorm:\"serializer:json\" yaml:\"resourceTypes\"R\rresourceTypes\x12f\n" +
+	"\x0fevidence_fields\x18\n" +
+	" \x03(\tB=\xbaH\t\x92\x01\x06\"\x04r\x02\x10\x01\x9a\x84\x9e\x03,gorm:\"serializer:json\" yaml:\"evidenceFields\"R\x0eevidenceFields\x12n\n" +
+	"\x1acompliant_message_template\x18\v \x01(\tB+\xbaH\x04r\x02\x10\x01\x9a\x84\x9e\x03\x1fyaml:\"compliantMessageTemplate\"H\x02R\x18compliantMessageTemplate\x88\x01\x01\x12x\n" +
+	"\x1enon_compliant_message_template\x18\f \x01(\tB.\xbaH\x04r\x02\x10\x01\x9a\x84\x9e\x03\"yaml:\"nonCompliantMessageTemplate\"H\x03R\x1bnonCompliantMessageTemplate\x88\x01\x01\x12z\n" +
+	"\tcomposite\x18\r \x01(\v2).confirmate.assessment.v1.CompositeMetricB,\x9a\x84\x9e\x03'gorm:\"serializer:json\" yaml:\"composite\"H\x04R\tcomposite\x88\x01\x01\x12\x84\x01\n" +
+	"\vcorrelation\x18\x0e \x01(\v2-.confirmate.assessment.v1.EvidenceCorrelationB.\x9a\x84\x9e\x03)gorm:\"serializer:json\" yaml:\"correlation\"H\x05R\vcorrelation\x88\x01\x01\x12|\n" +
+	"\vremediation\x18\x0f \x01(\v2%.confirmate.assessment.v1.RemediationB.\x9a\x84\x9e\x03)gorm:\"serializer:json\" yaml:\"remediation\"H\x06R\vremediation\x88\x01\x01B\x11\n" +
 	"\x0f_implementationB\x13\n" +
-	"\x11_deprecated_since\"\xe7\x03\n" +
+	"\x11_deprecated_sinceB\x1d\n" +
+	"\x1b_compliant_message_templateB!\n" +
+	"\x1f_non_compliant_message_templateB\f\n" +
+	"\n" +
+	"_compositeB\x0e\n" +
+	"\f_correlationB\x0e\n" +
+	"\f_remediation\"\x90\x02\n" +
+	"\vRemediation\x12.\n" +
+	"\x04text\x18\x01 \x01(\tB\x1a\xe0A\x02\xbaH\x04r\x02\x10\x01\x9a\x84\x9e\x03\vyaml:\"text\"R\x04text\x12]\n" +
+	"\x05links\x18\x02 \x03(\v2).confirmate.assessment.v1.RemediationLinkB\x1c\xbaH\b\x92\x01\x05\"\x03\xc8\x01\x01\x9a\x84\x9e\x03\fyaml:\"links\"R\x05links\x12b\n" +
+	"\viac_snippet\x18\x03 \x01(\v2$.confirmate.assessment.v1.IacSnippetB\x16\x9a\x84\x9e\x03\x11yaml:\"iacSnippet\"H\x00R\n" +
+	"iacSnippet\x88\x01\x01B\x0e\n" +
+	"\f_iac_snippet\"r\n" +
+	"\x0fRemediationLink\x121\n" +
+	"\x05title\x18\x01 \x01(\tB\x1b\xe0A\x02\xbaH\x04r\x02\x10\x01\x9a\x84\x9e\x03\fyaml:\"title\"R\x05title\x12,\n" +
+	"\x03url\x18\x02 \x01(\tB\x1a\xe0A\x02\xbaH\x05r\x03\x88\x01\x01\x9a\x84\x9e\x03\n" +
+	"yaml:\"url\"R\x03url\"x\n" +
+	"\n" +
+	"IacSnippet\x12:\n" +
+	"\blanguage\x18\x01 \x01(\tB\x1e\xe0A\x02\xbaH\x04r\x02\x10\x01\x9a\x84\x9e\x03\x0fyaml:\"language\"R\blanguage\x12.\n" +
+	"\x04code\x18\x02 \x01(\tB\x1a\xe0A\x02\xbaH\x04r\x02\x10\x01\x9a\x84\x9e\x03\vyaml:\"code\"R\x04code\"X\n" +
+	"\x13EvidenceCorrelation\x12A\n" +
+	"\btool_ids\x18\x01 \x03(\tB&\xe0A\x02\xbaH\r\x92\x01\n" +
+	"\b\x01\x18\x01\"\x04r\x02\x10\x01\x9a\x84\x9e\x03\x0eyaml:\"toolIds\"R\atoolIds\"\xec\x01\n" +
+	"\x0fCompositeMetric\x12G\n" +
+	"\n" +
+	"metric_ids\x18\x01 \x03(\tB(\xe0A\x02\xbaH\r\x92\x01\n" +
+	"\b\x01\x18\x01\"\x04r\x02\x10\x01\x9a\x84\x9e\x03\x10yaml:\"metricIds\"R\tmetricIds\x12V\n" +
+	"\boperator\x18\x02 \x01(\x0e2+.confirmate.assessment.v1.CompositeOperatorB\r\xe0A\x02\xbaH\a\x82\x01\x04\x10\x01 \x00R\boperator\x12*\n" +
+	"\tthreshold\x18\x03 \x01(\x05B\a\xbaH\x04\x1a\x02 \x00H\x00R\tthreshold\x88\x01\x01B\f\n" +
+	"\n" +
+	"_threshold\"\xe9\x05\n" +
 	"\x13MetricConfiguration\x12D\n" +
 	"\boperator\x18\x01 \x01(\tB(\xe0A\x02\xbaH\"r 2\x1e^(<|>|<=|>=|==|!=|isIn|allIn)$R\boperator\x12_\n" +
 	"\ftarget_value\x18\x02 \x01(\v2\x16.google.protobuf.ValueB$\xe0A\x02\xbaH\x03\xc8\x01\x01\x9a\x84\x9e\x03\x16gorm:\"serializer:json\"R\vtargetValue\x12\"\n" +
@@ -390,7 +1089,21 @@ const file_api_assessment_metric_proto_rawDesc = "" +
 	"\n" +
 	"updated_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampB1\x9a\x84\x9e\x03,gorm:\"serializer:timestamppb;type:timestamp\"R\tupdatedAt\x12=\n" +
 	"\tmetric_id\x18\x05 \x01(\tB \xe0A\x02\xbaH\x04r\x02\x10\x01\x9a\x84\x9e\x03\x11gorm:\"primaryKey\"R\bmetricId\x12X\n" +
-	"\x17target_of_evaluation_id\x18\x06 \x01(\tB!\xe0A\x02\xbaH\x05r\x03\xb0\x01\x01\x9a\x84\x9e\x03\x11gorm:\"primaryKey\"R\x14targetOfEvaluationId\"\xf3\x02\n" +
+	"\x17target_of_evaluation_id\x18\x06 \x01(\tB!\xe0A\x02\xbaH\x05r\x03\xb0\x01\x01\x9a\x84\x9e\x03\x11gorm:\"primaryKey\"R\x14targetOfEvaluationId\x12]\n" +
+	"\x06source\x18\a \x01(\x0e23.confirmate.assessment.v1.MetricConfigurationSourceB\x10\xe0A\x03\x9a\x84\x9e\x03\bgorm:\"-\"R\x06source\x124\n" +
+	"\n" +
+	"catalog_id\x18\b \x01(\tB\x10\xe0A\x03\x9a\x84\x9e\x03\bgorm:\"-\"H\x00R\tcatalogId\x88\x01\x01\x12A\n" +
+	"\x16max_evidence_age_hours\x18\t \x01(\rB\a\xbaH\x04*\x02 \x00H\x01R\x13maxEvidenceAgeHours\x88\x01\x01B\r\n" +
+	"\v_catalog_idB\x19\n" +
+	"\x17_max_evidence_age_hours\"\xb4\x03\n" +
+	"\x1aCatalogMetricConfiguration\x12?\n" +
+	"\n" +
+	"catalog_id\x18\x01 \x01(\tB \xe0A\x02\xbaH\x04r\x02\x10\x01\x9a\x84\x9e\x03\x11gorm:\"primaryKey\"R\tcatalogId\x12=\n" +
+	"\tmetric_id\x18\x02 \x01(\tB \xe0A\x02\xbaH\x04r\x02\x10\x01\x9a\x84\x9e\x03\x11gorm:\"primaryKey\"R\bmetricId\x12D\n" +
+	"\boperator\x18\x03 \x01(\tB(\xe0A\x02\xbaH\"r 2\x1e^(<|>|<=|>=|==|!=|isIn|allIn)$R\boperator\x12_\n" +
+	"\ftarget_value\x18\x04 \x01(\v2\x16.google.protobuf.ValueB$\xe0A\x02\xbaH\x03\xc8\x01\x01\x9a\x84\x9e\x03\x16gorm:\"serializer:json\"R\vtargetValue\x12o\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampB4\xe0A\x03\x9a\x84\x9e\x03,gorm:\"serializer:timestamppb;type:timestamp\"R\tupdatedAt\"\xf3\x02\n" +
 	"\x14MetricImplementation\x12=\n" +
 	"\tmetric_id\x18\x01 \x01(\tB \xe0A\x02\xbaH\x04r\x02\x10\x01\x9a\x84\x9e\x03\x11gorm:\"primaryKey\"R\bmetricId\x12U\n" +
 	"\x04lang\x18\x02 \x01(\x0e27.confirmate.assessment.v1.MetricImplementation.LanguageB\b\xbaH\x05\x82\x01\x02\x10\x01R\x04lang\x12\x1e\n" +
@@ -400,7 +1113,21 @@ const file_api_assessment_metric_proto_rawDesc = "" +
 	"updated_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampB1\x9a\x84\x9e\x03,gorm:\"serializer:timestamppb;type:timestamp\"R\tupdatedAt\"7\n" +
 	"\bLanguage\x12\x18\n" +
 	"\x14LANGUAGE_UNSPECIFIED\x10\x00\x12\x11\n" +
-	"\rLANGUAGE_REGO\x10\x01B#Z!confirmate.io/core/api/assessmentb\x06proto3"
+	"\rLANGUAGE_REGO\x10\x01\"\xfb\x01\n" +
+	"\fMetricBundle\x12:\n" +
+	"\ametrics\x18\x01 \x03(\v2 .confirmate.assessment.v1.MetricR\ametrics\x12X\n" +
+	"\x0fimplementations\x18\x02 \x03(\v2..confirmate.assessment.v1.MetricImplementationR\x0fimplementations\x12U\n" +
+	"\x0econfigurations\x18\x03 \x03(\v2-.confirmate.assessment.v1.MetricConfigurationR\x0econfigurations*\x90\x01\n" +
+	"\x11CompositeOperator\x12\"\n" +
+	"\x1eCOMPOSITE_OPERATOR_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16COMPOSITE_OPERATOR_AND\x10\x01\x12\x19\n" +
+	"\x15COMPOSITE_OPERATOR_OR\x10\x02\x12 \n" +
+	"\x1cCOMPOSITE_OPERATOR_THRESHOLD\x10\x03*\xd8\x01\n" +
+	"\x19MetricConfigurationSource\x12+\n" +
+	"'METRIC_CONFIGURATION_SOURCE_UNSPECIFIED\x10\x00\x12'\n" +
+	"#METRIC_CONFIGURATION_SOURCE_DEFAULT\x10\x01\x12/\n" +
+	"+METRIC_CONFIGURATION_SOURCE_CATALOG_DEFAULT\x10\x02\x124\n" +
+	"0METRIC_CONFIGURATION_SOURCE_TARGET_OF_EVALUATION\x10\x03B#Z!confirmate.io/core/api/assessmentb\x06proto3"
 
 var (
 	file_api_assessment_metric_proto_rawDescOnce sync.Once
@@ -414,28 +1141,49 @@ func file_api_assessment_metric_proto_rawDescGZIP() []byte {
 	return file_api_assessment_metric_proto_rawDescData
 }
 
-var file_api_assessment_metric_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_api_assessment_metric_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_api_assessment_metric_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_api_assessment_metric_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
 var file_api_assessment_metric_proto_goTypes = []any{
-	(MetricImplementation_Language)(0), // 0: confirmate.assessment.v1.MetricImplementation.Language
-	(*Metric)(nil),                     // 1: confirmate.assessment.v1.Metric
-	(*MetricConfiguration)(nil),        // 2: confirmate.assessment.v1.MetricConfiguration
-	(*MetricImplementation)(nil),       // 3: confirmate.assessment.v1.MetricImplementation
-	(*timestamppb.Timestamp)(nil),      // 4: google.protobuf.Timestamp
-	(*structpb.Value)(nil),             // 5: google.protobuf.Value
+	(CompositeOperator)(0),             // 0: confirmate.assessment.v1.CompositeOperator
+	(MetricConfigurationSource)(0),     // 1: confirmate.assessment.v1.MetricConfigurationSource
+	(MetricImplementation_Language)(0), // 2: confirmate.assessment.v1.MetricImplementation.Language
+	(*Metric)(nil),                     // 3: confirmate.assessment.v1.Metric
+	(*Remediation)(nil),                // 4: confirmate.assessment.v1.Remediation
+	(*RemediationLink)(nil),            // 5: confirmate.assessment.v1.RemediationLink
+	(*IacSnippet)(nil),                 // 6: confirmate.assessment.v1.IacSnippet
+	(*EvidenceCorrelation)(nil),        // 7: confirmate.assessment.v1.EvidenceCorrelation
+	(*CompositeMetric)(nil),            // 8: confirmate.assessment.v1.CompositeMetric
+	(*MetricConfiguration)(nil),        // 9: confirmate.assessment.v1.MetricConfiguration
+	(*CatalogMetricConfiguration)(nil), // 10: confirmate.assessment.v1.CatalogMetricConfiguration
+	(*MetricImplementation)(nil),       // 11: confirmate.assessment.v1.MetricImplementation
+	(*MetricBundle)(nil),               // 12: confirmate.assessment.v1.MetricBundle
+	(*timestamppb.Timestamp)(nil),      // 13: google.protobuf.Timestamp
+	(*structpb.Value)(nil),             // 14: google.protobuf.Value
 }
 var file_api_assessment_metric_proto_depIdxs = []int32{
-	3, // 0: confirmate.assessment.v1.Metric.implementation:type_name -> confirmate.assessment.v1.MetricImplementation
-	4, // 1: confirmate.assessment.v1.Metric.deprecated_since:type_name -> google.protobuf.Timestamp
-	5, // 2: confirmate.assessment.v1.MetricConfiguration.target_value:type_name -> google.protobuf.Value
-	4, // 3: confirmate.assessment.v1.MetricConfiguration.updated_at:type_name -> google.protobuf.Timestamp
-	0, // 4: confirmate.assessment.v1.MetricImplementation.lang:type_name -> confirmate.assessment.v1.MetricImplementation.Language
-	4, // 5: confirmate.assessment.v1.MetricImplementation.updated_at:type_name -> google.protobuf.Timestamp
-	6, // [6:6] is the sub-list for method output_type
-	6, // [6:6] is the sub-list for method input_type
-	6, // [6:6] is the sub-list for extension type_name
-	6, // [6:6] is the sub-list for extension extendee
-	0, // [0:6] is the sub-list for field type_name
+	11, // 0: confirmate.assessment.v1.Metric.implementation:type_name -> confirmate.assessment.v1.MetricImplementation
+	13, // 1: confirmate.assessment.v1.Metric.deprecated_since:type_name -> google.protobuf.Timestamp
+	8,  // 2: confirmate.assessment.v1.Metric.composite:type_name -> confirmate.assessment.v1.CompositeMetric
+	7,  // 3: confirmate.assessment.v1.Metric.correlation:type_name -> confirmate.assessment.v1.EvidenceCorrelation
+	4,  // 4: confirmate.assessment.v1.Metric.remediation:type_name -> confirmate.assessment.v1.Remediation
+	5,  // 5: confirmate.assessment.v1.Remediation.links:type_name -> confirmate.assessment.v1.RemediationLink
+	6,  // 6: confirmate.assessment.v1.Remediation.iac_snippet:type_name -> confirmate.assessment.v1.IacSnippet
+	0,  // 7: confirmate.assessment.v1.CompositeMetric.operator:type_name -> confirmate.assessment.v1.CompositeOperator
+	14, // 8: confirmate.assessment.v1.MetricConfiguration.target_value:type_name -> google.protobuf.Value
+	13, // 9: confirmate.assessment.v1.MetricConfiguration.updated_at:type_name -> google.protobuf.Timestamp
+	1,  // 10: confirmate.assessment.v1.MetricConfiguration.source:type_name -> confirmate.assessment.v1.MetricConfigurationSource
+	14, // 11: confirmate.assessment.v1.CatalogMetricConfiguration.target_value:type_name -> google.protobuf.Value
+	13, // 12: confirmate.assessment.v1.CatalogMetricConfiguration.updated_at:type_name -> google.protobuf.Timestamp
+	2,  // 13: confirmate.assessment.v1.MetricImplementation.lang:type_name -> confirmate.assessment.v1.MetricImplementation.Language
+	13, // 14: confirmate.assessment.v1.MetricImplementation.updated_at:type_name -> google.protobuf.Timestamp
+	3,  // 15: confirmate.assessment.v1.MetricBundle.metrics:type_name -> confirmate.assessment.v1.Metric
+	11, // 16: confirmate.assessment.v1.MetricBundle.implementations:type_name -> confirmate.assessment.v1.MetricImplementation
+	9,  // 17: confirmate.assessment.v1.MetricBundle.configurations:type_name -> confirmate.assessment.v1.MetricConfiguration
+	18, // [18:18] is the sub-list for method output_type
+	18, // [18:18] is the sub-list for method input_type
+	18, // [18:18] is the sub-list for extension type_name
+	18, // [18:18] is the sub-list for extension extendee
+	0,  // [0:18] is the sub-list for field type_name
 }
 
 func init() { file_api_assessment_metric_proto_init() }
@@ -444,13 +1192,16 @@ func file_api_assessment_metric_proto_init() {
 		return
 	}
 	file_api_assessment_metric_proto_msgTypes[0].OneofWrappers = []any{}
+	file_api_assessment_metric_proto_msgTypes[1].OneofWrappers = []any{}
+	file_api_assessment_metric_proto_msgTypes[5].OneofWrappers = []any{}
+	file_api_assessment_metric_proto_msgTypes[6].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_assessment_metric_proto_rawDesc), len(file_api_assessment_metric_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   3,
+			NumEnums:      3,
+			NumMessages:   10,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
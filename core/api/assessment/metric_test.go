@@ -0,0 +1,143 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package assessment
+
+import (
+	"testing"
+
+	"confirmate.io/core/util/assert"
+)
+
+func TestMetric_AppliesTo(t *testing.T) {
+	tests := []struct {
+		name   string
+		metric *Metric
+		types  []string
+		want   bool
+	}{
+		{
+			name:   "no declared resource types",
+			metric: &Metric{},
+			types:  []string{"VirtualMachine", "Compute", "Resource"},
+			want:   true,
+		},
+		{
+			name:   "matching resource type",
+			metric: &Metric{ResourceTypes: []string{"ObjectStorage", "Compute"}},
+			types:  []string{"VirtualMachine", "Compute", "Resource"},
+			want:   true,
+		},
+		{
+			name:   "other resource types only",
+			metric: &Metric{ResourceTypes: []string{"ObjectStorage"}},
+			types:  []string{"VirtualMachine", "Compute", "Resource"},
+			want:   false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.metric.AppliesTo(tt.types))
+		})
+	}
+}
+
+func TestCompositeMetric_Combine(t *testing.T) {
+	var compliance = map[string]bool{
+		"metric-1": true,
+		"metric-2": false,
+		"metric-3": true,
+	}
+
+	tests := []struct {
+		name           string
+		composite      *CompositeMetric
+		wantApplicable bool
+		wantCompliant  bool
+	}{
+		{
+			name: "no results",
+			composite: &CompositeMetric{
+				MetricIds: []string{"metric-4"},
+				Operator:  CompositeOperator_COMPOSITE_OPERATOR_AND,
+			},
+			wantApplicable: false,
+			wantCompliant:  false,
+		},
+		{
+			name: "and: all compliant",
+			composite: &CompositeMetric{
+				MetricIds: []string{"metric-1", "metric-3", "metric-4"},
+				Operator:  CompositeOperator_COMPOSITE_OPERATOR_AND,
+			},
+			wantApplicable: true,
+			wantCompliant:  true,
+		},
+		{
+			name: "and: one not compliant",
+			composite: &CompositeMetric{
+				MetricIds: []string{"metric-1", "metric-2"},
+				Operator:  CompositeOperator_COMPOSITE_OPERATOR_AND,
+			},
+			wantApplicable: true,
+			wantCompliant:  false,
+		},
+		{
+			name: "or: one compliant",
+			composite: &CompositeMetric{
+				MetricIds: []string{"metric-1", "metric-2"},
+				Operator:  CompositeOperator_COMPOSITE_OPERATOR_OR,
+			},
+			wantApplicable: true,
+			wantCompliant:  true,
+		},
+		{
+			name: "or: none compliant",
+			composite: &CompositeMetric{
+				MetricIds: []string{"metric-2"},
+				Operator:  CompositeOperator_COMPOSITE_OPERATOR_OR,
+			},
+			wantApplicable: true,
+			wantCompliant:  false,
+		},
+		{
+			name: "threshold: reached",
+			composite: &CompositeMetric{
+				MetricIds: []string{"metric-1", "metric-2", "metric-3"},
+				Operator:  CompositeOperator_COMPOSITE_OPERATOR_THRESHOLD,
+				Threshold: new(int32(2)),
+			},
+			wantApplicable: true,
+			wantCompliant:  true,
+		},
+		{
+			name: "threshold: not reached",
+			composite: &CompositeMetric{
+				MetricIds: []string{"metric-1", "metric-2", "metric-3"},
+				Operator:  CompositeOperator_COMPOSITE_OPERATOR_THRESHOLD,
+				Threshold: new(int32(3)),
+			},
+			wantApplicable: true,
+			wantCompliant:  false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotApplicable, gotCompliant := tt.composite.Combine(compliance)
+			assert.Equal(t, tt.wantApplicable, gotApplicable)
+			assert.Equal(t, tt.wantCompliant, gotCompliant)
+		})
+	}
+}
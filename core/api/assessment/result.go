@@ -0,0 +1,62 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package assessment
+
+import (
+	"time"
+
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// IsActive returns true if the waiver has not expired at the given time. A nil waiver is never active.
+func (x *Waiver) IsActive(now time.Time) bool {
+	return x != nil && (x.ExpiresAt == nil || x.ExpiresAt.AsTime().After(now))
+}
+
+func (x *Waiver) MarshalJSON() (b []byte, err error) {
+	return protojson.Marshal(x)
+}
+
+func (x *Waiver) UnmarshalJSON(b []byte) (err error) {
+	return protojson.Unmarshal(b, x)
+}
+
+// IsWaived returns true if the assessment result has a waiver that is active at the given time.
+func (x *AssessmentResult) IsWaived(now time.Time) bool {
+	return x.GetWaiver().IsActive(now)
+}
+
+// IsStale returns true if the evidence of the assessment result is outdated at the given time, i.e., if the result
+// has a stale time that is not after the given time.
+func (x *AssessmentResult) IsStale(now time.Time) bool {
+	return x.GetStaleAt() != nil && !x.GetStaleAt().AsTime().After(now)
+}
+
+// EvidenceRecordedAt returns the time at which the latest evidence of the assessment result was recorded, according to
+// its history. Without history, the creation time of the assessment result is returned instead.
+func (x *AssessmentResult) EvidenceRecordedAt() (t time.Time) {
+	if len(x.GetHistory()) == 0 {
+		return x.GetCreatedAt().AsTime()
+	}
+
+	for _, r := range x.GetHistory() {
+		if recordedAt := r.GetEvidenceRecordedAt().AsTime(); recordedAt.After(t) {
+			t = recordedAt
+		}
+	}
+
+	return t
+}
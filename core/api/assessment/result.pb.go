@@ -48,6 +48,9 @@ const (
 	AssessmentStatus_ASSESSMENT_STATUS_WAITING_FOR_RELATED AssessmentStatus = 1
 	AssessmentStatus_ASSESSMENT_STATUS_ASSESSED            AssessmentStatus = 2
 	AssessmentStatus_ASSESSMENT_STATUS_FAILED              AssessmentStatus = 3
+	// Some metrics are not assessed yet, since they correlate evidences of other
+	// tools that were not observed yet (see EvidenceCorrelation).
+	AssessmentStatus_ASSESSMENT_STATUS_WAITING_FOR_CORRELATION AssessmentStatus = 4
 )
 
 // Enum value maps for AssessmentStatus.
@@ -57,12 +60,14 @@ var (
 		1: "ASSESSMENT_STATUS_WAITING_FOR_RELATED",
 		2: "ASSESSMENT_STATUS_ASSESSED",
 		3: "ASSESSMENT_STATUS_FAILED",
+		4: "ASSESSMENT_STATUS_WAITING_FOR_CORRELATION",
 	}
 	AssessmentStatus_value = map[string]int32{
-		"ASSESSMENT_STATUS_UNSPECIFIED":         0,
-		"ASSESSMENT_STATUS_WAITING_FOR_RELATED": 1,
-		"ASSESSMENT_STATUS_ASSESSED":            2,
-		"ASSESSMENT_STATUS_FAILED":              3,
+		"ASSESSMENT_STATUS_UNSPECIFIED":             0,
+		"ASSESSMENT_STATUS_WAITING_FOR_RELATED":     1,
+		"ASSESSMENT_STATUS_ASSESSED":                2,
+		"ASSESSMENT_STATUS_FAILED":                  3,
+		"ASSESSMENT_STATUS_WAITING_FOR_CORRELATION": 4,
 	}
 )
 
@@ -108,7 +113,7 @@ type AssessmentResult struct {
 	// Compliant case: true or false
 	Compliant bool `protobuf:"varint,5,opt,name=compliant,proto3" json:"compliant,omitempty"`
 	// Reference to the last assessed evidence
-	EvidenceId string `protobuf:"bytes,6,opt,name=evidence_id,json=evidenceId,proto3" json:"evidence_id,omitempty"`
+	EvidenceId string `protobuf:"bytes,6,opt,name=evidence_id,json=evidenceId,proto3" json:"evidence_id,omitempty" gorm:"index"`
 	// Reference to the resource of the assessed evidence
 	ResourceId string `protobuf:"bytes,7,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
 	// Resource types
@@ -124,9 +129,40 @@ type AssessmentResult struct {
 	// The time of the last update of the assessment result history field
 	HistoryUpdatedAt *timestamppb.Timestamp `protobuf:"bytes,22,opt,name=history_updated_at,json=historyUpdatedAt,proto3" json:"history_updated_at,omitempty" gorm:"serializer:timestamppb;type:timestamp"`
 	// Stores the history of evidence IDs and timestamps for evidence that have the same content as the evidence used for this assessment result.
-	History       []*Record `protobuf:"bytes,23,rep,name=history,proto3" json:"history,omitempty" gorm:"serializer:json;constraint:OnDelete:CASCADE"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	History []*Record `protobuf:"bytes,23,rep,name=history,proto3" json:"history,omitempty" gorm:"serializer:json;constraint:OnDelete:CASCADE"`
+	// Optional waiver accepting the non-compliance of this assessment result. It
+	// can only be set using the WaiveAssessmentResult RPC of the orchestrator.
+	// Waivers are stored in their own table, so that granting or revoking them
+	// does not modify the (possibly immutable) assessment result.
+	Waiver *Waiver `protobuf:"bytes,24,opt,name=waiver,proto3" json:"waiver,omitempty" gorm:"foreignKey:AssessmentResultId;constraint:OnDelete:CASCADE"`
+	// The owner of the assessed resource, either taken from the hint of the evidence or resolved by the assessment
+	// service. It can be used to route non-compliant findings to the responsible person.
+	Owner *string `protobuf:"bytes,25,opt,name=owner,proto3,oneof" json:"owner,omitempty" gorm:"index"`
+	// The team owning the assessed resource, either taken from the hint of the evidence or resolved by the assessment
+	// service. It can be used to route non-compliant findings to the responsible team.
+	Team *string `protobuf:"bytes,26,opt,name=team,proto3,oneof" json:"team,omitempty" gorm:"index"`
+	// The time at which the assessment result becomes stale, because its
+	// evidence exceeds the maximum evidence age of the metric configuration or of
+	// the resource types. Stale results are not considered to be compliant by the
+	// evaluation, so that resources that are no longer collected do not stay
+	// compliant forever. If not set, the assessment result does not become stale.
+	StaleAt *timestamppb.Timestamp `protobuf:"bytes,27,opt,name=stale_at,json=staleAt,proto3,oneof" json:"stale_at,omitempty" gorm:"serializer:timestamppb;type:timestamp"`
+	// The environment (e.g., prod or staging) of the assessed resource, either
+	// taken from the evidence or resolved by the assessment service. Audit scopes
+	// that are restricted to an environment only evaluate the assessment results
+	// of this environment.
+	Environment *string `protobuf:"bytes,28,opt,name=environment,proto3,oneof" json:"environment,omitempty" gorm:"index"`
+	// Whether the assessment result was not produced by the assessment of an
+	// evidence, but imported from an external audit, e.g., a penetration test
+	// (see ImportExternalFindings of the orchestrator). Such results are
+	// evaluated like all others, but their evidence ID does not refer to an
+	// evidence in the evidence store.
+	ExternallySourced bool `protobuf:"varint,29,opt,name=externally_sourced,json=externallySourced,proto3" json:"externally_sourced,omitempty" gorm:"index"`
+	// The details of the external finding, if the assessment result is
+	// externally sourced.
+	ExternalFinding *ExternalFinding `protobuf:"bytes,30,opt,name=external_finding,json=externalFinding,proto3" json:"external_finding,omitempty" gorm:"serializer:json"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *AssessmentResult) Reset() {
@@ -257,6 +293,213 @@ func (x *AssessmentResult) GetHistory() []*Record {
 	return nil
 }
 
+func (x *AssessmentResult) GetWaiver() *Waiver {
+	if x != nil {
+		return x.Waiver
+	}
+	return nil
+}
+
+func (x *AssessmentResult) GetOwner() string {
+	if x != nil && x.Owner != nil {
+		return *x.Owner
+	}
+	return ""
+}
+
+func (x *AssessmentResult) GetTeam() string {
+	if x != nil && x.Team != nil {
+		return *x.Team
+	}
+	return ""
+}
+
+func (x *AssessmentResult) GetStaleAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StaleAt
+	}
+	return nil
+}
+
+func (x *AssessmentResult) GetEnvironment() string {
+	if x != nil && x.Environment != nil {
+		return *x.Environment
+	}
+	return ""
+}
+
+func (x *AssessmentResult) GetExternallySourced() bool {
+	if x != nil {
+		return x.ExternallySourced
+	}
+	return false
+}
+
+func (x *AssessmentResult) GetExternalFinding() *ExternalFinding {
+	if x != nil {
+		return x.ExternalFinding
+	}
+	return nil
+}
+
+// An ExternalFinding is a finding of an external audit tool or auditor, e.g., of
+// a manual penetration test, that was imported as an assessment result.
+type ExternalFinding struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The external audit the finding originates from, e.g., "Pentest 2026-Q3"
+	Source string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	// The ID of the finding in the external tool or report
+	Reference *string `protobuf:"bytes,2,opt,name=reference,proto3,oneof" json:"reference,omitempty"`
+	// The severity of the finding as rated by the external tool or auditor
+	Severity *string `protobuf:"bytes,3,opt,name=severity,proto3,oneof" json:"severity,omitempty"`
+	// The ID of the user who imported the finding
+	ImportedBy    string `protobuf:"bytes,4,opt,name=imported_by,json=importedBy,proto3" json:"imported_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExternalFinding) Reset() {
+	*x = ExternalFinding{}
+	mi := &file_api_assessment_result_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExternalFinding) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExternalFinding) ProtoMessage() {}
+
+func (x *ExternalFinding) ProtoReflect() protoreflect.Message {
+	mi := &file_api_assessment_result_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExternalFinding.ProtoReflect.Descriptor instead.
+func (*ExternalFinding) Descriptor() ([]byte, []int) {
+	return file_api_assessment_result_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ExternalFinding) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *ExternalFinding) GetReference() string {
+	if x != nil && x.Reference != nil {
+		return *x.Reference
+	}
+	return ""
+}
+
+func (x *ExternalFinding) GetSeverity() string {
+	if x != nil && x.Severity != nil {
+		return *x.Severity
+	}
+	return ""
+}
+
+func (x *ExternalFinding) GetImportedBy() string {
+	if x != nil {
+		return x.ImportedBy
+	}
+	return ""
+}
+
+// A Waiver accepts the risk of a non-compliant assessment result, e.g., for a
+// legacy resource. Waived assessment results are not taken into account when
+// evaluating the status of a control until the waiver expires.
+type Waiver struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Justification why the non-compliance is accepted
+	Justification string `protobuf:"bytes,1,opt,name=justification,proto3" json:"justification,omitempty"`
+	// The ID of the user who approved the waiver
+	Approver string `protobuf:"bytes,2,opt,name=approver,proto3" json:"approver,omitempty"`
+	// Time the waiver was approved
+	ApprovedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=approved_at,json=approvedAt,proto3" json:"approved_at,omitempty" gorm:"serializer:timestamppb;type:timestamp"`
+	// Time the waiver expires. If not set, the waiver does not expire.
+	ExpiresAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3,oneof" json:"expires_at,omitempty" gorm:"serializer:timestamppb;type:timestamp"`
+	// The ID of the waived assessment result
+	AssessmentResultId string `protobuf:"bytes,5,opt,name=assessment_result_id,json=assessmentResultId,proto3" json:"assessment_result_id,omitempty" gorm:"primaryKey"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *Waiver) Reset() {
+	*x = Waiver{}
+	mi := &file_api_assessment_result_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Waiver) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Waiver) ProtoMessage() {}
+
+func (x *Waiver) ProtoReflect() protoreflect.Message {
+	mi := &file_api_assessment_result_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Waiver.ProtoReflect.Descriptor instead.
+func (*Waiver) Descriptor() ([]byte, []int) {
+	return file_api_assessment_result_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Waiver) GetJustification() string {
+	if x != nil {
+		return x.Justification
+	}
+	return ""
+}
+
+func (x *Waiver) GetApprover() string {
+	if x != nil {
+		return x.Approver
+	}
+	return ""
+}
+
+func (x *Waiver) GetApprovedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ApprovedAt
+	}
+	return nil
+}
+
+func (x *Waiver) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *Waiver) GetAssessmentResultId() string {
+	if x != nil {
+		return x.AssessmentResultId
+	}
+	return ""
+}
+
 // An optional structure containing more details how a comparison inside an assessment result was done and if it was successful.
 type ComparisonResult struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -276,7 +519,7 @@ type ComparisonResult struct {
 
 func (x *ComparisonResult) Reset() {
 	*x = ComparisonResult{}
-	mi := &file_api_assessment_result_proto_msgTypes[1]
+	mi := &file_api_assessment_result_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -288,7 +531,7 @@ func (x *ComparisonResult) String() string {
 func (*ComparisonResult) ProtoMessage() {}
 
 func (x *ComparisonResult) ProtoReflect() protoreflect.Message {
-	mi := &file_api_assessment_result_proto_msgTypes[1]
+	mi := &file_api_assessment_result_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -301,7 +544,7 @@ func (x *ComparisonResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ComparisonResult.ProtoReflect.Descriptor instead.
 func (*ComparisonResult) Descriptor() ([]byte, []int) {
-	return file_api_assessment_result_proto_rawDescGZIP(), []int{1}
+	return file_api_assessment_result_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *ComparisonResult) GetProperty() string {
@@ -349,7 +592,7 @@ type Record struct {
 
 func (x *Record) Reset() {
 	*x = Record{}
-	mi := &file_api_assessment_result_proto_msgTypes[2]
+	mi := &file_api_assessment_result_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -361,7 +604,7 @@ func (x *Record) String() string {
 func (*Record) ProtoMessage() {}
 
 func (x *Record) ProtoReflect() protoreflect.Message {
-	mi := &file_api_assessment_result_proto_msgTypes[2]
+	mi := &file_api_assessment_result_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -374,7 +617,7 @@ func (x *Record) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Record.ProtoReflect.Descriptor instead.
 func (*Record) Descriptor() ([]byte, []int) {
-	return file_api_assessment_result_proto_rawDescGZIP(), []int{2}
+	return file_api_assessment_result_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *Record) GetEvidenceId() string {
@@ -395,7 +638,7 @@ var File_api_assessment_result_proto protoreflect.FileDescriptor
 
 const file_api_assessment_result_proto_rawDesc = "" +
 	"\n" +
-	"\x1bapi/assessment/result.proto\x12\x18confirmate.assessment.v1\x1a\x1bapi/assessment/metric.proto\x1a\x1bbuf/validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x13tagger/tagger.proto\"\xd2\b\n" +
+	"\x1bapi/assessment/result.proto\x12\x18confirmate.assessment.v1\x1a\x1bapi/assessment/metric.proto\x1a\x1bbuf/validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x13tagger/tagger.proto\"\xd6\r\n" +
 	"\x10AssessmentResult\x12\x1b\n" +
 	"\x02id\x18\x01 \x01(\tB\v\xe0A\x02\xbaH\x05r\x03\xb0\x01\x01R\x02id\x12u\n" +
 	"\n" +
@@ -403,8 +646,8 @@ const file_api_assessment_result_proto_rawDesc = "" +
 	"\tmetric_id\x18\x03 \x01(\tB\n" +
 	"\xe0A\x02\xbaH\x04r\x02\x10\x01R\bmetricId\x12\x86\x01\n" +
 	"\x14metric_configuration\x18\x04 \x01(\v2-.confirmate.assessment.v1.MetricConfigurationB$\xe0A\x02\xbaH\x03\xc8\x01\x01\x9a\x84\x9e\x03\x16gorm:\"serializer:json\"R\x13metricConfiguration\x12\x1c\n" +
-	"\tcompliant\x18\x05 \x01(\bR\tcompliant\x12,\n" +
-	"\vevidence_id\x18\x06 \x01(\tB\v\xe0A\x02\xbaH\x05r\x03\xb0\x01\x01R\n" +
+	"\tcompliant\x18\x05 \x01(\bR\tcompliant\x12=\n" +
+	"\vevidence_id\x18\x06 \x01(\tB\x1c\xe0A\x02\xbaH\x05r\x03\xb0\x01\x01\x9a\x84\x9e\x03\fgorm:\"index\"R\n" +
 	"evidenceId\x12+\n" +
 	"\vresource_id\x18\a \x01(\tB\n" +
 	"\xe0A\x02\xbaH\x04r\x02\x10\x01R\n" +
@@ -418,9 +661,39 @@ const file_api_assessment_result_proto_rawDesc = "" +
 	"\atool_id\x18\x15 \x01(\tB\n" +
 	"\xe0A\x02\xbaH\x04r\x02\x10\x01H\x00R\x06toolId\x88\x01\x01\x12\x84\x01\n" +
 	"\x12history_updated_at\x18\x16 \x01(\v2\x1a.google.protobuf.TimestampB:\xe0A\x02\xbaH\x03\xc8\x01\x01\x9a\x84\x9e\x03,gorm:\"serializer:timestamppb;type:timestamp\"R\x10historyUpdatedAt\x12|\n" +
-	"\ahistory\x18\x17 \x03(\v2 .confirmate.assessment.v1.RecordB@\xe0A\x02\xbaH\x03\xc8\x01\x01\x9a\x84\x9e\x032gorm:\"serializer:json;constraint:OnDelete:CASCADE\"R\ahistoryB\n" +
+	"\ahistory\x18\x17 \x03(\v2 .confirmate.assessment.v1.RecordB@\xe0A\x02\xbaH\x03\xc8\x01\x01\x9a\x84\x9e\x032gorm:\"serializer:json;constraint:OnDelete:CASCADE\"R\ahistory\x12\x82\x01\n" +
+	"\x06waiver\x18\x18 \x01(\v2 .confirmate.assessment.v1.WaiverBH\xe0A\x03\x9a\x84\x9e\x03@gorm:\"foreignKey:AssessmentResultId;constraint:OnDelete:CASCADE\"R\x06waiver\x12,\n" +
+	"\x05owner\x18\x19 \x01(\tB\x11\x9a\x84\x9e\x03\fgorm:\"index\"H\x01R\x05owner\x88\x01\x01\x12*\n" +
+	"\x04team\x18\x1a \x01(\tB\x11\x9a\x84\x9e\x03\fgorm:\"index\"H\x02R\x04team\x88\x01\x01\x12m\n" +
+	"\bstale_at\x18\x1b \x01(\v2\x1a.google.protobuf.TimestampB1\x9a\x84\x9e\x03,gorm:\"serializer:timestamppb;type:timestamp\"H\x03R\astaleAt\x88\x01\x01\x128\n" +
+	"\venvironment\x18\x1c \x01(\tB\x11\x9a\x84\x9e\x03\fgorm:\"index\"H\x04R\venvironment\x88\x01\x01\x12C\n" +
+	"\x12externally_sourced\x18\x1d \x01(\bB\x14\xe0A\x03\x9a\x84\x9e\x03\fgorm:\"index\"R\x11externallySourced\x12t\n" +
+	"\x10external_finding\x18\x1e \x01(\v2).confirmate.assessment.v1.ExternalFindingB\x1e\xe0A\x03\x9a\x84\x9e\x03\x16gorm:\"serializer:json\"R\x0fexternalFindingB\n" +
+	"\n" +
+	"\b_tool_idB\b\n" +
+	"\x06_ownerB\a\n" +
+	"\x05_teamB\v\n" +
+	"\t_stale_atB\x0e\n" +
+	"\f_environment\"\xae\x01\n" +
+	"\x0fExternalFinding\x12\x1b\n" +
+	"\x06source\x18\x01 \x01(\tB\x03\xe0A\x02R\x06source\x12!\n" +
+	"\treference\x18\x02 \x01(\tH\x00R\treference\x88\x01\x01\x12\x1f\n" +
+	"\bseverity\x18\x03 \x01(\tH\x01R\bseverity\x88\x01\x01\x12\x1f\n" +
+	"\vimported_by\x18\x04 \x01(\tR\n" +
+	"importedByB\f\n" +
+	"\n" +
+	"_referenceB\v\n" +
+	"\t_severity\"\x9d\x03\n" +
+	"\x06Waiver\x120\n" +
+	"\rjustification\x18\x01 \x01(\tB\n" +
+	"\xe0A\x02\xbaH\x04r\x02\x10\x01R\rjustification\x12\x1f\n" +
+	"\bapprover\x18\x02 \x01(\tB\x03\xe0A\x03R\bapprover\x12q\n" +
+	"\vapproved_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB4\xe0A\x03\x9a\x84\x9e\x03,gorm:\"serializer:timestamppb;type:timestamp\"R\n" +
+	"approvedAt\x12q\n" +
 	"\n" +
-	"\b_tool_id\"\xb6\x02\n" +
+	"expires_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampB1\x9a\x84\x9e\x03,gorm:\"serializer:timestamppb;type:timestamp\"H\x00R\texpiresAt\x88\x01\x01\x12K\n" +
+	"\x14assessment_result_id\x18\x05 \x01(\tB\x19\xe0A\x03\x9a\x84\x9e\x03\x11gorm:\"primaryKey\"R\x12assessmentResultIdB\r\n" +
+	"\v_expires_at\"\xb6\x02\n" +
 	"\x10ComparisonResult\x12&\n" +
 	"\bproperty\x18\x01 \x01(\tB\n" +
 	"\xe0A\x02\xbaH\x04r\x02\x10\x01R\bproperty\x127\n" +
@@ -431,12 +704,13 @@ const file_api_assessment_result_proto_rawDesc = "" +
 	"\x06Record\x12,\n" +
 	"\vevidence_id\x18\x01 \x01(\tB\v\xe0A\x02\xbaH\x05r\x03\xb0\x01\x01R\n" +
 	"evidenceId\x12\x88\x01\n" +
-	"\x14evidence_recorded_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampB:\xe0A\x02\xbaH\x03\xc8\x01\x01\x9a\x84\x9e\x03,gorm:\"serializer:timestamppb;type:timestamp\"R\x12evidenceRecordedAt*\x9e\x01\n" +
+	"\x14evidence_recorded_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampB:\xe0A\x02\xbaH\x03\xc8\x01\x01\x9a\x84\x9e\x03,gorm:\"serializer:timestamppb;type:timestamp\"R\x12evidenceRecordedAt*\xcd\x01\n" +
 	"\x10AssessmentStatus\x12!\n" +
 	"\x1dASSESSMENT_STATUS_UNSPECIFIED\x10\x00\x12)\n" +
 	"%ASSESSMENT_STATUS_WAITING_FOR_RELATED\x10\x01\x12\x1e\n" +
 	"\x1aASSESSMENT_STATUS_ASSESSED\x10\x02\x12\x1c\n" +
-	"\x18ASSESSMENT_STATUS_FAILED\x10\x03B#Z!confirmate.io/core/api/assessmentb\x06proto3"
+	"\x18ASSESSMENT_STATUS_FAILED\x10\x03\x12-\n" +
+	")ASSESSMENT_STATUS_WAITING_FOR_CORRELATION\x10\x04B#Z!confirmate.io/core/api/assessmentb\x06proto3"
 
 var (
 	file_api_assessment_result_proto_rawDescOnce sync.Once
@@ -451,30 +725,37 @@ func file_api_assessment_result_proto_rawDescGZIP() []byte {
 }
 
 var file_api_assessment_result_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_api_assessment_result_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_api_assessment_result_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
 var file_api_assessment_result_proto_goTypes = []any{
 	(AssessmentStatus)(0),         // 0: confirmate.assessment.v1.AssessmentStatus
 	(*AssessmentResult)(nil),      // 1: confirmate.assessment.v1.AssessmentResult
-	(*ComparisonResult)(nil),      // 2: confirmate.assessment.v1.ComparisonResult
-	(*Record)(nil),                // 3: confirmate.assessment.v1.Record
-	(*timestamppb.Timestamp)(nil), // 4: google.protobuf.Timestamp
-	(*MetricConfiguration)(nil),   // 5: confirmate.assessment.v1.MetricConfiguration
-	(*structpb.Value)(nil),        // 6: google.protobuf.Value
+	(*ExternalFinding)(nil),       // 2: confirmate.assessment.v1.ExternalFinding
+	(*Waiver)(nil),                // 3: confirmate.assessment.v1.Waiver
+	(*ComparisonResult)(nil),      // 4: confirmate.assessment.v1.ComparisonResult
+	(*Record)(nil),                // 5: confirmate.assessment.v1.Record
+	(*timestamppb.Timestamp)(nil), // 6: google.protobuf.Timestamp
+	(*MetricConfiguration)(nil),   // 7: confirmate.assessment.v1.MetricConfiguration
+	(*structpb.Value)(nil),        // 8: google.protobuf.Value
 }
 var file_api_assessment_result_proto_depIdxs = []int32{
-	4, // 0: confirmate.assessment.v1.AssessmentResult.created_at:type_name -> google.protobuf.Timestamp
-	5, // 1: confirmate.assessment.v1.AssessmentResult.metric_configuration:type_name -> confirmate.assessment.v1.MetricConfiguration
-	2, // 2: confirmate.assessment.v1.AssessmentResult.compliance_details:type_name -> confirmate.assessment.v1.ComparisonResult
-	4, // 3: confirmate.assessment.v1.AssessmentResult.history_updated_at:type_name -> google.protobuf.Timestamp
-	3, // 4: confirmate.assessment.v1.AssessmentResult.history:type_name -> confirmate.assessment.v1.Record
-	6, // 5: confirmate.assessment.v1.ComparisonResult.value:type_name -> google.protobuf.Value
-	6, // 6: confirmate.assessment.v1.ComparisonResult.target_value:type_name -> google.protobuf.Value
-	4, // 7: confirmate.assessment.v1.Record.evidence_recorded_at:type_name -> google.protobuf.Timestamp
-	8, // [8:8] is the sub-list for method output_type
-	8, // [8:8] is the sub-list for method input_type
-	8, // [8:8] is the sub-list for extension type_name
-	8, // [8:8] is the sub-list for extension extendee
-	0, // [0:8] is the sub-list for field type_name
+	6,  // 0: confirmate.assessment.v1.AssessmentResult.created_at:type_name -> google.protobuf.Timestamp
+	7,  // 1: confirmate.assessment.v1.AssessmentResult.metric_configuration:type_name -> confirmate.assessment.v1.MetricConfiguration
+	4,  // 2: confirmate.assessment.v1.AssessmentResult.compliance_details:type_name -> confirmate.assessment.v1.ComparisonResult
+	6,  // 3: confirmate.assessment.v1.AssessmentResult.history_updated_at:type_name -> google.protobuf.Timestamp
+	5,  // 4: confirmate.assessment.v1.AssessmentResult.history:type_name -> confirmate.assessment.v1.Record
+	3,  // 5: confirmate.assessment.v1.AssessmentResult.waiver:type_name -> confirmate.assessment.v1.Waiver
+	6,  // 6: confirmate.assessment.v1.AssessmentResult.stale_at:type_name -> google.protobuf.Timestamp
+	2,  // 7: confirmate.assessment.v1.AssessmentResult.external_finding:type_name -> confirmate.assessment.v1.ExternalFinding
+	6,  // 8: confirmate.assessment.v1.Waiver.approved_at:type_name -> google.protobuf.Timestamp
+	6,  // 9: confirmate.assessment.v1.Waiver.expires_at:type_name -> google.protobuf.Timestamp
+	8,  // 10: confirmate.assessment.v1.ComparisonResult.value:type_name -> google.protobuf.Value
+	8,  // 11: confirmate.assessment.v1.ComparisonResult.target_value:type_name -> google.protobuf.Value
+	6,  // 12: confirmate.assessment.v1.Record.evidence_recorded_at:type_name -> google.protobuf.Timestamp
+	13, // [13:13] is the sub-list for method output_type
+	13, // [13:13] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
 }
 
 func init() { file_api_assessment_result_proto_init() }
@@ -484,13 +765,15 @@ func file_api_assessment_result_proto_init() {
 	}
 	file_api_assessment_metric_proto_init()
 	file_api_assessment_result_proto_msgTypes[0].OneofWrappers = []any{}
+	file_api_assessment_result_proto_msgTypes[1].OneofWrappers = []any{}
+	file_api_assessment_result_proto_msgTypes[2].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_assessment_result_proto_rawDesc), len(file_api_assessment_result_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   3,
+			NumMessages:   5,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,149 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package assessment
+
+import (
+	"testing"
+	"time"
+
+	"confirmate.io/core/util/assert"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestAssessmentResult_IsWaived(t *testing.T) {
+	var (
+		now = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	)
+
+	tests := []struct {
+		name   string
+		result *AssessmentResult
+		want   bool
+	}{
+		{
+			name:   "no waiver",
+			result: &AssessmentResult{},
+			want:   false,
+		},
+		{
+			name: "waiver without expiry",
+			result: &AssessmentResult{
+				Waiver: &Waiver{Justification: "accepted risk"},
+			},
+			want: true,
+		},
+		{
+			name: "waiver not yet expired",
+			result: &AssessmentResult{
+				Waiver: &Waiver{Justification: "accepted risk", ExpiresAt: timestamppb.New(now.Add(time.Hour))},
+			},
+			want: true,
+		},
+		{
+			name: "waiver expired",
+			result: &AssessmentResult{
+				Waiver: &Waiver{Justification: "accepted risk", ExpiresAt: timestamppb.New(now.Add(-time.Hour))},
+			},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.result.IsWaived(now))
+		})
+	}
+}
+
+func TestAssessmentResult_IsStale(t *testing.T) {
+	var (
+		now = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	)
+
+	tests := []struct {
+		name   string
+		result *AssessmentResult
+		want   bool
+	}{
+		{
+			name:   "no stale time",
+			result: &AssessmentResult{},
+			want:   false,
+		},
+		{
+			name: "not yet stale",
+			result: &AssessmentResult{
+				StaleAt: timestamppb.New(now.Add(time.Hour)),
+			},
+			want: false,
+		},
+		{
+			name: "stale",
+			result: &AssessmentResult{
+				StaleAt: timestamppb.New(now.Add(-time.Hour)),
+			},
+			want: true,
+		},
+		{
+			name: "stale exactly now",
+			result: &AssessmentResult{
+				StaleAt: timestamppb.New(now),
+			},
+			want: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.result.IsStale(now))
+		})
+	}
+}
+
+func TestAssessmentResult_EvidenceRecordedAt(t *testing.T) {
+	var (
+		now = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	)
+
+	tests := []struct {
+		name   string
+		result *AssessmentResult
+		want   time.Time
+	}{
+		{
+			name: "no history",
+			result: &AssessmentResult{
+				CreatedAt: timestamppb.New(now),
+			},
+			want: now,
+		},
+		{
+			name: "latest evidence of history",
+			result: &AssessmentResult{
+				CreatedAt: timestamppb.New(now),
+				History: []*Record{
+					{EvidenceId: "11111111-1111-1111-1111-111111111111", EvidenceRecordedAt: timestamppb.New(now.Add(-48 * time.Hour))},
+					{EvidenceId: "22222222-2222-2222-2222-222222222222", EvidenceRecordedAt: timestamppb.New(now.Add(-24 * time.Hour))},
+				},
+			},
+			want: now.Add(-24 * time.Hour),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.result.EvidenceRecordedAt())
+		})
+	}
+}
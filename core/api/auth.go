@@ -64,6 +64,20 @@ func NewOAuthAuthorizerFromConfig(config *oauth2.Config, token *oauth2.Token) (a
 	return authorizer
 }
 
+// NewStaticAuthorizer creates a new authorizer that always provides the given bearer token, e.g., the token of a
+// collector issued by the evidence store.
+func NewStaticAuthorizer(token string) (authorizer Authorizer) {
+	if token == "" {
+		return nil
+	}
+
+	authorizer = &oauthAuthorizer{
+		TokenSource: oauth2.StaticTokenSource(&oauth2.Token{AccessToken: token, TokenType: "Bearer"}),
+	}
+
+	return authorizer
+}
+
 // NewOAuthHTTPClient returns a copy of base client that injects OAuth 2.0 bearer tokens.
 // If authorizer is nil, base is returned as-is (or http.DefaultClient if base is nil).
 func NewOAuthHTTPClient(base *http.Client, authorizer Authorizer) (client *http.Client) {
@@ -0,0 +1,81 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// canonicalMarshalOptions are the options of the JSON representation of an entity that is normalized by
+// [MarshalCanonicalJSON]. Enums are encoded as strings and timestamps in RFC 3339, which is the default of protojson.
+// Unpopulated fields are omitted, so that fields added in later versions do not change the representation of
+// existing entities.
+var canonicalMarshalOptions = protojson.MarshalOptions{
+	EmitUnpopulated: false,
+	UseEnumNumbers:  false,
+	UseProtoNames:   false,
+}
+
+// canonicalUnmarshalOptions are the options of [UnmarshalCanonicalJSON]. Unknown fields are discarded, so that entities
+// written by later versions can still be read.
+var canonicalUnmarshalOptions = protojson.UnmarshalOptions{
+	DiscardUnknown: true,
+}
+
+// MarshalCanonicalJSON returns the canonical JSON representation of the entity, which is used whenever the
+// representation is exported, hashed or signed, e.g., in webhook deliveries and archives. In contrast to protojson,
+// whose output is deliberately unstable, the canonical representation is compact, its object keys are sorted and HTML
+// characters are not escaped. Therefore, it is byte-for-byte reproducible across service versions as long as the
+// entity does not change.
+func MarshalCanonicalJSON(m proto.Message) (b []byte, err error) {
+	var (
+		v   any
+		buf bytes.Buffer
+		enc = json.NewEncoder(&buf)
+	)
+
+	b, err = canonicalMarshalOptions.Marshal(m)
+	if err != nil {
+		return nil, err
+	}
+
+	// Decode the JSON into generic values, whose object keys are sorted when encoding them again. Numbers are kept
+	// as they are, so that no precision is lost.
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	if err = dec.Decode(&v); err != nil {
+		return nil, fmt.Errorf("could not normalize JSON: %w", err)
+	}
+
+	enc.SetEscapeHTML(false)
+	if err = enc.Encode(v); err != nil {
+		return nil, fmt.Errorf("could not normalize JSON: %w", err)
+	}
+
+	// Encode terminates the value with a newline, which is not part of the canonical representation
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n")), nil
+}
+
+// UnmarshalCanonicalJSON parses the JSON representation of an entity, e.g., one created by [MarshalCanonicalJSON],
+// into m. Unknown fields are ignored.
+func UnmarshalCanonicalJSON(b []byte, m proto.Message) (err error) {
+	return canonicalUnmarshalOptions.Unmarshal(b, m)
+}
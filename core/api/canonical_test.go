@@ -0,0 +1,85 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package api
+
+import (
+	"testing"
+	"time"
+
+	"confirmate.io/core/api/orchestrator"
+	"confirmate.io/core/util/assert"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestMarshalCanonicalJSON(t *testing.T) {
+	tests := []struct {
+		name string
+		m    proto.Message
+		want string
+	}{
+		{
+			name: "empty",
+			m:    &orchestrator.TargetOfEvaluation{},
+			want: `{}`,
+		},
+		{
+			name: "sorted keys, enums as strings, RFC 3339 timestamps and no HTML escaping",
+			m: &orchestrator.TargetOfEvaluation{
+				Name:       "<Cloud & Co>",
+				Id:         "00000000-0000-0000-0000-000000000001",
+				TargetType: orchestrator.TargetOfEvaluation_TARGET_TYPE_CLOUD,
+				CreatedAt:  timestamppb.New(time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)),
+				Metadata: &orchestrator.TargetOfEvaluation_Metadata{
+					Labels: map[string]string{"zone": "b", "env": "prod"},
+				},
+			},
+			want: `{"createdAt":"2026-01-02T03:04:05Z","id":"00000000-0000-0000-0000-000000000001",` +
+				`"metadata":{"labels":{"env":"prod","zone":"b"}},"name":"<Cloud & Co>","targetType":"TARGET_TYPE_CLOUD"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := MarshalCanonicalJSON(tt.m)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, string(got))
+		})
+	}
+}
+
+func TestUnmarshalCanonicalJSON(t *testing.T) {
+	var (
+		toe = &orchestrator.TargetOfEvaluation{
+			Id:         "00000000-0000-0000-0000-000000000001",
+			Name:       "Cloud",
+			TargetType: orchestrator.TargetOfEvaluation_TARGET_TYPE_PRODUCT,
+			UpdatedAt:  timestamppb.New(time.Date(2026, 1, 2, 3, 4, 5, 600, time.UTC)),
+		}
+		got orchestrator.TargetOfEvaluation
+	)
+
+	b, err := MarshalCanonicalJSON(toe)
+	assert.NoError(t, err)
+
+	// Fields of later versions are ignored
+	b = append(b[:len(b)-1], []byte(`,"fieldOfLaterVersion":true}`)...)
+
+	assert.NoError(t, UnmarshalCanonicalJSON(b, &got))
+	assert.True(t, proto.Equal(toe, &got))
+	assert.ErrorContains(t, UnmarshalCanonicalJSON([]byte(`{"id":`), &got), "unexpected")
+}
@@ -8,10 +8,13 @@ package evaluation
 
 import (
 	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	assessment "confirmate.io/core/api/assessment"
 	_ "github.com/srikrsna/protoc-gen-gotag/tagger"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
+	httpbody "google.golang.org/genproto/googleapis/api/httpbody"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	structpb "google.golang.org/protobuf/types/known/structpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
@@ -25,6 +28,184 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// EvaluationPrecedence describes whether manual or automatic evaluation
+// determines the status of a control.
+type EvaluationPrecedence int32
+
+const (
+	EvaluationPrecedence_EVALUATION_PRECEDENCE_UNSPECIFIED EvaluationPrecedence = 0
+	// The control is evaluated automatically based on the assessment results.
+	EvaluationPrecedence_EVALUATION_PRECEDENCE_AUTOMATIC EvaluationPrecedence = 1
+	// A valid manual evaluation result of the control overrides the automatic
+	// evaluation, until it expires.
+	EvaluationPrecedence_EVALUATION_PRECEDENCE_MANUAL EvaluationPrecedence = 2
+	// The control is evaluated automatically, but some of its sub-controls are
+	// excluded from the automatic evaluation and their manual evaluation results
+	// are used instead.
+	EvaluationPrecedence_EVALUATION_PRECEDENCE_PARTIALLY_MANUAL EvaluationPrecedence = 3
+)
+
+// Enum value maps for EvaluationPrecedence.
+var (
+	EvaluationPrecedence_name = map[int32]string{
+		0: "EVALUATION_PRECEDENCE_UNSPECIFIED",
+		1: "EVALUATION_PRECEDENCE_AUTOMATIC",
+		2: "EVALUATION_PRECEDENCE_MANUAL",
+		3: "EVALUATION_PRECEDENCE_PARTIALLY_MANUAL",
+	}
+	EvaluationPrecedence_value = map[string]int32{
+		"EVALUATION_PRECEDENCE_UNSPECIFIED":      0,
+		"EVALUATION_PRECEDENCE_AUTOMATIC":        1,
+		"EVALUATION_PRECEDENCE_MANUAL":           2,
+		"EVALUATION_PRECEDENCE_PARTIALLY_MANUAL": 3,
+	}
+)
+
+func (x EvaluationPrecedence) Enum() *EvaluationPrecedence {
+	p := new(EvaluationPrecedence)
+	*p = x
+	return p
+}
+
+func (x EvaluationPrecedence) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EvaluationPrecedence) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_evaluation_evaluation_proto_enumTypes[0].Descriptor()
+}
+
+func (EvaluationPrecedence) Type() protoreflect.EnumType {
+	return &file_api_evaluation_evaluation_proto_enumTypes[0]
+}
+
+func (x EvaluationPrecedence) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EvaluationPrecedence.Descriptor instead.
+func (EvaluationPrecedence) EnumDescriptor() ([]byte, []int) {
+	return file_api_evaluation_evaluation_proto_rawDescGZIP(), []int{0}
+}
+
+// OrphanType is the kind of reference of an orphan that points to a missing
+// entity.
+type OrphanType int32
+
+const (
+	OrphanType_ORPHAN_TYPE_UNSPECIFIED OrphanType = 0
+	// An evaluation result references an assessment result that does not exist.
+	OrphanType_ORPHAN_TYPE_EVALUATION_RESULT OrphanType = 1
+	// An assessment result references an evidence that does not exist.
+	OrphanType_ORPHAN_TYPE_ASSESSMENT_RESULT OrphanType = 2
+	// An audit scope references a catalog that does not exist.
+	OrphanType_ORPHAN_TYPE_AUDIT_SCOPE OrphanType = 3
+)
+
+// Enum value maps for OrphanType.
+var (
+	OrphanType_name = map[int32]string{
+		0: "ORPHAN_TYPE_UNSPECIFIED",
+		1: "ORPHAN_TYPE_EVALUATION_RESULT",
+		2: "ORPHAN_TYPE_ASSESSMENT_RESULT",
+		3: "ORPHAN_TYPE_AUDIT_SCOPE",
+	}
+	OrphanType_value = map[string]int32{
+		"ORPHAN_TYPE_UNSPECIFIED":       0,
+		"ORPHAN_TYPE_EVALUATION_RESULT": 1,
+		"ORPHAN_TYPE_ASSESSMENT_RESULT": 2,
+		"ORPHAN_TYPE_AUDIT_SCOPE":       3,
+	}
+)
+
+func (x OrphanType) Enum() *OrphanType {
+	p := new(OrphanType)
+	*p = x
+	return p
+}
+
+func (x OrphanType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OrphanType) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_evaluation_evaluation_proto_enumTypes[1].Descriptor()
+}
+
+func (OrphanType) Type() protoreflect.EnumType {
+	return &file_api_evaluation_evaluation_proto_enumTypes[1]
+}
+
+func (x OrphanType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OrphanType.Descriptor instead.
+func (OrphanType) EnumDescriptor() ([]byte, []int) {
+	return file_api_evaluation_evaluation_proto_rawDescGZIP(), []int{1}
+}
+
+type CoverageStatus int32
+
+const (
+	CoverageStatus_COVERAGE_STATUS_UNSPECIFIED CoverageStatus = 0
+	// The control has no metrics and cannot be evaluated automatically. It is structurally stuck at PENDING unless it
+	// is evaluated manually.
+	CoverageStatus_COVERAGE_STATUS_NO_METRICS CoverageStatus = 1
+	// The control has metrics, but none of them has produced assessment results yet, e.g., because no collector
+	// provides the required evidence. The control remains PENDING until results arrive.
+	CoverageStatus_COVERAGE_STATUS_NO_RESULTS CoverageStatus = 2
+	// Only some of the metrics of the control have produced assessment results.
+	CoverageStatus_COVERAGE_STATUS_PARTIAL CoverageStatus = 3
+	// All metrics of the control have produced assessment results.
+	CoverageStatus_COVERAGE_STATUS_COVERED CoverageStatus = 4
+)
+
+// Enum value maps for CoverageStatus.
+var (
+	CoverageStatus_name = map[int32]string{
+		0: "COVERAGE_STATUS_UNSPECIFIED",
+		1: "COVERAGE_STATUS_NO_METRICS",
+		2: "COVERAGE_STATUS_NO_RESULTS",
+		3: "COVERAGE_STATUS_PARTIAL",
+		4: "COVERAGE_STATUS_COVERED",
+	}
+	CoverageStatus_value = map[string]int32{
+		"COVERAGE_STATUS_UNSPECIFIED": 0,
+		"COVERAGE_STATUS_NO_METRICS":  1,
+		"COVERAGE_STATUS_NO_RESULTS":  2,
+		"COVERAGE_STATUS_PARTIAL":     3,
+		"COVERAGE_STATUS_COVERED":     4,
+	}
+)
+
+func (x CoverageStatus) Enum() *CoverageStatus {
+	p := new(CoverageStatus)
+	*p = x
+	return p
+}
+
+func (x CoverageStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CoverageStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_evaluation_evaluation_proto_enumTypes[2].Descriptor()
+}
+
+func (CoverageStatus) Type() protoreflect.EnumType {
+	return &file_api_evaluation_evaluation_proto_enumTypes[2]
+}
+
+func (x CoverageStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CoverageStatus.Descriptor instead.
+func (CoverageStatus) EnumDescriptor() ([]byte, []int) {
+	return file_api_evaluation_evaluation_proto_rawDescGZIP(), []int{2}
+}
+
 type EvaluationStatus int32
 
 const (
@@ -34,6 +215,13 @@ const (
 	EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT          EvaluationStatus = 3
 	EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT_MANUALLY EvaluationStatus = 4
 	EvaluationStatus_EVALUATION_STATUS_PENDING                EvaluationStatus = 10
+	// The evaluation could not be completed, e.g., because it exceeded its
+	// timeout.
+	EvaluationStatus_EVALUATION_STATUS_ERROR EvaluationStatus = 11
+	// The assessment results of the control are based on outdated evidence,
+	// e.g., because the resources are no longer collected. Stale results are not
+	// considered to be compliant.
+	EvaluationStatus_EVALUATION_STATUS_STALE EvaluationStatus = 12
 )
 
 // Enum value maps for EvaluationStatus.
@@ -45,6 +233,8 @@ var (
 		3:  "EVALUATION_STATUS_NOT_COMPLIANT",
 		4:  "EVALUATION_STATUS_NOT_COMPLIANT_MANUALLY",
 		10: "EVALUATION_STATUS_PENDING",
+		11: "EVALUATION_STATUS_ERROR",
+		12: "EVALUATION_STATUS_STALE",
 	}
 	EvaluationStatus_value = map[string]int32{
 		"EVALUATION_STATUS_UNSPECIFIED":            0,
@@ -53,6 +243,8 @@ var (
 		"EVALUATION_STATUS_NOT_COMPLIANT":          3,
 		"EVALUATION_STATUS_NOT_COMPLIANT_MANUALLY": 4,
 		"EVALUATION_STATUS_PENDING":                10,
+		"EVALUATION_STATUS_ERROR":                  11,
+		"EVALUATION_STATUS_STALE":                  12,
 	}
 )
 
@@ -67,11 +259,11 @@ func (x EvaluationStatus) String() string {
 }
 
 func (EvaluationStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_api_evaluation_evaluation_proto_enumTypes[0].Descriptor()
+	return file_api_evaluation_evaluation_proto_enumTypes[3].Descriptor()
 }
 
 func (EvaluationStatus) Type() protoreflect.EnumType {
-	return &file_api_evaluation_evaluation_proto_enumTypes[0]
+	return &file_api_evaluation_evaluation_proto_enumTypes[3]
 }
 
 func (x EvaluationStatus) Number() protoreflect.EnumNumber {
@@ -80,17 +272,207 @@ func (x EvaluationStatus) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use EvaluationStatus.Descriptor instead.
 func (EvaluationStatus) EnumDescriptor() ([]byte, []int) {
-	return file_api_evaluation_evaluation_proto_rawDescGZIP(), []int{0}
+	return file_api_evaluation_evaluation_proto_rawDescGZIP(), []int{3}
+}
+
+// An EvaluationReason explains the status of an evaluation result beyond the
+// compliance of its assessment results.
+type EvaluationReason int32
+
+const (
+	EvaluationReason_EVALUATION_REASON_UNSPECIFIED EvaluationReason = 0
+	// Some assessment results of the control are based on evidence older than
+	// the maximum evidence age of the control. They do not satisfy the control,
+	// so that the control is not compliant.
+	EvaluationReason_EVALUATION_REASON_FRESHNESS_VIOLATION EvaluationReason = 1
+)
+
+// Enum value maps for EvaluationReason.
+var (
+	EvaluationReason_name = map[int32]string{
+		0: "EVALUATION_REASON_UNSPECIFIED",
+		1: "EVALUATION_REASON_FRESHNESS_VIOLATION",
+	}
+	EvaluationReason_value = map[string]int32{
+		"EVALUATION_REASON_UNSPECIFIED":         0,
+		"EVALUATION_REASON_FRESHNESS_VIOLATION": 1,
+	}
+)
+
+func (x EvaluationReason) Enum() *EvaluationReason {
+	p := new(EvaluationReason)
+	*p = x
+	return p
+}
+
+func (x EvaluationReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EvaluationReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_evaluation_evaluation_proto_enumTypes[4].Descriptor()
+}
+
+func (EvaluationReason) Type() protoreflect.EnumType {
+	return &file_api_evaluation_evaluation_proto_enumTypes[4]
+}
+
+func (x EvaluationReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EvaluationReason.Descriptor instead.
+func (EvaluationReason) EnumDescriptor() ([]byte, []int) {
+	return file_api_evaluation_evaluation_proto_rawDescGZIP(), []int{4}
+}
+
+// An AggregationStrategy defines how the status of a control is aggregated
+// from the statuses of its sub-controls.
+type AggregationStrategy int32
+
+const (
+	AggregationStrategy_AGGREGATION_STRATEGY_UNSPECIFIED AggregationStrategy = 0
+	// All sub-controls need to be compliant, i.e., the worst status of the
+	// sub-controls determines the status of the control.
+	AggregationStrategy_AGGREGATION_STRATEGY_ALL AggregationStrategy = 1
+	// A single compliant sub-control makes the control compliant, e.g., for
+	// schemes whose sub-controls are alternative ways to fulfill the control.
+	// Otherwise, the status is aggregated as for AGGREGATION_STRATEGY_ALL.
+	AggregationStrategy_AGGREGATION_STRATEGY_ANY AggregationStrategy = 2
+)
+
+// Enum value maps for AggregationStrategy.
+var (
+	AggregationStrategy_name = map[int32]string{
+		0: "AGGREGATION_STRATEGY_UNSPECIFIED",
+		1: "AGGREGATION_STRATEGY_ALL",
+		2: "AGGREGATION_STRATEGY_ANY",
+	}
+	AggregationStrategy_value = map[string]int32{
+		"AGGREGATION_STRATEGY_UNSPECIFIED": 0,
+		"AGGREGATION_STRATEGY_ALL":         1,
+		"AGGREGATION_STRATEGY_ANY":         2,
+	}
+)
+
+func (x AggregationStrategy) Enum() *AggregationStrategy {
+	p := new(AggregationStrategy)
+	*p = x
+	return p
+}
+
+func (x AggregationStrategy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AggregationStrategy) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_evaluation_evaluation_proto_enumTypes[5].Descriptor()
+}
+
+func (AggregationStrategy) Type() protoreflect.EnumType {
+	return &file_api_evaluation_evaluation_proto_enumTypes[5]
+}
+
+func (x AggregationStrategy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AggregationStrategy.Descriptor instead.
+func (AggregationStrategy) EnumDescriptor() ([]byte, []int) {
+	return file_api_evaluation_evaluation_proto_rawDescGZIP(), []int{5}
+}
+
+// A SamplingStrategy defines how a sample of the latest assessment results of
+// a control is drawn, if a control is backed by too many resources to
+// evaluate all of their assessment results in every run.
+type SamplingStrategy int32
+
+const (
+	SamplingStrategy_SAMPLING_STRATEGY_UNSPECIFIED SamplingStrategy = 0
+	// A random sample of all assessment results.
+	SamplingStrategy_SAMPLING_STRATEGY_RANDOM SamplingStrategy = 1
+	// A random sample, in which each metric is represented in proportion to its
+	// share of the assessment results, but at least once.
+	SamplingStrategy_SAMPLING_STRATEGY_STRATIFIED SamplingStrategy = 2
+	// All non-compliant assessment results (up to the sample size) and a random
+	// sample of the compliant ones for the remainder. Non-compliance is
+	// therefore never missed, but the sample is biased towards it.
+	SamplingStrategy_SAMPLING_STRATEGY_NON_COMPLIANT_FIRST SamplingStrategy = 3
+)
+
+// Enum value maps for SamplingStrategy.
+var (
+	SamplingStrategy_name = map[int32]string{
+		0: "SAMPLING_STRATEGY_UNSPECIFIED",
+		1: "SAMPLING_STRATEGY_RANDOM",
+		2: "SAMPLING_STRATEGY_STRATIFIED",
+		3: "SAMPLING_STRATEGY_NON_COMPLIANT_FIRST",
+	}
+	SamplingStrategy_value = map[string]int32{
+		"SAMPLING_STRATEGY_UNSPECIFIED":         0,
+		"SAMPLING_STRATEGY_RANDOM":              1,
+		"SAMPLING_STRATEGY_STRATIFIED":          2,
+		"SAMPLING_STRATEGY_NON_COMPLIANT_FIRST": 3,
+	}
+)
+
+func (x SamplingStrategy) Enum() *SamplingStrategy {
+	p := new(SamplingStrategy)
+	*p = x
+	return p
+}
+
+func (x SamplingStrategy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SamplingStrategy) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_evaluation_evaluation_proto_enumTypes[6].Descriptor()
+}
+
+func (SamplingStrategy) Type() protoreflect.EnumType {
+	return &file_api_evaluation_evaluation_proto_enumTypes[6]
+}
+
+func (x SamplingStrategy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SamplingStrategy.Descriptor instead.
+func (SamplingStrategy) EnumDescriptor() ([]byte, []int) {
+	return file_api_evaluation_evaluation_proto_rawDescGZIP(), []int{6}
 }
 
 type StartEvaluationRequest struct {
 	state        protoimpl.MessageState `protogen:"open.v1"`
 	AuditScopeId string                 `protobuf:"bytes,1,opt,name=audit_scope_id,json=auditScopeId,proto3" json:"audit_scope_id,omitempty"`
-	// The interval time in minutes the evaluation executes periodically. The
-	// default interval is set to 5 minutes.
-	Interval      *int32 `protobuf:"varint,3,opt,name=interval,proto3,oneof" json:"interval,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	// The interval time in minutes the evaluation executes periodically.
+	// Defaults to the shortest interval recommended by the catalogs of the audit
+	// scope (see Catalog.evaluation_defaults) or to 5 minutes, if none of them
+	// recommends one.
+	Interval *int32 `protobuf:"varint,3,opt,name=interval,proto3,oneof" json:"interval,omitempty"`
+	// The timeout in seconds of a single evaluation run of the audit scope. If
+	// the timeout is exceeded, the remaining controls are recorded with the
+	// status ERROR. Defaults to the interval.
+	Timeout *int32 `protobuf:"varint,4,opt,name=timeout,proto3,oneof" json:"timeout,omitempty"`
+	// Optional timeouts in seconds for individual (parent) controls, keyed by
+	// the control ID. A control timeout cannot extend the timeout of the audit
+	// scope.
+	ControlTimeouts map[string]int32 `protobuf:"bytes,5,rep,name=control_timeouts,json=controlTimeouts,proto3" json:"control_timeouts,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	// Optional. The locale of the texts that are generated by this evaluation,
+	// e.g., comments of evaluation results. Defaults to the locale of the audit
+	// scope.
+	Locale *string `protobuf:"bytes,6,opt,name=locale,proto3,oneof" json:"locale,omitempty"`
+	// Optional. The maximum age in days of the evidence that the assessment
+	// results of controls without their own maximum evidence age are based on.
+	// Defaults to the maximum evidence age recommended by the catalog.
+	MaxEvidenceAgeDays *uint32 `protobuf:"varint,7,opt,name=max_evidence_age_days,json=maxEvidenceAgeDays,proto3,oneof" json:"max_evidence_age_days,omitempty"`
+	// Optional. How the status of a control is aggregated from the statuses of
+	// its sub-controls. Defaults to the aggregation strategy recommended by the
+	// catalog or to AGGREGATION_STRATEGY_ALL.
+	AggregationStrategy *AggregationStrategy `protobuf:"varint,8,opt,name=aggregation_strategy,json=aggregationStrategy,proto3,enum=confirmate.evaluation.v1.AggregationStrategy,oneof" json:"aggregation_strategy,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
 func (x *StartEvaluationRequest) Reset() {
@@ -137,6 +519,41 @@ func (x *StartEvaluationRequest) GetInterval() int32 {
 	return 0
 }
 
+func (x *StartEvaluationRequest) GetTimeout() int32 {
+	if x != nil && x.Timeout != nil {
+		return *x.Timeout
+	}
+	return 0
+}
+
+func (x *StartEvaluationRequest) GetControlTimeouts() map[string]int32 {
+	if x != nil {
+		return x.ControlTimeouts
+	}
+	return nil
+}
+
+func (x *StartEvaluationRequest) GetLocale() string {
+	if x != nil && x.Locale != nil {
+		return *x.Locale
+	}
+	return ""
+}
+
+func (x *StartEvaluationRequest) GetMaxEvidenceAgeDays() uint32 {
+	if x != nil && x.MaxEvidenceAgeDays != nil {
+		return *x.MaxEvidenceAgeDays
+	}
+	return 0
+}
+
+func (x *StartEvaluationRequest) GetAggregationStrategy() AggregationStrategy {
+	if x != nil && x.AggregationStrategy != nil {
+		return *x.AggregationStrategy
+	}
+	return AggregationStrategy_AGGREGATION_STRATEGY_UNSPECIFIED
+}
+
 type StartEvaluationResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Successful    bool                   `protobuf:"varint,1,opt,name=successful,proto3" json:"successful,omitempty"`
@@ -261,27 +678,27 @@ func (*StopEvaluationResponse) Descriptor() ([]byte, []int) {
 	return file_api_evaluation_evaluation_proto_rawDescGZIP(), []int{3}
 }
 
-type ListEvaluationJobsRequest struct {
-	state         protoimpl.MessageState            `protogen:"open.v1"`
-	Filter        *ListEvaluationJobsRequest_Filter `protobuf:"bytes,1,opt,name=filter,proto3,oneof" json:"filter,omitempty"`
+type PauseEvaluationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AuditScopeId  string                 `protobuf:"bytes,1,opt,name=audit_scope_id,json=auditScopeId,proto3" json:"audit_scope_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListEvaluationJobsRequest) Reset() {
-	*x = ListEvaluationJobsRequest{}
+func (x *PauseEvaluationRequest) Reset() {
+	*x = PauseEvaluationRequest{}
 	mi := &file_api_evaluation_evaluation_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListEvaluationJobsRequest) String() string {
+func (x *PauseEvaluationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListEvaluationJobsRequest) ProtoMessage() {}
+func (*PauseEvaluationRequest) ProtoMessage() {}
 
-func (x *ListEvaluationJobsRequest) ProtoReflect() protoreflect.Message {
+func (x *PauseEvaluationRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_evaluation_evaluation_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -293,39 +710,38 @@ func (x *ListEvaluationJobsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListEvaluationJobsRequest.ProtoReflect.Descriptor instead.
-func (*ListEvaluationJobsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use PauseEvaluationRequest.ProtoReflect.Descriptor instead.
+func (*PauseEvaluationRequest) Descriptor() ([]byte, []int) {
 	return file_api_evaluation_evaluation_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *ListEvaluationJobsRequest) GetFilter() *ListEvaluationJobsRequest_Filter {
+func (x *PauseEvaluationRequest) GetAuditScopeId() string {
 	if x != nil {
-		return x.Filter
+		return x.AuditScopeId
 	}
-	return nil
+	return ""
 }
 
-type ListEvaluationJobsResponse struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	EvaluationJobs []*EvaluationJob       `protobuf:"bytes,1,rep,name=evaluation_jobs,json=evaluationJobs,proto3" json:"evaluation_jobs,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type PauseEvaluationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListEvaluationJobsResponse) Reset() {
-	*x = ListEvaluationJobsResponse{}
+func (x *PauseEvaluationResponse) Reset() {
+	*x = PauseEvaluationResponse{}
 	mi := &file_api_evaluation_evaluation_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListEvaluationJobsResponse) String() string {
+func (x *PauseEvaluationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListEvaluationJobsResponse) ProtoMessage() {}
+func (*PauseEvaluationResponse) ProtoMessage() {}
 
-func (x *ListEvaluationJobsResponse) ProtoReflect() protoreflect.Message {
+func (x *PauseEvaluationResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_evaluation_evaluation_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -337,68 +753,1863 @@ func (x *ListEvaluationJobsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListEvaluationJobsResponse.ProtoReflect.Descriptor instead.
-func (*ListEvaluationJobsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use PauseEvaluationResponse.ProtoReflect.Descriptor instead.
+func (*PauseEvaluationResponse) Descriptor() ([]byte, []int) {
 	return file_api_evaluation_evaluation_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *ListEvaluationJobsResponse) GetEvaluationJobs() []*EvaluationJob {
-	if x != nil {
-		return x.EvaluationJobs
-	}
-	return nil
-}
-
-// A evaluation result resource, representing the result after evaluating the
-// target of evaluation with a specific control target_of_evaluation_id, category_name and
-// catalog_id are necessary to get the corresponding AuditScope
-type EvaluationResult struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Evaluation result id
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// The Target of Evaluation ID the evaluation belongs to
-	TargetOfEvaluationId string `protobuf:"bytes,2,opt,name=target_of_evaluation_id,json=targetOfEvaluationId,proto3" json:"target_of_evaluation_id,omitempty"`
-	// The Audit Scope ID the evaluation belongs to
-	AuditScopeId string `protobuf:"bytes,3,opt,name=audit_scope_id,json=auditScopeId,proto3" json:"audit_scope_id,omitempty"`
-	// The control id the evaluation was based on
-	ControlId string `protobuf:"bytes,4,opt,name=control_id,json=controlId,proto3" json:"control_id,omitempty"`
-	// The catalog the evaluated control belongs to
-	ControlCatalogId string `protobuf:"bytes,6,opt,name=control_catalog_id,json=controlCatalogId,proto3" json:"control_catalog_id,omitempty"`
-	// Optionally, specifies the parent control ID, if this is a sub-control
-	ParentControlId *string `protobuf:"bytes,7,opt,name=parent_control_id,json=parentControlId,proto3,oneof" json:"parent_control_id,omitempty"`
-	// Evaluation status
-	Status EvaluationStatus `protobuf:"varint,8,opt,name=status,proto3,enum=confirmate.evaluation.v1.EvaluationStatus" json:"status,omitempty"`
-	// Time of evaluation
-	Timestamp *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=timestamp,proto3" json:"timestamp,omitempty" gorm:"serializer:timestamppb;type:timestamp"`
-	// List of assessment results because of which the evaluation status is compliant or not compliant
-	AssessmentResultIds []string `protobuf:"bytes,10,rep,name=assessment_result_ids,json=assessmentResultIds,proto3" json:"assessment_result_ids,omitempty" gorm:"serializer:json"`
-	Comment             *string  `protobuf:"bytes,11,opt,name=comment,proto3,oneof" json:"comment,omitempty"`
-	// Optional, but required if the status is one of the "manually" ones. This
-	// denotes how long the (manual) created evaluation result is valid. During
-	// this time, no automatic results are generated for the specific control.
-	ValidUntil *timestamppb.Timestamp `protobuf:"bytes,20,opt,name=valid_until,json=validUntil,proto3,oneof" json:"valid_until,omitempty" gorm:"serializer:timestamppb;type:timestamp"`
-	// Optional, but if you use manually created evaluation results, you can provide a justification for the manual
-	// creation, such as a large file like a policy in PDF format.
-	Data          []byte `protobuf:"bytes,21,opt,name=data,proto3,oneof" json:"data,omitempty" gorm:"type:bytea"`
+type ResumeEvaluationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AuditScopeId  string                 `protobuf:"bytes,1,opt,name=audit_scope_id,json=auditScopeId,proto3" json:"audit_scope_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *EvaluationResult) Reset() {
-	*x = EvaluationResult{}
+func (x *ResumeEvaluationRequest) Reset() {
+	*x = ResumeEvaluationRequest{}
 	mi := &file_api_evaluation_evaluation_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
+func (x *ResumeEvaluationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeEvaluationRequest) ProtoMessage() {}
+
+func (x *ResumeEvaluationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_evaluation_evaluation_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumeEvaluationRequest.ProtoReflect.Descriptor instead.
+func (*ResumeEvaluationRequest) Descriptor() ([]byte, []int) {
+	return file_api_evaluation_evaluation_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ResumeEvaluationRequest) GetAuditScopeId() string {
+	if x != nil {
+		return x.AuditScopeId
+	}
+	return ""
+}
+
+type ResumeEvaluationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResumeEvaluationResponse) Reset() {
+	*x = ResumeEvaluationResponse{}
+	mi := &file_api_evaluation_evaluation_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResumeEvaluationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeEvaluationResponse) ProtoMessage() {}
+
+func (x *ResumeEvaluationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_evaluation_evaluation_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumeEvaluationResponse.ProtoReflect.Descriptor instead.
+func (*ResumeEvaluationResponse) Descriptor() ([]byte, []int) {
+	return file_api_evaluation_evaluation_proto_rawDescGZIP(), []int{7}
+}
+
+type ListEvaluationJobsRequest struct {
+	state         protoimpl.MessageState            `protogen:"open.v1"`
+	Filter        *ListEvaluationJobsRequest_Filter `protobuf:"bytes,1,opt,name=filter,proto3,oneof" json:"filter,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEvaluationJobsRequest) Reset() {
+	*x = ListEvaluationJobsRequest{}
+	mi := &file_api_evaluation_evaluation_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEvaluationJobsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEvaluationJobsRequest) ProtoMessage() {}
+
+func (x *ListEvaluationJobsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_evaluation_evaluation_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEvaluationJobsRequest.ProtoReflect.Descriptor instead.
+func (*ListEvaluationJobsRequest) Descriptor() ([]byte, []int) {
+	return file_api_evaluation_evaluation_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListEvaluationJobsRequest) GetFilter() *ListEvaluationJobsRequest_Filter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+type ListEvaluationJobsResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	EvaluationJobs []*EvaluationJob       `protobuf:"bytes,1,rep,name=evaluation_jobs,json=evaluationJobs,proto3" json:"evaluation_jobs,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListEvaluationJobsResponse) Reset() {
+	*x = ListEvaluationJobsResponse{}
+	mi := &file_api_evaluation_evaluation_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEvaluationJobsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEvaluationJobsResponse) ProtoMessage() {}
+
+func (x *ListEvaluationJobsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_evaluation_evaluation_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEvaluationJobsResponse.ProtoReflect.Descriptor instead.
+func (*ListEvaluationJobsResponse) Descriptor() ([]byte, []int) {
+	return file_api_evaluation_evaluation_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListEvaluationJobsResponse) GetEvaluationJobs() []*EvaluationJob {
+	if x != nil {
+		return x.EvaluationJobs
+	}
+	return nil
+}
+
+type GetCoverageRequest struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	AuditScopeId string                 `protobuf:"bytes,1,opt,name=audit_scope_id,json=auditScopeId,proto3" json:"audit_scope_id,omitempty"`
+	// Optional. The catalog of the audit scope to report on. Defaults to the primary catalog of the audit scope.
+	CatalogId *string `protobuf:"bytes,2,opt,name=catalog_id,json=catalogId,proto3,oneof" json:"catalog_id,omitempty"`
+	// Optional. The locale of the texts of the report. Defaults to the locale of the audit scope.
+	Locale        *string `protobuf:"bytes,3,opt,name=locale,proto3,oneof" json:"locale,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCoverageRequest) Reset() {
+	*x = GetCoverageRequest{}
+	mi := &file_api_evaluation_evaluation_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCoverageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCoverageRequest) ProtoMessage() {}
+
+func (x *GetCoverageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_evaluation_evaluation_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCoverageRequest.ProtoReflect.Descriptor instead.
+func (*GetCoverageRequest) Descriptor() ([]byte, []int) {
+	return file_api_evaluation_evaluation_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetCoverageRequest) GetAuditScopeId() string {
+	if x != nil {
+		return x.AuditScopeId
+	}
+	return ""
+}
+
+func (x *GetCoverageRequest) GetCatalogId() string {
+	if x != nil && x.CatalogId != nil {
+		return *x.CatalogId
+	}
+	return ""
+}
+
+func (x *GetCoverageRequest) GetLocale() string {
+	if x != nil && x.Locale != nil {
+		return *x.Locale
+	}
+	return ""
+}
+
+type SimulateEvaluationRequest struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	AuditScopeId string                 `protobuf:"bytes,1,opt,name=audit_scope_id,json=auditScopeId,proto3" json:"audit_scope_id,omitempty"`
+	// Optional. The catalog of the audit scope to simulate. Defaults to the primary catalog of the audit scope.
+	CatalogId *string `protobuf:"bytes,2,opt,name=catalog_id,json=catalogId,proto3,oneof" json:"catalog_id,omitempty"`
+	// The proposed metric configurations. Metrics without a proposed configuration keep the compliance of their
+	// existing assessment results.
+	Configurations []*ProposedMetricConfiguration `protobuf:"bytes,3,rep,name=configurations,proto3" json:"configurations,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SimulateEvaluationRequest) Reset() {
+	*x = SimulateEvaluationRequest{}
+	mi := &file_api_evaluation_evaluation_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SimulateEvaluationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimulateEvaluationRequest) ProtoMessage() {}
+
+func (x *SimulateEvaluationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_evaluation_evaluation_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimulateEvaluationRequest.ProtoReflect.Descriptor instead.
+func (*SimulateEvaluationRequest) Descriptor() ([]byte, []int) {
+	return file_api_evaluation_evaluation_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *SimulateEvaluationRequest) GetAuditScopeId() string {
+	if x != nil {
+		return x.AuditScopeId
+	}
+	return ""
+}
+
+func (x *SimulateEvaluationRequest) GetCatalogId() string {
+	if x != nil && x.CatalogId != nil {
+		return *x.CatalogId
+	}
+	return ""
+}
+
+func (x *SimulateEvaluationRequest) GetConfigurations() []*ProposedMetricConfiguration {
+	if x != nil {
+		return x.Configurations
+	}
+	return nil
+}
+
+type GetCalendarSubscriptionRequest struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	AuditScopeId string                 `protobuf:"bytes,1,opt,name=audit_scope_id,json=auditScopeId,proto3" json:"audit_scope_id,omitempty"`
+	// Optional. The locale of the texts of the calendar feed. Defaults to the locale of the audit scope.
+	Locale        *string `protobuf:"bytes,2,opt,name=locale,proto3,oneof" json:"locale,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCalendarSubscriptionRequest) Reset() {
+	*x = GetCalendarSubscriptionRequest{}
+	mi := &file_api_evaluation_evaluation_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCalendarSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCalendarSubscriptionRequest) ProtoMessage() {}
+
+func (x *GetCalendarSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_evaluation_evaluation_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCalendarSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*GetCalendarSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_api_evaluation_evaluation_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetCalendarSubscriptionRequest) GetAuditScopeId() string {
+	if x != nil {
+		return x.AuditScopeId
+	}
+	return ""
+}
+
+func (x *GetCalendarSubscriptionRequest) GetLocale() string {
+	if x != nil && x.Locale != nil {
+		return *x.Locale
+	}
+	return ""
+}
+
+type CalendarSubscription struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	AuditScopeId string                 `protobuf:"bytes,1,opt,name=audit_scope_id,json=auditScopeId,proto3" json:"audit_scope_id,omitempty"`
+	// The URL of the calendar feed. It contains a token that grants read access to the feed, so it should be treated
+	// like a password.
+	Url           string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CalendarSubscription) Reset() {
+	*x = CalendarSubscription{}
+	mi := &file_api_evaluation_evaluation_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CalendarSubscription) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalendarSubscription) ProtoMessage() {}
+
+func (x *CalendarSubscription) ProtoReflect() protoreflect.Message {
+	mi := &file_api_evaluation_evaluation_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalendarSubscription.ProtoReflect.Descriptor instead.
+func (*CalendarSubscription) Descriptor() ([]byte, []int) {
+	return file_api_evaluation_evaluation_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *CalendarSubscription) GetAuditScopeId() string {
+	if x != nil {
+		return x.AuditScopeId
+	}
+	return ""
+}
+
+func (x *CalendarSubscription) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+type GetCalendarFeedRequest struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	AuditScopeId string                 `protobuf:"bytes,1,opt,name=audit_scope_id,json=auditScopeId,proto3" json:"audit_scope_id,omitempty"`
+	// The token of the subscription URL, see GetCalendarSubscription.
+	Token string `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	// Optional. The locale of the texts of the calendar feed. Defaults to the locale of the audit scope.
+	Locale        *string `protobuf:"bytes,3,opt,name=locale,proto3,oneof" json:"locale,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCalendarFeedRequest) Reset() {
+	*x = GetCalendarFeedRequest{}
+	mi := &file_api_evaluation_evaluation_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCalendarFeedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCalendarFeedRequest) ProtoMessage() {}
+
+func (x *GetCalendarFeedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_evaluation_evaluation_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCalendarFeedRequest.ProtoReflect.Descriptor instead.
+func (*GetCalendarFeedRequest) Descriptor() ([]byte, []int) {
+	return file_api_evaluation_evaluation_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetCalendarFeedRequest) GetAuditScopeId() string {
+	if x != nil {
+		return x.AuditScopeId
+	}
+	return ""
+}
+
+func (x *GetCalendarFeedRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *GetCalendarFeedRequest) GetLocale() string {
+	if x != nil && x.Locale != nil {
+		return *x.Locale
+	}
+	return ""
+}
+
+type GetControlEvaluationContextRequest struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	AuditScopeId string                 `protobuf:"bytes,1,opt,name=audit_scope_id,json=auditScopeId,proto3" json:"audit_scope_id,omitempty"`
+	// The control (or sub-control) to explain.
+	ControlId string `protobuf:"bytes,2,opt,name=control_id,json=controlId,proto3" json:"control_id,omitempty"`
+	// Optional. The catalog of the control. Defaults to the primary catalog of
+	// the audit scope.
+	CatalogId *string `protobuf:"bytes,3,opt,name=catalog_id,json=catalogId,proto3,oneof" json:"catalog_id,omitempty"`
+	// Optional. The locale of the explanation. Defaults to the locale of the
+	// audit scope.
+	Locale        *string `protobuf:"bytes,4,opt,name=locale,proto3,oneof" json:"locale,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetControlEvaluationContextRequest) Reset() {
+	*x = GetControlEvaluationContextRequest{}
+	mi := &file_api_evaluation_evaluation_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetControlEvaluationContextRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetControlEvaluationContextRequest) ProtoMessage() {}
+
+func (x *GetControlEvaluationContextRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_evaluation_evaluation_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetControlEvaluationContextRequest.ProtoReflect.Descriptor instead.
+func (*GetControlEvaluationContextRequest) Descriptor() ([]byte, []int) {
+	return file_api_evaluation_evaluation_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetControlEvaluationContextRequest) GetAuditScopeId() string {
+	if x != nil {
+		return x.AuditScopeId
+	}
+	return ""
+}
+
+func (x *GetControlEvaluationContextRequest) GetControlId() string {
+	if x != nil {
+		return x.ControlId
+	}
+	return ""
+}
+
+func (x *GetControlEvaluationContextRequest) GetCatalogId() string {
+	if x != nil && x.CatalogId != nil {
+		return *x.CatalogId
+	}
+	return ""
+}
+
+func (x *GetControlEvaluationContextRequest) GetLocale() string {
+	if x != nil && x.Locale != nil {
+		return *x.Locale
+	}
+	return ""
+}
+
+// ControlEvaluationContext explains the precedence of manual and automatic
+// evaluation for a control of an audit scope.
+type ControlEvaluationContext struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	AuditScopeId string                 `protobuf:"bytes,1,opt,name=audit_scope_id,json=auditScopeId,proto3" json:"audit_scope_id,omitempty"`
+	CatalogId    string                 `protobuf:"bytes,2,opt,name=catalog_id,json=catalogId,proto3" json:"catalog_id,omitempty"`
+	ControlId    string                 `protobuf:"bytes,3,opt,name=control_id,json=controlId,proto3" json:"control_id,omitempty"`
+	Precedence   EvaluationPrecedence   `protobuf:"varint,4,opt,name=precedence,proto3,enum=confirmate.evaluation.v1.EvaluationPrecedence" json:"precedence,omitempty"`
+	// The manual evaluation result that overrides the automatic evaluation. It
+	// is only set if the precedence is MANUAL.
+	ManualResult *EvaluationResult `protobuf:"bytes,5,opt,name=manual_result,json=manualResult,proto3,oneof" json:"manual_result,omitempty"`
+	// The time when the manual evaluation result expires and the automatic
+	// evaluation resumes. It is not set if the manual result does not expire.
+	ManualUntil *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=manual_until,json=manualUntil,proto3,oneof" json:"manual_until,omitempty"`
+	// The sub-controls that are excluded from the automatic evaluation because
+	// of their manual evaluation results, sorted by their ID.
+	ExcludedSubControls []*ExcludedSubControl `protobuf:"bytes,7,rep,name=excluded_sub_controls,json=excludedSubControls,proto3" json:"excluded_sub_controls,omitempty"`
+	// The locale of the explanation.
+	Locale string `protobuf:"bytes,8,opt,name=locale,proto3" json:"locale,omitempty"`
+	// A human-readable explanation of the precedence in the locale.
+	Explanation   string `protobuf:"bytes,9,opt,name=explanation,proto3" json:"explanation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ControlEvaluationContext) Reset() {
+	*x = ControlEvaluationContext{}
+	mi := &file_api_evaluation_evaluation_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ControlEvaluationContext) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ControlEvaluationContext) ProtoMessage() {}
+
+func (x *ControlEvaluationContext) ProtoReflect() protoreflect.Message {
+	mi := &file_api_evaluation_evaluation_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ControlEvaluationContext.ProtoReflect.Descriptor instead.
+func (*ControlEvaluationContext) Descriptor() ([]byte, []int) {
+	return file_api_evaluation_evaluation_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ControlEvaluationContext) GetAuditScopeId() string {
+	if x != nil {
+		return x.AuditScopeId
+	}
+	return ""
+}
+
+func (x *ControlEvaluationContext) GetCatalogId() string {
+	if x != nil {
+		return x.CatalogId
+	}
+	return ""
+}
+
+func (x *ControlEvaluationContext) GetControlId() string {
+	if x != nil {
+		return x.ControlId
+	}
+	return ""
+}
+
+func (x *ControlEvaluationContext) GetPrecedence() EvaluationPrecedence {
+	if x != nil {
+		return x.Precedence
+	}
+	return EvaluationPrecedence_EVALUATION_PRECEDENCE_UNSPECIFIED
+}
+
+func (x *ControlEvaluationContext) GetManualResult() *EvaluationResult {
+	if x != nil {
+		return x.ManualResult
+	}
+	return nil
+}
+
+func (x *ControlEvaluationContext) GetManualUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ManualUntil
+	}
+	return nil
+}
+
+func (x *ControlEvaluationContext) GetExcludedSubControls() []*ExcludedSubControl {
+	if x != nil {
+		return x.ExcludedSubControls
+	}
+	return nil
+}
+
+func (x *ControlEvaluationContext) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *ControlEvaluationContext) GetExplanation() string {
+	if x != nil {
+		return x.Explanation
+	}
+	return ""
+}
+
+// ExcludedSubControl is a sub-control that is excluded from the automatic
+// evaluation of its parent control, because a manual evaluation result is used
+// instead.
+type ExcludedSubControl struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ControlId string                 `protobuf:"bytes,1,opt,name=control_id,json=controlId,proto3" json:"control_id,omitempty"`
+	// The ID of the manual evaluation result of the sub-control.
+	ManualResultId string `protobuf:"bytes,2,opt,name=manual_result_id,json=manualResultId,proto3" json:"manual_result_id,omitempty"`
+	// The status of the manual evaluation result of the sub-control.
+	Status EvaluationStatus `protobuf:"varint,3,opt,name=status,proto3,enum=confirmate.evaluation.v1.EvaluationStatus" json:"status,omitempty"`
+	// The time when the manual evaluation result expires. It is not set if the
+	// manual result does not expire.
+	ValidUntil    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=valid_until,json=validUntil,proto3,oneof" json:"valid_until,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExcludedSubControl) Reset() {
+	*x = ExcludedSubControl{}
+	mi := &file_api_evaluation_evaluation_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExcludedSubControl) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExcludedSubControl) ProtoMessage() {}
+
+func (x *ExcludedSubControl) ProtoReflect() protoreflect.Message {
+	mi := &file_api_evaluation_evaluation_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExcludedSubControl.ProtoReflect.Descriptor instead.
+func (*ExcludedSubControl) Descriptor() ([]byte, []int) {
+	return file_api_evaluation_evaluation_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ExcludedSubControl) GetControlId() string {
+	if x != nil {
+		return x.ControlId
+	}
+	return ""
+}
+
+func (x *ExcludedSubControl) GetManualResultId() string {
+	if x != nil {
+		return x.ManualResultId
+	}
+	return ""
+}
+
+func (x *ExcludedSubControl) GetStatus() EvaluationStatus {
+	if x != nil {
+		return x.Status
+	}
+	return EvaluationStatus_EVALUATION_STATUS_UNSPECIFIED
+}
+
+func (x *ExcludedSubControl) GetValidUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValidUntil
+	}
+	return nil
+}
+
+type CheckConsistencyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckConsistencyRequest) Reset() {
+	*x = CheckConsistencyRequest{}
+	mi := &file_api_evaluation_evaluation_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckConsistencyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckConsistencyRequest) ProtoMessage() {}
+
+func (x *CheckConsistencyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_evaluation_evaluation_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckConsistencyRequest.ProtoReflect.Descriptor instead.
+func (*CheckConsistencyRequest) Descriptor() ([]byte, []int) {
+	return file_api_evaluation_evaluation_proto_rawDescGZIP(), []int{18}
+}
+
+type GetConsistencyReportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetConsistencyReportRequest) Reset() {
+	*x = GetConsistencyReportRequest{}
+	mi := &file_api_evaluation_evaluation_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetConsistencyReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConsistencyReportRequest) ProtoMessage() {}
+
+func (x *GetConsistencyReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_evaluation_evaluation_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConsistencyReportRequest.ProtoReflect.Descriptor instead.
+func (*GetConsistencyReportRequest) Descriptor() ([]byte, []int) {
+	return file_api_evaluation_evaluation_proto_rawDescGZIP(), []int{19}
+}
+
+// Orphan is an entity that references an entity that does not exist (anymore).
+type Orphan struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Type  OrphanType             `protobuf:"varint,1,opt,name=type,proto3,enum=confirmate.evaluation.v1.OrphanType" json:"type,omitempty"`
+	// The ID of the orphaned entity, e.g., of the evaluation result.
+	Id string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	// The ID of the missing entity, e.g., of the assessment result.
+	MissingId string `protobuf:"bytes,3,opt,name=missing_id,json=missingId,proto3" json:"missing_id,omitempty"`
+	// The target of evaluation the orphaned entity belongs to.
+	TargetOfEvaluationId string `protobuf:"bytes,4,opt,name=target_of_evaluation_id,json=targetOfEvaluationId,proto3" json:"target_of_evaluation_id,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *Orphan) Reset() {
+	*x = Orphan{}
+	mi := &file_api_evaluation_evaluation_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Orphan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Orphan) ProtoMessage() {}
+
+func (x *Orphan) ProtoReflect() protoreflect.Message {
+	mi := &file_api_evaluation_evaluation_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Orphan.ProtoReflect.Descriptor instead.
+func (*Orphan) Descriptor() ([]byte, []int) {
+	return file_api_evaluation_evaluation_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *Orphan) GetType() OrphanType {
+	if x != nil {
+		return x.Type
+	}
+	return OrphanType_ORPHAN_TYPE_UNSPECIFIED
+}
+
+func (x *Orphan) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Orphan) GetMissingId() string {
+	if x != nil {
+		return x.MissingId
+	}
+	return ""
+}
+
+func (x *Orphan) GetTargetOfEvaluationId() string {
+	if x != nil {
+		return x.TargetOfEvaluationId
+	}
+	return ""
+}
+
+// ConsistencyReport is the result of a consistency check of the entities
+// referenced across the services.
+type ConsistencyReport struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The time when the check was finished.
+	CheckedAt *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=checked_at,json=checkedAt,proto3" json:"checked_at,omitempty"`
+	// The orphans found by the check, ordered by their type and ID.
+	Orphans []*Orphan `protobuf:"bytes,2,rep,name=orphans,proto3" json:"orphans,omitempty"`
+	// The number of checked evaluation results.
+	EvaluationResultsChecked int64 `protobuf:"varint,3,opt,name=evaluation_results_checked,json=evaluationResultsChecked,proto3" json:"evaluation_results_checked,omitempty"`
+	// The number of checked assessment results. It is 0, if the evaluation
+	// service is not connected to an evidence store, so that the evidences of
+	// assessment results could not be checked.
+	AssessmentResultsChecked int64 `protobuf:"varint,4,opt,name=assessment_results_checked,json=assessmentResultsChecked,proto3" json:"assessment_results_checked,omitempty"`
+	// The number of checked audit scopes.
+	AuditScopesChecked int64 `protobuf:"varint,5,opt,name=audit_scopes_checked,json=auditScopesChecked,proto3" json:"audit_scopes_checked,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ConsistencyReport) Reset() {
+	*x = ConsistencyReport{}
+	mi := &file_api_evaluation_evaluation_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConsistencyReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConsistencyReport) ProtoMessage() {}
+
+func (x *ConsistencyReport) ProtoReflect() protoreflect.Message {
+	mi := &file_api_evaluation_evaluation_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConsistencyReport.ProtoReflect.Descriptor instead.
+func (*ConsistencyReport) Descriptor() ([]byte, []int) {
+	return file_api_evaluation_evaluation_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ConsistencyReport) GetCheckedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CheckedAt
+	}
+	return nil
+}
+
+func (x *ConsistencyReport) GetOrphans() []*Orphan {
+	if x != nil {
+		return x.Orphans
+	}
+	return nil
+}
+
+func (x *ConsistencyReport) GetEvaluationResultsChecked() int64 {
+	if x != nil {
+		return x.EvaluationResultsChecked
+	}
+	return 0
+}
+
+func (x *ConsistencyReport) GetAssessmentResultsChecked() int64 {
+	if x != nil {
+		return x.AssessmentResultsChecked
+	}
+	return 0
+}
+
+func (x *ConsistencyReport) GetAuditScopesChecked() int64 {
+	if x != nil {
+		return x.AuditScopesChecked
+	}
+	return 0
+}
+
+type EvaluateNowRequest struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	AuditScopeId string                 `protobuf:"bytes,1,opt,name=audit_scope_id,json=auditScopeId,proto3" json:"audit_scope_id,omitempty"`
+	// The timeout in seconds of the evaluation. If the timeout is exceeded, the
+	// remaining controls are recorded with the status ERROR. Defaults to 5
+	// minutes.
+	Timeout *int32 `protobuf:"varint,2,opt,name=timeout,proto3,oneof" json:"timeout,omitempty"`
+	// Optional. The locale of the texts that are generated by this evaluation,
+	// e.g., comments of evaluation results. Defaults to the locale of the audit
+	// scope.
+	Locale        *string `protobuf:"bytes,3,opt,name=locale,proto3,oneof" json:"locale,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EvaluateNowRequest) Reset() {
+	*x = EvaluateNowRequest{}
+	mi := &file_api_evaluation_evaluation_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EvaluateNowRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EvaluateNowRequest) ProtoMessage() {}
+
+func (x *EvaluateNowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_evaluation_evaluation_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EvaluateNowRequest.ProtoReflect.Descriptor instead.
+func (*EvaluateNowRequest) Descriptor() ([]byte, []int) {
+	return file_api_evaluation_evaluation_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *EvaluateNowRequest) GetAuditScopeId() string {
+	if x != nil {
+		return x.AuditScopeId
+	}
+	return ""
+}
+
+func (x *EvaluateNowRequest) GetTimeout() int32 {
+	if x != nil && x.Timeout != nil {
+		return *x.Timeout
+	}
+	return 0
+}
+
+func (x *EvaluateNowRequest) GetLocale() string {
+	if x != nil && x.Locale != nil {
+		return *x.Locale
+	}
+	return ""
+}
+
+type EvaluateNowResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The aggregated status of all evaluated controls. It is determined in the
+	// same way as the status of a control from its sub-controls, e.g., a single
+	// non-compliant control makes the audit scope non-compliant.
+	Status EvaluationStatus `protobuf:"varint,1,opt,name=status,proto3,enum=confirmate.evaluation.v1.EvaluationStatus" json:"status,omitempty"`
+	// Whether all evaluated controls are compliant. This is the criterion CI/CD
+	// gates should use.
+	Compliant bool `protobuf:"varint,2,opt,name=compliant,proto3" json:"compliant,omitempty"`
+	// The results of the evaluated (parent) controls, ordered by catalog and
+	// control.
+	Results       []*EvaluationResult `protobuf:"bytes,3,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EvaluateNowResponse) Reset() {
+	*x = EvaluateNowResponse{}
+	mi := &file_api_evaluation_evaluation_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EvaluateNowResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EvaluateNowResponse) ProtoMessage() {}
+
+func (x *EvaluateNowResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_evaluation_evaluation_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EvaluateNowResponse.ProtoReflect.Descriptor instead.
+func (*EvaluateNowResponse) Descriptor() ([]byte, []int) {
+	return file_api_evaluation_evaluation_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *EvaluateNowResponse) GetStatus() EvaluationStatus {
+	if x != nil {
+		return x.Status
+	}
+	return EvaluationStatus_EVALUATION_STATUS_UNSPECIFIED
+}
+
+func (x *EvaluateNowResponse) GetCompliant() bool {
+	if x != nil {
+		return x.Compliant
+	}
+	return false
+}
+
+func (x *EvaluateNowResponse) GetResults() []*EvaluationResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+// ProposedMetricConfiguration is a metric configuration that is only used for a simulation.
+type ProposedMetricConfiguration struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	MetricId string                 `protobuf:"bytes,1,opt,name=metric_id,json=metricId,proto3" json:"metric_id,omitempty"`
+	// The operator to compare the metric, such as "==" or ">"
+	Operator string `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
+	// The target value
+	TargetValue   *structpb.Value `protobuf:"bytes,3,opt,name=target_value,json=targetValue,proto3" json:"target_value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProposedMetricConfiguration) Reset() {
+	*x = ProposedMetricConfiguration{}
+	mi := &file_api_evaluation_evaluation_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProposedMetricConfiguration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProposedMetricConfiguration) ProtoMessage() {}
+
+func (x *ProposedMetricConfiguration) ProtoReflect() protoreflect.Message {
+	mi := &file_api_evaluation_evaluation_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProposedMetricConfiguration.ProtoReflect.Descriptor instead.
+func (*ProposedMetricConfiguration) Descriptor() ([]byte, []int) {
+	return file_api_evaluation_evaluation_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ProposedMetricConfiguration) GetMetricId() string {
+	if x != nil {
+		return x.MetricId
+	}
+	return ""
+}
+
+func (x *ProposedMetricConfiguration) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+func (x *ProposedMetricConfiguration) GetTargetValue() *structpb.Value {
+	if x != nil {
+		return x.TargetValue
+	}
+	return nil
+}
+
+type SimulateEvaluationResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The simulated status of all controls that are relevant for the audit scope, sorted by their ID. Parent controls
+	// are followed by their sub-controls.
+	Controls      []*SimulatedControlStatus `protobuf:"bytes,1,rep,name=controls,proto3" json:"controls,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SimulateEvaluationResponse) Reset() {
+	*x = SimulateEvaluationResponse{}
+	mi := &file_api_evaluation_evaluation_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SimulateEvaluationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimulateEvaluationResponse) ProtoMessage() {}
+
+func (x *SimulateEvaluationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_evaluation_evaluation_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimulateEvaluationResponse.ProtoReflect.Descriptor instead.
+func (*SimulateEvaluationResponse) Descriptor() ([]byte, []int) {
+	return file_api_evaluation_evaluation_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *SimulateEvaluationResponse) GetControls() []*SimulatedControlStatus {
+	if x != nil {
+		return x.Controls
+	}
+	return nil
+}
+
+// SimulatedControlStatus compares the current status of a control with its status under the proposed metric
+// configurations.
+type SimulatedControlStatus struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ControlId       string                 `protobuf:"bytes,1,opt,name=control_id,json=controlId,proto3" json:"control_id,omitempty"`
+	ParentControlId *string                `protobuf:"bytes,2,opt,name=parent_control_id,json=parentControlId,proto3,oneof" json:"parent_control_id,omitempty"`
+	// The status of the control based on the existing assessment results.
+	CurrentStatus EvaluationStatus `protobuf:"varint,3,opt,name=current_status,json=currentStatus,proto3,enum=confirmate.evaluation.v1.EvaluationStatus" json:"current_status,omitempty"`
+	// The status of the control under the proposed metric configurations.
+	SimulatedStatus EvaluationStatus `protobuf:"varint,4,opt,name=simulated_status,json=simulatedStatus,proto3,enum=confirmate.evaluation.v1.EvaluationStatus" json:"simulated_status,omitempty"`
+	// The IDs of the assessment results whose compliance changes under the proposed metric configurations.
+	ChangedAssessmentResultIds []string `protobuf:"bytes,5,rep,name=changed_assessment_result_ids,json=changedAssessmentResultIds,proto3" json:"changed_assessment_result_ids,omitempty"`
+	// The IDs of the assessment results that could not be simulated, because they do not contain the details of their
+	// comparisons. They keep their current compliance.
+	UnsimulatedAssessmentResultIds []string `protobuf:"bytes,6,rep,name=unsimulated_assessment_result_ids,json=unsimulatedAssessmentResultIds,proto3" json:"unsimulated_assessment_result_ids,omitempty"`
+	unknownFields                  protoimpl.UnknownFields
+	sizeCache                      protoimpl.SizeCache
+}
+
+func (x *SimulatedControlStatus) Reset() {
+	*x = SimulatedControlStatus{}
+	mi := &file_api_evaluation_evaluation_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SimulatedControlStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimulatedControlStatus) ProtoMessage() {}
+
+func (x *SimulatedControlStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_api_evaluation_evaluation_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimulatedControlStatus.ProtoReflect.Descriptor instead.
+func (*SimulatedControlStatus) Descriptor() ([]byte, []int) {
+	return file_api_evaluation_evaluation_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *SimulatedControlStatus) GetControlId() string {
+	if x != nil {
+		return x.ControlId
+	}
+	return ""
+}
+
+func (x *SimulatedControlStatus) GetParentControlId() string {
+	if x != nil && x.ParentControlId != nil {
+		return *x.ParentControlId
+	}
+	return ""
+}
+
+func (x *SimulatedControlStatus) GetCurrentStatus() EvaluationStatus {
+	if x != nil {
+		return x.CurrentStatus
+	}
+	return EvaluationStatus_EVALUATION_STATUS_UNSPECIFIED
+}
+
+func (x *SimulatedControlStatus) GetSimulatedStatus() EvaluationStatus {
+	if x != nil {
+		return x.SimulatedStatus
+	}
+	return EvaluationStatus_EVALUATION_STATUS_UNSPECIFIED
+}
+
+func (x *SimulatedControlStatus) GetChangedAssessmentResultIds() []string {
+	if x != nil {
+		return x.ChangedAssessmentResultIds
+	}
+	return nil
+}
+
+func (x *SimulatedControlStatus) GetUnsimulatedAssessmentResultIds() []string {
+	if x != nil {
+		return x.UnsimulatedAssessmentResultIds
+	}
+	return nil
+}
+
+// Coverage is a report about how well the controls of the catalog of an audit scope are covered by metrics and
+// assessment results.
+type Coverage struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	AuditScopeId         string                 `protobuf:"bytes,1,opt,name=audit_scope_id,json=auditScopeId,proto3" json:"audit_scope_id,omitempty"`
+	TargetOfEvaluationId string                 `protobuf:"bytes,2,opt,name=target_of_evaluation_id,json=targetOfEvaluationId,proto3" json:"target_of_evaluation_id,omitempty"`
+	CatalogId            string                 `protobuf:"bytes,3,opt,name=catalog_id,json=catalogId,proto3" json:"catalog_id,omitempty"`
+	// The coverage of all controls that are relevant for the audit scope, sorted by their ID. Parent controls are
+	// followed by their sub-controls.
+	Controls []*ControlCoverage `protobuf:"bytes,4,rep,name=controls,proto3" json:"controls,omitempty"`
+	// The locale of the texts of the report.
+	Locale string `protobuf:"bytes,5,opt,name=locale,proto3" json:"locale,omitempty"`
+	// A human-readable summary of the coverage in the locale of the report.
+	Summary       string `protobuf:"bytes,6,opt,name=summary,proto3" json:"summary,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Coverage) Reset() {
+	*x = Coverage{}
+	mi := &file_api_evaluation_evaluation_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Coverage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Coverage) ProtoMessage() {}
+
+func (x *Coverage) ProtoReflect() protoreflect.Message {
+	mi := &file_api_evaluation_evaluation_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Coverage.ProtoReflect.Descriptor instead.
+func (*Coverage) Descriptor() ([]byte, []int) {
+	return file_api_evaluation_evaluation_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *Coverage) GetAuditScopeId() string {
+	if x != nil {
+		return x.AuditScopeId
+	}
+	return ""
+}
+
+func (x *Coverage) GetTargetOfEvaluationId() string {
+	if x != nil {
+		return x.TargetOfEvaluationId
+	}
+	return ""
+}
+
+func (x *Coverage) GetCatalogId() string {
+	if x != nil {
+		return x.CatalogId
+	}
+	return ""
+}
+
+func (x *Coverage) GetControls() []*ControlCoverage {
+	if x != nil {
+		return x.Controls
+	}
+	return nil
+}
+
+func (x *Coverage) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *Coverage) GetSummary() string {
+	if x != nil {
+		return x.Summary
+	}
+	return ""
+}
+
+// ControlCoverage describes the coverage of a single control.
+type ControlCoverage struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ControlId       string                 `protobuf:"bytes,1,opt,name=control_id,json=controlId,proto3" json:"control_id,omitempty"`
+	ParentControlId *string                `protobuf:"bytes,2,opt,name=parent_control_id,json=parentControlId,proto3,oneof" json:"parent_control_id,omitempty"`
+	// The IDs of the metrics that are used to evaluate the control. For parent controls, these are the metrics of all
+	// their relevant sub-controls.
+	MetricIds []string `protobuf:"bytes,3,rep,name=metric_ids,json=metricIds,proto3" json:"metric_ids,omitempty"`
+	// The IDs of the metrics that have produced at least one assessment result for the target of evaluation.
+	AssessedMetricIds []string       `protobuf:"bytes,4,rep,name=assessed_metric_ids,json=assessedMetricIds,proto3" json:"assessed_metric_ids,omitempty"`
+	Status            CoverageStatus `protobuf:"varint,5,opt,name=status,proto3,enum=confirmate.evaluation.v1.CoverageStatus" json:"status,omitempty"`
+	// A human-readable label of the status in the locale of the report.
+	StatusLabel   string `protobuf:"bytes,6,opt,name=status_label,json=statusLabel,proto3" json:"status_label,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ControlCoverage) Reset() {
+	*x = ControlCoverage{}
+	mi := &file_api_evaluation_evaluation_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ControlCoverage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ControlCoverage) ProtoMessage() {}
+
+func (x *ControlCoverage) ProtoReflect() protoreflect.Message {
+	mi := &file_api_evaluation_evaluation_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ControlCoverage.ProtoReflect.Descriptor instead.
+func (*ControlCoverage) Descriptor() ([]byte, []int) {
+	return file_api_evaluation_evaluation_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ControlCoverage) GetControlId() string {
+	if x != nil {
+		return x.ControlId
+	}
+	return ""
+}
+
+func (x *ControlCoverage) GetParentControlId() string {
+	if x != nil && x.ParentControlId != nil {
+		return *x.ParentControlId
+	}
+	return ""
+}
+
+func (x *ControlCoverage) GetMetricIds() []string {
+	if x != nil {
+		return x.MetricIds
+	}
+	return nil
+}
+
+func (x *ControlCoverage) GetAssessedMetricIds() []string {
+	if x != nil {
+		return x.AssessedMetricIds
+	}
+	return nil
+}
+
+func (x *ControlCoverage) GetStatus() CoverageStatus {
+	if x != nil {
+		return x.Status
+	}
+	return CoverageStatus_COVERAGE_STATUS_UNSPECIFIED
+}
+
+func (x *ControlCoverage) GetStatusLabel() string {
+	if x != nil {
+		return x.StatusLabel
+	}
+	return ""
+}
+
+// A evaluation result resource, representing the result after evaluating the
+// target of evaluation with a specific control target_of_evaluation_id, category_name and
+// catalog_id are necessary to get the corresponding AuditScope
+type EvaluationResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Evaluation result id
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The Target of Evaluation ID the evaluation belongs to
+	TargetOfEvaluationId string `protobuf:"bytes,2,opt,name=target_of_evaluation_id,json=targetOfEvaluationId,proto3" json:"target_of_evaluation_id,omitempty"`
+	// The Audit Scope ID the evaluation belongs to
+	AuditScopeId string `protobuf:"bytes,3,opt,name=audit_scope_id,json=auditScopeId,proto3" json:"audit_scope_id,omitempty"`
+	// The control id the evaluation was based on
+	ControlId string `protobuf:"bytes,4,opt,name=control_id,json=controlId,proto3" json:"control_id,omitempty"`
+	// The catalog the evaluated control belongs to
+	ControlCatalogId string `protobuf:"bytes,6,opt,name=control_catalog_id,json=controlCatalogId,proto3" json:"control_catalog_id,omitempty"`
+	// Optionally, specifies the parent control ID, if this is a sub-control
+	ParentControlId *string `protobuf:"bytes,7,opt,name=parent_control_id,json=parentControlId,proto3,oneof" json:"parent_control_id,omitempty"`
+	// Evaluation status
+	Status EvaluationStatus `protobuf:"varint,8,opt,name=status,proto3,enum=confirmate.evaluation.v1.EvaluationStatus" json:"status,omitempty"`
+	// Time of evaluation
+	Timestamp *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=timestamp,proto3" json:"timestamp,omitempty" gorm:"serializer:timestamppb;type:timestamp"`
+	// List of assessment results because of which the evaluation status is compliant or not compliant
+	AssessmentResultIds []string `protobuf:"bytes,10,rep,name=assessment_result_ids,json=assessmentResultIds,proto3" json:"assessment_result_ids,omitempty" gorm:"serializer:json"`
+	Comment             *string  `protobuf:"bytes,11,opt,name=comment,proto3,oneof" json:"comment,omitempty"`
+	// Optional, but required if the status is one of the "manually" ones. This
+	// denotes how long the (manual) created evaluation result is valid. During
+	// this time, no automatic results are generated for the specific control.
+	ValidUntil *timestamppb.Timestamp `protobuf:"bytes,20,opt,name=valid_until,json=validUntil,proto3,oneof" json:"valid_until,omitempty" gorm:"serializer:timestamppb;type:timestamp"`
+	// Optional, but if you use manually created evaluation results, you can provide a justification for the manual
+	// creation, such as a large file like a policy in PDF format. For multiple
+	// or large files, use attachments instead.
+	Data []byte `protobuf:"bytes,21,opt,name=data,proto3,oneof" json:"data,omitempty" gorm:"type:bytea"`
+	// Attachments of the evaluation result, e.g., policy documents or
+	// screenshots justifying a manual evaluation. Their content is up- and
+	// downloaded using the UploadAttachment and DownloadAttachment RPCs of the
+	// orchestrator.
+	Attachments []*Attachment `protobuf:"bytes,22,rep,name=attachments,proto3" json:"attachments,omitempty" gorm:"foreignKey:EvaluationResultId;constraint:OnDelete:CASCADE"`
+	// Comments on the evaluation result, e.g., discussions about its
+	// remediation. They are managed using the AddEvaluationResultComment,
+	// ListEvaluationResultComments and RemoveEvaluationResultComment RPCs of the
+	// orchestrator.
+	Comments []*Comment `protobuf:"bytes,23,rep,name=comments,proto3" json:"comments,omitempty" gorm:"foreignKey:EvaluationResultId;constraint:OnDelete:CASCADE"`
+	// The time since which the control is continuously not compliant, i.e., the
+	// time of the first non-compliant result after the last compliant one. It is
+	// carried over by pending or erroneous results and cleared by a compliant
+	// result. It is used to track SLAs (see the sla_thresholds of a catalog).
+	NonCompliantSince *timestamppb.Timestamp `protobuf:"bytes,24,opt,name=non_compliant_since,json=nonCompliantSince,proto3,oneof" json:"non_compliant_since,omitempty" gorm:"serializer:timestamppb;type:timestamp"`
+	// A breakdown of the assessment results because of which the control is not
+	// compliant, grouped by metric and resource. It is only set if the status is
+	// not compliant and allows to explain the status without querying all
+	// assessment results.
+	FailingMetrics []*FailingMetric `protobuf:"bytes,25,rep,name=failing_metrics,json=failingMetrics,proto3" json:"failing_metrics,omitempty" gorm:"serializer:json"`
+	// A human-readable explanation of the result, e.g., which metrics were
+	// checked, how many resources were considered and what failed. It is
+	// rendered by the evaluation service from a (configurable) narrative
+	// template in the locale of the audit scope and is intended for audit
+	// reports.
+	Narrative *string `protobuf:"bytes,26,opt,name=narrative,proto3,oneof" json:"narrative,omitempty"`
+	// Optional. A catalog-defined refinement of the status, e.g.,
+	// PARTIALLY_COMPLIANT. It is one of the custom statuses of the catalog of
+	// the control that refine the status of this result. Consumers that are not
+	// aware of the catalog can rely on the status alone.
+	SubStatus *string `protobuf:"bytes,27,opt,name=sub_status,json=subStatus,proto3,oneof" json:"sub_status,omitempty"`
+	// The cause why the evaluation of the control failed, e.g., because the
+	// orchestrator could not be reached. It is only set if the status is ERROR.
+	ErrorCause *string `protobuf:"bytes,28,opt,name=error_cause,json=errorCause,proto3,oneof" json:"error_cause,omitempty"`
+	// Whether the result was stored during a maintenance window of its audit
+	// scope. Non-compliant results during maintenance do not start tracking
+	// non-compliance (see non_compliant_since), so planned outages do not lead
+	// to SLA breaches.
+	DuringMaintenance bool `protobuf:"varint,29,opt,name=during_maintenance,json=duringMaintenance,proto3" json:"during_maintenance,omitempty"`
+	// The reasons that explain the status beyond the compliance of the
+	// assessment results, e.g., that some assessment results are based on
+	// evidence older than the maximum evidence age of the control.
+	Reasons []EvaluationReason `protobuf:"varint,30,rep,packed,name=reasons,proto3,enum=confirmate.evaluation.v1.EvaluationReason" json:"reasons,omitempty" gorm:"serializer:json"`
+	// The version of the published catalog the control was evaluated against,
+	// see Catalog.version. It is recorded when the result is stored, so that the
+	// result remains interpretable after the catalog is updated.
+	CatalogVersion *int32 `protobuf:"varint,31,opt,name=catalog_version,json=catalogVersion,proto3,oneof" json:"catalog_version,omitempty"`
+	// The content hash of the published catalog the control was evaluated
+	// against, see Catalog.content_hash. It proves which requirement texts the
+	// result refers to.
+	CatalogHash *string `protobuf:"bytes,32,opt,name=catalog_hash,json=catalogHash,proto3,oneof" json:"catalog_hash,omitempty"`
+	// The environment (e.g., prod or staging) of the audit scope the result
+	// belongs to. It is taken from the audit scope when the result is stored.
+	Environment *string `protobuf:"bytes,33,opt,name=environment,proto3,oneof" json:"environment,omitempty" gorm:"index"`
+	// The ID of the evaluation run that produced the result. It is derived from
+	// the audit scope and the start of the run, so that all results of a run
+	// share it and runs can be compared with each other. Manually created
+	// results do not belong to a run.
+	RunId *string `protobuf:"bytes,34,opt,name=run_id,json=runId,proto3,oneof" json:"run_id,omitempty" gorm:"index"`
+	// The name of the category of the control within its catalog. It is
+	// recorded when the result is stored and is used to order results
+	// deterministically.
+	CategoryName *string `protobuf:"bytes,35,opt,name=category_name,json=categoryName,proto3,oneof" json:"category_name,omitempty"`
+	// The catalog-local identifier of the control, e.g., OPS-01.1 (see
+	// Control.short_name). It is recorded when the result is stored and is used
+	// to order results deterministically.
+	ControlShortName *string `protobuf:"bytes,36,opt,name=control_short_name,json=controlShortName,proto3,oneof" json:"control_short_name,omitempty"`
+	// The sample of assessment results the control was evaluated on. It is
+	// only set if the control is backed by more assessment results than the
+	// configured sample size of the evaluation service, in which case
+	// assessment_result_ids only contains the sampled results.
+	Sample *EvaluationSample `protobuf:"bytes,37,opt,name=sample,proto3,oneof" json:"sample,omitempty" gorm:"serializer:json"`
+	// The remediation of the control at the time of the evaluation, i.e., how
+	// to fulfill the control, if it is not. The remediations of its metrics are
+	// part of the failing_metrics.
+	Remediation   *assessment.Remediation `protobuf:"bytes,38,opt,name=remediation,proto3,oneof" json:"remediation,omitempty" gorm:"serializer:json"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EvaluationResult) Reset() {
+	*x = EvaluationResult{}
+	mi := &file_api_evaluation_evaluation_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
 func (x *EvaluationResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EvaluationResult) ProtoMessage() {}
+func (*EvaluationResult) ProtoMessage() {}
+
+func (x *EvaluationResult) ProtoReflect() protoreflect.Message {
+	mi := &file_api_evaluation_evaluation_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EvaluationResult.ProtoReflect.Descriptor instead.
+func (*EvaluationResult) Descriptor() ([]byte, []int) {
+	return file_api_evaluation_evaluation_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *EvaluationResult) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *EvaluationResult) GetTargetOfEvaluationId() string {
+	if x != nil {
+		return x.TargetOfEvaluationId
+	}
+	return ""
+}
+
+func (x *EvaluationResult) GetAuditScopeId() string {
+	if x != nil {
+		return x.AuditScopeId
+	}
+	return ""
+}
+
+func (x *EvaluationResult) GetControlId() string {
+	if x != nil {
+		return x.ControlId
+	}
+	return ""
+}
+
+func (x *EvaluationResult) GetControlCatalogId() string {
+	if x != nil {
+		return x.ControlCatalogId
+	}
+	return ""
+}
+
+func (x *EvaluationResult) GetParentControlId() string {
+	if x != nil && x.ParentControlId != nil {
+		return *x.ParentControlId
+	}
+	return ""
+}
+
+func (x *EvaluationResult) GetStatus() EvaluationStatus {
+	if x != nil {
+		return x.Status
+	}
+	return EvaluationStatus_EVALUATION_STATUS_UNSPECIFIED
+}
+
+func (x *EvaluationResult) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *EvaluationResult) GetAssessmentResultIds() []string {
+	if x != nil {
+		return x.AssessmentResultIds
+	}
+	return nil
+}
+
+func (x *EvaluationResult) GetComment() string {
+	if x != nil && x.Comment != nil {
+		return *x.Comment
+	}
+	return ""
+}
+
+func (x *EvaluationResult) GetValidUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValidUntil
+	}
+	return nil
+}
+
+func (x *EvaluationResult) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *EvaluationResult) GetAttachments() []*Attachment {
+	if x != nil {
+		return x.Attachments
+	}
+	return nil
+}
+
+func (x *EvaluationResult) GetComments() []*Comment {
+	if x != nil {
+		return x.Comments
+	}
+	return nil
+}
+
+func (x *EvaluationResult) GetNonCompliantSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NonCompliantSince
+	}
+	return nil
+}
+
+func (x *EvaluationResult) GetFailingMetrics() []*FailingMetric {
+	if x != nil {
+		return x.FailingMetrics
+	}
+	return nil
+}
+
+func (x *EvaluationResult) GetNarrative() string {
+	if x != nil && x.Narrative != nil {
+		return *x.Narrative
+	}
+	return ""
+}
+
+func (x *EvaluationResult) GetSubStatus() string {
+	if x != nil && x.SubStatus != nil {
+		return *x.SubStatus
+	}
+	return ""
+}
+
+func (x *EvaluationResult) GetErrorCause() string {
+	if x != nil && x.ErrorCause != nil {
+		return *x.ErrorCause
+	}
+	return ""
+}
+
+func (x *EvaluationResult) GetDuringMaintenance() bool {
+	if x != nil {
+		return x.DuringMaintenance
+	}
+	return false
+}
+
+func (x *EvaluationResult) GetReasons() []EvaluationReason {
+	if x != nil {
+		return x.Reasons
+	}
+	return nil
+}
+
+func (x *EvaluationResult) GetCatalogVersion() int32 {
+	if x != nil && x.CatalogVersion != nil {
+		return *x.CatalogVersion
+	}
+	return 0
+}
+
+func (x *EvaluationResult) GetCatalogHash() string {
+	if x != nil && x.CatalogHash != nil {
+		return *x.CatalogHash
+	}
+	return ""
+}
+
+func (x *EvaluationResult) GetEnvironment() string {
+	if x != nil && x.Environment != nil {
+		return *x.Environment
+	}
+	return ""
+}
+
+func (x *EvaluationResult) GetRunId() string {
+	if x != nil && x.RunId != nil {
+		return *x.RunId
+	}
+	return ""
+}
+
+func (x *EvaluationResult) GetCategoryName() string {
+	if x != nil && x.CategoryName != nil {
+		return *x.CategoryName
+	}
+	return ""
+}
+
+func (x *EvaluationResult) GetControlShortName() string {
+	if x != nil && x.ControlShortName != nil {
+		return *x.ControlShortName
+	}
+	return ""
+}
+
+func (x *EvaluationResult) GetSample() *EvaluationSample {
+	if x != nil {
+		return x.Sample
+	}
+	return nil
+}
+
+func (x *EvaluationResult) GetRemediation() *assessment.Remediation {
+	if x != nil {
+		return x.Remediation
+	}
+	return nil
+}
+
+// An EvaluationSample describes the sample of assessment results a control
+// was evaluated on instead of all of its latest assessment results.
+type EvaluationSample struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The strategy the sample was drawn with.
+	Strategy SamplingStrategy `protobuf:"varint,1,opt,name=strategy,proto3,enum=confirmate.evaluation.v1.SamplingStrategy" json:"strategy,omitempty"`
+	// The number of latest assessment results of the control, i.e., the
+	// population the sample was drawn from.
+	PopulationSize int64 `protobuf:"varint,2,opt,name=population_size,json=populationSize,proto3" json:"population_size,omitempty"`
+	// The number of assessment results in the sample.
+	SampleSize int64 `protobuf:"varint,3,opt,name=sample_size,json=sampleSize,proto3" json:"sample_size,omitempty"`
+	// The confidence level of the margin_of_error, e.g., 0.95.
+	ConfidenceLevel float64 `protobuf:"fixed64,4,opt,name=confidence_level,json=confidenceLevel,proto3" json:"confidence_level,omitempty"`
+	// The maximum margin of error of the compliance rate, i.e., with the
+	// probability of the confidence_level, the compliance rate of the
+	// population differs at most by this value from the compliance rate of
+	// the sample. It is only set for unbiased strategies, i.e., not for
+	// SAMPLING_STRATEGY_NON_COMPLIANT_FIRST.
+	MarginOfError *float64 `protobuf:"fixed64,5,opt,name=margin_of_error,json=marginOfError,proto3,oneof" json:"margin_of_error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EvaluationSample) Reset() {
+	*x = EvaluationSample{}
+	mi := &file_api_evaluation_evaluation_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EvaluationSample) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EvaluationSample) ProtoMessage() {}
 
-func (x *EvaluationResult) ProtoReflect() protoreflect.Message {
-	mi := &file_api_evaluation_evaluation_proto_msgTypes[6]
+func (x *EvaluationSample) ProtoReflect() protoreflect.Message {
+	mi := &file_api_evaluation_evaluation_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -409,91 +2620,283 @@ func (x *EvaluationResult) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EvaluationResult.ProtoReflect.Descriptor instead.
-func (*EvaluationResult) Descriptor() ([]byte, []int) {
-	return file_api_evaluation_evaluation_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use EvaluationSample.ProtoReflect.Descriptor instead.
+func (*EvaluationSample) Descriptor() ([]byte, []int) {
+	return file_api_evaluation_evaluation_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *EvaluationResult) GetId() string {
+func (x *EvaluationSample) GetStrategy() SamplingStrategy {
 	if x != nil {
-		return x.Id
+		return x.Strategy
 	}
-	return ""
+	return SamplingStrategy_SAMPLING_STRATEGY_UNSPECIFIED
 }
 
-func (x *EvaluationResult) GetTargetOfEvaluationId() string {
+func (x *EvaluationSample) GetPopulationSize() int64 {
 	if x != nil {
-		return x.TargetOfEvaluationId
+		return x.PopulationSize
 	}
-	return ""
+	return 0
 }
 
-func (x *EvaluationResult) GetAuditScopeId() string {
+func (x *EvaluationSample) GetSampleSize() int64 {
 	if x != nil {
-		return x.AuditScopeId
+		return x.SampleSize
 	}
-	return ""
+	return 0
 }
 
-func (x *EvaluationResult) GetControlId() string {
+func (x *EvaluationSample) GetConfidenceLevel() float64 {
 	if x != nil {
-		return x.ControlId
+		return x.ConfidenceLevel
 	}
-	return ""
+	return 0
 }
 
-func (x *EvaluationResult) GetControlCatalogId() string {
+func (x *EvaluationSample) GetMarginOfError() float64 {
+	if x != nil && x.MarginOfError != nil {
+		return *x.MarginOfError
+	}
+	return 0
+}
+
+// A FailingMetric summarizes the non-compliant (and not waived) assessment
+// results of a metric within an evaluation result.
+type FailingMetric struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	MetricId string                 `protobuf:"bytes,1,opt,name=metric_id,json=metricId,proto3" json:"metric_id,omitempty"`
+	// The number of non-compliant assessment results of the metric.
+	ResultCount int32 `protobuf:"varint,2,opt,name=result_count,json=resultCount,proto3" json:"result_count,omitempty"`
+	// The number of distinct resources with non-compliant assessment results.
+	ResourceCount int32 `protobuf:"varint,3,opt,name=resource_count,json=resourceCount,proto3" json:"resource_count,omitempty"`
+	// A sample of the resources with non-compliant assessment results. It
+	// contains at most 5 resources.
+	Resources []*FailingResource `protobuf:"bytes,4,rep,name=resources,proto3" json:"resources,omitempty"`
+	// The remediation of the metric, i.e., how to fix its non-compliant
+	// resources.
+	Remediation   *assessment.Remediation `protobuf:"bytes,5,opt,name=remediation,proto3,oneof" json:"remediation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FailingMetric) Reset() {
+	*x = FailingMetric{}
+	mi := &file_api_evaluation_evaluation_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FailingMetric) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FailingMetric) ProtoMessage() {}
+
+func (x *FailingMetric) ProtoReflect() protoreflect.Message {
+	mi := &file_api_evaluation_evaluation_proto_msgTypes[31]
 	if x != nil {
-		return x.ControlCatalogId
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *EvaluationResult) GetParentControlId() string {
-	if x != nil && x.ParentControlId != nil {
-		return *x.ParentControlId
+// Deprecated: Use FailingMetric.ProtoReflect.Descriptor instead.
+func (*FailingMetric) Descriptor() ([]byte, []int) {
+	return file_api_evaluation_evaluation_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *FailingMetric) GetMetricId() string {
+	if x != nil {
+		return x.MetricId
 	}
 	return ""
 }
 
-func (x *EvaluationResult) GetStatus() EvaluationStatus {
+func (x *FailingMetric) GetResultCount() int32 {
 	if x != nil {
-		return x.Status
+		return x.ResultCount
 	}
-	return EvaluationStatus_EVALUATION_STATUS_UNSPECIFIED
+	return 0
 }
 
-func (x *EvaluationResult) GetTimestamp() *timestamppb.Timestamp {
+func (x *FailingMetric) GetResourceCount() int32 {
 	if x != nil {
-		return x.Timestamp
+		return x.ResourceCount
+	}
+	return 0
+}
+
+func (x *FailingMetric) GetResources() []*FailingResource {
+	if x != nil {
+		return x.Resources
 	}
 	return nil
 }
 
-func (x *EvaluationResult) GetAssessmentResultIds() []string {
+func (x *FailingMetric) GetRemediation() *assessment.Remediation {
 	if x != nil {
-		return x.AssessmentResultIds
+		return x.Remediation
 	}
 	return nil
 }
 
-func (x *EvaluationResult) GetComment() string {
-	if x != nil && x.Comment != nil {
-		return *x.Comment
+// A FailingResource lists the non-compliant assessment results of a resource
+// for a particular metric.
+type FailingResource struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	ResourceId string                 `protobuf:"bytes,1,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
+	// The IDs of the non-compliant assessment results of the resource.
+	AssessmentResultIds []string `protobuf:"bytes,2,rep,name=assessment_result_ids,json=assessmentResultIds,proto3" json:"assessment_result_ids,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *FailingResource) Reset() {
+	*x = FailingResource{}
+	mi := &file_api_evaluation_evaluation_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FailingResource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FailingResource) ProtoMessage() {}
+
+func (x *FailingResource) ProtoReflect() protoreflect.Message {
+	mi := &file_api_evaluation_evaluation_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FailingResource.ProtoReflect.Descriptor instead.
+func (*FailingResource) Descriptor() ([]byte, []int) {
+	return file_api_evaluation_evaluation_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *FailingResource) GetResourceId() string {
+	if x != nil {
+		return x.ResourceId
 	}
 	return ""
 }
 
-func (x *EvaluationResult) GetValidUntil() *timestamppb.Timestamp {
+func (x *FailingResource) GetAssessmentResultIds() []string {
 	if x != nil {
-		return x.ValidUntil
+		return x.AssessmentResultIds
 	}
 	return nil
 }
 
-func (x *EvaluationResult) GetData() []byte {
+// An Attachment is a named file attached to an evaluation result. Only its
+// metadata is part of this message, the content is stored separately, either
+// in the database or in an object storage.
+type Attachment struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Attachment id
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The evaluation result the attachment belongs to
+	EvaluationResultId string `protobuf:"bytes,2,opt,name=evaluation_result_id,json=evaluationResultId,proto3" json:"evaluation_result_id,omitempty"`
+	// The (file) name of the attachment
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// The MIME content type of the attachment, e.g., "application/pdf"
+	ContentType string `protobuf:"bytes,4,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	// The size of the content in bytes
+	Size int64 `protobuf:"varint,5,opt,name=size,proto3" json:"size,omitempty"`
+	// The hex-encoded SHA-256 checksum of the content
+	Sha256 string `protobuf:"bytes,6,opt,name=sha256,proto3" json:"sha256,omitempty"`
+	// Time the attachment was uploaded
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty" gorm:"serializer:timestamppb;type:timestamp"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Attachment) Reset() {
+	*x = Attachment{}
+	mi := &file_api_evaluation_evaluation_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Attachment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Attachment) ProtoMessage() {}
+
+func (x *Attachment) ProtoReflect() protoreflect.Message {
+	mi := &file_api_evaluation_evaluation_proto_msgTypes[33]
 	if x != nil {
-		return x.Data
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Attachment.ProtoReflect.Descriptor instead.
+func (*Attachment) Descriptor() ([]byte, []int) {
+	return file_api_evaluation_evaluation_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *Attachment) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Attachment) GetEvaluationResultId() string {
+	if x != nil {
+		return x.EvaluationResultId
+	}
+	return ""
+}
+
+func (x *Attachment) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Attachment) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *Attachment) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *Attachment) GetSha256() string {
+	if x != nil {
+		return x.Sha256
+	}
+	return ""
+}
+
+func (x *Attachment) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
 	}
 	return nil
 }
@@ -505,15 +2908,19 @@ type EvaluationJob struct {
 	// interval in minutes the evaluation executes periodically. The default interval is set to 5 minutes.
 	Interval int32 `protobuf:"varint,3,opt,name=interval,proto3" json:"interval,omitempty"`
 	// the number of times the job has finished running
-	RunCount      int32                  `protobuf:"varint,4,opt,name=run_count,json=runCount,proto3" json:"run_count,omitempty"`
-	LastRun       *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=last_run,json=lastRun,proto3" json:"last_run,omitempty" gorm:"serializer:timestamppb;type:timestamp"`
+	RunCount int32                  `protobuf:"varint,4,opt,name=run_count,json=runCount,proto3" json:"run_count,omitempty"`
+	LastRun  *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=last_run,json=lastRun,proto3" json:"last_run,omitempty" gorm:"serializer:timestamppb;type:timestamp"`
+	// whether the job is paused, see PauseEvaluation. Scheduled runs of a paused job are skipped.
+	Paused bool `protobuf:"varint,6,opt,name=paused,proto3" json:"paused,omitempty"`
+	// the time the job was paused. It is only set, if the job is paused.
+	PausedAt      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=paused_at,json=pausedAt,proto3,oneof" json:"paused_at,omitempty" gorm:"serializer:timestamppb;type:timestamp"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *EvaluationJob) Reset() {
 	*x = EvaluationJob{}
-	mi := &file_api_evaluation_evaluation_proto_msgTypes[7]
+	mi := &file_api_evaluation_evaluation_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -525,7 +2932,7 @@ func (x *EvaluationJob) String() string {
 func (*EvaluationJob) ProtoMessage() {}
 
 func (x *EvaluationJob) ProtoReflect() protoreflect.Message {
-	mi := &file_api_evaluation_evaluation_proto_msgTypes[7]
+	mi := &file_api_evaluation_evaluation_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -538,7 +2945,7 @@ func (x *EvaluationJob) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EvaluationJob.ProtoReflect.Descriptor instead.
 func (*EvaluationJob) Descriptor() ([]byte, []int) {
-	return file_api_evaluation_evaluation_proto_rawDescGZIP(), []int{7}
+	return file_api_evaluation_evaluation_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *EvaluationJob) GetAuditScopeId() string {
@@ -576,6 +2983,124 @@ func (x *EvaluationJob) GetLastRun() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *EvaluationJob) GetPaused() bool {
+	if x != nil {
+		return x.Paused
+	}
+	return false
+}
+
+func (x *EvaluationJob) GetPausedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PausedAt
+	}
+	return nil
+}
+
+// A Comment is a remark on an evaluation result. Comments form threads: a
+// comment either starts a new thread or replies to the first comment of an
+// existing one.
+type Comment struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Comment id
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The evaluation result the comment belongs to
+	EvaluationResultId string `protobuf:"bytes,2,opt,name=evaluation_result_id,json=evaluationResultId,proto3" json:"evaluation_result_id,omitempty"`
+	// The comment this comment replies to. If it is not set, the comment starts
+	// a new thread. Replies to a reply are attached to the thread of the
+	// replied-to comment.
+	ParentId *string `protobuf:"bytes,3,opt,name=parent_id,json=parentId,proto3,oneof" json:"parent_id,omitempty"`
+	// The ID of the user who wrote the comment
+	Author string `protobuf:"bytes,4,opt,name=author,proto3" json:"author,omitempty"`
+	// Time the comment was written
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty" gorm:"serializer:timestamppb;type:timestamp"`
+	// The text of the comment
+	Text string `protobuf:"bytes,6,opt,name=text,proto3" json:"text,omitempty"`
+	// The IDs of the users mentioned in the comment
+	Mentions      []string `protobuf:"bytes,7,rep,name=mentions,proto3" json:"mentions,omitempty" gorm:"serializer:json"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Comment) Reset() {
+	*x = Comment{}
+	mi := &file_api_evaluation_evaluation_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Comment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Comment) ProtoMessage() {}
+
+func (x *Comment) ProtoReflect() protoreflect.Message {
+	mi := &file_api_evaluation_evaluation_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Comment.ProtoReflect.Descriptor instead.
+func (*Comment) Descriptor() ([]byte, []int) {
+	return file_api_evaluation_evaluation_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *Comment) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Comment) GetEvaluationResultId() string {
+	if x != nil {
+		return x.EvaluationResultId
+	}
+	return ""
+}
+
+func (x *Comment) GetParentId() string {
+	if x != nil && x.ParentId != nil {
+		return *x.ParentId
+	}
+	return ""
+}
+
+func (x *Comment) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+func (x *Comment) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Comment) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *Comment) GetMentions() []string {
+	if x != nil {
+		return x.Mentions
+	}
+	return nil
+}
+
 type ListEvaluationJobsRequest_Filter struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Optional, if provided, filters the evaluation jobs by the given audit scope ID.
@@ -586,7 +3111,7 @@ type ListEvaluationJobsRequest_Filter struct {
 
 func (x *ListEvaluationJobsRequest_Filter) Reset() {
 	*x = ListEvaluationJobsRequest_Filter{}
-	mi := &file_api_evaluation_evaluation_proto_msgTypes[8]
+	mi := &file_api_evaluation_evaluation_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -598,7 +3123,7 @@ func (x *ListEvaluationJobsRequest_Filter) String() string {
 func (*ListEvaluationJobsRequest_Filter) ProtoMessage() {}
 
 func (x *ListEvaluationJobsRequest_Filter) ProtoReflect() protoreflect.Message {
-	mi := &file_api_evaluation_evaluation_proto_msgTypes[8]
+	mi := &file_api_evaluation_evaluation_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -611,7 +3136,7 @@ func (x *ListEvaluationJobsRequest_Filter) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListEvaluationJobsRequest_Filter.ProtoReflect.Descriptor instead.
 func (*ListEvaluationJobsRequest_Filter) Descriptor() ([]byte, []int) {
-	return file_api_evaluation_evaluation_proto_rawDescGZIP(), []int{4, 0}
+	return file_api_evaluation_evaluation_proto_rawDescGZIP(), []int{8, 0}
 }
 
 func (x *ListEvaluationJobsRequest_Filter) GetAuditScopeId() string {
@@ -625,18 +3150,39 @@ var File_api_evaluation_evaluation_proto protoreflect.FileDescriptor
 
 const file_api_evaluation_evaluation_proto_rawDesc = "" +
 	"\n" +
-	"\x1fapi/evaluation/evaluation.proto\x12\x18confirmate.evaluation.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x13tagger/tagger.proto\"\x82\x01\n" +
+	"\x1fapi/evaluation/evaluation.proto\x12\x18confirmate.evaluation.v1\x1a\x1bapi/assessment/metric.proto\x1a\x1bbuf/validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x19google/api/httpbody.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x13tagger/tagger.proto\"\x98\x05\n" +
 	"\x16StartEvaluationRequest\x121\n" +
 	"\x0eaudit_scope_id\x18\x01 \x01(\tB\v\xe0A\x02\xbaH\x05r\x03\xb0\x01\x01R\fauditScopeId\x12(\n" +
-	"\binterval\x18\x03 \x01(\x05B\a\xbaH\x04\x1a\x02 \x00H\x00R\binterval\x88\x01\x01B\v\n" +
-	"\t_interval\"9\n" +
+	"\binterval\x18\x03 \x01(\x05B\a\xbaH\x04\x1a\x02 \x00H\x00R\binterval\x88\x01\x01\x12&\n" +
+	"\atimeout\x18\x04 \x01(\x05B\a\xbaH\x04\x1a\x02 \x00H\x01R\atimeout\x88\x01\x01\x12~\n" +
+	"\x10control_timeouts\x18\x05 \x03(\v2E.confirmate.evaluation.v1.StartEvaluationRequest.ControlTimeoutsEntryB\f\xbaH\t\x9a\x01\x06*\x04\x1a\x02 \x00R\x0fcontrolTimeouts\x12*\n" +
+	"\x06locale\x18\x06 \x01(\tB\r\xbaH\n" +
+	"r\bR\x02enR\x02deH\x02R\x06locale\x88\x01\x01\x12?\n" +
+	"\x15max_evidence_age_days\x18\a \x01(\rB\a\xbaH\x04*\x02 \x00H\x03R\x12maxEvidenceAgeDays\x88\x01\x01\x12q\n" +
+	"\x14aggregation_strategy\x18\b \x01(\x0e2-.confirmate.evaluation.v1.AggregationStrategyB\n" +
+	"\xbaH\a\x82\x01\x04\x10\x01 \x00H\x04R\x13aggregationStrategy\x88\x01\x01\x1aB\n" +
+	"\x14ControlTimeoutsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01B\v\n" +
+	"\t_intervalB\n" +
+	"\n" +
+	"\b_timeoutB\t\n" +
+	"\a_localeB\x18\n" +
+	"\x16_max_evidence_age_daysB\x17\n" +
+	"\x15_aggregation_strategy\"9\n" +
 	"\x17StartEvaluationResponse\x12\x1e\n" +
 	"\n" +
 	"successful\x18\x01 \x01(\bR\n" +
 	"successful\"J\n" +
 	"\x15StopEvaluationRequest\x121\n" +
 	"\x0eaudit_scope_id\x18\x01 \x01(\tB\v\xe0A\x02\xbaH\x05r\x03\xb0\x01\x01R\fauditScopeId\"\x18\n" +
-	"\x16StopEvaluationResponse\"\xd1\x01\n" +
+	"\x16StopEvaluationResponse\"K\n" +
+	"\x16PauseEvaluationRequest\x121\n" +
+	"\x0eaudit_scope_id\x18\x01 \x01(\tB\v\xe0A\x02\xbaH\x05r\x03\xb0\x01\x01R\fauditScopeId\"\x19\n" +
+	"\x17PauseEvaluationResponse\"L\n" +
+	"\x17ResumeEvaluationRequest\x121\n" +
+	"\x0eaudit_scope_id\x18\x01 \x01(\tB\v\xe0A\x02\xbaH\x05r\x03\xb0\x01\x01R\fauditScopeId\"\x1a\n" +
+	"\x18ResumeEvaluationResponse\"\xd1\x01\n" +
 	"\x19ListEvaluationJobsRequest\x12W\n" +
 	"\x06filter\x18\x01 \x01(\v2:.confirmate.evaluation.v1.ListEvaluationJobsRequest.FilterH\x00R\x06filter\x88\x01\x01\x1aP\n" +
 	"\x06Filter\x123\n" +
@@ -644,7 +3190,133 @@ const file_api_evaluation_evaluation_proto_rawDesc = "" +
 	"\x0f_audit_scope_idB\t\n" +
 	"\a_filter\"n\n" +
 	"\x1aListEvaluationJobsResponse\x12P\n" +
-	"\x0fevaluation_jobs\x18\x01 \x03(\v2'.confirmate.evaluation.v1.EvaluationJobR\x0eevaluationJobs\"\xd7\x06\n" +
+	"\x0fevaluation_jobs\x18\x01 \x03(\v2'.confirmate.evaluation.v1.EvaluationJobR\x0eevaluationJobs\"\xba\x01\n" +
+	"\x12GetCoverageRequest\x121\n" +
+	"\x0eaudit_scope_id\x18\x01 \x01(\tB\v\xe0A\x02\xbaH\x05r\x03\xb0\x01\x01R\fauditScopeId\x12+\n" +
+	"\n" +
+	"catalog_id\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x10\x01H\x00R\tcatalogId\x88\x01\x01\x12*\n" +
+	"\x06locale\x18\x03 \x01(\tB\r\xbaH\n" +
+	"r\bR\x02enR\x02deH\x01R\x06locale\x88\x01\x01B\r\n" +
+	"\v_catalog_idB\t\n" +
+	"\a_locale\"\xf6\x01\n" +
+	"\x19SimulateEvaluationRequest\x121\n" +
+	"\x0eaudit_scope_id\x18\x01 \x01(\tB\v\xe0A\x02\xbaH\x05r\x03\xb0\x01\x01R\fauditScopeId\x12+\n" +
+	"\n" +
+	"catalog_id\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x10\x01H\x00R\tcatalogId\x88\x01\x01\x12j\n" +
+	"\x0econfigurations\x18\x03 \x03(\v25.confirmate.evaluation.v1.ProposedMetricConfigurationB\v\xe0A\x02\xbaH\x05\x92\x01\x02\b\x01R\x0econfigurationsB\r\n" +
+	"\v_catalog_id\"\x8a\x01\n" +
+	"\x1eGetCalendarSubscriptionRequest\x121\n" +
+	"\x0eaudit_scope_id\x18\x01 \x01(\tB\v\xe0A\x02\xbaH\x05r\x03\xb0\x01\x01R\fauditScopeId\x12*\n" +
+	"\x06locale\x18\x02 \x01(\tB\r\xbaH\n" +
+	"r\bR\x02enR\x02deH\x00R\x06locale\x88\x01\x01B\t\n" +
+	"\a_locale\"X\n" +
+	"\x14CalendarSubscription\x12)\n" +
+	"\x0eaudit_scope_id\x18\x01 \x01(\tB\x03\xe0A\x02R\fauditScopeId\x12\x15\n" +
+	"\x03url\x18\x02 \x01(\tB\x03\xe0A\x02R\x03url\"\xa4\x01\n" +
+	"\x16GetCalendarFeedRequest\x121\n" +
+	"\x0eaudit_scope_id\x18\x01 \x01(\tB\v\xe0A\x02\xbaH\x05r\x03\xb0\x01\x01R\fauditScopeId\x12 \n" +
+	"\x05token\x18\x02 \x01(\tB\n" +
+	"\xe0A\x02\xbaH\x04r\x02\x10\x01R\x05token\x12*\n" +
+	"\x06locale\x18\x03 \x01(\tB\r\xbaH\n" +
+	"r\bR\x02enR\x02deH\x00R\x06locale\x88\x01\x01B\t\n" +
+	"\a_locale\"\xf5\x01\n" +
+	"\"GetControlEvaluationContextRequest\x121\n" +
+	"\x0eaudit_scope_id\x18\x01 \x01(\tB\v\xe0A\x02\xbaH\x05r\x03\xb0\x01\x01R\fauditScopeId\x12)\n" +
+	"\n" +
+	"control_id\x18\x02 \x01(\tB\n" +
+	"\xe0A\x02\xbaH\x04r\x02\x10\x01R\tcontrolId\x12+\n" +
+	"\n" +
+	"catalog_id\x18\x03 \x01(\tB\a\xbaH\x04r\x02\x10\x01H\x00R\tcatalogId\x88\x01\x01\x12*\n" +
+	"\x06locale\x18\x04 \x01(\tB\r\xbaH\n" +
+	"r\bR\x02enR\x02deH\x01R\x06locale\x88\x01\x01B\r\n" +
+	"\v_catalog_idB\t\n" +
+	"\a_locale\"\xbb\x04\n" +
+	"\x18ControlEvaluationContext\x12)\n" +
+	"\x0eaudit_scope_id\x18\x01 \x01(\tB\x03\xe0A\x02R\fauditScopeId\x12\"\n" +
+	"\n" +
+	"catalog_id\x18\x02 \x01(\tB\x03\xe0A\x02R\tcatalogId\x12\"\n" +
+	"\n" +
+	"control_id\x18\x03 \x01(\tB\x03\xe0A\x02R\tcontrolId\x12S\n" +
+	"\n" +
+	"precedence\x18\x04 \x01(\x0e2..confirmate.evaluation.v1.EvaluationPrecedenceB\x03\xe0A\x02R\n" +
+	"precedence\x12T\n" +
+	"\rmanual_result\x18\x05 \x01(\v2*.confirmate.evaluation.v1.EvaluationResultH\x00R\fmanualResult\x88\x01\x01\x12B\n" +
+	"\fmanual_until\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampH\x01R\vmanualUntil\x88\x01\x01\x12`\n" +
+	"\x15excluded_sub_controls\x18\a \x03(\v2,.confirmate.evaluation.v1.ExcludedSubControlR\x13excludedSubControls\x12\x16\n" +
+	"\x06locale\x18\b \x01(\tR\x06locale\x12 \n" +
+	"\vexplanation\x18\t \x01(\tR\vexplanationB\x10\n" +
+	"\x0e_manual_resultB\x0f\n" +
+	"\r_manual_until\"\x82\x02\n" +
+	"\x12ExcludedSubControl\x12\"\n" +
+	"\n" +
+	"control_id\x18\x01 \x01(\tB\x03\xe0A\x02R\tcontrolId\x12-\n" +
+	"\x10manual_result_id\x18\x02 \x01(\tB\x03\xe0A\x02R\x0emanualResultId\x12G\n" +
+	"\x06status\x18\x03 \x01(\x0e2*.confirmate.evaluation.v1.EvaluationStatusB\x03\xe0A\x02R\x06status\x12@\n" +
+	"\vvalid_until\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\n" +
+	"validUntil\x88\x01\x01B\x0e\n" +
+	"\f_valid_until\"\x19\n" +
+	"\x17CheckConsistencyRequest\"\x1d\n" +
+	"\x1bGetConsistencyReportRequest\"\xb7\x01\n" +
+	"\x06Orphan\x12=\n" +
+	"\x04type\x18\x01 \x01(\x0e2$.confirmate.evaluation.v1.OrphanTypeB\x03\xe0A\x02R\x04type\x12\x13\n" +
+	"\x02id\x18\x02 \x01(\tB\x03\xe0A\x02R\x02id\x12\"\n" +
+	"\n" +
+	"missing_id\x18\x03 \x01(\tB\x03\xe0A\x02R\tmissingId\x125\n" +
+	"\x17target_of_evaluation_id\x18\x04 \x01(\tR\x14targetOfEvaluationId\"\xbd\x02\n" +
+	"\x11ConsistencyReport\x12>\n" +
+	"\n" +
+	"checked_at\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x02R\tcheckedAt\x12:\n" +
+	"\aorphans\x18\x02 \x03(\v2 .confirmate.evaluation.v1.OrphanR\aorphans\x12<\n" +
+	"\x1aevaluation_results_checked\x18\x03 \x01(\x03R\x18evaluationResultsChecked\x12<\n" +
+	"\x1aassessment_results_checked\x18\x04 \x01(\x03R\x18assessmentResultsChecked\x120\n" +
+	"\x14audit_scopes_checked\x18\x05 \x01(\x03R\x12auditScopesChecked\"\xb5\x01\n" +
+	"\x12EvaluateNowRequest\x121\n" +
+	"\x0eaudit_scope_id\x18\x01 \x01(\tB\v\xe0A\x02\xbaH\x05r\x03\xb0\x01\x01R\fauditScopeId\x12)\n" +
+	"\atimeout\x18\x02 \x01(\x05B\n" +
+	"\xbaH\a\x1a\x05\x18\x90\x1c \x00H\x00R\atimeout\x88\x01\x01\x12*\n" +
+	"\x06locale\x18\x03 \x01(\tB\r\xbaH\n" +
+	"r\bR\x02enR\x02deH\x01R\x06locale\x88\x01\x01B\n" +
+	"\n" +
+	"\b_timeoutB\t\n" +
+	"\a_locale\"\xc7\x01\n" +
+	"\x13EvaluateNowResponse\x12G\n" +
+	"\x06status\x18\x01 \x01(\x0e2*.confirmate.evaluation.v1.EvaluationStatusB\x03\xe0A\x02R\x06status\x12!\n" +
+	"\tcompliant\x18\x02 \x01(\bB\x03\xe0A\x02R\tcompliant\x12D\n" +
+	"\aresults\x18\x03 \x03(\v2*.confirmate.evaluation.v1.EvaluationResultR\aresults\"\xd2\x01\n" +
+	"\x1bProposedMetricConfiguration\x12'\n" +
+	"\tmetric_id\x18\x01 \x01(\tB\n" +
+	"\xe0A\x02\xbaH\x04r\x02\x10\x01R\bmetricId\x12D\n" +
+	"\boperator\x18\x02 \x01(\tB(\xe0A\x02\xbaH\"r 2\x1e^(<|>|<=|>=|==|!=|isIn|allIn)$R\boperator\x12D\n" +
+	"\ftarget_value\x18\x03 \x01(\v2\x16.google.protobuf.ValueB\t\xe0A\x02\xbaH\x03\xc8\x01\x01R\vtargetValue\"j\n" +
+	"\x1aSimulateEvaluationResponse\x12L\n" +
+	"\bcontrols\x18\x01 \x03(\v20.confirmate.evaluation.v1.SimulatedControlStatusR\bcontrols\"\xbb\x03\n" +
+	"\x16SimulatedControlStatus\x12\"\n" +
+	"\n" +
+	"control_id\x18\x01 \x01(\tB\x03\xe0A\x02R\tcontrolId\x12/\n" +
+	"\x11parent_control_id\x18\x02 \x01(\tH\x00R\x0fparentControlId\x88\x01\x01\x12Q\n" +
+	"\x0ecurrent_status\x18\x03 \x01(\x0e2*.confirmate.evaluation.v1.EvaluationStatusR\rcurrentStatus\x12U\n" +
+	"\x10simulated_status\x18\x04 \x01(\x0e2*.confirmate.evaluation.v1.EvaluationStatusR\x0fsimulatedStatus\x12A\n" +
+	"\x1dchanged_assessment_result_ids\x18\x05 \x03(\tR\x1achangedAssessmentResultIds\x12I\n" +
+	"!unsimulated_assessment_result_ids\x18\x06 \x03(\tR\x1eunsimulatedAssessmentResultIdsB\x14\n" +
+	"\x12_parent_control_id\"\x8e\x02\n" +
+	"\bCoverage\x12)\n" +
+	"\x0eaudit_scope_id\x18\x01 \x01(\tB\x03\xe0A\x02R\fauditScopeId\x12:\n" +
+	"\x17target_of_evaluation_id\x18\x02 \x01(\tB\x03\xe0A\x02R\x14targetOfEvaluationId\x12\"\n" +
+	"\n" +
+	"catalog_id\x18\x03 \x01(\tB\x03\xe0A\x02R\tcatalogId\x12E\n" +
+	"\bcontrols\x18\x04 \x03(\v2).confirmate.evaluation.v1.ControlCoverageR\bcontrols\x12\x16\n" +
+	"\x06locale\x18\x05 \x01(\tR\x06locale\x12\x18\n" +
+	"\asummary\x18\x06 \x01(\tR\asummary\"\xb0\x02\n" +
+	"\x0fControlCoverage\x12\"\n" +
+	"\n" +
+	"control_id\x18\x01 \x01(\tB\x03\xe0A\x02R\tcontrolId\x12/\n" +
+	"\x11parent_control_id\x18\x02 \x01(\tH\x00R\x0fparentControlId\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"metric_ids\x18\x03 \x03(\tR\tmetricIds\x12.\n" +
+	"\x13assessed_metric_ids\x18\x04 \x03(\tR\x11assessedMetricIds\x12@\n" +
+	"\x06status\x18\x05 \x01(\x0e2(.confirmate.evaluation.v1.CoverageStatusR\x06status\x12!\n" +
+	"\fstatus_label\x18\x06 \x01(\tR\vstatusLabelB\x14\n" +
+	"\x12_parent_control_id\"\xd9\x12\n" +
 	"\x10EvaluationResult\x12\x1b\n" +
 	"\x02id\x18\x01 \x01(\tB\v\xe0A\x02\xbaH\x05r\x03\xb0\x01\x01R\x02id\x12?\n" +
 	"\x17target_of_evaluation_id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x14targetOfEvaluationId\x12.\n" +
@@ -660,19 +3332,116 @@ const file_api_evaluation_evaluation_proto_rawDesc = "" +
 	"\acomment\x18\v \x01(\tH\x01R\acomment\x88\x01\x01\x12s\n" +
 	"\vvalid_until\x18\x14 \x01(\v2\x1a.google.protobuf.TimestampB1\x9a\x84\x9e\x03,gorm:\"serializer:timestamppb;type:timestamp\"H\x02R\n" +
 	"validUntil\x88\x01\x01\x12/\n" +
-	"\x04data\x18\x15 \x01(\fB\x16\x9a\x84\x9e\x03\x11gorm:\"type:bytea\"H\x03R\x04data\x88\x01\x01B\x14\n" +
+	"\x04data\x18\x15 \x01(\fB\x16\x9a\x84\x9e\x03\x11gorm:\"type:bytea\"H\x03R\x04data\x88\x01\x01\x12\x90\x01\n" +
+	"\vattachments\x18\x16 \x03(\v2$.confirmate.evaluation.v1.AttachmentBH\xe0A\x03\x9a\x84\x9e\x03@gorm:\"foreignKey:EvaluationResultId;constraint:OnDelete:CASCADE\"R\vattachments\x12\x87\x01\n" +
+	"\bcomments\x18\x17 \x03(\v2!.confirmate.evaluation.v1.CommentBH\xe0A\x03\x9a\x84\x9e\x03@gorm:\"foreignKey:EvaluationResultId;constraint:OnDelete:CASCADE\"R\bcomments\x12\x85\x01\n" +
+	"\x13non_compliant_since\x18\x18 \x01(\v2\x1a.google.protobuf.TimestampB4\xe0A\x03\x9a\x84\x9e\x03,gorm:\"serializer:timestamppb;type:timestamp\"H\x04R\x11nonCompliantSince\x88\x01\x01\x12m\n" +
+	"\x0ffailing_metrics\x18\x19 \x03(\v2'.confirmate.evaluation.v1.FailingMetricB\x1b\x9a\x84\x9e\x03\x16gorm:\"serializer:json\"R\x0efailingMetrics\x12!\n" +
+	"\tnarrative\x18\x1a \x01(\tH\x05R\tnarrative\x88\x01\x01\x12+\n" +
+	"\n" +
+	"sub_status\x18\x1b \x01(\tB\a\xbaH\x04r\x02\x10\x01H\x06R\tsubStatus\x88\x01\x01\x12-\n" +
+	"\verror_cause\x18\x1c \x01(\tB\a\xbaH\x04r\x02\x10\x01H\aR\n" +
+	"errorCause\x88\x01\x01\x122\n" +
+	"\x12during_maintenance\x18\x1d \x01(\bB\x03\xe0A\x03R\x11duringMaintenance\x12d\n" +
+	"\areasons\x18\x1e \x03(\x0e2*.confirmate.evaluation.v1.EvaluationReasonB\x1e\xe0A\x03\x9a\x84\x9e\x03\x16gorm:\"serializer:json\"R\areasons\x121\n" +
+	"\x0fcatalog_version\x18\x1f \x01(\x05B\x03\xe0A\x03H\bR\x0ecatalogVersion\x88\x01\x01\x12+\n" +
+	"\fcatalog_hash\x18  \x01(\tB\x03\xe0A\x03H\tR\vcatalogHash\x88\x01\x01\x12;\n" +
+	"\venvironment\x18! \x01(\tB\x14\xe0A\x03\x9a\x84\x9e\x03\fgorm:\"index\"H\n" +
+	"R\venvironment\x88\x01\x01\x125\n" +
+	"\x06run_id\x18\" \x01(\tB\x19\xbaH\x05r\x03\xb0\x01\x01\x9a\x84\x9e\x03\fgorm:\"index\"H\vR\x05runId\x88\x01\x01\x12-\n" +
+	"\rcategory_name\x18# \x01(\tB\x03\xe0A\x03H\fR\fcategoryName\x88\x01\x01\x126\n" +
+	"\x12control_short_name\x18$ \x01(\tB\x03\xe0A\x03H\rR\x10controlShortName\x88\x01\x01\x12d\n" +
+	"\x06sample\x18% \x01(\v2*.confirmate.evaluation.v1.EvaluationSampleB\x1b\x9a\x84\x9e\x03\x16gorm:\"serializer:json\"H\x0eR\x06sample\x88\x01\x01\x12i\n" +
+	"\vremediation\x18& \x01(\v2%.confirmate.assessment.v1.RemediationB\x1b\x9a\x84\x9e\x03\x16gorm:\"serializer:json\"H\x0fR\vremediation\x88\x01\x01B\x14\n" +
 	"\x12_parent_control_idB\n" +
 	"\n" +
 	"\b_commentB\x0e\n" +
 	"\f_valid_untilB\a\n" +
-	"\x05_dataJ\x04\b\x05\x10\x06\"\xd9\x02\n" +
+	"\x05_dataB\x16\n" +
+	"\x14_non_compliant_sinceB\f\n" +
+	"\n" +
+	"_narrativeB\r\n" +
+	"\v_sub_statusB\x0e\n" +
+	"\f_error_causeB\x12\n" +
+	"\x10_catalog_versionB\x0f\n" +
+	"\r_catalog_hashB\x0e\n" +
+	"\f_environmentB\t\n" +
+	"\a_run_idB\x10\n" +
+	"\x0e_category_nameB\x15\n" +
+	"\x13_control_short_nameB\t\n" +
+	"\a_sampleB\x0e\n" +
+	"\f_remediationJ\x04\b\x05\x10\x06\"\xa9\x02\n" +
+	"\x10EvaluationSample\x12U\n" +
+	"\bstrategy\x18\x01 \x01(\x0e2*.confirmate.evaluation.v1.SamplingStrategyB\r\xe0A\x02\xbaH\a\x82\x01\x04\x10\x01 \x00R\bstrategy\x12,\n" +
+	"\x0fpopulation_size\x18\x02 \x01(\x03B\x03\xe0A\x02R\x0epopulationSize\x12$\n" +
+	"\vsample_size\x18\x03 \x01(\x03B\x03\xe0A\x02R\n" +
+	"sampleSize\x12)\n" +
+	"\x10confidence_level\x18\x04 \x01(\x01R\x0fconfidenceLevel\x12+\n" +
+	"\x0fmargin_of_error\x18\x05 \x01(\x01H\x00R\rmarginOfError\x88\x01\x01B\x12\n" +
+	"\x10_margin_of_error\"\xb3\x02\n" +
+	"\rFailingMetric\x12'\n" +
+	"\tmetric_id\x18\x01 \x01(\tB\n" +
+	"\xe0A\x02\xbaH\x04r\x02\x10\x01R\bmetricId\x12&\n" +
+	"\fresult_count\x18\x02 \x01(\x05B\x03\xe0A\x02R\vresultCount\x12*\n" +
+	"\x0eresource_count\x18\x03 \x01(\x05B\x03\xe0A\x02R\rresourceCount\x12G\n" +
+	"\tresources\x18\x04 \x03(\v2).confirmate.evaluation.v1.FailingResourceR\tresources\x12L\n" +
+	"\vremediation\x18\x05 \x01(\v2%.confirmate.assessment.v1.RemediationH\x00R\vremediation\x88\x01\x01B\x0e\n" +
+	"\f_remediation\"w\n" +
+	"\x0fFailingResource\x12+\n" +
+	"\vresource_id\x18\x01 \x01(\tB\n" +
+	"\xe0A\x02\xbaH\x04r\x02\x10\x01R\n" +
+	"resourceId\x127\n" +
+	"\x15assessment_result_ids\x18\x02 \x03(\tB\x03\xe0A\x02R\x13assessmentResultIds\"\xe1\x02\n" +
+	"\n" +
+	"Attachment\x12\x1b\n" +
+	"\x02id\x18\x01 \x01(\tB\v\xe0A\x02\xbaH\x05r\x03\xb0\x01\x01R\x02id\x12=\n" +
+	"\x14evaluation_result_id\x18\x02 \x01(\tB\v\xe0A\x02\xbaH\x05r\x03\xb0\x01\x01R\x12evaluationResultId\x12!\n" +
+	"\x04name\x18\x03 \x01(\tB\r\xe0A\x02\xbaH\ar\x05\x10\x01\x18\xff\x01R\x04name\x12-\n" +
+	"\fcontent_type\x18\x04 \x01(\tB\n" +
+	"\xe0A\x02\xbaH\x04r\x02\x10\x01R\vcontentType\x12\x17\n" +
+	"\x04size\x18\x05 \x01(\x03B\x03\xe0A\x03R\x04size\x12\x1b\n" +
+	"\x06sha256\x18\x06 \x01(\tB\x03\xe0A\x03R\x06sha256\x12o\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampB4\xe0A\x03\x9a\x84\x9e\x03,gorm:\"serializer:timestamppb;type:timestamp\"R\tcreatedAt\"\xf0\x03\n" +
 	"\rEvaluationJob\x12.\n" +
 	"\x0eaudit_scope_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\fauditScopeId\x12l\n" +
 	"\n" +
 	"started_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampB1\x9a\x84\x9e\x03,gorm:\"serializer:timestamppb;type:timestamp\"R\tstartedAt\x12#\n" +
 	"\binterval\x18\x03 \x01(\x05B\a\xbaH\x04\x1a\x02 \x00R\binterval\x12\x1b\n" +
 	"\trun_count\x18\x04 \x01(\x05R\brunCount\x12h\n" +
-	"\blast_run\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampB1\x9a\x84\x9e\x03,gorm:\"serializer:timestamppb;type:timestamp\"R\alastRun*\xf2\x01\n" +
+	"\blast_run\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampB1\x9a\x84\x9e\x03,gorm:\"serializer:timestamppb;type:timestamp\"R\alastRun\x12\x16\n" +
+	"\x06paused\x18\x06 \x01(\bR\x06paused\x12o\n" +
+	"\tpaused_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampB1\x9a\x84\x9e\x03,gorm:\"serializer:timestamppb;type:timestamp\"H\x00R\bpausedAt\x88\x01\x01B\f\n" +
+	"\n" +
+	"_paused_at\"\x97\x03\n" +
+	"\aComment\x12\x1b\n" +
+	"\x02id\x18\x01 \x01(\tB\v\xe0A\x02\xbaH\x05r\x03\xb0\x01\x01R\x02id\x12=\n" +
+	"\x14evaluation_result_id\x18\x02 \x01(\tB\v\xe0A\x02\xbaH\x05r\x03\xb0\x01\x01R\x12evaluationResultId\x12*\n" +
+	"\tparent_id\x18\x03 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01H\x00R\bparentId\x88\x01\x01\x12\x1b\n" +
+	"\x06author\x18\x04 \x01(\tB\x03\xe0A\x03R\x06author\x12o\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampB4\xe0A\x03\x9a\x84\x9e\x03,gorm:\"serializer:timestamppb;type:timestamp\"R\tcreatedAt\x12!\n" +
+	"\x04text\x18\x06 \x01(\tB\r\xe0A\x02\xbaH\ar\x05\x10\x01\x18\x90NR\x04text\x12E\n" +
+	"\bmentions\x18\a \x03(\tB)\xbaH\v\x92\x01\b\x18\x01\"\x04r\x02\x10\x01\x9a\x84\x9e\x03\x16gorm:\"serializer:json\"R\bmentionsB\f\n" +
+	"\n" +
+	"_parent_id*\xb0\x01\n" +
+	"\x14EvaluationPrecedence\x12%\n" +
+	"!EVALUATION_PRECEDENCE_UNSPECIFIED\x10\x00\x12#\n" +
+	"\x1fEVALUATION_PRECEDENCE_AUTOMATIC\x10\x01\x12 \n" +
+	"\x1cEVALUATION_PRECEDENCE_MANUAL\x10\x02\x12*\n" +
+	"&EVALUATION_PRECEDENCE_PARTIALLY_MANUAL\x10\x03*\x8c\x01\n" +
+	"\n" +
+	"OrphanType\x12\x1b\n" +
+	"\x17ORPHAN_TYPE_UNSPECIFIED\x10\x00\x12!\n" +
+	"\x1dORPHAN_TYPE_EVALUATION_RESULT\x10\x01\x12!\n" +
+	"\x1dORPHAN_TYPE_ASSESSMENT_RESULT\x10\x02\x12\x1b\n" +
+	"\x17ORPHAN_TYPE_AUDIT_SCOPE\x10\x03*\xab\x01\n" +
+	"\x0eCoverageStatus\x12\x1f\n" +
+	"\x1bCOVERAGE_STATUS_UNSPECIFIED\x10\x00\x12\x1e\n" +
+	"\x1aCOVERAGE_STATUS_NO_METRICS\x10\x01\x12\x1e\n" +
+	"\x1aCOVERAGE_STATUS_NO_RESULTS\x10\x02\x12\x1b\n" +
+	"\x17COVERAGE_STATUS_PARTIAL\x10\x03\x12\x1b\n" +
+	"\x17COVERAGE_STATUS_COVERED\x10\x04*\xac\x02\n" +
 	"\x10EvaluationStatus\x12!\n" +
 	"\x1dEVALUATION_STATUS_UNSPECIFIED\x10\x00\x12\x1f\n" +
 	"\x1bEVALUATION_STATUS_COMPLIANT\x10\x01\x12(\n" +
@@ -680,12 +3449,36 @@ const file_api_evaluation_evaluation_proto_rawDesc = "" +
 	"\x1fEVALUATION_STATUS_NOT_COMPLIANT\x10\x03\x12,\n" +
 	"(EVALUATION_STATUS_NOT_COMPLIANT_MANUALLY\x10\x04\x12\x1d\n" +
 	"\x19EVALUATION_STATUS_PENDING\x10\n" +
-	"2\x8d\x04\n" +
+	"\x12\x1b\n" +
+	"\x17EVALUATION_STATUS_ERROR\x10\v\x12\x1b\n" +
+	"\x17EVALUATION_STATUS_STALE\x10\f*`\n" +
+	"\x10EvaluationReason\x12!\n" +
+	"\x1dEVALUATION_REASON_UNSPECIFIED\x10\x00\x12)\n" +
+	"%EVALUATION_REASON_FRESHNESS_VIOLATION\x10\x01*w\n" +
+	"\x13AggregationStrategy\x12$\n" +
+	" AGGREGATION_STRATEGY_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18AGGREGATION_STRATEGY_ALL\x10\x01\x12\x1c\n" +
+	"\x18AGGREGATION_STRATEGY_ANY\x10\x02*\xa0\x01\n" +
+	"\x10SamplingStrategy\x12!\n" +
+	"\x1dSAMPLING_STRATEGY_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18SAMPLING_STRATEGY_RANDOM\x10\x01\x12 \n" +
+	"\x1cSAMPLING_STRATEGY_STRATIFIED\x10\x02\x12)\n" +
+	"%SAMPLING_STRATEGY_NON_COMPLIANT_FIRST\x10\x032\xe3\x11\n" +
 	"\n" +
 	"Evaluation\x12\xae\x01\n" +
 	"\x0fStartEvaluation\x120.confirmate.evaluation.v1.StartEvaluationRequest\x1a1.confirmate.evaluation.v1.StartEvaluationResponse\"6\x82\xd3\xe4\x93\x020\"./v1/evaluation/evaluate/{audit_scope_id}/start\x12\xaa\x01\n" +
-	"\x0eStopEvaluation\x12/.confirmate.evaluation.v1.StopEvaluationRequest\x1a0.confirmate.evaluation.v1.StopEvaluationResponse\"5\x82\xd3\xe4\x93\x02/\"-/v1/evaluation/evaluate/{audit_scope_id}/stop\x12\xa0\x01\n" +
-	"\x12ListEvaluationJobs\x123.confirmate.evaluation.v1.ListEvaluationJobsRequest\x1a4.confirmate.evaluation.v1.ListEvaluationJobsResponse\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/v1/evaluation/evaluateB#Z!confirmate.io/core/api/evaluationb\x06proto3"
+	"\x0eStopEvaluation\x12/.confirmate.evaluation.v1.StopEvaluationRequest\x1a0.confirmate.evaluation.v1.StopEvaluationResponse\"5\x82\xd3\xe4\x93\x02/\"-/v1/evaluation/evaluate/{audit_scope_id}/stop\x12\xae\x01\n" +
+	"\x0fPauseEvaluation\x120.confirmate.evaluation.v1.PauseEvaluationRequest\x1a1.confirmate.evaluation.v1.PauseEvaluationResponse\"6\x82\xd3\xe4\x93\x020\"./v1/evaluation/evaluate/{audit_scope_id}/pause\x12\xb2\x01\n" +
+	"\x10ResumeEvaluation\x121.confirmate.evaluation.v1.ResumeEvaluationRequest\x1a2.confirmate.evaluation.v1.ResumeEvaluationResponse\"7\x82\xd3\xe4\x93\x021\"//v1/evaluation/evaluate/{audit_scope_id}/resume\x12\xa0\x01\n" +
+	"\x12ListEvaluationJobs\x123.confirmate.evaluation.v1.ListEvaluationJobsRequest\x1a4.confirmate.evaluation.v1.ListEvaluationJobsResponse\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/v1/evaluation/evaluate\x12\x91\x01\n" +
+	"\vGetCoverage\x12,.confirmate.evaluation.v1.GetCoverageRequest\x1a\".confirmate.evaluation.v1.Coverage\"0\x82\xd3\xe4\x93\x02*\x12(/v1/evaluation/coverage/{audit_scope_id}\x12\xb4\x01\n" +
+	"\x12SimulateEvaluation\x123.confirmate.evaluation.v1.SimulateEvaluationRequest\x1a4.confirmate.evaluation.v1.SimulateEvaluationResponse\"3\x82\xd3\xe4\x93\x02-:\x01*\"(/v1/evaluation/simulate/{audit_scope_id}\x12\xc2\x01\n" +
+	"\x17GetCalendarSubscription\x128.confirmate.evaluation.v1.GetCalendarSubscriptionRequest\x1a..confirmate.evaluation.v1.CalendarSubscription\"=\x82\xd3\xe4\x93\x027\x125/v1/evaluation/calendar/{audit_scope_id}/subscription\x12\x94\x01\n" +
+	"\x0fGetCalendarFeed\x120.confirmate.evaluation.v1.GetCalendarFeedRequest\x1a\x14.google.api.HttpBody\"9\x82\xd3\xe4\x93\x023\x121/v1/evaluation/calendar/{audit_scope_id}/feed.ics\x12\xa3\x01\n" +
+	"\vEvaluateNow\x12,.confirmate.evaluation.v1.EvaluateNowRequest\x1a-.confirmate.evaluation.v1.EvaluateNowResponse\"7\x82\xd3\xe4\x93\x021:\x01*\",/v1/evaluation/evaluate/{audit_scope_id}/now\x12\xd6\x01\n" +
+	"\x1bGetControlEvaluationContext\x12<.confirmate.evaluation.v1.GetControlEvaluationContextRequest\x1a2.confirmate.evaluation.v1.ControlEvaluationContext\"E\x82\xd3\xe4\x93\x02?\x12=/v1/evaluation/context/{audit_scope_id}/controls/{control_id}\x12\x9f\x01\n" +
+	"\x10CheckConsistency\x121.confirmate.evaluation.v1.CheckConsistencyRequest\x1a+.confirmate.evaluation.v1.ConsistencyReport\"+\x82\xd3\xe4\x93\x02%:\x01*\" /v1/evaluation/consistency/check\x12\xa5\x01\n" +
+	"\x14GetConsistencyReport\x125.confirmate.evaluation.v1.GetConsistencyReportRequest\x1a+.confirmate.evaluation.v1.ConsistencyReport\")\x82\xd3\xe4\x93\x02#\x12!/v1/evaluation/consistency/reportB#Z!confirmate.io/core/api/evaluationb\x06proto3"
 
 var (
 	file_api_evaluation_evaluation_proto_rawDescOnce sync.Once
@@ -699,40 +3492,131 @@ func file_api_evaluation_evaluation_proto_rawDescGZIP() []byte {
 	return file_api_evaluation_evaluation_proto_rawDescData
 }
 
-var file_api_evaluation_evaluation_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_api_evaluation_evaluation_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_api_evaluation_evaluation_proto_enumTypes = make([]protoimpl.EnumInfo, 7)
+var file_api_evaluation_evaluation_proto_msgTypes = make([]protoimpl.MessageInfo, 38)
 var file_api_evaluation_evaluation_proto_goTypes = []any{
-	(EvaluationStatus)(0),                    // 0: confirmate.evaluation.v1.EvaluationStatus
-	(*StartEvaluationRequest)(nil),           // 1: confirmate.evaluation.v1.StartEvaluationRequest
-	(*StartEvaluationResponse)(nil),          // 2: confirmate.evaluation.v1.StartEvaluationResponse
-	(*StopEvaluationRequest)(nil),            // 3: confirmate.evaluation.v1.StopEvaluationRequest
-	(*StopEvaluationResponse)(nil),           // 4: confirmate.evaluation.v1.StopEvaluationResponse
-	(*ListEvaluationJobsRequest)(nil),        // 5: confirmate.evaluation.v1.ListEvaluationJobsRequest
-	(*ListEvaluationJobsResponse)(nil),       // 6: confirmate.evaluation.v1.ListEvaluationJobsResponse
-	(*EvaluationResult)(nil),                 // 7: confirmate.evaluation.v1.EvaluationResult
-	(*EvaluationJob)(nil),                    // 8: confirmate.evaluation.v1.EvaluationJob
-	(*ListEvaluationJobsRequest_Filter)(nil), // 9: confirmate.evaluation.v1.ListEvaluationJobsRequest.Filter
-	(*timestamppb.Timestamp)(nil),            // 10: google.protobuf.Timestamp
+	(EvaluationPrecedence)(0),                  // 0: confirmate.evaluation.v1.EvaluationPrecedence
+	(OrphanType)(0),                            // 1: confirmate.evaluation.v1.OrphanType
+	(CoverageStatus)(0),                        // 2: confirmate.evaluation.v1.CoverageStatus
+	(EvaluationStatus)(0),                      // 3: confirmate.evaluation.v1.EvaluationStatus
+	(EvaluationReason)(0),                      // 4: confirmate.evaluation.v1.EvaluationReason
+	(AggregationStrategy)(0),                   // 5: confirmate.evaluation.v1.AggregationStrategy
+	(SamplingStrategy)(0),                      // 6: confirmate.evaluation.v1.SamplingStrategy
+	(*StartEvaluationRequest)(nil),             // 7: confirmate.evaluation.v1.StartEvaluationRequest
+	(*StartEvaluationResponse)(nil),            // 8: confirmate.evaluation.v1.StartEvaluationResponse
+	(*StopEvaluationRequest)(nil),              // 9: confirmate.evaluation.v1.StopEvaluationRequest
+	(*StopEvaluationResponse)(nil),             // 10: confirmate.evaluation.v1.StopEvaluationResponse
+	(*PauseEvaluationRequest)(nil),             // 11: confirmate.evaluation.v1.PauseEvaluationRequest
+	(*PauseEvaluationResponse)(nil),            // 12: confirmate.evaluation.v1.PauseEvaluationResponse
+	(*ResumeEvaluationRequest)(nil),            // 13: confirmate.evaluation.v1.ResumeEvaluationRequest
+	(*ResumeEvaluationResponse)(nil),           // 14: confirmate.evaluation.v1.ResumeEvaluationResponse
+	(*ListEvaluationJobsRequest)(nil),          // 15: confirmate.evaluation.v1.ListEvaluationJobsRequest
+	(*ListEvaluationJobsResponse)(nil),         // 16: confirmate.evaluation.v1.ListEvaluationJobsResponse
+	(*GetCoverageRequest)(nil),                 // 17: confirmate.evaluation.v1.GetCoverageRequest
+	(*SimulateEvaluationRequest)(nil),          // 18: confirmate.evaluation.v1.SimulateEvaluationRequest
+	(*GetCalendarSubscriptionRequest)(nil),     // 19: confirmate.evaluation.v1.GetCalendarSubscriptionRequest
+	(*CalendarSubscription)(nil),               // 20: confirmate.evaluation.v1.CalendarSubscription
+	(*GetCalendarFeedRequest)(nil),             // 21: confirmate.evaluation.v1.GetCalendarFeedRequest
+	(*GetControlEvaluationContextRequest)(nil), // 22: confirmate.evaluation.v1.GetControlEvaluationContextRequest
+	(*ControlEvaluationContext)(nil),           // 23: confirmate.evaluation.v1.ControlEvaluationContext
+	(*ExcludedSubControl)(nil),                 // 24: confirmate.evaluation.v1.ExcludedSubControl
+	(*CheckConsistencyRequest)(nil),            // 25: confirmate.evaluation.v1.CheckConsistencyRequest
+	(*GetConsistencyReportRequest)(nil),        // 26: confirmate.evaluation.v1.GetConsistencyReportRequest
+	(*Orphan)(nil),                             // 27: confirmate.evaluation.v1.Orphan
+	(*ConsistencyReport)(nil),                  // 28: confirmate.evaluation.v1.ConsistencyReport
+	(*EvaluateNowRequest)(nil),                 // 29: confirmate.evaluation.v1.EvaluateNowRequest
+	(*EvaluateNowResponse)(nil),                // 30: confirmate.evaluation.v1.EvaluateNowResponse
+	(*ProposedMetricConfiguration)(nil),        // 31: confirmate.evaluation.v1.ProposedMetricConfiguration
+	(*SimulateEvaluationResponse)(nil),         // 32: confirmate.evaluation.v1.SimulateEvaluationResponse
+	(*SimulatedControlStatus)(nil),             // 33: confirmate.evaluation.v1.SimulatedControlStatus
+	(*Coverage)(nil),                           // 34: confirmate.evaluation.v1.Coverage
+	(*ControlCoverage)(nil),                    // 35: confirmate.evaluation.v1.ControlCoverage
+	(*EvaluationResult)(nil),                   // 36: confirmate.evaluation.v1.EvaluationResult
+	(*EvaluationSample)(nil),                   // 37: confirmate.evaluation.v1.EvaluationSample
+	(*FailingMetric)(nil),                      // 38: confirmate.evaluation.v1.FailingMetric
+	(*FailingResource)(nil),                    // 39: confirmate.evaluation.v1.FailingResource
+	(*Attachment)(nil),                         // 40: confirmate.evaluation.v1.Attachment
+	(*EvaluationJob)(nil),                      // 41: confirmate.evaluation.v1.EvaluationJob
+	(*Comment)(nil),                            // 42: confirmate.evaluation.v1.Comment
+	nil,                                        // 43: confirmate.evaluation.v1.StartEvaluationRequest.ControlTimeoutsEntry
+	(*ListEvaluationJobsRequest_Filter)(nil),   // 44: confirmate.evaluation.v1.ListEvaluationJobsRequest.Filter
+	(*timestamppb.Timestamp)(nil),              // 45: google.protobuf.Timestamp
+	(*structpb.Value)(nil),                     // 46: google.protobuf.Value
+	(*assessment.Remediation)(nil),             // 47: confirmate.assessment.v1.Remediation
+	(*httpbody.HttpBody)(nil),                  // 48: google.api.HttpBody
 }
 var file_api_evaluation_evaluation_proto_depIdxs = []int32{
-	9,  // 0: confirmate.evaluation.v1.ListEvaluationJobsRequest.filter:type_name -> confirmate.evaluation.v1.ListEvaluationJobsRequest.Filter
-	8,  // 1: confirmate.evaluation.v1.ListEvaluationJobsResponse.evaluation_jobs:type_name -> confirmate.evaluation.v1.EvaluationJob
-	0,  // 2: confirmate.evaluation.v1.EvaluationResult.status:type_name -> confirmate.evaluation.v1.EvaluationStatus
-	10, // 3: confirmate.evaluation.v1.EvaluationResult.timestamp:type_name -> google.protobuf.Timestamp
-	10, // 4: confirmate.evaluation.v1.EvaluationResult.valid_until:type_name -> google.protobuf.Timestamp
-	10, // 5: confirmate.evaluation.v1.EvaluationJob.started_at:type_name -> google.protobuf.Timestamp
-	10, // 6: confirmate.evaluation.v1.EvaluationJob.last_run:type_name -> google.protobuf.Timestamp
-	1,  // 7: confirmate.evaluation.v1.Evaluation.StartEvaluation:input_type -> confirmate.evaluation.v1.StartEvaluationRequest
-	3,  // 8: confirmate.evaluation.v1.Evaluation.StopEvaluation:input_type -> confirmate.evaluation.v1.StopEvaluationRequest
-	5,  // 9: confirmate.evaluation.v1.Evaluation.ListEvaluationJobs:input_type -> confirmate.evaluation.v1.ListEvaluationJobsRequest
-	2,  // 10: confirmate.evaluation.v1.Evaluation.StartEvaluation:output_type -> confirmate.evaluation.v1.StartEvaluationResponse
-	4,  // 11: confirmate.evaluation.v1.Evaluation.StopEvaluation:output_type -> confirmate.evaluation.v1.StopEvaluationResponse
-	6,  // 12: confirmate.evaluation.v1.Evaluation.ListEvaluationJobs:output_type -> confirmate.evaluation.v1.ListEvaluationJobsResponse
-	10, // [10:13] is the sub-list for method output_type
-	7,  // [7:10] is the sub-list for method input_type
-	7,  // [7:7] is the sub-list for extension type_name
-	7,  // [7:7] is the sub-list for extension extendee
-	0,  // [0:7] is the sub-list for field type_name
+	43, // 0: confirmate.evaluation.v1.StartEvaluationRequest.control_timeouts:type_name -> confirmate.evaluation.v1.StartEvaluationRequest.ControlTimeoutsEntry
+	5,  // 1: confirmate.evaluation.v1.StartEvaluationRequest.aggregation_strategy:type_name -> confirmate.evaluation.v1.AggregationStrategy
+	44, // 2: confirmate.evaluation.v1.ListEvaluationJobsRequest.filter:type_name -> confirmate.evaluation.v1.ListEvaluationJobsRequest.Filter
+	41, // 3: confirmate.evaluation.v1.ListEvaluationJobsResponse.evaluation_jobs:type_name -> confirmate.evaluation.v1.EvaluationJob
+	31, // 4: confirmate.evaluation.v1.SimulateEvaluationRequest.configurations:type_name -> confirmate.evaluation.v1.ProposedMetricConfiguration
+	0,  // 5: confirmate.evaluation.v1.ControlEvaluationContext.precedence:type_name -> confirmate.evaluation.v1.EvaluationPrecedence
+	36, // 6: confirmate.evaluation.v1.ControlEvaluationContext.manual_result:type_name -> confirmate.evaluation.v1.EvaluationResult
+	45, // 7: confirmate.evaluation.v1.ControlEvaluationContext.manual_until:type_name -> google.protobuf.Timestamp
+	24, // 8: confirmate.evaluation.v1.ControlEvaluationContext.excluded_sub_controls:type_name -> confirmate.evaluation.v1.ExcludedSubControl
+	3,  // 9: confirmate.evaluation.v1.ExcludedSubControl.status:type_name -> confirmate.evaluation.v1.EvaluationStatus
+	45, // 10: confirmate.evaluation.v1.ExcludedSubControl.valid_until:type_name -> google.protobuf.Timestamp
+	1,  // 11: confirmate.evaluation.v1.Orphan.type:type_name -> confirmate.evaluation.v1.OrphanType
+	45, // 12: confirmate.evaluation.v1.ConsistencyReport.checked_at:type_name -> google.protobuf.Timestamp
+	27, // 13: confirmate.evaluation.v1.ConsistencyReport.orphans:type_name -> confirmate.evaluation.v1.Orphan
+	3,  // 14: confirmate.evaluation.v1.EvaluateNowResponse.status:type_name -> confirmate.evaluation.v1.EvaluationStatus
+	36, // 15: confirmate.evaluation.v1.EvaluateNowResponse.results:type_name -> confirmate.evaluation.v1.EvaluationResult
+	46, // 16: confirmate.evaluation.v1.ProposedMetricConfiguration.target_value:type_name -> google.protobuf.Value
+	33, // 17: confirmate.evaluation.v1.SimulateEvaluationResponse.controls:type_name -> confirmate.evaluation.v1.SimulatedControlStatus
+	3,  // 18: confirmate.evaluation.v1.SimulatedControlStatus.current_status:type_name -> confirmate.evaluation.v1.EvaluationStatus
+	3,  // 19: confirmate.evaluation.v1.SimulatedControlStatus.simulated_status:type_name -> confirmate.evaluation.v1.EvaluationStatus
+	35, // 20: confirmate.evaluation.v1.Coverage.controls:type_name -> confirmate.evaluation.v1.ControlCoverage
+	2,  // 21: confirmate.evaluation.v1.ControlCoverage.status:type_name -> confirmate.evaluation.v1.CoverageStatus
+	3,  // 22: confirmate.evaluation.v1.EvaluationResult.status:type_name -> confirmate.evaluation.v1.EvaluationStatus
+	45, // 23: confirmate.evaluation.v1.EvaluationResult.timestamp:type_name -> google.protobuf.Timestamp
+	45, // 24: confirmate.evaluation.v1.EvaluationResult.valid_until:type_name -> google.protobuf.Timestamp
+	40, // 25: confirmate.evaluation.v1.EvaluationResult.attachments:type_name -> confirmate.evaluation.v1.Attachment
+	42, // 26: confirmate.evaluation.v1.EvaluationResult.comments:type_name -> confirmate.evaluation.v1.Comment
+	45, // 27: confirmate.evaluation.v1.EvaluationResult.non_compliant_since:type_name -> google.protobuf.Timestamp
+	38, // 28: confirmate.evaluation.v1.EvaluationResult.failing_metrics:type_name -> confirmate.evaluation.v1.FailingMetric
+	4,  // 29: confirmate.evaluation.v1.EvaluationResult.reasons:type_name -> confirmate.evaluation.v1.EvaluationReason
+	37, // 30: confirmate.evaluation.v1.EvaluationResult.sample:type_name -> confirmate.evaluation.v1.EvaluationSample
+	47, // 31: confirmate.evaluation.v1.EvaluationResult.remediation:type_name -> confirmate.assessment.v1.Remediation
+	6,  // 32: confirmate.evaluation.v1.EvaluationSample.strategy:type_name -> confirmate.evaluation.v1.SamplingStrategy
+	39, // 33: confirmate.evaluation.v1.FailingMetric.resources:type_name -> confirmate.evaluation.v1.FailingResource
+	47, // 34: confirmate.evaluation.v1.FailingMetric.remediation:type_name -> confirmate.assessment.v1.Remediation
+	45, // 35: confirmate.evaluation.v1.Attachment.created_at:type_name -> google.protobuf.Timestamp
+	45, // 36: confirmate.evaluation.v1.EvaluationJob.started_at:type_name -> google.protobuf.Timestamp
+	45, // 37: confirmate.evaluation.v1.EvaluationJob.last_run:type_name -> google.protobuf.Timestamp
+	45, // 38: confirmate.evaluation.v1.EvaluationJob.paused_at:type_name -> google.protobuf.Timestamp
+	45, // 39: confirmate.evaluation.v1.Comment.created_at:type_name -> google.protobuf.Timestamp
+	7,  // 40: confirmate.evaluation.v1.Evaluation.StartEvaluation:input_type -> confirmate.evaluation.v1.StartEvaluationRequest
+	9,  // 41: confirmate.evaluation.v1.Evaluation.StopEvaluation:input_type -> confirmate.evaluation.v1.StopEvaluationRequest
+	11, // 42: confirmate.evaluation.v1.Evaluation.PauseEvaluation:input_type -> confirmate.evaluation.v1.PauseEvaluationRequest
+	13, // 43: confirmate.evaluation.v1.Evaluation.ResumeEvaluation:input_type -> confirmate.evaluation.v1.ResumeEvaluationRequest
+	15, // 44: confirmate.evaluation.v1.Evaluation.ListEvaluationJobs:input_type -> confirmate.evaluation.v1.ListEvaluationJobsRequest
+	17, // 45: confirmate.evaluation.v1.Evaluation.GetCoverage:input_type -> confirmate.evaluation.v1.GetCoverageRequest
+	18, // 46: confirmate.evaluation.v1.Evaluation.SimulateEvaluation:input_type -> confirmate.evaluation.v1.SimulateEvaluationRequest
+	19, // 47: confirmate.evaluation.v1.Evaluation.GetCalendarSubscription:input_type -> confirmate.evaluation.v1.GetCalendarSubscriptionRequest
+	21, // 48: confirmate.evaluation.v1.Evaluation.GetCalendarFeed:input_type -> confirmate.evaluation.v1.GetCalendarFeedRequest
+	29, // 49: confirmate.evaluation.v1.Evaluation.EvaluateNow:input_type -> confirmate.evaluation.v1.EvaluateNowRequest
+	22, // 50: confirmate.evaluation.v1.Evaluation.GetControlEvaluationContext:input_type -> confirmate.evaluation.v1.GetControlEvaluationContextRequest
+	25, // 51: confirmate.evaluation.v1.Evaluation.CheckConsistency:input_type -> confirmate.evaluation.v1.CheckConsistencyRequest
+	26, // 52: confirmate.evaluation.v1.Evaluation.GetConsistencyReport:input_type -> confirmate.evaluation.v1.GetConsistencyReportRequest
+	8,  // 53: confirmate.evaluation.v1.Evaluation.StartEvaluation:output_type -> confirmate.evaluation.v1.StartEvaluationResponse
+	10, // 54: confirmate.evaluation.v1.Evaluation.StopEvaluation:output_type -> confirmate.evaluation.v1.StopEvaluationResponse
+	12, // 55: confirmate.evaluation.v1.Evaluation.PauseEvaluation:output_type -> confirmate.evaluation.v1.PauseEvaluationResponse
+	14, // 56: confirmate.evaluation.v1.Evaluation.ResumeEvaluation:output_type -> confirmate.evaluation.v1.ResumeEvaluationResponse
+	16, // 57: confirmate.evaluation.v1.Evaluation.ListEvaluationJobs:output_type -> confirmate.evaluation.v1.ListEvaluationJobsResponse
+	34, // 58: confirmate.evaluation.v1.Evaluation.GetCoverage:output_type -> confirmate.evaluation.v1.Coverage
+	32, // 59: confirmate.evaluation.v1.Evaluation.SimulateEvaluation:output_type -> confirmate.evaluation.v1.SimulateEvaluationResponse
+	20, // 60: confirmate.evaluation.v1.Evaluation.GetCalendarSubscription:output_type -> confirmate.evaluation.v1.CalendarSubscription
+	48, // 61: confirmate.evaluation.v1.Evaluation.GetCalendarFeed:output_type -> google.api.HttpBody
+	30, // 62: confirmate.evaluation.v1.Evaluation.EvaluateNow:output_type -> confirmate.evaluation.v1.EvaluateNowResponse
+	23, // 63: confirmate.evaluation.v1.Evaluation.GetControlEvaluationContext:output_type -> confirmate.evaluation.v1.ControlEvaluationContext
+	28, // 64: confirmate.evaluation.v1.Evaluation.CheckConsistency:output_type -> confirmate.evaluation.v1.ConsistencyReport
+	28, // 65: confirmate.evaluation.v1.Evaluation.GetConsistencyReport:output_type -> confirmate.evaluation.v1.ConsistencyReport
+	53, // [53:66] is the sub-list for method output_type
+	40, // [40:53] is the sub-list for method input_type
+	40, // [40:40] is the sub-list for extension type_name
+	40, // [40:40] is the sub-list for extension extendee
+	0,  // [0:40] is the sub-list for field type_name
 }
 
 func init() { file_api_evaluation_evaluation_proto_init() }
@@ -741,16 +3625,30 @@ func file_api_evaluation_evaluation_proto_init() {
 		return
 	}
 	file_api_evaluation_evaluation_proto_msgTypes[0].OneofWrappers = []any{}
-	file_api_evaluation_evaluation_proto_msgTypes[4].OneofWrappers = []any{}
-	file_api_evaluation_evaluation_proto_msgTypes[6].OneofWrappers = []any{}
 	file_api_evaluation_evaluation_proto_msgTypes[8].OneofWrappers = []any{}
+	file_api_evaluation_evaluation_proto_msgTypes[10].OneofWrappers = []any{}
+	file_api_evaluation_evaluation_proto_msgTypes[11].OneofWrappers = []any{}
+	file_api_evaluation_evaluation_proto_msgTypes[12].OneofWrappers = []any{}
+	file_api_evaluation_evaluation_proto_msgTypes[14].OneofWrappers = []any{}
+	file_api_evaluation_evaluation_proto_msgTypes[15].OneofWrappers = []any{}
+	file_api_evaluation_evaluation_proto_msgTypes[16].OneofWrappers = []any{}
+	file_api_evaluation_evaluation_proto_msgTypes[17].OneofWrappers = []any{}
+	file_api_evaluation_evaluation_proto_msgTypes[22].OneofWrappers = []any{}
+	file_api_evaluation_evaluation_proto_msgTypes[26].OneofWrappers = []any{}
+	file_api_evaluation_evaluation_proto_msgTypes[28].OneofWrappers = []any{}
+	file_api_evaluation_evaluation_proto_msgTypes[29].OneofWrappers = []any{}
+	file_api_evaluation_evaluation_proto_msgTypes[30].OneofWrappers = []any{}
+	file_api_evaluation_evaluation_proto_msgTypes[31].OneofWrappers = []any{}
+	file_api_evaluation_evaluation_proto_msgTypes[34].OneofWrappers = []any{}
+	file_api_evaluation_evaluation_proto_msgTypes[35].OneofWrappers = []any{}
+	file_api_evaluation_evaluation_proto_msgTypes[37].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_evaluation_evaluation_proto_rawDesc), len(file_api_evaluation_evaluation_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   9,
+			NumEnums:      7,
+			NumMessages:   38,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
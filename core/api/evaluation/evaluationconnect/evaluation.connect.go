@@ -9,6 +9,7 @@ import (
 	connect "connectrpc.com/connect"
 	context "context"
 	errors "errors"
+	httpbody "google.golang.org/genproto/googleapis/api/httpbody"
 	http "net/http"
 	strings "strings"
 )
@@ -39,9 +40,37 @@ const (
 	// EvaluationStopEvaluationProcedure is the fully-qualified name of the Evaluation's StopEvaluation
 	// RPC.
 	EvaluationStopEvaluationProcedure = "/confirmate.evaluation.v1.Evaluation/StopEvaluation"
+	// EvaluationPauseEvaluationProcedure is the fully-qualified name of the Evaluation's
+	// PauseEvaluation RPC.
+	EvaluationPauseEvaluationProcedure = "/confirmate.evaluation.v1.Evaluation/PauseEvaluation"
+	// EvaluationResumeEvaluationProcedure is the fully-qualified name of the Evaluation's
+	// ResumeEvaluation RPC.
+	EvaluationResumeEvaluationProcedure = "/confirmate.evaluation.v1.Evaluation/ResumeEvaluation"
 	// EvaluationListEvaluationJobsProcedure is the fully-qualified name of the Evaluation's
 	// ListEvaluationJobs RPC.
 	EvaluationListEvaluationJobsProcedure = "/confirmate.evaluation.v1.Evaluation/ListEvaluationJobs"
+	// EvaluationGetCoverageProcedure is the fully-qualified name of the Evaluation's GetCoverage RPC.
+	EvaluationGetCoverageProcedure = "/confirmate.evaluation.v1.Evaluation/GetCoverage"
+	// EvaluationSimulateEvaluationProcedure is the fully-qualified name of the Evaluation's
+	// SimulateEvaluation RPC.
+	EvaluationSimulateEvaluationProcedure = "/confirmate.evaluation.v1.Evaluation/SimulateEvaluation"
+	// EvaluationGetCalendarSubscriptionProcedure is the fully-qualified name of the Evaluation's
+	// GetCalendarSubscription RPC.
+	EvaluationGetCalendarSubscriptionProcedure = "/confirmate.evaluation.v1.Evaluation/GetCalendarSubscription"
+	// EvaluationGetCalendarFeedProcedure is the fully-qualified name of the Evaluation's
+	// GetCalendarFeed RPC.
+	EvaluationGetCalendarFeedProcedure = "/confirmate.evaluation.v1.Evaluation/GetCalendarFeed"
+	// EvaluationEvaluateNowProcedure is the fully-qualified name of the Evaluation's EvaluateNow RPC.
+	EvaluationEvaluateNowProcedure = "/confirmate.evaluation.v1.Evaluation/EvaluateNow"
+	// EvaluationGetControlEvaluationContextProcedure is the fully-qualified name of the Evaluation's
+	// GetControlEvaluationContext RPC.
+	EvaluationGetControlEvaluationContextProcedure = "/confirmate.evaluation.v1.Evaluation/GetControlEvaluationContext"
+	// EvaluationCheckConsistencyProcedure is the fully-qualified name of the Evaluation's
+	// CheckConsistency RPC.
+	EvaluationCheckConsistencyProcedure = "/confirmate.evaluation.v1.Evaluation/CheckConsistency"
+	// EvaluationGetConsistencyReportProcedure is the fully-qualified name of the Evaluation's
+	// GetConsistencyReport RPC.
+	EvaluationGetConsistencyReportProcedure = "/confirmate.evaluation.v1.Evaluation/GetConsistencyReport"
 )
 
 // EvaluationClient is a client for the confirmate.evaluation.v1.Evaluation service.
@@ -51,8 +80,50 @@ type EvaluationClient interface {
 	// StopEvaluation stops the evaluation for the given audit scope.
 	// Part of the public API, also exposed as REST.
 	StopEvaluation(context.Context, *connect.Request[evaluation.StopEvaluationRequest]) (*connect.Response[evaluation.StopEvaluationResponse], error)
+	// PauseEvaluation pauses the evaluation for the given audit scope. In contrast to StopEvaluation, the job retains
+	// its schedule and configuration, so that it can be continued with ResumeEvaluation. Scheduled runs are skipped
+	// while the evaluation is paused. Part of the public API, also exposed as REST.
+	PauseEvaluation(context.Context, *connect.Request[evaluation.PauseEvaluationRequest]) (*connect.Response[evaluation.PauseEvaluationResponse], error)
+	// ResumeEvaluation resumes a paused evaluation for the given audit scope with its previous schedule and
+	// configuration. Part of the public API, also exposed as REST.
+	ResumeEvaluation(context.Context, *connect.Request[evaluation.ResumeEvaluationRequest]) (*connect.Response[evaluation.ResumeEvaluationResponse], error)
 	// ListEvaluationJobs returns a list of all evaluation jobs running. Part of the public API, also exposed as REST.
 	ListEvaluationJobs(context.Context, *connect.Request[evaluation.ListEvaluationJobsRequest]) (*connect.Response[evaluation.ListEvaluationJobsResponse], error)
+	// GetCoverage returns a coverage report of the catalog of the given audit scope. For each relevant control, it shows
+	// which metrics are associated and which of them have produced assessment results for the target of evaluation.
+	// This allows to distinguish controls that are not yet evaluated from controls that are structurally stuck at
+	// PENDING. Part of the public API, also exposed as REST.
+	GetCoverage(context.Context, *connect.Request[evaluation.GetCoverageRequest]) (*connect.Response[evaluation.Coverage], error)
+	// SimulateEvaluation recomputes the status of the controls of the given audit scope with proposed metric
+	// configurations against the existing assessment results, without persisting anything. This allows to see the
+	// impact of, e.g., tightening a target value before changing the configuration. Part of the public API, also
+	// exposed as REST.
+	SimulateEvaluation(context.Context, *connect.Request[evaluation.SimulateEvaluationRequest]) (*connect.Response[evaluation.SimulateEvaluationResponse], error)
+	// GetCalendarSubscription returns the subscription URL of the calendar feed of the given audit scope. The feed
+	// contains the expirations of manual evaluation results, the expirations of the certificates of the target of
+	// evaluation and the maintenance windows of the audit scope. Part of the public API, also exposed as REST.
+	GetCalendarSubscription(context.Context, *connect.Request[evaluation.GetCalendarSubscriptionRequest]) (*connect.Response[evaluation.CalendarSubscription], error)
+	// GetCalendarFeed returns the calendar feed of the given audit scope in the iCalendar format (RFC 5545). Since
+	// calendar clients cannot authenticate, the feed is not protected by the usual authentication but by the token of
+	// its subscription URL. Part of the public API, also exposed as REST.
+	GetCalendarFeed(context.Context, *connect.Request[evaluation.GetCalendarFeedRequest]) (*connect.Response[httpbody.HttpBody], error)
+	// EvaluateNow evaluates the given audit scope once and waits for the evaluation to finish, so that the results can
+	// be returned in the response. The results are stored like the ones of a periodic evaluation. This is intended for
+	// CI/CD pipelines that block a release on the compliance status. Part of the public API, also exposed as REST.
+	EvaluateNow(context.Context, *connect.Request[evaluation.EvaluateNowRequest]) (*connect.Response[evaluation.EvaluateNowResponse], error)
+	// GetControlEvaluationContext explains how the given control of the audit scope is evaluated: whether a manual
+	// evaluation result currently overrides the automatic evaluation, when it expires and which sub-controls are
+	// excluded from the automatic evaluation because of their manual results. This explains, e.g., why a control is
+	// compliant despite failing assessment results. Part of the public API, also exposed as REST.
+	GetControlEvaluationContext(context.Context, *connect.Request[evaluation.GetControlEvaluationContextRequest]) (*connect.Response[evaluation.ControlEvaluationContext], error)
+	// CheckConsistency verifies that the entities referenced across the services still exist, i.e., that evaluation
+	// results point to existing assessment results, assessment results to existing evidences and audit scopes to
+	// existing catalogs. Since every service has its own database, these references can drift, e.g., after a restore of
+	// a single database. The check also runs periodically, if configured. Part of the public API, also exposed as REST.
+	CheckConsistency(context.Context, *connect.Request[evaluation.CheckConsistencyRequest]) (*connect.Response[evaluation.ConsistencyReport], error)
+	// GetConsistencyReport returns the report of the latest consistency check, see CheckConsistency. Part of the public
+	// API, also exposed as REST.
+	GetConsistencyReport(context.Context, *connect.Request[evaluation.GetConsistencyReportRequest]) (*connect.Response[evaluation.ConsistencyReport], error)
 }
 
 // NewEvaluationClient constructs a client for the confirmate.evaluation.v1.Evaluation service. By
@@ -78,20 +149,90 @@ func NewEvaluationClient(httpClient connect.HTTPClient, baseURL string, opts ...
 			connect.WithSchema(evaluationMethods.ByName("StopEvaluation")),
 			connect.WithClientOptions(opts...),
 		),
+		pauseEvaluation: connect.NewClient[evaluation.PauseEvaluationRequest, evaluation.PauseEvaluationResponse](
+			httpClient,
+			baseURL+EvaluationPauseEvaluationProcedure,
+			connect.WithSchema(evaluationMethods.ByName("PauseEvaluation")),
+			connect.WithClientOptions(opts...),
+		),
+		resumeEvaluation: connect.NewClient[evaluation.ResumeEvaluationRequest, evaluation.ResumeEvaluationResponse](
+			httpClient,
+			baseURL+EvaluationResumeEvaluationProcedure,
+			connect.WithSchema(evaluationMethods.ByName("ResumeEvaluation")),
+			connect.WithClientOptions(opts...),
+		),
 		listEvaluationJobs: connect.NewClient[evaluation.ListEvaluationJobsRequest, evaluation.ListEvaluationJobsResponse](
 			httpClient,
 			baseURL+EvaluationListEvaluationJobsProcedure,
 			connect.WithSchema(evaluationMethods.ByName("ListEvaluationJobs")),
 			connect.WithClientOptions(opts...),
 		),
+		getCoverage: connect.NewClient[evaluation.GetCoverageRequest, evaluation.Coverage](
+			httpClient,
+			baseURL+EvaluationGetCoverageProcedure,
+			connect.WithSchema(evaluationMethods.ByName("GetCoverage")),
+			connect.WithClientOptions(opts...),
+		),
+		simulateEvaluation: connect.NewClient[evaluation.SimulateEvaluationRequest, evaluation.SimulateEvaluationResponse](
+			httpClient,
+			baseURL+EvaluationSimulateEvaluationProcedure,
+			connect.WithSchema(evaluationMethods.ByName("SimulateEvaluation")),
+			connect.WithClientOptions(opts...),
+		),
+		getCalendarSubscription: connect.NewClient[evaluation.GetCalendarSubscriptionRequest, evaluation.CalendarSubscription](
+			httpClient,
+			baseURL+EvaluationGetCalendarSubscriptionProcedure,
+			connect.WithSchema(evaluationMethods.ByName("GetCalendarSubscription")),
+			connect.WithClientOptions(opts...),
+		),
+		getCalendarFeed: connect.NewClient[evaluation.GetCalendarFeedRequest, httpbody.HttpBody](
+			httpClient,
+			baseURL+EvaluationGetCalendarFeedProcedure,
+			connect.WithSchema(evaluationMethods.ByName("GetCalendarFeed")),
+			connect.WithClientOptions(opts...),
+		),
+		evaluateNow: connect.NewClient[evaluation.EvaluateNowRequest, evaluation.EvaluateNowResponse](
+			httpClient,
+			baseURL+EvaluationEvaluateNowProcedure,
+			connect.WithSchema(evaluationMethods.ByName("EvaluateNow")),
+			connect.WithClientOptions(opts...),
+		),
+		getControlEvaluationContext: connect.NewClient[evaluation.GetControlEvaluationContextRequest, evaluation.ControlEvaluationContext](
+			httpClient,
+			baseURL+EvaluationGetControlEvaluationContextProcedure,
+			connect.WithSchema(evaluationMethods.ByName("GetControlEvaluationContext")),
+			connect.WithClientOptions(opts...),
+		),
+		checkConsistency: connect.NewClient[evaluation.CheckConsistencyRequest, evaluation.ConsistencyReport](
+			httpClient,
+			baseURL+EvaluationCheckConsistencyProcedure,
+			connect.WithSchema(evaluationMethods.ByName("CheckConsistency")),
+			connect.WithClientOptions(opts...),
+		),
+		getConsistencyReport: connect.NewClient[evaluation.GetConsistencyReportRequest, evaluation.ConsistencyReport](
+			httpClient,
+			baseURL+EvaluationGetConsistencyReportProcedure,
+			connect.WithSchema(evaluationMethods.ByName("GetConsistencyReport")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // evaluationClient implements EvaluationClient.
 type evaluationClient struct {
-	startEvaluation    *connect.Client[evaluation.StartEvaluationRequest, evaluation.StartEvaluationResponse]
-	stopEvaluation     *connect.Client[evaluation.StopEvaluationRequest, evaluation.StopEvaluationResponse]
-	listEvaluationJobs *connect.Client[evaluation.ListEvaluationJobsRequest, evaluation.ListEvaluationJobsResponse]
+	startEvaluation             *connect.Client[evaluation.StartEvaluationRequest, evaluation.StartEvaluationResponse]
+	stopEvaluation              *connect.Client[evaluation.StopEvaluationRequest, evaluation.StopEvaluationResponse]
+	pauseEvaluation             *connect.Client[evaluation.PauseEvaluationRequest, evaluation.PauseEvaluationResponse]
+	resumeEvaluation            *connect.Client[evaluation.ResumeEvaluationRequest, evaluation.ResumeEvaluationResponse]
+	listEvaluationJobs          *connect.Client[evaluation.ListEvaluationJobsRequest, evaluation.ListEvaluationJobsResponse]
+	getCoverage                 *connect.Client[evaluation.GetCoverageRequest, evaluation.Coverage]
+	simulateEvaluation          *connect.Client[evaluation.SimulateEvaluationRequest, evaluation.SimulateEvaluationResponse]
+	getCalendarSubscription     *connect.Client[evaluation.GetCalendarSubscriptionRequest, evaluation.CalendarSubscription]
+	getCalendarFeed             *connect.Client[evaluation.GetCalendarFeedRequest, httpbody.HttpBody]
+	evaluateNow                 *connect.Client[evaluation.EvaluateNowRequest, evaluation.EvaluateNowResponse]
+	getControlEvaluationContext *connect.Client[evaluation.GetControlEvaluationContextRequest, evaluation.ControlEvaluationContext]
+	checkConsistency            *connect.Client[evaluation.CheckConsistencyRequest, evaluation.ConsistencyReport]
+	getConsistencyReport        *connect.Client[evaluation.GetConsistencyReportRequest, evaluation.ConsistencyReport]
 }
 
 // StartEvaluation calls confirmate.evaluation.v1.Evaluation.StartEvaluation.
@@ -104,11 +245,62 @@ func (c *evaluationClient) StopEvaluation(ctx context.Context, req *connect.Requ
 	return c.stopEvaluation.CallUnary(ctx, req)
 }
 
+// PauseEvaluation calls confirmate.evaluation.v1.Evaluation.PauseEvaluation.
+func (c *evaluationClient) PauseEvaluation(ctx context.Context, req *connect.Request[evaluation.PauseEvaluationRequest]) (*connect.Response[evaluation.PauseEvaluationResponse], error) {
+	return c.pauseEvaluation.CallUnary(ctx, req)
+}
+
+// ResumeEvaluation calls confirmate.evaluation.v1.Evaluation.ResumeEvaluation.
+func (c *evaluationClient) ResumeEvaluation(ctx context.Context, req *connect.Request[evaluation.ResumeEvaluationRequest]) (*connect.Response[evaluation.ResumeEvaluationResponse], error) {
+	return c.resumeEvaluation.CallUnary(ctx, req)
+}
+
 // ListEvaluationJobs calls confirmate.evaluation.v1.Evaluation.ListEvaluationJobs.
 func (c *evaluationClient) ListEvaluationJobs(ctx context.Context, req *connect.Request[evaluation.ListEvaluationJobsRequest]) (*connect.Response[evaluation.ListEvaluationJobsResponse], error) {
 	return c.listEvaluationJobs.CallUnary(ctx, req)
 }
 
+// GetCoverage calls confirmate.evaluation.v1.Evaluation.GetCoverage.
+func (c *evaluationClient) GetCoverage(ctx context.Context, req *connect.Request[evaluation.GetCoverageRequest]) (*connect.Response[evaluation.Coverage], error) {
+	return c.getCoverage.CallUnary(ctx, req)
+}
+
+// SimulateEvaluation calls confirmate.evaluation.v1.Evaluation.SimulateEvaluation.
+func (c *evaluationClient) SimulateEvaluation(ctx context.Context, req *connect.Request[evaluation.SimulateEvaluationRequest]) (*connect.Response[evaluation.SimulateEvaluationResponse], error) {
+	return c.simulateEvaluation.CallUnary(ctx, req)
+}
+
+// GetCalendarSubscription calls confirmate.evaluation.v1.Evaluation.GetCalendarSubscription.
+func (c *evaluationClient) GetCalendarSubscription(ctx context.Context, req *connect.Request[evaluation.GetCalendarSubscriptionRequest]) (*connect.Response[evaluation.CalendarSubscription], error) {
+	return c.getCalendarSubscription.CallUnary(ctx, req)
+}
+
+// GetCalendarFeed calls confirmate.evaluation.v1.Evaluation.GetCalendarFeed.
+func (c *evaluationClient) GetCalendarFeed(ctx context.Context, req *connect.Request[evaluation.GetCalendarFeedRequest]) (*connect.Response[httpbody.HttpBody], error) {
+	return c.getCalendarFeed.CallUnary(ctx, req)
+}
+
+// EvaluateNow calls confirmate.evaluation.v1.Evaluation.EvaluateNow.
+func (c *evaluationClient) EvaluateNow(ctx context.Context, req *connect.Request[evaluation.EvaluateNowRequest]) (*connect.Response[evaluation.EvaluateNowResponse], error) {
+	return c.evaluateNow.CallUnary(ctx, req)
+}
+
+// GetControlEvaluationContext calls
+// confirmate.evaluation.v1.Evaluation.GetControlEvaluationContext.
+func (c *evaluationClient) GetControlEvaluationContext(ctx context.Context, req *connect.Request[evaluation.GetControlEvaluationContextRequest]) (*connect.Response[evaluation.ControlEvaluationContext], error) {
+	return c.getControlEvaluationContext.CallUnary(ctx, req)
+}
+
+// CheckConsistency calls confirmate.evaluation.v1.Evaluation.CheckConsistency.
+func (c *evaluationClient) CheckConsistency(ctx context.Context, req *connect.Request[evaluation.CheckConsistencyRequest]) (*connect.Response[evaluation.ConsistencyReport], error) {
+	return c.checkConsistency.CallUnary(ctx, req)
+}
+
+// GetConsistencyReport calls confirmate.evaluation.v1.Evaluation.GetConsistencyReport.
+func (c *evaluationClient) GetConsistencyReport(ctx context.Context, req *connect.Request[evaluation.GetConsistencyReportRequest]) (*connect.Response[evaluation.ConsistencyReport], error) {
+	return c.getConsistencyReport.CallUnary(ctx, req)
+}
+
 // EvaluationHandler is an implementation of the confirmate.evaluation.v1.Evaluation service.
 type EvaluationHandler interface {
 	// StartEvaluation evaluates periodically all assessment results based on a given audit scope id. Part of the public API, also exposed as REST.
@@ -116,8 +308,50 @@ type EvaluationHandler interface {
 	// StopEvaluation stops the evaluation for the given audit scope.
 	// Part of the public API, also exposed as REST.
 	StopEvaluation(context.Context, *connect.Request[evaluation.StopEvaluationRequest]) (*connect.Response[evaluation.StopEvaluationResponse], error)
+	// PauseEvaluation pauses the evaluation for the given audit scope. In contrast to StopEvaluation, the job retains
+	// its schedule and configuration, so that it can be continued with ResumeEvaluation. Scheduled runs are skipped
+	// while the evaluation is paused. Part of the public API, also exposed as REST.
+	PauseEvaluation(context.Context, *connect.Request[evaluation.PauseEvaluationRequest]) (*connect.Response[evaluation.PauseEvaluationResponse], error)
+	// ResumeEvaluation resumes a paused evaluation for the given audit scope with its previous schedule and
+	// configuration. Part of the public API, also exposed as REST.
+	ResumeEvaluation(context.Context, *connect.Request[evaluation.ResumeEvaluationRequest]) (*connect.Response[evaluation.ResumeEvaluationResponse], error)
 	// ListEvaluationJobs returns a list of all evaluation jobs running. Part of the public API, also exposed as REST.
 	ListEvaluationJobs(context.Context, *connect.Request[evaluation.ListEvaluationJobsRequest]) (*connect.Response[evaluation.ListEvaluationJobsResponse], error)
+	// GetCoverage returns a coverage report of the catalog of the given audit scope. For each relevant control, it shows
+	// which metrics are associated and which of them have produced assessment results for the target of evaluation.
+	// This allows to distinguish controls that are not yet evaluated from controls that are structurally stuck at
+	// PENDING. Part of the public API, also exposed as REST.
+	GetCoverage(context.Context, *connect.Request[evaluation.GetCoverageRequest]) (*connect.Response[evaluation.Coverage], error)
+	// SimulateEvaluation recomputes the status of the controls of the given audit scope with proposed metric
+	// configurations against the existing assessment results, without persisting anything. This allows to see the
+	// impact of, e.g., tightening a target value before changing the configuration. Part of the public API, also
+	// exposed as REST.
+	SimulateEvaluation(context.Context, *connect.Request[evaluation.SimulateEvaluationRequest]) (*connect.Response[evaluation.SimulateEvaluationResponse], error)
+	// GetCalendarSubscription returns the subscription URL of the calendar feed of the given audit scope. The feed
+	// contains the expirations of manual evaluation results, the expirations of the certificates of the target of
+	// evaluation and the maintenance windows of the audit scope. Part of the public API, also exposed as REST.
+	GetCalendarSubscription(context.Context, *connect.Request[evaluation.GetCalendarSubscriptionRequest]) (*connect.Response[evaluation.CalendarSubscription], error)
+	// GetCalendarFeed returns the calendar feed of the given audit scope in the iCalendar format (RFC 5545). Since
+	// calendar clients cannot authenticate, the feed is not protected by the usual authentication but by the token of
+	// its subscription URL. Part of the public API, also exposed as REST.
+	GetCalendarFeed(context.Context, *connect.Request[evaluation.GetCalendarFeedRequest]) (*connect.Response[httpbody.HttpBody], error)
+	// EvaluateNow evaluates the given audit scope once and waits for the evaluation to finish, so that the results can
+	// be returned in the response. The results are stored like the ones of a periodic evaluation. This is intended for
+	// CI/CD pipelines that block a release on the compliance status. Part of the public API, also exposed as REST.
+	EvaluateNow(context.Context, *connect.Request[evaluation.EvaluateNowRequest]) (*connect.Response[evaluation.EvaluateNowResponse], error)
+	// GetControlEvaluationContext explains how the given control of the audit scope is evaluated: whether a manual
+	// evaluation result currently overrides the automatic evaluation, when it expires and which sub-controls are
+	// excluded from the automatic evaluation because of their manual results. This explains, e.g., why a control is
+	// compliant despite failing assessment results. Part of the public API, also exposed as REST.
+	GetControlEvaluationContext(context.Context, *connect.Request[evaluation.GetControlEvaluationContextRequest]) (*connect.Response[evaluation.ControlEvaluationContext], error)
+	// CheckConsistency verifies that the entities referenced across the services still exist, i.e., that evaluation
+	// results point to existing assessment results, assessment results to existing evidences and audit scopes to
+	// existing catalogs. Since every service has its own database, these references can drift, e.g., after a restore of
+	// a single database. The check also runs periodically, if configured. Part of the public API, also exposed as REST.
+	CheckConsistency(context.Context, *connect.Request[evaluation.CheckConsistencyRequest]) (*connect.Response[evaluation.ConsistencyReport], error)
+	// GetConsistencyReport returns the report of the latest consistency check, see CheckConsistency. Part of the public
+	// API, also exposed as REST.
+	GetConsistencyReport(context.Context, *connect.Request[evaluation.GetConsistencyReportRequest]) (*connect.Response[evaluation.ConsistencyReport], error)
 }
 
 // NewEvaluationHandler builds an HTTP handler from the service implementation. It returns the path
@@ -139,20 +373,100 @@ func NewEvaluationHandler(svc EvaluationHandler, opts ...connect.HandlerOption)
 		connect.WithSchema(evaluationMethods.ByName("StopEvaluation")),
 		connect.WithHandlerOptions(opts...),
 	)
+	evaluationPauseEvaluationHandler := connect.NewUnaryHandler(
+		EvaluationPauseEvaluationProcedure,
+		svc.PauseEvaluation,
+		connect.WithSchema(evaluationMethods.ByName("PauseEvaluation")),
+		connect.WithHandlerOptions(opts...),
+	)
+	evaluationResumeEvaluationHandler := connect.NewUnaryHandler(
+		EvaluationResumeEvaluationProcedure,
+		svc.ResumeEvaluation,
+		connect.WithSchema(evaluationMethods.ByName("ResumeEvaluation")),
+		connect.WithHandlerOptions(opts...),
+	)
 	evaluationListEvaluationJobsHandler := connect.NewUnaryHandler(
 		EvaluationListEvaluationJobsProcedure,
 		svc.ListEvaluationJobs,
 		connect.WithSchema(evaluationMethods.ByName("ListEvaluationJobs")),
 		connect.WithHandlerOptions(opts...),
 	)
+	evaluationGetCoverageHandler := connect.NewUnaryHandler(
+		EvaluationGetCoverageProcedure,
+		svc.GetCoverage,
+		connect.WithSchema(evaluationMethods.ByName("GetCoverage")),
+		connect.WithHandlerOptions(opts...),
+	)
+	evaluationSimulateEvaluationHandler := connect.NewUnaryHandler(
+		EvaluationSimulateEvaluationProcedure,
+		svc.SimulateEvaluation,
+		connect.WithSchema(evaluationMethods.ByName("SimulateEvaluation")),
+		connect.WithHandlerOptions(opts...),
+	)
+	evaluationGetCalendarSubscriptionHandler := connect.NewUnaryHandler(
+		EvaluationGetCalendarSubscriptionProcedure,
+		svc.GetCalendarSubscription,
+		connect.WithSchema(evaluationMethods.ByName("GetCalendarSubscription")),
+		connect.WithHandlerOptions(opts...),
+	)
+	evaluationGetCalendarFeedHandler := connect.NewUnaryHandler(
+		EvaluationGetCalendarFeedProcedure,
+		svc.GetCalendarFeed,
+		connect.WithSchema(evaluationMethods.ByName("GetCalendarFeed")),
+		connect.WithHandlerOptions(opts...),
+	)
+	evaluationEvaluateNowHandler := connect.NewUnaryHandler(
+		EvaluationEvaluateNowProcedure,
+		svc.EvaluateNow,
+		connect.WithSchema(evaluationMethods.ByName("EvaluateNow")),
+		connect.WithHandlerOptions(opts...),
+	)
+	evaluationGetControlEvaluationContextHandler := connect.NewUnaryHandler(
+		EvaluationGetControlEvaluationContextProcedure,
+		svc.GetControlEvaluationContext,
+		connect.WithSchema(evaluationMethods.ByName("GetControlEvaluationContext")),
+		connect.WithHandlerOptions(opts...),
+	)
+	evaluationCheckConsistencyHandler := connect.NewUnaryHandler(
+		EvaluationCheckConsistencyProcedure,
+		svc.CheckConsistency,
+		connect.WithSchema(evaluationMethods.ByName("CheckConsistency")),
+		connect.WithHandlerOptions(opts...),
+	)
+	evaluationGetConsistencyReportHandler := connect.NewUnaryHandler(
+		EvaluationGetConsistencyReportProcedure,
+		svc.GetConsistencyReport,
+		connect.WithSchema(evaluationMethods.ByName("GetConsistencyReport")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/confirmate.evaluation.v1.Evaluation/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case EvaluationStartEvaluationProcedure:
 			evaluationStartEvaluationHandler.ServeHTTP(w, r)
 		case EvaluationStopEvaluationProcedure:
 			evaluationStopEvaluationHandler.ServeHTTP(w, r)
+		case EvaluationPauseEvaluationProcedure:
+			evaluationPauseEvaluationHandler.ServeHTTP(w, r)
+		case EvaluationResumeEvaluationProcedure:
+			evaluationResumeEvaluationHandler.ServeHTTP(w, r)
 		case EvaluationListEvaluationJobsProcedure:
 			evaluationListEvaluationJobsHandler.ServeHTTP(w, r)
+		case EvaluationGetCoverageProcedure:
+			evaluationGetCoverageHandler.ServeHTTP(w, r)
+		case EvaluationSimulateEvaluationProcedure:
+			evaluationSimulateEvaluationHandler.ServeHTTP(w, r)
+		case EvaluationGetCalendarSubscriptionProcedure:
+			evaluationGetCalendarSubscriptionHandler.ServeHTTP(w, r)
+		case EvaluationGetCalendarFeedProcedure:
+			evaluationGetCalendarFeedHandler.ServeHTTP(w, r)
+		case EvaluationEvaluateNowProcedure:
+			evaluationEvaluateNowHandler.ServeHTTP(w, r)
+		case EvaluationGetControlEvaluationContextProcedure:
+			evaluationGetControlEvaluationContextHandler.ServeHTTP(w, r)
+		case EvaluationCheckConsistencyProcedure:
+			evaluationCheckConsistencyHandler.ServeHTTP(w, r)
+		case EvaluationGetConsistencyReportProcedure:
+			evaluationGetConsistencyReportHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -170,6 +484,46 @@ func (UnimplementedEvaluationHandler) StopEvaluation(context.Context, *connect.R
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("confirmate.evaluation.v1.Evaluation.StopEvaluation is not implemented"))
 }
 
+func (UnimplementedEvaluationHandler) PauseEvaluation(context.Context, *connect.Request[evaluation.PauseEvaluationRequest]) (*connect.Response[evaluation.PauseEvaluationResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("confirmate.evaluation.v1.Evaluation.PauseEvaluation is not implemented"))
+}
+
+func (UnimplementedEvaluationHandler) ResumeEvaluation(context.Context, *connect.Request[evaluation.ResumeEvaluationRequest]) (*connect.Response[evaluation.ResumeEvaluationResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("confirmate.evaluation.v1.Evaluation.ResumeEvaluation is not implemented"))
+}
+
 func (UnimplementedEvaluationHandler) ListEvaluationJobs(context.Context, *connect.Request[evaluation.ListEvaluationJobsRequest]) (*connect.Response[evaluation.ListEvaluationJobsResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("confirmate.evaluation.v1.Evaluation.ListEvaluationJobs is not implemented"))
 }
+
+func (UnimplementedEvaluationHandler) GetCoverage(context.Context, *connect.Request[evaluation.GetCoverageRequest]) (*connect.Response[evaluation.Coverage], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("confirmate.evaluation.v1.Evaluation.GetCoverage is not implemented"))
+}
+
+func (UnimplementedEvaluationHandler) SimulateEvaluation(context.Context, *connect.Request[evaluation.SimulateEvaluationRequest]) (*connect.Response[evaluation.SimulateEvaluationResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("confirmate.evaluation.v1.Evaluation.SimulateEvaluation is not implemented"))
+}
+
+func (UnimplementedEvaluationHandler) GetCalendarSubscription(context.Context, *connect.Request[evaluation.GetCalendarSubscriptionRequest]) (*connect.Response[evaluation.CalendarSubscription], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("confirmate.evaluation.v1.Evaluation.GetCalendarSubscription is not implemented"))
+}
+
+func (UnimplementedEvaluationHandler) GetCalendarFeed(context.Context, *connect.Request[evaluation.GetCalendarFeedRequest]) (*connect.Response[httpbody.HttpBody], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("confirmate.evaluation.v1.Evaluation.GetCalendarFeed is not implemented"))
+}
+
+func (UnimplementedEvaluationHandler) EvaluateNow(context.Context, *connect.Request[evaluation.EvaluateNowRequest]) (*connect.Response[evaluation.EvaluateNowResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("confirmate.evaluation.v1.Evaluation.EvaluateNow is not implemented"))
+}
+
+func (UnimplementedEvaluationHandler) GetControlEvaluationContext(context.Context, *connect.Request[evaluation.GetControlEvaluationContextRequest]) (*connect.Response[evaluation.ControlEvaluationContext], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("confirmate.evaluation.v1.Evaluation.GetControlEvaluationContext is not implemented"))
+}
+
+func (UnimplementedEvaluationHandler) CheckConsistency(context.Context, *connect.Request[evaluation.CheckConsistencyRequest]) (*connect.Response[evaluation.ConsistencyReport], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("confirmate.evaluation.v1.Evaluation.CheckConsistency is not implemented"))
+}
+
+func (UnimplementedEvaluationHandler) GetConsistencyReport(context.Context, *connect.Request[evaluation.GetConsistencyReportRequest]) (*connect.Response[evaluation.ConsistencyReport], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("confirmate.evaluation.v1.Evaluation.GetConsistencyReport is not implemented"))
+}
@@ -0,0 +1,558 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: api/evaluation/v2/evaluation.proto
+
+package evaluationv2
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	evaluation "confirmate.io/core/api/evaluation"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	httpbody "google.golang.org/genproto/googleapis/api/httpbody"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type StartEvaluationRequest struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	AuditScopeId string                 `protobuf:"bytes,1,opt,name=audit_scope_id,json=auditScopeId,proto3" json:"audit_scope_id,omitempty"`
+	// The interval in which the evaluation executes periodically. It must be a
+	// multiple of a minute. Defaults to the shortest interval recommended by the
+	// catalogs of the audit scope or to 5 minutes.
+	Interval *durationpb.Duration `protobuf:"bytes,2,opt,name=interval,proto3" json:"interval,omitempty"`
+	// The timeout of a single evaluation run of the audit scope. It must be a
+	// multiple of a second. If the timeout is exceeded, the remaining controls
+	// are recorded with the status ERROR. Defaults to the interval.
+	Timeout *durationpb.Duration `protobuf:"bytes,3,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	// Optional timeouts for individual (parent) controls, keyed by the control
+	// ID. They must be multiples of a second. A control timeout cannot extend the
+	// timeout of the audit scope.
+	ControlTimeouts map[string]*durationpb.Duration `protobuf:"bytes,4,rep,name=control_timeouts,json=controlTimeouts,proto3" json:"control_timeouts,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Optional. The locale of the texts that are generated by this evaluation,
+	// e.g., comments of evaluation results. Defaults to the locale of the audit
+	// scope.
+	Locale *string `protobuf:"bytes,5,opt,name=locale,proto3,oneof" json:"locale,omitempty"`
+	// Optional. The maximum age of the evidence that the assessment results of
+	// controls without their own maximum evidence age are based on. It must be
+	// a multiple of a day. Defaults to the maximum evidence age recommended by
+	// the catalog.
+	MaxEvidenceAge *durationpb.Duration `protobuf:"bytes,6,opt,name=max_evidence_age,json=maxEvidenceAge,proto3" json:"max_evidence_age,omitempty"`
+	// Optional. How the status of a control is aggregated from the statuses of
+	// its sub-controls. Defaults to the aggregation strategy recommended by the
+	// catalog or to AGGREGATION_STRATEGY_ALL.
+	AggregationStrategy *evaluation.AggregationStrategy `protobuf:"varint,7,opt,name=aggregation_strategy,json=aggregationStrategy,proto3,enum=confirmate.evaluation.v1.AggregationStrategy,oneof" json:"aggregation_strategy,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *StartEvaluationRequest) Reset() {
+	*x = StartEvaluationRequest{}
+	mi := &file_api_evaluation_v2_evaluation_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartEvaluationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartEvaluationRequest) ProtoMessage() {}
+
+func (x *StartEvaluationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_evaluation_v2_evaluation_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartEvaluationRequest.ProtoReflect.Descriptor instead.
+func (*StartEvaluationRequest) Descriptor() ([]byte, []int) {
+	return file_api_evaluation_v2_evaluation_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *StartEvaluationRequest) GetAuditScopeId() string {
+	if x != nil {
+		return x.AuditScopeId
+	}
+	return ""
+}
+
+func (x *StartEvaluationRequest) GetInterval() *durationpb.Duration {
+	if x != nil {
+		return x.Interval
+	}
+	return nil
+}
+
+func (x *StartEvaluationRequest) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+func (x *StartEvaluationRequest) GetControlTimeouts() map[string]*durationpb.Duration {
+	if x != nil {
+		return x.ControlTimeouts
+	}
+	return nil
+}
+
+func (x *StartEvaluationRequest) GetLocale() string {
+	if x != nil && x.Locale != nil {
+		return *x.Locale
+	}
+	return ""
+}
+
+func (x *StartEvaluationRequest) GetMaxEvidenceAge() *durationpb.Duration {
+	if x != nil {
+		return x.MaxEvidenceAge
+	}
+	return nil
+}
+
+func (x *StartEvaluationRequest) GetAggregationStrategy() evaluation.AggregationStrategy {
+	if x != nil && x.AggregationStrategy != nil {
+		return *x.AggregationStrategy
+	}
+	return evaluation.AggregationStrategy(0)
+}
+
+// StartEvaluationResponse is empty, errors are reported as such. It replaces the successful flag of version 1.
+type StartEvaluationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartEvaluationResponse) Reset() {
+	*x = StartEvaluationResponse{}
+	mi := &file_api_evaluation_v2_evaluation_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartEvaluationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartEvaluationResponse) ProtoMessage() {}
+
+func (x *StartEvaluationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_evaluation_v2_evaluation_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartEvaluationResponse.ProtoReflect.Descriptor instead.
+func (*StartEvaluationResponse) Descriptor() ([]byte, []int) {
+	return file_api_evaluation_v2_evaluation_proto_rawDescGZIP(), []int{1}
+}
+
+type EvaluateNowRequest struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	AuditScopeId string                 `protobuf:"bytes,1,opt,name=audit_scope_id,json=auditScopeId,proto3" json:"audit_scope_id,omitempty"`
+	// The timeout of the evaluation. It must be a multiple of a second. If the
+	// timeout is exceeded, the remaining controls are recorded with the status
+	// ERROR. Defaults to 5 minutes.
+	Timeout *durationpb.Duration `protobuf:"bytes,2,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	// Optional. The locale of the texts that are generated by this evaluation,
+	// e.g., comments of evaluation results. Defaults to the locale of the audit
+	// scope.
+	Locale        *string `protobuf:"bytes,3,opt,name=locale,proto3,oneof" json:"locale,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EvaluateNowRequest) Reset() {
+	*x = EvaluateNowRequest{}
+	mi := &file_api_evaluation_v2_evaluation_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EvaluateNowRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EvaluateNowRequest) ProtoMessage() {}
+
+func (x *EvaluateNowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_evaluation_v2_evaluation_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EvaluateNowRequest.ProtoReflect.Descriptor instead.
+func (*EvaluateNowRequest) Descriptor() ([]byte, []int) {
+	return file_api_evaluation_v2_evaluation_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *EvaluateNowRequest) GetAuditScopeId() string {
+	if x != nil {
+		return x.AuditScopeId
+	}
+	return ""
+}
+
+func (x *EvaluateNowRequest) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+func (x *EvaluateNowRequest) GetLocale() string {
+	if x != nil && x.Locale != nil {
+		return *x.Locale
+	}
+	return ""
+}
+
+type ListEvaluationJobsResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	EvaluationJobs []*EvaluationJob       `protobuf:"bytes,1,rep,name=evaluation_jobs,json=evaluationJobs,proto3" json:"evaluation_jobs,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListEvaluationJobsResponse) Reset() {
+	*x = ListEvaluationJobsResponse{}
+	mi := &file_api_evaluation_v2_evaluation_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEvaluationJobsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEvaluationJobsResponse) ProtoMessage() {}
+
+func (x *ListEvaluationJobsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_evaluation_v2_evaluation_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEvaluationJobsResponse.ProtoReflect.Descriptor instead.
+func (*ListEvaluationJobsResponse) Descriptor() ([]byte, []int) {
+	return file_api_evaluation_v2_evaluation_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListEvaluationJobsResponse) GetEvaluationJobs() []*EvaluationJob {
+	if x != nil {
+		return x.EvaluationJobs
+	}
+	return nil
+}
+
+type EvaluationJob struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	AuditScopeId string                 `protobuf:"bytes,1,opt,name=audit_scope_id,json=auditScopeId,proto3" json:"audit_scope_id,omitempty"`
+	StartedAt    *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	// The interval in which the evaluation executes periodically.
+	Interval *durationpb.Duration `protobuf:"bytes,3,opt,name=interval,proto3" json:"interval,omitempty"`
+	// the number of times the job has finished running
+	RunCount int32                  `protobuf:"varint,4,opt,name=run_count,json=runCount,proto3" json:"run_count,omitempty"`
+	LastRun  *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=last_run,json=lastRun,proto3" json:"last_run,omitempty"`
+	// Whether the job is paused. Scheduled runs of a paused job are skipped.
+	Paused bool `protobuf:"varint,6,opt,name=paused,proto3" json:"paused,omitempty"`
+	// The time the job was paused. It is only set, if the job is paused.
+	PausedAt      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=paused_at,json=pausedAt,proto3,oneof" json:"paused_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EvaluationJob) Reset() {
+	*x = EvaluationJob{}
+	mi := &file_api_evaluation_v2_evaluation_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EvaluationJob) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EvaluationJob) ProtoMessage() {}
+
+func (x *EvaluationJob) ProtoReflect() protoreflect.Message {
+	mi := &file_api_evaluation_v2_evaluation_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EvaluationJob.ProtoReflect.Descriptor instead.
+func (*EvaluationJob) Descriptor() ([]byte, []int) {
+	return file_api_evaluation_v2_evaluation_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *EvaluationJob) GetAuditScopeId() string {
+	if x != nil {
+		return x.AuditScopeId
+	}
+	return ""
+}
+
+func (x *EvaluationJob) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *EvaluationJob) GetInterval() *durationpb.Duration {
+	if x != nil {
+		return x.Interval
+	}
+	return nil
+}
+
+func (x *EvaluationJob) GetRunCount() int32 {
+	if x != nil {
+		return x.RunCount
+	}
+	return 0
+}
+
+func (x *EvaluationJob) GetLastRun() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastRun
+	}
+	return nil
+}
+
+func (x *EvaluationJob) GetPaused() bool {
+	if x != nil {
+		return x.Paused
+	}
+	return false
+}
+
+func (x *EvaluationJob) GetPausedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PausedAt
+	}
+	return nil
+}
+
+var File_api_evaluation_v2_evaluation_proto protoreflect.FileDescriptor
+
+const file_api_evaluation_v2_evaluation_proto_rawDesc = "" +
+	"\n" +
+	"\"api/evaluation/v2/evaluation.proto\x12\x18confirmate.evaluation.v2\x1a\x1fapi/evaluation/evaluation.proto\x1a\x1bbuf/validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x19google/api/httpbody.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xc8\x05\n" +
+	"\x16StartEvaluationRequest\x121\n" +
+	"\x0eaudit_scope_id\x18\x01 \x01(\tB\v\xe0A\x02\xbaH\x05r\x03\xb0\x01\x01R\fauditScopeId\x12A\n" +
+	"\binterval\x18\x02 \x01(\v2\x19.google.protobuf.DurationB\n" +
+	"\xbaH\a\xaa\x01\x042\x02\b<R\binterval\x12?\n" +
+	"\atimeout\x18\x03 \x01(\v2\x19.google.protobuf.DurationB\n" +
+	"\xbaH\a\xaa\x01\x042\x02\b\x01R\atimeout\x12\x81\x01\n" +
+	"\x10control_timeouts\x18\x04 \x03(\v2E.confirmate.evaluation.v2.StartEvaluationRequest.ControlTimeoutsEntryB\x0f\xbaH\f\x9a\x01\t*\a\xaa\x01\x042\x02\b\x01R\x0fcontrolTimeouts\x12*\n" +
+	"\x06locale\x18\x05 \x01(\tB\r\xbaH\n" +
+	"r\bR\x02enR\x02deH\x00R\x06locale\x88\x01\x01\x12Q\n" +
+	"\x10max_evidence_age\x18\x06 \x01(\v2\x19.google.protobuf.DurationB\f\xbaH\t\xaa\x01\x062\x04\b\x80\xa3\x05R\x0emaxEvidenceAge\x12q\n" +
+	"\x14aggregation_strategy\x18\a \x01(\x0e2-.confirmate.evaluation.v1.AggregationStrategyB\n" +
+	"\xbaH\a\x82\x01\x04\x10\x01 \x00H\x01R\x13aggregationStrategy\x88\x01\x01\x1a]\n" +
+	"\x14ControlTimeoutsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12/\n" +
+	"\x05value\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\x05value:\x028\x01B\t\n" +
+	"\a_localeB\x17\n" +
+	"\x15_aggregation_strategy\"\x19\n" +
+	"\x17StartEvaluationResponse\"\xc4\x01\n" +
+	"\x12EvaluateNowRequest\x121\n" +
+	"\x0eaudit_scope_id\x18\x01 \x01(\tB\v\xe0A\x02\xbaH\x05r\x03\xb0\x01\x01R\fauditScopeId\x12D\n" +
+	"\atimeout\x18\x02 \x01(\v2\x19.google.protobuf.DurationB\x0f\xbaH\f\xaa\x01\t\"\x03\b\x90\x1c2\x02\b\x01R\atimeout\x12*\n" +
+	"\x06locale\x18\x03 \x01(\tB\r\xbaH\n" +
+	"r\bR\x02enR\x02deH\x00R\x06locale\x88\x01\x01B\t\n" +
+	"\a_locale\"n\n" +
+	"\x1aListEvaluationJobsResponse\x12P\n" +
+	"\x0fevaluation_jobs\x18\x01 \x03(\v2'.confirmate.evaluation.v2.EvaluationJobR\x0eevaluationJobs\"\xe9\x02\n" +
+	"\rEvaluationJob\x12.\n" +
+	"\x0eaudit_scope_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\fauditScopeId\x129\n" +
+	"\n" +
+	"started_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tstartedAt\x125\n" +
+	"\binterval\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\binterval\x12\x1b\n" +
+	"\trun_count\x18\x04 \x01(\x05R\brunCount\x125\n" +
+	"\blast_run\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\alastRun\x12\x16\n" +
+	"\x06paused\x18\x06 \x01(\bR\x06paused\x12<\n" +
+	"\tpaused_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampH\x00R\bpausedAt\x88\x01\x01B\f\n" +
+	"\n" +
+	"_paused_at2\xce\x11\n" +
+	"\n" +
+	"Evaluation\x12\xad\x01\n" +
+	"\x0fStartEvaluation\x120.confirmate.evaluation.v2.StartEvaluationRequest\x1a1.confirmate.evaluation.v2.StartEvaluationResponse\"5\x82\xd3\xe4\x93\x02/:\x01*\"*/v2/evaluation/jobs/{audit_scope_id}/start\x12\xa6\x01\n" +
+	"\x0eStopEvaluation\x12/.confirmate.evaluation.v1.StopEvaluationRequest\x1a0.confirmate.evaluation.v1.StopEvaluationResponse\"1\x82\xd3\xe4\x93\x02+\")/v2/evaluation/jobs/{audit_scope_id}/stop\x12\xaa\x01\n" +
+	"\x0fPauseEvaluation\x120.confirmate.evaluation.v1.PauseEvaluationRequest\x1a1.confirmate.evaluation.v1.PauseEvaluationResponse\"2\x82\xd3\xe4\x93\x02,\"*/v2/evaluation/jobs/{audit_scope_id}/pause\x12\xae\x01\n" +
+	"\x10ResumeEvaluation\x121.confirmate.evaluation.v1.ResumeEvaluationRequest\x1a2.confirmate.evaluation.v1.ResumeEvaluationResponse\"3\x82\xd3\xe4\x93\x02-\"+/v2/evaluation/jobs/{audit_scope_id}/resume\x12\x9c\x01\n" +
+	"\x12ListEvaluationJobs\x123.confirmate.evaluation.v1.ListEvaluationJobsRequest\x1a4.confirmate.evaluation.v2.ListEvaluationJobsResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/v2/evaluation/jobs\x12\x91\x01\n" +
+	"\vGetCoverage\x12,.confirmate.evaluation.v1.GetCoverageRequest\x1a\".confirmate.evaluation.v1.Coverage\"0\x82\xd3\xe4\x93\x02*\x12(/v2/evaluation/coverage/{audit_scope_id}\x12\xb4\x01\n" +
+	"\x12SimulateEvaluation\x123.confirmate.evaluation.v1.SimulateEvaluationRequest\x1a4.confirmate.evaluation.v1.SimulateEvaluationResponse\"3\x82\xd3\xe4\x93\x02-:\x01*\"(/v2/evaluation/simulate/{audit_scope_id}\x12\xc2\x01\n" +
+	"\x17GetCalendarSubscription\x128.confirmate.evaluation.v1.GetCalendarSubscriptionRequest\x1a..confirmate.evaluation.v1.CalendarSubscription\"=\x82\xd3\xe4\x93\x027\x125/v2/evaluation/calendar/{audit_scope_id}/subscription\x12\x94\x01\n" +
+	"\x0fGetCalendarFeed\x120.confirmate.evaluation.v1.GetCalendarFeedRequest\x1a\x14.google.api.HttpBody\"9\x82\xd3\xe4\x93\x023\x121/v2/evaluation/calendar/{audit_scope_id}/feed.ics\x12\x9f\x01\n" +
+	"\vEvaluateNow\x12,.confirmate.evaluation.v2.EvaluateNowRequest\x1a-.confirmate.evaluation.v1.EvaluateNowResponse\"3\x82\xd3\xe4\x93\x02-:\x01*\"(/v2/evaluation/evaluate/{audit_scope_id}\x12\xd6\x01\n" +
+	"\x1bGetControlEvaluationContext\x12<.confirmate.evaluation.v1.GetControlEvaluationContextRequest\x1a2.confirmate.evaluation.v1.ControlEvaluationContext\"E\x82\xd3\xe4\x93\x02?\x12=/v2/evaluation/context/{audit_scope_id}/controls/{control_id}\x12\x9f\x01\n" +
+	"\x10CheckConsistency\x121.confirmate.evaluation.v1.CheckConsistencyRequest\x1a+.confirmate.evaluation.v1.ConsistencyReport\"+\x82\xd3\xe4\x93\x02%:\x01*\" /v2/evaluation/consistency/check\x12\xa5\x01\n" +
+	"\x14GetConsistencyReport\x125.confirmate.evaluation.v1.GetConsistencyReportRequest\x1a+.confirmate.evaluation.v1.ConsistencyReport\")\x82\xd3\xe4\x93\x02#\x12!/v2/evaluation/consistency/reportB3Z1confirmate.io/core/api/evaluation/v2;evaluationv2b\x06proto3"
+
+var (
+	file_api_evaluation_v2_evaluation_proto_rawDescOnce sync.Once
+	file_api_evaluation_v2_evaluation_proto_rawDescData []byte
+)
+
+func file_api_evaluation_v2_evaluation_proto_rawDescGZIP() []byte {
+	file_api_evaluation_v2_evaluation_proto_rawDescOnce.Do(func() {
+		file_api_evaluation_v2_evaluation_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_api_evaluation_v2_evaluation_proto_rawDesc), len(file_api_evaluation_v2_evaluation_proto_rawDesc)))
+	})
+	return file_api_evaluation_v2_evaluation_proto_rawDescData
+}
+
+var file_api_evaluation_v2_evaluation_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_api_evaluation_v2_evaluation_proto_goTypes = []any{
+	(*StartEvaluationRequest)(nil),                        // 0: confirmate.evaluation.v2.StartEvaluationRequest
+	(*StartEvaluationResponse)(nil),                       // 1: confirmate.evaluation.v2.StartEvaluationResponse
+	(*EvaluateNowRequest)(nil),                            // 2: confirmate.evaluation.v2.EvaluateNowRequest
+	(*ListEvaluationJobsResponse)(nil),                    // 3: confirmate.evaluation.v2.ListEvaluationJobsResponse
+	(*EvaluationJob)(nil),                                 // 4: confirmate.evaluation.v2.EvaluationJob
+	nil,                                                   // 5: confirmate.evaluation.v2.StartEvaluationRequest.ControlTimeoutsEntry
+	(*durationpb.Duration)(nil),                           // 6: google.protobuf.Duration
+	(evaluation.AggregationStrategy)(0),                   // 7: confirmate.evaluation.v1.AggregationStrategy
+	(*timestamppb.Timestamp)(nil),                         // 8: google.protobuf.Timestamp
+	(*evaluation.StopEvaluationRequest)(nil),              // 9: confirmate.evaluation.v1.StopEvaluationRequest
+	(*evaluation.PauseEvaluationRequest)(nil),             // 10: confirmate.evaluation.v1.PauseEvaluationRequest
+	(*evaluation.ResumeEvaluationRequest)(nil),            // 11: confirmate.evaluation.v1.ResumeEvaluationRequest
+	(*evaluation.ListEvaluationJobsRequest)(nil),          // 12: confirmate.evaluation.v1.ListEvaluationJobsRequest
+	(*evaluation.GetCoverageRequest)(nil),                 // 13: confirmate.evaluation.v1.GetCoverageRequest
+	(*evaluation.SimulateEvaluationRequest)(nil),          // 14: confirmate.evaluation.v1.SimulateEvaluationRequest
+	(*evaluation.GetCalendarSubscriptionRequest)(nil),     // 15: confirmate.evaluation.v1.GetCalendarSubscriptionRequest
+	(*evaluation.GetCalendarFeedRequest)(nil),             // 16: confirmate.evaluation.v1.GetCalendarFeedRequest
+	(*evaluation.GetControlEvaluationContextRequest)(nil), // 17: confirmate.evaluation.v1.GetControlEvaluationContextRequest
+	(*evaluation.CheckConsistencyRequest)(nil),            // 18: confirmate.evaluation.v1.CheckConsistencyRequest
+	(*evaluation.GetConsistencyReportRequest)(nil),        // 19: confirmate.evaluation.v1.GetConsistencyReportRequest
+	(*evaluation.StopEvaluationResponse)(nil),             // 20: confirmate.evaluation.v1.StopEvaluationResponse
+	(*evaluation.PauseEvaluationResponse)(nil),            // 21: confirmate.evaluation.v1.PauseEvaluationResponse
+	(*evaluation.ResumeEvaluationResponse)(nil),           // 22: confirmate.evaluation.v1.ResumeEvaluationResponse
+	(*evaluation.Coverage)(nil),                           // 23: confirmate.evaluation.v1.Coverage
+	(*evaluation.SimulateEvaluationResponse)(nil),         // 24: confirmate.evaluation.v1.SimulateEvaluationResponse
+	(*evaluation.CalendarSubscription)(nil),               // 25: confirmate.evaluation.v1.CalendarSubscription
+	(*httpbody.HttpBody)(nil),                             // 26: google.api.HttpBody
+	(*evaluation.EvaluateNowResponse)(nil),                // 27: confirmate.evaluation.v1.EvaluateNowResponse
+	(*evaluation.ControlEvaluationContext)(nil),           // 28: confirmate.evaluation.v1.ControlEvaluationContext
+	(*evaluation.ConsistencyReport)(nil),                  // 29: confirmate.evaluation.v1.ConsistencyReport
+}
+var file_api_evaluation_v2_evaluation_proto_depIdxs = []int32{
+	6,  // 0: confirmate.evaluation.v2.StartEvaluationRequest.interval:type_name -> google.protobuf.Duration
+	6,  // 1: confirmate.evaluation.v2.StartEvaluationRequest.timeout:type_name -> google.protobuf.Duration
+	5,  // 2: confirmate.evaluation.v2.StartEvaluationRequest.control_timeouts:type_name -> confirmate.evaluation.v2.StartEvaluationRequest.ControlTimeoutsEntry
+	6,  // 3: confirmate.evaluation.v2.StartEvaluationRequest.max_evidence_age:type_name -> google.protobuf.Duration
+	7,  // 4: confirmate.evaluation.v2.StartEvaluationRequest.aggregation_strategy:type_name -> confirmate.evaluation.v1.AggregationStrategy
+	6,  // 5: confirmate.evaluation.v2.EvaluateNowRequest.timeout:type_name -> google.protobuf.Duration
+	4,  // 6: confirmate.evaluation.v2.ListEvaluationJobsResponse.evaluation_jobs:type_name -> confirmate.evaluation.v2.EvaluationJob
+	8,  // 7: confirmate.evaluation.v2.EvaluationJob.started_at:type_name -> google.protobuf.Timestamp
+	6,  // 8: confirmate.evaluation.v2.EvaluationJob.interval:type_name -> google.protobuf.Duration
+	8,  // 9: confirmate.evaluation.v2.EvaluationJob.last_run:type_name -> google.protobuf.Timestamp
+	8,  // 10: confirmate.evaluation.v2.EvaluationJob.paused_at:type_name -> google.protobuf.Timestamp
+	6,  // 11: confirmate.evaluation.v2.StartEvaluationRequest.ControlTimeoutsEntry.value:type_name -> google.protobuf.Duration
+	0,  // 12: confirmate.evaluation.v2.Evaluation.StartEvaluation:input_type -> confirmate.evaluation.v2.StartEvaluationRequest
+	9,  // 13: confirmate.evaluation.v2.Evaluation.StopEvaluation:input_type -> confirmate.evaluation.v1.StopEvaluationRequest
+	10, // 14: confirmate.evaluation.v2.Evaluation.PauseEvaluation:input_type -> confirmate.evaluation.v1.PauseEvaluationRequest
+	11, // 15: confirmate.evaluation.v2.Evaluation.ResumeEvaluation:input_type -> confirmate.evaluation.v1.ResumeEvaluationRequest
+	12, // 16: confirmate.evaluation.v2.Evaluation.ListEvaluationJobs:input_type -> confirmate.evaluation.v1.ListEvaluationJobsRequest
+	13, // 17: confirmate.evaluation.v2.Evaluation.GetCoverage:input_type -> confirmate.evaluation.v1.GetCoverageRequest
+	14, // 18: confirmate.evaluation.v2.Evaluation.SimulateEvaluation:input_type -> confirmate.evaluation.v1.SimulateEvaluationRequest
+	15, // 19: confirmate.evaluation.v2.Evaluation.GetCalendarSubscription:input_type -> confirmate.evaluation.v1.GetCalendarSubscriptionRequest
+	16, // 20: confirmate.evaluation.v2.Evaluation.GetCalendarFeed:input_type -> confirmate.evaluation.v1.GetCalendarFeedRequest
+	2,  // 21: confirmate.evaluation.v2.Evaluation.EvaluateNow:input_type -> confirmate.evaluation.v2.EvaluateNowRequest
+	17, // 22: confirmate.evaluation.v2.Evaluation.GetControlEvaluationContext:input_type -> confirmate.evaluation.v1.GetControlEvaluationContextRequest
+	18, // 23: confirmate.evaluation.v2.Evaluation.CheckConsistency:input_type -> confirmate.evaluation.v1.CheckConsistencyRequest
+	19, // 24: confirmate.evaluation.v2.Evaluation.GetConsistencyReport:input_type -> confirmate.evaluation.v1.GetConsistencyReportRequest
+	1,  // 25: confirmate.evaluation.v2.Evaluation.StartEvaluation:output_type -> confirmate.evaluation.v2.StartEvaluationResponse
+	20, // 26: confirmate.evaluation.v2.Evaluation.StopEvaluation:output_type -> confirmate.evaluation.v1.StopEvaluationResponse
+	21, // 27: confirmate.evaluation.v2.Evaluation.PauseEvaluation:output_type -> confirmate.evaluation.v1.PauseEvaluationResponse
+	22, // 28: confirmate.evaluation.v2.Evaluation.ResumeEvaluation:output_type -> confirmate.evaluation.v1.ResumeEvaluationResponse
+	3,  // 29: confirmate.evaluation.v2.Evaluation.ListEvaluationJobs:output_type -> confirmate.evaluation.v2.ListEvaluationJobsResponse
+	23, // 30: confirmate.evaluation.v2.Evaluation.GetCoverage:output_type -> confirmate.evaluation.v1.Coverage
+	24, // 31: confirmate.evaluation.v2.Evaluation.SimulateEvaluation:output_type -> confirmate.evaluation.v1.SimulateEvaluationResponse
+	25, // 32: confirmate.evaluation.v2.Evaluation.GetCalendarSubscription:output_type -> confirmate.evaluation.v1.CalendarSubscription
+	26, // 33: confirmate.evaluation.v2.Evaluation.GetCalendarFeed:output_type -> google.api.HttpBody
+	27, // 34: confirmate.evaluation.v2.Evaluation.EvaluateNow:output_type -> confirmate.evaluation.v1.EvaluateNowResponse
+	28, // 35: confirmate.evaluation.v2.Evaluation.GetControlEvaluationContext:output_type -> confirmate.evaluation.v1.ControlEvaluationContext
+	29, // 36: confirmate.evaluation.v2.Evaluation.CheckConsistency:output_type -> confirmate.evaluation.v1.ConsistencyReport
+	29, // 37: confirmate.evaluation.v2.Evaluation.GetConsistencyReport:output_type -> confirmate.evaluation.v1.ConsistencyReport
+	25, // [25:38] is the sub-list for method output_type
+	12, // [12:25] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
+}
+
+func init() { file_api_evaluation_v2_evaluation_proto_init() }
+func file_api_evaluation_v2_evaluation_proto_init() {
+	if File_api_evaluation_v2_evaluation_proto != nil {
+		return
+	}
+	file_api_evaluation_v2_evaluation_proto_msgTypes[0].OneofWrappers = []any{}
+	file_api_evaluation_v2_evaluation_proto_msgTypes[2].OneofWrappers = []any{}
+	file_api_evaluation_v2_evaluation_proto_msgTypes[4].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_evaluation_v2_evaluation_proto_rawDesc), len(file_api_evaluation_v2_evaluation_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_api_evaluation_v2_evaluation_proto_goTypes,
+		DependencyIndexes: file_api_evaluation_v2_evaluation_proto_depIdxs,
+		MessageInfos:      file_api_evaluation_v2_evaluation_proto_msgTypes,
+	}.Build()
+	File_api_evaluation_v2_evaluation_proto = out.File
+	file_api_evaluation_v2_evaluation_proto_goTypes = nil
+	file_api_evaluation_v2_evaluation_proto_depIdxs = nil
+}
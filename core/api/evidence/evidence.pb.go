@@ -51,8 +51,34 @@ type Evidence struct {
 	TargetOfEvaluationId string `protobuf:"bytes,3,opt,name=target_of_evaluation_id,json=targetOfEvaluationId,proto3" json:"target_of_evaluation_id,omitempty"`
 	// Reference to the tool which provided the evidence
 	ToolId string `protobuf:"bytes,4,opt,name=tool_id,json=toolId,proto3" json:"tool_id,omitempty"`
-	// Semantic representation of the Cloud resource according to our defined ontology
+	// Semantic representation of the Cloud resource according to our defined ontology. The evidence store does not
+	// persist the resource with the evidence itself, but as a content-addressed [ResourceBlob] referenced by
+	// resource_hash.
 	Resource *ontology.Resource `protobuf:"bytes,6,opt,name=resource,proto3" json:"resource,omitempty" gorm:"serializer:json"`
+	// ResourceHash is the hex-encoded SHA-256 hash of the resource, which references the [ResourceBlob] that contains
+	// its payload. It is set when the evidence is stored.
+	ResourceHash string `protobuf:"bytes,8,opt,name=resource_hash,json=resourceHash,proto3" json:"resource_hash,omitempty" gorm:"index"`
+	// ResourceType contains a comma separated string of the resource types of
+	// the resource according to our ontology. It is extracted from the resource
+	// when the evidence is stored, so that evidences can be filtered by it.
+	ResourceType string `protobuf:"bytes,7,opt,name=resource_type,json=resourceType,proto3" json:"resource_type,omitempty" gorm:"index"`
+	// OwnerHint optionally contains the owner (e.g., a person or an e-mail address) of the resource, as known to the
+	// collector, e.g., from a tag of the resource. If it is not set, the assessment service tries to resolve it.
+	OwnerHint *string `protobuf:"bytes,9,opt,name=owner_hint,json=ownerHint,proto3,oneof" json:"owner_hint,omitempty"`
+	// TeamHint optionally contains the team owning the resource, as known to the collector, e.g., from a tag, the
+	// Kubernetes namespace or the subscription of the resource. If it is not set, the assessment service tries to
+	// resolve it.
+	TeamHint *string `protobuf:"bytes,10,opt,name=team_hint,json=teamHint,proto3,oneof" json:"team_hint,omitempty"`
+	// Environment optionally contains the environment of the resource (e.g., prod or staging), as known to the
+	// collector, e.g., from its own configuration. If it is not set, the assessment service tries to resolve it from the
+	// labels of the resource.
+	Environment *string `protobuf:"bytes,11,opt,name=environment,proto3,oneof" json:"environment,omitempty"`
+	// CollectorId references the collector that stored the evidence, if it authenticated with a collector token. It is
+	// set when the evidence is stored.
+	CollectorId *string `protobuf:"bytes,12,opt,name=collector_id,json=collectorId,proto3,oneof" json:"collector_id,omitempty" gorm:"index"`
+	// CreatedAt is the time the evidence was stored by the evidence store. In contrast to timestamp, it is set by the
+	// server and therefore starts the retention period of the append-only mode of the evidence store.
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty" gorm:"serializer:timestamppb;type:timestamp"`
 	// Very experimental property. Use at own risk. This property will be deleted again.
 	//
 	// Related resource IDs. The assessment will wait until all evidences for related resource have arrived in the
@@ -128,6 +154,55 @@ func (x *Evidence) GetResource() *ontology.Resource {
 	return nil
 }
 
+func (x *Evidence) GetResourceHash() string {
+	if x != nil {
+		return x.ResourceHash
+	}
+	return ""
+}
+
+func (x *Evidence) GetResourceType() string {
+	if x != nil {
+		return x.ResourceType
+	}
+	return ""
+}
+
+func (x *Evidence) GetOwnerHint() string {
+	if x != nil && x.OwnerHint != nil {
+		return *x.OwnerHint
+	}
+	return ""
+}
+
+func (x *Evidence) GetTeamHint() string {
+	if x != nil && x.TeamHint != nil {
+		return *x.TeamHint
+	}
+	return ""
+}
+
+func (x *Evidence) GetEnvironment() string {
+	if x != nil && x.Environment != nil {
+		return *x.Environment
+	}
+	return ""
+}
+
+func (x *Evidence) GetCollectorId() string {
+	if x != nil && x.CollectorId != nil {
+		return *x.CollectorId
+	}
+	return ""
+}
+
+func (x *Evidence) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
 func (x *Evidence) GetExperimentalRelatedResourceIds() []string {
 	if x != nil {
 		return x.ExperimentalRelatedResourceIds
@@ -225,6 +300,243 @@ func (x *ResourceSnapshot) GetResource() *ontology.Resource {
 	return nil
 }
 
+// ResourceBlob is the content-addressed payload of a resource. Byte-identical
+// resources, e.g., of evidences of subsequent discovery runs, are stored only
+// once and referenced by their hash.
+type ResourceBlob struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Hash is the hex-encoded SHA-256 hash of the data.
+	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty" gorm:"primaryKey"`
+	// Data contains the deterministic protobuf encoding of the
+	// confirmate.ontology.v1.Resource.
+	Data          []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResourceBlob) Reset() {
+	*x = ResourceBlob{}
+	mi := &file_api_evidence_evidence_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResourceBlob) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResourceBlob) ProtoMessage() {}
+
+func (x *ResourceBlob) ProtoReflect() protoreflect.Message {
+	mi := &file_api_evidence_evidence_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResourceBlob.ProtoReflect.Descriptor instead.
+func (*ResourceBlob) Descriptor() ([]byte, []int) {
+	return file_api_evidence_evidence_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ResourceBlob) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *ResourceBlob) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// Pseudonym maps a token to the personal data it replaces in stored
+// evidences. Pseudonyms can only be re-identified by privileged users.
+type Pseudonym struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Token is the value that replaces the personal data in stored evidences.
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty" gorm:"primaryKey"`
+	// Value contains the original personal data.
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	// CreatedAt is the time the personal data was first pseudonymized.
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty" gorm:"serializer:timestamppb;type:timestamp"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Pseudonym) Reset() {
+	*x = Pseudonym{}
+	mi := &file_api_evidence_evidence_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Pseudonym) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pseudonym) ProtoMessage() {}
+
+func (x *Pseudonym) ProtoReflect() protoreflect.Message {
+	mi := &file_api_evidence_evidence_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pseudonym.ProtoReflect.Descriptor instead.
+func (*Pseudonym) Descriptor() ([]byte, []int) {
+	return file_api_evidence_evidence_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Pseudonym) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *Pseudonym) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *Pseudonym) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// Collector is an evidence collector that authenticates to the evidence store
+// with its own token, so that its evidences can be told apart and its usage can
+// be limited.
+type Collector struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Collector ID
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty" gorm:"primaryKey"`
+	// The name of the collector
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// An optional description of the collector, e.g., where it is deployed
+	Description *string `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	// Optional. The maximum number of evidences the collector may store per
+	// minute. If not set, the number is not limited.
+	MaxEvidencesPerMinute *int32 `protobuf:"varint,4,opt,name=max_evidences_per_minute,json=maxEvidencesPerMinute,proto3,oneof" json:"max_evidences_per_minute,omitempty"`
+	// Optional. The maximum size of a single evidence of the collector in bytes.
+	// If not set, the size is not limited.
+	MaxEvidenceSize *int64 `protobuf:"varint,5,opt,name=max_evidence_size,json=maxEvidenceSize,proto3,oneof" json:"max_evidence_size,omitempty"`
+	// The hex-encoded SHA-256 hash of the secret of the token of the collector.
+	// It is never returned.
+	TokenHash string `protobuf:"bytes,6,opt,name=token_hash,json=tokenHash,proto3" json:"token_hash,omitempty"`
+	// The user that created the collector
+	CreatedBy string `protobuf:"bytes,7,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	// Creation time of the collector
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty" gorm:"serializer:timestamppb;type:timestamp"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Collector) Reset() {
+	*x = Collector{}
+	mi := &file_api_evidence_evidence_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Collector) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Collector) ProtoMessage() {}
+
+func (x *Collector) ProtoReflect() protoreflect.Message {
+	mi := &file_api_evidence_evidence_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Collector.ProtoReflect.Descriptor instead.
+func (*Collector) Descriptor() ([]byte, []int) {
+	return file_api_evidence_evidence_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Collector) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Collector) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Collector) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *Collector) GetMaxEvidencesPerMinute() int32 {
+	if x != nil && x.MaxEvidencesPerMinute != nil {
+		return *x.MaxEvidencesPerMinute
+	}
+	return 0
+}
+
+func (x *Collector) GetMaxEvidenceSize() int64 {
+	if x != nil && x.MaxEvidenceSize != nil {
+		return *x.MaxEvidenceSize
+	}
+	return 0
+}
+
+func (x *Collector) GetTokenHash() string {
+	if x != nil {
+		return x.TokenHash
+	}
+	return ""
+}
+
+func (x *Collector) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *Collector) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
 type UpdateResourceRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Resource      *ResourceSnapshot      `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
@@ -234,7 +546,7 @@ type UpdateResourceRequest struct {
 
 func (x *UpdateResourceRequest) Reset() {
 	*x = UpdateResourceRequest{}
-	mi := &file_api_evidence_evidence_proto_msgTypes[2]
+	mi := &file_api_evidence_evidence_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -246,7 +558,7 @@ func (x *UpdateResourceRequest) String() string {
 func (*UpdateResourceRequest) ProtoMessage() {}
 
 func (x *UpdateResourceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_evidence_evidence_proto_msgTypes[2]
+	mi := &file_api_evidence_evidence_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -259,7 +571,7 @@ func (x *UpdateResourceRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateResourceRequest.ProtoReflect.Descriptor instead.
 func (*UpdateResourceRequest) Descriptor() ([]byte, []int) {
-	return file_api_evidence_evidence_proto_rawDescGZIP(), []int{2}
+	return file_api_evidence_evidence_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *UpdateResourceRequest) GetResource() *ResourceSnapshot {
@@ -281,7 +593,7 @@ type ListGraphEdgesRequest struct {
 
 func (x *ListGraphEdgesRequest) Reset() {
 	*x = ListGraphEdgesRequest{}
-	mi := &file_api_evidence_evidence_proto_msgTypes[3]
+	mi := &file_api_evidence_evidence_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -293,7 +605,7 @@ func (x *ListGraphEdgesRequest) String() string {
 func (*ListGraphEdgesRequest) ProtoMessage() {}
 
 func (x *ListGraphEdgesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_evidence_evidence_proto_msgTypes[3]
+	mi := &file_api_evidence_evidence_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -306,7 +618,7 @@ func (x *ListGraphEdgesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListGraphEdgesRequest.ProtoReflect.Descriptor instead.
 func (*ListGraphEdgesRequest) Descriptor() ([]byte, []int) {
-	return file_api_evidence_evidence_proto_rawDescGZIP(), []int{3}
+	return file_api_evidence_evidence_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *ListGraphEdgesRequest) GetPageSize() int32 {
@@ -347,7 +659,7 @@ type ListGraphEdgesResponse struct {
 
 func (x *ListGraphEdgesResponse) Reset() {
 	*x = ListGraphEdgesResponse{}
-	mi := &file_api_evidence_evidence_proto_msgTypes[4]
+	mi := &file_api_evidence_evidence_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -359,7 +671,7 @@ func (x *ListGraphEdgesResponse) String() string {
 func (*ListGraphEdgesResponse) ProtoMessage() {}
 
 func (x *ListGraphEdgesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_evidence_evidence_proto_msgTypes[4]
+	mi := &file_api_evidence_evidence_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -372,7 +684,7 @@ func (x *ListGraphEdgesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListGraphEdgesResponse.ProtoReflect.Descriptor instead.
 func (*ListGraphEdgesResponse) Descriptor() ([]byte, []int) {
-	return file_api_evidence_evidence_proto_rawDescGZIP(), []int{4}
+	return file_api_evidence_evidence_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *ListGraphEdgesResponse) GetEdges() []*GraphEdge {
@@ -401,7 +713,7 @@ type GraphEdge struct {
 
 func (x *GraphEdge) Reset() {
 	*x = GraphEdge{}
-	mi := &file_api_evidence_evidence_proto_msgTypes[5]
+	mi := &file_api_evidence_evidence_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -413,7 +725,7 @@ func (x *GraphEdge) String() string {
 func (*GraphEdge) ProtoMessage() {}
 
 func (x *GraphEdge) ProtoReflect() protoreflect.Message {
-	mi := &file_api_evidence_evidence_proto_msgTypes[5]
+	mi := &file_api_evidence_evidence_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -426,7 +738,7 @@ func (x *GraphEdge) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GraphEdge.ProtoReflect.Descriptor instead.
 func (*GraphEdge) Descriptor() ([]byte, []int) {
-	return file_api_evidence_evidence_proto_rawDescGZIP(), []int{5}
+	return file_api_evidence_evidence_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *GraphEdge) GetId() string {
@@ -461,14 +773,29 @@ var File_api_evidence_evidence_proto protoreflect.FileDescriptor
 
 const file_api_evidence_evidence_proto_rawDesc = "" +
 	"\n" +
-	"\x1bapi/evidence/evidence.proto\x12\x16confirmate.evidence.v1\x1a4policies/security-metrics/ontology/v1/ontology.proto\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x13tagger/tagger.proto\"\xbe\x03\n" +
+	"\x1bapi/evidence/evidence.proto\x12\x16confirmate.evidence.v1\x1a4policies/security-metrics/ontology/v1/ontology.proto\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x13tagger/tagger.proto\"\xa9\a\n" +
 	"\bEvidence\x12\x18\n" +
 	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x12q\n" +
 	"\ttimestamp\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampB7\xbaH\x03\xc8\x01\x01\x9a\x84\x9e\x03,gorm:\"serializer:timestamppb;type:timestamp\"R\ttimestamp\x12?\n" +
 	"\x17target_of_evaluation_id\x18\x03 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x14targetOfEvaluationId\x12 \n" +
 	"\atool_id\x18\x04 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x06toolId\x12Y\n" +
-	"\bresource\x18\x06 \x01(\v2 .confirmate.ontology.v1.ResourceB\x1b\x9a\x84\x9e\x03\x16gorm:\"serializer:json\"R\bresource\x12g\n" +
-	"!experimental_related_resource_ids\x18\xe7\a \x03(\tB\x1b\x9a\x84\x9e\x03\x16gorm:\"serializer:json\"R\x1eexperimentalRelatedResourceIds\"\xa3\x02\n" +
+	"\bresource\x18\x06 \x01(\v2 .confirmate.ontology.v1.ResourceB\x1b\x9a\x84\x9e\x03\x16gorm:\"serializer:json\"R\bresource\x129\n" +
+	"\rresource_hash\x18\b \x01(\tB\x14\xe0A\x03\x9a\x84\x9e\x03\fgorm:\"index\"R\fresourceHash\x129\n" +
+	"\rresource_type\x18\a \x01(\tB\x14\xe0A\x03\x9a\x84\x9e\x03\fgorm:\"index\"R\fresourceType\x12+\n" +
+	"\n" +
+	"owner_hint\x18\t \x01(\tB\a\xbaH\x04r\x02\x10\x01H\x00R\townerHint\x88\x01\x01\x12)\n" +
+	"\tteam_hint\x18\n" +
+	" \x01(\tB\a\xbaH\x04r\x02\x10\x01H\x01R\bteamHint\x88\x01\x01\x12.\n" +
+	"\venvironment\x18\v \x01(\tB\a\xbaH\x04r\x02\x10\x01H\x02R\venvironment\x88\x01\x01\x12<\n" +
+	"\fcollector_id\x18\f \x01(\tB\x14\xe0A\x03\x9a\x84\x9e\x03\fgorm:\"index\"H\x03R\vcollectorId\x88\x01\x01\x12o\n" +
+	"\n" +
+	"created_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampB4\xe0A\x03\x9a\x84\x9e\x03,gorm:\"serializer:timestamppb;type:timestamp\"R\tcreatedAt\x12g\n" +
+	"!experimental_related_resource_ids\x18\xe7\a \x03(\tB\x1b\x9a\x84\x9e\x03\x16gorm:\"serializer:json\"R\x1eexperimentalRelatedResourceIdsB\r\n" +
+	"\v_owner_hintB\f\n" +
+	"\n" +
+	"_team_hintB\x0e\n" +
+	"\f_environmentB\x0f\n" +
+	"\r_collector_id\"\xa3\x02\n" +
 	"\x10ResourceSnapshot\x12\x1a\n" +
 	"\x02id\x18\x01 \x01(\tB\n" +
 	"\xe0A\x02\xbaH\x04r\x02\x10\x01R\x02id\x12B\n" +
@@ -477,7 +804,31 @@ const file_api_evidence_evidence_proto_rawDesc = "" +
 	"\xe0A\x02\xbaH\x04r\x02\x10\x01R\fresourceType\x12#\n" +
 	"\atool_id\x18\x04 \x01(\tB\n" +
 	"\xe0A\x02\xbaH\x04r\x02\x10\x01R\x06toolId\x12Y\n" +
-	"\bresource\x18\x06 \x01(\v2 .confirmate.ontology.v1.ResourceB\x1b\x9a\x84\x9e\x03\x16gorm:\"serializer:json\"R\bresource\"b\n" +
+	"\bresource\x18\x06 \x01(\v2 .confirmate.ontology.v1.ResourceB\x1b\x9a\x84\x9e\x03\x16gorm:\"serializer:json\"R\bresource\"N\n" +
+	"\fResourceBlob\x12*\n" +
+	"\x04hash\x18\x01 \x01(\tB\x16\x9a\x84\x9e\x03\x11gorm:\"primaryKey\"R\x04hash\x12\x12\n" +
+	"\x04data\x18\x02 \x01(\fR\x04data\"\xbd\x01\n" +
+	"\tPseudonym\x12,\n" +
+	"\x05token\x18\x01 \x01(\tB\x16\x9a\x84\x9e\x03\x11gorm:\"primaryKey\"R\x05token\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value\x12l\n" +
+	"\n" +
+	"created_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB1\x9a\x84\x9e\x03,gorm:\"serializer:timestamppb;type:timestamp\"R\tcreatedAt\"\x82\x04\n" +
+	"\tCollector\x121\n" +
+	"\x02id\x18\x01 \x01(\tB!\xe0A\x02\xbaH\x05r\x03\xb0\x01\x01\x9a\x84\x9e\x03\x11gorm:\"primaryKey\"R\x02id\x12\x1e\n" +
+	"\x04name\x18\x02 \x01(\tB\n" +
+	"\xe0A\x02\xbaH\x04r\x02\x10\x01R\x04name\x12%\n" +
+	"\vdescription\x18\x03 \x01(\tH\x00R\vdescription\x88\x01\x01\x12E\n" +
+	"\x18max_evidences_per_minute\x18\x04 \x01(\x05B\a\xbaH\x04\x1a\x02 \x00H\x01R\x15maxEvidencesPerMinute\x88\x01\x01\x128\n" +
+	"\x11max_evidence_size\x18\x05 \x01(\x03B\a\xbaH\x04\"\x02 \x00H\x02R\x0fmaxEvidenceSize\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"token_hash\x18\x06 \x01(\tB\x03\xe0A\x03R\ttokenHash\x12\"\n" +
+	"\n" +
+	"created_by\x18\a \x01(\tB\x03\xe0A\x03R\tcreatedBy\x12o\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampB4\xe0A\x03\x9a\x84\x9e\x03,gorm:\"serializer:timestamppb;type:timestamp\"R\tcreatedAtB\x0e\n" +
+	"\f_descriptionB\x1b\n" +
+	"\x19_max_evidences_per_minuteB\x14\n" +
+	"\x12_max_evidence_size\"b\n" +
 	"\x15UpdateResourceRequest\x12I\n" +
 	"\bresource\x18\x01 \x01(\v2(.confirmate.evidence.v1.ResourceSnapshotB\x03\xe0A\x02R\bresource\"\x80\x01\n" +
 	"\x15ListGraphEdgesRequest\x12\x1b\n" +
@@ -514,32 +865,38 @@ func file_api_evidence_evidence_proto_rawDescGZIP() []byte {
 	return file_api_evidence_evidence_proto_rawDescData
 }
 
-var file_api_evidence_evidence_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_api_evidence_evidence_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
 var file_api_evidence_evidence_proto_goTypes = []any{
 	(*Evidence)(nil),               // 0: confirmate.evidence.v1.Evidence
 	(*ResourceSnapshot)(nil),       // 1: confirmate.evidence.v1.ResourceSnapshot
-	(*UpdateResourceRequest)(nil),  // 2: confirmate.evidence.v1.UpdateResourceRequest
-	(*ListGraphEdgesRequest)(nil),  // 3: confirmate.evidence.v1.ListGraphEdgesRequest
-	(*ListGraphEdgesResponse)(nil), // 4: confirmate.evidence.v1.ListGraphEdgesResponse
-	(*GraphEdge)(nil),              // 5: confirmate.evidence.v1.GraphEdge
-	(*timestamppb.Timestamp)(nil),  // 6: google.protobuf.Timestamp
-	(*ontology.Resource)(nil),      // 7: confirmate.ontology.v1.Resource
+	(*ResourceBlob)(nil),           // 2: confirmate.evidence.v1.ResourceBlob
+	(*Pseudonym)(nil),              // 3: confirmate.evidence.v1.Pseudonym
+	(*Collector)(nil),              // 4: confirmate.evidence.v1.Collector
+	(*UpdateResourceRequest)(nil),  // 5: confirmate.evidence.v1.UpdateResourceRequest
+	(*ListGraphEdgesRequest)(nil),  // 6: confirmate.evidence.v1.ListGraphEdgesRequest
+	(*ListGraphEdgesResponse)(nil), // 7: confirmate.evidence.v1.ListGraphEdgesResponse
+	(*GraphEdge)(nil),              // 8: confirmate.evidence.v1.GraphEdge
+	(*timestamppb.Timestamp)(nil),  // 9: google.protobuf.Timestamp
+	(*ontology.Resource)(nil),      // 10: confirmate.ontology.v1.Resource
 }
 var file_api_evidence_evidence_proto_depIdxs = []int32{
-	6, // 0: confirmate.evidence.v1.Evidence.timestamp:type_name -> google.protobuf.Timestamp
-	7, // 1: confirmate.evidence.v1.Evidence.resource:type_name -> confirmate.ontology.v1.Resource
-	7, // 2: confirmate.evidence.v1.ResourceSnapshot.resource:type_name -> confirmate.ontology.v1.Resource
-	1, // 3: confirmate.evidence.v1.UpdateResourceRequest.resource:type_name -> confirmate.evidence.v1.ResourceSnapshot
-	5, // 4: confirmate.evidence.v1.ListGraphEdgesResponse.edges:type_name -> confirmate.evidence.v1.GraphEdge
-	2, // 5: confirmate.evidence.v1.Resources.UpdateResource:input_type -> confirmate.evidence.v1.UpdateResourceRequest
-	3, // 6: confirmate.evidence.v1.Resources.ListGraphEdges:input_type -> confirmate.evidence.v1.ListGraphEdgesRequest
-	1, // 7: confirmate.evidence.v1.Resources.UpdateResource:output_type -> confirmate.evidence.v1.ResourceSnapshot
-	4, // 8: confirmate.evidence.v1.Resources.ListGraphEdges:output_type -> confirmate.evidence.v1.ListGraphEdgesResponse
-	7, // [7:9] is the sub-list for method output_type
-	5, // [5:7] is the sub-list for method input_type
-	5, // [5:5] is the sub-list for extension type_name
-	5, // [5:5] is the sub-list for extension extendee
-	0, // [0:5] is the sub-list for field type_name
+	9,  // 0: confirmate.evidence.v1.Evidence.timestamp:type_name -> google.protobuf.Timestamp
+	10, // 1: confirmate.evidence.v1.Evidence.resource:type_name -> confirmate.ontology.v1.Resource
+	9,  // 2: confirmate.evidence.v1.Evidence.created_at:type_name -> google.protobuf.Timestamp
+	10, // 3: confirmate.evidence.v1.ResourceSnapshot.resource:type_name -> confirmate.ontology.v1.Resource
+	9,  // 4: confirmate.evidence.v1.Pseudonym.created_at:type_name -> google.protobuf.Timestamp
+	9,  // 5: confirmate.evidence.v1.Collector.created_at:type_name -> google.protobuf.Timestamp
+	1,  // 6: confirmate.evidence.v1.UpdateResourceRequest.resource:type_name -> confirmate.evidence.v1.ResourceSnapshot
+	8,  // 7: confirmate.evidence.v1.ListGraphEdgesResponse.edges:type_name -> confirmate.evidence.v1.GraphEdge
+	5,  // 8: confirmate.evidence.v1.Resources.UpdateResource:input_type -> confirmate.evidence.v1.UpdateResourceRequest
+	6,  // 9: confirmate.evidence.v1.Resources.ListGraphEdges:input_type -> confirmate.evidence.v1.ListGraphEdgesRequest
+	1,  // 10: confirmate.evidence.v1.Resources.UpdateResource:output_type -> confirmate.evidence.v1.ResourceSnapshot
+	7,  // 11: confirmate.evidence.v1.Resources.ListGraphEdges:output_type -> confirmate.evidence.v1.ListGraphEdgesResponse
+	10, // [10:12] is the sub-list for method output_type
+	8,  // [8:10] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
 }
 
 func init() { file_api_evidence_evidence_proto_init() }
@@ -547,13 +904,15 @@ func file_api_evidence_evidence_proto_init() {
 	if File_api_evidence_evidence_proto != nil {
 		return
 	}
+	file_api_evidence_evidence_proto_msgTypes[0].OneofWrappers = []any{}
+	file_api_evidence_evidence_proto_msgTypes[4].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_evidence_evidence_proto_rawDesc), len(file_api_evidence_evidence_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   6,
+			NumMessages:   9,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -25,6 +25,8 @@ import (
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -86,6 +88,52 @@ func (EvidenceStatus) EnumDescriptor() ([]byte, []int) {
 	return file_api_evidence_evidence_store_proto_rawDescGZIP(), []int{0}
 }
 
+type EvidenceEventType int32
+
+const (
+	EvidenceEventType_EVIDENCE_EVENT_TYPE_UNSPECIFIED EvidenceEventType = 0
+	EvidenceEventType_EVIDENCE_EVENT_TYPE_CREATED     EvidenceEventType = 1
+)
+
+// Enum value maps for EvidenceEventType.
+var (
+	EvidenceEventType_name = map[int32]string{
+		0: "EVIDENCE_EVENT_TYPE_UNSPECIFIED",
+		1: "EVIDENCE_EVENT_TYPE_CREATED",
+	}
+	EvidenceEventType_value = map[string]int32{
+		"EVIDENCE_EVENT_TYPE_UNSPECIFIED": 0,
+		"EVIDENCE_EVENT_TYPE_CREATED":     1,
+	}
+)
+
+func (x EvidenceEventType) Enum() *EvidenceEventType {
+	p := new(EvidenceEventType)
+	*p = x
+	return p
+}
+
+func (x EvidenceEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EvidenceEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_evidence_evidence_store_proto_enumTypes[1].Descriptor()
+}
+
+func (EvidenceEventType) Type() protoreflect.EnumType {
+	return &file_api_evidence_evidence_store_proto_enumTypes[1]
+}
+
+func (x EvidenceEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EvidenceEventType.Descriptor instead.
+func (EvidenceEventType) EnumDescriptor() ([]byte, []int) {
+	return file_api_evidence_evidence_store_proto_rawDescGZIP(), []int{1}
+}
+
 type StoreEvidenceRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Evidence      *Evidence              `protobuf:"bytes,1,opt,name=evidence,proto3" json:"evidence,omitempty"`
@@ -302,8 +350,16 @@ type Filter struct {
 	state                protoimpl.MessageState `protogen:"open.v1"`
 	TargetOfEvaluationId *string                `protobuf:"bytes,1,opt,name=target_of_evaluation_id,json=targetOfEvaluationId,proto3,oneof" json:"target_of_evaluation_id,omitempty"`
 	ToolId               *string                `protobuf:"bytes,2,opt,name=tool_id,json=toolId,proto3,oneof" json:"tool_id,omitempty"`
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
+	// Optional. Lists only evidences of resources of the given ontology
+	// resource type, e.g., "VirtualMachine" or "ObjectStorage".
+	ResourceType *string `protobuf:"bytes,3,opt,name=resource_type,json=resourceType,proto3,oneof" json:"resource_type,omitempty"`
+	// Optional. Lists only evidences with a timestamp at or after the given
+	// time.
+	TimestampAfter *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=timestamp_after,json=timestampAfter,proto3,oneof" json:"timestamp_after,omitempty"`
+	// Optional. Lists only evidences with a timestamp before the given time.
+	TimestampBefore *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=timestamp_before,json=timestampBefore,proto3,oneof" json:"timestamp_before,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *Filter) Reset() {
@@ -350,6 +406,27 @@ func (x *Filter) GetToolId() string {
 	return ""
 }
 
+func (x *Filter) GetResourceType() string {
+	if x != nil && x.ResourceType != nil {
+		return *x.ResourceType
+	}
+	return ""
+}
+
+func (x *Filter) GetTimestampAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.TimestampAfter
+	}
+	return nil
+}
+
+func (x *Filter) GetTimestampBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.TimestampBefore
+	}
+	return nil
+}
+
 type ListEvidencesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Evidences     []*Evidence            `protobuf:"bytes,1,rep,name=evidences,proto3" json:"evidences,omitempty"`
@@ -742,29 +819,28 @@ func (x *ListToolsResponse) GetNextPageToken() string {
 	return ""
 }
 
-type ListResourcesRequest_Filter struct {
-	state                protoimpl.MessageState `protogen:"open.v1"`
-	Type                 *string                `protobuf:"bytes,1,opt,name=type,proto3,oneof" json:"type,omitempty"`
-	TargetOfEvaluationId *string                `protobuf:"bytes,2,opt,name=target_of_evaluation_id,json=targetOfEvaluationId,proto3,oneof" json:"target_of_evaluation_id,omitempty"`
-	ToolId               *string                `protobuf:"bytes,3,opt,name=tool_id,json=toolId,proto3,oneof" json:"tool_id,omitempty"`
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
+type WatchEvidencesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Optional. Only evidences matching the filter are streamed.
+	Filter        *Filter `protobuf:"bytes,1,opt,name=filter,proto3,oneof" json:"filter,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListResourcesRequest_Filter) Reset() {
-	*x = ListResourcesRequest_Filter{}
+func (x *WatchEvidencesRequest) Reset() {
+	*x = WatchEvidencesRequest{}
 	mi := &file_api_evidence_evidence_store_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListResourcesRequest_Filter) String() string {
+func (x *WatchEvidencesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListResourcesRequest_Filter) ProtoMessage() {}
+func (*WatchEvidencesRequest) ProtoMessage() {}
 
-func (x *ListResourcesRequest_Filter) ProtoReflect() protoreflect.Message {
+func (x *WatchEvidencesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_evidence_evidence_store_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -776,59 +852,835 @@ func (x *ListResourcesRequest_Filter) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListResourcesRequest_Filter.ProtoReflect.Descriptor instead.
-func (*ListResourcesRequest_Filter) Descriptor() ([]byte, []int) {
-	return file_api_evidence_evidence_store_proto_rawDescGZIP(), []int{9, 0}
+// Deprecated: Use WatchEvidencesRequest.ProtoReflect.Descriptor instead.
+func (*WatchEvidencesRequest) Descriptor() ([]byte, []int) {
+	return file_api_evidence_evidence_store_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *ListResourcesRequest_Filter) GetType() string {
-	if x != nil && x.Type != nil {
-		return *x.Type
+func (x *WatchEvidencesRequest) GetFilter() *Filter {
+	if x != nil {
+		return x.Filter
 	}
-	return ""
+	return nil
 }
 
-func (x *ListResourcesRequest_Filter) GetTargetOfEvaluationId() string {
-	if x != nil && x.TargetOfEvaluationId != nil {
-		return *x.TargetOfEvaluationId
+// EvidenceEvent is streamed by WatchEvidences for each change of the evidence
+// storage.
+type EvidenceEvent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Type  EvidenceEventType      `protobuf:"varint,1,opt,name=type,proto3,enum=confirmate.evidence.v1.EvidenceEventType" json:"type,omitempty"`
+	// The evidence, including its resource.
+	Evidence *Evidence `protobuf:"bytes,2,opt,name=evidence,proto3" json:"evidence,omitempty"`
+	// The time the event occurred.
+	Timestamp     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EvidenceEvent) Reset() {
+	*x = EvidenceEvent{}
+	mi := &file_api_evidence_evidence_store_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EvidenceEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EvidenceEvent) ProtoMessage() {}
+
+func (x *EvidenceEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_api_evidence_evidence_store_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EvidenceEvent.ProtoReflect.Descriptor instead.
+func (*EvidenceEvent) Descriptor() ([]byte, []int) {
+	return file_api_evidence_evidence_store_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *EvidenceEvent) GetType() EvidenceEventType {
+	if x != nil {
+		return x.Type
+	}
+	return EvidenceEventType_EVIDENCE_EVENT_TYPE_UNSPECIFIED
+}
+
+func (x *EvidenceEvent) GetEvidence() *Evidence {
+	if x != nil {
+		return x.Evidence
+	}
+	return nil
+}
+
+func (x *EvidenceEvent) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+type ReidentifyPseudonymsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The tokens of the pseudonyms to re-identify.
+	Tokens []string `protobuf:"bytes,1,rep,name=tokens,proto3" json:"tokens,omitempty"`
+	// The reason for the re-identification, which is recorded in the audit log.
+	Justification string `protobuf:"bytes,2,opt,name=justification,proto3" json:"justification,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReidentifyPseudonymsRequest) Reset() {
+	*x = ReidentifyPseudonymsRequest{}
+	mi := &file_api_evidence_evidence_store_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReidentifyPseudonymsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReidentifyPseudonymsRequest) ProtoMessage() {}
+
+func (x *ReidentifyPseudonymsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_evidence_evidence_store_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReidentifyPseudonymsRequest.ProtoReflect.Descriptor instead.
+func (*ReidentifyPseudonymsRequest) Descriptor() ([]byte, []int) {
+	return file_api_evidence_evidence_store_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ReidentifyPseudonymsRequest) GetTokens() []string {
+	if x != nil {
+		return x.Tokens
+	}
+	return nil
+}
+
+func (x *ReidentifyPseudonymsRequest) GetJustification() string {
+	if x != nil {
+		return x.Justification
 	}
 	return ""
 }
 
-func (x *ListResourcesRequest_Filter) GetToolId() string {
-	if x != nil && x.ToolId != nil {
-		return *x.ToolId
+type ReidentifyPseudonymsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The personal data, keyed by the token of their pseudonym. Unknown tokens
+	// are omitted.
+	Values        map[string]string `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReidentifyPseudonymsResponse) Reset() {
+	*x = ReidentifyPseudonymsResponse{}
+	mi := &file_api_evidence_evidence_store_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReidentifyPseudonymsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReidentifyPseudonymsResponse) ProtoMessage() {}
+
+func (x *ReidentifyPseudonymsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_evidence_evidence_store_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReidentifyPseudonymsResponse.ProtoReflect.Descriptor instead.
+func (*ReidentifyPseudonymsResponse) Descriptor() ([]byte, []int) {
+	return file_api_evidence_evidence_store_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ReidentifyPseudonymsResponse) GetValues() map[string]string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type CreateCollectorRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Collector     *Collector             `protobuf:"bytes,1,opt,name=collector,proto3" json:"collector,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCollectorRequest) Reset() {
+	*x = CreateCollectorRequest{}
+	mi := &file_api_evidence_evidence_store_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCollectorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCollectorRequest) ProtoMessage() {}
+
+func (x *CreateCollectorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_evidence_evidence_store_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCollectorRequest.ProtoReflect.Descriptor instead.
+func (*CreateCollectorRequest) Descriptor() ([]byte, []int) {
+	return file_api_evidence_evidence_store_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *CreateCollectorRequest) GetCollector() *Collector {
+	if x != nil {
+		return x.Collector
+	}
+	return nil
+}
+
+type CreateCollectorResponse struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Collector *Collector             `protobuf:"bytes,1,opt,name=collector,proto3" json:"collector,omitempty"`
+	// The token of the collector, which it sends as bearer token. It is only
+	// returned once.
+	Token         string `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCollectorResponse) Reset() {
+	*x = CreateCollectorResponse{}
+	mi := &file_api_evidence_evidence_store_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCollectorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCollectorResponse) ProtoMessage() {}
+
+func (x *CreateCollectorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_evidence_evidence_store_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCollectorResponse.ProtoReflect.Descriptor instead.
+func (*CreateCollectorResponse) Descriptor() ([]byte, []int) {
+	return file_api_evidence_evidence_store_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *CreateCollectorResponse) GetCollector() *Collector {
+	if x != nil {
+		return x.Collector
+	}
+	return nil
+}
+
+func (x *CreateCollectorResponse) GetToken() string {
+	if x != nil {
+		return x.Token
 	}
 	return ""
 }
 
-var File_api_evidence_evidence_store_proto protoreflect.FileDescriptor
+type ListCollectorsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageSize      int32                  `protobuf:"varint,10,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,11,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	OrderBy       string                 `protobuf:"bytes,12,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	Asc           bool                   `protobuf:"varint,13,opt,name=asc,proto3" json:"asc,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_api_evidence_evidence_store_proto_rawDesc = "" +
-	"\n" +
-	"!api/evidence/evidence_store.proto\x12\x16confirmate.evidence.v1\x1a\x1bapi/evidence/evidence.proto\x1a\x1bbuf/validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\"\\\n" +
-	"\x14StoreEvidenceRequest\x12D\n" +
-	"\bevidence\x18\x01 \x01(\v2 .confirmate.evidence.v1.EvidenceB\x06\xbaH\x03\xc8\x01\x01R\bevidence\"\x17\n" +
-	"\x15StoreEvidenceResponse\"\x7f\n" +
-	"\x16StoreEvidencesResponse\x12>\n" +
-	"\x06status\x18\x01 \x01(\x0e2&.confirmate.evidence.v1.EvidenceStatusR\x06status\x12%\n" +
-	"\x0estatus_message\x18\x02 \x01(\tR\rstatusMessage\"\xc7\x01\n" +
-	"\x14ListEvidencesRequest\x12;\n" +
-	"\x06filter\x18\x01 \x01(\v2\x1e.confirmate.evidence.v1.FilterH\x00R\x06filter\x88\x01\x01\x12\x1b\n" +
-	"\tpage_size\x18\n" +
-	" \x01(\x05R\bpageSize\x12\x1d\n" +
-	"\n" +
-	"page_token\x18\v \x01(\tR\tpageToken\x12\x19\n" +
-	"\border_by\x18\f \x01(\tR\aorderBy\x12\x10\n" +
-	"\x03asc\x18\r \x01(\bR\x03ascB\t\n" +
-	"\a_filter\"\x9d\x01\n" +
-	"\x06Filter\x12D\n" +
-	"\x17target_of_evaluation_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01H\x00R\x14targetOfEvaluationId\x88\x01\x01\x12%\n" +
-	"\atool_id\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x10\x01H\x01R\x06toolId\x88\x01\x01B\x1a\n" +
-	"\x18_target_of_evaluation_idB\n" +
-	"\n" +
-	"\b_tool_id\"\x7f\n" +
-	"\x15ListEvidencesResponse\x12>\n" +
+func (x *ListCollectorsRequest) Reset() {
+	*x = ListCollectorsRequest{}
+	mi := &file_api_evidence_evidence_store_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCollectorsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCollectorsRequest) ProtoMessage() {}
+
+func (x *ListCollectorsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_evidence_evidence_store_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCollectorsRequest.ProtoReflect.Descriptor instead.
+func (*ListCollectorsRequest) Descriptor() ([]byte, []int) {
+	return file_api_evidence_evidence_store_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ListCollectorsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListCollectorsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListCollectorsRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+func (x *ListCollectorsRequest) GetAsc() bool {
+	if x != nil {
+		return x.Asc
+	}
+	return false
+}
+
+type ListCollectorsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Collectors    []*Collector           `protobuf:"bytes,1,rep,name=collectors,proto3" json:"collectors,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCollectorsResponse) Reset() {
+	*x = ListCollectorsResponse{}
+	mi := &file_api_evidence_evidence_store_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCollectorsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCollectorsResponse) ProtoMessage() {}
+
+func (x *ListCollectorsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_evidence_evidence_store_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCollectorsResponse.ProtoReflect.Descriptor instead.
+func (*ListCollectorsResponse) Descriptor() ([]byte, []int) {
+	return file_api_evidence_evidence_store_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ListCollectorsResponse) GetCollectors() []*Collector {
+	if x != nil {
+		return x.Collectors
+	}
+	return nil
+}
+
+func (x *ListCollectorsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type UpdateCollectorRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Collector     *Collector             `protobuf:"bytes,1,opt,name=collector,proto3" json:"collector,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCollectorRequest) Reset() {
+	*x = UpdateCollectorRequest{}
+	mi := &file_api_evidence_evidence_store_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCollectorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCollectorRequest) ProtoMessage() {}
+
+func (x *UpdateCollectorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_evidence_evidence_store_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCollectorRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCollectorRequest) Descriptor() ([]byte, []int) {
+	return file_api_evidence_evidence_store_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *UpdateCollectorRequest) GetCollector() *Collector {
+	if x != nil {
+		return x.Collector
+	}
+	return nil
+}
+
+type RemoveCollectorRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CollectorId   string                 `protobuf:"bytes,1,opt,name=collector_id,json=collectorId,proto3" json:"collector_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveCollectorRequest) Reset() {
+	*x = RemoveCollectorRequest{}
+	mi := &file_api_evidence_evidence_store_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveCollectorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveCollectorRequest) ProtoMessage() {}
+
+func (x *RemoveCollectorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_evidence_evidence_store_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveCollectorRequest.ProtoReflect.Descriptor instead.
+func (*RemoveCollectorRequest) Descriptor() ([]byte, []int) {
+	return file_api_evidence_evidence_store_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *RemoveCollectorRequest) GetCollectorId() string {
+	if x != nil {
+		return x.CollectorId
+	}
+	return ""
+}
+
+type RotateCollectorTokenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CollectorId   string                 `protobuf:"bytes,1,opt,name=collector_id,json=collectorId,proto3" json:"collector_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RotateCollectorTokenRequest) Reset() {
+	*x = RotateCollectorTokenRequest{}
+	mi := &file_api_evidence_evidence_store_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateCollectorTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateCollectorTokenRequest) ProtoMessage() {}
+
+func (x *RotateCollectorTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_evidence_evidence_store_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateCollectorTokenRequest.ProtoReflect.Descriptor instead.
+func (*RotateCollectorTokenRequest) Descriptor() ([]byte, []int) {
+	return file_api_evidence_evidence_store_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *RotateCollectorTokenRequest) GetCollectorId() string {
+	if x != nil {
+		return x.CollectorId
+	}
+	return ""
+}
+
+type RotateCollectorTokenResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The new token of the collector. It is only returned once.
+	Token         string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RotateCollectorTokenResponse) Reset() {
+	*x = RotateCollectorTokenResponse{}
+	mi := &file_api_evidence_evidence_store_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateCollectorTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateCollectorTokenResponse) ProtoMessage() {}
+
+func (x *RotateCollectorTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_evidence_evidence_store_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateCollectorTokenResponse.ProtoReflect.Descriptor instead.
+func (*RotateCollectorTokenResponse) Descriptor() ([]byte, []int) {
+	return file_api_evidence_evidence_store_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *RotateCollectorTokenResponse) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type ListCollectorUsageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCollectorUsageRequest) Reset() {
+	*x = ListCollectorUsageRequest{}
+	mi := &file_api_evidence_evidence_store_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCollectorUsageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCollectorUsageRequest) ProtoMessage() {}
+
+func (x *ListCollectorUsageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_evidence_evidence_store_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCollectorUsageRequest.ProtoReflect.Descriptor instead.
+func (*ListCollectorUsageRequest) Descriptor() ([]byte, []int) {
+	return file_api_evidence_evidence_store_proto_rawDescGZIP(), []int{25}
+}
+
+type ListCollectorUsageResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The usage of all collectors that stored or tried to store evidences, most
+	// active first.
+	Usage         []*CollectorUsage `protobuf:"bytes,1,rep,name=usage,proto3" json:"usage,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCollectorUsageResponse) Reset() {
+	*x = ListCollectorUsageResponse{}
+	mi := &file_api_evidence_evidence_store_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCollectorUsageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCollectorUsageResponse) ProtoMessage() {}
+
+func (x *ListCollectorUsageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_evidence_evidence_store_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCollectorUsageResponse.ProtoReflect.Descriptor instead.
+func (*ListCollectorUsageResponse) Descriptor() ([]byte, []int) {
+	return file_api_evidence_evidence_store_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ListCollectorUsageResponse) GetUsage() []*CollectorUsage {
+	if x != nil {
+		return x.Usage
+	}
+	return nil
+}
+
+// CollectorUsage contains the usage statistics of a collector since the start
+// of the evidence store.
+type CollectorUsage struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	CollectorId string                 `protobuf:"bytes,1,opt,name=collector_id,json=collectorId,proto3" json:"collector_id,omitempty"`
+	// The number of stored evidences
+	StoredEvidences int64 `protobuf:"varint,2,opt,name=stored_evidences,json=storedEvidences,proto3" json:"stored_evidences,omitempty"`
+	// The total size of the stored evidences in bytes
+	StoredBytes int64 `protobuf:"varint,3,opt,name=stored_bytes,json=storedBytes,proto3" json:"stored_bytes,omitempty"`
+	// The number of evidences that were rejected, because they exceeded a quota
+	// of the collector
+	RejectedEvidences int64 `protobuf:"varint,4,opt,name=rejected_evidences,json=rejectedEvidences,proto3" json:"rejected_evidences,omitempty"`
+	// The time the collector last stored or tried to store an evidence
+	LastSeenAt    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=last_seen_at,json=lastSeenAt,proto3,oneof" json:"last_seen_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CollectorUsage) Reset() {
+	*x = CollectorUsage{}
+	mi := &file_api_evidence_evidence_store_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CollectorUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollectorUsage) ProtoMessage() {}
+
+func (x *CollectorUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_api_evidence_evidence_store_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollectorUsage.ProtoReflect.Descriptor instead.
+func (*CollectorUsage) Descriptor() ([]byte, []int) {
+	return file_api_evidence_evidence_store_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *CollectorUsage) GetCollectorId() string {
+	if x != nil {
+		return x.CollectorId
+	}
+	return ""
+}
+
+func (x *CollectorUsage) GetStoredEvidences() int64 {
+	if x != nil {
+		return x.StoredEvidences
+	}
+	return 0
+}
+
+func (x *CollectorUsage) GetStoredBytes() int64 {
+	if x != nil {
+		return x.StoredBytes
+	}
+	return 0
+}
+
+func (x *CollectorUsage) GetRejectedEvidences() int64 {
+	if x != nil {
+		return x.RejectedEvidences
+	}
+	return 0
+}
+
+func (x *CollectorUsage) GetLastSeenAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastSeenAt
+	}
+	return nil
+}
+
+type ListResourcesRequest_Filter struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Type                 *string                `protobuf:"bytes,1,opt,name=type,proto3,oneof" json:"type,omitempty"`
+	TargetOfEvaluationId *string                `protobuf:"bytes,2,opt,name=target_of_evaluation_id,json=targetOfEvaluationId,proto3,oneof" json:"target_of_evaluation_id,omitempty"`
+	ToolId               *string                `protobuf:"bytes,3,opt,name=tool_id,json=toolId,proto3,oneof" json:"tool_id,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *ListResourcesRequest_Filter) Reset() {
+	*x = ListResourcesRequest_Filter{}
+	mi := &file_api_evidence_evidence_store_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListResourcesRequest_Filter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListResourcesRequest_Filter) ProtoMessage() {}
+
+func (x *ListResourcesRequest_Filter) ProtoReflect() protoreflect.Message {
+	mi := &file_api_evidence_evidence_store_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListResourcesRequest_Filter.ProtoReflect.Descriptor instead.
+func (*ListResourcesRequest_Filter) Descriptor() ([]byte, []int) {
+	return file_api_evidence_evidence_store_proto_rawDescGZIP(), []int{9, 0}
+}
+
+func (x *ListResourcesRequest_Filter) GetType() string {
+	if x != nil && x.Type != nil {
+		return *x.Type
+	}
+	return ""
+}
+
+func (x *ListResourcesRequest_Filter) GetTargetOfEvaluationId() string {
+	if x != nil && x.TargetOfEvaluationId != nil {
+		return *x.TargetOfEvaluationId
+	}
+	return ""
+}
+
+func (x *ListResourcesRequest_Filter) GetToolId() string {
+	if x != nil && x.ToolId != nil {
+		return *x.ToolId
+	}
+	return ""
+}
+
+var File_api_evidence_evidence_store_proto protoreflect.FileDescriptor
+
+const file_api_evidence_evidence_store_proto_rawDesc = "" +
+	"\n" +
+	"!api/evidence/evidence_store.proto\x12\x16confirmate.evidence.v1\x1a\x1bapi/evidence/evidence.proto\x1a\x1bbuf/validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\\\n" +
+	"\x14StoreEvidenceRequest\x12D\n" +
+	"\bevidence\x18\x01 \x01(\v2 .confirmate.evidence.v1.EvidenceB\x06\xbaH\x03\xc8\x01\x01R\bevidence\"\x17\n" +
+	"\x15StoreEvidenceResponse\"\x7f\n" +
+	"\x16StoreEvidencesResponse\x12>\n" +
+	"\x06status\x18\x01 \x01(\x0e2&.confirmate.evidence.v1.EvidenceStatusR\x06status\x12%\n" +
+	"\x0estatus_message\x18\x02 \x01(\tR\rstatusMessage\"\xc7\x01\n" +
+	"\x14ListEvidencesRequest\x12;\n" +
+	"\x06filter\x18\x01 \x01(\v2\x1e.confirmate.evidence.v1.FilterH\x00R\x06filter\x88\x01\x01\x12\x1b\n" +
+	"\tpage_size\x18\n" +
+	" \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\v \x01(\tR\tpageToken\x12\x19\n" +
+	"\border_by\x18\f \x01(\tR\aorderBy\x12\x10\n" +
+	"\x03asc\x18\r \x01(\bR\x03ascB\t\n" +
+	"\a_filter\"\xa1\x03\n" +
+	"\x06Filter\x12D\n" +
+	"\x17target_of_evaluation_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01H\x00R\x14targetOfEvaluationId\x88\x01\x01\x12%\n" +
+	"\atool_id\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x10\x01H\x01R\x06toolId\x88\x01\x01\x121\n" +
+	"\rresource_type\x18\x03 \x01(\tB\a\xbaH\x04r\x02\x10\x01H\x02R\fresourceType\x88\x01\x01\x12H\n" +
+	"\x0ftimestamp_after\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampH\x03R\x0etimestampAfter\x88\x01\x01\x12J\n" +
+	"\x10timestamp_before\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampH\x04R\x0ftimestampBefore\x88\x01\x01B\x1a\n" +
+	"\x18_target_of_evaluation_idB\n" +
+	"\n" +
+	"\b_tool_idB\x10\n" +
+	"\x0e_resource_typeB\x12\n" +
+	"\x10_timestamp_afterB\x13\n" +
+	"\x11_timestamp_before\"\x7f\n" +
+	"\x15ListEvidencesResponse\x12>\n" +
 	"\tevidences\x18\x01 \x03(\v2 .confirmate.evidence.v1.EvidenceR\tevidences\x12&\n" +
 	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"?\n" +
 	"\x12GetEvidenceRequest\x12)\n" +
@@ -860,11 +1712,67 @@ const file_api_evidence_evidence_store_proto_rawDesc = "" +
 	"\x10ListToolsRequest\"V\n" +
 	"\x11ListToolsResponse\x12\x19\n" +
 	"\btool_ids\x18\x01 \x03(\tR\atoolIds\x12&\n" +
-	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken*d\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"_\n" +
+	"\x15WatchEvidencesRequest\x12;\n" +
+	"\x06filter\x18\x01 \x01(\v2\x1e.confirmate.evidence.v1.FilterH\x00R\x06filter\x88\x01\x01B\t\n" +
+	"\a_filter\"\xe3\x01\n" +
+	"\rEvidenceEvent\x12J\n" +
+	"\x04type\x18\x01 \x01(\x0e2).confirmate.evidence.v1.EvidenceEventTypeB\v\xe0A\x02\xbaH\x05\x82\x01\x02\x10\x01R\x04type\x12G\n" +
+	"\bevidence\x18\x02 \x01(\v2 .confirmate.evidence.v1.EvidenceB\t\xe0A\x02\xbaH\x03\xc8\x01\x01R\bevidence\x12=\n" +
+	"\ttimestamp\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x02R\ttimestamp\"|\n" +
+	"\x1bReidentifyPseudonymsRequest\x12+\n" +
+	"\x06tokens\x18\x01 \x03(\tB\x13\xe0A\x02\xbaH\r\x92\x01\n" +
+	"\b\x01\x10d\"\x04r\x02\x10\x01R\x06tokens\x120\n" +
+	"\rjustification\x18\x02 \x01(\tB\n" +
+	"\xe0A\x02\xbaH\x04r\x02\x10\x01R\rjustification\"\xb8\x01\n" +
+	"\x1cReidentifyPseudonymsResponse\x12]\n" +
+	"\x06values\x18\x01 \x03(\v2@.confirmate.evidence.v1.ReidentifyPseudonymsResponse.ValuesEntryB\x03\xe0A\x02R\x06values\x1a9\n" +
+	"\vValuesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"d\n" +
+	"\x16CreateCollectorRequest\x12J\n" +
+	"\tcollector\x18\x01 \x01(\v2!.confirmate.evidence.v1.CollectorB\t\xe0A\x02\xbaH\x03\xc8\x01\x01R\tcollector\"z\n" +
+	"\x17CreateCollectorResponse\x12D\n" +
+	"\tcollector\x18\x01 \x01(\v2!.confirmate.evidence.v1.CollectorB\x03\xe0A\x02R\tcollector\x12\x19\n" +
+	"\x05token\x18\x02 \x01(\tB\x03\xe0A\x02R\x05token\"\x80\x01\n" +
+	"\x15ListCollectorsRequest\x12\x1b\n" +
+	"\tpage_size\x18\n" +
+	" \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\v \x01(\tR\tpageToken\x12\x19\n" +
+	"\border_by\x18\f \x01(\tR\aorderBy\x12\x10\n" +
+	"\x03asc\x18\r \x01(\bR\x03asc\"\x83\x01\n" +
+	"\x16ListCollectorsResponse\x12A\n" +
+	"\n" +
+	"collectors\x18\x01 \x03(\v2!.confirmate.evidence.v1.CollectorR\n" +
+	"collectors\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"d\n" +
+	"\x16UpdateCollectorRequest\x12J\n" +
+	"\tcollector\x18\x01 \x01(\v2!.confirmate.evidence.v1.CollectorB\t\xe0A\x02\xbaH\x03\xc8\x01\x01R\tcollector\"H\n" +
+	"\x16RemoveCollectorRequest\x12.\n" +
+	"\fcollector_id\x18\x01 \x01(\tB\v\xe0A\x02\xbaH\x05r\x03\xb0\x01\x01R\vcollectorId\"M\n" +
+	"\x1bRotateCollectorTokenRequest\x12.\n" +
+	"\fcollector_id\x18\x01 \x01(\tB\v\xe0A\x02\xbaH\x05r\x03\xb0\x01\x01R\vcollectorId\"9\n" +
+	"\x1cRotateCollectorTokenResponse\x12\x19\n" +
+	"\x05token\x18\x01 \x01(\tB\x03\xe0A\x02R\x05token\"\x1b\n" +
+	"\x19ListCollectorUsageRequest\"Z\n" +
+	"\x1aListCollectorUsageResponse\x12<\n" +
+	"\x05usage\x18\x01 \x03(\v2&.confirmate.evidence.v1.CollectorUsageR\x05usage\"\x89\x02\n" +
+	"\x0eCollectorUsage\x12&\n" +
+	"\fcollector_id\x18\x01 \x01(\tB\x03\xe0A\x02R\vcollectorId\x12)\n" +
+	"\x10stored_evidences\x18\x02 \x01(\x03R\x0fstoredEvidences\x12!\n" +
+	"\fstored_bytes\x18\x03 \x01(\x03R\vstoredBytes\x12-\n" +
+	"\x12rejected_evidences\x18\x04 \x01(\x03R\x11rejectedEvidences\x12A\n" +
+	"\flast_seen_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\n" +
+	"lastSeenAt\x88\x01\x01B\x0f\n" +
+	"\r_last_seen_at*d\n" +
 	"\x0eEvidenceStatus\x12\x1f\n" +
 	"\x1bEVIDENCE_STATUS_UNSPECIFIED\x10\x00\x12\x16\n" +
 	"\x12EVIDENCE_STATUS_OK\x10\x01\x12\x19\n" +
-	"\x15EVIDENCE_STATUS_ERROR\x10\x022\xaf\b\n" +
+	"\x15EVIDENCE_STATUS_ERROR\x10\x02*Y\n" +
+	"\x11EvidenceEventType\x12#\n" +
+	"\x1fEVIDENCE_EVENT_TYPE_UNSPECIFIED\x10\x00\x12\x1f\n" +
+	"\x1bEVIDENCE_EVENT_TYPE_CREATED\x10\x012\xc3\x12\n" +
 	"\rEvidenceStore\x12\x9b\x01\n" +
 	"\rStoreEvidence\x12,.confirmate.evidence.v1.StoreEvidenceRequest\x1a-.confirmate.evidence.v1.StoreEvidenceResponse\"-\x82\xd3\xe4\x93\x02':\bevidence\"\x1b/v1/evidence_store/evidence\x12t\n" +
 	"\x0eStoreEvidences\x12,.confirmate.evidence.v1.StoreEvidenceRequest\x1a..confirmate.evidence.v1.StoreEvidencesResponse\"\x00(\x010\x01\x12\x92\x01\n" +
@@ -872,7 +1780,15 @@ const file_api_evidence_evidence_store_proto_rawDesc = "" +
 	"\vGetEvidence\x12*.confirmate.evidence.v1.GetEvidenceRequest\x1a .confirmate.evidence.v1.Evidence\"2\x82\xd3\xe4\x93\x02,\x12*/v1/evidence_store/evidences/{evidence_id}\x12\xc8\x01\n" +
 	"\x1aListSupportedResourceTypes\x129.confirmate.evidence.v1.ListSupportedResourceTypesRequest\x1a:.confirmate.evidence.v1.ListSupportedResourceTypesResponse\"3\x82\xd3\xe4\x93\x02-\x12+/v1/evidence_store/supported_resource_types\x12\x92\x01\n" +
 	"\rListResources\x12,.confirmate.evidence.v1.ListResourcesRequest\x1a-.confirmate.evidence.v1.ListResourcesResponse\"$\x82\xd3\xe4\x93\x02\x1e\x12\x1c/v1/evidence_store/resources\x12\x82\x01\n" +
-	"\tListTools\x12(.confirmate.evidence.v1.ListToolsRequest\x1a).confirmate.evidence.v1.ListToolsResponse\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/v1/evidence_store/toolsB!Z\x1fconfirmate.io/core/api/evidenceb\x06proto3"
+	"\tListTools\x12(.confirmate.evidence.v1.ListToolsRequest\x1a).confirmate.evidence.v1.ListToolsResponse\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/v1/evidence_store/tools\x12j\n" +
+	"\x0eWatchEvidences\x12-.confirmate.evidence.v1.WatchEvidencesRequest\x1a%.confirmate.evidence.v1.EvidenceEvent\"\x000\x01\x12\xb6\x01\n" +
+	"\x14ReidentifyPseudonyms\x123.confirmate.evidence.v1.ReidentifyPseudonymsRequest\x1a4.confirmate.evidence.v1.ReidentifyPseudonymsResponse\"3\x82\xd3\xe4\x93\x02-:\x01*\"(/v1/evidence_store/pseudonyms/reidentify\x12\xa4\x01\n" +
+	"\x0fCreateCollector\x12..confirmate.evidence.v1.CreateCollectorRequest\x1a/.confirmate.evidence.v1.CreateCollectorResponse\"0\x82\xd3\xe4\x93\x02*:\tcollector\"\x1d/v1/evidence_store/collectors\x12\x96\x01\n" +
+	"\x0eListCollectors\x12-.confirmate.evidence.v1.ListCollectorsRequest\x1a..confirmate.evidence.v1.ListCollectorsResponse\"%\x82\xd3\xe4\x93\x02\x1f\x12\x1d/v1/evidence_store/collectors\x12\xa5\x01\n" +
+	"\x0fUpdateCollector\x12..confirmate.evidence.v1.UpdateCollectorRequest\x1a!.confirmate.evidence.v1.Collector\"?\x82\xd3\xe4\x93\x029:\tcollector\x1a,/v1/evidence_store/collectors/{collector.id}\x12\x8f\x01\n" +
+	"\x0fRemoveCollector\x12..confirmate.evidence.v1.RemoveCollectorRequest\x1a\x16.google.protobuf.Empty\"4\x82\xd3\xe4\x93\x02.*,/v1/evidence_store/collectors/{collector_id}\x12\xc7\x01\n" +
+	"\x14RotateCollectorToken\x123.confirmate.evidence.v1.RotateCollectorTokenRequest\x1a4.confirmate.evidence.v1.RotateCollectorTokenResponse\"D\x82\xd3\xe4\x93\x02>:\x01*\"9/v1/evidence_store/collectors/{collector_id}/rotate_token\x12\xa8\x01\n" +
+	"\x12ListCollectorUsage\x121.confirmate.evidence.v1.ListCollectorUsageRequest\x1a2.confirmate.evidence.v1.ListCollectorUsageResponse\"+\x82\xd3\xe4\x93\x02%\x12#/v1/evidence_store/collectors/usageB!Z\x1fconfirmate.io/core/api/evidenceb\x06proto3"
 
 var (
 	file_api_evidence_evidence_store_proto_rawDescOnce sync.Once
@@ -886,53 +1802,102 @@ func file_api_evidence_evidence_store_proto_rawDescGZIP() []byte {
 	return file_api_evidence_evidence_store_proto_rawDescData
 }
 
-var file_api_evidence_evidence_store_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_api_evidence_evidence_store_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_api_evidence_evidence_store_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_api_evidence_evidence_store_proto_msgTypes = make([]protoimpl.MessageInfo, 30)
 var file_api_evidence_evidence_store_proto_goTypes = []any{
 	(EvidenceStatus)(0),                        // 0: confirmate.evidence.v1.EvidenceStatus
-	(*StoreEvidenceRequest)(nil),               // 1: confirmate.evidence.v1.StoreEvidenceRequest
-	(*StoreEvidenceResponse)(nil),              // 2: confirmate.evidence.v1.StoreEvidenceResponse
-	(*StoreEvidencesResponse)(nil),             // 3: confirmate.evidence.v1.StoreEvidencesResponse
-	(*ListEvidencesRequest)(nil),               // 4: confirmate.evidence.v1.ListEvidencesRequest
-	(*Filter)(nil),                             // 5: confirmate.evidence.v1.Filter
-	(*ListEvidencesResponse)(nil),              // 6: confirmate.evidence.v1.ListEvidencesResponse
-	(*GetEvidenceRequest)(nil),                 // 7: confirmate.evidence.v1.GetEvidenceRequest
-	(*ListSupportedResourceTypesRequest)(nil),  // 8: confirmate.evidence.v1.ListSupportedResourceTypesRequest
-	(*ListSupportedResourceTypesResponse)(nil), // 9: confirmate.evidence.v1.ListSupportedResourceTypesResponse
-	(*ListResourcesRequest)(nil),               // 10: confirmate.evidence.v1.ListResourcesRequest
-	(*ListResourcesResponse)(nil),              // 11: confirmate.evidence.v1.ListResourcesResponse
-	(*ListToolsRequest)(nil),                   // 12: confirmate.evidence.v1.ListToolsRequest
-	(*ListToolsResponse)(nil),                  // 13: confirmate.evidence.v1.ListToolsResponse
-	(*ListResourcesRequest_Filter)(nil),        // 14: confirmate.evidence.v1.ListResourcesRequest.Filter
-	(*Evidence)(nil),                           // 15: confirmate.evidence.v1.Evidence
-	(*ResourceSnapshot)(nil),                   // 16: confirmate.evidence.v1.ResourceSnapshot
+	(EvidenceEventType)(0),                     // 1: confirmate.evidence.v1.EvidenceEventType
+	(*StoreEvidenceRequest)(nil),               // 2: confirmate.evidence.v1.StoreEvidenceRequest
+	(*StoreEvidenceResponse)(nil),              // 3: confirmate.evidence.v1.StoreEvidenceResponse
+	(*StoreEvidencesResponse)(nil),             // 4: confirmate.evidence.v1.StoreEvidencesResponse
+	(*ListEvidencesRequest)(nil),               // 5: confirmate.evidence.v1.ListEvidencesRequest
+	(*Filter)(nil),                             // 6: confirmate.evidence.v1.Filter
+	(*ListEvidencesResponse)(nil),              // 7: confirmate.evidence.v1.ListEvidencesResponse
+	(*GetEvidenceRequest)(nil),                 // 8: confirmate.evidence.v1.GetEvidenceRequest
+	(*ListSupportedResourceTypesRequest)(nil),  // 9: confirmate.evidence.v1.ListSupportedResourceTypesRequest
+	(*ListSupportedResourceTypesResponse)(nil), // 10: confirmate.evidence.v1.ListSupportedResourceTypesResponse
+	(*ListResourcesRequest)(nil),               // 11: confirmate.evidence.v1.ListResourcesRequest
+	(*ListResourcesResponse)(nil),              // 12: confirmate.evidence.v1.ListResourcesResponse
+	(*ListToolsRequest)(nil),                   // 13: confirmate.evidence.v1.ListToolsRequest
+	(*ListToolsResponse)(nil),                  // 14: confirmate.evidence.v1.ListToolsResponse
+	(*WatchEvidencesRequest)(nil),              // 15: confirmate.evidence.v1.WatchEvidencesRequest
+	(*EvidenceEvent)(nil),                      // 16: confirmate.evidence.v1.EvidenceEvent
+	(*ReidentifyPseudonymsRequest)(nil),        // 17: confirmate.evidence.v1.ReidentifyPseudonymsRequest
+	(*ReidentifyPseudonymsResponse)(nil),       // 18: confirmate.evidence.v1.ReidentifyPseudonymsResponse
+	(*CreateCollectorRequest)(nil),             // 19: confirmate.evidence.v1.CreateCollectorRequest
+	(*CreateCollectorResponse)(nil),            // 20: confirmate.evidence.v1.CreateCollectorResponse
+	(*ListCollectorsRequest)(nil),              // 21: confirmate.evidence.v1.ListCollectorsRequest
+	(*ListCollectorsResponse)(nil),             // 22: confirmate.evidence.v1.ListCollectorsResponse
+	(*UpdateCollectorRequest)(nil),             // 23: confirmate.evidence.v1.UpdateCollectorRequest
+	(*RemoveCollectorRequest)(nil),             // 24: confirmate.evidence.v1.RemoveCollectorRequest
+	(*RotateCollectorTokenRequest)(nil),        // 25: confirmate.evidence.v1.RotateCollectorTokenRequest
+	(*RotateCollectorTokenResponse)(nil),       // 26: confirmate.evidence.v1.RotateCollectorTokenResponse
+	(*ListCollectorUsageRequest)(nil),          // 27: confirmate.evidence.v1.ListCollectorUsageRequest
+	(*ListCollectorUsageResponse)(nil),         // 28: confirmate.evidence.v1.ListCollectorUsageResponse
+	(*CollectorUsage)(nil),                     // 29: confirmate.evidence.v1.CollectorUsage
+	(*ListResourcesRequest_Filter)(nil),        // 30: confirmate.evidence.v1.ListResourcesRequest.Filter
+	nil,                                        // 31: confirmate.evidence.v1.ReidentifyPseudonymsResponse.ValuesEntry
+	(*Evidence)(nil),                           // 32: confirmate.evidence.v1.Evidence
+	(*timestamppb.Timestamp)(nil),              // 33: google.protobuf.Timestamp
+	(*ResourceSnapshot)(nil),                   // 34: confirmate.evidence.v1.ResourceSnapshot
+	(*Collector)(nil),                          // 35: confirmate.evidence.v1.Collector
+	(*emptypb.Empty)(nil),                      // 36: google.protobuf.Empty
 }
 var file_api_evidence_evidence_store_proto_depIdxs = []int32{
-	15, // 0: confirmate.evidence.v1.StoreEvidenceRequest.evidence:type_name -> confirmate.evidence.v1.Evidence
+	32, // 0: confirmate.evidence.v1.StoreEvidenceRequest.evidence:type_name -> confirmate.evidence.v1.Evidence
 	0,  // 1: confirmate.evidence.v1.StoreEvidencesResponse.status:type_name -> confirmate.evidence.v1.EvidenceStatus
-	5,  // 2: confirmate.evidence.v1.ListEvidencesRequest.filter:type_name -> confirmate.evidence.v1.Filter
-	15, // 3: confirmate.evidence.v1.ListEvidencesResponse.evidences:type_name -> confirmate.evidence.v1.Evidence
-	14, // 4: confirmate.evidence.v1.ListResourcesRequest.filter:type_name -> confirmate.evidence.v1.ListResourcesRequest.Filter
-	16, // 5: confirmate.evidence.v1.ListResourcesResponse.results:type_name -> confirmate.evidence.v1.ResourceSnapshot
-	1,  // 6: confirmate.evidence.v1.EvidenceStore.StoreEvidence:input_type -> confirmate.evidence.v1.StoreEvidenceRequest
-	1,  // 7: confirmate.evidence.v1.EvidenceStore.StoreEvidences:input_type -> confirmate.evidence.v1.StoreEvidenceRequest
-	4,  // 8: confirmate.evidence.v1.EvidenceStore.ListEvidences:input_type -> confirmate.evidence.v1.ListEvidencesRequest
-	7,  // 9: confirmate.evidence.v1.EvidenceStore.GetEvidence:input_type -> confirmate.evidence.v1.GetEvidenceRequest
-	8,  // 10: confirmate.evidence.v1.EvidenceStore.ListSupportedResourceTypes:input_type -> confirmate.evidence.v1.ListSupportedResourceTypesRequest
-	10, // 11: confirmate.evidence.v1.EvidenceStore.ListResources:input_type -> confirmate.evidence.v1.ListResourcesRequest
-	12, // 12: confirmate.evidence.v1.EvidenceStore.ListTools:input_type -> confirmate.evidence.v1.ListToolsRequest
-	2,  // 13: confirmate.evidence.v1.EvidenceStore.StoreEvidence:output_type -> confirmate.evidence.v1.StoreEvidenceResponse
-	3,  // 14: confirmate.evidence.v1.EvidenceStore.StoreEvidences:output_type -> confirmate.evidence.v1.StoreEvidencesResponse
-	6,  // 15: confirmate.evidence.v1.EvidenceStore.ListEvidences:output_type -> confirmate.evidence.v1.ListEvidencesResponse
-	15, // 16: confirmate.evidence.v1.EvidenceStore.GetEvidence:output_type -> confirmate.evidence.v1.Evidence
-	9,  // 17: confirmate.evidence.v1.EvidenceStore.ListSupportedResourceTypes:output_type -> confirmate.evidence.v1.ListSupportedResourceTypesResponse
-	11, // 18: confirmate.evidence.v1.EvidenceStore.ListResources:output_type -> confirmate.evidence.v1.ListResourcesResponse
-	13, // 19: confirmate.evidence.v1.EvidenceStore.ListTools:output_type -> confirmate.evidence.v1.ListToolsResponse
-	13, // [13:20] is the sub-list for method output_type
-	6,  // [6:13] is the sub-list for method input_type
-	6,  // [6:6] is the sub-list for extension type_name
-	6,  // [6:6] is the sub-list for extension extendee
-	0,  // [0:6] is the sub-list for field type_name
+	6,  // 2: confirmate.evidence.v1.ListEvidencesRequest.filter:type_name -> confirmate.evidence.v1.Filter
+	33, // 3: confirmate.evidence.v1.Filter.timestamp_after:type_name -> google.protobuf.Timestamp
+	33, // 4: confirmate.evidence.v1.Filter.timestamp_before:type_name -> google.protobuf.Timestamp
+	32, // 5: confirmate.evidence.v1.ListEvidencesResponse.evidences:type_name -> confirmate.evidence.v1.Evidence
+	30, // 6: confirmate.evidence.v1.ListResourcesRequest.filter:type_name -> confirmate.evidence.v1.ListResourcesRequest.Filter
+	34, // 7: confirmate.evidence.v1.ListResourcesResponse.results:type_name -> confirmate.evidence.v1.ResourceSnapshot
+	6,  // 8: confirmate.evidence.v1.WatchEvidencesRequest.filter:type_name -> confirmate.evidence.v1.Filter
+	1,  // 9: confirmate.evidence.v1.EvidenceEvent.type:type_name -> confirmate.evidence.v1.EvidenceEventType
+	32, // 10: confirmate.evidence.v1.EvidenceEvent.evidence:type_name -> confirmate.evidence.v1.Evidence
+	33, // 11: confirmate.evidence.v1.EvidenceEvent.timestamp:type_name -> google.protobuf.Timestamp
+	31, // 12: confirmate.evidence.v1.ReidentifyPseudonymsResponse.values:type_name -> confirmate.evidence.v1.ReidentifyPseudonymsResponse.ValuesEntry
+	35, // 13: confirmate.evidence.v1.CreateCollectorRequest.collector:type_name -> confirmate.evidence.v1.Collector
+	35, // 14: confirmate.evidence.v1.CreateCollectorResponse.collector:type_name -> confirmate.evidence.v1.Collector
+	35, // 15: confirmate.evidence.v1.ListCollectorsResponse.collectors:type_name -> confirmate.evidence.v1.Collector
+	35, // 16: confirmate.evidence.v1.UpdateCollectorRequest.collector:type_name -> confirmate.evidence.v1.Collector
+	29, // 17: confirmate.evidence.v1.ListCollectorUsageResponse.usage:type_name -> confirmate.evidence.v1.CollectorUsage
+	33, // 18: confirmate.evidence.v1.CollectorUsage.last_seen_at:type_name -> google.protobuf.Timestamp
+	2,  // 19: confirmate.evidence.v1.EvidenceStore.StoreEvidence:input_type -> confirmate.evidence.v1.StoreEvidenceRequest
+	2,  // 20: confirmate.evidence.v1.EvidenceStore.StoreEvidences:input_type -> confirmate.evidence.v1.StoreEvidenceRequest
+	5,  // 21: confirmate.evidence.v1.EvidenceStore.ListEvidences:input_type -> confirmate.evidence.v1.ListEvidencesRequest
+	8,  // 22: confirmate.evidence.v1.EvidenceStore.GetEvidence:input_type -> confirmate.evidence.v1.GetEvidenceRequest
+	9,  // 23: confirmate.evidence.v1.EvidenceStore.ListSupportedResourceTypes:input_type -> confirmate.evidence.v1.ListSupportedResourceTypesRequest
+	11, // 24: confirmate.evidence.v1.EvidenceStore.ListResources:input_type -> confirmate.evidence.v1.ListResourcesRequest
+	13, // 25: confirmate.evidence.v1.EvidenceStore.ListTools:input_type -> confirmate.evidence.v1.ListToolsRequest
+	15, // 26: confirmate.evidence.v1.EvidenceStore.WatchEvidences:input_type -> confirmate.evidence.v1.WatchEvidencesRequest
+	17, // 27: confirmate.evidence.v1.EvidenceStore.ReidentifyPseudonyms:input_type -> confirmate.evidence.v1.ReidentifyPseudonymsRequest
+	19, // 28: confirmate.evidence.v1.EvidenceStore.CreateCollector:input_type -> confirmate.evidence.v1.CreateCollectorRequest
+	21, // 29: confirmate.evidence.v1.EvidenceStore.ListCollectors:input_type -> confirmate.evidence.v1.ListCollectorsRequest
+	23, // 30: confirmate.evidence.v1.EvidenceStore.UpdateCollector:input_type -> confirmate.evidence.v1.UpdateCollectorRequest
+	24, // 31: confirmate.evidence.v1.EvidenceStore.RemoveCollector:input_type -> confirmate.evidence.v1.RemoveCollectorRequest
+	25, // 32: confirmate.evidence.v1.EvidenceStore.RotateCollectorToken:input_type -> confirmate.evidence.v1.RotateCollectorTokenRequest
+	27, // 33: confirmate.evidence.v1.EvidenceStore.ListCollectorUsage:input_type -> confirmate.evidence.v1.ListCollectorUsageRequest
+	3,  // 34: confirmate.evidence.v1.EvidenceStore.StoreEvidence:output_type -> confirmate.evidence.v1.StoreEvidenceResponse
+	4,  // 35: confirmate.evidence.v1.EvidenceStore.StoreEvidences:output_type -> confirmate.evidence.v1.StoreEvidencesResponse
+	7,  // 36: confirmate.evidence.v1.EvidenceStore.ListEvidences:output_type -> confirmate.evidence.v1.ListEvidencesResponse
+	32, // 37: confirmate.evidence.v1.EvidenceStore.GetEvidence:output_type -> confirmate.evidence.v1.Evidence
+	10, // 38: confirmate.evidence.v1.EvidenceStore.ListSupportedResourceTypes:output_type -> confirmate.evidence.v1.ListSupportedResourceTypesResponse
+	12, // 39: confirmate.evidence.v1.EvidenceStore.ListResources:output_type -> confirmate.evidence.v1.ListResourcesResponse
+	14, // 40: confirmate.evidence.v1.EvidenceStore.ListTools:output_type -> confirmate.evidence.v1.ListToolsResponse
+	16, // 41: confirmate.evidence.v1.EvidenceStore.WatchEvidences:output_type -> confirmate.evidence.v1.EvidenceEvent
+	18, // 42: confirmate.evidence.v1.EvidenceStore.ReidentifyPseudonyms:output_type -> confirmate.evidence.v1.ReidentifyPseudonymsResponse
+	20, // 43: confirmate.evidence.v1.EvidenceStore.CreateCollector:output_type -> confirmate.evidence.v1.CreateCollectorResponse
+	22, // 44: confirmate.evidence.v1.EvidenceStore.ListCollectors:output_type -> confirmate.evidence.v1.ListCollectorsResponse
+	35, // 45: confirmate.evidence.v1.EvidenceStore.UpdateCollector:output_type -> confirmate.evidence.v1.Collector
+	36, // 46: confirmate.evidence.v1.EvidenceStore.RemoveCollector:output_type -> google.protobuf.Empty
+	26, // 47: confirmate.evidence.v1.EvidenceStore.RotateCollectorToken:output_type -> confirmate.evidence.v1.RotateCollectorTokenResponse
+	28, // 48: confirmate.evidence.v1.EvidenceStore.ListCollectorUsage:output_type -> confirmate.evidence.v1.ListCollectorUsageResponse
+	34, // [34:49] is the sub-list for method output_type
+	19, // [19:34] is the sub-list for method input_type
+	19, // [19:19] is the sub-list for extension type_name
+	19, // [19:19] is the sub-list for extension extendee
+	0,  // [0:19] is the sub-list for field type_name
 }
 
 func init() { file_api_evidence_evidence_store_proto_init() }
@@ -945,13 +1910,15 @@ func file_api_evidence_evidence_store_proto_init() {
 	file_api_evidence_evidence_store_proto_msgTypes[4].OneofWrappers = []any{}
 	file_api_evidence_evidence_store_proto_msgTypes[9].OneofWrappers = []any{}
 	file_api_evidence_evidence_store_proto_msgTypes[13].OneofWrappers = []any{}
+	file_api_evidence_evidence_store_proto_msgTypes[27].OneofWrappers = []any{}
+	file_api_evidence_evidence_store_proto_msgTypes[28].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_evidence_evidence_store_proto_rawDesc), len(file_api_evidence_evidence_store_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   14,
+			NumEnums:      2,
+			NumMessages:   30,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
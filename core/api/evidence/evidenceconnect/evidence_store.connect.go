@@ -23,6 +23,7 @@ import (
 	connect "connectrpc.com/connect"
 	context "context"
 	errors "errors"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
 	http "net/http"
 	strings "strings"
 )
@@ -67,6 +68,30 @@ const (
 	EvidenceStoreListResourcesProcedure = "/confirmate.evidence.v1.EvidenceStore/ListResources"
 	// EvidenceStoreListToolsProcedure is the fully-qualified name of the EvidenceStore's ListTools RPC.
 	EvidenceStoreListToolsProcedure = "/confirmate.evidence.v1.EvidenceStore/ListTools"
+	// EvidenceStoreWatchEvidencesProcedure is the fully-qualified name of the EvidenceStore's
+	// WatchEvidences RPC.
+	EvidenceStoreWatchEvidencesProcedure = "/confirmate.evidence.v1.EvidenceStore/WatchEvidences"
+	// EvidenceStoreReidentifyPseudonymsProcedure is the fully-qualified name of the EvidenceStore's
+	// ReidentifyPseudonyms RPC.
+	EvidenceStoreReidentifyPseudonymsProcedure = "/confirmate.evidence.v1.EvidenceStore/ReidentifyPseudonyms"
+	// EvidenceStoreCreateCollectorProcedure is the fully-qualified name of the EvidenceStore's
+	// CreateCollector RPC.
+	EvidenceStoreCreateCollectorProcedure = "/confirmate.evidence.v1.EvidenceStore/CreateCollector"
+	// EvidenceStoreListCollectorsProcedure is the fully-qualified name of the EvidenceStore's
+	// ListCollectors RPC.
+	EvidenceStoreListCollectorsProcedure = "/confirmate.evidence.v1.EvidenceStore/ListCollectors"
+	// EvidenceStoreUpdateCollectorProcedure is the fully-qualified name of the EvidenceStore's
+	// UpdateCollector RPC.
+	EvidenceStoreUpdateCollectorProcedure = "/confirmate.evidence.v1.EvidenceStore/UpdateCollector"
+	// EvidenceStoreRemoveCollectorProcedure is the fully-qualified name of the EvidenceStore's
+	// RemoveCollector RPC.
+	EvidenceStoreRemoveCollectorProcedure = "/confirmate.evidence.v1.EvidenceStore/RemoveCollector"
+	// EvidenceStoreRotateCollectorTokenProcedure is the fully-qualified name of the EvidenceStore's
+	// RotateCollectorToken RPC.
+	EvidenceStoreRotateCollectorTokenProcedure = "/confirmate.evidence.v1.EvidenceStore/RotateCollectorToken"
+	// EvidenceStoreListCollectorUsageProcedure is the fully-qualified name of the EvidenceStore's
+	// ListCollectorUsage RPC.
+	EvidenceStoreListCollectorUsageProcedure = "/confirmate.evidence.v1.EvidenceStore/ListCollectorUsage"
 )
 
 // EvidenceStoreClient is a client for the confirmate.evidence.v1.EvidenceStore service.
@@ -89,6 +114,36 @@ type EvidenceStoreClient interface {
 	// Returns the IDs of all evidence collecting tools that have provided
 	// evidence so far. Part of the public API, also exposed as REST.
 	ListTools(context.Context, *connect.Request[evidence.ListToolsRequest]) (*connect.Response[evidence.ListToolsResponse], error)
+	// Watches the evidence storage and streams an event for each newly stored
+	// evidence, optionally filtered by target of evaluation, tool and resource
+	// type. Part of the public API, not exposed as REST.
+	WatchEvidences(context.Context, *connect.Request[evidence.WatchEvidencesRequest]) (*connect.ServerStreamForClient[evidence.EvidenceEvent], error)
+	// Re-identifies the personal data behind pseudonyms of stored evidences.
+	// Only accessible to privileged users, every re-identification is recorded
+	// in the audit log, which consists of the log records of the evidence store.
+	// Part of the public API, also exposed as REST.
+	ReidentifyPseudonyms(context.Context, *connect.Request[evidence.ReidentifyPseudonymsRequest]) (*connect.Response[evidence.ReidentifyPseudonymsResponse], error)
+	// Creates a collector and issues its token, which is only returned once.
+	// Only accessible to privileged users. Part of the public API, also exposed
+	// as REST.
+	CreateCollector(context.Context, *connect.Request[evidence.CreateCollectorRequest]) (*connect.Response[evidence.CreateCollectorResponse], error)
+	// Lists all collectors. Only accessible to privileged users. Part of the
+	// public API, also exposed as REST.
+	ListCollectors(context.Context, *connect.Request[evidence.ListCollectorsRequest]) (*connect.Response[evidence.ListCollectorsResponse], error)
+	// Updates the name, description and quotas of a collector. Only accessible
+	// to privileged users. Part of the public API, also exposed as REST.
+	UpdateCollector(context.Context, *connect.Request[evidence.UpdateCollectorRequest]) (*connect.Response[evidence.Collector], error)
+	// Removes a collector, which revokes its token. Only accessible to
+	// privileged users. Part of the public API, also exposed as REST.
+	RemoveCollector(context.Context, *connect.Request[evidence.RemoveCollectorRequest]) (*connect.Response[emptypb.Empty], error)
+	// Issues a new token for a collector, which replaces its previous token.
+	// Only accessible to privileged users. Part of the public API, also exposed
+	// as REST.
+	RotateCollectorToken(context.Context, *connect.Request[evidence.RotateCollectorTokenRequest]) (*connect.Response[evidence.RotateCollectorTokenResponse], error)
+	// Returns the usage statistics of all collectors since the start of the
+	// evidence store. Only accessible to privileged users. Part of the public
+	// API, also exposed as REST.
+	ListCollectorUsage(context.Context, *connect.Request[evidence.ListCollectorUsageRequest]) (*connect.Response[evidence.ListCollectorUsageResponse], error)
 }
 
 // NewEvidenceStoreClient constructs a client for the confirmate.evidence.v1.EvidenceStore service.
@@ -144,6 +199,54 @@ func NewEvidenceStoreClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(evidenceStoreMethods.ByName("ListTools")),
 			connect.WithClientOptions(opts...),
 		),
+		watchEvidences: connect.NewClient[evidence.WatchEvidencesRequest, evidence.EvidenceEvent](
+			httpClient,
+			baseURL+EvidenceStoreWatchEvidencesProcedure,
+			connect.WithSchema(evidenceStoreMethods.ByName("WatchEvidences")),
+			connect.WithClientOptions(opts...),
+		),
+		reidentifyPseudonyms: connect.NewClient[evidence.ReidentifyPseudonymsRequest, evidence.ReidentifyPseudonymsResponse](
+			httpClient,
+			baseURL+EvidenceStoreReidentifyPseudonymsProcedure,
+			connect.WithSchema(evidenceStoreMethods.ByName("ReidentifyPseudonyms")),
+			connect.WithClientOptions(opts...),
+		),
+		createCollector: connect.NewClient[evidence.CreateCollectorRequest, evidence.CreateCollectorResponse](
+			httpClient,
+			baseURL+EvidenceStoreCreateCollectorProcedure,
+			connect.WithSchema(evidenceStoreMethods.ByName("CreateCollector")),
+			connect.WithClientOptions(opts...),
+		),
+		listCollectors: connect.NewClient[evidence.ListCollectorsRequest, evidence.ListCollectorsResponse](
+			httpClient,
+			baseURL+EvidenceStoreListCollectorsProcedure,
+			connect.WithSchema(evidenceStoreMethods.ByName("ListCollectors")),
+			connect.WithClientOptions(opts...),
+		),
+		updateCollector: connect.NewClient[evidence.UpdateCollectorRequest, evidence.Collector](
+			httpClient,
+			baseURL+EvidenceStoreUpdateCollectorProcedure,
+			connect.WithSchema(evidenceStoreMethods.ByName("UpdateCollector")),
+			connect.WithClientOptions(opts...),
+		),
+		removeCollector: connect.NewClient[evidence.RemoveCollectorRequest, emptypb.Empty](
+			httpClient,
+			baseURL+EvidenceStoreRemoveCollectorProcedure,
+			connect.WithSchema(evidenceStoreMethods.ByName("RemoveCollector")),
+			connect.WithClientOptions(opts...),
+		),
+		rotateCollectorToken: connect.NewClient[evidence.RotateCollectorTokenRequest, evidence.RotateCollectorTokenResponse](
+			httpClient,
+			baseURL+EvidenceStoreRotateCollectorTokenProcedure,
+			connect.WithSchema(evidenceStoreMethods.ByName("RotateCollectorToken")),
+			connect.WithClientOptions(opts...),
+		),
+		listCollectorUsage: connect.NewClient[evidence.ListCollectorUsageRequest, evidence.ListCollectorUsageResponse](
+			httpClient,
+			baseURL+EvidenceStoreListCollectorUsageProcedure,
+			connect.WithSchema(evidenceStoreMethods.ByName("ListCollectorUsage")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
@@ -156,6 +259,14 @@ type evidenceStoreClient struct {
 	listSupportedResourceTypes *connect.Client[evidence.ListSupportedResourceTypesRequest, evidence.ListSupportedResourceTypesResponse]
 	listResources              *connect.Client[evidence.ListResourcesRequest, evidence.ListResourcesResponse]
 	listTools                  *connect.Client[evidence.ListToolsRequest, evidence.ListToolsResponse]
+	watchEvidences             *connect.Client[evidence.WatchEvidencesRequest, evidence.EvidenceEvent]
+	reidentifyPseudonyms       *connect.Client[evidence.ReidentifyPseudonymsRequest, evidence.ReidentifyPseudonymsResponse]
+	createCollector            *connect.Client[evidence.CreateCollectorRequest, evidence.CreateCollectorResponse]
+	listCollectors             *connect.Client[evidence.ListCollectorsRequest, evidence.ListCollectorsResponse]
+	updateCollector            *connect.Client[evidence.UpdateCollectorRequest, evidence.Collector]
+	removeCollector            *connect.Client[evidence.RemoveCollectorRequest, emptypb.Empty]
+	rotateCollectorToken       *connect.Client[evidence.RotateCollectorTokenRequest, evidence.RotateCollectorTokenResponse]
+	listCollectorUsage         *connect.Client[evidence.ListCollectorUsageRequest, evidence.ListCollectorUsageResponse]
 }
 
 // StoreEvidence calls confirmate.evidence.v1.EvidenceStore.StoreEvidence.
@@ -193,6 +304,46 @@ func (c *evidenceStoreClient) ListTools(ctx context.Context, req *connect.Reques
 	return c.listTools.CallUnary(ctx, req)
 }
 
+// WatchEvidences calls confirmate.evidence.v1.EvidenceStore.WatchEvidences.
+func (c *evidenceStoreClient) WatchEvidences(ctx context.Context, req *connect.Request[evidence.WatchEvidencesRequest]) (*connect.ServerStreamForClient[evidence.EvidenceEvent], error) {
+	return c.watchEvidences.CallServerStream(ctx, req)
+}
+
+// ReidentifyPseudonyms calls confirmate.evidence.v1.EvidenceStore.ReidentifyPseudonyms.
+func (c *evidenceStoreClient) ReidentifyPseudonyms(ctx context.Context, req *connect.Request[evidence.ReidentifyPseudonymsRequest]) (*connect.Response[evidence.ReidentifyPseudonymsResponse], error) {
+	return c.reidentifyPseudonyms.CallUnary(ctx, req)
+}
+
+// CreateCollector calls confirmate.evidence.v1.EvidenceStore.CreateCollector.
+func (c *evidenceStoreClient) CreateCollector(ctx context.Context, req *connect.Request[evidence.CreateCollectorRequest]) (*connect.Response[evidence.CreateCollectorResponse], error) {
+	return c.createCollector.CallUnary(ctx, req)
+}
+
+// ListCollectors calls confirmate.evidence.v1.EvidenceStore.ListCollectors.
+func (c *evidenceStoreClient) ListCollectors(ctx context.Context, req *connect.Request[evidence.ListCollectorsRequest]) (*connect.Response[evidence.ListCollectorsResponse], error) {
+	return c.listCollectors.CallUnary(ctx, req)
+}
+
+// UpdateCollector calls confirmate.evidence.v1.EvidenceStore.UpdateCollector.
+func (c *evidenceStoreClient) UpdateCollector(ctx context.Context, req *connect.Request[evidence.UpdateCollectorRequest]) (*connect.Response[evidence.Collector], error) {
+	return c.updateCollector.CallUnary(ctx, req)
+}
+
+// RemoveCollector calls confirmate.evidence.v1.EvidenceStore.RemoveCollector.
+func (c *evidenceStoreClient) RemoveCollector(ctx context.Context, req *connect.Request[evidence.RemoveCollectorRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.removeCollector.CallUnary(ctx, req)
+}
+
+// RotateCollectorToken calls confirmate.evidence.v1.EvidenceStore.RotateCollectorToken.
+func (c *evidenceStoreClient) RotateCollectorToken(ctx context.Context, req *connect.Request[evidence.RotateCollectorTokenRequest]) (*connect.Response[evidence.RotateCollectorTokenResponse], error) {
+	return c.rotateCollectorToken.CallUnary(ctx, req)
+}
+
+// ListCollectorUsage calls confirmate.evidence.v1.EvidenceStore.ListCollectorUsage.
+func (c *evidenceStoreClient) ListCollectorUsage(ctx context.Context, req *connect.Request[evidence.ListCollectorUsageRequest]) (*connect.Response[evidence.ListCollectorUsageResponse], error) {
+	return c.listCollectorUsage.CallUnary(ctx, req)
+}
+
 // EvidenceStoreHandler is an implementation of the confirmate.evidence.v1.EvidenceStore service.
 type EvidenceStoreHandler interface {
 	// Stores an evidence to the evidence storage. Part of the public API, also
@@ -213,6 +364,36 @@ type EvidenceStoreHandler interface {
 	// Returns the IDs of all evidence collecting tools that have provided
 	// evidence so far. Part of the public API, also exposed as REST.
 	ListTools(context.Context, *connect.Request[evidence.ListToolsRequest]) (*connect.Response[evidence.ListToolsResponse], error)
+	// Watches the evidence storage and streams an event for each newly stored
+	// evidence, optionally filtered by target of evaluation, tool and resource
+	// type. Part of the public API, not exposed as REST.
+	WatchEvidences(context.Context, *connect.Request[evidence.WatchEvidencesRequest], *connect.ServerStream[evidence.EvidenceEvent]) error
+	// Re-identifies the personal data behind pseudonyms of stored evidences.
+	// Only accessible to privileged users, every re-identification is recorded
+	// in the audit log, which consists of the log records of the evidence store.
+	// Part of the public API, also exposed as REST.
+	ReidentifyPseudonyms(context.Context, *connect.Request[evidence.ReidentifyPseudonymsRequest]) (*connect.Response[evidence.ReidentifyPseudonymsResponse], error)
+	// Creates a collector and issues its token, which is only returned once.
+	// Only accessible to privileged users. Part of the public API, also exposed
+	// as REST.
+	CreateCollector(context.Context, *connect.Request[evidence.CreateCollectorRequest]) (*connect.Response[evidence.CreateCollectorResponse], error)
+	// Lists all collectors. Only accessible to privileged users. Part of the
+	// public API, also exposed as REST.
+	ListCollectors(context.Context, *connect.Request[evidence.ListCollectorsRequest]) (*connect.Response[evidence.ListCollectorsResponse], error)
+	// Updates the name, description and quotas of a collector. Only accessible
+	// to privileged users. Part of the public API, also exposed as REST.
+	UpdateCollector(context.Context, *connect.Request[evidence.UpdateCollectorRequest]) (*connect.Response[evidence.Collector], error)
+	// Removes a collector, which revokes its token. Only accessible to
+	// privileged users. Part of the public API, also exposed as REST.
+	RemoveCollector(context.Context, *connect.Request[evidence.RemoveCollectorRequest]) (*connect.Response[emptypb.Empty], error)
+	// Issues a new token for a collector, which replaces its previous token.
+	// Only accessible to privileged users. Part of the public API, also exposed
+	// as REST.
+	RotateCollectorToken(context.Context, *connect.Request[evidence.RotateCollectorTokenRequest]) (*connect.Response[evidence.RotateCollectorTokenResponse], error)
+	// Returns the usage statistics of all collectors since the start of the
+	// evidence store. Only accessible to privileged users. Part of the public
+	// API, also exposed as REST.
+	ListCollectorUsage(context.Context, *connect.Request[evidence.ListCollectorUsageRequest]) (*connect.Response[evidence.ListCollectorUsageResponse], error)
 }
 
 // NewEvidenceStoreHandler builds an HTTP handler from the service implementation. It returns the
@@ -264,6 +445,54 @@ func NewEvidenceStoreHandler(svc EvidenceStoreHandler, opts ...connect.HandlerOp
 		connect.WithSchema(evidenceStoreMethods.ByName("ListTools")),
 		connect.WithHandlerOptions(opts...),
 	)
+	evidenceStoreWatchEvidencesHandler := connect.NewServerStreamHandler(
+		EvidenceStoreWatchEvidencesProcedure,
+		svc.WatchEvidences,
+		connect.WithSchema(evidenceStoreMethods.ByName("WatchEvidences")),
+		connect.WithHandlerOptions(opts...),
+	)
+	evidenceStoreReidentifyPseudonymsHandler := connect.NewUnaryHandler(
+		EvidenceStoreReidentifyPseudonymsProcedure,
+		svc.ReidentifyPseudonyms,
+		connect.WithSchema(evidenceStoreMethods.ByName("ReidentifyPseudonyms")),
+		connect.WithHandlerOptions(opts...),
+	)
+	evidenceStoreCreateCollectorHandler := connect.NewUnaryHandler(
+		EvidenceStoreCreateCollectorProcedure,
+		svc.CreateCollector,
+		connect.WithSchema(evidenceStoreMethods.ByName("CreateCollector")),
+		connect.WithHandlerOptions(opts...),
+	)
+	evidenceStoreListCollectorsHandler := connect.NewUnaryHandler(
+		EvidenceStoreListCollectorsProcedure,
+		svc.ListCollectors,
+		connect.WithSchema(evidenceStoreMethods.ByName("ListCollectors")),
+		connect.WithHandlerOptions(opts...),
+	)
+	evidenceStoreUpdateCollectorHandler := connect.NewUnaryHandler(
+		EvidenceStoreUpdateCollectorProcedure,
+		svc.UpdateCollector,
+		connect.WithSchema(evidenceStoreMethods.ByName("UpdateCollector")),
+		connect.WithHandlerOptions(opts...),
+	)
+	evidenceStoreRemoveCollectorHandler := connect.NewUnaryHandler(
+		EvidenceStoreRemoveCollectorProcedure,
+		svc.RemoveCollector,
+		connect.WithSchema(evidenceStoreMethods.ByName("RemoveCollector")),
+		connect.WithHandlerOptions(opts...),
+	)
+	evidenceStoreRotateCollectorTokenHandler := connect.NewUnaryHandler(
+		EvidenceStoreRotateCollectorTokenProcedure,
+		svc.RotateCollectorToken,
+		connect.WithSchema(evidenceStoreMethods.ByName("RotateCollectorToken")),
+		connect.WithHandlerOptions(opts...),
+	)
+	evidenceStoreListCollectorUsageHandler := connect.NewUnaryHandler(
+		EvidenceStoreListCollectorUsageProcedure,
+		svc.ListCollectorUsage,
+		connect.WithSchema(evidenceStoreMethods.ByName("ListCollectorUsage")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/confirmate.evidence.v1.EvidenceStore/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case EvidenceStoreStoreEvidenceProcedure:
@@ -280,6 +509,22 @@ func NewEvidenceStoreHandler(svc EvidenceStoreHandler, opts ...connect.HandlerOp
 			evidenceStoreListResourcesHandler.ServeHTTP(w, r)
 		case EvidenceStoreListToolsProcedure:
 			evidenceStoreListToolsHandler.ServeHTTP(w, r)
+		case EvidenceStoreWatchEvidencesProcedure:
+			evidenceStoreWatchEvidencesHandler.ServeHTTP(w, r)
+		case EvidenceStoreReidentifyPseudonymsProcedure:
+			evidenceStoreReidentifyPseudonymsHandler.ServeHTTP(w, r)
+		case EvidenceStoreCreateCollectorProcedure:
+			evidenceStoreCreateCollectorHandler.ServeHTTP(w, r)
+		case EvidenceStoreListCollectorsProcedure:
+			evidenceStoreListCollectorsHandler.ServeHTTP(w, r)
+		case EvidenceStoreUpdateCollectorProcedure:
+			evidenceStoreUpdateCollectorHandler.ServeHTTP(w, r)
+		case EvidenceStoreRemoveCollectorProcedure:
+			evidenceStoreRemoveCollectorHandler.ServeHTTP(w, r)
+		case EvidenceStoreRotateCollectorTokenProcedure:
+			evidenceStoreRotateCollectorTokenHandler.ServeHTTP(w, r)
+		case EvidenceStoreListCollectorUsageProcedure:
+			evidenceStoreListCollectorUsageHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -316,3 +561,35 @@ func (UnimplementedEvidenceStoreHandler) ListResources(context.Context, *connect
 func (UnimplementedEvidenceStoreHandler) ListTools(context.Context, *connect.Request[evidence.ListToolsRequest]) (*connect.Response[evidence.ListToolsResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("confirmate.evidence.v1.EvidenceStore.ListTools is not implemented"))
 }
+
+func (UnimplementedEvidenceStoreHandler) WatchEvidences(context.Context, *connect.Request[evidence.WatchEvidencesRequest], *connect.ServerStream[evidence.EvidenceEvent]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("confirmate.evidence.v1.EvidenceStore.WatchEvidences is not implemented"))
+}
+
+func (UnimplementedEvidenceStoreHandler) ReidentifyPseudonyms(context.Context, *connect.Request[evidence.ReidentifyPseudonymsRequest]) (*connect.Response[evidence.ReidentifyPseudonymsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("confirmate.evidence.v1.EvidenceStore.ReidentifyPseudonyms is not implemented"))
+}
+
+func (UnimplementedEvidenceStoreHandler) CreateCollector(context.Context, *connect.Request[evidence.CreateCollectorRequest]) (*connect.Response[evidence.CreateCollectorResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("confirmate.evidence.v1.EvidenceStore.CreateCollector is not implemented"))
+}
+
+func (UnimplementedEvidenceStoreHandler) ListCollectors(context.Context, *connect.Request[evidence.ListCollectorsRequest]) (*connect.Response[evidence.ListCollectorsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("confirmate.evidence.v1.EvidenceStore.ListCollectors is not implemented"))
+}
+
+func (UnimplementedEvidenceStoreHandler) UpdateCollector(context.Context, *connect.Request[evidence.UpdateCollectorRequest]) (*connect.Response[evidence.Collector], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("confirmate.evidence.v1.EvidenceStore.UpdateCollector is not implemented"))
+}
+
+func (UnimplementedEvidenceStoreHandler) RemoveCollector(context.Context, *connect.Request[evidence.RemoveCollectorRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("confirmate.evidence.v1.EvidenceStore.RemoveCollector is not implemented"))
+}
+
+func (UnimplementedEvidenceStoreHandler) RotateCollectorToken(context.Context, *connect.Request[evidence.RotateCollectorTokenRequest]) (*connect.Response[evidence.RotateCollectorTokenResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("confirmate.evidence.v1.EvidenceStore.RotateCollectorToken is not implemented"))
+}
+
+func (UnimplementedEvidenceStoreHandler) ListCollectorUsage(context.Context, *connect.Request[evidence.ListCollectorUsageRequest]) (*connect.Response[evidence.ListCollectorUsageResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("confirmate.evidence.v1.EvidenceStore.ListCollectorUsage is not implemented"))
+}
@@ -17,8 +17,18 @@ package orchestrator
 
 import "slices"
 
-// IsRelevantFor checks if the control is relevant for the given audit scope and catalog. This is determined by comparing the assurance levels of the control and the audit scope against the assurance levels defined in the catalog. If the control's assurance level is less than or equal to the audit scope's assurance level, then the control is considered relevant. In the future, this could also include checks, if the control is somehow out of scope.
+// IsRelevantFor checks if the control is relevant for the given audit scope and catalog. If the catalog has an applicability rule for the control, the control is relevant if the rule contains the assurance level of the audit scope. Otherwise, this is determined by comparing the assurance levels of the control and the audit scope against the assurance levels defined in the catalog. If the control's assurance level is less than or equal to the audit scope's assurance level, then the control is considered relevant. In the future, this could also include checks, if the control is somehow out of scope.
 func (c *Control) IsRelevantFor(auditScope *AuditScope, catalog *Catalog) bool {
+	// Applicability rules take precedence over the assurance level of the control. A rule without assurance levels
+	// excludes the control from all audit scopes.
+	if rule := catalog.ApplicabilityRule(c.GetShortName()); rule != nil {
+		if len(rule.AssuranceLevels) == 0 {
+			return false
+		}
+
+		return auditScope.AssuranceLevel == nil || slices.Contains(rule.AssuranceLevels, *auditScope.AssuranceLevel)
+	}
+
 	// If the catalog does not have an assurance level, we are good to go
 	if len(catalog.AssuranceLevels) == 0 {
 		return true
@@ -36,3 +46,57 @@ func (c *Control) IsRelevantFor(auditScope *AuditScope, catalog *Catalog) bool {
 
 	return idxControl <= idxAuditScope
 }
+
+// ApplicabilityRule returns the applicability rule of the control with the given short name or nil, if the catalog
+// has no rule for it.
+func (c *Catalog) ApplicabilityRule(controlShortName string) *ApplicabilityRule {
+	for _, rule := range c.GetApplicabilityRules() {
+		if rule.GetControlShortName() == controlShortName {
+			return rule
+		}
+	}
+
+	return nil
+}
+
+// CustomStatus returns the custom status with the given name or nil, if the catalog does not define it.
+func (c *Catalog) CustomStatus(name string) *CustomStatus {
+	for _, status := range c.GetCustomStatuses() {
+		if status.GetName() == name {
+			return status
+		}
+	}
+
+	return nil
+}
+
+// Matches checks whether the given share of compliant parts lies within the bounds of the rule. A missing bound is not
+// checked.
+func (r *StatusAggregationRule) Matches(share float64) bool {
+	if r.MinCompliantShare != nil && share < r.GetMinCompliantShare() {
+		return false
+	}
+
+	if r.MaxCompliantShare != nil && share > r.GetMaxCompliantShare() {
+		return false
+	}
+
+	return true
+}
+
+// AllCatalogIds returns the IDs of all catalogs of the audit scope, starting with the primary catalog followed by the
+// additional catalogs. Duplicates and empty IDs are omitted.
+func (a *AuditScope) AllCatalogIds() (ids []string) {
+	for _, id := range append([]string{a.GetCatalogId()}, a.GetAdditionalCatalogIds()...) {
+		if id != "" && !slices.Contains(ids, id) {
+			ids = append(ids, id)
+		}
+	}
+
+	return ids
+}
+
+// HasCatalog checks whether the given catalog is evaluated as part of the audit scope.
+func (a *AuditScope) HasCatalog(catalogId string) bool {
+	return slices.Contains(a.AllCatalogIds(), catalogId)
+}
@@ -30,6 +30,7 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	structpb "google.golang.org/protobuf/types/known/structpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
@@ -43,6 +44,116 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// ExternalFindingsFileFormat is the file format of imported external audit
+// findings.
+type ExternalFindingsFileFormat int32
+
+const (
+	ExternalFindingsFileFormat_EXTERNAL_FINDINGS_FILE_FORMAT_UNSPECIFIED ExternalFindingsFileFormat = 0
+	// CSV is a comma-separated values file, whose first row is a header naming
+	// the columns.
+	ExternalFindingsFileFormat_EXTERNAL_FINDINGS_FILE_FORMAT_CSV ExternalFindingsFileFormat = 1
+	// JSON is an array of objects, whose keys are the column names.
+	ExternalFindingsFileFormat_EXTERNAL_FINDINGS_FILE_FORMAT_JSON ExternalFindingsFileFormat = 2
+)
+
+// Enum value maps for ExternalFindingsFileFormat.
+var (
+	ExternalFindingsFileFormat_name = map[int32]string{
+		0: "EXTERNAL_FINDINGS_FILE_FORMAT_UNSPECIFIED",
+		1: "EXTERNAL_FINDINGS_FILE_FORMAT_CSV",
+		2: "EXTERNAL_FINDINGS_FILE_FORMAT_JSON",
+	}
+	ExternalFindingsFileFormat_value = map[string]int32{
+		"EXTERNAL_FINDINGS_FILE_FORMAT_UNSPECIFIED": 0,
+		"EXTERNAL_FINDINGS_FILE_FORMAT_CSV":         1,
+		"EXTERNAL_FINDINGS_FILE_FORMAT_JSON":        2,
+	}
+)
+
+func (x ExternalFindingsFileFormat) Enum() *ExternalFindingsFileFormat {
+	p := new(ExternalFindingsFileFormat)
+	*p = x
+	return p
+}
+
+func (x ExternalFindingsFileFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ExternalFindingsFileFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_orchestrator_orchestrator_proto_enumTypes[0].Descriptor()
+}
+
+func (ExternalFindingsFileFormat) Type() protoreflect.EnumType {
+	return &file_api_orchestrator_orchestrator_proto_enumTypes[0]
+}
+
+func (x ExternalFindingsFileFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ExternalFindingsFileFormat.Descriptor instead.
+func (ExternalFindingsFileFormat) EnumDescriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{0}
+}
+
+// AlertType is the kind of anomaly an alert was raised for.
+type AlertType int32
+
+const (
+	AlertType_ALERT_TYPE_UNSPECIFIED AlertType = 0
+	// The number of assessment results of a target of evaluation dropped
+	// sharply compared to its baseline, e.g., because a collector stopped
+	// working.
+	AlertType_ALERT_TYPE_VOLUME_DROP AlertType = 1
+	// The assessment results of a metric for a resource changed between
+	// compliant and non-compliant unusually often, e.g., because of a policy
+	// regression.
+	AlertType_ALERT_TYPE_FLAPPING AlertType = 2
+)
+
+// Enum value maps for AlertType.
+var (
+	AlertType_name = map[int32]string{
+		0: "ALERT_TYPE_UNSPECIFIED",
+		1: "ALERT_TYPE_VOLUME_DROP",
+		2: "ALERT_TYPE_FLAPPING",
+	}
+	AlertType_value = map[string]int32{
+		"ALERT_TYPE_UNSPECIFIED": 0,
+		"ALERT_TYPE_VOLUME_DROP": 1,
+		"ALERT_TYPE_FLAPPING":    2,
+	}
+)
+
+func (x AlertType) Enum() *AlertType {
+	p := new(AlertType)
+	*p = x
+	return p
+}
+
+func (x AlertType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AlertType) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_orchestrator_orchestrator_proto_enumTypes[1].Descriptor()
+}
+
+func (AlertType) Type() protoreflect.EnumType {
+	return &file_api_orchestrator_orchestrator_proto_enumTypes[1]
+}
+
+func (x AlertType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AlertType.Descriptor instead.
+func (AlertType) EnumDescriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{1}
+}
+
 // Top-level event categories for subscription filtering
 type EventCategory int32
 
@@ -57,21 +168,23 @@ const (
 	EventCategory_EVENT_CATEGORY_ASSESSMENT_TOOL       EventCategory = 7
 	EventCategory_EVENT_CATEGORY_USER                  EventCategory = 8
 	EventCategory_EVENT_CATEGORY_CONTROL_IN_SCOPE      EventCategory = 9
+	EventCategory_EVENT_CATEGORY_CATALOG               EventCategory = 10
 )
 
 // Enum value maps for EventCategory.
 var (
 	EventCategory_name = map[int32]string{
-		0: "EVENT_CATEGORY_UNSPECIFIED",
-		1: "EVENT_CATEGORY_METRIC",
-		2: "EVENT_CATEGORY_METRIC_CONFIGURATION",
-		3: "EVENT_CATEGORY_METRIC_IMPLEMENTATION",
-		4: "EVENT_CATEGORY_TARGET_OF_EVALUATION",
-		5: "EVENT_CATEGORY_AUDIT_SCOPE",
-		6: "EVENT_CATEGORY_ASSESSMENT_RESULT",
-		7: "EVENT_CATEGORY_ASSESSMENT_TOOL",
-		8: "EVENT_CATEGORY_USER",
-		9: "EVENT_CATEGORY_CONTROL_IN_SCOPE",
+		0:  "EVENT_CATEGORY_UNSPECIFIED",
+		1:  "EVENT_CATEGORY_METRIC",
+		2:  "EVENT_CATEGORY_METRIC_CONFIGURATION",
+		3:  "EVENT_CATEGORY_METRIC_IMPLEMENTATION",
+		4:  "EVENT_CATEGORY_TARGET_OF_EVALUATION",
+		5:  "EVENT_CATEGORY_AUDIT_SCOPE",
+		6:  "EVENT_CATEGORY_ASSESSMENT_RESULT",
+		7:  "EVENT_CATEGORY_ASSESSMENT_TOOL",
+		8:  "EVENT_CATEGORY_USER",
+		9:  "EVENT_CATEGORY_CONTROL_IN_SCOPE",
+		10: "EVENT_CATEGORY_CATALOG",
 	}
 	EventCategory_value = map[string]int32{
 		"EVENT_CATEGORY_UNSPECIFIED":           0,
@@ -84,6 +197,7 @@ var (
 		"EVENT_CATEGORY_ASSESSMENT_TOOL":       7,
 		"EVENT_CATEGORY_USER":                  8,
 		"EVENT_CATEGORY_CONTROL_IN_SCOPE":      9,
+		"EVENT_CATEGORY_CATALOG":               10,
 	}
 )
 
@@ -98,11 +212,11 @@ func (x EventCategory) String() string {
 }
 
 func (EventCategory) Descriptor() protoreflect.EnumDescriptor {
-	return file_api_orchestrator_orchestrator_proto_enumTypes[0].Descriptor()
+	return file_api_orchestrator_orchestrator_proto_enumTypes[2].Descriptor()
 }
 
 func (EventCategory) Type() protoreflect.EnumType {
-	return &file_api_orchestrator_orchestrator_proto_enumTypes[0]
+	return &file_api_orchestrator_orchestrator_proto_enumTypes[2]
 }
 
 func (x EventCategory) Number() protoreflect.EnumNumber {
@@ -111,7 +225,7 @@ func (x EventCategory) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use EventCategory.Descriptor instead.
 func (EventCategory) EnumDescriptor() ([]byte, []int) {
-	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{0}
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{2}
 }
 
 // RequestType is a high-level classification of what happened to an entity. It represents the type
@@ -134,6 +248,8 @@ const (
 	RequestType_REQUEST_TYPE_GET RequestType = 6
 	// RequestTypeList indicates that a list of entities was retrieved from the orchestrator.
 	RequestType_REQUEST_TYPE_LIST RequestType = 7
+	// RequestTypePublished indicates that a new version of an entity was published, e.g., of a catalog.
+	RequestType_REQUEST_TYPE_PUBLISHED RequestType = 8
 )
 
 // Enum value maps for RequestType.
@@ -147,6 +263,7 @@ var (
 		5: "REQUEST_TYPE_STORED",
 		6: "REQUEST_TYPE_GET",
 		7: "REQUEST_TYPE_LIST",
+		8: "REQUEST_TYPE_PUBLISHED",
 	}
 	RequestType_value = map[string]int32{
 		"REQUEST_TYPE_UNSPECIFIED": 0,
@@ -157,6 +274,7 @@ var (
 		"REQUEST_TYPE_STORED":      5,
 		"REQUEST_TYPE_GET":         6,
 		"REQUEST_TYPE_LIST":        7,
+		"REQUEST_TYPE_PUBLISHED":   8,
 	}
 )
 
@@ -171,11 +289,11 @@ func (x RequestType) String() string {
 }
 
 func (RequestType) Descriptor() protoreflect.EnumDescriptor {
-	return file_api_orchestrator_orchestrator_proto_enumTypes[1].Descriptor()
+	return file_api_orchestrator_orchestrator_proto_enumTypes[3].Descriptor()
 }
 
 func (RequestType) Type() protoreflect.EnumType {
-	return &file_api_orchestrator_orchestrator_proto_enumTypes[1]
+	return &file_api_orchestrator_orchestrator_proto_enumTypes[3]
 }
 
 func (x RequestType) Number() protoreflect.EnumNumber {
@@ -184,7 +302,337 @@ func (x RequestType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use RequestType.Descriptor instead.
 func (RequestType) EnumDescriptor() ([]byte, []int) {
-	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{1}
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{3}
+}
+
+// MetadataFieldType is the type of the value of a custom metadata field.
+type MetadataFieldType int32
+
+const (
+	MetadataFieldType_METADATA_FIELD_TYPE_UNSPECIFIED MetadataFieldType = 0
+	// any string, optionally matching the pattern of the field
+	MetadataFieldType_METADATA_FIELD_TYPE_STRING MetadataFieldType = 1
+	// an integer, e.g., 42
+	MetadataFieldType_METADATA_FIELD_TYPE_INTEGER MetadataFieldType = 2
+	// a floating point number, e.g., 4.2
+	MetadataFieldType_METADATA_FIELD_TYPE_NUMBER MetadataFieldType = 3
+	// either true or false
+	MetadataFieldType_METADATA_FIELD_TYPE_BOOLEAN MetadataFieldType = 4
+	// one of the allowed values of the field
+	MetadataFieldType_METADATA_FIELD_TYPE_ENUM MetadataFieldType = 5
+	// a date in the format YYYY-MM-DD
+	MetadataFieldType_METADATA_FIELD_TYPE_DATE MetadataFieldType = 6
+)
+
+// Enum value maps for MetadataFieldType.
+var (
+	MetadataFieldType_name = map[int32]string{
+		0: "METADATA_FIELD_TYPE_UNSPECIFIED",
+		1: "METADATA_FIELD_TYPE_STRING",
+		2: "METADATA_FIELD_TYPE_INTEGER",
+		3: "METADATA_FIELD_TYPE_NUMBER",
+		4: "METADATA_FIELD_TYPE_BOOLEAN",
+		5: "METADATA_FIELD_TYPE_ENUM",
+		6: "METADATA_FIELD_TYPE_DATE",
+	}
+	MetadataFieldType_value = map[string]int32{
+		"METADATA_FIELD_TYPE_UNSPECIFIED": 0,
+		"METADATA_FIELD_TYPE_STRING":      1,
+		"METADATA_FIELD_TYPE_INTEGER":     2,
+		"METADATA_FIELD_TYPE_NUMBER":      3,
+		"METADATA_FIELD_TYPE_BOOLEAN":     4,
+		"METADATA_FIELD_TYPE_ENUM":        5,
+		"METADATA_FIELD_TYPE_DATE":        6,
+	}
+)
+
+func (x MetadataFieldType) Enum() *MetadataFieldType {
+	p := new(MetadataFieldType)
+	*p = x
+	return p
+}
+
+func (x MetadataFieldType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MetadataFieldType) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_orchestrator_orchestrator_proto_enumTypes[4].Descriptor()
+}
+
+func (MetadataFieldType) Type() protoreflect.EnumType {
+	return &file_api_orchestrator_orchestrator_proto_enumTypes[4]
+}
+
+func (x MetadataFieldType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MetadataFieldType.Descriptor instead.
+func (MetadataFieldType) EnumDescriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{4}
+}
+
+// RedactionMode defines how sensitive fields are redacted.
+type RedactionMode int32
+
+const (
+	RedactionMode_REDACTION_MODE_UNSPECIFIED RedactionMode = 0
+	// the fields are removed from the resource
+	RedactionMode_REDACTION_MODE_STRIP RedactionMode = 1
+	// the values of the fields are replaced by a mask, so that policies can
+	// still check whether they are set
+	RedactionMode_REDACTION_MODE_MASK RedactionMode = 2
+)
+
+// Enum value maps for RedactionMode.
+var (
+	RedactionMode_name = map[int32]string{
+		0: "REDACTION_MODE_UNSPECIFIED",
+		1: "REDACTION_MODE_STRIP",
+		2: "REDACTION_MODE_MASK",
+	}
+	RedactionMode_value = map[string]int32{
+		"REDACTION_MODE_UNSPECIFIED": 0,
+		"REDACTION_MODE_STRIP":       1,
+		"REDACTION_MODE_MASK":        2,
+	}
+)
+
+func (x RedactionMode) Enum() *RedactionMode {
+	p := new(RedactionMode)
+	*p = x
+	return p
+}
+
+func (x RedactionMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RedactionMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_orchestrator_orchestrator_proto_enumTypes[5].Descriptor()
+}
+
+func (RedactionMode) Type() protoreflect.EnumType {
+	return &file_api_orchestrator_orchestrator_proto_enumTypes[5]
+}
+
+func (x RedactionMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RedactionMode.Descriptor instead.
+func (RedactionMode) EnumDescriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{5}
+}
+
+// CatalogStatus represents the lifecycle status of a catalog.
+type CatalogStatus int32
+
+const (
+	// Unspecified is used by catalogs that pre-date catalog versioning, e.g., the
+	// default catalogs. They are editable and can be bound by audit scopes.
+	CatalogStatus_CATALOG_STATUS_UNSPECIFIED CatalogStatus = 0
+	// Draft catalogs can be edited but not bound by audit scopes.
+	CatalogStatus_CATALOG_STATUS_DRAFT CatalogStatus = 1
+	// Published catalogs are immutable snapshots of a draft and can be bound by
+	// audit scopes.
+	CatalogStatus_CATALOG_STATUS_PUBLISHED CatalogStatus = 2
+)
+
+// Enum value maps for CatalogStatus.
+var (
+	CatalogStatus_name = map[int32]string{
+		0: "CATALOG_STATUS_UNSPECIFIED",
+		1: "CATALOG_STATUS_DRAFT",
+		2: "CATALOG_STATUS_PUBLISHED",
+	}
+	CatalogStatus_value = map[string]int32{
+		"CATALOG_STATUS_UNSPECIFIED": 0,
+		"CATALOG_STATUS_DRAFT":       1,
+		"CATALOG_STATUS_PUBLISHED":   2,
+	}
+)
+
+func (x CatalogStatus) Enum() *CatalogStatus {
+	p := new(CatalogStatus)
+	*p = x
+	return p
+}
+
+func (x CatalogStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CatalogStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_orchestrator_orchestrator_proto_enumTypes[6].Descriptor()
+}
+
+func (CatalogStatus) Type() protoreflect.EnumType {
+	return &file_api_orchestrator_orchestrator_proto_enumTypes[6]
+}
+
+func (x CatalogStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CatalogStatus.Descriptor instead.
+func (CatalogStatus) EnumDescriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{6}
+}
+
+// CatalogSourceFormat is the format of the content of a catalog source.
+type CatalogSourceFormat int32
+
+const (
+	CatalogSourceFormat_CATALOG_SOURCE_FORMAT_UNSPECIFIED CatalogSourceFormat = 0
+	// JSON is a JSON array of catalogs, as used by the default catalogs.
+	CatalogSourceFormat_CATALOG_SOURCE_FORMAT_JSON CatalogSourceFormat = 1
+)
+
+// Enum value maps for CatalogSourceFormat.
+var (
+	CatalogSourceFormat_name = map[int32]string{
+		0: "CATALOG_SOURCE_FORMAT_UNSPECIFIED",
+		1: "CATALOG_SOURCE_FORMAT_JSON",
+	}
+	CatalogSourceFormat_value = map[string]int32{
+		"CATALOG_SOURCE_FORMAT_UNSPECIFIED": 0,
+		"CATALOG_SOURCE_FORMAT_JSON":        1,
+	}
+)
+
+func (x CatalogSourceFormat) Enum() *CatalogSourceFormat {
+	p := new(CatalogSourceFormat)
+	*p = x
+	return p
+}
+
+func (x CatalogSourceFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CatalogSourceFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_orchestrator_orchestrator_proto_enumTypes[7].Descriptor()
+}
+
+func (CatalogSourceFormat) Type() protoreflect.EnumType {
+	return &file_api_orchestrator_orchestrator_proto_enumTypes[7]
+}
+
+func (x CatalogSourceFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CatalogSourceFormat.Descriptor instead.
+func (CatalogSourceFormat) EnumDescriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{7}
+}
+
+// CatalogChangelogTrigger is the operation that generated a changelog of a
+// catalog.
+type CatalogChangelogTrigger int32
+
+const (
+	CatalogChangelogTrigger_CATALOG_CHANGELOG_TRIGGER_UNSPECIFIED CatalogChangelogTrigger = 0
+	// The draft of the catalog was re-imported from its catalog source.
+	CatalogChangelogTrigger_CATALOG_CHANGELOG_TRIGGER_REIMPORTED CatalogChangelogTrigger = 1
+	// The draft of the catalog was published as a new version.
+	CatalogChangelogTrigger_CATALOG_CHANGELOG_TRIGGER_PUBLISHED CatalogChangelogTrigger = 2
+)
+
+// Enum value maps for CatalogChangelogTrigger.
+var (
+	CatalogChangelogTrigger_name = map[int32]string{
+		0: "CATALOG_CHANGELOG_TRIGGER_UNSPECIFIED",
+		1: "CATALOG_CHANGELOG_TRIGGER_REIMPORTED",
+		2: "CATALOG_CHANGELOG_TRIGGER_PUBLISHED",
+	}
+	CatalogChangelogTrigger_value = map[string]int32{
+		"CATALOG_CHANGELOG_TRIGGER_UNSPECIFIED": 0,
+		"CATALOG_CHANGELOG_TRIGGER_REIMPORTED":  1,
+		"CATALOG_CHANGELOG_TRIGGER_PUBLISHED":   2,
+	}
+)
+
+func (x CatalogChangelogTrigger) Enum() *CatalogChangelogTrigger {
+	p := new(CatalogChangelogTrigger)
+	*p = x
+	return p
+}
+
+func (x CatalogChangelogTrigger) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CatalogChangelogTrigger) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_orchestrator_orchestrator_proto_enumTypes[8].Descriptor()
+}
+
+func (CatalogChangelogTrigger) Type() protoreflect.EnumType {
+	return &file_api_orchestrator_orchestrator_proto_enumTypes[8]
+}
+
+func (x CatalogChangelogTrigger) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CatalogChangelogTrigger.Descriptor instead.
+func (CatalogChangelogTrigger) EnumDescriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{8}
+}
+
+// ControlChangeType is the kind of change of a control in a catalog changelog.
+type ControlChangeType int32
+
+const (
+	ControlChangeType_CONTROL_CHANGE_TYPE_UNSPECIFIED ControlChangeType = 0
+	ControlChangeType_CONTROL_CHANGE_TYPE_ADDED       ControlChangeType = 1
+	ControlChangeType_CONTROL_CHANGE_TYPE_REMOVED     ControlChangeType = 2
+	ControlChangeType_CONTROL_CHANGE_TYPE_CHANGED     ControlChangeType = 3
+)
+
+// Enum value maps for ControlChangeType.
+var (
+	ControlChangeType_name = map[int32]string{
+		0: "CONTROL_CHANGE_TYPE_UNSPECIFIED",
+		1: "CONTROL_CHANGE_TYPE_ADDED",
+		2: "CONTROL_CHANGE_TYPE_REMOVED",
+		3: "CONTROL_CHANGE_TYPE_CHANGED",
+	}
+	ControlChangeType_value = map[string]int32{
+		"CONTROL_CHANGE_TYPE_UNSPECIFIED": 0,
+		"CONTROL_CHANGE_TYPE_ADDED":       1,
+		"CONTROL_CHANGE_TYPE_REMOVED":     2,
+		"CONTROL_CHANGE_TYPE_CHANGED":     3,
+	}
+)
+
+func (x ControlChangeType) Enum() *ControlChangeType {
+	p := new(ControlChangeType)
+	*p = x
+	return p
+}
+
+func (x ControlChangeType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ControlChangeType) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_orchestrator_orchestrator_proto_enumTypes[9].Descriptor()
+}
+
+func (ControlChangeType) Type() protoreflect.EnumType {
+	return &file_api_orchestrator_orchestrator_proto_enumTypes[9]
+}
+
+func (x ControlChangeType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ControlChangeType.Descriptor instead.
+func (ControlChangeType) EnumDescriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{9}
 }
 
 // AuditScopeStatus represents the lifecycle status of an audit scope.
@@ -235,11 +683,11 @@ func (x AuditScopeStatus) String() string {
 }
 
 func (AuditScopeStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_api_orchestrator_orchestrator_proto_enumTypes[2].Descriptor()
+	return file_api_orchestrator_orchestrator_proto_enumTypes[10].Descriptor()
 }
 
 func (AuditScopeStatus) Type() protoreflect.EnumType {
-	return &file_api_orchestrator_orchestrator_proto_enumTypes[2]
+	return &file_api_orchestrator_orchestrator_proto_enumTypes[10]
 }
 
 func (x AuditScopeStatus) Number() protoreflect.EnumNumber {
@@ -248,64 +696,231 @@ func (x AuditScopeStatus) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use AuditScopeStatus.Descriptor instead.
 func (AuditScopeStatus) EnumDescriptor() ([]byte, []int) {
-	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{2}
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{10}
 }
 
-// TargetType represents the type of the target of evaluation.
-type TargetOfEvaluation_TargetType int32
+// MaintenanceMode defines how evaluation behaves during a maintenance window.
+type MaintenanceMode int32
 
 const (
-	TargetOfEvaluation_TARGET_TYPE_UNSPECIFIED  TargetOfEvaluation_TargetType = 0
-	TargetOfEvaluation_TARGET_TYPE_CLOUD        TargetOfEvaluation_TargetType = 1
-	TargetOfEvaluation_TARGET_TYPE_PRODUCT      TargetOfEvaluation_TargetType = 2
-	TargetOfEvaluation_TARGET_TYPE_ORGANIZATION TargetOfEvaluation_TargetType = 3
+	MaintenanceMode_MAINTENANCE_MODE_UNSPECIFIED MaintenanceMode = 0
+	// Suppress indicates that no evaluation runs take place during the window.
+	MaintenanceMode_MAINTENANCE_MODE_SUPPRESS MaintenanceMode = 1
+	// Flag indicates that evaluation runs take place, but their results are
+	// flagged as "during maintenance".
+	MaintenanceMode_MAINTENANCE_MODE_FLAG MaintenanceMode = 2
 )
 
-// Enum value maps for TargetOfEvaluation_TargetType.
+// Enum value maps for MaintenanceMode.
 var (
-	TargetOfEvaluation_TargetType_name = map[int32]string{
-		0: "TARGET_TYPE_UNSPECIFIED",
-		1: "TARGET_TYPE_CLOUD",
-		2: "TARGET_TYPE_PRODUCT",
-		3: "TARGET_TYPE_ORGANIZATION",
+	MaintenanceMode_name = map[int32]string{
+		0: "MAINTENANCE_MODE_UNSPECIFIED",
+		1: "MAINTENANCE_MODE_SUPPRESS",
+		2: "MAINTENANCE_MODE_FLAG",
 	}
-	TargetOfEvaluation_TargetType_value = map[string]int32{
-		"TARGET_TYPE_UNSPECIFIED":  0,
-		"TARGET_TYPE_CLOUD":        1,
-		"TARGET_TYPE_PRODUCT":      2,
-		"TARGET_TYPE_ORGANIZATION": 3,
+	MaintenanceMode_value = map[string]int32{
+		"MAINTENANCE_MODE_UNSPECIFIED": 0,
+		"MAINTENANCE_MODE_SUPPRESS":    1,
+		"MAINTENANCE_MODE_FLAG":        2,
 	}
 )
 
-func (x TargetOfEvaluation_TargetType) Enum() *TargetOfEvaluation_TargetType {
-	p := new(TargetOfEvaluation_TargetType)
+func (x MaintenanceMode) Enum() *MaintenanceMode {
+	p := new(MaintenanceMode)
 	*p = x
 	return p
 }
 
-func (x TargetOfEvaluation_TargetType) String() string {
+func (x MaintenanceMode) String() string {
 	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (TargetOfEvaluation_TargetType) Descriptor() protoreflect.EnumDescriptor {
-	return file_api_orchestrator_orchestrator_proto_enumTypes[3].Descriptor()
+func (MaintenanceMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_orchestrator_orchestrator_proto_enumTypes[11].Descriptor()
 }
 
-func (TargetOfEvaluation_TargetType) Type() protoreflect.EnumType {
-	return &file_api_orchestrator_orchestrator_proto_enumTypes[3]
+func (MaintenanceMode) Type() protoreflect.EnumType {
+	return &file_api_orchestrator_orchestrator_proto_enumTypes[11]
 }
 
-func (x TargetOfEvaluation_TargetType) Number() protoreflect.EnumNumber {
+func (x MaintenanceMode) Number() protoreflect.EnumNumber {
 	return protoreflect.EnumNumber(x)
 }
 
-// Deprecated: Use TargetOfEvaluation_TargetType.Descriptor instead.
-func (TargetOfEvaluation_TargetType) EnumDescriptor() ([]byte, []int) {
-	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{35, 0}
+// Deprecated: Use MaintenanceMode.Descriptor instead.
+func (MaintenanceMode) EnumDescriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{11}
 }
 
-type RegisterAssessmentToolRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+type SchemaRelationshipType int32
+
+const (
+	SchemaRelationshipType_SCHEMA_RELATIONSHIP_TYPE_UNSPECIFIED  SchemaRelationshipType = 0
+	SchemaRelationshipType_SCHEMA_RELATIONSHIP_TYPE_HAS_ONE      SchemaRelationshipType = 1
+	SchemaRelationshipType_SCHEMA_RELATIONSHIP_TYPE_HAS_MANY     SchemaRelationshipType = 2
+	SchemaRelationshipType_SCHEMA_RELATIONSHIP_TYPE_BELONGS_TO   SchemaRelationshipType = 3
+	SchemaRelationshipType_SCHEMA_RELATIONSHIP_TYPE_MANY_TO_MANY SchemaRelationshipType = 4
+	// The entity holds the ID of the related entity in a column, but the
+	// database does not enforce the relationship with a foreign key constraint.
+	SchemaRelationshipType_SCHEMA_RELATIONSHIP_TYPE_REFERENCES SchemaRelationshipType = 5
+)
+
+// Enum value maps for SchemaRelationshipType.
+var (
+	SchemaRelationshipType_name = map[int32]string{
+		0: "SCHEMA_RELATIONSHIP_TYPE_UNSPECIFIED",
+		1: "SCHEMA_RELATIONSHIP_TYPE_HAS_ONE",
+		2: "SCHEMA_RELATIONSHIP_TYPE_HAS_MANY",
+		3: "SCHEMA_RELATIONSHIP_TYPE_BELONGS_TO",
+		4: "SCHEMA_RELATIONSHIP_TYPE_MANY_TO_MANY",
+		5: "SCHEMA_RELATIONSHIP_TYPE_REFERENCES",
+	}
+	SchemaRelationshipType_value = map[string]int32{
+		"SCHEMA_RELATIONSHIP_TYPE_UNSPECIFIED":  0,
+		"SCHEMA_RELATIONSHIP_TYPE_HAS_ONE":      1,
+		"SCHEMA_RELATIONSHIP_TYPE_HAS_MANY":     2,
+		"SCHEMA_RELATIONSHIP_TYPE_BELONGS_TO":   3,
+		"SCHEMA_RELATIONSHIP_TYPE_MANY_TO_MANY": 4,
+		"SCHEMA_RELATIONSHIP_TYPE_REFERENCES":   5,
+	}
+)
+
+func (x SchemaRelationshipType) Enum() *SchemaRelationshipType {
+	p := new(SchemaRelationshipType)
+	*p = x
+	return p
+}
+
+func (x SchemaRelationshipType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SchemaRelationshipType) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_orchestrator_orchestrator_proto_enumTypes[12].Descriptor()
+}
+
+func (SchemaRelationshipType) Type() protoreflect.EnumType {
+	return &file_api_orchestrator_orchestrator_proto_enumTypes[12]
+}
+
+func (x SchemaRelationshipType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SchemaRelationshipType.Descriptor instead.
+func (SchemaRelationshipType) EnumDescriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{12}
+}
+
+// MappingFileFormat is the file format of an imported control-to-metric
+// mapping.
+type MappingFileFormat int32
+
+const (
+	MappingFileFormat_MAPPING_FILE_FORMAT_UNSPECIFIED MappingFileFormat = 0
+	// CSV is a comma-separated values file.
+	MappingFileFormat_MAPPING_FILE_FORMAT_CSV MappingFileFormat = 1
+	// XLSX is an Office Open XML spreadsheet. Only its first worksheet is
+	// imported.
+	MappingFileFormat_MAPPING_FILE_FORMAT_XLSX MappingFileFormat = 2
+)
+
+// Enum value maps for MappingFileFormat.
+var (
+	MappingFileFormat_name = map[int32]string{
+		0: "MAPPING_FILE_FORMAT_UNSPECIFIED",
+		1: "MAPPING_FILE_FORMAT_CSV",
+		2: "MAPPING_FILE_FORMAT_XLSX",
+	}
+	MappingFileFormat_value = map[string]int32{
+		"MAPPING_FILE_FORMAT_UNSPECIFIED": 0,
+		"MAPPING_FILE_FORMAT_CSV":         1,
+		"MAPPING_FILE_FORMAT_XLSX":        2,
+	}
+)
+
+func (x MappingFileFormat) Enum() *MappingFileFormat {
+	p := new(MappingFileFormat)
+	*p = x
+	return p
+}
+
+func (x MappingFileFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MappingFileFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_orchestrator_orchestrator_proto_enumTypes[13].Descriptor()
+}
+
+func (MappingFileFormat) Type() protoreflect.EnumType {
+	return &file_api_orchestrator_orchestrator_proto_enumTypes[13]
+}
+
+func (x MappingFileFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MappingFileFormat.Descriptor instead.
+func (MappingFileFormat) EnumDescriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{13}
+}
+
+// TargetType represents the type of the target of evaluation.
+type TargetOfEvaluation_TargetType int32
+
+const (
+	TargetOfEvaluation_TARGET_TYPE_UNSPECIFIED  TargetOfEvaluation_TargetType = 0
+	TargetOfEvaluation_TARGET_TYPE_CLOUD        TargetOfEvaluation_TargetType = 1
+	TargetOfEvaluation_TARGET_TYPE_PRODUCT      TargetOfEvaluation_TargetType = 2
+	TargetOfEvaluation_TARGET_TYPE_ORGANIZATION TargetOfEvaluation_TargetType = 3
+)
+
+// Enum value maps for TargetOfEvaluation_TargetType.
+var (
+	TargetOfEvaluation_TargetType_name = map[int32]string{
+		0: "TARGET_TYPE_UNSPECIFIED",
+		1: "TARGET_TYPE_CLOUD",
+		2: "TARGET_TYPE_PRODUCT",
+		3: "TARGET_TYPE_ORGANIZATION",
+	}
+	TargetOfEvaluation_TargetType_value = map[string]int32{
+		"TARGET_TYPE_UNSPECIFIED":  0,
+		"TARGET_TYPE_CLOUD":        1,
+		"TARGET_TYPE_PRODUCT":      2,
+		"TARGET_TYPE_ORGANIZATION": 3,
+	}
+)
+
+func (x TargetOfEvaluation_TargetType) Enum() *TargetOfEvaluation_TargetType {
+	p := new(TargetOfEvaluation_TargetType)
+	*p = x
+	return p
+}
+
+func (x TargetOfEvaluation_TargetType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TargetOfEvaluation_TargetType) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_orchestrator_orchestrator_proto_enumTypes[14].Descriptor()
+}
+
+func (TargetOfEvaluation_TargetType) Type() protoreflect.EnumType {
+	return &file_api_orchestrator_orchestrator_proto_enumTypes[14]
+}
+
+func (x TargetOfEvaluation_TargetType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TargetOfEvaluation_TargetType.Descriptor instead.
+func (TargetOfEvaluation_TargetType) EnumDescriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{107, 0}
+}
+
+type RegisterAssessmentToolRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
 	Tool          *AssessmentTool        `protobuf:"bytes,1,opt,name=tool,proto3" json:"tool,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -747,27 +1362,29 @@ func (x *StoreAssessmentResultsResponse) GetStatusMessage() string {
 	return ""
 }
 
-type StoreEvaluationResultRequest struct {
-	state         protoimpl.MessageState       `protogen:"open.v1"`
-	Result        *evaluation.EvaluationResult `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+type BatchStoreAssessmentResultsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The assessment results to store. The results are validated individually,
+	// so that an invalid result does not prevent the others from being stored.
+	Results       []*assessment.AssessmentResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StoreEvaluationResultRequest) Reset() {
-	*x = StoreEvaluationResultRequest{}
+func (x *BatchStoreAssessmentResultsRequest) Reset() {
+	*x = BatchStoreAssessmentResultsRequest{}
 	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StoreEvaluationResultRequest) String() string {
+func (x *BatchStoreAssessmentResultsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StoreEvaluationResultRequest) ProtoMessage() {}
+func (*BatchStoreAssessmentResultsRequest) ProtoMessage() {}
 
-func (x *StoreEvaluationResultRequest) ProtoReflect() protoreflect.Message {
+func (x *BatchStoreAssessmentResultsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -779,45 +1396,44 @@ func (x *StoreEvaluationResultRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StoreEvaluationResultRequest.ProtoReflect.Descriptor instead.
-func (*StoreEvaluationResultRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use BatchStoreAssessmentResultsRequest.ProtoReflect.Descriptor instead.
+func (*BatchStoreAssessmentResultsRequest) Descriptor() ([]byte, []int) {
 	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *StoreEvaluationResultRequest) GetResult() *evaluation.EvaluationResult {
+func (x *BatchStoreAssessmentResultsRequest) GetResults() []*assessment.AssessmentResult {
 	if x != nil {
-		return x.Result
+		return x.Results
 	}
 	return nil
 }
 
-type ListEvaluationResultsRequest struct {
-	state  protoimpl.MessageState               `protogen:"open.v1"`
-	Filter *ListEvaluationResultsRequest_Filter `protobuf:"bytes,1,opt,name=filter,proto3,oneof" json:"filter,omitempty"`
-	// Optional. Latest results grouped by control_id.
-	LatestByControlId *bool  `protobuf:"varint,2,opt,name=latest_by_control_id,json=latestByControlId,proto3,oneof" json:"latest_by_control_id,omitempty"`
-	PageSize          int32  `protobuf:"varint,10,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	PageToken         string `protobuf:"bytes,11,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
-	OrderBy           string `protobuf:"bytes,12,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
-	Asc               bool   `protobuf:"varint,13,opt,name=asc,proto3" json:"asc,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+// BatchStoreAssessmentResultsResponse belongs to BatchStoreAssessmentResults.
+// It contains the status of each result in the same order as the results of
+// the request.
+type BatchStoreAssessmentResultsResponse struct {
+	state   protoimpl.MessageState            `protogen:"open.v1"`
+	Results []*StoreAssessmentResultsResponse `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	// The number of results that have been stored.
+	Stored        int32 `protobuf:"varint,2,opt,name=stored,proto3" json:"stored,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListEvaluationResultsRequest) Reset() {
-	*x = ListEvaluationResultsRequest{}
+func (x *BatchStoreAssessmentResultsResponse) Reset() {
+	*x = BatchStoreAssessmentResultsResponse{}
 	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListEvaluationResultsRequest) String() string {
+func (x *BatchStoreAssessmentResultsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListEvaluationResultsRequest) ProtoMessage() {}
+func (*BatchStoreAssessmentResultsResponse) ProtoMessage() {}
 
-func (x *ListEvaluationResultsRequest) ProtoReflect() protoreflect.Message {
+func (x *BatchStoreAssessmentResultsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -829,75 +1445,50 @@ func (x *ListEvaluationResultsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListEvaluationResultsRequest.ProtoReflect.Descriptor instead.
-func (*ListEvaluationResultsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use BatchStoreAssessmentResultsResponse.ProtoReflect.Descriptor instead.
+func (*BatchStoreAssessmentResultsResponse) Descriptor() ([]byte, []int) {
 	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *ListEvaluationResultsRequest) GetFilter() *ListEvaluationResultsRequest_Filter {
+func (x *BatchStoreAssessmentResultsResponse) GetResults() []*StoreAssessmentResultsResponse {
 	if x != nil {
-		return x.Filter
+		return x.Results
 	}
 	return nil
 }
 
-func (x *ListEvaluationResultsRequest) GetLatestByControlId() bool {
-	if x != nil && x.LatestByControlId != nil {
-		return *x.LatestByControlId
-	}
-	return false
-}
-
-func (x *ListEvaluationResultsRequest) GetPageSize() int32 {
+func (x *BatchStoreAssessmentResultsResponse) GetStored() int32 {
 	if x != nil {
-		return x.PageSize
+		return x.Stored
 	}
 	return 0
 }
 
-func (x *ListEvaluationResultsRequest) GetPageToken() string {
-	if x != nil {
-		return x.PageToken
-	}
-	return ""
-}
-
-func (x *ListEvaluationResultsRequest) GetOrderBy() string {
-	if x != nil {
-		return x.OrderBy
-	}
-	return ""
-}
-
-func (x *ListEvaluationResultsRequest) GetAsc() bool {
-	if x != nil {
-		return x.Asc
-	}
-	return false
-}
-
-type ListEvaluationResultsResponse struct {
-	state         protoimpl.MessageState         `protogen:"open.v1"`
-	Results       []*evaluation.EvaluationResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
-	NextPageToken string                         `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+type WaiveAssessmentResultRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	AssessmentResultId string                 `protobuf:"bytes,1,opt,name=assessment_result_id,json=assessmentResultId,proto3" json:"assessment_result_id,omitempty"`
+	// Justification why the non-compliance is accepted
+	Justification string `protobuf:"bytes,2,opt,name=justification,proto3" json:"justification,omitempty"`
+	// Optional time the waiver expires. If not set, the waiver does not expire.
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expires_at,json=expiresAt,proto3,oneof" json:"expires_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListEvaluationResultsResponse) Reset() {
-	*x = ListEvaluationResultsResponse{}
+func (x *WaiveAssessmentResultRequest) Reset() {
+	*x = WaiveAssessmentResultRequest{}
 	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListEvaluationResultsResponse) String() string {
+func (x *WaiveAssessmentResultRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListEvaluationResultsResponse) ProtoMessage() {}
+func (*WaiveAssessmentResultRequest) ProtoMessage() {}
 
-func (x *ListEvaluationResultsResponse) ProtoReflect() protoreflect.Message {
+func (x *WaiveAssessmentResultRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -909,46 +1500,53 @@ func (x *ListEvaluationResultsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListEvaluationResultsResponse.ProtoReflect.Descriptor instead.
-func (*ListEvaluationResultsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use WaiveAssessmentResultRequest.ProtoReflect.Descriptor instead.
+func (*WaiveAssessmentResultRequest) Descriptor() ([]byte, []int) {
 	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *ListEvaluationResultsResponse) GetResults() []*evaluation.EvaluationResult {
+func (x *WaiveAssessmentResultRequest) GetAssessmentResultId() string {
 	if x != nil {
-		return x.Results
+		return x.AssessmentResultId
 	}
-	return nil
+	return ""
 }
 
-func (x *ListEvaluationResultsResponse) GetNextPageToken() string {
+func (x *WaiveAssessmentResultRequest) GetJustification() string {
 	if x != nil {
-		return x.NextPageToken
+		return x.Justification
 	}
 	return ""
 }
 
-type CreateMetricRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Metric        *assessment.Metric     `protobuf:"bytes,1,opt,name=metric,proto3" json:"metric,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *WaiveAssessmentResultRequest) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
 }
 
-func (x *CreateMetricRequest) Reset() {
-	*x = CreateMetricRequest{}
+type RevokeAssessmentResultWaiverRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	AssessmentResultId string                 `protobuf:"bytes,1,opt,name=assessment_result_id,json=assessmentResultId,proto3" json:"assessment_result_id,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *RevokeAssessmentResultWaiverRequest) Reset() {
+	*x = RevokeAssessmentResultWaiverRequest{}
 	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateMetricRequest) String() string {
+func (x *RevokeAssessmentResultWaiverRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateMetricRequest) ProtoMessage() {}
+func (*RevokeAssessmentResultWaiverRequest) ProtoMessage() {}
 
-func (x *CreateMetricRequest) ProtoReflect() protoreflect.Message {
+func (x *RevokeAssessmentResultWaiverRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -960,39 +1558,57 @@ func (x *CreateMetricRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateMetricRequest.ProtoReflect.Descriptor instead.
-func (*CreateMetricRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RevokeAssessmentResultWaiverRequest.ProtoReflect.Descriptor instead.
+func (*RevokeAssessmentResultWaiverRequest) Descriptor() ([]byte, []int) {
 	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *CreateMetricRequest) GetMetric() *assessment.Metric {
+func (x *RevokeAssessmentResultWaiverRequest) GetAssessmentResultId() string {
 	if x != nil {
-		return x.Metric
+		return x.AssessmentResultId
 	}
-	return nil
+	return ""
 }
 
-type UpdateMetricRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Metric        *assessment.Metric     `protobuf:"bytes,1,opt,name=metric,proto3" json:"metric,omitempty"`
+type ImportExternalFindingsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The target of evaluation the findings belong to
+	TargetOfEvaluationId string `protobuf:"bytes,1,opt,name=target_of_evaluation_id,json=targetOfEvaluationId,proto3" json:"target_of_evaluation_id,omitempty"`
+	// The catalog whose controls the findings are mapped to
+	CatalogId string `protobuf:"bytes,2,opt,name=catalog_id,json=catalogId,proto3" json:"catalog_id,omitempty"`
+	// The external audit the findings originate from, e.g., "Pentest 2026-Q3".
+	// It is used as the tool ID of the assessment results.
+	Source string `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`
+	// The format of the file
+	Format ExternalFindingsFileFormat `protobuf:"varint,4,opt,name=format,proto3,enum=confirmate.orchestrator.v1.ExternalFindingsFileFormat" json:"format,omitempty"`
+	// The content of the file. Each finding consists of the columns
+	// "control_id", "compliant" and "comment" and, optionally, "metric_id",
+	// "resource_id", "reference", "severity" and "environment". A control is
+	// either referenced by its ID or its short name, e.g., "OPS-01". Without a
+	// metric ID, the finding applies to all metrics of the control. Without a
+	// resource ID, the reference of the finding is used as resource ID, so that
+	// a re-imported finding supersedes its previous result.
+	Data []byte `protobuf:"bytes,5,opt,name=data,proto3" json:"data,omitempty"`
+	// If set, the findings are only validated, but not imported
+	DryRun        bool `protobuf:"varint,6,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateMetricRequest) Reset() {
-	*x = UpdateMetricRequest{}
+func (x *ImportExternalFindingsRequest) Reset() {
+	*x = ImportExternalFindingsRequest{}
 	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateMetricRequest) String() string {
+func (x *ImportExternalFindingsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateMetricRequest) ProtoMessage() {}
+func (*ImportExternalFindingsRequest) ProtoMessage() {}
 
-func (x *UpdateMetricRequest) ProtoReflect() protoreflect.Message {
+func (x *ImportExternalFindingsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1004,39 +1620,83 @@ func (x *UpdateMetricRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateMetricRequest.ProtoReflect.Descriptor instead.
-func (*UpdateMetricRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ImportExternalFindingsRequest.ProtoReflect.Descriptor instead.
+func (*ImportExternalFindingsRequest) Descriptor() ([]byte, []int) {
 	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *UpdateMetricRequest) GetMetric() *assessment.Metric {
+func (x *ImportExternalFindingsRequest) GetTargetOfEvaluationId() string {
 	if x != nil {
-		return x.Metric
+		return x.TargetOfEvaluationId
+	}
+	return ""
+}
+
+func (x *ImportExternalFindingsRequest) GetCatalogId() string {
+	if x != nil {
+		return x.CatalogId
+	}
+	return ""
+}
+
+func (x *ImportExternalFindingsRequest) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *ImportExternalFindingsRequest) GetFormat() ExternalFindingsFileFormat {
+	if x != nil {
+		return x.Format
+	}
+	return ExternalFindingsFileFormat_EXTERNAL_FINDINGS_FILE_FORMAT_UNSPECIFIED
+}
+
+func (x *ImportExternalFindingsRequest) GetData() []byte {
+	if x != nil {
+		return x.Data
 	}
 	return nil
 }
 
-type GetMetricRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	MetricId      string                 `protobuf:"bytes,1,opt,name=metric_id,json=metricId,proto3" json:"metric_id,omitempty"`
+func (x *ImportExternalFindingsRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type ImportExternalFindingsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The number of findings in the file, excluding the header
+	Findings int32 `protobuf:"varint,1,opt,name=findings,proto3" json:"findings,omitempty"`
+	// The IDs of the assessment results that were created. It is empty if the
+	// findings were not imported.
+	AssessmentResultIds []string `protobuf:"bytes,2,rep,name=assessment_result_ids,json=assessmentResultIds,proto3" json:"assessment_result_ids,omitempty"`
+	// The problems found in the file. For a JSON file, row 2 refers to the first
+	// finding of the array, as if the file had a header.
+	Issues []*ImportIssue `protobuf:"bytes,3,rep,name=issues,proto3" json:"issues,omitempty"`
+	// Whether the findings were imported
+	Applied       bool `protobuf:"varint,4,opt,name=applied,proto3" json:"applied,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetMetricRequest) Reset() {
-	*x = GetMetricRequest{}
+func (x *ImportExternalFindingsResponse) Reset() {
+	*x = ImportExternalFindingsResponse{}
 	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetMetricRequest) String() string {
+func (x *ImportExternalFindingsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMetricRequest) ProtoMessage() {}
+func (*ImportExternalFindingsResponse) ProtoMessage() {}
 
-func (x *GetMetricRequest) ProtoReflect() protoreflect.Message {
+func (x *ImportExternalFindingsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1048,43 +1708,60 @@ func (x *GetMetricRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMetricRequest.ProtoReflect.Descriptor instead.
-func (*GetMetricRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ImportExternalFindingsResponse.ProtoReflect.Descriptor instead.
+func (*ImportExternalFindingsResponse) Descriptor() ([]byte, []int) {
 	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *GetMetricRequest) GetMetricId() string {
+func (x *ImportExternalFindingsResponse) GetFindings() int32 {
 	if x != nil {
-		return x.MetricId
+		return x.Findings
 	}
-	return ""
+	return 0
 }
 
-type ListMetricsRequest struct {
-	state         protoimpl.MessageState     `protogen:"open.v1"`
-	Filter        *ListMetricsRequest_Filter `protobuf:"bytes,1,opt,name=filter,proto3,oneof" json:"filter,omitempty"`
-	PageSize      int32                      `protobuf:"varint,10,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	PageToken     string                     `protobuf:"bytes,11,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
-	OrderBy       string                     `protobuf:"bytes,12,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
-	Asc           bool                       `protobuf:"varint,13,opt,name=asc,proto3" json:"asc,omitempty"`
+func (x *ImportExternalFindingsResponse) GetAssessmentResultIds() []string {
+	if x != nil {
+		return x.AssessmentResultIds
+	}
+	return nil
+}
+
+func (x *ImportExternalFindingsResponse) GetIssues() []*ImportIssue {
+	if x != nil {
+		return x.Issues
+	}
+	return nil
+}
+
+func (x *ImportExternalFindingsResponse) GetApplied() bool {
+	if x != nil {
+		return x.Applied
+	}
+	return false
+}
+
+type StoreEvaluationResultRequest struct {
+	state         protoimpl.MessageState       `protogen:"open.v1"`
+	Result        *evaluation.EvaluationResult `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListMetricsRequest) Reset() {
-	*x = ListMetricsRequest{}
+func (x *StoreEvaluationResultRequest) Reset() {
+	*x = StoreEvaluationResultRequest{}
 	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListMetricsRequest) String() string {
+func (x *StoreEvaluationResultRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListMetricsRequest) ProtoMessage() {}
+func (*StoreEvaluationResultRequest) ProtoMessage() {}
 
-func (x *ListMetricsRequest) ProtoReflect() protoreflect.Message {
+func (x *StoreEvaluationResultRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1096,68 +1773,140 @@ func (x *ListMetricsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListMetricsRequest.ProtoReflect.Descriptor instead.
-func (*ListMetricsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use StoreEvaluationResultRequest.ProtoReflect.Descriptor instead.
+func (*StoreEvaluationResultRequest) Descriptor() ([]byte, []int) {
 	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *ListMetricsRequest) GetFilter() *ListMetricsRequest_Filter {
+func (x *StoreEvaluationResultRequest) GetResult() *evaluation.EvaluationResult {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type ListEvaluationResultsRequest struct {
+	state  protoimpl.MessageState               `protogen:"open.v1"`
+	Filter *ListEvaluationResultsRequest_Filter `protobuf:"bytes,1,opt,name=filter,proto3,oneof" json:"filter,omitempty"`
+	// Optional. Latest results grouped by control_id.
+	LatestByControlId *bool `protobuf:"varint,2,opt,name=latest_by_control_id,json=latestByControlId,proto3,oneof" json:"latest_by_control_id,omitempty"`
+	// Optional. Lists the evaluation results matching the filter of the given
+	// saved view. The fields set in filter take precedence over the ones of the
+	// saved view.
+	SavedViewId *string `protobuf:"bytes,3,opt,name=saved_view_id,json=savedViewId,proto3,oneof" json:"saved_view_id,omitempty"`
+	PageSize    int32   `protobuf:"varint,10,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken   string  `protobuf:"bytes,11,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Optional. If not given, the results are ordered by catalog, category,
+	// control and sub-control, so that listings of the same results are
+	// reproducible regardless of the order in which they were stored.
+	OrderBy       string `protobuf:"bytes,12,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	Asc           bool   `protobuf:"varint,13,opt,name=asc,proto3" json:"asc,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEvaluationResultsRequest) Reset() {
+	*x = ListEvaluationResultsRequest{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEvaluationResultsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEvaluationResultsRequest) ProtoMessage() {}
+
+func (x *ListEvaluationResultsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEvaluationResultsRequest.ProtoReflect.Descriptor instead.
+func (*ListEvaluationResultsRequest) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListEvaluationResultsRequest) GetFilter() *ListEvaluationResultsRequest_Filter {
 	if x != nil {
 		return x.Filter
 	}
 	return nil
 }
 
-func (x *ListMetricsRequest) GetPageSize() int32 {
+func (x *ListEvaluationResultsRequest) GetLatestByControlId() bool {
+	if x != nil && x.LatestByControlId != nil {
+		return *x.LatestByControlId
+	}
+	return false
+}
+
+func (x *ListEvaluationResultsRequest) GetSavedViewId() string {
+	if x != nil && x.SavedViewId != nil {
+		return *x.SavedViewId
+	}
+	return ""
+}
+
+func (x *ListEvaluationResultsRequest) GetPageSize() int32 {
 	if x != nil {
 		return x.PageSize
 	}
 	return 0
 }
 
-func (x *ListMetricsRequest) GetPageToken() string {
+func (x *ListEvaluationResultsRequest) GetPageToken() string {
 	if x != nil {
 		return x.PageToken
 	}
 	return ""
 }
 
-func (x *ListMetricsRequest) GetOrderBy() string {
+func (x *ListEvaluationResultsRequest) GetOrderBy() string {
 	if x != nil {
 		return x.OrderBy
 	}
 	return ""
 }
 
-func (x *ListMetricsRequest) GetAsc() bool {
+func (x *ListEvaluationResultsRequest) GetAsc() bool {
 	if x != nil {
 		return x.Asc
 	}
 	return false
 }
 
-type RemoveMetricRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	MetricId      string                 `protobuf:"bytes,1,opt,name=metric_id,json=metricId,proto3" json:"metric_id,omitempty"`
+type ListEvaluationResultsResponse struct {
+	state         protoimpl.MessageState         `protogen:"open.v1"`
+	Results       []*evaluation.EvaluationResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	NextPageToken string                         `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RemoveMetricRequest) Reset() {
-	*x = RemoveMetricRequest{}
-	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[16]
+func (x *ListEvaluationResultsResponse) Reset() {
+	*x = ListEvaluationResultsResponse{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RemoveMetricRequest) String() string {
+func (x *ListEvaluationResultsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveMetricRequest) ProtoMessage() {}
+func (*ListEvaluationResultsResponse) ProtoMessage() {}
 
-func (x *RemoveMetricRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[16]
+func (x *ListEvaluationResultsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1168,41 +1917,66 @@ func (x *RemoveMetricRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveMetricRequest.ProtoReflect.Descriptor instead.
-func (*RemoveMetricRequest) Descriptor() ([]byte, []int) {
-	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use ListEvaluationResultsResponse.ProtoReflect.Descriptor instead.
+func (*ListEvaluationResultsResponse) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *RemoveMetricRequest) GetMetricId() string {
+func (x *ListEvaluationResultsResponse) GetResults() []*evaluation.EvaluationResult {
 	if x != nil {
-		return x.MetricId
+		return x.Results
+	}
+	return nil
+}
+
+func (x *ListEvaluationResultsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
 	}
 	return ""
 }
 
-type ListMetricsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Metrics       []*assessment.Metric   `protobuf:"bytes,1,rep,name=metrics,proto3" json:"metrics,omitempty"`
-	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+// A SavedView is a named filter of evaluation results, e.g., to offer
+// persistent dashboards in a UI. It is owned by the user that created it and
+// can be shared with all other users.
+type SavedView struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Saved view ID
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty" gorm:"primaryKey"`
+	// The name of the view, e.g., "Open findings in production"
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// An optional description of the view
+	Description *string `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	// The filter of the evaluation results shown in the view
+	Filter *ListEvaluationResultsRequest_Filter `protobuf:"bytes,4,opt,name=filter,proto3" json:"filter,omitempty" gorm:"serializer:json"`
+	// Whether the view is visible to all users. Only the owner can update or
+	// remove a shared view.
+	Shared bool `protobuf:"varint,5,opt,name=shared,proto3" json:"shared,omitempty"`
+	// The user that owns the view
+	OwnerId string `protobuf:"bytes,6,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	// Creation time of the view
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty" gorm:"serializer:timestamppb;type:timestamp"`
+	// Time of the last update of the view
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty" gorm:"serializer:timestamppb;type:timestamp"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListMetricsResponse) Reset() {
-	*x = ListMetricsResponse{}
-	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[17]
+func (x *SavedView) Reset() {
+	*x = SavedView{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListMetricsResponse) String() string {
+func (x *SavedView) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListMetricsResponse) ProtoMessage() {}
+func (*SavedView) ProtoMessage() {}
 
-func (x *ListMetricsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[17]
+func (x *SavedView) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1213,90 +1987,88 @@ func (x *ListMetricsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListMetricsResponse.ProtoReflect.Descriptor instead.
-func (*ListMetricsResponse) Descriptor() ([]byte, []int) {
-	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use SavedView.ProtoReflect.Descriptor instead.
+func (*SavedView) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *ListMetricsResponse) GetMetrics() []*assessment.Metric {
+func (x *SavedView) GetId() string {
 	if x != nil {
-		return x.Metrics
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-func (x *ListMetricsResponse) GetNextPageToken() string {
+func (x *SavedView) GetName() string {
 	if x != nil {
-		return x.NextPageToken
+		return x.Name
 	}
 	return ""
 }
 
-type GetTargetOfEvaluationRequest struct {
-	state                protoimpl.MessageState `protogen:"open.v1"`
-	TargetOfEvaluationId string                 `protobuf:"bytes,1,opt,name=target_of_evaluation_id,json=targetOfEvaluationId,proto3" json:"target_of_evaluation_id,omitempty"`
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
+func (x *SavedView) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
 }
 
-func (x *GetTargetOfEvaluationRequest) Reset() {
-	*x = GetTargetOfEvaluationRequest{}
-	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[18]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *SavedView) GetFilter() *ListEvaluationResultsRequest_Filter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
 }
 
-func (x *GetTargetOfEvaluationRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *SavedView) GetShared() bool {
+	if x != nil {
+		return x.Shared
+	}
+	return false
 }
 
-func (*GetTargetOfEvaluationRequest) ProtoMessage() {}
-
-func (x *GetTargetOfEvaluationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[18]
+func (x *SavedView) GetOwnerId() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.OwnerId
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use GetTargetOfEvaluationRequest.ProtoReflect.Descriptor instead.
-func (*GetTargetOfEvaluationRequest) Descriptor() ([]byte, []int) {
-	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{18}
+func (x *SavedView) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
 }
 
-func (x *GetTargetOfEvaluationRequest) GetTargetOfEvaluationId() string {
+func (x *SavedView) GetUpdatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.TargetOfEvaluationId
+		return x.UpdatedAt
 	}
-	return ""
+	return nil
 }
 
-type CreateTargetOfEvaluationRequest struct {
-	state              protoimpl.MessageState `protogen:"open.v1"`
-	TargetOfEvaluation *TargetOfEvaluation    `protobuf:"bytes,1,opt,name=target_of_evaluation,json=targetOfEvaluation,proto3" json:"target_of_evaluation,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+type CreateSavedViewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SavedView     *SavedView             `protobuf:"bytes,1,opt,name=saved_view,json=savedView,proto3" json:"saved_view,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateTargetOfEvaluationRequest) Reset() {
-	*x = CreateTargetOfEvaluationRequest{}
+func (x *CreateSavedViewRequest) Reset() {
+	*x = CreateSavedViewRequest{}
 	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateTargetOfEvaluationRequest) String() string {
+func (x *CreateSavedViewRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateTargetOfEvaluationRequest) ProtoMessage() {}
+func (*CreateSavedViewRequest) ProtoMessage() {}
 
-func (x *CreateTargetOfEvaluationRequest) ProtoReflect() protoreflect.Message {
+func (x *CreateSavedViewRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1308,39 +2080,39 @@ func (x *CreateTargetOfEvaluationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateTargetOfEvaluationRequest.ProtoReflect.Descriptor instead.
-func (*CreateTargetOfEvaluationRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateSavedViewRequest.ProtoReflect.Descriptor instead.
+func (*CreateSavedViewRequest) Descriptor() ([]byte, []int) {
 	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *CreateTargetOfEvaluationRequest) GetTargetOfEvaluation() *TargetOfEvaluation {
+func (x *CreateSavedViewRequest) GetSavedView() *SavedView {
 	if x != nil {
-		return x.TargetOfEvaluation
+		return x.SavedView
 	}
 	return nil
 }
 
-type UpdateTargetOfEvaluationRequest struct {
-	state              protoimpl.MessageState `protogen:"open.v1"`
-	TargetOfEvaluation *TargetOfEvaluation    `protobuf:"bytes,1,opt,name=target_of_evaluation,json=targetOfEvaluation,proto3" json:"target_of_evaluation,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+type GetSavedViewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SavedViewId   string                 `protobuf:"bytes,1,opt,name=saved_view_id,json=savedViewId,proto3" json:"saved_view_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateTargetOfEvaluationRequest) Reset() {
-	*x = UpdateTargetOfEvaluationRequest{}
+func (x *GetSavedViewRequest) Reset() {
+	*x = GetSavedViewRequest{}
 	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateTargetOfEvaluationRequest) String() string {
+func (x *GetSavedViewRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateTargetOfEvaluationRequest) ProtoMessage() {}
+func (*GetSavedViewRequest) ProtoMessage() {}
 
-func (x *UpdateTargetOfEvaluationRequest) ProtoReflect() protoreflect.Message {
+func (x *GetSavedViewRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1352,39 +2124,45 @@ func (x *UpdateTargetOfEvaluationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateTargetOfEvaluationRequest.ProtoReflect.Descriptor instead.
-func (*UpdateTargetOfEvaluationRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetSavedViewRequest.ProtoReflect.Descriptor instead.
+func (*GetSavedViewRequest) Descriptor() ([]byte, []int) {
 	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *UpdateTargetOfEvaluationRequest) GetTargetOfEvaluation() *TargetOfEvaluation {
+func (x *GetSavedViewRequest) GetSavedViewId() string {
 	if x != nil {
-		return x.TargetOfEvaluation
+		return x.SavedViewId
 	}
-	return nil
+	return ""
 }
 
-type RemoveTargetOfEvaluationRequest struct {
-	state                protoimpl.MessageState `protogen:"open.v1"`
-	TargetOfEvaluationId string                 `protobuf:"bytes,1,opt,name=target_of_evaluation_id,json=targetOfEvaluationId,proto3" json:"target_of_evaluation_id,omitempty"`
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
+type ListSavedViewsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Optional. Lists only the views owned by the calling user, i.e., no views
+	// shared by other users.
+	OwnedOnly     *bool  `protobuf:"varint,1,opt,name=owned_only,json=ownedOnly,proto3,oneof" json:"owned_only,omitempty"`
+	PageSize      int32  `protobuf:"varint,10,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string `protobuf:"bytes,11,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	OrderBy       string `protobuf:"bytes,12,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	Asc           bool   `protobuf:"varint,13,opt,name=asc,proto3" json:"asc,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RemoveTargetOfEvaluationRequest) Reset() {
-	*x = RemoveTargetOfEvaluationRequest{}
+func (x *ListSavedViewsRequest) Reset() {
+	*x = ListSavedViewsRequest{}
 	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RemoveTargetOfEvaluationRequest) String() string {
+func (x *ListSavedViewsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveTargetOfEvaluationRequest) ProtoMessage() {}
+func (*ListSavedViewsRequest) ProtoMessage() {}
 
-func (x *RemoveTargetOfEvaluationRequest) ProtoReflect() protoreflect.Message {
+func (x *ListSavedViewsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1396,42 +2174,68 @@ func (x *RemoveTargetOfEvaluationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveTargetOfEvaluationRequest.ProtoReflect.Descriptor instead.
-func (*RemoveTargetOfEvaluationRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListSavedViewsRequest.ProtoReflect.Descriptor instead.
+func (*ListSavedViewsRequest) Descriptor() ([]byte, []int) {
 	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *RemoveTargetOfEvaluationRequest) GetTargetOfEvaluationId() string {
+func (x *ListSavedViewsRequest) GetOwnedOnly() bool {
+	if x != nil && x.OwnedOnly != nil {
+		return *x.OwnedOnly
+	}
+	return false
+}
+
+func (x *ListSavedViewsRequest) GetPageSize() int32 {
 	if x != nil {
-		return x.TargetOfEvaluationId
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListSavedViewsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
 	}
 	return ""
 }
 
-type ListTargetsOfEvaluationRequest struct {
+func (x *ListSavedViewsRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+func (x *ListSavedViewsRequest) GetAsc() bool {
+	if x != nil {
+		return x.Asc
+	}
+	return false
+}
+
+type ListSavedViewsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	PageSize      int32                  `protobuf:"varint,10,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	PageToken     string                 `protobuf:"bytes,11,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
-	OrderBy       string                 `protobuf:"bytes,12,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
-	Asc           bool                   `protobuf:"varint,13,opt,name=asc,proto3" json:"asc,omitempty"`
+	SavedViews    []*SavedView           `protobuf:"bytes,1,rep,name=saved_views,json=savedViews,proto3" json:"saved_views,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListTargetsOfEvaluationRequest) Reset() {
-	*x = ListTargetsOfEvaluationRequest{}
+func (x *ListSavedViewsResponse) Reset() {
+	*x = ListSavedViewsResponse{}
 	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListTargetsOfEvaluationRequest) String() string {
+func (x *ListSavedViewsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListTargetsOfEvaluationRequest) ProtoMessage() {}
+func (*ListSavedViewsResponse) ProtoMessage() {}
 
-func (x *ListTargetsOfEvaluationRequest) ProtoReflect() protoreflect.Message {
+func (x *ListSavedViewsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1443,61 +2247,46 @@ func (x *ListTargetsOfEvaluationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListTargetsOfEvaluationRequest.ProtoReflect.Descriptor instead.
-func (*ListTargetsOfEvaluationRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListSavedViewsResponse.ProtoReflect.Descriptor instead.
+func (*ListSavedViewsResponse) Descriptor() ([]byte, []int) {
 	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *ListTargetsOfEvaluationRequest) GetPageSize() int32 {
-	if x != nil {
-		return x.PageSize
-	}
-	return 0
-}
-
-func (x *ListTargetsOfEvaluationRequest) GetPageToken() string {
+func (x *ListSavedViewsResponse) GetSavedViews() []*SavedView {
 	if x != nil {
-		return x.PageToken
+		return x.SavedViews
 	}
-	return ""
+	return nil
 }
 
-func (x *ListTargetsOfEvaluationRequest) GetOrderBy() string {
+func (x *ListSavedViewsResponse) GetNextPageToken() string {
 	if x != nil {
-		return x.OrderBy
+		return x.NextPageToken
 	}
 	return ""
 }
 
-func (x *ListTargetsOfEvaluationRequest) GetAsc() bool {
-	if x != nil {
-		return x.Asc
-	}
-	return false
-}
-
-type ListTargetsOfEvaluationResponse struct {
-	state               protoimpl.MessageState `protogen:"open.v1"`
-	TargetsOfEvaluation []*TargetOfEvaluation  `protobuf:"bytes,1,rep,name=targets_of_evaluation,json=targetsOfEvaluation,proto3" json:"targets_of_evaluation,omitempty"`
-	NextPageToken       string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
-	unknownFields       protoimpl.UnknownFields
-	sizeCache           protoimpl.SizeCache
+type UpdateSavedViewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SavedView     *SavedView             `protobuf:"bytes,1,opt,name=saved_view,json=savedView,proto3" json:"saved_view,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListTargetsOfEvaluationResponse) Reset() {
-	*x = ListTargetsOfEvaluationResponse{}
+func (x *UpdateSavedViewRequest) Reset() {
+	*x = UpdateSavedViewRequest{}
 	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListTargetsOfEvaluationResponse) String() string {
+func (x *UpdateSavedViewRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListTargetsOfEvaluationResponse) ProtoMessage() {}
+func (*UpdateSavedViewRequest) ProtoMessage() {}
 
-func (x *ListTargetsOfEvaluationResponse) ProtoReflect() protoreflect.Message {
+func (x *UpdateSavedViewRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1509,46 +2298,39 @@ func (x *ListTargetsOfEvaluationResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListTargetsOfEvaluationResponse.ProtoReflect.Descriptor instead.
-func (*ListTargetsOfEvaluationResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateSavedViewRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSavedViewRequest) Descriptor() ([]byte, []int) {
 	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *ListTargetsOfEvaluationResponse) GetTargetsOfEvaluation() []*TargetOfEvaluation {
+func (x *UpdateSavedViewRequest) GetSavedView() *SavedView {
 	if x != nil {
-		return x.TargetsOfEvaluation
+		return x.SavedView
 	}
 	return nil
 }
 
-func (x *ListTargetsOfEvaluationResponse) GetNextPageToken() string {
-	if x != nil {
-		return x.NextPageToken
-	}
-	return ""
-}
-
-type GetTargetOfEvaluationStatisticsRequest struct {
-	state                protoimpl.MessageState `protogen:"open.v1"`
-	TargetOfEvaluationId string                 `protobuf:"bytes,1,opt,name=target_of_evaluation_id,json=targetOfEvaluationId,proto3" json:"target_of_evaluation_id,omitempty"`
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
+type RemoveSavedViewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SavedViewId   string                 `protobuf:"bytes,1,opt,name=saved_view_id,json=savedViewId,proto3" json:"saved_view_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetTargetOfEvaluationStatisticsRequest) Reset() {
-	*x = GetTargetOfEvaluationStatisticsRequest{}
+func (x *RemoveSavedViewRequest) Reset() {
+	*x = RemoveSavedViewRequest{}
 	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTargetOfEvaluationStatisticsRequest) String() string {
+func (x *RemoveSavedViewRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTargetOfEvaluationStatisticsRequest) ProtoMessage() {}
+func (*RemoveSavedViewRequest) ProtoMessage() {}
 
-func (x *GetTargetOfEvaluationStatisticsRequest) ProtoReflect() protoreflect.Message {
+func (x *RemoveSavedViewRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1560,46 +2342,44 @@ func (x *GetTargetOfEvaluationStatisticsRequest) ProtoReflect() protoreflect.Mes
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTargetOfEvaluationStatisticsRequest.ProtoReflect.Descriptor instead.
-func (*GetTargetOfEvaluationStatisticsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RemoveSavedViewRequest.ProtoReflect.Descriptor instead.
+func (*RemoveSavedViewRequest) Descriptor() ([]byte, []int) {
 	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *GetTargetOfEvaluationStatisticsRequest) GetTargetOfEvaluationId() string {
+func (x *RemoveSavedViewRequest) GetSavedViewId() string {
 	if x != nil {
-		return x.TargetOfEvaluationId
+		return x.SavedViewId
 	}
 	return ""
 }
 
-type GetTargetOfEvaluationStatisticsResponse struct {
+type ListSlaBreachesRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// number of discovered resources per target of evaluation
-	NumberOfDiscoveredResources int64 `protobuf:"varint,1,opt,name=number_of_discovered_resources,json=numberOfDiscoveredResources,proto3" json:"number_of_discovered_resources,omitempty"`
-	// number of assessment results per target of evaluation
-	NumberOfAssessmentResults int64 `protobuf:"varint,2,opt,name=number_of_assessment_results,json=numberOfAssessmentResults,proto3" json:"number_of_assessment_results,omitempty"`
-	// number of evidences per target of evaluation
-	NumberOfEvidences int64 `protobuf:"varint,3,opt,name=number_of_evidences,json=numberOfEvidences,proto3" json:"number_of_evidences,omitempty"`
-	// number of selected catalogs per target of evaluation
-	NumberOfSelectedCatalogs int64 `protobuf:"varint,4,opt,name=number_of_selected_catalogs,json=numberOfSelectedCatalogs,proto3" json:"number_of_selected_catalogs,omitempty"`
-	unknownFields            protoimpl.UnknownFields
-	sizeCache                protoimpl.SizeCache
+	// Optional. Lists only SLA breaches of a specific target of evaluation.
+	TargetOfEvaluationId *string `protobuf:"bytes,1,opt,name=target_of_evaluation_id,json=targetOfEvaluationId,proto3,oneof" json:"target_of_evaluation_id,omitempty"`
+	// Optional. Lists only SLA breaches of a specific audit scope.
+	AuditScopeId *string `protobuf:"bytes,2,opt,name=audit_scope_id,json=auditScopeId,proto3,oneof" json:"audit_scope_id,omitempty"`
+	// Optional. Lists only SLA breaches of a specific catalog.
+	CatalogId     *string `protobuf:"bytes,3,opt,name=catalog_id,json=catalogId,proto3,oneof" json:"catalog_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetTargetOfEvaluationStatisticsResponse) Reset() {
-	*x = GetTargetOfEvaluationStatisticsResponse{}
+func (x *ListSlaBreachesRequest) Reset() {
+	*x = ListSlaBreachesRequest{}
 	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTargetOfEvaluationStatisticsResponse) String() string {
+func (x *ListSlaBreachesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTargetOfEvaluationStatisticsResponse) ProtoMessage() {}
+func (*ListSlaBreachesRequest) ProtoMessage() {}
 
-func (x *GetTargetOfEvaluationStatisticsResponse) ProtoReflect() protoreflect.Message {
+func (x *ListSlaBreachesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1611,60 +2391,53 @@ func (x *GetTargetOfEvaluationStatisticsResponse) ProtoReflect() protoreflect.Me
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTargetOfEvaluationStatisticsResponse.ProtoReflect.Descriptor instead.
-func (*GetTargetOfEvaluationStatisticsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListSlaBreachesRequest.ProtoReflect.Descriptor instead.
+func (*ListSlaBreachesRequest) Descriptor() ([]byte, []int) {
 	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *GetTargetOfEvaluationStatisticsResponse) GetNumberOfDiscoveredResources() int64 {
-	if x != nil {
-		return x.NumberOfDiscoveredResources
-	}
-	return 0
-}
-
-func (x *GetTargetOfEvaluationStatisticsResponse) GetNumberOfAssessmentResults() int64 {
-	if x != nil {
-		return x.NumberOfAssessmentResults
+func (x *ListSlaBreachesRequest) GetTargetOfEvaluationId() string {
+	if x != nil && x.TargetOfEvaluationId != nil {
+		return *x.TargetOfEvaluationId
 	}
-	return 0
+	return ""
 }
 
-func (x *GetTargetOfEvaluationStatisticsResponse) GetNumberOfEvidences() int64 {
-	if x != nil {
-		return x.NumberOfEvidences
+func (x *ListSlaBreachesRequest) GetAuditScopeId() string {
+	if x != nil && x.AuditScopeId != nil {
+		return *x.AuditScopeId
 	}
-	return 0
+	return ""
 }
 
-func (x *GetTargetOfEvaluationStatisticsResponse) GetNumberOfSelectedCatalogs() int64 {
-	if x != nil {
-		return x.NumberOfSelectedCatalogs
+func (x *ListSlaBreachesRequest) GetCatalogId() string {
+	if x != nil && x.CatalogId != nil {
+		return *x.CatalogId
 	}
-	return 0
+	return ""
 }
 
-type UpdateMetricConfigurationRequest struct {
-	state         protoimpl.MessageState          `protogen:"open.v1"`
-	Configuration *assessment.MetricConfiguration `protobuf:"bytes,1,opt,name=configuration,proto3" json:"configuration,omitempty"`
+type ListSlaBreachesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Breaches      []*SlaBreach           `protobuf:"bytes,1,rep,name=breaches,proto3" json:"breaches,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateMetricConfigurationRequest) Reset() {
-	*x = UpdateMetricConfigurationRequest{}
+func (x *ListSlaBreachesResponse) Reset() {
+	*x = ListSlaBreachesResponse{}
 	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateMetricConfigurationRequest) String() string {
+func (x *ListSlaBreachesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateMetricConfigurationRequest) ProtoMessage() {}
+func (*ListSlaBreachesResponse) ProtoMessage() {}
 
-func (x *UpdateMetricConfigurationRequest) ProtoReflect() protoreflect.Message {
+func (x *ListSlaBreachesResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1676,40 +2449,52 @@ func (x *UpdateMetricConfigurationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateMetricConfigurationRequest.ProtoReflect.Descriptor instead.
-func (*UpdateMetricConfigurationRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListSlaBreachesResponse.ProtoReflect.Descriptor instead.
+func (*ListSlaBreachesResponse) Descriptor() ([]byte, []int) {
 	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *UpdateMetricConfigurationRequest) GetConfiguration() *assessment.MetricConfiguration {
+func (x *ListSlaBreachesResponse) GetBreaches() []*SlaBreach {
 	if x != nil {
-		return x.Configuration
+		return x.Breaches
 	}
 	return nil
 }
 
-type GetMetricConfigurationRequest struct {
-	state                protoimpl.MessageState `protogen:"open.v1"`
-	TargetOfEvaluationId string                 `protobuf:"bytes,1,opt,name=target_of_evaluation_id,json=targetOfEvaluationId,proto3" json:"target_of_evaluation_id,omitempty"`
-	MetricId             string                 `protobuf:"bytes,2,opt,name=metric_id,json=metricId,proto3" json:"metric_id,omitempty"`
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
+// SlaBreach is a control that is not compliant for longer than allowed by the
+// SLA thresholds of its catalog.
+type SlaBreach struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the latest evaluation result of the control.
+	EvaluationResultId   string `protobuf:"bytes,1,opt,name=evaluation_result_id,json=evaluationResultId,proto3" json:"evaluation_result_id,omitempty"`
+	TargetOfEvaluationId string `protobuf:"bytes,2,opt,name=target_of_evaluation_id,json=targetOfEvaluationId,proto3" json:"target_of_evaluation_id,omitempty"`
+	AuditScopeId         string `protobuf:"bytes,3,opt,name=audit_scope_id,json=auditScopeId,proto3" json:"audit_scope_id,omitempty"`
+	ControlId            string `protobuf:"bytes,4,opt,name=control_id,json=controlId,proto3" json:"control_id,omitempty"`
+	ControlCatalogId     string `protobuf:"bytes,5,opt,name=control_catalog_id,json=controlCatalogId,proto3" json:"control_catalog_id,omitempty"`
+	// The time since which the control is not compliant.
+	NonCompliantSince *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=non_compliant_since,json=nonCompliantSince,proto3" json:"non_compliant_since,omitempty"`
+	// The time at which the control should have returned to compliant.
+	Deadline *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	// The SLA threshold that was exceeded.
+	Threshold     *SlaThreshold `protobuf:"bytes,8,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetMetricConfigurationRequest) Reset() {
-	*x = GetMetricConfigurationRequest{}
+func (x *SlaBreach) Reset() {
+	*x = SlaBreach{}
 	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetMetricConfigurationRequest) String() string {
+func (x *SlaBreach) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMetricConfigurationRequest) ProtoMessage() {}
+func (*SlaBreach) ProtoMessage() {}
 
-func (x *GetMetricConfigurationRequest) ProtoReflect() protoreflect.Message {
+func (x *SlaBreach) ProtoReflect() protoreflect.Message {
 	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1721,50 +2506,92 @@ func (x *GetMetricConfigurationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMetricConfigurationRequest.ProtoReflect.Descriptor instead.
-func (*GetMetricConfigurationRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SlaBreach.ProtoReflect.Descriptor instead.
+func (*SlaBreach) Descriptor() ([]byte, []int) {
 	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *GetMetricConfigurationRequest) GetTargetOfEvaluationId() string {
+func (x *SlaBreach) GetEvaluationResultId() string {
+	if x != nil {
+		return x.EvaluationResultId
+	}
+	return ""
+}
+
+func (x *SlaBreach) GetTargetOfEvaluationId() string {
 	if x != nil {
 		return x.TargetOfEvaluationId
 	}
 	return ""
 }
 
-func (x *GetMetricConfigurationRequest) GetMetricId() string {
+func (x *SlaBreach) GetAuditScopeId() string {
 	if x != nil {
-		return x.MetricId
+		return x.AuditScopeId
 	}
 	return ""
 }
 
-type ListMetricConfigurationRequest struct {
-	state                protoimpl.MessageState `protogen:"open.v1"`
-	TargetOfEvaluationId string                 `protobuf:"bytes,10,opt,name=target_of_evaluation_id,json=targetOfEvaluationId,proto3" json:"target_of_evaluation_id,omitempty"`
-	PageSize             int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	PageToken            string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
-	OrderBy              string                 `protobuf:"bytes,3,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
-	Asc                  bool                   `protobuf:"varint,4,opt,name=asc,proto3" json:"asc,omitempty"`
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
+func (x *SlaBreach) GetControlId() string {
+	if x != nil {
+		return x.ControlId
+	}
+	return ""
 }
 
-func (x *ListMetricConfigurationRequest) Reset() {
-	*x = ListMetricConfigurationRequest{}
+func (x *SlaBreach) GetControlCatalogId() string {
+	if x != nil {
+		return x.ControlCatalogId
+	}
+	return ""
+}
+
+func (x *SlaBreach) GetNonCompliantSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NonCompliantSince
+	}
+	return nil
+}
+
+func (x *SlaBreach) GetDeadline() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Deadline
+	}
+	return nil
+}
+
+func (x *SlaBreach) GetThreshold() *SlaThreshold {
+	if x != nil {
+		return x.Threshold
+	}
+	return nil
+}
+
+type GetCompliancePostureRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Optional. Aggregates only evaluation results of a specific catalog.
+	CatalogId *string `protobuf:"bytes,1,opt,name=catalog_id,json=catalogId,proto3,oneof" json:"catalog_id,omitempty"`
+	// Optional. Aggregates only evaluation results of a specific environment,
+	// e.g., prod.
+	Environment   *string `protobuf:"bytes,2,opt,name=environment,proto3,oneof" json:"environment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCompliancePostureRequest) Reset() {
+	*x = GetCompliancePostureRequest{}
 	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListMetricConfigurationRequest) String() string {
+func (x *GetCompliancePostureRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListMetricConfigurationRequest) ProtoMessage() {}
+func (*GetCompliancePostureRequest) ProtoMessage() {}
 
-func (x *ListMetricConfigurationRequest) ProtoReflect() protoreflect.Message {
+func (x *GetCompliancePostureRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1776,69 +2603,53 @@ func (x *ListMetricConfigurationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListMetricConfigurationRequest.ProtoReflect.Descriptor instead.
-func (*ListMetricConfigurationRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetCompliancePostureRequest.ProtoReflect.Descriptor instead.
+func (*GetCompliancePostureRequest) Descriptor() ([]byte, []int) {
 	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *ListMetricConfigurationRequest) GetTargetOfEvaluationId() string {
-	if x != nil {
-		return x.TargetOfEvaluationId
+func (x *GetCompliancePostureRequest) GetCatalogId() string {
+	if x != nil && x.CatalogId != nil {
+		return *x.CatalogId
 	}
 	return ""
 }
 
-func (x *ListMetricConfigurationRequest) GetPageSize() int32 {
-	if x != nil {
-		return x.PageSize
-	}
-	return 0
-}
-
-func (x *ListMetricConfigurationRequest) GetPageToken() string {
-	if x != nil {
-		return x.PageToken
-	}
-	return ""
-}
-
-func (x *ListMetricConfigurationRequest) GetOrderBy() string {
-	if x != nil {
-		return x.OrderBy
+func (x *GetCompliancePostureRequest) GetEnvironment() string {
+	if x != nil && x.Environment != nil {
+		return *x.Environment
 	}
 	return ""
 }
 
-func (x *ListMetricConfigurationRequest) GetAsc() bool {
-	if x != nil {
-		return x.Asc
-	}
-	return false
-}
-
-type ListMetricConfigurationResponse struct {
+// CompliancePosture is the compliance posture of all targets of evaluation
+// across their catalogs.
+type CompliancePosture struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// A map of metric configurations associated by their metric ID
-	Configurations map[string]*assessment.MetricConfiguration `protobuf:"bytes,1,rep,name=configurations,proto3" json:"configurations,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	NextPageToken  string                                     `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	// The number of targets of evaluation with evaluation results.
+	NumberOfTargetsOfEvaluation int64 `protobuf:"varint,1,opt,name=number_of_targets_of_evaluation,json=numberOfTargetsOfEvaluation,proto3" json:"number_of_targets_of_evaluation,omitempty"`
+	// The status counts of the controls of all catalogs.
+	Counts *ComplianceCounts `protobuf:"bytes,2,opt,name=counts,proto3" json:"counts,omitempty"`
+	// The posture per catalog, sorted by the catalog ID.
+	Catalogs      []*CatalogPosture `protobuf:"bytes,3,rep,name=catalogs,proto3" json:"catalogs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListMetricConfigurationResponse) Reset() {
-	*x = ListMetricConfigurationResponse{}
+func (x *CompliancePosture) Reset() {
+	*x = CompliancePosture{}
 	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListMetricConfigurationResponse) String() string {
+func (x *CompliancePosture) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListMetricConfigurationResponse) ProtoMessage() {}
+func (*CompliancePosture) ProtoMessage() {}
 
-func (x *ListMetricConfigurationResponse) ProtoReflect() protoreflect.Message {
+func (x *CompliancePosture) ProtoReflect() protoreflect.Message {
 	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1850,46 +2661,66 @@ func (x *ListMetricConfigurationResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListMetricConfigurationResponse.ProtoReflect.Descriptor instead.
-func (*ListMetricConfigurationResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CompliancePosture.ProtoReflect.Descriptor instead.
+func (*CompliancePosture) Descriptor() ([]byte, []int) {
 	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *ListMetricConfigurationResponse) GetConfigurations() map[string]*assessment.MetricConfiguration {
+func (x *CompliancePosture) GetNumberOfTargetsOfEvaluation() int64 {
 	if x != nil {
-		return x.Configurations
+		return x.NumberOfTargetsOfEvaluation
+	}
+	return 0
+}
+
+func (x *CompliancePosture) GetCounts() *ComplianceCounts {
+	if x != nil {
+		return x.Counts
 	}
 	return nil
 }
 
-func (x *ListMetricConfigurationResponse) GetNextPageToken() string {
+func (x *CompliancePosture) GetCatalogs() []*CatalogPosture {
 	if x != nil {
-		return x.NextPageToken
+		return x.Catalogs
 	}
-	return ""
+	return nil
 }
 
-type UpdateMetricImplementationRequest struct {
-	state          protoimpl.MessageState           `protogen:"open.v1"`
-	Implementation *assessment.MetricImplementation `protobuf:"bytes,1,opt,name=implementation,proto3" json:"implementation,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+// CatalogPosture is the compliance posture of all targets of evaluation within
+// a catalog.
+type CatalogPosture struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	CatalogId string                 `protobuf:"bytes,1,opt,name=catalog_id,json=catalogId,proto3" json:"catalog_id,omitempty"`
+	// The name of the catalog, if it still exists.
+	CatalogName string `protobuf:"bytes,2,opt,name=catalog_name,json=catalogName,proto3" json:"catalog_name,omitempty"`
+	// The number of targets of evaluation with evaluation results of the
+	// catalog.
+	NumberOfTargetsOfEvaluation int64 `protobuf:"varint,3,opt,name=number_of_targets_of_evaluation,json=numberOfTargetsOfEvaluation,proto3" json:"number_of_targets_of_evaluation,omitempty"`
+	// The status counts of the controls of the catalog.
+	Counts *ComplianceCounts `protobuf:"bytes,4,opt,name=counts,proto3" json:"counts,omitempty"`
+	// The posture per control family of the catalog, sorted by the category
+	// name. Controls without a category are counted in a family with an empty
+	// name.
+	Families      []*ControlFamilyPosture `protobuf:"bytes,5,rep,name=families,proto3" json:"families,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateMetricImplementationRequest) Reset() {
-	*x = UpdateMetricImplementationRequest{}
+func (x *CatalogPosture) Reset() {
+	*x = CatalogPosture{}
 	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateMetricImplementationRequest) String() string {
+func (x *CatalogPosture) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateMetricImplementationRequest) ProtoMessage() {}
+func (*CatalogPosture) ProtoMessage() {}
 
-func (x *UpdateMetricImplementationRequest) ProtoReflect() protoreflect.Message {
+func (x *CatalogPosture) ProtoReflect() protoreflect.Message {
 	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1901,39 +2732,74 @@ func (x *UpdateMetricImplementationRequest) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateMetricImplementationRequest.ProtoReflect.Descriptor instead.
-func (*UpdateMetricImplementationRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CatalogPosture.ProtoReflect.Descriptor instead.
+func (*CatalogPosture) Descriptor() ([]byte, []int) {
 	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *UpdateMetricImplementationRequest) GetImplementation() *assessment.MetricImplementation {
+func (x *CatalogPosture) GetCatalogId() string {
 	if x != nil {
-		return x.Implementation
+		return x.CatalogId
+	}
+	return ""
+}
+
+func (x *CatalogPosture) GetCatalogName() string {
+	if x != nil {
+		return x.CatalogName
+	}
+	return ""
+}
+
+func (x *CatalogPosture) GetNumberOfTargetsOfEvaluation() int64 {
+	if x != nil {
+		return x.NumberOfTargetsOfEvaluation
+	}
+	return 0
+}
+
+func (x *CatalogPosture) GetCounts() *ComplianceCounts {
+	if x != nil {
+		return x.Counts
 	}
 	return nil
 }
 
-type GetMetricImplementationRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	MetricId      string                 `protobuf:"bytes,1,opt,name=metric_id,json=metricId,proto3" json:"metric_id,omitempty"`
+func (x *CatalogPosture) GetFamilies() []*ControlFamilyPosture {
+	if x != nil {
+		return x.Families
+	}
+	return nil
+}
+
+// ControlFamilyPosture is the compliance posture of all targets of evaluation
+// within a control family, i.e., a category of a catalog.
+type ControlFamilyPosture struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	CategoryName string                 `protobuf:"bytes,1,opt,name=category_name,json=categoryName,proto3" json:"category_name,omitempty"`
+	// The number of targets of evaluation with evaluation results of the
+	// control family.
+	NumberOfTargetsOfEvaluation int64 `protobuf:"varint,2,opt,name=number_of_targets_of_evaluation,json=numberOfTargetsOfEvaluation,proto3" json:"number_of_targets_of_evaluation,omitempty"`
+	// The status counts of the controls of the control family.
+	Counts        *ComplianceCounts `protobuf:"bytes,3,opt,name=counts,proto3" json:"counts,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetMetricImplementationRequest) Reset() {
-	*x = GetMetricImplementationRequest{}
+func (x *ControlFamilyPosture) Reset() {
+	*x = ControlFamilyPosture{}
 	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetMetricImplementationRequest) String() string {
+func (x *ControlFamilyPosture) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMetricImplementationRequest) ProtoMessage() {}
+func (*ControlFamilyPosture) ProtoMessage() {}
 
-func (x *GetMetricImplementationRequest) ProtoReflect() protoreflect.Message {
+func (x *ControlFamilyPosture) ProtoReflect() protoreflect.Message {
 	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1945,39 +2811,62 @@ func (x *GetMetricImplementationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMetricImplementationRequest.ProtoReflect.Descriptor instead.
-func (*GetMetricImplementationRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ControlFamilyPosture.ProtoReflect.Descriptor instead.
+func (*ControlFamilyPosture) Descriptor() ([]byte, []int) {
 	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *GetMetricImplementationRequest) GetMetricId() string {
+func (x *ControlFamilyPosture) GetCategoryName() string {
 	if x != nil {
-		return x.MetricId
+		return x.CategoryName
 	}
 	return ""
 }
 
-type SubscribeRequest struct {
-	state         protoimpl.MessageState   `protogen:"open.v1"`
-	Filter        *SubscribeRequest_Filter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *ControlFamilyPosture) GetNumberOfTargetsOfEvaluation() int64 {
+	if x != nil {
+		return x.NumberOfTargetsOfEvaluation
+	}
+	return 0
 }
 
-func (x *SubscribeRequest) Reset() {
-	*x = SubscribeRequest{}
+func (x *ControlFamilyPosture) GetCounts() *ComplianceCounts {
+	if x != nil {
+		return x.Counts
+	}
+	return nil
+}
+
+// ComplianceCounts counts the latest evaluation results of controls by their
+// status. Manual results are counted along with the automatic ones.
+type ComplianceCounts struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Compliant    int64                  `protobuf:"varint,1,opt,name=compliant,proto3" json:"compliant,omitempty"`
+	NotCompliant int64                  `protobuf:"varint,2,opt,name=not_compliant,json=notCompliant,proto3" json:"not_compliant,omitempty"`
+	Pending      int64                  `protobuf:"varint,3,opt,name=pending,proto3" json:"pending,omitempty"`
+	// The number of stale results and of results whose evaluation failed.
+	Other int64 `protobuf:"varint,4,opt,name=other,proto3" json:"other,omitempty"`
+	// The share of compliant controls among all counted controls, between 0
+	// and 1. It is 0 if no controls are counted.
+	ComplianceRate float64 `protobuf:"fixed64,5,opt,name=compliance_rate,json=complianceRate,proto3" json:"compliance_rate,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ComplianceCounts) Reset() {
+	*x = ComplianceCounts{}
 	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SubscribeRequest) String() string {
+func (x *ComplianceCounts) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubscribeRequest) ProtoMessage() {}
+func (*ComplianceCounts) ProtoMessage() {}
 
-func (x *SubscribeRequest) ProtoReflect() protoreflect.Message {
+func (x *ComplianceCounts) ProtoReflect() protoreflect.Message {
 	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1989,64 +2878,71 @@ func (x *SubscribeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubscribeRequest.ProtoReflect.Descriptor instead.
-func (*SubscribeRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ComplianceCounts.ProtoReflect.Descriptor instead.
+func (*ComplianceCounts) Descriptor() ([]byte, []int) {
 	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *SubscribeRequest) GetFilter() *SubscribeRequest_Filter {
+func (x *ComplianceCounts) GetCompliant() int64 {
 	if x != nil {
-		return x.Filter
+		return x.Compliant
 	}
-	return nil
+	return 0
 }
 
-// ChangeEvent represents a change that occurred to an entity managed by the orchestrator.
-type ChangeEvent struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Metadata common to all events
-	Timestamp *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty" gorm:"serializer:timestamppb;type:timestamp"`
-	// Event category (what type of entity changed)
-	Category EventCategory `protobuf:"varint,2,opt,name=category,proto3,enum=confirmate.orchestrator.v1.EventCategory" json:"category,omitempty"`
-	// Request type (what happened to the entity)
-	RequestType RequestType `protobuf:"varint,3,opt,name=request_type,json=requestType,proto3,enum=confirmate.orchestrator.v1.RequestType" json:"request_type,omitempty"`
-	// Entity ID (always present for reference)
-	EntityId string `protobuf:"bytes,4,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
-	// TargetOfEvaluationId is an optional target of evaluation ID (for metric configuration changes).
-	// When present, should be a valid UUID.
-	TargetOfEvaluationId *string `protobuf:"bytes,5,opt,name=target_of_evaluation_id,json=targetOfEvaluationId,proto3,oneof" json:"target_of_evaluation_id,omitempty"`
-	// The actual entity data (optional, may be omitted for DELETED events)
-	//
-	// Types that are valid to be assigned to Entity:
-	//
-	//	*ChangeEvent_Metric
-	//	*ChangeEvent_TargetOfEvaluation
-	//	*ChangeEvent_AuditScope
-	//	*ChangeEvent_AssessmentResult
-	//	*ChangeEvent_MetricConfiguration
-	//	*ChangeEvent_MetricImplementation
-	//	*ChangeEvent_AssessmentTool
-	//	*ChangeEvent_User
-	//	*ChangeEvent_ControlInScope
-	Entity        isChangeEvent_Entity `protobuf_oneof:"entity"`
+func (x *ComplianceCounts) GetNotCompliant() int64 {
+	if x != nil {
+		return x.NotCompliant
+	}
+	return 0
+}
+
+func (x *ComplianceCounts) GetPending() int64 {
+	if x != nil {
+		return x.Pending
+	}
+	return 0
+}
+
+func (x *ComplianceCounts) GetOther() int64 {
+	if x != nil {
+		return x.Other
+	}
+	return 0
+}
+
+func (x *ComplianceCounts) GetComplianceRate() float64 {
+	if x != nil {
+		return x.ComplianceRate
+	}
+	return 0
+}
+
+type ListAlertsRequest struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Filter        *ListAlertsRequest_Filter `protobuf:"bytes,1,opt,name=filter,proto3,oneof" json:"filter,omitempty"`
+	PageSize      int32                     `protobuf:"varint,10,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                    `protobuf:"bytes,11,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	OrderBy       string                    `protobuf:"bytes,12,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	Asc           bool                      `protobuf:"varint,13,opt,name=asc,proto3" json:"asc,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ChangeEvent) Reset() {
-	*x = ChangeEvent{}
+func (x *ListAlertsRequest) Reset() {
+	*x = ListAlertsRequest{}
 	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ChangeEvent) String() string {
+func (x *ListAlertsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChangeEvent) ProtoMessage() {}
+func (*ListAlertsRequest) ProtoMessage() {}
 
-func (x *ChangeEvent) ProtoReflect() protoreflect.Message {
+func (x *ListAlertsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2058,220 +2954,323 @@ func (x *ChangeEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChangeEvent.ProtoReflect.Descriptor instead.
-func (*ChangeEvent) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListAlertsRequest.ProtoReflect.Descriptor instead.
+func (*ListAlertsRequest) Descriptor() ([]byte, []int) {
 	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *ChangeEvent) GetTimestamp() *timestamppb.Timestamp {
+func (x *ListAlertsRequest) GetFilter() *ListAlertsRequest_Filter {
 	if x != nil {
-		return x.Timestamp
+		return x.Filter
 	}
 	return nil
 }
 
-func (x *ChangeEvent) GetCategory() EventCategory {
+func (x *ListAlertsRequest) GetPageSize() int32 {
 	if x != nil {
-		return x.Category
+		return x.PageSize
 	}
-	return EventCategory_EVENT_CATEGORY_UNSPECIFIED
+	return 0
 }
 
-func (x *ChangeEvent) GetRequestType() RequestType {
+func (x *ListAlertsRequest) GetPageToken() string {
 	if x != nil {
-		return x.RequestType
+		return x.PageToken
 	}
-	return RequestType_REQUEST_TYPE_UNSPECIFIED
+	return ""
 }
 
-func (x *ChangeEvent) GetEntityId() string {
+func (x *ListAlertsRequest) GetOrderBy() string {
 	if x != nil {
-		return x.EntityId
+		return x.OrderBy
 	}
 	return ""
 }
 
-func (x *ChangeEvent) GetTargetOfEvaluationId() string {
-	if x != nil && x.TargetOfEvaluationId != nil {
-		return *x.TargetOfEvaluationId
+func (x *ListAlertsRequest) GetAsc() bool {
+	if x != nil {
+		return x.Asc
 	}
-	return ""
+	return false
 }
 
-func (x *ChangeEvent) GetEntity() isChangeEvent_Entity {
-	if x != nil {
-		return x.Entity
-	}
-	return nil
+type ListAlertsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Alerts        []*Alert               `protobuf:"bytes,1,rep,name=alerts,proto3" json:"alerts,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ChangeEvent) GetMetric() *assessment.Metric {
-	if x != nil {
-		if x, ok := x.Entity.(*ChangeEvent_Metric); ok {
-			return x.Metric
-		}
-	}
-	return nil
+func (x *ListAlertsResponse) Reset() {
+	*x = ListAlertsResponse{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *ChangeEvent) GetTargetOfEvaluation() *TargetOfEvaluation {
+func (x *ListAlertsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAlertsResponse) ProtoMessage() {}
+
+func (x *ListAlertsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[34]
 	if x != nil {
-		if x, ok := x.Entity.(*ChangeEvent_TargetOfEvaluation); ok {
-			return x.TargetOfEvaluation
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *ChangeEvent) GetAuditScope() *AuditScope {
+// Deprecated: Use ListAlertsResponse.ProtoReflect.Descriptor instead.
+func (*ListAlertsResponse) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ListAlertsResponse) GetAlerts() []*Alert {
 	if x != nil {
-		if x, ok := x.Entity.(*ChangeEvent_AuditScope); ok {
-			return x.AuditScope
-		}
+		return x.Alerts
 	}
 	return nil
 }
 
-func (x *ChangeEvent) GetAssessmentResult() *assessment.AssessmentResult {
+func (x *ListAlertsResponse) GetNextPageToken() string {
 	if x != nil {
-		if x, ok := x.Entity.(*ChangeEvent_AssessmentResult); ok {
-			return x.AssessmentResult
-		}
+		return x.NextPageToken
 	}
-	return nil
+	return ""
 }
 
-func (x *ChangeEvent) GetMetricConfiguration() *assessment.MetricConfiguration {
-	if x != nil {
-		if x, ok := x.Entity.(*ChangeEvent_MetricConfiguration); ok {
-			return x.MetricConfiguration
-		}
-	}
-	return nil
+// An Alert is an anomaly detected in the incoming assessment results.
+type Alert struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Alert ID
+	Id                   string    `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty" gorm:"primaryKey"`
+	Type                 AlertType `protobuf:"varint,2,opt,name=type,proto3,enum=confirmate.orchestrator.v1.AlertType" json:"type,omitempty"`
+	TargetOfEvaluationId string    `protobuf:"bytes,3,opt,name=target_of_evaluation_id,json=targetOfEvaluationId,proto3" json:"target_of_evaluation_id,omitempty"`
+	// The metric of a flapping alert
+	MetricId *string `protobuf:"bytes,4,opt,name=metric_id,json=metricId,proto3,oneof" json:"metric_id,omitempty"`
+	// The resource of a flapping alert
+	ResourceId *string `protobuf:"bytes,5,opt,name=resource_id,json=resourceId,proto3,oneof" json:"resource_id,omitempty"`
+	// A human-readable description of the anomaly
+	Description string `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+	// The time at which the anomaly was detected
+	DetectedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=detected_at,json=detectedAt,proto3" json:"detected_at,omitempty" gorm:"serializer:timestamppb;type:timestamp"`
+	// The observed value within the detection window, i.e., the number of
+	// results for a volume drop or the number of status changes for flapping.
+	Observed float64 `protobuf:"fixed64,8,opt,name=observed,proto3" json:"observed,omitempty"`
+	// The value that was expected based on the baseline or the threshold that
+	// was exceeded.
+	Expected      float64 `protobuf:"fixed64,9,opt,name=expected,proto3" json:"expected,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ChangeEvent) GetMetricImplementation() *assessment.MetricImplementation {
+func (x *Alert) Reset() {
+	*x = Alert{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Alert) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Alert) ProtoMessage() {}
+
+func (x *Alert) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[35]
 	if x != nil {
-		if x, ok := x.Entity.(*ChangeEvent_MetricImplementation); ok {
-			return x.MetricImplementation
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *ChangeEvent) GetAssessmentTool() *AssessmentTool {
+// Deprecated: Use Alert.ProtoReflect.Descriptor instead.
+func (*Alert) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *Alert) GetId() string {
 	if x != nil {
-		if x, ok := x.Entity.(*ChangeEvent_AssessmentTool); ok {
-			return x.AssessmentTool
-		}
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-func (x *ChangeEvent) GetUser() *User {
+func (x *Alert) GetType() AlertType {
 	if x != nil {
-		if x, ok := x.Entity.(*ChangeEvent_User); ok {
-			return x.User
-		}
+		return x.Type
 	}
-	return nil
+	return AlertType_ALERT_TYPE_UNSPECIFIED
 }
 
-func (x *ChangeEvent) GetControlInScope() *ControlInScope {
+func (x *Alert) GetTargetOfEvaluationId() string {
 	if x != nil {
-		if x, ok := x.Entity.(*ChangeEvent_ControlInScope); ok {
-			return x.ControlInScope
-		}
+		return x.TargetOfEvaluationId
 	}
-	return nil
+	return ""
 }
 
-type isChangeEvent_Entity interface {
-	isChangeEvent_Entity()
+func (x *Alert) GetMetricId() string {
+	if x != nil && x.MetricId != nil {
+		return *x.MetricId
+	}
+	return ""
 }
 
-type ChangeEvent_Metric struct {
-	Metric *assessment.Metric `protobuf:"bytes,10,opt,name=metric,proto3,oneof"`
+func (x *Alert) GetResourceId() string {
+	if x != nil && x.ResourceId != nil {
+		return *x.ResourceId
+	}
+	return ""
 }
 
-type ChangeEvent_TargetOfEvaluation struct {
-	TargetOfEvaluation *TargetOfEvaluation `protobuf:"bytes,11,opt,name=target_of_evaluation,json=targetOfEvaluation,proto3,oneof"`
+func (x *Alert) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
 }
 
-type ChangeEvent_AuditScope struct {
-	AuditScope *AuditScope `protobuf:"bytes,12,opt,name=audit_scope,json=auditScope,proto3,oneof"`
+func (x *Alert) GetDetectedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DetectedAt
+	}
+	return nil
 }
 
-type ChangeEvent_AssessmentResult struct {
-	AssessmentResult *assessment.AssessmentResult `protobuf:"bytes,13,opt,name=assessment_result,json=assessmentResult,proto3,oneof"`
+func (x *Alert) GetObserved() float64 {
+	if x != nil {
+		return x.Observed
+	}
+	return 0
 }
 
-type ChangeEvent_MetricConfiguration struct {
-	MetricConfiguration *assessment.MetricConfiguration `protobuf:"bytes,14,opt,name=metric_configuration,json=metricConfiguration,proto3,oneof"`
+func (x *Alert) GetExpected() float64 {
+	if x != nil {
+		return x.Expected
+	}
+	return 0
 }
 
-type ChangeEvent_MetricImplementation struct {
-	MetricImplementation *assessment.MetricImplementation `protobuf:"bytes,15,opt,name=metric_implementation,json=metricImplementation,proto3,oneof"`
+type UploadAttachmentRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*UploadAttachmentRequest_Metadata
+	//	*UploadAttachmentRequest_Chunk
+	Payload       isUploadAttachmentRequest_Payload `protobuf_oneof:"payload"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-type ChangeEvent_AssessmentTool struct {
-	AssessmentTool *AssessmentTool `protobuf:"bytes,16,opt,name=assessment_tool,json=assessmentTool,proto3,oneof"`
+func (x *UploadAttachmentRequest) Reset() {
+	*x = UploadAttachmentRequest{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-type ChangeEvent_User struct {
-	User *User `protobuf:"bytes,17,opt,name=user,proto3,oneof"`
+func (x *UploadAttachmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-type ChangeEvent_ControlInScope struct {
-	ControlInScope *ControlInScope `protobuf:"bytes,18,opt,name=control_in_scope,json=controlInScope,proto3,oneof"`
+func (*UploadAttachmentRequest) ProtoMessage() {}
+
+func (x *UploadAttachmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (*ChangeEvent_Metric) isChangeEvent_Entity() {}
+// Deprecated: Use UploadAttachmentRequest.ProtoReflect.Descriptor instead.
+func (*UploadAttachmentRequest) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{36}
+}
 
-func (*ChangeEvent_TargetOfEvaluation) isChangeEvent_Entity() {}
+func (x *UploadAttachmentRequest) GetPayload() isUploadAttachmentRequest_Payload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
 
-func (*ChangeEvent_AuditScope) isChangeEvent_Entity() {}
+func (x *UploadAttachmentRequest) GetMetadata() *evaluation.Attachment {
+	if x != nil {
+		if x, ok := x.Payload.(*UploadAttachmentRequest_Metadata); ok {
+			return x.Metadata
+		}
+	}
+	return nil
+}
 
-func (*ChangeEvent_AssessmentResult) isChangeEvent_Entity() {}
+func (x *UploadAttachmentRequest) GetChunk() []byte {
+	if x != nil {
+		if x, ok := x.Payload.(*UploadAttachmentRequest_Chunk); ok {
+			return x.Chunk
+		}
+	}
+	return nil
+}
 
-func (*ChangeEvent_MetricConfiguration) isChangeEvent_Entity() {}
+type isUploadAttachmentRequest_Payload interface {
+	isUploadAttachmentRequest_Payload()
+}
 
-func (*ChangeEvent_MetricImplementation) isChangeEvent_Entity() {}
+type UploadAttachmentRequest_Metadata struct {
+	// The metadata of the attachment. Must be sent in the first message. The
+	// id, size, checksum and creation time are set by the server.
+	Metadata *evaluation.Attachment `protobuf:"bytes,1,opt,name=metadata,proto3,oneof"`
+}
 
-func (*ChangeEvent_AssessmentTool) isChangeEvent_Entity() {}
+type UploadAttachmentRequest_Chunk struct {
+	// A chunk of the content of the attachment
+	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"`
+}
 
-func (*ChangeEvent_User) isChangeEvent_Entity() {}
+func (*UploadAttachmentRequest_Metadata) isUploadAttachmentRequest_Payload() {}
 
-func (*ChangeEvent_ControlInScope) isChangeEvent_Entity() {}
+func (*UploadAttachmentRequest_Chunk) isUploadAttachmentRequest_Payload() {}
 
-// Represents an external tool or service that offers assessments according to
-// certain metrics.
-type AssessmentTool struct {
-	state       protoimpl.MessageState `protogen:"open.v1"`
-	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name        string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	// a list of metrics that this tool can assess, referred by their ids
-	AvailableMetrics []string `protobuf:"bytes,4,rep,name=available_metrics,json=availableMetrics,proto3" json:"available_metrics,omitempty" gorm:"serializer:json"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+type DownloadAttachmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AttachmentId  string                 `protobuf:"bytes,1,opt,name=attachment_id,json=attachmentId,proto3" json:"attachment_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AssessmentTool) Reset() {
-	*x = AssessmentTool{}
-	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[34]
+func (x *DownloadAttachmentRequest) Reset() {
+	*x = DownloadAttachmentRequest{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AssessmentTool) String() string {
+func (x *DownloadAttachmentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AssessmentTool) ProtoMessage() {}
+func (*DownloadAttachmentRequest) ProtoMessage() {}
 
-func (x *AssessmentTool) ProtoReflect() protoreflect.Message {
-	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[34]
+func (x *DownloadAttachmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2282,74 +3281,128 @@ func (x *AssessmentTool) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AssessmentTool.ProtoReflect.Descriptor instead.
-func (*AssessmentTool) Descriptor() ([]byte, []int) {
-	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{34}
+// Deprecated: Use DownloadAttachmentRequest.ProtoReflect.Descriptor instead.
+func (*DownloadAttachmentRequest) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *AssessmentTool) GetId() string {
+func (x *DownloadAttachmentRequest) GetAttachmentId() string {
 	if x != nil {
-		return x.Id
+		return x.AttachmentId
 	}
 	return ""
 }
 
-func (x *AssessmentTool) GetName() string {
+type DownloadAttachmentResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*DownloadAttachmentResponse_Metadata
+	//	*DownloadAttachmentResponse_Chunk
+	Payload       isDownloadAttachmentResponse_Payload `protobuf_oneof:"payload"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DownloadAttachmentResponse) Reset() {
+	*x = DownloadAttachmentResponse{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DownloadAttachmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadAttachmentResponse) ProtoMessage() {}
+
+func (x *DownloadAttachmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[38]
 	if x != nil {
-		return x.Name
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *AssessmentTool) GetDescription() string {
+// Deprecated: Use DownloadAttachmentResponse.ProtoReflect.Descriptor instead.
+func (*DownloadAttachmentResponse) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *DownloadAttachmentResponse) GetPayload() isDownloadAttachmentResponse_Payload {
 	if x != nil {
-		return x.Description
+		return x.Payload
 	}
-	return ""
+	return nil
 }
 
-func (x *AssessmentTool) GetAvailableMetrics() []string {
+func (x *DownloadAttachmentResponse) GetMetadata() *evaluation.Attachment {
 	if x != nil {
-		return x.AvailableMetrics
+		if x, ok := x.Payload.(*DownloadAttachmentResponse_Metadata); ok {
+			return x.Metadata
+		}
 	}
 	return nil
 }
 
-type TargetOfEvaluation struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	Id                string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name              string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description       string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	ConfiguredMetrics []*assessment.Metric   `protobuf:"bytes,5,rep,name=configured_metrics,json=configuredMetrics,proto3" json:"configured_metrics,omitempty" gorm:"many2many:metric_configurations"`
-	// creation time of the target_of_evaluation
-	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3,oneof" json:"created_at,omitempty" gorm:"serializer:timestamppb;type:timestamp"`
-	// last update time of the target_of_evaluation
-	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3,oneof" json:"updated_at,omitempty" gorm:"serializer:timestamppb;type:timestamp"`
-	// additional metadata of the target of evaluation, mostly used for the UI
-	Metadata *TargetOfEvaluation_Metadata `protobuf:"bytes,10,opt,name=metadata,proto3,oneof" json:"metadata,omitempty" gorm:"serializer:json"`
-	// type of the target to be evaluated: cloud, product or organization
-	TargetType TargetOfEvaluation_TargetType `protobuf:"varint,11,opt,name=target_type,json=targetType,proto3,enum=confirmate.orchestrator.v1.TargetOfEvaluation_TargetType" json:"target_type,omitempty"`
-	// Organization details for this target of evaluation.
-	Organization  *TargetOfEvaluation_Organization `protobuf:"bytes,15,opt,name=organization,proto3,oneof" json:"organization,omitempty" gorm:"serializer:json"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *DownloadAttachmentResponse) GetChunk() []byte {
+	if x != nil {
+		if x, ok := x.Payload.(*DownloadAttachmentResponse_Chunk); ok {
+			return x.Chunk
+		}
+	}
+	return nil
 }
 
-func (x *TargetOfEvaluation) Reset() {
-	*x = TargetOfEvaluation{}
-	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[35]
+type isDownloadAttachmentResponse_Payload interface {
+	isDownloadAttachmentResponse_Payload()
+}
+
+type DownloadAttachmentResponse_Metadata struct {
+	// The metadata of the attachment. Only sent in the first message.
+	Metadata *evaluation.Attachment `protobuf:"bytes,1,opt,name=metadata,proto3,oneof"`
+}
+
+type DownloadAttachmentResponse_Chunk struct {
+	// A chunk of the content of the attachment
+	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"`
+}
+
+func (*DownloadAttachmentResponse_Metadata) isDownloadAttachmentResponse_Payload() {}
+
+func (*DownloadAttachmentResponse_Chunk) isDownloadAttachmentResponse_Payload() {}
+
+type ListAttachmentsRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	EvaluationResultId string                 `protobuf:"bytes,1,opt,name=evaluation_result_id,json=evaluationResultId,proto3" json:"evaluation_result_id,omitempty"`
+	PageSize           int32                  `protobuf:"varint,10,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken          string                 `protobuf:"bytes,11,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	OrderBy            string                 `protobuf:"bytes,12,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	Asc                bool                   `protobuf:"varint,13,opt,name=asc,proto3" json:"asc,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ListAttachmentsRequest) Reset() {
+	*x = ListAttachmentsRequest{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TargetOfEvaluation) String() string {
+func (x *ListAttachmentsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TargetOfEvaluation) ProtoMessage() {}
+func (*ListAttachmentsRequest) ProtoMessage() {}
 
-func (x *TargetOfEvaluation) ProtoReflect() protoreflect.Message {
-	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[35]
+func (x *ListAttachmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2360,110 +3413,8957 @@ func (x *TargetOfEvaluation) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TargetOfEvaluation.ProtoReflect.Descriptor instead.
-func (*TargetOfEvaluation) Descriptor() ([]byte, []int) {
-	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{35}
+// Deprecated: Use ListAttachmentsRequest.ProtoReflect.Descriptor instead.
+func (*ListAttachmentsRequest) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *TargetOfEvaluation) GetId() string {
+func (x *ListAttachmentsRequest) GetEvaluationResultId() string {
 	if x != nil {
-		return x.Id
+		return x.EvaluationResultId
 	}
 	return ""
 }
 
-func (x *TargetOfEvaluation) GetName() string {
+func (x *ListAttachmentsRequest) GetPageSize() int32 {
 	if x != nil {
-		return x.Name
+		return x.PageSize
 	}
-	return ""
+	return 0
 }
 
-func (x *TargetOfEvaluation) GetDescription() string {
+func (x *ListAttachmentsRequest) GetPageToken() string {
 	if x != nil {
-		return x.Description
+		return x.PageToken
 	}
 	return ""
 }
 
-func (x *TargetOfEvaluation) GetConfiguredMetrics() []*assessment.Metric {
+func (x *ListAttachmentsRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+func (x *ListAttachmentsRequest) GetAsc() bool {
+	if x != nil {
+		return x.Asc
+	}
+	return false
+}
+
+type ListAttachmentsResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Attachments   []*evaluation.Attachment `protobuf:"bytes,1,rep,name=attachments,proto3" json:"attachments,omitempty"`
+	NextPageToken string                   `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAttachmentsResponse) Reset() {
+	*x = ListAttachmentsResponse{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAttachmentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAttachmentsResponse) ProtoMessage() {}
+
+func (x *ListAttachmentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAttachmentsResponse.ProtoReflect.Descriptor instead.
+func (*ListAttachmentsResponse) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ListAttachmentsResponse) GetAttachments() []*evaluation.Attachment {
+	if x != nil {
+		return x.Attachments
+	}
+	return nil
+}
+
+func (x *ListAttachmentsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type RemoveAttachmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AttachmentId  string                 `protobuf:"bytes,1,opt,name=attachment_id,json=attachmentId,proto3" json:"attachment_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveAttachmentRequest) Reset() {
+	*x = RemoveAttachmentRequest{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveAttachmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveAttachmentRequest) ProtoMessage() {}
+
+func (x *RemoveAttachmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveAttachmentRequest.ProtoReflect.Descriptor instead.
+func (*RemoveAttachmentRequest) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *RemoveAttachmentRequest) GetAttachmentId() string {
+	if x != nil {
+		return x.AttachmentId
+	}
+	return ""
+}
+
+type AddEvaluationResultCommentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Comment       *evaluation.Comment    `protobuf:"bytes,1,opt,name=comment,proto3" json:"comment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddEvaluationResultCommentRequest) Reset() {
+	*x = AddEvaluationResultCommentRequest{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddEvaluationResultCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddEvaluationResultCommentRequest) ProtoMessage() {}
+
+func (x *AddEvaluationResultCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddEvaluationResultCommentRequest.ProtoReflect.Descriptor instead.
+func (*AddEvaluationResultCommentRequest) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *AddEvaluationResultCommentRequest) GetComment() *evaluation.Comment {
+	if x != nil {
+		return x.Comment
+	}
+	return nil
+}
+
+type ListEvaluationResultCommentsRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	EvaluationResultId string                 `protobuf:"bytes,1,opt,name=evaluation_result_id,json=evaluationResultId,proto3" json:"evaluation_result_id,omitempty"`
+	PageSize           int32                  `protobuf:"varint,10,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken          string                 `protobuf:"bytes,11,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	OrderBy            string                 `protobuf:"bytes,12,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	Asc                bool                   `protobuf:"varint,13,opt,name=asc,proto3" json:"asc,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ListEvaluationResultCommentsRequest) Reset() {
+	*x = ListEvaluationResultCommentsRequest{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEvaluationResultCommentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEvaluationResultCommentsRequest) ProtoMessage() {}
+
+func (x *ListEvaluationResultCommentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEvaluationResultCommentsRequest.ProtoReflect.Descriptor instead.
+func (*ListEvaluationResultCommentsRequest) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ListEvaluationResultCommentsRequest) GetEvaluationResultId() string {
+	if x != nil {
+		return x.EvaluationResultId
+	}
+	return ""
+}
+
+func (x *ListEvaluationResultCommentsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListEvaluationResultCommentsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListEvaluationResultCommentsRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+func (x *ListEvaluationResultCommentsRequest) GetAsc() bool {
+	if x != nil {
+		return x.Asc
+	}
+	return false
+}
+
+type ListEvaluationResultCommentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Comments      []*evaluation.Comment  `protobuf:"bytes,1,rep,name=comments,proto3" json:"comments,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEvaluationResultCommentsResponse) Reset() {
+	*x = ListEvaluationResultCommentsResponse{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEvaluationResultCommentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEvaluationResultCommentsResponse) ProtoMessage() {}
+
+func (x *ListEvaluationResultCommentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEvaluationResultCommentsResponse.ProtoReflect.Descriptor instead.
+func (*ListEvaluationResultCommentsResponse) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ListEvaluationResultCommentsResponse) GetComments() []*evaluation.Comment {
+	if x != nil {
+		return x.Comments
+	}
+	return nil
+}
+
+func (x *ListEvaluationResultCommentsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type RemoveEvaluationResultCommentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CommentId     string                 `protobuf:"bytes,1,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveEvaluationResultCommentRequest) Reset() {
+	*x = RemoveEvaluationResultCommentRequest{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveEvaluationResultCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveEvaluationResultCommentRequest) ProtoMessage() {}
+
+func (x *RemoveEvaluationResultCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveEvaluationResultCommentRequest.ProtoReflect.Descriptor instead.
+func (*RemoveEvaluationResultCommentRequest) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *RemoveEvaluationResultCommentRequest) GetCommentId() string {
+	if x != nil {
+		return x.CommentId
+	}
+	return ""
+}
+
+type CreateMetricRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Metric        *assessment.Metric     `protobuf:"bytes,1,opt,name=metric,proto3" json:"metric,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateMetricRequest) Reset() {
+	*x = CreateMetricRequest{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateMetricRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateMetricRequest) ProtoMessage() {}
+
+func (x *CreateMetricRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateMetricRequest.ProtoReflect.Descriptor instead.
+func (*CreateMetricRequest) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *CreateMetricRequest) GetMetric() *assessment.Metric {
+	if x != nil {
+		return x.Metric
+	}
+	return nil
+}
+
+type UpdateMetricRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Metric        *assessment.Metric     `protobuf:"bytes,1,opt,name=metric,proto3" json:"metric,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateMetricRequest) Reset() {
+	*x = UpdateMetricRequest{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateMetricRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMetricRequest) ProtoMessage() {}
+
+func (x *UpdateMetricRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMetricRequest.ProtoReflect.Descriptor instead.
+func (*UpdateMetricRequest) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *UpdateMetricRequest) GetMetric() *assessment.Metric {
+	if x != nil {
+		return x.Metric
+	}
+	return nil
+}
+
+type GetMetricRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MetricId      string                 `protobuf:"bytes,1,opt,name=metric_id,json=metricId,proto3" json:"metric_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMetricRequest) Reset() {
+	*x = GetMetricRequest{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMetricRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMetricRequest) ProtoMessage() {}
+
+func (x *GetMetricRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMetricRequest.ProtoReflect.Descriptor instead.
+func (*GetMetricRequest) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *GetMetricRequest) GetMetricId() string {
+	if x != nil {
+		return x.MetricId
+	}
+	return ""
+}
+
+type ListMetricsRequest struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Filter        *ListMetricsRequest_Filter `protobuf:"bytes,1,opt,name=filter,proto3,oneof" json:"filter,omitempty"`
+	PageSize      int32                      `protobuf:"varint,10,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                     `protobuf:"bytes,11,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	OrderBy       string                     `protobuf:"bytes,12,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	Asc           bool                       `protobuf:"varint,13,opt,name=asc,proto3" json:"asc,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMetricsRequest) Reset() {
+	*x = ListMetricsRequest{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMetricsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMetricsRequest) ProtoMessage() {}
+
+func (x *ListMetricsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMetricsRequest.ProtoReflect.Descriptor instead.
+func (*ListMetricsRequest) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ListMetricsRequest) GetFilter() *ListMetricsRequest_Filter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ListMetricsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListMetricsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListMetricsRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+func (x *ListMetricsRequest) GetAsc() bool {
+	if x != nil {
+		return x.Asc
+	}
+	return false
+}
+
+type RemoveMetricRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MetricId      string                 `protobuf:"bytes,1,opt,name=metric_id,json=metricId,proto3" json:"metric_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveMetricRequest) Reset() {
+	*x = RemoveMetricRequest{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveMetricRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveMetricRequest) ProtoMessage() {}
+
+func (x *RemoveMetricRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveMetricRequest.ProtoReflect.Descriptor instead.
+func (*RemoveMetricRequest) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *RemoveMetricRequest) GetMetricId() string {
+	if x != nil {
+		return x.MetricId
+	}
+	return ""
+}
+
+type ListMetricsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Metrics       []*assessment.Metric   `protobuf:"bytes,1,rep,name=metrics,proto3" json:"metrics,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMetricsResponse) Reset() {
+	*x = ListMetricsResponse{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMetricsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMetricsResponse) ProtoMessage() {}
+
+func (x *ListMetricsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMetricsResponse.ProtoReflect.Descriptor instead.
+func (*ListMetricsResponse) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ListMetricsResponse) GetMetrics() []*assessment.Metric {
+	if x != nil {
+		return x.Metrics
+	}
+	return nil
+}
+
+func (x *ListMetricsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type GetTargetOfEvaluationRequest struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	TargetOfEvaluationId string                 `protobuf:"bytes,1,opt,name=target_of_evaluation_id,json=targetOfEvaluationId,proto3" json:"target_of_evaluation_id,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *GetTargetOfEvaluationRequest) Reset() {
+	*x = GetTargetOfEvaluationRequest{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTargetOfEvaluationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTargetOfEvaluationRequest) ProtoMessage() {}
+
+func (x *GetTargetOfEvaluationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTargetOfEvaluationRequest.ProtoReflect.Descriptor instead.
+func (*GetTargetOfEvaluationRequest) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *GetTargetOfEvaluationRequest) GetTargetOfEvaluationId() string {
+	if x != nil {
+		return x.TargetOfEvaluationId
+	}
+	return ""
+}
+
+type CreateTargetOfEvaluationRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	TargetOfEvaluation *TargetOfEvaluation    `protobuf:"bytes,1,opt,name=target_of_evaluation,json=targetOfEvaluation,proto3" json:"target_of_evaluation,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *CreateTargetOfEvaluationRequest) Reset() {
+	*x = CreateTargetOfEvaluationRequest{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTargetOfEvaluationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTargetOfEvaluationRequest) ProtoMessage() {}
+
+func (x *CreateTargetOfEvaluationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTargetOfEvaluationRequest.ProtoReflect.Descriptor instead.
+func (*CreateTargetOfEvaluationRequest) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *CreateTargetOfEvaluationRequest) GetTargetOfEvaluation() *TargetOfEvaluation {
+	if x != nil {
+		return x.TargetOfEvaluation
+	}
+	return nil
+}
+
+type UpdateTargetOfEvaluationRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	TargetOfEvaluation *TargetOfEvaluation    `protobuf:"bytes,1,opt,name=target_of_evaluation,json=targetOfEvaluation,proto3" json:"target_of_evaluation,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *UpdateTargetOfEvaluationRequest) Reset() {
+	*x = UpdateTargetOfEvaluationRequest{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTargetOfEvaluationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTargetOfEvaluationRequest) ProtoMessage() {}
+
+func (x *UpdateTargetOfEvaluationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTargetOfEvaluationRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTargetOfEvaluationRequest) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *UpdateTargetOfEvaluationRequest) GetTargetOfEvaluation() *TargetOfEvaluation {
+	if x != nil {
+		return x.TargetOfEvaluation
+	}
+	return nil
+}
+
+type RemoveTargetOfEvaluationRequest struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	TargetOfEvaluationId string                 `protobuf:"bytes,1,opt,name=target_of_evaluation_id,json=targetOfEvaluationId,proto3" json:"target_of_evaluation_id,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *RemoveTargetOfEvaluationRequest) Reset() {
+	*x = RemoveTargetOfEvaluationRequest{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveTargetOfEvaluationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveTargetOfEvaluationRequest) ProtoMessage() {}
+
+func (x *RemoveTargetOfEvaluationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveTargetOfEvaluationRequest.ProtoReflect.Descriptor instead.
+func (*RemoveTargetOfEvaluationRequest) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *RemoveTargetOfEvaluationRequest) GetTargetOfEvaluationId() string {
+	if x != nil {
+		return x.TargetOfEvaluationId
+	}
+	return ""
+}
+
+type MergeTargetsOfEvaluationRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The target of evaluation that remains after the merge
+	TargetOfEvaluationId string `protobuf:"bytes,1,opt,name=target_of_evaluation_id,json=targetOfEvaluationId,proto3" json:"target_of_evaluation_id,omitempty"`
+	// The duplicate target of evaluation that is merged and removed afterwards
+	DuplicateTargetOfEvaluationId string `protobuf:"bytes,2,opt,name=duplicate_target_of_evaluation_id,json=duplicateTargetOfEvaluationId,proto3" json:"duplicate_target_of_evaluation_id,omitempty"`
+	// If set, the merge is only previewed, but not applied
+	DryRun        bool `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergeTargetsOfEvaluationRequest) Reset() {
+	*x = MergeTargetsOfEvaluationRequest{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeTargetsOfEvaluationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeTargetsOfEvaluationRequest) ProtoMessage() {}
+
+func (x *MergeTargetsOfEvaluationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeTargetsOfEvaluationRequest.ProtoReflect.Descriptor instead.
+func (*MergeTargetsOfEvaluationRequest) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *MergeTargetsOfEvaluationRequest) GetTargetOfEvaluationId() string {
+	if x != nil {
+		return x.TargetOfEvaluationId
+	}
+	return ""
+}
+
+func (x *MergeTargetsOfEvaluationRequest) GetDuplicateTargetOfEvaluationId() string {
+	if x != nil {
+		return x.DuplicateTargetOfEvaluationId
+	}
+	return ""
+}
+
+func (x *MergeTargetsOfEvaluationRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type MergeTargetsOfEvaluationResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The number of audit scopes that were (or, in a dry run, would have been)
+	// moved
+	NumberOfAuditScopes int64 `protobuf:"varint,1,opt,name=number_of_audit_scopes,json=numberOfAuditScopes,proto3" json:"number_of_audit_scopes,omitempty"`
+	// The number of controls in scope that were moved
+	NumberOfControlsInScope int64 `protobuf:"varint,2,opt,name=number_of_controls_in_scope,json=numberOfControlsInScope,proto3" json:"number_of_controls_in_scope,omitempty"`
+	// The number of assessment results that were moved. They carry the
+	// references to the evidences they are based on.
+	NumberOfAssessmentResults int64 `protobuf:"varint,3,opt,name=number_of_assessment_results,json=numberOfAssessmentResults,proto3" json:"number_of_assessment_results,omitempty"`
+	// The number of evaluation results that were moved
+	NumberOfEvaluationResults int64 `protobuf:"varint,4,opt,name=number_of_evaluation_results,json=numberOfEvaluationResults,proto3" json:"number_of_evaluation_results,omitempty"`
+	// The number of certificates that were moved
+	NumberOfCertificates int64 `protobuf:"varint,5,opt,name=number_of_certificates,json=numberOfCertificates,proto3" json:"number_of_certificates,omitempty"`
+	// The number of metric configurations that were moved. Configurations of
+	// metrics that are already configured for the remaining target of
+	// evaluation are dropped.
+	NumberOfMetricConfigurations int64 `protobuf:"varint,6,opt,name=number_of_metric_configurations,json=numberOfMetricConfigurations,proto3" json:"number_of_metric_configurations,omitempty"`
+	// The number of user permissions that were moved. Permissions of users that
+	// already have access to the remaining target of evaluation are dropped.
+	NumberOfUserPermissions int64 `protobuf:"varint,7,opt,name=number_of_user_permissions,json=numberOfUserPermissions,proto3" json:"number_of_user_permissions,omitempty"`
+	// Whether the merge was applied
+	Applied bool `protobuf:"varint,8,opt,name=applied,proto3" json:"applied,omitempty"`
+	// The number of secrets that were moved. Secrets whose name is already used
+	// by a secret of the remaining target of evaluation are dropped.
+	NumberOfSecrets int64 `protobuf:"varint,9,opt,name=number_of_secrets,json=numberOfSecrets,proto3" json:"number_of_secrets,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *MergeTargetsOfEvaluationResponse) Reset() {
+	*x = MergeTargetsOfEvaluationResponse{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeTargetsOfEvaluationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeTargetsOfEvaluationResponse) ProtoMessage() {}
+
+func (x *MergeTargetsOfEvaluationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeTargetsOfEvaluationResponse.ProtoReflect.Descriptor instead.
+func (*MergeTargetsOfEvaluationResponse) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *MergeTargetsOfEvaluationResponse) GetNumberOfAuditScopes() int64 {
+	if x != nil {
+		return x.NumberOfAuditScopes
+	}
+	return 0
+}
+
+func (x *MergeTargetsOfEvaluationResponse) GetNumberOfControlsInScope() int64 {
+	if x != nil {
+		return x.NumberOfControlsInScope
+	}
+	return 0
+}
+
+func (x *MergeTargetsOfEvaluationResponse) GetNumberOfAssessmentResults() int64 {
+	if x != nil {
+		return x.NumberOfAssessmentResults
+	}
+	return 0
+}
+
+func (x *MergeTargetsOfEvaluationResponse) GetNumberOfEvaluationResults() int64 {
+	if x != nil {
+		return x.NumberOfEvaluationResults
+	}
+	return 0
+}
+
+func (x *MergeTargetsOfEvaluationResponse) GetNumberOfCertificates() int64 {
+	if x != nil {
+		return x.NumberOfCertificates
+	}
+	return 0
+}
+
+func (x *MergeTargetsOfEvaluationResponse) GetNumberOfMetricConfigurations() int64 {
+	if x != nil {
+		return x.NumberOfMetricConfigurations
+	}
+	return 0
+}
+
+func (x *MergeTargetsOfEvaluationResponse) GetNumberOfUserPermissions() int64 {
+	if x != nil {
+		return x.NumberOfUserPermissions
+	}
+	return 0
+}
+
+func (x *MergeTargetsOfEvaluationResponse) GetApplied() bool {
+	if x != nil {
+		return x.Applied
+	}
+	return false
+}
+
+func (x *MergeTargetsOfEvaluationResponse) GetNumberOfSecrets() int64 {
+	if x != nil {
+		return x.NumberOfSecrets
+	}
+	return 0
+}
+
+type ListTargetsOfEvaluationRequest struct {
+	state         protoimpl.MessageState                 `protogen:"open.v1"`
+	Filter        *ListTargetsOfEvaluationRequest_Filter `protobuf:"bytes,1,opt,name=filter,proto3,oneof" json:"filter,omitempty"`
+	PageSize      int32                                  `protobuf:"varint,10,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                                 `protobuf:"bytes,11,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	OrderBy       string                                 `protobuf:"bytes,12,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	Asc           bool                                   `protobuf:"varint,13,opt,name=asc,proto3" json:"asc,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTargetsOfEvaluationRequest) Reset() {
+	*x = ListTargetsOfEvaluationRequest{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTargetsOfEvaluationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTargetsOfEvaluationRequest) ProtoMessage() {}
+
+func (x *ListTargetsOfEvaluationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTargetsOfEvaluationRequest.ProtoReflect.Descriptor instead.
+func (*ListTargetsOfEvaluationRequest) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ListTargetsOfEvaluationRequest) GetFilter() *ListTargetsOfEvaluationRequest_Filter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ListTargetsOfEvaluationRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListTargetsOfEvaluationRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListTargetsOfEvaluationRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+func (x *ListTargetsOfEvaluationRequest) GetAsc() bool {
+	if x != nil {
+		return x.Asc
+	}
+	return false
+}
+
+type ListTargetsOfEvaluationResponse struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	TargetsOfEvaluation []*TargetOfEvaluation  `protobuf:"bytes,1,rep,name=targets_of_evaluation,json=targetsOfEvaluation,proto3" json:"targets_of_evaluation,omitempty"`
+	NextPageToken       string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *ListTargetsOfEvaluationResponse) Reset() {
+	*x = ListTargetsOfEvaluationResponse{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTargetsOfEvaluationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTargetsOfEvaluationResponse) ProtoMessage() {}
+
+func (x *ListTargetsOfEvaluationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTargetsOfEvaluationResponse.ProtoReflect.Descriptor instead.
+func (*ListTargetsOfEvaluationResponse) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *ListTargetsOfEvaluationResponse) GetTargetsOfEvaluation() []*TargetOfEvaluation {
+	if x != nil {
+		return x.TargetsOfEvaluation
+	}
+	return nil
+}
+
+func (x *ListTargetsOfEvaluationResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type GetTargetOfEvaluationStatisticsRequest struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	TargetOfEvaluationId string                 `protobuf:"bytes,1,opt,name=target_of_evaluation_id,json=targetOfEvaluationId,proto3" json:"target_of_evaluation_id,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *GetTargetOfEvaluationStatisticsRequest) Reset() {
+	*x = GetTargetOfEvaluationStatisticsRequest{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTargetOfEvaluationStatisticsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTargetOfEvaluationStatisticsRequest) ProtoMessage() {}
+
+func (x *GetTargetOfEvaluationStatisticsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTargetOfEvaluationStatisticsRequest.ProtoReflect.Descriptor instead.
+func (*GetTargetOfEvaluationStatisticsRequest) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *GetTargetOfEvaluationStatisticsRequest) GetTargetOfEvaluationId() string {
+	if x != nil {
+		return x.TargetOfEvaluationId
+	}
+	return ""
+}
+
+type GetTargetOfEvaluationStatisticsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// number of discovered resources per target of evaluation
+	NumberOfDiscoveredResources int64 `protobuf:"varint,1,opt,name=number_of_discovered_resources,json=numberOfDiscoveredResources,proto3" json:"number_of_discovered_resources,omitempty"`
+	// number of assessment results per target of evaluation
+	NumberOfAssessmentResults int64 `protobuf:"varint,2,opt,name=number_of_assessment_results,json=numberOfAssessmentResults,proto3" json:"number_of_assessment_results,omitempty"`
+	// number of evidences per target of evaluation
+	NumberOfEvidences int64 `protobuf:"varint,3,opt,name=number_of_evidences,json=numberOfEvidences,proto3" json:"number_of_evidences,omitempty"`
+	// number of selected catalogs per target of evaluation
+	NumberOfSelectedCatalogs int64 `protobuf:"varint,4,opt,name=number_of_selected_catalogs,json=numberOfSelectedCatalogs,proto3" json:"number_of_selected_catalogs,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *GetTargetOfEvaluationStatisticsResponse) Reset() {
+	*x = GetTargetOfEvaluationStatisticsResponse{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTargetOfEvaluationStatisticsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTargetOfEvaluationStatisticsResponse) ProtoMessage() {}
+
+func (x *GetTargetOfEvaluationStatisticsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTargetOfEvaluationStatisticsResponse.ProtoReflect.Descriptor instead.
+func (*GetTargetOfEvaluationStatisticsResponse) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *GetTargetOfEvaluationStatisticsResponse) GetNumberOfDiscoveredResources() int64 {
+	if x != nil {
+		return x.NumberOfDiscoveredResources
+	}
+	return 0
+}
+
+func (x *GetTargetOfEvaluationStatisticsResponse) GetNumberOfAssessmentResults() int64 {
+	if x != nil {
+		return x.NumberOfAssessmentResults
+	}
+	return 0
+}
+
+func (x *GetTargetOfEvaluationStatisticsResponse) GetNumberOfEvidences() int64 {
+	if x != nil {
+		return x.NumberOfEvidences
+	}
+	return 0
+}
+
+func (x *GetTargetOfEvaluationStatisticsResponse) GetNumberOfSelectedCatalogs() int64 {
+	if x != nil {
+		return x.NumberOfSelectedCatalogs
+	}
+	return 0
+}
+
+type GetUsageReportRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Optional. Reports only the usage of a specific target of evaluation.
+	TargetOfEvaluationId *string `protobuf:"bytes,1,opt,name=target_of_evaluation_id,json=targetOfEvaluationId,proto3,oneof" json:"target_of_evaluation_id,omitempty"`
+	// Optional. The first day (UTC) of the report in the format YYYY-MM-DD.
+	StartDay *string `protobuf:"bytes,2,opt,name=start_day,json=startDay,proto3,oneof" json:"start_day,omitempty"`
+	// Optional. The last day (UTC) of the report in the format YYYY-MM-DD.
+	EndDay        *string `protobuf:"bytes,3,opt,name=end_day,json=endDay,proto3,oneof" json:"end_day,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUsageReportRequest) Reset() {
+	*x = GetUsageReportRequest{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUsageReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUsageReportRequest) ProtoMessage() {}
+
+func (x *GetUsageReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUsageReportRequest.ProtoReflect.Descriptor instead.
+func (*GetUsageReportRequest) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *GetUsageReportRequest) GetTargetOfEvaluationId() string {
+	if x != nil && x.TargetOfEvaluationId != nil {
+		return *x.TargetOfEvaluationId
+	}
+	return ""
+}
+
+func (x *GetUsageReportRequest) GetStartDay() string {
+	if x != nil && x.StartDay != nil {
+		return *x.StartDay
+	}
+	return ""
+}
+
+func (x *GetUsageReportRequest) GetEndDay() string {
+	if x != nil && x.EndDay != nil {
+		return *x.EndDay
+	}
+	return ""
+}
+
+// UsageReport is the metered usage of targets of evaluation within a period.
+type UsageReport struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The usage per target of evaluation, sorted by the target of evaluation ID.
+	TargetsOfEvaluation []*TargetOfEvaluationUsage `protobuf:"bytes,1,rep,name=targets_of_evaluation,json=targetsOfEvaluation,proto3" json:"targets_of_evaluation,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *UsageReport) Reset() {
+	*x = UsageReport{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UsageReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UsageReport) ProtoMessage() {}
+
+func (x *UsageReport) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UsageReport.ProtoReflect.Descriptor instead.
+func (*UsageReport) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *UsageReport) GetTargetsOfEvaluation() []*TargetOfEvaluationUsage {
+	if x != nil {
+		return x.TargetsOfEvaluation
+	}
+	return nil
+}
+
+// TargetOfEvaluationUsage is the metered usage of a target of evaluation within
+// the period of a UsageReport.
+type TargetOfEvaluationUsage struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	TargetOfEvaluationId string                 `protobuf:"bytes,1,opt,name=target_of_evaluation_id,json=targetOfEvaluationId,proto3" json:"target_of_evaluation_id,omitempty"`
+	// The number of evidences within the period, see UsageRecord.
+	Evidences int64 `protobuf:"varint,2,opt,name=evidences,proto3" json:"evidences,omitempty"`
+	// The number of assessment results within the period, see UsageRecord.
+	AssessmentResults int64 `protobuf:"varint,3,opt,name=assessment_results,json=assessmentResults,proto3" json:"assessment_results,omitempty"`
+	// The number of evaluation runs within the period, see UsageRecord.
+	EvaluationRuns int64 `protobuf:"varint,4,opt,name=evaluation_runs,json=evaluationRuns,proto3" json:"evaluation_runs,omitempty"`
+	// The number of stored bytes within the period, see UsageRecord.
+	StorageBytes int64 `protobuf:"varint,5,opt,name=storage_bytes,json=storageBytes,proto3" json:"storage_bytes,omitempty"`
+	// The usage per day, sorted by the day.
+	Days          []*UsageRecord `protobuf:"bytes,6,rep,name=days,proto3" json:"days,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TargetOfEvaluationUsage) Reset() {
+	*x = TargetOfEvaluationUsage{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TargetOfEvaluationUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TargetOfEvaluationUsage) ProtoMessage() {}
+
+func (x *TargetOfEvaluationUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TargetOfEvaluationUsage.ProtoReflect.Descriptor instead.
+func (*TargetOfEvaluationUsage) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *TargetOfEvaluationUsage) GetTargetOfEvaluationId() string {
+	if x != nil {
+		return x.TargetOfEvaluationId
+	}
+	return ""
+}
+
+func (x *TargetOfEvaluationUsage) GetEvidences() int64 {
+	if x != nil {
+		return x.Evidences
+	}
+	return 0
+}
+
+func (x *TargetOfEvaluationUsage) GetAssessmentResults() int64 {
+	if x != nil {
+		return x.AssessmentResults
+	}
+	return 0
+}
+
+func (x *TargetOfEvaluationUsage) GetEvaluationRuns() int64 {
+	if x != nil {
+		return x.EvaluationRuns
+	}
+	return 0
+}
+
+func (x *TargetOfEvaluationUsage) GetStorageBytes() int64 {
+	if x != nil {
+		return x.StorageBytes
+	}
+	return 0
+}
+
+func (x *TargetOfEvaluationUsage) GetDays() []*UsageRecord {
+	if x != nil {
+		return x.Days
+	}
+	return nil
+}
+
+// A UsageRecord is the metered usage of a target of evaluation on a single day
+// (UTC). The records are not removed together with the target of evaluation,
+// so that its usage can still be billed.
+type UsageRecord struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	TargetOfEvaluationId string                 `protobuf:"bytes,1,opt,name=target_of_evaluation_id,json=targetOfEvaluationId,proto3" json:"target_of_evaluation_id,omitempty" gorm:"primaryKey"`
+	// The day in the format YYYY-MM-DD.
+	Day string `protobuf:"bytes,2,opt,name=day,proto3" json:"day,omitempty" gorm:"primaryKey"`
+	// The number of evidences, for which assessment results were stored.
+	Evidences int64 `protobuf:"varint,3,opt,name=evidences,proto3" json:"evidences,omitempty"`
+	// The number of stored assessment results.
+	AssessmentResults int64 `protobuf:"varint,4,opt,name=assessment_results,json=assessmentResults,proto3" json:"assessment_results,omitempty"`
+	// The number of evaluation runs, for which evaluation results were stored.
+	EvaluationRuns int64 `protobuf:"varint,5,opt,name=evaluation_runs,json=evaluationRuns,proto3" json:"evaluation_runs,omitempty"`
+	// The number of bytes of the stored assessment results, evaluation results
+	// and attachments.
+	StorageBytes  int64 `protobuf:"varint,6,opt,name=storage_bytes,json=storageBytes,proto3" json:"storage_bytes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UsageRecord) Reset() {
+	*x = UsageRecord{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UsageRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UsageRecord) ProtoMessage() {}
+
+func (x *UsageRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UsageRecord.ProtoReflect.Descriptor instead.
+func (*UsageRecord) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *UsageRecord) GetTargetOfEvaluationId() string {
+	if x != nil {
+		return x.TargetOfEvaluationId
+	}
+	return ""
+}
+
+func (x *UsageRecord) GetDay() string {
+	if x != nil {
+		return x.Day
+	}
+	return ""
+}
+
+func (x *UsageRecord) GetEvidences() int64 {
+	if x != nil {
+		return x.Evidences
+	}
+	return 0
+}
+
+func (x *UsageRecord) GetAssessmentResults() int64 {
+	if x != nil {
+		return x.AssessmentResults
+	}
+	return 0
+}
+
+func (x *UsageRecord) GetEvaluationRuns() int64 {
+	if x != nil {
+		return x.EvaluationRuns
+	}
+	return 0
+}
+
+func (x *UsageRecord) GetStorageBytes() int64 {
+	if x != nil {
+		return x.StorageBytes
+	}
+	return 0
+}
+
+// A Secret is a credential of a target of evaluation, e.g., read-only API
+// credentials of the system under evaluation. Secrets are managed centrally in
+// the orchestrator and read by collectors with AccessSecret, instead of being
+// configured in the environment of each collector.
+type Secret struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Secret ID
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty" gorm:"primaryKey"`
+	// The target of evaluation the secret belongs to
+	TargetOfEvaluationId string `protobuf:"bytes,2,opt,name=target_of_evaluation_id,json=targetOfEvaluationId,proto3" json:"target_of_evaluation_id,omitempty" gorm:"uniqueIndex:idx_secret_target_of_evaluation_name"`
+	// The name of the secret, which is unique per target of evaluation, e.g.,
+	// aws-read-only. Collectors access the secret by its name.
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty" gorm:"uniqueIndex:idx_secret_target_of_evaluation_name"`
+	// The value of the secret. It is stored encrypted with the secret key of the
+	// orchestrator and never returned, use AccessSecret to read it.
+	Value string `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
+	// An optional description of the secret, e.g., which collector uses it
+	Description *string `protobuf:"bytes,5,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	// The user that created the secret
+	CreatedBy string `protobuf:"bytes,6,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	// Creation time of the secret
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty" gorm:"serializer:timestamppb;type:timestamp"`
+	// Time of the last update of the secret
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty" gorm:"serializer:timestamppb;type:timestamp"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Secret) Reset() {
+	*x = Secret{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Secret) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Secret) ProtoMessage() {}
+
+func (x *Secret) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Secret.ProtoReflect.Descriptor instead.
+func (*Secret) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *Secret) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Secret) GetTargetOfEvaluationId() string {
+	if x != nil {
+		return x.TargetOfEvaluationId
+	}
+	return ""
+}
+
+func (x *Secret) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Secret) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *Secret) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *Secret) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *Secret) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Secret) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// SecretValue contains the decrypted value of a secret.
+type SecretValue struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SecretId      string                 `protobuf:"bytes,1,opt,name=secret_id,json=secretId,proto3" json:"secret_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Value         string                 `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SecretValue) Reset() {
+	*x = SecretValue{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SecretValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SecretValue) ProtoMessage() {}
+
+func (x *SecretValue) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SecretValue.ProtoReflect.Descriptor instead.
+func (*SecretValue) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *SecretValue) GetSecretId() string {
+	if x != nil {
+		return x.SecretId
+	}
+	return ""
+}
+
+func (x *SecretValue) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SecretValue) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type CreateSecretRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Secret        *Secret                `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSecretRequest) Reset() {
+	*x = CreateSecretRequest{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSecretRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSecretRequest) ProtoMessage() {}
+
+func (x *CreateSecretRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSecretRequest.ProtoReflect.Descriptor instead.
+func (*CreateSecretRequest) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *CreateSecretRequest) GetSecret() *Secret {
+	if x != nil {
+		return x.Secret
+	}
+	return nil
+}
+
+type ListSecretsRequest struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	TargetOfEvaluationId string                 `protobuf:"bytes,1,opt,name=target_of_evaluation_id,json=targetOfEvaluationId,proto3" json:"target_of_evaluation_id,omitempty"`
+	PageSize             int32                  `protobuf:"varint,10,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken            string                 `protobuf:"bytes,11,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	OrderBy              string                 `protobuf:"bytes,12,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	Asc                  bool                   `protobuf:"varint,13,opt,name=asc,proto3" json:"asc,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *ListSecretsRequest) Reset() {
+	*x = ListSecretsRequest{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSecretsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSecretsRequest) ProtoMessage() {}
+
+func (x *ListSecretsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSecretsRequest.ProtoReflect.Descriptor instead.
+func (*ListSecretsRequest) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *ListSecretsRequest) GetTargetOfEvaluationId() string {
+	if x != nil {
+		return x.TargetOfEvaluationId
+	}
+	return ""
+}
+
+func (x *ListSecretsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListSecretsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListSecretsRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+func (x *ListSecretsRequest) GetAsc() bool {
+	if x != nil {
+		return x.Asc
+	}
+	return false
+}
+
+type ListSecretsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Secrets       []*Secret              `protobuf:"bytes,1,rep,name=secrets,proto3" json:"secrets,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSecretsResponse) Reset() {
+	*x = ListSecretsResponse{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSecretsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSecretsResponse) ProtoMessage() {}
+
+func (x *ListSecretsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSecretsResponse.ProtoReflect.Descriptor instead.
+func (*ListSecretsResponse) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *ListSecretsResponse) GetSecrets() []*Secret {
+	if x != nil {
+		return x.Secrets
+	}
+	return nil
+}
+
+func (x *ListSecretsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type UpdateSecretRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Secret        *Secret                `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSecretRequest) Reset() {
+	*x = UpdateSecretRequest{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSecretRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSecretRequest) ProtoMessage() {}
+
+func (x *UpdateSecretRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSecretRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSecretRequest) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *UpdateSecretRequest) GetSecret() *Secret {
+	if x != nil {
+		return x.Secret
+	}
+	return nil
+}
+
+type RemoveSecretRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SecretId      string                 `protobuf:"bytes,1,opt,name=secret_id,json=secretId,proto3" json:"secret_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveSecretRequest) Reset() {
+	*x = RemoveSecretRequest{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveSecretRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveSecretRequest) ProtoMessage() {}
+
+func (x *RemoveSecretRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveSecretRequest.ProtoReflect.Descriptor instead.
+func (*RemoveSecretRequest) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *RemoveSecretRequest) GetSecretId() string {
+	if x != nil {
+		return x.SecretId
+	}
+	return ""
+}
+
+type AccessSecretRequest struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	TargetOfEvaluationId string                 `protobuf:"bytes,1,opt,name=target_of_evaluation_id,json=targetOfEvaluationId,proto3" json:"target_of_evaluation_id,omitempty"`
+	Name                 string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *AccessSecretRequest) Reset() {
+	*x = AccessSecretRequest{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccessSecretRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccessSecretRequest) ProtoMessage() {}
+
+func (x *AccessSecretRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccessSecretRequest.ProtoReflect.Descriptor instead.
+func (*AccessSecretRequest) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *AccessSecretRequest) GetTargetOfEvaluationId() string {
+	if x != nil {
+		return x.TargetOfEvaluationId
+	}
+	return ""
+}
+
+func (x *AccessSecretRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// A ResourceInventory summarizes the resources a collector discovered for a
+// target of evaluation in its last run, e.g., to compare what exists with what
+// is assessed.
+type ResourceInventory struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The target of evaluation the resources belong to
+	TargetOfEvaluationId string `protobuf:"bytes,1,opt,name=target_of_evaluation_id,json=targetOfEvaluationId,proto3" json:"target_of_evaluation_id,omitempty" gorm:"primaryKey"`
+	// The ID of the collector that discovered the resources, e.g., the ID of the
+	// Azure collector of a subscription. Each collector has its own inventory.
+	CollectorId string `protobuf:"bytes,2,opt,name=collector_id,json=collectorId,proto3" json:"collector_id,omitempty" gorm:"primaryKey"`
+	// The ID of the tool the collector is part of
+	ToolId string `protobuf:"bytes,3,opt,name=tool_id,json=toolId,proto3" json:"tool_id,omitempty"`
+	// The environment (e.g., prod or staging) of the resources, if the collector
+	// is restricted to one
+	Environment *string `protobuf:"bytes,4,opt,name=environment,proto3,oneof" json:"environment,omitempty"`
+	// The number of discovered resources per resource type
+	ResourceTypes []*ResourceTypeCount `protobuf:"bytes,5,rep,name=resource_types,json=resourceTypes,proto3" json:"resource_types,omitempty" gorm:"serializer:json"`
+	// The regions the resources are located in, e.g., eu-central-1
+	Regions []string `protobuf:"bytes,6,rep,name=regions,proto3" json:"regions,omitempty" gorm:"serializer:json"`
+	// The accounts the resources belong to, e.g., the IDs of the discovered
+	// accounts, subscriptions or resource groups
+	Accounts []string `protobuf:"bytes,7,rep,name=accounts,proto3" json:"accounts,omitempty" gorm:"serializer:json"`
+	// Time the collector discovered the resources
+	CollectedAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=collected_at,json=collectedAt,proto3" json:"collected_at,omitempty" gorm:"serializer:timestamppb;type:timestamp"`
+	// Time the inventory was stored
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty" gorm:"serializer:timestamppb;type:timestamp"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResourceInventory) Reset() {
+	*x = ResourceInventory{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResourceInventory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResourceInventory) ProtoMessage() {}
+
+func (x *ResourceInventory) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResourceInventory.ProtoReflect.Descriptor instead.
+func (*ResourceInventory) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *ResourceInventory) GetTargetOfEvaluationId() string {
+	if x != nil {
+		return x.TargetOfEvaluationId
+	}
+	return ""
+}
+
+func (x *ResourceInventory) GetCollectorId() string {
+	if x != nil {
+		return x.CollectorId
+	}
+	return ""
+}
+
+func (x *ResourceInventory) GetToolId() string {
+	if x != nil {
+		return x.ToolId
+	}
+	return ""
+}
+
+func (x *ResourceInventory) GetEnvironment() string {
+	if x != nil && x.Environment != nil {
+		return *x.Environment
+	}
+	return ""
+}
+
+func (x *ResourceInventory) GetResourceTypes() []*ResourceTypeCount {
+	if x != nil {
+		return x.ResourceTypes
+	}
+	return nil
+}
+
+func (x *ResourceInventory) GetRegions() []string {
+	if x != nil {
+		return x.Regions
+	}
+	return nil
+}
+
+func (x *ResourceInventory) GetAccounts() []string {
+	if x != nil {
+		return x.Accounts
+	}
+	return nil
+}
+
+func (x *ResourceInventory) GetCollectedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CollectedAt
+	}
+	return nil
+}
+
+func (x *ResourceInventory) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// A ResourceTypeCount is the number of discovered resources of a resource
+// type.
+type ResourceTypeCount struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The most specific ontology type of the resources, e.g., VirtualMachine
+	ResourceType string `protobuf:"bytes,1,opt,name=resource_type,json=resourceType,proto3" json:"resource_type,omitempty"`
+	// The number of resources of the type
+	Count         int64 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResourceTypeCount) Reset() {
+	*x = ResourceTypeCount{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResourceTypeCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResourceTypeCount) ProtoMessage() {}
+
+func (x *ResourceTypeCount) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResourceTypeCount.ProtoReflect.Descriptor instead.
+func (*ResourceTypeCount) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *ResourceTypeCount) GetResourceType() string {
+	if x != nil {
+		return x.ResourceType
+	}
+	return ""
+}
+
+func (x *ResourceTypeCount) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type StoreResourceInventoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Inventory     *ResourceInventory     `protobuf:"bytes,1,opt,name=inventory,proto3" json:"inventory,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StoreResourceInventoryRequest) Reset() {
+	*x = StoreResourceInventoryRequest{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StoreResourceInventoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StoreResourceInventoryRequest) ProtoMessage() {}
+
+func (x *StoreResourceInventoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StoreResourceInventoryRequest.ProtoReflect.Descriptor instead.
+func (*StoreResourceInventoryRequest) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *StoreResourceInventoryRequest) GetInventory() *ResourceInventory {
+	if x != nil {
+		return x.Inventory
+	}
+	return nil
+}
+
+type ListResourceInventoriesRequest struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	TargetOfEvaluationId string                 `protobuf:"bytes,1,opt,name=target_of_evaluation_id,json=targetOfEvaluationId,proto3" json:"target_of_evaluation_id,omitempty"`
+	PageSize             int32                  `protobuf:"varint,10,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken            string                 `protobuf:"bytes,11,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	OrderBy              string                 `protobuf:"bytes,12,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	Asc                  bool                   `protobuf:"varint,13,opt,name=asc,proto3" json:"asc,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *ListResourceInventoriesRequest) Reset() {
+	*x = ListResourceInventoriesRequest{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListResourceInventoriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListResourceInventoriesRequest) ProtoMessage() {}
+
+func (x *ListResourceInventoriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListResourceInventoriesRequest.ProtoReflect.Descriptor instead.
+func (*ListResourceInventoriesRequest) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *ListResourceInventoriesRequest) GetTargetOfEvaluationId() string {
+	if x != nil {
+		return x.TargetOfEvaluationId
+	}
+	return ""
+}
+
+func (x *ListResourceInventoriesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListResourceInventoriesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListResourceInventoriesRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+func (x *ListResourceInventoriesRequest) GetAsc() bool {
+	if x != nil {
+		return x.Asc
+	}
+	return false
+}
+
+type ListResourceInventoriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Inventories   []*ResourceInventory   `protobuf:"bytes,1,rep,name=inventories,proto3" json:"inventories,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListResourceInventoriesResponse) Reset() {
+	*x = ListResourceInventoriesResponse{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListResourceInventoriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListResourceInventoriesResponse) ProtoMessage() {}
+
+func (x *ListResourceInventoriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListResourceInventoriesResponse.ProtoReflect.Descriptor instead.
+func (*ListResourceInventoriesResponse) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *ListResourceInventoriesResponse) GetInventories() []*ResourceInventory {
+	if x != nil {
+		return x.Inventories
+	}
+	return nil
+}
+
+func (x *ListResourceInventoriesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type UpdateMetadataFieldRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Field         *MetadataField         `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateMetadataFieldRequest) Reset() {
+	*x = UpdateMetadataFieldRequest{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateMetadataFieldRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMetadataFieldRequest) ProtoMessage() {}
+
+func (x *UpdateMetadataFieldRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMetadataFieldRequest.ProtoReflect.Descriptor instead.
+func (*UpdateMetadataFieldRequest) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *UpdateMetadataFieldRequest) GetField() *MetadataField {
+	if x != nil {
+		return x.Field
+	}
+	return nil
+}
+
+type ListMetadataFieldsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageSize      int32                  `protobuf:"varint,10,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,11,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	OrderBy       string                 `protobuf:"bytes,12,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	Asc           bool                   `protobuf:"varint,13,opt,name=asc,proto3" json:"asc,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMetadataFieldsRequest) Reset() {
+	*x = ListMetadataFieldsRequest{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMetadataFieldsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMetadataFieldsRequest) ProtoMessage() {}
+
+func (x *ListMetadataFieldsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMetadataFieldsRequest.ProtoReflect.Descriptor instead.
+func (*ListMetadataFieldsRequest) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *ListMetadataFieldsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListMetadataFieldsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListMetadataFieldsRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+func (x *ListMetadataFieldsRequest) GetAsc() bool {
+	if x != nil {
+		return x.Asc
+	}
+	return false
+}
+
+type ListMetadataFieldsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Fields        []*MetadataField       `protobuf:"bytes,1,rep,name=fields,proto3" json:"fields,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMetadataFieldsResponse) Reset() {
+	*x = ListMetadataFieldsResponse{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMetadataFieldsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMetadataFieldsResponse) ProtoMessage() {}
+
+func (x *ListMetadataFieldsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMetadataFieldsResponse.ProtoReflect.Descriptor instead.
+func (*ListMetadataFieldsResponse) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *ListMetadataFieldsResponse) GetFields() []*MetadataField {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *ListMetadataFieldsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type RemoveMetadataFieldRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveMetadataFieldRequest) Reset() {
+	*x = RemoveMetadataFieldRequest{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveMetadataFieldRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveMetadataFieldRequest) ProtoMessage() {}
+
+func (x *RemoveMetadataFieldRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveMetadataFieldRequest.ProtoReflect.Descriptor instead.
+func (*RemoveMetadataFieldRequest) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *RemoveMetadataFieldRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type UpdateRedactionRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rule          *RedactionRule         `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateRedactionRuleRequest) Reset() {
+	*x = UpdateRedactionRuleRequest{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateRedactionRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRedactionRuleRequest) ProtoMessage() {}
+
+func (x *UpdateRedactionRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRedactionRuleRequest.ProtoReflect.Descriptor instead.
+func (*UpdateRedactionRuleRequest) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *UpdateRedactionRuleRequest) GetRule() *RedactionRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+type ListRedactionRulesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageSize      int32                  `protobuf:"varint,10,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,11,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	OrderBy       string                 `protobuf:"bytes,12,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	Asc           bool                   `protobuf:"varint,13,opt,name=asc,proto3" json:"asc,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRedactionRulesRequest) Reset() {
+	*x = ListRedactionRulesRequest{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRedactionRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRedactionRulesRequest) ProtoMessage() {}
+
+func (x *ListRedactionRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRedactionRulesRequest.ProtoReflect.Descriptor instead.
+func (*ListRedactionRulesRequest) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *ListRedactionRulesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListRedactionRulesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListRedactionRulesRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+func (x *ListRedactionRulesRequest) GetAsc() bool {
+	if x != nil {
+		return x.Asc
+	}
+	return false
+}
+
+type ListRedactionRulesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rules         []*RedactionRule       `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRedactionRulesResponse) Reset() {
+	*x = ListRedactionRulesResponse{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRedactionRulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRedactionRulesResponse) ProtoMessage() {}
+
+func (x *ListRedactionRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRedactionRulesResponse.ProtoReflect.Descriptor instead.
+func (*ListRedactionRulesResponse) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *ListRedactionRulesResponse) GetRules() []*RedactionRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+func (x *ListRedactionRulesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type RemoveRedactionRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ResourceType  string                 `protobuf:"bytes,1,opt,name=resource_type,json=resourceType,proto3" json:"resource_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveRedactionRuleRequest) Reset() {
+	*x = RemoveRedactionRuleRequest{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveRedactionRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveRedactionRuleRequest) ProtoMessage() {}
+
+func (x *RemoveRedactionRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveRedactionRuleRequest.ProtoReflect.Descriptor instead.
+func (*RemoveRedactionRuleRequest) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *RemoveRedactionRuleRequest) GetResourceType() string {
+	if x != nil {
+		return x.ResourceType
+	}
+	return ""
+}
+
+type UpdateMetricConfigurationRequest struct {
+	state         protoimpl.MessageState          `protogen:"open.v1"`
+	Configuration *assessment.MetricConfiguration `protobuf:"bytes,1,opt,name=configuration,proto3" json:"configuration,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateMetricConfigurationRequest) Reset() {
+	*x = UpdateMetricConfigurationRequest{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateMetricConfigurationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMetricConfigurationRequest) ProtoMessage() {}
+
+func (x *UpdateMetricConfigurationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMetricConfigurationRequest.ProtoReflect.Descriptor instead.
+func (*UpdateMetricConfigurationRequest) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *UpdateMetricConfigurationRequest) GetConfiguration() *assessment.MetricConfiguration {
+	if x != nil {
+		return x.Configuration
+	}
+	return nil
+}
+
+type GetMetricConfigurationRequest struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	TargetOfEvaluationId string                 `protobuf:"bytes,1,opt,name=target_of_evaluation_id,json=targetOfEvaluationId,proto3" json:"target_of_evaluation_id,omitempty"`
+	MetricId             string                 `protobuf:"bytes,2,opt,name=metric_id,json=metricId,proto3" json:"metric_id,omitempty"`
+	// Optional. The catalog whose default configuration should be taken into
+	// account. If it is not set, the default configuration of the catalog is
+	// used if exactly one catalog of the audit scopes of the target of
+	// evaluation defines one.
+	CatalogId     *string `protobuf:"bytes,3,opt,name=catalog_id,json=catalogId,proto3,oneof" json:"catalog_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMetricConfigurationRequest) Reset() {
+	*x = GetMetricConfigurationRequest{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMetricConfigurationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMetricConfigurationRequest) ProtoMessage() {}
+
+func (x *GetMetricConfigurationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMetricConfigurationRequest.ProtoReflect.Descriptor instead.
+func (*GetMetricConfigurationRequest) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *GetMetricConfigurationRequest) GetTargetOfEvaluationId() string {
+	if x != nil {
+		return x.TargetOfEvaluationId
+	}
+	return ""
+}
+
+func (x *GetMetricConfigurationRequest) GetMetricId() string {
+	if x != nil {
+		return x.MetricId
+	}
+	return ""
+}
+
+func (x *GetMetricConfigurationRequest) GetCatalogId() string {
+	if x != nil && x.CatalogId != nil {
+		return *x.CatalogId
+	}
+	return ""
+}
+
+type ResolveMetricConfigurationRequest struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	TargetOfEvaluationId string                 `protobuf:"bytes,1,opt,name=target_of_evaluation_id,json=targetOfEvaluationId,proto3" json:"target_of_evaluation_id,omitempty"`
+	MetricId             string                 `protobuf:"bytes,2,opt,name=metric_id,json=metricId,proto3" json:"metric_id,omitempty"`
+	// Optional. The catalog whose default configuration should be taken into
+	// account, see GetMetricConfigurationRequest.
+	CatalogId     *string `protobuf:"bytes,3,opt,name=catalog_id,json=catalogId,proto3,oneof" json:"catalog_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveMetricConfigurationRequest) Reset() {
+	*x = ResolveMetricConfigurationRequest{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveMetricConfigurationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveMetricConfigurationRequest) ProtoMessage() {}
+
+func (x *ResolveMetricConfigurationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveMetricConfigurationRequest.ProtoReflect.Descriptor instead.
+func (*ResolveMetricConfigurationRequest) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *ResolveMetricConfigurationRequest) GetTargetOfEvaluationId() string {
+	if x != nil {
+		return x.TargetOfEvaluationId
+	}
+	return ""
+}
+
+func (x *ResolveMetricConfigurationRequest) GetMetricId() string {
+	if x != nil {
+		return x.MetricId
+	}
+	return ""
+}
+
+func (x *ResolveMetricConfigurationRequest) GetCatalogId() string {
+	if x != nil && x.CatalogId != nil {
+		return *x.CatalogId
+	}
+	return ""
+}
+
+type ResolveMetricConfigurationResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The effective configuration, as it is returned by GetMetricConfiguration.
+	Configuration *assessment.MetricConfiguration `protobuf:"bytes,1,opt,name=configuration,proto3" json:"configuration,omitempty"`
+	// The layers that were considered, ordered by their precedence from the
+	// global default configuration to the configuration of the target of
+	// evaluation.
+	Chain         []*MetricConfigurationResolutionStep `protobuf:"bytes,2,rep,name=chain,proto3" json:"chain,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveMetricConfigurationResponse) Reset() {
+	*x = ResolveMetricConfigurationResponse{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveMetricConfigurationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveMetricConfigurationResponse) ProtoMessage() {}
+
+func (x *ResolveMetricConfigurationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveMetricConfigurationResponse.ProtoReflect.Descriptor instead.
+func (*ResolveMetricConfigurationResponse) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *ResolveMetricConfigurationResponse) GetConfiguration() *assessment.MetricConfiguration {
+	if x != nil {
+		return x.Configuration
+	}
+	return nil
+}
+
+func (x *ResolveMetricConfigurationResponse) GetChain() []*MetricConfigurationResolutionStep {
+	if x != nil {
+		return x.Chain
+	}
+	return nil
+}
+
+// MetricConfigurationResolutionStep describes a layer that was considered
+// when resolving a metric configuration.
+type MetricConfigurationResolutionStep struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The layer of this step.
+	Source assessment.MetricConfigurationSource `protobuf:"varint,1,opt,name=source,proto3,enum=confirmate.assessment.v1.MetricConfigurationSource" json:"source,omitempty"`
+	// The catalog of the layer, if the source is
+	// METRIC_CONFIGURATION_SOURCE_CATALOG_DEFAULT.
+	CatalogId *string `protobuf:"bytes,2,opt,name=catalog_id,json=catalogId,proto3,oneof" json:"catalog_id,omitempty"`
+	// Whether the layer defines a configuration of the metric.
+	Defined bool `protobuf:"varint,3,opt,name=defined,proto3" json:"defined,omitempty"`
+	// The operator of the configuration of the layer, if it is defined.
+	Operator *string `protobuf:"bytes,4,opt,name=operator,proto3,oneof" json:"operator,omitempty"`
+	// The target value of the configuration of the layer, if it is defined.
+	TargetValue *structpb.Value `protobuf:"bytes,5,opt,name=target_value,json=targetValue,proto3" json:"target_value,omitempty"`
+	// The last time the configuration of the layer was updated, if it is
+	// defined.
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// Whether the configuration of the layer is the effective configuration.
+	Applied bool `protobuf:"varint,7,opt,name=applied,proto3" json:"applied,omitempty"`
+	// A human readable explanation why the layer was applied or not.
+	Reason        string `protobuf:"bytes,8,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MetricConfigurationResolutionStep) Reset() {
+	*x = MetricConfigurationResolutionStep{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MetricConfigurationResolutionStep) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MetricConfigurationResolutionStep) ProtoMessage() {}
+
+func (x *MetricConfigurationResolutionStep) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MetricConfigurationResolutionStep.ProtoReflect.Descriptor instead.
+func (*MetricConfigurationResolutionStep) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *MetricConfigurationResolutionStep) GetSource() assessment.MetricConfigurationSource {
+	if x != nil {
+		return x.Source
+	}
+	return assessment.MetricConfigurationSource(0)
+}
+
+func (x *MetricConfigurationResolutionStep) GetCatalogId() string {
+	if x != nil && x.CatalogId != nil {
+		return *x.CatalogId
+	}
+	return ""
+}
+
+func (x *MetricConfigurationResolutionStep) GetDefined() bool {
+	if x != nil {
+		return x.Defined
+	}
+	return false
+}
+
+func (x *MetricConfigurationResolutionStep) GetOperator() string {
+	if x != nil && x.Operator != nil {
+		return *x.Operator
+	}
+	return ""
+}
+
+func (x *MetricConfigurationResolutionStep) GetTargetValue() *structpb.Value {
+	if x != nil {
+		return x.TargetValue
+	}
+	return nil
+}
+
+func (x *MetricConfigurationResolutionStep) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *MetricConfigurationResolutionStep) GetApplied() bool {
+	if x != nil {
+		return x.Applied
+	}
+	return false
+}
+
+func (x *MetricConfigurationResolutionStep) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ListMetricConfigurationRequest struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	TargetOfEvaluationId string                 `protobuf:"bytes,10,opt,name=target_of_evaluation_id,json=targetOfEvaluationId,proto3" json:"target_of_evaluation_id,omitempty"`
+	PageSize             int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken            string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	OrderBy              string                 `protobuf:"bytes,3,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	Asc                  bool                   `protobuf:"varint,4,opt,name=asc,proto3" json:"asc,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *ListMetricConfigurationRequest) Reset() {
+	*x = ListMetricConfigurationRequest{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMetricConfigurationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMetricConfigurationRequest) ProtoMessage() {}
+
+func (x *ListMetricConfigurationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMetricConfigurationRequest.ProtoReflect.Descriptor instead.
+func (*ListMetricConfigurationRequest) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *ListMetricConfigurationRequest) GetTargetOfEvaluationId() string {
+	if x != nil {
+		return x.TargetOfEvaluationId
+	}
+	return ""
+}
+
+func (x *ListMetricConfigurationRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListMetricConfigurationRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListMetricConfigurationRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+func (x *ListMetricConfigurationRequest) GetAsc() bool {
+	if x != nil {
+		return x.Asc
+	}
+	return false
+}
+
+type ListMetricConfigurationResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// A map of metric configurations associated by their metric ID
+	Configurations map[string]*assessment.MetricConfiguration `protobuf:"bytes,1,rep,name=configurations,proto3" json:"configurations,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	NextPageToken  string                                     `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListMetricConfigurationResponse) Reset() {
+	*x = ListMetricConfigurationResponse{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMetricConfigurationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMetricConfigurationResponse) ProtoMessage() {}
+
+func (x *ListMetricConfigurationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMetricConfigurationResponse.ProtoReflect.Descriptor instead.
+func (*ListMetricConfigurationResponse) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *ListMetricConfigurationResponse) GetConfigurations() map[string]*assessment.MetricConfiguration {
+	if x != nil {
+		return x.Configurations
+	}
+	return nil
+}
+
+func (x *ListMetricConfigurationResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type UpdateCatalogMetricConfigurationRequest struct {
+	state         protoimpl.MessageState                 `protogen:"open.v1"`
+	Configuration *assessment.CatalogMetricConfiguration `protobuf:"bytes,1,opt,name=configuration,proto3" json:"configuration,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCatalogMetricConfigurationRequest) Reset() {
+	*x = UpdateCatalogMetricConfigurationRequest{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCatalogMetricConfigurationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCatalogMetricConfigurationRequest) ProtoMessage() {}
+
+func (x *UpdateCatalogMetricConfigurationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCatalogMetricConfigurationRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCatalogMetricConfigurationRequest) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *UpdateCatalogMetricConfigurationRequest) GetConfiguration() *assessment.CatalogMetricConfiguration {
+	if x != nil {
+		return x.Configuration
+	}
+	return nil
+}
+
+type ListCatalogMetricConfigurationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CatalogId     string                 `protobuf:"bytes,10,opt,name=catalog_id,json=catalogId,proto3" json:"catalog_id,omitempty"`
+	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	OrderBy       string                 `protobuf:"bytes,3,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	Asc           bool                   `protobuf:"varint,4,opt,name=asc,proto3" json:"asc,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCatalogMetricConfigurationsRequest) Reset() {
+	*x = ListCatalogMetricConfigurationsRequest{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCatalogMetricConfigurationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCatalogMetricConfigurationsRequest) ProtoMessage() {}
+
+func (x *ListCatalogMetricConfigurationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCatalogMetricConfigurationsRequest.ProtoReflect.Descriptor instead.
+func (*ListCatalogMetricConfigurationsRequest) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *ListCatalogMetricConfigurationsRequest) GetCatalogId() string {
+	if x != nil {
+		return x.CatalogId
+	}
+	return ""
+}
+
+func (x *ListCatalogMetricConfigurationsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListCatalogMetricConfigurationsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListCatalogMetricConfigurationsRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+func (x *ListCatalogMetricConfigurationsRequest) GetAsc() bool {
+	if x != nil {
+		return x.Asc
+	}
+	return false
+}
+
+type ListCatalogMetricConfigurationsResponse struct {
+	state          protoimpl.MessageState                   `protogen:"open.v1"`
+	Configurations []*assessment.CatalogMetricConfiguration `protobuf:"bytes,1,rep,name=configurations,proto3" json:"configurations,omitempty"`
+	NextPageToken  string                                   `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListCatalogMetricConfigurationsResponse) Reset() {
+	*x = ListCatalogMetricConfigurationsResponse{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCatalogMetricConfigurationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCatalogMetricConfigurationsResponse) ProtoMessage() {}
+
+func (x *ListCatalogMetricConfigurationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCatalogMetricConfigurationsResponse.ProtoReflect.Descriptor instead.
+func (*ListCatalogMetricConfigurationsResponse) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *ListCatalogMetricConfigurationsResponse) GetConfigurations() []*assessment.CatalogMetricConfiguration {
+	if x != nil {
+		return x.Configurations
+	}
+	return nil
+}
+
+func (x *ListCatalogMetricConfigurationsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type RemoveCatalogMetricConfigurationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CatalogId     string                 `protobuf:"bytes,1,opt,name=catalog_id,json=catalogId,proto3" json:"catalog_id,omitempty"`
+	MetricId      string                 `protobuf:"bytes,2,opt,name=metric_id,json=metricId,proto3" json:"metric_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveCatalogMetricConfigurationRequest) Reset() {
+	*x = RemoveCatalogMetricConfigurationRequest{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveCatalogMetricConfigurationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveCatalogMetricConfigurationRequest) ProtoMessage() {}
+
+func (x *RemoveCatalogMetricConfigurationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveCatalogMetricConfigurationRequest.ProtoReflect.Descriptor instead.
+func (*RemoveCatalogMetricConfigurationRequest) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *RemoveCatalogMetricConfigurationRequest) GetCatalogId() string {
+	if x != nil {
+		return x.CatalogId
+	}
+	return ""
+}
+
+func (x *RemoveCatalogMetricConfigurationRequest) GetMetricId() string {
+	if x != nil {
+		return x.MetricId
+	}
+	return ""
+}
+
+type UpdateMetricImplementationRequest struct {
+	state          protoimpl.MessageState           `protogen:"open.v1"`
+	Implementation *assessment.MetricImplementation `protobuf:"bytes,1,opt,name=implementation,proto3" json:"implementation,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UpdateMetricImplementationRequest) Reset() {
+	*x = UpdateMetricImplementationRequest{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateMetricImplementationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMetricImplementationRequest) ProtoMessage() {}
+
+func (x *UpdateMetricImplementationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMetricImplementationRequest.ProtoReflect.Descriptor instead.
+func (*UpdateMetricImplementationRequest) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *UpdateMetricImplementationRequest) GetImplementation() *assessment.MetricImplementation {
+	if x != nil {
+		return x.Implementation
+	}
+	return nil
+}
+
+type GetMetricImplementationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MetricId      string                 `protobuf:"bytes,1,opt,name=metric_id,json=metricId,proto3" json:"metric_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMetricImplementationRequest) Reset() {
+	*x = GetMetricImplementationRequest{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMetricImplementationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMetricImplementationRequest) ProtoMessage() {}
+
+func (x *GetMetricImplementationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMetricImplementationRequest.ProtoReflect.Descriptor instead.
+func (*GetMetricImplementationRequest) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *GetMetricImplementationRequest) GetMetricId() string {
+	if x != nil {
+		return x.MetricId
+	}
+	return ""
+}
+
+type SubscribeRequest struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Filter        *SubscribeRequest_Filter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubscribeRequest) Reset() {
+	*x = SubscribeRequest{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscribeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeRequest) ProtoMessage() {}
+
+func (x *SubscribeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeRequest) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *SubscribeRequest) GetFilter() *SubscribeRequest_Filter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+// ChangeEvent represents a change that occurred to an entity managed by the orchestrator.
+type ChangeEvent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Metadata common to all events
+	Timestamp *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty" gorm:"serializer:timestamppb;type:timestamp"`
+	// Event category (what type of entity changed)
+	Category EventCategory `protobuf:"varint,2,opt,name=category,proto3,enum=confirmate.orchestrator.v1.EventCategory" json:"category,omitempty"`
+	// Request type (what happened to the entity)
+	RequestType RequestType `protobuf:"varint,3,opt,name=request_type,json=requestType,proto3,enum=confirmate.orchestrator.v1.RequestType" json:"request_type,omitempty"`
+	// Entity ID (always present for reference)
+	EntityId string `protobuf:"bytes,4,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
+	// TargetOfEvaluationId is an optional target of evaluation ID (for metric configuration changes).
+	// When present, should be a valid UUID.
+	TargetOfEvaluationId *string `protobuf:"bytes,5,opt,name=target_of_evaluation_id,json=targetOfEvaluationId,proto3,oneof" json:"target_of_evaluation_id,omitempty"`
+	// The actual entity data (optional, may be omitted for DELETED events)
+	//
+	// Types that are valid to be assigned to Entity:
+	//
+	//	*ChangeEvent_Metric
+	//	*ChangeEvent_TargetOfEvaluation
+	//	*ChangeEvent_AuditScope
+	//	*ChangeEvent_AssessmentResult
+	//	*ChangeEvent_MetricConfiguration
+	//	*ChangeEvent_MetricImplementation
+	//	*ChangeEvent_AssessmentTool
+	//	*ChangeEvent_User
+	//	*ChangeEvent_ControlInScope
+	//	*ChangeEvent_Catalog
+	Entity        isChangeEvent_Entity `protobuf_oneof:"entity"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangeEvent) Reset() {
+	*x = ChangeEvent{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeEvent) ProtoMessage() {}
+
+func (x *ChangeEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeEvent.ProtoReflect.Descriptor instead.
+func (*ChangeEvent) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *ChangeEvent) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *ChangeEvent) GetCategory() EventCategory {
+	if x != nil {
+		return x.Category
+	}
+	return EventCategory_EVENT_CATEGORY_UNSPECIFIED
+}
+
+func (x *ChangeEvent) GetRequestType() RequestType {
+	if x != nil {
+		return x.RequestType
+	}
+	return RequestType_REQUEST_TYPE_UNSPECIFIED
+}
+
+func (x *ChangeEvent) GetEntityId() string {
+	if x != nil {
+		return x.EntityId
+	}
+	return ""
+}
+
+func (x *ChangeEvent) GetTargetOfEvaluationId() string {
+	if x != nil && x.TargetOfEvaluationId != nil {
+		return *x.TargetOfEvaluationId
+	}
+	return ""
+}
+
+func (x *ChangeEvent) GetEntity() isChangeEvent_Entity {
+	if x != nil {
+		return x.Entity
+	}
+	return nil
+}
+
+func (x *ChangeEvent) GetMetric() *assessment.Metric {
+	if x != nil {
+		if x, ok := x.Entity.(*ChangeEvent_Metric); ok {
+			return x.Metric
+		}
+	}
+	return nil
+}
+
+func (x *ChangeEvent) GetTargetOfEvaluation() *TargetOfEvaluation {
+	if x != nil {
+		if x, ok := x.Entity.(*ChangeEvent_TargetOfEvaluation); ok {
+			return x.TargetOfEvaluation
+		}
+	}
+	return nil
+}
+
+func (x *ChangeEvent) GetAuditScope() *AuditScope {
+	if x != nil {
+		if x, ok := x.Entity.(*ChangeEvent_AuditScope); ok {
+			return x.AuditScope
+		}
+	}
+	return nil
+}
+
+func (x *ChangeEvent) GetAssessmentResult() *assessment.AssessmentResult {
+	if x != nil {
+		if x, ok := x.Entity.(*ChangeEvent_AssessmentResult); ok {
+			return x.AssessmentResult
+		}
+	}
+	return nil
+}
+
+func (x *ChangeEvent) GetMetricConfiguration() *assessment.MetricConfiguration {
+	if x != nil {
+		if x, ok := x.Entity.(*ChangeEvent_MetricConfiguration); ok {
+			return x.MetricConfiguration
+		}
+	}
+	return nil
+}
+
+func (x *ChangeEvent) GetMetricImplementation() *assessment.MetricImplementation {
+	if x != nil {
+		if x, ok := x.Entity.(*ChangeEvent_MetricImplementation); ok {
+			return x.MetricImplementation
+		}
+	}
+	return nil
+}
+
+func (x *ChangeEvent) GetAssessmentTool() *AssessmentTool {
+	if x != nil {
+		if x, ok := x.Entity.(*ChangeEvent_AssessmentTool); ok {
+			return x.AssessmentTool
+		}
+	}
+	return nil
+}
+
+func (x *ChangeEvent) GetUser() *User {
+	if x != nil {
+		if x, ok := x.Entity.(*ChangeEvent_User); ok {
+			return x.User
+		}
+	}
+	return nil
+}
+
+func (x *ChangeEvent) GetControlInScope() *ControlInScope {
+	if x != nil {
+		if x, ok := x.Entity.(*ChangeEvent_ControlInScope); ok {
+			return x.ControlInScope
+		}
+	}
+	return nil
+}
+
+func (x *ChangeEvent) GetCatalog() *Catalog {
+	if x != nil {
+		if x, ok := x.Entity.(*ChangeEvent_Catalog); ok {
+			return x.Catalog
+		}
+	}
+	return nil
+}
+
+type isChangeEvent_Entity interface {
+	isChangeEvent_Entity()
+}
+
+type ChangeEvent_Metric struct {
+	Metric *assessment.Metric `protobuf:"bytes,10,opt,name=metric,proto3,oneof"`
+}
+
+type ChangeEvent_TargetOfEvaluation struct {
+	TargetOfEvaluation *TargetOfEvaluation `protobuf:"bytes,11,opt,name=target_of_evaluation,json=targetOfEvaluation,proto3,oneof"`
+}
+
+type ChangeEvent_AuditScope struct {
+	AuditScope *AuditScope `protobuf:"bytes,12,opt,name=audit_scope,json=auditScope,proto3,oneof"`
+}
+
+type ChangeEvent_AssessmentResult struct {
+	AssessmentResult *assessment.AssessmentResult `protobuf:"bytes,13,opt,name=assessment_result,json=assessmentResult,proto3,oneof"`
+}
+
+type ChangeEvent_MetricConfiguration struct {
+	MetricConfiguration *assessment.MetricConfiguration `protobuf:"bytes,14,opt,name=metric_configuration,json=metricConfiguration,proto3,oneof"`
+}
+
+type ChangeEvent_MetricImplementation struct {
+	MetricImplementation *assessment.MetricImplementation `protobuf:"bytes,15,opt,name=metric_implementation,json=metricImplementation,proto3,oneof"`
+}
+
+type ChangeEvent_AssessmentTool struct {
+	AssessmentTool *AssessmentTool `protobuf:"bytes,16,opt,name=assessment_tool,json=assessmentTool,proto3,oneof"`
+}
+
+type ChangeEvent_User struct {
+	User *User `protobuf:"bytes,17,opt,name=user,proto3,oneof"`
+}
+
+type ChangeEvent_ControlInScope struct {
+	ControlInScope *ControlInScope `protobuf:"bytes,18,opt,name=control_in_scope,json=controlInScope,proto3,oneof"`
+}
+
+type ChangeEvent_Catalog struct {
+	Catalog *Catalog `protobuf:"bytes,19,opt,name=catalog,proto3,oneof"`
+}
+
+func (*ChangeEvent_Metric) isChangeEvent_Entity() {}
+
+func (*ChangeEvent_TargetOfEvaluation) isChangeEvent_Entity() {}
+
+func (*ChangeEvent_AuditScope) isChangeEvent_Entity() {}
+
+func (*ChangeEvent_AssessmentResult) isChangeEvent_Entity() {}
+
+func (*ChangeEvent_MetricConfiguration) isChangeEvent_Entity() {}
+
+func (*ChangeEvent_MetricImplementation) isChangeEvent_Entity() {}
+
+func (*ChangeEvent_AssessmentTool) isChangeEvent_Entity() {}
+
+func (*ChangeEvent_User) isChangeEvent_Entity() {}
+
+func (*ChangeEvent_ControlInScope) isChangeEvent_Entity() {}
+
+func (*ChangeEvent_Catalog) isChangeEvent_Entity() {}
+
+// A Webhook receives the change events of the orchestrator that match its
+// filter as JSON-encoded ChangeEvent via HTTP POST. Each delivery is signed
+// with the secret of the webhook, see the X-Confirmate-Signature header, and
+// recorded in the delivery log of the webhook.
+type Webhook struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Webhook ID
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty" gorm:"primaryKey"`
+	// The URL the change events are posted to
+	Url string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	// The secret used to sign the deliveries with HMAC-SHA256. It is never
+	// returned.
+	Secret string `protobuf:"bytes,3,opt,name=secret,proto3" json:"secret,omitempty"`
+	// Optional. Only change events of these categories are delivered. If empty,
+	// events of all categories are delivered.
+	Categories []EventCategory `protobuf:"varint,4,rep,packed,name=categories,proto3,enum=confirmate.orchestrator.v1.EventCategory" json:"categories,omitempty" gorm:"serializer:json"`
+	// Optional. Only change events of these request types are delivered, e.g.,
+	// only created entities. If empty, events of all request types are
+	// delivered.
+	RequestTypes []RequestType `protobuf:"varint,5,rep,packed,name=request_types,json=requestTypes,proto3,enum=confirmate.orchestrator.v1.RequestType" json:"request_types,omitempty" gorm:"serializer:json"`
+	// Optional. Only change events concerning these targets of evaluation are
+	// delivered. If empty, events of all targets of evaluation as well as events
+	// that do not concern a target of evaluation, e.g., of catalogs, are
+	// delivered.
+	TargetOfEvaluationIds []string `protobuf:"bytes,6,rep,name=target_of_evaluation_ids,json=targetOfEvaluationIds,proto3" json:"target_of_evaluation_ids,omitempty" gorm:"serializer:json"`
+	// An optional description of the webhook, e.g., the external system it
+	// keeps in sync
+	Description *string `protobuf:"bytes,7,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	// The user that created the webhook
+	CreatedBy string `protobuf:"bytes,8,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	// Creation time of the webhook
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty" gorm:"serializer:timestamppb;type:timestamp"`
+	// FK-constraint-only back-reference: not populated in API responses.
+	Deliveries    []*WebhookDelivery `protobuf:"bytes,10,rep,name=deliveries,proto3" json:"deliveries,omitempty" gorm:"foreignKey:WebhookId;constraint:OnDelete:CASCADE"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Webhook) Reset() {
+	*x = Webhook{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Webhook) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Webhook) ProtoMessage() {}
+
+func (x *Webhook) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Webhook.ProtoReflect.Descriptor instead.
+func (*Webhook) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *Webhook) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Webhook) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *Webhook) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *Webhook) GetCategories() []EventCategory {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+func (x *Webhook) GetRequestTypes() []RequestType {
+	if x != nil {
+		return x.RequestTypes
+	}
+	return nil
+}
+
+func (x *Webhook) GetTargetOfEvaluationIds() []string {
+	if x != nil {
+		return x.TargetOfEvaluationIds
+	}
+	return nil
+}
+
+func (x *Webhook) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *Webhook) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *Webhook) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Webhook) GetDeliveries() []*WebhookDelivery {
+	if x != nil {
+		return x.Deliveries
+	}
+	return nil
+}
+
+// A WebhookDelivery records the delivery of a change event to a webhook.
+type WebhookDelivery struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Delivery ID. It is also sent in the X-Confirmate-Delivery header.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty" gorm:"primaryKey"`
+	// The webhook the change event was delivered to
+	WebhookId string `protobuf:"bytes,2,opt,name=webhook_id,json=webhookId,proto3" json:"webhook_id,omitempty"`
+	// The category of the delivered change event
+	Category EventCategory `protobuf:"varint,3,opt,name=category,proto3,enum=confirmate.orchestrator.v1.EventCategory" json:"category,omitempty"`
+	// The request type of the delivered change event
+	RequestType RequestType `protobuf:"varint,4,opt,name=request_type,json=requestType,proto3,enum=confirmate.orchestrator.v1.RequestType" json:"request_type,omitempty"`
+	// The ID of the entity of the delivered change event
+	EntityId string `protobuf:"bytes,5,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
+	// Time of the delivery
+	DeliveredAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=delivered_at,json=deliveredAt,proto3" json:"delivered_at,omitempty" gorm:"serializer:timestamppb;type:timestamp"`
+	// Whether the webhook accepted the change event, i.e., responded with a 2xx
+	// status code
+	Success bool `protobuf:"varint,7,opt,name=success,proto3" json:"success,omitempty"`
+	// The HTTP status code of the response, if the webhook responded at all
+	StatusCode *int32 `protobuf:"varint,8,opt,name=status_code,json=statusCode,proto3,oneof" json:"status_code,omitempty"`
+	// The error, if the change event could not be delivered
+	Error         *string `protobuf:"bytes,9,opt,name=error,proto3,oneof" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WebhookDelivery) Reset() {
+	*x = WebhookDelivery{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhookDelivery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookDelivery) ProtoMessage() {}
+
+func (x *WebhookDelivery) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookDelivery.ProtoReflect.Descriptor instead.
+func (*WebhookDelivery) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *WebhookDelivery) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetWebhookId() string {
+	if x != nil {
+		return x.WebhookId
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetCategory() EventCategory {
+	if x != nil {
+		return x.Category
+	}
+	return EventCategory_EVENT_CATEGORY_UNSPECIFIED
+}
+
+func (x *WebhookDelivery) GetRequestType() RequestType {
+	if x != nil {
+		return x.RequestType
+	}
+	return RequestType_REQUEST_TYPE_UNSPECIFIED
+}
+
+func (x *WebhookDelivery) GetEntityId() string {
+	if x != nil {
+		return x.EntityId
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetDeliveredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeliveredAt
+	}
+	return nil
+}
+
+func (x *WebhookDelivery) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *WebhookDelivery) GetStatusCode() int32 {
+	if x != nil && x.StatusCode != nil {
+		return *x.StatusCode
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetError() string {
+	if x != nil && x.Error != nil {
+		return *x.Error
+	}
+	return ""
+}
+
+// Represents an external tool or service that offers assessments according to
+// certain metrics.
+type AssessmentTool struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name        string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// a list of metrics that this tool can assess, referred by their ids
+	AvailableMetrics []string `protobuf:"bytes,4,rep,name=available_metrics,json=availableMetrics,proto3" json:"available_metrics,omitempty" gorm:"serializer:json"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *AssessmentTool) Reset() {
+	*x = AssessmentTool{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssessmentTool) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssessmentTool) ProtoMessage() {}
+
+func (x *AssessmentTool) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[104]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssessmentTool.ProtoReflect.Descriptor instead.
+func (*AssessmentTool) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *AssessmentTool) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AssessmentTool) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AssessmentTool) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *AssessmentTool) GetAvailableMetrics() []string {
+	if x != nil {
+		return x.AvailableMetrics
+	}
+	return nil
+}
+
+// MetadataField defines a custom metadata field of targets of evaluation, e.g.,
+// the business owner, the criticality or the product line.
+type MetadataField struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// key of the field, used as key in the custom fields of the target of
+	// evaluation metadata
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty" gorm:"primaryKey"`
+	// human-readable name of the field
+	DisplayName string `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	// description of the field
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// type of the values of the field
+	Type MetadataFieldType `protobuf:"varint,4,opt,name=type,proto3,enum=confirmate.orchestrator.v1.MetadataFieldType" json:"type,omitempty"`
+	// whether every target of evaluation must have a value for this field
+	Required bool `protobuf:"varint,5,opt,name=required,proto3" json:"required,omitempty"`
+	// allowed values of a field of type METADATA_FIELD_TYPE_ENUM
+	AllowedValues []string `protobuf:"bytes,6,rep,name=allowed_values,json=allowedValues,proto3" json:"allowed_values,omitempty" gorm:"serializer:json"`
+	// optional regular expression that values of a field of type
+	// METADATA_FIELD_TYPE_STRING must match
+	Pattern *string `protobuf:"bytes,7,opt,name=pattern,proto3,oneof" json:"pattern,omitempty"`
+	// last update time of the field
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty" gorm:"serializer:timestamppb;type:timestamp"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MetadataField) Reset() {
+	*x = MetadataField{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[105]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MetadataField) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MetadataField) ProtoMessage() {}
+
+func (x *MetadataField) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[105]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MetadataField.ProtoReflect.Descriptor instead.
+func (*MetadataField) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *MetadataField) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *MetadataField) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *MetadataField) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *MetadataField) GetType() MetadataFieldType {
+	if x != nil {
+		return x.Type
+	}
+	return MetadataFieldType_METADATA_FIELD_TYPE_UNSPECIFIED
+}
+
+func (x *MetadataField) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+func (x *MetadataField) GetAllowedValues() []string {
+	if x != nil {
+		return x.AllowedValues
+	}
+	return nil
+}
+
+func (x *MetadataField) GetPattern() string {
+	if x != nil && x.Pattern != nil {
+		return *x.Pattern
+	}
+	return ""
+}
+
+func (x *MetadataField) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// RedactionRule defines the sensitive fields of a resource type, which are
+// redacted from evidence resources before they are evaluated by the assessment.
+// Since assessment results only contain values of the redacted resource, the
+// fields are never persisted as part of a result either.
+type RedactionRule struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ontology resource type the rule applies to, e.g., "VirtualMachine". Since a
+	// resource has all the types of its hierarchy, rules can also be defined for
+	// more general types, e.g., "Resource"
+	ResourceType string `protobuf:"bytes,1,opt,name=resource_type,json=resourceType,proto3" json:"resource_type,omitempty" gorm:"primaryKey"`
+	// fields to redact, given by their JSON name. Nested fields are separated by
+	// dots, e.g., "bootLogging.loggingServiceIds"
+	Fields []string `protobuf:"bytes,2,rep,name=fields,proto3" json:"fields,omitempty" gorm:"serializer:json"`
+	// how the fields are redacted
+	Mode RedactionMode `protobuf:"varint,3,opt,name=mode,proto3,enum=confirmate.orchestrator.v1.RedactionMode" json:"mode,omitempty"`
+	// last update time of the rule
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty" gorm:"serializer:timestamppb;type:timestamp"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RedactionRule) Reset() {
+	*x = RedactionRule{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[106]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RedactionRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RedactionRule) ProtoMessage() {}
+
+func (x *RedactionRule) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[106]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RedactionRule.ProtoReflect.Descriptor instead.
+func (*RedactionRule) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *RedactionRule) GetResourceType() string {
+	if x != nil {
+		return x.ResourceType
+	}
+	return ""
+}
+
+func (x *RedactionRule) GetFields() []string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *RedactionRule) GetMode() RedactionMode {
+	if x != nil {
+		return x.Mode
+	}
+	return RedactionMode_REDACTION_MODE_UNSPECIFIED
+}
+
+func (x *RedactionRule) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type TargetOfEvaluation struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name              string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description       string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	ConfiguredMetrics []*assessment.Metric   `protobuf:"bytes,5,rep,name=configured_metrics,json=configuredMetrics,proto3" json:"configured_metrics,omitempty" gorm:"many2many:metric_configurations"`
+	// creation time of the target_of_evaluation
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3,oneof" json:"created_at,omitempty" gorm:"serializer:timestamppb;type:timestamp"`
+	// last update time of the target_of_evaluation
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3,oneof" json:"updated_at,omitempty" gorm:"serializer:timestamppb;type:timestamp"`
+	// additional metadata of the target of evaluation, mostly used for the UI
+	Metadata *TargetOfEvaluation_Metadata `protobuf:"bytes,10,opt,name=metadata,proto3,oneof" json:"metadata,omitempty" gorm:"serializer:json"`
+	// type of the target to be evaluated: cloud, product or organization
+	TargetType TargetOfEvaluation_TargetType `protobuf:"varint,11,opt,name=target_type,json=targetType,proto3,enum=confirmate.orchestrator.v1.TargetOfEvaluation_TargetType" json:"target_type,omitempty"`
+	// Organization details for this target of evaluation.
+	Organization  *TargetOfEvaluation_Organization `protobuf:"bytes,15,opt,name=organization,proto3,oneof" json:"organization,omitempty" gorm:"serializer:json"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TargetOfEvaluation) Reset() {
+	*x = TargetOfEvaluation{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[107]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TargetOfEvaluation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TargetOfEvaluation) ProtoMessage() {}
+
+func (x *TargetOfEvaluation) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[107]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TargetOfEvaluation.ProtoReflect.Descriptor instead.
+func (*TargetOfEvaluation) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *TargetOfEvaluation) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TargetOfEvaluation) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TargetOfEvaluation) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *TargetOfEvaluation) GetConfiguredMetrics() []*assessment.Metric {
+	if x != nil {
+		return x.ConfiguredMetrics
+	}
+	return nil
+}
+
+func (x *TargetOfEvaluation) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *TargetOfEvaluation) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *TargetOfEvaluation) GetMetadata() *TargetOfEvaluation_Metadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *TargetOfEvaluation) GetTargetType() TargetOfEvaluation_TargetType {
+	if x != nil {
+		return x.TargetType
+	}
+	return TargetOfEvaluation_TARGET_TYPE_UNSPECIFIED
+}
+
+func (x *TargetOfEvaluation) GetOrganization() *TargetOfEvaluation_Organization {
+	if x != nil {
+		return x.Organization
+	}
+	return nil
+}
+
+type Catalog struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name        string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Categories  []*Category            `protobuf:"bytes,4,rep,name=categories,proto3" json:"categories,omitempty" gorm:"constraint:OnDelete:CASCADE"`
+	// Certain security catalogs do not allow to select the scope of the controls,
+	// but all controls are automatically "in scope", however they can be set to a
+	// DELEGATED status.
+	AllInScope bool `protobuf:"varint,5,opt,name=all_in_scope,json=allInScope,proto3" json:"all_in_scope,omitempty"`
+	// A list of the assurance levels, e.g., basic, substantial and high for the
+	// EUCS catalog, ordered from the lowest to the highest level. When the
+	// catalog is created, an AssuranceLevel is created for each of them.
+	// Afterwards, the levels are managed with UpdateAssuranceLevel and
+	// RemoveAssuranceLevel and this list reflects them in the order of their
+	// rank; UpdateCatalog does not change it.
+	AssuranceLevels []string `protobuf:"bytes,7,rep,name=assurance_levels,json=assuranceLevels,proto3" json:"assurance_levels,omitempty" gorm:"serializer:json"`
+	// Catalogs short name, e.g. EUCS
+	ShortName string `protobuf:"bytes,9,opt,name=short_name,json=shortName,proto3" json:"short_name,omitempty"`
+	// metadata of the catalog
+	Metadata *Catalog_Metadata `protobuf:"bytes,6,opt,name=metadata,proto3,oneof" json:"metadata,omitempty" gorm:"serializer:json"`
+	// The lifecycle status of the catalog.
+	Status CatalogStatus `protobuf:"varint,10,opt,name=status,proto3,enum=confirmate.orchestrator.v1.CatalogStatus" json:"status,omitempty"`
+	// For a published catalog, the version number of this snapshot. For a draft,
+	// the version number of its latest published snapshot (0 if it was never
+	// published).
+	Version int32 `protobuf:"varint,11,opt,name=version,proto3" json:"version,omitempty"`
+	// For a published catalog, the ID of the draft it was published from.
+	DraftId *string `protobuf:"bytes,12,opt,name=draft_id,json=draftId,proto3,oneof" json:"draft_id,omitempty"`
+	// The SLA thresholds of the catalog, i.e., how long its controls may be
+	// non-compliant before they must return to compliant. A threshold with an
+	// assurance level takes precedence over one without.
+	SlaThresholds []*SlaThreshold `protobuf:"bytes,13,rep,name=sla_thresholds,json=slaThresholds,proto3" json:"sla_thresholds,omitempty" gorm:"serializer:json"`
+	// The applicability rules of the controls of the catalog. They are managed
+	// with UpdateApplicabilityRule and RemoveApplicabilityRule.
+	ApplicabilityRules []*ApplicabilityRule `protobuf:"bytes,14,rep,name=applicability_rules,json=applicabilityRules,proto3" json:"applicability_rules,omitempty" gorm:"foreignKey:CatalogId;constraint:OnDelete:CASCADE"`
+	// The custom statuses of the catalog. They refine the status of the
+	// evaluation results of its controls for schemes that need additional
+	// statuses, e.g., PARTIALLY_COMPLIANT as a refinement of NOT_COMPLIANT.
+	CustomStatuses []*CustomStatus `protobuf:"bytes,15,rep,name=custom_statuses,json=customStatuses,proto3" json:"custom_statuses,omitempty" gorm:"serializer:json"`
+	// For a published catalog, the hex-encoded SHA-256 hash of the texts of its
+	// categories and controls at the time it was published. Evaluation results
+	// record it to prove which requirement texts they refer to.
+	ContentHash *string `protobuf:"bytes,16,opt,name=content_hash,json=contentHash,proto3,oneof" json:"content_hash,omitempty"`
+	// Whether an audit scope is created automatically for the catalog whenever a
+	// new target of evaluation is created, e.g., when a collector registers it.
+	// For a draft, the audit scope binds its latest published version; drafts
+	// that were never published are skipped.
+	AutoCreateAuditScopes *bool `protobuf:"varint,17,opt,name=auto_create_audit_scopes,json=autoCreateAuditScopes,proto3,oneof" json:"auto_create_audit_scopes,omitempty"`
+	// Optional. The assurance level of automatically created audit scopes. If not
+	// set or not one of the assurance levels of the catalog, the lowest
+	// assurance level of the catalog is used.
+	AutoAuditScopeAssuranceLevel *string `protobuf:"bytes,18,opt,name=auto_audit_scope_assurance_level,json=autoAuditScopeAssuranceLevel,proto3,oneof" json:"auto_audit_scope_assurance_level,omitempty"`
+	// Optional. The evaluation settings recommended by the scheme of the
+	// catalog. StartEvaluation uses them for the settings its request omits.
+	EvaluationDefaults *EvaluationDefaults `protobuf:"bytes,19,opt,name=evaluation_defaults,json=evaluationDefaults,proto3,oneof" json:"evaluation_defaults,omitempty" gorm:"serializer:json"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *Catalog) Reset() {
+	*x = Catalog{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[108]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Catalog) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Catalog) ProtoMessage() {}
+
+func (x *Catalog) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[108]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Catalog.ProtoReflect.Descriptor instead.
+func (*Catalog) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *Catalog) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Catalog) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Catalog) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Catalog) GetCategories() []*Category {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+func (x *Catalog) GetAllInScope() bool {
+	if x != nil {
+		return x.AllInScope
+	}
+	return false
+}
+
+func (x *Catalog) GetAssuranceLevels() []string {
+	if x != nil {
+		return x.AssuranceLevels
+	}
+	return nil
+}
+
+func (x *Catalog) GetShortName() string {
+	if x != nil {
+		return x.ShortName
+	}
+	return ""
+}
+
+func (x *Catalog) GetMetadata() *Catalog_Metadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *Catalog) GetStatus() CatalogStatus {
+	if x != nil {
+		return x.Status
+	}
+	return CatalogStatus_CATALOG_STATUS_UNSPECIFIED
+}
+
+func (x *Catalog) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Catalog) GetDraftId() string {
+	if x != nil && x.DraftId != nil {
+		return *x.DraftId
+	}
+	return ""
+}
+
+func (x *Catalog) GetSlaThresholds() []*SlaThreshold {
+	if x != nil {
+		return x.SlaThresholds
+	}
+	return nil
+}
+
+func (x *Catalog) GetApplicabilityRules() []*ApplicabilityRule {
+	if x != nil {
+		return x.ApplicabilityRules
+	}
+	return nil
+}
+
+func (x *Catalog) GetCustomStatuses() []*CustomStatus {
+	if x != nil {
+		return x.CustomStatuses
+	}
+	return nil
+}
+
+func (x *Catalog) GetContentHash() string {
+	if x != nil && x.ContentHash != nil {
+		return *x.ContentHash
+	}
+	return ""
+}
+
+func (x *Catalog) GetAutoCreateAuditScopes() bool {
+	if x != nil && x.AutoCreateAuditScopes != nil {
+		return *x.AutoCreateAuditScopes
+	}
+	return false
+}
+
+func (x *Catalog) GetAutoAuditScopeAssuranceLevel() string {
+	if x != nil && x.AutoAuditScopeAssuranceLevel != nil {
+		return *x.AutoAuditScopeAssuranceLevel
+	}
+	return ""
+}
+
+func (x *Catalog) GetEvaluationDefaults() *EvaluationDefaults {
+	if x != nil {
+		return x.EvaluationDefaults
+	}
+	return nil
+}
+
+// EvaluationDefaults are the evaluation settings recommended by the scheme of a
+// catalog, so that its best practices are applied without configuring every
+// evaluation.
+type EvaluationDefaults struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Optional. The recommended interval in minutes of periodic evaluations. If
+	// an audit scope has multiple catalogs, the shortest interval is used.
+	Interval *int32 `protobuf:"varint,1,opt,name=interval,proto3,oneof" json:"interval,omitempty"`
+	// Optional. The maximum age in days of the evidence that the assessment
+	// results of controls without their own maximum evidence age are based on,
+	// see Control.max_evidence_age_days.
+	MaxEvidenceAgeDays *uint32 `protobuf:"varint,2,opt,name=max_evidence_age_days,json=maxEvidenceAgeDays,proto3,oneof" json:"max_evidence_age_days,omitempty"`
+	// Optional. How the status of a control is aggregated from the statuses of
+	// its sub-controls.
+	AggregationStrategy evaluation.AggregationStrategy `protobuf:"varint,3,opt,name=aggregation_strategy,json=aggregationStrategy,proto3,enum=confirmate.evaluation.v1.AggregationStrategy" json:"aggregation_strategy,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *EvaluationDefaults) Reset() {
+	*x = EvaluationDefaults{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[109]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EvaluationDefaults) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EvaluationDefaults) ProtoMessage() {}
+
+func (x *EvaluationDefaults) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[109]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EvaluationDefaults.ProtoReflect.Descriptor instead.
+func (*EvaluationDefaults) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *EvaluationDefaults) GetInterval() int32 {
+	if x != nil && x.Interval != nil {
+		return *x.Interval
+	}
+	return 0
+}
+
+func (x *EvaluationDefaults) GetMaxEvidenceAgeDays() uint32 {
+	if x != nil && x.MaxEvidenceAgeDays != nil {
+		return *x.MaxEvidenceAgeDays
+	}
+	return 0
+}
+
+func (x *EvaluationDefaults) GetAggregationStrategy() evaluation.AggregationStrategy {
+	if x != nil {
+		return x.AggregationStrategy
+	}
+	return evaluation.AggregationStrategy(0)
+}
+
+// A CustomStatus is a catalog-defined sub-status that refines the status of an
+// evaluation result, e.g., PARTIALLY_COMPLIANT or NOT_APPLICABLE.
+type CustomStatus struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the sub-status, e.g., "PARTIALLY_COMPLIANT"
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// A human-readable description of the sub-status
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// The status refined by the sub-status. An evaluation result can only have
+	// the sub-status if it has this status.
+	Status evaluation.EvaluationStatus `protobuf:"varint,3,opt,name=status,proto3,enum=confirmate.evaluation.v1.EvaluationStatus" json:"status,omitempty"`
+	// The precedence of the sub-status. If the sub-controls of a control have
+	// different sub-statuses, the control inherits the one with the highest
+	// precedence that refines its own status.
+	Precedence int32 `protobuf:"varint,4,opt,name=precedence,proto3" json:"precedence,omitempty"`
+	// Optional. The rule by which the sub-status is assigned to automatically
+	// evaluated results. Rules take precedence over inherited sub-statuses.
+	AggregationRule *StatusAggregationRule `protobuf:"bytes,5,opt,name=aggregation_rule,json=aggregationRule,proto3,oneof" json:"aggregation_rule,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CustomStatus) Reset() {
+	*x = CustomStatus{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[110]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CustomStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CustomStatus) ProtoMessage() {}
+
+func (x *CustomStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[110]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CustomStatus.ProtoReflect.Descriptor instead.
+func (*CustomStatus) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *CustomStatus) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CustomStatus) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CustomStatus) GetStatus() evaluation.EvaluationStatus {
+	if x != nil {
+		return x.Status
+	}
+	return evaluation.EvaluationStatus(0)
+}
+
+func (x *CustomStatus) GetPrecedence() int32 {
+	if x != nil {
+		return x.Precedence
+	}
+	return 0
+}
+
+func (x *CustomStatus) GetAggregationRule() *StatusAggregationRule {
+	if x != nil {
+		return x.AggregationRule
+	}
+	return nil
+}
+
+// A StatusAggregationRule assigns a custom status to an automatically
+// evaluated result based on the share of its compliant parts, i.e., the
+// assessment results of a sub-control or the sub-controls of a control. It
+// only applies to results with the status refined by the custom status.
+type StatusAggregationRule struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Optional. The minimum share of compliant parts, between 0 and 1
+	// (inclusive).
+	MinCompliantShare *float64 `protobuf:"fixed64,1,opt,name=min_compliant_share,json=minCompliantShare,proto3,oneof" json:"min_compliant_share,omitempty"`
+	// Optional. The maximum share of compliant parts, between 0 and 1
+	// (inclusive).
+	MaxCompliantShare *float64 `protobuf:"fixed64,2,opt,name=max_compliant_share,json=maxCompliantShare,proto3,oneof" json:"max_compliant_share,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *StatusAggregationRule) Reset() {
+	*x = StatusAggregationRule{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[111]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StatusAggregationRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatusAggregationRule) ProtoMessage() {}
+
+func (x *StatusAggregationRule) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[111]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatusAggregationRule.ProtoReflect.Descriptor instead.
+func (*StatusAggregationRule) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *StatusAggregationRule) GetMinCompliantShare() float64 {
+	if x != nil && x.MinCompliantShare != nil {
+		return *x.MinCompliantShare
+	}
+	return 0
+}
+
+func (x *StatusAggregationRule) GetMaxCompliantShare() float64 {
+	if x != nil && x.MaxCompliantShare != nil {
+		return *x.MaxCompliantShare
+	}
+	return 0
+}
+
+// AssuranceLevel is an assurance level offered by a catalog, e.g., "basic",
+// "substantial" or "high" for the EUCS catalog.
+type AssuranceLevel struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The catalog the assurance level belongs to.
+	CatalogId string `protobuf:"bytes,1,opt,name=catalog_id,json=catalogId,proto3" json:"catalog_id,omitempty" gorm:"primaryKey"`
+	// The name of the assurance level, which is referenced by controls, audit
+	// scopes and applicability rules.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty" gorm:"primaryKey"`
+	// The rank of the assurance level within the catalog. Levels with a higher
+	// rank are stricter and include all controls of the levels below them.
+	Rank uint32 `protobuf:"varint,3,opt,name=rank,proto3" json:"rank,omitempty"`
+	// A description of the assurance level.
+	Description   string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssuranceLevel) Reset() {
+	*x = AssuranceLevel{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[112]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssuranceLevel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssuranceLevel) ProtoMessage() {}
+
+func (x *AssuranceLevel) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[112]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssuranceLevel.ProtoReflect.Descriptor instead.
+func (*AssuranceLevel) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *AssuranceLevel) GetCatalogId() string {
+	if x != nil {
+		return x.CatalogId
+	}
+	return ""
+}
+
+func (x *AssuranceLevel) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AssuranceLevel) GetRank() uint32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *AssuranceLevel) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+// ApplicabilityRule defines to which assurance levels a control of a catalog
+// applies. It takes precedence over the assurance level of the control itself.
+type ApplicabilityRule struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The catalog of the control.
+	CatalogId string `protobuf:"bytes,1,opt,name=catalog_id,json=catalogId,proto3" json:"catalog_id,omitempty" gorm:"primaryKey"`
+	// The short name of the control, e.g., OPS-01. The short name is used
+	// instead of the control ID, since it is stable across the published versions
+	// of a catalog.
+	ControlShortName string `protobuf:"bytes,2,opt,name=control_short_name,json=controlShortName,proto3" json:"control_short_name,omitempty" gorm:"primaryKey"`
+	// The assurance levels of audit scopes the control applies to. If empty, the
+	// control does not apply to any audit scope.
+	AssuranceLevels []string `protobuf:"bytes,3,rep,name=assurance_levels,json=assuranceLevels,proto3" json:"assurance_levels,omitempty" gorm:"serializer:json"`
+	// A justification of the rule, e.g., a reference to the scheme.
+	Justification string `protobuf:"bytes,4,opt,name=justification,proto3" json:"justification,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplicabilityRule) Reset() {
+	*x = ApplicabilityRule{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[113]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplicabilityRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplicabilityRule) ProtoMessage() {}
+
+func (x *ApplicabilityRule) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[113]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplicabilityRule.ProtoReflect.Descriptor instead.
+func (*ApplicabilityRule) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *ApplicabilityRule) GetCatalogId() string {
+	if x != nil {
+		return x.CatalogId
+	}
+	return ""
+}
+
+func (x *ApplicabilityRule) GetControlShortName() string {
+	if x != nil {
+		return x.ControlShortName
+	}
+	return ""
+}
+
+func (x *ApplicabilityRule) GetAssuranceLevels() []string {
+	if x != nil {
+		return x.AssuranceLevels
+	}
+	return nil
+}
+
+func (x *ApplicabilityRule) GetJustification() string {
+	if x != nil {
+		return x.Justification
+	}
+	return ""
+}
+
+// CatalogSource is an upstream source of catalogs, e.g., the catalogs of the
+// security-metrics repository. Its catalogs are re-imported into their drafts
+// whenever the content of the source changes.
+type CatalogSource struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty" gorm:"primaryKey"`
+	// The URL the content of the source is retrieved from with an HTTP GET
+	// request.
+	Url string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	// The format of the content of the source.
+	Format CatalogSourceFormat `protobuf:"varint,3,opt,name=format,proto3,enum=confirmate.orchestrator.v1.CatalogSourceFormat" json:"format,omitempty"`
+	// The interval in minutes in which the source is checked for changes.
+	SyncIntervalMinutes uint32 `protobuf:"varint,4,opt,name=sync_interval_minutes,json=syncIntervalMinutes,proto3" json:"sync_interval_minutes,omitempty"`
+	// If set, changed catalogs are published after they were re-imported.
+	// Otherwise, the changes remain in the drafts of the catalogs until they are
+	// published with PublishCatalog.
+	AutoPublish bool `protobuf:"varint,5,opt,name=auto_publish,json=autoPublish,proto3" json:"auto_publish,omitempty"`
+	// The time of the last successful sync.
+	LastSyncedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=last_synced_at,json=lastSyncedAt,proto3,oneof" json:"last_synced_at,omitempty" gorm:"serializer:timestamppb;type:timestamp"`
+	// The SHA-256 digest of the content of the source at the last successful
+	// sync, which is used to detect upstream changes.
+	Digest string `protobuf:"bytes,7,opt,name=digest,proto3" json:"digest,omitempty"`
+	// The error of the last sync, if it failed.
+	LastError *string `protobuf:"bytes,8,opt,name=last_error,json=lastError,proto3,oneof" json:"last_error,omitempty"`
+	// The report of the last sync that changed the content of the source.
+	LastReport    *CatalogSyncReport `protobuf:"bytes,9,opt,name=last_report,json=lastReport,proto3,oneof" json:"last_report,omitempty" gorm:"serializer:json"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CatalogSource) Reset() {
+	*x = CatalogSource{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[114]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CatalogSource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CatalogSource) ProtoMessage() {}
+
+func (x *CatalogSource) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[114]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CatalogSource.ProtoReflect.Descriptor instead.
+func (*CatalogSource) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *CatalogSource) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CatalogSource) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *CatalogSource) GetFormat() CatalogSourceFormat {
+	if x != nil {
+		return x.Format
+	}
+	return CatalogSourceFormat_CATALOG_SOURCE_FORMAT_UNSPECIFIED
+}
+
+func (x *CatalogSource) GetSyncIntervalMinutes() uint32 {
+	if x != nil {
+		return x.SyncIntervalMinutes
+	}
+	return 0
+}
+
+func (x *CatalogSource) GetAutoPublish() bool {
+	if x != nil {
+		return x.AutoPublish
+	}
+	return false
+}
+
+func (x *CatalogSource) GetLastSyncedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastSyncedAt
+	}
+	return nil
+}
+
+func (x *CatalogSource) GetDigest() string {
+	if x != nil {
+		return x.Digest
+	}
+	return ""
+}
+
+func (x *CatalogSource) GetLastError() string {
+	if x != nil && x.LastError != nil {
+		return *x.LastError
+	}
+	return ""
+}
+
+func (x *CatalogSource) GetLastReport() *CatalogSyncReport {
+	if x != nil {
+		return x.LastReport
+	}
+	return nil
+}
+
+// CatalogSyncReport is the report of a sync of a catalog source.
+type CatalogSyncReport struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The catalog source that was synced.
+	SourceId string `protobuf:"bytes,1,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
+	// Whether the content of the source changed since the last sync. If not, no
+	// catalog was re-imported.
+	Changed bool `protobuf:"varint,2,opt,name=changed,proto3" json:"changed,omitempty"`
+	// The changes made to each catalog of the source.
+	Catalogs      []*CatalogDiff `protobuf:"bytes,3,rep,name=catalogs,proto3" json:"catalogs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CatalogSyncReport) Reset() {
+	*x = CatalogSyncReport{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[115]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CatalogSyncReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CatalogSyncReport) ProtoMessage() {}
+
+func (x *CatalogSyncReport) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[115]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CatalogSyncReport.ProtoReflect.Descriptor instead.
+func (*CatalogSyncReport) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *CatalogSyncReport) GetSourceId() string {
+	if x != nil {
+		return x.SourceId
+	}
+	return ""
+}
+
+func (x *CatalogSyncReport) GetChanged() bool {
+	if x != nil {
+		return x.Changed
+	}
+	return false
+}
+
+func (x *CatalogSyncReport) GetCatalogs() []*CatalogDiff {
+	if x != nil {
+		return x.Catalogs
+	}
+	return nil
+}
+
+// CatalogDiff describes the changes made to a catalog when it was re-imported
+// from its source. Controls are identified by their short names.
+type CatalogDiff struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	CatalogId string                 `protobuf:"bytes,1,opt,name=catalog_id,json=catalogId,proto3" json:"catalog_id,omitempty"`
+	// Whether the catalog did not exist before and was created.
+	Created bool `protobuf:"varint,2,opt,name=created,proto3" json:"created,omitempty"`
+	// The controls that were added to the catalog.
+	AddedControls []string `protobuf:"bytes,3,rep,name=added_controls,json=addedControls,proto3" json:"added_controls,omitempty"`
+	// The controls that were removed from the catalog.
+	RemovedControls []string `protobuf:"bytes,4,rep,name=removed_controls,json=removedControls,proto3" json:"removed_controls,omitempty"`
+	// The controls whose name, description, assurance level, category or parent
+	// control changed.
+	ChangedControls []string `protobuf:"bytes,5,rep,name=changed_controls,json=changedControls,proto3" json:"changed_controls,omitempty"`
+	// Whether the catalog was published after it was re-imported.
+	Published bool `protobuf:"varint,6,opt,name=published,proto3" json:"published,omitempty"`
+	// The reason why the catalog could not be re-imported.
+	Error         *string `protobuf:"bytes,7,opt,name=error,proto3,oneof" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CatalogDiff) Reset() {
+	*x = CatalogDiff{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[116]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CatalogDiff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CatalogDiff) ProtoMessage() {}
+
+func (x *CatalogDiff) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[116]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CatalogDiff.ProtoReflect.Descriptor instead.
+func (*CatalogDiff) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *CatalogDiff) GetCatalogId() string {
+	if x != nil {
+		return x.CatalogId
+	}
+	return ""
+}
+
+func (x *CatalogDiff) GetCreated() bool {
+	if x != nil {
+		return x.Created
+	}
+	return false
+}
+
+func (x *CatalogDiff) GetAddedControls() []string {
+	if x != nil {
+		return x.AddedControls
+	}
+	return nil
+}
+
+func (x *CatalogDiff) GetRemovedControls() []string {
+	if x != nil {
+		return x.RemovedControls
+	}
+	return nil
+}
+
+func (x *CatalogDiff) GetChangedControls() []string {
+	if x != nil {
+		return x.ChangedControls
+	}
+	return nil
+}
+
+func (x *CatalogDiff) GetPublished() bool {
+	if x != nil {
+		return x.Published
+	}
+	return false
+}
+
+func (x *CatalogDiff) GetError() string {
+	if x != nil && x.Error != nil {
+		return *x.Error
+	}
+	return ""
+}
+
+// CatalogChangelog is a human-readable record of the changes of a catalog,
+// e.g., between two of its published versions, so that compliance managers
+// can brief auditors on changes of the scheme.
+type CatalogChangelog struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty" gorm:"primaryKey"`
+	// The catalog whose draft was re-imported or published.
+	CatalogId string `protobuf:"bytes,2,opt,name=catalog_id,json=catalogId,proto3" json:"catalog_id,omitempty" gorm:"index"`
+	// The operation that generated the changelog.
+	Trigger CatalogChangelogTrigger `protobuf:"varint,3,opt,name=trigger,proto3,enum=confirmate.orchestrator.v1.CatalogChangelogTrigger" json:"trigger,omitempty"`
+	// The published version of the catalog the changes are relative to, if the
+	// changelog was generated by publishing a catalog that was published before.
+	PreviousVersionId *string `protobuf:"bytes,4,opt,name=previous_version_id,json=previousVersionId,proto3,oneof" json:"previous_version_id,omitempty"`
+	// The published version of the catalog, if the changelog was generated by
+	// publishing the catalog.
+	VersionId *string                `protobuf:"bytes,5,opt,name=version_id,json=versionId,proto3,oneof" json:"version_id,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty" gorm:"serializer:timestamppb;type:timestamp"`
+	// The changes of the controls, ordered by their short names.
+	Changes []*ControlChange `protobuf:"bytes,7,rep,name=changes,proto3" json:"changes,omitempty" gorm:"serializer:json"`
+	// The changelog rendered as Markdown.
+	Markdown      string `protobuf:"bytes,8,opt,name=markdown,proto3" json:"markdown,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CatalogChangelog) Reset() {
+	*x = CatalogChangelog{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[117]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CatalogChangelog) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CatalogChangelog) ProtoMessage() {}
+
+func (x *CatalogChangelog) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[117]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CatalogChangelog.ProtoReflect.Descriptor instead.
+func (*CatalogChangelog) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *CatalogChangelog) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CatalogChangelog) GetCatalogId() string {
+	if x != nil {
+		return x.CatalogId
+	}
+	return ""
+}
+
+func (x *CatalogChangelog) GetTrigger() CatalogChangelogTrigger {
+	if x != nil {
+		return x.Trigger
+	}
+	return CatalogChangelogTrigger_CATALOG_CHANGELOG_TRIGGER_UNSPECIFIED
+}
+
+func (x *CatalogChangelog) GetPreviousVersionId() string {
+	if x != nil && x.PreviousVersionId != nil {
+		return *x.PreviousVersionId
+	}
+	return ""
+}
+
+func (x *CatalogChangelog) GetVersionId() string {
+	if x != nil && x.VersionId != nil {
+		return *x.VersionId
+	}
+	return ""
+}
+
+func (x *CatalogChangelog) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *CatalogChangelog) GetChanges() []*ControlChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+func (x *CatalogChangelog) GetMarkdown() string {
+	if x != nil {
+		return x.Markdown
+	}
+	return ""
+}
+
+// ControlChange describes the change of a single control in a catalog
+// changelog. Controls are identified by their short names.
+type ControlChange struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	ControlShortName string                 `protobuf:"bytes,1,opt,name=control_short_name,json=controlShortName,proto3" json:"control_short_name,omitempty"`
+	// The name of the control, i.e., its new name if it was changed.
+	ControlName string            `protobuf:"bytes,2,opt,name=control_name,json=controlName,proto3" json:"control_name,omitempty"`
+	Type        ControlChangeType `protobuf:"varint,3,opt,name=type,proto3,enum=confirmate.orchestrator.v1.ControlChangeType" json:"type,omitempty"`
+	// The fields of a changed control that differ, e.g., "name", "description",
+	// "references", "assurance_level", "category" or "parent_control".
+	ChangedFields []string `protobuf:"bytes,4,rep,name=changed_fields,json=changedFields,proto3" json:"changed_fields,omitempty"`
+	// The metrics that were mapped to the control.
+	AddedMetrics []string `protobuf:"bytes,5,rep,name=added_metrics,json=addedMetrics,proto3" json:"added_metrics,omitempty"`
+	// The metrics that are no longer mapped to the control.
+	RemovedMetrics []string `protobuf:"bytes,6,rep,name=removed_metrics,json=removedMetrics,proto3" json:"removed_metrics,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ControlChange) Reset() {
+	*x = ControlChange{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[118]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ControlChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ControlChange) ProtoMessage() {}
+
+func (x *ControlChange) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[118]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ControlChange.ProtoReflect.Descriptor instead.
+func (*ControlChange) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *ControlChange) GetControlShortName() string {
+	if x != nil {
+		return x.ControlShortName
+	}
+	return ""
+}
+
+func (x *ControlChange) GetControlName() string {
+	if x != nil {
+		return x.ControlName
+	}
+	return ""
+}
+
+func (x *ControlChange) GetType() ControlChangeType {
+	if x != nil {
+		return x.Type
+	}
+	return ControlChangeType_CONTROL_CHANGE_TYPE_UNSPECIFIED
+}
+
+func (x *ControlChange) GetChangedFields() []string {
+	if x != nil {
+		return x.ChangedFields
+	}
+	return nil
+}
+
+func (x *ControlChange) GetAddedMetrics() []string {
+	if x != nil {
+		return x.AddedMetrics
+	}
+	return nil
+}
+
+func (x *ControlChange) GetRemovedMetrics() []string {
+	if x != nil {
+		return x.RemovedMetrics
+	}
+	return nil
+}
+
+// SlaThreshold is the maximum time the controls of a catalog may be
+// non-compliant.
+type SlaThreshold struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The assurance level of the controls the threshold applies to, e.g.,
+	// "high". If empty, the threshold applies to all controls without a more
+	// specific threshold.
+	AssuranceLevel *string `protobuf:"bytes,1,opt,name=assurance_level,json=assuranceLevel,proto3,oneof" json:"assurance_level,omitempty"`
+	// The number of days within which a non-compliant control must return to
+	// compliant.
+	MaxDays       uint32 `protobuf:"varint,2,opt,name=max_days,json=maxDays,proto3" json:"max_days,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SlaThreshold) Reset() {
+	*x = SlaThreshold{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[119]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SlaThreshold) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SlaThreshold) ProtoMessage() {}
+
+func (x *SlaThreshold) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[119]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SlaThreshold.ProtoReflect.Descriptor instead.
+func (*SlaThreshold) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *SlaThreshold) GetAssuranceLevel() string {
+	if x != nil && x.AssuranceLevel != nil {
+		return *x.AssuranceLevel
+	}
+	return ""
+}
+
+func (x *SlaThreshold) GetMaxDays() uint32 {
+	if x != nil {
+		return x.MaxDays
+	}
+	return 0
+}
+
+type Category struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty" gorm:"primaryKey"`
+	// Reference to the catalog this category belongs to.
+	CatalogId     string     `protobuf:"bytes,2,opt,name=catalog_id,json=catalogId,proto3" json:"catalog_id,omitempty" gorm:"primaryKey"`
+	Description   string     `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Controls      []*Control `protobuf:"bytes,4,rep,name=controls,proto3" json:"controls,omitempty" gorm:"many2many:category_controls;joinForeignKey:category_name,category_catalog_id;joinReferences:control_id;constraint:OnDelete:CASCADE"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Category) Reset() {
+	*x = Category{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[120]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Category) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Category) ProtoMessage() {}
+
+func (x *Category) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[120]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Category.ProtoReflect.Descriptor instead.
+func (*Category) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *Category) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Category) GetCatalogId() string {
+	if x != nil {
+		return x.CatalogId
+	}
+	return ""
+}
+
+func (x *Category) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Category) GetControls() []*Control {
+	if x != nil {
+		return x.Controls
+	}
+	return nil
+}
+
+// Control represents a certain Control that needs to be fulfilled. It could be
+// a Control in a certification catalog. It follows the OSCAL model. A
+// requirement in the EUCS terminology, e.g., is represented as the lowest
+// sub-control.
+type Control struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Globally unique ID of the control.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty" gorm:"primaryKey"`
+	// Human-readable name of the control.
+	Name string `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	// Description of the control, formatted as Markdown. See RenderControlText
+	// for the supported subset.
+	Description string `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	// List of sub - controls -
+	//
+	//	this is in accordance with the OSCAL model.
+	Controls []*Control `protobuf:"bytes,6,rep,name=controls,proto3" json:"controls,omitempty" gorm:"foreignKey:parent_control_id;references:id;constraint:OnDelete:CASCADE"`
+	// metrics contains either a list of reference to metrics - in this case only
+	// the id field of the metric is populated - or a list of populated metric
+	// meta-data, most likely returned by the database.
+	Metrics []*assessment.Metric `protobuf:"bytes,7,rep,name=metrics,proto3" json:"metrics,omitempty" gorm:"many2many:control_metrics;constraint:OnDelete:CASCADE"`
+	// Unique ID of the parent control, if this is a sub-control.
+	ParentControlId *string `protobuf:"bytes,8,opt,name=parent_control_id,json=parentControlId,proto3,oneof" json:"parent_control_id,omitempty"`
+	// An assurance level is not offered by every catalog, therefore it is
+	// optional.
+	AssuranceLevel *string `protobuf:"bytes,11,opt,name=assurance_level,json=assuranceLevel,proto3,oneof" json:"assurance_level,omitempty"`
+	// Catalog-local control identifier (e.g. OPS-01).
+	ShortName string `protobuf:"bytes,12,opt,name=short_name,json=shortName,proto3" json:"short_name,omitempty"`
+	// FK-constraint-only back-reference: not populated in API responses (queries use WithoutPreload).
+	ControlsInScope []*ControlInScope `protobuf:"bytes,13,rep,name=controls_in_scope,json=controlsInScope,proto3" json:"controls_in_scope,omitempty" gorm:"foreignKey:ControlId;constraint:OnDelete:RESTRICT"`
+	// Catalog ID of the catalog this control belongs to.
+	CatalogId string `protobuf:"bytes,14,opt,name=catalog_id,json=catalogId,proto3" json:"catalog_id,omitempty"`
+	// References to the sections of external requirement documents, e.g.,
+	// regulations, that the control is derived from.
+	References []*ControlReference `protobuf:"bytes,15,rep,name=references,proto3" json:"references,omitempty" gorm:"serializer:json"`
+	// The version of the text of the control, i.e., of its name, description and
+	// references. A new version is recorded whenever the text of the control in
+	// the draft of its catalog changes (see ListControlTextVersions).
+	TextVersion int32 `protobuf:"varint,16,opt,name=text_version,json=textVersion,proto3" json:"text_version,omitempty"`
+	// The maximum age in days of the evidence that the assessment results of the
+	// control are based on. Assessment results based on older evidence do not
+	// satisfy the control, since some certifications require evidence not older
+	// than, e.g., 90 days. If not set, the age of the evidence is not restricted.
+	MaxEvidenceAgeDays *uint32 `protobuf:"varint,17,opt,name=max_evidence_age_days,json=maxEvidenceAgeDays,proto3,oneof" json:"max_evidence_age_days,omitempty"`
+	// Optional. Guidance on how to fulfill the control, if it is not. It is
+	// included in the evaluation results of the control.
+	Remediation   *assessment.Remediation `protobuf:"bytes,18,opt,name=remediation,proto3,oneof" json:"remediation,omitempty" gorm:"serializer:json"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Control) Reset() {
+	*x = Control{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[121]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Control) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Control) ProtoMessage() {}
+
+func (x *Control) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[121]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Control.ProtoReflect.Descriptor instead.
+func (*Control) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *Control) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Control) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Control) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Control) GetControls() []*Control {
+	if x != nil {
+		return x.Controls
+	}
+	return nil
+}
+
+func (x *Control) GetMetrics() []*assessment.Metric {
+	if x != nil {
+		return x.Metrics
+	}
+	return nil
+}
+
+func (x *Control) GetParentControlId() string {
+	if x != nil && x.ParentControlId != nil {
+		return *x.ParentControlId
+	}
+	return ""
+}
+
+func (x *Control) GetAssuranceLevel() string {
+	if x != nil && x.AssuranceLevel != nil {
+		return *x.AssuranceLevel
+	}
+	return ""
+}
+
+func (x *Control) GetShortName() string {
+	if x != nil {
+		return x.ShortName
+	}
+	return ""
+}
+
+func (x *Control) GetControlsInScope() []*ControlInScope {
+	if x != nil {
+		return x.ControlsInScope
+	}
+	return nil
+}
+
+func (x *Control) GetCatalogId() string {
+	if x != nil {
+		return x.CatalogId
+	}
+	return ""
+}
+
+func (x *Control) GetReferences() []*ControlReference {
+	if x != nil {
+		return x.References
+	}
+	return nil
+}
+
+func (x *Control) GetTextVersion() int32 {
+	if x != nil {
+		return x.TextVersion
+	}
+	return 0
+}
+
+func (x *Control) GetMaxEvidenceAgeDays() uint32 {
+	if x != nil && x.MaxEvidenceAgeDays != nil {
+		return *x.MaxEvidenceAgeDays
+	}
+	return 0
+}
+
+func (x *Control) GetRemediation() *assessment.Remediation {
+	if x != nil {
+		return x.Remediation
+	}
+	return nil
+}
+
+// A ControlReference links a control to a section of an external requirement
+// document, e.g., the regulation the control is derived from.
+type ControlReference struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Title of the referenced document, e.g., "Regulation (EU) 2022/2554"
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// Optional. The referenced section within the document, e.g., "Art. 9"
+	Section *string `protobuf:"bytes,2,opt,name=section,proto3,oneof" json:"section,omitempty"`
+	// Optional. A link to the document or section
+	Url           *string `protobuf:"bytes,3,opt,name=url,proto3,oneof" json:"url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ControlReference) Reset() {
+	*x = ControlReference{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[122]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ControlReference) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ControlReference) ProtoMessage() {}
+
+func (x *ControlReference) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[122]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ControlReference.ProtoReflect.Descriptor instead.
+func (*ControlReference) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *ControlReference) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *ControlReference) GetSection() string {
+	if x != nil && x.Section != nil {
+		return *x.Section
+	}
+	return ""
+}
+
+func (x *ControlReference) GetUrl() string {
+	if x != nil && x.Url != nil {
+		return *x.Url
+	}
+	return ""
+}
+
+// A ControlTextVersion is a version of the text of a control, i.e., of its
+// name, description and references. Versions are tracked by the path of the
+// control within the draft of its catalog, so that they are kept across
+// re-imports of the catalog and apply to its published versions as well.
+type ControlTextVersion struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the draft catalog of the control
+	CatalogId string `protobuf:"bytes,1,opt,name=catalog_id,json=catalogId,proto3" json:"catalog_id,omitempty" gorm:"primaryKey"`
+	// The path of the control in the control tree of its catalog, i.e., the
+	// short names of its parent controls and of itself separated by slashes,
+	// e.g., OPS-01/OPS-01.1
+	ControlPath string `protobuf:"bytes,2,opt,name=control_path,json=controlPath,proto3" json:"control_path,omitempty" gorm:"primaryKey"`
+	// The version number, starting with 1
+	Version int32 `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty" gorm:"primaryKey;autoIncrement:false"`
+	// The name of the control in this version
+	Name string `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	// The Markdown description of the control in this version
+	Description string `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	// The references of the control in this version
+	References []*ControlReference `protobuf:"bytes,6,rep,name=references,proto3" json:"references,omitempty" gorm:"serializer:json"`
+	// Time the version was recorded
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty" gorm:"serializer:timestamppb;type:timestamp"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ControlTextVersion) Reset() {
+	*x = ControlTextVersion{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[123]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ControlTextVersion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ControlTextVersion) ProtoMessage() {}
+
+func (x *ControlTextVersion) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[123]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ControlTextVersion.ProtoReflect.Descriptor instead.
+func (*ControlTextVersion) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *ControlTextVersion) GetCatalogId() string {
+	if x != nil {
+		return x.CatalogId
+	}
+	return ""
+}
+
+func (x *ControlTextVersion) GetControlPath() string {
+	if x != nil {
+		return x.ControlPath
+	}
+	return ""
+}
+
+func (x *ControlTextVersion) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *ControlTextVersion) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ControlTextVersion) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ControlTextVersion) GetReferences() []*ControlReference {
+	if x != nil {
+		return x.References
+	}
+	return nil
+}
+
+func (x *ControlTextVersion) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// ControlMetric is the join table between controls and their metrics. Besides
+// the mapping itself, it holds the weight of a metric within a control.
+type ControlMetric struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The control the metric is mapped to
+	ControlId string `protobuf:"bytes,1,opt,name=control_id,json=controlId,proto3" json:"control_id,omitempty" gorm:"primaryKey"`
+	// The mapped metric
+	MetricId string `protobuf:"bytes,2,opt,name=metric_id,json=metricId,proto3" json:"metric_id,omitempty" gorm:"primaryKey"`
+	// The relative weight of the metric within the control. Metrics without a
+	// weight count with a weight of 1.
+	Weight        *float64 `protobuf:"fixed64,3,opt,name=weight,proto3,oneof" json:"weight,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ControlMetric) Reset() {
+	*x = ControlMetric{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[124]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ControlMetric) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ControlMetric) ProtoMessage() {}
+
+func (x *ControlMetric) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[124]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ControlMetric.ProtoReflect.Descriptor instead.
+func (*ControlMetric) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{124}
+}
+
+func (x *ControlMetric) GetControlId() string {
+	if x != nil {
+		return x.ControlId
+	}
+	return ""
+}
+
+func (x *ControlMetric) GetMetricId() string {
+	if x != nil {
+		return x.MetricId
+	}
+	return ""
+}
+
+func (x *ControlMetric) GetWeight() float64 {
+	if x != nil && x.Weight != nil {
+		return *x.Weight
+	}
+	return 0
+}
+
+// A Audit Scope binds a target of evaluation to a catalog, so the target of evaluation is
+// evaluated regarding this catalog's controls
+type AuditScope struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Audit Scope ID
+	Id                   string `protobuf:"bytes,4,opt,name=id,proto3" json:"id,omitempty" gorm:"primaryKey"`
+	Name                 string `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
+	TargetOfEvaluationId string `protobuf:"bytes,1,opt,name=target_of_evaluation_id,json=targetOfEvaluationId,proto3" json:"target_of_evaluation_id,omitempty"`
+	CatalogId            string `protobuf:"bytes,2,opt,name=catalog_id,json=catalogId,proto3" json:"catalog_id,omitempty"`
+	// an assurance level is not offered by every catalog, therefore it is
+	// optional
+	AssuranceLevel *string `protobuf:"bytes,3,opt,name=assurance_level,json=assuranceLevel,proto3,oneof" json:"assurance_level,omitempty"`
+	// Status represents the current lifecycle status of the audit scope.
+	Status AuditScopeStatus `protobuf:"varint,9,opt,name=status,proto3,enum=confirmate.orchestrator.v1.AuditScopeStatus" json:"status,omitempty"`
+	// FK-constraint-only back-references: not populated in API responses (queries use WithoutPreload).
+	// GORM requires a relationship field on the parent to declare the FK and cascade behaviour on
+	// AutoMigrate; workflow.proto cannot import orchestrator.proto (circular), so the declarations
+	// must live here.
+	ControlsInScope    []*ControlInScope    `protobuf:"bytes,10,rep,name=controls_in_scope,json=controlsInScope,proto3" json:"controls_in_scope,omitempty" gorm:"foreignKey:AuditScopeId;constraint:OnDelete:CASCADE"`
+	AuditTrailEvents   []*AuditTrailEvent   `protobuf:"bytes,11,rep,name=audit_trail_events,json=auditTrailEvents,proto3" json:"audit_trail_events,omitempty" gorm:"foreignKey:AuditScopeId;constraint:OnDelete:CASCADE"`
+	MaintenanceWindows []*MaintenanceWindow `protobuf:"bytes,14,rep,name=maintenance_windows,json=maintenanceWindows,proto3" json:"maintenance_windows,omitempty" gorm:"foreignKey:AuditScopeId;constraint:OnDelete:CASCADE"`
+	// AdditionalCatalogIds contains further catalogs (e.g., an internal baseline) that are evaluated together with the
+	// catalog in catalog_id. Evaluation results are tagged with the catalog of their control.
+	AdditionalCatalogIds []string `protobuf:"bytes,12,rep,name=additional_catalog_ids,json=additionalCatalogIds,proto3" json:"additional_catalog_ids,omitempty" gorm:"serializer:json"`
+	// Locale is the language of user-facing texts that are generated for this audit scope, e.g., comments of evaluation
+	// results and coverage reports. Defaults to English.
+	Locale *string `protobuf:"bytes,13,opt,name=locale,proto3,oneof" json:"locale,omitempty"`
+	// Environment restricts the audit scope to the resources of an environment,
+	// e.g., prod. Only assessment results of this environment are evaluated, so
+	// that several audit scopes of the same target of evaluation can be evaluated
+	// per environment, e.g., non-compliant staging resources do not fail the
+	// certification of production. If not set, all assessment results of the
+	// target of evaluation are evaluated.
+	Environment   *string `protobuf:"bytes,15,opt,name=environment,proto3,oneof" json:"environment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditScope) Reset() {
+	*x = AuditScope{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[125]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditScope) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditScope) ProtoMessage() {}
+
+func (x *AuditScope) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[125]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditScope.ProtoReflect.Descriptor instead.
+func (*AuditScope) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{125}
+}
+
+func (x *AuditScope) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AuditScope) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AuditScope) GetTargetOfEvaluationId() string {
+	if x != nil {
+		return x.TargetOfEvaluationId
+	}
+	return ""
+}
+
+func (x *AuditScope) GetCatalogId() string {
+	if x != nil {
+		return x.CatalogId
+	}
+	return ""
+}
+
+func (x *AuditScope) GetAssuranceLevel() string {
+	if x != nil && x.AssuranceLevel != nil {
+		return *x.AssuranceLevel
+	}
+	return ""
+}
+
+func (x *AuditScope) GetStatus() AuditScopeStatus {
+	if x != nil {
+		return x.Status
+	}
+	return AuditScopeStatus_AUDIT_SCOPE_STATUS_UNSPECIFIED
+}
+
+func (x *AuditScope) GetControlsInScope() []*ControlInScope {
+	if x != nil {
+		return x.ControlsInScope
+	}
+	return nil
+}
+
+func (x *AuditScope) GetAuditTrailEvents() []*AuditTrailEvent {
+	if x != nil {
+		return x.AuditTrailEvents
+	}
+	return nil
+}
+
+func (x *AuditScope) GetMaintenanceWindows() []*MaintenanceWindow {
+	if x != nil {
+		return x.MaintenanceWindows
+	}
+	return nil
+}
+
+func (x *AuditScope) GetAdditionalCatalogIds() []string {
+	if x != nil {
+		return x.AdditionalCatalogIds
+	}
+	return nil
+}
+
+func (x *AuditScope) GetLocale() string {
+	if x != nil && x.Locale != nil {
+		return *x.Locale
+	}
+	return ""
+}
+
+func (x *AuditScope) GetEnvironment() string {
+	if x != nil && x.Environment != nil {
+		return *x.Environment
+	}
+	return ""
+}
+
+// A MaintenanceWindow is a planned time range of an audit scope, e.g., an
+// outage, in which the target of evaluation is not expected to be compliant.
+// Results stored during the window do not start tracking non-compliance and
+// therefore do not lead to SLA breaches.
+type MaintenanceWindow struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Maintenance window ID
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty" gorm:"primaryKey"`
+	// The audit scope the maintenance window belongs to
+	AuditScopeId string `protobuf:"bytes,2,opt,name=audit_scope_id,json=auditScopeId,proto3" json:"audit_scope_id,omitempty"`
+	// Start of the maintenance window (inclusive)
+	StartsAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty" gorm:"serializer:timestamppb;type:timestamp"`
+	// End of the maintenance window (exclusive)
+	EndsAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty" gorm:"serializer:timestamppb;type:timestamp"`
+	// Mode defines whether evaluation runs are suppressed or their results are
+	// flagged during the window.
+	Mode MaintenanceMode `protobuf:"varint,5,opt,name=mode,proto3,enum=confirmate.orchestrator.v1.MaintenanceMode" json:"mode,omitempty"`
+	// An optional description, e.g., the reason for the maintenance
+	Description *string `protobuf:"bytes,6,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	// The ID of the user who created the maintenance window
+	CreatedBy string `protobuf:"bytes,7,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	// Time the maintenance window was created
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty" gorm:"serializer:timestamppb;type:timestamp"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MaintenanceWindow) Reset() {
+	*x = MaintenanceWindow{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[126]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MaintenanceWindow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MaintenanceWindow) ProtoMessage() {}
+
+func (x *MaintenanceWindow) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[126]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MaintenanceWindow.ProtoReflect.Descriptor instead.
+func (*MaintenanceWindow) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{126}
+}
+
+func (x *MaintenanceWindow) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MaintenanceWindow) GetAuditScopeId() string {
+	if x != nil {
+		return x.AuditScopeId
+	}
+	return ""
+}
+
+func (x *MaintenanceWindow) GetStartsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartsAt
+	}
+	return nil
+}
+
+func (x *MaintenanceWindow) GetEndsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndsAt
+	}
+	return nil
+}
+
+func (x *MaintenanceWindow) GetMode() MaintenanceMode {
+	if x != nil {
+		return x.Mode
+	}
+	return MaintenanceMode_MAINTENANCE_MODE_UNSPECIFIED
+}
+
+func (x *MaintenanceWindow) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *MaintenanceWindow) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *MaintenanceWindow) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type GetAssessmentResultRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAssessmentResultRequest) Reset() {
+	*x = GetAssessmentResultRequest{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[127]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAssessmentResultRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAssessmentResultRequest) ProtoMessage() {}
+
+func (x *GetAssessmentResultRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[127]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAssessmentResultRequest.ProtoReflect.Descriptor instead.
+func (*GetAssessmentResultRequest) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{127}
+}
+
+func (x *GetAssessmentResultRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListAssessmentResultsRequest struct {
+	state  protoimpl.MessageState               `protogen:"open.v1"`
+	Filter *ListAssessmentResultsRequest_Filter `protobuf:"bytes,1,opt,name=filter,proto3,oneof" json:"filter,omitempty"`
+	// Optional. Latest results grouped by resource_id and metric_id.
+	LatestByResourceId *bool `protobuf:"varint,2,opt,name=latest_by_resource_id,json=latestByResourceId,proto3,oneof" json:"latest_by_resource_id,omitempty"`
+	// Optional. List only assessment results that were created at or before the
+	// given time. Combined with latest_by_resource_id, the latest results as of
+	// that time are returned, e.g., to reproduce a historical evaluation.
+	AsOf *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=as_of,json=asOf,proto3,oneof" json:"as_of,omitempty"`
+	// Optional. Return only a sample of the latest results instead of all of
+	// them, e.g., to evaluate controls backed by a huge number of resources in
+	// bounded time. It requires latest_by_resource_id.
+	Sampling      *AssessmentResultSampling `protobuf:"bytes,4,opt,name=sampling,proto3,oneof" json:"sampling,omitempty"`
+	PageSize      int32                     `protobuf:"varint,10,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                    `protobuf:"bytes,11,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	OrderBy       string                    `protobuf:"bytes,12,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	Asc           bool                      `protobuf:"varint,13,opt,name=asc,proto3" json:"asc,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAssessmentResultsRequest) Reset() {
+	*x = ListAssessmentResultsRequest{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[128]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAssessmentResultsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAssessmentResultsRequest) ProtoMessage() {}
+
+func (x *ListAssessmentResultsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[128]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAssessmentResultsRequest.ProtoReflect.Descriptor instead.
+func (*ListAssessmentResultsRequest) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{128}
+}
+
+func (x *ListAssessmentResultsRequest) GetFilter() *ListAssessmentResultsRequest_Filter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ListAssessmentResultsRequest) GetLatestByResourceId() bool {
+	if x != nil && x.LatestByResourceId != nil {
+		return *x.LatestByResourceId
+	}
+	return false
+}
+
+func (x *ListAssessmentResultsRequest) GetAsOf() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AsOf
+	}
+	return nil
+}
+
+func (x *ListAssessmentResultsRequest) GetSampling() *AssessmentResultSampling {
+	if x != nil {
+		return x.Sampling
+	}
+	return nil
+}
+
+func (x *ListAssessmentResultsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListAssessmentResultsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListAssessmentResultsRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+func (x *ListAssessmentResultsRequest) GetAsc() bool {
+	if x != nil {
+		return x.Asc
+	}
+	return false
+}
+
+type ListAssessmentResultsResponse struct {
+	state         protoimpl.MessageState         `protogen:"open.v1"`
+	Results       []*assessment.AssessmentResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	NextPageToken string                         `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// The number of latest results the sample was drawn from. It is only set,
+	// if a sampling was requested.
+	PopulationSize *int64 `protobuf:"varint,3,opt,name=population_size,json=populationSize,proto3,oneof" json:"population_size,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListAssessmentResultsResponse) Reset() {
+	*x = ListAssessmentResultsResponse{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[129]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAssessmentResultsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAssessmentResultsResponse) ProtoMessage() {}
+
+func (x *ListAssessmentResultsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[129]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAssessmentResultsResponse.ProtoReflect.Descriptor instead.
+func (*ListAssessmentResultsResponse) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{129}
+}
+
+func (x *ListAssessmentResultsResponse) GetResults() []*assessment.AssessmentResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *ListAssessmentResultsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListAssessmentResultsResponse) GetPopulationSize() int64 {
+	if x != nil && x.PopulationSize != nil {
+		return *x.PopulationSize
+	}
+	return 0
+}
+
+// AssessmentResultSampling configures the sample of the latest assessment
+// results returned by ListAssessmentResults.
+type AssessmentResultSampling struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The strategy the sample is drawn with.
+	Strategy evaluation.SamplingStrategy `protobuf:"varint,1,opt,name=strategy,proto3,enum=confirmate.evaluation.v1.SamplingStrategy" json:"strategy,omitempty"`
+	// The maximum number of results in the sample. If there are not more
+	// results, all of them are returned.
+	SampleSize int32 `protobuf:"varint,2,opt,name=sample_size,json=sampleSize,proto3" json:"sample_size,omitempty"`
+	// The seed of the sample. The same seed yields the same sample of the same
+	// results, so that samples are reproducible.
+	Seed          string `protobuf:"bytes,3,opt,name=seed,proto3" json:"seed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssessmentResultSampling) Reset() {
+	*x = AssessmentResultSampling{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[130]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssessmentResultSampling) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssessmentResultSampling) ProtoMessage() {}
+
+func (x *AssessmentResultSampling) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[130]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssessmentResultSampling.ProtoReflect.Descriptor instead.
+func (*AssessmentResultSampling) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{130}
+}
+
+func (x *AssessmentResultSampling) GetStrategy() evaluation.SamplingStrategy {
+	if x != nil {
+		return x.Strategy
+	}
+	return evaluation.SamplingStrategy(0)
+}
+
+func (x *AssessmentResultSampling) GetSampleSize() int32 {
+	if x != nil {
+		return x.SampleSize
+	}
+	return 0
+}
+
+func (x *AssessmentResultSampling) GetSeed() string {
+	if x != nil {
+		return x.Seed
+	}
+	return ""
+}
+
+type CreateAuditScopeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AuditScope    *AuditScope            `protobuf:"bytes,1,opt,name=audit_scope,json=auditScope,proto3" json:"audit_scope,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAuditScopeRequest) Reset() {
+	*x = CreateAuditScopeRequest{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[131]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAuditScopeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAuditScopeRequest) ProtoMessage() {}
+
+func (x *CreateAuditScopeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[131]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAuditScopeRequest.ProtoReflect.Descriptor instead.
+func (*CreateAuditScopeRequest) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{131}
+}
+
+func (x *CreateAuditScopeRequest) GetAuditScope() *AuditScope {
+	if x != nil {
+		return x.AuditScope
+	}
+	return nil
+}
+
+type RemoveAuditScopeRequest struct {
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	AuditScopeId            string                 `protobuf:"bytes,1,opt,name=audit_scope_id,json=auditScopeId,proto3" json:"audit_scope_id,omitempty"`
+	RemoveEvaluationResults bool                   `protobuf:"varint,2,opt,name=remove_evaluation_results,json=removeEvaluationResults,proto3" json:"remove_evaluation_results,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *RemoveAuditScopeRequest) Reset() {
+	*x = RemoveAuditScopeRequest{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[132]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveAuditScopeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveAuditScopeRequest) ProtoMessage() {}
+
+func (x *RemoveAuditScopeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[132]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveAuditScopeRequest.ProtoReflect.Descriptor instead.
+func (*RemoveAuditScopeRequest) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{132}
+}
+
+func (x *RemoveAuditScopeRequest) GetAuditScopeId() string {
+	if x != nil {
+		return x.AuditScopeId
+	}
+	return ""
+}
+
+func (x *RemoveAuditScopeRequest) GetRemoveEvaluationResults() bool {
+	if x != nil {
+		return x.RemoveEvaluationResults
+	}
+	return false
+}
+
+type GetAuditScopeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AuditScopeId  string                 `protobuf:"bytes,1,opt,name=audit_scope_id,json=auditScopeId,proto3" json:"audit_scope_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAuditScopeRequest) Reset() {
+	*x = GetAuditScopeRequest{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[133]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAuditScopeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAuditScopeRequest) ProtoMessage() {}
+
+func (x *GetAuditScopeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[133]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAuditScopeRequest.ProtoReflect.Descriptor instead.
+func (*GetAuditScopeRequest) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{133}
+}
+
+func (x *GetAuditScopeRequest) GetAuditScopeId() string {
+	if x != nil {
+		return x.AuditScopeId
+	}
+	return ""
+}
+
+type ListAuditScopesRequest struct {
+	state         protoimpl.MessageState         `protogen:"open.v1"`
+	Filter        *ListAuditScopesRequest_Filter `protobuf:"bytes,1,opt,name=filter,proto3,oneof" json:"filter,omitempty"`
+	PageSize      int32                          `protobuf:"varint,10,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                         `protobuf:"bytes,11,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	OrderBy       string                         `protobuf:"bytes,12,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	Asc           bool                           `protobuf:"varint,13,opt,name=asc,proto3" json:"asc,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAuditScopesRequest) Reset() {
+	*x = ListAuditScopesRequest{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[134]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuditScopesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditScopesRequest) ProtoMessage() {}
+
+func (x *ListAuditScopesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[134]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditScopesRequest.ProtoReflect.Descriptor instead.
+func (*ListAuditScopesRequest) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{134}
+}
+
+func (x *ListAuditScopesRequest) GetFilter() *ListAuditScopesRequest_Filter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ListAuditScopesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListAuditScopesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListAuditScopesRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+func (x *ListAuditScopesRequest) GetAsc() bool {
+	if x != nil {
+		return x.Asc
+	}
+	return false
+}
+
+type ListAuditScopesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AuditScopes   []*AuditScope          `protobuf:"bytes,1,rep,name=audit_scopes,json=auditScopes,proto3" json:"audit_scopes,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAuditScopesResponse) Reset() {
+	*x = ListAuditScopesResponse{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[135]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuditScopesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditScopesResponse) ProtoMessage() {}
+
+func (x *ListAuditScopesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[135]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditScopesResponse.ProtoReflect.Descriptor instead.
+func (*ListAuditScopesResponse) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{135}
+}
+
+func (x *ListAuditScopesResponse) GetAuditScopes() []*AuditScope {
+	if x != nil {
+		return x.AuditScopes
+	}
+	return nil
+}
+
+func (x *ListAuditScopesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type UpdateAuditScopeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AuditScope    *AuditScope            `protobuf:"bytes,3,opt,name=audit_scope,json=auditScope,proto3" json:"audit_scope,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateAuditScopeRequest) Reset() {
+	*x = UpdateAuditScopeRequest{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[136]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAuditScopeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAuditScopeRequest) ProtoMessage() {}
+
+func (x *UpdateAuditScopeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[136]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAuditScopeRequest.ProtoReflect.Descriptor instead.
+func (*UpdateAuditScopeRequest) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{136}
+}
+
+func (x *UpdateAuditScopeRequest) GetAuditScope() *AuditScope {
+	if x != nil {
+		return x.AuditScope
+	}
+	return nil
+}
+
+type CreateMaintenanceWindowRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	MaintenanceWindow *MaintenanceWindow     `protobuf:"bytes,1,opt,name=maintenance_window,json=maintenanceWindow,proto3" json:"maintenance_window,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *CreateMaintenanceWindowRequest) Reset() {
+	*x = CreateMaintenanceWindowRequest{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[137]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateMaintenanceWindowRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateMaintenanceWindowRequest) ProtoMessage() {}
+
+func (x *CreateMaintenanceWindowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[137]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateMaintenanceWindowRequest.ProtoReflect.Descriptor instead.
+func (*CreateMaintenanceWindowRequest) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{137}
+}
+
+func (x *CreateMaintenanceWindowRequest) GetMaintenanceWindow() *MaintenanceWindow {
+	if x != nil {
+		return x.MaintenanceWindow
+	}
+	return nil
+}
+
+type ListMaintenanceWindowsRequest struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	AuditScopeId string                 `protobuf:"bytes,1,opt,name=audit_scope_id,json=auditScopeId,proto3" json:"audit_scope_id,omitempty"`
+	// Optional. List only maintenance windows that are active at the given time
+	ActiveAt      *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=active_at,json=activeAt,proto3,oneof" json:"active_at,omitempty"`
+	PageSize      int32                  `protobuf:"varint,10,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,11,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	OrderBy       string                 `protobuf:"bytes,12,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	Asc           bool                   `protobuf:"varint,13,opt,name=asc,proto3" json:"asc,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMaintenanceWindowsRequest) Reset() {
+	*x = ListMaintenanceWindowsRequest{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[138]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMaintenanceWindowsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMaintenanceWindowsRequest) ProtoMessage() {}
+
+func (x *ListMaintenanceWindowsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[138]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMaintenanceWindowsRequest.ProtoReflect.Descriptor instead.
+func (*ListMaintenanceWindowsRequest) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{138}
+}
+
+func (x *ListMaintenanceWindowsRequest) GetAuditScopeId() string {
+	if x != nil {
+		return x.AuditScopeId
+	}
+	return ""
+}
+
+func (x *ListMaintenanceWindowsRequest) GetActiveAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ActiveAt
+	}
+	return nil
+}
+
+func (x *ListMaintenanceWindowsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListMaintenanceWindowsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListMaintenanceWindowsRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+func (x *ListMaintenanceWindowsRequest) GetAsc() bool {
+	if x != nil {
+		return x.Asc
+	}
+	return false
+}
+
+type ListMaintenanceWindowsResponse struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	MaintenanceWindows []*MaintenanceWindow   `protobuf:"bytes,1,rep,name=maintenance_windows,json=maintenanceWindows,proto3" json:"maintenance_windows,omitempty"`
+	NextPageToken      string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ListMaintenanceWindowsResponse) Reset() {
+	*x = ListMaintenanceWindowsResponse{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[139]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMaintenanceWindowsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMaintenanceWindowsResponse) ProtoMessage() {}
+
+func (x *ListMaintenanceWindowsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[139]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMaintenanceWindowsResponse.ProtoReflect.Descriptor instead.
+func (*ListMaintenanceWindowsResponse) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{139}
+}
+
+func (x *ListMaintenanceWindowsResponse) GetMaintenanceWindows() []*MaintenanceWindow {
+	if x != nil {
+		return x.MaintenanceWindows
+	}
+	return nil
+}
+
+func (x *ListMaintenanceWindowsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type RemoveMaintenanceWindowRequest struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	MaintenanceWindowId string                 `protobuf:"bytes,1,opt,name=maintenance_window_id,json=maintenanceWindowId,proto3" json:"maintenance_window_id,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *RemoveMaintenanceWindowRequest) Reset() {
+	*x = RemoveMaintenanceWindowRequest{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[140]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveMaintenanceWindowRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveMaintenanceWindowRequest) ProtoMessage() {}
+
+func (x *RemoveMaintenanceWindowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[140]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveMaintenanceWindowRequest.ProtoReflect.Descriptor instead.
+func (*RemoveMaintenanceWindowRequest) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{140}
+}
+
+func (x *RemoveMaintenanceWindowRequest) GetMaintenanceWindowId() string {
+	if x != nil {
+		return x.MaintenanceWindowId
+	}
+	return ""
+}
+
+type CreateWebhookRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Webhook       *Webhook               `protobuf:"bytes,1,opt,name=webhook,proto3" json:"webhook,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateWebhookRequest) Reset() {
+	*x = CreateWebhookRequest{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[141]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWebhookRequest) ProtoMessage() {}
+
+func (x *CreateWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[141]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWebhookRequest.ProtoReflect.Descriptor instead.
+func (*CreateWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{141}
+}
+
+func (x *CreateWebhookRequest) GetWebhook() *Webhook {
+	if x != nil {
+		return x.Webhook
+	}
+	return nil
+}
+
+type ListWebhooksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageSize      int32                  `protobuf:"varint,10,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,11,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	OrderBy       string                 `protobuf:"bytes,12,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	Asc           bool                   `protobuf:"varint,13,opt,name=asc,proto3" json:"asc,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebhooksRequest) Reset() {
+	*x = ListWebhooksRequest{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[142]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhooksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhooksRequest) ProtoMessage() {}
+
+func (x *ListWebhooksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[142]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhooksRequest.ProtoReflect.Descriptor instead.
+func (*ListWebhooksRequest) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{142}
+}
+
+func (x *ListWebhooksRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListWebhooksRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListWebhooksRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+func (x *ListWebhooksRequest) GetAsc() bool {
+	if x != nil {
+		return x.Asc
+	}
+	return false
+}
+
+type ListWebhooksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Webhooks      []*Webhook             `protobuf:"bytes,1,rep,name=webhooks,proto3" json:"webhooks,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebhooksResponse) Reset() {
+	*x = ListWebhooksResponse{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[143]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhooksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhooksResponse) ProtoMessage() {}
+
+func (x *ListWebhooksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[143]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhooksResponse.ProtoReflect.Descriptor instead.
+func (*ListWebhooksResponse) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{143}
+}
+
+func (x *ListWebhooksResponse) GetWebhooks() []*Webhook {
+	if x != nil {
+		return x.Webhooks
+	}
+	return nil
+}
+
+func (x *ListWebhooksResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type RemoveWebhookRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WebhookId     string                 `protobuf:"bytes,1,opt,name=webhook_id,json=webhookId,proto3" json:"webhook_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveWebhookRequest) Reset() {
+	*x = RemoveWebhookRequest{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[144]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveWebhookRequest) ProtoMessage() {}
+
+func (x *RemoveWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[144]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveWebhookRequest.ProtoReflect.Descriptor instead.
+func (*RemoveWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{144}
+}
+
+func (x *RemoveWebhookRequest) GetWebhookId() string {
+	if x != nil {
+		return x.WebhookId
+	}
+	return ""
+}
+
+type ListWebhookDeliveriesRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	WebhookId string                 `protobuf:"bytes,1,opt,name=webhook_id,json=webhookId,proto3" json:"webhook_id,omitempty"`
+	// Optional. List only failed deliveries
+	FailedOnly    *bool  `protobuf:"varint,2,opt,name=failed_only,json=failedOnly,proto3,oneof" json:"failed_only,omitempty"`
+	PageSize      int32  `protobuf:"varint,10,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string `protobuf:"bytes,11,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	OrderBy       string `protobuf:"bytes,12,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	Asc           bool   `protobuf:"varint,13,opt,name=asc,proto3" json:"asc,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebhookDeliveriesRequest) Reset() {
+	*x = ListWebhookDeliveriesRequest{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[145]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhookDeliveriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhookDeliveriesRequest) ProtoMessage() {}
+
+func (x *ListWebhookDeliveriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[145]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhookDeliveriesRequest.ProtoReflect.Descriptor instead.
+func (*ListWebhookDeliveriesRequest) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{145}
+}
+
+func (x *ListWebhookDeliveriesRequest) GetWebhookId() string {
+	if x != nil {
+		return x.WebhookId
+	}
+	return ""
+}
+
+func (x *ListWebhookDeliveriesRequest) GetFailedOnly() bool {
+	if x != nil && x.FailedOnly != nil {
+		return *x.FailedOnly
+	}
+	return false
+}
+
+func (x *ListWebhookDeliveriesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListWebhookDeliveriesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListWebhookDeliveriesRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+func (x *ListWebhookDeliveriesRequest) GetAsc() bool {
+	if x != nil {
+		return x.Asc
+	}
+	return false
+}
+
+type ListWebhookDeliveriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Deliveries    []*WebhookDelivery     `protobuf:"bytes,1,rep,name=deliveries,proto3" json:"deliveries,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebhookDeliveriesResponse) Reset() {
+	*x = ListWebhookDeliveriesResponse{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[146]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhookDeliveriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhookDeliveriesResponse) ProtoMessage() {}
+
+func (x *ListWebhookDeliveriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[146]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhookDeliveriesResponse.ProtoReflect.Descriptor instead.
+func (*ListWebhookDeliveriesResponse) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{146}
+}
+
+func (x *ListWebhookDeliveriesResponse) GetDeliveries() []*WebhookDelivery {
+	if x != nil {
+		return x.Deliveries
+	}
+	return nil
+}
+
+func (x *ListWebhookDeliveriesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type ExportOSCALRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AuditScopeId  string                 `protobuf:"bytes,1,opt,name=audit_scope_id,json=auditScopeId,proto3" json:"audit_scope_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportOSCALRequest) Reset() {
+	*x = ExportOSCALRequest{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[147]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportOSCALRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportOSCALRequest) ProtoMessage() {}
+
+func (x *ExportOSCALRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[147]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportOSCALRequest.ProtoReflect.Descriptor instead.
+func (*ExportOSCALRequest) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{147}
+}
+
+func (x *ExportOSCALRequest) GetAuditScopeId() string {
+	if x != nil {
+		return x.AuditScopeId
+	}
+	return ""
+}
+
+type ExportOSCALResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The OSCAL assessment results document, encoded as JSON.
+	AssessmentResults []byte `protobuf:"bytes,1,opt,name=assessment_results,json=assessmentResults,proto3" json:"assessment_results,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ExportOSCALResponse) Reset() {
+	*x = ExportOSCALResponse{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[148]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportOSCALResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportOSCALResponse) ProtoMessage() {}
+
+func (x *ExportOSCALResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[148]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportOSCALResponse.ProtoReflect.Descriptor instead.
+func (*ExportOSCALResponse) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{148}
+}
+
+func (x *ExportOSCALResponse) GetAssessmentResults() []byte {
+	if x != nil {
+		return x.AssessmentResults
+	}
+	return nil
+}
+
+type ArchiveAuditScopeDataRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AuditScopeId  string                 `protobuf:"bytes,1,opt,name=audit_scope_id,json=auditScopeId,proto3" json:"audit_scope_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArchiveAuditScopeDataRequest) Reset() {
+	*x = ArchiveAuditScopeDataRequest{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[149]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchiveAuditScopeDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveAuditScopeDataRequest) ProtoMessage() {}
+
+func (x *ArchiveAuditScopeDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[149]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchiveAuditScopeDataRequest.ProtoReflect.Descriptor instead.
+func (*ArchiveAuditScopeDataRequest) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{149}
+}
+
+func (x *ArchiveAuditScopeDataRequest) GetAuditScopeId() string {
+	if x != nil {
+		return x.AuditScopeId
+	}
+	return ""
+}
+
+type ArchiveAuditScopeDataResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The archive, a tar file containing a manifest.json, the evaluation results
+	// in evaluation_results.ndjson and the IDs of the referenced assessment
+	// results in assessment_result_ids.ndjson.
+	Archive       []byte `protobuf:"bytes,1,opt,name=archive,proto3" json:"archive,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArchiveAuditScopeDataResponse) Reset() {
+	*x = ArchiveAuditScopeDataResponse{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[150]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchiveAuditScopeDataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveAuditScopeDataResponse) ProtoMessage() {}
+
+func (x *ArchiveAuditScopeDataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[150]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchiveAuditScopeDataResponse.ProtoReflect.Descriptor instead.
+func (*ArchiveAuditScopeDataResponse) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{150}
+}
+
+func (x *ArchiveAuditScopeDataResponse) GetArchive() []byte {
+	if x != nil {
+		return x.Archive
+	}
+	return nil
+}
+
+type RestoreAuditScopeDataRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The Audit Scope to restore the evaluation results into. It does not need
+	// to be the Audit Scope the archive was created from.
+	AuditScopeId string `protobuf:"bytes,1,opt,name=audit_scope_id,json=auditScopeId,proto3" json:"audit_scope_id,omitempty"`
+	// The archive as created by ArchiveAuditScopeData
+	Archive       []byte `protobuf:"bytes,2,opt,name=archive,proto3" json:"archive,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreAuditScopeDataRequest) Reset() {
+	*x = RestoreAuditScopeDataRequest{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[151]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreAuditScopeDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreAuditScopeDataRequest) ProtoMessage() {}
+
+func (x *RestoreAuditScopeDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[151]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreAuditScopeDataRequest.ProtoReflect.Descriptor instead.
+func (*RestoreAuditScopeDataRequest) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{151}
+}
+
+func (x *RestoreAuditScopeDataRequest) GetAuditScopeId() string {
+	if x != nil {
+		return x.AuditScopeId
+	}
+	return ""
+}
+
+func (x *RestoreAuditScopeDataRequest) GetArchive() []byte {
+	if x != nil {
+		return x.Archive
+	}
+	return nil
+}
+
+type RestoreAuditScopeDataResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The number of restored evaluation results
+	RestoredEvaluationResults int32 `protobuf:"varint,1,opt,name=restored_evaluation_results,json=restoredEvaluationResults,proto3" json:"restored_evaluation_results,omitempty"`
+	// The number of evaluation results that were skipped because they already
+	// exist
+	SkippedEvaluationResults int32 `protobuf:"varint,2,opt,name=skipped_evaluation_results,json=skippedEvaluationResults,proto3" json:"skipped_evaluation_results,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *RestoreAuditScopeDataResponse) Reset() {
+	*x = RestoreAuditScopeDataResponse{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[152]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreAuditScopeDataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreAuditScopeDataResponse) ProtoMessage() {}
+
+func (x *RestoreAuditScopeDataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[152]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreAuditScopeDataResponse.ProtoReflect.Descriptor instead.
+func (*RestoreAuditScopeDataResponse) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{152}
+}
+
+func (x *RestoreAuditScopeDataResponse) GetRestoredEvaluationResults() int32 {
+	if x != nil {
+		return x.RestoredEvaluationResults
+	}
+	return 0
+}
+
+func (x *RestoreAuditScopeDataResponse) GetSkippedEvaluationResults() int32 {
+	if x != nil {
+		return x.SkippedEvaluationResults
+	}
+	return 0
+}
+
+type GetSchemaRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSchemaRequest) Reset() {
+	*x = GetSchemaRequest{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[153]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSchemaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSchemaRequest) ProtoMessage() {}
+
+func (x *GetSchemaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[153]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSchemaRequest.ProtoReflect.Descriptor instead.
+func (*GetSchemaRequest) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{153}
+}
+
+// Schema describes the persisted entities of the orchestrator and their
+// relationships.
+type Schema struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The entities in the order of their migration, i.e., an entity appears
+	// after all entities it holds a foreign key constraint to.
+	Entities      []*SchemaEntity `protobuf:"bytes,1,rep,name=entities,proto3" json:"entities,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Schema) Reset() {
+	*x = Schema{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[154]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Schema) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Schema) ProtoMessage() {}
+
+func (x *Schema) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[154]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Schema.ProtoReflect.Descriptor instead.
+func (*Schema) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{154}
+}
+
+func (x *Schema) GetEntities() []*SchemaEntity {
+	if x != nil {
+		return x.Entities
+	}
+	return nil
+}
+
+// SchemaEntity describes a persisted entity and its database table.
+type SchemaEntity struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the entity, e.g., "Control".
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The name of the database table, e.g., "controls".
+	Table string `protobuf:"bytes,2,opt,name=table,proto3" json:"table,omitempty"`
+	// The persisted fields of the entity.
+	Fields []*SchemaField `protobuf:"bytes,3,rep,name=fields,proto3" json:"fields,omitempty"`
+	// The relationships to other entities, sorted by their name.
+	Relationships []*SchemaRelationship `protobuf:"bytes,4,rep,name=relationships,proto3" json:"relationships,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SchemaEntity) Reset() {
+	*x = SchemaEntity{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[155]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SchemaEntity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SchemaEntity) ProtoMessage() {}
+
+func (x *SchemaEntity) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[155]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SchemaEntity.ProtoReflect.Descriptor instead.
+func (*SchemaEntity) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{155}
+}
+
+func (x *SchemaEntity) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SchemaEntity) GetTable() string {
+	if x != nil {
+		return x.Table
+	}
+	return ""
+}
+
+func (x *SchemaEntity) GetFields() []*SchemaField {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *SchemaEntity) GetRelationships() []*SchemaRelationship {
+	if x != nil {
+		return x.Relationships
+	}
+	return nil
+}
+
+// SchemaField describes a persisted field of an entity.
+type SchemaField struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the field, e.g., "CatalogId".
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The name of the database column, e.g., "catalog_id".
+	Column string `protobuf:"bytes,2,opt,name=column,proto3" json:"column,omitempty"`
+	// The database-independent data type of the column, e.g., "string", "int",
+	// "bool" or "time".
+	DataType string `protobuf:"bytes,3,opt,name=data_type,json=dataType,proto3" json:"data_type,omitempty"`
+	// Whether the column is (part of) the primary key.
+	PrimaryKey bool `protobuf:"varint,4,opt,name=primary_key,json=primaryKey,proto3" json:"primary_key,omitempty"`
+	// The serializer that is used to store the field, e.g., "json". Serialized
+	// fields need to be decoded before they can be used in joins.
+	Serializer    *string `protobuf:"bytes,5,opt,name=serializer,proto3,oneof" json:"serializer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SchemaField) Reset() {
+	*x = SchemaField{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[156]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SchemaField) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SchemaField) ProtoMessage() {}
+
+func (x *SchemaField) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[156]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SchemaField.ProtoReflect.Descriptor instead.
+func (*SchemaField) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{156}
+}
+
+func (x *SchemaField) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SchemaField) GetColumn() string {
+	if x != nil {
+		return x.Column
+	}
+	return ""
+}
+
+func (x *SchemaField) GetDataType() string {
+	if x != nil {
+		return x.DataType
+	}
+	return ""
+}
+
+func (x *SchemaField) GetPrimaryKey() bool {
+	if x != nil {
+		return x.PrimaryKey
+	}
+	return false
+}
+
+func (x *SchemaField) GetSerializer() string {
+	if x != nil && x.Serializer != nil {
+		return *x.Serializer
+	}
+	return ""
+}
+
+// SchemaRelationship describes a relationship between two entities.
+type SchemaRelationship struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the relationship, which is the name of the field that holds
+	// the related entities or the ID of the related entity.
+	Name string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type SchemaRelationshipType `protobuf:"varint,2,opt,name=type,proto3,enum=confirmate.orchestrator.v1.SchemaRelationshipType" json:"type,omitempty"`
+	// The name of the related entity.
+	Entity string `protobuf:"bytes,3,opt,name=entity,proto3" json:"entity,omitempty"`
+	// The join table of a many-to-many relationship.
+	JoinTable *string `protobuf:"bytes,4,opt,name=join_table,json=joinTable,proto3,oneof" json:"join_table,omitempty"`
+	// The columns that join both tables. For many-to-many relationships, these
+	// join each of the tables with the join table.
+	ForeignKeys   []*SchemaForeignKey `protobuf:"bytes,5,rep,name=foreign_keys,json=foreignKeys,proto3" json:"foreign_keys,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SchemaRelationship) Reset() {
+	*x = SchemaRelationship{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[157]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SchemaRelationship) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SchemaRelationship) ProtoMessage() {}
+
+func (x *SchemaRelationship) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[157]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SchemaRelationship.ProtoReflect.Descriptor instead.
+func (*SchemaRelationship) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{157}
+}
+
+func (x *SchemaRelationship) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SchemaRelationship) GetType() SchemaRelationshipType {
+	if x != nil {
+		return x.Type
+	}
+	return SchemaRelationshipType_SCHEMA_RELATIONSHIP_TYPE_UNSPECIFIED
+}
+
+func (x *SchemaRelationship) GetEntity() string {
+	if x != nil {
+		return x.Entity
+	}
+	return ""
+}
+
+func (x *SchemaRelationship) GetJoinTable() string {
+	if x != nil && x.JoinTable != nil {
+		return *x.JoinTable
+	}
+	return ""
+}
+
+func (x *SchemaRelationship) GetForeignKeys() []*SchemaForeignKey {
+	if x != nil {
+		return x.ForeignKeys
+	}
+	return nil
+}
+
+// SchemaForeignKey describes a column that references a column of another
+// table.
+type SchemaForeignKey struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Table            string                 `protobuf:"bytes,1,opt,name=table,proto3" json:"table,omitempty"`
+	Column           string                 `protobuf:"bytes,2,opt,name=column,proto3" json:"column,omitempty"`
+	ReferencedTable  string                 `protobuf:"bytes,3,opt,name=referenced_table,json=referencedTable,proto3" json:"referenced_table,omitempty"`
+	ReferencedColumn string                 `protobuf:"bytes,4,opt,name=referenced_column,json=referencedColumn,proto3" json:"referenced_column,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SchemaForeignKey) Reset() {
+	*x = SchemaForeignKey{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[158]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SchemaForeignKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SchemaForeignKey) ProtoMessage() {}
+
+func (x *SchemaForeignKey) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[158]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SchemaForeignKey.ProtoReflect.Descriptor instead.
+func (*SchemaForeignKey) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{158}
+}
+
+func (x *SchemaForeignKey) GetTable() string {
+	if x != nil {
+		return x.Table
+	}
+	return ""
+}
+
+func (x *SchemaForeignKey) GetColumn() string {
+	if x != nil {
+		return x.Column
+	}
+	return ""
+}
+
+func (x *SchemaForeignKey) GetReferencedTable() string {
+	if x != nil {
+		return x.ReferencedTable
+	}
+	return ""
+}
+
+func (x *SchemaForeignKey) GetReferencedColumn() string {
+	if x != nil {
+		return x.ReferencedColumn
+	}
+	return ""
+}
+
+type GetCertificateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CertificateId string                 `protobuf:"bytes,1,opt,name=certificate_id,json=certificateId,proto3" json:"certificate_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCertificateRequest) Reset() {
+	*x = GetCertificateRequest{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[159]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCertificateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCertificateRequest) ProtoMessage() {}
+
+func (x *GetCertificateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[159]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCertificateRequest.ProtoReflect.Descriptor instead.
+func (*GetCertificateRequest) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{159}
+}
+
+func (x *GetCertificateRequest) GetCertificateId() string {
+	if x != nil {
+		return x.CertificateId
+	}
+	return ""
+}
+
+type ListCertificatesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageSize      int32                  `protobuf:"varint,10,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,11,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	OrderBy       string                 `protobuf:"bytes,12,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	Asc           bool                   `protobuf:"varint,13,opt,name=asc,proto3" json:"asc,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCertificatesRequest) Reset() {
+	*x = ListCertificatesRequest{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[160]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCertificatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCertificatesRequest) ProtoMessage() {}
+
+func (x *ListCertificatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[160]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCertificatesRequest.ProtoReflect.Descriptor instead.
+func (*ListCertificatesRequest) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{160}
+}
+
+func (x *ListCertificatesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListCertificatesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListCertificatesRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+func (x *ListCertificatesRequest) GetAsc() bool {
+	if x != nil {
+		return x.Asc
+	}
+	return false
+}
+
+type ListCertificatesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Certificates  []*Certificate         `protobuf:"bytes,1,rep,name=certificates,proto3" json:"certificates,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCertificatesResponse) Reset() {
+	*x = ListCertificatesResponse{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[161]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCertificatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCertificatesResponse) ProtoMessage() {}
+
+func (x *ListCertificatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[161]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCertificatesResponse.ProtoReflect.Descriptor instead.
+func (*ListCertificatesResponse) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{161}
+}
+
+func (x *ListCertificatesResponse) GetCertificates() []*Certificate {
+	if x != nil {
+		return x.Certificates
+	}
+	return nil
+}
+
+func (x *ListCertificatesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type ListPublicCertificatesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageSize      int32                  `protobuf:"varint,10,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,11,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	OrderBy       string                 `protobuf:"bytes,12,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	Asc           bool                   `protobuf:"varint,13,opt,name=asc,proto3" json:"asc,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPublicCertificatesRequest) Reset() {
+	*x = ListPublicCertificatesRequest{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[162]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPublicCertificatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPublicCertificatesRequest) ProtoMessage() {}
+
+func (x *ListPublicCertificatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[162]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPublicCertificatesRequest.ProtoReflect.Descriptor instead.
+func (*ListPublicCertificatesRequest) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{162}
+}
+
+func (x *ListPublicCertificatesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListPublicCertificatesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListPublicCertificatesRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+func (x *ListPublicCertificatesRequest) GetAsc() bool {
+	if x != nil {
+		return x.Asc
+	}
+	return false
+}
+
+type ListPublicCertificatesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Certificates  []*Certificate         `protobuf:"bytes,1,rep,name=certificates,proto3" json:"certificates,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPublicCertificatesResponse) Reset() {
+	*x = ListPublicCertificatesResponse{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[163]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPublicCertificatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPublicCertificatesResponse) ProtoMessage() {}
+
+func (x *ListPublicCertificatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[163]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPublicCertificatesResponse.ProtoReflect.Descriptor instead.
+func (*ListPublicCertificatesResponse) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{163}
+}
+
+func (x *ListPublicCertificatesResponse) GetCertificates() []*Certificate {
+	if x != nil {
+		return x.Certificates
+	}
+	return nil
+}
+
+func (x *ListPublicCertificatesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type UpdateCertificateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Certificate   *Certificate           `protobuf:"bytes,1,opt,name=certificate,proto3" json:"certificate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCertificateRequest) Reset() {
+	*x = UpdateCertificateRequest{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[164]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCertificateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCertificateRequest) ProtoMessage() {}
+
+func (x *UpdateCertificateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[164]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCertificateRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCertificateRequest) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{164}
+}
+
+func (x *UpdateCertificateRequest) GetCertificate() *Certificate {
+	if x != nil {
+		return x.Certificate
+	}
+	return nil
+}
+
+type CreateCatalogRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Catalog       *Catalog               `protobuf:"bytes,1,opt,name=catalog,proto3" json:"catalog,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCatalogRequest) Reset() {
+	*x = CreateCatalogRequest{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[165]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCatalogRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCatalogRequest) ProtoMessage() {}
+
+func (x *CreateCatalogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[165]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCatalogRequest.ProtoReflect.Descriptor instead.
+func (*CreateCatalogRequest) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{165}
+}
+
+func (x *CreateCatalogRequest) GetCatalog() *Catalog {
+	if x != nil {
+		return x.Catalog
+	}
+	return nil
+}
+
+type RemoveCatalogRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CatalogId     string                 `protobuf:"bytes,1,opt,name=catalog_id,json=catalogId,proto3" json:"catalog_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveCatalogRequest) Reset() {
+	*x = RemoveCatalogRequest{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[166]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveCatalogRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveCatalogRequest) ProtoMessage() {}
+
+func (x *RemoveCatalogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[166]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveCatalogRequest.ProtoReflect.Descriptor instead.
+func (*RemoveCatalogRequest) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{166}
+}
+
+func (x *RemoveCatalogRequest) GetCatalogId() string {
+	if x != nil {
+		return x.CatalogId
+	}
+	return ""
+}
+
+type GetCatalogRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CatalogId     string                 `protobuf:"bytes,1,opt,name=catalog_id,json=catalogId,proto3" json:"catalog_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCatalogRequest) Reset() {
+	*x = GetCatalogRequest{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[167]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCatalogRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCatalogRequest) ProtoMessage() {}
+
+func (x *GetCatalogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[167]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCatalogRequest.ProtoReflect.Descriptor instead.
+func (*GetCatalogRequest) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{167}
+}
+
+func (x *GetCatalogRequest) GetCatalogId() string {
+	if x != nil {
+		return x.CatalogId
+	}
+	return ""
+}
+
+type GetCatalogTreeRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	CatalogId string                 `protobuf:"bytes,1,opt,name=catalog_id,json=catalogId,proto3" json:"catalog_id,omitempty"`
+	// Optional. The audit scope whose latest evaluation status is included for
+	// each control. The catalog must be one of the catalogs of the audit scope.
+	AuditScopeId *string `protobuf:"bytes,2,opt,name=audit_scope_id,json=auditScopeId,proto3,oneof" json:"audit_scope_id,omitempty"`
+	// Optional. The maximum depth of the tree. A depth of 1 only includes the
+	// categories, a depth of 2 also includes their top-level controls and each
+	// additional level includes one more level of sub-controls. If not set, the
+	// full tree is returned.
+	MaxDepth      *int32 `protobuf:"varint,3,opt,name=max_depth,json=maxDepth,proto3,oneof" json:"max_depth,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCatalogTreeRequest) Reset() {
+	*x = GetCatalogTreeRequest{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[168]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCatalogTreeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCatalogTreeRequest) ProtoMessage() {}
+
+func (x *GetCatalogTreeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[168]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCatalogTreeRequest.ProtoReflect.Descriptor instead.
+func (*GetCatalogTreeRequest) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{168}
+}
+
+func (x *GetCatalogTreeRequest) GetCatalogId() string {
+	if x != nil {
+		return x.CatalogId
+	}
+	return ""
+}
+
+func (x *GetCatalogTreeRequest) GetAuditScopeId() string {
+	if x != nil && x.AuditScopeId != nil {
+		return *x.AuditScopeId
+	}
+	return ""
+}
+
+func (x *GetCatalogTreeRequest) GetMaxDepth() int32 {
+	if x != nil && x.MaxDepth != nil {
+		return *x.MaxDepth
+	}
+	return 0
+}
+
+type GetCatalogTreeResponse struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	CatalogId string                 `protobuf:"bytes,1,opt,name=catalog_id,json=catalogId,proto3" json:"catalog_id,omitempty"`
+	// The audit scope of the evaluation status, if requested.
+	AuditScopeId *string `protobuf:"bytes,2,opt,name=audit_scope_id,json=auditScopeId,proto3,oneof" json:"audit_scope_id,omitempty"`
+	// The categories of the catalog, sorted by their name.
+	Categories    []*CatalogTreeCategory `protobuf:"bytes,3,rep,name=categories,proto3" json:"categories,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCatalogTreeResponse) Reset() {
+	*x = GetCatalogTreeResponse{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[169]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCatalogTreeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCatalogTreeResponse) ProtoMessage() {}
+
+func (x *GetCatalogTreeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[169]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCatalogTreeResponse.ProtoReflect.Descriptor instead.
+func (*GetCatalogTreeResponse) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{169}
+}
+
+func (x *GetCatalogTreeResponse) GetCatalogId() string {
+	if x != nil {
+		return x.CatalogId
+	}
+	return ""
+}
+
+func (x *GetCatalogTreeResponse) GetAuditScopeId() string {
+	if x != nil && x.AuditScopeId != nil {
+		return *x.AuditScopeId
+	}
+	return ""
+}
+
+func (x *GetCatalogTreeResponse) GetCategories() []*CatalogTreeCategory {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+// CatalogTreeCategory is a category within the tree of a catalog.
+type CatalogTreeCategory struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Name        string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// The number of top-level controls of the category. This also counts
+	// controls that are omitted because of the depth limit.
+	NumberOfControls int32 `protobuf:"varint,3,opt,name=number_of_controls,json=numberOfControls,proto3" json:"number_of_controls,omitempty"`
+	// The top-level controls of the category, sorted by their short name.
+	Controls      []*CatalogTreeControl `protobuf:"bytes,4,rep,name=controls,proto3" json:"controls,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CatalogTreeCategory) Reset() {
+	*x = CatalogTreeCategory{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[170]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CatalogTreeCategory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CatalogTreeCategory) ProtoMessage() {}
+
+func (x *CatalogTreeCategory) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[170]
 	if x != nil {
-		return x.ConfiguredMetrics
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *TargetOfEvaluation) GetCreatedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return nil
+// Deprecated: Use CatalogTreeCategory.ProtoReflect.Descriptor instead.
+func (*CatalogTreeCategory) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{170}
 }
 
-func (x *TargetOfEvaluation) GetUpdatedAt() *timestamppb.Timestamp {
+func (x *CatalogTreeCategory) GetName() string {
 	if x != nil {
-		return x.UpdatedAt
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-func (x *TargetOfEvaluation) GetMetadata() *TargetOfEvaluation_Metadata {
+func (x *CatalogTreeCategory) GetDescription() string {
 	if x != nil {
-		return x.Metadata
+		return x.Description
 	}
-	return nil
+	return ""
 }
 
-func (x *TargetOfEvaluation) GetTargetType() TargetOfEvaluation_TargetType {
+func (x *CatalogTreeCategory) GetNumberOfControls() int32 {
 	if x != nil {
-		return x.TargetType
+		return x.NumberOfControls
 	}
-	return TargetOfEvaluation_TARGET_TYPE_UNSPECIFIED
+	return 0
 }
 
-func (x *TargetOfEvaluation) GetOrganization() *TargetOfEvaluation_Organization {
+func (x *CatalogTreeCategory) GetControls() []*CatalogTreeControl {
 	if x != nil {
-		return x.Organization
+		return x.Controls
 	}
 	return nil
 }
 
-type Catalog struct {
-	state       protoimpl.MessageState `protogen:"open.v1"`
-	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name        string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Categories  []*Category            `protobuf:"bytes,4,rep,name=categories,proto3" json:"categories,omitempty" gorm:"constraint:OnDelete:CASCADE"`
-	// Certain security catalogs do not allow to select the scope of the controls,
-	// but all controls are automatically "in scope", however they can be set to a
-	// DELEGATED status.
-	AllInScope bool `protobuf:"varint,5,opt,name=all_in_scope,json=allInScope,proto3" json:"all_in_scope,omitempty"`
-	// A list of the assurance levels, e.g., basic, substantial and high for the
-	// EUCS catalog.
-	AssuranceLevels []string `protobuf:"bytes,7,rep,name=assurance_levels,json=assuranceLevels,proto3" json:"assurance_levels,omitempty" gorm:"serializer:json"`
-	// Catalogs short name, e.g. EUCS
-	ShortName string `protobuf:"bytes,9,opt,name=short_name,json=shortName,proto3" json:"short_name,omitempty"`
-	// metadata of the catalog
-	Metadata      *Catalog_Metadata `protobuf:"bytes,6,opt,name=metadata,proto3,oneof" json:"metadata,omitempty" gorm:"serializer:json"`
+// CatalogTreeControl is a control within the tree of a catalog.
+type CatalogTreeControl struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ShortName      string                 `protobuf:"bytes,2,opt,name=short_name,json=shortName,proto3" json:"short_name,omitempty"`
+	Name           string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	AssuranceLevel *string                `protobuf:"bytes,4,opt,name=assurance_level,json=assuranceLevel,proto3,oneof" json:"assurance_level,omitempty"`
+	// The number of metrics that are mapped to the control itself.
+	NumberOfMetrics int32 `protobuf:"varint,5,opt,name=number_of_metrics,json=numberOfMetrics,proto3" json:"number_of_metrics,omitempty"`
+	// The number of sub-controls of the control. This also counts sub-controls
+	// that are omitted because of the depth limit.
+	NumberOfControls int32 `protobuf:"varint,6,opt,name=number_of_controls,json=numberOfControls,proto3" json:"number_of_controls,omitempty"`
+	// The status of the latest evaluation result of the control within the
+	// requested audit scope. It is not set if no audit scope was requested or
+	// the control has not been evaluated yet.
+	Status *evaluation.EvaluationStatus `protobuf:"varint,7,opt,name=status,proto3,enum=confirmate.evaluation.v1.EvaluationStatus,oneof" json:"status,omitempty"`
+	// The sub-controls of the control, sorted by their short name.
+	Controls      []*CatalogTreeControl `protobuf:"bytes,8,rep,name=controls,proto3" json:"controls,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Catalog) Reset() {
-	*x = Catalog{}
-	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[36]
+func (x *CatalogTreeControl) Reset() {
+	*x = CatalogTreeControl{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[171]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Catalog) String() string {
+func (x *CatalogTreeControl) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Catalog) ProtoMessage() {}
+func (*CatalogTreeControl) ProtoMessage() {}
 
-func (x *Catalog) ProtoReflect() protoreflect.Message {
-	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[36]
+func (x *CatalogTreeControl) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[171]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2474,93 +12374,93 @@ func (x *Catalog) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Catalog.ProtoReflect.Descriptor instead.
-func (*Catalog) Descriptor() ([]byte, []int) {
-	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{36}
+// Deprecated: Use CatalogTreeControl.ProtoReflect.Descriptor instead.
+func (*CatalogTreeControl) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{171}
 }
 
-func (x *Catalog) GetId() string {
+func (x *CatalogTreeControl) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *Catalog) GetName() string {
+func (x *CatalogTreeControl) GetShortName() string {
 	if x != nil {
-		return x.Name
+		return x.ShortName
 	}
 	return ""
 }
 
-func (x *Catalog) GetDescription() string {
+func (x *CatalogTreeControl) GetName() string {
 	if x != nil {
-		return x.Description
+		return x.Name
 	}
 	return ""
 }
 
-func (x *Catalog) GetCategories() []*Category {
-	if x != nil {
-		return x.Categories
+func (x *CatalogTreeControl) GetAssuranceLevel() string {
+	if x != nil && x.AssuranceLevel != nil {
+		return *x.AssuranceLevel
 	}
-	return nil
+	return ""
 }
 
-func (x *Catalog) GetAllInScope() bool {
+func (x *CatalogTreeControl) GetNumberOfMetrics() int32 {
 	if x != nil {
-		return x.AllInScope
+		return x.NumberOfMetrics
 	}
-	return false
+	return 0
 }
 
-func (x *Catalog) GetAssuranceLevels() []string {
+func (x *CatalogTreeControl) GetNumberOfControls() int32 {
 	if x != nil {
-		return x.AssuranceLevels
+		return x.NumberOfControls
 	}
-	return nil
+	return 0
 }
 
-func (x *Catalog) GetShortName() string {
-	if x != nil {
-		return x.ShortName
+func (x *CatalogTreeControl) GetStatus() evaluation.EvaluationStatus {
+	if x != nil && x.Status != nil {
+		return *x.Status
 	}
-	return ""
+	return evaluation.EvaluationStatus(0)
 }
 
-func (x *Catalog) GetMetadata() *Catalog_Metadata {
+func (x *CatalogTreeControl) GetControls() []*CatalogTreeControl {
 	if x != nil {
-		return x.Metadata
+		return x.Controls
 	}
 	return nil
 }
 
-type Category struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty" gorm:"primaryKey"`
-	// Reference to the catalog this category belongs to.
-	CatalogId     string     `protobuf:"bytes,2,opt,name=catalog_id,json=catalogId,proto3" json:"catalog_id,omitempty" gorm:"primaryKey"`
-	Description   string     `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Controls      []*Control `protobuf:"bytes,4,rep,name=controls,proto3" json:"controls,omitempty" gorm:"many2many:category_controls;joinForeignKey:category_name,category_catalog_id;joinReferences:control_id;constraint:OnDelete:CASCADE"`
+type ListCatalogChangelogsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CatalogId     string                 `protobuf:"bytes,1,opt,name=catalog_id,json=catalogId,proto3" json:"catalog_id,omitempty"`
+	PageSize      int32                  `protobuf:"varint,10,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,11,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	OrderBy       string                 `protobuf:"bytes,12,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	Asc           bool                   `protobuf:"varint,13,opt,name=asc,proto3" json:"asc,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Category) Reset() {
-	*x = Category{}
-	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[37]
+func (x *ListCatalogChangelogsRequest) Reset() {
+	*x = ListCatalogChangelogsRequest{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[172]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Category) String() string {
+func (x *ListCatalogChangelogsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Category) ProtoMessage() {}
+func (*ListCatalogChangelogsRequest) ProtoMessage() {}
 
-func (x *Category) ProtoReflect() protoreflect.Message {
-	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[37]
+func (x *ListCatalogChangelogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[172]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2571,89 +12471,69 @@ func (x *Category) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Category.ProtoReflect.Descriptor instead.
-func (*Category) Descriptor() ([]byte, []int) {
-	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{37}
+// Deprecated: Use ListCatalogChangelogsRequest.ProtoReflect.Descriptor instead.
+func (*ListCatalogChangelogsRequest) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{172}
 }
 
-func (x *Category) GetName() string {
+func (x *ListCatalogChangelogsRequest) GetCatalogId() string {
 	if x != nil {
-		return x.Name
+		return x.CatalogId
 	}
 	return ""
 }
 
-func (x *Category) GetCatalogId() string {
+func (x *ListCatalogChangelogsRequest) GetPageSize() int32 {
 	if x != nil {
-		return x.CatalogId
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListCatalogChangelogsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
 	}
 	return ""
 }
 
-func (x *Category) GetDescription() string {
+func (x *ListCatalogChangelogsRequest) GetOrderBy() string {
 	if x != nil {
-		return x.Description
+		return x.OrderBy
 	}
 	return ""
 }
 
-func (x *Category) GetControls() []*Control {
+func (x *ListCatalogChangelogsRequest) GetAsc() bool {
 	if x != nil {
-		return x.Controls
+		return x.Asc
 	}
-	return nil
+	return false
 }
 
-// Control represents a certain Control that needs to be fulfilled. It could be
-// a Control in a certification catalog. It follows the OSCAL model. A
-// requirement in the EUCS terminology, e.g., is represented as the lowest
-// sub-control.
-type Control struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Globally unique ID of the control.
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty" gorm:"primaryKey"`
-	// Human-readable name of the control.
-	Name string `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
-	// Description of the control
-	Description string `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
-	// List of sub - controls -
-	//
-	//	this is in accordance with the OSCAL model.
-	Controls []*Control `protobuf:"bytes,6,rep,name=controls,proto3" json:"controls,omitempty" gorm:"foreignKey:parent_control_id;references:id;constraint:OnDelete:CASCADE"`
-	// metrics contains either a list of reference to metrics - in this case only
-	// the id field of the metric is populated - or a list of populated metric
-	// meta-data, most likely returned by the database.
-	Metrics []*assessment.Metric `protobuf:"bytes,7,rep,name=metrics,proto3" json:"metrics,omitempty" gorm:"many2many:control_metrics;constraint:OnDelete:CASCADE"`
-	// Unique ID of the parent control, if this is a sub-control.
-	ParentControlId *string `protobuf:"bytes,8,opt,name=parent_control_id,json=parentControlId,proto3,oneof" json:"parent_control_id,omitempty"`
-	// An assurance level is not offered by every catalog, therefore it is
-	// optional.
-	AssuranceLevel *string `protobuf:"bytes,11,opt,name=assurance_level,json=assuranceLevel,proto3,oneof" json:"assurance_level,omitempty"`
-	// Catalog-local control identifier (e.g. OPS-01).
-	ShortName string `protobuf:"bytes,12,opt,name=short_name,json=shortName,proto3" json:"short_name,omitempty"`
-	// FK-constraint-only back-reference: not populated in API responses (queries use WithoutPreload).
-	ControlsInScope []*ControlInScope `protobuf:"bytes,13,rep,name=controls_in_scope,json=controlsInScope,proto3" json:"controls_in_scope,omitempty" gorm:"foreignKey:ControlId;constraint:OnDelete:RESTRICT"`
-	// Catalog ID of the catalog this control belongs to.
-	CatalogId     string `protobuf:"bytes,14,opt,name=catalog_id,json=catalogId,proto3" json:"catalog_id,omitempty"`
+type ListCatalogChangelogsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Changelogs    []*CatalogChangelog    `protobuf:"bytes,1,rep,name=changelogs,proto3" json:"changelogs,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Control) Reset() {
-	*x = Control{}
-	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[38]
+func (x *ListCatalogChangelogsResponse) Reset() {
+	*x = ListCatalogChangelogsResponse{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[173]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Control) String() string {
+func (x *ListCatalogChangelogsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Control) ProtoMessage() {}
+func (*ListCatalogChangelogsResponse) ProtoMessage() {}
 
-func (x *Control) ProtoReflect() protoreflect.Message {
-	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[38]
+func (x *ListCatalogChangelogsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[173]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2664,120 +12544,102 @@ func (x *Control) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Control.ProtoReflect.Descriptor instead.
-func (*Control) Descriptor() ([]byte, []int) {
-	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{38}
-}
-
-func (x *Control) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
+// Deprecated: Use ListCatalogChangelogsResponse.ProtoReflect.Descriptor instead.
+func (*ListCatalogChangelogsResponse) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{173}
 }
 
-func (x *Control) GetName() string {
+func (x *ListCatalogChangelogsResponse) GetChangelogs() []*CatalogChangelog {
 	if x != nil {
-		return x.Name
+		return x.Changelogs
 	}
-	return ""
+	return nil
 }
 
-func (x *Control) GetDescription() string {
+func (x *ListCatalogChangelogsResponse) GetNextPageToken() string {
 	if x != nil {
-		return x.Description
+		return x.NextPageToken
 	}
 	return ""
 }
 
-func (x *Control) GetControls() []*Control {
-	if x != nil {
-		return x.Controls
-	}
-	return nil
-}
-
-func (x *Control) GetMetrics() []*assessment.Metric {
-	if x != nil {
-		return x.Metrics
-	}
-	return nil
+type GetCatalogChangelogRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CatalogId     string                 `protobuf:"bytes,1,opt,name=catalog_id,json=catalogId,proto3" json:"catalog_id,omitempty"`
+	ChangelogId   string                 `protobuf:"bytes,2,opt,name=changelog_id,json=changelogId,proto3" json:"changelog_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Control) GetParentControlId() string {
-	if x != nil && x.ParentControlId != nil {
-		return *x.ParentControlId
-	}
-	return ""
+func (x *GetCatalogChangelogRequest) Reset() {
+	*x = GetCatalogChangelogRequest{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[174]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Control) GetAssuranceLevel() string {
-	if x != nil && x.AssuranceLevel != nil {
-		return *x.AssuranceLevel
-	}
-	return ""
+func (x *GetCatalogChangelogRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Control) GetShortName() string {
-	if x != nil {
-		return x.ShortName
-	}
-	return ""
-}
+func (*GetCatalogChangelogRequest) ProtoMessage() {}
 
-func (x *Control) GetControlsInScope() []*ControlInScope {
+func (x *GetCatalogChangelogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[174]
 	if x != nil {
-		return x.ControlsInScope
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *Control) GetCatalogId() string {
-	if x != nil {
-		return x.CatalogId
-	}
-	return ""
+// Deprecated: Use GetCatalogChangelogRequest.ProtoReflect.Descriptor instead.
+func (*GetCatalogChangelogRequest) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{174}
 }
-
-// A Audit Scope binds a target of evaluation to a catalog, so the target of evaluation is
-// evaluated regarding this catalog's controls
-type AuditScope struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Audit Scope ID
-	Id                   string `protobuf:"bytes,4,opt,name=id,proto3" json:"id,omitempty" gorm:"primaryKey"`
-	Name                 string `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
-	TargetOfEvaluationId string `protobuf:"bytes,1,opt,name=target_of_evaluation_id,json=targetOfEvaluationId,proto3" json:"target_of_evaluation_id,omitempty"`
-	CatalogId            string `protobuf:"bytes,2,opt,name=catalog_id,json=catalogId,proto3" json:"catalog_id,omitempty"`
-	// an assurance level is not offered by every catalog, therefore it is
-	// optional
-	AssuranceLevel *string `protobuf:"bytes,3,opt,name=assurance_level,json=assuranceLevel,proto3,oneof" json:"assurance_level,omitempty"`
-	// Status represents the current lifecycle status of the audit scope.
-	Status AuditScopeStatus `protobuf:"varint,9,opt,name=status,proto3,enum=confirmate.orchestrator.v1.AuditScopeStatus" json:"status,omitempty"`
-	// FK-constraint-only back-references: not populated in API responses (queries use WithoutPreload).
-	// GORM requires a relationship field on the parent to declare the FK and cascade behaviour on
-	// AutoMigrate; workflow.proto cannot import orchestrator.proto (circular), so the declarations
-	// must live here.
-	ControlsInScope  []*ControlInScope  `protobuf:"bytes,10,rep,name=controls_in_scope,json=controlsInScope,proto3" json:"controls_in_scope,omitempty" gorm:"foreignKey:AuditScopeId;constraint:OnDelete:CASCADE"`
-	AuditTrailEvents []*AuditTrailEvent `protobuf:"bytes,11,rep,name=audit_trail_events,json=auditTrailEvents,proto3" json:"audit_trail_events,omitempty" gorm:"foreignKey:AuditScopeId;constraint:OnDelete:CASCADE"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+
+func (x *GetCatalogChangelogRequest) GetCatalogId() string {
+	if x != nil {
+		return x.CatalogId
+	}
+	return ""
 }
 
-func (x *AuditScope) Reset() {
-	*x = AuditScope{}
-	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[39]
+func (x *GetCatalogChangelogRequest) GetChangelogId() string {
+	if x != nil {
+		return x.ChangelogId
+	}
+	return ""
+}
+
+type ListCatalogsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageSize      int32                  `protobuf:"varint,10,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,11,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	OrderBy       string                 `protobuf:"bytes,12,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	Asc           bool                   `protobuf:"varint,13,opt,name=asc,proto3" json:"asc,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCatalogsRequest) Reset() {
+	*x = ListCatalogsRequest{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[175]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AuditScope) String() string {
+func (x *ListCatalogsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AuditScope) ProtoMessage() {}
+func (*ListCatalogsRequest) ProtoMessage() {}
 
-func (x *AuditScope) ProtoReflect() protoreflect.Message {
-	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[39]
+func (x *ListCatalogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[175]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2788,89 +12650,113 @@ func (x *AuditScope) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AuditScope.ProtoReflect.Descriptor instead.
-func (*AuditScope) Descriptor() ([]byte, []int) {
-	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{39}
+// Deprecated: Use ListCatalogsRequest.ProtoReflect.Descriptor instead.
+func (*ListCatalogsRequest) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{175}
 }
 
-func (x *AuditScope) GetId() string {
+func (x *ListCatalogsRequest) GetPageSize() int32 {
 	if x != nil {
-		return x.Id
+		return x.PageSize
 	}
-	return ""
+	return 0
 }
 
-func (x *AuditScope) GetName() string {
+func (x *ListCatalogsRequest) GetPageToken() string {
 	if x != nil {
-		return x.Name
+		return x.PageToken
 	}
 	return ""
 }
 
-func (x *AuditScope) GetTargetOfEvaluationId() string {
+func (x *ListCatalogsRequest) GetOrderBy() string {
 	if x != nil {
-		return x.TargetOfEvaluationId
+		return x.OrderBy
 	}
 	return ""
 }
 
-func (x *AuditScope) GetCatalogId() string {
+func (x *ListCatalogsRequest) GetAsc() bool {
 	if x != nil {
-		return x.CatalogId
+		return x.Asc
 	}
-	return ""
+	return false
 }
 
-func (x *AuditScope) GetAssuranceLevel() string {
-	if x != nil && x.AssuranceLevel != nil {
-		return *x.AssuranceLevel
-	}
-	return ""
+type ListCatalogsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Catalogs      []*Catalog             `protobuf:"bytes,1,rep,name=catalogs,proto3" json:"catalogs,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AuditScope) GetStatus() AuditScopeStatus {
+func (x *ListCatalogsResponse) Reset() {
+	*x = ListCatalogsResponse{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[176]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCatalogsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCatalogsResponse) ProtoMessage() {}
+
+func (x *ListCatalogsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[176]
 	if x != nil {
-		return x.Status
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return AuditScopeStatus_AUDIT_SCOPE_STATUS_UNSPECIFIED
+	return mi.MessageOf(x)
 }
 
-func (x *AuditScope) GetControlsInScope() []*ControlInScope {
+// Deprecated: Use ListCatalogsResponse.ProtoReflect.Descriptor instead.
+func (*ListCatalogsResponse) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{176}
+}
+
+func (x *ListCatalogsResponse) GetCatalogs() []*Catalog {
 	if x != nil {
-		return x.ControlsInScope
+		return x.Catalogs
 	}
 	return nil
 }
 
-func (x *AuditScope) GetAuditTrailEvents() []*AuditTrailEvent {
+func (x *ListCatalogsResponse) GetNextPageToken() string {
 	if x != nil {
-		return x.AuditTrailEvents
+		return x.NextPageToken
 	}
-	return nil
+	return ""
 }
 
-type GetAssessmentResultRequest struct {
+type UpdateCatalogRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Catalog       *Catalog               `protobuf:"bytes,1,opt,name=catalog,proto3" json:"catalog,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAssessmentResultRequest) Reset() {
-	*x = GetAssessmentResultRequest{}
-	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[40]
+func (x *UpdateCatalogRequest) Reset() {
+	*x = UpdateCatalogRequest{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[177]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAssessmentResultRequest) String() string {
+func (x *UpdateCatalogRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAssessmentResultRequest) ProtoMessage() {}
+func (*UpdateCatalogRequest) ProtoMessage() {}
 
-func (x *GetAssessmentResultRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[40]
+func (x *UpdateCatalogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[177]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2881,46 +12767,40 @@ func (x *GetAssessmentResultRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAssessmentResultRequest.ProtoReflect.Descriptor instead.
-func (*GetAssessmentResultRequest) Descriptor() ([]byte, []int) {
-	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{40}
+// Deprecated: Use UpdateCatalogRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCatalogRequest) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{177}
 }
 
-func (x *GetAssessmentResultRequest) GetId() string {
+func (x *UpdateCatalogRequest) GetCatalog() *Catalog {
 	if x != nil {
-		return x.Id
+		return x.Catalog
 	}
-	return ""
+	return nil
 }
 
-type ListAssessmentResultsRequest struct {
-	state  protoimpl.MessageState               `protogen:"open.v1"`
-	Filter *ListAssessmentResultsRequest_Filter `protobuf:"bytes,1,opt,name=filter,proto3,oneof" json:"filter,omitempty"`
-	// Optional. Latest results grouped by resource_id and metric_id.
-	LatestByResourceId *bool  `protobuf:"varint,2,opt,name=latest_by_resource_id,json=latestByResourceId,proto3,oneof" json:"latest_by_resource_id,omitempty"`
-	PageSize           int32  `protobuf:"varint,10,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	PageToken          string `protobuf:"bytes,11,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
-	OrderBy            string `protobuf:"bytes,12,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
-	Asc                bool   `protobuf:"varint,13,opt,name=asc,proto3" json:"asc,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+type PublishCatalogRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CatalogId     string                 `protobuf:"bytes,1,opt,name=catalog_id,json=catalogId,proto3" json:"catalog_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListAssessmentResultsRequest) Reset() {
-	*x = ListAssessmentResultsRequest{}
-	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[41]
+func (x *PublishCatalogRequest) Reset() {
+	*x = PublishCatalogRequest{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[178]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListAssessmentResultsRequest) String() string {
+func (x *PublishCatalogRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListAssessmentResultsRequest) ProtoMessage() {}
+func (*PublishCatalogRequest) ProtoMessage() {}
 
-func (x *ListAssessmentResultsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[41]
+func (x *PublishCatalogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[178]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2931,76 +12811,97 @@ func (x *ListAssessmentResultsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListAssessmentResultsRequest.ProtoReflect.Descriptor instead.
-func (*ListAssessmentResultsRequest) Descriptor() ([]byte, []int) {
-	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{41}
+// Deprecated: Use PublishCatalogRequest.ProtoReflect.Descriptor instead.
+func (*PublishCatalogRequest) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{178}
 }
 
-func (x *ListAssessmentResultsRequest) GetFilter() *ListAssessmentResultsRequest_Filter {
+func (x *PublishCatalogRequest) GetCatalogId() string {
 	if x != nil {
-		return x.Filter
+		return x.CatalogId
 	}
-	return nil
+	return ""
 }
 
-func (x *ListAssessmentResultsRequest) GetLatestByResourceId() bool {
-	if x != nil && x.LatestByResourceId != nil {
-		return *x.LatestByResourceId
-	}
-	return false
+type DiscardCatalogDraftRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CatalogId     string                 `protobuf:"bytes,1,opt,name=catalog_id,json=catalogId,proto3" json:"catalog_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListAssessmentResultsRequest) GetPageSize() int32 {
-	if x != nil {
-		return x.PageSize
-	}
-	return 0
+func (x *DiscardCatalogDraftRequest) Reset() {
+	*x = DiscardCatalogDraftRequest{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[179]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListAssessmentResultsRequest) GetPageToken() string {
-	if x != nil {
-		return x.PageToken
-	}
-	return ""
+func (x *DiscardCatalogDraftRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *ListAssessmentResultsRequest) GetOrderBy() string {
+func (*DiscardCatalogDraftRequest) ProtoMessage() {}
+
+func (x *DiscardCatalogDraftRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[179]
 	if x != nil {
-		return x.OrderBy
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *ListAssessmentResultsRequest) GetAsc() bool {
+// Deprecated: Use DiscardCatalogDraftRequest.ProtoReflect.Descriptor instead.
+func (*DiscardCatalogDraftRequest) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{179}
+}
+
+func (x *DiscardCatalogDraftRequest) GetCatalogId() string {
 	if x != nil {
-		return x.Asc
+		return x.CatalogId
 	}
-	return false
+	return ""
 }
 
-type ListAssessmentResultsResponse struct {
-	state         protoimpl.MessageState         `protogen:"open.v1"`
-	Results       []*assessment.AssessmentResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
-	NextPageToken string                         `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+type ImportControlMetricMappingRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The catalog whose controls are mapped. Published catalogs cannot be
+	// modified.
+	CatalogId string `protobuf:"bytes,1,opt,name=catalog_id,json=catalogId,proto3" json:"catalog_id,omitempty"`
+	// The format of the file
+	Format MappingFileFormat `protobuf:"varint,2,opt,name=format,proto3,enum=confirmate.orchestrator.v1.MappingFileFormat" json:"format,omitempty"`
+	// The content of the file. Its first row is a header naming the columns
+	// "control_id", "metric_id" and, optionally, "weight". A control is either
+	// referenced by its ID or its short name, e.g., "OPS-01".
+	Data []byte `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	// If set, the mapping is only validated, but not applied
+	DryRun bool `protobuf:"varint,4,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	// If set, all existing metrics of the controls contained in the file are
+	// replaced by the imported ones. Otherwise, the imported metrics are added.
+	Replace       bool `protobuf:"varint,5,opt,name=replace,proto3" json:"replace,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListAssessmentResultsResponse) Reset() {
-	*x = ListAssessmentResultsResponse{}
-	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[42]
+func (x *ImportControlMetricMappingRequest) Reset() {
+	*x = ImportControlMetricMappingRequest{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[180]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListAssessmentResultsResponse) String() string {
+func (x *ImportControlMetricMappingRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListAssessmentResultsResponse) ProtoMessage() {}
+func (*ImportControlMetricMappingRequest) ProtoMessage() {}
 
-func (x *ListAssessmentResultsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[42]
+func (x *ImportControlMetricMappingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[180]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3011,47 +12912,72 @@ func (x *ListAssessmentResultsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListAssessmentResultsResponse.ProtoReflect.Descriptor instead.
-func (*ListAssessmentResultsResponse) Descriptor() ([]byte, []int) {
-	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{42}
+// Deprecated: Use ImportControlMetricMappingRequest.ProtoReflect.Descriptor instead.
+func (*ImportControlMetricMappingRequest) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{180}
 }
 
-func (x *ListAssessmentResultsResponse) GetResults() []*assessment.AssessmentResult {
+func (x *ImportControlMetricMappingRequest) GetCatalogId() string {
 	if x != nil {
-		return x.Results
+		return x.CatalogId
+	}
+	return ""
+}
+
+func (x *ImportControlMetricMappingRequest) GetFormat() MappingFileFormat {
+	if x != nil {
+		return x.Format
+	}
+	return MappingFileFormat_MAPPING_FILE_FORMAT_UNSPECIFIED
+}
+
+func (x *ImportControlMetricMappingRequest) GetData() []byte {
+	if x != nil {
+		return x.Data
 	}
 	return nil
 }
 
-func (x *ListAssessmentResultsResponse) GetNextPageToken() string {
+func (x *ImportControlMetricMappingRequest) GetDryRun() bool {
 	if x != nil {
-		return x.NextPageToken
+		return x.DryRun
 	}
-	return ""
+	return false
 }
 
-type CreateAuditScopeRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	AuditScope    *AuditScope            `protobuf:"bytes,1,opt,name=audit_scope,json=auditScope,proto3" json:"audit_scope,omitempty"`
+func (x *ImportControlMetricMappingRequest) GetReplace() bool {
+	if x != nil {
+		return x.Replace
+	}
+	return false
+}
+
+// ImportIssue describes a problem with a row of an imported file.
+type ImportIssue struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The row of the file, starting with 1 for the header
+	Row int32 `protobuf:"varint,1,opt,name=row,proto3" json:"row,omitempty"`
+	// A human-readable description of the problem
+	Message       string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateAuditScopeRequest) Reset() {
-	*x = CreateAuditScopeRequest{}
-	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[43]
+func (x *ImportIssue) Reset() {
+	*x = ImportIssue{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[181]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateAuditScopeRequest) String() string {
+func (x *ImportIssue) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateAuditScopeRequest) ProtoMessage() {}
+func (*ImportIssue) ProtoMessage() {}
 
-func (x *CreateAuditScopeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[43]
+func (x *ImportIssue) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[181]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3062,41 +12988,55 @@ func (x *CreateAuditScopeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateAuditScopeRequest.ProtoReflect.Descriptor instead.
-func (*CreateAuditScopeRequest) Descriptor() ([]byte, []int) {
-	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{43}
+// Deprecated: Use ImportIssue.ProtoReflect.Descriptor instead.
+func (*ImportIssue) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{181}
 }
 
-func (x *CreateAuditScopeRequest) GetAuditScope() *AuditScope {
+func (x *ImportIssue) GetRow() int32 {
 	if x != nil {
-		return x.AuditScope
+		return x.Row
 	}
-	return nil
+	return 0
 }
 
-type RemoveAuditScopeRequest struct {
-	state                   protoimpl.MessageState `protogen:"open.v1"`
-	AuditScopeId            string                 `protobuf:"bytes,1,opt,name=audit_scope_id,json=auditScopeId,proto3" json:"audit_scope_id,omitempty"`
-	RemoveEvaluationResults bool                   `protobuf:"varint,2,opt,name=remove_evaluation_results,json=removeEvaluationResults,proto3" json:"remove_evaluation_results,omitempty"`
-	unknownFields           protoimpl.UnknownFields
-	sizeCache               protoimpl.SizeCache
+func (x *ImportIssue) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
 }
 
-func (x *RemoveAuditScopeRequest) Reset() {
-	*x = RemoveAuditScopeRequest{}
-	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[44]
+type ImportControlMetricMappingResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The number of mapping rows in the file, excluding the header
+	Rows int32 `protobuf:"varint,1,opt,name=rows,proto3" json:"rows,omitempty"`
+	// The number of control-to-metric mappings that were (or, in a dry run,
+	// would have been) created or updated
+	Mapped int32 `protobuf:"varint,2,opt,name=mapped,proto3" json:"mapped,omitempty"`
+	// The problems found in the file
+	Issues []*ImportIssue `protobuf:"bytes,3,rep,name=issues,proto3" json:"issues,omitempty"`
+	// Whether the mapping was applied
+	Applied       bool `protobuf:"varint,4,opt,name=applied,proto3" json:"applied,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportControlMetricMappingResponse) Reset() {
+	*x = ImportControlMetricMappingResponse{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[182]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RemoveAuditScopeRequest) String() string {
+func (x *ImportControlMetricMappingResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveAuditScopeRequest) ProtoMessage() {}
+func (*ImportControlMetricMappingResponse) ProtoMessage() {}
 
-func (x *RemoveAuditScopeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[44]
+func (x *ImportControlMetricMappingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[182]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3107,47 +13047,61 @@ func (x *RemoveAuditScopeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveAuditScopeRequest.ProtoReflect.Descriptor instead.
-func (*RemoveAuditScopeRequest) Descriptor() ([]byte, []int) {
-	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{44}
+// Deprecated: Use ImportControlMetricMappingResponse.ProtoReflect.Descriptor instead.
+func (*ImportControlMetricMappingResponse) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{182}
 }
 
-func (x *RemoveAuditScopeRequest) GetAuditScopeId() string {
+func (x *ImportControlMetricMappingResponse) GetRows() int32 {
 	if x != nil {
-		return x.AuditScopeId
+		return x.Rows
 	}
-	return ""
+	return 0
 }
 
-func (x *RemoveAuditScopeRequest) GetRemoveEvaluationResults() bool {
+func (x *ImportControlMetricMappingResponse) GetMapped() int32 {
 	if x != nil {
-		return x.RemoveEvaluationResults
+		return x.Mapped
+	}
+	return 0
+}
+
+func (x *ImportControlMetricMappingResponse) GetIssues() []*ImportIssue {
+	if x != nil {
+		return x.Issues
+	}
+	return nil
+}
+
+func (x *ImportControlMetricMappingResponse) GetApplied() bool {
+	if x != nil {
+		return x.Applied
 	}
 	return false
 }
 
-type GetAuditScopeRequest struct {
+type UpdateAssuranceLevelRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	AuditScopeId  string                 `protobuf:"bytes,1,opt,name=audit_scope_id,json=auditScopeId,proto3" json:"audit_scope_id,omitempty"`
+	Level         *AssuranceLevel        `protobuf:"bytes,1,opt,name=level,proto3" json:"level,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAuditScopeRequest) Reset() {
-	*x = GetAuditScopeRequest{}
-	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[45]
+func (x *UpdateAssuranceLevelRequest) Reset() {
+	*x = UpdateAssuranceLevelRequest{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[183]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAuditScopeRequest) String() string {
+func (x *UpdateAssuranceLevelRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAuditScopeRequest) ProtoMessage() {}
+func (*UpdateAssuranceLevelRequest) ProtoMessage() {}
 
-func (x *GetAuditScopeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[45]
+func (x *UpdateAssuranceLevelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[183]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3158,44 +13112,44 @@ func (x *GetAuditScopeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAuditScopeRequest.ProtoReflect.Descriptor instead.
-func (*GetAuditScopeRequest) Descriptor() ([]byte, []int) {
-	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{45}
+// Deprecated: Use UpdateAssuranceLevelRequest.ProtoReflect.Descriptor instead.
+func (*UpdateAssuranceLevelRequest) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{183}
 }
 
-func (x *GetAuditScopeRequest) GetAuditScopeId() string {
+func (x *UpdateAssuranceLevelRequest) GetLevel() *AssuranceLevel {
 	if x != nil {
-		return x.AuditScopeId
+		return x.Level
 	}
-	return ""
+	return nil
 }
 
-type ListAuditScopesRequest struct {
-	state         protoimpl.MessageState         `protogen:"open.v1"`
-	Filter        *ListAuditScopesRequest_Filter `protobuf:"bytes,1,opt,name=filter,proto3,oneof" json:"filter,omitempty"`
-	PageSize      int32                          `protobuf:"varint,10,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	PageToken     string                         `protobuf:"bytes,11,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
-	OrderBy       string                         `protobuf:"bytes,12,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
-	Asc           bool                           `protobuf:"varint,13,opt,name=asc,proto3" json:"asc,omitempty"`
+type ListAssuranceLevelsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CatalogId     string                 `protobuf:"bytes,1,opt,name=catalog_id,json=catalogId,proto3" json:"catalog_id,omitempty"`
+	PageSize      int32                  `protobuf:"varint,10,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,11,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	OrderBy       string                 `protobuf:"bytes,12,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	Asc           bool                   `protobuf:"varint,13,opt,name=asc,proto3" json:"asc,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListAuditScopesRequest) Reset() {
-	*x = ListAuditScopesRequest{}
-	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[46]
+func (x *ListAssuranceLevelsRequest) Reset() {
+	*x = ListAssuranceLevelsRequest{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[184]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListAuditScopesRequest) String() string {
+func (x *ListAssuranceLevelsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListAuditScopesRequest) ProtoMessage() {}
+func (*ListAssuranceLevelsRequest) ProtoMessage() {}
 
-func (x *ListAuditScopesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[46]
+func (x *ListAssuranceLevelsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[184]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3206,69 +13160,69 @@ func (x *ListAuditScopesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListAuditScopesRequest.ProtoReflect.Descriptor instead.
-func (*ListAuditScopesRequest) Descriptor() ([]byte, []int) {
-	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{46}
+// Deprecated: Use ListAssuranceLevelsRequest.ProtoReflect.Descriptor instead.
+func (*ListAssuranceLevelsRequest) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{184}
 }
 
-func (x *ListAuditScopesRequest) GetFilter() *ListAuditScopesRequest_Filter {
+func (x *ListAssuranceLevelsRequest) GetCatalogId() string {
 	if x != nil {
-		return x.Filter
+		return x.CatalogId
 	}
-	return nil
+	return ""
 }
 
-func (x *ListAuditScopesRequest) GetPageSize() int32 {
+func (x *ListAssuranceLevelsRequest) GetPageSize() int32 {
 	if x != nil {
 		return x.PageSize
 	}
 	return 0
 }
 
-func (x *ListAuditScopesRequest) GetPageToken() string {
+func (x *ListAssuranceLevelsRequest) GetPageToken() string {
 	if x != nil {
 		return x.PageToken
 	}
 	return ""
 }
 
-func (x *ListAuditScopesRequest) GetOrderBy() string {
+func (x *ListAssuranceLevelsRequest) GetOrderBy() string {
 	if x != nil {
 		return x.OrderBy
 	}
 	return ""
 }
 
-func (x *ListAuditScopesRequest) GetAsc() bool {
+func (x *ListAssuranceLevelsRequest) GetAsc() bool {
 	if x != nil {
 		return x.Asc
 	}
 	return false
 }
 
-type ListAuditScopesResponse struct {
+type ListAssuranceLevelsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	AuditScopes   []*AuditScope          `protobuf:"bytes,1,rep,name=audit_scopes,json=auditScopes,proto3" json:"audit_scopes,omitempty"`
+	Levels        []*AssuranceLevel      `protobuf:"bytes,1,rep,name=levels,proto3" json:"levels,omitempty"`
 	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListAuditScopesResponse) Reset() {
-	*x = ListAuditScopesResponse{}
-	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[47]
+func (x *ListAssuranceLevelsResponse) Reset() {
+	*x = ListAssuranceLevelsResponse{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[185]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListAuditScopesResponse) String() string {
+func (x *ListAssuranceLevelsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListAuditScopesResponse) ProtoMessage() {}
+func (*ListAssuranceLevelsResponse) ProtoMessage() {}
 
-func (x *ListAuditScopesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[47]
+func (x *ListAssuranceLevelsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[185]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3279,47 +13233,48 @@ func (x *ListAuditScopesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListAuditScopesResponse.ProtoReflect.Descriptor instead.
-func (*ListAuditScopesResponse) Descriptor() ([]byte, []int) {
-	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{47}
+// Deprecated: Use ListAssuranceLevelsResponse.ProtoReflect.Descriptor instead.
+func (*ListAssuranceLevelsResponse) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{185}
 }
 
-func (x *ListAuditScopesResponse) GetAuditScopes() []*AuditScope {
+func (x *ListAssuranceLevelsResponse) GetLevels() []*AssuranceLevel {
 	if x != nil {
-		return x.AuditScopes
+		return x.Levels
 	}
 	return nil
 }
 
-func (x *ListAuditScopesResponse) GetNextPageToken() string {
+func (x *ListAssuranceLevelsResponse) GetNextPageToken() string {
 	if x != nil {
 		return x.NextPageToken
 	}
 	return ""
 }
 
-type UpdateAuditScopeRequest struct {
+type RemoveAssuranceLevelRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	AuditScope    *AuditScope            `protobuf:"bytes,3,opt,name=audit_scope,json=auditScope,proto3" json:"audit_scope,omitempty"`
+	CatalogId     string                 `protobuf:"bytes,1,opt,name=catalog_id,json=catalogId,proto3" json:"catalog_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateAuditScopeRequest) Reset() {
-	*x = UpdateAuditScopeRequest{}
-	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[48]
+func (x *RemoveAssuranceLevelRequest) Reset() {
+	*x = RemoveAssuranceLevelRequest{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[186]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateAuditScopeRequest) String() string {
+func (x *RemoveAssuranceLevelRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateAuditScopeRequest) ProtoMessage() {}
+func (*RemoveAssuranceLevelRequest) ProtoMessage() {}
 
-func (x *UpdateAuditScopeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[48]
+func (x *RemoveAssuranceLevelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[186]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3330,40 +13285,47 @@ func (x *UpdateAuditScopeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateAuditScopeRequest.ProtoReflect.Descriptor instead.
-func (*UpdateAuditScopeRequest) Descriptor() ([]byte, []int) {
-	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{48}
+// Deprecated: Use RemoveAssuranceLevelRequest.ProtoReflect.Descriptor instead.
+func (*RemoveAssuranceLevelRequest) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{186}
 }
 
-func (x *UpdateAuditScopeRequest) GetAuditScope() *AuditScope {
+func (x *RemoveAssuranceLevelRequest) GetCatalogId() string {
 	if x != nil {
-		return x.AuditScope
+		return x.CatalogId
 	}
-	return nil
+	return ""
 }
 
-type GetCertificateRequest struct {
+func (x *RemoveAssuranceLevelRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type UpdateApplicabilityRuleRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	CertificateId string                 `protobuf:"bytes,1,opt,name=certificate_id,json=certificateId,proto3" json:"certificate_id,omitempty"`
+	Rule          *ApplicabilityRule     `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCertificateRequest) Reset() {
-	*x = GetCertificateRequest{}
-	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[49]
+func (x *UpdateApplicabilityRuleRequest) Reset() {
+	*x = UpdateApplicabilityRuleRequest{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[187]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCertificateRequest) String() string {
+func (x *UpdateApplicabilityRuleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCertificateRequest) ProtoMessage() {}
+func (*UpdateApplicabilityRuleRequest) ProtoMessage() {}
 
-func (x *GetCertificateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[49]
+func (x *UpdateApplicabilityRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[187]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3374,20 +13336,21 @@ func (x *GetCertificateRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCertificateRequest.ProtoReflect.Descriptor instead.
-func (*GetCertificateRequest) Descriptor() ([]byte, []int) {
-	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{49}
+// Deprecated: Use UpdateApplicabilityRuleRequest.ProtoReflect.Descriptor instead.
+func (*UpdateApplicabilityRuleRequest) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{187}
 }
 
-func (x *GetCertificateRequest) GetCertificateId() string {
+func (x *UpdateApplicabilityRuleRequest) GetRule() *ApplicabilityRule {
 	if x != nil {
-		return x.CertificateId
+		return x.Rule
 	}
-	return ""
+	return nil
 }
 
-type ListCertificatesRequest struct {
+type ListApplicabilityRulesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	CatalogId     string                 `protobuf:"bytes,1,opt,name=catalog_id,json=catalogId,proto3" json:"catalog_id,omitempty"`
 	PageSize      int32                  `protobuf:"varint,10,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
 	PageToken     string                 `protobuf:"bytes,11,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
 	OrderBy       string                 `protobuf:"bytes,12,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
@@ -3396,21 +13359,21 @@ type ListCertificatesRequest struct {
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListCertificatesRequest) Reset() {
-	*x = ListCertificatesRequest{}
-	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[50]
+func (x *ListApplicabilityRulesRequest) Reset() {
+	*x = ListApplicabilityRulesRequest{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[188]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListCertificatesRequest) String() string {
+func (x *ListApplicabilityRulesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListCertificatesRequest) ProtoMessage() {}
+func (*ListApplicabilityRulesRequest) ProtoMessage() {}
 
-func (x *ListCertificatesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[50]
+func (x *ListApplicabilityRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[188]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3421,62 +13384,69 @@ func (x *ListCertificatesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListCertificatesRequest.ProtoReflect.Descriptor instead.
-func (*ListCertificatesRequest) Descriptor() ([]byte, []int) {
-	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{50}
+// Deprecated: Use ListApplicabilityRulesRequest.ProtoReflect.Descriptor instead.
+func (*ListApplicabilityRulesRequest) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{188}
 }
 
-func (x *ListCertificatesRequest) GetPageSize() int32 {
+func (x *ListApplicabilityRulesRequest) GetCatalogId() string {
+	if x != nil {
+		return x.CatalogId
+	}
+	return ""
+}
+
+func (x *ListApplicabilityRulesRequest) GetPageSize() int32 {
 	if x != nil {
 		return x.PageSize
 	}
 	return 0
 }
 
-func (x *ListCertificatesRequest) GetPageToken() string {
+func (x *ListApplicabilityRulesRequest) GetPageToken() string {
 	if x != nil {
 		return x.PageToken
 	}
 	return ""
 }
 
-func (x *ListCertificatesRequest) GetOrderBy() string {
+func (x *ListApplicabilityRulesRequest) GetOrderBy() string {
 	if x != nil {
 		return x.OrderBy
 	}
 	return ""
 }
 
-func (x *ListCertificatesRequest) GetAsc() bool {
+func (x *ListApplicabilityRulesRequest) GetAsc() bool {
 	if x != nil {
 		return x.Asc
 	}
 	return false
 }
 
-type ListCertificatesResponse struct {
+type ListApplicabilityRulesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Certificates  []*Certificate         `protobuf:"bytes,1,rep,name=certificates,proto3" json:"certificates,omitempty"`
+	Rules         []*ApplicabilityRule   `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
 	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListCertificatesResponse) Reset() {
-	*x = ListCertificatesResponse{}
-	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[51]
+func (x *ListApplicabilityRulesResponse) Reset() {
+	*x = ListApplicabilityRulesResponse{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[189]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListCertificatesResponse) String() string {
+func (x *ListApplicabilityRulesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListCertificatesResponse) ProtoMessage() {}
+func (*ListApplicabilityRulesResponse) ProtoMessage() {}
 
-func (x *ListCertificatesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[51]
+func (x *ListApplicabilityRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[189]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3487,26 +13457,70 @@ func (x *ListCertificatesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListCertificatesResponse.ProtoReflect.Descriptor instead.
-func (*ListCertificatesResponse) Descriptor() ([]byte, []int) {
-	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{51}
+// Deprecated: Use ListApplicabilityRulesResponse.ProtoReflect.Descriptor instead.
+func (*ListApplicabilityRulesResponse) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{189}
 }
 
-func (x *ListCertificatesResponse) GetCertificates() []*Certificate {
+func (x *ListApplicabilityRulesResponse) GetRules() []*ApplicabilityRule {
 	if x != nil {
-		return x.Certificates
+		return x.Rules
 	}
 	return nil
 }
 
-func (x *ListCertificatesResponse) GetNextPageToken() string {
+func (x *ListApplicabilityRulesResponse) GetNextPageToken() string {
 	if x != nil {
 		return x.NextPageToken
 	}
 	return ""
 }
 
-type ListPublicCertificatesRequest struct {
+type CreateCatalogSourceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Source        *CatalogSource         `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCatalogSourceRequest) Reset() {
+	*x = CreateCatalogSourceRequest{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[190]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCatalogSourceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCatalogSourceRequest) ProtoMessage() {}
+
+func (x *CreateCatalogSourceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[190]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCatalogSourceRequest.ProtoReflect.Descriptor instead.
+func (*CreateCatalogSourceRequest) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{190}
+}
+
+func (x *CreateCatalogSourceRequest) GetSource() *CatalogSource {
+	if x != nil {
+		return x.Source
+	}
+	return nil
+}
+
+type ListCatalogSourcesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	PageSize      int32                  `protobuf:"varint,10,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
 	PageToken     string                 `protobuf:"bytes,11,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
@@ -3516,21 +13530,21 @@ type ListPublicCertificatesRequest struct {
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListPublicCertificatesRequest) Reset() {
-	*x = ListPublicCertificatesRequest{}
-	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[52]
+func (x *ListCatalogSourcesRequest) Reset() {
+	*x = ListCatalogSourcesRequest{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[191]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListPublicCertificatesRequest) String() string {
+func (x *ListCatalogSourcesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListPublicCertificatesRequest) ProtoMessage() {}
+func (*ListCatalogSourcesRequest) ProtoMessage() {}
 
-func (x *ListPublicCertificatesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[52]
+func (x *ListCatalogSourcesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[191]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3541,62 +13555,113 @@ func (x *ListPublicCertificatesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListPublicCertificatesRequest.ProtoReflect.Descriptor instead.
-func (*ListPublicCertificatesRequest) Descriptor() ([]byte, []int) {
-	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{52}
+// Deprecated: Use ListCatalogSourcesRequest.ProtoReflect.Descriptor instead.
+func (*ListCatalogSourcesRequest) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{191}
 }
 
-func (x *ListPublicCertificatesRequest) GetPageSize() int32 {
+func (x *ListCatalogSourcesRequest) GetPageSize() int32 {
 	if x != nil {
 		return x.PageSize
 	}
 	return 0
 }
 
-func (x *ListPublicCertificatesRequest) GetPageToken() string {
+func (x *ListCatalogSourcesRequest) GetPageToken() string {
 	if x != nil {
 		return x.PageToken
 	}
 	return ""
 }
 
-func (x *ListPublicCertificatesRequest) GetOrderBy() string {
+func (x *ListCatalogSourcesRequest) GetOrderBy() string {
 	if x != nil {
 		return x.OrderBy
 	}
 	return ""
 }
 
-func (x *ListPublicCertificatesRequest) GetAsc() bool {
+func (x *ListCatalogSourcesRequest) GetAsc() bool {
 	if x != nil {
 		return x.Asc
 	}
 	return false
 }
 
-type ListPublicCertificatesResponse struct {
+type ListCatalogSourcesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Certificates  []*Certificate         `protobuf:"bytes,1,rep,name=certificates,proto3" json:"certificates,omitempty"`
+	Sources       []*CatalogSource       `protobuf:"bytes,1,rep,name=sources,proto3" json:"sources,omitempty"`
 	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListPublicCertificatesResponse) Reset() {
-	*x = ListPublicCertificatesResponse{}
-	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[53]
+func (x *ListCatalogSourcesResponse) Reset() {
+	*x = ListCatalogSourcesResponse{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[192]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCatalogSourcesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCatalogSourcesResponse) ProtoMessage() {}
+
+func (x *ListCatalogSourcesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[192]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCatalogSourcesResponse.ProtoReflect.Descriptor instead.
+func (*ListCatalogSourcesResponse) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{192}
+}
+
+func (x *ListCatalogSourcesResponse) GetSources() []*CatalogSource {
+	if x != nil {
+		return x.Sources
+	}
+	return nil
+}
+
+func (x *ListCatalogSourcesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type RemoveCatalogSourceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SourceId      string                 `protobuf:"bytes,1,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveCatalogSourceRequest) Reset() {
+	*x = RemoveCatalogSourceRequest{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[193]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListPublicCertificatesResponse) String() string {
+func (x *RemoveCatalogSourceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListPublicCertificatesResponse) ProtoMessage() {}
+func (*RemoveCatalogSourceRequest) ProtoMessage() {}
 
-func (x *ListPublicCertificatesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[53]
+func (x *RemoveCatalogSourceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[193]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3607,47 +13672,43 @@ func (x *ListPublicCertificatesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListPublicCertificatesResponse.ProtoReflect.Descriptor instead.
-func (*ListPublicCertificatesResponse) Descriptor() ([]byte, []int) {
-	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{53}
-}
-
-func (x *ListPublicCertificatesResponse) GetCertificates() []*Certificate {
-	if x != nil {
-		return x.Certificates
-	}
-	return nil
+// Deprecated: Use RemoveCatalogSourceRequest.ProtoReflect.Descriptor instead.
+func (*RemoveCatalogSourceRequest) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{193}
 }
 
-func (x *ListPublicCertificatesResponse) GetNextPageToken() string {
+func (x *RemoveCatalogSourceRequest) GetSourceId() string {
 	if x != nil {
-		return x.NextPageToken
+		return x.SourceId
 	}
 	return ""
 }
 
-type UpdateCertificateRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Certificate   *Certificate           `protobuf:"bytes,1,opt,name=certificate,proto3" json:"certificate,omitempty"`
+type SyncCatalogSourceRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	SourceId string                 `protobuf:"bytes,1,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
+	// If set, the catalogs are re-imported even if the content of the source did
+	// not change since the last sync.
+	Force         bool `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateCertificateRequest) Reset() {
-	*x = UpdateCertificateRequest{}
-	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[54]
+func (x *SyncCatalogSourceRequest) Reset() {
+	*x = SyncCatalogSourceRequest{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[194]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateCertificateRequest) String() string {
+func (x *SyncCatalogSourceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateCertificateRequest) ProtoMessage() {}
+func (*SyncCatalogSourceRequest) ProtoMessage() {}
 
-func (x *UpdateCertificateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[54]
+func (x *SyncCatalogSourceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[194]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3658,40 +13719,48 @@ func (x *UpdateCertificateRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateCertificateRequest.ProtoReflect.Descriptor instead.
-func (*UpdateCertificateRequest) Descriptor() ([]byte, []int) {
-	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{54}
+// Deprecated: Use SyncCatalogSourceRequest.ProtoReflect.Descriptor instead.
+func (*SyncCatalogSourceRequest) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{194}
 }
 
-func (x *UpdateCertificateRequest) GetCertificate() *Certificate {
+func (x *SyncCatalogSourceRequest) GetSourceId() string {
 	if x != nil {
-		return x.Certificate
+		return x.SourceId
 	}
-	return nil
+	return ""
 }
 
-type CreateCatalogRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Catalog       *Catalog               `protobuf:"bytes,1,opt,name=catalog,proto3" json:"catalog,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *SyncCatalogSourceRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
 }
 
-func (x *CreateCatalogRequest) Reset() {
-	*x = CreateCatalogRequest{}
-	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[55]
+type RemoveApplicabilityRuleRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	CatalogId        string                 `protobuf:"bytes,1,opt,name=catalog_id,json=catalogId,proto3" json:"catalog_id,omitempty"`
+	ControlShortName string                 `protobuf:"bytes,2,opt,name=control_short_name,json=controlShortName,proto3" json:"control_short_name,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *RemoveApplicabilityRuleRequest) Reset() {
+	*x = RemoveApplicabilityRuleRequest{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[195]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateCatalogRequest) String() string {
+func (x *RemoveApplicabilityRuleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateCatalogRequest) ProtoMessage() {}
+func (*RemoveApplicabilityRuleRequest) ProtoMessage() {}
 
-func (x *CreateCatalogRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[55]
+func (x *RemoveApplicabilityRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[195]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3702,40 +13771,48 @@ func (x *CreateCatalogRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateCatalogRequest.ProtoReflect.Descriptor instead.
-func (*CreateCatalogRequest) Descriptor() ([]byte, []int) {
-	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{55}
+// Deprecated: Use RemoveApplicabilityRuleRequest.ProtoReflect.Descriptor instead.
+func (*RemoveApplicabilityRuleRequest) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{195}
 }
 
-func (x *CreateCatalogRequest) GetCatalog() *Catalog {
+func (x *RemoveApplicabilityRuleRequest) GetCatalogId() string {
 	if x != nil {
-		return x.Catalog
+		return x.CatalogId
 	}
-	return nil
+	return ""
 }
 
-type RemoveCatalogRequest struct {
+func (x *RemoveApplicabilityRuleRequest) GetControlShortName() string {
+	if x != nil {
+		return x.ControlShortName
+	}
+	return ""
+}
+
+type GetCategoryRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	CatalogId     string                 `protobuf:"bytes,1,opt,name=catalog_id,json=catalogId,proto3" json:"catalog_id,omitempty"`
+	CategoryName  string                 `protobuf:"bytes,2,opt,name=category_name,json=categoryName,proto3" json:"category_name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RemoveCatalogRequest) Reset() {
-	*x = RemoveCatalogRequest{}
-	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[56]
+func (x *GetCategoryRequest) Reset() {
+	*x = GetCategoryRequest{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[196]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RemoveCatalogRequest) String() string {
+func (x *GetCategoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveCatalogRequest) ProtoMessage() {}
+func (*GetCategoryRequest) ProtoMessage() {}
 
-func (x *RemoveCatalogRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[56]
+func (x *GetCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[196]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3746,40 +13823,47 @@ func (x *RemoveCatalogRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveCatalogRequest.ProtoReflect.Descriptor instead.
-func (*RemoveCatalogRequest) Descriptor() ([]byte, []int) {
-	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{56}
+// Deprecated: Use GetCategoryRequest.ProtoReflect.Descriptor instead.
+func (*GetCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{196}
 }
 
-func (x *RemoveCatalogRequest) GetCatalogId() string {
+func (x *GetCategoryRequest) GetCatalogId() string {
 	if x != nil {
 		return x.CatalogId
 	}
 	return ""
 }
 
-type GetCatalogRequest struct {
+func (x *GetCategoryRequest) GetCategoryName() string {
+	if x != nil {
+		return x.CategoryName
+	}
+	return ""
+}
+
+type GetControlRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	CatalogId     string                 `protobuf:"bytes,1,opt,name=catalog_id,json=catalogId,proto3" json:"catalog_id,omitempty"`
+	ControlId     string                 `protobuf:"bytes,3,opt,name=control_id,json=controlId,proto3" json:"control_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCatalogRequest) Reset() {
-	*x = GetCatalogRequest{}
-	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[57]
+func (x *GetControlRequest) Reset() {
+	*x = GetControlRequest{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[197]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCatalogRequest) String() string {
+func (x *GetControlRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCatalogRequest) ProtoMessage() {}
+func (*GetControlRequest) ProtoMessage() {}
 
-func (x *GetCatalogRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[57]
+func (x *GetControlRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[197]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3790,20 +13874,21 @@ func (x *GetCatalogRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCatalogRequest.ProtoReflect.Descriptor instead.
-func (*GetCatalogRequest) Descriptor() ([]byte, []int) {
-	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{57}
+// Deprecated: Use GetControlRequest.ProtoReflect.Descriptor instead.
+func (*GetControlRequest) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{197}
 }
 
-func (x *GetCatalogRequest) GetCatalogId() string {
+func (x *GetControlRequest) GetControlId() string {
 	if x != nil {
-		return x.CatalogId
+		return x.ControlId
 	}
 	return ""
 }
 
-type ListCatalogsRequest struct {
+type ListControlTextVersionsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	ControlId     string                 `protobuf:"bytes,1,opt,name=control_id,json=controlId,proto3" json:"control_id,omitempty"`
 	PageSize      int32                  `protobuf:"varint,10,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
 	PageToken     string                 `protobuf:"bytes,11,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
 	OrderBy       string                 `protobuf:"bytes,12,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
@@ -3812,21 +13897,21 @@ type ListCatalogsRequest struct {
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListCatalogsRequest) Reset() {
-	*x = ListCatalogsRequest{}
-	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[58]
+func (x *ListControlTextVersionsRequest) Reset() {
+	*x = ListControlTextVersionsRequest{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[198]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListCatalogsRequest) String() string {
+func (x *ListControlTextVersionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListCatalogsRequest) ProtoMessage() {}
+func (*ListControlTextVersionsRequest) ProtoMessage() {}
 
-func (x *ListCatalogsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[58]
+func (x *ListControlTextVersionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[198]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3837,62 +13922,69 @@ func (x *ListCatalogsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListCatalogsRequest.ProtoReflect.Descriptor instead.
-func (*ListCatalogsRequest) Descriptor() ([]byte, []int) {
-	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{58}
+// Deprecated: Use ListControlTextVersionsRequest.ProtoReflect.Descriptor instead.
+func (*ListControlTextVersionsRequest) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{198}
 }
 
-func (x *ListCatalogsRequest) GetPageSize() int32 {
+func (x *ListControlTextVersionsRequest) GetControlId() string {
+	if x != nil {
+		return x.ControlId
+	}
+	return ""
+}
+
+func (x *ListControlTextVersionsRequest) GetPageSize() int32 {
 	if x != nil {
 		return x.PageSize
 	}
 	return 0
 }
 
-func (x *ListCatalogsRequest) GetPageToken() string {
+func (x *ListControlTextVersionsRequest) GetPageToken() string {
 	if x != nil {
 		return x.PageToken
 	}
 	return ""
 }
 
-func (x *ListCatalogsRequest) GetOrderBy() string {
+func (x *ListControlTextVersionsRequest) GetOrderBy() string {
 	if x != nil {
 		return x.OrderBy
 	}
 	return ""
 }
 
-func (x *ListCatalogsRequest) GetAsc() bool {
+func (x *ListControlTextVersionsRequest) GetAsc() bool {
 	if x != nil {
 		return x.Asc
 	}
 	return false
 }
 
-type ListCatalogsResponse struct {
+type ListControlTextVersionsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Catalogs      []*Catalog             `protobuf:"bytes,1,rep,name=catalogs,proto3" json:"catalogs,omitempty"`
+	Versions      []*ControlTextVersion  `protobuf:"bytes,1,rep,name=versions,proto3" json:"versions,omitempty"`
 	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListCatalogsResponse) Reset() {
-	*x = ListCatalogsResponse{}
-	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[59]
+func (x *ListControlTextVersionsResponse) Reset() {
+	*x = ListControlTextVersionsResponse{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[199]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListCatalogsResponse) String() string {
+func (x *ListControlTextVersionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListCatalogsResponse) ProtoMessage() {}
+func (*ListControlTextVersionsResponse) ProtoMessage() {}
 
-func (x *ListCatalogsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[59]
+func (x *ListControlTextVersionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[199]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3903,92 +13995,50 @@ func (x *ListCatalogsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListCatalogsResponse.ProtoReflect.Descriptor instead.
-func (*ListCatalogsResponse) Descriptor() ([]byte, []int) {
-	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{59}
+// Deprecated: Use ListControlTextVersionsResponse.ProtoReflect.Descriptor instead.
+func (*ListControlTextVersionsResponse) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{199}
 }
 
-func (x *ListCatalogsResponse) GetCatalogs() []*Catalog {
+func (x *ListControlTextVersionsResponse) GetVersions() []*ControlTextVersion {
 	if x != nil {
-		return x.Catalogs
+		return x.Versions
 	}
 	return nil
 }
 
-func (x *ListCatalogsResponse) GetNextPageToken() string {
+func (x *ListControlTextVersionsResponse) GetNextPageToken() string {
 	if x != nil {
 		return x.NextPageToken
 	}
 	return ""
 }
 
-type UpdateCatalogRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Catalog       *Catalog               `protobuf:"bytes,1,opt,name=catalog,proto3" json:"catalog,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *UpdateCatalogRequest) Reset() {
-	*x = UpdateCatalogRequest{}
-	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[60]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *UpdateCatalogRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*UpdateCatalogRequest) ProtoMessage() {}
-
-func (x *UpdateCatalogRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[60]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use UpdateCatalogRequest.ProtoReflect.Descriptor instead.
-func (*UpdateCatalogRequest) Descriptor() ([]byte, []int) {
-	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{60}
-}
-
-func (x *UpdateCatalogRequest) GetCatalog() *Catalog {
-	if x != nil {
-		return x.Catalog
-	}
-	return nil
-}
-
-type GetCategoryRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	CatalogId     string                 `protobuf:"bytes,1,opt,name=catalog_id,json=catalogId,proto3" json:"catalog_id,omitempty"`
-	CategoryName  string                 `protobuf:"bytes,2,opt,name=category_name,json=categoryName,proto3" json:"category_name,omitempty"`
+type RenderControlTextRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ControlId string                 `protobuf:"bytes,1,opt,name=control_id,json=controlId,proto3" json:"control_id,omitempty"`
+	// Optional. The text version to render. Defaults to the current text of the
+	// control.
+	Version       *int32 `protobuf:"varint,2,opt,name=version,proto3,oneof" json:"version,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCategoryRequest) Reset() {
-	*x = GetCategoryRequest{}
-	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[61]
+func (x *RenderControlTextRequest) Reset() {
+	*x = RenderControlTextRequest{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[200]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCategoryRequest) String() string {
+func (x *RenderControlTextRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCategoryRequest) ProtoMessage() {}
+func (*RenderControlTextRequest) ProtoMessage() {}
 
-func (x *GetCategoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[61]
+func (x *RenderControlTextRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[200]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3999,47 +14049,52 @@ func (x *GetCategoryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCategoryRequest.ProtoReflect.Descriptor instead.
-func (*GetCategoryRequest) Descriptor() ([]byte, []int) {
-	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{61}
+// Deprecated: Use RenderControlTextRequest.ProtoReflect.Descriptor instead.
+func (*RenderControlTextRequest) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{200}
 }
 
-func (x *GetCategoryRequest) GetCatalogId() string {
+func (x *RenderControlTextRequest) GetControlId() string {
 	if x != nil {
-		return x.CatalogId
+		return x.ControlId
 	}
 	return ""
 }
 
-func (x *GetCategoryRequest) GetCategoryName() string {
-	if x != nil {
-		return x.CategoryName
+func (x *RenderControlTextRequest) GetVersion() int32 {
+	if x != nil && x.Version != nil {
+		return *x.Version
 	}
-	return ""
+	return 0
 }
 
-type GetControlRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ControlId     string                 `protobuf:"bytes,3,opt,name=control_id,json=controlId,proto3" json:"control_id,omitempty"`
+type RenderControlTextResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The rendered text version
+	Version int32 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	// The text of the control rendered to HTML, i.e., its name as heading, its
+	// description and a list of its references. Raw HTML in the description is
+	// escaped and only http, https and mailto links are rendered.
+	Html          string `protobuf:"bytes,2,opt,name=html,proto3" json:"html,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetControlRequest) Reset() {
-	*x = GetControlRequest{}
-	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[62]
+func (x *RenderControlTextResponse) Reset() {
+	*x = RenderControlTextResponse{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[201]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetControlRequest) String() string {
+func (x *RenderControlTextResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetControlRequest) ProtoMessage() {}
+func (*RenderControlTextResponse) ProtoMessage() {}
 
-func (x *GetControlRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[62]
+func (x *RenderControlTextResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[201]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4050,14 +14105,21 @@ func (x *GetControlRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetControlRequest.ProtoReflect.Descriptor instead.
-func (*GetControlRequest) Descriptor() ([]byte, []int) {
-	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{62}
+// Deprecated: Use RenderControlTextResponse.ProtoReflect.Descriptor instead.
+func (*RenderControlTextResponse) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{201}
 }
 
-func (x *GetControlRequest) GetControlId() string {
+func (x *RenderControlTextResponse) GetVersion() int32 {
 	if x != nil {
-		return x.ControlId
+		return x.Version
+	}
+	return 0
+}
+
+func (x *RenderControlTextResponse) GetHtml() string {
+	if x != nil {
+		return x.Html
 	}
 	return ""
 }
@@ -4075,7 +14137,7 @@ type ListControlsRequest struct {
 
 func (x *ListControlsRequest) Reset() {
 	*x = ListControlsRequest{}
-	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[63]
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[202]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4087,7 +14149,7 @@ func (x *ListControlsRequest) String() string {
 func (*ListControlsRequest) ProtoMessage() {}
 
 func (x *ListControlsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[63]
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[202]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4100,7 +14162,7 @@ func (x *ListControlsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListControlsRequest.ProtoReflect.Descriptor instead.
 func (*ListControlsRequest) Descriptor() ([]byte, []int) {
-	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{63}
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{202}
 }
 
 func (x *ListControlsRequest) GetFilter() *ListControlsRequest_Filter {
@@ -4148,7 +14210,7 @@ type ListControlsResponse struct {
 
 func (x *ListControlsResponse) Reset() {
 	*x = ListControlsResponse{}
-	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[64]
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[203]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4160,7 +14222,7 @@ func (x *ListControlsResponse) String() string {
 func (*ListControlsResponse) ProtoMessage() {}
 
 func (x *ListControlsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[64]
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[203]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4173,7 +14235,7 @@ func (x *ListControlsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListControlsResponse.ProtoReflect.Descriptor instead.
 func (*ListControlsResponse) Descriptor() ([]byte, []int) {
-	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{64}
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{203}
 }
 
 func (x *ListControlsResponse) GetControls() []*Control {
@@ -4199,7 +14261,7 @@ type CreateCertificateRequest struct {
 
 func (x *CreateCertificateRequest) Reset() {
 	*x = CreateCertificateRequest{}
-	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[65]
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[204]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4211,7 +14273,7 @@ func (x *CreateCertificateRequest) String() string {
 func (*CreateCertificateRequest) ProtoMessage() {}
 
 func (x *CreateCertificateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[65]
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[204]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4224,7 +14286,7 @@ func (x *CreateCertificateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateCertificateRequest.ProtoReflect.Descriptor instead.
 func (*CreateCertificateRequest) Descriptor() ([]byte, []int) {
-	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{65}
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{204}
 }
 
 func (x *CreateCertificateRequest) GetCertificate() *Certificate {
@@ -4243,7 +14305,7 @@ type RemoveCertificateRequest struct {
 
 func (x *RemoveCertificateRequest) Reset() {
 	*x = RemoveCertificateRequest{}
-	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[66]
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[205]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4255,7 +14317,7 @@ func (x *RemoveCertificateRequest) String() string {
 func (*RemoveCertificateRequest) ProtoMessage() {}
 
 func (x *RemoveCertificateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[66]
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[205]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4268,7 +14330,7 @@ func (x *RemoveCertificateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemoveCertificateRequest.ProtoReflect.Descriptor instead.
 func (*RemoveCertificateRequest) Descriptor() ([]byte, []int) {
-	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{66}
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{205}
 }
 
 func (x *RemoveCertificateRequest) GetCertificateId() string {
@@ -4298,7 +14360,7 @@ type Certificate struct {
 
 func (x *Certificate) Reset() {
 	*x = Certificate{}
-	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[67]
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[206]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4310,7 +14372,7 @@ func (x *Certificate) String() string {
 func (*Certificate) ProtoMessage() {}
 
 func (x *Certificate) ProtoReflect() protoreflect.Message {
-	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[67]
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[206]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4323,7 +14385,7 @@ func (x *Certificate) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Certificate.ProtoReflect.Descriptor instead.
 func (*Certificate) Descriptor() ([]byte, []int) {
-	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{67}
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{206}
 }
 
 func (x *Certificate) GetId() string {
@@ -4412,7 +14474,7 @@ type State struct {
 
 func (x *State) Reset() {
 	*x = State{}
-	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[68]
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[207]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4424,7 +14486,7 @@ func (x *State) String() string {
 func (*State) ProtoMessage() {}
 
 func (x *State) ProtoReflect() protoreflect.Message {
-	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[68]
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[207]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4437,7 +14499,7 @@ func (x *State) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use State.ProtoReflect.Descriptor instead.
 func (*State) Descriptor() ([]byte, []int) {
-	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{68}
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{207}
 }
 
 func (x *State) GetId() string {
@@ -4484,7 +14546,7 @@ type UpsertUserPermissionRequest struct {
 
 func (x *UpsertUserPermissionRequest) Reset() {
 	*x = UpsertUserPermissionRequest{}
-	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[69]
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[208]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4496,7 +14558,7 @@ func (x *UpsertUserPermissionRequest) String() string {
 func (*UpsertUserPermissionRequest) ProtoMessage() {}
 
 func (x *UpsertUserPermissionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[69]
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[208]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4509,7 +14571,7 @@ func (x *UpsertUserPermissionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpsertUserPermissionRequest.ProtoReflect.Descriptor instead.
 func (*UpsertUserPermissionRequest) Descriptor() ([]byte, []int) {
-	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{69}
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{208}
 }
 
 func (x *UpsertUserPermissionRequest) GetUserPermission() *UserPermission {
@@ -4528,7 +14590,7 @@ type UpsertUserPermissionResponse struct {
 
 func (x *UpsertUserPermissionResponse) Reset() {
 	*x = UpsertUserPermissionResponse{}
-	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[70]
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[209]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4540,7 +14602,7 @@ func (x *UpsertUserPermissionResponse) String() string {
 func (*UpsertUserPermissionResponse) ProtoMessage() {}
 
 func (x *UpsertUserPermissionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[70]
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[209]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4553,7 +14615,7 @@ func (x *UpsertUserPermissionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpsertUserPermissionResponse.ProtoReflect.Descriptor instead.
 func (*UpsertUserPermissionResponse) Descriptor() ([]byte, []int) {
-	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{70}
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{209}
 }
 
 func (x *UpsertUserPermissionResponse) GetUserPermission() *UserPermission {
@@ -4574,7 +14636,7 @@ type RemoveUserPermissionRequest struct {
 
 func (x *RemoveUserPermissionRequest) Reset() {
 	*x = RemoveUserPermissionRequest{}
-	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[71]
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[210]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4586,7 +14648,7 @@ func (x *RemoveUserPermissionRequest) String() string {
 func (*RemoveUserPermissionRequest) ProtoMessage() {}
 
 func (x *RemoveUserPermissionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[71]
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[210]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4599,7 +14661,7 @@ func (x *RemoveUserPermissionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemoveUserPermissionRequest.ProtoReflect.Descriptor instead.
 func (*RemoveUserPermissionRequest) Descriptor() ([]byte, []int) {
-	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{71}
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{210}
 }
 
 func (x *RemoveUserPermissionRequest) GetUserId() string {
@@ -4631,7 +14693,7 @@ type GetCurrentUserRequest struct {
 
 func (x *GetCurrentUserRequest) Reset() {
 	*x = GetCurrentUserRequest{}
-	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[72]
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[211]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4643,7 +14705,7 @@ func (x *GetCurrentUserRequest) String() string {
 func (*GetCurrentUserRequest) ProtoMessage() {}
 
 func (x *GetCurrentUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[72]
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[211]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4656,7 +14718,7 @@ func (x *GetCurrentUserRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCurrentUserRequest.ProtoReflect.Descriptor instead.
 func (*GetCurrentUserRequest) Descriptor() ([]byte, []int) {
-	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{72}
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{211}
 }
 
 type GetUserRequest struct {
@@ -4668,7 +14730,7 @@ type GetUserRequest struct {
 
 func (x *GetUserRequest) Reset() {
 	*x = GetUserRequest{}
-	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[73]
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[212]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4680,7 +14742,7 @@ func (x *GetUserRequest) String() string {
 func (*GetUserRequest) ProtoMessage() {}
 
 func (x *GetUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[73]
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[212]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4693,7 +14755,7 @@ func (x *GetUserRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserRequest.ProtoReflect.Descriptor instead.
 func (*GetUserRequest) Descriptor() ([]byte, []int) {
-	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{73}
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{212}
 }
 
 func (x *GetUserRequest) GetUserId() string {
@@ -4716,7 +14778,7 @@ type ListUsersRequest struct {
 
 func (x *ListUsersRequest) Reset() {
 	*x = ListUsersRequest{}
-	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[74]
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[213]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4728,7 +14790,7 @@ func (x *ListUsersRequest) String() string {
 func (*ListUsersRequest) ProtoMessage() {}
 
 func (x *ListUsersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[74]
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[213]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4741,7 +14803,7 @@ func (x *ListUsersRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListUsersRequest.ProtoReflect.Descriptor instead.
 func (*ListUsersRequest) Descriptor() ([]byte, []int) {
-	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{74}
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{213}
 }
 
 func (x *ListUsersRequest) GetFilter() *ListUsersRequest_Filter {
@@ -4789,7 +14851,7 @@ type ListUsersResponse struct {
 
 func (x *ListUsersResponse) Reset() {
 	*x = ListUsersResponse{}
-	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[75]
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[214]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4801,7 +14863,7 @@ func (x *ListUsersResponse) String() string {
 func (*ListUsersResponse) ProtoMessage() {}
 
 func (x *ListUsersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[75]
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[214]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4814,7 +14876,7 @@ func (x *ListUsersResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListUsersResponse.ProtoReflect.Descriptor instead.
 func (*ListUsersResponse) Descriptor() ([]byte, []int) {
-	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{75}
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{214}
 }
 
 func (x *ListUsersResponse) GetUsers() []*User {
@@ -4831,6 +14893,161 @@ func (x *ListUsersResponse) GetNextPageToken() string {
 	return ""
 }
 
+type ResolveUserRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Issuer is the issuer of the identity provider. If not set, only the external ID of the user in
+	// the user directory is matched against the subject.
+	Issuer *string `protobuf:"bytes,1,opt,name=issuer,proto3,oneof" json:"issuer,omitempty"`
+	// Subject is the identifier of the user at the identity provider or in the user directory.
+	Subject       string `protobuf:"bytes,2,opt,name=subject,proto3" json:"subject,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveUserRequest) Reset() {
+	*x = ResolveUserRequest{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[215]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveUserRequest) ProtoMessage() {}
+
+func (x *ResolveUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[215]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveUserRequest.ProtoReflect.Descriptor instead.
+func (*ResolveUserRequest) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{215}
+}
+
+func (x *ResolveUserRequest) GetIssuer() string {
+	if x != nil && x.Issuer != nil {
+		return *x.Issuer
+	}
+	return ""
+}
+
+func (x *ResolveUserRequest) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+type SyncUsersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SyncUsersRequest) Reset() {
+	*x = SyncUsersRequest{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[216]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncUsersRequest) ProtoMessage() {}
+
+func (x *SyncUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[216]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncUsersRequest.ProtoReflect.Descriptor instead.
+func (*SyncUsersRequest) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{216}
+}
+
+type SyncUsersResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Created is the number of users that were newly created.
+	Created int32 `protobuf:"varint,1,opt,name=created,proto3" json:"created,omitempty"`
+	// Updated is the number of existing users that were updated.
+	Updated int32 `protobuf:"varint,2,opt,name=updated,proto3" json:"updated,omitempty"`
+	// Disabled is the number of users that were disabled because they no longer exist in the user
+	// directory.
+	Disabled      int32 `protobuf:"varint,3,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SyncUsersResponse) Reset() {
+	*x = SyncUsersResponse{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[217]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncUsersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncUsersResponse) ProtoMessage() {}
+
+func (x *SyncUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[217]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncUsersResponse.ProtoReflect.Descriptor instead.
+func (*SyncUsersResponse) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{217}
+}
+
+func (x *SyncUsersResponse) GetCreated() int32 {
+	if x != nil {
+		return x.Created
+	}
+	return 0
+}
+
+func (x *SyncUsersResponse) GetUpdated() int32 {
+	if x != nil {
+		return x.Updated
+	}
+	return 0
+}
+
+func (x *SyncUsersResponse) GetDisabled() int32 {
+	if x != nil {
+		return x.Disabled
+	}
+	return 0
+}
+
 type ListUserPermissionsRequest struct {
 	state         protoimpl.MessageState             `protogen:"open.v1"`
 	Filter        *ListUserPermissionsRequest_Filter `protobuf:"bytes,1,opt,name=filter,proto3,oneof" json:"filter,omitempty"`
@@ -4844,7 +15061,7 @@ type ListUserPermissionsRequest struct {
 
 func (x *ListUserPermissionsRequest) Reset() {
 	*x = ListUserPermissionsRequest{}
-	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[76]
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[218]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4856,7 +15073,7 @@ func (x *ListUserPermissionsRequest) String() string {
 func (*ListUserPermissionsRequest) ProtoMessage() {}
 
 func (x *ListUserPermissionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[76]
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[218]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4869,7 +15086,7 @@ func (x *ListUserPermissionsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListUserPermissionsRequest.ProtoReflect.Descriptor instead.
 func (*ListUserPermissionsRequest) Descriptor() ([]byte, []int) {
-	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{76}
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{218}
 }
 
 func (x *ListUserPermissionsRequest) GetFilter() *ListUserPermissionsRequest_Filter {
@@ -4917,7 +15134,7 @@ type ListUserPermissionsResponse struct {
 
 func (x *ListUserPermissionsResponse) Reset() {
 	*x = ListUserPermissionsResponse{}
-	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[77]
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[219]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4929,7 +15146,7 @@ func (x *ListUserPermissionsResponse) String() string {
 func (*ListUserPermissionsResponse) ProtoMessage() {}
 
 func (x *ListUserPermissionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[77]
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[219]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4942,7 +15159,7 @@ func (x *ListUserPermissionsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListUserPermissionsResponse.ProtoReflect.Descriptor instead.
 func (*ListUserPermissionsResponse) Descriptor() ([]byte, []int) {
-	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{77}
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{219}
 }
 
 func (x *ListUserPermissionsResponse) GetUserPermissions() []*UserPermission {
@@ -4970,7 +15187,7 @@ type ListUserRolesRequest struct {
 
 func (x *ListUserRolesRequest) Reset() {
 	*x = ListUserRolesRequest{}
-	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[78]
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[220]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4982,7 +15199,7 @@ func (x *ListUserRolesRequest) String() string {
 func (*ListUserRolesRequest) ProtoMessage() {}
 
 func (x *ListUserRolesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[78]
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[220]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4995,7 +15212,7 @@ func (x *ListUserRolesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListUserRolesRequest.ProtoReflect.Descriptor instead.
 func (*ListUserRolesRequest) Descriptor() ([]byte, []int) {
-	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{78}
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{220}
 }
 
 func (x *ListUserRolesRequest) GetPageSize() int32 {
@@ -5028,7 +15245,7 @@ type ListUserRolesResponse struct {
 
 func (x *ListUserRolesResponse) Reset() {
 	*x = ListUserRolesResponse{}
-	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[79]
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[221]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5040,7 +15257,7 @@ func (x *ListUserRolesResponse) String() string {
 func (*ListUserRolesResponse) ProtoMessage() {}
 
 func (x *ListUserRolesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[79]
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[221]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5053,7 +15270,7 @@ func (x *ListUserRolesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListUserRolesResponse.ProtoReflect.Descriptor instead.
 func (*ListUserRolesResponse) Descriptor() ([]byte, []int) {
-	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{79}
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{221}
 }
 
 func (x *ListUserRolesResponse) GetRoles() []Role {
@@ -5072,7 +15289,7 @@ type RemoveUserRequest struct {
 
 func (x *RemoveUserRequest) Reset() {
 	*x = RemoveUserRequest{}
-	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[80]
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[222]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5084,7 +15301,7 @@ func (x *RemoveUserRequest) String() string {
 func (*RemoveUserRequest) ProtoMessage() {}
 
 func (x *RemoveUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[80]
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[222]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5097,7 +15314,7 @@ func (x *RemoveUserRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemoveUserRequest.ProtoReflect.Descriptor instead.
 func (*RemoveUserRequest) Descriptor() ([]byte, []int) {
-	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{80}
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{222}
 }
 
 func (x *RemoveUserRequest) GetUserId() string {
@@ -5115,7 +15332,7 @@ type ListAssessmentToolsRequest_Filter struct {
 
 func (x *ListAssessmentToolsRequest_Filter) Reset() {
 	*x = ListAssessmentToolsRequest_Filter{}
-	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[81]
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[223]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5127,7 +15344,7 @@ func (x *ListAssessmentToolsRequest_Filter) String() string {
 func (*ListAssessmentToolsRequest_Filter) ProtoMessage() {}
 
 func (x *ListAssessmentToolsRequest_Filter) ProtoReflect() protoreflect.Message {
-	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[81]
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[223]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5160,14 +15377,30 @@ type ListEvaluationResultsRequest_Filter struct {
 	// Optional. Lists only manual results in their validity period
 	ValidManualOnly *bool `protobuf:"varint,6,opt,name=valid_manual_only,json=validManualOnly,proto3,oneof" json:"valid_manual_only,omitempty"`
 	// Optional. Lists only evaluation results for a specific audit scope.
-	AuditScopeId  *string `protobuf:"bytes,7,opt,name=audit_scope_id,json=auditScopeId,proto3,oneof" json:"audit_scope_id,omitempty"`
+	AuditScopeId *string `protobuf:"bytes,7,opt,name=audit_scope_id,json=auditScopeId,proto3,oneof" json:"audit_scope_id,omitempty"`
+	// Optional. Lists only evaluation results with the given status.
+	Status *evaluation.EvaluationStatus `protobuf:"varint,8,opt,name=status,proto3,enum=confirmate.evaluation.v1.EvaluationStatus,oneof" json:"status,omitempty"`
+	// Optional. Lists only evaluation results with the given catalog-defined
+	// sub-status, e.g., PARTIALLY_COMPLIANT.
+	SubStatus *string `protobuf:"bytes,9,opt,name=sub_status,json=subStatus,proto3,oneof" json:"sub_status,omitempty"`
+	// Optional. Lists only evaluation results that were evaluated against the
+	// given version of their catalog.
+	CatalogVersion *int32 `protobuf:"varint,10,opt,name=catalog_version,json=catalogVersion,proto3,oneof" json:"catalog_version,omitempty"`
+	// Optional. Lists only evaluation results that were evaluated against a
+	// catalog with the given content hash.
+	CatalogHash *string `protobuf:"bytes,11,opt,name=catalog_hash,json=catalogHash,proto3,oneof" json:"catalog_hash,omitempty"`
+	// Optional. Lists only evaluation results of audit scopes restricted to
+	// the given environment, e.g., prod.
+	Environment *string `protobuf:"bytes,12,opt,name=environment,proto3,oneof" json:"environment,omitempty"`
+	// Optional. Lists only evaluation results of the given evaluation run.
+	RunId         *string `protobuf:"bytes,13,opt,name=run_id,json=runId,proto3,oneof" json:"run_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListEvaluationResultsRequest_Filter) Reset() {
 	*x = ListEvaluationResultsRequest_Filter{}
-	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[82]
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[224]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5179,7 +15412,7 @@ func (x *ListEvaluationResultsRequest_Filter) String() string {
 func (*ListEvaluationResultsRequest_Filter) ProtoMessage() {}
 
 func (x *ListEvaluationResultsRequest_Filter) ProtoReflect() protoreflect.Message {
-	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[82]
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[224]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5192,7 +15425,7 @@ func (x *ListEvaluationResultsRequest_Filter) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use ListEvaluationResultsRequest_Filter.ProtoReflect.Descriptor instead.
 func (*ListEvaluationResultsRequest_Filter) Descriptor() ([]byte, []int) {
-	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{10, 0}
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{16, 0}
 }
 
 func (x *ListEvaluationResultsRequest_Filter) GetTargetOfEvaluationId() string {
@@ -5244,16 +15477,118 @@ func (x *ListEvaluationResultsRequest_Filter) GetAuditScopeId() string {
 	return ""
 }
 
+func (x *ListEvaluationResultsRequest_Filter) GetStatus() evaluation.EvaluationStatus {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return evaluation.EvaluationStatus(0)
+}
+
+func (x *ListEvaluationResultsRequest_Filter) GetSubStatus() string {
+	if x != nil && x.SubStatus != nil {
+		return *x.SubStatus
+	}
+	return ""
+}
+
+func (x *ListEvaluationResultsRequest_Filter) GetCatalogVersion() int32 {
+	if x != nil && x.CatalogVersion != nil {
+		return *x.CatalogVersion
+	}
+	return 0
+}
+
+func (x *ListEvaluationResultsRequest_Filter) GetCatalogHash() string {
+	if x != nil && x.CatalogHash != nil {
+		return *x.CatalogHash
+	}
+	return ""
+}
+
+func (x *ListEvaluationResultsRequest_Filter) GetEnvironment() string {
+	if x != nil && x.Environment != nil {
+		return *x.Environment
+	}
+	return ""
+}
+
+func (x *ListEvaluationResultsRequest_Filter) GetRunId() string {
+	if x != nil && x.RunId != nil {
+		return *x.RunId
+	}
+	return ""
+}
+
+type ListAlertsRequest_Filter struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Optional. Lists only alerts of a specific target of evaluation.
+	TargetOfEvaluationId *string `protobuf:"bytes,1,opt,name=target_of_evaluation_id,json=targetOfEvaluationId,proto3,oneof" json:"target_of_evaluation_id,omitempty"`
+	// Optional. Lists only alerts of a specific type.
+	Type          *AlertType `protobuf:"varint,2,opt,name=type,proto3,enum=confirmate.orchestrator.v1.AlertType,oneof" json:"type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAlertsRequest_Filter) Reset() {
+	*x = ListAlertsRequest_Filter{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[225]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAlertsRequest_Filter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAlertsRequest_Filter) ProtoMessage() {}
+
+func (x *ListAlertsRequest_Filter) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[225]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAlertsRequest_Filter.ProtoReflect.Descriptor instead.
+func (*ListAlertsRequest_Filter) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{33, 0}
+}
+
+func (x *ListAlertsRequest_Filter) GetTargetOfEvaluationId() string {
+	if x != nil && x.TargetOfEvaluationId != nil {
+		return *x.TargetOfEvaluationId
+	}
+	return ""
+}
+
+func (x *ListAlertsRequest_Filter) GetType() AlertType {
+	if x != nil && x.Type != nil {
+		return *x.Type
+	}
+	return AlertType_ALERT_TYPE_UNSPECIFIED
+}
+
 type ListMetricsRequest_Filter struct {
 	state             protoimpl.MessageState `protogen:"open.v1"`
 	IncludeDeprecated *bool                  `protobuf:"varint,1,opt,name=include_deprecated,json=includeDeprecated,proto3,oneof" json:"include_deprecated,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+	// Only list metrics of the given category.
+	Category *string `protobuf:"bytes,2,opt,name=category,proto3,oneof" json:"category,omitempty"`
+	// Only list metrics that declare to apply to the given ontology resource type, e.g., "VirtualMachine".
+	ResourceType *string `protobuf:"bytes,3,opt,name=resource_type,json=resourceType,proto3,oneof" json:"resource_type,omitempty"`
+	// Only list metrics that declare to read the given evidence field, e.g., "bootLogging.enabled".
+	EvidenceField *string `protobuf:"bytes,4,opt,name=evidence_field,json=evidenceField,proto3,oneof" json:"evidence_field,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListMetricsRequest_Filter) Reset() {
 	*x = ListMetricsRequest_Filter{}
-	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[83]
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[226]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5265,7 +15600,74 @@ func (x *ListMetricsRequest_Filter) String() string {
 func (*ListMetricsRequest_Filter) ProtoMessage() {}
 
 func (x *ListMetricsRequest_Filter) ProtoReflect() protoreflect.Message {
-	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[83]
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[226]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMetricsRequest_Filter.ProtoReflect.Descriptor instead.
+func (*ListMetricsRequest_Filter) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{49, 0}
+}
+
+func (x *ListMetricsRequest_Filter) GetIncludeDeprecated() bool {
+	if x != nil && x.IncludeDeprecated != nil {
+		return *x.IncludeDeprecated
+	}
+	return false
+}
+
+func (x *ListMetricsRequest_Filter) GetCategory() string {
+	if x != nil && x.Category != nil {
+		return *x.Category
+	}
+	return ""
+}
+
+func (x *ListMetricsRequest_Filter) GetResourceType() string {
+	if x != nil && x.ResourceType != nil {
+		return *x.ResourceType
+	}
+	return ""
+}
+
+func (x *ListMetricsRequest_Filter) GetEvidenceField() string {
+	if x != nil && x.EvidenceField != nil {
+		return *x.EvidenceField
+	}
+	return ""
+}
+
+type ListTargetsOfEvaluationRequest_Filter struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Optional. Lists only targets of evaluation whose custom metadata fields
+	// have all of the given values.
+	CustomFields  map[string]string `protobuf:"bytes,1,rep,name=custom_fields,json=customFields,proto3" json:"custom_fields,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTargetsOfEvaluationRequest_Filter) Reset() {
+	*x = ListTargetsOfEvaluationRequest_Filter{}
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[227]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTargetsOfEvaluationRequest_Filter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTargetsOfEvaluationRequest_Filter) ProtoMessage() {}
+
+func (x *ListTargetsOfEvaluationRequest_Filter) ProtoReflect() protoreflect.Message {
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[227]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5276,16 +15678,16 @@ func (x *ListMetricsRequest_Filter) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListMetricsRequest_Filter.ProtoReflect.Descriptor instead.
-func (*ListMetricsRequest_Filter) Descriptor() ([]byte, []int) {
-	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{15, 0}
+// Deprecated: Use ListTargetsOfEvaluationRequest_Filter.ProtoReflect.Descriptor instead.
+func (*ListTargetsOfEvaluationRequest_Filter) Descriptor() ([]byte, []int) {
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{58, 0}
 }
 
-func (x *ListMetricsRequest_Filter) GetIncludeDeprecated() bool {
-	if x != nil && x.IncludeDeprecated != nil {
-		return *x.IncludeDeprecated
+func (x *ListTargetsOfEvaluationRequest_Filter) GetCustomFields() map[string]string {
+	if x != nil {
+		return x.CustomFields
 	}
-	return false
+	return nil
 }
 
 type SubscribeRequest_Filter struct {
@@ -5304,7 +15706,7 @@ type SubscribeRequest_Filter struct {
 
 func (x *SubscribeRequest_Filter) Reset() {
 	*x = SubscribeRequest_Filter{}
-	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[85]
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[230]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5316,7 +15718,7 @@ func (x *SubscribeRequest_Filter) String() string {
 func (*SubscribeRequest_Filter) ProtoMessage() {}
 
 func (x *SubscribeRequest_Filter) ProtoReflect() protoreflect.Message {
-	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[85]
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[230]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5329,7 +15731,7 @@ func (x *SubscribeRequest_Filter) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SubscribeRequest_Filter.ProtoReflect.Descriptor instead.
 func (*SubscribeRequest_Filter) Descriptor() ([]byte, []int) {
-	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{32, 0}
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{100, 0}
 }
 
 func (x *SubscribeRequest_Filter) GetCategories() []EventCategory {
@@ -5365,14 +15767,17 @@ type TargetOfEvaluation_Metadata struct {
 	// a map of key/value pairs, e.g., env:prod
 	Labels map[string]string `protobuf:"bytes,1,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	// an icon for the target of evaluation used by the UI
-	Icon          *string `protobuf:"bytes,2,opt,name=icon,proto3,oneof" json:"icon,omitempty"`
+	Icon *string `protobuf:"bytes,2,opt,name=icon,proto3,oneof" json:"icon,omitempty"`
+	// values of the custom metadata fields, e.g., criticality:high. The keys
+	// and values are validated against the defined [MetadataField]s.
+	CustomFields  map[string]string `protobuf:"bytes,3,rep,name=custom_fields,json=customFields,proto3" json:"custom_fields,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *TargetOfEvaluation_Metadata) Reset() {
 	*x = TargetOfEvaluation_Metadata{}
-	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[86]
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[231]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5384,7 +15789,7 @@ func (x *TargetOfEvaluation_Metadata) String() string {
 func (*TargetOfEvaluation_Metadata) ProtoMessage() {}
 
 func (x *TargetOfEvaluation_Metadata) ProtoReflect() protoreflect.Message {
-	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[86]
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[231]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5397,7 +15802,7 @@ func (x *TargetOfEvaluation_Metadata) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TargetOfEvaluation_Metadata.ProtoReflect.Descriptor instead.
 func (*TargetOfEvaluation_Metadata) Descriptor() ([]byte, []int) {
-	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{35, 0}
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{107, 0}
 }
 
 func (x *TargetOfEvaluation_Metadata) GetLabels() map[string]string {
@@ -5414,6 +15819,13 @@ func (x *TargetOfEvaluation_Metadata) GetIcon() string {
 	return ""
 }
 
+func (x *TargetOfEvaluation_Metadata) GetCustomFields() map[string]string {
+	if x != nil {
+		return x.CustomFields
+	}
+	return nil
+}
+
 // Organization contains details about the organization responsible for this target of evaluation.
 type TargetOfEvaluation_Organization struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -5433,7 +15845,7 @@ type TargetOfEvaluation_Organization struct {
 
 func (x *TargetOfEvaluation_Organization) Reset() {
 	*x = TargetOfEvaluation_Organization{}
-	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[87]
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[232]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5445,7 +15857,7 @@ func (x *TargetOfEvaluation_Organization) String() string {
 func (*TargetOfEvaluation_Organization) ProtoMessage() {}
 
 func (x *TargetOfEvaluation_Organization) ProtoReflect() protoreflect.Message {
-	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[87]
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[232]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5458,7 +15870,7 @@ func (x *TargetOfEvaluation_Organization) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TargetOfEvaluation_Organization.ProtoReflect.Descriptor instead.
 func (*TargetOfEvaluation_Organization) Descriptor() ([]byte, []int) {
-	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{35, 1}
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{107, 1}
 }
 
 func (x *TargetOfEvaluation_Organization) GetName() string {
@@ -5513,7 +15925,7 @@ type TargetOfEvaluation_Organization_PostalAddress struct {
 
 func (x *TargetOfEvaluation_Organization_PostalAddress) Reset() {
 	*x = TargetOfEvaluation_Organization_PostalAddress{}
-	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[89]
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[235]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5525,7 +15937,7 @@ func (x *TargetOfEvaluation_Organization_PostalAddress) String() string {
 func (*TargetOfEvaluation_Organization_PostalAddress) ProtoMessage() {}
 
 func (x *TargetOfEvaluation_Organization_PostalAddress) ProtoReflect() protoreflect.Message {
-	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[89]
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[235]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5538,7 +15950,7 @@ func (x *TargetOfEvaluation_Organization_PostalAddress) ProtoReflect() protorefl
 
 // Deprecated: Use TargetOfEvaluation_Organization_PostalAddress.ProtoReflect.Descriptor instead.
 func (*TargetOfEvaluation_Organization_PostalAddress) Descriptor() ([]byte, []int) {
-	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{35, 1, 0}
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{107, 1, 0}
 }
 
 func (x *TargetOfEvaluation_Organization_PostalAddress) GetStreet() string {
@@ -5579,7 +15991,7 @@ type Catalog_Metadata struct {
 
 func (x *Catalog_Metadata) Reset() {
 	*x = Catalog_Metadata{}
-	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[90]
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[236]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5591,7 +16003,7 @@ func (x *Catalog_Metadata) String() string {
 func (*Catalog_Metadata) ProtoMessage() {}
 
 func (x *Catalog_Metadata) ProtoReflect() protoreflect.Message {
-	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[90]
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[236]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5604,7 +16016,7 @@ func (x *Catalog_Metadata) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Catalog_Metadata.ProtoReflect.Descriptor instead.
 func (*Catalog_Metadata) Descriptor() ([]byte, []int) {
-	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{36, 0}
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{108, 0}
 }
 
 func (x *Catalog_Metadata) GetColor() string {
@@ -5628,14 +16040,22 @@ type ListAssessmentResultsRequest_Filter struct {
 	// Optional. List only assessment result from a specific list of IDs.
 	AssessmentResultIds []string `protobuf:"bytes,6,rep,name=assessment_result_ids,json=assessmentResultIds,proto3" json:"assessment_result_ids,omitempty"`
 	// Optional. List only assessment results from a specific evidence ID.
-	EvidenceId    *string `protobuf:"bytes,7,opt,name=evidence_id,json=evidenceId,proto3,oneof" json:"evidence_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	EvidenceId *string `protobuf:"bytes,7,opt,name=evidence_id,json=evidenceId,proto3,oneof" json:"evidence_id,omitempty"`
+	// Optional. List only assessment results of resources owned by a specific owner.
+	Owner *string `protobuf:"bytes,8,opt,name=owner,proto3,oneof" json:"owner,omitempty"`
+	// Optional. List only assessment results of resources owned by a specific team.
+	Team *string `protobuf:"bytes,9,opt,name=team,proto3,oneof" json:"team,omitempty"`
+	// Optional. List only assessment results of resources in a specific environment, e.g., prod.
+	Environment *string `protobuf:"bytes,10,opt,name=environment,proto3,oneof" json:"environment,omitempty"`
+	// Optional. List only assessment results that were (or were not) imported from an external audit.
+	ExternallySourced *bool `protobuf:"varint,11,opt,name=externally_sourced,json=externallySourced,proto3,oneof" json:"externally_sourced,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *ListAssessmentResultsRequest_Filter) Reset() {
 	*x = ListAssessmentResultsRequest_Filter{}
-	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[91]
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[237]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5647,7 +16067,7 @@ func (x *ListAssessmentResultsRequest_Filter) String() string {
 func (*ListAssessmentResultsRequest_Filter) ProtoMessage() {}
 
 func (x *ListAssessmentResultsRequest_Filter) ProtoReflect() protoreflect.Message {
-	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[91]
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[237]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5660,7 +16080,7 @@ func (x *ListAssessmentResultsRequest_Filter) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use ListAssessmentResultsRequest_Filter.ProtoReflect.Descriptor instead.
 func (*ListAssessmentResultsRequest_Filter) Descriptor() ([]byte, []int) {
-	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{41, 0}
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{128, 0}
 }
 
 func (x *ListAssessmentResultsRequest_Filter) GetTargetOfEvaluationId() string {
@@ -5712,19 +16132,49 @@ func (x *ListAssessmentResultsRequest_Filter) GetEvidenceId() string {
 	return ""
 }
 
+func (x *ListAssessmentResultsRequest_Filter) GetOwner() string {
+	if x != nil && x.Owner != nil {
+		return *x.Owner
+	}
+	return ""
+}
+
+func (x *ListAssessmentResultsRequest_Filter) GetTeam() string {
+	if x != nil && x.Team != nil {
+		return *x.Team
+	}
+	return ""
+}
+
+func (x *ListAssessmentResultsRequest_Filter) GetEnvironment() string {
+	if x != nil && x.Environment != nil {
+		return *x.Environment
+	}
+	return ""
+}
+
+func (x *ListAssessmentResultsRequest_Filter) GetExternallySourced() bool {
+	if x != nil && x.ExternallySourced != nil {
+		return *x.ExternallySourced
+	}
+	return false
+}
+
 type ListAuditScopesRequest_Filter struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Optional. List only audit scopes of a specific target of evaluation
 	TargetOfEvaluationId *string `protobuf:"bytes,1,opt,name=target_of_evaluation_id,json=targetOfEvaluationId,proto3,oneof" json:"target_of_evaluation_id,omitempty"`
 	// Optional. List only audit scopes that evaluate the given catalog for any target of evaluation
-	CatalogId     *string `protobuf:"bytes,2,opt,name=catalog_id,json=catalogId,proto3,oneof" json:"catalog_id,omitempty"`
+	CatalogId *string `protobuf:"bytes,2,opt,name=catalog_id,json=catalogId,proto3,oneof" json:"catalog_id,omitempty"`
+	// Optional. List only audit scopes restricted to a specific environment, e.g., prod
+	Environment   *string `protobuf:"bytes,3,opt,name=environment,proto3,oneof" json:"environment,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListAuditScopesRequest_Filter) Reset() {
 	*x = ListAuditScopesRequest_Filter{}
-	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[92]
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[238]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5736,7 +16186,7 @@ func (x *ListAuditScopesRequest_Filter) String() string {
 func (*ListAuditScopesRequest_Filter) ProtoMessage() {}
 
 func (x *ListAuditScopesRequest_Filter) ProtoReflect() protoreflect.Message {
-	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[92]
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[238]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5749,7 +16199,7 @@ func (x *ListAuditScopesRequest_Filter) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListAuditScopesRequest_Filter.ProtoReflect.Descriptor instead.
 func (*ListAuditScopesRequest_Filter) Descriptor() ([]byte, []int) {
-	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{46, 0}
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{134, 0}
 }
 
 func (x *ListAuditScopesRequest_Filter) GetTargetOfEvaluationId() string {
@@ -5766,6 +16216,13 @@ func (x *ListAuditScopesRequest_Filter) GetCatalogId() string {
 	return ""
 }
 
+func (x *ListAuditScopesRequest_Filter) GetEnvironment() string {
+	if x != nil && x.Environment != nil {
+		return *x.Environment
+	}
+	return ""
+}
+
 type ListControlsRequest_Filter struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Optional. Lists only controls with the specified catalog.
@@ -5782,7 +16239,7 @@ type ListControlsRequest_Filter struct {
 
 func (x *ListControlsRequest_Filter) Reset() {
 	*x = ListControlsRequest_Filter{}
-	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[93]
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[239]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5794,7 +16251,7 @@ func (x *ListControlsRequest_Filter) String() string {
 func (*ListControlsRequest_Filter) ProtoMessage() {}
 
 func (x *ListControlsRequest_Filter) ProtoReflect() protoreflect.Message {
-	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[93]
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[239]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5807,7 +16264,7 @@ func (x *ListControlsRequest_Filter) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListControlsRequest_Filter.ProtoReflect.Descriptor instead.
 func (*ListControlsRequest_Filter) Descriptor() ([]byte, []int) {
-	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{63, 0}
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{202, 0}
 }
 
 func (x *ListControlsRequest_Filter) GetCatalogId() string {
@@ -5847,14 +16304,16 @@ type ListUsersRequest_Filter struct {
 	// Optional. Search query for username, email, first name, or last name
 	Search *string `protobuf:"bytes,3,opt,name=search,proto3,oneof" json:"search,omitempty"`
 	// Optional. Filter by specific attribute key-value pairs (e.g., department)
-	Attributes    map[string]string `protobuf:"bytes,4,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Attributes map[string]string `protobuf:"bytes,4,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Optional. Filter by the source the user was provisioned from
+	Source        *UserSource `protobuf:"varint,5,opt,name=source,proto3,enum=confirmate.orchestrator.v1.UserSource,oneof" json:"source,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListUsersRequest_Filter) Reset() {
 	*x = ListUsersRequest_Filter{}
-	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[94]
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[240]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5866,7 +16325,7 @@ func (x *ListUsersRequest_Filter) String() string {
 func (*ListUsersRequest_Filter) ProtoMessage() {}
 
 func (x *ListUsersRequest_Filter) ProtoReflect() protoreflect.Message {
-	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[94]
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[240]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5879,7 +16338,7 @@ func (x *ListUsersRequest_Filter) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListUsersRequest_Filter.ProtoReflect.Descriptor instead.
 func (*ListUsersRequest_Filter) Descriptor() ([]byte, []int) {
-	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{74, 0}
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{213, 0}
 }
 
 func (x *ListUsersRequest_Filter) GetRole() Role {
@@ -5910,6 +16369,13 @@ func (x *ListUsersRequest_Filter) GetAttributes() map[string]string {
 	return nil
 }
 
+func (x *ListUsersRequest_Filter) GetSource() UserSource {
+	if x != nil && x.Source != nil {
+		return *x.Source
+	}
+	return UserSource_USER_SOURCE_UNSPECIFIED
+}
+
 type ListUserPermissionsRequest_Filter struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Optional. Filter by a specific user ID to list all permissions for that user.
@@ -5924,7 +16390,7 @@ type ListUserPermissionsRequest_Filter struct {
 
 func (x *ListUserPermissionsRequest_Filter) Reset() {
 	*x = ListUserPermissionsRequest_Filter{}
-	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[96]
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[242]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5936,7 +16402,7 @@ func (x *ListUserPermissionsRequest_Filter) String() string {
 func (*ListUserPermissionsRequest_Filter) ProtoMessage() {}
 
 func (x *ListUserPermissionsRequest_Filter) ProtoReflect() protoreflect.Message {
-	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[96]
+	mi := &file_api_orchestrator_orchestrator_proto_msgTypes[242]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5949,7 +16415,7 @@ func (x *ListUserPermissionsRequest_Filter) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use ListUserPermissionsRequest_Filter.ProtoReflect.Descriptor instead.
 func (*ListUserPermissionsRequest_Filter) Descriptor() ([]byte, []int) {
-	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{76, 0}
+	return file_api_orchestrator_orchestrator_proto_rawDescGZIP(), []int{218, 0}
 }
 
 func (x *ListUserPermissionsRequest_Filter) GetUserId() string {
@@ -5977,7 +16443,7 @@ var File_api_orchestrator_orchestrator_proto protoreflect.FileDescriptor
 
 const file_api_orchestrator_orchestrator_proto_rawDesc = "" +
 	"\n" +
-	"#api/orchestrator/orchestrator.proto\x12\x1aconfirmate.orchestrator.v1\x1a\x1bapi/assessment/metric.proto\x1a\x1bapi/assessment/result.proto\x1a\x18api/common/runtime.proto\x1a\x1fapi/evaluation/evaluation.proto\x1a\x1bapi/orchestrator/user.proto\x1a\x1fapi/orchestrator/workflow.proto\x1a\x1bbuf/validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x13tagger/tagger.proto\"j\n" +
+	"#api/orchestrator/orchestrator.proto\x12\x1aconfirmate.orchestrator.v1\x1a\x1bapi/assessment/metric.proto\x1a\x1bapi/assessment/result.proto\x1a\x18api/common/runtime.proto\x1a\x1fapi/evaluation/evaluation.proto\x1a\x1bapi/orchestrator/user.proto\x1a\x1fapi/orchestrator/workflow.proto\x1a\x1bbuf/validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x13tagger/tagger.proto\"j\n" +
 	"\x1dRegisterAssessmentToolRequest\x12I\n" +
 	"\x04tool\x18\x01 \x01(\v2*.confirmate.orchestrator.v1.AssessmentToolB\t\xe0A\x02\xbaH\x03\xc8\x01\x01R\x04tool\"\xf6\x01\n" +
 	"\x1aListAssessmentToolsRequest\x12Z\n" +
@@ -6006,18 +16472,50 @@ const file_api_orchestrator_orchestrator_proto_rawDesc = "" +
 	"\x1dStoreAssessmentResultResponse\"_\n" +
 	"\x1eStoreAssessmentResultsResponse\x12\x16\n" +
 	"\x06status\x18\x01 \x01(\bR\x06status\x12%\n" +
-	"\x0estatus_message\x18\x02 \x01(\tR\rstatusMessage\"m\n" +
+	"\x0estatus_message\x18\x02 \x01(\tR\rstatusMessage\"\x7f\n" +
+	"\"BatchStoreAssessmentResultsRequest\x12Y\n" +
+	"\aresults\x18\x01 \x03(\v2*.confirmate.assessment.v1.AssessmentResultB\x13\xe0A\x02\xbaH\r\x92\x01\n" +
+	"\b\x01\x10\xe8\a\"\x03\xd8\x01\x03R\aresults\"\x93\x01\n" +
+	"#BatchStoreAssessmentResultsResponse\x12T\n" +
+	"\aresults\x18\x01 \x03(\v2:.confirmate.orchestrator.v1.StoreAssessmentResultsResponseR\aresults\x12\x16\n" +
+	"\x06stored\x18\x02 \x01(\x05R\x06stored\"\xe8\x01\n" +
+	"\x1cWaiveAssessmentResultRequest\x12=\n" +
+	"\x14assessment_result_id\x18\x01 \x01(\tB\v\xe0A\x02\xbaH\x05r\x03\xb0\x01\x01R\x12assessmentResultId\x120\n" +
+	"\rjustification\x18\x02 \x01(\tB\n" +
+	"\xe0A\x02\xbaH\x04r\x02\x10\x01R\rjustification\x12H\n" +
+	"\n" +
+	"expires_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB\b\xbaH\x05\xb2\x01\x02@\x01H\x00R\texpiresAt\x88\x01\x01B\r\n" +
+	"\v_expires_at\"d\n" +
+	"#RevokeAssessmentResultWaiverRequest\x12=\n" +
+	"\x14assessment_result_id\x18\x01 \x01(\tB\v\xe0A\x02\xbaH\x05r\x03\xb0\x01\x01R\x12assessmentResultId\"\xcf\x02\n" +
+	"\x1dImportExternalFindingsRequest\x12B\n" +
+	"\x17target_of_evaluation_id\x18\x01 \x01(\tB\v\xe0A\x02\xbaH\x05r\x03\xb0\x01\x01R\x14targetOfEvaluationId\x12)\n" +
+	"\n" +
+	"catalog_id\x18\x02 \x01(\tB\n" +
+	"\xe0A\x02\xbaH\x04r\x02\x10\x01R\tcatalogId\x12\"\n" +
+	"\x06source\x18\x03 \x01(\tB\n" +
+	"\xe0A\x02\xbaH\x04r\x02\x10\x01R\x06source\x12]\n" +
+	"\x06format\x18\x04 \x01(\x0e26.confirmate.orchestrator.v1.ExternalFindingsFileFormatB\r\xe0A\x02\xbaH\a\x82\x01\x04\x10\x01 \x00R\x06format\x12#\n" +
+	"\x04data\x18\x05 \x01(\fB\x0f\xe0A\x02\xbaH\tz\a\x10\x01\x18\x80\x80\x80\x05R\x04data\x12\x17\n" +
+	"\adry_run\x18\x06 \x01(\bR\x06dryRun\"\xcb\x01\n" +
+	"\x1eImportExternalFindingsResponse\x12\x1a\n" +
+	"\bfindings\x18\x01 \x01(\x05R\bfindings\x122\n" +
+	"\x15assessment_result_ids\x18\x02 \x03(\tR\x13assessmentResultIds\x12?\n" +
+	"\x06issues\x18\x03 \x03(\v2'.confirmate.orchestrator.v1.ImportIssueR\x06issues\x12\x18\n" +
+	"\aapplied\x18\x04 \x01(\bR\aapplied\"m\n" +
 	"\x1cStoreEvaluationResultRequest\x12M\n" +
-	"\x06result\x18\x01 \x01(\v2*.confirmate.evaluation.v1.EvaluationResultB\t\xe0A\x02\xbaH\x03\xc8\x01\x01R\x06result\"\xae\x06\n" +
+	"\x06result\x18\x01 \x01(\v2*.confirmate.evaluation.v1.EvaluationResultB\t\xe0A\x02\xbaH\x03\xc8\x01\x01R\x06result\"\x8b\n" +
+	"\n" +
 	"\x1cListEvaluationResultsRequest\x12\\\n" +
 	"\x06filter\x18\x01 \x01(\v2?.confirmate.orchestrator.v1.ListEvaluationResultsRequest.FilterH\x00R\x06filter\x88\x01\x01\x124\n" +
-	"\x14latest_by_control_id\x18\x02 \x01(\bH\x01R\x11latestByControlId\x88\x01\x01\x12\x1b\n" +
+	"\x14latest_by_control_id\x18\x02 \x01(\bH\x01R\x11latestByControlId\x88\x01\x01\x121\n" +
+	"\rsaved_view_id\x18\x03 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01H\x02R\vsavedViewId\x88\x01\x01\x12\x1b\n" +
 	"\tpage_size\x18\n" +
 	" \x01(\x05R\bpageSize\x12\x1d\n" +
 	"\n" +
 	"page_token\x18\v \x01(\tR\tpageToken\x12\x19\n" +
 	"\border_by\x18\f \x01(\tR\aorderBy\x12\x10\n" +
-	"\x03asc\x18\r \x01(\bR\x03asc\x1a\xec\x03\n" +
+	"\x03asc\x18\r \x01(\bR\x03asc\x1a\x84\a\n" +
 	"\x06Filter\x12D\n" +
 	"\x17target_of_evaluation_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01H\x00R\x14targetOfEvaluationId\x88\x01\x01\x12+\n" +
 	"\n" +
@@ -6027,7 +16525,16 @@ const file_api_orchestrator_orchestrator_proto_rawDesc = "" +
 	"\fsub_controls\x18\x04 \x01(\tB\a\xbaH\x04r\x02\x10\x01H\x03R\vsubControls\x88\x01\x01\x12&\n" +
 	"\fparents_only\x18\x05 \x01(\bH\x04R\vparentsOnly\x88\x01\x01\x12/\n" +
 	"\x11valid_manual_only\x18\x06 \x01(\bH\x05R\x0fvalidManualOnly\x88\x01\x01\x123\n" +
-	"\x0eaudit_scope_id\x18\a \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01H\x06R\fauditScopeId\x88\x01\x01B\x1a\n" +
+	"\x0eaudit_scope_id\x18\a \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01H\x06R\fauditScopeId\x88\x01\x01\x12Q\n" +
+	"\x06status\x18\b \x01(\x0e2*.confirmate.evaluation.v1.EvaluationStatusB\b\xbaH\x05\x82\x01\x02\x10\x01H\aR\x06status\x88\x01\x01\x12+\n" +
+	"\n" +
+	"sub_status\x18\t \x01(\tB\a\xbaH\x04r\x02\x10\x01H\bR\tsubStatus\x88\x01\x01\x125\n" +
+	"\x0fcatalog_version\x18\n" +
+	" \x01(\x05B\a\xbaH\x04\x1a\x02 \x00H\tR\x0ecatalogVersion\x88\x01\x01\x12/\n" +
+	"\fcatalog_hash\x18\v \x01(\tB\a\xbaH\x04r\x02\x10\x01H\n" +
+	"R\vcatalogHash\x88\x01\x01\x12.\n" +
+	"\venvironment\x18\f \x01(\tB\a\xbaH\x04r\x02\x10\x01H\vR\venvironment\x88\x01\x01\x12$\n" +
+	"\x06run_id\x18\r \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01H\fR\x05runId\x88\x01\x01B\x1a\n" +
 	"\x18_target_of_evaluation_idB\r\n" +
 	"\v_catalog_idB\r\n" +
 	"\v_control_idB\x0f\n" +
@@ -6035,18 +16542,181 @@ const file_api_orchestrator_orchestrator_proto_rawDesc = "" +
 	"\r_parents_onlyB\x14\n" +
 	"\x12_valid_manual_onlyB\x11\n" +
 	"\x0f_audit_scope_idB\t\n" +
+	"\a_statusB\r\n" +
+	"\v_sub_statusB\x12\n" +
+	"\x10_catalog_versionB\x0f\n" +
+	"\r_catalog_hashB\x0e\n" +
+	"\f_environmentB\t\n" +
+	"\a_run_idB\t\n" +
 	"\a_filterB\x17\n" +
-	"\x15_latest_by_control_id\"\x8d\x01\n" +
+	"\x15_latest_by_control_idB\x10\n" +
+	"\x0e_saved_view_id\"\x8d\x01\n" +
 	"\x1dListEvaluationResultsResponse\x12D\n" +
 	"\aresults\x18\x01 \x03(\v2*.confirmate.evaluation.v1.EvaluationResultR\aresults\x12&\n" +
-	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"Z\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xae\x04\n" +
+	"\tSavedView\x121\n" +
+	"\x02id\x18\x01 \x01(\tB!\xe0A\x02\xbaH\x05r\x03\xb0\x01\x01\x9a\x84\x9e\x03\x11gorm:\"primaryKey\"R\x02id\x12\x1e\n" +
+	"\x04name\x18\x02 \x01(\tB\n" +
+	"\xe0A\x02\xbaH\x04r\x02\x10\x01R\x04name\x12%\n" +
+	"\vdescription\x18\x03 \x01(\tH\x00R\vdescription\x88\x01\x01\x12}\n" +
+	"\x06filter\x18\x04 \x01(\v2?.confirmate.orchestrator.v1.ListEvaluationResultsRequest.FilterB$\xe0A\x02\xbaH\x03\xc8\x01\x01\x9a\x84\x9e\x03\x16gorm:\"serializer:json\"R\x06filter\x12\x16\n" +
+	"\x06shared\x18\x05 \x01(\bR\x06shared\x12\x1e\n" +
+	"\bowner_id\x18\x06 \x01(\tB\x03\xe0A\x03R\aownerId\x12o\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampB4\xe0A\x03\x9a\x84\x9e\x03,gorm:\"serializer:timestamppb;type:timestamp\"R\tcreatedAt\x12o\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampB4\xe0A\x03\x9a\x84\x9e\x03,gorm:\"serializer:timestamppb;type:timestamp\"R\tupdatedAtB\x0e\n" +
+	"\f_description\"i\n" +
+	"\x16CreateSavedViewRequest\x12O\n" +
+	"\n" +
+	"saved_view\x18\x01 \x01(\v2%.confirmate.orchestrator.v1.SavedViewB\t\xe0A\x02\xbaH\x03\xc8\x01\x01R\tsavedView\"F\n" +
+	"\x13GetSavedViewRequest\x12/\n" +
+	"\rsaved_view_id\x18\x01 \x01(\tB\v\xe0A\x02\xbaH\x05r\x03\xb0\x01\x01R\vsavedViewId\"\xb3\x01\n" +
+	"\x15ListSavedViewsRequest\x12\"\n" +
+	"\n" +
+	"owned_only\x18\x01 \x01(\bH\x00R\townedOnly\x88\x01\x01\x12\x1b\n" +
+	"\tpage_size\x18\n" +
+	" \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\v \x01(\tR\tpageToken\x12\x19\n" +
+	"\border_by\x18\f \x01(\tR\aorderBy\x12\x10\n" +
+	"\x03asc\x18\r \x01(\bR\x03ascB\r\n" +
+	"\v_owned_only\"\x88\x01\n" +
+	"\x16ListSavedViewsResponse\x12F\n" +
+	"\vsaved_views\x18\x01 \x03(\v2%.confirmate.orchestrator.v1.SavedViewR\n" +
+	"savedViews\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"i\n" +
+	"\x16UpdateSavedViewRequest\x12O\n" +
+	"\n" +
+	"saved_view\x18\x01 \x01(\v2%.confirmate.orchestrator.v1.SavedViewB\t\xe0A\x02\xbaH\x03\xc8\x01\x01R\tsavedView\"I\n" +
+	"\x16RemoveSavedViewRequest\x12/\n" +
+	"\rsaved_view_id\x18\x01 \x01(\tB\v\xe0A\x02\xbaH\x05r\x03\xb0\x01\x01R\vsavedViewId\"\xfe\x01\n" +
+	"\x16ListSlaBreachesRequest\x12D\n" +
+	"\x17target_of_evaluation_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01H\x00R\x14targetOfEvaluationId\x88\x01\x01\x123\n" +
+	"\x0eaudit_scope_id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01H\x01R\fauditScopeId\x88\x01\x01\x12+\n" +
+	"\n" +
+	"catalog_id\x18\x03 \x01(\tB\a\xbaH\x04r\x02\x10\x01H\x02R\tcatalogId\x88\x01\x01B\x1a\n" +
+	"\x18_target_of_evaluation_idB\x11\n" +
+	"\x0f_audit_scope_idB\r\n" +
+	"\v_catalog_id\"\\\n" +
+	"\x17ListSlaBreachesResponse\x12A\n" +
+	"\bbreaches\x18\x01 \x03(\v2%.confirmate.orchestrator.v1.SlaBreachR\bbreaches\"\xdb\x03\n" +
+	"\tSlaBreach\x125\n" +
+	"\x14evaluation_result_id\x18\x01 \x01(\tB\x03\xe0A\x02R\x12evaluationResultId\x12:\n" +
+	"\x17target_of_evaluation_id\x18\x02 \x01(\tB\x03\xe0A\x02R\x14targetOfEvaluationId\x12)\n" +
+	"\x0eaudit_scope_id\x18\x03 \x01(\tB\x03\xe0A\x02R\fauditScopeId\x12\"\n" +
+	"\n" +
+	"control_id\x18\x04 \x01(\tB\x03\xe0A\x02R\tcontrolId\x121\n" +
+	"\x12control_catalog_id\x18\x05 \x01(\tB\x03\xe0A\x02R\x10controlCatalogId\x12O\n" +
+	"\x13non_compliant_since\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x02R\x11nonCompliantSince\x12;\n" +
+	"\bdeadline\x18\a \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x02R\bdeadline\x12K\n" +
+	"\tthreshold\x18\b \x01(\v2(.confirmate.orchestrator.v1.SlaThresholdB\x03\xe0A\x02R\tthreshold\"\x99\x01\n" +
+	"\x1bGetCompliancePostureRequest\x12+\n" +
+	"\n" +
+	"catalog_id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01H\x00R\tcatalogId\x88\x01\x01\x12.\n" +
+	"\venvironment\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x10\x01H\x01R\venvironment\x88\x01\x01B\r\n" +
+	"\v_catalog_idB\x0e\n" +
+	"\f_environment\"\xf6\x01\n" +
+	"\x11CompliancePosture\x12I\n" +
+	"\x1fnumber_of_targets_of_evaluation\x18\x01 \x01(\x03B\x03\xe0A\x02R\x1bnumberOfTargetsOfEvaluation\x12I\n" +
+	"\x06counts\x18\x02 \x01(\v2,.confirmate.orchestrator.v1.ComplianceCountsB\x03\xe0A\x02R\x06counts\x12K\n" +
+	"\bcatalogs\x18\x03 \x03(\v2*.confirmate.orchestrator.v1.CatalogPostureB\x03\xe0A\x02R\bcatalogs\"\xc0\x02\n" +
+	"\x0eCatalogPosture\x12\"\n" +
+	"\n" +
+	"catalog_id\x18\x01 \x01(\tB\x03\xe0A\x02R\tcatalogId\x12!\n" +
+	"\fcatalog_name\x18\x02 \x01(\tR\vcatalogName\x12I\n" +
+	"\x1fnumber_of_targets_of_evaluation\x18\x03 \x01(\x03B\x03\xe0A\x02R\x1bnumberOfTargetsOfEvaluation\x12I\n" +
+	"\x06counts\x18\x04 \x01(\v2,.confirmate.orchestrator.v1.ComplianceCountsB\x03\xe0A\x02R\x06counts\x12Q\n" +
+	"\bfamilies\x18\x05 \x03(\v20.confirmate.orchestrator.v1.ControlFamilyPostureB\x03\xe0A\x02R\bfamilies\"\xd1\x01\n" +
+	"\x14ControlFamilyPosture\x12#\n" +
+	"\rcategory_name\x18\x01 \x01(\tR\fcategoryName\x12I\n" +
+	"\x1fnumber_of_targets_of_evaluation\x18\x02 \x01(\x03B\x03\xe0A\x02R\x1bnumberOfTargetsOfEvaluation\x12I\n" +
+	"\x06counts\x18\x03 \x01(\v2,.confirmate.orchestrator.v1.ComplianceCountsB\x03\xe0A\x02R\x06counts\"\xc7\x01\n" +
+	"\x10ComplianceCounts\x12!\n" +
+	"\tcompliant\x18\x01 \x01(\x03B\x03\xe0A\x02R\tcompliant\x12(\n" +
+	"\rnot_compliant\x18\x02 \x01(\x03B\x03\xe0A\x02R\fnotCompliant\x12\x1d\n" +
+	"\apending\x18\x03 \x01(\x03B\x03\xe0A\x02R\apending\x12\x19\n" +
+	"\x05other\x18\x04 \x01(\x03B\x03\xe0A\x02R\x05other\x12,\n" +
+	"\x0fcompliance_rate\x18\x05 \x01(\x01B\x03\xe0A\x02R\x0ecomplianceRate\"\x9c\x03\n" +
+	"\x11ListAlertsRequest\x12Q\n" +
+	"\x06filter\x18\x01 \x01(\v24.confirmate.orchestrator.v1.ListAlertsRequest.FilterH\x00R\x06filter\x88\x01\x01\x12\x1b\n" +
+	"\tpage_size\x18\n" +
+	" \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\v \x01(\tR\tpageToken\x12\x19\n" +
+	"\border_by\x18\f \x01(\tR\aorderBy\x12\x10\n" +
+	"\x03asc\x18\r \x01(\bR\x03asc\x1a\xbf\x01\n" +
+	"\x06Filter\x12D\n" +
+	"\x17target_of_evaluation_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01H\x00R\x14targetOfEvaluationId\x88\x01\x01\x12J\n" +
+	"\x04type\x18\x02 \x01(\x0e2%.confirmate.orchestrator.v1.AlertTypeB\n" +
+	"\xbaH\a\x82\x01\x04\x10\x01 \x00H\x01R\x04type\x88\x01\x01B\x1a\n" +
+	"\x18_target_of_evaluation_idB\a\n" +
+	"\x05_typeB\t\n" +
+	"\a_filter\"w\n" +
+	"\x12ListAlertsResponse\x129\n" +
+	"\x06alerts\x18\x01 \x03(\v2!.confirmate.orchestrator.v1.AlertR\x06alerts\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\x8a\x04\n" +
+	"\x05Alert\x121\n" +
+	"\x02id\x18\x01 \x01(\tB!\xe0A\x02\xbaH\x05r\x03\xb0\x01\x01\x9a\x84\x9e\x03\x11gorm:\"primaryKey\"R\x02id\x12H\n" +
+	"\x04type\x18\x02 \x01(\x0e2%.confirmate.orchestrator.v1.AlertTypeB\r\xe0A\x02\xbaH\a\x82\x01\x04\x10\x01 \x00R\x04type\x12B\n" +
+	"\x17target_of_evaluation_id\x18\x03 \x01(\tB\v\xe0A\x02\xbaH\x05r\x03\xb0\x01\x01R\x14targetOfEvaluationId\x12 \n" +
+	"\tmetric_id\x18\x04 \x01(\tH\x00R\bmetricId\x88\x01\x01\x12$\n" +
+	"\vresource_id\x18\x05 \x01(\tH\x01R\n" +
+	"resourceId\x88\x01\x01\x12%\n" +
+	"\vdescription\x18\x06 \x01(\tB\x03\xe0A\x02R\vdescription\x12q\n" +
+	"\vdetected_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampB4\xe0A\x02\x9a\x84\x9e\x03,gorm:\"serializer:timestamppb;type:timestamp\"R\n" +
+	"detectedAt\x12\x1f\n" +
+	"\bobserved\x18\b \x01(\x01B\x03\xe0A\x02R\bobserved\x12\x1f\n" +
+	"\bexpected\x18\t \x01(\x01B\x03\xe0A\x02R\bexpectedB\f\n" +
+	"\n" +
+	"_metric_idB\x0e\n" +
+	"\f_resource_id\"\x87\x01\n" +
+	"\x17UploadAttachmentRequest\x12B\n" +
+	"\bmetadata\x18\x01 \x01(\v2$.confirmate.evaluation.v1.AttachmentH\x00R\bmetadata\x12\x16\n" +
+	"\x05chunk\x18\x02 \x01(\fH\x00R\x05chunkB\x10\n" +
+	"\apayload\x12\x05\xbaH\x02\b\x01\"M\n" +
+	"\x19DownloadAttachmentRequest\x120\n" +
+	"\rattachment_id\x18\x01 \x01(\tB\v\xe0A\x02\xbaH\x05r\x03\xb0\x01\x01R\fattachmentId\"\x83\x01\n" +
+	"\x1aDownloadAttachmentResponse\x12B\n" +
+	"\bmetadata\x18\x01 \x01(\v2$.confirmate.evaluation.v1.AttachmentH\x00R\bmetadata\x12\x16\n" +
+	"\x05chunk\x18\x02 \x01(\fH\x00R\x05chunkB\t\n" +
+	"\apayload\"\xc0\x01\n" +
+	"\x16ListAttachmentsRequest\x12=\n" +
+	"\x14evaluation_result_id\x18\x01 \x01(\tB\v\xe0A\x02\xbaH\x05r\x03\xb0\x01\x01R\x12evaluationResultId\x12\x1b\n" +
+	"\tpage_size\x18\n" +
+	" \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\v \x01(\tR\tpageToken\x12\x19\n" +
+	"\border_by\x18\f \x01(\tR\aorderBy\x12\x10\n" +
+	"\x03asc\x18\r \x01(\bR\x03asc\"\x89\x01\n" +
+	"\x17ListAttachmentsResponse\x12F\n" +
+	"\vattachments\x18\x01 \x03(\v2$.confirmate.evaluation.v1.AttachmentR\vattachments\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"K\n" +
+	"\x17RemoveAttachmentRequest\x120\n" +
+	"\rattachment_id\x18\x01 \x01(\tB\v\xe0A\x02\xbaH\x05r\x03\xb0\x01\x01R\fattachmentId\"k\n" +
+	"!AddEvaluationResultCommentRequest\x12F\n" +
+	"\acomment\x18\x01 \x01(\v2!.confirmate.evaluation.v1.CommentB\t\xe0A\x02\xbaH\x03\xc8\x01\x01R\acomment\"\xcd\x01\n" +
+	"#ListEvaluationResultCommentsRequest\x12=\n" +
+	"\x14evaluation_result_id\x18\x01 \x01(\tB\v\xe0A\x02\xbaH\x05r\x03\xb0\x01\x01R\x12evaluationResultId\x12\x1b\n" +
+	"\tpage_size\x18\n" +
+	" \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\v \x01(\tR\tpageToken\x12\x19\n" +
+	"\border_by\x18\f \x01(\tR\aorderBy\x12\x10\n" +
+	"\x03asc\x18\r \x01(\bR\x03asc\"\x8d\x01\n" +
+	"$ListEvaluationResultCommentsResponse\x12=\n" +
+	"\bcomments\x18\x01 \x03(\v2!.confirmate.evaluation.v1.CommentR\bcomments\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"R\n" +
+	"$RemoveEvaluationResultCommentRequest\x12*\n" +
+	"\n" +
+	"comment_id\x18\x01 \x01(\tB\v\xe0A\x02\xbaH\x05r\x03\xb0\x01\x01R\tcommentId\"Z\n" +
 	"\x13CreateMetricRequest\x12C\n" +
 	"\x06metric\x18\x01 \x01(\v2 .confirmate.assessment.v1.MetricB\t\xe0A\x02\xbaH\x03\xc8\x01\x01R\x06metric\"Z\n" +
 	"\x13UpdateMetricRequest\x12C\n" +
 	"\x06metric\x18\x01 \x01(\v2 .confirmate.assessment.v1.MetricB\t\xe0A\x02\xbaH\x03\xc8\x01\x01R\x06metric\";\n" +
 	"\x10GetMetricRequest\x12'\n" +
 	"\tmetric_id\x18\x01 \x01(\tB\n" +
-	"\xe0A\x02\xbaH\x04r\x02\x10\x01R\bmetricId\"\xb1\x02\n" +
+	"\xe0A\x02\xbaH\x04r\x02\x10\x01R\bmetricId\"\xf6\x03\n" +
 	"\x12ListMetricsRequest\x12R\n" +
 	"\x06filter\x18\x01 \x01(\v25.confirmate.orchestrator.v1.ListMetricsRequest.FilterH\x00R\x06filter\x88\x01\x01\x12\x1b\n" +
 	"\tpage_size\x18\n" +
@@ -6054,10 +16724,16 @@ const file_api_orchestrator_orchestrator_proto_rawDesc = "" +
 	"\n" +
 	"page_token\x18\v \x01(\tR\tpageToken\x12\x19\n" +
 	"\border_by\x18\f \x01(\tR\aorderBy\x12\x10\n" +
-	"\x03asc\x18\r \x01(\bR\x03asc\x1aS\n" +
+	"\x03asc\x18\r \x01(\bR\x03asc\x1a\x97\x02\n" +
 	"\x06Filter\x122\n" +
-	"\x12include_deprecated\x18\x01 \x01(\bH\x00R\x11includeDeprecated\x88\x01\x01B\x15\n" +
-	"\x13_include_deprecatedB\t\n" +
+	"\x12include_deprecated\x18\x01 \x01(\bH\x00R\x11includeDeprecated\x88\x01\x01\x12(\n" +
+	"\bcategory\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x10\x01H\x01R\bcategory\x88\x01\x01\x121\n" +
+	"\rresource_type\x18\x03 \x01(\tB\a\xbaH\x04r\x02\x10\x01H\x02R\fresourceType\x88\x01\x01\x123\n" +
+	"\x0eevidence_field\x18\x04 \x01(\tB\a\xbaH\x04r\x02\x10\x01H\x03R\revidenceField\x88\x01\x01B\x15\n" +
+	"\x13_include_deprecatedB\v\n" +
+	"\t_categoryB\x10\n" +
+	"\x0e_resource_typeB\x11\n" +
+	"\x0f_evidence_fieldB\t\n" +
 	"\a_filter\">\n" +
 	"\x13RemoveMetricRequest\x12'\n" +
 	"\tmetric_id\x18\x01 \x01(\tB\n" +
@@ -6072,14 +16748,36 @@ const file_api_orchestrator_orchestrator_proto_rawDesc = "" +
 	"\x1fUpdateTargetOfEvaluationRequest\x12k\n" +
 	"\x14target_of_evaluation\x18\x01 \x01(\v2..confirmate.orchestrator.v1.TargetOfEvaluationB\t\xe0A\x02\xbaH\x03\xc8\x01\x01R\x12targetOfEvaluation\"e\n" +
 	"\x1fRemoveTargetOfEvaluationRequest\x12B\n" +
-	"\x17target_of_evaluation_id\x18\x01 \x01(\tB\v\xe0A\x02\xbaH\x05r\x03\xb0\x01\x01R\x14targetOfEvaluationId\"\x89\x01\n" +
-	"\x1eListTargetsOfEvaluationRequest\x12\x1b\n" +
+	"\x17target_of_evaluation_id\x18\x01 \x01(\tB\v\xe0A\x02\xbaH\x05r\x03\xb0\x01\x01R\x14targetOfEvaluationId\"\xf4\x02\n" +
+	"\x1fMergeTargetsOfEvaluationRequest\x12B\n" +
+	"\x17target_of_evaluation_id\x18\x01 \x01(\tB\v\xe0A\x02\xbaH\x05r\x03\xb0\x01\x01R\x14targetOfEvaluationId\x12U\n" +
+	"!duplicate_target_of_evaluation_id\x18\x02 \x01(\tB\v\xe0A\x02\xbaH\x05r\x03\xb0\x01\x01R\x1dduplicateTargetOfEvaluationId\x12\x17\n" +
+	"\adry_run\x18\x03 \x01(\bR\x06dryRun:\x9c\x01\xbaH\x98\x01\x1a\x95\x01\n" +
+	"\x16merge_distinct_targets\x123a target of evaluation cannot be merged into itself\x1aFthis.target_of_evaluation_id != this.duplicate_target_of_evaluation_id\"\x97\x04\n" +
+	" MergeTargetsOfEvaluationResponse\x123\n" +
+	"\x16number_of_audit_scopes\x18\x01 \x01(\x03R\x13numberOfAuditScopes\x12<\n" +
+	"\x1bnumber_of_controls_in_scope\x18\x02 \x01(\x03R\x17numberOfControlsInScope\x12?\n" +
+	"\x1cnumber_of_assessment_results\x18\x03 \x01(\x03R\x19numberOfAssessmentResults\x12?\n" +
+	"\x1cnumber_of_evaluation_results\x18\x04 \x01(\x03R\x19numberOfEvaluationResults\x124\n" +
+	"\x16number_of_certificates\x18\x05 \x01(\x03R\x14numberOfCertificates\x12E\n" +
+	"\x1fnumber_of_metric_configurations\x18\x06 \x01(\x03R\x1cnumberOfMetricConfigurations\x12;\n" +
+	"\x1anumber_of_user_permissions\x18\a \x01(\x03R\x17numberOfUserPermissions\x12\x18\n" +
+	"\aapplied\x18\b \x01(\bR\aapplied\x12*\n" +
+	"\x11number_of_secrets\x18\t \x01(\x03R\x0fnumberOfSecrets\"\xba\x03\n" +
+	"\x1eListTargetsOfEvaluationRequest\x12^\n" +
+	"\x06filter\x18\x01 \x01(\v2A.confirmate.orchestrator.v1.ListTargetsOfEvaluationRequest.FilterH\x00R\x06filter\x88\x01\x01\x12\x1b\n" +
 	"\tpage_size\x18\n" +
 	" \x01(\x05R\bpageSize\x12\x1d\n" +
 	"\n" +
 	"page_token\x18\v \x01(\tR\tpageToken\x12\x19\n" +
 	"\border_by\x18\f \x01(\tR\aorderBy\x12\x10\n" +
-	"\x03asc\x18\r \x01(\bR\x03asc\"\xb2\x01\n" +
+	"\x03asc\x18\r \x01(\bR\x03asc\x1a\xc3\x01\n" +
+	"\x06Filter\x12x\n" +
+	"\rcustom_fields\x18\x01 \x03(\v2S.confirmate.orchestrator.v1.ListTargetsOfEvaluationRequest.Filter.CustomFieldsEntryR\fcustomFields\x1a?\n" +
+	"\x11CustomFieldsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\t\n" +
+	"\a_filter\"\xb2\x01\n" +
 	"\x1fListTargetsOfEvaluationResponse\x12g\n" +
 	"\x15targets_of_evaluation\x18\x01 \x03(\v2..confirmate.orchestrator.v1.TargetOfEvaluationB\x03\xe0A\x02R\x13targetsOfEvaluation\x12&\n" +
 	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"l\n" +
@@ -6089,13 +16787,164 @@ const file_api_orchestrator_orchestrator_proto_rawDesc = "" +
 	"\x1enumber_of_discovered_resources\x18\x01 \x01(\x03R\x1bnumberOfDiscoveredResources\x12?\n" +
 	"\x1cnumber_of_assessment_results\x18\x02 \x01(\x03R\x19numberOfAssessmentResults\x12.\n" +
 	"\x13number_of_evidences\x18\x03 \x01(\x03R\x11numberOfEvidences\x12=\n" +
-	"\x1bnumber_of_selected_catalogs\x18\x04 \x01(\x03R\x18numberOfSelectedCatalogs\"\x82\x01\n" +
+	"\x1bnumber_of_selected_catalogs\x18\x04 \x01(\x03R\x18numberOfSelectedCatalogs\"\x9d\x02\n" +
+	"\x15GetUsageReportRequest\x12D\n" +
+	"\x17target_of_evaluation_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01H\x00R\x14targetOfEvaluationId\x88\x01\x01\x12E\n" +
+	"\tstart_day\x18\x02 \x01(\tB#\xbaH r\x1e2\x1c^[0-9]{4}-[0-9]{2}-[0-9]{2}$H\x01R\bstartDay\x88\x01\x01\x12A\n" +
+	"\aend_day\x18\x03 \x01(\tB#\xbaH r\x1e2\x1c^[0-9]{4}-[0-9]{2}-[0-9]{2}$H\x02R\x06endDay\x88\x01\x01B\x1a\n" +
+	"\x18_target_of_evaluation_idB\f\n" +
+	"\n" +
+	"_start_dayB\n" +
+	"\n" +
+	"\b_end_day\"{\n" +
+	"\vUsageReport\x12l\n" +
+	"\x15targets_of_evaluation\x18\x01 \x03(\v23.confirmate.orchestrator.v1.TargetOfEvaluationUsageB\x03\xe0A\x02R\x13targetsOfEvaluation\"\xb2\x02\n" +
+	"\x17TargetOfEvaluationUsage\x12:\n" +
+	"\x17target_of_evaluation_id\x18\x01 \x01(\tB\x03\xe0A\x02R\x14targetOfEvaluationId\x12\x1c\n" +
+	"\tevidences\x18\x02 \x01(\x03R\tevidences\x12-\n" +
+	"\x12assessment_results\x18\x03 \x01(\x03R\x11assessmentResults\x12'\n" +
+	"\x0fevaluation_runs\x18\x04 \x01(\x03R\x0eevaluationRuns\x12#\n" +
+	"\rstorage_bytes\x18\x05 \x01(\x03R\fstorageBytes\x12@\n" +
+	"\x04days\x18\x06 \x03(\v2'.confirmate.orchestrator.v1.UsageRecordB\x03\xe0A\x02R\x04days\"\xaf\x02\n" +
+	"\vUsageRecord\x12X\n" +
+	"\x17target_of_evaluation_id\x18\x01 \x01(\tB!\xe0A\x02\xbaH\x05r\x03\xb0\x01\x01\x9a\x84\x9e\x03\x11gorm:\"primaryKey\"R\x14targetOfEvaluationId\x12+\n" +
+	"\x03day\x18\x02 \x01(\tB\x19\xe0A\x02\x9a\x84\x9e\x03\x11gorm:\"primaryKey\"R\x03day\x12\x1c\n" +
+	"\tevidences\x18\x03 \x01(\x03R\tevidences\x12-\n" +
+	"\x12assessment_results\x18\x04 \x01(\x03R\x11assessmentResults\x12'\n" +
+	"\x0fevaluation_runs\x18\x05 \x01(\x03R\x0eevaluationRuns\x12#\n" +
+	"\rstorage_bytes\x18\x06 \x01(\x03R\fstorageBytes\"\xf2\x04\n" +
+	"\x06Secret\x121\n" +
+	"\x02id\x18\x01 \x01(\tB!\xe0A\x02\xbaH\x05r\x03\xb0\x01\x01\x9a\x84\x9e\x03\x11gorm:\"primaryKey\"R\x02id\x12~\n" +
+	"\x17target_of_evaluation_id\x18\x02 \x01(\tBG\xe0A\x02\xbaH\x05r\x03\xb0\x01\x01\x9a\x84\x9e\x037gorm:\"uniqueIndex:idx_secret_target_of_evaluation_name\"R\x14targetOfEvaluationId\x12]\n" +
+	"\x04name\x18\x03 \x01(\tBI\xe0A\x02\xbaH\ar\x05\x10\x01\x18\xff\x01\x9a\x84\x9e\x037gorm:\"uniqueIndex:idx_secret_target_of_evaluation_name\"R\x04name\x12\x19\n" +
+	"\x05value\x18\x04 \x01(\tB\x03\xe0A\x04R\x05value\x12%\n" +
+	"\vdescription\x18\x05 \x01(\tH\x00R\vdescription\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"created_by\x18\x06 \x01(\tB\x03\xe0A\x03R\tcreatedBy\x12o\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampB4\xe0A\x03\x9a\x84\x9e\x03,gorm:\"serializer:timestamppb;type:timestamp\"R\tcreatedAt\x12o\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampB4\xe0A\x03\x9a\x84\x9e\x03,gorm:\"serializer:timestamppb;type:timestamp\"R\tupdatedAtB\x0e\n" +
+	"\f_description\"T\n" +
+	"\vSecretValue\x12\x1b\n" +
+	"\tsecret_id\x18\x01 \x01(\tR\bsecretId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05value\x18\x03 \x01(\tR\x05value\"\xb8\x01\n" +
+	"\x13CreateSecretRequest\x12E\n" +
+	"\x06secret\x18\x01 \x01(\v2\".confirmate.orchestrator.v1.SecretB\t\xe0A\x02\xbaH\x03\xc8\x01\x01R\x06secret:Z\xbaHW\x1aU\n" +
+	"\x15secret_value_required\x12#the value of the secret is required\x1a\x17this.secret.value != ''\"\xc1\x01\n" +
+	"\x12ListSecretsRequest\x12B\n" +
+	"\x17target_of_evaluation_id\x18\x01 \x01(\tB\v\xe0A\x02\xbaH\x05r\x03\xb0\x01\x01R\x14targetOfEvaluationId\x12\x1b\n" +
+	"\tpage_size\x18\n" +
+	" \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\v \x01(\tR\tpageToken\x12\x19\n" +
+	"\border_by\x18\f \x01(\tR\aorderBy\x12\x10\n" +
+	"\x03asc\x18\r \x01(\bR\x03asc\"{\n" +
+	"\x13ListSecretsResponse\x12<\n" +
+	"\asecrets\x18\x01 \x03(\v2\".confirmate.orchestrator.v1.SecretR\asecrets\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\\\n" +
+	"\x13UpdateSecretRequest\x12E\n" +
+	"\x06secret\x18\x01 \x01(\v2\".confirmate.orchestrator.v1.SecretB\t\xe0A\x02\xbaH\x03\xc8\x01\x01R\x06secret\"?\n" +
+	"\x13RemoveSecretRequest\x12(\n" +
+	"\tsecret_id\x18\x01 \x01(\tB\v\xe0A\x02\xbaH\x05r\x03\xb0\x01\x01R\bsecretId\"y\n" +
+	"\x13AccessSecretRequest\x12B\n" +
+	"\x17target_of_evaluation_id\x18\x01 \x01(\tB\v\xe0A\x02\xbaH\x05r\x03\xb0\x01\x01R\x14targetOfEvaluationId\x12\x1e\n" +
+	"\x04name\x18\x02 \x01(\tB\n" +
+	"\xe0A\x02\xbaH\x04r\x02\x10\x01R\x04name\"\x83\x06\n" +
+	"\x11ResourceInventory\x12X\n" +
+	"\x17target_of_evaluation_id\x18\x01 \x01(\tB!\xe0A\x02\xbaH\x05r\x03\xb0\x01\x01\x9a\x84\x9e\x03\x11gorm:\"primaryKey\"R\x14targetOfEvaluationId\x12F\n" +
+	"\fcollector_id\x18\x02 \x01(\tB#\xe0A\x02\xbaH\ar\x05\x10\x01\x18\xff\x01\x9a\x84\x9e\x03\x11gorm:\"primaryKey\"R\vcollectorId\x12#\n" +
+	"\atool_id\x18\x03 \x01(\tB\n" +
+	"\xe0A\x02\xbaH\x04r\x02\x10\x01R\x06toolId\x12%\n" +
+	"\venvironment\x18\x04 \x01(\tH\x00R\venvironment\x88\x01\x01\x12|\n" +
+	"\x0eresource_types\x18\x05 \x03(\v2-.confirmate.orchestrator.v1.ResourceTypeCountB&\xbaH\b\x92\x01\x05\"\x03\xc8\x01\x01\x9a\x84\x9e\x03\x16gorm:\"serializer:json\"R\rresourceTypes\x12A\n" +
+	"\aregions\x18\x06 \x03(\tB'\xbaH\t\x92\x01\x06\"\x04r\x02\x10\x01\x9a\x84\x9e\x03\x16gorm:\"serializer:json\"R\aregions\x12C\n" +
+	"\baccounts\x18\a \x03(\tB'\xbaH\t\x92\x01\x06\"\x04r\x02\x10\x01\x9a\x84\x9e\x03\x16gorm:\"serializer:json\"R\baccounts\x12y\n" +
+	"\fcollected_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampB:\xe0A\x02\xbaH\x03\xc8\x01\x01\x9a\x84\x9e\x03,gorm:\"serializer:timestamppb;type:timestamp\"R\vcollectedAt\x12o\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampB4\xe0A\x03\x9a\x84\x9e\x03,gorm:\"serializer:timestamppb;type:timestamp\"R\tupdatedAtB\x0e\n" +
+	"\f_environment\"f\n" +
+	"\x11ResourceTypeCount\x12/\n" +
+	"\rresource_type\x18\x01 \x01(\tB\n" +
+	"\xe0A\x02\xbaH\x04r\x02\x10\x01R\fresourceType\x12 \n" +
+	"\x05count\x18\x02 \x01(\x03B\n" +
+	"\xe0A\x02\xbaH\x04\"\x02(\x00R\x05count\"w\n" +
+	"\x1dStoreResourceInventoryRequest\x12V\n" +
+	"\tinventory\x18\x01 \x01(\v2-.confirmate.orchestrator.v1.ResourceInventoryB\t\xe0A\x02\xbaH\x03\xc8\x01\x01R\tinventory\"\xcd\x01\n" +
+	"\x1eListResourceInventoriesRequest\x12B\n" +
+	"\x17target_of_evaluation_id\x18\x01 \x01(\tB\v\xe0A\x02\xbaH\x05r\x03\xb0\x01\x01R\x14targetOfEvaluationId\x12\x1b\n" +
+	"\tpage_size\x18\n" +
+	" \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\v \x01(\tR\tpageToken\x12\x19\n" +
+	"\border_by\x18\f \x01(\tR\aorderBy\x12\x10\n" +
+	"\x03asc\x18\r \x01(\bR\x03asc\"\x9a\x01\n" +
+	"\x1fListResourceInventoriesResponse\x12O\n" +
+	"\vinventories\x18\x01 \x03(\v2-.confirmate.orchestrator.v1.ResourceInventoryR\vinventories\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"h\n" +
+	"\x1aUpdateMetadataFieldRequest\x12J\n" +
+	"\x05field\x18\x01 \x01(\v2).confirmate.orchestrator.v1.MetadataFieldB\t\xe0A\x02\xbaH\x03\xc8\x01\x01R\x05field\"\x84\x01\n" +
+	"\x19ListMetadataFieldsRequest\x12\x1b\n" +
+	"\tpage_size\x18\n" +
+	" \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\v \x01(\tR\tpageToken\x12\x19\n" +
+	"\border_by\x18\f \x01(\tR\aorderBy\x12\x10\n" +
+	"\x03asc\x18\r \x01(\bR\x03asc\"\x87\x01\n" +
+	"\x1aListMetadataFieldsResponse\x12A\n" +
+	"\x06fields\x18\x01 \x03(\v2).confirmate.orchestrator.v1.MetadataFieldR\x06fields\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\":\n" +
+	"\x1aRemoveMetadataFieldRequest\x12\x1c\n" +
+	"\x03key\x18\x01 \x01(\tB\n" +
+	"\xe0A\x02\xbaH\x04r\x02\x10\x01R\x03key\"f\n" +
+	"\x1aUpdateRedactionRuleRequest\x12H\n" +
+	"\x04rule\x18\x01 \x01(\v2).confirmate.orchestrator.v1.RedactionRuleB\t\xe0A\x02\xbaH\x03\xc8\x01\x01R\x04rule\"\x84\x01\n" +
+	"\x19ListRedactionRulesRequest\x12\x1b\n" +
+	"\tpage_size\x18\n" +
+	" \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\v \x01(\tR\tpageToken\x12\x19\n" +
+	"\border_by\x18\f \x01(\tR\aorderBy\x12\x10\n" +
+	"\x03asc\x18\r \x01(\bR\x03asc\"\x85\x01\n" +
+	"\x1aListRedactionRulesResponse\x12?\n" +
+	"\x05rules\x18\x01 \x03(\v2).confirmate.orchestrator.v1.RedactionRuleR\x05rules\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"M\n" +
+	"\x1aRemoveRedactionRuleRequest\x12/\n" +
+	"\rresource_type\x18\x01 \x01(\tB\n" +
+	"\xe0A\x02\xbaH\x04r\x02\x10\x01R\fresourceType\"\x82\x01\n" +
 	" UpdateMetricConfigurationRequest\x12^\n" +
-	"\rconfiguration\x18\x01 \x01(\v2-.confirmate.assessment.v1.MetricConfigurationB\t\xe0A\x02\xbaH\x03\xc8\x01\x01R\rconfiguration\"\x8c\x01\n" +
+	"\rconfiguration\x18\x01 \x01(\v2-.confirmate.assessment.v1.MetricConfigurationB\t\xe0A\x02\xbaH\x03\xc8\x01\x01R\rconfiguration\"\xc8\x01\n" +
 	"\x1dGetMetricConfigurationRequest\x12B\n" +
 	"\x17target_of_evaluation_id\x18\x01 \x01(\tB\v\xe0A\x02\xbaH\x05r\x03\xb0\x01\x01R\x14targetOfEvaluationId\x12'\n" +
 	"\tmetric_id\x18\x02 \x01(\tB\n" +
-	"\xe0A\x02\xbaH\x04r\x02\x10\x01R\bmetricId\"\xcd\x01\n" +
+	"\xe0A\x02\xbaH\x04r\x02\x10\x01R\bmetricId\x12+\n" +
+	"\n" +
+	"catalog_id\x18\x03 \x01(\tB\a\xbaH\x04r\x02\x10\x01H\x00R\tcatalogId\x88\x01\x01B\r\n" +
+	"\v_catalog_id\"\xcc\x01\n" +
+	"!ResolveMetricConfigurationRequest\x12B\n" +
+	"\x17target_of_evaluation_id\x18\x01 \x01(\tB\v\xe0A\x02\xbaH\x05r\x03\xb0\x01\x01R\x14targetOfEvaluationId\x12'\n" +
+	"\tmetric_id\x18\x02 \x01(\tB\n" +
+	"\xe0A\x02\xbaH\x04r\x02\x10\x01R\bmetricId\x12+\n" +
+	"\n" +
+	"catalog_id\x18\x03 \x01(\tB\a\xbaH\x04r\x02\x10\x01H\x00R\tcatalogId\x88\x01\x01B\r\n" +
+	"\v_catalog_id\"\xd8\x01\n" +
+	"\"ResolveMetricConfigurationResponse\x12X\n" +
+	"\rconfiguration\x18\x01 \x01(\v2-.confirmate.assessment.v1.MetricConfigurationB\x03\xe0A\x02R\rconfiguration\x12X\n" +
+	"\x05chain\x18\x02 \x03(\v2=.confirmate.orchestrator.v1.MetricConfigurationResolutionStepB\x03\xe0A\x02R\x05chain\"\xa7\x03\n" +
+	"!MetricConfigurationResolutionStep\x12P\n" +
+	"\x06source\x18\x01 \x01(\x0e23.confirmate.assessment.v1.MetricConfigurationSourceB\x03\xe0A\x02R\x06source\x12\"\n" +
+	"\n" +
+	"catalog_id\x18\x02 \x01(\tH\x00R\tcatalogId\x88\x01\x01\x12\x1d\n" +
+	"\adefined\x18\x03 \x01(\bB\x03\xe0A\x02R\adefined\x12\x1f\n" +
+	"\boperator\x18\x04 \x01(\tH\x01R\boperator\x88\x01\x01\x129\n" +
+	"\ftarget_value\x18\x05 \x01(\v2\x16.google.protobuf.ValueR\vtargetValue\x129\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x1d\n" +
+	"\aapplied\x18\a \x01(\bB\x03\xe0A\x02R\aapplied\x12\x1b\n" +
+	"\x06reason\x18\b \x01(\tB\x03\xe0A\x02R\x06reasonB\r\n" +
+	"\v_catalog_idB\v\n" +
+	"\t_operator\"\xcd\x01\n" +
 	"\x1eListMetricConfigurationRequest\x12B\n" +
 	"\x17target_of_evaluation_id\x18\n" +
 	" \x01(\tB\v\xe0A\x02\xbaH\x05r\x03\xb0\x01\x01R\x14targetOfEvaluationId\x12\x1b\n" +
@@ -6109,7 +16958,28 @@ const file_api_orchestrator_orchestrator_proto_rawDesc = "" +
 	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x1ap\n" +
 	"\x13ConfigurationsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12C\n" +
-	"\x05value\x18\x02 \x01(\v2-.confirmate.assessment.v1.MetricConfigurationR\x05value:\x028\x01\"\x86\x01\n" +
+	"\x05value\x18\x02 \x01(\v2-.confirmate.assessment.v1.MetricConfigurationR\x05value:\x028\x01\"\x90\x01\n" +
+	"'UpdateCatalogMetricConfigurationRequest\x12e\n" +
+	"\rconfiguration\x18\x01 \x01(\v24.confirmate.assessment.v1.CatalogMetricConfigurationB\t\xe0A\x02\xbaH\x03\xc8\x01\x01R\rconfiguration\"\xbc\x01\n" +
+	"&ListCatalogMetricConfigurationsRequest\x12)\n" +
+	"\n" +
+	"catalog_id\x18\n" +
+	" \x01(\tB\n" +
+	"\xe0A\x02\xbaH\x04r\x02\x10\x01R\tcatalogId\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x12\x19\n" +
+	"\border_by\x18\x03 \x01(\tR\aorderBy\x12\x10\n" +
+	"\x03asc\x18\x04 \x01(\bR\x03asc\"\xaf\x01\n" +
+	"'ListCatalogMetricConfigurationsResponse\x12\\\n" +
+	"\x0econfigurations\x18\x01 \x03(\v24.confirmate.assessment.v1.CatalogMetricConfigurationR\x0econfigurations\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"}\n" +
+	"'RemoveCatalogMetricConfigurationRequest\x12)\n" +
+	"\n" +
+	"catalog_id\x18\x01 \x01(\tB\n" +
+	"\xe0A\x02\xbaH\x04r\x02\x10\x01R\tcatalogId\x12'\n" +
+	"\tmetric_id\x18\x02 \x01(\tB\n" +
+	"\xe0A\x02\xbaH\x04r\x02\x10\x01R\bmetricId\"\x86\x01\n" +
 	"!UpdateMetricImplementationRequest\x12a\n" +
 	"\x0eimplementation\x18\x01 \x01(\v2..confirmate.assessment.v1.MetricImplementationB\t\xe0A\x02\xbaH\x03\xc8\x01\x01R\x0eimplementation\"I\n" +
 	"\x1eGetMetricImplementationRequest\x12'\n" +
@@ -6126,7 +16996,7 @@ const file_api_orchestrator_orchestrator_proto_rawDesc = "" +
 	"operations\x12\x1d\n" +
 	"\n" +
 	"metric_ids\x18\x03 \x03(\tR\tmetricIds\x127\n" +
-	"\x18target_of_evaluation_ids\x18\x04 \x03(\tR\x15targetOfEvaluationIds\"\xaa\t\n" +
+	"\x18target_of_evaluation_ids\x18\x04 \x03(\tR\x15targetOfEvaluationIds\"\xeb\t\n" +
 	"\vChangeEvent\x12k\n" +
 	"\ttimestamp\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampB1\x9a\x84\x9e\x03,gorm:\"serializer:timestamppb;type:timestamp\"R\ttimestamp\x12R\n" +
 	"\bcategory\x18\x02 \x01(\x0e2).confirmate.orchestrator.v1.EventCategoryB\v\xe0A\x02\xbaH\x05\x82\x01\x02\x10\x01R\bcategory\x12W\n" +
@@ -6144,15 +17014,68 @@ const file_api_orchestrator_orchestrator_proto_rawDesc = "" +
 	"\x15metric_implementation\x18\x0f \x01(\v2..confirmate.assessment.v1.MetricImplementationH\x00R\x14metricImplementation\x12U\n" +
 	"\x0fassessment_tool\x18\x10 \x01(\v2*.confirmate.orchestrator.v1.AssessmentToolH\x00R\x0eassessmentTool\x126\n" +
 	"\x04user\x18\x11 \x01(\v2 .confirmate.orchestrator.v1.UserH\x00R\x04user\x12V\n" +
-	"\x10control_in_scope\x18\x12 \x01(\v2*.confirmate.orchestrator.v1.ControlInScopeH\x00R\x0econtrolInScopeB\b\n" +
+	"\x10control_in_scope\x18\x12 \x01(\v2*.confirmate.orchestrator.v1.ControlInScopeH\x00R\x0econtrolInScope\x12?\n" +
+	"\acatalog\x18\x13 \x01(\v2#.confirmate.orchestrator.v1.CatalogH\x00R\acatalogB\b\n" +
 	"\x06entityB\x1a\n" +
-	"\x18_target_of_evaluation_id\"\xc5\x01\n" +
+	"\x18_target_of_evaluation_id\"\xb1\x06\n" +
+	"\aWebhook\x121\n" +
+	"\x02id\x18\x01 \x01(\tB!\xe0A\x02\xbaH\x05r\x03\xb0\x01\x01\x9a\x84\x9e\x03\x11gorm:\"primaryKey\"R\x02id\x12\x1d\n" +
+	"\x03url\x18\x02 \x01(\tB\v\xe0A\x02\xbaH\x05r\x03\x88\x01\x01R\x03url\x12%\n" +
+	"\x06secret\x18\x03 \x01(\tB\r\xe0A\x04\xe0A\x02\xbaH\x04r\x02\x10\x10R\x06secret\x12u\n" +
+	"\n" +
+	"categories\x18\x04 \x03(\x0e2).confirmate.orchestrator.v1.EventCategoryB*\xbaH\f\x92\x01\t\"\a\x82\x01\x04\x10\x01 \x00\x9a\x84\x9e\x03\x16gorm:\"serializer:json\"R\n" +
+	"categories\x12x\n" +
+	"\rrequest_types\x18\x05 \x03(\x0e2'.confirmate.orchestrator.v1.RequestTypeB*\xbaH\f\x92\x01\t\"\a\x82\x01\x04\x10\x01 \x00\x9a\x84\x9e\x03\x16gorm:\"serializer:json\"R\frequestTypes\x12a\n" +
+	"\x18target_of_evaluation_ids\x18\x06 \x03(\tB(\xbaH\n" +
+	"\x92\x01\a\"\x05r\x03\xb0\x01\x01\x9a\x84\x9e\x03\x16gorm:\"serializer:json\"R\x15targetOfEvaluationIds\x12%\n" +
+	"\vdescription\x18\a \x01(\tH\x00R\vdescription\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"created_by\x18\b \x01(\tB\x03\xe0A\x03R\tcreatedBy\x12o\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampB4\xe0A\x03\x9a\x84\x9e\x03,gorm:\"serializer:timestamppb;type:timestamp\"R\tcreatedAt\x12\x8c\x01\n" +
+	"\n" +
+	"deliveries\x18\n" +
+	" \x03(\v2+.confirmate.orchestrator.v1.WebhookDeliveryB?\xe0A\x03\x9a\x84\x9e\x037gorm:\"foreignKey:WebhookId;constraint:OnDelete:CASCADE\"R\n" +
+	"deliveriesB\x0e\n" +
+	"\f_description\"\x9e\x04\n" +
+	"\x0fWebhookDelivery\x121\n" +
+	"\x02id\x18\x01 \x01(\tB!\xe0A\x02\xbaH\x05r\x03\xb0\x01\x01\x9a\x84\x9e\x03\x11gorm:\"primaryKey\"R\x02id\x12*\n" +
+	"\n" +
+	"webhook_id\x18\x02 \x01(\tB\v\xe0A\x02\xbaH\x05r\x03\xb0\x01\x01R\twebhookId\x12J\n" +
+	"\bcategory\x18\x03 \x01(\x0e2).confirmate.orchestrator.v1.EventCategoryB\x03\xe0A\x02R\bcategory\x12O\n" +
+	"\frequest_type\x18\x04 \x01(\x0e2'.confirmate.orchestrator.v1.RequestTypeB\x03\xe0A\x02R\vrequestType\x12 \n" +
+	"\tentity_id\x18\x05 \x01(\tB\x03\xe0A\x02R\bentityId\x12s\n" +
+	"\fdelivered_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampB4\xe0A\x02\x9a\x84\x9e\x03,gorm:\"serializer:timestamppb;type:timestamp\"R\vdeliveredAt\x12\x1d\n" +
+	"\asuccess\x18\a \x01(\bB\x03\xe0A\x02R\asuccess\x12$\n" +
+	"\vstatus_code\x18\b \x01(\x05H\x00R\n" +
+	"statusCode\x88\x01\x01\x12\x19\n" +
+	"\x05error\x18\t \x01(\tH\x01R\x05error\x88\x01\x01B\x0e\n" +
+	"\f_status_codeB\b\n" +
+	"\x06_error\"\xc5\x01\n" +
 	"\x0eAssessmentTool\x12\x18\n" +
 	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x12\x1e\n" +
 	"\x04name\x18\x02 \x01(\tB\n" +
 	"\xe0A\x02\xbaH\x04r\x02\x10\x01R\x04name\x12 \n" +
 	"\vdescription\x18\x03 \x01(\tR\vdescription\x12W\n" +
-	"\x11available_metrics\x18\x04 \x03(\tB*\xe0A\x02\xbaH\t\x92\x01\x06\"\x04r\x02\x10\x01\x9a\x84\x9e\x03\x16gorm:\"serializer:json\"R\x10availableMetrics\"\xe8\f\n" +
+	"\x11available_metrics\x18\x04 \x03(\tB*\xe0A\x02\xbaH\t\x92\x01\x06\"\x04r\x02\x10\x01\x9a\x84\x9e\x03\x16gorm:\"serializer:json\"R\x10availableMetrics\"\xe9\x03\n" +
+	"\rMetadataField\x12E\n" +
+	"\x03key\x18\x01 \x01(\tB3\xe0A\x02\xbaH\x17r\x15\x18@2\x11^[a-z][a-z0-9_]*$\x9a\x84\x9e\x03\x11gorm:\"primaryKey\"R\x03key\x12!\n" +
+	"\fdisplay_name\x18\x02 \x01(\tR\vdisplayName\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12P\n" +
+	"\x04type\x18\x04 \x01(\x0e2-.confirmate.orchestrator.v1.MetadataFieldTypeB\r\xe0A\x02\xbaH\a\x82\x01\x04\x10\x01 \x00R\x04type\x12\x1a\n" +
+	"\brequired\x18\x05 \x01(\bR\brequired\x12B\n" +
+	"\x0eallowed_values\x18\x06 \x03(\tB\x1b\x9a\x84\x9e\x03\x16gorm:\"serializer:json\"R\rallowedValues\x12\x1d\n" +
+	"\apattern\x18\a \x01(\tH\x00R\apattern\x88\x01\x01\x12o\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampB4\xe0A\x03\x9a\x84\x9e\x03,gorm:\"serializer:timestamppb;type:timestamp\"R\tupdatedAtB\n" +
+	"\n" +
+	"\b_pattern\"\xf1\x02\n" +
+	"\rRedactionRule\x12[\n" +
+	"\rresource_type\x18\x01 \x01(\tB6\xe0A\x02\xbaH\x1ar\x18\x18\x80\x012\x13^[A-Z][A-Za-z0-9]*$\x9a\x84\x9e\x03\x11gorm:\"primaryKey\"R\fresourceType\x12D\n" +
+	"\x06fields\x18\x02 \x03(\tB,\xe0A\x02\xbaH\v\x92\x01\b\b\x01\"\x04r\x02\x10\x01\x9a\x84\x9e\x03\x16gorm:\"serializer:json\"R\x06fields\x12L\n" +
+	"\x04mode\x18\x03 \x01(\x0e2).confirmate.orchestrator.v1.RedactionModeB\r\xe0A\x02\xbaH\a\x82\x01\x04\x10\x01 \x00R\x04mode\x12o\n" +
+	"\n" +
+	"updated_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampB4\xe0A\x03\x9a\x84\x9e\x03,gorm:\"serializer:timestamppb;type:timestamp\"R\tupdatedAt\"\x99\x0e\n" +
 	"\x12TargetOfEvaluation\x12\x1b\n" +
 	"\x02id\x18\x01 \x01(\tB\v\xe0A\x02\xbaH\x05r\x03\xb0\x01\x01R\x02id\x12\x1e\n" +
 	"\x04name\x18\x02 \x01(\tB\n" +
@@ -6167,12 +17090,16 @@ const file_api_orchestrator_orchestrator_proto_rawDesc = "" +
 	" \x01(\v27.confirmate.orchestrator.v1.TargetOfEvaluation.MetadataB\x1b\x9a\x84\x9e\x03\x16gorm:\"serializer:json\"H\x02R\bmetadata\x88\x01\x01\x12g\n" +
 	"\vtarget_type\x18\v \x01(\x0e29.confirmate.orchestrator.v1.TargetOfEvaluation.TargetTypeB\v\xe0A\x02\xbaH\x05\x82\x01\x02\x10\x01R\n" +
 	"targetType\x12\x81\x01\n" +
-	"\forganization\x18\x0f \x01(\v2;.confirmate.orchestrator.v1.TargetOfEvaluation.OrganizationB\x1b\x9a\x84\x9e\x03\x16gorm:\"serializer:json\"H\x03R\forganization\x88\x01\x01\x1a\xc4\x01\n" +
+	"\forganization\x18\x0f \x01(\v2;.confirmate.orchestrator.v1.TargetOfEvaluation.OrganizationB\x1b\x9a\x84\x9e\x03\x16gorm:\"serializer:json\"H\x03R\forganization\x88\x01\x01\x1a\xf5\x02\n" +
 	"\bMetadata\x12[\n" +
 	"\x06labels\x18\x01 \x03(\v2C.confirmate.orchestrator.v1.TargetOfEvaluation.Metadata.LabelsEntryR\x06labels\x12\x17\n" +
-	"\x04icon\x18\x02 \x01(\tH\x00R\x04icon\x88\x01\x01\x1a9\n" +
+	"\x04icon\x18\x02 \x01(\tH\x00R\x04icon\x88\x01\x01\x12n\n" +
+	"\rcustom_fields\x18\x03 \x03(\v2I.confirmate.orchestrator.v1.TargetOfEvaluation.Metadata.CustomFieldsEntryR\fcustomFields\x1a9\n" +
 	"\vLabelsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a?\n" +
+	"\x11CustomFieldsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\a\n" +
 	"\x05_icon\x1a\xee\x02\n" +
 	"\fOrganization\x12\x12\n" +
@@ -6196,7 +17123,7 @@ const file_api_orchestrator_orchestrator_proto_rawDesc = "" +
 	"\v_created_atB\r\n" +
 	"\v_updated_atB\v\n" +
 	"\t_metadataB\x0f\n" +
-	"\r_organizationJ\x04\b\f\x10\rJ\x04\b\r\x10\x0eJ\x04\b\x0e\x10\x0fR\areadersR\fcontributorsR\x06admins\"\x9c\x04\n" +
+	"\r_organizationJ\x04\b\f\x10\rJ\x04\b\r\x10\x0eJ\x04\b\x0e\x10\x0fR\areadersR\fcontributorsR\x06admins\"\xe7\f\n" +
 	"\aCatalog\x12\x1a\n" +
 	"\x02id\x18\x01 \x01(\tB\n" +
 	"\xe0A\x02\xbaH\x04r\x02\x10\x01R\x02id\x12\x1e\n" +
@@ -6211,17 +17138,123 @@ const file_api_orchestrator_orchestrator_proto_rawDesc = "" +
 	"\x10assurance_levels\x18\a \x03(\tB\x1b\x9a\x84\x9e\x03\x16gorm:\"serializer:json\"R\x0fassuranceLevels\x12\"\n" +
 	"\n" +
 	"short_name\x18\t \x01(\tB\x03\xe0A\x02R\tshortName\x12j\n" +
-	"\bmetadata\x18\x06 \x01(\v2,.confirmate.orchestrator.v1.Catalog.MetadataB\x1b\x9a\x84\x9e\x03\x16gorm:\"serializer:json\"H\x00R\bmetadata\x88\x01\x01\x1a/\n" +
+	"\bmetadata\x18\x06 \x01(\v2,.confirmate.orchestrator.v1.Catalog.MetadataB\x1b\x9a\x84\x9e\x03\x16gorm:\"serializer:json\"H\x00R\bmetadata\x88\x01\x01\x12F\n" +
+	"\x06status\x18\n" +
+	" \x01(\x0e2).confirmate.orchestrator.v1.CatalogStatusB\x03\xe0A\x03R\x06status\x12\x1d\n" +
+	"\aversion\x18\v \x01(\x05B\x03\xe0A\x03R\aversion\x12#\n" +
+	"\bdraft_id\x18\f \x01(\tB\x03\xe0A\x03H\x01R\adraftId\x88\x01\x01\x12w\n" +
+	"\x0esla_thresholds\x18\r \x03(\v2(.confirmate.orchestrator.v1.SlaThresholdB&\xbaH\b\x92\x01\x05\"\x03\xc8\x01\x01\x9a\x84\x9e\x03\x16gorm:\"serializer:json\"R\rslaThresholds\x12\x9f\x01\n" +
+	"\x13applicability_rules\x18\x0e \x03(\v2-.confirmate.orchestrator.v1.ApplicabilityRuleB?\xe0A\x03\x9a\x84\x9e\x037gorm:\"foreignKey:CatalogId;constraint:OnDelete:CASCADE\"R\x12applicabilityRules\x12\xe4\x01\n" +
+	"\x0fcustom_statuses\x18\x0f \x03(\v2(.confirmate.orchestrator.v1.CustomStatusB\x90\x01\xbaHr\xba\x01g\n" +
+	"\x1acustom_status_names_unique\x12+the names of custom statuses must be unique\x1a\x1cthis.map(s, s.name).unique()\x92\x01\x05\"\x03\xc8\x01\x01\x9a\x84\x9e\x03\x16gorm:\"serializer:json\"R\x0ecustomStatuses\x12+\n" +
+	"\fcontent_hash\x18\x10 \x01(\tB\x03\xe0A\x03H\x02R\vcontentHash\x88\x01\x01\x12<\n" +
+	"\x18auto_create_audit_scopes\x18\x11 \x01(\bH\x03R\x15autoCreateAuditScopes\x88\x01\x01\x12T\n" +
+	" auto_audit_scope_assurance_level\x18\x12 \x01(\tB\a\xbaH\x04r\x02\x10\x01H\x04R\x1cautoAuditScopeAssuranceLevel\x88\x01\x01\x12\x81\x01\n" +
+	"\x13evaluation_defaults\x18\x13 \x01(\v2..confirmate.orchestrator.v1.EvaluationDefaultsB\x1b\x9a\x84\x9e\x03\x16gorm:\"serializer:json\"H\x05R\x12evaluationDefaults\x88\x01\x01\x1a/\n" +
 	"\bMetadata\x12\x19\n" +
 	"\x05color\x18\x03 \x01(\tH\x00R\x05color\x88\x01\x01B\b\n" +
 	"\x06_colorB\v\n" +
-	"\t_metadata\"\x85\x03\n" +
+	"\t_metadataB\v\n" +
+	"\t_draft_idB\x0f\n" +
+	"\r_content_hashB\x1b\n" +
+	"\x19_auto_create_audit_scopesB#\n" +
+	"!_auto_audit_scope_assurance_levelB\x16\n" +
+	"\x14_evaluation_defaults\"\x92\x02\n" +
+	"\x12EvaluationDefaults\x12(\n" +
+	"\binterval\x18\x01 \x01(\x05B\a\xbaH\x04\x1a\x02 \x00H\x00R\binterval\x88\x01\x01\x12?\n" +
+	"\x15max_evidence_age_days\x18\x02 \x01(\rB\a\xbaH\x04*\x02 \x00H\x01R\x12maxEvidenceAgeDays\x88\x01\x01\x12j\n" +
+	"\x14aggregation_strategy\x18\x03 \x01(\x0e2-.confirmate.evaluation.v1.AggregationStrategyB\b\xbaH\x05\x82\x01\x02\x10\x01R\x13aggregationStrategyB\v\n" +
+	"\t_intervalB\x18\n" +
+	"\x16_max_evidence_age_days\"\xcc\x02\n" +
+	"\fCustomStatus\x12/\n" +
+	"\x04name\x18\x01 \x01(\tB\x1b\xe0A\x02\xbaH\x15r\x132\x11^[A-Z][A-Z0-9_]*$R\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12Q\n" +
+	"\x06status\x18\x03 \x01(\x0e2*.confirmate.evaluation.v1.EvaluationStatusB\r\xe0A\x02\xbaH\a\x82\x01\x04\x10\x01 \x00R\x06status\x12\x1e\n" +
+	"\n" +
+	"precedence\x18\x04 \x01(\x05R\n" +
+	"precedence\x12a\n" +
+	"\x10aggregation_rule\x18\x05 \x01(\v21.confirmate.orchestrator.v1.StatusAggregationRuleH\x00R\x0faggregationRule\x88\x01\x01B\x13\n" +
+	"\x11_aggregation_rule\"\xc0\x03\n" +
+	"\x15StatusAggregationRule\x12L\n" +
+	"\x13min_compliant_share\x18\x01 \x01(\x01B\x17\xbaH\x14\x12\x12\x19\x00\x00\x00\x00\x00\x00\xf0?)\x00\x00\x00\x00\x00\x00\x00\x00H\x00R\x11minCompliantShare\x88\x01\x01\x12L\n" +
+	"\x13max_compliant_share\x18\x02 \x01(\x01B\x17\xbaH\x14\x12\x12\x19\x00\x00\x00\x00\x00\x00\xf0?)\x00\x00\x00\x00\x00\x00\x00\x00H\x01R\x11maxCompliantShare\x88\x01\x01:\xda\x01\xbaH\xd6\x01\x1a\xd3\x01\n" +
+	"\x15compliant_share_range\x12@min_compliant_share must not be greater than max_compliant_share\x1ax!has(this.min_compliant_share) || !has(this.max_compliant_share) || this.min_compliant_share <= this.max_compliant_shareB\x16\n" +
+	"\x14_min_compliant_shareB\x16\n" +
+	"\x14_max_compliant_share\"\xbd\x01\n" +
+	"\x0eAssuranceLevel\x12?\n" +
+	"\n" +
+	"catalog_id\x18\x01 \x01(\tB \xe0A\x02\xbaH\x04r\x02\x10\x01\x9a\x84\x9e\x03\x11gorm:\"primaryKey\"R\tcatalogId\x124\n" +
+	"\x04name\x18\x02 \x01(\tB \xe0A\x02\xbaH\x04r\x02\x10\x01\x9a\x84\x9e\x03\x11gorm:\"primaryKey\"R\x04name\x12\x12\n" +
+	"\x04rank\x18\x03 \x01(\rR\x04rank\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\"\xa0\x02\n" +
+	"\x11ApplicabilityRule\x12?\n" +
+	"\n" +
+	"catalog_id\x18\x01 \x01(\tB \xe0A\x02\xbaH\x04r\x02\x10\x01\x9a\x84\x9e\x03\x11gorm:\"primaryKey\"R\tcatalogId\x12N\n" +
+	"\x12control_short_name\x18\x02 \x01(\tB \xe0A\x02\xbaH\x04r\x02\x10\x01\x9a\x84\x9e\x03\x11gorm:\"primaryKey\"R\x10controlShortName\x12T\n" +
+	"\x10assurance_levels\x18\x03 \x03(\tB)\xbaH\v\x92\x01\b\x18\x01\"\x04r\x02\x10\x01\x9a\x84\x9e\x03\x16gorm:\"serializer:json\"R\x0fassuranceLevels\x12$\n" +
+	"\rjustification\x18\x04 \x01(\tR\rjustification\"\xfe\x04\n" +
+	"\rCatalogSource\x12)\n" +
+	"\x02id\x18\x01 \x01(\tB\x19\xe0A\x03\x9a\x84\x9e\x03\x11gorm:\"primaryKey\"R\x02id\x12\x1d\n" +
+	"\x03url\x18\x02 \x01(\tB\v\xe0A\x02\xbaH\x05r\x03\x88\x01\x01R\x03url\x12V\n" +
+	"\x06format\x18\x03 \x01(\x0e2/.confirmate.orchestrator.v1.CatalogSourceFormatB\r\xe0A\x02\xbaH\a\x82\x01\x04\x10\x01 \x00R\x06format\x12>\n" +
+	"\x15sync_interval_minutes\x18\x04 \x01(\rB\n" +
+	"\xe0A\x02\xbaH\x04*\x02 \x00R\x13syncIntervalMinutes\x12!\n" +
+	"\fauto_publish\x18\x05 \x01(\bR\vautoPublish\x12{\n" +
+	"\x0elast_synced_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampB4\xe0A\x03\x9a\x84\x9e\x03,gorm:\"serializer:timestamppb;type:timestamp\"H\x00R\flastSyncedAt\x88\x01\x01\x12\x1b\n" +
+	"\x06digest\x18\a \x01(\tB\x03\xe0A\x03R\x06digest\x12'\n" +
+	"\n" +
+	"last_error\x18\b \x01(\tB\x03\xe0A\x03H\x01R\tlastError\x88\x01\x01\x12s\n" +
+	"\vlast_report\x18\t \x01(\v2-.confirmate.orchestrator.v1.CatalogSyncReportB\x1e\xe0A\x03\x9a\x84\x9e\x03\x16gorm:\"serializer:json\"H\x02R\n" +
+	"lastReport\x88\x01\x01B\x11\n" +
+	"\x0f_last_synced_atB\r\n" +
+	"\v_last_errorB\x0e\n" +
+	"\f_last_report\"\x8f\x01\n" +
+	"\x11CatalogSyncReport\x12\x1b\n" +
+	"\tsource_id\x18\x01 \x01(\tR\bsourceId\x12\x18\n" +
+	"\achanged\x18\x02 \x01(\bR\achanged\x12C\n" +
+	"\bcatalogs\x18\x03 \x03(\v2'.confirmate.orchestrator.v1.CatalogDiffR\bcatalogs\"\x86\x02\n" +
+	"\vCatalogDiff\x12\x1d\n" +
+	"\n" +
+	"catalog_id\x18\x01 \x01(\tR\tcatalogId\x12\x18\n" +
+	"\acreated\x18\x02 \x01(\bR\acreated\x12%\n" +
+	"\x0eadded_controls\x18\x03 \x03(\tR\raddedControls\x12)\n" +
+	"\x10removed_controls\x18\x04 \x03(\tR\x0fremovedControls\x12)\n" +
+	"\x10changed_controls\x18\x05 \x03(\tR\x0fchangedControls\x12\x1c\n" +
+	"\tpublished\x18\x06 \x01(\bR\tpublished\x12\x19\n" +
+	"\x05error\x18\a \x01(\tH\x00R\x05error\x88\x01\x01B\b\n" +
+	"\x06_error\"\xc7\x04\n" +
+	"\x10CatalogChangelog\x12)\n" +
+	"\x02id\x18\x01 \x01(\tB\x19\xe0A\x03\x9a\x84\x9e\x03\x11gorm:\"primaryKey\"R\x02id\x123\n" +
+	"\n" +
+	"catalog_id\x18\x02 \x01(\tB\x14\xe0A\x03\x9a\x84\x9e\x03\fgorm:\"index\"R\tcatalogId\x12R\n" +
+	"\atrigger\x18\x03 \x01(\x0e23.confirmate.orchestrator.v1.CatalogChangelogTriggerB\x03\xe0A\x03R\atrigger\x128\n" +
+	"\x13previous_version_id\x18\x04 \x01(\tB\x03\xe0A\x03H\x00R\x11previousVersionId\x88\x01\x01\x12'\n" +
+	"\n" +
+	"version_id\x18\x05 \x01(\tB\x03\xe0A\x03H\x01R\tversionId\x88\x01\x01\x12o\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampB4\xe0A\x03\x9a\x84\x9e\x03,gorm:\"serializer:timestamppb;type:timestamp\"R\tcreatedAt\x12c\n" +
+	"\achanges\x18\a \x03(\v2).confirmate.orchestrator.v1.ControlChangeB\x1e\xe0A\x03\x9a\x84\x9e\x03\x16gorm:\"serializer:json\"R\achanges\x12\x1f\n" +
+	"\bmarkdown\x18\b \x01(\tB\x03\xe0A\x03R\bmarkdownB\x16\n" +
+	"\x14_previous_version_idB\r\n" +
+	"\v_version_id\"\x98\x02\n" +
+	"\rControlChange\x12,\n" +
+	"\x12control_short_name\x18\x01 \x01(\tR\x10controlShortName\x12!\n" +
+	"\fcontrol_name\x18\x02 \x01(\tR\vcontrolName\x12A\n" +
+	"\x04type\x18\x03 \x01(\x0e2-.confirmate.orchestrator.v1.ControlChangeTypeR\x04type\x12%\n" +
+	"\x0echanged_fields\x18\x04 \x03(\tR\rchangedFields\x12#\n" +
+	"\radded_metrics\x18\x05 \x03(\tR\faddedMetrics\x12'\n" +
+	"\x0fremoved_metrics\x18\x06 \x03(\tR\x0eremovedMetrics\"\x80\x01\n" +
+	"\fSlaThreshold\x125\n" +
+	"\x0fassurance_level\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01H\x00R\x0eassuranceLevel\x88\x01\x01\x12%\n" +
+	"\bmax_days\x18\x02 \x01(\rB\n" +
+	"\xe0A\x02\xbaH\x04*\x02 \x00R\amaxDaysB\x12\n" +
+	"\x10_assurance_level\"\x85\x03\n" +
 	"\bCategory\x124\n" +
 	"\x04name\x18\x01 \x01(\tB \xe0A\x02\xbaH\x04r\x02\x10\x01\x9a\x84\x9e\x03\x11gorm:\"primaryKey\"R\x04name\x12?\n" +
 	"\n" +
 	"catalog_id\x18\x02 \x01(\tB \xe0A\x02\xbaH\x04r\x02\x10\x01\x9a\x84\x9e\x03\x11gorm:\"primaryKey\"R\tcatalogId\x12 \n" +
 	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\xdf\x01\n" +
-	"\bcontrols\x18\x04 \x03(\v2#.confirmate.orchestrator.v1.ControlB\x9d\x01\xe0A\x02\xbaH\b\x92\x01\x05\"\x03\xc8\x01\x01\x9a\x84\x9e\x03\x89\x01gorm:\"many2many:category_controls;joinForeignKey:category_name,category_catalog_id;joinReferences:control_id;constraint:OnDelete:CASCADE\"R\bcontrols\"\xbb\x06\n" +
+	"\bcontrols\x18\x04 \x03(\v2#.confirmate.orchestrator.v1.ControlB\x9d\x01\xe0A\x02\xbaH\b\x92\x01\x05\"\x03\xc8\x01\x01\x9a\x84\x9e\x03\x89\x01gorm:\"many2many:category_controls;joinForeignKey:category_name,category_catalog_id;joinReferences:control_id;constraint:OnDelete:CASCADE\"R\bcontrols\"\xaf\t\n" +
 	"\aControl\x121\n" +
 	"\x02id\x18\x01 \x01(\tB!\xe0A\x02\xbaH\x05r\x03\xb0\x01\x01\x9a\x84\x9e\x03\x11gorm:\"primaryKey\"R\x02id\x12\x17\n" +
 	"\x04name\x18\x04 \x01(\tB\x03\xe0A\x02R\x04name\x12 \n" +
@@ -6235,11 +17268,45 @@ const file_api_orchestrator_orchestrator_proto_rawDesc = "" +
 	"\x11controls_in_scope\x18\r \x03(\v2*.confirmate.orchestrator.v1.ControlInScopeB=\x9a\x84\x9e\x038gorm:\"foreignKey:ControlId;constraint:OnDelete:RESTRICT\"R\x0fcontrolsInScope\x12)\n" +
 	"\n" +
 	"catalog_id\x18\x0e \x01(\tB\n" +
-	"\xe0A\x02\xbaH\x04r\x02\x10\x01R\tcatalogIdB\x14\n" +
+	"\xe0A\x02\xbaH\x04r\x02\x10\x01R\tcatalogId\x12t\n" +
+	"\n" +
+	"references\x18\x0f \x03(\v2,.confirmate.orchestrator.v1.ControlReferenceB&\xbaH\b\x92\x01\x05\"\x03\xc8\x01\x01\x9a\x84\x9e\x03\x16gorm:\"serializer:json\"R\n" +
+	"references\x12&\n" +
+	"\ftext_version\x18\x10 \x01(\x05B\x03\xe0A\x03R\vtextVersion\x12?\n" +
+	"\x15max_evidence_age_days\x18\x11 \x01(\rB\a\xbaH\x04*\x02 \x00H\x02R\x12maxEvidenceAgeDays\x88\x01\x01\x12i\n" +
+	"\vremediation\x18\x12 \x01(\v2%.confirmate.assessment.v1.RemediationB\x1b\x9a\x84\x9e\x03\x16gorm:\"serializer:json\"H\x03R\vremediation\x88\x01\x01B\x14\n" +
 	"\x12_parent_control_idB\x12\n" +
-	"\x10_assurance_levelJ\x04\b\x02\x10\x03J\x04\b\x03\x10\x04J\x04\b\t\x10\n" +
+	"\x10_assurance_levelB\x18\n" +
+	"\x16_max_evidence_age_daysB\x0e\n" +
+	"\f_remediationJ\x04\b\x02\x10\x03J\x04\b\x03\x10\x04J\x04\b\t\x10\n" +
 	"J\x04\b\n" +
-	"\x10\v\"\xcd\x05\n" +
+	"\x10\v\"\x88\x01\n" +
+	"\x10ControlReference\x12 \n" +
+	"\x05title\x18\x01 \x01(\tB\n" +
+	"\xe0A\x02\xbaH\x04r\x02\x10\x01R\x05title\x12\x1d\n" +
+	"\asection\x18\x02 \x01(\tH\x00R\asection\x88\x01\x01\x12\x1f\n" +
+	"\x03url\x18\x03 \x01(\tB\b\xbaH\x05r\x03\x88\x01\x01H\x01R\x03url\x88\x01\x01B\n" +
+	"\n" +
+	"\b_sectionB\x06\n" +
+	"\x04_url\"\xf5\x03\n" +
+	"\x12ControlTextVersion\x12?\n" +
+	"\n" +
+	"catalog_id\x18\x01 \x01(\tB \xe0A\x02\xbaH\x04r\x02\x10\x01\x9a\x84\x9e\x03\x11gorm:\"primaryKey\"R\tcatalogId\x12C\n" +
+	"\fcontrol_path\x18\x02 \x01(\tB \xe0A\x02\xbaH\x04r\x02\x10\x01\x9a\x84\x9e\x03\x11gorm:\"primaryKey\"R\vcontrolPath\x12G\n" +
+	"\aversion\x18\x03 \x01(\x05B-\xe0A\x02\x9a\x84\x9e\x03%gorm:\"primaryKey;autoIncrement:false\"R\aversion\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescription\x12i\n" +
+	"\n" +
+	"references\x18\x06 \x03(\v2,.confirmate.orchestrator.v1.ControlReferenceB\x1b\x9a\x84\x9e\x03\x16gorm:\"serializer:json\"R\n" +
+	"references\x12o\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampB4\xe0A\x03\x9a\x84\x9e\x03,gorm:\"serializer:timestamppb;type:timestamp\"R\tcreatedAt\"\xcb\x01\n" +
+	"\rControlMetric\x12@\n" +
+	"\n" +
+	"control_id\x18\x01 \x01(\tB!\xe0A\x02\xbaH\x05r\x03\xb0\x01\x01\x9a\x84\x9e\x03\x11gorm:\"primaryKey\"R\tcontrolId\x12>\n" +
+	"\tmetric_id\x18\x02 \x01(\tB!\xe0A\x02\xbaH\x05r\x03\xb0\x01\x01\x9a\x84\x9e\x03\x11gorm:\"primaryKey\"R\bmetricId\x12-\n" +
+	"\x06weight\x18\x03 \x01(\x01B\x10\xbaH\r\x12\v@\x01)\x00\x00\x00\x00\x00\x00\x00\x00H\x00R\x06weight\x88\x01\x01B\t\n" +
+	"\a_weight\"\xc7\b\n" +
 	"\n" +
 	"AuditScope\x121\n" +
 	"\x02id\x18\x04 \x01(\tB!\xe0A\x02\xbaH\x05r\x03\xb0\x01\x01\x9a\x84\x9e\x03\x11gorm:\"primaryKey\"R\x02id\x12\x1e\n" +
@@ -6253,19 +17320,42 @@ const file_api_orchestrator_orchestrator_proto_rawDesc = "" +
 	"\x06status\x18\t \x01(\x0e2,.confirmate.orchestrator.v1.AuditScopeStatusB\r\xe0A\x02\xbaH\a\x82\x01\x04\x10\x01 \x00R\x06status\x12\x97\x01\n" +
 	"\x11controls_in_scope\x18\n" +
 	" \x03(\v2*.confirmate.orchestrator.v1.ControlInScopeB?\x9a\x84\x9e\x03:gorm:\"foreignKey:AuditScopeId;constraint:OnDelete:CASCADE\"R\x0fcontrolsInScope\x12\x9a\x01\n" +
-	"\x12audit_trail_events\x18\v \x03(\v2+.confirmate.orchestrator.v1.AuditTrailEventB?\x9a\x84\x9e\x03:gorm:\"foreignKey:AuditScopeId;constraint:OnDelete:CASCADE\"R\x10auditTrailEventsB\x12\n" +
-	"\x10_assurance_levelJ\x04\b\x06\x10\aJ\x04\b\a\x10\bJ\x04\b\b\x10\tR\areadersR\fcontributorsR\x06admins\"6\n" +
+	"\x12audit_trail_events\x18\v \x03(\v2+.confirmate.orchestrator.v1.AuditTrailEventB?\x9a\x84\x9e\x03:gorm:\"foreignKey:AuditScopeId;constraint:OnDelete:CASCADE\"R\x10auditTrailEvents\x12\x9f\x01\n" +
+	"\x13maintenance_windows\x18\x0e \x03(\v2-.confirmate.orchestrator.v1.MaintenanceWindowB?\x9a\x84\x9e\x03:gorm:\"foreignKey:AuditScopeId;constraint:OnDelete:CASCADE\"R\x12maintenanceWindows\x12_\n" +
+	"\x16additional_catalog_ids\x18\f \x03(\tB)\xbaH\v\x92\x01\b\x18\x01\"\x04r\x02\x10\x01\x9a\x84\x9e\x03\x16gorm:\"serializer:json\"R\x14additionalCatalogIds\x12*\n" +
+	"\x06locale\x18\r \x01(\tB\r\xbaH\n" +
+	"r\bR\x02enR\x02deH\x01R\x06locale\x88\x01\x01\x12.\n" +
+	"\venvironment\x18\x0f \x01(\tB\a\xbaH\x04r\x02\x10\x01H\x02R\venvironment\x88\x01\x01B\x12\n" +
+	"\x10_assurance_levelB\t\n" +
+	"\a_localeB\x0e\n" +
+	"\f_environmentJ\x04\b\x06\x10\aJ\x04\b\a\x10\bJ\x04\b\b\x10\tR\areadersR\fcontributorsR\x06admins\"\xdc\x05\n" +
+	"\x11MaintenanceWindow\x121\n" +
+	"\x02id\x18\x01 \x01(\tB!\xe0A\x02\xbaH\x05r\x03\xb0\x01\x01\x9a\x84\x9e\x03\x11gorm:\"primaryKey\"R\x02id\x121\n" +
+	"\x0eaudit_scope_id\x18\x02 \x01(\tB\v\xe0A\x02\xbaH\x05r\x03\xb0\x01\x01R\fauditScopeId\x12s\n" +
+	"\tstarts_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB:\xe0A\x02\xbaH\x03\xc8\x01\x01\x9a\x84\x9e\x03,gorm:\"serializer:timestamppb;type:timestamp\"R\bstartsAt\x12o\n" +
+	"\aends_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampB:\xe0A\x02\xbaH\x03\xc8\x01\x01\x9a\x84\x9e\x03,gorm:\"serializer:timestamppb;type:timestamp\"R\x06endsAt\x12N\n" +
+	"\x04mode\x18\x05 \x01(\x0e2+.confirmate.orchestrator.v1.MaintenanceModeB\r\xe0A\x02\xbaH\a\x82\x01\x04\x10\x01 \x00R\x04mode\x12%\n" +
+	"\vdescription\x18\x06 \x01(\tH\x00R\vdescription\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"created_by\x18\a \x01(\tB\x03\xe0A\x03R\tcreatedBy\x12o\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampB4\xe0A\x03\x9a\x84\x9e\x03,gorm:\"serializer:timestamppb;type:timestamp\"R\tcreatedAt:_\xbaH\\\x1aZ\n" +
+	"\x18maintenance_window_range\x12\x1fends_at must be after starts_at\x1a\x1dthis.ends_at > this.starts_atB\x0e\n" +
+	"\f_description\"6\n" +
 	"\x1aGetAssessmentResultRequest\x12\x18\n" +
-	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\"\xfb\x05\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\"\x92\n" +
+	"\n" +
 	"\x1cListAssessmentResultsRequest\x12\\\n" +
 	"\x06filter\x18\x01 \x01(\v2?.confirmate.orchestrator.v1.ListAssessmentResultsRequest.FilterH\x00R\x06filter\x88\x01\x01\x126\n" +
-	"\x15latest_by_resource_id\x18\x02 \x01(\bH\x01R\x12latestByResourceId\x88\x01\x01\x12\x1b\n" +
+	"\x15latest_by_resource_id\x18\x02 \x01(\bH\x01R\x12latestByResourceId\x88\x01\x01\x124\n" +
+	"\x05as_of\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampH\x02R\x04asOf\x88\x01\x01\x12U\n" +
+	"\bsampling\x18\x04 \x01(\v24.confirmate.orchestrator.v1.AssessmentResultSamplingH\x03R\bsampling\x88\x01\x01\x12\x1b\n" +
 	"\tpage_size\x18\n" +
 	" \x01(\x05R\bpageSize\x12\x1d\n" +
 	"\n" +
 	"page_token\x18\v \x01(\tR\tpageToken\x12\x19\n" +
 	"\border_by\x18\f \x01(\tR\aorderBy\x12\x10\n" +
-	"\x03asc\x18\r \x01(\bR\x03asc\x1a\xb6\x03\n" +
+	"\x03asc\x18\r \x01(\bR\x03asc\x1a\x9a\x05\n" +
 	"\x06Filter\x12D\n" +
 	"\x17target_of_evaluation_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01H\x00R\x14targetOfEvaluationId\x88\x01\x01\x12!\n" +
 	"\tcompliant\x18\x02 \x01(\bH\x01R\tcompliant\x88\x01\x01\x12+\n" +
@@ -6275,7 +17365,12 @@ const file_api_orchestrator_orchestrator_proto_rawDesc = "" +
 	"\atool_id\x18\x05 \x01(\tB\a\xbaH\x04r\x02\x10\x01H\x03R\x06toolId\x88\x01\x01\x12@\n" +
 	"\x15assessment_result_ids\x18\x06 \x03(\tB\f\xbaH\t\x92\x01\x06\"\x04r\x02\x10\x01R\x13assessmentResultIds\x12.\n" +
 	"\vevidence_id\x18\a \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01H\x04R\n" +
-	"evidenceId\x88\x01\x01B\x1a\n" +
+	"evidenceId\x88\x01\x01\x12\"\n" +
+	"\x05owner\x18\b \x01(\tB\a\xbaH\x04r\x02\x10\x01H\x05R\x05owner\x88\x01\x01\x12 \n" +
+	"\x04team\x18\t \x01(\tB\a\xbaH\x04r\x02\x10\x01H\x06R\x04team\x88\x01\x01\x12.\n" +
+	"\venvironment\x18\n" +
+	" \x01(\tB\a\xbaH\x04r\x02\x10\x01H\aR\venvironment\x88\x01\x01\x122\n" +
+	"\x12externally_sourced\x18\v \x01(\bH\bR\x11externallySourced\x88\x01\x01B\x1a\n" +
 	"\x18_target_of_evaluation_idB\f\n" +
 	"\n" +
 	"_compliantB\f\n" +
@@ -6283,12 +17378,27 @@ const file_api_orchestrator_orchestrator_proto_rawDesc = "" +
 	"_metric_idB\n" +
 	"\n" +
 	"\b_tool_idB\x0e\n" +
-	"\f_evidence_idB\t\n" +
+	"\f_evidence_idB\b\n" +
+	"\x06_ownerB\a\n" +
+	"\x05_teamB\x0e\n" +
+	"\f_environmentB\x15\n" +
+	"\x13_externally_sourced:\x8c\x01\xbaH\x88\x01\x1a\x85\x01\n" +
+	"'sampling_requires_latest_by_resource_id\x12'sampling requires latest_by_resource_id\x1a1!has(this.sampling) || this.latest_by_resource_idB\t\n" +
 	"\a_filterB\x18\n" +
-	"\x16_latest_by_resource_id\"\x8d\x01\n" +
+	"\x16_latest_by_resource_idB\b\n" +
+	"\x06_as_ofB\v\n" +
+	"\t_sampling\"\xcf\x01\n" +
 	"\x1dListAssessmentResultsResponse\x12D\n" +
 	"\aresults\x18\x01 \x03(\v2*.confirmate.assessment.v1.AssessmentResultR\aresults\x12&\n" +
-	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"m\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12,\n" +
+	"\x0fpopulation_size\x18\x03 \x01(\x03H\x00R\x0epopulationSize\x88\x01\x01B\x12\n" +
+	"\x10_population_size\"\xb2\x01\n" +
+	"\x18AssessmentResultSampling\x12U\n" +
+	"\bstrategy\x18\x01 \x01(\x0e2*.confirmate.evaluation.v1.SamplingStrategyB\r\xe0A\x02\xbaH\a\x82\x01\x04\x10\x01 \x00R\bstrategy\x12+\n" +
+	"\vsample_size\x18\x02 \x01(\x05B\n" +
+	"\xe0A\x02\xbaH\x04\x1a\x02 \x00R\n" +
+	"sampleSize\x12\x12\n" +
+	"\x04seed\x18\x03 \x01(\tR\x04seed\"m\n" +
 	"\x17CreateAuditScopeRequest\x12R\n" +
 	"\vaudit_scope\x18\x01 \x01(\v2&.confirmate.orchestrator.v1.AuditScopeB\t\xe0A\x02\xbaH\x03\xc8\x01\x01R\n" +
 	"auditScope\"\x88\x01\n" +
@@ -6296,7 +17406,7 @@ const file_api_orchestrator_orchestrator_proto_rawDesc = "" +
 	"\x0eaudit_scope_id\x18\x01 \x01(\tB\v\xe0A\x02\xbaH\x05r\x03\xb0\x01\x01R\fauditScopeId\x12:\n" +
 	"\x19remove_evaluation_results\x18\x02 \x01(\bR\x17removeEvaluationResults\"I\n" +
 	"\x14GetAuditScopeRequest\x121\n" +
-	"\x0eaudit_scope_id\x18\x01 \x01(\tB\v\xe0A\x02\xbaH\x05r\x03\xb0\x01\x01R\fauditScopeId\"\xfa\x02\n" +
+	"\x0eaudit_scope_id\x18\x01 \x01(\tB\v\xe0A\x02\xbaH\x05r\x03\xb0\x01\x01R\fauditScopeId\"\xba\x03\n" +
 	"\x16ListAuditScopesRequest\x12V\n" +
 	"\x06filter\x18\x01 \x01(\v29.confirmate.orchestrator.v1.ListAuditScopesRequest.FilterH\x00R\x06filter\x88\x01\x01\x12\x1b\n" +
 	"\tpage_size\x18\n" +
@@ -6304,20 +17414,118 @@ const file_api_orchestrator_orchestrator_proto_rawDesc = "" +
 	"\n" +
 	"page_token\x18\v \x01(\tR\tpageToken\x12\x19\n" +
 	"\border_by\x18\f \x01(\tR\aorderBy\x12\x10\n" +
-	"\x03asc\x18\r \x01(\bR\x03asc\x1a\x93\x01\n" +
+	"\x03asc\x18\r \x01(\bR\x03asc\x1a\xd3\x01\n" +
 	"\x06Filter\x12:\n" +
 	"\x17target_of_evaluation_id\x18\x01 \x01(\tH\x00R\x14targetOfEvaluationId\x88\x01\x01\x12\"\n" +
 	"\n" +
-	"catalog_id\x18\x02 \x01(\tH\x01R\tcatalogId\x88\x01\x01B\x1a\n" +
+	"catalog_id\x18\x02 \x01(\tH\x01R\tcatalogId\x88\x01\x01\x12.\n" +
+	"\venvironment\x18\x03 \x01(\tB\a\xbaH\x04r\x02\x10\x01H\x02R\venvironment\x88\x01\x01B\x1a\n" +
 	"\x18_target_of_evaluation_idB\r\n" +
-	"\v_catalog_idB\t\n" +
+	"\v_catalog_idB\x0e\n" +
+	"\f_environmentB\t\n" +
 	"\a_filter\"\x8c\x01\n" +
 	"\x17ListAuditScopesResponse\x12I\n" +
 	"\faudit_scopes\x18\x01 \x03(\v2&.confirmate.orchestrator.v1.AuditScopeR\vauditScopes\x12&\n" +
 	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"m\n" +
 	"\x17UpdateAuditScopeRequest\x12R\n" +
 	"\vaudit_scope\x18\x03 \x01(\v2&.confirmate.orchestrator.v1.AuditScopeB\t\xe0A\x02\xbaH\x03\xc8\x01\x01R\n" +
-	"auditScope\"J\n" +
+	"auditScope\"\x89\x01\n" +
+	"\x1eCreateMaintenanceWindowRequest\x12g\n" +
+	"\x12maintenance_window\x18\x01 \x01(\v2-.confirmate.orchestrator.v1.MaintenanceWindowB\t\xe0A\x02\xbaH\x03\xc8\x01\x01R\x11maintenanceWindow\"\x87\x02\n" +
+	"\x1dListMaintenanceWindowsRequest\x121\n" +
+	"\x0eaudit_scope_id\x18\x01 \x01(\tB\v\xe0A\x02\xbaH\x05r\x03\xb0\x01\x01R\fauditScopeId\x12<\n" +
+	"\tactive_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\bactiveAt\x88\x01\x01\x12\x1b\n" +
+	"\tpage_size\x18\n" +
+	" \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\v \x01(\tR\tpageToken\x12\x19\n" +
+	"\border_by\x18\f \x01(\tR\aorderBy\x12\x10\n" +
+	"\x03asc\x18\r \x01(\bR\x03ascB\f\n" +
+	"\n" +
+	"_active_at\"\xa8\x01\n" +
+	"\x1eListMaintenanceWindowsResponse\x12^\n" +
+	"\x13maintenance_windows\x18\x01 \x03(\v2-.confirmate.orchestrator.v1.MaintenanceWindowR\x12maintenanceWindows\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"a\n" +
+	"\x1eRemoveMaintenanceWindowRequest\x12?\n" +
+	"\x15maintenance_window_id\x18\x01 \x01(\tB\v\xe0A\x02\xbaH\x05r\x03\xb0\x01\x01R\x13maintenanceWindowId\"`\n" +
+	"\x14CreateWebhookRequest\x12H\n" +
+	"\awebhook\x18\x01 \x01(\v2#.confirmate.orchestrator.v1.WebhookB\t\xe0A\x02\xbaH\x03\xc8\x01\x01R\awebhook\"~\n" +
+	"\x13ListWebhooksRequest\x12\x1b\n" +
+	"\tpage_size\x18\n" +
+	" \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\v \x01(\tR\tpageToken\x12\x19\n" +
+	"\border_by\x18\f \x01(\tR\aorderBy\x12\x10\n" +
+	"\x03asc\x18\r \x01(\bR\x03asc\"\x7f\n" +
+	"\x14ListWebhooksResponse\x12?\n" +
+	"\bwebhooks\x18\x01 \x03(\v2#.confirmate.orchestrator.v1.WebhookR\bwebhooks\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"B\n" +
+	"\x14RemoveWebhookRequest\x12*\n" +
+	"\n" +
+	"webhook_id\x18\x01 \x01(\tB\v\xe0A\x02\xbaH\x05r\x03\xb0\x01\x01R\twebhookId\"\xe9\x01\n" +
+	"\x1cListWebhookDeliveriesRequest\x12*\n" +
+	"\n" +
+	"webhook_id\x18\x01 \x01(\tB\v\xe0A\x02\xbaH\x05r\x03\xb0\x01\x01R\twebhookId\x12$\n" +
+	"\vfailed_only\x18\x02 \x01(\bH\x00R\n" +
+	"failedOnly\x88\x01\x01\x12\x1b\n" +
+	"\tpage_size\x18\n" +
+	" \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\v \x01(\tR\tpageToken\x12\x19\n" +
+	"\border_by\x18\f \x01(\tR\aorderBy\x12\x10\n" +
+	"\x03asc\x18\r \x01(\bR\x03ascB\x0e\n" +
+	"\f_failed_only\"\x94\x01\n" +
+	"\x1dListWebhookDeliveriesResponse\x12K\n" +
+	"\n" +
+	"deliveries\x18\x01 \x03(\v2+.confirmate.orchestrator.v1.WebhookDeliveryR\n" +
+	"deliveries\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"G\n" +
+	"\x12ExportOSCALRequest\x121\n" +
+	"\x0eaudit_scope_id\x18\x01 \x01(\tB\v\xe0A\x02\xbaH\x05r\x03\xb0\x01\x01R\fauditScopeId\"I\n" +
+	"\x13ExportOSCALResponse\x122\n" +
+	"\x12assessment_results\x18\x01 \x01(\fB\x03\xe0A\x02R\x11assessmentResults\"Q\n" +
+	"\x1cArchiveAuditScopeDataRequest\x121\n" +
+	"\x0eaudit_scope_id\x18\x01 \x01(\tB\v\xe0A\x02\xbaH\x05r\x03\xb0\x01\x01R\fauditScopeId\">\n" +
+	"\x1dArchiveAuditScopeDataResponse\x12\x1d\n" +
+	"\aarchive\x18\x01 \x01(\fB\x03\xe0A\x02R\aarchive\"w\n" +
+	"\x1cRestoreAuditScopeDataRequest\x121\n" +
+	"\x0eaudit_scope_id\x18\x01 \x01(\tB\v\xe0A\x02\xbaH\x05r\x03\xb0\x01\x01R\fauditScopeId\x12$\n" +
+	"\aarchive\x18\x02 \x01(\fB\n" +
+	"\xe0A\x02\xbaH\x04z\x02\x10\x01R\aarchive\"\xa7\x01\n" +
+	"\x1dRestoreAuditScopeDataResponse\x12C\n" +
+	"\x1brestored_evaluation_results\x18\x01 \x01(\x05B\x03\xe0A\x02R\x19restoredEvaluationResults\x12A\n" +
+	"\x1askipped_evaluation_results\x18\x02 \x01(\x05B\x03\xe0A\x02R\x18skippedEvaluationResults\"\x12\n" +
+	"\x10GetSchemaRequest\"S\n" +
+	"\x06Schema\x12I\n" +
+	"\bentities\x18\x01 \x03(\v2(.confirmate.orchestrator.v1.SchemaEntityB\x03\xe0A\x02R\bentities\"\xe3\x01\n" +
+	"\fSchemaEntity\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tB\x03\xe0A\x02R\x04name\x12\x19\n" +
+	"\x05table\x18\x02 \x01(\tB\x03\xe0A\x02R\x05table\x12D\n" +
+	"\x06fields\x18\x03 \x03(\v2'.confirmate.orchestrator.v1.SchemaFieldB\x03\xe0A\x02R\x06fields\x12Y\n" +
+	"\rrelationships\x18\x04 \x03(\v2..confirmate.orchestrator.v1.SchemaRelationshipB\x03\xe0A\x02R\rrelationships\"\xb5\x01\n" +
+	"\vSchemaField\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tB\x03\xe0A\x02R\x04name\x12\x1b\n" +
+	"\x06column\x18\x02 \x01(\tB\x03\xe0A\x02R\x06column\x12\x1b\n" +
+	"\tdata_type\x18\x03 \x01(\tR\bdataType\x12\x1f\n" +
+	"\vprimary_key\x18\x04 \x01(\bR\n" +
+	"primaryKey\x12#\n" +
+	"\n" +
+	"serializer\x18\x05 \x01(\tH\x00R\n" +
+	"serializer\x88\x01\x01B\r\n" +
+	"\v_serializer\"\xa0\x02\n" +
+	"\x12SchemaRelationship\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tB\x03\xe0A\x02R\x04name\x12K\n" +
+	"\x04type\x18\x02 \x01(\x0e22.confirmate.orchestrator.v1.SchemaRelationshipTypeB\x03\xe0A\x02R\x04type\x12\x1b\n" +
+	"\x06entity\x18\x03 \x01(\tB\x03\xe0A\x02R\x06entity\x12\"\n" +
+	"\n" +
+	"join_table\x18\x04 \x01(\tH\x00R\tjoinTable\x88\x01\x01\x12T\n" +
+	"\fforeign_keys\x18\x05 \x03(\v2,.confirmate.orchestrator.v1.SchemaForeignKeyB\x03\xe0A\x02R\vforeignKeysB\r\n" +
+	"\v_join_table\"\xac\x01\n" +
+	"\x10SchemaForeignKey\x12\x19\n" +
+	"\x05table\x18\x01 \x01(\tB\x03\xe0A\x02R\x05table\x12\x1b\n" +
+	"\x06column\x18\x02 \x01(\tB\x03\xe0A\x02R\x06column\x12.\n" +
+	"\x10referenced_table\x18\x03 \x01(\tB\x03\xe0A\x02R\x0freferencedTable\x120\n" +
+	"\x11referenced_column\x18\x04 \x01(\tB\x03\xe0A\x02R\x10referencedColumn\"J\n" +
 	"\x15GetCertificateRequest\x121\n" +
 	"\x0ecertificate_id\x18\x01 \x01(\tB\n" +
 	"\xe0A\x02\xbaH\x04r\x02\x10\x01R\rcertificateId\"\x82\x01\n" +
@@ -6352,7 +17560,61 @@ const file_api_orchestrator_orchestrator_proto_rawDesc = "" +
 	"\x11GetCatalogRequest\x12)\n" +
 	"\n" +
 	"catalog_id\x18\x01 \x01(\tB\n" +
-	"\xe0A\x02\xbaH\x04r\x02\x10\x01R\tcatalogId\"~\n" +
+	"\xe0A\x02\xbaH\x04r\x02\x10\x01R\tcatalogId\"\xc3\x01\n" +
+	"\x15GetCatalogTreeRequest\x12)\n" +
+	"\n" +
+	"catalog_id\x18\x01 \x01(\tB\n" +
+	"\xe0A\x02\xbaH\x04r\x02\x10\x01R\tcatalogId\x123\n" +
+	"\x0eaudit_scope_id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01H\x00R\fauditScopeId\x88\x01\x01\x12)\n" +
+	"\tmax_depth\x18\x03 \x01(\x05B\a\xbaH\x04\x1a\x02(\x01H\x01R\bmaxDepth\x88\x01\x01B\x11\n" +
+	"\x0f_audit_scope_idB\f\n" +
+	"\n" +
+	"_max_depth\"\xd0\x01\n" +
+	"\x16GetCatalogTreeResponse\x12\"\n" +
+	"\n" +
+	"catalog_id\x18\x01 \x01(\tB\x03\xe0A\x02R\tcatalogId\x12)\n" +
+	"\x0eaudit_scope_id\x18\x02 \x01(\tH\x00R\fauditScopeId\x88\x01\x01\x12T\n" +
+	"\n" +
+	"categories\x18\x03 \x03(\v2/.confirmate.orchestrator.v1.CatalogTreeCategoryB\x03\xe0A\x02R\n" +
+	"categoriesB\x11\n" +
+	"\x0f_audit_scope_id\"\xd4\x01\n" +
+	"\x13CatalogTreeCategory\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tB\x03\xe0A\x02R\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x121\n" +
+	"\x12number_of_controls\x18\x03 \x01(\x05B\x03\xe0A\x02R\x10numberOfControls\x12O\n" +
+	"\bcontrols\x18\x04 \x03(\v2..confirmate.orchestrator.v1.CatalogTreeControlB\x03\xe0A\x02R\bcontrols\"\xb1\x03\n" +
+	"\x12CatalogTreeControl\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\tB\x03\xe0A\x02R\x02id\x12\"\n" +
+	"\n" +
+	"short_name\x18\x02 \x01(\tB\x03\xe0A\x02R\tshortName\x12\x17\n" +
+	"\x04name\x18\x03 \x01(\tB\x03\xe0A\x02R\x04name\x12,\n" +
+	"\x0fassurance_level\x18\x04 \x01(\tH\x00R\x0eassuranceLevel\x88\x01\x01\x12/\n" +
+	"\x11number_of_metrics\x18\x05 \x01(\x05B\x03\xe0A\x02R\x0fnumberOfMetrics\x121\n" +
+	"\x12number_of_controls\x18\x06 \x01(\x05B\x03\xe0A\x02R\x10numberOfControls\x12G\n" +
+	"\x06status\x18\a \x01(\x0e2*.confirmate.evaluation.v1.EvaluationStatusH\x01R\x06status\x88\x01\x01\x12O\n" +
+	"\bcontrols\x18\b \x03(\v2..confirmate.orchestrator.v1.CatalogTreeControlB\x03\xe0A\x02R\bcontrolsB\x12\n" +
+	"\x10_assurance_levelB\t\n" +
+	"\a_status\"\xb2\x01\n" +
+	"\x1cListCatalogChangelogsRequest\x12)\n" +
+	"\n" +
+	"catalog_id\x18\x01 \x01(\tB\n" +
+	"\xe0A\x02\xbaH\x04r\x02\x10\x01R\tcatalogId\x12\x1b\n" +
+	"\tpage_size\x18\n" +
+	" \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\v \x01(\tR\tpageToken\x12\x19\n" +
+	"\border_by\x18\f \x01(\tR\aorderBy\x12\x10\n" +
+	"\x03asc\x18\r \x01(\bR\x03asc\"\x95\x01\n" +
+	"\x1dListCatalogChangelogsResponse\x12L\n" +
+	"\n" +
+	"changelogs\x18\x01 \x03(\v2,.confirmate.orchestrator.v1.CatalogChangelogR\n" +
+	"changelogs\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"w\n" +
+	"\x1aGetCatalogChangelogRequest\x12)\n" +
+	"\n" +
+	"catalog_id\x18\x01 \x01(\tB\n" +
+	"\xe0A\x02\xbaH\x04r\x02\x10\x01R\tcatalogId\x12.\n" +
+	"\fchangelog_id\x18\x02 \x01(\tB\v\xe0A\x02\xbaH\x05r\x03\xb0\x01\x01R\vchangelogId\"~\n" +
 	"\x13ListCatalogsRequest\x12\x1b\n" +
 	"\tpage_size\x18\n" +
 	" \x01(\x05R\bpageSize\x12\x1d\n" +
@@ -6364,7 +17626,90 @@ const file_api_orchestrator_orchestrator_proto_rawDesc = "" +
 	"\bcatalogs\x18\x01 \x03(\v2#.confirmate.orchestrator.v1.CatalogR\bcatalogs\x12&\n" +
 	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"`\n" +
 	"\x14UpdateCatalogRequest\x12H\n" +
-	"\acatalog\x18\x01 \x01(\v2#.confirmate.orchestrator.v1.CatalogB\t\xe0A\x02\xbaH\x03\xc8\x01\x01R\acatalog\"p\n" +
+	"\acatalog\x18\x01 \x01(\v2#.confirmate.orchestrator.v1.CatalogB\t\xe0A\x02\xbaH\x03\xc8\x01\x01R\acatalog\"B\n" +
+	"\x15PublishCatalogRequest\x12)\n" +
+	"\n" +
+	"catalog_id\x18\x01 \x01(\tB\n" +
+	"\xe0A\x02\xbaH\x04r\x02\x10\x01R\tcatalogId\"G\n" +
+	"\x1aDiscardCatalogDraftRequest\x12)\n" +
+	"\n" +
+	"catalog_id\x18\x01 \x01(\tB\n" +
+	"\xe0A\x02\xbaH\x04r\x02\x10\x01R\tcatalogId\"\xfc\x01\n" +
+	"!ImportControlMetricMappingRequest\x12)\n" +
+	"\n" +
+	"catalog_id\x18\x01 \x01(\tB\n" +
+	"\xe0A\x02\xbaH\x04r\x02\x10\x01R\tcatalogId\x12T\n" +
+	"\x06format\x18\x02 \x01(\x0e2-.confirmate.orchestrator.v1.MappingFileFormatB\r\xe0A\x02\xbaH\a\x82\x01\x04\x10\x01 \x00R\x06format\x12#\n" +
+	"\x04data\x18\x03 \x01(\fB\x0f\xe0A\x02\xbaH\tz\a\x10\x01\x18\x80\x80\x80\x05R\x04data\x12\x17\n" +
+	"\adry_run\x18\x04 \x01(\bR\x06dryRun\x12\x18\n" +
+	"\areplace\x18\x05 \x01(\bR\areplace\"9\n" +
+	"\vImportIssue\x12\x10\n" +
+	"\x03row\x18\x01 \x01(\x05R\x03row\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\xab\x01\n" +
+	"\"ImportControlMetricMappingResponse\x12\x12\n" +
+	"\x04rows\x18\x01 \x01(\x05R\x04rows\x12\x16\n" +
+	"\x06mapped\x18\x02 \x01(\x05R\x06mapped\x12?\n" +
+	"\x06issues\x18\x03 \x03(\v2'.confirmate.orchestrator.v1.ImportIssueR\x06issues\x12\x18\n" +
+	"\aapplied\x18\x04 \x01(\bR\aapplied\"j\n" +
+	"\x1bUpdateAssuranceLevelRequest\x12K\n" +
+	"\x05level\x18\x01 \x01(\v2*.confirmate.orchestrator.v1.AssuranceLevelB\t\xe0A\x02\xbaH\x03\xc8\x01\x01R\x05level\"\xb0\x01\n" +
+	"\x1aListAssuranceLevelsRequest\x12)\n" +
+	"\n" +
+	"catalog_id\x18\x01 \x01(\tB\n" +
+	"\xe0A\x02\xbaH\x04r\x02\x10\x01R\tcatalogId\x12\x1b\n" +
+	"\tpage_size\x18\n" +
+	" \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\v \x01(\tR\tpageToken\x12\x19\n" +
+	"\border_by\x18\f \x01(\tR\aorderBy\x12\x10\n" +
+	"\x03asc\x18\r \x01(\bR\x03asc\"\x89\x01\n" +
+	"\x1bListAssuranceLevelsResponse\x12B\n" +
+	"\x06levels\x18\x01 \x03(\v2*.confirmate.orchestrator.v1.AssuranceLevelR\x06levels\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"h\n" +
+	"\x1bRemoveAssuranceLevelRequest\x12)\n" +
+	"\n" +
+	"catalog_id\x18\x01 \x01(\tB\n" +
+	"\xe0A\x02\xbaH\x04r\x02\x10\x01R\tcatalogId\x12\x1e\n" +
+	"\x04name\x18\x02 \x01(\tB\n" +
+	"\xe0A\x02\xbaH\x04r\x02\x10\x01R\x04name\"n\n" +
+	"\x1eUpdateApplicabilityRuleRequest\x12L\n" +
+	"\x04rule\x18\x01 \x01(\v2-.confirmate.orchestrator.v1.ApplicabilityRuleB\t\xe0A\x02\xbaH\x03\xc8\x01\x01R\x04rule\"\xb3\x01\n" +
+	"\x1dListApplicabilityRulesRequest\x12)\n" +
+	"\n" +
+	"catalog_id\x18\x01 \x01(\tB\n" +
+	"\xe0A\x02\xbaH\x04r\x02\x10\x01R\tcatalogId\x12\x1b\n" +
+	"\tpage_size\x18\n" +
+	" \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\v \x01(\tR\tpageToken\x12\x19\n" +
+	"\border_by\x18\f \x01(\tR\aorderBy\x12\x10\n" +
+	"\x03asc\x18\r \x01(\bR\x03asc\"\x8d\x01\n" +
+	"\x1eListApplicabilityRulesResponse\x12C\n" +
+	"\x05rules\x18\x01 \x03(\v2-.confirmate.orchestrator.v1.ApplicabilityRuleR\x05rules\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"j\n" +
+	"\x1aCreateCatalogSourceRequest\x12L\n" +
+	"\x06source\x18\x01 \x01(\v2).confirmate.orchestrator.v1.CatalogSourceB\t\xe0A\x02\xbaH\x03\xc8\x01\x01R\x06source\"\x84\x01\n" +
+	"\x19ListCatalogSourcesRequest\x12\x1b\n" +
+	"\tpage_size\x18\n" +
+	" \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\v \x01(\tR\tpageToken\x12\x19\n" +
+	"\border_by\x18\f \x01(\tR\aorderBy\x12\x10\n" +
+	"\x03asc\x18\r \x01(\bR\x03asc\"\x89\x01\n" +
+	"\x1aListCatalogSourcesResponse\x12C\n" +
+	"\asources\x18\x01 \x03(\v2).confirmate.orchestrator.v1.CatalogSourceR\asources\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"F\n" +
+	"\x1aRemoveCatalogSourceRequest\x12(\n" +
+	"\tsource_id\x18\x01 \x01(\tB\v\xe0A\x02\xbaH\x05r\x03\xb0\x01\x01R\bsourceId\"Z\n" +
+	"\x18SyncCatalogSourceRequest\x12(\n" +
+	"\tsource_id\x18\x01 \x01(\tB\v\xe0A\x02\xbaH\x05r\x03\xb0\x01\x01R\bsourceId\x12\x14\n" +
+	"\x05force\x18\x02 \x01(\bR\x05force\"\x85\x01\n" +
+	"\x1eRemoveApplicabilityRuleRequest\x12)\n" +
+	"\n" +
+	"catalog_id\x18\x01 \x01(\tB\n" +
+	"\xe0A\x02\xbaH\x04r\x02\x10\x01R\tcatalogId\x128\n" +
+	"\x12control_short_name\x18\x02 \x01(\tB\n" +
+	"\xe0A\x02\xbaH\x04r\x02\x10\x01R\x10controlShortName\"p\n" +
 	"\x12GetCategoryRequest\x12)\n" +
 	"\n" +
 	"catalog_id\x18\x01 \x01(\tB\n" +
@@ -6374,7 +17719,28 @@ const file_api_orchestrator_orchestrator_proto_rawDesc = "" +
 	"\x11GetControlRequest\x12)\n" +
 	"\n" +
 	"control_id\x18\x03 \x01(\tB\n" +
-	"\xe0A\x02\xbaH\x04r\x02\x10\x01R\tcontrolId\"\xb3\x03\n" +
+	"\xe0A\x02\xbaH\x04r\x02\x10\x01R\tcontrolId\"\xb5\x01\n" +
+	"\x1eListControlTextVersionsRequest\x12*\n" +
+	"\n" +
+	"control_id\x18\x01 \x01(\tB\v\xe0A\x02\xbaH\x05r\x03\xb0\x01\x01R\tcontrolId\x12\x1b\n" +
+	"\tpage_size\x18\n" +
+	" \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\v \x01(\tR\tpageToken\x12\x19\n" +
+	"\border_by\x18\f \x01(\tR\aorderBy\x12\x10\n" +
+	"\x03asc\x18\r \x01(\bR\x03asc\"\x95\x01\n" +
+	"\x1fListControlTextVersionsResponse\x12J\n" +
+	"\bversions\x18\x01 \x03(\v2..confirmate.orchestrator.v1.ControlTextVersionR\bversions\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"z\n" +
+	"\x18RenderControlTextRequest\x12*\n" +
+	"\n" +
+	"control_id\x18\x01 \x01(\tB\v\xe0A\x02\xbaH\x05r\x03\xb0\x01\x01R\tcontrolId\x12&\n" +
+	"\aversion\x18\x02 \x01(\x05B\a\xbaH\x04\x1a\x02 \x00H\x00R\aversion\x88\x01\x01B\n" +
+	"\n" +
+	"\b_version\"I\n" +
+	"\x19RenderControlTextResponse\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\x05R\aversion\x12\x12\n" +
+	"\x04html\x18\x02 \x01(\tR\x04html\"\xb3\x03\n" +
 	"\x13ListControlsRequest\x12S\n" +
 	"\x06filter\x18\x03 \x01(\v26.confirmate.orchestrator.v1.ListControlsRequest.FilterH\x00R\x06filter\x88\x01\x01\x12\x1b\n" +
 	"\tpage_size\x18\n" +
@@ -6436,7 +17802,7 @@ const file_api_orchestrator_orchestrator_proto_rawDesc = "" +
 	"\x15GetCurrentUserRequest\"5\n" +
 	"\x0eGetUserRequest\x12#\n" +
 	"\auser_id\x18\x01 \x01(\tB\n" +
-	"\xe0A\x02\xbaH\x04r\x02\x10\x01R\x06userId\"\xa8\x04\n" +
+	"\xe0A\x02\xbaH\x04r\x02\x10\x01R\x06userId\"\x82\x05\n" +
 	"\x10ListUsersRequest\x12P\n" +
 	"\x06filter\x18\x01 \x01(\v23.confirmate.orchestrator.v1.ListUsersRequest.FilterH\x00R\x06filter\x88\x01\x01\x12\x1b\n" +
 	"\tpage_size\x18\n" +
@@ -6444,14 +17810,15 @@ const file_api_orchestrator_orchestrator_proto_rawDesc = "" +
 	"\n" +
 	"page_token\x18\v \x01(\tR\tpageToken\x12\x19\n" +
 	"\border_by\x18\f \x01(\tR\aorderBy\x12\x10\n" +
-	"\x03asc\x18\r \x01(\bR\x03asc\x1a\xcd\x02\n" +
+	"\x03asc\x18\r \x01(\bR\x03asc\x1a\xa7\x03\n" +
 	"\x06Filter\x12C\n" +
 	"\x04role\x18\x01 \x01(\x0e2 .confirmate.orchestrator.v1.RoleB\b\xbaH\x05\x82\x01\x02\x10\x01H\x00R\x04role\x88\x01\x01\x12\x1d\n" +
 	"\aenabled\x18\x02 \x01(\bH\x01R\aenabled\x88\x01\x01\x12\x1b\n" +
 	"\x06search\x18\x03 \x01(\tH\x02R\x06search\x88\x01\x01\x12c\n" +
 	"\n" +
 	"attributes\x18\x04 \x03(\v2C.confirmate.orchestrator.v1.ListUsersRequest.Filter.AttributesEntryR\n" +
-	"attributes\x1a=\n" +
+	"attributes\x12M\n" +
+	"\x06source\x18\x05 \x01(\x0e2&.confirmate.orchestrator.v1.UserSourceB\b\xbaH\x05\x82\x01\x02\x10\x01H\x03R\x06source\x88\x01\x01\x1a=\n" +
 	"\x0fAttributesEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\a\n" +
@@ -6459,10 +17826,21 @@ const file_api_orchestrator_orchestrator_proto_rawDesc = "" +
 	"\n" +
 	"\b_enabledB\t\n" +
 	"\a_searchB\t\n" +
+	"\a_sourceB\t\n" +
 	"\a_filter\"s\n" +
 	"\x11ListUsersResponse\x126\n" +
 	"\x05users\x18\x01 \x03(\v2 .confirmate.orchestrator.v1.UserR\x05users\x12&\n" +
-	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xcc\x03\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"k\n" +
+	"\x12ResolveUserRequest\x12$\n" +
+	"\x06issuer\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01H\x00R\x06issuer\x88\x01\x01\x12$\n" +
+	"\asubject\x18\x02 \x01(\tB\n" +
+	"\xe0A\x02\xbaH\x04r\x02\x10\x01R\asubjectB\t\n" +
+	"\a_issuer\"\x12\n" +
+	"\x10SyncUsersRequest\"c\n" +
+	"\x11SyncUsersResponse\x12\x18\n" +
+	"\acreated\x18\x01 \x01(\x05R\acreated\x12\x18\n" +
+	"\aupdated\x18\x02 \x01(\x05R\aupdated\x12\x1a\n" +
+	"\bdisabled\x18\x03 \x01(\x05R\bdisabled\"\xcc\x03\n" +
 	"\x1aListUserPermissionsRequest\x12Z\n" +
 	"\x06filter\x18\x01 \x01(\v2=.confirmate.orchestrator.v1.ListUserPermissionsRequest.FilterH\x00R\x06filter\x88\x01\x01\x12\x1b\n" +
 	"\tpage_size\x18\n" +
@@ -6495,7 +17873,15 @@ const file_api_orchestrator_orchestrator_proto_rawDesc = "" +
 	"\x05roles\x18\x01 \x03(\x0e2 .confirmate.orchestrator.v1.RoleR\x05roles\"8\n" +
 	"\x11RemoveUserRequest\x12#\n" +
 	"\auser_id\x18\x01 \x01(\tB\n" +
-	"\xe0A\x02\xbaH\x04r\x02\x10\x01R\x06userId*\xee\x02\n" +
+	"\xe0A\x02\xbaH\x04r\x02\x10\x01R\x06userId*\x9a\x01\n" +
+	"\x1aExternalFindingsFileFormat\x12-\n" +
+	")EXTERNAL_FINDINGS_FILE_FORMAT_UNSPECIFIED\x10\x00\x12%\n" +
+	"!EXTERNAL_FINDINGS_FILE_FORMAT_CSV\x10\x01\x12&\n" +
+	"\"EXTERNAL_FINDINGS_FILE_FORMAT_JSON\x10\x02*\\\n" +
+	"\tAlertType\x12\x1a\n" +
+	"\x16ALERT_TYPE_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16ALERT_TYPE_VOLUME_DROP\x10\x01\x12\x17\n" +
+	"\x13ALERT_TYPE_FLAPPING\x10\x02*\x8a\x03\n" +
 	"\rEventCategory\x12\x1e\n" +
 	"\x1aEVENT_CATEGORY_UNSPECIFIED\x10\x00\x12\x19\n" +
 	"\x15EVENT_CATEGORY_METRIC\x10\x01\x12'\n" +
@@ -6506,7 +17892,9 @@ const file_api_orchestrator_orchestrator_proto_rawDesc = "" +
 	" EVENT_CATEGORY_ASSESSMENT_RESULT\x10\x06\x12\"\n" +
 	"\x1eEVENT_CATEGORY_ASSESSMENT_TOOL\x10\a\x12\x17\n" +
 	"\x13EVENT_CATEGORY_USER\x10\b\x12#\n" +
-	"\x1fEVENT_CATEGORY_CONTROL_IN_SCOPE\x10\t*\xdc\x01\n" +
+	"\x1fEVENT_CATEGORY_CONTROL_IN_SCOPE\x10\t\x12\x1a\n" +
+	"\x16EVENT_CATEGORY_CATALOG\x10\n" +
+	"*\xf8\x01\n" +
 	"\vRequestType\x12\x1c\n" +
 	"\x18REQUEST_TYPE_UNSPECIFIED\x10\x00\x12\x18\n" +
 	"\x14REQUEST_TYPE_CREATED\x10\x01\x12\x18\n" +
@@ -6515,14 +17903,58 @@ const file_api_orchestrator_orchestrator_proto_rawDesc = "" +
 	"\x17REQUEST_TYPE_REGISTERED\x10\x04\x12\x17\n" +
 	"\x13REQUEST_TYPE_STORED\x10\x05\x12\x14\n" +
 	"\x10REQUEST_TYPE_GET\x10\x06\x12\x15\n" +
-	"\x11REQUEST_TYPE_LIST\x10\a*\xfa\x01\n" +
+	"\x11REQUEST_TYPE_LIST\x10\a\x12\x1a\n" +
+	"\x16REQUEST_TYPE_PUBLISHED\x10\b*\xf6\x01\n" +
+	"\x11MetadataFieldType\x12#\n" +
+	"\x1fMETADATA_FIELD_TYPE_UNSPECIFIED\x10\x00\x12\x1e\n" +
+	"\x1aMETADATA_FIELD_TYPE_STRING\x10\x01\x12\x1f\n" +
+	"\x1bMETADATA_FIELD_TYPE_INTEGER\x10\x02\x12\x1e\n" +
+	"\x1aMETADATA_FIELD_TYPE_NUMBER\x10\x03\x12\x1f\n" +
+	"\x1bMETADATA_FIELD_TYPE_BOOLEAN\x10\x04\x12\x1c\n" +
+	"\x18METADATA_FIELD_TYPE_ENUM\x10\x05\x12\x1c\n" +
+	"\x18METADATA_FIELD_TYPE_DATE\x10\x06*b\n" +
+	"\rRedactionMode\x12\x1e\n" +
+	"\x1aREDACTION_MODE_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14REDACTION_MODE_STRIP\x10\x01\x12\x17\n" +
+	"\x13REDACTION_MODE_MASK\x10\x02*g\n" +
+	"\rCatalogStatus\x12\x1e\n" +
+	"\x1aCATALOG_STATUS_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14CATALOG_STATUS_DRAFT\x10\x01\x12\x1c\n" +
+	"\x18CATALOG_STATUS_PUBLISHED\x10\x02*\\\n" +
+	"\x13CatalogSourceFormat\x12%\n" +
+	"!CATALOG_SOURCE_FORMAT_UNSPECIFIED\x10\x00\x12\x1e\n" +
+	"\x1aCATALOG_SOURCE_FORMAT_JSON\x10\x01*\x97\x01\n" +
+	"\x17CatalogChangelogTrigger\x12)\n" +
+	"%CATALOG_CHANGELOG_TRIGGER_UNSPECIFIED\x10\x00\x12(\n" +
+	"$CATALOG_CHANGELOG_TRIGGER_REIMPORTED\x10\x01\x12'\n" +
+	"#CATALOG_CHANGELOG_TRIGGER_PUBLISHED\x10\x02*\x99\x01\n" +
+	"\x11ControlChangeType\x12#\n" +
+	"\x1fCONTROL_CHANGE_TYPE_UNSPECIFIED\x10\x00\x12\x1d\n" +
+	"\x19CONTROL_CHANGE_TYPE_ADDED\x10\x01\x12\x1f\n" +
+	"\x1bCONTROL_CHANGE_TYPE_REMOVED\x10\x02\x12\x1f\n" +
+	"\x1bCONTROL_CHANGE_TYPE_CHANGED\x10\x03*\xfa\x01\n" +
 	"\x10AuditScopeStatus\x12\"\n" +
 	"\x1eAUDIT_SCOPE_STATUS_UNSPECIFIED\x10\x00\x12\x1c\n" +
 	"\x18AUDIT_SCOPE_STATUS_SETUP\x10\x01\x12&\n" +
 	"\"AUDIT_SCOPE_STATUS_INTERNAL_REVIEW\x10\x02\x12%\n" +
 	"!AUDIT_SCOPE_STATUS_AUDITOR_REVIEW\x10\x03\x127\n" +
 	"3AUDIT_SCOPE_STATUS_CONTINUOUS_COMPLIANCE_MANAGEMENT\x10\x04\x12\x1c\n" +
-	"\x18AUDIT_SCOPE_STATUS_FIXED\x10\x052\xe6W\n" +
+	"\x18AUDIT_SCOPE_STATUS_FIXED\x10\x05*m\n" +
+	"\x0fMaintenanceMode\x12 \n" +
+	"\x1cMAINTENANCE_MODE_UNSPECIFIED\x10\x00\x12\x1d\n" +
+	"\x19MAINTENANCE_MODE_SUPPRESS\x10\x01\x12\x19\n" +
+	"\x15MAINTENANCE_MODE_FLAG\x10\x02*\x8c\x02\n" +
+	"\x16SchemaRelationshipType\x12(\n" +
+	"$SCHEMA_RELATIONSHIP_TYPE_UNSPECIFIED\x10\x00\x12$\n" +
+	" SCHEMA_RELATIONSHIP_TYPE_HAS_ONE\x10\x01\x12%\n" +
+	"!SCHEMA_RELATIONSHIP_TYPE_HAS_MANY\x10\x02\x12'\n" +
+	"#SCHEMA_RELATIONSHIP_TYPE_BELONGS_TO\x10\x03\x12)\n" +
+	"%SCHEMA_RELATIONSHIP_TYPE_MANY_TO_MANY\x10\x04\x12'\n" +
+	"#SCHEMA_RELATIONSHIP_TYPE_REFERENCES\x10\x05*s\n" +
+	"\x11MappingFileFormat\x12#\n" +
+	"\x1fMAPPING_FILE_FORMAT_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17MAPPING_FILE_FORMAT_CSV\x10\x01\x12\x1c\n" +
+	"\x18MAPPING_FILE_FORMAT_XLSX\x10\x022ɽ\x01\n" +
 	"\fOrchestrator\x12\xb0\x01\n" +
 	"\x16RegisterAssessmentTool\x129.confirmate.orchestrator.v1.RegisterAssessmentToolRequest\x1a*.confirmate.orchestrator.v1.AssessmentTool\"/\x82\xd3\xe4\x93\x02):\x04tool\"!/v1/orchestrator/assessment_tools\x12\xb1\x01\n" +
 	"\x13ListAssessmentTools\x126.confirmate.orchestrator.v1.ListAssessmentToolsRequest\x1a7.confirmate.orchestrator.v1.ListAssessmentToolsResponse\")\x82\xd3\xe4\x93\x02#\x12!/v1/orchestrator/assessment_tools\x12\xaa\x01\n" +
@@ -6530,11 +17962,33 @@ const file_api_orchestrator_orchestrator_proto_rawDesc = "" +
 	"\x14UpdateAssessmentTool\x127.confirmate.orchestrator.v1.UpdateAssessmentToolRequest\x1a*.confirmate.orchestrator.v1.AssessmentTool\"9\x82\xd3\xe4\x93\x023:\x04tool\x1a+/v1/orchestrator/assessment_tools/{tool.id}\x12\xa4\x01\n" +
 	"\x18DeregisterAssessmentTool\x12;.confirmate.orchestrator.v1.DeregisterAssessmentToolRequest\x1a\x16.google.protobuf.Empty\"3\x82\xd3\xe4\x93\x02-*+/v1/orchestrator/assessment_tools/{tool_id}\x12\xc1\x01\n" +
 	"\x15StoreAssessmentResult\x128.confirmate.orchestrator.v1.StoreAssessmentResultRequest\x1a9.confirmate.orchestrator.v1.StoreAssessmentResultResponse\"3\x82\xd3\xe4\x93\x02-:\x06result\"#/v1/orchestrator/assessment_results\x12\x92\x01\n" +
-	"\x16StoreAssessmentResults\x128.confirmate.orchestrator.v1.StoreAssessmentResultRequest\x1a:.confirmate.orchestrator.v1.StoreAssessmentResultsResponse(\x010\x01\x12\xab\x01\n" +
-	"\x13GetAssessmentResult\x126.confirmate.orchestrator.v1.GetAssessmentResultRequest\x1a*.confirmate.assessment.v1.AssessmentResult\"0\x82\xd3\xe4\x93\x02*\x12(/v1/orchestrator/assessment_results/{id}\x12\xb2\x01\n" +
+	"\x16StoreAssessmentResults\x128.confirmate.orchestrator.v1.StoreAssessmentResultRequest\x1a:.confirmate.orchestrator.v1.StoreAssessmentResultsResponse(\x010\x01\x12\xd4\x01\n" +
+	"\x1bBatchStoreAssessmentResults\x12>.confirmate.orchestrator.v1.BatchStoreAssessmentResultsRequest\x1a?.confirmate.orchestrator.v1.BatchStoreAssessmentResultsResponse\"4\x82\xd3\xe4\x93\x02.:\x01*\")/v1/orchestrator/assessment_results/batch\x12\xab\x01\n" +
+	"\x13GetAssessmentResult\x126.confirmate.orchestrator.v1.GetAssessmentResultRequest\x1a*.confirmate.assessment.v1.AssessmentResult\"0\x82\xd3\xe4\x93\x02*\x12(/v1/orchestrator/assessment_results/{id}\x12\xcb\x01\n" +
+	"\x15WaiveAssessmentResult\x128.confirmate.orchestrator.v1.WaiveAssessmentResultRequest\x1a*.confirmate.assessment.v1.AssessmentResult\"L\x82\xd3\xe4\x93\x02F:\x01*\"A/v1/orchestrator/assessment_results/{assessment_result_id}/waiver\x12\xd6\x01\n" +
+	"\x1cRevokeAssessmentResultWaiver\x12?.confirmate.orchestrator.v1.RevokeAssessmentResultWaiverRequest\x1a*.confirmate.assessment.v1.AssessmentResult\"I\x82\xd3\xe4\x93\x02C*A/v1/orchestrator/assessment_results/{assessment_result_id}/waiver\x12\xee\x01\n" +
+	"\x16ImportExternalFindings\x129.confirmate.orchestrator.v1.ImportExternalFindingsRequest\x1a:.confirmate.orchestrator.v1.ImportExternalFindingsResponse\"]\x82\xd3\xe4\x93\x02W:\x01*\"R/v1/orchestrator/targets_of_evaluation/{target_of_evaluation_id}/external_findings\x12\xb2\x01\n" +
 	"\x15StoreEvaluationResult\x128.confirmate.orchestrator.v1.StoreEvaluationResultRequest\x1a*.confirmate.evaluation.v1.EvaluationResult\"3\x82\xd3\xe4\x93\x02-:\x06result\"#/v1/orchestrator/evaluation_results\x12\xb9\x01\n" +
 	"\x15ListAssessmentResults\x128.confirmate.orchestrator.v1.ListAssessmentResultsRequest\x1a9.confirmate.orchestrator.v1.ListAssessmentResultsResponse\"+\x82\xd3\xe4\x93\x02%\x12#/v1/orchestrator/assessment_results\x12\xb9\x01\n" +
-	"\x15ListEvaluationResults\x128.confirmate.orchestrator.v1.ListEvaluationResultsRequest\x1a9.confirmate.orchestrator.v1.ListEvaluationResultsResponse\"+\x82\xd3\xe4\x93\x02%\x12#/v1/orchestrator/evaluation_results\x12\x8b\x01\n" +
+	"\x15ListEvaluationResults\x128.confirmate.orchestrator.v1.ListEvaluationResultsRequest\x1a9.confirmate.orchestrator.v1.ListEvaluationResultsResponse\"+\x82\xd3\xe4\x93\x02%\x12#/v1/orchestrator/evaluation_results\x12\xa1\x01\n" +
+	"\x0fListSlaBreaches\x122.confirmate.orchestrator.v1.ListSlaBreachesRequest\x1a3.confirmate.orchestrator.v1.ListSlaBreachesResponse\"%\x82\xd3\xe4\x93\x02\x1f\x12\x1d/v1/orchestrator/sla_breaches\x12\xab\x01\n" +
+	"\x14GetCompliancePosture\x127.confirmate.orchestrator.v1.GetCompliancePostureRequest\x1a-.confirmate.orchestrator.v1.CompliancePosture\"+\x82\xd3\xe4\x93\x02%\x12#/v1/orchestrator/compliance_posture\x12\x8c\x01\n" +
+	"\n" +
+	"ListAlerts\x12-.confirmate.orchestrator.v1.ListAlertsRequest\x1a..confirmate.orchestrator.v1.ListAlertsResponse\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/v1/orchestrator/alerts\x12o\n" +
+	"\x10UploadAttachment\x123.confirmate.orchestrator.v1.UploadAttachmentRequest\x1a$.confirmate.evaluation.v1.Attachment(\x01\x12\x85\x01\n" +
+	"\x12DownloadAttachment\x125.confirmate.orchestrator.v1.DownloadAttachmentRequest\x1a6.confirmate.orchestrator.v1.DownloadAttachmentResponse0\x01\x12\xca\x01\n" +
+	"\x0fListAttachments\x122.confirmate.orchestrator.v1.ListAttachmentsRequest\x1a3.confirmate.orchestrator.v1.ListAttachmentsResponse\"N\x82\xd3\xe4\x93\x02H\x12F/v1/orchestrator/evaluation_results/{evaluation_result_id}/attachments\x12\x95\x01\n" +
+	"\x10RemoveAttachment\x123.confirmate.orchestrator.v1.RemoveAttachmentRequest\x1a\x16.google.protobuf.Empty\"4\x82\xd3\xe4\x93\x02.*,/v1/orchestrator/attachments/{attachment_id}\x12\xdc\x01\n" +
+	"\x1aAddEvaluationResultComment\x12=.confirmate.orchestrator.v1.AddEvaluationResultCommentRequest\x1a!.confirmate.evaluation.v1.Comment\"\\\x82\xd3\xe4\x93\x02V:\acomment\"K/v1/orchestrator/evaluation_results/{comment.evaluation_result_id}/comments\x12\xee\x01\n" +
+	"\x1cListEvaluationResultComments\x12?.confirmate.orchestrator.v1.ListEvaluationResultCommentsRequest\x1a@.confirmate.orchestrator.v1.ListEvaluationResultCommentsResponse\"K\x82\xd3\xe4\x93\x02E\x12C/v1/orchestrator/evaluation_results/{evaluation_result_id}/comments\x12\xa9\x01\n" +
+	"\x1dRemoveEvaluationResultComment\x12@.confirmate.orchestrator.v1.RemoveEvaluationResultCommentRequest\x1a\x16.google.protobuf.Empty\".\x82\xd3\xe4\x93\x02(*&/v1/orchestrator/comments/{comment_id}\x12\x9e\x01\n" +
+	"\x0fCreateSavedView\x122.confirmate.orchestrator.v1.CreateSavedViewRequest\x1a%.confirmate.orchestrator.v1.SavedView\"0\x82\xd3\xe4\x93\x02*:\n" +
+	"saved_view\"\x1c/v1/orchestrator/saved_views\x12\x9c\x01\n" +
+	"\fGetSavedView\x12/.confirmate.orchestrator.v1.GetSavedViewRequest\x1a%.confirmate.orchestrator.v1.SavedView\"4\x82\xd3\xe4\x93\x02.\x12,/v1/orchestrator/saved_views/{saved_view_id}\x12\x9d\x01\n" +
+	"\x0eListSavedViews\x121.confirmate.orchestrator.v1.ListSavedViewsRequest\x1a2.confirmate.orchestrator.v1.ListSavedViewsResponse\"$\x82\xd3\xe4\x93\x02\x1e\x12\x1c/v1/orchestrator/saved_views\x12\xae\x01\n" +
+	"\x0fUpdateSavedView\x122.confirmate.orchestrator.v1.UpdateSavedViewRequest\x1a%.confirmate.orchestrator.v1.SavedView\"@\x82\xd3\xe4\x93\x02::\n" +
+	"saved_view\x1a,/v1/orchestrator/saved_views/{saved_view.id}\x12\x93\x01\n" +
+	"\x0fRemoveSavedView\x122.confirmate.orchestrator.v1.RemoveSavedViewRequest\x1a\x16.google.protobuf.Empty\"4\x82\xd3\xe4\x93\x02.*,/v1/orchestrator/saved_views/{saved_view_id}\x12\x8b\x01\n" +
 	"\fCreateMetric\x12/.confirmate.orchestrator.v1.CreateMetricRequest\x1a .confirmate.assessment.v1.Metric\"(\x82\xd3\xe4\x93\x02\":\x06metric\"\x18/v1/orchestrator/metrics\x12\x97\x01\n" +
 	"\fUpdateMetric\x12/.confirmate.orchestrator.v1.UpdateMetricRequest\x1a .confirmate.assessment.v1.Metric\"4\x82\xd3\xe4\x93\x02.:\x06metric\x1a$/v1/orchestrator/metrics/{metric.id}\x12\x89\x01\n" +
 	"\tGetMetric\x12,.confirmate.orchestrator.v1.GetMetricRequest\x1a .confirmate.assessment.v1.Metric\",\x82\xd3\xe4\x93\x02&\x12$/v1/orchestrator/metrics/{metric_id}\x12\x90\x01\n" +
@@ -6544,14 +17998,37 @@ const file_api_orchestrator_orchestrator_proto_rawDesc = "" +
 	"\x18UpdateTargetOfEvaluation\x12;.confirmate.orchestrator.v1.UpdateTargetOfEvaluationRequest\x1a..confirmate.orchestrator.v1.TargetOfEvaluation\"^\x82\xd3\xe4\x93\x02X:\x14target_of_evaluation\x1a@/v1/orchestrator/targets_of_evaluation/{target_of_evaluation.id}\x12\xcb\x01\n" +
 	"\x15GetTargetOfEvaluation\x128.confirmate.orchestrator.v1.GetTargetOfEvaluationRequest\x1a..confirmate.orchestrator.v1.TargetOfEvaluation\"H\x82\xd3\xe4\x93\x02B\x12@/v1/orchestrator/targets_of_evaluation/{target_of_evaluation_id}\x12\xc2\x01\n" +
 	"\x17ListTargetsOfEvaluation\x12:.confirmate.orchestrator.v1.ListTargetsOfEvaluationRequest\x1a;.confirmate.orchestrator.v1.ListTargetsOfEvaluationResponse\".\x82\xd3\xe4\x93\x02(\x12&/v1/orchestrator/targets_of_evaluation\x12\xb9\x01\n" +
-	"\x18RemoveTargetOfEvaluation\x12;.confirmate.orchestrator.v1.RemoveTargetOfEvaluationRequest\x1a\x16.google.protobuf.Empty\"H\x82\xd3\xe4\x93\x02B*@/v1/orchestrator/targets_of_evaluation/{target_of_evaluation_id}\x12\xe5\x01\n" +
-	"\x1fGetTargetOfEvaluationStatistics\x12B.confirmate.orchestrator.v1.GetTargetOfEvaluationStatisticsRequest\x1aC.confirmate.orchestrator.v1.GetTargetOfEvaluationStatisticsResponse\"9\x82\xd3\xe4\x93\x023\x121/v1/orchestrator/targets_of_evaluation/statistics\x12\xa1\x02\n" +
+	"\x18RemoveTargetOfEvaluation\x12;.confirmate.orchestrator.v1.RemoveTargetOfEvaluationRequest\x1a\x16.google.protobuf.Empty\"H\x82\xd3\xe4\x93\x02B*@/v1/orchestrator/targets_of_evaluation/{target_of_evaluation_id}\x12\xe8\x01\n" +
+	"\x18MergeTargetsOfEvaluation\x12;.confirmate.orchestrator.v1.MergeTargetsOfEvaluationRequest\x1a<.confirmate.orchestrator.v1.MergeTargetsOfEvaluationResponse\"Q\x82\xd3\xe4\x93\x02K:\x01*\"F/v1/orchestrator/targets_of_evaluation/{target_of_evaluation_id}/merge\x12\xe5\x01\n" +
+	"\x1fGetTargetOfEvaluationStatistics\x12B.confirmate.orchestrator.v1.GetTargetOfEvaluationStatisticsRequest\x1aC.confirmate.orchestrator.v1.GetTargetOfEvaluationStatisticsResponse\"9\x82\xd3\xe4\x93\x023\x121/v1/orchestrator/targets_of_evaluation/statistics\x12\x8c\x01\n" +
+	"\x0eGetUsageReport\x121.confirmate.orchestrator.v1.GetUsageReportRequest\x1a'.confirmate.orchestrator.v1.UsageReport\"\x1e\x82\xd3\xe4\x93\x02\x18\x12\x16/v1/orchestrator/usage\x12\xc4\x01\n" +
+	"\fCreateSecret\x12/.confirmate.orchestrator.v1.CreateSecretRequest\x1a\".confirmate.orchestrator.v1.Secret\"_\x82\xd3\xe4\x93\x02Y:\x06secret\"O/v1/orchestrator/targets_of_evaluation/{secret.target_of_evaluation_id}/secrets\x12\xc0\x01\n" +
+	"\vListSecrets\x12..confirmate.orchestrator.v1.ListSecretsRequest\x1a/.confirmate.orchestrator.v1.ListSecretsResponse\"P\x82\xd3\xe4\x93\x02J\x12H/v1/orchestrator/targets_of_evaluation/{target_of_evaluation_id}/secrets\x12\x99\x01\n" +
+	"\fUpdateSecret\x12/.confirmate.orchestrator.v1.UpdateSecretRequest\x1a\".confirmate.orchestrator.v1.Secret\"4\x82\xd3\xe4\x93\x02.:\x06secret\x1a$/v1/orchestrator/secrets/{secret.id}\x12\x85\x01\n" +
+	"\fRemoveSecret\x12/.confirmate.orchestrator.v1.RemoveSecretRequest\x1a\x16.google.protobuf.Empty\",\x82\xd3\xe4\x93\x02&*$/v1/orchestrator/secrets/{secret_id}\x12\xc7\x01\n" +
+	"\fAccessSecret\x12/.confirmate.orchestrator.v1.AccessSecretRequest\x1a'.confirmate.orchestrator.v1.SecretValue\"]\x82\xd3\xe4\x93\x02W\x12U/v1/orchestrator/targets_of_evaluation/{target_of_evaluation_id}/secrets/{name}/value\x12\x91\x02\n" +
+	"\x16StoreResourceInventory\x129.confirmate.orchestrator.v1.StoreResourceInventoryRequest\x1a-.confirmate.orchestrator.v1.ResourceInventory\"\x8c\x01\x82\xd3\xe4\x93\x02\x85\x01:\tinventory\x1ax/v1/orchestrator/targets_of_evaluation/{inventory.target_of_evaluation_id}/resource_inventories/{inventory.collector_id}\x12\xf1\x01\n" +
+	"\x17ListResourceInventories\x12:.confirmate.orchestrator.v1.ListResourceInventoriesRequest\x1a;.confirmate.orchestrator.v1.ListResourceInventoriesResponse\"]\x82\xd3\xe4\x93\x02W\x12U/v1/orchestrator/targets_of_evaluation/{target_of_evaluation_id}/resource_inventories\x12\xb5\x01\n" +
+	"\x13UpdateMetadataField\x126.confirmate.orchestrator.v1.UpdateMetadataFieldRequest\x1a).confirmate.orchestrator.v1.MetadataField\";\x82\xd3\xe4\x93\x025:\x05field\x1a,/v1/orchestrator/metadata_fields/{field.key}\x12\xad\x01\n" +
+	"\x12ListMetadataFields\x125.confirmate.orchestrator.v1.ListMetadataFieldsRequest\x1a6.confirmate.orchestrator.v1.ListMetadataFieldsResponse\"(\x82\xd3\xe4\x93\x02\"\x12 /v1/orchestrator/metadata_fields\x12\x95\x01\n" +
+	"\x13RemoveMetadataField\x126.confirmate.orchestrator.v1.RemoveMetadataFieldRequest\x1a\x16.google.protobuf.Empty\".\x82\xd3\xe4\x93\x02(*&/v1/orchestrator/metadata_fields/{key}\x12\xbd\x01\n" +
+	"\x13UpdateRedactionRule\x126.confirmate.orchestrator.v1.UpdateRedactionRuleRequest\x1a).confirmate.orchestrator.v1.RedactionRule\"C\x82\xd3\xe4\x93\x02=:\x04rule\x1a5/v1/orchestrator/redaction_rules/{rule.resource_type}\x12\xad\x01\n" +
+	"\x12ListRedactionRules\x125.confirmate.orchestrator.v1.ListRedactionRulesRequest\x1a6.confirmate.orchestrator.v1.ListRedactionRulesResponse\"(\x82\xd3\xe4\x93\x02\"\x12 /v1/orchestrator/redaction_rules\x12\x9f\x01\n" +
+	"\x13RemoveRedactionRule\x126.confirmate.orchestrator.v1.RemoveRedactionRuleRequest\x1a\x16.google.protobuf.Empty\"8\x82\xd3\xe4\x93\x022*0/v1/orchestrator/redaction_rules/{resource_type}\x12\xa1\x02\n" +
 	"\x19UpdateMetricConfiguration\x12<.confirmate.orchestrator.v1.UpdateMetricConfigurationRequest\x1a-.confirmate.assessment.v1.MetricConfiguration\"\x96\x01\x82\xd3\xe4\x93\x02\x8f\x01:\rconfiguration\x1a~/v1/orchestrator/targets_of_evaluation/{configuration.target_of_evaluation_id}/metric_configurations/{configuration.metric_id}\x12\xee\x01\n" +
-	"\x16GetMetricConfiguration\x129.confirmate.orchestrator.v1.GetMetricConfigurationRequest\x1a-.confirmate.assessment.v1.MetricConfiguration\"j\x82\xd3\xe4\x93\x02d\x12b/v1/orchestrator/targets_of_evaluation/{target_of_evaluation_id}/metric_configurations/{metric_id}\x12\xf3\x01\n" +
-	"\x18ListMetricConfigurations\x12:.confirmate.orchestrator.v1.ListMetricConfigurationRequest\x1a;.confirmate.orchestrator.v1.ListMetricConfigurationResponse\"^\x82\xd3\xe4\x93\x02X\x12V/v1/orchestrator/targets_of_evaluation/{target_of_evaluation_id}/metric_configurations\x12\xe7\x01\n" +
+	"\x16GetMetricConfiguration\x129.confirmate.orchestrator.v1.GetMetricConfigurationRequest\x1a-.confirmate.assessment.v1.MetricConfiguration\"j\x82\xd3\xe4\x93\x02d\x12b/v1/orchestrator/targets_of_evaluation/{target_of_evaluation_id}/metric_configurations/{metric_id}\x12\x92\x02\n" +
+	"\x1aResolveMetricConfiguration\x12=.confirmate.orchestrator.v1.ResolveMetricConfigurationRequest\x1a>.confirmate.orchestrator.v1.ResolveMetricConfigurationResponse\"u\x82\xd3\xe4\x93\x02o\x12m/v1/orchestrator/targets_of_evaluation/{target_of_evaluation_id}/metric_configurations/{metric_id}/resolution\x12\xf3\x01\n" +
+	"\x18ListMetricConfigurations\x12:.confirmate.orchestrator.v1.ListMetricConfigurationRequest\x1a;.confirmate.orchestrator.v1.ListMetricConfigurationResponse\"^\x82\xd3\xe4\x93\x02X\x12V/v1/orchestrator/targets_of_evaluation/{target_of_evaluation_id}/metric_configurations\x12\x9a\x02\n" +
+	" UpdateCatalogMetricConfiguration\x12C.confirmate.orchestrator.v1.UpdateCatalogMetricConfigurationRequest\x1a4.confirmate.assessment.v1.CatalogMetricConfiguration\"{\x82\xd3\xe4\x93\x02u:\rconfiguration\x1ad/v1/orchestrator/catalogs/{configuration.catalog_id}/metric_configurations/{configuration.metric_id}\x12\xf0\x01\n" +
+	"\x1fListCatalogMetricConfigurations\x12B.confirmate.orchestrator.v1.ListCatalogMetricConfigurationsRequest\x1aC.confirmate.orchestrator.v1.ListCatalogMetricConfigurationsResponse\"D\x82\xd3\xe4\x93\x02>\x12</v1/orchestrator/catalogs/{catalog_id}/metric_configurations\x12\xd1\x01\n" +
+	" RemoveCatalogMetricConfiguration\x12C.confirmate.orchestrator.v1.RemoveCatalogMetricConfigurationRequest\x1a\x16.google.protobuf.Empty\"P\x82\xd3\xe4\x93\x02J*H/v1/orchestrator/catalogs/{catalog_id}/metric_configurations/{metric_id}\x12\xe7\x01\n" +
 	"\x1aUpdateMetricImplementation\x12=.confirmate.orchestrator.v1.UpdateMetricImplementationRequest\x1a..confirmate.assessment.v1.MetricImplementation\"Z\x82\xd3\xe4\x93\x02T:\x0eimplementation\x1aB/v1/orchestrator/metrics/{implementation.metric_id}/implementation\x12\xc2\x01\n" +
 	"\x17GetMetricImplementation\x12:.confirmate.orchestrator.v1.GetMetricImplementationRequest\x1a..confirmate.assessment.v1.MetricImplementation\";\x82\xd3\xe4\x93\x025\x123/v1/orchestrator/metrics/{metric_id}/implementation\x12f\n" +
-	"\tSubscribe\x12,.confirmate.orchestrator.v1.SubscribeRequest\x1a'.confirmate.orchestrator.v1.ChangeEvent\"\x000\x01\x12\xa6\x01\n" +
+	"\tSubscribe\x12,.confirmate.orchestrator.v1.SubscribeRequest\x1a'.confirmate.orchestrator.v1.ChangeEvent\"\x000\x01\x12\x92\x01\n" +
+	"\rCreateWebhook\x120.confirmate.orchestrator.v1.CreateWebhookRequest\x1a#.confirmate.orchestrator.v1.Webhook\"*\x82\xd3\xe4\x93\x02$:\awebhook\"\x19/v1/orchestrator/webhooks\x12\x94\x01\n" +
+	"\fListWebhooks\x12/.confirmate.orchestrator.v1.ListWebhooksRequest\x1a0.confirmate.orchestrator.v1.ListWebhooksResponse\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/v1/orchestrator/webhooks\x12\x89\x01\n" +
+	"\rRemoveWebhook\x120.confirmate.orchestrator.v1.RemoveWebhookRequest\x1a\x16.google.protobuf.Empty\".\x82\xd3\xe4\x93\x02(*&/v1/orchestrator/webhooks/{webhook_id}\x12\xc7\x01\n" +
+	"\x15ListWebhookDeliveries\x128.confirmate.orchestrator.v1.ListWebhookDeliveriesRequest\x1a9.confirmate.orchestrator.v1.ListWebhookDeliveriesResponse\"9\x82\xd3\xe4\x93\x023\x121/v1/orchestrator/webhooks/{webhook_id}/deliveries\x12\xa6\x01\n" +
 	"\x11CreateCertificate\x124.confirmate.orchestrator.v1.CreateCertificateRequest\x1a'.confirmate.orchestrator.v1.Certificate\"2\x82\xd3\xe4\x93\x02,:\vcertificate\"\x1d/v1/orchestrator/certificates\x12\xa4\x01\n" +
 	"\x0eGetCertificate\x121.confirmate.orchestrator.v1.GetCertificateRequest\x1a'.confirmate.orchestrator.v1.Certificate\"6\x82\xd3\xe4\x93\x020\x12./v1/orchestrator/certificates/{certificate_id}\x12\xa4\x01\n" +
 	"\x10ListCertificates\x123.confirmate.orchestrator.v1.ListCertificatesRequest\x1a4.confirmate.orchestrator.v1.ListCertificatesResponse\"%\x82\xd3\xe4\x93\x02\x1f\x12\x1d/v1/orchestrator/certificates\x12\xbd\x01\n" +
@@ -6561,24 +18038,51 @@ const file_api_orchestrator_orchestrator_proto_rawDesc = "" +
 	"\rCreateCatalog\x120.confirmate.orchestrator.v1.CreateCatalogRequest\x1a#.confirmate.orchestrator.v1.Catalog\"*\x82\xd3\xe4\x93\x02$:\acatalog\"\x19/v1/orchestrator/catalogs\x12\x94\x01\n" +
 	"\fListCatalogs\x12/.confirmate.orchestrator.v1.ListCatalogsRequest\x1a0.confirmate.orchestrator.v1.ListCatalogsResponse\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/v1/orchestrator/catalogs\x12\x90\x01\n" +
 	"\n" +
-	"GetCatalog\x12-.confirmate.orchestrator.v1.GetCatalogRequest\x1a#.confirmate.orchestrator.v1.Catalog\".\x82\xd3\xe4\x93\x02(\x12&/v1/orchestrator/catalogs/{catalog_id}\x12\x89\x01\n" +
+	"GetCatalog\x12-.confirmate.orchestrator.v1.GetCatalogRequest\x1a#.confirmate.orchestrator.v1.Catalog\".\x82\xd3\xe4\x93\x02(\x12&/v1/orchestrator/catalogs/{catalog_id}\x12\xac\x01\n" +
+	"\x0eGetCatalogTree\x121.confirmate.orchestrator.v1.GetCatalogTreeRequest\x1a2.confirmate.orchestrator.v1.GetCatalogTreeResponse\"3\x82\xd3\xe4\x93\x02-\x12+/v1/orchestrator/catalogs/{catalog_id}/tree\x12\x89\x01\n" +
 	"\rRemoveCatalog\x120.confirmate.orchestrator.v1.RemoveCatalogRequest\x1a\x16.google.protobuf.Empty\".\x82\xd3\xe4\x93\x02(*&/v1/orchestrator/catalogs/{catalog_id}\x12\x9f\x01\n" +
-	"\rUpdateCatalog\x120.confirmate.orchestrator.v1.UpdateCatalogRequest\x1a#.confirmate.orchestrator.v1.Catalog\"7\x82\xd3\xe4\x93\x021:\acatalog\x1a&/v1/orchestrator/catalogs/{catalog.id}\x12\xac\x01\n" +
+	"\rUpdateCatalog\x120.confirmate.orchestrator.v1.UpdateCatalogRequest\x1a#.confirmate.orchestrator.v1.Catalog\"7\x82\xd3\xe4\x93\x021:\acatalog\x1a&/v1/orchestrator/catalogs/{catalog.id}\x12\xa3\x01\n" +
+	"\x0ePublishCatalog\x121.confirmate.orchestrator.v1.PublishCatalogRequest\x1a#.confirmate.orchestrator.v1.Catalog\"9\x82\xd3\xe4\x93\x023:\x01*\"./v1/orchestrator/catalogs/{catalog_id}/publish\x12\xad\x01\n" +
+	"\x13DiscardCatalogDraft\x126.confirmate.orchestrator.v1.DiscardCatalogDraftRequest\x1a#.confirmate.orchestrator.v1.Catalog\"9\x82\xd3\xe4\x93\x023:\x01*\"./v1/orchestrator/catalogs/{catalog_id}/discard\x12\xc7\x01\n" +
+	"\x15ListCatalogChangelogs\x128.confirmate.orchestrator.v1.ListCatalogChangelogsRequest\x1a9.confirmate.orchestrator.v1.ListCatalogChangelogsResponse\"9\x82\xd3\xe4\x93\x023\x121/v1/orchestrator/catalogs/{catalog_id}/changelogs\x12\xc5\x01\n" +
+	"\x13GetCatalogChangelog\x126.confirmate.orchestrator.v1.GetCatalogChangelogRequest\x1a,.confirmate.orchestrator.v1.CatalogChangelog\"H\x82\xd3\xe4\x93\x02B\x12@/v1/orchestrator/catalogs/{catalog_id}/changelogs/{changelog_id}\x12\xe5\x01\n" +
+	"\x1aImportControlMetricMapping\x12=.confirmate.orchestrator.v1.ImportControlMetricMappingRequest\x1a>.confirmate.orchestrator.v1.ImportControlMetricMappingResponse\"H\x82\xd3\xe4\x93\x02B:\x01*\"=/v1/orchestrator/catalogs/{catalog_id}/control_metric_mapping\x12\xd6\x01\n" +
+	"\x14UpdateAssuranceLevel\x127.confirmate.orchestrator.v1.UpdateAssuranceLevelRequest\x1a*.confirmate.orchestrator.v1.AssuranceLevel\"Y\x82\xd3\xe4\x93\x02S:\x05level\x1aJ/v1/orchestrator/catalogs/{level.catalog_id}/assurance_levels/{level.name}\x12\xc7\x01\n" +
+	"\x13ListAssuranceLevels\x126.confirmate.orchestrator.v1.ListAssuranceLevelsRequest\x1a7.confirmate.orchestrator.v1.ListAssuranceLevelsResponse\"?\x82\xd3\xe4\x93\x029\x127/v1/orchestrator/catalogs/{catalog_id}/assurance_levels\x12\xaf\x01\n" +
+	"\x14RemoveAssuranceLevel\x127.confirmate.orchestrator.v1.RemoveAssuranceLevelRequest\x1a\x16.google.protobuf.Empty\"F\x82\xd3\xe4\x93\x02@*>/v1/orchestrator/catalogs/{catalog_id}/assurance_levels/{name}\x12\xed\x01\n" +
+	"\x17UpdateApplicabilityRule\x12:.confirmate.orchestrator.v1.UpdateApplicabilityRuleRequest\x1a-.confirmate.orchestrator.v1.ApplicabilityRule\"g\x82\xd3\xe4\x93\x02a:\x04rule\x1aY/v1/orchestrator/catalogs/{rule.catalog_id}/applicability_rules/{rule.control_short_name}\x12\xd3\x01\n" +
+	"\x16ListApplicabilityRules\x129.confirmate.orchestrator.v1.ListApplicabilityRulesRequest\x1a:.confirmate.orchestrator.v1.ListApplicabilityRulesResponse\"B\x82\xd3\xe4\x93\x02<\x12:/v1/orchestrator/catalogs/{catalog_id}/applicability_rules\x12\xc6\x01\n" +
+	"\x17RemoveApplicabilityRule\x12:.confirmate.orchestrator.v1.RemoveApplicabilityRuleRequest\x1a\x16.google.protobuf.Empty\"W\x82\xd3\xe4\x93\x02Q*O/v1/orchestrator/catalogs/{catalog_id}/applicability_rules/{control_short_name}\x12\xaa\x01\n" +
+	"\x13CreateCatalogSource\x126.confirmate.orchestrator.v1.CreateCatalogSourceRequest\x1a).confirmate.orchestrator.v1.CatalogSource\"0\x82\xd3\xe4\x93\x02*:\x06source\" /v1/orchestrator/catalog_sources\x12\xad\x01\n" +
+	"\x12ListCatalogSources\x125.confirmate.orchestrator.v1.ListCatalogSourcesRequest\x1a6.confirmate.orchestrator.v1.ListCatalogSourcesResponse\"(\x82\xd3\xe4\x93\x02\"\x12 /v1/orchestrator/catalog_sources\x12\x9b\x01\n" +
+	"\x13RemoveCatalogSource\x126.confirmate.orchestrator.v1.RemoveCatalogSourceRequest\x1a\x16.google.protobuf.Empty\"4\x82\xd3\xe4\x93\x02.*,/v1/orchestrator/catalog_sources/{source_id}\x12\xb6\x01\n" +
+	"\x11SyncCatalogSource\x124.confirmate.orchestrator.v1.SyncCatalogSourceRequest\x1a-.confirmate.orchestrator.v1.CatalogSyncReport\"<\x82\xd3\xe4\x93\x026:\x01*\"1/v1/orchestrator/catalog_sources/{source_id}/sync\x12\xac\x01\n" +
 	"\vGetCategory\x12..confirmate.orchestrator.v1.GetCategoryRequest\x1a$.confirmate.orchestrator.v1.Category\"G\x82\xd3\xe4\x93\x02A\x12?/v1/orchestrator/catalogs/{catalog_id}/category/{category_name}\x12\x94\x01\n" +
 	"\fListControls\x12/.confirmate.orchestrator.v1.ListControlsRequest\x1a0.confirmate.orchestrator.v1.ListControlsResponse\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/v1/orchestrator/controls\x12\x90\x01\n" +
 	"\n" +
-	"GetControl\x12-.confirmate.orchestrator.v1.GetControlRequest\x1a#.confirmate.orchestrator.v1.Control\".\x82\xd3\xe4\x93\x02(\x12&/v1/orchestrator/controls/{control_id}\x12\xa3\x01\n" +
+	"GetControl\x12-.confirmate.orchestrator.v1.GetControlRequest\x1a#.confirmate.orchestrator.v1.Control\".\x82\xd3\xe4\x93\x02(\x12&/v1/orchestrator/controls/{control_id}\x12\xd0\x01\n" +
+	"\x17ListControlTextVersions\x12:.confirmate.orchestrator.v1.ListControlTextVersionsRequest\x1a;.confirmate.orchestrator.v1.ListControlTextVersionsResponse\"<\x82\xd3\xe4\x93\x026\x124/v1/orchestrator/controls/{control_id}/text_versions\x12\xbe\x01\n" +
+	"\x11RenderControlText\x124.confirmate.orchestrator.v1.RenderControlTextRequest\x1a5.confirmate.orchestrator.v1.RenderControlTextResponse\"<\x82\xd3\xe4\x93\x026\x124/v1/orchestrator/controls/{control_id}/rendered_text\x12\xa3\x01\n" +
 	"\x10CreateAuditScope\x123.confirmate.orchestrator.v1.CreateAuditScopeRequest\x1a&.confirmate.orchestrator.v1.AuditScope\"2\x82\xd3\xe4\x93\x02,:\vaudit_scope\"\x1d/v1/orchestrator/audit_scopes\x12\xa1\x01\n" +
 	"\rGetAuditScope\x120.confirmate.orchestrator.v1.GetAuditScopeRequest\x1a&.confirmate.orchestrator.v1.AuditScope\"6\x82\xd3\xe4\x93\x020\x12./v1/orchestrator/audit_scopes/{audit_scope_id}\x12\xa1\x01\n" +
 	"\x0fListAuditScopes\x122.confirmate.orchestrator.v1.ListAuditScopesRequest\x1a3.confirmate.orchestrator.v1.ListAuditScopesResponse\"%\x82\xd3\xe4\x93\x02\x1f\x12\x1d/v1/orchestrator/audit_scopes\x12\xfa\x01\n" +
 	"\x10UpdateAuditScope\x123.confirmate.orchestrator.v1.UpdateAuditScopeRequest\x1a&.confirmate.orchestrator.v1.AuditScope\"\x88\x01\x82\xd3\xe4\x93\x02\x81\x01:\vaudit_scope\x1ar/v1/orchestrator/targets_of_evaluation/{audit_scope.target_of_evaluation_id}/audit_scopes/{audit_scope.catalog_id}\x12\x97\x01\n" +
-	"\x10RemoveAuditScope\x123.confirmate.orchestrator.v1.RemoveAuditScopeRequest\x1a\x16.google.protobuf.Empty\"6\x82\xd3\xe4\x93\x020*./v1/orchestrator/audit_scopes/{audit_scope_id}\x12\x83\x01\n" +
+	"\x10RemoveAuditScope\x123.confirmate.orchestrator.v1.RemoveAuditScopeRequest\x1a\x16.google.protobuf.Empty\"6\x82\xd3\xe4\x93\x020*./v1/orchestrator/audit_scopes/{audit_scope_id}\x12\xf7\x01\n" +
+	"\x17CreateMaintenanceWindow\x12:.confirmate.orchestrator.v1.CreateMaintenanceWindowRequest\x1a-.confirmate.orchestrator.v1.MaintenanceWindow\"q\x82\xd3\xe4\x93\x02k:\x12maintenance_window\"U/v1/orchestrator/audit_scopes/{maintenance_window.audit_scope_id}/maintenance_windows\x12\xdb\x01\n" +
+	"\x16ListMaintenanceWindows\x129.confirmate.orchestrator.v1.ListMaintenanceWindowsRequest\x1a:.confirmate.orchestrator.v1.ListMaintenanceWindowsResponse\"J\x82\xd3\xe4\x93\x02D\x12B/v1/orchestrator/audit_scopes/{audit_scope_id}/maintenance_windows\x12\xb3\x01\n" +
+	"\x17RemoveMaintenanceWindow\x12:.confirmate.orchestrator.v1.RemoveMaintenanceWindowRequest\x1a\x16.google.protobuf.Empty\"D\x82\xd3\xe4\x93\x02>*</v1/orchestrator/maintenance_windows/{maintenance_window_id}\x12\xac\x01\n" +
+	"\vExportOSCAL\x12..confirmate.orchestrator.v1.ExportOSCALRequest\x1a/.confirmate.orchestrator.v1.ExportOSCALResponse\"<\x82\xd3\xe4\x93\x026\x124/v1/orchestrator/audit_scopes/{audit_scope_id}/oscal\x12\xcc\x01\n" +
+	"\x15ArchiveAuditScopeData\x128.confirmate.orchestrator.v1.ArchiveAuditScopeDataRequest\x1a9.confirmate.orchestrator.v1.ArchiveAuditScopeDataResponse\">\x82\xd3\xe4\x93\x028\x126/v1/orchestrator/audit_scopes/{audit_scope_id}/archive\x12\xcf\x01\n" +
+	"\x15RestoreAuditScopeData\x128.confirmate.orchestrator.v1.RestoreAuditScopeDataRequest\x1a9.confirmate.orchestrator.v1.RestoreAuditScopeDataResponse\"A\x82\xd3\xe4\x93\x02;:\x01*\"6/v1/orchestrator/audit_scopes/{audit_scope_id}/archive\x12~\n" +
+	"\tGetSchema\x12,.confirmate.orchestrator.v1.GetSchemaRequest\x1a\".confirmate.orchestrator.v1.Schema\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/v1/orchestrator/schema\x12\x83\x01\n" +
 	"\x0eGetRuntimeInfo\x12+.confirmate.common.v1.GetRuntimeInfoRequest\x1a\x1d.confirmate.common.v1.Runtime\"%\x82\xd3\xe4\x93\x02\x1f\x12\x1d/v1/orchestrator/runtime_info\x12\xa5\x02\n" +
 	"\x14UpsertUserPermission\x127.confirmate.orchestrator.v1.UpsertUserPermissionRequest\x1a8.confirmate.orchestrator.v1.UpsertUserPermissionResponse\"\x99\x01\x82\xd3\xe4\x93\x02\x92\x01:\x01*\x1a\x8c\x01/v1/users/permissions/{user_permission.object_type}/{user_permission.object_id}/users/{user_permission.user_id}/{user_permission.permission}\x12\xb0\x01\n" +
 	"\x14RemoveUserPermission\x127.confirmate.orchestrator.v1.RemoveUserPermissionRequest\x1a\x16.google.protobuf.Empty\"G\x82\xd3\xe4\x93\x02A*?/v1/users/permissions/{object_type}/{object_id}/users/{user_id}\x12{\n" +
 	"\x0eGetCurrentUser\x121.confirmate.orchestrator.v1.GetCurrentUserRequest\x1a .confirmate.orchestrator.v1.User\"\x14\x82\xd3\xe4\x93\x02\x0e\x12\f/v1/users/me\x12t\n" +
 	"\aGetUser\x12*.confirmate.orchestrator.v1.GetUserRequest\x1a .confirmate.orchestrator.v1.User\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/v1/users/{user_id}\x12{\n" +
-	"\tListUsers\x12,.confirmate.orchestrator.v1.ListUsersRequest\x1a-.confirmate.orchestrator.v1.ListUsersResponse\"\x11\x82\xd3\xe4\x93\x02\v\x12\t/v1/users\x12\xc0\x02\n" +
+	"\tListUsers\x12,.confirmate.orchestrator.v1.ListUsersRequest\x1a-.confirmate.orchestrator.v1.ListUsersResponse\"\x11\x82\xd3\xe4\x93\x02\v\x12\t/v1/users\x12z\n" +
+	"\vResolveUser\x12..confirmate.orchestrator.v1.ResolveUserRequest\x1a .confirmate.orchestrator.v1.User\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/v1/users/resolve\x12\x83\x01\n" +
+	"\tSyncUsers\x12,.confirmate.orchestrator.v1.SyncUsersRequest\x1a-.confirmate.orchestrator.v1.SyncUsersResponse\"\x19\x82\xd3\xe4\x93\x02\x13:\x01*\"\x0e/v1/users/sync\x12\xc0\x02\n" +
 	"\x13ListUserPermissions\x126.confirmate.orchestrator.v1.ListUserPermissionsRequest\x1a7.confirmate.orchestrator.v1.ListUserPermissionsResponse\"\xb7\x01\x82\xd3\xe4\x93\x02\xb0\x01Z?\x12=/v1/users/permissions/{filter.object_type}/{filter.object_id}ZV\x12T/v1/users/permissions/{filter.object_type}/{filter.object_id}/users/{filter.user_id}\x12\x15/v1/users/permissions\x12\x8d\x01\n" +
 	"\rListUserRoles\x120.confirmate.orchestrator.v1.ListUserRolesRequest\x1a1.confirmate.orchestrator.v1.ListUserRolesResponse\"\x17\x82\xd3\xe4\x93\x02\x11\x12\x0f/v1/users/roles\x12p\n" +
 	"\n" +
@@ -6603,344 +18107,772 @@ func file_api_orchestrator_orchestrator_proto_rawDescGZIP() []byte {
 	return file_api_orchestrator_orchestrator_proto_rawDescData
 }
 
-var file_api_orchestrator_orchestrator_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
-var file_api_orchestrator_orchestrator_proto_msgTypes = make([]protoimpl.MessageInfo, 97)
+var file_api_orchestrator_orchestrator_proto_enumTypes = make([]protoimpl.EnumInfo, 15)
+var file_api_orchestrator_orchestrator_proto_msgTypes = make([]protoimpl.MessageInfo, 243)
 var file_api_orchestrator_orchestrator_proto_goTypes = []any{
-	(EventCategory)(0),                                    // 0: confirmate.orchestrator.v1.EventCategory
-	(RequestType)(0),                                      // 1: confirmate.orchestrator.v1.RequestType
-	(AuditScopeStatus)(0),                                 // 2: confirmate.orchestrator.v1.AuditScopeStatus
-	(TargetOfEvaluation_TargetType)(0),                    // 3: confirmate.orchestrator.v1.TargetOfEvaluation.TargetType
-	(*RegisterAssessmentToolRequest)(nil),                 // 4: confirmate.orchestrator.v1.RegisterAssessmentToolRequest
-	(*ListAssessmentToolsRequest)(nil),                    // 5: confirmate.orchestrator.v1.ListAssessmentToolsRequest
-	(*ListAssessmentToolsResponse)(nil),                   // 6: confirmate.orchestrator.v1.ListAssessmentToolsResponse
-	(*GetAssessmentToolRequest)(nil),                      // 7: confirmate.orchestrator.v1.GetAssessmentToolRequest
-	(*UpdateAssessmentToolRequest)(nil),                   // 8: confirmate.orchestrator.v1.UpdateAssessmentToolRequest
-	(*DeregisterAssessmentToolRequest)(nil),               // 9: confirmate.orchestrator.v1.DeregisterAssessmentToolRequest
-	(*StoreAssessmentResultRequest)(nil),                  // 10: confirmate.orchestrator.v1.StoreAssessmentResultRequest
-	(*StoreAssessmentResultResponse)(nil),                 // 11: confirmate.orchestrator.v1.StoreAssessmentResultResponse
-	(*StoreAssessmentResultsResponse)(nil),                // 12: confirmate.orchestrator.v1.StoreAssessmentResultsResponse
-	(*StoreEvaluationResultRequest)(nil),                  // 13: confirmate.orchestrator.v1.StoreEvaluationResultRequest
-	(*ListEvaluationResultsRequest)(nil),                  // 14: confirmate.orchestrator.v1.ListEvaluationResultsRequest
-	(*ListEvaluationResultsResponse)(nil),                 // 15: confirmate.orchestrator.v1.ListEvaluationResultsResponse
-	(*CreateMetricRequest)(nil),                           // 16: confirmate.orchestrator.v1.CreateMetricRequest
-	(*UpdateMetricRequest)(nil),                           // 17: confirmate.orchestrator.v1.UpdateMetricRequest
-	(*GetMetricRequest)(nil),                              // 18: confirmate.orchestrator.v1.GetMetricRequest
-	(*ListMetricsRequest)(nil),                            // 19: confirmate.orchestrator.v1.ListMetricsRequest
-	(*RemoveMetricRequest)(nil),                           // 20: confirmate.orchestrator.v1.RemoveMetricRequest
-	(*ListMetricsResponse)(nil),                           // 21: confirmate.orchestrator.v1.ListMetricsResponse
-	(*GetTargetOfEvaluationRequest)(nil),                  // 22: confirmate.orchestrator.v1.GetTargetOfEvaluationRequest
-	(*CreateTargetOfEvaluationRequest)(nil),               // 23: confirmate.orchestrator.v1.CreateTargetOfEvaluationRequest
-	(*UpdateTargetOfEvaluationRequest)(nil),               // 24: confirmate.orchestrator.v1.UpdateTargetOfEvaluationRequest
-	(*RemoveTargetOfEvaluationRequest)(nil),               // 25: confirmate.orchestrator.v1.RemoveTargetOfEvaluationRequest
-	(*ListTargetsOfEvaluationRequest)(nil),                // 26: confirmate.orchestrator.v1.ListTargetsOfEvaluationRequest
-	(*ListTargetsOfEvaluationResponse)(nil),               // 27: confirmate.orchestrator.v1.ListTargetsOfEvaluationResponse
-	(*GetTargetOfEvaluationStatisticsRequest)(nil),        // 28: confirmate.orchestrator.v1.GetTargetOfEvaluationStatisticsRequest
-	(*GetTargetOfEvaluationStatisticsResponse)(nil),       // 29: confirmate.orchestrator.v1.GetTargetOfEvaluationStatisticsResponse
-	(*UpdateMetricConfigurationRequest)(nil),              // 30: confirmate.orchestrator.v1.UpdateMetricConfigurationRequest
-	(*GetMetricConfigurationRequest)(nil),                 // 31: confirmate.orchestrator.v1.GetMetricConfigurationRequest
-	(*ListMetricConfigurationRequest)(nil),                // 32: confirmate.orchestrator.v1.ListMetricConfigurationRequest
-	(*ListMetricConfigurationResponse)(nil),               // 33: confirmate.orchestrator.v1.ListMetricConfigurationResponse
-	(*UpdateMetricImplementationRequest)(nil),             // 34: confirmate.orchestrator.v1.UpdateMetricImplementationRequest
-	(*GetMetricImplementationRequest)(nil),                // 35: confirmate.orchestrator.v1.GetMetricImplementationRequest
-	(*SubscribeRequest)(nil),                              // 36: confirmate.orchestrator.v1.SubscribeRequest
-	(*ChangeEvent)(nil),                                   // 37: confirmate.orchestrator.v1.ChangeEvent
-	(*AssessmentTool)(nil),                                // 38: confirmate.orchestrator.v1.AssessmentTool
-	(*TargetOfEvaluation)(nil),                            // 39: confirmate.orchestrator.v1.TargetOfEvaluation
-	(*Catalog)(nil),                                       // 40: confirmate.orchestrator.v1.Catalog
-	(*Category)(nil),                                      // 41: confirmate.orchestrator.v1.Category
-	(*Control)(nil),                                       // 42: confirmate.orchestrator.v1.Control
-	(*AuditScope)(nil),                                    // 43: confirmate.orchestrator.v1.AuditScope
-	(*GetAssessmentResultRequest)(nil),                    // 44: confirmate.orchestrator.v1.GetAssessmentResultRequest
-	(*ListAssessmentResultsRequest)(nil),                  // 45: confirmate.orchestrator.v1.ListAssessmentResultsRequest
-	(*ListAssessmentResultsResponse)(nil),                 // 46: confirmate.orchestrator.v1.ListAssessmentResultsResponse
-	(*CreateAuditScopeRequest)(nil),                       // 47: confirmate.orchestrator.v1.CreateAuditScopeRequest
-	(*RemoveAuditScopeRequest)(nil),                       // 48: confirmate.orchestrator.v1.RemoveAuditScopeRequest
-	(*GetAuditScopeRequest)(nil),                          // 49: confirmate.orchestrator.v1.GetAuditScopeRequest
-	(*ListAuditScopesRequest)(nil),                        // 50: confirmate.orchestrator.v1.ListAuditScopesRequest
-	(*ListAuditScopesResponse)(nil),                       // 51: confirmate.orchestrator.v1.ListAuditScopesResponse
-	(*UpdateAuditScopeRequest)(nil),                       // 52: confirmate.orchestrator.v1.UpdateAuditScopeRequest
-	(*GetCertificateRequest)(nil),                         // 53: confirmate.orchestrator.v1.GetCertificateRequest
-	(*ListCertificatesRequest)(nil),                       // 54: confirmate.orchestrator.v1.ListCertificatesRequest
-	(*ListCertificatesResponse)(nil),                      // 55: confirmate.orchestrator.v1.ListCertificatesResponse
-	(*ListPublicCertificatesRequest)(nil),                 // 56: confirmate.orchestrator.v1.ListPublicCertificatesRequest
-	(*ListPublicCertificatesResponse)(nil),                // 57: confirmate.orchestrator.v1.ListPublicCertificatesResponse
-	(*UpdateCertificateRequest)(nil),                      // 58: confirmate.orchestrator.v1.UpdateCertificateRequest
-	(*CreateCatalogRequest)(nil),                          // 59: confirmate.orchestrator.v1.CreateCatalogRequest
-	(*RemoveCatalogRequest)(nil),                          // 60: confirmate.orchestrator.v1.RemoveCatalogRequest
-	(*GetCatalogRequest)(nil),                             // 61: confirmate.orchestrator.v1.GetCatalogRequest
-	(*ListCatalogsRequest)(nil),                           // 62: confirmate.orchestrator.v1.ListCatalogsRequest
-	(*ListCatalogsResponse)(nil),                          // 63: confirmate.orchestrator.v1.ListCatalogsResponse
-	(*UpdateCatalogRequest)(nil),                          // 64: confirmate.orchestrator.v1.UpdateCatalogRequest
-	(*GetCategoryRequest)(nil),                            // 65: confirmate.orchestrator.v1.GetCategoryRequest
-	(*GetControlRequest)(nil),                             // 66: confirmate.orchestrator.v1.GetControlRequest
-	(*ListControlsRequest)(nil),                           // 67: confirmate.orchestrator.v1.ListControlsRequest
-	(*ListControlsResponse)(nil),                          // 68: confirmate.orchestrator.v1.ListControlsResponse
-	(*CreateCertificateRequest)(nil),                      // 69: confirmate.orchestrator.v1.CreateCertificateRequest
-	(*RemoveCertificateRequest)(nil),                      // 70: confirmate.orchestrator.v1.RemoveCertificateRequest
-	(*Certificate)(nil),                                   // 71: confirmate.orchestrator.v1.Certificate
-	(*State)(nil),                                         // 72: confirmate.orchestrator.v1.State
-	(*UpsertUserPermissionRequest)(nil),                   // 73: confirmate.orchestrator.v1.UpsertUserPermissionRequest
-	(*UpsertUserPermissionResponse)(nil),                  // 74: confirmate.orchestrator.v1.UpsertUserPermissionResponse
-	(*RemoveUserPermissionRequest)(nil),                   // 75: confirmate.orchestrator.v1.RemoveUserPermissionRequest
-	(*GetCurrentUserRequest)(nil),                         // 76: confirmate.orchestrator.v1.GetCurrentUserRequest
-	(*GetUserRequest)(nil),                                // 77: confirmate.orchestrator.v1.GetUserRequest
-	(*ListUsersRequest)(nil),                              // 78: confirmate.orchestrator.v1.ListUsersRequest
-	(*ListUsersResponse)(nil),                             // 79: confirmate.orchestrator.v1.ListUsersResponse
-	(*ListUserPermissionsRequest)(nil),                    // 80: confirmate.orchestrator.v1.ListUserPermissionsRequest
-	(*ListUserPermissionsResponse)(nil),                   // 81: confirmate.orchestrator.v1.ListUserPermissionsResponse
-	(*ListUserRolesRequest)(nil),                          // 82: confirmate.orchestrator.v1.ListUserRolesRequest
-	(*ListUserRolesResponse)(nil),                         // 83: confirmate.orchestrator.v1.ListUserRolesResponse
-	(*RemoveUserRequest)(nil),                             // 84: confirmate.orchestrator.v1.RemoveUserRequest
-	(*ListAssessmentToolsRequest_Filter)(nil),             // 85: confirmate.orchestrator.v1.ListAssessmentToolsRequest.Filter
-	(*ListEvaluationResultsRequest_Filter)(nil),           // 86: confirmate.orchestrator.v1.ListEvaluationResultsRequest.Filter
-	(*ListMetricsRequest_Filter)(nil),                     // 87: confirmate.orchestrator.v1.ListMetricsRequest.Filter
-	nil,                                                   // 88: confirmate.orchestrator.v1.ListMetricConfigurationResponse.ConfigurationsEntry
-	(*SubscribeRequest_Filter)(nil),                       // 89: confirmate.orchestrator.v1.SubscribeRequest.Filter
-	(*TargetOfEvaluation_Metadata)(nil),                   // 90: confirmate.orchestrator.v1.TargetOfEvaluation.Metadata
-	(*TargetOfEvaluation_Organization)(nil),               // 91: confirmate.orchestrator.v1.TargetOfEvaluation.Organization
-	nil,                                                   // 92: confirmate.orchestrator.v1.TargetOfEvaluation.Metadata.LabelsEntry
-	(*TargetOfEvaluation_Organization_PostalAddress)(nil), // 93: confirmate.orchestrator.v1.TargetOfEvaluation.Organization.PostalAddress
-	(*Catalog_Metadata)(nil),                              // 94: confirmate.orchestrator.v1.Catalog.Metadata
-	(*ListAssessmentResultsRequest_Filter)(nil),           // 95: confirmate.orchestrator.v1.ListAssessmentResultsRequest.Filter
-	(*ListAuditScopesRequest_Filter)(nil),                 // 96: confirmate.orchestrator.v1.ListAuditScopesRequest.Filter
-	(*ListControlsRequest_Filter)(nil),                    // 97: confirmate.orchestrator.v1.ListControlsRequest.Filter
-	(*ListUsersRequest_Filter)(nil),                       // 98: confirmate.orchestrator.v1.ListUsersRequest.Filter
-	nil,                                                   // 99: confirmate.orchestrator.v1.ListUsersRequest.Filter.AttributesEntry
-	(*ListUserPermissionsRequest_Filter)(nil),             // 100: confirmate.orchestrator.v1.ListUserPermissionsRequest.Filter
-	(*assessment.AssessmentResult)(nil),                   // 101: confirmate.assessment.v1.AssessmentResult
-	(*evaluation.EvaluationResult)(nil),                   // 102: confirmate.evaluation.v1.EvaluationResult
-	(*assessment.Metric)(nil),                             // 103: confirmate.assessment.v1.Metric
-	(*assessment.MetricConfiguration)(nil),                // 104: confirmate.assessment.v1.MetricConfiguration
-	(*assessment.MetricImplementation)(nil),               // 105: confirmate.assessment.v1.MetricImplementation
-	(*timestamppb.Timestamp)(nil),                         // 106: google.protobuf.Timestamp
-	(*User)(nil),                                          // 107: confirmate.orchestrator.v1.User
-	(*ControlInScope)(nil),                                // 108: confirmate.orchestrator.v1.ControlInScope
-	(*AuditTrailEvent)(nil),                               // 109: confirmate.orchestrator.v1.AuditTrailEvent
-	(*UserPermission)(nil),                                // 110: confirmate.orchestrator.v1.UserPermission
-	(ObjectType)(0),                                       // 111: confirmate.orchestrator.v1.ObjectType
-	(Role)(0),                                             // 112: confirmate.orchestrator.v1.Role
-	(*common.GetRuntimeInfoRequest)(nil),                  // 113: confirmate.common.v1.GetRuntimeInfoRequest
-	(*CreateControlInScopeRequest)(nil),                   // 114: confirmate.orchestrator.v1.CreateControlInScopeRequest
-	(*GetControlInScopeRequest)(nil),                      // 115: confirmate.orchestrator.v1.GetControlInScopeRequest
-	(*ListControlsInScopeRequest)(nil),                    // 116: confirmate.orchestrator.v1.ListControlsInScopeRequest
-	(*UpdateControlInScopeRequest)(nil),                   // 117: confirmate.orchestrator.v1.UpdateControlInScopeRequest
-	(*TransitionControlInScopeStateRequest)(nil),          // 118: confirmate.orchestrator.v1.TransitionControlInScopeStateRequest
-	(*RemoveControlInScopeRequest)(nil),                   // 119: confirmate.orchestrator.v1.RemoveControlInScopeRequest
-	(*ListAuditTrailEventsRequest)(nil),                   // 120: confirmate.orchestrator.v1.ListAuditTrailEventsRequest
-	(*emptypb.Empty)(nil),                                 // 121: google.protobuf.Empty
-	(*common.Runtime)(nil),                                // 122: confirmate.common.v1.Runtime
-	(*ListControlsInScopeResponse)(nil),                   // 123: confirmate.orchestrator.v1.ListControlsInScopeResponse
-	(*ListAuditTrailEventsResponse)(nil),                  // 124: confirmate.orchestrator.v1.ListAuditTrailEventsResponse
+	(ExternalFindingsFileFormat)(0),                 // 0: confirmate.orchestrator.v1.ExternalFindingsFileFormat
+	(AlertType)(0),                                  // 1: confirmate.orchestrator.v1.AlertType
+	(EventCategory)(0),                              // 2: confirmate.orchestrator.v1.EventCategory
+	(RequestType)(0),                                // 3: confirmate.orchestrator.v1.RequestType
+	(MetadataFieldType)(0),                          // 4: confirmate.orchestrator.v1.MetadataFieldType
+	(RedactionMode)(0),                              // 5: confirmate.orchestrator.v1.RedactionMode
+	(CatalogStatus)(0),                              // 6: confirmate.orchestrator.v1.CatalogStatus
+	(CatalogSourceFormat)(0),                        // 7: confirmate.orchestrator.v1.CatalogSourceFormat
+	(CatalogChangelogTrigger)(0),                    // 8: confirmate.orchestrator.v1.CatalogChangelogTrigger
+	(ControlChangeType)(0),                          // 9: confirmate.orchestrator.v1.ControlChangeType
+	(AuditScopeStatus)(0),                           // 10: confirmate.orchestrator.v1.AuditScopeStatus
+	(MaintenanceMode)(0),                            // 11: confirmate.orchestrator.v1.MaintenanceMode
+	(SchemaRelationshipType)(0),                     // 12: confirmate.orchestrator.v1.SchemaRelationshipType
+	(MappingFileFormat)(0),                          // 13: confirmate.orchestrator.v1.MappingFileFormat
+	(TargetOfEvaluation_TargetType)(0),              // 14: confirmate.orchestrator.v1.TargetOfEvaluation.TargetType
+	(*RegisterAssessmentToolRequest)(nil),           // 15: confirmate.orchestrator.v1.RegisterAssessmentToolRequest
+	(*ListAssessmentToolsRequest)(nil),              // 16: confirmate.orchestrator.v1.ListAssessmentToolsRequest
+	(*ListAssessmentToolsResponse)(nil),             // 17: confirmate.orchestrator.v1.ListAssessmentToolsResponse
+	(*GetAssessmentToolRequest)(nil),                // 18: confirmate.orchestrator.v1.GetAssessmentToolRequest
+	(*UpdateAssessmentToolRequest)(nil),             // 19: confirmate.orchestrator.v1.UpdateAssessmentToolRequest
+	(*DeregisterAssessmentToolRequest)(nil),         // 20: confirmate.orchestrator.v1.DeregisterAssessmentToolRequest
+	(*StoreAssessmentResultRequest)(nil),            // 21: confirmate.orchestrator.v1.StoreAssessmentResultRequest
+	(*StoreAssessmentResultResponse)(nil),           // 22: confirmate.orchestrator.v1.StoreAssessmentResultResponse
+	(*StoreAssessmentResultsResponse)(nil),          // 23: confirmate.orchestrator.v1.StoreAssessmentResultsResponse
+	(*BatchStoreAssessmentResultsRequest)(nil),      // 24: confirmate.orchestrator.v1.BatchStoreAssessmentResultsRequest
+	(*BatchStoreAssessmentResultsResponse)(nil),     // 25: confirmate.orchestrator.v1.BatchStoreAssessmentResultsResponse
+	(*WaiveAssessmentResultRequest)(nil),            // 26: confirmate.orchestrator.v1.WaiveAssessmentResultRequest
+	(*RevokeAssessmentResultWaiverRequest)(nil),     // 27: confirmate.orchestrator.v1.RevokeAssessmentResultWaiverRequest
+	(*ImportExternalFindingsRequest)(nil),           // 28: confirmate.orchestrator.v1.ImportExternalFindingsRequest
+	(*ImportExternalFindingsResponse)(nil),          // 29: confirmate.orchestrator.v1.ImportExternalFindingsResponse
+	(*StoreEvaluationResultRequest)(nil),            // 30: confirmate.orchestrator.v1.StoreEvaluationResultRequest
+	(*ListEvaluationResultsRequest)(nil),            // 31: confirmate.orchestrator.v1.ListEvaluationResultsRequest
+	(*ListEvaluationResultsResponse)(nil),           // 32: confirmate.orchestrator.v1.ListEvaluationResultsResponse
+	(*SavedView)(nil),                               // 33: confirmate.orchestrator.v1.SavedView
+	(*CreateSavedViewRequest)(nil),                  // 34: confirmate.orchestrator.v1.CreateSavedViewRequest
+	(*GetSavedViewRequest)(nil),                     // 35: confirmate.orchestrator.v1.GetSavedViewRequest
+	(*ListSavedViewsRequest)(nil),                   // 36: confirmate.orchestrator.v1.ListSavedViewsRequest
+	(*ListSavedViewsResponse)(nil),                  // 37: confirmate.orchestrator.v1.ListSavedViewsResponse
+	(*UpdateSavedViewRequest)(nil),                  // 38: confirmate.orchestrator.v1.UpdateSavedViewRequest
+	(*RemoveSavedViewRequest)(nil),                  // 39: confirmate.orchestrator.v1.RemoveSavedViewRequest
+	(*ListSlaBreachesRequest)(nil),                  // 40: confirmate.orchestrator.v1.ListSlaBreachesRequest
+	(*ListSlaBreachesResponse)(nil),                 // 41: confirmate.orchestrator.v1.ListSlaBreachesResponse
+	(*SlaBreach)(nil),                               // 42: confirmate.orchestrator.v1.SlaBreach
+	(*GetCompliancePostureRequest)(nil),             // 43: confirmate.orchestrator.v1.GetCompliancePostureRequest
+	(*CompliancePosture)(nil),                       // 44: confirmate.orchestrator.v1.CompliancePosture
+	(*CatalogPosture)(nil),                          // 45: confirmate.orchestrator.v1.CatalogPosture
+	(*ControlFamilyPosture)(nil),                    // 46: confirmate.orchestrator.v1.ControlFamilyPosture
+	(*ComplianceCounts)(nil),                        // 47: confirmate.orchestrator.v1.ComplianceCounts
+	(*ListAlertsRequest)(nil),                       // 48: confirmate.orchestrator.v1.ListAlertsRequest
+	(*ListAlertsResponse)(nil),                      // 49: confirmate.orchestrator.v1.ListAlertsResponse
+	(*Alert)(nil),                                   // 50: confirmate.orchestrator.v1.Alert
+	(*UploadAttachmentRequest)(nil),                 // 51: confirmate.orchestrator.v1.UploadAttachmentRequest
+	(*DownloadAttachmentRequest)(nil),               // 52: confirmate.orchestrator.v1.DownloadAttachmentRequest
+	(*DownloadAttachmentResponse)(nil),              // 53: confirmate.orchestrator.v1.DownloadAttachmentResponse
+	(*ListAttachmentsRequest)(nil),                  // 54: confirmate.orchestrator.v1.ListAttachmentsRequest
+	(*ListAttachmentsResponse)(nil),                 // 55: confirmate.orchestrator.v1.ListAttachmentsResponse
+	(*RemoveAttachmentRequest)(nil),                 // 56: confirmate.orchestrator.v1.RemoveAttachmentRequest
+	(*AddEvaluationResultCommentRequest)(nil),       // 57: confirmate.orchestrator.v1.AddEvaluationResultCommentRequest
+	(*ListEvaluationResultCommentsRequest)(nil),     // 58: confirmate.orchestrator.v1.ListEvaluationResultCommentsRequest
+	(*ListEvaluationResultCommentsResponse)(nil),    // 59: confirmate.orchestrator.v1.ListEvaluationResultCommentsResponse
+	(*RemoveEvaluationResultCommentRequest)(nil),    // 60: confirmate.orchestrator.v1.RemoveEvaluationResultCommentRequest
+	(*CreateMetricRequest)(nil),                     // 61: confirmate.orchestrator.v1.CreateMetricRequest
+	(*UpdateMetricRequest)(nil),                     // 62: confirmate.orchestrator.v1.UpdateMetricRequest
+	(*GetMetricRequest)(nil),                        // 63: confirmate.orchestrator.v1.GetMetricRequest
+	(*ListMetricsRequest)(nil),                      // 64: confirmate.orchestrator.v1.ListMetricsRequest
+	(*RemoveMetricRequest)(nil),                     // 65: confirmate.orchestrator.v1.RemoveMetricRequest
+	(*ListMetricsResponse)(nil),                     // 66: confirmate.orchestrator.v1.ListMetricsResponse
+	(*GetTargetOfEvaluationRequest)(nil),            // 67: confirmate.orchestrator.v1.GetTargetOfEvaluationRequest
+	(*CreateTargetOfEvaluationRequest)(nil),         // 68: confirmate.orchestrator.v1.CreateTargetOfEvaluationRequest
+	(*UpdateTargetOfEvaluationRequest)(nil),         // 69: confirmate.orchestrator.v1.UpdateTargetOfEvaluationRequest
+	(*RemoveTargetOfEvaluationRequest)(nil),         // 70: confirmate.orchestrator.v1.RemoveTargetOfEvaluationRequest
+	(*MergeTargetsOfEvaluationRequest)(nil),         // 71: confirmate.orchestrator.v1.MergeTargetsOfEvaluationRequest
+	(*MergeTargetsOfEvaluationResponse)(nil),        // 72: confirmate.orchestrator.v1.MergeTargetsOfEvaluationResponse
+	(*ListTargetsOfEvaluationRequest)(nil),          // 73: confirmate.orchestrator.v1.ListTargetsOfEvaluationRequest
+	(*ListTargetsOfEvaluationResponse)(nil),         // 74: confirmate.orchestrator.v1.ListTargetsOfEvaluationResponse
+	(*GetTargetOfEvaluationStatisticsRequest)(nil),  // 75: confirmate.orchestrator.v1.GetTargetOfEvaluationStatisticsRequest
+	(*GetTargetOfEvaluationStatisticsResponse)(nil), // 76: confirmate.orchestrator.v1.GetTargetOfEvaluationStatisticsResponse
+	(*GetUsageReportRequest)(nil),                   // 77: confirmate.orchestrator.v1.GetUsageReportRequest
+	(*UsageReport)(nil),                             // 78: confirmate.orchestrator.v1.UsageReport
+	(*TargetOfEvaluationUsage)(nil),                 // 79: confirmate.orchestrator.v1.TargetOfEvaluationUsage
+	(*UsageRecord)(nil),                             // 80: confirmate.orchestrator.v1.UsageRecord
+	(*Secret)(nil),                                  // 81: confirmate.orchestrator.v1.Secret
+	(*SecretValue)(nil),                             // 82: confirmate.orchestrator.v1.SecretValue
+	(*CreateSecretRequest)(nil),                     // 83: confirmate.orchestrator.v1.CreateSecretRequest
+	(*ListSecretsRequest)(nil),                      // 84: confirmate.orchestrator.v1.ListSecretsRequest
+	(*ListSecretsResponse)(nil),                     // 85: confirmate.orchestrator.v1.ListSecretsResponse
+	(*UpdateSecretRequest)(nil),                     // 86: confirmate.orchestrator.v1.UpdateSecretRequest
+	(*RemoveSecretRequest)(nil),                     // 87: confirmate.orchestrator.v1.RemoveSecretRequest
+	(*AccessSecretRequest)(nil),                     // 88: confirmate.orchestrator.v1.AccessSecretRequest
+	(*ResourceInventory)(nil),                       // 89: confirmate.orchestrator.v1.ResourceInventory
+	(*ResourceTypeCount)(nil),                       // 90: confirmate.orchestrator.v1.ResourceTypeCount
+	(*StoreResourceInventoryRequest)(nil),           // 91: confirmate.orchestrator.v1.StoreResourceInventoryRequest
+	(*ListResourceInventoriesRequest)(nil),          // 92: confirmate.orchestrator.v1.ListResourceInventoriesRequest
+	(*ListResourceInventoriesResponse)(nil),         // 93: confirmate.orchestrator.v1.ListResourceInventoriesResponse
+	(*UpdateMetadataFieldRequest)(nil),              // 94: confirmate.orchestrator.v1.UpdateMetadataFieldRequest
+	(*ListMetadataFieldsRequest)(nil),               // 95: confirmate.orchestrator.v1.ListMetadataFieldsRequest
+	(*ListMetadataFieldsResponse)(nil),              // 96: confirmate.orchestrator.v1.ListMetadataFieldsResponse
+	(*RemoveMetadataFieldRequest)(nil),              // 97: confirmate.orchestrator.v1.RemoveMetadataFieldRequest
+	(*UpdateRedactionRuleRequest)(nil),              // 98: confirmate.orchestrator.v1.UpdateRedactionRuleRequest
+	(*ListRedactionRulesRequest)(nil),               // 99: confirmate.orchestrator.v1.ListRedactionRulesRequest
+	(*ListRedactionRulesResponse)(nil),              // 100: confirmate.orchestrator.v1.ListRedactionRulesResponse
+	(*RemoveRedactionRuleRequest)(nil),              // 101: confirmate.orchestrator.v1.RemoveRedactionRuleRequest
+	(*UpdateMetricConfigurationRequest)(nil),        // 102: confirmate.orchestrator.v1.UpdateMetricConfigurationRequest
+	(*GetMetricConfigurationRequest)(nil),           // 103: confirmate.orchestrator.v1.GetMetricConfigurationRequest
+	(*ResolveMetricConfigurationRequest)(nil),       // 104: confirmate.orchestrator.v1.ResolveMetricConfigurationRequest
+	(*ResolveMetricConfigurationResponse)(nil),      // 105: confirmate.orchestrator.v1.ResolveMetricConfigurationResponse
+	(*MetricConfigurationResolutionStep)(nil),       // 106: confirmate.orchestrator.v1.MetricConfigurationResolutionStep
+	(*ListMetricConfigurationRequest)(nil),          // 107: confirmate.orchestrator.v1.ListMetricConfigurationRequest
+	(*ListMetricConfigurationResponse)(nil),         // 108: confirmate.orchestrator.v1.ListMetricConfigurationResponse
+	(*UpdateCatalogMetricConfigurationRequest)(nil), // 109: confirmate.orchestrator.v1.UpdateCatalogMetricConfigurationRequest
+	(*ListCatalogMetricConfigurationsRequest)(nil),  // 110: confirmate.orchestrator.v1.ListCatalogMetricConfigurationsRequest
+	(*ListCatalogMetricConfigurationsResponse)(nil), // 111: confirmate.orchestrator.v1.ListCatalogMetricConfigurationsResponse
+	(*RemoveCatalogMetricConfigurationRequest)(nil), // 112: confirmate.orchestrator.v1.RemoveCatalogMetricConfigurationRequest
+	(*UpdateMetricImplementationRequest)(nil),       // 113: confirmate.orchestrator.v1.UpdateMetricImplementationRequest
+	(*GetMetricImplementationRequest)(nil),          // 114: confirmate.orchestrator.v1.GetMetricImplementationRequest
+	(*SubscribeRequest)(nil),                        // 115: confirmate.orchestrator.v1.SubscribeRequest
+	(*ChangeEvent)(nil),                             // 116: confirmate.orchestrator.v1.ChangeEvent
+	(*Webhook)(nil),                                 // 117: confirmate.orchestrator.v1.Webhook
+	(*WebhookDelivery)(nil),                         // 118: confirmate.orchestrator.v1.WebhookDelivery
+	(*AssessmentTool)(nil),                          // 119: confirmate.orchestrator.v1.AssessmentTool
+	(*MetadataField)(nil),                           // 120: confirmate.orchestrator.v1.MetadataField
+	(*RedactionRule)(nil),                           // 121: confirmate.orchestrator.v1.RedactionRule
+	(*TargetOfEvaluation)(nil),                      // 122: confirmate.orchestrator.v1.TargetOfEvaluation
+	(*Catalog)(nil),                                 // 123: confirmate.orchestrator.v1.Catalog
+	(*EvaluationDefaults)(nil),                      // 124: confirmate.orchestrator.v1.EvaluationDefaults
+	(*CustomStatus)(nil),                            // 125: confirmate.orchestrator.v1.CustomStatus
+	(*StatusAggregationRule)(nil),                   // 126: confirmate.orchestrator.v1.StatusAggregationRule
+	(*AssuranceLevel)(nil),                          // 127: confirmate.orchestrator.v1.AssuranceLevel
+	(*ApplicabilityRule)(nil),                       // 128: confirmate.orchestrator.v1.ApplicabilityRule
+	(*CatalogSource)(nil),                           // 129: confirmate.orchestrator.v1.CatalogSource
+	(*CatalogSyncReport)(nil),                       // 130: confirmate.orchestrator.v1.CatalogSyncReport
+	(*CatalogDiff)(nil),                             // 131: confirmate.orchestrator.v1.CatalogDiff
+	(*CatalogChangelog)(nil),                        // 132: confirmate.orchestrator.v1.CatalogChangelog
+	(*ControlChange)(nil),                           // 133: confirmate.orchestrator.v1.ControlChange
+	(*SlaThreshold)(nil),                            // 134: confirmate.orchestrator.v1.SlaThreshold
+	(*Category)(nil),                                // 135: confirmate.orchestrator.v1.Category
+	(*Control)(nil),                                 // 136: confirmate.orchestrator.v1.Control
+	(*ControlReference)(nil),                        // 137: confirmate.orchestrator.v1.ControlReference
+	(*ControlTextVersion)(nil),                      // 138: confirmate.orchestrator.v1.ControlTextVersion
+	(*ControlMetric)(nil),                           // 139: confirmate.orchestrator.v1.ControlMetric
+	(*AuditScope)(nil),                              // 140: confirmate.orchestrator.v1.AuditScope
+	(*MaintenanceWindow)(nil),                       // 141: confirmate.orchestrator.v1.MaintenanceWindow
+	(*GetAssessmentResultRequest)(nil),              // 142: confirmate.orchestrator.v1.GetAssessmentResultRequest
+	(*ListAssessmentResultsRequest)(nil),            // 143: confirmate.orchestrator.v1.ListAssessmentResultsRequest
+	(*ListAssessmentResultsResponse)(nil),           // 144: confirmate.orchestrator.v1.ListAssessmentResultsResponse
+	(*AssessmentResultSampling)(nil),                // 145: confirmate.orchestrator.v1.AssessmentResultSampling
+	(*CreateAuditScopeRequest)(nil),                 // 146: confirmate.orchestrator.v1.CreateAuditScopeRequest
+	(*RemoveAuditScopeRequest)(nil),                 // 147: confirmate.orchestrator.v1.RemoveAuditScopeRequest
+	(*GetAuditScopeRequest)(nil),                    // 148: confirmate.orchestrator.v1.GetAuditScopeRequest
+	(*ListAuditScopesRequest)(nil),                  // 149: confirmate.orchestrator.v1.ListAuditScopesRequest
+	(*ListAuditScopesResponse)(nil),                 // 150: confirmate.orchestrator.v1.ListAuditScopesResponse
+	(*UpdateAuditScopeRequest)(nil),                 // 151: confirmate.orchestrator.v1.UpdateAuditScopeRequest
+	(*CreateMaintenanceWindowRequest)(nil),          // 152: confirmate.orchestrator.v1.CreateMaintenanceWindowRequest
+	(*ListMaintenanceWindowsRequest)(nil),           // 153: confirmate.orchestrator.v1.ListMaintenanceWindowsRequest
+	(*ListMaintenanceWindowsResponse)(nil),          // 154: confirmate.orchestrator.v1.ListMaintenanceWindowsResponse
+	(*RemoveMaintenanceWindowRequest)(nil),          // 155: confirmate.orchestrator.v1.RemoveMaintenanceWindowRequest
+	(*CreateWebhookRequest)(nil),                    // 156: confirmate.orchestrator.v1.CreateWebhookRequest
+	(*ListWebhooksRequest)(nil),                     // 157: confirmate.orchestrator.v1.ListWebhooksRequest
+	(*ListWebhooksResponse)(nil),                    // 158: confirmate.orchestrator.v1.ListWebhooksResponse
+	(*RemoveWebhookRequest)(nil),                    // 159: confirmate.orchestrator.v1.RemoveWebhookRequest
+	(*ListWebhookDeliveriesRequest)(nil),            // 160: confirmate.orchestrator.v1.ListWebhookDeliveriesRequest
+	(*ListWebhookDeliveriesResponse)(nil),           // 161: confirmate.orchestrator.v1.ListWebhookDeliveriesResponse
+	(*ExportOSCALRequest)(nil),                      // 162: confirmate.orchestrator.v1.ExportOSCALRequest
+	(*ExportOSCALResponse)(nil),                     // 163: confirmate.orchestrator.v1.ExportOSCALResponse
+	(*ArchiveAuditScopeDataRequest)(nil),            // 164: confirmate.orchestrator.v1.ArchiveAuditScopeDataRequest
+	(*ArchiveAuditScopeDataResponse)(nil),           // 165: confirmate.orchestrator.v1.ArchiveAuditScopeDataResponse
+	(*RestoreAuditScopeDataRequest)(nil),            // 166: confirmate.orchestrator.v1.RestoreAuditScopeDataRequest
+	(*RestoreAuditScopeDataResponse)(nil),           // 167: confirmate.orchestrator.v1.RestoreAuditScopeDataResponse
+	(*GetSchemaRequest)(nil),                        // 168: confirmate.orchestrator.v1.GetSchemaRequest
+	(*Schema)(nil),                                  // 169: confirmate.orchestrator.v1.Schema
+	(*SchemaEntity)(nil),                            // 170: confirmate.orchestrator.v1.SchemaEntity
+	(*SchemaField)(nil),                             // 171: confirmate.orchestrator.v1.SchemaField
+	(*SchemaRelationship)(nil),                      // 172: confirmate.orchestrator.v1.SchemaRelationship
+	(*SchemaForeignKey)(nil),                        // 173: confirmate.orchestrator.v1.SchemaForeignKey
+	(*GetCertificateRequest)(nil),                   // 174: confirmate.orchestrator.v1.GetCertificateRequest
+	(*ListCertificatesRequest)(nil),                 // 175: confirmate.orchestrator.v1.ListCertificatesRequest
+	(*ListCertificatesResponse)(nil),                // 176: confirmate.orchestrator.v1.ListCertificatesResponse
+	(*ListPublicCertificatesRequest)(nil),           // 177: confirmate.orchestrator.v1.ListPublicCertificatesRequest
+	(*ListPublicCertificatesResponse)(nil),          // 178: confirmate.orchestrator.v1.ListPublicCertificatesResponse
+	(*UpdateCertificateRequest)(nil),                // 179: confirmate.orchestrator.v1.UpdateCertificateRequest
+	(*CreateCatalogRequest)(nil),                    // 180: confirmate.orchestrator.v1.CreateCatalogRequest
+	(*RemoveCatalogRequest)(nil),                    // 181: confirmate.orchestrator.v1.RemoveCatalogRequest
+	(*GetCatalogRequest)(nil),                       // 182: confirmate.orchestrator.v1.GetCatalogRequest
+	(*GetCatalogTreeRequest)(nil),                   // 183: confirmate.orchestrator.v1.GetCatalogTreeRequest
+	(*GetCatalogTreeResponse)(nil),                  // 184: confirmate.orchestrator.v1.GetCatalogTreeResponse
+	(*CatalogTreeCategory)(nil),                     // 185: confirmate.orchestrator.v1.CatalogTreeCategory
+	(*CatalogTreeControl)(nil),                      // 186: confirmate.orchestrator.v1.CatalogTreeControl
+	(*ListCatalogChangelogsRequest)(nil),            // 187: confirmate.orchestrator.v1.ListCatalogChangelogsRequest
+	(*ListCatalogChangelogsResponse)(nil),           // 188: confirmate.orchestrator.v1.ListCatalogChangelogsResponse
+	(*GetCatalogChangelogRequest)(nil),              // 189: confirmate.orchestrator.v1.GetCatalogChangelogRequest
+	(*ListCatalogsRequest)(nil),                     // 190: confirmate.orchestrator.v1.ListCatalogsRequest
+	(*ListCatalogsResponse)(nil),                    // 191: confirmate.orchestrator.v1.ListCatalogsResponse
+	(*UpdateCatalogRequest)(nil),                    // 192: confirmate.orchestrator.v1.UpdateCatalogRequest
+	(*PublishCatalogRequest)(nil),                   // 193: confirmate.orchestrator.v1.PublishCatalogRequest
+	(*DiscardCatalogDraftRequest)(nil),              // 194: confirmate.orchestrator.v1.DiscardCatalogDraftRequest
+	(*ImportControlMetricMappingRequest)(nil),       // 195: confirmate.orchestrator.v1.ImportControlMetricMappingRequest
+	(*ImportIssue)(nil),                             // 196: confirmate.orchestrator.v1.ImportIssue
+	(*ImportControlMetricMappingResponse)(nil),      // 197: confirmate.orchestrator.v1.ImportControlMetricMappingResponse
+	(*UpdateAssuranceLevelRequest)(nil),             // 198: confirmate.orchestrator.v1.UpdateAssuranceLevelRequest
+	(*ListAssuranceLevelsRequest)(nil),              // 199: confirmate.orchestrator.v1.ListAssuranceLevelsRequest
+	(*ListAssuranceLevelsResponse)(nil),             // 200: confirmate.orchestrator.v1.ListAssuranceLevelsResponse
+	(*RemoveAssuranceLevelRequest)(nil),             // 201: confirmate.orchestrator.v1.RemoveAssuranceLevelRequest
+	(*UpdateApplicabilityRuleRequest)(nil),          // 202: confirmate.orchestrator.v1.UpdateApplicabilityRuleRequest
+	(*ListApplicabilityRulesRequest)(nil),           // 203: confirmate.orchestrator.v1.ListApplicabilityRulesRequest
+	(*ListApplicabilityRulesResponse)(nil),          // 204: confirmate.orchestrator.v1.ListApplicabilityRulesResponse
+	(*CreateCatalogSourceRequest)(nil),              // 205: confirmate.orchestrator.v1.CreateCatalogSourceRequest
+	(*ListCatalogSourcesRequest)(nil),               // 206: confirmate.orchestrator.v1.ListCatalogSourcesRequest
+	(*ListCatalogSourcesResponse)(nil),              // 207: confirmate.orchestrator.v1.ListCatalogSourcesResponse
+	(*RemoveCatalogSourceRequest)(nil),              // 208: confirmate.orchestrator.v1.RemoveCatalogSourceRequest
+	(*SyncCatalogSourceRequest)(nil),                // 209: confirmate.orchestrator.v1.SyncCatalogSourceRequest
+	(*RemoveApplicabilityRuleRequest)(nil),          // 210: confirmate.orchestrator.v1.RemoveApplicabilityRuleRequest
+	(*GetCategoryRequest)(nil),                      // 211: confirmate.orchestrator.v1.GetCategoryRequest
+	(*GetControlRequest)(nil),                       // 212: confirmate.orchestrator.v1.GetControlRequest
+	(*ListControlTextVersionsRequest)(nil),          // 213: confirmate.orchestrator.v1.ListControlTextVersionsRequest
+	(*ListControlTextVersionsResponse)(nil),         // 214: confirmate.orchestrator.v1.ListControlTextVersionsResponse
+	(*RenderControlTextRequest)(nil),                // 215: confirmate.orchestrator.v1.RenderControlTextRequest
+	(*RenderControlTextResponse)(nil),               // 216: confirmate.orchestrator.v1.RenderControlTextResponse
+	(*ListControlsRequest)(nil),                     // 217: confirmate.orchestrator.v1.ListControlsRequest
+	(*ListControlsResponse)(nil),                    // 218: confirmate.orchestrator.v1.ListControlsResponse
+	(*CreateCertificateRequest)(nil),                // 219: confirmate.orchestrator.v1.CreateCertificateRequest
+	(*RemoveCertificateRequest)(nil),                // 220: confirmate.orchestrator.v1.RemoveCertificateRequest
+	(*Certificate)(nil),                             // 221: confirmate.orchestrator.v1.Certificate
+	(*State)(nil),                                   // 222: confirmate.orchestrator.v1.State
+	(*UpsertUserPermissionRequest)(nil),             // 223: confirmate.orchestrator.v1.UpsertUserPermissionRequest
+	(*UpsertUserPermissionResponse)(nil),            // 224: confirmate.orchestrator.v1.UpsertUserPermissionResponse
+	(*RemoveUserPermissionRequest)(nil),             // 225: confirmate.orchestrator.v1.RemoveUserPermissionRequest
+	(*GetCurrentUserRequest)(nil),                   // 226: confirmate.orchestrator.v1.GetCurrentUserRequest
+	(*GetUserRequest)(nil),                          // 227: confirmate.orchestrator.v1.GetUserRequest
+	(*ListUsersRequest)(nil),                        // 228: confirmate.orchestrator.v1.ListUsersRequest
+	(*ListUsersResponse)(nil),                       // 229: confirmate.orchestrator.v1.ListUsersResponse
+	(*ResolveUserRequest)(nil),                      // 230: confirmate.orchestrator.v1.ResolveUserRequest
+	(*SyncUsersRequest)(nil),                        // 231: confirmate.orchestrator.v1.SyncUsersRequest
+	(*SyncUsersResponse)(nil),                       // 232: confirmate.orchestrator.v1.SyncUsersResponse
+	(*ListUserPermissionsRequest)(nil),              // 233: confirmate.orchestrator.v1.ListUserPermissionsRequest
+	(*ListUserPermissionsResponse)(nil),             // 234: confirmate.orchestrator.v1.ListUserPermissionsResponse
+	(*ListUserRolesRequest)(nil),                    // 235: confirmate.orchestrator.v1.ListUserRolesRequest
+	(*ListUserRolesResponse)(nil),                   // 236: confirmate.orchestrator.v1.ListUserRolesResponse
+	(*RemoveUserRequest)(nil),                       // 237: confirmate.orchestrator.v1.RemoveUserRequest
+	(*ListAssessmentToolsRequest_Filter)(nil),       // 238: confirmate.orchestrator.v1.ListAssessmentToolsRequest.Filter
+	(*ListEvaluationResultsRequest_Filter)(nil),     // 239: confirmate.orchestrator.v1.ListEvaluationResultsRequest.Filter
+	(*ListAlertsRequest_Filter)(nil),                // 240: confirmate.orchestrator.v1.ListAlertsRequest.Filter
+	(*ListMetricsRequest_Filter)(nil),               // 241: confirmate.orchestrator.v1.ListMetricsRequest.Filter
+	(*ListTargetsOfEvaluationRequest_Filter)(nil),   // 242: confirmate.orchestrator.v1.ListTargetsOfEvaluationRequest.Filter
+	nil,                                     // 243: confirmate.orchestrator.v1.ListTargetsOfEvaluationRequest.Filter.CustomFieldsEntry
+	nil,                                     // 244: confirmate.orchestrator.v1.ListMetricConfigurationResponse.ConfigurationsEntry
+	(*SubscribeRequest_Filter)(nil),         // 245: confirmate.orchestrator.v1.SubscribeRequest.Filter
+	(*TargetOfEvaluation_Metadata)(nil),     // 246: confirmate.orchestrator.v1.TargetOfEvaluation.Metadata
+	(*TargetOfEvaluation_Organization)(nil), // 247: confirmate.orchestrator.v1.TargetOfEvaluation.Organization
+	nil,                                     // 248: confirmate.orchestrator.v1.TargetOfEvaluation.Metadata.LabelsEntry
+	nil,                                     // 249: confirmate.orchestrator.v1.TargetOfEvaluation.Metadata.CustomFieldsEntry
+	(*TargetOfEvaluation_Organization_PostalAddress)(nil), // 250: confirmate.orchestrator.v1.TargetOfEvaluation.Organization.PostalAddress
+	(*Catalog_Metadata)(nil),                              // 251: confirmate.orchestrator.v1.Catalog.Metadata
+	(*ListAssessmentResultsRequest_Filter)(nil),           // 252: confirmate.orchestrator.v1.ListAssessmentResultsRequest.Filter
+	(*ListAuditScopesRequest_Filter)(nil),                 // 253: confirmate.orchestrator.v1.ListAuditScopesRequest.Filter
+	(*ListControlsRequest_Filter)(nil),                    // 254: confirmate.orchestrator.v1.ListControlsRequest.Filter
+	(*ListUsersRequest_Filter)(nil),                       // 255: confirmate.orchestrator.v1.ListUsersRequest.Filter
+	nil,                                                   // 256: confirmate.orchestrator.v1.ListUsersRequest.Filter.AttributesEntry
+	(*ListUserPermissionsRequest_Filter)(nil),             // 257: confirmate.orchestrator.v1.ListUserPermissionsRequest.Filter
+	(*assessment.AssessmentResult)(nil),                   // 258: confirmate.assessment.v1.AssessmentResult
+	(*timestamppb.Timestamp)(nil),                         // 259: google.protobuf.Timestamp
+	(*evaluation.EvaluationResult)(nil),                   // 260: confirmate.evaluation.v1.EvaluationResult
+	(*evaluation.Attachment)(nil),                         // 261: confirmate.evaluation.v1.Attachment
+	(*evaluation.Comment)(nil),                            // 262: confirmate.evaluation.v1.Comment
+	(*assessment.Metric)(nil),                             // 263: confirmate.assessment.v1.Metric
+	(*assessment.MetricConfiguration)(nil),                // 264: confirmate.assessment.v1.MetricConfiguration
+	(assessment.MetricConfigurationSource)(0),             // 265: confirmate.assessment.v1.MetricConfigurationSource
+	(*structpb.Value)(nil),                                // 266: google.protobuf.Value
+	(*assessment.CatalogMetricConfiguration)(nil),         // 267: confirmate.assessment.v1.CatalogMetricConfiguration
+	(*assessment.MetricImplementation)(nil),               // 268: confirmate.assessment.v1.MetricImplementation
+	(*User)(nil),                                          // 269: confirmate.orchestrator.v1.User
+	(*ControlInScope)(nil),                                // 270: confirmate.orchestrator.v1.ControlInScope
+	(evaluation.AggregationStrategy)(0),                   // 271: confirmate.evaluation.v1.AggregationStrategy
+	(evaluation.EvaluationStatus)(0),                      // 272: confirmate.evaluation.v1.EvaluationStatus
+	(*assessment.Remediation)(nil),                        // 273: confirmate.assessment.v1.Remediation
+	(*AuditTrailEvent)(nil),                               // 274: confirmate.orchestrator.v1.AuditTrailEvent
+	(evaluation.SamplingStrategy)(0),                      // 275: confirmate.evaluation.v1.SamplingStrategy
+	(*UserPermission)(nil),                                // 276: confirmate.orchestrator.v1.UserPermission
+	(ObjectType)(0),                                       // 277: confirmate.orchestrator.v1.ObjectType
+	(Role)(0),                                             // 278: confirmate.orchestrator.v1.Role
+	(UserSource)(0),                                       // 279: confirmate.orchestrator.v1.UserSource
+	(*common.GetRuntimeInfoRequest)(nil),                  // 280: confirmate.common.v1.GetRuntimeInfoRequest
+	(*CreateControlInScopeRequest)(nil),                   // 281: confirmate.orchestrator.v1.CreateControlInScopeRequest
+	(*GetControlInScopeRequest)(nil),                      // 282: confirmate.orchestrator.v1.GetControlInScopeRequest
+	(*ListControlsInScopeRequest)(nil),                    // 283: confirmate.orchestrator.v1.ListControlsInScopeRequest
+	(*UpdateControlInScopeRequest)(nil),                   // 284: confirmate.orchestrator.v1.UpdateControlInScopeRequest
+	(*TransitionControlInScopeStateRequest)(nil),          // 285: confirmate.orchestrator.v1.TransitionControlInScopeStateRequest
+	(*RemoveControlInScopeRequest)(nil),                   // 286: confirmate.orchestrator.v1.RemoveControlInScopeRequest
+	(*ListAuditTrailEventsRequest)(nil),                   // 287: confirmate.orchestrator.v1.ListAuditTrailEventsRequest
+	(*emptypb.Empty)(nil),                                 // 288: google.protobuf.Empty
+	(*common.Runtime)(nil),                                // 289: confirmate.common.v1.Runtime
+	(*ListControlsInScopeResponse)(nil),                   // 290: confirmate.orchestrator.v1.ListControlsInScopeResponse
+	(*ListAuditTrailEventsResponse)(nil),                  // 291: confirmate.orchestrator.v1.ListAuditTrailEventsResponse
 }
 var file_api_orchestrator_orchestrator_proto_depIdxs = []int32{
-	38,  // 0: confirmate.orchestrator.v1.RegisterAssessmentToolRequest.tool:type_name -> confirmate.orchestrator.v1.AssessmentTool
-	85,  // 1: confirmate.orchestrator.v1.ListAssessmentToolsRequest.filter:type_name -> confirmate.orchestrator.v1.ListAssessmentToolsRequest.Filter
-	38,  // 2: confirmate.orchestrator.v1.ListAssessmentToolsResponse.tools:type_name -> confirmate.orchestrator.v1.AssessmentTool
-	38,  // 3: confirmate.orchestrator.v1.UpdateAssessmentToolRequest.tool:type_name -> confirmate.orchestrator.v1.AssessmentTool
-	101, // 4: confirmate.orchestrator.v1.StoreAssessmentResultRequest.result:type_name -> confirmate.assessment.v1.AssessmentResult
-	102, // 5: confirmate.orchestrator.v1.StoreEvaluationResultRequest.result:type_name -> confirmate.evaluation.v1.EvaluationResult
-	86,  // 6: confirmate.orchestrator.v1.ListEvaluationResultsRequest.filter:type_name -> confirmate.orchestrator.v1.ListEvaluationResultsRequest.Filter
-	102, // 7: confirmate.orchestrator.v1.ListEvaluationResultsResponse.results:type_name -> confirmate.evaluation.v1.EvaluationResult
-	103, // 8: confirmate.orchestrator.v1.CreateMetricRequest.metric:type_name -> confirmate.assessment.v1.Metric
-	103, // 9: confirmate.orchestrator.v1.UpdateMetricRequest.metric:type_name -> confirmate.assessment.v1.Metric
-	87,  // 10: confirmate.orchestrator.v1.ListMetricsRequest.filter:type_name -> confirmate.orchestrator.v1.ListMetricsRequest.Filter
-	103, // 11: confirmate.orchestrator.v1.ListMetricsResponse.metrics:type_name -> confirmate.assessment.v1.Metric
-	39,  // 12: confirmate.orchestrator.v1.CreateTargetOfEvaluationRequest.target_of_evaluation:type_name -> confirmate.orchestrator.v1.TargetOfEvaluation
-	39,  // 13: confirmate.orchestrator.v1.UpdateTargetOfEvaluationRequest.target_of_evaluation:type_name -> confirmate.orchestrator.v1.TargetOfEvaluation
-	39,  // 14: confirmate.orchestrator.v1.ListTargetsOfEvaluationResponse.targets_of_evaluation:type_name -> confirmate.orchestrator.v1.TargetOfEvaluation
-	104, // 15: confirmate.orchestrator.v1.UpdateMetricConfigurationRequest.configuration:type_name -> confirmate.assessment.v1.MetricConfiguration
-	88,  // 16: confirmate.orchestrator.v1.ListMetricConfigurationResponse.configurations:type_name -> confirmate.orchestrator.v1.ListMetricConfigurationResponse.ConfigurationsEntry
-	105, // 17: confirmate.orchestrator.v1.UpdateMetricImplementationRequest.implementation:type_name -> confirmate.assessment.v1.MetricImplementation
-	89,  // 18: confirmate.orchestrator.v1.SubscribeRequest.filter:type_name -> confirmate.orchestrator.v1.SubscribeRequest.Filter
-	106, // 19: confirmate.orchestrator.v1.ChangeEvent.timestamp:type_name -> google.protobuf.Timestamp
-	0,   // 20: confirmate.orchestrator.v1.ChangeEvent.category:type_name -> confirmate.orchestrator.v1.EventCategory
-	1,   // 21: confirmate.orchestrator.v1.ChangeEvent.request_type:type_name -> confirmate.orchestrator.v1.RequestType
-	103, // 22: confirmate.orchestrator.v1.ChangeEvent.metric:type_name -> confirmate.assessment.v1.Metric
-	39,  // 23: confirmate.orchestrator.v1.ChangeEvent.target_of_evaluation:type_name -> confirmate.orchestrator.v1.TargetOfEvaluation
-	43,  // 24: confirmate.orchestrator.v1.ChangeEvent.audit_scope:type_name -> confirmate.orchestrator.v1.AuditScope
-	101, // 25: confirmate.orchestrator.v1.ChangeEvent.assessment_result:type_name -> confirmate.assessment.v1.AssessmentResult
-	104, // 26: confirmate.orchestrator.v1.ChangeEvent.metric_configuration:type_name -> confirmate.assessment.v1.MetricConfiguration
-	105, // 27: confirmate.orchestrator.v1.ChangeEvent.metric_implementation:type_name -> confirmate.assessment.v1.MetricImplementation
-	38,  // 28: confirmate.orchestrator.v1.ChangeEvent.assessment_tool:type_name -> confirmate.orchestrator.v1.AssessmentTool
-	107, // 29: confirmate.orchestrator.v1.ChangeEvent.user:type_name -> confirmate.orchestrator.v1.User
-	108, // 30: confirmate.orchestrator.v1.ChangeEvent.control_in_scope:type_name -> confirmate.orchestrator.v1.ControlInScope
-	103, // 31: confirmate.orchestrator.v1.TargetOfEvaluation.configured_metrics:type_name -> confirmate.assessment.v1.Metric
-	106, // 32: confirmate.orchestrator.v1.TargetOfEvaluation.created_at:type_name -> google.protobuf.Timestamp
-	106, // 33: confirmate.orchestrator.v1.TargetOfEvaluation.updated_at:type_name -> google.protobuf.Timestamp
-	90,  // 34: confirmate.orchestrator.v1.TargetOfEvaluation.metadata:type_name -> confirmate.orchestrator.v1.TargetOfEvaluation.Metadata
-	3,   // 35: confirmate.orchestrator.v1.TargetOfEvaluation.target_type:type_name -> confirmate.orchestrator.v1.TargetOfEvaluation.TargetType
-	91,  // 36: confirmate.orchestrator.v1.TargetOfEvaluation.organization:type_name -> confirmate.orchestrator.v1.TargetOfEvaluation.Organization
-	41,  // 37: confirmate.orchestrator.v1.Catalog.categories:type_name -> confirmate.orchestrator.v1.Category
-	94,  // 38: confirmate.orchestrator.v1.Catalog.metadata:type_name -> confirmate.orchestrator.v1.Catalog.Metadata
-	42,  // 39: confirmate.orchestrator.v1.Category.controls:type_name -> confirmate.orchestrator.v1.Control
-	42,  // 40: confirmate.orchestrator.v1.Control.controls:type_name -> confirmate.orchestrator.v1.Control
-	103, // 41: confirmate.orchestrator.v1.Control.metrics:type_name -> confirmate.assessment.v1.Metric
-	108, // 42: confirmate.orchestrator.v1.Control.controls_in_scope:type_name -> confirmate.orchestrator.v1.ControlInScope
-	2,   // 43: confirmate.orchestrator.v1.AuditScope.status:type_name -> confirmate.orchestrator.v1.AuditScopeStatus
-	108, // 44: confirmate.orchestrator.v1.AuditScope.controls_in_scope:type_name -> confirmate.orchestrator.v1.ControlInScope
-	109, // 45: confirmate.orchestrator.v1.AuditScope.audit_trail_events:type_name -> confirmate.orchestrator.v1.AuditTrailEvent
-	95,  // 46: confirmate.orchestrator.v1.ListAssessmentResultsRequest.filter:type_name -> confirmate.orchestrator.v1.ListAssessmentResultsRequest.Filter
-	101, // 47: confirmate.orchestrator.v1.ListAssessmentResultsResponse.results:type_name -> confirmate.assessment.v1.AssessmentResult
-	43,  // 48: confirmate.orchestrator.v1.CreateAuditScopeRequest.audit_scope:type_name -> confirmate.orchestrator.v1.AuditScope
-	96,  // 49: confirmate.orchestrator.v1.ListAuditScopesRequest.filter:type_name -> confirmate.orchestrator.v1.ListAuditScopesRequest.Filter
-	43,  // 50: confirmate.orchestrator.v1.ListAuditScopesResponse.audit_scopes:type_name -> confirmate.orchestrator.v1.AuditScope
-	43,  // 51: confirmate.orchestrator.v1.UpdateAuditScopeRequest.audit_scope:type_name -> confirmate.orchestrator.v1.AuditScope
-	71,  // 52: confirmate.orchestrator.v1.ListCertificatesResponse.certificates:type_name -> confirmate.orchestrator.v1.Certificate
-	71,  // 53: confirmate.orchestrator.v1.ListPublicCertificatesResponse.certificates:type_name -> confirmate.orchestrator.v1.Certificate
-	71,  // 54: confirmate.orchestrator.v1.UpdateCertificateRequest.certificate:type_name -> confirmate.orchestrator.v1.Certificate
-	40,  // 55: confirmate.orchestrator.v1.CreateCatalogRequest.catalog:type_name -> confirmate.orchestrator.v1.Catalog
-	40,  // 56: confirmate.orchestrator.v1.ListCatalogsResponse.catalogs:type_name -> confirmate.orchestrator.v1.Catalog
-	40,  // 57: confirmate.orchestrator.v1.UpdateCatalogRequest.catalog:type_name -> confirmate.orchestrator.v1.Catalog
-	97,  // 58: confirmate.orchestrator.v1.ListControlsRequest.filter:type_name -> confirmate.orchestrator.v1.ListControlsRequest.Filter
-	42,  // 59: confirmate.orchestrator.v1.ListControlsResponse.controls:type_name -> confirmate.orchestrator.v1.Control
-	71,  // 60: confirmate.orchestrator.v1.CreateCertificateRequest.certificate:type_name -> confirmate.orchestrator.v1.Certificate
-	72,  // 61: confirmate.orchestrator.v1.Certificate.states:type_name -> confirmate.orchestrator.v1.State
-	110, // 62: confirmate.orchestrator.v1.UpsertUserPermissionRequest.user_permission:type_name -> confirmate.orchestrator.v1.UserPermission
-	110, // 63: confirmate.orchestrator.v1.UpsertUserPermissionResponse.user_permission:type_name -> confirmate.orchestrator.v1.UserPermission
-	111, // 64: confirmate.orchestrator.v1.RemoveUserPermissionRequest.object_type:type_name -> confirmate.orchestrator.v1.ObjectType
-	98,  // 65: confirmate.orchestrator.v1.ListUsersRequest.filter:type_name -> confirmate.orchestrator.v1.ListUsersRequest.Filter
-	107, // 66: confirmate.orchestrator.v1.ListUsersResponse.users:type_name -> confirmate.orchestrator.v1.User
-	100, // 67: confirmate.orchestrator.v1.ListUserPermissionsRequest.filter:type_name -> confirmate.orchestrator.v1.ListUserPermissionsRequest.Filter
-	110, // 68: confirmate.orchestrator.v1.ListUserPermissionsResponse.user_permissions:type_name -> confirmate.orchestrator.v1.UserPermission
-	112, // 69: confirmate.orchestrator.v1.ListUserRolesResponse.roles:type_name -> confirmate.orchestrator.v1.Role
-	104, // 70: confirmate.orchestrator.v1.ListMetricConfigurationResponse.ConfigurationsEntry.value:type_name -> confirmate.assessment.v1.MetricConfiguration
-	0,   // 71: confirmate.orchestrator.v1.SubscribeRequest.Filter.categories:type_name -> confirmate.orchestrator.v1.EventCategory
-	92,  // 72: confirmate.orchestrator.v1.TargetOfEvaluation.Metadata.labels:type_name -> confirmate.orchestrator.v1.TargetOfEvaluation.Metadata.LabelsEntry
-	93,  // 73: confirmate.orchestrator.v1.TargetOfEvaluation.Organization.address:type_name -> confirmate.orchestrator.v1.TargetOfEvaluation.Organization.PostalAddress
-	112, // 74: confirmate.orchestrator.v1.ListUsersRequest.Filter.role:type_name -> confirmate.orchestrator.v1.Role
-	99,  // 75: confirmate.orchestrator.v1.ListUsersRequest.Filter.attributes:type_name -> confirmate.orchestrator.v1.ListUsersRequest.Filter.AttributesEntry
-	111, // 76: confirmate.orchestrator.v1.ListUserPermissionsRequest.Filter.object_type:type_name -> confirmate.orchestrator.v1.ObjectType
-	4,   // 77: confirmate.orchestrator.v1.Orchestrator.RegisterAssessmentTool:input_type -> confirmate.orchestrator.v1.RegisterAssessmentToolRequest
-	5,   // 78: confirmate.orchestrator.v1.Orchestrator.ListAssessmentTools:input_type -> confirmate.orchestrator.v1.ListAssessmentToolsRequest
-	7,   // 79: confirmate.orchestrator.v1.Orchestrator.GetAssessmentTool:input_type -> confirmate.orchestrator.v1.GetAssessmentToolRequest
-	8,   // 80: confirmate.orchestrator.v1.Orchestrator.UpdateAssessmentTool:input_type -> confirmate.orchestrator.v1.UpdateAssessmentToolRequest
-	9,   // 81: confirmate.orchestrator.v1.Orchestrator.DeregisterAssessmentTool:input_type -> confirmate.orchestrator.v1.DeregisterAssessmentToolRequest
-	10,  // 82: confirmate.orchestrator.v1.Orchestrator.StoreAssessmentResult:input_type -> confirmate.orchestrator.v1.StoreAssessmentResultRequest
-	10,  // 83: confirmate.orchestrator.v1.Orchestrator.StoreAssessmentResults:input_type -> confirmate.orchestrator.v1.StoreAssessmentResultRequest
-	44,  // 84: confirmate.orchestrator.v1.Orchestrator.GetAssessmentResult:input_type -> confirmate.orchestrator.v1.GetAssessmentResultRequest
-	13,  // 85: confirmate.orchestrator.v1.Orchestrator.StoreEvaluationResult:input_type -> confirmate.orchestrator.v1.StoreEvaluationResultRequest
-	45,  // 86: confirmate.orchestrator.v1.Orchestrator.ListAssessmentResults:input_type -> confirmate.orchestrator.v1.ListAssessmentResultsRequest
-	14,  // 87: confirmate.orchestrator.v1.Orchestrator.ListEvaluationResults:input_type -> confirmate.orchestrator.v1.ListEvaluationResultsRequest
-	16,  // 88: confirmate.orchestrator.v1.Orchestrator.CreateMetric:input_type -> confirmate.orchestrator.v1.CreateMetricRequest
-	17,  // 89: confirmate.orchestrator.v1.Orchestrator.UpdateMetric:input_type -> confirmate.orchestrator.v1.UpdateMetricRequest
-	18,  // 90: confirmate.orchestrator.v1.Orchestrator.GetMetric:input_type -> confirmate.orchestrator.v1.GetMetricRequest
-	19,  // 91: confirmate.orchestrator.v1.Orchestrator.ListMetrics:input_type -> confirmate.orchestrator.v1.ListMetricsRequest
-	20,  // 92: confirmate.orchestrator.v1.Orchestrator.RemoveMetric:input_type -> confirmate.orchestrator.v1.RemoveMetricRequest
-	23,  // 93: confirmate.orchestrator.v1.Orchestrator.CreateTargetOfEvaluation:input_type -> confirmate.orchestrator.v1.CreateTargetOfEvaluationRequest
-	24,  // 94: confirmate.orchestrator.v1.Orchestrator.UpdateTargetOfEvaluation:input_type -> confirmate.orchestrator.v1.UpdateTargetOfEvaluationRequest
-	22,  // 95: confirmate.orchestrator.v1.Orchestrator.GetTargetOfEvaluation:input_type -> confirmate.orchestrator.v1.GetTargetOfEvaluationRequest
-	26,  // 96: confirmate.orchestrator.v1.Orchestrator.ListTargetsOfEvaluation:input_type -> confirmate.orchestrator.v1.ListTargetsOfEvaluationRequest
-	25,  // 97: confirmate.orchestrator.v1.Orchestrator.RemoveTargetOfEvaluation:input_type -> confirmate.orchestrator.v1.RemoveTargetOfEvaluationRequest
-	28,  // 98: confirmate.orchestrator.v1.Orchestrator.GetTargetOfEvaluationStatistics:input_type -> confirmate.orchestrator.v1.GetTargetOfEvaluationStatisticsRequest
-	30,  // 99: confirmate.orchestrator.v1.Orchestrator.UpdateMetricConfiguration:input_type -> confirmate.orchestrator.v1.UpdateMetricConfigurationRequest
-	31,  // 100: confirmate.orchestrator.v1.Orchestrator.GetMetricConfiguration:input_type -> confirmate.orchestrator.v1.GetMetricConfigurationRequest
-	32,  // 101: confirmate.orchestrator.v1.Orchestrator.ListMetricConfigurations:input_type -> confirmate.orchestrator.v1.ListMetricConfigurationRequest
-	34,  // 102: confirmate.orchestrator.v1.Orchestrator.UpdateMetricImplementation:input_type -> confirmate.orchestrator.v1.UpdateMetricImplementationRequest
-	35,  // 103: confirmate.orchestrator.v1.Orchestrator.GetMetricImplementation:input_type -> confirmate.orchestrator.v1.GetMetricImplementationRequest
-	36,  // 104: confirmate.orchestrator.v1.Orchestrator.Subscribe:input_type -> confirmate.orchestrator.v1.SubscribeRequest
-	69,  // 105: confirmate.orchestrator.v1.Orchestrator.CreateCertificate:input_type -> confirmate.orchestrator.v1.CreateCertificateRequest
-	53,  // 106: confirmate.orchestrator.v1.Orchestrator.GetCertificate:input_type -> confirmate.orchestrator.v1.GetCertificateRequest
-	54,  // 107: confirmate.orchestrator.v1.Orchestrator.ListCertificates:input_type -> confirmate.orchestrator.v1.ListCertificatesRequest
-	56,  // 108: confirmate.orchestrator.v1.Orchestrator.ListPublicCertificates:input_type -> confirmate.orchestrator.v1.ListPublicCertificatesRequest
-	58,  // 109: confirmate.orchestrator.v1.Orchestrator.UpdateCertificate:input_type -> confirmate.orchestrator.v1.UpdateCertificateRequest
-	70,  // 110: confirmate.orchestrator.v1.Orchestrator.RemoveCertificate:input_type -> confirmate.orchestrator.v1.RemoveCertificateRequest
-	59,  // 111: confirmate.orchestrator.v1.Orchestrator.CreateCatalog:input_type -> confirmate.orchestrator.v1.CreateCatalogRequest
-	62,  // 112: confirmate.orchestrator.v1.Orchestrator.ListCatalogs:input_type -> confirmate.orchestrator.v1.ListCatalogsRequest
-	61,  // 113: confirmate.orchestrator.v1.Orchestrator.GetCatalog:input_type -> confirmate.orchestrator.v1.GetCatalogRequest
-	60,  // 114: confirmate.orchestrator.v1.Orchestrator.RemoveCatalog:input_type -> confirmate.orchestrator.v1.RemoveCatalogRequest
-	64,  // 115: confirmate.orchestrator.v1.Orchestrator.UpdateCatalog:input_type -> confirmate.orchestrator.v1.UpdateCatalogRequest
-	65,  // 116: confirmate.orchestrator.v1.Orchestrator.GetCategory:input_type -> confirmate.orchestrator.v1.GetCategoryRequest
-	67,  // 117: confirmate.orchestrator.v1.Orchestrator.ListControls:input_type -> confirmate.orchestrator.v1.ListControlsRequest
-	66,  // 118: confirmate.orchestrator.v1.Orchestrator.GetControl:input_type -> confirmate.orchestrator.v1.GetControlRequest
-	47,  // 119: confirmate.orchestrator.v1.Orchestrator.CreateAuditScope:input_type -> confirmate.orchestrator.v1.CreateAuditScopeRequest
-	49,  // 120: confirmate.orchestrator.v1.Orchestrator.GetAuditScope:input_type -> confirmate.orchestrator.v1.GetAuditScopeRequest
-	50,  // 121: confirmate.orchestrator.v1.Orchestrator.ListAuditScopes:input_type -> confirmate.orchestrator.v1.ListAuditScopesRequest
-	52,  // 122: confirmate.orchestrator.v1.Orchestrator.UpdateAuditScope:input_type -> confirmate.orchestrator.v1.UpdateAuditScopeRequest
-	48,  // 123: confirmate.orchestrator.v1.Orchestrator.RemoveAuditScope:input_type -> confirmate.orchestrator.v1.RemoveAuditScopeRequest
-	113, // 124: confirmate.orchestrator.v1.Orchestrator.GetRuntimeInfo:input_type -> confirmate.common.v1.GetRuntimeInfoRequest
-	73,  // 125: confirmate.orchestrator.v1.Orchestrator.UpsertUserPermission:input_type -> confirmate.orchestrator.v1.UpsertUserPermissionRequest
-	75,  // 126: confirmate.orchestrator.v1.Orchestrator.RemoveUserPermission:input_type -> confirmate.orchestrator.v1.RemoveUserPermissionRequest
-	76,  // 127: confirmate.orchestrator.v1.Orchestrator.GetCurrentUser:input_type -> confirmate.orchestrator.v1.GetCurrentUserRequest
-	77,  // 128: confirmate.orchestrator.v1.Orchestrator.GetUser:input_type -> confirmate.orchestrator.v1.GetUserRequest
-	78,  // 129: confirmate.orchestrator.v1.Orchestrator.ListUsers:input_type -> confirmate.orchestrator.v1.ListUsersRequest
-	80,  // 130: confirmate.orchestrator.v1.Orchestrator.ListUserPermissions:input_type -> confirmate.orchestrator.v1.ListUserPermissionsRequest
-	82,  // 131: confirmate.orchestrator.v1.Orchestrator.ListUserRoles:input_type -> confirmate.orchestrator.v1.ListUserRolesRequest
-	84,  // 132: confirmate.orchestrator.v1.Orchestrator.RemoveUser:input_type -> confirmate.orchestrator.v1.RemoveUserRequest
-	114, // 133: confirmate.orchestrator.v1.Orchestrator.CreateControlInScope:input_type -> confirmate.orchestrator.v1.CreateControlInScopeRequest
-	115, // 134: confirmate.orchestrator.v1.Orchestrator.GetControlInScope:input_type -> confirmate.orchestrator.v1.GetControlInScopeRequest
-	116, // 135: confirmate.orchestrator.v1.Orchestrator.ListControlsInScope:input_type -> confirmate.orchestrator.v1.ListControlsInScopeRequest
-	117, // 136: confirmate.orchestrator.v1.Orchestrator.UpdateControlInScope:input_type -> confirmate.orchestrator.v1.UpdateControlInScopeRequest
-	118, // 137: confirmate.orchestrator.v1.Orchestrator.TransitionControlInScopeState:input_type -> confirmate.orchestrator.v1.TransitionControlInScopeStateRequest
-	119, // 138: confirmate.orchestrator.v1.Orchestrator.RemoveControlInScope:input_type -> confirmate.orchestrator.v1.RemoveControlInScopeRequest
-	120, // 139: confirmate.orchestrator.v1.Orchestrator.ListAuditTrailEvents:input_type -> confirmate.orchestrator.v1.ListAuditTrailEventsRequest
-	38,  // 140: confirmate.orchestrator.v1.Orchestrator.RegisterAssessmentTool:output_type -> confirmate.orchestrator.v1.AssessmentTool
-	6,   // 141: confirmate.orchestrator.v1.Orchestrator.ListAssessmentTools:output_type -> confirmate.orchestrator.v1.ListAssessmentToolsResponse
-	38,  // 142: confirmate.orchestrator.v1.Orchestrator.GetAssessmentTool:output_type -> confirmate.orchestrator.v1.AssessmentTool
-	38,  // 143: confirmate.orchestrator.v1.Orchestrator.UpdateAssessmentTool:output_type -> confirmate.orchestrator.v1.AssessmentTool
-	121, // 144: confirmate.orchestrator.v1.Orchestrator.DeregisterAssessmentTool:output_type -> google.protobuf.Empty
-	11,  // 145: confirmate.orchestrator.v1.Orchestrator.StoreAssessmentResult:output_type -> confirmate.orchestrator.v1.StoreAssessmentResultResponse
-	12,  // 146: confirmate.orchestrator.v1.Orchestrator.StoreAssessmentResults:output_type -> confirmate.orchestrator.v1.StoreAssessmentResultsResponse
-	101, // 147: confirmate.orchestrator.v1.Orchestrator.GetAssessmentResult:output_type -> confirmate.assessment.v1.AssessmentResult
-	102, // 148: confirmate.orchestrator.v1.Orchestrator.StoreEvaluationResult:output_type -> confirmate.evaluation.v1.EvaluationResult
-	46,  // 149: confirmate.orchestrator.v1.Orchestrator.ListAssessmentResults:output_type -> confirmate.orchestrator.v1.ListAssessmentResultsResponse
-	15,  // 150: confirmate.orchestrator.v1.Orchestrator.ListEvaluationResults:output_type -> confirmate.orchestrator.v1.ListEvaluationResultsResponse
-	103, // 151: confirmate.orchestrator.v1.Orchestrator.CreateMetric:output_type -> confirmate.assessment.v1.Metric
-	103, // 152: confirmate.orchestrator.v1.Orchestrator.UpdateMetric:output_type -> confirmate.assessment.v1.Metric
-	103, // 153: confirmate.orchestrator.v1.Orchestrator.GetMetric:output_type -> confirmate.assessment.v1.Metric
-	21,  // 154: confirmate.orchestrator.v1.Orchestrator.ListMetrics:output_type -> confirmate.orchestrator.v1.ListMetricsResponse
-	121, // 155: confirmate.orchestrator.v1.Orchestrator.RemoveMetric:output_type -> google.protobuf.Empty
-	39,  // 156: confirmate.orchestrator.v1.Orchestrator.CreateTargetOfEvaluation:output_type -> confirmate.orchestrator.v1.TargetOfEvaluation
-	39,  // 157: confirmate.orchestrator.v1.Orchestrator.UpdateTargetOfEvaluation:output_type -> confirmate.orchestrator.v1.TargetOfEvaluation
-	39,  // 158: confirmate.orchestrator.v1.Orchestrator.GetTargetOfEvaluation:output_type -> confirmate.orchestrator.v1.TargetOfEvaluation
-	27,  // 159: confirmate.orchestrator.v1.Orchestrator.ListTargetsOfEvaluation:output_type -> confirmate.orchestrator.v1.ListTargetsOfEvaluationResponse
-	121, // 160: confirmate.orchestrator.v1.Orchestrator.RemoveTargetOfEvaluation:output_type -> google.protobuf.Empty
-	29,  // 161: confirmate.orchestrator.v1.Orchestrator.GetTargetOfEvaluationStatistics:output_type -> confirmate.orchestrator.v1.GetTargetOfEvaluationStatisticsResponse
-	104, // 162: confirmate.orchestrator.v1.Orchestrator.UpdateMetricConfiguration:output_type -> confirmate.assessment.v1.MetricConfiguration
-	104, // 163: confirmate.orchestrator.v1.Orchestrator.GetMetricConfiguration:output_type -> confirmate.assessment.v1.MetricConfiguration
-	33,  // 164: confirmate.orchestrator.v1.Orchestrator.ListMetricConfigurations:output_type -> confirmate.orchestrator.v1.ListMetricConfigurationResponse
-	105, // 165: confirmate.orchestrator.v1.Orchestrator.UpdateMetricImplementation:output_type -> confirmate.assessment.v1.MetricImplementation
-	105, // 166: confirmate.orchestrator.v1.Orchestrator.GetMetricImplementation:output_type -> confirmate.assessment.v1.MetricImplementation
-	37,  // 167: confirmate.orchestrator.v1.Orchestrator.Subscribe:output_type -> confirmate.orchestrator.v1.ChangeEvent
-	71,  // 168: confirmate.orchestrator.v1.Orchestrator.CreateCertificate:output_type -> confirmate.orchestrator.v1.Certificate
-	71,  // 169: confirmate.orchestrator.v1.Orchestrator.GetCertificate:output_type -> confirmate.orchestrator.v1.Certificate
-	55,  // 170: confirmate.orchestrator.v1.Orchestrator.ListCertificates:output_type -> confirmate.orchestrator.v1.ListCertificatesResponse
-	57,  // 171: confirmate.orchestrator.v1.Orchestrator.ListPublicCertificates:output_type -> confirmate.orchestrator.v1.ListPublicCertificatesResponse
-	71,  // 172: confirmate.orchestrator.v1.Orchestrator.UpdateCertificate:output_type -> confirmate.orchestrator.v1.Certificate
-	121, // 173: confirmate.orchestrator.v1.Orchestrator.RemoveCertificate:output_type -> google.protobuf.Empty
-	40,  // 174: confirmate.orchestrator.v1.Orchestrator.CreateCatalog:output_type -> confirmate.orchestrator.v1.Catalog
-	63,  // 175: confirmate.orchestrator.v1.Orchestrator.ListCatalogs:output_type -> confirmate.orchestrator.v1.ListCatalogsResponse
-	40,  // 176: confirmate.orchestrator.v1.Orchestrator.GetCatalog:output_type -> confirmate.orchestrator.v1.Catalog
-	121, // 177: confirmate.orchestrator.v1.Orchestrator.RemoveCatalog:output_type -> google.protobuf.Empty
-	40,  // 178: confirmate.orchestrator.v1.Orchestrator.UpdateCatalog:output_type -> confirmate.orchestrator.v1.Catalog
-	41,  // 179: confirmate.orchestrator.v1.Orchestrator.GetCategory:output_type -> confirmate.orchestrator.v1.Category
-	68,  // 180: confirmate.orchestrator.v1.Orchestrator.ListControls:output_type -> confirmate.orchestrator.v1.ListControlsResponse
-	42,  // 181: confirmate.orchestrator.v1.Orchestrator.GetControl:output_type -> confirmate.orchestrator.v1.Control
-	43,  // 182: confirmate.orchestrator.v1.Orchestrator.CreateAuditScope:output_type -> confirmate.orchestrator.v1.AuditScope
-	43,  // 183: confirmate.orchestrator.v1.Orchestrator.GetAuditScope:output_type -> confirmate.orchestrator.v1.AuditScope
-	51,  // 184: confirmate.orchestrator.v1.Orchestrator.ListAuditScopes:output_type -> confirmate.orchestrator.v1.ListAuditScopesResponse
-	43,  // 185: confirmate.orchestrator.v1.Orchestrator.UpdateAuditScope:output_type -> confirmate.orchestrator.v1.AuditScope
-	121, // 186: confirmate.orchestrator.v1.Orchestrator.RemoveAuditScope:output_type -> google.protobuf.Empty
-	122, // 187: confirmate.orchestrator.v1.Orchestrator.GetRuntimeInfo:output_type -> confirmate.common.v1.Runtime
-	74,  // 188: confirmate.orchestrator.v1.Orchestrator.UpsertUserPermission:output_type -> confirmate.orchestrator.v1.UpsertUserPermissionResponse
-	121, // 189: confirmate.orchestrator.v1.Orchestrator.RemoveUserPermission:output_type -> google.protobuf.Empty
-	107, // 190: confirmate.orchestrator.v1.Orchestrator.GetCurrentUser:output_type -> confirmate.orchestrator.v1.User
-	107, // 191: confirmate.orchestrator.v1.Orchestrator.GetUser:output_type -> confirmate.orchestrator.v1.User
-	79,  // 192: confirmate.orchestrator.v1.Orchestrator.ListUsers:output_type -> confirmate.orchestrator.v1.ListUsersResponse
-	81,  // 193: confirmate.orchestrator.v1.Orchestrator.ListUserPermissions:output_type -> confirmate.orchestrator.v1.ListUserPermissionsResponse
-	83,  // 194: confirmate.orchestrator.v1.Orchestrator.ListUserRoles:output_type -> confirmate.orchestrator.v1.ListUserRolesResponse
-	121, // 195: confirmate.orchestrator.v1.Orchestrator.RemoveUser:output_type -> google.protobuf.Empty
-	108, // 196: confirmate.orchestrator.v1.Orchestrator.CreateControlInScope:output_type -> confirmate.orchestrator.v1.ControlInScope
-	108, // 197: confirmate.orchestrator.v1.Orchestrator.GetControlInScope:output_type -> confirmate.orchestrator.v1.ControlInScope
-	123, // 198: confirmate.orchestrator.v1.Orchestrator.ListControlsInScope:output_type -> confirmate.orchestrator.v1.ListControlsInScopeResponse
-	108, // 199: confirmate.orchestrator.v1.Orchestrator.UpdateControlInScope:output_type -> confirmate.orchestrator.v1.ControlInScope
-	108, // 200: confirmate.orchestrator.v1.Orchestrator.TransitionControlInScopeState:output_type -> confirmate.orchestrator.v1.ControlInScope
-	121, // 201: confirmate.orchestrator.v1.Orchestrator.RemoveControlInScope:output_type -> google.protobuf.Empty
-	124, // 202: confirmate.orchestrator.v1.Orchestrator.ListAuditTrailEvents:output_type -> confirmate.orchestrator.v1.ListAuditTrailEventsResponse
-	140, // [140:203] is the sub-list for method output_type
-	77,  // [77:140] is the sub-list for method input_type
-	77,  // [77:77] is the sub-list for extension type_name
-	77,  // [77:77] is the sub-list for extension extendee
-	0,   // [0:77] is the sub-list for field type_name
+	119, // 0: confirmate.orchestrator.v1.RegisterAssessmentToolRequest.tool:type_name -> confirmate.orchestrator.v1.AssessmentTool
+	238, // 1: confirmate.orchestrator.v1.ListAssessmentToolsRequest.filter:type_name -> confirmate.orchestrator.v1.ListAssessmentToolsRequest.Filter
+	119, // 2: confirmate.orchestrator.v1.ListAssessmentToolsResponse.tools:type_name -> confirmate.orchestrator.v1.AssessmentTool
+	119, // 3: confirmate.orchestrator.v1.UpdateAssessmentToolRequest.tool:type_name -> confirmate.orchestrator.v1.AssessmentTool
+	258, // 4: confirmate.orchestrator.v1.StoreAssessmentResultRequest.result:type_name -> confirmate.assessment.v1.AssessmentResult
+	258, // 5: confirmate.orchestrator.v1.BatchStoreAssessmentResultsRequest.results:type_name -> confirmate.assessment.v1.AssessmentResult
+	23,  // 6: confirmate.orchestrator.v1.BatchStoreAssessmentResultsResponse.results:type_name -> confirmate.orchestrator.v1.StoreAssessmentResultsResponse
+	259, // 7: confirmate.orchestrator.v1.WaiveAssessmentResultRequest.expires_at:type_name -> google.protobuf.Timestamp
+	0,   // 8: confirmate.orchestrator.v1.ImportExternalFindingsRequest.format:type_name -> confirmate.orchestrator.v1.ExternalFindingsFileFormat
+	196, // 9: confirmate.orchestrator.v1.ImportExternalFindingsResponse.issues:type_name -> confirmate.orchestrator.v1.ImportIssue
+	260, // 10: confirmate.orchestrator.v1.StoreEvaluationResultRequest.result:type_name -> confirmate.evaluation.v1.EvaluationResult
+	239, // 11: confirmate.orchestrator.v1.ListEvaluationResultsRequest.filter:type_name -> confirmate.orchestrator.v1.ListEvaluationResultsRequest.Filter
+	260, // 12: confirmate.orchestrator.v1.ListEvaluationResultsResponse.results:type_name -> confirmate.evaluation.v1.EvaluationResult
+	239, // 13: confirmate.orchestrator.v1.SavedView.filter:type_name -> confirmate.orchestrator.v1.ListEvaluationResultsRequest.Filter
+	259, // 14: confirmate.orchestrator.v1.SavedView.created_at:type_name -> google.protobuf.Timestamp
+	259, // 15: confirmate.orchestrator.v1.SavedView.updated_at:type_name -> google.protobuf.Timestamp
+	33,  // 16: confirmate.orchestrator.v1.CreateSavedViewRequest.saved_view:type_name -> confirmate.orchestrator.v1.SavedView
+	33,  // 17: confirmate.orchestrator.v1.ListSavedViewsResponse.saved_views:type_name -> confirmate.orchestrator.v1.SavedView
+	33,  // 18: confirmate.orchestrator.v1.UpdateSavedViewRequest.saved_view:type_name -> confirmate.orchestrator.v1.SavedView
+	42,  // 19: confirmate.orchestrator.v1.ListSlaBreachesResponse.breaches:type_name -> confirmate.orchestrator.v1.SlaBreach
+	259, // 20: confirmate.orchestrator.v1.SlaBreach.non_compliant_since:type_name -> google.protobuf.Timestamp
+	259, // 21: confirmate.orchestrator.v1.SlaBreach.deadline:type_name -> google.protobuf.Timestamp
+	134, // 22: confirmate.orchestrator.v1.SlaBreach.threshold:type_name -> confirmate.orchestrator.v1.SlaThreshold
+	47,  // 23: confirmate.orchestrator.v1.CompliancePosture.counts:type_name -> confirmate.orchestrator.v1.ComplianceCounts
+	45,  // 24: confirmate.orchestrator.v1.CompliancePosture.catalogs:type_name -> confirmate.orchestrator.v1.CatalogPosture
+	47,  // 25: confirmate.orchestrator.v1.CatalogPosture.counts:type_name -> confirmate.orchestrator.v1.ComplianceCounts
+	46,  // 26: confirmate.orchestrator.v1.CatalogPosture.families:type_name -> confirmate.orchestrator.v1.ControlFamilyPosture
+	47,  // 27: confirmate.orchestrator.v1.ControlFamilyPosture.counts:type_name -> confirmate.orchestrator.v1.ComplianceCounts
+	240, // 28: confirmate.orchestrator.v1.ListAlertsRequest.filter:type_name -> confirmate.orchestrator.v1.ListAlertsRequest.Filter
+	50,  // 29: confirmate.orchestrator.v1.ListAlertsResponse.alerts:type_name -> confirmate.orchestrator.v1.Alert
+	1,   // 30: confirmate.orchestrator.v1.Alert.type:type_name -> confirmate.orchestrator.v1.AlertType
+	259, // 31: confirmate.orchestrator.v1.Alert.detected_at:type_name -> google.protobuf.Timestamp
+	261, // 32: confirmate.orchestrator.v1.UploadAttachmentRequest.metadata:type_name -> confirmate.evaluation.v1.Attachment
+	261, // 33: confirmate.orchestrator.v1.DownloadAttachmentResponse.metadata:type_name -> confirmate.evaluation.v1.Attachment
+	261, // 34: confirmate.orchestrator.v1.ListAttachmentsResponse.attachments:type_name -> confirmate.evaluation.v1.Attachment
+	262, // 35: confirmate.orchestrator.v1.AddEvaluationResultCommentRequest.comment:type_name -> confirmate.evaluation.v1.Comment
+	262, // 36: confirmate.orchestrator.v1.ListEvaluationResultCommentsResponse.comments:type_name -> confirmate.evaluation.v1.Comment
+	263, // 37: confirmate.orchestrator.v1.CreateMetricRequest.metric:type_name -> confirmate.assessment.v1.Metric
+	263, // 38: confirmate.orchestrator.v1.UpdateMetricRequest.metric:type_name -> confirmate.assessment.v1.Metric
+	241, // 39: confirmate.orchestrator.v1.ListMetricsRequest.filter:type_name -> confirmate.orchestrator.v1.ListMetricsRequest.Filter
+	263, // 40: confirmate.orchestrator.v1.ListMetricsResponse.metrics:type_name -> confirmate.assessment.v1.Metric
+	122, // 41: confirmate.orchestrator.v1.CreateTargetOfEvaluationRequest.target_of_evaluation:type_name -> confirmate.orchestrator.v1.TargetOfEvaluation
+	122, // 42: confirmate.orchestrator.v1.UpdateTargetOfEvaluationRequest.target_of_evaluation:type_name -> confirmate.orchestrator.v1.TargetOfEvaluation
+	242, // 43: confirmate.orchestrator.v1.ListTargetsOfEvaluationRequest.filter:type_name -> confirmate.orchestrator.v1.ListTargetsOfEvaluationRequest.Filter
+	122, // 44: confirmate.orchestrator.v1.ListTargetsOfEvaluationResponse.targets_of_evaluation:type_name -> confirmate.orchestrator.v1.TargetOfEvaluation
+	79,  // 45: confirmate.orchestrator.v1.UsageReport.targets_of_evaluation:type_name -> confirmate.orchestrator.v1.TargetOfEvaluationUsage
+	80,  // 46: confirmate.orchestrator.v1.TargetOfEvaluationUsage.days:type_name -> confirmate.orchestrator.v1.UsageRecord
+	259, // 47: confirmate.orchestrator.v1.Secret.created_at:type_name -> google.protobuf.Timestamp
+	259, // 48: confirmate.orchestrator.v1.Secret.updated_at:type_name -> google.protobuf.Timestamp
+	81,  // 49: confirmate.orchestrator.v1.CreateSecretRequest.secret:type_name -> confirmate.orchestrator.v1.Secret
+	81,  // 50: confirmate.orchestrator.v1.ListSecretsResponse.secrets:type_name -> confirmate.orchestrator.v1.Secret
+	81,  // 51: confirmate.orchestrator.v1.UpdateSecretRequest.secret:type_name -> confirmate.orchestrator.v1.Secret
+	90,  // 52: confirmate.orchestrator.v1.ResourceInventory.resource_types:type_name -> confirmate.orchestrator.v1.ResourceTypeCount
+	259, // 53: confirmate.orchestrator.v1.ResourceInventory.collected_at:type_name -> google.protobuf.Timestamp
+	259, // 54: confirmate.orchestrator.v1.ResourceInventory.updated_at:type_name -> google.protobuf.Timestamp
+	89,  // 55: confirmate.orchestrator.v1.StoreResourceInventoryRequest.inventory:type_name -> confirmate.orchestrator.v1.ResourceInventory
+	89,  // 56: confirmate.orchestrator.v1.ListResourceInventoriesResponse.inventories:type_name -> confirmate.orchestrator.v1.ResourceInventory
+	120, // 57: confirmate.orchestrator.v1.UpdateMetadataFieldRequest.field:type_name -> confirmate.orchestrator.v1.MetadataField
+	120, // 58: confirmate.orchestrator.v1.ListMetadataFieldsResponse.fields:type_name -> confirmate.orchestrator.v1.MetadataField
+	121, // 59: confirmate.orchestrator.v1.UpdateRedactionRuleRequest.rule:type_name -> confirmate.orchestrator.v1.RedactionRule
+	121, // 60: confirmate.orchestrator.v1.ListRedactionRulesResponse.rules:type_name -> confirmate.orchestrator.v1.RedactionRule
+	264, // 61: confirmate.orchestrator.v1.UpdateMetricConfigurationRequest.configuration:type_name -> confirmate.assessment.v1.MetricConfiguration
+	264, // 62: confirmate.orchestrator.v1.ResolveMetricConfigurationResponse.configuration:type_name -> confirmate.assessment.v1.MetricConfiguration
+	106, // 63: confirmate.orchestrator.v1.ResolveMetricConfigurationResponse.chain:type_name -> confirmate.orchestrator.v1.MetricConfigurationResolutionStep
+	265, // 64: confirmate.orchestrator.v1.MetricConfigurationResolutionStep.source:type_name -> confirmate.assessment.v1.MetricConfigurationSource
+	266, // 65: confirmate.orchestrator.v1.MetricConfigurationResolutionStep.target_value:type_name -> google.protobuf.Value
+	259, // 66: confirmate.orchestrator.v1.MetricConfigurationResolutionStep.updated_at:type_name -> google.protobuf.Timestamp
+	244, // 67: confirmate.orchestrator.v1.ListMetricConfigurationResponse.configurations:type_name -> confirmate.orchestrator.v1.ListMetricConfigurationResponse.ConfigurationsEntry
+	267, // 68: confirmate.orchestrator.v1.UpdateCatalogMetricConfigurationRequest.configuration:type_name -> confirmate.assessment.v1.CatalogMetricConfiguration
+	267, // 69: confirmate.orchestrator.v1.ListCatalogMetricConfigurationsResponse.configurations:type_name -> confirmate.assessment.v1.CatalogMetricConfiguration
+	268, // 70: confirmate.orchestrator.v1.UpdateMetricImplementationRequest.implementation:type_name -> confirmate.assessment.v1.MetricImplementation
+	245, // 71: confirmate.orchestrator.v1.SubscribeRequest.filter:type_name -> confirmate.orchestrator.v1.SubscribeRequest.Filter
+	259, // 72: confirmate.orchestrator.v1.ChangeEvent.timestamp:type_name -> google.protobuf.Timestamp
+	2,   // 73: confirmate.orchestrator.v1.ChangeEvent.category:type_name -> confirmate.orchestrator.v1.EventCategory
+	3,   // 74: confirmate.orchestrator.v1.ChangeEvent.request_type:type_name -> confirmate.orchestrator.v1.RequestType
+	263, // 75: confirmate.orchestrator.v1.ChangeEvent.metric:type_name -> confirmate.assessment.v1.Metric
+	122, // 76: confirmate.orchestrator.v1.ChangeEvent.target_of_evaluation:type_name -> confirmate.orchestrator.v1.TargetOfEvaluation
+	140, // 77: confirmate.orchestrator.v1.ChangeEvent.audit_scope:type_name -> confirmate.orchestrator.v1.AuditScope
+	258, // 78: confirmate.orchestrator.v1.ChangeEvent.assessment_result:type_name -> confirmate.assessment.v1.AssessmentResult
+	264, // 79: confirmate.orchestrator.v1.ChangeEvent.metric_configuration:type_name -> confirmate.assessment.v1.MetricConfiguration
+	268, // 80: confirmate.orchestrator.v1.ChangeEvent.metric_implementation:type_name -> confirmate.assessment.v1.MetricImplementation
+	119, // 81: confirmate.orchestrator.v1.ChangeEvent.assessment_tool:type_name -> confirmate.orchestrator.v1.AssessmentTool
+	269, // 82: confirmate.orchestrator.v1.ChangeEvent.user:type_name -> confirmate.orchestrator.v1.User
+	270, // 83: confirmate.orchestrator.v1.ChangeEvent.control_in_scope:type_name -> confirmate.orchestrator.v1.ControlInScope
+	123, // 84: confirmate.orchestrator.v1.ChangeEvent.catalog:type_name -> confirmate.orchestrator.v1.Catalog
+	2,   // 85: confirmate.orchestrator.v1.Webhook.categories:type_name -> confirmate.orchestrator.v1.EventCategory
+	3,   // 86: confirmate.orchestrator.v1.Webhook.request_types:type_name -> confirmate.orchestrator.v1.RequestType
+	259, // 87: confirmate.orchestrator.v1.Webhook.created_at:type_name -> google.protobuf.Timestamp
+	118, // 88: confirmate.orchestrator.v1.Webhook.deliveries:type_name -> confirmate.orchestrator.v1.WebhookDelivery
+	2,   // 89: confirmate.orchestrator.v1.WebhookDelivery.category:type_name -> confirmate.orchestrator.v1.EventCategory
+	3,   // 90: confirmate.orchestrator.v1.WebhookDelivery.request_type:type_name -> confirmate.orchestrator.v1.RequestType
+	259, // 91: confirmate.orchestrator.v1.WebhookDelivery.delivered_at:type_name -> google.protobuf.Timestamp
+	4,   // 92: confirmate.orchestrator.v1.MetadataField.type:type_name -> confirmate.orchestrator.v1.MetadataFieldType
+	259, // 93: confirmate.orchestrator.v1.MetadataField.updated_at:type_name -> google.protobuf.Timestamp
+	5,   // 94: confirmate.orchestrator.v1.RedactionRule.mode:type_name -> confirmate.orchestrator.v1.RedactionMode
+	259, // 95: confirmate.orchestrator.v1.RedactionRule.updated_at:type_name -> google.protobuf.Timestamp
+	263, // 96: confirmate.orchestrator.v1.TargetOfEvaluation.configured_metrics:type_name -> confirmate.assessment.v1.Metric
+	259, // 97: confirmate.orchestrator.v1.TargetOfEvaluation.created_at:type_name -> google.protobuf.Timestamp
+	259, // 98: confirmate.orchestrator.v1.TargetOfEvaluation.updated_at:type_name -> google.protobuf.Timestamp
+	246, // 99: confirmate.orchestrator.v1.TargetOfEvaluation.metadata:type_name -> confirmate.orchestrator.v1.TargetOfEvaluation.Metadata
+	14,  // 100: confirmate.orchestrator.v1.TargetOfEvaluation.target_type:type_name -> confirmate.orchestrator.v1.TargetOfEvaluation.TargetType
+	247, // 101: confirmate.orchestrator.v1.TargetOfEvaluation.organization:type_name -> confirmate.orchestrator.v1.TargetOfEvaluation.Organization
+	135, // 102: confirmate.orchestrator.v1.Catalog.categories:type_name -> confirmate.orchestrator.v1.Category
+	251, // 103: confirmate.orchestrator.v1.Catalog.metadata:type_name -> confirmate.orchestrator.v1.Catalog.Metadata
+	6,   // 104: confirmate.orchestrator.v1.Catalog.status:type_name -> confirmate.orchestrator.v1.CatalogStatus
+	134, // 105: confirmate.orchestrator.v1.Catalog.sla_thresholds:type_name -> confirmate.orchestrator.v1.SlaThreshold
+	128, // 106: confirmate.orchestrator.v1.Catalog.applicability_rules:type_name -> confirmate.orchestrator.v1.ApplicabilityRule
+	125, // 107: confirmate.orchestrator.v1.Catalog.custom_statuses:type_name -> confirmate.orchestrator.v1.CustomStatus
+	124, // 108: confirmate.orchestrator.v1.Catalog.evaluation_defaults:type_name -> confirmate.orchestrator.v1.EvaluationDefaults
+	271, // 109: confirmate.orchestrator.v1.EvaluationDefaults.aggregation_strategy:type_name -> confirmate.evaluation.v1.AggregationStrategy
+	272, // 110: confirmate.orchestrator.v1.CustomStatus.status:type_name -> confirmate.evaluation.v1.EvaluationStatus
+	126, // 111: confirmate.orchestrator.v1.CustomStatus.aggregation_rule:type_name -> confirmate.orchestrator.v1.StatusAggregationRule
+	7,   // 112: confirmate.orchestrator.v1.CatalogSource.format:type_name -> confirmate.orchestrator.v1.CatalogSourceFormat
+	259, // 113: confirmate.orchestrator.v1.CatalogSource.last_synced_at:type_name -> google.protobuf.Timestamp
+	130, // 114: confirmate.orchestrator.v1.CatalogSource.last_report:type_name -> confirmate.orchestrator.v1.CatalogSyncReport
+	131, // 115: confirmate.orchestrator.v1.CatalogSyncReport.catalogs:type_name -> confirmate.orchestrator.v1.CatalogDiff
+	8,   // 116: confirmate.orchestrator.v1.CatalogChangelog.trigger:type_name -> confirmate.orchestrator.v1.CatalogChangelogTrigger
+	259, // 117: confirmate.orchestrator.v1.CatalogChangelog.created_at:type_name -> google.protobuf.Timestamp
+	133, // 118: confirmate.orchestrator.v1.CatalogChangelog.changes:type_name -> confirmate.orchestrator.v1.ControlChange
+	9,   // 119: confirmate.orchestrator.v1.ControlChange.type:type_name -> confirmate.orchestrator.v1.ControlChangeType
+	136, // 120: confirmate.orchestrator.v1.Category.controls:type_name -> confirmate.orchestrator.v1.Control
+	136, // 121: confirmate.orchestrator.v1.Control.controls:type_name -> confirmate.orchestrator.v1.Control
+	263, // 122: confirmate.orchestrator.v1.Control.metrics:type_name -> confirmate.assessment.v1.Metric
+	270, // 123: confirmate.orchestrator.v1.Control.controls_in_scope:type_name -> confirmate.orchestrator.v1.ControlInScope
+	137, // 124: confirmate.orchestrator.v1.Control.references:type_name -> confirmate.orchestrator.v1.ControlReference
+	273, // 125: confirmate.orchestrator.v1.Control.remediation:type_name -> confirmate.assessment.v1.Remediation
+	137, // 126: confirmate.orchestrator.v1.ControlTextVersion.references:type_name -> confirmate.orchestrator.v1.ControlReference
+	259, // 127: confirmate.orchestrator.v1.ControlTextVersion.created_at:type_name -> google.protobuf.Timestamp
+	10,  // 128: confirmate.orchestrator.v1.AuditScope.status:type_name -> confirmate.orchestrator.v1.AuditScopeStatus
+	270, // 129: confirmate.orchestrator.v1.AuditScope.controls_in_scope:type_name -> confirmate.orchestrator.v1.ControlInScope
+	274, // 130: confirmate.orchestrator.v1.AuditScope.audit_trail_events:type_name -> confirmate.orchestrator.v1.AuditTrailEvent
+	141, // 131: confirmate.orchestrator.v1.AuditScope.maintenance_windows:type_name -> confirmate.orchestrator.v1.MaintenanceWindow
+	259, // 132: confirmate.orchestrator.v1.MaintenanceWindow.starts_at:type_name -> google.protobuf.Timestamp
+	259, // 133: confirmate.orchestrator.v1.MaintenanceWindow.ends_at:type_name -> google.protobuf.Timestamp
+	11,  // 134: confirmate.orchestrator.v1.MaintenanceWindow.mode:type_name -> confirmate.orchestrator.v1.MaintenanceMode
+	259, // 135: confirmate.orchestrator.v1.MaintenanceWindow.created_at:type_name -> google.protobuf.Timestamp
+	252, // 136: confirmate.orchestrator.v1.ListAssessmentResultsRequest.filter:type_name -> confirmate.orchestrator.v1.ListAssessmentResultsRequest.Filter
+	259, // 137: confirmate.orchestrator.v1.ListAssessmentResultsRequest.as_of:type_name -> google.protobuf.Timestamp
+	145, // 138: confirmate.orchestrator.v1.ListAssessmentResultsRequest.sampling:type_name -> confirmate.orchestrator.v1.AssessmentResultSampling
+	258, // 139: confirmate.orchestrator.v1.ListAssessmentResultsResponse.results:type_name -> confirmate.assessment.v1.AssessmentResult
+	275, // 140: confirmate.orchestrator.v1.AssessmentResultSampling.strategy:type_name -> confirmate.evaluation.v1.SamplingStrategy
+	140, // 141: confirmate.orchestrator.v1.CreateAuditScopeRequest.audit_scope:type_name -> confirmate.orchestrator.v1.AuditScope
+	253, // 142: confirmate.orchestrator.v1.ListAuditScopesRequest.filter:type_name -> confirmate.orchestrator.v1.ListAuditScopesRequest.Filter
+	140, // 143: confirmate.orchestrator.v1.ListAuditScopesResponse.audit_scopes:type_name -> confirmate.orchestrator.v1.AuditScope
+	140, // 144: confirmate.orchestrator.v1.UpdateAuditScopeRequest.audit_scope:type_name -> confirmate.orchestrator.v1.AuditScope
+	141, // 145: confirmate.orchestrator.v1.CreateMaintenanceWindowRequest.maintenance_window:type_name -> confirmate.orchestrator.v1.MaintenanceWindow
+	259, // 146: confirmate.orchestrator.v1.ListMaintenanceWindowsRequest.active_at:type_name -> google.protobuf.Timestamp
+	141, // 147: confirmate.orchestrator.v1.ListMaintenanceWindowsResponse.maintenance_windows:type_name -> confirmate.orchestrator.v1.MaintenanceWindow
+	117, // 148: confirmate.orchestrator.v1.CreateWebhookRequest.webhook:type_name -> confirmate.orchestrator.v1.Webhook
+	117, // 149: confirmate.orchestrator.v1.ListWebhooksResponse.webhooks:type_name -> confirmate.orchestrator.v1.Webhook
+	118, // 150: confirmate.orchestrator.v1.ListWebhookDeliveriesResponse.deliveries:type_name -> confirmate.orchestrator.v1.WebhookDelivery
+	170, // 151: confirmate.orchestrator.v1.Schema.entities:type_name -> confirmate.orchestrator.v1.SchemaEntity
+	171, // 152: confirmate.orchestrator.v1.SchemaEntity.fields:type_name -> confirmate.orchestrator.v1.SchemaField
+	172, // 153: confirmate.orchestrator.v1.SchemaEntity.relationships:type_name -> confirmate.orchestrator.v1.SchemaRelationship
+	12,  // 154: confirmate.orchestrator.v1.SchemaRelationship.type:type_name -> confirmate.orchestrator.v1.SchemaRelationshipType
+	173, // 155: confirmate.orchestrator.v1.SchemaRelationship.foreign_keys:type_name -> confirmate.orchestrator.v1.SchemaForeignKey
+	221, // 156: confirmate.orchestrator.v1.ListCertificatesResponse.certificates:type_name -> confirmate.orchestrator.v1.Certificate
+	221, // 157: confirmate.orchestrator.v1.ListPublicCertificatesResponse.certificates:type_name -> confirmate.orchestrator.v1.Certificate
+	221, // 158: confirmate.orchestrator.v1.UpdateCertificateRequest.certificate:type_name -> confirmate.orchestrator.v1.Certificate
+	123, // 159: confirmate.orchestrator.v1.CreateCatalogRequest.catalog:type_name -> confirmate.orchestrator.v1.Catalog
+	185, // 160: confirmate.orchestrator.v1.GetCatalogTreeResponse.categories:type_name -> confirmate.orchestrator.v1.CatalogTreeCategory
+	186, // 161: confirmate.orchestrator.v1.CatalogTreeCategory.controls:type_name -> confirmate.orchestrator.v1.CatalogTreeControl
+	272, // 162: confirmate.orchestrator.v1.CatalogTreeControl.status:type_name -> confirmate.evaluation.v1.EvaluationStatus
+	186, // 163: confirmate.orchestrator.v1.CatalogTreeControl.controls:type_name -> confirmate.orchestrator.v1.CatalogTreeControl
+	132, // 164: confirmate.orchestrator.v1.ListCatalogChangelogsResponse.changelogs:type_name -> confirmate.orchestrator.v1.CatalogChangelog
+	123, // 165: confirmate.orchestrator.v1.ListCatalogsResponse.catalogs:type_name -> confirmate.orchestrator.v1.Catalog
+	123, // 166: confirmate.orchestrator.v1.UpdateCatalogRequest.catalog:type_name -> confirmate.orchestrator.v1.Catalog
+	13,  // 167: confirmate.orchestrator.v1.ImportControlMetricMappingRequest.format:type_name -> confirmate.orchestrator.v1.MappingFileFormat
+	196, // 168: confirmate.orchestrator.v1.ImportControlMetricMappingResponse.issues:type_name -> confirmate.orchestrator.v1.ImportIssue
+	127, // 169: confirmate.orchestrator.v1.UpdateAssuranceLevelRequest.level:type_name -> confirmate.orchestrator.v1.AssuranceLevel
+	127, // 170: confirmate.orchestrator.v1.ListAssuranceLevelsResponse.levels:type_name -> confirmate.orchestrator.v1.AssuranceLevel
+	128, // 171: confirmate.orchestrator.v1.UpdateApplicabilityRuleRequest.rule:type_name -> confirmate.orchestrator.v1.ApplicabilityRule
+	128, // 172: confirmate.orchestrator.v1.ListApplicabilityRulesResponse.rules:type_name -> confirmate.orchestrator.v1.ApplicabilityRule
+	129, // 173: confirmate.orchestrator.v1.CreateCatalogSourceRequest.source:type_name -> confirmate.orchestrator.v1.CatalogSource
+	129, // 174: confirmate.orchestrator.v1.ListCatalogSourcesResponse.sources:type_name -> confirmate.orchestrator.v1.CatalogSource
+	138, // 175: confirmate.orchestrator.v1.ListControlTextVersionsResponse.versions:type_name -> confirmate.orchestrator.v1.ControlTextVersion
+	254, // 176: confirmate.orchestrator.v1.ListControlsRequest.filter:type_name -> confirmate.orchestrator.v1.ListControlsRequest.Filter
+	136, // 177: confirmate.orchestrator.v1.ListControlsResponse.controls:type_name -> confirmate.orchestrator.v1.Control
+	221, // 178: confirmate.orchestrator.v1.CreateCertificateRequest.certificate:type_name -> confirmate.orchestrator.v1.Certificate
+	222, // 179: confirmate.orchestrator.v1.Certificate.states:type_name -> confirmate.orchestrator.v1.State
+	276, // 180: confirmate.orchestrator.v1.UpsertUserPermissionRequest.user_permission:type_name -> confirmate.orchestrator.v1.UserPermission
+	276, // 181: confirmate.orchestrator.v1.UpsertUserPermissionResponse.user_permission:type_name -> confirmate.orchestrator.v1.UserPermission
+	277, // 182: confirmate.orchestrator.v1.RemoveUserPermissionRequest.object_type:type_name -> confirmate.orchestrator.v1.ObjectType
+	255, // 183: confirmate.orchestrator.v1.ListUsersRequest.filter:type_name -> confirmate.orchestrator.v1.ListUsersRequest.Filter
+	269, // 184: confirmate.orchestrator.v1.ListUsersResponse.users:type_name -> confirmate.orchestrator.v1.User
+	257, // 185: confirmate.orchestrator.v1.ListUserPermissionsRequest.filter:type_name -> confirmate.orchestrator.v1.ListUserPermissionsRequest.Filter
+	276, // 186: confirmate.orchestrator.v1.ListUserPermissionsResponse.user_permissions:type_name -> confirmate.orchestrator.v1.UserPermission
+	278, // 187: confirmate.orchestrator.v1.ListUserRolesResponse.roles:type_name -> confirmate.orchestrator.v1.Role
+	272, // 188: confirmate.orchestrator.v1.ListEvaluationResultsRequest.Filter.status:type_name -> confirmate.evaluation.v1.EvaluationStatus
+	1,   // 189: confirmate.orchestrator.v1.ListAlertsRequest.Filter.type:type_name -> confirmate.orchestrator.v1.AlertType
+	243, // 190: confirmate.orchestrator.v1.ListTargetsOfEvaluationRequest.Filter.custom_fields:type_name -> confirmate.orchestrator.v1.ListTargetsOfEvaluationRequest.Filter.CustomFieldsEntry
+	264, // 191: confirmate.orchestrator.v1.ListMetricConfigurationResponse.ConfigurationsEntry.value:type_name -> confirmate.assessment.v1.MetricConfiguration
+	2,   // 192: confirmate.orchestrator.v1.SubscribeRequest.Filter.categories:type_name -> confirmate.orchestrator.v1.EventCategory
+	248, // 193: confirmate.orchestrator.v1.TargetOfEvaluation.Metadata.labels:type_name -> confirmate.orchestrator.v1.TargetOfEvaluation.Metadata.LabelsEntry
+	249, // 194: confirmate.orchestrator.v1.TargetOfEvaluation.Metadata.custom_fields:type_name -> confirmate.orchestrator.v1.TargetOfEvaluation.Metadata.CustomFieldsEntry
+	250, // 195: confirmate.orchestrator.v1.TargetOfEvaluation.Organization.address:type_name -> confirmate.orchestrator.v1.TargetOfEvaluation.Organization.PostalAddress
+	278, // 196: confirmate.orchestrator.v1.ListUsersRequest.Filter.role:type_name -> confirmate.orchestrator.v1.Role
+	256, // 197: confirmate.orchestrator.v1.ListUsersRequest.Filter.attributes:type_name -> confirmate.orchestrator.v1.ListUsersRequest.Filter.AttributesEntry
+	279, // 198: confirmate.orchestrator.v1.ListUsersRequest.Filter.source:type_name -> confirmate.orchestrator.v1.UserSource
+	277, // 199: confirmate.orchestrator.v1.ListUserPermissionsRequest.Filter.object_type:type_name -> confirmate.orchestrator.v1.ObjectType
+	15,  // 200: confirmate.orchestrator.v1.Orchestrator.RegisterAssessmentTool:input_type -> confirmate.orchestrator.v1.RegisterAssessmentToolRequest
+	16,  // 201: confirmate.orchestrator.v1.Orchestrator.ListAssessmentTools:input_type -> confirmate.orchestrator.v1.ListAssessmentToolsRequest
+	18,  // 202: confirmate.orchestrator.v1.Orchestrator.GetAssessmentTool:input_type -> confirmate.orchestrator.v1.GetAssessmentToolRequest
+	19,  // 203: confirmate.orchestrator.v1.Orchestrator.UpdateAssessmentTool:input_type -> confirmate.orchestrator.v1.UpdateAssessmentToolRequest
+	20,  // 204: confirmate.orchestrator.v1.Orchestrator.DeregisterAssessmentTool:input_type -> confirmate.orchestrator.v1.DeregisterAssessmentToolRequest
+	21,  // 205: confirmate.orchestrator.v1.Orchestrator.StoreAssessmentResult:input_type -> confirmate.orchestrator.v1.StoreAssessmentResultRequest
+	21,  // 206: confirmate.orchestrator.v1.Orchestrator.StoreAssessmentResults:input_type -> confirmate.orchestrator.v1.StoreAssessmentResultRequest
+	24,  // 207: confirmate.orchestrator.v1.Orchestrator.BatchStoreAssessmentResults:input_type -> confirmate.orchestrator.v1.BatchStoreAssessmentResultsRequest
+	142, // 208: confirmate.orchestrator.v1.Orchestrator.GetAssessmentResult:input_type -> confirmate.orchestrator.v1.GetAssessmentResultRequest
+	26,  // 209: confirmate.orchestrator.v1.Orchestrator.WaiveAssessmentResult:input_type -> confirmate.orchestrator.v1.WaiveAssessmentResultRequest
+	27,  // 210: confirmate.orchestrator.v1.Orchestrator.RevokeAssessmentResultWaiver:input_type -> confirmate.orchestrator.v1.RevokeAssessmentResultWaiverRequest
+	28,  // 211: confirmate.orchestrator.v1.Orchestrator.ImportExternalFindings:input_type -> confirmate.orchestrator.v1.ImportExternalFindingsRequest
+	30,  // 212: confirmate.orchestrator.v1.Orchestrator.StoreEvaluationResult:input_type -> confirmate.orchestrator.v1.StoreEvaluationResultRequest
+	143, // 213: confirmate.orchestrator.v1.Orchestrator.ListAssessmentResults:input_type -> confirmate.orchestrator.v1.ListAssessmentResultsRequest
+	31,  // 214: confirmate.orchestrator.v1.Orchestrator.ListEvaluationResults:input_type -> confirmate.orchestrator.v1.ListEvaluationResultsRequest
+	40,  // 215: confirmate.orchestrator.v1.Orchestrator.ListSlaBreaches:input_type -> confirmate.orchestrator.v1.ListSlaBreachesRequest
+	43,  // 216: confirmate.orchestrator.v1.Orchestrator.GetCompliancePosture:input_type -> confirmate.orchestrator.v1.GetCompliancePostureRequest
+	48,  // 217: confirmate.orchestrator.v1.Orchestrator.ListAlerts:input_type -> confirmate.orchestrator.v1.ListAlertsRequest
+	51,  // 218: confirmate.orchestrator.v1.Orchestrator.UploadAttachment:input_type -> confirmate.orchestrator.v1.UploadAttachmentRequest
+	52,  // 219: confirmate.orchestrator.v1.Orchestrator.DownloadAttachment:input_type -> confirmate.orchestrator.v1.DownloadAttachmentRequest
+	54,  // 220: confirmate.orchestrator.v1.Orchestrator.ListAttachments:input_type -> confirmate.orchestrator.v1.ListAttachmentsRequest
+	56,  // 221: confirmate.orchestrator.v1.Orchestrator.RemoveAttachment:input_type -> confirmate.orchestrator.v1.RemoveAttachmentRequest
+	57,  // 222: confirmate.orchestrator.v1.Orchestrator.AddEvaluationResultComment:input_type -> confirmate.orchestrator.v1.AddEvaluationResultCommentRequest
+	58,  // 223: confirmate.orchestrator.v1.Orchestrator.ListEvaluationResultComments:input_type -> confirmate.orchestrator.v1.ListEvaluationResultCommentsRequest
+	60,  // 224: confirmate.orchestrator.v1.Orchestrator.RemoveEvaluationResultComment:input_type -> confirmate.orchestrator.v1.RemoveEvaluationResultCommentRequest
+	34,  // 225: confirmate.orchestrator.v1.Orchestrator.CreateSavedView:input_type -> confirmate.orchestrator.v1.CreateSavedViewRequest
+	35,  // 226: confirmate.orchestrator.v1.Orchestrator.GetSavedView:input_type -> confirmate.orchestrator.v1.GetSavedViewRequest
+	36,  // 227: confirmate.orchestrator.v1.Orchestrator.ListSavedViews:input_type -> confirmate.orchestrator.v1.ListSavedViewsRequest
+	38,  // 228: confirmate.orchestrator.v1.Orchestrator.UpdateSavedView:input_type -> confirmate.orchestrator.v1.UpdateSavedViewRequest
+	39,  // 229: confirmate.orchestrator.v1.Orchestrator.RemoveSavedView:input_type -> confirmate.orchestrator.v1.RemoveSavedViewRequest
+	61,  // 230: confirmate.orchestrator.v1.Orchestrator.CreateMetric:input_type -> confirmate.orchestrator.v1.CreateMetricRequest
+	62,  // 231: confirmate.orchestrator.v1.Orchestrator.UpdateMetric:input_type -> confirmate.orchestrator.v1.UpdateMetricRequest
+	63,  // 232: confirmate.orchestrator.v1.Orchestrator.GetMetric:input_type -> confirmate.orchestrator.v1.GetMetricRequest
+	64,  // 233: confirmate.orchestrator.v1.Orchestrator.ListMetrics:input_type -> confirmate.orchestrator.v1.ListMetricsRequest
+	65,  // 234: confirmate.orchestrator.v1.Orchestrator.RemoveMetric:input_type -> confirmate.orchestrator.v1.RemoveMetricRequest
+	68,  // 235: confirmate.orchestrator.v1.Orchestrator.CreateTargetOfEvaluation:input_type -> confirmate.orchestrator.v1.CreateTargetOfEvaluationRequest
+	69,  // 236: confirmate.orchestrator.v1.Orchestrator.UpdateTargetOfEvaluation:input_type -> confirmate.orchestrator.v1.UpdateTargetOfEvaluationRequest
+	67,  // 237: confirmate.orchestrator.v1.Orchestrator.GetTargetOfEvaluation:input_type -> confirmate.orchestrator.v1.GetTargetOfEvaluationRequest
+	73,  // 238: confirmate.orchestrator.v1.Orchestrator.ListTargetsOfEvaluation:input_type -> confirmate.orchestrator.v1.ListTargetsOfEvaluationRequest
+	70,  // 239: confirmate.orchestrator.v1.Orchestrator.RemoveTargetOfEvaluation:input_type -> confirmate.orchestrator.v1.RemoveTargetOfEvaluationRequest
+	71,  // 240: confirmate.orchestrator.v1.Orchestrator.MergeTargetsOfEvaluation:input_type -> confirmate.orchestrator.v1.MergeTargetsOfEvaluationRequest
+	75,  // 241: confirmate.orchestrator.v1.Orchestrator.GetTargetOfEvaluationStatistics:input_type -> confirmate.orchestrator.v1.GetTargetOfEvaluationStatisticsRequest
+	77,  // 242: confirmate.orchestrator.v1.Orchestrator.GetUsageReport:input_type -> confirmate.orchestrator.v1.GetUsageReportRequest
+	83,  // 243: confirmate.orchestrator.v1.Orchestrator.CreateSecret:input_type -> confirmate.orchestrator.v1.CreateSecretRequest
+	84,  // 244: confirmate.orchestrator.v1.Orchestrator.ListSecrets:input_type -> confirmate.orchestrator.v1.ListSecretsRequest
+	86,  // 245: confirmate.orchestrator.v1.Orchestrator.UpdateSecret:input_type -> confirmate.orchestrator.v1.UpdateSecretRequest
+	87,  // 246: confirmate.orchestrator.v1.Orchestrator.RemoveSecret:input_type -> confirmate.orchestrator.v1.RemoveSecretRequest
+	88,  // 247: confirmate.orchestrator.v1.Orchestrator.AccessSecret:input_type -> confirmate.orchestrator.v1.AccessSecretRequest
+	91,  // 248: confirmate.orchestrator.v1.Orchestrator.StoreResourceInventory:input_type -> confirmate.orchestrator.v1.StoreResourceInventoryRequest
+	92,  // 249: confirmate.orchestrator.v1.Orchestrator.ListResourceInventories:input_type -> confirmate.orchestrator.v1.ListResourceInventoriesRequest
+	94,  // 250: confirmate.orchestrator.v1.Orchestrator.UpdateMetadataField:input_type -> confirmate.orchestrator.v1.UpdateMetadataFieldRequest
+	95,  // 251: confirmate.orchestrator.v1.Orchestrator.ListMetadataFields:input_type -> confirmate.orchestrator.v1.ListMetadataFieldsRequest
+	97,  // 252: confirmate.orchestrator.v1.Orchestrator.RemoveMetadataField:input_type -> confirmate.orchestrator.v1.RemoveMetadataFieldRequest
+	98,  // 253: confirmate.orchestrator.v1.Orchestrator.UpdateRedactionRule:input_type -> confirmate.orchestrator.v1.UpdateRedactionRuleRequest
+	99,  // 254: confirmate.orchestrator.v1.Orchestrator.ListRedactionRules:input_type -> confirmate.orchestrator.v1.ListRedactionRulesRequest
+	101, // 255: confirmate.orchestrator.v1.Orchestrator.RemoveRedactionRule:input_type -> confirmate.orchestrator.v1.RemoveRedactionRuleRequest
+	102, // 256: confirmate.orchestrator.v1.Orchestrator.UpdateMetricConfiguration:input_type -> confirmate.orchestrator.v1.UpdateMetricConfigurationRequest
+	103, // 257: confirmate.orchestrator.v1.Orchestrator.GetMetricConfiguration:input_type -> confirmate.orchestrator.v1.GetMetricConfigurationRequest
+	104, // 258: confirmate.orchestrator.v1.Orchestrator.ResolveMetricConfiguration:input_type -> confirmate.orchestrator.v1.ResolveMetricConfigurationRequest
+	107, // 259: confirmate.orchestrator.v1.Orchestrator.ListMetricConfigurations:input_type -> confirmate.orchestrator.v1.ListMetricConfigurationRequest
+	109, // 260: confirmate.orchestrator.v1.Orchestrator.UpdateCatalogMetricConfiguration:input_type -> confirmate.orchestrator.v1.UpdateCatalogMetricConfigurationRequest
+	110, // 261: confirmate.orchestrator.v1.Orchestrator.ListCatalogMetricConfigurations:input_type -> confirmate.orchestrator.v1.ListCatalogMetricConfigurationsRequest
+	112, // 262: confirmate.orchestrator.v1.Orchestrator.RemoveCatalogMetricConfiguration:input_type -> confirmate.orchestrator.v1.RemoveCatalogMetricConfigurationRequest
+	113, // 263: confirmate.orchestrator.v1.Orchestrator.UpdateMetricImplementation:input_type -> confirmate.orchestrator.v1.UpdateMetricImplementationRequest
+	114, // 264: confirmate.orchestrator.v1.Orchestrator.GetMetricImplementation:input_type -> confirmate.orchestrator.v1.GetMetricImplementationRequest
+	115, // 265: confirmate.orchestrator.v1.Orchestrator.Subscribe:input_type -> confirmate.orchestrator.v1.SubscribeRequest
+	156, // 266: confirmate.orchestrator.v1.Orchestrator.CreateWebhook:input_type -> confirmate.orchestrator.v1.CreateWebhookRequest
+	157, // 267: confirmate.orchestrator.v1.Orchestrator.ListWebhooks:input_type -> confirmate.orchestrator.v1.ListWebhooksRequest
+	159, // 268: confirmate.orchestrator.v1.Orchestrator.RemoveWebhook:input_type -> confirmate.orchestrator.v1.RemoveWebhookRequest
+	160, // 269: confirmate.orchestrator.v1.Orchestrator.ListWebhookDeliveries:input_type -> confirmate.orchestrator.v1.ListWebhookDeliveriesRequest
+	219, // 270: confirmate.orchestrator.v1.Orchestrator.CreateCertificate:input_type -> confirmate.orchestrator.v1.CreateCertificateRequest
+	174, // 271: confirmate.orchestrator.v1.Orchestrator.GetCertificate:input_type -> confirmate.orchestrator.v1.GetCertificateRequest
+	175, // 272: confirmate.orchestrator.v1.Orchestrator.ListCertificates:input_type -> confirmate.orchestrator.v1.ListCertificatesRequest
+	177, // 273: confirmate.orchestrator.v1.Orchestrator.ListPublicCertificates:input_type -> confirmate.orchestrator.v1.ListPublicCertificatesRequest
+	179, // 274: confirmate.orchestrator.v1.Orchestrator.UpdateCertificate:input_type -> confirmate.orchestrator.v1.UpdateCertificateRequest
+	220, // 275: confirmate.orchestrator.v1.Orchestrator.RemoveCertificate:input_type -> confirmate.orchestrator.v1.RemoveCertificateRequest
+	180, // 276: confirmate.orchestrator.v1.Orchestrator.CreateCatalog:input_type -> confirmate.orchestrator.v1.CreateCatalogRequest
+	190, // 277: confirmate.orchestrator.v1.Orchestrator.ListCatalogs:input_type -> confirmate.orchestrator.v1.ListCatalogsRequest
+	182, // 278: confirmate.orchestrator.v1.Orchestrator.GetCatalog:input_type -> confirmate.orchestrator.v1.GetCatalogRequest
+	183, // 279: confirmate.orchestrator.v1.Orchestrator.GetCatalogTree:input_type -> confirmate.orchestrator.v1.GetCatalogTreeRequest
+	181, // 280: confirmate.orchestrator.v1.Orchestrator.RemoveCatalog:input_type -> confirmate.orchestrator.v1.RemoveCatalogRequest
+	192, // 281: confirmate.orchestrator.v1.Orchestrator.UpdateCatalog:input_type -> confirmate.orchestrator.v1.UpdateCatalogRequest
+	193, // 282: confirmate.orchestrator.v1.Orchestrator.PublishCatalog:input_type -> confirmate.orchestrator.v1.PublishCatalogRequest
+	194, // 283: confirmate.orchestrator.v1.Orchestrator.DiscardCatalogDraft:input_type -> confirmate.orchestrator.v1.DiscardCatalogDraftRequest
+	187, // 284: confirmate.orchestrator.v1.Orchestrator.ListCatalogChangelogs:input_type -> confirmate.orchestrator.v1.ListCatalogChangelogsRequest
+	189, // 285: confirmate.orchestrator.v1.Orchestrator.GetCatalogChangelog:input_type -> confirmate.orchestrator.v1.GetCatalogChangelogRequest
+	195, // 286: confirmate.orchestrator.v1.Orchestrator.ImportControlMetricMapping:input_type -> confirmate.orchestrator.v1.ImportControlMetricMappingRequest
+	198, // 287: confirmate.orchestrator.v1.Orchestrator.UpdateAssuranceLevel:input_type -> confirmate.orchestrator.v1.UpdateAssuranceLevelRequest
+	199, // 288: confirmate.orchestrator.v1.Orchestrator.ListAssuranceLevels:input_type -> confirmate.orchestrator.v1.ListAssuranceLevelsRequest
+	201, // 289: confirmate.orchestrator.v1.Orchestrator.RemoveAssuranceLevel:input_type -> confirmate.orchestrator.v1.RemoveAssuranceLevelRequest
+	202, // 290: confirmate.orchestrator.v1.Orchestrator.UpdateApplicabilityRule:input_type -> confirmate.orchestrator.v1.UpdateApplicabilityRuleRequest
+	203, // 291: confirmate.orchestrator.v1.Orchestrator.ListApplicabilityRules:input_type -> confirmate.orchestrator.v1.ListApplicabilityRulesRequest
+	210, // 292: confirmate.orchestrator.v1.Orchestrator.RemoveApplicabilityRule:input_type -> confirmate.orchestrator.v1.RemoveApplicabilityRuleRequest
+	205, // 293: confirmate.orchestrator.v1.Orchestrator.CreateCatalogSource:input_type -> confirmate.orchestrator.v1.CreateCatalogSourceRequest
+	206, // 294: confirmate.orchestrator.v1.Orchestrator.ListCatalogSources:input_type -> confirmate.orchestrator.v1.ListCatalogSourcesRequest
+	208, // 295: confirmate.orchestrator.v1.Orchestrator.RemoveCatalogSource:input_type -> confirmate.orchestrator.v1.RemoveCatalogSourceRequest
+	209, // 296: confirmate.orchestrator.v1.Orchestrator.SyncCatalogSource:input_type -> confirmate.orchestrator.v1.SyncCatalogSourceRequest
+	211, // 297: confirmate.orchestrator.v1.Orchestrator.GetCategory:input_type -> confirmate.orchestrator.v1.GetCategoryRequest
+	217, // 298: confirmate.orchestrator.v1.Orchestrator.ListControls:input_type -> confirmate.orchestrator.v1.ListControlsRequest
+	212, // 299: confirmate.orchestrator.v1.Orchestrator.GetControl:input_type -> confirmate.orchestrator.v1.GetControlRequest
+	213, // 300: confirmate.orchestrator.v1.Orchestrator.ListControlTextVersions:input_type -> confirmate.orchestrator.v1.ListControlTextVersionsRequest
+	215, // 301: confirmate.orchestrator.v1.Orchestrator.RenderControlText:input_type -> confirmate.orchestrator.v1.RenderControlTextRequest
+	146, // 302: confirmate.orchestrator.v1.Orchestrator.CreateAuditScope:input_type -> confirmate.orchestrator.v1.CreateAuditScopeRequest
+	148, // 303: confirmate.orchestrator.v1.Orchestrator.GetAuditScope:input_type -> confirmate.orchestrator.v1.GetAuditScopeRequest
+	149, // 304: confirmate.orchestrator.v1.Orchestrator.ListAuditScopes:input_type -> confirmate.orchestrator.v1.ListAuditScopesRequest
+	151, // 305: confirmate.orchestrator.v1.Orchestrator.UpdateAuditScope:input_type -> confirmate.orchestrator.v1.UpdateAuditScopeRequest
+	147, // 306: confirmate.orchestrator.v1.Orchestrator.RemoveAuditScope:input_type -> confirmate.orchestrator.v1.RemoveAuditScopeRequest
+	152, // 307: confirmate.orchestrator.v1.Orchestrator.CreateMaintenanceWindow:input_type -> confirmate.orchestrator.v1.CreateMaintenanceWindowRequest
+	153, // 308: confirmate.orchestrator.v1.Orchestrator.ListMaintenanceWindows:input_type -> confirmate.orchestrator.v1.ListMaintenanceWindowsRequest
+	155, // 309: confirmate.orchestrator.v1.Orchestrator.RemoveMaintenanceWindow:input_type -> confirmate.orchestrator.v1.RemoveMaintenanceWindowRequest
+	162, // 310: confirmate.orchestrator.v1.Orchestrator.ExportOSCAL:input_type -> confirmate.orchestrator.v1.ExportOSCALRequest
+	164, // 311: confirmate.orchestrator.v1.Orchestrator.ArchiveAuditScopeData:input_type -> confirmate.orchestrator.v1.ArchiveAuditScopeDataRequest
+	166, // 312: confirmate.orchestrator.v1.Orchestrator.RestoreAuditScopeData:input_type -> confirmate.orchestrator.v1.RestoreAuditScopeDataRequest
+	168, // 313: confirmate.orchestrator.v1.Orchestrator.GetSchema:input_type -> confirmate.orchestrator.v1.GetSchemaRequest
+	280, // 314: confirmate.orchestrator.v1.Orchestrator.GetRuntimeInfo:input_type -> confirmate.common.v1.GetRuntimeInfoRequest
+	223, // 315: confirmate.orchestrator.v1.Orchestrator.UpsertUserPermission:input_type -> confirmate.orchestrator.v1.UpsertUserPermissionRequest
+	225, // 316: confirmate.orchestrator.v1.Orchestrator.RemoveUserPermission:input_type -> confirmate.orchestrator.v1.RemoveUserPermissionRequest
+	226, // 317: confirmate.orchestrator.v1.Orchestrator.GetCurrentUser:input_type -> confirmate.orchestrator.v1.GetCurrentUserRequest
+	227, // 318: confirmate.orchestrator.v1.Orchestrator.GetUser:input_type -> confirmate.orchestrator.v1.GetUserRequest
+	228, // 319: confirmate.orchestrator.v1.Orchestrator.ListUsers:input_type -> confirmate.orchestrator.v1.ListUsersRequest
+	230, // 320: confirmate.orchestrator.v1.Orchestrator.ResolveUser:input_type -> confirmate.orchestrator.v1.ResolveUserRequest
+	231, // 321: confirmate.orchestrator.v1.Orchestrator.SyncUsers:input_type -> confirmate.orchestrator.v1.SyncUsersRequest
+	233, // 322: confirmate.orchestrator.v1.Orchestrator.ListUserPermissions:input_type -> confirmate.orchestrator.v1.ListUserPermissionsRequest
+	235, // 323: confirmate.orchestrator.v1.Orchestrator.ListUserRoles:input_type -> confirmate.orchestrator.v1.ListUserRolesRequest
+	237, // 324: confirmate.orchestrator.v1.Orchestrator.RemoveUser:input_type -> confirmate.orchestrator.v1.RemoveUserRequest
+	281, // 325: confirmate.orchestrator.v1.Orchestrator.CreateControlInScope:input_type -> confirmate.orchestrator.v1.CreateControlInScopeRequest
+	282, // 326: confirmate.orchestrator.v1.Orchestrator.GetControlInScope:input_type -> confirmate.orchestrator.v1.GetControlInScopeRequest
+	283, // 327: confirmate.orchestrator.v1.Orchestrator.ListControlsInScope:input_type -> confirmate.orchestrator.v1.ListControlsInScopeRequest
+	284, // 328: confirmate.orchestrator.v1.Orchestrator.UpdateControlInScope:input_type -> confirmate.orchestrator.v1.UpdateControlInScopeRequest
+	285, // 329: confirmate.orchestrator.v1.Orchestrator.TransitionControlInScopeState:input_type -> confirmate.orchestrator.v1.TransitionControlInScopeStateRequest
+	286, // 330: confirmate.orchestrator.v1.Orchestrator.RemoveControlInScope:input_type -> confirmate.orchestrator.v1.RemoveControlInScopeRequest
+	287, // 331: confirmate.orchestrator.v1.Orchestrator.ListAuditTrailEvents:input_type -> confirmate.orchestrator.v1.ListAuditTrailEventsRequest
+	119, // 332: confirmate.orchestrator.v1.Orchestrator.RegisterAssessmentTool:output_type -> confirmate.orchestrator.v1.AssessmentTool
+	17,  // 333: confirmate.orchestrator.v1.Orchestrator.ListAssessmentTools:output_type -> confirmate.orchestrator.v1.ListAssessmentToolsResponse
+	119, // 334: confirmate.orchestrator.v1.Orchestrator.GetAssessmentTool:output_type -> confirmate.orchestrator.v1.AssessmentTool
+	119, // 335: confirmate.orchestrator.v1.Orchestrator.UpdateAssessmentTool:output_type -> confirmate.orchestrator.v1.AssessmentTool
+	288, // 336: confirmate.orchestrator.v1.Orchestrator.DeregisterAssessmentTool:output_type -> google.protobuf.Empty
+	22,  // 337: confirmate.orchestrator.v1.Orchestrator.StoreAssessmentResult:output_type -> confirmate.orchestrator.v1.StoreAssessmentResultResponse
+	23,  // 338: confirmate.orchestrator.v1.Orchestrator.StoreAssessmentResults:output_type -> confirmate.orchestrator.v1.StoreAssessmentResultsResponse
+	25,  // 339: confirmate.orchestrator.v1.Orchestrator.BatchStoreAssessmentResults:output_type -> confirmate.orchestrator.v1.BatchStoreAssessmentResultsResponse
+	258, // 340: confirmate.orchestrator.v1.Orchestrator.GetAssessmentResult:output_type -> confirmate.assessment.v1.AssessmentResult
+	258, // 341: confirmate.orchestrator.v1.Orchestrator.WaiveAssessmentResult:output_type -> confirmate.assessment.v1.AssessmentResult
+	258, // 342: confirmate.orchestrator.v1.Orchestrator.RevokeAssessmentResultWaiver:output_type -> confirmate.assessment.v1.AssessmentResult
+	29,  // 343: confirmate.orchestrator.v1.Orchestrator.ImportExternalFindings:output_type -> confirmate.orchestrator.v1.ImportExternalFindingsResponse
+	260, // 344: confirmate.orchestrator.v1.Orchestrator.StoreEvaluationResult:output_type -> confirmate.evaluation.v1.EvaluationResult
+	144, // 345: confirmate.orchestrator.v1.Orchestrator.ListAssessmentResults:output_type -> confirmate.orchestrator.v1.ListAssessmentResultsResponse
+	32,  // 346: confirmate.orchestrator.v1.Orchestrator.ListEvaluationResults:output_type -> confirmate.orchestrator.v1.ListEvaluationResultsResponse
+	41,  // 347: confirmate.orchestrator.v1.Orchestrator.ListSlaBreaches:output_type -> confirmate.orchestrator.v1.ListSlaBreachesResponse
+	44,  // 348: confirmate.orchestrator.v1.Orchestrator.GetCompliancePosture:output_type -> confirmate.orchestrator.v1.CompliancePosture
+	49,  // 349: confirmate.orchestrator.v1.Orchestrator.ListAlerts:output_type -> confirmate.orchestrator.v1.ListAlertsResponse
+	261, // 350: confirmate.orchestrator.v1.Orchestrator.UploadAttachment:output_type -> confirmate.evaluation.v1.Attachment
+	53,  // 351: confirmate.orchestrator.v1.Orchestrator.DownloadAttachment:output_type -> confirmate.orchestrator.v1.DownloadAttachmentResponse
+	55,  // 352: confirmate.orchestrator.v1.Orchestrator.ListAttachments:output_type -> confirmate.orchestrator.v1.ListAttachmentsResponse
+	288, // 353: confirmate.orchestrator.v1.Orchestrator.RemoveAttachment:output_type -> google.protobuf.Empty
+	262, // 354: confirmate.orchestrator.v1.Orchestrator.AddEvaluationResultComment:output_type -> confirmate.evaluation.v1.Comment
+	59,  // 355: confirmate.orchestrator.v1.Orchestrator.ListEvaluationResultComments:output_type -> confirmate.orchestrator.v1.ListEvaluationResultCommentsResponse
+	288, // 356: confirmate.orchestrator.v1.Orchestrator.RemoveEvaluationResultComment:output_type -> google.protobuf.Empty
+	33,  // 357: confirmate.orchestrator.v1.Orchestrator.CreateSavedView:output_type -> confirmate.orchestrator.v1.SavedView
+	33,  // 358: confirmate.orchestrator.v1.Orchestrator.GetSavedView:output_type -> confirmate.orchestrator.v1.SavedView
+	37,  // 359: confirmate.orchestrator.v1.Orchestrator.ListSavedViews:output_type -> confirmate.orchestrator.v1.ListSavedViewsResponse
+	33,  // 360: confirmate.orchestrator.v1.Orchestrator.UpdateSavedView:output_type -> confirmate.orchestrator.v1.SavedView
+	288, // 361: confirmate.orchestrator.v1.Orchestrator.RemoveSavedView:output_type -> google.protobuf.Empty
+	263, // 362: confirmate.orchestrator.v1.Orchestrator.CreateMetric:output_type -> confirmate.assessment.v1.Metric
+	263, // 363: confirmate.orchestrator.v1.Orchestrator.UpdateMetric:output_type -> confirmate.assessment.v1.Metric
+	263, // 364: confirmate.orchestrator.v1.Orchestrator.GetMetric:output_type -> confirmate.assessment.v1.Metric
+	66,  // 365: confirmate.orchestrator.v1.Orchestrator.ListMetrics:output_type -> confirmate.orchestrator.v1.ListMetricsResponse
+	288, // 366: confirmate.orchestrator.v1.Orchestrator.RemoveMetric:output_type -> google.protobuf.Empty
+	122, // 367: confirmate.orchestrator.v1.Orchestrator.CreateTargetOfEvaluation:output_type -> confirmate.orchestrator.v1.TargetOfEvaluation
+	122, // 368: confirmate.orchestrator.v1.Orchestrator.UpdateTargetOfEvaluation:output_type -> confirmate.orchestrator.v1.TargetOfEvaluation
+	122, // 369: confirmate.orchestrator.v1.Orchestrator.GetTargetOfEvaluation:output_type -> confirmate.orchestrator.v1.TargetOfEvaluation
+	74,  // 370: confirmate.orchestrator.v1.Orchestrator.ListTargetsOfEvaluation:output_type -> confirmate.orchestrator.v1.ListTargetsOfEvaluationResponse
+	288, // 371: confirmate.orchestrator.v1.Orchestrator.RemoveTargetOfEvaluation:output_type -> google.protobuf.Empty
+	72,  // 372: confirmate.orchestrator.v1.Orchestrator.MergeTargetsOfEvaluation:output_type -> confirmate.orchestrator.v1.MergeTargetsOfEvaluationResponse
+	76,  // 373: confirmate.orchestrator.v1.Orchestrator.GetTargetOfEvaluationStatistics:output_type -> confirmate.orchestrator.v1.GetTargetOfEvaluationStatisticsResponse
+	78,  // 374: confirmate.orchestrator.v1.Orchestrator.GetUsageReport:output_type -> confirmate.orchestrator.v1.UsageReport
+	81,  // 375: confirmate.orchestrator.v1.Orchestrator.CreateSecret:output_type -> confirmate.orchestrator.v1.Secret
+	85,  // 376: confirmate.orchestrator.v1.Orchestrator.ListSecrets:output_type -> confirmate.orchestrator.v1.ListSecretsResponse
+	81,  // 377: confirmate.orchestrator.v1.Orchestrator.UpdateSecret:output_type -> confirmate.orchestrator.v1.Secret
+	288, // 378: confirmate.orchestrator.v1.Orchestrator.RemoveSecret:output_type -> google.protobuf.Empty
+	82,  // 379: confirmate.orchestrator.v1.Orchestrator.AccessSecret:output_type -> confirmate.orchestrator.v1.SecretValue
+	89,  // 380: confirmate.orchestrator.v1.Orchestrator.StoreResourceInventory:output_type -> confirmate.orchestrator.v1.ResourceInventory
+	93,  // 381: confirmate.orchestrator.v1.Orchestrator.ListResourceInventories:output_type -> confirmate.orchestrator.v1.ListResourceInventoriesResponse
+	120, // 382: confirmate.orchestrator.v1.Orchestrator.UpdateMetadataField:output_type -> confirmate.orchestrator.v1.MetadataField
+	96,  // 383: confirmate.orchestrator.v1.Orchestrator.ListMetadataFields:output_type -> confirmate.orchestrator.v1.ListMetadataFieldsResponse
+	288, // 384: confirmate.orchestrator.v1.Orchestrator.RemoveMetadataField:output_type -> google.protobuf.Empty
+	121, // 385: confirmate.orchestrator.v1.Orchestrator.UpdateRedactionRule:output_type -> confirmate.orchestrator.v1.RedactionRule
+	100, // 386: confirmate.orchestrator.v1.Orchestrator.ListRedactionRules:output_type -> confirmate.orchestrator.v1.ListRedactionRulesResponse
+	288, // 387: confirmate.orchestrator.v1.Orchestrator.RemoveRedactionRule:output_type -> google.protobuf.Empty
+	264, // 388: confirmate.orchestrator.v1.Orchestrator.UpdateMetricConfiguration:output_type -> confirmate.assessment.v1.MetricConfiguration
+	264, // 389: confirmate.orchestrator.v1.Orchestrator.GetMetricConfiguration:output_type -> confirmate.assessment.v1.MetricConfiguration
+	105, // 390: confirmate.orchestrator.v1.Orchestrator.ResolveMetricConfiguration:output_type -> confirmate.orchestrator.v1.ResolveMetricConfigurationResponse
+	108, // 391: confirmate.orchestrator.v1.Orchestrator.ListMetricConfigurations:output_type -> confirmate.orchestrator.v1.ListMetricConfigurationResponse
+	267, // 392: confirmate.orchestrator.v1.Orchestrator.UpdateCatalogMetricConfiguration:output_type -> confirmate.assessment.v1.CatalogMetricConfiguration
+	111, // 393: confirmate.orchestrator.v1.Orchestrator.ListCatalogMetricConfigurations:output_type -> confirmate.orchestrator.v1.ListCatalogMetricConfigurationsResponse
+	288, // 394: confirmate.orchestrator.v1.Orchestrator.RemoveCatalogMetricConfiguration:output_type -> google.protobuf.Empty
+	268, // 395: confirmate.orchestrator.v1.Orchestrator.UpdateMetricImplementation:output_type -> confirmate.assessment.v1.MetricImplementation
+	268, // 396: confirmate.orchestrator.v1.Orchestrator.GetMetricImplementation:output_type -> confirmate.assessment.v1.MetricImplementation
+	116, // 397: confirmate.orchestrator.v1.Orchestrator.Subscribe:output_type -> confirmate.orchestrator.v1.ChangeEvent
+	117, // 398: confirmate.orchestrator.v1.Orchestrator.CreateWebhook:output_type -> confirmate.orchestrator.v1.Webhook
+	158, // 399: confirmate.orchestrator.v1.Orchestrator.ListWebhooks:output_type -> confirmate.orchestrator.v1.ListWebhooksResponse
+	288, // 400: confirmate.orchestrator.v1.Orchestrator.RemoveWebhook:output_type -> google.protobuf.Empty
+	161, // 401: confirmate.orchestrator.v1.Orchestrator.ListWebhookDeliveries:output_type -> confirmate.orchestrator.v1.ListWebhookDeliveriesResponse
+	221, // 402: confirmate.orchestrator.v1.Orchestrator.CreateCertificate:output_type -> confirmate.orchestrator.v1.Certificate
+	221, // 403: confirmate.orchestrator.v1.Orchestrator.GetCertificate:output_type -> confirmate.orchestrator.v1.Certificate
+	176, // 404: confirmate.orchestrator.v1.Orchestrator.ListCertificates:output_type -> confirmate.orchestrator.v1.ListCertificatesResponse
+	178, // 405: confirmate.orchestrator.v1.Orchestrator.ListPublicCertificates:output_type -> confirmate.orchestrator.v1.ListPublicCertificatesResponse
+	221, // 406: confirmate.orchestrator.v1.Orchestrator.UpdateCertificate:output_type -> confirmate.orchestrator.v1.Certificate
+	288, // 407: confirmate.orchestrator.v1.Orchestrator.RemoveCertificate:output_type -> google.protobuf.Empty
+	123, // 408: confirmate.orchestrator.v1.Orchestrator.CreateCatalog:output_type -> confirmate.orchestrator.v1.Catalog
+	191, // 409: confirmate.orchestrator.v1.Orchestrator.ListCatalogs:output_type -> confirmate.orchestrator.v1.ListCatalogsResponse
+	123, // 410: confirmate.orchestrator.v1.Orchestrator.GetCatalog:output_type -> confirmate.orchestrator.v1.Catalog
+	184, // 411: confirmate.orchestrator.v1.Orchestrator.GetCatalogTree:output_type -> confirmate.orchestrator.v1.GetCatalogTreeResponse
+	288, // 412: confirmate.orchestrator.v1.Orchestrator.RemoveCatalog:output_type -> google.protobuf.Empty
+	123, // 413: confirmate.orchestrator.v1.Orchestrator.UpdateCatalog:output_type -> confirmate.orchestrator.v1.Catalog
+	123, // 414: confirmate.orchestrator.v1.Orchestrator.PublishCatalog:output_type -> confirmate.orchestrator.v1.Catalog
+	123, // 415: confirmate.orchestrator.v1.Orchestrator.DiscardCatalogDraft:output_type -> confirmate.orchestrator.v1.Catalog
+	188, // 416: confirmate.orchestrator.v1.Orchestrator.ListCatalogChangelogs:output_type -> confirmate.orchestrator.v1.ListCatalogChangelogsResponse
+	132, // 417: confirmate.orchestrator.v1.Orchestrator.GetCatalogChangelog:output_type -> confirmate.orchestrator.v1.CatalogChangelog
+	197, // 418: confirmate.orchestrator.v1.Orchestrator.ImportControlMetricMapping:output_type -> confirmate.orchestrator.v1.ImportControlMetricMappingResponse
+	127, // 419: confirmate.orchestrator.v1.Orchestrator.UpdateAssuranceLevel:output_type -> confirmate.orchestrator.v1.AssuranceLevel
+	200, // 420: confirmate.orchestrator.v1.Orchestrator.ListAssuranceLevels:output_type -> confirmate.orchestrator.v1.ListAssuranceLevelsResponse
+	288, // 421: confirmate.orchestrator.v1.Orchestrator.RemoveAssuranceLevel:output_type -> google.protobuf.Empty
+	128, // 422: confirmate.orchestrator.v1.Orchestrator.UpdateApplicabilityRule:output_type -> confirmate.orchestrator.v1.ApplicabilityRule
+	204, // 423: confirmate.orchestrator.v1.Orchestrator.ListApplicabilityRules:output_type -> confirmate.orchestrator.v1.ListApplicabilityRulesResponse
+	288, // 424: confirmate.orchestrator.v1.Orchestrator.RemoveApplicabilityRule:output_type -> google.protobuf.Empty
+	129, // 425: confirmate.orchestrator.v1.Orchestrator.CreateCatalogSource:output_type -> confirmate.orchestrator.v1.CatalogSource
+	207, // 426: confirmate.orchestrator.v1.Orchestrator.ListCatalogSources:output_type -> confirmate.orchestrator.v1.ListCatalogSourcesResponse
+	288, // 427: confirmate.orchestrator.v1.Orchestrator.RemoveCatalogSource:output_type -> google.protobuf.Empty
+	130, // 428: confirmate.orchestrator.v1.Orchestrator.SyncCatalogSource:output_type -> confirmate.orchestrator.v1.CatalogSyncReport
+	135, // 429: confirmate.orchestrator.v1.Orchestrator.GetCategory:output_type -> confirmate.orchestrator.v1.Category
+	218, // 430: confirmate.orchestrator.v1.Orchestrator.ListControls:output_type -> confirmate.orchestrator.v1.ListControlsResponse
+	136, // 431: confirmate.orchestrator.v1.Orchestrator.GetControl:output_type -> confirmate.orchestrator.v1.Control
+	214, // 432: confirmate.orchestrator.v1.Orchestrator.ListControlTextVersions:output_type -> confirmate.orchestrator.v1.ListControlTextVersionsResponse
+	216, // 433: confirmate.orchestrator.v1.Orchestrator.RenderControlText:output_type -> confirmate.orchestrator.v1.RenderControlTextResponse
+	140, // 434: confirmate.orchestrator.v1.Orchestrator.CreateAuditScope:output_type -> confirmate.orchestrator.v1.AuditScope
+	140, // 435: confirmate.orchestrator.v1.Orchestrator.GetAuditScope:output_type -> confirmate.orchestrator.v1.AuditScope
+	150, // 436: confirmate.orchestrator.v1.Orchestrator.ListAuditScopes:output_type -> confirmate.orchestrator.v1.ListAuditScopesResponse
+	140, // 437: confirmate.orchestrator.v1.Orchestrator.UpdateAuditScope:output_type -> confirmate.orchestrator.v1.AuditScope
+	288, // 438: confirmate.orchestrator.v1.Orchestrator.RemoveAuditScope:output_type -> google.protobuf.Empty
+	141, // 439: confirmate.orchestrator.v1.Orchestrator.CreateMaintenanceWindow:output_type -> confirmate.orchestrator.v1.MaintenanceWindow
+	154, // 440: confirmate.orchestrator.v1.Orchestrator.ListMaintenanceWindows:output_type -> confirmate.orchestrator.v1.ListMaintenanceWindowsResponse
+	288, // 441: confirmate.orchestrator.v1.Orchestrator.RemoveMaintenanceWindow:output_type -> google.protobuf.Empty
+	163, // 442: confirmate.orchestrator.v1.Orchestrator.ExportOSCAL:output_type -> confirmate.orchestrator.v1.ExportOSCALResponse
+	165, // 443: confirmate.orchestrator.v1.Orchestrator.ArchiveAuditScopeData:output_type -> confirmate.orchestrator.v1.ArchiveAuditScopeDataResponse
+	167, // 444: confirmate.orchestrator.v1.Orchestrator.RestoreAuditScopeData:output_type -> confirmate.orchestrator.v1.RestoreAuditScopeDataResponse
+	169, // 445: confirmate.orchestrator.v1.Orchestrator.GetSchema:output_type -> confirmate.orchestrator.v1.Schema
+	289, // 446: confirmate.orchestrator.v1.Orchestrator.GetRuntimeInfo:output_type -> confirmate.common.v1.Runtime
+	224, // 447: confirmate.orchestrator.v1.Orchestrator.UpsertUserPermission:output_type -> confirmate.orchestrator.v1.UpsertUserPermissionResponse
+	288, // 448: confirmate.orchestrator.v1.Orchestrator.RemoveUserPermission:output_type -> google.protobuf.Empty
+	269, // 449: confirmate.orchestrator.v1.Orchestrator.GetCurrentUser:output_type -> confirmate.orchestrator.v1.User
+	269, // 450: confirmate.orchestrator.v1.Orchestrator.GetUser:output_type -> confirmate.orchestrator.v1.User
+	229, // 451: confirmate.orchestrator.v1.Orchestrator.ListUsers:output_type -> confirmate.orchestrator.v1.ListUsersResponse
+	269, // 452: confirmate.orchestrator.v1.Orchestrator.ResolveUser:output_type -> confirmate.orchestrator.v1.User
+	232, // 453: confirmate.orchestrator.v1.Orchestrator.SyncUsers:output_type -> confirmate.orchestrator.v1.SyncUsersResponse
+	234, // 454: confirmate.orchestrator.v1.Orchestrator.ListUserPermissions:output_type -> confirmate.orchestrator.v1.ListUserPermissionsResponse
+	236, // 455: confirmate.orchestrator.v1.Orchestrator.ListUserRoles:output_type -> confirmate.orchestrator.v1.ListUserRolesResponse
+	288, // 456: confirmate.orchestrator.v1.Orchestrator.RemoveUser:output_type -> google.protobuf.Empty
+	270, // 457: confirmate.orchestrator.v1.Orchestrator.CreateControlInScope:output_type -> confirmate.orchestrator.v1.ControlInScope
+	270, // 458: confirmate.orchestrator.v1.Orchestrator.GetControlInScope:output_type -> confirmate.orchestrator.v1.ControlInScope
+	290, // 459: confirmate.orchestrator.v1.Orchestrator.ListControlsInScope:output_type -> confirmate.orchestrator.v1.ListControlsInScopeResponse
+	270, // 460: confirmate.orchestrator.v1.Orchestrator.UpdateControlInScope:output_type -> confirmate.orchestrator.v1.ControlInScope
+	270, // 461: confirmate.orchestrator.v1.Orchestrator.TransitionControlInScopeState:output_type -> confirmate.orchestrator.v1.ControlInScope
+	288, // 462: confirmate.orchestrator.v1.Orchestrator.RemoveControlInScope:output_type -> google.protobuf.Empty
+	291, // 463: confirmate.orchestrator.v1.Orchestrator.ListAuditTrailEvents:output_type -> confirmate.orchestrator.v1.ListAuditTrailEventsResponse
+	332, // [332:464] is the sub-list for method output_type
+	200, // [200:332] is the sub-list for method input_type
+	200, // [200:200] is the sub-list for extension type_name
+	200, // [200:200] is the sub-list for extension extendee
+	0,   // [0:200] is the sub-list for field type_name
 }
 
 func init() { file_api_orchestrator_orchestrator_proto_init() }
@@ -6951,9 +18883,31 @@ func file_api_orchestrator_orchestrator_proto_init() {
 	file_api_orchestrator_user_proto_init()
 	file_api_orchestrator_workflow_proto_init()
 	file_api_orchestrator_orchestrator_proto_msgTypes[1].OneofWrappers = []any{}
-	file_api_orchestrator_orchestrator_proto_msgTypes[10].OneofWrappers = []any{}
-	file_api_orchestrator_orchestrator_proto_msgTypes[15].OneofWrappers = []any{}
-	file_api_orchestrator_orchestrator_proto_msgTypes[33].OneofWrappers = []any{
+	file_api_orchestrator_orchestrator_proto_msgTypes[11].OneofWrappers = []any{}
+	file_api_orchestrator_orchestrator_proto_msgTypes[16].OneofWrappers = []any{}
+	file_api_orchestrator_orchestrator_proto_msgTypes[18].OneofWrappers = []any{}
+	file_api_orchestrator_orchestrator_proto_msgTypes[21].OneofWrappers = []any{}
+	file_api_orchestrator_orchestrator_proto_msgTypes[25].OneofWrappers = []any{}
+	file_api_orchestrator_orchestrator_proto_msgTypes[28].OneofWrappers = []any{}
+	file_api_orchestrator_orchestrator_proto_msgTypes[33].OneofWrappers = []any{}
+	file_api_orchestrator_orchestrator_proto_msgTypes[35].OneofWrappers = []any{}
+	file_api_orchestrator_orchestrator_proto_msgTypes[36].OneofWrappers = []any{
+		(*UploadAttachmentRequest_Metadata)(nil),
+		(*UploadAttachmentRequest_Chunk)(nil),
+	}
+	file_api_orchestrator_orchestrator_proto_msgTypes[38].OneofWrappers = []any{
+		(*DownloadAttachmentResponse_Metadata)(nil),
+		(*DownloadAttachmentResponse_Chunk)(nil),
+	}
+	file_api_orchestrator_orchestrator_proto_msgTypes[49].OneofWrappers = []any{}
+	file_api_orchestrator_orchestrator_proto_msgTypes[58].OneofWrappers = []any{}
+	file_api_orchestrator_orchestrator_proto_msgTypes[62].OneofWrappers = []any{}
+	file_api_orchestrator_orchestrator_proto_msgTypes[66].OneofWrappers = []any{}
+	file_api_orchestrator_orchestrator_proto_msgTypes[74].OneofWrappers = []any{}
+	file_api_orchestrator_orchestrator_proto_msgTypes[88].OneofWrappers = []any{}
+	file_api_orchestrator_orchestrator_proto_msgTypes[89].OneofWrappers = []any{}
+	file_api_orchestrator_orchestrator_proto_msgTypes[91].OneofWrappers = []any{}
+	file_api_orchestrator_orchestrator_proto_msgTypes[101].OneofWrappers = []any{
 		(*ChangeEvent_Metric)(nil),
 		(*ChangeEvent_TargetOfEvaluation)(nil),
 		(*ChangeEvent_AuditScope)(nil),
@@ -6963,33 +18917,58 @@ func file_api_orchestrator_orchestrator_proto_init() {
 		(*ChangeEvent_AssessmentTool)(nil),
 		(*ChangeEvent_User)(nil),
 		(*ChangeEvent_ControlInScope)(nil),
-	}
-	file_api_orchestrator_orchestrator_proto_msgTypes[35].OneofWrappers = []any{}
-	file_api_orchestrator_orchestrator_proto_msgTypes[36].OneofWrappers = []any{}
-	file_api_orchestrator_orchestrator_proto_msgTypes[38].OneofWrappers = []any{}
-	file_api_orchestrator_orchestrator_proto_msgTypes[39].OneofWrappers = []any{}
-	file_api_orchestrator_orchestrator_proto_msgTypes[41].OneofWrappers = []any{}
-	file_api_orchestrator_orchestrator_proto_msgTypes[46].OneofWrappers = []any{}
-	file_api_orchestrator_orchestrator_proto_msgTypes[63].OneofWrappers = []any{}
-	file_api_orchestrator_orchestrator_proto_msgTypes[74].OneofWrappers = []any{}
-	file_api_orchestrator_orchestrator_proto_msgTypes[76].OneofWrappers = []any{}
-	file_api_orchestrator_orchestrator_proto_msgTypes[82].OneofWrappers = []any{}
-	file_api_orchestrator_orchestrator_proto_msgTypes[83].OneofWrappers = []any{}
-	file_api_orchestrator_orchestrator_proto_msgTypes[86].OneofWrappers = []any{}
-	file_api_orchestrator_orchestrator_proto_msgTypes[87].OneofWrappers = []any{}
-	file_api_orchestrator_orchestrator_proto_msgTypes[90].OneofWrappers = []any{}
-	file_api_orchestrator_orchestrator_proto_msgTypes[91].OneofWrappers = []any{}
-	file_api_orchestrator_orchestrator_proto_msgTypes[92].OneofWrappers = []any{}
-	file_api_orchestrator_orchestrator_proto_msgTypes[93].OneofWrappers = []any{}
-	file_api_orchestrator_orchestrator_proto_msgTypes[94].OneofWrappers = []any{}
-	file_api_orchestrator_orchestrator_proto_msgTypes[96].OneofWrappers = []any{}
+		(*ChangeEvent_Catalog)(nil),
+	}
+	file_api_orchestrator_orchestrator_proto_msgTypes[102].OneofWrappers = []any{}
+	file_api_orchestrator_orchestrator_proto_msgTypes[103].OneofWrappers = []any{}
+	file_api_orchestrator_orchestrator_proto_msgTypes[105].OneofWrappers = []any{}
+	file_api_orchestrator_orchestrator_proto_msgTypes[107].OneofWrappers = []any{}
+	file_api_orchestrator_orchestrator_proto_msgTypes[108].OneofWrappers = []any{}
+	file_api_orchestrator_orchestrator_proto_msgTypes[109].OneofWrappers = []any{}
+	file_api_orchestrator_orchestrator_proto_msgTypes[110].OneofWrappers = []any{}
+	file_api_orchestrator_orchestrator_proto_msgTypes[111].OneofWrappers = []any{}
+	file_api_orchestrator_orchestrator_proto_msgTypes[114].OneofWrappers = []any{}
+	file_api_orchestrator_orchestrator_proto_msgTypes[116].OneofWrappers = []any{}
+	file_api_orchestrator_orchestrator_proto_msgTypes[117].OneofWrappers = []any{}
+	file_api_orchestrator_orchestrator_proto_msgTypes[119].OneofWrappers = []any{}
+	file_api_orchestrator_orchestrator_proto_msgTypes[121].OneofWrappers = []any{}
+	file_api_orchestrator_orchestrator_proto_msgTypes[122].OneofWrappers = []any{}
+	file_api_orchestrator_orchestrator_proto_msgTypes[124].OneofWrappers = []any{}
+	file_api_orchestrator_orchestrator_proto_msgTypes[125].OneofWrappers = []any{}
+	file_api_orchestrator_orchestrator_proto_msgTypes[126].OneofWrappers = []any{}
+	file_api_orchestrator_orchestrator_proto_msgTypes[128].OneofWrappers = []any{}
+	file_api_orchestrator_orchestrator_proto_msgTypes[129].OneofWrappers = []any{}
+	file_api_orchestrator_orchestrator_proto_msgTypes[134].OneofWrappers = []any{}
+	file_api_orchestrator_orchestrator_proto_msgTypes[138].OneofWrappers = []any{}
+	file_api_orchestrator_orchestrator_proto_msgTypes[145].OneofWrappers = []any{}
+	file_api_orchestrator_orchestrator_proto_msgTypes[156].OneofWrappers = []any{}
+	file_api_orchestrator_orchestrator_proto_msgTypes[157].OneofWrappers = []any{}
+	file_api_orchestrator_orchestrator_proto_msgTypes[168].OneofWrappers = []any{}
+	file_api_orchestrator_orchestrator_proto_msgTypes[169].OneofWrappers = []any{}
+	file_api_orchestrator_orchestrator_proto_msgTypes[171].OneofWrappers = []any{}
+	file_api_orchestrator_orchestrator_proto_msgTypes[200].OneofWrappers = []any{}
+	file_api_orchestrator_orchestrator_proto_msgTypes[202].OneofWrappers = []any{}
+	file_api_orchestrator_orchestrator_proto_msgTypes[213].OneofWrappers = []any{}
+	file_api_orchestrator_orchestrator_proto_msgTypes[215].OneofWrappers = []any{}
+	file_api_orchestrator_orchestrator_proto_msgTypes[218].OneofWrappers = []any{}
+	file_api_orchestrator_orchestrator_proto_msgTypes[224].OneofWrappers = []any{}
+	file_api_orchestrator_orchestrator_proto_msgTypes[225].OneofWrappers = []any{}
+	file_api_orchestrator_orchestrator_proto_msgTypes[226].OneofWrappers = []any{}
+	file_api_orchestrator_orchestrator_proto_msgTypes[231].OneofWrappers = []any{}
+	file_api_orchestrator_orchestrator_proto_msgTypes[232].OneofWrappers = []any{}
+	file_api_orchestrator_orchestrator_proto_msgTypes[236].OneofWrappers = []any{}
+	file_api_orchestrator_orchestrator_proto_msgTypes[237].OneofWrappers = []any{}
+	file_api_orchestrator_orchestrator_proto_msgTypes[238].OneofWrappers = []any{}
+	file_api_orchestrator_orchestrator_proto_msgTypes[239].OneofWrappers = []any{}
+	file_api_orchestrator_orchestrator_proto_msgTypes[240].OneofWrappers = []any{}
+	file_api_orchestrator_orchestrator_proto_msgTypes[242].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_orchestrator_orchestrator_proto_rawDesc), len(file_api_orchestrator_orchestrator_proto_rawDesc)),
-			NumEnums:      4,
-			NumMessages:   97,
+			NumEnums:      15,
+			NumMessages:   243,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -0,0 +1,168 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package orchestrator
+
+import (
+	"testing"
+
+	"confirmate.io/core/util/assert"
+)
+
+func TestAuditScope_AllCatalogIds(t *testing.T) {
+	tests := []struct {
+		name  string
+		scope *AuditScope
+		want  assert.Want[[]string]
+	}{
+		{
+			name:  "nil audit scope",
+			scope: nil,
+			want:  assert.Nil[[]string],
+		},
+		{
+			name:  "primary catalog only",
+			scope: &AuditScope{CatalogId: "c5"},
+			want: func(t *testing.T, got []string, msgAndArgs ...any) bool {
+				return assert.Equal(t, []string{"c5"}, got)
+			},
+		},
+		{
+			name: "additional catalogs without duplicates",
+			scope: &AuditScope{
+				CatalogId:            "c5",
+				AdditionalCatalogIds: []string{"baseline", "c5", "iso27001"},
+			},
+			want: func(t *testing.T, got []string, msgAndArgs ...any) bool {
+				return assert.Equal(t, []string{"c5", "baseline", "iso27001"}, got)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.scope.AllCatalogIds()
+			tt.want(t, got)
+		})
+	}
+}
+
+func TestControl_IsRelevantFor(t *testing.T) {
+	var catalog = &Catalog{
+		AssuranceLevels: []string{"basic", "substantial", "high"},
+		ApplicabilityRules: []*ApplicabilityRule{
+			{ControlShortName: "OPS-02", AssuranceLevels: []string{"high"}},
+			{ControlShortName: "OPS-03"},
+		},
+	}
+
+	tests := []struct {
+		name       string
+		control    *Control
+		auditScope *AuditScope
+		catalog    *Catalog
+		want       bool
+	}{
+		{
+			name:       "catalog without assurance levels",
+			control:    &Control{ShortName: "OPS-01", AssuranceLevel: new("high")},
+			auditScope: &AuditScope{AssuranceLevel: new("basic")},
+			catalog:    &Catalog{},
+			want:       true,
+		},
+		{
+			name:       "control level below audit scope level",
+			control:    &Control{ShortName: "OPS-01", AssuranceLevel: new("basic")},
+			auditScope: &AuditScope{AssuranceLevel: new("substantial")},
+			catalog:    catalog,
+			want:       true,
+		},
+		{
+			name:       "control level above audit scope level",
+			control:    &Control{ShortName: "OPS-01", AssuranceLevel: new("high")},
+			auditScope: &AuditScope{AssuranceLevel: new("substantial")},
+			catalog:    catalog,
+			want:       false,
+		},
+		{
+			name:       "rule overrides control level",
+			control:    &Control{ShortName: "OPS-02", AssuranceLevel: new("basic")},
+			auditScope: &AuditScope{AssuranceLevel: new("substantial")},
+			catalog:    catalog,
+			want:       false,
+		},
+		{
+			name:       "rule contains audit scope level",
+			control:    &Control{ShortName: "OPS-02", AssuranceLevel: new("basic")},
+			auditScope: &AuditScope{AssuranceLevel: new("high")},
+			catalog:    catalog,
+			want:       true,
+		},
+		{
+			name:       "rule without levels",
+			control:    &Control{ShortName: "OPS-03"},
+			auditScope: &AuditScope{},
+			catalog:    catalog,
+			want:       false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.control.IsRelevantFor(tt.auditScope, tt.catalog)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestStatusAggregationRule_Matches(t *testing.T) {
+	tests := []struct {
+		name  string
+		rule  *StatusAggregationRule
+		share float64
+		want  bool
+	}{
+		{
+			name:  "no bounds",
+			rule:  &StatusAggregationRule{},
+			share: 0,
+			want:  true,
+		},
+		{
+			name:  "within bounds",
+			rule:  &StatusAggregationRule{MinCompliantShare: new(0.5), MaxCompliantShare: new(0.99)},
+			share: 0.5,
+			want:  true,
+		},
+		{
+			name:  "below minimum",
+			rule:  &StatusAggregationRule{MinCompliantShare: new(0.5)},
+			share: 0.25,
+			want:  false,
+		},
+		{
+			name:  "above maximum",
+			rule:  &StatusAggregationRule{MaxCompliantShare: new(0.0)},
+			share: 0.25,
+			want:  false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.rule.Matches(tt.share))
+		})
+	}
+}
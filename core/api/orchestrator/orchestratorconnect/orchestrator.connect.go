@@ -72,9 +72,21 @@ const (
 	// OrchestratorStoreAssessmentResultsProcedure is the fully-qualified name of the Orchestrator's
 	// StoreAssessmentResults RPC.
 	OrchestratorStoreAssessmentResultsProcedure = "/confirmate.orchestrator.v1.Orchestrator/StoreAssessmentResults"
+	// OrchestratorBatchStoreAssessmentResultsProcedure is the fully-qualified name of the
+	// Orchestrator's BatchStoreAssessmentResults RPC.
+	OrchestratorBatchStoreAssessmentResultsProcedure = "/confirmate.orchestrator.v1.Orchestrator/BatchStoreAssessmentResults"
 	// OrchestratorGetAssessmentResultProcedure is the fully-qualified name of the Orchestrator's
 	// GetAssessmentResult RPC.
 	OrchestratorGetAssessmentResultProcedure = "/confirmate.orchestrator.v1.Orchestrator/GetAssessmentResult"
+	// OrchestratorWaiveAssessmentResultProcedure is the fully-qualified name of the Orchestrator's
+	// WaiveAssessmentResult RPC.
+	OrchestratorWaiveAssessmentResultProcedure = "/confirmate.orchestrator.v1.Orchestrator/WaiveAssessmentResult"
+	// OrchestratorRevokeAssessmentResultWaiverProcedure is the fully-qualified name of the
+	// Orchestrator's RevokeAssessmentResultWaiver RPC.
+	OrchestratorRevokeAssessmentResultWaiverProcedure = "/confirmate.orchestrator.v1.Orchestrator/RevokeAssessmentResultWaiver"
+	// OrchestratorImportExternalFindingsProcedure is the fully-qualified name of the Orchestrator's
+	// ImportExternalFindings RPC.
+	OrchestratorImportExternalFindingsProcedure = "/confirmate.orchestrator.v1.Orchestrator/ImportExternalFindings"
 	// OrchestratorStoreEvaluationResultProcedure is the fully-qualified name of the Orchestrator's
 	// StoreEvaluationResult RPC.
 	OrchestratorStoreEvaluationResultProcedure = "/confirmate.orchestrator.v1.Orchestrator/StoreEvaluationResult"
@@ -84,6 +96,50 @@ const (
 	// OrchestratorListEvaluationResultsProcedure is the fully-qualified name of the Orchestrator's
 	// ListEvaluationResults RPC.
 	OrchestratorListEvaluationResultsProcedure = "/confirmate.orchestrator.v1.Orchestrator/ListEvaluationResults"
+	// OrchestratorListSlaBreachesProcedure is the fully-qualified name of the Orchestrator's
+	// ListSlaBreaches RPC.
+	OrchestratorListSlaBreachesProcedure = "/confirmate.orchestrator.v1.Orchestrator/ListSlaBreaches"
+	// OrchestratorGetCompliancePostureProcedure is the fully-qualified name of the Orchestrator's
+	// GetCompliancePosture RPC.
+	OrchestratorGetCompliancePostureProcedure = "/confirmate.orchestrator.v1.Orchestrator/GetCompliancePosture"
+	// OrchestratorListAlertsProcedure is the fully-qualified name of the Orchestrator's ListAlerts RPC.
+	OrchestratorListAlertsProcedure = "/confirmate.orchestrator.v1.Orchestrator/ListAlerts"
+	// OrchestratorUploadAttachmentProcedure is the fully-qualified name of the Orchestrator's
+	// UploadAttachment RPC.
+	OrchestratorUploadAttachmentProcedure = "/confirmate.orchestrator.v1.Orchestrator/UploadAttachment"
+	// OrchestratorDownloadAttachmentProcedure is the fully-qualified name of the Orchestrator's
+	// DownloadAttachment RPC.
+	OrchestratorDownloadAttachmentProcedure = "/confirmate.orchestrator.v1.Orchestrator/DownloadAttachment"
+	// OrchestratorListAttachmentsProcedure is the fully-qualified name of the Orchestrator's
+	// ListAttachments RPC.
+	OrchestratorListAttachmentsProcedure = "/confirmate.orchestrator.v1.Orchestrator/ListAttachments"
+	// OrchestratorRemoveAttachmentProcedure is the fully-qualified name of the Orchestrator's
+	// RemoveAttachment RPC.
+	OrchestratorRemoveAttachmentProcedure = "/confirmate.orchestrator.v1.Orchestrator/RemoveAttachment"
+	// OrchestratorAddEvaluationResultCommentProcedure is the fully-qualified name of the Orchestrator's
+	// AddEvaluationResultComment RPC.
+	OrchestratorAddEvaluationResultCommentProcedure = "/confirmate.orchestrator.v1.Orchestrator/AddEvaluationResultComment"
+	// OrchestratorListEvaluationResultCommentsProcedure is the fully-qualified name of the
+	// Orchestrator's ListEvaluationResultComments RPC.
+	OrchestratorListEvaluationResultCommentsProcedure = "/confirmate.orchestrator.v1.Orchestrator/ListEvaluationResultComments"
+	// OrchestratorRemoveEvaluationResultCommentProcedure is the fully-qualified name of the
+	// Orchestrator's RemoveEvaluationResultComment RPC.
+	OrchestratorRemoveEvaluationResultCommentProcedure = "/confirmate.orchestrator.v1.Orchestrator/RemoveEvaluationResultComment"
+	// OrchestratorCreateSavedViewProcedure is the fully-qualified name of the Orchestrator's
+	// CreateSavedView RPC.
+	OrchestratorCreateSavedViewProcedure = "/confirmate.orchestrator.v1.Orchestrator/CreateSavedView"
+	// OrchestratorGetSavedViewProcedure is the fully-qualified name of the Orchestrator's GetSavedView
+	// RPC.
+	OrchestratorGetSavedViewProcedure = "/confirmate.orchestrator.v1.Orchestrator/GetSavedView"
+	// OrchestratorListSavedViewsProcedure is the fully-qualified name of the Orchestrator's
+	// ListSavedViews RPC.
+	OrchestratorListSavedViewsProcedure = "/confirmate.orchestrator.v1.Orchestrator/ListSavedViews"
+	// OrchestratorUpdateSavedViewProcedure is the fully-qualified name of the Orchestrator's
+	// UpdateSavedView RPC.
+	OrchestratorUpdateSavedViewProcedure = "/confirmate.orchestrator.v1.Orchestrator/UpdateSavedView"
+	// OrchestratorRemoveSavedViewProcedure is the fully-qualified name of the Orchestrator's
+	// RemoveSavedView RPC.
+	OrchestratorRemoveSavedViewProcedure = "/confirmate.orchestrator.v1.Orchestrator/RemoveSavedView"
 	// OrchestratorCreateMetricProcedure is the fully-qualified name of the Orchestrator's CreateMetric
 	// RPC.
 	OrchestratorCreateMetricProcedure = "/confirmate.orchestrator.v1.Orchestrator/CreateMetric"
@@ -113,18 +169,75 @@ const (
 	// OrchestratorRemoveTargetOfEvaluationProcedure is the fully-qualified name of the Orchestrator's
 	// RemoveTargetOfEvaluation RPC.
 	OrchestratorRemoveTargetOfEvaluationProcedure = "/confirmate.orchestrator.v1.Orchestrator/RemoveTargetOfEvaluation"
+	// OrchestratorMergeTargetsOfEvaluationProcedure is the fully-qualified name of the Orchestrator's
+	// MergeTargetsOfEvaluation RPC.
+	OrchestratorMergeTargetsOfEvaluationProcedure = "/confirmate.orchestrator.v1.Orchestrator/MergeTargetsOfEvaluation"
 	// OrchestratorGetTargetOfEvaluationStatisticsProcedure is the fully-qualified name of the
 	// Orchestrator's GetTargetOfEvaluationStatistics RPC.
 	OrchestratorGetTargetOfEvaluationStatisticsProcedure = "/confirmate.orchestrator.v1.Orchestrator/GetTargetOfEvaluationStatistics"
+	// OrchestratorGetUsageReportProcedure is the fully-qualified name of the Orchestrator's
+	// GetUsageReport RPC.
+	OrchestratorGetUsageReportProcedure = "/confirmate.orchestrator.v1.Orchestrator/GetUsageReport"
+	// OrchestratorCreateSecretProcedure is the fully-qualified name of the Orchestrator's CreateSecret
+	// RPC.
+	OrchestratorCreateSecretProcedure = "/confirmate.orchestrator.v1.Orchestrator/CreateSecret"
+	// OrchestratorListSecretsProcedure is the fully-qualified name of the Orchestrator's ListSecrets
+	// RPC.
+	OrchestratorListSecretsProcedure = "/confirmate.orchestrator.v1.Orchestrator/ListSecrets"
+	// OrchestratorUpdateSecretProcedure is the fully-qualified name of the Orchestrator's UpdateSecret
+	// RPC.
+	OrchestratorUpdateSecretProcedure = "/confirmate.orchestrator.v1.Orchestrator/UpdateSecret"
+	// OrchestratorRemoveSecretProcedure is the fully-qualified name of the Orchestrator's RemoveSecret
+	// RPC.
+	OrchestratorRemoveSecretProcedure = "/confirmate.orchestrator.v1.Orchestrator/RemoveSecret"
+	// OrchestratorAccessSecretProcedure is the fully-qualified name of the Orchestrator's AccessSecret
+	// RPC.
+	OrchestratorAccessSecretProcedure = "/confirmate.orchestrator.v1.Orchestrator/AccessSecret"
+	// OrchestratorStoreResourceInventoryProcedure is the fully-qualified name of the Orchestrator's
+	// StoreResourceInventory RPC.
+	OrchestratorStoreResourceInventoryProcedure = "/confirmate.orchestrator.v1.Orchestrator/StoreResourceInventory"
+	// OrchestratorListResourceInventoriesProcedure is the fully-qualified name of the Orchestrator's
+	// ListResourceInventories RPC.
+	OrchestratorListResourceInventoriesProcedure = "/confirmate.orchestrator.v1.Orchestrator/ListResourceInventories"
+	// OrchestratorUpdateMetadataFieldProcedure is the fully-qualified name of the Orchestrator's
+	// UpdateMetadataField RPC.
+	OrchestratorUpdateMetadataFieldProcedure = "/confirmate.orchestrator.v1.Orchestrator/UpdateMetadataField"
+	// OrchestratorListMetadataFieldsProcedure is the fully-qualified name of the Orchestrator's
+	// ListMetadataFields RPC.
+	OrchestratorListMetadataFieldsProcedure = "/confirmate.orchestrator.v1.Orchestrator/ListMetadataFields"
+	// OrchestratorRemoveMetadataFieldProcedure is the fully-qualified name of the Orchestrator's
+	// RemoveMetadataField RPC.
+	OrchestratorRemoveMetadataFieldProcedure = "/confirmate.orchestrator.v1.Orchestrator/RemoveMetadataField"
+	// OrchestratorUpdateRedactionRuleProcedure is the fully-qualified name of the Orchestrator's
+	// UpdateRedactionRule RPC.
+	OrchestratorUpdateRedactionRuleProcedure = "/confirmate.orchestrator.v1.Orchestrator/UpdateRedactionRule"
+	// OrchestratorListRedactionRulesProcedure is the fully-qualified name of the Orchestrator's
+	// ListRedactionRules RPC.
+	OrchestratorListRedactionRulesProcedure = "/confirmate.orchestrator.v1.Orchestrator/ListRedactionRules"
+	// OrchestratorRemoveRedactionRuleProcedure is the fully-qualified name of the Orchestrator's
+	// RemoveRedactionRule RPC.
+	OrchestratorRemoveRedactionRuleProcedure = "/confirmate.orchestrator.v1.Orchestrator/RemoveRedactionRule"
 	// OrchestratorUpdateMetricConfigurationProcedure is the fully-qualified name of the Orchestrator's
 	// UpdateMetricConfiguration RPC.
 	OrchestratorUpdateMetricConfigurationProcedure = "/confirmate.orchestrator.v1.Orchestrator/UpdateMetricConfiguration"
 	// OrchestratorGetMetricConfigurationProcedure is the fully-qualified name of the Orchestrator's
 	// GetMetricConfiguration RPC.
 	OrchestratorGetMetricConfigurationProcedure = "/confirmate.orchestrator.v1.Orchestrator/GetMetricConfiguration"
+	// OrchestratorResolveMetricConfigurationProcedure is the fully-qualified name of the Orchestrator's
+	// ResolveMetricConfiguration RPC.
+	OrchestratorResolveMetricConfigurationProcedure = "/confirmate.orchestrator.v1.Orchestrator/ResolveMetricConfiguration"
 	// OrchestratorListMetricConfigurationsProcedure is the fully-qualified name of the Orchestrator's
 	// ListMetricConfigurations RPC.
 	OrchestratorListMetricConfigurationsProcedure = "/confirmate.orchestrator.v1.Orchestrator/ListMetricConfigurations"
+	// OrchestratorUpdateCatalogMetricConfigurationProcedure is the fully-qualified name of the
+	// Orchestrator's UpdateCatalogMetricConfiguration RPC.
+	OrchestratorUpdateCatalogMetricConfigurationProcedure = "/confirmate.orchestrator.v1.Orchestrator/UpdateCatalogMetricConfiguration"
+	// OrchestratorListCatalogMetricConfigurationsProcedure is the fully-qualified name of the
+	// Orchestrator's ListCatalogMetricConfigurations RPC.
+	OrchestratorListCatalogMetricConfigurationsProcedure = "/confirmate.orchestrator.v1.Orchestrator/ListCatalogMetricConfigurations"
+	// OrchestratorRemoveCatalogMetricConfigurationProcedure is the fully-qualified name of the
+	// Orchestrator's RemoveCatalogMetricConfiguration RPC.
+	OrchestratorRemoveCatalogMetricConfigurationProcedure = "/confirmate.orchestrator.v1.Orchestrator/RemoveCatalogMetricConfiguration"
 	// OrchestratorUpdateMetricImplementationProcedure is the fully-qualified name of the Orchestrator's
 	// UpdateMetricImplementation RPC.
 	OrchestratorUpdateMetricImplementationProcedure = "/confirmate.orchestrator.v1.Orchestrator/UpdateMetricImplementation"
@@ -133,6 +246,18 @@ const (
 	OrchestratorGetMetricImplementationProcedure = "/confirmate.orchestrator.v1.Orchestrator/GetMetricImplementation"
 	// OrchestratorSubscribeProcedure is the fully-qualified name of the Orchestrator's Subscribe RPC.
 	OrchestratorSubscribeProcedure = "/confirmate.orchestrator.v1.Orchestrator/Subscribe"
+	// OrchestratorCreateWebhookProcedure is the fully-qualified name of the Orchestrator's
+	// CreateWebhook RPC.
+	OrchestratorCreateWebhookProcedure = "/confirmate.orchestrator.v1.Orchestrator/CreateWebhook"
+	// OrchestratorListWebhooksProcedure is the fully-qualified name of the Orchestrator's ListWebhooks
+	// RPC.
+	OrchestratorListWebhooksProcedure = "/confirmate.orchestrator.v1.Orchestrator/ListWebhooks"
+	// OrchestratorRemoveWebhookProcedure is the fully-qualified name of the Orchestrator's
+	// RemoveWebhook RPC.
+	OrchestratorRemoveWebhookProcedure = "/confirmate.orchestrator.v1.Orchestrator/RemoveWebhook"
+	// OrchestratorListWebhookDeliveriesProcedure is the fully-qualified name of the Orchestrator's
+	// ListWebhookDeliveries RPC.
+	OrchestratorListWebhookDeliveriesProcedure = "/confirmate.orchestrator.v1.Orchestrator/ListWebhookDeliveries"
 	// OrchestratorCreateCertificateProcedure is the fully-qualified name of the Orchestrator's
 	// CreateCertificate RPC.
 	OrchestratorCreateCertificateProcedure = "/confirmate.orchestrator.v1.Orchestrator/CreateCertificate"
@@ -159,12 +284,60 @@ const (
 	OrchestratorListCatalogsProcedure = "/confirmate.orchestrator.v1.Orchestrator/ListCatalogs"
 	// OrchestratorGetCatalogProcedure is the fully-qualified name of the Orchestrator's GetCatalog RPC.
 	OrchestratorGetCatalogProcedure = "/confirmate.orchestrator.v1.Orchestrator/GetCatalog"
+	// OrchestratorGetCatalogTreeProcedure is the fully-qualified name of the Orchestrator's
+	// GetCatalogTree RPC.
+	OrchestratorGetCatalogTreeProcedure = "/confirmate.orchestrator.v1.Orchestrator/GetCatalogTree"
 	// OrchestratorRemoveCatalogProcedure is the fully-qualified name of the Orchestrator's
 	// RemoveCatalog RPC.
 	OrchestratorRemoveCatalogProcedure = "/confirmate.orchestrator.v1.Orchestrator/RemoveCatalog"
 	// OrchestratorUpdateCatalogProcedure is the fully-qualified name of the Orchestrator's
 	// UpdateCatalog RPC.
 	OrchestratorUpdateCatalogProcedure = "/confirmate.orchestrator.v1.Orchestrator/UpdateCatalog"
+	// OrchestratorPublishCatalogProcedure is the fully-qualified name of the Orchestrator's
+	// PublishCatalog RPC.
+	OrchestratorPublishCatalogProcedure = "/confirmate.orchestrator.v1.Orchestrator/PublishCatalog"
+	// OrchestratorDiscardCatalogDraftProcedure is the fully-qualified name of the Orchestrator's
+	// DiscardCatalogDraft RPC.
+	OrchestratorDiscardCatalogDraftProcedure = "/confirmate.orchestrator.v1.Orchestrator/DiscardCatalogDraft"
+	// OrchestratorListCatalogChangelogsProcedure is the fully-qualified name of the Orchestrator's
+	// ListCatalogChangelogs RPC.
+	OrchestratorListCatalogChangelogsProcedure = "/confirmate.orchestrator.v1.Orchestrator/ListCatalogChangelogs"
+	// OrchestratorGetCatalogChangelogProcedure is the fully-qualified name of the Orchestrator's
+	// GetCatalogChangelog RPC.
+	OrchestratorGetCatalogChangelogProcedure = "/confirmate.orchestrator.v1.Orchestrator/GetCatalogChangelog"
+	// OrchestratorImportControlMetricMappingProcedure is the fully-qualified name of the Orchestrator's
+	// ImportControlMetricMapping RPC.
+	OrchestratorImportControlMetricMappingProcedure = "/confirmate.orchestrator.v1.Orchestrator/ImportControlMetricMapping"
+	// OrchestratorUpdateAssuranceLevelProcedure is the fully-qualified name of the Orchestrator's
+	// UpdateAssuranceLevel RPC.
+	OrchestratorUpdateAssuranceLevelProcedure = "/confirmate.orchestrator.v1.Orchestrator/UpdateAssuranceLevel"
+	// OrchestratorListAssuranceLevelsProcedure is the fully-qualified name of the Orchestrator's
+	// ListAssuranceLevels RPC.
+	OrchestratorListAssuranceLevelsProcedure = "/confirmate.orchestrator.v1.Orchestrator/ListAssuranceLevels"
+	// OrchestratorRemoveAssuranceLevelProcedure is the fully-qualified name of the Orchestrator's
+	// RemoveAssuranceLevel RPC.
+	OrchestratorRemoveAssuranceLevelProcedure = "/confirmate.orchestrator.v1.Orchestrator/RemoveAssuranceLevel"
+	// OrchestratorUpdateApplicabilityRuleProcedure is the fully-qualified name of the Orchestrator's
+	// UpdateApplicabilityRule RPC.
+	OrchestratorUpdateApplicabilityRuleProcedure = "/confirmate.orchestrator.v1.Orchestrator/UpdateApplicabilityRule"
+	// OrchestratorListApplicabilityRulesProcedure is the fully-qualified name of the Orchestrator's
+	// ListApplicabilityRules RPC.
+	OrchestratorListApplicabilityRulesProcedure = "/confirmate.orchestrator.v1.Orchestrator/ListApplicabilityRules"
+	// OrchestratorRemoveApplicabilityRuleProcedure is the fully-qualified name of the Orchestrator's
+	// RemoveApplicabilityRule RPC.
+	OrchestratorRemoveApplicabilityRuleProcedure = "/confirmate.orchestrator.v1.Orchestrator/RemoveApplicabilityRule"
+	// OrchestratorCreateCatalogSourceProcedure is the fully-qualified name of the Orchestrator's
+	// CreateCatalogSource RPC.
+	OrchestratorCreateCatalogSourceProcedure = "/confirmate.orchestrator.v1.Orchestrator/CreateCatalogSource"
+	// OrchestratorListCatalogSourcesProcedure is the fully-qualified name of the Orchestrator's
+	// ListCatalogSources RPC.
+	OrchestratorListCatalogSourcesProcedure = "/confirmate.orchestrator.v1.Orchestrator/ListCatalogSources"
+	// OrchestratorRemoveCatalogSourceProcedure is the fully-qualified name of the Orchestrator's
+	// RemoveCatalogSource RPC.
+	OrchestratorRemoveCatalogSourceProcedure = "/confirmate.orchestrator.v1.Orchestrator/RemoveCatalogSource"
+	// OrchestratorSyncCatalogSourceProcedure is the fully-qualified name of the Orchestrator's
+	// SyncCatalogSource RPC.
+	OrchestratorSyncCatalogSourceProcedure = "/confirmate.orchestrator.v1.Orchestrator/SyncCatalogSource"
 	// OrchestratorGetCategoryProcedure is the fully-qualified name of the Orchestrator's GetCategory
 	// RPC.
 	OrchestratorGetCategoryProcedure = "/confirmate.orchestrator.v1.Orchestrator/GetCategory"
@@ -173,6 +346,12 @@ const (
 	OrchestratorListControlsProcedure = "/confirmate.orchestrator.v1.Orchestrator/ListControls"
 	// OrchestratorGetControlProcedure is the fully-qualified name of the Orchestrator's GetControl RPC.
 	OrchestratorGetControlProcedure = "/confirmate.orchestrator.v1.Orchestrator/GetControl"
+	// OrchestratorListControlTextVersionsProcedure is the fully-qualified name of the Orchestrator's
+	// ListControlTextVersions RPC.
+	OrchestratorListControlTextVersionsProcedure = "/confirmate.orchestrator.v1.Orchestrator/ListControlTextVersions"
+	// OrchestratorRenderControlTextProcedure is the fully-qualified name of the Orchestrator's
+	// RenderControlText RPC.
+	OrchestratorRenderControlTextProcedure = "/confirmate.orchestrator.v1.Orchestrator/RenderControlText"
 	// OrchestratorCreateAuditScopeProcedure is the fully-qualified name of the Orchestrator's
 	// CreateAuditScope RPC.
 	OrchestratorCreateAuditScopeProcedure = "/confirmate.orchestrator.v1.Orchestrator/CreateAuditScope"
@@ -188,6 +367,26 @@ const (
 	// OrchestratorRemoveAuditScopeProcedure is the fully-qualified name of the Orchestrator's
 	// RemoveAuditScope RPC.
 	OrchestratorRemoveAuditScopeProcedure = "/confirmate.orchestrator.v1.Orchestrator/RemoveAuditScope"
+	// OrchestratorCreateMaintenanceWindowProcedure is the fully-qualified name of the Orchestrator's
+	// CreateMaintenanceWindow RPC.
+	OrchestratorCreateMaintenanceWindowProcedure = "/confirmate.orchestrator.v1.Orchestrator/CreateMaintenanceWindow"
+	// OrchestratorListMaintenanceWindowsProcedure is the fully-qualified name of the Orchestrator's
+	// ListMaintenanceWindows RPC.
+	OrchestratorListMaintenanceWindowsProcedure = "/confirmate.orchestrator.v1.Orchestrator/ListMaintenanceWindows"
+	// OrchestratorRemoveMaintenanceWindowProcedure is the fully-qualified name of the Orchestrator's
+	// RemoveMaintenanceWindow RPC.
+	OrchestratorRemoveMaintenanceWindowProcedure = "/confirmate.orchestrator.v1.Orchestrator/RemoveMaintenanceWindow"
+	// OrchestratorExportOSCALProcedure is the fully-qualified name of the Orchestrator's ExportOSCAL
+	// RPC.
+	OrchestratorExportOSCALProcedure = "/confirmate.orchestrator.v1.Orchestrator/ExportOSCAL"
+	// OrchestratorArchiveAuditScopeDataProcedure is the fully-qualified name of the Orchestrator's
+	// ArchiveAuditScopeData RPC.
+	OrchestratorArchiveAuditScopeDataProcedure = "/confirmate.orchestrator.v1.Orchestrator/ArchiveAuditScopeData"
+	// OrchestratorRestoreAuditScopeDataProcedure is the fully-qualified name of the Orchestrator's
+	// RestoreAuditScopeData RPC.
+	OrchestratorRestoreAuditScopeDataProcedure = "/confirmate.orchestrator.v1.Orchestrator/RestoreAuditScopeData"
+	// OrchestratorGetSchemaProcedure is the fully-qualified name of the Orchestrator's GetSchema RPC.
+	OrchestratorGetSchemaProcedure = "/confirmate.orchestrator.v1.Orchestrator/GetSchema"
 	// OrchestratorGetRuntimeInfoProcedure is the fully-qualified name of the Orchestrator's
 	// GetRuntimeInfo RPC.
 	OrchestratorGetRuntimeInfoProcedure = "/confirmate.orchestrator.v1.Orchestrator/GetRuntimeInfo"
@@ -204,6 +403,11 @@ const (
 	OrchestratorGetUserProcedure = "/confirmate.orchestrator.v1.Orchestrator/GetUser"
 	// OrchestratorListUsersProcedure is the fully-qualified name of the Orchestrator's ListUsers RPC.
 	OrchestratorListUsersProcedure = "/confirmate.orchestrator.v1.Orchestrator/ListUsers"
+	// OrchestratorResolveUserProcedure is the fully-qualified name of the Orchestrator's ResolveUser
+	// RPC.
+	OrchestratorResolveUserProcedure = "/confirmate.orchestrator.v1.Orchestrator/ResolveUser"
+	// OrchestratorSyncUsersProcedure is the fully-qualified name of the Orchestrator's SyncUsers RPC.
+	OrchestratorSyncUsersProcedure = "/confirmate.orchestrator.v1.Orchestrator/SyncUsers"
 	// OrchestratorListUserPermissionsProcedure is the fully-qualified name of the Orchestrator's
 	// ListUserPermissions RPC.
 	OrchestratorListUserPermissionsProcedure = "/confirmate.orchestrator.v1.Orchestrator/ListUserPermissions"
@@ -254,8 +458,28 @@ type OrchestratorClient interface {
 	// Stores stream of assessment results provided by an assessment tool and
 	// returns a response stream. Part of the public API, not exposed as REST.
 	StoreAssessmentResults(context.Context) *connect.BidiStreamForClient[orchestrator.StoreAssessmentResultRequest, orchestrator.StoreAssessmentResultsResponse]
+	// Stores a batch of assessment results in a single transaction. Results that
+	// cannot be stored are reported individually in the response, while the
+	// remaining results are still stored.
+	BatchStoreAssessmentResults(context.Context, *connect.Request[orchestrator.BatchStoreAssessmentResultsRequest]) (*connect.Response[orchestrator.BatchStoreAssessmentResultsResponse], error)
 	// Get an assessment result by ID
 	GetAssessmentResult(context.Context, *connect.Request[orchestrator.GetAssessmentResultRequest]) (*connect.Response[assessment.AssessmentResult], error)
+	// Waives a non-compliant assessment result, i.e., accepts the risk of its
+	// non-compliance. Waived assessment results are skipped when evaluating the
+	// status of a control until the waiver expires. Part of the public API, also
+	// exposed as REST.
+	WaiveAssessmentResult(context.Context, *connect.Request[orchestrator.WaiveAssessmentResultRequest]) (*connect.Response[assessment.AssessmentResult], error)
+	// Revokes the waiver of an assessment result. Part of the public API, also
+	// exposed as REST.
+	RevokeAssessmentResultWaiver(context.Context, *connect.Request[orchestrator.RevokeAssessmentResultWaiverRequest]) (*connect.Response[assessment.AssessmentResult], error)
+	// Imports the findings of an external audit, e.g., a manual penetration
+	// test, from a file (CSV or JSON) as assessment results of a target of
+	// evaluation. Each finding is mapped to a control of a catalog and is stored
+	// as an externally sourced assessment result for the metrics of the control,
+	// so that it is evaluated together with the automated assessment results.
+	// The response contains a validation report of all findings. The findings
+	// are only imported if all of them are valid.
+	ImportExternalFindings(context.Context, *connect.Request[orchestrator.ImportExternalFindingsRequest]) (*connect.Response[orchestrator.ImportExternalFindingsResponse], error)
 	// Store the evaluation result provided by the evaluation component.″
 	StoreEvaluationResult(context.Context, *connect.Request[orchestrator.StoreEvaluationResultRequest]) (*connect.Response[evaluation.EvaluationResult], error)
 	// List all assessment results. Part of the public API, also exposed as REST.
@@ -264,6 +488,59 @@ type OrchestratorClient interface {
 	// restricted by various filtering options. Part of the public API, also
 	// exposed as REST.
 	ListEvaluationResults(context.Context, *connect.Request[orchestrator.ListEvaluationResultsRequest]) (*connect.Response[orchestrator.ListEvaluationResultsResponse], error)
+	// Lists the controls that are not compliant for longer than allowed by the
+	// SLA thresholds of their catalog. Part of the public API, also exposed as
+	// REST.
+	ListSlaBreaches(context.Context, *connect.Request[orchestrator.ListSlaBreachesRequest]) (*connect.Response[orchestrator.ListSlaBreachesResponse], error)
+	// Aggregates the compliance posture of all targets of evaluation the user has
+	// access to per catalog and per control family (i.e., category of a
+	// catalog), e.g., for a portfolio dashboard. Only the latest evaluation
+	// result of each top-level control of an audit scope is counted. Part of the
+	// public API, also exposed as REST.
+	GetCompliancePosture(context.Context, *connect.Request[orchestrator.GetCompliancePostureRequest]) (*connect.Response[orchestrator.CompliancePosture], error)
+	// Lists the alerts raised by the anomaly detection of incoming assessment
+	// results, e.g., a sudden drop in the result volume of a target of
+	// evaluation or a metric that flips between compliant and non-compliant.
+	// Part of the public API, also exposed as REST.
+	ListAlerts(context.Context, *connect.Request[orchestrator.ListAlertsRequest]) (*connect.Response[orchestrator.ListAlertsResponse], error)
+	// Uploads an attachment of an evaluation result. The first message must
+	// contain the metadata of the attachment, all following messages contain
+	// chunks of its content. Part of the public API, not exposed as REST.
+	UploadAttachment(context.Context) *connect.ClientStreamForClient[orchestrator.UploadAttachmentRequest, evaluation.Attachment]
+	// Downloads an attachment of an evaluation result. The first message
+	// contains the metadata of the attachment, all following messages contain
+	// chunks of its content. Part of the public API, not exposed as REST.
+	DownloadAttachment(context.Context, *connect.Request[orchestrator.DownloadAttachmentRequest]) (*connect.ServerStreamForClient[orchestrator.DownloadAttachmentResponse], error)
+	// Lists the attachments of an evaluation result. Part of the public API,
+	// also exposed as REST.
+	ListAttachments(context.Context, *connect.Request[orchestrator.ListAttachmentsRequest]) (*connect.Response[orchestrator.ListAttachmentsResponse], error)
+	// Removes an attachment including its content. Part of the public API, also
+	// exposed as REST.
+	RemoveAttachment(context.Context, *connect.Request[orchestrator.RemoveAttachmentRequest]) (*connect.Response[emptypb.Empty], error)
+	// Adds a comment to an evaluation result. The author and creation time are
+	// set by the server. Part of the public API, also exposed as REST.
+	AddEvaluationResultComment(context.Context, *connect.Request[orchestrator.AddEvaluationResultCommentRequest]) (*connect.Response[evaluation.Comment], error)
+	// Lists the comments of an evaluation result, by default in the order they
+	// were written. Part of the public API, also exposed as REST.
+	ListEvaluationResultComments(context.Context, *connect.Request[orchestrator.ListEvaluationResultCommentsRequest]) (*connect.Response[orchestrator.ListEvaluationResultCommentsResponse], error)
+	// Removes a comment including all of its replies. Part of the public API,
+	// also exposed as REST.
+	RemoveEvaluationResultComment(context.Context, *connect.Request[orchestrator.RemoveEvaluationResultCommentRequest]) (*connect.Response[emptypb.Empty], error)
+	// Creates a saved view, i.e., a named filter of evaluation results, owned by
+	// the calling user. Part of the public API, also exposed as REST.
+	CreateSavedView(context.Context, *connect.Request[orchestrator.CreateSavedViewRequest]) (*connect.Response[orchestrator.SavedView], error)
+	// Retrieves a saved view of the calling user or a view shared by another
+	// user. Part of the public API, also exposed as REST.
+	GetSavedView(context.Context, *connect.Request[orchestrator.GetSavedViewRequest]) (*connect.Response[orchestrator.SavedView], error)
+	// Lists the saved views of the calling user and the views shared by other
+	// users. Part of the public API, also exposed as REST.
+	ListSavedViews(context.Context, *connect.Request[orchestrator.ListSavedViewsRequest]) (*connect.Response[orchestrator.ListSavedViewsResponse], error)
+	// Updates a saved view of the calling user. Part of the public API, also
+	// exposed as REST.
+	UpdateSavedView(context.Context, *connect.Request[orchestrator.UpdateSavedViewRequest]) (*connect.Response[orchestrator.SavedView], error)
+	// Removes a saved view of the calling user. Part of the public API, also
+	// exposed as REST.
+	RemoveSavedView(context.Context, *connect.Request[orchestrator.RemoveSavedViewRequest]) (*connect.Response[emptypb.Empty], error)
 	// Creates a new metric
 	CreateMetric(context.Context, *connect.Request[orchestrator.CreateMetricRequest]) (*connect.Response[assessment.Metric], error)
 	// Updates an existing metric
@@ -284,23 +561,112 @@ type OrchestratorClient interface {
 	ListTargetsOfEvaluation(context.Context, *connect.Request[orchestrator.ListTargetsOfEvaluationRequest]) (*connect.Response[orchestrator.ListTargetsOfEvaluationResponse], error)
 	// Removes a target of evaluation
 	RemoveTargetOfEvaluation(context.Context, *connect.Request[orchestrator.RemoveTargetOfEvaluationRequest]) (*connect.Response[emptypb.Empty], error)
+	// Merges a duplicate target of evaluation into another one. All audit
+	// scopes, controls in scope, assessment and evaluation results,
+	// certificates, metric configurations and permissions of the duplicate are
+	// moved to the remaining target of evaluation and the duplicate is removed.
+	// In a dry run, the response only previews the affected entities.
+	MergeTargetsOfEvaluation(context.Context, *connect.Request[orchestrator.MergeTargetsOfEvaluationRequest]) (*connect.Response[orchestrator.MergeTargetsOfEvaluationResponse], error)
 	// Retrieves target of evaluation statistics
 	GetTargetOfEvaluationStatistics(context.Context, *connect.Request[orchestrator.GetTargetOfEvaluationStatisticsRequest]) (*connect.Response[orchestrator.GetTargetOfEvaluationStatisticsResponse], error)
+	// Reports the metered usage of all targets of evaluation the user has access
+	// to, e.g., so that operators of a hosted installation can bill or budget per
+	// customer. The usage is metered per day when data is stored and is not
+	// reduced when the data is archived or removed. Part of the public API, also
+	// exposed as REST.
+	GetUsageReport(context.Context, *connect.Request[orchestrator.GetUsageReportRequest]) (*connect.Response[orchestrator.UsageReport], error)
+	// Creates a secret of a target of evaluation, e.g., read-only API
+	// credentials of the system under evaluation that are used by collectors.
+	// The value is stored encrypted and never returned. Part of the public API,
+	// also exposed as REST.
+	CreateSecret(context.Context, *connect.Request[orchestrator.CreateSecretRequest]) (*connect.Response[orchestrator.Secret], error)
+	// Lists the secrets of a target of evaluation without their values, by
+	// default ordered by their name. Part of the public API, also exposed as
+	// REST.
+	ListSecrets(context.Context, *connect.Request[orchestrator.ListSecretsRequest]) (*connect.Response[orchestrator.ListSecretsResponse], error)
+	// Updates a secret. If no value is given, the value is kept. Part of the
+	// public API, also exposed as REST.
+	UpdateSecret(context.Context, *connect.Request[orchestrator.UpdateSecretRequest]) (*connect.Response[orchestrator.Secret], error)
+	// Removes a secret. Part of the public API, also exposed as REST.
+	RemoveSecret(context.Context, *connect.Request[orchestrator.RemoveSecretRequest]) (*connect.Response[emptypb.Empty], error)
+	// Returns the decrypted value of a secret of a target of evaluation by its
+	// name. Unlike listing the secrets, this requires contributor permission for
+	// the target of evaluation. It is intended for collectors, whose tokens
+	// should be restricted to the targets of evaluation they collect evidences
+	// for. Part of the public API, also exposed as REST.
+	AccessSecret(context.Context, *connect.Request[orchestrator.AccessSecretRequest]) (*connect.Response[orchestrator.SecretValue], error)
+	// Stores the resource inventory of a collector for a target of evaluation,
+	// i.e., which resources the collector discovered, regardless of whether they
+	// are assessed. It replaces the previous inventory of the same collector.
+	// Part of the public API, also exposed as REST.
+	StoreResourceInventory(context.Context, *connect.Request[orchestrator.StoreResourceInventoryRequest]) (*connect.Response[orchestrator.ResourceInventory], error)
+	// Lists the resource inventories of the collectors of a target of
+	// evaluation, by default ordered by the ID of the collector. Part of the
+	// public API, also exposed as REST.
+	ListResourceInventories(context.Context, *connect.Request[orchestrator.ListResourceInventoriesRequest]) (*connect.Response[orchestrator.ListResourceInventoriesResponse], error)
+	// Creates or updates a custom metadata field that can be set on targets of
+	// evaluation
+	UpdateMetadataField(context.Context, *connect.Request[orchestrator.UpdateMetadataFieldRequest]) (*connect.Response[orchestrator.MetadataField], error)
+	// Lists all custom metadata fields of targets of evaluation
+	ListMetadataFields(context.Context, *connect.Request[orchestrator.ListMetadataFieldsRequest]) (*connect.Response[orchestrator.ListMetadataFieldsResponse], error)
+	// Removes a custom metadata field and its values from all targets of
+	// evaluation
+	RemoveMetadataField(context.Context, *connect.Request[orchestrator.RemoveMetadataFieldRequest]) (*connect.Response[emptypb.Empty], error)
+	// Creates or updates the redaction rule of a resource type. Fields of
+	// resources of this type matching the rule are stripped or masked before
+	// they are evaluated by the assessment
+	UpdateRedactionRule(context.Context, *connect.Request[orchestrator.UpdateRedactionRuleRequest]) (*connect.Response[orchestrator.RedactionRule], error)
+	// Lists all redaction rules
+	ListRedactionRules(context.Context, *connect.Request[orchestrator.ListRedactionRulesRequest]) (*connect.Response[orchestrator.ListRedactionRulesResponse], error)
+	// Removes the redaction rule of a resource type
+	RemoveRedactionRule(context.Context, *connect.Request[orchestrator.RemoveRedactionRuleRequest]) (*connect.Response[emptypb.Empty], error)
 	// Updates a metric configuration (target value and operator) for a specific
 	// target of evaluation and metric ID
 	UpdateMetricConfiguration(context.Context, *connect.Request[orchestrator.UpdateMetricConfigurationRequest]) (*connect.Response[assessment.MetricConfiguration], error)
 	// Retrieves a metric configuration (target value and operator) for a specific
-	// target of evaluation and metric ID.
+	// target of evaluation and metric ID. The configuration is resolved in the
+	// following order: an explicit configuration of the target of evaluation,
+	// the default configuration of the catalog and finally the global default
+	// configuration of the metric. The layer that was used is returned in the
+	// source field.
 	GetMetricConfiguration(context.Context, *connect.Request[orchestrator.GetMetricConfigurationRequest]) (*connect.Response[assessment.MetricConfiguration], error)
+	// Resolves the effective metric configuration (target value and operator)
+	// for a specific target of evaluation and metric ID and explains how it was
+	// resolved. The resolution chain contains all layers that were considered,
+	// from the global default configuration of the metric over the default
+	// configurations of the catalogs to an explicit configuration of the target
+	// of evaluation, and states which layer was applied and why the others were
+	// not.
+	ResolveMetricConfiguration(context.Context, *connect.Request[orchestrator.ResolveMetricConfigurationRequest]) (*connect.Response[orchestrator.ResolveMetricConfigurationResponse], error)
 	// Lists all metric configurations (target value and operator) for a
 	// specific target of evaluation ID
 	ListMetricConfigurations(context.Context, *connect.Request[orchestrator.ListMetricConfigurationRequest]) (*connect.Response[orchestrator.ListMetricConfigurationResponse], error)
+	// Creates or updates the default metric configuration (target value and
+	// operator) of a metric for a specific catalog
+	UpdateCatalogMetricConfiguration(context.Context, *connect.Request[orchestrator.UpdateCatalogMetricConfigurationRequest]) (*connect.Response[assessment.CatalogMetricConfiguration], error)
+	// Lists all default metric configurations of a specific catalog
+	ListCatalogMetricConfigurations(context.Context, *connect.Request[orchestrator.ListCatalogMetricConfigurationsRequest]) (*connect.Response[orchestrator.ListCatalogMetricConfigurationsResponse], error)
+	// Removes the default metric configuration of a metric for a specific
+	// catalog
+	RemoveCatalogMetricConfiguration(context.Context, *connect.Request[orchestrator.RemoveCatalogMetricConfigurationRequest]) (*connect.Response[emptypb.Empty], error)
 	// Updates an existing metric implementation
 	UpdateMetricImplementation(context.Context, *connect.Request[orchestrator.UpdateMetricImplementationRequest]) (*connect.Response[assessment.MetricImplementation], error)
 	// Returns the metric implementation of the passed metric id
 	GetMetricImplementation(context.Context, *connect.Request[orchestrator.GetMetricImplementationRequest]) (*connect.Response[assessment.MetricImplementation], error)
 	// Subscribes to change events in the orchestrator
 	Subscribe(context.Context, *connect.Request[orchestrator.SubscribeRequest]) (*connect.ServerStreamForClient[orchestrator.ChangeEvent], error)
+	// Creates a webhook that receives the change events of the orchestrator
+	// matching its filter, e.g., to keep external systems in sync. Part of the
+	// public API, also exposed as REST.
+	CreateWebhook(context.Context, *connect.Request[orchestrator.CreateWebhookRequest]) (*connect.Response[orchestrator.Webhook], error)
+	// Lists all webhooks. Part of the public API, also exposed as REST.
+	ListWebhooks(context.Context, *connect.Request[orchestrator.ListWebhooksRequest]) (*connect.Response[orchestrator.ListWebhooksResponse], error)
+	// Removes a webhook together with its delivery log. Part of the public API,
+	// also exposed as REST.
+	RemoveWebhook(context.Context, *connect.Request[orchestrator.RemoveWebhookRequest]) (*connect.Response[emptypb.Empty], error)
+	// Lists the deliveries of change events to a webhook, by default the latest
+	// delivery first. Part of the public API, also exposed as REST.
+	ListWebhookDeliveries(context.Context, *connect.Request[orchestrator.ListWebhookDeliveriesRequest]) (*connect.Response[orchestrator.ListWebhookDeliveriesResponse], error)
 	// Creates a new certificate
 	CreateCertificate(context.Context, *connect.Request[orchestrator.CreateCertificateRequest]) (*connect.Response[orchestrator.Certificate], error)
 	// Retrieves a certificate
@@ -321,10 +687,60 @@ type OrchestratorClient interface {
 	// Retrieves a specific catalog by it's ID. The catalog includes a list of all
 	// of it categories as well as the first level of controls in each category.
 	GetCatalog(context.Context, *connect.Request[orchestrator.GetCatalogRequest]) (*connect.Response[orchestrator.Catalog], error)
+	// Retrieves the hierarchical structure of a catalog, i.e., its categories,
+	// controls and sub-controls, in a single call. Each control includes the
+	// number of its metrics and, if an audit scope is given, its latest
+	// evaluation status within the audit scope. The depth of the tree can be
+	// limited, so that clients can browse large catalogs interactively.
+	GetCatalogTree(context.Context, *connect.Request[orchestrator.GetCatalogTreeRequest]) (*connect.Response[orchestrator.GetCatalogTreeResponse], error)
 	// Removes a catalog
 	RemoveCatalog(context.Context, *connect.Request[orchestrator.RemoveCatalogRequest]) (*connect.Response[emptypb.Empty], error)
 	// Updates an existing certificate
 	UpdateCatalog(context.Context, *connect.Request[orchestrator.UpdateCatalogRequest]) (*connect.Response[orchestrator.Catalog], error)
+	// Publishes the draft of a catalog. The draft is snapshotted into a new,
+	// immutable catalog version that audit scopes can bind to. The draft itself
+	// stays editable.
+	PublishCatalog(context.Context, *connect.Request[orchestrator.PublishCatalogRequest]) (*connect.Response[orchestrator.Catalog], error)
+	// Discards all changes made to the draft of a catalog since its last
+	// published version.
+	DiscardCatalogDraft(context.Context, *connect.Request[orchestrator.DiscardCatalogDraftRequest]) (*connect.Response[orchestrator.Catalog], error)
+	// Lists the changelogs of a catalog, ordered by their creation time, most
+	// recent first. A changelog is generated whenever the draft of the catalog
+	// is re-imported from its catalog source or published.
+	ListCatalogChangelogs(context.Context, *connect.Request[orchestrator.ListCatalogChangelogsRequest]) (*connect.Response[orchestrator.ListCatalogChangelogsResponse], error)
+	// Retrieves a changelog of a catalog, including its rendering as Markdown.
+	GetCatalogChangelog(context.Context, *connect.Request[orchestrator.GetCatalogChangelogRequest]) (*connect.Response[orchestrator.CatalogChangelog], error)
+	// Imports a mapping of controls to metrics from a spreadsheet (CSV or XLSX)
+	// into the controls of a catalog. The response contains a validation report
+	// of all rows. The mapping is only applied if all rows are valid.
+	ImportControlMetricMapping(context.Context, *connect.Request[orchestrator.ImportControlMetricMappingRequest]) (*connect.Response[orchestrator.ImportControlMetricMappingResponse], error)
+	// Creates or updates an assurance level of a catalog. Published catalogs
+	// cannot be modified.
+	UpdateAssuranceLevel(context.Context, *connect.Request[orchestrator.UpdateAssuranceLevelRequest]) (*connect.Response[orchestrator.AssuranceLevel], error)
+	// Lists the assurance levels of a catalog, ordered by their rank
+	ListAssuranceLevels(context.Context, *connect.Request[orchestrator.ListAssuranceLevelsRequest]) (*connect.Response[orchestrator.ListAssuranceLevelsResponse], error)
+	// Removes an assurance level of a catalog. Published catalogs cannot be
+	// modified.
+	RemoveAssuranceLevel(context.Context, *connect.Request[orchestrator.RemoveAssuranceLevelRequest]) (*connect.Response[emptypb.Empty], error)
+	// Creates or updates the applicability rule of a control of a catalog.
+	// Published catalogs cannot be modified.
+	UpdateApplicabilityRule(context.Context, *connect.Request[orchestrator.UpdateApplicabilityRuleRequest]) (*connect.Response[orchestrator.ApplicabilityRule], error)
+	// Lists the applicability rules of a catalog
+	ListApplicabilityRules(context.Context, *connect.Request[orchestrator.ListApplicabilityRulesRequest]) (*connect.Response[orchestrator.ListApplicabilityRulesResponse], error)
+	// Removes the applicability rule of a control of a catalog. Published
+	// catalogs cannot be modified.
+	RemoveApplicabilityRule(context.Context, *connect.Request[orchestrator.RemoveApplicabilityRuleRequest]) (*connect.Response[emptypb.Empty], error)
+	// Creates an upstream source of catalogs. The catalogs of the source are
+	// re-imported periodically, whenever its content changes.
+	CreateCatalogSource(context.Context, *connect.Request[orchestrator.CreateCatalogSourceRequest]) (*connect.Response[orchestrator.CatalogSource], error)
+	// Lists all catalog sources
+	ListCatalogSources(context.Context, *connect.Request[orchestrator.ListCatalogSourcesRequest]) (*connect.Response[orchestrator.ListCatalogSourcesResponse], error)
+	// Removes a catalog source. The catalogs imported from it are kept.
+	RemoveCatalogSource(context.Context, *connect.Request[orchestrator.RemoveCatalogSourceRequest]) (*connect.Response[emptypb.Empty], error)
+	// Re-imports the catalogs of a catalog source immediately instead of
+	// waiting for its next scheduled sync. The response contains a report of the
+	// changes made to the catalogs.
+	SyncCatalogSource(context.Context, *connect.Request[orchestrator.SyncCatalogSourceRequest]) (*connect.Response[orchestrator.CatalogSyncReport], error)
 	// Retrieves a category of a catalog specified by the catalog ID and the
 	// category name. It includes the first level of controls within each
 	// category.
@@ -337,6 +753,14 @@ type OrchestratorClient interface {
 	// If present, it also includes a list of sub-controls if present or a list of
 	// metrics if no sub-controls but metrics are present.
 	GetControl(context.Context, *connect.Request[orchestrator.GetControlRequest]) (*connect.Response[orchestrator.Control], error)
+	// Lists the versions of the text of a control, i.e., of its name,
+	// description and references, by default starting with the latest one.
+	// Part of the public API, also exposed as REST.
+	ListControlTextVersions(context.Context, *connect.Request[orchestrator.ListControlTextVersionsRequest]) (*connect.Response[orchestrator.ListControlTextVersionsResponse], error)
+	// Renders the text of a control, i.e., its Markdown description and its
+	// references, to HTML. By default, the current text is rendered. Part of the
+	// public API, also exposed as REST.
+	RenderControlText(context.Context, *connect.Request[orchestrator.RenderControlTextRequest]) (*connect.Response[orchestrator.RenderControlTextResponse], error)
 	// Creates a new Audit Scope
 	CreateAuditScope(context.Context, *connect.Request[orchestrator.CreateAuditScopeRequest]) (*connect.Response[orchestrator.AuditScope], error)
 	// Retrieves an Audit Scope
@@ -347,6 +771,37 @@ type OrchestratorClient interface {
 	UpdateAuditScope(context.Context, *connect.Request[orchestrator.UpdateAuditScopeRequest]) (*connect.Response[orchestrator.AuditScope], error)
 	// Removes an Audit Scope
 	RemoveAuditScope(context.Context, *connect.Request[orchestrator.RemoveAuditScopeRequest]) (*connect.Response[emptypb.Empty], error)
+	// Creates a maintenance window for an Audit Scope. During the window,
+	// evaluation runs are either suppressed or their results are flagged as
+	// "during maintenance". Part of the public API, also exposed as REST.
+	CreateMaintenanceWindow(context.Context, *connect.Request[orchestrator.CreateMaintenanceWindowRequest]) (*connect.Response[orchestrator.MaintenanceWindow], error)
+	// Lists the maintenance windows of an Audit Scope, by default ordered by
+	// their start. Part of the public API, also exposed as REST.
+	ListMaintenanceWindows(context.Context, *connect.Request[orchestrator.ListMaintenanceWindowsRequest]) (*connect.Response[orchestrator.ListMaintenanceWindowsResponse], error)
+	// Removes a maintenance window. Part of the public API, also exposed as
+	// REST.
+	RemoveMaintenanceWindow(context.Context, *connect.Request[orchestrator.RemoveMaintenanceWindowRequest]) (*connect.Response[emptypb.Empty], error)
+	// Exports the assessment and evaluation results of an Audit Scope in the
+	// OSCAL Assessment Results model (JSON). Part of the public API, also exposed
+	// as REST.
+	ExportOSCAL(context.Context, *connect.Request[orchestrator.ExportOSCALRequest]) (*connect.Response[orchestrator.ExportOSCALResponse], error)
+	// Archives the evaluation results of an Audit Scope, together with the IDs
+	// of the assessment results they are based on, as a tar archive of NDJSON
+	// files. The archive can be restored with RestoreAuditScopeData, e.g., to
+	// hand an audit over to another deployment. Part of the public API, also
+	// exposed as REST.
+	ArchiveAuditScopeData(context.Context, *connect.Request[orchestrator.ArchiveAuditScopeDataRequest]) (*connect.Response[orchestrator.ArchiveAuditScopeDataResponse], error)
+	// Restores the evaluation results of an archive created by
+	// ArchiveAuditScopeData into an Audit Scope. The Audit Scope must cover the
+	// catalogs of the evaluation results. Evaluation results that already exist
+	// are skipped, so that an archive can be restored more than once. Part of
+	// the public API, also exposed as REST.
+	RestoreAuditScopeData(context.Context, *connect.Request[orchestrator.RestoreAuditScopeDataRequest]) (*connect.Response[orchestrator.RestoreAuditScopeDataResponse], error)
+	// Exports the persisted schema of the orchestrator, i.e., its entities with
+	// their tables and columns and the relationships between them, so that
+	// external reporting tools can join the tables. Part of the public API, also
+	// exposed as REST.
+	GetSchema(context.Context, *connect.Request[orchestrator.GetSchemaRequest]) (*connect.Response[orchestrator.Schema], error)
 	// Get Runtime Information
 	GetRuntimeInfo(context.Context, *connect.Request[common.GetRuntimeInfoRequest]) (*connect.Response[common.Runtime], error)
 	// Upserts a specific user permission identified by object and user.
@@ -359,6 +814,13 @@ type OrchestratorClient interface {
 	GetUser(context.Context, *connect.Request[orchestrator.GetUserRequest]) (*connect.Response[orchestrator.User], error)
 	// Lists users with optional filtering
 	ListUsers(context.Context, *connect.Request[orchestrator.ListUsersRequest]) (*connect.Response[orchestrator.ListUsersResponse], error)
+	// Resolves the local user that belongs to an external identity, i.e., the subject of an
+	// identity provider or the identifier of a user in an external user directory.
+	ResolveUser(context.Context, *connect.Request[orchestrator.ResolveUserRequest]) (*connect.Response[orchestrator.User], error)
+	// Synchronizes the users of the configured user directory (e.g., a SCIM provider) with the local
+	// users. Users that no longer exist in the directory are disabled. This endpoint is restricted to
+	// admins.
+	SyncUsers(context.Context, *connect.Request[orchestrator.SyncUsersRequest]) (*connect.Response[orchestrator.SyncUsersResponse], error)
 	// Lists user permissions, optionally filtered by object type, object ID, and/or user ID.
 	ListUserPermissions(context.Context, *connect.Request[orchestrator.ListUserPermissionsRequest]) (*connect.Response[orchestrator.ListUserPermissionsResponse], error)
 	// Lists all predefined roles in the system.
@@ -437,12 +899,36 @@ func NewOrchestratorClient(httpClient connect.HTTPClient, baseURL string, opts .
 			connect.WithSchema(orchestratorMethods.ByName("StoreAssessmentResults")),
 			connect.WithClientOptions(opts...),
 		),
+		batchStoreAssessmentResults: connect.NewClient[orchestrator.BatchStoreAssessmentResultsRequest, orchestrator.BatchStoreAssessmentResultsResponse](
+			httpClient,
+			baseURL+OrchestratorBatchStoreAssessmentResultsProcedure,
+			connect.WithSchema(orchestratorMethods.ByName("BatchStoreAssessmentResults")),
+			connect.WithClientOptions(opts...),
+		),
 		getAssessmentResult: connect.NewClient[orchestrator.GetAssessmentResultRequest, assessment.AssessmentResult](
 			httpClient,
 			baseURL+OrchestratorGetAssessmentResultProcedure,
 			connect.WithSchema(orchestratorMethods.ByName("GetAssessmentResult")),
 			connect.WithClientOptions(opts...),
 		),
+		waiveAssessmentResult: connect.NewClient[orchestrator.WaiveAssessmentResultRequest, assessment.AssessmentResult](
+			httpClient,
+			baseURL+OrchestratorWaiveAssessmentResultProcedure,
+			connect.WithSchema(orchestratorMethods.ByName("WaiveAssessmentResult")),
+			connect.WithClientOptions(opts...),
+		),
+		revokeAssessmentResultWaiver: connect.NewClient[orchestrator.RevokeAssessmentResultWaiverRequest, assessment.AssessmentResult](
+			httpClient,
+			baseURL+OrchestratorRevokeAssessmentResultWaiverProcedure,
+			connect.WithSchema(orchestratorMethods.ByName("RevokeAssessmentResultWaiver")),
+			connect.WithClientOptions(opts...),
+		),
+		importExternalFindings: connect.NewClient[orchestrator.ImportExternalFindingsRequest, orchestrator.ImportExternalFindingsResponse](
+			httpClient,
+			baseURL+OrchestratorImportExternalFindingsProcedure,
+			connect.WithSchema(orchestratorMethods.ByName("ImportExternalFindings")),
+			connect.WithClientOptions(opts...),
+		),
 		storeEvaluationResult: connect.NewClient[orchestrator.StoreEvaluationResultRequest, evaluation.EvaluationResult](
 			httpClient,
 			baseURL+OrchestratorStoreEvaluationResultProcedure,
@@ -461,6 +947,96 @@ func NewOrchestratorClient(httpClient connect.HTTPClient, baseURL string, opts .
 			connect.WithSchema(orchestratorMethods.ByName("ListEvaluationResults")),
 			connect.WithClientOptions(opts...),
 		),
+		listSlaBreaches: connect.NewClient[orchestrator.ListSlaBreachesRequest, orchestrator.ListSlaBreachesResponse](
+			httpClient,
+			baseURL+OrchestratorListSlaBreachesProcedure,
+			connect.WithSchema(orchestratorMethods.ByName("ListSlaBreaches")),
+			connect.WithClientOptions(opts...),
+		),
+		getCompliancePosture: connect.NewClient[orchestrator.GetCompliancePostureRequest, orchestrator.CompliancePosture](
+			httpClient,
+			baseURL+OrchestratorGetCompliancePostureProcedure,
+			connect.WithSchema(orchestratorMethods.ByName("GetCompliancePosture")),
+			connect.WithClientOptions(opts...),
+		),
+		listAlerts: connect.NewClient[orchestrator.ListAlertsRequest, orchestrator.ListAlertsResponse](
+			httpClient,
+			baseURL+OrchestratorListAlertsProcedure,
+			connect.WithSchema(orchestratorMethods.ByName("ListAlerts")),
+			connect.WithClientOptions(opts...),
+		),
+		uploadAttachment: connect.NewClient[orchestrator.UploadAttachmentRequest, evaluation.Attachment](
+			httpClient,
+			baseURL+OrchestratorUploadAttachmentProcedure,
+			connect.WithSchema(orchestratorMethods.ByName("UploadAttachment")),
+			connect.WithClientOptions(opts...),
+		),
+		downloadAttachment: connect.NewClient[orchestrator.DownloadAttachmentRequest, orchestrator.DownloadAttachmentResponse](
+			httpClient,
+			baseURL+OrchestratorDownloadAttachmentProcedure,
+			connect.WithSchema(orchestratorMethods.ByName("DownloadAttachment")),
+			connect.WithClientOptions(opts...),
+		),
+		listAttachments: connect.NewClient[orchestrator.ListAttachmentsRequest, orchestrator.ListAttachmentsResponse](
+			httpClient,
+			baseURL+OrchestratorListAttachmentsProcedure,
+			connect.WithSchema(orchestratorMethods.ByName("ListAttachments")),
+			connect.WithClientOptions(opts...),
+		),
+		removeAttachment: connect.NewClient[orchestrator.RemoveAttachmentRequest, emptypb.Empty](
+			httpClient,
+			baseURL+OrchestratorRemoveAttachmentProcedure,
+			connect.WithSchema(orchestratorMethods.ByName("RemoveAttachment")),
+			connect.WithClientOptions(opts...),
+		),
+		addEvaluationResultComment: connect.NewClient[orchestrator.AddEvaluationResultCommentRequest, evaluation.Comment](
+			httpClient,
+			baseURL+OrchestratorAddEvaluationResultCommentProcedure,
+			connect.WithSchema(orchestratorMethods.ByName("AddEvaluationResultComment")),
+			connect.WithClientOptions(opts...),
+		),
+		listEvaluationResultComments: connect.NewClient[orchestrator.ListEvaluationResultCommentsRequest, orchestrator.ListEvaluationResultCommentsResponse](
+			httpClient,
+			baseURL+OrchestratorListEvaluationResultCommentsProcedure,
+			connect.WithSchema(orchestratorMethods.ByName("ListEvaluationResultComments")),
+			connect.WithClientOptions(opts...),
+		),
+		removeEvaluationResultComment: connect.NewClient[orchestrator.RemoveEvaluationResultCommentRequest, emptypb.Empty](
+			httpClient,
+			baseURL+OrchestratorRemoveEvaluationResultCommentProcedure,
+			connect.WithSchema(orchestratorMethods.ByName("RemoveEvaluationResultComment")),
+			connect.WithClientOptions(opts...),
+		),
+		createSavedView: connect.NewClient[orchestrator.CreateSavedViewRequest, orchestrator.SavedView](
+			httpClient,
+			baseURL+OrchestratorCreateSavedViewProcedure,
+			connect.WithSchema(orchestratorMethods.ByName("CreateSavedView")),
+			connect.WithClientOptions(opts...),
+		),
+		getSavedView: connect.NewClient[orchestrator.GetSavedViewRequest, orchestrator.SavedView](
+			httpClient,
+			baseURL+OrchestratorGetSavedViewProcedure,
+			connect.WithSchema(orchestratorMethods.ByName("GetSavedView")),
+			connect.WithClientOptions(opts...),
+		),
+		listSavedViews: connect.NewClient[orchestrator.ListSavedViewsRequest, orchestrator.ListSavedViewsResponse](
+			httpClient,
+			baseURL+OrchestratorListSavedViewsProcedure,
+			connect.WithSchema(orchestratorMethods.ByName("ListSavedViews")),
+			connect.WithClientOptions(opts...),
+		),
+		updateSavedView: connect.NewClient[orchestrator.UpdateSavedViewRequest, orchestrator.SavedView](
+			httpClient,
+			baseURL+OrchestratorUpdateSavedViewProcedure,
+			connect.WithSchema(orchestratorMethods.ByName("UpdateSavedView")),
+			connect.WithClientOptions(opts...),
+		),
+		removeSavedView: connect.NewClient[orchestrator.RemoveSavedViewRequest, emptypb.Empty](
+			httpClient,
+			baseURL+OrchestratorRemoveSavedViewProcedure,
+			connect.WithSchema(orchestratorMethods.ByName("RemoveSavedView")),
+			connect.WithClientOptions(opts...),
+		),
 		createMetric: connect.NewClient[orchestrator.CreateMetricRequest, assessment.Metric](
 			httpClient,
 			baseURL+OrchestratorCreateMetricProcedure,
@@ -521,12 +1097,102 @@ func NewOrchestratorClient(httpClient connect.HTTPClient, baseURL string, opts .
 			connect.WithSchema(orchestratorMethods.ByName("RemoveTargetOfEvaluation")),
 			connect.WithClientOptions(opts...),
 		),
+		mergeTargetsOfEvaluation: connect.NewClient[orchestrator.MergeTargetsOfEvaluationRequest, orchestrator.MergeTargetsOfEvaluationResponse](
+			httpClient,
+			baseURL+OrchestratorMergeTargetsOfEvaluationProcedure,
+			connect.WithSchema(orchestratorMethods.ByName("MergeTargetsOfEvaluation")),
+			connect.WithClientOptions(opts...),
+		),
 		getTargetOfEvaluationStatistics: connect.NewClient[orchestrator.GetTargetOfEvaluationStatisticsRequest, orchestrator.GetTargetOfEvaluationStatisticsResponse](
 			httpClient,
 			baseURL+OrchestratorGetTargetOfEvaluationStatisticsProcedure,
 			connect.WithSchema(orchestratorMethods.ByName("GetTargetOfEvaluationStatistics")),
 			connect.WithClientOptions(opts...),
 		),
+		getUsageReport: connect.NewClient[orchestrator.GetUsageReportRequest, orchestrator.UsageReport](
+			httpClient,
+			baseURL+OrchestratorGetUsageReportProcedure,
+			connect.WithSchema(orchestratorMethods.ByName("GetUsageReport")),
+			connect.WithClientOptions(opts...),
+		),
+		createSecret: connect.NewClient[orchestrator.CreateSecretRequest, orchestrator.Secret](
+			httpClient,
+			baseURL+OrchestratorCreateSecretProcedure,
+			connect.WithSchema(orchestratorMethods.ByName("CreateSecret")),
+			connect.WithClientOptions(opts...),
+		),
+		listSecrets: connect.NewClient[orchestrator.ListSecretsRequest, orchestrator.ListSecretsResponse](
+			httpClient,
+			baseURL+OrchestratorListSecretsProcedure,
+			connect.WithSchema(orchestratorMethods.ByName("ListSecrets")),
+			connect.WithClientOptions(opts...),
+		),
+		updateSecret: connect.NewClient[orchestrator.UpdateSecretRequest, orchestrator.Secret](
+			httpClient,
+			baseURL+OrchestratorUpdateSecretProcedure,
+			connect.WithSchema(orchestratorMethods.ByName("UpdateSecret")),
+			connect.WithClientOptions(opts...),
+		),
+		removeSecret: connect.NewClient[orchestrator.RemoveSecretRequest, emptypb.Empty](
+			httpClient,
+			baseURL+OrchestratorRemoveSecretProcedure,
+			connect.WithSchema(orchestratorMethods.ByName("RemoveSecret")),
+			connect.WithClientOptions(opts...),
+		),
+		accessSecret: connect.NewClient[orchestrator.AccessSecretRequest, orchestrator.SecretValue](
+			httpClient,
+			baseURL+OrchestratorAccessSecretProcedure,
+			connect.WithSchema(orchestratorMethods.ByName("AccessSecret")),
+			connect.WithClientOptions(opts...),
+		),
+		storeResourceInventory: connect.NewClient[orchestrator.StoreResourceInventoryRequest, orchestrator.ResourceInventory](
+			httpClient,
+			baseURL+OrchestratorStoreResourceInventoryProcedure,
+			connect.WithSchema(orchestratorMethods.ByName("StoreResourceInventory")),
+			connect.WithClientOptions(opts...),
+		),
+		listResourceInventories: connect.NewClient[orchestrator.ListResourceInventoriesRequest, orchestrator.ListResourceInventoriesResponse](
+			httpClient,
+			baseURL+OrchestratorListResourceInventoriesProcedure,
+			connect.WithSchema(orchestratorMethods.ByName("ListResourceInventories")),
+			connect.WithClientOptions(opts...),
+		),
+		updateMetadataField: connect.NewClient[orchestrator.UpdateMetadataFieldRequest, orchestrator.MetadataField](
+			httpClient,
+			baseURL+OrchestratorUpdateMetadataFieldProcedure,
+			connect.WithSchema(orchestratorMethods.ByName("UpdateMetadataField")),
+			connect.WithClientOptions(opts...),
+		),
+		listMetadataFields: connect.NewClient[orchestrator.ListMetadataFieldsRequest, orchestrator.ListMetadataFieldsResponse](
+			httpClient,
+			baseURL+OrchestratorListMetadataFieldsProcedure,
+			connect.WithSchema(orchestratorMethods.ByName("ListMetadataFields")),
+			connect.WithClientOptions(opts...),
+		),
+		removeMetadataField: connect.NewClient[orchestrator.RemoveMetadataFieldRequest, emptypb.Empty](
+			httpClient,
+			baseURL+OrchestratorRemoveMetadataFieldProcedure,
+			connect.WithSchema(orchestratorMethods.ByName("RemoveMetadataField")),
+			connect.WithClientOptions(opts...),
+		),
+		updateRedactionRule: connect.NewClient[orchestrator.UpdateRedactionRuleRequest, orchestrator.RedactionRule](
+			httpClient,
+			baseURL+OrchestratorUpdateRedactionRuleProcedure,
+			connect.WithSchema(orchestratorMethods.ByName("UpdateRedactionRule")),
+			connect.WithClientOptions(opts...),
+		),
+		listRedactionRules: connect.NewClient[orchestrator.ListRedactionRulesRequest, orchestrator.ListRedactionRulesResponse](
+			httpClient,
+			baseURL+OrchestratorListRedactionRulesProcedure,
+			connect.WithSchema(orchestratorMethods.ByName("ListRedactionRules")),
+			connect.WithClientOptions(opts...),
+		),
+		removeRedactionRule: connect.NewClient[orchestrator.RemoveRedactionRuleRequest, emptypb.Empty](
+			httpClient,
+			baseURL+OrchestratorRemoveRedactionRuleProcedure,
+			connect.WithSchema(orchestratorMethods.ByName("RemoveRedactionRule")),
+			connect.WithClientOptions(opts...),
+		),
 		updateMetricConfiguration: connect.NewClient[orchestrator.UpdateMetricConfigurationRequest, assessment.MetricConfiguration](
 			httpClient,
 			baseURL+OrchestratorUpdateMetricConfigurationProcedure,
@@ -539,12 +1205,36 @@ func NewOrchestratorClient(httpClient connect.HTTPClient, baseURL string, opts .
 			connect.WithSchema(orchestratorMethods.ByName("GetMetricConfiguration")),
 			connect.WithClientOptions(opts...),
 		),
+		resolveMetricConfiguration: connect.NewClient[orchestrator.ResolveMetricConfigurationRequest, orchestrator.ResolveMetricConfigurationResponse](
+			httpClient,
+			baseURL+OrchestratorResolveMetricConfigurationProcedure,
+			connect.WithSchema(orchestratorMethods.ByName("ResolveMetricConfiguration")),
+			connect.WithClientOptions(opts...),
+		),
 		listMetricConfigurations: connect.NewClient[orchestrator.ListMetricConfigurationRequest, orchestrator.ListMetricConfigurationResponse](
 			httpClient,
 			baseURL+OrchestratorListMetricConfigurationsProcedure,
 			connect.WithSchema(orchestratorMethods.ByName("ListMetricConfigurations")),
 			connect.WithClientOptions(opts...),
 		),
+		updateCatalogMetricConfiguration: connect.NewClient[orchestrator.UpdateCatalogMetricConfigurationRequest, assessment.CatalogMetricConfiguration](
+			httpClient,
+			baseURL+OrchestratorUpdateCatalogMetricConfigurationProcedure,
+			connect.WithSchema(orchestratorMethods.ByName("UpdateCatalogMetricConfiguration")),
+			connect.WithClientOptions(opts...),
+		),
+		listCatalogMetricConfigurations: connect.NewClient[orchestrator.ListCatalogMetricConfigurationsRequest, orchestrator.ListCatalogMetricConfigurationsResponse](
+			httpClient,
+			baseURL+OrchestratorListCatalogMetricConfigurationsProcedure,
+			connect.WithSchema(orchestratorMethods.ByName("ListCatalogMetricConfigurations")),
+			connect.WithClientOptions(opts...),
+		),
+		removeCatalogMetricConfiguration: connect.NewClient[orchestrator.RemoveCatalogMetricConfigurationRequest, emptypb.Empty](
+			httpClient,
+			baseURL+OrchestratorRemoveCatalogMetricConfigurationProcedure,
+			connect.WithSchema(orchestratorMethods.ByName("RemoveCatalogMetricConfiguration")),
+			connect.WithClientOptions(opts...),
+		),
 		updateMetricImplementation: connect.NewClient[orchestrator.UpdateMetricImplementationRequest, assessment.MetricImplementation](
 			httpClient,
 			baseURL+OrchestratorUpdateMetricImplementationProcedure,
@@ -563,6 +1253,30 @@ func NewOrchestratorClient(httpClient connect.HTTPClient, baseURL string, opts .
 			connect.WithSchema(orchestratorMethods.ByName("Subscribe")),
 			connect.WithClientOptions(opts...),
 		),
+		createWebhook: connect.NewClient[orchestrator.CreateWebhookRequest, orchestrator.Webhook](
+			httpClient,
+			baseURL+OrchestratorCreateWebhookProcedure,
+			connect.WithSchema(orchestratorMethods.ByName("CreateWebhook")),
+			connect.WithClientOptions(opts...),
+		),
+		listWebhooks: connect.NewClient[orchestrator.ListWebhooksRequest, orchestrator.ListWebhooksResponse](
+			httpClient,
+			baseURL+OrchestratorListWebhooksProcedure,
+			connect.WithSchema(orchestratorMethods.ByName("ListWebhooks")),
+			connect.WithClientOptions(opts...),
+		),
+		removeWebhook: connect.NewClient[orchestrator.RemoveWebhookRequest, emptypb.Empty](
+			httpClient,
+			baseURL+OrchestratorRemoveWebhookProcedure,
+			connect.WithSchema(orchestratorMethods.ByName("RemoveWebhook")),
+			connect.WithClientOptions(opts...),
+		),
+		listWebhookDeliveries: connect.NewClient[orchestrator.ListWebhookDeliveriesRequest, orchestrator.ListWebhookDeliveriesResponse](
+			httpClient,
+			baseURL+OrchestratorListWebhookDeliveriesProcedure,
+			connect.WithSchema(orchestratorMethods.ByName("ListWebhookDeliveries")),
+			connect.WithClientOptions(opts...),
+		),
 		createCertificate: connect.NewClient[orchestrator.CreateCertificateRequest, orchestrator.Certificate](
 			httpClient,
 			baseURL+OrchestratorCreateCertificateProcedure,
@@ -617,6 +1331,12 @@ func NewOrchestratorClient(httpClient connect.HTTPClient, baseURL string, opts .
 			connect.WithSchema(orchestratorMethods.ByName("GetCatalog")),
 			connect.WithClientOptions(opts...),
 		),
+		getCatalogTree: connect.NewClient[orchestrator.GetCatalogTreeRequest, orchestrator.GetCatalogTreeResponse](
+			httpClient,
+			baseURL+OrchestratorGetCatalogTreeProcedure,
+			connect.WithSchema(orchestratorMethods.ByName("GetCatalogTree")),
+			connect.WithClientOptions(opts...),
+		),
 		removeCatalog: connect.NewClient[orchestrator.RemoveCatalogRequest, emptypb.Empty](
 			httpClient,
 			baseURL+OrchestratorRemoveCatalogProcedure,
@@ -629,6 +1349,96 @@ func NewOrchestratorClient(httpClient connect.HTTPClient, baseURL string, opts .
 			connect.WithSchema(orchestratorMethods.ByName("UpdateCatalog")),
 			connect.WithClientOptions(opts...),
 		),
+		publishCatalog: connect.NewClient[orchestrator.PublishCatalogRequest, orchestrator.Catalog](
+			httpClient,
+			baseURL+OrchestratorPublishCatalogProcedure,
+			connect.WithSchema(orchestratorMethods.ByName("PublishCatalog")),
+			connect.WithClientOptions(opts...),
+		),
+		discardCatalogDraft: connect.NewClient[orchestrator.DiscardCatalogDraftRequest, orchestrator.Catalog](
+			httpClient,
+			baseURL+OrchestratorDiscardCatalogDraftProcedure,
+			connect.WithSchema(orchestratorMethods.ByName("DiscardCatalogDraft")),
+			connect.WithClientOptions(opts...),
+		),
+		listCatalogChangelogs: connect.NewClient[orchestrator.ListCatalogChangelogsRequest, orchestrator.ListCatalogChangelogsResponse](
+			httpClient,
+			baseURL+OrchestratorListCatalogChangelogsProcedure,
+			connect.WithSchema(orchestratorMethods.ByName("ListCatalogChangelogs")),
+			connect.WithClientOptions(opts...),
+		),
+		getCatalogChangelog: connect.NewClient[orchestrator.GetCatalogChangelogRequest, orchestrator.CatalogChangelog](
+			httpClient,
+			baseURL+OrchestratorGetCatalogChangelogProcedure,
+			connect.WithSchema(orchestratorMethods.ByName("GetCatalogChangelog")),
+			connect.WithClientOptions(opts...),
+		),
+		importControlMetricMapping: connect.NewClient[orchestrator.ImportControlMetricMappingRequest, orchestrator.ImportControlMetricMappingResponse](
+			httpClient,
+			baseURL+OrchestratorImportControlMetricMappingProcedure,
+			connect.WithSchema(orchestratorMethods.ByName("ImportControlMetricMapping")),
+			connect.WithClientOptions(opts...),
+		),
+		updateAssuranceLevel: connect.NewClient[orchestrator.UpdateAssuranceLevelRequest, orchestrator.AssuranceLevel](
+			httpClient,
+			baseURL+OrchestratorUpdateAssuranceLevelProcedure,
+			connect.WithSchema(orchestratorMethods.ByName("UpdateAssuranceLevel")),
+			connect.WithClientOptions(opts...),
+		),
+		listAssuranceLevels: connect.NewClient[orchestrator.ListAssuranceLevelsRequest, orchestrator.ListAssuranceLevelsResponse](
+			httpClient,
+			baseURL+OrchestratorListAssuranceLevelsProcedure,
+			connect.WithSchema(orchestratorMethods.ByName("ListAssuranceLevels")),
+			connect.WithClientOptions(opts...),
+		),
+		removeAssuranceLevel: connect.NewClient[orchestrator.RemoveAssuranceLevelRequest, emptypb.Empty](
+			httpClient,
+			baseURL+OrchestratorRemoveAssuranceLevelProcedure,
+			connect.WithSchema(orchestratorMethods.ByName("RemoveAssuranceLevel")),
+			connect.WithClientOptions(opts...),
+		),
+		updateApplicabilityRule: connect.NewClient[orchestrator.UpdateApplicabilityRuleRequest, orchestrator.ApplicabilityRule](
+			httpClient,
+			baseURL+OrchestratorUpdateApplicabilityRuleProcedure,
+			connect.WithSchema(orchestratorMethods.ByName("UpdateApplicabilityRule")),
+			connect.WithClientOptions(opts...),
+		),
+		listApplicabilityRules: connect.NewClient[orchestrator.ListApplicabilityRulesRequest, orchestrator.ListApplicabilityRulesResponse](
+			httpClient,
+			baseURL+OrchestratorListApplicabilityRulesProcedure,
+			connect.WithSchema(orchestratorMethods.ByName("ListApplicabilityRules")),
+			connect.WithClientOptions(opts...),
+		),
+		removeApplicabilityRule: connect.NewClient[orchestrator.RemoveApplicabilityRuleRequest, emptypb.Empty](
+			httpClient,
+			baseURL+OrchestratorRemoveApplicabilityRuleProcedure,
+			connect.WithSchema(orchestratorMethods.ByName("RemoveApplicabilityRule")),
+			connect.WithClientOptions(opts...),
+		),
+		createCatalogSource: connect.NewClient[orchestrator.CreateCatalogSourceRequest, orchestrator.CatalogSource](
+			httpClient,
+			baseURL+OrchestratorCreateCatalogSourceProcedure,
+			connect.WithSchema(orchestratorMethods.ByName("CreateCatalogSource")),
+			connect.WithClientOptions(opts...),
+		),
+		listCatalogSources: connect.NewClient[orchestrator.ListCatalogSourcesRequest, orchestrator.ListCatalogSourcesResponse](
+			httpClient,
+			baseURL+OrchestratorListCatalogSourcesProcedure,
+			connect.WithSchema(orchestratorMethods.ByName("ListCatalogSources")),
+			connect.WithClientOptions(opts...),
+		),
+		removeCatalogSource: connect.NewClient[orchestrator.RemoveCatalogSourceRequest, emptypb.Empty](
+			httpClient,
+			baseURL+OrchestratorRemoveCatalogSourceProcedure,
+			connect.WithSchema(orchestratorMethods.ByName("RemoveCatalogSource")),
+			connect.WithClientOptions(opts...),
+		),
+		syncCatalogSource: connect.NewClient[orchestrator.SyncCatalogSourceRequest, orchestrator.CatalogSyncReport](
+			httpClient,
+			baseURL+OrchestratorSyncCatalogSourceProcedure,
+			connect.WithSchema(orchestratorMethods.ByName("SyncCatalogSource")),
+			connect.WithClientOptions(opts...),
+		),
 		getCategory: connect.NewClient[orchestrator.GetCategoryRequest, orchestrator.Category](
 			httpClient,
 			baseURL+OrchestratorGetCategoryProcedure,
@@ -647,6 +1457,18 @@ func NewOrchestratorClient(httpClient connect.HTTPClient, baseURL string, opts .
 			connect.WithSchema(orchestratorMethods.ByName("GetControl")),
 			connect.WithClientOptions(opts...),
 		),
+		listControlTextVersions: connect.NewClient[orchestrator.ListControlTextVersionsRequest, orchestrator.ListControlTextVersionsResponse](
+			httpClient,
+			baseURL+OrchestratorListControlTextVersionsProcedure,
+			connect.WithSchema(orchestratorMethods.ByName("ListControlTextVersions")),
+			connect.WithClientOptions(opts...),
+		),
+		renderControlText: connect.NewClient[orchestrator.RenderControlTextRequest, orchestrator.RenderControlTextResponse](
+			httpClient,
+			baseURL+OrchestratorRenderControlTextProcedure,
+			connect.WithSchema(orchestratorMethods.ByName("RenderControlText")),
+			connect.WithClientOptions(opts...),
+		),
 		createAuditScope: connect.NewClient[orchestrator.CreateAuditScopeRequest, orchestrator.AuditScope](
 			httpClient,
 			baseURL+OrchestratorCreateAuditScopeProcedure,
@@ -677,6 +1499,48 @@ func NewOrchestratorClient(httpClient connect.HTTPClient, baseURL string, opts .
 			connect.WithSchema(orchestratorMethods.ByName("RemoveAuditScope")),
 			connect.WithClientOptions(opts...),
 		),
+		createMaintenanceWindow: connect.NewClient[orchestrator.CreateMaintenanceWindowRequest, orchestrator.MaintenanceWindow](
+			httpClient,
+			baseURL+OrchestratorCreateMaintenanceWindowProcedure,
+			connect.WithSchema(orchestratorMethods.ByName("CreateMaintenanceWindow")),
+			connect.WithClientOptions(opts...),
+		),
+		listMaintenanceWindows: connect.NewClient[orchestrator.ListMaintenanceWindowsRequest, orchestrator.ListMaintenanceWindowsResponse](
+			httpClient,
+			baseURL+OrchestratorListMaintenanceWindowsProcedure,
+			connect.WithSchema(orchestratorMethods.ByName("ListMaintenanceWindows")),
+			connect.WithClientOptions(opts...),
+		),
+		removeMaintenanceWindow: connect.NewClient[orchestrator.RemoveMaintenanceWindowRequest, emptypb.Empty](
+			httpClient,
+			baseURL+OrchestratorRemoveMaintenanceWindowProcedure,
+			connect.WithSchema(orchestratorMethods.ByName("RemoveMaintenanceWindow")),
+			connect.WithClientOptions(opts...),
+		),
+		exportOSCAL: connect.NewClient[orchestrator.ExportOSCALRequest, orchestrator.ExportOSCALResponse](
+			httpClient,
+			baseURL+OrchestratorExportOSCALProcedure,
+			connect.WithSchema(orchestratorMethods.ByName("ExportOSCAL")),
+			connect.WithClientOptions(opts...),
+		),
+		archiveAuditScopeData: connect.NewClient[orchestrator.ArchiveAuditScopeDataRequest, orchestrator.ArchiveAuditScopeDataResponse](
+			httpClient,
+			baseURL+OrchestratorArchiveAuditScopeDataProcedure,
+			connect.WithSchema(orchestratorMethods.ByName("ArchiveAuditScopeData")),
+			connect.WithClientOptions(opts...),
+		),
+		restoreAuditScopeData: connect.NewClient[orchestrator.RestoreAuditScopeDataRequest, orchestrator.RestoreAuditScopeDataResponse](
+			httpClient,
+			baseURL+OrchestratorRestoreAuditScopeDataProcedure,
+			connect.WithSchema(orchestratorMethods.ByName("RestoreAuditScopeData")),
+			connect.WithClientOptions(opts...),
+		),
+		getSchema: connect.NewClient[orchestrator.GetSchemaRequest, orchestrator.Schema](
+			httpClient,
+			baseURL+OrchestratorGetSchemaProcedure,
+			connect.WithSchema(orchestratorMethods.ByName("GetSchema")),
+			connect.WithClientOptions(opts...),
+		),
 		getRuntimeInfo: connect.NewClient[common.GetRuntimeInfoRequest, common.Runtime](
 			httpClient,
 			baseURL+OrchestratorGetRuntimeInfoProcedure,
@@ -713,6 +1577,18 @@ func NewOrchestratorClient(httpClient connect.HTTPClient, baseURL string, opts .
 			connect.WithSchema(orchestratorMethods.ByName("ListUsers")),
 			connect.WithClientOptions(opts...),
 		),
+		resolveUser: connect.NewClient[orchestrator.ResolveUserRequest, orchestrator.User](
+			httpClient,
+			baseURL+OrchestratorResolveUserProcedure,
+			connect.WithSchema(orchestratorMethods.ByName("ResolveUser")),
+			connect.WithClientOptions(opts...),
+		),
+		syncUsers: connect.NewClient[orchestrator.SyncUsersRequest, orchestrator.SyncUsersResponse](
+			httpClient,
+			baseURL+OrchestratorSyncUsersProcedure,
+			connect.WithSchema(orchestratorMethods.ByName("SyncUsers")),
+			connect.WithClientOptions(opts...),
+		),
 		listUserPermissions: connect.NewClient[orchestrator.ListUserPermissionsRequest, orchestrator.ListUserPermissionsResponse](
 			httpClient,
 			baseURL+OrchestratorListUserPermissionsProcedure,
@@ -778,69 +1654,138 @@ func NewOrchestratorClient(httpClient connect.HTTPClient, baseURL string, opts .
 
 // orchestratorClient implements OrchestratorClient.
 type orchestratorClient struct {
-	registerAssessmentTool          *connect.Client[orchestrator.RegisterAssessmentToolRequest, orchestrator.AssessmentTool]
-	listAssessmentTools             *connect.Client[orchestrator.ListAssessmentToolsRequest, orchestrator.ListAssessmentToolsResponse]
-	getAssessmentTool               *connect.Client[orchestrator.GetAssessmentToolRequest, orchestrator.AssessmentTool]
-	updateAssessmentTool            *connect.Client[orchestrator.UpdateAssessmentToolRequest, orchestrator.AssessmentTool]
-	deregisterAssessmentTool        *connect.Client[orchestrator.DeregisterAssessmentToolRequest, emptypb.Empty]
-	storeAssessmentResult           *connect.Client[orchestrator.StoreAssessmentResultRequest, orchestrator.StoreAssessmentResultResponse]
-	storeAssessmentResults          *connect.Client[orchestrator.StoreAssessmentResultRequest, orchestrator.StoreAssessmentResultsResponse]
-	getAssessmentResult             *connect.Client[orchestrator.GetAssessmentResultRequest, assessment.AssessmentResult]
-	storeEvaluationResult           *connect.Client[orchestrator.StoreEvaluationResultRequest, evaluation.EvaluationResult]
-	listAssessmentResults           *connect.Client[orchestrator.ListAssessmentResultsRequest, orchestrator.ListAssessmentResultsResponse]
-	listEvaluationResults           *connect.Client[orchestrator.ListEvaluationResultsRequest, orchestrator.ListEvaluationResultsResponse]
-	createMetric                    *connect.Client[orchestrator.CreateMetricRequest, assessment.Metric]
-	updateMetric                    *connect.Client[orchestrator.UpdateMetricRequest, assessment.Metric]
-	getMetric                       *connect.Client[orchestrator.GetMetricRequest, assessment.Metric]
-	listMetrics                     *connect.Client[orchestrator.ListMetricsRequest, orchestrator.ListMetricsResponse]
-	removeMetric                    *connect.Client[orchestrator.RemoveMetricRequest, emptypb.Empty]
-	createTargetOfEvaluation        *connect.Client[orchestrator.CreateTargetOfEvaluationRequest, orchestrator.TargetOfEvaluation]
-	updateTargetOfEvaluation        *connect.Client[orchestrator.UpdateTargetOfEvaluationRequest, orchestrator.TargetOfEvaluation]
-	getTargetOfEvaluation           *connect.Client[orchestrator.GetTargetOfEvaluationRequest, orchestrator.TargetOfEvaluation]
-	listTargetsOfEvaluation         *connect.Client[orchestrator.ListTargetsOfEvaluationRequest, orchestrator.ListTargetsOfEvaluationResponse]
-	removeTargetOfEvaluation        *connect.Client[orchestrator.RemoveTargetOfEvaluationRequest, emptypb.Empty]
-	getTargetOfEvaluationStatistics *connect.Client[orchestrator.GetTargetOfEvaluationStatisticsRequest, orchestrator.GetTargetOfEvaluationStatisticsResponse]
-	updateMetricConfiguration       *connect.Client[orchestrator.UpdateMetricConfigurationRequest, assessment.MetricConfiguration]
-	getMetricConfiguration          *connect.Client[orchestrator.GetMetricConfigurationRequest, assessment.MetricConfiguration]
-	listMetricConfigurations        *connect.Client[orchestrator.ListMetricConfigurationRequest, orchestrator.ListMetricConfigurationResponse]
-	updateMetricImplementation      *connect.Client[orchestrator.UpdateMetricImplementationRequest, assessment.MetricImplementation]
-	getMetricImplementation         *connect.Client[orchestrator.GetMetricImplementationRequest, assessment.MetricImplementation]
-	subscribe                       *connect.Client[orchestrator.SubscribeRequest, orchestrator.ChangeEvent]
-	createCertificate               *connect.Client[orchestrator.CreateCertificateRequest, orchestrator.Certificate]
-	getCertificate                  *connect.Client[orchestrator.GetCertificateRequest, orchestrator.Certificate]
-	listCertificates                *connect.Client[orchestrator.ListCertificatesRequest, orchestrator.ListCertificatesResponse]
-	listPublicCertificates          *connect.Client[orchestrator.ListPublicCertificatesRequest, orchestrator.ListPublicCertificatesResponse]
-	updateCertificate               *connect.Client[orchestrator.UpdateCertificateRequest, orchestrator.Certificate]
-	removeCertificate               *connect.Client[orchestrator.RemoveCertificateRequest, emptypb.Empty]
-	createCatalog                   *connect.Client[orchestrator.CreateCatalogRequest, orchestrator.Catalog]
-	listCatalogs                    *connect.Client[orchestrator.ListCatalogsRequest, orchestrator.ListCatalogsResponse]
-	getCatalog                      *connect.Client[orchestrator.GetCatalogRequest, orchestrator.Catalog]
-	removeCatalog                   *connect.Client[orchestrator.RemoveCatalogRequest, emptypb.Empty]
-	updateCatalog                   *connect.Client[orchestrator.UpdateCatalogRequest, orchestrator.Catalog]
-	getCategory                     *connect.Client[orchestrator.GetCategoryRequest, orchestrator.Category]
-	listControls                    *connect.Client[orchestrator.ListControlsRequest, orchestrator.ListControlsResponse]
-	getControl                      *connect.Client[orchestrator.GetControlRequest, orchestrator.Control]
-	createAuditScope                *connect.Client[orchestrator.CreateAuditScopeRequest, orchestrator.AuditScope]
-	getAuditScope                   *connect.Client[orchestrator.GetAuditScopeRequest, orchestrator.AuditScope]
-	listAuditScopes                 *connect.Client[orchestrator.ListAuditScopesRequest, orchestrator.ListAuditScopesResponse]
-	updateAuditScope                *connect.Client[orchestrator.UpdateAuditScopeRequest, orchestrator.AuditScope]
-	removeAuditScope                *connect.Client[orchestrator.RemoveAuditScopeRequest, emptypb.Empty]
-	getRuntimeInfo                  *connect.Client[common.GetRuntimeInfoRequest, common.Runtime]
-	upsertUserPermission            *connect.Client[orchestrator.UpsertUserPermissionRequest, orchestrator.UpsertUserPermissionResponse]
-	removeUserPermission            *connect.Client[orchestrator.RemoveUserPermissionRequest, emptypb.Empty]
-	getCurrentUser                  *connect.Client[orchestrator.GetCurrentUserRequest, orchestrator.User]
-	getUser                         *connect.Client[orchestrator.GetUserRequest, orchestrator.User]
-	listUsers                       *connect.Client[orchestrator.ListUsersRequest, orchestrator.ListUsersResponse]
-	listUserPermissions             *connect.Client[orchestrator.ListUserPermissionsRequest, orchestrator.ListUserPermissionsResponse]
-	listUserRoles                   *connect.Client[orchestrator.ListUserRolesRequest, orchestrator.ListUserRolesResponse]
-	removeUser                      *connect.Client[orchestrator.RemoveUserRequest, emptypb.Empty]
-	createControlInScope            *connect.Client[orchestrator.CreateControlInScopeRequest, orchestrator.ControlInScope]
-	getControlInScope               *connect.Client[orchestrator.GetControlInScopeRequest, orchestrator.ControlInScope]
-	listControlsInScope             *connect.Client[orchestrator.ListControlsInScopeRequest, orchestrator.ListControlsInScopeResponse]
-	updateControlInScope            *connect.Client[orchestrator.UpdateControlInScopeRequest, orchestrator.ControlInScope]
-	transitionControlInScopeState   *connect.Client[orchestrator.TransitionControlInScopeStateRequest, orchestrator.ControlInScope]
-	removeControlInScope            *connect.Client[orchestrator.RemoveControlInScopeRequest, emptypb.Empty]
-	listAuditTrailEvents            *connect.Client[orchestrator.ListAuditTrailEventsRequest, orchestrator.ListAuditTrailEventsResponse]
+	registerAssessmentTool           *connect.Client[orchestrator.RegisterAssessmentToolRequest, orchestrator.AssessmentTool]
+	listAssessmentTools              *connect.Client[orchestrator.ListAssessmentToolsRequest, orchestrator.ListAssessmentToolsResponse]
+	getAssessmentTool                *connect.Client[orchestrator.GetAssessmentToolRequest, orchestrator.AssessmentTool]
+	updateAssessmentTool             *connect.Client[orchestrator.UpdateAssessmentToolRequest, orchestrator.AssessmentTool]
+	deregisterAssessmentTool         *connect.Client[orchestrator.DeregisterAssessmentToolRequest, emptypb.Empty]
+	storeAssessmentResult            *connect.Client[orchestrator.StoreAssessmentResultRequest, orchestrator.StoreAssessmentResultResponse]
+	storeAssessmentResults           *connect.Client[orchestrator.StoreAssessmentResultRequest, orchestrator.StoreAssessmentResultsResponse]
+	batchStoreAssessmentResults      *connect.Client[orchestrator.BatchStoreAssessmentResultsRequest, orchestrator.BatchStoreAssessmentResultsResponse]
+	getAssessmentResult              *connect.Client[orchestrator.GetAssessmentResultRequest, assessment.AssessmentResult]
+	waiveAssessmentResult            *connect.Client[orchestrator.WaiveAssessmentResultRequest, assessment.AssessmentResult]
+	revokeAssessmentResultWaiver     *connect.Client[orchestrator.RevokeAssessmentResultWaiverRequest, assessment.AssessmentResult]
+	importExternalFindings           *connect.Client[orchestrator.ImportExternalFindingsRequest, orchestrator.ImportExternalFindingsResponse]
+	storeEvaluationResult            *connect.Client[orchestrator.StoreEvaluationResultRequest, evaluation.EvaluationResult]
+	listAssessmentResults            *connect.Client[orchestrator.ListAssessmentResultsRequest, orchestrator.ListAssessmentResultsResponse]
+	listEvaluationResults            *connect.Client[orchestrator.ListEvaluationResultsRequest, orchestrator.ListEvaluationResultsResponse]
+	listSlaBreaches                  *connect.Client[orchestrator.ListSlaBreachesRequest, orchestrator.ListSlaBreachesResponse]
+	getCompliancePosture             *connect.Client[orchestrator.GetCompliancePostureRequest, orchestrator.CompliancePosture]
+	listAlerts                       *connect.Client[orchestrator.ListAlertsRequest, orchestrator.ListAlertsResponse]
+	uploadAttachment                 *connect.Client[orchestrator.UploadAttachmentRequest, evaluation.Attachment]
+	downloadAttachment               *connect.Client[orchestrator.DownloadAttachmentRequest, orchestrator.DownloadAttachmentResponse]
+	listAttachments                  *connect.Client[orchestrator.ListAttachmentsRequest, orchestrator.ListAttachmentsResponse]
+	removeAttachment                 *connect.Client[orchestrator.RemoveAttachmentRequest, emptypb.Empty]
+	addEvaluationResultComment       *connect.Client[orchestrator.AddEvaluationResultCommentRequest, evaluation.Comment]
+	listEvaluationResultComments     *connect.Client[orchestrator.ListEvaluationResultCommentsRequest, orchestrator.ListEvaluationResultCommentsResponse]
+	removeEvaluationResultComment    *connect.Client[orchestrator.RemoveEvaluationResultCommentRequest, emptypb.Empty]
+	createSavedView                  *connect.Client[orchestrator.CreateSavedViewRequest, orchestrator.SavedView]
+	getSavedView                     *connect.Client[orchestrator.GetSavedViewRequest, orchestrator.SavedView]
+	listSavedViews                   *connect.Client[orchestrator.ListSavedViewsRequest, orchestrator.ListSavedViewsResponse]
+	updateSavedView                  *connect.Client[orchestrator.UpdateSavedViewRequest, orchestrator.SavedView]
+	removeSavedView                  *connect.Client[orchestrator.RemoveSavedViewRequest, emptypb.Empty]
+	createMetric                     *connect.Client[orchestrator.CreateMetricRequest, assessment.Metric]
+	updateMetric                     *connect.Client[orchestrator.UpdateMetricRequest, assessment.Metric]
+	getMetric                        *connect.Client[orchestrator.GetMetricRequest, assessment.Metric]
+	listMetrics                      *connect.Client[orchestrator.ListMetricsRequest, orchestrator.ListMetricsResponse]
+	removeMetric                     *connect.Client[orchestrator.RemoveMetricRequest, emptypb.Empty]
+	createTargetOfEvaluation         *connect.Client[orchestrator.CreateTargetOfEvaluationRequest, orchestrator.TargetOfEvaluation]
+	updateTargetOfEvaluation         *connect.Client[orchestrator.UpdateTargetOfEvaluationRequest, orchestrator.TargetOfEvaluation]
+	getTargetOfEvaluation            *connect.Client[orchestrator.GetTargetOfEvaluationRequest, orchestrator.TargetOfEvaluation]
+	listTargetsOfEvaluation          *connect.Client[orchestrator.ListTargetsOfEvaluationRequest, orchestrator.ListTargetsOfEvaluationResponse]
+	removeTargetOfEvaluation         *connect.Client[orchestrator.RemoveTargetOfEvaluationRequest, emptypb.Empty]
+	mergeTargetsOfEvaluation         *connect.Client[orchestrator.MergeTargetsOfEvaluationRequest, orchestrator.MergeTargetsOfEvaluationResponse]
+	getTargetOfEvaluationStatistics  *connect.Client[orchestrator.GetTargetOfEvaluationStatisticsRequest, orchestrator.GetTargetOfEvaluationStatisticsResponse]
+	getUsageReport                   *connect.Client[orchestrator.GetUsageReportRequest, orchestrator.UsageReport]
+	createSecret                     *connect.Client[orchestrator.CreateSecretRequest, orchestrator.Secret]
+	listSecrets                      *connect.Client[orchestrator.ListSecretsRequest, orchestrator.ListSecretsResponse]
+	updateSecret                     *connect.Client[orchestrator.UpdateSecretRequest, orchestrator.Secret]
+	removeSecret                     *connect.Client[orchestrator.RemoveSecretRequest, emptypb.Empty]
+	accessSecret                     *connect.Client[orchestrator.AccessSecretRequest, orchestrator.SecretValue]
+	storeResourceInventory           *connect.Client[orchestrator.StoreResourceInventoryRequest, orchestrator.ResourceInventory]
+	listResourceInventories          *connect.Client[orchestrator.ListResourceInventoriesRequest, orchestrator.ListResourceInventoriesResponse]
+	updateMetadataField              *connect.Client[orchestrator.UpdateMetadataFieldRequest, orchestrator.MetadataField]
+	listMetadataFields               *connect.Client[orchestrator.ListMetadataFieldsRequest, orchestrator.ListMetadataFieldsResponse]
+	removeMetadataField              *connect.Client[orchestrator.RemoveMetadataFieldRequest, emptypb.Empty]
+	updateRedactionRule              *connect.Client[orchestrator.UpdateRedactionRuleRequest, orchestrator.RedactionRule]
+	listRedactionRules               *connect.Client[orchestrator.ListRedactionRulesRequest, orchestrator.ListRedactionRulesResponse]
+	removeRedactionRule              *connect.Client[orchestrator.RemoveRedactionRuleRequest, emptypb.Empty]
+	updateMetricConfiguration        *connect.Client[orchestrator.UpdateMetricConfigurationRequest, assessment.MetricConfiguration]
+	getMetricConfiguration           *connect.Client[orchestrator.GetMetricConfigurationRequest, assessment.MetricConfiguration]
+	resolveMetricConfiguration       *connect.Client[orchestrator.ResolveMetricConfigurationRequest, orchestrator.ResolveMetricConfigurationResponse]
+	listMetricConfigurations         *connect.Client[orchestrator.ListMetricConfigurationRequest, orchestrator.ListMetricConfigurationResponse]
+	updateCatalogMetricConfiguration *connect.Client[orchestrator.UpdateCatalogMetricConfigurationRequest, assessment.CatalogMetricConfiguration]
+	listCatalogMetricConfigurations  *connect.Client[orchestrator.ListCatalogMetricConfigurationsRequest, orchestrator.ListCatalogMetricConfigurationsResponse]
+	removeCatalogMetricConfiguration *connect.Client[orchestrator.RemoveCatalogMetricConfigurationRequest, emptypb.Empty]
+	updateMetricImplementation       *connect.Client[orchestrator.UpdateMetricImplementationRequest, assessment.MetricImplementation]
+	getMetricImplementation          *connect.Client[orchestrator.GetMetricImplementationRequest, assessment.MetricImplementation]
+	subscribe                        *connect.Client[orchestrator.SubscribeRequest, orchestrator.ChangeEvent]
+	createWebhook                    *connect.Client[orchestrator.CreateWebhookRequest, orchestrator.Webhook]
+	listWebhooks                     *connect.Client[orchestrator.ListWebhooksRequest, orchestrator.ListWebhooksResponse]
+	removeWebhook                    *connect.Client[orchestrator.RemoveWebhookRequest, emptypb.Empty]
+	listWebhookDeliveries            *connect.Client[orchestrator.ListWebhookDeliveriesRequest, orchestrator.ListWebhookDeliveriesResponse]
+	createCertificate                *connect.Client[orchestrator.CreateCertificateRequest, orchestrator.Certificate]
+	getCertificate                   *connect.Client[orchestrator.GetCertificateRequest, orchestrator.Certificate]
+	listCertificates                 *connect.Client[orchestrator.ListCertificatesRequest, orchestrator.ListCertificatesResponse]
+	listPublicCertificates           *connect.Client[orchestrator.ListPublicCertificatesRequest, orchestrator.ListPublicCertificatesResponse]
+	updateCertificate                *connect.Client[orchestrator.UpdateCertificateRequest, orchestrator.Certificate]
+	removeCertificate                *connect.Client[orchestrator.RemoveCertificateRequest, emptypb.Empty]
+	createCatalog                    *connect.Client[orchestrator.CreateCatalogRequest, orchestrator.Catalog]
+	listCatalogs                     *connect.Client[orchestrator.ListCatalogsRequest, orchestrator.ListCatalogsResponse]
+	getCatalog                       *connect.Client[orchestrator.GetCatalogRequest, orchestrator.Catalog]
+	getCatalogTree                   *connect.Client[orchestrator.GetCatalogTreeRequest, orchestrator.GetCatalogTreeResponse]
+	removeCatalog                    *connect.Client[orchestrator.RemoveCatalogRequest, emptypb.Empty]
+	updateCatalog                    *connect.Client[orchestrator.UpdateCatalogRequest, orchestrator.Catalog]
+	publishCatalog                   *connect.Client[orchestrator.PublishCatalogRequest, orchestrator.Catalog]
+	discardCatalogDraft              *connect.Client[orchestrator.DiscardCatalogDraftRequest, orchestrator.Catalog]
+	listCatalogChangelogs            *connect.Client[orchestrator.ListCatalogChangelogsRequest, orchestrator.ListCatalogChangelogsResponse]
+	getCatalogChangelog              *connect.Client[orchestrator.GetCatalogChangelogRequest, orchestrator.CatalogChangelog]
+	importControlMetricMapping       *connect.Client[orchestrator.ImportControlMetricMappingRequest, orchestrator.ImportControlMetricMappingResponse]
+	updateAssuranceLevel             *connect.Client[orchestrator.UpdateAssuranceLevelRequest, orchestrator.AssuranceLevel]
+	listAssuranceLevels              *connect.Client[orchestrator.ListAssuranceLevelsRequest, orchestrator.ListAssuranceLevelsResponse]
+	removeAssuranceLevel             *connect.Client[orchestrator.RemoveAssuranceLevelRequest, emptypb.Empty]
+	updateApplicabilityRule          *connect.Client[orchestrator.UpdateApplicabilityRuleRequest, orchestrator.ApplicabilityRule]
+	listApplicabilityRules           *connect.Client[orchestrator.ListApplicabilityRulesRequest, orchestrator.ListApplicabilityRulesResponse]
+	removeApplicabilityRule          *connect.Client[orchestrator.RemoveApplicabilityRuleRequest, emptypb.Empty]
+	createCatalogSource              *connect.Client[orchestrator.CreateCatalogSourceRequest, orchestrator.CatalogSource]
+	listCatalogSources               *connect.Client[orchestrator.ListCatalogSourcesRequest, orchestrator.ListCatalogSourcesResponse]
+	removeCatalogSource              *connect.Client[orchestrator.RemoveCatalogSourceRequest, emptypb.Empty]
+	syncCatalogSource                *connect.Client[orchestrator.SyncCatalogSourceRequest, orchestrator.CatalogSyncReport]
+	getCategory                      *connect.Client[orchestrator.GetCategoryRequest, orchestrator.Category]
+	listControls                     *connect.Client[orchestrator.ListControlsRequest, orchestrator.ListControlsResponse]
+	getControl                       *connect.Client[orchestrator.GetControlRequest, orchestrator.Control]
+	listControlTextVersions          *connect.Client[orchestrator.ListControlTextVersionsRequest, orchestrator.ListControlTextVersionsResponse]
+	renderControlText                *connect.Client[orchestrator.RenderControlTextRequest, orchestrator.RenderControlTextResponse]
+	createAuditScope                 *connect.Client[orchestrator.CreateAuditScopeRequest, orchestrator.AuditScope]
+	getAuditScope                    *connect.Client[orchestrator.GetAuditScopeRequest, orchestrator.AuditScope]
+	listAuditScopes                  *connect.Client[orchestrator.ListAuditScopesRequest, orchestrator.ListAuditScopesResponse]
+	updateAuditScope                 *connect.Client[orchestrator.UpdateAuditScopeRequest, orchestrator.AuditScope]
+	removeAuditScope                 *connect.Client[orchestrator.RemoveAuditScopeRequest, emptypb.Empty]
+	createMaintenanceWindow          *connect.Client[orchestrator.CreateMaintenanceWindowRequest, orchestrator.MaintenanceWindow]
+	listMaintenanceWindows           *connect.Client[orchestrator.ListMaintenanceWindowsRequest, orchestrator.ListMaintenanceWindowsResponse]
+	removeMaintenanceWindow          *connect.Client[orchestrator.RemoveMaintenanceWindowRequest, emptypb.Empty]
+	exportOSCAL                      *connect.Client[orchestrator.ExportOSCALRequest, orchestrator.ExportOSCALResponse]
+	archiveAuditScopeData            *connect.Client[orchestrator.ArchiveAuditScopeDataRequest, orchestrator.ArchiveAuditScopeDataResponse]
+	restoreAuditScopeData            *connect.Client[orchestrator.RestoreAuditScopeDataRequest, orchestrator.RestoreAuditScopeDataResponse]
+	getSchema                        *connect.Client[orchestrator.GetSchemaRequest, orchestrator.Schema]
+	getRuntimeInfo                   *connect.Client[common.GetRuntimeInfoRequest, common.Runtime]
+	upsertUserPermission             *connect.Client[orchestrator.UpsertUserPermissionRequest, orchestrator.UpsertUserPermissionResponse]
+	removeUserPermission             *connect.Client[orchestrator.RemoveUserPermissionRequest, emptypb.Empty]
+	getCurrentUser                   *connect.Client[orchestrator.GetCurrentUserRequest, orchestrator.User]
+	getUser                          *connect.Client[orchestrator.GetUserRequest, orchestrator.User]
+	listUsers                        *connect.Client[orchestrator.ListUsersRequest, orchestrator.ListUsersResponse]
+	resolveUser                      *connect.Client[orchestrator.ResolveUserRequest, orchestrator.User]
+	syncUsers                        *connect.Client[orchestrator.SyncUsersRequest, orchestrator.SyncUsersResponse]
+	listUserPermissions              *connect.Client[orchestrator.ListUserPermissionsRequest, orchestrator.ListUserPermissionsResponse]
+	listUserRoles                    *connect.Client[orchestrator.ListUserRolesRequest, orchestrator.ListUserRolesResponse]
+	removeUser                       *connect.Client[orchestrator.RemoveUserRequest, emptypb.Empty]
+	createControlInScope             *connect.Client[orchestrator.CreateControlInScopeRequest, orchestrator.ControlInScope]
+	getControlInScope                *connect.Client[orchestrator.GetControlInScopeRequest, orchestrator.ControlInScope]
+	listControlsInScope              *connect.Client[orchestrator.ListControlsInScopeRequest, orchestrator.ListControlsInScopeResponse]
+	updateControlInScope             *connect.Client[orchestrator.UpdateControlInScopeRequest, orchestrator.ControlInScope]
+	transitionControlInScopeState    *connect.Client[orchestrator.TransitionControlInScopeStateRequest, orchestrator.ControlInScope]
+	removeControlInScope             *connect.Client[orchestrator.RemoveControlInScopeRequest, emptypb.Empty]
+	listAuditTrailEvents             *connect.Client[orchestrator.ListAuditTrailEventsRequest, orchestrator.ListAuditTrailEventsResponse]
 }
 
 // RegisterAssessmentTool calls confirmate.orchestrator.v1.Orchestrator.RegisterAssessmentTool.
@@ -878,11 +1823,33 @@ func (c *orchestratorClient) StoreAssessmentResults(ctx context.Context) *connec
 	return c.storeAssessmentResults.CallBidiStream(ctx)
 }
 
+// BatchStoreAssessmentResults calls
+// confirmate.orchestrator.v1.Orchestrator.BatchStoreAssessmentResults.
+func (c *orchestratorClient) BatchStoreAssessmentResults(ctx context.Context, req *connect.Request[orchestrator.BatchStoreAssessmentResultsRequest]) (*connect.Response[orchestrator.BatchStoreAssessmentResultsResponse], error) {
+	return c.batchStoreAssessmentResults.CallUnary(ctx, req)
+}
+
 // GetAssessmentResult calls confirmate.orchestrator.v1.Orchestrator.GetAssessmentResult.
 func (c *orchestratorClient) GetAssessmentResult(ctx context.Context, req *connect.Request[orchestrator.GetAssessmentResultRequest]) (*connect.Response[assessment.AssessmentResult], error) {
 	return c.getAssessmentResult.CallUnary(ctx, req)
 }
 
+// WaiveAssessmentResult calls confirmate.orchestrator.v1.Orchestrator.WaiveAssessmentResult.
+func (c *orchestratorClient) WaiveAssessmentResult(ctx context.Context, req *connect.Request[orchestrator.WaiveAssessmentResultRequest]) (*connect.Response[assessment.AssessmentResult], error) {
+	return c.waiveAssessmentResult.CallUnary(ctx, req)
+}
+
+// RevokeAssessmentResultWaiver calls
+// confirmate.orchestrator.v1.Orchestrator.RevokeAssessmentResultWaiver.
+func (c *orchestratorClient) RevokeAssessmentResultWaiver(ctx context.Context, req *connect.Request[orchestrator.RevokeAssessmentResultWaiverRequest]) (*connect.Response[assessment.AssessmentResult], error) {
+	return c.revokeAssessmentResultWaiver.CallUnary(ctx, req)
+}
+
+// ImportExternalFindings calls confirmate.orchestrator.v1.Orchestrator.ImportExternalFindings.
+func (c *orchestratorClient) ImportExternalFindings(ctx context.Context, req *connect.Request[orchestrator.ImportExternalFindingsRequest]) (*connect.Response[orchestrator.ImportExternalFindingsResponse], error) {
+	return c.importExternalFindings.CallUnary(ctx, req)
+}
+
 // StoreEvaluationResult calls confirmate.orchestrator.v1.Orchestrator.StoreEvaluationResult.
 func (c *orchestratorClient) StoreEvaluationResult(ctx context.Context, req *connect.Request[orchestrator.StoreEvaluationResultRequest]) (*connect.Response[evaluation.EvaluationResult], error) {
 	return c.storeEvaluationResult.CallUnary(ctx, req)
@@ -898,44 +1865,122 @@ func (c *orchestratorClient) ListEvaluationResults(ctx context.Context, req *con
 	return c.listEvaluationResults.CallUnary(ctx, req)
 }
 
-// CreateMetric calls confirmate.orchestrator.v1.Orchestrator.CreateMetric.
-func (c *orchestratorClient) CreateMetric(ctx context.Context, req *connect.Request[orchestrator.CreateMetricRequest]) (*connect.Response[assessment.Metric], error) {
-	return c.createMetric.CallUnary(ctx, req)
+// ListSlaBreaches calls confirmate.orchestrator.v1.Orchestrator.ListSlaBreaches.
+func (c *orchestratorClient) ListSlaBreaches(ctx context.Context, req *connect.Request[orchestrator.ListSlaBreachesRequest]) (*connect.Response[orchestrator.ListSlaBreachesResponse], error) {
+	return c.listSlaBreaches.CallUnary(ctx, req)
 }
 
-// UpdateMetric calls confirmate.orchestrator.v1.Orchestrator.UpdateMetric.
-func (c *orchestratorClient) UpdateMetric(ctx context.Context, req *connect.Request[orchestrator.UpdateMetricRequest]) (*connect.Response[assessment.Metric], error) {
-	return c.updateMetric.CallUnary(ctx, req)
+// GetCompliancePosture calls confirmate.orchestrator.v1.Orchestrator.GetCompliancePosture.
+func (c *orchestratorClient) GetCompliancePosture(ctx context.Context, req *connect.Request[orchestrator.GetCompliancePostureRequest]) (*connect.Response[orchestrator.CompliancePosture], error) {
+	return c.getCompliancePosture.CallUnary(ctx, req)
 }
 
-// GetMetric calls confirmate.orchestrator.v1.Orchestrator.GetMetric.
-func (c *orchestratorClient) GetMetric(ctx context.Context, req *connect.Request[orchestrator.GetMetricRequest]) (*connect.Response[assessment.Metric], error) {
-	return c.getMetric.CallUnary(ctx, req)
+// ListAlerts calls confirmate.orchestrator.v1.Orchestrator.ListAlerts.
+func (c *orchestratorClient) ListAlerts(ctx context.Context, req *connect.Request[orchestrator.ListAlertsRequest]) (*connect.Response[orchestrator.ListAlertsResponse], error) {
+	return c.listAlerts.CallUnary(ctx, req)
 }
 
-// ListMetrics calls confirmate.orchestrator.v1.Orchestrator.ListMetrics.
-func (c *orchestratorClient) ListMetrics(ctx context.Context, req *connect.Request[orchestrator.ListMetricsRequest]) (*connect.Response[orchestrator.ListMetricsResponse], error) {
-	return c.listMetrics.CallUnary(ctx, req)
+// UploadAttachment calls confirmate.orchestrator.v1.Orchestrator.UploadAttachment.
+func (c *orchestratorClient) UploadAttachment(ctx context.Context) *connect.ClientStreamForClient[orchestrator.UploadAttachmentRequest, evaluation.Attachment] {
+	return c.uploadAttachment.CallClientStream(ctx)
 }
 
-// RemoveMetric calls confirmate.orchestrator.v1.Orchestrator.RemoveMetric.
-func (c *orchestratorClient) RemoveMetric(ctx context.Context, req *connect.Request[orchestrator.RemoveMetricRequest]) (*connect.Response[emptypb.Empty], error) {
-	return c.removeMetric.CallUnary(ctx, req)
+// DownloadAttachment calls confirmate.orchestrator.v1.Orchestrator.DownloadAttachment.
+func (c *orchestratorClient) DownloadAttachment(ctx context.Context, req *connect.Request[orchestrator.DownloadAttachmentRequest]) (*connect.ServerStreamForClient[orchestrator.DownloadAttachmentResponse], error) {
+	return c.downloadAttachment.CallServerStream(ctx, req)
 }
 
-// CreateTargetOfEvaluation calls confirmate.orchestrator.v1.Orchestrator.CreateTargetOfEvaluation.
-func (c *orchestratorClient) CreateTargetOfEvaluation(ctx context.Context, req *connect.Request[orchestrator.CreateTargetOfEvaluationRequest]) (*connect.Response[orchestrator.TargetOfEvaluation], error) {
-	return c.createTargetOfEvaluation.CallUnary(ctx, req)
+// ListAttachments calls confirmate.orchestrator.v1.Orchestrator.ListAttachments.
+func (c *orchestratorClient) ListAttachments(ctx context.Context, req *connect.Request[orchestrator.ListAttachmentsRequest]) (*connect.Response[orchestrator.ListAttachmentsResponse], error) {
+	return c.listAttachments.CallUnary(ctx, req)
 }
 
-// UpdateTargetOfEvaluation calls confirmate.orchestrator.v1.Orchestrator.UpdateTargetOfEvaluation.
-func (c *orchestratorClient) UpdateTargetOfEvaluation(ctx context.Context, req *connect.Request[orchestrator.UpdateTargetOfEvaluationRequest]) (*connect.Response[orchestrator.TargetOfEvaluation], error) {
-	return c.updateTargetOfEvaluation.CallUnary(ctx, req)
+// RemoveAttachment calls confirmate.orchestrator.v1.Orchestrator.RemoveAttachment.
+func (c *orchestratorClient) RemoveAttachment(ctx context.Context, req *connect.Request[orchestrator.RemoveAttachmentRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.removeAttachment.CallUnary(ctx, req)
 }
 
-// GetTargetOfEvaluation calls confirmate.orchestrator.v1.Orchestrator.GetTargetOfEvaluation.
-func (c *orchestratorClient) GetTargetOfEvaluation(ctx context.Context, req *connect.Request[orchestrator.GetTargetOfEvaluationRequest]) (*connect.Response[orchestrator.TargetOfEvaluation], error) {
-	return c.getTargetOfEvaluation.CallUnary(ctx, req)
+// AddEvaluationResultComment calls
+// confirmate.orchestrator.v1.Orchestrator.AddEvaluationResultComment.
+func (c *orchestratorClient) AddEvaluationResultComment(ctx context.Context, req *connect.Request[orchestrator.AddEvaluationResultCommentRequest]) (*connect.Response[evaluation.Comment], error) {
+	return c.addEvaluationResultComment.CallUnary(ctx, req)
+}
+
+// ListEvaluationResultComments calls
+// confirmate.orchestrator.v1.Orchestrator.ListEvaluationResultComments.
+func (c *orchestratorClient) ListEvaluationResultComments(ctx context.Context, req *connect.Request[orchestrator.ListEvaluationResultCommentsRequest]) (*connect.Response[orchestrator.ListEvaluationResultCommentsResponse], error) {
+	return c.listEvaluationResultComments.CallUnary(ctx, req)
+}
+
+// RemoveEvaluationResultComment calls
+// confirmate.orchestrator.v1.Orchestrator.RemoveEvaluationResultComment.
+func (c *orchestratorClient) RemoveEvaluationResultComment(ctx context.Context, req *connect.Request[orchestrator.RemoveEvaluationResultCommentRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.removeEvaluationResultComment.CallUnary(ctx, req)
+}
+
+// CreateSavedView calls confirmate.orchestrator.v1.Orchestrator.CreateSavedView.
+func (c *orchestratorClient) CreateSavedView(ctx context.Context, req *connect.Request[orchestrator.CreateSavedViewRequest]) (*connect.Response[orchestrator.SavedView], error) {
+	return c.createSavedView.CallUnary(ctx, req)
+}
+
+// GetSavedView calls confirmate.orchestrator.v1.Orchestrator.GetSavedView.
+func (c *orchestratorClient) GetSavedView(ctx context.Context, req *connect.Request[orchestrator.GetSavedViewRequest]) (*connect.Response[orchestrator.SavedView], error) {
+	return c.getSavedView.CallUnary(ctx, req)
+}
+
+// ListSavedViews calls confirmate.orchestrator.v1.Orchestrator.ListSavedViews.
+func (c *orchestratorClient) ListSavedViews(ctx context.Context, req *connect.Request[orchestrator.ListSavedViewsRequest]) (*connect.Response[orchestrator.ListSavedViewsResponse], error) {
+	return c.listSavedViews.CallUnary(ctx, req)
+}
+
+// UpdateSavedView calls confirmate.orchestrator.v1.Orchestrator.UpdateSavedView.
+func (c *orchestratorClient) UpdateSavedView(ctx context.Context, req *connect.Request[orchestrator.UpdateSavedViewRequest]) (*connect.Response[orchestrator.SavedView], error) {
+	return c.updateSavedView.CallUnary(ctx, req)
+}
+
+// RemoveSavedView calls confirmate.orchestrator.v1.Orchestrator.RemoveSavedView.
+func (c *orchestratorClient) RemoveSavedView(ctx context.Context, req *connect.Request[orchestrator.RemoveSavedViewRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.removeSavedView.CallUnary(ctx, req)
+}
+
+// CreateMetric calls confirmate.orchestrator.v1.Orchestrator.CreateMetric.
+func (c *orchestratorClient) CreateMetric(ctx context.Context, req *connect.Request[orchestrator.CreateMetricRequest]) (*connect.Response[assessment.Metric], error) {
+	return c.createMetric.CallUnary(ctx, req)
+}
+
+// UpdateMetric calls confirmate.orchestrator.v1.Orchestrator.UpdateMetric.
+func (c *orchestratorClient) UpdateMetric(ctx context.Context, req *connect.Request[orchestrator.UpdateMetricRequest]) (*connect.Response[assessment.Metric], error) {
+	return c.updateMetric.CallUnary(ctx, req)
+}
+
+// GetMetric calls confirmate.orchestrator.v1.Orchestrator.GetMetric.
+func (c *orchestratorClient) GetMetric(ctx context.Context, req *connect.Request[orchestrator.GetMetricRequest]) (*connect.Response[assessment.Metric], error) {
+	return c.getMetric.CallUnary(ctx, req)
+}
+
+// ListMetrics calls confirmate.orchestrator.v1.Orchestrator.ListMetrics.
+func (c *orchestratorClient) ListMetrics(ctx context.Context, req *connect.Request[orchestrator.ListMetricsRequest]) (*connect.Response[orchestrator.ListMetricsResponse], error) {
+	return c.listMetrics.CallUnary(ctx, req)
+}
+
+// RemoveMetric calls confirmate.orchestrator.v1.Orchestrator.RemoveMetric.
+func (c *orchestratorClient) RemoveMetric(ctx context.Context, req *connect.Request[orchestrator.RemoveMetricRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.removeMetric.CallUnary(ctx, req)
+}
+
+// CreateTargetOfEvaluation calls confirmate.orchestrator.v1.Orchestrator.CreateTargetOfEvaluation.
+func (c *orchestratorClient) CreateTargetOfEvaluation(ctx context.Context, req *connect.Request[orchestrator.CreateTargetOfEvaluationRequest]) (*connect.Response[orchestrator.TargetOfEvaluation], error) {
+	return c.createTargetOfEvaluation.CallUnary(ctx, req)
+}
+
+// UpdateTargetOfEvaluation calls confirmate.orchestrator.v1.Orchestrator.UpdateTargetOfEvaluation.
+func (c *orchestratorClient) UpdateTargetOfEvaluation(ctx context.Context, req *connect.Request[orchestrator.UpdateTargetOfEvaluationRequest]) (*connect.Response[orchestrator.TargetOfEvaluation], error) {
+	return c.updateTargetOfEvaluation.CallUnary(ctx, req)
+}
+
+// GetTargetOfEvaluation calls confirmate.orchestrator.v1.Orchestrator.GetTargetOfEvaluation.
+func (c *orchestratorClient) GetTargetOfEvaluation(ctx context.Context, req *connect.Request[orchestrator.GetTargetOfEvaluationRequest]) (*connect.Response[orchestrator.TargetOfEvaluation], error) {
+	return c.getTargetOfEvaluation.CallUnary(ctx, req)
 }
 
 // ListTargetsOfEvaluation calls confirmate.orchestrator.v1.Orchestrator.ListTargetsOfEvaluation.
@@ -948,12 +1993,87 @@ func (c *orchestratorClient) RemoveTargetOfEvaluation(ctx context.Context, req *
 	return c.removeTargetOfEvaluation.CallUnary(ctx, req)
 }
 
+// MergeTargetsOfEvaluation calls confirmate.orchestrator.v1.Orchestrator.MergeTargetsOfEvaluation.
+func (c *orchestratorClient) MergeTargetsOfEvaluation(ctx context.Context, req *connect.Request[orchestrator.MergeTargetsOfEvaluationRequest]) (*connect.Response[orchestrator.MergeTargetsOfEvaluationResponse], error) {
+	return c.mergeTargetsOfEvaluation.CallUnary(ctx, req)
+}
+
 // GetTargetOfEvaluationStatistics calls
 // confirmate.orchestrator.v1.Orchestrator.GetTargetOfEvaluationStatistics.
 func (c *orchestratorClient) GetTargetOfEvaluationStatistics(ctx context.Context, req *connect.Request[orchestrator.GetTargetOfEvaluationStatisticsRequest]) (*connect.Response[orchestrator.GetTargetOfEvaluationStatisticsResponse], error) {
 	return c.getTargetOfEvaluationStatistics.CallUnary(ctx, req)
 }
 
+// GetUsageReport calls confirmate.orchestrator.v1.Orchestrator.GetUsageReport.
+func (c *orchestratorClient) GetUsageReport(ctx context.Context, req *connect.Request[orchestrator.GetUsageReportRequest]) (*connect.Response[orchestrator.UsageReport], error) {
+	return c.getUsageReport.CallUnary(ctx, req)
+}
+
+// CreateSecret calls confirmate.orchestrator.v1.Orchestrator.CreateSecret.
+func (c *orchestratorClient) CreateSecret(ctx context.Context, req *connect.Request[orchestrator.CreateSecretRequest]) (*connect.Response[orchestrator.Secret], error) {
+	return c.createSecret.CallUnary(ctx, req)
+}
+
+// ListSecrets calls confirmate.orchestrator.v1.Orchestrator.ListSecrets.
+func (c *orchestratorClient) ListSecrets(ctx context.Context, req *connect.Request[orchestrator.ListSecretsRequest]) (*connect.Response[orchestrator.ListSecretsResponse], error) {
+	return c.listSecrets.CallUnary(ctx, req)
+}
+
+// UpdateSecret calls confirmate.orchestrator.v1.Orchestrator.UpdateSecret.
+func (c *orchestratorClient) UpdateSecret(ctx context.Context, req *connect.Request[orchestrator.UpdateSecretRequest]) (*connect.Response[orchestrator.Secret], error) {
+	return c.updateSecret.CallUnary(ctx, req)
+}
+
+// RemoveSecret calls confirmate.orchestrator.v1.Orchestrator.RemoveSecret.
+func (c *orchestratorClient) RemoveSecret(ctx context.Context, req *connect.Request[orchestrator.RemoveSecretRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.removeSecret.CallUnary(ctx, req)
+}
+
+// AccessSecret calls confirmate.orchestrator.v1.Orchestrator.AccessSecret.
+func (c *orchestratorClient) AccessSecret(ctx context.Context, req *connect.Request[orchestrator.AccessSecretRequest]) (*connect.Response[orchestrator.SecretValue], error) {
+	return c.accessSecret.CallUnary(ctx, req)
+}
+
+// StoreResourceInventory calls confirmate.orchestrator.v1.Orchestrator.StoreResourceInventory.
+func (c *orchestratorClient) StoreResourceInventory(ctx context.Context, req *connect.Request[orchestrator.StoreResourceInventoryRequest]) (*connect.Response[orchestrator.ResourceInventory], error) {
+	return c.storeResourceInventory.CallUnary(ctx, req)
+}
+
+// ListResourceInventories calls confirmate.orchestrator.v1.Orchestrator.ListResourceInventories.
+func (c *orchestratorClient) ListResourceInventories(ctx context.Context, req *connect.Request[orchestrator.ListResourceInventoriesRequest]) (*connect.Response[orchestrator.ListResourceInventoriesResponse], error) {
+	return c.listResourceInventories.CallUnary(ctx, req)
+}
+
+// UpdateMetadataField calls confirmate.orchestrator.v1.Orchestrator.UpdateMetadataField.
+func (c *orchestratorClient) UpdateMetadataField(ctx context.Context, req *connect.Request[orchestrator.UpdateMetadataFieldRequest]) (*connect.Response[orchestrator.MetadataField], error) {
+	return c.updateMetadataField.CallUnary(ctx, req)
+}
+
+// ListMetadataFields calls confirmate.orchestrator.v1.Orchestrator.ListMetadataFields.
+func (c *orchestratorClient) ListMetadataFields(ctx context.Context, req *connect.Request[orchestrator.ListMetadataFieldsRequest]) (*connect.Response[orchestrator.ListMetadataFieldsResponse], error) {
+	return c.listMetadataFields.CallUnary(ctx, req)
+}
+
+// RemoveMetadataField calls confirmate.orchestrator.v1.Orchestrator.RemoveMetadataField.
+func (c *orchestratorClient) RemoveMetadataField(ctx context.Context, req *connect.Request[orchestrator.RemoveMetadataFieldRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.removeMetadataField.CallUnary(ctx, req)
+}
+
+// UpdateRedactionRule calls confirmate.orchestrator.v1.Orchestrator.UpdateRedactionRule.
+func (c *orchestratorClient) UpdateRedactionRule(ctx context.Context, req *connect.Request[orchestrator.UpdateRedactionRuleRequest]) (*connect.Response[orchestrator.RedactionRule], error) {
+	return c.updateRedactionRule.CallUnary(ctx, req)
+}
+
+// ListRedactionRules calls confirmate.orchestrator.v1.Orchestrator.ListRedactionRules.
+func (c *orchestratorClient) ListRedactionRules(ctx context.Context, req *connect.Request[orchestrator.ListRedactionRulesRequest]) (*connect.Response[orchestrator.ListRedactionRulesResponse], error) {
+	return c.listRedactionRules.CallUnary(ctx, req)
+}
+
+// RemoveRedactionRule calls confirmate.orchestrator.v1.Orchestrator.RemoveRedactionRule.
+func (c *orchestratorClient) RemoveRedactionRule(ctx context.Context, req *connect.Request[orchestrator.RemoveRedactionRuleRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.removeRedactionRule.CallUnary(ctx, req)
+}
+
 // UpdateMetricConfiguration calls
 // confirmate.orchestrator.v1.Orchestrator.UpdateMetricConfiguration.
 func (c *orchestratorClient) UpdateMetricConfiguration(ctx context.Context, req *connect.Request[orchestrator.UpdateMetricConfigurationRequest]) (*connect.Response[assessment.MetricConfiguration], error) {
@@ -965,11 +2085,35 @@ func (c *orchestratorClient) GetMetricConfiguration(ctx context.Context, req *co
 	return c.getMetricConfiguration.CallUnary(ctx, req)
 }
 
+// ResolveMetricConfiguration calls
+// confirmate.orchestrator.v1.Orchestrator.ResolveMetricConfiguration.
+func (c *orchestratorClient) ResolveMetricConfiguration(ctx context.Context, req *connect.Request[orchestrator.ResolveMetricConfigurationRequest]) (*connect.Response[orchestrator.ResolveMetricConfigurationResponse], error) {
+	return c.resolveMetricConfiguration.CallUnary(ctx, req)
+}
+
 // ListMetricConfigurations calls confirmate.orchestrator.v1.Orchestrator.ListMetricConfigurations.
 func (c *orchestratorClient) ListMetricConfigurations(ctx context.Context, req *connect.Request[orchestrator.ListMetricConfigurationRequest]) (*connect.Response[orchestrator.ListMetricConfigurationResponse], error) {
 	return c.listMetricConfigurations.CallUnary(ctx, req)
 }
 
+// UpdateCatalogMetricConfiguration calls
+// confirmate.orchestrator.v1.Orchestrator.UpdateCatalogMetricConfiguration.
+func (c *orchestratorClient) UpdateCatalogMetricConfiguration(ctx context.Context, req *connect.Request[orchestrator.UpdateCatalogMetricConfigurationRequest]) (*connect.Response[assessment.CatalogMetricConfiguration], error) {
+	return c.updateCatalogMetricConfiguration.CallUnary(ctx, req)
+}
+
+// ListCatalogMetricConfigurations calls
+// confirmate.orchestrator.v1.Orchestrator.ListCatalogMetricConfigurations.
+func (c *orchestratorClient) ListCatalogMetricConfigurations(ctx context.Context, req *connect.Request[orchestrator.ListCatalogMetricConfigurationsRequest]) (*connect.Response[orchestrator.ListCatalogMetricConfigurationsResponse], error) {
+	return c.listCatalogMetricConfigurations.CallUnary(ctx, req)
+}
+
+// RemoveCatalogMetricConfiguration calls
+// confirmate.orchestrator.v1.Orchestrator.RemoveCatalogMetricConfiguration.
+func (c *orchestratorClient) RemoveCatalogMetricConfiguration(ctx context.Context, req *connect.Request[orchestrator.RemoveCatalogMetricConfigurationRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.removeCatalogMetricConfiguration.CallUnary(ctx, req)
+}
+
 // UpdateMetricImplementation calls
 // confirmate.orchestrator.v1.Orchestrator.UpdateMetricImplementation.
 func (c *orchestratorClient) UpdateMetricImplementation(ctx context.Context, req *connect.Request[orchestrator.UpdateMetricImplementationRequest]) (*connect.Response[assessment.MetricImplementation], error) {
@@ -986,6 +2130,26 @@ func (c *orchestratorClient) Subscribe(ctx context.Context, req *connect.Request
 	return c.subscribe.CallServerStream(ctx, req)
 }
 
+// CreateWebhook calls confirmate.orchestrator.v1.Orchestrator.CreateWebhook.
+func (c *orchestratorClient) CreateWebhook(ctx context.Context, req *connect.Request[orchestrator.CreateWebhookRequest]) (*connect.Response[orchestrator.Webhook], error) {
+	return c.createWebhook.CallUnary(ctx, req)
+}
+
+// ListWebhooks calls confirmate.orchestrator.v1.Orchestrator.ListWebhooks.
+func (c *orchestratorClient) ListWebhooks(ctx context.Context, req *connect.Request[orchestrator.ListWebhooksRequest]) (*connect.Response[orchestrator.ListWebhooksResponse], error) {
+	return c.listWebhooks.CallUnary(ctx, req)
+}
+
+// RemoveWebhook calls confirmate.orchestrator.v1.Orchestrator.RemoveWebhook.
+func (c *orchestratorClient) RemoveWebhook(ctx context.Context, req *connect.Request[orchestrator.RemoveWebhookRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.removeWebhook.CallUnary(ctx, req)
+}
+
+// ListWebhookDeliveries calls confirmate.orchestrator.v1.Orchestrator.ListWebhookDeliveries.
+func (c *orchestratorClient) ListWebhookDeliveries(ctx context.Context, req *connect.Request[orchestrator.ListWebhookDeliveriesRequest]) (*connect.Response[orchestrator.ListWebhookDeliveriesResponse], error) {
+	return c.listWebhookDeliveries.CallUnary(ctx, req)
+}
+
 // CreateCertificate calls confirmate.orchestrator.v1.Orchestrator.CreateCertificate.
 func (c *orchestratorClient) CreateCertificate(ctx context.Context, req *connect.Request[orchestrator.CreateCertificateRequest]) (*connect.Response[orchestrator.Certificate], error) {
 	return c.createCertificate.CallUnary(ctx, req)
@@ -1031,6 +2195,11 @@ func (c *orchestratorClient) GetCatalog(ctx context.Context, req *connect.Reques
 	return c.getCatalog.CallUnary(ctx, req)
 }
 
+// GetCatalogTree calls confirmate.orchestrator.v1.Orchestrator.GetCatalogTree.
+func (c *orchestratorClient) GetCatalogTree(ctx context.Context, req *connect.Request[orchestrator.GetCatalogTreeRequest]) (*connect.Response[orchestrator.GetCatalogTreeResponse], error) {
+	return c.getCatalogTree.CallUnary(ctx, req)
+}
+
 // RemoveCatalog calls confirmate.orchestrator.v1.Orchestrator.RemoveCatalog.
 func (c *orchestratorClient) RemoveCatalog(ctx context.Context, req *connect.Request[orchestrator.RemoveCatalogRequest]) (*connect.Response[emptypb.Empty], error) {
 	return c.removeCatalog.CallUnary(ctx, req)
@@ -1041,6 +2210,82 @@ func (c *orchestratorClient) UpdateCatalog(ctx context.Context, req *connect.Req
 	return c.updateCatalog.CallUnary(ctx, req)
 }
 
+// PublishCatalog calls confirmate.orchestrator.v1.Orchestrator.PublishCatalog.
+func (c *orchestratorClient) PublishCatalog(ctx context.Context, req *connect.Request[orchestrator.PublishCatalogRequest]) (*connect.Response[orchestrator.Catalog], error) {
+	return c.publishCatalog.CallUnary(ctx, req)
+}
+
+// DiscardCatalogDraft calls confirmate.orchestrator.v1.Orchestrator.DiscardCatalogDraft.
+func (c *orchestratorClient) DiscardCatalogDraft(ctx context.Context, req *connect.Request[orchestrator.DiscardCatalogDraftRequest]) (*connect.Response[orchestrator.Catalog], error) {
+	return c.discardCatalogDraft.CallUnary(ctx, req)
+}
+
+// ListCatalogChangelogs calls confirmate.orchestrator.v1.Orchestrator.ListCatalogChangelogs.
+func (c *orchestratorClient) ListCatalogChangelogs(ctx context.Context, req *connect.Request[orchestrator.ListCatalogChangelogsRequest]) (*connect.Response[orchestrator.ListCatalogChangelogsResponse], error) {
+	return c.listCatalogChangelogs.CallUnary(ctx, req)
+}
+
+// GetCatalogChangelog calls confirmate.orchestrator.v1.Orchestrator.GetCatalogChangelog.
+func (c *orchestratorClient) GetCatalogChangelog(ctx context.Context, req *connect.Request[orchestrator.GetCatalogChangelogRequest]) (*connect.Response[orchestrator.CatalogChangelog], error) {
+	return c.getCatalogChangelog.CallUnary(ctx, req)
+}
+
+// ImportControlMetricMapping calls
+// confirmate.orchestrator.v1.Orchestrator.ImportControlMetricMapping.
+func (c *orchestratorClient) ImportControlMetricMapping(ctx context.Context, req *connect.Request[orchestrator.ImportControlMetricMappingRequest]) (*connect.Response[orchestrator.ImportControlMetricMappingResponse], error) {
+	return c.importControlMetricMapping.CallUnary(ctx, req)
+}
+
+// UpdateAssuranceLevel calls confirmate.orchestrator.v1.Orchestrator.UpdateAssuranceLevel.
+func (c *orchestratorClient) UpdateAssuranceLevel(ctx context.Context, req *connect.Request[orchestrator.UpdateAssuranceLevelRequest]) (*connect.Response[orchestrator.AssuranceLevel], error) {
+	return c.updateAssuranceLevel.CallUnary(ctx, req)
+}
+
+// ListAssuranceLevels calls confirmate.orchestrator.v1.Orchestrator.ListAssuranceLevels.
+func (c *orchestratorClient) ListAssuranceLevels(ctx context.Context, req *connect.Request[orchestrator.ListAssuranceLevelsRequest]) (*connect.Response[orchestrator.ListAssuranceLevelsResponse], error) {
+	return c.listAssuranceLevels.CallUnary(ctx, req)
+}
+
+// RemoveAssuranceLevel calls confirmate.orchestrator.v1.Orchestrator.RemoveAssuranceLevel.
+func (c *orchestratorClient) RemoveAssuranceLevel(ctx context.Context, req *connect.Request[orchestrator.RemoveAssuranceLevelRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.removeAssuranceLevel.CallUnary(ctx, req)
+}
+
+// UpdateApplicabilityRule calls confirmate.orchestrator.v1.Orchestrator.UpdateApplicabilityRule.
+func (c *orchestratorClient) UpdateApplicabilityRule(ctx context.Context, req *connect.Request[orchestrator.UpdateApplicabilityRuleRequest]) (*connect.Response[orchestrator.ApplicabilityRule], error) {
+	return c.updateApplicabilityRule.CallUnary(ctx, req)
+}
+
+// ListApplicabilityRules calls confirmate.orchestrator.v1.Orchestrator.ListApplicabilityRules.
+func (c *orchestratorClient) ListApplicabilityRules(ctx context.Context, req *connect.Request[orchestrator.ListApplicabilityRulesRequest]) (*connect.Response[orchestrator.ListApplicabilityRulesResponse], error) {
+	return c.listApplicabilityRules.CallUnary(ctx, req)
+}
+
+// RemoveApplicabilityRule calls confirmate.orchestrator.v1.Orchestrator.RemoveApplicabilityRule.
+func (c *orchestratorClient) RemoveApplicabilityRule(ctx context.Context, req *connect.Request[orchestrator.RemoveApplicabilityRuleRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.removeApplicabilityRule.CallUnary(ctx, req)
+}
+
+// CreateCatalogSource calls confirmate.orchestrator.v1.Orchestrator.CreateCatalogSource.
+func (c *orchestratorClient) CreateCatalogSource(ctx context.Context, req *connect.Request[orchestrator.CreateCatalogSourceRequest]) (*connect.Response[orchestrator.CatalogSource], error) {
+	return c.createCatalogSource.CallUnary(ctx, req)
+}
+
+// ListCatalogSources calls confirmate.orchestrator.v1.Orchestrator.ListCatalogSources.
+func (c *orchestratorClient) ListCatalogSources(ctx context.Context, req *connect.Request[orchestrator.ListCatalogSourcesRequest]) (*connect.Response[orchestrator.ListCatalogSourcesResponse], error) {
+	return c.listCatalogSources.CallUnary(ctx, req)
+}
+
+// RemoveCatalogSource calls confirmate.orchestrator.v1.Orchestrator.RemoveCatalogSource.
+func (c *orchestratorClient) RemoveCatalogSource(ctx context.Context, req *connect.Request[orchestrator.RemoveCatalogSourceRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.removeCatalogSource.CallUnary(ctx, req)
+}
+
+// SyncCatalogSource calls confirmate.orchestrator.v1.Orchestrator.SyncCatalogSource.
+func (c *orchestratorClient) SyncCatalogSource(ctx context.Context, req *connect.Request[orchestrator.SyncCatalogSourceRequest]) (*connect.Response[orchestrator.CatalogSyncReport], error) {
+	return c.syncCatalogSource.CallUnary(ctx, req)
+}
+
 // GetCategory calls confirmate.orchestrator.v1.Orchestrator.GetCategory.
 func (c *orchestratorClient) GetCategory(ctx context.Context, req *connect.Request[orchestrator.GetCategoryRequest]) (*connect.Response[orchestrator.Category], error) {
 	return c.getCategory.CallUnary(ctx, req)
@@ -1056,6 +2301,16 @@ func (c *orchestratorClient) GetControl(ctx context.Context, req *connect.Reques
 	return c.getControl.CallUnary(ctx, req)
 }
 
+// ListControlTextVersions calls confirmate.orchestrator.v1.Orchestrator.ListControlTextVersions.
+func (c *orchestratorClient) ListControlTextVersions(ctx context.Context, req *connect.Request[orchestrator.ListControlTextVersionsRequest]) (*connect.Response[orchestrator.ListControlTextVersionsResponse], error) {
+	return c.listControlTextVersions.CallUnary(ctx, req)
+}
+
+// RenderControlText calls confirmate.orchestrator.v1.Orchestrator.RenderControlText.
+func (c *orchestratorClient) RenderControlText(ctx context.Context, req *connect.Request[orchestrator.RenderControlTextRequest]) (*connect.Response[orchestrator.RenderControlTextResponse], error) {
+	return c.renderControlText.CallUnary(ctx, req)
+}
+
 // CreateAuditScope calls confirmate.orchestrator.v1.Orchestrator.CreateAuditScope.
 func (c *orchestratorClient) CreateAuditScope(ctx context.Context, req *connect.Request[orchestrator.CreateAuditScopeRequest]) (*connect.Response[orchestrator.AuditScope], error) {
 	return c.createAuditScope.CallUnary(ctx, req)
@@ -1081,6 +2336,41 @@ func (c *orchestratorClient) RemoveAuditScope(ctx context.Context, req *connect.
 	return c.removeAuditScope.CallUnary(ctx, req)
 }
 
+// CreateMaintenanceWindow calls confirmate.orchestrator.v1.Orchestrator.CreateMaintenanceWindow.
+func (c *orchestratorClient) CreateMaintenanceWindow(ctx context.Context, req *connect.Request[orchestrator.CreateMaintenanceWindowRequest]) (*connect.Response[orchestrator.MaintenanceWindow], error) {
+	return c.createMaintenanceWindow.CallUnary(ctx, req)
+}
+
+// ListMaintenanceWindows calls confirmate.orchestrator.v1.Orchestrator.ListMaintenanceWindows.
+func (c *orchestratorClient) ListMaintenanceWindows(ctx context.Context, req *connect.Request[orchestrator.ListMaintenanceWindowsRequest]) (*connect.Response[orchestrator.ListMaintenanceWindowsResponse], error) {
+	return c.listMaintenanceWindows.CallUnary(ctx, req)
+}
+
+// RemoveMaintenanceWindow calls confirmate.orchestrator.v1.Orchestrator.RemoveMaintenanceWindow.
+func (c *orchestratorClient) RemoveMaintenanceWindow(ctx context.Context, req *connect.Request[orchestrator.RemoveMaintenanceWindowRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.removeMaintenanceWindow.CallUnary(ctx, req)
+}
+
+// ExportOSCAL calls confirmate.orchestrator.v1.Orchestrator.ExportOSCAL.
+func (c *orchestratorClient) ExportOSCAL(ctx context.Context, req *connect.Request[orchestrator.ExportOSCALRequest]) (*connect.Response[orchestrator.ExportOSCALResponse], error) {
+	return c.exportOSCAL.CallUnary(ctx, req)
+}
+
+// ArchiveAuditScopeData calls confirmate.orchestrator.v1.Orchestrator.ArchiveAuditScopeData.
+func (c *orchestratorClient) ArchiveAuditScopeData(ctx context.Context, req *connect.Request[orchestrator.ArchiveAuditScopeDataRequest]) (*connect.Response[orchestrator.ArchiveAuditScopeDataResponse], error) {
+	return c.archiveAuditScopeData.CallUnary(ctx, req)
+}
+
+// RestoreAuditScopeData calls confirmate.orchestrator.v1.Orchestrator.RestoreAuditScopeData.
+func (c *orchestratorClient) RestoreAuditScopeData(ctx context.Context, req *connect.Request[orchestrator.RestoreAuditScopeDataRequest]) (*connect.Response[orchestrator.RestoreAuditScopeDataResponse], error) {
+	return c.restoreAuditScopeData.CallUnary(ctx, req)
+}
+
+// GetSchema calls confirmate.orchestrator.v1.Orchestrator.GetSchema.
+func (c *orchestratorClient) GetSchema(ctx context.Context, req *connect.Request[orchestrator.GetSchemaRequest]) (*connect.Response[orchestrator.Schema], error) {
+	return c.getSchema.CallUnary(ctx, req)
+}
+
 // GetRuntimeInfo calls confirmate.orchestrator.v1.Orchestrator.GetRuntimeInfo.
 func (c *orchestratorClient) GetRuntimeInfo(ctx context.Context, req *connect.Request[common.GetRuntimeInfoRequest]) (*connect.Response[common.Runtime], error) {
 	return c.getRuntimeInfo.CallUnary(ctx, req)
@@ -1111,6 +2401,16 @@ func (c *orchestratorClient) ListUsers(ctx context.Context, req *connect.Request
 	return c.listUsers.CallUnary(ctx, req)
 }
 
+// ResolveUser calls confirmate.orchestrator.v1.Orchestrator.ResolveUser.
+func (c *orchestratorClient) ResolveUser(ctx context.Context, req *connect.Request[orchestrator.ResolveUserRequest]) (*connect.Response[orchestrator.User], error) {
+	return c.resolveUser.CallUnary(ctx, req)
+}
+
+// SyncUsers calls confirmate.orchestrator.v1.Orchestrator.SyncUsers.
+func (c *orchestratorClient) SyncUsers(ctx context.Context, req *connect.Request[orchestrator.SyncUsersRequest]) (*connect.Response[orchestrator.SyncUsersResponse], error) {
+	return c.syncUsers.CallUnary(ctx, req)
+}
+
 // ListUserPermissions calls confirmate.orchestrator.v1.Orchestrator.ListUserPermissions.
 func (c *orchestratorClient) ListUserPermissions(ctx context.Context, req *connect.Request[orchestrator.ListUserPermissionsRequest]) (*connect.Response[orchestrator.ListUserPermissionsResponse], error) {
 	return c.listUserPermissions.CallUnary(ctx, req)
@@ -1181,8 +2481,28 @@ type OrchestratorHandler interface {
 	// Stores stream of assessment results provided by an assessment tool and
 	// returns a response stream. Part of the public API, not exposed as REST.
 	StoreAssessmentResults(context.Context, *connect.BidiStream[orchestrator.StoreAssessmentResultRequest, orchestrator.StoreAssessmentResultsResponse]) error
+	// Stores a batch of assessment results in a single transaction. Results that
+	// cannot be stored are reported individually in the response, while the
+	// remaining results are still stored.
+	BatchStoreAssessmentResults(context.Context, *connect.Request[orchestrator.BatchStoreAssessmentResultsRequest]) (*connect.Response[orchestrator.BatchStoreAssessmentResultsResponse], error)
 	// Get an assessment result by ID
 	GetAssessmentResult(context.Context, *connect.Request[orchestrator.GetAssessmentResultRequest]) (*connect.Response[assessment.AssessmentResult], error)
+	// Waives a non-compliant assessment result, i.e., accepts the risk of its
+	// non-compliance. Waived assessment results are skipped when evaluating the
+	// status of a control until the waiver expires. Part of the public API, also
+	// exposed as REST.
+	WaiveAssessmentResult(context.Context, *connect.Request[orchestrator.WaiveAssessmentResultRequest]) (*connect.Response[assessment.AssessmentResult], error)
+	// Revokes the waiver of an assessment result. Part of the public API, also
+	// exposed as REST.
+	RevokeAssessmentResultWaiver(context.Context, *connect.Request[orchestrator.RevokeAssessmentResultWaiverRequest]) (*connect.Response[assessment.AssessmentResult], error)
+	// Imports the findings of an external audit, e.g., a manual penetration
+	// test, from a file (CSV or JSON) as assessment results of a target of
+	// evaluation. Each finding is mapped to a control of a catalog and is stored
+	// as an externally sourced assessment result for the metrics of the control,
+	// so that it is evaluated together with the automated assessment results.
+	// The response contains a validation report of all findings. The findings
+	// are only imported if all of them are valid.
+	ImportExternalFindings(context.Context, *connect.Request[orchestrator.ImportExternalFindingsRequest]) (*connect.Response[orchestrator.ImportExternalFindingsResponse], error)
 	// Store the evaluation result provided by the evaluation component.″
 	StoreEvaluationResult(context.Context, *connect.Request[orchestrator.StoreEvaluationResultRequest]) (*connect.Response[evaluation.EvaluationResult], error)
 	// List all assessment results. Part of the public API, also exposed as REST.
@@ -1191,6 +2511,59 @@ type OrchestratorHandler interface {
 	// restricted by various filtering options. Part of the public API, also
 	// exposed as REST.
 	ListEvaluationResults(context.Context, *connect.Request[orchestrator.ListEvaluationResultsRequest]) (*connect.Response[orchestrator.ListEvaluationResultsResponse], error)
+	// Lists the controls that are not compliant for longer than allowed by the
+	// SLA thresholds of their catalog. Part of the public API, also exposed as
+	// REST.
+	ListSlaBreaches(context.Context, *connect.Request[orchestrator.ListSlaBreachesRequest]) (*connect.Response[orchestrator.ListSlaBreachesResponse], error)
+	// Aggregates the compliance posture of all targets of evaluation the user has
+	// access to per catalog and per control family (i.e., category of a
+	// catalog), e.g., for a portfolio dashboard. Only the latest evaluation
+	// result of each top-level control of an audit scope is counted. Part of the
+	// public API, also exposed as REST.
+	GetCompliancePosture(context.Context, *connect.Request[orchestrator.GetCompliancePostureRequest]) (*connect.Response[orchestrator.CompliancePosture], error)
+	// Lists the alerts raised by the anomaly detection of incoming assessment
+	// results, e.g., a sudden drop in the result volume of a target of
+	// evaluation or a metric that flips between compliant and non-compliant.
+	// Part of the public API, also exposed as REST.
+	ListAlerts(context.Context, *connect.Request[orchestrator.ListAlertsRequest]) (*connect.Response[orchestrator.ListAlertsResponse], error)
+	// Uploads an attachment of an evaluation result. The first message must
+	// contain the metadata of the attachment, all following messages contain
+	// chunks of its content. Part of the public API, not exposed as REST.
+	UploadAttachment(context.Context, *connect.ClientStream[orchestrator.UploadAttachmentRequest]) (*connect.Response[evaluation.Attachment], error)
+	// Downloads an attachment of an evaluation result. The first message
+	// contains the metadata of the attachment, all following messages contain
+	// chunks of its content. Part of the public API, not exposed as REST.
+	DownloadAttachment(context.Context, *connect.Request[orchestrator.DownloadAttachmentRequest], *connect.ServerStream[orchestrator.DownloadAttachmentResponse]) error
+	// Lists the attachments of an evaluation result. Part of the public API,
+	// also exposed as REST.
+	ListAttachments(context.Context, *connect.Request[orchestrator.ListAttachmentsRequest]) (*connect.Response[orchestrator.ListAttachmentsResponse], error)
+	// Removes an attachment including its content. Part of the public API, also
+	// exposed as REST.
+	RemoveAttachment(context.Context, *connect.Request[orchestrator.RemoveAttachmentRequest]) (*connect.Response[emptypb.Empty], error)
+	// Adds a comment to an evaluation result. The author and creation time are
+	// set by the server. Part of the public API, also exposed as REST.
+	AddEvaluationResultComment(context.Context, *connect.Request[orchestrator.AddEvaluationResultCommentRequest]) (*connect.Response[evaluation.Comment], error)
+	// Lists the comments of an evaluation result, by default in the order they
+	// were written. Part of the public API, also exposed as REST.
+	ListEvaluationResultComments(context.Context, *connect.Request[orchestrator.ListEvaluationResultCommentsRequest]) (*connect.Response[orchestrator.ListEvaluationResultCommentsResponse], error)
+	// Removes a comment including all of its replies. Part of the public API,
+	// also exposed as REST.
+	RemoveEvaluationResultComment(context.Context, *connect.Request[orchestrator.RemoveEvaluationResultCommentRequest]) (*connect.Response[emptypb.Empty], error)
+	// Creates a saved view, i.e., a named filter of evaluation results, owned by
+	// the calling user. Part of the public API, also exposed as REST.
+	CreateSavedView(context.Context, *connect.Request[orchestrator.CreateSavedViewRequest]) (*connect.Response[orchestrator.SavedView], error)
+	// Retrieves a saved view of the calling user or a view shared by another
+	// user. Part of the public API, also exposed as REST.
+	GetSavedView(context.Context, *connect.Request[orchestrator.GetSavedViewRequest]) (*connect.Response[orchestrator.SavedView], error)
+	// Lists the saved views of the calling user and the views shared by other
+	// users. Part of the public API, also exposed as REST.
+	ListSavedViews(context.Context, *connect.Request[orchestrator.ListSavedViewsRequest]) (*connect.Response[orchestrator.ListSavedViewsResponse], error)
+	// Updates a saved view of the calling user. Part of the public API, also
+	// exposed as REST.
+	UpdateSavedView(context.Context, *connect.Request[orchestrator.UpdateSavedViewRequest]) (*connect.Response[orchestrator.SavedView], error)
+	// Removes a saved view of the calling user. Part of the public API, also
+	// exposed as REST.
+	RemoveSavedView(context.Context, *connect.Request[orchestrator.RemoveSavedViewRequest]) (*connect.Response[emptypb.Empty], error)
 	// Creates a new metric
 	CreateMetric(context.Context, *connect.Request[orchestrator.CreateMetricRequest]) (*connect.Response[assessment.Metric], error)
 	// Updates an existing metric
@@ -1211,23 +2584,112 @@ type OrchestratorHandler interface {
 	ListTargetsOfEvaluation(context.Context, *connect.Request[orchestrator.ListTargetsOfEvaluationRequest]) (*connect.Response[orchestrator.ListTargetsOfEvaluationResponse], error)
 	// Removes a target of evaluation
 	RemoveTargetOfEvaluation(context.Context, *connect.Request[orchestrator.RemoveTargetOfEvaluationRequest]) (*connect.Response[emptypb.Empty], error)
+	// Merges a duplicate target of evaluation into another one. All audit
+	// scopes, controls in scope, assessment and evaluation results,
+	// certificates, metric configurations and permissions of the duplicate are
+	// moved to the remaining target of evaluation and the duplicate is removed.
+	// In a dry run, the response only previews the affected entities.
+	MergeTargetsOfEvaluation(context.Context, *connect.Request[orchestrator.MergeTargetsOfEvaluationRequest]) (*connect.Response[orchestrator.MergeTargetsOfEvaluationResponse], error)
 	// Retrieves target of evaluation statistics
 	GetTargetOfEvaluationStatistics(context.Context, *connect.Request[orchestrator.GetTargetOfEvaluationStatisticsRequest]) (*connect.Response[orchestrator.GetTargetOfEvaluationStatisticsResponse], error)
+	// Reports the metered usage of all targets of evaluation the user has access
+	// to, e.g., so that operators of a hosted installation can bill or budget per
+	// customer. The usage is metered per day when data is stored and is not
+	// reduced when the data is archived or removed. Part of the public API, also
+	// exposed as REST.
+	GetUsageReport(context.Context, *connect.Request[orchestrator.GetUsageReportRequest]) (*connect.Response[orchestrator.UsageReport], error)
+	// Creates a secret of a target of evaluation, e.g., read-only API
+	// credentials of the system under evaluation that are used by collectors.
+	// The value is stored encrypted and never returned. Part of the public API,
+	// also exposed as REST.
+	CreateSecret(context.Context, *connect.Request[orchestrator.CreateSecretRequest]) (*connect.Response[orchestrator.Secret], error)
+	// Lists the secrets of a target of evaluation without their values, by
+	// default ordered by their name. Part of the public API, also exposed as
+	// REST.
+	ListSecrets(context.Context, *connect.Request[orchestrator.ListSecretsRequest]) (*connect.Response[orchestrator.ListSecretsResponse], error)
+	// Updates a secret. If no value is given, the value is kept. Part of the
+	// public API, also exposed as REST.
+	UpdateSecret(context.Context, *connect.Request[orchestrator.UpdateSecretRequest]) (*connect.Response[orchestrator.Secret], error)
+	// Removes a secret. Part of the public API, also exposed as REST.
+	RemoveSecret(context.Context, *connect.Request[orchestrator.RemoveSecretRequest]) (*connect.Response[emptypb.Empty], error)
+	// Returns the decrypted value of a secret of a target of evaluation by its
+	// name. Unlike listing the secrets, this requires contributor permission for
+	// the target of evaluation. It is intended for collectors, whose tokens
+	// should be restricted to the targets of evaluation they collect evidences
+	// for. Part of the public API, also exposed as REST.
+	AccessSecret(context.Context, *connect.Request[orchestrator.AccessSecretRequest]) (*connect.Response[orchestrator.SecretValue], error)
+	// Stores the resource inventory of a collector for a target of evaluation,
+	// i.e., which resources the collector discovered, regardless of whether they
+	// are assessed. It replaces the previous inventory of the same collector.
+	// Part of the public API, also exposed as REST.
+	StoreResourceInventory(context.Context, *connect.Request[orchestrator.StoreResourceInventoryRequest]) (*connect.Response[orchestrator.ResourceInventory], error)
+	// Lists the resource inventories of the collectors of a target of
+	// evaluation, by default ordered by the ID of the collector. Part of the
+	// public API, also exposed as REST.
+	ListResourceInventories(context.Context, *connect.Request[orchestrator.ListResourceInventoriesRequest]) (*connect.Response[orchestrator.ListResourceInventoriesResponse], error)
+	// Creates or updates a custom metadata field that can be set on targets of
+	// evaluation
+	UpdateMetadataField(context.Context, *connect.Request[orchestrator.UpdateMetadataFieldRequest]) (*connect.Response[orchestrator.MetadataField], error)
+	// Lists all custom metadata fields of targets of evaluation
+	ListMetadataFields(context.Context, *connect.Request[orchestrator.ListMetadataFieldsRequest]) (*connect.Response[orchestrator.ListMetadataFieldsResponse], error)
+	// Removes a custom metadata field and its values from all targets of
+	// evaluation
+	RemoveMetadataField(context.Context, *connect.Request[orchestrator.RemoveMetadataFieldRequest]) (*connect.Response[emptypb.Empty], error)
+	// Creates or updates the redaction rule of a resource type. Fields of
+	// resources of this type matching the rule are stripped or masked before
+	// they are evaluated by the assessment
+	UpdateRedactionRule(context.Context, *connect.Request[orchestrator.UpdateRedactionRuleRequest]) (*connect.Response[orchestrator.RedactionRule], error)
+	// Lists all redaction rules
+	ListRedactionRules(context.Context, *connect.Request[orchestrator.ListRedactionRulesRequest]) (*connect.Response[orchestrator.ListRedactionRulesResponse], error)
+	// Removes the redaction rule of a resource type
+	RemoveRedactionRule(context.Context, *connect.Request[orchestrator.RemoveRedactionRuleRequest]) (*connect.Response[emptypb.Empty], error)
 	// Updates a metric configuration (target value and operator) for a specific
 	// target of evaluation and metric ID
 	UpdateMetricConfiguration(context.Context, *connect.Request[orchestrator.UpdateMetricConfigurationRequest]) (*connect.Response[assessment.MetricConfiguration], error)
 	// Retrieves a metric configuration (target value and operator) for a specific
-	// target of evaluation and metric ID.
+	// target of evaluation and metric ID. The configuration is resolved in the
+	// following order: an explicit configuration of the target of evaluation,
+	// the default configuration of the catalog and finally the global default
+	// configuration of the metric. The layer that was used is returned in the
+	// source field.
 	GetMetricConfiguration(context.Context, *connect.Request[orchestrator.GetMetricConfigurationRequest]) (*connect.Response[assessment.MetricConfiguration], error)
+	// Resolves the effective metric configuration (target value and operator)
+	// for a specific target of evaluation and metric ID and explains how it was
+	// resolved. The resolution chain contains all layers that were considered,
+	// from the global default configuration of the metric over the default
+	// configurations of the catalogs to an explicit configuration of the target
+	// of evaluation, and states which layer was applied and why the others were
+	// not.
+	ResolveMetricConfiguration(context.Context, *connect.Request[orchestrator.ResolveMetricConfigurationRequest]) (*connect.Response[orchestrator.ResolveMetricConfigurationResponse], error)
 	// Lists all metric configurations (target value and operator) for a
 	// specific target of evaluation ID
 	ListMetricConfigurations(context.Context, *connect.Request[orchestrator.ListMetricConfigurationRequest]) (*connect.Response[orchestrator.ListMetricConfigurationResponse], error)
+	// Creates or updates the default metric configuration (target value and
+	// operator) of a metric for a specific catalog
+	UpdateCatalogMetricConfiguration(context.Context, *connect.Request[orchestrator.UpdateCatalogMetricConfigurationRequest]) (*connect.Response[assessment.CatalogMetricConfiguration], error)
+	// Lists all default metric configurations of a specific catalog
+	ListCatalogMetricConfigurations(context.Context, *connect.Request[orchestrator.ListCatalogMetricConfigurationsRequest]) (*connect.Response[orchestrator.ListCatalogMetricConfigurationsResponse], error)
+	// Removes the default metric configuration of a metric for a specific
+	// catalog
+	RemoveCatalogMetricConfiguration(context.Context, *connect.Request[orchestrator.RemoveCatalogMetricConfigurationRequest]) (*connect.Response[emptypb.Empty], error)
 	// Updates an existing metric implementation
 	UpdateMetricImplementation(context.Context, *connect.Request[orchestrator.UpdateMetricImplementationRequest]) (*connect.Response[assessment.MetricImplementation], error)
 	// Returns the metric implementation of the passed metric id
 	GetMetricImplementation(context.Context, *connect.Request[orchestrator.GetMetricImplementationRequest]) (*connect.Response[assessment.MetricImplementation], error)
 	// Subscribes to change events in the orchestrator
 	Subscribe(context.Context, *connect.Request[orchestrator.SubscribeRequest], *connect.ServerStream[orchestrator.ChangeEvent]) error
+	// Creates a webhook that receives the change events of the orchestrator
+	// matching its filter, e.g., to keep external systems in sync. Part of the
+	// public API, also exposed as REST.
+	CreateWebhook(context.Context, *connect.Request[orchestrator.CreateWebhookRequest]) (*connect.Response[orchestrator.Webhook], error)
+	// Lists all webhooks. Part of the public API, also exposed as REST.
+	ListWebhooks(context.Context, *connect.Request[orchestrator.ListWebhooksRequest]) (*connect.Response[orchestrator.ListWebhooksResponse], error)
+	// Removes a webhook together with its delivery log. Part of the public API,
+	// also exposed as REST.
+	RemoveWebhook(context.Context, *connect.Request[orchestrator.RemoveWebhookRequest]) (*connect.Response[emptypb.Empty], error)
+	// Lists the deliveries of change events to a webhook, by default the latest
+	// delivery first. Part of the public API, also exposed as REST.
+	ListWebhookDeliveries(context.Context, *connect.Request[orchestrator.ListWebhookDeliveriesRequest]) (*connect.Response[orchestrator.ListWebhookDeliveriesResponse], error)
 	// Creates a new certificate
 	CreateCertificate(context.Context, *connect.Request[orchestrator.CreateCertificateRequest]) (*connect.Response[orchestrator.Certificate], error)
 	// Retrieves a certificate
@@ -1248,10 +2710,60 @@ type OrchestratorHandler interface {
 	// Retrieves a specific catalog by it's ID. The catalog includes a list of all
 	// of it categories as well as the first level of controls in each category.
 	GetCatalog(context.Context, *connect.Request[orchestrator.GetCatalogRequest]) (*connect.Response[orchestrator.Catalog], error)
+	// Retrieves the hierarchical structure of a catalog, i.e., its categories,
+	// controls and sub-controls, in a single call. Each control includes the
+	// number of its metrics and, if an audit scope is given, its latest
+	// evaluation status within the audit scope. The depth of the tree can be
+	// limited, so that clients can browse large catalogs interactively.
+	GetCatalogTree(context.Context, *connect.Request[orchestrator.GetCatalogTreeRequest]) (*connect.Response[orchestrator.GetCatalogTreeResponse], error)
 	// Removes a catalog
 	RemoveCatalog(context.Context, *connect.Request[orchestrator.RemoveCatalogRequest]) (*connect.Response[emptypb.Empty], error)
 	// Updates an existing certificate
 	UpdateCatalog(context.Context, *connect.Request[orchestrator.UpdateCatalogRequest]) (*connect.Response[orchestrator.Catalog], error)
+	// Publishes the draft of a catalog. The draft is snapshotted into a new,
+	// immutable catalog version that audit scopes can bind to. The draft itself
+	// stays editable.
+	PublishCatalog(context.Context, *connect.Request[orchestrator.PublishCatalogRequest]) (*connect.Response[orchestrator.Catalog], error)
+	// Discards all changes made to the draft of a catalog since its last
+	// published version.
+	DiscardCatalogDraft(context.Context, *connect.Request[orchestrator.DiscardCatalogDraftRequest]) (*connect.Response[orchestrator.Catalog], error)
+	// Lists the changelogs of a catalog, ordered by their creation time, most
+	// recent first. A changelog is generated whenever the draft of the catalog
+	// is re-imported from its catalog source or published.
+	ListCatalogChangelogs(context.Context, *connect.Request[orchestrator.ListCatalogChangelogsRequest]) (*connect.Response[orchestrator.ListCatalogChangelogsResponse], error)
+	// Retrieves a changelog of a catalog, including its rendering as Markdown.
+	GetCatalogChangelog(context.Context, *connect.Request[orchestrator.GetCatalogChangelogRequest]) (*connect.Response[orchestrator.CatalogChangelog], error)
+	// Imports a mapping of controls to metrics from a spreadsheet (CSV or XLSX)
+	// into the controls of a catalog. The response contains a validation report
+	// of all rows. The mapping is only applied if all rows are valid.
+	ImportControlMetricMapping(context.Context, *connect.Request[orchestrator.ImportControlMetricMappingRequest]) (*connect.Response[orchestrator.ImportControlMetricMappingResponse], error)
+	// Creates or updates an assurance level of a catalog. Published catalogs
+	// cannot be modified.
+	UpdateAssuranceLevel(context.Context, *connect.Request[orchestrator.UpdateAssuranceLevelRequest]) (*connect.Response[orchestrator.AssuranceLevel], error)
+	// Lists the assurance levels of a catalog, ordered by their rank
+	ListAssuranceLevels(context.Context, *connect.Request[orchestrator.ListAssuranceLevelsRequest]) (*connect.Response[orchestrator.ListAssuranceLevelsResponse], error)
+	// Removes an assurance level of a catalog. Published catalogs cannot be
+	// modified.
+	RemoveAssuranceLevel(context.Context, *connect.Request[orchestrator.RemoveAssuranceLevelRequest]) (*connect.Response[emptypb.Empty], error)
+	// Creates or updates the applicability rule of a control of a catalog.
+	// Published catalogs cannot be modified.
+	UpdateApplicabilityRule(context.Context, *connect.Request[orchestrator.UpdateApplicabilityRuleRequest]) (*connect.Response[orchestrator.ApplicabilityRule], error)
+	// Lists the applicability rules of a catalog
+	ListApplicabilityRules(context.Context, *connect.Request[orchestrator.ListApplicabilityRulesRequest]) (*connect.Response[orchestrator.ListApplicabilityRulesResponse], error)
+	// Removes the applicability rule of a control of a catalog. Published
+	// catalogs cannot be modified.
+	RemoveApplicabilityRule(context.Context, *connect.Request[orchestrator.RemoveApplicabilityRuleRequest]) (*connect.Response[emptypb.Empty], error)
+	// Creates an upstream source of catalogs. The catalogs of the source are
+	// re-imported periodically, whenever its content changes.
+	CreateCatalogSource(context.Context, *connect.Request[orchestrator.CreateCatalogSourceRequest]) (*connect.Response[orchestrator.CatalogSource], error)
+	// Lists all catalog sources
+	ListCatalogSources(context.Context, *connect.Request[orchestrator.ListCatalogSourcesRequest]) (*connect.Response[orchestrator.ListCatalogSourcesResponse], error)
+	// Removes a catalog source. The catalogs imported from it are kept.
+	RemoveCatalogSource(context.Context, *connect.Request[orchestrator.RemoveCatalogSourceRequest]) (*connect.Response[emptypb.Empty], error)
+	// Re-imports the catalogs of a catalog source immediately instead of
+	// waiting for its next scheduled sync. The response contains a report of the
+	// changes made to the catalogs.
+	SyncCatalogSource(context.Context, *connect.Request[orchestrator.SyncCatalogSourceRequest]) (*connect.Response[orchestrator.CatalogSyncReport], error)
 	// Retrieves a category of a catalog specified by the catalog ID and the
 	// category name. It includes the first level of controls within each
 	// category.
@@ -1264,6 +2776,14 @@ type OrchestratorHandler interface {
 	// If present, it also includes a list of sub-controls if present or a list of
 	// metrics if no sub-controls but metrics are present.
 	GetControl(context.Context, *connect.Request[orchestrator.GetControlRequest]) (*connect.Response[orchestrator.Control], error)
+	// Lists the versions of the text of a control, i.e., of its name,
+	// description and references, by default starting with the latest one.
+	// Part of the public API, also exposed as REST.
+	ListControlTextVersions(context.Context, *connect.Request[orchestrator.ListControlTextVersionsRequest]) (*connect.Response[orchestrator.ListControlTextVersionsResponse], error)
+	// Renders the text of a control, i.e., its Markdown description and its
+	// references, to HTML. By default, the current text is rendered. Part of the
+	// public API, also exposed as REST.
+	RenderControlText(context.Context, *connect.Request[orchestrator.RenderControlTextRequest]) (*connect.Response[orchestrator.RenderControlTextResponse], error)
 	// Creates a new Audit Scope
 	CreateAuditScope(context.Context, *connect.Request[orchestrator.CreateAuditScopeRequest]) (*connect.Response[orchestrator.AuditScope], error)
 	// Retrieves an Audit Scope
@@ -1274,6 +2794,37 @@ type OrchestratorHandler interface {
 	UpdateAuditScope(context.Context, *connect.Request[orchestrator.UpdateAuditScopeRequest]) (*connect.Response[orchestrator.AuditScope], error)
 	// Removes an Audit Scope
 	RemoveAuditScope(context.Context, *connect.Request[orchestrator.RemoveAuditScopeRequest]) (*connect.Response[emptypb.Empty], error)
+	// Creates a maintenance window for an Audit Scope. During the window,
+	// evaluation runs are either suppressed or their results are flagged as
+	// "during maintenance". Part of the public API, also exposed as REST.
+	CreateMaintenanceWindow(context.Context, *connect.Request[orchestrator.CreateMaintenanceWindowRequest]) (*connect.Response[orchestrator.MaintenanceWindow], error)
+	// Lists the maintenance windows of an Audit Scope, by default ordered by
+	// their start. Part of the public API, also exposed as REST.
+	ListMaintenanceWindows(context.Context, *connect.Request[orchestrator.ListMaintenanceWindowsRequest]) (*connect.Response[orchestrator.ListMaintenanceWindowsResponse], error)
+	// Removes a maintenance window. Part of the public API, also exposed as
+	// REST.
+	RemoveMaintenanceWindow(context.Context, *connect.Request[orchestrator.RemoveMaintenanceWindowRequest]) (*connect.Response[emptypb.Empty], error)
+	// Exports the assessment and evaluation results of an Audit Scope in the
+	// OSCAL Assessment Results model (JSON). Part of the public API, also exposed
+	// as REST.
+	ExportOSCAL(context.Context, *connect.Request[orchestrator.ExportOSCALRequest]) (*connect.Response[orchestrator.ExportOSCALResponse], error)
+	// Archives the evaluation results of an Audit Scope, together with the IDs
+	// of the assessment results they are based on, as a tar archive of NDJSON
+	// files. The archive can be restored with RestoreAuditScopeData, e.g., to
+	// hand an audit over to another deployment. Part of the public API, also
+	// exposed as REST.
+	ArchiveAuditScopeData(context.Context, *connect.Request[orchestrator.ArchiveAuditScopeDataRequest]) (*connect.Response[orchestrator.ArchiveAuditScopeDataResponse], error)
+	// Restores the evaluation results of an archive created by
+	// ArchiveAuditScopeData into an Audit Scope. The Audit Scope must cover the
+	// catalogs of the evaluation results. Evaluation results that already exist
+	// are skipped, so that an archive can be restored more than once. Part of
+	// the public API, also exposed as REST.
+	RestoreAuditScopeData(context.Context, *connect.Request[orchestrator.RestoreAuditScopeDataRequest]) (*connect.Response[orchestrator.RestoreAuditScopeDataResponse], error)
+	// Exports the persisted schema of the orchestrator, i.e., its entities with
+	// their tables and columns and the relationships between them, so that
+	// external reporting tools can join the tables. Part of the public API, also
+	// exposed as REST.
+	GetSchema(context.Context, *connect.Request[orchestrator.GetSchemaRequest]) (*connect.Response[orchestrator.Schema], error)
 	// Get Runtime Information
 	GetRuntimeInfo(context.Context, *connect.Request[common.GetRuntimeInfoRequest]) (*connect.Response[common.Runtime], error)
 	// Upserts a specific user permission identified by object and user.
@@ -1286,6 +2837,13 @@ type OrchestratorHandler interface {
 	GetUser(context.Context, *connect.Request[orchestrator.GetUserRequest]) (*connect.Response[orchestrator.User], error)
 	// Lists users with optional filtering
 	ListUsers(context.Context, *connect.Request[orchestrator.ListUsersRequest]) (*connect.Response[orchestrator.ListUsersResponse], error)
+	// Resolves the local user that belongs to an external identity, i.e., the subject of an
+	// identity provider or the identifier of a user in an external user directory.
+	ResolveUser(context.Context, *connect.Request[orchestrator.ResolveUserRequest]) (*connect.Response[orchestrator.User], error)
+	// Synchronizes the users of the configured user directory (e.g., a SCIM provider) with the local
+	// users. Users that no longer exist in the directory are disabled. This endpoint is restricted to
+	// admins.
+	SyncUsers(context.Context, *connect.Request[orchestrator.SyncUsersRequest]) (*connect.Response[orchestrator.SyncUsersResponse], error)
 	// Lists user permissions, optionally filtered by object type, object ID, and/or user ID.
 	ListUserPermissions(context.Context, *connect.Request[orchestrator.ListUserPermissionsRequest]) (*connect.Response[orchestrator.ListUserPermissionsResponse], error)
 	// Lists all predefined roles in the system.
@@ -1360,12 +2918,36 @@ func NewOrchestratorHandler(svc OrchestratorHandler, opts ...connect.HandlerOpti
 		connect.WithSchema(orchestratorMethods.ByName("StoreAssessmentResults")),
 		connect.WithHandlerOptions(opts...),
 	)
+	orchestratorBatchStoreAssessmentResultsHandler := connect.NewUnaryHandler(
+		OrchestratorBatchStoreAssessmentResultsProcedure,
+		svc.BatchStoreAssessmentResults,
+		connect.WithSchema(orchestratorMethods.ByName("BatchStoreAssessmentResults")),
+		connect.WithHandlerOptions(opts...),
+	)
 	orchestratorGetAssessmentResultHandler := connect.NewUnaryHandler(
 		OrchestratorGetAssessmentResultProcedure,
 		svc.GetAssessmentResult,
 		connect.WithSchema(orchestratorMethods.ByName("GetAssessmentResult")),
 		connect.WithHandlerOptions(opts...),
 	)
+	orchestratorWaiveAssessmentResultHandler := connect.NewUnaryHandler(
+		OrchestratorWaiveAssessmentResultProcedure,
+		svc.WaiveAssessmentResult,
+		connect.WithSchema(orchestratorMethods.ByName("WaiveAssessmentResult")),
+		connect.WithHandlerOptions(opts...),
+	)
+	orchestratorRevokeAssessmentResultWaiverHandler := connect.NewUnaryHandler(
+		OrchestratorRevokeAssessmentResultWaiverProcedure,
+		svc.RevokeAssessmentResultWaiver,
+		connect.WithSchema(orchestratorMethods.ByName("RevokeAssessmentResultWaiver")),
+		connect.WithHandlerOptions(opts...),
+	)
+	orchestratorImportExternalFindingsHandler := connect.NewUnaryHandler(
+		OrchestratorImportExternalFindingsProcedure,
+		svc.ImportExternalFindings,
+		connect.WithSchema(orchestratorMethods.ByName("ImportExternalFindings")),
+		connect.WithHandlerOptions(opts...),
+	)
 	orchestratorStoreEvaluationResultHandler := connect.NewUnaryHandler(
 		OrchestratorStoreEvaluationResultProcedure,
 		svc.StoreEvaluationResult,
@@ -1384,6 +2966,96 @@ func NewOrchestratorHandler(svc OrchestratorHandler, opts ...connect.HandlerOpti
 		connect.WithSchema(orchestratorMethods.ByName("ListEvaluationResults")),
 		connect.WithHandlerOptions(opts...),
 	)
+	orchestratorListSlaBreachesHandler := connect.NewUnaryHandler(
+		OrchestratorListSlaBreachesProcedure,
+		svc.ListSlaBreaches,
+		connect.WithSchema(orchestratorMethods.ByName("ListSlaBreaches")),
+		connect.WithHandlerOptions(opts...),
+	)
+	orchestratorGetCompliancePostureHandler := connect.NewUnaryHandler(
+		OrchestratorGetCompliancePostureProcedure,
+		svc.GetCompliancePosture,
+		connect.WithSchema(orchestratorMethods.ByName("GetCompliancePosture")),
+		connect.WithHandlerOptions(opts...),
+	)
+	orchestratorListAlertsHandler := connect.NewUnaryHandler(
+		OrchestratorListAlertsProcedure,
+		svc.ListAlerts,
+		connect.WithSchema(orchestratorMethods.ByName("ListAlerts")),
+		connect.WithHandlerOptions(opts...),
+	)
+	orchestratorUploadAttachmentHandler := connect.NewClientStreamHandler(
+		OrchestratorUploadAttachmentProcedure,
+		svc.UploadAttachment,
+		connect.WithSchema(orchestratorMethods.ByName("UploadAttachment")),
+		connect.WithHandlerOptions(opts...),
+	)
+	orchestratorDownloadAttachmentHandler := connect.NewServerStreamHandler(
+		OrchestratorDownloadAttachmentProcedure,
+		svc.DownloadAttachment,
+		connect.WithSchema(orchestratorMethods.ByName("DownloadAttachment")),
+		connect.WithHandlerOptions(opts...),
+	)
+	orchestratorListAttachmentsHandler := connect.NewUnaryHandler(
+		OrchestratorListAttachmentsProcedure,
+		svc.ListAttachments,
+		connect.WithSchema(orchestratorMethods.ByName("ListAttachments")),
+		connect.WithHandlerOptions(opts...),
+	)
+	orchestratorRemoveAttachmentHandler := connect.NewUnaryHandler(
+		OrchestratorRemoveAttachmentProcedure,
+		svc.RemoveAttachment,
+		connect.WithSchema(orchestratorMethods.ByName("RemoveAttachment")),
+		connect.WithHandlerOptions(opts...),
+	)
+	orchestratorAddEvaluationResultCommentHandler := connect.NewUnaryHandler(
+		OrchestratorAddEvaluationResultCommentProcedure,
+		svc.AddEvaluationResultComment,
+		connect.WithSchema(orchestratorMethods.ByName("AddEvaluationResultComment")),
+		connect.WithHandlerOptions(opts...),
+	)
+	orchestratorListEvaluationResultCommentsHandler := connect.NewUnaryHandler(
+		OrchestratorListEvaluationResultCommentsProcedure,
+		svc.ListEvaluationResultComments,
+		connect.WithSchema(orchestratorMethods.ByName("ListEvaluationResultComments")),
+		connect.WithHandlerOptions(opts...),
+	)
+	orchestratorRemoveEvaluationResultCommentHandler := connect.NewUnaryHandler(
+		OrchestratorRemoveEvaluationResultCommentProcedure,
+		svc.RemoveEvaluationResultComment,
+		connect.WithSchema(orchestratorMethods.ByName("RemoveEvaluationResultComment")),
+		connect.WithHandlerOptions(opts...),
+	)
+	orchestratorCreateSavedViewHandler := connect.NewUnaryHandler(
+		OrchestratorCreateSavedViewProcedure,
+		svc.CreateSavedView,
+		connect.WithSchema(orchestratorMethods.ByName("CreateSavedView")),
+		connect.WithHandlerOptions(opts...),
+	)
+	orchestratorGetSavedViewHandler := connect.NewUnaryHandler(
+		OrchestratorGetSavedViewProcedure,
+		svc.GetSavedView,
+		connect.WithSchema(orchestratorMethods.ByName("GetSavedView")),
+		connect.WithHandlerOptions(opts...),
+	)
+	orchestratorListSavedViewsHandler := connect.NewUnaryHandler(
+		OrchestratorListSavedViewsProcedure,
+		svc.ListSavedViews,
+		connect.WithSchema(orchestratorMethods.ByName("ListSavedViews")),
+		connect.WithHandlerOptions(opts...),
+	)
+	orchestratorUpdateSavedViewHandler := connect.NewUnaryHandler(
+		OrchestratorUpdateSavedViewProcedure,
+		svc.UpdateSavedView,
+		connect.WithSchema(orchestratorMethods.ByName("UpdateSavedView")),
+		connect.WithHandlerOptions(opts...),
+	)
+	orchestratorRemoveSavedViewHandler := connect.NewUnaryHandler(
+		OrchestratorRemoveSavedViewProcedure,
+		svc.RemoveSavedView,
+		connect.WithSchema(orchestratorMethods.ByName("RemoveSavedView")),
+		connect.WithHandlerOptions(opts...),
+	)
 	orchestratorCreateMetricHandler := connect.NewUnaryHandler(
 		OrchestratorCreateMetricProcedure,
 		svc.CreateMetric,
@@ -1444,12 +3116,102 @@ func NewOrchestratorHandler(svc OrchestratorHandler, opts ...connect.HandlerOpti
 		connect.WithSchema(orchestratorMethods.ByName("RemoveTargetOfEvaluation")),
 		connect.WithHandlerOptions(opts...),
 	)
+	orchestratorMergeTargetsOfEvaluationHandler := connect.NewUnaryHandler(
+		OrchestratorMergeTargetsOfEvaluationProcedure,
+		svc.MergeTargetsOfEvaluation,
+		connect.WithSchema(orchestratorMethods.ByName("MergeTargetsOfEvaluation")),
+		connect.WithHandlerOptions(opts...),
+	)
 	orchestratorGetTargetOfEvaluationStatisticsHandler := connect.NewUnaryHandler(
 		OrchestratorGetTargetOfEvaluationStatisticsProcedure,
 		svc.GetTargetOfEvaluationStatistics,
 		connect.WithSchema(orchestratorMethods.ByName("GetTargetOfEvaluationStatistics")),
 		connect.WithHandlerOptions(opts...),
 	)
+	orchestratorGetUsageReportHandler := connect.NewUnaryHandler(
+		OrchestratorGetUsageReportProcedure,
+		svc.GetUsageReport,
+		connect.WithSchema(orchestratorMethods.ByName("GetUsageReport")),
+		connect.WithHandlerOptions(opts...),
+	)
+	orchestratorCreateSecretHandler := connect.NewUnaryHandler(
+		OrchestratorCreateSecretProcedure,
+		svc.CreateSecret,
+		connect.WithSchema(orchestratorMethods.ByName("CreateSecret")),
+		connect.WithHandlerOptions(opts...),
+	)
+	orchestratorListSecretsHandler := connect.NewUnaryHandler(
+		OrchestratorListSecretsProcedure,
+		svc.ListSecrets,
+		connect.WithSchema(orchestratorMethods.ByName("ListSecrets")),
+		connect.WithHandlerOptions(opts...),
+	)
+	orchestratorUpdateSecretHandler := connect.NewUnaryHandler(
+		OrchestratorUpdateSecretProcedure,
+		svc.UpdateSecret,
+		connect.WithSchema(orchestratorMethods.ByName("UpdateSecret")),
+		connect.WithHandlerOptions(opts...),
+	)
+	orchestratorRemoveSecretHandler := connect.NewUnaryHandler(
+		OrchestratorRemoveSecretProcedure,
+		svc.RemoveSecret,
+		connect.WithSchema(orchestratorMethods.ByName("RemoveSecret")),
+		connect.WithHandlerOptions(opts...),
+	)
+	orchestratorAccessSecretHandler := connect.NewUnaryHandler(
+		OrchestratorAccessSecretProcedure,
+		svc.AccessSecret,
+		connect.WithSchema(orchestratorMethods.ByName("AccessSecret")),
+		connect.WithHandlerOptions(opts...),
+	)
+	orchestratorStoreResourceInventoryHandler := connect.NewUnaryHandler(
+		OrchestratorStoreResourceInventoryProcedure,
+		svc.StoreResourceInventory,
+		connect.WithSchema(orchestratorMethods.ByName("StoreResourceInventory")),
+		connect.WithHandlerOptions(opts...),
+	)
+	orchestratorListResourceInventoriesHandler := connect.NewUnaryHandler(
+		OrchestratorListResourceInventoriesProcedure,
+		svc.ListResourceInventories,
+		connect.WithSchema(orchestratorMethods.ByName("ListResourceInventories")),
+		connect.WithHandlerOptions(opts...),
+	)
+	orchestratorUpdateMetadataFieldHandler := connect.NewUnaryHandler(
+		OrchestratorUpdateMetadataFieldProcedure,
+		svc.UpdateMetadataField,
+		connect.WithSchema(orchestratorMethods.ByName("UpdateMetadataField")),
+		connect.WithHandlerOptions(opts...),
+	)
+	orchestratorListMetadataFieldsHandler := connect.NewUnaryHandler(
+		OrchestratorListMetadataFieldsProcedure,
+		svc.ListMetadataFields,
+		connect.WithSchema(orchestratorMethods.ByName("ListMetadataFields")),
+		connect.WithHandlerOptions(opts...),
+	)
+	orchestratorRemoveMetadataFieldHandler := connect.NewUnaryHandler(
+		OrchestratorRemoveMetadataFieldProcedure,
+		svc.RemoveMetadataField,
+		connect.WithSchema(orchestratorMethods.ByName("RemoveMetadataField")),
+		connect.WithHandlerOptions(opts...),
+	)
+	orchestratorUpdateRedactionRuleHandler := connect.NewUnaryHandler(
+		OrchestratorUpdateRedactionRuleProcedure,
+		svc.UpdateRedactionRule,
+		connect.WithSchema(orchestratorMethods.ByName("UpdateRedactionRule")),
+		connect.WithHandlerOptions(opts...),
+	)
+	orchestratorListRedactionRulesHandler := connect.NewUnaryHandler(
+		OrchestratorListRedactionRulesProcedure,
+		svc.ListRedactionRules,
+		connect.WithSchema(orchestratorMethods.ByName("ListRedactionRules")),
+		connect.WithHandlerOptions(opts...),
+	)
+	orchestratorRemoveRedactionRuleHandler := connect.NewUnaryHandler(
+		OrchestratorRemoveRedactionRuleProcedure,
+		svc.RemoveRedactionRule,
+		connect.WithSchema(orchestratorMethods.ByName("RemoveRedactionRule")),
+		connect.WithHandlerOptions(opts...),
+	)
 	orchestratorUpdateMetricConfigurationHandler := connect.NewUnaryHandler(
 		OrchestratorUpdateMetricConfigurationProcedure,
 		svc.UpdateMetricConfiguration,
@@ -1462,12 +3224,36 @@ func NewOrchestratorHandler(svc OrchestratorHandler, opts ...connect.HandlerOpti
 		connect.WithSchema(orchestratorMethods.ByName("GetMetricConfiguration")),
 		connect.WithHandlerOptions(opts...),
 	)
+	orchestratorResolveMetricConfigurationHandler := connect.NewUnaryHandler(
+		OrchestratorResolveMetricConfigurationProcedure,
+		svc.ResolveMetricConfiguration,
+		connect.WithSchema(orchestratorMethods.ByName("ResolveMetricConfiguration")),
+		connect.WithHandlerOptions(opts...),
+	)
 	orchestratorListMetricConfigurationsHandler := connect.NewUnaryHandler(
 		OrchestratorListMetricConfigurationsProcedure,
 		svc.ListMetricConfigurations,
 		connect.WithSchema(orchestratorMethods.ByName("ListMetricConfigurations")),
 		connect.WithHandlerOptions(opts...),
 	)
+	orchestratorUpdateCatalogMetricConfigurationHandler := connect.NewUnaryHandler(
+		OrchestratorUpdateCatalogMetricConfigurationProcedure,
+		svc.UpdateCatalogMetricConfiguration,
+		connect.WithSchema(orchestratorMethods.ByName("UpdateCatalogMetricConfiguration")),
+		connect.WithHandlerOptions(opts...),
+	)
+	orchestratorListCatalogMetricConfigurationsHandler := connect.NewUnaryHandler(
+		OrchestratorListCatalogMetricConfigurationsProcedure,
+		svc.ListCatalogMetricConfigurations,
+		connect.WithSchema(orchestratorMethods.ByName("ListCatalogMetricConfigurations")),
+		connect.WithHandlerOptions(opts...),
+	)
+	orchestratorRemoveCatalogMetricConfigurationHandler := connect.NewUnaryHandler(
+		OrchestratorRemoveCatalogMetricConfigurationProcedure,
+		svc.RemoveCatalogMetricConfiguration,
+		connect.WithSchema(orchestratorMethods.ByName("RemoveCatalogMetricConfiguration")),
+		connect.WithHandlerOptions(opts...),
+	)
 	orchestratorUpdateMetricImplementationHandler := connect.NewUnaryHandler(
 		OrchestratorUpdateMetricImplementationProcedure,
 		svc.UpdateMetricImplementation,
@@ -1486,6 +3272,30 @@ func NewOrchestratorHandler(svc OrchestratorHandler, opts ...connect.HandlerOpti
 		connect.WithSchema(orchestratorMethods.ByName("Subscribe")),
 		connect.WithHandlerOptions(opts...),
 	)
+	orchestratorCreateWebhookHandler := connect.NewUnaryHandler(
+		OrchestratorCreateWebhookProcedure,
+		svc.CreateWebhook,
+		connect.WithSchema(orchestratorMethods.ByName("CreateWebhook")),
+		connect.WithHandlerOptions(opts...),
+	)
+	orchestratorListWebhooksHandler := connect.NewUnaryHandler(
+		OrchestratorListWebhooksProcedure,
+		svc.ListWebhooks,
+		connect.WithSchema(orchestratorMethods.ByName("ListWebhooks")),
+		connect.WithHandlerOptions(opts...),
+	)
+	orchestratorRemoveWebhookHandler := connect.NewUnaryHandler(
+		OrchestratorRemoveWebhookProcedure,
+		svc.RemoveWebhook,
+		connect.WithSchema(orchestratorMethods.ByName("RemoveWebhook")),
+		connect.WithHandlerOptions(opts...),
+	)
+	orchestratorListWebhookDeliveriesHandler := connect.NewUnaryHandler(
+		OrchestratorListWebhookDeliveriesProcedure,
+		svc.ListWebhookDeliveries,
+		connect.WithSchema(orchestratorMethods.ByName("ListWebhookDeliveries")),
+		connect.WithHandlerOptions(opts...),
+	)
 	orchestratorCreateCertificateHandler := connect.NewUnaryHandler(
 		OrchestratorCreateCertificateProcedure,
 		svc.CreateCertificate,
@@ -1540,6 +3350,12 @@ func NewOrchestratorHandler(svc OrchestratorHandler, opts ...connect.HandlerOpti
 		connect.WithSchema(orchestratorMethods.ByName("GetCatalog")),
 		connect.WithHandlerOptions(opts...),
 	)
+	orchestratorGetCatalogTreeHandler := connect.NewUnaryHandler(
+		OrchestratorGetCatalogTreeProcedure,
+		svc.GetCatalogTree,
+		connect.WithSchema(orchestratorMethods.ByName("GetCatalogTree")),
+		connect.WithHandlerOptions(opts...),
+	)
 	orchestratorRemoveCatalogHandler := connect.NewUnaryHandler(
 		OrchestratorRemoveCatalogProcedure,
 		svc.RemoveCatalog,
@@ -1552,6 +3368,96 @@ func NewOrchestratorHandler(svc OrchestratorHandler, opts ...connect.HandlerOpti
 		connect.WithSchema(orchestratorMethods.ByName("UpdateCatalog")),
 		connect.WithHandlerOptions(opts...),
 	)
+	orchestratorPublishCatalogHandler := connect.NewUnaryHandler(
+		OrchestratorPublishCatalogProcedure,
+		svc.PublishCatalog,
+		connect.WithSchema(orchestratorMethods.ByName("PublishCatalog")),
+		connect.WithHandlerOptions(opts...),
+	)
+	orchestratorDiscardCatalogDraftHandler := connect.NewUnaryHandler(
+		OrchestratorDiscardCatalogDraftProcedure,
+		svc.DiscardCatalogDraft,
+		connect.WithSchema(orchestratorMethods.ByName("DiscardCatalogDraft")),
+		connect.WithHandlerOptions(opts...),
+	)
+	orchestratorListCatalogChangelogsHandler := connect.NewUnaryHandler(
+		OrchestratorListCatalogChangelogsProcedure,
+		svc.ListCatalogChangelogs,
+		connect.WithSchema(orchestratorMethods.ByName("ListCatalogChangelogs")),
+		connect.WithHandlerOptions(opts...),
+	)
+	orchestratorGetCatalogChangelogHandler := connect.NewUnaryHandler(
+		OrchestratorGetCatalogChangelogProcedure,
+		svc.GetCatalogChangelog,
+		connect.WithSchema(orchestratorMethods.ByName("GetCatalogChangelog")),
+		connect.WithHandlerOptions(opts...),
+	)
+	orchestratorImportControlMetricMappingHandler := connect.NewUnaryHandler(
+		OrchestratorImportControlMetricMappingProcedure,
+		svc.ImportControlMetricMapping,
+		connect.WithSchema(orchestratorMethods.ByName("ImportControlMetricMapping")),
+		connect.WithHandlerOptions(opts...),
+	)
+	orchestratorUpdateAssuranceLevelHandler := connect.NewUnaryHandler(
+		OrchestratorUpdateAssuranceLevelProcedure,
+		svc.UpdateAssuranceLevel,
+		connect.WithSchema(orchestratorMethods.ByName("UpdateAssuranceLevel")),
+		connect.WithHandlerOptions(opts...),
+	)
+	orchestratorListAssuranceLevelsHandler := connect.NewUnaryHandler(
+		OrchestratorListAssuranceLevelsProcedure,
+		svc.ListAssuranceLevels,
+		connect.WithSchema(orchestratorMethods.ByName("ListAssuranceLevels")),
+		connect.WithHandlerOptions(opts...),
+	)
+	orchestratorRemoveAssuranceLevelHandler := connect.NewUnaryHandler(
+		OrchestratorRemoveAssuranceLevelProcedure,
+		svc.RemoveAssuranceLevel,
+		connect.WithSchema(orchestratorMethods.ByName("RemoveAssuranceLevel")),
+		connect.WithHandlerOptions(opts...),
+	)
+	orchestratorUpdateApplicabilityRuleHandler := connect.NewUnaryHandler(
+		OrchestratorUpdateApplicabilityRuleProcedure,
+		svc.UpdateApplicabilityRule,
+		connect.WithSchema(orchestratorMethods.ByName("UpdateApplicabilityRule")),
+		connect.WithHandlerOptions(opts...),
+	)
+	orchestratorListApplicabilityRulesHandler := connect.NewUnaryHandler(
+		OrchestratorListApplicabilityRulesProcedure,
+		svc.ListApplicabilityRules,
+		connect.WithSchema(orchestratorMethods.ByName("ListApplicabilityRules")),
+		connect.WithHandlerOptions(opts...),
+	)
+	orchestratorRemoveApplicabilityRuleHandler := connect.NewUnaryHandler(
+		OrchestratorRemoveApplicabilityRuleProcedure,
+		svc.RemoveApplicabilityRule,
+		connect.WithSchema(orchestratorMethods.ByName("RemoveApplicabilityRule")),
+		connect.WithHandlerOptions(opts...),
+	)
+	orchestratorCreateCatalogSourceHandler := connect.NewUnaryHandler(
+		OrchestratorCreateCatalogSourceProcedure,
+		svc.CreateCatalogSource,
+		connect.WithSchema(orchestratorMethods.ByName("CreateCatalogSource")),
+		connect.WithHandlerOptions(opts...),
+	)
+	orchestratorListCatalogSourcesHandler := connect.NewUnaryHandler(
+		OrchestratorListCatalogSourcesProcedure,
+		svc.ListCatalogSources,
+		connect.WithSchema(orchestratorMethods.ByName("ListCatalogSources")),
+		connect.WithHandlerOptions(opts...),
+	)
+	orchestratorRemoveCatalogSourceHandler := connect.NewUnaryHandler(
+		OrchestratorRemoveCatalogSourceProcedure,
+		svc.RemoveCatalogSource,
+		connect.WithSchema(orchestratorMethods.ByName("RemoveCatalogSource")),
+		connect.WithHandlerOptions(opts...),
+	)
+	orchestratorSyncCatalogSourceHandler := connect.NewUnaryHandler(
+		OrchestratorSyncCatalogSourceProcedure,
+		svc.SyncCatalogSource,
+		connect.WithSchema(orchestratorMethods.ByName("SyncCatalogSource")),
+		connect.WithHandlerOptions(opts...),
+	)
 	orchestratorGetCategoryHandler := connect.NewUnaryHandler(
 		OrchestratorGetCategoryProcedure,
 		svc.GetCategory,
@@ -1570,6 +3476,18 @@ func NewOrchestratorHandler(svc OrchestratorHandler, opts ...connect.HandlerOpti
 		connect.WithSchema(orchestratorMethods.ByName("GetControl")),
 		connect.WithHandlerOptions(opts...),
 	)
+	orchestratorListControlTextVersionsHandler := connect.NewUnaryHandler(
+		OrchestratorListControlTextVersionsProcedure,
+		svc.ListControlTextVersions,
+		connect.WithSchema(orchestratorMethods.ByName("ListControlTextVersions")),
+		connect.WithHandlerOptions(opts...),
+	)
+	orchestratorRenderControlTextHandler := connect.NewUnaryHandler(
+		OrchestratorRenderControlTextProcedure,
+		svc.RenderControlText,
+		connect.WithSchema(orchestratorMethods.ByName("RenderControlText")),
+		connect.WithHandlerOptions(opts...),
+	)
 	orchestratorCreateAuditScopeHandler := connect.NewUnaryHandler(
 		OrchestratorCreateAuditScopeProcedure,
 		svc.CreateAuditScope,
@@ -1600,6 +3518,48 @@ func NewOrchestratorHandler(svc OrchestratorHandler, opts ...connect.HandlerOpti
 		connect.WithSchema(orchestratorMethods.ByName("RemoveAuditScope")),
 		connect.WithHandlerOptions(opts...),
 	)
+	orchestratorCreateMaintenanceWindowHandler := connect.NewUnaryHandler(
+		OrchestratorCreateMaintenanceWindowProcedure,
+		svc.CreateMaintenanceWindow,
+		connect.WithSchema(orchestratorMethods.ByName("CreateMaintenanceWindow")),
+		connect.WithHandlerOptions(opts...),
+	)
+	orchestratorListMaintenanceWindowsHandler := connect.NewUnaryHandler(
+		OrchestratorListMaintenanceWindowsProcedure,
+		svc.ListMaintenanceWindows,
+		connect.WithSchema(orchestratorMethods.ByName("ListMaintenanceWindows")),
+		connect.WithHandlerOptions(opts...),
+	)
+	orchestratorRemoveMaintenanceWindowHandler := connect.NewUnaryHandler(
+		OrchestratorRemoveMaintenanceWindowProcedure,
+		svc.RemoveMaintenanceWindow,
+		connect.WithSchema(orchestratorMethods.ByName("RemoveMaintenanceWindow")),
+		connect.WithHandlerOptions(opts...),
+	)
+	orchestratorExportOSCALHandler := connect.NewUnaryHandler(
+		OrchestratorExportOSCALProcedure,
+		svc.ExportOSCAL,
+		connect.WithSchema(orchestratorMethods.ByName("ExportOSCAL")),
+		connect.WithHandlerOptions(opts...),
+	)
+	orchestratorArchiveAuditScopeDataHandler := connect.NewUnaryHandler(
+		OrchestratorArchiveAuditScopeDataProcedure,
+		svc.ArchiveAuditScopeData,
+		connect.WithSchema(orchestratorMethods.ByName("ArchiveAuditScopeData")),
+		connect.WithHandlerOptions(opts...),
+	)
+	orchestratorRestoreAuditScopeDataHandler := connect.NewUnaryHandler(
+		OrchestratorRestoreAuditScopeDataProcedure,
+		svc.RestoreAuditScopeData,
+		connect.WithSchema(orchestratorMethods.ByName("RestoreAuditScopeData")),
+		connect.WithHandlerOptions(opts...),
+	)
+	orchestratorGetSchemaHandler := connect.NewUnaryHandler(
+		OrchestratorGetSchemaProcedure,
+		svc.GetSchema,
+		connect.WithSchema(orchestratorMethods.ByName("GetSchema")),
+		connect.WithHandlerOptions(opts...),
+	)
 	orchestratorGetRuntimeInfoHandler := connect.NewUnaryHandler(
 		OrchestratorGetRuntimeInfoProcedure,
 		svc.GetRuntimeInfo,
@@ -1636,6 +3596,18 @@ func NewOrchestratorHandler(svc OrchestratorHandler, opts ...connect.HandlerOpti
 		connect.WithSchema(orchestratorMethods.ByName("ListUsers")),
 		connect.WithHandlerOptions(opts...),
 	)
+	orchestratorResolveUserHandler := connect.NewUnaryHandler(
+		OrchestratorResolveUserProcedure,
+		svc.ResolveUser,
+		connect.WithSchema(orchestratorMethods.ByName("ResolveUser")),
+		connect.WithHandlerOptions(opts...),
+	)
+	orchestratorSyncUsersHandler := connect.NewUnaryHandler(
+		OrchestratorSyncUsersProcedure,
+		svc.SyncUsers,
+		connect.WithSchema(orchestratorMethods.ByName("SyncUsers")),
+		connect.WithHandlerOptions(opts...),
+	)
 	orchestratorListUserPermissionsHandler := connect.NewUnaryHandler(
 		OrchestratorListUserPermissionsProcedure,
 		svc.ListUserPermissions,
@@ -1712,14 +3684,52 @@ func NewOrchestratorHandler(svc OrchestratorHandler, opts ...connect.HandlerOpti
 			orchestratorStoreAssessmentResultHandler.ServeHTTP(w, r)
 		case OrchestratorStoreAssessmentResultsProcedure:
 			orchestratorStoreAssessmentResultsHandler.ServeHTTP(w, r)
+		case OrchestratorBatchStoreAssessmentResultsProcedure:
+			orchestratorBatchStoreAssessmentResultsHandler.ServeHTTP(w, r)
 		case OrchestratorGetAssessmentResultProcedure:
 			orchestratorGetAssessmentResultHandler.ServeHTTP(w, r)
+		case OrchestratorWaiveAssessmentResultProcedure:
+			orchestratorWaiveAssessmentResultHandler.ServeHTTP(w, r)
+		case OrchestratorRevokeAssessmentResultWaiverProcedure:
+			orchestratorRevokeAssessmentResultWaiverHandler.ServeHTTP(w, r)
+		case OrchestratorImportExternalFindingsProcedure:
+			orchestratorImportExternalFindingsHandler.ServeHTTP(w, r)
 		case OrchestratorStoreEvaluationResultProcedure:
 			orchestratorStoreEvaluationResultHandler.ServeHTTP(w, r)
 		case OrchestratorListAssessmentResultsProcedure:
 			orchestratorListAssessmentResultsHandler.ServeHTTP(w, r)
 		case OrchestratorListEvaluationResultsProcedure:
 			orchestratorListEvaluationResultsHandler.ServeHTTP(w, r)
+		case OrchestratorListSlaBreachesProcedure:
+			orchestratorListSlaBreachesHandler.ServeHTTP(w, r)
+		case OrchestratorGetCompliancePostureProcedure:
+			orchestratorGetCompliancePostureHandler.ServeHTTP(w, r)
+		case OrchestratorListAlertsProcedure:
+			orchestratorListAlertsHandler.ServeHTTP(w, r)
+		case OrchestratorUploadAttachmentProcedure:
+			orchestratorUploadAttachmentHandler.ServeHTTP(w, r)
+		case OrchestratorDownloadAttachmentProcedure:
+			orchestratorDownloadAttachmentHandler.ServeHTTP(w, r)
+		case OrchestratorListAttachmentsProcedure:
+			orchestratorListAttachmentsHandler.ServeHTTP(w, r)
+		case OrchestratorRemoveAttachmentProcedure:
+			orchestratorRemoveAttachmentHandler.ServeHTTP(w, r)
+		case OrchestratorAddEvaluationResultCommentProcedure:
+			orchestratorAddEvaluationResultCommentHandler.ServeHTTP(w, r)
+		case OrchestratorListEvaluationResultCommentsProcedure:
+			orchestratorListEvaluationResultCommentsHandler.ServeHTTP(w, r)
+		case OrchestratorRemoveEvaluationResultCommentProcedure:
+			orchestratorRemoveEvaluationResultCommentHandler.ServeHTTP(w, r)
+		case OrchestratorCreateSavedViewProcedure:
+			orchestratorCreateSavedViewHandler.ServeHTTP(w, r)
+		case OrchestratorGetSavedViewProcedure:
+			orchestratorGetSavedViewHandler.ServeHTTP(w, r)
+		case OrchestratorListSavedViewsProcedure:
+			orchestratorListSavedViewsHandler.ServeHTTP(w, r)
+		case OrchestratorUpdateSavedViewProcedure:
+			orchestratorUpdateSavedViewHandler.ServeHTTP(w, r)
+		case OrchestratorRemoveSavedViewProcedure:
+			orchestratorRemoveSavedViewHandler.ServeHTTP(w, r)
 		case OrchestratorCreateMetricProcedure:
 			orchestratorCreateMetricHandler.ServeHTTP(w, r)
 		case OrchestratorUpdateMetricProcedure:
@@ -1740,20 +3750,66 @@ func NewOrchestratorHandler(svc OrchestratorHandler, opts ...connect.HandlerOpti
 			orchestratorListTargetsOfEvaluationHandler.ServeHTTP(w, r)
 		case OrchestratorRemoveTargetOfEvaluationProcedure:
 			orchestratorRemoveTargetOfEvaluationHandler.ServeHTTP(w, r)
+		case OrchestratorMergeTargetsOfEvaluationProcedure:
+			orchestratorMergeTargetsOfEvaluationHandler.ServeHTTP(w, r)
 		case OrchestratorGetTargetOfEvaluationStatisticsProcedure:
 			orchestratorGetTargetOfEvaluationStatisticsHandler.ServeHTTP(w, r)
+		case OrchestratorGetUsageReportProcedure:
+			orchestratorGetUsageReportHandler.ServeHTTP(w, r)
+		case OrchestratorCreateSecretProcedure:
+			orchestratorCreateSecretHandler.ServeHTTP(w, r)
+		case OrchestratorListSecretsProcedure:
+			orchestratorListSecretsHandler.ServeHTTP(w, r)
+		case OrchestratorUpdateSecretProcedure:
+			orchestratorUpdateSecretHandler.ServeHTTP(w, r)
+		case OrchestratorRemoveSecretProcedure:
+			orchestratorRemoveSecretHandler.ServeHTTP(w, r)
+		case OrchestratorAccessSecretProcedure:
+			orchestratorAccessSecretHandler.ServeHTTP(w, r)
+		case OrchestratorStoreResourceInventoryProcedure:
+			orchestratorStoreResourceInventoryHandler.ServeHTTP(w, r)
+		case OrchestratorListResourceInventoriesProcedure:
+			orchestratorListResourceInventoriesHandler.ServeHTTP(w, r)
+		case OrchestratorUpdateMetadataFieldProcedure:
+			orchestratorUpdateMetadataFieldHandler.ServeHTTP(w, r)
+		case OrchestratorListMetadataFieldsProcedure:
+			orchestratorListMetadataFieldsHandler.ServeHTTP(w, r)
+		case OrchestratorRemoveMetadataFieldProcedure:
+			orchestratorRemoveMetadataFieldHandler.ServeHTTP(w, r)
+		case OrchestratorUpdateRedactionRuleProcedure:
+			orchestratorUpdateRedactionRuleHandler.ServeHTTP(w, r)
+		case OrchestratorListRedactionRulesProcedure:
+			orchestratorListRedactionRulesHandler.ServeHTTP(w, r)
+		case OrchestratorRemoveRedactionRuleProcedure:
+			orchestratorRemoveRedactionRuleHandler.ServeHTTP(w, r)
 		case OrchestratorUpdateMetricConfigurationProcedure:
 			orchestratorUpdateMetricConfigurationHandler.ServeHTTP(w, r)
 		case OrchestratorGetMetricConfigurationProcedure:
 			orchestratorGetMetricConfigurationHandler.ServeHTTP(w, r)
+		case OrchestratorResolveMetricConfigurationProcedure:
+			orchestratorResolveMetricConfigurationHandler.ServeHTTP(w, r)
 		case OrchestratorListMetricConfigurationsProcedure:
 			orchestratorListMetricConfigurationsHandler.ServeHTTP(w, r)
+		case OrchestratorUpdateCatalogMetricConfigurationProcedure:
+			orchestratorUpdateCatalogMetricConfigurationHandler.ServeHTTP(w, r)
+		case OrchestratorListCatalogMetricConfigurationsProcedure:
+			orchestratorListCatalogMetricConfigurationsHandler.ServeHTTP(w, r)
+		case OrchestratorRemoveCatalogMetricConfigurationProcedure:
+			orchestratorRemoveCatalogMetricConfigurationHandler.ServeHTTP(w, r)
 		case OrchestratorUpdateMetricImplementationProcedure:
 			orchestratorUpdateMetricImplementationHandler.ServeHTTP(w, r)
 		case OrchestratorGetMetricImplementationProcedure:
 			orchestratorGetMetricImplementationHandler.ServeHTTP(w, r)
 		case OrchestratorSubscribeProcedure:
 			orchestratorSubscribeHandler.ServeHTTP(w, r)
+		case OrchestratorCreateWebhookProcedure:
+			orchestratorCreateWebhookHandler.ServeHTTP(w, r)
+		case OrchestratorListWebhooksProcedure:
+			orchestratorListWebhooksHandler.ServeHTTP(w, r)
+		case OrchestratorRemoveWebhookProcedure:
+			orchestratorRemoveWebhookHandler.ServeHTTP(w, r)
+		case OrchestratorListWebhookDeliveriesProcedure:
+			orchestratorListWebhookDeliveriesHandler.ServeHTTP(w, r)
 		case OrchestratorCreateCertificateProcedure:
 			orchestratorCreateCertificateHandler.ServeHTTP(w, r)
 		case OrchestratorGetCertificateProcedure:
@@ -1772,16 +3828,52 @@ func NewOrchestratorHandler(svc OrchestratorHandler, opts ...connect.HandlerOpti
 			orchestratorListCatalogsHandler.ServeHTTP(w, r)
 		case OrchestratorGetCatalogProcedure:
 			orchestratorGetCatalogHandler.ServeHTTP(w, r)
+		case OrchestratorGetCatalogTreeProcedure:
+			orchestratorGetCatalogTreeHandler.ServeHTTP(w, r)
 		case OrchestratorRemoveCatalogProcedure:
 			orchestratorRemoveCatalogHandler.ServeHTTP(w, r)
 		case OrchestratorUpdateCatalogProcedure:
 			orchestratorUpdateCatalogHandler.ServeHTTP(w, r)
+		case OrchestratorPublishCatalogProcedure:
+			orchestratorPublishCatalogHandler.ServeHTTP(w, r)
+		case OrchestratorDiscardCatalogDraftProcedure:
+			orchestratorDiscardCatalogDraftHandler.ServeHTTP(w, r)
+		case OrchestratorListCatalogChangelogsProcedure:
+			orchestratorListCatalogChangelogsHandler.ServeHTTP(w, r)
+		case OrchestratorGetCatalogChangelogProcedure:
+			orchestratorGetCatalogChangelogHandler.ServeHTTP(w, r)
+		case OrchestratorImportControlMetricMappingProcedure:
+			orchestratorImportControlMetricMappingHandler.ServeHTTP(w, r)
+		case OrchestratorUpdateAssuranceLevelProcedure:
+			orchestratorUpdateAssuranceLevelHandler.ServeHTTP(w, r)
+		case OrchestratorListAssuranceLevelsProcedure:
+			orchestratorListAssuranceLevelsHandler.ServeHTTP(w, r)
+		case OrchestratorRemoveAssuranceLevelProcedure:
+			orchestratorRemoveAssuranceLevelHandler.ServeHTTP(w, r)
+		case OrchestratorUpdateApplicabilityRuleProcedure:
+			orchestratorUpdateApplicabilityRuleHandler.ServeHTTP(w, r)
+		case OrchestratorListApplicabilityRulesProcedure:
+			orchestratorListApplicabilityRulesHandler.ServeHTTP(w, r)
+		case OrchestratorRemoveApplicabilityRuleProcedure:
+			orchestratorRemoveApplicabilityRuleHandler.ServeHTTP(w, r)
+		case OrchestratorCreateCatalogSourceProcedure:
+			orchestratorCreateCatalogSourceHandler.ServeHTTP(w, r)
+		case OrchestratorListCatalogSourcesProcedure:
+			orchestratorListCatalogSourcesHandler.ServeHTTP(w, r)
+		case OrchestratorRemoveCatalogSourceProcedure:
+			orchestratorRemoveCatalogSourceHandler.ServeHTTP(w, r)
+		case OrchestratorSyncCatalogSourceProcedure:
+			orchestratorSyncCatalogSourceHandler.ServeHTTP(w, r)
 		case OrchestratorGetCategoryProcedure:
 			orchestratorGetCategoryHandler.ServeHTTP(w, r)
 		case OrchestratorListControlsProcedure:
 			orchestratorListControlsHandler.ServeHTTP(w, r)
 		case OrchestratorGetControlProcedure:
 			orchestratorGetControlHandler.ServeHTTP(w, r)
+		case OrchestratorListControlTextVersionsProcedure:
+			orchestratorListControlTextVersionsHandler.ServeHTTP(w, r)
+		case OrchestratorRenderControlTextProcedure:
+			orchestratorRenderControlTextHandler.ServeHTTP(w, r)
 		case OrchestratorCreateAuditScopeProcedure:
 			orchestratorCreateAuditScopeHandler.ServeHTTP(w, r)
 		case OrchestratorGetAuditScopeProcedure:
@@ -1792,6 +3884,20 @@ func NewOrchestratorHandler(svc OrchestratorHandler, opts ...connect.HandlerOpti
 			orchestratorUpdateAuditScopeHandler.ServeHTTP(w, r)
 		case OrchestratorRemoveAuditScopeProcedure:
 			orchestratorRemoveAuditScopeHandler.ServeHTTP(w, r)
+		case OrchestratorCreateMaintenanceWindowProcedure:
+			orchestratorCreateMaintenanceWindowHandler.ServeHTTP(w, r)
+		case OrchestratorListMaintenanceWindowsProcedure:
+			orchestratorListMaintenanceWindowsHandler.ServeHTTP(w, r)
+		case OrchestratorRemoveMaintenanceWindowProcedure:
+			orchestratorRemoveMaintenanceWindowHandler.ServeHTTP(w, r)
+		case OrchestratorExportOSCALProcedure:
+			orchestratorExportOSCALHandler.ServeHTTP(w, r)
+		case OrchestratorArchiveAuditScopeDataProcedure:
+			orchestratorArchiveAuditScopeDataHandler.ServeHTTP(w, r)
+		case OrchestratorRestoreAuditScopeDataProcedure:
+			orchestratorRestoreAuditScopeDataHandler.ServeHTTP(w, r)
+		case OrchestratorGetSchemaProcedure:
+			orchestratorGetSchemaHandler.ServeHTTP(w, r)
 		case OrchestratorGetRuntimeInfoProcedure:
 			orchestratorGetRuntimeInfoHandler.ServeHTTP(w, r)
 		case OrchestratorUpsertUserPermissionProcedure:
@@ -1804,6 +3910,10 @@ func NewOrchestratorHandler(svc OrchestratorHandler, opts ...connect.HandlerOpti
 			orchestratorGetUserHandler.ServeHTTP(w, r)
 		case OrchestratorListUsersProcedure:
 			orchestratorListUsersHandler.ServeHTTP(w, r)
+		case OrchestratorResolveUserProcedure:
+			orchestratorResolveUserHandler.ServeHTTP(w, r)
+		case OrchestratorSyncUsersProcedure:
+			orchestratorSyncUsersHandler.ServeHTTP(w, r)
 		case OrchestratorListUserPermissionsProcedure:
 			orchestratorListUserPermissionsHandler.ServeHTTP(w, r)
 		case OrchestratorListUserRolesProcedure:
@@ -1861,10 +3971,26 @@ func (UnimplementedOrchestratorHandler) StoreAssessmentResults(context.Context,
 	return connect.NewError(connect.CodeUnimplemented, errors.New("confirmate.orchestrator.v1.Orchestrator.StoreAssessmentResults is not implemented"))
 }
 
+func (UnimplementedOrchestratorHandler) BatchStoreAssessmentResults(context.Context, *connect.Request[orchestrator.BatchStoreAssessmentResultsRequest]) (*connect.Response[orchestrator.BatchStoreAssessmentResultsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("confirmate.orchestrator.v1.Orchestrator.BatchStoreAssessmentResults is not implemented"))
+}
+
 func (UnimplementedOrchestratorHandler) GetAssessmentResult(context.Context, *connect.Request[orchestrator.GetAssessmentResultRequest]) (*connect.Response[assessment.AssessmentResult], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("confirmate.orchestrator.v1.Orchestrator.GetAssessmentResult is not implemented"))
 }
 
+func (UnimplementedOrchestratorHandler) WaiveAssessmentResult(context.Context, *connect.Request[orchestrator.WaiveAssessmentResultRequest]) (*connect.Response[assessment.AssessmentResult], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("confirmate.orchestrator.v1.Orchestrator.WaiveAssessmentResult is not implemented"))
+}
+
+func (UnimplementedOrchestratorHandler) RevokeAssessmentResultWaiver(context.Context, *connect.Request[orchestrator.RevokeAssessmentResultWaiverRequest]) (*connect.Response[assessment.AssessmentResult], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("confirmate.orchestrator.v1.Orchestrator.RevokeAssessmentResultWaiver is not implemented"))
+}
+
+func (UnimplementedOrchestratorHandler) ImportExternalFindings(context.Context, *connect.Request[orchestrator.ImportExternalFindingsRequest]) (*connect.Response[orchestrator.ImportExternalFindingsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("confirmate.orchestrator.v1.Orchestrator.ImportExternalFindings is not implemented"))
+}
+
 func (UnimplementedOrchestratorHandler) StoreEvaluationResult(context.Context, *connect.Request[orchestrator.StoreEvaluationResultRequest]) (*connect.Response[evaluation.EvaluationResult], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("confirmate.orchestrator.v1.Orchestrator.StoreEvaluationResult is not implemented"))
 }
@@ -1877,6 +4003,66 @@ func (UnimplementedOrchestratorHandler) ListEvaluationResults(context.Context, *
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("confirmate.orchestrator.v1.Orchestrator.ListEvaluationResults is not implemented"))
 }
 
+func (UnimplementedOrchestratorHandler) ListSlaBreaches(context.Context, *connect.Request[orchestrator.ListSlaBreachesRequest]) (*connect.Response[orchestrator.ListSlaBreachesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("confirmate.orchestrator.v1.Orchestrator.ListSlaBreaches is not implemented"))
+}
+
+func (UnimplementedOrchestratorHandler) GetCompliancePosture(context.Context, *connect.Request[orchestrator.GetCompliancePostureRequest]) (*connect.Response[orchestrator.CompliancePosture], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("confirmate.orchestrator.v1.Orchestrator.GetCompliancePosture is not implemented"))
+}
+
+func (UnimplementedOrchestratorHandler) ListAlerts(context.Context, *connect.Request[orchestrator.ListAlertsRequest]) (*connect.Response[orchestrator.ListAlertsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("confirmate.orchestrator.v1.Orchestrator.ListAlerts is not implemented"))
+}
+
+func (UnimplementedOrchestratorHandler) UploadAttachment(context.Context, *connect.ClientStream[orchestrator.UploadAttachmentRequest]) (*connect.Response[evaluation.Attachment], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("confirmate.orchestrator.v1.Orchestrator.UploadAttachment is not implemented"))
+}
+
+func (UnimplementedOrchestratorHandler) DownloadAttachment(context.Context, *connect.Request[orchestrator.DownloadAttachmentRequest], *connect.ServerStream[orchestrator.DownloadAttachmentResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("confirmate.orchestrator.v1.Orchestrator.DownloadAttachment is not implemented"))
+}
+
+func (UnimplementedOrchestratorHandler) ListAttachments(context.Context, *connect.Request[orchestrator.ListAttachmentsRequest]) (*connect.Response[orchestrator.ListAttachmentsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("confirmate.orchestrator.v1.Orchestrator.ListAttachments is not implemented"))
+}
+
+func (UnimplementedOrchestratorHandler) RemoveAttachment(context.Context, *connect.Request[orchestrator.RemoveAttachmentRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("confirmate.orchestrator.v1.Orchestrator.RemoveAttachment is not implemented"))
+}
+
+func (UnimplementedOrchestratorHandler) AddEvaluationResultComment(context.Context, *connect.Request[orchestrator.AddEvaluationResultCommentRequest]) (*connect.Response[evaluation.Comment], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("confirmate.orchestrator.v1.Orchestrator.AddEvaluationResultComment is not implemented"))
+}
+
+func (UnimplementedOrchestratorHandler) ListEvaluationResultComments(context.Context, *connect.Request[orchestrator.ListEvaluationResultCommentsRequest]) (*connect.Response[orchestrator.ListEvaluationResultCommentsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("confirmate.orchestrator.v1.Orchestrator.ListEvaluationResultComments is not implemented"))
+}
+
+func (UnimplementedOrchestratorHandler) RemoveEvaluationResultComment(context.Context, *connect.Request[orchestrator.RemoveEvaluationResultCommentRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("confirmate.orchestrator.v1.Orchestrator.RemoveEvaluationResultComment is not implemented"))
+}
+
+func (UnimplementedOrchestratorHandler) CreateSavedView(context.Context, *connect.Request[orchestrator.CreateSavedViewRequest]) (*connect.Response[orchestrator.SavedView], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("confirmate.orchestrator.v1.Orchestrator.CreateSavedView is not implemented"))
+}
+
+func (UnimplementedOrchestratorHandler) GetSavedView(context.Context, *connect.Request[orchestrator.GetSavedViewRequest]) (*connect.Response[orchestrator.SavedView], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("confirmate.orchestrator.v1.Orchestrator.GetSavedView is not implemented"))
+}
+
+func (UnimplementedOrchestratorHandler) ListSavedViews(context.Context, *connect.Request[orchestrator.ListSavedViewsRequest]) (*connect.Response[orchestrator.ListSavedViewsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("confirmate.orchestrator.v1.Orchestrator.ListSavedViews is not implemented"))
+}
+
+func (UnimplementedOrchestratorHandler) UpdateSavedView(context.Context, *connect.Request[orchestrator.UpdateSavedViewRequest]) (*connect.Response[orchestrator.SavedView], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("confirmate.orchestrator.v1.Orchestrator.UpdateSavedView is not implemented"))
+}
+
+func (UnimplementedOrchestratorHandler) RemoveSavedView(context.Context, *connect.Request[orchestrator.RemoveSavedViewRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("confirmate.orchestrator.v1.Orchestrator.RemoveSavedView is not implemented"))
+}
+
 func (UnimplementedOrchestratorHandler) CreateMetric(context.Context, *connect.Request[orchestrator.CreateMetricRequest]) (*connect.Response[assessment.Metric], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("confirmate.orchestrator.v1.Orchestrator.CreateMetric is not implemented"))
 }
@@ -1917,10 +4103,70 @@ func (UnimplementedOrchestratorHandler) RemoveTargetOfEvaluation(context.Context
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("confirmate.orchestrator.v1.Orchestrator.RemoveTargetOfEvaluation is not implemented"))
 }
 
+func (UnimplementedOrchestratorHandler) MergeTargetsOfEvaluation(context.Context, *connect.Request[orchestrator.MergeTargetsOfEvaluationRequest]) (*connect.Response[orchestrator.MergeTargetsOfEvaluationResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("confirmate.orchestrator.v1.Orchestrator.MergeTargetsOfEvaluation is not implemented"))
+}
+
 func (UnimplementedOrchestratorHandler) GetTargetOfEvaluationStatistics(context.Context, *connect.Request[orchestrator.GetTargetOfEvaluationStatisticsRequest]) (*connect.Response[orchestrator.GetTargetOfEvaluationStatisticsResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("confirmate.orchestrator.v1.Orchestrator.GetTargetOfEvaluationStatistics is not implemented"))
 }
 
+func (UnimplementedOrchestratorHandler) GetUsageReport(context.Context, *connect.Request[orchestrator.GetUsageReportRequest]) (*connect.Response[orchestrator.UsageReport], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("confirmate.orchestrator.v1.Orchestrator.GetUsageReport is not implemented"))
+}
+
+func (UnimplementedOrchestratorHandler) CreateSecret(context.Context, *connect.Request[orchestrator.CreateSecretRequest]) (*connect.Response[orchestrator.Secret], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("confirmate.orchestrator.v1.Orchestrator.CreateSecret is not implemented"))
+}
+
+func (UnimplementedOrchestratorHandler) ListSecrets(context.Context, *connect.Request[orchestrator.ListSecretsRequest]) (*connect.Response[orchestrator.ListSecretsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("confirmate.orchestrator.v1.Orchestrator.ListSecrets is not implemented"))
+}
+
+func (UnimplementedOrchestratorHandler) UpdateSecret(context.Context, *connect.Request[orchestrator.UpdateSecretRequest]) (*connect.Response[orchestrator.Secret], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("confirmate.orchestrator.v1.Orchestrator.UpdateSecret is not implemented"))
+}
+
+func (UnimplementedOrchestratorHandler) RemoveSecret(context.Context, *connect.Request[orchestrator.RemoveSecretRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("confirmate.orchestrator.v1.Orchestrator.RemoveSecret is not implemented"))
+}
+
+func (UnimplementedOrchestratorHandler) AccessSecret(context.Context, *connect.Request[orchestrator.AccessSecretRequest]) (*connect.Response[orchestrator.SecretValue], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("confirmate.orchestrator.v1.Orchestrator.AccessSecret is not implemented"))
+}
+
+func (UnimplementedOrchestratorHandler) StoreResourceInventory(context.Context, *connect.Request[orchestrator.StoreResourceInventoryRequest]) (*connect.Response[orchestrator.ResourceInventory], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("confirmate.orchestrator.v1.Orchestrator.StoreResourceInventory is not implemented"))
+}
+
+func (UnimplementedOrchestratorHandler) ListResourceInventories(context.Context, *connect.Request[orchestrator.ListResourceInventoriesRequest]) (*connect.Response[orchestrator.ListResourceInventoriesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("confirmate.orchestrator.v1.Orchestrator.ListResourceInventories is not implemented"))
+}
+
+func (UnimplementedOrchestratorHandler) UpdateMetadataField(context.Context, *connect.Request[orchestrator.UpdateMetadataFieldRequest]) (*connect.Response[orchestrator.MetadataField], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("confirmate.orchestrator.v1.Orchestrator.UpdateMetadataField is not implemented"))
+}
+
+func (UnimplementedOrchestratorHandler) ListMetadataFields(context.Context, *connect.Request[orchestrator.ListMetadataFieldsRequest]) (*connect.Response[orchestrator.ListMetadataFieldsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("confirmate.orchestrator.v1.Orchestrator.ListMetadataFields is not implemented"))
+}
+
+func (UnimplementedOrchestratorHandler) RemoveMetadataField(context.Context, *connect.Request[orchestrator.RemoveMetadataFieldRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("confirmate.orchestrator.v1.Orchestrator.RemoveMetadataField is not implemented"))
+}
+
+func (UnimplementedOrchestratorHandler) UpdateRedactionRule(context.Context, *connect.Request[orchestrator.UpdateRedactionRuleRequest]) (*connect.Response[orchestrator.RedactionRule], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("confirmate.orchestrator.v1.Orchestrator.UpdateRedactionRule is not implemented"))
+}
+
+func (UnimplementedOrchestratorHandler) ListRedactionRules(context.Context, *connect.Request[orchestrator.ListRedactionRulesRequest]) (*connect.Response[orchestrator.ListRedactionRulesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("confirmate.orchestrator.v1.Orchestrator.ListRedactionRules is not implemented"))
+}
+
+func (UnimplementedOrchestratorHandler) RemoveRedactionRule(context.Context, *connect.Request[orchestrator.RemoveRedactionRuleRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("confirmate.orchestrator.v1.Orchestrator.RemoveRedactionRule is not implemented"))
+}
+
 func (UnimplementedOrchestratorHandler) UpdateMetricConfiguration(context.Context, *connect.Request[orchestrator.UpdateMetricConfigurationRequest]) (*connect.Response[assessment.MetricConfiguration], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("confirmate.orchestrator.v1.Orchestrator.UpdateMetricConfiguration is not implemented"))
 }
@@ -1929,10 +4175,26 @@ func (UnimplementedOrchestratorHandler) GetMetricConfiguration(context.Context,
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("confirmate.orchestrator.v1.Orchestrator.GetMetricConfiguration is not implemented"))
 }
 
+func (UnimplementedOrchestratorHandler) ResolveMetricConfiguration(context.Context, *connect.Request[orchestrator.ResolveMetricConfigurationRequest]) (*connect.Response[orchestrator.ResolveMetricConfigurationResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("confirmate.orchestrator.v1.Orchestrator.ResolveMetricConfiguration is not implemented"))
+}
+
 func (UnimplementedOrchestratorHandler) ListMetricConfigurations(context.Context, *connect.Request[orchestrator.ListMetricConfigurationRequest]) (*connect.Response[orchestrator.ListMetricConfigurationResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("confirmate.orchestrator.v1.Orchestrator.ListMetricConfigurations is not implemented"))
 }
 
+func (UnimplementedOrchestratorHandler) UpdateCatalogMetricConfiguration(context.Context, *connect.Request[orchestrator.UpdateCatalogMetricConfigurationRequest]) (*connect.Response[assessment.CatalogMetricConfiguration], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("confirmate.orchestrator.v1.Orchestrator.UpdateCatalogMetricConfiguration is not implemented"))
+}
+
+func (UnimplementedOrchestratorHandler) ListCatalogMetricConfigurations(context.Context, *connect.Request[orchestrator.ListCatalogMetricConfigurationsRequest]) (*connect.Response[orchestrator.ListCatalogMetricConfigurationsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("confirmate.orchestrator.v1.Orchestrator.ListCatalogMetricConfigurations is not implemented"))
+}
+
+func (UnimplementedOrchestratorHandler) RemoveCatalogMetricConfiguration(context.Context, *connect.Request[orchestrator.RemoveCatalogMetricConfigurationRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("confirmate.orchestrator.v1.Orchestrator.RemoveCatalogMetricConfiguration is not implemented"))
+}
+
 func (UnimplementedOrchestratorHandler) UpdateMetricImplementation(context.Context, *connect.Request[orchestrator.UpdateMetricImplementationRequest]) (*connect.Response[assessment.MetricImplementation], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("confirmate.orchestrator.v1.Orchestrator.UpdateMetricImplementation is not implemented"))
 }
@@ -1945,6 +4207,22 @@ func (UnimplementedOrchestratorHandler) Subscribe(context.Context, *connect.Requ
 	return connect.NewError(connect.CodeUnimplemented, errors.New("confirmate.orchestrator.v1.Orchestrator.Subscribe is not implemented"))
 }
 
+func (UnimplementedOrchestratorHandler) CreateWebhook(context.Context, *connect.Request[orchestrator.CreateWebhookRequest]) (*connect.Response[orchestrator.Webhook], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("confirmate.orchestrator.v1.Orchestrator.CreateWebhook is not implemented"))
+}
+
+func (UnimplementedOrchestratorHandler) ListWebhooks(context.Context, *connect.Request[orchestrator.ListWebhooksRequest]) (*connect.Response[orchestrator.ListWebhooksResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("confirmate.orchestrator.v1.Orchestrator.ListWebhooks is not implemented"))
+}
+
+func (UnimplementedOrchestratorHandler) RemoveWebhook(context.Context, *connect.Request[orchestrator.RemoveWebhookRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("confirmate.orchestrator.v1.Orchestrator.RemoveWebhook is not implemented"))
+}
+
+func (UnimplementedOrchestratorHandler) ListWebhookDeliveries(context.Context, *connect.Request[orchestrator.ListWebhookDeliveriesRequest]) (*connect.Response[orchestrator.ListWebhookDeliveriesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("confirmate.orchestrator.v1.Orchestrator.ListWebhookDeliveries is not implemented"))
+}
+
 func (UnimplementedOrchestratorHandler) CreateCertificate(context.Context, *connect.Request[orchestrator.CreateCertificateRequest]) (*connect.Response[orchestrator.Certificate], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("confirmate.orchestrator.v1.Orchestrator.CreateCertificate is not implemented"))
 }
@@ -1981,6 +4259,10 @@ func (UnimplementedOrchestratorHandler) GetCatalog(context.Context, *connect.Req
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("confirmate.orchestrator.v1.Orchestrator.GetCatalog is not implemented"))
 }
 
+func (UnimplementedOrchestratorHandler) GetCatalogTree(context.Context, *connect.Request[orchestrator.GetCatalogTreeRequest]) (*connect.Response[orchestrator.GetCatalogTreeResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("confirmate.orchestrator.v1.Orchestrator.GetCatalogTree is not implemented"))
+}
+
 func (UnimplementedOrchestratorHandler) RemoveCatalog(context.Context, *connect.Request[orchestrator.RemoveCatalogRequest]) (*connect.Response[emptypb.Empty], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("confirmate.orchestrator.v1.Orchestrator.RemoveCatalog is not implemented"))
 }
@@ -1989,6 +4271,66 @@ func (UnimplementedOrchestratorHandler) UpdateCatalog(context.Context, *connect.
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("confirmate.orchestrator.v1.Orchestrator.UpdateCatalog is not implemented"))
 }
 
+func (UnimplementedOrchestratorHandler) PublishCatalog(context.Context, *connect.Request[orchestrator.PublishCatalogRequest]) (*connect.Response[orchestrator.Catalog], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("confirmate.orchestrator.v1.Orchestrator.PublishCatalog is not implemented"))
+}
+
+func (UnimplementedOrchestratorHandler) DiscardCatalogDraft(context.Context, *connect.Request[orchestrator.DiscardCatalogDraftRequest]) (*connect.Response[orchestrator.Catalog], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("confirmate.orchestrator.v1.Orchestrator.DiscardCatalogDraft is not implemented"))
+}
+
+func (UnimplementedOrchestratorHandler) ListCatalogChangelogs(context.Context, *connect.Request[orchestrator.ListCatalogChangelogsRequest]) (*connect.Response[orchestrator.ListCatalogChangelogsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("confirmate.orchestrator.v1.Orchestrator.ListCatalogChangelogs is not implemented"))
+}
+
+func (UnimplementedOrchestratorHandler) GetCatalogChangelog(context.Context, *connect.Request[orchestrator.GetCatalogChangelogRequest]) (*connect.Response[orchestrator.CatalogChangelog], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("confirmate.orchestrator.v1.Orchestrator.GetCatalogChangelog is not implemented"))
+}
+
+func (UnimplementedOrchestratorHandler) ImportControlMetricMapping(context.Context, *connect.Request[orchestrator.ImportControlMetricMappingRequest]) (*connect.Response[orchestrator.ImportControlMetricMappingResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("confirmate.orchestrator.v1.Orchestrator.ImportControlMetricMapping is not implemented"))
+}
+
+func (UnimplementedOrchestratorHandler) UpdateAssuranceLevel(context.Context, *connect.Request[orchestrator.UpdateAssuranceLevelRequest]) (*connect.Response[orchestrator.AssuranceLevel], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("confirmate.orchestrator.v1.Orchestrator.UpdateAssuranceLevel is not implemented"))
+}
+
+func (UnimplementedOrchestratorHandler) ListAssuranceLevels(context.Context, *connect.Request[orchestrator.ListAssuranceLevelsRequest]) (*connect.Response[orchestrator.ListAssuranceLevelsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("confirmate.orchestrator.v1.Orchestrator.ListAssuranceLevels is not implemented"))
+}
+
+func (UnimplementedOrchestratorHandler) RemoveAssuranceLevel(context.Context, *connect.Request[orchestrator.RemoveAssuranceLevelRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("confirmate.orchestrator.v1.Orchestrator.RemoveAssuranceLevel is not implemented"))
+}
+
+func (UnimplementedOrchestratorHandler) UpdateApplicabilityRule(context.Context, *connect.Request[orchestrator.UpdateApplicabilityRuleRequest]) (*connect.Response[orchestrator.ApplicabilityRule], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("confirmate.orchestrator.v1.Orchestrator.UpdateApplicabilityRule is not implemented"))
+}
+
+func (UnimplementedOrchestratorHandler) ListApplicabilityRules(context.Context, *connect.Request[orchestrator.ListApplicabilityRulesRequest]) (*connect.Response[orchestrator.ListApplicabilityRulesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("confirmate.orchestrator.v1.Orchestrator.ListApplicabilityRules is not implemented"))
+}
+
+func (UnimplementedOrchestratorHandler) RemoveApplicabilityRule(context.Context, *connect.Request[orchestrator.RemoveApplicabilityRuleRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("confirmate.orchestrator.v1.Orchestrator.RemoveApplicabilityRule is not implemented"))
+}
+
+func (UnimplementedOrchestratorHandler) CreateCatalogSource(context.Context, *connect.Request[orchestrator.CreateCatalogSourceRequest]) (*connect.Response[orchestrator.CatalogSource], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("confirmate.orchestrator.v1.Orchestrator.CreateCatalogSource is not implemented"))
+}
+
+func (UnimplementedOrchestratorHandler) ListCatalogSources(context.Context, *connect.Request[orchestrator.ListCatalogSourcesRequest]) (*connect.Response[orchestrator.ListCatalogSourcesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("confirmate.orchestrator.v1.Orchestrator.ListCatalogSources is not implemented"))
+}
+
+func (UnimplementedOrchestratorHandler) RemoveCatalogSource(context.Context, *connect.Request[orchestrator.RemoveCatalogSourceRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("confirmate.orchestrator.v1.Orchestrator.RemoveCatalogSource is not implemented"))
+}
+
+func (UnimplementedOrchestratorHandler) SyncCatalogSource(context.Context, *connect.Request[orchestrator.SyncCatalogSourceRequest]) (*connect.Response[orchestrator.CatalogSyncReport], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("confirmate.orchestrator.v1.Orchestrator.SyncCatalogSource is not implemented"))
+}
+
 func (UnimplementedOrchestratorHandler) GetCategory(context.Context, *connect.Request[orchestrator.GetCategoryRequest]) (*connect.Response[orchestrator.Category], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("confirmate.orchestrator.v1.Orchestrator.GetCategory is not implemented"))
 }
@@ -2001,6 +4343,14 @@ func (UnimplementedOrchestratorHandler) GetControl(context.Context, *connect.Req
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("confirmate.orchestrator.v1.Orchestrator.GetControl is not implemented"))
 }
 
+func (UnimplementedOrchestratorHandler) ListControlTextVersions(context.Context, *connect.Request[orchestrator.ListControlTextVersionsRequest]) (*connect.Response[orchestrator.ListControlTextVersionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("confirmate.orchestrator.v1.Orchestrator.ListControlTextVersions is not implemented"))
+}
+
+func (UnimplementedOrchestratorHandler) RenderControlText(context.Context, *connect.Request[orchestrator.RenderControlTextRequest]) (*connect.Response[orchestrator.RenderControlTextResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("confirmate.orchestrator.v1.Orchestrator.RenderControlText is not implemented"))
+}
+
 func (UnimplementedOrchestratorHandler) CreateAuditScope(context.Context, *connect.Request[orchestrator.CreateAuditScopeRequest]) (*connect.Response[orchestrator.AuditScope], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("confirmate.orchestrator.v1.Orchestrator.CreateAuditScope is not implemented"))
 }
@@ -2021,6 +4371,34 @@ func (UnimplementedOrchestratorHandler) RemoveAuditScope(context.Context, *conne
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("confirmate.orchestrator.v1.Orchestrator.RemoveAuditScope is not implemented"))
 }
 
+func (UnimplementedOrchestratorHandler) CreateMaintenanceWindow(context.Context, *connect.Request[orchestrator.CreateMaintenanceWindowRequest]) (*connect.Response[orchestrator.MaintenanceWindow], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("confirmate.orchestrator.v1.Orchestrator.CreateMaintenanceWindow is not implemented"))
+}
+
+func (UnimplementedOrchestratorHandler) ListMaintenanceWindows(context.Context, *connect.Request[orchestrator.ListMaintenanceWindowsRequest]) (*connect.Response[orchestrator.ListMaintenanceWindowsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("confirmate.orchestrator.v1.Orchestrator.ListMaintenanceWindows is not implemented"))
+}
+
+func (UnimplementedOrchestratorHandler) RemoveMaintenanceWindow(context.Context, *connect.Request[orchestrator.RemoveMaintenanceWindowRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("confirmate.orchestrator.v1.Orchestrator.RemoveMaintenanceWindow is not implemented"))
+}
+
+func (UnimplementedOrchestratorHandler) ExportOSCAL(context.Context, *connect.Request[orchestrator.ExportOSCALRequest]) (*connect.Response[orchestrator.ExportOSCALResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("confirmate.orchestrator.v1.Orchestrator.ExportOSCAL is not implemented"))
+}
+
+func (UnimplementedOrchestratorHandler) ArchiveAuditScopeData(context.Context, *connect.Request[orchestrator.ArchiveAuditScopeDataRequest]) (*connect.Response[orchestrator.ArchiveAuditScopeDataResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("confirmate.orchestrator.v1.Orchestrator.ArchiveAuditScopeData is not implemented"))
+}
+
+func (UnimplementedOrchestratorHandler) RestoreAuditScopeData(context.Context, *connect.Request[orchestrator.RestoreAuditScopeDataRequest]) (*connect.Response[orchestrator.RestoreAuditScopeDataResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("confirmate.orchestrator.v1.Orchestrator.RestoreAuditScopeData is not implemented"))
+}
+
+func (UnimplementedOrchestratorHandler) GetSchema(context.Context, *connect.Request[orchestrator.GetSchemaRequest]) (*connect.Response[orchestrator.Schema], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("confirmate.orchestrator.v1.Orchestrator.GetSchema is not implemented"))
+}
+
 func (UnimplementedOrchestratorHandler) GetRuntimeInfo(context.Context, *connect.Request[common.GetRuntimeInfoRequest]) (*connect.Response[common.Runtime], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("confirmate.orchestrator.v1.Orchestrator.GetRuntimeInfo is not implemented"))
 }
@@ -2045,6 +4423,14 @@ func (UnimplementedOrchestratorHandler) ListUsers(context.Context, *connect.Requ
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("confirmate.orchestrator.v1.Orchestrator.ListUsers is not implemented"))
 }
 
+func (UnimplementedOrchestratorHandler) ResolveUser(context.Context, *connect.Request[orchestrator.ResolveUserRequest]) (*connect.Response[orchestrator.User], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("confirmate.orchestrator.v1.Orchestrator.ResolveUser is not implemented"))
+}
+
+func (UnimplementedOrchestratorHandler) SyncUsers(context.Context, *connect.Request[orchestrator.SyncUsersRequest]) (*connect.Response[orchestrator.SyncUsersResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("confirmate.orchestrator.v1.Orchestrator.SyncUsers is not implemented"))
+}
+
 func (UnimplementedOrchestratorHandler) ListUserPermissions(context.Context, *connect.Request[orchestrator.ListUserPermissionsRequest]) (*connect.Response[orchestrator.ListUserPermissionsResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("confirmate.orchestrator.v1.Orchestrator.ListUserPermissions is not implemented"))
 }
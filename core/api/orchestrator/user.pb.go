@@ -39,6 +39,58 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// UserSource describes how a user was provisioned in the system.
+type UserSource int32
+
+const (
+	UserSource_USER_SOURCE_UNSPECIFIED UserSource = 0
+	// The user was provisioned just-in-time from the claims of an OIDC token.
+	UserSource_USER_SOURCE_OIDC UserSource = 1
+	// The user was synchronized from a SCIM user directory.
+	UserSource_USER_SOURCE_SCIM UserSource = 2
+)
+
+// Enum value maps for UserSource.
+var (
+	UserSource_name = map[int32]string{
+		0: "USER_SOURCE_UNSPECIFIED",
+		1: "USER_SOURCE_OIDC",
+		2: "USER_SOURCE_SCIM",
+	}
+	UserSource_value = map[string]int32{
+		"USER_SOURCE_UNSPECIFIED": 0,
+		"USER_SOURCE_OIDC":        1,
+		"USER_SOURCE_SCIM":        2,
+	}
+)
+
+func (x UserSource) Enum() *UserSource {
+	p := new(UserSource)
+	*p = x
+	return p
+}
+
+func (x UserSource) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (UserSource) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_orchestrator_user_proto_enumTypes[0].Descriptor()
+}
+
+func (UserSource) Type() protoreflect.EnumType {
+	return &file_api_orchestrator_user_proto_enumTypes[0]
+}
+
+func (x UserSource) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use UserSource.Descriptor instead.
+func (UserSource) EnumDescriptor() ([]byte, []int) {
+	return file_api_orchestrator_user_proto_rawDescGZIP(), []int{0}
+}
+
 // Predefined roles in the system. These should align with the roles defined in the IdP
 type Role int32
 
@@ -97,11 +149,11 @@ func (x Role) String() string {
 }
 
 func (Role) Descriptor() protoreflect.EnumDescriptor {
-	return file_api_orchestrator_user_proto_enumTypes[0].Descriptor()
+	return file_api_orchestrator_user_proto_enumTypes[1].Descriptor()
 }
 
 func (Role) Type() protoreflect.EnumType {
-	return &file_api_orchestrator_user_proto_enumTypes[0]
+	return &file_api_orchestrator_user_proto_enumTypes[1]
 }
 
 func (x Role) Number() protoreflect.EnumNumber {
@@ -110,7 +162,7 @@ func (x Role) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Role.Descriptor instead.
 func (Role) EnumDescriptor() ([]byte, []int) {
-	return file_api_orchestrator_user_proto_rawDescGZIP(), []int{0}
+	return file_api_orchestrator_user_proto_rawDescGZIP(), []int{1}
 }
 
 // ObjectType represents the type of the entity that changed in the orchestrator.
@@ -134,6 +186,11 @@ const (
 	ObjectType_OBJECT_TYPE_EVALUATION_RESULT     ObjectType = 14
 	ObjectType_OBJECT_TYPE_EVIDENCE              ObjectType = 15
 	ObjectType_OBJECT_TYPE_CONTROL_IN_SCOPE      ObjectType = 16
+	ObjectType_OBJECT_TYPE_METADATA_FIELD        ObjectType = 17
+	ObjectType_OBJECT_TYPE_PSEUDONYM             ObjectType = 18
+	ObjectType_OBJECT_TYPE_COLLECTOR             ObjectType = 19
+	ObjectType_OBJECT_TYPE_REDACTION_RULE        ObjectType = 20
+	ObjectType_OBJECT_TYPE_CONSISTENCY_REPORT    ObjectType = 21
 )
 
 // Enum value maps for ObjectType.
@@ -156,6 +213,11 @@ var (
 		14: "OBJECT_TYPE_EVALUATION_RESULT",
 		15: "OBJECT_TYPE_EVIDENCE",
 		16: "OBJECT_TYPE_CONTROL_IN_SCOPE",
+		17: "OBJECT_TYPE_METADATA_FIELD",
+		18: "OBJECT_TYPE_PSEUDONYM",
+		19: "OBJECT_TYPE_COLLECTOR",
+		20: "OBJECT_TYPE_REDACTION_RULE",
+		21: "OBJECT_TYPE_CONSISTENCY_REPORT",
 	}
 	ObjectType_value = map[string]int32{
 		"OBJECT_TYPE_UNSPECIFIED":           0,
@@ -175,6 +237,11 @@ var (
 		"OBJECT_TYPE_EVALUATION_RESULT":     14,
 		"OBJECT_TYPE_EVIDENCE":              15,
 		"OBJECT_TYPE_CONTROL_IN_SCOPE":      16,
+		"OBJECT_TYPE_METADATA_FIELD":        17,
+		"OBJECT_TYPE_PSEUDONYM":             18,
+		"OBJECT_TYPE_COLLECTOR":             19,
+		"OBJECT_TYPE_REDACTION_RULE":        20,
+		"OBJECT_TYPE_CONSISTENCY_REPORT":    21,
 	}
 )
 
@@ -189,11 +256,11 @@ func (x ObjectType) String() string {
 }
 
 func (ObjectType) Descriptor() protoreflect.EnumDescriptor {
-	return file_api_orchestrator_user_proto_enumTypes[1].Descriptor()
+	return file_api_orchestrator_user_proto_enumTypes[2].Descriptor()
 }
 
 func (ObjectType) Type() protoreflect.EnumType {
-	return &file_api_orchestrator_user_proto_enumTypes[1]
+	return &file_api_orchestrator_user_proto_enumTypes[2]
 }
 
 func (x ObjectType) Number() protoreflect.EnumNumber {
@@ -202,7 +269,7 @@ func (x ObjectType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ObjectType.Descriptor instead.
 func (ObjectType) EnumDescriptor() ([]byte, []int) {
-	return file_api_orchestrator_user_proto_rawDescGZIP(), []int{1}
+	return file_api_orchestrator_user_proto_rawDescGZIP(), []int{2}
 }
 
 type UserPermission_Permission int32
@@ -241,11 +308,11 @@ func (x UserPermission_Permission) String() string {
 }
 
 func (UserPermission_Permission) Descriptor() protoreflect.EnumDescriptor {
-	return file_api_orchestrator_user_proto_enumTypes[2].Descriptor()
+	return file_api_orchestrator_user_proto_enumTypes[3].Descriptor()
 }
 
 func (UserPermission_Permission) Type() protoreflect.EnumType {
-	return &file_api_orchestrator_user_proto_enumTypes[2]
+	return &file_api_orchestrator_user_proto_enumTypes[3]
 }
 
 func (x UserPermission_Permission) Number() protoreflect.EnumNumber {
@@ -277,7 +344,16 @@ type User struct {
 	// Attributes contains additional key-value pairs associated with the user, such as department or team.
 	Attributes map[string]string `protobuf:"bytes,8,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value" gorm:"serializer:json"`
 	// LastAccess indicates the last time the user accessed the system.
-	LastAccess    *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=last_access,json=lastAccess,proto3" json:"last_access,omitempty" gorm:"serializer:timestamppb;type:timestamp"`
+	LastAccess *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=last_access,json=lastAccess,proto3" json:"last_access,omitempty" gorm:"serializer:timestamppb;type:timestamp"`
+	// Issuer is the issuer of the identity provider the user originates from, i.e., the "iss" claim.
+	Issuer *string `protobuf:"bytes,11,opt,name=issuer,proto3,oneof" json:"issuer,omitempty"`
+	// Subject is the identifier of the user at the identity provider, i.e., the "sub" claim.
+	Subject *string `protobuf:"bytes,12,opt,name=subject,proto3,oneof" json:"subject,omitempty" gorm:"index"`
+	// ExternalId is the identifier of the user in an external user directory, such as a SCIM
+	// provider, if it differs from the subject.
+	ExternalId *string `protobuf:"bytes,13,opt,name=external_id,json=externalId,proto3,oneof" json:"external_id,omitempty" gorm:"index"`
+	// Source indicates how the user was provisioned.
+	Source        UserSource `protobuf:"varint,14,opt,name=source,proto3,enum=confirmate.orchestrator.v1.UserSource" json:"source,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -375,6 +451,34 @@ func (x *User) GetLastAccess() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *User) GetIssuer() string {
+	if x != nil && x.Issuer != nil {
+		return *x.Issuer
+	}
+	return ""
+}
+
+func (x *User) GetSubject() string {
+	if x != nil && x.Subject != nil {
+		return *x.Subject
+	}
+	return ""
+}
+
+func (x *User) GetExternalId() string {
+	if x != nil && x.ExternalId != nil {
+		return *x.ExternalId
+	}
+	return ""
+}
+
+func (x *User) GetSource() UserSource {
+	if x != nil {
+		return x.Source
+	}
+	return UserSource_USER_SOURCE_UNSPECIFIED
+}
+
 type UserPermission struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// User ID is required to identify the user for whom the perission is being upserted.
@@ -451,7 +555,7 @@ var File_api_orchestrator_user_proto protoreflect.FileDescriptor
 
 const file_api_orchestrator_user_proto_rawDesc = "" +
 	"\n" +
-	"\x1bapi/orchestrator/user.proto\x12\x1aconfirmate.orchestrator.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x13tagger/tagger.proto\"\xf2\x04\n" +
+	"\x1bapi/orchestrator/user.proto\x12\x1aconfirmate.orchestrator.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x13tagger/tagger.proto\"\xeb\x06\n" +
 	"\x04User\x12\x1a\n" +
 	"\x02id\x18\x01 \x01(\tB\n" +
 	"\xe0A\x02\xbaH\x04r\x02\x10\x01R\x02id\x12\x1f\n" +
@@ -468,7 +572,12 @@ const file_api_orchestrator_user_proto_rawDesc = "" +
 	"attributes\x12n\n" +
 	"\vlast_access\x18\n" +
 	" \x01(\v2\x1a.google.protobuf.TimestampB1\x9a\x84\x9e\x03,gorm:\"serializer:timestamppb;type:timestamp\"R\n" +
-	"lastAccess\x1a=\n" +
+	"lastAccess\x12\x1b\n" +
+	"\x06issuer\x18\v \x01(\tH\x04R\x06issuer\x88\x01\x01\x120\n" +
+	"\asubject\x18\f \x01(\tB\x11\x9a\x84\x9e\x03\fgorm:\"index\"H\x05R\asubject\x88\x01\x01\x127\n" +
+	"\vexternal_id\x18\r \x01(\tB\x11\x9a\x84\x9e\x03\fgorm:\"index\"H\x06R\n" +
+	"externalId\x88\x01\x01\x12H\n" +
+	"\x06source\x18\x0e \x01(\x0e2&.confirmate.orchestrator.v1.UserSourceB\b\xbaH\x05\x82\x01\x02\x10\x01R\x06source\x1a=\n" +
 	"\x0fAttributesEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\v\n" +
@@ -476,7 +585,11 @@ const file_api_orchestrator_user_proto_rawDesc = "" +
 	"\x06_emailB\r\n" +
 	"\v_first_nameB\f\n" +
 	"\n" +
-	"_last_name\"\xba\x04\n" +
+	"_last_nameB\t\n" +
+	"\a_issuerB\n" +
+	"\n" +
+	"\b_subjectB\x0e\n" +
+	"\f_external_id\"\xba\x04\n" +
 	"\x0eUserPermission\x12G\n" +
 	"\auser_id\x18\x01 \x01(\tB.\xe0A\x02\xbaH\x03\xc8\x01\x01\x9a\x84\x9e\x03 gorm:\"column:user_id;primaryKey\"R\x06userId\x12U\n" +
 	"\tobject_id\x18\x02 \x01(\tB8\xe0A\x02\xbaH\x05r\x03\xb0\x01\x01\x9a\x84\x9e\x03(gorm:\"column:object_id;primaryKey;index\"R\bobjectId\x12}\n" +
@@ -490,7 +603,12 @@ const file_api_orchestrator_user_proto_rawDesc = "" +
 	"\x16PERMISSION_UNSPECIFIED\x10\x00\x12\x15\n" +
 	"\x11PERMISSION_READER\x10\x01\x12\x1a\n" +
 	"\x16PERMISSION_CONTRIBUTOR\x10\x02\x12\x14\n" +
-	"\x10PERMISSION_ADMIN\x10\x03*\xbc\x02\n" +
+	"\x10PERMISSION_ADMIN\x10\x03*U\n" +
+	"\n" +
+	"UserSource\x12\x1b\n" +
+	"\x17USER_SOURCE_UNSPECIFIED\x10\x00\x12\x14\n" +
+	"\x10USER_SOURCE_OIDC\x10\x01\x12\x14\n" +
+	"\x10USER_SOURCE_SCIM\x10\x02*\xbc\x02\n" +
 	"\x04Role\x12\x14\n" +
 	"\x10ROLE_UNSPECIFIED\x10\x00\x12\x0e\n" +
 	"\n" +
@@ -504,7 +622,7 @@ const file_api_orchestrator_user_proto_rawDesc = "" +
 	"\x16ROLE_TECHNICAL_AUDITOR\x10\b\x12+\n" +
 	"'ROLE_CHIEF_INFORMATION_SECURITY_OFFICER\x10\t\x12\x11\n" +
 	"\rROLE_UI_ADMIN\x10\n" +
-	"*\x94\x04\n" +
+	"*\xae\x05\n" +
 	"\n" +
 	"ObjectType\x12\x1b\n" +
 	"\x17OBJECT_TYPE_UNSPECIFIED\x10\x00\x12\x16\n" +
@@ -524,7 +642,12 @@ const file_api_orchestrator_user_proto_rawDesc = "" +
 	"\x13OBJECT_TYPE_CONTROL\x10\r\x12!\n" +
 	"\x1dOBJECT_TYPE_EVALUATION_RESULT\x10\x0e\x12\x18\n" +
 	"\x14OBJECT_TYPE_EVIDENCE\x10\x0f\x12 \n" +
-	"\x1cOBJECT_TYPE_CONTROL_IN_SCOPE\x10\x10B%Z#confirmate.io/core/api/orchestratorb\x06proto3"
+	"\x1cOBJECT_TYPE_CONTROL_IN_SCOPE\x10\x10\x12\x1e\n" +
+	"\x1aOBJECT_TYPE_METADATA_FIELD\x10\x11\x12\x19\n" +
+	"\x15OBJECT_TYPE_PSEUDONYM\x10\x12\x12\x19\n" +
+	"\x15OBJECT_TYPE_COLLECTOR\x10\x13\x12\x1e\n" +
+	"\x1aOBJECT_TYPE_REDACTION_RULE\x10\x14\x12\"\n" +
+	"\x1eOBJECT_TYPE_CONSISTENCY_REPORT\x10\x15B%Z#confirmate.io/core/api/orchestratorb\x06proto3"
 
 var (
 	file_api_orchestrator_user_proto_rawDescOnce sync.Once
@@ -538,28 +661,30 @@ func file_api_orchestrator_user_proto_rawDescGZIP() []byte {
 	return file_api_orchestrator_user_proto_rawDescData
 }
 
-var file_api_orchestrator_user_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_api_orchestrator_user_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
 var file_api_orchestrator_user_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
 var file_api_orchestrator_user_proto_goTypes = []any{
-	(Role)(0),                      // 0: confirmate.orchestrator.v1.Role
-	(ObjectType)(0),                // 1: confirmate.orchestrator.v1.ObjectType
-	(UserPermission_Permission)(0), // 2: confirmate.orchestrator.v1.UserPermission.Permission
-	(*User)(nil),                   // 3: confirmate.orchestrator.v1.User
-	(*UserPermission)(nil),         // 4: confirmate.orchestrator.v1.UserPermission
-	nil,                            // 5: confirmate.orchestrator.v1.User.AttributesEntry
-	(*timestamppb.Timestamp)(nil),  // 6: google.protobuf.Timestamp
+	(UserSource)(0),                // 0: confirmate.orchestrator.v1.UserSource
+	(Role)(0),                      // 1: confirmate.orchestrator.v1.Role
+	(ObjectType)(0),                // 2: confirmate.orchestrator.v1.ObjectType
+	(UserPermission_Permission)(0), // 3: confirmate.orchestrator.v1.UserPermission.Permission
+	(*User)(nil),                   // 4: confirmate.orchestrator.v1.User
+	(*UserPermission)(nil),         // 5: confirmate.orchestrator.v1.UserPermission
+	nil,                            // 6: confirmate.orchestrator.v1.User.AttributesEntry
+	(*timestamppb.Timestamp)(nil),  // 7: google.protobuf.Timestamp
 }
 var file_api_orchestrator_user_proto_depIdxs = []int32{
-	0, // 0: confirmate.orchestrator.v1.User.roles:type_name -> confirmate.orchestrator.v1.Role
-	5, // 1: confirmate.orchestrator.v1.User.attributes:type_name -> confirmate.orchestrator.v1.User.AttributesEntry
-	6, // 2: confirmate.orchestrator.v1.User.last_access:type_name -> google.protobuf.Timestamp
-	1, // 3: confirmate.orchestrator.v1.UserPermission.object_type:type_name -> confirmate.orchestrator.v1.ObjectType
-	2, // 4: confirmate.orchestrator.v1.UserPermission.permission:type_name -> confirmate.orchestrator.v1.UserPermission.Permission
-	5, // [5:5] is the sub-list for method output_type
-	5, // [5:5] is the sub-list for method input_type
-	5, // [5:5] is the sub-list for extension type_name
-	5, // [5:5] is the sub-list for extension extendee
-	0, // [0:5] is the sub-list for field type_name
+	1, // 0: confirmate.orchestrator.v1.User.roles:type_name -> confirmate.orchestrator.v1.Role
+	6, // 1: confirmate.orchestrator.v1.User.attributes:type_name -> confirmate.orchestrator.v1.User.AttributesEntry
+	7, // 2: confirmate.orchestrator.v1.User.last_access:type_name -> google.protobuf.Timestamp
+	0, // 3: confirmate.orchestrator.v1.User.source:type_name -> confirmate.orchestrator.v1.UserSource
+	2, // 4: confirmate.orchestrator.v1.UserPermission.object_type:type_name -> confirmate.orchestrator.v1.ObjectType
+	3, // 5: confirmate.orchestrator.v1.UserPermission.permission:type_name -> confirmate.orchestrator.v1.UserPermission.Permission
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_api_orchestrator_user_proto_init() }
@@ -573,7 +698,7 @@ func file_api_orchestrator_user_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_orchestrator_user_proto_rawDesc), len(file_api_orchestrator_user_proto_rawDesc)),
-			NumEnums:      3,
+			NumEnums:      4,
 			NumMessages:   3,
 			NumExtensions: 0,
 			NumServices:   0,
@@ -0,0 +1,47 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+import { type Client, type Transport, createClient } from "@connectrpc/connect";
+
+import { Evaluation } from "./gen/api/evaluation/evaluation_pb.js";
+import { Evaluation as EvaluationV2 } from "./gen/api/evaluation/v2/evaluation_pb.js";
+import { EvidenceStore } from "./gen/api/evidence/evidence_store_pb.js";
+import { Orchestrator } from "./gen/api/orchestrator/orchestrator_pb.js";
+
+export * as assessment from "./assessment.js";
+export * as evaluation from "./evaluation.js";
+export * as evaluationv2 from "./evaluationv2.js";
+export * as evidence from "./evidence.js";
+export * as orchestrator from "./orchestrator.js";
+
+// ConfirmateClient bundles the clients of all Confirmate services that are reachable through a
+// single API endpoint.
+export interface ConfirmateClient {
+  evaluation: Client<typeof Evaluation>;
+  evaluationV2: Client<typeof EvaluationV2>;
+  evidenceStore: Client<typeof EvidenceStore>;
+  orchestrator: Client<typeof Orchestrator>;
+}
+
+// createConfirmateClient creates the clients of all Confirmate services using the given transport,
+// e.g., one created with createConnectTransport from @connectrpc/connect-web.
+export function createConfirmateClient(transport: Transport): ConfirmateClient {
+  return {
+    evaluation: createClient(Evaluation, transport),
+    evaluationV2: createClient(EvaluationV2, transport),
+    evidenceStore: createClient(EvidenceStore, transport),
+    orchestrator: createClient(Orchestrator, transport),
+  };
+}
@@ -18,6 +18,7 @@ package auth
 import (
 	"crypto/md5"
 	"encoding/hex"
+	"slices"
 
 	"confirmate.io/core/api/orchestrator"
 
@@ -46,6 +47,12 @@ type OAuthClaims struct {
 	// The presence and value of this claim should be determined by the authentication provider
 	// issuing the token.
 	IsAdminToken bool `json:"cfadmin,omitempty"`
+
+	// TargetOfEvaluationIds holds the IDs of the targets of evaluation the token is restricted to. Like
+	// [OAuthClaims.Roles], it is not populated from JSON unmarshal of the token, but extracted from the
+	// configured claim paths (by default "cftoes") by the auth interceptor. If it is empty, the token is not
+	// restricted to particular targets of evaluation.
+	TargetOfEvaluationIds []string `json:"-"`
 }
 
 // IsAdmin returns whether the claims indicate that the token is an admin token. It checks the
@@ -77,6 +84,22 @@ func (claims *OAuthClaims) HasRole(role orchestrator.Role) bool {
 	return false
 }
 
+// RestrictsTargetOfEvaluations returns whether the claims restrict access to particular targets of evaluation.
+func (claims *OAuthClaims) RestrictsTargetOfEvaluations() bool {
+	return claims != nil && len(claims.TargetOfEvaluationIds) > 0
+}
+
+// AllowsTargetOfEvaluation returns whether the claims allow access to the target of evaluation with the given
+// ID. This is the case if the claims do not restrict access to particular targets of evaluation or if they list
+// the ID.
+func (claims *OAuthClaims) AllowsTargetOfEvaluation(toeId string) bool {
+	if !claims.RestrictsTargetOfEvaluations() {
+		return true
+	}
+
+	return slices.Contains(claims.TargetOfEvaluationIds, toeId)
+}
+
 // GetConfirmateUserIDFromClaims constructs a unique user ID from the claims.
 // It combines a hashed version of the issuer URL and the subject claim to create a unique identifier for the user.
 func GetConfirmateUserIDFromClaims(claims *OAuthClaims) string {
@@ -84,9 +107,19 @@ func GetConfirmateUserIDFromClaims(claims *OAuthClaims) string {
 		return ""
 	}
 
+	return ConfirmateUserID(claims.RegisteredClaims.Issuer, claims.RegisteredClaims.Subject)
+}
+
+// ConfirmateUserID constructs the unique user ID of a user with the given subject at the given
+// issuer. It is the same ID that [GetConfirmateUserIDFromClaims] derives from token claims.
+func ConfirmateUserID(issuer string, subject string) string {
+	if issuer == "" || subject == "" {
+		return ""
+	}
+
 	// Hash the issuer URL so the URL itself is not stored directly.
-	h := md5.Sum([]byte(claims.RegisteredClaims.Issuer))
+	h := md5.Sum([]byte(issuer))
 	hashedIssuer := hex.EncodeToString(h[:])
 
-	return hashedIssuer + "-" + claims.RegisteredClaims.Subject
+	return hashedIssuer + "-" + subject
 }
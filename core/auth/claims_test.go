@@ -61,6 +61,47 @@ func TestGetConfirmateUserIDFromClaims(t *testing.T) {
 	}
 }
 
+func TestConfirmateUserID(t *testing.T) {
+	type args struct {
+		issuer  string
+		subject string
+	}
+	tests := []struct {
+		name string
+		args args
+		want assert.Want[string]
+	}{
+		{
+			name: "empty issuer",
+			args: args{subject: "testSubject"},
+			want: func(t *testing.T, got string, _ ...any) bool {
+				return assert.Equal(t, "", got)
+			},
+		},
+		{
+			name: "empty subject",
+			args: args{issuer: "testIssuer"},
+			want: func(t *testing.T, got string, _ ...any) bool {
+				return assert.Equal(t, "", got)
+			},
+		},
+		{
+			name: "happy path",
+			args: args{issuer: "testIssuer", subject: "testSubject"},
+			want: func(t *testing.T, got string, _ ...any) bool {
+				return assert.Equal(t, orchestratortest.GetConfirmateUserID("testIssuer", "testSubject"), got)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ConfirmateUserID(tt.args.issuer, tt.args.subject)
+
+			tt.want(t, got)
+		})
+	}
+}
+
 func TestOAuthClaimsHasRole(t *testing.T) {
 	tests := []struct {
 		name   string
@@ -166,3 +207,52 @@ func TestOAuthClaimsIsAdmin(t *testing.T) {
 		})
 	}
 }
+
+func TestOAuthClaimsAllowsTargetOfEvaluation(t *testing.T) {
+	tests := []struct {
+		name   string
+		claims *OAuthClaims
+		toeId  string
+		want   assert.Want[bool]
+	}{
+		{
+			name:   "nil claims",
+			claims: nil,
+			toeId:  "toe-1",
+			want: func(t *testing.T, got bool, _ ...any) bool {
+				return assert.True(t, got)
+			},
+		},
+		{
+			name:   "claims not restricted",
+			claims: &OAuthClaims{},
+			toeId:  "toe-1",
+			want: func(t *testing.T, got bool, _ ...any) bool {
+				return assert.True(t, got)
+			},
+		},
+		{
+			name:   "target of evaluation listed",
+			claims: &OAuthClaims{TargetOfEvaluationIds: []string{"toe-1", "toe-2"}},
+			toeId:  "toe-2",
+			want: func(t *testing.T, got bool, _ ...any) bool {
+				return assert.True(t, got)
+			},
+		},
+		{
+			name:   "target of evaluation not listed",
+			claims: &OAuthClaims{TargetOfEvaluationIds: []string{"toe-1"}, IsAdminToken: true},
+			toeId:  "toe-2",
+			want: func(t *testing.T, got bool, _ ...any) bool {
+				return assert.False(t, got)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.claims.AllowsTargetOfEvaluation(tt.toeId)
+
+			tt.want(t, got)
+		})
+	}
+}
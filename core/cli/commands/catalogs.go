@@ -67,6 +67,48 @@ func CatalogsGetCommand() *cli.Command {
 	}
 }
 
+func CatalogsTreeCommand() *cli.Command {
+	return &cli.Command{
+		Name:      "tree",
+		Usage:     "Get the categories, controls and sub-controls of a catalog",
+		ArgsUsage: "<catalog-id>",
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:    "audit-scope",
+				Aliases: []string{"a"},
+				Usage:   "Include the latest evaluation status of each control within the audit scope",
+			},
+			&cli.IntFlag{
+				Name:    "max-depth",
+				Aliases: []string{"d"},
+				Usage:   "Maximum depth of the tree (1 = categories only)",
+			},
+		},
+		Action: func(ctx context.Context, c *cli.Command) error {
+			if c.Args().Len() < 1 {
+				return fmt.Errorf("catalog ID required")
+			}
+
+			req := &orchestrator.GetCatalogTreeRequest{
+				CatalogId: c.Args().Get(0),
+			}
+			if auditScopeID := c.String("audit-scope"); auditScopeID != "" {
+				req.AuditScopeId = &auditScopeID
+			}
+			if c.IsSet("max-depth") {
+				req.MaxDepth = new(int32(c.Int("max-depth")))
+			}
+
+			client := OrchestratorClient(ctx, c)
+			resp, err := client.GetCatalogTree(ctx, connect.NewRequest(req))
+			if err != nil {
+				return err
+			}
+			return PrettyPrint(resp.Msg)
+		},
+	}
+}
+
 func CatalogsRemoveCommand() *cli.Command {
 	return &cli.Command{
 		Name:      "remove",
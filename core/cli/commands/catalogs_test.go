@@ -16,6 +16,7 @@
 package commands_test
 
 import (
+	"strings"
 	"testing"
 
 	"confirmate.io/core/cli/commandstest"
@@ -37,6 +38,13 @@ func TestCatalogsCommands(t *testing.T) {
 		assert.Contains(t, output, orchestratortest.MockCatalogId1)
 	})
 
+	t.Run("tree", func(t *testing.T) {
+		output, err := commandstest.RunCLI(t, "catalogs", "tree", "--max-depth", "2", orchestratortest.MockCatalogId1)
+		assert.NoError(t, err)
+		assert.Contains(t, output, orchestratortest.MockControlShortName1)
+		assert.False(t, strings.Contains(output, orchestratortest.MockSubControlShortName1))
+	})
+
 	t.Run("remove", func(t *testing.T) {
 		output, err := commandstest.RunCLI(t, "catalogs", "remove", orchestratortest.MockCatalogId1)
 		assert.NoError(t, err)
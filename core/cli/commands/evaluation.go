@@ -18,12 +18,17 @@ package commands
 import (
 	"context"
 	"fmt"
+	"os"
+	"strings"
+	"time"
 
 	"confirmate.io/core/api/evaluation"
 	"confirmate.io/core/api/orchestrator"
 
 	"connectrpc.com/connect"
+	"github.com/google/uuid"
 	"github.com/urfave/cli/v3"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func EvaluationResultsListCommand() *cli.Command {
@@ -61,11 +66,19 @@ func EvaluationResultsListCommand() *cli.Command {
 				Aliases: []string{"v"},
 				Usage:   "Filter for valid manual evaluations only",
 			},
+			&cli.StringFlag{
+				Name:  "status",
+				Usage: "Filter by evaluation status, e.g., ERROR or NOT_COMPLIANT",
+			},
 			&cli.StringFlag{
 				Name:    "latest",
 				Aliases: []string{"l"},
 				Usage:   "Filter by latest evaluation result for each control",
 			},
+			&cli.StringFlag{
+				Name:  "view",
+				Usage: "Apply the filter of a saved view by its ID",
+			},
 		}, PaginationFlags()...),
 		Action: func(ctx context.Context, c *cli.Command) error {
 			req := &orchestrator.ListEvaluationResultsRequest{
@@ -74,8 +87,12 @@ func EvaluationResultsListCommand() *cli.Command {
 				LatestByControlId: new(c.Bool("latest")),
 			}
 
+			if viewID := c.String("view"); viewID != "" {
+				req.SavedViewId = &viewID
+			}
+
 			// Apply filters if provided
-			if c.String("target") != "" || c.String("catalog") != "" || c.String("control") != "" || c.String("subcontrol") != "" || c.IsSet("parent") || c.IsSet("valid-manual-only") {
+			if c.String("target") != "" || c.String("catalog") != "" || c.String("control") != "" || c.String("subcontrol") != "" || c.IsSet("parent") || c.IsSet("valid-manual-only") || c.String("status") != "" {
 				filter := &orchestrator.ListEvaluationResultsRequest_Filter{}
 
 				if targetID := c.String("target"); targetID != "" {
@@ -98,6 +115,13 @@ func EvaluationResultsListCommand() *cli.Command {
 					validManualOnly := c.Bool("valid-manual-only")
 					filter.ValidManualOnly = &validManualOnly
 				}
+				if status := c.String("status"); status != "" {
+					evalStatus, err := parseEvaluationStatus(status)
+					if err != nil {
+						return err
+					}
+					filter.Status = &evalStatus
+				}
 				req.Filter = filter
 			}
 
@@ -111,6 +135,21 @@ func EvaluationResultsListCommand() *cli.Command {
 	}
 }
 
+// parseEvaluationStatus parses an evaluation status by its name, with or without the EVALUATION_STATUS_ prefix.
+func parseEvaluationStatus(name string) (status evaluation.EvaluationStatus, err error) {
+	name = strings.ToUpper(name)
+	if !strings.HasPrefix(name, "EVALUATION_STATUS_") {
+		name = "EVALUATION_STATUS_" + name
+	}
+
+	value, ok := evaluation.EvaluationStatus_value[name]
+	if !ok {
+		return status, fmt.Errorf("unknown evaluation status: %s", name)
+	}
+
+	return evaluation.EvaluationStatus(value), nil
+}
+
 func EvaluationStartCommand() *cli.Command {
 	return &cli.Command{
 		Name:      "start",
@@ -156,3 +195,247 @@ func EvaluationStopCommand() *cli.Command {
 		},
 	}
 }
+
+// EvaluationPauseCommand pauses the evaluation of an audit scope. The job keeps its schedule and configuration, so
+// that it can be continued with [EvaluationResumeCommand].
+func EvaluationPauseCommand() *cli.Command {
+	return &cli.Command{
+		Name:      "pause",
+		Usage:     "Pause the evaluation of an audit scope without losing its configuration",
+		ArgsUsage: "<audit-scope-id>",
+		Action: func(ctx context.Context, c *cli.Command) error {
+			if c.Args().Len() < 1 {
+				return fmt.Errorf("audit scope ID is required")
+			}
+			auditScopeID := c.Args().Get(0)
+
+			client := EvaluationClient(ctx, c)
+			resp, err := client.PauseEvaluation(ctx, connect.NewRequest(&evaluation.PauseEvaluationRequest{
+				AuditScopeId: auditScopeID,
+			}))
+			if err != nil {
+				return err
+			}
+			return PrettyPrint(resp.Msg)
+		},
+	}
+}
+
+// EvaluationResumeCommand resumes the paused evaluation of an audit scope.
+func EvaluationResumeCommand() *cli.Command {
+	return &cli.Command{
+		Name:      "resume",
+		Usage:     "Resume the paused evaluation of an audit scope",
+		ArgsUsage: "<audit-scope-id>",
+		Action: func(ctx context.Context, c *cli.Command) error {
+			if c.Args().Len() < 1 {
+				return fmt.Errorf("audit scope ID is required")
+			}
+			auditScopeID := c.Args().Get(0)
+
+			client := EvaluationClient(ctx, c)
+			resp, err := client.ResumeEvaluation(ctx, connect.NewRequest(&evaluation.ResumeEvaluationRequest{
+				AuditScopeId: auditScopeID,
+			}))
+			if err != nil {
+				return err
+			}
+			return PrettyPrint(resp.Msg)
+		},
+	}
+}
+
+// EvaluationNowCommand evaluates an audit scope synchronously. It fails if the audit scope is not compliant, so that it
+// can be used as a gate in CI/CD pipelines.
+func EvaluationNowCommand() *cli.Command {
+	return &cli.Command{
+		Name:      "now",
+		Usage:     "Evaluate an audit scope once and fail if it is not compliant",
+		ArgsUsage: "<audit-scope-id>",
+		Flags: []cli.Flag{
+			&cli.IntFlag{
+				Name:  "timeout",
+				Usage: "Timeout of the evaluation in seconds, defaults to 5 minutes",
+			},
+		},
+		Action: func(ctx context.Context, c *cli.Command) error {
+			if c.Args().Len() < 1 {
+				return fmt.Errorf("audit scope ID is required")
+			}
+			auditScopeID := c.Args().Get(0)
+
+			req := &evaluation.EvaluateNowRequest{
+				AuditScopeId: auditScopeID,
+			}
+			if c.IsSet("timeout") {
+				req.Timeout = new(int32(c.Int("timeout")))
+			}
+
+			client := EvaluationClient(ctx, c)
+			resp, err := client.EvaluateNow(ctx, connect.NewRequest(req))
+			if err != nil {
+				return err
+			}
+
+			err = PrettyPrint(resp.Msg)
+			if err != nil {
+				return err
+			}
+
+			if !resp.Msg.GetCompliant() {
+				return fmt.Errorf("audit scope is not compliant: %s", resp.Msg.GetStatus())
+			}
+			return nil
+		},
+	}
+}
+
+// EvaluationCreateCommand creates a manual evaluation result for a control, e.g., for controls that cannot be
+// evaluated automatically.
+func EvaluationCreateCommand() *cli.Command {
+	return &cli.Command{
+		Name:      "create",
+		Usage:     "Create a manual evaluation result for a control",
+		ArgsUsage: "<audit-scope-id>",
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:     "target",
+				Aliases:  []string{"t"},
+				Usage:    "ID of the target of evaluation",
+				Required: true,
+			},
+			&cli.StringFlag{
+				Name:     "catalog",
+				Aliases:  []string{"c"},
+				Usage:    "ID of the catalog of the control",
+				Required: true,
+			},
+			&cli.StringFlag{
+				Name:     "control",
+				Aliases:  []string{"co"},
+				Usage:    "ID of the control",
+				Required: true,
+			},
+			&cli.StringFlag{
+				Name:  "parent",
+				Usage: "ID of the parent control, if the control is a sub-control",
+			},
+			&cli.BoolFlag{
+				Name:  "compliant",
+				Usage: "Whether the control is compliant",
+			},
+			&cli.StringFlag{
+				Name:  "comment",
+				Usage: "Comment explaining the manual evaluation",
+			},
+			&cli.StringFlag{
+				Name:  "valid-until",
+				Usage: "Time until which the manual evaluation is valid (RFC 3339)",
+			},
+		},
+		Action: func(ctx context.Context, c *cli.Command) error {
+			if c.Args().Len() < 1 {
+				return fmt.Errorf("audit scope ID is required")
+			}
+
+			result := &evaluation.EvaluationResult{
+				Id:                   uuid.NewString(),
+				TargetOfEvaluationId: c.String("target"),
+				AuditScopeId:         c.Args().Get(0),
+				ControlId:            c.String("control"),
+				ControlCatalogId:     c.String("catalog"),
+				Status:               evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT_MANUALLY,
+				Timestamp:            timestamppb.Now(),
+			}
+			if c.Bool("compliant") {
+				result.Status = evaluation.EvaluationStatus_EVALUATION_STATUS_COMPLIANT_MANUALLY
+			}
+			if parentID := c.String("parent"); parentID != "" {
+				result.ParentControlId = &parentID
+			}
+			if comment := c.String("comment"); comment != "" {
+				result.Comment = &comment
+			}
+			if validUntil := c.String("valid-until"); validUntil != "" {
+				t, err := time.Parse(time.RFC3339, validUntil)
+				if err != nil {
+					return fmt.Errorf("invalid time: %w", err)
+				}
+				result.ValidUntil = timestamppb.New(t)
+			}
+
+			client := OrchestratorClient(ctx, c)
+			resp, err := client.StoreEvaluationResult(ctx, connect.NewRequest(&orchestrator.StoreEvaluationResultRequest{
+				Result: result,
+			}))
+			if err != nil {
+				return err
+			}
+			return PrettyPrint(resp.Msg)
+		},
+	}
+}
+
+// EvaluationExportCommand exports the results of an audit scope as a report, either in the OSCAL Assessment Results
+// model or as an archive that can be restored in another deployment.
+func EvaluationExportCommand() *cli.Command {
+	return &cli.Command{
+		Name:      "export",
+		Usage:     "Export the results of an audit scope as OSCAL assessment results or as archive",
+		ArgsUsage: "<audit-scope-id>",
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:  "format",
+				Usage: "Format of the export, either oscal or archive",
+				Value: "oscal",
+			},
+			&cli.StringFlag{
+				Name:    "output",
+				Aliases: []string{"o"},
+				Usage:   "File to write the export to, defaults to stdout",
+			},
+		},
+		Action: func(ctx context.Context, c *cli.Command) error {
+			var data []byte
+
+			if c.Args().Len() < 1 {
+				return fmt.Errorf("audit scope ID is required")
+			}
+			auditScopeID := c.Args().Get(0)
+
+			client := OrchestratorClient(ctx, c)
+			switch c.String("format") {
+			case "oscal":
+				resp, err := client.ExportOSCAL(ctx, connect.NewRequest(&orchestrator.ExportOSCALRequest{
+					AuditScopeId: auditScopeID,
+				}))
+				if err != nil {
+					return err
+				}
+				data = resp.Msg.GetAssessmentResults()
+			case "archive":
+				resp, err := client.ArchiveAuditScopeData(ctx, connect.NewRequest(&orchestrator.ArchiveAuditScopeDataRequest{
+					AuditScopeId: auditScopeID,
+				}))
+				if err != nil {
+					return err
+				}
+				data = resp.Msg.GetArchive()
+			default:
+				return fmt.Errorf("unknown export format: %s", c.String("format"))
+			}
+
+			return writeOutput(c.String("output"), data)
+		},
+	}
+}
+
+// writeOutput writes data to the file with the given name or to stdout, if name is empty.
+func writeOutput(name string, data []byte) (err error) {
+	if name == "" {
+		_, err = os.Stdout.Write(data)
+		return err
+	}
+
+	return os.WriteFile(name, data, 0600)
+}
@@ -16,6 +16,7 @@
 package commands_test
 
 import (
+	"strings"
 	"testing"
 
 	"confirmate.io/core/cli/commandstest"
@@ -29,4 +30,54 @@ func TestEvaluationCommands(t *testing.T) {
 		assert.NoError(t, err)
 		assert.Contains(t, output, evaluationtest.MockEvaluationResultId1)
 	})
+
+	t.Run("list with status filter", func(t *testing.T) {
+		output, err := commandstest.RunCLI(t, "evaluation", "list", "--status", "error")
+		assert.NoError(t, err)
+		assert.False(t, strings.Contains(output, evaluationtest.MockEvaluationResultId1))
+	})
+
+	t.Run("list with unknown status", func(t *testing.T) {
+		_, err := commandstest.RunCLI(t, "evaluation", "list", "--status", "unknown")
+		assert.ErrorContains(t, err, "unknown evaluation status")
+	})
+
+	t.Run("list with unknown saved view", func(t *testing.T) {
+		_, err := commandstest.RunCLI(t, "evaluation", "list", "--view", "00000000-0000-0000-0000-000000000000")
+		assert.ErrorContains(t, err, "saved view not found")
+	})
+
+	t.Run("create manual result", func(t *testing.T) {
+		output, err := commandstest.RunCLI(t, "evaluation", "create",
+			"--target", evaluationtest.MockToeId1,
+			"--catalog", evaluationtest.MockCatalogId1,
+			"--control", evaluationtest.MockControlId1,
+			"--compliant",
+			"--comment", "Checked manually",
+			"--valid-until", "2099-01-01T00:00:00Z",
+			evaluationtest.MockAuditScopeId1)
+		assert.NoError(t, err)
+		assert.Contains(t, output, "EVALUATION_STATUS_COMPLIANT_MANUALLY")
+		assert.Contains(t, output, "Checked manually")
+	})
+
+	t.Run("create manual result with invalid time", func(t *testing.T) {
+		_, err := commandstest.RunCLI(t, "evaluation", "create",
+			"--target", evaluationtest.MockToeId1,
+			"--catalog", evaluationtest.MockCatalogId1,
+			"--control", evaluationtest.MockControlId1,
+			"--valid-until", "tomorrow",
+			evaluationtest.MockAuditScopeId1)
+		assert.ErrorContains(t, err, "invalid time")
+	})
+
+	t.Run("export with unknown format", func(t *testing.T) {
+		_, err := commandstest.RunCLI(t, "evaluation", "export", "--format", "pdf", evaluationtest.MockAuditScopeId1)
+		assert.ErrorContains(t, err, "unknown export format")
+	})
+
+	t.Run("export unknown audit scope", func(t *testing.T) {
+		_, err := commandstest.RunCLI(t, "evaluation", "export", "00000000-0000-0000-0000-000000000000")
+		assert.ErrorContains(t, err, "not found")
+	})
 }
@@ -75,3 +75,39 @@ func MetricConfigurationsGetCommand() *cli.Command {
 		},
 	}
 }
+
+func MetricConfigurationsExplainCommand() *cli.Command {
+	return &cli.Command{
+		Name:      "explain",
+		Usage:     "Explain how the effective metric configuration of a target is resolved",
+		ArgsUsage: "<target-id> <metric-id>",
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:  "catalog-id",
+				Usage: "Catalog whose default configuration is taken into account",
+			},
+		},
+		Action: func(ctx context.Context, c *cli.Command) error {
+			if c.Args().Len() < 2 {
+				return fmt.Errorf("target ID and metric ID required")
+			}
+			targetID := c.Args().Get(0)
+			metricID := c.Args().Get(1)
+
+			req := &orchestrator.ResolveMetricConfigurationRequest{
+				TargetOfEvaluationId: targetID,
+				MetricId:             metricID,
+			}
+			if catalogId := c.String("catalog-id"); catalogId != "" {
+				req.CatalogId = &catalogId
+			}
+
+			client := OrchestratorClient(ctx, c)
+			resp, err := client.ResolveMetricConfiguration(ctx, connect.NewRequest(req))
+			if err != nil {
+				return err
+			}
+			return PrettyPrint(resp.Msg)
+		},
+	}
+}
@@ -35,4 +35,10 @@ func TestMetricConfigurationsCommands(t *testing.T) {
 		assert.NoError(t, err)
 		assert.Contains(t, output, orchestratortest.MockMetricId1)
 	})
+
+	t.Run("explain", func(t *testing.T) {
+		output, err := commandstest.RunCLI(t, "metric-configurations", "explain", orchestratortest.MockToeId1, orchestratortest.MockMetricId1)
+		assert.NoError(t, err)
+		assert.Contains(t, output, "METRIC_CONFIGURATION_SOURCE_TARGET_OF_EVALUATION")
+	})
 }
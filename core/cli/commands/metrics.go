@@ -29,13 +29,45 @@ func MetricsListCommand() *cli.Command {
 	return &cli.Command{
 		Name:  "list",
 		Usage: "List all metrics",
-		Flags: PaginationFlags(),
+		Flags: append(PaginationFlags(),
+			&cli.StringFlag{
+				Name:  "category",
+				Usage: "Filter by metric category",
+			},
+			&cli.StringFlag{
+				Name:  "resource-type",
+				Usage: "Filter by the ontology resource type the metrics apply to",
+			},
+			&cli.StringFlag{
+				Name:  "evidence-field",
+				Usage: "Filter by the evidence field the metrics read",
+			},
+			&cli.BoolFlag{
+				Name:  "include-deprecated",
+				Usage: "Include deprecated metrics",
+			},
+		),
 		Action: func(ctx context.Context, c *cli.Command) error {
-			client := OrchestratorClient(ctx, c)
-			resp, err := client.ListMetrics(ctx, connect.NewRequest(&orchestrator.ListMetricsRequest{
+			req := &orchestrator.ListMetricsRequest{
 				PageSize:  int32(c.Int("page-size")),
 				PageToken: c.String("page-token"),
-			}))
+				Filter:    &orchestrator.ListMetricsRequest_Filter{},
+			}
+			if c.Bool("include-deprecated") {
+				req.Filter.IncludeDeprecated = new(true)
+			}
+			if category := c.String("category"); category != "" {
+				req.Filter.Category = &category
+			}
+			if resourceType := c.String("resource-type"); resourceType != "" {
+				req.Filter.ResourceType = &resourceType
+			}
+			if evidenceField := c.String("evidence-field"); evidenceField != "" {
+				req.Filter.EvidenceField = &evidenceField
+			}
+
+			client := OrchestratorClient(ctx, c)
+			resp, err := client.ListMetrics(ctx, connect.NewRequest(req))
 			if err != nil {
 				return err
 			}
@@ -16,6 +16,7 @@
 package commands_test
 
 import (
+	"strings"
 	"testing"
 
 	"confirmate.io/core/cli/commandstest"
@@ -31,6 +32,13 @@ func TestMetricsCommands(t *testing.T) {
 		assert.Contains(t, output, orchestratortest.MockMetricId2)
 	})
 
+	t.Run("list with filter", func(t *testing.T) {
+		output, err := commandstest.RunCLI(t, "metrics", "list", "--category", orchestratortest.MockTestCategory, "--resource-type", "VirtualMachine")
+		assert.NoError(t, err)
+		assert.Contains(t, output, orchestratortest.MockMetricId1)
+		assert.False(t, strings.Contains(output, orchestratortest.MockMetricId2))
+	})
+
 	t.Run("get", func(t *testing.T) {
 		output, err := commandstest.RunCLI(t, "metrics", "get", orchestratortest.MockMetricId1)
 		assert.NoError(t, err)
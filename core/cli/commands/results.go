@@ -18,11 +18,13 @@ package commands
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"confirmate.io/core/api/orchestrator"
 
 	"connectrpc.com/connect"
 	"github.com/urfave/cli/v3"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func ResultsListCommand() *cli.Command {
@@ -45,6 +47,10 @@ func ResultsListCommand() *cli.Command {
 				Aliases: []string{"c"},
 				Usage:   "Filter only compliant results",
 			},
+			&cli.StringFlag{
+				Name:  "as-of",
+				Usage: "List only results created at or before the given time (RFC 3339), e.g., to reproduce an audit",
+			},
 		}, PaginationFlags()...),
 		Action: func(ctx context.Context, c *cli.Command) error {
 			req := &orchestrator.ListAssessmentResultsRequest{
@@ -52,6 +58,14 @@ func ResultsListCommand() *cli.Command {
 				PageToken: c.String("page-token"),
 			}
 
+			if asOf := c.String("as-of"); asOf != "" {
+				t, err := time.Parse(time.RFC3339, asOf)
+				if err != nil {
+					return fmt.Errorf("invalid time: %w", err)
+				}
+				req.AsOf = timestamppb.New(t)
+			}
+
 			// Apply filters if provided
 			if c.String("target") != "" || c.String("metric") != "" || c.IsSet("compliant") {
 				filter := &orchestrator.ListAssessmentResultsRequest_Filter{}
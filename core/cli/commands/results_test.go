@@ -16,6 +16,7 @@
 package commands_test
 
 import (
+	"strings"
 	"testing"
 
 	"confirmate.io/core/cli/commandstest"
@@ -30,6 +31,17 @@ func TestResultsCommands(t *testing.T) {
 		assert.Contains(t, output, orchestratortest.MockResultId1)
 	})
 
+	t.Run("list as of", func(t *testing.T) {
+		output, err := commandstest.RunCLI(t, "results", "list", "--as-of", "2000-01-01T00:00:00Z")
+		assert.NoError(t, err)
+		assert.False(t, strings.Contains(output, orchestratortest.MockResultId1))
+	})
+
+	t.Run("list as of invalid time", func(t *testing.T) {
+		_, err := commandstest.RunCLI(t, "results", "list", "--as-of", "yesterday")
+		assert.ErrorContains(t, err, "invalid time")
+	})
+
 	t.Run("get", func(t *testing.T) {
 		output, err := commandstest.RunCLI(t, "results", "get", orchestratortest.MockResultId1)
 		assert.NoError(t, err)
@@ -81,6 +81,7 @@ func NewRootCommand() *cli.Command {
 				Commands: []*cli.Command{
 					MetricConfigurationsListCommand(),
 					MetricConfigurationsGetCommand(),
+					MetricConfigurationsExplainCommand(),
 				},
 			},
 			{
@@ -92,6 +93,7 @@ func NewRootCommand() *cli.Command {
 					TargetsListCommand(),
 					TargetsGetCommand(),
 					TargetsStatsCommand(),
+					TargetsMergeCommand(),
 					TargetsRemoveCommand(),
 				},
 			},
@@ -109,6 +111,7 @@ func NewRootCommand() *cli.Command {
 				Commands: []*cli.Command{
 					CatalogsListCommand(),
 					CatalogsGetCommand(),
+					CatalogsTreeCommand(),
 					CatalogsRemoveCommand(),
 				},
 			},
@@ -146,6 +149,11 @@ func NewRootCommand() *cli.Command {
 					EvaluationResultsListCommand(),
 					EvaluationStartCommand(),
 					EvaluationStopCommand(),
+					EvaluationPauseCommand(),
+					EvaluationResumeCommand(),
+					EvaluationNowCommand(),
+					EvaluationCreateCommand(),
+					EvaluationExportCommand(),
 				},
 			},
 		},
@@ -172,6 +172,36 @@ func TargetsStatsCommand() *cli.Command {
 	}
 }
 
+func TargetsMergeCommand() *cli.Command {
+	return &cli.Command{
+		Name:      "merge",
+		Usage:     "Merge a duplicate target of evaluation into another one",
+		ArgsUsage: "<target-id> <duplicate-id>",
+		Flags: []cli.Flag{
+			&cli.BoolFlag{
+				Name:  "dry-run",
+				Usage: "Only preview the entities that would be moved",
+			},
+		},
+		Action: func(ctx context.Context, c *cli.Command) error {
+			if c.Args().Len() < 2 {
+				return fmt.Errorf("target ID and duplicate ID required")
+			}
+
+			client := OrchestratorClient(ctx, c)
+			resp, err := client.MergeTargetsOfEvaluation(ctx, connect.NewRequest(&orchestrator.MergeTargetsOfEvaluationRequest{
+				TargetOfEvaluationId:          c.Args().Get(0),
+				DuplicateTargetOfEvaluationId: c.Args().Get(1),
+				DryRun:                        c.Bool("dry-run"),
+			}))
+			if err != nil {
+				return err
+			}
+			return PrettyPrint(resp.Msg)
+		},
+	}
+}
+
 func TargetsRemoveCommand() *cli.Command {
 	return &cli.Command{
 		Name:      "remove",
@@ -44,6 +44,12 @@ func TestTargetsCommands(t *testing.T) {
 		assert.Contains(t, output, "\"numberOfAssessmentResults\": \"1\"")
 	})
 
+	t.Run("merge dry-run", func(t *testing.T) {
+		output, err := commandstest.RunCLI(t, "targets", "merge", "--dry-run", orchestratortest.MockToeId1, orchestratortest.MockToeId2)
+		assert.NoError(t, err)
+		assert.Contains(t, output, "\"numberOfCertificates\": \"1\"")
+	})
+
 	t.Run("remove", func(t *testing.T) {
 		output, err := commandstest.RunCLI(t, "targets", "remove", orchestratortest.MockToeId2)
 		assert.NoError(t, err)
@@ -32,8 +32,9 @@ require (
 	github.com/antlr4-go/antlr/v4 v4.13.1 // indirect
 	github.com/google/cel-go v0.28.0 // indirect
 	github.com/google/uuid v1.6.0
+	github.com/klauspost/compress v1.18.5
 	google.golang.org/genproto/googleapis/api v0.0.0-20260526163538-3dc84a4a5aaa
-	google.golang.org/genproto/googleapis/rpc v0.0.0-20260526163538-3dc84a4a5aaa // indirect
+	google.golang.org/genproto/googleapis/rpc v0.0.0-20260526163538-3dc84a4a5aaa
 	google.golang.org/protobuf v1.36.12-0.20260120151049-f2248ac996af
 )
 
@@ -108,7 +109,7 @@ require (
 	github.com/srikrsna/protoc-gen-gotag v1.0.2
 	golang.org/x/mod v0.36.0 // indirect
 	golang.org/x/sync v0.22.0
-	golang.org/x/tools v0.45.0 // indirect
+	golang.org/x/tools v0.45.0
 )
 
 /// Use confirmate/ramsql fork instead of proullon/ramsql due to required bugfixes and compatibility
@@ -29,10 +29,12 @@ var DefaultConfig = Config{
 	User:             "confirmate",
 	Password:         "confirmate",
 	SSLMode:          "disable",
+	Backend:          BackendPostgres,
 	MaxConn:          10,
 	InMemoryDB:       true,
 	Types:            []any{},
 	CustomJoinTables: []CustomJoinTable{},
+	Immutable:        []ImmutableType{},
 }
 
 // DefaultGormConfig contains the default [gorm.Config] for the persistence layer.
@@ -63,6 +65,11 @@ type Config struct {
 	// to true.
 	SSLMode string
 
+	// Backend is the name of the backend that stores the data, e.g., [BackendPostgres] (the
+	// default), [BackendCockroachDB] or a backend registered with [RegisterBackend]. It is
+	// ignored if [Config.InMemoryDB] is set to true.
+	Backend string
+
 	// InMemoryDB indicates whether to use an in-memory database instead of the database server
 	// described by [Config.Host], [Config.Port], [Config.DBName], [Config.User], [Config.Password]
 	// and [Config.SSLMode].
@@ -80,6 +87,11 @@ type Config struct {
 	// layer.
 	CustomJoinTables []CustomJoinTable
 
+	// Immutable contains the types that are stored append-only (write once, read many), e.g., to
+	// meet regulatory immutability requirements. Records of these types can still be created, but
+	// not updated or deleted within their retention period, see [ImmutableType].
+	Immutable []ImmutableType
+
 	// InitFunc is an optional hook that runs after migrations to seed data.
 	// If it returns an error, database initialization fails.
 	InitFunc func(DB) error
@@ -16,19 +16,25 @@
 package persistence
 
 import (
-	"database/sql"
 	"fmt"
-	"log/slog"
-	"math/rand/v2"
+	"sync"
+)
+
+// Names of the built-in backends, see [Config.Backend].
+const (
+	// BackendPostgres stores data in a PostgreSQL database server.
+	BackendPostgres = "postgres"
 
-	_ "github.com/proullon/ramsql/driver"
-	"gorm.io/driver/postgres"
-	"gorm.io/gorm"
-	"gorm.io/gorm/schema"
+	// BackendCockroachDB stores data in a CockroachDB cluster, which is wire-compatible with PostgreSQL.
+	BackendCockroachDB = "cockroachdb"
+
+	// BackendInMemory stores data in an in-memory database. It is selected by [Config.InMemoryDB].
+	BackendInMemory = "inmemory"
 )
 
 // DB is our main database interface that allows to interact with the persistence layer. It is
-// closely aligned to the [gorm] operations.
+// closely aligned to the [gorm] operations. It is implemented by the registered backends (see
+// [Backend]), which are by default built on GORM (see [GormBackend]).
 type DB interface {
 	// Create attempts to insert the provided record into the database.
 	//
@@ -39,19 +45,22 @@ type DB interface {
 	// Save attempts to save the given record to the database, applying optional conditions for
 	// filtering.
 	//
-	// If a constraint violation occurs, it must return [ErrConstraintFailed].
+	// If a constraint violation occurs, it must return [ErrConstraintFailed]. It must return
+	// [ErrImmutable] if it would overwrite a record that is immutable (see [Config.Immutable]).
 	Save(r any, conds ...any) (err error)
 
 	// Update applies the provided changes to the database record, optionally applying conditions
 	// for filtering.
 	//
 	// Must return [ErrConstraintFailed] on a constraint violation or [ErrRecordNotFound] if no
-	// matching record is found.
+	// matching record is found. Must return [ErrImmutable] if a matching record is immutable (see
+	// [Config.Immutable]).
 	Update(r any, conds ...any) (err error)
 
 	// Delete attempts to delete the record with the given ID from the database.
 	//
-	// Must return [ErrRecordNotFound] if no matching record is found.
+	// Must return [ErrRecordNotFound] if no matching record is found or [ErrImmutable] if a
+	// matching record is immutable (see [Config.Immutable]).
 	Delete(r any, conds ...any) (err error)
 
 	// Get attempts to retrieve a record from the database.
@@ -59,7 +68,8 @@ type DB interface {
 	// If no record is found, it returns [ErrRecordNotFound].
 	Get(r any, conds ...any) (err error)
 
-	// List retrieves a list of records from the database.
+	// List retrieves a list of records from the database. The records are ordered by orderBy, which may contain
+	// several comma-separated columns, e.g., to break ties deterministically.
 	List(r any, orderBy string, asc bool, offset int, limit int, conds ...any) (err error)
 
 	// Count retrieves the count of records in the database that match the provided conditions.
@@ -70,31 +80,59 @@ type DB interface {
 	Pluck(model any, column string, dest any, conds ...any) (err error)
 
 	// Raw executes a raw SQL query and scans the result into the provided destination. Returns an error
-	// if the query fails.
+	// if the query fails. Queries that use optional features of SQL must check [DB.Capabilities]
+	// first.
 	Raw(r any, query string, args ...any) (err error)
 
 	// Transaction executes fn within a transaction. If fn returns an error, the transaction is
 	// rolled back. Otherwise, the transaction is committed.
 	Transaction(fn func(tx DB) error) error
+
+	// Capabilities returns the optional features of SQL that are supported by the backend.
+	Capabilities() Capabilities
 }
 
-// gormDB is our main database struct that wraps GORM's DB instance and provides additional
-// configuration options.
-type gormDB struct {
-	*gorm.DB
+// Capabilities describes the optional features of SQL that a backend supports in raw queries (see
+// [DB.Raw]). Callers use them to choose between an efficient query and a portable fallback.
+type Capabilities struct {
+	// WindowFunctions indicates whether window functions, e.g., ROW_NUMBER() OVER (PARTITION BY
+	// ...), are supported.
+	WindowFunctions bool
+}
+
+// Backend opens a [DB] for the given configuration, including the migration of
+// [Config.Types]. Backends are registered under a name with [RegisterBackend] and selected with
+// [Config.Backend].
+type Backend func(cfg Config) (DB, error)
+
+var (
+	// backends contains all registered backends by their name.
+	backends = map[string]Backend{
+		BackendPostgres:    GormBackend(postgresDialector, Capabilities{WindowFunctions: true}),
+		BackendCockroachDB: GormBackend(postgresDialector, Capabilities{WindowFunctions: true}),
+		BackendInMemory:    GormBackend(inMemoryDialector, Capabilities{}),
+	}
+	backendsMutex sync.RWMutex
+)
+
+// RegisterBackend registers a backend under the given name, so that it can be selected with
+// [Config.Backend]. An existing backend with the same name is replaced. This allows deployments
+// that cannot run stock PostgreSQL to plug in their own storage, e.g., a cloud-managed
+// serverless driver using [GormBackend].
+func RegisterBackend(name string, backend Backend) {
+	backendsMutex.Lock()
+	defer backendsMutex.Unlock()
 
-	cfg  Config
-	gcfg gorm.Config
-	pcfg postgres.Config
+	backends[name] = backend
 }
 
 // DBOption defines a function type for configuring the [DB] instance.
-type DBOption func(*gormDB)
+type DBOption func(*Config)
 
 // WithConfig is an option to add types to GORM's auto-migration.
 func WithConfig(cfg Config) DBOption {
-	return func(s *gormDB) {
-		s.cfg = cfg
+	return func(c *Config) {
+		*c = cfg
 	}
 }
 
@@ -105,87 +143,49 @@ type CustomJoinTable struct {
 	JoinTable any    // The custom join table struct (e.g., &MetricConfiguration{})
 }
 
-// NewDB creates a new [DB] instance with the provided options.
+// NewDB creates a new [DB] instance with the provided options, using the backend selected by
+// [Config.Backend] or [Config.InMemoryDB].
 func NewDB(opts ...DBOption) (s DB, err error) {
 	var (
-		db *gormDB
+		cfg     = DefaultConfig
+		name    string
+		backend Backend
+		ok      bool
 	)
 
-	db = &gormDB{
-		cfg:  DefaultConfig,
-		gcfg: DefaultGormConfig,
-	}
-
 	// Add options and/or override default ones
 	for _, o := range opts {
-		o(db)
+		o(&cfg)
 	}
 
-	// Build the postrges config out of our persistence config
-	if db.cfg.InMemoryDB {
-		db.pcfg.Conn, err = sql.Open("ramsql", fmt.Sprintf("confirmate_inmemory_%d", rand.Uint64()))
-		if err != nil {
-			return nil, fmt.Errorf("could not open in-memory database: %w", err)
-		}
-
-		// Also limit max connection to 1 for in-memory DB
-		db.cfg.MaxConn = 1
-		slog.Info("Using in-memory database. Note that all data will be lost when the application stops.")
-	} else {
-		db.pcfg.DSN = db.cfg.buildDSN()
+	switch {
+	case cfg.InMemoryDB:
+		name = BackendInMemory
+	case cfg.Backend == "":
+		name = BackendPostgres
+	default:
+		name = cfg.Backend
 	}
 
-	// Set up GORM DB connection
-	db.DB, err = gorm.Open(postgres.New(db.pcfg), &db.gcfg)
-	if err != nil {
-		return nil, fmt.Errorf("could not create gorm connection: %w", err)
+	backendsMutex.RLock()
+	backend, ok = backends[name]
+	backendsMutex.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownBackend, name)
 	}
 
-	// Set max open connections
-	if db.cfg.MaxConn > 0 {
-		sqlDB, err := db.DB.DB()
-		if err != nil {
-			return nil, fmt.Errorf("could not retrieve sql.DB: %v", err)
-		}
-
-		sqlDB.SetMaxOpenConns(db.cfg.MaxConn)
-	}
-
-	// Register custom serializers
-	schema.RegisterSerializer("durationpb", &DurationSerializer{})
-	schema.RegisterSerializer("timestamppb", &TimestampSerializer{})
-	schema.RegisterSerializer("valuepb", &ValueSerializer{})
-	schema.RegisterSerializer("anypb", &AnySerializer{})
-
-	// Setup custom join tables if any are provided
-	for _, jt := range db.cfg.CustomJoinTables {
-		if err = db.DB.SetupJoinTable(jt.Model, jt.Field, jt.JoinTable); err != nil {
-			err = fmt.Errorf("error during join-table: %w", err)
-			return
-		}
-	}
-
-	// After successful DB initialization, migrate the schema
-	if err = db.DB.AutoMigrate(db.cfg.Types...); err != nil {
-		err = fmt.Errorf("error during auto-migration: %w", err)
-		return
+	s, err = backend(cfg)
+	if err != nil {
+		return nil, err
 	}
 
 	// Run optional init function after migrations
-	if db.cfg.InitFunc != nil {
-		if err = db.cfg.InitFunc(db); err != nil {
+	if cfg.InitFunc != nil {
+		if err = cfg.InitFunc(s); err != nil {
 			err = fmt.Errorf("error during init function: %w", err)
 			return
 		}
 	}
 
-	s = db
-
 	return
 }
-
-func (db *gormDB) Transaction(fn func(tx DB) error) error {
-	return db.DB.Transaction(func(tx *gorm.DB) error {
-		return fn(&gormDB{DB: tx, cfg: db.cfg, gcfg: db.gcfg, pcfg: db.pcfg})
-	})
-}
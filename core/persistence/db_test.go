@@ -161,3 +161,63 @@ func Test_DB_Get(t *testing.T) {
 	// assert.NoError(t, api.Validate(gotImpl))
 	assert.Equal(t, impl, gotImpl)
 }
+
+func TestNewDB(t *testing.T) {
+	persistence.RegisterBackend("test", func(cfg persistence.Config) (persistence.DB, error) {
+		return persistencetest.NewInMemoryDB(t, cfg.Types, cfg.CustomJoinTables), nil
+	})
+
+	tests := []struct {
+		name    string
+		cfg     persistence.Config
+		want    assert.Want[persistence.DB]
+		wantErr assert.WantErr
+	}{
+		{
+			name: "unknown backend",
+			cfg: persistence.Config{
+				Backend: "unknown",
+			},
+			want: assert.Nil[persistence.DB],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.ErrorIs(t, err, persistence.ErrUnknownBackend)
+			},
+		},
+		{
+			name: "registered backend with init function",
+			cfg: persistence.Config{
+				Backend: "test",
+				Types:   []any{&assessment.Metric{}, &assessment.MetricImplementation{}},
+				InitFunc: func(db persistence.DB) error {
+					return db.Create(&assessment.Metric{Id: MockMetricId1})
+				},
+			},
+			want: func(t *testing.T, got persistence.DB, msgAndArgs ...any) bool {
+				count, err := got.Count(&assessment.Metric{})
+				return assert.NoError(t, err) &&
+					assert.Equal(t, int64(1), count)
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "in-memory database takes precedence over backend",
+			cfg: persistence.Config{
+				Backend:    "unknown",
+				InMemoryDB: true,
+			},
+			want: func(t *testing.T, got persistence.DB, msgAndArgs ...any) bool {
+				// The in-memory database does not support window functions
+				return assert.False(t, got.Capabilities().WindowFunctions)
+			},
+			wantErr: assert.NoError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := persistence.NewDB(persistence.WithConfig(tt.cfg))
+			tt.want(t, got)
+			tt.wantErr(t, err)
+		})
+	}
+}
@@ -25,4 +25,6 @@ var (
 	ErrUnsupportedType        = errors.New("unsupported type")
 	ErrDatabase               = errors.New("database error")
 	ErrEntryAlreadyExists     = errors.New("entry already exists")
+	ErrUnknownBackend         = errors.New("unknown database backend")
+	ErrImmutable              = errors.New("record is immutable within its retention period")
 )
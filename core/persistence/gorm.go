@@ -0,0 +1,145 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package persistence
+
+import (
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"math/rand/v2"
+
+	_ "github.com/proullon/ramsql/driver"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/schema"
+)
+
+// gormDB is our main database struct that wraps GORM's DB instance and provides additional
+// configuration options.
+type gormDB struct {
+	*gorm.DB
+
+	cfg  Config
+	caps Capabilities
+
+	// immutable contains the immutable types of [Config.Immutable], keyed by their table name
+	immutable map[string]ImmutableType
+}
+
+// Dialector returns the [gorm.Dialector] that connects to the database described by the
+// configuration. It may adjust the configuration, e.g., to limit [Config.MaxConn].
+type Dialector func(cfg *Config) (gorm.Dialector, error)
+
+// GormBackend returns a [Backend] that is implemented with GORM. The dialector connects to the
+// database, which allows to use any GORM driver, and caps describes the optional features of SQL
+// that the database supports.
+func GormBackend(dialector Dialector, caps Capabilities) Backend {
+	return func(cfg Config) (s DB, err error) {
+		var (
+			db = &gormDB{
+				cfg:  cfg,
+				caps: caps,
+			}
+			gcfg = DefaultGormConfig
+			d    gorm.Dialector
+		)
+
+		d, err = dialector(&db.cfg)
+		if err != nil {
+			return nil, err
+		}
+
+		// Set up GORM DB connection
+		db.DB, err = gorm.Open(d, &gcfg)
+		if err != nil {
+			return nil, fmt.Errorf("could not create gorm connection: %w", err)
+		}
+
+		// Set max open connections
+		if db.cfg.MaxConn > 0 {
+			sqlDB, err := db.DB.DB()
+			if err != nil {
+				return nil, fmt.Errorf("could not retrieve sql.DB: %w", err)
+			}
+
+			sqlDB.SetMaxOpenConns(db.cfg.MaxConn)
+		}
+
+		registerSerializers()
+
+		// Setup custom join tables if any are provided
+		for _, jt := range db.cfg.CustomJoinTables {
+			if err = db.DB.SetupJoinTable(jt.Model, jt.Field, jt.JoinTable); err != nil {
+				err = fmt.Errorf("error during join-table: %w", err)
+				return
+			}
+		}
+
+		// After successful DB initialization, migrate the schema
+		if err = db.DB.AutoMigrate(db.cfg.Types...); err != nil {
+			err = fmt.Errorf("error during auto-migration: %w", err)
+			return
+		}
+
+		db.immutable, err = immutableTables(db.DB, db.cfg.Immutable)
+		if err != nil {
+			return
+		}
+
+		s = db
+
+		return
+	}
+}
+
+// registerSerializers registers our custom serializers with GORM.
+func registerSerializers() {
+	schema.RegisterSerializer("durationpb", &DurationSerializer{})
+	schema.RegisterSerializer("timestamppb", &TimestampSerializer{})
+	schema.RegisterSerializer("valuepb", &ValueSerializer{})
+	schema.RegisterSerializer("anypb", &AnySerializer{})
+}
+
+// postgresDialector connects to the PostgreSQL (compatible) database server described by the
+// configuration.
+func postgresDialector(cfg *Config) (gorm.Dialector, error) {
+	return postgres.New(postgres.Config{DSN: cfg.buildDSN()}), nil
+}
+
+// inMemoryDialector opens a new in-memory database. Since the in-memory database only supports
+// a single connection, [Config.MaxConn] is limited to 1.
+func inMemoryDialector(cfg *Config) (gorm.Dialector, error) {
+	conn, err := sql.Open("ramsql", fmt.Sprintf("confirmate_inmemory_%d", rand.Uint64()))
+	if err != nil {
+		return nil, fmt.Errorf("could not open in-memory database: %w", err)
+	}
+
+	cfg.MaxConn = 1
+	slog.Info("Using in-memory database. Note that all data will be lost when the application stops.")
+
+	return postgres.New(postgres.Config{Conn: conn}), nil
+}
+
+func (db *gormDB) Transaction(fn func(tx DB) error) error {
+	return db.DB.Transaction(func(tx *gorm.DB) error {
+		return fn(&gormDB{DB: tx, cfg: db.cfg, caps: db.caps, immutable: db.immutable})
+	})
+}
+
+// Capabilities returns the optional features of SQL that are supported by the database.
+func (db *gormDB) Capabilities() Capabilities {
+	return db.caps
+}
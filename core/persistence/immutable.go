@@ -0,0 +1,147 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package persistence
+
+import (
+	"fmt"
+	"reflect"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ImmutableType describes a type whose records are stored append-only (write once, read many), see
+// [Config.Immutable]. Updating or deleting a record that is still within its retention period fails
+// with [ErrImmutable]. This also applies to [DB.Save], if it would overwrite an existing record.
+//
+// The immutability is enforced for all operations of the [DB] interface, except for raw queries
+// (see [DB.Raw]).
+type ImmutableType struct {
+	// Model is the immutable type, e.g., &evidence.Evidence{}.
+	Model any
+
+	// Column is the time column the retention period of a record starts with, e.g., "created_at".
+	Column string
+
+	// Retention is the period in which a record cannot be updated or deleted. If either Retention
+	// or Column is not set, records are immutable regardless of their age. This also applies to
+	// records without a value in Column.
+	Retention time.Duration
+}
+
+// immutableTables returns the immutable types keyed by their table name.
+func immutableTables(db *gorm.DB, types []ImmutableType) (tables map[string]ImmutableType, err error) {
+	tables = make(map[string]ImmutableType, len(types))
+
+	for _, t := range types {
+		stmt := &gorm.Statement{DB: db}
+		if err = stmt.Parse(t.Model); err != nil {
+			return nil, fmt.Errorf("could not parse immutable type %T: %w", t.Model, err)
+		}
+
+		tables[stmt.Schema.Table] = t
+	}
+
+	return
+}
+
+// checkImmutable returns [ErrImmutable] if updating or deleting r with the given conditions would
+// modify a record of an immutable type (see [Config.Immutable]) that is still within its
+// retention period. Similar to GORM, the records are selected by the conditions and the primary
+// key of r, if it is set. If save is true, a record with an unset primary key is about to be
+// created and can therefore always be saved.
+func (s *gormDB) checkImmutable(r any, save bool, conds ...any) (err error) {
+	var (
+		stmt *gorm.Statement
+		t    ImmutableType
+		ok   bool
+		rv   reflect.Value
+	)
+
+	if len(s.immutable) == 0 {
+		return nil
+	}
+
+	// If we cannot parse the type, GORM cannot either and the operation itself fails
+	stmt = &gorm.Statement{DB: s.DB}
+	if stmt.Parse(r) != nil {
+		return nil
+	}
+
+	t, ok = s.immutable[stmt.Schema.Table]
+	if !ok {
+		return nil
+	}
+
+	// Check all records of a slice individually, since each of them might be saved
+	rv = reflect.Indirect(reflect.ValueOf(r))
+	if (rv.Kind() == reflect.Slice || rv.Kind() == reflect.Array) && rv.Len() > 0 {
+		for i := range rv.Len() {
+			if err = s.checkImmutableRecord(stmt, t, reflect.Indirect(rv.Index(i)), save, conds...); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	return s.checkImmutableRecord(stmt, t, rv, save, conds...)
+}
+
+// checkImmutableRecord returns [ErrImmutable] if the records of type t selected by the conditions
+// and the primary key of rv contain a record within its retention period, see
+// [gormDB.checkImmutable].
+func (s *gormDB) checkImmutableRecord(stmt *gorm.Statement, t ImmutableType, rv reflect.Value, save bool,
+	conds ...any) (err error) {
+	var (
+		db    = applyWhere(s.DB.Session(&gorm.Session{NewDB: true}).Model(t.Model), conds...)
+		count int64
+	)
+
+	if rv.Kind() == reflect.Struct {
+		for _, f := range stmt.Schema.PrimaryFields {
+			v, zero := f.ValueOf(s.DB.Statement.Context, rv)
+			if zero && save {
+				return nil
+			} else if zero {
+				continue
+			}
+
+			db = db.Where(clause.Eq{Column: clause.Column{Table: clause.CurrentTable, Name: f.DBName}, Value: v})
+		}
+	}
+
+	// Records without a start of their retention period, e.g., records stored before the column was introduced, are
+	// treated as being within their retention period
+	if t.Column != "" && t.Retention > 0 {
+		column := clause.Column{Table: clause.CurrentTable, Name: t.Column}
+		db = db.Where(clause.Or(
+			clause.Gt{Column: column, Value: time.Now().Add(-t.Retention)},
+			clause.Eq{Column: column, Value: nil},
+		))
+	}
+
+	if err = db.Count(&count).Error; err != nil {
+		return err
+	}
+
+	if count > 0 {
+		return fmt.Errorf("%w: %s", ErrImmutable, stmt.Schema.Table)
+	}
+
+	return nil
+}
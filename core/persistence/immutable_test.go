@@ -0,0 +1,189 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package persistence_test
+
+import (
+	"testing"
+	"time"
+
+	"confirmate.io/core/api/assessment"
+	"confirmate.io/core/persistence"
+	"confirmate.io/core/util/assert"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestDB_Immutable(t *testing.T) {
+	var (
+		recent = &assessment.MetricImplementation{
+			MetricId:  "recent",
+			Code:      "package recent",
+			UpdatedAt: timestamppb.New(time.Now().Add(-time.Hour)),
+		}
+		expired = &assessment.MetricImplementation{
+			MetricId:  "expired",
+			Code:      "package expired",
+			UpdatedAt: timestamppb.New(time.Now().Add(-48 * time.Hour)),
+		}
+		legacy = &assessment.MetricImplementation{
+			MetricId: "legacy",
+			Code:     "package legacy",
+		}
+	)
+
+	tests := []struct {
+		name      string
+		immutable []persistence.ImmutableType
+		op        func(db persistence.DB) error
+		wantErr   assert.WantErr
+	}{
+		{
+			name: "update within retention",
+			immutable: []persistence.ImmutableType{
+				{Model: &assessment.MetricImplementation{}, Column: "updated_at", Retention: 24 * time.Hour},
+			},
+			op: func(db persistence.DB) error {
+				return db.Update(&assessment.MetricImplementation{MetricId: "recent", Code: "changed"})
+			},
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.ErrorIs(t, err, persistence.ErrImmutable)
+			},
+		},
+		{
+			name: "update after retention",
+			immutable: []persistence.ImmutableType{
+				{Model: &assessment.MetricImplementation{}, Column: "updated_at", Retention: 24 * time.Hour},
+			},
+			op: func(db persistence.DB) error {
+				return db.Update(&assessment.MetricImplementation{MetricId: "expired", Code: "changed"})
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "update without retention start",
+			immutable: []persistence.ImmutableType{
+				{Model: &assessment.MetricImplementation{}, Column: "updated_at", Retention: 24 * time.Hour},
+			},
+			op: func(db persistence.DB) error {
+				return db.Update(&assessment.MetricImplementation{MetricId: "legacy", Code: "changed"})
+			},
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.ErrorIs(t, err, persistence.ErrImmutable)
+			},
+		},
+		{
+			name: "delete by condition within retention",
+			immutable: []persistence.ImmutableType{
+				{Model: &assessment.MetricImplementation{}, Column: "updated_at", Retention: 24 * time.Hour},
+			},
+			op: func(db persistence.DB) error {
+				return db.Delete(&assessment.MetricImplementation{}, "code LIKE ?", "package %")
+			},
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.ErrorIs(t, err, persistence.ErrImmutable)
+			},
+		},
+		{
+			name: "delete by condition after retention",
+			immutable: []persistence.ImmutableType{
+				{Model: &assessment.MetricImplementation{}, Column: "updated_at", Retention: 24 * time.Hour},
+			},
+			op: func(db persistence.DB) error {
+				return db.Delete(&assessment.MetricImplementation{}, "metric_id = ?", "expired")
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "save existing record within retention",
+			immutable: []persistence.ImmutableType{
+				{Model: &assessment.MetricImplementation{}, Column: "updated_at", Retention: 24 * time.Hour},
+			},
+			op: func(db persistence.DB) error {
+				return db.Save(&assessment.MetricImplementation{MetricId: "recent", Code: "changed"})
+			},
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.ErrorIs(t, err, persistence.ErrImmutable)
+			},
+		},
+		{
+			name: "save new record",
+			immutable: []persistence.ImmutableType{
+				{Model: &assessment.MetricImplementation{}, Column: "updated_at", Retention: 24 * time.Hour},
+			},
+			op: func(db persistence.DB) error {
+				return db.Save(&assessment.MetricImplementation{MetricId: MockMetricId1, Code: "package new"})
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "delete without retention",
+			immutable: []persistence.ImmutableType{
+				{Model: &assessment.MetricImplementation{}},
+			},
+			op: func(db persistence.DB) error {
+				return db.Delete(&assessment.MetricImplementation{}, "metric_id = ?", "expired")
+			},
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.ErrorIs(t, err, persistence.ErrImmutable)
+			},
+		},
+		{
+			name: "delete within transaction",
+			immutable: []persistence.ImmutableType{
+				{Model: &assessment.MetricImplementation{}, Column: "updated_at", Retention: 24 * time.Hour},
+			},
+			op: func(db persistence.DB) error {
+				return db.Transaction(func(tx persistence.DB) error {
+					return tx.Delete(&assessment.MetricImplementation{MetricId: "recent"})
+				})
+			},
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.ErrorIs(t, err, persistence.ErrImmutable)
+			},
+		},
+		{
+			name: "other types are mutable",
+			immutable: []persistence.ImmutableType{
+				{Model: &assessment.MetricImplementation{}},
+			},
+			op: func(db persistence.DB) error {
+				return db.Delete(&assessment.Metric{}, "id = ?", MockMetricId1)
+			},
+			wantErr: assert.NoError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, err := persistence.NewDB(persistence.WithConfig(persistence.Config{
+				InMemoryDB: true,
+				Types:      []any{&assessment.Metric{}, &assessment.MetricImplementation{}},
+				Immutable:  tt.immutable,
+			}))
+			assert.NoError(t, err)
+
+			for _, id := range []string{MockMetricId1, recent.MetricId, expired.MetricId, legacy.MetricId} {
+				assert.NoError(t, db.Create(&assessment.Metric{Id: id}))
+			}
+			assert.NoError(t, db.Create(recent))
+			assert.NoError(t, db.Create(expired))
+			assert.NoError(t, db.Create(legacy))
+
+			err = tt.op(db)
+			tt.wantErr(t, err)
+		})
+	}
+}
@@ -53,7 +53,14 @@ func (s *gormDB) Create(r any) (err error) {
 
 // Save attempts to save the given record to the database, applying optional conditions for
 // filtering. If a constraint violation occurs, it returns [ErrConstraintFailed].
+//
+// Returns [ErrImmutable] if an existing record of an immutable type would be overwritten within
+// its retention period, see [Config.Immutable].
 func (s *gormDB) Save(r any, conds ...any) (err error) {
+	if err = s.checkImmutable(r, true, conds...); err != nil {
+		return err
+	}
+
 	db := applyWhere(s.DB, conds...).Save(r)
 	err = db.Error
 
@@ -68,8 +75,13 @@ func (s *gormDB) Save(r any, conds ...any) (err error) {
 // filtering.
 //
 // Returns [ErrConstraintFailed] on a constraint violation or [ErrRecordNotFound] if no matching
-// record is found.
+// record is found. Returns [ErrImmutable] if a matching record of an immutable type is within its
+// retention period, see [Config.Immutable].
 func (s *gormDB) Update(r any, conds ...any) (err error) {
+	if err = s.checkImmutable(r, false, conds...); err != nil {
+		return err
+	}
+
 	db := s.DB.Session(&gorm.Session{FullSaveAssociations: true}).Model(r)
 	db = applyWhere(db, conds...).Updates(r)
 	if err = db.Error; err != nil { // db error
@@ -89,8 +101,13 @@ func (s *gormDB) Update(r any, conds ...any) (err error) {
 
 // Delete attempts to delete the record with the given ID from the database.
 //
-// Returns [ErrRecordNotFound] if no matching record is found.
+// Returns [ErrRecordNotFound] if no matching record is found. Returns [ErrImmutable] if a matching
+// record of an immutable type is within its retention period, see [Config.Immutable].
 func (s *gormDB) Delete(r any, conds ...any) (err error) {
+	if err = s.checkImmutable(r, false, conds...); err != nil {
+		return err
+	}
+
 	// Remove record r with a given ID
 	db := s.DB.Delete(r, conds...)
 	if err = db.Error; err != nil { // db error
@@ -133,12 +150,14 @@ func (s *gormDB) List(r any, orderBy string, asc bool, offset int, limit int, co
 	}
 
 	// Use GORM's clause.OrderByColumn to safely handle column names
-	// This prevents SQL injection by treating the column name as an identifier
+	// This prevents SQL injection by treating each column name as an identifier
 	if orderBy != "" {
-		db = db.Order(clause.OrderByColumn{
-			Column: clause.Column{Name: orderBy},
-			Desc:   !asc,
-		})
+		for column := range strings.SplitSeq(orderBy, ",") {
+			db = db.Order(clause.OrderByColumn{
+				Column: clause.Column{Name: strings.TrimSpace(column)},
+				Desc:   !asc,
+			})
+		}
 	}
 
 	// Preload all associations of r if necessary
@@ -0,0 +1,318 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package persistencetest
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+	"time"
+
+	"confirmate.io/core/api/assessment"
+	"confirmate.io/core/api/orchestrator"
+	"confirmate.io/core/persistence"
+	"confirmate.io/core/util/assert"
+
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// The dimensions of the scenario that is seeded by the seed packs.
+const (
+	// SeedCategoryCount is the number of categories of the seeded catalog.
+	SeedCategoryCount = 3
+	// SeedControlsPerCategory is the number of (top-level) controls per category of the seeded catalog.
+	SeedControlsPerCategory = 4
+	// SeedSubControlsPerControl is the number of sub-controls per control of the seeded catalog.
+	SeedSubControlsPerControl = 3
+	// SeedTargetOfEvaluationCount is the number of seeded targets of evaluation. Each of them has its own audit scope.
+	SeedTargetOfEvaluationCount = 2
+	// SeedResourcesPerMetric is the number of resources per target of evaluation that are assessed for each metric.
+	SeedResourcesPerMetric = 10
+
+	// SeedCatalogId is the ID of the seeded catalog.
+	SeedCatalogId = "00000000-0000-0000-5eed-000000000001"
+	// SeedToolId is the ID of the assessment tool of the seeded assessment results.
+	SeedToolId = "00000000-0000-0000-5eed-000000000002"
+)
+
+// seedCategories contains the names and control prefixes of the seeded categories.
+var seedCategories = [SeedCategoryCount]struct {
+	name   string
+	prefix string
+}{
+	{"Operational Security", "OPS"},
+	{"Identity and Access Management", "IAM"},
+	{"Cryptography and Key Management", "CRY"},
+}
+
+// seedMetrics contains the names and resource types of the seeded metrics.
+var seedMetrics = []struct {
+	name  string
+	types []string
+}{
+	{"BootLoggingEnabled", []string{"VirtualMachine"}},
+	{"MalwareProtectionEnabled", []string{"VirtualMachine"}},
+	{"AtRestEncryptionEnabled", []string{"BlockStorage", "ObjectStorage"}},
+	{"TransportEncryptionEnabled", []string{"HttpEndpoint"}},
+	{"MultiFactorAuthenticationEnabled", []string{"Identity"}},
+	{"BackupEnabled", []string{"BlockStorage"}},
+}
+
+// seedAssuranceLevels contains the assurance levels that are assigned to the seeded sub-controls in turn.
+var seedAssuranceLevels = []string{"basic", "substantial", "high"}
+
+// SeedPack is a reusable set of records that is seeded into a database, e.g., the catalog of a scenario. The records
+// are created in their order, so referenced records need to come before the records referencing them.
+type SeedPack struct {
+	// Name identifies the pack in failure messages.
+	Name string
+
+	// Records contains the records to create.
+	Records []any
+}
+
+// Seed returns an init function for [NewInMemoryDB] that creates the records of the given packs in their order. If a
+// record cannot be created, the test will panic immediately.
+func Seed(t *testing.T, packs ...*SeedPack) func(persistence.DB) {
+	return func(db persistence.DB) {
+		for _, pack := range packs {
+			for _, r := range pack.Records {
+				if err := db.Create(r); !assert.NoError(t, err, "could not seed %s", pack.Name) {
+					panic(err)
+				}
+			}
+		}
+	}
+}
+
+// ScenarioPacks returns all seed packs of the scenario in the order they need to be seeded, i.e., the catalog and its
+// metrics, the targets of evaluation, their audit scopes and their assessment results.
+func ScenarioPacks() []*SeedPack {
+	return []*SeedPack{
+		CatalogPack(),
+		TargetsOfEvaluationPack(),
+		AuditScopesPack(),
+		AssessmentResultsPack(),
+	}
+}
+
+// CatalogPack returns a pack with a catalog of [SeedCategoryCount] categories, each with [SeedControlsPerCategory]
+// controls of [SeedSubControlsPerControl] sub-controls. Every sub-control has one of the metrics, which are part of
+// the pack as well.
+func CatalogPack() *SeedPack {
+	var (
+		metrics = seedMetricRecords()
+		catalog = &orchestrator.Catalog{
+			Id:              SeedCatalogId,
+			Name:            "Seed Catalog",
+			Description:     "A catalog seeded for tests",
+			ShortName:       "SEED",
+			AssuranceLevels: slices.Clone(seedAssuranceLevels),
+		}
+		pack = &SeedPack{Name: "catalog"}
+		n    int
+	)
+
+	for i, c := range seedCategories {
+		category := &orchestrator.Category{
+			Name:      c.name,
+			CatalogId: SeedCatalogId,
+		}
+
+		for j := range SeedControlsPerCategory {
+			control := &orchestrator.Control{
+				Id:        SeedControlId(i, j),
+				Name:      fmt.Sprintf("%s control %d", c.name, j+1),
+				ShortName: fmt.Sprintf("%s-%02d", c.prefix, j+1),
+				CatalogId: SeedCatalogId,
+			}
+
+			for k := range SeedSubControlsPerControl {
+				control.Controls = append(control.Controls, &orchestrator.Control{
+					Id:              SeedSubControlId(i, j, k),
+					Name:            fmt.Sprintf("%s control %d.%d", c.name, j+1, k+1),
+					ShortName:       fmt.Sprintf("%s-%02d.%d", c.prefix, j+1, k+1),
+					CatalogId:       SeedCatalogId,
+					ParentControlId: new(control.Id),
+					AssuranceLevel:  new(seedAssuranceLevels[k%len(seedAssuranceLevels)]),
+					Metrics:         []*assessment.Metric{metrics[n%len(metrics)]},
+				})
+				n++
+			}
+
+			category.Controls = append(category.Controls, control)
+		}
+
+		catalog.Categories = append(catalog.Categories, category)
+	}
+
+	for _, m := range metrics {
+		pack.Records = append(pack.Records, m)
+	}
+	pack.Records = append(pack.Records, catalog)
+
+	return pack
+}
+
+// TargetsOfEvaluationPack returns a pack with [SeedTargetOfEvaluationCount] cloud targets of evaluation.
+func TargetsOfEvaluationPack() *SeedPack {
+	var pack = &SeedPack{Name: "targets of evaluation"}
+
+	for i := range SeedTargetOfEvaluationCount {
+		pack.Records = append(pack.Records, &orchestrator.TargetOfEvaluation{
+			Id:         SeedToeId(i),
+			Name:       fmt.Sprintf("Seed Target of Evaluation %d", i+1),
+			TargetType: orchestrator.TargetOfEvaluation_TARGET_TYPE_CLOUD,
+		})
+	}
+
+	return pack
+}
+
+// AuditScopesPack returns a pack with an audit scope of the seeded catalog for each seeded target of evaluation. It
+// requires the [CatalogPack] and the [TargetsOfEvaluationPack].
+func AuditScopesPack() *SeedPack {
+	var pack = &SeedPack{Name: "audit scopes"}
+
+	for i := range SeedTargetOfEvaluationCount {
+		pack.Records = append(pack.Records, &orchestrator.AuditScope{
+			Id:                   SeedAuditScopeId(i),
+			Name:                 fmt.Sprintf("Seed Audit Scope %d", i+1),
+			TargetOfEvaluationId: SeedToeId(i),
+			CatalogId:            SeedCatalogId,
+		})
+	}
+
+	return pack
+}
+
+// AssessmentResultsPack returns a pack with an assessment result for each seeded metric and each of the
+// [SeedResourcesPerMetric] resources of every seeded target of evaluation. The results are spread like in a realistic
+// deployment: of every ten resources, six are compliant, two are not compliant, one is not compliant but waived and
+// one is compliant, but stale. The results were created in the last hours. It requires the [CatalogPack] and the
+// [TargetsOfEvaluationPack].
+func AssessmentResultsPack() *SeedPack {
+	var (
+		pack = &SeedPack{Name: "assessment results"}
+		now  = time.Now()
+		n    int
+	)
+
+	for i := range SeedTargetOfEvaluationCount {
+		for j, m := range seedMetrics {
+			for k := range SeedResourcesPerMetric {
+				var (
+					createdAt = timestamppb.New(now.Add(-time.Duration(k+1) * time.Hour))
+					r         = &assessment.AssessmentResult{
+						Id:        SeedAssessmentResultId(n),
+						CreatedAt: createdAt,
+						MetricId:  SeedMetricId(j),
+						MetricConfiguration: &assessment.MetricConfiguration{
+							TargetOfEvaluationId: SeedToeId(i),
+							MetricId:             SeedMetricId(j),
+							Operator:             "==",
+							TargetValue:          structpb.NewBoolValue(true),
+							IsDefault:            true,
+						},
+						Compliant:            true,
+						EvidenceId:           SeedEvidenceId(n),
+						ResourceId:           fmt.Sprintf("seed-toe-%d-resource-%d", i+1, k+1),
+						ResourceTypes:        m.types,
+						TargetOfEvaluationId: SeedToeId(i),
+						ToolId:               new(SeedToolId),
+						HistoryUpdatedAt:     createdAt,
+						History: []*assessment.Record{
+							{EvidenceId: SeedEvidenceId(n), EvidenceRecordedAt: createdAt},
+						},
+					}
+				)
+
+				switch k % 10 {
+				case 6, 7:
+					r.Compliant = false
+				case 8:
+					r.Compliant = false
+					r.Waiver = &assessment.Waiver{Justification: "Accepted risk"}
+				case 9:
+					r.StaleAt = timestamppb.New(now.Add(-time.Minute))
+				}
+
+				pack.Records = append(pack.Records, r)
+				n++
+			}
+		}
+	}
+
+	return pack
+}
+
+// seedMetricRecords returns the seeded metrics.
+func seedMetricRecords() (metrics []*assessment.Metric) {
+	for i, m := range seedMetrics {
+		metrics = append(metrics, &assessment.Metric{
+			Id:            SeedMetricId(i),
+			Name:          m.name,
+			Description:   fmt.Sprintf("Seeded metric %s", m.name),
+			Version:       "1.0",
+			Category:      "Seed",
+			ResourceTypes: m.types,
+		})
+	}
+
+	return
+}
+
+// SeedMetricId returns the ID of the i-th seeded metric.
+func SeedMetricId(i int) string {
+	return seedId(1, i)
+}
+
+// SeedControlId returns the ID of the j-th control of the i-th category of the seeded catalog.
+func SeedControlId(i int, j int) string {
+	return seedId(2, i*0x100+j)
+}
+
+// SeedSubControlId returns the ID of the k-th sub-control of the j-th control of the i-th category of the seeded
+// catalog.
+func SeedSubControlId(i int, j int, k int) string {
+	return seedId(3, i*0x10000+j*0x100+k)
+}
+
+// SeedToeId returns the ID of the i-th seeded target of evaluation.
+func SeedToeId(i int) string {
+	return seedId(4, i)
+}
+
+// SeedAuditScopeId returns the ID of the audit scope of the i-th seeded target of evaluation.
+func SeedAuditScopeId(i int) string {
+	return seedId(5, i)
+}
+
+// SeedAssessmentResultId returns the ID of the n-th seeded assessment result.
+func SeedAssessmentResultId(n int) string {
+	return seedId(6, n)
+}
+
+// SeedEvidenceId returns the ID of the evidence of the n-th seeded assessment result.
+func SeedEvidenceId(n int) string {
+	return seedId(7, n)
+}
+
+// seedId returns a deterministic UUID for the n-th seeded record of the given kind.
+func seedId(kind int, n int) string {
+	return fmt.Sprintf("00000000-0000-%04x-5eed-%012x", kind, n)
+}
@@ -0,0 +1,115 @@
+// Copyright 2016-2025 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package persistencetest
+
+import (
+	"testing"
+
+	"confirmate.io/core/api/assessment"
+	"confirmate.io/core/api/orchestrator"
+	"confirmate.io/core/persistence"
+	"confirmate.io/core/util/assert"
+)
+
+// seedTypes are the types needed to seed all packs of the scenario.
+var seedTypes = []any{
+	&assessment.Metric{},
+	&orchestrator.TargetOfEvaluation{},
+	&orchestrator.Catalog{},
+	&orchestrator.Control{},
+	&orchestrator.ControlMetric{},
+	&orchestrator.Category{},
+	&orchestrator.AuditScope{},
+	&assessment.MetricConfiguration{},
+	&assessment.AssessmentResult{},
+	&assessment.Waiver{},
+	&orchestrator.ControlInScope{},
+}
+
+// seedJoinTables are the join tables needed to seed all packs of the scenario.
+var seedJoinTables = []persistence.CustomJoinTable{
+	{
+		Model:     orchestrator.TargetOfEvaluation{},
+		Field:     "ConfiguredMetrics",
+		JoinTable: assessment.MetricConfiguration{},
+	},
+	{
+		Model:     orchestrator.Control{},
+		Field:     "Metrics",
+		JoinTable: orchestrator.ControlMetric{},
+	},
+}
+
+func TestSeed(t *testing.T) {
+	var (
+		count    int64
+		err      error
+		results  []*assessment.AssessmentResult
+		controls []*orchestrator.Control
+	)
+
+	db := NewInMemoryDB(t, seedTypes, seedJoinTables, Seed(t, ScenarioPacks()...))
+
+	count, err = db.Count(&assessment.Metric{})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(len(seedMetrics)), count)
+
+	count, err = db.Count(&orchestrator.Control{})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(SeedCategoryCount*SeedControlsPerCategory*(1+SeedSubControlsPerControl)), count)
+
+	count, err = db.Count(&orchestrator.AuditScope{}, "catalog_id = ?", SeedCatalogId)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(SeedTargetOfEvaluationCount), count)
+
+	// Sub-controls reference their metrics
+	err = db.List(&controls, "id", true, 0, -1, "parent_control_id = ?", SeedControlId(0, 0))
+	assert.NoError(t, err)
+	assert.Equal(t, SeedSubControlsPerControl, len(controls))
+	assert.Equal(t, 1, len(controls[0].GetMetrics()))
+
+	// The assessment results are spread across compliant, non-compliant, waived and stale results
+	err = db.List(&results, "id", true, 0, -1, "target_of_evaluation_id = ? AND metric_id = ?", SeedToeId(1), SeedMetricId(0))
+	assert.NoError(t, err)
+	assert.Equal(t, SeedResourcesPerMetric, len(results))
+
+	var compliant, waived, stale int
+	for _, r := range results {
+		if r.GetCompliant() {
+			compliant++
+		}
+		if r.GetWaiver() != nil {
+			waived++
+		}
+		if r.GetStaleAt() != nil {
+			stale++
+		}
+	}
+	assert.Equal(t, 7, compliant)
+	assert.Equal(t, 1, waived)
+	assert.Equal(t, 1, stale)
+}
+
+func TestSeed_independentPacks(t *testing.T) {
+	var (
+		first  = CatalogPack()
+		second = CatalogPack()
+	)
+
+	// Every call returns new records, so that tests can modify them
+	first.Records[len(first.Records)-1].(*orchestrator.Catalog).Name = "Modified"
+	assert.Equal(t, "Seed Catalog", second.Records[len(second.Records)-1].(*orchestrator.Catalog).GetName())
+}
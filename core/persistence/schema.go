@@ -0,0 +1,270 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package persistence
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+
+	"gorm.io/gorm/schema"
+)
+
+// The types of [RelationshipSchema.Type].
+const (
+	RelationshipHasOne     = string(schema.HasOne)
+	RelationshipHasMany    = string(schema.HasMany)
+	RelationshipBelongsTo  = string(schema.BelongsTo)
+	RelationshipManyToMany = string(schema.Many2Many)
+
+	// RelationshipReferences is the type of relationships that are described by a [ColumnReference] instead of an
+	// association. The database does not enforce them with a foreign key constraint.
+	RelationshipReferences = "references"
+)
+
+// ColumnReference describes a relationship between two types that is not modeled as an association, but only by a
+// column that holds the (single column) primary key of the referenced type, e.g., the target of evaluation ID of an
+// audit scope.
+type ColumnReference struct {
+	Model      any    // The referencing struct (e.g., &AuditScope{})
+	Field      string // The field name in the struct that holds the reference (e.g., "TargetOfEvaluationId")
+	References any    // The referenced struct (e.g., &TargetOfEvaluation{})
+}
+
+// EntitySchema describes how a type is persisted and how it is related to other types.
+type EntitySchema struct {
+	// Name is the name of the type.
+	Name string
+
+	// Table is the name of the database table.
+	Table string
+
+	// Fields contains the persisted fields in the order of the struct.
+	Fields []FieldSchema
+
+	// Relationships contains the relationships to other types, sorted by their name.
+	Relationships []RelationshipSchema
+}
+
+// FieldSchema describes a persisted field.
+type FieldSchema struct {
+	// Name is the name of the field in the struct.
+	Name string
+
+	// Column is the name of the database column.
+	Column string
+
+	// DataType is the (database-independent) data type of the column, e.g., "string" or "time".
+	DataType string
+
+	// PrimaryKey is true, if the column is (part of) the primary key.
+	PrimaryKey bool
+
+	// Serializer is the name of the serializer, e.g., "json", if the field is serialized into the column.
+	Serializer string
+}
+
+// RelationshipSchema describes a relationship between two types.
+type RelationshipSchema struct {
+	// Name is the name of the field that holds the relationship.
+	Name string
+
+	// Type is the type of the relationship, e.g., [RelationshipHasMany].
+	Type string
+
+	// Entity is the name of the related type.
+	Entity string
+
+	// JoinTable is the name of the join table of a [RelationshipManyToMany] relationship.
+	JoinTable string
+
+	// ForeignKeys contains the columns that join both tables (or each of them with the join table).
+	ForeignKeys []ForeignKeySchema
+}
+
+// ForeignKeySchema describes a column that references a column of another table.
+type ForeignKeySchema struct {
+	Table            string
+	Column           string
+	ReferencedTable  string
+	ReferencedColumn string
+}
+
+// DescribeSchema describes the tables of [Config.Types] and their relationships, including the custom join tables of
+// [Config.CustomJoinTables] and the given column references. This allows external tools to join the tables without
+// reverse-engineering the models.
+func DescribeSchema(cfg Config, refs ...ColumnReference) (entities []EntitySchema, err error) {
+	var (
+		cache      = new(sync.Map)
+		namer      = namingStrategy()
+		s          *schema.Schema
+		joinTables = make(map[string]string)
+		index      = make(map[string]int)
+	)
+
+	registerSerializers()
+
+	// Determine the table names of the custom join tables by model and field
+	for _, jt := range cfg.CustomJoinTables {
+		var model *schema.Schema
+
+		if model, err = schema.Parse(jt.Model, cache, namer); err != nil {
+			return nil, fmt.Errorf("could not parse model of join table: %w", err)
+		}
+		if s, err = schema.Parse(jt.JoinTable, cache, namer); err != nil {
+			return nil, fmt.Errorf("could not parse join table: %w", err)
+		}
+
+		joinTables[model.Name+"."+jt.Field] = s.Table
+	}
+
+	for _, typ := range cfg.Types {
+		if s, err = schema.Parse(typ, cache, namer); err != nil {
+			return nil, fmt.Errorf("could not parse type: %w", err)
+		}
+
+		index[s.Name] = len(entities)
+		entities = append(entities, describeEntity(s, joinTables))
+	}
+
+	for _, ref := range refs {
+		var (
+			rel    RelationshipSchema
+			target *schema.Schema
+			field  *schema.Field
+			i      int
+			ok     bool
+		)
+
+		if s, err = schema.Parse(ref.Model, cache, namer); err != nil {
+			return nil, fmt.Errorf("could not parse referencing type: %w", err)
+		}
+		if target, err = schema.Parse(ref.References, cache, namer); err != nil {
+			return nil, fmt.Errorf("could not parse referenced type: %w", err)
+		}
+
+		if field = s.LookUpField(ref.Field); field == nil || field.DBName == "" {
+			return nil, fmt.Errorf("field %s of %s is not persisted", ref.Field, s.Name)
+		}
+		if len(target.PrimaryFields) != 1 {
+			return nil, fmt.Errorf("%s does not have a single column primary key", target.Name)
+		}
+		if i, ok = index[s.Name]; !ok {
+			return nil, fmt.Errorf("%s is not one of the types", s.Name)
+		}
+
+		rel = RelationshipSchema{
+			Name:   ref.Field,
+			Type:   RelationshipReferences,
+			Entity: target.Name,
+			ForeignKeys: []ForeignKeySchema{{
+				Table:            s.Table,
+				Column:           field.DBName,
+				ReferencedTable:  target.Table,
+				ReferencedColumn: target.PrimaryFields[0].DBName,
+			}},
+		}
+
+		entities[i].Relationships = append(entities[i].Relationships, rel)
+		sortRelationships(entities[i].Relationships)
+	}
+
+	return entities, nil
+}
+
+// describeEntity describes the persisted fields and the associations of the parsed type.
+func describeEntity(s *schema.Schema, joinTables map[string]string) (entity EntitySchema) {
+	entity = EntitySchema{
+		Name:  s.Name,
+		Table: s.Table,
+	}
+
+	for _, field := range s.Fields {
+		if field.DBName == "" {
+			continue
+		}
+
+		entity.Fields = append(entity.Fields, FieldSchema{
+			Name:       field.Name,
+			Column:     field.DBName,
+			DataType:   string(field.DataType),
+			PrimaryKey: field.PrimaryKey,
+			Serializer: field.TagSettings["SERIALIZER"],
+		})
+	}
+
+	for _, rel := range s.Relationships.Relations {
+		// GORM also registers the relationships of other types with their related type, we only want our own
+		if rel.Schema != s {
+			continue
+		}
+
+		var r = RelationshipSchema{
+			Name:   rel.Name,
+			Type:   string(rel.Type),
+			Entity: rel.FieldSchema.Name,
+		}
+
+		if rel.JoinTable != nil {
+			r.JoinTable = rel.JoinTable.Table
+			if table, ok := joinTables[s.Name+"."+rel.Name]; ok {
+				r.JoinTable = table
+			}
+		}
+
+		for _, ref := range rel.References {
+			// Polymorphic relationships reference a fixed value instead of a column
+			if ref.PrimaryKey == nil || ref.ForeignKey == nil {
+				continue
+			}
+
+			fk := ForeignKeySchema{
+				Table:            ref.ForeignKey.Schema.Table,
+				Column:           ref.ForeignKey.DBName,
+				ReferencedTable:  ref.PrimaryKey.Schema.Table,
+				ReferencedColumn: ref.PrimaryKey.DBName,
+			}
+			if rel.JoinTable != nil && ref.ForeignKey.Schema == rel.JoinTable {
+				fk.Table = r.JoinTable
+			}
+
+			r.ForeignKeys = append(r.ForeignKeys, fk)
+		}
+
+		entity.Relationships = append(entity.Relationships, r)
+	}
+
+	sortRelationships(entity.Relationships)
+
+	return entity
+}
+
+// sortRelationships sorts the relationships by their name.
+func sortRelationships(rels []RelationshipSchema) {
+	slices.SortFunc(rels, func(a, b RelationshipSchema) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+}
+
+// namingStrategy returns the naming strategy that GORM uses for [DefaultGormConfig].
+func namingStrategy() schema.Namer {
+	if DefaultGormConfig.NamingStrategy != nil {
+		return DefaultGormConfig.NamingStrategy
+	}
+
+	return schema.NamingStrategy{IdentifierMaxLength: 64}
+}
@@ -0,0 +1,205 @@
+// Copyright 2016-2025 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package persistence_test
+
+import (
+	"testing"
+
+	"confirmate.io/core/persistence"
+	"confirmate.io/core/util/assert"
+)
+
+type schemaOwner struct {
+	Id    string         `gorm:"primaryKey"`
+	Tags  []string       `gorm:"serializer:json"`
+	Items []*schemaItem  `gorm:"foreignKey:OwnerId"`
+	Peers []*schemaOwner `gorm:"many2many:owner_peers"`
+}
+
+type schemaItem struct {
+	Id      string `gorm:"primaryKey"`
+	OwnerId string
+	OtherId string
+}
+
+type schemaOther struct {
+	Id string `gorm:"primaryKey"`
+}
+
+type schemaOwnerPeer struct {
+	SchemaOwnerId string `gorm:"primaryKey"`
+	PeerId        string `gorm:"primaryKey"`
+}
+
+func TestDescribeSchema(t *testing.T) {
+	type args struct {
+		cfg  persistence.Config
+		refs []persistence.ColumnReference
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    assert.Want[[]persistence.EntitySchema]
+		wantErr assert.WantErr
+	}{
+		{
+			name: "happy path",
+			args: args{
+				cfg: persistence.Config{
+					Types: []any{&schemaOwner{}, &schemaItem{}, &schemaOther{}},
+				},
+				refs: []persistence.ColumnReference{
+					{Model: &schemaItem{}, Field: "OtherId", References: &schemaOther{}},
+				},
+			},
+			want: func(t *testing.T, got []persistence.EntitySchema, msgAndArgs ...any) bool {
+				return assert.Equal(t, []persistence.EntitySchema{
+					{
+						Name:  "schemaOwner",
+						Table: "schema_owners",
+						Fields: []persistence.FieldSchema{
+							{Name: "Id", Column: "id", DataType: "string", PrimaryKey: true},
+							{Name: "Tags", Column: "tags", DataType: "string", Serializer: "json"},
+						},
+						Relationships: []persistence.RelationshipSchema{
+							{
+								Name:   "Items",
+								Type:   persistence.RelationshipHasMany,
+								Entity: "schemaItem",
+								ForeignKeys: []persistence.ForeignKeySchema{
+									{Table: "schema_items", Column: "owner_id", ReferencedTable: "schema_owners", ReferencedColumn: "id"},
+								},
+							},
+							{
+								Name:      "Peers",
+								Type:      persistence.RelationshipManyToMany,
+								Entity:    "schemaOwner",
+								JoinTable: "owner_peers",
+								ForeignKeys: []persistence.ForeignKeySchema{
+									{Table: "owner_peers", Column: "schema_owner_id", ReferencedTable: "schema_owners", ReferencedColumn: "id"},
+									{Table: "owner_peers", Column: "peer_id", ReferencedTable: "schema_owners", ReferencedColumn: "id"},
+								},
+							},
+						},
+					},
+					{
+						Name:  "schemaItem",
+						Table: "schema_items",
+						Fields: []persistence.FieldSchema{
+							{Name: "Id", Column: "id", DataType: "string", PrimaryKey: true},
+							{Name: "OwnerId", Column: "owner_id", DataType: "string"},
+							{Name: "OtherId", Column: "other_id", DataType: "string"},
+						},
+						Relationships: []persistence.RelationshipSchema{
+							{
+								Name:   "OtherId",
+								Type:   persistence.RelationshipReferences,
+								Entity: "schemaOther",
+								ForeignKeys: []persistence.ForeignKeySchema{
+									{Table: "schema_items", Column: "other_id", ReferencedTable: "schema_others", ReferencedColumn: "id"},
+								},
+							},
+						},
+					},
+					{
+						Name:  "schemaOther",
+						Table: "schema_others",
+						Fields: []persistence.FieldSchema{
+							{Name: "Id", Column: "id", DataType: "string", PrimaryKey: true},
+						},
+					},
+				}, got)
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "happy path: custom join table",
+			args: args{
+				cfg: persistence.Config{
+					Types: []any{&schemaOwner{}, &schemaItem{}},
+					CustomJoinTables: []persistence.CustomJoinTable{
+						{Model: schemaOwner{}, Field: "Peers", JoinTable: schemaOwnerPeer{}},
+					},
+				},
+			},
+			want: func(t *testing.T, got []persistence.EntitySchema, msgAndArgs ...any) bool {
+				return assert.Equal(t, persistence.RelationshipSchema{
+					Name:      "Peers",
+					Type:      persistence.RelationshipManyToMany,
+					Entity:    "schemaOwner",
+					JoinTable: "schema_owner_peers",
+					ForeignKeys: []persistence.ForeignKeySchema{
+						{Table: "schema_owner_peers", Column: "schema_owner_id", ReferencedTable: "schema_owners", ReferencedColumn: "id"},
+						{Table: "schema_owner_peers", Column: "peer_id", ReferencedTable: "schema_owners", ReferencedColumn: "id"},
+					},
+				}, got[0].Relationships[1])
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "reference of a field that is not persisted",
+			args: args{
+				cfg: persistence.Config{
+					Types: []any{&schemaItem{}, &schemaOther{}},
+				},
+				refs: []persistence.ColumnReference{
+					{Model: &schemaItem{}, Field: "DoesNotExist", References: &schemaOther{}},
+				},
+			},
+			want: assert.Nil[[]persistence.EntitySchema],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.ErrorContains(t, err, "field DoesNotExist of schemaItem is not persisted")
+			},
+		},
+		{
+			name: "reference of a type that is not described",
+			args: args{
+				cfg: persistence.Config{
+					Types: []any{&schemaOther{}},
+				},
+				refs: []persistence.ColumnReference{
+					{Model: &schemaItem{}, Field: "OtherId", References: &schemaOther{}},
+				},
+			},
+			want: assert.Nil[[]persistence.EntitySchema],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.ErrorContains(t, err, "schemaItem is not one of the types")
+			},
+		},
+		{
+			name: "reference to a type without single column primary key",
+			args: args{
+				cfg: persistence.Config{
+					Types: []any{&schemaItem{}},
+				},
+				refs: []persistence.ColumnReference{
+					{Model: &schemaItem{}, Field: "OtherId", References: &schemaOwnerPeer{}},
+				},
+			},
+			want: assert.Nil[[]persistence.EntitySchema],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.ErrorContains(t, err, "does not have a single column primary key")
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := persistence.DescribeSchema(tt.args.cfg, tt.args.refs...)
+			tt.want(t, got)
+			tt.wantErr(t, err)
+		})
+	}
+}
@@ -0,0 +1,88 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package policies
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"confirmate.io/core/api/assessment"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// evalComposites evaluates the given composite metrics based on the results of their metrics in data, which must have
+// been evaluated for the same resource m. Like other metrics, composite metrics that are not configured for the target
+// of evaluation are skipped, as well as composite metrics none of whose metrics has a result.
+func evalComposites(ctx context.Context, targetID string, composites []*assessment.Metric, data []*CombinedResult, m map[string]any, src MetricsSource) (results []*CombinedResult, err error) {
+	var (
+		compliance = make(map[string]bool, len(data))
+		names      = make(map[string]string, len(data))
+		config     *assessment.MetricConfiguration
+	)
+
+	for _, d := range data {
+		compliance[d.MetricID] = d.Compliant
+		names[d.MetricID] = d.MetricName
+	}
+
+	for _, metric := range composites {
+		applicable, compliant := metric.GetComposite().Combine(compliance)
+		if !applicable {
+			continue
+		}
+
+		config, err = src.MetricConfiguration(ctx, targetID, metric)
+		if connect.CodeOf(err) == connect.CodeNotFound && strings.Contains(err.Error(), "metric configuration not found") {
+			continue
+		} else if err != nil {
+			return nil, fmt.Errorf("could not fetch metric configuration for metric %s: %w", metric.Name, err)
+		}
+
+		result := &CombinedResult{
+			Applicable:  true,
+			Compliant:   compliant,
+			MetricID:    metric.Id,
+			MetricName:  metric.Name,
+			Config:      config,
+			Correlation: metric.GetCorrelation(),
+		}
+
+		// Each result of a metric is a comparison detail of the composite result
+		for _, id := range metric.GetComposite().GetMetricIds() {
+			c, ok := compliance[id]
+			if !ok {
+				continue
+			}
+
+			result.ComparisonResult = append(result.ComparisonResult, &assessment.ComparisonResult{
+				Property:    names[id],
+				Value:       structpb.NewBoolValue(c),
+				Operator:    "==",
+				TargetValue: structpb.NewBoolValue(true),
+				Success:     c,
+			})
+		}
+
+		result.Message = metric.Message(compliant, assessment.NewMessageData(m, config))
+
+		results = append(results, result)
+	}
+
+	return results, nil
+}
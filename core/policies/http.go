@@ -0,0 +1,273 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package policies
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"confirmate.io/core/api/assessment"
+	"confirmate.io/core/api/evidence"
+	"confirmate.io/core/api/ontology"
+	"confirmate.io/core/util"
+
+	"connectrpc.com/connect"
+)
+
+// httpEval is a [PolicyEval] that delegates the evaluation of metrics to an external policy server, such as an OPA
+// server, using the OPA Data API. The policies of the metrics need to be deployed on the server beforehand; the metric
+// implementations of the metrics source are not used.
+//
+// For each metric, the server receives the resource as input, with its related resources in "related", the resources of
+// correlated evidences in "correlated" and the metric configuration in "metric_configuration", and is expected to
+// return the same document as the embedded Rego policies, i.e., an object containing "applicable", "compliant" and
+// optionally "results" and "message". Metrics for which the server has no policy are treated as not applicable.
+type httpEval struct {
+	// url is the base URL of the policy server
+	url string
+
+	// client is the HTTP client used to query the policy server
+	client *http.Client
+
+	// pkg is the base package name of the policies on the policy server
+	pkg string
+
+	// mrtc stores a list of applicable metrics per toolID and resourceType
+	mrtc *metricsCache
+}
+
+// HTTPEvalOption is an option to configure the policy evaluation using an external policy server.
+type HTTPEvalOption func(he *httpEval)
+
+// WithHTTPClient is an option to configure the HTTP client used to query the policy server, e.g., to supply
+// credentials or a custom CA.
+func WithHTTPClient(client *http.Client) HTTPEvalOption {
+	return func(he *httpEval) {
+		he.client = client
+	}
+}
+
+// WithPolicyPackage is an option to configure the base package name of the policies on the policy server.
+func WithPolicyPackage(pkg string) HTTPEvalOption {
+	return func(he *httpEval) {
+		he.pkg = pkg
+	}
+}
+
+// NewHTTPEval creates a new [PolicyEval] that evaluates metrics using the policy server at the given base URL.
+func NewHTTPEval(url string, opts ...HTTPEvalOption) PolicyEval {
+	he := httpEval{
+		url:    strings.TrimSuffix(url, "/"),
+		client: http.DefaultClient,
+		pkg:    DefaultRegoPackage,
+		mrtc:   &metricsCache{m: make(map[string][]*assessment.Metric)},
+	}
+
+	for _, o := range opts {
+		o(&he)
+	}
+
+	return &he
+}
+
+// Eval evaluates a given evidence against all metrics of the metrics source using the policy server and returns the
+// result of all metrics that were considered to be applicable.
+func (he *httpEval) Eval(ctx context.Context, evidence *evidence.Evidence, r ontology.IsResource, related map[string]ontology.IsResource, correlated map[string]ontology.IsResource, src MetricsSource) (data []*CombinedResult, err error) {
+	var (
+		m          map[string]any
+		types      []string
+		metrics    []*assessment.Metric
+		cached     []*assessment.Metric
+		result     *CombinedResult
+		results    []*CombinedResult
+		composites []*assessment.Metric
+		fill       bool
+	)
+
+	m, err = policyInput(r, related, correlated)
+	if err != nil {
+		return nil, err
+	}
+
+	types = ontology.ResourceTypes(r)
+	key := createKey(evidence, types)
+
+	he.mrtc.RLock()
+	metrics = he.mrtc.m[key]
+	he.mrtc.RUnlock()
+
+	// Without cached metrics, we need to find out which metrics are applicable for this kind of resource
+	if metrics == nil {
+		metrics, err = src.Metrics(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("could not retrieve metric definitions: %w", err)
+		}
+
+		fill = true
+		cached = []*assessment.Metric{}
+	}
+
+	for _, metric := range metrics {
+		// Metrics that declare to apply to other resource types only do not need to be evaluated at all
+		if !metric.AppliesTo(types) {
+			continue
+		}
+
+		// Composite metrics are evaluated once the results of their metrics are known. Whether they are applicable
+		// depends on these results, so they are always cached.
+		if metric.GetComposite() != nil {
+			composites = append(composites, metric)
+			cached = append(cached, metric)
+			continue
+		}
+
+		result, err = he.evalMetric(ctx, evidence.TargetOfEvaluationId, metric, m, src)
+		// Like the embedded evaluation, we skip metrics that are not configured for the target of evaluation
+		if connect.CodeOf(err) == connect.CodeNotFound && strings.Contains(err.Error(), "metric configuration not found") {
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+
+		// Only applicable metrics end up in the results and in the cache
+		if result != nil {
+			data = append(data, result)
+			cached = append(cached, metric)
+		}
+	}
+
+	results, err = evalComposites(ctx, evidence.TargetOfEvaluationId, composites, data, m, src)
+	if err != nil {
+		return nil, err
+	}
+	data = append(data, results...)
+
+	if fill {
+		he.mrtc.Lock()
+		he.mrtc.m[key] = cached
+		he.mrtc.Unlock()
+
+		slog.Info("Resource type has the applicable metric(s)", slog.Any("key", key), slog.Any("len", len(cached)), slog.Any("names", namesOf(cached)))
+	}
+
+	return data, nil
+}
+
+// ClearCache discards the cached applicable metrics of all resource types.
+func (he *httpEval) ClearCache() {
+	he.mrtc.Lock()
+	clear(he.mrtc.m)
+	he.mrtc.Unlock()
+}
+
+// evalMetric queries the policy of the metric on the policy server with the resource map m as input. It returns nil,
+// if the metric is not applicable to the resource or if the policy server has no policy for the metric.
+func (he *httpEval) evalMetric(ctx context.Context, targetID string, metric *assessment.Metric, m map[string]any, src MetricsSource) (result *CombinedResult, err error) {
+	var (
+		config *assessment.MetricConfiguration
+		input  = make(map[string]any, len(m)+1)
+		body   []byte
+		req    *http.Request
+		res    *http.Response
+		out    struct {
+			Result *struct {
+				Applicable bool                           `json:"applicable"`
+				Compliant  bool                           `json:"compliant"`
+				Results    []*assessment.ComparisonResult `json:"results"`
+				Message    *string                        `json:"message"`
+			} `json:"result"`
+		}
+	)
+
+	config, err = src.MetricConfiguration(ctx, targetID, metric)
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch metric configuration for metric %s: %w", metric.Name, err)
+	}
+
+	for k, v := range m {
+		input[k] = v
+	}
+	input["metric_configuration"] = map[string]any{
+		"target_value": config.TargetValue.AsInterface(),
+		"operator":     config.Operator,
+		"config":       config,
+	}
+
+	body, err = json.Marshal(map[string]any{"input": input})
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal input for metric %s: %w", metric.Name, err)
+	}
+
+	req, err = http.NewRequestWithContext(ctx, http.MethodPost, he.policyURL(metric), bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("could not create request for metric %s: %w", metric.Name, err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err = he.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("could not query policy server for metric %s: %w", metric.Name, err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("could not query policy server for metric %s: unexpected status %s", metric.Name, res.Status)
+	}
+
+	if err = json.NewDecoder(res.Body).Decode(&out); err != nil {
+		return nil, fmt.Errorf("could not decode response of policy server for metric %s: %w", metric.Name, err)
+	}
+
+	// An undefined document means that the policy server does not know the metric, e.g., because it is evaluated by
+	// an external tool
+	if out.Result == nil || !out.Result.Applicable {
+		return nil, nil
+	}
+
+	result = &CombinedResult{
+		Applicable:       true,
+		Compliant:        out.Result.Compliant,
+		MetricID:         metric.Id,
+		MetricName:       metric.Name,
+		Config:           config,
+		ComparisonResult: out.Result.Results,
+		Correlation:      metric.GetCorrelation(),
+	}
+
+	// Check, if the metric supplies an additional message
+	if out.Result.Message != nil {
+		if len(result.ComparisonResult) > 0 {
+			result.Message = fmt.Sprintf("%s %s", *out.Result.Message, assessment.AdditionalDetailsMessage)
+		} else {
+			result.Message = assessment.AdditionalDetailsMessage
+		}
+	} else {
+		// Otherwise, the message template of the metric explains the result
+		result.Message = metric.Message(result.Compliant, assessment.NewMessageData(m, config))
+	}
+
+	return result, nil
+}
+
+// policyURL returns the URL of the OPA Data API document of the policy of the given metric.
+func (he *httpEval) policyURL(metric *assessment.Metric) string {
+	return fmt.Sprintf("%s/v1/data/%s/%s", he.url, strings.ReplaceAll(he.pkg, ".", "/"), util.CamelCaseToSnakeCase(metric.Name))
+}
@@ -0,0 +1,272 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package policies
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"confirmate.io/core/api/assessment"
+	"confirmate.io/core/api/evidence"
+	"confirmate.io/core/api/ontology"
+	"confirmate.io/core/util/assert"
+)
+
+// staticMetricsSource is a [MetricsSource] with a fixed list of metrics, which all share the same configuration.
+type staticMetricsSource struct {
+	metrics []*assessment.Metric
+	config  *assessment.MetricConfiguration
+}
+
+func (s *staticMetricsSource) Metrics(_ context.Context) ([]*assessment.Metric, error) {
+	return s.metrics, nil
+}
+
+func (s *staticMetricsSource) MetricConfiguration(_ context.Context, _ string, _ *assessment.Metric) (*assessment.MetricConfiguration, error) {
+	return s.config, nil
+}
+
+func (s *staticMetricsSource) MetricImplementation(_ context.Context, _ assessment.MetricImplementation_Language, _ *assessment.Metric) (*assessment.MetricImplementation, error) {
+	return nil, errors.New("not implemented")
+}
+
+// newPolicyServer returns a test server that mimics the OPA Data API. It answers requests to a policy in documents
+// with the given document and records the inputs it receives.
+func newPolicyServer(t *testing.T, documents map[string]any, inputs map[string]map[string]any) *httptest.Server {
+	t.Helper()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Input map[string]any `json:"input"`
+		}
+
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		if inputs != nil {
+			inputs[r.URL.Path] = req.Input
+		}
+
+		doc, ok := documents[r.URL.Path]
+		if !ok {
+			// OPA returns an empty object for undefined documents
+			_, _ = w.Write([]byte("{}"))
+			return
+		}
+
+		_ = json.NewEncoder(w).Encode(map[string]any{"result": doc})
+	}))
+	t.Cleanup(srv.Close)
+
+	return srv
+}
+
+func Test_httpEval_Eval(t *testing.T) {
+	var (
+		ev = &evidence.Evidence{
+			Id:                   "11111111-1111-1111-1111-111111111111",
+			ToolId:               "tool-a",
+			TargetOfEvaluationId: "00000000-0000-0000-0000-000000000000",
+		}
+		config = &assessment.MetricConfiguration{
+			Operator:    "==",
+			TargetValue: structpb.NewBoolValue(true),
+		}
+		src = &staticMetricsSource{
+			metrics: []*assessment.Metric{
+				{Id: "metric-1", Name: "BootLoggingEnabled"},
+				{Id: "metric-2", Name: "MalwareProtectionEnabled"},
+				{Id: "metric-3", Name: "EncryptionAtRestEnabled"},
+				{
+					Id:                          "metric-4",
+					Name:                        "AutomaticUpdatesEnabled",
+					NonCompliantMessageTemplate: new("Automatic updates of {{.Resource.id}} must be {{.Operator}} {{.TargetValue}}."),
+				},
+				{
+					Id:   "metric-5",
+					Name: "SecureOperationEnabled",
+					Composite: &assessment.CompositeMetric{
+						MetricIds: []string{"metric-1", "metric-2", "metric-3"},
+						Operator:  assessment.CompositeOperator_COMPOSITE_OPERATOR_AND,
+					},
+				},
+			},
+			config: config,
+		}
+	)
+
+	type args struct {
+		documents map[string]any
+		status    int
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    assert.Want[[]*CombinedResult]
+		wantErr assert.WantErr
+	}{
+		{
+			name: "applicable and undefined metrics",
+			args: args{
+				documents: map[string]any{
+					"/v1/data/cch/metrics/boot_logging_enabled": map[string]any{
+						"applicable": true,
+						"compliant":  true,
+					},
+					"/v1/data/cch/metrics/malware_protection_enabled": map[string]any{
+						"applicable": true,
+						"compliant":  false,
+						"message":    "Malware protection is disabled.",
+						"results": []any{
+							map[string]any{"property": "enabled", "value": false, "operator": "==", "target_value": true, "success": false},
+						},
+					},
+					"/v1/data/cch/metrics/encryption_at_rest_enabled": map[string]any{
+						"applicable": false,
+					},
+					"/v1/data/cch/metrics/automatic_updates_enabled": map[string]any{
+						"applicable": true,
+						"compliant":  false,
+					},
+				},
+			},
+			want: func(t *testing.T, got []*CombinedResult, msgAndArgs ...any) bool {
+				return assert.Equal(t, []*CombinedResult{
+					{
+						Applicable: true,
+						Compliant:  true,
+						MetricID:   "metric-1",
+						MetricName: "BootLoggingEnabled",
+						Config:     config,
+						Message:    assessment.DefaultCompliantMessage,
+					},
+					{
+						Applicable: true,
+						Compliant:  false,
+						MetricID:   "metric-2",
+						MetricName: "MalwareProtectionEnabled",
+						Config:     config,
+						ComparisonResult: []*assessment.ComparisonResult{
+							{Property: "enabled", Value: structpb.NewBoolValue(false), Operator: "==", TargetValue: structpb.NewBoolValue(true), Success: false},
+						},
+						Message: "Malware protection is disabled. " + assessment.AdditionalDetailsMessage,
+					},
+					{
+						Applicable: true,
+						Compliant:  false,
+						MetricID:   "metric-4",
+						MetricName: "AutomaticUpdatesEnabled",
+						Config:     config,
+						Message:    "Automatic updates of vm-1 must be == true.",
+					},
+					{
+						Applicable: true,
+						Compliant:  false,
+						MetricID:   "metric-5",
+						MetricName: "SecureOperationEnabled",
+						Config:     config,
+						ComparisonResult: []*assessment.ComparisonResult{
+							{Property: "BootLoggingEnabled", Value: structpb.NewBoolValue(true), Operator: "==", TargetValue: structpb.NewBoolValue(true), Success: true},
+							{Property: "MalwareProtectionEnabled", Value: structpb.NewBoolValue(false), Operator: "==", TargetValue: structpb.NewBoolValue(true), Success: false},
+						},
+						Message: assessment.DefaultNonCompliantMessage,
+					},
+				}, got)
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "server error",
+			args: args{
+				status: http.StatusInternalServerError,
+			},
+			want: assert.Nil[[]*CombinedResult],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.ErrorContains(t, err, "unexpected status 500")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var srv *httptest.Server
+			if tt.args.status != 0 {
+				srv = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					w.WriteHeader(tt.args.status)
+				}))
+				t.Cleanup(srv.Close)
+			} else {
+				srv = newPolicyServer(t, tt.args.documents, nil)
+			}
+
+			pe := NewHTTPEval(srv.URL+"/", WithHTTPClient(srv.Client()))
+
+			got, err := pe.Eval(context.Background(), ev, &ontology.VirtualMachine{Id: "vm-1"}, nil, nil, src)
+			tt.wantErr(t, err)
+			tt.want(t, got)
+		})
+	}
+}
+
+func Test_httpEval_Eval_input(t *testing.T) {
+	var (
+		inputs = make(map[string]map[string]any)
+		srv    = newPolicyServer(t, map[string]any{
+			"/v1/data/custom/pkg/boot_logging_enabled": map[string]any{
+				"applicable": true,
+				"compliant":  true,
+			},
+		}, inputs)
+		src = &staticMetricsSource{
+			metrics: []*assessment.Metric{{Id: "metric-1", Name: "BootLoggingEnabled"}},
+			config: &assessment.MetricConfiguration{
+				Operator:    ">=",
+				TargetValue: structpb.NewNumberValue(30),
+			},
+		}
+		he = NewHTTPEval(srv.URL, WithHTTPClient(srv.Client()), WithPolicyPackage("custom.pkg")).(*httpEval)
+	)
+
+	_, err := he.Eval(context.Background(), &evidence.Evidence{ToolId: "tool-a"}, &ontology.VirtualMachine{Id: "vm-1"},
+		map[string]ontology.IsResource{"storage-1": &ontology.BlockStorage{Id: "storage-1"}},
+		map[string]ontology.IsResource{"tool-b": &ontology.VirtualMachine{Id: "vm-1", Name: "correlated"}}, src)
+	assert.NoError(t, err)
+
+	input := inputs["/v1/data/custom/pkg/boot_logging_enabled"]
+	assert.Equal[any](t, "vm-1", input["id"])
+	assert.Equal[any](t, "storage-1", input["related"].(map[string]any)["storage-1"].(map[string]any)["id"])
+	assert.Equal[any](t, "correlated", input["correlated"].(map[string]any)["tool-b"].(map[string]any)["name"])
+
+	config := input["metric_configuration"].(map[string]any)
+	assert.Equal[any](t, ">=", config["operator"])
+	assert.Equal[any](t, float64(30), config["target_value"])
+
+	// The applicable metric is cached for the resource type and tool
+	he.mrtc.RLock()
+	assert.Equal(t, 1, len(he.mrtc.m[createKey(&evidence.Evidence{ToolId: "tool-a"}, ontology.ResourceTypes(&ontology.VirtualMachine{}))]))
+	he.mrtc.RUnlock()
+
+	he.ClearCache()
+	assert.Empty(t, he.mrtc.m)
+}
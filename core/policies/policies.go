@@ -42,10 +42,28 @@ type metricsCache struct {
 type PolicyEval interface {
 	// Eval evaluates a given evidence against a metric coming from the metrics source. In order to avoid unnecessary
 	// unwrapping, the callee of this function needs to supply the unwrapped ontology resource, since they most likely
-	// unwrapped the resource already, e.g. to check for validation.
-	Eval(ctx context.Context, evidence *evidence.Evidence, r ontology.IsResource, related map[string]ontology.IsResource, src MetricsSource) (data []*CombinedResult, err error)
+	// unwrapped the resource already, e.g. to check for validation. The resources of evidences of other tools that are
+	// correlated with the evidence (see [assessment.EvidenceCorrelation]) are supplied in correlated, keyed by the ID
+	// of the tool.
+	Eval(ctx context.Context, evidence *evidence.Evidence, r ontology.IsResource, related map[string]ontology.IsResource, correlated map[string]ontology.IsResource, src MetricsSource) (data []*CombinedResult, err error)
+
+	// ClearCache discards all cached metrics and policies, so that the next evaluation uses the current metrics of the
+	// metrics source.
+	ClearCache()
+}
+
+// Preloader is implemented by policy evaluation engines that can prepare the policies of metrics ahead of their first
+// evaluation, such as the embedded Rego engine.
+type Preloader interface {
+	// Preload prepares the policies of all metrics of the metrics source for the given targets of evaluation, using at
+	// most concurrency parallel workers. If progress is not nil, it is called after every prepared policy.
+	Preload(ctx context.Context, targetIDs []string, src MetricsSource, concurrency int, progress PreloadProgressFunc) (err error)
 }
 
+// PreloadProgressFunc reports the progress of [Preloader.Preload], i.e., that done out of total policies are prepared.
+// It might be called concurrently.
+type PreloadProgressFunc func(done int, total int)
+
 type CombinedResult struct {
 	Applicable bool
 	Compliant  bool
@@ -53,6 +71,9 @@ type CombinedResult struct {
 	MetricName string
 	Config     *assessment.MetricConfiguration
 
+	// Correlation is the evidence correlation the metric requires, if any
+	Correlation *assessment.EvidenceCorrelation
+
 	// ComparisonResult is an optional feature to get more infos about the comparisons
 	ComparisonResult []*assessment.ComparisonResult
 
@@ -80,3 +101,30 @@ func createKey(evidence *evidence.Evidence, types []string) (key string) {
 	key = strings.ReplaceAll(key, " ", "")
 	return
 }
+
+// policyInput returns the input of the policies for the resource r, containing its related resources in "related" and
+// the resources of correlated evidences in "correlated".
+func policyInput(r ontology.IsResource, related map[string]ontology.IsResource, correlated map[string]ontology.IsResource) (m map[string]any, err error) {
+	m, err = ontology.ResourceMap(r)
+	if err != nil {
+		return nil, err
+	}
+
+	for key, resources := range map[string]map[string]ontology.IsResource{"related": related, "correlated": correlated} {
+		if resources == nil {
+			continue
+		}
+
+		am := make(map[string]any)
+		for id, value := range resources {
+			am[id], err = ontology.ResourceMap(value)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		m[key] = am
+	}
+
+	return m, nil
+}
@@ -179,10 +179,13 @@ type mockPolicyEval struct {
 var _ PolicyEval = (*mockPolicyEval)(nil)
 
 // Eval returns pre-configured results
-func (m *mockPolicyEval) Eval(ctx context.Context, evidence *evidence.Evidence, r ontology.IsResource, related map[string]ontology.IsResource, src MetricsSource) ([]*CombinedResult, error) {
+func (m *mockPolicyEval) Eval(ctx context.Context, evidence *evidence.Evidence, r ontology.IsResource, related map[string]ontology.IsResource, correlated map[string]ontology.IsResource, src MetricsSource) ([]*CombinedResult, error) {
 	return m.results, m.err
 }
 
+// ClearCache does nothing, since the mock does not cache anything
+func (m *mockPolicyEval) ClearCache() {}
+
 // HandleMetricEvent handles metric change events
 func (m *mockPolicyEval) HandleMetricEvent(event *orchestrator.ChangeEvent) error {
 	return m.err
@@ -331,7 +334,7 @@ func TestMockPolicyEval_Eval(t *testing.T) {
 		results: expectedResults,
 	}
 
-	results, err := mock.Eval(context.Background(), nil, nil, nil, nil)
+	results, err := mock.Eval(context.Background(), nil, nil, nil, nil, nil)
 	assert.NoError(t, err)
 	assert.Equal(t, expectedResults, results)
 }
@@ -18,10 +18,12 @@ package policies
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log/slog"
 	"strings"
 	"sync"
+	"sync/atomic"
 
 	"confirmate.io/core/api/assessment"
 	"confirmate.io/core/api/evidence"
@@ -33,6 +35,7 @@ import (
 	"github.com/open-policy-agent/opa/v1/rego"
 	"github.com/open-policy-agent/opa/v1/storage"
 	"github.com/open-policy-agent/opa/v1/storage/inmem"
+	"golang.org/x/sync/errgroup"
 )
 
 // DefaultRegoPackage is the default package name for the Rego files
@@ -70,9 +73,18 @@ type regoEval struct {
 	eventMutex sync.Mutex
 }
 
+var _ Preloader = (*regoEval)(nil)
+
 type queryCache struct {
 	sync.Mutex
 	cache map[string]*rego.PreparedEvalQuery
+
+	// pending contains a channel for every key whose query is currently prepared, which is closed once the query is
+	// prepared. Queries of different keys are prepared concurrently, while callers of the same key wait for it.
+	pending map[string]chan struct{}
+
+	// generation is incremented whenever entries are evicted, so that queries that were prepared before are not cached
+	generation uint64
 }
 
 type orElseFunc func(key string) (query *rego.PreparedEvalQuery, err error)
@@ -163,34 +175,22 @@ func (re *regoEval) Close() error {
 // Eval evaluates a given evidence against all available Rego policies and returns the result of all policies that were
 // considered to be applicable. In order to avoid multiple unwrapping, the callee will already supply an unwrapped
 // ontology resource in r.
-func (re *regoEval) Eval(ctx context.Context, evidence *evidence.Evidence, r ontology.IsResource, related map[string]ontology.IsResource, src MetricsSource) (data []*CombinedResult, err error) {
+func (re *regoEval) Eval(ctx context.Context, evidence *evidence.Evidence, r ontology.IsResource, related map[string]ontology.IsResource, correlated map[string]ontology.IsResource, src MetricsSource) (data []*CombinedResult, err error) {
 	var (
-		baseDir string
-		m       map[string]any
-		mm      map[string]any
-		types   []string
+		baseDir    string
+		m          map[string]any
+		types      []string
+		composites []*assessment.Metric
+		results    []*CombinedResult
 	)
 
 	baseDir = "."
 
-	m, err = ontology.ResourceMap(r)
+	m, err = policyInput(r, related, correlated)
 	if err != nil {
 		return nil, err
 	}
 
-	if related != nil {
-		am := make(map[string]interface{})
-		for key, value := range related {
-			mm, err = ontology.ResourceMap(value)
-			if err != nil {
-				return nil, err
-			}
-			am[key] = mm
-		}
-
-		m["related"] = am
-	}
-
 	types = ontology.ResourceTypes(r)
 	key := createKey(evidence, types)
 
@@ -213,6 +213,19 @@ func (re *regoEval) Eval(ctx context.Context, evidence *evidence.Evidence, r ont
 		// time.
 		cached = []*assessment.Metric{}
 		for _, metric := range metrics {
+			// Metrics that declare to apply to other resource types only do not need to be evaluated at all
+			if !metric.AppliesTo(types) {
+				continue
+			}
+
+			// Composite metrics are evaluated once the results of their metrics are known. Whether they are
+			// applicable depends on these results, so they are always cached.
+			if metric.GetComposite() != nil {
+				cached = append(cached, metric)
+				composites = append(composites, metric)
+				continue
+			}
+
 			// Try to evaluate it and check if the metric is applicable (in which case we are
 			// getting a result). We need to differentiate here between an execution error (which
 			// might be temporary) and an error if the metric configuration or implementation is not
@@ -223,9 +236,7 @@ func (re *regoEval) Eval(ctx context.Context, evidence *evidence.Evidence, r ont
 			runMap, err := re.evalMap(ctx, baseDir, evidence.TargetOfEvaluationId, metric, m, src)
 			if err != nil {
 				// Try to check if the metric implementation just does not exist.
-				if connect.CodeOf(err) == connect.CodeNotFound &&
-					(strings.Contains(err.Error(), "implementation for metric not found") ||
-						strings.Contains(err.Error(), "metric configuration not found")) {
+				if isMissingMetric(err) {
 					continue
 				}
 
@@ -245,6 +256,14 @@ func (re *regoEval) Eval(ctx context.Context, evidence *evidence.Evidence, r ont
 			}
 		}
 
+		results, err = evalComposites(ctx, evidence.TargetOfEvaluationId, composites, data, m, src)
+		if err != nil {
+			re.mrtc.m[key] = nil
+			re.mrtc.Unlock()
+			return nil, err
+		}
+		data = append(data, results...)
+
 		// Set it and unlock
 		re.mrtc.m[key] = cached
 		slog.Info("Resource type has the applicable metric(s)", slog.Any("key", key), slog.Any("len", len(re.mrtc.m[key])), slog.Any("names", namesOf(re.mrtc.m[key])))
@@ -252,6 +271,11 @@ func (re *regoEval) Eval(ctx context.Context, evidence *evidence.Evidence, r ont
 		re.mrtc.Unlock()
 	} else {
 		for _, metric := range cached {
+			if metric.GetComposite() != nil {
+				composites = append(composites, metric)
+				continue
+			}
+
 			runMap, err := re.evalMap(ctx, baseDir, evidence.TargetOfEvaluationId, metric, m, src)
 			if err != nil {
 				return nil, err
@@ -266,6 +290,12 @@ func (re *regoEval) Eval(ctx context.Context, evidence *evidence.Evidence, r ont
 				data = append(data, runMap)
 			}
 		}
+
+		results, err = evalComposites(ctx, evidence.TargetOfEvaluationId, composites, data, m, src)
+		if err != nil {
+			return nil, err
+		}
+		data = append(data, results...)
 	}
 
 	return data, nil
@@ -286,9 +316,152 @@ func (re *regoEval) HandleMetricEvent(event *orchestrator.ChangeEvent) (err erro
 	return nil
 }
 
+// ClearCache discards the cached applicable metrics of all resource types as well as all prepared queries.
+func (re *regoEval) ClearCache() {
+	re.mrtc.Lock()
+	clear(re.mrtc.m)
+	re.mrtc.Unlock()
+
+	re.qc.Empty()
+}
+
+// Preload prepares the queries of all metrics of the metrics source for the given targets of evaluation, so that
+// their first evaluation does not need to compile them. At most concurrency queries are prepared in parallel. Metrics
+// whose implementation or configuration does not exist are skipped, as they are in [regoEval.Eval]. Which metrics are
+// applicable to a resource type is still determined by the first evidence of that type, since this depends on the
+// resource itself.
+func (re *regoEval) Preload(ctx context.Context, targetIDs []string, src MetricsSource, concurrency int, progress PreloadProgressFunc) (err error) {
+	var (
+		metrics []*assessment.Metric
+		jobs    []preloadJob
+		done    atomic.Int64
+		errs    []error
+		mu      sync.Mutex
+		g       errgroup.Group
+	)
+
+	metrics, err = src.Metrics(ctx)
+	if err != nil {
+		return fmt.Errorf("could not retrieve metric definitions: %w", err)
+	}
+
+	for _, targetID := range targetIDs {
+		for _, metric := range metrics {
+			// Composite metrics have no implementation of their own
+			if metric.GetComposite() != nil {
+				continue
+			}
+
+			jobs = append(jobs, preloadJob{targetID: targetID, metric: metric})
+		}
+	}
+
+	g.SetLimit(max(concurrency, 1))
+	for _, job := range jobs {
+		if ctx.Err() != nil {
+			break
+		}
+
+		g.Go(func() error {
+			_, err := re.prepareQuery(ctx, ".", job.targetID, job.metric, src)
+			if err != nil && !isMissingMetric(err) {
+				mu.Lock()
+				errs = append(errs, err)
+				mu.Unlock()
+			}
+
+			if progress != nil {
+				progress(int(done.Add(1)), len(jobs))
+			}
+
+			return nil
+		})
+	}
+	_ = g.Wait()
+
+	return errors.Join(append(errs, ctx.Err())...)
+}
+
+// preloadJob is a metric whose query is prepared for a target of evaluation by [regoEval.Preload].
+type preloadJob struct {
+	targetID string
+	metric   *assessment.Metric
+}
+
+// isMissingMetric checks, whether err denotes that the implementation or configuration of a metric does not exist. This
+// happens if the metric is not assessed within the toolset, e.g., because it is evaluated by an external tool.
+func isMissingMetric(err error) bool {
+	return connect.CodeOf(err) == connect.CodeNotFound &&
+		(strings.Contains(err.Error(), "implementation for metric not found") ||
+			strings.Contains(err.Error(), "metric configuration not found"))
+}
+
 func (re *regoEval) evalMap(ctx context.Context, baseDir string, targetID string, metric *assessment.Metric, m map[string]interface{}, src MetricsSource) (result *CombinedResult, err error) {
 	var (
-		query  *rego.PreparedEvalQuery
+		query *rego.PreparedEvalQuery
+	)
+
+	query, err = re.prepareQuery(ctx, baseDir, targetID, metric, src)
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := query.Eval(ctx, rego.EvalInput(m))
+	if err != nil {
+		return nil, fmt.Errorf("could not evaluate rego policy: %w", err)
+	}
+
+	if len(results) == 0 {
+		return nil, fmt.Errorf("no results. probably the package name of metric %s is wrong", metric.Name)
+	}
+
+	result = &CombinedResult{
+		Applicable:  results[0].Bindings["applicable"].(bool),
+		Compliant:   results[0].Bindings["compliant"].(bool),
+		MetricID:    metric.Id,
+		MetricName:  metric.Name,
+		Correlation: metric.GetCorrelation(),
+	}
+
+	// A little trick to convert the map-based metric configuration back to a real object
+	result.Config = new(assessment.MetricConfiguration)
+	if err = reencode(results[0].Bindings["config"], result.Config); err != nil {
+		return nil, err
+	}
+
+	// Enable the new results
+	output := results[0].Bindings["output"]
+	if results, ok := output.(map[string]interface{})["results"]; ok {
+		result.ComparisonResult = make([]*assessment.ComparisonResult, 0)
+		if err = reencode(results, &result.ComparisonResult); err != nil {
+			return nil, err
+		}
+	}
+
+	// Check, if the metric supplies an additional message
+	if msg, ok := output.(map[string]interface{})["message"]; ok {
+		// Also append a short comment that details can be found in the ... details, if we have any
+		if len(result.ComparisonResult) > 0 {
+			result.Message = fmt.Sprintf("%s %s", msg, assessment.AdditionalDetailsMessage)
+		} else {
+			result.Message = assessment.AdditionalDetailsMessage
+		}
+	} else {
+		// Otherwise, the message template of the metric explains the result
+		result.Message = metric.Message(result.Compliant, assessment.NewMessageData(m, result.Config))
+	}
+
+	if !result.Applicable {
+		return nil, nil
+	} else {
+		return result, nil
+	}
+}
+
+// prepareQuery returns the prepared query of the metric for the target of evaluation. The query is prepared once for
+// every configuration of the metric and is cached afterwards.
+func (re *regoEval) prepareQuery(ctx context.Context, baseDir string, targetID string, metric *assessment.Metric, src MetricsSource) (query *rego.PreparedEvalQuery, err error) {
+	var (
 		key    string
 		pkg    string
 		prefix string
@@ -388,61 +561,13 @@ func (re *regoEval) evalMap(ctx context.Context, baseDir string, targetID string
 		return nil, fmt.Errorf("could not fetch cached query for metric %s: %w", metric.Name, err)
 	}
 
-	results, err := query.Eval(ctx, rego.EvalInput(m))
-	if err != nil {
-		return nil, fmt.Errorf("could not evaluate rego policy: %w", err)
-	}
-
-	if len(results) == 0 {
-		return nil, fmt.Errorf("no results. probably the package name of metric %s is wrong", metric.Name)
-	}
-
-	result = &CombinedResult{
-		Applicable: results[0].Bindings["applicable"].(bool),
-		Compliant:  results[0].Bindings["compliant"].(bool),
-		MetricID:   metric.Id,
-		MetricName: metric.Name,
-	}
-
-	// A little trick to convert the map-based metric configuration back to a real object
-	result.Config = new(assessment.MetricConfiguration)
-	if err = reencode(results[0].Bindings["config"], result.Config); err != nil {
-		return nil, err
-	}
-
-	// Enable the new results
-	output := results[0].Bindings["output"]
-	if results, ok := output.(map[string]interface{})["results"]; ok {
-		result.ComparisonResult = make([]*assessment.ComparisonResult, 0)
-		if err = reencode(results, &result.ComparisonResult); err != nil {
-			return nil, err
-		}
-	}
-
-	// Check, if the metric supplies an additional message
-	if msg, ok := output.(map[string]interface{})["message"]; ok {
-		// Also append a short comment that details can be found in the ... details, if we have any
-		if len(result.ComparisonResult) > 0 {
-			result.Message = fmt.Sprintf("%s %s", msg, assessment.AdditionalDetailsMessage)
-		} else {
-			result.Message = assessment.AdditionalDetailsMessage
-		}
-	} else if result.Compliant {
-		result.Message = assessment.DefaultCompliantMessage
-	} else if !result.Compliant {
-		result.Message = assessment.DefaultNonCompliantMessage
-	}
-
-	if !result.Applicable {
-		return nil, nil
-	} else {
-		return result, nil
-	}
+	return query, nil
 }
 
 func newQueryCache() *queryCache {
 	return &queryCache{
-		cache: make(map[string]*rego.PreparedEvalQuery),
+		cache:   make(map[string]*rego.PreparedEvalQuery),
+		pending: make(map[string]chan struct{}),
 	}
 }
 
@@ -460,31 +585,59 @@ func reencode[T any](in any, out *T) (err error) {
 }
 
 // Get returns the prepared query for the given key. If the key was not found in the cache,
-// the orElse function is executed to populate the cache.
+// the orElse function is executed to populate the cache. The cache is not locked while orElse is executed, so that
+// queries of different keys can be prepared concurrently.
 func (qc *queryCache) Get(key string, orElse orElseFunc) (query *rego.PreparedEvalQuery, err error) {
 	var (
-		ok bool
+		ok         bool
+		ch         chan struct{}
+		generation uint64
 	)
 
-	// Lock the cache
 	qc.Lock()
-	// And defer the unlock
-	defer qc.Unlock()
+	for {
+		// Check, if query is contained in the cache
+		query, ok = qc.cache[key]
+		if ok {
+			qc.Unlock()
+			return
+		}
 
-	// Check, if query is contained in the cache
-	query, ok = qc.cache[key]
-	if ok {
-		return
+		// Wait until the query is prepared, if it is currently prepared by someone else. If preparing it failed, we try
+		// it ourselves.
+		ch, ok = qc.pending[key]
+		if !ok {
+			break
+		}
+
+		qc.Unlock()
+		<-ch
+		qc.Lock()
 	}
 
+	ch = make(chan struct{})
+	qc.pending[key] = ch
+	generation = qc.generation
+	qc.Unlock()
+
 	// Otherwise, the orElse function is executed to fetch the query
 	query, err = orElse(key)
+
+	qc.Lock()
+	defer qc.Unlock()
+
+	delete(qc.pending, key)
+	close(ch)
+
 	if err != nil {
 		return nil, err
 	}
 
-	// Update the cache
-	qc.cache[key] = query
+	// Update the cache, unless it was evicted in the meantime, since the query might be based on an outdated metric
+	if generation == qc.generation {
+		qc.cache[key] = query
+	}
+
 	return
 }
 
@@ -495,6 +648,7 @@ func (qc *queryCache) Empty() {
 	for k := range qc.cache {
 		delete(qc.cache, k)
 	}
+	qc.generation++
 }
 
 // Evict deletes all keys from the cache that belong to the given metric.
@@ -508,6 +662,7 @@ func (qc *queryCache) Evict(metric string) {
 			delete(qc.cache, k)
 		}
 	}
+	qc.generation++
 }
 
 func namesOf(metrics []*assessment.Metric) (ids []string) {
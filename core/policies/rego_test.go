@@ -20,6 +20,7 @@ import (
 	"errors"
 	"fmt"
 	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -433,7 +434,7 @@ func Test_regoEval_Eval(t *testing.T) {
 			results, err := pe.Eval(context.Background(), &evidence.Evidence{
 				Id:       tt.args.evidenceID,
 				Resource: prototest.NewProtobufResource(t, tt.args.resource),
-			}, tt.args.resource, tt.args.related, tt.args.src)
+			}, tt.args.resource, tt.args.related, nil, tt.args.src)
 
 			tt.wantErr(t, err)
 
@@ -471,7 +472,7 @@ func Test_regoEval_Eval_MetricsError(t *testing.T) {
 		Id:                   "11111111-1111-1111-1111-111111111111",
 		ToolId:               "tool-a",
 		TargetOfEvaluationId: "00000000-0000-0000-0000-000000000000",
-	}, &ontology.VirtualMachine{Id: "vm-1"}, nil, source)
+	}, &ontology.VirtualMachine{Id: "vm-1"}, nil, nil, source)
 
 	assert.Nil(t, results)
 	assert.ErrorContains(t, err, "could not retrieve metric definitions")
@@ -496,7 +497,7 @@ func Test_regoEval_Eval_SkipMissingMetricConfiguration(t *testing.T) {
 		Id:                   "11111111-1111-1111-1111-111111111111",
 		ToolId:               "tool-a",
 		TargetOfEvaluationId: "00000000-0000-0000-0000-000000000000",
-	}, &ontology.VirtualMachine{Id: "vm-1"}, nil, source)
+	}, &ontology.VirtualMachine{Id: "vm-1"}, nil, nil, source)
 
 	assert.NoError(t, err)
 	assert.Equal(t, 0, len(results))
@@ -521,7 +522,7 @@ func Test_regoEval_Eval_ReturnsNonSkippableMetricConfigurationError(t *testing.T
 		Id:                   "11111111-1111-1111-1111-111111111111",
 		ToolId:               "tool-a",
 		TargetOfEvaluationId: "00000000-0000-0000-0000-000000000000",
-	}, &ontology.VirtualMachine{Id: "vm-1"}, nil, source)
+	}, &ontology.VirtualMachine{Id: "vm-1"}, nil, nil, source)
 
 	assert.Nil(t, results)
 	assert.ErrorContains(t, err, "database unavailable")
@@ -667,6 +668,171 @@ func Test_regoEval_evalMap(t *testing.T) {
 	}
 }
 
+func Test_regoEval_Preload(t *testing.T) {
+	type args struct {
+		targetIDs []string
+		src       MetricsSource
+	}
+	tests := []struct {
+		name      string
+		args      args
+		wantCache assert.Want[map[string]*rego.PreparedEvalQuery]
+		wantDone  assert.Want[int]
+		wantErr   assert.WantErr
+	}{
+		{
+			name: "metrics error",
+			args: args{
+				targetIDs: []string{evidencetest.MockTargetOfEvaluationID1},
+				src:       &metricsErrorSource{},
+			},
+			wantCache: func(t *testing.T, got map[string]*rego.PreparedEvalQuery, msgAndArgs ...any) bool {
+				return assert.Equal(t, 0, len(got))
+			},
+			wantDone: func(t *testing.T, got int, msgAndArgs ...any) bool {
+				return assert.Equal(t, 0, got)
+			},
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.ErrorContains(t, err, "could not retrieve metric definitions")
+			},
+		},
+		{
+			name: "missing metric configuration is skipped",
+			args: args{
+				targetIDs: []string{evidencetest.MockTargetOfEvaluationID1},
+				src:       &missingConfigSource{},
+			},
+			wantCache: func(t *testing.T, got map[string]*rego.PreparedEvalQuery, msgAndArgs ...any) bool {
+				return assert.Equal(t, 0, len(got))
+			},
+			wantDone: func(t *testing.T, got int, msgAndArgs ...any) bool {
+				return assert.Equal(t, 1, got)
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "metric configuration error",
+			args: args{
+				targetIDs: []string{evidencetest.MockTargetOfEvaluationID1},
+				src:       &metricConfigErrorSource{},
+			},
+			wantCache: func(t *testing.T, got map[string]*rego.PreparedEvalQuery, msgAndArgs ...any) bool {
+				return assert.Equal(t, 0, len(got))
+			},
+			wantDone: func(t *testing.T, got int, msgAndArgs ...any) bool {
+				return assert.Equal(t, 1, got)
+			},
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.ErrorContains(t, err, "database unavailable")
+			},
+		},
+		{
+			name: "happy path",
+			args: args{
+				targetIDs: []string{evidencetest.MockTargetOfEvaluationID1, evidencetest.MockTargetOfEvaluationID2},
+				src:       &mockMetricsSource{t: t},
+			},
+			wantCache: func(t *testing.T, got map[string]*rego.PreparedEvalQuery, msgAndArgs ...any) bool {
+				return assert.Equal(t, 2*numPolicyMetrics(t), len(got))
+			},
+			wantDone: func(t *testing.T, got int, msgAndArgs ...any) bool {
+				return assert.Equal(t, 2*numPolicyMetrics(t), got)
+			},
+			wantErr: assert.NoError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				mu   sync.Mutex
+				done int
+			)
+
+			re := &regoEval{
+				qc:   newQueryCache(),
+				mrtc: &metricsCache{m: make(map[string][]*assessment.Metric)},
+				pkg:  DefaultRegoPackage,
+			}
+
+			err := re.Preload(context.Background(), tt.args.targetIDs, tt.args.src, 4, func(n int, total int) {
+				mu.Lock()
+				defer mu.Unlock()
+				done = max(done, n)
+			})
+
+			tt.wantErr(t, err)
+			tt.wantCache(t, re.qc.cache)
+			tt.wantDone(t, done)
+		})
+	}
+}
+
+// numPolicyMetrics returns the number of metrics of the [mockMetricsSource] that have a policy, i.e., that are not
+// composite metrics.
+func numPolicyMetrics(t *testing.T) (n int) {
+	metrics, err := (&mockMetricsSource{t: t}).Metrics(context.Background())
+	assert.NoError(t, err)
+
+	for _, metric := range metrics {
+		if metric.GetComposite() == nil {
+			n++
+		}
+	}
+
+	return n
+}
+
+func Test_queryCache_Get(t *testing.T) {
+	var (
+		qc       *queryCache
+		prepared atomic.Int64
+		wg       sync.WaitGroup
+		release  = make(chan struct{})
+	)
+
+	qc = newQueryCache()
+
+	// Concurrent callers of the same key prepare the query only once
+	for range 5 {
+		wg.Go(func() {
+			query, err := qc.Get("metric-1-toe-hash", func(key string) (*rego.PreparedEvalQuery, error) {
+				prepared.Add(1)
+				<-release
+				return &rego.PreparedEvalQuery{}, nil
+			})
+			assert.NoError(t, err)
+			assert.NotNil(t, query)
+		})
+	}
+
+	// Queries of other keys are not blocked by the pending query
+	_, err := qc.Get("metric-2-toe-hash", func(key string) (*rego.PreparedEvalQuery, error) {
+		return &rego.PreparedEvalQuery{}, nil
+	})
+	assert.NoError(t, err)
+
+	close(release)
+	wg.Wait()
+
+	assert.Equal(t, int64(1), prepared.Load())
+	assert.Equal(t, 2, len(qc.cache))
+
+	// Queries that were evicted while they were prepared are not cached
+	_, err = qc.Get("metric-3-toe-hash", func(key string) (*rego.PreparedEvalQuery, error) {
+		qc.Evict("metric-3")
+		return &rego.PreparedEvalQuery{}, nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(qc.cache))
+
+	// Failed queries are not cached
+	_, err = qc.Get("metric-4-toe-hash", func(key string) (*rego.PreparedEvalQuery, error) {
+		return nil, errors.New("boom")
+	})
+	assert.ErrorContains(t, err, "boom")
+	assert.Equal(t, 2, len(qc.cache))
+}
+
 func Test_reencode(t *testing.T) {
 	type args struct {
 		in  any
@@ -827,6 +993,23 @@ func Test_regoEval_HandleMetricEvent_PartialKeyMatching(t *testing.T) {
 	assert.True(t, exists, "metric-456-config1 should still exist")
 }
 
+// Test_regoEval_ClearCache verifies that both the applicable metrics and the prepared queries are discarded
+func Test_regoEval_ClearCache(t *testing.T) {
+	re := &regoEval{
+		qc:   newQueryCache(),
+		mrtc: &metricsCache{m: make(map[string][]*assessment.Metric)},
+		pkg:  DefaultRegoPackage,
+	}
+
+	re.qc.cache["metric-123-target1"] = &rego.PreparedEvalQuery{}
+	re.mrtc.m["VirtualMachine-tool"] = []*assessment.Metric{{Id: "metric-123"}}
+
+	re.ClearCache()
+
+	assert.Equal(t, 0, len(re.qc.cache))
+	assert.Equal(t, 0, len(re.mrtc.m))
+}
+
 // Test_queryCache_GetExecutesOrElseOnMiss tests cache hit/miss behavior
 func Test_queryCache_GetExecutesOrElseOnMiss(t *testing.T) {
 	qc := newQueryCache()
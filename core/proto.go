@@ -17,10 +17,12 @@ package core
 
 // //go:generate buf generate
 //go:generate buf generate --exclude-path policies
-//go:generate buf generate --template buf.openapi.gen.yaml --path api/evaluation -o api/evaluation
+//go:generate buf generate --template buf.openapi.gen.yaml --path api/evaluation --exclude-path api/evaluation/v2 -o api/evaluation
+//go:generate buf generate --template buf.openapi.gen.yaml --path api/evaluation/v2 -o api/evaluation/v2
 //go:generate buf generate --template buf.openapi.gen.yaml --path api/evidence -o api/evidence
 //go:generate buf generate --template buf.openapi.gen.yaml --path api/assessment -o api/assessment
 //go:generate buf generate --template buf.openapi.gen.yaml --path api/orchestrator -o api/orchestrator
+//go:generate buf generate --template buf.gen.ts.yaml --path api/evaluation --path api/evidence --path api/orchestrator
 //go:generate buf generate --template buf.gen.ontology.yaml --path policies/security-metrics/ontology/v1/ontology.proto -o api/ontology
 // Keep the gotag generation at the end to make sure that it isn't overridden by the other generators.
 //go:generate buf generate --template buf.gotag.gen.yaml --exclude-path policies
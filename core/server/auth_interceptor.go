@@ -20,6 +20,8 @@ import (
 	"crypto/ecdsa"
 	"encoding/json"
 	"errors"
+	"net/http"
+	"slices"
 	"strings"
 	"time"
 
@@ -41,14 +43,20 @@ const DefaultFallbackIssuer = "http://localhost:8080/v1/auth"
 
 // AuthConfig contains parameters needed to configure authentication.
 type AuthConfig struct {
-	jwksURL string
-	useJWKS bool
-	jwks    *keyfunc.JWKS
+	jwksURL    string
+	useJWKS    bool
+	jwks       *keyfunc.JWKS
+	jwksClient *http.Client
 
 	publicKey *ecdsa.PublicKey
 
 	publicProcedures map[string]struct{}
 
+	// delegatedTokens maps RPC procedures to the prefix of the bearer tokens
+	// that are not validated by the interceptor, but passed to the handler,
+	// which authenticates them itself (see [WithDelegatedTokens]).
+	delegatedTokens map[string]string
+
 	// roleClaimPaths lists the dotted JWT claim paths to read role strings
 	// from (e.g. "roles" or "realm_access.roles"). Extracted strings are
 	// then canonicalized via the always-on [roleMapper].
@@ -60,6 +68,11 @@ type AuthConfig struct {
 	// [WithRoleClaimPaths], not via the mapper.
 	roleMapper roleMapper
 
+	// toeClaimPaths lists the dotted JWT claim paths to read the IDs of the
+	// targets of evaluation from, which the token is restricted to (e.g.
+	// "cftoes"). The IDs end up in [auth.OAuthClaims.TargetOfEvaluationIds].
+	toeClaimPaths []string
+
 	// fallbackIssuer is used as the JWT issuer (iss) claim when the token
 	// itself does not carry one. This is needed for the embedded OAuth 2.0
 	// server, whose tokens omit the iss claim even though [WithPublicURL]
@@ -98,6 +111,25 @@ func WithRoleClaimPaths(paths ...string) AuthOption {
 	}
 }
 
+// WithTargetOfEvaluationClaimPaths configures where the IDs of the targets
+// of evaluation a token is restricted to are found in the JWT claims. It
+// replaces the default ("cftoes"). Tokens that carry none of the claims are
+// not restricted to particular targets of evaluation.
+//
+// Empty / whitespace-only entries are ignored.
+func WithTargetOfEvaluationClaimPaths(paths ...string) AuthOption {
+	return func(c *AuthConfig) {
+		c.toeClaimPaths = c.toeClaimPaths[:0]
+		for _, p := range paths {
+			p = strings.TrimSpace(p)
+			if p == "" {
+				continue
+			}
+			c.toeClaimPaths = append(c.toeClaimPaths, p)
+		}
+	}
+}
+
 // WithJWKS enables JWKS support for token verification.
 func WithJWKS(url string) AuthOption {
 	return func(c *AuthConfig) {
@@ -106,6 +138,14 @@ func WithJWKS(url string) AuthOption {
 	}
 }
 
+// WithJWKSHTTPClient configures the HTTP client that is used to retrieve the JWKS, e.g., a client that uses
+// mutual TLS. By default, [http.DefaultClient] is used.
+func WithJWKSHTTPClient(client *http.Client) AuthOption {
+	return func(c *AuthConfig) {
+		c.jwksClient = client
+	}
+}
+
 // WithPublicKey configures a static public key for token verification.
 func WithPublicKey(publicKey *ecdsa.PublicKey) AuthOption {
 	return func(c *AuthConfig) {
@@ -136,6 +176,22 @@ func WithPublicProcedures(procedures ...string) AuthOption {
 	}
 }
 
+// WithDelegatedTokens passes requests to the given RPC procedures, whose
+// bearer token starts with prefix, to the handler without validating the
+// token, so that the handler can authenticate it itself, e.g., the collector
+// tokens of the evidence store. All other requests to these procedures still
+// require a valid token.
+func WithDelegatedTokens(prefix string, procedures ...string) AuthOption {
+	return func(c *AuthConfig) {
+		if c.delegatedTokens == nil {
+			c.delegatedTokens = make(map[string]string)
+		}
+		for _, p := range procedures {
+			c.delegatedTokens[p] = prefix
+		}
+	}
+}
+
 // AuthInterceptor authenticates incoming requests using bearer tokens.
 type AuthInterceptor struct {
 	cfg *AuthConfig
@@ -153,6 +209,7 @@ func NewAuthInterceptor(opts ...AuthOption) (interceptor *AuthInterceptor) {
 		// Callers that emit roles elsewhere (e.g. Keycloak's realm_access.roles)
 		// override this via WithRoleClaimPaths.
 		roleClaimPaths: []string{"roles"},
+		toeClaimPaths:  []string{"cftoes"},
 		fallbackIssuer: DefaultFallbackIssuer,
 	}
 	for _, opt := range opts {
@@ -171,7 +228,7 @@ func (ai *AuthInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
 	return func(ctx context.Context, req connect.AnyRequest) (res connect.AnyResponse, err error) {
 		var token string
 
-		if ai.isPublic(req.Spec().Procedure) {
+		if ai.isPublic(req.Spec().Procedure) || ai.isDelegated(req.Spec().Procedure, req.Header()) {
 			return next(ctx, req)
 		}
 
@@ -202,7 +259,7 @@ func (ai *AuthInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFun
 	return func(ctx context.Context, conn connect.StreamingHandlerConn) (err error) {
 		var token string
 
-		if ai.isPublic(conn.Spec().Procedure) {
+		if ai.isPublic(conn.Spec().Procedure) || ai.isDelegated(conn.Spec().Procedure, conn.RequestHeader()) {
 			return next(ctx, conn)
 		}
 
@@ -223,6 +280,35 @@ func (ai *AuthInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFun
 	}
 }
 
+// RequireAdmin wraps the plain HTTP handler next, so that it is only served to requests that carry a valid bearer token
+// of an admin. Requests without a valid token are rejected with 401 Unauthorized, requests of non-admins with 403
+// Forbidden.
+func (ai *AuthInterceptor) RequireAdmin(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var (
+			token  string
+			claims *auth.OAuthClaims
+			err    error
+		)
+
+		token, err = bearerToken(r.Header.Get("Authorization"))
+		if err == nil {
+			claims, err = ai.parseToken(token)
+		}
+		if err != nil {
+			http.Error(w, "invalid auth token", http.StatusUnauthorized)
+			return
+		}
+
+		if !claims.IsAdmin() {
+			http.Error(w, "access denied", http.StatusForbidden)
+			return
+		}
+
+		next.ServeHTTP(w, r.WithContext(auth.WithClaims(r.Context(), claims)))
+	})
+}
+
 func (ai *AuthInterceptor) isPublic(procedure string) (ok bool) {
 	if ai == nil || ai.cfg == nil {
 		return false
@@ -235,6 +321,32 @@ func (ai *AuthInterceptor) isPublic(procedure string) (ok bool) {
 	return ok
 }
 
+// isDelegated returns true, if the bearer token of a request to the procedure is authenticated by the handler instead
+// of the interceptor, see [WithDelegatedTokens].
+func (ai *AuthInterceptor) isDelegated(procedure string, header http.Header) (ok bool) {
+	var (
+		prefix string
+		token  string
+		err    error
+	)
+
+	if ai == nil || ai.cfg == nil {
+		return false
+	}
+
+	prefix, ok = ai.cfg.delegatedTokens[procedure]
+	if !ok {
+		return false
+	}
+
+	token, err = bearerToken(header.Get("Authorization"))
+	if err != nil {
+		return false
+	}
+
+	return strings.HasPrefix(token, prefix)
+}
+
 func (ai *AuthInterceptor) parseToken(token string) (claims *auth.OAuthClaims, err error) {
 	var (
 		jwks    *keyfunc.JWKS
@@ -248,7 +360,10 @@ func (ai *AuthInterceptor) parseToken(token string) (claims *auth.OAuthClaims, e
 
 	if ai.cfg.useJWKS {
 		if ai.cfg.jwks == nil {
-			jwks, err = keyfunc.Get(ai.cfg.jwksURL, keyfunc.Options{RefreshInterval: time.Hour})
+			jwks, err = keyfunc.Get(ai.cfg.jwksURL, keyfunc.Options{
+				Client:          ai.cfg.jwksClient,
+				RefreshInterval: time.Hour,
+			})
 			if err != nil {
 				return nil, err
 			}
@@ -301,6 +416,10 @@ func (ai *AuthInterceptor) parseToken(token string) (claims *auth.OAuthClaims, e
 	// "realm_access.roles" that the typed view doesn't expose.
 	ai.applyRoleMapping(claims, raw)
 
+	// Restrict the token to the targets of evaluation listed in the configured
+	// claim paths, if any.
+	ai.applyTargetOfEvaluationMapping(claims, raw)
+
 	return claims, nil
 }
 
@@ -339,6 +458,26 @@ func (ai *AuthInterceptor) applyRoleMapping(claims *auth.OAuthClaims, raw jwt.Ma
 	}
 }
 
+// applyTargetOfEvaluationMapping extracts the IDs of the targets of
+// evaluation from the configured claim paths in raw, dedupes them and stores
+// the result in claims.TargetOfEvaluationIds.
+func (ai *AuthInterceptor) applyTargetOfEvaluationMapping(claims *auth.OAuthClaims, raw jwt.MapClaims) {
+	if ai == nil || ai.cfg == nil || claims == nil {
+		return
+	}
+
+	var out []string
+	for _, path := range ai.cfg.toeClaimPaths {
+		for _, id := range extractStringListAtPath(raw, path) {
+			if !slices.Contains(out, id) {
+				out = append(out, id)
+			}
+		}
+	}
+
+	claims.TargetOfEvaluationIds = out
+}
+
 // extractStringListAtPath reads a list of strings from a dotted path inside JWT MapClaims.
 // Supported leaf formats:
 // - []any / []string
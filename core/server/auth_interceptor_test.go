@@ -124,6 +124,26 @@ func TestAuthInterceptorWrapUnary(t *testing.T) {
 			},
 			wantErr: assert.NoError,
 		},
+		{
+			name:   "delegated token bypasses auth",
+			args:   args{authHeader: "Bearer cc_collector_secret"},
+			fields: fields{interceptor: NewAuthInterceptor(WithDelegatedTokens("cc_", ""), WithPublicKey(publicKey))},
+			want: func(t *testing.T, got gotData, _ ...any) bool {
+				return assert.True(t, got.nextCalled) &&
+					assert.Nil(t, got.claims)
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name:   "other token of delegated procedure returns unauthenticated",
+			args:   args{authHeader: "Bearer " + invalidToken},
+			fields: fields{interceptor: NewAuthInterceptor(WithDelegatedTokens("cc_", ""), WithPublicKey(publicKey))},
+			want: func(t *testing.T, got gotData, _ ...any) bool {
+				return assert.Equal(t, connect.CodeUnauthenticated, got.code) &&
+					assert.False(t, got.nextCalled)
+			},
+			wantErr: wantError,
+		},
 		{
 			name:   "missing authorization header returns unauthenticated",
 			args:   args{authHeader: ""},
@@ -216,6 +236,7 @@ func TestAuthInterceptorParseToken(t *testing.T) {
 		roleAdminToken        = mustSignES256Token(t, privateKey, kid, jwt.MapClaims{"sub": "pk-role-admin", "roles": []string{"ROLE_ADMIN"}})
 		noIssuerToken         = mustSignES256Token(t, privateKey, kid, jwt.MapClaims{"sub": "alice"})
 		withIssuerToken       = mustSignES256Token(t, privateKey, kid, jwt.MapClaims{"sub": "alice", "iss": "https://idp.example.com"})
+		toeToken              = mustSignES256Token(t, privateKey, kid, jwt.MapClaims{"sub": "bob", "cftoes": []string{"toe-1", "toe-2", "toe-1"}, "team": map[string]any{"toes": "toe-3"}})
 		fallbackIssuer        = "http://localhost:8080/v1/auth"
 	)
 
@@ -263,6 +284,33 @@ func TestAuthInterceptorParseToken(t *testing.T) {
 			},
 			wantErr: assert.NoError,
 		},
+		{
+			name:   "public key restricts targets of evaluation from default claim path",
+			args:   args{token: toeToken},
+			fields: fields{interceptor: NewAuthInterceptor(WithPublicKey(publicKey))},
+			want: func(t *testing.T, got *auth.OAuthClaims, _ ...any) bool {
+				return assert.Equal(t, []string{"toe-1", "toe-2"}, got.TargetOfEvaluationIds)
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name:   "public key restricts targets of evaluation from configured claim path",
+			args:   args{token: toeToken},
+			fields: fields{interceptor: NewAuthInterceptor(WithPublicKey(publicKey), WithTargetOfEvaluationClaimPaths("team.toes"))},
+			want: func(t *testing.T, got *auth.OAuthClaims, _ ...any) bool {
+				return assert.Equal(t, []string{"toe-3"}, got.TargetOfEvaluationIds)
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name:   "public key does not restrict targets of evaluation without claim",
+			args:   args{token: validPublicKeyToken},
+			fields: fields{interceptor: NewAuthInterceptor(WithPublicKey(publicKey))},
+			want: func(t *testing.T, got *auth.OAuthClaims, _ ...any) bool {
+				return assert.False(t, got.RestrictsTargetOfEvaluations())
+			},
+			wantErr: assert.NoError,
+		},
 		{
 			name:   "missing public key returns error",
 			args:   args{token: validPublicKeyToken},
@@ -360,6 +408,26 @@ func TestAuthInterceptorWrapStreamingHandler(t *testing.T) {
 			},
 			wantErr: assert.NoError,
 		},
+		{
+			name:   "delegated token bypasses auth",
+			args:   args{procedure: "/svc/Store", header: "Bearer cc_collector_secret"},
+			fields: fields{interceptor: NewAuthInterceptor(WithDelegatedTokens("cc_", "/svc/Store"), WithPublicKey(publicKey))},
+			want: func(t *testing.T, got gotData, _ ...any) bool {
+				return assert.True(t, got.nextCalled) &&
+					assert.False(t, got.claimsSet)
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name:   "delegated token of other procedure returns unauthenticated",
+			args:   args{procedure: "/svc/Method", header: "Bearer cc_collector_secret"},
+			fields: fields{interceptor: NewAuthInterceptor(WithDelegatedTokens("cc_", "/svc/Store"), WithPublicKey(publicKey))},
+			want: func(t *testing.T, got gotData, _ ...any) bool {
+				return assert.Equal(t, connect.CodeUnauthenticated, got.code) &&
+					assert.False(t, got.nextCalled)
+			},
+			wantErr: wantError,
+		},
 		{
 			name:   "missing authorization returns unauthenticated",
 			args:   args{procedure: "/svc/Method", header: ""},
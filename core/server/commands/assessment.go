@@ -18,6 +18,7 @@ package commands
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"confirmate.io/core/api/assessment/assessmentconnect"
 	"confirmate.io/core/server"
@@ -37,37 +38,208 @@ var assessmentFlags = []cli.Flag{
 		Value:   assessment.DefaultOrchestratorURL,
 		Sources: envVarSources("assessment-orchestrator-address"),
 	},
+	&cli.StringFlag{
+		Name:    "assessment-evidence-store-address",
+		Usage:   "Address of the evidence store service from which stored evidences are re-assessed",
+		Value:   assessment.DefaultEvidenceStoreURL,
+		Sources: envVarSources("assessment-evidence-store-address"),
+	},
 	&cli.StringFlag{
 		Name:    "assessment-rego-package",
 		Usage:   "Rego package to use for assessments",
 		Value:   assessment.DefaultConfig.RegoPackage,
 		Sources: envVarSources("assessment-rego-package"),
 	},
+	&cli.StringFlag{
+		Name:    "assessment-policy-server-url",
+		Usage:   "Base URL of an external policy server implementing the OPA Data API that evaluates the metrics (empty uses the embedded Rego engine)",
+		Sources: envVarSources("assessment-policy-server-url"),
+	},
+	&cli.StringFlag{
+		Name:    "assessment-metric-bundle",
+		Usage:   "Path to a local metric bundle (JSON) that is used instead of the metrics of the orchestrator",
+		Sources: envVarSources("assessment-metric-bundle"),
+	},
+	&cli.StringFlag{
+		Name:    "assessment-spool-directory",
+		Usage:   "Directory in which assessment results are spooled until the orchestrator is reachable (empty disables spooling)",
+		Sources: envVarSources("assessment-spool-directory"),
+	},
+	&cli.DurationFlag{
+		Name:    "assessment-spool-sync-interval",
+		Usage:   "Interval in which spooled assessment results are synced to the orchestrator",
+		Value:   assessment.DefaultConfig.SpoolSyncInterval,
+		Sources: envVarSources("assessment-spool-sync-interval"),
+	},
+	&cli.IntFlag{
+		Name:    "assessment-stream-workers",
+		Usage:   "Number of evidences of a single AssessEvidences stream that are assessed concurrently",
+		Value:   assessment.DefaultConfig.StreamWorkers,
+		Sources: envVarSources("assessment-stream-workers"),
+	},
+	&cli.IntFlag{
+		Name:    "assessment-stream-queue-size",
+		Usage:   "Number of evidences of a single AssessEvidences stream that are queued for assessment",
+		Value:   assessment.DefaultConfig.StreamQueueSize,
+		Sources: envVarSources("assessment-stream-queue-size"),
+	},
+	&cli.IntFlag{
+		Name:    "assessment-toe-workers",
+		Usage:   "Number of evidences of a single target of evaluation that are assessed concurrently",
+		Value:   assessment.DefaultConfig.ToEWorkers,
+		Sources: envVarSources("assessment-toe-workers"),
+	},
+	&cli.IntFlag{
+		Name:    "assessment-toe-queue-size",
+		Usage:   "Number of evidences of a single target of evaluation that are queued for assessment",
+		Value:   assessment.DefaultConfig.ToEQueueSize,
+		Sources: envVarSources("assessment-toe-queue-size"),
+	},
+	&cli.StringSliceFlag{
+		Name:    "assessment-owner-labels",
+		Usage:   "Label keys of a resource that contain its owner, if the evidence contains no owner hint",
+		Value:   assessment.DefaultOwnershipConfig.OwnerLabels,
+		Sources: envVarSources("assessment-owner-labels"),
+	},
+	&cli.StringSliceFlag{
+		Name:    "assessment-team-labels",
+		Usage:   "Label keys of a resource that contain its team, if the evidence contains no team hint",
+		Value:   assessment.DefaultOwnershipConfig.TeamLabels,
+		Sources: envVarSources("assessment-team-labels"),
+	},
+	&cli.StringMapFlag{
+		Name:    "assessment-namespace-teams",
+		Usage:   "Mapping of Kubernetes namespaces to the teams owning their resources (e.g. payments=team-payments)",
+		Sources: envVarSources("assessment-namespace-teams"),
+	},
+	&cli.StringMapFlag{
+		Name:    "assessment-subscription-teams",
+		Usage:   "Mapping of Azure subscription IDs or AWS account IDs to the teams owning their resources",
+		Sources: envVarSources("assessment-subscription-teams"),
+	},
+	&cli.StringSliceFlag{
+		Name:    "assessment-environment-labels",
+		Usage:   "Label keys of a resource that contain its environment (e.g. prod or staging), if the evidence contains no environment",
+		Value:   assessment.DefaultEnvironmentLabels,
+		Sources: envVarSources("assessment-environment-labels"),
+	},
+	&cli.StringMapFlag{
+		Name:    "assessment-evidence-max-age",
+		Usage:   "Mapping of resource types to the maximum age of their evidences, after which assessment results are stale (e.g. VirtualMachine=72h)",
+		Sources: envVarSources("assessment-evidence-max-age"),
+	},
+	&cli.DurationFlag{
+		Name:    "assessment-correlation-window",
+		Usage:   "The time within which the evidences of the tools a metric correlates need to be collected for a resource",
+		Value:   assessment.DefaultConfig.CorrelationWindow,
+		Sources: envVarSources("assessment-correlation-window"),
+	},
+	&cli.DurationFlag{
+		Name:    "assessment-correlation-eviction-interval",
+		Usage:   "The interval in which evidences outside of the correlation window are evicted (0 disables the eviction)",
+		Value:   assessment.DefaultConfig.CorrelationEvictionInterval,
+		Sources: envVarSources("assessment-correlation-eviction-interval"),
+	},
+	&cli.IntFlag{
+		Name:    "assessment-preload-workers",
+		Usage:   "Number of metric policies that are prepared concurrently on startup (0 prepares them on their first evaluation)",
+		Value:   assessment.DefaultConfig.PreloadWorkers,
+		Sources: envVarSources("assessment-preload-workers"),
+	},
+}
+
+// ownershipConfig builds the [assessment.OwnershipConfig] out of the assessment flags.
+func ownershipConfig(cmd *cli.Command) assessment.OwnershipConfig {
+	return assessment.OwnershipConfig{
+		OwnerLabels:       cmd.StringSlice("assessment-owner-labels"),
+		TeamLabels:        cmd.StringSlice("assessment-team-labels"),
+		NamespaceTeams:    cmd.StringMap("assessment-namespace-teams"),
+		SubscriptionTeams: cmd.StringMap("assessment-subscription-teams"),
+	}
+}
+
+// evidenceMaxAge parses the maximum evidence ages of the assessment-evidence-max-age flag, keyed by resource type.
+func evidenceMaxAge(cmd *cli.Command) (maxAges map[string]time.Duration, err error) {
+	var (
+		age time.Duration
+	)
+
+	maxAges = make(map[string]time.Duration)
+	for typ, value := range cmd.StringMap("assessment-evidence-max-age") {
+		age, err = time.ParseDuration(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid maximum evidence age of resource type %q: %w", typ, err)
+		}
+
+		maxAges[typ] = age
+	}
+
+	return
 }
 
 // AssessmentCommand is the command to start the assessment server.
-var AssessmentCommand = &cli.Command{
+var AssessmentCommand = withConfig(&cli.Command{
 	Name:  "assessment",
 	Usage: "Launches the assessment service",
 	Action: func(ctx context.Context, cmd *cli.Command) error {
 		var (
-			interceptors []connect.Interceptor
-			svcOptions   []service.Option[assessment.Service]
-			cfg          assessment.Config
+			interceptors     []connect.Interceptor
+			authInterceptor  *server.AuthInterceptor
+			observabilityOpt server.Option
+			svcOptions       []service.Option[assessment.Service]
+			cfg              assessment.Config
+			transport        service.TransportConfig
+			certs            *service.TLSCertificates
+			maxAges          map[string]time.Duration
+			err              error
 		)
 
+		transport, err = transportConfig(cmd)
+		if err != nil {
+			return err
+		}
+
+		certs, err = tlsCertificates(cmd)
+		if err != nil {
+			return err
+		}
+
+		maxAges, err = evidenceMaxAge(cmd)
+		if err != nil {
+			return err
+		}
+
 		cfg = assessment.Config{
-			OrchestratorAddress:    cmd.String("assessment-orchestrator-address"),
-			OrchestratorHTTPClient: service.NewHTTPClient(),
-			RegoPackage:            cmd.String("assessment-rego-package"),
+			OrchestratorAddress:         cmd.String("assessment-orchestrator-address"),
+			OrchestratorHTTPClient:      newHTTPClient(certs),
+			EvidenceStoreAddress:        cmd.String("assessment-evidence-store-address"),
+			EvidenceStoreHTTPClient:     newHTTPClient(certs),
+			RegoPackage:                 cmd.String("assessment-rego-package"),
+			PolicyServerURL:             cmd.String("assessment-policy-server-url"),
+			PolicyServerHTTPClient:      newHTTPClient(certs),
+			MetricBundlePath:            cmd.String("assessment-metric-bundle"),
+			SpoolDirectory:              cmd.String("assessment-spool-directory"),
+			SpoolSyncInterval:           cmd.Duration("assessment-spool-sync-interval"),
+			StreamWorkers:               cmd.Int("assessment-stream-workers"),
+			StreamQueueSize:             cmd.Int("assessment-stream-queue-size"),
+			ToEWorkers:                  cmd.Int("assessment-toe-workers"),
+			ToEQueueSize:                cmd.Int("assessment-toe-queue-size"),
+			Ownership:                   ownershipConfig(cmd),
+			EnvironmentLabels:           cmd.StringSlice("assessment-environment-labels"),
+			EvidenceMaxAge:              maxAges,
+			CorrelationWindow:           cmd.Duration("assessment-correlation-window"),
+			CorrelationEvictionInterval: cmd.Duration("assessment-correlation-eviction-interval"),
+			PreloadWorkers:              cmd.Int("assessment-preload-workers"),
+			Transport:                   transport,
 		}
 
 		if cmd.Bool("auth-enabled") {
 			jwksURL := cmd.String("auth-jwks-url")
 			if jwksURL == server.DefaultJWKSURL {
-				jwksURL = fmt.Sprintf("http://localhost:%d/v1/auth/certs", cmd.Uint16("api-port"))
+				jwksURL = localJWKSURL(cmd.Uint16("api-port"), certs)
 			}
-			interceptors = append(interceptors, server.NewAuthInterceptor(authInterceptorOptions(cmd, jwksURL)...))
+			authInterceptor = server.NewAuthInterceptor(authInterceptorOptions(cmd, jwksURL, certs)...)
+			interceptors = append(interceptors, authInterceptor)
 			svcOptions = append(svcOptions, assessment.WithAuthorizationStrategyPermissionStore())
 
 			cfg.ServiceOAuth2Config = &clientcredentials.Config{
@@ -78,6 +250,7 @@ var AssessmentCommand = &cli.Command{
 		}
 
 		interceptors = append(interceptors, &server.LoggingInterceptor{})
+		interceptors, observabilityOpt = observability(cmd, authInterceptor, interceptors)
 		svcOptions = append(svcOptions, assessment.WithConfig(cfg))
 
 		svc, err := assessment.NewService(svcOptions...)
@@ -85,11 +258,15 @@ var AssessmentCommand = &cli.Command{
 			return err
 		}
 
-		return server.RunConnectServer(
+		lifecycle := service.NewLifecycleManager()
+		lifecycle.Register("assessment", svc.(service.Lifecycle))
+
+		return serve(ctx, lifecycle,
 			server.WithConfig(server.Config{
-				Port:     cmd.Uint16("api-port"),
-				Path:     "/",
-				LogLevel: cmd.String("log-level"),
+				Port:      cmd.Uint16("api-port"),
+				Path:      "/",
+				LogLevel:  cmd.String("log-level"),
+				TLSConfig: serverTLSConfig(certs),
 				CORS: server.CORS{
 					AllowedOrigins: cmd.StringSlice("api-cors-allowed-origins"),
 					AllowedMethods: cmd.StringSlice("api-cors-allowed-methods"),
@@ -98,16 +275,19 @@ var AssessmentCommand = &cli.Command{
 			}),
 			server.WithHandler(assessmentconnect.NewAssessmentHandler(
 				svc,
-				connect.WithInterceptors(interceptors...),
+				handlerOptions(interceptors, transport)...,
 			)),
 			server.WithReflection(),
+			observabilityOpt,
 		)
 	},
 	Flags: joinFlagSlices(
 		logFlags,
 		apiFlags,
+		transportFlags,
+		tlsFlags,
 		authFlags,
 		serviceAuthFlags,
 		assessmentFlags,
 	),
-}
+})
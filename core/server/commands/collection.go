@@ -17,13 +17,13 @@ package commands
 
 import (
 	"context"
-	"log/slog"
 	"os"
 	"os/signal"
 	"syscall"
 
 	"confirmate.io/core/api/ontology"
 	"confirmate.io/core/log"
+	"confirmate.io/core/service"
 	"confirmate.io/core/service/collection"
 
 	"github.com/google/uuid"
@@ -81,15 +81,17 @@ var collectionFlags = []cli.Flag{
 }
 
 // CollectionCommand is the command to start the collection service.
-var CollectionCommand = &cli.Command{
+var CollectionCommand = withConfig(&cli.Command{
 	Name:  "collection",
 	Usage: "Launches the collection service",
 	Action: func(ctx context.Context, cmd *cli.Command) (err error) {
 		var (
-			runCtx   context.Context
-			cancel   context.CancelFunc
-			svc      *collection.Service
-			resultCh <-chan collection.CollectionResult
+			runCtx    context.Context
+			cancel    context.CancelFunc
+			svc       *collection.Service
+			lifecycle *service.LifecycleManager
+			transport service.TransportConfig
+			certs     *service.TLSCertificates
 		)
 
 		runCtx, cancel = signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
@@ -99,11 +101,23 @@ var CollectionCommand = &cli.Command{
 			return err
 		}
 
+		transport, err = transportConfig(cmd)
+		if err != nil {
+			return err
+		}
+
+		certs, err = tlsCertificates(cmd)
+		if err != nil {
+			return err
+		}
+
 		svc, err = collection.NewService(
 			collection.WithConfig(collection.Config{
-				Interval:             cmd.Duration("collection-interval"),
-				EvidenceStoreAddress: cmd.String("evidence-store-address"),
-				TargetOfEvaluationID: cmd.String("target-of-evaluation-id"),
+				Interval:                cmd.Duration("collection-interval"),
+				EvidenceStoreAddress:    cmd.String("evidence-store-address"),
+				TargetOfEvaluationID:    cmd.String("target-of-evaluation-id"),
+				Transport:               transport,
+				EvidenceStoreHTTPClient: newHTTPClient(certs),
 				Collectors: []collection.Collector{
 					newNoOpCollector("cli-no-op-collector"),
 				},
@@ -113,15 +127,22 @@ var CollectionCommand = &cli.Command{
 			return err
 		}
 
-		resultCh = svc.Start(runCtx)
-		for range resultCh {
-			slog.Debug("Collection cycle finished")
+		lifecycle = service.NewLifecycleManager()
+		lifecycle.Register("collection", svc)
+
+		err = lifecycle.Start(runCtx)
+		if err != nil {
+			return err
 		}
 
-		return nil
+		<-runCtx.Done()
+
+		return shutdown(lifecycle)
 	},
 	Flags: joinFlagSlices(
 		logFlags,
+		transportFlags,
+		tlsFlags,
 		collectionFlags,
 	),
-}
+})
@@ -17,12 +17,20 @@ package commands
 
 import (
 	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net/http"
 	"os"
+	"os/signal"
 	"strings"
+	"syscall"
 
 	"confirmate.io/core/persistence"
 	"confirmate.io/core/server"
+	"confirmate.io/core/service"
 
+	"connectrpc.com/connect"
 	"github.com/urfave/cli/v3"
 )
 
@@ -63,6 +71,72 @@ var (
 			Value:   server.DefaultConfig.CORS.AllowedHeaders,
 			Sources: envVarSources("api-cors-allowed-headers"),
 		},
+		&cli.BoolFlag{
+			Name:    "api-metrics",
+			Usage:   "Expose Prometheus metrics of the handled RPCs at " + server.DefaultMetricsPath,
+			Value:   true,
+			Sources: envVarSources("api-metrics"),
+		},
+		&cli.BoolFlag{
+			Name:    "api-pprof",
+			Usage:   "Serve the pprof profiling endpoints at " + server.DefaultPprofPath + " (restricted to admins if auth is enabled)",
+			Sources: envVarSources("api-pprof"),
+		},
+	}
+
+	// transportFlags contains the flags for configuring message size limits and compression of the API
+	// server and of the clients to other services.
+	transportFlags = []cli.Flag{
+		&cli.IntFlag{
+			Name:    "api-read-max-bytes",
+			Usage:   "Maximum size of a received message in bytes (0 means no limit)",
+			Value:   service.DefaultTransportConfig.ReadMaxBytes,
+			Sources: envVarSources("api-read-max-bytes"),
+		},
+		&cli.IntFlag{
+			Name:    "api-send-max-bytes",
+			Usage:   "Maximum size of a sent message in bytes (0 means no limit)",
+			Value:   service.DefaultTransportConfig.SendMaxBytes,
+			Sources: envVarSources("api-send-max-bytes"),
+		},
+		&cli.StringFlag{
+			Name:    "api-compression",
+			Usage:   "Compression used for requests to other services (gzip, zstd or empty to disable)",
+			Value:   service.DefaultTransportConfig.Compression,
+			Sources: envVarSources("api-compression"),
+		},
+		&cli.IntFlag{
+			Name:    "api-compress-min-bytes",
+			Usage:   "Minimum size of a message in bytes before it is compressed",
+			Value:   service.DefaultTransportConfig.CompressMinBytes,
+			Sources: envVarSources("api-compress-min-bytes"),
+		},
+	}
+
+	// tlsFlags contains the flags for configuring (mutual) TLS of the API server and of the clients
+	// to other services.
+	tlsFlags = []cli.Flag{
+		&cli.StringFlag{
+			Name:    "tls-cert-file",
+			Usage:   "Path of the PEM encoded certificate of the service; enables TLS for the API server and the clients to other services",
+			Sources: envVarSources("tls-cert-file"),
+		},
+		&cli.StringFlag{
+			Name:    "tls-key-file",
+			Usage:   "Path of the PEM encoded private key of the certificate",
+			Sources: envVarSources("tls-key-file"),
+		},
+		&cli.StringFlag{
+			Name:    "tls-ca-file",
+			Usage:   "Path of the PEM encoded CA certificates used to verify peers; enables mutual TLS, i.e., clients need to present a certificate",
+			Sources: envVarSources("tls-ca-file"),
+		},
+		&cli.DurationFlag{
+			Name:    "tls-reload-interval",
+			Usage:   "Interval in which the certificate, key and CA files are checked for changes (0 disables reloading)",
+			Value:   service.DefaultTLSReloadInterval,
+			Sources: envVarSources("tls-reload-interval"),
+		},
 	}
 
 	// authFlags contains the flags for configuring authentication and authorization for the
@@ -86,6 +160,12 @@ var (
 			Value:   []string{"roles"},
 			Sources: envVarSources("auth-role-claim-paths"),
 		},
+		&cli.StringSliceFlag{
+			Name:    "auth-toe-claim-paths",
+			Usage:   "Dotted JWT claim paths to read the IDs of the targets of evaluation a token is restricted to from (repeatable)",
+			Value:   []string{"cftoes"},
+			Sources: envVarSources("auth-toe-claim-paths"),
+		},
 	}
 
 	// serviceAuthFlags contains the flags for configuring service-to-service authentication using
@@ -181,6 +261,12 @@ func newDBFlags(defaultInMemory bool) (flags []cli.Flag) {
 			Value:   persistence.DefaultConfig.SSLMode,
 			Sources: envVarSources("db-ssl-mode"),
 		},
+		&cli.StringFlag{
+			Name:    "db-backend",
+			Usage:   "Specifies the database backend (postgres, cockroachdb or a registered custom backend)",
+			Value:   persistence.DefaultConfig.Backend,
+			Sources: envVarSources("db-backend"),
+		},
 		&cli.BoolFlag{
 			Name:    "db-in-memory",
 			Usage:   "Use in-memory database instead of PostgreSQL (useful for testing)",
@@ -200,23 +286,162 @@ func newDBFlags(defaultInMemory bool) (flags []cli.Flag) {
 
 // authInterceptorOptions builds the [server.AuthOption] list for the auth
 // interceptor from the shared --auth-* flags. Server commands should pass the
-// already-resolved JWKS URL — the rest comes straight from the CLI flags.
-func authInterceptorOptions(cmd *cli.Command, jwksURL string) []server.AuthOption {
+// already-resolved JWKS URL — the rest comes straight from the CLI flags. If TLS
+// is configured, the JWKS is retrieved using the certificates of the service.
+func authInterceptorOptions(cmd *cli.Command, jwksURL string, certs *service.TLSCertificates) []server.AuthOption {
 	opts := []server.AuthOption{server.WithJWKS(jwksURL)}
 
+	if certs != nil {
+		opts = append(opts, server.WithJWKSHTTPClient(newHTTPClient(certs)))
+	}
+
 	if paths := cmd.StringSlice("auth-role-claim-paths"); len(paths) > 0 {
 		opts = append(opts, server.WithRoleClaimPaths(paths...))
 	}
 
+	if paths := cmd.StringSlice("auth-toe-claim-paths"); len(paths) > 0 {
+		opts = append(opts, server.WithTargetOfEvaluationClaimPaths(paths...))
+	}
+
 	return opts
 }
 
+// transportConfig builds the [service.TransportConfig] from the shared --api-* transport flags.
+func transportConfig(cmd *cli.Command) (cfg service.TransportConfig, err error) {
+	cfg = service.TransportConfig{
+		ReadMaxBytes:     cmd.Int("api-read-max-bytes"),
+		SendMaxBytes:     cmd.Int("api-send-max-bytes"),
+		Compression:      cmd.String("api-compression"),
+		CompressMinBytes: cmd.Int("api-compress-min-bytes"),
+	}
+
+	err = cfg.Validate()
+	if err != nil {
+		return service.TransportConfig{}, err
+	}
+
+	return cfg, nil
+}
+
+// tlsCertificates loads the certificates of the shared --tls-* flags. It returns nil if TLS is not configured.
+func tlsCertificates(cmd *cli.Command) (certs *service.TLSCertificates, err error) {
+	cfg := service.TLSConfig{
+		CertFile:       cmd.String("tls-cert-file"),
+		KeyFile:        cmd.String("tls-key-file"),
+		CAFile:         cmd.String("tls-ca-file"),
+		ReloadInterval: cmd.Duration("tls-reload-interval"),
+	}
+
+	if !cfg.Enabled() {
+		return nil, nil
+	}
+
+	return service.NewTLSCertificates(cfg)
+}
+
+// newHTTPClient returns an [http.Client] for the connections to other services, which presents the certificate of
+// the service and verifies the other services, if TLS is configured.
+func newHTTPClient(certs *service.TLSCertificates) *http.Client {
+	if certs == nil {
+		return service.NewHTTPClient()
+	}
+
+	return service.NewTLSHTTPClient(certs.ClientConfig())
+}
+
+// serverTLSConfig returns the [tls.Config] of the API server, or nil if TLS is not configured.
+func serverTLSConfig(certs *service.TLSCertificates) *tls.Config {
+	if certs == nil {
+		return nil
+	}
+
+	return certs.ServerConfig()
+}
+
+// localJWKSURL returns the URL of the JWKS of the embedded OAuth 2.0 server of the local API server.
+func localJWKSURL(port uint16, certs *service.TLSCertificates) string {
+	scheme := "http"
+	if certs != nil {
+		scheme = "https"
+	}
+
+	return fmt.Sprintf("%s://localhost:%d/v1/auth/certs", scheme, port)
+}
+
+// handlerOptions returns the [connect.HandlerOption]s for a service handler, consisting of the given interceptors
+// and the message size limits and compression of the transport configuration.
+func handlerOptions(interceptors []connect.Interceptor, transport service.TransportConfig) []connect.HandlerOption {
+	return append([]connect.HandlerOption{connect.WithInterceptors(interceptors...)}, transport.HandlerOptions()...)
+}
+
+// observability prepends the metrics interceptor to the given interceptors and returns a [server.Option] that exposes
+// the RPC metrics and the pprof endpoints, as configured by the api-metrics and api-pprof flags. The metrics of the
+// given collectors are exposed along with the RPC metrics. The pprof endpoints are restricted to admins by
+// authInterceptor, which is nil if auth is disabled. The metrics interceptor comes first, so that rejected requests are
+// recorded as well.
+func observability(cmd *cli.Command, authInterceptor *server.AuthInterceptor, interceptors []connect.Interceptor,
+	collectors ...server.MetricsCollector) ([]connect.Interceptor, server.Option) {
+	var opts []server.Option
+
+	if cmd.Bool("api-metrics") {
+		metrics := server.NewMetricsInterceptor()
+		for _, c := range collectors {
+			metrics.RegisterCollector(c)
+		}
+		interceptors = append([]connect.Interceptor{metrics}, interceptors...)
+		opts = append(opts, server.WithMetrics(metrics))
+	}
+
+	if cmd.Bool("api-pprof") {
+		opts = append(opts, server.WithPprof(authInterceptor))
+	}
+
+	return interceptors, func(srv *server.Server) {
+		for _, opt := range opts {
+			opt(srv)
+		}
+	}
+}
+
+// serve runs a Connect server with the given options until ctx is done. The components of lifecycle are started
+// before the server serves requests and shut down in reverse order after the server stopped (see [shutdown]).
+func serve(ctx context.Context, lifecycle *service.LifecycleManager, opts ...server.Option) (err error) {
+	var srv *server.Server
+
+	srv, err = server.NewConnectServer(opts)
+	if err != nil {
+		return err
+	}
+
+	err = lifecycle.Start(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = srv.Run(ctx)
+
+	return errors.Join(err, shutdown(lifecycle))
+}
+
+// shutdown shuts down the components of lifecycle in reverse order, waiting at most [server.DefaultShutdownTimeout].
+func shutdown(lifecycle *service.LifecycleManager) (err error) {
+	ctx, cancel := context.WithTimeout(context.Background(), server.DefaultShutdownTimeout)
+	defer cancel()
+
+	return lifecycle.Shutdown(ctx)
+}
+
 // ParseAndRun parses the command line arguments and runs the given command.
 // If an error occurs, it is printed to stderr and the program exits with a non-zero
 // status code.
 //
 // If the help flag is provided, the usage information is printed to stdout
 // and the function returns without error.
+//
+// The context of the command is canceled on SIGINT or SIGTERM, so that the services are shut down gracefully.
 func ParseAndRun(cmd *cli.Command) error {
-	return cmd.Run(context.Background(), os.Args)
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	return cmd.Run(ctx, os.Args)
 }
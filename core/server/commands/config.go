@@ -0,0 +1,325 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package commands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"net/url"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"confirmate.io/core/log"
+	"confirmate.io/core/service"
+
+	"github.com/urfave/cli/v3"
+	"go.yaml.in/yaml/v3"
+)
+
+// redacted replaces the values of secrets in the output of the print-config command.
+const redacted = "<redacted>"
+
+// newConfigFlags constructs the flag for loading the configuration of a service from a file. Each command gets its
+// own flag, since the flag keeps its state once it is set.
+func newConfigFlags() []cli.Flag {
+	return []cli.Flag{
+		&cli.StringFlag{
+			Name:    "config",
+			Usage:   "Path of a YAML file that configures the service, keyed by the names of the flags; flags and environment variables take precedence",
+			Sources: envVarSources("config"),
+		},
+	}
+}
+
+// configRule checks the value of a flag once the configuration of a service is loaded.
+type configRule func(cmd *cli.Command, name string) error
+
+// configRules contains the constraints of the configuration that go beyond the type of a flag, keyed by the name of
+// the flag. Rules of flags that a command does not have are skipped. In addition, all addresses and URLs are
+// validated, see [validURL].
+var configRules = map[string]configRule{
+	"log-level":                             validLogLevel,
+	"api-port":                              atLeast(1),
+	"api-compression":                       oneOf("", service.CompressionGzip, service.CompressionZstd),
+	"api-read-max-bytes":                    atLeast(0),
+	"api-send-max-bytes":                    atLeast(0),
+	"api-compress-min-bytes":                atLeast(0),
+	"tls-reload-interval":                   atLeast(0),
+	"db-port":                               atLeast(1),
+	"db-ssl-mode":                           oneOf("disable", "allow", "prefer", "require", "verify-ca", "verify-full"),
+	"db-max-connections":                    atLeast(1),
+	"assessment-preload-workers":            atLeast(0),
+	"evaluation-max-concurrent-controls":    atLeast(0),
+	"evaluation-max-concurrent-queries":     atLeast(0),
+	"evaluation-sample-size":                atLeast(0),
+	"evaluation-sampling-strategy":          oneOf("random", "stratified", "non-compliant-first"),
+	"evaluation-control-cache-ttl":          atLeast(0),
+	"evaluation-control-jitter":             atLeast(0),
+	"evaluation-audit-scope-stagger":        atLeast(0),
+	"evaluation-consistency-check-interval": atLeast(0),
+	"evidence-immutable-retention":          atLeast(0),
+	"assessment-immutable-retention":        atLeast(0),
+	"collection-interval":                   atLeast(1),
+	"anomaly-check-interval":                atLeast(0),
+	"anomaly-baseline-period":               atLeast(1),
+	"anomaly-flapping-threshold":            atLeast(1),
+}
+
+// withConfig adds the shared configuration handling to a service command: The configuration can be loaded from the
+// file of the config flag, it is validated before the service starts and the print-config sub-command prints the
+// effective configuration.
+func withConfig(cmd *cli.Command) *cli.Command {
+	cmd.Flags = joinFlagSlices(newConfigFlags(), cmd.Flags)
+	cmd.Before = loadConfig
+	cmd.Commands = append(cmd.Commands, printConfigCommand(cmd))
+
+	return cmd
+}
+
+// loadConfig applies the configuration file of the config flag to all flags that are neither set on the command line
+// nor by an environment variable and validates the resulting configuration.
+func loadConfig(ctx context.Context, cmd *cli.Command) (context.Context, error) {
+	if path := cmd.String("config"); path != "" {
+		if err := applyConfigFile(cmd, path); err != nil {
+			return ctx, err
+		}
+	}
+
+	return ctx, validateConfig(cmd)
+}
+
+// applyConfigFile sets the flags of cmd to the values of the YAML file at path. Keys that are not the name of a flag
+// are rejected, so that typos do not go unnoticed. Lists and maps are accepted for slice and map flags.
+func applyConfigFile(cmd *cli.Command, path string) (err error) {
+	var (
+		b      []byte
+		values map[string]any
+		known  = flagNames(cmd)
+	)
+
+	b, err = os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("could not read configuration file: %w", err)
+	}
+
+	err = yaml.Unmarshal(b, &values)
+	if err != nil {
+		return fmt.Errorf("could not parse configuration file %s: %w", path, err)
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(values)) {
+		if !slices.Contains(known, name) || name == "config" {
+			return fmt.Errorf("unknown configuration key %q in %s", name, path)
+		}
+
+		// Flags and environment variables take precedence over the file
+		if cmd.IsSet(name) {
+			continue
+		}
+
+		for _, v := range configValues(values[name]) {
+			err = cmd.Set(name, v)
+			if err != nil {
+				return fmt.Errorf("invalid value of configuration key %q in %s: %w", name, path, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// configValues converts a value of the configuration file into the values of a flag. Lists yield one value per item
+// and maps one key=value pair per entry.
+func configValues(v any) (values []string) {
+	switch v := v.(type) {
+	case nil:
+		return []string{""}
+	case []any:
+		for _, item := range v {
+			values = append(values, fmt.Sprint(item))
+		}
+	case map[string]any:
+		for _, key := range slices.Sorted(maps.Keys(v)) {
+			values = append(values, fmt.Sprintf("%s=%v", key, v[key]))
+		}
+	default:
+		values = []string{fmt.Sprint(v)}
+	}
+
+	return values
+}
+
+// validateConfig checks the effective configuration of cmd against [configRules] and validates all addresses and
+// URLs. All violations are reported at once.
+func validateConfig(cmd *cli.Command) error {
+	var errs []error
+
+	for _, name := range flagNames(cmd) {
+		rule, ok := configRules[name]
+		if !ok && isURLFlag(name) {
+			rule, ok = validURL, true
+		}
+		if !ok {
+			continue
+		}
+
+		if err := rule(cmd, name); err != nil {
+			errs = append(errs, fmt.Errorf("invalid configuration of %s: %w", name, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// printConfigCommand returns the print-config sub-command of the given service command. It prints the effective
+// configuration, i.e., after applying defaults, the configuration file, environment variables and flags, as YAML
+// that can be used as configuration file. Secrets are redacted.
+func printConfigCommand(serviceCmd *cli.Command) *cli.Command {
+	return &cli.Command{
+		Name:  "print-config",
+		Usage: "Prints the effective configuration of the service as YAML",
+		Action: func(ctx context.Context, cmd *cli.Command) error {
+			b, err := yaml.Marshal(effectiveConfig(serviceCmd))
+			if err != nil {
+				return err
+			}
+
+			_, err = cmd.Root().Writer.Write(b)
+			return err
+		},
+	}
+}
+
+// effectiveConfig returns the values of all flags of cmd, keyed by their name.
+func effectiveConfig(cmd *cli.Command) (cfg map[string]any) {
+	cfg = make(map[string]any)
+	for _, name := range flagNames(cmd) {
+		if name == "config" {
+			continue
+		}
+
+		switch v := cmd.Value(name).(type) {
+		case time.Duration:
+			cfg[name] = v.String()
+		case string:
+			if v != "" && isSecretFlag(name) {
+				v = redacted
+			}
+			cfg[name] = v
+		default:
+			cfg[name] = v
+		}
+	}
+
+	return cfg
+}
+
+// flagNames returns the names of all flags of cmd, except the help flag.
+func flagNames(cmd *cli.Command) (names []string) {
+	for _, f := range cmd.Flags {
+		if name := f.Names()[0]; name != "help" {
+			names = append(names, name)
+		}
+	}
+
+	return names
+}
+
+// isSecretFlag returns whether the flag with the given name contains a secret that must not be printed. This includes
+// all keys, such as the pseudonymization key of the evidence store.
+func isSecretFlag(name string) bool {
+	return strings.HasSuffix(name, "-secret") || strings.HasSuffix(name, "-password") ||
+		strings.HasSuffix(name, "-token") || strings.HasSuffix(name, "-key")
+}
+
+// isURLFlag returns whether the flag with the given name contains an address or URL.
+func isURLFlag(name string) bool {
+	return strings.HasSuffix(name, "-address") || strings.HasSuffix(name, "-url") || strings.HasSuffix(name, "-endpoint")
+}
+
+// validLogLevel checks that the flag contains a known log level.
+func validLogLevel(cmd *cli.Command, name string) error {
+	var level log.Level
+
+	return level.UnmarshalText([]byte(cmd.String(name)))
+}
+
+// validURL checks that the flag, or each value of a slice flag, is either empty or an absolute HTTP(S) URL.
+func validURL(cmd *cli.Command, name string) error {
+	var values []string
+
+	switch v := cmd.Value(name).(type) {
+	case string:
+		values = []string{v}
+	case []string:
+		values = v
+	}
+
+	for _, s := range values {
+		if s == "" {
+			continue
+		}
+
+		u, err := url.Parse(s)
+		if err != nil {
+			return err
+		}
+		if u.Scheme != "http" && u.Scheme != "https" || u.Host == "" {
+			return fmt.Errorf("%q is not an absolute HTTP(S) URL", s)
+		}
+	}
+
+	return nil
+}
+
+// oneOf returns a [configRule] that checks that the flag contains one of the given values.
+func oneOf(values ...string) configRule {
+	return func(cmd *cli.Command, name string) error {
+		if !slices.Contains(values, cmd.String(name)) {
+			return fmt.Errorf("%q is not one of %q", cmd.String(name), values)
+		}
+
+		return nil
+	}
+}
+
+// atLeast returns a [configRule] that checks that the numeric flag, or a duration in nanoseconds, is at least min.
+func atLeast(min int64) configRule {
+	return func(cmd *cli.Command, name string) error {
+		var n int64
+
+		switch v := cmd.Value(name).(type) {
+		case int:
+			n = int64(v)
+		case uint16:
+			n = int64(v)
+		case time.Duration:
+			n = int64(v)
+		default:
+			return fmt.Errorf("unsupported type %T", v)
+		}
+
+		if n < min {
+			return fmt.Errorf("%v is less than %d", cmd.Value(name), min)
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,244 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package commands
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"confirmate.io/core/util/assert"
+
+	"github.com/urfave/cli/v3"
+)
+
+// newConfigTestCommand returns a service command with a subset of the service flags. The action stores the command,
+// so that the effective configuration can be inspected.
+func newConfigTestCommand(got **cli.Command) *cli.Command {
+	return withConfig(&cli.Command{
+		Name: "test",
+		Flags: []cli.Flag{
+			&cli.StringFlag{Name: "log-level", Value: "INFO", Sources: envVarSources("log-level")},
+			&cli.IntFlag{Name: "db-port", Value: 5432},
+			&cli.StringFlag{Name: "db-password", Value: "confirmate"},
+			&cli.StringSliceFlag{Name: "api-cors-allowed-origins", Value: []string{"*"}},
+			&cli.StringMapFlag{Name: "evaluation-narrative-templates"},
+			&cli.DurationFlag{Name: "collection-interval", Value: time.Minute},
+			&cli.StringFlag{Name: "evaluation-orchestrator-address", Value: "http://localhost:8080"},
+			&cli.StringFlag{Name: "secret-key"},
+		},
+		Action: func(_ context.Context, cmd *cli.Command) error {
+			*got = cmd
+			return nil
+		},
+	})
+}
+
+// writeConfigFile writes the given configuration into a temporary file and returns its path.
+func writeConfigFile(t *testing.T, content string) string {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	assert.NoError(t, os.WriteFile(path, []byte(content), 0600))
+
+	return path
+}
+
+func TestWithConfig(t *testing.T) {
+	type args struct {
+		config string
+		env    map[string]string
+		args   []string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    assert.Want[*cli.Command]
+		wantErr assert.WantErr
+	}{
+		{
+			name: "defaults without configuration file",
+			want: func(t *testing.T, got *cli.Command, msgAndArgs ...any) bool {
+				return assert.Equal(t, "INFO", got.String("log-level")) &&
+					assert.Equal(t, 5432, got.Int("db-port"))
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "configuration file",
+			args: args{
+				config: `
+log-level: DEBUG
+db-port: 5433
+api-cors-allowed-origins: [https://a.example, https://b.example]
+evaluation-narrative-templates:
+  de: narrative.de.tmpl
+  en: narrative.tmpl
+collection-interval: 30s
+`,
+			},
+			want: func(t *testing.T, got *cli.Command, msgAndArgs ...any) bool {
+				return assert.Equal(t, "DEBUG", got.String("log-level")) &&
+					assert.Equal(t, 5433, got.Int("db-port")) &&
+					assert.Equal(t, []string{"https://a.example", "https://b.example"}, got.StringSlice("api-cors-allowed-origins")) &&
+					assert.Equal(t, map[string]string{"de": "narrative.de.tmpl", "en": "narrative.tmpl"}, got.StringMap("evaluation-narrative-templates")) &&
+					assert.Equal(t, 30*time.Second, got.Duration("collection-interval"))
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "flags and environment variables take precedence",
+			args: args{
+				config: "log-level: DEBUG\ndb-port: 5433\n",
+				env:    map[string]string{"CONFIRMATE_LOG_LEVEL": "WARN"},
+				args:   []string{"--db-port", "5434"},
+			},
+			want: func(t *testing.T, got *cli.Command, msgAndArgs ...any) bool {
+				return assert.Equal(t, "WARN", got.String("log-level")) &&
+					assert.Equal(t, 5434, got.Int("db-port"))
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "err: unknown key",
+			args: args{
+				config: "db-prot: 5433\n",
+			},
+			want: assert.Nil[*cli.Command],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.ErrorContains(t, err, `unknown configuration key "db-prot"`)
+			},
+		},
+		{
+			name: "err: invalid value",
+			args: args{
+				config: "db-port: many\n",
+			},
+			want: assert.Nil[*cli.Command],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.ErrorContains(t, err, `invalid value of configuration key "db-port"`)
+			},
+		},
+		{
+			name: "err: validation of all violations",
+			args: args{
+				args: []string{"--log-level", "LOUD", "--db-port", "0", "--evaluation-orchestrator-address", "localhost:8080"},
+			},
+			want: assert.Nil[*cli.Command],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.ErrorContains(t, err, "invalid configuration of log-level") &&
+					assert.ErrorContains(t, err, "invalid configuration of db-port: 0 is less than 1") &&
+					assert.ErrorContains(t, err, "invalid configuration of evaluation-orchestrator-address")
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				got  *cli.Command
+				args = []string{"test"}
+			)
+
+			for key, value := range tt.args.env {
+				t.Setenv(key, value)
+			}
+			if tt.args.config != "" {
+				args = append(args, "--config", writeConfigFile(t, tt.args.config))
+			}
+
+			err := newConfigTestCommand(&got).Run(context.Background(), append(args, tt.args.args...))
+			tt.want(t, got)
+			tt.wantErr(t, err)
+		})
+	}
+}
+
+func TestPrintConfigCommand(t *testing.T) {
+	var (
+		got *cli.Command
+		out bytes.Buffer
+	)
+
+	cmd := newConfigTestCommand(&got)
+	cmd.Writer = &out
+
+	err := cmd.Run(context.Background(), []string{"test", "--config", writeConfigFile(t, "db-port: 5433\nsecret-key: 00ff\n"), "print-config"})
+	assert.NoError(t, err)
+
+	// The action of the service is not run, the secrets are redacted and the output can be used as configuration file
+	assert.Nil(t, got)
+	assert.Equal(t, `api-cors-allowed-origins:
+    - '*'
+collection-interval: 1m0s
+db-password: <redacted>
+db-port: 5433
+evaluation-narrative-templates: {}
+evaluation-orchestrator-address: http://localhost:8080
+log-level: INFO
+secret-key: <redacted>
+`, out.String())
+}
+
+func Test_isSecretFlag(t *testing.T) {
+	tests := []struct {
+		name string
+		flag string
+		want bool
+	}{
+		{
+			name: "password",
+			flag: "db-password",
+			want: true,
+		},
+		{
+			name: "client secret",
+			flag: "service-oauth2-client-secret",
+			want: true,
+		},
+		{
+			name: "token",
+			flag: "user-directory-scim-token",
+			want: true,
+		},
+		{
+			name: "key",
+			flag: "evidence-pseudonymization-key",
+			want: true,
+		},
+		{
+			name: "encryption key of secrets",
+			flag: "secret-key",
+			want: true,
+		},
+		{
+			name: "path of a key file",
+			flag: "tls-key-file",
+			want: false,
+		},
+		{
+			name: "address",
+			flag: "evaluation-orchestrator-address",
+			want: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, isSecretFlag(tt.flag))
+		})
+	}
+}
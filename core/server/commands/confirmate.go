@@ -25,8 +25,12 @@ import (
 
 	"confirmate.io/core/api"
 	"confirmate.io/core/api/assessment/assessmentconnect"
+	evaluationapi "confirmate.io/core/api/evaluation"
 	"confirmate.io/core/api/evaluation/evaluationconnect"
+	evaluationv2api "confirmate.io/core/api/evaluation/v2"
+	"confirmate.io/core/api/evaluation/v2/evaluationv2connect"
 	"confirmate.io/core/api/evidence/evidenceconnect"
+	orchestratorapi "confirmate.io/core/api/orchestrator"
 	"confirmate.io/core/api/orchestrator/orchestratorconnect"
 	"confirmate.io/core/persistence"
 	"confirmate.io/core/server"
@@ -82,7 +86,7 @@ var oauthServerFlags = []cli.Flag{
 }
 
 // ConfirmateCommand starts the full framework: orchestrator, assessment, and evidence store services on one server.
-var ConfirmateCommand = &cli.Command{
+var ConfirmateCommand = withConfig(&cli.Command{
 	Name:  "confirmate",
 	Usage: "Launches the confirmate framework (including orchestrator, assessment, evidence store and evaluation services)",
 	Action: func(ctx context.Context, cmd *cli.Command) (err error) {
@@ -91,6 +95,8 @@ var ConfirmateCommand = &cli.Command{
 	Flags: joinFlagSlices(
 		logFlags,
 		apiFlags,
+		transportFlags,
+		tlsFlags,
 		authFlags,
 		serviceAuthFlags,
 		newDBFlags(true),
@@ -100,12 +106,14 @@ var ConfirmateCommand = &cli.Command{
 		orchestratorFlags,
 		evaluationFlags,
 	),
-}
+})
 
 // runConfirmate starts the embedded Confirmate framework stack.
 func runConfirmate(ctx context.Context, cmd *cli.Command) (err error) {
 	var (
 		interceptors        []connect.Interceptor
+		authInterceptor     *server.AuthInterceptor
+		observabilityOpt    server.Option
 		orchestratorOptions []service.Option[orchestrator.Service]
 		assessmentOptions   []service.Option[assessment.Service]
 		evidenceOptions     []service.Option[evidence.Service]
@@ -121,29 +129,52 @@ func runConfirmate(ctx context.Context, cmd *cli.Command) (err error) {
 		evaluationSvc       evaluationconnect.EvaluationHandler
 		orchestratorClient  *http.Client
 		evaluationClient    *http.Client
+		evidenceStoreClient *http.Client
 		apiPort             uint16
 		credentials         *clientcredentials.Config
 		authorizer          api.Authorizer
 		serverOpts          []server.Option
 		srv                 *server.Server
+		lifecycle           *service.LifecycleManager
 		serverErrCh         chan error
+		transport           service.TransportConfig
+		certs               *service.TLSCertificates
+		narratives          map[string]string
+		maxAges             map[string]time.Duration
+		key                 []byte
 	)
 
+	transport, err = transportConfig(cmd)
+	if err != nil {
+		return err
+	}
+
+	certs, err = tlsCertificates(cmd)
+	if err != nil {
+		return err
+	}
+
+	key, err = secretKey(cmd)
+	if err != nil {
+		return err
+	}
+
 	if cmd.Bool("auth-enabled") {
 		jwksURL = cmd.String("auth-jwks-url")
 		if jwksURL == server.DefaultJWKSURL {
-			jwksURL = fmt.Sprintf("http://localhost:%d/v1/auth/certs", cmd.Uint16("api-port"))
+			jwksURL = localJWKSURL(cmd.Uint16("api-port"), certs)
 		}
 
 		// Configure authentication interceptor for all services and authorization strategy for services based on JWT claims
-		interceptors = append(interceptors, server.NewAuthInterceptor(authInterceptorOptions(cmd, jwksURL)...))
+		authInterceptor = server.NewAuthInterceptor(append(append(authInterceptorOptions(cmd, jwksURL, certs),
+			server.WithPublicProcedures(evaluationPublicProcedures...)),
+			evidenceAuthOptions(cmd)...)...)
+		interceptors = append(interceptors, authInterceptor)
 		orchestratorOptions = append(orchestratorOptions, orchestrator.WithAuthorizationStrategyPermissionStore())
 		assessmentOptions = append(assessmentOptions, assessment.WithAuthorizationStrategyPermissionStore())
 		evaluationOptions = append(evaluationOptions, evaluation.WithAuthorizationStrategyPermissionStore())
 	}
 
-	interceptors = append(interceptors, &server.LoggingInterceptor{})
-
 	// Orchestrator service configuration
 	orchestratorOpts = append([]service.Option[orchestrator.Service]{
 		orchestrator.WithConfig(orchestrator.Config{
@@ -152,6 +183,17 @@ func runConfirmate(ctx context.Context, cmd *cli.Command) (err error) {
 			DefaultMetricsPath:              cmd.String("metrics-default-path"),
 			LoadDefaultMetrics:              cmd.Bool("metrics-load-default"),
 			CreateDefaultTargetOfEvaluation: cmd.Bool("create-default-target-of-evaluation"),
+			UserDirectory:                   userDirectory(cmd),
+			UserDirectorySyncInterval:       cmd.Duration("user-directory-sync-interval"),
+			SLAWebhookURLs:                  cmd.StringSlice("sla-webhook-url"),
+			SLACheckInterval:                cmd.Duration("sla-check-interval"),
+			CatalogSourceCheckInterval:      cmd.Duration("catalog-source-check-interval"),
+			AnomalyCheckInterval:            cmd.Duration("anomaly-check-interval"),
+			AnomalyBaselinePeriod:           cmd.Duration("anomaly-baseline-period"),
+			AnomalyVolumeDropThreshold:      cmd.Float64("anomaly-volume-drop-threshold"),
+			AnomalyFlappingThreshold:        cmd.Int("anomaly-flapping-threshold"),
+			SecretKey:                       key,
+			ImmutableRetention:              cmd.Duration("assessment-immutable-retention"),
 			PersistenceConfig: persistence.Config{
 				Host:       cmd.String("db-host"),
 				Port:       cmd.Int("db-port"),
@@ -159,6 +201,7 @@ func runConfirmate(ctx context.Context, cmd *cli.Command) (err error) {
 				User:       cmd.String("db-user-name"),
 				Password:   cmd.String("db-password"),
 				SSLMode:    cmd.String("db-ssl-mode"),
+				Backend:    cmd.String("db-backend"),
 				InMemoryDB: cmd.Bool("db-in-memory"),
 				MaxConn:    cmd.Int("db-max-connections"),
 			},
@@ -171,8 +214,9 @@ func runConfirmate(ctx context.Context, cmd *cli.Command) (err error) {
 	}
 	apiPort = cmd.Uint16("api-port")
 
-	orchestratorClient = service.NewHTTPClient()
-	evaluationClient = service.NewHTTPClient()
+	orchestratorClient = newHTTPClient(certs)
+	evaluationClient = newHTTPClient(certs)
+	evidenceStoreClient = newHTTPClient(certs)
 	if cmd.Bool("auth-enabled") {
 		credentials = &clientcredentials.Config{
 			ClientID:     cmd.String("service-oauth2-client-id"),
@@ -182,14 +226,38 @@ func runConfirmate(ctx context.Context, cmd *cli.Command) (err error) {
 		authorizer = api.NewOAuthAuthorizerFromClientCredentials(credentials)
 		orchestratorClient = api.NewOAuthHTTPClient(orchestratorClient, authorizer)
 		evaluationClient = api.NewOAuthHTTPClient(evaluationClient, authorizer)
+		evidenceStoreClient = api.NewOAuthHTTPClient(evidenceStoreClient, authorizer)
 	}
 
 	// Assessment service configuration
+	maxAges, err = evidenceMaxAge(cmd)
+	if err != nil {
+		return err
+	}
+
 	assessmentOpts = append([]service.Option[assessment.Service]{
 		assessment.WithConfig(assessment.Config{
-			OrchestratorAddress:    cmd.String("assessment-orchestrator-address"),
-			OrchestratorHTTPClient: orchestratorClient,
-			RegoPackage:            cmd.String("assessment-rego-package"),
+			OrchestratorAddress:         cmd.String("assessment-orchestrator-address"),
+			OrchestratorHTTPClient:      orchestratorClient,
+			EvidenceStoreAddress:        cmd.String("assessment-evidence-store-address"),
+			EvidenceStoreHTTPClient:     evidenceStoreClient,
+			RegoPackage:                 cmd.String("assessment-rego-package"),
+			PolicyServerURL:             cmd.String("assessment-policy-server-url"),
+			PolicyServerHTTPClient:      newHTTPClient(certs),
+			MetricBundlePath:            cmd.String("assessment-metric-bundle"),
+			SpoolDirectory:              cmd.String("assessment-spool-directory"),
+			SpoolSyncInterval:           cmd.Duration("assessment-spool-sync-interval"),
+			StreamWorkers:               cmd.Int("assessment-stream-workers"),
+			StreamQueueSize:             cmd.Int("assessment-stream-queue-size"),
+			ToEWorkers:                  cmd.Int("assessment-toe-workers"),
+			ToEQueueSize:                cmd.Int("assessment-toe-queue-size"),
+			Ownership:                   ownershipConfig(cmd),
+			EnvironmentLabels:           cmd.StringSlice("assessment-environment-labels"),
+			EvidenceMaxAge:              maxAges,
+			CorrelationWindow:           cmd.Duration("assessment-correlation-window"),
+			CorrelationEvictionInterval: cmd.Duration("assessment-correlation-eviction-interval"),
+			PreloadWorkers:              cmd.Int("assessment-preload-workers"),
+			Transport:                   transport,
 		}),
 	}, assessmentOptions...)
 
@@ -199,7 +267,7 @@ func runConfirmate(ctx context.Context, cmd *cli.Command) (err error) {
 	}
 
 	// EvidenceStore service configuration
-	assessmentClient := service.NewHTTPClient()
+	assessmentClient := newHTTPClient(certs)
 	assessmentClient.Timeout = cmd.Duration("evidence-assessment-http-timeout")
 	if authorizer != nil {
 		assessmentClient = api.NewOAuthHTTPClient(assessmentClient, authorizer)
@@ -215,10 +283,15 @@ func runConfirmate(ctx context.Context, cmd *cli.Command) (err error) {
 				User:       cmd.String("db-user-name"),
 				Password:   cmd.String("db-password"),
 				SSLMode:    cmd.String("db-ssl-mode"),
+				Backend:    cmd.String("db-backend"),
 				InMemoryDB: cmd.Bool("db-in-memory"),
 				MaxConn:    cmd.Int("db-max-connections"),
 			},
-			AssessmentHTTPClient: assessmentClient,
+			AssessmentHTTPClient:   assessmentClient,
+			Transport:              transport,
+			Pseudonymization:       pseudonymizationConfig(cmd),
+			RequireCollectorTokens: cmd.Bool("evidence-require-collector-tokens"),
+			ImmutableRetention:     cmd.Duration("evidence-immutable-retention"),
 		}),
 	}, evidenceOptions...)
 
@@ -228,10 +301,28 @@ func runConfirmate(ctx context.Context, cmd *cli.Command) (err error) {
 	}
 
 	// Evaluation service configuration
+	narratives, err = narrativeTemplates(cmd)
+	if err != nil {
+		return err
+	}
+
 	evaluationOpts = append([]service.Option[evaluation.Service]{
 		evaluation.WithConfig(evaluation.Config{
-			OrchestratorAddress: cmd.String("evaluation-orchestrator-address"),
-			OrchestratorClient:  orchestratorClient,
+			OrchestratorAddress:      cmd.String("evaluation-orchestrator-address"),
+			OrchestratorClient:       orchestratorClient,
+			Transport:                transport,
+			NarrativeTemplates:       narratives,
+			MaxConcurrentControls:    cmd.Int("evaluation-max-concurrent-controls"),
+			MaxConcurrentQueries:     cmd.Int("evaluation-max-concurrent-queries"),
+			SampleSize:               cmd.Int("evaluation-sample-size"),
+			SamplingStrategy:         samplingStrategies[cmd.String("evaluation-sampling-strategy")],
+			CalendarURL:              cmd.String("evaluation-calendar-url"),
+			CalendarSecret:           cmd.String("evaluation-calendar-secret"),
+			ControlCacheTTL:          cmd.Duration("evaluation-control-cache-ttl"),
+			ControlJitter:            cmd.Duration("evaluation-control-jitter"),
+			AuditScopeStagger:        cmd.Duration("evaluation-audit-scope-stagger"),
+			EvidenceStoreAddress:     cmd.String("evaluation-evidence-store-address"),
+			ConsistencyCheckInterval: cmd.Duration("evaluation-consistency-check-interval"),
 		}),
 	}, evaluationOptions...)
 
@@ -240,12 +331,16 @@ func runConfirmate(ctx context.Context, cmd *cli.Command) (err error) {
 		return err
 	}
 
+	interceptors = append(interceptors, &server.LoggingInterceptor{})
+	interceptors, observabilityOpt = observability(cmd, authInterceptor, interceptors, evaluationSvc.(server.MetricsCollector))
+
 	// Server options configuration including CORS, logging, handler and gRPC reflection
 	serverOpts = []server.Option{
 		server.WithConfig(server.Config{
-			Port:     apiPort,
-			Path:     "/",
-			LogLevel: cmd.String("log-level"),
+			Port:      apiPort,
+			Path:      "/",
+			LogLevel:  cmd.String("log-level"),
+			TLSConfig: serverTLSConfig(certs),
 			CORS: server.CORS{
 				AllowedOrigins: cmd.StringSlice("api-cors-allowed-origins"),
 				AllowedMethods: cmd.StringSlice("api-cors-allowed-methods"),
@@ -254,21 +349,29 @@ func runConfirmate(ctx context.Context, cmd *cli.Command) (err error) {
 		}),
 		server.WithHandler(orchestratorconnect.NewOrchestratorHandler(
 			orchestratorSvc,
-			connect.WithInterceptors(interceptors...),
+			handlerOptions(interceptors, transport)...,
 		)),
 		server.WithHandler(assessmentconnect.NewAssessmentHandler(
 			assessmentSvc,
-			connect.WithInterceptors(interceptors...),
+			handlerOptions(interceptors, transport)...,
 		)),
 		server.WithHandler(evidenceconnect.NewEvidenceStoreHandler(
 			evidenceSvc,
-			connect.WithInterceptors(interceptors...),
+			handlerOptions(interceptors, transport)...,
 		)),
 		server.WithHandler(evaluationconnect.NewEvaluationHandler(
 			evaluationSvc,
-			connect.WithInterceptors(interceptors...),
+			handlerOptions(interceptors, transport)...,
 		)),
+		server.WithHandler(evaluationv2connect.NewEvaluationHandler(
+			evaluation.NewServiceV2(evaluationSvc),
+			handlerOptions(interceptors, transport)...,
+		)),
+		server.WithOpenAPI(orchestratorapi.OpenAPIPath, orchestratorapi.OpenAPI),
+		server.WithOpenAPI(evaluationapi.OpenAPIPath, evaluationapi.OpenAPI),
+		server.WithOpenAPI(evaluationv2api.OpenAPIPath, evaluationv2api.OpenAPI),
 		server.WithReflection(),
+		observabilityOpt,
 	}
 
 	if cmd.Bool("oauth2-embedded") {
@@ -285,30 +388,34 @@ func runConfirmate(ctx context.Context, cmd *cli.Command) (err error) {
 		return err
 	}
 
+	// Start the services in the order of their dependencies, i.e., the orchestrator first, and shut them down in
+	// reverse order
+	lifecycle = service.NewLifecycleManager()
+	lifecycle.Register("orchestrator", orchestratorSvc.(service.Lifecycle))
+	lifecycle.Register("assessment", assessmentSvc.(service.Lifecycle))
+	lifecycle.Register("evidence-store", evidenceSvc.(service.Lifecycle))
+	lifecycle.Register("evaluation", evaluationSvc.(service.Lifecycle))
+
+	err = lifecycle.Start(ctx)
+	if err != nil {
+		return err
+	}
+
 	serverErrCh = make(chan error, 1)
 	go func() {
-		serverErrCh <- srv.ListenAndServe()
+		serverErrCh <- srv.Run(ctx)
 	}()
 
 	err = waitForLocalServer(ctx, apiPort)
 	if err != nil {
-		return err
+		return errors.Join(err, shutdown(lifecycle))
 	}
 
-	// Run until the server exits on its own or the context is cancelled (SIGTERM,
-	// test teardown, etc.) — in which case shut the HTTP server down gracefully.
-	select {
-	case err = <-serverErrCh:
-	case <-ctx.Done():
-		shutdownCtx, cancelShutdown := context.WithTimeout(context.Background(), 5*time.Second)
-		defer cancelShutdown()
-		_ = srv.Shutdown(shutdownCtx)
-		err = <-serverErrCh
-		if errors.Is(err, http.ErrServerClosed) {
-			err = nil
-		}
-	}
-	return err
+	// Run until the server exits on its own or the context is cancelled (SIGTERM, test teardown, etc.), in which case
+	// the server and afterwards the services are shut down gracefully.
+	err = <-serverErrCh
+
+	return errors.Join(err, shutdown(lifecycle))
 }
 
 func waitForLocalServer(ctx context.Context, port uint16) (err error) {
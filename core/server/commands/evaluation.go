@@ -18,8 +18,12 @@ package commands
 import (
 	"context"
 	"fmt"
+	"os"
 
+	evaluationapi "confirmate.io/core/api/evaluation"
 	"confirmate.io/core/api/evaluation/evaluationconnect"
+	evaluationv2api "confirmate.io/core/api/evaluation/v2"
+	"confirmate.io/core/api/evaluation/v2/evaluationv2connect"
 	"confirmate.io/core/server"
 	"confirmate.io/core/service"
 	"confirmate.io/core/service/evaluation"
@@ -29,6 +33,13 @@ import (
 	"golang.org/x/oauth2/clientcredentials"
 )
 
+// samplingStrategies maps the values of the evaluation-sampling-strategy flag to their strategy.
+var samplingStrategies = map[string]evaluationapi.SamplingStrategy{
+	"random":              evaluationapi.SamplingStrategy_SAMPLING_STRATEGY_RANDOM,
+	"stratified":          evaluationapi.SamplingStrategy_SAMPLING_STRATEGY_STRATIFIED,
+	"non-compliant-first": evaluationapi.SamplingStrategy_SAMPLING_STRATEGY_NON_COMPLIANT_FIRST,
+}
+
 // evaluationFlags contains the flags that are specific to configuring the evaluation service.
 var evaluationFlags = []cli.Flag{
 	&cli.StringFlag{
@@ -37,31 +48,161 @@ var evaluationFlags = []cli.Flag{
 		Value:   evaluation.DefaultOrchestratorURL,
 		Sources: envVarSources("evaluation-orchestrator-address"),
 	},
+	&cli.StringMapFlag{
+		Name:    "evaluation-narrative-templates",
+		Usage:   "Mapping of locales to files containing custom Go templates of the narratives of evaluation results (e.g. en=narrative.tmpl)",
+		Sources: envVarSources("evaluation-narrative-templates"),
+	},
+	&cli.IntFlag{
+		Name:    "evaluation-max-concurrent-controls",
+		Usage:   "Maximum number of controls of a catalog that are evaluated concurrently (0 means no limit)",
+		Value:   evaluation.DefaultConfig.MaxConcurrentControls,
+		Sources: envVarSources("evaluation-max-concurrent-controls"),
+	},
+	&cli.IntFlag{
+		Name:    "evaluation-max-concurrent-queries",
+		Usage:   "Maximum number of concurrent orchestrator queries of all evaluations, shared fairly by the audit scopes (0 means no limit)",
+		Value:   evaluation.DefaultConfig.MaxConcurrentQueries,
+		Sources: envVarSources("evaluation-max-concurrent-queries"),
+	},
+	&cli.IntFlag{
+		Name:    "evaluation-sample-size",
+		Usage:   "Maximum number of the latest assessment results a control is evaluated on, larger sets are sampled (0 means no sampling)",
+		Value:   evaluation.DefaultConfig.SampleSize,
+		Sources: envVarSources("evaluation-sample-size"),
+	},
+	&cli.StringFlag{
+		Name:    "evaluation-sampling-strategy",
+		Usage:   "Strategy the sample of assessment results is drawn with (random, stratified or non-compliant-first)",
+		Value:   "non-compliant-first",
+		Sources: envVarSources("evaluation-sampling-strategy"),
+	},
+	&cli.DurationFlag{
+		Name:    "evaluation-control-cache-ttl",
+		Usage:   "Duration after which the cached controls of a catalog are retrieved again, changed catalogs are refreshed earlier (0 means no expiry)",
+		Value:   evaluation.DefaultControlCacheTTL,
+		Sources: envVarSources("evaluation-control-cache-ttl"),
+	},
+	&cli.DurationFlag{
+		Name:    "evaluation-control-jitter",
+		Usage:   "Maximum random delay of the evaluation of each control within a scheduled run (0 disables the jitter)",
+		Sources: envVarSources("evaluation-control-jitter"),
+	},
+	&cli.DurationFlag{
+		Name:    "evaluation-audit-scope-stagger",
+		Usage:   "Window over which the first runs of the scheduled audit scopes are spread (0 starts them immediately)",
+		Sources: envVarSources("evaluation-audit-scope-stagger"),
+	},
+	&cli.StringFlag{
+		Name:    "evaluation-evidence-store-address",
+		Usage:   "Address of the evidence store against which the consistency checker verifies the evidences of assessment results (not checked if empty)",
+		Sources: envVarSources("evaluation-evidence-store-address"),
+	},
+	&cli.DurationFlag{
+		Name:    "evaluation-consistency-check-interval",
+		Usage:   "Interval in which the entities referenced across the services are checked for orphans (0 disables the periodic checks)",
+		Sources: envVarSources("evaluation-consistency-check-interval"),
+	},
+	&cli.StringFlag{
+		Name:    "evaluation-calendar-url",
+		Usage:   "External base URL of the API that is used in the subscription URLs of calendar feeds",
+		Value:   evaluation.DefaultCalendarURL,
+		Sources: envVarSources("evaluation-calendar-url"),
+	},
+	&cli.StringFlag{
+		Name:    "evaluation-calendar-secret",
+		Usage:   "Secret the tokens of the subscription URLs of calendar feeds are derived from (calendar feeds are disabled if empty)",
+		Sources: envVarSources("evaluation-calendar-secret"),
+	},
+}
+
+// evaluationPublicProcedures contains the procedures of the evaluation service that do not require authentication.
+// Calendar feeds are protected by the token of their subscription URL instead, since calendar clients cannot
+// authenticate.
+var evaluationPublicProcedures = []string{
+	evaluationconnect.EvaluationGetCalendarFeedProcedure,
+	evaluationv2connect.EvaluationGetCalendarFeedProcedure,
+}
+
+// narrativeTemplates reads the custom narrative templates of the evaluation-narrative-templates flag, keyed by their
+// locale.
+func narrativeTemplates(cmd *cli.Command) (templates map[string]string, err error) {
+	var (
+		b []byte
+	)
+
+	templates = make(map[string]string)
+	for locale, path := range cmd.StringMap("evaluation-narrative-templates") {
+		b, err = os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("could not read narrative template of locale %q: %w", locale, err)
+		}
+
+		templates[locale] = string(b)
+	}
+
+	return
 }
 
 // EvaluationCommand is the command to start the evaluation server.
-var EvaluationCommand = &cli.Command{
+var EvaluationCommand = withConfig(&cli.Command{
 	Name:  "evaluation",
 	Usage: "Launches the evaluation service",
 	Action: func(ctx context.Context, cmd *cli.Command) error {
 		var (
-			interceptors []connect.Interceptor
-			svcOptions   []service.Option[evaluation.Service]
-			cfg          evaluation.Config
+			interceptors     []connect.Interceptor
+			authInterceptor  *server.AuthInterceptor
+			observabilityOpt server.Option
+			svcOptions       []service.Option[evaluation.Service]
+			cfg              evaluation.Config
+			transport        service.TransportConfig
+			certs            *service.TLSCertificates
+			narratives       map[string]string
+			err              error
 		)
 
+		transport, err = transportConfig(cmd)
+		if err != nil {
+			return err
+		}
+
+		certs, err = tlsCertificates(cmd)
+		if err != nil {
+			return err
+		}
+
+		narratives, err = narrativeTemplates(cmd)
+		if err != nil {
+			return err
+		}
+
 		cfg = evaluation.Config{
-			OrchestratorAddress: cmd.String("evaluation-orchestrator-address"),
-			OrchestratorClient:  service.NewHTTPClient(),
+			OrchestratorAddress:      cmd.String("evaluation-orchestrator-address"),
+			OrchestratorClient:       newHTTPClient(certs),
+			Transport:                transport,
+			NarrativeTemplates:       narratives,
+			MaxConcurrentControls:    cmd.Int("evaluation-max-concurrent-controls"),
+			MaxConcurrentQueries:     cmd.Int("evaluation-max-concurrent-queries"),
+			SampleSize:               cmd.Int("evaluation-sample-size"),
+			SamplingStrategy:         samplingStrategies[cmd.String("evaluation-sampling-strategy")],
+			CalendarURL:              cmd.String("evaluation-calendar-url"),
+			CalendarSecret:           cmd.String("evaluation-calendar-secret"),
+			ControlCacheTTL:          cmd.Duration("evaluation-control-cache-ttl"),
+			ControlJitter:            cmd.Duration("evaluation-control-jitter"),
+			AuditScopeStagger:        cmd.Duration("evaluation-audit-scope-stagger"),
+			EvidenceStoreAddress:     cmd.String("evaluation-evidence-store-address"),
+			ConsistencyCheckInterval: cmd.Duration("evaluation-consistency-check-interval"),
 		}
 
 		if cmd.Bool("auth-enabled") {
 			jwksURL := cmd.String("auth-jwks-url")
 			if jwksURL == server.DefaultJWKSURL {
-				jwksURL = fmt.Sprintf("http://localhost:%d/v1/auth/certs", cmd.Uint16("api-port"))
+				jwksURL = localJWKSURL(cmd.Uint16("api-port"), certs)
 			}
 
-			interceptors = append(interceptors, server.NewAuthInterceptor(authInterceptorOptions(cmd, jwksURL)...))
+			authInterceptor = server.NewAuthInterceptor(append(authInterceptorOptions(cmd, jwksURL, certs),
+				server.WithPublicProcedures(evaluationPublicProcedures...))...)
+			interceptors = append(interceptors, authInterceptor)
 			svcOptions = append(svcOptions, evaluation.WithAuthorizationStrategyPermissionStore())
 
 			cfg.ServiceOAuth2Config = &clientcredentials.Config{
@@ -71,7 +212,6 @@ var EvaluationCommand = &cli.Command{
 			}
 		}
 
-		interceptors = append(interceptors, &server.LoggingInterceptor{})
 		svcOptions = append(svcOptions, evaluation.WithConfig(cfg))
 
 		svc, err := evaluation.NewService(svcOptions...)
@@ -79,11 +219,18 @@ var EvaluationCommand = &cli.Command{
 			return err
 		}
 
-		return server.RunConnectServer(
+		interceptors = append(interceptors, &server.LoggingInterceptor{})
+		interceptors, observabilityOpt = observability(cmd, authInterceptor, interceptors, svc.(server.MetricsCollector))
+
+		lifecycle := service.NewLifecycleManager()
+		lifecycle.Register("evaluation", svc.(service.Lifecycle))
+
+		return serve(ctx, lifecycle,
 			server.WithConfig(server.Config{
-				Port:     cmd.Uint16("api-port"),
-				Path:     "/",
-				LogLevel: cmd.String("log-level"),
+				Port:      cmd.Uint16("api-port"),
+				Path:      "/",
+				LogLevel:  cmd.String("log-level"),
+				TLSConfig: serverTLSConfig(certs),
 				CORS: server.CORS{
 					AllowedOrigins: cmd.StringSlice("api-cors-allowed-origins"),
 					AllowedMethods: cmd.StringSlice("api-cors-allowed-methods"),
@@ -92,16 +239,25 @@ var EvaluationCommand = &cli.Command{
 			}),
 			server.WithHandler(evaluationconnect.NewEvaluationHandler(
 				svc,
-				connect.WithInterceptors(interceptors...),
+				handlerOptions(interceptors, transport)...,
 			)),
+			server.WithHandler(evaluationv2connect.NewEvaluationHandler(
+				evaluation.NewServiceV2(svc),
+				handlerOptions(interceptors, transport)...,
+			)),
+			server.WithOpenAPI(evaluationapi.OpenAPIPath, evaluationapi.OpenAPI),
+			server.WithOpenAPI(evaluationv2api.OpenAPIPath, evaluationv2api.OpenAPI),
 			server.WithReflection(),
+			observabilityOpt,
 		)
 	},
 	Flags: joinFlagSlices(
 		logFlags,
 		apiFlags,
+		transportFlags,
+		tlsFlags,
 		authFlags,
 		serviceAuthFlags,
 		evaluationFlags,
 	),
-}
+})
@@ -16,8 +16,8 @@ package commands
 
 import (
 	"context"
-	"fmt"
 	"log/slog"
+	"strings"
 	"time"
 
 	"confirmate.io/core/api/evidence/evidenceconnect"
@@ -45,19 +45,124 @@ var evidenceFlags = []cli.Flag{
 		Value:   30 * time.Second,
 		Sources: envVarSources("evidence-assessment-http-timeout"),
 	},
+	&cli.BoolFlag{
+		Name:    "evidence-pseudonymization-enabled",
+		Usage:   "Replace personal data in stored evidences by pseudonyms",
+		Sources: envVarSources("evidence-pseudonymization-enabled"),
+	},
+	&cli.StringMapFlag{
+		Name:    "evidence-pseudonymization-fields",
+		Usage:   "Mapping of ontology resource types to comma-separated fields containing personal data (e.g. Identity=name,description)",
+		Value:   pseudonymizationFields(evidence.DefaultPseudonymizationConfig.Fields),
+		Sources: envVarSources("evidence-pseudonymization-fields"),
+	},
+	&cli.BoolFlag{
+		Name:    "evidence-pseudonymization-detect-emails",
+		Usage:   "Additionally replace e-mail addresses in all fields of stored evidences by pseudonyms",
+		Value:   evidence.DefaultPseudonymizationConfig.DetectEmails,
+		Sources: envVarSources("evidence-pseudonymization-detect-emails"),
+	},
+	&cli.StringFlag{
+		Name:    "evidence-pseudonymization-key",
+		Usage:   "Secret key the pseudonyms are derived from (a random key is used if empty)",
+		Sources: envVarSources("evidence-pseudonymization-key"),
+	},
+	&cli.BoolFlag{
+		Name:    "evidence-require-collector-tokens",
+		Usage:   "Require evidences to be stored with the token of a collector instead of an OAuth token",
+		Sources: envVarSources("evidence-require-collector-tokens"),
+	},
+	&cli.DurationFlag{
+		Name:    "evidence-immutable-retention",
+		Usage:   "Period in which stored evidences cannot be updated or deleted (append-only mode is disabled if 0)",
+		Sources: envVarSources("evidence-immutable-retention"),
+	},
+}
+
+// evidenceCollectorProcedures are the procedures of the evidence store that collectors can authenticate with a
+// collector token (see [evidence.CollectorTokenPrefix]) instead of an OAuth token.
+var evidenceCollectorProcedures = []string{
+	evidenceconnect.EvidenceStoreStoreEvidenceProcedure,
+	evidenceconnect.EvidenceStoreStoreEvidencesProcedure,
+}
+
+// evidencePublicProcedures returns the procedures of the evidence store that do not require an OAuth token. If
+// collector tokens are required, the evidence store authenticates the collectors storing evidences itself.
+func evidencePublicProcedures(cmd *cli.Command) (procedures []string) {
+	if cmd.Bool("evidence-require-collector-tokens") {
+		procedures = append(procedures, evidenceCollectorProcedures...)
+	}
+
+	return
+}
+
+// evidenceAuthOptions returns the options of the auth interceptor for the evidence store. Collector tokens are passed
+// to the evidence store, which authenticates them itself, also if they are optional. If collector tokens are required,
+// storing evidences does not require an OAuth token at all.
+func evidenceAuthOptions(cmd *cli.Command) []server.AuthOption {
+	return []server.AuthOption{
+		server.WithPublicProcedures(evidencePublicProcedures(cmd)...),
+		server.WithDelegatedTokens(evidence.CollectorTokenPrefix, evidenceCollectorProcedures...),
+	}
+}
+
+// pseudonymizationFields converts the personal data fields of the pseudonymization into the format of the
+// evidence-pseudonymization-fields flag.
+func pseudonymizationFields(fields map[string][]string) (m map[string]string) {
+	m = make(map[string]string, len(fields))
+	for typ, f := range fields {
+		m[typ] = strings.Join(f, ",")
+	}
+
+	return
+}
+
+// pseudonymizationConfig returns the configuration of the pseudonymization of the evidence store.
+func pseudonymizationConfig(cmd *cli.Command) (cfg evidence.PseudonymizationConfig) {
+	cfg = evidence.PseudonymizationConfig{
+		Enabled:      cmd.Bool("evidence-pseudonymization-enabled"),
+		Fields:       make(map[string][]string),
+		DetectEmails: cmd.Bool("evidence-pseudonymization-detect-emails"),
+		Key:          []byte(cmd.String("evidence-pseudonymization-key")),
+	}
+
+	for typ, fields := range cmd.StringMap("evidence-pseudonymization-fields") {
+		for field := range strings.SplitSeq(fields, ",") {
+			if field = strings.TrimSpace(field); field != "" {
+				cfg.Fields[typ] = append(cfg.Fields[typ], field)
+			}
+		}
+	}
+
+	return
 }
 
 // EvidenceCommand is the command to start the evidence store server.
-var EvidenceCommand = &cli.Command{
+var EvidenceCommand = withConfig(&cli.Command{
 	Name:  "evidence",
 	Usage: "Launches the evidence store service",
 	Action: func(ctx context.Context, cmd *cli.Command) error {
 		var (
-			interceptors []connect.Interceptor
-			svcOptions   []service.Option[evidence.Service]
-			cfg          evidence.Config
+			interceptors     []connect.Interceptor
+			authInterceptor  *server.AuthInterceptor
+			observabilityOpt server.Option
+			svcOptions       []service.Option[evidence.Service]
+			cfg              evidence.Config
+			transport        service.TransportConfig
+			certs            *service.TLSCertificates
+			err              error
 		)
 
+		transport, err = transportConfig(cmd)
+		if err != nil {
+			return err
+		}
+
+		certs, err = tlsCertificates(cmd)
+		if err != nil {
+			return err
+		}
+
 		slog.Info("Starting Evidence Store",
 			slog.Uint64("api_port", uint64(cmd.Uint16("api-port"))),
 			slog.String("log_level", cmd.String("log-level")),
@@ -75,22 +180,28 @@ var EvidenceCommand = &cli.Command{
 			slog.String("assessment_address", cmd.String("evidence-assessment-address")),
 			slog.Duration("assessment_timeout", cmd.Duration("evidence-assessment-http-timeout")))
 
-		assessmentClient := service.NewHTTPClient()
+		assessmentClient := newHTTPClient(certs)
 		assessmentClient.Timeout = cmd.Duration("evidence-assessment-http-timeout")
 
 		cfg = evidence.Config{
-			AssessmentAddress:    cmd.String("evidence-assessment-address"),
-			AssessmentHTTPClient: assessmentClient,
-			EvidenceQueueSize:    evidence.DefaultConfig.EvidenceQueueSize,
+			AssessmentAddress:      cmd.String("evidence-assessment-address"),
+			AssessmentHTTPClient:   assessmentClient,
+			EvidenceQueueSize:      evidence.DefaultConfig.EvidenceQueueSize,
+			Transport:              transport,
+			Pseudonymization:       pseudonymizationConfig(cmd),
+			RequireCollectorTokens: cmd.Bool("evidence-require-collector-tokens"),
+			ImmutableRetention:     cmd.Duration("evidence-immutable-retention"),
 		}
 
 		// Add auth config
 		if cmd.Bool("auth-enabled") {
 			jwksURL := cmd.String("auth-jwks-url")
 			if jwksURL == server.DefaultJWKSURL {
-				jwksURL = fmt.Sprintf("http://localhost:%d/v1/auth/certs", cmd.Uint16("api-port"))
+				jwksURL = localJWKSURL(cmd.Uint16("api-port"), certs)
 			}
-			interceptors = append(interceptors, server.NewAuthInterceptor(authInterceptorOptions(cmd, jwksURL)...))
+			authInterceptor = server.NewAuthInterceptor(append(authInterceptorOptions(cmd, jwksURL, certs),
+				evidenceAuthOptions(cmd)...)...)
+			interceptors = append(interceptors, authInterceptor)
 
 			svcOptions = append(svcOptions, evidence.WithAuthorizationStrategyPermissionStore())
 
@@ -109,11 +220,13 @@ var EvidenceCommand = &cli.Command{
 			User:       cmd.String("db-user-name"),
 			Password:   cmd.String("db-password"),
 			SSLMode:    cmd.String("db-ssl-mode"),
+			Backend:    cmd.String("db-backend"),
 			InMemoryDB: cmd.Bool("db-in-memory"),
 			MaxConn:    cmd.Int("db-max-connections"),
 		}
 
 		interceptors = append(interceptors, &server.LoggingInterceptor{})
+		interceptors, observabilityOpt = observability(cmd, authInterceptor, interceptors)
 		svcOptions = append(svcOptions, evidence.WithConfig(cfg))
 
 		svc, err := evidence.NewService(svcOptions...)
@@ -121,11 +234,15 @@ var EvidenceCommand = &cli.Command{
 			return err
 		}
 
-		return server.RunConnectServer(
+		lifecycle := service.NewLifecycleManager()
+		lifecycle.Register("evidence-store", svc)
+
+		return serve(ctx, lifecycle,
 			server.WithConfig(server.Config{
-				Port:     cmd.Uint16("api-port"),
-				Path:     "/",
-				LogLevel: cmd.String("log-level"),
+				Port:      cmd.Uint16("api-port"),
+				Path:      "/",
+				LogLevel:  cmd.String("log-level"),
+				TLSConfig: serverTLSConfig(certs),
 				CORS: server.CORS{
 					AllowedOrigins: cmd.StringSlice("api-cors-allowed-origins"),
 					AllowedMethods: cmd.StringSlice("api-cors-allowed-methods"),
@@ -134,17 +251,20 @@ var EvidenceCommand = &cli.Command{
 			}),
 			server.WithHandler(evidenceconnect.NewEvidenceStoreHandler(
 				svc,
-				connect.WithInterceptors(interceptors...),
+				handlerOptions(interceptors, transport)...,
 			)),
 			server.WithReflection(),
+			observabilityOpt,
 		)
 	},
 	Flags: joinFlagSlices(
 		logFlags,
 		apiFlags,
+		transportFlags,
+		tlsFlags,
 		authFlags,
 		serviceAuthFlags,
 		dbFlags,
 		evidenceFlags,
 	),
-}
+})
@@ -17,8 +17,10 @@ package commands
 
 import (
 	"context"
+	"encoding/hex"
 	"fmt"
 
+	orchestratorapi "confirmate.io/core/api/orchestrator"
 	"confirmate.io/core/api/orchestrator/orchestratorconnect"
 	"confirmate.io/core/persistence"
 	"confirmate.io/core/server"
@@ -61,33 +63,152 @@ var orchestratorFlags = []cli.Flag{
 		Value:   orchestrator.DefaultConfig.CreateDefaultTargetOfEvaluation,
 		Sources: envVarSources("create-default-target-of-evaluation"),
 	},
+	&cli.StringFlag{
+		Name:    "user-directory-scim-url",
+		Usage:   "The base URL of a SCIM 2.0 API to synchronize users from. If empty, no user directory is used",
+		Sources: envVarSources("user-directory-scim-url"),
+	},
+	&cli.StringFlag{
+		Name:    "user-directory-scim-token",
+		Usage:   "The bearer token used to authenticate against the SCIM API",
+		Sources: envVarSources("user-directory-scim-token"),
+	},
+	&cli.StringFlag{
+		Name:    "user-directory-issuer",
+		Usage:   "The OIDC issuer of the identity provider backing the user directory",
+		Sources: envVarSources("user-directory-issuer"),
+	},
+	&cli.DurationFlag{
+		Name:    "user-directory-sync-interval",
+		Usage:   "The interval in which users are synchronized from the user directory. If zero, users are only synchronized on request",
+		Value:   orchestrator.DefaultConfig.UserDirectorySyncInterval,
+		Sources: envVarSources("user-directory-sync-interval"),
+	},
+	&cli.StringSliceFlag{
+		Name:    "sla-webhook-url",
+		Usage:   "A URL that is notified with a POST request whenever a control exceeds an SLA threshold of its catalog",
+		Sources: envVarSources("sla-webhook-url"),
+	},
+	&cli.DurationFlag{
+		Name:    "sla-check-interval",
+		Usage:   "The interval in which SLA breaches are checked, if any SLA webhook URL is configured",
+		Value:   orchestrator.DefaultConfig.SLACheckInterval,
+		Sources: envVarSources("sla-check-interval"),
+	},
+	&cli.DurationFlag{
+		Name:    "catalog-source-check-interval",
+		Usage:   "The interval in which catalog sources are checked for a due sync (0 disables scheduled syncs)",
+		Value:   orchestrator.DefaultConfig.CatalogSourceCheckInterval,
+		Sources: envVarSources("catalog-source-check-interval"),
+	},
+	&cli.DurationFlag{
+		Name:    "anomaly-check-interval",
+		Usage:   "The interval in which incoming assessment results are checked for anomalies (0 disables the anomaly detection)",
+		Value:   orchestrator.DefaultConfig.AnomalyCheckInterval,
+		Sources: envVarSources("anomaly-check-interval"),
+	},
+	&cli.DurationFlag{
+		Name:    "anomaly-baseline-period",
+		Usage:   "The period the result volume of a target of evaluation is compared against to detect a volume drop",
+		Value:   orchestrator.DefaultConfig.AnomalyBaselinePeriod,
+		Sources: envVarSources("anomaly-baseline-period"),
+	},
+	&cli.Float64Flag{
+		Name:    "anomaly-volume-drop-threshold",
+		Usage:   "The relative drop of the result volume compared to the baseline at which an alert is raised, e.g., 0.5 for 50%",
+		Value:   orchestrator.DefaultConfig.AnomalyVolumeDropThreshold,
+		Sources: envVarSources("anomaly-volume-drop-threshold"),
+	},
+	&cli.IntFlag{
+		Name:    "anomaly-flapping-threshold",
+		Usage:   "The number of compliance changes of a metric for a resource within the check interval at which an alert is raised",
+		Value:   orchestrator.DefaultConfig.AnomalyFlappingThreshold,
+		Sources: envVarSources("anomaly-flapping-threshold"),
+	},
+	&cli.StringFlag{
+		Name:    "secret-key",
+		Usage:   "The hex-encoded 32-byte key used to encrypt the secrets of targets of evaluation. If empty, secrets cannot be created or accessed",
+		Sources: envVarSources("secret-key"),
+	},
+	&cli.DurationFlag{
+		Name:    "assessment-immutable-retention",
+		Usage:   "Period in which stored assessment results cannot be updated or deleted (append-only mode is disabled if 0)",
+		Sources: envVarSources("assessment-immutable-retention"),
+	},
+}
+
+// userDirectory returns the [orchestrator.UserDirectory] configured by the user directory flags, or nil if no user
+// directory is configured.
+func userDirectory(cmd *cli.Command) orchestrator.UserDirectory {
+	if cmd.String("user-directory-scim-url") == "" {
+		return nil
+	}
+
+	return &orchestrator.SCIMUserDirectory{
+		Endpoint: cmd.String("user-directory-scim-url"),
+		Token:    cmd.String("user-directory-scim-token"),
+		Issuer:   cmd.String("user-directory-issuer"),
+	}
+}
+
+// secretKey decodes the key of the secret-key flag, which is used to encrypt the secrets of targets of evaluation.
+func secretKey(cmd *cli.Command) (key []byte, err error) {
+	key, err = hex.DecodeString(cmd.String("secret-key"))
+	if err != nil {
+		return nil, fmt.Errorf("could not decode secret key: %w", err)
+	}
+
+	return
 }
 
 // OrchestratorCommand is the command to start the orchestrator server.
-var OrchestratorCommand = &cli.Command{
+var OrchestratorCommand = withConfig(&cli.Command{
 	Name:  "orchestrator",
 	Usage: "Launches the orchestrator service",
 	Action: func(ctx context.Context, cmd *cli.Command) (err error) {
 		var (
-			interceptors []connect.Interceptor
-			svcOptions   []service.Option[orchestrator.Service]
-			jwksURL      string
-			opts         []service.Option[orchestrator.Service]
-			svc          orchestratorconnect.OrchestratorHandler
-			serverOpts   []server.Option
+			interceptors     []connect.Interceptor
+			authInterceptor  *server.AuthInterceptor
+			observabilityOpt server.Option
+			svcOptions       []service.Option[orchestrator.Service]
+			jwksURL          string
+			opts             []service.Option[orchestrator.Service]
+			svc              orchestratorconnect.OrchestratorHandler
+			serverOpts       []server.Option
+			lifecycle        *service.LifecycleManager
+			transport        service.TransportConfig
+			certs            *service.TLSCertificates
+			key              []byte
 		)
 
+		transport, err = transportConfig(cmd)
+		if err != nil {
+			return err
+		}
+
+		certs, err = tlsCertificates(cmd)
+		if err != nil {
+			return err
+		}
+
+		key, err = secretKey(cmd)
+		if err != nil {
+			return err
+		}
+
 		if cmd.Bool("auth-enabled") {
 			jwksURL = cmd.String("auth-jwks-url")
 			if jwksURL == server.DefaultJWKSURL {
-				jwksURL = fmt.Sprintf("http://localhost:%d/v1/auth/certs", cmd.Uint16("api-port"))
+				jwksURL = localJWKSURL(cmd.Uint16("api-port"), certs)
 			}
 
-			interceptors = append(interceptors, server.NewAuthInterceptor(authInterceptorOptions(cmd, jwksURL)...))
+			authInterceptor = server.NewAuthInterceptor(authInterceptorOptions(cmd, jwksURL, certs)...)
+			interceptors = append(interceptors, authInterceptor)
 			svcOptions = append(svcOptions, orchestrator.WithAuthorizationStrategyPermissionStore())
 		}
 
 		interceptors = append(interceptors, &server.LoggingInterceptor{})
+		interceptors, observabilityOpt = observability(cmd, authInterceptor, interceptors)
 
 		opts = append([]service.Option[orchestrator.Service]{
 			orchestrator.WithConfig(orchestrator.Config{
@@ -96,6 +217,17 @@ var OrchestratorCommand = &cli.Command{
 				DefaultMetricsPath:              cmd.String("metrics-default-path"),
 				LoadDefaultMetrics:              cmd.Bool("metrics-load-default"),
 				CreateDefaultTargetOfEvaluation: cmd.Bool("create-default-target-of-evaluation"),
+				UserDirectory:                   userDirectory(cmd),
+				UserDirectorySyncInterval:       cmd.Duration("user-directory-sync-interval"),
+				SLAWebhookURLs:                  cmd.StringSlice("sla-webhook-url"),
+				SLACheckInterval:                cmd.Duration("sla-check-interval"),
+				CatalogSourceCheckInterval:      cmd.Duration("catalog-source-check-interval"),
+				AnomalyCheckInterval:            cmd.Duration("anomaly-check-interval"),
+				AnomalyBaselinePeriod:           cmd.Duration("anomaly-baseline-period"),
+				AnomalyVolumeDropThreshold:      cmd.Float64("anomaly-volume-drop-threshold"),
+				AnomalyFlappingThreshold:        cmd.Int("anomaly-flapping-threshold"),
+				SecretKey:                       key,
+				ImmutableRetention:              cmd.Duration("assessment-immutable-retention"),
 				PersistenceConfig: persistence.Config{
 					Host:       cmd.String("db-host"),
 					Port:       cmd.Int("db-port"),
@@ -103,6 +235,7 @@ var OrchestratorCommand = &cli.Command{
 					User:       cmd.String("db-user-name"),
 					Password:   cmd.String("db-password"),
 					SSLMode:    cmd.String("db-ssl-mode"),
+					Backend:    cmd.String("db-backend"),
 					InMemoryDB: cmd.Bool("db-in-memory"),
 					MaxConn:    cmd.Int("db-max-connections"),
 				},
@@ -116,9 +249,10 @@ var OrchestratorCommand = &cli.Command{
 
 		serverOpts = []server.Option{
 			server.WithConfig(server.Config{
-				Port:     cmd.Uint16("api-port"),
-				Path:     "/",
-				LogLevel: cmd.String("log-level"),
+				Port:      cmd.Uint16("api-port"),
+				Path:      "/",
+				LogLevel:  cmd.String("log-level"),
+				TLSConfig: serverTLSConfig(certs),
 				CORS: server.CORS{
 					AllowedOrigins: cmd.StringSlice("api-cors-allowed-origins"),
 					AllowedMethods: cmd.StringSlice("api-cors-allowed-methods"),
@@ -127,19 +261,26 @@ var OrchestratorCommand = &cli.Command{
 			}),
 			server.WithHandler(orchestratorconnect.NewOrchestratorHandler(
 				svc,
-				connect.WithInterceptors(interceptors...),
+				handlerOptions(interceptors, transport)...,
 			)),
+			server.WithOpenAPI(orchestratorapi.OpenAPIPath, orchestratorapi.OpenAPI),
 			server.WithReflection(),
+			observabilityOpt,
 		}
 
-		err = server.RunConnectServer(serverOpts...)
+		lifecycle = service.NewLifecycleManager()
+		lifecycle.Register("orchestrator", svc.(service.Lifecycle))
+
+		err = serve(ctx, lifecycle, serverOpts...)
 		return err
 	},
 	Flags: joinFlagSlices(
 		logFlags,
 		apiFlags,
+		transportFlags,
+		tlsFlags,
 		authFlags,
 		dbFlags,
 		orchestratorFlags,
 	),
-}
+})
@@ -15,6 +15,14 @@
 
 package server
 
+import (
+	"crypto/tls"
+	"time"
+)
+
+// DefaultShutdownTimeout is the default time the [Server] waits for the active requests on shutdown.
+const DefaultShutdownTimeout = 5 * time.Second
+
 // DefaultConfig is the default configuration for the [Server].
 var DefaultConfig = Config{
 	Port:     8080,
@@ -36,6 +44,12 @@ type Config struct {
 	// UseGRPCReflection enables gRPC reflection, which allows clients to query the server for its
 	// supported services and methods.
 	UseGRPCReflection bool
+	// TLSConfig enables TLS, if set. Otherwise, the server serves HTTP/2 without TLS (h2c). Use
+	// [service.TLSCertificates.ServerConfig] for mutual TLS with hot-reloaded certificates.
+	TLSConfig *tls.Config
+	// ShutdownTimeout is the time [Server.Run] waits for the active requests on shutdown. If not set,
+	// [DefaultShutdownTimeout] is used.
+	ShutdownTimeout time.Duration
 }
 
 // CORS represents the CORS configuration for the server.
@@ -16,11 +16,15 @@
 package server
 
 import (
+	"context"
+	"crypto/tls"
+	"errors"
 	"fmt"
 	"log/slog"
 	"net/http"
 	"slices"
 	"strings"
+	"time"
 
 	"connectrpc.com/grpcreflect"
 	"connectrpc.com/vanguard"
@@ -34,6 +38,7 @@ type Server struct {
 	cfg          Config
 	handlers     map[string]http.Handler
 	httpHandlers map[string]http.Handler
+	openAPISpecs map[string][]byte
 }
 
 // Option is a functional option for configuring the [Server].
@@ -54,6 +59,22 @@ func WithHandler(path string, handler http.Handler) Option {
 	}
 }
 
+// WithOpenAPI serves the OpenAPI specification spec at the specified path, so that clients that only speak REST/JSON
+// can discover the REST routes of the registered handlers. Multiple specifications can be served by calling
+// WithOpenAPI multiple times.
+func WithOpenAPI(path string, spec []byte) Option {
+	return func(srv *Server) {
+		srv.openAPISpecs[path] = spec
+	}
+}
+
+// WithTLSConfig enables TLS for the server with the given [tls.Config].
+func WithTLSConfig(tlsConfig *tls.Config) Option {
+	return func(srv *Server) {
+		srv.cfg.TLSConfig = tlsConfig
+	}
+}
+
 // WithReflection adds gRPC reflection support to the server, which allows clients to query the
 // server for its supported services and methods.
 func WithReflection() Option {
@@ -79,9 +100,9 @@ func registerReflectionHandlers(srv *Server) {
 	srv.httpHandlers[reflectionV1APath] = reflectionV1A
 }
 
-// RunConnectServer runs a Connect server with the given options.
-// It uses [http.Protocols] to serve HTTP/2 without TLS (h2c).
-func RunConnectServer(opts ...Option) (err error) {
+// RunConnectServer runs a Connect server with the given options until ctx is done (see [Server.Run]).
+// It uses [http.Protocols] to serve HTTP/2 without TLS (h2c), unless TLS is configured.
+func RunConnectServer(ctx context.Context, opts ...Option) (err error) {
 	var (
 		srv *Server
 	)
@@ -91,13 +112,13 @@ func RunConnectServer(opts ...Option) (err error) {
 		return
 	}
 
-	err = srv.ListenAndServe()
+	err = srv.Run(ctx)
 
 	return err
 }
 
 // NewConnectServer creates a new Connect server with the given options.
-// It uses [http.Protocols] to serve HTTP/2 without TLS (h2c).
+// It uses [http.Protocols] to serve HTTP/2 without TLS (h2c), unless TLS is configured.
 func NewConnectServer(opts []Option) (srv *Server, err error) {
 	var (
 		vs         []*vanguard.Service
@@ -111,6 +132,7 @@ func NewConnectServer(opts []Option) (srv *Server, err error) {
 		cfg:          DefaultConfig,
 		handlers:     make(map[string]http.Handler),
 		httpHandlers: make(map[string]http.Handler),
+		openAPISpecs: make(map[string][]byte),
 	}
 
 	// Apply options
@@ -142,28 +164,89 @@ func NewConnectServer(opts []Option) (srv *Server, err error) {
 	for path, handler := range srv.httpHandlers {
 		mux.Handle(path, handler)
 	}
+	for path, spec := range srv.openAPISpecs {
+		mux.Handle("GET "+path, srv.handleCORS(openAPIHandler(spec)))
+	}
 	mux.Handle("/", srv.handleCORS(transcoder))
 
-	// Configure h2c support using standard library
+	// Configure HTTP/2 support using standard library, either with TLS or without (h2c)
 	p = new(http.Protocols)
 	p.SetHTTP1(true)
-	p.SetUnencryptedHTTP2(true)
+	if srv.cfg.TLSConfig != nil {
+		p.SetHTTP2(true)
+	} else {
+		p.SetUnencryptedHTTP2(true)
+	}
 
-	// Set address, handler, and protocols
+	// Set address, handler, protocols and TLS configuration
 	srv.Server = &http.Server{
 		Addr:      fmt.Sprintf("0.0.0.0:%d", srv.cfg.Port),
 		Handler:   mux,
 		Protocols: p,
+		TLSConfig: srv.cfg.TLSConfig,
 	}
 
 	slog.Info("Starting Connect server",
 		slog.String("address", srv.Addr),
 		slog.String("path", srv.cfg.Path),
+		slog.Bool("tls", srv.cfg.TLSConfig != nil),
 	)
 
 	return srv, nil
 }
 
+// ListenAndServe listens on the configured address and serves requests, using TLS if it is configured. The
+// certificates are taken from the [tls.Config] of the configuration.
+func (srv *Server) ListenAndServe() error {
+	if srv.TLSConfig != nil {
+		return srv.Server.ListenAndServeTLS("", "")
+	}
+
+	return srv.Server.ListenAndServe()
+}
+
+// Run serves requests until ctx is done or the server fails. Once ctx is done, the server is shut down gracefully,
+// waiting at most [Config.ShutdownTimeout] for the active requests.
+func (srv *Server) Run(ctx context.Context) (err error) {
+	var serverErrCh = make(chan error, 1)
+
+	go func() {
+		serverErrCh <- srv.ListenAndServe()
+	}()
+
+	select {
+	case err = <-serverErrCh:
+	case <-ctx.Done():
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), srv.shutdownTimeout())
+		defer cancel()
+
+		_ = srv.Shutdown(shutdownCtx)
+		err = <-serverErrCh
+	}
+	if errors.Is(err, http.ErrServerClosed) {
+		err = nil
+	}
+
+	return err
+}
+
+// shutdownTimeout returns the [Config.ShutdownTimeout] or [DefaultShutdownTimeout], if it is not set.
+func (srv *Server) shutdownTimeout() time.Duration {
+	if srv.cfg.ShutdownTimeout <= 0 {
+		return DefaultShutdownTimeout
+	}
+
+	return srv.cfg.ShutdownTimeout
+}
+
+// openAPIHandler returns an [http.Handler] that serves the OpenAPI specification spec.
+func openAPIHandler(spec []byte) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/yaml")
+		_, _ = w.Write(spec)
+	})
+}
+
 // Names implements the [grpcreflect.Namer] interface, returning the names of the services supported
 // by the server.
 func (srv *Server) Names() []string {
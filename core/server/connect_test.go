@@ -15,11 +15,16 @@
 package server
 
 import (
+	"context"
+	"net"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"confirmate.io/core/api/assessment/assessmentconnect"
+	"confirmate.io/core/api/evaluation"
+	"confirmate.io/core/api/evaluation/evaluationconnect"
 	"confirmate.io/core/api/evidence/evidenceconnect"
 	"confirmate.io/core/api/orchestrator/orchestratorconnect"
 	"confirmate.io/core/util/assert"
@@ -111,3 +116,93 @@ func TestNewConnectServer_WithReflection(t *testing.T) {
 		})
 	}
 }
+
+func TestNewConnectServer_REST(t *testing.T) {
+	tests := []struct {
+		name            string
+		method          string
+		requestPath     string
+		wantHTTPCode    int
+		wantContentType string
+		wantBody        []byte
+	}{
+		{
+			name:            "OpenAPI specification is served",
+			method:          http.MethodGet,
+			requestPath:     evaluation.OpenAPIPath,
+			wantHTTPCode:    http.StatusOK,
+			wantContentType: "application/yaml",
+			wantBody:        evaluation.OpenAPI,
+		},
+		{
+			name:            "REST route is transcoded to the RPC",
+			method:          http.MethodGet,
+			requestPath:     "/v1/evaluation/evaluate",
+			wantHTTPCode:    http.StatusNotImplemented,
+			wantContentType: "application/json",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv, err := NewConnectServer([]Option{
+				WithHandler(evaluationconnect.NewEvaluationHandler(evaluationconnect.UnimplementedEvaluationHandler{})),
+				WithOpenAPI(evaluation.OpenAPIPath, evaluation.OpenAPI),
+			})
+			assert.NoError(t, err)
+			if err != nil {
+				return
+			}
+
+			rec := httptest.NewRecorder()
+			req := httptest.NewRequest(tt.method, tt.requestPath, nil)
+			srv.Handler.ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.wantHTTPCode, rec.Code)
+			assert.Equal(t, tt.wantContentType, rec.Header().Get("Content-Type"))
+			if tt.wantBody != nil {
+				assert.Equal(t, tt.wantBody, rec.Body.Bytes())
+			}
+		})
+	}
+}
+
+func TestServer_Run(t *testing.T) {
+	// Occupy a port, so that the server cannot listen on it
+	l, err := net.Listen("tcp", "0.0.0.0:0")
+	assert.NoError(t, err)
+	defer l.Close()
+
+	tests := []struct {
+		name    string
+		port    uint16
+		wantErr assert.WantErr
+	}{
+		{
+			name:    "happy path: shut down on cancel",
+			port:    0,
+			wantErr: assert.NoError,
+		},
+		{
+			name: "port in use",
+			port: uint16(l.Addr().(*net.TCPAddr).Port),
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.ErrorContains(t, err, "address already in use")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv, err := NewConnectServer([]Option{
+				WithConfig(Config{Port: tt.port, LogLevel: "INFO"}),
+			})
+			assert.NoError(t, err)
+
+			ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+			defer cancel()
+
+			tt.wantErr(t, srv.Run(ctx))
+		})
+	}
+}
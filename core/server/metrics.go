@@ -0,0 +1,229 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"connectrpc.com/connect"
+)
+
+// DefaultMetricsPath is the path at which the RPC metrics are exposed in the Prometheus text format.
+const DefaultMetricsPath = "/metrics"
+
+// DefaultDurationBuckets are the upper bounds (in seconds) of the buckets of the RPC duration histograms. They match
+// the default buckets of the Prometheus client libraries.
+var DefaultDurationBuckets = []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10}
+
+// codeOK is the code label of successful RPCs, since [connect.Code] has no representation for success.
+const codeOK = "ok"
+
+// rpcKey identifies the counter of RPCs of a procedure that finished with a particular code.
+type rpcKey struct {
+	procedure string
+	code      string
+}
+
+// histogram counts observed durations in buckets. The bucket counts are not cumulative; they are accumulated when
+// the metrics are written.
+type histogram struct {
+	buckets []uint64
+	sum     float64
+	count   uint64
+}
+
+// MetricsCollector writes additional metrics of a service in the Prometheus text format, e.g., gauges that are
+// determined by periodic jobs of the service. Collectors are registered with [MetricsInterceptor.RegisterCollector].
+type MetricsCollector interface {
+	WriteMetrics(w io.Writer)
+}
+
+// MetricsInterceptor is a [connect.Interceptor] that records the number of handled RPCs per procedure and status code
+// as well as their durations. The metrics are exposed in the Prometheus text format by its [MetricsInterceptor.ServeHTTP]
+// method, which is registered by [WithMetrics]. Streaming RPCs are recorded once the stream is finished.
+type MetricsInterceptor struct {
+	bounds []float64
+
+	mu         sync.Mutex
+	requests   map[rpcKey]uint64
+	durations  map[string]*histogram
+	collectors []MetricsCollector
+}
+
+// NewMetricsInterceptor creates a new [MetricsInterceptor] using [DefaultDurationBuckets].
+func NewMetricsInterceptor() *MetricsInterceptor {
+	return &MetricsInterceptor{
+		bounds:    DefaultDurationBuckets,
+		requests:  make(map[rpcKey]uint64),
+		durations: make(map[string]*histogram),
+	}
+}
+
+// WithMetrics exposes the metrics recorded by the given [MetricsInterceptor] at [DefaultMetricsPath]. The interceptor
+// itself still needs to be added to the interceptors of the service handlers.
+func WithMetrics(mi *MetricsInterceptor) Option {
+	return func(srv *Server) {
+		srv.httpHandlers["GET "+DefaultMetricsPath] = mi
+	}
+}
+
+// RegisterCollector registers a [MetricsCollector], whose metrics are exposed after the RPC metrics.
+func (mi *MetricsInterceptor) RegisterCollector(c MetricsCollector) {
+	mi.mu.Lock()
+	defer mi.mu.Unlock()
+
+	mi.collectors = append(mi.collectors, c)
+}
+
+// WrapUnary implements the [connect.Interceptor] interface for unary calls.
+func (mi *MetricsInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (res connect.AnyResponse, err error) {
+		var start = time.Now()
+
+		res, err = next(ctx, req)
+		mi.observe(req.Spec().Procedure, err, time.Since(start))
+
+		return res, err
+	}
+}
+
+// WrapStreamingClient implements the [connect.Interceptor] interface for streaming client calls.
+func (mi *MetricsInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next // Only handled RPCs are recorded
+}
+
+// WrapStreamingHandler implements the [connect.Interceptor] interface for streaming handler calls.
+func (mi *MetricsInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) (err error) {
+		var start = time.Now()
+
+		err = next(ctx, conn)
+		mi.observe(conn.Spec().Procedure, err, time.Since(start))
+
+		return err
+	}
+}
+
+// observe records a finished RPC of the given procedure.
+func (mi *MetricsInterceptor) observe(procedure string, err error, duration time.Duration) {
+	var (
+		code    = codeOK
+		seconds = duration.Seconds()
+		h       *histogram
+		ok      bool
+	)
+
+	if err != nil {
+		code = connect.CodeOf(err).String()
+	}
+
+	mi.mu.Lock()
+	defer mi.mu.Unlock()
+
+	mi.requests[rpcKey{procedure, code}]++
+
+	h, ok = mi.durations[procedure]
+	if !ok {
+		h = &histogram{buckets: make([]uint64, len(mi.bounds))}
+		mi.durations[procedure] = h
+	}
+
+	for i, bound := range mi.bounds {
+		if seconds <= bound {
+			h.buckets[i]++
+			break
+		}
+	}
+	h.sum += seconds
+	h.count++
+}
+
+// ServeHTTP writes the recorded metrics in the Prometheus text format.
+func (mi *MetricsInterceptor) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	mi.writeMetrics(w)
+}
+
+// writeMetrics writes the recorded metrics in the Prometheus text format to w, sorted by procedure and code, followed
+// by the metrics of the registered collectors.
+func (mi *MetricsInterceptor) writeMetrics(w io.Writer) {
+	var (
+		keys       []rpcKey
+		procedures []string
+	)
+
+	mi.mu.Lock()
+	defer mi.mu.Unlock()
+
+	for key := range mi.requests {
+		keys = append(keys, key)
+	}
+	slices.SortFunc(keys, func(a, b rpcKey) int {
+		return strings.Compare(a.procedure+" "+a.code, b.procedure+" "+b.code)
+	})
+
+	fmt.Fprintln(w, "# HELP confirmate_rpc_requests_total Total number of RPCs handled by the server, by procedure and status code.")
+	fmt.Fprintln(w, "# TYPE confirmate_rpc_requests_total counter")
+	for _, key := range keys {
+		fmt.Fprintf(w, "confirmate_rpc_requests_total{procedure=%s,code=%s} %d\n",
+			labelValue(key.procedure), labelValue(key.code), mi.requests[key])
+	}
+
+	for procedure := range mi.durations {
+		procedures = append(procedures, procedure)
+	}
+	slices.Sort(procedures)
+
+	fmt.Fprintln(w, "# HELP confirmate_rpc_request_duration_seconds Duration of the RPCs handled by the server, by procedure.")
+	fmt.Fprintln(w, "# TYPE confirmate_rpc_request_duration_seconds histogram")
+	for _, procedure := range procedures {
+		var (
+			h          = mi.durations[procedure]
+			label      = labelValue(procedure)
+			cumulative uint64
+		)
+
+		for i, bound := range mi.bounds {
+			cumulative += h.buckets[i]
+			fmt.Fprintf(w, "confirmate_rpc_request_duration_seconds_bucket{procedure=%s,le=\"%s\"} %d\n",
+				label, strconv.FormatFloat(bound, 'g', -1, 64), cumulative)
+		}
+		fmt.Fprintf(w, "confirmate_rpc_request_duration_seconds_bucket{procedure=%s,le=\"+Inf\"} %d\n", label, h.count)
+		fmt.Fprintf(w, "confirmate_rpc_request_duration_seconds_sum{procedure=%s} %s\n", label,
+			strconv.FormatFloat(h.sum, 'g', -1, 64))
+		fmt.Fprintf(w, "confirmate_rpc_request_duration_seconds_count{procedure=%s} %d\n", label, h.count)
+	}
+
+	for _, c := range mi.collectors {
+		c.WriteMetrics(w)
+	}
+}
+
+// labelReplacer escapes the characters that must be escaped in label values of the Prometheus text format.
+var labelReplacer = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+// labelValue returns the quoted and escaped label value v.
+func labelValue(v string) string {
+	return `"` + labelReplacer.Replace(v) + `"`
+}
@@ -0,0 +1,135 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"confirmate.io/core/api/evaluation/evaluationconnect"
+	"confirmate.io/core/util/assert"
+
+	"connectrpc.com/connect"
+)
+
+func TestMetricsInterceptor(t *testing.T) {
+	var (
+		mi  = NewMetricsInterceptor()
+		rec = httptest.NewRecorder()
+	)
+
+	srv, err := NewConnectServer([]Option{
+		WithHandler(evaluationconnect.NewEvaluationHandler(evaluationconnect.UnimplementedEvaluationHandler{},
+			connect.WithInterceptors(mi))),
+		WithMetrics(mi),
+	})
+	assert.NoError(t, err)
+	if err != nil {
+		return
+	}
+
+	// Call an RPC via its REST route, which is not implemented
+	srv.Handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/v1/evaluation/evaluate", nil))
+
+	srv.Handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, DefaultMetricsPath, nil))
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "text/plain; version=0.0.4; charset=utf-8", rec.Header().Get("Content-Type"))
+	assert.Contains(t, rec.Body.String(), `confirmate_rpc_requests_total{procedure="/confirmate.evaluation.v1.Evaluation/ListEvaluationJobs",code="unimplemented"} 1`)
+	assert.Contains(t, rec.Body.String(), `confirmate_rpc_request_duration_seconds_count{procedure="/confirmate.evaluation.v1.Evaluation/ListEvaluationJobs"} 1`)
+}
+
+func TestMetricsInterceptor_writeMetrics(t *testing.T) {
+	var (
+		mi = NewMetricsInterceptor()
+		sb strings.Builder
+	)
+
+	mi.bounds = []float64{0.1, 1}
+	mi.observe("/svc/B", nil, 50*time.Millisecond)
+	mi.observe("/svc/B", nil, 500*time.Millisecond)
+	mi.observe("/svc/B", connect.NewError(connect.CodeNotFound, errors.New("not found")), 2*time.Second)
+	mi.observe("/svc/A", errors.New("unknown"), 10*time.Millisecond)
+
+	mi.writeMetrics(&sb)
+
+	assert.Equal(t, `# HELP confirmate_rpc_requests_total Total number of RPCs handled by the server, by procedure and status code.
+# TYPE confirmate_rpc_requests_total counter
+confirmate_rpc_requests_total{procedure="/svc/A",code="unknown"} 1
+confirmate_rpc_requests_total{procedure="/svc/B",code="not_found"} 1
+confirmate_rpc_requests_total{procedure="/svc/B",code="ok"} 2
+# HELP confirmate_rpc_request_duration_seconds Duration of the RPCs handled by the server, by procedure.
+# TYPE confirmate_rpc_request_duration_seconds histogram
+confirmate_rpc_request_duration_seconds_bucket{procedure="/svc/A",le="0.1"} 1
+confirmate_rpc_request_duration_seconds_bucket{procedure="/svc/A",le="1"} 1
+confirmate_rpc_request_duration_seconds_bucket{procedure="/svc/A",le="+Inf"} 1
+confirmate_rpc_request_duration_seconds_sum{procedure="/svc/A"} 0.01
+confirmate_rpc_request_duration_seconds_count{procedure="/svc/A"} 1
+confirmate_rpc_request_duration_seconds_bucket{procedure="/svc/B",le="0.1"} 1
+confirmate_rpc_request_duration_seconds_bucket{procedure="/svc/B",le="1"} 2
+confirmate_rpc_request_duration_seconds_bucket{procedure="/svc/B",le="+Inf"} 3
+confirmate_rpc_request_duration_seconds_sum{procedure="/svc/B"} 2.55
+confirmate_rpc_request_duration_seconds_count{procedure="/svc/B"} 3
+`, sb.String())
+}
+
+// testCollector is a [MetricsCollector] that writes a fixed metric.
+type testCollector struct{}
+
+// WriteMetrics writes a fixed gauge.
+func (testCollector) WriteMetrics(w io.Writer) {
+	fmt.Fprintln(w, "confirmate_test_gauge 42")
+}
+
+func TestMetricsInterceptor_RegisterCollector(t *testing.T) {
+	var (
+		mi = NewMetricsInterceptor()
+		sb strings.Builder
+	)
+
+	mi.RegisterCollector(testCollector{})
+	mi.observe("/svc/A", nil, 10*time.Millisecond)
+
+	mi.writeMetrics(&sb)
+	assert.True(t, strings.HasSuffix(sb.String(), "confirmate_test_gauge 42\n"))
+}
+
+func TestMetricsInterceptor_WrapStreamingHandler(t *testing.T) {
+	var (
+		mi   = NewMetricsInterceptor()
+		conn = &testStreamingConn{spec: connect.Spec{Procedure: "/svc/Stream"}}
+		sb   strings.Builder
+	)
+
+	err := mi.WrapStreamingHandler(func(context.Context, connect.StreamingHandlerConn) error {
+		return connect.NewError(connect.CodeAborted, errors.New("aborted"))
+	})(context.Background(), conn)
+	assert.Error(t, err)
+
+	mi.writeMetrics(&sb)
+	assert.Contains(t, sb.String(), `confirmate_rpc_requests_total{procedure="/svc/Stream",code="aborted"} 1`)
+}
+
+func Test_labelValue(t *testing.T) {
+	assert.Equal(t, `"a\\b\"c\nd"`, labelValue("a\\b\"c\nd"))
+}
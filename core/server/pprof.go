@@ -0,0 +1,49 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package server
+
+import (
+	"log/slog"
+	"net/http"
+	"net/http/pprof"
+)
+
+// DefaultPprofPath is the path prefix at which the pprof profiling endpoints are served.
+const DefaultPprofPath = "/debug/pprof/"
+
+// WithPprof serves the profiling endpoints of [net/http/pprof] at [DefaultPprofPath]. Since profiles expose internals
+// of the process and can be expensive to collect, the endpoints are restricted to admins by the given
+// [AuthInterceptor] (see [AuthInterceptor.RequireAdmin]). If ai is nil, i.e., authentication is disabled, the endpoints
+// are served without authentication.
+func WithPprof(ai *AuthInterceptor) Option {
+	return func(srv *Server) {
+		var guard = func(h http.Handler) http.Handler {
+			return h
+		}
+
+		if ai != nil {
+			guard = ai.RequireAdmin
+		} else {
+			slog.Warn("Serving pprof endpoints without authentication", slog.String("path", DefaultPprofPath))
+		}
+
+		srv.httpHandlers[DefaultPprofPath] = guard(http.HandlerFunc(pprof.Index))
+		srv.httpHandlers[DefaultPprofPath+"cmdline"] = guard(http.HandlerFunc(pprof.Cmdline))
+		srv.httpHandlers[DefaultPprofPath+"profile"] = guard(http.HandlerFunc(pprof.Profile))
+		srv.httpHandlers[DefaultPprofPath+"symbol"] = guard(http.HandlerFunc(pprof.Symbol))
+		srv.httpHandlers[DefaultPprofPath+"trace"] = guard(http.HandlerFunc(pprof.Trace))
+	}
+}
@@ -0,0 +1,88 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"confirmate.io/core/util/assert"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+func TestWithPprof(t *testing.T) {
+	var (
+		privateKey, publicKey = mustECDSAKeyPair(t)
+		adminToken            = mustSignES256Token(t, privateKey, "kid-1", jwt.MapClaims{"sub": "admin", "roles": []string{"ROLE_ADMIN"}})
+		userToken             = mustSignES256Token(t, privateKey, "kid-1", jwt.MapClaims{"sub": "user"})
+	)
+
+	type args struct {
+		interceptor *AuthInterceptor
+		header      string
+	}
+	tests := []struct {
+		name         string
+		args         args
+		wantHTTPCode int
+	}{
+		{
+			name:         "missing token",
+			args:         args{interceptor: NewAuthInterceptor(WithPublicKey(publicKey))},
+			wantHTTPCode: http.StatusUnauthorized,
+		},
+		{
+			name:         "invalid token",
+			args:         args{interceptor: NewAuthInterceptor(WithPublicKey(publicKey)), header: "Bearer invalid"},
+			wantHTTPCode: http.StatusUnauthorized,
+		},
+		{
+			name:         "non-admin token",
+			args:         args{interceptor: NewAuthInterceptor(WithPublicKey(publicKey)), header: "Bearer " + userToken},
+			wantHTTPCode: http.StatusForbidden,
+		},
+		{
+			name:         "admin token",
+			args:         args{interceptor: NewAuthInterceptor(WithPublicKey(publicKey)), header: "Bearer " + adminToken},
+			wantHTTPCode: http.StatusOK,
+		},
+		{
+			name:         "authentication disabled",
+			wantHTTPCode: http.StatusOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv, err := NewConnectServer([]Option{WithPprof(tt.args.interceptor)})
+			assert.NoError(t, err)
+			if err != nil {
+				return
+			}
+
+			rec := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodGet, DefaultPprofPath+"cmdline", nil)
+			if tt.args.header != "" {
+				req.Header.Set("Authorization", tt.args.header)
+			}
+			srv.Handler.ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.wantHTTPCode, rec.Code)
+		})
+	}
+}
@@ -0,0 +1,134 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package assessment
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+
+	"confirmate.io/core/api/assessment"
+
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// metricBundle is a metrics source that is backed by a local [assessment.MetricBundle] instead of the orchestrator.
+type metricBundle struct {
+	metrics []*assessment.Metric
+
+	// implementations contains the metric implementations, with the key being the metric ID
+	implementations map[string]*assessment.MetricImplementation
+
+	// configurations contains the explicit metric configurations, with the key being the target of evaluation ID and
+	// the metric ID
+	configurations map[string]*assessment.MetricConfiguration
+
+	// defaults contains the default metric configurations, with the key being the metric ID
+	defaults map[string]*assessment.MetricConfiguration
+
+	// targets contains the IDs of the targets of evaluation that the bundle contains explicit configurations for
+	targets []string
+}
+
+// loadMetricBundle loads the metric bundle in the given file, which contains a [assessment.MetricBundle] in its JSON
+// representation.
+func loadMetricBundle(path string) (b *metricBundle, err error) {
+	var (
+		data   []byte
+		bundle assessment.MetricBundle
+	)
+
+	data, err = os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("could not read metric bundle: %w", err)
+	}
+
+	err = protojson.Unmarshal(data, &bundle)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse metric bundle: %w", err)
+	}
+
+	b = &metricBundle{
+		metrics:         bundle.Metrics,
+		implementations: make(map[string]*assessment.MetricImplementation),
+		configurations:  make(map[string]*assessment.MetricConfiguration),
+		defaults:        make(map[string]*assessment.MetricConfiguration),
+	}
+
+	for _, impl := range bundle.Implementations {
+		b.implementations[impl.MetricId] = impl
+	}
+
+	for _, config := range bundle.Configurations {
+		if config.MetricId == "" {
+			return nil, errors.New("metric configuration in bundle without metric ID")
+		}
+
+		if config.IsDefault {
+			b.defaults[config.MetricId] = config
+		}
+		if config.TargetOfEvaluationId != "" {
+			b.configurations[fmt.Sprintf("%s-%s", config.TargetOfEvaluationId, config.MetricId)] = config
+
+			if !slices.Contains(b.targets, config.TargetOfEvaluationId) {
+				b.targets = append(b.targets, config.TargetOfEvaluationId)
+			}
+		}
+	}
+
+	return b, nil
+}
+
+// Metrics returns the metrics of the bundle.
+func (b *metricBundle) Metrics(_ context.Context) ([]*assessment.Metric, error) {
+	return b.metrics, nil
+}
+
+// MetricImplementation returns the implementation of the metric in the bundle.
+func (b *metricBundle) MetricImplementation(_ context.Context, lang assessment.MetricImplementation_Language, metric *assessment.Metric) (impl *assessment.MetricImplementation, err error) {
+	var ok bool
+
+	if lang != assessment.MetricImplementation_LANGUAGE_REGO {
+		return nil, errors.New("unsupported language")
+	}
+
+	impl, ok = b.implementations[metric.Id]
+	if !ok {
+		return nil, fmt.Errorf("no implementation for metric %s in bundle", metric.Id)
+	}
+
+	return impl, nil
+}
+
+// MetricConfiguration returns the configuration of the metric for the target of evaluation in the bundle. If there is no
+// explicit configuration for the target of evaluation, the default configuration of the metric is used.
+func (b *metricBundle) MetricConfiguration(_ context.Context, targetID string, metric *assessment.Metric) (config *assessment.MetricConfiguration, err error) {
+	var ok bool
+
+	config, ok = b.configurations[fmt.Sprintf("%s-%s", targetID, metric.Id)]
+	if ok {
+		return config, nil
+	}
+
+	config, ok = b.defaults[metric.Id]
+	if !ok {
+		return nil, fmt.Errorf("no configuration for metric %s in bundle", metric.Id)
+	}
+
+	return config, nil
+}
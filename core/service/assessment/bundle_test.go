@@ -0,0 +1,143 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package assessment
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"confirmate.io/core/api/assessment"
+	"confirmate.io/core/util/assert"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+const (
+	mockBundleMetricId = "00000000-0000-0000-0000-000000000001"
+	mockBundleToeId    = "11111111-1111-1111-1111-111111111111"
+)
+
+// writeMockBundle writes a metric bundle with one metric and returns the path of the bundle file.
+func writeMockBundle(t *testing.T) string {
+	var path = filepath.Join(t.TempDir(), "bundle.json")
+
+	data, err := protojson.Marshal(&assessment.MetricBundle{
+		Metrics: []*assessment.Metric{{Id: mockBundleMetricId, Name: "TransportEncryptionEnabled", Category: "TransportEncryption"}},
+		Implementations: []*assessment.MetricImplementation{{
+			MetricId: mockBundleMetricId,
+			Lang:     assessment.MetricImplementation_LANGUAGE_REGO,
+			Code:     "package confirmate.metrics.transport_encryption_enabled",
+		}},
+		Configurations: []*assessment.MetricConfiguration{
+			{MetricId: mockBundleMetricId, Operator: "==", TargetValue: structpb.NewBoolValue(true), IsDefault: true},
+			{MetricId: mockBundleMetricId, TargetOfEvaluationId: mockBundleToeId, Operator: "==", TargetValue: structpb.NewBoolValue(false)},
+		},
+	})
+	assert.NoError(t, err)
+	assert.NoError(t, os.WriteFile(path, data, 0o600))
+
+	return path
+}
+
+func Test_loadMetricBundle(t *testing.T) {
+	tests := []struct {
+		name    string
+		path    string
+		want    assert.Want[*metricBundle]
+		wantErr assert.WantErr
+	}{
+		{
+			name: "file does not exist",
+			path: filepath.Join(t.TempDir(), "missing.json"),
+			want: assert.Nil[*metricBundle],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.ErrorContains(t, err, "could not read metric bundle")
+			},
+		},
+		{
+			name: "happy path",
+			path: writeMockBundle(t),
+			want: func(t *testing.T, got *metricBundle, msgAndArgs ...any) bool {
+				return assert.Equal(t, 1, len(got.metrics)) &&
+					assert.Equal(t, 1, len(got.implementations)) &&
+					assert.Equal(t, 1, len(got.configurations)) &&
+					assert.Equal(t, 1, len(got.defaults)) &&
+					assert.Equal(t, []string{mockBundleToeId}, got.targets)
+			},
+			wantErr: assert.NoError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := loadMetricBundle(tt.path)
+			tt.want(t, got)
+			tt.wantErr(t, err)
+		})
+	}
+}
+
+func Test_metricBundle_MetricConfiguration(t *testing.T) {
+	bundle, err := loadMetricBundle(writeMockBundle(t))
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		targetID string
+		metric   *assessment.Metric
+		want     assert.Want[*assessment.MetricConfiguration]
+		wantErr  assert.WantErr
+	}{
+		{
+			name:     "explicit configuration of the target of evaluation",
+			targetID: mockBundleToeId,
+			metric:   &assessment.Metric{Id: mockBundleMetricId},
+			want: func(t *testing.T, got *assessment.MetricConfiguration, msgAndArgs ...any) bool {
+				return assert.False(t, got.IsDefault) && assert.False(t, got.TargetValue.GetBoolValue())
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name:     "default configuration",
+			targetID: "22222222-2222-2222-2222-222222222222",
+			metric:   &assessment.Metric{Id: mockBundleMetricId},
+			want: func(t *testing.T, got *assessment.MetricConfiguration, msgAndArgs ...any) bool {
+				return assert.True(t, got.IsDefault) && assert.True(t, got.TargetValue.GetBoolValue())
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name:     "unknown metric",
+			targetID: mockBundleToeId,
+			metric:   &assessment.Metric{Id: "unknown"},
+			want:     assert.Nil[*assessment.MetricConfiguration],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.ErrorContains(t, err, "no configuration for metric unknown")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := bundle.MetricConfiguration(context.Background(), tt.targetID, tt.metric)
+			tt.want(t, got)
+			tt.wantErr(t, err)
+		})
+	}
+}
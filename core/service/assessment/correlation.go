@@ -0,0 +1,242 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package assessment
+
+import (
+	"cmp"
+	"slices"
+	"sync"
+	"time"
+
+	"confirmate.io/core/api/assessment"
+	"confirmate.io/core/api/evidence"
+	"confirmate.io/core/api/ontology"
+)
+
+const (
+	// DefaultCorrelationWindow is the default time within which the evidences of a resource need to be collected, so
+	// that they are correlated.
+	DefaultCorrelationWindow = 15 * time.Minute
+
+	// DefaultCorrelationEvictionInterval is the default interval in which evidences that fell out of the correlation
+	// window are evicted.
+	DefaultCorrelationEvictionInterval = time.Minute
+)
+
+// IncompleteCorrelation is a metric of a resource that is not assessed yet, since it correlates evidences of tools
+// that were not observed within the correlation window (see [assessment.EvidenceCorrelation]).
+type IncompleteCorrelation struct {
+	// ResourceId is the ID of the resource.
+	ResourceId string
+	// MetricId is the ID of the metric.
+	MetricId string
+	// MissingToolIds are the IDs of the tools whose evidences are missing.
+	MissingToolIds []string
+	// Since is the collection time of the first evidence that waits for the correlation.
+	Since time.Time
+}
+
+// correlationBuffer contains the latest evidence of each tool for each resource, so that the evidences of different
+// tools collected within the window can be correlated. Evidences that fall out of the window are evicted, together
+// with the incomplete correlations waiting for them.
+type correlationBuffer struct {
+	window time.Duration
+
+	// evidences contains the latest evidences keyed by resource ID and tool ID
+	evidences map[string]map[string]*evidence.Evidence
+	// incomplete contains the incomplete correlations keyed by resource ID and metric ID
+	incomplete map[string]map[string]*IncompleteCorrelation
+	mu         sync.Mutex
+}
+
+// newCorrelationBuffer creates a new [correlationBuffer] with the given window.
+func newCorrelationBuffer(window time.Duration) *correlationBuffer {
+	return &correlationBuffer{
+		window:     window,
+		evidences:  make(map[string]map[string]*evidence.Evidence),
+		incomplete: make(map[string]map[string]*IncompleteCorrelation),
+	}
+}
+
+// add adds the evidence of the resource to the buffer and returns the resources of the evidences of all other tools
+// for the same resource that were collected within the window around the evidence, keyed by the tool ID. An evidence
+// does not replace a more recent evidence of the same tool, e.g., if it is re-assessed. A nil buffer does not correlate
+// any evidences.
+func (b *correlationBuffer) add(ev *evidence.Evidence, resourceId string) (correlated map[string]ontology.IsResource) {
+	var (
+		collected = ev.GetTimestamp().AsTime()
+		latest    *evidence.Evidence
+		ok        bool
+	)
+
+	if b == nil {
+		return nil
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.evidences[resourceId] == nil {
+		b.evidences[resourceId] = make(map[string]*evidence.Evidence)
+	}
+
+	latest, ok = b.evidences[resourceId][ev.GetToolId()]
+	if !ok || !latest.GetTimestamp().AsTime().After(collected) {
+		b.evidences[resourceId][ev.GetToolId()] = ev
+	}
+
+	correlated = make(map[string]ontology.IsResource)
+	for toolId, other := range b.evidences[resourceId] {
+		if toolId == ev.GetToolId() || other.GetOntologyResource() == nil {
+			continue
+		}
+
+		if d := collected.Sub(other.GetTimestamp().AsTime()).Abs(); d <= b.window {
+			correlated[toolId] = other.GetOntologyResource()
+		}
+	}
+
+	return correlated
+}
+
+// complete checks, whether the evidences of all tools of the correlation of the metric are available for the
+// resource, i.e., the evidence of toolId and the correlated evidences returned by [correlationBuffer.add]. If not, the
+// correlation is recorded as incomplete until it is completed or its evidences are evicted. Metrics without a
+// correlation are always complete, as are all metrics if the buffer is nil.
+func (b *correlationBuffer) complete(
+	resourceId string,
+	metricId string,
+	correlation *assessment.EvidenceCorrelation,
+	toolId string,
+	correlated map[string]ontology.IsResource,
+	collected time.Time,
+) bool {
+	var missing []string
+
+	if b == nil || correlation == nil {
+		return true
+	}
+
+	for _, id := range correlation.GetToolIds() {
+		if _, ok := correlated[id]; id != toolId && !ok {
+			missing = append(missing, id)
+		}
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if len(missing) == 0 {
+		delete(b.incomplete[resourceId], metricId)
+		if len(b.incomplete[resourceId]) == 0 {
+			delete(b.incomplete, resourceId)
+		}
+
+		return true
+	}
+
+	if b.incomplete[resourceId] == nil {
+		b.incomplete[resourceId] = make(map[string]*IncompleteCorrelation)
+	}
+
+	if c, ok := b.incomplete[resourceId][metricId]; ok {
+		c.MissingToolIds = missing
+		if collected.Before(c.Since) {
+			c.Since = collected
+		}
+	} else {
+		b.incomplete[resourceId][metricId] = &IncompleteCorrelation{
+			ResourceId:     resourceId,
+			MetricId:       metricId,
+			MissingToolIds: missing,
+			Since:          collected,
+		}
+	}
+
+	return false
+}
+
+// pending returns whether any metric of the resource waits for evidences to be correlated.
+func (b *correlationBuffer) pending(resourceId string) bool {
+	if b == nil {
+		return false
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return len(b.incomplete[resourceId]) > 0
+}
+
+// evict removes all evidences and incomplete correlations collected before the window preceding now.
+func (b *correlationBuffer) evict(now time.Time) {
+	var oldest = now.Add(-b.window)
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for resourceId, evidences := range b.evidences {
+		for toolId, ev := range evidences {
+			if ev.GetTimestamp().AsTime().Before(oldest) {
+				delete(evidences, toolId)
+			}
+		}
+
+		if len(evidences) == 0 {
+			delete(b.evidences, resourceId)
+		}
+	}
+
+	for resourceId, correlations := range b.incomplete {
+		for metricId, c := range correlations {
+			if c.Since.Before(oldest) {
+				delete(correlations, metricId)
+			}
+		}
+
+		if len(correlations) == 0 {
+			delete(b.incomplete, resourceId)
+		}
+	}
+}
+
+// incompleteCorrelations returns a copy of all incomplete correlations, ordered by resource and metric.
+func (b *correlationBuffer) incompleteCorrelations() (correlations []IncompleteCorrelation) {
+	correlations = []IncompleteCorrelation{}
+	if b == nil {
+		return correlations
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for _, m := range b.incomplete {
+		for _, c := range m {
+			correlations = append(correlations, IncompleteCorrelation{
+				ResourceId:     c.ResourceId,
+				MetricId:       c.MetricId,
+				MissingToolIds: slices.Clone(c.MissingToolIds),
+				Since:          c.Since,
+			})
+		}
+	}
+
+	slices.SortFunc(correlations, func(a, b IncompleteCorrelation) int {
+		return cmp.Or(cmp.Compare(a.ResourceId, b.ResourceId), cmp.Compare(a.MetricId, b.MetricId))
+	})
+
+	return correlations
+}
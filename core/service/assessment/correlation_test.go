@@ -0,0 +1,93 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package assessment
+
+import (
+	"testing"
+	"time"
+
+	"confirmate.io/core/api/assessment"
+	"confirmate.io/core/api/evidence"
+	"confirmate.io/core/api/ontology"
+	"confirmate.io/core/util/assert"
+	"confirmate.io/core/util/prototest"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func Test_correlationBuffer(t *testing.T) {
+	var (
+		now         = time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+		b           = newCorrelationBuffer(15 * time.Minute)
+		correlation = &assessment.EvidenceCorrelation{ToolIds: []string{"log-collector", "config-collector"}}
+		correlated  map[string]ontology.IsResource
+	)
+
+	newEvidence := func(toolId string, collected time.Time) *evidence.Evidence {
+		return &evidence.Evidence{
+			ToolId:    toolId,
+			Timestamp: timestamppb.New(collected),
+			Resource:  prototest.NewProtobufResource(t, &ontology.VirtualMachine{Id: "vm-1", Name: toolId}),
+		}
+	}
+
+	// The configuration evidence arrives first, so the correlation is incomplete
+	correlated = b.add(newEvidence("config-collector", now), "vm-1")
+	assert.Empty(t, correlated)
+	assert.False(t, b.complete("vm-1", "metric-1", correlation, "config-collector", correlated, now))
+	assert.True(t, b.pending("vm-1"))
+	assert.Equal(t, []IncompleteCorrelation{{
+		ResourceId:     "vm-1",
+		MetricId:       "metric-1",
+		MissingToolIds: []string{"log-collector"},
+		Since:          now,
+	}}, b.incompleteCorrelations())
+
+	// Metrics without a correlation are always complete
+	assert.True(t, b.complete("vm-1", "metric-2", nil, "config-collector", correlated, now))
+
+	// A log evidence outside of the window is not correlated
+	correlated = b.add(newEvidence("log-collector", now.Add(-time.Hour)), "vm-1")
+	assert.Empty(t, correlated)
+
+	// A log evidence within the window completes the correlation
+	correlated = b.add(newEvidence("log-collector", now.Add(5*time.Minute)), "vm-1")
+	assert.Equal(t, "config-collector", correlated["config-collector"].GetName())
+	assert.True(t, b.complete("vm-1", "metric-1", correlation, "log-collector", correlated, now.Add(5*time.Minute)))
+	assert.False(t, b.pending("vm-1"))
+	assert.Empty(t, b.incompleteCorrelations())
+
+	// Evidences and incomplete correlations that fell out of the window are evicted
+	correlated = b.add(newEvidence("config-collector", now.Add(10*time.Minute)), "vm-2")
+	assert.False(t, b.complete("vm-2", "metric-1", correlation, "config-collector", correlated, now.Add(10*time.Minute)))
+
+	b.evict(now.Add(20 * time.Minute))
+	assert.Equal(t, 1, len(b.evidences["vm-1"]))
+	assert.True(t, b.pending("vm-2"))
+
+	b.evict(now.Add(time.Hour))
+	assert.Empty(t, b.evidences)
+	assert.False(t, b.pending("vm-2"))
+}
+
+func Test_correlationBuffer_nil(t *testing.T) {
+	var b *correlationBuffer
+
+	assert.Nil(t, b.add(&evidence.Evidence{}, "vm-1"))
+	assert.True(t, b.complete("vm-1", "metric-1", &assessment.EvidenceCorrelation{ToolIds: []string{"tool"}}, "other", nil, time.Now()))
+	assert.False(t, b.pending("vm-1"))
+	assert.Empty(t, b.incompleteCorrelations())
+}
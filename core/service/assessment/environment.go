@@ -0,0 +1,39 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package assessment
+
+import (
+	"confirmate.io/core/api/evidence"
+	"confirmate.io/core/api/ontology"
+)
+
+// DefaultEnvironmentLabels are the default label (or tag) keys of a resource that contain its environment.
+var DefaultEnvironmentLabels = []string{"environment", "env"}
+
+// resolveEnvironment resolves the environment (e.g., prod or staging) of the resource of an evidence. The environment
+// of the evidence takes precedence. Otherwise, it is taken from the first of the given labels of the resource. An
+// empty value is returned as nil.
+func resolveEnvironment(ev *evidence.Evidence, resource ontology.IsResource, keys []string) *string {
+	if ev.Environment != nil {
+		return ev.Environment
+	}
+
+	if r, ok := resource.(hasLabels); ok {
+		return firstLabel(r.GetLabels(), keys)
+	}
+
+	return nil
+}
@@ -0,0 +1,79 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package assessment
+
+import (
+	"testing"
+
+	"confirmate.io/core/api/evidence"
+	"confirmate.io/core/api/ontology"
+	"confirmate.io/core/util/assert"
+)
+
+func Test_resolveEnvironment(t *testing.T) {
+	type args struct {
+		ev       *evidence.Evidence
+		resource ontology.IsResource
+		keys     []string
+	}
+	tests := []struct {
+		name string
+		args args
+		want *string
+	}{
+		{
+			name: "environment of the evidence takes precedence",
+			args: args{
+				ev: &evidence.Evidence{Environment: new("prod")},
+				resource: &ontology.VirtualMachine{
+					Id:     "my-vm",
+					Labels: map[string]string{"environment": "staging"},
+				},
+				keys: DefaultEnvironmentLabels,
+			},
+			want: new("prod"),
+		},
+		{
+			name: "resolved from the first matching label",
+			args: args{
+				ev: &evidence.Evidence{},
+				resource: &ontology.VirtualMachine{
+					Id:     "my-vm",
+					Labels: map[string]string{"env": "staging", "stage": "dev"},
+				},
+				keys: DefaultEnvironmentLabels,
+			},
+			want: new("staging"),
+		},
+		{
+			name: "unresolvable",
+			args: args{
+				ev: &evidence.Evidence{},
+				resource: &ontology.VirtualMachine{
+					Id:     "my-vm",
+					Labels: map[string]string{"environment": ""},
+				},
+				keys: DefaultEnvironmentLabels,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := resolveEnvironment(tt.args.ev, tt.args.resource, tt.args.keys)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,141 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package assessment
+
+import (
+	"strings"
+
+	"confirmate.io/core/api/evidence"
+	"confirmate.io/core/api/ontology"
+)
+
+// DefaultOwnershipConfig is the default [OwnershipConfig], which resolves the owner and team of a resource from its
+// "owner" and "team" labels.
+var DefaultOwnershipConfig = OwnershipConfig{
+	OwnerLabels: []string{"owner"},
+	TeamLabels:  []string{"team"},
+}
+
+// OwnershipConfig configures how the owner and the team of a resource are resolved, if the evidence does not contain
+// an owner or team hint. The resolved ownership is stored in the assessment results, so that non-compliant findings
+// can be routed to the owning team.
+type OwnershipConfig struct {
+	// OwnerLabels contains the label (or tag) keys of a resource that contain its owner. The first matching label wins.
+	OwnerLabels []string
+	// TeamLabels contains the label (or tag) keys of a resource that contain its team. The first matching label wins.
+	TeamLabels []string
+	// NamespaceTeams maps Kubernetes namespaces to the teams owning the resources within them.
+	NamespaceTeams map[string]string
+	// SubscriptionTeams maps cloud subscriptions (Azure subscription IDs or AWS account IDs) to the teams owning the
+	// resources within them.
+	SubscriptionTeams map[string]string
+}
+
+// hasLabels is implemented by all ontology resources that have labels.
+type hasLabels interface {
+	GetLabels() map[string]string
+}
+
+// resolveOwnership resolves the owner and the team of the resource of an evidence. Hints contained in the evidence take
+// precedence. Otherwise, the owner is taken from the labels of the resource and the team from the labels, the
+// Kubernetes namespace or the subscription of the resource, in that order. Empty values are returned as nil.
+func (cfg *OwnershipConfig) resolveOwnership(ev *evidence.Evidence, resource ontology.IsResource) (owner *string, team *string) {
+	var labels map[string]string
+
+	if r, ok := resource.(hasLabels); ok {
+		labels = r.GetLabels()
+	}
+
+	owner = ev.OwnerHint
+	if owner == nil {
+		owner = firstLabel(labels, cfg.OwnerLabels)
+	}
+
+	team = ev.TeamHint
+	if team == nil {
+		team = firstLabel(labels, cfg.TeamLabels)
+	}
+	if team == nil {
+		team = lookup(cfg.NamespaceTeams, kubernetesNamespace(resource.GetId()))
+	}
+	if team == nil {
+		team = lookup(cfg.SubscriptionTeams, subscription(resource.GetId()))
+	}
+
+	return owner, team
+}
+
+// firstLabel returns the value of the first of the given keys that has a non-empty value in labels.
+func firstLabel(labels map[string]string, keys []string) *string {
+	for _, key := range keys {
+		if value := labels[key]; value != "" {
+			return &value
+		}
+	}
+
+	return nil
+}
+
+// lookup returns the non-empty value of key in m.
+func lookup(m map[string]string, key string) *string {
+	if key == "" {
+		return nil
+	}
+
+	if value := m[key]; value != "" {
+		return &value
+	}
+
+	return nil
+}
+
+// kubernetesNamespace extracts the namespace out of the ID of a Kubernetes resource, e.g.
+// "/namespaces/default/containers/my-pod".
+func kubernetesNamespace(id string) string {
+	return pathSegmentAfter(id, "namespaces")
+}
+
+// subscription extracts the subscription out of the ID of a cloud resource. This is either the subscription ID of an
+// Azure resource ID, e.g., "/subscriptions/<id>/resourceGroups/...", or the account ID of an AWS ARN, e.g.,
+// "arn:aws:s3:eu-central-1:<id>:bucket".
+func subscription(id string) string {
+	var parts []string
+
+	if strings.HasPrefix(id, "arn:") {
+		parts = strings.SplitN(id, ":", 6)
+		if len(parts) == 6 {
+			return parts[4]
+		}
+
+		return ""
+	}
+
+	return pathSegmentAfter(id, "subscriptions")
+}
+
+// pathSegmentAfter returns the path segment of id that follows the segment with the given name (compared
+// case-insensitively).
+func pathSegmentAfter(id string, name string) string {
+	var segments = strings.Split(id, "/")
+
+	for i := range len(segments) - 1 {
+		if strings.EqualFold(segments[i], name) {
+			return segments[i+1]
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,116 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package assessment
+
+import (
+	"testing"
+
+	"confirmate.io/core/api/evidence"
+	"confirmate.io/core/api/ontology"
+	"confirmate.io/core/util/assert"
+)
+
+func TestOwnershipConfig_resolveOwnership(t *testing.T) {
+	type args struct {
+		ev       *evidence.Evidence
+		resource ontology.IsResource
+	}
+	tests := []struct {
+		name      string
+		cfg       OwnershipConfig
+		args      args
+		wantOwner *string
+		wantTeam  *string
+	}{
+		{
+			name: "hints of the evidence take precedence",
+			cfg:  DefaultOwnershipConfig,
+			args: args{
+				ev: &evidence.Evidence{OwnerHint: new("bob"), TeamHint: new("team-hint")},
+				resource: &ontology.VirtualMachine{
+					Id:     "my-vm",
+					Labels: map[string]string{"owner": "alice", "team": "team-label"},
+				},
+			},
+			wantOwner: new("bob"),
+			wantTeam:  new("team-hint"),
+		},
+		{
+			name: "resolved from labels",
+			cfg: OwnershipConfig{
+				OwnerLabels: []string{"contact", "owner"},
+				TeamLabels:  []string{"team"},
+			},
+			args: args{
+				ev: &evidence.Evidence{},
+				resource: &ontology.VirtualMachine{
+					Id:     "my-vm",
+					Labels: map[string]string{"owner": "alice", "team": "team-label"},
+				},
+			},
+			wantOwner: new("alice"),
+			wantTeam:  new("team-label"),
+		},
+		{
+			name: "team resolved from Kubernetes namespace",
+			cfg: OwnershipConfig{
+				NamespaceTeams: map[string]string{"payments": "team-payments"},
+			},
+			args: args{
+				ev:       &evidence.Evidence{},
+				resource: &ontology.Container{Id: "/namespaces/payments/containers/my-pod"},
+			},
+			wantTeam: new("team-payments"),
+		},
+		{
+			name: "team resolved from Azure subscription",
+			cfg: OwnershipConfig{
+				SubscriptionTeams: map[string]string{"00000000-0000-0000-0000-000000000001": "team-azure"},
+			},
+			args: args{
+				ev:       &evidence.Evidence{},
+				resource: &ontology.VirtualMachine{Id: "/subscriptions/00000000-0000-0000-0000-000000000001/resourceGroups/rg/providers/Microsoft.Compute/virtualMachines/vm"},
+			},
+			wantTeam: new("team-azure"),
+		},
+		{
+			name: "team resolved from AWS account",
+			cfg: OwnershipConfig{
+				SubscriptionTeams: map[string]string{"123456789012": "team-aws"},
+			},
+			args: args{
+				ev:       &evidence.Evidence{},
+				resource: &ontology.VirtualMachine{Id: "arn:aws:ec2:eu-central-1:123456789012:instance/i-1"},
+			},
+			wantTeam: new("team-aws"),
+		},
+		{
+			name: "unresolvable",
+			cfg:  DefaultOwnershipConfig,
+			args: args{
+				ev:       &evidence.Evidence{},
+				resource: &ontology.Container{Id: "/namespaces/unknown/containers/my-pod"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			owner, team := tt.cfg.resolveOwnership(tt.args.ev, tt.args.resource)
+			assert.Equal(t, tt.wantOwner, owner)
+			assert.Equal(t, tt.wantTeam, team)
+		})
+	}
+}
@@ -0,0 +1,108 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package assessment
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"confirmate.io/core/api"
+	"confirmate.io/core/api/orchestrator"
+	"confirmate.io/core/log"
+	"confirmate.io/core/policies"
+
+	"connectrpc.com/connect"
+)
+
+// preloadRetryInterval is the interval in which retrieving the targets of evaluation for preloading the metric policies
+// is retried, e.g., if the orchestrator is not yet reachable when the service starts.
+const preloadRetryInterval = 30 * time.Second
+
+// preloadMetrics prepares the policies of all metrics for all targets of evaluation with [Config.PreloadWorkers]
+// workers, so that the first assessment after a restart does not need to compile them. If the targets of evaluation
+// cannot be retrieved, it is retried every [preloadRetryInterval] until ctx is done.
+func (svc *Service) preloadMetrics(ctx context.Context, preloader policies.Preloader) {
+	var (
+		targetIDs []string
+		start     time.Time
+		err       error
+	)
+
+	for {
+		targetIDs, err = svc.targetsOfEvaluation(ctx)
+		if err == nil {
+			break
+		}
+
+		slog.Warn("Could not retrieve targets of evaluation for preloading metrics, retrying later", log.Err(err))
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(preloadRetryInterval):
+		}
+	}
+
+	start = time.Now()
+	err = preloader.Preload(ctx, targetIDs, svc, svc.cfg.PreloadWorkers, logPreloadProgress)
+	if err != nil {
+		// The affected metrics are prepared on their first evaluation instead
+		slog.Warn("Could not preload all metrics", slog.Duration("duration", time.Since(start)), log.Err(err))
+		return
+	}
+
+	slog.Info("Preloaded metrics", slog.Int("targets_of_evaluation", len(targetIDs)), slog.Duration("duration", time.Since(start)))
+}
+
+// logPreloadProgress logs the progress of preloading the metric policies in steps of 10 percent.
+func logPreloadProgress(done int, total int) {
+	if done%max(total/10, 1) == 0 || done == total {
+		slog.Info("Preloading metrics", slog.Int("done", done), slog.Int("total", total))
+	}
+}
+
+// targetsOfEvaluation returns the IDs of all targets of evaluation, either of the local metric bundle or of the
+// orchestrator.
+func (svc *Service) targetsOfEvaluation(ctx context.Context) (ids []string, err error) {
+	var (
+		toes []*orchestrator.TargetOfEvaluation
+	)
+
+	if svc.bundle != nil {
+		return svc.bundle.targets, nil
+	}
+
+	toes, err = api.ListAllPaginated(ctx, &orchestrator.ListTargetsOfEvaluationRequest{}, func(ctx context.Context, req *orchestrator.ListTargetsOfEvaluationRequest) (*orchestrator.ListTargetsOfEvaluationResponse, error) {
+		res, err := svc.orchestratorClient.ListTargetsOfEvaluation(ctx, connect.NewRequest(req))
+		if err != nil {
+			return nil, err
+		}
+		return res.Msg, nil
+	}, func(res *orchestrator.ListTargetsOfEvaluationResponse) []*orchestrator.TargetOfEvaluation {
+		return res.TargetsOfEvaluation
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve targets of evaluation from orchestrator: %w", err)
+	}
+
+	for _, toe := range toes {
+		ids = append(ids, toe.Id)
+	}
+
+	return ids, nil
+}
@@ -0,0 +1,146 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package assessment
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"confirmate.io/core/api/orchestrator/orchestratorconnect"
+	"confirmate.io/core/persistence"
+	"confirmate.io/core/policies"
+	"confirmate.io/core/server"
+	"confirmate.io/core/server/servertest"
+	"confirmate.io/core/service/orchestrator"
+	"confirmate.io/core/util/assert"
+)
+
+// mockPreloader records the arguments of [policies.Preloader.Preload].
+type mockPreloader struct {
+	targetIDs   []string
+	concurrency int
+	err         error
+}
+
+func (m *mockPreloader) Preload(_ context.Context, targetIDs []string, _ policies.MetricsSource, concurrency int, progress policies.PreloadProgressFunc) (err error) {
+	m.targetIDs = targetIDs
+	m.concurrency = concurrency
+
+	for i := range targetIDs {
+		progress(i+1, len(targetIDs))
+	}
+
+	return m.err
+}
+
+func TestService_targetsOfEvaluation(t *testing.T) {
+	bundle, err := loadMetricBundle(writeMockBundle(t))
+	assert.NoError(t, err)
+
+	orchSvc, err := orchestrator.NewService(
+		orchestrator.WithConfig(orchestrator.Config{
+			PersistenceConfig: persistence.Config{
+				InMemoryDB: true,
+			},
+			CreateDefaultTargetOfEvaluation: true,
+		}),
+	)
+	assert.NoError(t, err)
+
+	_, testSrv := servertest.NewTestConnectServer(t,
+		server.WithHandler(orchestratorconnect.NewOrchestratorHandler(orchSvc)),
+	)
+	defer testSrv.Close()
+
+	tests := []struct {
+		name    string
+		svc     *Service
+		want    assert.Want[[]string]
+		wantErr assert.WantErr
+	}{
+		{
+			name: "metric bundle",
+			svc:  &Service{bundle: bundle},
+			want: func(t *testing.T, got []string, msgAndArgs ...any) bool {
+				return assert.Equal(t, []string{mockBundleToeId}, got)
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "orchestrator",
+			svc: &Service{
+				orchestratorClient: orchestratorconnect.NewOrchestratorClient(testSrv.Client(), testSrv.URL),
+			},
+			want: func(t *testing.T, got []string, msgAndArgs ...any) bool {
+				return assert.Equal(t, 1, len(got))
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "orchestrator not reachable",
+			svc: &Service{
+				orchestratorClient: orchestratorconnect.NewOrchestratorClient(http.DefaultClient, "http://localhost:1"),
+			},
+			want: assert.Nil[[]string],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.ErrorContains(t, err, "could not retrieve targets of evaluation from orchestrator")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.svc.targetsOfEvaluation(context.Background())
+			tt.want(t, got)
+			tt.wantErr(t, err)
+		})
+	}
+}
+
+func TestService_preloadMetrics(t *testing.T) {
+	bundle, err := loadMetricBundle(writeMockBundle(t))
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		preloader *mockPreloader
+	}{
+		{
+			name:      "happy path",
+			preloader: &mockPreloader{},
+		},
+		{
+			name:      "preload error",
+			preloader: &mockPreloader{err: errors.New("could not prepare metric")},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &Service{
+				bundle: bundle,
+				cfg:    Config{PreloadWorkers: 2},
+			}
+
+			svc.preloadMetrics(context.Background(), tt.preloader)
+
+			assert.Equal(t, []string{mockBundleToeId}, tt.preloader.targetIDs)
+			assert.Equal(t, 2, tt.preloader.concurrency)
+		})
+	}
+}
@@ -0,0 +1,153 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package assessment
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"confirmate.io/core/api"
+	"confirmate.io/core/api/assessment"
+	"confirmate.io/core/api/evidence"
+	"confirmate.io/core/api/ontology"
+	"confirmate.io/core/api/orchestrator"
+	"confirmate.io/core/auth"
+	"confirmate.io/core/log"
+	"confirmate.io/core/service"
+
+	"connectrpc.com/connect"
+)
+
+// ReassessEvidences re-assesses the evidences of a target of evaluation that are stored in the evidence store against
+// the current metrics, e.g., after a broken metric implementation was fixed. The cached metrics, metric configurations
+// and policies are discarded beforehand, so that the evidences are not assessed against outdated metrics. The new
+// assessment results are sent to the orchestrator just like the results of newly collected evidences.
+func (svc *Service) ReassessEvidences(ctx context.Context, req *connect.Request[assessment.ReassessEvidencesRequest]) (res *connect.Response[assessment.ReassessEvidencesResponse], err error) {
+	var (
+		allowed   bool
+		claims    *auth.OAuthClaims
+		evidences []*evidence.Evidence
+		resources map[string]ontology.IsResource
+		assessed  int32
+		failed    int32
+	)
+
+	// Validate the request
+	if err = service.Validate(req); err != nil {
+		return nil, err
+	}
+
+	claims, _ = auth.ClaimsFromContext(ctx)
+
+	// Re-assessing evidences replaces the assessment results of the target of evaluation
+	allowed, _ = svc.authz.CheckAccess(ctx, auth.GetConfirmateUserIDFromClaims(claims), orchestrator.RequestType_REQUEST_TYPE_UPDATED,
+		orchestrator.UserPermission_PERMISSION_CONTRIBUTOR, req.Msg.TargetOfEvaluationId, orchestrator.ObjectType_OBJECT_TYPE_TARGET_OF_EVALUATION)
+	if !allowed {
+		return nil, service.ErrPermissionDenied
+	}
+
+	evidences, err = api.ListAllPaginated(ctx, &evidence.ListEvidencesRequest{
+		Filter: &evidence.Filter{
+			TargetOfEvaluationId: &req.Msg.TargetOfEvaluationId,
+			TimestampAfter:       req.Msg.TimestampAfter,
+			TimestampBefore:      req.Msg.TimestampBefore,
+		},
+		OrderBy: "timestamp",
+		Asc:     true,
+	}, func(ctx context.Context, req *evidence.ListEvidencesRequest) (*evidence.ListEvidencesResponse, error) {
+		res, err := svc.evidenceStoreClient.ListEvidences(ctx, connect.NewRequest(req))
+		if err != nil {
+			return nil, err
+		}
+		return res.Msg, nil
+	}, func(res *evidence.ListEvidencesResponse) []*evidence.Evidence {
+		return res.Evidences
+	})
+	if err != nil {
+		slog.Error("Could not retrieve evidences from evidence store", log.Err(err))
+		return nil, connect.NewError(connect.CodeUnavailable, err)
+	}
+
+	svc.clearCaches()
+
+	// Since the evidences are ordered by their timestamp, the latest evidence of each resource is used for related
+	// resources
+	resources = make(map[string]ontology.IsResource)
+	for _, ev := range evidences {
+		if resource := ev.GetOntologyResource(); resource != nil {
+			resources[resource.GetId()] = resource
+		}
+	}
+
+	for _, ev := range evidences {
+		err = svc.reassessEvidence(ctx, ev, resources)
+		if err != nil {
+			slog.Warn("Could not re-assess evidence", slog.String("evidence_id", ev.GetId()), log.Err(err))
+			failed++
+			continue
+		}
+
+		assessed++
+	}
+
+	slog.Info("Re-assessed evidences",
+		slog.String("target_of_evaluation_id", req.Msg.TargetOfEvaluationId),
+		slog.Int("assessed", int(assessed)),
+		slog.Int("failed", int(failed)))
+
+	res = connect.NewResponse(&assessment.ReassessEvidencesResponse{
+		Assessed: assessed,
+		Failed:   failed,
+	})
+
+	return res, nil
+}
+
+// reassessEvidence assesses a stored evidence again. Its related resources are looked up in the given resources of the
+// other stored evidences.
+func (svc *Service) reassessEvidence(ctx context.Context, ev *evidence.Evidence, resources map[string]ontology.IsResource) (err error) {
+	var (
+		related  map[string]ontology.IsResource
+		resource ontology.IsResource
+		ok       bool
+	)
+
+	related = make(map[string]ontology.IsResource)
+	for _, id := range ev.ExperimentalRelatedResourceIds {
+		resource, ok = resources[id]
+		if !ok {
+			return fmt.Errorf("related resource %s is not stored", id)
+		}
+
+		related[id] = resource
+	}
+
+	_, err = svc.handleEvidence(ctx, ev, ev.GetOntologyResource(), related)
+	return err
+}
+
+// clearCaches discards the cached metric configurations and redaction rules as well as the metrics and policies cached
+// by the policy evaluation engine.
+func (svc *Service) clearCaches() {
+	svc.confMutex.Lock()
+	clear(svc.cachedConfigurations)
+	svc.confMutex.Unlock()
+
+	svc.redaction.clear()
+
+	svc.pe.ClearCache()
+}
@@ -0,0 +1,189 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package assessment
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"confirmate.io/core/api/assessment"
+	"confirmate.io/core/api/evidence"
+	"confirmate.io/core/api/evidence/evidenceconnect"
+	"confirmate.io/core/api/ontology"
+	"confirmate.io/core/policies"
+	"confirmate.io/core/service"
+	"confirmate.io/core/service/evidence/evidencetest"
+	"confirmate.io/core/util/assert"
+	"confirmate.io/core/util/prototest"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// mockEvidenceStoreClient returns the configured evidences when listing evidences.
+type mockEvidenceStoreClient struct {
+	evidenceconnect.EvidenceStoreClient
+
+	evidences []*evidence.Evidence
+	err       error
+}
+
+func (m *mockEvidenceStoreClient) ListEvidences(_ context.Context, _ *connect.Request[evidence.ListEvidencesRequest]) (*connect.Response[evidence.ListEvidencesResponse], error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+
+	return connect.NewResponse(&evidence.ListEvidencesResponse{Evidences: m.evidences}), nil
+}
+
+// emptyPolicyEval does not find any applicable metric and records whether its cache was cleared.
+type emptyPolicyEval struct {
+	cleared bool
+}
+
+func (*emptyPolicyEval) Eval(context.Context, *evidence.Evidence, ontology.IsResource, map[string]ontology.IsResource, map[string]ontology.IsResource, policies.MetricsSource) ([]*policies.CombinedResult, error) {
+	return nil, nil
+}
+
+func (pe *emptyPolicyEval) ClearCache() {
+	pe.cleared = true
+}
+
+func TestService_ReassessEvidences(t *testing.T) {
+	var (
+		vm = &evidence.Evidence{
+			Id:                   evidencetest.MockEvidenceID1,
+			Timestamp:            timestamppb.Now(),
+			TargetOfEvaluationId: evidencetest.MockTargetOfEvaluationID1,
+			ToolId:               evidencetest.MockEvidenceToolID1,
+			Resource: prototest.NewProtobufResource(t, &ontology.VirtualMachine{
+				Id: evidencetest.MockVirtualMachineID1,
+			}),
+		}
+		withMissingRelated = &evidence.Evidence{
+			Id:                             evidencetest.MockEvidenceID2,
+			Timestamp:                      timestamppb.Now(),
+			TargetOfEvaluationId:           evidencetest.MockTargetOfEvaluationID1,
+			ToolId:                         evidencetest.MockEvidenceToolID1,
+			ExperimentalRelatedResourceIds: []string{evidencetest.MockBlockStorageID1},
+			Resource: prototest.NewProtobufResource(t, &ontology.VirtualMachine{
+				Id: evidencetest.MockVirtualMachineID2,
+			}),
+		}
+	)
+
+	type fields struct {
+		evidenceStoreClient evidenceconnect.EvidenceStoreClient
+		authz               service.AuthorizationStrategy
+	}
+	type args struct {
+		req *assessment.ReassessEvidencesRequest
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    assert.Want[*connect.Response[assessment.ReassessEvidencesResponse]]
+		wantErr assert.WantErr
+	}{
+		{
+			name: "validation error - missing target of evaluation",
+			fields: fields{
+				evidenceStoreClient: &mockEvidenceStoreClient{},
+				authz:               &service.AuthorizationStrategyAllowAll{},
+			},
+			args: args{
+				req: &assessment.ReassessEvidencesRequest{},
+			},
+			want: assert.Nil[*connect.Response[assessment.ReassessEvidencesResponse]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeInvalidArgument)
+			},
+		},
+		{
+			name: "permission denied",
+			fields: fields{
+				evidenceStoreClient: &mockEvidenceStoreClient{},
+				authz:               &denyAssessmentAuthorizationStrategy{},
+			},
+			args: args{
+				req: &assessment.ReassessEvidencesRequest{
+					TargetOfEvaluationId: evidencetest.MockTargetOfEvaluationID1,
+				},
+			},
+			want: assert.Nil[*connect.Response[assessment.ReassessEvidencesResponse]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodePermissionDenied)
+			},
+		},
+		{
+			name: "evidence store unavailable",
+			fields: fields{
+				evidenceStoreClient: &mockEvidenceStoreClient{err: errors.New("connection refused")},
+				authz:               &service.AuthorizationStrategyAllowAll{},
+			},
+			args: args{
+				req: &assessment.ReassessEvidencesRequest{
+					TargetOfEvaluationId: evidencetest.MockTargetOfEvaluationID1,
+				},
+			},
+			want: assert.Nil[*connect.Response[assessment.ReassessEvidencesResponse]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeUnavailable)
+			},
+		},
+		{
+			name: "happy path",
+			fields: fields{
+				evidenceStoreClient: &mockEvidenceStoreClient{
+					evidences: []*evidence.Evidence{vm, withMissingRelated},
+				},
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			args: args{
+				req: &assessment.ReassessEvidencesRequest{
+					TargetOfEvaluationId: evidencetest.MockTargetOfEvaluationID1,
+				},
+			},
+			want: func(t *testing.T, got *connect.Response[assessment.ReassessEvidencesResponse], msgAndArgs ...any) bool {
+				return assert.Equal(t, &assessment.ReassessEvidencesResponse{Assessed: 1, Failed: 1}, got.Msg)
+			},
+			wantErr: assert.NoError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pe := &emptyPolicyEval{}
+			svc := &Service{
+				evidenceStoreClient:  tt.fields.evidenceStoreClient,
+				authz:                tt.fields.authz,
+				pe:                   pe,
+				cachedConfigurations: map[string]cachedConfiguration{"toe-metric": {}},
+			}
+
+			got, err := svc.ReassessEvidences(context.Background(), connect.NewRequest(tt.args.req))
+			tt.want(t, got)
+			tt.wantErr(t, err)
+
+			if err == nil {
+				assert.True(t, pe.cleared)
+				assert.Equal(t, 0, len(svc.cachedConfigurations))
+			}
+		})
+	}
+}
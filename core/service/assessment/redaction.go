@@ -0,0 +1,224 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package assessment
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"confirmate.io/core/api"
+	"confirmate.io/core/api/ontology"
+	"confirmate.io/core/api/orchestrator"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// redactionMask replaces the values of fields that are redacted with [orchestrator.RedactionMode_REDACTION_MODE_MASK].
+const redactionMask = "[REDACTED]"
+
+// redactionCache caches the redaction rules managed by the orchestrator.
+type redactionCache struct {
+	mu       sync.Mutex
+	cachedAt time.Time
+	rules    []*orchestrator.RedactionRule
+}
+
+// clear discards the cached redaction rules, so that they are retrieved again on the next assessment.
+func (c *redactionCache) clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.rules = nil
+	c.cachedAt = time.Time{}
+}
+
+// redactionRules returns the static redaction rules of [Config.RedactionRules] together with the redaction rules
+// managed by the orchestrator. The latter are cached for [EvictionTime]. If the metrics are loaded from a local metric
+// bundle, only the static rules apply.
+func (svc *Service) redactionRules(ctx context.Context) (rules []*orchestrator.RedactionRule, err error) {
+	var managed []*orchestrator.RedactionRule
+
+	rules = slices.Clone(svc.cfg.RedactionRules)
+	if svc.bundle != nil || svc.orchestratorClient == nil {
+		return rules, nil
+	}
+
+	svc.redaction.mu.Lock()
+	defer svc.redaction.mu.Unlock()
+
+	if svc.redaction.cachedAt.IsZero() || time.Since(svc.redaction.cachedAt) > EvictionTime {
+		managed, err = api.ListAllPaginated(ctx, &orchestrator.ListRedactionRulesRequest{}, func(ctx context.Context, req *orchestrator.ListRedactionRulesRequest) (*orchestrator.ListRedactionRulesResponse, error) {
+			res, err := svc.orchestratorClient.ListRedactionRules(ctx, connect.NewRequest(req))
+			if err != nil {
+				return nil, err
+			}
+			return res.Msg, nil
+		}, func(res *orchestrator.ListRedactionRulesResponse) []*orchestrator.RedactionRule {
+			return res.Rules
+		})
+		if err != nil {
+			return nil, fmt.Errorf("could not retrieve redaction rules from orchestrator: %w", err)
+		}
+
+		svc.redaction.rules = managed
+		svc.redaction.cachedAt = time.Now()
+	}
+
+	return append(rules, svc.redaction.rules...), nil
+}
+
+// redact returns a copy of the resource in which the fields matching the redaction rules of its resource types are
+// stripped or masked. The resource itself is left untouched, since its evidence might be assessed again, e.g., as
+// related resource of another evidence. Masked strings are replaced by [redactionMask]; masked values that cannot hold
+// a string, e.g., numbers, are stripped instead.
+func redact(resource ontology.IsResource, rules []*orchestrator.RedactionRule) ontology.IsResource {
+	var (
+		types []string
+		strip []string
+		mask  []string
+	)
+
+	if resource == nil || len(rules) == 0 {
+		return resource
+	}
+
+	types = ontology.ResourceTypes(resource)
+	for _, rule := range rules {
+		if !slices.Contains(types, rule.GetResourceType()) {
+			continue
+		}
+
+		switch rule.GetMode() {
+		case orchestrator.RedactionMode_REDACTION_MODE_STRIP:
+			strip = append(strip, rule.GetFields()...)
+		case orchestrator.RedactionMode_REDACTION_MODE_MASK:
+			mask = append(mask, rule.GetFields()...)
+		}
+	}
+
+	if len(strip) == 0 && len(mask) == 0 {
+		return resource
+	}
+
+	resource = proto.Clone(resource).(ontology.IsResource)
+	redactMessage(resource.ProtoReflect(), "", strip, mask)
+
+	return resource
+}
+
+// redactResources returns redacted copies of the resources, see [redact].
+func redactResources(resources map[string]ontology.IsResource, rules []*orchestrator.RedactionRule) (redacted map[string]ontology.IsResource) {
+	if resources == nil {
+		return nil
+	}
+
+	redacted = make(map[string]ontology.IsResource, len(resources))
+	for id, resource := range resources {
+		redacted[id] = redact(resource, rules)
+	}
+
+	return redacted
+}
+
+// redactMessage strips the fields of m whose path is contained in strip and masks the fields whose path is contained
+// in mask. Paths consist of the JSON names of the fields, nested fields are separated by dots.
+func redactMessage(m protoreflect.Message, prefix string, strip []string, mask []string) {
+	var populated []protoreflect.FieldDescriptor
+
+	// Collect the populated fields first, since we are not allowed to modify the message while ranging over it
+	m.Range(func(fd protoreflect.FieldDescriptor, _ protoreflect.Value) bool {
+		populated = append(populated, fd)
+		return true
+	})
+
+	for _, fd := range populated {
+		var path = fd.JSONName()
+
+		if prefix != "" {
+			path = prefix + "." + path
+		} else if path == "id" {
+			// The ID of the resource is needed to relate the assessment results to it
+			continue
+		}
+
+		switch {
+		case slices.Contains(strip, path):
+			m.Clear(fd)
+		case slices.Contains(mask, path):
+			maskField(m, fd)
+		case fd.IsMap() || fd.Kind() != protoreflect.MessageKind:
+			continue
+		case fd.IsList():
+			list := m.Mutable(fd).List()
+			for i := range list.Len() {
+				redactMessage(list.Get(i).Message(), path, strip, mask)
+			}
+		default:
+			redactMessage(m.Mutable(fd).Message(), path, strip, mask)
+		}
+	}
+}
+
+// maskField replaces the value of the field fd of m with [redactionMask]. Nested messages are masked completely.
+func maskField(m protoreflect.Message, fd protoreflect.FieldDescriptor) {
+	switch {
+	case fd.IsMap() && fd.MapValue().Kind() == protoreflect.StringKind:
+		mm := m.Mutable(fd).Map()
+		mm.Range(func(k protoreflect.MapKey, _ protoreflect.Value) bool {
+			mm.Set(k, protoreflect.ValueOfString(redactionMask))
+			return true
+		})
+	case fd.IsMap():
+		m.Clear(fd)
+	case fd.IsList() && fd.Kind() == protoreflect.StringKind:
+		list := m.Mutable(fd).List()
+		for i := range list.Len() {
+			list.Set(i, protoreflect.ValueOfString(redactionMask))
+		}
+	case fd.IsList() && fd.Kind() == protoreflect.MessageKind:
+		list := m.Mutable(fd).List()
+		for i := range list.Len() {
+			maskMessage(list.Get(i).Message())
+		}
+	case fd.IsList():
+		m.Clear(fd)
+	case fd.Kind() == protoreflect.StringKind:
+		m.Set(fd, protoreflect.ValueOfString(redactionMask))
+	case fd.Kind() == protoreflect.MessageKind:
+		maskMessage(m.Mutable(fd).Message())
+	default:
+		m.Clear(fd)
+	}
+}
+
+// maskMessage masks all populated fields of m, see [maskField].
+func maskMessage(m protoreflect.Message) {
+	var populated []protoreflect.FieldDescriptor
+
+	m.Range(func(fd protoreflect.FieldDescriptor, _ protoreflect.Value) bool {
+		populated = append(populated, fd)
+		return true
+	})
+
+	for _, fd := range populated {
+		maskField(m, fd)
+	}
+}
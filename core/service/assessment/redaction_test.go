@@ -0,0 +1,186 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package assessment
+
+import (
+	"context"
+	"testing"
+
+	"confirmate.io/core/api/ontology"
+	"confirmate.io/core/api/orchestrator"
+	"confirmate.io/core/api/orchestrator/orchestratorconnect"
+	"confirmate.io/core/persistence"
+	"confirmate.io/core/service/evidence/evidencetest"
+	"confirmate.io/core/util/assert"
+)
+
+func Test_redact(t *testing.T) {
+	var vm = func() *ontology.VirtualMachine {
+		return &ontology.VirtualMachine{
+			Id:     evidencetest.MockVirtualMachineID1,
+			Name:   evidencetest.MockVirtualMachineName1,
+			Raw:    `{"password": "secret"}`,
+			Labels: map[string]string{"owner": "alice@example.com"},
+			BootLogging: &ontology.BootLogging{
+				Enabled:           true,
+				LoggingServiceIds: []string{"logging-1"},
+			},
+		}
+	}
+
+	type args struct {
+		resource ontology.IsResource
+		rules    []*orchestrator.RedactionRule
+	}
+	tests := []struct {
+		name string
+		args args
+		want assert.Want[ontology.IsResource]
+	}{
+		{
+			name: "no rules",
+			args: args{
+				resource: vm(),
+			},
+			want: func(t *testing.T, got ontology.IsResource, msgAndArgs ...any) bool {
+				return assert.Equal[ontology.IsResource](t, vm(), got)
+			},
+		},
+		{
+			name: "rule of other resource type",
+			args: args{
+				resource: vm(),
+				rules: []*orchestrator.RedactionRule{
+					{ResourceType: "Identity", Fields: []string{"name"}, Mode: orchestrator.RedactionMode_REDACTION_MODE_STRIP},
+				},
+			},
+			want: func(t *testing.T, got ontology.IsResource, msgAndArgs ...any) bool {
+				return assert.Equal[ontology.IsResource](t, vm(), got)
+			},
+		},
+		{
+			name: "strip fields",
+			args: args{
+				resource: vm(),
+				rules: []*orchestrator.RedactionRule{
+					{
+						ResourceType: "VirtualMachine",
+						Fields:       []string{"raw", "labels", "bootLogging.loggingServiceIds"},
+						Mode:         orchestrator.RedactionMode_REDACTION_MODE_STRIP,
+					},
+				},
+			},
+			want: func(t *testing.T, got ontology.IsResource, msgAndArgs ...any) bool {
+				return assert.Equal[ontology.IsResource](t, &ontology.VirtualMachine{
+					Id:          evidencetest.MockVirtualMachineID1,
+					Name:        evidencetest.MockVirtualMachineName1,
+					BootLogging: &ontology.BootLogging{Enabled: true},
+				}, got)
+			},
+		},
+		{
+			name: "mask fields of more general resource type",
+			args: args{
+				resource: vm(),
+				rules: []*orchestrator.RedactionRule{
+					{
+						ResourceType: "Resource",
+						Fields:       []string{"id", "name", "labels", "bootLogging"},
+						Mode:         orchestrator.RedactionMode_REDACTION_MODE_MASK,
+					},
+				},
+			},
+			want: func(t *testing.T, got ontology.IsResource, msgAndArgs ...any) bool {
+				return assert.Equal[ontology.IsResource](t, &ontology.VirtualMachine{
+					Id:     evidencetest.MockVirtualMachineID1,
+					Name:   redactionMask,
+					Raw:    `{"password": "secret"}`,
+					Labels: map[string]string{"owner": redactionMask},
+					BootLogging: &ontology.BootLogging{
+						LoggingServiceIds: []string{redactionMask},
+					},
+				}, got)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := redact(tt.args.resource, tt.args.rules)
+			tt.want(t, got)
+
+			// The original resource must never be modified
+			assert.Equal[ontology.IsResource](t, vm(), tt.args.resource)
+		})
+	}
+}
+
+func TestService_redactionRules(t *testing.T) {
+	var static = &orchestrator.RedactionRule{
+		ResourceType: "Identity",
+		Fields:       []string{"name"},
+		Mode:         orchestrator.RedactionMode_REDACTION_MODE_MASK,
+	}
+
+	orchSvc := newTestOrchestratorServiceWithInit(t, func(db persistence.DB) error {
+		return db.Create(&orchestrator.RedactionRule{
+			ResourceType: "VirtualMachine",
+			Fields:       []string{"raw"},
+			Mode:         orchestrator.RedactionMode_REDACTION_MODE_STRIP,
+		})
+	})
+	client, url := setupOrchestratorServer(t, orchSvc)
+
+	tests := []struct {
+		name    string
+		svc     *Service
+		want    assert.Want[[]*orchestrator.RedactionRule]
+		wantErr assert.WantErr
+	}{
+		{
+			name: "metric bundle - only static rules",
+			svc: &Service{
+				cfg:    Config{RedactionRules: []*orchestrator.RedactionRule{static}},
+				bundle: &metricBundle{},
+			},
+			want: func(t *testing.T, got []*orchestrator.RedactionRule, msgAndArgs ...any) bool {
+				return assert.Equal(t, []*orchestrator.RedactionRule{static}, got)
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "orchestrator - static and managed rules",
+			svc: &Service{
+				cfg:                Config{RedactionRules: []*orchestrator.RedactionRule{static}},
+				orchestratorClient: orchestratorconnect.NewOrchestratorClient(client, url),
+			},
+			want: func(t *testing.T, got []*orchestrator.RedactionRule, msgAndArgs ...any) bool {
+				return assert.Equal(t, 2, len(got)) &&
+					assert.Equal(t, "Identity", got[0].ResourceType) &&
+					assert.Equal(t, "VirtualMachine", got[1].ResourceType)
+			},
+			wantErr: assert.NoError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.svc.redactionRules(context.Background())
+			tt.want(t, got)
+			tt.wantErr(t, err)
+		})
+	}
+}
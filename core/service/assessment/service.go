@@ -22,6 +22,7 @@ import (
 	"io"
 	"log/slog"
 	"net/http"
+	"slices"
 	"sync"
 	"time"
 
@@ -29,27 +30,70 @@ import (
 	"confirmate.io/core/api/assessment"
 	"confirmate.io/core/api/assessment/assessmentconnect"
 	"confirmate.io/core/api/evidence"
+	"confirmate.io/core/api/evidence/evidenceconnect"
 	"confirmate.io/core/api/ontology"
 	"confirmate.io/core/api/orchestrator"
 	"confirmate.io/core/api/orchestrator/orchestratorconnect"
 	"confirmate.io/core/log"
 	"confirmate.io/core/policies"
 	"confirmate.io/core/service"
-	"confirmate.io/core/stream"
 	"golang.org/x/oauth2/clientcredentials"
+	"golang.org/x/sync/errgroup"
 
 	"connectrpc.com/connect"
 	"github.com/google/uuid"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
-const DefaultOrchestratorURL = "http://localhost:8080"
+const (
+	DefaultOrchestratorURL = "http://localhost:8080"
+
+	// DefaultEvidenceStoreURL is the default address of the evidence store, from which stored evidences are re-assessed.
+	DefaultEvidenceStoreURL = "http://localhost:8080"
+
+	// DefaultStreamQueueSize is the default number of evidences of a stream that are queued for assessment.
+	DefaultStreamQueueSize = 256
+	// DefaultStreamWorkers is the default number of evidences of a stream that are assessed concurrently.
+	DefaultStreamWorkers = 4
+
+	// DefaultToEQueueSize is the default number of evidences of a single target of evaluation that are queued for
+	// assessment.
+	DefaultToEQueueSize = 256
+	// DefaultToEWorkers is the default number of evidences of a single target of evaluation that are assessed
+	// concurrently.
+	DefaultToEWorkers = 4
+
+	// DefaultSpoolSyncInterval is the default interval in which spooled assessment results are synced to the
+	// orchestrator.
+	DefaultSpoolSyncInterval = time.Minute
+
+	// DefaultPreloadWorkers is the default number of metric policies that are prepared concurrently when the service
+	// starts.
+	DefaultPreloadWorkers = 4
+
+	// maxResultBatchSize is the maximum number of assessment results of a single BatchStoreAssessmentResults request.
+	maxResultBatchSize = 1000
+)
 
 // DefaultConfig is the default configuration for the assessment [Service].
 var DefaultConfig = Config{
-	OrchestratorAddress:    DefaultOrchestratorURL,
-	OrchestratorHTTPClient: service.DefaultHTTPClient,
-	RegoPackage:            policies.DefaultRegoPackage,
+	OrchestratorAddress:         DefaultOrchestratorURL,
+	OrchestratorHTTPClient:      service.DefaultHTTPClient,
+	EvidenceStoreAddress:        DefaultEvidenceStoreURL,
+	EvidenceStoreHTTPClient:     service.DefaultHTTPClient,
+	RegoPackage:                 policies.DefaultRegoPackage,
+	PolicyServerHTTPClient:      service.DefaultHTTPClient,
+	StreamQueueSize:             DefaultStreamQueueSize,
+	StreamWorkers:               DefaultStreamWorkers,
+	ToEQueueSize:                DefaultToEQueueSize,
+	ToEWorkers:                  DefaultToEWorkers,
+	SpoolSyncInterval:           DefaultSpoolSyncInterval,
+	Ownership:                   DefaultOwnershipConfig,
+	EnvironmentLabels:           DefaultEnvironmentLabels,
+	CorrelationWindow:           DefaultCorrelationWindow,
+	CorrelationEvictionInterval: DefaultCorrelationEvictionInterval,
+	PreloadWorkers:              DefaultPreloadWorkers,
+	Transport:                   service.DefaultTransportConfig,
 }
 
 // Config represents the configuration for the assessment [Service].
@@ -58,12 +102,83 @@ type Config struct {
 	OrchestratorAddress string
 	// OrchestratorHTTPClient is the HTTP client to use for orchestrator communication.
 	OrchestratorHTTPClient *http.Client
+	// EvidenceStoreAddress is the address of the evidence store service, from which stored evidences are retrieved in
+	// order to re-assess them (see [Service.ReassessEvidences]).
+	EvidenceStoreAddress string
+	// EvidenceStoreHTTPClient is the HTTP client to use for evidence store communication.
+	EvidenceStoreHTTPClient *http.Client
 	// RegoPackage is the package name to use for Rego policy evaluation.
 	RegoPackage string
+	// PolicyServerURL is the base URL of an external policy server that implements the OPA Data API, such as an OPA
+	// server. If set, metrics are evaluated by the policies deployed on this server under [Config.RegoPackage] instead
+	// of the embedded Rego engine.
+	PolicyServerURL string
+	// PolicyServerHTTPClient is the HTTP client to use for policy server communication.
+	PolicyServerHTTPClient *http.Client
 	// ServiceOAuth2Config is the OAuth2 client credentials configuration used for
 	// service-to-service authentication with the orchestrator. When set, all outgoing
 	// orchestrator calls use this token.
 	ServiceOAuth2Config *clientcredentials.Config
+
+	// StreamQueueSize is the number of evidences received via [Service.AssessEvidences] that are queued for
+	// assessment. If the queue is full, no further evidences are received until an assessment is finished.
+	StreamQueueSize int
+	// StreamWorkers is the number of evidences received via [Service.AssessEvidences] that are assessed concurrently.
+	StreamWorkers int
+
+	// ToEQueueSize is the number of evidences of a single target of evaluation that are queued for assessment across
+	// all streams. If the queue is full, the streams sending evidences of this target of evaluation wait until an
+	// assessment is finished, while evidences of other targets of evaluation are still assessed.
+	ToEQueueSize int
+	// ToEWorkers is the number of evidences of a single target of evaluation that are assessed concurrently across all
+	// streams.
+	ToEWorkers int
+
+	// MetricBundlePath is the path to a local metric bundle (see [assessment.MetricBundle]). If set, the metrics, their
+	// implementations and configurations are loaded from the bundle instead of the orchestrator, so that evidences can
+	// be assessed without connectivity to the orchestrator.
+	MetricBundlePath string
+	// SpoolDirectory is the directory in which assessment results are spooled. If set, assessment results are written
+	// to the spool first and are synced to the orchestrator every [Config.SpoolSyncInterval]. Results are only removed
+	// from the spool once the orchestrator has stored them.
+	SpoolDirectory string
+	// SpoolSyncInterval is the interval in which spooled assessment results are synced to the orchestrator.
+	SpoolSyncInterval time.Duration
+
+	// Ownership configures how the owner and team of a resource are resolved, if the evidence does not contain
+	// ownership hints.
+	Ownership OwnershipConfig
+
+	// EnvironmentLabels contains the label (or tag) keys of a resource that contain its environment (e.g., prod or
+	// staging), if the evidence does not contain it. The first matching label wins.
+	EnvironmentLabels []string
+
+	// EvidenceMaxAge contains the maximum age of evidences keyed by resource type. Assessment results of older
+	// evidences are stale, so that resources that are no longer collected do not stay compliant. The maximum evidence
+	// age of a metric configuration takes precedence.
+	EvidenceMaxAge map[string]time.Duration
+
+	// CorrelationWindow is the time within which the evidences of the tools that a metric correlates (see
+	// [assessment.EvidenceCorrelation]) need to be collected for a resource. If not set, [DefaultCorrelationWindow] is
+	// used.
+	CorrelationWindow time.Duration
+	// CorrelationEvictionInterval is the interval in which evidences that fell out of the [Config.CorrelationWindow]
+	// are evicted from memory. If not set, evidences are only replaced by newer evidences of the same tool.
+	CorrelationEvictionInterval time.Duration
+
+	// RedactionRules contains static redaction rules, which are applied in addition to the redaction rules managed by
+	// the orchestrator (see [orchestrator.RedactionRule]). The fields matching a rule are redacted from the resources
+	// before they are evaluated, so that they neither reach the policies nor the assessment results. Static rules are
+	// also applied if the metrics are loaded from a local metric bundle.
+	RedactionRules []*orchestrator.RedactionRule
+
+	// PreloadWorkers is the number of metric policies that are prepared concurrently when the service starts, so that
+	// the first assessment after a restart does not need to compile the policies of all metrics. The policies are
+	// prepared for all targets of evaluation. If 0, the policies are only prepared on their first evaluation.
+	PreloadWorkers int
+
+	// Transport configures the message size limits and the compression of the orchestrator client.
+	Transport service.TransportConfig
 }
 
 const (
@@ -89,8 +204,8 @@ type Service struct {
 	assessmentconnect.UnimplementedAssessmentHandler
 
 	orchestratorClient orchestratorconnect.OrchestratorClient
-	orchestratorStream *stream.RestartableBidiStream[orchestrator.StoreAssessmentResultRequest, orchestrator.StoreAssessmentResultsResponse]
-	streamMutex        sync.Mutex
+	// evidenceStoreClient is used to retrieve stored evidences for re-assessment
+	evidenceStoreClient evidenceconnect.EvidenceStoreClient
 
 	// resultHooks is a list of hook functions that can be used if one wants to be
 	// informed about each assessment result
@@ -119,6 +234,22 @@ type Service struct {
 	// pe contains the actual policy evaluation engine we use
 	pe policies.PolicyEval
 
+	// bundle contains the local metric bundle, if the metrics are not retrieved from the orchestrator
+	bundle *metricBundle
+
+	// spool contains the assessment results that still need to be synced to the orchestrator, if spooling is enabled
+	spool *resultSpool
+
+	// toeQueues contains the per target of evaluation queues in which streamed evidences are assessed
+	toeQueues *toeQueues
+
+	// correlations contains the recent evidences of each resource, so that metrics can correlate the evidences of
+	// several tools
+	correlations *correlationBuffer
+
+	// redaction caches the redaction rules managed by the orchestrator
+	redaction redactionCache
+
 	// authz defines our authorization strategy for target-of-evaluation scoped access.
 	authz service.AuthorizationStrategy
 
@@ -129,8 +260,13 @@ type Service struct {
 	subscribers      map[int64]*subscriber
 	subscribersMutex sync.RWMutex
 	nextSubscriberId int64
+
+	// workers runs the periodic jobs of the service between [Service.Start] and [Service.Shutdown]
+	workers service.Workers
 }
 
+var _ service.Lifecycle = (*Service)(nil)
+
 // WithConfig sets the service configuration, overriding the default configuration.
 func WithConfig(cfg Config) service.Option[Service] {
 	return func(svc *Service) {
@@ -175,28 +311,79 @@ func NewService(opts ...service.Option[Service]) (handler assessmentconnect.Asse
 		svc.authz = &service.AuthorizationStrategyAllowAll{}
 	}
 
-	// If service OAuth2 credentials are configured, wrap the HTTP client so all outgoing orchestrator calls authenticate using the client credentials flow. Auth is handled at the transport level rather than via the original request context.
+	// Fall back to the defaults, if the stream of evidences is not configured
+	if svc.cfg.StreamWorkers <= 0 {
+		svc.cfg.StreamWorkers = DefaultStreamWorkers
+	}
+	if svc.cfg.StreamQueueSize <= 0 {
+		svc.cfg.StreamQueueSize = DefaultStreamQueueSize
+	}
+
+	svc.toeQueues = newToEQueues(svc.cfg.ToEWorkers, svc.cfg.ToEQueueSize)
+
+	// Buffer the evidences of each resource, so that metrics can correlate the evidences of several tools
+	if svc.cfg.CorrelationWindow <= 0 {
+		svc.cfg.CorrelationWindow = DefaultCorrelationWindow
+	}
+	svc.correlations = newCorrelationBuffer(svc.cfg.CorrelationWindow)
+
+	// If service OAuth2 credentials are configured, wrap the HTTP clients so all outgoing orchestrator and evidence store calls authenticate using the client credentials flow. Auth is handled at the transport level rather than via the original request context.
 	orchestratorHTTPClient := svc.cfg.OrchestratorHTTPClient
+	evidenceStoreHTTPClient := svc.cfg.EvidenceStoreHTTPClient
+	if evidenceStoreHTTPClient == nil {
+		evidenceStoreHTTPClient = service.DefaultHTTPClient
+	}
 	if svc.cfg.ServiceOAuth2Config != nil {
-		orchestratorHTTPClient = api.NewOAuthHTTPClient(
-			orchestratorHTTPClient,
-			api.NewOAuthAuthorizerFromClientCredentials(svc.cfg.ServiceOAuth2Config),
-		)
+		authorizer := api.NewOAuthAuthorizerFromClientCredentials(svc.cfg.ServiceOAuth2Config)
+		orchestratorHTTPClient = api.NewOAuthHTTPClient(orchestratorHTTPClient, authorizer)
+		evidenceStoreHTTPClient = api.NewOAuthHTTPClient(evidenceStoreHTTPClient, authorizer)
 	}
 
-	// Initialize the policy evaluator with event subscription
-	svc.pe = policies.NewRegoEval(
-		policies.WithPackageName(svc.cfg.RegoPackage),
-		policies.WithEventSubscriber(svc),
-	)
+	// Initialize the policy evaluator, either using an external policy server or the embedded Rego engine with event
+	// subscription
+	if svc.cfg.PolicyServerURL != "" {
+		policyServerHTTPClient := svc.cfg.PolicyServerHTTPClient
+		if policyServerHTTPClient == nil {
+			policyServerHTTPClient = service.DefaultHTTPClient
+		}
+
+		svc.pe = policies.NewHTTPEval(svc.cfg.PolicyServerURL,
+			policies.WithHTTPClient(policyServerHTTPClient),
+			policies.WithPolicyPackage(svc.cfg.RegoPackage),
+		)
+	} else {
+		svc.pe = policies.NewRegoEval(
+			policies.WithPackageName(svc.cfg.RegoPackage),
+			policies.WithEventSubscriber(svc),
+		)
+	}
 
 	// Initialize orchestrator service client
-	svc.orchestratorClient = orchestratorconnect.NewOrchestratorClient(orchestratorHTTPClient, svc.cfg.OrchestratorAddress)
+	svc.orchestratorClient = orchestratorconnect.NewOrchestratorClient(orchestratorHTTPClient, svc.cfg.OrchestratorAddress,
+		svc.cfg.Transport.ClientOptions()...)
 
-	// Initialize the restartable stream for the orchestrator service
-	err = svc.initOrchestratorStream()
-	if err != nil {
-		return nil, err
+	// Initialize evidence store client
+	svc.evidenceStoreClient = evidenceconnect.NewEvidenceStoreClient(evidenceStoreHTTPClient, svc.cfg.EvidenceStoreAddress,
+		svc.cfg.Transport.ClientOptions()...)
+
+	// Load the local metric bundle, if configured
+	if svc.cfg.MetricBundlePath != "" {
+		svc.bundle, err = loadMetricBundle(svc.cfg.MetricBundlePath)
+		if err != nil {
+			return nil, err
+		}
+
+		slog.Info("Using local metric bundle", slog.String("path", svc.cfg.MetricBundlePath))
+	}
+
+	// Spool the assessment results on disk, if configured
+	if svc.cfg.SpoolDirectory != "" {
+		svc.spool, err = newResultSpool(svc.cfg.SpoolDirectory)
+		if err != nil {
+			return nil, err
+		}
+
+		slog.Info("Spooling assessment results", slog.String("directory", svc.cfg.SpoolDirectory))
 	}
 
 	slog.Info("Orchestrator URL is set", slog.String("orchestrator_url", svc.cfg.OrchestratorAddress))
@@ -205,74 +392,165 @@ func NewService(opts ...service.Option[Service]) (handler assessmentconnect.Asse
 	return
 }
 
-func (svc *Service) initOrchestratorStream() (err error) {
-	var (
-		factory           stream.StreamFactory[orchestrator.StoreAssessmentResultRequest, orchestrator.StoreAssessmentResultsResponse]
-		restartableStream *stream.RestartableBidiStream[orchestrator.StoreAssessmentResultRequest, orchestrator.StoreAssessmentResultsResponse]
-	)
-
-	if svc.orchestratorStream != nil {
-		return nil
+// Start starts the periodic jobs of the service, i.e., the eviction of the correlated evidences and the sync of the
+// spooled assessment results, if they are configured. It also starts preloading the metric policies, if the policy
+// evaluation engine supports it. This implements [service.Lifecycle].
+func (svc *Service) Start(_ context.Context) (err error) {
+	if preloader, ok := svc.pe.(policies.Preloader); ok && svc.cfg.PreloadWorkers > 0 {
+		svc.workers.Go(func(ctx context.Context) {
+			svc.preloadMetrics(ctx, preloader)
+		})
 	}
 
-	factory = func(ctx context.Context) *connect.BidiStreamForClient[orchestrator.StoreAssessmentResultRequest, orchestrator.StoreAssessmentResultsResponse] {
-		return svc.orchestratorClient.StoreAssessmentResults(ctx)
+	if svc.cfg.CorrelationEvictionInterval > 0 {
+		svc.workers.Go(svc.evictCorrelationsPeriodically)
 	}
-	restartableStream, err = stream.NewRestartableBidiStream(context.Background(), factory, stream.DefaultRestartConfig(), "StoreAssessmentResults")
-	if err != nil {
-		return err
+
+	if svc.spool != nil {
+		svc.workers.Go(svc.syncSpoolPeriodically)
 	}
-	svc.orchestratorStream = restartableStream
-	return
+
+	return nil
 }
 
+// Shutdown stops the periodic jobs of the service and waits until they returned or ctx is done. This implements
+// [service.Lifecycle].
+func (svc *Service) Shutdown(ctx context.Context) (err error) {
+	return svc.workers.Stop(ctx)
+}
+
+// AssessEvidences is a method implementation of the assessment interface: It assesses multiple evidences (stream) and
+// responds with a stream of assessment statuses. Received evidences are put into a bounded queue and assessed
+// concurrently by [Config.StreamWorkers] workers. Once the queue is full, we stop receiving, which slows down the client
+// by means of the flow control of the underlying HTTP/2 stream. Each worker hands its evidence over to the queue of the
+// evidence's target of evaluation (see [Config.ToEWorkers]), so that the targets of evaluation do not starve each other.
 func (svc *Service) AssessEvidences(ctx context.Context, stream *connect.BidiStream[assessment.AssessEvidenceRequest, assessment.AssessEvidencesResponse]) (err error) {
 	var (
-		req           *assessment.AssessEvidenceRequest
-		res           *assessment.AssessEvidencesResponse
-		assessmentReq *connect.Request[assessment.AssessEvidenceRequest]
-		assessmentRes *connect.Response[assessment.AssessEvidenceResponse]
+		g       *errgroup.Group
+		gctx    context.Context
+		workers sync.WaitGroup
+		queue   = make(chan *assessment.AssessEvidenceRequest, svc.cfg.StreamQueueSize)
+		results = make(chan *assessment.AssessEvidencesResponse, svc.cfg.StreamQueueSize)
 	)
 
-	for {
-		req, err = stream.Receive()
-		// If no more input of the stream is available, return
-		if errors.Is(err, io.EOF) {
-			return nil
-		}
-		if err != nil {
-			err = fmt.Errorf("cannot receive stream request: %w", err)
-			slog.Error("cannot receive stream request", log.Err(err))
-			return connect.NewError(connect.CodeUnknown, err)
+	g, gctx = errgroup.WithContext(ctx)
+
+	// Receive the evidences until the client closes the stream
+	g.Go(func() (err error) {
+		var req *assessment.AssessEvidenceRequest
+
+		defer close(queue)
+
+		for {
+			req, err = stream.Receive()
+			// If no more input of the stream is available, return
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			if err != nil {
+				err = fmt.Errorf("cannot receive stream request: %w", err)
+				slog.Error("cannot receive stream request", log.Err(err))
+				return connect.NewError(connect.CodeAborted, err)
+			}
+
+			slog.Debug("Received evidence for assessment via stream",
+				slog.String("evidence_id", req.Evidence.GetId()),
+				slog.String("tool_id", req.Evidence.GetToolId()))
+
+			// This blocks if the queue is full
+			select {
+			case queue <- req:
+			case <-gctx.Done():
+				return gctx.Err()
+			}
 		}
+	})
 
-		slog.Debug("Received evidence for assessment via stream",
-			slog.String("evidence_id", req.Evidence.GetId()),
-			slog.String("tool_id", req.Evidence.GetToolId()))
+	// Assess the queued evidences
+	for range max(svc.cfg.StreamWorkers, 1) {
+		workers.Add(1)
+		g.Go(func() error {
+			defer workers.Done()
+
+			for req := range queue {
+				var res *assessment.AssessEvidencesResponse
+
+				err := svc.toeQueues.Run(gctx, req.Evidence.GetTargetOfEvaluationId(), func(ctx context.Context) {
+					res = svc.assessStreamedEvidence(ctx, req)
+				})
+				if err != nil {
+					return err
+				}
+
+				select {
+				case results <- res:
+				case <-gctx.Done():
+					return gctx.Err()
+				}
+			}
 
-		assessmentReq = connect.NewRequest(&assessment.AssessEvidenceRequest{
-			Evidence: req.Evidence,
+			return nil
 		})
+	}
 
-		assessmentRes, err = svc.AssessEvidence(ctx, assessmentReq)
-		if err != nil {
-			slog.Error("AssessEvidenceStream: could not assess evidence:", log.Err(err))
-			res = &assessment.AssessEvidencesResponse{
-				Status:        assessment.AssessmentStatus_ASSESSMENT_STATUS_FAILED,
-				StatusMessage: err.Error(),
-			}
-		} else {
-			res = &assessment.AssessEvidencesResponse{
-				Status: assessmentRes.Msg.Status,
+	g.Go(func() error {
+		workers.Wait()
+		close(results)
+		return nil
+	})
+
+	// Send the responses in the order the assessments finish. The stream must only be used by a single sender.
+	g.Go(func() (err error) {
+		for res := range results {
+			err = stream.Send(res)
+			if err != nil {
+				slog.Error("AssessEvidenceStream: could not send response:", log.Err(err))
+				return connect.NewError(connect.CodeAborted, fmt.Errorf("could not send stream response: %w", err))
 			}
 		}
 
-		err = stream.Send(res)
-		if err != nil {
-			slog.Error("AssessEvidenceStream: could not send response:", log.Err(err))
-			return connect.NewError(connect.CodeUnknown, fmt.Errorf("could not send stream response: %w", err))
+		return nil
+	})
+
+	return g.Wait()
+}
+
+// IncompleteCorrelations returns the metrics of resources that are not assessed yet, since they correlate evidences of
+// tools that were not observed within the [Config.CorrelationWindow].
+func (svc *Service) IncompleteCorrelations() []IncompleteCorrelation {
+	return svc.correlations.incompleteCorrelations()
+}
+
+// ToEQueueStats returns the metrics of the assessment queues of all targets of evaluation that had evidences assessed
+// via [Service.AssessEvidences].
+func (svc *Service) ToEQueueStats() []ToEQueueStats {
+	return svc.toeQueues.Stats()
+}
+
+// assessStreamedEvidence assesses a single evidence received by [Service.AssessEvidences] and returns the response to
+// send back to the client.
+func (svc *Service) assessStreamedEvidence(ctx context.Context, req *assessment.AssessEvidenceRequest) (res *assessment.AssessEvidencesResponse) {
+	var (
+		assessmentRes *connect.Response[assessment.AssessEvidenceResponse]
+		err           error
+	)
+
+	assessmentRes, err = svc.AssessEvidence(ctx, connect.NewRequest(&assessment.AssessEvidenceRequest{
+		Evidence: req.Evidence,
+	}))
+	if err != nil {
+		slog.Error("AssessEvidenceStream: could not assess evidence:", log.Err(err))
+		return &assessment.AssessEvidencesResponse{
+			Status:        assessment.AssessmentStatus_ASSESSMENT_STATUS_FAILED,
+			StatusMessage: err.Error(),
+			EvidenceId:    req.Evidence.GetId(),
 		}
 	}
+
+	return &assessment.AssessEvidencesResponse{
+		Status:     assessmentRes.Msg.Status,
+		EvidenceId: req.Evidence.GetId(),
+	}
 }
 
 // AssessEvidence is a method implementation of the assessment interface: It assesses a single evidence
@@ -340,6 +618,11 @@ func (svc *Service) AssessEvidence(ctx context.Context, req *connect.Request[ass
 		res = connect.NewResponse(&assessment.AssessEvidenceResponse{
 			Status: assessment.AssessmentStatus_ASSESSMENT_STATUS_ASSESSED,
 		})
+
+		// Some metrics of the resource might still wait for the evidences of other tools
+		if svc.correlations.pending(resource.GetId()) {
+			res.Msg.Status = assessment.AssessmentStatus_ASSESSMENT_STATUS_WAITING_FOR_CORRELATION
+		}
 	} else {
 		slog.Debug("Evidence needs to wait for more resource(s) to assess evidence", slog.Any("evidence", ev), slog.Int("waitingFor", len(waitingFor)))
 
@@ -389,7 +672,12 @@ func (svc *Service) handleEvidence(
 		evaluations []*policies.CombinedResult
 		newError    error
 		metricID    string
+		correlated  map[string]ontology.IsResource
 		result      *assessment.AssessmentResult
+		rules       []*orchestrator.RedactionRule
+		owner       *string
+		team        *string
+		environment *string
 	)
 
 	if resource == nil {
@@ -403,7 +691,23 @@ func (svc *Service) handleEvidence(
 		slog.Any("Timestamp", ev.Timestamp.AsTime()),
 	)
 
-	evaluations, err = svc.pe.Eval(ctx, ev, resource, related, svc)
+	// Redact the sensitive fields of all resources before they are evaluated, so that they are neither visible to the
+	// policies nor contained in the assessment results
+	rules, err = svc.redactionRules(ctx)
+	if err != nil {
+		newError = fmt.Errorf("could not redact resources: %w", err)
+
+		go svc.informHooks(ctx, nil, newError)
+
+		return nil, connect.NewError(connect.CodeInternal, newError)
+	}
+	resource = redact(resource, rules)
+	related = redactResources(related, rules)
+
+	// Correlate the evidence with the recent evidences of other tools for the same resource
+	correlated = redactResources(svc.correlations.add(ev, resource.GetId()), rules)
+
+	evaluations, err = svc.pe.Eval(ctx, ev, resource, related, correlated, svc)
 	if err != nil {
 		newError = fmt.Errorf("could not evaluate evidence: %w", err)
 
@@ -417,6 +721,12 @@ func (svc *Service) handleEvidence(
 		return results, nil
 	}
 
+	// Resolve the ownership of the resource, so that findings can be routed to the owning team
+	owner, team = svc.cfg.Ownership.resolveOwnership(ev, resource)
+
+	// Resolve the environment of the resource, so that audit scopes can be restricted to it
+	environment = resolveEnvironment(ev, resource, svc.cfg.EnvironmentLabels)
+
 	for _, data := range evaluations {
 		// That there is an empty (nil) evaluation should be caught beforehand, but you never know.
 		if data == nil {
@@ -425,6 +735,12 @@ func (svc *Service) handleEvidence(
 		}
 		metricID = data.MetricID
 
+		// Hold back the result of a metric that correlates evidences of tools that were not observed yet
+		if !svc.correlations.complete(resource.GetId(), metricID, data.Correlation, ev.GetToolId(), correlated, ev.GetTimestamp().AsTime()) {
+			slog.Debug("Metric waits for correlated evidences", slog.String("Evidence", ev.Id), slog.String("MetricID", metricID))
+			continue
+		}
+
 		slog.Debug("Evaluated evidence with metric", slog.String("Evidence", ev.Id), slog.String("MetricID", metricID), slog.Bool("Compliant", data.Compliant))
 
 		types = ontology.ResourceTypes(resource)
@@ -447,28 +763,68 @@ func (svc *Service) handleEvidence(
 				EvidenceId:         ev.GetId(),
 				EvidenceRecordedAt: timestamppb.Now(),
 			}},
+			Owner:       owner,
+			Team:        team,
+			Environment: environment,
+			StaleAt:     staleAt(ev.GetTimestamp().AsTime(), data.Config, types, svc.cfg.EvidenceMaxAge),
 		}
 
 		// Inform hooks about new assessment result
 		go svc.informHooks(ctx, result, nil)
 
-		svc.streamMutex.Lock()
-		err = svc.orchestratorStream.Send(&orchestrator.StoreAssessmentResultRequest{
-			Result: result,
-		})
-		svc.streamMutex.Unlock()
-
-		if err != nil {
-			slog.Error("Failed to send assessment result to orchestrator", log.Err(err))
-			go svc.informHooks(ctx, nil, fmt.Errorf("failed to send result: %w", err))
+		if svc.spool != nil {
+			// The result is synced to the orchestrator later on
+			err = svc.spool.Add(result)
+			if err != nil {
+				slog.Error("Failed to spool assessment result", log.Err(err))
+				go svc.informHooks(ctx, nil, fmt.Errorf("failed to spool result: %w", err))
+			}
 		}
 
 		results = append(results, result)
 	}
 
+	// Send the results of the evidence to the orchestrator at once, unless they are synced from the spool
+	if svc.spool == nil && len(results) > 0 {
+		svc.storeResults(ctx, results)
+	}
+
 	return results, nil
 }
 
+// storeResults stores the assessment results in the orchestrator using BatchStoreAssessmentResults. Since the results
+// are assessed already, errors are not returned but logged and reported to the hooks.
+func (svc *Service) storeResults(ctx context.Context, results []*assessment.AssessmentResult) {
+	var (
+		res *connect.Response[orchestrator.BatchStoreAssessmentResultsResponse]
+		err error
+	)
+
+	// The results should be stored, even if the client of the evidence is gone in the meantime
+	ctx = context.WithoutCancel(ctx)
+
+	for batch := range slices.Chunk(results, maxResultBatchSize) {
+		res, err = svc.orchestratorClient.BatchStoreAssessmentResults(ctx,
+			connect.NewRequest(&orchestrator.BatchStoreAssessmentResultsRequest{Results: batch}))
+		if err != nil {
+			slog.Error("Failed to send assessment results to orchestrator", slog.Int("results", len(batch)), log.Err(err))
+			go svc.informHooks(ctx, nil, fmt.Errorf("failed to send results: %w", err))
+			continue
+		}
+
+		for i, status := range res.Msg.GetResults() {
+			if status.GetStatus() || i >= len(batch) {
+				continue
+			}
+
+			slog.Error("Orchestrator did not store assessment result",
+				slog.String("assessment_result_id", batch[i].GetId()),
+				slog.String("status", status.GetStatusMessage()))
+			go svc.informHooks(ctx, nil, fmt.Errorf("failed to store result %s: %s", batch[i].GetId(), status.GetStatusMessage()))
+		}
+	}
+}
+
 // informHooks informs the registered hook functions
 func (svc *Service) informHooks(ctx context.Context, result *assessment.AssessmentResult, err error) {
 	var (
@@ -494,8 +850,12 @@ func (svc *Service) RegisterAssessmentResultHook(assessmentResultsHook func(ctx
 	svc.resultHooks = append(svc.resultHooks, assessmentResultsHook)
 }
 
-// Metrics implements MetricsSource by retrieving the metric list from the orchestrator.
+// Metrics implements MetricsSource by retrieving the metric list from the orchestrator or the local metric bundle.
 func (svc *Service) Metrics(ctx context.Context) (metrics []*assessment.Metric, err error) {
+	if svc.bundle != nil {
+		return svc.bundle.Metrics(ctx)
+	}
+
 	metrics, err = api.ListAllPaginated(context.Background(), &orchestrator.ListMetricsRequest{}, func(ctx context.Context, req *orchestrator.ListMetricsRequest) (*orchestrator.ListMetricsResponse, error) {
 		res, err := svc.orchestratorClient.ListMetrics(ctx, connect.NewRequest(req))
 		if err != nil {
@@ -513,8 +873,12 @@ func (svc *Service) Metrics(ctx context.Context) (metrics []*assessment.Metric,
 }
 
 // MetricImplementation implements MetricsSource by retrieving the metric implementation
-// from the orchestrator.
+// from the orchestrator or the local metric bundle.
 func (svc *Service) MetricImplementation(ctx context.Context, lang assessment.MetricImplementation_Language, metric *assessment.Metric) (impl *assessment.MetricImplementation, err error) {
+	if svc.bundle != nil {
+		return svc.bundle.MetricImplementation(ctx, lang, metric)
+	}
+
 	if lang != assessment.MetricImplementation_LANGUAGE_REGO {
 		return nil, errors.New("unsupported language")
 	}
@@ -533,7 +897,7 @@ func (svc *Service) MetricImplementation(ctx context.Context, lang assessment.Me
 }
 
 // MetricConfiguration implements MetricsSource by getting the corresponding metric configuration for the
-// given target of evaluation
+// given target of evaluation from the orchestrator or the local metric bundle.
 func (svc *Service) MetricConfiguration(ctx context.Context, TargetOfEvaluationID string, metric *assessment.Metric) (config *assessment.MetricConfiguration, err error) {
 	var (
 		ok    bool
@@ -543,6 +907,10 @@ func (svc *Service) MetricConfiguration(ctx context.Context, TargetOfEvaluationI
 		resp  *connect.Response[assessment.MetricConfiguration]
 	)
 
+	if svc.bundle != nil {
+		return svc.bundle.MetricConfiguration(ctx, TargetOfEvaluationID, metric)
+	}
+
 	// Calculate the cache key
 	key = fmt.Sprintf("%s-%s", TargetOfEvaluationID, metric.Id)
 
@@ -634,3 +1002,63 @@ func (svc *Service) publishEvent(event *orchestrator.ChangeEvent) {
 		}
 	}
 }
+
+// syncSpoolPeriodically syncs the spooled assessment results to the orchestrator every [Config.SpoolSyncInterval]
+// until ctx is done.
+func (svc *Service) syncSpoolPeriodically(ctx context.Context) {
+	var ticker = time.NewTicker(max(svc.cfg.SpoolSyncInterval, time.Second))
+
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			svc.syncSpool(ctx)
+		}
+	}
+}
+
+// syncSpool syncs the spooled assessment results to the orchestrator. If the orchestrator is not reachable, the
+// remaining results are kept in the spool and retried on the next sync.
+func (svc *Service) syncSpool(ctx context.Context) {
+	var (
+		n   int
+		err error
+	)
+
+	n, err = svc.spool.Sync(ctx, func(ctx context.Context, result *assessment.AssessmentResult) (err error) {
+		_, err = svc.orchestratorClient.StoreAssessmentResult(ctx, connect.NewRequest(&orchestrator.StoreAssessmentResultRequest{
+			Result: result,
+		}))
+		// The result has already been stored by a previous, interrupted sync
+		if connect.CodeOf(err) == connect.CodeAlreadyExists {
+			return nil
+		}
+
+		return err
+	})
+	if err != nil {
+		slog.Warn("Could not sync all spooled assessment results, retrying later", slog.Int("synced", n), log.Err(err))
+	} else if n > 0 {
+		slog.Info("Synced spooled assessment results", slog.Int("synced", n))
+	}
+}
+
+// evictCorrelationsPeriodically evicts the evidences that fell out of the [Config.CorrelationWindow] every
+// [Config.CorrelationEvictionInterval] until ctx is done.
+func (svc *Service) evictCorrelationsPeriodically(ctx context.Context) {
+	var ticker = time.NewTicker(max(svc.cfg.CorrelationEvictionInterval, time.Second))
+
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			svc.correlations.evict(time.Now())
+		}
+	}
+}
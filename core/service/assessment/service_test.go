@@ -18,11 +18,13 @@ package assessment
 import (
 	"context"
 	"fmt"
+	"io"
 	"log/slog"
 	"net/http"
 	"os"
 	"reflect"
 	"runtime"
+	"slices"
 	"sync"
 	"testing"
 	"time"
@@ -39,6 +41,7 @@ import (
 	"confirmate.io/core/persistence"
 	"confirmate.io/core/policies"
 
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/structpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -49,12 +52,16 @@ import (
 	"confirmate.io/core/service"
 	"confirmate.io/core/service/evidence/evidencetest"
 	"confirmate.io/core/service/orchestrator"
+	"confirmate.io/core/service/orchestrator/orchestratortest"
 	"confirmate.io/core/util/assert"
 	"confirmate.io/core/util/clitest"
 	"confirmate.io/core/util/prototest"
 )
 
-type denyAssessmentAuthorizationStrategy struct{}
+// denyAssessmentAuthorizationStrategy denies all access checks.
+type denyAssessmentAuthorizationStrategy struct {
+	service.AuthorizationStrategyAllowAll
+}
 
 func (*denyAssessmentAuthorizationStrategy) CheckAccess(_ context.Context, _ string, _ apiOrch.RequestType, _ apiOrch.UserPermission_Permission, _ string, _ apiOrch.ObjectType) (bool, []string) {
 	return false, nil
@@ -106,6 +113,22 @@ func TestNewService(t *testing.T) {
 				return assert.Equal(t, "localhost:9092", got.cfg.OrchestratorAddress)
 			},
 		},
+		{
+			name: "AssessmentServer created with default stream configuration",
+			args: args{
+				opts: []service.Option[Service]{
+					WithConfig(Config{
+						OrchestratorAddress:    DefaultOrchestratorURL,
+						OrchestratorHTTPClient: http.DefaultClient,
+						RegoPackage:            policies.DefaultRegoPackage,
+					}),
+				},
+			},
+			want: func(t *testing.T, got *Service, msgAndArgs ...any) bool {
+				return assert.Equal(t, DefaultStreamWorkers, got.cfg.StreamWorkers) &&
+					assert.Equal(t, DefaultStreamQueueSize, got.cfg.StreamQueueSize)
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -512,13 +535,6 @@ func TestService_AssessEvidences(t *testing.T) {
 			assert.NoError(t, err)
 			s := aHandler.(*Service)
 
-			streamHandle := s.orchestratorStream
-			t.Cleanup(func() {
-				if streamHandle != nil {
-					_ = streamHandle.Close()
-				}
-			})
-
 			_, assSrv := servertest.NewTestConnectServer(t,
 				server.WithHandler(assessmentconnect.NewAssessmentHandler(s)),
 			)
@@ -555,6 +571,66 @@ func TestService_AssessEvidences(t *testing.T) {
 	}
 }
 
+// TestService_AssessEvidences_Concurrent tests that evidences sent without waiting for their responses are all
+// assessed, even if the queue is smaller than the number of evidences, and that each response refers to its evidence.
+func TestService_AssessEvidences_Concurrent(t *testing.T) {
+	var (
+		want []string
+		got  []string
+	)
+
+	svc := &Service{
+		cfg: Config{
+			StreamQueueSize: 2,
+			StreamWorkers:   4,
+		},
+		toeQueues: newToEQueues(2, 2),
+	}
+
+	_, srv := servertest.NewTestConnectServer(t,
+		server.WithHandler(assessmentconnect.NewAssessmentHandler(svc)),
+	)
+	t.Cleanup(srv.Close)
+
+	client := assessmentconnect.NewAssessmentClient(srv.Client(), srv.URL)
+	stream := client.AssessEvidences(context.Background())
+
+	// Evidences without a tool ID fail the validation, so we do not need an orchestrator
+	for range 20 {
+		id := uuid.NewString()
+		err := stream.Send(&assessment.AssessEvidenceRequest{
+			Evidence: &evidence.Evidence{
+				Id:                   id,
+				Timestamp:            timestamppb.Now(),
+				TargetOfEvaluationId: evidencetest.MockTargetOfEvaluationID1,
+				Resource:             prototest.NewProtobufResource(t, &ontology.VirtualMachine{Id: evidencetest.MockVirtualMachineID1}),
+			},
+		})
+		assert.NoError(t, err)
+		want = append(want, id)
+	}
+
+	err := stream.CloseRequest()
+	assert.NoError(t, err)
+
+	for {
+		res, err := stream.Receive()
+		if err != nil {
+			assert.ErrorIs(t, err, io.EOF)
+			break
+		}
+		assert.Equal(t, assessment.AssessmentStatus_ASSESSMENT_STATUS_FAILED, res.GetStatus())
+		got = append(got, res.GetEvidenceId())
+	}
+
+	assert.NoError(t, stream.CloseResponse())
+
+	// Responses are sent in the order the assessments finish
+	slices.Sort(want)
+	slices.Sort(got)
+	assert.Equal(t, want, got)
+}
+
 func TestService_handleEvidence(t *testing.T) {
 	type args struct {
 		evidence *evidence.Evidence
@@ -726,14 +802,6 @@ func TestService_handleEvidence(t *testing.T) {
 			assert.NoError(t, err)
 			s := aHandler.(*Service)
 
-			// Cleanup orchestrator stream
-			streamHandle := s.orchestratorStream
-			t.Cleanup(func() {
-				if streamHandle != nil {
-					_ = streamHandle.Close()
-				}
-			})
-
 			res, err := s.handleEvidence(context.Background(), tt.args.evidence, tt.args.resource, tt.args.related)
 			tt.want(t, res)
 			tt.wantErr(t, err)
@@ -782,14 +850,6 @@ func TestService_AssessEvidence_DetectMisconfiguredEvidenceEvenWhenAlreadyCached
 	assert.NoError(t, err)
 	s := aHandler.(*Service)
 
-	// Cleanup orchestrator stream before server cleanup
-	streamHandle := s.orchestratorStream
-	t.Cleanup(func() {
-		if streamHandle != nil {
-			_ = streamHandle.Close()
-		}
-	})
-
 	// Create metric
 	metric = &assessment.Metric{
 		Id:          "bb41142b-ce8c-4c5c-9b42-360f015fd325",
@@ -960,14 +1020,6 @@ func TestService_AssessmentResultHooks(t *testing.T) {
 			assert.NoError(t, err)
 			s := aHandler.(*Service)
 
-			// Cleanup orchestrator stream before server cleanup
-			streamHandle := s.orchestratorStream
-			t.Cleanup(func() {
-				if streamHandle != nil {
-					_ = streamHandle.Close()
-				}
-			})
-
 			// Create metric
 			metric = &assessment.Metric{
 				Id:          "bb41142b-ce8c-4c5c-9b42-360f015fd325",
@@ -1004,6 +1056,44 @@ func TestService_AssessmentResultHooks(t *testing.T) {
 	}
 }
 
+// TestService_storeResults tests that results are stored in a single batch and that hooks are informed about results
+// that could not be stored
+func TestService_storeResults(t *testing.T) {
+	var (
+		orchSvc     = newTestOrchestratorService(t)
+		client, url = setupOrchestratorServer(t, orchSvc)
+		errs        = make(chan error, 1)
+		valid       = proto.Clone(orchestratortest.MockAssessmentResult1).(*assessment.AssessmentResult)
+		invalid     = proto.Clone(orchestratortest.MockAssessmentResult1).(*assessment.AssessmentResult)
+		svc         = &Service{orchestratorClient: orchestratorconnect.NewOrchestratorClient(client, url)}
+	)
+
+	valid.Id = uuid.NewString()
+	invalid.Id = uuid.NewString()
+	invalid.MetricId = ""
+
+	svc.RegisterAssessmentResultHook(func(ctx context.Context, result *assessment.AssessmentResult, err error) {
+		errs <- err
+	})
+
+	// Both results are sent in a single batch, of which only the valid one is stored
+	svc.storeResults(context.Background(), []*assessment.AssessmentResult{valid, invalid})
+
+	res, err := orchSvc.GetAssessmentResult(context.Background(), connect.NewRequest(&apiOrch.GetAssessmentResultRequest{Id: valid.Id}))
+	assert.NoError(t, err)
+	assert.Equal(t, valid.Id, res.Msg.GetId())
+
+	_, err = orchSvc.GetAssessmentResult(context.Background(), connect.NewRequest(&apiOrch.GetAssessmentResultRequest{Id: invalid.Id}))
+	assert.IsConnectError(t, err, connect.CodeNotFound)
+
+	select {
+	case err = <-errs:
+		assert.ErrorContains(t, err, invalid.Id)
+	case <-time.After(time.Second):
+		t.Fatal("hook was not informed about the result that was not stored")
+	}
+}
+
 // TestService_Metrics tests the Metrics() method
 func TestService_Metrics(t *testing.T) {
 	tests := []struct {
@@ -1358,49 +1448,6 @@ func TestService_MetricConfiguration(t *testing.T) {
 	}
 }
 
-// TestService_initOrchestratorStream tests the orchestrator stream initialization
-func TestService_initOrchestratorStream(t *testing.T) {
-	tests := []struct {
-		name    string
-		wantErr assert.WantErr
-	}{
-		{
-			name:    "Successfully initialize stream",
-			wantErr: assert.NoError,
-		},
-	}
-
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			// Setup orchestrator
-			orchSvc := newTestOrchestratorService(t)
-			testClient, url := setupOrchestratorServer(t, orchSvc)
-
-			// Create service
-			assSvc := &Service{
-				cfg: Config{
-					OrchestratorAddress:    url,
-					OrchestratorHTTPClient: testClient,
-					RegoPackage:            policies.DefaultRegoPackage,
-				},
-			}
-
-			assSvc.orchestratorClient = orchestratorconnect.NewOrchestratorClient(
-				assSvc.cfg.OrchestratorHTTPClient,
-				assSvc.cfg.OrchestratorAddress,
-			)
-
-			// Execute test
-			err := assSvc.initOrchestratorStream()
-			tt.wantErr(t, err)
-
-			if err == nil {
-				assert.NotNil(t, assSvc.orchestratorStream)
-			}
-		})
-	}
-}
-
 // TestService_informHooks tests the informHooks method
 func TestService_informHooks(t *testing.T) {
 	tests := []struct {
@@ -0,0 +1,149 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package assessment
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"confirmate.io/core/api/assessment"
+	"confirmate.io/core/log"
+
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const (
+	// spoolFileExt is the extension of the files in the spool that contain an assessment result.
+	spoolFileExt = ".json"
+	// corruptFileExt is appended to spool files that cannot be parsed, so that they are kept for inspection but no
+	// longer block the sync.
+	corruptFileExt = ".corrupt"
+)
+
+// resultSpool is a directory-backed queue of assessment results that still need to be stored by the orchestrator. Each
+// result is kept in its own file, so that results survive a restart of the assessment service.
+type resultSpool struct {
+	dir string
+
+	// mu ensures that only one sync is running at a time
+	mu sync.Mutex
+}
+
+// newResultSpool creates a spool in the given directory. The directory is created, if it does not exist yet.
+func newResultSpool(dir string) (s *resultSpool, err error) {
+	err = os.MkdirAll(dir, 0o700)
+	if err != nil {
+		return nil, fmt.Errorf("could not create spool directory: %w", err)
+	}
+
+	return &resultSpool{dir: dir}, nil
+}
+
+// Add writes the assessment result to the spool. The file name starts with the current time, so that results are
+// synced in the order they were added.
+func (s *resultSpool) Add(result *assessment.AssessmentResult) (err error) {
+	var (
+		data []byte
+		name string
+		tmp  string
+	)
+
+	data, err = protojson.Marshal(result)
+	if err != nil {
+		return fmt.Errorf("could not marshal assessment result: %w", err)
+	}
+
+	// Write to a temporary file first, so that a sync never picks up a partially written result
+	name = filepath.Join(s.dir, fmt.Sprintf("%020d-%s%s", time.Now().UnixNano(), result.GetId(), spoolFileExt))
+	tmp = name + ".tmp"
+
+	err = os.WriteFile(tmp, data, 0o600)
+	if err != nil {
+		return fmt.Errorf("could not write spool file: %w", err)
+	}
+
+	err = os.Rename(tmp, name)
+	if err != nil {
+		return fmt.Errorf("could not write spool file: %w", err)
+	}
+
+	return nil
+}
+
+// Sync stores the spooled assessment results in the order they were added using the given store function and removes
+// them from the spool afterwards. It stops at the first result that cannot be stored, e.g., because the orchestrator
+// is not reachable, and returns the number of results that were synced.
+func (s *resultSpool) Sync(ctx context.Context, store func(ctx context.Context, result *assessment.AssessmentResult) error) (n int, err error) {
+	var (
+		entries []os.DirEntry
+		data    []byte
+		path    string
+	)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// The entries are sorted by their name and thus by the time they were added
+	entries, err = os.ReadDir(s.dir)
+	if err != nil {
+		return 0, fmt.Errorf("could not read spool directory: %w", err)
+	}
+
+	for _, entry := range entries {
+		var result assessment.AssessmentResult
+
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), spoolFileExt) {
+			continue
+		}
+
+		path = filepath.Join(s.dir, entry.Name())
+
+		data, err = os.ReadFile(path)
+		if err != nil {
+			return n, fmt.Errorf("could not read spool file: %w", err)
+		}
+
+		err = protojson.Unmarshal(data, &result)
+		if err != nil {
+			slog.Warn("Could not parse spooled assessment result, skipping it", slog.String("file", path), log.Err(err))
+
+			if err = os.Rename(path, path+corruptFileExt); err != nil {
+				return n, fmt.Errorf("could not move corrupt spool file: %w", err)
+			}
+			continue
+		}
+
+		err = store(ctx, &result)
+		if err != nil {
+			return n, fmt.Errorf("could not store spooled assessment result: %w", err)
+		}
+
+		err = os.Remove(path)
+		if err != nil {
+			return n, fmt.Errorf("could not remove spool file: %w", err)
+		}
+
+		n++
+	}
+
+	return n, nil
+}
@@ -0,0 +1,71 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package assessment
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"confirmate.io/core/api/assessment"
+	"confirmate.io/core/util/assert"
+)
+
+func Test_resultSpool_Sync(t *testing.T) {
+	var (
+		spool  *resultSpool
+		stored []string
+		err    error
+		n      int
+	)
+
+	spool, err = newResultSpool(filepath.Join(t.TempDir(), "spool"))
+	assert.NoError(t, err)
+
+	assert.NoError(t, spool.Add(&assessment.AssessmentResult{Id: "result-1"}))
+	assert.NoError(t, spool.Add(&assessment.AssessmentResult{Id: "result-2"}))
+	assert.NoError(t, os.WriteFile(filepath.Join(spool.dir, "00000000000000000000-corrupt.json"), []byte("{"), 0o600))
+
+	// The orchestrator is only reachable for the first result
+	n, err = spool.Sync(context.Background(), func(_ context.Context, result *assessment.AssessmentResult) error {
+		if len(stored) == 1 {
+			return errors.New("connection refused")
+		}
+
+		stored = append(stored, result.Id)
+		return nil
+	})
+	assert.ErrorContains(t, err, "connection refused")
+	assert.Equal(t, 1, n)
+	assert.Equal(t, []string{"result-1"}, stored)
+
+	// Once the orchestrator is reachable again, the remaining result is synced
+	n, err = spool.Sync(context.Background(), func(_ context.Context, result *assessment.AssessmentResult) error {
+		stored = append(stored, result.Id)
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, n)
+	assert.Equal(t, []string{"result-1", "result-2"}, stored)
+
+	// Only the corrupt result is left in the spool
+	entries, err := os.ReadDir(spool.dir)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(entries))
+	assert.Equal(t, "00000000000000000000-corrupt.json"+corruptFileExt, entries[0].Name())
+}
@@ -0,0 +1,51 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package assessment
+
+import (
+	"time"
+
+	"confirmate.io/core/api/assessment"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// staleAt returns the time at which an assessment result becomes stale, given the time its evidence was collected. The
+// maximum evidence age of the metric configuration takes precedence over the maximum evidence ages of the resource
+// types (see [Config.EvidenceMaxAge]). If multiple resource types of the resource have a maximum evidence age, the
+// shortest one is used. If no maximum evidence age is configured, the result does not become stale and nil is
+// returned.
+func staleAt(collected time.Time, config *assessment.MetricConfiguration, types []string, maxAges map[string]time.Duration) *timestamppb.Timestamp {
+	var (
+		maxAge time.Duration
+	)
+
+	if config.MaxEvidenceAgeHours != nil {
+		maxAge = time.Duration(config.GetMaxEvidenceAgeHours()) * time.Hour
+	} else {
+		for _, typ := range types {
+			if age, ok := maxAges[typ]; ok && age > 0 && (maxAge == 0 || age < maxAge) {
+				maxAge = age
+			}
+		}
+	}
+
+	if maxAge == 0 {
+		return nil
+	}
+
+	return timestamppb.New(collected.Add(maxAge))
+}
@@ -0,0 +1,89 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package assessment
+
+import (
+	"testing"
+	"time"
+
+	"confirmate.io/core/api/assessment"
+	"confirmate.io/core/util/assert"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func Test_staleAt(t *testing.T) {
+	var (
+		collected = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+		maxAges   = map[string]time.Duration{
+			"VirtualMachine": 72 * time.Hour,
+			"Resource":       7 * 24 * time.Hour,
+		}
+	)
+
+	type args struct {
+		config  *assessment.MetricConfiguration
+		types   []string
+		maxAges map[string]time.Duration
+	}
+	tests := []struct {
+		name string
+		args args
+		want *timestamppb.Timestamp
+	}{
+		{
+			name: "no maximum evidence age",
+			args: args{
+				config: &assessment.MetricConfiguration{},
+				types:  []string{"VirtualMachine", "Resource"},
+			},
+			want: nil,
+		},
+		{
+			name: "resource type without maximum evidence age",
+			args: args{
+				config:  &assessment.MetricConfiguration{},
+				types:   []string{"ObjectStorage"},
+				maxAges: maxAges,
+			},
+			want: nil,
+		},
+		{
+			name: "shortest maximum evidence age of the resource types",
+			args: args{
+				config:  &assessment.MetricConfiguration{},
+				types:   []string{"VirtualMachine", "Resource"},
+				maxAges: maxAges,
+			},
+			want: timestamppb.New(collected.Add(72 * time.Hour)),
+		},
+		{
+			name: "metric configuration takes precedence",
+			args: args{
+				config:  &assessment.MetricConfiguration{MaxEvidenceAgeHours: new(uint32(240))},
+				types:   []string{"VirtualMachine", "Resource"},
+				maxAges: maxAges,
+			},
+			want: timestamppb.New(collected.Add(240 * time.Hour)),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := staleAt(collected, tt.args.config, tt.args.types, tt.args.maxAges)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
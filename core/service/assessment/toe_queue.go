@@ -0,0 +1,215 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package assessment
+
+import (
+	"cmp"
+	"context"
+	"log/slog"
+	"slices"
+	"sync"
+	"time"
+)
+
+// ToEQueueStats contains the metrics of the assessment queue of a single target of evaluation.
+type ToEQueueStats struct {
+	// TargetOfEvaluationId is the ID of the target of evaluation.
+	TargetOfEvaluationId string
+	// Queued is the number of evidences waiting for a worker, including the ones waiting for space in the queue.
+	Queued int
+	// InFlight is the number of evidences that are currently assessed.
+	InFlight int
+	// Assessed is the total number of evidences that were assessed.
+	Assessed uint64
+	// Cancelled is the total number of evidences that were dropped from the queue, because their stream was closed
+	// before a worker picked them up.
+	Cancelled uint64
+	// QueueWait is the total time the assessed evidences waited in the queue.
+	QueueWait time.Duration
+}
+
+// toeJob is a single assessment in the queue of a target of evaluation.
+type toeJob struct {
+	ctx      context.Context
+	fn       func(ctx context.Context)
+	queuedAt time.Time
+
+	// err contains the reason why fn was not executed. It must only be read after done is closed.
+	err  error
+	done chan struct{}
+}
+
+// toeQueue is the queue of a single target of evaluation together with its workers.
+type toeQueue struct {
+	jobs chan *toeJob
+
+	// pending is the number of jobs that are queued, in flight or waiting to be queued. Once it drops to zero, the
+	// queue is closed and its workers exit.
+	pending int
+}
+
+// toeQueues isolates the assessments of the different targets of evaluation from each other. Each target of
+// evaluation has its own bounded queue and its own workers, so that a target of evaluation with a large collector run
+// cannot starve the assessment of the evidences of other targets of evaluation. Queues and workers are created on
+// demand and are removed once they are idle.
+type toeQueues struct {
+	workers   int
+	queueSize int
+
+	queues map[string]*toeQueue
+	stats  map[string]*ToEQueueStats
+	mu     sync.Mutex
+}
+
+// newToEQueues creates a new [toeQueues] with the given number of workers and queue size per target of evaluation.
+func newToEQueues(workers int, queueSize int) *toeQueues {
+	return &toeQueues{
+		workers:   max(workers, 1),
+		queueSize: max(queueSize, 0),
+		queues:    make(map[string]*toeQueue),
+		stats:     make(map[string]*ToEQueueStats),
+	}
+}
+
+// Run queues fn for the target of evaluation and blocks until fn has been executed by one of its workers. If the
+// queue of the target of evaluation is full, Run waits for space in the queue. If ctx is done before a worker picked
+// up fn, fn is not executed and the error of ctx is returned.
+func (q *toeQueues) Run(ctx context.Context, toeId string, fn func(ctx context.Context)) (err error) {
+	var (
+		queue *toeQueue
+		job   *toeJob
+	)
+
+	job = &toeJob{
+		ctx:      ctx,
+		fn:       fn,
+		queuedAt: time.Now(),
+		done:     make(chan struct{}),
+	}
+
+	queue = q.acquire(toeId)
+
+	select {
+	case queue.jobs <- job:
+	default:
+		slog.Debug("Assessment queue of target of evaluation is full",
+			slog.String("target_of_evaluation_id", toeId),
+			slog.Int("queue_size", q.queueSize))
+
+		select {
+		case queue.jobs <- job:
+		case <-ctx.Done():
+			q.mu.Lock()
+			q.stats[toeId].Queued--
+			q.stats[toeId].Cancelled++
+			q.releaseLocked(toeId, queue)
+			q.mu.Unlock()
+
+			return ctx.Err()
+		}
+	}
+
+	<-job.done
+
+	return job.err
+}
+
+// Stats returns the metrics of all targets of evaluation that had evidences assessed so far, sorted by the ID of the
+// target of evaluation.
+func (q *toeQueues) Stats() (stats []ToEQueueStats) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	stats = make([]ToEQueueStats, 0, len(q.stats))
+	for _, s := range q.stats {
+		stats = append(stats, *s)
+	}
+
+	slices.SortFunc(stats, func(a, b ToEQueueStats) int {
+		return cmp.Compare(a.TargetOfEvaluationId, b.TargetOfEvaluationId)
+	})
+
+	return
+}
+
+// acquire returns the queue of the target of evaluation and registers a pending job. If the target of evaluation has
+// no queue yet, the queue and its workers are started.
+func (q *toeQueues) acquire(toeId string) (queue *toeQueue) {
+	var ok bool
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	queue, ok = q.queues[toeId]
+	if !ok {
+		queue = &toeQueue{jobs: make(chan *toeJob, q.queueSize)}
+		q.queues[toeId] = queue
+
+		for range q.workers {
+			go q.work(toeId, queue)
+		}
+	}
+
+	if _, ok = q.stats[toeId]; !ok {
+		q.stats[toeId] = &ToEQueueStats{TargetOfEvaluationId: toeId}
+	}
+
+	queue.pending++
+	q.stats[toeId].Queued++
+
+	return
+}
+
+// releaseLocked removes a pending job from the queue of the target of evaluation. If the queue has no pending jobs
+// left, it is closed, which stops its workers. The caller must hold q.mu.
+func (q *toeQueues) releaseLocked(toeId string, queue *toeQueue) {
+	queue.pending--
+	if queue.pending == 0 {
+		delete(q.queues, toeId)
+		close(queue.jobs)
+	}
+}
+
+// work executes the jobs of the queue of the target of evaluation until the queue is closed.
+func (q *toeQueues) work(toeId string, queue *toeQueue) {
+	for job := range queue.jobs {
+		q.mu.Lock()
+		stats := q.stats[toeId]
+		stats.Queued--
+		job.err = job.ctx.Err()
+		if job.err != nil {
+			stats.Cancelled++
+		} else {
+			stats.InFlight++
+			stats.QueueWait += time.Since(job.queuedAt)
+		}
+		q.mu.Unlock()
+
+		if job.err == nil {
+			job.fn(job.ctx)
+		}
+
+		q.mu.Lock()
+		if job.err == nil {
+			stats.InFlight--
+			stats.Assessed++
+		}
+		q.releaseLocked(toeId, queue)
+		q.mu.Unlock()
+
+		close(job.done)
+	}
+}
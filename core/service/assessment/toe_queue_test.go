@@ -0,0 +1,107 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package assessment
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"confirmate.io/core/util/assert"
+)
+
+func Test_toeQueues_Run(t *testing.T) {
+	var (
+		q       *toeQueues
+		release = make(chan struct{})
+		started = make(chan struct{}, 2)
+		wg      sync.WaitGroup
+		err     error
+	)
+
+	q = newToEQueues(1, 1)
+
+	// Occupy the only worker of the first target of evaluation and fill up its queue
+	for range 2 {
+		wg.Go(func() {
+			assert.NoError(t, q.Run(context.Background(), "toe-1", func(context.Context) {
+				started <- struct{}{}
+				<-release
+			}))
+		})
+	}
+	<-started
+
+	// The second target of evaluation is not blocked by the first one
+	err = q.Run(context.Background(), "toe-2", func(context.Context) {})
+	assert.NoError(t, err)
+
+	// A full queue is left once the context is done
+	for q.Stats()[0].Queued != 1 {
+		time.Sleep(time.Millisecond)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	err = q.Run(ctx, "toe-1", func(context.Context) {
+		t.Error("job should not have been executed")
+	})
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	close(release)
+	wg.Wait()
+
+	assert.Equal(t, []ToEQueueStats{
+		{TargetOfEvaluationId: "toe-1", Assessed: 2, Cancelled: 1},
+		{TargetOfEvaluationId: "toe-2", Assessed: 1},
+	}, zeroQueueWait(q.Stats()))
+
+	// Idle queues are removed
+	q.mu.Lock()
+	assert.Equal(t, 0, len(q.queues))
+	q.mu.Unlock()
+}
+
+func Test_toeQueues_Run_cancelledBeforeStart(t *testing.T) {
+	var (
+		q   *toeQueues
+		err error
+	)
+
+	q = newToEQueues(1, 1)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err = q.Run(ctx, "toe-1", func(context.Context) {
+		t.Error("job should not have been executed")
+	})
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, []ToEQueueStats{
+		{TargetOfEvaluationId: "toe-1", Cancelled: 1},
+	}, q.Stats())
+}
+
+// zeroQueueWait removes the non-deterministic queue wait times from stats.
+func zeroQueueWait(stats []ToEQueueStats) []ToEQueueStats {
+	for i := range stats {
+		stats[i].QueueWait = 0
+	}
+
+	return stats
+}
@@ -19,6 +19,7 @@ import (
 	"context"
 	"errors"
 	"log/slog"
+	"slices"
 
 	"confirmate.io/core/api/orchestrator"
 	"confirmate.io/core/auth"
@@ -41,6 +42,7 @@ type AuthorizationStrategy interface {
 	AllowedTargetOfEvaluations(ctx context.Context) (all bool, toeIds []string)
 	AllowedAuditScopes(ctx context.Context) (all bool, auditScopeIds []string)
 	AllowedUserPermission(ctx context.Context) (all bool, userPermissions []string)
+	ClaimedTargetOfEvaluations(ctx context.Context) (all bool, toeIds []string)
 }
 
 // CheckAccess checks access via the configured strategy.
@@ -65,6 +67,27 @@ func CheckAccess[T any](authz AuthorizationStrategy,
 		objectType)
 }
 
+// AllowsTargetOfEvaluation checks whether the configured strategy allows access to the target of evaluation with the
+// given ID, considering only the targets of evaluation the claims of the request are restricted to (see
+// [AuthorizationStrategy.ClaimedTargetOfEvaluations]). It is used for objects whose permissions are not checked per
+// target of evaluation, such as audit scopes. A nil strategy allows all access.
+func AllowsTargetOfEvaluation(ctx context.Context, authz AuthorizationStrategy, toeId string) bool {
+	all, toeIds := ClaimedTargetOfEvaluations(ctx, authz)
+
+	return all || slices.Contains(toeIds, toeId)
+}
+
+// ClaimedTargetOfEvaluations returns the IDs of the targets of evaluation the claims of the request are restricted to
+// via the configured strategy (see [AuthorizationStrategy.ClaimedTargetOfEvaluations]). A nil strategy allows all
+// targets of evaluation.
+func ClaimedTargetOfEvaluations(ctx context.Context, authz AuthorizationStrategy) (all bool, toeIds []string) {
+	if authz == nil {
+		return true, nil
+	}
+
+	return authz.ClaimedTargetOfEvaluations(ctx)
+}
+
 // AuthorizationStrategyPermissionStore implements access checks based on user permissions stored in
 // a [PermissionStore]. It checks permissions for the user making the request and the requested
 // resource, returning whether access is allowed and, for list requests, the IDs of resources the
@@ -84,10 +107,26 @@ func (a *AuthorizationStrategyPermissionStore) CheckAccess(ctx context.Context,
 	var (
 		err            error
 		objectTypeUsed orchestrator.ObjectType
+		supported      bool
+		claims         *auth.OAuthClaims
 	)
 
+	objectTypeUsed, supported = permissionObjectType(objectType)
+	claims, _ = auth.ClaimsFromContext(ctx)
+
+	// Tokens can be restricted to particular targets of evaluation. This applies to all objects that belong to a
+	// target of evaluation, even for admins. Objects that belong to an audit scope are restricted by the orchestrator,
+	// which resolves the target of evaluation of the audit scope.
+	if objectTypeUsed == orchestrator.ObjectType_OBJECT_TYPE_TARGET_OF_EVALUATION && claims.RestrictsTargetOfEvaluations() {
+		if reqType == orchestrator.RequestType_REQUEST_TYPE_LIST && claims.IsAdmin() {
+			return false, claims.TargetOfEvaluationIds
+		} else if reqType != orchestrator.RequestType_REQUEST_TYPE_LIST && !claims.AllowsTargetOfEvaluation(resourceId) {
+			return false, nil
+		}
+	}
+
 	// Check admin claim to allow access to all.
-	if claims, ok := auth.ClaimsFromContext(ctx); ok && claims.IsAdmin() {
+	if claims.IsAdmin() {
 		return true, nil
 	}
 
@@ -101,20 +140,7 @@ func (a *AuthorizationStrategyPermissionStore) CheckAccess(ctx context.Context,
 	}
 
 	// Check if ToE ID or Audit Scope ID is necessary for the permission check; return false if not provided.
-	switch objectType {
-	case orchestrator.ObjectType_OBJECT_TYPE_TARGET_OF_EVALUATION,
-		orchestrator.ObjectType_OBJECT_TYPE_EVIDENCE,
-		orchestrator.ObjectType_OBJECT_TYPE_ASSESSMENT_RESULT,
-		orchestrator.ObjectType_OBJECT_TYPE_METRIC_CONFIGURATION,
-		orchestrator.ObjectType_OBJECT_TYPE_CERTIFICATE:
-		objectTypeUsed = orchestrator.ObjectType_OBJECT_TYPE_TARGET_OF_EVALUATION
-	case orchestrator.ObjectType_OBJECT_TYPE_AUDIT_SCOPE,
-		orchestrator.ObjectType_OBJECT_TYPE_EVALUATION_RESULT,
-		orchestrator.ObjectType_OBJECT_TYPE_CONTROL_IN_SCOPE:
-		objectTypeUsed = orchestrator.ObjectType_OBJECT_TYPE_AUDIT_SCOPE
-	case orchestrator.ObjectType_OBJECT_TYPE_USER_PERMISSION:
-		objectTypeUsed = orchestrator.ObjectType_OBJECT_TYPE_USER_PERMISSION
-	default:
+	if !supported {
 		slog.Debug("Unsupported object type for permission check", "objectType", objectType)
 		return false, nil
 	}
@@ -132,6 +158,10 @@ func (a *AuthorizationStrategyPermissionStore) CheckAccess(ctx context.Context,
 			return false, nil
 		}
 
+		if objectTypeUsed == orchestrator.ObjectType_OBJECT_TYPE_TARGET_OF_EVALUATION {
+			resourceIDs = restrictTargetOfEvaluations(claims, resourceIDs)
+		}
+
 		return false, resourceIDs
 	}
 
@@ -166,6 +196,39 @@ func (a *AuthorizationStrategyPermissionStore) CheckAccess(ctx context.Context,
 	return allowed, nil
 }
 
+// permissionObjectType returns the object type whose permissions are checked for an object of the given type, e.g.,
+// the permissions of the target of evaluation of an assessment result. It returns false, if the permissions of objects
+// of the given type cannot be checked.
+func permissionObjectType(objectType orchestrator.ObjectType) (orchestrator.ObjectType, bool) {
+	switch objectType {
+	case orchestrator.ObjectType_OBJECT_TYPE_TARGET_OF_EVALUATION,
+		orchestrator.ObjectType_OBJECT_TYPE_EVIDENCE,
+		orchestrator.ObjectType_OBJECT_TYPE_ASSESSMENT_RESULT,
+		orchestrator.ObjectType_OBJECT_TYPE_METRIC_CONFIGURATION,
+		orchestrator.ObjectType_OBJECT_TYPE_CERTIFICATE:
+		return orchestrator.ObjectType_OBJECT_TYPE_TARGET_OF_EVALUATION, true
+	case orchestrator.ObjectType_OBJECT_TYPE_AUDIT_SCOPE,
+		orchestrator.ObjectType_OBJECT_TYPE_EVALUATION_RESULT,
+		orchestrator.ObjectType_OBJECT_TYPE_CONTROL_IN_SCOPE:
+		return orchestrator.ObjectType_OBJECT_TYPE_AUDIT_SCOPE, true
+	case orchestrator.ObjectType_OBJECT_TYPE_USER_PERMISSION:
+		return orchestrator.ObjectType_OBJECT_TYPE_USER_PERMISSION, true
+	default:
+		return orchestrator.ObjectType_OBJECT_TYPE_UNSPECIFIED, false
+	}
+}
+
+// restrictTargetOfEvaluations returns the IDs of the given targets of evaluation that are allowed by the claims.
+func restrictTargetOfEvaluations(claims *auth.OAuthClaims, toeIds []string) []string {
+	if !claims.RestrictsTargetOfEvaluations() {
+		return toeIds
+	}
+
+	return slices.DeleteFunc(slices.Clone(toeIds), func(id string) bool {
+		return !claims.AllowsTargetOfEvaluation(id)
+	})
+}
+
 // AllowedUserPermission returns a list of UserPermission IDs the user has access to, or all if the user has access to all UserPermissions.
 func (a *AuthorizationStrategyPermissionStore) AllowedUserPermission(ctx context.Context) (all bool, userPermissions []string) {
 	var (
@@ -210,8 +273,12 @@ func (a *AuthorizationStrategyPermissionStore) AllowedTargetOfEvaluations(ctx co
 		return false, nil
 	}
 
-	// Check admin claim to allow access to all.
+	// Check admin claim to allow access to all, unless the token is restricted to particular targets of evaluation.
 	if claims, ok = auth.ClaimsFromContext(ctx); ok && claims.IsAdmin() {
+		if claims.RestrictsTargetOfEvaluations() {
+			return false, claims.TargetOfEvaluationIds
+		}
+
 		return true, nil
 	}
 
@@ -229,7 +296,23 @@ func (a *AuthorizationStrategyPermissionStore) AllowedTargetOfEvaluations(ctx co
 		return false, nil
 	}
 
-	return false, toeIds
+	return false, restrictTargetOfEvaluations(claims, toeIds)
+}
+
+// ClaimedTargetOfEvaluations returns the IDs of the targets of evaluation the claims of the request are restricted
+// to, or all if the claims do not restrict access to particular targets of evaluation. In contrast to
+// [AuthorizationStrategyPermissionStore.AllowedTargetOfEvaluations], the permissions of the user are not considered.
+func (a *AuthorizationStrategyPermissionStore) ClaimedTargetOfEvaluations(ctx context.Context) (all bool, toeIds []string) {
+	var (
+		claims *auth.OAuthClaims
+		ok     bool
+	)
+
+	if claims, ok = auth.ClaimsFromContext(ctx); ok && claims.RestrictsTargetOfEvaluations() {
+		return false, claims.TargetOfEvaluationIds
+	}
+
+	return true, nil
 }
 
 // AllowedAuditScopes returns a list of Audit Scope IDs the user has access to, or all if the user has access to all audit scopes.
@@ -294,3 +377,8 @@ func (a *AuthorizationStrategyAllowAll) AllowedTargetOfEvaluations(_ context.Con
 func (a *AuthorizationStrategyAllowAll) AllowedAuditScopes(_ context.Context) (all bool, auditScopeIds []string) {
 	return true, nil
 }
+
+// ClaimedTargetOfEvaluations returns true and nil, ignoring any restriction of the claims to particular ToEs.
+func (a *AuthorizationStrategyAllowAll) ClaimedTargetOfEvaluations(_ context.Context) (all bool, toeIds []string) {
+	return true, nil
+}
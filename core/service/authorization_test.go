@@ -63,6 +63,10 @@ func (*denyAuthorizationStrategy) AllowedAuditScopes(_ context.Context) (bool, [
 	return false, nil
 }
 
+func (*denyAuthorizationStrategy) ClaimedTargetOfEvaluations(_ context.Context) (bool, []string) {
+	return false, nil
+}
+
 func (*denyAuthorizationStrategy) AllowedUserPermission(_ context.Context) (bool, []string) {
 	return false, nil
 }
@@ -169,6 +173,41 @@ func TestAuthorizationStrategyPermissionStore_CheckAccess(t *testing.T) {
 			},
 			wantResourceIDs: assert.Nil[[]string],
 		},
+		{
+			name: "err: admin token restricted to other targets of evaluation",
+			args: args{
+				ctx:            auth.WithClaims(context.Background(), &auth.OAuthClaims{IsAdminToken: true, TargetOfEvaluationIds: []string{orchestratortest.MockToeId2}}),
+				userId:         "user-1",
+				reqType:        orchestrator.RequestType_REQUEST_TYPE_CREATED,
+				userPermission: orchestrator.UserPermission_PERMISSION_READER,
+				resourceId:     orchestratortest.MockToeId1,
+				objectType:     orchestrator.ObjectType_OBJECT_TYPE_ASSESSMENT_RESULT,
+			},
+			fields: fields{strategy: &AuthorizationStrategyPermissionStore{}},
+			wantAllowed: func(t *testing.T, got bool, _ ...any) bool {
+				return assert.False(t, got)
+			},
+			wantResourceIDs: assert.Nil[[]string],
+		},
+		{
+			name: "happy path: list restricted to the targets of evaluation of the token",
+			args: args{
+				ctx:            auth.WithClaims(context.Background(), &auth.OAuthClaims{TargetOfEvaluationIds: []string{orchestratortest.MockToeId2}}),
+				userId:         "user-1",
+				reqType:        orchestrator.RequestType_REQUEST_TYPE_LIST,
+				userPermission: orchestrator.UserPermission_PERMISSION_READER,
+				objectType:     orchestrator.ObjectType_OBJECT_TYPE_ASSESSMENT_RESULT,
+			},
+			fields: fields{strategy: &AuthorizationStrategyPermissionStore{
+				Permissions: &fakePermissionStore{ids: []string{orchestratortest.MockToeId1, orchestratortest.MockToeId2}},
+			}},
+			wantAllowed: func(t *testing.T, got bool, _ ...any) bool {
+				return assert.False(t, got)
+			},
+			wantResourceIDs: func(t *testing.T, got []string, _ ...any) bool {
+				return assert.Equal(t, []string{orchestratortest.MockToeId2}, got)
+			},
+		},
 		{
 			name: "err: unsupported object type",
 			args: args{
@@ -564,6 +603,51 @@ func TestAuthorizationStrategyPermissionStore_AllowedTargetOfEvaluations(t *test
 				return assert.Equal(t, []string{orchestratortest.MockToeId1, orchestratortest.MockToeId2}, got)
 			},
 		},
+		{
+			name: "happy path: admin token restricted to specific ToEs",
+			args: args{
+				ctx: auth.WithClaims(
+					context.Background(),
+					&auth.OAuthClaims{IsAdminToken: true, TargetOfEvaluationIds: []string{orchestratortest.MockToeId1}},
+				),
+			},
+			fields: fields{authz: &AuthorizationStrategyPermissionStore{}},
+			wantAllowed: func(t *testing.T, got bool, msgAndArgs ...any) bool {
+				return assert.False(t, got)
+			},
+			wantResourceIds: func(t *testing.T, got []string, msgAndArgs ...any) bool {
+				return assert.Equal(t, []string{orchestratortest.MockToeId1}, got)
+			},
+		},
+		{
+			name: "happy path: permissions store allows specific ToEs restricted by token",
+			args: args{
+				auth.WithClaims(
+					context.Background(),
+					&auth.OAuthClaims{
+						RegisteredClaims: jwt.RegisteredClaims{
+							Subject: orchestratortest.MockUserId1,
+							Issuer:  orchestratortest.MockUserIssuer1,
+						},
+						TargetOfEvaluationIds: []string{orchestratortest.MockToeId2},
+					},
+				),
+			},
+			fields: fields{
+				authz: &AuthorizationStrategyPermissionStore{
+					&fakePermissionStore{
+						ids: []string{orchestratortest.MockToeId1, orchestratortest.MockToeId2},
+						err: nil,
+					},
+				},
+			},
+			wantAllowed: func(t *testing.T, got bool, msgAndArgs ...any) bool {
+				return assert.False(t, got)
+			},
+			wantResourceIds: func(t *testing.T, got []string, msgAndArgs ...any) bool {
+				return assert.Equal(t, []string{orchestratortest.MockToeId2}, got)
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -575,6 +659,69 @@ func TestAuthorizationStrategyPermissionStore_AllowedTargetOfEvaluations(t *test
 	}
 }
 
+func TestAllowsTargetOfEvaluation(t *testing.T) {
+	type args struct {
+		ctx   context.Context
+		authz AuthorizationStrategy
+		toeId string
+	}
+	tests := []struct {
+		name string
+		args args
+		want assert.Want[bool]
+	}{
+		{
+			name: "nil strategy allows all",
+			args: args{
+				ctx:   auth.WithClaims(context.Background(), &auth.OAuthClaims{TargetOfEvaluationIds: []string{orchestratortest.MockToeId2}}),
+				toeId: orchestratortest.MockToeId1,
+			},
+			want: func(t *testing.T, got bool, _ ...any) bool {
+				return assert.True(t, got)
+			},
+		},
+		{
+			name: "allow all strategy ignores claims",
+			args: args{
+				ctx:   auth.WithClaims(context.Background(), &auth.OAuthClaims{TargetOfEvaluationIds: []string{orchestratortest.MockToeId2}}),
+				authz: &AuthorizationStrategyAllowAll{},
+				toeId: orchestratortest.MockToeId1,
+			},
+			want: func(t *testing.T, got bool, _ ...any) bool {
+				return assert.True(t, got)
+			},
+		},
+		{
+			name: "permission store without restriction",
+			args: args{
+				ctx:   auth.WithClaims(context.Background(), &auth.OAuthClaims{}),
+				authz: &AuthorizationStrategyPermissionStore{},
+				toeId: orchestratortest.MockToeId1,
+			},
+			want: func(t *testing.T, got bool, _ ...any) bool {
+				return assert.True(t, got)
+			},
+		},
+		{
+			name: "permission store with restriction to other targets of evaluation",
+			args: args{
+				ctx:   auth.WithClaims(context.Background(), &auth.OAuthClaims{IsAdminToken: true, TargetOfEvaluationIds: []string{orchestratortest.MockToeId2}}),
+				authz: &AuthorizationStrategyPermissionStore{},
+				toeId: orchestratortest.MockToeId1,
+			},
+			want: func(t *testing.T, got bool, _ ...any) bool {
+				return assert.False(t, got)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := AllowsTargetOfEvaluation(tt.args.ctx, tt.args.authz, tt.args.toeId)
+			tt.want(t, got)
+		})
+	}
+}
+
 func TestAuthorizationStrategyAllowAll_CheckAccess(t *testing.T) {
 	type fields struct {
 		authz *AuthorizationStrategyAllowAll
@@ -17,8 +17,10 @@ package collection
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
+	"log/slog"
 	"net/http"
 	"time"
 
@@ -27,6 +29,7 @@ import (
 	"confirmate.io/core/api/ontology"
 	"confirmate.io/core/service"
 	"confirmate.io/core/stream"
+	"confirmate.io/core/util/entity"
 
 	"connectrpc.com/connect"
 	"github.com/google/uuid"
@@ -56,13 +59,19 @@ type Service struct {
 	cfg                 Config
 	evidenceStoreClient evidenceconnect.EvidenceStoreClient
 	evidenceStoreStream *stream.RestartableBidiStream[evidence.StoreEvidenceRequest, evidence.StoreEvidencesResponse]
+
+	// workers runs the collection loop between [Service.Start] and [Service.Shutdown]
+	workers service.Workers
 }
 
+var _ service.Lifecycle = (*Service)(nil)
+
 // DefaultConfig is the default configuration for the collection service.
 var DefaultConfig = Config{
 	Interval:                5 * time.Minute,
 	EvidenceStoreAddress:    DefaultEvidenceStoreAddress,
 	EvidenceStoreHTTPClient: service.DefaultHTTPClient,
+	Transport:               service.DefaultTransportConfig,
 }
 
 // Config is the configuration for the collection service.
@@ -88,6 +97,10 @@ type Config struct {
 	// ToolID overrides the collector ID when creating evidence records. If empty, the collector's
 	// own ID is used.
 	ToolID string
+
+	// Transport configures the message size limits and the compression of the evidence store
+	// client.
+	Transport service.TransportConfig
 }
 
 // WithConfig sets the service configuration, overriding the default configuration.
@@ -123,8 +136,8 @@ func NewService(opts ...service.Option[Service]) (svc *Service, err error) {
 	}
 
 	if cfg.TargetOfEvaluationID != "" {
-		if err = uuid.Validate(cfg.TargetOfEvaluationID); err != nil {
-			return nil, fmt.Errorf("invalid target of evaluation id: %w", err)
+		if err = entity.ToEID(cfg.TargetOfEvaluationID).Validate(); err != nil {
+			return nil, err
 		}
 	}
 
@@ -142,7 +155,8 @@ func NewService(opts ...service.Option[Service]) (svc *Service, err error) {
 			httpClient = service.DefaultHTTPClient
 		}
 
-		svc.evidenceStoreClient = evidenceconnect.NewEvidenceStoreClient(httpClient, cfg.EvidenceStoreAddress)
+		svc.evidenceStoreClient = evidenceconnect.NewEvidenceStoreClient(httpClient, cfg.EvidenceStoreAddress,
+			cfg.Transport.ClientOptions()...)
 	}
 
 	if svc.evidenceStoreClient != nil {
@@ -248,9 +262,9 @@ func (svc *Service) sendResourcesToEvidenceStore(ctx context.Context, collector
 	return nil
 }
 
-// Start runs all collectors immediately and then repeatedly at the configured interval.
+// Run runs all collectors immediately and then repeatedly at the configured interval.
 // The returned channel is closed when ctx is canceled.
-func (svc *Service) Start(ctx context.Context) (resultCh <-chan CollectionResult) {
+func (svc *Service) Run(ctx context.Context) (resultCh <-chan CollectionResult) {
 	var (
 		results chan CollectionResult
 	)
@@ -263,3 +277,27 @@ func (svc *Service) Start(ctx context.Context) (resultCh <-chan CollectionResult
 
 	return results
 }
+
+// Start runs the collection loop (see [Service.Run]) in the background until [Service.Shutdown] is called. This
+// implements [service.Lifecycle].
+func (svc *Service) Start(_ context.Context) (err error) {
+	svc.workers.Go(func(ctx context.Context) {
+		for range svc.Run(ctx) {
+			slog.Debug("Collection cycle finished")
+		}
+	})
+
+	return nil
+}
+
+// Shutdown stops the collection loop and waits until the running collection cycle returned or ctx is done. This
+// implements [service.Lifecycle].
+func (svc *Service) Shutdown(ctx context.Context) (err error) {
+	err = svc.workers.Stop(ctx)
+
+	if svc.evidenceStoreStream != nil {
+		err = errors.Join(err, svc.evidenceStoreStream.Close())
+	}
+
+	return err
+}
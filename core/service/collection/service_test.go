@@ -141,7 +141,7 @@ func TestRunOnce_CollectsIndividualErrors(t *testing.T) {
 	assert.False(t, res.FinishedAt.Before(res.StartedAt))
 }
 
-func TestStart_RunsPeriodicallyAndDoesNotStopOnCollectorError(t *testing.T) {
+func TestRun_RunsPeriodicallyAndDoesNotStopOnCollectorError(t *testing.T) {
 	var (
 		ctx               context.Context
 		cancel            context.CancelFunc
@@ -176,7 +176,7 @@ func TestStart_RunsPeriodicallyAndDoesNotStopOnCollectorError(t *testing.T) {
 	)
 	assert.NoError(t, err)
 
-	resultCh = svc.Start(ctx)
+	resultCh = svc.Run(ctx)
 
 	for collectedRuns < 2 {
 		select {
@@ -200,6 +200,47 @@ func TestStart_RunsPeriodicallyAndDoesNotStopOnCollectorError(t *testing.T) {
 	mu.Unlock()
 }
 
+func TestService_StartAndShutdown(t *testing.T) {
+	var (
+		svc   *collection.Service
+		err   error
+		calls atomic.Int32
+		ran   = make(chan struct{}, 1)
+	)
+
+	svc, err = collection.NewService(
+		collection.WithConfig(collection.Config{
+			Interval: 10 * time.Millisecond,
+			Collectors: []collection.Collector{
+				collectiontest.NewFunctionCollector("collector", func() ([]ontology.IsResource, error) {
+					calls.Add(1)
+					select {
+					case ran <- struct{}{}:
+					default:
+					}
+					return nil, nil
+				}),
+			},
+		}),
+	)
+	assert.NoError(t, err)
+
+	assert.NoError(t, svc.Start(context.Background()))
+
+	select {
+	case <-time.After(500 * time.Millisecond):
+		t.Fatal("timed out waiting for collection run")
+	case <-ran:
+	}
+
+	assert.NoError(t, svc.Shutdown(context.Background()))
+
+	// No collection runs after the shutdown
+	n := calls.Load()
+	time.Sleep(50 * time.Millisecond)
+	assert.Equal(t, n, calls.Load())
+}
+
 func TestRunOnce_ForwardsCollectedResourcesToEvidenceStore(t *testing.T) {
 	var (
 		targetOfEvaluationID string
@@ -19,13 +19,16 @@ import (
 	"encoding/base64"
 	"errors"
 	"fmt"
+	"log/slog"
 
 	"confirmate.io/core/api/orchestrator"
+	"confirmate.io/core/log"
 	"confirmate.io/core/persistence"
 	"confirmate.io/core/util"
 
 	"buf.build/go/protovalidate"
 	"connectrpc.com/connect"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/reflect/protoreflect"
 )
@@ -52,6 +55,9 @@ var (
 	// ErrConstraintFailed is returned when a database constraint is violated.
 	ErrConstraintFailed = errors.New("database constraint failed")
 
+	// ErrImmutable is returned when trying to update or delete a record that is immutable within its retention period.
+	ErrImmutable = errors.New("record is immutable within its retention period")
+
 	// ErrDatabaseError is returned for general database errors.
 	ErrDatabaseError = errors.New("database error")
 )
@@ -75,11 +81,68 @@ func ErrNotFound(entity string) error {
 	return fmt.Errorf("%s not found", entity)
 }
 
+// NewInvalidFieldError returns a [connect.CodeInvalidArgument] error for a request field that is invalid. The path of
+// the field (e.g., "metric.id") is attached to the error as an [errdetails.BadRequest] field violation, so that API
+// consumers do not need to parse it out of the error message.
+func NewInvalidFieldError(field string, err error) *connect.Error {
+	return newBadRequestError(err, &errdetails.BadRequest_FieldViolation{
+		Field:       field,
+		Description: err.Error(),
+	})
+}
+
+// newValidationError returns a [connect.CodeInvalidArgument] error for a request that failed validation. If err is a
+// [protovalidate.ValidationError], each of its violations is attached to the error as an [errdetails.BadRequest] field
+// violation.
+func newValidationError(err error) *connect.Error {
+	var (
+		validationErr *protovalidate.ValidationError
+		violations    []*errdetails.BadRequest_FieldViolation
+	)
+
+	if errors.As(err, &validationErr) {
+		for _, violation := range validationErr.Violations {
+			violations = append(violations, &errdetails.BadRequest_FieldViolation{
+				Field:       protovalidate.FieldPathString(violation.Proto.GetField()),
+				Description: violation.Proto.GetMessage(),
+				Reason:      violation.Proto.GetRuleId(),
+			})
+		}
+	}
+
+	return newBadRequestError(fmt.Errorf("invalid request: %w", err), violations...)
+}
+
+// newBadRequestError returns a [connect.CodeInvalidArgument] error with an [errdetails.BadRequest] detail containing
+// the given field violations.
+func newBadRequestError(err error, violations ...*errdetails.BadRequest_FieldViolation) (cErr *connect.Error) {
+	var (
+		detail *connect.ErrorDetail
+		dErr   error
+	)
+
+	cErr = connect.NewError(connect.CodeInvalidArgument, err)
+	if len(violations) == 0 {
+		return cErr
+	}
+
+	detail, dErr = connect.NewErrorDetail(&errdetails.BadRequest{FieldViolations: violations})
+	if dErr != nil {
+		// This should not happen, since the detail is a valid message. The error is still usable without it.
+		slog.Warn("Could not attach field violations to error", log.Err(dErr))
+		return cErr
+	}
+
+	cErr.AddDetail(detail)
+	return cErr
+}
+
 // Validate validates an incoming request using protovalidate.
 // The type parameter T should be a protobuf message type where *T implements [proto.Message].
 //   - If the request or request message is nil, it returns an [ErrEmptyRequest] error.
 //   - Accepts optional validation options (e.g., WithFilter to ignore specific fields).
-//   - If the request fails validation, it returns a [connect.CodeInvalidArgument] error.
+//   - If the request fails validation, it returns a [connect.CodeInvalidArgument] error. The violated fields are
+//     attached to it as [errdetails.BadRequest] field violations.
 func Validate[T any](req *connect.Request[T], opts ...protovalidate.ValidationOption) error {
 	if util.IsNil(req) || util.IsNil(req.Msg) {
 		return connect.NewError(connect.CodeInvalidArgument, ErrEmptyRequest)
@@ -98,13 +161,17 @@ func Validate[T any](req *connect.Request[T], opts ...protovalidate.ValidationOp
 		if s := v.String(); s != "" {
 			// Validate base64 (raw or padded).
 			if err := validatePageTokenBase64(s); err != nil {
-				return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid request: invalid page_token: %w", err))
+				return newBadRequestError(fmt.Errorf("invalid request: invalid page_token: %w", err),
+					&errdetails.BadRequest_FieldViolation{
+						Field:       "page_token",
+						Description: err.Error(),
+					})
 			}
 		}
 	}
 
 	if err := validator.Validate(msg, opts...); err != nil {
-		return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid request: %w", err))
+		return newValidationError(err)
 	}
 
 	return nil
@@ -117,7 +184,8 @@ func Validate[T any](req *connect.Request[T], opts ...protovalidate.ValidationOp
 //   - If the request or request message is nil, it returns an [ErrEmptyRequest] error.
 //   - If the prep function is not nil, it is called before validation.
 //   - Accepts optional validation options (e.g., WithFilter to ignore specific fields).
-//   - If the request fails validation, it returns a [connect.CodeInvalidArgument] error.
+//   - If the request fails validation, it returns a [connect.CodeInvalidArgument] error. The violated fields are
+//     attached to it as [errdetails.BadRequest] field violations.
 func ValidateWithPrep[T any](req *connect.Request[T], prep func(), opts ...protovalidate.ValidationOption) error {
 	if util.IsNil(req) || util.IsNil(req.Msg) {
 		return connect.NewError(connect.CodeInvalidArgument, ErrEmptyRequest)
@@ -135,7 +203,7 @@ func ValidateWithPrep[T any](req *connect.Request[T], prep func(), opts ...proto
 	}
 
 	if err := validator.Validate(msg, opts...); err != nil {
-		return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid request: %w", err))
+		return newValidationError(err)
 	}
 
 	return nil
@@ -145,6 +213,7 @@ func ValidateWithPrep[T any](req *connect.Request[T], prep func(), opts ...proto
 //   - If the error is [persistence.ErrRecordNotFound], it returns a [connect.CodeNotFound]
 //     error with the provided notFoundErr (or a default error if not provided).
 //   - If err is already a [connect.Error], it returns it as-is.
+//   - If the error is [persistence.ErrImmutable], it returns a [connect.CodeFailedPrecondition] error.
 //   - For other errors, it returns a [connect.CodeInternal] error. If err is nil, it returns nil.
 func HandleDatabaseError(err error, notFoundErr ...error) error {
 	if err == nil {
@@ -176,6 +245,10 @@ func HandleDatabaseError(err error, notFoundErr ...error) error {
 		return connect.NewError(connect.CodeInvalidArgument, ErrConstraintFailed)
 	}
 
+	if errors.Is(err, persistence.ErrImmutable) {
+		return connect.NewError(connect.CodeFailedPrecondition, ErrImmutable)
+	}
+
 	// We return the full error for internal errors to aid debugging. This is later replaced in the
 	// logging interceptor with a generic message to avoid leaking internal details to clients.
 	return connect.NewError(connect.CodeInternal, fmt.Errorf("%w: %w", ErrDatabaseError, err))
@@ -16,6 +16,7 @@
 package service_test
 
 import (
+	"errors"
 	"io"
 	"testing"
 
@@ -79,6 +80,17 @@ func TestHandleDatabaseError(t *testing.T) {
 				return assert.Equal(t, connect.CodeInvalidArgument, cErr.Code())
 			},
 		},
+		{
+			name: "immutable error",
+			args: args{
+				err:          persistence.ErrImmutable,
+				notFoundErrs: []error{},
+			},
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				cErr := assert.Is[*connect.Error](t, err)
+				return assert.Equal(t, connect.CodeFailedPrecondition, cErr.Code())
+			},
+		},
 		{
 			name: "other error",
 			args: args{
@@ -144,7 +156,9 @@ func TestValidate(t *testing.T) {
 			},
 			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
 				cErr := assert.Is[*connect.Error](t, err)
-				return assert.Equal(t, connect.CodeInvalidArgument, cErr.Code())
+				return assert.Equal(t, connect.CodeInvalidArgument, cErr.Code()) &&
+					assert.HasFieldViolation(t, err, "metric.id") &&
+					assert.HasFieldViolation(t, err, "metric.name")
 			},
 		},
 	}
@@ -156,3 +170,11 @@ func TestValidate(t *testing.T) {
 		})
 	}
 }
+
+func TestNewInvalidFieldError(t *testing.T) {
+	err := service.NewInvalidFieldError("catalog_id", errors.New("catalog is not part of the audit scope"))
+
+	assert.IsConnectError(t, err, connect.CodeInvalidArgument)
+	assert.ErrorContains(t, err, "catalog is not part of the audit scope")
+	assert.HasFieldViolation(t, err, "catalog_id")
+}
@@ -0,0 +1,372 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package evaluation
+
+import (
+	"cmp"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"confirmate.io/core/api"
+	"confirmate.io/core/api/evaluation"
+	"confirmate.io/core/api/orchestrator"
+	"confirmate.io/core/log"
+	"confirmate.io/core/service"
+
+	"connectrpc.com/connect"
+	"google.golang.org/genproto/googleapis/api/httpbody"
+)
+
+const (
+	// DefaultCalendarURL is the default external base URL of the API, which is used in the subscription URLs of
+	// calendar feeds.
+	DefaultCalendarURL = "http://localhost:8080"
+
+	// calendarContentType is the content type of calendar feeds.
+	calendarContentType = "text/calendar; charset=utf-8"
+
+	// calendarLineLength is the maximum length of a line of a calendar feed in octets, excluding the line break.
+	calendarLineLength = 75
+)
+
+// errCalendarDisabled is returned if no [Config.CalendarSecret] is configured.
+var errCalendarDisabled = errors.New("calendar feeds are not configured")
+
+// calendarTextEscaper escapes the values of text properties of a calendar feed (see RFC 5545, section 3.3.11).
+var calendarTextEscaper = strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`)
+
+// calendarEvent is an event of the calendar feed of an audit scope.
+type calendarEvent struct {
+	// uid is the globally unique and stable ID of the event, so that calendar clients update the event instead of
+	// creating a new one on every refresh.
+	uid         string
+	summary     string
+	description string
+	start       time.Time
+	end         time.Time
+	// allDay events only use the date of start and end.
+	allDay bool
+}
+
+// GetCalendarSubscription returns the subscription URL of the calendar feed of the given audit scope. Everyone who
+// is allowed to read the audit scope can retrieve it.
+func (svc *Service) GetCalendarSubscription(ctx context.Context, req *connect.Request[evaluation.GetCalendarSubscriptionRequest]) (res *connect.Response[evaluation.CalendarSubscription], err error) {
+	var (
+		allowed    bool
+		auditScope *orchestrator.AuditScope
+		feedURL    *url.URL
+		query      = url.Values{}
+	)
+
+	// Validate the request
+	if err = service.Validate(req); err != nil {
+		return nil, err
+	}
+
+	// Check access via the configured auth strategy
+	allowed, _, err = checkAccess(ctx, svc.authz, orchestrator.RequestType_REQUEST_TYPE_GET, req.Msg.GetAuditScopeId(), orchestrator.ObjectType_OBJECT_TYPE_AUDIT_SCOPE)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if !allowed {
+		return nil, service.ErrPermissionDenied
+	}
+
+	if svc.cfg.CalendarSecret == "" {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errCalendarDisabled)
+	}
+
+	auditScope, err = svc.fetchAuditScope(ctx, req.Msg.GetAuditScopeId())
+	if err != nil {
+		return nil, err
+	}
+
+	// The token might be restricted to other targets of evaluation
+	if !service.AllowsTargetOfEvaluation(ctx, svc.authz, auditScope.GetTargetOfEvaluationId()) {
+		return nil, service.ErrPermissionDenied
+	}
+
+	feedURL, err = url.Parse(svc.cfg.CalendarURL)
+	if err != nil {
+		slog.Error("Could not parse calendar URL", log.Err(err))
+		return nil, connect.NewError(connect.CodeInternal, errors.New("invalid calendar URL"))
+	}
+	feedURL = feedURL.JoinPath("v1", "evaluation", "calendar", auditScope.GetId(), "feed.ics")
+
+	query.Set("token", svc.calendarToken(auditScope.GetId()))
+	if req.Msg.Locale != nil {
+		query.Set("locale", req.Msg.GetLocale())
+	}
+	feedURL.RawQuery = query.Encode()
+
+	res = connect.NewResponse(&evaluation.CalendarSubscription{
+		AuditScopeId: auditScope.GetId(),
+		Url:          feedURL.String(),
+	})
+	return
+}
+
+// GetCalendarFeed returns the calendar feed of the given audit scope. The procedure is public, since calendar clients
+// cannot authenticate. Instead, the request needs to contain the token of the subscription URL of the audit scope. The
+// events of the feed are retrieved with the credentials of the service.
+func (svc *Service) GetCalendarFeed(ctx context.Context, req *connect.Request[evaluation.GetCalendarFeedRequest]) (res *connect.Response[httpbody.HttpBody], err error) {
+	var (
+		auditScope *orchestrator.AuditScope
+		events     []calendarEvent
+		locale     string
+	)
+
+	// Validate the request
+	if err = service.Validate(req); err != nil {
+		return nil, err
+	}
+
+	if svc.cfg.CalendarSecret == "" {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errCalendarDisabled)
+	}
+
+	// The token replaces the access check
+	if !hmac.Equal([]byte(req.Msg.GetToken()), []byte(svc.calendarToken(req.Msg.GetAuditScopeId()))) {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("invalid calendar token"))
+	}
+
+	auditScope, err = svc.fetchAuditScope(ctx, req.Msg.GetAuditScopeId())
+	if err != nil {
+		return nil, err
+	}
+	locale = resolveLocale(req.Msg.Locale, auditScope)
+
+	events, err = svc.calendarEvents(ctx, auditScope, locale)
+	if err != nil {
+		slog.Error("Could not gather the events of the calendar feed", log.Err(err))
+		return nil, connect.NewError(connect.CodeInternal, errors.New("could not gather the events of the calendar feed"))
+	}
+
+	res = connect.NewResponse(&httpbody.HttpBody{
+		ContentType: calendarContentType,
+		Data:        writeCalendar(translate(locale, msgCalendarName, auditScope.GetName()), events, time.Now()),
+	})
+	return
+}
+
+// calendarToken returns the token of the subscription URL of the calendar feed of the audit scope, which is the
+// hex-encoded HMAC-SHA256 of its ID keyed with [Config.CalendarSecret].
+func (svc *Service) calendarToken(auditScopeId string) string {
+	mac := hmac.New(sha256.New, []byte(svc.cfg.CalendarSecret))
+	mac.Write([]byte(auditScopeId))
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// calendarEvents gathers the events of the calendar feed of the audit scope, sorted by their start: the expirations of
+// manual evaluation results that are still valid, the expirations of the certificates of the target of evaluation and
+// the maintenance windows of the audit scope.
+func (svc *Service) calendarEvents(ctx context.Context, auditScope *orchestrator.AuditScope, locale string) (events []calendarEvent, err error) {
+	var (
+		results      []*evaluation.EvaluationResult
+		certificates []*orchestrator.Certificate
+		windows      []*orchestrator.MaintenanceWindow
+	)
+
+	results, err = api.ListAllPaginated(ctx, &orchestrator.ListEvaluationResultsRequest{
+		Filter: &orchestrator.ListEvaluationResultsRequest_Filter{
+			AuditScopeId:    &auditScope.Id,
+			ValidManualOnly: new(true),
+		},
+		LatestByControlId: new(true),
+	}, func(ctx context.Context, req *orchestrator.ListEvaluationResultsRequest) (*orchestrator.ListEvaluationResultsResponse, error) {
+		res, err := svc.orchestratorClient.ListEvaluationResults(ctx, connect.NewRequest(req))
+		if err != nil {
+			return nil, err
+		}
+		return res.Msg, nil
+	}, func(res *orchestrator.ListEvaluationResultsResponse) []*evaluation.EvaluationResult {
+		return res.Results
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve manual evaluation results: %w", err)
+	}
+
+	for _, result := range results {
+		if result.ValidUntil == nil {
+			continue
+		}
+
+		day := result.GetValidUntil().AsTime()
+		events = append(events, calendarEvent{
+			uid:         "manual-result-" + result.GetId() + "@confirmate",
+			summary:     translate(locale, msgCalendarManualResultExpires, result.GetControlId()),
+			description: result.GetComment(),
+			start:       day,
+			end:         day.AddDate(0, 0, 1),
+			allDay:      true,
+		})
+	}
+
+	certificates, err = api.ListAllPaginated(ctx, &orchestrator.ListCertificatesRequest{},
+		func(ctx context.Context, req *orchestrator.ListCertificatesRequest) (*orchestrator.ListCertificatesResponse, error) {
+			res, err := svc.orchestratorClient.ListCertificates(ctx, connect.NewRequest(req))
+			if err != nil {
+				return nil, err
+			}
+			return res.Msg, nil
+		}, func(res *orchestrator.ListCertificatesResponse) []*orchestrator.Certificate {
+			return res.Certificates
+		})
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve certificates: %w", err)
+	}
+
+	for _, certificate := range certificates {
+		if certificate.GetTargetOfEvaluationId() != auditScope.GetTargetOfEvaluationId() {
+			continue
+		}
+
+		day, ok := parseCertificateDate(certificate.GetExpirationDate())
+		if !ok {
+			slog.Warn("Skipping certificate with invalid expiration date in calendar feed",
+				slog.String("certificate", certificate.GetId()),
+				slog.String("expiration_date", certificate.GetExpirationDate()))
+			continue
+		}
+
+		events = append(events, calendarEvent{
+			uid:         "certificate-" + certificate.GetId() + "@confirmate",
+			summary:     translate(locale, msgCalendarCertificateExpires, certificate.GetName()),
+			description: certificate.GetDescription(),
+			start:       day,
+			end:         day.AddDate(0, 0, 1),
+			allDay:      true,
+		})
+	}
+
+	windows, err = api.ListAllPaginated(ctx, &orchestrator.ListMaintenanceWindowsRequest{
+		AuditScopeId: auditScope.GetId(),
+	}, func(ctx context.Context, req *orchestrator.ListMaintenanceWindowsRequest) (*orchestrator.ListMaintenanceWindowsResponse, error) {
+		res, err := svc.orchestratorClient.ListMaintenanceWindows(ctx, connect.NewRequest(req))
+		if err != nil {
+			return nil, err
+		}
+		return res.Msg, nil
+	}, func(res *orchestrator.ListMaintenanceWindowsResponse) []*orchestrator.MaintenanceWindow {
+		return res.MaintenanceWindows
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve maintenance windows: %w", err)
+	}
+
+	for _, w := range windows {
+		msg := msgCalendarMaintenanceFlag
+		if w.GetMode() == orchestrator.MaintenanceMode_MAINTENANCE_MODE_SUPPRESS {
+			msg = msgCalendarMaintenanceSuppress
+		}
+
+		events = append(events, calendarEvent{
+			uid:         "maintenance-window-" + w.GetId() + "@confirmate",
+			summary:     translate(locale, msg),
+			description: w.GetDescription(),
+			start:       w.GetStartsAt().AsTime(),
+			end:         w.GetEndsAt().AsTime(),
+		})
+	}
+
+	slices.SortStableFunc(events, func(a calendarEvent, b calendarEvent) int {
+		return cmp.Or(a.start.Compare(b.start), strings.Compare(a.uid, b.uid))
+	})
+
+	return events, nil
+}
+
+// parseCertificateDate parses the issue or expiration date of a certificate, which is either a date or a timestamp in
+// RFC 3339 format.
+func parseCertificateDate(s string) (day time.Time, ok bool) {
+	for _, layout := range []string{time.DateOnly, time.RFC3339} {
+		t, err := time.Parse(layout, s)
+		if err == nil {
+			return t.UTC(), true
+		}
+	}
+
+	return time.Time{}, false
+}
+
+// writeCalendar writes the events as calendar in the iCalendar format (see RFC 5545). The time stamp of the events
+// is set to now.
+func writeCalendar(name string, events []calendarEvent, now time.Time) []byte {
+	var (
+		b     strings.Builder
+		stamp = now.UTC().Format("20060102T150405Z")
+	)
+
+	writeCalendarLine(&b, "BEGIN:VCALENDAR")
+	writeCalendarLine(&b, "VERSION:2.0")
+	writeCalendarLine(&b, "PRODID:-//Confirmate//Evaluation//EN")
+	writeCalendarLine(&b, "CALSCALE:GREGORIAN")
+	writeCalendarLine(&b, "METHOD:PUBLISH")
+	writeCalendarLine(&b, "X-WR-CALNAME:"+calendarTextEscaper.Replace(name))
+
+	for _, e := range events {
+		writeCalendarLine(&b, "BEGIN:VEVENT")
+		writeCalendarLine(&b, "UID:"+e.uid)
+		writeCalendarLine(&b, "DTSTAMP:"+stamp)
+		if e.allDay {
+			writeCalendarLine(&b, "DTSTART;VALUE=DATE:"+e.start.UTC().Format("20060102"))
+			writeCalendarLine(&b, "DTEND;VALUE=DATE:"+e.end.UTC().Format("20060102"))
+		} else {
+			writeCalendarLine(&b, "DTSTART:"+e.start.UTC().Format("20060102T150405Z"))
+			writeCalendarLine(&b, "DTEND:"+e.end.UTC().Format("20060102T150405Z"))
+		}
+		writeCalendarLine(&b, "SUMMARY:"+calendarTextEscaper.Replace(e.summary))
+		if e.description != "" {
+			writeCalendarLine(&b, "DESCRIPTION:"+calendarTextEscaper.Replace(e.description))
+		}
+		writeCalendarLine(&b, "END:VEVENT")
+	}
+
+	writeCalendarLine(&b, "END:VCALENDAR")
+
+	return []byte(b.String())
+}
+
+// writeCalendarLine writes a content line of a calendar, terminated by CRLF. Lines that are longer than
+// [calendarLineLength] octets are folded, i.e., continued on the next line after a leading space, without splitting
+// UTF-8 characters.
+func writeCalendarLine(b *strings.Builder, line string) {
+	// The leading space of continuation lines counts towards their length
+	for limit := calendarLineLength; len(line) > limit; limit = calendarLineLength - 1 {
+		i := limit
+		for !utf8.RuneStart(line[i]) {
+			i--
+		}
+
+		b.WriteString(line[:i])
+		b.WriteString("\r\n ")
+		line = line[i:]
+	}
+
+	b.WriteString(line)
+	b.WriteString("\r\n")
+}
@@ -0,0 +1,476 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package evaluation
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"testing"
+	"time"
+
+	"confirmate.io/core/api/evaluation"
+	"confirmate.io/core/api/orchestrator"
+	"confirmate.io/core/api/orchestrator/orchestratorconnect"
+	"confirmate.io/core/service"
+	"confirmate.io/core/service/evaluation/evaluationtest"
+	"confirmate.io/core/util/assert"
+
+	"connectrpc.com/connect"
+	"google.golang.org/genproto/googleapis/api/httpbody"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// testCalendarSecret is the calendar secret of the tests.
+const testCalendarSecret = "calendar-secret"
+
+// testCalendarToken returns the expected token of the calendar feed of the audit scope for [testCalendarSecret].
+func testCalendarToken(auditScopeId string) string {
+	mac := hmac.New(sha256.New, []byte(testCalendarSecret))
+	mac.Write([]byte(auditScopeId))
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func TestService_GetCalendarSubscription(t *testing.T) {
+	type fields struct {
+		orchestratorClient orchestratorconnect.OrchestratorClient
+		authz              service.AuthorizationStrategy
+		cfg                Config
+	}
+	type args struct {
+		req *connect.Request[evaluation.GetCalendarSubscriptionRequest]
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    assert.Want[*connect.Response[evaluation.CalendarSubscription]]
+		wantErr assert.WantErr
+	}{
+		{
+			name: "err: validation error",
+			args: args{
+				req: connect.NewRequest(&evaluation.GetCalendarSubscriptionRequest{}),
+			},
+			want: assert.Nil[*connect.Response[evaluation.CalendarSubscription]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeInvalidArgument)
+			},
+		},
+		{
+			name: "err: permission denied",
+			fields: fields{
+				authz: &denyAuthorizationStrategy{},
+				cfg:   Config{CalendarSecret: testCalendarSecret},
+			},
+			args: args{
+				req: connect.NewRequest(&evaluation.GetCalendarSubscriptionRequest{
+					AuditScopeId: evaluationtest.MockAuditScopeId1,
+				}),
+			},
+			want: assert.Nil[*connect.Response[evaluation.CalendarSubscription]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodePermissionDenied)
+			},
+		},
+		{
+			name: "err: calendar feeds disabled",
+			fields: fields{
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			args: args{
+				req: connect.NewRequest(&evaluation.GetCalendarSubscriptionRequest{
+					AuditScopeId: evaluationtest.MockAuditScopeId1,
+				}),
+			},
+			want: assert.Nil[*connect.Response[evaluation.CalendarSubscription]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeFailedPrecondition) &&
+					assert.ErrorIs(t, err, errCalendarDisabled)
+			},
+		},
+		{
+			name: "err: audit scope not found",
+			fields: fields{
+				orchestratorClient: newOrchestratorClient(t,
+					WithGetAuditScopeNotFoundError(connect.NewError(connect.CodeNotFound, service.ErrNotFound("audit scope"))),
+				),
+				authz: &service.AuthorizationStrategyAllowAll{},
+				cfg:   Config{CalendarSecret: testCalendarSecret},
+			},
+			args: args{
+				req: connect.NewRequest(&evaluation.GetCalendarSubscriptionRequest{
+					AuditScopeId: evaluationtest.MockAuditScopeId1,
+				}),
+			},
+			want: assert.Nil[*connect.Response[evaluation.CalendarSubscription]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeNotFound)
+			},
+		},
+		{
+			name: "happy path",
+			fields: fields{
+				orchestratorClient: newOrchestratorClient(t,
+					WithAuditScope(evaluationtest.MockAuditScope1),
+				),
+				authz: &service.AuthorizationStrategyAllowAll{},
+				cfg: Config{
+					CalendarURL:    "https://confirmate.example/api",
+					CalendarSecret: testCalendarSecret,
+				},
+			},
+			args: args{
+				req: connect.NewRequest(&evaluation.GetCalendarSubscriptionRequest{
+					AuditScopeId: evaluationtest.MockAuditScopeId1,
+					Locale:       new(LocaleGerman),
+				}),
+			},
+			want: func(t *testing.T, got *connect.Response[evaluation.CalendarSubscription], msgAndArgs ...any) bool {
+				return assert.Equal(t, &evaluation.CalendarSubscription{
+					AuditScopeId: evaluationtest.MockAuditScopeId1,
+					Url: "https://confirmate.example/api/v1/evaluation/calendar/" + evaluationtest.MockAuditScopeId1 +
+						"/feed.ics?locale=de&token=" + testCalendarToken(evaluationtest.MockAuditScopeId1),
+				}, got.Msg)
+			},
+			wantErr: assert.NoError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &Service{
+				orchestratorClient: tt.fields.orchestratorClient,
+				authz:              tt.fields.authz,
+				cfg:                tt.fields.cfg,
+			}
+
+			got, err := svc.GetCalendarSubscription(context.Background(), tt.args.req)
+			tt.want(t, got)
+			tt.wantErr(t, err)
+		})
+	}
+}
+
+func TestService_GetCalendarFeed(t *testing.T) {
+	var (
+		validUntil = time.Date(2026, 11, 30, 0, 0, 0, 0, time.UTC)
+		startsAt   = time.Date(2026, 11, 7, 22, 0, 0, 0, time.UTC)
+	)
+
+	type fields struct {
+		orchestratorClient orchestratorconnect.OrchestratorClient
+		cfg                Config
+	}
+	type args struct {
+		req *connect.Request[evaluation.GetCalendarFeedRequest]
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    assert.Want[*connect.Response[httpbody.HttpBody]]
+		wantErr assert.WantErr
+	}{
+		{
+			name: "err: validation error",
+			args: args{
+				req: connect.NewRequest(&evaluation.GetCalendarFeedRequest{
+					AuditScopeId: evaluationtest.MockAuditScopeId1,
+				}),
+			},
+			want: assert.Nil[*connect.Response[httpbody.HttpBody]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeInvalidArgument)
+			},
+		},
+		{
+			name: "err: calendar feeds disabled",
+			args: args{
+				req: connect.NewRequest(&evaluation.GetCalendarFeedRequest{
+					AuditScopeId: evaluationtest.MockAuditScopeId1,
+					Token:        testCalendarToken(evaluationtest.MockAuditScopeId1),
+				}),
+			},
+			want: assert.Nil[*connect.Response[httpbody.HttpBody]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeFailedPrecondition)
+			},
+		},
+		{
+			name: "err: token of another audit scope",
+			fields: fields{
+				cfg: Config{CalendarSecret: testCalendarSecret},
+			},
+			args: args{
+				req: connect.NewRequest(&evaluation.GetCalendarFeedRequest{
+					AuditScopeId: evaluationtest.MockAuditScopeId1,
+					Token:        testCalendarToken(evaluationtest.MockAuditScopeId2),
+				}),
+			},
+			want: assert.Nil[*connect.Response[httpbody.HttpBody]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeUnauthenticated)
+			},
+		},
+		{
+			name: "err: audit scope not found",
+			fields: fields{
+				orchestratorClient: newOrchestratorClient(t,
+					WithGetAuditScopeNotFoundError(connect.NewError(connect.CodeNotFound, service.ErrNotFound("audit scope"))),
+				),
+				cfg: Config{CalendarSecret: testCalendarSecret},
+			},
+			args: args{
+				req: connect.NewRequest(&evaluation.GetCalendarFeedRequest{
+					AuditScopeId: evaluationtest.MockAuditScopeId1,
+					Token:        testCalendarToken(evaluationtest.MockAuditScopeId1),
+				}),
+			},
+			want: assert.Nil[*connect.Response[httpbody.HttpBody]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeNotFound)
+			},
+		},
+		{
+			name: "err: certificates cannot be retrieved",
+			fields: fields{
+				orchestratorClient: newOrchestratorClient(t,
+					WithAuditScope(evaluationtest.MockAuditScope1),
+					WithListCertificatesError(connect.NewError(connect.CodeInternal, service.ErrNotFound("certificates"))),
+				),
+				cfg: Config{CalendarSecret: testCalendarSecret},
+			},
+			args: args{
+				req: connect.NewRequest(&evaluation.GetCalendarFeedRequest{
+					AuditScopeId: evaluationtest.MockAuditScopeId1,
+					Token:        testCalendarToken(evaluationtest.MockAuditScopeId1),
+				}),
+			},
+			want: assert.Nil[*connect.Response[httpbody.HttpBody]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeInternal)
+			},
+		},
+		{
+			name: "happy path",
+			fields: fields{
+				orchestratorClient: newOrchestratorClient(t,
+					WithAuditScope(&orchestrator.AuditScope{
+						Id:                   evaluationtest.MockAuditScopeId1,
+						Name:                 "Production",
+						TargetOfEvaluationId: evaluationtest.MockToeId1,
+						CatalogId:            evaluationtest.MockCatalogId1,
+						Locale:               new(LocaleGerman),
+					}),
+					WithEvaluationResults([]*evaluation.EvaluationResult{
+						{
+							Id:         evaluationtest.MockEvaluationResultId1,
+							ControlId:  evaluationtest.MockControlId1,
+							Comment:    new("Checked manually"),
+							ValidUntil: timestamppb.New(validUntil),
+						},
+						{
+							Id:        evaluationtest.MockEvaluationResultId2,
+							ControlId: evaluationtest.MockControlId2,
+						},
+					}),
+					WithCertificates(
+						&orchestrator.Certificate{
+							Id:                   "cert-1",
+							Name:                 "EUCS",
+							TargetOfEvaluationId: evaluationtest.MockToeId1,
+							ExpirationDate:       "2027-01-31",
+						},
+						&orchestrator.Certificate{
+							Id:                   "cert-2",
+							Name:                 "EUCS",
+							TargetOfEvaluationId: evaluationtest.MockToeId2,
+							ExpirationDate:       "2027-02-28",
+						},
+						&orchestrator.Certificate{
+							Id:                   "cert-3",
+							Name:                 "Invalid",
+							TargetOfEvaluationId: evaluationtest.MockToeId1,
+							ExpirationDate:       "soon",
+						},
+					),
+					WithMaintenanceWindows(&orchestrator.MaintenanceWindow{
+						Id:           "00000000-0000-0000-0002-000000000001",
+						AuditScopeId: evaluationtest.MockAuditScopeId1,
+						StartsAt:     timestamppb.New(startsAt),
+						EndsAt:       timestamppb.New(startsAt.Add(4 * time.Hour)),
+						Mode:         orchestrator.MaintenanceMode_MAINTENANCE_MODE_SUPPRESS,
+						Description:  new("Migration; part 1"),
+					}),
+				),
+				cfg: Config{CalendarSecret: testCalendarSecret},
+			},
+			args: args{
+				req: connect.NewRequest(&evaluation.GetCalendarFeedRequest{
+					AuditScopeId: evaluationtest.MockAuditScopeId1,
+					Token:        testCalendarToken(evaluationtest.MockAuditScopeId1),
+				}),
+			},
+			want: func(t *testing.T, got *connect.Response[httpbody.HttpBody], msgAndArgs ...any) bool {
+				feed := string(got.Msg.GetData())
+
+				return assert.Equal(t, calendarContentType, got.Msg.GetContentType()) &&
+					assert.Contains(t, feed, "X-WR-CALNAME:Confirmate: Production\r\n") &&
+					assert.Contains(t, feed, "UID:maintenance-window-00000000-0000-0000-0002-000000000001@confirmate\r\n"+
+						"DTSTAMP:") &&
+					assert.Contains(t, feed, "DTSTART:20261107T220000Z\r\nDTEND:20261108T020000Z\r\n"+
+						"SUMMARY:Wartungsfenster: Evaluierung ausgesetzt\r\nDESCRIPTION:Migration\\; part 1\r\n") &&
+					assert.Contains(t, feed, "UID:manual-result-"+evaluationtest.MockEvaluationResultId1+"@confirmate\r\n") &&
+					assert.Contains(t, feed, "DTSTART;VALUE=DATE:20261130\r\nDTEND;VALUE=DATE:20261201\r\n") &&
+					assert.Contains(t, feed, "UID:certificate-cert-1@confirmate\r\n") &&
+					assert.Contains(t, feed, "SUMMARY:Zertifikat EUCS läuft ab\r\n") &&
+					assert.Equal(t, 3, strings.Count(feed, "BEGIN:VEVENT")) &&
+					// Events are sorted by their start
+					assert.True(t, strings.Index(feed, "maintenance-window-") < strings.Index(feed, "manual-result-")) &&
+					assert.True(t, strings.Index(feed, "manual-result-") < strings.Index(feed, "certificate-cert-1"))
+			},
+			wantErr: assert.NoError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &Service{
+				orchestratorClient: tt.fields.orchestratorClient,
+				cfg:                tt.fields.cfg,
+			}
+
+			got, err := svc.GetCalendarFeed(context.Background(), tt.args.req)
+			tt.want(t, got)
+			tt.wantErr(t, err)
+		})
+	}
+}
+
+func Test_parseCertificateDate(t *testing.T) {
+	tests := []struct {
+		name   string
+		s      string
+		want   time.Time
+		wantOk bool
+	}{
+		{
+			name:   "date",
+			s:      "2027-01-31",
+			want:   time.Date(2027, 1, 31, 0, 0, 0, 0, time.UTC),
+			wantOk: true,
+		},
+		{
+			name:   "timestamp",
+			s:      "2027-01-31T23:30:00+02:00",
+			want:   time.Date(2027, 1, 31, 21, 30, 0, 0, time.UTC),
+			wantOk: true,
+		},
+		{
+			name: "invalid",
+			s:    "31.01.2027",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := parseCertificateDate(tt.s)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantOk, ok)
+		})
+	}
+}
+
+func Test_writeCalendar(t *testing.T) {
+	var (
+		now = time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+		day = time.Date(2026, 11, 30, 0, 0, 0, 0, time.UTC)
+	)
+
+	got := writeCalendar("Confirmate: Prod, EU", []calendarEvent{
+		{
+			uid:     "certificate-1@confirmate",
+			summary: "Certificate expires",
+			start:   day,
+			end:     day.AddDate(0, 0, 1),
+			allDay:  true,
+		},
+		{
+			uid:         "maintenance-window-1@confirmate",
+			summary:     "Maintenance window",
+			description: "Line 1\nLine 2",
+			start:       day.Add(2 * time.Hour),
+			end:         day.Add(3 * time.Hour),
+		},
+	}, now)
+
+	assert.Equal(t, "BEGIN:VCALENDAR\r\n"+
+		"VERSION:2.0\r\n"+
+		"PRODID:-//Confirmate//Evaluation//EN\r\n"+
+		"CALSCALE:GREGORIAN\r\n"+
+		"METHOD:PUBLISH\r\n"+
+		"X-WR-CALNAME:Confirmate: Prod\\, EU\r\n"+
+		"BEGIN:VEVENT\r\n"+
+		"UID:certificate-1@confirmate\r\n"+
+		"DTSTAMP:20261016T120000Z\r\n"+
+		"DTSTART;VALUE=DATE:20261130\r\n"+
+		"DTEND;VALUE=DATE:20261201\r\n"+
+		"SUMMARY:Certificate expires\r\n"+
+		"END:VEVENT\r\n"+
+		"BEGIN:VEVENT\r\n"+
+		"UID:maintenance-window-1@confirmate\r\n"+
+		"DTSTAMP:20261016T120000Z\r\n"+
+		"DTSTART:20261130T020000Z\r\n"+
+		"DTEND:20261130T030000Z\r\n"+
+		"SUMMARY:Maintenance window\r\n"+
+		"DESCRIPTION:Line 1\\nLine 2\r\n"+
+		"END:VEVENT\r\n"+
+		"END:VCALENDAR\r\n", string(got))
+}
+
+func Test_writeCalendarLine(t *testing.T) {
+	tests := []struct {
+		name string
+		line string
+		want string
+	}{
+		{
+			name: "short line",
+			line: "SUMMARY:Short",
+			want: "SUMMARY:Short\r\n",
+		},
+		{
+			name: "long line",
+			line: "DESCRIPTION:" + strings.Repeat("a", 150),
+			want: "DESCRIPTION:" + strings.Repeat("a", 63) + "\r\n " + strings.Repeat("a", 74) + "\r\n " +
+				strings.Repeat("a", 13) + "\r\n",
+		},
+		{
+			name: "multi-byte characters are not split",
+			line: "SUMMARY:" + strings.Repeat("a", 66) + "ä",
+			want: "SUMMARY:" + strings.Repeat("a", 66) + "\r\n ä\r\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var b strings.Builder
+
+			writeCalendarLine(&b, tt.line)
+			assert.Equal(t, tt.want, b.String())
+		})
+	}
+}
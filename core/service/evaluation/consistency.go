@@ -0,0 +1,342 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package evaluation
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"confirmate.io/core/api"
+	"confirmate.io/core/api/assessment"
+	"confirmate.io/core/api/evaluation"
+	"confirmate.io/core/api/evidence"
+	"confirmate.io/core/api/orchestrator"
+	"confirmate.io/core/log"
+	"confirmate.io/core/service"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// consistencyChecker holds the state of the consistency checks, see [Service.CheckConsistency].
+type consistencyChecker struct {
+	// running serializes the checks, so that a requested check does not overlap with a periodic one.
+	running sync.Mutex
+
+	mu     sync.RWMutex
+	report *evaluation.ConsistencyReport
+}
+
+// CheckConsistency runs a consistency check and returns its report. It is restricted to admins, since the report
+// contains the entities of all targets of evaluation.
+func (svc *Service) CheckConsistency(ctx context.Context, req *connect.Request[evaluation.CheckConsistencyRequest]) (res *connect.Response[evaluation.ConsistencyReport], err error) {
+	var (
+		allowed bool
+		report  *evaluation.ConsistencyReport
+	)
+
+	// Validate the request
+	if err = service.Validate(req); err != nil {
+		return nil, err
+	}
+
+	// Check access via the configured auth strategy
+	allowed, _, err = checkAccess(ctx, svc.authz, orchestrator.RequestType_REQUEST_TYPE_UPDATED, "", orchestrator.ObjectType_OBJECT_TYPE_CONSISTENCY_REPORT)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if !allowed {
+		return nil, service.ErrPermissionDenied
+	}
+
+	report, err = svc.checkConsistency(ctx)
+	if err != nil {
+		slog.Error("Could not check consistency", log.Err(err))
+		return nil, connect.NewError(connect.CodeUnavailable, errors.New("could not check consistency"))
+	}
+
+	res = connect.NewResponse(report)
+	return
+}
+
+// GetConsistencyReport returns the report of the latest consistency check. It is restricted to admins, see
+// [Service.CheckConsistency].
+func (svc *Service) GetConsistencyReport(ctx context.Context, req *connect.Request[evaluation.GetConsistencyReportRequest]) (res *connect.Response[evaluation.ConsistencyReport], err error) {
+	var (
+		allowed bool
+		report  *evaluation.ConsistencyReport
+	)
+
+	// Validate the request
+	if err = service.Validate(req); err != nil {
+		return nil, err
+	}
+
+	// Check access via the configured auth strategy
+	allowed, _, err = checkAccess(ctx, svc.authz, orchestrator.RequestType_REQUEST_TYPE_GET, "", orchestrator.ObjectType_OBJECT_TYPE_CONSISTENCY_REPORT)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if !allowed {
+		return nil, service.ErrPermissionDenied
+	}
+
+	svc.consistency.mu.RLock()
+	report = svc.consistency.report
+	svc.consistency.mu.RUnlock()
+
+	if report == nil {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("no consistency check has finished yet"))
+	}
+
+	res = connect.NewResponse(report)
+	return
+}
+
+// checkConsistencyPeriodically runs a consistency check every [Config.ConsistencyCheckInterval] until ctx is done.
+func (svc *Service) checkConsistencyPeriodically(ctx context.Context) {
+	var ticker = time.NewTicker(max(svc.cfg.ConsistencyCheckInterval, time.Second))
+
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := svc.checkConsistency(ctx); err != nil {
+				slog.Warn("Could not check consistency, retrying later", log.Err(err))
+			}
+		}
+	}
+}
+
+// checkConsistency checks whether the entities referenced across the services still exist and stores the report as
+// the latest one. The evidences of assessment results are only checked if an evidence store is configured (see
+// [Config.EvidenceStoreAddress]).
+func (svc *Service) checkConsistency(ctx context.Context) (report *evaluation.ConsistencyReport, err error) {
+	var (
+		evaluationResults []*evaluation.EvaluationResult
+		assessmentResults []*assessment.AssessmentResult
+		auditScopes       []*orchestrator.AuditScope
+		catalogs          []*orchestrator.Catalog
+		evidences         []*evidence.Evidence
+		assessmentIds     = make(map[string]bool)
+		evidenceIds       = make(map[string]bool)
+		catalogIds        = make(map[string]bool)
+	)
+
+	svc.consistency.running.Lock()
+	defer svc.consistency.running.Unlock()
+
+	report = &evaluation.ConsistencyReport{}
+
+	evaluationResults, err = api.ListAllPaginated(ctx, &orchestrator.ListEvaluationResultsRequest{},
+		func(ctx context.Context, req *orchestrator.ListEvaluationResultsRequest) (*orchestrator.ListEvaluationResultsResponse, error) {
+			res, err := svc.orchestratorClient.ListEvaluationResults(ctx, connect.NewRequest(req))
+			if err != nil {
+				return nil, err
+			}
+			return res.Msg, nil
+		}, func(res *orchestrator.ListEvaluationResultsResponse) []*evaluation.EvaluationResult {
+			return res.Results
+		})
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve evaluation results: %w", err)
+	}
+
+	assessmentResults, err = api.ListAllPaginated(ctx, &orchestrator.ListAssessmentResultsRequest{},
+		func(ctx context.Context, req *orchestrator.ListAssessmentResultsRequest) (*orchestrator.ListAssessmentResultsResponse, error) {
+			res, err := svc.orchestratorClient.ListAssessmentResults(ctx, connect.NewRequest(req))
+			if err != nil {
+				return nil, err
+			}
+			return res.Msg, nil
+		}, func(res *orchestrator.ListAssessmentResultsResponse) []*assessment.AssessmentResult {
+			return res.Results
+		})
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve assessment results: %w", err)
+	}
+
+	auditScopes, err = api.ListAllPaginated(ctx, &orchestrator.ListAuditScopesRequest{},
+		func(ctx context.Context, req *orchestrator.ListAuditScopesRequest) (*orchestrator.ListAuditScopesResponse, error) {
+			res, err := svc.orchestratorClient.ListAuditScopes(ctx, connect.NewRequest(req))
+			if err != nil {
+				return nil, err
+			}
+			return res.Msg, nil
+		}, func(res *orchestrator.ListAuditScopesResponse) []*orchestrator.AuditScope {
+			return res.AuditScopes
+		})
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve audit scopes: %w", err)
+	}
+
+	catalogs, err = api.ListAllPaginated(ctx, &orchestrator.ListCatalogsRequest{},
+		func(ctx context.Context, req *orchestrator.ListCatalogsRequest) (*orchestrator.ListCatalogsResponse, error) {
+			res, err := svc.orchestratorClient.ListCatalogs(ctx, connect.NewRequest(req))
+			if err != nil {
+				return nil, err
+			}
+			return res.Msg, nil
+		}, func(res *orchestrator.ListCatalogsResponse) []*orchestrator.Catalog {
+			return res.Catalogs
+		})
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve catalogs: %w", err)
+	}
+
+	if svc.evidenceStoreClient != nil {
+		evidences, err = api.ListAllPaginated(ctx, &evidence.ListEvidencesRequest{},
+			func(ctx context.Context, req *evidence.ListEvidencesRequest) (*evidence.ListEvidencesResponse, error) {
+				res, err := svc.evidenceStoreClient.ListEvidences(ctx, connect.NewRequest(req))
+				if err != nil {
+					return nil, err
+				}
+				return res.Msg, nil
+			}, func(res *evidence.ListEvidencesResponse) []*evidence.Evidence {
+				return res.Evidences
+			})
+		if err != nil {
+			return nil, fmt.Errorf("could not retrieve evidences: %w", err)
+		}
+
+		for _, ev := range evidences {
+			evidenceIds[ev.GetId()] = true
+		}
+	}
+
+	// Evaluation results need to point to existing assessment results
+	for _, result := range assessmentResults {
+		assessmentIds[result.GetId()] = true
+	}
+	for _, result := range evaluationResults {
+		for _, id := range result.GetAssessmentResultIds() {
+			if !assessmentIds[id] {
+				report.Orphans = append(report.Orphans, &evaluation.Orphan{
+					Type:                 evaluation.OrphanType_ORPHAN_TYPE_EVALUATION_RESULT,
+					Id:                   result.GetId(),
+					MissingId:            id,
+					TargetOfEvaluationId: result.GetTargetOfEvaluationId(),
+				})
+			}
+		}
+	}
+	report.EvaluationResultsChecked = int64(len(evaluationResults))
+
+	// Assessment results need to point to existing evidences
+	if svc.evidenceStoreClient != nil {
+		for _, result := range assessmentResults {
+			// Imported findings of external audits have no evidence in the evidence store
+			if result.GetExternallySourced() {
+				continue
+			}
+
+			if !evidenceIds[result.GetEvidenceId()] {
+				report.Orphans = append(report.Orphans, &evaluation.Orphan{
+					Type:                 evaluation.OrphanType_ORPHAN_TYPE_ASSESSMENT_RESULT,
+					Id:                   result.GetId(),
+					MissingId:            result.GetEvidenceId(),
+					TargetOfEvaluationId: result.GetTargetOfEvaluationId(),
+				})
+			}
+		}
+		report.AssessmentResultsChecked = int64(len(assessmentResults))
+	}
+
+	// Audit scopes need to point to existing catalogs
+	for _, catalog := range catalogs {
+		catalogIds[catalog.GetId()] = true
+	}
+	for _, auditScope := range auditScopes {
+		for _, id := range auditScope.AllCatalogIds() {
+			if !catalogIds[id] {
+				report.Orphans = append(report.Orphans, &evaluation.Orphan{
+					Type:                 evaluation.OrphanType_ORPHAN_TYPE_AUDIT_SCOPE,
+					Id:                   auditScope.GetId(),
+					MissingId:            id,
+					TargetOfEvaluationId: auditScope.GetTargetOfEvaluationId(),
+				})
+			}
+		}
+	}
+	report.AuditScopesChecked = int64(len(auditScopes))
+
+	slices.SortFunc(report.Orphans, func(a, b *evaluation.Orphan) int {
+		return cmp.Or(
+			cmp.Compare(a.GetType(), b.GetType()),
+			strings.Compare(a.GetId(), b.GetId()),
+			strings.Compare(a.GetMissingId(), b.GetMissingId()),
+		)
+	})
+	report.CheckedAt = timestamppb.Now()
+
+	if len(report.Orphans) > 0 {
+		slog.Warn("Consistency check found orphaned entities", slog.Int("orphans", len(report.Orphans)))
+	}
+
+	svc.consistency.mu.Lock()
+	svc.consistency.report = report
+	svc.consistency.mu.Unlock()
+
+	return report, nil
+}
+
+// WriteMetrics writes the number of orphans of the latest consistency check by their type and the time of the check in
+// the Prometheus text format. Nothing is written before the first check finished. This implements
+// [server.MetricsCollector].
+func (svc *Service) WriteMetrics(w io.Writer) {
+	var (
+		report *evaluation.ConsistencyReport
+		counts = make(map[evaluation.OrphanType]int)
+	)
+
+	svc.consistency.mu.RLock()
+	report = svc.consistency.report
+	svc.consistency.mu.RUnlock()
+
+	if report == nil {
+		return
+	}
+
+	for _, orphan := range report.GetOrphans() {
+		counts[orphan.GetType()]++
+	}
+
+	fmt.Fprintln(w, "# HELP confirmate_consistency_orphans Number of entities referencing missing entities found by the latest consistency check, by type.")
+	fmt.Fprintln(w, "# TYPE confirmate_consistency_orphans gauge")
+	for _, typ := range []evaluation.OrphanType{
+		evaluation.OrphanType_ORPHAN_TYPE_EVALUATION_RESULT,
+		evaluation.OrphanType_ORPHAN_TYPE_ASSESSMENT_RESULT,
+		evaluation.OrphanType_ORPHAN_TYPE_AUDIT_SCOPE,
+	} {
+		fmt.Fprintf(w, "confirmate_consistency_orphans{type=%q} %d\n",
+			strings.ToLower(strings.TrimPrefix(typ.String(), "ORPHAN_TYPE_")), counts[typ])
+	}
+
+	fmt.Fprintln(w, "# HELP confirmate_consistency_last_check_timestamp_seconds Time of the latest consistency check.")
+	fmt.Fprintln(w, "# TYPE confirmate_consistency_last_check_timestamp_seconds gauge")
+	fmt.Fprintf(w, "confirmate_consistency_last_check_timestamp_seconds %d\n", report.GetCheckedAt().GetSeconds())
+}
@@ -0,0 +1,396 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package evaluation
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"confirmate.io/core/api/assessment"
+	"confirmate.io/core/api/evaluation"
+	"confirmate.io/core/api/evidence"
+	"confirmate.io/core/api/evidence/evidenceconnect"
+	"confirmate.io/core/api/orchestrator"
+	"confirmate.io/core/api/orchestrator/orchestratorconnect"
+	"confirmate.io/core/server"
+	"confirmate.io/core/server/servertest"
+	"confirmate.io/core/service"
+	"confirmate.io/core/service/evaluation/evaluationtest"
+	"confirmate.io/core/util/assert"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// mockEvidenceStoreHandler is a mock implementation of the evidence store, which lists the configured evidences.
+type mockEvidenceStoreHandler struct {
+	evidenceconnect.UnimplementedEvidenceStoreHandler
+
+	evidences []*evidence.Evidence
+}
+
+// ListEvidences returns the mocked evidences.
+func (m *mockEvidenceStoreHandler) ListEvidences(
+	_ context.Context,
+	_ *connect.Request[evidence.ListEvidencesRequest],
+) (*connect.Response[evidence.ListEvidencesResponse], error) {
+	return connect.NewResponse(&evidence.ListEvidencesResponse{
+		Evidences: m.evidences,
+	}), nil
+}
+
+// newEvidenceStoreClient creates an evidence store client for a mock evidence store with the given evidences.
+func newEvidenceStoreClient(t *testing.T, evidences ...*evidence.Evidence) evidenceconnect.EvidenceStoreClient {
+	t.Helper()
+
+	_, testSrv := servertest.NewTestConnectServer(
+		t,
+		server.WithHandler(evidenceconnect.NewEvidenceStoreHandler(&mockEvidenceStoreHandler{evidences: evidences})),
+	)
+	t.Cleanup(testSrv.Close)
+
+	return evidenceconnect.NewEvidenceStoreClient(testSrv.Client(), testSrv.URL)
+}
+
+func TestService_CheckConsistency(t *testing.T) {
+	var (
+		assessmentResult = &assessment.AssessmentResult{
+			Id:                   "11111111-1111-1111-1111-111111111111",
+			EvidenceId:           "22222222-2222-2222-2222-222222222222",
+			TargetOfEvaluationId: evaluationtest.MockToeId1,
+		}
+		evaluationResults = []*evaluation.EvaluationResult{
+			{
+				Id:                   evaluationtest.MockEvaluationResultId1,
+				TargetOfEvaluationId: evaluationtest.MockToeId1,
+				AssessmentResultIds:  []string{assessmentResult.Id},
+			},
+			{
+				Id:                   evaluationtest.MockEvaluationResultId2,
+				TargetOfEvaluationId: evaluationtest.MockToeId1,
+				AssessmentResultIds:  []string{assessmentResult.Id, "33333333-3333-3333-3333-333333333333"},
+			},
+		}
+		auditScopes = []*orchestrator.AuditScope{
+			{
+				Id:                   evaluationtest.MockAuditScopeId1,
+				TargetOfEvaluationId: evaluationtest.MockToeId1,
+				CatalogId:            evaluationtest.MockCatalogId1,
+			},
+			{
+				Id:                   evaluationtest.MockAuditScopeId2,
+				TargetOfEvaluationId: evaluationtest.MockToeId2,
+				CatalogId:            evaluationtest.MockCatalogId2,
+			},
+		}
+	)
+
+	type fields struct {
+		orchestratorClient  orchestratorconnect.OrchestratorClient
+		evidenceStoreClient evidenceconnect.EvidenceStoreClient
+		authz               service.AuthorizationStrategy
+	}
+	type args struct {
+		req *connect.Request[evaluation.CheckConsistencyRequest]
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    assert.Want[*connect.Response[evaluation.ConsistencyReport]]
+		wantErr assert.WantErr
+	}{
+		{
+			name: "err: permission denied",
+			fields: fields{
+				authz: &denyAuthorizationStrategy{},
+			},
+			args: args{
+				req: connect.NewRequest(&evaluation.CheckConsistencyRequest{}),
+			},
+			want: assert.Nil[*connect.Response[evaluation.ConsistencyReport]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodePermissionDenied)
+			},
+		},
+		{
+			name: "err: orchestrator error",
+			fields: fields{
+				orchestratorClient: newOrchestratorClient(t,
+					func(h *mockOrchestratorHandler) {
+						h.listEvalError = connect.NewError(connect.CodeInternal, errors.New("database error"))
+					},
+				),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			args: args{
+				req: connect.NewRequest(&evaluation.CheckConsistencyRequest{}),
+			},
+			want: assert.Nil[*connect.Response[evaluation.ConsistencyReport]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeUnavailable)
+			},
+		},
+		{
+			name: "happy path: without evidence store",
+			fields: fields{
+				orchestratorClient: newOrchestratorClient(t,
+					WithEvaluationResults(evaluationResults),
+					WithAssessmentResults([]*assessment.AssessmentResult{assessmentResult}),
+					WithAuditScopes(auditScopes...),
+					WithCatalogs(&orchestrator.Catalog{Id: evaluationtest.MockCatalogId1}),
+				),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			args: args{
+				req: connect.NewRequest(&evaluation.CheckConsistencyRequest{}),
+			},
+			want: func(t *testing.T, got *connect.Response[evaluation.ConsistencyReport], msgAndArgs ...any) bool {
+				return assert.NotNil(t, got.Msg.CheckedAt) &&
+					assert.Equal(t, &evaluation.ConsistencyReport{
+						CheckedAt: got.Msg.CheckedAt,
+						Orphans: []*evaluation.Orphan{
+							{
+								Type:                 evaluation.OrphanType_ORPHAN_TYPE_EVALUATION_RESULT,
+								Id:                   evaluationtest.MockEvaluationResultId2,
+								MissingId:            "33333333-3333-3333-3333-333333333333",
+								TargetOfEvaluationId: evaluationtest.MockToeId1,
+							},
+							{
+								Type:                 evaluation.OrphanType_ORPHAN_TYPE_AUDIT_SCOPE,
+								Id:                   evaluationtest.MockAuditScopeId2,
+								MissingId:            evaluationtest.MockCatalogId2,
+								TargetOfEvaluationId: evaluationtest.MockToeId2,
+							},
+						},
+						EvaluationResultsChecked: 2,
+						AuditScopesChecked:       2,
+					}, got.Msg)
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "happy path: with evidence store",
+			fields: fields{
+				orchestratorClient: newOrchestratorClient(t,
+					WithAssessmentResults([]*assessment.AssessmentResult{assessmentResult}),
+				),
+				evidenceStoreClient: newEvidenceStoreClient(t),
+				authz:               &service.AuthorizationStrategyAllowAll{},
+			},
+			args: args{
+				req: connect.NewRequest(&evaluation.CheckConsistencyRequest{}),
+			},
+			want: func(t *testing.T, got *connect.Response[evaluation.ConsistencyReport], msgAndArgs ...any) bool {
+				return assert.Equal(t, &evaluation.ConsistencyReport{
+					CheckedAt: got.Msg.CheckedAt,
+					Orphans: []*evaluation.Orphan{
+						{
+							Type:                 evaluation.OrphanType_ORPHAN_TYPE_ASSESSMENT_RESULT,
+							Id:                   assessmentResult.Id,
+							MissingId:            assessmentResult.EvidenceId,
+							TargetOfEvaluationId: evaluationtest.MockToeId1,
+						},
+					},
+					AssessmentResultsChecked: 1,
+				}, got.Msg)
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "happy path: externally sourced",
+			fields: fields{
+				orchestratorClient: newOrchestratorClient(t,
+					WithAssessmentResults([]*assessment.AssessmentResult{{
+						Id:                   assessmentResult.Id,
+						EvidenceId:           assessmentResult.EvidenceId,
+						TargetOfEvaluationId: evaluationtest.MockToeId1,
+						ExternallySourced:    true,
+					}}),
+				),
+				evidenceStoreClient: newEvidenceStoreClient(t),
+				authz:               &service.AuthorizationStrategyAllowAll{},
+			},
+			args: args{
+				req: connect.NewRequest(&evaluation.CheckConsistencyRequest{}),
+			},
+			want: func(t *testing.T, got *connect.Response[evaluation.ConsistencyReport], msgAndArgs ...any) bool {
+				// Imported findings have no evidence in the evidence store
+				return assert.Empty(t, got.Msg.Orphans) &&
+					assert.Equal(t, int64(1), got.Msg.AssessmentResultsChecked)
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "happy path: consistent",
+			fields: fields{
+				orchestratorClient: newOrchestratorClient(t,
+					WithAssessmentResults([]*assessment.AssessmentResult{assessmentResult}),
+				),
+				evidenceStoreClient: newEvidenceStoreClient(t, &evidence.Evidence{Id: assessmentResult.EvidenceId}),
+				authz:               &service.AuthorizationStrategyAllowAll{},
+			},
+			args: args{
+				req: connect.NewRequest(&evaluation.CheckConsistencyRequest{}),
+			},
+			want: func(t *testing.T, got *connect.Response[evaluation.ConsistencyReport], msgAndArgs ...any) bool {
+				return assert.Empty(t, got.Msg.Orphans) &&
+					assert.Equal(t, int64(1), got.Msg.AssessmentResultsChecked)
+			},
+			wantErr: assert.NoError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &Service{
+				orchestratorClient:  tt.fields.orchestratorClient,
+				evidenceStoreClient: tt.fields.evidenceStoreClient,
+				authz:               tt.fields.authz,
+			}
+
+			got, err := svc.CheckConsistency(context.Background(), tt.args.req)
+			tt.want(t, got)
+			tt.wantErr(t, err)
+		})
+	}
+}
+
+func TestService_GetConsistencyReport(t *testing.T) {
+	var report = &evaluation.ConsistencyReport{
+		CheckedAt:          timestamppb.Now(),
+		AuditScopesChecked: 1,
+	}
+
+	type fields struct {
+		authz  service.AuthorizationStrategy
+		report *evaluation.ConsistencyReport
+	}
+	type args struct {
+		req *connect.Request[evaluation.GetConsistencyReportRequest]
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    assert.Want[*connect.Response[evaluation.ConsistencyReport]]
+		wantErr assert.WantErr
+	}{
+		{
+			name: "err: permission denied",
+			fields: fields{
+				authz:  &denyAuthorizationStrategy{},
+				report: report,
+			},
+			args: args{
+				req: connect.NewRequest(&evaluation.GetConsistencyReportRequest{}),
+			},
+			want: assert.Nil[*connect.Response[evaluation.ConsistencyReport]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodePermissionDenied)
+			},
+		},
+		{
+			name: "err: no check yet",
+			fields: fields{
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			args: args{
+				req: connect.NewRequest(&evaluation.GetConsistencyReportRequest{}),
+			},
+			want: assert.Nil[*connect.Response[evaluation.ConsistencyReport]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeNotFound)
+			},
+		},
+		{
+			name: "happy path",
+			fields: fields{
+				authz:  &service.AuthorizationStrategyAllowAll{},
+				report: report,
+			},
+			args: args{
+				req: connect.NewRequest(&evaluation.GetConsistencyReportRequest{}),
+			},
+			want: func(t *testing.T, got *connect.Response[evaluation.ConsistencyReport], msgAndArgs ...any) bool {
+				return assert.Equal(t, report, got.Msg)
+			},
+			wantErr: assert.NoError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &Service{
+				authz: tt.fields.authz,
+			}
+			svc.consistency.report = tt.fields.report
+
+			got, err := svc.GetConsistencyReport(context.Background(), tt.args.req)
+			tt.want(t, got)
+			tt.wantErr(t, err)
+		})
+	}
+}
+
+func TestService_WriteMetrics(t *testing.T) {
+	type fields struct {
+		report *evaluation.ConsistencyReport
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   assert.Want[string]
+	}{
+		{
+			name: "no check yet",
+			want: func(t *testing.T, got string, msgAndArgs ...any) bool {
+				return assert.Equal(t, "", got)
+			},
+		},
+		{
+			name: "orphans by type",
+			fields: fields{
+				report: &evaluation.ConsistencyReport{
+					CheckedAt: timestamppb.New(time.Unix(1700000000, 0)),
+					Orphans: []*evaluation.Orphan{
+						{Type: evaluation.OrphanType_ORPHAN_TYPE_EVALUATION_RESULT},
+						{Type: evaluation.OrphanType_ORPHAN_TYPE_EVALUATION_RESULT},
+						{Type: evaluation.OrphanType_ORPHAN_TYPE_AUDIT_SCOPE},
+					},
+				},
+			},
+			want: func(t *testing.T, got string, msgAndArgs ...any) bool {
+				return assert.Contains(t, got, `confirmate_consistency_orphans{type="evaluation_result"} 2`) &&
+					assert.Contains(t, got, `confirmate_consistency_orphans{type="assessment_result"} 0`) &&
+					assert.Contains(t, got, `confirmate_consistency_orphans{type="audit_scope"} 1`) &&
+					assert.Contains(t, got, "confirmate_consistency_last_check_timestamp_seconds 1700000000")
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+
+			svc := &Service{}
+			svc.consistency.report = tt.fields.report
+
+			svc.WriteMetrics(&buf)
+			tt.want(t, buf.String())
+		})
+	}
+}
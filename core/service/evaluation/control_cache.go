@@ -0,0 +1,183 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package evaluation
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"sync"
+	"time"
+
+	"confirmate.io/core/api/orchestrator"
+	"confirmate.io/core/log"
+	"confirmate.io/core/util/entity"
+
+	"connectrpc.com/connect"
+)
+
+// catalogEventsRetryInterval is the interval in which the subscription to the catalog change events of the
+// orchestrator is re-established after it failed.
+const catalogEventsRetryInterval = 30 * time.Second
+
+// controlCache caches the controls of catalogs, so that they do not always have to be retrieved from the orchestrator.
+// Entries expire after a TTL and are invalidated once the catalog changes. All access is synchronized, the cached
+// control maps are only replaced, never modified, so they can be read without holding the lock.
+type controlCache struct {
+	// ttl is the duration after which cached controls are refreshed. A value smaller than 1 disables the expiry.
+	ttl time.Duration
+
+	mu sync.RWMutex
+
+	// catalogs contains the cached controls, keyed by the catalog ID.
+	catalogs map[string]cachedControls
+}
+
+// cachedControls are the controls of a catalog, keyed by the control ID, together with the time they were cached.
+type cachedControls struct {
+	controls map[string]*orchestrator.Control
+	cachedAt time.Time
+}
+
+// newControlCache creates a new [controlCache] whose entries expire after ttl.
+func newControlCache(ttl time.Duration) *controlCache {
+	return &controlCache{
+		ttl:      ttl,
+		catalogs: make(map[string]cachedControls),
+	}
+}
+
+// get returns the cached controls of the catalog, keyed by the control ID. The controls are also returned once they
+// expired, fresh indicates whether they are still valid. The returned map must not be modified.
+func (c *controlCache) get(catalogId string) (controls map[string]*orchestrator.Control, fresh bool) {
+	var (
+		entry cachedControls
+		ok    bool
+	)
+
+	c.mu.RLock()
+	entry, ok = c.catalogs[catalogId]
+	c.mu.RUnlock()
+	if !ok {
+		return nil, false
+	}
+
+	return entry.controls, c.ttl < 1 || time.Since(entry.cachedAt) < c.ttl
+}
+
+// set replaces the cached controls of the catalog.
+func (c *controlCache) set(catalogId string, controls []*orchestrator.Control) {
+	var entry = cachedControls{
+		controls: make(map[string]*orchestrator.Control, len(controls)),
+		cachedAt: time.Now(),
+	}
+
+	for _, control := range controls {
+		entry.controls[control.GetId()] = control
+	}
+
+	c.mu.Lock()
+	c.catalogs[catalogId] = entry
+	c.mu.Unlock()
+}
+
+// invalidate removes the cached controls of the catalog, so that they are retrieved again on their next use.
+func (c *controlCache) invalidate(catalogId string) {
+	c.mu.Lock()
+	delete(c.catalogs, catalogId)
+	c.mu.Unlock()
+}
+
+// clear removes the cached controls of all catalogs.
+func (c *controlCache) clear() {
+	c.mu.Lock()
+	clear(c.catalogs)
+	c.mu.Unlock()
+}
+
+// controlsOf returns the controls of the catalog, keyed by the control ID. Expired or missing controls are retrieved
+// from the orchestrator. If this fails, expired controls are used until the next try.
+func (svc *Service) controlsOf(catalogId string) (controls map[string]*orchestrator.Control, err error) {
+	var fresh bool
+
+	controls, fresh = svc.catalogControls.get(catalogId)
+	if fresh {
+		return controls, nil
+	}
+
+	err = svc.cacheControls(entity.CatalogID(catalogId))
+	if err != nil && controls != nil {
+		slog.Warn("Could not refresh controls, using expired controls", slog.String("catalog id", catalogId), log.Err(err))
+		return controls, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	controls, _ = svc.catalogControls.get(catalogId)
+	return controls, nil
+}
+
+// watchCatalogEvents subscribes to the catalog change events of the orchestrator and invalidates the cached controls
+// of changed catalogs, until ctx is done. A failed subscription is re-established after
+// [catalogEventsRetryInterval].
+func (svc *Service) watchCatalogEvents(ctx context.Context) {
+	var (
+		stream *connect.ServerStreamForClient[orchestrator.ChangeEvent]
+		err    error
+	)
+
+	for {
+		stream, err = svc.orchestratorClient.Subscribe(ctx, connect.NewRequest(&orchestrator.SubscribeRequest{
+			Filter: &orchestrator.SubscribeRequest_Filter{
+				Categories: []orchestrator.EventCategory{orchestrator.EventCategory_EVENT_CATEGORY_CATALOG},
+			},
+		}))
+		if err == nil {
+			// Events might have been missed while we were not subscribed
+			svc.catalogControls.clear()
+
+			for stream.Receive() {
+				svc.handleCatalogEvent(stream.Msg())
+			}
+
+			err = errors.Join(stream.Err(), stream.Close())
+		}
+
+		if ctx.Err() != nil {
+			return
+		}
+
+		slog.Warn("Subscription to catalog events of the orchestrator failed, cached controls expire after their TTL",
+			slog.Duration("retry in", catalogEventsRetryInterval), log.Err(err))
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(catalogEventsRetryInterval):
+		}
+	}
+}
+
+// handleCatalogEvent invalidates the cached controls of the catalog the change event refers to.
+func (svc *Service) handleCatalogEvent(event *orchestrator.ChangeEvent) {
+	if event.GetCategory() != orchestrator.EventCategory_EVENT_CATEGORY_CATALOG {
+		return
+	}
+
+	slog.Debug("Catalog changed, invalidating cached controls", slog.String("catalog id", event.GetEntityId()))
+
+	svc.catalogControls.invalidate(event.GetEntityId())
+}
@@ -0,0 +1,197 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package evaluation
+
+import (
+	"testing"
+	"time"
+
+	"confirmate.io/core/api/orchestrator"
+	"confirmate.io/core/service/orchestrator/orchestratortest"
+	"confirmate.io/core/util/assert"
+)
+
+// newTestControlCache returns a [controlCache] without expiry that contains the given controls, keyed by the catalog
+// and control ID.
+func newTestControlCache(catalogs map[string]map[string]*orchestrator.Control) (c *controlCache) {
+	c = newControlCache(0)
+	for id, controls := range catalogs {
+		c.catalogs[id] = cachedControls{controls: controls, cachedAt: time.Now()}
+	}
+
+	return
+}
+
+func Test_controlCache(t *testing.T) {
+	var c = newControlCache(time.Hour)
+
+	// Unknown catalogs are neither cached nor fresh
+	controls, fresh := c.get(orchestratortest.MockCatalogId1)
+	assert.Nil(t, controls)
+	assert.False(t, fresh)
+
+	c.set(orchestratortest.MockCatalogId1, []*orchestrator.Control{orchestratortest.MockControl1})
+	controls, fresh = c.get(orchestratortest.MockCatalogId1)
+	assert.True(t, fresh)
+	assert.Equal(t, orchestratortest.MockControl1, controls[orchestratortest.MockControlId1])
+
+	// Expired controls are still returned, but not fresh
+	c.catalogs[orchestratortest.MockCatalogId1] = cachedControls{
+		controls: controls,
+		cachedAt: time.Now().Add(-2 * time.Hour),
+	}
+	controls, fresh = c.get(orchestratortest.MockCatalogId1)
+	assert.False(t, fresh)
+	assert.Equal(t, 1, len(controls))
+
+	c.invalidate(orchestratortest.MockCatalogId1)
+	controls, _ = c.get(orchestratortest.MockCatalogId1)
+	assert.Nil(t, controls)
+
+	c.set(orchestratortest.MockCatalogId1, []*orchestrator.Control{orchestratortest.MockControl1})
+	c.set(orchestratortest.MockCatalogId2, []*orchestrator.Control{orchestratortest.MockControl1})
+	c.clear()
+	assert.Empty(t, c.catalogs)
+}
+
+func TestService_controlsOf(t *testing.T) {
+	type fields struct {
+		catalogControls *controlCache
+		controls        []*orchestrator.Control
+		orchestratorErr error
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		want    assert.Want[map[string]*orchestrator.Control]
+		wantErr assert.WantErr
+	}{
+		{
+			name: "fresh controls are not retrieved again",
+			fields: fields{
+				catalogControls: newTestControlCache(map[string]map[string]*orchestrator.Control{
+					orchestratortest.MockCatalogId1: {orchestratortest.MockControlId1: orchestratortest.MockControl1},
+				}),
+			},
+			want: func(t *testing.T, got map[string]*orchestrator.Control, args ...any) bool {
+				return assert.Equal(t, 1, len(got))
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "missing controls are retrieved",
+			fields: fields{
+				catalogControls: newControlCache(time.Hour),
+				controls:        mockControlsForCatalog(orchestratortest.MockCatalogId1),
+			},
+			want: func(t *testing.T, got map[string]*orchestrator.Control, args ...any) bool {
+				return assert.Equal(t, 4, len(got))
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "expired controls are refreshed",
+			fields: fields{
+				catalogControls: &controlCache{
+					ttl: time.Hour,
+					catalogs: map[string]cachedControls{
+						orchestratortest.MockCatalogId1: {
+							controls: map[string]*orchestrator.Control{orchestratortest.MockControlId1: orchestratortest.MockControl1},
+							cachedAt: time.Now().Add(-2 * time.Hour),
+						},
+					},
+				},
+				controls: mockControlsForCatalog(orchestratortest.MockCatalogId1),
+			},
+			want: func(t *testing.T, got map[string]*orchestrator.Control, args ...any) bool {
+				return assert.Equal(t, 4, len(got))
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "expired controls are used if the refresh fails",
+			fields: fields{
+				catalogControls: &controlCache{
+					ttl: time.Hour,
+					catalogs: map[string]cachedControls{
+						orchestratortest.MockCatalogId1: {
+							controls: map[string]*orchestrator.Control{orchestratortest.MockControlId1: orchestratortest.MockControl1},
+							cachedAt: time.Now().Add(-2 * time.Hour),
+						},
+					},
+				},
+			},
+			want: func(t *testing.T, got map[string]*orchestrator.Control, args ...any) bool {
+				return assert.Equal(t, 1, len(got))
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "missing controls cannot be retrieved",
+			fields: fields{
+				catalogControls: newControlCache(time.Hour),
+			},
+			want: assert.Nil[map[string]*orchestrator.Control],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.ErrorContains(t, err, "no controls for catalog")
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, testSrv := newOrchestratorTestServer(t, tt.fields.controls)
+			t.Cleanup(testSrv.Close)
+
+			svc := &Service{
+				orchestratorClient: newOrchestratorClientForTest(testSrv),
+				catalogControls:    tt.fields.catalogControls,
+			}
+			got, err := svc.controlsOf(orchestratortest.MockCatalogId1)
+
+			tt.want(t, got)
+			tt.wantErr(t, err)
+		})
+	}
+}
+
+func TestService_handleCatalogEvent(t *testing.T) {
+	svc := &Service{
+		catalogControls: newTestControlCache(map[string]map[string]*orchestrator.Control{
+			orchestratortest.MockCatalogId1: {orchestratortest.MockControlId1: orchestratortest.MockControl1},
+			orchestratortest.MockCatalogId2: {orchestratortest.MockControlId1: orchestratortest.MockControl1},
+		}),
+	}
+
+	// Events of other categories are ignored
+	svc.handleCatalogEvent(&orchestrator.ChangeEvent{
+		Category: orchestrator.EventCategory_EVENT_CATEGORY_METRIC,
+		EntityId: orchestratortest.MockCatalogId1,
+	})
+	_, fresh := svc.catalogControls.get(orchestratortest.MockCatalogId1)
+	assert.True(t, fresh)
+
+	svc.handleCatalogEvent(&orchestrator.ChangeEvent{
+		Category:    orchestrator.EventCategory_EVENT_CATEGORY_CATALOG,
+		RequestType: orchestrator.RequestType_REQUEST_TYPE_UPDATED,
+		EntityId:    orchestratortest.MockCatalogId1,
+	})
+	controls, _ := svc.catalogControls.get(orchestratortest.MockCatalogId1)
+	assert.Nil(t, controls)
+
+	// Other catalogs are kept
+	_, fresh = svc.catalogControls.get(orchestratortest.MockCatalogId2)
+	assert.True(t, fresh)
+}
@@ -0,0 +1,323 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package evaluation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"maps"
+	"slices"
+	"strings"
+
+	"confirmate.io/core/api"
+	"confirmate.io/core/api/assessment"
+	"confirmate.io/core/api/evaluation"
+	"confirmate.io/core/api/orchestrator"
+	"confirmate.io/core/log"
+	"confirmate.io/core/service"
+	"confirmate.io/core/util/entity"
+
+	"connectrpc.com/connect"
+)
+
+// GetCoverage returns a coverage report for a catalog of the given audit scope. If no catalog is requested, the primary
+// catalog of the audit scope is used. Every control that is relevant for the audit scope (and in scope) is listed
+// together with its metrics and the metrics that already produced assessment results for the target of evaluation.
+func (svc *Service) GetCoverage(ctx context.Context, req *connect.Request[evaluation.GetCoverageRequest]) (res *connect.Response[evaluation.Coverage], err error) {
+	var (
+		allowed    bool
+		auditScope *orchestrator.AuditScope
+		catalog    *orchestrator.Catalog
+		parents    []*orchestrator.Control
+		subs       map[string][]*orchestrator.Control
+		metricIds  []entity.MetricID
+		assessed   map[entity.MetricID]struct{}
+		coverage   *evaluation.Coverage
+		locale     string
+		covered    int
+	)
+
+	// Validate the request
+	if err = service.Validate(req); err != nil {
+		return nil, err
+	}
+
+	// Check access via the configured auth strategy
+	allowed, _, err = checkAccess(ctx, svc.authz, orchestrator.RequestType_REQUEST_TYPE_GET, req.Msg.GetAuditScopeId(), orchestrator.ObjectType_OBJECT_TYPE_AUDIT_SCOPE)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if !allowed {
+		return nil, service.ErrPermissionDenied
+	}
+
+	// Retrieve the audit scope and the catalog to report on
+	auditScope, catalog, err = svc.fetchAuditScopeCatalog(ctx, req.Msg.GetAuditScopeId(), (*entity.CatalogID)(req.Msg.CatalogId))
+	if err != nil {
+		return nil, err
+	}
+
+	// The token might be restricted to other targets of evaluation
+	if !service.AllowsTargetOfEvaluation(ctx, svc.authz, auditScope.GetTargetOfEvaluationId()) {
+		return nil, service.ErrPermissionDenied
+	}
+	locale = resolveLocale(req.Msg.Locale, auditScope)
+
+	// Gather the relevant parent controls and their relevant sub-controls, in the same way as the evaluation does
+	parents, subs = svc.relevantControls(ctx, auditScope, catalog)
+	for children := range maps.Values(subs) {
+		for _, sub := range children {
+			metricIds = append(metricIds, getMetricIds(getMetricsFromControl(sub))...)
+		}
+	}
+
+	// Find out which of the metrics have produced assessment results for the target of evaluation
+	assessed, err = svc.fetchAssessedMetricIds(ctx, auditScope, metricIds)
+	if err != nil {
+		slog.Error("Could not get assessment results", log.Err(err))
+		return nil, connect.NewError(connect.CodeInternal, errors.New("could not get assessment results from the orchestrator"))
+	}
+
+	coverage = &evaluation.Coverage{
+		AuditScopeId:         auditScope.GetId(),
+		TargetOfEvaluationId: auditScope.GetTargetOfEvaluationId(),
+		CatalogId:            catalog.GetId(),
+		Controls:             []*evaluation.ControlCoverage{},
+		Locale:               locale,
+	}
+
+	for _, parent := range parents {
+		var (
+			children []*evaluation.ControlCoverage
+			all      []entity.MetricID
+		)
+
+		for _, sub := range subs[parent.Id] {
+			ids := getMetricIds(getMetricsFromControl(sub))
+			children = append(children, newControlCoverage(locale, entity.ControlID(sub.Id), (*entity.ControlID)(sub.ParentControlId), ids, assessed))
+			all = append(all, ids...)
+		}
+
+		cov := newControlCoverage(locale, entity.ControlID(parent.Id), nil, all, assessed)
+		if cov.Status == evaluation.CoverageStatus_COVERAGE_STATUS_COVERED {
+			covered++
+		}
+
+		coverage.Controls = append(coverage.Controls, cov)
+		coverage.Controls = append(coverage.Controls, children...)
+	}
+
+	// The summary only refers to the parent controls, since these are the controls that are evaluated
+	coverage.Summary = translate(locale, msgCoverageSummary, covered, len(parents))
+
+	res = connect.NewResponse(coverage)
+	return
+}
+
+// fetchAuditScopeCatalog retrieves the audit scope and one of its catalogs, which defaults to the primary catalog of
+// the audit scope. It also refreshes the cached controls of the catalog, so that they reflect the current metric
+// assignments. Errors are already returned as [connect.Error].
+func (svc *Service) fetchAuditScopeCatalog(ctx context.Context, auditScopeId string, catalogId *entity.CatalogID) (auditScope *orchestrator.AuditScope, catalog *orchestrator.Catalog, err error) {
+	var (
+		catalogRes *connect.Response[orchestrator.Catalog]
+		id         entity.CatalogID
+	)
+
+	// Get Audit Scope
+	auditScope, err = svc.fetchAuditScope(ctx, auditScopeId)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Determine the catalog, which needs to be one of the catalogs of the audit scope
+	id = entity.CatalogID(auditScope.GetCatalogId())
+	if catalogId != nil {
+		id = *catalogId
+	}
+	if !auditScope.HasCatalog(id.String()) {
+		return nil, nil, service.NewInvalidFieldError("catalog_id", fmt.Errorf("catalog '%s' is not part of the audit scope", id))
+	}
+
+	// Retrieve the catalog
+	catalogRes, err = svc.orchestratorClient.GetCatalog(ctx, connect.NewRequest(&orchestrator.GetCatalogRequest{
+		CatalogId: id.String(),
+	}))
+	if err != nil {
+		slog.Error("Could not get catalog from the orchestrator", log.Err(err))
+		return nil, nil, connect.NewError(connect.CodeInternal, errors.New("could not get catalog from the orchestrator"))
+	}
+	catalog = catalogRes.Msg
+
+	// Refresh the controls of the catalog
+	err = svc.cacheControls(id)
+	if err != nil {
+		slog.Error("Could not cache controls", log.Err(err))
+		return nil, nil, connect.NewError(connect.CodeInternal, errors.New("could not cache controls"))
+	}
+
+	return
+}
+
+// fetchAuditScope retrieves the audit scope from the orchestrator. Errors are already returned as [connect.Error].
+func (svc *Service) fetchAuditScope(ctx context.Context, auditScopeId string) (auditScope *orchestrator.AuditScope, err error) {
+	var res *connect.Response[orchestrator.AuditScope]
+
+	res, err = svc.orchestratorClient.GetAuditScope(ctx, connect.NewRequest(&orchestrator.GetAuditScopeRequest{
+		AuditScopeId: auditScopeId,
+	}))
+	if err != nil {
+		slog.Error("Could not get audit scope from orchestrator", log.Err(err))
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("could not get audit scope from orchestrator"))
+	}
+
+	return res.Msg, nil
+}
+
+// relevantControls returns the parent controls of the catalog that are relevant for the audit scope, sorted by their
+// ID, and their relevant sub-controls, keyed by the ID of the parent control. Controls that have been removed from
+// scope are skipped.
+func (svc *Service) relevantControls(ctx context.Context, auditScope *orchestrator.AuditScope, catalog *orchestrator.Catalog) (parents []*orchestrator.Control, subs map[string][]*orchestrator.Control) {
+	var (
+		inScopeIds map[string]struct{}
+		controls   map[string]*orchestrator.Control
+		err        error
+	)
+
+	inScopeIds, err = svc.fetchInScopeControlIds(ctx, auditScope.GetId())
+	if err != nil {
+		slog.Warn("Could not fetch controls in scope, using all controls", log.Err(err))
+		inScopeIds = nil
+	}
+
+	controls, _ = svc.catalogControls.get(catalog.GetId())
+
+	subs = make(map[string][]*orchestrator.Control)
+	for c := range maps.Values(controls) {
+		if c.ParentControlId != nil || !c.IsRelevantFor(auditScope, catalog) {
+			continue
+		}
+
+		if inScopeIds != nil {
+			if _, ok := inScopeIds[c.Id]; !ok {
+				continue
+			}
+		}
+
+		parents = append(parents, c)
+		for _, sub := range c.Controls {
+			if sub.IsRelevantFor(auditScope, catalog) {
+				subs[c.Id] = append(subs[c.Id], sub)
+			}
+		}
+	}
+
+	slices.SortFunc(parents, func(a *orchestrator.Control, b *orchestrator.Control) int {
+		return strings.Compare(a.Id, b.Id)
+	})
+
+	for _, children := range subs {
+		slices.SortFunc(children, func(a *orchestrator.Control, b *orchestrator.Control) int {
+			return strings.Compare(a.Id, b.Id)
+		})
+	}
+
+	return
+}
+
+// fetchAssessedMetricIds returns the set of the given metric IDs that have at least one assessment result within the
+// audit scope.
+func (svc *Service) fetchAssessedMetricIds(ctx context.Context, auditScope *orchestrator.AuditScope, metricIds []entity.MetricID) (assessed map[entity.MetricID]struct{}, err error) {
+	var results []*assessment.AssessmentResult
+
+	assessed = make(map[entity.MetricID]struct{})
+
+	results, err = svc.fetchAssessmentResults(ctx, auditScope, metricIds)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, r := range results {
+		assessed[entity.MetricID(r.GetMetricId())] = struct{}{}
+	}
+
+	return
+}
+
+// fetchAssessmentResults returns the latest assessment results of each resource of the target of evaluation of the
+// audit scope for the given metric IDs. If the audit scope is restricted to an environment, only the results of this
+// environment are returned.
+func (svc *Service) fetchAssessmentResults(ctx context.Context, auditScope *orchestrator.AuditScope, metricIds []entity.MetricID) (results []*assessment.AssessmentResult, err error) {
+	// Without any metrics, there is nothing to look for. We also need to avoid an empty filter, which would return
+	// all assessment results.
+	if len(metricIds) == 0 {
+		return
+	}
+
+	slices.Sort(metricIds)
+
+	return api.ListAllPaginated(ctx, &orchestrator.ListAssessmentResultsRequest{
+		Filter: &orchestrator.ListAssessmentResultsRequest_Filter{
+			TargetOfEvaluationId: new(auditScope.GetTargetOfEvaluationId()),
+			MetricIds:            entity.Strings(slices.Compact(metricIds)),
+			Environment:          auditScope.Environment,
+		},
+		LatestByResourceId: new(true),
+	}, func(ctx context.Context, req *orchestrator.ListAssessmentResultsRequest) (*orchestrator.ListAssessmentResultsResponse, error) {
+		res, err := svc.orchestratorClient.ListAssessmentResults(ctx, connect.NewRequest(req))
+		if err != nil {
+			return nil, err
+		}
+		return res.Msg, nil
+	}, func(res *orchestrator.ListAssessmentResultsResponse) []*assessment.AssessmentResult {
+		return res.Results
+	})
+}
+
+// newControlCoverage creates the coverage of a single control based on its metric IDs and the set of metric IDs that
+// produced assessment results. The status label is translated into the given locale.
+func newControlCoverage(locale string, controlId entity.ControlID, parentControlId *entity.ControlID, metricIds []entity.MetricID, assessed map[entity.MetricID]struct{}) (cov *evaluation.ControlCoverage) {
+	cov = &evaluation.ControlCoverage{
+		ControlId:         controlId.String(),
+		ParentControlId:   (*string)(parentControlId),
+		MetricIds:         []string{},
+		AssessedMetricIds: []string{},
+	}
+
+	slices.Sort(metricIds)
+	for _, id := range slices.Compact(metricIds) {
+		cov.MetricIds = append(cov.MetricIds, id.String())
+		if _, ok := assessed[id]; ok {
+			cov.AssessedMetricIds = append(cov.AssessedMetricIds, id.String())
+		}
+	}
+
+	switch {
+	case len(cov.MetricIds) == 0:
+		cov.Status = evaluation.CoverageStatus_COVERAGE_STATUS_NO_METRICS
+	case len(cov.AssessedMetricIds) == 0:
+		cov.Status = evaluation.CoverageStatus_COVERAGE_STATUS_NO_RESULTS
+	case len(cov.AssessedMetricIds) < len(cov.MetricIds):
+		cov.Status = evaluation.CoverageStatus_COVERAGE_STATUS_PARTIAL
+	default:
+		cov.Status = evaluation.CoverageStatus_COVERAGE_STATUS_COVERED
+	}
+
+	cov.StatusLabel = coverageStatusLabel(locale, cov.Status)
+
+	return
+}
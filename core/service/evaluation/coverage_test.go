@@ -0,0 +1,272 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package evaluation
+
+import (
+	"context"
+	"testing"
+
+	"confirmate.io/core/api/assessment"
+	"confirmate.io/core/api/evaluation"
+	"confirmate.io/core/api/orchestrator"
+	"confirmate.io/core/api/orchestrator/orchestratorconnect"
+	"confirmate.io/core/service"
+	"confirmate.io/core/service/evaluation/evaluationtest"
+	"confirmate.io/core/util/assert"
+
+	"connectrpc.com/connect"
+)
+
+func TestService_GetCoverage(t *testing.T) {
+	type fields struct {
+		orchestratorClient orchestratorconnect.OrchestratorClient
+		authz              service.AuthorizationStrategy
+	}
+	type args struct {
+		req *connect.Request[evaluation.GetCoverageRequest]
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    assert.Want[*connect.Response[evaluation.Coverage]]
+		wantErr assert.WantErr
+	}{
+		{
+			name: "err: validation error",
+			args: args{
+				req: connect.NewRequest(&evaluation.GetCoverageRequest{}),
+			},
+			want: assert.Nil[*connect.Response[evaluation.Coverage]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeInvalidArgument)
+			},
+		},
+		{
+			name: "err: permission denied",
+			fields: fields{
+				authz: &denyAuthorizationStrategy{},
+			},
+			args: args{
+				req: connect.NewRequest(&evaluation.GetCoverageRequest{
+					AuditScopeId: evaluationtest.MockAuditScopeId1,
+				}),
+			},
+			want: assert.Nil[*connect.Response[evaluation.Coverage]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodePermissionDenied)
+			},
+		},
+		{
+			name: "err: audit scope not found",
+			fields: fields{
+				orchestratorClient: newOrchestratorClient(t,
+					WithGetAuditScopeNotFoundError(connect.NewError(connect.CodeNotFound, service.ErrNotFound("audit scope"))),
+				),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			args: args{
+				req: connect.NewRequest(&evaluation.GetCoverageRequest{
+					AuditScopeId: evaluationtest.MockAuditScopeId1,
+				}),
+			},
+			want: assert.Nil[*connect.Response[evaluation.Coverage]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeNotFound)
+			},
+		},
+		{
+			name: "err: catalog not part of the audit scope",
+			fields: fields{
+				orchestratorClient: newOrchestratorClient(t,
+					WithAuditScope(evaluationtest.MockAuditScope1),
+				),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			args: args{
+				req: connect.NewRequest(&evaluation.GetCoverageRequest{
+					AuditScopeId: evaluationtest.MockAuditScopeId1,
+					CatalogId:    new("other-catalog"),
+				}),
+			},
+			want: assert.Nil[*connect.Response[evaluation.Coverage]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeInvalidArgument) &&
+					assert.ErrorContains(t, err, "not part of the audit scope") &&
+					assert.HasFieldViolation(t, err, "catalog_id")
+			},
+		},
+		{
+			name: "err: catalog not found",
+			fields: fields{
+				orchestratorClient: newOrchestratorClient(t,
+					WithAuditScope(evaluationtest.MockAuditScope1),
+					WithGetCatalogNotFoundError(connect.NewError(connect.CodeNotFound, service.ErrNotFound("catalog"))),
+				),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			args: args{
+				req: connect.NewRequest(&evaluation.GetCoverageRequest{
+					AuditScopeId: evaluationtest.MockAuditScopeId1,
+				}),
+			},
+			want: assert.Nil[*connect.Response[evaluation.Coverage]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeInternal)
+			},
+		},
+		{
+			name: "happy path",
+			fields: fields{
+				orchestratorClient: newOrchestratorClient(t,
+					WithAuditScope(evaluationtest.MockAuditScope1),
+					WithCatalog(evaluationtest.MockCatalog1),
+					WithControls([]*orchestrator.Control{evaluationtest.MockControl1, evaluationtest.MockControl2}),
+					WithAdditionalControls(&orchestrator.Control{
+						Id:   "Control 3",
+						Name: "Control without metrics",
+					}),
+					WithAssessmentResults([]*assessment.AssessmentResult{
+						{
+							Id:                   evaluationtest.MockAssessmentResultId1,
+							MetricId:             evaluationtest.MockMetricId1,
+							Compliant:            true,
+							ResourceId:           "resource-1",
+							TargetOfEvaluationId: evaluationtest.MockToeId1,
+						},
+						{
+							Id:                   evaluationtest.MockAssessmentResultId2,
+							MetricId:             evaluationtest.MockMetricId2,
+							Compliant:            true,
+							ResourceId:           "resource-2",
+							TargetOfEvaluationId: evaluationtest.MockToeId2,
+						},
+					}),
+				),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			args: args{
+				req: connect.NewRequest(&evaluation.GetCoverageRequest{
+					AuditScopeId: evaluationtest.MockAuditScopeId1,
+				}),
+			},
+			want: func(t *testing.T, got *connect.Response[evaluation.Coverage], msgAndArgs ...any) bool {
+				want := &evaluation.Coverage{
+					AuditScopeId:         evaluationtest.MockAuditScopeId1,
+					TargetOfEvaluationId: evaluationtest.MockToeId1,
+					CatalogId:            evaluationtest.MockCatalogId1,
+					Controls: []*evaluation.ControlCoverage{
+						{
+							ControlId:         evaluationtest.MockControlId1,
+							MetricIds:         []string{evaluationtest.MockMetricId1, evaluationtest.MockMetricId2},
+							AssessedMetricIds: []string{evaluationtest.MockMetricId1},
+							Status:            evaluation.CoverageStatus_COVERAGE_STATUS_PARTIAL,
+							StatusLabel:       "Partially covered",
+						},
+						{
+							ControlId:         evaluationtest.MockControl1SubcontrolId11,
+							ParentControlId:   new(evaluationtest.MockControlId1),
+							MetricIds:         []string{evaluationtest.MockMetricId1},
+							AssessedMetricIds: []string{evaluationtest.MockMetricId1},
+							Status:            evaluation.CoverageStatus_COVERAGE_STATUS_COVERED,
+							StatusLabel:       "Covered",
+						},
+						{
+							ControlId:         evaluationtest.MockControl1SubcontrolId12,
+							ParentControlId:   new(evaluationtest.MockControlId1),
+							MetricIds:         []string{evaluationtest.MockMetricId2},
+							AssessedMetricIds: []string{},
+							Status:            evaluation.CoverageStatus_COVERAGE_STATUS_NO_RESULTS,
+							StatusLabel:       "No results",
+						},
+						{
+							ControlId:         evaluationtest.MockControlId2,
+							MetricIds:         []string{evaluationtest.MockMetricId2},
+							AssessedMetricIds: []string{},
+							Status:            evaluation.CoverageStatus_COVERAGE_STATUS_NO_RESULTS,
+							StatusLabel:       "No results",
+						},
+						{
+							ControlId:         evaluationtest.MockControl2SubcontrolID21,
+							ParentControlId:   new(evaluationtest.MockControlId2),
+							MetricIds:         []string{evaluationtest.MockMetricId2},
+							AssessedMetricIds: []string{},
+							Status:            evaluation.CoverageStatus_COVERAGE_STATUS_NO_RESULTS,
+							StatusLabel:       "No results",
+						},
+						{
+							ControlId:         "Control 3",
+							MetricIds:         []string{},
+							AssessedMetricIds: []string{},
+							Status:            evaluation.CoverageStatus_COVERAGE_STATUS_NO_METRICS,
+							StatusLabel:       "No metrics",
+						},
+					},
+					Locale:  LocaleEnglish,
+					Summary: "0 of 3 controls are fully covered by assessment results.",
+				}
+				return assert.Equal(t, want, got.Msg)
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "happy path: german locale",
+			fields: fields{
+				orchestratorClient: newOrchestratorClient(t,
+					WithAuditScope(evaluationtest.MockAuditScope1),
+					WithCatalog(evaluationtest.MockCatalog1),
+					WithControls([]*orchestrator.Control{evaluationtest.MockControl1}),
+					WithAssessmentResults([]*assessment.AssessmentResult{
+						{
+							Id:                   evaluationtest.MockAssessmentResultId1,
+							MetricId:             evaluationtest.MockMetricId1,
+							Compliant:            true,
+							ResourceId:           "resource-1",
+							TargetOfEvaluationId: evaluationtest.MockToeId1,
+						},
+					}),
+				),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			args: args{
+				req: connect.NewRequest(&evaluation.GetCoverageRequest{
+					AuditScopeId: evaluationtest.MockAuditScopeId1,
+					Locale:       new(LocaleGerman),
+				}),
+			},
+			want: func(t *testing.T, got *connect.Response[evaluation.Coverage], msgAndArgs ...any) bool {
+				return assert.Equal(t, LocaleGerman, got.Msg.GetLocale()) &&
+					assert.Equal(t, "0 von 1 Anforderungen sind vollständig durch Bewertungsergebnisse abgedeckt.", got.Msg.GetSummary()) &&
+					assert.Equal(t, "Teilweise abgedeckt", got.Msg.GetControls()[0].GetStatusLabel()) &&
+					assert.Equal(t, "Abgedeckt", got.Msg.GetControls()[1].GetStatusLabel())
+			},
+			wantErr: assert.NoError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &Service{
+				orchestratorClient: tt.fields.orchestratorClient,
+				authz:              tt.fields.authz,
+				catalogControls:    newControlCache(0),
+			}
+
+			got, err := svc.GetCoverage(context.Background(), tt.args.req)
+			tt.want(t, got)
+			tt.wantErr(t, err)
+		})
+	}
+}
@@ -0,0 +1,101 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package evaluation
+
+import (
+	"confirmate.io/core/api/evaluation"
+	"confirmate.io/core/api/orchestrator"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// evaluationInterval returns the interval in minutes of the evaluation of the given catalogs. The requested interval
+// takes precedence over the shortest interval recommended by the catalogs (see [orchestrator.EvaluationDefaults]),
+// which takes precedence over [defaultInterval].
+func evaluationInterval(req *evaluation.StartEvaluationRequest, catalogs []*orchestrator.Catalog) (interval int) {
+	if req.GetInterval() > 0 {
+		return int(req.GetInterval())
+	}
+
+	for _, catalog := range catalogs {
+		recommended := int(catalog.GetEvaluationDefaults().GetInterval())
+		if recommended > 0 && (interval == 0 || recommended < interval) {
+			interval = recommended
+		}
+	}
+
+	if interval == 0 {
+		interval = defaultInterval
+	}
+
+	return
+}
+
+// withRequestedDefaults returns copies of the catalogs, whose evaluation defaults are overridden by the maximum evidence
+// age and the aggregation strategy of the request, if it specifies them. The catalogs of an evaluation job therefore
+// carry the effective settings of the job.
+func withRequestedDefaults(req *evaluation.StartEvaluationRequest, catalogs []*orchestrator.Catalog) (out []*orchestrator.Catalog) {
+	out = make([]*orchestrator.Catalog, 0, len(catalogs))
+
+	for _, catalog := range catalogs {
+		if req.MaxEvidenceAgeDays == nil && req.AggregationStrategy == nil {
+			out = append(out, catalog)
+			continue
+		}
+
+		catalog = proto.Clone(catalog).(*orchestrator.Catalog)
+		if catalog.EvaluationDefaults == nil {
+			catalog.EvaluationDefaults = &orchestrator.EvaluationDefaults{}
+		}
+		if req.MaxEvidenceAgeDays != nil {
+			catalog.EvaluationDefaults.MaxEvidenceAgeDays = req.MaxEvidenceAgeDays
+		}
+		if req.AggregationStrategy != nil {
+			catalog.EvaluationDefaults.AggregationStrategy = req.GetAggregationStrategy()
+		}
+
+		out = append(out, catalog)
+	}
+
+	return
+}
+
+// maxEvidenceAgeDays returns the maximum age in days of the evidence of the assessment results of the control. The
+// maximum of the control takes precedence over the one of the catalog. It returns nil, if the age is not restricted.
+func maxEvidenceAgeDays(catalog *orchestrator.Catalog, control *orchestrator.Control) *uint32 {
+	if control.MaxEvidenceAgeDays != nil {
+		return control.MaxEvidenceAgeDays
+	}
+
+	if defaults := catalog.GetEvaluationDefaults(); defaults != nil {
+		return defaults.MaxEvidenceAgeDays
+	}
+
+	return nil
+}
+
+// applyAggregationStrategy adjusts the status of a parent control, which is aggregated from the statuses of its
+// sub-controls as for [evaluation.AggregationStrategy_AGGREGATION_STRATEGY_ALL], to the aggregation strategy of the
+// catalog. compliant is the number of compliant sub-controls.
+func applyAggregationStrategy(catalog *orchestrator.Catalog, status evaluation.EvaluationStatus, compliant int) evaluation.EvaluationStatus {
+	// A single compliant sub-control suffices, unless the control was already evaluated as compliant manually
+	if catalog.GetEvaluationDefaults().GetAggregationStrategy() == evaluation.AggregationStrategy_AGGREGATION_STRATEGY_ANY &&
+		compliant > 0 && status != evaluation.EvaluationStatus_EVALUATION_STATUS_COMPLIANT_MANUALLY {
+		return evaluation.EvaluationStatus_EVALUATION_STATUS_COMPLIANT
+	}
+
+	return status
+}
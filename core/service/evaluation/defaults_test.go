@@ -0,0 +1,248 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package evaluation
+
+import (
+	"testing"
+
+	"confirmate.io/core/api/evaluation"
+	"confirmate.io/core/api/orchestrator"
+	"confirmate.io/core/service/evaluation/evaluationtest"
+	"confirmate.io/core/util/assert"
+)
+
+func Test_evaluationInterval(t *testing.T) {
+	type args struct {
+		req      *evaluation.StartEvaluationRequest
+		catalogs []*orchestrator.Catalog
+	}
+	tests := []struct {
+		name string
+		args args
+		want int
+	}{
+		{
+			name: "requested interval",
+			args: args{
+				req: &evaluation.StartEvaluationRequest{Interval: new(int32(10))},
+				catalogs: []*orchestrator.Catalog{
+					{EvaluationDefaults: &orchestrator.EvaluationDefaults{Interval: new(int32(60))}},
+				},
+			},
+			want: 10,
+		},
+		{
+			name: "shortest recommended interval",
+			args: args{
+				req: &evaluation.StartEvaluationRequest{},
+				catalogs: []*orchestrator.Catalog{
+					{EvaluationDefaults: &orchestrator.EvaluationDefaults{Interval: new(int32(60))}},
+					{},
+					{EvaluationDefaults: &orchestrator.EvaluationDefaults{Interval: new(int32(30))}},
+				},
+			},
+			want: 30,
+		},
+		{
+			name: "default interval",
+			args: args{
+				req:      &evaluation.StartEvaluationRequest{},
+				catalogs: []*orchestrator.Catalog{{}},
+			},
+			want: defaultInterval,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := evaluationInterval(tt.args.req, tt.args.catalogs)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_withRequestedDefaults(t *testing.T) {
+	var catalog = &orchestrator.Catalog{
+		Id: evaluationtest.MockCatalogId1,
+		EvaluationDefaults: &orchestrator.EvaluationDefaults{
+			Interval:           new(int32(60)),
+			MaxEvidenceAgeDays: new(uint32(30)),
+		},
+	}
+
+	type args struct {
+		req      *evaluation.StartEvaluationRequest
+		catalogs []*orchestrator.Catalog
+	}
+	tests := []struct {
+		name        string
+		args        args
+		want        []*orchestrator.Catalog
+		wantCatalog assert.Want[*orchestrator.Catalog]
+	}{
+		{
+			name: "nothing requested",
+			args: args{
+				req:      &evaluation.StartEvaluationRequest{},
+				catalogs: []*orchestrator.Catalog{catalog},
+			},
+			want: []*orchestrator.Catalog{catalog},
+		},
+		{
+			name: "requested settings override the catalog",
+			args: args{
+				req: &evaluation.StartEvaluationRequest{
+					MaxEvidenceAgeDays:  new(uint32(90)),
+					AggregationStrategy: new(evaluation.AggregationStrategy_AGGREGATION_STRATEGY_ANY),
+				},
+				catalogs: []*orchestrator.Catalog{catalog, {Id: evaluationtest.MockCatalogId2}},
+			},
+			want: []*orchestrator.Catalog{
+				{
+					Id: evaluationtest.MockCatalogId1,
+					EvaluationDefaults: &orchestrator.EvaluationDefaults{
+						Interval:            new(int32(60)),
+						MaxEvidenceAgeDays:  new(uint32(90)),
+						AggregationStrategy: evaluation.AggregationStrategy_AGGREGATION_STRATEGY_ANY,
+					},
+				},
+				{
+					Id: evaluationtest.MockCatalogId2,
+					EvaluationDefaults: &orchestrator.EvaluationDefaults{
+						MaxEvidenceAgeDays:  new(uint32(90)),
+						AggregationStrategy: evaluation.AggregationStrategy_AGGREGATION_STRATEGY_ANY,
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := withRequestedDefaults(tt.args.req, tt.args.catalogs)
+			assert.Equal(t, tt.want, got)
+
+			// The catalogs of the request must not be modified
+			assert.Equal(t, uint32(30), catalog.GetEvaluationDefaults().GetMaxEvidenceAgeDays())
+		})
+	}
+}
+
+func Test_maxEvidenceAgeDays(t *testing.T) {
+	type args struct {
+		catalog *orchestrator.Catalog
+		control *orchestrator.Control
+	}
+	tests := []struct {
+		name string
+		args args
+		want *uint32
+	}{
+		{
+			name: "maximum of the control",
+			args: args{
+				catalog: &orchestrator.Catalog{
+					EvaluationDefaults: &orchestrator.EvaluationDefaults{MaxEvidenceAgeDays: new(uint32(90))},
+				},
+				control: &orchestrator.Control{MaxEvidenceAgeDays: new(uint32(30))},
+			},
+			want: new(uint32(30)),
+		},
+		{
+			name: "maximum of the catalog",
+			args: args{
+				catalog: &orchestrator.Catalog{
+					EvaluationDefaults: &orchestrator.EvaluationDefaults{MaxEvidenceAgeDays: new(uint32(90))},
+				},
+				control: &orchestrator.Control{},
+			},
+			want: new(uint32(90)),
+		},
+		{
+			name: "not restricted",
+			args: args{
+				catalog: &orchestrator.Catalog{},
+				control: &orchestrator.Control{},
+			},
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := maxEvidenceAgeDays(tt.args.catalog, tt.args.control)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_applyAggregationStrategy(t *testing.T) {
+	var anyCatalog = &orchestrator.Catalog{
+		EvaluationDefaults: &orchestrator.EvaluationDefaults{
+			AggregationStrategy: evaluation.AggregationStrategy_AGGREGATION_STRATEGY_ANY,
+		},
+	}
+
+	type args struct {
+		catalog   *orchestrator.Catalog
+		status    evaluation.EvaluationStatus
+		compliant int
+	}
+	tests := []struct {
+		name string
+		args args
+		want evaluation.EvaluationStatus
+	}{
+		{
+			name: "all: status is kept",
+			args: args{
+				catalog:   &orchestrator.Catalog{},
+				status:    evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT,
+				compliant: 1,
+			},
+			want: evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT,
+		},
+		{
+			name: "any: compliant sub-control",
+			args: args{
+				catalog:   anyCatalog,
+				status:    evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT,
+				compliant: 1,
+			},
+			want: evaluation.EvaluationStatus_EVALUATION_STATUS_COMPLIANT,
+		},
+		{
+			name: "any: no compliant sub-control",
+			args: args{
+				catalog: anyCatalog,
+				status:  evaluation.EvaluationStatus_EVALUATION_STATUS_STALE,
+			},
+			want: evaluation.EvaluationStatus_EVALUATION_STATUS_STALE,
+		},
+		{
+			name: "any: compliant manually",
+			args: args{
+				catalog:   anyCatalog,
+				status:    evaluation.EvaluationStatus_EVALUATION_STATUS_COMPLIANT_MANUALLY,
+				compliant: 1,
+			},
+			want: evaluation.EvaluationStatus_EVALUATION_STATUS_COMPLIANT_MANUALLY,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := applyAggregationStrategy(tt.args.catalog, tt.args.status, tt.args.compliant)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
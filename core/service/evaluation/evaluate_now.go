@@ -0,0 +1,124 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package evaluation
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"confirmate.io/core/api/evaluation"
+	"confirmate.io/core/api/orchestrator"
+	"confirmate.io/core/log"
+	"confirmate.io/core/service"
+
+	"connectrpc.com/connect"
+)
+
+// defaultEvaluateNowTimeout is the timeout of a synchronous evaluation, if no timeout is set in the
+// EvaluateNowRequest.
+const defaultEvaluateNowTimeout = 5 * time.Minute
+
+// EvaluateNow evaluates all catalogs of the given audit scope once and waits until the evaluation is finished. The
+// results are stored in the same way as the ones of a scheduled evaluation and are returned together with their
+// aggregated status, so that a CI/CD pipeline can block a release on the compliance status. Controls that exceed the
+// timeout are recorded with the status ERROR.
+func (svc *Service) EvaluateNow(ctx context.Context, req *connect.Request[evaluation.EvaluateNowRequest]) (res *connect.Response[evaluation.EvaluateNowResponse], err error) {
+	var (
+		allowed       bool
+		timeouts      evaluationTimeouts
+		auditScope    *orchestrator.AuditScope
+		auditScopeRes *connect.Response[orchestrator.AuditScope]
+		catalogs      []*orchestrator.Catalog
+		results       []*evaluation.EvaluationResult
+		status        = evaluation.EvaluationStatus_EVALUATION_STATUS_PENDING
+	)
+
+	// Validate the request
+	if err = service.Validate(req); err != nil {
+		return nil, err
+	}
+
+	// Check access via the configured auth strategy. Evaluating an audit scope requires the same permission as
+	// starting its evaluation.
+	allowed, _, err = checkAccess(ctx, svc.authz, orchestrator.RequestType_REQUEST_TYPE_UPDATED, req.Msg.GetAuditScopeId(), orchestrator.ObjectType_OBJECT_TYPE_AUDIT_SCOPE)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if !allowed {
+		return nil, service.ErrPermissionDenied
+	}
+
+	// Get Audit Scope
+	auditScopeRes, err = svc.orchestratorClient.GetAuditScope(ctx, connect.NewRequest(&orchestrator.GetAuditScopeRequest{
+		AuditScopeId: req.Msg.GetAuditScopeId(),
+	}))
+	if err != nil {
+		slog.Error("Could not get audit scope from orchestrator", log.Err(err))
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("could not get audit scope from orchestrator"))
+	}
+	auditScope = auditScopeRes.Msg
+
+	// The token might be restricted to other targets of evaluation
+	if !service.AllowsTargetOfEvaluation(ctx, svc.authz, auditScope.GetTargetOfEvaluationId()) {
+		return nil, service.ErrPermissionDenied
+	}
+
+	// A requested locale takes precedence over the locale of the audit scope for all texts generated by this evaluation
+	if req.Msg.Locale != nil {
+		auditScope.Locale = req.Msg.Locale
+	}
+
+	// A gate must not pass because the evaluation was skipped, so we report the maintenance instead
+	if svc.suppressedByMaintenance(ctx, auditScope.GetId()) {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("evaluation of the audit scope is suppressed by a maintenance window"))
+	}
+
+	catalogs, err = svc.fetchCatalogs(ctx, auditScope)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Msg.Timeout == nil {
+		timeouts.scope = defaultEvaluateNowTimeout
+	} else {
+		timeouts.scope = time.Duration(req.Msg.GetTimeout()) * time.Second
+	}
+
+	slog.Info("Evaluating audit scope synchronously",
+		slog.String("audit scope", auditScope.GetId()),
+		slog.Duration("timeout", timeouts.scope),
+	)
+
+	results, err = svc.evaluateCatalogs(ctx, auditScope, catalogs, timeouts)
+	if err != nil {
+		slog.Error("Could not evaluate audit scope", slog.String("audit scope", auditScope.GetId()), log.Err(err))
+		return nil, connect.NewError(connect.CodeInternal, errors.New("could not evaluate audit scope"))
+	}
+
+	// The audit scope is aggregated from its controls in the same way as a control from its sub-controls
+	for _, r := range results {
+		status = aggregateStatus(status, r.GetStatus())
+	}
+
+	res = connect.NewResponse(&evaluation.EvaluateNowResponse{
+		Status:    status,
+		Compliant: status == evaluation.EvaluationStatus_EVALUATION_STATUS_COMPLIANT,
+		Results:   results,
+	})
+	return
+}
@@ -0,0 +1,219 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package evaluation
+
+import (
+	"context"
+	"testing"
+
+	"confirmate.io/core/api/assessment"
+	"confirmate.io/core/api/evaluation"
+	"confirmate.io/core/api/orchestrator"
+	"confirmate.io/core/api/orchestrator/orchestratorconnect"
+	"confirmate.io/core/service"
+	"confirmate.io/core/service/evaluation/evaluationtest"
+	"confirmate.io/core/util/assert"
+
+	"connectrpc.com/connect"
+)
+
+func TestService_EvaluateNow(t *testing.T) {
+	type fields struct {
+		orchestratorClient orchestratorconnect.OrchestratorClient
+		authz              service.AuthorizationStrategy
+	}
+	type args struct {
+		req *connect.Request[evaluation.EvaluateNowRequest]
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    assert.Want[*connect.Response[evaluation.EvaluateNowResponse]]
+		wantErr assert.WantErr
+	}{
+		{
+			name: "err: validation error",
+			args: args{
+				req: connect.NewRequest(&evaluation.EvaluateNowRequest{
+					AuditScopeId: evaluationtest.MockAuditScopeId1,
+					Timeout:      new(int32(0)),
+				}),
+			},
+			want: assert.Nil[*connect.Response[evaluation.EvaluateNowResponse]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeInvalidArgument)
+			},
+		},
+		{
+			name: "err: permission denied",
+			fields: fields{
+				authz: &denyAuthorizationStrategy{},
+			},
+			args: args{
+				req: connect.NewRequest(&evaluation.EvaluateNowRequest{
+					AuditScopeId: evaluationtest.MockAuditScopeId1,
+				}),
+			},
+			want: assert.Nil[*connect.Response[evaluation.EvaluateNowResponse]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodePermissionDenied)
+			},
+		},
+		{
+			name: "err: audit scope not found",
+			fields: fields{
+				orchestratorClient: newOrchestratorClient(t,
+					WithGetAuditScopeNotFoundError(connect.NewError(connect.CodeNotFound, service.ErrNotFound("audit scope"))),
+				),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			args: args{
+				req: connect.NewRequest(&evaluation.EvaluateNowRequest{
+					AuditScopeId: evaluationtest.MockAuditScopeId1,
+				}),
+			},
+			want: assert.Nil[*connect.Response[evaluation.EvaluateNowResponse]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeNotFound)
+			},
+		},
+		{
+			name: "err: suppressed by maintenance",
+			fields: fields{
+				orchestratorClient: newOrchestratorClient(t,
+					WithAuditScope(evaluationtest.MockAuditScope1),
+					WithMaintenanceWindows(&orchestrator.MaintenanceWindow{
+						AuditScopeId: evaluationtest.MockAuditScopeId1,
+						Mode:         orchestrator.MaintenanceMode_MAINTENANCE_MODE_SUPPRESS,
+					}),
+				),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			args: args{
+				req: connect.NewRequest(&evaluation.EvaluateNowRequest{
+					AuditScopeId: evaluationtest.MockAuditScopeId1,
+				}),
+			},
+			want: assert.Nil[*connect.Response[evaluation.EvaluateNowResponse]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeFailedPrecondition)
+			},
+		},
+		{
+			name: "err: catalog not found",
+			fields: fields{
+				orchestratorClient: newOrchestratorClient(t,
+					WithAuditScope(evaluationtest.MockAuditScope1),
+					WithControls([]*orchestrator.Control{evaluationtest.MockControl1}),
+					WithGetCatalogNotFoundError(connect.NewError(connect.CodeNotFound, service.ErrNotFound("catalog"))),
+				),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			args: args{
+				req: connect.NewRequest(&evaluation.EvaluateNowRequest{
+					AuditScopeId: evaluationtest.MockAuditScopeId1,
+				}),
+			},
+			want: assert.Nil[*connect.Response[evaluation.EvaluateNowResponse]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeInternal)
+			},
+		},
+		{
+			name: "happy path",
+			fields: fields{
+				orchestratorClient: newOrchestratorClient(t,
+					WithAuditScope(evaluationtest.MockAuditScope1),
+					WithCatalog(evaluationtest.MockCatalog1),
+					WithControls([]*orchestrator.Control{evaluationtest.MockControl1}),
+					WithAssessmentResults([]*assessment.AssessmentResult{
+						{
+							Id:                   evaluationtest.MockAssessmentResultId1,
+							MetricId:             evaluationtest.MockMetricId1,
+							Compliant:            true,
+							ResourceId:           "resource-1",
+							TargetOfEvaluationId: evaluationtest.MockToeId1,
+						},
+					}),
+				),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			args: args{
+				req: connect.NewRequest(&evaluation.EvaluateNowRequest{
+					AuditScopeId: evaluationtest.MockAuditScopeId1,
+					Timeout:      new(int32(60)),
+				}),
+			},
+			want: func(t *testing.T, got *connect.Response[evaluation.EvaluateNowResponse], msgAndArgs ...any) bool {
+				assert.Equal(t, evaluation.EvaluationStatus_EVALUATION_STATUS_COMPLIANT, got.Msg.Status)
+				assert.True(t, got.Msg.Compliant)
+				if !assert.Equal(t, 1, len(got.Msg.Results)) {
+					return false
+				}
+				// The result belongs to the evaluation run and its ID is derived from it
+				assert.NotNil(t, got.Msg.Results[0].RunId)
+				assert.Equal(t, resultId(withRunId(context.Background(), got.Msg.Results[0].GetRunId()),
+					evaluationtest.MockCatalogId1, evaluationtest.MockControlId1, "result"), got.Msg.Results[0].GetId())
+				return assert.Equal(t, evaluationtest.MockControlId1, got.Msg.Results[0].ControlId)
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "happy path: not compliant",
+			fields: fields{
+				orchestratorClient: newOrchestratorClient(t,
+					WithAuditScope(evaluationtest.MockAuditScope1),
+					WithCatalog(evaluationtest.MockCatalog1),
+					WithControls([]*orchestrator.Control{evaluationtest.MockControl1}),
+					WithAssessmentResults([]*assessment.AssessmentResult{
+						{
+							Id:                   evaluationtest.MockAssessmentResultId1,
+							MetricId:             evaluationtest.MockMetricId1,
+							Compliant:            false,
+							ResourceId:           "resource-1",
+							TargetOfEvaluationId: evaluationtest.MockToeId1,
+						},
+					}),
+				),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			args: args{
+				req: connect.NewRequest(&evaluation.EvaluateNowRequest{
+					AuditScopeId: evaluationtest.MockAuditScopeId1,
+				}),
+			},
+			want: func(t *testing.T, got *connect.Response[evaluation.EvaluateNowResponse], msgAndArgs ...any) bool {
+				assert.Equal(t, evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT, got.Msg.Status)
+				return assert.False(t, got.Msg.Compliant)
+			},
+			wantErr: assert.NoError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &Service{
+				orchestratorClient: tt.fields.orchestratorClient,
+				authz:              tt.fields.authz,
+				catalogControls:    newControlCache(0),
+			}
+
+			got, err := svc.EvaluateNow(context.Background(), tt.args.req)
+			tt.want(t, got)
+			tt.wantErr(t, err)
+		})
+	}
+}
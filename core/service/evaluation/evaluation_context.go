@@ -0,0 +1,139 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package evaluation
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	"confirmate.io/core/api/evaluation"
+	"confirmate.io/core/api/orchestrator"
+	"confirmate.io/core/log"
+	"confirmate.io/core/service"
+	"confirmate.io/core/util/entity"
+
+	"connectrpc.com/connect"
+)
+
+// GetControlEvaluationContext explains the precedence of manual and automatic evaluation for a control of the audit
+// scope. It applies the same rules as the evaluation (see [Service.evaluateCatalog] and [Service.evaluateControl]): a
+// valid manual result of a control replaces its automatic evaluation, and valid manual results of the sub-controls of
+// a parent control replace the automatic evaluation of these sub-controls.
+func (svc *Service) GetControlEvaluationContext(ctx context.Context, req *connect.Request[evaluation.GetControlEvaluationContextRequest]) (res *connect.Response[evaluation.ControlEvaluationContext], err error) {
+	var (
+		allowed    bool
+		auditScope *orchestrator.AuditScope
+		catalog    *orchestrator.Catalog
+		controls   map[string]*orchestrator.Control
+		control    *orchestrator.Control
+		results    []*evaluation.EvaluationResult
+		manual     *evaluation.EvaluationResult
+		evalCtx    *evaluation.ControlEvaluationContext
+		ok         bool
+	)
+
+	// Validate the request
+	if err = service.Validate(req); err != nil {
+		return nil, err
+	}
+
+	// Check access via the configured auth strategy
+	allowed, _, err = checkAccess(ctx, svc.authz, orchestrator.RequestType_REQUEST_TYPE_GET, req.Msg.GetAuditScopeId(), orchestrator.ObjectType_OBJECT_TYPE_AUDIT_SCOPE)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if !allowed {
+		return nil, service.ErrPermissionDenied
+	}
+
+	// Retrieve the audit scope and the catalog of the control
+	auditScope, catalog, err = svc.fetchAuditScopeCatalog(ctx, req.Msg.GetAuditScopeId(), (*entity.CatalogID)(req.Msg.CatalogId))
+	if err != nil {
+		return nil, err
+	}
+
+	// The token might be restricted to other targets of evaluation
+	if !service.AllowsTargetOfEvaluation(ctx, svc.authz, auditScope.GetTargetOfEvaluationId()) {
+		return nil, service.ErrPermissionDenied
+	}
+
+	controls, _ = svc.catalogControls.get(catalog.GetId())
+	control, ok = controls[req.Msg.GetControlId()]
+	if !ok {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("control not found in the catalog"))
+	}
+
+	results, err = svc.fetchValidManualResults(ctx, auditScope, catalog)
+	if err != nil {
+		slog.Error("Could not get manual evaluation results", log.Err(err))
+		return nil, connect.NewError(connect.CodeInternal, errors.New("could not get manual evaluation results from the orchestrator"))
+	}
+
+	evalCtx = &evaluation.ControlEvaluationContext{
+		AuditScopeId:        auditScope.GetId(),
+		CatalogId:           catalog.GetId(),
+		ControlId:           control.GetId(),
+		Precedence:          evaluation.EvaluationPrecedence_EVALUATION_PRECEDENCE_AUTOMATIC,
+		ExcludedSubControls: []*evaluation.ExcludedSubControl{},
+		Locale:              resolveLocale(req.Msg.Locale, auditScope),
+	}
+
+	for _, r := range results {
+		switch {
+		case r.GetControlId() == control.GetId():
+			manual = r
+		case r.GetParentControlId() == control.GetId():
+			evalCtx.ExcludedSubControls = append(evalCtx.ExcludedSubControls, &evaluation.ExcludedSubControl{
+				ControlId:      r.GetControlId(),
+				ManualResultId: r.GetId(),
+				Status:         r.GetStatus(),
+				ValidUntil:     r.GetValidUntil(),
+			})
+		}
+	}
+
+	slices.SortFunc(evalCtx.ExcludedSubControls, func(a *evaluation.ExcludedSubControl, b *evaluation.ExcludedSubControl) int {
+		return strings.Compare(a.ControlId, b.ControlId)
+	})
+
+	// A manual result of the control itself takes precedence over everything else, since the control is not evaluated
+	// automatically at all
+	switch {
+	case manual != nil:
+		evalCtx.Precedence = evaluation.EvaluationPrecedence_EVALUATION_PRECEDENCE_MANUAL
+		evalCtx.ManualResult = manual
+		evalCtx.ManualUntil = manual.GetValidUntil()
+		evalCtx.ExcludedSubControls = []*evaluation.ExcludedSubControl{}
+
+		if manual.ValidUntil != nil {
+			evalCtx.Explanation = translate(evalCtx.Locale, msgPrecedenceManual, manual.GetValidUntil().AsTime().UTC().Format(time.RFC3339))
+		} else {
+			evalCtx.Explanation = translate(evalCtx.Locale, msgPrecedenceManualForever)
+		}
+	case len(evalCtx.ExcludedSubControls) > 0:
+		evalCtx.Precedence = evaluation.EvaluationPrecedence_EVALUATION_PRECEDENCE_PARTIALLY_MANUAL
+		evalCtx.Explanation = translate(evalCtx.Locale, msgPrecedencePartiallyManual, len(evalCtx.ExcludedSubControls))
+	default:
+		evalCtx.Explanation = translate(evalCtx.Locale, msgPrecedenceAutomatic)
+	}
+
+	res = connect.NewResponse(evalCtx)
+	return
+}
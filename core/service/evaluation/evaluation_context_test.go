@@ -0,0 +1,270 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package evaluation
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"confirmate.io/core/api/evaluation"
+	"confirmate.io/core/api/orchestrator"
+	"confirmate.io/core/api/orchestrator/orchestratorconnect"
+	"confirmate.io/core/service"
+	"confirmate.io/core/service/evaluation/evaluationtest"
+	"confirmate.io/core/util/assert"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestService_GetControlEvaluationContext(t *testing.T) {
+	var validUntil = time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	type fields struct {
+		orchestratorClient orchestratorconnect.OrchestratorClient
+		authz              service.AuthorizationStrategy
+	}
+	type args struct {
+		req *connect.Request[evaluation.GetControlEvaluationContextRequest]
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    assert.Want[*connect.Response[evaluation.ControlEvaluationContext]]
+		wantErr assert.WantErr
+	}{
+		{
+			name: "err: validation error",
+			args: args{
+				req: connect.NewRequest(&evaluation.GetControlEvaluationContextRequest{}),
+			},
+			want: assert.Nil[*connect.Response[evaluation.ControlEvaluationContext]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeInvalidArgument)
+			},
+		},
+		{
+			name: "err: permission denied",
+			fields: fields{
+				authz: &denyAuthorizationStrategy{},
+			},
+			args: args{
+				req: connect.NewRequest(&evaluation.GetControlEvaluationContextRequest{
+					AuditScopeId: evaluationtest.MockAuditScopeId1,
+					ControlId:    evaluationtest.MockControlId1,
+				}),
+			},
+			want: assert.Nil[*connect.Response[evaluation.ControlEvaluationContext]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodePermissionDenied)
+			},
+		},
+		{
+			name: "err: control not found",
+			fields: fields{
+				orchestratorClient: newOrchestratorClient(t,
+					WithAuditScope(evaluationtest.MockAuditScope1),
+					WithCatalog(evaluationtest.MockCatalog1),
+					WithControls([]*orchestrator.Control{evaluationtest.MockControl1}),
+				),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			args: args{
+				req: connect.NewRequest(&evaluation.GetControlEvaluationContextRequest{
+					AuditScopeId: evaluationtest.MockAuditScopeId1,
+					ControlId:    "other-control",
+				}),
+			},
+			want: assert.Nil[*connect.Response[evaluation.ControlEvaluationContext]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeNotFound)
+			},
+		},
+		{
+			name: "happy path: automatic",
+			fields: fields{
+				orchestratorClient: newOrchestratorClient(t,
+					WithAuditScope(evaluationtest.MockAuditScope1),
+					WithCatalog(evaluationtest.MockCatalog1),
+					WithControls([]*orchestrator.Control{evaluationtest.MockControl1}),
+				),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			args: args{
+				req: connect.NewRequest(&evaluation.GetControlEvaluationContextRequest{
+					AuditScopeId: evaluationtest.MockAuditScopeId1,
+					ControlId:    evaluationtest.MockControlId1,
+				}),
+			},
+			want: func(t *testing.T, got *connect.Response[evaluation.ControlEvaluationContext], msgAndArgs ...any) bool {
+				want := &evaluation.ControlEvaluationContext{
+					AuditScopeId:        evaluationtest.MockAuditScopeId1,
+					CatalogId:           evaluationtest.MockCatalogId1,
+					ControlId:           evaluationtest.MockControlId1,
+					Precedence:          evaluation.EvaluationPrecedence_EVALUATION_PRECEDENCE_AUTOMATIC,
+					ExcludedSubControls: []*evaluation.ExcludedSubControl{},
+					Locale:              LocaleEnglish,
+					Explanation:         "The control is evaluated automatically based on the assessment results.",
+				}
+				return assert.Equal(t, want, got.Msg)
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "happy path: manual",
+			fields: fields{
+				orchestratorClient: newOrchestratorClient(t,
+					WithAuditScope(evaluationtest.MockAuditScope1),
+					WithCatalog(evaluationtest.MockCatalog1),
+					WithControls([]*orchestrator.Control{evaluationtest.MockControl1}),
+					WithEvaluationResults([]*evaluation.EvaluationResult{
+						{
+							Id:           evaluationtest.MockEvaluationResultId101,
+							AuditScopeId: evaluationtest.MockAuditScopeId1,
+							ControlId:    evaluationtest.MockControlId1,
+							Status:       evaluation.EvaluationStatus_EVALUATION_STATUS_COMPLIANT_MANUALLY,
+							ValidUntil:   timestamppb.New(validUntil),
+						},
+						{
+							Id:              evaluationtest.MockEvaluationResultId102,
+							AuditScopeId:    evaluationtest.MockAuditScopeId1,
+							ControlId:       evaluationtest.MockControl1SubcontrolId11,
+							ParentControlId: new(evaluationtest.MockControlId1),
+							Status:          evaluation.EvaluationStatus_EVALUATION_STATUS_COMPLIANT_MANUALLY,
+						},
+					}),
+				),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			args: args{
+				req: connect.NewRequest(&evaluation.GetControlEvaluationContextRequest{
+					AuditScopeId: evaluationtest.MockAuditScopeId1,
+					ControlId:    evaluationtest.MockControlId1,
+				}),
+			},
+			want: func(t *testing.T, got *connect.Response[evaluation.ControlEvaluationContext], msgAndArgs ...any) bool {
+				return assert.Equal(t, evaluation.EvaluationPrecedence_EVALUATION_PRECEDENCE_MANUAL, got.Msg.GetPrecedence()) &&
+					assert.Equal(t, evaluationtest.MockEvaluationResultId101, got.Msg.GetManualResult().GetId()) &&
+					assert.Equal(t, validUntil, got.Msg.GetManualUntil().AsTime()) &&
+					assert.Empty(t, got.Msg.GetExcludedSubControls()) &&
+					assert.Equal(t, "A manual evaluation result overrides the automatic evaluation until 2030-01-02T03:04:05Z.", got.Msg.GetExplanation())
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "happy path: manual without expiry in german",
+			fields: fields{
+				orchestratorClient: newOrchestratorClient(t,
+					WithAuditScope(evaluationtest.MockAuditScope1),
+					WithCatalog(evaluationtest.MockCatalog1),
+					WithControls([]*orchestrator.Control{evaluationtest.MockControl1}),
+					WithEvaluationResults([]*evaluation.EvaluationResult{
+						{
+							Id:           evaluationtest.MockEvaluationResultId101,
+							AuditScopeId: evaluationtest.MockAuditScopeId1,
+							ControlId:    evaluationtest.MockControlId1,
+							Status:       evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT_MANUALLY,
+						},
+					}),
+				),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			args: args{
+				req: connect.NewRequest(&evaluation.GetControlEvaluationContextRequest{
+					AuditScopeId: evaluationtest.MockAuditScopeId1,
+					ControlId:    evaluationtest.MockControlId1,
+					Locale:       new(LocaleGerman),
+				}),
+			},
+			want: func(t *testing.T, got *connect.Response[evaluation.ControlEvaluationContext], msgAndArgs ...any) bool {
+				return assert.Equal(t, evaluation.EvaluationPrecedence_EVALUATION_PRECEDENCE_MANUAL, got.Msg.GetPrecedence()) &&
+					assert.Nil(t, got.Msg.GetManualUntil()) &&
+					assert.Equal(t, LocaleGerman, got.Msg.GetLocale()) &&
+					assert.Equal(t, "Ein manuelles Evaluierungsergebnis hat unbefristet Vorrang vor der automatischen Evaluierung.", got.Msg.GetExplanation())
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "happy path: partially manual",
+			fields: fields{
+				orchestratorClient: newOrchestratorClient(t,
+					WithAuditScope(evaluationtest.MockAuditScope1),
+					WithCatalog(evaluationtest.MockCatalog1),
+					WithControls([]*orchestrator.Control{evaluationtest.MockControl1}),
+					WithEvaluationResults([]*evaluation.EvaluationResult{
+						{
+							Id:              evaluationtest.MockEvaluationResultId103,
+							AuditScopeId:    evaluationtest.MockAuditScopeId1,
+							ControlId:       evaluationtest.MockControl1SubcontrolId12,
+							ParentControlId: new(evaluationtest.MockControlId1),
+							Status:          evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT_MANUALLY,
+						},
+						{
+							Id:              evaluationtest.MockEvaluationResultId102,
+							AuditScopeId:    evaluationtest.MockAuditScopeId1,
+							ControlId:       evaluationtest.MockControl1SubcontrolId11,
+							ParentControlId: new(evaluationtest.MockControlId1),
+							Status:          evaluation.EvaluationStatus_EVALUATION_STATUS_COMPLIANT_MANUALLY,
+							ValidUntil:      timestamppb.New(validUntil),
+						},
+					}),
+				),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			args: args{
+				req: connect.NewRequest(&evaluation.GetControlEvaluationContextRequest{
+					AuditScopeId: evaluationtest.MockAuditScopeId1,
+					ControlId:    evaluationtest.MockControlId1,
+				}),
+			},
+			want: func(t *testing.T, got *connect.Response[evaluation.ControlEvaluationContext], msgAndArgs ...any) bool {
+				want := []*evaluation.ExcludedSubControl{
+					{
+						ControlId:      evaluationtest.MockControl1SubcontrolId11,
+						ManualResultId: evaluationtest.MockEvaluationResultId102,
+						Status:         evaluation.EvaluationStatus_EVALUATION_STATUS_COMPLIANT_MANUALLY,
+						ValidUntil:     timestamppb.New(validUntil),
+					},
+					{
+						ControlId:      evaluationtest.MockControl1SubcontrolId12,
+						ManualResultId: evaluationtest.MockEvaluationResultId103,
+						Status:         evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT_MANUALLY,
+					},
+				}
+				return assert.Equal(t, evaluation.EvaluationPrecedence_EVALUATION_PRECEDENCE_PARTIALLY_MANUAL, got.Msg.GetPrecedence()) &&
+					assert.Nil(t, got.Msg.GetManualResult()) &&
+					assert.Equal(t, want, got.Msg.GetExcludedSubControls()) &&
+					assert.Equal(t, "The control is evaluated automatically, but 2 sub-controls are excluded because their manual evaluation results are used instead.", got.Msg.GetExplanation())
+			},
+			wantErr: assert.NoError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &Service{
+				orchestratorClient: tt.fields.orchestratorClient,
+				authz:              tt.fields.authz,
+				catalogControls:    newControlCache(0),
+			}
+
+			got, err := svc.GetControlEvaluationContext(context.Background(), tt.args.req)
+			tt.want(t, got)
+			tt.wantErr(t, err)
+		})
+	}
+}
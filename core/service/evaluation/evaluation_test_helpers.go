@@ -20,6 +20,7 @@ import (
 	"net/http/httptest"
 	"sync"
 	"testing"
+	"time"
 
 	"confirmate.io/core/api/assessment"
 	"confirmate.io/core/api/evaluation"
@@ -43,6 +44,7 @@ type mockOrchestratorHandler struct {
 	// ListAssessmentResults support
 	assessmentResults         []*assessment.AssessmentResult
 	listAssessmentResultError error
+	listAssessmentResultDelay time.Duration
 
 	// GetAuditScope support
 	auditScope                 *orchestrator.AuditScope
@@ -67,6 +69,20 @@ type mockOrchestratorHandler struct {
 	// ListControlsInScope support
 	controlsInScope          []*orchestrator.ControlInScope
 	listControlsInScopeError error
+
+	// ListMaintenanceWindows support
+	maintenanceWindows          []*orchestrator.MaintenanceWindow
+	listMaintenanceWindowsError error
+
+	// ListCertificates support
+	certificates          []*orchestrator.Certificate
+	listCertificatesError error
+
+	// ListAuditScopes support
+	auditScopes []*orchestrator.AuditScope
+
+	// ListCatalogs support
+	catalogs []*orchestrator.Catalog
 }
 
 // ListControls returns the mocked controls or an error if configured
@@ -95,14 +111,49 @@ func (m *mockOrchestratorHandler) ListControlsInScope(
 	}), nil
 }
 
+// ListMaintenanceWindows returns the mocked maintenance windows or an error if configured
+func (m *mockOrchestratorHandler) ListMaintenanceWindows(
+	_ context.Context,
+	_ *connect.Request[orchestrator.ListMaintenanceWindowsRequest],
+) (*connect.Response[orchestrator.ListMaintenanceWindowsResponse], error) {
+	if m.listMaintenanceWindowsError != nil {
+		return nil, m.listMaintenanceWindowsError
+	}
+	return connect.NewResponse(&orchestrator.ListMaintenanceWindowsResponse{
+		MaintenanceWindows: m.maintenanceWindows,
+	}), nil
+}
+
+// ListCertificates returns the mocked certificates or an error if configured
+func (m *mockOrchestratorHandler) ListCertificates(
+	_ context.Context,
+	_ *connect.Request[orchestrator.ListCertificatesRequest],
+) (*connect.Response[orchestrator.ListCertificatesResponse], error) {
+	if m.listCertificatesError != nil {
+		return nil, m.listCertificatesError
+	}
+	return connect.NewResponse(&orchestrator.ListCertificatesResponse{
+		Certificates: m.certificates,
+	}), nil
+}
+
 // ListAssessmentResults returns assessment results or an error if configured
 func (m *mockOrchestratorHandler) ListAssessmentResults(
-	_ context.Context,
+	ctx context.Context,
 	req *connect.Request[orchestrator.ListAssessmentResultsRequest],
 ) (*connect.Response[orchestrator.ListAssessmentResultsResponse], error) {
 	if m.listAssessmentResultError != nil {
 		return nil, m.listAssessmentResultError
 	}
+
+	// Simulate a slow orchestrator query
+	if m.listAssessmentResultDelay > 0 {
+		select {
+		case <-time.After(m.listAssessmentResultDelay):
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
 	// Return configured assessment results, or empty list if none configured
 	results := m.assessmentResults
 	if results == nil {
@@ -131,6 +182,11 @@ func (m *mockOrchestratorHandler) ListAssessmentResults(
 					continue
 				}
 			}
+			// Filter by environment
+			if req.Msg.Filter.Environment != nil &&
+				result.GetEnvironment() != req.Msg.Filter.GetEnvironment() {
+				continue
+			}
 			filtered = append(filtered, result)
 		}
 		results = filtered
@@ -152,6 +208,14 @@ func (m *mockOrchestratorHandler) ListAssessmentResults(
 		}
 	}
 
+	// If a sampling is requested, return the first results as sample
+	if req.Msg.Sampling != nil {
+		return connect.NewResponse(&orchestrator.ListAssessmentResultsResponse{
+			Results:        results[:min(len(results), int(req.Msg.Sampling.SampleSize))],
+			PopulationSize: new(int64(len(results))),
+		}), nil
+	}
+
 	return connect.NewResponse(&orchestrator.ListAssessmentResultsResponse{
 		Results: results,
 	}), nil
@@ -267,6 +331,26 @@ func (m *mockOrchestratorHandler) GetCatalog(
 	return connect.NewResponse(m.catalog), nil
 }
 
+// ListAuditScopes returns the mocked audit scopes.
+func (m *mockOrchestratorHandler) ListAuditScopes(
+	_ context.Context,
+	_ *connect.Request[orchestrator.ListAuditScopesRequest],
+) (*connect.Response[orchestrator.ListAuditScopesResponse], error) {
+	return connect.NewResponse(&orchestrator.ListAuditScopesResponse{
+		AuditScopes: m.auditScopes,
+	}), nil
+}
+
+// ListCatalogs returns the mocked catalogs.
+func (m *mockOrchestratorHandler) ListCatalogs(
+	_ context.Context,
+	_ *connect.Request[orchestrator.ListCatalogsRequest],
+) (*connect.Response[orchestrator.ListCatalogsResponse], error) {
+	return connect.NewResponse(&orchestrator.ListCatalogsResponse{
+		Catalogs: m.catalogs,
+	}), nil
+}
+
 // newOrchestratorTestServer creates a mock orchestrator server for testing
 func newOrchestratorTestServer(t *testing.T, controls []*orchestrator.Control) (
 	*mockOrchestratorHandler,
@@ -332,11 +416,31 @@ func WithAssessmentResults(results []*assessment.AssessmentResult) func(*mockOrc
 	return func(h *mockOrchestratorHandler) { h.assessmentResults = results }
 }
 
+// WithListAssessmentResultsError forces ListAssessmentResults to return the given error.
+func WithListAssessmentResultsError(err error) func(*mockOrchestratorHandler) {
+	return func(h *mockOrchestratorHandler) { h.listAssessmentResultError = err }
+}
+
+// WithListAssessmentResultsDelay delays the response of ListAssessmentResults by the given duration.
+func WithListAssessmentResultsDelay(delay time.Duration) func(*mockOrchestratorHandler) {
+	return func(h *mockOrchestratorHandler) { h.listAssessmentResultDelay = delay }
+}
+
 // WithEvaluationResults seeds the handler with evaluation results (visible via ListEvaluationResults).
 func WithEvaluationResults(results []*evaluation.EvaluationResult) func(*mockOrchestratorHandler) {
 	return func(h *mockOrchestratorHandler) { h.evaluationResults = results }
 }
 
+// WithAuditScopes seeds the handler with audit scopes (visible via ListAuditScopes).
+func WithAuditScopes(scopes ...*orchestrator.AuditScope) func(*mockOrchestratorHandler) {
+	return func(h *mockOrchestratorHandler) { h.auditScopes = scopes }
+}
+
+// WithCatalogs seeds the handler with catalogs (visible via ListCatalogs).
+func WithCatalogs(catalogs ...*orchestrator.Catalog) func(*mockOrchestratorHandler) {
+	return func(h *mockOrchestratorHandler) { h.catalogs = catalogs }
+}
+
 // WithControls seeds the handler with controls. It accepts one or more control lists and flattens them.
 func WithControls(lists ...[]*orchestrator.Control) func(*mockOrchestratorHandler) {
 	return func(h *mockOrchestratorHandler) {
@@ -394,6 +498,26 @@ func WithControlsInScope(controlsInScope []*orchestrator.ControlInScope) func(*m
 	return func(h *mockOrchestratorHandler) { h.controlsInScope = controlsInScope }
 }
 
+// WithMaintenanceWindows seeds the handler with maintenance windows returned by ListMaintenanceWindows.
+func WithMaintenanceWindows(windows ...*orchestrator.MaintenanceWindow) func(*mockOrchestratorHandler) {
+	return func(h *mockOrchestratorHandler) { h.maintenanceWindows = windows }
+}
+
+// WithListMaintenanceWindowsError forces ListMaintenanceWindows to return the given error.
+func WithListMaintenanceWindowsError(err error) func(*mockOrchestratorHandler) {
+	return func(h *mockOrchestratorHandler) { h.listMaintenanceWindowsError = err }
+}
+
+// WithCertificates seeds the handler with certificates returned by ListCertificates.
+func WithCertificates(certificates ...*orchestrator.Certificate) func(*mockOrchestratorHandler) {
+	return func(h *mockOrchestratorHandler) { h.certificates = certificates }
+}
+
+// WithListCertificatesError forces ListCertificates to return the given error.
+func WithListCertificatesError(err error) func(*mockOrchestratorHandler) {
+	return func(h *mockOrchestratorHandler) { h.listCertificatesError = err }
+}
+
 // mockControlsForCatalog returns mock controls for a catalog
 func mockControlsForCatalog(catalogID string) []*orchestrator.Control {
 	// Return 4 controls as expected by the test
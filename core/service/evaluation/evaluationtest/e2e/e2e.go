@@ -0,0 +1,179 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+// Package e2e wires the orchestrator, evidence store, assessment and evaluation services together in-process, so that
+// tests can drive the full evidence -> assessment -> evaluation flow and catch regressions that only show up across
+// service boundaries. Each service is served by its own test Connect server and uses an in-memory database.
+//
+// The assessment service resolves its policies relative to the current working directory, so tests using this package
+// need to run in the core folder, e.g., by calling clitest.AutoChdir in their TestMain.
+package e2e
+
+import (
+	"context"
+	"net"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"confirmate.io/core/api/assessment/assessmentconnect"
+	"confirmate.io/core/api/evaluation/evaluationconnect"
+	"confirmate.io/core/api/evidence/evidenceconnect"
+	"confirmate.io/core/api/orchestrator/orchestratorconnect"
+	"confirmate.io/core/persistence"
+	"confirmate.io/core/server"
+	"confirmate.io/core/server/servertest"
+	"confirmate.io/core/service"
+	"confirmate.io/core/service/assessment"
+	"confirmate.io/core/service/evaluation"
+	"confirmate.io/core/service/evidence"
+	"confirmate.io/core/service/orchestrator"
+	"confirmate.io/core/util/assert"
+)
+
+// DefaultTargetOfEvaluationId is the ID of the default target of evaluation that the orchestrator of a [Harness]
+// creates on startup.
+const DefaultTargetOfEvaluationId = "00000000-0000-0000-0000-000000000000"
+
+// shutdownTimeout is the time the services of a [Harness] have to shut down at the end of a test.
+const shutdownTimeout = 10 * time.Second
+
+// Harness contains clients for all services of an in-process Confirmate deployment. It is created with [New].
+type Harness struct {
+	// Orchestrator is a client for the orchestrator service.
+	Orchestrator orchestratorconnect.OrchestratorClient
+	// EvidenceStore is a client for the evidence store service.
+	EvidenceStore evidenceconnect.EvidenceStoreClient
+	// Assessment is a client for the assessment service.
+	Assessment assessmentconnect.AssessmentClient
+	// Evaluation is a client for the evaluation service.
+	Evaluation evaluationconnect.EvaluationClient
+}
+
+// New starts the orchestrator, assessment, evidence store and evaluation services in the order of their dependencies
+// and connects them to each other. The orchestrator loads the default metrics and creates the default target of
+// evaluation (see [DefaultTargetOfEvaluationId]). All services are shut down and their servers are closed when the test
+// is finished. This will fail the test if a service could not be started.
+func New(t *testing.T) (h *Harness) {
+	var (
+		err           error
+		orchestratorH orchestratorconnect.OrchestratorHandler
+		assessmentH   assessmentconnect.AssessmentHandler
+		evidenceSvc   *evidence.Service
+		evaluationH   evaluationconnect.EvaluationHandler
+		orchestratorS *httptest.Server
+		assessmentS   *httptest.Server
+		evidenceS     *httptest.Server
+		evaluationS   *httptest.Server
+		evidenceL     net.Listener
+		lifecycle     = service.NewLifecycleManager()
+		ocfg          = orchestrator.DefaultConfig
+		acfg          = assessment.DefaultConfig
+		ecfg          = evidence.DefaultConfig
+		vcfg          = evaluation.DefaultConfig
+	)
+
+	t.Helper()
+
+	// Orchestrator
+	ocfg.LoadDefaultCatalogs = false
+	ocfg.PersistenceConfig = persistence.Config{InMemoryDB: true}
+	orchestratorH, err = orchestrator.NewService(orchestrator.WithConfig(ocfg))
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	orchestratorS = newServer(t, server.WithHandler(orchestratorconnect.NewOrchestratorHandler(orchestratorH)))
+
+	// Assessment. The assessment service and the evidence store depend on each other, so we reserve the address of the
+	// evidence store before it is created. All test servers use the same certificate, so that the client of the
+	// orchestrator server can be used for the evidence store as well.
+	evidenceL, err = net.Listen("tcp", "127.0.0.1:0")
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	acfg.OrchestratorAddress = orchestratorS.URL
+	acfg.OrchestratorHTTPClient = orchestratorS.Client()
+	acfg.EvidenceStoreAddress = "https://" + evidenceL.Addr().String()
+	acfg.EvidenceStoreHTTPClient = orchestratorS.Client()
+	assessmentH, err = assessment.NewService(assessment.WithConfig(acfg))
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	assessmentS = newServer(t, server.WithHandler(assessmentconnect.NewAssessmentHandler(assessmentH)))
+
+	// Evidence store
+	ecfg.AssessmentAddress = assessmentS.URL
+	ecfg.AssessmentHTTPClient = assessmentS.Client()
+	ecfg.PersistenceConfig = persistence.Config{InMemoryDB: true}
+	evidenceSvc, err = evidence.NewService(evidence.WithConfig(ecfg))
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	evidenceS = newServerWithListener(t, evidenceL, server.WithHandler(evidenceconnect.NewEvidenceStoreHandler(evidenceSvc)))
+
+	// Evaluation
+	vcfg.OrchestratorAddress = orchestratorS.URL
+	vcfg.OrchestratorClient = orchestratorS.Client()
+	vcfg.EvidenceStoreAddress = evidenceS.URL
+	evaluationH, err = evaluation.NewService(evaluation.WithConfig(vcfg))
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	evaluationS = newServer(t, server.WithHandler(evaluationconnect.NewEvaluationHandler(evaluationH)))
+
+	lifecycle.Register("orchestrator", orchestratorH.(service.Lifecycle))
+	lifecycle.Register("assessment", assessmentH.(service.Lifecycle))
+	lifecycle.Register("evidence-store", evidenceSvc)
+	lifecycle.Register("evaluation", evaluationH.(service.Lifecycle))
+
+	err = lifecycle.Start(context.Background())
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+
+	t.Cleanup(func() {
+		ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+		defer cancel()
+
+		assert.NoError(t, lifecycle.Shutdown(ctx))
+	})
+
+	h = &Harness{
+		Orchestrator:  orchestratorconnect.NewOrchestratorClient(orchestratorS.Client(), orchestratorS.URL),
+		EvidenceStore: evidenceconnect.NewEvidenceStoreClient(evidenceS.Client(), evidenceS.URL),
+		Assessment:    assessmentconnect.NewAssessmentClient(assessmentS.Client(), assessmentS.URL),
+		Evaluation:    evaluationconnect.NewEvaluationClient(evaluationS.Client(), evaluationS.URL),
+	}
+
+	return
+}
+
+// newServer starts a test Connect server with the given options and closes it when the test is finished. Servers are
+// closed after the services are shut down, since cleanup functions are called in reverse order.
+func newServer(t *testing.T, opts ...server.Option) (testsrv *httptest.Server) {
+	t.Helper()
+
+	return newServerWithListener(t, nil, opts...)
+}
+
+// newServerWithListener is like [newServer], but serves on the given listener, if it is not nil.
+func newServerWithListener(t *testing.T, listener net.Listener, opts ...server.Option) (testsrv *httptest.Server) {
+	t.Helper()
+
+	_, testsrv = servertest.NewTestConnectServerWithListener(t, listener, opts...)
+	t.Cleanup(testsrv.Close)
+
+	return
+}
@@ -0,0 +1,223 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package e2e
+
+import (
+	"context"
+	"os"
+	"slices"
+	"testing"
+	"time"
+
+	"confirmate.io/core/api/assessment"
+	"confirmate.io/core/api/evaluation"
+	"confirmate.io/core/api/evidence"
+	"confirmate.io/core/api/ontology"
+	"confirmate.io/core/api/orchestrator"
+	"confirmate.io/core/util/assert"
+	"confirmate.io/core/util/clitest"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestMain(m *testing.M) {
+	clitest.AutoChdir()
+	code := m.Run()
+	os.Exit(code)
+}
+
+// TestHarness_EvidenceToEvaluation drives an evidence through all services: the evidence store forwards it to the
+// assessment service, which stores its assessment results in the orchestrator, and the evaluation service evaluates
+// a control that is mapped to the assessed metrics.
+func TestHarness_EvidenceToEvaluation(t *testing.T) {
+	var (
+		err       error
+		ctx       = context.Background()
+		h         = New(t)
+		results   []*assessment.AssessmentResult
+		metrics   []*assessment.Metric
+		compliant = true
+	)
+
+	// Store the evidence and wait until the assessment service produced results for it
+	_, err = h.EvidenceStore.StoreEvidence(ctx, connect.NewRequest(&evidence.StoreEvidenceRequest{
+		Evidence: loadBalancerEvidence(),
+	}))
+	assert.NoError(t, err)
+
+	ok := waitFor(20*time.Second, 200*time.Millisecond, func() bool {
+		res, err := h.Orchestrator.ListAssessmentResults(ctx, connect.NewRequest(&orchestrator.ListAssessmentResultsRequest{
+			Filter: &orchestrator.ListAssessmentResultsRequest_Filter{
+				TargetOfEvaluationId: new(DefaultTargetOfEvaluationId),
+			},
+		}))
+		if err != nil {
+			return false
+		}
+		results = res.Msg.GetResults()
+		return len(results) > 0
+	})
+	if !ok {
+		t.Fatal("expected at least one assessment result")
+	}
+
+	// Map a control to all metrics that were assessed, so that its status follows from the assessment results
+	for _, r := range results {
+		compliant = compliant && r.GetCompliant()
+		if slices.ContainsFunc(metrics, func(m *assessment.Metric) bool { return m.GetId() == r.GetMetricId() }) {
+			continue
+		}
+
+		metric, err := h.Orchestrator.GetMetric(ctx, connect.NewRequest(&orchestrator.GetMetricRequest{
+			MetricId: r.GetMetricId(),
+		}))
+		assert.NoError(t, err)
+		metrics = append(metrics, metric.Msg)
+	}
+
+	catalog, err := h.Orchestrator.CreateCatalog(ctx, connect.NewRequest(&orchestrator.CreateCatalogRequest{
+		Catalog: testCatalog(metrics),
+	}))
+	assert.NoError(t, err)
+
+	published, err := h.Orchestrator.PublishCatalog(ctx, connect.NewRequest(&orchestrator.PublishCatalogRequest{
+		CatalogId: catalog.Msg.GetId(),
+	}))
+	assert.NoError(t, err)
+
+	scope, err := h.Orchestrator.CreateAuditScope(ctx, connect.NewRequest(&orchestrator.CreateAuditScopeRequest{
+		AuditScope: &orchestrator.AuditScope{
+			Id:                   uuid.NewString(),
+			Name:                 "E2E Audit Scope",
+			TargetOfEvaluationId: DefaultTargetOfEvaluationId,
+			CatalogId:            published.Msg.GetId(),
+			Status:               orchestrator.AuditScopeStatus_AUDIT_SCOPE_STATUS_SETUP,
+		},
+	}))
+	assert.NoError(t, err)
+
+	// Evaluate the audit scope and check that the result is based on the assessment results
+	eval, err := h.Evaluation.EvaluateNow(ctx, connect.NewRequest(&evaluation.EvaluateNowRequest{
+		AuditScopeId: scope.Msg.GetId(),
+	}))
+	assert.NoError(t, err)
+	assert.Equal(t, compliant, eval.Msg.GetCompliant())
+	assert.Equal(t, 1, len(eval.Msg.GetResults()))
+
+	want := evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT
+	if compliant {
+		want = evaluation.EvaluationStatus_EVALUATION_STATUS_COMPLIANT
+	}
+	assert.Equal(t, want, eval.Msg.GetStatus())
+
+	// The evaluation results are stored in the orchestrator
+	stored, err := h.Orchestrator.ListEvaluationResults(ctx, connect.NewRequest(&orchestrator.ListEvaluationResultsRequest{
+		Filter: &orchestrator.ListEvaluationResultsRequest_Filter{
+			AuditScopeId: new(scope.Msg.GetId()),
+		},
+	}))
+	assert.NoError(t, err)
+	assert.NotEmpty(t, stored.Msg.GetResults())
+}
+
+// waitFor polls the condition in the given interval until it is met or the timeout is exceeded.
+func waitFor(timeout, interval time.Duration, condition func() bool) bool {
+	deadline := time.Now().Add(timeout)
+	for {
+		if condition() {
+			return true
+		}
+		if time.Now().After(deadline) {
+			return false
+		}
+		time.Sleep(interval)
+	}
+}
+
+// testCatalog builds a catalog with a single control, whose sub-control is mapped to the given metrics.
+func testCatalog(metrics []*assessment.Metric) *orchestrator.Catalog {
+	var (
+		catalogId = "E2E"
+		controlId = uuid.NewString()
+	)
+
+	return &orchestrator.Catalog{
+		Id:         catalogId,
+		Name:       "E2E Catalog",
+		ShortName:  "E2E",
+		AllInScope: true,
+		Categories: []*orchestrator.Category{
+			{
+				Name:      "E2E Category",
+				CatalogId: catalogId,
+				Controls: []*orchestrator.Control{
+					{
+						Id:        controlId,
+						Name:      "E2E Control",
+						ShortName: "E2E-01",
+						CatalogId: catalogId,
+						Controls: []*orchestrator.Control{
+							{
+								Id:              uuid.NewString(),
+								Name:            "E2E Sub-Control",
+								ShortName:       "E2E-01.1",
+								CatalogId:       catalogId,
+								ParentControlId: new(controlId),
+								Metrics:         metrics,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// loadBalancerEvidence builds a minimal LoadBalancer evidence for the default target of evaluation, which is assessed
+// by the bundled default metrics.
+func loadBalancerEvidence() *evidence.Evidence {
+	return &evidence.Evidence{
+		Id:                   uuid.NewString(),
+		Timestamp:            timestamppb.Now(),
+		TargetOfEvaluationId: DefaultTargetOfEvaluationId,
+		ToolId:               "manual",
+		Resource: &ontology.Resource{
+			Type: &ontology.Resource_LoadBalancer{
+				LoadBalancer: &ontology.LoadBalancer{
+					Id:           "123e4567-e89b-12d3-a456-426614174000",
+					Name:         "Example Load Balancer",
+					Description:  "Example Load Balancer",
+					CreationTime: timestamppb.New(time.Date(2023, 5, 11, 14, 16, 9, 0, time.UTC)),
+					ParentId:     new("123e4567-e89b-12d3-a456-426614174002"),
+					GeoLocation:  &ontology.GeoLocation{Region: "Germany"},
+					AccessRestriction: &ontology.AccessRestriction{
+						Type: &ontology.AccessRestriction_WebApplicationFirewall{
+							WebApplicationFirewall: &ontology.WebApplicationFirewall{Enabled: true},
+						},
+					},
+					TransportEncryption: &ontology.TransportEncryption{
+						Enabled:         true,
+						Enforced:        true,
+						Protocol:        "HTTPS",
+						ProtocolVersion: 1.2,
+					},
+				},
+			},
+		},
+	}
+}
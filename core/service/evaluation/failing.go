@@ -0,0 +1,107 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package evaluation
+
+import (
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"confirmate.io/core/api/assessment"
+	"confirmate.io/core/api/evaluation"
+)
+
+// maxFailingResources is the maximum number of resources that are listed as a sample for each failing metric of an
+// evaluation result.
+const maxFailingResources = 5
+
+// newFailingMetrics groups the non-compliant assessment results by their metric and resource. Waived and stale results
+// are not considered to be failing. The metrics are sorted by their ID, the sampled resources by their ID. Each failing
+// metric includes the remediation of the corresponding metric of metrics, if it has one.
+func newFailingMetrics(results []*assessment.AssessmentResult, metrics []*assessment.Metric, now time.Time) (failing []*evaluation.FailingMetric) {
+	var (
+		byMetric     = make(map[string]map[string][]string)
+		remediations = make(map[string]*assessment.Remediation)
+	)
+
+	for _, m := range metrics {
+		if m.GetRemediation() != nil {
+			remediations[m.GetId()] = m.GetRemediation()
+		}
+	}
+
+	for _, r := range results {
+		if r.Compliant || r.IsWaived(now) || r.IsStale(now) {
+			continue
+		}
+
+		if byMetric[r.GetMetricId()] == nil {
+			byMetric[r.GetMetricId()] = make(map[string][]string)
+		}
+		byMetric[r.GetMetricId()][r.GetResourceId()] = append(byMetric[r.GetMetricId()][r.GetResourceId()], r.GetId())
+	}
+
+	for metricId, byResource := range byMetric {
+		fm := &evaluation.FailingMetric{
+			MetricId:      metricId,
+			ResourceCount: int32(len(byResource)),
+			Remediation:   remediations[metricId],
+		}
+
+		for _, resourceId := range slices.Sorted(maps.Keys(byResource)) {
+			fm.ResultCount += int32(len(byResource[resourceId]))
+			if len(fm.Resources) < maxFailingResources {
+				fm.Resources = append(fm.Resources, &evaluation.FailingResource{
+					ResourceId:          resourceId,
+					AssessmentResultIds: slices.Sorted(slices.Values(byResource[resourceId])),
+				})
+			}
+		}
+
+		failing = append(failing, fm)
+	}
+
+	slices.SortFunc(failing, func(a, b *evaluation.FailingMetric) int {
+		return strings.Compare(a.MetricId, b.MetricId)
+	})
+
+	return
+}
+
+// mergeFailingMetrics merges the failing metrics of the given (sub-control) evaluation results. Sub-controls that
+// share a metric are evaluated against the same assessment results, so the first breakdown of a metric is kept.
+func mergeFailingMetrics(results []*evaluation.EvaluationResult) (failing []*evaluation.FailingMetric) {
+	var (
+		seen = make(map[string]struct{})
+	)
+
+	for _, r := range results {
+		for _, fm := range r.GetFailingMetrics() {
+			if _, ok := seen[fm.GetMetricId()]; ok {
+				continue
+			}
+			seen[fm.GetMetricId()] = struct{}{}
+			failing = append(failing, fm)
+		}
+	}
+
+	slices.SortFunc(failing, func(a, b *evaluation.FailingMetric) int {
+		return strings.Compare(a.MetricId, b.MetricId)
+	})
+
+	return
+}
@@ -0,0 +1,204 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package evaluation
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"confirmate.io/core/api/assessment"
+	"confirmate.io/core/api/evaluation"
+	"confirmate.io/core/service/evaluation/evaluationtest"
+	"confirmate.io/core/util/assert"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func Test_newFailingMetrics(t *testing.T) {
+	var (
+		now     = time.Now()
+		many    []*assessment.AssessmentResult
+		sampled []*evaluation.FailingResource
+	)
+
+	for i := range maxFailingResources + 2 {
+		many = append(many, &assessment.AssessmentResult{
+			Id:         fmt.Sprintf("result-%d", i),
+			MetricId:   evaluationtest.MockMetricId1,
+			ResourceId: fmt.Sprintf("resource-%d", i),
+		})
+		if i < maxFailingResources {
+			sampled = append(sampled, &evaluation.FailingResource{
+				ResourceId:          fmt.Sprintf("resource-%d", i),
+				AssessmentResultIds: []string{fmt.Sprintf("result-%d", i)},
+			})
+		}
+	}
+
+	type args struct {
+		results []*assessment.AssessmentResult
+		metrics []*assessment.Metric
+	}
+	tests := []struct {
+		name string
+		args args
+		want assert.Want[[]*evaluation.FailingMetric]
+	}{
+		{
+			name: "no results",
+			args: args{},
+			want: assert.Empty[[]*evaluation.FailingMetric],
+		},
+		{
+			name: "compliant, waived and stale results are not failing",
+			args: args{
+				results: []*assessment.AssessmentResult{
+					{Id: "result-1", MetricId: evaluationtest.MockMetricId1, ResourceId: "resource-1", Compliant: true},
+					{
+						Id:         "result-2",
+						MetricId:   evaluationtest.MockMetricId1,
+						ResourceId: "resource-2",
+						Waiver: &assessment.Waiver{
+							Justification: "Accepted risk",
+							ExpiresAt:     timestamppb.New(now.Add(time.Hour)),
+						},
+					},
+					{
+						Id:         "result-3",
+						MetricId:   evaluationtest.MockMetricId1,
+						ResourceId: "resource-3",
+						StaleAt:    timestamppb.New(now.Add(-time.Hour)),
+					},
+				},
+			},
+			want: assert.Empty[[]*evaluation.FailingMetric],
+		},
+		{
+			name: "grouped by metric and resource",
+			args: args{
+				results: []*assessment.AssessmentResult{
+					{Id: "result-3", MetricId: evaluationtest.MockMetricId2, ResourceId: "resource-1"},
+					{Id: "result-2", MetricId: evaluationtest.MockMetricId1, ResourceId: "resource-2"},
+					{Id: "result-1", MetricId: evaluationtest.MockMetricId1, ResourceId: "resource-2"},
+					{Id: "result-4", MetricId: evaluationtest.MockMetricId1, ResourceId: "resource-1", Compliant: true},
+				},
+			},
+			want: func(t *testing.T, got []*evaluation.FailingMetric, msgAndArgs ...any) bool {
+				return assert.Equal(t, []*evaluation.FailingMetric{
+					{
+						MetricId:      evaluationtest.MockMetricId1,
+						ResultCount:   2,
+						ResourceCount: 1,
+						Resources: []*evaluation.FailingResource{
+							{ResourceId: "resource-2", AssessmentResultIds: []string{"result-1", "result-2"}},
+						},
+					},
+					{
+						MetricId:      evaluationtest.MockMetricId2,
+						ResultCount:   1,
+						ResourceCount: 1,
+						Resources: []*evaluation.FailingResource{
+							{ResourceId: "resource-1", AssessmentResultIds: []string{"result-3"}},
+						},
+					},
+				}, got)
+			},
+		},
+		{
+			name: "resources are sampled",
+			args: args{
+				results: many,
+			},
+			want: func(t *testing.T, got []*evaluation.FailingMetric, msgAndArgs ...any) bool {
+				return assert.Equal(t, []*evaluation.FailingMetric{
+					{
+						MetricId:      evaluationtest.MockMetricId1,
+						ResultCount:   int32(len(many)),
+						ResourceCount: int32(len(many)),
+						Resources:     sampled,
+					},
+				}, got)
+			},
+		},
+		{
+			name: "with remediation of the metric",
+			args: args{
+				results: []*assessment.AssessmentResult{
+					{Id: "result-1", MetricId: evaluationtest.MockMetricId1, ResourceId: "resource-1"},
+					{Id: "result-2", MetricId: evaluationtest.MockMetricId2, ResourceId: "resource-1"},
+				},
+				metrics: []*assessment.Metric{
+					{Id: evaluationtest.MockMetricId1, Remediation: &assessment.Remediation{Text: "Enable boot logging."}},
+					{Id: evaluationtest.MockMetricId2},
+				},
+			},
+			want: func(t *testing.T, got []*evaluation.FailingMetric, msgAndArgs ...any) bool {
+				return assert.Equal(t, 2, len(got)) &&
+					assert.Equal(t, &assessment.Remediation{Text: "Enable boot logging."}, got[0].GetRemediation()) &&
+					assert.Nil(t, got[1].GetRemediation())
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := newFailingMetrics(tt.args.results, tt.args.metrics, now)
+			tt.want(t, got)
+		})
+	}
+}
+
+func Test_mergeFailingMetrics(t *testing.T) {
+	var (
+		metric1 = &evaluation.FailingMetric{MetricId: evaluationtest.MockMetricId1, ResultCount: 1, ResourceCount: 1}
+		metric2 = &evaluation.FailingMetric{MetricId: evaluationtest.MockMetricId2, ResultCount: 2, ResourceCount: 2}
+	)
+
+	type args struct {
+		results []*evaluation.EvaluationResult
+	}
+	tests := []struct {
+		name string
+		args args
+		want assert.Want[[]*evaluation.FailingMetric]
+	}{
+		{
+			name: "no failing metrics",
+			args: args{
+				results: []*evaluation.EvaluationResult{{}, {}},
+			},
+			want: assert.Empty[[]*evaluation.FailingMetric],
+		},
+		{
+			name: "shared metrics are merged",
+			args: args{
+				results: []*evaluation.EvaluationResult{
+					{FailingMetrics: []*evaluation.FailingMetric{metric2}},
+					{FailingMetrics: []*evaluation.FailingMetric{metric1, metric2}},
+				},
+			},
+			want: func(t *testing.T, got []*evaluation.FailingMetric, msgAndArgs ...any) bool {
+				return assert.Equal(t, []*evaluation.FailingMetric{metric1, metric2}, got)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := mergeFailingMetrics(tt.args.results)
+			tt.want(t, got)
+		})
+	}
+}
@@ -0,0 +1,172 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package evaluation
+
+import (
+	"fmt"
+
+	"confirmate.io/core/api/evaluation"
+	"confirmate.io/core/api/orchestrator"
+)
+
+const (
+	// LocaleEnglish is the locale of English texts. It is also the default locale.
+	LocaleEnglish = "en"
+
+	// LocaleGerman is the locale of German texts.
+	LocaleGerman = "de"
+
+	// DefaultLocale is the locale that is used if neither the request nor the audit scope specify a locale.
+	DefaultLocale = LocaleEnglish
+)
+
+// message identifies a user-facing text that is generated by the evaluation service.
+type message int
+
+const (
+	msgEvaluationTimedOut message = iota
+	msgEvaluationFailed
+	msgNoMetrics
+	msgNoAssessmentResults
+	msgFreshnessViolation
+	msgCoverageSummary
+	msgCoverageStatusUnspecified
+	msgCoverageStatusNoMetrics
+	msgCoverageStatusNoResults
+	msgCoverageStatusPartial
+	msgCoverageStatusCovered
+	msgCalendarName
+	msgCalendarManualResultExpires
+	msgCalendarCertificateExpires
+	msgCalendarMaintenanceSuppress
+	msgCalendarMaintenanceFlag
+	msgPrecedenceAutomatic
+	msgPrecedenceManual
+	msgPrecedenceManualForever
+	msgPrecedencePartiallyManual
+)
+
+// messages contains the translations of all user-facing texts, keyed by their locale. Every locale needs to contain
+// all messages.
+var messages = map[string]map[message]string{
+	LocaleEnglish: {
+		msgEvaluationTimedOut:          "evaluation timed out",
+		msgEvaluationFailed:            "The evaluation of the control failed, see the error cause for details.",
+		msgNoMetrics:                   "No metrics are assigned to the control, it needs to be evaluated manually.",
+		msgNoAssessmentResults:         "No assessment results are available for the metrics of the control yet.",
+		msgFreshnessViolation:          "%d assessment results are based on evidence older than the maximum evidence age of %d days.",
+		msgCoverageSummary:             "%d of %d controls are fully covered by assessment results.",
+		msgCoverageStatusUnspecified:   "Unspecified",
+		msgCoverageStatusNoMetrics:     "No metrics",
+		msgCoverageStatusNoResults:     "No results",
+		msgCoverageStatusPartial:       "Partially covered",
+		msgCoverageStatusCovered:       "Covered",
+		msgCalendarName:                "Confirmate: %s",
+		msgCalendarManualResultExpires: "Manual evaluation result of control %s expires",
+		msgCalendarCertificateExpires:  "Certificate %s expires",
+		msgCalendarMaintenanceSuppress: "Maintenance window: evaluation suspended",
+		msgCalendarMaintenanceFlag:     "Maintenance window: evaluation results flagged",
+		msgPrecedenceAutomatic:         "The control is evaluated automatically based on the assessment results.",
+		msgPrecedenceManual:            "A manual evaluation result overrides the automatic evaluation until %s.",
+		msgPrecedenceManualForever:     "A manual evaluation result overrides the automatic evaluation indefinitely.",
+		msgPrecedencePartiallyManual:   "The control is evaluated automatically, but %d sub-controls are excluded because their manual evaluation results are used instead.",
+	},
+	LocaleGerman: {
+		msgEvaluationTimedOut:          "Zeitüberschreitung bei der Evaluierung",
+		msgEvaluationFailed:            "Die Evaluierung der Anforderung ist fehlgeschlagen, die Fehlerursache enthält weitere Details.",
+		msgNoMetrics:                   "Der Anforderung sind keine Metriken zugeordnet, sie muss manuell evaluiert werden.",
+		msgNoAssessmentResults:         "Für die Metriken der Anforderung liegen noch keine Bewertungsergebnisse vor.",
+		msgFreshnessViolation:          "%d Bewertungsergebnisse beruhen auf Nachweisen, die älter als das maximale Alter von %d Tagen sind.",
+		msgCoverageSummary:             "%d von %d Anforderungen sind vollständig durch Bewertungsergebnisse abgedeckt.",
+		msgCoverageStatusUnspecified:   "Nicht festgelegt",
+		msgCoverageStatusNoMetrics:     "Keine Metriken",
+		msgCoverageStatusNoResults:     "Keine Ergebnisse",
+		msgCoverageStatusPartial:       "Teilweise abgedeckt",
+		msgCoverageStatusCovered:       "Abgedeckt",
+		msgCalendarName:                "Confirmate: %s",
+		msgCalendarManualResultExpires: "Manuelles Evaluierungsergebnis der Anforderung %s läuft ab",
+		msgCalendarCertificateExpires:  "Zertifikat %s läuft ab",
+		msgCalendarMaintenanceSuppress: "Wartungsfenster: Evaluierung ausgesetzt",
+		msgCalendarMaintenanceFlag:     "Wartungsfenster: Evaluierungsergebnisse markiert",
+		msgPrecedenceAutomatic:         "Die Anforderung wird automatisch anhand der Bewertungsergebnisse evaluiert.",
+		msgPrecedenceManual:            "Ein manuelles Evaluierungsergebnis hat bis %s Vorrang vor der automatischen Evaluierung.",
+		msgPrecedenceManualForever:     "Ein manuelles Evaluierungsergebnis hat unbefristet Vorrang vor der automatischen Evaluierung.",
+		msgPrecedencePartiallyManual:   "Die Anforderung wird automatisch evaluiert, aber %d Unteranforderungen sind ausgenommen, da stattdessen ihre manuellen Evaluierungsergebnisse verwendet werden.",
+	},
+}
+
+// coverageStatusMessages maps a coverage status to the message of its label.
+var coverageStatusMessages = map[evaluation.CoverageStatus]message{
+	evaluation.CoverageStatus_COVERAGE_STATUS_UNSPECIFIED: msgCoverageStatusUnspecified,
+	evaluation.CoverageStatus_COVERAGE_STATUS_NO_METRICS:  msgCoverageStatusNoMetrics,
+	evaluation.CoverageStatus_COVERAGE_STATUS_NO_RESULTS:  msgCoverageStatusNoResults,
+	evaluation.CoverageStatus_COVERAGE_STATUS_PARTIAL:     msgCoverageStatusPartial,
+	evaluation.CoverageStatus_COVERAGE_STATUS_COVERED:     msgCoverageStatusCovered,
+}
+
+// resolveLocale returns the locale of the texts generated for the audit scope. A requested locale takes precedence
+// over the locale of the audit scope. Unknown locales fall back to [DefaultLocale].
+func resolveLocale(requested *string, auditScope *orchestrator.AuditScope) (locale string) {
+	switch {
+	case requested != nil:
+		locale = *requested
+	case auditScope.GetLocale() != "":
+		locale = auditScope.GetLocale()
+	default:
+		locale = DefaultLocale
+	}
+
+	if _, ok := messages[locale]; !ok {
+		locale = DefaultLocale
+	}
+
+	return
+}
+
+// translate returns the text of the message in the given locale, formatted with the optional arguments. Unknown
+// locales fall back to [DefaultLocale].
+func translate(locale string, msg message, args ...any) string {
+	var (
+		texts map[message]string
+		ok    bool
+	)
+
+	texts, ok = messages[locale]
+	if !ok {
+		texts = messages[DefaultLocale]
+	}
+
+	if len(args) == 0 {
+		return texts[msg]
+	}
+
+	return fmt.Sprintf(texts[msg], args...)
+}
+
+// coverageStatusLabel returns the label of the coverage status in the given locale.
+func coverageStatusLabel(locale string, status evaluation.CoverageStatus) string {
+	var (
+		msg message
+		ok  bool
+	)
+
+	msg, ok = coverageStatusMessages[status]
+	if !ok {
+		return status.String()
+	}
+
+	return translate(locale, msg)
+}
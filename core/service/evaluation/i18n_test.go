@@ -0,0 +1,140 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package evaluation
+
+import (
+	"testing"
+
+	"confirmate.io/core/api/evaluation"
+	"confirmate.io/core/api/orchestrator"
+	"confirmate.io/core/util/assert"
+)
+
+func Test_messages(t *testing.T) {
+	// Every locale needs to contain the same messages as the default locale
+	for locale, texts := range messages {
+		assert.Equal(t, len(messages[DefaultLocale]), len(texts))
+		for msg := range messages[DefaultLocale] {
+			assert.NotEmpty(t, texts[msg], "locale %s, message %d", locale, msg)
+		}
+	}
+}
+
+func Test_resolveLocale(t *testing.T) {
+	type args struct {
+		requested  *string
+		auditScope *orchestrator.AuditScope
+	}
+	tests := []struct {
+		name string
+		args args
+		want string
+	}{
+		{
+			name: "default locale",
+			args: args{
+				auditScope: &orchestrator.AuditScope{},
+			},
+			want: DefaultLocale,
+		},
+		{
+			name: "locale of the audit scope",
+			args: args{
+				auditScope: &orchestrator.AuditScope{Locale: new(LocaleGerman)},
+			},
+			want: LocaleGerman,
+		},
+		{
+			name: "requested locale takes precedence",
+			args: args{
+				requested:  new(LocaleEnglish),
+				auditScope: &orchestrator.AuditScope{Locale: new(LocaleGerman)},
+			},
+			want: LocaleEnglish,
+		},
+		{
+			name: "unknown locale",
+			args: args{
+				requested: new("fr"),
+			},
+			want: DefaultLocale,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := resolveLocale(tt.args.requested, tt.args.auditScope)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_translate(t *testing.T) {
+	type args struct {
+		locale string
+		msg    message
+		args   []any
+	}
+	tests := []struct {
+		name string
+		args args
+		want string
+	}{
+		{
+			name: "english",
+			args: args{
+				locale: LocaleEnglish,
+				msg:    msgEvaluationTimedOut,
+			},
+			want: "evaluation timed out",
+		},
+		{
+			name: "german",
+			args: args{
+				locale: LocaleGerman,
+				msg:    msgEvaluationTimedOut,
+			},
+			want: "Zeitüberschreitung bei der Evaluierung",
+		},
+		{
+			name: "with arguments",
+			args: args{
+				locale: LocaleGerman,
+				msg:    msgCoverageSummary,
+				args:   []any{2, 5},
+			},
+			want: "2 von 5 Anforderungen sind vollständig durch Bewertungsergebnisse abgedeckt.",
+		},
+		{
+			name: "unknown locale falls back to default",
+			args: args{
+				locale: "fr",
+				msg:    msgCoverageStatusCovered,
+			},
+			want: "Covered",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := translate(tt.args.locale, tt.args.msg, tt.args.args...)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_coverageStatusLabel(t *testing.T) {
+	assert.Equal(t, "Keine Metriken", coverageStatusLabel(LocaleGerman, evaluation.CoverageStatus_COVERAGE_STATUS_NO_METRICS))
+	assert.Equal(t, "-1", coverageStatusLabel(LocaleEnglish, evaluation.CoverageStatus(-1)))
+}
@@ -0,0 +1,68 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package evaluation
+
+import (
+	"context"
+	"hash/fnv"
+	"math/rand/v2"
+	"slices"
+	"time"
+)
+
+// scopeOffset returns the delay of the first run of the audit scope within the stagger window, which is bound by the
+// interval of the audit scope. The delay is derived from the audit scope ID, so that the runs of the audit scopes that
+// share an evaluation service are spread over the interval instead of being synchronized, also across restarts.
+func scopeOffset(auditScopeId string, stagger time.Duration, interval time.Duration) time.Duration {
+	var h = fnv.New64a()
+
+	stagger = min(stagger, interval)
+	if stagger <= 0 {
+		return 0
+	}
+
+	_, _ = h.Write([]byte(auditScopeId))
+
+	return time.Duration(h.Sum64() % uint64(stagger))
+}
+
+// controlDelays returns n random delays smaller than jitter in ascending order, after which the evaluations of the
+// controls of a run are started relative to the start of the run. It returns nil if jitter is disabled.
+func controlDelays(n int, jitter time.Duration) (delays []time.Duration) {
+	if jitter <= 0 {
+		return nil
+	}
+
+	delays = make([]time.Duration, n)
+	for i := range delays {
+		delays[i] = rand.N(jitter)
+	}
+	slices.Sort(delays)
+
+	return delays
+}
+
+// waitUntil waits until t or until ctx is done, whichever happens first.
+func waitUntil(ctx context.Context, t time.Time) {
+	var timer = time.NewTimer(time.Until(t))
+
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+	case <-timer.C:
+	}
+}
@@ -0,0 +1,114 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package evaluation
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"confirmate.io/core/util/assert"
+)
+
+func Test_scopeOffset(t *testing.T) {
+	type args struct {
+		auditScopeId string
+		stagger      time.Duration
+		interval     time.Duration
+	}
+	tests := []struct {
+		name string
+		args args
+		want assert.Want[time.Duration]
+	}{
+		{
+			name: "stagger disabled",
+			args: args{auditScopeId: "scope-1", interval: 5 * time.Minute},
+			want: func(t *testing.T, got time.Duration, msgAndArgs ...any) bool {
+				return assert.Equal(t, time.Duration(0), got)
+			},
+		},
+		{
+			name: "within stagger",
+			args: args{auditScopeId: "scope-1", stagger: time.Minute, interval: 5 * time.Minute},
+			want: func(t *testing.T, got time.Duration, msgAndArgs ...any) bool {
+				return assert.True(t, got >= 0 && got < time.Minute) &&
+					assert.Equal(t, scopeOffset("scope-1", time.Minute, 5*time.Minute), got)
+			},
+		},
+		{
+			name: "bounded by interval",
+			args: args{auditScopeId: "scope-1", stagger: time.Hour, interval: 5 * time.Minute},
+			want: func(t *testing.T, got time.Duration, msgAndArgs ...any) bool {
+				return assert.True(t, got >= 0 && got < 5*time.Minute)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.want(t, scopeOffset(tt.args.auditScopeId, tt.args.stagger, tt.args.interval))
+		})
+	}
+}
+
+func Test_controlDelays(t *testing.T) {
+	type args struct {
+		n      int
+		jitter time.Duration
+	}
+	tests := []struct {
+		name string
+		args args
+		want assert.Want[[]time.Duration]
+	}{
+		{
+			name: "jitter disabled",
+			args: args{n: 3},
+			want: assert.Nil[[]time.Duration],
+		},
+		{
+			name: "happy path",
+			args: args{n: 10, jitter: time.Second},
+			want: func(t *testing.T, got []time.Duration, msgAndArgs ...any) bool {
+				return assert.Equal(t, 10, len(got)) &&
+					assert.True(t, slices.IsSorted(got)) &&
+					assert.True(t, got[0] >= 0 && got[9] < time.Second)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.want(t, controlDelays(tt.args.n, tt.args.jitter))
+		})
+	}
+}
+
+func Test_waitUntil(t *testing.T) {
+	var (
+		ctx, cancel = context.WithCancel(context.Background())
+		start       = time.Now()
+	)
+
+	waitUntil(context.Background(), start.Add(10*time.Millisecond))
+	assert.True(t, time.Since(start) >= 10*time.Millisecond)
+
+	// A cancelled context does not wait
+	cancel()
+	start = time.Now()
+	waitUntil(ctx, start.Add(time.Hour))
+	assert.True(t, time.Since(start) < time.Minute)
+}
@@ -0,0 +1,129 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package evaluation
+
+import (
+	"context"
+	"slices"
+	"sync"
+)
+
+// fairLimiter bounds the number of concurrent operations, e.g., queries to the orchestrator. Waiting operations are
+// grouped by a key, e.g., the audit scope ID, and free slots are handed out to the keys in a round-robin fashion. This
+// ensures that an audit scope with a large catalog cannot starve other audit scopes that share the same scheduler.
+//
+// A nil *fairLimiter does not limit anything.
+type fairLimiter struct {
+	mu sync.Mutex
+
+	// free is the number of slots that are currently available.
+	free int
+
+	// waiting contains the channels of the waiting operations, keyed by their key.
+	waiting map[string][]chan struct{}
+
+	// order contains the keys with waiting operations in the order in which they receive the next free slot.
+	order []string
+}
+
+// newFairLimiter creates a new [fairLimiter] that allows up to n concurrent operations. If n is smaller than 1, nil
+// is returned, which does not limit anything.
+func newFairLimiter(n int) *fairLimiter {
+	if n < 1 {
+		return nil
+	}
+
+	return &fairLimiter{
+		free:    n,
+		waiting: make(map[string][]chan struct{}),
+	}
+}
+
+// acquire blocks until a slot for an operation of the given key is available or the context is done. A successfully
+// acquired slot must be returned with [fairLimiter.release].
+func (l *fairLimiter) acquire(ctx context.Context, key string) error {
+	if l == nil {
+		return nil
+	}
+
+	l.mu.Lock()
+	if l.free > 0 && len(l.order) == 0 {
+		l.free--
+		l.mu.Unlock()
+		return nil
+	}
+
+	ch := make(chan struct{})
+	if len(l.waiting[key]) == 0 {
+		l.order = append(l.order, key)
+	}
+	l.waiting[key] = append(l.waiting[key], ch)
+	l.mu.Unlock()
+
+	select {
+	case <-ch:
+		return nil
+	case <-ctx.Done():
+	}
+
+	l.mu.Lock()
+	select {
+	case <-ch:
+		// The slot was handed to us in the meantime, so we need to pass it on
+		l.mu.Unlock()
+		l.release()
+	default:
+		l.dequeue(key, ch)
+		l.mu.Unlock()
+	}
+
+	return ctx.Err()
+}
+
+// release returns a slot that was acquired with [fairLimiter.acquire]. The slot is handed to the next waiting key.
+func (l *fairLimiter) release() {
+	if l == nil {
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if len(l.order) == 0 {
+		l.free++
+		return
+	}
+
+	key := l.order[0]
+	ch := l.waiting[key][0]
+	l.dequeue(key, ch)
+
+	// Move the key to the end of the line, if it has further waiting operations
+	if len(l.waiting[key]) > 0 {
+		l.order = append(slices.DeleteFunc(l.order, func(k string) bool { return k == key }), key)
+	}
+
+	close(ch)
+}
+
+// dequeue removes the waiting channel ch of the given key. It must be called while holding the lock.
+func (l *fairLimiter) dequeue(key string, ch chan struct{}) {
+	l.waiting[key] = slices.DeleteFunc(l.waiting[key], func(c chan struct{}) bool { return c == ch })
+	if len(l.waiting[key]) == 0 {
+		delete(l.waiting, key)
+		l.order = slices.DeleteFunc(l.order, func(k string) bool { return k == key })
+	}
+}
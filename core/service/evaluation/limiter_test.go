@@ -0,0 +1,131 @@
+// Copyright 2016-2025 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package evaluation
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"confirmate.io/core/util/assert"
+)
+
+func Test_newFairLimiter(t *testing.T) {
+	tests := []struct {
+		name string
+		n    int
+		want assert.Want[*fairLimiter]
+	}{
+		{
+			name: "no limit",
+			n:    0,
+			want: assert.Nil[*fairLimiter],
+		},
+		{
+			name: "limit",
+			n:    2,
+			want: func(t *testing.T, got *fairLimiter, msgAndArgs ...any) bool {
+				return assert.Equal(t, 2, got.free)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.want(t, newFairLimiter(tt.n))
+		})
+	}
+}
+
+func Test_fairLimiter_acquire(t *testing.T) {
+	t.Run("nil limiter", func(t *testing.T) {
+		var l *fairLimiter
+
+		assert.NoError(t, l.acquire(context.Background(), "scope"))
+		l.release()
+	})
+
+	t.Run("round-robin across keys", func(t *testing.T) {
+		var (
+			l     = newFairLimiter(1)
+			mu    sync.Mutex
+			order []string
+			wg    sync.WaitGroup
+		)
+
+		// Occupy the only slot, so that all further operations need to wait
+		assert.NoError(t, l.acquire(context.Background(), "a"))
+
+		// Queue three operations of "a" before a single one of "b"
+		for i, key := range []string{"a", "a", "a", "b"} {
+			wg.Go(func() {
+				assert.NoError(t, l.acquire(context.Background(), key))
+
+				mu.Lock()
+				order = append(order, key)
+				mu.Unlock()
+
+				l.release()
+			})
+
+			waitForWaiting(t, l, i+1)
+		}
+
+		l.release()
+		wg.Wait()
+
+		assert.Equal(t, []string{"a", "b", "a", "a"}, order)
+		assert.Equal(t, 1, l.free)
+	})
+
+	t.Run("context done", func(t *testing.T) {
+		var (
+			l           = newFairLimiter(1)
+			ctx, cancel = context.WithTimeout(context.Background(), 10*time.Millisecond)
+		)
+		defer cancel()
+
+		assert.NoError(t, l.acquire(context.Background(), "a"))
+		assert.ErrorIs(t, l.acquire(ctx, "b"), context.DeadlineExceeded)
+
+		// The waiting operation must not be left behind
+		l.release()
+		assert.Equal(t, 1, l.free)
+		assert.Empty(t, l.order)
+	})
+}
+
+// waitForWaiting waits until the given number of operations are waiting for a slot of the limiter.
+func waitForWaiting(t *testing.T, l *fairLimiter, n int) {
+	t.Helper()
+
+	for range 1000 {
+		l.mu.Lock()
+		var waiting int
+		for _, w := range l.waiting {
+			waiting += len(w)
+		}
+		l.mu.Unlock()
+
+		if waiting == n {
+			return
+		}
+
+		time.Sleep(time.Millisecond)
+	}
+
+	t.Fatalf("expected %d waiting operations", n)
+}
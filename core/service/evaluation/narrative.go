@@ -0,0 +1,249 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package evaluation
+
+import (
+	"fmt"
+	"log/slog"
+	"maps"
+	"reflect"
+	"slices"
+	"strings"
+	"text/template"
+
+	"confirmate.io/core/api/assessment"
+	"confirmate.io/core/api/evaluation"
+	"confirmate.io/core/api/orchestrator"
+	"confirmate.io/core/log"
+	"confirmate.io/core/util/entity"
+)
+
+// defaultNarrativeTemplates contains the default narrative templates, keyed by their locale. Every locale of
+// [messages] needs to contain a template.
+var defaultNarrativeTemplates = map[string]string{
+	LocaleEnglish: `
+{{- if .Parent -}}
+	{{- if not .SubResults -}}
+		No sub-control of control {{.Control.Id}} is relevant for the audit scope.
+	{{- else -}}
+		{{.CompliantSubControls}} of {{plural (len .SubResults) "sub-control" "sub-controls"}} of control {{.Control.Id}} are compliant.
+	{{- end -}}
+{{- else if .Result.GetErrorCause -}}
+	The evaluation of control {{.Control.Id}} failed: {{.Result.GetErrorCause}}.
+{{- else if not .MetricIds -}}
+	No metrics are assigned to control {{.Control.Id}}, it needs to be evaluated manually.
+{{- else if not .ResultCount -}}
+	{{plural (len .MetricIds) "metric was" "metrics were"}} checked ({{join .MetricIds ", "}}), but no assessment results are available yet.
+{{- else -}}
+	{{plural (len .MetricIds) "metric was" "metrics were"}} checked ({{join .MetricIds ", "}}) on {{plural .ResourceCount "resource" "resources"}}.
+	{{- with .StaleCount}} {{plural . "assessment result is" "assessment results are"}} based on outdated evidence.{{end -}}
+{{- end -}}
+{{- range .Result.FailingMetrics}} Metric {{.MetricId}} failed for {{plural .ResourceCount "resource" "resources"}}, including {{join (resourceIds .Resources) ", "}}.{{end -}}
+`,
+	LocaleGerman: `
+{{- if .Parent -}}
+	{{- if not .SubResults -}}
+		Keine Unteranforderung der Anforderung {{.Control.Id}} ist für den Prüfumfang relevant.
+	{{- else -}}
+		{{.CompliantSubControls}} von {{plural (len .SubResults) "Unteranforderung" "Unteranforderungen"}} der Anforderung {{.Control.Id}} sind konform.
+	{{- end -}}
+{{- else if .Result.GetErrorCause -}}
+	Die Evaluierung der Anforderung {{.Control.Id}} ist fehlgeschlagen: {{.Result.GetErrorCause}}.
+{{- else if not .MetricIds -}}
+	Der Anforderung {{.Control.Id}} sind keine Metriken zugeordnet, sie muss manuell evaluiert werden.
+{{- else if not .ResultCount -}}
+	{{plural (len .MetricIds) "Metrik wurde" "Metriken wurden"}} geprüft ({{join .MetricIds ", "}}), es liegen aber noch keine Bewertungsergebnisse vor.
+{{- else -}}
+	{{plural (len .MetricIds) "Metrik wurde" "Metriken wurden"}} auf {{plural .ResourceCount "Ressource" "Ressourcen"}} geprüft ({{join .MetricIds ", "}}).
+	{{- with .StaleCount}} {{plural . "Bewertungsergebnis beruht" "Bewertungsergebnisse beruhen"}} auf veralteten Nachweisen.{{end -}}
+{{- end -}}
+{{- range .Result.FailingMetrics}} Metrik {{.MetricId}} ist für {{plural .ResourceCount "Ressource" "Ressourcen"}} nicht erfüllt, darunter {{join (resourceIds .Resources) ", "}}.{{end -}}
+`,
+}
+
+// narrativeFuncs contains the functions that are available to narrative templates in addition to the built-in ones.
+var narrativeFuncs = template.FuncMap{
+	"join":        strings.Join,
+	"plural":      plural,
+	"resourceIds": resourceIds,
+}
+
+// defaultNarratives contains the parsed [defaultNarrativeTemplates].
+var defaultNarratives = func() map[string]*template.Template {
+	narratives, err := parseNarrativeTemplates(defaultNarrativeTemplates)
+	if err != nil {
+		panic(err)
+	}
+
+	return narratives
+}()
+
+// NarrativeData is the evaluation context that is available to narrative templates. A narrative template is a Go
+// [text/template] that renders the human-readable narrative of an evaluation result. In addition to the built-in
+// functions, templates can use "join" ([strings.Join]), "plural" (e.g., {{plural 2 "resource" "resources"}} renders
+// "2 resources") and "resourceIds" (the IDs of a list of failing resources).
+type NarrativeData struct {
+	// Result is the evaluation result that is explained. Its failing metrics are only set if it is not compliant.
+	Result *evaluation.EvaluationResult
+
+	// AuditScope is the audit scope that is evaluated.
+	AuditScope *orchestrator.AuditScope
+
+	// Catalog is the catalog of the evaluated control.
+	Catalog *orchestrator.Catalog
+
+	// Control is the evaluated control.
+	Control *orchestrator.Control
+
+	// Parent is true if the result of a parent control is explained, which is aggregated from the results of its
+	// sub-controls.
+	Parent bool
+
+	// SubResults contains the results of the relevant sub-controls of a parent control.
+	SubResults []*evaluation.EvaluationResult
+
+	// Metrics contains the metrics that were checked to evaluate the control.
+	Metrics []*assessment.Metric
+
+	// ResourceCount is the number of distinct resources of the assessment results of a sub-control.
+	ResourceCount int
+
+	// StaleCount is the number of assessment results of a sub-control that are based on outdated evidence.
+	StaleCount int
+
+	// Locale is the locale of the narrative.
+	Locale string
+}
+
+// MetricIds returns the sorted and distinct IDs of the checked metrics.
+func (d *NarrativeData) MetricIds() (ids []string) {
+	ids = entity.Strings(getMetricIds(d.Metrics))
+	slices.Sort(ids)
+
+	return slices.Compact(ids)
+}
+
+// ResultCount returns the number of assessment results the result is based on.
+func (d *NarrativeData) ResultCount() int {
+	return len(d.Result.GetAssessmentResultIds())
+}
+
+// CompliantSubControls returns the number of (automatically or manually) compliant sub-controls of a parent control.
+func (d *NarrativeData) CompliantSubControls() (n int) {
+	for _, r := range d.SubResults {
+		if r.GetStatus() == evaluation.EvaluationStatus_EVALUATION_STATUS_COMPLIANT ||
+			r.GetStatus() == evaluation.EvaluationStatus_EVALUATION_STATUS_COMPLIANT_MANUALLY {
+			n++
+		}
+	}
+
+	return
+}
+
+// parseNarrativeTemplates parses the given narrative templates, keyed by their locale.
+func parseNarrativeTemplates(texts map[string]string) (narratives map[string]*template.Template, err error) {
+	var (
+		tmpl *template.Template
+	)
+
+	narratives = make(map[string]*template.Template, len(texts))
+	for _, locale := range slices.Sorted(maps.Keys(texts)) {
+		tmpl, err = template.New(locale).Funcs(narrativeFuncs).Option("missingkey=error").Parse(texts[locale])
+		if err != nil {
+			return nil, fmt.Errorf("could not parse narrative template of locale %q: %w", locale, err)
+		}
+
+		narratives[locale] = tmpl
+	}
+
+	return
+}
+
+// narrative renders the narrative of the evaluation result described by data. Since the narrative is only an
+// addition to the result, a template that cannot be rendered is logged and no narrative is returned.
+func (svc *Service) narrative(data *NarrativeData) *string {
+	narrative, err := svc.renderNarrative(data)
+	if err != nil {
+		slog.Warn("Could not render the narrative of the evaluation result",
+			slog.String("control id", data.Control.GetId()),
+			slog.String("locale", data.Locale),
+			log.Err(err))
+		return nil
+	}
+
+	return &narrative
+}
+
+// renderNarrative renders the narrative of the evaluation result described by data. A custom template of the locale
+// takes precedence over the default one. Unknown locales fall back to [DefaultLocale].
+func (svc *Service) renderNarrative(data *NarrativeData) (narrative string, err error) {
+	var (
+		tmpl *template.Template
+		ok   bool
+		sb   strings.Builder
+	)
+
+	tmpl, ok = svc.narratives[data.Locale]
+	if !ok {
+		tmpl, ok = defaultNarratives[data.Locale]
+	}
+	if !ok {
+		tmpl = defaultNarratives[DefaultLocale]
+	}
+
+	err = tmpl.Execute(&sb, data)
+	if err != nil {
+		return "", fmt.Errorf("could not render narrative: %w", err)
+	}
+
+	return strings.TrimSpace(sb.String()), nil
+}
+
+// plural formats the count n together with the singular or plural form of a noun, e.g., "1 resource" or
+// "2 resources". It accepts any integer type, so that it can be used with the counts of proto messages.
+func plural(n any, singular string, plural string) string {
+	var (
+		count = reflect.ValueOf(n).Int()
+	)
+
+	if count == 1 {
+		return fmt.Sprintf("%d %s", count, singular)
+	}
+
+	return fmt.Sprintf("%d %s", count, plural)
+}
+
+// resourceIds returns the IDs of the given failing resources.
+func resourceIds(resources []*evaluation.FailingResource) (ids []string) {
+	for _, r := range resources {
+		ids = append(ids, r.GetResourceId())
+	}
+
+	return
+}
+
+// countResources returns the number of distinct resources of the given assessment results.
+func countResources(results []*assessment.AssessmentResult) int {
+	var (
+		resources = make(map[string]struct{})
+	)
+
+	for _, r := range results {
+		resources[r.GetResourceId()] = struct{}{}
+	}
+
+	return len(resources)
+}
@@ -0,0 +1,270 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package evaluation
+
+import (
+	"testing"
+	"text/template"
+
+	"confirmate.io/core/api/assessment"
+	"confirmate.io/core/api/evaluation"
+	"confirmate.io/core/api/orchestrator"
+	"confirmate.io/core/util/assert"
+)
+
+func Test_defaultNarrativeTemplates(t *testing.T) {
+	// Every locale needs to contain a default narrative template
+	for locale := range messages {
+		assert.NotNil(t, defaultNarratives[locale], "locale %s", locale)
+	}
+}
+
+func Test_parseNarrativeTemplates(t *testing.T) {
+	type args struct {
+		texts map[string]string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    assert.Want[map[string]*template.Template]
+		wantErr assert.WantErr
+	}{
+		{
+			name: "no templates",
+			args: args{},
+			want: func(t *testing.T, got map[string]*template.Template, msgAndArgs ...any) bool {
+				return assert.NotNil(t, got) && assert.Empty(t, got)
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "custom template",
+			args: args{
+				texts: map[string]string{LocaleGerman: "{{.Control.Id}}"},
+			},
+			want: func(t *testing.T, got map[string]*template.Template, msgAndArgs ...any) bool {
+				return assert.Equal(t, 1, len(got)) && assert.NotNil(t, got[LocaleGerman])
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "invalid template",
+			args: args{
+				texts: map[string]string{LocaleGerman: "{{plural}"},
+			},
+			want: assert.Nil[map[string]*template.Template],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.ErrorContains(t, err, `could not parse narrative template of locale "de"`)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotErr := parseNarrativeTemplates(tt.args.texts)
+
+			tt.want(t, got)
+			tt.wantErr(t, gotErr)
+		})
+	}
+}
+
+func TestService_renderNarrative(t *testing.T) {
+	var (
+		metrics = []*assessment.Metric{{Id: "metric-2"}, {Id: "metric-1"}, {Id: "metric-2"}}
+		failing = &evaluation.EvaluationResult{
+			Status:              evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT,
+			AssessmentResultIds: []string{"result-1", "result-2", "result-3"},
+			FailingMetrics: []*evaluation.FailingMetric{
+				{
+					MetricId:      "metric-1",
+					ResultCount:   2,
+					ResourceCount: 2,
+					Resources: []*evaluation.FailingResource{
+						{ResourceId: "resource-1", AssessmentResultIds: []string{"result-1"}},
+						{ResourceId: "resource-2", AssessmentResultIds: []string{"result-2"}},
+					},
+				},
+			},
+		}
+		control = &orchestrator.Control{Id: "OPS-13"}
+	)
+
+	type fields struct {
+		narratives map[string]*template.Template
+	}
+	type args struct {
+		data *NarrativeData
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    string
+		wantErr assert.WantErr
+	}{
+		{
+			name: "sub-control without metrics",
+			args: args{
+				data: &NarrativeData{
+					Result:  &evaluation.EvaluationResult{},
+					Control: control,
+					Locale:  LocaleEnglish,
+				},
+			},
+			want:    "No metrics are assigned to control OPS-13, it needs to be evaluated manually.",
+			wantErr: assert.NoError,
+		},
+		{
+			name: "sub-control without assessment results",
+			args: args{
+				data: &NarrativeData{
+					Result:  &evaluation.EvaluationResult{},
+					Control: control,
+					Metrics: metrics[:1],
+					Locale:  LocaleEnglish,
+				},
+			},
+			want:    "1 metric was checked (metric-2), but no assessment results are available yet.",
+			wantErr: assert.NoError,
+		},
+		{
+			name: "non-compliant sub-control",
+			args: args{
+				data: &NarrativeData{
+					Result:        failing,
+					Control:       control,
+					Metrics:       metrics,
+					ResourceCount: 3,
+					Locale:        LocaleEnglish,
+				},
+			},
+			want:    "2 metrics were checked (metric-1, metric-2) on 3 resources. Metric metric-1 failed for 2 resources, including resource-1, resource-2.",
+			wantErr: assert.NoError,
+		},
+		{
+			name: "non-compliant sub-control in german",
+			args: args{
+				data: &NarrativeData{
+					Result:        failing,
+					Control:       control,
+					Metrics:       metrics,
+					ResourceCount: 1,
+					Locale:        LocaleGerman,
+				},
+			},
+			want:    "2 Metriken wurden auf 1 Ressource geprüft (metric-1, metric-2). Metrik metric-1 ist für 2 Ressourcen nicht erfüllt, darunter resource-1, resource-2.",
+			wantErr: assert.NoError,
+		},
+		{
+			name: "parent control",
+			args: args{
+				data: &NarrativeData{
+					Result:  failing,
+					Control: control,
+					Parent:  true,
+					SubResults: []*evaluation.EvaluationResult{
+						{Status: evaluation.EvaluationStatus_EVALUATION_STATUS_COMPLIANT_MANUALLY},
+						failing,
+					},
+					Metrics: metrics,
+					Locale:  LocaleEnglish,
+				},
+			},
+			want:    "1 of 2 sub-controls of control OPS-13 are compliant. Metric metric-1 failed for 2 resources, including resource-1, resource-2.",
+			wantErr: assert.NoError,
+		},
+		{
+			name: "parent control without relevant sub-controls in german",
+			args: args{
+				data: &NarrativeData{
+					Result:  &evaluation.EvaluationResult{},
+					Control: control,
+					Parent:  true,
+					Locale:  LocaleGerman,
+				},
+			},
+			want:    "Keine Unteranforderung der Anforderung OPS-13 ist für den Prüfumfang relevant.",
+			wantErr: assert.NoError,
+		},
+		{
+			name: "unknown locale falls back to default",
+			args: args{
+				data: &NarrativeData{
+					Result:  &evaluation.EvaluationResult{},
+					Control: control,
+					Locale:  "fr",
+				},
+			},
+			want:    "No metrics are assigned to control OPS-13, it needs to be evaluated manually.",
+			wantErr: assert.NoError,
+		},
+		{
+			name: "custom template",
+			fields: fields{
+				narratives: map[string]*template.Template{
+					LocaleEnglish: template.Must(template.New(LocaleEnglish).Funcs(narrativeFuncs).Parse(`{{.Control.Id}} of {{.Catalog.Name}}: {{plural .ResultCount "result" "results"}}`)),
+				},
+			},
+			args: args{
+				data: &NarrativeData{
+					Result:  failing,
+					Catalog: &orchestrator.Catalog{Name: "EUCS"},
+					Control: control,
+					Locale:  LocaleEnglish,
+				},
+			},
+			want:    "OPS-13 of EUCS: 3 results",
+			wantErr: assert.NoError,
+		},
+		{
+			name: "custom template fails",
+			fields: fields{
+				narratives: map[string]*template.Template{
+					LocaleEnglish: template.Must(template.New(LocaleEnglish).Funcs(narrativeFuncs).Parse(`{{plural .Control "result" "results"}}`)),
+				},
+			},
+			args: args{
+				data: &NarrativeData{
+					Result:  failing,
+					Control: control,
+					Locale:  LocaleEnglish,
+				},
+			},
+			want: "",
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.ErrorContains(t, err, "could not render narrative")
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &Service{
+				narratives: tt.fields.narratives,
+			}
+
+			got, gotErr := svc.renderNarrative(tt.args.data)
+
+			assert.Equal(t, tt.want, got)
+			tt.wantErr(t, gotErr)
+		})
+	}
+}
+
+func Test_plural(t *testing.T) {
+	assert.Equal(t, "1 resource", plural(int32(1), "resource", "resources"))
+	assert.Equal(t, "0 resources", plural(0, "resource", "resources"))
+	assert.Equal(t, "2 Ressourcen", plural(int64(2), "Ressource", "Ressourcen"))
+}
@@ -0,0 +1,170 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package evaluation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"confirmate.io/core/api/evaluation"
+	"confirmate.io/core/api/orchestrator"
+	"confirmate.io/core/log"
+	"confirmate.io/core/service"
+
+	"connectrpc.com/connect"
+	"github.com/go-co-op/gocron"
+)
+
+// pausedJobs holds the audit scopes whose evaluation jobs are paused, see [Service.PauseEvaluation]. The jobs of
+// paused audit scopes stay in the scheduler, so that they retain their schedule and configuration, but their runs are
+// skipped.
+type pausedJobs struct {
+	mu sync.RWMutex
+
+	// since contains the time each audit scope was paused, keyed by the audit scope ID.
+	since map[string]time.Time
+}
+
+// pause marks the audit scope as paused. It returns false, if the audit scope is already paused.
+func (p *pausedJobs) pause(auditScopeId string, now time.Time) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if _, ok := p.since[auditScopeId]; ok {
+		return false
+	}
+
+	if p.since == nil {
+		p.since = make(map[string]time.Time)
+	}
+	p.since[auditScopeId] = now
+
+	return true
+}
+
+// resume removes the pause of the audit scope. It returns false, if the audit scope is not paused.
+func (p *pausedJobs) resume(auditScopeId string) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if _, ok := p.since[auditScopeId]; !ok {
+		return false
+	}
+	delete(p.since, auditScopeId)
+
+	return true
+}
+
+// pausedAt returns the time the audit scope was paused and whether it is paused at all.
+func (p *pausedJobs) pausedAt(auditScopeId string) (t time.Time, ok bool) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	t, ok = p.since[auditScopeId]
+	return
+}
+
+// PauseEvaluation is a method implementation of the evaluation interface: It pauses the evaluation of an audit scope.
+// In contrast to [Service.StopEvaluation], the job is kept in the scheduler with its interval, timeouts and catalogs;
+// only its runs are skipped until the evaluation is resumed with [Service.ResumeEvaluation].
+func (svc *Service) PauseEvaluation(ctx context.Context, req *connect.Request[evaluation.PauseEvaluationRequest]) (res *connect.Response[evaluation.PauseEvaluationResponse], err error) {
+	// Validate the request
+	if err = service.Validate(req); err != nil {
+		return nil, err
+	}
+
+	auditScopeId := req.Msg.GetAuditScopeId()
+
+	err = svc.checkJobAccess(ctx, auditScopeId)
+	if err != nil {
+		return nil, err
+	}
+
+	if !svc.paused.pause(auditScopeId, time.Now()) {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("job for audit scope '%s' is already paused", auditScopeId))
+	}
+
+	slog.Info("Paused evaluation of audit scope", slog.String("audit scope", auditScopeId))
+
+	res = connect.NewResponse(&evaluation.PauseEvaluationResponse{})
+
+	return
+}
+
+// ResumeEvaluation is a method implementation of the evaluation interface: It resumes the paused evaluation of an
+// audit scope. The job continues with the schedule and configuration it was started with.
+func (svc *Service) ResumeEvaluation(ctx context.Context, req *connect.Request[evaluation.ResumeEvaluationRequest]) (res *connect.Response[evaluation.ResumeEvaluationResponse], err error) {
+	// Validate the request
+	if err = service.Validate(req); err != nil {
+		return nil, err
+	}
+
+	auditScopeId := req.Msg.GetAuditScopeId()
+
+	err = svc.checkJobAccess(ctx, auditScopeId)
+	if err != nil {
+		return nil, err
+	}
+
+	if !svc.paused.resume(auditScopeId) {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("job for audit scope '%s' is not paused", auditScopeId))
+	}
+
+	slog.Info("Resumed evaluation of audit scope", slog.String("audit scope", auditScopeId))
+
+	res = connect.NewResponse(&evaluation.ResumeEvaluationResponse{})
+
+	return
+}
+
+// checkJobAccess checks whether the caller may update the evaluation job of the audit scope and whether the job
+// exists at all. It returns an buf connect error that can be used directly by the caller.
+func (svc *Service) checkJobAccess(ctx context.Context, auditScopeId string) (err error) {
+	var (
+		allowed bool
+		jobs    []*gocron.Job
+	)
+
+	// Check access via the configured auth strategy
+	allowed, _, err = checkAccess(ctx, svc.authz, orchestrator.RequestType_REQUEST_TYPE_UPDATED, auditScopeId, orchestrator.ObjectType_OBJECT_TYPE_AUDIT_SCOPE)
+	if err != nil {
+		return connect.NewError(connect.CodeInternal, err)
+	}
+	if !allowed {
+		return service.ErrPermissionDenied
+	}
+
+	jobs, err = svc.scheduler.FindJobsByTag(auditScopeId)
+	if err != nil && !errors.Is(err, gocron.ErrJobNotFoundWithTag) {
+		slog.Error("Could not find jobs for audit scope", slog.String("audit scope", auditScopeId), log.Err(err))
+		return connect.NewError(connect.CodeInternal, fmt.Errorf("could not find jobs for audit scope '%s'", auditScopeId))
+	} else if len(jobs) == 0 {
+		return connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("job for audit scope '%s' is not running", auditScopeId))
+	}
+
+	// The token might be restricted to other targets of evaluation than the one of the evaluated audit scope
+	for _, job := range jobs {
+		if !service.AllowsTargetOfEvaluation(ctx, svc.authz, jobTargetOfEvaluationId(job)) {
+			return service.ErrPermissionDenied
+		}
+	}
+
+	return nil
+}
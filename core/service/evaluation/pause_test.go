@@ -0,0 +1,265 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package evaluation
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"confirmate.io/core/api/evaluation"
+	"confirmate.io/core/service"
+	"confirmate.io/core/service/evaluation/evaluationtest"
+	"confirmate.io/core/util/assert"
+
+	"connectrpc.com/connect"
+	"github.com/go-co-op/gocron"
+)
+
+// newSchedulerWithJob returns a scheduler with a job for the given audit scope.
+func newSchedulerWithJob(t *testing.T, auditScopeId string) *gocron.Scheduler {
+	s := gocron.NewScheduler(time.UTC)
+	_, err := s.Every(1).Day().Tag(auditScopeId).Do(func() {})
+	assert.NoError(t, err)
+
+	return s
+}
+
+func TestService_PauseEvaluation(t *testing.T) {
+	type fields struct {
+		scheduler *gocron.Scheduler
+		authz     service.AuthorizationStrategy
+		paused    map[string]time.Time
+	}
+	type args struct {
+		req *connect.Request[evaluation.PauseEvaluationRequest]
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    assert.Want[*connect.Response[evaluation.PauseEvaluationResponse]]
+		wantErr assert.WantErr
+	}{
+		{
+			name: "error: input empty",
+			args: args{
+				req: &connect.Request[evaluation.PauseEvaluationRequest]{},
+			},
+			want: assert.Nil[*connect.Response[evaluation.PauseEvaluationResponse]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeInvalidArgument)
+			},
+		},
+		{
+			name: "error: permission denied",
+			fields: fields{
+				scheduler: newSchedulerWithJob(t, evaluationtest.MockAuditScopeId1),
+				authz:     &denyAuthorizationStrategy{},
+			},
+			args: args{
+				req: connect.NewRequest(&evaluation.PauseEvaluationRequest{
+					AuditScopeId: evaluationtest.MockAuditScopeId1,
+				}),
+			},
+			want: assert.Nil[*connect.Response[evaluation.PauseEvaluationResponse]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodePermissionDenied)
+			},
+		},
+		{
+			name: "error: job not running",
+			fields: fields{
+				scheduler: gocron.NewScheduler(time.UTC),
+				authz:     &service.AuthorizationStrategyAllowAll{},
+			},
+			args: args{
+				req: connect.NewRequest(&evaluation.PauseEvaluationRequest{
+					AuditScopeId: evaluationtest.MockAuditScopeId1,
+				}),
+			},
+			want: assert.Nil[*connect.Response[evaluation.PauseEvaluationResponse]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeFailedPrecondition) &&
+					assert.ErrorContains(t, err, fmt.Sprintf("job for audit scope '%s' is not running", evaluationtest.MockAuditScopeId1))
+			},
+		},
+		{
+			name: "error: already paused",
+			fields: fields{
+				scheduler: newSchedulerWithJob(t, evaluationtest.MockAuditScopeId1),
+				authz:     &service.AuthorizationStrategyAllowAll{},
+				paused:    map[string]time.Time{evaluationtest.MockAuditScopeId1: time.Now()},
+			},
+			args: args{
+				req: connect.NewRequest(&evaluation.PauseEvaluationRequest{
+					AuditScopeId: evaluationtest.MockAuditScopeId1,
+				}),
+			},
+			want: assert.Nil[*connect.Response[evaluation.PauseEvaluationResponse]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeFailedPrecondition) &&
+					assert.ErrorContains(t, err, "is already paused")
+			},
+		},
+		{
+			name: "happy path",
+			fields: fields{
+				scheduler: newSchedulerWithJob(t, evaluationtest.MockAuditScopeId1),
+				authz:     &service.AuthorizationStrategyAllowAll{},
+			},
+			args: args{
+				req: connect.NewRequest(&evaluation.PauseEvaluationRequest{
+					AuditScopeId: evaluationtest.MockAuditScopeId1,
+				}),
+			},
+			want: func(t *testing.T, got *connect.Response[evaluation.PauseEvaluationResponse], msgAndArgs ...any) bool {
+				return assert.NotNil(t, got)
+			},
+			wantErr: assert.NoError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &Service{
+				scheduler: tt.fields.scheduler,
+				authz:     tt.fields.authz,
+				paused:    pausedJobs{since: tt.fields.paused},
+			}
+			got, err := svc.PauseEvaluation(context.Background(), tt.args.req)
+
+			tt.want(t, got)
+			tt.wantErr(t, err)
+		})
+	}
+}
+
+func TestService_ResumeEvaluation(t *testing.T) {
+	type fields struct {
+		scheduler *gocron.Scheduler
+		authz     service.AuthorizationStrategy
+		paused    map[string]time.Time
+	}
+	type args struct {
+		req *connect.Request[evaluation.ResumeEvaluationRequest]
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    assert.Want[*connect.Response[evaluation.ResumeEvaluationResponse]]
+		wantErr assert.WantErr
+	}{
+		{
+			name: "error: permission denied",
+			fields: fields{
+				scheduler: newSchedulerWithJob(t, evaluationtest.MockAuditScopeId1),
+				authz:     &denyAuthorizationStrategy{},
+				paused:    map[string]time.Time{evaluationtest.MockAuditScopeId1: time.Now()},
+			},
+			args: args{
+				req: connect.NewRequest(&evaluation.ResumeEvaluationRequest{
+					AuditScopeId: evaluationtest.MockAuditScopeId1,
+				}),
+			},
+			want: assert.Nil[*connect.Response[evaluation.ResumeEvaluationResponse]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodePermissionDenied)
+			},
+		},
+		{
+			name: "error: not paused",
+			fields: fields{
+				scheduler: newSchedulerWithJob(t, evaluationtest.MockAuditScopeId1),
+				authz:     &service.AuthorizationStrategyAllowAll{},
+			},
+			args: args{
+				req: connect.NewRequest(&evaluation.ResumeEvaluationRequest{
+					AuditScopeId: evaluationtest.MockAuditScopeId1,
+				}),
+			},
+			want: assert.Nil[*connect.Response[evaluation.ResumeEvaluationResponse]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeFailedPrecondition) &&
+					assert.ErrorContains(t, err, "is not paused")
+			},
+		},
+		{
+			name: "happy path",
+			fields: fields{
+				scheduler: newSchedulerWithJob(t, evaluationtest.MockAuditScopeId1),
+				authz:     &service.AuthorizationStrategyAllowAll{},
+				paused:    map[string]time.Time{evaluationtest.MockAuditScopeId1: time.Now()},
+			},
+			args: args{
+				req: connect.NewRequest(&evaluation.ResumeEvaluationRequest{
+					AuditScopeId: evaluationtest.MockAuditScopeId1,
+				}),
+			},
+			want: func(t *testing.T, got *connect.Response[evaluation.ResumeEvaluationResponse], msgAndArgs ...any) bool {
+				return assert.NotNil(t, got)
+			},
+			wantErr: assert.NoError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &Service{
+				scheduler: tt.fields.scheduler,
+				authz:     tt.fields.authz,
+				paused:    pausedJobs{since: tt.fields.paused},
+			}
+			got, err := svc.ResumeEvaluation(context.Background(), tt.args.req)
+
+			tt.want(t, got)
+			tt.wantErr(t, err)
+		})
+	}
+}
+
+func TestService_PauseEvaluation_retainsJob(t *testing.T) {
+	var (
+		svc = &Service{
+			scheduler: newSchedulerWithJob(t, evaluationtest.MockAuditScopeId1),
+			authz:     &service.AuthorizationStrategyAllowAll{},
+		}
+		ctx = context.Background()
+		id  = evaluationtest.MockAuditScopeId1
+	)
+
+	_, err := svc.PauseEvaluation(ctx, connect.NewRequest(&evaluation.PauseEvaluationRequest{AuditScopeId: id}))
+	assert.NoError(t, err)
+
+	// The job is still scheduled with its interval, but marked as paused
+	res, err := svc.ListEvaluationJobs(ctx, connect.NewRequest(&evaluation.ListEvaluationJobsRequest{}))
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(res.Msg.EvaluationJobs))
+	assert.True(t, res.Msg.EvaluationJobs[0].GetPaused())
+	assert.NotNil(t, res.Msg.EvaluationJobs[0].GetPausedAt())
+	assert.Equal(t, int32(1), res.Msg.EvaluationJobs[0].GetInterval())
+
+	// Runs of the paused job are skipped
+	assert.NoError(t, svc.evaluateAuditScope(ctx, evaluationtest.MockAuditScope1, nil, evaluationTimeouts{}))
+
+	_, err = svc.ResumeEvaluation(ctx, connect.NewRequest(&evaluation.ResumeEvaluationRequest{AuditScopeId: id}))
+	assert.NoError(t, err)
+
+	res, err = svc.ListEvaluationJobs(ctx, connect.NewRequest(&evaluation.ListEvaluationJobsRequest{}))
+	assert.NoError(t, err)
+	assert.False(t, res.Msg.EvaluationJobs[0].GetPaused())
+	assert.Nil(t, res.Msg.EvaluationJobs[0].GetPausedAt())
+}
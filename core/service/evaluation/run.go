@@ -0,0 +1,60 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package evaluation
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// runIdKey is the context key of the ID of the evaluation run that a control is evaluated in.
+type runIdKey struct{}
+
+// newRunId returns the ID of the evaluation run of the audit scope that started at start. It is derived from both, so
+// that the same run always has the same ID.
+func newRunId(auditScopeId string, start time.Time) string {
+	return uuid.NewSHA1(uuid.NameSpaceOID, []byte("evaluation-run:"+auditScopeId+":"+start.UTC().Format(time.RFC3339Nano))).String()
+}
+
+// withRunId returns a context that carries the ID of the evaluation run, see [runIdFromContext].
+func withRunId(ctx context.Context, runId string) context.Context {
+	return context.WithValue(ctx, runIdKey{}, runId)
+}
+
+// runIdFromContext returns the ID of the evaluation run carried by the context or nil, if there is none.
+func runIdFromContext(ctx context.Context) *string {
+	if runId, ok := ctx.Value(runIdKey{}).(string); ok {
+		return &runId
+	}
+
+	return nil
+}
+
+// resultId returns the ID of an evaluation result of the control of the catalog within the evaluation run of the
+// context. It is derived from the run, so that the results of a run always have the same IDs. The kind tells apart
+// several results of the same control within a run, e.g., an error result stored after a failed one. Outside of an
+// evaluation run, a random ID is returned.
+func resultId(ctx context.Context, catalogId string, controlId string, kind string) string {
+	var runId = runIdFromContext(ctx)
+
+	if runId == nil {
+		return uuid.NewString()
+	}
+
+	return uuid.NewSHA1(uuid.MustParse(*runId), []byte(kind+":"+catalogId+"/"+controlId)).String()
+}
@@ -0,0 +1,154 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package evaluation
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"confirmate.io/core/service/evaluation/evaluationtest"
+	"confirmate.io/core/util/assert"
+
+	"github.com/google/uuid"
+)
+
+func Test_newRunId(t *testing.T) {
+	var start = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	type args struct {
+		auditScopeId string
+		start        time.Time
+	}
+	tests := []struct {
+		name string
+		args args
+		want assert.Want[string]
+	}{
+		{
+			name: "same run",
+			args: args{
+				auditScopeId: evaluationtest.MockAuditScopeId1,
+				start:        start.In(time.FixedZone("CET", 3600)),
+			},
+			want: func(t *testing.T, got string, msgAndArgs ...any) bool {
+				return assert.Equal(t, newRunId(evaluationtest.MockAuditScopeId1, start), got)
+			},
+		},
+		{
+			name: "other start",
+			args: args{
+				auditScopeId: evaluationtest.MockAuditScopeId1,
+				start:        start.Add(time.Nanosecond),
+			},
+			want: func(t *testing.T, got string, msgAndArgs ...any) bool {
+				return assert.NotEqual(t, newRunId(evaluationtest.MockAuditScopeId1, start), got)
+			},
+		},
+		{
+			name: "other audit scope",
+			args: args{
+				auditScopeId: evaluationtest.MockAuditScopeId2,
+				start:        start,
+			},
+			want: func(t *testing.T, got string, msgAndArgs ...any) bool {
+				return assert.NotEqual(t, newRunId(evaluationtest.MockAuditScopeId1, start), got)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := newRunId(tt.args.auditScopeId, tt.args.start)
+			assert.NoError(t, uuid.Validate(got))
+			tt.want(t, got)
+		})
+	}
+}
+
+func Test_resultId(t *testing.T) {
+	var (
+		runId = newRunId(evaluationtest.MockAuditScopeId1, time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+		ctx   = withRunId(context.Background(), runId)
+	)
+
+	type args struct {
+		ctx       context.Context
+		catalogId string
+		controlId string
+		kind      string
+	}
+	tests := []struct {
+		name string
+		args args
+		want assert.Want[string]
+	}{
+		{
+			name: "same result of the run",
+			args: args{
+				ctx:       withRunId(context.Background(), runId),
+				catalogId: evaluationtest.MockCatalogId1,
+				controlId: evaluationtest.MockControlId1,
+				kind:      "result",
+			},
+			want: func(t *testing.T, got string, msgAndArgs ...any) bool {
+				return assert.Equal(t, resultId(ctx, evaluationtest.MockCatalogId1, evaluationtest.MockControlId1, "result"), got)
+			},
+		},
+		{
+			name: "other kind",
+			args: args{
+				ctx:       ctx,
+				catalogId: evaluationtest.MockCatalogId1,
+				controlId: evaluationtest.MockControlId1,
+				kind:      "error",
+			},
+			want: func(t *testing.T, got string, msgAndArgs ...any) bool {
+				return assert.NotEqual(t, resultId(ctx, evaluationtest.MockCatalogId1, evaluationtest.MockControlId1, "result"), got)
+			},
+		},
+		{
+			name: "other control",
+			args: args{
+				ctx:       ctx,
+				catalogId: evaluationtest.MockCatalogId1,
+				controlId: evaluationtest.MockControlId2,
+				kind:      "result",
+			},
+			want: func(t *testing.T, got string, msgAndArgs ...any) bool {
+				return assert.NotEqual(t, resultId(ctx, evaluationtest.MockCatalogId1, evaluationtest.MockControlId1, "result"), got)
+			},
+		},
+		{
+			name: "outside of a run",
+			args: args{
+				ctx:       context.Background(),
+				catalogId: evaluationtest.MockCatalogId1,
+				controlId: evaluationtest.MockControlId1,
+				kind:      "result",
+			},
+			want: func(t *testing.T, got string, msgAndArgs ...any) bool {
+				return assert.NotEqual(t, resultId(context.Background(), evaluationtest.MockCatalogId1, evaluationtest.MockControlId1, "result"), got)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := resultId(tt.args.ctx, tt.args.catalogId, tt.args.controlId, tt.args.kind)
+			assert.NoError(t, uuid.Validate(got))
+			tt.want(t, got)
+		})
+	}
+}
@@ -0,0 +1,51 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package evaluation
+
+import (
+	"math"
+
+	"confirmate.io/core/api/evaluation"
+)
+
+const (
+	// sampleConfidenceLevel is the confidence level of the margin of error of a sample.
+	sampleConfidenceLevel = 0.95
+
+	// sampleZScore is the z-score of the standard normal distribution for [sampleConfidenceLevel].
+	sampleZScore = 1.96
+)
+
+// newEvaluationSample describes a sample of n out of a population of the given size. For unbiased strategies, it
+// reports the maximum margin of error of the compliance rate at [sampleConfidenceLevel], i.e., assuming a compliance
+// rate of 50% and including the finite population correction.
+func newEvaluationSample(strategy evaluation.SamplingStrategy, population int64, n int64) (sample *evaluation.EvaluationSample) {
+	sample = &evaluation.EvaluationSample{
+		Strategy:       strategy,
+		PopulationSize: population,
+		SampleSize:     n,
+	}
+
+	// A sample that prefers non-compliant results is not representative of the compliance rate
+	if strategy == evaluation.SamplingStrategy_SAMPLING_STRATEGY_NON_COMPLIANT_FIRST || n == 0 || population < 2 {
+		return sample
+	}
+
+	sample.ConfidenceLevel = sampleConfidenceLevel
+	sample.MarginOfError = new(sampleZScore * math.Sqrt(0.25/float64(n)) * math.Sqrt(float64(population-n)/float64(population-1)))
+
+	return sample
+}
@@ -24,33 +24,66 @@ import (
 	"net/http"
 	"slices"
 	"strings"
-	"sync"
+	"text/template"
 	"time"
 
 	"confirmate.io/core/api"
 	"confirmate.io/core/api/assessment"
 	"confirmate.io/core/api/evaluation"
 	"confirmate.io/core/api/evaluation/evaluationconnect"
+	"confirmate.io/core/api/evidence/evidenceconnect"
 	"confirmate.io/core/api/orchestrator"
 	"confirmate.io/core/api/orchestrator/orchestratorconnect"
 	"confirmate.io/core/log"
 	"confirmate.io/core/service"
+	"confirmate.io/core/util/entity"
 
 	"connectrpc.com/connect"
 	"github.com/go-co-op/gocron"
-	"github.com/google/uuid"
 	"golang.org/x/oauth2/clientcredentials"
 	"golang.org/x/sync/errgroup"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 const (
 	DefaultOrchestratorURL = "http://localhost:8080"
 
+	// DefaultMaxConcurrentControls is the default maximum number of controls that are evaluated concurrently.
+	DefaultMaxConcurrentControls = 10
+
+	// DefaultMaxConcurrentQueries is the default maximum number of concurrent orchestrator queries of the evaluation.
+	DefaultMaxConcurrentQueries = 20
+
+	// DefaultSamplingStrategy is the default strategy of the sample of assessment results, if sampling is enabled by
+	// [Config.SampleSize]. It never misses a non-compliant result as long as they fit into the sample.
+	DefaultSamplingStrategy = evaluation.SamplingStrategy_SAMPLING_STRATEGY_NON_COMPLIANT_FIRST
+
+	// DefaultControlCacheTTL is the default duration after which the cached controls of a catalog are refreshed.
+	DefaultControlCacheTTL = 15 * time.Minute
+
 	// defaultInterval is the default interval time for the scheduler. If no interval is set in the StartEvaluationRequest, the default value is taken.
 	defaultInterval int = 5
+
+	// storeErrorResultTimeout is the timeout used to store an ERROR evaluation result once the evaluation of a
+	// control exceeded its own deadline.
+	storeErrorResultTimeout = 30 * time.Second
 )
 
+// evaluationTimeouts holds the deadlines of a scheduled evaluation job.
+type evaluationTimeouts struct {
+	// scope is the deadline of a complete evaluation run of the audit scope.
+	scope time.Duration
+
+	// controls contains optional deadlines of individual (parent) controls, keyed by the control ID. They are
+	// bounded by scope.
+	controls map[string]time.Duration
+
+	// jitter is the maximum delay of the evaluation of each (parent) control after the start of a run, see
+	// [Config.ControlJitter]. It is bounded by scope.
+	jitter time.Duration
+}
+
 // Service implements the Evaluation Service handler (see
 // [evaluationconnect.EvaluationHandler]).
 type Service struct {
@@ -62,16 +95,43 @@ type Service struct {
 
 	scheduler *gocron.Scheduler
 
-	// catalogControls stores the catalog controls so that they do not always have to be retrieved from Orchestrators getControl endpoint.
-	// map[catalog_id][control_id]*orchestrator.Control
-	catalogControls map[string]map[string]*orchestrator.Control
-	catalogsMutex   sync.RWMutex
+	// catalogControls caches the catalog controls so that they do not always have to be retrieved from the
+	// orchestrator.
+	catalogControls *controlCache
+
+	// narratives contains the parsed custom narrative templates of [Config.NarrativeTemplates], keyed by their locale.
+	narratives map[string]*template.Template
+
+	// queries bounds the concurrent orchestrator queries of all evaluations, see [Config.MaxConcurrentQueries].
+	queries *fairLimiter
+
+	// evidenceStoreClient is used by the consistency checker to verify the evidences of assessment results. It is nil,
+	// if no evidence store is configured.
+	evidenceStoreClient evidenceconnect.EvidenceStoreClient
+
+	// consistency contains the report of the latest consistency check.
+	consistency consistencyChecker
+
+	// paused contains the audit scopes whose evaluation jobs are paused, see [Service.PauseEvaluation].
+	paused pausedJobs
+
+	// workers tracks the background work of the service, i.e., the subscription to catalog change events and the
+	// periodic consistency checks.
+	workers service.Workers
 }
 
+var _ service.Lifecycle = (*Service)(nil)
+
 // DefaultConfig is the default configuration for the evaluation [Service].
 var DefaultConfig = Config{
-	OrchestratorAddress: DefaultOrchestratorURL,
-	OrchestratorClient:  service.DefaultHTTPClient,
+	OrchestratorAddress:   DefaultOrchestratorURL,
+	OrchestratorClient:    service.DefaultHTTPClient,
+	Transport:             service.DefaultTransportConfig,
+	MaxConcurrentControls: DefaultMaxConcurrentControls,
+	MaxConcurrentQueries:  DefaultMaxConcurrentQueries,
+	SamplingStrategy:      DefaultSamplingStrategy,
+	CalendarURL:           DefaultCalendarURL,
+	ControlCacheTTL:       DefaultControlCacheTTL,
 }
 
 // Config represents the configuration for the evaluation [Service].
@@ -84,6 +144,49 @@ type Config struct {
 	// service-to-service authentication with the orchestrator. When set, all outgoing
 	// orchestrator calls use this token.
 	ServiceOAuth2Config *clientcredentials.Config
+	// Transport configures the message size limits and the compression of the orchestrator client.
+	Transport service.TransportConfig
+	// NarrativeTemplates contains custom narrative templates keyed by their locale, which take precedence over the
+	// default templates. See [NarrativeData] for the evaluation context that is available to them.
+	NarrativeTemplates map[string]string
+	// MaxConcurrentControls is the maximum number of controls of a catalog (and sub-controls of a control) that are
+	// evaluated concurrently. A value smaller than 1 disables the limit.
+	MaxConcurrentControls int
+	// MaxConcurrentQueries is the maximum number of concurrent queries to the orchestrator that are issued by the
+	// evaluation of controls. The limit is shared by all audit scopes, which take turns once it is reached. A value
+	// smaller than 1 disables the limit.
+	MaxConcurrentQueries int
+	// SampleSize is the maximum number of the latest assessment results a control is evaluated on. Controls backed by
+	// more results are evaluated on a sample drawn with SamplingStrategy, which keeps the runtime of evaluations bounded
+	// on huge estates. A value smaller than 1 disables sampling.
+	SampleSize int
+	// SamplingStrategy is the strategy the sample of assessment results is drawn with, see [Config.SampleSize].
+	SamplingStrategy evaluation.SamplingStrategy
+	// CalendarURL is the external base URL of the API, which is used in the subscription URLs of calendar feeds.
+	CalendarURL string
+	// CalendarSecret is the secret the tokens of the subscription URLs of calendar feeds are derived from. Changing it
+	// invalidates all subscription URLs. If it is empty, calendar feeds are disabled.
+	CalendarSecret string
+	// ControlCacheTTL is the duration after which the cached controls of a catalog are retrieved again from the
+	// orchestrator. Controls of changed catalogs are refreshed earlier, as long as the subscription to the catalog change
+	// events of the orchestrator is established. A value smaller than 1 disables the expiry.
+	ControlCacheTTL time.Duration
+	// ControlJitter is the maximum random delay of the evaluation of each control after the start of a scheduled run,
+	// which spreads the queries of the controls to the orchestrator over this duration instead of issuing them in a
+	// single burst. The delay counts towards the timeout of the run. A value smaller than 1 disables the jitter.
+	ControlJitter time.Duration
+	// AuditScopeStagger is the window over which the first runs of the scheduled audit scopes are spread, so that the
+	// audit scopes sharing an evaluation service do not query the orchestrator at the same time every interval. The
+	// offset of an audit scope is derived from its ID and is bounded by its interval. A value smaller than 1 starts the
+	// first run immediately.
+	AuditScopeStagger time.Duration
+	// EvidenceStoreAddress is the address of the evidence store, against which the consistency checker verifies the
+	// evidences of assessment results. It is accessed with the same HTTP client as the orchestrator. If it is empty, the
+	// evidences are not checked.
+	EvidenceStoreAddress string
+	// ConsistencyCheckInterval is the interval in which the entities referenced across the services are checked for
+	// orphans, see [Service.CheckConsistency]. A value smaller than 1 disables the periodic checks.
+	ConsistencyCheckInterval time.Duration
 }
 
 // WithConfig sets the service configuration, overriding the default configuration.
@@ -113,9 +216,8 @@ func WithAuthorizationStrategyPermissionStore() service.Option[Service] {
 func NewService(opts ...service.Option[Service]) (handler evaluationconnect.EvaluationHandler, err error) {
 	var (
 		svc = &Service{
-			cfg:             DefaultConfig,
-			scheduler:       gocron.NewScheduler(time.Local),
-			catalogControls: make(map[string]map[string]*orchestrator.Control),
+			cfg:       DefaultConfig,
+			scheduler: gocron.NewScheduler(time.Local),
 		}
 	)
 
@@ -123,10 +225,19 @@ func NewService(opts ...service.Option[Service]) (handler evaluationconnect.Eval
 		o(svc)
 	}
 
+	svc.catalogControls = newControlCache(svc.cfg.ControlCacheTTL)
+
 	if svc.authz == nil {
 		svc.authz = &service.AuthorizationStrategyAllowAll{}
 	}
 
+	svc.narratives, err = parseNarrativeTemplates(svc.cfg.NarrativeTemplates)
+	if err != nil {
+		return nil, err
+	}
+
+	svc.queries = newFairLimiter(svc.cfg.MaxConcurrentQueries)
+
 	// If service OAuth2 credentials are configured, wrap the HTTP client so all outgoing
 	// orchestrator calls authenticate using the client credentials flow. This also fixes the
 	// scheduled-job token expiry issue: auth is handled at the transport level rather than via
@@ -140,7 +251,14 @@ func NewService(opts ...service.Option[Service]) (handler evaluationconnect.Eval
 	}
 
 	// Initialize the orchestrator service client
-	svc.orchestratorClient = orchestratorconnect.NewOrchestratorClient(orchestratorHTTPClient, svc.cfg.OrchestratorAddress)
+	svc.orchestratorClient = orchestratorconnect.NewOrchestratorClient(orchestratorHTTPClient, svc.cfg.OrchestratorAddress,
+		svc.cfg.Transport.ClientOptions()...)
+
+	// Initialize the evidence store client of the consistency checker
+	if svc.cfg.EvidenceStoreAddress != "" {
+		svc.evidenceStoreClient = evidenceconnect.NewEvidenceStoreClient(orchestratorHTTPClient, svc.cfg.EvidenceStoreAddress,
+			svc.cfg.Transport.ClientOptions()...)
+	}
 
 	// If using permission store-based authorization, back it with the orchestrator client so the
 	// evaluation service can check permissions without direct database access.
@@ -154,20 +272,49 @@ func NewService(opts ...service.Option[Service]) (handler evaluationconnect.Eval
 	return
 }
 
-func (svc *Service) Shutdown() {
-	svc.scheduler.Stop()
+// Start starts the scheduler of the evaluation jobs and the subscription to the catalog change events of the
+// orchestrator, which keeps the cached controls up to date, as well as the periodic consistency checks, if configured.
+// This implements [service.Lifecycle].
+func (svc *Service) Start(_ context.Context) (err error) {
+	svc.scheduler.StartAsync()
+	svc.workers.Go(svc.watchCatalogEvents)
+
+	if svc.cfg.ConsistencyCheckInterval > 0 {
+		svc.workers.Go(svc.checkConsistencyPeriodically)
+	}
+
+	return nil
+}
+
+// Shutdown stops the scheduler of the evaluation jobs and the subscription to the catalog change events and waits
+// until the running jobs returned or ctx is done. This implements [service.Lifecycle].
+func (svc *Service) Shutdown(ctx context.Context) (err error) {
+	var done = make(chan struct{})
+
+	go func() {
+		svc.scheduler.Stop()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return svc.workers.Stop(ctx)
+	case <-ctx.Done():
+		return fmt.Errorf("could not stop evaluation jobs: %w", ctx.Err())
+	}
 }
 
 // StartEvaluation is a method implementation of the evaluation interface: It periodically starts the evaluation of a
-// target of evaluation and the given catalog in the audit_scope. If no interval time is given, the default value is
-// used.
+// target of evaluation and the given catalogs in the audit_scope. Settings the request omits are taken from the
+// evaluation defaults of the catalogs (see [orchestrator.EvaluationDefaults]) or, if no interval time is given at all,
+// the default value is used.
 func (svc *Service) StartEvaluation(ctx context.Context, req *connect.Request[evaluation.StartEvaluationRequest]) (res *connect.Response[evaluation.StartEvaluationResponse], err error) {
 	var (
 		interval      int
+		timeouts      evaluationTimeouts
 		auditScope    *orchestrator.AuditScope
 		auditScopeRes *connect.Response[orchestrator.AuditScope]
-		catalog       *orchestrator.Catalog
-		catalogRes    *connect.Response[orchestrator.Catalog]
+		catalogs      []*orchestrator.Catalog
 		jobs          []*gocron.Job
 	)
 
@@ -196,32 +343,42 @@ func (svc *Service) StartEvaluation(ctx context.Context, req *connect.Request[ev
 	}
 	auditScope = auditScopeRes.Msg
 
-	// Make sure that the scheduler is already running
-	svc.scheduler.StartAsync()
+	// The token might be restricted to other targets of evaluation
+	if !service.AllowsTargetOfEvaluation(ctx, svc.authz, auditScope.GetTargetOfEvaluationId()) {
+		return nil, service.ErrPermissionDenied
+	}
 
-	// Set the interval to the default value if not set. If the interval is set to 0, the default interval is used.
-	if req.Msg.GetInterval() == 0 {
-		interval = defaultInterval
-	} else {
-		interval = int(req.Msg.GetInterval())
+	// A requested locale takes precedence over the locale of the audit scope for all texts generated by this job
+	if req.Msg.Locale != nil {
+		auditScope.Locale = req.Msg.Locale
 	}
 
-	// Get all Controls from Orchestrator for the evaluation
-	err = svc.cacheControls(auditScope.GetCatalogId())
+	// Make sure that the scheduler is already running
+	svc.scheduler.StartAsync()
+
+	// Get all controls and catalogs from the orchestrator for the evaluation. The settings of the request take
+	// precedence over the evaluation defaults of the catalogs.
+	catalogs, err = svc.fetchCatalogs(ctx, auditScope)
 	if err != nil {
-		slog.Error("Could not cache controls", log.Err(err))
-		return nil, connect.NewError(connect.CodeInternal, errors.New("could not cache controls"))
+		return nil, err
 	}
+	catalogs = withRequestedDefaults(req.Msg, catalogs)
 
-	// Retrieve the catalog
-	catalogRes, err = svc.orchestratorClient.GetCatalog(ctx, connect.NewRequest(&orchestrator.GetCatalogRequest{
-		CatalogId: auditScope.GetCatalogId(),
-	}))
-	if err != nil {
-		slog.Error("Could not get catalog from the orchestrator", log.Err(err))
-		return nil, connect.NewError(connect.CodeInternal, errors.New("could not get catalog from the orchestrator"))
+	// Set the interval to the one recommended by the catalogs or to the default value, if not set
+	interval = evaluationInterval(req.Msg, catalogs)
+
+	// Set the timeouts. If no timeout is given for the audit scope, we are using a timeout equal to the interval, so
+	// that we reduce premature cancellations while still aiming to avoid overlapping executions.
+	if req.Msg.Timeout == nil {
+		timeouts.scope = time.Duration(interval) * time.Minute
+	} else {
+		timeouts.scope = time.Duration(req.Msg.GetTimeout()) * time.Second
+	}
+	timeouts.controls = make(map[string]time.Duration, len(req.Msg.GetControlTimeouts()))
+	for id, timeout := range req.Msg.GetControlTimeouts() {
+		timeouts.controls[id] = time.Duration(timeout) * time.Second
 	}
-	catalog = catalogRes.Msg
+	timeouts.jitter = min(svc.cfg.ControlJitter, timeouts.scope)
 
 	// Check, if a previous job exists and/or is running
 	jobs, err = svc.scheduler.FindJobsByTag(auditScope.GetId())
@@ -229,14 +386,14 @@ func (svc *Service) StartEvaluation(ctx context.Context, req *connect.Request[ev
 		slog.Error("Could not find existing scheduler job", log.Err(err))
 		return nil, connect.NewError(connect.CodeInternal, errors.New("no scheduler job found"))
 	} else if len(jobs) > 0 {
-		slog.Error("Evaluation already started for Audit scope", slog.String("audit scope", auditScope.GetId()), slog.String("target of evaluation", auditScope.GetTargetOfEvaluationId()), slog.String("catalog id", auditScope.GetCatalogId()))
+		slog.Error("Evaluation already started for Audit scope", slog.String("audit scope", auditScope.GetId()), slog.String("target of evaluation", auditScope.GetTargetOfEvaluationId()), slog.Any("catalog ids", auditScope.AllCatalogIds()))
 		return nil, connect.NewError(connect.CodeAlreadyExists, fmt.Errorf("evaluation already started for the given audit scope '%s'", auditScope.GetId()))
 	}
 
 	slog.Info("Starting evaluation ...")
 
 	// Add job to scheduler
-	err = svc.addJobToScheduler(ctx, auditScope, catalog, interval)
+	err = svc.addJobToScheduler(ctx, auditScope, catalogs, interval, timeouts)
 	// We can return the error as it is
 	if err != nil {
 		return nil, err
@@ -245,6 +402,7 @@ func (svc *Service) StartEvaluation(ctx context.Context, req *connect.Request[ev
 	slog.Info("Scheduled to evaluate audit scope",
 		slog.String("audit scope", auditScope.GetId()),
 		slog.Int("interval (in minutes)", interval),
+		slog.Duration("timeout", timeouts.scope),
 	)
 
 	res = connect.NewResponse(&evaluation.StartEvaluationResponse{
@@ -254,6 +412,33 @@ func (svc *Service) StartEvaluation(ctx context.Context, req *connect.Request[ev
 	return res, nil
 }
 
+// fetchCatalogs caches the controls of all catalogs of the audit scope and retrieves the catalogs from the
+// orchestrator. It returns an buf connect error that can be used directly by the caller.
+func (svc *Service) fetchCatalogs(ctx context.Context, auditScope *orchestrator.AuditScope) (catalogs []*orchestrator.Catalog, err error) {
+	var catalogRes *connect.Response[orchestrator.Catalog]
+
+	for _, catalogId := range auditScope.AllCatalogIds() {
+		// Get all Controls from Orchestrator for the evaluation
+		err = svc.cacheControls(entity.CatalogID(catalogId))
+		if err != nil {
+			slog.Error("Could not cache controls", slog.String("catalog id", catalogId), log.Err(err))
+			return nil, connect.NewError(connect.CodeInternal, errors.New("could not cache controls"))
+		}
+
+		// Retrieve the catalog
+		catalogRes, err = svc.orchestratorClient.GetCatalog(ctx, connect.NewRequest(&orchestrator.GetCatalogRequest{
+			CatalogId: catalogId,
+		}))
+		if err != nil {
+			slog.Error("Could not get catalog from the orchestrator", slog.String("catalog id", catalogId), log.Err(err))
+			return nil, connect.NewError(connect.CodeInternal, errors.New("could not get catalog from the orchestrator"))
+		}
+		catalogs = append(catalogs, catalogRes.Msg)
+	}
+
+	return catalogs, nil
+}
+
 // StopEvaluation is a method implementation of the evaluation interface: It stops the evaluation for a
 // AuditScope.
 func (svc *Service) StopEvaluation(ctx context.Context, req *connect.Request[evaluation.StopEvaluationRequest]) (res *connect.Response[evaluation.StopEvaluationResponse], err error) {
@@ -274,16 +459,27 @@ func (svc *Service) StopEvaluation(ctx context.Context, req *connect.Request[eva
 
 	auditScopeId := req.Msg.GetAuditScopeId()
 
+	// The token might be restricted to other targets of evaluation than the one of the evaluated audit scope
+	jobs, _ := svc.scheduler.FindJobsByTag(auditScopeId)
+	for _, job := range jobs {
+		if !service.AllowsTargetOfEvaluation(ctx, svc.authz, jobTargetOfEvaluationId(job)) {
+			return nil, service.ErrPermissionDenied
+		}
+	}
+
 	// Stop jobs(s) for given audit scope
 	err = svc.scheduler.RemoveByTags(auditScopeId)
 	if err != nil && errors.Is(err, gocron.ErrJobNotFoundWithTag) {
 		slog.Error("Job for audit scope is not running", slog.String("audit scope", auditScopeId), log.Err(err))
 		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("job for audit scope '%s' is not running", auditScopeId))
 	} else if err != nil {
-		slog.Error("Could not remove jobs for audit scope '%s': %w", auditScopeId, log.Err(err))
+		slog.Error("Could not remove jobs for audit scope", slog.String("audit scope", auditScopeId), log.Err(err))
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("could not remove jobs for audit scope '%s'", auditScopeId))
 	}
 
+	// A stopped job is not paused anymore, so that a restarted evaluation runs right away
+	svc.paused.resume(auditScopeId)
+
 	res = &connect.Response[evaluation.StopEvaluationResponse]{}
 
 	return
@@ -336,13 +532,22 @@ func (svc *Service) ListEvaluationJobs(ctx context.Context, req *connect.Request
 				continue
 			}
 		}
-		evaluationJobs = append(evaluationJobs, &evaluation.EvaluationJob{
+		// Filter by the targets of evaluation the token is restricted to
+		if !service.AllowsTargetOfEvaluation(ctx, svc.authz, jobTargetOfEvaluationId(job)) {
+			continue
+		}
+		evaluationJob := &evaluation.EvaluationJob{
 			AuditScopeId: jobScopeId,
 			RunCount:     int32(job.FinishedRunCount()),
 			LastRun:      timestamppb.New(job.LastRun()),
 			Interval:     int32(job.ScheduledInterval()),
 			StartedAt:    timestamppb.New(job.LastRun()),
-		})
+		}
+		if pausedAt, ok := svc.paused.pausedAt(jobScopeId); ok {
+			evaluationJob.Paused = true
+			evaluationJob.PausedAt = timestamppb.New(pausedAt)
+		}
+		evaluationJobs = append(evaluationJobs, evaluationJob)
 	}
 
 	return connect.NewResponse(&evaluation.ListEvaluationJobsResponse{
@@ -350,9 +555,15 @@ func (svc *Service) ListEvaluationJobs(ctx context.Context, req *connect.Request
 	}), nil
 }
 
-// addJobToScheduler adds a job for the given control to the scheduler and sets the scheduler interval to the given
-// interval. It returns an buf connect error that can be used directly by the caller
-func (svc *Service) addJobToScheduler(ctx context.Context, auditScope *orchestrator.AuditScope, catalog *orchestrator.Catalog, interval int) (err error) {
+// addJobToScheduler adds a job for the given audit scope and its catalogs to the scheduler and sets the scheduler
+// interval to the given interval. Each run of the job is bound by the given timeouts. The first run is delayed by the
+// offset of the audit scope within [Config.AuditScopeStagger]. It returns an buf connect error that can be used directly
+// by the caller
+func (svc *Service) addJobToScheduler(ctx context.Context, auditScope *orchestrator.AuditScope, catalogs []*orchestrator.Catalog, interval int, timeouts evaluationTimeouts) (err error) {
+	var (
+		offset time.Duration
+	)
+
 	// Check inputs and log error
 	if auditScope == nil {
 		err = errors.New("audit scope is invalid")
@@ -365,44 +576,116 @@ func (svc *Service) addJobToScheduler(ctx context.Context, auditScope *orchestra
 		return connect.NewError(connect.CodeInternal, errors.New("evaluation cannot be scheduled due to invalid input"))
 	}
 
-	// Use context.Background() rather than the original request context: auth for outgoing
-	// orchestrator calls is handled by the OAuth2 HTTP transport, so the scheduled job does not
-	// need (or want) to inherit the caller's token, which would eventually expire.
-	_, err = svc.scheduler.
+	svc.scheduler.
 		Every(interval).
 		Minute().
-		Tag(auditScope.GetId()).
-		Do(svc.evaluateCatalog, context.Background(), auditScope, catalog, interval)
+		Tag(auditScope.GetId(), auditScope.GetTargetOfEvaluationId())
+
+	offset = scopeOffset(auditScope.GetId(), svc.cfg.AuditScopeStagger, time.Duration(interval)*time.Minute)
+	if offset > 0 {
+		svc.scheduler.StartAt(time.Now().Add(offset))
+	}
+
+	// Use context.Background() rather than the original request context: auth for outgoing
+	// orchestrator calls is handled by the OAuth2 HTTP transport, so the scheduled job does not
+	// need (or want) to inherit the caller's token, which would eventually expire. The job is
+	// tagged with the audit scope and its target of evaluation (see [jobTargetOfEvaluationId]).
+	_, err = svc.scheduler.Do(svc.evaluateAuditScope, context.Background(), auditScope, catalogs, timeouts)
 	if err != nil {
 		slog.Error("Evaluation cannot be scheduled", slog.String("audit scope", auditScope.GetId()), log.Err(err))
 		return connect.NewError(connect.CodeInternal, errors.New("evaluation cannot be scheduled"))
 	}
 
 	slog.Debug("Audit scope added to scheduler",
-		slog.String("audit scope id", auditScope.GetId()))
+		slog.String("audit scope id", auditScope.GetId()),
+		slog.Duration("offset", offset))
 
 	return
 }
 
+// evaluateAuditScope evaluates all catalogs of the audit scope, unless the job is paused or an active maintenance
+// window suppresses the evaluation, see [Service.evaluateCatalogs].
+func (svc *Service) evaluateAuditScope(ctx context.Context, auditScope *orchestrator.AuditScope, catalogs []*orchestrator.Catalog, timeouts evaluationTimeouts) error {
+	// A paused job retains its schedule, but does not evaluate until it is resumed
+	if _, ok := svc.paused.pausedAt(auditScope.GetId()); ok {
+		slog.Debug("Skipping evaluation of paused audit scope",
+			slog.String("audit scope id", auditScope.GetId()))
+		return nil
+	}
+
+	// Planned outages should not generate non-compliance noise
+	if svc.suppressedByMaintenance(ctx, auditScope.GetId()) {
+		slog.Info("Skipping evaluation of audit scope during maintenance",
+			slog.String("audit scope id", auditScope.GetId()))
+		return nil
+	}
+
+	_, err := svc.evaluateCatalogs(ctx, auditScope, catalogs, timeouts)
+	return err
+}
+
+// evaluateCatalogs evaluates all catalogs of the audit scope in parallel and returns the results of their (parent)
+// controls, ordered by catalog and control. The evaluation of each catalog is bound by the given timeouts, see
+// [Service.evaluateCatalog]. All results belong to the same evaluation run.
+func (svc *Service) evaluateCatalogs(ctx context.Context, auditScope *orchestrator.AuditScope, catalogs []*orchestrator.Catalog, timeouts evaluationTimeouts) (results []*evaluation.EvaluationResult, err error) {
+	var (
+		g         errgroup.Group
+		evaluated = make([][]*evaluation.EvaluationResult, len(catalogs))
+		runId     = newRunId(auditScope.GetId(), time.Now())
+	)
+
+	slog.Debug("Starting evaluation run",
+		slog.String("audit scope id", auditScope.GetId()),
+		slog.String("run id", runId))
+
+	ctx = withRunId(ctx, runId)
+
+	for i, catalog := range catalogs {
+		g.Go(func() (err error) {
+			evaluated[i], err = svc.evaluateCatalog(ctx, auditScope, catalog, timeouts)
+			return err
+		})
+	}
+
+	err = g.Wait()
+	if err != nil {
+		return nil, err
+	}
+
+	return slices.Concat(evaluated...), nil
+}
+
 // evaluateCatalog evaluates all [orchestrator.Control] items in the catalog whether their associated metrics are
-// fulfilled or not.
-func (svc *Service) evaluateCatalog(ctx context.Context, auditScope *orchestrator.AuditScope, catalog *orchestrator.Catalog, interval int) error {
+// fulfilled or not and returns the results of the relevant controls, ordered by their ID. The evaluation run is bound
+// by timeouts.scope, individual controls can be further restricted by timeouts.controls. If no scope timeout is given,
+// the default interval is used. The starts of the evaluations of the controls are spread over timeouts.jitter.
+func (svc *Service) evaluateCatalog(ctx context.Context, auditScope *orchestrator.AuditScope, catalog *orchestrator.Catalog, timeouts evaluationTimeouts) (evaluated []*evaluation.EvaluationResult, err error) {
 	var (
-		controls   []*orchestrator.Control
-		relevant   []*orchestrator.Control
-		ignored    []string
-		manual     map[string][]*evaluation.EvaluationResult
-		inScopeIds map[string]struct{}
-		err        error
-		cancel     context.CancelFunc
+		catalogControls map[string]*orchestrator.Control
+		controls        []*orchestrator.Control
+		relevant        []*orchestrator.Control
+		ignored         []string
+		manual          map[string][]*evaluation.EvaluationResult
+		inScopeIds      map[string]struct{}
+		delays          []time.Duration
+		start           time.Time
+		cancel          context.CancelFunc
 	)
 
-	// Retrieve all controls that match our assurance level, sorted by the control ID for easier debugging
-	controls = slices.Collect(maps.Values(svc.catalogControls[auditScope.CatalogId]))
+	// Retrieve all controls that match our assurance level, sorted by the control ID for easier debugging. The
+	// controls are refreshed if the catalog changed since the last run
+	catalogControls, err = svc.controlsOf(catalog.GetId())
+	if err != nil {
+		return nil, err
+	}
+	controls = slices.Collect(maps.Values(catalogControls))
 	slices.SortFunc(controls, func(a *orchestrator.Control, b *orchestrator.Control) int {
 		return strings.Compare(a.Id, b.Id)
 	})
 
+	// The assurance levels and applicability rules of the catalog can change while the evaluation is scheduled
+	catalog = svc.refreshApplicability(ctx, catalog)
+
 	// Fetch ControlInScope records for this audit scope so we can skip
 	// controls that have been explicitly removed from scope.
 	inScopeIds, err = svc.fetchInScopeControlIds(ctx, auditScope.Id)
@@ -413,26 +696,9 @@ func (svc *Service) evaluateCatalog(ctx context.Context, auditScope *orchestrato
 	}
 
 	// First, look for any manual evaluation results that are still within their validity period, to see whether we need to ignore some of the automated ones
-	results, err := api.ListAllPaginated(ctx, &orchestrator.ListEvaluationResultsRequest{
-		Filter: &orchestrator.ListEvaluationResultsRequest_Filter{
-			TargetOfEvaluationId: &auditScope.TargetOfEvaluationId,
-			CatalogId:            &auditScope.CatalogId,
-			ValidManualOnly:      new(true),
-		},
-		LatestByControlId: new(true),
-	},
-		func(ctx context.Context, req *orchestrator.ListEvaluationResultsRequest) (*orchestrator.ListEvaluationResultsResponse, error) {
-			res, err := svc.orchestratorClient.ListEvaluationResults(ctx, connect.NewRequest(req))
-			if err != nil {
-				return nil, err
-			}
-			return res.Msg, nil
-		}, func(res *orchestrator.ListEvaluationResultsResponse) []*evaluation.EvaluationResult {
-			return res.Results
-		})
+	results, err := svc.fetchValidManualResults(ctx, auditScope, catalog)
 	if err != nil {
-		err = fmt.Errorf("could not retrieve existing manual evaluation results: %w", err)
-		return err
+		return nil, err
 	}
 
 	manual = make(map[string][]*evaluation.EvaluationResult)
@@ -473,23 +739,46 @@ func (svc *Service) evaluateCatalog(ctx context.Context, auditScope *orchestrato
 
 	slog.Info("Starting catalog evaluation",
 		slog.String("target of evaluation id", auditScope.GetTargetOfEvaluationId()),
-		slog.String("catalog id", auditScope.GetCatalogId()),
+		slog.String("catalog id", catalog.GetId()),
 		slog.Int("number of relevant controls", len(relevant)),
 		slog.Int("number of ignored controls", len(ignored)),
 	)
 
-	// We are using a timeout equal to the interval, so that we reduce premature cancellations
-	// while still aiming to avoid overlapping executions.
-	ctx, cancel = context.WithTimeout(context.Background(), time.Duration(interval)*time.Minute)
+	if timeouts.scope == 0 {
+		timeouts.scope = time.Duration(defaultInterval) * time.Minute
+	}
+
+	// The deadline of the caller, e.g., of a synchronous evaluation, also applies
+	ctx, cancel = context.WithTimeout(ctx, timeouts.scope)
 	defer cancel()
 
+	evaluated = make([]*evaluation.EvaluationResult, len(relevant))
+
+	// Spread the evaluations of the controls over the jitter of the run, so that their queries do not hit the
+	// orchestrator at once. Once the run is cancelled, the remaining controls are no longer delayed.
+	delays = controlDelays(len(relevant), timeouts.jitter)
+	start = time.Now()
+
 	g, gctx := errgroup.WithContext(ctx)
-	for _, control := range relevant {
+	g.SetLimit(concurrencyLimit(svc.cfg.MaxConcurrentControls))
+	for i, control := range relevant {
+		if delays != nil {
+			waitUntil(gctx, start.Add(delays[i]))
+		}
+
 		g.Go(func() error {
-			err := svc.evaluateControl(gctx, auditScope, catalog, control, manual[control.Id])
+			cctx := gctx
+			if timeout, ok := timeouts.controls[control.Id]; ok {
+				var ccancel context.CancelFunc
+				cctx, ccancel = context.WithTimeout(gctx, timeout)
+				defer ccancel()
+			}
+
+			r, err := svc.evaluateControl(cctx, auditScope, catalog, control, manual[control.Id])
 			if err != nil {
 				return err
 			}
+			evaluated[i] = r
 
 			return nil
 		})
@@ -499,10 +788,63 @@ func (svc *Service) evaluateCatalog(ctx context.Context, auditScope *orchestrato
 	err = g.Wait()
 	if err != nil {
 		slog.Error("Wait group error", log.Err(err))
-		return err
+		return nil, err
 	}
 
-	return nil
+	return evaluated, nil
+}
+
+// fetchValidManualResults returns the latest manual evaluation result of each control of the catalog for the target of
+// evaluation of the audit scope that is still within its validity period. These results take precedence over the
+// automatic evaluation of their controls.
+func (svc *Service) fetchValidManualResults(ctx context.Context, auditScope *orchestrator.AuditScope, catalog *orchestrator.Catalog) (results []*evaluation.EvaluationResult, err error) {
+	results, err = api.ListAllPaginated(ctx, &orchestrator.ListEvaluationResultsRequest{
+		Filter: &orchestrator.ListEvaluationResultsRequest_Filter{
+			TargetOfEvaluationId: &auditScope.TargetOfEvaluationId,
+			CatalogId:            &catalog.Id,
+			ValidManualOnly:      new(true),
+		},
+		LatestByControlId: new(true),
+	},
+		func(ctx context.Context, req *orchestrator.ListEvaluationResultsRequest) (*orchestrator.ListEvaluationResultsResponse, error) {
+			res, err := svc.orchestratorClient.ListEvaluationResults(ctx, connect.NewRequest(req))
+			if err != nil {
+				return nil, err
+			}
+			return res.Msg, nil
+		}, func(res *orchestrator.ListEvaluationResultsResponse) []*evaluation.EvaluationResult {
+			return res.Results
+		})
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve existing manual evaluation results: %w", err)
+	}
+
+	return results, nil
+}
+
+// suppressedByMaintenance returns whether an active maintenance window of the audit scope suppresses evaluation runs.
+// If the maintenance windows cannot be retrieved, the audit scope is evaluated anyway.
+func (svc *Service) suppressedByMaintenance(ctx context.Context, auditScopeId string) bool {
+	windows, err := api.ListAllPaginated(ctx, &orchestrator.ListMaintenanceWindowsRequest{
+		AuditScopeId: auditScopeId,
+		ActiveAt:     timestamppb.Now(),
+	}, func(ctx context.Context, req *orchestrator.ListMaintenanceWindowsRequest) (*orchestrator.ListMaintenanceWindowsResponse, error) {
+		res, err := svc.orchestratorClient.ListMaintenanceWindows(ctx, connect.NewRequest(req))
+		if err != nil {
+			return nil, err
+		}
+		return res.Msg, nil
+	}, func(res *orchestrator.ListMaintenanceWindowsResponse) []*orchestrator.MaintenanceWindow {
+		return res.MaintenanceWindows
+	})
+	if err != nil {
+		slog.Warn("Could not fetch maintenance windows, evaluating audit scope", log.Err(err))
+		return false
+	}
+
+	return slices.ContainsFunc(windows, func(w *orchestrator.MaintenanceWindow) bool {
+		return w.GetMode() == orchestrator.MaintenanceMode_MAINTENANCE_MODE_SUPPRESS
+	})
 }
 
 // fetchInScopeControlIds returns a set of control IDs that are currently in
@@ -541,15 +883,17 @@ func (svc *Service) fetchInScopeControlIds(ctx context.Context, auditScopeId str
 }
 
 // evaluateControl evaluates a control, e.g., OPS-13. Therefore, the method needs to wait till all sub-controls (e.g.,
-// OPS-13.1) are evaluated.
-func (svc *Service) evaluateControl(ctx context.Context, auditScope *orchestrator.AuditScope, catalog *orchestrator.Catalog, control *orchestrator.Control, manual []*evaluation.EvaluationResult) (err error) {
+// OPS-13.1) are evaluated. It returns the stored result of the control, which has the status ERROR if the evaluation
+// failed.
+func (svc *Service) evaluateControl(ctx context.Context, auditScope *orchestrator.AuditScope, catalog *orchestrator.Catalog, control *orchestrator.Control, manual []*evaluation.EvaluationResult) (result *evaluation.EvaluationResult, err error) {
 	var (
 		status              = evaluation.EvaluationStatus_EVALUATION_STATUS_PENDING
-		result              *evaluation.EvaluationResult
 		evaluationResults   []*evaluation.EvaluationResult
 		assessmentResultIds = []string{}
 		relevantSubcontrol  []*orchestrator.Control
 		ignored             []string
+		metrics             []*assessment.Metric
+		compliant           int
 	)
 
 	// TODO(lebogg): Don't think this is 100% correct. 1st) if all sub controls are manually evaluated we would ignore all of them and status would be still pending according to our logic below and 2nd) In theory, we could also have manual NON-complaint results. These would be then ignored but shouldn't be.
@@ -573,7 +917,7 @@ func (svc *Service) evaluateControl(ctx context.Context, auditScope *orchestrato
 
 	slog.Info("Starting control evaluation",
 		slog.String("target of evaluation id", auditScope.TargetOfEvaluationId),
-		slog.String("catalog id", auditScope.CatalogId),
+		slog.String("catalog id", catalog.GetId()),
 		slog.String("control id", control.Id),
 		slog.Int("number of relevant controls for the audit scope", len(relevantSubcontrol)))
 
@@ -582,9 +926,10 @@ func (svc *Service) evaluateControl(ctx context.Context, auditScope *orchestrato
 
 	// evaluate all subcontrols in parallel
 	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(concurrencyLimit(svc.cfg.MaxConcurrentControls))
 	for i, sub := range relevantSubcontrol {
 		g.Go(func() error {
-			r, err := svc.evaluateSubcontrol(gctx, auditScope, sub)
+			r, err := svc.evaluateSubcontrol(gctx, auditScope, catalog, sub)
 			if err != nil {
 				return err
 			}
@@ -593,11 +938,18 @@ func (svc *Service) evaluateControl(ctx context.Context, auditScope *orchestrato
 		})
 	}
 
-	// Wait until all sub-controls are evaluated
+	// Wait until all sub-controls are evaluated. If the control exceeded its deadline, we record this as a distinct
+	// status instead of silently keeping the last (stale) result.
 	err = g.Wait()
-	if err != nil {
+	if isTimeout(err) {
+		slog.Warn("Control evaluation timed out",
+			slog.String("audit scope id", auditScope.Id),
+			slog.String("control id", control.Id),
+			log.Err(err))
+		return svc.storeErrorResult(ctx, auditScope, catalog, control, translate(resolveLocale(nil, auditScope), msgEvaluationTimedOut), err)
+	} else if err != nil {
 		slog.Error("Wait group error", log.Err(err))
-		return
+		return svc.storeErrorResult(ctx, auditScope, catalog, control, translate(resolveLocale(nil, auditScope), msgEvaluationFailed), err)
 	}
 
 	// Copy the manual results
@@ -625,35 +977,79 @@ func (svc *Service) evaluateControl(ctx context.Context, auditScope *orchestrato
 		case evaluation.EvaluationStatus_EVALUATION_STATUS_COMPLIANT, evaluation.EvaluationStatus_EVALUATION_STATUS_COMPLIANT_MANUALLY:
 			// check the given evaluation results for the current evaluation status COMPLIANT
 			status = handleCompliant(r)
+		case evaluation.EvaluationStatus_EVALUATION_STATUS_STALE:
+			// check the given evaluation results for the current evaluation status STALE
+			status = handleStale(r)
+		case evaluation.EvaluationStatus_EVALUATION_STATUS_ERROR:
+			// check the given evaluation results for the current evaluation status ERROR
+			status = handleError(r)
 		case evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT, evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT_MANUALLY:
 			// Evaluation status does not change if it is already not_compliant
 		}
 
+		if r.Status == evaluation.EvaluationStatus_EVALUATION_STATUS_COMPLIANT ||
+			r.Status == evaluation.EvaluationStatus_EVALUATION_STATUS_COMPLIANT_MANUALLY {
+			compliant++
+		}
+
 		// We are interested in all result IDs in order to provide a trace back from evaluation result back to assessment (and evidence).
 		assessmentResultIds = append(assessmentResultIds, r.AssessmentResultIds...)
 	}
 
+	// The catalog might not require all sub-controls to be compliant
+	status = applyAggregationStrategy(catalog, status, compliant)
+
 	// Create evaluation result
 	// slices.Compact only removes adjacent duplicates, so sort first to ensure full deduplication.
 	slices.Sort(assessmentResultIds)
 
 	result = &evaluation.EvaluationResult{
-		Id:                   uuid.NewString(),
+		Id:                   resultId(ctx, catalog.GetId(), control.Id, "result"),
+		RunId:                runIdFromContext(ctx),
 		Timestamp:            timestamppb.Now(),
-		ControlCatalogId:     auditScope.CatalogId,
+		ControlCatalogId:     catalog.GetId(),
 		ControlId:            control.Id,
 		TargetOfEvaluationId: auditScope.TargetOfEvaluationId,
 		AuditScopeId:         auditScope.Id,
 		Status:               status,
+		SubStatus:            subStatus(catalog, status, compliant, len(evaluationResults), evaluationResults),
 		AssessmentResultIds:  slices.Compact(assessmentResultIds),
+		Reasons:              subControlReasons(evaluationResults),
+		Remediation:          control.Remediation,
 	}
 
-	_, err = svc.orchestratorClient.StoreEvaluationResult(ctx, connect.NewRequest(&orchestrator.StoreEvaluationResultRequest{
-		Result: result,
-	}))
-	if err != nil {
+	// An erroneous control inherits the causes of its erroneous sub-controls
+	if status == evaluation.EvaluationStatus_EVALUATION_STATUS_ERROR {
+		result.ErrorCause = subControlErrorCause(evaluationResults)
+	}
+
+	for _, sub := range relevantSubcontrol {
+		metrics = append(metrics, getMetricsFromControl(sub)...)
+	}
+
+	// Explain a non-compliant control by the failing metrics of its sub-controls
+	if status == evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT {
+		result.FailingMetrics = mergeFailingMetrics(evaluationResults)
+	}
+
+	result.Narrative = svc.narrative(&NarrativeData{
+		Result:     result,
+		AuditScope: auditScope,
+		Catalog:    catalog,
+		Control:    control,
+		Parent:     true,
+		SubResults: evaluationResults,
+		Metrics:    metrics,
+		Locale:     resolveLocale(nil, auditScope),
+	})
+
+	err = svc.storeEvaluationResult(ctx, result)
+	if isTimeout(err) {
+		return svc.storeErrorResult(ctx, auditScope, catalog, control, translate(resolveLocale(nil, auditScope), msgEvaluationTimedOut), err)
+	} else if err != nil {
 		slog.Error("Failed to send evaluation result to orchestrator", log.Err(err))
-		return errors.New("failed to send evaluation result to orchestrator")
+		return svc.storeErrorResult(ctx, auditScope, catalog, control, translate(resolveLocale(nil, auditScope), msgEvaluationFailed),
+			fmt.Errorf("failed to send evaluation result to orchestrator: %w", err))
 	}
 
 	slog.Info("Evaluation result created",
@@ -664,12 +1060,41 @@ func (svc *Service) evaluateControl(ctx context.Context, auditScope *orchestrato
 	return
 }
 
-// evaluateSubcontrol evaluates the sub-controls, e.g., OPS-13.2
-func (svc *Service) evaluateSubcontrol(ctx context.Context, auditScope *orchestrator.AuditScope, control *orchestrator.Control) (eval *evaluation.EvaluationResult, err error) {
+// refreshApplicability returns a copy of the catalog with its current assurance levels and applicability rules as
+// retrieved from the orchestrator. If they cannot be retrieved, the catalog is returned as it is.
+func (svc *Service) refreshApplicability(ctx context.Context, catalog *orchestrator.Catalog) *orchestrator.Catalog {
+	res, err := svc.orchestratorClient.GetCatalog(ctx, connect.NewRequest(&orchestrator.GetCatalogRequest{
+		CatalogId: catalog.GetId(),
+	}))
+	if err != nil {
+		slog.Warn("Could not refresh the applicability of controls, using the previous one",
+			slog.String("catalog id", catalog.GetId()), log.Err(err))
+		return catalog
+	}
+
+	catalog = proto.Clone(catalog).(*orchestrator.Catalog)
+	catalog.AssuranceLevels = res.Msg.GetAssuranceLevels()
+	catalog.ApplicabilityRules = res.Msg.GetApplicabilityRules()
+
+	return catalog
+}
+
+// evaluateSubcontrol evaluates the sub-controls of the given catalog, e.g., OPS-13.2
+func (svc *Service) evaluateSubcontrol(ctx context.Context, auditScope *orchestrator.AuditScope, catalog *orchestrator.Catalog, control *orchestrator.Control) (eval *evaluation.EvaluationResult, err error) {
 	var (
 		assessments []*assessment.AssessmentResult
 		status      evaluation.EvaluationStatus
+		comment     *string
+		cause       error
 		resultIds   []string
+		stale       int
+		outdated    int
+		compliant   int
+		now         time.Time
+		reasons     []evaluation.EvaluationReason
+		sampling    *orchestrator.AssessmentResultSampling
+		population  int64
+		maxAge      *uint32
 	)
 
 	// TODO(lebogg): Why we don't return an error here?
@@ -692,32 +1117,55 @@ func (svc *Service) evaluateSubcontrol(ctx context.Context, auditScope *orchestr
 	}
 
 	if len(metrics) != 0 {
+		// Only evaluate a sample of the latest assessment results, if it is configured. The seed differs per run and
+		// control, so that another sample of the same results is evaluated in every run.
+		if svc.cfg.SampleSize > 0 {
+			sampling = &orchestrator.AssessmentResultSampling{
+				Strategy:   svc.cfg.SamplingStrategy,
+				SampleSize: int32(svc.cfg.SampleSize),
+				Seed:       resultId(ctx, catalog.GetId(), control.Id, "sample"),
+			}
+		}
+
 		// Get latest assessment_results by resource_id filtered by
 		// * target of evaluation id
 		// * metric ids
+		// * environment, if the audit scope is restricted to one
 		assessments, err = api.ListAllPaginated(ctx, &orchestrator.ListAssessmentResultsRequest{
 			Filter: &orchestrator.ListAssessmentResultsRequest_Filter{
 				TargetOfEvaluationId: &auditScope.TargetOfEvaluationId,
-				MetricIds:            getMetricIds(metrics),
+				MetricIds:            entity.Strings(getMetricIds(metrics)),
+				Environment:          auditScope.Environment,
 			},
 			LatestByResourceId: new(true),
+			Sampling:           sampling,
 		}, func(ctx context.Context, req *orchestrator.ListAssessmentResultsRequest) (*orchestrator.ListAssessmentResultsResponse, error) {
+			if err := svc.queries.acquire(ctx, auditScope.GetId()); err != nil {
+				return nil, err
+			}
+			defer svc.queries.release()
+
 			res, err := svc.orchestratorClient.ListAssessmentResults(ctx, connect.NewRequest(req))
 			if err != nil {
 				return nil, err
 			}
 			return res.Msg, nil
 		}, func(res *orchestrator.ListAssessmentResultsResponse) []*assessment.AssessmentResult {
+			population += res.GetPopulationSize()
 			return res.Results
 		})
 
-		if err != nil {
+		if isTimeout(err) {
+			// The deadline of the control is exceeded, there is no point in storing a (pending) result
+			return nil, fmt.Errorf("could not get assessment results: %w", err)
+		} else if err != nil {
 			// We let the scheduler running if we do not get the assessment results from the orchestrator, maybe it is
-			// only a temporary network problem
+			// only a temporary network problem. Until then, the control is recorded as erroneous together with the cause.
 			slog.Error("Could not get assessment results",
 				slog.String("target of evaluation id", auditScope.GetTargetOfEvaluationId()),
 				slog.Any("metric ids", getMetricIds(metrics)),
 				log.Err(err))
+			cause = fmt.Errorf("could not get assessment results: %w", err)
 		} else if len(assessments) == 0 {
 			// We let the scheduler running if we do not get the assessment results from the orchestrator, maybe it is
 			// only a temporary network problem
@@ -731,38 +1179,171 @@ func (svc *Service) evaluateSubcontrol(ctx context.Context, auditScope *orchestr
 			slog.String("audit_scope_id", auditScope.GetId()))
 	}
 
-	// If no assessment_results are available we are stuck at pending
-	if len(assessments) == 0 {
+	// If no assessment_results are available we are stuck at pending. We explain the reason in the comment, so that
+	// auditors can distinguish controls that need a manual evaluation from controls that are still waiting for results.
+	if cause != nil {
+		status = evaluation.EvaluationStatus_EVALUATION_STATUS_ERROR
+		comment = new(translate(resolveLocale(nil, auditScope), msgEvaluationFailed))
+	} else if len(metrics) == 0 {
+		status = evaluation.EvaluationStatus_EVALUATION_STATUS_PENDING
+		comment = new(translate(resolveLocale(nil, auditScope), msgNoMetrics))
+	} else if len(assessments) == 0 {
 		status = evaluation.EvaluationStatus_EVALUATION_STATUS_PENDING
+		comment = new(translate(resolveLocale(nil, auditScope), msgNoAssessmentResults))
 	} else {
 		// Otherwise, there are some results and first we assume that everything is compliant, unless someone proves it
 		// otherwise
 		status = evaluation.EvaluationStatus_EVALUATION_STATUS_COMPLIANT
 	}
 
-	// Here the actual evaluation takes place. We check if the assessment results are compliant.
+	// Here the actual evaluation takes place. We check if the assessment results are compliant. Waived results are
+	// still referenced, but their non-compliance is accepted. Stale results are based on outdated evidence, so they
+	// can neither prove compliance nor non-compliance.
+	// Results based on evidence older than the maximum evidence age of the control do not satisfy the control at all.
+	now = time.Now()
+	maxAge = maxEvidenceAgeDays(catalog, control)
 	for _, r := range assessments {
-		if !r.Compliant {
+		if isOutdated(r, maxAge, now) {
+			outdated++
 			status = evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT
+		} else if r.IsStale(now) {
+			stale++
+		} else if !r.Compliant && !r.IsWaived(now) {
+			status = evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT
+		} else {
+			compliant++
 		}
 		resultIds = append(resultIds, r.GetId())
 	}
 
+	// A control that would be compliant is stale, if some of its results are based on outdated evidence
+	if stale > 0 && status == evaluation.EvaluationStatus_EVALUATION_STATUS_COMPLIANT {
+		status = evaluation.EvaluationStatus_EVALUATION_STATUS_STALE
+	}
+
+	if outdated > 0 {
+		reasons = append(reasons, evaluation.EvaluationReason_EVALUATION_REASON_FRESHNESS_VIOLATION)
+		comment = new(translate(resolveLocale(nil, auditScope), msgFreshnessViolation, outdated, *maxAge))
+	}
+
 	// Create evaluation result
 	eval = &evaluation.EvaluationResult{
-		Id:                   uuid.NewString(),
+		Id:                   resultId(ctx, catalog.GetId(), control.Id, "result"),
+		RunId:                runIdFromContext(ctx),
 		Timestamp:            timestamppb.Now(),
-		ControlCatalogId:     auditScope.CatalogId,
+		ControlCatalogId:     catalog.GetId(),
 		ControlId:            control.Id,
 		ParentControlId:      control.ParentControlId,
 		TargetOfEvaluationId: auditScope.TargetOfEvaluationId,
 		AuditScopeId:         auditScope.Id,
 		Status:               status,
+		SubStatus:            subStatus(catalog, status, compliant, len(assessments), nil),
 		AssessmentResultIds:  resultIds,
+		Comment:              comment,
+		ErrorCause:           errorCause(cause),
+		Reasons:              reasons,
+		Remediation:          control.Remediation,
+	}
+
+	// Record the sample, if the control was not evaluated on all of its latest assessment results
+	if sampling != nil && population > int64(len(assessments)) {
+		eval.Sample = newEvaluationSample(sampling.Strategy, population, int64(len(assessments)))
+	}
+
+	// Explain a non-compliant control by its failing assessment results
+	if status == evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT {
+		eval.FailingMetrics = newFailingMetrics(assessments, metrics, now)
+	}
+
+	eval.Narrative = svc.narrative(&NarrativeData{
+		Result:        eval,
+		AuditScope:    auditScope,
+		Catalog:       catalog,
+		Control:       control,
+		Metrics:       metrics,
+		ResourceCount: countResources(assessments),
+		StaleCount:    stale,
+		Locale:        resolveLocale(nil, auditScope),
+	})
+
+	err = svc.storeEvaluationResult(ctx, eval)
+	if err != nil {
+		slog.Error("Failed to send evaluation result to orchestrator", log.Err(err))
+		return nil, fmt.Errorf("failed to send evaluation result to orchestrator: %w", err)
 	}
 
+	slog.Info("Evaluation result created",
+		slog.String("control id", control.Id),
+		slog.String("target of evaluation id", auditScope.GetTargetOfEvaluationId()),
+		slog.String("status", eval.Status.String()))
+
+	return
+}
+
+// storeEvaluationResult sends the evaluation result to the orchestrator, once [Service.queries] permits another query
+// of its audit scope.
+func (svc *Service) storeEvaluationResult(ctx context.Context, result *evaluation.EvaluationResult) (err error) {
+	err = svc.queries.acquire(ctx, result.GetAuditScopeId())
+	if err != nil {
+		return err
+	}
+	defer svc.queries.release()
+
 	_, err = svc.orchestratorClient.StoreEvaluationResult(ctx, connect.NewRequest(&orchestrator.StoreEvaluationResultRequest{
-		Result: eval,
+		Result: result,
+	}))
+
+	return
+}
+
+// jobTargetOfEvaluationId returns the ID of the target of evaluation whose audit scope is evaluated by the given
+// scheduler job. It is empty for jobs that are not tagged with it.
+func jobTargetOfEvaluationId(job *gocron.Job) string {
+	if tags := job.Tags(); len(tags) > 1 {
+		return tags[1]
+	}
+
+	return ""
+}
+
+// concurrencyLimit converts a configured limit into the limit of an [errgroup.Group], which is unbounded if the limit
+// is negative.
+func concurrencyLimit(n int) int {
+	if n < 1 {
+		return -1
+	}
+
+	return n
+}
+
+// storeErrorResult stores an evaluation result with the status ERROR and the given cause for the given control of the
+// catalog and returns it. Since the context of the control is most likely already expired, the result is stored with a
+// fresh deadline.
+func (svc *Service) storeErrorResult(ctx context.Context, auditScope *orchestrator.AuditScope, catalog *orchestrator.Catalog, control *orchestrator.Control, comment string, cause error) (result *evaluation.EvaluationResult, err error) {
+	var (
+		cancel context.CancelFunc
+	)
+
+	ctx, cancel = context.WithTimeout(context.WithoutCancel(ctx), storeErrorResultTimeout)
+	defer cancel()
+
+	result = &evaluation.EvaluationResult{
+		Id:                   resultId(ctx, catalog.GetId(), control.Id, "error"),
+		RunId:                runIdFromContext(ctx),
+		Timestamp:            timestamppb.Now(),
+		ControlCatalogId:     catalog.GetId(),
+		ControlId:            control.Id,
+		ParentControlId:      control.ParentControlId,
+		TargetOfEvaluationId: auditScope.TargetOfEvaluationId,
+		AuditScopeId:         auditScope.Id,
+		Status:               evaluation.EvaluationStatus_EVALUATION_STATUS_ERROR,
+		AssessmentResultIds:  []string{},
+		Comment:              &comment,
+		ErrorCause:           errorCause(cause),
+	}
+
+	_, err = svc.orchestratorClient.StoreEvaluationResult(ctx, connect.NewRequest(&orchestrator.StoreEvaluationResultRequest{
+		Result: result,
 	}))
 	if err != nil {
 		slog.Error("Failed to send evaluation result to orchestrator", log.Err(err))
@@ -771,12 +1352,69 @@ func (svc *Service) evaluateSubcontrol(ctx context.Context, auditScope *orchestr
 
 	slog.Info("Evaluation result created",
 		slog.String("control id", control.Id),
-		slog.String("target of evaluation id", auditScope.GetTargetOfEvaluationId()),
-		slog.String("status", eval.Status.String()))
+		slog.String("target of evaluation id", auditScope.TargetOfEvaluationId),
+		slog.String("status", result.Status.String()))
+
+	return
+}
+
+// errorCause returns the persisted cause of an erroneous evaluation result, if there is any.
+func errorCause(cause error) *string {
+	if cause == nil {
+		return nil
+	}
+
+	return new(cause.Error())
+}
+
+// subControlErrorCause combines the causes of the erroneous sub-control results into the cause of their parent
+// control.
+func subControlErrorCause(results []*evaluation.EvaluationResult) *string {
+	var causes []string
+
+	for _, r := range results {
+		if r.GetStatus() == evaluation.EvaluationStatus_EVALUATION_STATUS_ERROR {
+			causes = append(causes, fmt.Sprintf("%s: %s", r.GetControlId(), r.GetErrorCause()))
+		}
+	}
+
+	if len(causes) == 0 {
+		return nil
+	}
+
+	return new(strings.Join(causes, "; "))
+}
+
+// isOutdated returns true if the assessment result is based on evidence older than the given maximum evidence age in
+// days at the given time, see [maxEvidenceAgeDays].
+func isOutdated(r *assessment.AssessmentResult, maxAge *uint32, now time.Time) bool {
+	if maxAge == nil {
+		return false
+	}
+
+	return r.EvidenceRecordedAt().Before(now.AddDate(0, 0, -int(*maxAge)))
+}
+
+// subControlReasons returns the distinct reasons of the given evaluation results of sub-controls, so that a parent
+// control explains its status by the reasons of its sub-controls.
+func subControlReasons(results []*evaluation.EvaluationResult) (reasons []evaluation.EvaluationReason) {
+	for _, r := range results {
+		for _, reason := range r.GetReasons() {
+			if !slices.Contains(reasons, reason) {
+				reasons = append(reasons, reason)
+			}
+		}
+	}
 
 	return
 }
 
+// isTimeout checks whether the given error is caused by an exceeded deadline, either locally or reported by the
+// orchestrator.
+func isTimeout(err error) bool {
+	return errors.Is(err, context.DeadlineExceeded) || connect.CodeOf(err) == connect.CodeDeadlineExceeded
+}
+
 // getMetricsFromControl returns all metrics from a given control. If the control has sub-controls, get also all metrics from the sub-controls.
 func getMetricsFromControl(control *orchestrator.Control) (metrics []*assessment.Metric) {
 	// Add metric of control to the metrics list
@@ -791,10 +1429,9 @@ func getMetricsFromControl(control *orchestrator.Control) (metrics []*assessment
 }
 
 // cacheControls caches the catalog controls for the given catalog.
-func (svc *Service) cacheControls(catalogId string) error {
+func (svc *Service) cacheControls(catalogId entity.CatalogID) error {
 	var (
 		err      error
-		tag      string
 		controls []*orchestrator.Control
 	)
 
@@ -806,7 +1443,7 @@ func (svc *Service) cacheControls(catalogId string) error {
 	// TODO(anatheka): Shouldn´t we use the ListControlsInScope endpoint?
 	controls, err = api.ListAllPaginated(context.Background(), &orchestrator.ListControlsRequest{
 		Filter: &orchestrator.ListControlsRequest_Filter{
-			CatalogId: &catalogId,
+			CatalogId: new(catalogId.String()),
 			Full:      new(true),
 		},
 	}, func(ctx context.Context, req *orchestrator.ListControlsRequest) (*orchestrator.ListControlsResponse, error) {
@@ -826,14 +1463,7 @@ func (svc *Service) cacheControls(catalogId string) error {
 		return fmt.Errorf("no controls for catalog '%s' available", catalogId)
 	}
 
-	// Store controls in map
-	svc.catalogsMutex.Lock()
-	svc.catalogControls[catalogId] = make(map[string]*orchestrator.Control)
-	for _, control := range controls {
-		tag = control.GetId()
-		svc.catalogControls[catalogId][tag] = control
-	}
-	svc.catalogsMutex.Unlock()
+	svc.catalogControls.set(catalogId.String(), controls)
 
 	return nil
 }
@@ -853,6 +1483,10 @@ func handlePending(er *evaluation.EvaluationResult) evaluation.EvaluationStatus
 	case evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT,
 		evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT_MANUALLY:
 		evalStatus = evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT
+	case evaluation.EvaluationStatus_EVALUATION_STATUS_STALE:
+		evalStatus = evaluation.EvaluationStatus_EVALUATION_STATUS_STALE
+	case evaluation.EvaluationStatus_EVALUATION_STATUS_ERROR:
+		evalStatus = evaluation.EvaluationStatus_EVALUATION_STATUS_ERROR
 	}
 
 	return evalStatus
@@ -867,6 +1501,41 @@ func handleCompliant(er *evaluation.EvaluationResult) evaluation.EvaluationStatu
 	switch er.Status {
 	case evaluation.EvaluationStatus_EVALUATION_STATUS_PENDING, evaluation.EvaluationStatus_EVALUATION_STATUS_COMPLIANT, evaluation.EvaluationStatus_EVALUATION_STATUS_COMPLIANT_MANUALLY:
 		// valuation status does not change
+	case evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT, evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT_MANUALLY:
+		evalStatus = evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT
+	case evaluation.EvaluationStatus_EVALUATION_STATUS_STALE:
+		evalStatus = evaluation.EvaluationStatus_EVALUATION_STATUS_STALE
+	case evaluation.EvaluationStatus_EVALUATION_STATUS_ERROR:
+		evalStatus = evaluation.EvaluationStatus_EVALUATION_STATUS_ERROR
+	}
+
+	return evalStatus
+}
+
+// handleStale evaluates the given evaluation result when the current control evaluation status is STALE
+func handleStale(er *evaluation.EvaluationResult) evaluation.EvaluationStatus {
+	var (
+		evalStatus = evaluation.EvaluationStatus_EVALUATION_STATUS_STALE
+	)
+
+	switch er.Status {
+	case evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT, evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT_MANUALLY:
+		evalStatus = evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT
+	case evaluation.EvaluationStatus_EVALUATION_STATUS_ERROR:
+		evalStatus = evaluation.EvaluationStatus_EVALUATION_STATUS_ERROR
+	}
+
+	return evalStatus
+}
+
+// handleError evaluates the given evaluation result when the current control evaluation status is ERROR. Only a
+// non-compliant result overrides the error, since the control cannot be compliant anymore.
+func handleError(er *evaluation.EvaluationResult) evaluation.EvaluationStatus {
+	var (
+		evalStatus = evaluation.EvaluationStatus_EVALUATION_STATUS_ERROR
+	)
+
+	switch er.Status {
 	case evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT, evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT_MANUALLY:
 		evalStatus = evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT
 	}
@@ -875,11 +1544,11 @@ func handleCompliant(er *evaluation.EvaluationResult) evaluation.EvaluationStatu
 }
 
 // getMetricIds returns the metric Ids for the given metrics
-func getMetricIds(metrics []*assessment.Metric) []string {
-	var metricIds []string
+func getMetricIds(metrics []*assessment.Metric) []entity.MetricID {
+	var metricIds []entity.MetricID
 
 	for m := range slices.Values(metrics) {
-		metricIds = append(metricIds, m.GetId())
+		metricIds = append(metricIds, entity.MetricID(m.GetId()))
 	}
 
 	return metricIds
@@ -18,6 +18,7 @@ package evaluation
 import (
 	"context"
 	"fmt"
+	"math"
 	"net/http"
 	"sort"
 	"testing"
@@ -29,7 +30,9 @@ import (
 	"confirmate.io/core/api/orchestrator"
 	"confirmate.io/core/api/orchestrator/orchestratorconnect"
 	"github.com/google/go-cmp/cmp/cmpopts"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"confirmate.io/core/server"
 	"confirmate.io/core/server/servertest"
@@ -38,6 +41,7 @@ import (
 	"confirmate.io/core/service/evidence/evidencetest"
 	"confirmate.io/core/service/orchestrator/orchestratortest"
 	"confirmate.io/core/util/assert"
+	"confirmate.io/core/util/entity"
 	"connectrpc.com/connect"
 	"github.com/go-co-op/gocron"
 )
@@ -57,6 +61,10 @@ func (*denyAuthorizationStrategy) AllowedAuditScopes(_ context.Context) (bool, [
 	return false, nil
 }
 
+func (*denyAuthorizationStrategy) ClaimedTargetOfEvaluations(_ context.Context) (bool, []string) {
+	return false, nil
+}
+
 func (*denyAuthorizationStrategy) AllowedUserPermission(_ context.Context) (bool, []string) {
 	return false, nil
 }
@@ -79,6 +87,10 @@ func (s *partialScopeAuthorizationStrategy) AllowedAuditScopes(_ context.Context
 	return false, s.scopeIds
 }
 
+func (s *partialScopeAuthorizationStrategy) ClaimedTargetOfEvaluations(_ context.Context) (bool, []string) {
+	return true, nil
+}
+
 func (s *partialScopeAuthorizationStrategy) AllowedUserPermission(_ context.Context) (bool, []string) {
 	return false, nil
 }
@@ -113,10 +125,10 @@ func TestNewService(t *testing.T) {
 					OrchestratorAddress: "http://testhost:8080",
 					OrchestratorClient:  http.DefaultClient,
 				}, svc.cfg)
+				assert.Nil(t, svc.queries)
 				assert.NotEmpty(t, svc.scheduler)
 				assert.NotEmpty(t, orchestratorconnect.NewOrchestratorClient(svc.cfg.OrchestratorClient, "http:://testhost:8080"), svc.orchestratorClient)
-				assert.Equal(t, make(map[string]map[string]*orchestrator.Control), svc.catalogControls)
-				return assert.NotNil(t, &svc.catalogsMutex)
+				return assert.Equal(t, time.Duration(0), svc.catalogControls.ttl)
 			},
 			wantErr: assert.NoError,
 		},
@@ -133,10 +145,10 @@ func TestNewService(t *testing.T) {
 				}
 				assert.Equal(t, DefaultConfig, svc.cfg, cmpopts.IgnoreFields(Config{}, "OrchestratorClient"))
 				assert.True(t, svc.cfg.OrchestratorClient == DefaultConfig.OrchestratorClient)
+				assert.Equal(t, DefaultMaxConcurrentQueries, svc.queries.free)
 				assert.NotEmpty(t, svc.scheduler)
 				assert.NotEmpty(t, orchestratorconnect.NewOrchestratorClient(svc.cfg.OrchestratorClient, svc.cfg.OrchestratorAddress), svc.orchestratorClient)
-				assert.Equal(t, make(map[string]map[string]*orchestrator.Control), svc.catalogControls)
-				return assert.NotNil(t, &svc.catalogsMutex)
+				return assert.Equal(t, DefaultControlCacheTTL, svc.catalogControls.ttl)
 			},
 			wantErr: assert.NoError,
 		},
@@ -161,6 +173,20 @@ func TestNewService(t *testing.T) {
 			},
 			wantErr: assert.NoError,
 		},
+		{
+			name: "invalid narrative template",
+			args: args{
+				opts: []service.Option[Service]{
+					WithConfig(Config{
+						NarrativeTemplates: map[string]string{LocaleEnglish: "{{.Control.Id"},
+					}),
+				},
+			},
+			want: assert.Nil[evaluationconnect.EvaluationHandler],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.ErrorContains(t, err, `could not parse narrative template of locale "en"`)
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -190,7 +216,10 @@ func TestService_Shutdown(t *testing.T) {
 				t.Fatalf("expected *Service, got %T", got)
 			}
 
-			svc.Shutdown()
+			assert.NoError(t, svc.Start(context.Background()))
+			assert.True(t, svc.scheduler.IsRunning())
+
+			assert.NoError(t, svc.Shutdown(context.Background()))
 			assert.False(t, svc.scheduler.IsRunning())
 
 		})
@@ -203,7 +232,7 @@ func TestService_cacheControls(t *testing.T) {
 		catalogControls    map[string]map[string]*orchestrator.Control
 	}
 	type args struct {
-		catalogId string
+		catalogId entity.CatalogID
 	}
 	tests := []struct {
 		name    string
@@ -275,9 +304,10 @@ func TestService_cacheControls(t *testing.T) {
 				catalogId: orchestratortest.MockCatalogId1,
 			},
 			wantSvc: func(t *testing.T, got *Service, msgAndArgs ...any) bool {
-				assert.Equal(t, 1, len(got.catalogControls))
-				assert.Equal(t, 4, len(got.catalogControls[orchestratortest.MockCatalogId1]))
-				return assert.Equal(t, orchestratortest.MockControl1, got.catalogControls[orchestratortest.MockCatalogId1][orchestratortest.MockControlId1])
+				controls, fresh := got.catalogControls.get(orchestratortest.MockCatalogId1)
+				assert.True(t, fresh)
+				assert.Equal(t, 4, len(controls))
+				return assert.Equal(t, orchestratortest.MockControl1, controls[orchestratortest.MockControlId1])
 			},
 			wantErr: assert.NoError,
 		},
@@ -286,7 +316,7 @@ func TestService_cacheControls(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			svc := &Service{
 				orchestratorClient: tt.fields.orchestratorClient,
-				catalogControls:    tt.fields.catalogControls,
+				catalogControls:    newTestControlCache(tt.fields.catalogControls),
 			}
 			err := svc.cacheControls(tt.args.catalogId)
 			tt.wantErr(t, err)
@@ -295,6 +325,63 @@ func TestService_cacheControls(t *testing.T) {
 	}
 }
 
+func TestService_refreshApplicability(t *testing.T) {
+	var catalog = &orchestrator.Catalog{
+		Id:              orchestratortest.MockCatalogId1,
+		Name:            "Catalog",
+		AssuranceLevels: []string{"basic", "high"},
+	}
+
+	tests := []struct {
+		name   string
+		client orchestratorconnect.OrchestratorClient
+		want   assert.Want[*orchestrator.Catalog]
+	}{
+		{
+			name: "orchestrator returns error",
+			client: newOrchestratorClient(t, func(m *mockOrchestratorHandler) {
+				m.getCatalogError = connect.NewError(connect.CodeInternal, fmt.Errorf("internal"))
+			}),
+			want: func(t *testing.T, got *orchestrator.Catalog, msgAndArgs ...any) bool {
+				return assert.Equal(t, catalog, got)
+			},
+		},
+		{
+			name: "happy path",
+			client: newOrchestratorClient(t, func(m *mockOrchestratorHandler) {
+				m.catalog = &orchestrator.Catalog{
+					Id:              orchestratortest.MockCatalogId1,
+					Name:            "Changed",
+					AssuranceLevels: []string{"basic", "substantial", "high"},
+					ApplicabilityRules: []*orchestrator.ApplicabilityRule{
+						{CatalogId: orchestratortest.MockCatalogId1, ControlShortName: "OPS-01"},
+					},
+				}
+			}),
+			want: func(t *testing.T, got *orchestrator.Catalog, msgAndArgs ...any) bool {
+				return assert.Equal(t, &orchestrator.Catalog{
+					Id:              orchestratortest.MockCatalogId1,
+					Name:            "Catalog",
+					AssuranceLevels: []string{"basic", "substantial", "high"},
+					ApplicabilityRules: []*orchestrator.ApplicabilityRule{
+						{CatalogId: orchestratortest.MockCatalogId1, ControlShortName: "OPS-01"},
+					},
+				}, got)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &Service{
+				orchestratorClient: tt.client,
+			}
+			got := svc.refreshApplicability(context.Background(), catalog)
+			tt.want(t, got)
+		})
+	}
+}
+
 func Test_handlePending(t *testing.T) {
 	type args struct {
 		eval *evaluation.EvaluationResult
@@ -359,6 +446,28 @@ func Test_handlePending(t *testing.T) {
 				return assert.Equal(t, evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT, got)
 			},
 		},
+		{
+			name: "Status: Stale",
+			args: args{
+				eval: &evaluation.EvaluationResult{
+					Status: evaluation.EvaluationStatus_EVALUATION_STATUS_STALE,
+				},
+			},
+			want: func(t *testing.T, got evaluation.EvaluationStatus, _ ...any) bool {
+				return assert.Equal(t, evaluation.EvaluationStatus_EVALUATION_STATUS_STALE, got)
+			},
+		},
+		{
+			name: "Status: Error",
+			args: args{
+				eval: &evaluation.EvaluationResult{
+					Status: evaluation.EvaluationStatus_EVALUATION_STATUS_ERROR,
+				},
+			},
+			want: func(t *testing.T, got evaluation.EvaluationStatus, _ ...any) bool {
+				return assert.Equal(t, evaluation.EvaluationStatus_EVALUATION_STATUS_ERROR, got)
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -422,6 +531,28 @@ func Test_handleCompliant(t *testing.T) {
 				return assert.Equal(t, evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT, got)
 			},
 		},
+		{
+			name: "Status: Stale",
+			args: args{
+				er: &evaluation.EvaluationResult{
+					Status: evaluation.EvaluationStatus_EVALUATION_STATUS_STALE,
+				},
+			},
+			want: func(t *testing.T, got evaluation.EvaluationStatus, msgAndArgs ...any) bool {
+				return assert.Equal(t, evaluation.EvaluationStatus_EVALUATION_STATUS_STALE, got)
+			},
+		},
+		{
+			name: "Status: Error",
+			args: args{
+				er: &evaluation.EvaluationResult{
+					Status: evaluation.EvaluationStatus_EVALUATION_STATUS_ERROR,
+				},
+			},
+			want: func(t *testing.T, got evaluation.EvaluationStatus, msgAndArgs ...any) bool {
+				return assert.Equal(t, evaluation.EvaluationStatus_EVALUATION_STATUS_ERROR, got)
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -431,6 +562,181 @@ func Test_handleCompliant(t *testing.T) {
 	}
 }
 
+func Test_handleStale(t *testing.T) {
+	type args struct {
+		er *evaluation.EvaluationResult
+	}
+	tests := []struct {
+		name string
+		args args
+		want assert.Want[evaluation.EvaluationStatus]
+	}{
+		{
+			name: "Status: Pending",
+			args: args{
+				er: &evaluation.EvaluationResult{
+					Status: evaluation.EvaluationStatus_EVALUATION_STATUS_PENDING,
+				},
+			},
+			want: func(t *testing.T, got evaluation.EvaluationStatus, msgAndArgs ...any) bool {
+				return assert.Equal(t, evaluation.EvaluationStatus_EVALUATION_STATUS_STALE, got)
+			},
+		},
+		{
+			name: "Status: Compliant",
+			args: args{
+				er: &evaluation.EvaluationResult{
+					Status: evaluation.EvaluationStatus_EVALUATION_STATUS_COMPLIANT,
+				},
+			},
+			want: func(t *testing.T, got evaluation.EvaluationStatus, msgAndArgs ...any) bool {
+				return assert.Equal(t, evaluation.EvaluationStatus_EVALUATION_STATUS_STALE, got)
+			},
+		},
+		{
+			name: "Status: Not compliant",
+			args: args{
+				er: &evaluation.EvaluationResult{
+					Status: evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT,
+				},
+			},
+			want: func(t *testing.T, got evaluation.EvaluationStatus, msgAndArgs ...any) bool {
+				return assert.Equal(t, evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT, got)
+			},
+		},
+		{
+			name: "Status: Not compliant manually",
+			args: args{
+				er: &evaluation.EvaluationResult{
+					Status: evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT_MANUALLY,
+				},
+			},
+			want: func(t *testing.T, got evaluation.EvaluationStatus, msgAndArgs ...any) bool {
+				return assert.Equal(t, evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT, got)
+			},
+		},
+		{
+			name: "Status: Error",
+			args: args{
+				er: &evaluation.EvaluationResult{
+					Status: evaluation.EvaluationStatus_EVALUATION_STATUS_ERROR,
+				},
+			},
+			want: func(t *testing.T, got evaluation.EvaluationStatus, msgAndArgs ...any) bool {
+				return assert.Equal(t, evaluation.EvaluationStatus_EVALUATION_STATUS_ERROR, got)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := handleStale(tt.args.er)
+			tt.want(t, got)
+		})
+	}
+}
+
+func Test_handleError(t *testing.T) {
+	type args struct {
+		er *evaluation.EvaluationResult
+	}
+	tests := []struct {
+		name string
+		args args
+		want assert.Want[evaluation.EvaluationStatus]
+	}{
+		{
+			name: "Status: Compliant",
+			args: args{
+				er: &evaluation.EvaluationResult{
+					Status: evaluation.EvaluationStatus_EVALUATION_STATUS_COMPLIANT,
+				},
+			},
+			want: func(t *testing.T, got evaluation.EvaluationStatus, msgAndArgs ...any) bool {
+				return assert.Equal(t, evaluation.EvaluationStatus_EVALUATION_STATUS_ERROR, got)
+			},
+		},
+		{
+			name: "Status: Stale",
+			args: args{
+				er: &evaluation.EvaluationResult{
+					Status: evaluation.EvaluationStatus_EVALUATION_STATUS_STALE,
+				},
+			},
+			want: func(t *testing.T, got evaluation.EvaluationStatus, msgAndArgs ...any) bool {
+				return assert.Equal(t, evaluation.EvaluationStatus_EVALUATION_STATUS_ERROR, got)
+			},
+		},
+		{
+			name: "Status: Not compliant",
+			args: args{
+				er: &evaluation.EvaluationResult{
+					Status: evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT,
+				},
+			},
+			want: func(t *testing.T, got evaluation.EvaluationStatus, msgAndArgs ...any) bool {
+				return assert.Equal(t, evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT, got)
+			},
+		},
+		{
+			name: "Status: Not compliant manually",
+			args: args{
+				er: &evaluation.EvaluationResult{
+					Status: evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT_MANUALLY,
+				},
+			},
+			want: func(t *testing.T, got evaluation.EvaluationStatus, msgAndArgs ...any) bool {
+				return assert.Equal(t, evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT, got)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := handleError(tt.args.er)
+			tt.want(t, got)
+		})
+	}
+}
+
+func Test_subControlErrorCause(t *testing.T) {
+	type args struct {
+		results []*evaluation.EvaluationResult
+	}
+	tests := []struct {
+		name string
+		args args
+		want assert.Want[*string]
+	}{
+		{
+			name: "no erroneous results",
+			args: args{
+				results: []*evaluation.EvaluationResult{
+					{ControlId: "A-1.1", Status: evaluation.EvaluationStatus_EVALUATION_STATUS_COMPLIANT},
+				},
+			},
+			want: assert.Nil[*string],
+		},
+		{
+			name: "erroneous results",
+			args: args{
+				results: []*evaluation.EvaluationResult{
+					{ControlId: "A-1.1", Status: evaluation.EvaluationStatus_EVALUATION_STATUS_ERROR, ErrorCause: new("boom")},
+					{ControlId: "A-1.2", Status: evaluation.EvaluationStatus_EVALUATION_STATUS_COMPLIANT},
+					{ControlId: "A-1.3", Status: evaluation.EvaluationStatus_EVALUATION_STATUS_ERROR, ErrorCause: new("timeout")},
+				},
+			},
+			want: func(t *testing.T, got *string, _ ...any) bool {
+				return assert.Equal(t, new("A-1.1: boom; A-1.3: timeout"), got)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := subControlErrorCause(tt.args.results)
+			tt.want(t, got)
+		})
+	}
+}
+
 func Test_getMetricIds(t *testing.T) {
 	type args struct {
 		metrics []*assessment.Metric
@@ -438,7 +744,7 @@ func Test_getMetricIds(t *testing.T) {
 	tests := []struct {
 		name string
 		args args
-		want []string
+		want []entity.MetricID
 	}{
 		{
 			name: "Empty input",
@@ -457,7 +763,7 @@ func Test_getMetricIds(t *testing.T) {
 					},
 				},
 			},
-			want: []string{evidencetest.MockSubControlID11, evidencetest.MockSubControlID},
+			want: []entity.MetricID{evidencetest.MockSubControlID11, evidencetest.MockSubControlID},
 		},
 	}
 	for _, tt := range tests {
@@ -468,6 +774,50 @@ func Test_getMetricIds(t *testing.T) {
 	}
 }
 
+func Test_isTimeout(t *testing.T) {
+	type args struct {
+		err error
+	}
+	tests := []struct {
+		name string
+		args args
+		want bool
+	}{
+		{
+			name: "no error",
+			args: args{},
+			want: false,
+		},
+		{
+			name: "other error",
+			args: args{
+				err: connect.NewError(connect.CodeInternal, fmt.Errorf("some error")),
+			},
+			want: false,
+		},
+		{
+			name: "wrapped context deadline",
+			args: args{
+				err: fmt.Errorf("could not get assessment results: %w", context.DeadlineExceeded),
+			},
+			want: true,
+		},
+		{
+			name: "connect deadline exceeded",
+			args: args{
+				err: connect.NewError(connect.CodeDeadlineExceeded, fmt.Errorf("deadline exceeded")),
+			},
+			want: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := isTimeout(tt.args.err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
 func TestService_StopEvaluation(t *testing.T) {
 	type args struct {
 		ctx context.Context
@@ -605,12 +955,14 @@ func TestService_StopEvaluation(t *testing.T) {
 func TestService_addJobToScheduler(t *testing.T) {
 	type fields struct {
 		scheduler *gocron.Scheduler
+		cfg       Config
 	}
 	type args struct {
 		ctx        context.Context
 		auditScope *orchestrator.AuditScope
-		catalog    *orchestrator.Catalog
+		catalogs   []*orchestrator.Catalog
 		interval   int
+		timeouts   evaluationTimeouts
 	}
 	tests := []struct {
 		name    string
@@ -627,7 +979,7 @@ func TestService_addJobToScheduler(t *testing.T) {
 			args: args{
 				ctx:        context.Background(),
 				auditScope: nil,
-				catalog:    &orchestrator.Catalog{},
+				catalogs:   []*orchestrator.Catalog{{}},
 				interval:   5,
 			},
 			want: func(t *testing.T, got *Service, msgAndArgs ...any) bool {
@@ -645,7 +997,7 @@ func TestService_addJobToScheduler(t *testing.T) {
 			args: args{
 				ctx:        context.Background(),
 				auditScope: evaluationtest.MockAuditScope1,
-				catalog:    &orchestrator.Catalog{},
+				catalogs:   []*orchestrator.Catalog{{}},
 			},
 			want: func(t *testing.T, got *Service, msgAndArgs ...any) bool {
 				return assert.False(t, got.scheduler.IsRunning())
@@ -662,8 +1014,9 @@ func TestService_addJobToScheduler(t *testing.T) {
 			args: args{
 				ctx:        context.Background(),
 				auditScope: evaluationtest.MockAuditScope1,
-				catalog:    &orchestrator.Catalog{},
+				catalogs:   []*orchestrator.Catalog{{}},
 				interval:   5,
+				timeouts:   evaluationTimeouts{scope: 5 * time.Minute},
 			},
 			want: func(t *testing.T, got *Service, msgAndArgs ...any) bool {
 				assert.Equal(t, 1, len(got.scheduler.Jobs()))
@@ -671,13 +1024,38 @@ func TestService_addJobToScheduler(t *testing.T) {
 			},
 			wantErr: assert.NoError,
 		},
-	}
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			svc := &Service{
-				scheduler: tt.fields.scheduler,
-			}
-			err := svc.addJobToScheduler(tt.args.ctx, tt.args.auditScope, tt.args.catalog, tt.args.interval)
+		{
+			name: "happy path: first run staggered",
+			fields: fields{
+				scheduler: gocron.NewScheduler(time.Local),
+				cfg:       Config{AuditScopeStagger: time.Minute},
+			},
+			args: args{
+				ctx:        context.Background(),
+				auditScope: evaluationtest.MockAuditScope1,
+				catalogs:   []*orchestrator.Catalog{{}},
+				interval:   5,
+				timeouts:   evaluationTimeouts{scope: 5 * time.Minute},
+			},
+			want: func(t *testing.T, got *Service, msgAndArgs ...any) bool {
+				var offset = scopeOffset(evaluationtest.MockAuditScope1.Id, time.Minute, 5*time.Minute)
+
+				got.scheduler.StartAsync()
+				defer got.scheduler.Stop()
+
+				return assert.Equal(t, 1, len(got.scheduler.Jobs())) &&
+					assert.True(t, time.Until(got.scheduler.Jobs()[0].NextRun()) > offset-time.Second)
+			},
+			wantErr: assert.NoError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &Service{
+				scheduler: tt.fields.scheduler,
+				cfg:       tt.fields.cfg,
+			}
+			err := svc.addJobToScheduler(tt.args.ctx, tt.args.auditScope, tt.args.catalogs, tt.args.interval, tt.args.timeouts)
 
 			tt.wantErr(t, err)
 			tt.want(t, svc)
@@ -785,11 +1163,49 @@ func TestService_evaluateControl(t *testing.T) {
 					Comment:              nil,
 					ValidUntil:           nil,
 					Data:                 nil,
+					Narrative:            new("2 of 2 sub-controls of control " + evaluationtest.MockControlId1 + " are compliant."),
 				}
 				return assert.Equal(t, want, mainControlResult, protocmp.IgnoreFields(&evaluation.EvaluationResult{}, "id", "timestamp", "assessment_result_ids"))
 			},
 			wantErr: assert.NoError,
 		},
+		{
+			name: "assessment results cannot be retrieved => error with cause",
+			args: args{
+				ctx:        context.Background(),
+				auditScope: evaluationtest.MockAuditScope1,
+				catalog:    evaluationtest.MockCatalog1,
+				control:    evaluationtest.MockControl1,
+			},
+			fields: fields{
+				orchestratorClient: newOrchestratorClient(t,
+					WithListAssessmentResultsError(connect.NewError(connect.CodeUnavailable, fmt.Errorf("orchestrator unreachable"))),
+				),
+				catalogControls: map[string]map[string]*orchestrator.Control{
+					evaluationtest.MockCatalog1.Id: {
+						evaluationtest.MockControl1.Id: evaluationtest.MockControl1,
+					},
+				},
+			},
+			wantSvc: func(t *testing.T, got *Service, msgAndArgs ...any) bool {
+				evalResults, err := got.orchestratorClient.ListEvaluationResults(context.Background(), connect.NewRequest(&orchestrator.ListEvaluationResultsRequest{}))
+				assert.NoError(t, err)
+
+				// The sub-controls as well as their parent are erroneous
+				assert.Equal(t, 3, len(evalResults.Msg.Results))
+				for _, result := range evalResults.Msg.Results {
+					assert.Equal(t, evaluation.EvaluationStatus_EVALUATION_STATUS_ERROR, result.Status)
+					assert.Contains(t, result.GetErrorCause(), "could not get assessment results: unavailable: orchestrator unreachable")
+
+					if result.ControlId == evaluationtest.MockControlId1 {
+						assert.Contains(t, result.GetErrorCause(), evaluationtest.MockControl1SubcontrolId11+": ")
+					}
+				}
+
+				return true
+			},
+			wantErr: assert.NoError,
+		},
 		{
 			name: "happy path - with non-compliant assessment results but manual compliant result",
 			args: args{
@@ -868,6 +1284,7 @@ func TestService_evaluateControl(t *testing.T) {
 					Comment:              nil,
 					ValidUntil:           nil,
 					Data:                 nil,
+					Narrative:            new("3 of 3 sub-controls of control " + evaluationtest.MockControlId1 + " are compliant."),
 				}
 
 				return assert.Equal(t, want, mainControlResult, protocmp.IgnoreFields(&evaluation.EvaluationResult{}, "id", "timestamp", "assessment_result_ids"))
@@ -939,6 +1356,25 @@ func TestService_evaluateControl(t *testing.T) {
 					Comment:              nil,
 					ValidUntil:           nil,
 					Data:                 nil,
+					Narrative:            new("0 of 2 sub-controls of control " + evaluationtest.MockControlId1 + " are compliant. Metric " + evaluationtest.MockMetricId1 + " failed for 1 resource, including resource-1. Metric " + evaluationtest.MockMetricId2 + " failed for 1 resource, including resource-2."),
+					FailingMetrics: []*evaluation.FailingMetric{
+						{
+							MetricId:      evaluationtest.MockMetricId1,
+							ResultCount:   1,
+							ResourceCount: 1,
+							Resources: []*evaluation.FailingResource{
+								{ResourceId: "resource-1", AssessmentResultIds: []string{evaluationtest.MockAssessmentResultId1}},
+							},
+						},
+						{
+							MetricId:      evaluationtest.MockMetricId2,
+							ResultCount:   1,
+							ResourceCount: 1,
+							Resources: []*evaluation.FailingResource{
+								{ResourceId: "resource-2", AssessmentResultIds: []string{evaluationtest.MockAssessmentResultId2}},
+							},
+						},
+					},
 				}
 
 				return assert.Equal(t, want, mainControlResult, protocmp.IgnoreFields(&evaluation.EvaluationResult{}, "id", "timestamp", "assessment_result_ids"))
@@ -950,10 +1386,10 @@ func TestService_evaluateControl(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			svc := Service{
 				orchestratorClient: tt.fields.orchestratorClient,
-				catalogControls:    tt.fields.catalogControls,
+				catalogControls:    newTestControlCache(tt.fields.catalogControls),
 			}
 
-			gotErr := svc.evaluateControl(tt.args.ctx, tt.args.auditScope, tt.args.catalog, tt.args.control, tt.args.manual)
+			_, gotErr := svc.evaluateControl(tt.args.ctx, tt.args.auditScope, tt.args.catalog, tt.args.control, tt.args.manual)
 
 			tt.wantErr(t, gotErr)
 			tt.wantSvc(t, &svc)
@@ -968,7 +1404,7 @@ func TestService_evaluateCatalog(t *testing.T) {
 		ctx        context.Context
 		auditScope *orchestrator.AuditScope
 		catalog    *orchestrator.Catalog
-		interval   int
+		timeouts   evaluationTimeouts
 	}
 	type fields struct {
 		orchestratorClient orchestratorconnect.OrchestratorClient
@@ -987,7 +1423,7 @@ func TestService_evaluateCatalog(t *testing.T) {
 				ctx:        context.Background(),
 				auditScope: evaluationtest.MockAuditScope1,
 				catalog:    evaluationtest.MockCatalog1,
-				interval:   5,
+				timeouts:   evaluationTimeouts{scope: 5 * time.Minute},
 			},
 			fields: fields{
 				orchestratorClient: newOrchestratorClient(t,
@@ -1051,7 +1487,7 @@ func TestService_evaluateCatalog(t *testing.T) {
 				ctx:        context.Background(),
 				auditScope: evaluationtest.MockAuditScope1,
 				catalog:    evaluationtest.MockCatalog1,
-				interval:   5,
+				timeouts:   evaluationTimeouts{scope: 5 * time.Minute},
 			},
 			fields: fields{
 				orchestratorClient: newOrchestratorClient(t,
@@ -1110,29 +1546,222 @@ func TestService_evaluateCatalog(t *testing.T) {
 			},
 			wantErr: assert.NoError,
 		},
+		{
+			name: "happy path - control exceeding its timeout is recorded with status error",
+			args: args{
+				ctx:        context.Background(),
+				auditScope: evaluationtest.MockAuditScope1,
+				catalog:    evaluationtest.MockCatalog1,
+				timeouts: evaluationTimeouts{
+					scope: 5 * time.Minute,
+					controls: map[string]time.Duration{
+						evaluationtest.MockControlId1: 10 * time.Millisecond,
+					},
+				},
+			},
+			fields: fields{
+				orchestratorClient: newOrchestratorClient(t,
+					WithAssessmentResults([]*assessment.AssessmentResult{
+						{
+							Id:                   evaluationtest.MockAssessmentResultId1,
+							MetricId:             evaluationtest.MockMetricId1,
+							Compliant:            true,
+							ResourceId:           "resource-1",
+							TargetOfEvaluationId: evaluationtest.MockToeId1,
+						},
+						{
+							Id:                   evaluationtest.MockAssessmentResultId2,
+							MetricId:             evaluationtest.MockMetricId2,
+							Compliant:            true,
+							ResourceId:           "resource-2",
+							TargetOfEvaluationId: evaluationtest.MockToeId1,
+						},
+					}),
+					WithListAssessmentResultsDelay(200*time.Millisecond),
+				),
+				catalogControls: map[string]map[string]*orchestrator.Control{
+					evaluationtest.MockCatalog1.Id: {
+						evaluationtest.MockControl1.Id: evaluationtest.MockControl1,
+						evaluationtest.MockControl2.Id: evaluationtest.MockControl2,
+					},
+				},
+			},
+			want: func(t *testing.T, got *Service, msgAndArgs ...any) bool {
+				evalResults, err := got.orchestratorClient.ListEvaluationResults(context.Background(), connect.NewRequest(&orchestrator.ListEvaluationResultsRequest{}))
+				assert.NoError(t, err)
+
+				// We should have 3 results total:
+				// - 1 for Control 1 (parent) - error, because it exceeded its timeout
+				// - 1 for Control 2 (parent) - compliant
+				// - 1 for Control 2.1 (subcontrol) - compliant
+				assert.Equal(t, 3, len(evalResults.Msg.Results))
+
+				for _, result := range evalResults.Msg.Results {
+					switch result.ControlId {
+					case evaluationtest.MockControlId1:
+						assert.Equal(t, evaluation.EvaluationStatus_EVALUATION_STATUS_ERROR, result.Status)
+						assert.Equal(t, "evaluation timed out", result.GetComment())
+					case evaluationtest.MockControlId2:
+						assert.Equal(t, evaluation.EvaluationStatus_EVALUATION_STATUS_COMPLIANT, result.Status)
+					}
+				}
+
+				return true
+			},
+			wantErr: assert.NoError,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			svc := Service{
 				orchestratorClient: tt.fields.orchestratorClient,
-				catalogControls:    tt.fields.catalogControls,
+				catalogControls:    newTestControlCache(tt.fields.catalogControls),
 			}
 
-			gotErr := svc.evaluateCatalog(tt.args.ctx, tt.args.auditScope, tt.args.catalog, tt.args.interval)
+			_, gotErr := svc.evaluateCatalog(tt.args.ctx, tt.args.auditScope, tt.args.catalog, tt.args.timeouts)
 			tt.wantErr(t, gotErr)
 			tt.want(t, &svc)
 		})
 	}
 }
 
+// TestService_evaluateAuditScope covers the evaluation of an audit scope with multiple catalogs.
+func TestService_evaluateAuditScope(t *testing.T) {
+	const internalCatalogId = "internal-baseline"
+
+	svc := &Service{
+		orchestratorClient: newOrchestratorClient(t,
+			WithAssessmentResults([]*assessment.AssessmentResult{
+				{
+					Id:                   evaluationtest.MockAssessmentResultId1,
+					MetricId:             evaluationtest.MockMetricId1,
+					Compliant:            true,
+					ResourceId:           "resource-1",
+					TargetOfEvaluationId: evaluationtest.MockToeId1,
+				},
+			}),
+		),
+		catalogControls: newTestControlCache(map[string]map[string]*orchestrator.Control{
+			evaluationtest.MockCatalog1.Id: {
+				evaluationtest.MockControl1.Id: evaluationtest.MockControl1,
+			},
+			internalCatalogId: {
+				evaluationtest.MockControl2.Id: evaluationtest.MockControl2,
+			},
+		}),
+	}
+
+	err := svc.evaluateAuditScope(context.Background(), evaluationtest.MockAuditScope1,
+		[]*orchestrator.Catalog{evaluationtest.MockCatalog1, {Id: internalCatalogId}},
+		evaluationTimeouts{scope: 5 * time.Minute})
+	assert.NoError(t, err)
+
+	evalResults, err := svc.orchestratorClient.ListEvaluationResults(context.Background(), connect.NewRequest(&orchestrator.ListEvaluationResultsRequest{}))
+	assert.NoError(t, err)
+
+	// Control 1 and its two sub-controls belong to the first catalog, control 2 and its sub-control to the second
+	byCatalog := make(map[string]int)
+	for _, result := range evalResults.Msg.Results {
+		byCatalog[result.ControlCatalogId]++
+	}
+	assert.Equal(t, map[string]int{evaluationtest.MockCatalog1.Id: 3, internalCatalogId: 2}, byCatalog)
+}
+
+func TestService_suppressedByMaintenance(t *testing.T) {
+	type fields struct {
+		orchestratorOpts []func(*mockOrchestratorHandler)
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   bool
+	}{
+		{
+			name: "no maintenance window",
+			want: false,
+		},
+		{
+			name: "maintenance window flags results",
+			fields: fields{
+				orchestratorOpts: []func(*mockOrchestratorHandler){
+					WithMaintenanceWindows(&orchestrator.MaintenanceWindow{
+						AuditScopeId: evaluationtest.MockAuditScope1.Id,
+						Mode:         orchestrator.MaintenanceMode_MAINTENANCE_MODE_FLAG,
+					}),
+				},
+			},
+			want: false,
+		},
+		{
+			name: "maintenance window suppresses evaluation",
+			fields: fields{
+				orchestratorOpts: []func(*mockOrchestratorHandler){
+					WithMaintenanceWindows(&orchestrator.MaintenanceWindow{
+						AuditScopeId: evaluationtest.MockAuditScope1.Id,
+						Mode:         orchestrator.MaintenanceMode_MAINTENANCE_MODE_SUPPRESS,
+					}),
+				},
+			},
+			want: true,
+		},
+		{
+			name: "maintenance windows unavailable",
+			fields: fields{
+				orchestratorOpts: []func(*mockOrchestratorHandler){
+					WithListMaintenanceWindowsError(connect.NewError(connect.CodeUnavailable, fmt.Errorf("unavailable"))),
+				},
+			},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &Service{
+				orchestratorClient: newOrchestratorClient(t, tt.fields.orchestratorOpts...),
+			}
+
+			got := svc.suppressedByMaintenance(context.Background(), evaluationtest.MockAuditScope1.Id)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+// TestService_evaluateAuditScope_maintenance covers that no results are stored while evaluation is suppressed by a
+// maintenance window.
+func TestService_evaluateAuditScope_maintenance(t *testing.T) {
+	svc := &Service{
+		orchestratorClient: newOrchestratorClient(t,
+			WithMaintenanceWindows(&orchestrator.MaintenanceWindow{
+				AuditScopeId: evaluationtest.MockAuditScope1.Id,
+				Mode:         orchestrator.MaintenanceMode_MAINTENANCE_MODE_SUPPRESS,
+			}),
+		),
+		catalogControls: newTestControlCache(map[string]map[string]*orchestrator.Control{
+			evaluationtest.MockCatalog1.Id: {
+				evaluationtest.MockControl1.Id: evaluationtest.MockControl1,
+			},
+		}),
+	}
+
+	err := svc.evaluateAuditScope(context.Background(), evaluationtest.MockAuditScope1,
+		[]*orchestrator.Catalog{evaluationtest.MockCatalog1}, evaluationTimeouts{scope: 5 * time.Minute})
+	assert.NoError(t, err)
+
+	evalResults, err := svc.orchestratorClient.ListEvaluationResults(context.Background(), connect.NewRequest(&orchestrator.ListEvaluationResultsRequest{}))
+	assert.NoError(t, err)
+	assert.Empty(t, evalResults.Msg.Results)
+}
+
 func TestService_evaluateSubcontrol(t *testing.T) {
 	type fields struct {
+		cfg                Config
 		orchestratorClient orchestratorconnect.OrchestratorClient
 		catalogControls    map[string]map[string]*orchestrator.Control
 	}
 	type args struct {
 		ctx        context.Context
 		auditScope *orchestrator.AuditScope
+		catalog    *orchestrator.Catalog
 		control    *orchestrator.Control
 	}
 	tests := []struct {
@@ -1171,6 +1800,7 @@ func TestService_evaluateSubcontrol(t *testing.T) {
 					TargetOfEvaluationId: evaluationtest.MockToeId1,
 					CatalogId:            orchestratortest.MockCatalogId1,
 				},
+				catalog: &orchestrator.Catalog{Id: orchestratortest.MockCatalogId1},
 				control: nil,
 			},
 			want:    assert.Nil[*evaluation.EvaluationResult],
@@ -1211,6 +1841,7 @@ func TestService_evaluateSubcontrol(t *testing.T) {
 					TargetOfEvaluationId: evaluationtest.MockToeId2,
 					CatalogId:            orchestratortest.MockCatalogId2,
 				},
+				catalog: &orchestrator.Catalog{Id: orchestratortest.MockCatalogId2},
 				control: &orchestrator.Control{
 					Id:       orchestratortest.MockControlId2,
 					Name:     "Mock Control 2",
@@ -1231,9 +1862,10 @@ func TestService_evaluateSubcontrol(t *testing.T) {
 					ControlCatalogId:     orchestratortest.MockCatalogId2,
 					ParentControlId:      nil,
 					Status:               evaluation.EvaluationStatus_EVALUATION_STATUS_PENDING,
-					Comment:              nil,
+					Comment:              new("No metrics are assigned to the control, it needs to be evaluated manually."),
 					ValidUntil:           nil,
 					Data:                 nil,
+					Narrative:            new("No metrics are assigned to control " + orchestratortest.MockControlId2 + ", it needs to be evaluated manually."),
 				}
 
 				return assert.Equal(t, want, got, protocmp.IgnoreFields(&evaluation.EvaluationResult{}, "id", "timestamp", "assessment_result_ids"))
@@ -1255,9 +1887,10 @@ func TestService_evaluateSubcontrol(t *testing.T) {
 					ControlCatalogId:     orchestratortest.MockCatalogId2,
 					ParentControlId:      nil,
 					Status:               evaluation.EvaluationStatus_EVALUATION_STATUS_PENDING,
-					Comment:              nil,
+					Comment:              new("No metrics are assigned to the control, it needs to be evaluated manually."),
 					ValidUntil:           nil,
 					Data:                 nil,
+					Narrative:            new("No metrics are assigned to control " + orchestratortest.MockControlId2 + ", it needs to be evaluated manually."),
 				}
 
 				return assert.Equal(t, want, res.Msg.Results[0], protocmp.IgnoreFields(&evaluation.EvaluationResult{}, "id", "timestamp", "assessment_result_ids"))
@@ -1265,7 +1898,7 @@ func TestService_evaluateSubcontrol(t *testing.T) {
 			wantErr: assert.NoError,
 		},
 		{
-			name: "happy path - metrics but ListAssessmentResults returns error => pending, stored",
+			name: "metrics but ListAssessmentResults returns error => error, stored with cause",
 			fields: func() fields {
 				handler := &mockOrchestratorHandler{
 					listAssessmentResultError: connect.NewError(connect.CodeInternal, fmt.Errorf("boom")),
@@ -1301,6 +1934,7 @@ func TestService_evaluateSubcontrol(t *testing.T) {
 					TargetOfEvaluationId: evaluationtest.MockToeId1,
 					CatalogId:            orchestratortest.MockCatalogId1,
 				},
+				catalog: &orchestrator.Catalog{Id: orchestratortest.MockCatalogId1},
 				control: &orchestrator.Control{
 					Id:   orchestratortest.MockControl2SubControlId1,
 					Name: "Mock Subcontrol 1",
@@ -1321,10 +1955,12 @@ func TestService_evaluateSubcontrol(t *testing.T) {
 					ControlId:            orchestratortest.MockControl2SubControlId1,
 					ControlCatalogId:     orchestratortest.MockCatalogId1,
 					ParentControlId:      nil,
-					Status:               evaluation.EvaluationStatus_EVALUATION_STATUS_PENDING,
-					Comment:              nil,
+					Status:               evaluation.EvaluationStatus_EVALUATION_STATUS_ERROR,
+					Comment:              new("The evaluation of the control failed, see the error cause for details."),
+					ErrorCause:           new("could not get assessment results: internal: boom"),
 					ValidUntil:           nil,
 					Data:                 nil,
+					Narrative:            new("The evaluation of control " + orchestratortest.MockControl2SubControlId1 + " failed: could not get assessment results: internal: boom."),
 				}
 
 				return assert.Equal(t, want, got, protocmp.IgnoreFields(&evaluation.EvaluationResult{}, "id", "timestamp", "assessment_result_ids"))
@@ -1345,10 +1981,12 @@ func TestService_evaluateSubcontrol(t *testing.T) {
 					ControlId:            orchestratortest.MockControl2SubControlId1,
 					ControlCatalogId:     orchestratortest.MockCatalogId1,
 					ParentControlId:      nil,
-					Status:               evaluation.EvaluationStatus_EVALUATION_STATUS_PENDING,
-					Comment:              nil,
+					Status:               evaluation.EvaluationStatus_EVALUATION_STATUS_ERROR,
+					Comment:              new("The evaluation of the control failed, see the error cause for details."),
+					ErrorCause:           new("could not get assessment results: internal: boom"),
 					ValidUntil:           nil,
 					Data:                 nil,
+					Narrative:            new("The evaluation of control " + orchestratortest.MockControl2SubControlId1 + " failed: could not get assessment results: internal: boom."),
 				}
 
 				return assert.Equal(t, want, res.Msg.Results[0], protocmp.IgnoreFields(&evaluation.EvaluationResult{}, "id", "timestamp", "assessment_result_ids"))
@@ -1392,6 +2030,7 @@ func TestService_evaluateSubcontrol(t *testing.T) {
 					TargetOfEvaluationId: evaluationtest.MockToeId1,
 					CatalogId:            evaluationtest.MockCatalogId1,
 				},
+				catalog: &orchestrator.Catalog{Id: evaluationtest.MockCatalogId1},
 				control: evaluationtest.MockSubcontrol11,
 			},
 			want: func(t *testing.T, got *evaluation.EvaluationResult, _ ...any) bool {
@@ -1412,6 +2051,7 @@ func TestService_evaluateSubcontrol(t *testing.T) {
 					Comment:              nil,
 					ValidUntil:           nil,
 					Data:                 nil,
+					Narrative:            new("1 metric was checked (" + evaluationtest.MockMetricId1 + ") on 2 resources."),
 				}
 
 				return assert.Equal(t, want, got, protocmp.IgnoreFields(&evaluation.EvaluationResult{}, "id", "timestamp", "assessment_result_ids"))
@@ -1437,11 +2077,116 @@ func TestService_evaluateSubcontrol(t *testing.T) {
 					Comment:              nil,
 					ValidUntil:           nil,
 					Data:                 nil,
+					Narrative:            new("1 metric was checked (" + evaluationtest.MockMetricId1 + ") on 2 resources."),
 				}
 
 				return assert.Equal(t, want, res.Msg.Results[0], protocmp.IgnoreFields(&evaluation.EvaluationResult{}, "id", "timestamp", "assessment_result_ids"))
 			},
 		},
+		{
+			name: "happy path - assessment results with outdated evidence => stale",
+			fields: func() fields {
+				return fields{
+					orchestratorClient: newOrchestratorClient(t,
+						WithAssessmentResults([]*assessment.AssessmentResult{
+							{
+								Id:                   "assessment-result-1",
+								MetricId:             evaluationtest.MockMetricId1,
+								Compliant:            true,
+								ResourceId:           "resource-1",
+								TargetOfEvaluationId: evaluationtest.MockToeId1,
+							},
+							{
+								Id:                   "assessment-result-2",
+								MetricId:             evaluationtest.MockMetricId1,
+								Compliant:            false,
+								ResourceId:           "resource-2",
+								TargetOfEvaluationId: evaluationtest.MockToeId1,
+								StaleAt:              timestamppb.New(time.Now().Add(-time.Hour)),
+							},
+						}),
+					),
+					catalogControls: map[string]map[string]*orchestrator.Control{
+						evaluationtest.MockCatalogId1: {
+							evaluationtest.MockControl1.GetId(): evaluationtest.MockControl1,
+						},
+					},
+				}
+			}(),
+			args: args{
+				ctx: context.Background(),
+				auditScope: &orchestrator.AuditScope{
+					Id:                   evaluationtest.MockAuditScopeId1,
+					TargetOfEvaluationId: evaluationtest.MockToeId1,
+					CatalogId:            evaluationtest.MockCatalogId1,
+				},
+				catalog: &orchestrator.Catalog{Id: evaluationtest.MockCatalogId1},
+				control: evaluationtest.MockSubcontrol11,
+			},
+			want: func(t *testing.T, got *evaluation.EvaluationResult, _ ...any) bool {
+				return assert.Equal(t, evaluation.EvaluationStatus_EVALUATION_STATUS_STALE, got.GetStatus()) &&
+					assert.Empty(t, got.GetFailingMetrics()) &&
+					assert.Equal(t, "1 metric was checked ("+evaluationtest.MockMetricId1+") on 2 resources. 1 assessment result is based on outdated evidence.", got.GetNarrative())
+			},
+			wantSvc: assert.NotNil[*Service],
+			wantErr: assert.NoError,
+		},
+		{
+			name: "happy path - assessment results with evidence older than the maximum evidence age => not compliant",
+			fields: func() fields {
+				return fields{
+					orchestratorClient: newOrchestratorClient(t,
+						WithAssessmentResults([]*assessment.AssessmentResult{
+							{
+								Id:                   "assessment-result-1",
+								MetricId:             evaluationtest.MockMetricId1,
+								Compliant:            true,
+								ResourceId:           "resource-1",
+								TargetOfEvaluationId: evaluationtest.MockToeId1,
+								CreatedAt:            timestamppb.New(time.Now().AddDate(0, 0, -100)),
+								History: []*assessment.Record{
+									{EvidenceId: evidencetest.MockEvidenceID1, EvidenceRecordedAt: timestamppb.New(time.Now().AddDate(0, 0, -1))},
+								},
+							},
+							{
+								Id:                   "assessment-result-2",
+								MetricId:             evaluationtest.MockMetricId1,
+								Compliant:            true,
+								ResourceId:           "resource-2",
+								TargetOfEvaluationId: evaluationtest.MockToeId1,
+								CreatedAt:            timestamppb.New(time.Now().AddDate(0, 0, -100)),
+							},
+						}),
+					),
+					catalogControls: map[string]map[string]*orchestrator.Control{
+						evaluationtest.MockCatalogId1: {
+							evaluationtest.MockControl1.GetId(): evaluationtest.MockControl1,
+						},
+					},
+				}
+			}(),
+			args: args{
+				ctx: context.Background(),
+				auditScope: &orchestrator.AuditScope{
+					Id:                   evaluationtest.MockAuditScopeId1,
+					TargetOfEvaluationId: evaluationtest.MockToeId1,
+					CatalogId:            evaluationtest.MockCatalogId1,
+				},
+				catalog: &orchestrator.Catalog{Id: evaluationtest.MockCatalogId1},
+				control: func() *orchestrator.Control {
+					control := proto.CloneOf(evaluationtest.MockSubcontrol11)
+					control.MaxEvidenceAgeDays = new(uint32(90))
+					return control
+				}(),
+			},
+			want: func(t *testing.T, got *evaluation.EvaluationResult, _ ...any) bool {
+				return assert.Equal(t, evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT, got.GetStatus()) &&
+					assert.Equal(t, []evaluation.EvaluationReason{evaluation.EvaluationReason_EVALUATION_REASON_FRESHNESS_VIOLATION}, got.GetReasons()) &&
+					assert.Equal(t, "1 assessment results are based on evidence older than the maximum evidence age of 90 days.", got.GetComment())
+			},
+			wantSvc: assert.NotNil[*Service],
+			wantErr: assert.NoError,
+		},
 		{
 			name: "happy path - assessment results include non-compliant => not compliant",
 			fields: func() fields {
@@ -1478,6 +2223,7 @@ func TestService_evaluateSubcontrol(t *testing.T) {
 					TargetOfEvaluationId: evaluationtest.MockToeId1,
 					CatalogId:            evaluationtest.MockCatalogId1,
 				},
+				catalog: &orchestrator.Catalog{Id: evaluationtest.MockCatalogId1},
 				control: evaluationtest.MockSubcontrol11,
 			},
 			want: func(t *testing.T, got *evaluation.EvaluationResult, _ ...any) bool {
@@ -1496,6 +2242,17 @@ func TestService_evaluateSubcontrol(t *testing.T) {
 					Comment:              nil,
 					ValidUntil:           nil,
 					Data:                 nil,
+					Narrative:            new("1 metric was checked (" + evaluationtest.MockMetricId1 + ") on 2 resources. Metric " + evaluationtest.MockMetricId1 + " failed for 1 resource, including resource-2."),
+					FailingMetrics: []*evaluation.FailingMetric{
+						{
+							MetricId:      evaluationtest.MockMetricId1,
+							ResultCount:   1,
+							ResourceCount: 1,
+							Resources: []*evaluation.FailingResource{
+								{ResourceId: "resource-2", AssessmentResultIds: []string{"assessment-result-2"}},
+							},
+						},
+					},
 				}
 				return assert.Equal(t, want, got, protocmp.IgnoreFields(&evaluation.EvaluationResult{}, "id", "timestamp", "assessment_result_ids"))
 			},
@@ -1518,6 +2275,17 @@ func TestService_evaluateSubcontrol(t *testing.T) {
 					Comment:              nil,
 					ValidUntil:           nil,
 					Data:                 nil,
+					Narrative:            new("1 metric was checked (" + evaluationtest.MockMetricId1 + ") on 2 resources. Metric " + evaluationtest.MockMetricId1 + " failed for 1 resource, including resource-2."),
+					FailingMetrics: []*evaluation.FailingMetric{
+						{
+							MetricId:      evaluationtest.MockMetricId1,
+							ResultCount:   1,
+							ResourceCount: 1,
+							Resources: []*evaluation.FailingResource{
+								{ResourceId: "resource-2", AssessmentResultIds: []string{"assessment-result-2"}},
+							},
+						},
+					},
 				}
 
 				return assert.Equal(t, 1, len(res.Msg.Results)) &&
@@ -1525,15 +2293,317 @@ func TestService_evaluateSubcontrol(t *testing.T) {
 			},
 			wantErr: assert.NoError,
 		},
+		{
+			name: "happy path - non-compliant assessment result with expired waiver => not compliant",
+			fields: func() fields {
+				return fields{
+					orchestratorClient: newOrchestratorClient(t,
+						WithAssessmentResults([]*assessment.AssessmentResult{
+							{
+								Id:                   "assessment-result-1",
+								MetricId:             evaluationtest.MockMetricId1,
+								Compliant:            true,
+								ResourceId:           "resource-1",
+								TargetOfEvaluationId: evaluationtest.MockToeId1,
+							},
+							{
+								Id:                   "assessment-result-2",
+								MetricId:             evaluationtest.MockMetricId1,
+								Compliant:            false,
+								ResourceId:           "resource-2",
+								TargetOfEvaluationId: evaluationtest.MockToeId1,
+								Waiver: &assessment.Waiver{
+									Justification: "Legacy resource, accepted risk",
+									ExpiresAt:     timestamppb.New(time.Now().Add(24 * time.Hour)),
+								},
+							},
+							{
+								Id:                   "assessment-result-3",
+								MetricId:             evaluationtest.MockMetricId1,
+								Compliant:            false,
+								ResourceId:           "resource-3",
+								TargetOfEvaluationId: evaluationtest.MockToeId1,
+								Waiver: &assessment.Waiver{
+									Justification: "Expired waiver",
+									ExpiresAt:     timestamppb.New(time.Now().Add(-24 * time.Hour)),
+								},
+							},
+						}),
+					),
+					catalogControls: map[string]map[string]*orchestrator.Control{
+						evaluationtest.MockCatalogId1: {
+							evaluationtest.MockControl1.GetId(): evaluationtest.MockControl1,
+						},
+					},
+				}
+			}(),
+			args: args{
+				ctx: context.Background(),
+				auditScope: &orchestrator.AuditScope{
+					Id:                   evaluationtest.MockAuditScopeId1,
+					TargetOfEvaluationId: evaluationtest.MockToeId1,
+					CatalogId:            evaluationtest.MockCatalogId1,
+				},
+				catalog: &orchestrator.Catalog{Id: evaluationtest.MockCatalogId1},
+				control: evaluationtest.MockSubcontrol11,
+			},
+			want: func(t *testing.T, got *evaluation.EvaluationResult, _ ...any) bool {
+				// The expired waiver does not count, so the control is still not compliant
+				return assert.Equal(t, 3, len(got.GetAssessmentResultIds())) &&
+					assert.Equal(t, evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT, got.GetStatus())
+			},
+			wantSvc: assert.NotNil[*Service],
+			wantErr: assert.NoError,
+		},
+		{
+			name: "happy path - only non-compliant assessment result is waived => compliant",
+			fields: func() fields {
+				return fields{
+					orchestratorClient: newOrchestratorClient(t,
+						WithAssessmentResults([]*assessment.AssessmentResult{
+							{
+								Id:                   "assessment-result-1",
+								MetricId:             evaluationtest.MockMetricId1,
+								Compliant:            true,
+								ResourceId:           "resource-1",
+								TargetOfEvaluationId: evaluationtest.MockToeId1,
+							},
+							{
+								Id:                   "assessment-result-2",
+								MetricId:             evaluationtest.MockMetricId1,
+								Compliant:            false,
+								ResourceId:           "resource-2",
+								TargetOfEvaluationId: evaluationtest.MockToeId1,
+								Waiver: &assessment.Waiver{
+									Justification: "Legacy resource, accepted risk",
+								},
+							},
+						}),
+					),
+					catalogControls: map[string]map[string]*orchestrator.Control{
+						evaluationtest.MockCatalogId1: {
+							evaluationtest.MockControl1.GetId(): evaluationtest.MockControl1,
+						},
+					},
+				}
+			}(),
+			args: args{
+				ctx: context.Background(),
+				auditScope: &orchestrator.AuditScope{
+					Id:                   evaluationtest.MockAuditScopeId1,
+					TargetOfEvaluationId: evaluationtest.MockToeId1,
+					CatalogId:            evaluationtest.MockCatalogId1,
+				},
+				catalog: &orchestrator.Catalog{Id: evaluationtest.MockCatalogId1},
+				control: evaluationtest.MockSubcontrol11,
+			},
+			want: func(t *testing.T, got *evaluation.EvaluationResult, _ ...any) bool {
+				return assert.Equal(t, 2, len(got.GetAssessmentResultIds())) &&
+					assert.Equal(t, evaluation.EvaluationStatus_EVALUATION_STATUS_COMPLIANT, got.GetStatus())
+			},
+			wantSvc: assert.NotNil[*Service],
+			wantErr: assert.NoError,
+		},
+		{
+			name: "happy path - non-compliant assessment result of another environment is ignored => compliant",
+			fields: func() fields {
+				return fields{
+					orchestratorClient: newOrchestratorClient(t,
+						WithAssessmentResults([]*assessment.AssessmentResult{
+							{
+								Id:                   "assessment-result-1",
+								MetricId:             evaluationtest.MockMetricId1,
+								Compliant:            true,
+								ResourceId:           "resource-1",
+								TargetOfEvaluationId: evaluationtest.MockToeId1,
+								Environment:          new("prod"),
+							},
+							{
+								Id:                   "assessment-result-2",
+								MetricId:             evaluationtest.MockMetricId1,
+								Compliant:            false,
+								ResourceId:           "resource-2",
+								TargetOfEvaluationId: evaluationtest.MockToeId1,
+								Environment:          new("staging"),
+							},
+						}),
+					),
+					catalogControls: map[string]map[string]*orchestrator.Control{
+						evaluationtest.MockCatalogId1: {
+							evaluationtest.MockControl1.GetId(): evaluationtest.MockControl1,
+						},
+					},
+				}
+			}(),
+			args: args{
+				ctx: context.Background(),
+				auditScope: &orchestrator.AuditScope{
+					Id:                   evaluationtest.MockAuditScopeId1,
+					TargetOfEvaluationId: evaluationtest.MockToeId1,
+					CatalogId:            evaluationtest.MockCatalogId1,
+					Environment:          new("prod"),
+				},
+				catalog: &orchestrator.Catalog{Id: evaluationtest.MockCatalogId1},
+				control: evaluationtest.MockSubcontrol11,
+			},
+			want: func(t *testing.T, got *evaluation.EvaluationResult, _ ...any) bool {
+				return assert.Equal(t, []string{"assessment-result-1"}, got.GetAssessmentResultIds()) &&
+					assert.Equal(t, evaluation.EvaluationStatus_EVALUATION_STATUS_COMPLIANT, got.GetStatus())
+			},
+			wantSvc: assert.NotNil[*Service],
+			wantErr: assert.NoError,
+		},
+		{
+			name: "happy path - sample of the assessment results",
+			fields: func() fields {
+				var results []*assessment.AssessmentResult
+				for i := range 3 {
+					results = append(results, &assessment.AssessmentResult{
+						Id:                   fmt.Sprintf("assessment-result-%d", i),
+						MetricId:             evaluationtest.MockMetricId1,
+						Compliant:            true,
+						ResourceId:           fmt.Sprintf("resource-%d", i),
+						TargetOfEvaluationId: evaluationtest.MockToeId1,
+					})
+				}
+
+				return fields{
+					cfg: Config{
+						SampleSize:       2,
+						SamplingStrategy: evaluation.SamplingStrategy_SAMPLING_STRATEGY_RANDOM,
+					},
+					orchestratorClient: newOrchestratorClient(t, WithAssessmentResults(results)),
+					catalogControls: map[string]map[string]*orchestrator.Control{
+						evaluationtest.MockCatalogId1: {
+							evaluationtest.MockControl1.GetId(): evaluationtest.MockControl1,
+						},
+					},
+				}
+			}(),
+			args: args{
+				ctx: context.Background(),
+				auditScope: &orchestrator.AuditScope{
+					Id:                   evaluationtest.MockAuditScopeId1,
+					TargetOfEvaluationId: evaluationtest.MockToeId1,
+					CatalogId:            evaluationtest.MockCatalogId1,
+				},
+				catalog: &orchestrator.Catalog{Id: evaluationtest.MockCatalogId1},
+				control: evaluationtest.MockSubcontrol11,
+			},
+			want: func(t *testing.T, got *evaluation.EvaluationResult, _ ...any) bool {
+				return assert.Equal(t, 2, len(got.GetAssessmentResultIds())) &&
+					assert.Equal(t, evaluation.EvaluationStatus_EVALUATION_STATUS_COMPLIANT, got.GetStatus()) &&
+					assert.Equal(t, &evaluation.EvaluationSample{
+						Strategy:        evaluation.SamplingStrategy_SAMPLING_STRATEGY_RANDOM,
+						PopulationSize:  3,
+						SampleSize:      2,
+						ConfidenceLevel: 0.95,
+						MarginOfError:   new(1.96 * math.Sqrt(0.25/2) * math.Sqrt(0.5)),
+					}, got.GetSample())
+			},
+			wantSvc: assert.NotNil[*Service],
+			wantErr: assert.NoError,
+		},
+		{
+			name: "happy path - includes the remediations of the control and its metrics",
+			fields: fields{
+				orchestratorClient: newOrchestratorClient(t,
+					WithAssessmentResults([]*assessment.AssessmentResult{
+						{
+							Id:                   "assessment-result-1",
+							MetricId:             evaluationtest.MockMetricId1,
+							Compliant:            false,
+							ResourceId:           "resource-1",
+							TargetOfEvaluationId: evaluationtest.MockToeId1,
+						},
+					}),
+				),
+				catalogControls: map[string]map[string]*orchestrator.Control{
+					evaluationtest.MockCatalogId1: {
+						evaluationtest.MockControl1.GetId(): evaluationtest.MockControl1,
+					},
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				auditScope: &orchestrator.AuditScope{
+					Id:                   evaluationtest.MockAuditScopeId1,
+					TargetOfEvaluationId: evaluationtest.MockToeId1,
+					CatalogId:            evaluationtest.MockCatalogId1,
+				},
+				catalog: &orchestrator.Catalog{Id: evaluationtest.MockCatalogId1},
+				control: &orchestrator.Control{
+					Id:              evaluationtest.MockControl1SubcontrolId11,
+					Name:            evaluationtest.MockControl1SubcontrolName11,
+					ParentControlId: new(evaluationtest.MockControlId1),
+					Metrics: []*assessment.Metric{{
+						Id:          evaluationtest.MockMetricId1,
+						Name:        evaluationtest.MockMetricName1,
+						Remediation: &assessment.Remediation{Text: "Enable boot logging."},
+					}},
+					Remediation: &assessment.Remediation{Text: "Log the boot process of all machines."},
+				},
+			},
+			want: func(t *testing.T, got *evaluation.EvaluationResult, _ ...any) bool {
+				return assert.Equal(t, evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT, got.GetStatus()) &&
+					assert.Equal(t, &assessment.Remediation{Text: "Log the boot process of all machines."}, got.GetRemediation()) &&
+					assert.Equal(t, 1, len(got.GetFailingMetrics())) &&
+					assert.Equal(t, &assessment.Remediation{Text: "Enable boot logging."}, got.GetFailingMetrics()[0].GetRemediation())
+			},
+			wantSvc: assert.NotNil[*Service],
+			wantErr: assert.NoError,
+		},
+		{
+			name: "happy path - no sample if all assessment results fit",
+			fields: fields{
+				cfg: Config{
+					SampleSize:       10,
+					SamplingStrategy: evaluation.SamplingStrategy_SAMPLING_STRATEGY_RANDOM,
+				},
+				orchestratorClient: newOrchestratorClient(t,
+					WithAssessmentResults([]*assessment.AssessmentResult{
+						{
+							Id:                   "assessment-result-1",
+							MetricId:             evaluationtest.MockMetricId1,
+							Compliant:            true,
+							ResourceId:           "resource-1",
+							TargetOfEvaluationId: evaluationtest.MockToeId1,
+						},
+					}),
+				),
+				catalogControls: map[string]map[string]*orchestrator.Control{
+					evaluationtest.MockCatalogId1: {
+						evaluationtest.MockControl1.GetId(): evaluationtest.MockControl1,
+					},
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				auditScope: &orchestrator.AuditScope{
+					Id:                   evaluationtest.MockAuditScopeId1,
+					TargetOfEvaluationId: evaluationtest.MockToeId1,
+					CatalogId:            evaluationtest.MockCatalogId1,
+				},
+				catalog: &orchestrator.Catalog{Id: evaluationtest.MockCatalogId1},
+				control: evaluationtest.MockSubcontrol11,
+			},
+			want: func(t *testing.T, got *evaluation.EvaluationResult, _ ...any) bool {
+				return assert.Equal(t, []string{"assessment-result-1"}, got.GetAssessmentResultIds()) &&
+					assert.Nil(t, got.GetSample())
+			},
+			wantSvc: assert.NotNil[*Service],
+			wantErr: assert.NoError,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			svc := &Service{
+				cfg:                tt.fields.cfg,
 				orchestratorClient: tt.fields.orchestratorClient,
-				catalogControls:    tt.fields.catalogControls,
+				catalogControls:    newTestControlCache(tt.fields.catalogControls),
 			}
 
-			got, gotErr := svc.evaluateSubcontrol(tt.args.ctx, tt.args.auditScope, tt.args.control)
+			got, gotErr := svc.evaluateSubcontrol(tt.args.ctx, tt.args.auditScope, tt.args.catalog, tt.args.control)
 
 			tt.want(t, got)
 			tt.wantErr(t, gotErr)
@@ -1673,6 +2743,7 @@ func TestService_StartEvaluation(t *testing.T) {
 					WithAuditScope(&orchestrator.AuditScope{
 						Id:                   evaluationtest.MockAuditScopeId1,
 						TargetOfEvaluationId: evaluationtest.MockToeId1,
+						CatalogId:            evaluationtest.MockCatalogId1,
 					})),
 				scheduler: gocron.NewScheduler(time.Local),
 			},
@@ -1753,6 +2824,38 @@ func TestService_StartEvaluation(t *testing.T) {
 				return assert.Equal(t, 10, got.scheduler.Jobs()[0].ScheduledInterval())
 			},
 		},
+		{
+			name: "happy path: interval recommended by the catalog",
+			args: args{
+				ctx: context.Background(),
+				req: connect.NewRequest(&evaluation.StartEvaluationRequest{
+					AuditScopeId: evaluationtest.MockAuditScopeId1,
+				}),
+			},
+			fields: fields{
+				orchestratorClient: newOrchestratorClient(t,
+					WithAuditScope(evaluationtest.MockAuditScope1),
+					WithControls(
+						[]*orchestrator.Control{evaluationtest.MockControl1, evaluationtest.MockControl2},
+					),
+					WithCatalog(&orchestrator.Catalog{
+						Id:   evaluationtest.MockCatalogId1,
+						Name: evaluationtest.MockCatalogName1,
+						EvaluationDefaults: &orchestrator.EvaluationDefaults{
+							Interval: new(int32(60)),
+						},
+					}),
+				),
+				scheduler: gocron.NewScheduler(time.Local),
+			},
+			want: func(t *testing.T, got *connect.Response[evaluation.StartEvaluationResponse], _ ...any) bool {
+				return assert.True(t, got.Msg.GetSuccessful())
+			},
+			wantErr: assert.NoError,
+			wantSvc: func(t *testing.T, got *Service, msgAndArgs ...any) bool {
+				return assert.Equal(t, 60, got.scheduler.Jobs()[0].ScheduledInterval())
+			},
+		},
 		{
 			name: "happy path",
 			args: args{
@@ -1782,7 +2885,8 @@ func TestService_StartEvaluation(t *testing.T) {
 				return assert.True(t, got.Msg.GetSuccessful())
 			},
 			wantSvc: func(t *testing.T, got *Service, msgAndArgs ...any) bool {
-				assert.Equal(t, 2, len(got.catalogControls[evaluationtest.MockCatalogId1]))
+				controls, _ := got.catalogControls.get(evaluationtest.MockCatalogId1)
+				assert.Equal(t, 2, len(controls))
 				return assert.Equal(t, 1, len(got.scheduler.Jobs()))
 			},
 			wantErr: assert.NoError,
@@ -1793,7 +2897,7 @@ func TestService_StartEvaluation(t *testing.T) {
 
 			svc := Service{
 				orchestratorClient: tt.fields.orchestratorClient,
-				catalogControls:    tt.fields.catalogControls,
+				catalogControls:    newTestControlCache(tt.fields.catalogControls),
 				scheduler:          tt.fields.scheduler,
 				authz:              tt.fields.authz,
 			}
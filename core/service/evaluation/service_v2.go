@@ -0,0 +1,247 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package evaluation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"confirmate.io/core/api/evaluation"
+	"confirmate.io/core/api/evaluation/evaluationconnect"
+	evaluationv2 "confirmate.io/core/api/evaluation/v2"
+	"confirmate.io/core/api/evaluation/v2/evaluationv2connect"
+	"confirmate.io/core/service"
+
+	"connectrpc.com/connect"
+	"google.golang.org/genproto/googleapis/api/httpbody"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// ServiceV2 implements version 2 of the evaluation API (see [evaluationv2connect.EvaluationHandler]). It is a
+// compatibility shim on top of a version 1 handler, usually a [Service]: requests are translated into their version 1
+// equivalent and responses back, so that both versions share one implementation including its access checks.
+type ServiceV2 struct {
+	evaluationv2connect.UnimplementedEvaluationHandler
+	v1 evaluationconnect.EvaluationHandler
+}
+
+// NewServiceV2 creates a new handler of version 2 of the evaluation API that delegates to the given version 1 handler.
+func NewServiceV2(v1 evaluationconnect.EvaluationHandler) (handler evaluationv2connect.EvaluationHandler) {
+	return &ServiceV2{v1: v1}
+}
+
+// StartEvaluation starts the periodic evaluation of an audit scope, see [Service.StartEvaluation].
+func (svc *ServiceV2) StartEvaluation(ctx context.Context, req *connect.Request[evaluationv2.StartEvaluationRequest]) (res *connect.Response[evaluationv2.StartEvaluationResponse], err error) {
+	var v1 *evaluation.StartEvaluationRequest
+
+	// Validate the request
+	if err = service.Validate(req); err != nil {
+		return nil, err
+	}
+
+	v1, err = startEvaluationRequestV1(req.Msg)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = svc.v1.StartEvaluation(ctx, connect.NewRequest(v1))
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&evaluationv2.StartEvaluationResponse{}), nil
+}
+
+// StopEvaluation stops the evaluation of an audit scope, see [Service.StopEvaluation].
+func (svc *ServiceV2) StopEvaluation(ctx context.Context, req *connect.Request[evaluation.StopEvaluationRequest]) (res *connect.Response[evaluation.StopEvaluationResponse], err error) {
+	return svc.v1.StopEvaluation(ctx, req)
+}
+
+// PauseEvaluation pauses the evaluation of an audit scope, see [Service.PauseEvaluation].
+func (svc *ServiceV2) PauseEvaluation(ctx context.Context, req *connect.Request[evaluation.PauseEvaluationRequest]) (res *connect.Response[evaluation.PauseEvaluationResponse], err error) {
+	return svc.v1.PauseEvaluation(ctx, req)
+}
+
+// ResumeEvaluation resumes the paused evaluation of an audit scope, see [Service.ResumeEvaluation].
+func (svc *ServiceV2) ResumeEvaluation(ctx context.Context, req *connect.Request[evaluation.ResumeEvaluationRequest]) (res *connect.Response[evaluation.ResumeEvaluationResponse], err error) {
+	return svc.v1.ResumeEvaluation(ctx, req)
+}
+
+// ListEvaluationJobs lists all running evaluation jobs, see [Service.ListEvaluationJobs].
+func (svc *ServiceV2) ListEvaluationJobs(ctx context.Context, req *connect.Request[evaluation.ListEvaluationJobsRequest]) (res *connect.Response[evaluationv2.ListEvaluationJobsResponse], err error) {
+	var (
+		v1   *connect.Response[evaluation.ListEvaluationJobsResponse]
+		jobs []*evaluationv2.EvaluationJob
+	)
+
+	v1, err = svc.v1.ListEvaluationJobs(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	jobs = make([]*evaluationv2.EvaluationJob, 0, len(v1.Msg.GetEvaluationJobs()))
+	for _, job := range v1.Msg.GetEvaluationJobs() {
+		jobs = append(jobs, &evaluationv2.EvaluationJob{
+			AuditScopeId: job.GetAuditScopeId(),
+			StartedAt:    job.GetStartedAt(),
+			Interval:     durationpb.New(time.Duration(job.GetInterval()) * time.Minute),
+			RunCount:     job.GetRunCount(),
+			LastRun:      job.GetLastRun(),
+			Paused:       job.GetPaused(),
+			PausedAt:     job.PausedAt,
+		})
+	}
+
+	return connect.NewResponse(&evaluationv2.ListEvaluationJobsResponse{
+		EvaluationJobs: jobs,
+	}), nil
+}
+
+// GetCoverage returns a coverage report of an audit scope, see [Service.GetCoverage].
+func (svc *ServiceV2) GetCoverage(ctx context.Context, req *connect.Request[evaluation.GetCoverageRequest]) (res *connect.Response[evaluation.Coverage], err error) {
+	return svc.v1.GetCoverage(ctx, req)
+}
+
+// GetControlEvaluationContext explains the precedence of manual and automatic evaluation for a control, see
+// [Service.GetControlEvaluationContext].
+func (svc *ServiceV2) GetControlEvaluationContext(ctx context.Context, req *connect.Request[evaluation.GetControlEvaluationContextRequest]) (res *connect.Response[evaluation.ControlEvaluationContext], err error) {
+	return svc.v1.GetControlEvaluationContext(ctx, req)
+}
+
+// CheckConsistency runs a consistency check, see [Service.CheckConsistency].
+func (svc *ServiceV2) CheckConsistency(ctx context.Context, req *connect.Request[evaluation.CheckConsistencyRequest]) (res *connect.Response[evaluation.ConsistencyReport], err error) {
+	return svc.v1.CheckConsistency(ctx, req)
+}
+
+// GetConsistencyReport returns the report of the latest consistency check, see [Service.GetConsistencyReport].
+func (svc *ServiceV2) GetConsistencyReport(ctx context.Context, req *connect.Request[evaluation.GetConsistencyReportRequest]) (res *connect.Response[evaluation.ConsistencyReport], err error) {
+	return svc.v1.GetConsistencyReport(ctx, req)
+}
+
+// SimulateEvaluation simulates the evaluation of an audit scope, see [Service.SimulateEvaluation].
+func (svc *ServiceV2) SimulateEvaluation(ctx context.Context, req *connect.Request[evaluation.SimulateEvaluationRequest]) (res *connect.Response[evaluation.SimulateEvaluationResponse], err error) {
+	return svc.v1.SimulateEvaluation(ctx, req)
+}
+
+// GetCalendarSubscription returns the subscription URL of the calendar feed of an audit scope, see
+// [Service.GetCalendarSubscription]. The URL refers to the feed of version 1.
+func (svc *ServiceV2) GetCalendarSubscription(ctx context.Context, req *connect.Request[evaluation.GetCalendarSubscriptionRequest]) (res *connect.Response[evaluation.CalendarSubscription], err error) {
+	return svc.v1.GetCalendarSubscription(ctx, req)
+}
+
+// GetCalendarFeed returns the calendar feed of an audit scope, see [Service.GetCalendarFeed].
+func (svc *ServiceV2) GetCalendarFeed(ctx context.Context, req *connect.Request[evaluation.GetCalendarFeedRequest]) (res *connect.Response[httpbody.HttpBody], err error) {
+	return svc.v1.GetCalendarFeed(ctx, req)
+}
+
+// EvaluateNow evaluates an audit scope once and returns the results, see [Service.EvaluateNow].
+func (svc *ServiceV2) EvaluateNow(ctx context.Context, req *connect.Request[evaluationv2.EvaluateNowRequest]) (res *connect.Response[evaluation.EvaluateNowResponse], err error) {
+	var v1 *evaluation.EvaluateNowRequest
+
+	// Validate the request
+	if err = service.Validate(req); err != nil {
+		return nil, err
+	}
+
+	v1, err = evaluateNowRequestV1(req.Msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return svc.v1.EvaluateNow(ctx, connect.NewRequest(v1))
+}
+
+// startEvaluationRequestV1 translates a version 2 request into version 1, which expects the interval in minutes, the
+// timeouts in seconds and the maximum evidence age in days.
+func startEvaluationRequestV1(req *evaluationv2.StartEvaluationRequest) (v1 *evaluation.StartEvaluationRequest, err error) {
+	var interval, timeout, maxAge int32
+
+	v1 = &evaluation.StartEvaluationRequest{
+		AuditScopeId:        req.GetAuditScopeId(),
+		ControlTimeouts:     make(map[string]int32, len(req.GetControlTimeouts())),
+		Locale:              req.Locale,
+		AggregationStrategy: req.AggregationStrategy,
+	}
+
+	if req.Interval != nil {
+		interval, err = durationIn(req.GetInterval(), time.Minute)
+		if err != nil {
+			return nil, service.NewInvalidFieldError("interval", fmt.Errorf("invalid interval: %w", err))
+		}
+		v1.Interval = &interval
+	}
+
+	if req.Timeout != nil {
+		timeout, err = durationIn(req.GetTimeout(), time.Second)
+		if err != nil {
+			return nil, service.NewInvalidFieldError("timeout", fmt.Errorf("invalid timeout: %w", err))
+		}
+		v1.Timeout = &timeout
+	}
+
+	for id, d := range req.GetControlTimeouts() {
+		v1.ControlTimeouts[id], err = durationIn(d, time.Second)
+		if err != nil {
+			return nil, service.NewInvalidFieldError("control_timeouts", fmt.Errorf("invalid timeout of control %s: %w", id, err))
+		}
+	}
+
+	if req.MaxEvidenceAge != nil {
+		maxAge, err = durationIn(req.GetMaxEvidenceAge(), 24*time.Hour)
+		if err != nil {
+			return nil, service.NewInvalidFieldError("max_evidence_age", fmt.Errorf("invalid maximum evidence age: %w", err))
+		}
+		v1.MaxEvidenceAgeDays = new(uint32(maxAge))
+	}
+
+	return v1, nil
+}
+
+// evaluateNowRequestV1 translates a version 2 request into version 1, which expects the timeout in seconds.
+func evaluateNowRequestV1(req *evaluationv2.EvaluateNowRequest) (v1 *evaluation.EvaluateNowRequest, err error) {
+	var timeout int32
+
+	v1 = &evaluation.EvaluateNowRequest{
+		AuditScopeId: req.GetAuditScopeId(),
+		Locale:       req.Locale,
+	}
+
+	if req.Timeout != nil {
+		timeout, err = durationIn(req.GetTimeout(), time.Second)
+		if err != nil {
+			return nil, service.NewInvalidFieldError("timeout", fmt.Errorf("invalid timeout: %w", err))
+		}
+		v1.Timeout = &timeout
+	}
+
+	return v1, nil
+}
+
+// durationIn returns the duration d as a number of the given unit. Durations that are not a multiple of the unit or
+// that exceed the range of version 1 are rejected rather than rounded.
+func durationIn(d *durationpb.Duration, unit time.Duration) (n int32, err error) {
+	if d.AsDuration()%unit != 0 {
+		return 0, fmt.Errorf("must be a multiple of %s", unit)
+	}
+	if d.AsDuration()/unit > math.MaxInt32 {
+		return 0, errors.New("too long")
+	}
+
+	return int32(d.AsDuration() / unit), nil
+}
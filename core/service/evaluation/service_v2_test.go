@@ -0,0 +1,335 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package evaluation
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"confirmate.io/core/api/evaluation"
+	"confirmate.io/core/api/evaluation/evaluationconnect"
+	evaluationv2 "confirmate.io/core/api/evaluation/v2"
+	"confirmate.io/core/service"
+	"confirmate.io/core/service/evaluation/evaluationtest"
+	"confirmate.io/core/util/assert"
+
+	"connectrpc.com/connect"
+	"github.com/go-co-op/gocron"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// recordingEvaluationHandler is a version 1 evaluation handler that records the last request of StartEvaluation and
+// EvaluateNow.
+type recordingEvaluationHandler struct {
+	evaluationconnect.UnimplementedEvaluationHandler
+
+	req            *evaluation.StartEvaluationRequest
+	evaluateNowReq *evaluation.EvaluateNowRequest
+	err            error
+}
+
+func (h *recordingEvaluationHandler) StartEvaluation(_ context.Context, req *connect.Request[evaluation.StartEvaluationRequest]) (*connect.Response[evaluation.StartEvaluationResponse], error) {
+	h.req = req.Msg
+	if h.err != nil {
+		return nil, h.err
+	}
+
+	return connect.NewResponse(&evaluation.StartEvaluationResponse{Successful: true}), nil
+}
+
+func (h *recordingEvaluationHandler) EvaluateNow(_ context.Context, req *connect.Request[evaluation.EvaluateNowRequest]) (*connect.Response[evaluation.EvaluateNowResponse], error) {
+	h.evaluateNowReq = req.Msg
+	if h.err != nil {
+		return nil, h.err
+	}
+
+	return connect.NewResponse(&evaluation.EvaluateNowResponse{
+		Status:    evaluation.EvaluationStatus_EVALUATION_STATUS_COMPLIANT,
+		Compliant: true,
+	}), nil
+}
+
+func TestServiceV2_StartEvaluation(t *testing.T) {
+	type fields struct {
+		v1 *recordingEvaluationHandler
+	}
+	type args struct {
+		req *connect.Request[evaluationv2.StartEvaluationRequest]
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    assert.Want[*connect.Response[evaluationv2.StartEvaluationResponse]]
+		wantV1  assert.Want[*evaluation.StartEvaluationRequest]
+		wantErr assert.WantErr
+	}{
+		{
+			name: "err: validation error",
+			fields: fields{
+				v1: &recordingEvaluationHandler{},
+			},
+			args: args{
+				req: connect.NewRequest(&evaluationv2.StartEvaluationRequest{
+					AuditScopeId: evaluationtest.MockAuditScopeId1,
+					Interval:     durationpb.New(30 * time.Second),
+				}),
+			},
+			want:   assert.Nil[*connect.Response[evaluationv2.StartEvaluationResponse]],
+			wantV1: assert.Nil[*evaluation.StartEvaluationRequest],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeInvalidArgument)
+			},
+		},
+		{
+			name: "err: interval is not a multiple of a minute",
+			fields: fields{
+				v1: &recordingEvaluationHandler{},
+			},
+			args: args{
+				req: connect.NewRequest(&evaluationv2.StartEvaluationRequest{
+					AuditScopeId: evaluationtest.MockAuditScopeId1,
+					Interval:     durationpb.New(90 * time.Second),
+				}),
+			},
+			want:   assert.Nil[*connect.Response[evaluationv2.StartEvaluationResponse]],
+			wantV1: assert.Nil[*evaluation.StartEvaluationRequest],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				assert.IsConnectError(t, err, connect.CodeInvalidArgument)
+				return assert.ErrorContains(t, err, "invalid interval: must be a multiple of 1m0s")
+			},
+		},
+		{
+			name: "err: control timeout is not a multiple of a second",
+			fields: fields{
+				v1: &recordingEvaluationHandler{},
+			},
+			args: args{
+				req: connect.NewRequest(&evaluationv2.StartEvaluationRequest{
+					AuditScopeId: evaluationtest.MockAuditScopeId1,
+					ControlTimeouts: map[string]*durationpb.Duration{
+						evaluationtest.MockControlId1: durationpb.New(1500 * time.Millisecond),
+					},
+				}),
+			},
+			want:   assert.Nil[*connect.Response[evaluationv2.StartEvaluationResponse]],
+			wantV1: assert.Nil[*evaluation.StartEvaluationRequest],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				assert.IsConnectError(t, err, connect.CodeInvalidArgument)
+				return assert.ErrorContains(t, err, "invalid timeout of control "+evaluationtest.MockControlId1)
+			},
+		},
+		{
+			name: "err: maximum evidence age is not a multiple of a day",
+			fields: fields{
+				v1: &recordingEvaluationHandler{},
+			},
+			args: args{
+				req: connect.NewRequest(&evaluationv2.StartEvaluationRequest{
+					AuditScopeId:   evaluationtest.MockAuditScopeId1,
+					MaxEvidenceAge: durationpb.New(36 * time.Hour),
+				}),
+			},
+			want:   assert.Nil[*connect.Response[evaluationv2.StartEvaluationResponse]],
+			wantV1: assert.Nil[*evaluation.StartEvaluationRequest],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				assert.IsConnectError(t, err, connect.CodeInvalidArgument)
+				return assert.ErrorContains(t, err, "invalid maximum evidence age: must be a multiple of 24h0m0s")
+			},
+		},
+		{
+			name: "err: error of version 1",
+			fields: fields{
+				v1: &recordingEvaluationHandler{err: service.ErrPermissionDenied},
+			},
+			args: args{
+				req: connect.NewRequest(&evaluationv2.StartEvaluationRequest{
+					AuditScopeId: evaluationtest.MockAuditScopeId1,
+				}),
+			},
+			want: assert.Nil[*connect.Response[evaluationv2.StartEvaluationResponse]],
+			wantV1: func(t *testing.T, got *evaluation.StartEvaluationRequest, msgAndArgs ...any) bool {
+				return assert.Equal(t, evaluationtest.MockAuditScopeId1, got.GetAuditScopeId())
+			},
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.ErrorIs(t, err, service.ErrPermissionDenied)
+			},
+		},
+		{
+			name: "happy path",
+			fields: fields{
+				v1: &recordingEvaluationHandler{},
+			},
+			args: args{
+				req: connect.NewRequest(&evaluationv2.StartEvaluationRequest{
+					AuditScopeId: evaluationtest.MockAuditScopeId1,
+					Interval:     durationpb.New(10 * time.Minute),
+					Timeout:      durationpb.New(2 * time.Minute),
+					ControlTimeouts: map[string]*durationpb.Duration{
+						evaluationtest.MockControlId1: durationpb.New(30 * time.Second),
+					},
+					Locale:              new("de"),
+					MaxEvidenceAge:      durationpb.New(90 * 24 * time.Hour),
+					AggregationStrategy: new(evaluation.AggregationStrategy_AGGREGATION_STRATEGY_ANY),
+				}),
+			},
+			want: assert.NotNil[*connect.Response[evaluationv2.StartEvaluationResponse]],
+			wantV1: func(t *testing.T, got *evaluation.StartEvaluationRequest, msgAndArgs ...any) bool {
+				want := &evaluation.StartEvaluationRequest{
+					AuditScopeId: evaluationtest.MockAuditScopeId1,
+					Interval:     new(int32(10)),
+					Timeout:      new(int32(120)),
+					ControlTimeouts: map[string]int32{
+						evaluationtest.MockControlId1: 30,
+					},
+					Locale:              new("de"),
+					MaxEvidenceAgeDays:  new(uint32(90)),
+					AggregationStrategy: new(evaluation.AggregationStrategy_AGGREGATION_STRATEGY_ANY),
+				}
+				return assert.Equal(t, want, got)
+			},
+			wantErr: assert.NoError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := NewServiceV2(tt.fields.v1)
+
+			got, err := svc.StartEvaluation(context.Background(), tt.args.req)
+			tt.want(t, got)
+			tt.wantV1(t, tt.fields.v1.req)
+			tt.wantErr(t, err)
+		})
+	}
+}
+
+func TestServiceV2_EvaluateNow(t *testing.T) {
+	type fields struct {
+		v1 *recordingEvaluationHandler
+	}
+	type args struct {
+		req *connect.Request[evaluationv2.EvaluateNowRequest]
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    assert.Want[*connect.Response[evaluation.EvaluateNowResponse]]
+		wantV1  assert.Want[*evaluation.EvaluateNowRequest]
+		wantErr assert.WantErr
+	}{
+		{
+			name: "err: validation error",
+			fields: fields{
+				v1: &recordingEvaluationHandler{},
+			},
+			args: args{
+				req: connect.NewRequest(&evaluationv2.EvaluateNowRequest{
+					AuditScopeId: evaluationtest.MockAuditScopeId1,
+					Timeout:      durationpb.New(2 * time.Hour),
+				}),
+			},
+			want:   assert.Nil[*connect.Response[evaluation.EvaluateNowResponse]],
+			wantV1: assert.Nil[*evaluation.EvaluateNowRequest],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeInvalidArgument)
+			},
+		},
+		{
+			name: "err: timeout is not a multiple of a second",
+			fields: fields{
+				v1: &recordingEvaluationHandler{},
+			},
+			args: args{
+				req: connect.NewRequest(&evaluationv2.EvaluateNowRequest{
+					AuditScopeId: evaluationtest.MockAuditScopeId1,
+					Timeout:      durationpb.New(1500 * time.Millisecond),
+				}),
+			},
+			want:   assert.Nil[*connect.Response[evaluation.EvaluateNowResponse]],
+			wantV1: assert.Nil[*evaluation.EvaluateNowRequest],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				assert.IsConnectError(t, err, connect.CodeInvalidArgument)
+				return assert.ErrorContains(t, err, "invalid timeout: must be a multiple of 1s")
+			},
+		},
+		{
+			name: "happy path",
+			fields: fields{
+				v1: &recordingEvaluationHandler{},
+			},
+			args: args{
+				req: connect.NewRequest(&evaluationv2.EvaluateNowRequest{
+					AuditScopeId: evaluationtest.MockAuditScopeId1,
+					Timeout:      durationpb.New(2 * time.Minute),
+					Locale:       new("de"),
+				}),
+			},
+			want: func(t *testing.T, got *connect.Response[evaluation.EvaluateNowResponse], msgAndArgs ...any) bool {
+				return assert.True(t, got.Msg.GetCompliant())
+			},
+			wantV1: func(t *testing.T, got *evaluation.EvaluateNowRequest, msgAndArgs ...any) bool {
+				want := &evaluation.EvaluateNowRequest{
+					AuditScopeId: evaluationtest.MockAuditScopeId1,
+					Timeout:      new(int32(120)),
+					Locale:       new("de"),
+				}
+				return assert.Equal(t, want, got)
+			},
+			wantErr: assert.NoError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := NewServiceV2(tt.fields.v1)
+
+			got, err := svc.EvaluateNow(context.Background(), tt.args.req)
+			tt.want(t, got)
+			tt.wantV1(t, tt.fields.v1.evaluateNowReq)
+			tt.wantErr(t, err)
+		})
+	}
+}
+
+func TestServiceV2_ListEvaluationJobs(t *testing.T) {
+	var (
+		s   = gocron.NewScheduler(time.Local)
+		err error
+	)
+
+	_, err = s.Every(10).Minute().Tag(evaluationtest.MockAuditScopeId1).Do(func() {})
+	assert.NoError(t, err)
+
+	svc := NewServiceV2(&Service{
+		scheduler: s,
+		authz:     &service.AuthorizationStrategyAllowAll{},
+	})
+
+	got, err := svc.ListEvaluationJobs(context.Background(), connect.NewRequest(&evaluation.ListEvaluationJobsRequest{}))
+	assert.NoError(t, err)
+
+	want := &evaluationv2.ListEvaluationJobsResponse{
+		EvaluationJobs: []*evaluationv2.EvaluationJob{
+			{
+				AuditScopeId: evaluationtest.MockAuditScopeId1,
+				Interval:     durationpb.New(10 * time.Minute),
+			},
+		},
+	}
+	assert.Equal(t, want, got.Msg, protocmp.IgnoreFields(&evaluationv2.EvaluationJob{}, "last_run", "started_at"))
+}
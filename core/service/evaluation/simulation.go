@@ -0,0 +1,250 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package evaluation
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"maps"
+	"time"
+
+	"confirmate.io/core/api/assessment"
+	"confirmate.io/core/api/evaluation"
+	"confirmate.io/core/api/orchestrator"
+	"confirmate.io/core/log"
+	"confirmate.io/core/service"
+	"confirmate.io/core/util/entity"
+
+	"connectrpc.com/connect"
+)
+
+// SimulateEvaluation recomputes the status of the controls of a catalog of the given audit scope with the proposed
+// metric configurations. The comparisons stored in the compliance details of the existing assessment results are
+// repeated with the proposed operators and target values. Nothing is persisted. Manual evaluation results are not
+// taken into account.
+func (svc *Service) SimulateEvaluation(ctx context.Context, req *connect.Request[evaluation.SimulateEvaluationRequest]) (res *connect.Response[evaluation.SimulateEvaluationResponse], err error) {
+	var (
+		allowed    bool
+		auditScope *orchestrator.AuditScope
+		catalog    *orchestrator.Catalog
+		parents    []*orchestrator.Control
+		subs       map[string][]*orchestrator.Control
+		metricIds  []entity.MetricID
+		proposed   map[string]*evaluation.ProposedMetricConfiguration
+		results    []*assessment.AssessmentResult
+		byMetric   map[string][]*simulatedResult
+		controls   []*evaluation.SimulatedControlStatus
+	)
+
+	// Validate the request
+	if err = service.Validate(req); err != nil {
+		return nil, err
+	}
+
+	// Check access via the configured auth strategy
+	allowed, _, err = checkAccess(ctx, svc.authz, orchestrator.RequestType_REQUEST_TYPE_GET, req.Msg.GetAuditScopeId(), orchestrator.ObjectType_OBJECT_TYPE_AUDIT_SCOPE)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if !allowed {
+		return nil, service.ErrPermissionDenied
+	}
+
+	// Retrieve the audit scope and the catalog to simulate
+	auditScope, catalog, err = svc.fetchAuditScopeCatalog(ctx, req.Msg.GetAuditScopeId(), (*entity.CatalogID)(req.Msg.CatalogId))
+	if err != nil {
+		return nil, err
+	}
+
+	// The token might be restricted to other targets of evaluation
+	if !service.AllowsTargetOfEvaluation(ctx, svc.authz, auditScope.GetTargetOfEvaluationId()) {
+		return nil, service.ErrPermissionDenied
+	}
+
+	proposed = make(map[string]*evaluation.ProposedMetricConfiguration, len(req.Msg.Configurations))
+	for _, c := range req.Msg.Configurations {
+		proposed[c.MetricId] = c
+	}
+
+	// Gather the relevant controls in the same way as the evaluation does
+	parents, subs = svc.relevantControls(ctx, auditScope, catalog)
+	for children := range maps.Values(subs) {
+		for _, sub := range children {
+			metricIds = append(metricIds, getMetricIds(getMetricsFromControl(sub))...)
+		}
+	}
+
+	results, err = svc.fetchAssessmentResults(ctx, auditScope, metricIds)
+	if err != nil {
+		slog.Error("Could not get assessment results", log.Err(err))
+		return nil, connect.NewError(connect.CodeInternal, errors.New("could not get assessment results from the orchestrator"))
+	}
+
+	// Simulate every assessment result once and group them by their metric
+	byMetric = make(map[string][]*simulatedResult)
+	for _, r := range results {
+		byMetric[r.GetMetricId()] = append(byMetric[r.GetMetricId()], simulate(r, proposed[r.GetMetricId()]))
+	}
+
+	for _, parent := range parents {
+		var (
+			children           []*evaluation.SimulatedControlStatus
+			current            = evaluation.EvaluationStatus_EVALUATION_STATUS_PENDING
+			simulatedStatus    = evaluation.EvaluationStatus_EVALUATION_STATUS_PENDING
+			currentCompliant   int
+			simulatedCompliant int
+		)
+
+		for _, sub := range subs[parent.Id] {
+			child := newSimulatedControlStatus(sub, byMetric)
+			children = append(children, child)
+
+			current = aggregateStatus(current, child.CurrentStatus)
+			simulatedStatus = aggregateStatus(simulatedStatus, child.SimulatedStatus)
+
+			if child.CurrentStatus == evaluation.EvaluationStatus_EVALUATION_STATUS_COMPLIANT {
+				currentCompliant++
+			}
+			if child.SimulatedStatus == evaluation.EvaluationStatus_EVALUATION_STATUS_COMPLIANT {
+				simulatedCompliant++
+			}
+		}
+
+		// The catalog might not require all sub-controls to be compliant
+		current = applyAggregationStrategy(catalog, current, currentCompliant)
+		simulatedStatus = applyAggregationStrategy(catalog, simulatedStatus, simulatedCompliant)
+
+		controls = append(controls, &evaluation.SimulatedControlStatus{
+			ControlId:       parent.Id,
+			CurrentStatus:   current,
+			SimulatedStatus: simulatedStatus,
+		})
+		controls = append(controls, children...)
+	}
+
+	res = connect.NewResponse(&evaluation.SimulateEvaluationResponse{
+		Controls: controls,
+	})
+	return
+}
+
+// simulatedResult contains the current and the simulated compliance of an assessment result.
+type simulatedResult struct {
+	id          string
+	current     bool
+	simulated   bool
+	unsimulated bool
+}
+
+// simulate repeats the comparisons of the assessment result with the proposed metric configuration. If there is no
+// proposed configuration or the comparisons cannot be repeated, the result keeps its current compliance. Waived
+// results are always considered compliant, as in the evaluation.
+func simulate(r *assessment.AssessmentResult, proposed *evaluation.ProposedMetricConfiguration) (s *simulatedResult) {
+	var waived = r.IsWaived(time.Now())
+
+	s = &simulatedResult{
+		id:        r.GetId(),
+		current:   r.GetCompliant() || waived,
+		simulated: r.GetCompliant() || waived,
+	}
+
+	if proposed == nil || waived {
+		return
+	}
+
+	if len(r.GetComplianceDetails()) == 0 {
+		s.unsimulated = true
+		return
+	}
+
+	s.simulated = true
+	for _, d := range r.GetComplianceDetails() {
+		ok, err := assessment.Compare(d.GetValue(), proposed.GetOperator(), proposed.GetTargetValue())
+		if err != nil {
+			slog.Debug("Could not simulate assessment result", slog.String("assessment result id", r.GetId()), log.Err(err))
+
+			s.unsimulated = true
+			s.simulated = s.current
+			return
+		}
+
+		s.simulated = s.simulated && ok
+	}
+
+	return
+}
+
+// newSimulatedControlStatus computes the current and the simulated status of a sub-control based on the simulated
+// assessment results of its metrics.
+func newSimulatedControlStatus(control *orchestrator.Control, byMetric map[string][]*simulatedResult) (status *evaluation.SimulatedControlStatus) {
+	var results []*simulatedResult
+
+	for _, id := range getMetricIds(getMetricsFromControl(control)) {
+		results = append(results, byMetric[id.String()]...)
+	}
+
+	status = &evaluation.SimulatedControlStatus{
+		ControlId:                      control.Id,
+		ParentControlId:                control.ParentControlId,
+		CurrentStatus:                  evaluation.EvaluationStatus_EVALUATION_STATUS_PENDING,
+		SimulatedStatus:                evaluation.EvaluationStatus_EVALUATION_STATUS_PENDING,
+		ChangedAssessmentResultIds:     []string{},
+		UnsimulatedAssessmentResultIds: []string{},
+	}
+
+	// If no assessment results are available, we are stuck at pending
+	if len(results) == 0 {
+		return
+	}
+
+	status.CurrentStatus = evaluation.EvaluationStatus_EVALUATION_STATUS_COMPLIANT
+	status.SimulatedStatus = evaluation.EvaluationStatus_EVALUATION_STATUS_COMPLIANT
+
+	for _, r := range results {
+		if !r.current {
+			status.CurrentStatus = evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT
+		}
+		if !r.simulated {
+			status.SimulatedStatus = evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT
+		}
+		if r.current != r.simulated {
+			status.ChangedAssessmentResultIds = append(status.ChangedAssessmentResultIds, r.id)
+		}
+		if r.unsimulated {
+			status.UnsimulatedAssessmentResultIds = append(status.UnsimulatedAssessmentResultIds, r.id)
+		}
+	}
+
+	return
+}
+
+// aggregateStatus combines the status of a parent control with the status of one of its sub-controls, in the same way
+// as the evaluation does.
+func aggregateStatus(status evaluation.EvaluationStatus, sub evaluation.EvaluationStatus) evaluation.EvaluationStatus {
+	switch status {
+	case evaluation.EvaluationStatus_EVALUATION_STATUS_PENDING:
+		return handlePending(&evaluation.EvaluationResult{Status: sub})
+	case evaluation.EvaluationStatus_EVALUATION_STATUS_COMPLIANT:
+		return handleCompliant(&evaluation.EvaluationResult{Status: sub})
+	case evaluation.EvaluationStatus_EVALUATION_STATUS_STALE:
+		return handleStale(&evaluation.EvaluationResult{Status: sub})
+	case evaluation.EvaluationStatus_EVALUATION_STATUS_ERROR:
+		return handleError(&evaluation.EvaluationResult{Status: sub})
+	default:
+		return status
+	}
+}
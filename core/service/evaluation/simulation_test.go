@@ -0,0 +1,202 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package evaluation
+
+import (
+	"context"
+	"testing"
+
+	"confirmate.io/core/api/assessment"
+	"confirmate.io/core/api/evaluation"
+	"confirmate.io/core/api/orchestrator"
+	"confirmate.io/core/api/orchestrator/orchestratorconnect"
+	"confirmate.io/core/service"
+	"confirmate.io/core/service/evaluation/evaluationtest"
+	"confirmate.io/core/util/assert"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func TestService_SimulateEvaluation(t *testing.T) {
+	var (
+		proposed = []*evaluation.ProposedMetricConfiguration{
+			{
+				MetricId:    evaluationtest.MockMetricId1,
+				Operator:    ">=",
+				TargetValue: structpb.NewNumberValue(90),
+			},
+		}
+	)
+
+	type fields struct {
+		orchestratorClient orchestratorconnect.OrchestratorClient
+		authz              service.AuthorizationStrategy
+	}
+	type args struct {
+		req *connect.Request[evaluation.SimulateEvaluationRequest]
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    assert.Want[*connect.Response[evaluation.SimulateEvaluationResponse]]
+		wantErr assert.WantErr
+	}{
+		{
+			name: "err: validation error",
+			args: args{
+				req: connect.NewRequest(&evaluation.SimulateEvaluationRequest{
+					AuditScopeId: evaluationtest.MockAuditScopeId1,
+				}),
+			},
+			want: assert.Nil[*connect.Response[evaluation.SimulateEvaluationResponse]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeInvalidArgument)
+			},
+		},
+		{
+			name: "err: permission denied",
+			fields: fields{
+				authz: &denyAuthorizationStrategy{},
+			},
+			args: args{
+				req: connect.NewRequest(&evaluation.SimulateEvaluationRequest{
+					AuditScopeId:   evaluationtest.MockAuditScopeId1,
+					Configurations: proposed,
+				}),
+			},
+			want: assert.Nil[*connect.Response[evaluation.SimulateEvaluationResponse]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodePermissionDenied)
+			},
+		},
+		{
+			name: "err: audit scope not found",
+			fields: fields{
+				orchestratorClient: newOrchestratorClient(t,
+					WithGetAuditScopeNotFoundError(connect.NewError(connect.CodeNotFound, service.ErrNotFound("audit scope"))),
+				),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			args: args{
+				req: connect.NewRequest(&evaluation.SimulateEvaluationRequest{
+					AuditScopeId:   evaluationtest.MockAuditScopeId1,
+					Configurations: proposed,
+				}),
+			},
+			want: assert.Nil[*connect.Response[evaluation.SimulateEvaluationResponse]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeNotFound)
+			},
+		},
+		{
+			name: "happy path",
+			fields: fields{
+				orchestratorClient: newOrchestratorClient(t,
+					WithAuditScope(evaluationtest.MockAuditScope1),
+					WithCatalog(evaluationtest.MockCatalog1),
+					WithControls([]*orchestrator.Control{evaluationtest.MockControl1}),
+					WithAssessmentResults([]*assessment.AssessmentResult{
+						{
+							Id:                   evaluationtest.MockAssessmentResultId1,
+							MetricId:             evaluationtest.MockMetricId1,
+							Compliant:            true,
+							ResourceId:           "resource-1",
+							TargetOfEvaluationId: evaluationtest.MockToeId1,
+							ComplianceDetails: []*assessment.ComparisonResult{
+								{
+									Property:    "retentionPeriod",
+									Value:       structpb.NewNumberValue(30),
+									Operator:    ">=",
+									TargetValue: structpb.NewNumberValue(30),
+									Success:     true,
+								},
+							},
+						},
+						{
+							Id:                   evaluationtest.MockAssessmentResultId2,
+							MetricId:             evaluationtest.MockMetricId1,
+							Compliant:            true,
+							ResourceId:           "resource-2",
+							TargetOfEvaluationId: evaluationtest.MockToeId1,
+						},
+					}),
+				),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			args: args{
+				req: connect.NewRequest(&evaluation.SimulateEvaluationRequest{
+					AuditScopeId:   evaluationtest.MockAuditScopeId1,
+					Configurations: proposed,
+				}),
+			},
+			want: func(t *testing.T, got *connect.Response[evaluation.SimulateEvaluationResponse], msgAndArgs ...any) bool {
+				want := &evaluation.SimulateEvaluationResponse{
+					Controls: []*evaluation.SimulatedControlStatus{
+						{
+							ControlId:       evaluationtest.MockControlId1,
+							CurrentStatus:   evaluation.EvaluationStatus_EVALUATION_STATUS_COMPLIANT,
+							SimulatedStatus: evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT,
+						},
+						{
+							ControlId:                      evaluationtest.MockControl1SubcontrolId11,
+							ParentControlId:                new(evaluationtest.MockControlId1),
+							CurrentStatus:                  evaluation.EvaluationStatus_EVALUATION_STATUS_COMPLIANT,
+							SimulatedStatus:                evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT,
+							ChangedAssessmentResultIds:     []string{evaluationtest.MockAssessmentResultId1},
+							UnsimulatedAssessmentResultIds: []string{evaluationtest.MockAssessmentResultId2},
+						},
+						{
+							ControlId:                      evaluationtest.MockControl1SubcontrolId12,
+							ParentControlId:                new(evaluationtest.MockControlId1),
+							CurrentStatus:                  evaluation.EvaluationStatus_EVALUATION_STATUS_PENDING,
+							SimulatedStatus:                evaluation.EvaluationStatus_EVALUATION_STATUS_PENDING,
+							ChangedAssessmentResultIds:     []string{},
+							UnsimulatedAssessmentResultIds: []string{},
+						},
+					},
+				}
+				return assert.Equal(t, want, got.Msg)
+			},
+			wantErr: assert.NoError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &Service{
+				orchestratorClient: tt.fields.orchestratorClient,
+				authz:              tt.fields.authz,
+				catalogControls:    newControlCache(0),
+			}
+
+			got, err := svc.SimulateEvaluation(context.Background(), tt.args.req)
+			tt.want(t, got)
+			tt.wantErr(t, err)
+		})
+	}
+}
+
+func Test_aggregateStatus(t *testing.T) {
+	assert.Equal(t, evaluation.EvaluationStatus_EVALUATION_STATUS_COMPLIANT,
+		aggregateStatus(evaluation.EvaluationStatus_EVALUATION_STATUS_PENDING, evaluation.EvaluationStatus_EVALUATION_STATUS_COMPLIANT))
+	assert.Equal(t, evaluation.EvaluationStatus_EVALUATION_STATUS_COMPLIANT,
+		aggregateStatus(evaluation.EvaluationStatus_EVALUATION_STATUS_COMPLIANT, evaluation.EvaluationStatus_EVALUATION_STATUS_PENDING))
+	assert.Equal(t, evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT,
+		aggregateStatus(evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT, evaluation.EvaluationStatus_EVALUATION_STATUS_COMPLIANT))
+	assert.Equal(t, evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT,
+		aggregateStatus(evaluation.EvaluationStatus_EVALUATION_STATUS_ERROR, evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT))
+}
@@ -0,0 +1,65 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package evaluation
+
+import (
+	"cmp"
+	"slices"
+
+	"confirmate.io/core/api/evaluation"
+	"confirmate.io/core/api/orchestrator"
+)
+
+// subStatus determines the catalog-defined sub-status of an evaluation result with the given status. The parts of the
+// result are the assessment results of a sub-control or the sub-controls of a control, of which compliant parts are
+// compliant. Only custom statuses of the catalog that refine the status are considered, in the order of their
+// precedence:
+//   - The first custom status whose aggregation rule matches the share of compliant parts is assigned.
+//   - Otherwise, the result inherits the sub-status of its sub-results with the highest precedence.
+//
+// If no custom status applies, nil is returned.
+func subStatus(catalog *orchestrator.Catalog, status evaluation.EvaluationStatus, compliant int, total int, subResults []*evaluation.EvaluationResult) *string {
+	var candidates []*orchestrator.CustomStatus
+
+	for _, s := range catalog.GetCustomStatuses() {
+		if s.GetStatus() == status {
+			candidates = append(candidates, s)
+		}
+	}
+
+	// Higher precedence first, custom statuses with the same precedence in the order of the catalog
+	slices.SortStableFunc(candidates, func(a, b *orchestrator.CustomStatus) int {
+		return cmp.Compare(b.GetPrecedence(), a.GetPrecedence())
+	})
+
+	if total > 0 {
+		for _, s := range candidates {
+			if s.AggregationRule != nil && s.AggregationRule.Matches(float64(compliant)/float64(total)) {
+				return new(s.GetName())
+			}
+		}
+	}
+
+	for _, s := range candidates {
+		if slices.ContainsFunc(subResults, func(r *evaluation.EvaluationResult) bool {
+			return r.GetSubStatus() == s.GetName()
+		}) {
+			return new(s.GetName())
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,154 @@
+// Copyright 2016-2025 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package evaluation
+
+import (
+	"testing"
+
+	"confirmate.io/core/api/evaluation"
+	"confirmate.io/core/api/orchestrator"
+	"confirmate.io/core/util/assert"
+)
+
+func Test_subStatus(t *testing.T) {
+	catalog := &orchestrator.Catalog{
+		CustomStatuses: []*orchestrator.CustomStatus{
+			{
+				Name:       "PARTIALLY_COMPLIANT",
+				Status:     evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT,
+				Precedence: 1,
+				AggregationRule: &orchestrator.StatusAggregationRule{
+					MinCompliantShare: new(0.5),
+				},
+			},
+			{
+				Name:   "NOT_APPLICABLE",
+				Status: evaluation.EvaluationStatus_EVALUATION_STATUS_COMPLIANT_MANUALLY,
+			},
+			{
+				Name:       "EXCEPTION_GRANTED",
+				Status:     evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT,
+				Precedence: 2,
+			},
+		},
+	}
+
+	type args struct {
+		catalog    *orchestrator.Catalog
+		status     evaluation.EvaluationStatus
+		compliant  int
+		total      int
+		subResults []*evaluation.EvaluationResult
+	}
+	tests := []struct {
+		name string
+		args args
+		want assert.Want[*string]
+	}{
+		{
+			name: "catalog without custom statuses",
+			args: args{
+				catalog:   &orchestrator.Catalog{},
+				status:    evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT,
+				compliant: 1,
+				total:     2,
+			},
+			want: assert.Nil[*string],
+		},
+		{
+			name: "aggregation rule matches",
+			args: args{
+				catalog:   catalog,
+				status:    evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT,
+				compliant: 2,
+				total:     3,
+			},
+			want: func(t *testing.T, got *string, msgAndArgs ...any) bool {
+				return assert.Equal(t, new("PARTIALLY_COMPLIANT"), got)
+			},
+		},
+		{
+			name: "aggregation rule does not match",
+			args: args{
+				catalog:   catalog,
+				status:    evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT,
+				compliant: 1,
+				total:     3,
+			},
+			want: assert.Nil[*string],
+		},
+		{
+			name: "aggregation rule does not apply to other statuses",
+			args: args{
+				catalog:   catalog,
+				status:    evaluation.EvaluationStatus_EVALUATION_STATUS_COMPLIANT,
+				compliant: 3,
+				total:     3,
+			},
+			want: assert.Nil[*string],
+		},
+		{
+			name: "rule takes precedence over inherited sub-status",
+			args: args{
+				catalog:   catalog,
+				status:    evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT,
+				compliant: 1,
+				total:     2,
+				subResults: []*evaluation.EvaluationResult{
+					{Status: evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT, SubStatus: new("EXCEPTION_GRANTED")},
+				},
+			},
+			want: func(t *testing.T, got *string, msgAndArgs ...any) bool {
+				return assert.Equal(t, new("PARTIALLY_COMPLIANT"), got)
+			},
+		},
+		{
+			name: "inherit sub-status with highest precedence",
+			args: args{
+				catalog:   catalog,
+				status:    evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT,
+				compliant: 0,
+				total:     2,
+				subResults: []*evaluation.EvaluationResult{
+					{Status: evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT, SubStatus: new("PARTIALLY_COMPLIANT")},
+					{Status: evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT, SubStatus: new("EXCEPTION_GRANTED")},
+				},
+			},
+			want: func(t *testing.T, got *string, msgAndArgs ...any) bool {
+				return assert.Equal(t, new("EXCEPTION_GRANTED"), got)
+			},
+		},
+		{
+			name: "sub-status refining another status is not inherited",
+			args: args{
+				catalog: catalog,
+				status:  evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT,
+				total:   2,
+				subResults: []*evaluation.EvaluationResult{
+					{Status: evaluation.EvaluationStatus_EVALUATION_STATUS_COMPLIANT_MANUALLY, SubStatus: new("NOT_APPLICABLE")},
+				},
+			},
+			want: assert.Nil[*string],
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := subStatus(tt.args.catalog, tt.args.status, tt.args.compliant, tt.args.total, tt.args.subResults)
+			tt.want(t, got)
+		})
+	}
+}
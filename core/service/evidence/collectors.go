@@ -0,0 +1,395 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package evidence
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"confirmate.io/core/api/evidence"
+	"confirmate.io/core/api/orchestrator"
+	"confirmate.io/core/auth"
+	"confirmate.io/core/persistence"
+	"confirmate.io/core/service"
+
+	"buf.build/go/protovalidate"
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// CollectorTokenPrefix is the prefix of all collector tokens. A token consists of the prefix, the ID of the collector
+// and its secret, separated by underscores, e.g., "cc_<collector-id>_<secret>".
+const CollectorTokenPrefix = "cc_"
+
+// ErrInvalidCollectorToken is returned if a request carries no valid collector token, although one is required.
+var ErrInvalidCollectorToken = connect.NewError(connect.CodeUnauthenticated, errors.New("invalid collector token"))
+
+// collectorKey is the context key of the collector that authenticated a stream of evidences.
+type collectorKey struct{}
+
+// CreateCollector creates a collector and issues its token. The token is only returned in the response; we only keep
+// the hash of its secret. The token is not scoped to targets of evaluation, i.e., the collector can store evidences for
+// all targets of evaluation.
+func (svc *Service) CreateCollector(ctx context.Context, req *connect.Request[evidence.CreateCollectorRequest]) (
+	res *connect.Response[evidence.CreateCollectorResponse], err error) {
+	var (
+		collector *evidence.Collector
+		token     string
+	)
+
+	// Validate the request. The ID of the collector is generated by us.
+	if err = service.Validate(req, protovalidate.WithFilter(service.IgnoreIDFilter)); err != nil {
+		return nil, err
+	}
+
+	// Check access via the configured auth strategy
+	if err = svc.checkCollectorAccess(ctx, orchestrator.RequestType_REQUEST_TYPE_CREATED); err != nil {
+		return nil, err
+	}
+
+	collector = &evidence.Collector{
+		Id:                    uuid.NewString(),
+		Name:                  req.Msg.Collector.GetName(),
+		Description:           req.Msg.Collector.Description,
+		MaxEvidencesPerMinute: req.Msg.Collector.MaxEvidencesPerMinute,
+		MaxEvidenceSize:       req.Msg.Collector.MaxEvidenceSize,
+		CreatedBy:             actorFromContext(ctx),
+		CreatedAt:             timestamppb.Now(),
+	}
+
+	token, collector.TokenHash, err = newCollectorToken(collector.Id)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	err = svc.db.Create(collector)
+	if err = service.HandleDatabaseError(err); err != nil {
+		return nil, err
+	}
+
+	slog.Info("Collector created",
+		slog.String("collector_id", collector.Id),
+		slog.String("name", collector.Name),
+		slog.String("user_id", collector.CreatedBy))
+
+	// The hash of the token is never returned
+	collector.TokenHash = ""
+
+	res = connect.NewResponse(&evidence.CreateCollectorResponse{
+		Collector: collector,
+		Token:     token,
+	})
+	return
+}
+
+// ListCollectors lists all collectors without the hashes of their tokens. Unless requested otherwise, the collectors
+// are ordered by their creation.
+func (svc *Service) ListCollectors(ctx context.Context, req *connect.Request[evidence.ListCollectorsRequest]) (
+	res *connect.Response[evidence.ListCollectorsResponse], err error) {
+	var (
+		collectors []*evidence.Collector
+		npt        string
+	)
+
+	// Validate the request
+	if err = service.Validate(req); err != nil {
+		return nil, err
+	}
+
+	// Check access via the configured auth strategy
+	if err = svc.checkCollectorAccess(ctx, orchestrator.RequestType_REQUEST_TYPE_LIST); err != nil {
+		return nil, err
+	}
+
+	// Set default ordering
+	if req.Msg.OrderBy == "" {
+		req.Msg.OrderBy = "created_at"
+		req.Msg.Asc = true
+	}
+
+	collectors, npt, err = service.PaginateStorage[*evidence.Collector](req.Msg, svc.db, service.DefaultPaginationOpts)
+	if err = service.HandleDatabaseError(err); err != nil {
+		return nil, err
+	}
+
+	for _, collector := range collectors {
+		collector.TokenHash = ""
+	}
+
+	res = connect.NewResponse(&evidence.ListCollectorsResponse{
+		Collectors:    collectors,
+		NextPageToken: npt,
+	})
+	return
+}
+
+// UpdateCollector updates the name, the description and the quotas of a collector. Its token is left untouched, see
+// [Service.RotateCollectorToken].
+func (svc *Service) UpdateCollector(ctx context.Context, req *connect.Request[evidence.UpdateCollectorRequest]) (
+	res *connect.Response[evidence.Collector], err error) {
+	var (
+		collector evidence.Collector
+	)
+
+	// Validate the request
+	if err = service.Validate(req); err != nil {
+		return nil, err
+	}
+
+	// Check access via the configured auth strategy
+	if err = svc.checkCollectorAccess(ctx, orchestrator.RequestType_REQUEST_TYPE_UPDATED); err != nil {
+		return nil, err
+	}
+
+	err = svc.db.Get(&collector, "id = ?", req.Msg.Collector.GetId())
+	if err = service.HandleDatabaseError(err, service.ErrNotFound("collector")); err != nil {
+		return nil, err
+	}
+
+	collector.Name = req.Msg.Collector.GetName()
+	collector.Description = req.Msg.Collector.Description
+	collector.MaxEvidencesPerMinute = req.Msg.Collector.MaxEvidencesPerMinute
+	collector.MaxEvidenceSize = req.Msg.Collector.MaxEvidenceSize
+
+	err = svc.db.Save(&collector)
+	if err = service.HandleDatabaseError(err); err != nil {
+		return nil, err
+	}
+
+	// The hash of the token is never returned
+	collector.TokenHash = ""
+
+	res = connect.NewResponse(&collector)
+	return
+}
+
+// RemoveCollector removes a collector, which revokes its token. The evidences of the collector are kept.
+func (svc *Service) RemoveCollector(ctx context.Context, req *connect.Request[evidence.RemoveCollectorRequest]) (
+	res *connect.Response[emptypb.Empty], err error) {
+	// Validate the request
+	if err = service.Validate(req); err != nil {
+		return nil, err
+	}
+
+	// Check access via the configured auth strategy
+	if err = svc.checkCollectorAccess(ctx, orchestrator.RequestType_REQUEST_TYPE_DELETED); err != nil {
+		return nil, err
+	}
+
+	err = svc.db.Delete(&evidence.Collector{}, "id = ?", req.Msg.GetCollectorId())
+	if err = service.HandleDatabaseError(err, service.ErrNotFound("collector")); err != nil {
+		return nil, err
+	}
+
+	svc.forgetCollectorUsage(req.Msg.GetCollectorId())
+
+	slog.Info("Collector removed",
+		slog.String("collector_id", req.Msg.GetCollectorId()),
+		slog.String("user_id", actorFromContext(ctx)))
+
+	res = connect.NewResponse(&emptypb.Empty{})
+	return
+}
+
+// RotateCollectorToken issues a new token for a collector. The previous token is revoked immediately, so that
+// streams of evidences that are authenticated with it fail with their next evidence.
+func (svc *Service) RotateCollectorToken(ctx context.Context, req *connect.Request[evidence.RotateCollectorTokenRequest]) (
+	res *connect.Response[evidence.RotateCollectorTokenResponse], err error) {
+	var (
+		collector evidence.Collector
+		token     string
+	)
+
+	// Validate the request
+	if err = service.Validate(req); err != nil {
+		return nil, err
+	}
+
+	// Check access via the configured auth strategy
+	if err = svc.checkCollectorAccess(ctx, orchestrator.RequestType_REQUEST_TYPE_UPDATED); err != nil {
+		return nil, err
+	}
+
+	err = svc.db.Get(&collector, "id = ?", req.Msg.GetCollectorId())
+	if err = service.HandleDatabaseError(err, service.ErrNotFound("collector")); err != nil {
+		return nil, err
+	}
+
+	token, collector.TokenHash, err = newCollectorToken(collector.Id)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	err = svc.db.Save(&collector)
+	if err = service.HandleDatabaseError(err); err != nil {
+		return nil, err
+	}
+
+	slog.Info("Collector token rotated",
+		slog.String("collector_id", collector.Id),
+		slog.String("user_id", actorFromContext(ctx)))
+
+	res = connect.NewResponse(&evidence.RotateCollectorTokenResponse{Token: token})
+	return
+}
+
+// ListCollectorUsage returns the usage statistics of all collectors since the start of the service, see
+// [Service.checkCollectorQuota] and [Service.recordCollectorUsage].
+func (svc *Service) ListCollectorUsage(ctx context.Context, req *connect.Request[evidence.ListCollectorUsageRequest]) (
+	res *connect.Response[evidence.ListCollectorUsageResponse], err error) {
+	// Validate the request
+	if err = service.Validate(req); err != nil {
+		return nil, err
+	}
+
+	// Check access via the configured auth strategy
+	if err = svc.checkCollectorAccess(ctx, orchestrator.RequestType_REQUEST_TYPE_LIST); err != nil {
+		return nil, err
+	}
+
+	res = connect.NewResponse(&evidence.ListCollectorUsageResponse{
+		Usage: svc.collectorUsage(),
+	})
+	return
+}
+
+// checkCollectorAccess checks whether the user of the request may manage collectors. Since the permission store has
+// no permissions for collectors, this is reserved to admins.
+func (svc *Service) checkCollectorAccess(ctx context.Context, reqType orchestrator.RequestType) (err error) {
+	var allowed bool
+
+	allowed, _ = svc.authz.CheckAccess(ctx, actorFromContext(ctx), reqType,
+		orchestrator.UserPermission_PERMISSION_ADMIN, "", orchestrator.ObjectType_OBJECT_TYPE_COLLECTOR)
+	if !allowed {
+		return service.ErrPermissionDenied
+	}
+
+	return nil
+}
+
+// authenticateCollector returns the collector whose token is contained in the Authorization header. If the header
+// contains no collector token, nil is returned, unless [Config.RequireCollectorTokens] is set. An invalid or revoked
+// collector token always results in [ErrInvalidCollectorToken].
+func (svc *Service) authenticateCollector(header http.Header) (collector *evidence.Collector, err error) {
+	var (
+		token  string
+		id     string
+		secret string
+		ok     bool
+	)
+
+	token, ok = strings.CutPrefix(header.Get("Authorization"), "Bearer ")
+	if ok {
+		token, ok = strings.CutPrefix(token, CollectorTokenPrefix)
+	}
+	if !ok {
+		if svc.cfg.RequireCollectorTokens {
+			return nil, ErrInvalidCollectorToken
+		}
+
+		return nil, nil
+	}
+
+	id, secret, ok = strings.Cut(token, "_")
+	if !ok || uuid.Validate(id) != nil {
+		return nil, ErrInvalidCollectorToken
+	}
+
+	collector = new(evidence.Collector)
+	err = svc.db.Get(collector, "id = ?", id)
+	if errors.Is(err, persistence.ErrRecordNotFound) {
+		// The collector was removed, which revokes its token
+		return nil, ErrInvalidCollectorToken
+	}
+	if err = service.HandleDatabaseError(err); err != nil {
+		return nil, err
+	}
+
+	if !hmac.Equal([]byte(collectorTokenHash(secret)), []byte(collector.TokenHash)) {
+		return nil, ErrInvalidCollectorToken
+	}
+
+	return collector, nil
+}
+
+// collectorFromRequest returns the collector that authenticated the request. The collector of a stream of evidences
+// is authenticated once for the whole stream and taken from the context, see [Service.StoreEvidences]. It is reloaded
+// for each evidence, so that changed quotas, a removal or a token rotation also apply to open streams.
+func (svc *Service) collectorFromRequest(ctx context.Context, header http.Header) (collector *evidence.Collector, err error) {
+	var (
+		authenticated *evidence.Collector
+		ok            bool
+	)
+
+	authenticated, ok = ctx.Value(collectorKey{}).(*evidence.Collector)
+	if !ok {
+		return svc.authenticateCollector(header)
+	}
+
+	collector = new(evidence.Collector)
+	err = svc.db.Get(collector, "id = ?", authenticated.Id)
+	if errors.Is(err, persistence.ErrRecordNotFound) {
+		return nil, ErrInvalidCollectorToken
+	}
+	if err = service.HandleDatabaseError(err); err != nil {
+		return nil, err
+	}
+
+	if collector.TokenHash != authenticated.TokenHash {
+		return nil, ErrInvalidCollectorToken
+	}
+
+	return collector, nil
+}
+
+// newCollectorToken generates a new token for the collector with the given ID. It returns the token and the hash of
+// its secret, which is stored instead of the token.
+func newCollectorToken(collectorId string) (token string, hash string, err error) {
+	var secret = make([]byte, 32)
+
+	if _, err = rand.Read(secret); err != nil {
+		return "", "", fmt.Errorf("could not generate collector token: %w", err)
+	}
+
+	token = hex.EncodeToString(secret)
+
+	return CollectorTokenPrefix + collectorId + "_" + token, collectorTokenHash(token), nil
+}
+
+// collectorTokenHash returns the hex-encoded SHA-256 hash of the secret of a collector token.
+func collectorTokenHash(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+
+	return hex.EncodeToString(sum[:])
+}
+
+// actorFromContext returns the ID of the user of the request, if it is authenticated.
+func actorFromContext(ctx context.Context) string {
+	if claims, ok := auth.ClaimsFromContext(ctx); ok {
+		return auth.GetConfirmateUserIDFromClaims(claims)
+	}
+	return ""
+}
@@ -0,0 +1,450 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package evidence
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"confirmate.io/core/api/evidence"
+	"confirmate.io/core/api/ontology"
+	"confirmate.io/core/auth"
+	"confirmate.io/core/persistence"
+	"confirmate.io/core/persistence/persistencetest"
+	"confirmate.io/core/service"
+	"confirmate.io/core/util/assert"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	testCollectorId     = "11111111-1111-1111-1111-111111111111"
+	testCollectorSecret = "secret"
+	testCollectorToken  = CollectorTokenPrefix + testCollectorId + "_" + testCollectorSecret
+)
+
+// withTestCollector creates the collector with the ID [testCollectorId], whose token is [testCollectorToken].
+func withTestCollector(t *testing.T, opts ...func(c *evidence.Collector)) func(db persistence.DB) {
+	return func(db persistence.DB) {
+		c := &evidence.Collector{
+			Id:        testCollectorId,
+			Name:      "collector",
+			TokenHash: collectorTokenHash(testCollectorSecret),
+		}
+		for _, o := range opts {
+			o(c)
+		}
+
+		assert.NoError(t, db.Create(c))
+	}
+}
+
+// bearer returns a header with the given bearer token.
+func bearer(token string) http.Header {
+	return http.Header{"Authorization": []string{"Bearer " + token}}
+}
+
+func TestService_CreateCollector(t *testing.T) {
+	type fields struct {
+		db    persistence.DB
+		authz service.AuthorizationStrategy
+	}
+	type args struct {
+		ctx context.Context
+		req *evidence.CreateCollectorRequest
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    assert.Want[*connect.Response[evidence.CreateCollectorResponse]]
+		wantErr assert.WantErr
+	}{
+		{
+			name: "validation error",
+			args: args{
+				ctx: context.Background(),
+				req: &evidence.CreateCollectorRequest{Collector: &evidence.Collector{}},
+			},
+			want: assert.Nil[*connect.Response[evidence.CreateCollectorResponse]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeInvalidArgument)
+			},
+		},
+		{
+			name: "permission denied",
+			fields: fields{
+				db:    persistencetest.NewInMemoryDB(t, types, nil),
+				authz: &service.AuthorizationStrategyPermissionStore{},
+			},
+			args: args{
+				ctx: context.Background(),
+				req: &evidence.CreateCollectorRequest{Collector: &evidence.Collector{Name: "collector"}},
+			},
+			want: assert.Nil[*connect.Response[evidence.CreateCollectorResponse]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodePermissionDenied)
+			},
+		},
+		{
+			name: "happy path: admin",
+			fields: fields{
+				db:    persistencetest.NewInMemoryDB(t, types, nil),
+				authz: &service.AuthorizationStrategyPermissionStore{},
+			},
+			args: args{
+				ctx: auth.WithClaims(context.Background(), &auth.OAuthClaims{IsAdminToken: true}),
+				req: &evidence.CreateCollectorRequest{Collector: &evidence.Collector{
+					Name:                  "collector",
+					MaxEvidencesPerMinute: new(int32(60)),
+				}},
+			},
+			want: func(t *testing.T, got *connect.Response[evidence.CreateCollectorResponse], msgAndArgs ...any) bool {
+				return assert.Equal(t, "collector", got.Msg.Collector.Name) &&
+					assert.Equal(t, int32(60), got.Msg.Collector.GetMaxEvidencesPerMinute()) &&
+					assert.Empty(t, got.Msg.Collector.TokenHash) &&
+					assert.True(t, strings.HasPrefix(got.Msg.Token, CollectorTokenPrefix+got.Msg.Collector.Id+"_"))
+			},
+			wantErr: assert.NoError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &Service{
+				db:    tt.fields.db,
+				authz: tt.fields.authz,
+			}
+
+			got, err := svc.CreateCollector(tt.args.ctx, connect.NewRequest(tt.args.req))
+			tt.want(t, got)
+			tt.wantErr(t, err)
+		})
+	}
+}
+
+func TestService_CreateCollector_token(t *testing.T) {
+	var (
+		db  = persistencetest.NewInMemoryDB(t, types, nil)
+		svc = &Service{db: db, authz: &service.AuthorizationStrategyAllowAll{}}
+	)
+
+	res, err := svc.CreateCollector(context.Background(), connect.NewRequest(&evidence.CreateCollectorRequest{
+		Collector: &evidence.Collector{Name: "collector"},
+	}))
+	assert.NoError(t, err)
+
+	// The issued token authenticates the collector
+	got, err := svc.authenticateCollector(bearer(res.Msg.Token))
+	assert.NoError(t, err)
+	assert.Equal(t, res.Msg.Collector.Id, got.GetId())
+}
+
+func TestService_ListCollectors(t *testing.T) {
+	var (
+		db  = persistencetest.NewInMemoryDB(t, types, nil, withTestCollector(t))
+		svc = &Service{db: db, authz: &service.AuthorizationStrategyAllowAll{}}
+	)
+
+	res, err := svc.ListCollectors(context.Background(), connect.NewRequest(&evidence.ListCollectorsRequest{}))
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(res.Msg.Collectors))
+	assert.Equal(t, testCollectorId, res.Msg.Collectors[0].Id)
+	assert.Empty(t, res.Msg.Collectors[0].TokenHash)
+}
+
+func TestService_UpdateCollector(t *testing.T) {
+	type args struct {
+		req *evidence.UpdateCollectorRequest
+	}
+	tests := []struct {
+		name    string
+		db      persistence.DB
+		args    args
+		want    assert.Want[*connect.Response[evidence.Collector]]
+		wantErr assert.WantErr
+	}{
+		{
+			name: "not found",
+			db:   persistencetest.NewInMemoryDB(t, types, nil),
+			args: args{
+				req: &evidence.UpdateCollectorRequest{Collector: &evidence.Collector{Id: testCollectorId, Name: "other"}},
+			},
+			want: assert.Nil[*connect.Response[evidence.Collector]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeNotFound)
+			},
+		},
+		{
+			name: "happy path",
+			db:   persistencetest.NewInMemoryDB(t, types, nil, withTestCollector(t)),
+			args: args{
+				req: &evidence.UpdateCollectorRequest{Collector: &evidence.Collector{
+					Id:              testCollectorId,
+					Name:            "other",
+					MaxEvidenceSize: new(int64(1024)),
+					TokenHash:       "ignored",
+				}},
+			},
+			want: func(t *testing.T, got *connect.Response[evidence.Collector], msgAndArgs ...any) bool {
+				return assert.Equal(t, "other", got.Msg.Name) &&
+					assert.Equal(t, int64(1024), got.Msg.GetMaxEvidenceSize()) &&
+					assert.Empty(t, got.Msg.TokenHash)
+			},
+			wantErr: assert.NoError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &Service{db: tt.db, authz: &service.AuthorizationStrategyAllowAll{}}
+
+			got, err := svc.UpdateCollector(context.Background(), connect.NewRequest(tt.args.req))
+			tt.want(t, got)
+			tt.wantErr(t, err)
+
+			// The token is left untouched
+			if err == nil {
+				_, err = svc.authenticateCollector(bearer(testCollectorToken))
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestService_RemoveCollector(t *testing.T) {
+	tests := []struct {
+		name    string
+		db      persistence.DB
+		want    assert.Want[*connect.Response[emptypb.Empty]]
+		wantErr assert.WantErr
+	}{
+		{
+			name: "not found",
+			db:   persistencetest.NewInMemoryDB(t, types, nil),
+			want: assert.Nil[*connect.Response[emptypb.Empty]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeNotFound)
+			},
+		},
+		{
+			name:    "happy path",
+			db:      persistencetest.NewInMemoryDB(t, types, nil, withTestCollector(t)),
+			want:    assert.NotNil[*connect.Response[emptypb.Empty]],
+			wantErr: assert.NoError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &Service{db: tt.db, authz: &service.AuthorizationStrategyAllowAll{}}
+
+			got, err := svc.RemoveCollector(context.Background(), connect.NewRequest(&evidence.RemoveCollectorRequest{
+				CollectorId: testCollectorId,
+			}))
+			tt.want(t, got)
+			tt.wantErr(t, err)
+
+			// The token of the collector is revoked
+			_, err = svc.authenticateCollector(bearer(testCollectorToken))
+			assert.ErrorIs(t, err, ErrInvalidCollectorToken)
+		})
+	}
+}
+
+func TestService_RotateCollectorToken(t *testing.T) {
+	var (
+		db  = persistencetest.NewInMemoryDB(t, types, nil, withTestCollector(t))
+		svc = &Service{db: db, authz: &service.AuthorizationStrategyAllowAll{}}
+	)
+
+	res, err := svc.RotateCollectorToken(context.Background(), connect.NewRequest(&evidence.RotateCollectorTokenRequest{
+		CollectorId: testCollectorId,
+	}))
+	assert.NoError(t, err)
+
+	// Only the new token is valid
+	_, err = svc.authenticateCollector(bearer(testCollectorToken))
+	assert.ErrorIs(t, err, ErrInvalidCollectorToken)
+
+	got, err := svc.authenticateCollector(bearer(res.Msg.Token))
+	assert.NoError(t, err)
+	assert.Equal(t, testCollectorId, got.GetId())
+}
+
+func TestService_authenticateCollector(t *testing.T) {
+	type fields struct {
+		cfg Config
+	}
+	type args struct {
+		header http.Header
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    assert.Want[*evidence.Collector]
+		wantErr assert.WantErr
+	}{
+		{
+			name:    "no token",
+			args:    args{header: http.Header{}},
+			want:    assert.Nil[*evidence.Collector],
+			wantErr: assert.NoError,
+		},
+		{
+			name:    "other token",
+			args:    args{header: bearer("eyJhbGciOi")},
+			want:    assert.Nil[*evidence.Collector],
+			wantErr: assert.NoError,
+		},
+		{
+			name:   "no token, but required",
+			fields: fields{cfg: Config{RequireCollectorTokens: true}},
+			args:   args{header: http.Header{}},
+			want:   assert.Nil[*evidence.Collector],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeUnauthenticated)
+			},
+		},
+		{
+			name: "malformed token",
+			args: args{header: bearer(CollectorTokenPrefix + "collector")},
+			want: assert.Nil[*evidence.Collector],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.ErrorIs(t, err, ErrInvalidCollectorToken)
+			},
+		},
+		{
+			name: "unknown collector",
+			args: args{header: bearer(CollectorTokenPrefix + uuid.NewString() + "_" + testCollectorSecret)},
+			want: assert.Nil[*evidence.Collector],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.ErrorIs(t, err, ErrInvalidCollectorToken)
+			},
+		},
+		{
+			name: "wrong secret",
+			args: args{header: bearer(CollectorTokenPrefix + testCollectorId + "_wrong")},
+			want: assert.Nil[*evidence.Collector],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.ErrorIs(t, err, ErrInvalidCollectorToken)
+			},
+		},
+		{
+			name:   "happy path",
+			fields: fields{cfg: Config{RequireCollectorTokens: true}},
+			args:   args{header: bearer(testCollectorToken)},
+			want: func(t *testing.T, got *evidence.Collector, msgAndArgs ...any) bool {
+				return assert.Equal(t, testCollectorId, got.GetId())
+			},
+			wantErr: assert.NoError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &Service{
+				db:  persistencetest.NewInMemoryDB(t, types, nil, withTestCollector(t)),
+				cfg: tt.fields.cfg,
+			}
+
+			got, err := svc.authenticateCollector(tt.args.header)
+			tt.want(t, got)
+			tt.wantErr(t, err)
+		})
+	}
+}
+
+func TestService_StoreEvidence_collector(t *testing.T) {
+	var (
+		db = persistencetest.NewInMemoryDB(t, types, nil, withTestCollector(t, func(c *evidence.Collector) {
+			c.MaxEvidencesPerMinute = new(int32(1))
+		}))
+		svc = &Service{
+			db:              db,
+			channelEvidence: make(chan *evidence.Evidence, defaultEvidenceQueueSize),
+		}
+		newRequest = func(token string) *connect.Request[evidence.StoreEvidenceRequest] {
+			req := connect.NewRequest(&evidence.StoreEvidenceRequest{Evidence: &evidence.Evidence{
+				Id:                   uuid.NewString(),
+				Timestamp:            timestamppb.Now(),
+				TargetOfEvaluationId: uuid.NewString(),
+				ToolId:               "MockTool1",
+				Resource:             ontology.ProtoResource(&ontology.VirtualMachine{Id: "vm-1", Name: "vm-1"}),
+				// Collectors cannot claim to be another collector
+				CollectorId: new(uuid.NewString()),
+			}})
+			if token != "" {
+				req.Header().Set("Authorization", "Bearer "+token)
+			}
+			return req
+		}
+	)
+
+	// Evidences stored with a collector token are attributed to the collector
+	req := newRequest(testCollectorToken)
+	_, err := svc.StoreEvidence(context.Background(), req)
+	assert.NoError(t, err)
+
+	stored := assert.InDB[evidence.Evidence](t, db, req.Msg.Evidence.Id)
+	assert.Equal(t, testCollectorId, stored.GetCollectorId())
+
+	// The second evidence within the same minute exceeds the quota of the collector
+	_, err = svc.StoreEvidence(context.Background(), newRequest(testCollectorToken))
+	assert.IsConnectError(t, err, connect.CodeResourceExhausted)
+
+	// Evidences stored without a collector token are not attributed to any collector
+	req = newRequest("")
+	_, err = svc.StoreEvidence(context.Background(), req)
+	assert.NoError(t, err)
+
+	stored = assert.InDB[evidence.Evidence](t, db, req.Msg.Evidence.Id)
+	assert.Nil(t, stored.CollectorId)
+
+	usage := svc.collectorUsage()
+	assert.Equal(t, 1, len(usage))
+	assert.Equal(t, int64(1), usage[0].StoredEvidences)
+	assert.Equal(t, int64(1), usage[0].RejectedEvidences)
+}
+
+func TestService_collectorFromRequest(t *testing.T) {
+	var (
+		db            = persistencetest.NewInMemoryDB(t, types, nil, withTestCollector(t))
+		svc           = &Service{db: db, authz: &service.AuthorizationStrategyAllowAll{}}
+		authenticated *evidence.Collector
+		ctx           context.Context
+	)
+
+	// The collector of a stream is taken from the context, regardless of the header of the single request
+	authenticated, err := svc.authenticateCollector(bearer(testCollectorToken))
+	assert.NoError(t, err)
+	ctx = context.WithValue(context.Background(), collectorKey{}, authenticated)
+
+	got, err := svc.collectorFromRequest(ctx, http.Header{})
+	assert.NoError(t, err)
+	assert.Equal(t, testCollectorId, got.GetId())
+
+	// Rotating the token revokes it for open streams as well
+	_, err = svc.RotateCollectorToken(context.Background(), connect.NewRequest(&evidence.RotateCollectorTokenRequest{
+		CollectorId: testCollectorId,
+	}))
+	assert.NoError(t, err)
+
+	_, err = svc.collectorFromRequest(ctx, http.Header{})
+	assert.ErrorIs(t, err, ErrInvalidCollectorToken)
+}
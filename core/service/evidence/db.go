@@ -16,10 +16,25 @@
 package evidence
 
 import (
+	"time"
+
 	"confirmate.io/core/api/evidence"
+	"confirmate.io/core/persistence"
 )
 
 var types = []any{
 	&evidence.Evidence{},
 	&evidence.ResourceSnapshot{},
+	&evidence.ResourceBlob{},
+	&evidence.Pseudonym{},
+	&evidence.Collector{},
+}
+
+// immutableTypes returns the types that cannot be updated or deleted within the given retention period, if the
+// append-only mode is enabled, see [Config.ImmutableRetention].
+func immutableTypes(retention time.Duration) []persistence.ImmutableType {
+	return []persistence.ImmutableType{
+		{Model: &evidence.Evidence{}, Column: "created_at", Retention: retention},
+		{Model: &evidence.ResourceBlob{}},
+	}
 }
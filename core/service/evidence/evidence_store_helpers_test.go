@@ -100,6 +100,10 @@ func (nilAssessmentClient) AssessEvidences(context.Context) *connect.BidiStreamF
 	return nil
 }
 
+func (nilAssessmentClient) ReassessEvidences(context.Context, *connect.Request[assessment.ReassessEvidencesRequest]) (*connect.Response[assessment.ReassessEvidencesResponse], error) {
+	return nil, errors.New("not implemented")
+}
+
 // fakeReceive describes the next Receive result for a fake stream.
 type fakeReceive struct {
 	req *evidence.StoreEvidenceRequest
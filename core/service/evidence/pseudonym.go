@@ -0,0 +1,295 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package evidence
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log/slog"
+	"regexp"
+	"slices"
+	"strings"
+
+	"confirmate.io/core/api/evidence"
+	"confirmate.io/core/api/ontology"
+	"confirmate.io/core/api/orchestrator"
+	"confirmate.io/core/auth"
+	"confirmate.io/core/persistence"
+	"confirmate.io/core/service"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// pseudonymPrefix is the prefix of all pseudonym tokens, so that they can be recognized in stored evidences.
+const pseudonymPrefix = "pseudonym:"
+
+// emailPattern matches e-mail addresses within arbitrary strings, e.g., within the raw representation of a resource.
+var emailPattern = regexp.MustCompile(`[A-Za-z0-9._%+-]+@[A-Za-z0-9.-]+\.[A-Za-z]{2,}`)
+
+// DefaultPseudonymizationConfig is the default configuration of the pseudonymization. It is disabled by default.
+var DefaultPseudonymizationConfig = PseudonymizationConfig{
+	Fields: map[string][]string{
+		"Identity": {"name"},
+	},
+	DetectEmails: true,
+}
+
+// PseudonymizationConfig configures the pseudonymization of personal data in stored evidences. Personal data is
+// replaced by tokens before the evidence is stored and forwarded to the assessment. The original values are kept in a
+// separate vault, from which only privileged users can re-identify them, see [Service.ReidentifyPseudonyms]. The
+// vault is a table of the evidence store database, which holds the values in plain text. Its protection therefore
+// relies on the access control (and encryption at rest) of the database.
+type PseudonymizationConfig struct {
+	// Enabled enables the pseudonymization of personal data.
+	Enabled bool
+
+	// Fields contains the fields that hold personal data, keyed by the ontology resource type they belong to, e.g.,
+	// "Identity". Since a resource has all the types of its hierarchy, fields can also be configured for more general
+	// types, e.g., "Identifiable". Fields are given by their JSON name, nested fields are separated by dots.
+	Fields map[string][]string
+
+	// DetectEmails additionally detects e-mail addresses in all string fields of a resource, including its raw
+	// representation.
+	DetectEmails bool
+
+	// Key is the secret key the tokens are derived from. The same value always results in the same token, so that
+	// pseudonymized resources can still be correlated. If empty, a random key is generated on startup.
+	Key []byte
+}
+
+// pseudonymizer replaces personal data in resources with tokens.
+type pseudonymizer struct {
+	cfg PseudonymizationConfig
+	key []byte
+}
+
+// newPseudonymizer creates a new [pseudonymizer] for the given configuration. If the pseudonymization is not
+// enabled, nil is returned.
+func newPseudonymizer(cfg PseudonymizationConfig) (p *pseudonymizer, err error) {
+	if !cfg.Enabled {
+		return nil, nil
+	}
+
+	p = &pseudonymizer{cfg: cfg, key: cfg.Key}
+	if len(p.key) == 0 {
+		slog.Warn("No pseudonymization key configured, using a random key. Tokens of the same personal data will differ after a restart.")
+
+		p.key = make([]byte, sha256.Size)
+		if _, err = rand.Read(p.key); err != nil {
+			return nil, fmt.Errorf("could not generate pseudonymization key: %w", err)
+		}
+	}
+
+	return p, nil
+}
+
+// token returns the token of the given value.
+func (p *pseudonymizer) token(value string) string {
+	mac := hmac.New(sha256.New, p.key)
+	mac.Write([]byte(value))
+
+	return pseudonymPrefix + hex.EncodeToString(mac.Sum(nil)[:16])
+}
+
+// pseudonymize replaces the personal data of the resource in place and returns the pseudonyms of the replaced values.
+// A nil pseudonymizer leaves the resource untouched.
+func (p *pseudonymizer) pseudonymize(resource ontology.IsResource) (pseudonyms []*evidence.Pseudonym) {
+	var (
+		fields []string
+		values = make(map[string]string)
+	)
+
+	if p == nil || resource == nil {
+		return nil
+	}
+
+	for _, typ := range ontology.ResourceTypes(resource) {
+		fields = append(fields, p.cfg.Fields[typ]...)
+	}
+
+	p.walk(resource.ProtoReflect(), "", fields, values)
+
+	for token, value := range values {
+		pseudonyms = append(pseudonyms, &evidence.Pseudonym{
+			Token:     token,
+			Value:     value,
+			CreatedAt: timestamppb.Now(),
+		})
+	}
+
+	return pseudonyms
+}
+
+// walk replaces the personal data in all string fields of m. Fields whose path is contained in fields are replaced
+// completely, e-mail addresses are replaced in all other fields if [PseudonymizationConfig.DetectEmails] is set. The
+// replaced values are collected in values, keyed by their token.
+func (p *pseudonymizer) walk(m protoreflect.Message, prefix string, fields []string, values map[string]string) {
+	var populated []protoreflect.FieldDescriptor
+
+	// Collect the populated fields first, since we are not allowed to modify the message while ranging over it
+	m.Range(func(fd protoreflect.FieldDescriptor, _ protoreflect.Value) bool {
+		populated = append(populated, fd)
+		return true
+	})
+
+	for _, fd := range populated {
+		var (
+			path  = fd.JSONName()
+			whole bool
+		)
+
+		if prefix != "" {
+			path = prefix + "." + path
+		}
+		whole = slices.Contains(fields, path)
+
+		switch {
+		case fd.IsMap():
+			if fd.MapValue().Kind() != protoreflect.StringKind {
+				continue
+			}
+
+			mm := m.Mutable(fd).Map()
+			mm.Range(func(k protoreflect.MapKey, v protoreflect.Value) bool {
+				mm.Set(k, protoreflect.ValueOfString(p.replace(v.String(), whole, values)))
+				return true
+			})
+		case fd.IsList():
+			list := m.Mutable(fd).List()
+			for i := range list.Len() {
+				switch fd.Kind() {
+				case protoreflect.StringKind:
+					list.Set(i, protoreflect.ValueOfString(p.replace(list.Get(i).String(), whole, values)))
+				case protoreflect.MessageKind:
+					p.walk(list.Get(i).Message(), path, fields, values)
+				}
+			}
+		case fd.Kind() == protoreflect.MessageKind:
+			p.walk(m.Mutable(fd).Message(), path, fields, values)
+		case fd.Kind() == protoreflect.StringKind:
+			m.Set(fd, protoreflect.ValueOfString(p.replace(m.Get(fd).String(), whole, values)))
+		}
+	}
+}
+
+// replace returns s with its personal data replaced by tokens. If whole is set, s is replaced completely.
+func (p *pseudonymizer) replace(s string, whole bool, values map[string]string) string {
+	var pseudonymize = func(value string) string {
+		if value == "" || strings.HasPrefix(value, pseudonymPrefix) {
+			return value
+		}
+
+		token := p.token(value)
+		values[token] = value
+
+		return token
+	}
+
+	if whole {
+		return pseudonymize(s)
+	}
+
+	if p.cfg.DetectEmails {
+		return emailPattern.ReplaceAllStringFunc(s, pseudonymize)
+	}
+
+	return s
+}
+
+// storePseudonyms stores the pseudonyms in the vault, unless they already exist.
+func (svc *Service) storePseudonyms(pseudonyms []*evidence.Pseudonym) (err error) {
+	var count int64
+
+	for _, pseudonym := range pseudonyms {
+		count, err = svc.db.Count(&evidence.Pseudonym{}, "token = ?", pseudonym.Token)
+		if err != nil {
+			return err
+		}
+		if count > 0 {
+			continue
+		}
+
+		err = svc.db.Create(pseudonym)
+		// The same personal data might have been pseudonymized concurrently
+		if errors.Is(err, persistence.ErrUniqueConstraintFailed) || errors.Is(err, persistence.ErrPrimaryKeyViolation) {
+			continue
+		} else if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ReidentifyPseudonyms returns the personal data behind the requested pseudonyms. It is restricted to privileged
+// users and every attempt is recorded in the audit log. The audit log consists of the log records of the service, it
+// is not persisted in the database. Operators therefore need to retain these log records themselves.
+// This implements the [evidenceconnect.EvidenceStoreHandler.ReidentifyPseudonyms] RPC method.
+func (svc *Service) ReidentifyPseudonyms(ctx context.Context, req *connect.Request[evidence.ReidentifyPseudonymsRequest]) (
+	res *connect.Response[evidence.ReidentifyPseudonymsResponse], err error) {
+	var (
+		pseudonyms []*evidence.Pseudonym
+		userId     string
+		allowed    bool
+	)
+
+	// Validate request
+	if err = service.Validate(req); err != nil {
+		return nil, err
+	}
+
+	claims, _ := auth.ClaimsFromContext(ctx)
+	userId = auth.GetConfirmateUserIDFromClaims(claims)
+
+	// Check access via the configured auth strategy. The strategy does not return errors, e.g., of the permission
+	// store, but logs them and denies access, so that personal data is never revealed by mistake.
+	allowed, _ = svc.authz.CheckAccess(ctx, userId, orchestrator.RequestType_REQUEST_TYPE_GET,
+		orchestrator.UserPermission_PERMISSION_READER, "", orchestrator.ObjectType_OBJECT_TYPE_PSEUDONYM)
+	if !allowed {
+		slog.Warn("Audit: re-identification of pseudonyms denied",
+			slog.String("user_id", userId),
+			slog.Any("tokens", req.Msg.GetTokens()),
+			slog.String("justification", req.Msg.GetJustification()))
+		return nil, service.ErrPermissionDenied
+	}
+
+	err = svc.db.List(&pseudonyms, "token", true, 0, -1, "token IN ?", req.Msg.GetTokens())
+	if err = service.HandleDatabaseError(err); err != nil {
+		return nil, err
+	}
+
+	res = connect.NewResponse(&evidence.ReidentifyPseudonymsResponse{
+		Values: make(map[string]string, len(pseudonyms)),
+	})
+	for _, pseudonym := range pseudonyms {
+		res.Msg.Values[pseudonym.Token] = pseudonym.Value
+	}
+
+	slog.Info("Audit: pseudonyms re-identified",
+		slog.String("user_id", userId),
+		slog.Any("tokens", req.Msg.GetTokens()),
+		slog.Int("re-identified", len(pseudonyms)),
+		slog.String("justification", req.Msg.GetJustification()))
+
+	return
+}
@@ -0,0 +1,253 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package evidence
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"confirmate.io/core/api/evidence"
+	"confirmate.io/core/api/ontology"
+	"confirmate.io/core/auth"
+	"confirmate.io/core/persistence"
+	"confirmate.io/core/persistence/persistencetest"
+	"confirmate.io/core/service"
+	"confirmate.io/core/util/assert"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// mockIdentity returns an identity that contains personal data in its name, its labels and its raw representation.
+func mockIdentity() *ontology.Identity {
+	return &ontology.Identity{
+		Id:     "identity-1",
+		Name:   "Jane Doe",
+		Labels: map[string]string{"owner": "jane.doe@example.com"},
+		Raw:    `{"user":"Jane Doe","mail":"jane.doe@example.com"}`,
+	}
+}
+
+func Test_newPseudonymizer(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     PseudonymizationConfig
+		want    assert.Want[*pseudonymizer]
+		wantErr assert.WantErr
+	}{
+		{
+			name:    "disabled",
+			cfg:     DefaultPseudonymizationConfig,
+			want:    assert.Nil[*pseudonymizer],
+			wantErr: assert.NoError,
+		},
+		{
+			name: "configured key",
+			cfg:  PseudonymizationConfig{Enabled: true, Key: []byte("secret")},
+			want: func(t *testing.T, got *pseudonymizer, msgAndArgs ...any) bool {
+				return assert.Equal(t, []byte("secret"), got.key)
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "random key",
+			cfg:  PseudonymizationConfig{Enabled: true},
+			want: func(t *testing.T, got *pseudonymizer, msgAndArgs ...any) bool {
+				return assert.Equal(t, 32, len(got.key))
+			},
+			wantErr: assert.NoError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := newPseudonymizer(tt.cfg)
+			tt.want(t, got)
+			tt.wantErr(t, err)
+		})
+	}
+}
+
+func Test_pseudonymizer_pseudonymize(t *testing.T) {
+	var (
+		cfg = PseudonymizationConfig{
+			Enabled:      true,
+			Fields:       DefaultPseudonymizationConfig.Fields,
+			DetectEmails: true,
+			Key:          []byte("secret"),
+		}
+		p, _ = newPseudonymizer(cfg)
+	)
+
+	t.Run("nil pseudonymizer", func(t *testing.T) {
+		var (
+			p        *pseudonymizer
+			identity = mockIdentity()
+		)
+
+		assert.Empty(t, p.pseudonymize(identity))
+		assert.Equal(t, mockIdentity(), identity)
+	})
+
+	t.Run("configured fields and e-mail addresses", func(t *testing.T) {
+		var (
+			identity = mockIdentity()
+			name     = p.token("Jane Doe")
+			email    = p.token("jane.doe@example.com")
+		)
+
+		pseudonyms := p.pseudonymize(identity)
+
+		assert.Equal(t, 2, len(pseudonyms))
+		assert.Equal(t, "identity-1", identity.Id)
+		assert.Equal(t, name, identity.Name)
+		assert.Equal(t, map[string]string{"owner": email}, identity.Labels)
+
+		// Only the e-mail address is detected in the raw representation, the name is not a configured field there
+		assert.Equal(t, `{"user":"Jane Doe","mail":"`+email+`"}`, identity.Raw)
+	})
+
+	t.Run("already pseudonymized", func(t *testing.T) {
+		var identity = mockIdentity()
+
+		p.pseudonymize(identity)
+		assert.Empty(t, p.pseudonymize(identity))
+		assert.True(t, strings.HasPrefix(identity.Name, pseudonymPrefix))
+	})
+
+	t.Run("same value results in same token", func(t *testing.T) {
+		assert.Equal(t, p.token("Jane Doe"), p.token("Jane Doe"))
+		assert.NotEqual(t, p.token("Jane Doe"), p.token("John Doe"))
+	})
+}
+
+func TestService_StoreEvidence_pseudonymizes(t *testing.T) {
+	var (
+		db   = persistencetest.NewInMemoryDB(t, types, nil)
+		p, _ = newPseudonymizer(PseudonymizationConfig{
+			Enabled:      true,
+			Fields:       DefaultPseudonymizationConfig.Fields,
+			DetectEmails: true,
+		})
+		svc = &Service{
+			db:              db,
+			pseudonymizer:   p,
+			channelEvidence: make(chan *evidence.Evidence, defaultEvidenceQueueSize),
+		}
+		ev = &evidence.Evidence{
+			Id:                   uuid.NewString(),
+			Timestamp:            timestamppb.Now(),
+			TargetOfEvaluationId: uuid.NewString(),
+			ToolId:               "MockTool1",
+			Resource:             ontology.ProtoResource(mockIdentity()),
+		}
+	)
+
+	_, err := svc.StoreEvidence(context.Background(), connect.NewRequest(&evidence.StoreEvidenceRequest{Evidence: ev}))
+	assert.NoError(t, err)
+
+	// Neither the stored evidence nor the resource snapshot contain the personal data
+	res, err := svc.GetEvidence(context.Background(), connect.NewRequest(&evidence.GetEvidenceRequest{EvidenceId: ev.Id}))
+	assert.NoError(t, err)
+	assert.Equal(t, p.token("Jane Doe"), res.Msg.Resource.GetIdentity().GetName())
+
+	snapshot := assert.InDB[evidence.ResourceSnapshot](t, db, "identity-1")
+	assert.Equal(t, p.token("Jane Doe"), snapshot.Resource.GetIdentity().GetName())
+
+	// But the vault does
+	count, err := db.Count(&evidence.Pseudonym{})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), count)
+}
+
+func TestService_ReidentifyPseudonyms(t *testing.T) {
+	type fields struct {
+		db    persistence.DB
+		authz service.AuthorizationStrategy
+	}
+	type args struct {
+		ctx context.Context
+		req *evidence.ReidentifyPseudonymsRequest
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    assert.Want[*connect.Response[evidence.ReidentifyPseudonymsResponse]]
+		wantErr assert.WantErr
+	}{
+		{
+			name: "validation error",
+			args: args{
+				ctx: context.Background(),
+				req: &evidence.ReidentifyPseudonymsRequest{Tokens: []string{"pseudonym:1"}},
+			},
+			want: assert.Nil[*connect.Response[evidence.ReidentifyPseudonymsResponse]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeInvalidArgument)
+			},
+		},
+		{
+			name: "permission denied",
+			fields: fields{
+				db:    persistencetest.NewInMemoryDB(t, types, nil),
+				authz: &service.AuthorizationStrategyPermissionStore{},
+			},
+			args: args{
+				ctx: context.Background(),
+				req: &evidence.ReidentifyPseudonymsRequest{Tokens: []string{"pseudonym:1"}, Justification: "incident"},
+			},
+			want: assert.Nil[*connect.Response[evidence.ReidentifyPseudonymsResponse]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodePermissionDenied)
+			},
+		},
+		{
+			name: "happy path: admin",
+			fields: fields{
+				db: persistencetest.NewInMemoryDB(t, types, nil, func(db persistence.DB) {
+					assert.NoError(t, db.Create(&evidence.Pseudonym{Token: "pseudonym:1", Value: "Jane Doe"}))
+					assert.NoError(t, db.Create(&evidence.Pseudonym{Token: "pseudonym:2", Value: "John Doe"}))
+				}),
+				authz: &service.AuthorizationStrategyPermissionStore{},
+			},
+			args: args{
+				ctx: auth.WithClaims(context.Background(), &auth.OAuthClaims{IsAdminToken: true}),
+				req: &evidence.ReidentifyPseudonymsRequest{
+					Tokens:        []string{"pseudonym:1", "pseudonym:3"},
+					Justification: "incident",
+				},
+			},
+			want: func(t *testing.T, got *connect.Response[evidence.ReidentifyPseudonymsResponse], msgAndArgs ...any) bool {
+				return assert.Equal(t, map[string]string{"pseudonym:1": "Jane Doe"}, got.Msg.Values)
+			},
+			wantErr: assert.NoError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &Service{
+				db:    tt.fields.db,
+				authz: tt.fields.authz,
+			}
+
+			got, err := svc.ReidentifyPseudonyms(tt.args.ctx, connect.NewRequest(tt.args.req))
+			tt.want(t, got)
+			tt.wantErr(t, err)
+		})
+	}
+}
@@ -0,0 +1,155 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package evidence
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"time"
+
+	"confirmate.io/core/api/evidence"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// collectorQuota tracks the usage of a collector since the start of the service. The number of evidences per minute
+// is limited with a token bucket, which holds up to [evidence.Collector.MaxEvidencesPerMinute] tokens and is refilled
+// continuously, so that a collector can send a burst of a full minute at once.
+type collectorQuota struct {
+	usage *evidence.CollectorUsage
+
+	// tokens is the number of evidences the collector may currently store
+	tokens float64
+
+	// refilled is the time the tokens were last refilled
+	refilled time.Time
+}
+
+// checkCollectorQuota checks whether the collector may store an evidence of the given size at the given time. If it
+// exceeds one of its quotas, the evidence is counted as rejected and a [connect.CodeResourceExhausted] error is
+// returned. A nil collector is not limited.
+func (svc *Service) checkCollectorQuota(collector *evidence.Collector, size int, now time.Time) (err error) {
+	var q *collectorQuota
+
+	if collector == nil {
+		return nil
+	}
+
+	svc.collectorQuotasMutex.Lock()
+	defer svc.collectorQuotasMutex.Unlock()
+
+	q = svc.quota(collector, now)
+	q.usage.LastSeenAt = timestamppb.New(now)
+
+	if collector.MaxEvidenceSize != nil && int64(size) > collector.GetMaxEvidenceSize() {
+		q.usage.RejectedEvidences++
+		return connect.NewError(connect.CodeResourceExhausted,
+			fmt.Errorf("evidence of %d bytes exceeds the maximum size of %d bytes of the collector", size, collector.GetMaxEvidenceSize()))
+	}
+
+	if collector.MaxEvidencesPerMinute != nil {
+		limit := float64(collector.GetMaxEvidencesPerMinute())
+
+		// The limit might have been lowered since the last refill
+		q.tokens = min(limit, q.tokens+now.Sub(q.refilled).Minutes()*limit)
+		q.refilled = now
+
+		if q.tokens < 1 {
+			q.usage.RejectedEvidences++
+			return connect.NewError(connect.CodeResourceExhausted,
+				fmt.Errorf("collector exceeds its maximum of %d evidences per minute", collector.GetMaxEvidencesPerMinute()))
+		}
+
+		q.tokens--
+	}
+
+	return nil
+}
+
+// recordCollectorUsage records a stored evidence of the given size in the usage statistics of the collector. A nil
+// collector is not recorded.
+func (svc *Service) recordCollectorUsage(collector *evidence.Collector, size int) {
+	var q *collectorQuota
+
+	if collector == nil {
+		return
+	}
+
+	svc.collectorQuotasMutex.Lock()
+	defer svc.collectorQuotasMutex.Unlock()
+
+	q = svc.quota(collector, time.Now())
+	q.usage.StoredEvidences++
+	q.usage.StoredBytes += int64(size)
+}
+
+// quota returns the quota of the collector, creating it with a full token bucket if necessary. The caller
+// must hold [Service.collectorQuotasMutex].
+func (svc *Service) quota(collector *evidence.Collector, now time.Time) (q *collectorQuota) {
+	var ok bool
+
+	if svc.collectorQuotas == nil {
+		svc.collectorQuotas = make(map[string]*collectorQuota)
+	}
+
+	q, ok = svc.collectorQuotas[collector.GetId()]
+	if !ok {
+		q = &collectorQuota{
+			usage:    &evidence.CollectorUsage{CollectorId: collector.GetId()},
+			tokens:   float64(collector.GetMaxEvidencesPerMinute()),
+			refilled: now,
+		}
+		svc.collectorQuotas[collector.GetId()] = q
+	}
+
+	return q
+}
+
+// forgetCollectorUsage removes the usage statistics of a removed collector.
+func (svc *Service) forgetCollectorUsage(collectorId string) {
+	svc.collectorQuotasMutex.Lock()
+	defer svc.collectorQuotasMutex.Unlock()
+
+	delete(svc.collectorQuotas, collectorId)
+}
+
+// collectorUsage returns a snapshot of the usage statistics of all collectors, ordered by the number of their stored
+// evidences, most active first.
+func (svc *Service) collectorUsage() (usage []*evidence.CollectorUsage) {
+	svc.collectorQuotasMutex.Lock()
+	defer svc.collectorQuotasMutex.Unlock()
+
+	for _, q := range svc.collectorQuotas {
+		usage = append(usage, &evidence.CollectorUsage{
+			CollectorId:       q.usage.CollectorId,
+			StoredEvidences:   q.usage.StoredEvidences,
+			StoredBytes:       q.usage.StoredBytes,
+			RejectedEvidences: q.usage.RejectedEvidences,
+			LastSeenAt:        q.usage.LastSeenAt,
+		})
+	}
+
+	slices.SortFunc(usage, func(a *evidence.CollectorUsage, b *evidence.CollectorUsage) int {
+		return cmp.Or(
+			cmp.Compare(b.StoredEvidences, a.StoredEvidences),
+			cmp.Compare(a.CollectorId, b.CollectorId),
+		)
+	})
+
+	return usage
+}
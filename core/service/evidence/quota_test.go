@@ -0,0 +1,131 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package evidence
+
+import (
+	"testing"
+	"time"
+
+	"confirmate.io/core/api/evidence"
+	"confirmate.io/core/util/assert"
+
+	"connectrpc.com/connect"
+)
+
+func TestService_checkCollectorQuota(t *testing.T) {
+	var (
+		start = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	)
+
+	type args struct {
+		collector *evidence.Collector
+		sizes     []int
+		at        []time.Duration
+	}
+	tests := []struct {
+		name         string
+		args         args
+		wantRejected int64
+	}{
+		{
+			name: "no collector",
+			args: args{
+				sizes: []int{1 << 20},
+				at:    []time.Duration{0},
+			},
+			wantRejected: 0,
+		},
+		{
+			name: "unlimited collector",
+			args: args{
+				collector: &evidence.Collector{Id: "c1"},
+				sizes:     []int{1 << 20, 1 << 20, 1 << 20},
+				at:        []time.Duration{0, 0, 0},
+			},
+			wantRejected: 0,
+		},
+		{
+			name: "size exceeded",
+			args: args{
+				collector: &evidence.Collector{Id: "c1", MaxEvidenceSize: new(int64(100))},
+				sizes:     []int{100, 101},
+				at:        []time.Duration{0, 0},
+			},
+			wantRejected: 1,
+		},
+		{
+			name: "burst of a full minute",
+			args: args{
+				collector: &evidence.Collector{Id: "c1", MaxEvidencesPerMinute: new(int32(2))},
+				sizes:     []int{1, 1, 1},
+				at:        []time.Duration{0, 0, 0},
+			},
+			wantRejected: 1,
+		},
+		{
+			name: "refilled over time",
+			args: args{
+				collector: &evidence.Collector{Id: "c1", MaxEvidencesPerMinute: new(int32(2))},
+				sizes:     []int{1, 1, 1, 1},
+				at:        []time.Duration{0, 0, 10 * time.Second, 30 * time.Second},
+			},
+			wantRejected: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				svc      = &Service{}
+				rejected int64
+			)
+
+			for i, size := range tt.args.sizes {
+				err := svc.checkCollectorQuota(tt.args.collector, size, start.Add(tt.args.at[i]))
+				if err != nil {
+					assert.IsConnectError(t, err, connect.CodeResourceExhausted)
+					rejected++
+				}
+			}
+
+			assert.Equal(t, tt.wantRejected, rejected)
+			if tt.args.collector != nil {
+				assert.Equal(t, tt.wantRejected, svc.collectorUsage()[0].RejectedEvidences)
+			}
+		})
+	}
+}
+
+func TestService_collectorUsage(t *testing.T) {
+	var (
+		svc = &Service{}
+		c1  = &evidence.Collector{Id: "c1"}
+		c2  = &evidence.Collector{Id: "c2"}
+	)
+
+	svc.recordCollectorUsage(c1, 10)
+	svc.recordCollectorUsage(c2, 10)
+	svc.recordCollectorUsage(c2, 20)
+	svc.recordCollectorUsage(nil, 10)
+
+	assert.Equal(t, []*evidence.CollectorUsage{
+		{CollectorId: "c2", StoredEvidences: 2, StoredBytes: 30},
+		{CollectorId: "c1", StoredEvidences: 1, StoredBytes: 10},
+	}, svc.collectorUsage())
+
+	// Removed collectors are forgotten
+	svc.forgetCollectorUsage("c2")
+	assert.Equal(t, 1, len(svc.collectorUsage()))
+}
@@ -0,0 +1,120 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package evidence
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"confirmate.io/core/api/evidence"
+	"confirmate.io/core/api/ontology"
+	"confirmate.io/core/persistence"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// newResourceBlob creates the content-addressed blob of the given resource. The resource is encoded
+// deterministically, so that byte-identical resources result in the same hash.
+func newResourceBlob(resource *ontology.Resource) (blob *evidence.ResourceBlob, err error) {
+	var (
+		data []byte
+		sum  [sha256.Size]byte
+	)
+
+	data, err = proto.MarshalOptions{Deterministic: true}.Marshal(resource)
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal resource: %w", err)
+	}
+
+	sum = sha256.Sum256(data)
+
+	return &evidence.ResourceBlob{
+		Hash: hex.EncodeToString(sum[:]),
+		Data: data,
+	}, nil
+}
+
+// storeResourceBlob stores the blob, unless a blob with the same hash already exists.
+func (svc *Service) storeResourceBlob(blob *evidence.ResourceBlob) (err error) {
+	var count int64
+
+	count, err = svc.db.Count(&evidence.ResourceBlob{}, "hash = ?", blob.Hash)
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		return nil
+	}
+
+	err = svc.db.Create(blob)
+	// Another evidence with the same resource might have been stored concurrently
+	if errors.Is(err, persistence.ErrUniqueConstraintFailed) || errors.Is(err, persistence.ErrPrimaryKeyViolation) {
+		return nil
+	}
+
+	return err
+}
+
+// loadResources restores the resources of the given evidences from their blobs. Evidences that have been stored
+// before the resources were stored content-addressed still contain their resource and are left untouched.
+func (svc *Service) loadResources(evidences ...*evidence.Evidence) (err error) {
+	var (
+		hashes []string
+		blobs  []*evidence.ResourceBlob
+		data   map[string][]byte
+	)
+
+	for _, ev := range evidences {
+		if ev.ResourceHash != "" && ev.Resource == nil {
+			hashes = append(hashes, ev.ResourceHash)
+		}
+	}
+
+	if len(hashes) == 0 {
+		return nil
+	}
+
+	err = svc.db.List(&blobs, "hash", true, 0, -1, "hash IN ?", hashes)
+	if err != nil {
+		return err
+	}
+
+	data = make(map[string][]byte, len(blobs))
+	for _, blob := range blobs {
+		data[blob.Hash] = blob.Data
+	}
+
+	for _, ev := range evidences {
+		if ev.ResourceHash == "" || ev.Resource != nil {
+			continue
+		}
+
+		b, ok := data[ev.ResourceHash]
+		if !ok {
+			return fmt.Errorf("resource blob %s of evidence %s not found", ev.ResourceHash, ev.Id)
+		}
+
+		ev.Resource = &ontology.Resource{}
+		err = proto.Unmarshal(b, ev.Resource)
+		if err != nil {
+			return fmt.Errorf("could not unmarshal resource of evidence %s: %w", ev.Id, err)
+		}
+	}
+
+	return nil
+}
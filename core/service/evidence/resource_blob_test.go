@@ -0,0 +1,156 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package evidence
+
+import (
+	"context"
+	"testing"
+
+	"confirmate.io/core/api/evidence"
+	"confirmate.io/core/api/ontology"
+	"confirmate.io/core/persistence"
+	"confirmate.io/core/persistence/persistencetest"
+	"confirmate.io/core/service/evidence/evidencetest"
+	"confirmate.io/core/util/assert"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/proto"
+)
+
+func Test_newResourceBlob(t *testing.T) {
+	blob1, err := newResourceBlob(evidencetest.MockEvidenceWithVMResource.Resource)
+	assert.NoError(t, err)
+
+	blob2, err := newResourceBlob(evidencetest.MockEvidenceWithVMResource2.Resource)
+	assert.NoError(t, err)
+
+	blob3, err := newResourceBlob(&ontology.Resource{Type: &ontology.Resource_VirtualMachine{
+		VirtualMachine: &ontology.VirtualMachine{
+			Id:   "mock-id-2",
+			Name: "my-other-vm",
+		},
+	}})
+	assert.NoError(t, err)
+
+	// Identical resources share the same blob, different resources do not
+	assert.Equal(t, blob1, blob2)
+	assert.NotEqual(t, blob1.Hash, blob3.Hash)
+	assert.Equal(t, 64, len(blob1.Hash))
+}
+
+func TestService_StoreEvidence_deduplicatesResources(t *testing.T) {
+	var (
+		db  = persistencetest.NewInMemoryDB(t, types, nil)
+		svc = &Service{
+			db:              db,
+			channelEvidence: make(chan *evidence.Evidence, defaultEvidenceQueueSize),
+		}
+		ev1 = proto.Clone(evidencetest.MockEvidenceWithVMResource).(*evidence.Evidence)
+		ev2 = proto.Clone(evidencetest.MockEvidenceWithVMResource2).(*evidence.Evidence)
+	)
+
+	for _, ev := range []*evidence.Evidence{ev1, ev2} {
+		_, err := svc.StoreEvidence(context.Background(), connect.NewRequest(&evidence.StoreEvidenceRequest{Evidence: ev}))
+		assert.NoError(t, err)
+	}
+
+	// Both evidences reference the same blob
+	count, err := db.Count(&evidence.ResourceBlob{})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+	assert.Equal(t, ev1.ResourceHash, ev2.ResourceHash)
+
+	// The evidence itself does not contain the resource anymore
+	stored := assert.InDB[evidence.Evidence](t, db, ev2.Id)
+	assert.Nil(t, stored.Resource)
+	assert.Equal(t, ev2.ResourceHash, stored.ResourceHash)
+
+	// But it is restored when retrieving the evidence
+	res, err := svc.GetEvidence(context.Background(), connect.NewRequest(&evidence.GetEvidenceRequest{EvidenceId: ev2.Id}))
+	assert.NoError(t, err)
+	assert.Equal(t, ev2.Resource, res.Msg.Resource)
+}
+
+func TestService_loadResources(t *testing.T) {
+	blob, err := newResourceBlob(evidencetest.MockEvidenceWithVMResource.Resource)
+	assert.NoError(t, err)
+
+	type fields struct {
+		db persistence.DB
+	}
+	type args struct {
+		evidences []*evidence.Evidence
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    assert.Want[[]*evidence.Evidence]
+		wantErr assert.WantErr
+	}{
+		{
+			name: "legacy evidence with embedded resource",
+			fields: fields{
+				db: persistencetest.NewInMemoryDB(t, types, nil),
+			},
+			args: args{
+				evidences: []*evidence.Evidence{{Resource: evidencetest.MockEvidenceWithVMResource.Resource}},
+			},
+			want: func(t *testing.T, got []*evidence.Evidence, msgAndArgs ...any) bool {
+				return assert.Equal(t, evidencetest.MockEvidenceWithVMResource.Resource, got[0].Resource)
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "err: blob not found",
+			fields: fields{
+				db: persistencetest.NewInMemoryDB(t, types, nil),
+			},
+			args: args{
+				evidences: []*evidence.Evidence{{ResourceHash: blob.Hash}},
+			},
+			want: assert.NotNil[[]*evidence.Evidence],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.ErrorContains(t, err, "not found")
+			},
+		},
+		{
+			name: "happy path",
+			fields: fields{
+				db: persistencetest.NewInMemoryDB(t, types, nil, func(db persistence.DB) {
+					assert.NoError(t, db.Create(blob))
+				}),
+			},
+			args: args{
+				evidences: []*evidence.Evidence{{ResourceHash: blob.Hash}, {ResourceHash: blob.Hash}},
+			},
+			want: func(t *testing.T, got []*evidence.Evidence, msgAndArgs ...any) bool {
+				return assert.Equal(t, evidencetest.MockEvidenceWithVMResource.Resource, got[0].Resource) &&
+					assert.Equal(t, evidencetest.MockEvidenceWithVMResource.Resource, got[1].Resource)
+			},
+			wantErr: assert.NoError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &Service{db: tt.fields.db}
+
+			err := svc.loadResources(tt.args.evidences...)
+			tt.want(t, tt.args.evidences)
+			tt.wantErr(t, err)
+		})
+	}
+}
@@ -0,0 +1,97 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package evidence
+
+import (
+	"errors"
+	"log/slog"
+	"strings"
+
+	"confirmate.io/core/api/evidence"
+	"confirmate.io/core/api/ontology"
+	"confirmate.io/core/persistence"
+)
+
+// backfillBatchSize is the number of evidences whose resource types are backfilled at once.
+const backfillBatchSize = 100
+
+// resourceTypeCondition returns the query condition that matches records whose comma separated list of resource types
+// contains the given resource type, either as the only type or at any position of the list. The first pattern has no
+// wildcards and therefore matches the resource type exactly.
+func resourceTypeCondition(resourceType string) (cond string, args []any) {
+	return "(resource_type LIKE ? OR resource_type LIKE ? OR resource_type LIKE ? OR resource_type LIKE ?)",
+		[]any{resourceType, resourceType + ",%", "%," + resourceType + ",%", "%," + resourceType}
+}
+
+// backfillResourceTypes extracts the resource types of evidences that have been stored before the resource types
+// were extracted on storing, so that filtering by resource type also covers them. Evidences that cannot be updated,
+// because they are within the retention period of the append-only mode (see [Config.ImmutableRetention]), keep an
+// empty resource type.
+func (svc *Service) backfillResourceTypes() (err error) {
+	var (
+		evidences []*evidence.Evidence
+		lastId    string
+		types     []string
+		updated   int
+		skipped   int
+	)
+
+	for {
+		evidences = nil
+		err = svc.db.List(&evidences, "id", true, 0, backfillBatchSize, "resource_type = ? AND id > ?", "", lastId)
+		if err != nil {
+			return err
+		}
+		if len(evidences) == 0 {
+			break
+		}
+		lastId = evidences[len(evidences)-1].Id
+
+		if err = svc.loadResources(evidences...); err != nil {
+			return err
+		}
+
+		for _, ev := range evidences {
+			if resource := ev.GetOntologyResource(); resource != nil {
+				types = ontology.ResourceTypes(resource)
+			} else {
+				types = nil
+			}
+			if len(types) == 0 {
+				skipped++
+				continue
+			}
+
+			err = svc.db.Update(&evidence.Evidence{ResourceType: strings.Join(types, ",")}, "id = ?", ev.Id)
+			if errors.Is(err, persistence.ErrImmutable) {
+				skipped++
+				continue
+			} else if err != nil {
+				return err
+			}
+			updated++
+		}
+	}
+
+	if updated > 0 || skipped > 0 {
+		slog.Info("Backfilled resource types of evidences",
+			slog.Int("updated", updated),
+			slog.Int("skipped", skipped),
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,92 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package evidence
+
+import (
+	"testing"
+
+	"confirmate.io/core/api/evidence"
+	"confirmate.io/core/persistence"
+	"confirmate.io/core/persistence/persistencetest"
+	"confirmate.io/core/service/evidence/evidencetest"
+	"confirmate.io/core/util/assert"
+
+	"google.golang.org/protobuf/proto"
+)
+
+func TestService_backfillResourceTypes(t *testing.T) {
+	var (
+		legacy = proto.Clone(evidencetest.MockEvidenceWithVMResource).(*evidence.Evidence)
+		stored = proto.Clone(evidencetest.MockEvidenceWithVMResource2).(*evidence.Evidence)
+	)
+
+	// The evidence was stored before its resource types were extracted
+	legacy.ResourceType = ""
+	stored.ResourceType = "ObjectStorage,Storage,Resource"
+
+	type fields struct {
+		db persistence.DB
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		want    assert.Want[persistence.DB]
+		wantErr assert.WantErr
+	}{
+		{
+			name: "no evidences",
+			fields: fields{
+				db: persistencetest.NewInMemoryDB(t, types, nil),
+			},
+			want:    assert.NotNil[persistence.DB],
+			wantErr: assert.NoError,
+		},
+		{
+			name: "happy path",
+			fields: fields{
+				db: persistencetest.NewInMemoryDB(t, types, nil, func(d persistence.DB) {
+					assert.NoError(t, d.Create(legacy))
+					assert.NoError(t, d.Create(stored))
+				}),
+			},
+			want: func(t *testing.T, got persistence.DB, msgAndArgs ...any) bool {
+				var (
+					ev1 evidence.Evidence
+					ev2 evidence.Evidence
+				)
+
+				// The resource types of the legacy evidence are extracted, the ones of other evidences are kept
+				assert.NoError(t, got.Get(&ev1, "id = ?", legacy.Id))
+				assert.NoError(t, got.Get(&ev2, "id = ?", stored.Id))
+				return assert.Equal(t, "VirtualMachine,Compute,Infrastructure,Resource", ev1.ResourceType) &&
+					assert.Equal(t, "ObjectStorage,Storage,Resource", ev2.ResourceType)
+			},
+			wantErr: assert.NoError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &Service{
+				db: tt.fields.db,
+			}
+
+			err := svc.backfillResourceTypes()
+			tt.want(t, svc.db)
+			tt.wantErr(t, err)
+		})
+	}
+}
@@ -23,6 +23,7 @@ import (
 	"net/http"
 	"strings"
 	"sync"
+	"time"
 
 	"confirmate.io/core/api"
 	"confirmate.io/core/api/assessment"
@@ -37,6 +38,8 @@ import (
 
 	"connectrpc.com/connect"
 	"github.com/lmittmann/tint"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 const (
@@ -50,6 +53,8 @@ var DefaultConfig = Config{
 	AssessmentHTTPClient: service.DefaultHTTPClient,
 	PersistenceConfig:    persistence.DefaultConfig,
 	EvidenceQueueSize:    defaultEvidenceQueueSize,
+	Transport:            service.DefaultTransportConfig,
+	Pseudonymization:     DefaultPseudonymizationConfig,
 }
 
 // Config represents the configuration for the evidence store [Service].
@@ -70,6 +75,24 @@ type Config struct {
 	// service-to-service authentication with the orchestrator. When set, all outgoing
 	// orchestrator calls use this token.
 	ServiceOAuth2Config *clientcredentials.Config
+
+	// Transport configures the message size limits and the compression of the assessment client.
+	Transport service.TransportConfig
+
+	// Pseudonymization configures the pseudonymization of personal data in stored evidences.
+	Pseudonymization PseudonymizationConfig
+
+	// RequireCollectorTokens requires all evidences to be stored with the token of a collector (see
+	// [Service.CreateCollector]). Otherwise, collector tokens are optional and evidences stored without one are not
+	// attributed to a collector. Either way, the auth interceptor must pass collector tokens through to the service,
+	// since they are no OAuth tokens (see WithDelegatedTokens and WithPublicProcedures of the server package).
+	RequireCollectorTokens bool
+
+	// ImmutableRetention enables the append-only (write once, read many) mode of the evidence store, if positive.
+	// Stored evidences cannot be updated or deleted for this period after they were stored (see
+	// [evidence.Evidence.CreatedAt]), which is enforced by the persistence layer (see [persistence.Config.Immutable]).
+	// Since the resource blobs are shared by evidences, they cannot be updated or deleted at all in this mode.
+	ImmutableRetention time.Duration
 }
 
 // Service is an implementation of the Confirmate req service (evidenceServer)
@@ -84,6 +107,8 @@ type Service struct {
 
 	// channel that is used to send evidences from the StoreEvidence method to the worker threat to process the evidence
 	channelEvidence chan *evidence.Evidence
+	// workers runs the worker processing the evidences of channelEvidence until [Service.Shutdown]
+	workers service.Workers
 
 	// evidenceHooks is a list of hook functions that can be used if one wants to be
 	// informed about each evidence
@@ -91,10 +116,24 @@ type Service struct {
 	// hookMutex is used for (un)locking result hook calls
 	hookMutex sync.Mutex
 
+	// watchers are the consumers of the evidence change feed, see [Service.WatchEvidences]
+	watchers      map[int64]*watcher
+	watchersMutex sync.RWMutex
+	nextWatcherId int64
+
 	// authz defines our authorization strategy for target-of-evaluation scoped access.
 	authz service.AuthorizationStrategy
+
+	// pseudonymizer replaces personal data in stored evidences, see [Config.Pseudonymization].
+	pseudonymizer *pseudonymizer
+
+	// collectorQuotas tracks the usage of the collectors, keyed by their ID, see [Service.checkCollectorQuota]
+	collectorQuotas      map[string]*collectorQuota
+	collectorQuotasMutex sync.Mutex
 }
 
+var _ service.Lifecycle = (*Service)(nil)
+
 // WithConfig sets the service configuration, overriding the default configuration.
 func WithConfig(cfg Config) service.Option[Service] {
 	return func(svc *Service) {
@@ -140,9 +179,14 @@ func NewService(opts ...service.Option[Service]) (svc *Service, err error) {
 		)
 	}
 
+	svc.pseudonymizer, err = newPseudonymizer(svc.cfg.Pseudonymization)
+	if err != nil {
+		return nil, err
+	}
+
 	// Initialize the assessment service client
 	svc.assessmentClient = assessmentconnect.NewAssessmentClient(
-		assessmentHTTPClient, svc.cfg.AssessmentAddress)
+		assessmentHTTPClient, svc.cfg.AssessmentAddress, svc.cfg.Transport.ClientOptions()...)
 
 	// Initialize the restartable stream for assessment service
 	err = svc.initAssessmentStream()
@@ -153,11 +197,20 @@ func NewService(opts ...service.Option[Service]) (svc *Service, err error) {
 	// Initialize database
 	pcfg := svc.cfg.PersistenceConfig
 	pcfg.Types = append(pcfg.Types, types...)
+	if svc.cfg.ImmutableRetention > 0 {
+		pcfg.Immutable = append(pcfg.Immutable, immutableTypes(svc.cfg.ImmutableRetention)...)
+	}
 	svc.db, err = persistence.NewDB(persistence.WithConfig(pcfg))
 	if err != nil {
 		return nil, fmt.Errorf("could not create db: %w", err)
 	}
 
+	// Evidences stored before the resource types were extracted would otherwise be missing in filtered lists. The
+	// evidence store is usable without them, so we only warn about failures.
+	if berr := svc.backfillResourceTypes(); berr != nil {
+		slog.Warn("Could not backfill resource types of evidences", tint.Err(berr))
+	}
+
 	// Create a channel to send evidence to the worker thread
 	svc.initEvidenceChannel()
 
@@ -203,12 +256,20 @@ func (svc *Service) initEvidenceChannel() {
 	}
 
 	// Start a worker thread to process the evidence that is being passed to the StoreEvidence function to use the
-	// fire-and-forget strategy.
-	// NOTE: This simple approach has a few limitations: a full queue will block StoreEvidence, the worker
-	// has no shutdown signal, errors are only logged (no retry), and throughput is limited to a single goroutine.
-	go func() {
+	// fire-and-forget strategy. The worker runs until the service is shut down.
+	// NOTE: This simple approach has a few limitations: a full queue will block StoreEvidence, errors are only
+	// logged (no retry), and throughput is limited to a single goroutine.
+	svc.workers.Go(func(ctx context.Context) {
 		slog.Debug("Evidence worker thread started, waiting for evidence to process...")
-		for e := range svc.channelEvidence { // exits when channel is closed
+		for {
+			var e *evidence.Evidence
+
+			select {
+			case <-ctx.Done():
+				return
+			case e = <-svc.channelEvidence:
+			}
+
 			if e == nil {
 				continue
 			}
@@ -226,14 +287,37 @@ func (svc *Service) initEvidenceChannel() {
 				)
 			}
 		}
-	}()
+	})
+}
+
+// Start is a no-op, since the evidence worker must already process evidences stored before the launcher starts the
+// service and is therefore started by [NewService]. This implements [service.Lifecycle].
+func (svc *Service) Start(_ context.Context) (err error) {
+	return nil
+}
+
+// Shutdown stops the evidence worker, waits until it returned or ctx is done and closes the stream to the assessment
+// service. Evidences that are still queued are not sent anymore. This implements [service.Lifecycle].
+func (svc *Service) Shutdown(ctx context.Context) (err error) {
+	err = svc.workers.Stop(ctx)
+
+	if svc.assessmentStream != nil {
+		err = errors.Join(err, svc.assessmentStream.Close())
+	}
+
+	return err
 }
 
 // StoreEvidence receives an evidence and stores it into the database
 // This implements the [evidenceconnect.EvidenceStoreHandler.StoreEvidence] RPC method.
 func (svc *Service) StoreEvidence(ctx context.Context, req *connect.Request[evidence.StoreEvidenceRequest]) (res *connect.Response[evidence.StoreEvidenceResponse], err error) {
 	var (
-		r *evidence.ResourceSnapshot
+		r          *evidence.ResourceSnapshot
+		blob       *evidence.ResourceBlob
+		stored     *evidence.Evidence
+		pseudonyms []*evidence.Pseudonym
+		collector  *evidence.Collector
+		size       int
 	)
 
 	// Validate request
@@ -242,8 +326,64 @@ func (svc *Service) StoreEvidence(ctx context.Context, req *connect.Request[evid
 		return nil, err
 	}
 
+	// Attribute the evidence to the collector of the token, if any. The collector ID is never taken from the request,
+	// so that collectors cannot store evidences in the name of another collector.
+	collector, err = svc.collectorFromRequest(ctx, req.Header())
+	if err != nil {
+		return nil, err
+	}
+	req.Msg.Evidence.CollectorId = nil
+	if collector != nil {
+		req.Msg.Evidence.CollectorId = &collector.Id
+	}
+
+	// The creation time is set by the server as well, since it starts the retention period of the append-only mode
+	// (see [Config.ImmutableRetention]) and must therefore not be chosen by the client.
+	req.Msg.Evidence.CreatedAt = timestamppb.Now()
+
+	size = proto.Size(req.Msg.Evidence)
+	err = svc.checkCollectorQuota(collector, size, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	ontologyResource := req.Msg.Evidence.GetOntologyResource()
+	if ontologyResource == nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.New("could not convert resource (proto to DB): nil ontology resource"))
+	}
+
+	// Replace personal data by pseudonyms, before the resource is stored or forwarded anywhere
+	pseudonyms = svc.pseudonymizer.pseudonymize(ontologyResource)
+	err = svc.storePseudonyms(pseudonyms)
+	if err = service.HandleDatabaseError(err); err != nil {
+		return nil, err
+	}
+
+	// Extract the resource types, so that we can filter evidences by them without unpacking the resource
+	req.Msg.Evidence.ResourceType = strings.Join(ontology.ResourceTypes(ontologyResource), ",")
+
+	// Store the resource content-addressed, so that byte-identical resources of subsequent discovery runs are only
+	// stored once
+	blob, err = newResourceBlob(req.Msg.Evidence.Resource)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("could not convert resource (proto to DB): %w", err))
+	}
+	req.Msg.Evidence.ResourceHash = blob.Hash
+
+	// The stored evidence only references the blob. We keep the resource in the original evidence, since it is still
+	// needed for the assessment.
+	stored = proto.Clone(req.Msg.Evidence).(*evidence.Evidence)
+	stored.Resource = nil
+
+	// Store the blob first. If storing the evidence fails afterwards, the blob is simply re-used by the next evidence
+	// with the same resource.
+	err = svc.storeResourceBlob(blob)
+	if err = service.HandleDatabaseError(err); err != nil {
+		return nil, err
+	}
+
 	// Store evidence
-	err = svc.db.Create(req.Msg.Evidence)
+	err = svc.db.Create(stored)
 	if err = service.HandleDatabaseError(err); err != nil {
 		return nil, err
 	}
@@ -254,10 +394,6 @@ func (svc *Service) StoreEvidence(ctx context.Context, req *connect.Request[evid
 
 	// Store resource snapshot. This will hold the latest sync state of the resource and its
 	// association to ToE for our storage layer.
-	ontologyResource := req.Msg.Evidence.GetOntologyResource()
-	if ontologyResource == nil {
-		return nil, connect.NewError(connect.CodeInternal, errors.New("could not convert resource (proto to DB): nil ontology resource"))
-	}
 	r, err = evidence.ToResourceSnapshot(
 		ontologyResource,
 		req.Msg.GetTargetOfEvaluationId(),
@@ -277,8 +413,13 @@ func (svc *Service) StoreEvidence(ctx context.Context, req *connect.Request[evid
 		slog.String("resource_type", r.ResourceType),
 		slog.String("evidence_id", req.Msg.Evidence.Id))
 
+	svc.recordCollectorUsage(collector, size)
+
 	go svc.informHooks(ctx, req.Msg.Evidence, nil)
 
+	// Inform the watchers of the evidence change feed
+	svc.publishEvidenceEvent(evidence.EvidenceEventType_EVIDENCE_EVENT_TYPE_CREATED, req.Msg.Evidence)
+
 	// Send evidence to the channel for further processing and acknowledge receipt, without waiting for the processing to finish. This allows the sender to continue
 	// without waiting for the evidence to be processed.
 	svc.channelEvidence <- req.Msg.Evidence
@@ -294,6 +435,17 @@ func (svc *Service) StoreEvidence(ctx context.Context, req *connect.Request[evid
 // success or failure. This implements the [evidenceconnect.EvidenceStoreHandler.StoreEvidences] RPC method.
 func (svc *Service) StoreEvidences(ctx context.Context,
 	stream *connect.BidiStream[evidence.StoreEvidenceRequest, evidence.StoreEvidencesResponse]) (err error) {
+	var collector *evidence.Collector
+
+	// Authenticate the collector once for the whole stream
+	collector, err = svc.authenticateCollector(stream.RequestHeader())
+	if err != nil {
+		return err
+	}
+	if collector != nil {
+		ctx = context.WithValue(ctx, collectorKey{}, collector)
+	}
+
 	// Delegate to a stream-agnostic helper for unit testing with fakes.
 	return svc.storeEvidencesStream(ctx, stream)
 }
@@ -324,7 +476,7 @@ func (svc *Service) storeEvidencesStream(ctx context.Context, stream evidenceStr
 			err = fmt.Errorf("cannot receive stream request: %w", err)
 			slog.Error("failed to receive stream request",
 				tint.Err(err))
-			return connect.NewError(connect.CodeUnknown, err)
+			return connect.NewError(connect.CodeInternal, err)
 		}
 
 		// Call StoreEvidence() for storing a single evidence
@@ -355,7 +507,7 @@ func (svc *Service) storeEvidencesStream(ctx context.Context, stream evidenceStr
 		if err != nil {
 			err = fmt.Errorf("cannot send response to the client: %w", err)
 			slog.Error("failed to send response to client", tint.Err(err))
-			return connect.NewError(connect.CodeUnknown, err)
+			return connect.NewError(connect.CodeInternal, err)
 		}
 	}
 }
@@ -387,6 +539,19 @@ func (svc *Service) ListEvidences(_ context.Context, req *connect.Request[eviden
 			query = append(query, "tool_id = ?")
 			args = append(args, toolId)
 		}
+		if resourceType := filter.GetResourceType(); resourceType != "" {
+			cond, condArgs := resourceTypeCondition(resourceType)
+			query = append(query, cond)
+			args = append(args, condArgs...)
+		}
+		if filter.TimestampAfter != nil {
+			query = append(query, "timestamp >= ?")
+			args = append(args, filter.TimestampAfter.AsTime())
+		}
+		if filter.TimestampBefore != nil {
+			query = append(query, "timestamp < ?")
+			args = append(args, filter.TimestampBefore.AsTime())
+		}
 	}
 
 	// Build conditions for pagination
@@ -405,6 +570,12 @@ func (svc *Service) ListEvidences(_ context.Context, req *connect.Request[eviden
 		return nil, err
 	}
 
+	// Restore the resources from their blobs
+	err = svc.loadResources(res.Msg.Evidences...)
+	if err = service.HandleDatabaseError(err); err != nil {
+		return nil, err
+	}
+
 	return
 }
 
@@ -425,6 +596,12 @@ func (svc *Service) GetEvidence(_ context.Context, req *connect.Request[evidence
 		return nil, err
 	}
 
+	// Restore the resource from its blob
+	err = svc.loadResources(res.Msg)
+	if err = service.HandleDatabaseError(err); err != nil {
+		return nil, err
+	}
+
 	return
 }
 
@@ -497,8 +674,9 @@ func (svc *Service) ListResources(_ context.Context, req *connect.Request[eviden
 			args = append(args, f.GetTargetOfEvaluationId())
 		}
 		if f.Type != nil {
-			query = append(query, "(resource_type LIKE ? OR resource_type LIKE ? OR resource_type LIKE ?)")
-			args = append(args, f.GetType()+",%", "%,"+f.GetType()+",%", "%,"+f.GetType())
+			cond, condArgs := resourceTypeCondition(f.GetType())
+			query = append(query, cond)
+			args = append(args, condArgs...)
 		}
 		if f.ToolId != nil {
 			query = append(query, "tool_id = ?")
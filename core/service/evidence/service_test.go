@@ -37,6 +37,8 @@ import (
 	"confirmate.io/core/util/assert"
 	"connectrpc.com/connect"
 	"github.com/google/uuid"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func TestMain(m *testing.M) {
@@ -120,6 +122,28 @@ func TestNewService(t *testing.T) {
 			},
 			wantErr: assert.NoError,
 		},
+		{
+			name: "EvidenceStoreServer created in append-only mode",
+			args: args{opts: []service.Option[Service]{
+				WithConfig(Config{
+					AssessmentAddress: DefaultAssessmentURL,
+					PersistenceConfig: persistence.Config{
+						InMemoryDB: true,
+					},
+					EvidenceQueueSize:  DefaultConfig.EvidenceQueueSize,
+					ImmutableRetention: 24 * time.Hour,
+				}),
+			}},
+			want: func(t *testing.T, got *Service, msgAndArgs ...any) bool {
+				e := &evidence.Evidence{Id: uuid.NewString(), Timestamp: timestamppb.Now(), CreatedAt: timestamppb.Now()}
+				assert.NoError(t, got.db.Create(e))
+
+				// Stored evidences can neither be updated nor deleted within the retention period
+				return assert.ErrorIs(t, got.db.Save(e), persistence.ErrImmutable) &&
+					assert.ErrorIs(t, got.db.Delete(&evidence.Evidence{}, "id = ?", e.Id), persistence.ErrImmutable)
+			},
+			wantErr: assert.NoError,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -390,6 +414,43 @@ func TestService_StoreEvidence(t *testing.T) {
 	}
 }
 
+func TestService_StoreEvidence_appendOnly(t *testing.T) {
+	var (
+		old = timestamppb.New(time.Now().Add(-48 * time.Hour))
+		req = connect.NewRequest(&evidence.StoreEvidenceRequest{Evidence: &evidence.Evidence{
+			Id:                   uuid.NewString(),
+			Timestamp:            old,
+			TargetOfEvaluationId: uuid.NewString(),
+			ToolId:               "MockTool1",
+			Resource:             ontology.ProtoResource(&ontology.VirtualMachine{Id: "vm-1", Name: "vm-1"}),
+			// Clients cannot backdate the start of the retention period
+			CreatedAt: old,
+		}})
+	)
+
+	db, err := persistence.NewDB(persistence.WithConfig(persistence.Config{
+		InMemoryDB: true,
+		Types:      types,
+		Immutable:  immutableTypes(24 * time.Hour),
+	}))
+	assert.NoError(t, err)
+
+	svc := &Service{
+		db:              db,
+		channelEvidence: make(chan *evidence.Evidence, defaultEvidenceQueueSize),
+	}
+
+	_, err = svc.StoreEvidence(context.Background(), req)
+	assert.NoError(t, err)
+
+	stored := assert.InDB[evidence.Evidence](t, db, req.Msg.Evidence.Id)
+	assert.True(t, stored.CreatedAt.AsTime().After(old.AsTime()))
+
+	// The evidence is within its retention period, although its timestamp is not
+	err = db.Delete(&evidence.Evidence{}, "id = ?", req.Msg.Evidence.Id)
+	assert.ErrorIs(t, err, persistence.ErrImmutable)
+}
+
 // TestService_StoreEvidences tests the streaming StoreEvidences RPC.
 // It focuses on happy-path Send/Receive cycles and per-message status handling.
 func TestService_StoreEvidences(t *testing.T) {
@@ -593,13 +654,13 @@ func TestService_StoreEvidences_SendErrors(t *testing.T) {
 			wantErr: assert.NoError,
 		},
 		{
-			name: "send error returns CodeUnknown",
+			name: "send error returns CodeInternal",
 			fields: fields{
 				db: persistencetest.NewInMemoryDB(t, types, nil),
 			},
 			sendErr: errors.New("send failed"),
 			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
-				return assert.IsConnectError(t, err, connect.CodeUnknown)
+				return assert.IsConnectError(t, err, connect.CodeInternal)
 			},
 		},
 	}
@@ -733,6 +794,83 @@ func TestService_ListEvidences(t *testing.T) {
 			},
 			wantErr: assert.NoError,
 		},
+		{
+			name: "happy path - filter by resource type",
+			fields: fields{db: persistencetest.NewInMemoryDB(t, types, nil, func(db persistence.DB) {
+				vm := proto.Clone(ev1).(*evidence.Evidence)
+				vm.ResourceType = "VirtualMachine,Compute,Resource"
+				storage := proto.Clone(ev2).(*evidence.Evidence)
+				storage.ResourceType = "ObjectStorage,Storage,Resource"
+				assert.NoError(t, db.Create(vm))
+				assert.NoError(t, db.Create(storage))
+			})},
+			req: &connect.Request[evidence.ListEvidencesRequest]{Msg: &evidence.ListEvidencesRequest{
+				Filter: &evidence.Filter{ResourceType: new("VirtualMachine")},
+			}},
+			want: func(t *testing.T, got *connect.Response[evidence.ListEvidencesResponse], msgAndArgs ...any) bool {
+				assert.NotNil(t, got)
+				if !assert.Equal(t, 1, len(got.Msg.Evidences)) {
+					return false
+				}
+				return assert.Equal(t, ev1.Id, got.Msg.Evidences[0].Id)
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "happy path - filter by single resource type",
+			fields: fields{db: persistencetest.NewInMemoryDB(t, types, nil, func(db persistence.DB) {
+				resource := proto.Clone(ev1).(*evidence.Evidence)
+				resource.ResourceType = "Resource"
+				vm := proto.Clone(ev2).(*evidence.Evidence)
+				vm.ResourceType = "VirtualMachine,Compute,Resource"
+				storage := proto.Clone(ev3).(*evidence.Evidence)
+				storage.ResourceType = "ObjectStorage,Storage"
+				assert.NoError(t, db.Create(resource))
+				assert.NoError(t, db.Create(vm))
+				assert.NoError(t, db.Create(storage))
+			})},
+			req: &connect.Request[evidence.ListEvidencesRequest]{Msg: &evidence.ListEvidencesRequest{
+				Filter: &evidence.Filter{ResourceType: new("Resource")},
+			}},
+			want: func(t *testing.T, got *connect.Response[evidence.ListEvidencesResponse], msgAndArgs ...any) bool {
+				assert.NotNil(t, got)
+				if !assert.Equal(t, 2, len(got.Msg.Evidences)) {
+					return false
+				}
+				ids := []string{got.Msg.Evidences[0].Id, got.Msg.Evidences[1].Id}
+				assert.Contains(t, ids, ev1.Id)
+				return assert.Contains(t, ids, ev2.Id)
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "happy path - filter by time window",
+			fields: fields{db: persistencetest.NewInMemoryDB(t, types, nil, func(db persistence.DB) {
+				older := proto.Clone(ev1).(*evidence.Evidence)
+				older.Timestamp = timestamppb.New(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+				inWindow := proto.Clone(ev2).(*evidence.Evidence)
+				inWindow.Timestamp = timestamppb.New(time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC))
+				newer := proto.Clone(ev3).(*evidence.Evidence)
+				newer.Timestamp = timestamppb.New(time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC))
+				assert.NoError(t, db.Create(older))
+				assert.NoError(t, db.Create(inWindow))
+				assert.NoError(t, db.Create(newer))
+			})},
+			req: &connect.Request[evidence.ListEvidencesRequest]{Msg: &evidence.ListEvidencesRequest{
+				Filter: &evidence.Filter{
+					TimestampAfter:  timestamppb.New(time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC)),
+					TimestampBefore: timestamppb.New(time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)),
+				},
+			}},
+			want: func(t *testing.T, got *connect.Response[evidence.ListEvidencesResponse], msgAndArgs ...any) bool {
+				assert.NotNil(t, got)
+				if !assert.Equal(t, 1, len(got.Msg.Evidences)) {
+					return false
+				}
+				return assert.Equal(t, ev2.Id, got.Msg.Evidences[0].Id)
+			},
+			wantErr: assert.NoError,
+		},
 		{
 			name: "happy path - pagination",
 			fields: fields{db: persistencetest.NewInMemoryDB(t, types, nil, func(db persistence.DB) {
@@ -0,0 +1,183 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package evidence
+
+import (
+	"context"
+	"log/slog"
+	"slices"
+	"strings"
+
+	"confirmate.io/core/api/evidence"
+	"confirmate.io/core/service"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// watcherBufferSize is the number of events that are buffered for each watcher before further events are dropped.
+const watcherBufferSize = 100
+
+// watcher is a consumer of the evidence change feed registered by [Service.WatchEvidences].
+type watcher struct {
+	ch     chan *evidence.EvidenceEvent
+	filter *evidence.Filter
+
+	// allToEs specifies whether the watcher may see the evidences of all targets of evaluation. Otherwise, only
+	// evidences of the targets of evaluation in toeIds are sent.
+	allToEs bool
+	toeIds  []string
+}
+
+// evidenceEventStream abstracts the server stream to allow deterministic unit tests, including send error cases.
+type evidenceEventStream interface {
+	Send(*evidence.EvidenceEvent) error
+}
+
+// WatchEvidences streams an event for each newly stored evidence that matches the filter of the request. Only
+// evidences of targets of evaluation the caller is allowed to access are streamed.
+// This implements the [evidenceconnect.EvidenceStoreHandler.WatchEvidences] RPC method.
+func (svc *Service) WatchEvidences(ctx context.Context, req *connect.Request[evidence.WatchEvidencesRequest],
+	stream *connect.ServerStream[evidence.EvidenceEvent]) (err error) {
+	// Delegate to a stream-agnostic helper for unit testing with fakes.
+	return svc.watchEvidencesStream(ctx, req, stream)
+}
+
+// watchEvidencesStream registers a watcher and sends its events to the stream until the context is done or sending
+// fails.
+func (svc *Service) watchEvidencesStream(ctx context.Context, req *connect.Request[evidence.WatchEvidencesRequest],
+	stream evidenceEventStream) (err error) {
+	var (
+		all    bool
+		toeIds []string
+		ch     <-chan *evidence.EvidenceEvent
+		id     int64
+	)
+
+	// Validate request
+	if err = service.Validate(req); err != nil {
+		return err
+	}
+
+	// Restrict the watcher to the allowed targets of evaluation
+	all, toeIds = svc.authz.AllowedTargetOfEvaluations(ctx)
+	if toeId := req.Msg.GetFilter().GetTargetOfEvaluationId(); toeId != "" && !all && !slices.Contains(toeIds, toeId) {
+		return service.ErrPermissionDenied
+	}
+
+	ch, id = svc.registerWatcher(&watcher{
+		filter:  req.Msg.GetFilter(),
+		allToEs: all,
+		toeIds:  toeIds,
+	})
+
+	// Ensure cleanup on return
+	defer svc.unregisterWatcher(id)
+
+	// Send events to the stream
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case event, ok := <-ch:
+			if !ok {
+				return nil
+			}
+			if err = stream.Send(event); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// registerWatcher registers w and returns the channel its events are sent to, as well as its ID.
+func (svc *Service) registerWatcher(w *watcher) (<-chan *evidence.EvidenceEvent, int64) {
+	w.ch = make(chan *evidence.EvidenceEvent, watcherBufferSize)
+
+	svc.watchersMutex.Lock()
+	if svc.watchers == nil {
+		svc.watchers = make(map[int64]*watcher)
+	}
+	id := svc.nextWatcherId
+	svc.nextWatcherId++
+	svc.watchers[id] = w
+	svc.watchersMutex.Unlock()
+
+	slog.Debug("Registered evidence watcher", slog.Int64("id", id), slog.Any("filter", w.filter))
+
+	return w.ch, id
+}
+
+// unregisterWatcher un-registers the watcher with the given ID.
+func (svc *Service) unregisterWatcher(id int64) {
+	svc.watchersMutex.Lock()
+	defer svc.watchersMutex.Unlock()
+
+	if w, ok := svc.watchers[id]; ok {
+		delete(svc.watchers, id)
+		close(w.ch)
+	}
+}
+
+// publishEvidenceEvent publishes an event of the given type for ev to all watchers whose filter matches. Watchers that
+// do not keep up are skipped, so that storing evidences is never blocked by a slow consumer.
+func (svc *Service) publishEvidenceEvent(typ evidence.EvidenceEventType, ev *evidence.Evidence) {
+	event := &evidence.EvidenceEvent{
+		Type:      typ,
+		Evidence:  ev,
+		Timestamp: timestamppb.Now(),
+	}
+
+	svc.watchersMutex.RLock()
+	defer svc.watchersMutex.RUnlock()
+
+	for id, w := range svc.watchers {
+		if !w.matches(ev) {
+			continue
+		}
+
+		select {
+		case w.ch <- event:
+		default:
+			// Channel is full, skip this watcher to avoid blocking
+			slog.Warn("Evidence watcher is not keeping up, dropping event",
+				slog.Int64("id", id),
+				slog.String("evidence_id", ev.GetId()))
+		}
+	}
+}
+
+// matches returns whether ev is allowed for and matches the filter of the watcher.
+func (w *watcher) matches(ev *evidence.Evidence) bool {
+	if !w.allToEs && !slices.Contains(w.toeIds, ev.GetTargetOfEvaluationId()) {
+		return false
+	}
+
+	if w.filter == nil {
+		return true
+	}
+	if w.filter.TargetOfEvaluationId != nil && w.filter.GetTargetOfEvaluationId() != ev.GetTargetOfEvaluationId() {
+		return false
+	}
+	if w.filter.ToolId != nil && w.filter.GetToolId() != ev.GetToolId() {
+		return false
+	}
+	if w.filter.ResourceType != nil && !slices.Contains(strings.Split(ev.GetResourceType(), ","), w.filter.GetResourceType()) {
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,291 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package evidence
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"confirmate.io/core/api/evidence"
+	"confirmate.io/core/api/ontology"
+	"confirmate.io/core/persistence/persistencetest"
+	"confirmate.io/core/service"
+	"confirmate.io/core/service/evidence/evidencetest"
+	"confirmate.io/core/util/assert"
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/proto"
+)
+
+// toeAuthorizationStrategy allows all requests, but restricts the allowed targets of evaluation to toeIds.
+type toeAuthorizationStrategy struct {
+	service.AuthorizationStrategyAllowAll
+	toeIds []string
+}
+
+// AllowedTargetOfEvaluations returns the configured target of evaluation IDs.
+func (a *toeAuthorizationStrategy) AllowedTargetOfEvaluations(_ context.Context) (bool, []string) {
+	return false, a.toeIds
+}
+
+// fakeEvidenceEventStream records the events sent by the watcher.
+type fakeEvidenceEventStream struct {
+	events  chan *evidence.EvidenceEvent
+	sendErr error
+}
+
+// Send records the event or returns the configured error.
+func (s *fakeEvidenceEventStream) Send(event *evidence.EvidenceEvent) error {
+	if s.sendErr != nil {
+		return s.sendErr
+	}
+
+	s.events <- event
+	return nil
+}
+
+// waitForWatchers waits until n watchers are registered at svc.
+func waitForWatchers(t *testing.T, svc *Service, n int) {
+	t.Helper()
+
+	for range 100 {
+		svc.watchersMutex.RLock()
+		l := len(svc.watchers)
+		svc.watchersMutex.RUnlock()
+		if l == n {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	t.Fatalf("expected %d watchers", n)
+}
+
+func TestService_WatchEvidences(t *testing.T) {
+	type fields struct {
+		authz service.AuthorizationStrategy
+	}
+	type args struct {
+		req *evidence.WatchEvidencesRequest
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		wantErr assert.WantErr
+	}{
+		{
+			name: "validation error",
+			fields: fields{
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			args: args{
+				req: &evidence.WatchEvidencesRequest{
+					Filter: &evidence.Filter{TargetOfEvaluationId: new("not-a-uuid")},
+				},
+			},
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsValidationError(t, err, "filter.target_of_evaluation_id")
+			},
+		},
+		{
+			name: "permission denied",
+			fields: fields{
+				authz: &toeAuthorizationStrategy{toeIds: []string{evidencetest.MockTargetOfEvaluationID1}},
+			},
+			args: args{
+				req: &evidence.WatchEvidencesRequest{
+					Filter: &evidence.Filter{TargetOfEvaluationId: new(evidencetest.MockTargetOfEvaluationID2)},
+				},
+			},
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.ErrorIs(t, err, service.ErrPermissionDenied)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &Service{
+				authz: tt.fields.authz,
+			}
+
+			err := svc.watchEvidencesStream(context.Background(), connect.NewRequest(tt.args.req),
+				&fakeEvidenceEventStream{})
+			tt.wantErr(t, err)
+			assert.Empty(t, svc.watchers)
+		})
+	}
+}
+
+func TestService_WatchEvidences_stream(t *testing.T) {
+	var (
+		ctx, cancel = context.WithCancel(context.Background())
+		stream      = &fakeEvidenceEventStream{events: make(chan *evidence.EvidenceEvent, 10)}
+		errc        = make(chan error, 1)
+		other       *evidence.Evidence
+		ev          *evidence.Evidence
+		event       *evidence.EvidenceEvent
+	)
+	defer cancel()
+
+	svc := &Service{
+		db:              persistencetest.NewInMemoryDB(t, types, nil),
+		channelEvidence: make(chan *evidence.Evidence, defaultEvidenceQueueSize),
+		authz:           &toeAuthorizationStrategy{toeIds: []string{evidencetest.MockTargetOfEvaluationID1}},
+	}
+
+	go func() {
+		errc <- svc.watchEvidencesStream(ctx, connect.NewRequest(&evidence.WatchEvidencesRequest{
+			Filter: &evidence.Filter{ResourceType: new("VirtualMachine")},
+		}), stream)
+	}()
+	waitForWatchers(t, svc, 1)
+
+	// The evidence of another target of evaluation must not be streamed
+	other = proto.Clone(evidencetest.MockEvidenceWithVMResource).(*evidence.Evidence)
+	other.Id = uuid.NewString()
+	other.TargetOfEvaluationId = evidencetest.MockTargetOfEvaluationID2
+	_, err := svc.StoreEvidence(ctx, connect.NewRequest(&evidence.StoreEvidenceRequest{Evidence: other}))
+	assert.NoError(t, err)
+
+	ev = proto.Clone(evidencetest.MockEvidenceWithVMResource).(*evidence.Evidence)
+	ev.Id = uuid.NewString()
+	ev.TargetOfEvaluationId = evidencetest.MockTargetOfEvaluationID1
+	_, err = svc.StoreEvidence(ctx, connect.NewRequest(&evidence.StoreEvidenceRequest{Evidence: ev}))
+	assert.NoError(t, err)
+
+	select {
+	case event = <-stream.events:
+	case <-time.After(time.Second):
+		t.Fatal("expected an evidence event")
+	}
+	assert.Equal(t, evidence.EvidenceEventType_EVIDENCE_EVENT_TYPE_CREATED, event.Type)
+	assert.Equal(t, ev.Id, event.Evidence.GetId())
+	assert.NotNil(t, event.Evidence.GetResource())
+	assert.NotNil(t, event.Timestamp)
+	assert.Empty(t, stream.events)
+
+	cancel()
+	assert.ErrorIs(t, <-errc, context.Canceled)
+	assert.Empty(t, svc.watchers)
+}
+
+func TestService_WatchEvidences_sendError(t *testing.T) {
+	var (
+		sendErr = errors.New("send failed")
+		errc    = make(chan error, 1)
+	)
+
+	svc := &Service{
+		authz: &service.AuthorizationStrategyAllowAll{},
+	}
+
+	go func() {
+		errc <- svc.watchEvidencesStream(context.Background(), connect.NewRequest(&evidence.WatchEvidencesRequest{}),
+			&fakeEvidenceEventStream{sendErr: sendErr})
+	}()
+	waitForWatchers(t, svc, 1)
+
+	svc.publishEvidenceEvent(evidence.EvidenceEventType_EVIDENCE_EVENT_TYPE_CREATED, evidencetest.MockEvidenceWithVMResource)
+
+	assert.ErrorIs(t, <-errc, sendErr)
+	assert.Empty(t, svc.watchers)
+}
+
+func Test_watcher_matches(t *testing.T) {
+	ev := &evidence.Evidence{
+		Id:                   uuid.NewString(),
+		TargetOfEvaluationId: evidencetest.MockTargetOfEvaluationID1,
+		ToolId:               "tool-a",
+		ResourceType:         "VirtualMachine,Compute,Resource",
+		Resource: &ontology.Resource{Type: &ontology.Resource_VirtualMachine{
+			VirtualMachine: &ontology.VirtualMachine{Id: "vm-1"},
+		}},
+	}
+
+	tests := []struct {
+		name string
+		w    *watcher
+		want bool
+	}{
+		{
+			name: "no filter",
+			w:    &watcher{allToEs: true},
+			want: true,
+		},
+		{
+			name: "target of evaluation not allowed",
+			w:    &watcher{toeIds: []string{evidencetest.MockTargetOfEvaluationID2}},
+			want: false,
+		},
+		{
+			name: "target of evaluation allowed",
+			w:    &watcher{toeIds: []string{evidencetest.MockTargetOfEvaluationID1}},
+			want: true,
+		},
+		{
+			name: "other target of evaluation",
+			w: &watcher{
+				allToEs: true,
+				filter:  &evidence.Filter{TargetOfEvaluationId: new(evidencetest.MockTargetOfEvaluationID2)},
+			},
+			want: false,
+		},
+		{
+			name: "other tool",
+			w: &watcher{
+				allToEs: true,
+				filter:  &evidence.Filter{ToolId: new("tool-b")},
+			},
+			want: false,
+		},
+		{
+			name: "matching resource type",
+			w: &watcher{
+				allToEs: true,
+				filter:  &evidence.Filter{ResourceType: new("Compute")},
+			},
+			want: true,
+		},
+		{
+			name: "resource type is only a prefix",
+			w: &watcher{
+				allToEs: true,
+				filter:  &evidence.Filter{ResourceType: new("Virtual")},
+			},
+			want: false,
+		},
+		{
+			name: "all filters match",
+			w: &watcher{
+				allToEs: true,
+				filter: &evidence.Filter{
+					TargetOfEvaluationId: new(evidencetest.MockTargetOfEvaluationID1),
+					ToolId:               new("tool-a"),
+					ResourceType:         new("VirtualMachine"),
+				},
+			},
+			want: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.w.matches(ev))
+		})
+	}
+}
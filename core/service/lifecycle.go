@@ -0,0 +1,87 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// Lifecycle is implemented by services that run background work besides serving requests, e.g., periodic jobs or
+// queue workers. The launcher starts all services with [Lifecycle.Start] before serving requests and stops them
+// with [Lifecycle.Shutdown] after the server stopped.
+type Lifecycle interface {
+	// Start starts the background work of the service. The given context only bounds the startup itself, the
+	// background work runs until [Lifecycle.Shutdown] is called.
+	Start(ctx context.Context) error
+
+	// Shutdown stops the background work of the service and waits until it returned or ctx is done.
+	Shutdown(ctx context.Context) error
+}
+
+// Workers tracks the goroutines of the background work of a service, so that all of them are stopped on shutdown
+// instead of leaking. The zero value is ready to use.
+type Workers struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+	mu     sync.Mutex
+}
+
+// Go runs fn in a new goroutine. The context passed to fn is canceled on [Workers.Stop], so fn must return once it
+// is done.
+func (w *Workers) Go(fn func(ctx context.Context)) {
+	var ctx context.Context
+
+	w.mu.Lock()
+	if w.ctx == nil {
+		w.ctx, w.cancel = context.WithCancel(context.Background())
+	}
+	ctx = w.ctx
+	w.wg.Add(1)
+	w.mu.Unlock()
+
+	go func() {
+		defer w.wg.Done()
+		fn(ctx)
+	}()
+}
+
+// Stop cancels the context of all goroutines started with [Workers.Go] and waits until they returned or ctx is
+// done. Goroutines started afterwards get a new context.
+func (w *Workers) Stop(ctx context.Context) (err error) {
+	var done = make(chan struct{})
+
+	w.mu.Lock()
+	if w.cancel != nil {
+		w.cancel()
+	}
+	w.ctx, w.cancel = nil, nil
+	w.mu.Unlock()
+
+	go func() {
+		w.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("could not stop all workers: %w", ctx.Err())
+	}
+}
@@ -0,0 +1,185 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"sync"
+	"time"
+
+	"confirmate.io/core/log"
+)
+
+// ComponentState is the state of a component of the [LifecycleManager].
+type ComponentState string
+
+const (
+	// ComponentStateRegistered is the state of a component that was not started yet.
+	ComponentStateRegistered ComponentState = "registered"
+	// ComponentStateStarting is the state of a component while it is started.
+	ComponentStateStarting ComponentState = "starting"
+	// ComponentStateRunning is the state of a component that was started successfully.
+	ComponentStateRunning ComponentState = "running"
+	// ComponentStateStopping is the state of a component while it is shut down.
+	ComponentStateStopping ComponentState = "stopping"
+	// ComponentStateStopped is the state of a component that was shut down successfully.
+	ComponentStateStopped ComponentState = "stopped"
+	// ComponentStateFailed is the state of a component that could not be started or shut down.
+	ComponentStateFailed ComponentState = "failed"
+)
+
+// ComponentStatus is the status of a component of the [LifecycleManager].
+type ComponentStatus struct {
+	// Name is the name the component was registered with.
+	Name string
+	// State is the current state of the component.
+	State ComponentState
+	// Since is the time of the last state change.
+	Since time.Time
+	// Err is the reason why the component is in [ComponentStateFailed].
+	Err error
+}
+
+// component is a [Lifecycle] registered at the [LifecycleManager].
+type component struct {
+	lifecycle Lifecycle
+	status    ComponentStatus
+}
+
+// LifecycleManager coordinates the startup and shutdown of several [Lifecycle] components. Components are started in
+// the order they were registered and shut down in the reverse order, so that a component can rely on the components
+// registered before it during its whole lifetime.
+type LifecycleManager struct {
+	components []*component
+	mu         sync.RWMutex
+}
+
+// NewLifecycleManager creates a new [LifecycleManager] without any components.
+func NewLifecycleManager() *LifecycleManager {
+	return &LifecycleManager{}
+}
+
+// Register adds the component c with the given name. Components must be registered before [LifecycleManager.Start]
+// is called.
+func (m *LifecycleManager) Register(name string, c Lifecycle) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.components = append(m.components, &component{
+		lifecycle: c,
+		status: ComponentStatus{
+			Name:  name,
+			State: ComponentStateRegistered,
+			Since: time.Now(),
+		},
+	})
+}
+
+// Start starts all registered components in the order of their registration. If a component fails to start, the
+// components started before are shut down again in reverse order and the error is returned.
+func (m *LifecycleManager) Start(ctx context.Context) (err error) {
+	for _, c := range m.list() {
+		m.setState(c, ComponentStateStarting, nil)
+
+		err = c.lifecycle.Start(ctx)
+		if err != nil {
+			err = fmt.Errorf("could not start %s: %w", c.status.Name, err)
+			m.setState(c, ComponentStateFailed, err)
+
+			return errors.Join(err, m.Shutdown(ctx))
+		}
+
+		m.setState(c, ComponentStateRunning, nil)
+	}
+
+	return nil
+}
+
+// Shutdown shuts down all running components in the reverse order of their registration. A component that fails to
+// shut down does not prevent the shutdown of the remaining components. All errors are returned joined.
+func (m *LifecycleManager) Shutdown(ctx context.Context) (err error) {
+	var errs []error
+
+	for _, c := range slices.Backward(m.list()) {
+		if m.state(c) != ComponentStateRunning {
+			continue
+		}
+
+		m.setState(c, ComponentStateStopping, nil)
+
+		err = c.lifecycle.Shutdown(ctx)
+		if err != nil {
+			err = fmt.Errorf("could not shut down %s: %w", c.status.Name, err)
+			m.setState(c, ComponentStateFailed, err)
+			errs = append(errs, err)
+			continue
+		}
+
+		m.setState(c, ComponentStateStopped, nil)
+	}
+
+	return errors.Join(errs...)
+}
+
+// Status returns the status of all registered components in the order of their registration.
+func (m *LifecycleManager) Status() (status []ComponentStatus) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	status = make([]ComponentStatus, 0, len(m.components))
+	for _, c := range m.components {
+		status = append(status, c.status)
+	}
+
+	return
+}
+
+// list returns a copy of the registered components, so that they can be started and shut down without holding the
+// lock.
+func (m *LifecycleManager) list() []*component {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return slices.Clone(m.components)
+}
+
+// state returns the current state of the component c.
+func (m *LifecycleManager) state(c *component) ComponentState {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return c.status.State
+}
+
+// setState changes the state of the component c and logs the change.
+func (m *LifecycleManager) setState(c *component, state ComponentState, err error) {
+	m.mu.Lock()
+	c.status.State = state
+	c.status.Since = time.Now()
+	c.status.Err = err
+	m.mu.Unlock()
+
+	if err != nil {
+		slog.Error("Component failed", slog.String("component", c.status.Name), log.Err(err))
+		return
+	}
+
+	slog.Info("Component state changed", slog.String("component", c.status.Name), slog.String("state", string(state)))
+}
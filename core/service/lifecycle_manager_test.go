@@ -0,0 +1,140 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"confirmate.io/core/service"
+	"confirmate.io/core/util/assert"
+)
+
+// mockComponent is a [service.Lifecycle] that records its calls in calls and fails with the configured errors.
+type mockComponent struct {
+	name        string
+	calls       *[]string
+	startErr    error
+	shutdownErr error
+}
+
+func (c *mockComponent) Start(_ context.Context) error {
+	*c.calls = append(*c.calls, "start "+c.name)
+	return c.startErr
+}
+
+func (c *mockComponent) Shutdown(_ context.Context) error {
+	*c.calls = append(*c.calls, "shutdown "+c.name)
+	return c.shutdownErr
+}
+
+func TestLifecycleManager_Start(t *testing.T) {
+	tests := []struct {
+		name       string
+		startErr   map[string]error
+		wantCalls  []string
+		wantStates []service.ComponentState
+		wantErr    assert.WantErr
+	}{
+		{
+			name:       "happy path",
+			wantCalls:  []string{"start a", "start b", "start c"},
+			wantStates: []service.ComponentState{service.ComponentStateRunning, service.ComponentStateRunning, service.ComponentStateRunning},
+			wantErr:    assert.NoError,
+		},
+		{
+			name:       "component fails to start",
+			startErr:   map[string]error{"b": errors.New("some error")},
+			wantCalls:  []string{"start a", "start b", "shutdown a"},
+			wantStates: []service.ComponentState{service.ComponentStateStopped, service.ComponentStateFailed, service.ComponentStateRegistered},
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.ErrorContains(t, err, "could not start b: some error")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				calls []string
+				m     = service.NewLifecycleManager()
+			)
+
+			for _, name := range []string{"a", "b", "c"} {
+				m.Register(name, &mockComponent{name: name, calls: &calls, startErr: tt.startErr[name]})
+			}
+
+			tt.wantErr(t, m.Start(context.Background()))
+			assert.Equal(t, tt.wantCalls, calls)
+			assert.Equal(t, tt.wantStates, states(m.Status()))
+		})
+	}
+}
+
+func TestLifecycleManager_Shutdown(t *testing.T) {
+	tests := []struct {
+		name        string
+		shutdownErr map[string]error
+		wantCalls   []string
+		wantStates  []service.ComponentState
+		wantErr     assert.WantErr
+	}{
+		{
+			name:       "happy path",
+			wantCalls:  []string{"shutdown c", "shutdown b", "shutdown a"},
+			wantStates: []service.ComponentState{service.ComponentStateStopped, service.ComponentStateStopped, service.ComponentStateStopped},
+			wantErr:    assert.NoError,
+		},
+		{
+			name:        "component fails to shut down",
+			shutdownErr: map[string]error{"b": errors.New("some error")},
+			wantCalls:   []string{"shutdown c", "shutdown b", "shutdown a"},
+			wantStates:  []service.ComponentState{service.ComponentStateStopped, service.ComponentStateFailed, service.ComponentStateStopped},
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.ErrorContains(t, err, "could not shut down b: some error")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				calls []string
+				m     = service.NewLifecycleManager()
+			)
+
+			for _, name := range []string{"a", "b", "c"} {
+				m.Register(name, &mockComponent{name: name, calls: &calls, shutdownErr: tt.shutdownErr[name]})
+			}
+			assert.NoError(t, m.Start(context.Background()))
+			calls = nil
+
+			tt.wantErr(t, m.Shutdown(context.Background()))
+			assert.Equal(t, tt.wantCalls, calls)
+			assert.Equal(t, tt.wantStates, states(m.Status()))
+		})
+	}
+}
+
+// states returns the states of the given component statuses.
+func states(status []service.ComponentStatus) (states []service.ComponentState) {
+	for _, s := range status {
+		states = append(states, s.State)
+	}
+
+	return
+}
@@ -0,0 +1,78 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package service_test
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"confirmate.io/core/service"
+	"confirmate.io/core/util/assert"
+)
+
+func TestWorkers_Stop(t *testing.T) {
+	tests := []struct {
+		name    string
+		fn      func(ctx context.Context)
+		timeout time.Duration
+		wantErr assert.WantErr
+	}{
+		{
+			name: "happy path",
+			fn: func(ctx context.Context) {
+				<-ctx.Done()
+			},
+			timeout: time.Second,
+			wantErr: assert.NoError,
+		},
+		{
+			name: "worker does not return in time",
+			fn: func(_ context.Context) {
+				time.Sleep(time.Second)
+			},
+			timeout: 10 * time.Millisecond,
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.ErrorIs(t, err, context.DeadlineExceeded)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				w       service.Workers
+				started = make(chan struct{})
+				stopped atomic.Bool
+			)
+
+			w.Go(func(ctx context.Context) {
+				close(started)
+				tt.fn(ctx)
+				stopped.Store(true)
+			})
+			<-started
+
+			ctx, cancel := context.WithTimeout(context.Background(), tt.timeout)
+			defer cancel()
+
+			err := w.Stop(ctx)
+			tt.wantErr(t, err)
+			assert.Equal(t, err == nil, stopped.Load())
+		})
+	}
+}
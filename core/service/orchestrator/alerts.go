@@ -0,0 +1,301 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package orchestrator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"confirmate.io/core/api/assessment"
+	"confirmate.io/core/api/orchestrator"
+	"confirmate.io/core/log"
+	"confirmate.io/core/service"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// DefaultAnomalyCheckInterval is the default interval in which assessment results are checked for anomalies.
+	DefaultAnomalyCheckInterval = time.Hour
+
+	// DefaultAnomalyBaselinePeriod is the default period the result volume of a target of evaluation is compared
+	// against.
+	DefaultAnomalyBaselinePeriod = 7 * 24 * time.Hour
+
+	// DefaultAnomalyVolumeDropThreshold is the default relative drop of the result volume at which an alert is raised.
+	DefaultAnomalyVolumeDropThreshold = 0.5
+
+	// DefaultAnomalyFlappingThreshold is the default number of compliance changes within the detection window at which
+	// an alert is raised.
+	DefaultAnomalyFlappingThreshold = 4
+
+	// minExpectedResults is the minimum number of results expected within the detection window for a volume drop to be
+	// considered an anomaly. It prevents alerts for targets of evaluation that only receive a few results anyway.
+	minExpectedResults = 10
+)
+
+// ListAlerts lists the alerts raised by the anomaly detection of incoming assessment results. Only alerts of targets
+// of evaluation the user has access to are returned.
+func (svc *Service) ListAlerts(
+	ctx context.Context,
+	req *connect.Request[orchestrator.ListAlertsRequest],
+) (res *connect.Response[orchestrator.ListAlertsResponse], err error) {
+	var (
+		alerts []*orchestrator.Alert
+		npt    string
+		query  []string
+		args   []any
+		conds  []any
+		all    bool
+		toeIds []string
+	)
+
+	// Validate the request
+	if err = service.Validate(req); err != nil {
+		return nil, err
+	}
+
+	// Set default ordering
+	if req.Msg.OrderBy == "" {
+		req.Msg.OrderBy = "detected_at"
+		req.Msg.Asc = false
+	}
+
+	if req.Msg.Filter != nil {
+		if req.Msg.Filter.TargetOfEvaluationId != nil {
+			query = append(query, "target_of_evaluation_id = ?")
+			args = append(args, req.Msg.Filter.GetTargetOfEvaluationId())
+		}
+		if req.Msg.Filter.Type != nil {
+			query = append(query, "type = ?")
+			args = append(args, req.Msg.Filter.GetType())
+		}
+	}
+
+	// Retrieve list of all allowed ToE IDs for the user to filter alerts by access permissions
+	all, toeIds = svc.authz.AllowedTargetOfEvaluations(ctx)
+	if !all && len(toeIds) == 0 {
+		return connect.NewResponse(&orchestrator.ListAlertsResponse{
+			Alerts: []*orchestrator.Alert{},
+		}), nil
+	}
+	if !all {
+		query = append(query, "target_of_evaluation_id IN ?")
+		args = append(args, toeIds)
+	}
+
+	if len(query) > 0 {
+		conds = append([]any{strings.Join(query, " AND ")}, args...)
+	}
+
+	alerts, npt, err = service.PaginateStorage[*orchestrator.Alert](req.Msg, svc.db, service.DefaultPaginationOpts,
+		conds...)
+	if err = service.HandleDatabaseError(err); err != nil {
+		return nil, err
+	}
+
+	res = connect.NewResponse(&orchestrator.ListAlertsResponse{
+		Alerts:        alerts,
+		NextPageToken: npt,
+	})
+	return
+}
+
+// detectAnomaliesPeriodically checks the incoming assessment results for anomalies every
+// [Config.AnomalyCheckInterval] until ctx is done.
+func (svc *Service) detectAnomaliesPeriodically(ctx context.Context) {
+	var ticker = time.NewTicker(max(svc.cfg.AnomalyCheckInterval, time.Second))
+
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := svc.detectAnomalies(time.Now()); err != nil {
+				slog.Warn("Could not detect all anomalies of assessment results, retrying later", log.Err(err))
+			}
+		}
+	}
+}
+
+// detectAnomalies checks the assessment results of the detection window, i.e., the [Config.AnomalyCheckInterval]
+// before the given time, for anomalies and stores an [orchestrator.Alert] for each one. An anomaly already raised
+// within the detection window is not raised again.
+func (svc *Service) detectAnomalies(now time.Time) (err error) {
+	var (
+		window   = svc.cfg.AnomalyCheckInterval
+		detected []*orchestrator.Alert
+		flapping []*orchestrator.Alert
+		existing []*orchestrator.Alert
+		raised   = make(map[string]bool)
+		errs     []error
+	)
+
+	detected, err = svc.detectVolumeDrops(now)
+	if err != nil {
+		return fmt.Errorf("could not detect volume drops: %w", err)
+	}
+
+	flapping, err = svc.detectFlapping(now)
+	if err != nil {
+		return fmt.Errorf("could not detect flapping metrics: %w", err)
+	}
+	detected = append(detected, flapping...)
+
+	err = svc.db.List(&existing, "", true, 0, -1, "detected_at > ?", now.Add(-window))
+	if err != nil {
+		return fmt.Errorf("could not retrieve alerts: %w", err)
+	}
+	for _, a := range existing {
+		raised[alertKey(a)] = true
+	}
+
+	for _, a := range detected {
+		if raised[alertKey(a)] {
+			continue
+		}
+
+		slog.Info("Detected anomaly of assessment results",
+			slog.String("type", a.GetType().String()),
+			slog.String("target_of_evaluation", a.GetTargetOfEvaluationId()),
+			slog.String("description", a.GetDescription()),
+		)
+
+		if err = svc.db.Create(a); err != nil {
+			errs = append(errs, fmt.Errorf("could not store alert: %w", err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// detectVolumeDrops compares the number of assessment results of each target of evaluation within the detection
+// window with the number expected from the preceding [Config.AnomalyBaselinePeriod]. A drop by more than
+// [Config.AnomalyVolumeDropThreshold] is an anomaly, e.g., because a collector stopped working.
+func (svc *Service) detectVolumeDrops(now time.Time) (alerts []*orchestrator.Alert, err error) {
+	var (
+		window   = svc.cfg.AnomalyCheckInterval
+		baseline = svc.cfg.AnomalyBaselinePeriod
+		start    = now.Add(-window)
+		toeIds   []string
+		observed int64
+		previous int64
+	)
+
+	err = svc.db.Pluck(&orchestrator.TargetOfEvaluation{}, "id", &toeIds)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, toeId := range toeIds {
+		previous, err = svc.db.Count(&assessment.AssessmentResult{},
+			"target_of_evaluation_id = ? AND created_at > ? AND created_at <= ?", toeId, start.Add(-baseline), start)
+		if err != nil {
+			return nil, err
+		}
+
+		// Scale the baseline volume to the length of the detection window
+		expected := float64(previous) * float64(window) / float64(baseline)
+		if expected < minExpectedResults {
+			continue
+		}
+
+		observed, err = svc.db.Count(&assessment.AssessmentResult{},
+			"target_of_evaluation_id = ? AND created_at > ? AND created_at <= ?", toeId, start, now)
+		if err != nil {
+			return nil, err
+		}
+
+		if float64(observed) >= expected*(1-svc.cfg.AnomalyVolumeDropThreshold) {
+			continue
+		}
+
+		alerts = append(alerts, &orchestrator.Alert{
+			Id:                   uuid.NewString(),
+			Type:                 orchestrator.AlertType_ALERT_TYPE_VOLUME_DROP,
+			TargetOfEvaluationId: toeId,
+			Description: fmt.Sprintf("Received %d assessment results within the last %s, expected about %.0f",
+				observed, window, expected),
+			DetectedAt: timestamppb.New(now),
+			Observed:   float64(observed),
+			Expected:   expected,
+		})
+	}
+
+	return alerts, nil
+}
+
+// detectFlapping counts, how often the assessment results of each metric for each resource changed between compliant
+// and non-compliant within the detection window. At least [Config.AnomalyFlappingThreshold] changes are an anomaly,
+// e.g., because of a policy regression.
+func (svc *Service) detectFlapping(now time.Time) (alerts []*orchestrator.Alert, err error) {
+	var (
+		results   []*assessment.AssessmentResult
+		last      = make(map[string]*assessment.AssessmentResult)
+		changes   = make(map[string]int)
+		threshold = svc.cfg.AnomalyFlappingThreshold
+	)
+
+	err = svc.db.List(&results, "created_at", true, 0, -1, "created_at > ? AND created_at <= ?",
+		now.Add(-svc.cfg.AnomalyCheckInterval), now)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, r := range results {
+		key := r.GetTargetOfEvaluationId() + "/" + r.GetMetricId() + "/" + r.GetResourceId()
+		if prev, ok := last[key]; ok && prev.GetCompliant() != r.GetCompliant() {
+			changes[key]++
+
+			// Raise exactly one alert, once the threshold is reached
+			if changes[key] == threshold {
+				alerts = append(alerts, &orchestrator.Alert{
+					Id:                   uuid.NewString(),
+					Type:                 orchestrator.AlertType_ALERT_TYPE_FLAPPING,
+					TargetOfEvaluationId: r.GetTargetOfEvaluationId(),
+					MetricId:             new(r.GetMetricId()),
+					ResourceId:           new(r.GetResourceId()),
+					DetectedAt:           timestamppb.New(now),
+					Expected:             float64(threshold),
+				})
+			}
+		}
+		last[key] = r
+	}
+
+	// Fill in the final number of changes
+	for _, a := range alerts {
+		n := changes[a.GetTargetOfEvaluationId()+"/"+a.GetMetricId()+"/"+a.GetResourceId()]
+		a.Observed = float64(n)
+		a.Description = fmt.Sprintf("Metric %s changed %d times between compliant and non-compliant for resource %s",
+			a.GetMetricId(), n, a.GetResourceId())
+	}
+
+	return alerts, nil
+}
+
+// alertKey returns a key identifying the anomaly of the alert, independent of the time it was detected at.
+func alertKey(a *orchestrator.Alert) string {
+	return fmt.Sprintf("%d/%s/%s/%s", a.GetType(), a.GetTargetOfEvaluationId(), a.GetMetricId(), a.GetResourceId())
+}
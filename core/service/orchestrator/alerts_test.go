@@ -0,0 +1,274 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package orchestrator
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"confirmate.io/core/api/assessment"
+	"confirmate.io/core/api/orchestrator"
+	"confirmate.io/core/auth"
+	"confirmate.io/core/persistence"
+	"confirmate.io/core/persistence/persistencetest"
+	"confirmate.io/core/service"
+	"confirmate.io/core/service/orchestrator/orchestratortest"
+	"confirmate.io/core/util/assert"
+
+	"connectrpc.com/connect"
+	"github.com/golang-jwt/jwt/v5"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// mockAnomalyConfig uses a detection window of a day and a baseline of two days, so that few results suffice to
+// exceed [minExpectedResults].
+var mockAnomalyConfig = Config{
+	AnomalyCheckInterval:       24 * time.Hour,
+	AnomalyBaselinePeriod:      48 * time.Hour,
+	AnomalyVolumeDropThreshold: DefaultAnomalyVolumeDropThreshold,
+	AnomalyFlappingThreshold:   DefaultAnomalyFlappingThreshold,
+}
+
+// mockAnomalyRecords returns assessment results relative to now. Both targets of evaluation received 20 results within
+// the baseline period. Within the detection window, target of evaluation 1 only received 2 results, whereas the results
+// of target of evaluation 2 contain a metric flipping 4 times.
+func mockAnomalyRecords(now time.Time) (records []any) {
+	var (
+		create = func(toeId string, n int, age time.Duration, compliant func(i int) bool) {
+			for i := range n {
+				records = append(records, &assessment.AssessmentResult{
+					Id:                   fmt.Sprintf("%s-%d-%d", toeId, age/time.Hour, i),
+					CreatedAt:            timestamppb.New(now.Add(-age + time.Duration(i)*time.Minute)),
+					MetricId:             orchestratortest.MockMetricId1,
+					ResourceId:           orchestratortest.MockResourceId1,
+					TargetOfEvaluationId: toeId,
+					Compliant:            compliant(i),
+				})
+			}
+		}
+		always = func(int) bool { return true }
+	)
+
+	records = []any{
+		orchestratortest.MockTargetOfEvaluation1,
+		orchestratortest.MockTargetOfEvaluation2,
+	}
+
+	create(orchestratortest.MockToeId1, 20, 48*time.Hour, always)
+	create(orchestratortest.MockToeId1, 2, 12*time.Hour, always)
+	create(orchestratortest.MockToeId2, 20, 48*time.Hour, always)
+	create(orchestratortest.MockToeId2, 5, 12*time.Hour, func(i int) bool { return i%2 == 0 })
+	create(orchestratortest.MockToeId2, 5, 6*time.Hour, always)
+
+	return
+}
+
+func TestService_detectAnomalies(t *testing.T) {
+	var (
+		now    = time.Now()
+		alerts []*orchestrator.Alert
+	)
+
+	svc := &Service{
+		db:  persistencetest.NewInMemoryDB(t, types, joinTables, createRecords(t, mockAnomalyRecords(now)...)),
+		cfg: mockAnomalyConfig,
+	}
+
+	err := svc.detectAnomalies(now)
+	assert.NoError(t, err)
+
+	err = svc.db.List(&alerts, "type", true, 0, -1)
+	assert.NoError(t, err)
+	if !assert.Equal(t, 2, len(alerts)) {
+		return
+	}
+
+	assert.Equal(t, orchestrator.AlertType_ALERT_TYPE_VOLUME_DROP, alerts[0].GetType())
+	assert.Equal(t, orchestratortest.MockToeId1, alerts[0].GetTargetOfEvaluationId())
+	assert.Equal(t, float64(2), alerts[0].GetObserved())
+	assert.Equal(t, float64(10), alerts[0].GetExpected())
+
+	assert.Equal(t, orchestrator.AlertType_ALERT_TYPE_FLAPPING, alerts[1].GetType())
+	assert.Equal(t, orchestratortest.MockToeId2, alerts[1].GetTargetOfEvaluationId())
+	assert.Equal(t, orchestratortest.MockMetricId1, alerts[1].GetMetricId())
+	assert.Equal(t, orchestratortest.MockResourceId1, alerts[1].GetResourceId())
+	assert.Equal(t, float64(4), alerts[1].GetObserved())
+
+	// Anomalies are only raised once within the detection window
+	err = svc.detectAnomalies(now.Add(time.Minute))
+	assert.NoError(t, err)
+
+	count, err := svc.db.Count(&orchestrator.Alert{})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), count)
+}
+
+func TestService_ListAlerts(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		req *orchestrator.ListAlertsRequest
+	}
+	type fields struct {
+		db    persistence.DB
+		authz service.AuthorizationStrategy
+	}
+
+	newDB := func(t *testing.T) persistence.DB {
+		now := time.Now()
+		return persistencetest.NewInMemoryDB(t, types, joinTables, func(d persistence.DB) {
+			assert.NoError(t, d.Create(&orchestrator.Alert{
+				Id:                   "00000000-0000-0000-000a-000000000001",
+				Type:                 orchestrator.AlertType_ALERT_TYPE_VOLUME_DROP,
+				TargetOfEvaluationId: orchestratortest.MockToeId1,
+				Description:          "Volume drop",
+				DetectedAt:           timestamppb.New(now.Add(-time.Hour)),
+			}))
+			assert.NoError(t, d.Create(&orchestrator.Alert{
+				Id:                   "00000000-0000-0000-000a-000000000002",
+				Type:                 orchestrator.AlertType_ALERT_TYPE_FLAPPING,
+				TargetOfEvaluationId: orchestratortest.MockToeId2,
+				MetricId:             new(orchestratortest.MockMetricId1),
+				ResourceId:           new(orchestratortest.MockResourceId1),
+				Description:          "Flapping",
+				DetectedAt:           timestamppb.New(now),
+			}))
+		})
+	}
+
+	tests := []struct {
+		name    string
+		args    args
+		fields  fields
+		want    assert.Want[*connect.Response[orchestrator.ListAlertsResponse]]
+		wantErr assert.WantErr
+	}{
+		{
+			name: "happy path: latest alert first",
+			args: args{
+				ctx: context.Background(),
+				req: &orchestrator.ListAlertsRequest{},
+			},
+			fields: fields{
+				db:    newDB(t),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			want: func(t *testing.T, got *connect.Response[orchestrator.ListAlertsResponse], msgAndArgs ...any) bool {
+				return assert.Equal(t, 2, len(got.Msg.Alerts)) &&
+					assert.Equal(t, orchestrator.AlertType_ALERT_TYPE_FLAPPING, got.Msg.Alerts[0].GetType())
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "happy path: filtered by type",
+			args: args{
+				ctx: context.Background(),
+				req: &orchestrator.ListAlertsRequest{
+					Filter: &orchestrator.ListAlertsRequest_Filter{
+						Type: orchestrator.AlertType_ALERT_TYPE_VOLUME_DROP.Enum(),
+					},
+				},
+			},
+			fields: fields{
+				db:    newDB(t),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			want: func(t *testing.T, got *connect.Response[orchestrator.ListAlertsResponse], msgAndArgs ...any) bool {
+				return assert.Equal(t, 1, len(got.Msg.Alerts)) &&
+					assert.Equal(t, orchestratortest.MockToeId1, got.Msg.Alerts[0].GetTargetOfEvaluationId())
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "happy path: filtered by target of evaluation",
+			args: args{
+				ctx: context.Background(),
+				req: &orchestrator.ListAlertsRequest{
+					Filter: &orchestrator.ListAlertsRequest_Filter{
+						TargetOfEvaluationId: new(orchestratortest.MockToeId2),
+					},
+				},
+			},
+			fields: fields{
+				db:    newDB(t),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			want: func(t *testing.T, got *connect.Response[orchestrator.ListAlertsResponse], msgAndArgs ...any) bool {
+				return assert.Equal(t, 1, len(got.Msg.Alerts)) &&
+					assert.Equal(t, orchestrator.AlertType_ALERT_TYPE_FLAPPING, got.Msg.Alerts[0].GetType())
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "happy path: no access to any target of evaluation",
+			args: args{
+				ctx: auth.WithClaims(context.Background(), &auth.OAuthClaims{
+					RegisteredClaims: jwt.RegisteredClaims{
+						Subject: orchestratortest.MockUserId1,
+						Issuer:  orchestratortest.MockUserIssuer1,
+					},
+				}),
+				req: &orchestrator.ListAlertsRequest{},
+			},
+			fields: fields{
+				db: newDB(t),
+				authz: &service.AuthorizationStrategyPermissionStore{
+					Permissions: service.DBPermissionStore{
+						DB: persistencetest.NewInMemoryDB(t, types, joinTables),
+					},
+				},
+			},
+			want: func(t *testing.T, got *connect.Response[orchestrator.ListAlertsResponse], msgAndArgs ...any) bool {
+				return assert.Empty(t, got.Msg.Alerts)
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "validation error",
+			args: args{
+				ctx: context.Background(),
+				req: &orchestrator.ListAlertsRequest{
+					Filter: &orchestrator.ListAlertsRequest_Filter{
+						TargetOfEvaluationId: new("not-a-uuid"),
+					},
+				},
+			},
+			fields: fields{
+				db:    newDB(t),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			want: assert.Nil[*connect.Response[orchestrator.ListAlertsResponse]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeInvalidArgument) &&
+					assert.HasFieldViolation(t, err, "filter.target_of_evaluation_id")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &Service{
+				db:    tt.fields.db,
+				authz: tt.fields.authz,
+			}
+
+			got, err := svc.ListAlerts(tt.args.ctx, connect.NewRequest(tt.args.req))
+			tt.want(t, got)
+			tt.wantErr(t, err)
+		})
+	}
+}
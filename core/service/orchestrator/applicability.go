@@ -0,0 +1,388 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package orchestrator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"confirmate.io/core/api/orchestrator"
+	"confirmate.io/core/persistence"
+	"confirmate.io/core/service"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// UpdateAssuranceLevel creates or updates an assurance level of a catalog. The list of assurance levels of the catalog
+// is updated accordingly, so that it reflects all levels in the order of their rank.
+func (svc *Service) UpdateAssuranceLevel(
+	ctx context.Context,
+	req *connect.Request[orchestrator.UpdateAssuranceLevelRequest],
+) (res *connect.Response[orchestrator.AssuranceLevel], err error) {
+	var (
+		level   *orchestrator.AssuranceLevel
+		allowed bool
+	)
+
+	// Validate the request
+	if err = service.Validate(req); err != nil {
+		return nil, err
+	}
+
+	// Check access via the configured auth strategy
+	allowed, _, err = CheckAccess(ctx, svc.authz, svc, orchestrator.RequestType_REQUEST_TYPE_UPDATED, "", orchestrator.ObjectType_OBJECT_TYPE_CATALOG)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if !allowed {
+		return nil, service.ErrPermissionDenied
+	}
+
+	level = &orchestrator.AssuranceLevel{
+		CatalogId:   req.Msg.GetLevel().GetCatalogId(),
+		Name:        req.Msg.GetLevel().GetName(),
+		Rank:        req.Msg.GetLevel().GetRank(),
+		Description: req.Msg.GetLevel().GetDescription(),
+	}
+
+	err = svc.db.Transaction(func(tx persistence.DB) error {
+		if _, err = editableCatalog(tx, level.CatalogId); err != nil {
+			return err
+		}
+
+		if err = tx.Save(level); err != nil {
+			return err
+		}
+
+		return syncCatalogAssuranceLevels(tx, level.CatalogId)
+	})
+	if err = service.HandleDatabaseError(err); err != nil {
+		return nil, err
+	}
+
+	// Notify subscribers, since the applicability of the controls changed
+	svc.notifyCatalogUpdated(level.CatalogId)
+
+	res = connect.NewResponse(level)
+	return
+}
+
+// ListAssuranceLevels lists the assurance levels of a catalog, ordered by their rank.
+func (svc *Service) ListAssuranceLevels(
+	ctx context.Context,
+	req *connect.Request[orchestrator.ListAssuranceLevelsRequest],
+) (res *connect.Response[orchestrator.ListAssuranceLevelsResponse], err error) {
+	var (
+		levels []*orchestrator.AssuranceLevel
+		npt    string
+	)
+
+	// Validate the request
+	if err = service.Validate(req); err != nil {
+		return nil, err
+	}
+
+	// Set default ordering
+	if req.Msg.OrderBy == "" {
+		req.Msg.OrderBy = "rank"
+		req.Msg.Asc = true
+	}
+
+	levels, npt, err = service.PaginateStorage[*orchestrator.AssuranceLevel](req.Msg, svc.db, service.DefaultPaginationOpts,
+		"catalog_id = ?", req.Msg.GetCatalogId())
+	if err = service.HandleDatabaseError(err); err != nil {
+		return nil, err
+	}
+
+	res = connect.NewResponse(&orchestrator.ListAssuranceLevelsResponse{
+		Levels:        levels,
+		NextPageToken: npt,
+	})
+	return
+}
+
+// RemoveAssuranceLevel removes an assurance level of a catalog. Controls and applicability rules that still reference
+// the level are not changed.
+func (svc *Service) RemoveAssuranceLevel(
+	ctx context.Context,
+	req *connect.Request[orchestrator.RemoveAssuranceLevelRequest],
+) (res *connect.Response[emptypb.Empty], err error) {
+	var allowed bool
+
+	// Validate the request
+	if err = service.Validate(req); err != nil {
+		return nil, err
+	}
+
+	// Check access via the configured auth strategy
+	allowed, _, err = CheckAccess(ctx, svc.authz, svc, orchestrator.RequestType_REQUEST_TYPE_UPDATED, "", orchestrator.ObjectType_OBJECT_TYPE_CATALOG)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if !allowed {
+		return nil, service.ErrPermissionDenied
+	}
+
+	err = svc.db.Transaction(func(tx persistence.DB) error {
+		if _, err = editableCatalog(tx, req.Msg.GetCatalogId()); err != nil {
+			return err
+		}
+
+		if err = tx.Delete(&orchestrator.AssuranceLevel{}, "catalog_id = ? AND name = ?", req.Msg.GetCatalogId(), req.Msg.GetName()); err != nil {
+			return err
+		}
+
+		return syncCatalogAssuranceLevels(tx, req.Msg.GetCatalogId())
+	})
+	if err = service.HandleDatabaseError(err, service.ErrNotFound("assurance level")); err != nil {
+		return nil, err
+	}
+
+	// Notify subscribers, since the applicability of the controls changed
+	svc.notifyCatalogUpdated(req.Msg.GetCatalogId())
+
+	res = connect.NewResponse(&emptypb.Empty{})
+	return
+}
+
+// UpdateApplicabilityRule creates or updates the applicability rule of a control of a catalog. The control must be part
+// of the catalog and the assurance levels of the rule must be assurance levels of the catalog.
+func (svc *Service) UpdateApplicabilityRule(
+	ctx context.Context,
+	req *connect.Request[orchestrator.UpdateApplicabilityRuleRequest],
+) (res *connect.Response[orchestrator.ApplicabilityRule], err error) {
+	var (
+		rule    *orchestrator.ApplicabilityRule
+		allowed bool
+	)
+
+	// Validate the request
+	if err = service.Validate(req); err != nil {
+		return nil, err
+	}
+
+	// Check access via the configured auth strategy
+	allowed, _, err = CheckAccess(ctx, svc.authz, svc, orchestrator.RequestType_REQUEST_TYPE_UPDATED, "", orchestrator.ObjectType_OBJECT_TYPE_CATALOG)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if !allowed {
+		return nil, service.ErrPermissionDenied
+	}
+
+	rule = &orchestrator.ApplicabilityRule{
+		CatalogId:        req.Msg.GetRule().GetCatalogId(),
+		ControlShortName: req.Msg.GetRule().GetControlShortName(),
+		AssuranceLevels:  req.Msg.GetRule().GetAssuranceLevels(),
+		Justification:    req.Msg.GetRule().GetJustification(),
+	}
+
+	err = svc.db.Transaction(func(tx persistence.DB) error {
+		var (
+			catalog *orchestrator.Catalog
+			count   int64
+		)
+
+		if catalog, err = editableCatalog(tx, rule.CatalogId); err != nil {
+			return err
+		}
+
+		count, err = tx.Count(&orchestrator.Control{}, "catalog_id = ? AND short_name = ?", rule.CatalogId, rule.ControlShortName)
+		if err != nil {
+			return err
+		}
+		if count == 0 {
+			return connect.NewError(connect.CodeNotFound, service.ErrNotFound("control"))
+		}
+
+		for _, level := range rule.AssuranceLevels {
+			if !slices.Contains(catalog.GetAssuranceLevels(), level) {
+				return service.NewInvalidFieldError("rule.assurance_levels", fmt.Errorf("unknown assurance level %q", level))
+			}
+		}
+
+		return tx.Save(rule)
+	})
+	if err = service.HandleDatabaseError(err); err != nil {
+		return nil, err
+	}
+
+	// Notify subscribers, since the applicability of the controls changed
+	svc.notifyCatalogUpdated(rule.CatalogId)
+
+	res = connect.NewResponse(rule)
+	return
+}
+
+// ListApplicabilityRules lists the applicability rules of a catalog.
+func (svc *Service) ListApplicabilityRules(
+	ctx context.Context,
+	req *connect.Request[orchestrator.ListApplicabilityRulesRequest],
+) (res *connect.Response[orchestrator.ListApplicabilityRulesResponse], err error) {
+	var (
+		rules []*orchestrator.ApplicabilityRule
+		npt   string
+	)
+
+	// Validate the request
+	if err = service.Validate(req); err != nil {
+		return nil, err
+	}
+
+	// Set default ordering
+	if req.Msg.OrderBy == "" {
+		req.Msg.OrderBy = "control_short_name"
+		req.Msg.Asc = true
+	}
+
+	rules, npt, err = service.PaginateStorage[*orchestrator.ApplicabilityRule](req.Msg, svc.db, service.DefaultPaginationOpts,
+		"catalog_id = ?", req.Msg.GetCatalogId())
+	if err = service.HandleDatabaseError(err); err != nil {
+		return nil, err
+	}
+
+	res = connect.NewResponse(&orchestrator.ListApplicabilityRulesResponse{
+		Rules:         rules,
+		NextPageToken: npt,
+	})
+	return
+}
+
+// RemoveApplicabilityRule removes the applicability rule of a control of a catalog. Afterwards, the assurance level of
+// the control itself determines again whether it applies to an audit scope.
+func (svc *Service) RemoveApplicabilityRule(
+	ctx context.Context,
+	req *connect.Request[orchestrator.RemoveApplicabilityRuleRequest],
+) (res *connect.Response[emptypb.Empty], err error) {
+	var allowed bool
+
+	// Validate the request
+	if err = service.Validate(req); err != nil {
+		return nil, err
+	}
+
+	// Check access via the configured auth strategy
+	allowed, _, err = CheckAccess(ctx, svc.authz, svc, orchestrator.RequestType_REQUEST_TYPE_UPDATED, "", orchestrator.ObjectType_OBJECT_TYPE_CATALOG)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if !allowed {
+		return nil, service.ErrPermissionDenied
+	}
+
+	err = svc.db.Transaction(func(tx persistence.DB) error {
+		if _, err = editableCatalog(tx, req.Msg.GetCatalogId()); err != nil {
+			return err
+		}
+
+		return tx.Delete(&orchestrator.ApplicabilityRule{}, "catalog_id = ? AND control_short_name = ?",
+			req.Msg.GetCatalogId(), req.Msg.GetControlShortName())
+	})
+	if err = service.HandleDatabaseError(err, service.ErrNotFound("applicability rule")); err != nil {
+		return nil, err
+	}
+
+	// Notify subscribers, since the applicability of the controls changed
+	svc.notifyCatalogUpdated(req.Msg.GetCatalogId())
+
+	res = connect.NewResponse(&emptypb.Empty{})
+	return
+}
+
+// editableCatalog retrieves the catalog with the given ID without its sub-resources. An error with
+// [connect.CodeNotFound] is returned if the catalog does not exist and one with [connect.CodeFailedPrecondition] if it
+// is a published (and therefore immutable) catalog version.
+func editableCatalog(db persistence.DB, catalogId string) (catalog *orchestrator.Catalog, err error) {
+	catalog = new(orchestrator.Catalog)
+
+	err = db.Get(catalog, persistence.WithoutPreload(), "id = ?", catalogId)
+	if err = service.HandleDatabaseError(err, service.ErrNotFound("catalog")); err != nil {
+		return nil, err
+	}
+
+	if catalog.GetStatus() == orchestrator.CatalogStatus_CATALOG_STATUS_PUBLISHED {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("published catalogs cannot be modified"))
+	}
+
+	return catalog, nil
+}
+
+// syncCatalogAssuranceLevels updates the list of assurance levels of the catalog with the given ID, so that it
+// contains the names of all its assurance levels in the order of their rank.
+func syncCatalogAssuranceLevels(tx persistence.DB, catalogId string) (err error) {
+	var (
+		levels []*orchestrator.AssuranceLevel
+		// The names must not be nil, otherwise the update would skip the list if the last level was removed
+		names = []string{}
+	)
+
+	if err = tx.List(&levels, "rank", true, 0, -1, "catalog_id = ?", catalogId); err != nil {
+		return err
+	}
+
+	for _, level := range levels {
+		names = append(names, level.Name)
+	}
+
+	return tx.Update(&orchestrator.Catalog{AssuranceLevels: names}, "id = ?", catalogId)
+}
+
+// createCatalog persists the given catalog together with an assurance level for each of its assurance levels, ranked
+// in the order of the list.
+func createCatalog(db persistence.DB, catalog *orchestrator.Catalog) (err error) {
+	return db.Transaction(func(tx persistence.DB) error {
+		if err = recordControlTextVersions(tx, catalog); err != nil {
+			return err
+		}
+
+		if err = tx.Create(catalog); err != nil {
+			return err
+		}
+
+		for i, name := range catalog.GetAssuranceLevels() {
+			if err = tx.Create(&orchestrator.AssuranceLevel{CatalogId: catalog.Id, Name: name, Rank: uint32(i)}); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// copyAssuranceLevels copies the assurance levels of a catalog to a copy of it (see [copyCatalog]), since the levels
+// are not part of the catalog itself.
+func copyAssuranceLevels(tx persistence.DB, fromId string, toId string) (err error) {
+	var levels []*orchestrator.AssuranceLevel
+
+	if err = tx.List(&levels, "rank", true, 0, -1, "catalog_id = ?", fromId); err != nil {
+		return err
+	}
+
+	for _, level := range levels {
+		level = proto.Clone(level).(*orchestrator.AssuranceLevel)
+		level.CatalogId = toId
+
+		if err = tx.Create(level); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
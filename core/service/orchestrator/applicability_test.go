@@ -0,0 +1,559 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package orchestrator
+
+import (
+	"context"
+	"testing"
+
+	"confirmate.io/core/api/orchestrator"
+	"confirmate.io/core/persistence"
+	"confirmate.io/core/persistence/persistencetest"
+	"confirmate.io/core/service"
+	"confirmate.io/core/util/assert"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// mockApplicabilityRecords returns an editable catalog with the assurance levels "basic" and "high" and a single
+// control "OPS-01", as well as a published catalog.
+func mockApplicabilityRecords() []any {
+	return []any{
+		&orchestrator.Catalog{
+			Id:              "eucs",
+			Name:            "EUCS",
+			AssuranceLevels: []string{"basic", "high"},
+			Categories: []*orchestrator.Category{
+				{
+					Name:      "OPS",
+					CatalogId: "eucs",
+					Controls: []*orchestrator.Control{
+						{
+							Id:        "00000000-0000-0000-0000-000000000001",
+							Name:      "Capacity management",
+							ShortName: "OPS-01",
+							CatalogId: "eucs",
+						},
+					},
+				},
+			},
+		},
+		&orchestrator.Catalog{
+			Id:     "eucs@v1",
+			Name:   "EUCS",
+			Status: orchestrator.CatalogStatus_CATALOG_STATUS_PUBLISHED,
+		},
+	}
+}
+
+func TestService_UpdateAssuranceLevel(t *testing.T) {
+	type args struct {
+		req *orchestrator.UpdateAssuranceLevelRequest
+	}
+	type fields struct {
+		db persistence.DB
+	}
+	tests := []struct {
+		name    string
+		args    args
+		fields  fields
+		want    assert.Want[*connect.Response[orchestrator.AssuranceLevel]]
+		wantErr assert.WantErr
+		wantDB  assert.Want[persistence.DB]
+	}{
+		{
+			name: "validation error",
+			args: args{
+				req: &orchestrator.UpdateAssuranceLevelRequest{
+					Level: &orchestrator.AssuranceLevel{CatalogId: "eucs"},
+				},
+			},
+			fields: fields{
+				db: persistencetest.NewInMemoryDB(t, types, joinTables, createRecords(t, mockApplicabilityRecords()...)),
+			},
+			want: assert.Nil[*connect.Response[orchestrator.AssuranceLevel]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeInvalidArgument)
+			},
+			wantDB: assert.NotNil[persistence.DB],
+		},
+		{
+			name: "catalog not found",
+			args: args{
+				req: &orchestrator.UpdateAssuranceLevelRequest{
+					Level: &orchestrator.AssuranceLevel{CatalogId: "unknown", Name: "basic"},
+				},
+			},
+			fields: fields{
+				db: persistencetest.NewInMemoryDB(t, types, joinTables, createRecords(t, mockApplicabilityRecords()...)),
+			},
+			want: assert.Nil[*connect.Response[orchestrator.AssuranceLevel]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeNotFound) &&
+					assert.ErrorContains(t, err, "catalog")
+			},
+			wantDB: assert.NotNil[persistence.DB],
+		},
+		{
+			name: "published catalog",
+			args: args{
+				req: &orchestrator.UpdateAssuranceLevelRequest{
+					Level: &orchestrator.AssuranceLevel{CatalogId: "eucs@v1", Name: "basic"},
+				},
+			},
+			fields: fields{
+				db: persistencetest.NewInMemoryDB(t, types, joinTables, createRecords(t, mockApplicabilityRecords()...)),
+			},
+			want: assert.Nil[*connect.Response[orchestrator.AssuranceLevel]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeFailedPrecondition)
+			},
+			wantDB: assert.NotNil[persistence.DB],
+		},
+		{
+			name: "happy path",
+			args: args{
+				req: &orchestrator.UpdateAssuranceLevelRequest{
+					Level: &orchestrator.AssuranceLevel{
+						CatalogId:   "eucs",
+						Name:        "substantial",
+						Rank:        1,
+						Description: "Substantial",
+					},
+				},
+			},
+			fields: fields{
+				db: persistencetest.NewInMemoryDB(t, types, joinTables, createRecords(t, mockApplicabilityRecords()...), func(d persistence.DB) {
+					// Move "high" behind the new level
+					assert.NoError(t, d.Update(&orchestrator.AssuranceLevel{Rank: 2}, "catalog_id = ? AND name = ?", "eucs", "high"))
+				}),
+			},
+			want: func(t *testing.T, got *connect.Response[orchestrator.AssuranceLevel], msgAndArgs ...any) bool {
+				return assert.Equal(t, "substantial", got.Msg.Name) &&
+					assert.Equal(t, uint32(1), got.Msg.Rank)
+			},
+			wantErr: assert.NoError,
+			wantDB: func(t *testing.T, db persistence.DB, msgAndArgs ...any) bool {
+				var catalog orchestrator.Catalog
+
+				err := db.Get(&catalog, persistence.WithoutPreload(), "id = ?", "eucs")
+				return assert.NoError(t, err) &&
+					assert.Equal(t, []string{"basic", "substantial", "high"}, catalog.AssuranceLevels)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &Service{
+				db:    tt.fields.db,
+				authz: &service.AuthorizationStrategyAllowAll{},
+			}
+			res, err := svc.UpdateAssuranceLevel(context.Background(), connect.NewRequest(tt.args.req))
+			tt.want(t, res)
+			tt.wantErr(t, err)
+			tt.wantDB(t, tt.fields.db)
+		})
+	}
+}
+
+func TestService_ListAssuranceLevels(t *testing.T) {
+	type args struct {
+		req *orchestrator.ListAssuranceLevelsRequest
+	}
+	type fields struct {
+		db persistence.DB
+	}
+	tests := []struct {
+		name    string
+		args    args
+		fields  fields
+		want    assert.Want[*connect.Response[orchestrator.ListAssuranceLevelsResponse]]
+		wantErr assert.WantErr
+	}{
+		{
+			name: "validation error",
+			args: args{
+				req: &orchestrator.ListAssuranceLevelsRequest{},
+			},
+			fields: fields{
+				db: persistencetest.NewInMemoryDB(t, types, joinTables, createRecords(t, mockApplicabilityRecords()...)),
+			},
+			want: assert.Nil[*connect.Response[orchestrator.ListAssuranceLevelsResponse]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeInvalidArgument)
+			},
+		},
+		{
+			name: "db error",
+			args: args{
+				req: &orchestrator.ListAssuranceLevelsRequest{CatalogId: "eucs"},
+			},
+			fields: fields{
+				db: persistencetest.ListErrorDB(t, persistence.ErrDatabase, types, joinTables),
+			},
+			want: assert.Nil[*connect.Response[orchestrator.ListAssuranceLevelsResponse]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeInternal)
+			},
+		},
+		{
+			name: "happy path - ordered by rank",
+			args: args{
+				req: &orchestrator.ListAssuranceLevelsRequest{CatalogId: "eucs"},
+			},
+			fields: fields{
+				db: persistencetest.NewInMemoryDB(t, types, joinTables, createRecords(t, mockApplicabilityRecords()...)),
+			},
+			want: func(t *testing.T, got *connect.Response[orchestrator.ListAssuranceLevelsResponse], msgAndArgs ...any) bool {
+				return assert.Equal(t, 2, len(got.Msg.Levels)) &&
+					assert.Equal(t, "basic", got.Msg.Levels[0].Name) &&
+					assert.Equal(t, "high", got.Msg.Levels[1].Name)
+			},
+			wantErr: assert.NoError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &Service{
+				db: tt.fields.db,
+			}
+			res, err := svc.ListAssuranceLevels(context.Background(), connect.NewRequest(tt.args.req))
+			tt.want(t, res)
+			tt.wantErr(t, err)
+		})
+	}
+}
+
+func TestService_RemoveAssuranceLevel(t *testing.T) {
+	type args struct {
+		req *orchestrator.RemoveAssuranceLevelRequest
+	}
+	type fields struct {
+		db persistence.DB
+	}
+	tests := []struct {
+		name    string
+		args    args
+		fields  fields
+		want    assert.Want[*connect.Response[emptypb.Empty]]
+		wantErr assert.WantErr
+		wantDB  assert.Want[persistence.DB]
+	}{
+		{
+			name: "level not found",
+			args: args{
+				req: &orchestrator.RemoveAssuranceLevelRequest{CatalogId: "eucs", Name: "unknown"},
+			},
+			fields: fields{
+				db: persistencetest.NewInMemoryDB(t, types, joinTables, createRecords(t, mockApplicabilityRecords()...)),
+			},
+			want: assert.Nil[*connect.Response[emptypb.Empty]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeNotFound) &&
+					assert.ErrorContains(t, err, "assurance level")
+			},
+			wantDB: assert.NotNil[persistence.DB],
+		},
+		{
+			name: "published catalog",
+			args: args{
+				req: &orchestrator.RemoveAssuranceLevelRequest{CatalogId: "eucs@v1", Name: "basic"},
+			},
+			fields: fields{
+				db: persistencetest.NewInMemoryDB(t, types, joinTables, createRecords(t, mockApplicabilityRecords()...)),
+			},
+			want: assert.Nil[*connect.Response[emptypb.Empty]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeFailedPrecondition)
+			},
+			wantDB: assert.NotNil[persistence.DB],
+		},
+		{
+			name: "happy path",
+			args: args{
+				req: &orchestrator.RemoveAssuranceLevelRequest{CatalogId: "eucs", Name: "basic"},
+			},
+			fields: fields{
+				db: persistencetest.NewInMemoryDB(t, types, joinTables, createRecords(t, mockApplicabilityRecords()...)),
+			},
+			want:    assert.NotNil[*connect.Response[emptypb.Empty]],
+			wantErr: assert.NoError,
+			wantDB: func(t *testing.T, db persistence.DB, msgAndArgs ...any) bool {
+				var catalog orchestrator.Catalog
+
+				count, err := db.Count(&orchestrator.AssuranceLevel{}, "catalog_id = ?", "eucs")
+				assert.NoError(t, err)
+				assert.Equal(t, int64(1), count)
+
+				err = db.Get(&catalog, persistence.WithoutPreload(), "id = ?", "eucs")
+				return assert.NoError(t, err) &&
+					assert.Equal(t, []string{"high"}, catalog.AssuranceLevels)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &Service{
+				db:    tt.fields.db,
+				authz: &service.AuthorizationStrategyAllowAll{},
+			}
+			res, err := svc.RemoveAssuranceLevel(context.Background(), connect.NewRequest(tt.args.req))
+			tt.want(t, res)
+			tt.wantErr(t, err)
+			tt.wantDB(t, tt.fields.db)
+		})
+	}
+}
+
+func TestService_UpdateApplicabilityRule(t *testing.T) {
+	type args struct {
+		req *orchestrator.UpdateApplicabilityRuleRequest
+	}
+	type fields struct {
+		db persistence.DB
+	}
+	tests := []struct {
+		name    string
+		args    args
+		fields  fields
+		want    assert.Want[*connect.Response[orchestrator.ApplicabilityRule]]
+		wantErr assert.WantErr
+		wantDB  assert.Want[persistence.DB]
+	}{
+		{
+			name: "validation error - duplicate levels",
+			args: args{
+				req: &orchestrator.UpdateApplicabilityRuleRequest{
+					Rule: &orchestrator.ApplicabilityRule{
+						CatalogId:        "eucs",
+						ControlShortName: "OPS-01",
+						AssuranceLevels:  []string{"high", "high"},
+					},
+				},
+			},
+			fields: fields{
+				db: persistencetest.NewInMemoryDB(t, types, joinTables, createRecords(t, mockApplicabilityRecords()...)),
+			},
+			want: assert.Nil[*connect.Response[orchestrator.ApplicabilityRule]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeInvalidArgument)
+			},
+			wantDB: assert.NotNil[persistence.DB],
+		},
+		{
+			name: "control not found",
+			args: args{
+				req: &orchestrator.UpdateApplicabilityRuleRequest{
+					Rule: &orchestrator.ApplicabilityRule{
+						CatalogId:        "eucs",
+						ControlShortName: "OPS-99",
+					},
+				},
+			},
+			fields: fields{
+				db: persistencetest.NewInMemoryDB(t, types, joinTables, createRecords(t, mockApplicabilityRecords()...)),
+			},
+			want: assert.Nil[*connect.Response[orchestrator.ApplicabilityRule]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeNotFound) &&
+					assert.ErrorContains(t, err, "control")
+			},
+			wantDB: assert.NotNil[persistence.DB],
+		},
+		{
+			name: "unknown assurance level",
+			args: args{
+				req: &orchestrator.UpdateApplicabilityRuleRequest{
+					Rule: &orchestrator.ApplicabilityRule{
+						CatalogId:        "eucs",
+						ControlShortName: "OPS-01",
+						AssuranceLevels:  []string{"substantial"},
+					},
+				},
+			},
+			fields: fields{
+				db: persistencetest.NewInMemoryDB(t, types, joinTables, createRecords(t, mockApplicabilityRecords()...)),
+			},
+			want: assert.Nil[*connect.Response[orchestrator.ApplicabilityRule]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeInvalidArgument) &&
+					assert.HasFieldViolation(t, err, "rule.assurance_levels")
+			},
+			wantDB: assert.NotNil[persistence.DB],
+		},
+		{
+			name: "happy path",
+			args: args{
+				req: &orchestrator.UpdateApplicabilityRuleRequest{
+					Rule: &orchestrator.ApplicabilityRule{
+						CatalogId:        "eucs",
+						ControlShortName: "OPS-01",
+						AssuranceLevels:  []string{"high"},
+						Justification:    "Only required for high",
+					},
+				},
+			},
+			fields: fields{
+				db: persistencetest.NewInMemoryDB(t, types, joinTables, createRecords(t, mockApplicabilityRecords()...)),
+			},
+			want: func(t *testing.T, got *connect.Response[orchestrator.ApplicabilityRule], msgAndArgs ...any) bool {
+				return assert.Equal(t, []string{"high"}, got.Msg.AssuranceLevels)
+			},
+			wantErr: assert.NoError,
+			wantDB: func(t *testing.T, db persistence.DB, msgAndArgs ...any) bool {
+				var catalog orchestrator.Catalog
+
+				// The rule is part of the catalog
+				err := db.Get(&catalog, persistence.WithPreload("ApplicabilityRules"), "id = ?", "eucs")
+				return assert.NoError(t, err) &&
+					assert.Equal(t, 1, len(catalog.ApplicabilityRules)) &&
+					assert.Equal(t, "Only required for high", catalog.ApplicabilityRules[0].Justification)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &Service{
+				db:    tt.fields.db,
+				authz: &service.AuthorizationStrategyAllowAll{},
+			}
+			res, err := svc.UpdateApplicabilityRule(context.Background(), connect.NewRequest(tt.args.req))
+			tt.want(t, res)
+			tt.wantErr(t, err)
+			tt.wantDB(t, tt.fields.db)
+		})
+	}
+}
+
+func TestService_ListApplicabilityRules(t *testing.T) {
+	svc := &Service{
+		db: persistencetest.NewInMemoryDB(t, types, joinTables, createRecords(t, mockApplicabilityRecords()...), func(d persistence.DB) {
+			assert.NoError(t, d.Create(&orchestrator.ApplicabilityRule{CatalogId: "eucs", ControlShortName: "OPS-01"}))
+		}),
+	}
+
+	res, err := svc.ListApplicabilityRules(context.Background(), connect.NewRequest(&orchestrator.ListApplicabilityRulesRequest{
+		CatalogId: "eucs",
+	}))
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(res.Msg.Rules))
+	assert.Equal(t, "OPS-01", res.Msg.Rules[0].ControlShortName)
+
+	res, err = svc.ListApplicabilityRules(context.Background(), connect.NewRequest(&orchestrator.ListApplicabilityRulesRequest{
+		CatalogId: "eucs@v1",
+	}))
+	assert.NoError(t, err)
+	assert.Empty(t, res.Msg.Rules)
+}
+
+func TestService_RemoveApplicabilityRule(t *testing.T) {
+	type args struct {
+		req *orchestrator.RemoveApplicabilityRuleRequest
+	}
+	type fields struct {
+		db persistence.DB
+	}
+	tests := []struct {
+		name    string
+		args    args
+		fields  fields
+		want    assert.Want[*connect.Response[emptypb.Empty]]
+		wantErr assert.WantErr
+		wantDB  assert.Want[persistence.DB]
+	}{
+		{
+			name: "rule not found",
+			args: args{
+				req: &orchestrator.RemoveApplicabilityRuleRequest{CatalogId: "eucs", ControlShortName: "OPS-01"},
+			},
+			fields: fields{
+				db: persistencetest.NewInMemoryDB(t, types, joinTables, createRecords(t, mockApplicabilityRecords()...)),
+			},
+			want: assert.Nil[*connect.Response[emptypb.Empty]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeNotFound) &&
+					assert.ErrorContains(t, err, "applicability rule")
+			},
+			wantDB: assert.NotNil[persistence.DB],
+		},
+		{
+			name: "happy path",
+			args: args{
+				req: &orchestrator.RemoveApplicabilityRuleRequest{CatalogId: "eucs", ControlShortName: "OPS-01"},
+			},
+			fields: fields{
+				db: persistencetest.NewInMemoryDB(t, types, joinTables, createRecords(t, mockApplicabilityRecords()...), func(d persistence.DB) {
+					assert.NoError(t, d.Create(&orchestrator.ApplicabilityRule{CatalogId: "eucs", ControlShortName: "OPS-01"}))
+				}),
+			},
+			want:    assert.NotNil[*connect.Response[emptypb.Empty]],
+			wantErr: assert.NoError,
+			wantDB: func(t *testing.T, db persistence.DB, msgAndArgs ...any) bool {
+				count, err := db.Count(&orchestrator.ApplicabilityRule{})
+				return assert.NoError(t, err) &&
+					assert.Equal(t, int64(0), count)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &Service{
+				db:    tt.fields.db,
+				authz: &service.AuthorizationStrategyAllowAll{},
+			}
+			res, err := svc.RemoveApplicabilityRule(context.Background(), connect.NewRequest(tt.args.req))
+			tt.want(t, res)
+			tt.wantErr(t, err)
+			tt.wantDB(t, tt.fields.db)
+		})
+	}
+}
+
+func TestService_PublishCatalog_applicability(t *testing.T) {
+	var (
+		catalog orchestrator.Catalog
+		levels  []*orchestrator.AssuranceLevel
+	)
+
+	svc := &Service{
+		db: persistencetest.NewInMemoryDB(t, types, joinTables, createRecords(t, mockApplicabilityRecords()...), func(d persistence.DB) {
+			assert.NoError(t, d.Update(&orchestrator.Catalog{Status: orchestrator.CatalogStatus_CATALOG_STATUS_DRAFT}, "id = ?", "eucs"))
+			assert.NoError(t, d.Delete(&orchestrator.Catalog{}, "id = ?", "eucs@v1"))
+			assert.NoError(t, d.Create(&orchestrator.ApplicabilityRule{CatalogId: "eucs", ControlShortName: "OPS-01"}))
+		}),
+		authz: &service.AuthorizationStrategyAllowAll{},
+	}
+
+	res, err := svc.PublishCatalog(context.Background(), connect.NewRequest(&orchestrator.PublishCatalogRequest{CatalogId: "eucs"}))
+	assert.NoError(t, err)
+	assert.Equal(t, "eucs@v1", res.Msg.Id)
+
+	// The snapshot has its own copies of the assurance levels and applicability rules
+	err = svc.db.List(&levels, "rank", true, 0, -1, "catalog_id = ?", "eucs@v1")
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(levels))
+
+	err = svc.db.Get(&catalog, persistence.WithPreload("ApplicabilityRules"), "id = ?", "eucs@v1")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"basic", "high"}, catalog.AssuranceLevels)
+	assert.Equal(t, 1, len(catalog.ApplicabilityRules))
+}
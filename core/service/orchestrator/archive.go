@@ -0,0 +1,329 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package orchestrator
+
+import (
+	"archive/tar"
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"time"
+
+	"confirmate.io/core/api"
+	"confirmate.io/core/api/evaluation"
+	"confirmate.io/core/api/orchestrator"
+	"confirmate.io/core/persistence"
+	"confirmate.io/core/service"
+
+	"connectrpc.com/connect"
+)
+
+const (
+	// ArchiveVersion is the version of the format of the archives created by [Service.ArchiveAuditScopeData].
+	ArchiveVersion = 1
+
+	// archiveManifestFile is the name of the file describing the contents of an archive.
+	archiveManifestFile = "manifest.json"
+
+	// archiveEvaluationResultsFile is the name of the file containing the evaluation results of an archive, one per line.
+	archiveEvaluationResultsFile = "evaluation_results.ndjson"
+
+	// archiveAssessmentResultIdsFile is the name of the file containing the IDs of the assessment results referenced by
+	// the evaluation results of an archive, one per line.
+	archiveAssessmentResultIdsFile = "assessment_result_ids.ndjson"
+)
+
+// ErrArchiveInvalid is returned if an archive cannot be restored because it is not a valid archive.
+var ErrArchiveInvalid = errors.New("invalid archive")
+
+// archiveManifest describes the contents of an archive.
+type archiveManifest struct {
+	Version              int       `json:"version"`
+	AuditScopeId         string    `json:"auditScopeId"`
+	TargetOfEvaluationId string    `json:"targetOfEvaluationId"`
+	CatalogIds           []string  `json:"catalogIds"`
+	CreatedAt            time.Time `json:"createdAt"`
+	EvaluationResults    int       `json:"evaluationResults"`
+	AssessmentResultIds  int       `json:"assessmentResultIds"`
+}
+
+// ArchiveAuditScopeData archives all evaluation results of an audit scope together with the IDs of the assessment
+// results they are based on. Comments and attachments of the evaluation results are not part of the archive.
+func (svc *Service) ArchiveAuditScopeData(
+	ctx context.Context,
+	req *connect.Request[orchestrator.ArchiveAuditScopeDataRequest],
+) (res *connect.Response[orchestrator.ArchiveAuditScopeDataResponse], err error) {
+	var (
+		scope     *orchestrator.AuditScope
+		evals     []*evaluation.EvaluationResult
+		resultIds []string
+		b         []byte
+	)
+
+	// Validate the request
+	if err = service.Validate(req); err != nil {
+		return nil, err
+	}
+
+	scope, err = svc.checkAuditScopeAccess(ctx, orchestrator.RequestType_REQUEST_TYPE_GET, req.Msg.GetAuditScopeId())
+	if err != nil {
+		return nil, err
+	}
+
+	err = svc.db.List(&evals, "timestamp", true, 0, -1, persistence.WithoutPreload(), "audit_scope_id = ?", scope.GetId())
+	if err = service.HandleDatabaseError(err); err != nil {
+		return nil, err
+	}
+
+	for _, eval := range evals {
+		resultIds = append(resultIds, eval.GetAssessmentResultIds()...)
+	}
+	slices.Sort(resultIds)
+	resultIds = slices.Compact(resultIds)
+
+	b, err = writeArchive(&archiveManifest{
+		Version:              ArchiveVersion,
+		AuditScopeId:         scope.GetId(),
+		TargetOfEvaluationId: scope.GetTargetOfEvaluationId(),
+		CatalogIds:           auditScopeCatalogIds(scope),
+		CreatedAt:            time.Now().UTC(),
+		EvaluationResults:    len(evals),
+		AssessmentResultIds:  len(resultIds),
+	}, evals, resultIds)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("could not write archive: %w", err))
+	}
+
+	res = connect.NewResponse(&orchestrator.ArchiveAuditScopeDataResponse{
+		Archive: b,
+	})
+	return
+}
+
+// RestoreAuditScopeData restores the evaluation results of an archive into an audit scope. The evaluation results are
+// moved to the audit scope and its target of evaluation, so that an archive can also be restored into another
+// deployment. Evaluation results that already exist are skipped.
+func (svc *Service) RestoreAuditScopeData(
+	ctx context.Context,
+	req *connect.Request[orchestrator.RestoreAuditScopeDataRequest],
+) (res *connect.Response[orchestrator.RestoreAuditScopeDataResponse], err error) {
+	var (
+		scope    *orchestrator.AuditScope
+		manifest *archiveManifest
+		evals    []*evaluation.EvaluationResult
+		catalogs []string
+		restored int32
+		skipped  int32
+	)
+
+	// Validate the request
+	if err = service.Validate(req); err != nil {
+		return nil, err
+	}
+
+	scope, err = svc.checkAuditScopeAccess(ctx, orchestrator.RequestType_REQUEST_TYPE_UPDATED, req.Msg.GetAuditScopeId())
+	if err != nil {
+		return nil, err
+	}
+
+	manifest, evals, err = readArchive(req.Msg.GetArchive())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if manifest.Version != ArchiveVersion {
+		return nil, connect.NewError(connect.CodeInvalidArgument,
+			fmt.Errorf("%w: unsupported version %d", ErrArchiveInvalid, manifest.Version))
+	}
+
+	// The evaluation results must refer to the controls of the audit scope
+	catalogs = auditScopeCatalogIds(scope)
+	for _, eval := range evals {
+		if !slices.Contains(catalogs, eval.GetControlCatalogId()) {
+			return nil, connect.NewError(connect.CodeInvalidArgument,
+				fmt.Errorf("evaluation result %s belongs to catalog %s, which is not part of the audit scope",
+					eval.GetId(), eval.GetControlCatalogId()))
+		}
+
+		eval.AuditScopeId = scope.GetId()
+		eval.TargetOfEvaluationId = scope.GetTargetOfEvaluationId()
+		eval.Attachments = nil
+		eval.Comments = nil
+	}
+
+	err = svc.db.Transaction(func(tx persistence.DB) (err error) {
+		var count int64
+
+		for _, eval := range evals {
+			count, err = tx.Count(&evaluation.EvaluationResult{}, "id = ?", eval.GetId())
+			if err != nil {
+				return err
+			}
+			if count > 0 {
+				skipped++
+				continue
+			}
+
+			if err = tx.Create(eval); err != nil {
+				return err
+			}
+			restored++
+		}
+
+		return nil
+	})
+	if err = service.HandleDatabaseError(err); err != nil {
+		return nil, err
+	}
+
+	res = connect.NewResponse(&orchestrator.RestoreAuditScopeDataResponse{
+		RestoredEvaluationResults: restored,
+		SkippedEvaluationResults:  skipped,
+	})
+	return
+}
+
+// auditScopeCatalogIds returns the IDs of the catalog and the additional catalogs of the audit scope.
+func auditScopeCatalogIds(scope *orchestrator.AuditScope) []string {
+	return append([]string{scope.GetCatalogId()}, scope.GetAdditionalCatalogIds()...)
+}
+
+// writeArchive writes a tar archive containing the manifest, the evaluation results and the assessment result IDs.
+func writeArchive(manifest *archiveManifest, evals []*evaluation.EvaluationResult, resultIds []string) (b []byte, err error) {
+	var (
+		buf     bytes.Buffer
+		tw      = tar.NewWriter(&buf)
+		data    []byte
+		results bytes.Buffer
+		ids     bytes.Buffer
+	)
+
+	data, err = json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	if err = writeArchiveFile(tw, archiveManifestFile, data, manifest.CreatedAt); err != nil {
+		return nil, err
+	}
+
+	for _, eval := range evals {
+		data, err = api.MarshalCanonicalJSON(eval)
+		if err != nil {
+			return nil, err
+		}
+		results.Write(data)
+		results.WriteByte('\n')
+	}
+	if err = writeArchiveFile(tw, archiveEvaluationResultsFile, results.Bytes(), manifest.CreatedAt); err != nil {
+		return nil, err
+	}
+
+	for _, id := range resultIds {
+		data, err = json.Marshal(id)
+		if err != nil {
+			return nil, err
+		}
+		ids.Write(data)
+		ids.WriteByte('\n')
+	}
+	if err = writeArchiveFile(tw, archiveAssessmentResultIdsFile, ids.Bytes(), manifest.CreatedAt); err != nil {
+		return nil, err
+	}
+
+	if err = tw.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// writeArchiveFile adds a file with the given name and contents to the tar archive.
+func writeArchiveFile(tw *tar.Writer, name string, data []byte, modTime time.Time) (err error) {
+	err = tw.WriteHeader(&tar.Header{
+		Name:    name,
+		Mode:    0644,
+		Size:    int64(len(data)),
+		ModTime: modTime,
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = tw.Write(data)
+	return err
+}
+
+// readArchive reads the manifest and the evaluation results of a tar archive created by [writeArchive]. The
+// assessment result IDs are only informational and are not read.
+func readArchive(b []byte) (manifest *archiveManifest, evals []*evaluation.EvaluationResult, err error) {
+	var (
+		tr  = tar.NewReader(bytes.NewReader(b))
+		hdr *tar.Header
+	)
+
+	for {
+		hdr, err = tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return nil, nil, fmt.Errorf("%w: %w", ErrArchiveInvalid, err)
+		}
+
+		switch hdr.Name {
+		case archiveManifestFile:
+			manifest = new(archiveManifest)
+			if err = json.NewDecoder(tr).Decode(manifest); err != nil {
+				return nil, nil, fmt.Errorf("%w: could not read %s: %w", ErrArchiveInvalid, archiveManifestFile, err)
+			}
+		case archiveEvaluationResultsFile:
+			evals, err = readEvaluationResults(tr, len(b))
+			if err != nil {
+				return nil, nil, fmt.Errorf("%w: could not read %s: %w", ErrArchiveInvalid, archiveEvaluationResultsFile, err)
+			}
+		}
+	}
+
+	if manifest == nil {
+		return nil, nil, fmt.Errorf("%w: %s is missing", ErrArchiveInvalid, archiveManifestFile)
+	}
+
+	return manifest, evals, nil
+}
+
+// readEvaluationResults reads evaluation results encoded as NDJSON. Lines can be at most maxLine bytes long.
+func readEvaluationResults(r io.Reader, maxLine int) (evals []*evaluation.EvaluationResult, err error) {
+	var scanner = bufio.NewScanner(r)
+
+	scanner.Buffer(make([]byte, 0, 64*1024), maxLine+1)
+	for scanner.Scan() {
+		var eval evaluation.EvaluationResult
+
+		if len(bytes.TrimSpace(scanner.Bytes())) == 0 {
+			continue
+		}
+
+		if err = api.UnmarshalCanonicalJSON(scanner.Bytes(), &eval); err != nil {
+			return nil, err
+		}
+		evals = append(evals, &eval)
+	}
+
+	return evals, scanner.Err()
+}
@@ -0,0 +1,339 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package orchestrator
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"confirmate.io/core/api/evaluation"
+	"confirmate.io/core/api/orchestrator"
+	"confirmate.io/core/persistence"
+	"confirmate.io/core/persistence/persistencetest"
+	"confirmate.io/core/service"
+	"confirmate.io/core/service/orchestrator/orchestratortest"
+	"confirmate.io/core/util/assert"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	mockArchiveEvaluationResultId1 = "00000000-0000-0000-0008-000000000001"
+	mockArchiveEvaluationResultId2 = "00000000-0000-0000-0008-000000000002"
+	mockArchiveScopeId             = "00000000-0000-0000-0008-000000000010"
+)
+
+// mockArchiveRecords returns the audit scopes 1 and 2, where audit scope 1 has two evaluation results based on the same
+// assessment result.
+func mockArchiveRecords() (records []any) {
+	records = []any{
+		orchestratortest.MockAuditScope1,
+		orchestratortest.MockAuditScope2,
+	}
+
+	for i, id := range []string{mockArchiveEvaluationResultId1, mockArchiveEvaluationResultId2} {
+		records = append(records, &evaluation.EvaluationResult{
+			Id:                   id,
+			TargetOfEvaluationId: orchestratortest.MockToeId1,
+			AuditScopeId:         orchestratortest.MockScopeId1,
+			ControlId:            orchestratortest.MockControlId1,
+			ControlCatalogId:     orchestratortest.MockCatalogId1,
+			Status:               evaluation.EvaluationStatus_EVALUATION_STATUS_COMPLIANT,
+			Timestamp:            timestamppb.New(time.Date(2026, 1, i+1, 0, 0, 0, 0, time.UTC)),
+			AssessmentResultIds:  []string{orchestratortest.MockResultId1},
+		})
+	}
+
+	return
+}
+
+// newMockArchive returns the archive of audit scope 1 of [mockArchiveRecords].
+func newMockArchive(t *testing.T) []byte {
+	svc := &Service{db: persistencetest.NewInMemoryDB(t, types, joinTables, createRecords(t, mockArchiveRecords()...)), authz: &service.AuthorizationStrategyAllowAll{}}
+
+	res, err := svc.ArchiveAuditScopeData(context.Background(), connect.NewRequest(&orchestrator.ArchiveAuditScopeDataRequest{
+		AuditScopeId: orchestratortest.MockScopeId1,
+	}))
+	assert.NoError(t, err)
+
+	return res.Msg.GetArchive()
+}
+
+// archiveFile returns the contents of the file with the given name in the tar archive.
+func archiveFile(t *testing.T, archive []byte, name string) string {
+	tr := tar.NewReader(bytes.NewReader(archive))
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return ""
+		}
+		assert.NoError(t, err)
+
+		if hdr.Name == name {
+			b, err := io.ReadAll(tr)
+			assert.NoError(t, err)
+			return string(b)
+		}
+	}
+}
+
+func TestService_ArchiveAuditScopeData(t *testing.T) {
+	type fields struct {
+		db    persistence.DB
+		authz service.AuthorizationStrategy
+	}
+	tests := []struct {
+		name    string
+		req     *orchestrator.ArchiveAuditScopeDataRequest
+		fields  fields
+		want    assert.Want[*connect.Response[orchestrator.ArchiveAuditScopeDataResponse]]
+		wantErr assert.WantErr
+	}{
+		{
+			name: "happy path",
+			req: &orchestrator.ArchiveAuditScopeDataRequest{
+				AuditScopeId: orchestratortest.MockScopeId1,
+			},
+			fields: fields{
+				db:    persistencetest.NewInMemoryDB(t, types, joinTables, createRecords(t, mockArchiveRecords()...)),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			want: func(t *testing.T, got *connect.Response[orchestrator.ArchiveAuditScopeDataResponse], args ...any) bool {
+				manifest, evals, err := readArchive(got.Msg.GetArchive())
+
+				return assert.NoError(t, err) &&
+					assert.Equal(t, ArchiveVersion, manifest.Version) &&
+					assert.Equal(t, orchestratortest.MockScopeId1, manifest.AuditScopeId) &&
+					assert.Equal(t, []string{orchestratortest.MockCatalogId1}, manifest.CatalogIds) &&
+					assert.Equal(t, 2, manifest.EvaluationResults) &&
+					assert.Equal(t, 1, manifest.AssessmentResultIds) &&
+					assert.Equal(t, 2, len(evals)) &&
+					assert.Equal(t, mockArchiveEvaluationResultId1, evals[0].GetId()) &&
+					assert.Equal(t, `"`+orchestratortest.MockResultId1+`"`+"\n",
+						archiveFile(t, got.Msg.GetArchive(), archiveAssessmentResultIdsFile))
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "empty audit scope",
+			req: &orchestrator.ArchiveAuditScopeDataRequest{
+				AuditScopeId: orchestratortest.MockScopeId2,
+			},
+			fields: fields{
+				db:    persistencetest.NewInMemoryDB(t, types, joinTables, createRecords(t, mockArchiveRecords()...)),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			want: func(t *testing.T, got *connect.Response[orchestrator.ArchiveAuditScopeDataResponse], args ...any) bool {
+				manifest, evals, err := readArchive(got.Msg.GetArchive())
+
+				return assert.NoError(t, err) &&
+					assert.Equal(t, 0, manifest.EvaluationResults) &&
+					assert.Empty(t, evals)
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "audit scope not found",
+			req: &orchestrator.ArchiveAuditScopeDataRequest{
+				AuditScopeId: mockArchiveScopeId,
+			},
+			fields: fields{
+				db:    persistencetest.NewInMemoryDB(t, types, joinTables, createRecords(t, mockArchiveRecords()...)),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			want: assert.Nil[*connect.Response[orchestrator.ArchiveAuditScopeDataResponse]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeNotFound)
+			},
+		},
+		{
+			name: "permission denied",
+			req: &orchestrator.ArchiveAuditScopeDataRequest{
+				AuditScopeId: orchestratortest.MockScopeId1,
+			},
+			fields: fields{
+				db:    persistencetest.NewInMemoryDB(t, types, joinTables, createRecords(t, mockArchiveRecords()...)),
+				authz: &denyAuthorizationStrategy{},
+			},
+			want: assert.Nil[*connect.Response[orchestrator.ArchiveAuditScopeDataResponse]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodePermissionDenied)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &Service{
+				db:    tt.fields.db,
+				authz: tt.fields.authz,
+			}
+			got, gotErr := svc.ArchiveAuditScopeData(context.Background(), connect.NewRequest(tt.req))
+
+			tt.want(t, got)
+			tt.wantErr(t, gotErr)
+		})
+	}
+}
+
+func TestService_RestoreAuditScopeData(t *testing.T) {
+	type fields struct {
+		db    persistence.DB
+		authz service.AuthorizationStrategy
+	}
+	tests := []struct {
+		name    string
+		req     *orchestrator.RestoreAuditScopeDataRequest
+		fields  fields
+		want    assert.Want[*connect.Response[orchestrator.RestoreAuditScopeDataResponse]]
+		wantErr assert.WantErr
+	}{
+		{
+			name: "happy path: into another deployment",
+			req: &orchestrator.RestoreAuditScopeDataRequest{
+				AuditScopeId: mockArchiveScopeId,
+				Archive:      newMockArchive(t),
+			},
+			fields: fields{
+				db: persistencetest.NewInMemoryDB(t, types, joinTables, func(d persistence.DB) {
+					assert.NoError(t, d.Create(&orchestrator.AuditScope{
+						Id:                   mockArchiveScopeId,
+						Name:                 "Handover",
+						TargetOfEvaluationId: orchestratortest.MockToeId2,
+						CatalogId:            orchestratortest.MockCatalogId1,
+					}))
+				}),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			want: func(t *testing.T, got *connect.Response[orchestrator.RestoreAuditScopeDataResponse], args ...any) bool {
+				return assert.Equal(t, int32(2), got.Msg.GetRestoredEvaluationResults()) &&
+					assert.Equal(t, int32(0), got.Msg.GetSkippedEvaluationResults())
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "existing evaluation results are skipped",
+			req: &orchestrator.RestoreAuditScopeDataRequest{
+				AuditScopeId: orchestratortest.MockScopeId1,
+				Archive:      newMockArchive(t),
+			},
+			fields: fields{
+				db:    persistencetest.NewInMemoryDB(t, types, joinTables, createRecords(t, mockArchiveRecords()...)),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			want: func(t *testing.T, got *connect.Response[orchestrator.RestoreAuditScopeDataResponse], args ...any) bool {
+				return assert.Equal(t, int32(0), got.Msg.GetRestoredEvaluationResults()) &&
+					assert.Equal(t, int32(2), got.Msg.GetSkippedEvaluationResults())
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "catalog not part of the audit scope",
+			req: &orchestrator.RestoreAuditScopeDataRequest{
+				AuditScopeId: orchestratortest.MockScopeId2,
+				Archive:      newMockArchive(t),
+			},
+			fields: fields{
+				db:    persistencetest.NewInMemoryDB(t, types, joinTables, createRecords(t, mockArchiveRecords()...)),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			want: assert.Nil[*connect.Response[orchestrator.RestoreAuditScopeDataResponse]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeInvalidArgument) &&
+					assert.ErrorContains(t, err, "not part of the audit scope")
+			},
+		},
+		{
+			name: "invalid archive",
+			req: &orchestrator.RestoreAuditScopeDataRequest{
+				AuditScopeId: orchestratortest.MockScopeId1,
+				Archive:      []byte("not a tar file"),
+			},
+			fields: fields{
+				db:    persistencetest.NewInMemoryDB(t, types, joinTables, createRecords(t, mockArchiveRecords()...)),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			want: assert.Nil[*connect.Response[orchestrator.RestoreAuditScopeDataResponse]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeInvalidArgument) &&
+					assert.ErrorIs(t, err, ErrArchiveInvalid)
+			},
+		},
+		{
+			name: "permission denied",
+			req: &orchestrator.RestoreAuditScopeDataRequest{
+				AuditScopeId: orchestratortest.MockScopeId1,
+				Archive:      newMockArchive(t),
+			},
+			fields: fields{
+				db:    persistencetest.NewInMemoryDB(t, types, joinTables, createRecords(t, mockArchiveRecords()...)),
+				authz: &denyAuthorizationStrategy{},
+			},
+			want: assert.Nil[*connect.Response[orchestrator.RestoreAuditScopeDataResponse]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodePermissionDenied)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &Service{
+				db:    tt.fields.db,
+				authz: tt.fields.authz,
+			}
+			got, gotErr := svc.RestoreAuditScopeData(context.Background(), connect.NewRequest(tt.req))
+
+			tt.want(t, got)
+			tt.wantErr(t, gotErr)
+		})
+	}
+}
+
+// TestService_RestoreAuditScopeData_moved covers that restored evaluation results are moved to the audit scope they
+// are restored into.
+func TestService_RestoreAuditScopeData_moved(t *testing.T) {
+	var (
+		db = persistencetest.NewInMemoryDB(t, types, joinTables, func(d persistence.DB) {
+			assert.NoError(t, d.Create(&orchestrator.AuditScope{
+				Id:                   mockArchiveScopeId,
+				Name:                 "Handover",
+				TargetOfEvaluationId: orchestratortest.MockToeId2,
+				CatalogId:            orchestratortest.MockCatalogId1,
+			}))
+		})
+		svc   = &Service{db: db, authz: &service.AuthorizationStrategyAllowAll{}}
+		evals []*evaluation.EvaluationResult
+	)
+
+	_, err := svc.RestoreAuditScopeData(context.Background(), connect.NewRequest(&orchestrator.RestoreAuditScopeDataRequest{
+		AuditScopeId: mockArchiveScopeId,
+		Archive:      newMockArchive(t),
+	}))
+	assert.NoError(t, err)
+
+	err = db.List(&evals, "timestamp", true, 0, -1, persistence.WithoutPreload())
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(evals))
+	for _, eval := range evals {
+		assert.Equal(t, mockArchiveScopeId, eval.GetAuditScopeId())
+		assert.Equal(t, orchestratortest.MockToeId2, eval.GetTargetOfEvaluationId())
+		assert.Equal(t, []string{orchestratortest.MockResultId1}, eval.GetAssessmentResultIds())
+	}
+}
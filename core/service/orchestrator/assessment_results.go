@@ -20,10 +20,12 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"slices"
 	"strings"
 
 	"confirmate.io/core/api/assessment"
 	"confirmate.io/core/api/orchestrator"
+	"confirmate.io/core/persistence"
 	"confirmate.io/core/service"
 
 	"connectrpc.com/connect"
@@ -58,12 +60,21 @@ func (svc *Service) StoreAssessmentResult(
 	// Set timestamp
 	result.CreatedAt = timestamppb.Now()
 
+	// Waivers can only be granted using WaiveAssessmentResult
+	result.Waiver = nil
+
+	// Only ImportExternalFindings creates externally sourced results
+	result.ExternallySourced = false
+	result.ExternalFinding = nil
+
 	// Persist the assessment result in the database
 	err = svc.db.Create(result)
 	if err = service.HandleDatabaseError(err); err != nil {
 		return nil, err
 	}
 
+	svc.meterAssessmentResults([]*assessment.AssessmentResult{result}, result.CreatedAt.AsTime())
+
 	// Notify subscribers
 	go svc.publishEvent(&orchestrator.ChangeEvent{
 		Timestamp:   timestamppb.Now(),
@@ -111,7 +122,135 @@ func (svc *Service) GetAssessmentResult(
 	return
 }
 
-// ListAssessmentResults lists all assessment results with optional filtering.
+// WaiveAssessmentResult waives a non-compliant assessment result. The current user is recorded as the approver of the
+// waiver. Waived assessment results are skipped by the evaluation until the waiver expires. The waiver is stored in
+// its own table, so that the assessment result itself is not modified, even if it is immutable.
+func (svc *Service) WaiveAssessmentResult(
+	ctx context.Context,
+	req *connect.Request[orchestrator.WaiveAssessmentResultRequest],
+) (res *connect.Response[assessment.AssessmentResult], err error) {
+	var (
+		result  assessment.AssessmentResult
+		allowed bool
+		userId  string
+	)
+
+	// Validate the request
+	if err = service.Validate(req); err != nil {
+		return nil, err
+	}
+
+	err = svc.db.Get(&result, "id = ?", req.Msg.GetAssessmentResultId())
+	if err = service.HandleDatabaseError(err, service.ErrNotFound("assessment result")); err != nil {
+		return nil, err
+	}
+
+	// Check access via the configured auth strategy
+	allowed, _, err = CheckAccess(ctx, svc.authz, svc, orchestrator.RequestType_REQUEST_TYPE_UPDATED, result.GetTargetOfEvaluationId(), orchestrator.ObjectType_OBJECT_TYPE_ASSESSMENT_RESULT)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if !allowed {
+		return nil, service.ErrPermissionDenied
+	}
+
+	// There is no risk to accept for compliant results
+	if result.GetCompliant() {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("only non-compliant assessment results can be waived"))
+	}
+
+	userId, err = provisionCurrentUser(ctx, svc)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	result.Waiver = &assessment.Waiver{
+		AssessmentResultId: result.GetId(),
+		Justification:      req.Msg.GetJustification(),
+		Approver:           userId,
+		ApprovedAt:         timestamppb.Now(),
+		ExpiresAt:          req.Msg.ExpiresAt,
+	}
+
+	// Replace an existing waiver of the assessment result
+	err = svc.db.Save(result.Waiver, "assessment_result_id = ?", result.GetId())
+	if err = service.HandleDatabaseError(err); err != nil {
+		return nil, err
+	}
+
+	// Notify subscribers
+	go svc.publishEvent(&orchestrator.ChangeEvent{
+		Timestamp:   timestamppb.Now(),
+		Category:    orchestrator.EventCategory_EVENT_CATEGORY_ASSESSMENT_RESULT,
+		RequestType: orchestrator.RequestType_REQUEST_TYPE_UPDATED,
+		EntityId:    result.GetId(),
+		Entity: &orchestrator.ChangeEvent_AssessmentResult{
+			AssessmentResult: &result,
+		},
+	})
+
+	res = connect.NewResponse(&result)
+	return
+}
+
+// RevokeAssessmentResultWaiver removes the waiver of an assessment result, so that it is taken into account by the
+// evaluation again.
+func (svc *Service) RevokeAssessmentResultWaiver(
+	ctx context.Context,
+	req *connect.Request[orchestrator.RevokeAssessmentResultWaiverRequest],
+) (res *connect.Response[assessment.AssessmentResult], err error) {
+	var (
+		result  assessment.AssessmentResult
+		allowed bool
+	)
+
+	// Validate the request
+	if err = service.Validate(req); err != nil {
+		return nil, err
+	}
+
+	err = svc.db.Get(&result, "id = ?", req.Msg.GetAssessmentResultId())
+	if err = service.HandleDatabaseError(err, service.ErrNotFound("assessment result")); err != nil {
+		return nil, err
+	}
+
+	// Check access via the configured auth strategy
+	allowed, _, err = CheckAccess(ctx, svc.authz, svc, orchestrator.RequestType_REQUEST_TYPE_UPDATED, result.GetTargetOfEvaluationId(), orchestrator.ObjectType_OBJECT_TYPE_ASSESSMENT_RESULT)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if !allowed {
+		return nil, service.ErrPermissionDenied
+	}
+
+	if result.GetWaiver() == nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("assessment result is not waived"))
+	}
+
+	err = svc.db.Delete(&assessment.Waiver{}, "assessment_result_id = ?", result.GetId())
+	if err = service.HandleDatabaseError(err); err != nil {
+		return nil, err
+	}
+
+	result.Waiver = nil
+
+	// Notify subscribers
+	go svc.publishEvent(&orchestrator.ChangeEvent{
+		Timestamp:   timestamppb.Now(),
+		Category:    orchestrator.EventCategory_EVENT_CATEGORY_ASSESSMENT_RESULT,
+		RequestType: orchestrator.RequestType_REQUEST_TYPE_UPDATED,
+		EntityId:    result.GetId(),
+		Entity: &orchestrator.ChangeEvent_AssessmentResult{
+			AssessmentResult: &result,
+		},
+	})
+
+	res = connect.NewResponse(&result)
+	return
+}
+
+// ListAssessmentResults lists all assessment results with optional filtering. If as_of is set, only results created
+// at or before that time are considered, so that historical evaluation decisions can be reproduced.
 func (svc *Service) ListAssessmentResults(
 	ctx context.Context,
 	req *connect.Request[orchestrator.ListAssessmentResultsRequest],
@@ -152,6 +291,16 @@ func (svc *Service) ListAssessmentResults(
 			whereClauses = append(whereClauses, "metric_id = ?")
 			args = append(args, req.Msg.Filter.GetMetricId())
 		}
+		if len(req.Msg.Filter.MetricIds) > 0 {
+			// Build IN clause dynamically to support ramsql (doesn't support array binding)
+			var placeholders string
+			placeholders = strings.Repeat("?,", len(req.Msg.Filter.MetricIds))
+			placeholders = placeholders[:len(placeholders)-1] // Remove trailing comma
+			whereClauses = append(whereClauses, "metric_id IN ("+placeholders+")")
+			for _, id := range req.Msg.Filter.MetricIds {
+				args = append(args, id)
+			}
+		}
 		if req.Msg.Filter.ToolId != nil {
 			whereClauses = append(whereClauses, "tool_id = ?")
 			args = append(args, req.Msg.Filter.GetToolId())
@@ -170,6 +319,29 @@ func (svc *Service) ListAssessmentResults(
 			whereClauses = append(whereClauses, "evidence_id = ?")
 			args = append(args, req.Msg.Filter.GetEvidenceId())
 		}
+		if req.Msg.Filter.Owner != nil {
+			whereClauses = append(whereClauses, "owner = ?")
+			args = append(args, req.Msg.Filter.GetOwner())
+		}
+		if req.Msg.Filter.Team != nil {
+			whereClauses = append(whereClauses, "team = ?")
+			args = append(args, req.Msg.Filter.GetTeam())
+		}
+		if req.Msg.Filter.Environment != nil {
+			whereClauses = append(whereClauses, "environment = ?")
+			args = append(args, req.Msg.Filter.GetEnvironment())
+		}
+		if req.Msg.Filter.ExternallySourced != nil {
+			whereClauses = append(whereClauses, "externally_sourced = ?")
+			args = append(args, req.Msg.Filter.GetExternallySourced())
+		}
+	}
+
+	// Only consider results that already existed at the requested point in time. This also applies to
+	// latest_by_resource_id, which then yields the latest results as of that time.
+	if req.Msg.AsOf != nil {
+		whereClauses = append(whereClauses, "created_at <= ?")
+		args = append(args, req.Msg.GetAsOf().AsTime())
 	}
 
 	// Retrieve list of all allowed ToE IDs for the user to filter results by access permissions.
@@ -226,12 +398,24 @@ func (svc *Service) ListAssessmentResults(
 			return nil, err
 		}
 
+		// Raw SQL does not preload associations, so the waivers of the results need to be loaded separately
+		err = svc.loadWaivers(results)
+		if err = service.HandleDatabaseError(err); err != nil {
+			return nil, err
+		}
+
 		// Since we used raw SQL, we need to handle pagination differently
 		// For now, return all results without pagination support
 		res = connect.NewResponse(&orchestrator.ListAssessmentResultsResponse{
 			Results:       results,
 			NextPageToken: "",
 		})
+
+		// Reduce the latest results to a sample, if requested
+		if req.Msg.Sampling != nil {
+			res.Msg.PopulationSize = new(int64(len(results)))
+			res.Msg.Results = sampleAssessmentResults(results, req.Msg.Sampling)
+		}
 		return
 	}
 
@@ -247,6 +431,45 @@ func (svc *Service) ListAssessmentResults(
 	return
 }
 
+// loadWaivers loads the waivers of the given assessment results from their own table and attaches them to the
+// results. This is only necessary for results retrieved using raw SQL, otherwise the waivers are preloaded.
+func (svc *Service) loadWaivers(results []*assessment.AssessmentResult) (err error) {
+	var (
+		waivers      []*assessment.Waiver
+		byResultId   map[string]*assessment.Waiver
+		placeholders string
+		conds        []any
+	)
+
+	if len(results) == 0 {
+		return nil
+	}
+
+	// Build IN clause dynamically to support ramsql (doesn't support array binding)
+	placeholders = strings.Repeat("?,", len(results))
+	placeholders = placeholders[:len(placeholders)-1] // Remove trailing comma
+	conds = append(conds, "assessment_result_id IN ("+placeholders+")")
+	for _, result := range results {
+		conds = append(conds, result.GetId())
+	}
+
+	err = svc.db.List(&waivers, "assessment_result_id", true, 0, -1, conds...)
+	if err != nil {
+		return err
+	}
+
+	byResultId = make(map[string]*assessment.Waiver, len(waivers))
+	for _, waiver := range waivers {
+		byResultId[waiver.GetAssessmentResultId()] = waiver
+	}
+
+	for _, result := range results {
+		result.Waiver = byResultId[result.GetId()]
+	}
+
+	return nil
+}
+
 // StoreAssessmentResults stores assessment results via a bidirectional stream.
 func (svc *Service) StoreAssessmentResults(
 	ctx context.Context,
@@ -290,3 +513,122 @@ func (svc *Service) StoreAssessmentResults(
 		}
 	}
 }
+
+// BatchStoreAssessmentResults stores a batch of assessment results in a single transaction. Each result is validated
+// and authorized individually; results that fail these checks or already exist are reported in the response at their
+// position in the request, while the remaining results are stored. If the transaction itself fails, none of the
+// results are stored.
+func (svc *Service) BatchStoreAssessmentResults(
+	ctx context.Context,
+	req *connect.Request[orchestrator.BatchStoreAssessmentResultsRequest],
+) (res *connect.Response[orchestrator.BatchStoreAssessmentResultsResponse], err error) {
+	var (
+		statuses []*orchestrator.StoreAssessmentResultsResponse
+		allowed  map[string]bool
+		ids      []string
+		existing []string
+		valid    []*assessment.AssessmentResult
+		now      *timestamppb.Timestamp
+	)
+
+	// Validate the request
+	if err = service.Validate(req); err != nil {
+		return nil, err
+	}
+
+	statuses = make([]*orchestrator.StoreAssessmentResultsResponse, len(req.Msg.Results))
+	allowed = make(map[string]bool)
+
+	// Retrieve the IDs that are already stored, so that we can report them individually instead of failing the whole
+	// transaction
+	for _, result := range req.Msg.Results {
+		ids = append(ids, result.GetId())
+	}
+	err = svc.db.Pluck(&assessment.AssessmentResult{}, "id", &existing, "id IN ?", ids)
+	if err = service.HandleDatabaseError(err); err != nil {
+		return nil, err
+	}
+
+	for i, result := range req.Msg.Results {
+		var ok, found bool
+
+		// Validate each result in the same way as a single one
+		err = service.Validate(connect.NewRequest(&orchestrator.StoreAssessmentResultRequest{Result: result}))
+		if err != nil {
+			statuses[i] = &orchestrator.StoreAssessmentResultsResponse{StatusMessage: err.Error()}
+			continue
+		}
+
+		// Check access via the configured auth strategy, once per target of evaluation
+		ok, found = allowed[result.GetTargetOfEvaluationId()]
+		if !found {
+			ok, _, err = CheckAccess(ctx, svc.authz, svc, orchestrator.RequestType_REQUEST_TYPE_CREATED, result.GetTargetOfEvaluationId(), orchestrator.ObjectType_OBJECT_TYPE_ASSESSMENT_RESULT)
+			if err != nil {
+				return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("%w: %w", service.ErrDatabaseError, err))
+			}
+			allowed[result.GetTargetOfEvaluationId()] = ok
+		}
+		if !ok {
+			statuses[i] = &orchestrator.StoreAssessmentResultsResponse{StatusMessage: service.ErrPermissionDenied.Error()}
+			continue
+		}
+
+		// Results can neither be stored twice nor be contained twice in the batch
+		if slices.Contains(existing, result.GetId()) {
+			statuses[i] = &orchestrator.StoreAssessmentResultsResponse{StatusMessage: service.ErrResourceAlreadyExists.Error()}
+			continue
+		}
+		existing = append(existing, result.GetId())
+
+		valid = append(valid, result)
+		statuses[i] = &orchestrator.StoreAssessmentResultsResponse{Status: true}
+	}
+
+	if len(valid) > 0 {
+		now = timestamppb.Now()
+		for _, result := range valid {
+			result.CreatedAt = now
+
+			// Waivers can only be granted using WaiveAssessmentResult
+			result.Waiver = nil
+
+			// Only ImportExternalFindings creates externally sourced results
+			result.ExternallySourced = false
+			result.ExternalFinding = nil
+		}
+
+		// Persist all valid assessment results in a single transaction
+		err = svc.db.Transaction(func(tx persistence.DB) error {
+			for _, result := range valid {
+				if err := tx.Create(result); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		if err = service.HandleDatabaseError(err); err != nil {
+			return nil, err
+		}
+
+		svc.meterAssessmentResults(valid, now.AsTime())
+
+		// Notify subscribers
+		for _, result := range valid {
+			go svc.publishEvent(&orchestrator.ChangeEvent{
+				Timestamp:   timestamppb.Now(),
+				Category:    orchestrator.EventCategory_EVENT_CATEGORY_ASSESSMENT_RESULT,
+				RequestType: orchestrator.RequestType_REQUEST_TYPE_CREATED,
+				EntityId:    result.Id,
+				Entity: &orchestrator.ChangeEvent_AssessmentResult{
+					AssessmentResult: result,
+				},
+			})
+		}
+	}
+
+	res = connect.NewResponse(&orchestrator.BatchStoreAssessmentResultsResponse{
+		Results: statuses,
+		Stored:  int32(len(valid)),
+	})
+	return
+}
@@ -22,6 +22,7 @@ import (
 	"time"
 
 	"confirmate.io/core/api/assessment"
+	"confirmate.io/core/api/evaluation"
 	"confirmate.io/core/api/orchestrator"
 	"confirmate.io/core/auth"
 	"confirmate.io/core/persistence"
@@ -31,6 +32,7 @@ import (
 	"confirmate.io/core/util/assert"
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/testing/protocmp"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
@@ -55,6 +57,10 @@ func (*denyAuthorizationStrategy) AllowedAuditScopes(_ context.Context) (bool, [
 	return false, nil
 }
 
+func (*denyAuthorizationStrategy) ClaimedTargetOfEvaluations(_ context.Context) (bool, []string) {
+	return false, nil
+}
+
 func TestService_StoreAssessmentResult(t *testing.T) {
 	type args struct {
 		req     *orchestrator.StoreAssessmentResultRequest
@@ -249,6 +255,136 @@ func TestService_StoreAssessmentResult(t *testing.T) {
 	}
 }
 
+func TestService_BatchStoreAssessmentResults(t *testing.T) {
+	type args struct {
+		req *orchestrator.BatchStoreAssessmentResultsRequest
+	}
+	type fields struct {
+		db    persistence.DB
+		authz service.AuthorizationStrategy
+	}
+	tests := []struct {
+		name    string
+		args    args
+		fields  fields
+		want    assert.Want[*connect.Response[orchestrator.BatchStoreAssessmentResultsResponse]]
+		wantErr assert.WantErr
+		wantDB  assert.Want[persistence.DB]
+	}{
+		{
+			name: "validation error - empty request",
+			args: args{
+				req: &orchestrator.BatchStoreAssessmentResultsRequest{},
+			},
+			fields: fields{
+				db: persistencetest.NewInMemoryDB(t, types, joinTables),
+			},
+			want: assert.Nil[*connect.Response[orchestrator.BatchStoreAssessmentResultsResponse]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeInvalidArgument)
+			},
+			wantDB: assert.NotNil[persistence.DB],
+		},
+		{
+			name: "db error",
+			args: args{
+				req: &orchestrator.BatchStoreAssessmentResultsRequest{
+					Results: []*assessment.AssessmentResult{orchestratortest.MockNewAssessmentResult},
+				},
+			},
+			fields: fields{
+				db:    persistencetest.PluckErrorDB(t, persistence.ErrDatabase, types, joinTables),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			want: assert.Nil[*connect.Response[orchestrator.BatchStoreAssessmentResultsResponse]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeInternal)
+			},
+			wantDB: assert.NotNil[persistence.DB],
+		},
+		{
+			name: "authorization failure",
+			args: args{
+				req: &orchestrator.BatchStoreAssessmentResultsRequest{
+					Results: []*assessment.AssessmentResult{orchestratortest.MockNewAssessmentResult},
+				},
+			},
+			fields: fields{
+				db:    persistencetest.NewInMemoryDB(t, types, joinTables),
+				authz: &denyAuthorizationStrategy{},
+			},
+			want: func(t *testing.T, got *connect.Response[orchestrator.BatchStoreAssessmentResultsResponse], msgAndArgs ...any) bool {
+				return assert.Equal(t, int32(0), got.Msg.Stored) &&
+					assert.False(t, got.Msg.Results[0].Status) &&
+					assert.Equal(t, service.ErrPermissionDenied.Error(), got.Msg.Results[0].StatusMessage)
+			},
+			wantErr: assert.NoError,
+			wantDB: func(t *testing.T, db persistence.DB, msgAndArgs ...any) bool {
+				count, err := db.Count(&assessment.AssessmentResult{}, "id = ?", orchestratortest.MockResultId3)
+				return assert.NoError(t, err) && assert.Equal(t, int64(0), count)
+			},
+		},
+		{
+			name: "happy path: partial failure",
+			args: args{
+				req: &orchestrator.BatchStoreAssessmentResultsRequest{
+					Results: []*assessment.AssessmentResult{
+						// Invalid, because the metric ID is missing
+						{
+							Id:                   orchestratortest.MockResultId2,
+							EvidenceId:           orchestratortest.MockEvidenceId1,
+							TargetOfEvaluationId: orchestratortest.MockToeId1,
+						},
+						// Already stored
+						orchestratortest.MockAssessmentResult1,
+						orchestratortest.MockNewAssessmentResult,
+						// Duplicate within the batch
+						orchestratortest.MockNewAssessmentResult,
+					},
+				},
+			},
+			fields: fields{
+				db: persistencetest.NewInMemoryDB(t, types, joinTables, func(d persistence.DB) {
+					assert.NoError(t, d.Create(orchestratortest.MockAssessmentResult1))
+				}),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			want: func(t *testing.T, got *connect.Response[orchestrator.BatchStoreAssessmentResultsResponse], msgAndArgs ...any) bool {
+				return assert.Equal(t, int32(1), got.Msg.Stored) &&
+					assert.Equal(t, 4, len(got.Msg.Results)) &&
+					assert.False(t, got.Msg.Results[0].Status) &&
+					assert.Contains(t, got.Msg.Results[0].StatusMessage, "metric_id") &&
+					assert.False(t, got.Msg.Results[1].Status) &&
+					assert.Equal(t, service.ErrResourceAlreadyExists.Error(), got.Msg.Results[1].StatusMessage) &&
+					assert.True(t, got.Msg.Results[2].Status) &&
+					assert.False(t, got.Msg.Results[3].Status)
+			},
+			wantErr: assert.NoError,
+			wantDB: func(t *testing.T, db persistence.DB, msgAndArgs ...any) bool {
+				result := assert.InDB[assessment.AssessmentResult](t, db, orchestratortest.MockResultId3)
+				assert.NotNil(t, result.CreatedAt)
+				assert.Equal(t, orchestratortest.MockResourceIdNew, result.ResourceId)
+
+				count, err := db.Count(&assessment.AssessmentResult{}, "id = ?", orchestratortest.MockResultId2)
+				return assert.NoError(t, err) && assert.Equal(t, int64(0), count)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &Service{
+				db:    tt.fields.db,
+				authz: tt.fields.authz,
+			}
+			res, err := svc.BatchStoreAssessmentResults(context.Background(), connect.NewRequest(tt.args.req))
+			tt.want(t, res)
+			tt.wantErr(t, err)
+			tt.wantDB(t, tt.fields.db)
+		})
+	}
+}
+
 func TestService_GetAssessmentResult(t *testing.T) {
 	type args struct {
 		req     *orchestrator.GetAssessmentResultRequest
@@ -491,6 +627,25 @@ func TestService_ListAssessmentResults(t *testing.T) {
 					assert.ErrorContains(t, err, "invalid page_token")
 			},
 		},
+		{
+			name: "validation error: sampling without latest_by_resource_id",
+			args: args{
+				req: &orchestrator.ListAssessmentResultsRequest{
+					Sampling: &orchestrator.AssessmentResultSampling{
+						Strategy:   evaluation.SamplingStrategy_SAMPLING_STRATEGY_RANDOM,
+						SampleSize: 10,
+					},
+				},
+			},
+			fields: fields{
+				db: persistencetest.NewInMemoryDB(t, types, joinTables),
+			},
+			want: assert.Nil[*connect.Response[orchestrator.ListAssessmentResultsResponse]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeInvalidArgument) &&
+					assert.ErrorContains(t, err, "sampling requires latest_by_resource_id")
+			},
+		},
 		{
 			name: "authorization failure returns empty list",
 			args: args{
@@ -586,6 +741,31 @@ func TestService_ListAssessmentResults(t *testing.T) {
 			},
 			wantErr: assert.NoError,
 		},
+		{
+			name: "filter by metric IDs",
+			args: args{
+				req: &orchestrator.ListAssessmentResultsRequest{
+					Filter: &orchestrator.ListAssessmentResultsRequest_Filter{
+						MetricIds: []string{orchestratortest.MockAssessmentResult1.MetricId, "other-metric"},
+					},
+				},
+			},
+			fields: fields{
+				db: persistencetest.NewInMemoryDB(t, types, joinTables, func(d persistence.DB) {
+					err := d.Create(orchestratortest.MockAssessmentResult1)
+					assert.NoError(t, err)
+					err = d.Create(orchestratortest.MockAssessmentResult2)
+					assert.NoError(t, err)
+				}),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			want: func(t *testing.T, got *connect.Response[orchestrator.ListAssessmentResultsResponse], args ...any) bool {
+				return assert.NotNil(t, got.Msg) &&
+					assert.Equal(t, 1, len(got.Msg.Results)) &&
+					assert.Equal(t, orchestratortest.MockAssessmentResult1.Id, got.Msg.Results[0].Id)
+			},
+			wantErr: assert.NoError,
+		},
 		{
 			name: "filter by compliant",
 			args: args{
@@ -688,6 +868,86 @@ func TestService_ListAssessmentResults(t *testing.T) {
 			},
 			wantErr: assert.NoError,
 		},
+		{
+			name: "filter by owner and team",
+			args: args{
+				req: &orchestrator.ListAssessmentResultsRequest{
+					Filter: &orchestrator.ListAssessmentResultsRequest_Filter{
+						Owner: new(orchestratortest.MockOwner1),
+						Team:  new(orchestratortest.MockTeam1),
+					},
+				},
+			},
+			fields: fields{
+				db: persistencetest.NewInMemoryDB(t, types, joinTables, func(d persistence.DB) {
+					err := d.Create(orchestratortest.MockAssessmentResult1)
+					assert.NoError(t, err)
+					err = d.Create(orchestratortest.MockAssessmentResult2)
+					assert.NoError(t, err)
+				}),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			want: func(t *testing.T, got *connect.Response[orchestrator.ListAssessmentResultsResponse], args ...any) bool {
+				return assert.NotNil(t, got.Msg) &&
+					assert.Equal(t, 1, len(got.Msg.Results)) &&
+					assert.Equal(t, orchestratortest.MockAssessmentResult2, got.Msg.Results[0])
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "filter by environment",
+			args: args{
+				req: &orchestrator.ListAssessmentResultsRequest{
+					Filter: &orchestrator.ListAssessmentResultsRequest_Filter{
+						Environment: new(orchestratortest.MockEnvironment1),
+					},
+				},
+			},
+			fields: fields{
+				db: persistencetest.NewInMemoryDB(t, types, joinTables, func(d persistence.DB) {
+					err := d.Create(orchestratortest.MockAssessmentResult1)
+					assert.NoError(t, err)
+					err = d.Create(orchestratortest.MockAssessmentResult2)
+					assert.NoError(t, err)
+				}),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			want: func(t *testing.T, got *connect.Response[orchestrator.ListAssessmentResultsResponse], args ...any) bool {
+				return assert.NotNil(t, got.Msg) &&
+					assert.Equal(t, 1, len(got.Msg.Results)) &&
+					assert.Equal(t, orchestratortest.MockAssessmentResult2, got.Msg.Results[0])
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "filter by externally sourced",
+			args: args{
+				req: &orchestrator.ListAssessmentResultsRequest{
+					Filter: &orchestrator.ListAssessmentResultsRequest_Filter{
+						ExternallySourced: new(true),
+					},
+				},
+			},
+			fields: fields{
+				db: persistencetest.NewInMemoryDB(t, types, joinTables, func(d persistence.DB) {
+					external := proto.Clone(orchestratortest.MockAssessmentResult2).(*assessment.AssessmentResult)
+					external.ExternallySourced = true
+
+					err := d.Create(orchestratortest.MockAssessmentResult1)
+					assert.NoError(t, err)
+					err = d.Create(external)
+					assert.NoError(t, err)
+				}),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			want: func(t *testing.T, got *connect.Response[orchestrator.ListAssessmentResultsResponse], args ...any) bool {
+				return assert.NotNil(t, got.Msg) &&
+					assert.Equal(t, 1, len(got.Msg.Results)) &&
+					assert.Equal(t, orchestratortest.MockAssessmentResult2.GetId(), got.Msg.Results[0].GetId()) &&
+					assert.True(t, got.Msg.Results[0].GetExternallySourced())
+			},
+			wantErr: assert.NoError,
+		},
 		{
 			name: "filter by evidence ID",
 			args: args{
@@ -967,6 +1227,79 @@ func TestService_ListAssessmentResults(t *testing.T) {
 			},
 			wantErr: assert.NoError,
 		},
+		{
+			name: "filter by as_of",
+			args: args{
+				req: &orchestrator.ListAssessmentResultsRequest{
+					AsOf: timestamppb.New(time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)),
+				},
+				context: context.Background(),
+			},
+			fields: fields{
+				authz: &service.AuthorizationStrategyAllowAll{},
+				db:    persistencetest.NewInMemoryDB(t, types, joinTables, createRecords(t, mockAsOfRecords()...)),
+			},
+			want: func(t *testing.T, got *connect.Response[orchestrator.ListAssessmentResultsResponse], args ...any) bool {
+				// Results created after the given time are not returned, the default ordering is by created_at descending
+				if !assert.Equal(t, 2, len(got.Msg.Results)) {
+					return false
+				}
+				return assert.Equal(t, "result-1-1-middle", got.Msg.Results[0].Id) &&
+					assert.Equal(t, "result-1-1-old", got.Msg.Results[1].Id)
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "filter by latest_by_resource_id with as_of",
+			args: args{
+				req: &orchestrator.ListAssessmentResultsRequest{
+					LatestByResourceId: new(true),
+					AsOf:               timestamppb.New(time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)),
+				},
+				context: context.Background(),
+			},
+			fields: fields{
+				authz: &service.AuthorizationStrategyAllowAll{},
+				db:    persistencetest.NewInMemoryDB(t, types, joinTables, createRecords(t, mockAsOfRecords()...)),
+			},
+			want: func(t *testing.T, got *connect.Response[orchestrator.ListAssessmentResultsResponse], args ...any) bool {
+				// The latest result of resource-1 as of the given time is the middle one, resource-2 did not exist yet
+				if !assert.Equal(t, 1, len(got.Msg.Results)) {
+					return false
+				}
+				return assert.Equal(t, "result-1-1-middle", got.Msg.Results[0].Id)
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "filter by latest_by_resource_id with waiver",
+			args: args{
+				req: &orchestrator.ListAssessmentResultsRequest{
+					LatestByResourceId: new(true),
+				},
+				context: context.Background(),
+			},
+			fields: fields{
+				authz: &service.AuthorizationStrategyAllowAll{},
+				db: persistencetest.NewInMemoryDB(t, types, joinTables, func(d persistence.DB) {
+					err := d.Create(orchestratortest.MockAssessmentResult2)
+					assert.NoError(t, err)
+					err = d.Create(&assessment.Waiver{
+						AssessmentResultId: orchestratortest.MockAssessmentResult2.GetId(),
+						Justification:      "Legacy resource, accepted risk",
+					})
+					assert.NoError(t, err)
+				}),
+			},
+			want: func(t *testing.T, got *connect.Response[orchestrator.ListAssessmentResultsResponse], args ...any) bool {
+				// Waivers are stored in their own table and must be attached to the results of the raw query
+				if !assert.Equal(t, 1, len(got.Msg.Results)) {
+					return false
+				}
+				return assert.True(t, got.Msg.Results[0].IsWaived(time.Now()))
+			},
+			wantErr: assert.NoError,
+		},
 		{
 			name: "happy path: with allow-all authorization strategy",
 			args: args{
@@ -1062,6 +1395,62 @@ func TestService_ListAssessmentResults(t *testing.T) {
 			},
 			wantErr: assert.NoError,
 		},
+		{
+			name: "happy path: non-compliant results of a seeded scenario",
+			args: args{
+				req: &orchestrator.ListAssessmentResultsRequest{
+					PageSize: 100,
+					Filter: &orchestrator.ListAssessmentResultsRequest_Filter{
+						TargetOfEvaluationId: new(persistencetest.SeedToeId(0)),
+						Compliant:            new(false),
+					},
+				},
+			},
+			fields: fields{
+				db:    persistencetest.NewInMemoryDB(t, types, joinTables, persistencetest.Seed(t, persistencetest.ScenarioPacks()...)),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			want: func(t *testing.T, got *connect.Response[orchestrator.ListAssessmentResultsResponse], args ...any) bool {
+				// Three of every ten resources are not compliant for each of the six metrics, including waived ones
+				return assert.Equal(t, 18, len(got.Msg.Results))
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "happy path: sample of the latest results of a seeded scenario",
+			args: args{
+				req: &orchestrator.ListAssessmentResultsRequest{
+					Filter: &orchestrator.ListAssessmentResultsRequest_Filter{
+						TargetOfEvaluationId: new(persistencetest.SeedToeId(0)),
+					},
+					LatestByResourceId: new(true),
+					Sampling: &orchestrator.AssessmentResultSampling{
+						Strategy:   evaluation.SamplingStrategy_SAMPLING_STRATEGY_NON_COMPLIANT_FIRST,
+						SampleSize: 20,
+						Seed:       "run-1",
+					},
+				},
+				context: context.Background(),
+			},
+			fields: fields{
+				db:    persistencetest.NewInMemoryDB(t, types, joinTables, persistencetest.Seed(t, persistencetest.ScenarioPacks()...)),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			want: func(t *testing.T, got *connect.Response[orchestrator.ListAssessmentResultsResponse], args ...any) bool {
+				var nonCompliant int
+				for _, r := range got.Msg.Results {
+					if !r.Compliant {
+						nonCompliant++
+					}
+				}
+
+				// All 18 non-compliant results of the 60 latest results are part of the sample
+				return assert.Equal(t, int64(60), got.Msg.GetPopulationSize()) &&
+					assert.Equal(t, 20, len(got.Msg.Results)) &&
+					assert.Equal(t, 18, nonCompliant)
+			},
+			wantErr: assert.NoError,
+		},
 	}
 
 	for _, tt := range tests {
@@ -1076,3 +1465,241 @@ func TestService_ListAssessmentResults(t *testing.T) {
 		})
 	}
 }
+
+func TestService_WaiveAssessmentResult(t *testing.T) {
+	type fields struct {
+		db    persistence.DB
+		authz service.AuthorizationStrategy
+	}
+	tests := []struct {
+		name    string
+		req     *orchestrator.WaiveAssessmentResultRequest
+		fields  fields
+		want    assert.Want[*connect.Response[assessment.AssessmentResult]]
+		wantErr assert.WantErr
+	}{
+		{
+			name: "happy path",
+			req: &orchestrator.WaiveAssessmentResultRequest{
+				AssessmentResultId: orchestratortest.MockAssessmentResult2.GetId(),
+				Justification:      "Legacy resource, accepted risk",
+				ExpiresAt:          timestamppb.New(time.Now().Add(24 * time.Hour)),
+			},
+			fields: fields{
+				db: persistencetest.NewInMemoryDB(t, types, joinTables, func(d persistence.DB) {
+					err := d.Create(orchestratortest.MockAssessmentResult2)
+					assert.NoError(t, err)
+				}),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			want: func(t *testing.T, got *connect.Response[assessment.AssessmentResult], args ...any) bool {
+				db := args[0].(persistence.DB)
+
+				// The waiver must be persisted
+				var stored assessment.AssessmentResult
+				err := db.Get(&stored, "id = ?", orchestratortest.MockAssessmentResult2.GetId())
+				assert.NoError(t, err)
+
+				return assert.Equal(t, "Legacy resource, accepted risk", got.Msg.GetWaiver().GetJustification()) &&
+					assert.NotNil(t, got.Msg.GetWaiver().GetApprovedAt()) &&
+					assert.True(t, stored.IsWaived(time.Now())) &&
+					assert.Equal(t, got.Msg.GetWaiver(), stored.GetWaiver())
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "validation error - expiry in the past",
+			req: &orchestrator.WaiveAssessmentResultRequest{
+				AssessmentResultId: orchestratortest.MockAssessmentResult2.GetId(),
+				Justification:      "Legacy resource, accepted risk",
+				ExpiresAt:          timestamppb.New(time.Now().Add(-24 * time.Hour)),
+			},
+			fields: fields{
+				db:    persistencetest.NewInMemoryDB(t, types, joinTables),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			want: assert.Nil[*connect.Response[assessment.AssessmentResult]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeInvalidArgument) &&
+					assert.ErrorContains(t, err, "expires_at")
+			},
+		},
+		{
+			name: "compliant result cannot be waived",
+			req: &orchestrator.WaiveAssessmentResultRequest{
+				AssessmentResultId: orchestratortest.MockAssessmentResult1.GetId(),
+				Justification:      "Legacy resource, accepted risk",
+			},
+			fields: fields{
+				db: persistencetest.NewInMemoryDB(t, types, joinTables, func(d persistence.DB) {
+					err := d.Create(orchestratortest.MockAssessmentResult1)
+					assert.NoError(t, err)
+				}),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			want: assert.Nil[*connect.Response[assessment.AssessmentResult]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeFailedPrecondition)
+			},
+		},
+		{
+			name: "not found",
+			req: &orchestrator.WaiveAssessmentResultRequest{
+				AssessmentResultId: orchestratortest.MockNonExistentId,
+				Justification:      "Legacy resource, accepted risk",
+			},
+			fields: fields{
+				db:    persistencetest.NewInMemoryDB(t, types, joinTables),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			want: assert.Nil[*connect.Response[assessment.AssessmentResult]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeNotFound)
+			},
+		},
+		{
+			name: "authorization failure",
+			req: &orchestrator.WaiveAssessmentResultRequest{
+				AssessmentResultId: orchestratortest.MockAssessmentResult2.GetId(),
+				Justification:      "Legacy resource, accepted risk",
+			},
+			fields: fields{
+				db: persistencetest.NewInMemoryDB(t, types, joinTables, func(d persistence.DB) {
+					err := d.Create(orchestratortest.MockAssessmentResult2)
+					assert.NoError(t, err)
+				}),
+				authz: &denyAuthorizationStrategy{},
+			},
+			want: assert.Nil[*connect.Response[assessment.AssessmentResult]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodePermissionDenied)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &Service{
+				db:    tt.fields.db,
+				authz: tt.fields.authz,
+			}
+			got, gotErr := svc.WaiveAssessmentResult(context.Background(), connect.NewRequest(tt.req))
+
+			tt.want(t, got, tt.fields.db)
+			tt.wantErr(t, gotErr)
+		})
+	}
+}
+
+func TestService_RevokeAssessmentResultWaiver(t *testing.T) {
+	type fields struct {
+		db    persistence.DB
+		authz service.AuthorizationStrategy
+	}
+	tests := []struct {
+		name    string
+		req     *orchestrator.RevokeAssessmentResultWaiverRequest
+		fields  fields
+		want    assert.Want[*connect.Response[assessment.AssessmentResult]]
+		wantErr assert.WantErr
+	}{
+		{
+			name: "happy path",
+			req: &orchestrator.RevokeAssessmentResultWaiverRequest{
+				AssessmentResultId: orchestratortest.MockAssessmentResult2.GetId(),
+			},
+			fields: fields{
+				db: persistencetest.NewInMemoryDB(t, types, joinTables, func(d persistence.DB) {
+					result := proto.Clone(orchestratortest.MockAssessmentResult2).(*assessment.AssessmentResult)
+					result.Waiver = &assessment.Waiver{
+						Justification: "Legacy resource, accepted risk",
+						ApprovedAt:    timestamppb.Now(),
+					}
+					err := d.Create(result)
+					assert.NoError(t, err)
+				}),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			want: func(t *testing.T, got *connect.Response[assessment.AssessmentResult], args ...any) bool {
+				db := args[0].(persistence.DB)
+
+				var stored assessment.AssessmentResult
+				err := db.Get(&stored, "id = ?", orchestratortest.MockAssessmentResult2.GetId())
+				assert.NoError(t, err)
+
+				return assert.Nil(t, got.Msg.GetWaiver()) &&
+					assert.Nil(t, stored.GetWaiver())
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "result is not waived",
+			req: &orchestrator.RevokeAssessmentResultWaiverRequest{
+				AssessmentResultId: orchestratortest.MockAssessmentResult2.GetId(),
+			},
+			fields: fields{
+				db: persistencetest.NewInMemoryDB(t, types, joinTables, func(d persistence.DB) {
+					err := d.Create(orchestratortest.MockAssessmentResult2)
+					assert.NoError(t, err)
+				}),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			want: assert.Nil[*connect.Response[assessment.AssessmentResult]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeFailedPrecondition)
+			},
+		},
+		{
+			name: "authorization failure",
+			req: &orchestrator.RevokeAssessmentResultWaiverRequest{
+				AssessmentResultId: orchestratortest.MockAssessmentResult2.GetId(),
+			},
+			fields: fields{
+				db: persistencetest.NewInMemoryDB(t, types, joinTables, func(d persistence.DB) {
+					err := d.Create(orchestratortest.MockAssessmentResult2)
+					assert.NoError(t, err)
+				}),
+				authz: &denyAuthorizationStrategy{},
+			},
+			want: assert.Nil[*connect.Response[assessment.AssessmentResult]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodePermissionDenied)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &Service{
+				db:    tt.fields.db,
+				authz: tt.fields.authz,
+			}
+			got, gotErr := svc.RevokeAssessmentResultWaiver(context.Background(), connect.NewRequest(tt.req))
+
+			tt.want(t, got, tt.fields.db)
+			tt.wantErr(t, gotErr)
+		})
+	}
+}
+
+// mockAsOfRecords returns the history of assessment results of two resources, which is used to test time-travel
+// queries.
+func mockAsOfRecords() (records []any) {
+	for i, id := range []string{"result-1-1-old", "result-1-1-middle", "result-1-1-latest"} {
+		records = append(records, &assessment.AssessmentResult{
+			Id:                   id,
+			CreatedAt:            timestamppb.New(time.Date(2024, 1, 1+i, 0, 0, 0, 0, time.UTC)),
+			MetricId:             "metric-1",
+			ResourceId:           "resource-1",
+			TargetOfEvaluationId: orchestratortest.MockToeId1,
+		})
+	}
+
+	records = append(records, &assessment.AssessmentResult{
+		Id:                   "result-2-1-latest",
+		CreatedAt:            timestamppb.New(time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC)),
+		MetricId:             "metric-1",
+		ResourceId:           "resource-2",
+		TargetOfEvaluationId: orchestratortest.MockToeId1,
+	})
+
+	return
+}
@@ -0,0 +1,461 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package orchestrator
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+
+	"confirmate.io/core/api/evaluation"
+	"confirmate.io/core/api/orchestrator"
+	"confirmate.io/core/log"
+	"confirmate.io/core/persistence"
+	"confirmate.io/core/service"
+
+	"buf.build/go/protovalidate"
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// DefaultMaxAttachmentSize is the default maximum size of an attachment in bytes.
+	DefaultMaxAttachmentSize int64 = 10 << 20
+
+	// attachmentChunkSize is the size of the chunks an attachment is downloaded in.
+	attachmentChunkSize = 64 << 10
+)
+
+// AttachmentStore stores the content of attachments, while their metadata is always stored in the database of the
+// orchestrator. By default, the content is stored in the database as well (see [DBAttachmentStore]). An object storage,
+// such as S3 or MinIO, can be used by implementing this interface and setting it as [Config.AttachmentStore].
+//
+// The orchestrator only calls Delete if an attachment is removed using RemoveAttachment. Evaluation results, and with
+// them their attachments, are never removed by the orchestrator itself, but only by removing them from the database
+// directly. The content of the [DBAttachmentStore] is removed along with them by a foreign key, while other stores are
+// responsible for removing the content of attachments whose evaluation result no longer exists, e.g., by organizing
+// the content by evaluation result (see Put).
+type AttachmentStore interface {
+	// Put stores the content read from r under the ID of the given attachment. The attachment itself is stored only
+	// after its content, so that its metadata can be used to organize the content, e.g., by its evaluation result. Put
+	// must return an error if reading from r fails, since the upload is aborted this way.
+	Put(ctx context.Context, att *evaluation.Attachment, r io.Reader) (err error)
+	// Get returns a reader for the content of the attachment with the given ID. The caller must close it.
+	Get(ctx context.Context, id string) (rc io.ReadCloser, err error)
+	// Delete removes the content of the attachment with the given ID.
+	Delete(ctx context.Context, id string) (err error)
+}
+
+// attachmentContent holds a chunk of the content of an attachment stored by the [DBAttachmentStore]. The content is
+// split into chunks of [attachmentChunkSize], so that it can be stored and retrieved without holding all of it in
+// memory. It references the evaluation result of the attachment, so that the content is removed together with the
+// attachment, if the evaluation result is removed.
+type attachmentContent struct {
+	AttachmentId       string                       `gorm:"primaryKey"`
+	Chunk              int                          `gorm:"primaryKey;autoIncrement:false"`
+	EvaluationResultId string                       `gorm:"index"`
+	EvaluationResult   *evaluation.EvaluationResult `gorm:"constraint:OnDelete:CASCADE"`
+	Data               []byte                       `gorm:"type:bytea"`
+}
+
+// DBAttachmentStore is an [AttachmentStore] that stores the content of attachments in the database.
+type DBAttachmentStore struct {
+	DB persistence.DB
+}
+
+// Put implements [AttachmentStore]. The content is stored in chunks while it is read from r.
+func (s DBAttachmentStore) Put(_ context.Context, att *evaluation.Attachment, r io.Reader) (err error) {
+	var (
+		buf   = make([]byte, attachmentChunkSize)
+		n     int
+		chunk int
+	)
+
+	for {
+		n, err = io.ReadFull(r, buf)
+		if errors.Is(err, io.EOF) && chunk > 0 {
+			return nil
+		}
+		if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+			return fmt.Errorf("could not read attachment content: %w", err)
+		}
+
+		// Empty content is stored as a single empty chunk, so that it can be told apart from missing content
+		err = s.DB.Create(&attachmentContent{
+			AttachmentId:       att.GetId(),
+			Chunk:              chunk,
+			EvaluationResultId: att.GetEvaluationResultId(),
+			Data:               bytes.Clone(buf[:n]),
+		})
+		if err != nil || n < len(buf) {
+			return err
+		}
+
+		chunk++
+	}
+}
+
+// Get implements [AttachmentStore]. The returned reader retrieves one chunk of the content at a time.
+func (s DBAttachmentStore) Get(_ context.Context, id string) (rc io.ReadCloser, err error) {
+	var (
+		count int64
+	)
+
+	count, err = s.DB.Count(&attachmentContent{}, "attachment_id = ?", id)
+	if err != nil {
+		return nil, err
+	}
+	if count == 0 {
+		return nil, persistence.ErrRecordNotFound
+	}
+
+	return &attachmentContentReader{db: s.DB, id: id}, nil
+}
+
+// Delete implements [AttachmentStore].
+func (s DBAttachmentStore) Delete(_ context.Context, id string) (err error) {
+	return s.DB.Delete(&attachmentContent{}, "attachment_id = ?", id)
+}
+
+// attachmentContentReader reads the content of an attachment of the [DBAttachmentStore] chunk by chunk.
+type attachmentContentReader struct {
+	db    persistence.DB
+	id    string
+	chunk int
+	data  []byte
+}
+
+// Read implements [io.Reader]. It retrieves the next chunk, once the current one is consumed.
+func (r *attachmentContentReader) Read(p []byte) (n int, err error) {
+	var (
+		content attachmentContent
+	)
+
+	for len(r.data) == 0 {
+		err = r.db.Get(&content, persistence.WithoutPreload(), "attachment_id = ? AND chunk = ?", r.id, r.chunk)
+		if errors.Is(err, persistence.ErrRecordNotFound) {
+			return 0, io.EOF
+		}
+		if err != nil {
+			return 0, err
+		}
+
+		r.data = content.Data
+		r.chunk++
+	}
+
+	n = copy(p, r.data)
+	r.data = r.data[n:]
+	return n, nil
+}
+
+// Close implements [io.Closer].
+func (r *attachmentContentReader) Close() error {
+	return nil
+}
+
+// UploadAttachment uploads an attachment of an evaluation result. The first message of the stream contains the
+// metadata, all following messages contain chunks of the content, which are passed to the [AttachmentStore] while they
+// arrive. Uploads exceeding [Config.MaxAttachmentSize] are aborted.
+func (svc *Service) UploadAttachment(
+	ctx context.Context,
+	stream *connect.ClientStream[orchestrator.UploadAttachmentRequest],
+) (res *connect.Response[evaluation.Attachment], err error) {
+	var (
+		meta    *evaluation.Attachment
+		att     *evaluation.Attachment
+		pr      *io.PipeReader
+		pw      *io.PipeWriter
+		hash    = sha256.New()
+		size    int64
+		recvErr error
+		cerr    *connect.Error
+		done    = make(chan struct{})
+	)
+
+	// The first message must contain the metadata of the attachment
+	if !stream.Receive() {
+		if err = stream.Err(); err != nil {
+			return nil, err
+		}
+		return nil, connect.NewError(connect.CodeInvalidArgument, service.ErrEmptyRequest)
+	}
+
+	// Validate the request. The ID of the attachment is generated by us.
+	if err = service.Validate(connect.NewRequest(stream.Msg()), protovalidate.WithFilter(service.IgnoreIDFilter)); err != nil {
+		return nil, err
+	}
+
+	meta = stream.Msg().GetMetadata()
+	if meta == nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("first message must contain the attachment metadata"))
+	}
+
+	// Check access via the configured auth strategy
+	if err = svc.checkEvaluationResultAccess(ctx, orchestrator.RequestType_REQUEST_TYPE_UPDATED, meta.GetEvaluationResultId()); err != nil {
+		return nil, err
+	}
+
+	att = &evaluation.Attachment{
+		Id:                 uuid.NewString(),
+		EvaluationResultId: meta.GetEvaluationResultId(),
+		Name:               meta.GetName(),
+		ContentType:        meta.GetContentType(),
+		CreatedAt:          timestamppb.Now(),
+	}
+
+	// Receive the content in the background and pass it to the attachment store while it arrives
+	pr, pw = io.Pipe()
+	go func() {
+		defer close(done)
+		recvErr = svc.receiveAttachmentContent(stream, io.MultiWriter(pw, hash), &size)
+		pw.CloseWithError(recvErr)
+	}()
+
+	err = svc.cfg.AttachmentStore.Put(ctx, att, pr)
+
+	// Unblock the receiver in case the store did not consume all of the content
+	pr.CloseWithError(err)
+	<-done
+
+	// Errors of the client (e.g., exceeding the size limit) take precedence, since they also abort the store
+	if errors.As(recvErr, &cerr) {
+		return nil, recvErr
+	}
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("could not store attachment content: %w", err))
+	}
+
+	att.Size = size
+	att.Sha256 = hex.EncodeToString(hash.Sum(nil))
+
+	err = svc.db.Create(att)
+	if err = service.HandleDatabaseError(err); err != nil {
+		// Do not leave orphaned content behind
+		if derr := svc.cfg.AttachmentStore.Delete(context.WithoutCancel(ctx), att.Id); derr != nil {
+			slog.Warn("Could not remove content of attachment", slog.String("attachment_id", att.Id), log.Err(derr))
+		}
+
+		return nil, err
+	}
+
+	svc.meterAttachment(att)
+
+	res = connect.NewResponse(att)
+	return
+}
+
+// receiveAttachmentContent writes the chunks received on the stream to w until the client closes the stream. The total
+// number of bytes received is tracked in size.
+func (svc *Service) receiveAttachmentContent(
+	stream *connect.ClientStream[orchestrator.UploadAttachmentRequest],
+	w io.Writer,
+	size *int64,
+) (err error) {
+	var (
+		msg *orchestrator.UploadAttachmentRequest
+	)
+
+	for stream.Receive() {
+		msg = stream.Msg()
+		if msg.GetMetadata() != nil {
+			return connect.NewError(connect.CodeInvalidArgument, errors.New("metadata must only be sent in the first message"))
+		}
+
+		*size += int64(len(msg.GetChunk()))
+		if *size > svc.cfg.MaxAttachmentSize {
+			return connect.NewError(connect.CodeResourceExhausted,
+				fmt.Errorf("attachment exceeds the maximum size of %d bytes", svc.cfg.MaxAttachmentSize))
+		}
+
+		if _, err = w.Write(msg.GetChunk()); err != nil {
+			return fmt.Errorf("could not write attachment content: %w", err)
+		}
+	}
+
+	return stream.Err()
+}
+
+// DownloadAttachment downloads an attachment of an evaluation result. The first message contains the metadata of the
+// attachment, all following messages contain chunks of its content.
+func (svc *Service) DownloadAttachment(
+	ctx context.Context,
+	req *connect.Request[orchestrator.DownloadAttachmentRequest],
+	stream *connect.ServerStream[orchestrator.DownloadAttachmentResponse],
+) (err error) {
+	var (
+		att evaluation.Attachment
+		rc  io.ReadCloser
+		buf []byte
+		n   int
+	)
+
+	// Validate the request
+	if err = service.Validate(req); err != nil {
+		return err
+	}
+
+	err = svc.db.Get(&att, "id = ?", req.Msg.GetAttachmentId())
+	if err = service.HandleDatabaseError(err, service.ErrNotFound("attachment")); err != nil {
+		return err
+	}
+
+	// Check access via the configured auth strategy
+	if err = svc.checkEvaluationResultAccess(ctx, orchestrator.RequestType_REQUEST_TYPE_GET, att.GetEvaluationResultId()); err != nil {
+		return err
+	}
+
+	rc, err = svc.cfg.AttachmentStore.Get(ctx, att.GetId())
+	if err != nil {
+		return connect.NewError(connect.CodeInternal, fmt.Errorf("could not retrieve attachment content: %w", err))
+	}
+	defer rc.Close()
+
+	err = stream.Send(&orchestrator.DownloadAttachmentResponse{
+		Payload: &orchestrator.DownloadAttachmentResponse_Metadata{Metadata: &att},
+	})
+	if err != nil {
+		return err
+	}
+
+	buf = make([]byte, attachmentChunkSize)
+	for {
+		n, err = rc.Read(buf)
+		if n > 0 {
+			// Send serializes the message before returning, so we can re-use the buffer
+			if serr := stream.Send(&orchestrator.DownloadAttachmentResponse{
+				Payload: &orchestrator.DownloadAttachmentResponse_Chunk{Chunk: buf[:n]},
+			}); serr != nil {
+				return serr
+			}
+		}
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return connect.NewError(connect.CodeInternal, fmt.Errorf("could not read attachment content: %w", err))
+		}
+	}
+}
+
+// ListAttachments lists the attachments of an evaluation result.
+func (svc *Service) ListAttachments(
+	ctx context.Context,
+	req *connect.Request[orchestrator.ListAttachmentsRequest],
+) (res *connect.Response[orchestrator.ListAttachmentsResponse], err error) {
+	var (
+		atts []*evaluation.Attachment
+		npt  string
+	)
+
+	// Validate the request
+	if err = service.Validate(req); err != nil {
+		return nil, err
+	}
+
+	// Check access via the configured auth strategy
+	if err = svc.checkEvaluationResultAccess(ctx, orchestrator.RequestType_REQUEST_TYPE_GET, req.Msg.GetEvaluationResultId()); err != nil {
+		return nil, err
+	}
+
+	// Set default ordering
+	if req.Msg.OrderBy == "" {
+		req.Msg.OrderBy = "id"
+		req.Msg.Asc = true
+	}
+
+	atts, npt, err = service.PaginateStorage[*evaluation.Attachment](req.Msg, svc.db, service.DefaultPaginationOpts,
+		"evaluation_result_id = ?", req.Msg.GetEvaluationResultId())
+	if err = service.HandleDatabaseError(err); err != nil {
+		return nil, err
+	}
+
+	res = connect.NewResponse(&orchestrator.ListAttachmentsResponse{
+		Attachments:   atts,
+		NextPageToken: npt,
+	})
+	return
+}
+
+// RemoveAttachment removes an attachment including its content.
+func (svc *Service) RemoveAttachment(
+	ctx context.Context,
+	req *connect.Request[orchestrator.RemoveAttachmentRequest],
+) (res *connect.Response[emptypb.Empty], err error) {
+	var (
+		att evaluation.Attachment
+	)
+
+	// Validate the request
+	if err = service.Validate(req); err != nil {
+		return nil, err
+	}
+
+	err = svc.db.Get(&att, "id = ?", req.Msg.GetAttachmentId())
+	if err = service.HandleDatabaseError(err, service.ErrNotFound("attachment")); err != nil {
+		return nil, err
+	}
+
+	// Check access via the configured auth strategy
+	if err = svc.checkEvaluationResultAccess(ctx, orchestrator.RequestType_REQUEST_TYPE_UPDATED, att.GetEvaluationResultId()); err != nil {
+		return nil, err
+	}
+
+	err = svc.cfg.AttachmentStore.Delete(ctx, att.GetId())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("could not remove attachment content: %w", err))
+	}
+
+	err = svc.db.Delete(&att, "id = ?", att.GetId())
+	if err = service.HandleDatabaseError(err); err != nil {
+		return nil, err
+	}
+
+	res = connect.NewResponse(&emptypb.Empty{})
+	return
+}
+
+// checkEvaluationResultAccess checks whether the current user may perform the given request type on the audit scope of
+// the evaluation result with the given ID. It returns a [connect.Error] if the evaluation result does not exist or the
+// access is denied.
+func (svc *Service) checkEvaluationResultAccess(ctx context.Context, reqType orchestrator.RequestType, evalId string) (err error) {
+	var (
+		eval    evaluation.EvaluationResult
+		allowed bool
+	)
+
+	err = svc.db.Get(&eval, persistence.WithoutPreload(), "id = ?", evalId)
+	if err = service.HandleDatabaseError(err, service.ErrNotFound("evaluation result")); err != nil {
+		return err
+	}
+
+	allowed, _, err = CheckAccess(ctx, svc.authz, svc, reqType, eval.GetAuditScopeId(), orchestrator.ObjectType_OBJECT_TYPE_AUDIT_SCOPE)
+	if err != nil {
+		return connect.NewError(connect.CodeInternal, err)
+	}
+	if !allowed {
+		return service.ErrPermissionDenied
+	}
+
+	return nil
+}
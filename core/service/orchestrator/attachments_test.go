@@ -0,0 +1,461 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package orchestrator
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"testing"
+
+	"confirmate.io/core/api/evaluation"
+	"confirmate.io/core/api/orchestrator"
+	"confirmate.io/core/api/orchestrator/orchestratorconnect"
+	"confirmate.io/core/auth"
+	"confirmate.io/core/persistence"
+	"confirmate.io/core/persistence/persistencetest"
+	"confirmate.io/core/server"
+	"confirmate.io/core/server/servertest"
+	"confirmate.io/core/service"
+	"confirmate.io/core/service/evaluation/evaluationtest"
+	"confirmate.io/core/service/orchestrator/orchestratortest"
+	"confirmate.io/core/util/assert"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+const (
+	mockAttachmentId1 = "00000000-0000-0000-0003-000000000001"
+)
+
+// mockAttachmentRecords returns an evaluation result with a single attachment.
+func mockAttachmentRecords() []any {
+	return []any{
+		evaluationtest.MockEvaluationResult1,
+		&evaluation.Attachment{
+			Id:                 mockAttachmentId1,
+			EvaluationResultId: evaluationtest.MockEvaluationResultId1,
+			Name:               "policy.pdf",
+			ContentType:        "application/pdf",
+			Size:               4,
+		},
+		&attachmentContent{
+			AttachmentId:       mockAttachmentId1,
+			EvaluationResultId: evaluationtest.MockEvaluationResultId1,
+			Data:               []byte("test"),
+		},
+	}
+}
+
+// TestService_UploadAttachment tests the up- and download of attachments using the streaming RPCs.
+func TestService_UploadAttachment(t *testing.T) {
+	var (
+		content = bytes.Repeat([]byte("confirmate"), 10000)
+		sum     = sha256.Sum256(content)
+	)
+
+	tests := []struct {
+		name     string
+		authz    service.AuthorizationStrategy
+		messages []*orchestrator.UploadAttachmentRequest
+		want     assert.Want[*evaluation.Attachment]
+		wantErr  assert.WantErr
+	}{
+		{
+			name:  "happy path",
+			authz: &service.AuthorizationStrategyAllowAll{},
+			messages: []*orchestrator.UploadAttachmentRequest{
+				{Payload: &orchestrator.UploadAttachmentRequest_Metadata{Metadata: &evaluation.Attachment{
+					EvaluationResultId: evaluationtest.MockEvaluationResultId1,
+					Name:               "evidence.txt",
+					ContentType:        "text/plain",
+				}}},
+				{Payload: &orchestrator.UploadAttachmentRequest_Chunk{Chunk: content[:50000]}},
+				{Payload: &orchestrator.UploadAttachmentRequest_Chunk{Chunk: content[50000:]}},
+			},
+			want: func(t *testing.T, got *evaluation.Attachment, args ...any) bool {
+				return assert.NotEmpty(t, got.GetId()) &&
+					assert.Equal(t, "evidence.txt", got.GetName()) &&
+					assert.Equal(t, int64(len(content)), got.GetSize()) &&
+					assert.Equal(t, hex.EncodeToString(sum[:]), got.GetSha256())
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name:  "attachment too large",
+			authz: &service.AuthorizationStrategyAllowAll{},
+			messages: []*orchestrator.UploadAttachmentRequest{
+				{Payload: &orchestrator.UploadAttachmentRequest_Metadata{Metadata: &evaluation.Attachment{
+					EvaluationResultId: evaluationtest.MockEvaluationResultId1,
+					Name:               "evidence.txt",
+					ContentType:        "text/plain",
+				}}},
+				{Payload: &orchestrator.UploadAttachmentRequest_Chunk{Chunk: content}},
+				{Payload: &orchestrator.UploadAttachmentRequest_Chunk{Chunk: content}},
+			},
+			want: assert.Nil[*evaluation.Attachment],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeResourceExhausted)
+			},
+		},
+		{
+			name:  "first message without metadata",
+			authz: &service.AuthorizationStrategyAllowAll{},
+			messages: []*orchestrator.UploadAttachmentRequest{
+				{Payload: &orchestrator.UploadAttachmentRequest_Chunk{Chunk: content}},
+			},
+			want: assert.Nil[*evaluation.Attachment],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeInvalidArgument) &&
+					assert.ErrorContains(t, err, "attachment metadata")
+			},
+		},
+		{
+			name:  "evaluation result not found",
+			authz: &service.AuthorizationStrategyAllowAll{},
+			messages: []*orchestrator.UploadAttachmentRequest{
+				{Payload: &orchestrator.UploadAttachmentRequest_Metadata{Metadata: &evaluation.Attachment{
+					EvaluationResultId: evaluationtest.MockEvaluationResultId2,
+					Name:               "evidence.txt",
+					ContentType:        "text/plain",
+				}}},
+			},
+			want: assert.Nil[*evaluation.Attachment],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeNotFound)
+			},
+		},
+		{
+			name:  "permission denied",
+			authz: &denyAuthorizationStrategy{},
+			messages: []*orchestrator.UploadAttachmentRequest{
+				{Payload: &orchestrator.UploadAttachmentRequest_Metadata{Metadata: &evaluation.Attachment{
+					EvaluationResultId: evaluationtest.MockEvaluationResultId1,
+					Name:               "evidence.txt",
+					ContentType:        "text/plain",
+				}}},
+			},
+			want: assert.Nil[*evaluation.Attachment],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodePermissionDenied)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := persistencetest.NewInMemoryDB(t, types, joinTables, createRecords(t, mockAttachmentRecords()...))
+			svc := &Service{
+				db:    db,
+				authz: tt.authz,
+				cfg: Config{
+					AttachmentStore:   DBAttachmentStore{DB: db},
+					MaxAttachmentSize: int64(len(content)),
+				},
+			}
+
+			_, testSrv := servertest.NewTestConnectServer(t,
+				server.WithHandler(orchestratorconnect.NewOrchestratorHandler(svc)),
+			)
+			defer testSrv.Close()
+
+			client := orchestratorconnect.NewOrchestratorClient(testSrv.Client(), testSrv.URL)
+			stream := client.UploadAttachment(context.Background())
+			for _, msg := range tt.messages {
+				// The server may abort the stream early, which is reported by CloseAndReceive
+				if err := stream.Send(msg); err != nil {
+					break
+				}
+			}
+
+			res, gotErr := stream.CloseAndReceive()
+			tt.wantErr(t, gotErr)
+			if gotErr != nil {
+				tt.want(t, nil)
+				return
+			}
+			tt.want(t, res.Msg)
+
+			// The uploaded content must be downloadable again
+			down, err := client.DownloadAttachment(context.Background(), connect.NewRequest(&orchestrator.DownloadAttachmentRequest{
+				AttachmentId: res.Msg.GetId(),
+			}))
+			assert.NoError(t, err)
+
+			var (
+				meta *evaluation.Attachment
+				got  []byte
+			)
+			for down.Receive() {
+				if down.Msg().GetMetadata() != nil {
+					meta = down.Msg().GetMetadata()
+				}
+				got = append(got, down.Msg().GetChunk()...)
+			}
+			assert.NoError(t, down.Err())
+			assert.Equal(t, res.Msg.GetId(), meta.GetId())
+			assert.Equal(t, content, got)
+		})
+	}
+}
+
+func TestService_ListAttachments(t *testing.T) {
+	type fields struct {
+		db    persistence.DB
+		authz service.AuthorizationStrategy
+	}
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		req     *orchestrator.ListAttachmentsRequest
+		fields  fields
+		want    assert.Want[*connect.Response[orchestrator.ListAttachmentsResponse]]
+		wantErr assert.WantErr
+	}{
+		{
+			name: "happy path",
+			req: &orchestrator.ListAttachmentsRequest{
+				EvaluationResultId: evaluationtest.MockEvaluationResultId1,
+			},
+			fields: fields{
+				db:    persistencetest.NewInMemoryDB(t, types, joinTables, createRecords(t, mockAttachmentRecords()...)),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			want: func(t *testing.T, got *connect.Response[orchestrator.ListAttachmentsResponse], args ...any) bool {
+				return assert.Equal(t, 1, len(got.Msg.Attachments)) &&
+					assert.Equal(t, mockAttachmentId1, got.Msg.Attachments[0].GetId())
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "validation error",
+			req:  &orchestrator.ListAttachmentsRequest{},
+			fields: fields{
+				db:    persistencetest.NewInMemoryDB(t, types, joinTables, createRecords(t, mockAttachmentRecords()...)),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			want: assert.Nil[*connect.Response[orchestrator.ListAttachmentsResponse]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeInvalidArgument)
+			},
+		},
+		{
+			name: "permission denied",
+			req: &orchestrator.ListAttachmentsRequest{
+				EvaluationResultId: evaluationtest.MockEvaluationResultId1,
+			},
+			fields: fields{
+				db:    persistencetest.NewInMemoryDB(t, types, joinTables, createRecords(t, mockAttachmentRecords()...)),
+				authz: &denyAuthorizationStrategy{},
+			},
+			want: assert.Nil[*connect.Response[orchestrator.ListAttachmentsResponse]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodePermissionDenied)
+			},
+		},
+		{
+			name: "permission denied: token restricted to other target of evaluation",
+			ctx: auth.WithClaims(context.Background(), &auth.OAuthClaims{
+				IsAdminToken:          true,
+				TargetOfEvaluationIds: []string{orchestratortest.MockToeId2},
+			}),
+			req: &orchestrator.ListAttachmentsRequest{
+				EvaluationResultId: evaluationtest.MockEvaluationResultId1,
+			},
+			fields: fields{
+				db: persistencetest.NewInMemoryDB(t, types, joinTables, func(d persistence.DB) {
+					assert.NoError(t, d.Create(orchestratortest.MockAuditScope1))
+					assert.NoError(t, d.Create(evaluationtest.MockEvaluationResult1))
+				}),
+				authz: &service.AuthorizationStrategyPermissionStore{},
+			},
+			want: assert.Nil[*connect.Response[orchestrator.ListAttachmentsResponse]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodePermissionDenied)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &Service{
+				db:    tt.fields.db,
+				authz: tt.fields.authz,
+			}
+			ctx := tt.ctx
+			if ctx == nil {
+				ctx = context.Background()
+			}
+			got, gotErr := svc.ListAttachments(ctx, connect.NewRequest(tt.req))
+
+			tt.want(t, got)
+			tt.wantErr(t, gotErr)
+		})
+	}
+}
+
+func TestService_RemoveAttachment(t *testing.T) {
+	type fields struct {
+		db    persistence.DB
+		authz service.AuthorizationStrategy
+	}
+	tests := []struct {
+		name    string
+		req     *orchestrator.RemoveAttachmentRequest
+		fields  fields
+		want    assert.Want[*connect.Response[emptypb.Empty]]
+		wantErr assert.WantErr
+	}{
+		{
+			name: "happy path",
+			req: &orchestrator.RemoveAttachmentRequest{
+				AttachmentId: mockAttachmentId1,
+			},
+			fields: fields{
+				db:    persistencetest.NewInMemoryDB(t, types, joinTables, createRecords(t, mockAttachmentRecords()...)),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			want: func(t *testing.T, got *connect.Response[emptypb.Empty], args ...any) bool {
+				db := args[0].(persistence.DB)
+
+				// Metadata and content must be removed
+				n, err := db.Count(&evaluation.Attachment{}, "id = ?", mockAttachmentId1)
+				assert.NoError(t, err)
+				m, err := db.Count(&attachmentContent{}, "attachment_id = ?", mockAttachmentId1)
+				assert.NoError(t, err)
+
+				return assert.NotNil(t, got) &&
+					assert.Equal(t, int64(0), n) &&
+					assert.Equal(t, int64(0), m)
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "attachment not found",
+			req: &orchestrator.RemoveAttachmentRequest{
+				AttachmentId: "00000000-0000-0000-0003-000000000099",
+			},
+			fields: fields{
+				db:    persistencetest.NewInMemoryDB(t, types, joinTables, createRecords(t, mockAttachmentRecords()...)),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			want: assert.Nil[*connect.Response[emptypb.Empty]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeNotFound)
+			},
+		},
+		{
+			name: "permission denied",
+			req: &orchestrator.RemoveAttachmentRequest{
+				AttachmentId: mockAttachmentId1,
+			},
+			fields: fields{
+				db:    persistencetest.NewInMemoryDB(t, types, joinTables, createRecords(t, mockAttachmentRecords()...)),
+				authz: &denyAuthorizationStrategy{},
+			},
+			want: assert.Nil[*connect.Response[emptypb.Empty]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodePermissionDenied)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &Service{
+				db:    tt.fields.db,
+				authz: tt.fields.authz,
+				cfg: Config{
+					AttachmentStore: DBAttachmentStore{DB: tt.fields.db},
+				},
+			}
+			got, gotErr := svc.RemoveAttachment(context.Background(), connect.NewRequest(tt.req))
+
+			tt.want(t, got, tt.fields.db)
+			tt.wantErr(t, gotErr)
+		})
+	}
+}
+
+func TestDBAttachmentStore(t *testing.T) {
+	var (
+		db  persistence.DB
+		s   DBAttachmentStore
+		att *evaluation.Attachment
+		rc  io.ReadCloser
+		got []byte
+		err error
+	)
+
+	db = persistencetest.NewInMemoryDB(t, types, joinTables, func(d persistence.DB) {
+		assert.NoError(t, d.Create(evaluationtest.MockEvaluationResult1))
+	})
+	s = DBAttachmentStore{DB: db}
+	att = &evaluation.Attachment{
+		Id:                 mockAttachmentId1,
+		EvaluationResultId: evaluationtest.MockEvaluationResultId1,
+		Name:               "policy.pdf",
+	}
+
+	err = s.Put(context.Background(), att, bytes.NewReader([]byte("content")))
+	assert.NoError(t, err)
+
+	rc, err = s.Get(context.Background(), mockAttachmentId1)
+	assert.NoError(t, err)
+	got, err = io.ReadAll(rc)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("content"), got)
+
+	err = s.Delete(context.Background(), mockAttachmentId1)
+	assert.NoError(t, err)
+
+	_, err = s.Get(context.Background(), mockAttachmentId1)
+	assert.ErrorIs(t, err, persistence.ErrRecordNotFound)
+
+	// The content is stored and retrieved in chunks
+	for size, chunks := range map[int]int64{0: 1, attachmentChunkSize: 1, 2*attachmentChunkSize + 10: 3} {
+		var (
+			content = bytes.Repeat([]byte("x"), size)
+			count   int64
+		)
+
+		err = s.Put(context.Background(), att, bytes.NewReader(content))
+		assert.NoError(t, err)
+
+		count, err = db.Count(&attachmentContent{}, "attachment_id = ?", mockAttachmentId1)
+		assert.NoError(t, err)
+		assert.Equal(t, chunks, count)
+
+		rc, err = s.Get(context.Background(), mockAttachmentId1)
+		assert.NoError(t, err)
+		got, err = io.ReadAll(rc)
+		assert.NoError(t, err)
+		assert.Equal(t, content, got)
+
+		assert.NoError(t, s.Delete(context.Background(), mockAttachmentId1))
+	}
+
+	// The content is removed together with the attachment, if its evaluation result is removed
+	err = s.Put(context.Background(), att, bytes.NewReader([]byte("content")))
+	assert.NoError(t, err)
+	assert.NoError(t, db.Create(att))
+
+	err = db.Delete(&evaluation.EvaluationResult{}, "id = ?", evaluationtest.MockEvaluationResultId1)
+	assert.NoError(t, err)
+
+	_, err = s.Get(context.Background(), mockAttachmentId1)
+	assert.ErrorIs(t, err, persistence.ErrRecordNotFound)
+}
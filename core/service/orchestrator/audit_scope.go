@@ -16,7 +16,12 @@
 package orchestrator
 
 import (
+	"cmp"
 	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
 
 	"confirmate.io/core/api/orchestrator"
 	"confirmate.io/core/persistence"
@@ -49,8 +54,10 @@ func (svc *Service) CreateAuditScope(
 		Name:                 req.Msg.GetAuditScope().GetName(),
 		TargetOfEvaluationId: req.Msg.GetAuditScope().GetTargetOfEvaluationId(),
 		CatalogId:            req.Msg.GetAuditScope().GetCatalogId(),
+		AdditionalCatalogIds: req.Msg.GetAuditScope().GetAdditionalCatalogIds(),
 		AssuranceLevel:       req.Msg.GetAuditScope().AssuranceLevel,
 		Status:               req.Msg.GetAuditScope().GetStatus(),
+		Environment:          req.Msg.GetAuditScope().Environment,
 	}
 
 	// Check access via the configured auth strategy
@@ -58,10 +65,15 @@ func (svc *Service) CreateAuditScope(
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
-	if !allowed {
+	if !allowed || !service.AllowsTargetOfEvaluation(ctx, svc.authz, scope.TargetOfEvaluationId) {
 		return nil, service.ErrPermissionDenied
 	}
 
+	// Audit scopes must not bind to catalog drafts, since these can change at any time
+	if err = svc.checkCatalogsBindable(scope); err != nil {
+		return nil, err
+	}
+
 	// Persist the new audit scope in the database, grant creator admin access, and auto-create
 	// ControlInScope records for all controls in the catalog matching the assurance level.
 	err = svc.db.Transaction(func(tx persistence.DB) error {
@@ -138,10 +150,13 @@ func (svc *Service) ListAuditScopes(
 ) (res *connect.Response[orchestrator.ListAuditScopesResponse], err error) {
 	var (
 		scopes        []*orchestrator.AuditScope
+		query         []string
+		args          []any
 		conds         []any
 		npt           string
 		all           bool
 		auditScopeIds []string
+		toeIds        []string
 	)
 
 	// Validate the request
@@ -158,11 +173,19 @@ func (svc *Service) ListAuditScopes(
 	// Use filter from request to build query conditions
 	// Filter by target_of_evaluation_id if provided
 	if req.Msg.Filter != nil && req.Msg.Filter.TargetOfEvaluationId != nil {
-		conds = append(conds, "target_of_evaluation_id = ?", *req.Msg.Filter.TargetOfEvaluationId)
+		query = append(query, "target_of_evaluation_id = ?")
+		args = append(args, req.Msg.Filter.GetTargetOfEvaluationId())
 	}
-	// Filter by catalog_id if provided
+	// Filter by catalog_id if provided. This includes audit scopes that evaluate the catalog as an additional catalog.
+	// Since the additional catalogs are stored as a JSON array, we look for the quoted ID within it.
 	if req.Msg.Filter != nil && req.Msg.Filter.CatalogId != nil {
-		conds = append(conds, "catalog_id = ?", *req.Msg.Filter.CatalogId)
+		query = append(query, "(catalog_id = ? OR additional_catalog_ids LIKE ?)")
+		args = append(args, req.Msg.Filter.GetCatalogId(), fmt.Sprintf("%%%q%%", req.Msg.Filter.GetCatalogId()))
+	}
+	// Filter by environment if provided
+	if req.Msg.Filter != nil && req.Msg.Filter.Environment != nil {
+		query = append(query, "environment = ?")
+		args = append(args, req.Msg.Filter.GetEnvironment())
 	}
 
 	// Retrieve list of all allowed Audit Scope IDs for the user to filter results by access permissions.
@@ -177,12 +200,24 @@ func (svc *Service) ListAuditScopes(
 
 	// If access is not allowed to all objects, add a condition to filter by the allowed object IDs
 	if !all {
-		conds = append(conds, "id IN ?", auditScopeIds)
+		query = append(query, "id IN ?")
+		args = append(args, auditScopeIds)
+	}
+
+	// If the token is restricted to particular targets of evaluation, only their audit scopes are listed
+	if all, toeIds = service.ClaimedTargetOfEvaluations(ctx, svc.authz); !all {
+		query = append(query, "target_of_evaluation_id IN ?")
+		args = append(args, toeIds)
+	}
+
+	conds = []any{persistence.WithoutPreload()}
+	if len(query) > 0 {
+		conds = append(conds, strings.Join(query, " AND "))
+		conds = append(conds, args...)
 	}
 
 	// Query the database with pagination and the constructed conditions
-	scopes, npt, err = service.PaginateStorage[*orchestrator.AuditScope](req.Msg, svc.db, service.DefaultPaginationOpts,
-		append([]any{persistence.WithoutPreload()}, conds...)...)
+	scopes, npt, err = service.PaginateStorage[*orchestrator.AuditScope](req.Msg, svc.db, service.DefaultPaginationOpts, conds...)
 	if err = service.HandleDatabaseError(err); err != nil {
 		return nil, err
 	}
@@ -214,8 +249,10 @@ func (svc *Service) UpdateAuditScope(
 		Name:                 req.Msg.GetAuditScope().GetName(),
 		TargetOfEvaluationId: req.Msg.GetAuditScope().GetTargetOfEvaluationId(),
 		CatalogId:            req.Msg.GetAuditScope().GetCatalogId(),
+		AdditionalCatalogIds: req.Msg.GetAuditScope().GetAdditionalCatalogIds(),
 		AssuranceLevel:       req.Msg.GetAuditScope().AssuranceLevel,
 		Status:               req.Msg.GetAuditScope().GetStatus(),
+		Environment:          req.Msg.GetAuditScope().Environment,
 	}
 
 	// Check access via the configured auth strategy
@@ -223,10 +260,20 @@ func (svc *Service) UpdateAuditScope(
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
-	if !allowed {
+	if !allowed || !service.AllowsTargetOfEvaluation(ctx, svc.authz, scope.TargetOfEvaluationId) {
 		return nil, service.ErrPermissionDenied
 	}
 
+	// The token must also be allowed to access the target of evaluation the audit scope currently belongs to
+	if err = svc.checkAuditScopeTargetOfEvaluation(ctx, scope.GetId()); err != nil {
+		return nil, err
+	}
+
+	// Audit scopes must not bind to catalog drafts, since these can change at any time
+	if err = svc.checkCatalogsBindable(scope); err != nil {
+		return nil, err
+	}
+
 	// Update the audit scope
 	err = svc.db.Update(scope, "id = ?", scope.Id)
 	if err = service.HandleDatabaseError(err, service.ErrNotFound("audit scope")); err != nil {
@@ -295,18 +342,136 @@ func (svc *Service) RemoveAuditScope(
 	return
 }
 
-// autoCreateControlsInScope loads all controls for the catalog associated with scope and creates
+// checkAuditScopeTargetOfEvaluation checks whether the claims of the request allow access to the target of
+// evaluation of the audit scope with the given ID. The audit scope is only retrieved, if the claims are restricted to
+// particular targets of evaluation.
+func (svc *Service) checkAuditScopeTargetOfEvaluation(ctx context.Context, auditScopeId string) (err error) {
+	var (
+		scope  orchestrator.AuditScope
+		all    bool
+		toeIds []string
+	)
+
+	if all, toeIds = service.ClaimedTargetOfEvaluations(ctx, svc.authz); all {
+		return nil
+	}
+
+	err = svc.db.Get(&scope, persistence.WithoutPreload(), "id = ?", auditScopeId)
+	if err = service.HandleDatabaseError(err, service.ErrNotFound("audit scope")); err != nil {
+		return err
+	}
+
+	if !slices.Contains(toeIds, scope.TargetOfEvaluationId) {
+		return service.ErrPermissionDenied
+	}
+
+	return nil
+}
+
+// checkCatalogsBindable returns an error with [connect.CodeFailedPrecondition] if any of the catalogs of the audit
+// scope is a catalog draft. Only published catalog versions (or catalogs that pre-date catalog versioning) can be
+// bound by audit scopes.
+func (svc *Service) checkCatalogsBindable(scope *orchestrator.AuditScope) (err error) {
+	var drafts []string
+
+	err = svc.db.Pluck(&orchestrator.Catalog{}, "id", &drafts, "id IN ? AND status = ?",
+		scope.AllCatalogIds(), orchestrator.CatalogStatus_CATALOG_STATUS_DRAFT)
+	if err = service.HandleDatabaseError(err); err != nil {
+		return err
+	}
+	if len(drafts) > 0 {
+		return connect.NewError(connect.CodeFailedPrecondition,
+			fmt.Errorf("catalog drafts cannot be bound by audit scopes, publish them first: %s", strings.Join(drafts, ", ")))
+	}
+
+	return nil
+}
+
+// autoCreateAuditScopes creates an audit scope for the target of evaluation with each catalog that automatically
+// creates audit scopes (see [orchestrator.Catalog.AutoCreateAuditScopes]) and returns them. A draft is bound by its
+// latest published version, so that only one audit scope is created per catalog. Drafts that were never published are
+// skipped, since audit scopes must not bind to drafts.
+func autoCreateAuditScopes(ctx context.Context, tx persistence.DB, toe *orchestrator.TargetOfEvaluation) (scopes []*orchestrator.AuditScope, err error) {
+	var catalogs []*orchestrator.Catalog
+
+	err = tx.List(&catalogs, "id", true, 0, -1, persistence.WithoutPreload(),
+		"auto_create_audit_scopes = ? AND status IN ?", true, []orchestrator.CatalogStatus{
+			orchestrator.CatalogStatus_CATALOG_STATUS_UNSPECIFIED,
+			orchestrator.CatalogStatus_CATALOG_STATUS_DRAFT,
+		})
+	if err != nil {
+		return nil, service.HandleDatabaseError(err)
+	}
+
+	for _, catalog := range catalogs {
+		var catalogId = catalog.Id
+
+		if catalog.Status == orchestrator.CatalogStatus_CATALOG_STATUS_DRAFT {
+			if catalog.Version == 0 {
+				slog.Warn("Skipping automatic audit scope of catalog that was never published",
+					slog.String("catalog_id", catalog.Id),
+					slog.String("target_of_evaluation_id", toe.Id))
+				continue
+			}
+
+			catalogId = catalogVersionId(catalog.Id, catalog.Version)
+		}
+
+		scope := &orchestrator.AuditScope{
+			Id:                   uuid.NewString(),
+			Name:                 fmt.Sprintf("%s (%s)", toe.Name, cmp.Or(catalog.ShortName, catalog.Name)),
+			TargetOfEvaluationId: toe.Id,
+			CatalogId:            catalogId,
+			AssuranceLevel:       autoAuditScopeAssuranceLevel(catalog),
+			Status:               orchestrator.AuditScopeStatus_AUDIT_SCOPE_STATUS_SETUP,
+		}
+
+		if err = tx.Create(scope); err != nil {
+			return nil, service.HandleDatabaseError(err)
+		}
+
+		if err = grantCreatorAdminPermission(ctx, tx, scope.Id, orchestrator.ObjectType_OBJECT_TYPE_AUDIT_SCOPE); err != nil {
+			return nil, err
+		}
+
+		if err = autoCreateControlsInScope(ctx, tx, scope); err != nil {
+			return nil, err
+		}
+
+		scopes = append(scopes, scope)
+	}
+
+	return scopes, nil
+}
+
+// autoAuditScopeAssuranceLevel returns the assurance level of audit scopes that are automatically created for the
+// catalog. This is the configured level, if it is one of the levels of the catalog, and the lowest level otherwise.
+// Catalogs without assurance levels yield nil.
+func autoAuditScopeAssuranceLevel(catalog *orchestrator.Catalog) *string {
+	if catalog.AutoAuditScopeAssuranceLevel != nil &&
+		slices.Contains(catalog.AssuranceLevels, catalog.GetAutoAuditScopeAssuranceLevel()) {
+		return catalog.AutoAuditScopeAssuranceLevel
+	}
+
+	if len(catalog.AssuranceLevels) > 0 {
+		return &catalog.AssuranceLevels[0]
+	}
+
+	return nil
+}
+
+// autoCreateControlsInScope loads all controls for the catalogs associated with scope and creates
 // a ControlInScope record for each matching control. A control matches if the scope has no
 // assurance level, the control has no assurance level, or both levels match exactly.
 func autoCreateControlsInScope(ctx context.Context, tx persistence.DB, scope *orchestrator.AuditScope) error {
 	var controls []*orchestrator.Control
 
-	// Query all controls for the catalog, including sub-controls. Since
+	// Query all controls for the catalogs, including sub-controls. Since
 	// catalog_id is now set on every control during normalization, a simple
 	// filter suffices — no join through category_controls needed.
 	if err := tx.Raw(&controls,
-		`SELECT * FROM controls WHERE catalog_id = ? ORDER BY controls.short_name`,
-		scope.CatalogId); err != nil {
+		`SELECT * FROM controls WHERE catalog_id IN ? ORDER BY controls.short_name`,
+		scope.AllCatalogIds()); err != nil {
 		return service.HandleDatabaseError(err)
 	}
 
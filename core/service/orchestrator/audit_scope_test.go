@@ -93,6 +93,38 @@ func TestService_CreateAuditScope(t *testing.T) {
 				})
 			},
 		},
+		{
+			name: "catalog draft",
+			args: args{
+				req: &orchestrator.CreateAuditScopeRequest{
+					AuditScope: &orchestrator.AuditScope{
+						TargetOfEvaluationId: orchestratortest.MockAuditScope1.TargetOfEvaluationId,
+						CatalogId:            orchestratortest.MockAuditScope1.CatalogId,
+						Name:                 orchestratortest.MockScopeName1,
+						Status:               orchestrator.AuditScopeStatus_AUDIT_SCOPE_STATUS_SETUP,
+					},
+				},
+			},
+			fields: fields{
+				db: persistencetest.NewInMemoryDB(t, types, joinTables, func(d persistence.DB) {
+					assert.NoError(t, d.Create(&orchestrator.Catalog{
+						Id:     orchestratortest.MockAuditScope1.CatalogId,
+						Name:   orchestratortest.MockCatalogName1,
+						Status: orchestrator.CatalogStatus_CATALOG_STATUS_DRAFT,
+					}))
+				}),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			want: assert.Nil[*connect.Response[orchestrator.AuditScope]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeFailedPrecondition)
+			},
+			wantDB: func(t *testing.T, db persistence.DB, msgAndArgs ...any) bool {
+				count, err := db.Count(&orchestrator.AuditScope{})
+				assert.NoError(t, err)
+				return assert.Equal(t, int64(0), count)
+			},
+		},
 		{
 			name: "happy path: with authorization strategy with permission store and admin token",
 			args: args{
@@ -474,6 +506,29 @@ func TestService_GetAuditScope(t *testing.T) {
 			},
 			wantErr: assert.NoError,
 		},
+		{
+			name: "admin token restricted to other targets of evaluation",
+			args: args{
+				req: &orchestrator.GetAuditScopeRequest{
+					AuditScopeId: orchestratortest.MockAuditScope1.Id,
+				},
+				context: auth.WithClaims(context.Background(), &auth.OAuthClaims{
+					IsAdminToken:          true,
+					TargetOfEvaluationIds: []string{orchestratortest.MockToeId2},
+				}),
+			},
+			fields: fields{
+				db: persistencetest.NewInMemoryDB(t, types, joinTables, func(d persistence.DB) {
+					err := d.Create(orchestratortest.MockAuditScope1)
+					assert.NoError(t, err)
+				}),
+				authz: &service.AuthorizationStrategyPermissionStore{},
+			},
+			want: assert.Nil[*connect.Response[orchestrator.AuditScope]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodePermissionDenied)
+			},
+		},
 		{
 			name: "validation error - empty request",
 			args: args{
@@ -616,6 +671,30 @@ func TestService_ListAuditScopes(t *testing.T) {
 			},
 			wantErr: assert.NoError,
 		},
+		{
+			name: "happy path: admin token restricted to a target of evaluation",
+			args: args{
+				req: &orchestrator.ListAuditScopesRequest{},
+				context: auth.WithClaims(context.Background(), &auth.OAuthClaims{
+					IsAdminToken:          true,
+					TargetOfEvaluationIds: []string{orchestratortest.MockToeId2},
+				}),
+			},
+			fields: fields{
+				db: persistencetest.NewInMemoryDB(t, types, joinTables, func(d persistence.DB) {
+					err := d.Create(orchestratortest.MockAuditScope1)
+					assert.NoError(t, err)
+					err = d.Create(orchestratortest.MockAuditScope2)
+					assert.NoError(t, err)
+				}),
+				authz: &service.AuthorizationStrategyPermissionStore{},
+			},
+			want: func(t *testing.T, got *connect.Response[orchestrator.ListAuditScopesResponse], _ ...any) bool {
+				return assert.Equal(t, 1, len(got.Msg.AuditScopes)) &&
+					assert.Equal(t, orchestratortest.MockAuditScope2.Id, got.Msg.AuditScopes[0].Id)
+			},
+			wantErr: assert.NoError,
+		},
 		{
 			name: "happy path: with allow-all authorization strategy",
 			args: args{
@@ -751,6 +830,69 @@ func TestService_ListAuditScopes(t *testing.T) {
 			},
 			wantErr: assert.NoError,
 		},
+		{
+			name: "filter by target of evaluation and additional catalog",
+			args: args{
+				req: &orchestrator.ListAuditScopesRequest{
+					Filter: &orchestrator.ListAuditScopesRequest_Filter{
+						TargetOfEvaluationId: &orchestratortest.MockAuditScope2.TargetOfEvaluationId,
+						CatalogId:            &orchestratortest.MockAuditScope1.CatalogId,
+					},
+				},
+			},
+			fields: fields{
+				db: persistencetest.NewInMemoryDB(t, types, joinTables, func(d persistence.DB) {
+					err := d.Create(orchestratortest.MockAuditScope1)
+					assert.NoError(t, err)
+					err = d.Create(&orchestrator.AuditScope{
+						Id:                   orchestratortest.MockScopeId2,
+						Name:                 orchestratortest.MockScopeName2,
+						TargetOfEvaluationId: orchestratortest.MockToeId2,
+						CatalogId:            orchestratortest.MockCatalogId2,
+						AdditionalCatalogIds: []string{orchestratortest.MockCatalogId1},
+					})
+					assert.NoError(t, err)
+				}),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			want: func(t *testing.T, got *connect.Response[orchestrator.ListAuditScopesResponse], args ...any) bool {
+				return assert.NotNil(t, got.Msg) &&
+					assert.Equal(t, 1, len(got.Msg.AuditScopes)) &&
+					assert.Equal(t, orchestratortest.MockScopeId2, got.Msg.AuditScopes[0].Id)
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "filter by environment",
+			args: args{
+				req: &orchestrator.ListAuditScopesRequest{
+					Filter: &orchestrator.ListAuditScopesRequest_Filter{
+						Environment: new(orchestratortest.MockEnvironment1),
+					},
+				},
+			},
+			fields: fields{
+				db: persistencetest.NewInMemoryDB(t, types, joinTables, func(d persistence.DB) {
+					err := d.Create(orchestratortest.MockAuditScope1)
+					assert.NoError(t, err)
+					err = d.Create(&orchestrator.AuditScope{
+						Id:                   orchestratortest.MockScopeId2,
+						Name:                 orchestratortest.MockScopeName2,
+						TargetOfEvaluationId: orchestratortest.MockToeId1,
+						CatalogId:            orchestratortest.MockCatalogId1,
+						Environment:          new(orchestratortest.MockEnvironment1),
+					})
+					assert.NoError(t, err)
+				}),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			want: func(t *testing.T, got *connect.Response[orchestrator.ListAuditScopesResponse], args ...any) bool {
+				return assert.NotNil(t, got.Msg) &&
+					assert.Equal(t, 1, len(got.Msg.AuditScopes)) &&
+					assert.Equal(t, orchestratortest.MockScopeId2, got.Msg.AuditScopes[0].Id)
+			},
+			wantErr: assert.NoError,
+		},
 	}
 
 	for _, tt := range tests {
@@ -1320,3 +1462,65 @@ func TestCreateAuditScope_AutoCreatesControlsInScope(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, int64(2), count)
 }
+
+func Test_autoAuditScopeAssuranceLevel(t *testing.T) {
+	type args struct {
+		catalog *orchestrator.Catalog
+	}
+	tests := []struct {
+		name string
+		args args
+		want assert.Want[*string]
+	}{
+		{
+			name: "configured level",
+			args: args{
+				catalog: &orchestrator.Catalog{
+					AssuranceLevels:              []string{"basic", "substantial", "high"},
+					AutoAuditScopeAssuranceLevel: new("substantial"),
+				},
+			},
+			want: func(t *testing.T, got *string, msgAndArgs ...any) bool {
+				return assert.Equal(t, "substantial", *got)
+			},
+		},
+		{
+			name: "unknown level falls back to the lowest level",
+			args: args{
+				catalog: &orchestrator.Catalog{
+					AssuranceLevels:              []string{"basic", "substantial", "high"},
+					AutoAuditScopeAssuranceLevel: new("medium"),
+				},
+			},
+			want: func(t *testing.T, got *string, msgAndArgs ...any) bool {
+				return assert.Equal(t, "basic", *got)
+			},
+		},
+		{
+			name: "no configured level",
+			args: args{
+				catalog: &orchestrator.Catalog{
+					AssuranceLevels: []string{"basic", "substantial", "high"},
+				},
+			},
+			want: func(t *testing.T, got *string, msgAndArgs ...any) bool {
+				return assert.Equal(t, "basic", *got)
+			},
+		},
+		{
+			name: "catalog without assurance levels",
+			args: args{
+				catalog: &orchestrator.Catalog{
+					AutoAuditScopeAssuranceLevel: new("high"),
+				},
+			},
+			want: assert.Nil[*string],
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := autoAuditScopeAssuranceLevel(tt.args.catalog)
+			tt.want(t, got)
+		})
+	}
+}
@@ -0,0 +1,238 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package orchestrator
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"confirmate.io/core/api/orchestrator"
+	"confirmate.io/core/service"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ListCatalogChangelogs lists the changelogs of a catalog, most recent first. Changelogs are generated whenever the
+// draft of a catalog is re-imported from its catalog source or published, see [newCatalogChangelog].
+func (svc *Service) ListCatalogChangelogs(
+	ctx context.Context,
+	req *connect.Request[orchestrator.ListCatalogChangelogsRequest],
+) (res *connect.Response[orchestrator.ListCatalogChangelogsResponse], err error) {
+	var (
+		changelogs []*orchestrator.CatalogChangelog
+		npt        string
+	)
+
+	// Validate the request
+	if err = service.Validate(req); err != nil {
+		return nil, err
+	}
+
+	// Set default ordering
+	if req.Msg.OrderBy == "" {
+		req.Msg.OrderBy = "created_at"
+		req.Msg.Asc = false
+	}
+
+	changelogs, npt, err = service.PaginateStorage[*orchestrator.CatalogChangelog](req.Msg, svc.db,
+		service.DefaultPaginationOpts, "catalog_id = ?", req.Msg.CatalogId)
+	if err = service.HandleDatabaseError(err); err != nil {
+		return nil, err
+	}
+
+	res = connect.NewResponse(&orchestrator.ListCatalogChangelogsResponse{
+		Changelogs:    changelogs,
+		NextPageToken: npt,
+	})
+	return
+}
+
+// GetCatalogChangelog retrieves a changelog of a catalog.
+func (svc *Service) GetCatalogChangelog(
+	ctx context.Context,
+	req *connect.Request[orchestrator.GetCatalogChangelogRequest],
+) (res *connect.Response[orchestrator.CatalogChangelog], err error) {
+	var changelog orchestrator.CatalogChangelog
+
+	// Validate the request
+	if err = service.Validate(req); err != nil {
+		return nil, err
+	}
+
+	err = svc.db.Get(&changelog, "id = ? AND catalog_id = ?", req.Msg.ChangelogId, req.Msg.CatalogId)
+	if err = service.HandleDatabaseError(err, service.ErrNotFound("catalog changelog")); err != nil {
+		return nil, err
+	}
+
+	res = connect.NewResponse(&changelog)
+	return
+}
+
+// newCatalogChangelog returns the changelog of the catalog with the given ID between two of its states. Controls that
+// only exist in after are added, controls that only exist in before are removed and controls that exist in both are
+// changed, if their fields (see [catalogControl.changedFields]) or their metrics differ. If before is nil, all
+// controls of after are added.
+func newCatalogChangelog(
+	catalogId string,
+	trigger orchestrator.CatalogChangelogTrigger,
+	before *orchestrator.Catalog,
+	after *orchestrator.Catalog,
+) (changelog *orchestrator.CatalogChangelog) {
+	var (
+		previous = flattenCatalogControls(before)
+		current  = flattenCatalogControls(after)
+	)
+
+	changelog = &orchestrator.CatalogChangelog{
+		Id:        uuid.NewString(),
+		CatalogId: catalogId,
+		Trigger:   trigger,
+		CreatedAt: timestamppb.Now(),
+	}
+
+	for shortName, c := range current {
+		o, ok := previous[shortName]
+		if !ok {
+			changelog.Changes = append(changelog.Changes, &orchestrator.ControlChange{
+				ControlShortName: shortName,
+				ControlName:      c.control.GetName(),
+				Type:             orchestrator.ControlChangeType_CONTROL_CHANGE_TYPE_ADDED,
+				AddedMetrics:     controlMetricIds(c.control),
+			})
+			continue
+		}
+
+		change := &orchestrator.ControlChange{
+			ControlShortName: shortName,
+			ControlName:      c.control.GetName(),
+			Type:             orchestrator.ControlChangeType_CONTROL_CHANGE_TYPE_CHANGED,
+			ChangedFields:    c.changedFields(o),
+		}
+		for _, metricId := range controlMetricIds(c.control) {
+			if !slices.Contains(controlMetricIds(o.control), metricId) {
+				change.AddedMetrics = append(change.AddedMetrics, metricId)
+			}
+		}
+		for _, metricId := range controlMetricIds(o.control) {
+			if !slices.Contains(controlMetricIds(c.control), metricId) {
+				change.RemovedMetrics = append(change.RemovedMetrics, metricId)
+			}
+		}
+
+		if len(change.ChangedFields) > 0 || len(change.AddedMetrics) > 0 || len(change.RemovedMetrics) > 0 {
+			changelog.Changes = append(changelog.Changes, change)
+		}
+	}
+	for shortName, c := range previous {
+		if _, ok := current[shortName]; !ok {
+			changelog.Changes = append(changelog.Changes, &orchestrator.ControlChange{
+				ControlShortName: shortName,
+				ControlName:      c.control.GetName(),
+				Type:             orchestrator.ControlChangeType_CONTROL_CHANGE_TYPE_REMOVED,
+				RemovedMetrics:   controlMetricIds(c.control),
+			})
+		}
+	}
+
+	slices.SortFunc(changelog.Changes, func(a, b *orchestrator.ControlChange) int {
+		return strings.Compare(a.ControlShortName, b.ControlShortName)
+	})
+
+	return
+}
+
+// controlMetricIds returns the sorted IDs of the metrics mapped to the control.
+func controlMetricIds(control *orchestrator.Control) (ids []string) {
+	for _, metric := range control.GetMetrics() {
+		ids = append(ids, metric.GetId())
+	}
+	slices.Sort(ids)
+
+	return
+}
+
+// renderCatalogChangelog renders the changelog as Markdown and stores it in the changelog, so that it can be handed
+// to auditors as is.
+func renderCatalogChangelog(changelog *orchestrator.CatalogChangelog) {
+	var (
+		b       strings.Builder
+		grouped = make(map[orchestrator.ControlChangeType][]*orchestrator.ControlChange)
+	)
+
+	fmt.Fprintf(&b, "# Changelog of catalog %s\n\n", changelog.CatalogId)
+
+	switch changelog.Trigger {
+	case orchestrator.CatalogChangelogTrigger_CATALOG_CHANGELOG_TRIGGER_PUBLISHED:
+		fmt.Fprintf(&b, "Published as version `%s`", changelog.GetVersionId())
+		if changelog.PreviousVersionId != nil {
+			fmt.Fprintf(&b, ", compared to version `%s`", changelog.GetPreviousVersionId())
+		}
+		b.WriteString(".")
+	case orchestrator.CatalogChangelogTrigger_CATALOG_CHANGELOG_TRIGGER_REIMPORTED:
+		b.WriteString("Re-imported from its catalog source.")
+	}
+	fmt.Fprintf(&b, " Generated at %s.\n", changelog.GetCreatedAt().AsTime().Format(time.RFC3339))
+
+	if len(changelog.Changes) == 0 {
+		b.WriteString("\nNo controls changed.\n")
+		changelog.Markdown = b.String()
+		return
+	}
+
+	for _, change := range changelog.Changes {
+		grouped[change.Type] = append(grouped[change.Type], change)
+	}
+
+	for _, section := range []struct {
+		typ   orchestrator.ControlChangeType
+		title string
+	}{
+		{orchestrator.ControlChangeType_CONTROL_CHANGE_TYPE_ADDED, "Added controls"},
+		{orchestrator.ControlChangeType_CONTROL_CHANGE_TYPE_REMOVED, "Removed controls"},
+		{orchestrator.ControlChangeType_CONTROL_CHANGE_TYPE_CHANGED, "Changed controls"},
+	} {
+		if len(grouped[section.typ]) == 0 {
+			continue
+		}
+
+		fmt.Fprintf(&b, "\n## %s\n\n", section.title)
+		for _, change := range grouped[section.typ] {
+			fmt.Fprintf(&b, "- **%s** %s", change.ControlShortName, change.ControlName)
+
+			var details []string
+			if len(change.ChangedFields) > 0 {
+				details = append(details, "changed "+strings.Join(change.ChangedFields, ", "))
+			}
+			if len(change.AddedMetrics) > 0 {
+				details = append(details, "added metrics "+strings.Join(change.AddedMetrics, ", "))
+			}
+			if len(change.RemovedMetrics) > 0 {
+				details = append(details, "removed metrics "+strings.Join(change.RemovedMetrics, ", "))
+			}
+			if len(details) > 0 {
+				fmt.Fprintf(&b, ": %s", strings.Join(details, "; "))
+			}
+			b.WriteString("\n")
+		}
+	}
+
+	changelog.Markdown = b.String()
+}
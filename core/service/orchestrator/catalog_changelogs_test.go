@@ -0,0 +1,292 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package orchestrator
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"confirmate.io/core/api/assessment"
+	"confirmate.io/core/api/orchestrator"
+	"confirmate.io/core/persistence/persistencetest"
+	"confirmate.io/core/util/assert"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const mockChangelogId = "00000000-0000-0000-0000-0000000000c1"
+
+// mockChangelogCatalog returns a catalog with the given controls in a single category.
+func mockChangelogCatalog(controls ...*orchestrator.Control) *orchestrator.Catalog {
+	return &orchestrator.Catalog{
+		Id:         "eucs",
+		Categories: []*orchestrator.Category{{Name: "OPS", Controls: controls}},
+	}
+}
+
+func Test_newCatalogChangelog(t *testing.T) {
+	type args struct {
+		before *orchestrator.Catalog
+		after  *orchestrator.Catalog
+	}
+	tests := []struct {
+		name string
+		args args
+		want assert.Want[*orchestrator.CatalogChangelog]
+	}{
+		{
+			name: "no previous state",
+			args: args{
+				after: mockChangelogCatalog(&orchestrator.Control{
+					ShortName: "OPS-01",
+					Name:      "Capacity",
+					Metrics:   []*assessment.Metric{{Id: "m2"}, {Id: "m1"}},
+				}),
+			},
+			want: func(t *testing.T, got *orchestrator.CatalogChangelog, msgAndArgs ...any) bool {
+				return assert.Equal(t, []*orchestrator.ControlChange{
+					{
+						ControlShortName: "OPS-01",
+						ControlName:      "Capacity",
+						Type:             orchestrator.ControlChangeType_CONTROL_CHANGE_TYPE_ADDED,
+						AddedMetrics:     []string{"m1", "m2"},
+					},
+				}, got.Changes)
+			},
+		},
+		{
+			name: "controls and metric mapping changed",
+			args: args{
+				before: mockChangelogCatalog(
+					&orchestrator.Control{ShortName: "OPS-01", Name: "Capacity", Metrics: []*assessment.Metric{{Id: "m1"}}},
+					&orchestrator.Control{ShortName: "OPS-02", Name: "Monitoring", Metrics: []*assessment.Metric{{Id: "m1"}}},
+					&orchestrator.Control{ShortName: "OPS-03", Name: "Logging"},
+				),
+				after: mockChangelogCatalog(
+					&orchestrator.Control{ShortName: "OPS-01", Name: "Capacity planning", Metrics: []*assessment.Metric{{Id: "m1"}}},
+					&orchestrator.Control{ShortName: "OPS-02", Name: "Monitoring", Metrics: []*assessment.Metric{{Id: "m2"}}},
+					&orchestrator.Control{ShortName: "OPS-04", Name: "Backup"},
+				),
+			},
+			want: func(t *testing.T, got *orchestrator.CatalogChangelog, msgAndArgs ...any) bool {
+				return assert.Equal(t, []*orchestrator.ControlChange{
+					{
+						ControlShortName: "OPS-01",
+						ControlName:      "Capacity planning",
+						Type:             orchestrator.ControlChangeType_CONTROL_CHANGE_TYPE_CHANGED,
+						ChangedFields:    []string{"name"},
+					},
+					{
+						ControlShortName: "OPS-02",
+						ControlName:      "Monitoring",
+						Type:             orchestrator.ControlChangeType_CONTROL_CHANGE_TYPE_CHANGED,
+						AddedMetrics:     []string{"m2"},
+						RemovedMetrics:   []string{"m1"},
+					},
+					{
+						ControlShortName: "OPS-03",
+						ControlName:      "Logging",
+						Type:             orchestrator.ControlChangeType_CONTROL_CHANGE_TYPE_REMOVED,
+					},
+					{
+						ControlShortName: "OPS-04",
+						ControlName:      "Backup",
+						Type:             orchestrator.ControlChangeType_CONTROL_CHANGE_TYPE_ADDED,
+					},
+				}, got.Changes)
+			},
+		},
+		{
+			name: "unchanged",
+			args: args{
+				before: mockChangelogCatalog(&orchestrator.Control{ShortName: "OPS-01", Name: "Capacity"}),
+				after:  mockChangelogCatalog(&orchestrator.Control{ShortName: "OPS-01", Name: "Capacity"}),
+			},
+			want: func(t *testing.T, got *orchestrator.CatalogChangelog, msgAndArgs ...any) bool {
+				return assert.Empty(t, got.Changes) &&
+					assert.Equal(t, "eucs", got.CatalogId) &&
+					assert.NotEmpty(t, got.Id)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := newCatalogChangelog("eucs", orchestrator.CatalogChangelogTrigger_CATALOG_CHANGELOG_TRIGGER_PUBLISHED,
+				tt.args.before, tt.args.after)
+			tt.want(t, got)
+		})
+	}
+}
+
+func Test_renderCatalogChangelog(t *testing.T) {
+	tests := []struct {
+		name      string
+		changelog *orchestrator.CatalogChangelog
+		want      string
+	}{
+		{
+			name: "published",
+			changelog: &orchestrator.CatalogChangelog{
+				CatalogId:         "eucs",
+				Trigger:           orchestrator.CatalogChangelogTrigger_CATALOG_CHANGELOG_TRIGGER_PUBLISHED,
+				VersionId:         new("eucs@v2"),
+				PreviousVersionId: new("eucs@v1"),
+				CreatedAt:         timestamppb.New(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)),
+				Changes: []*orchestrator.ControlChange{
+					{
+						ControlShortName: "OPS-01",
+						ControlName:      "Capacity planning",
+						Type:             orchestrator.ControlChangeType_CONTROL_CHANGE_TYPE_CHANGED,
+						ChangedFields:    []string{"name"},
+						AddedMetrics:     []string{"m2"},
+					},
+					{
+						ControlShortName: "OPS-04",
+						ControlName:      "Backup",
+						Type:             orchestrator.ControlChangeType_CONTROL_CHANGE_TYPE_ADDED,
+					},
+				},
+			},
+			want: "# Changelog of catalog eucs\n\n" +
+				"Published as version `eucs@v2`, compared to version `eucs@v1`. Generated at 2026-01-01T00:00:00Z.\n\n" +
+				"## Added controls\n\n" +
+				"- **OPS-04** Backup\n\n" +
+				"## Changed controls\n\n" +
+				"- **OPS-01** Capacity planning: changed name; added metrics m2\n",
+		},
+		{
+			name: "re-imported without changes",
+			changelog: &orchestrator.CatalogChangelog{
+				CatalogId: "eucs",
+				Trigger:   orchestrator.CatalogChangelogTrigger_CATALOG_CHANGELOG_TRIGGER_REIMPORTED,
+				CreatedAt: timestamppb.New(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)),
+			},
+			want: "# Changelog of catalog eucs\n\n" +
+				"Re-imported from its catalog source. Generated at 2026-01-01T00:00:00Z.\n\n" +
+				"No controls changed.\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			renderCatalogChangelog(tt.changelog)
+			assert.Equal(t, tt.want, tt.changelog.Markdown)
+		})
+	}
+}
+
+func TestService_ListCatalogChangelogs(t *testing.T) {
+	tests := []struct {
+		name    string
+		req     *orchestrator.ListCatalogChangelogsRequest
+		want    assert.Want[*connect.Response[orchestrator.ListCatalogChangelogsResponse]]
+		wantErr assert.WantErr
+	}{
+		{
+			name: "validation error",
+			req:  &orchestrator.ListCatalogChangelogsRequest{},
+			want: assert.Nil[*connect.Response[orchestrator.ListCatalogChangelogsResponse]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeInvalidArgument)
+			},
+		},
+		{
+			name: "happy path",
+			req:  &orchestrator.ListCatalogChangelogsRequest{CatalogId: "eucs"},
+			want: func(t *testing.T, got *connect.Response[orchestrator.ListCatalogChangelogsResponse], msgAndArgs ...any) bool {
+				return assert.Equal(t, 1, len(got.Msg.Changelogs)) &&
+					assert.Equal(t, mockChangelogId, got.Msg.Changelogs[0].Id)
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "other catalog",
+			req:  &orchestrator.ListCatalogChangelogsRequest{CatalogId: "bsi"},
+			want: func(t *testing.T, got *connect.Response[orchestrator.ListCatalogChangelogsResponse], msgAndArgs ...any) bool {
+				return assert.Empty(t, got.Msg.Changelogs)
+			},
+			wantErr: assert.NoError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &Service{db: persistencetest.NewInMemoryDB(t, types, joinTables, createRecords(t, mockChangelogRecords()...))}
+			res, err := svc.ListCatalogChangelogs(context.Background(), connect.NewRequest(tt.req))
+			tt.want(t, res)
+			tt.wantErr(t, err)
+		})
+	}
+}
+
+func TestService_GetCatalogChangelog(t *testing.T) {
+	tests := []struct {
+		name    string
+		req     *orchestrator.GetCatalogChangelogRequest
+		want    assert.Want[*connect.Response[orchestrator.CatalogChangelog]]
+		wantErr assert.WantErr
+	}{
+		{
+			name: "validation error",
+			req:  &orchestrator.GetCatalogChangelogRequest{CatalogId: "eucs", ChangelogId: "invalid"},
+			want: assert.Nil[*connect.Response[orchestrator.CatalogChangelog]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeInvalidArgument)
+			},
+		},
+		{
+			name: "changelog of other catalog",
+			req:  &orchestrator.GetCatalogChangelogRequest{CatalogId: "bsi", ChangelogId: mockChangelogId},
+			want: assert.Nil[*connect.Response[orchestrator.CatalogChangelog]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeNotFound)
+			},
+		},
+		{
+			name: "happy path",
+			req:  &orchestrator.GetCatalogChangelogRequest{CatalogId: "eucs", ChangelogId: mockChangelogId},
+			want: func(t *testing.T, got *connect.Response[orchestrator.CatalogChangelog], msgAndArgs ...any) bool {
+				return assert.Equal(t, 1, len(got.Msg.Changes)) &&
+					assert.Equal(t, "OPS-01", got.Msg.Changes[0].ControlShortName) &&
+					assert.Contains(t, got.Msg.Markdown, "OPS-01")
+			},
+			wantErr: assert.NoError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &Service{db: persistencetest.NewInMemoryDB(t, types, joinTables, createRecords(t, mockChangelogRecords()...))}
+			res, err := svc.GetCatalogChangelog(context.Background(), connect.NewRequest(tt.req))
+			tt.want(t, res)
+			tt.wantErr(t, err)
+		})
+	}
+}
+
+// mockChangelogRecords returns a changelog of the catalog "eucs".
+func mockChangelogRecords() []any {
+	var changelog = newCatalogChangelog("eucs", orchestrator.CatalogChangelogTrigger_CATALOG_CHANGELOG_TRIGGER_REIMPORTED,
+		nil, mockChangelogCatalog(&orchestrator.Control{ShortName: "OPS-01", Name: "Capacity"}))
+
+	changelog.Id = mockChangelogId
+	renderCatalogChangelog(changelog)
+
+	return []any{changelog}
+}
@@ -0,0 +1,615 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package orchestrator
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"maps"
+	"net/http"
+	"slices"
+	"time"
+
+	"confirmate.io/core/api/assessment"
+	"confirmate.io/core/api/orchestrator"
+	"confirmate.io/core/log"
+	"confirmate.io/core/persistence"
+	"confirmate.io/core/service"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// DefaultCatalogSourceCheckInterval is the default interval in which the catalog sources are checked for a due sync.
+const DefaultCatalogSourceCheckInterval = time.Minute
+
+// CreateCatalogSource creates an upstream source of catalogs. Its catalogs are imported with the next check of the
+// catalog sources (see [Config.CatalogSourceCheckInterval]) or with [Service.SyncCatalogSource].
+func (svc *Service) CreateCatalogSource(
+	ctx context.Context,
+	req *connect.Request[orchestrator.CreateCatalogSourceRequest],
+) (res *connect.Response[orchestrator.CatalogSource], err error) {
+	var (
+		source  *orchestrator.CatalogSource
+		allowed bool
+	)
+
+	// Validate the request
+	if err = service.Validate(req); err != nil {
+		return nil, err
+	}
+
+	// Check access via the configured auth strategy
+	allowed, _, err = CheckAccess(ctx, svc.authz, svc, orchestrator.RequestType_REQUEST_TYPE_CREATED, "", orchestrator.ObjectType_OBJECT_TYPE_CATALOG)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if !allowed {
+		return nil, service.ErrPermissionDenied
+	}
+
+	source = &orchestrator.CatalogSource{
+		Id:                  uuid.NewString(),
+		Url:                 req.Msg.GetSource().GetUrl(),
+		Format:              req.Msg.GetSource().GetFormat(),
+		SyncIntervalMinutes: req.Msg.GetSource().GetSyncIntervalMinutes(),
+		AutoPublish:         req.Msg.GetSource().GetAutoPublish(),
+	}
+
+	err = svc.db.Create(source)
+	if err = service.HandleDatabaseError(err); err != nil {
+		return nil, err
+	}
+
+	res = connect.NewResponse(source)
+	return
+}
+
+// ListCatalogSources lists all catalog sources.
+func (svc *Service) ListCatalogSources(
+	ctx context.Context,
+	req *connect.Request[orchestrator.ListCatalogSourcesRequest],
+) (res *connect.Response[orchestrator.ListCatalogSourcesResponse], err error) {
+	var (
+		sources []*orchestrator.CatalogSource
+		npt     string
+	)
+
+	// Validate the request
+	if err = service.Validate(req); err != nil {
+		return nil, err
+	}
+
+	// Set default ordering
+	if req.Msg.OrderBy == "" {
+		req.Msg.OrderBy = "id"
+		req.Msg.Asc = true
+	}
+
+	sources, npt, err = service.PaginateStorage[*orchestrator.CatalogSource](req.Msg, svc.db, service.DefaultPaginationOpts)
+	if err = service.HandleDatabaseError(err); err != nil {
+		return nil, err
+	}
+
+	res = connect.NewResponse(&orchestrator.ListCatalogSourcesResponse{
+		Sources:       sources,
+		NextPageToken: npt,
+	})
+	return
+}
+
+// RemoveCatalogSource removes a catalog source. The catalogs imported from the source are kept.
+func (svc *Service) RemoveCatalogSource(
+	ctx context.Context,
+	req *connect.Request[orchestrator.RemoveCatalogSourceRequest],
+) (res *connect.Response[emptypb.Empty], err error) {
+	var allowed bool
+
+	// Validate the request
+	if err = service.Validate(req); err != nil {
+		return nil, err
+	}
+
+	// Check access via the configured auth strategy
+	allowed, _, err = CheckAccess(ctx, svc.authz, svc, orchestrator.RequestType_REQUEST_TYPE_UPDATED, "", orchestrator.ObjectType_OBJECT_TYPE_CATALOG)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if !allowed {
+		return nil, service.ErrPermissionDenied
+	}
+
+	err = svc.db.Delete(&orchestrator.CatalogSource{}, "id = ?", req.Msg.GetSourceId())
+	if err = service.HandleDatabaseError(err, service.ErrNotFound("catalog source")); err != nil {
+		return nil, err
+	}
+
+	res = connect.NewResponse(&emptypb.Empty{})
+	return
+}
+
+// SyncCatalogSource re-imports the catalogs of a catalog source immediately, if its content changed since the last
+// sync or if the sync is forced.
+func (svc *Service) SyncCatalogSource(
+	ctx context.Context,
+	req *connect.Request[orchestrator.SyncCatalogSourceRequest],
+) (res *connect.Response[orchestrator.CatalogSyncReport], err error) {
+	var (
+		source  orchestrator.CatalogSource
+		report  *orchestrator.CatalogSyncReport
+		allowed bool
+	)
+
+	// Validate the request
+	if err = service.Validate(req); err != nil {
+		return nil, err
+	}
+
+	// Check access via the configured auth strategy
+	allowed, _, err = CheckAccess(ctx, svc.authz, svc, orchestrator.RequestType_REQUEST_TYPE_UPDATED, "", orchestrator.ObjectType_OBJECT_TYPE_CATALOG)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if !allowed {
+		return nil, service.ErrPermissionDenied
+	}
+
+	err = svc.db.Get(&source, "id = ?", req.Msg.GetSourceId())
+	if err = service.HandleDatabaseError(err, service.ErrNotFound("catalog source")); err != nil {
+		return nil, err
+	}
+
+	report, err = svc.syncCatalogSource(ctx, &source, req.Msg.GetForce())
+	if err != nil {
+		return nil, err
+	}
+
+	res = connect.NewResponse(report)
+	return
+}
+
+// syncCatalogSourcesPeriodically syncs the catalog sources whose sync interval elapsed every
+// [Config.CatalogSourceCheckInterval] until ctx is done.
+func (svc *Service) syncCatalogSourcesPeriodically(ctx context.Context) {
+	var ticker = time.NewTicker(max(svc.cfg.CatalogSourceCheckInterval, time.Second))
+
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			svc.syncDueCatalogSources(ctx, time.Now())
+		}
+	}
+}
+
+// syncDueCatalogSources syncs all catalog sources that were never synced or whose sync interval elapsed since their
+// last successful sync. Sources that fail to sync are retried with the next check.
+func (svc *Service) syncDueCatalogSources(ctx context.Context, now time.Time) {
+	var (
+		sources []*orchestrator.CatalogSource
+		report  *orchestrator.CatalogSyncReport
+		err     error
+	)
+
+	err = svc.db.List(&sources, "id", true, 0, -1)
+	if err != nil {
+		slog.Warn("Could not retrieve catalog sources", log.Err(err))
+		return
+	}
+
+	for _, source := range sources {
+		interval := time.Duration(source.GetSyncIntervalMinutes()) * time.Minute
+		if source.LastSyncedAt != nil && now.Before(source.LastSyncedAt.AsTime().Add(interval)) {
+			continue
+		}
+
+		report, err = svc.syncCatalogSource(ctx, source, false)
+		if err != nil {
+			slog.Warn("Could not sync catalog source, retrying later", slog.String("source_id", source.Id), log.Err(err))
+			continue
+		}
+
+		if report.Changed {
+			slog.Info("Synced catalog source",
+				slog.String("source_id", source.Id),
+				slog.Int("catalogs", len(report.Catalogs)),
+			)
+		}
+	}
+}
+
+// syncCatalogSource retrieves the content of the catalog source and re-imports its catalogs, if the content changed
+// since the last sync or if force is set. The outcome of the sync is recorded in the source. The returned error is
+// already a [connect.Error].
+func (svc *Service) syncCatalogSource(ctx context.Context, source *orchestrator.CatalogSource, force bool) (report *orchestrator.CatalogSyncReport, err error) {
+	var (
+		b        []byte
+		digest   string
+		catalogs []*orchestrator.Catalog
+	)
+
+	report = &orchestrator.CatalogSyncReport{SourceId: source.Id}
+
+	b, err = svc.fetchCatalogSource(ctx, source.Url)
+	if err != nil {
+		err = connect.NewError(connect.CodeUnavailable, err)
+	} else {
+		sum := sha256.Sum256(b)
+		digest = hex.EncodeToString(sum[:])
+		report.Changed = force || digest != source.Digest
+
+		if report.Changed {
+			catalogs, err = parseCatalogSource(source.Format, b)
+			if err != nil {
+				err = connect.NewError(connect.CodeFailedPrecondition, err)
+			}
+		}
+	}
+	if err != nil {
+		source.LastError = new(err.Error())
+		if saveErr := svc.db.Save(source); saveErr != nil {
+			slog.Warn("Could not record error of catalog source", slog.String("source_id", source.Id), log.Err(saveErr))
+		}
+
+		return nil, err
+	}
+
+	for _, catalog := range catalogs {
+		report.Catalogs = append(report.Catalogs, svc.syncCatalog(catalog, source.AutoPublish))
+	}
+
+	source.LastSyncedAt = timestamppb.Now()
+	source.Digest = digest
+	source.LastError = nil
+	if report.Changed {
+		source.LastReport = report
+	}
+
+	err = svc.db.Save(source)
+	if err = service.HandleDatabaseError(err); err != nil {
+		return nil, err
+	}
+
+	return report, nil
+}
+
+// fetchCatalogSource retrieves the content of a catalog source.
+func (svc *Service) fetchCatalogSource(ctx context.Context, url string) (b []byte, err error) {
+	var (
+		req *http.Request
+		res *http.Response
+	)
+
+	req, err = http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("could not create catalog source request: %w", err)
+	}
+
+	res, err = svc.cfg.CatalogSourceHTTPClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve catalog source: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("could not retrieve catalog source: unexpected status %s", res.Status)
+	}
+
+	b, err = io.ReadAll(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("could not read catalog source: %w", err)
+	}
+
+	return b, nil
+}
+
+// parseCatalogSource parses the content of a catalog source in the given format into catalogs.
+func parseCatalogSource(format orchestrator.CatalogSourceFormat, b []byte) (catalogs []*orchestrator.Catalog, err error) {
+	switch format {
+	case orchestrator.CatalogSourceFormat_CATALOG_SOURCE_FORMAT_JSON:
+		err = json.Unmarshal(b, &catalogs)
+		if err != nil {
+			return nil, fmt.Errorf("could not parse catalog source: %w", err)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported catalog source format %s", format)
+	}
+
+	for _, catalog := range catalogs {
+		if catalog.GetId() == "" {
+			return nil, errors.New("catalog source contains a catalog without an ID")
+		}
+
+		// The lifecycle and the applicability rules of a catalog are managed locally
+		catalog.Status = orchestrator.CatalogStatus_CATALOG_STATUS_DRAFT
+		catalog.Version = 0
+		catalog.DraftId = nil
+		catalog.ApplicabilityRules = nil
+		normalizeCatalogControls(catalog)
+	}
+
+	return catalogs, nil
+}
+
+// syncCatalog imports a catalog of a catalog source. A catalog that does not exist yet is created as a draft,
+// otherwise the categories and controls of the existing catalog are replaced (see [Service.reimportCatalog]). The
+// changes are recorded in a changelog (see [Service.storeReimportChangelog]). If autoPublish is set, a catalog that
+// changed is published afterwards.
+func (svc *Service) syncCatalog(catalog *orchestrator.Catalog, autoPublish bool) (diff *orchestrator.CatalogDiff) {
+	var (
+		existing *orchestrator.Catalog
+		err      error
+	)
+
+	diff = &orchestrator.CatalogDiff{CatalogId: catalog.Id}
+
+	existing, _, err = svc.loadCatalogSnapshot(catalog.Id)
+	if errors.Is(err, persistence.ErrRecordNotFound) {
+		diff.Created = true
+		diff.AddedControls = slices.Sorted(maps.Keys(flattenCatalogControls(catalog)))
+
+		err = createCatalog(svc.db, catalog)
+	} else if err == nil {
+		err = svc.reimportCatalog(existing, catalog, diff)
+		if err == nil {
+			// Notify subscribers, since the controls of the catalog were replaced
+			svc.notifyCatalogUpdated(catalog.Id)
+		}
+	}
+
+	changed := diff.Created || len(diff.AddedControls) > 0 || len(diff.RemovedControls) > 0 || len(diff.ChangedControls) > 0
+	if err == nil {
+		err = svc.storeReimportChangelog(catalog.Id, existing)
+	}
+	if err == nil && autoPublish && changed {
+		_, err = svc.publishCatalog(catalog.Id)
+		diff.Published = err == nil
+	}
+
+	if err != nil {
+		slog.Warn("Could not import catalog of catalog source", slog.String("catalog_id", catalog.Id), log.Err(err))
+		diff.Error = new(err.Error())
+	}
+
+	return diff
+}
+
+// storeReimportChangelog stores the changelog of a catalog that was re-imported from its catalog source, compared to
+// its state before the re-import, unless nothing changed. Contrary to the [orchestrator.CatalogDiff] of the sync, it
+// also covers the metrics mapped to the controls.
+func (svc *Service) storeReimportChangelog(catalogId string, before *orchestrator.Catalog) (err error) {
+	var (
+		after     *orchestrator.Catalog
+		changelog *orchestrator.CatalogChangelog
+	)
+
+	after, _, err = svc.loadCatalogSnapshot(catalogId)
+	if err != nil {
+		return err
+	}
+
+	changelog = newCatalogChangelog(catalogId, orchestrator.CatalogChangelogTrigger_CATALOG_CHANGELOG_TRIGGER_REIMPORTED,
+		before, after)
+	if before != nil && len(changelog.Changes) == 0 {
+		return nil
+	}
+	renderCatalogChangelog(changelog)
+
+	return svc.db.Create(changelog)
+}
+
+// reimportCatalog replaces the categories and controls of an existing catalog with the ones of the catalog from its
+// source and records the changes in diff. Controls keep their IDs across re-imports. Controls without metrics in the
+// source keep the metrics that were mapped to them locally, including their weights. Assurance levels of the source
+// that the catalog does not offer yet are added, but local assurance levels and applicability rules are kept.
+func (svc *Service) reimportCatalog(existing *orchestrator.Catalog, catalog *orchestrator.Catalog, diff *orchestrator.CatalogDiff) (err error) {
+	var (
+		count   int64
+		before  map[string]catalogControl
+		after   map[string]catalogControl
+		weights []*orchestrator.ControlMetric
+		levels  []*orchestrator.AssuranceLevel
+		row     *orchestrator.Catalog
+		ids     []string
+	)
+
+	if existing.GetStatus() == orchestrator.CatalogStatus_CATALOG_STATUS_PUBLISHED {
+		return errors.New("published catalogs cannot be re-imported")
+	}
+
+	// Catalogs that pre-date catalog versioning can be bound by audit scopes directly, so we must not replace their
+	// controls
+	count, err = svc.db.Count(&orchestrator.AuditScope{}, "catalog_id = ? OR additional_catalog_ids LIKE ?",
+		existing.Id, fmt.Sprintf("%%%q%%", existing.Id))
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		return errors.New("catalog is bound by audit scopes")
+	}
+
+	before = flattenCatalogControls(existing)
+	after = flattenCatalogControls(catalog)
+
+	for shortName, c := range after {
+		old, ok := before[shortName]
+		if !ok {
+			diff.AddedControls = append(diff.AddedControls, shortName)
+			continue
+		}
+
+		if c.changedFrom(old) {
+			diff.ChangedControls = append(diff.ChangedControls, shortName)
+		}
+
+		c.control.Id = old.control.Id
+		if len(c.control.Metrics) == 0 {
+			for _, metric := range old.control.Metrics {
+				c.control.Metrics = append(c.control.Metrics, &assessment.Metric{Id: metric.GetId()})
+			}
+			ids = append(ids, old.control.Id)
+		}
+	}
+	for shortName := range before {
+		if _, ok := after[shortName]; !ok {
+			diff.RemovedControls = append(diff.RemovedControls, shortName)
+		}
+	}
+	slices.Sort(diff.AddedControls)
+	slices.Sort(diff.RemovedControls)
+	slices.Sort(diff.ChangedControls)
+
+	// Re-link the parents of the controls, since some of them received the IDs of the existing controls
+	normalizeCatalogControls(catalog)
+
+	if len(ids) > 0 {
+		err = svc.db.List(&weights, "control_id", true, 0, -1, persistence.WithoutPreload(), "control_id IN ?", ids)
+		if err != nil {
+			return err
+		}
+	}
+
+	row = proto.Clone(existing).(*orchestrator.Catalog)
+	row.Name = catalog.Name
+	row.Description = catalog.Description
+	row.ShortName = catalog.ShortName
+	row.AllInScope = catalog.AllInScope
+	row.Metadata = catalog.Metadata
+	row.SlaThresholds = catalog.SlaThresholds
+	row.CustomStatuses = catalog.CustomStatuses
+	row.EvaluationDefaults = catalog.EvaluationDefaults
+	row.Categories = nil
+	row.ApplicabilityRules = nil
+
+	return svc.db.Transaction(func(tx persistence.DB) error {
+		if err = deleteCatalogControls(tx, existing.Id); err != nil {
+			return err
+		}
+
+		if err = tx.Save(row); err != nil {
+			return err
+		}
+
+		if err = recordControlTextVersions(tx, catalog); err != nil {
+			return err
+		}
+
+		for _, category := range catalog.Categories {
+			category.CatalogId = existing.Id
+			if err = tx.Create(category); err != nil {
+				return err
+			}
+		}
+
+		for _, w := range weights {
+			if w.Weight == nil {
+				continue
+			}
+
+			err = tx.Update(&orchestrator.ControlMetric{Weight: w.Weight}, "control_id = ? AND metric_id = ?", w.GetControlId(), w.GetMetricId())
+			if err != nil && !errors.Is(err, persistence.ErrRecordNotFound) {
+				return err
+			}
+		}
+
+		if err = tx.List(&levels, "rank", true, 0, -1, "catalog_id = ?", existing.Id); err != nil {
+			return err
+		}
+
+		rank := uint32(len(levels))
+		for _, name := range catalog.GetAssuranceLevels() {
+			if slices.ContainsFunc(levels, func(l *orchestrator.AssuranceLevel) bool { return l.GetName() == name }) {
+				continue
+			}
+
+			if err = tx.Create(&orchestrator.AssuranceLevel{CatalogId: existing.Id, Name: name, Rank: rank}); err != nil {
+				return err
+			}
+			rank++
+		}
+
+		return syncCatalogAssuranceLevels(tx, existing.Id)
+	})
+}
+
+// catalogControl is a control of a catalog together with its position in the control tree.
+type catalogControl struct {
+	control  *orchestrator.Control
+	category string
+	parent   string
+}
+
+// changedFrom returns whether the control differs from an older version of it in its name, description, references,
+// assurance level or position in the control tree.
+func (c catalogControl) changedFrom(old catalogControl) bool {
+	return len(c.changedFields(old)) > 0
+}
+
+// changedFields returns the fields in which the control differs from an older version of it, see
+// [catalogControl.changedFrom].
+func (c catalogControl) changedFields(old catalogControl) (fields []string) {
+	if c.control.GetName() != old.control.GetName() {
+		fields = append(fields, "name")
+	}
+	if c.control.GetDescription() != old.control.GetDescription() {
+		fields = append(fields, "description")
+	}
+	if !sameControlReferences(c.control.GetReferences(), old.control.GetReferences()) {
+		fields = append(fields, "references")
+	}
+	if c.control.GetAssuranceLevel() != old.control.GetAssuranceLevel() {
+		fields = append(fields, "assurance_level")
+	}
+	if c.category != old.category {
+		fields = append(fields, "category")
+	}
+	if c.parent != old.parent {
+		fields = append(fields, "parent_control")
+	}
+
+	return
+}
+
+// flattenCatalogControls returns all controls of the control tree of a catalog by their short name.
+func flattenCatalogControls(catalog *orchestrator.Catalog) (controls map[string]catalogControl) {
+	var walk func(items []*orchestrator.Control, category string, parent string)
+
+	controls = make(map[string]catalogControl)
+	walk = func(items []*orchestrator.Control, category string, parent string) {
+		for _, control := range items {
+			controls[control.GetShortName()] = catalogControl{control: control, category: category, parent: parent}
+			walk(control.GetControls(), category, control.GetShortName())
+		}
+	}
+
+	for _, category := range catalog.GetCategories() {
+		walk(category.GetControls(), category.GetName(), "")
+	}
+
+	return
+}
@@ -0,0 +1,384 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package orchestrator
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"confirmate.io/core/api/orchestrator"
+	"confirmate.io/core/persistence"
+	"confirmate.io/core/persistence/persistencetest"
+	"confirmate.io/core/service"
+	"confirmate.io/core/util/assert"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const mockCatalogSourceID = "00000000-0000-0000-0000-0000000000c5"
+
+// newMockCatalogSourceServer returns a server that serves the given catalogs as a catalog source in the JSON format.
+// If catalogs is nil, the server responds with an internal server error.
+func newMockCatalogSourceServer(t *testing.T, catalogs []*orchestrator.Catalog) *httptest.Server {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if catalogs == nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		assert.NoError(t, json.NewEncoder(w).Encode(catalogs))
+	}))
+	t.Cleanup(srv.Close)
+
+	return srv
+}
+
+// upstreamEUCS returns the upstream version of the "eucs" catalog of [mockApplicabilityRecords], in which "OPS-01" was
+// renamed and "OPS-02" was added.
+func upstreamEUCS() []*orchestrator.Catalog {
+	return []*orchestrator.Catalog{
+		{
+			Id:              "eucs",
+			Name:            "EUCS",
+			AssuranceLevels: []string{"basic", "substantial", "high"},
+			Categories: []*orchestrator.Category{
+				{
+					Name: "OPS",
+					Controls: []*orchestrator.Control{
+						{Id: "OPS-01", Name: "Capacity planning"},
+						{Id: "OPS-02", Name: "Monitoring"},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestService_CreateCatalogSource(t *testing.T) {
+	type args struct {
+		req *orchestrator.CreateCatalogSourceRequest
+	}
+	type fields struct {
+		db persistence.DB
+	}
+	tests := []struct {
+		name    string
+		args    args
+		fields  fields
+		want    assert.Want[*connect.Response[orchestrator.CatalogSource]]
+		wantErr assert.WantErr
+	}{
+		{
+			name: "validation error",
+			args: args{
+				req: &orchestrator.CreateCatalogSourceRequest{
+					Source: &orchestrator.CatalogSource{
+						Url:                 "not a url",
+						Format:              orchestrator.CatalogSourceFormat_CATALOG_SOURCE_FORMAT_JSON,
+						SyncIntervalMinutes: 60,
+					},
+				},
+			},
+			fields: fields{
+				db: persistencetest.NewInMemoryDB(t, types, joinTables),
+			},
+			want: assert.Nil[*connect.Response[orchestrator.CatalogSource]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsValidationError(t, err, "source.url")
+			},
+		},
+		{
+			name: "happy path",
+			args: args{
+				req: &orchestrator.CreateCatalogSourceRequest{
+					Source: &orchestrator.CatalogSource{
+						Url:                 "https://example.com/catalogs.json",
+						Format:              orchestrator.CatalogSourceFormat_CATALOG_SOURCE_FORMAT_JSON,
+						SyncIntervalMinutes: 60,
+						AutoPublish:         true,
+						Digest:              "ignored",
+					},
+				},
+			},
+			fields: fields{
+				db: persistencetest.NewInMemoryDB(t, types, joinTables),
+			},
+			want: func(t *testing.T, got *connect.Response[orchestrator.CatalogSource], msgAndArgs ...any) bool {
+				return assert.NotEmpty(t, got.Msg.Id) &&
+					assert.Equal(t, "https://example.com/catalogs.json", got.Msg.Url) &&
+					assert.Equal(t, true, got.Msg.AutoPublish) &&
+					assert.Equal(t, "", got.Msg.Digest)
+			},
+			wantErr: assert.NoError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &Service{
+				db:    tt.fields.db,
+				authz: &service.AuthorizationStrategyAllowAll{},
+			}
+			res, err := svc.CreateCatalogSource(context.Background(), connect.NewRequest(tt.args.req))
+			tt.want(t, res)
+			tt.wantErr(t, err)
+		})
+	}
+}
+
+func TestService_SyncCatalogSource(t *testing.T) {
+	type args struct {
+		req *orchestrator.SyncCatalogSourceRequest
+	}
+	type fields struct {
+		db       persistence.DB
+		catalogs []*orchestrator.Catalog
+		source   *orchestrator.CatalogSource
+		upToDate bool
+	}
+	tests := []struct {
+		name    string
+		args    args
+		fields  fields
+		want    assert.Want[*connect.Response[orchestrator.CatalogSyncReport]]
+		wantErr assert.WantErr
+		wantDB  assert.Want[persistence.DB]
+	}{
+		{
+			name: "source not found",
+			args: args{
+				req: &orchestrator.SyncCatalogSourceRequest{SourceId: mockCatalogSourceID},
+			},
+			fields: fields{
+				db: persistencetest.NewInMemoryDB(t, types, joinTables, createRecords(t, mockApplicabilityRecords()...)),
+			},
+			want: assert.Nil[*connect.Response[orchestrator.CatalogSyncReport]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeNotFound)
+			},
+			wantDB: assert.NotNil[persistence.DB],
+		},
+		{
+			name: "upstream error",
+			args: args{
+				req: &orchestrator.SyncCatalogSourceRequest{SourceId: mockCatalogSourceID},
+			},
+			fields: fields{
+				db:     persistencetest.NewInMemoryDB(t, types, joinTables, createRecords(t, mockApplicabilityRecords()...)),
+				source: &orchestrator.CatalogSource{},
+			},
+			want: assert.Nil[*connect.Response[orchestrator.CatalogSyncReport]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeUnavailable)
+			},
+			wantDB: func(t *testing.T, db persistence.DB, msgAndArgs ...any) bool {
+				source := assert.InDB[orchestrator.CatalogSource](t, db, mockCatalogSourceID)
+				return assert.NotNil(t, source.LastError) &&
+					assert.Nil(t, source.LastSyncedAt)
+			},
+		},
+		{
+			name: "new catalog is created",
+			args: args{
+				req: &orchestrator.SyncCatalogSourceRequest{SourceId: mockCatalogSourceID},
+			},
+			fields: fields{
+				db: persistencetest.NewInMemoryDB(t, types, joinTables, createRecords(t, mockApplicabilityRecords()...)),
+				catalogs: []*orchestrator.Catalog{
+					{
+						Id:   "bsi",
+						Name: "BSI C5",
+						Categories: []*orchestrator.Category{
+							{Name: "OPS", Controls: []*orchestrator.Control{{Id: "OPS-01", Name: "Capacity"}}},
+						},
+					},
+				},
+				source: &orchestrator.CatalogSource{},
+			},
+			want: func(t *testing.T, got *connect.Response[orchestrator.CatalogSyncReport], msgAndArgs ...any) bool {
+				return assert.Equal(t, &orchestrator.CatalogSyncReport{
+					SourceId: mockCatalogSourceID,
+					Changed:  true,
+					Catalogs: []*orchestrator.CatalogDiff{
+						{CatalogId: "bsi", Created: true, AddedControls: []string{"OPS-01"}},
+					},
+				}, got.Msg)
+			},
+			wantErr: assert.NoError,
+			wantDB: func(t *testing.T, db persistence.DB, msgAndArgs ...any) bool {
+				catalog := assert.InDB[orchestrator.Catalog](t, db, "bsi")
+				source := assert.InDB[orchestrator.CatalogSource](t, db, mockCatalogSourceID)
+				return assert.Equal(t, orchestrator.CatalogStatus_CATALOG_STATUS_DRAFT, catalog.Status) &&
+					assert.NotEmpty(t, source.Digest) &&
+					assert.NotNil(t, source.LastSyncedAt) &&
+					assert.NotNil(t, source.LastReport)
+			},
+		},
+		{
+			name: "existing catalog is re-imported and published",
+			args: args{
+				req: &orchestrator.SyncCatalogSourceRequest{SourceId: mockCatalogSourceID},
+			},
+			fields: fields{
+				db: persistencetest.NewInMemoryDB(t, types, joinTables, createRecords(t, mockApplicabilityRecords()...), func(d persistence.DB) {
+					// The draft was published as "eucs@v1" before
+					assert.NoError(t, d.Update(&orchestrator.Catalog{Version: 1}, "id = ?", "eucs"))
+				}),
+				catalogs: upstreamEUCS(),
+				source:   &orchestrator.CatalogSource{AutoPublish: true},
+			},
+			want: func(t *testing.T, got *connect.Response[orchestrator.CatalogSyncReport], msgAndArgs ...any) bool {
+				return assert.Equal(t, &orchestrator.CatalogSyncReport{
+					SourceId: mockCatalogSourceID,
+					Changed:  true,
+					Catalogs: []*orchestrator.CatalogDiff{
+						{
+							CatalogId:       "eucs",
+							AddedControls:   []string{"OPS-02"},
+							ChangedControls: []string{"OPS-01"},
+							Published:       true,
+						},
+					},
+				}, got.Msg)
+			},
+			wantErr: assert.NoError,
+			wantDB: func(t *testing.T, db persistence.DB, msgAndArgs ...any) bool {
+				var (
+					controls  []*orchestrator.Control
+					draft     orchestrator.Catalog
+					changelog orchestrator.CatalogChangelog
+				)
+
+				err := db.List(&controls, "short_name", true, 0, -1, "catalog_id = ?", "eucs")
+				assert.NoError(t, err)
+				err = db.Get(&draft, persistence.WithoutPreload(), "id = ?", "eucs")
+				assert.NoError(t, err)
+				err = db.Get(&changelog, "catalog_id = ? AND trigger = ?", "eucs",
+					orchestrator.CatalogChangelogTrigger_CATALOG_CHANGELOG_TRIGGER_REIMPORTED)
+				assert.NoError(t, err)
+				assert.Equal(t, 2, len(changelog.Changes))
+				assert.Equal(t, "OPS-01", changelog.Changes[0].ControlShortName)
+				assert.Equal(t, orchestrator.ControlChangeType_CONTROL_CHANGE_TYPE_CHANGED, changelog.Changes[0].Type)
+				assert.Equal(t, orchestrator.ControlChangeType_CONTROL_CHANGE_TYPE_ADDED, changelog.Changes[1].Type)
+
+				// The existing control keeps its ID
+				return assert.Equal(t, 2, len(controls)) &&
+					assert.Equal(t, "00000000-0000-0000-0000-000000000001", controls[0].Id) &&
+					assert.Equal(t, "Capacity planning", controls[0].Name) &&
+					assert.Equal(t, []string{"basic", "high", "substantial"}, draft.AssuranceLevels) &&
+					assert.Equal(t, int32(2), draft.Version) &&
+					assert.NotNil(t, assert.InDB[orchestrator.Catalog](t, db, "eucs@v2"))
+			},
+		},
+		{
+			name: "unchanged content is not re-imported",
+			args: args{
+				req: &orchestrator.SyncCatalogSourceRequest{SourceId: mockCatalogSourceID},
+			},
+			fields: fields{
+				db:       persistencetest.NewInMemoryDB(t, types, joinTables, createRecords(t, mockApplicabilityRecords()...)),
+				catalogs: upstreamEUCS(),
+				source:   &orchestrator.CatalogSource{},
+				upToDate: true,
+			},
+			want: func(t *testing.T, got *connect.Response[orchestrator.CatalogSyncReport], msgAndArgs ...any) bool {
+				return assert.Equal(t, &orchestrator.CatalogSyncReport{SourceId: mockCatalogSourceID}, got.Msg)
+			},
+			wantErr: assert.NoError,
+			wantDB: func(t *testing.T, db persistence.DB, msgAndArgs ...any) bool {
+				control := assert.InDB[orchestrator.Control](t, db, "00000000-0000-0000-0000-000000000001")
+				return assert.Equal(t, "Capacity management", control.Name)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := newMockCatalogSourceServer(t, tt.fields.catalogs)
+			svc := &Service{
+				db:    tt.fields.db,
+				authz: &service.AuthorizationStrategyAllowAll{},
+				cfg:   Config{CatalogSourceHTTPClient: srv.Client()},
+			}
+
+			if tt.fields.source != nil {
+				tt.fields.source.Id = mockCatalogSourceID
+				tt.fields.source.Url = srv.URL
+				tt.fields.source.Format = orchestrator.CatalogSourceFormat_CATALOG_SOURCE_FORMAT_JSON
+				tt.fields.source.SyncIntervalMinutes = 60
+
+				// Record the digest of the current content, as if it was synced before
+				if tt.fields.upToDate {
+					b, err := svc.fetchCatalogSource(context.Background(), srv.URL)
+					assert.NoError(t, err)
+
+					sum := sha256.Sum256(b)
+					tt.fields.source.Digest = hex.EncodeToString(sum[:])
+				}
+
+				assert.NoError(t, tt.fields.db.Create(tt.fields.source))
+			}
+
+			res, err := svc.SyncCatalogSource(context.Background(), connect.NewRequest(tt.args.req))
+			tt.want(t, res)
+			tt.wantErr(t, err)
+			tt.wantDB(t, tt.fields.db)
+		})
+	}
+}
+
+func TestService_syncDueCatalogSources(t *testing.T) {
+	var (
+		now = time.Now()
+		srv = newMockCatalogSourceServer(t, upstreamEUCS())
+		db  = persistencetest.NewInMemoryDB(t, types, joinTables, createRecords(t, mockApplicabilityRecords()...), func(d persistence.DB) {
+			assert.NoError(t, d.Create(&orchestrator.CatalogSource{
+				Id:                  mockCatalogSourceID,
+				Format:              orchestrator.CatalogSourceFormat_CATALOG_SOURCE_FORMAT_JSON,
+				SyncIntervalMinutes: 60,
+			}))
+		})
+		source *orchestrator.CatalogSource
+	)
+
+	svc := &Service{
+		db:  db,
+		cfg: Config{CatalogSourceHTTPClient: srv.Client()},
+	}
+
+	// The source was synced recently, so it is not due yet
+	assert.NoError(t, db.Update(&orchestrator.CatalogSource{
+		Url:          srv.URL,
+		LastSyncedAt: timestamppb.New(now.Add(-time.Minute)),
+	}, "id = ?", mockCatalogSourceID))
+
+	svc.syncDueCatalogSources(context.Background(), now)
+	source = assert.InDB[orchestrator.CatalogSource](t, db, mockCatalogSourceID)
+	assert.Equal(t, "", source.Digest)
+
+	// Once the sync interval elapsed, the source is synced
+	svc.syncDueCatalogSources(context.Background(), now.Add(time.Hour))
+	source = assert.InDB[orchestrator.CatalogSource](t, db, mockCatalogSourceID)
+	assert.NotEmpty(t, source.Digest)
+	assert.Nil(t, source.LastError)
+}
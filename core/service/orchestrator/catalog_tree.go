@@ -0,0 +1,214 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package orchestrator
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"confirmate.io/core/api/evaluation"
+	"confirmate.io/core/api/orchestrator"
+	"confirmate.io/core/persistence"
+	"confirmate.io/core/service"
+
+	"connectrpc.com/connect"
+)
+
+// catalogTree builds the tree of a catalog out of its controls, which are loaded at once instead of level by level.
+type catalogTree struct {
+	// children contains the sub-controls of each control, keyed by the ID of the parent control.
+	children map[string][]*orchestrator.Control
+
+	// metrics contains the number of metrics of each control, keyed by the control ID.
+	metrics map[string]int32
+
+	// status contains the status of the latest evaluation result of each control, keyed by the control ID.
+	status map[string]evaluation.EvaluationStatus
+
+	// maxDepth is the maximum depth of the tree, 0 means unlimited.
+	maxDepth int32
+}
+
+// GetCatalogTree retrieves the categories, controls and sub-controls of a catalog in a single call. If an audit scope
+// is given, each control includes the status of its latest evaluation result within the audit scope.
+func (svc *Service) GetCatalogTree(
+	ctx context.Context,
+	req *connect.Request[orchestrator.GetCatalogTreeRequest],
+) (res *connect.Response[orchestrator.GetCatalogTreeResponse], err error) {
+	var (
+		allowed    bool
+		scope      orchestrator.AuditScope
+		catalog    orchestrator.Catalog
+		categories []*orchestrator.Category
+		controls   []*orchestrator.Control
+		weights    []*orchestrator.ControlMetric
+		results    []*evaluation.EvaluationResult
+		ids        []string
+		tree       = catalogTree{
+			children: make(map[string][]*orchestrator.Control),
+			metrics:  make(map[string]int32),
+			status:   make(map[string]evaluation.EvaluationStatus),
+			maxDepth: req.Msg.GetMaxDepth(),
+		}
+	)
+
+	// Validate the request
+	if err = service.Validate(req); err != nil {
+		return nil, err
+	}
+
+	// Catalogs can be read by all authenticated users, but the evaluation status requires access to the audit scope
+	if req.Msg.AuditScopeId != nil {
+		allowed, _, err = CheckAccess(ctx, svc.authz, svc, orchestrator.RequestType_REQUEST_TYPE_GET, req.Msg.GetAuditScopeId(), orchestrator.ObjectType_OBJECT_TYPE_AUDIT_SCOPE)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+		if !allowed {
+			return nil, service.ErrPermissionDenied
+		}
+
+		err = svc.db.Get(&scope, persistence.WithoutPreload(), "id = ?", req.Msg.GetAuditScopeId())
+		if err = service.HandleDatabaseError(err, service.ErrNotFound("audit scope")); err != nil {
+			return nil, err
+		}
+
+		if !scope.HasCatalog(req.Msg.GetCatalogId()) {
+			return nil, service.NewInvalidFieldError("audit_scope_id",
+				fmt.Errorf("catalog '%s' is not part of the audit scope", req.Msg.GetCatalogId()))
+		}
+	}
+
+	err = svc.db.Get(&catalog, persistence.WithoutPreload(), "id = ?", req.Msg.GetCatalogId())
+	if err = service.HandleDatabaseError(err, service.ErrNotFound("catalog")); err != nil {
+		return nil, err
+	}
+
+	// The categories include their top-level controls, all other controls of the catalog are retrieved at once
+	err = svc.db.List(&categories, "name", true, 0, -1,
+		persistence.WithPreload("Controls", "parent_control_id IS NULL"), "catalog_id = ?", catalog.Id)
+	if err = service.HandleDatabaseError(err); err != nil {
+		return nil, err
+	}
+
+	err = svc.db.List(&controls, "short_name", true, 0, -1, persistence.WithoutPreload(), "catalog_id = ?", catalog.Id)
+	if err = service.HandleDatabaseError(err); err != nil {
+		return nil, err
+	}
+
+	for _, c := range controls {
+		ids = append(ids, c.Id)
+		if c.ParentControlId != nil {
+			tree.children[c.GetParentControlId()] = append(tree.children[c.GetParentControlId()], c)
+		}
+	}
+
+	if len(ids) > 0 {
+		err = svc.db.List(&weights, "control_id", true, 0, -1, persistence.WithoutPreload(), "control_id IN ?", ids)
+		if err = service.HandleDatabaseError(err); err != nil {
+			return nil, err
+		}
+
+		for _, w := range weights {
+			tree.metrics[w.GetControlId()]++
+		}
+	}
+
+	if req.Msg.AuditScopeId != nil {
+		results, err = latestEvaluationResults(svc.db, []string{"control_id"},
+			func(r *evaluation.EvaluationResult) string {
+				return r.GetControlId()
+			}, "WHERE audit_scope_id = ? AND control_catalog_id = ?", scope.Id, catalog.Id)
+		if err = service.HandleDatabaseError(err); err != nil {
+			return nil, err
+		}
+
+		for _, r := range results {
+			tree.status[r.GetControlId()] = r.GetStatus()
+		}
+	}
+
+	res = connect.NewResponse(&orchestrator.GetCatalogTreeResponse{
+		CatalogId:    catalog.Id,
+		AuditScopeId: req.Msg.AuditScopeId,
+		Categories:   make([]*orchestrator.CatalogTreeCategory, 0, len(categories)),
+	})
+
+	for _, category := range categories {
+		res.Msg.Categories = append(res.Msg.Categories, tree.category(category))
+	}
+
+	return
+}
+
+// category returns the node of the category. Its top-level controls are included if the depth limit allows it.
+func (t *catalogTree) category(category *orchestrator.Category) (node *orchestrator.CatalogTreeCategory) {
+	node = &orchestrator.CatalogTreeCategory{
+		Name:             category.Name,
+		Description:      category.Description,
+		NumberOfControls: int32(len(category.Controls)),
+		Controls:         []*orchestrator.CatalogTreeControl{},
+	}
+
+	if !t.includes(2) {
+		return
+	}
+
+	// The order of preloaded controls is not defined, so we sort them in the same way as the sub-controls
+	slices.SortFunc(category.Controls, func(a *orchestrator.Control, b *orchestrator.Control) int {
+		return strings.Compare(a.ShortName, b.ShortName)
+	})
+
+	for _, c := range category.Controls {
+		node.Controls = append(node.Controls, t.control(c, 2))
+	}
+
+	return
+}
+
+// control returns the node of the control at the given depth. Its sub-controls are included recursively as long as
+// the depth limit allows it.
+func (t *catalogTree) control(control *orchestrator.Control, depth int32) (node *orchestrator.CatalogTreeControl) {
+	node = &orchestrator.CatalogTreeControl{
+		Id:               control.Id,
+		ShortName:        control.ShortName,
+		Name:             control.Name,
+		AssuranceLevel:   control.AssuranceLevel,
+		NumberOfMetrics:  t.metrics[control.Id],
+		NumberOfControls: int32(len(t.children[control.Id])),
+		Controls:         []*orchestrator.CatalogTreeControl{},
+	}
+
+	if status, ok := t.status[control.Id]; ok {
+		node.Status = &status
+	}
+
+	if !t.includes(depth + 1) {
+		return
+	}
+
+	for _, child := range t.children[control.Id] {
+		node.Controls = append(node.Controls, t.control(child, depth+1))
+	}
+
+	return
+}
+
+// includes returns whether nodes at the given depth are part of the tree.
+func (t *catalogTree) includes(depth int32) bool {
+	return t.maxDepth == 0 || depth <= t.maxDepth
+}
@@ -0,0 +1,277 @@
+// Copyright 2016-2025 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package orchestrator
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"confirmate.io/core/api/evaluation"
+	"confirmate.io/core/api/orchestrator"
+	"confirmate.io/core/persistence"
+	"confirmate.io/core/persistence/persistencetest"
+	"confirmate.io/core/service"
+	"confirmate.io/core/service/orchestrator/orchestratortest"
+	"confirmate.io/core/util/assert"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// mockCatalogTreeRecords returns a catalog and an audit scope, in which the first control has been evaluated twice.
+func mockCatalogTreeRecords() (records []any) {
+	records = []any{
+		proto.CloneOf(orchestratortest.MockCatalog1),
+		orchestratortest.MockTargetOfEvaluation1,
+		orchestratortest.MockAuditScope1,
+	}
+
+	for i, status := range []evaluation.EvaluationStatus{
+		evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT,
+		evaluation.EvaluationStatus_EVALUATION_STATUS_COMPLIANT,
+	} {
+		records = append(records, &evaluation.EvaluationResult{
+			Id:                   []string{"00000000-0000-0000-0002-000000000001", "00000000-0000-0000-0002-000000000002"}[i],
+			TargetOfEvaluationId: orchestratortest.MockToeId1,
+			AuditScopeId:         orchestratortest.MockScopeId1,
+			ControlId:            orchestratortest.MockControlId1,
+			ControlCatalogId:     orchestratortest.MockCatalogId1,
+			Status:               status,
+			Timestamp:            timestamppb.New(time.Date(2026, 1, i+1, 0, 0, 0, 0, time.UTC)),
+		})
+	}
+
+	return
+}
+
+func TestService_GetCatalogTree(t *testing.T) {
+	type fields struct {
+		db    persistence.DB
+		authz service.AuthorizationStrategy
+	}
+	type args struct {
+		req *orchestrator.GetCatalogTreeRequest
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    assert.Want[*connect.Response[orchestrator.GetCatalogTreeResponse]]
+		wantErr assert.WantErr
+	}{
+		{
+			name: "validation error - invalid depth",
+			fields: fields{
+				db:    persistencetest.NewInMemoryDB(t, types, joinTables, createRecords(t, mockCatalogTreeRecords()...)),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			args: args{
+				req: &orchestrator.GetCatalogTreeRequest{
+					CatalogId: orchestratortest.MockCatalogId1,
+					MaxDepth:  new(int32(0)),
+				},
+			},
+			want: assert.Nil[*connect.Response[orchestrator.GetCatalogTreeResponse]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeInvalidArgument)
+			},
+		},
+		{
+			name: "full tree",
+			fields: fields{
+				db:    persistencetest.NewInMemoryDB(t, types, joinTables, createRecords(t, mockCatalogTreeRecords()...)),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			args: args{
+				req: &orchestrator.GetCatalogTreeRequest{
+					CatalogId: orchestratortest.MockCatalogId1,
+				},
+			},
+			want: func(t *testing.T, got *connect.Response[orchestrator.GetCatalogTreeResponse], msgAndArgs ...any) bool {
+				return assert.Equal(t, &orchestrator.GetCatalogTreeResponse{
+					CatalogId: orchestratortest.MockCatalogId1,
+					Categories: []*orchestrator.CatalogTreeCategory{
+						{
+							Name:             orchestratortest.MockCategoryName1,
+							NumberOfControls: 1,
+							Controls: []*orchestrator.CatalogTreeControl{
+								{
+									Id:               orchestratortest.MockControlId1,
+									ShortName:        orchestratortest.MockControlShortName1,
+									Name:             orchestratortest.MockControlName1,
+									NumberOfControls: 2,
+									Controls: []*orchestrator.CatalogTreeControl{
+										{
+											Id:              orchestratortest.MockControl1SubControlId1,
+											ShortName:       orchestratortest.MockSubControlShortName1,
+											Name:            orchestratortest.MockSubControlName1,
+											AssuranceLevel:  new("high"),
+											NumberOfMetrics: 1,
+											Controls:        []*orchestrator.CatalogTreeControl{},
+										},
+										{
+											Id:              orchestratortest.MockControl1SubControlId2,
+											ShortName:       orchestratortest.MockSubControlShortName2,
+											Name:            orchestratortest.MockSubControlName2,
+											AssuranceLevel:  new("medium"),
+											NumberOfMetrics: 1,
+											Controls:        []*orchestrator.CatalogTreeControl{},
+										},
+									},
+								},
+							},
+						},
+						{
+							Name:             orchestratortest.MockCategoryName2,
+							NumberOfControls: 1,
+							Controls: []*orchestrator.CatalogTreeControl{
+								{
+									Id:               orchestratortest.MockControlId2,
+									ShortName:        orchestratortest.MockControlShortName2,
+									Name:             orchestratortest.MockControlName2,
+									NumberOfControls: 1,
+									Controls: []*orchestrator.CatalogTreeControl{
+										{
+											Id:              orchestratortest.MockControl2SubControlId1,
+											ShortName:       orchestratortest.MockSubControlShortName1,
+											Name:            orchestratortest.MockSubControlName2,
+											NumberOfMetrics: 1,
+											Controls:        []*orchestrator.CatalogTreeControl{},
+										},
+									},
+								},
+							},
+						},
+					},
+				}, got.Msg)
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "limited depth with evaluation status",
+			fields: fields{
+				db:    persistencetest.NewInMemoryDB(t, types, joinTables, createRecords(t, mockCatalogTreeRecords()...)),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			args: args{
+				req: &orchestrator.GetCatalogTreeRequest{
+					CatalogId:    orchestratortest.MockCatalogId1,
+					AuditScopeId: new(orchestratortest.MockScopeId1),
+					MaxDepth:     new(int32(2)),
+				},
+			},
+			want: func(t *testing.T, got *connect.Response[orchestrator.GetCatalogTreeResponse], msgAndArgs ...any) bool {
+				var control = got.Msg.Categories[0].Controls[0]
+
+				return assert.Equal(t, orchestratortest.MockScopeId1, got.Msg.GetAuditScopeId()) &&
+					assert.Equal(t, 2, len(got.Msg.Categories)) &&
+					assert.Equal(t, evaluation.EvaluationStatus_EVALUATION_STATUS_COMPLIANT, control.GetStatus()) &&
+					assert.Equal(t, int32(2), control.NumberOfControls) &&
+					assert.Empty(t, control.Controls) &&
+					assert.Nil(t, got.Msg.Categories[1].Controls[0].Status)
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "categories only",
+			fields: fields{
+				db:    persistencetest.NewInMemoryDB(t, types, joinTables, createRecords(t, mockCatalogTreeRecords()...)),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			args: args{
+				req: &orchestrator.GetCatalogTreeRequest{
+					CatalogId: orchestratortest.MockCatalogId1,
+					MaxDepth:  new(int32(1)),
+				},
+			},
+			want: func(t *testing.T, got *connect.Response[orchestrator.GetCatalogTreeResponse], msgAndArgs ...any) bool {
+				return assert.Equal(t, 2, len(got.Msg.Categories)) &&
+					assert.Equal(t, int32(1), got.Msg.Categories[0].NumberOfControls) &&
+					assert.Empty(t, got.Msg.Categories[0].Controls)
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "catalog not part of audit scope",
+			fields: fields{
+				db: persistencetest.NewInMemoryDB(t, types, joinTables, func(d persistence.DB) {
+					assert.NoError(t, d.Create(orchestratortest.MockCatalog1))
+					assert.NoError(t, d.Create(orchestratortest.MockTargetOfEvaluation2))
+					assert.NoError(t, d.Create(orchestratortest.MockAuditScope2))
+				}),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			args: args{
+				req: &orchestrator.GetCatalogTreeRequest{
+					CatalogId:    orchestratortest.MockCatalogId1,
+					AuditScopeId: new(orchestratortest.MockScopeId2),
+				},
+			},
+			want: assert.Nil[*connect.Response[orchestrator.GetCatalogTreeResponse]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeInvalidArgument) &&
+					assert.ErrorContains(t, err, "is not part of the audit scope")
+			},
+		},
+		{
+			name: "permission denied",
+			fields: fields{
+				db:    persistencetest.NewInMemoryDB(t, types, joinTables, createRecords(t, mockCatalogTreeRecords()...)),
+				authz: &denyAuthorizationStrategy{},
+			},
+			args: args{
+				req: &orchestrator.GetCatalogTreeRequest{
+					CatalogId:    orchestratortest.MockCatalogId1,
+					AuditScopeId: new(orchestratortest.MockScopeId1),
+				},
+			},
+			want: assert.Nil[*connect.Response[orchestrator.GetCatalogTreeResponse]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.ErrorIs(t, err, service.ErrPermissionDenied)
+			},
+		},
+		{
+			name: "catalog not found",
+			fields: fields{
+				db:    persistencetest.NewInMemoryDB(t, types, joinTables),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			args: args{
+				req: &orchestrator.GetCatalogTreeRequest{
+					CatalogId: "does-not-exist",
+				},
+			},
+			want: assert.Nil[*connect.Response[orchestrator.GetCatalogTreeResponse]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeNotFound)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &Service{
+				db:    tt.fields.db,
+				authz: tt.fields.authz,
+			}
+
+			res, err := svc.GetCatalogTree(context.Background(), connect.NewRequest(tt.args.req))
+			tt.want(t, res)
+			tt.wantErr(t, err)
+		})
+	}
+}
@@ -18,12 +18,14 @@ package orchestrator
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log/slog"
 	"os"
 	"path/filepath"
 	"strings"
 
+	"confirmate.io/core/api/assessment"
 	"confirmate.io/core/api/orchestrator"
 	"confirmate.io/core/log"
 	"confirmate.io/core/persistence"
@@ -33,6 +35,7 @@ import (
 	"github.com/google/uuid"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // CreateCatalog creates a new catalog.
@@ -51,14 +54,20 @@ func (svc *Service) CreateCatalog(
 	}
 
 	catalog = &orchestrator.Catalog{
-		Id:              req.Msg.GetCatalog().GetId(),
-		Name:            req.Msg.GetCatalog().GetName(),
-		Categories:      req.Msg.GetCatalog().GetCategories(),
-		Description:     req.Msg.Catalog.GetDescription(),
-		AllInScope:      req.Msg.Catalog.GetAllInScope(),
-		AssuranceLevels: req.Msg.Catalog.GetAssuranceLevels(),
-		ShortName:       req.Msg.Catalog.GetShortName(),
-		Metadata:        req.Msg.Catalog.Metadata,
+		Id:                           req.Msg.GetCatalog().GetId(),
+		Name:                         req.Msg.GetCatalog().GetName(),
+		Categories:                   req.Msg.GetCatalog().GetCategories(),
+		Description:                  req.Msg.Catalog.GetDescription(),
+		AllInScope:                   req.Msg.Catalog.GetAllInScope(),
+		AssuranceLevels:              req.Msg.Catalog.GetAssuranceLevels(),
+		ShortName:                    req.Msg.Catalog.GetShortName(),
+		Metadata:                     req.Msg.Catalog.Metadata,
+		SlaThresholds:                req.Msg.Catalog.GetSlaThresholds(),
+		CustomStatuses:               req.Msg.Catalog.GetCustomStatuses(),
+		Status:                       orchestrator.CatalogStatus_CATALOG_STATUS_DRAFT,
+		AutoCreateAuditScopes:        req.Msg.Catalog.AutoCreateAuditScopes,
+		AutoAuditScopeAssuranceLevel: req.Msg.Catalog.AutoAuditScopeAssuranceLevel,
+		EvaluationDefaults:           req.Msg.Catalog.EvaluationDefaults,
 	}
 	catalog = proto.Clone(catalog).(*orchestrator.Catalog)
 	normalizeCatalogControls(catalog)
@@ -71,8 +80,8 @@ func (svc *Service) CreateCatalog(
 	if !allowed {
 		return nil, service.ErrPermissionDenied
 	}
-	// Persist the new catalog in the database
-	err = svc.db.Create(catalog)
+	// Persist the new catalog including its assurance levels in the database
+	err = createCatalog(svc.db, catalog)
 	if err = service.HandleDatabaseError(err); err != nil {
 		return nil, err
 	}
@@ -100,6 +109,7 @@ func (svc *Service) GetCatalog(
 		// Preload fills in associated entities, in this case controls. We want to only select those controls which do
 		// not have a parent, e.g., the top-level
 		persistence.WithPreload("Categories.Controls", "parent_control_id IS NULL"),
+		persistence.WithPreload("ApplicabilityRules"),
 		"id = ?", req.Msg.CatalogId)
 	if err = service.HandleDatabaseError(err, service.ErrNotFound("catalog")); err != nil {
 		return nil, err
@@ -158,15 +168,21 @@ func (svc *Service) UpdateCatalog(
 		return nil, err
 	}
 
+	// The assurance levels are not updated, since they are managed with [Service.UpdateAssuranceLevel] and
+	// [Service.RemoveAssuranceLevel]
 	catalog = &orchestrator.Catalog{
-		Id:              req.Msg.GetCatalog().GetId(),
-		Name:            req.Msg.GetCatalog().GetName(),
-		Categories:      req.Msg.GetCatalog().GetCategories(),
-		Description:     req.Msg.Catalog.GetDescription(),
-		AllInScope:      req.Msg.Catalog.GetAllInScope(),
-		AssuranceLevels: req.Msg.Catalog.GetAssuranceLevels(),
-		ShortName:       req.Msg.Catalog.GetShortName(),
-		Metadata:        req.Msg.Catalog.Metadata,
+		Id:                           req.Msg.GetCatalog().GetId(),
+		Name:                         req.Msg.GetCatalog().GetName(),
+		Categories:                   req.Msg.GetCatalog().GetCategories(),
+		Description:                  req.Msg.Catalog.GetDescription(),
+		AllInScope:                   req.Msg.Catalog.GetAllInScope(),
+		ShortName:                    req.Msg.Catalog.GetShortName(),
+		Metadata:                     req.Msg.Catalog.Metadata,
+		SlaThresholds:                req.Msg.Catalog.GetSlaThresholds(),
+		CustomStatuses:               req.Msg.Catalog.GetCustomStatuses(),
+		AutoCreateAuditScopes:        req.Msg.Catalog.AutoCreateAuditScopes,
+		AutoAuditScopeAssuranceLevel: req.Msg.Catalog.AutoAuditScopeAssuranceLevel,
+		EvaluationDefaults:           req.Msg.Catalog.EvaluationDefaults,
 	}
 	catalog = proto.Clone(catalog).(*orchestrator.Catalog)
 	normalizeCatalogControls(catalog)
@@ -180,12 +196,36 @@ func (svc *Service) UpdateCatalog(
 		return nil, service.ErrPermissionDenied
 	}
 
-	// Update the catalog
-	err = svc.db.Update(catalog, "id = ?", catalog.Id)
+	// Update the catalog and record changed control texts. Published catalogs are immutable, so they are excluded
+	// from the update
+	err = svc.db.Transaction(func(tx persistence.DB) error {
+		if err = recordControlTextVersions(tx, catalog); err != nil {
+			return err
+		}
+
+		return tx.Update(catalog, "id = ? AND status IN ?", catalog.Id, []orchestrator.CatalogStatus{
+			orchestrator.CatalogStatus_CATALOG_STATUS_UNSPECIFIED,
+			orchestrator.CatalogStatus_CATALOG_STATUS_DRAFT,
+		})
+	})
+	if errors.Is(err, persistence.ErrRecordNotFound) {
+		err = svc.checkCatalogNotPublished(catalog.Id)
+	}
 	if err = service.HandleDatabaseError(err, service.ErrNotFound("catalog")); err != nil {
 		return nil, err
 	}
 
+	// Notify subscribers
+	go svc.publishEvent(&orchestrator.ChangeEvent{
+		Timestamp:   timestamppb.Now(),
+		Category:    orchestrator.EventCategory_EVENT_CATEGORY_CATALOG,
+		RequestType: orchestrator.RequestType_REQUEST_TYPE_UPDATED,
+		EntityId:    catalog.Id,
+		Entity: &orchestrator.ChangeEvent_Catalog{
+			Catalog: catalog,
+		},
+	})
+
 	res = connect.NewResponse(catalog)
 	return
 }
@@ -197,6 +237,7 @@ func (svc *Service) RemoveCatalog(
 ) (res *connect.Response[emptypb.Empty], err error) {
 	var (
 		catalog orchestrator.Catalog
+		count   int64
 		allowed bool
 	)
 
@@ -214,16 +255,287 @@ func (svc *Service) RemoveCatalog(
 		return nil, service.ErrPermissionDenied
 	}
 
+	// Catalogs that are bound by audit scopes cannot be removed, otherwise running evaluations lose their controls
+	count, err = svc.db.Count(&orchestrator.AuditScope{}, "catalog_id = ? OR additional_catalog_ids LIKE ?",
+		req.Msg.CatalogId, fmt.Sprintf("%%%q%%", req.Msg.CatalogId))
+	if err = service.HandleDatabaseError(err); err != nil {
+		return nil, err
+	}
+	if count > 0 {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("catalog is still bound by audit scopes"))
+	}
+
 	// Delete the catalog
 	err = svc.db.Delete(&catalog, "id = ?", req.Msg.CatalogId)
 	if err = service.HandleDatabaseError(err); err != nil {
 		return nil, err
 	}
 
+	// Delete the default metric configurations, assurance levels and applicability rules of the catalog, if there are
+	// any
+	for _, r := range []any{
+		&assessment.CatalogMetricConfiguration{},
+		&orchestrator.AssuranceLevel{},
+		&orchestrator.ApplicabilityRule{},
+	} {
+		err = svc.db.Delete(r, "catalog_id = ?", req.Msg.CatalogId)
+		if errors.Is(err, persistence.ErrRecordNotFound) {
+			err = nil
+		}
+		if err = service.HandleDatabaseError(err); err != nil {
+			return nil, err
+		}
+	}
+
+	// Notify subscribers
+	go svc.publishEvent(&orchestrator.ChangeEvent{
+		Timestamp:   timestamppb.Now(),
+		Category:    orchestrator.EventCategory_EVENT_CATEGORY_CATALOG,
+		RequestType: orchestrator.RequestType_REQUEST_TYPE_DELETED,
+		EntityId:    req.Msg.CatalogId,
+	})
+
 	res = connect.NewResponse(&emptypb.Empty{})
 	return
 }
 
+// PublishCatalog snapshots the draft of a catalog into a new, immutable catalog version with the ID
+// "<catalog_id>@v<version>". Audit scopes bind to published versions, so that later edits of the draft do not change
+// the semantics of running evaluations. The default metric configurations, assurance levels and applicability rules of
+// the draft are copied to the snapshot. A changelog of the controls since the previous version is stored alongside
+// (see [Service.ListCatalogChangelogs]).
+func (svc *Service) PublishCatalog(
+	ctx context.Context,
+	req *connect.Request[orchestrator.PublishCatalogRequest],
+) (res *connect.Response[orchestrator.Catalog], err error) {
+	var (
+		published *orchestrator.Catalog
+		allowed   bool
+	)
+
+	// Validate the request
+	if err = service.Validate(req); err != nil {
+		return nil, err
+	}
+
+	// Check access via the configured auth strategy
+	allowed, _, err = CheckAccess(ctx, svc.authz, svc, orchestrator.RequestType_REQUEST_TYPE_UPDATED, "", orchestrator.ObjectType_OBJECT_TYPE_CATALOG)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if !allowed {
+		return nil, service.ErrPermissionDenied
+	}
+
+	published, err = svc.publishCatalog(req.Msg.CatalogId)
+	if err != nil {
+		return nil, err
+	}
+
+	res = connect.NewResponse(published)
+	return
+}
+
+// publishCatalog snapshots the draft of a catalog into a new published version. It is used by [Service.PublishCatalog]
+// and by the sync of catalog sources that publish automatically. The returned error is already a [connect.Error].
+func (svc *Service) publishCatalog(catalogId string) (published *orchestrator.Catalog, err error) {
+	var (
+		draft     *orchestrator.Catalog
+		previous  *orchestrator.Catalog
+		configs   []*assessment.CatalogMetricConfiguration
+		changelog *orchestrator.CatalogChangelog
+	)
+
+	draft, configs, err = svc.loadCatalogSnapshot(catalogId)
+	if err = service.HandleDatabaseError(err, service.ErrNotFound("catalog")); err != nil {
+		return nil, err
+	}
+
+	if draft.GetStatus() == orchestrator.CatalogStatus_CATALOG_STATUS_PUBLISHED {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("published catalogs cannot be published again"))
+	}
+
+	// The changelog compares the new version to the previous one, if the catalog was published before
+	if draft.Version > 0 {
+		previous, _, err = svc.loadCatalogSnapshot(catalogVersionId(draft.Id, draft.Version))
+		if errors.Is(err, persistence.ErrRecordNotFound) {
+			previous = nil
+		} else if err = service.HandleDatabaseError(err); err != nil {
+			return nil, err
+		}
+	}
+
+	published = copyCatalog(draft, catalogVersionId(draft.Id, draft.Version+1))
+	published.Status = orchestrator.CatalogStatus_CATALOG_STATUS_PUBLISHED
+	published.Version = draft.Version + 1
+	published.DraftId = &draft.Id
+	published.ContentHash = new(catalogContentHash(published))
+
+	changelog = newCatalogChangelog(draft.Id, orchestrator.CatalogChangelogTrigger_CATALOG_CHANGELOG_TRIGGER_PUBLISHED,
+		previous, published)
+	changelog.VersionId = &published.Id
+	if previous != nil {
+		changelog.PreviousVersionId = &previous.Id
+	}
+	renderCatalogChangelog(changelog)
+
+	// Persist the snapshot and mark the source catalog as its draft
+	err = svc.db.Transaction(func(tx persistence.DB) error {
+		if err = tx.Create(published); err != nil {
+			return err
+		}
+
+		if err = createCatalogMetricConfigurations(tx, configs, published.Id); err != nil {
+			return err
+		}
+
+		if err = copyControlMetricWeights(tx, draft, published); err != nil {
+			return err
+		}
+
+		if err = copyAssuranceLevels(tx, draft.Id, published.Id); err != nil {
+			return err
+		}
+
+		if err = tx.Create(changelog); err != nil {
+			return err
+		}
+
+		return tx.Update(&orchestrator.Catalog{
+			Status:  orchestrator.CatalogStatus_CATALOG_STATUS_DRAFT,
+			Version: published.Version,
+		}, "id = ?", draft.Id)
+	})
+	if err = service.HandleDatabaseError(err); err != nil {
+		return nil, err
+	}
+
+	slog.Info("Published catalog",
+		slog.String("catalog_id", draft.Id),
+		slog.String("version_id", published.Id),
+		slog.Int("version", int(published.Version)),
+	)
+
+	// Notify subscribers
+	go svc.publishEvent(&orchestrator.ChangeEvent{
+		Timestamp:   timestamppb.Now(),
+		Category:    orchestrator.EventCategory_EVENT_CATEGORY_CATALOG,
+		RequestType: orchestrator.RequestType_REQUEST_TYPE_PUBLISHED,
+		EntityId:    published.Id,
+		Entity: &orchestrator.ChangeEvent_Catalog{
+			Catalog: published,
+		},
+	})
+
+	return published, nil
+}
+
+// DiscardCatalogDraft reverts the draft of a catalog to its latest published version. All changes made to the draft
+// since it was published, including its default metric configurations, assurance levels and applicability rules, are
+// discarded.
+func (svc *Service) DiscardCatalogDraft(
+	ctx context.Context,
+	req *connect.Request[orchestrator.DiscardCatalogDraftRequest],
+) (res *connect.Response[orchestrator.Catalog], err error) {
+	var (
+		draft     orchestrator.Catalog
+		published *orchestrator.Catalog
+		restored  *orchestrator.Catalog
+		configs   []*assessment.CatalogMetricConfiguration
+		allowed   bool
+	)
+
+	// Validate the request
+	if err = service.Validate(req); err != nil {
+		return nil, err
+	}
+
+	// Check access via the configured auth strategy
+	allowed, _, err = CheckAccess(ctx, svc.authz, svc, orchestrator.RequestType_REQUEST_TYPE_UPDATED, "", orchestrator.ObjectType_OBJECT_TYPE_CATALOG)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if !allowed {
+		return nil, service.ErrPermissionDenied
+	}
+
+	err = svc.db.Get(&draft, persistence.WithoutPreload(), "id = ?", req.Msg.CatalogId)
+	if err = service.HandleDatabaseError(err, service.ErrNotFound("catalog")); err != nil {
+		return nil, err
+	}
+
+	if draft.GetStatus() != orchestrator.CatalogStatus_CATALOG_STATUS_DRAFT || draft.GetVersion() == 0 {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("catalog is not a draft of a published catalog"))
+	}
+
+	published, configs, err = svc.loadCatalogSnapshot(catalogVersionId(draft.Id, draft.Version))
+	if err = service.HandleDatabaseError(err, service.ErrNotFound("published catalog")); err != nil {
+		return nil, err
+	}
+
+	restored = copyCatalog(published, draft.Id)
+	restored.Status = orchestrator.CatalogStatus_CATALOG_STATUS_DRAFT
+	restored.Version = draft.Version
+
+	// Replace the draft, including its categories, controls and default metric configurations, with a copy of the
+	// published version
+	err = svc.db.Transaction(func(tx persistence.DB) error {
+		if err = deleteCatalogControls(tx, draft.Id); err != nil {
+			return err
+		}
+
+		for _, r := range []any{
+			&assessment.CatalogMetricConfiguration{},
+			&orchestrator.AssuranceLevel{},
+			&orchestrator.ApplicabilityRule{},
+		} {
+			err = tx.Delete(r, "catalog_id = ?", draft.Id)
+			if err != nil && !errors.Is(err, persistence.ErrRecordNotFound) {
+				return err
+			}
+		}
+
+		if err = tx.Delete(&orchestrator.Catalog{}, "id = ?", draft.Id); err != nil {
+			return err
+		}
+
+		if err = recordControlTextVersions(tx, restored); err != nil {
+			return err
+		}
+
+		if err = tx.Create(restored); err != nil {
+			return err
+		}
+
+		if err = copyControlMetricWeights(tx, published, restored); err != nil {
+			return err
+		}
+
+		if err = copyAssuranceLevels(tx, published.Id, restored.Id); err != nil {
+			return err
+		}
+
+		return createCatalogMetricConfigurations(tx, configs, restored.Id)
+	})
+	if err = service.HandleDatabaseError(err); err != nil {
+		return nil, err
+	}
+
+	// Notify subscribers
+	go svc.publishEvent(&orchestrator.ChangeEvent{
+		Timestamp:   timestamppb.Now(),
+		Category:    orchestrator.EventCategory_EVENT_CATEGORY_CATALOG,
+		RequestType: orchestrator.RequestType_REQUEST_TYPE_UPDATED,
+		EntityId:    restored.Id,
+		Entity: &orchestrator.ChangeEvent_Catalog{
+			Catalog: restored,
+		},
+	})
+
+	res = connect.NewResponse(restored)
+	return
+}
+
 // GetCategory retrieves a category of a catalog specified by the catalog ID and the
 // category name. It includes the first level of controls within each
 // category.
@@ -404,6 +716,180 @@ func (svc *Service) GetControl(
 	return
 }
 
+// notifyCatalogUpdated publishes a change event for the catalog with the given ID after its controls, metric mappings or
+// assurance levels changed, so that subscribers, such as the control cache of the evaluation, do not act on stale
+// controls.
+func (svc *Service) notifyCatalogUpdated(catalogId string) {
+	var (
+		catalog orchestrator.Catalog
+		err     error
+	)
+
+	err = svc.db.Get(&catalog, persistence.WithoutPreload(), "id = ?", catalogId)
+	if err != nil {
+		slog.Warn("Could not notify subscribers about updated catalog", slog.String("catalog_id", catalogId), log.Err(err))
+		return
+	}
+
+	go svc.publishEvent(&orchestrator.ChangeEvent{
+		Timestamp:   timestamppb.Now(),
+		Category:    orchestrator.EventCategory_EVENT_CATEGORY_CATALOG,
+		RequestType: orchestrator.RequestType_REQUEST_TYPE_UPDATED,
+		EntityId:    catalog.Id,
+		Entity: &orchestrator.ChangeEvent_Catalog{
+			Catalog: &catalog,
+		},
+	})
+}
+
+// checkCatalogNotPublished returns an error with [connect.CodeFailedPrecondition] if the catalog with the given ID is
+// a published (and therefore immutable) catalog version. Otherwise, [persistence.ErrRecordNotFound] is returned, since
+// this function is only used after an update did not match any editable catalog.
+func (svc *Service) checkCatalogNotPublished(catalogId string) (err error) {
+	var count int64
+
+	count, err = svc.db.Count(&orchestrator.Catalog{}, "id = ? AND status = ?", catalogId, orchestrator.CatalogStatus_CATALOG_STATUS_PUBLISHED)
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		return connect.NewError(connect.CodeFailedPrecondition, errors.New("published catalogs cannot be modified"))
+	}
+
+	return persistence.ErrRecordNotFound
+}
+
+// loadCatalogSnapshot loads a catalog with its full control tree, including sub-controls and their metrics, as well
+// as its default metric configurations.
+func (svc *Service) loadCatalogSnapshot(catalogId string) (catalog *orchestrator.Catalog, configs []*assessment.CatalogMetricConfiguration, err error) {
+	var (
+		controls []*orchestrator.Control
+		nodes    map[string]*orchestrator.Control
+	)
+
+	catalog = new(orchestrator.Catalog)
+	err = svc.db.Get(catalog,
+		persistence.WithPreload("Categories.Controls", "parent_control_id IS NULL"),
+		persistence.WithPreload("ApplicabilityRules"),
+		"id = ?", catalogId)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	err = svc.db.List(&controls, "short_name", true, 0, -1, persistence.WithPreload("Metrics"), "catalog_id = ?", catalogId)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Re-assemble the control tree out of the flat list of controls
+	nodes = make(map[string]*orchestrator.Control, len(controls))
+	for _, control := range controls {
+		nodes[control.Id] = control
+	}
+	for _, control := range controls {
+		if parent, ok := nodes[control.GetParentControlId()]; ok {
+			parent.Controls = append(parent.Controls, control)
+		}
+	}
+	for _, category := range catalog.Categories {
+		for i, control := range category.Controls {
+			if node, ok := nodes[control.Id]; ok {
+				category.Controls[i] = node
+			}
+		}
+	}
+
+	// Use WithoutPreload because CatalogMetricConfiguration contains structpb.Value which has unexported fields
+	err = svc.db.List(&configs, "metric_id", true, 0, -1, persistence.WithoutPreload(), "catalog_id = ?", catalogId)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return catalog, configs, nil
+}
+
+// deleteCatalogControls deletes the categories and controls of a catalog, including the mapping of the controls to
+// their metrics.
+func deleteCatalogControls(tx persistence.DB, catalogId string) (err error) {
+	var controlIds []string
+
+	if err = tx.Pluck(&orchestrator.Control{}, "id", &controlIds, "catalog_id = ?", catalogId); err != nil {
+		return err
+	}
+
+	if len(controlIds) > 0 {
+		err = tx.Delete(&orchestrator.ControlMetric{}, "control_id IN ?", controlIds)
+		if err != nil && !errors.Is(err, persistence.ErrRecordNotFound) {
+			return err
+		}
+	}
+
+	err = tx.Delete(&orchestrator.Control{}, "catalog_id = ?", catalogId)
+	if err != nil && !errors.Is(err, persistence.ErrRecordNotFound) {
+		return err
+	}
+
+	err = tx.Delete(&orchestrator.Category{}, "catalog_id = ?", catalogId)
+	if err != nil && !errors.Is(err, persistence.ErrRecordNotFound) {
+		return err
+	}
+
+	return nil
+}
+
+// copyCatalog returns a deep copy of the given catalog with the new catalog ID. All controls of the copy receive new
+// IDs and only reference their metrics by ID.
+func copyCatalog(catalog *orchestrator.Catalog, catalogId string) (c *orchestrator.Catalog) {
+	c = proto.Clone(catalog).(*orchestrator.Catalog)
+	c.Id = catalogId
+	c.DraftId = nil
+
+	for _, category := range c.Categories {
+		category.CatalogId = catalogId
+		resetControls(category.Controls)
+	}
+	for _, rule := range c.ApplicabilityRules {
+		rule.CatalogId = catalogId
+	}
+	normalizeCatalogControls(c)
+
+	return c
+}
+
+// resetControls recursively clears the IDs of the given controls, so that [normalizeControls] assigns new ones, and
+// reduces their metrics to references.
+func resetControls(controls []*orchestrator.Control) {
+	for _, control := range controls {
+		control.Id = ""
+		control.ControlsInScope = nil
+		for i, metric := range control.Metrics {
+			control.Metrics[i] = &assessment.Metric{Id: metric.GetId()}
+		}
+
+		resetControls(control.Controls)
+	}
+}
+
+// catalogVersionId returns the ID of the given published version of a catalog.
+func catalogVersionId(catalogId string, version int32) string {
+	return fmt.Sprintf("%s@v%d", catalogId, version)
+}
+
+// createCatalogMetricConfigurations stores copies of the given default metric configurations for the catalog with the
+// given ID.
+func createCatalogMetricConfigurations(tx persistence.DB, configs []*assessment.CatalogMetricConfiguration, catalogId string) (err error) {
+	for _, config := range configs {
+		config = proto.Clone(config).(*assessment.CatalogMetricConfiguration)
+		config.CatalogId = catalogId
+
+		if err = tx.Create(config); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // loadCatalogs loads catalog definitions from configured sources.
 // It loads catalogs from:
 // 1. DefaultCatalogsPath (if LoadDefaultCatalogs is true)
@@ -435,7 +921,7 @@ func (svc *Service) loadCatalogs() (err error) {
 	// Save all catalogs to DB (only if we have any)
 	if len(catalogs) > 0 {
 		for _, catalog := range catalogs {
-			err = svc.db.Create(catalog)
+			err = createCatalog(svc.db, catalog)
 			if err != nil {
 				slog.Error("Catalog exists already", slog.String("catalog_id", catalog.GetId()), slog.String("name", catalog.GetName()), log.Err(err))
 				// Continue to next catalog instead of returning error
@@ -22,6 +22,7 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"confirmate.io/core/api/assessment"
 	"confirmate.io/core/api/orchestrator"
@@ -34,6 +35,7 @@ import (
 
 	"connectrpc.com/connect"
 	"github.com/google/uuid"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
@@ -90,8 +92,18 @@ func TestService_CreateCatalog(t *testing.T) {
 				authz: &service.AuthorizationStrategyPermissionStore{},
 			},
 			want: func(t *testing.T, got *connect.Response[orchestrator.Catalog], args ...any) bool {
-				want := orchestratortest.MockCatalog1
+				want := proto.Clone(orchestratortest.MockCatalog1).(*orchestrator.Catalog)
+				want.Status = orchestrator.CatalogStatus_CATALOG_STATUS_DRAFT
 				normalizeCatalogControls(want)
+				// All controls start with their first text version
+				for _, category := range want.Categories {
+					for _, control := range category.Controls {
+						control.TextVersion = 1
+						for _, sub := range control.Controls {
+							sub.TextVersion = 1
+						}
+					}
+				}
 				return assert.NotNil(t, got.Msg) &&
 					assert.Equal(t, want, got.Msg)
 			},
@@ -560,6 +572,31 @@ func TestService_UpdateCatalog(t *testing.T) {
 				return assert.IsConnectError(t, err, connect.CodeNotFound)
 			},
 		},
+		{
+			name: "published catalog",
+			args: args{
+				req: &orchestrator.UpdateCatalogRequest{
+					Catalog: &orchestrator.Catalog{
+						Id:   "catalog-1@v1",
+						Name: "Updated Catalog",
+					},
+				},
+			},
+			fields: fields{
+				db: persistencetest.NewInMemoryDB(t, types, joinTables, func(d persistence.DB) {
+					assert.NoError(t, d.Create(&orchestrator.Catalog{
+						Id:     "catalog-1@v1",
+						Name:   orchestratortest.MockCatalogName1,
+						Status: orchestrator.CatalogStatus_CATALOG_STATUS_PUBLISHED,
+					}))
+				}),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			want: assert.Nil[*connect.Response[orchestrator.Catalog]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeFailedPrecondition)
+			},
+		},
 		{
 			name: "db error - constraint",
 			args: args{
@@ -685,6 +722,25 @@ func TestService_RemoveCatalog(t *testing.T) {
 					assert.ErrorContains(t, err, "invalid request")
 			},
 		},
+		{
+			name: "bound by audit scope",
+			args: args{
+				req: &orchestrator.RemoveCatalogRequest{
+					CatalogId: orchestratortest.MockCatalog1.Id,
+				},
+			},
+			fields: fields{
+				db: persistencetest.NewInMemoryDB(t, types, joinTables, func(d persistence.DB) {
+					assert.NoError(t, d.Create(orchestratortest.MockCatalog1))
+					assert.NoError(t, d.Create(orchestratortest.MockAuditScope1))
+				}),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			want: assert.Nil[*connect.Response[emptypb.Empty]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeFailedPrecondition)
+			},
+		},
 		{
 			name: "db error - not found",
 			args: args{
@@ -716,6 +772,305 @@ func TestService_RemoveCatalog(t *testing.T) {
 	}
 }
 
+func TestService_PublishCatalog(t *testing.T) {
+	type args struct {
+		req *orchestrator.PublishCatalogRequest
+	}
+	type fields struct {
+		db    persistence.DB
+		authz service.AuthorizationStrategy
+	}
+	tests := []struct {
+		name    string
+		args    args
+		fields  fields
+		want    assert.Want[*connect.Response[orchestrator.Catalog]]
+		wantErr assert.WantErr
+		wantDB  assert.Want[persistence.DB]
+	}{
+		{
+			name: "validation error - empty request",
+			args: args{
+				req: &orchestrator.PublishCatalogRequest{},
+			},
+			fields: fields{
+				db: persistencetest.NewInMemoryDB(t, types, joinTables),
+			},
+			want: assert.Nil[*connect.Response[orchestrator.Catalog]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeInvalidArgument)
+			},
+			wantDB: assert.NotNil[persistence.DB],
+		},
+		{
+			name: "authorization error",
+			args: args{
+				req: &orchestrator.PublishCatalogRequest{CatalogId: orchestratortest.MockCatalogId1},
+			},
+			fields: fields{
+				db:    persistencetest.NewInMemoryDB(t, types, joinTables),
+				authz: &service.AuthorizationStrategyPermissionStore{},
+			},
+			want: assert.Nil[*connect.Response[orchestrator.Catalog]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodePermissionDenied)
+			},
+			wantDB: assert.NotNil[persistence.DB],
+		},
+		{
+			name: "not found",
+			args: args{
+				req: &orchestrator.PublishCatalogRequest{CatalogId: "non-existent"},
+			},
+			fields: fields{
+				db:    persistencetest.NewInMemoryDB(t, types, joinTables),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			want: assert.Nil[*connect.Response[orchestrator.Catalog]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeNotFound)
+			},
+			wantDB: assert.NotNil[persistence.DB],
+		},
+		{
+			name: "published catalog",
+			args: args{
+				req: &orchestrator.PublishCatalogRequest{CatalogId: orchestratortest.MockCatalogId1},
+			},
+			fields: fields{
+				db: persistencetest.NewInMemoryDB(t, types, joinTables, func(d persistence.DB) {
+					assert.NoError(t, d.Create(&orchestrator.Catalog{
+						Id:     orchestratortest.MockCatalogId1,
+						Name:   orchestratortest.MockCatalogName1,
+						Status: orchestrator.CatalogStatus_CATALOG_STATUS_PUBLISHED,
+					}))
+				}),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			want: assert.Nil[*connect.Response[orchestrator.Catalog]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeFailedPrecondition)
+			},
+			wantDB: assert.NotNil[persistence.DB],
+		},
+		{
+			name: "happy path",
+			args: args{
+				req: &orchestrator.PublishCatalogRequest{CatalogId: orchestratortest.MockCatalogId1},
+			},
+			fields: fields{
+				db: persistencetest.NewInMemoryDB(t, types, joinTables, func(d persistence.DB) {
+					assert.NoError(t, d.Create(orchestratortest.MockCatalog1))
+					assert.NoError(t, d.Create(orchestratortest.MockCatalogMetricConfiguration1))
+				}),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			want: func(t *testing.T, got *connect.Response[orchestrator.Catalog], args ...any) bool {
+				return assert.Equal(t, "catalog-1@v1", got.Msg.Id) &&
+					assert.Equal(t, orchestratortest.MockCatalogName1, got.Msg.Name) &&
+					assert.Equal(t, orchestrator.CatalogStatus_CATALOG_STATUS_PUBLISHED, got.Msg.Status) &&
+					assert.Equal(t, int32(1), got.Msg.Version) &&
+					assert.Equal(t, orchestratortest.MockCatalogId1, got.Msg.GetDraftId()) &&
+					assert.Equal(t, catalogContentHash(orchestratortest.MockCatalog1), got.Msg.GetContentHash()) &&
+					assert.Equal(t, 2, len(got.Msg.Categories)) &&
+					assert.NotEqual(t, orchestratortest.MockControlId1, got.Msg.Categories[0].Controls[0].Id) &&
+					assert.Equal(t, 2, len(got.Msg.Categories[0].Controls[0].Controls)) &&
+					assert.Equal(t, got.Msg.Categories[0].Controls[0].Id, got.Msg.Categories[0].Controls[0].Controls[0].GetParentControlId())
+			},
+			wantErr: assert.NoError,
+			wantDB: func(t *testing.T, db persistence.DB, args ...any) bool {
+				var (
+					draft     orchestrator.Catalog
+					configs   []*assessment.CatalogMetricConfiguration
+					changelog orchestrator.CatalogChangelog
+				)
+
+				assert.NoError(t, db.Get(&draft, persistence.WithoutPreload(), "id = ?", orchestratortest.MockCatalogId1))
+				assert.NoError(t, db.List(&configs, "metric_id", true, 0, -1, persistence.WithoutPreload(), "catalog_id = ?", "catalog-1@v1"))
+
+				// The first version has no previous version, so all of its controls are added. Controls are identified by
+				// their short names, which two of the sub-controls share.
+				assert.NoError(t, db.Get(&changelog, "catalog_id = ?", orchestratortest.MockCatalogId1))
+				assert.Equal(t, "catalog-1@v1", changelog.GetVersionId())
+				assert.Nil(t, changelog.PreviousVersionId)
+				assert.Equal(t, 4, len(changelog.Changes))
+
+				controls, err := db.Count(&orchestrator.Control{}, "catalog_id = ?", "catalog-1@v1")
+				assert.NoError(t, err)
+
+				return assert.Equal(t, orchestrator.CatalogStatus_CATALOG_STATUS_DRAFT, draft.Status) &&
+					assert.Equal(t, int32(1), draft.Version) &&
+					assert.Equal(t, int64(5), controls) &&
+					assert.Equal(t, 1, len(configs))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &Service{
+				db:    tt.fields.db,
+				authz: tt.fields.authz,
+			}
+			res, err := svc.PublishCatalog(context.Background(), connect.NewRequest(tt.args.req))
+			tt.want(t, res)
+			tt.wantErr(t, err)
+			tt.wantDB(t, tt.fields.db)
+		})
+	}
+}
+
+func TestService_DiscardCatalogDraft(t *testing.T) {
+	var (
+		draft     *orchestrator.Catalog
+		published *orchestrator.Catalog
+	)
+
+	// The published version has the original content of the mock catalog, whereas the draft has been edited since
+	published = copyCatalog(orchestratortest.MockCatalog1, "catalog-1@v1")
+	published.Status = orchestrator.CatalogStatus_CATALOG_STATUS_PUBLISHED
+	published.Version = 1
+	published.DraftId = new(orchestratortest.MockCatalogId1)
+
+	draft = copyCatalog(orchestratortest.MockCatalog1, orchestratortest.MockCatalogId1)
+	draft.Name = "Edited Catalog"
+	draft.Status = orchestrator.CatalogStatus_CATALOG_STATUS_DRAFT
+	draft.Version = 1
+	draft.Categories[0].Controls = append(draft.Categories[0].Controls, &orchestrator.Control{
+		Id:        uuid.NewString(),
+		Name:      "Added Control",
+		ShortName: "ADDED-01",
+		CatalogId: orchestratortest.MockCatalogId1,
+	})
+
+	type args struct {
+		req *orchestrator.DiscardCatalogDraftRequest
+	}
+	type fields struct {
+		db    persistence.DB
+		authz service.AuthorizationStrategy
+	}
+	tests := []struct {
+		name    string
+		args    args
+		fields  fields
+		want    assert.Want[*connect.Response[orchestrator.Catalog]]
+		wantErr assert.WantErr
+		wantDB  assert.Want[persistence.DB]
+	}{
+		{
+			name: "validation error - empty request",
+			args: args{
+				req: &orchestrator.DiscardCatalogDraftRequest{},
+			},
+			fields: fields{
+				db: persistencetest.NewInMemoryDB(t, types, joinTables),
+			},
+			want: assert.Nil[*connect.Response[orchestrator.Catalog]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeInvalidArgument)
+			},
+			wantDB: assert.NotNil[persistence.DB],
+		},
+		{
+			name: "authorization error",
+			args: args{
+				req: &orchestrator.DiscardCatalogDraftRequest{CatalogId: orchestratortest.MockCatalogId1},
+			},
+			fields: fields{
+				db:    persistencetest.NewInMemoryDB(t, types, joinTables),
+				authz: &service.AuthorizationStrategyPermissionStore{},
+			},
+			want: assert.Nil[*connect.Response[orchestrator.Catalog]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodePermissionDenied)
+			},
+			wantDB: assert.NotNil[persistence.DB],
+		},
+		{
+			name: "not found",
+			args: args{
+				req: &orchestrator.DiscardCatalogDraftRequest{CatalogId: "non-existent"},
+			},
+			fields: fields{
+				db:    persistencetest.NewInMemoryDB(t, types, joinTables),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			want: assert.Nil[*connect.Response[orchestrator.Catalog]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeNotFound)
+			},
+			wantDB: assert.NotNil[persistence.DB],
+		},
+		{
+			name: "never published",
+			args: args{
+				req: &orchestrator.DiscardCatalogDraftRequest{CatalogId: orchestratortest.MockCatalogId1},
+			},
+			fields: fields{
+				db: persistencetest.NewInMemoryDB(t, types, joinTables, func(d persistence.DB) {
+					assert.NoError(t, d.Create(&orchestrator.Catalog{
+						Id:     orchestratortest.MockCatalogId1,
+						Name:   orchestratortest.MockCatalogName1,
+						Status: orchestrator.CatalogStatus_CATALOG_STATUS_DRAFT,
+					}))
+				}),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			want: assert.Nil[*connect.Response[orchestrator.Catalog]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeFailedPrecondition)
+			},
+			wantDB: assert.NotNil[persistence.DB],
+		},
+		{
+			name: "happy path",
+			args: args{
+				req: &orchestrator.DiscardCatalogDraftRequest{CatalogId: orchestratortest.MockCatalogId1},
+			},
+			fields: fields{
+				db: persistencetest.NewInMemoryDB(t, types, joinTables, func(d persistence.DB) {
+					assert.NoError(t, d.Create(published))
+					assert.NoError(t, d.Create(draft))
+				}),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			want: func(t *testing.T, got *connect.Response[orchestrator.Catalog], args ...any) bool {
+				return assert.Equal(t, orchestratortest.MockCatalogId1, got.Msg.Id) &&
+					assert.Equal(t, orchestratortest.MockCatalogName1, got.Msg.Name) &&
+					assert.Equal(t, orchestrator.CatalogStatus_CATALOG_STATUS_DRAFT, got.Msg.Status) &&
+					assert.Equal(t, int32(1), got.Msg.Version) &&
+					assert.Nil(t, got.Msg.DraftId)
+			},
+			wantErr: assert.NoError,
+			wantDB: func(t *testing.T, db persistence.DB, args ...any) bool {
+				var catalog orchestrator.Catalog
+
+				assert.NoError(t, db.Get(&catalog, persistence.WithoutPreload(), "id = ?", orchestratortest.MockCatalogId1))
+
+				controls, err := db.Count(&orchestrator.Control{}, "catalog_id = ?", orchestratortest.MockCatalogId1)
+				assert.NoError(t, err)
+
+				return assert.Equal(t, orchestratortest.MockCatalogName1, catalog.Name) &&
+					assert.Equal(t, int64(5), controls)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &Service{
+				db:    tt.fields.db,
+				authz: tt.fields.authz,
+			}
+			res, err := svc.DiscardCatalogDraft(context.Background(), connect.NewRequest(tt.args.req))
+			tt.want(t, res)
+			tt.wantErr(t, err)
+			tt.wantDB(t, tt.fields.db)
+		})
+	}
+}
+
 func TestService_GetCategory(t *testing.T) {
 	type args struct {
 		req *orchestrator.GetCategoryRequest
@@ -1600,3 +1955,69 @@ func TestService_loadCatalogsFromFolder(t *testing.T) {
 		})
 	}
 }
+
+func TestService_notifyCatalogUpdated(t *testing.T) {
+	type args struct {
+		catalogId string
+	}
+	type fields struct {
+		db persistence.DB
+	}
+	tests := []struct {
+		name   string
+		args   args
+		fields fields
+		want   assert.Want[*orchestrator.ChangeEvent]
+	}{
+		{
+			name: "catalog not found",
+			args: args{
+				catalogId: orchestratortest.MockCatalogId1,
+			},
+			fields: fields{
+				db: persistencetest.NewInMemoryDB(t, types, joinTables),
+			},
+			want: assert.Nil[*orchestrator.ChangeEvent],
+		},
+		{
+			name: "happy path",
+			args: args{
+				catalogId: orchestratortest.MockCatalogId1,
+			},
+			fields: fields{
+				db: persistencetest.NewInMemoryDB(t, types, joinTables, func(d persistence.DB) {
+					assert.NoError(t, d.Create(orchestratortest.MockCatalog1))
+				}),
+			},
+			want: func(t *testing.T, got *orchestrator.ChangeEvent, msgAndArgs ...any) bool {
+				return assert.Equal(t, orchestrator.EventCategory_EVENT_CATEGORY_CATALOG, got.GetCategory()) &&
+					assert.Equal(t, orchestrator.RequestType_REQUEST_TYPE_UPDATED, got.GetRequestType()) &&
+					assert.Equal(t, orchestratortest.MockCatalogId1, got.GetEntityId()) &&
+					assert.Equal(t, orchestratortest.MockCatalogId1, got.GetCatalog().GetId())
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got *orchestrator.ChangeEvent
+
+			svc := &Service{
+				db:          tt.fields.db,
+				subscribers: make(map[int64]*subscriber),
+			}
+
+			ch, id := svc.RegisterSubscriber(nil)
+			defer svc.UnregisterSubscriber(id)
+
+			svc.notifyCatalogUpdated(tt.args.catalogId)
+
+			select {
+			case got = <-ch:
+			case <-time.After(100 * time.Millisecond):
+			}
+
+			tt.want(t, got)
+		})
+	}
+}
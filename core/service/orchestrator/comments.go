@@ -0,0 +1,207 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package orchestrator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"confirmate.io/core/api/evaluation"
+	"confirmate.io/core/api/orchestrator"
+	"confirmate.io/core/persistence"
+	"confirmate.io/core/service"
+
+	"buf.build/go/protovalidate"
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// AddEvaluationResultComment adds a comment to an evaluation result. Since comments capture discussions, e.g., about
+// the remediation of a finding, every user with read access to the audit scope of the evaluation result may comment on
+// it.
+func (svc *Service) AddEvaluationResultComment(
+	ctx context.Context,
+	req *connect.Request[orchestrator.AddEvaluationResultCommentRequest],
+) (res *connect.Response[evaluation.Comment], err error) {
+	var (
+		comment *evaluation.Comment
+		parent  evaluation.Comment
+	)
+
+	// Validate the request. The ID of the comment is generated by us.
+	if err = service.Validate(req, protovalidate.WithFilter(service.IgnoreIDFilter)); err != nil {
+		return nil, err
+	}
+
+	// Check access via the configured auth strategy
+	if err = svc.checkEvaluationResultAccess(ctx, orchestrator.RequestType_REQUEST_TYPE_GET, req.Msg.Comment.GetEvaluationResultId()); err != nil {
+		return nil, err
+	}
+
+	comment = &evaluation.Comment{
+		Id:                 uuid.NewString(),
+		EvaluationResultId: req.Msg.Comment.GetEvaluationResultId(),
+		Author:             actorFromContext(ctx),
+		CreatedAt:          timestamppb.Now(),
+		Text:               req.Msg.Comment.GetText(),
+		Mentions:           req.Msg.Comment.GetMentions(),
+	}
+
+	// Replies are always attached to the first comment of a thread, so that threads do not nest
+	if req.Msg.Comment.ParentId != nil {
+		err = svc.db.Get(&parent, "id = ? AND evaluation_result_id = ?", req.Msg.Comment.GetParentId(), comment.EvaluationResultId)
+		if errors.Is(err, persistence.ErrRecordNotFound) {
+			return nil, service.NewInvalidFieldError("comment.parent_id",
+				errors.New("parent comment does not exist for this evaluation result"))
+		} else if err = service.HandleDatabaseError(err); err != nil {
+			return nil, err
+		}
+
+		if parent.ParentId != nil {
+			comment.ParentId = parent.ParentId
+		} else {
+			comment.ParentId = &parent.Id
+		}
+	}
+
+	if err = svc.checkMentionedUsers(comment.Mentions); err != nil {
+		return nil, err
+	}
+
+	err = svc.db.Create(comment)
+	if err = service.HandleDatabaseError(err); err != nil {
+		return nil, err
+	}
+
+	res = connect.NewResponse(comment)
+	return
+}
+
+// ListEvaluationResultComments lists the comments of an evaluation result. Unless requested otherwise, the comments
+// are ordered by the time they were written.
+func (svc *Service) ListEvaluationResultComments(
+	ctx context.Context,
+	req *connect.Request[orchestrator.ListEvaluationResultCommentsRequest],
+) (res *connect.Response[orchestrator.ListEvaluationResultCommentsResponse], err error) {
+	var (
+		comments []*evaluation.Comment
+		npt      string
+	)
+
+	// Validate the request
+	if err = service.Validate(req); err != nil {
+		return nil, err
+	}
+
+	// Check access via the configured auth strategy
+	if err = svc.checkEvaluationResultAccess(ctx, orchestrator.RequestType_REQUEST_TYPE_GET, req.Msg.GetEvaluationResultId()); err != nil {
+		return nil, err
+	}
+
+	// Set default ordering
+	if req.Msg.OrderBy == "" {
+		req.Msg.OrderBy = "created_at"
+		req.Msg.Asc = true
+	}
+
+	comments, npt, err = service.PaginateStorage[*evaluation.Comment](req.Msg, svc.db, service.DefaultPaginationOpts,
+		"evaluation_result_id = ?", req.Msg.GetEvaluationResultId())
+	if err = service.HandleDatabaseError(err); err != nil {
+		return nil, err
+	}
+
+	res = connect.NewResponse(&orchestrator.ListEvaluationResultCommentsResponse{
+		Comments:      comments,
+		NextPageToken: npt,
+	})
+	return
+}
+
+// RemoveEvaluationResultComment removes a comment including all of its replies. Authors may remove their own comments
+// as long as they can read the evaluation result, all other comments require update access to its audit scope.
+func (svc *Service) RemoveEvaluationResultComment(
+	ctx context.Context,
+	req *connect.Request[orchestrator.RemoveEvaluationResultCommentRequest],
+) (res *connect.Response[emptypb.Empty], err error) {
+	var (
+		comment evaluation.Comment
+		reqType = orchestrator.RequestType_REQUEST_TYPE_UPDATED
+	)
+
+	// Validate the request
+	if err = service.Validate(req); err != nil {
+		return nil, err
+	}
+
+	err = svc.db.Get(&comment, "id = ?", req.Msg.GetCommentId())
+	if err = service.HandleDatabaseError(err, service.ErrNotFound("comment")); err != nil {
+		return nil, err
+	}
+
+	if comment.GetAuthor() == actorFromContext(ctx) {
+		reqType = orchestrator.RequestType_REQUEST_TYPE_GET
+	}
+
+	// Check access via the configured auth strategy
+	if err = svc.checkEvaluationResultAccess(ctx, reqType, comment.GetEvaluationResultId()); err != nil {
+		return nil, err
+	}
+
+	// Remove the comment together with its replies
+	err = svc.db.Transaction(func(tx persistence.DB) error {
+		err = tx.Delete(&evaluation.Comment{}, "parent_id = ?", comment.GetId())
+		if err != nil && !errors.Is(err, persistence.ErrRecordNotFound) {
+			return err
+		}
+
+		return tx.Delete(&evaluation.Comment{}, "id = ?", comment.GetId())
+	})
+	if err = service.HandleDatabaseError(err); err != nil {
+		return nil, err
+	}
+
+	res = connect.NewResponse(&emptypb.Empty{})
+	return
+}
+
+// checkMentionedUsers checks whether all mentioned users exist. It returns a [connect.Error] naming the first unknown
+// user otherwise.
+func (svc *Service) checkMentionedUsers(mentions []string) (err error) {
+	var (
+		existing []string
+	)
+
+	if len(mentions) == 0 {
+		return nil
+	}
+
+	err = svc.db.Pluck(&orchestrator.User{}, "id", &existing, "id IN ?", mentions)
+	if err = service.HandleDatabaseError(err); err != nil {
+		return err
+	}
+
+	for _, id := range mentions {
+		if !slices.Contains(existing, id) {
+			return service.NewInvalidFieldError("comment.mentions", fmt.Errorf("mentioned user %q does not exist", id))
+		}
+	}
+
+	return nil
+}
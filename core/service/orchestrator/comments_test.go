@@ -0,0 +1,414 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package orchestrator
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"confirmate.io/core/api/evaluation"
+	"confirmate.io/core/api/orchestrator"
+	"confirmate.io/core/auth"
+	"confirmate.io/core/persistence"
+	"confirmate.io/core/persistence/persistencetest"
+	"confirmate.io/core/service"
+	"confirmate.io/core/service/evaluation/evaluationtest"
+	"confirmate.io/core/service/orchestrator/orchestratortest"
+	"confirmate.io/core/util/assert"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	mockCommentId1 = "00000000-0000-0000-0004-000000000001"
+	mockCommentId2 = "00000000-0000-0000-0004-000000000002"
+)
+
+// mockCommentRecords returns an evaluation result with a thread of two comments.
+func mockCommentRecords() []any {
+	return []any{
+		orchestratortest.MockUser1,
+		evaluationtest.MockEvaluationResult1,
+		&evaluation.Comment{
+			Id:                 mockCommentId1,
+			EvaluationResultId: evaluationtest.MockEvaluationResultId1,
+			Author:             orchestratortest.MockUser2.Id,
+			CreatedAt:          timestamppb.New(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)),
+			Text:               "Who takes care of this finding?",
+		},
+		&evaluation.Comment{
+			Id:                 mockCommentId2,
+			EvaluationResultId: evaluationtest.MockEvaluationResultId1,
+			ParentId:           new(mockCommentId1),
+			Author:             orchestratortest.MockUser2.Id,
+			CreatedAt:          timestamppb.New(time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC)),
+			Text:               "I will.",
+		},
+	}
+}
+
+func TestService_AddEvaluationResultComment(t *testing.T) {
+	type fields struct {
+		db    persistence.DB
+		authz service.AuthorizationStrategy
+	}
+	tests := []struct {
+		name    string
+		req     *orchestrator.AddEvaluationResultCommentRequest
+		fields  fields
+		want    assert.Want[*connect.Response[evaluation.Comment]]
+		wantErr assert.WantErr
+	}{
+		{
+			name: "happy path",
+			req: &orchestrator.AddEvaluationResultCommentRequest{
+				Comment: &evaluation.Comment{
+					EvaluationResultId: evaluationtest.MockEvaluationResultId1,
+					Text:               "Please have a look",
+					Mentions:           []string{orchestratortest.MockUser1.Id},
+				},
+			},
+			fields: fields{
+				db:    persistencetest.NewInMemoryDB(t, types, joinTables, createRecords(t, mockCommentRecords()...)),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			want: func(t *testing.T, got *connect.Response[evaluation.Comment], args ...any) bool {
+				return assert.NotEmpty(t, got.Msg.GetId()) &&
+					assert.NotNil(t, got.Msg.GetCreatedAt()) &&
+					assert.Nil(t, got.Msg.ParentId) &&
+					assert.Equal(t, []string{orchestratortest.MockUser1.Id}, got.Msg.GetMentions())
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "reply to a reply",
+			req: &orchestrator.AddEvaluationResultCommentRequest{
+				Comment: &evaluation.Comment{
+					EvaluationResultId: evaluationtest.MockEvaluationResultId1,
+					ParentId:           new(mockCommentId2),
+					Text:               "Thanks!",
+				},
+			},
+			fields: fields{
+				db:    persistencetest.NewInMemoryDB(t, types, joinTables, createRecords(t, mockCommentRecords()...)),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			want: func(t *testing.T, got *connect.Response[evaluation.Comment], args ...any) bool {
+				return assert.Equal(t, mockCommentId1, got.Msg.GetParentId())
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "validation error",
+			req: &orchestrator.AddEvaluationResultCommentRequest{
+				Comment: &evaluation.Comment{
+					EvaluationResultId: evaluationtest.MockEvaluationResultId1,
+				},
+			},
+			fields: fields{
+				db:    persistencetest.NewInMemoryDB(t, types, joinTables, createRecords(t, mockCommentRecords()...)),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			want: assert.Nil[*connect.Response[evaluation.Comment]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeInvalidArgument) &&
+					assert.HasFieldViolation(t, err, "comment.text")
+			},
+		},
+		{
+			name: "parent comment not found",
+			req: &orchestrator.AddEvaluationResultCommentRequest{
+				Comment: &evaluation.Comment{
+					EvaluationResultId: evaluationtest.MockEvaluationResultId1,
+					ParentId:           new("00000000-0000-0000-0004-000000000099"),
+					Text:               "Thanks!",
+				},
+			},
+			fields: fields{
+				db:    persistencetest.NewInMemoryDB(t, types, joinTables, createRecords(t, mockCommentRecords()...)),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			want: assert.Nil[*connect.Response[evaluation.Comment]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeInvalidArgument) &&
+					assert.HasFieldViolation(t, err, "comment.parent_id")
+			},
+		},
+		{
+			name: "mentioned user not found",
+			req: &orchestrator.AddEvaluationResultCommentRequest{
+				Comment: &evaluation.Comment{
+					EvaluationResultId: evaluationtest.MockEvaluationResultId1,
+					Text:               "Please have a look",
+					Mentions:           []string{"unknown"},
+				},
+			},
+			fields: fields{
+				db:    persistencetest.NewInMemoryDB(t, types, joinTables, createRecords(t, mockCommentRecords()...)),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			want: assert.Nil[*connect.Response[evaluation.Comment]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeInvalidArgument) &&
+					assert.HasFieldViolation(t, err, "comment.mentions")
+			},
+		},
+		{
+			name: "evaluation result not found",
+			req: &orchestrator.AddEvaluationResultCommentRequest{
+				Comment: &evaluation.Comment{
+					EvaluationResultId: "00000000-0000-0000-0000-000000000099",
+					Text:               "Please have a look",
+				},
+			},
+			fields: fields{
+				db:    persistencetest.NewInMemoryDB(t, types, joinTables, createRecords(t, mockCommentRecords()...)),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			want: assert.Nil[*connect.Response[evaluation.Comment]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeNotFound)
+			},
+		},
+		{
+			name: "permission denied",
+			req: &orchestrator.AddEvaluationResultCommentRequest{
+				Comment: &evaluation.Comment{
+					EvaluationResultId: evaluationtest.MockEvaluationResultId1,
+					Text:               "Please have a look",
+				},
+			},
+			fields: fields{
+				db:    persistencetest.NewInMemoryDB(t, types, joinTables, createRecords(t, mockCommentRecords()...)),
+				authz: &denyAuthorizationStrategy{},
+			},
+			want: assert.Nil[*connect.Response[evaluation.Comment]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodePermissionDenied)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &Service{
+				db:    tt.fields.db,
+				authz: tt.fields.authz,
+			}
+			got, gotErr := svc.AddEvaluationResultComment(context.Background(), connect.NewRequest(tt.req))
+
+			tt.want(t, got)
+			tt.wantErr(t, gotErr)
+		})
+	}
+}
+
+func TestService_ListEvaluationResultComments(t *testing.T) {
+	type fields struct {
+		db    persistence.DB
+		authz service.AuthorizationStrategy
+	}
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		req     *orchestrator.ListEvaluationResultCommentsRequest
+		fields  fields
+		want    assert.Want[*connect.Response[orchestrator.ListEvaluationResultCommentsResponse]]
+		wantErr assert.WantErr
+	}{
+		{
+			name: "happy path",
+			req: &orchestrator.ListEvaluationResultCommentsRequest{
+				EvaluationResultId: evaluationtest.MockEvaluationResultId1,
+			},
+			fields: fields{
+				db:    persistencetest.NewInMemoryDB(t, types, joinTables, createRecords(t, mockCommentRecords()...)),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			want: func(t *testing.T, got *connect.Response[orchestrator.ListEvaluationResultCommentsResponse], args ...any) bool {
+				return assert.Equal(t, 2, len(got.Msg.Comments)) &&
+					assert.Equal(t, mockCommentId1, got.Msg.Comments[0].GetId()) &&
+					assert.Equal(t, mockCommentId2, got.Msg.Comments[1].GetId())
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "validation error",
+			req:  &orchestrator.ListEvaluationResultCommentsRequest{},
+			fields: fields{
+				db:    persistencetest.NewInMemoryDB(t, types, joinTables, createRecords(t, mockCommentRecords()...)),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			want: assert.Nil[*connect.Response[orchestrator.ListEvaluationResultCommentsResponse]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeInvalidArgument)
+			},
+		},
+		{
+			name: "permission denied",
+			req: &orchestrator.ListEvaluationResultCommentsRequest{
+				EvaluationResultId: evaluationtest.MockEvaluationResultId1,
+			},
+			fields: fields{
+				db:    persistencetest.NewInMemoryDB(t, types, joinTables, createRecords(t, mockCommentRecords()...)),
+				authz: &denyAuthorizationStrategy{},
+			},
+			want: assert.Nil[*connect.Response[orchestrator.ListEvaluationResultCommentsResponse]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodePermissionDenied)
+			},
+		},
+		{
+			name: "permission denied: token restricted to other target of evaluation",
+			ctx: auth.WithClaims(context.Background(), &auth.OAuthClaims{
+				IsAdminToken:          true,
+				TargetOfEvaluationIds: []string{orchestratortest.MockToeId2},
+			}),
+			req: &orchestrator.ListEvaluationResultCommentsRequest{
+				EvaluationResultId: evaluationtest.MockEvaluationResultId1,
+			},
+			fields: fields{
+				db: persistencetest.NewInMemoryDB(t, types, joinTables, func(d persistence.DB) {
+					assert.NoError(t, d.Create(orchestratortest.MockAuditScope1))
+					assert.NoError(t, d.Create(evaluationtest.MockEvaluationResult1))
+				}),
+				authz: &service.AuthorizationStrategyPermissionStore{},
+			},
+			want: assert.Nil[*connect.Response[orchestrator.ListEvaluationResultCommentsResponse]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodePermissionDenied)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &Service{
+				db:    tt.fields.db,
+				authz: tt.fields.authz,
+			}
+			ctx := tt.ctx
+			if ctx == nil {
+				ctx = context.Background()
+			}
+			got, gotErr := svc.ListEvaluationResultComments(ctx, connect.NewRequest(tt.req))
+
+			tt.want(t, got)
+			tt.wantErr(t, gotErr)
+		})
+	}
+}
+
+func TestService_RemoveEvaluationResultComment(t *testing.T) {
+	type fields struct {
+		db    persistence.DB
+		authz service.AuthorizationStrategy
+	}
+	tests := []struct {
+		name    string
+		req     *orchestrator.RemoveEvaluationResultCommentRequest
+		fields  fields
+		want    assert.Want[*connect.Response[emptypb.Empty]]
+		wantDB  assert.Want[persistence.DB]
+		wantErr assert.WantErr
+	}{
+		{
+			name: "happy path: removes replies",
+			req: &orchestrator.RemoveEvaluationResultCommentRequest{
+				CommentId: mockCommentId1,
+			},
+			fields: fields{
+				db:    persistencetest.NewInMemoryDB(t, types, joinTables, createRecords(t, mockCommentRecords()...)),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			want: assert.NotNil[*connect.Response[emptypb.Empty]],
+			wantDB: func(t *testing.T, db persistence.DB, args ...any) bool {
+				n, err := db.Count(&evaluation.Comment{}, "evaluation_result_id = ?", evaluationtest.MockEvaluationResultId1)
+				assert.NoError(t, err)
+
+				return assert.Equal(t, int64(0), n)
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "happy path: reply only",
+			req: &orchestrator.RemoveEvaluationResultCommentRequest{
+				CommentId: mockCommentId2,
+			},
+			fields: fields{
+				db:    persistencetest.NewInMemoryDB(t, types, joinTables, createRecords(t, mockCommentRecords()...)),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			want: assert.NotNil[*connect.Response[emptypb.Empty]],
+			wantDB: func(t *testing.T, db persistence.DB, args ...any) bool {
+				n, err := db.Count(&evaluation.Comment{}, "id = ?", mockCommentId1)
+				assert.NoError(t, err)
+
+				return assert.Equal(t, int64(1), n)
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "comment not found",
+			req: &orchestrator.RemoveEvaluationResultCommentRequest{
+				CommentId: "00000000-0000-0000-0004-000000000099",
+			},
+			fields: fields{
+				db:    persistencetest.NewInMemoryDB(t, types, joinTables, createRecords(t, mockCommentRecords()...)),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			want:   assert.Nil[*connect.Response[emptypb.Empty]],
+			wantDB: assert.AnyValue[persistence.DB],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeNotFound)
+			},
+		},
+		{
+			name: "permission denied",
+			req: &orchestrator.RemoveEvaluationResultCommentRequest{
+				CommentId: mockCommentId1,
+			},
+			fields: fields{
+				db:    persistencetest.NewInMemoryDB(t, types, joinTables, createRecords(t, mockCommentRecords()...)),
+				authz: &denyAuthorizationStrategy{},
+			},
+			want: assert.Nil[*connect.Response[emptypb.Empty]],
+			wantDB: func(t *testing.T, db persistence.DB, args ...any) bool {
+				n, err := db.Count(&evaluation.Comment{}, "evaluation_result_id = ?", evaluationtest.MockEvaluationResultId1)
+				assert.NoError(t, err)
+
+				return assert.Equal(t, int64(2), n)
+			},
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodePermissionDenied)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &Service{
+				db:    tt.fields.db,
+				authz: tt.fields.authz,
+			}
+			got, gotErr := svc.RemoveEvaluationResultComment(context.Background(), connect.NewRequest(tt.req))
+
+			tt.want(t, got)
+			tt.wantDB(t, tt.fields.db)
+			tt.wantErr(t, gotErr)
+		})
+	}
+}
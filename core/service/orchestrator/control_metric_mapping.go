@@ -0,0 +1,325 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package orchestrator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+
+	"confirmate.io/core/api/assessment"
+	"confirmate.io/core/api/orchestrator"
+	"confirmate.io/core/persistence"
+	"confirmate.io/core/service"
+	"confirmate.io/core/util/spreadsheet"
+
+	"connectrpc.com/connect"
+)
+
+// Column names of an imported control-to-metric mapping.
+const (
+	mappingColumnControl = "control_id"
+	mappingColumnMetric  = "metric_id"
+	mappingColumnWeight  = "weight"
+)
+
+// ImportControlMetricMapping imports a mapping of controls to metrics from a spreadsheet. Each row of the spreadsheet
+// maps a control, referenced by its ID or short name, to a metric, optionally with a weight. All rows are validated
+// first and the resulting report is returned to the caller. The mapping is only applied if there are no issues and
+// the request is not a dry run.
+func (svc *Service) ImportControlMetricMapping(
+	ctx context.Context,
+	req *connect.Request[orchestrator.ImportControlMetricMappingRequest],
+) (res *connect.Response[orchestrator.ImportControlMetricMappingResponse], err error) {
+	var (
+		catalog  orchestrator.Catalog
+		rows     [][]string
+		mappings []*orchestrator.ControlMetric
+		report   *orchestrator.ImportControlMetricMappingResponse
+		allowed  bool
+	)
+
+	// Validate the request
+	if err = service.Validate(req); err != nil {
+		return nil, err
+	}
+
+	// Check access via the configured auth strategy
+	allowed, _, err = CheckAccess(ctx, svc.authz, svc, orchestrator.RequestType_REQUEST_TYPE_UPDATED, "", orchestrator.ObjectType_OBJECT_TYPE_CATALOG)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if !allowed {
+		return nil, service.ErrPermissionDenied
+	}
+
+	err = svc.db.Get(&catalog, persistence.WithoutPreload(), "id = ?", req.Msg.GetCatalogId())
+	if err = service.HandleDatabaseError(err, service.ErrNotFound("catalog")); err != nil {
+		return nil, err
+	}
+
+	if catalog.GetStatus() == orchestrator.CatalogStatus_CATALOG_STATUS_PUBLISHED {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("published catalogs cannot be modified"))
+	}
+
+	switch req.Msg.GetFormat() {
+	case orchestrator.MappingFileFormat_MAPPING_FILE_FORMAT_XLSX:
+		rows, err = spreadsheet.ReadXLSX(req.Msg.GetData())
+	default:
+		rows, err = spreadsheet.ReadCSV(req.Msg.GetData())
+	}
+	if err != nil {
+		return nil, service.NewInvalidFieldError("data", err)
+	}
+
+	mappings, report, err = svc.parseControlMetricMapping(catalog.GetId(), rows)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(report.Issues) == 0 && !req.Msg.GetDryRun() {
+		err = svc.db.Transaction(func(tx persistence.DB) error {
+			return applyControlMetricMapping(tx, mappings, req.Msg.GetReplace())
+		})
+		if err = service.HandleDatabaseError(err); err != nil {
+			return nil, err
+		}
+
+		report.Applied = true
+
+		// Notify subscribers, since the metrics of the controls changed
+		svc.notifyCatalogUpdated(catalog.GetId())
+
+		slog.Info("Imported control-to-metric mapping",
+			slog.String("catalog_id", catalog.GetId()),
+			slog.Int("mappings", len(mappings)),
+		)
+	}
+
+	res = connect.NewResponse(report)
+	return
+}
+
+// parseControlMetricMapping validates the rows of an imported control-to-metric mapping against the controls of the
+// catalog with the given ID and the existing metrics. It returns the valid mappings as well as a report containing an
+// issue for each invalid row. Only errors of the database are returned as error.
+func (svc *Service) parseControlMetricMapping(catalogId string, rows [][]string) (
+	mappings []*orchestrator.ControlMetric,
+	report *orchestrator.ImportControlMetricMappingResponse,
+	err error,
+) {
+	var (
+		controls  []*orchestrator.Control
+		byRef     = make(map[string]string)
+		ambiguous = make(map[string]bool)
+		metricIds []string
+		existing  []string
+		cols      map[string]int
+		seen      = make(map[string]int)
+	)
+
+	report = &orchestrator.ImportControlMetricMappingResponse{}
+
+	if len(rows) == 0 {
+		return nil, nil, service.NewInvalidFieldError("data", errors.New("file is empty"))
+	}
+
+	cols = make(map[string]int)
+	for i, name := range rows[0] {
+		cols[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	for _, name := range []string{mappingColumnControl, mappingColumnMetric} {
+		if _, ok := cols[name]; !ok {
+			return nil, nil, service.NewInvalidFieldError("data", fmt.Errorf("header does not contain the column %q", name))
+		}
+	}
+
+	// Controls can be referenced both by their ID and their catalog-local short name
+	err = svc.db.List(&controls, "id", true, 0, -1, persistence.WithoutPreload(), "catalog_id = ?", catalogId)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, control := range controls {
+		if _, ok := byRef[control.GetShortName()]; ok {
+			ambiguous[control.GetShortName()] = true
+		}
+		byRef[control.GetShortName()] = control.GetId()
+	}
+	for _, control := range controls {
+		byRef[control.GetId()] = control.GetId()
+	}
+
+	for _, row := range rows[1:] {
+		if id := cell(row, cols, mappingColumnMetric); id != "" {
+			metricIds = append(metricIds, id)
+		}
+	}
+	if len(metricIds) > 0 {
+		err = svc.db.Pluck(&assessment.Metric{}, "id", &existing, "id IN ?", metricIds)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	for i, row := range rows[1:] {
+		var (
+			rowNum    = int32(i + 2)
+			mapping   = &orchestrator.ControlMetric{}
+			controlId string
+			metricId  = cell(row, cols, mappingColumnMetric)
+			ref       = cell(row, cols, mappingColumnControl)
+			weight    = cell(row, cols, mappingColumnWeight)
+			issues    []string
+			ok        bool
+		)
+
+		// Skip empty rows, which spreadsheet applications often leave at the end of a file
+		if !slices.ContainsFunc(row, func(c string) bool { return strings.TrimSpace(c) != "" }) {
+			continue
+		}
+		report.Rows++
+
+		if controlId, ok = byRef[ref]; !ok {
+			issues = append(issues, fmt.Sprintf("control %q does not exist in the catalog", ref))
+		} else if ambiguous[ref] {
+			issues = append(issues, fmt.Sprintf("short name %q refers to multiple controls, use the control ID instead", ref))
+		}
+		if !slices.Contains(existing, metricId) {
+			issues = append(issues, fmt.Sprintf("metric %q does not exist", metricId))
+		}
+		if weight != "" {
+			w, werr := strconv.ParseFloat(weight, 64)
+			if werr != nil || w < 0 || math.IsInf(w, 0) || math.IsNaN(w) {
+				issues = append(issues, fmt.Sprintf("weight %q is not a non-negative number", weight))
+			} else {
+				mapping.Weight = &w
+			}
+		}
+		if first, dup := seen[controlId+"/"+metricId]; dup && len(issues) == 0 {
+			issues = append(issues, fmt.Sprintf("mapping is a duplicate of row %d", first))
+		}
+
+		for _, msg := range issues {
+			report.Issues = append(report.Issues, &orchestrator.ImportIssue{Row: rowNum, Message: msg})
+		}
+		if len(issues) > 0 {
+			continue
+		}
+
+		seen[controlId+"/"+metricId] = int(rowNum)
+		mapping.ControlId = controlId
+		mapping.MetricId = metricId
+		mappings = append(mappings, mapping)
+	}
+
+	report.Mapped = int32(len(mappings))
+
+	return mappings, report, nil
+}
+
+// applyControlMetricMapping stores the given mappings. If replace is set, all existing mappings of the affected
+// controls are removed first.
+func applyControlMetricMapping(tx persistence.DB, mappings []*orchestrator.ControlMetric, replace bool) (err error) {
+	var controlIds []string
+
+	if replace {
+		for _, mapping := range mappings {
+			if !slices.Contains(controlIds, mapping.ControlId) {
+				controlIds = append(controlIds, mapping.ControlId)
+			}
+		}
+
+		err = tx.Delete(&orchestrator.ControlMetric{}, "control_id IN ?", controlIds)
+		if err != nil && !errors.Is(err, persistence.ErrRecordNotFound) {
+			return err
+		}
+	}
+
+	for _, mapping := range mappings {
+		if err = tx.Save(mapping); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// copyControlMetricWeights copies the weights of the metrics of all controls of a catalog to the corresponding
+// controls of a copy of it (see [copyCatalog]), since the weights are not part of the control tree.
+func copyControlMetricWeights(tx persistence.DB, from *orchestrator.Catalog, to *orchestrator.Catalog) (err error) {
+	var (
+		ids     = make(map[string]string)
+		fromIds []string
+		weights []*orchestrator.ControlMetric
+	)
+
+	for i, category := range from.GetCategories() {
+		if i < len(to.GetCategories()) {
+			mapControlIds(category.GetControls(), to.GetCategories()[i].GetControls(), ids)
+		}
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	for id := range ids {
+		fromIds = append(fromIds, id)
+	}
+
+	if err = tx.List(&weights, "control_id", true, 0, -1, persistence.WithoutPreload(), "control_id IN ?", fromIds); err != nil {
+		return err
+	}
+
+	for _, w := range weights {
+		if w.Weight == nil {
+			continue
+		}
+
+		err = tx.Update(&orchestrator.ControlMetric{Weight: w.Weight}, "control_id = ? AND metric_id = ?", ids[w.GetControlId()], w.GetMetricId())
+		if err != nil && !errors.Is(err, persistence.ErrRecordNotFound) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// mapControlIds recursively records the ID of each control of a copied control tree under the ID of its original.
+func mapControlIds(from []*orchestrator.Control, to []*orchestrator.Control, ids map[string]string) {
+	for i, control := range from {
+		if i >= len(to) {
+			return
+		}
+
+		ids[control.GetId()] = to[i].GetId()
+		mapControlIds(control.GetControls(), to[i].GetControls(), ids)
+	}
+}
+
+// cell returns the trimmed value of the column with the given name of a row. Missing columns and cells are returned as
+// empty string.
+func cell(row []string, cols map[string]int, name string) string {
+	i, ok := cols[name]
+	if !ok || i >= len(row) {
+		return ""
+	}
+
+	return strings.TrimSpace(row[i])
+}
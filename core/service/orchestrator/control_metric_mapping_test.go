@@ -0,0 +1,302 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package orchestrator
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"confirmate.io/core/api/orchestrator"
+	"confirmate.io/core/persistence"
+	"confirmate.io/core/persistence/persistencetest"
+	"confirmate.io/core/service"
+	"confirmate.io/core/service/orchestrator/orchestratortest"
+	"confirmate.io/core/util/assert"
+
+	"connectrpc.com/connect"
+)
+
+// newMappingCSV returns a CSV file with the header of a control-to-metric mapping and the given rows.
+func newMappingCSV(rows ...string) []byte {
+	return []byte(strings.Join(append([]string{"control_id,metric_id,weight"}, rows...), "\n"))
+}
+
+func TestService_ImportControlMetricMapping(t *testing.T) {
+	type fields struct {
+		db    persistence.DB
+		authz service.AuthorizationStrategy
+	}
+	tests := []struct {
+		name    string
+		req     *orchestrator.ImportControlMetricMappingRequest
+		fields  fields
+		want    assert.Want[*connect.Response[orchestrator.ImportControlMetricMappingResponse]]
+		wantDB  assert.Want[persistence.DB]
+		wantErr assert.WantErr
+	}{
+		{
+			name: "validation error",
+			req:  &orchestrator.ImportControlMetricMappingRequest{CatalogId: orchestratortest.MockCatalogId1},
+			fields: fields{
+				db:    persistencetest.NewInMemoryDB(t, types, joinTables),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			want:   assert.Nil[*connect.Response[orchestrator.ImportControlMetricMappingResponse]],
+			wantDB: assert.NotNil[persistence.DB],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeInvalidArgument)
+			},
+		},
+		{
+			name: "authorization error",
+			req: &orchestrator.ImportControlMetricMappingRequest{
+				CatalogId: orchestratortest.MockCatalogId1,
+				Format:    orchestrator.MappingFileFormat_MAPPING_FILE_FORMAT_CSV,
+				Data:      newMappingCSV(),
+			},
+			fields: fields{
+				db:    persistencetest.NewInMemoryDB(t, types, joinTables),
+				authz: &service.AuthorizationStrategyPermissionStore{},
+			},
+			want:   assert.Nil[*connect.Response[orchestrator.ImportControlMetricMappingResponse]],
+			wantDB: assert.NotNil[persistence.DB],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodePermissionDenied)
+			},
+		},
+		{
+			name: "catalog not found",
+			req: &orchestrator.ImportControlMetricMappingRequest{
+				CatalogId: orchestratortest.MockCatalogId1,
+				Format:    orchestrator.MappingFileFormat_MAPPING_FILE_FORMAT_CSV,
+				Data:      newMappingCSV(),
+			},
+			fields: fields{
+				db:    persistencetest.NewInMemoryDB(t, types, joinTables),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			want:   assert.Nil[*connect.Response[orchestrator.ImportControlMetricMappingResponse]],
+			wantDB: assert.NotNil[persistence.DB],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeNotFound)
+			},
+		},
+		{
+			name: "published catalog",
+			req: &orchestrator.ImportControlMetricMappingRequest{
+				CatalogId: orchestratortest.MockCatalogId1,
+				Format:    orchestrator.MappingFileFormat_MAPPING_FILE_FORMAT_CSV,
+				Data:      newMappingCSV(),
+			},
+			fields: fields{
+				db: persistencetest.NewInMemoryDB(t, types, joinTables, func(d persistence.DB) {
+					assert.NoError(t, d.Create(&orchestrator.Catalog{
+						Id:     orchestratortest.MockCatalogId1,
+						Name:   orchestratortest.MockCatalogName1,
+						Status: orchestrator.CatalogStatus_CATALOG_STATUS_PUBLISHED,
+					}))
+				}),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			want:   assert.Nil[*connect.Response[orchestrator.ImportControlMetricMappingResponse]],
+			wantDB: assert.NotNil[persistence.DB],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeFailedPrecondition)
+			},
+		},
+		{
+			name: "missing column",
+			req: &orchestrator.ImportControlMetricMappingRequest{
+				CatalogId: orchestratortest.MockCatalogId1,
+				Format:    orchestrator.MappingFileFormat_MAPPING_FILE_FORMAT_CSV,
+				Data:      []byte("control_id,weight\ncontrol-1,1"),
+			},
+			fields: fields{
+				db: persistencetest.NewInMemoryDB(t, types, joinTables, func(d persistence.DB) {
+					assert.NoError(t, d.Create(orchestratortest.MockCatalog1))
+				}),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			want:   assert.Nil[*connect.Response[orchestrator.ImportControlMetricMappingResponse]],
+			wantDB: assert.NotNil[persistence.DB],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeInvalidArgument) &&
+					assert.HasFieldViolation(t, err, "data")
+			},
+		},
+		{
+			name: "invalid XLSX",
+			req: &orchestrator.ImportControlMetricMappingRequest{
+				CatalogId: orchestratortest.MockCatalogId1,
+				Format:    orchestrator.MappingFileFormat_MAPPING_FILE_FORMAT_XLSX,
+				Data:      newMappingCSV(),
+			},
+			fields: fields{
+				db: persistencetest.NewInMemoryDB(t, types, joinTables, func(d persistence.DB) {
+					assert.NoError(t, d.Create(orchestratortest.MockCatalog1))
+				}),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			want:   assert.Nil[*connect.Response[orchestrator.ImportControlMetricMappingResponse]],
+			wantDB: assert.NotNil[persistence.DB],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeInvalidArgument) &&
+					assert.HasFieldViolation(t, err, "data")
+			},
+		},
+		{
+			name: "invalid rows",
+			req: &orchestrator.ImportControlMetricMappingRequest{
+				CatalogId: orchestratortest.MockCatalogId1,
+				Format:    orchestrator.MappingFileFormat_MAPPING_FILE_FORMAT_CSV,
+				Data: newMappingCSV(
+					orchestratortest.MockControlShortName1+","+orchestratortest.MockMetricId2+",2",
+					"unknown,"+orchestratortest.MockMetricId2+",",
+					orchestratortest.MockControlShortName2+",unknown,",
+					orchestratortest.MockControlShortName2+","+orchestratortest.MockMetricId2+",-1",
+					orchestratortest.MockSubControlShortName1+","+orchestratortest.MockMetricId2+",",
+					orchestratortest.MockControlId1+","+orchestratortest.MockMetricId2+",",
+				),
+			},
+			fields: fields{
+				db: persistencetest.NewInMemoryDB(t, types, joinTables, func(d persistence.DB) {
+					assert.NoError(t, d.Create(orchestratortest.MockCatalog1))
+				}),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			want: func(t *testing.T, got *connect.Response[orchestrator.ImportControlMetricMappingResponse], args ...any) bool {
+				return assert.Equal(t, int32(6), got.Msg.Rows) &&
+					assert.Equal(t, int32(1), got.Msg.Mapped) &&
+					assert.False(t, got.Msg.Applied) &&
+					assert.Equal(t, 5, len(got.Msg.Issues)) &&
+					assert.Equal(t, int32(3), got.Msg.Issues[0].Row) &&
+					assert.Equal(t, int32(7), got.Msg.Issues[4].Row)
+			},
+			wantDB: func(t *testing.T, db persistence.DB, args ...any) bool {
+				n, err := db.Count(&orchestrator.ControlMetric{}, "control_id = ?", orchestratortest.MockControlId1)
+				assert.NoError(t, err)
+
+				return assert.Equal(t, int64(0), n)
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "dry run",
+			req: &orchestrator.ImportControlMetricMappingRequest{
+				CatalogId: orchestratortest.MockCatalogId1,
+				Format:    orchestrator.MappingFileFormat_MAPPING_FILE_FORMAT_CSV,
+				Data:      newMappingCSV(orchestratortest.MockControlShortName1 + "," + orchestratortest.MockMetricId2 + ",0.5"),
+				DryRun:    true,
+			},
+			fields: fields{
+				db: persistencetest.NewInMemoryDB(t, types, joinTables, func(d persistence.DB) {
+					assert.NoError(t, d.Create(orchestratortest.MockCatalog1))
+				}),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			want: func(t *testing.T, got *connect.Response[orchestrator.ImportControlMetricMappingResponse], args ...any) bool {
+				return assert.Equal(t, int32(1), got.Msg.Mapped) &&
+					assert.Empty(t, got.Msg.Issues) &&
+					assert.False(t, got.Msg.Applied)
+			},
+			wantDB: func(t *testing.T, db persistence.DB, args ...any) bool {
+				n, err := db.Count(&orchestrator.ControlMetric{}, "control_id = ?", orchestratortest.MockControlId1)
+				assert.NoError(t, err)
+
+				return assert.Equal(t, int64(0), n)
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "happy path",
+			req: &orchestrator.ImportControlMetricMappingRequest{
+				CatalogId: orchestratortest.MockCatalogId1,
+				Format:    orchestrator.MappingFileFormat_MAPPING_FILE_FORMAT_CSV,
+				Data: newMappingCSV(
+					orchestratortest.MockControlShortName1+","+orchestratortest.MockMetricId2+",0.5",
+					"",
+					orchestratortest.MockControl1SubControlId1+","+orchestratortest.MockMetricId2+",",
+				),
+			},
+			fields: fields{
+				db: persistencetest.NewInMemoryDB(t, types, joinTables, func(d persistence.DB) {
+					assert.NoError(t, d.Create(orchestratortest.MockCatalog1))
+				}),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			want: func(t *testing.T, got *connect.Response[orchestrator.ImportControlMetricMappingResponse], args ...any) bool {
+				return assert.Equal(t, int32(2), got.Msg.Rows) &&
+					assert.Equal(t, int32(2), got.Msg.Mapped) &&
+					assert.True(t, got.Msg.Applied)
+			},
+			wantDB: func(t *testing.T, db persistence.DB, args ...any) bool {
+				var mapping orchestrator.ControlMetric
+
+				assert.NoError(t, db.Get(&mapping, persistence.WithoutPreload(), "control_id = ? AND metric_id = ?", orchestratortest.MockControlId1, orchestratortest.MockMetricId2))
+
+				// Existing metrics are kept
+				n, err := db.Count(&orchestrator.ControlMetric{}, "control_id = ?", orchestratortest.MockControl1SubControlId1)
+				assert.NoError(t, err)
+
+				return assert.Equal(t, 0.5, mapping.GetWeight()) &&
+					assert.Equal(t, int64(2), n)
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "happy path: replace",
+			req: &orchestrator.ImportControlMetricMappingRequest{
+				CatalogId: orchestratortest.MockCatalogId1,
+				Format:    orchestrator.MappingFileFormat_MAPPING_FILE_FORMAT_CSV,
+				Data:      newMappingCSV(orchestratortest.MockControl1SubControlId1 + "," + orchestratortest.MockMetricId2 + ",2"),
+				Replace:   true,
+			},
+			fields: fields{
+				db: persistencetest.NewInMemoryDB(t, types, joinTables, func(d persistence.DB) {
+					assert.NoError(t, d.Create(orchestratortest.MockCatalog1))
+				}),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			want: func(t *testing.T, got *connect.Response[orchestrator.ImportControlMetricMappingResponse], args ...any) bool {
+				return assert.True(t, got.Msg.Applied)
+			},
+			wantDB: func(t *testing.T, db persistence.DB, args ...any) bool {
+				var mappings []*orchestrator.ControlMetric
+
+				assert.NoError(t, db.List(&mappings, "metric_id", true, 0, -1, persistence.WithoutPreload(), "control_id = ?", orchestratortest.MockControl1SubControlId1))
+
+				return assert.Equal(t, 1, len(mappings)) &&
+					assert.Equal(t, orchestratortest.MockMetricId2, mappings[0].GetMetricId()) &&
+					assert.Equal(t, 2.0, mappings[0].GetWeight())
+			},
+			wantErr: assert.NoError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &Service{
+				db:    tt.fields.db,
+				authz: tt.fields.authz,
+			}
+			got, gotErr := svc.ImportControlMetricMapping(context.Background(), connect.NewRequest(tt.req))
+
+			tt.want(t, got)
+			tt.wantDB(t, tt.fields.db)
+			tt.wantErr(t, gotErr)
+		})
+	}
+}
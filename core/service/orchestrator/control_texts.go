@@ -0,0 +1,332 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package orchestrator
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"html"
+	"slices"
+	"strings"
+
+	"confirmate.io/core/api/orchestrator"
+	"confirmate.io/core/persistence"
+	"confirmate.io/core/service"
+	"confirmate.io/core/util/markdown"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ListControlTextVersions lists the versions of the text of a control. Unless requested otherwise, the latest version
+// comes first. Like the controls themselves, the versions are open to all authenticated users.
+func (svc *Service) ListControlTextVersions(
+	ctx context.Context,
+	req *connect.Request[orchestrator.ListControlTextVersionsRequest],
+) (res *connect.Response[orchestrator.ListControlTextVersionsResponse], err error) {
+	var (
+		control   orchestrator.Control
+		catalogId string
+		path      string
+		versions  []*orchestrator.ControlTextVersion
+		npt       string
+	)
+
+	// Validate the request
+	if err = service.Validate(req); err != nil {
+		return nil, err
+	}
+
+	err = svc.db.Get(&control, persistence.WithoutPreload(), "id = ?", req.Msg.GetControlId())
+	if err = service.HandleDatabaseError(err, service.ErrNotFound("control")); err != nil {
+		return nil, err
+	}
+
+	catalogId, err = svc.draftCatalogId(control.GetCatalogId())
+	if err = service.HandleDatabaseError(err, service.ErrNotFound("catalog")); err != nil {
+		return nil, err
+	}
+
+	path, err = svc.controlPath(&control)
+	if err = service.HandleDatabaseError(err); err != nil {
+		return nil, err
+	}
+
+	// Set default ordering
+	if req.Msg.OrderBy == "" {
+		req.Msg.OrderBy = "version"
+		req.Msg.Asc = false
+	}
+
+	versions, npt, err = service.PaginateStorage[*orchestrator.ControlTextVersion](req.Msg, svc.db, service.DefaultPaginationOpts,
+		"catalog_id = ? AND control_path = ?", catalogId, path)
+	if err = service.HandleDatabaseError(err); err != nil {
+		return nil, err
+	}
+
+	res = connect.NewResponse(&orchestrator.ListControlTextVersionsResponse{
+		Versions:      versions,
+		NextPageToken: npt,
+	})
+	return
+}
+
+// RenderControlText renders the current or a previous version of the text of a control to HTML.
+func (svc *Service) RenderControlText(
+	ctx context.Context,
+	req *connect.Request[orchestrator.RenderControlTextRequest],
+) (res *connect.Response[orchestrator.RenderControlTextResponse], err error) {
+	var (
+		control   orchestrator.Control
+		catalogId string
+		path      string
+		text      *orchestrator.ControlTextVersion
+	)
+
+	// Validate the request
+	if err = service.Validate(req); err != nil {
+		return nil, err
+	}
+
+	err = svc.db.Get(&control, persistence.WithoutPreload(), "id = ?", req.Msg.GetControlId())
+	if err = service.HandleDatabaseError(err, service.ErrNotFound("control")); err != nil {
+		return nil, err
+	}
+
+	text = &orchestrator.ControlTextVersion{
+		Version:     control.GetTextVersion(),
+		Name:        control.GetName(),
+		Description: control.GetDescription(),
+		References:  control.GetReferences(),
+	}
+
+	// Previous versions are retrieved from the history of the control
+	if req.Msg.Version != nil && req.Msg.GetVersion() != control.GetTextVersion() {
+		catalogId, err = svc.draftCatalogId(control.GetCatalogId())
+		if err = service.HandleDatabaseError(err, service.ErrNotFound("catalog")); err != nil {
+			return nil, err
+		}
+
+		path, err = svc.controlPath(&control)
+		if err = service.HandleDatabaseError(err); err != nil {
+			return nil, err
+		}
+
+		text = new(orchestrator.ControlTextVersion)
+		err = svc.db.Get(text, "catalog_id = ? AND control_path = ? AND version = ?",
+			catalogId, path, req.Msg.GetVersion())
+		if err = service.HandleDatabaseError(err, service.ErrNotFound("control text version")); err != nil {
+			return nil, err
+		}
+	}
+
+	res = connect.NewResponse(&orchestrator.RenderControlTextResponse{
+		Version: text.GetVersion(),
+		Html:    renderControlText(text),
+	})
+	return
+}
+
+// draftCatalogId returns the ID of the draft of the catalog with the given ID. This is the catalog itself, unless it is
+// a published version of a draft.
+func (svc *Service) draftCatalogId(catalogId string) (id string, err error) {
+	var catalog orchestrator.Catalog
+
+	err = svc.db.Get(&catalog, persistence.WithoutPreload(), "id = ?", catalogId)
+	if err != nil {
+		return "", err
+	}
+
+	if catalog.DraftId != nil {
+		return catalog.GetDraftId(), nil
+	}
+
+	return catalog.GetId(), nil
+}
+
+// controlPath returns the path of the control in the control tree of its catalog, i.e., the short names of its parent
+// controls and of itself separated by slashes.
+func (svc *Service) controlPath(control *orchestrator.Control) (path string, err error) {
+	path = control.GetShortName()
+
+	for parentId := control.ParentControlId; parentId != nil; {
+		var parent orchestrator.Control
+
+		err = svc.db.Get(&parent, persistence.WithoutPreload(), "id = ?", *parentId)
+		if err != nil {
+			return "", err
+		}
+
+		path = parent.GetShortName() + "/" + path
+		parentId = parent.ParentControlId
+	}
+
+	return path, nil
+}
+
+// recordControlTextVersions records a new text version for each control of the catalog whose text differs from its
+// latest version, and sets the text version of all controls accordingly. It must be called before the controls are
+// persisted.
+func recordControlTextVersions(tx persistence.DB, catalog *orchestrator.Catalog) (err error) {
+	var (
+		versions []*orchestrator.ControlTextVersion
+		latest   = make(map[string]*orchestrator.ControlTextVersion)
+		now      = timestamppb.Now()
+		walk     func(controls []*orchestrator.Control, parent string) error
+	)
+
+	err = tx.List(&versions, "version", true, 0, -1, "catalog_id = ?", catalog.GetId())
+	if err != nil {
+		return err
+	}
+	for _, v := range versions {
+		latest[v.GetControlPath()] = v
+	}
+
+	// Short names are only unique among siblings, so the versions are tracked by the path of the control
+	walk = func(controls []*orchestrator.Control, parent string) (err error) {
+		for _, control := range controls {
+			var path = control.GetShortName()
+			if parent != "" {
+				path = parent + "/" + path
+			}
+
+			v, ok := latest[path]
+			if ok && !controlTextChanged(control, v) {
+				control.TextVersion = v.GetVersion()
+			} else {
+				v = &orchestrator.ControlTextVersion{
+					CatalogId:   catalog.GetId(),
+					ControlPath: path,
+					Version:     v.GetVersion() + 1,
+					Name:        control.GetName(),
+					Description: control.GetDescription(),
+					References:  control.GetReferences(),
+					CreatedAt:   now,
+				}
+				if err = tx.Create(v); err != nil {
+					return err
+				}
+
+				control.TextVersion = v.GetVersion()
+			}
+
+			if err = walk(control.GetControls(), path); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	for _, category := range catalog.GetCategories() {
+		if err = walk(category.GetControls(), ""); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// controlTextChanged returns whether the text of the control differs from the given text version.
+func controlTextChanged(control *orchestrator.Control, v *orchestrator.ControlTextVersion) bool {
+	return control.GetName() != v.GetName() ||
+		control.GetDescription() != v.GetDescription() ||
+		!sameControlReferences(control.GetReferences(), v.GetReferences())
+}
+
+// sameControlReferences returns whether both lists contain the same references in the same order.
+func sameControlReferences(a []*orchestrator.ControlReference, b []*orchestrator.ControlReference) bool {
+	return slices.EqualFunc(a, b, func(x *orchestrator.ControlReference, y *orchestrator.ControlReference) bool {
+		return proto.Equal(x, y)
+	})
+}
+
+// catalogContentHash returns the hex-encoded SHA-256 hash of the texts of the categories and controls of the catalog.
+// Categories and controls are hashed in the order of their names and paths, so that the hash does not depend on the
+// order in which they were retrieved.
+func catalogContentHash(catalog *orchestrator.Catalog) string {
+	var (
+		h          = sha256.New()
+		categories = slices.Clone(catalog.GetCategories())
+		walk       func(controls []*orchestrator.Control, parent string)
+		write      = func(s string) {
+			// The length prefix keeps adjacent fields from being confused with each other
+			fmt.Fprintf(h, "%d:%s", len(s), s)
+		}
+	)
+
+	walk = func(controls []*orchestrator.Control, parent string) {
+		controls = slices.Clone(controls)
+		slices.SortFunc(controls, func(a *orchestrator.Control, b *orchestrator.Control) int {
+			return strings.Compare(a.GetShortName(), b.GetShortName())
+		})
+
+		for _, control := range controls {
+			path := parent + "/" + control.GetShortName()
+
+			write(path)
+			write(control.GetName())
+			write(control.GetDescription())
+			for _, ref := range control.GetReferences() {
+				write(ref.GetTitle())
+				write(ref.GetSection())
+				write(ref.GetUrl())
+			}
+
+			walk(control.GetControls(), path)
+		}
+	}
+
+	slices.SortFunc(categories, func(a *orchestrator.Category, b *orchestrator.Category) int {
+		return strings.Compare(a.GetName(), b.GetName())
+	})
+	for _, category := range categories {
+		write(category.GetName())
+		write(category.GetDescription())
+		walk(category.GetControls(), category.GetName())
+	}
+
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// renderControlText renders the name of the control as heading, followed by its description and its references.
+func renderControlText(text *orchestrator.ControlTextVersion) string {
+	var b strings.Builder
+
+	b.WriteString("<h1>" + html.EscapeString(text.GetName()) + "</h1>\n")
+	b.WriteString(markdown.ToHTML(text.GetDescription()))
+
+	if len(text.GetReferences()) == 0 {
+		return b.String()
+	}
+
+	b.WriteString("<h2>References</h2>\n<ul>\n")
+	for _, ref := range text.GetReferences() {
+		title := ref.GetTitle()
+		if ref.Section != nil {
+			title += ", " + ref.GetSection()
+		}
+
+		b.WriteString("<li>" + markdown.Link(title, ref.GetUrl()) + "</li>\n")
+	}
+	b.WriteString("</ul>\n")
+
+	return b.String()
+}
@@ -0,0 +1,275 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package orchestrator
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"testing"
+
+	"confirmate.io/core/api/orchestrator"
+	"confirmate.io/core/persistence"
+	"confirmate.io/core/persistence/persistencetest"
+	"confirmate.io/core/service"
+	"confirmate.io/core/service/orchestrator/orchestratortest"
+	"confirmate.io/core/util/assert"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// mockControlDescription is the description of the second text version of control 1 in [mockControlTextRecords].
+const mockControlDescription = "The provider **must** encrypt data at rest."
+
+// mockControlTextRecords returns the mock catalog 1, whose control 1 has two text versions. The first version
+// already exists, so that the catalog is created with the second version, which adds a description and a reference.
+func mockControlTextRecords() []any {
+	var catalog = proto.CloneOf(orchestratortest.MockCatalog1)
+
+	normalizeCatalogControls(catalog)
+	catalog.Categories[0].Controls[0].Description = mockControlDescription
+	catalog.Categories[0].Controls[0].References = []*orchestrator.ControlReference{
+		{Title: "Regulation (EU) 2022/2554", Section: new("Art. 9"), Url: new("https://eur-lex.europa.eu/eli/reg/2022/2554/oj")},
+	}
+
+	return []any{
+		&orchestrator.ControlTextVersion{
+			CatalogId:   catalog.GetId(),
+			ControlPath: catalog.Categories[0].Controls[0].GetShortName(),
+			Version:     1,
+			Name:        catalog.Categories[0].Controls[0].GetName(),
+			CreatedAt:   timestamppb.Now(),
+		},
+		catalog,
+	}
+}
+
+func TestService_ListControlTextVersions(t *testing.T) {
+	type fields struct {
+		db persistence.DB
+	}
+	tests := []struct {
+		name    string
+		req     *orchestrator.ListControlTextVersionsRequest
+		fields  fields
+		want    assert.Want[*connect.Response[orchestrator.ListControlTextVersionsResponse]]
+		wantErr assert.WantErr
+	}{
+		{
+			name: "happy path",
+			req: &orchestrator.ListControlTextVersionsRequest{
+				ControlId: orchestratortest.MockControlId1,
+			},
+			fields: fields{
+				db: persistencetest.NewInMemoryDB(t, types, joinTables, createRecords(t, mockControlTextRecords()...)),
+			},
+			want: func(t *testing.T, got *connect.Response[orchestrator.ListControlTextVersionsResponse], args ...any) bool {
+				return assert.Equal(t, 2, len(got.Msg.Versions)) &&
+					assert.Equal(t, int32(2), got.Msg.Versions[0].GetVersion()) &&
+					assert.Equal(t, mockControlDescription, got.Msg.Versions[0].GetDescription()) &&
+					assert.Equal(t, 1, len(got.Msg.Versions[0].GetReferences())) &&
+					assert.Equal(t, "", got.Msg.Versions[1].GetDescription())
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "unchanged control",
+			req: &orchestrator.ListControlTextVersionsRequest{
+				ControlId: orchestratortest.MockControl1SubControlId1,
+			},
+			fields: fields{
+				db: persistencetest.NewInMemoryDB(t, types, joinTables, createRecords(t, mockControlTextRecords()...)),
+			},
+			want: func(t *testing.T, got *connect.Response[orchestrator.ListControlTextVersionsResponse], args ...any) bool {
+				return assert.Equal(t, 1, len(got.Msg.Versions)) &&
+					assert.Equal(t, orchestratortest.MockSubControlName1, got.Msg.Versions[0].GetName())
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "same short name under another parent",
+			req: &orchestrator.ListControlTextVersionsRequest{
+				ControlId: orchestratortest.MockControl2SubControlId1,
+			},
+			fields: fields{
+				db: persistencetest.NewInMemoryDB(t, types, joinTables, createRecords(t, mockControlTextRecords()...)),
+			},
+			want: func(t *testing.T, got *connect.Response[orchestrator.ListControlTextVersionsResponse], args ...any) bool {
+				return assert.Equal(t, 1, len(got.Msg.Versions)) &&
+					assert.Equal(t, orchestratortest.MockSubControlName2, got.Msg.Versions[0].GetName())
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "control not found",
+			req: &orchestrator.ListControlTextVersionsRequest{
+				ControlId: "00000000-0000-0000-0005-000000000099",
+			},
+			fields: fields{
+				db: persistencetest.NewInMemoryDB(t, types, joinTables, createRecords(t, mockControlTextRecords()...)),
+			},
+			want: assert.Nil[*connect.Response[orchestrator.ListControlTextVersionsResponse]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeNotFound)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &Service{
+				db: tt.fields.db,
+			}
+			got, gotErr := svc.ListControlTextVersions(context.Background(), connect.NewRequest(tt.req))
+
+			tt.want(t, got)
+			tt.wantErr(t, gotErr)
+		})
+	}
+}
+
+// TestService_ListControlTextVersions_published covers that published versions of a catalog share the text history
+// of their draft and that unchanged texts do not record new versions.
+func TestService_ListControlTextVersions_published(t *testing.T) {
+	var (
+		db      = persistencetest.NewInMemoryDB(t, types, joinTables, createRecords(t, mockControlTextRecords()...))
+		svc     = &Service{db: db, authz: &service.AuthorizationStrategyAllowAll{}}
+		control orchestrator.Control
+		catalog *orchestrator.Catalog
+	)
+
+	_, err := svc.PublishCatalog(context.Background(), connect.NewRequest(&orchestrator.PublishCatalogRequest{
+		CatalogId: orchestratortest.MockCatalogId1,
+	}))
+	assert.NoError(t, err)
+
+	// Updating the draft with the same texts keeps the text versions
+	catalog, _, err = svc.loadCatalogSnapshot(orchestratortest.MockCatalogId1)
+	assert.NoError(t, err)
+	_, err = svc.UpdateCatalog(context.Background(), connect.NewRequest(&orchestrator.UpdateCatalogRequest{Catalog: catalog}))
+	assert.NoError(t, err)
+
+	err = db.Get(&control, persistence.WithoutPreload(), "catalog_id = ? AND short_name = ?",
+		catalogVersionId(orchestratortest.MockCatalogId1, 1), orchestratortest.MockControlShortName1)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(2), control.GetTextVersion())
+
+	res, err := svc.ListControlTextVersions(context.Background(), connect.NewRequest(&orchestrator.ListControlTextVersionsRequest{
+		ControlId: control.GetId(),
+	}))
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(res.Msg.Versions))
+}
+
+func TestService_RenderControlText(t *testing.T) {
+	type fields struct {
+		db persistence.DB
+	}
+	tests := []struct {
+		name    string
+		req     *orchestrator.RenderControlTextRequest
+		fields  fields
+		want    assert.Want[*connect.Response[orchestrator.RenderControlTextResponse]]
+		wantErr assert.WantErr
+	}{
+		{
+			name: "current version",
+			req: &orchestrator.RenderControlTextRequest{
+				ControlId: orchestratortest.MockControlId1,
+			},
+			fields: fields{
+				db: persistencetest.NewInMemoryDB(t, types, joinTables, createRecords(t, mockControlTextRecords()...)),
+			},
+			want: func(t *testing.T, got *connect.Response[orchestrator.RenderControlTextResponse], args ...any) bool {
+				return assert.Equal(t, int32(2), got.Msg.GetVersion()) &&
+					assert.Contains(t, got.Msg.GetHtml(), "<strong>must</strong>") &&
+					assert.Contains(t, got.Msg.GetHtml(), `<a href="https://eur-lex.europa.eu/eli/reg/2022/2554/oj">Regulation (EU) 2022/2554, Art. 9</a>`)
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "previous version",
+			req: &orchestrator.RenderControlTextRequest{
+				ControlId: orchestratortest.MockControlId1,
+				Version:   new(int32(1)),
+			},
+			fields: fields{
+				db: persistencetest.NewInMemoryDB(t, types, joinTables, createRecords(t, mockControlTextRecords()...)),
+			},
+			want: func(t *testing.T, got *connect.Response[orchestrator.RenderControlTextResponse], args ...any) bool {
+				return assert.Equal(t, int32(1), got.Msg.GetVersion()) &&
+					assert.Equal(t, "<h1>"+orchestratortest.MockControlName1+"</h1>\n", got.Msg.GetHtml())
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "version not found",
+			req: &orchestrator.RenderControlTextRequest{
+				ControlId: orchestratortest.MockControlId1,
+				Version:   new(int32(5)),
+			},
+			fields: fields{
+				db: persistencetest.NewInMemoryDB(t, types, joinTables, createRecords(t, mockControlTextRecords()...)),
+			},
+			want: assert.Nil[*connect.Response[orchestrator.RenderControlTextResponse]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeNotFound)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &Service{
+				db: tt.fields.db,
+			}
+			got, gotErr := svc.RenderControlText(context.Background(), connect.NewRequest(tt.req))
+
+			tt.want(t, got)
+			tt.wantErr(t, gotErr)
+		})
+	}
+}
+
+func Test_renderControlText(t *testing.T) {
+	got := renderControlText(&orchestrator.ControlTextVersion{
+		Name:        "Encryption <at rest>",
+		Description: "Use [approved](javascript:void) ciphers.",
+		References:  []*orchestrator.ControlReference{{Title: "Internal policy"}},
+	})
+
+	assert.Equal(t, "<h1>Encryption &lt;at rest&gt;</h1>\n<p>Use approved ciphers.</p>\n"+
+		"<h2>References</h2>\n<ul>\n<li>Internal policy</li>\n</ul>\n", got)
+	assert.False(t, strings.Contains(got, "javascript"))
+}
+
+func Test_catalogContentHash(t *testing.T) {
+	var (
+		catalog   = proto.CloneOf(orchestratortest.MockCatalog1)
+		reordered = proto.CloneOf(orchestratortest.MockCatalog1)
+		changed   = proto.CloneOf(orchestratortest.MockCatalog1)
+	)
+
+	slices.Reverse(reordered.Categories)
+	changed.Categories[0].Controls[0].Description = mockControlDescription
+
+	got := catalogContentHash(catalog)
+	assert.Equal(t, 64, len(got))
+
+	// The order in which categories and controls are retrieved does not matter, but their texts do
+	assert.Equal(t, got, catalogContentHash(reordered))
+	assert.NotEqual(t, got, catalogContentHash(changed))
+}
@@ -16,6 +16,8 @@
 package orchestrator
 
 import (
+	"time"
+
 	"confirmate.io/core/api/assessment"
 	"confirmate.io/core/api/evaluation"
 	"confirmate.io/core/api/orchestrator"
@@ -33,22 +35,57 @@ var types = []any{
 	// MetricImplementation depends on Metric.
 	&assessment.MetricImplementation{},
 	&orchestrator.TargetOfEvaluation{},
+	// Secret has no FK to TargetOfEvaluation, so that it is never preloaded with it. It is removed together with the
+	// target of evaluation.
+	&orchestrator.Secret{},
+	// ResourceInventory has no FK to TargetOfEvaluation either and is removed together with it.
+	&orchestrator.ResourceInventory{},
+	&orchestrator.MetadataField{},
+	&orchestrator.RedactionRule{},
 	&orchestrator.Certificate{},
 	&orchestrator.State{},
 	&orchestrator.Catalog{},
+	&orchestrator.AssuranceLevel{},
+	// ApplicabilityRule depends on Catalog.
+	&orchestrator.ApplicabilityRule{},
+	&orchestrator.CatalogSource{},
+	// CatalogChangelog has no FK to Catalog, so that the changelogs outlive the removal of the catalog.
+	&orchestrator.CatalogChangelog{},
 	// Control depends on Metric (control_metrics join table).
 	&orchestrator.Control{},
+	// ControlMetric is the join table between Control and Metric.
+	&orchestrator.ControlMetric{},
 	// Category depends on Control (category_controls join table).
 	&orchestrator.Category{},
+	// ControlTextVersion has no FK to Control, so that the text history outlives re-imports of the catalog.
+	&orchestrator.ControlTextVersion{},
 	&orchestrator.AuditScope{},
 	&orchestrator.AssessmentTool{},
 	&assessment.MetricConfiguration{},
+	&assessment.CatalogMetricConfiguration{},
 	&assessment.AssessmentResult{},
+	// Waiver depends on AssessmentResult.
+	&assessment.Waiver{},
 	&evaluation.EvaluationResult{},
+	// Attachment depends on EvaluationResult.
+	&evaluation.Attachment{},
+	&attachmentContent{},
+	// Comment depends on EvaluationResult.
+	&evaluation.Comment{},
 	// ControlInScope depends on AuditScope and Control.
 	&orchestrator.ControlInScope{},
 	// AuditTrailEvent depends on AuditScope.
 	&orchestrator.AuditTrailEvent{},
+	// MaintenanceWindow depends on AuditScope.
+	&orchestrator.MaintenanceWindow{},
+	&slaNotification{},
+	&orchestrator.Webhook{},
+	// WebhookDelivery depends on Webhook.
+	&orchestrator.WebhookDelivery{},
+	&orchestrator.SavedView{},
+	&orchestrator.Alert{},
+	// UsageRecord has no FK to TargetOfEvaluation, so that the usage outlives the removal of the target of evaluation.
+	&orchestrator.UsageRecord{},
 }
 
 // joinTables defines the [MetricConfiguration] as a custom join table between
@@ -59,4 +96,17 @@ var joinTables = []persistence.CustomJoinTable{
 		Field:     "ConfiguredMetrics",
 		JoinTable: assessment.MetricConfiguration{},
 	},
+	{
+		Model:     orchestrator.Control{},
+		Field:     "Metrics",
+		JoinTable: orchestrator.ControlMetric{},
+	},
+}
+
+// immutableTypes returns the types that cannot be updated or deleted within the given retention period, if the
+// append-only mode is enabled, see [Config.ImmutableRetention].
+func immutableTypes(retention time.Duration) []persistence.ImmutableType {
+	return []persistence.ImmutableType{
+		{Model: &assessment.AssessmentResult{}, Column: "created_at", Retention: retention},
+	}
 }
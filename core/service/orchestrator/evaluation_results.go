@@ -16,22 +16,32 @@
 package orchestrator
 
 import (
+	"cmp"
 	"context"
+	"errors"
 	"fmt"
+	"slices"
 	"strings"
 	"time"
 
 	"confirmate.io/core/api/evaluation"
 	"confirmate.io/core/api/orchestrator"
+	"confirmate.io/core/persistence"
 	"confirmate.io/core/service"
 	"connectrpc.com/connect"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+// defaultEvaluationResultsOrder orders evaluation results by catalog, category, control and sub-control. The timestamp
+// and the ID break ties between the results of several evaluation runs.
+const defaultEvaluationResultsOrder = "control_catalog_id,category_name,control_short_name,timestamp,id"
+
 // StoreEvaluationResult is a method implementation of the evaluation interface
-func (svc *Service) StoreEvaluationResult(_ context.Context, req *connect.Request[orchestrator.StoreEvaluationResultRequest]) (res *connect.Response[evaluation.EvaluationResult], err error) {
+func (svc *Service) StoreEvaluationResult(ctx context.Context, req *connect.Request[orchestrator.StoreEvaluationResultRequest]) (res *connect.Response[evaluation.EvaluationResult], err error) {
 	var (
-		eval *evaluation.EvaluationResult
+		eval     *evaluation.EvaluationResult
+		previous []*evaluation.EvaluationResult
+		windows  []*orchestrator.MaintenanceWindow
 	)
 
 	// Validate the request
@@ -52,6 +62,61 @@ func (svc *Service) StoreEvaluationResult(_ context.Context, req *connect.Reques
 		Comment:              req.Msg.Result.Comment,
 		ValidUntil:           req.Msg.Result.GetValidUntil(),
 		Data:                 req.Msg.Result.GetData(),
+		FailingMetrics:       req.Msg.Result.GetFailingMetrics(),
+		Narrative:            req.Msg.Result.Narrative,
+		SubStatus:            req.Msg.Result.SubStatus,
+		ErrorCause:           req.Msg.Result.ErrorCause,
+		Reasons:              req.Msg.Result.GetReasons(),
+		RunId:                req.Msg.Result.RunId,
+	}
+
+	// The token might be restricted to other targets of evaluation
+	if !service.AllowsTargetOfEvaluation(ctx, svc.authz, eval.TargetOfEvaluationId) {
+		return nil, service.ErrPermissionDenied
+	}
+
+	// Record the version of the catalog the control was evaluated against, so that the result remains interpretable
+	// after the catalog is updated
+	if err = svc.recordCatalogVersion(eval); err != nil {
+		return nil, err
+	}
+
+	// Tag the result with the environment of its audit scope, so that results can be told apart per environment
+	if err = svc.recordEnvironment(eval); err != nil {
+		return nil, err
+	}
+
+	// Record where the control is located in its catalog, so that results can be listed in the order of the catalog
+	if err = svc.recordControl(eval); err != nil {
+		return nil, err
+	}
+
+	// A sub-status must be defined by the catalog of the control and refine the status of the result
+	if eval.SubStatus != nil {
+		if err = svc.checkSubStatus(eval); err != nil {
+			return nil, err
+		}
+	}
+
+	// Results during a maintenance window of the audit scope are flagged, so that planned outages are not tracked as
+	// non-compliance
+	windows, err = svc.activeMaintenanceWindows(eval.Timestamp.AsTime(), eval.AuditScopeId)
+	if err = service.HandleDatabaseError(err); err != nil {
+		return nil, err
+	}
+	eval.DuringMaintenance = len(windows) > 0
+
+	// Track since when the control is not compliant, based on the previous result of the control in the audit scope
+	err = svc.db.List(&previous, "timestamp", false, 0, 1, persistence.WithoutPreload(),
+		"target_of_evaluation_id = ? AND audit_scope_id = ? AND control_catalog_id = ? AND control_id = ?",
+		eval.TargetOfEvaluationId, eval.AuditScopeId, eval.ControlCatalogId, eval.ControlId)
+	if err = service.HandleDatabaseError(err); err != nil {
+		return nil, err
+	}
+	if len(previous) > 0 {
+		eval.NonCompliantSince = nonCompliantSince(eval, previous[0])
+	} else {
+		eval.NonCompliantSince = nonCompliantSince(eval, nil)
 	}
 
 	err = svc.db.Create(eval)
@@ -59,19 +124,22 @@ func (svc *Service) StoreEvaluationResult(_ context.Context, req *connect.Reques
 		return nil, err
 	}
 
+	svc.meterEvaluationResult(eval)
+
 	res = connect.NewResponse(eval)
 
 	return res, nil
 }
 
 // ListEvaluationResults is a method implementation of the evaluation interface
-func (svc *Service) ListEvaluationResults(_ context.Context,
+func (svc *Service) ListEvaluationResults(ctx context.Context,
 	req *connect.Request[orchestrator.ListEvaluationResultsRequest],
 ) (res *connect.Response[orchestrator.ListEvaluationResultsResponse], err error) {
 	var (
-		query     []string
-		partition []string
-		args      []any
+		query  []string
+		args   []any
+		all    bool
+		toeIds []string
 	)
 
 	// Validate the request
@@ -79,6 +147,19 @@ func (svc *Service) ListEvaluationResults(_ context.Context,
 		return nil, err
 	}
 
+	// A saved view contributes its filter
+	if req.Msg.SavedViewId != nil {
+		if err = svc.applySavedView(ctx, req.Msg); err != nil {
+			return nil, err
+		}
+	}
+
+	// Set default ordering, which does not depend on the order in which the results were stored
+	if req.Msg.OrderBy == "" {
+		req.Msg.OrderBy = defaultEvaluationResultsOrder
+		req.Msg.Asc = true
+	}
+
 	// Filtering evaluation results by
 	// * target of evaluation ID
 	// * control ID
@@ -106,11 +187,40 @@ func (svc *Service) ListEvaluationResults(_ context.Context,
 
 		// TODO(anatheka): change that, in other catalogs maybe it's not that easy to get the sub-control by name
 		if req.Msg.Filter.SubControls != nil {
-			partition = append(partition, "control_id")
 			query = append(query, "control_id LIKE ?")
 			args = append(args, fmt.Sprintf("%s%%", req.Msg.Filter.GetSubControls()))
 		}
 
+		if req.Msg.Filter.Status != nil {
+			query = append(query, "status = ?")
+			args = append(args, req.Msg.Filter.GetStatus())
+		}
+
+		if req.Msg.Filter.SubStatus != nil {
+			query = append(query, "sub_status = ?")
+			args = append(args, req.Msg.Filter.GetSubStatus())
+		}
+
+		if req.Msg.Filter.CatalogVersion != nil {
+			query = append(query, "catalog_version = ?")
+			args = append(args, req.Msg.Filter.GetCatalogVersion())
+		}
+
+		if req.Msg.Filter.CatalogHash != nil {
+			query = append(query, "catalog_hash = ?")
+			args = append(args, req.Msg.Filter.GetCatalogHash())
+		}
+
+		if req.Msg.Filter.Environment != nil {
+			query = append(query, "environment = ?")
+			args = append(args, req.Msg.Filter.GetEnvironment())
+		}
+
+		if req.Msg.Filter.RunId != nil {
+			query = append(query, "run_id = ?")
+			args = append(args, req.Msg.Filter.GetRunId())
+		}
+
 		if req.Msg.Filter.GetParentsOnly() {
 			query = append(query, "parent_control_id IS NULL")
 		}
@@ -123,49 +233,39 @@ func (svc *Service) ListEvaluationResults(_ context.Context,
 			})
 
 			// Use parameterized query instead of CURRENT_TIMESTAMP SQL function for compatibility with in-memory test database (ramsql)
-			query = append(query, "valid_until IS NULL OR valid_until >= ?")
+			query = append(query, "(valid_until IS NULL OR valid_until >= ?)")
 			args = append(args, time.Now())
 		}
 	}
 
+	// If the token is restricted to particular targets of evaluation, only their evaluation results are listed
+	if all, toeIds = service.ClaimedTargetOfEvaluations(ctx, svc.authz); !all {
+		query = append(query, "target_of_evaluation_id IN ?")
+		args = append(args, toeIds)
+	}
+
 	res = &connect.Response[orchestrator.ListEvaluationResultsResponse]{Msg: &orchestrator.ListEvaluationResultsResponse{Results: make([]*evaluation.EvaluationResult, 0)}}
 
-	// If we want to have it grouped by resource ID, we need to do a raw query
+	// If we want to have it grouped by control ID, we need to do a raw query
 	if req.Msg.GetLatestByControlId() {
 		// In the raw SQL, we need to build the whole WHERE statement
-		var where string
+		var (
+			where   string
+			results []*evaluation.EvaluationResult
+		)
 		if len(query) > 0 {
 			where = "WHERE " + strings.Join(query, " AND ")
 		}
 
-		// TODO(all): Is there a better solution? Ramsql does not support our SQL statement, so we have to do it that way for now.
-		// Simple query, then reduce to "latest per control_id" in Go, because doing it in SQL is to complex for ramsql. We need to order by timestamp desc, so that the first entry per control_id is the latest one.
-		sql := fmt.Sprintf(`
-			SELECT *
-			FROM evaluation_results
-			%s
-			ORDER BY control_catalog_id, control_id, timestamp DESC;
-		`, where)
-
-		err = svc.db.Raw(&res.Msg.Results, sql, args...)
+		results, err = latestEvaluationResults(svc.db, []string{"control_catalog_id", "control_id"},
+			func(r *evaluation.EvaluationResult) string {
+				return r.GetControlCatalogId() + "/" + r.GetControlId()
+			}, where, args...)
 		if err = service.HandleDatabaseError(err); err != nil {
 			return nil, err
 		}
 
-		// Reduce results to the latest entry per control_id
-		deduped := make([]*evaluation.EvaluationResult, 0, len(res.Msg.Results))
-		seen := make(map[string]bool)
-
-		for _, r := range res.Msg.Results {
-			key := r.GetControlId()
-			if seen[key] {
-				continue
-			}
-			seen[key] = true
-			deduped = append(deduped, r)
-		}
-
-		res.Msg.Results = deduped
+		res.Msg.Results = append(res.Msg.Results, results...)
 	} else {
 		// join query with AND and prepend the query
 		args = append([]any{strings.Join(query, " AND ")}, args...)
@@ -179,3 +279,159 @@ func (svc *Service) ListEvaluationResults(_ context.Context,
 
 	return
 }
+
+// recordCatalogVersion sets the version and the content hash of the published catalog of the control of the evaluation
+// result. Results of drafts and of unknown catalogs are stored without them.
+func (svc *Service) recordCatalogVersion(eval *evaluation.EvaluationResult) (err error) {
+	var catalog orchestrator.Catalog
+
+	err = svc.db.Get(&catalog, persistence.WithoutPreload(), "id = ?", eval.GetControlCatalogId())
+	if errors.Is(err, persistence.ErrRecordNotFound) {
+		return nil
+	} else if err = service.HandleDatabaseError(err); err != nil {
+		return err
+	}
+
+	if catalog.GetStatus() == orchestrator.CatalogStatus_CATALOG_STATUS_PUBLISHED {
+		eval.CatalogVersion = &catalog.Version
+		eval.CatalogHash = catalog.ContentHash
+	}
+
+	return nil
+}
+
+// recordEnvironment sets the environment of the audit scope of the evaluation result. Results of unknown audit scopes
+// and of audit scopes that are not restricted to an environment are stored without it.
+func (svc *Service) recordEnvironment(eval *evaluation.EvaluationResult) (err error) {
+	var auditScope orchestrator.AuditScope
+
+	err = svc.db.Get(&auditScope, persistence.WithoutPreload(), "id = ?", eval.GetAuditScopeId())
+	if errors.Is(err, persistence.ErrRecordNotFound) {
+		return nil
+	} else if err = service.HandleDatabaseError(err); err != nil {
+		return err
+	}
+
+	eval.Environment = auditScope.Environment
+
+	return nil
+}
+
+// recordControl sets the category and the short name of the control of the evaluation result. Sub-controls are located
+// in the category of their parent control. Results of unknown controls are stored without them.
+func (svc *Service) recordControl(eval *evaluation.EvaluationResult) (err error) {
+	var (
+		control    orchestrator.Control
+		categories []string
+	)
+
+	err = svc.db.Get(&control, persistence.WithoutPreload(), "id = ?", eval.GetControlId())
+	if errors.Is(err, persistence.ErrRecordNotFound) {
+		return nil
+	} else if err = service.HandleDatabaseError(err); err != nil {
+		return err
+	}
+
+	eval.ControlShortName = &control.ShortName
+
+	err = svc.db.Raw(&categories, "SELECT category_name FROM category_controls WHERE category_catalog_id = ? AND control_id = ?",
+		control.GetCatalogId(), cmp.Or(control.GetParentControlId(), control.GetId()))
+	if err = service.HandleDatabaseError(err); err != nil {
+		return err
+	}
+	if len(categories) > 0 {
+		eval.CategoryName = &categories[0]
+	}
+
+	return nil
+}
+
+// checkSubStatus checks whether the sub-status of the evaluation result is a custom status of the catalog of its control
+// that refines the status of the result.
+func (svc *Service) checkSubStatus(eval *evaluation.EvaluationResult) (err error) {
+	var (
+		catalog orchestrator.Catalog
+		custom  *orchestrator.CustomStatus
+	)
+
+	err = svc.db.Get(&catalog, persistence.WithoutPreload(), "id = ?", eval.GetControlCatalogId())
+	if err = service.HandleDatabaseError(err, service.ErrNotFound("catalog")); err != nil {
+		return err
+	}
+
+	custom = catalog.CustomStatus(eval.GetSubStatus())
+	if custom == nil {
+		return service.NewInvalidFieldError("result.sub_status",
+			fmt.Errorf("catalog %s does not define the custom status %s", catalog.GetId(), eval.GetSubStatus()))
+	} else if custom.GetStatus() != eval.GetStatus() {
+		return service.NewInvalidFieldError("result.sub_status",
+			fmt.Errorf("custom status %s refines %s, not %s", custom.GetName(), custom.GetStatus(), eval.GetStatus()))
+	}
+
+	return nil
+}
+
+// latestEvaluationResults retrieves the latest evaluation result of each group of evaluation results that share the
+// values of the partition columns, ordered by these columns. The where clause (including the WHERE keyword) and its
+// args filter the evaluation results beforehand. If the database does not support window functions, the evaluation
+// results are reduced in Go instead, for which key must return the values of the partition columns of a result.
+func latestEvaluationResults(
+	db persistence.DB,
+	partition []string,
+	key func(r *evaluation.EvaluationResult) string,
+	where string,
+	args ...any,
+) (results []*evaluation.EvaluationResult, err error) {
+	var columns = strings.Join(partition, ", ")
+
+	if db.Capabilities().WindowFunctions {
+		err = db.Raw(&results, fmt.Sprintf(`
+			SELECT *
+			FROM (
+				SELECT *, ROW_NUMBER() OVER (PARTITION BY %s ORDER BY timestamp DESC) AS latest_rank
+				FROM evaluation_results
+				%s
+			) AS ranked
+			WHERE latest_rank = 1
+			ORDER BY %s;
+		`, columns, where, columns), args...)
+
+		return results, err
+	}
+
+	// Otherwise, we order by timestamp within each group, so that the first result of a group is the latest one
+	err = db.Raw(&results, fmt.Sprintf(`
+		SELECT *
+		FROM evaluation_results
+		%s
+		ORDER BY %s, timestamp DESC;
+	`, where, columns), args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return slices.CompactFunc(results, func(a *evaluation.EvaluationResult, b *evaluation.EvaluationResult) bool {
+		return key(a) == key(b)
+	}), nil
+}
+
+// nonCompliantSince returns since when the control of the evaluation result is not compliant, given the previous
+// result of the control (if any). A non-compliant result continues the non-compliance of the previous result or starts
+// a new one, unless it was stored during maintenance. A compliant result ends it. Other results, e.g., pending ones, do
+// not change it.
+func nonCompliantSince(eval *evaluation.EvaluationResult, previous *evaluation.EvaluationResult) *timestamppb.Timestamp {
+	switch eval.GetStatus() {
+	case evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT,
+		evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT_MANUALLY:
+		if previous.GetNonCompliantSince() != nil || eval.GetDuringMaintenance() {
+			return previous.GetNonCompliantSince()
+		}
+
+		return eval.GetTimestamp()
+	case evaluation.EvaluationStatus_EVALUATION_STATUS_COMPLIANT,
+		evaluation.EvaluationStatus_EVALUATION_STATUS_COMPLIANT_MANUALLY:
+		return nil
+	default:
+		return previous.GetNonCompliantSince()
+	}
+}
@@ -19,20 +19,31 @@ import (
 	"context"
 	"slices"
 	"testing"
+	"time"
 
 	"confirmate.io/core/api/evaluation"
 	"confirmate.io/core/api/orchestrator"
+	"confirmate.io/core/auth"
 	"confirmate.io/core/persistence"
 	"confirmate.io/core/persistence/persistencetest"
+	"confirmate.io/core/service"
 	"confirmate.io/core/service/evaluation/evaluationtest"
+	"confirmate.io/core/service/orchestrator/orchestratortest"
 	"confirmate.io/core/util/assert"
 
 	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/testing/protocmp"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+// mockRunId is the ID of the evaluation run of mock evaluation results.
+const mockRunId = "00000000-0000-0000-0020-000000000001"
+
 func TestService_StoreEvaluationResult(t *testing.T) {
+	var failingDB = persistencetest.NewInMemoryDB(t, types, []persistence.CustomJoinTable{})
+
 	type args struct {
 		req *connect.Request[orchestrator.StoreEvaluationResultRequest]
 	}
@@ -90,6 +101,324 @@ func TestService_StoreEvaluationResult(t *testing.T) {
 				return assert.ErrorContains(t, err, "resource already exists")
 			},
 		},
+		{
+			name: "happy path: non-compliance of previous result is continued",
+			args: args{
+				req: connect.NewRequest(&orchestrator.StoreEvaluationResultRequest{
+					Result: &evaluation.EvaluationResult{
+						Id:                   evaluationtest.MockEvaluationResultId2,
+						TargetOfEvaluationId: evaluationtest.MockToeId1,
+						AuditScopeId:         evaluationtest.MockAuditScopeId1,
+						ControlId:            evaluationtest.MockControlId1,
+						ControlCatalogId:     evaluationtest.MockCatalogId1,
+						Status:               evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT,
+						Timestamp:            timestamppb.Now(),
+					},
+				}),
+			},
+			fields: fields{
+				db: persistencetest.NewInMemoryDB(t, types, []persistence.CustomJoinTable{}, func(d persistence.DB) {
+					err := d.Create(&evaluation.EvaluationResult{
+						Id:                   evaluationtest.MockEvaluationResultId1,
+						TargetOfEvaluationId: evaluationtest.MockToeId1,
+						AuditScopeId:         evaluationtest.MockAuditScopeId1,
+						ControlId:            evaluationtest.MockControlId1,
+						ControlCatalogId:     evaluationtest.MockCatalogId1,
+						Status:               evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT,
+						Timestamp:            timestamppb.New(time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC)),
+						NonCompliantSince:    timestamppb.New(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)),
+					})
+					assert.NoError(t, err)
+				}),
+			},
+			want: func(t *testing.T, got *connect.Response[evaluation.EvaluationResult], msgAndArgs ...any) bool {
+				return assert.Equal(t, time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), got.Msg.GetNonCompliantSince().AsTime())
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "happy path: result during maintenance is flagged",
+			args: args{
+				req: connect.NewRequest(&orchestrator.StoreEvaluationResultRequest{
+					Result: &evaluation.EvaluationResult{
+						Id:                   evaluationtest.MockEvaluationResultId2,
+						TargetOfEvaluationId: evaluationtest.MockToeId1,
+						AuditScopeId:         evaluationtest.MockAuditScopeId1,
+						ControlId:            evaluationtest.MockControlId1,
+						ControlCatalogId:     evaluationtest.MockCatalogId1,
+						Status:               evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT,
+						Timestamp:            timestamppb.Now(),
+					},
+				}),
+			},
+			fields: fields{
+				db: persistencetest.NewInMemoryDB(t, types, []persistence.CustomJoinTable{}, func(d persistence.DB) {
+					err := d.Create(orchestratortest.MockAuditScope1)
+					assert.NoError(t, err)
+					err = d.Create(&orchestrator.MaintenanceWindow{
+						Id:           mockMaintenanceWindowId1,
+						AuditScopeId: orchestratortest.MockAuditScope1.Id,
+						StartsAt:     timestamppb.New(time.Now().Add(-time.Hour)),
+						EndsAt:       timestamppb.New(time.Now().Add(time.Hour)),
+						Mode:         orchestrator.MaintenanceMode_MAINTENANCE_MODE_FLAG,
+					})
+					assert.NoError(t, err)
+				}),
+			},
+			want: func(t *testing.T, got *connect.Response[evaluation.EvaluationResult], msgAndArgs ...any) bool {
+				return assert.True(t, got.Msg.GetDuringMaintenance()) &&
+					assert.Nil(t, got.Msg.GetNonCompliantSince())
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "happy path: failing metrics and narrative are stored",
+			args: args{
+				req: connect.NewRequest(&orchestrator.StoreEvaluationResultRequest{
+					Result: &evaluation.EvaluationResult{
+						Id:                   evaluationtest.MockEvaluationResultId1,
+						TargetOfEvaluationId: evaluationtest.MockToeId1,
+						AuditScopeId:         evaluationtest.MockAuditScopeId1,
+						ControlId:            evaluationtest.MockControlId1,
+						ControlCatalogId:     evaluationtest.MockCatalogId1,
+						Status:               evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT,
+						Timestamp:            timestamppb.Now(),
+						AssessmentResultIds:  []string{evaluationtest.MockAssessmentResultId1},
+						FailingMetrics: []*evaluation.FailingMetric{
+							{
+								MetricId:      evaluationtest.MockMetricId1,
+								ResultCount:   1,
+								ResourceCount: 1,
+								Resources: []*evaluation.FailingResource{
+									{ResourceId: "resource-1", AssessmentResultIds: []string{evaluationtest.MockAssessmentResultId1}},
+								},
+							},
+						},
+						Narrative: new("Metric 1 failed for 1 resource, including resource-1."),
+					},
+				}),
+			},
+			fields: fields{
+				db: failingDB,
+			},
+			want: func(t *testing.T, got *connect.Response[evaluation.EvaluationResult], msgAndArgs ...any) bool {
+				stored := assert.InDB[evaluation.EvaluationResult](t, failingDB, evaluationtest.MockEvaluationResultId1)
+				return assert.Equal(t, got.Msg.FailingMetrics, stored.FailingMetrics) &&
+					assert.Equal(t, "Metric 1 failed for 1 resource, including resource-1.", stored.GetNarrative())
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "happy path: reasons are stored",
+			args: args{
+				req: connect.NewRequest(&orchestrator.StoreEvaluationResultRequest{
+					Result: &evaluation.EvaluationResult{
+						Id:                   evaluationtest.MockEvaluationResultId1,
+						TargetOfEvaluationId: evaluationtest.MockToeId1,
+						AuditScopeId:         evaluationtest.MockAuditScopeId1,
+						ControlId:            evaluationtest.MockControlId1,
+						ControlCatalogId:     evaluationtest.MockCatalogId1,
+						Status:               evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT,
+						Timestamp:            timestamppb.Now(),
+						Reasons:              []evaluation.EvaluationReason{evaluation.EvaluationReason_EVALUATION_REASON_FRESHNESS_VIOLATION},
+					},
+				}),
+			},
+			fields: fields{
+				db: persistencetest.NewInMemoryDB(t, types, []persistence.CustomJoinTable{}),
+			},
+			want: func(t *testing.T, got *connect.Response[evaluation.EvaluationResult], msgAndArgs ...any) bool {
+				return assert.Equal(t, []evaluation.EvaluationReason{evaluation.EvaluationReason_EVALUATION_REASON_FRESHNESS_VIOLATION}, got.Msg.GetReasons())
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "happy path: catalog version is recorded",
+			args: args{
+				req: connect.NewRequest(&orchestrator.StoreEvaluationResultRequest{
+					Result: &evaluation.EvaluationResult{
+						Id:                   evaluationtest.MockEvaluationResultId1,
+						TargetOfEvaluationId: evaluationtest.MockToeId1,
+						AuditScopeId:         evaluationtest.MockAuditScopeId1,
+						ControlId:            evaluationtest.MockControlId1,
+						ControlCatalogId:     evaluationtest.MockCatalogId1,
+						Status:               evaluation.EvaluationStatus_EVALUATION_STATUS_COMPLIANT,
+						Timestamp:            timestamppb.Now(),
+					},
+				}),
+			},
+			fields: fields{
+				db: persistencetest.NewInMemoryDB(t, types, []persistence.CustomJoinTable{}, func(d persistence.DB) {
+					err := d.Create(&orchestrator.Catalog{
+						Id:          evaluationtest.MockCatalogId1,
+						Name:        "Catalog 1",
+						ShortName:   "C1",
+						Status:      orchestrator.CatalogStatus_CATALOG_STATUS_PUBLISHED,
+						Version:     3,
+						ContentHash: new("hash-3"),
+					})
+					assert.NoError(t, err)
+				}),
+			},
+			want: func(t *testing.T, got *connect.Response[evaluation.EvaluationResult], msgAndArgs ...any) bool {
+				return assert.Equal(t, int32(3), got.Msg.GetCatalogVersion()) &&
+					assert.Equal(t, "hash-3", got.Msg.GetCatalogHash())
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "happy path: environment of the audit scope is recorded",
+			args: args{
+				req: connect.NewRequest(&orchestrator.StoreEvaluationResultRequest{
+					Result: &evaluation.EvaluationResult{
+						Id:                   evaluationtest.MockEvaluationResultId1,
+						TargetOfEvaluationId: evaluationtest.MockToeId1,
+						AuditScopeId:         evaluationtest.MockAuditScopeId1,
+						ControlId:            evaluationtest.MockControlId1,
+						ControlCatalogId:     evaluationtest.MockCatalogId1,
+						Status:               evaluation.EvaluationStatus_EVALUATION_STATUS_COMPLIANT,
+						Timestamp:            timestamppb.Now(),
+					},
+				}),
+			},
+			fields: fields{
+				db: persistencetest.NewInMemoryDB(t, types, []persistence.CustomJoinTable{}, func(d persistence.DB) {
+					scope := proto.CloneOf(evaluationtest.MockAuditScope1)
+					scope.Environment = new("prod")
+
+					err := d.Create(scope)
+					assert.NoError(t, err)
+				}),
+			},
+			want: func(t *testing.T, got *connect.Response[evaluation.EvaluationResult], msgAndArgs ...any) bool {
+				return assert.Equal(t, "prod", got.Msg.GetEnvironment())
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "happy path: run, category and short name of the control are recorded",
+			args: args{
+				req: connect.NewRequest(&orchestrator.StoreEvaluationResultRequest{
+					Result: &evaluation.EvaluationResult{
+						Id:                   evaluationtest.MockEvaluationResultId3,
+						TargetOfEvaluationId: evaluationtest.MockToeId1,
+						AuditScopeId:         evaluationtest.MockAuditScopeId1,
+						ControlId:            evaluationtest.MockControl1SubcontrolId11,
+						ParentControlId:      new(evaluationtest.MockControlId1),
+						ControlCatalogId:     evaluationtest.MockCatalogId1,
+						Status:               evaluation.EvaluationStatus_EVALUATION_STATUS_COMPLIANT,
+						Timestamp:            timestamppb.Now(),
+						RunId:                new(mockRunId),
+					},
+				}),
+			},
+			fields: fields{
+				db: persistencetest.NewInMemoryDB(t, types, []persistence.CustomJoinTable{}, func(d persistence.DB) {
+					err := d.Create(&orchestrator.Catalog{
+						Id:        evaluationtest.MockCatalogId1,
+						Name:      "Catalog 1",
+						ShortName: "C1",
+						Categories: []*orchestrator.Category{{
+							Name:      "Category 1",
+							CatalogId: evaluationtest.MockCatalogId1,
+							Controls: []*orchestrator.Control{{
+								Id:        evaluationtest.MockControlId1,
+								Name:      "Control 1",
+								ShortName: "CTRL-01",
+								CatalogId: evaluationtest.MockCatalogId1,
+								Controls: []*orchestrator.Control{{
+									Id:        evaluationtest.MockControl1SubcontrolId11,
+									Name:      "Control 1.1",
+									ShortName: "CTRL-01.1",
+									CatalogId: evaluationtest.MockCatalogId1,
+								}},
+							}},
+						}},
+					})
+					assert.NoError(t, err)
+				}),
+			},
+			want: func(t *testing.T, got *connect.Response[evaluation.EvaluationResult], msgAndArgs ...any) bool {
+				return assert.Equal(t, mockRunId, got.Msg.GetRunId()) &&
+					assert.Equal(t, "Category 1", got.Msg.GetCategoryName()) &&
+					assert.Equal(t, "CTRL-01.1", got.Msg.GetControlShortName())
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "happy path: sub-status is stored",
+			args: args{
+				req: connect.NewRequest(&orchestrator.StoreEvaluationResultRequest{
+					Result: &evaluation.EvaluationResult{
+						Id:                   evaluationtest.MockEvaluationResultId1,
+						TargetOfEvaluationId: evaluationtest.MockToeId1,
+						AuditScopeId:         evaluationtest.MockAuditScopeId1,
+						ControlId:            evaluationtest.MockControlId1,
+						ControlCatalogId:     evaluationtest.MockCatalogId1,
+						Status:               evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT,
+						SubStatus:            new("PARTIALLY_COMPLIANT"),
+						Timestamp:            timestamppb.Now(),
+					},
+				}),
+			},
+			fields: fields{
+				db: persistencetest.NewInMemoryDB(t, types, joinTables, createRecords(t, mockCustomStatusRecords()...)),
+			},
+			want: func(t *testing.T, got *connect.Response[evaluation.EvaluationResult], msgAndArgs ...any) bool {
+				return assert.Equal(t, "PARTIALLY_COMPLIANT", got.Msg.GetSubStatus())
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "error: sub-status not defined by the catalog",
+			args: args{
+				req: connect.NewRequest(&orchestrator.StoreEvaluationResultRequest{
+					Result: &evaluation.EvaluationResult{
+						Id:                   evaluationtest.MockEvaluationResultId1,
+						TargetOfEvaluationId: evaluationtest.MockToeId1,
+						AuditScopeId:         evaluationtest.MockAuditScopeId1,
+						ControlId:            evaluationtest.MockControlId1,
+						ControlCatalogId:     evaluationtest.MockCatalogId1,
+						Status:               evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT,
+						SubStatus:            new("NOT_APPLICABLE"),
+						Timestamp:            timestamppb.Now(),
+					},
+				}),
+			},
+			fields: fields{
+				db: persistencetest.NewInMemoryDB(t, types, joinTables, createRecords(t, mockCustomStatusRecords()...)),
+			},
+			want: assert.Nil[*connect.Response[evaluation.EvaluationResult]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				assert.IsConnectError(t, err, connect.CodeInvalidArgument)
+				return assert.ErrorContains(t, err, "does not define the custom status NOT_APPLICABLE")
+			},
+		},
+		{
+			name: "error: sub-status refines another status",
+			args: args{
+				req: connect.NewRequest(&orchestrator.StoreEvaluationResultRequest{
+					Result: &evaluation.EvaluationResult{
+						Id:                   evaluationtest.MockEvaluationResultId1,
+						TargetOfEvaluationId: evaluationtest.MockToeId1,
+						AuditScopeId:         evaluationtest.MockAuditScopeId1,
+						ControlId:            evaluationtest.MockControlId1,
+						ControlCatalogId:     evaluationtest.MockCatalogId1,
+						Status:               evaluation.EvaluationStatus_EVALUATION_STATUS_COMPLIANT,
+						SubStatus:            new("PARTIALLY_COMPLIANT"),
+						Timestamp:            timestamppb.Now(),
+					},
+				}),
+			},
+			fields: fields{
+				db: persistencetest.NewInMemoryDB(t, types, joinTables, createRecords(t, mockCustomStatusRecords()...)),
+			},
+			want: assert.Nil[*connect.Response[evaluation.EvaluationResult]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				assert.IsConnectError(t, err, connect.CodeInvalidArgument)
+				return assert.ErrorContains(t, err, "refines EVALUATION_STATUS_NOT_COMPLIANT")
+			},
+		},
 		{
 			name: "happy path: with all fields populated",
 			args: args{
@@ -121,6 +450,23 @@ func TestService_StoreEvaluationResult(t *testing.T) {
 	}
 }
 
+// mockCustomStatusRecords returns a catalog that defines the custom status PARTIALLY_COMPLIANT.
+func mockCustomStatusRecords() []any {
+	return []any{
+		&orchestrator.Catalog{
+			Id:        evaluationtest.MockCatalogId1,
+			Name:      "Catalog 1",
+			ShortName: "C1",
+			CustomStatuses: []*orchestrator.CustomStatus{
+				{
+					Name:   "PARTIALLY_COMPLIANT",
+					Status: evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT,
+				},
+			},
+		},
+	}
+}
+
 // TestService_StoreEvaluationResult_LargeBlobIntegration is an integration test that verifies large binary data blobs
 // are correctly stored and retrieved from the database. This test ensures the persistence layer (GORM) properly handles
 // large binary data (1MB+) in the evaluation result's Data field, which is used for giving additional justification.
@@ -188,10 +534,12 @@ func TestService_StoreEvaluationResult_LargeBlobIntegration(t *testing.T) {
 
 func TestService_ListEvaluationResults(t *testing.T) {
 	type args struct {
+		ctx context.Context
 		req *connect.Request[orchestrator.ListEvaluationResultsRequest]
 	}
 	type fields struct {
-		db persistence.DB
+		db    persistence.DB
+		authz service.AuthorizationStrategy
 	}
 	tests := []struct {
 		name    string
@@ -286,6 +634,93 @@ func TestService_ListEvaluationResults(t *testing.T) {
 			},
 			wantErr: assert.NoError,
 		},
+		{
+			name: "happy path: filter by status and sub-status",
+			args: args{
+				req: connect.NewRequest(&orchestrator.ListEvaluationResultsRequest{
+					Filter: &orchestrator.ListEvaluationResultsRequest_Filter{
+						Status:    new(evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT),
+						SubStatus: new("PARTIALLY_COMPLIANT"),
+					},
+				}),
+			},
+			fields: fields{
+				db: persistencetest.NewInMemoryDB(t, types, []persistence.CustomJoinTable{}, func(d persistence.DB) {
+					partial := proto.CloneOf(evaluationtest.MockEvaluationResult1)
+					partial.Status = evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT
+					partial.SubStatus = new("PARTIALLY_COMPLIANT")
+
+					err := d.Create(partial)
+					assert.NoError(t, err)
+					err = d.Create(evaluationtest.MockEvaluationResult2)
+					assert.NoError(t, err)
+				}),
+			},
+			want: func(t *testing.T, got *connect.Response[orchestrator.ListEvaluationResultsResponse], msgAndArgs ...any) bool {
+				assert.Equal(t, 1, len(got.Msg.Results))
+				return assert.Equal(t, evaluationtest.MockEvaluationResult1.Id, got.Msg.Results[0].Id)
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "happy path: filter by catalog version and hash",
+			args: args{
+				req: connect.NewRequest(&orchestrator.ListEvaluationResultsRequest{
+					Filter: &orchestrator.ListEvaluationResultsRequest_Filter{
+						CatalogVersion: new(int32(2)),
+						CatalogHash:    new("hash-2"),
+					},
+				}),
+			},
+			fields: fields{
+				db: persistencetest.NewInMemoryDB(t, types, []persistence.CustomJoinTable{}, func(d persistence.DB) {
+					previous := proto.CloneOf(evaluationtest.MockEvaluationResult1)
+					previous.CatalogVersion = new(int32(1))
+					previous.CatalogHash = new("hash-1")
+					current := proto.CloneOf(evaluationtest.MockEvaluationResult2)
+					current.CatalogVersion = new(int32(2))
+					current.CatalogHash = new("hash-2")
+
+					err := d.Create(previous)
+					assert.NoError(t, err)
+					err = d.Create(current)
+					assert.NoError(t, err)
+				}),
+			},
+			want: func(t *testing.T, got *connect.Response[orchestrator.ListEvaluationResultsResponse], msgAndArgs ...any) bool {
+				assert.Equal(t, 1, len(got.Msg.Results))
+				return assert.Equal(t, evaluationtest.MockEvaluationResult2.Id, got.Msg.Results[0].Id)
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "happy path: filter by environment",
+			args: args{
+				req: connect.NewRequest(&orchestrator.ListEvaluationResultsRequest{
+					Filter: &orchestrator.ListEvaluationResultsRequest_Filter{
+						Environment: new("prod"),
+					},
+				}),
+			},
+			fields: fields{
+				db: persistencetest.NewInMemoryDB(t, types, []persistence.CustomJoinTable{}, func(d persistence.DB) {
+					staging := proto.CloneOf(evaluationtest.MockEvaluationResult1)
+					staging.Environment = new("staging")
+					prod := proto.CloneOf(evaluationtest.MockEvaluationResult2)
+					prod.Environment = new("prod")
+
+					err := d.Create(staging)
+					assert.NoError(t, err)
+					err = d.Create(prod)
+					assert.NoError(t, err)
+				}),
+			},
+			want: func(t *testing.T, got *connect.Response[orchestrator.ListEvaluationResultsResponse], msgAndArgs ...any) bool {
+				assert.Equal(t, 1, len(got.Msg.Results))
+				return assert.Equal(t, evaluationtest.MockEvaluationResult2.Id, got.Msg.Results[0].Id)
+			},
+			wantErr: assert.NoError,
+		},
 		{
 			name: "happy path: filter by `get latest by control id` and filter by ToE",
 			args: args{
@@ -359,6 +794,42 @@ func TestService_ListEvaluationResults(t *testing.T) {
 			},
 			wantErr: assert.NoError,
 		},
+		{
+			name: "happy path: filter by `valid manual only` with a token restricted to a target of evaluation",
+			args: args{
+				ctx: auth.WithClaims(context.Background(), &auth.OAuthClaims{
+					IsAdminToken:          true,
+					TargetOfEvaluationIds: []string{evaluationtest.MockToeId1},
+				}),
+				req: connect.NewRequest(&orchestrator.ListEvaluationResultsRequest{
+					Filter: &orchestrator.ListEvaluationResultsRequest_Filter{
+						ValidManualOnly: new(true),
+					},
+				}),
+			},
+			fields: fields{
+				db: persistencetest.NewInMemoryDB(t, types, []persistence.CustomJoinTable{}, func(d persistence.DB) {
+					err := d.Create(evaluationtest.MockManualEvaluationResult2)
+					assert.NoError(t, err)
+					err = d.Create(evaluationtest.MockManualEvaluationResult3)
+					assert.NoError(t, err)
+
+					// A manual evaluation result of another target of evaluation without a validity period
+					unlimited := proto.Clone(evaluationtest.MockManualEvaluationResult2).(*evaluation.EvaluationResult)
+					unlimited.Id = uuid.NewString()
+					unlimited.ValidUntil = nil
+					err = d.Create(unlimited)
+					assert.NoError(t, err)
+				}),
+				authz: &service.AuthorizationStrategyPermissionStore{},
+			},
+			want: func(t *testing.T, got *connect.Response[orchestrator.ListEvaluationResultsResponse], msgAndArgs ...any) bool {
+				assert.NotNil(t, got)
+				assert.Equal(t, 1, len(got.Msg.Results))
+				return assert.Equal(t, evaluationtest.MockManualEvaluationResult3.Id, got.Msg.Results[0].Id)
+			},
+			wantErr: assert.NoError,
+		},
 		{
 			name: "happy path: filter by `parents only`",
 			args: args{
@@ -484,6 +955,123 @@ func TestService_ListEvaluationResults(t *testing.T) {
 			},
 			wantErr: assert.NoError,
 		},
+		{
+			name: "happy path: filter by run ID",
+			args: args{
+				req: connect.NewRequest(&orchestrator.ListEvaluationResultsRequest{
+					Filter: &orchestrator.ListEvaluationResultsRequest_Filter{
+						RunId: new(mockRunId),
+					},
+				}),
+			},
+			fields: fields{
+				db: persistencetest.NewInMemoryDB(t, types, []persistence.CustomJoinTable{}, func(d persistence.DB) {
+					result := proto.CloneOf(evaluationtest.MockEvaluationResult2)
+					result.RunId = new(mockRunId)
+
+					err := d.Create(evaluationtest.MockEvaluationResult1)
+					assert.NoError(t, err)
+					err = d.Create(result)
+					assert.NoError(t, err)
+				}),
+			},
+			want: func(t *testing.T, got *connect.Response[orchestrator.ListEvaluationResultsResponse], msgAndArgs ...any) bool {
+				return assert.Equal(t, 1, len(got.Msg.Results)) &&
+					assert.Equal(t, evaluationtest.MockEvaluationResultId2, got.Msg.Results[0].GetId())
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "happy path: ordered by catalog, category, control and sub-control",
+			args: args{
+				req: connect.NewRequest(&orchestrator.ListEvaluationResultsRequest{}),
+			},
+			fields: fields{
+				db: persistencetest.NewInMemoryDB(t, types, []persistence.CustomJoinTable{}, func(d persistence.DB) {
+					var (
+						control    = proto.CloneOf(evaluationtest.MockEvaluationResult1)
+						subControl = proto.CloneOf(evaluationtest.MockEvaluationResult3)
+						other      = proto.CloneOf(evaluationtest.MockEvaluationResult4)
+					)
+
+					control.CategoryName = new("Category 2")
+					control.ControlShortName = new("CTRL-02")
+					subControl.CategoryName = new("Category 2")
+					subControl.ControlShortName = new("CTRL-02.1")
+					other.CategoryName = new("Category 1")
+					other.ControlShortName = new("CTRL-01")
+
+					// The results are stored in reverse order
+					err := d.Create(evaluationtest.MockEvaluationResult2)
+					assert.NoError(t, err)
+					err = d.Create(subControl)
+					assert.NoError(t, err)
+					err = d.Create(control)
+					assert.NoError(t, err)
+					err = d.Create(other)
+					assert.NoError(t, err)
+				}),
+			},
+			want: func(t *testing.T, got *connect.Response[orchestrator.ListEvaluationResultsResponse], msgAndArgs ...any) bool {
+				var ids []string
+				for _, r := range got.Msg.Results {
+					ids = append(ids, r.GetId())
+				}
+
+				return assert.Equal(t, []string{
+					evaluationtest.MockEvaluationResultId4,
+					evaluationtest.MockEvaluationResultId1,
+					evaluationtest.MockEvaluationResultId3,
+					evaluationtest.MockEvaluationResultId2,
+				}, ids)
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "happy path: saved view",
+			args: args{
+				req: connect.NewRequest(&orchestrator.ListEvaluationResultsRequest{
+					SavedViewId: new(mockSavedViewId1),
+					// The filter of the request takes precedence over the one of the view
+					Filter: &orchestrator.ListEvaluationResultsRequest_Filter{
+						ControlId: new(evaluationtest.MockControlId2),
+					},
+				}),
+			},
+			fields: fields{
+				db: persistencetest.NewInMemoryDB(t, types, []persistence.CustomJoinTable{}, func(d persistence.DB) {
+					err := d.Create(evaluationtest.MockEvaluationResult1)
+					assert.NoError(t, err)
+					err = d.Create(evaluationtest.MockEvaluationResult2)
+					assert.NoError(t, err)
+					err = d.Create(mockSavedView(mockSavedViewId1, "", false, &orchestrator.ListEvaluationResultsRequest_Filter{
+						ControlId: new(evaluationtest.MockControlId1),
+						Status:    new(evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT),
+					}))
+					assert.NoError(t, err)
+				}),
+			},
+			want: func(t *testing.T, got *connect.Response[orchestrator.ListEvaluationResultsResponse], msgAndArgs ...any) bool {
+				return assert.Equal(t, 1, len(got.Msg.Results)) &&
+					assert.Equal(t, evaluationtest.MockEvaluationResult2, got.Msg.Results[0])
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "error: saved view not found",
+			args: args{
+				req: connect.NewRequest(&orchestrator.ListEvaluationResultsRequest{
+					SavedViewId: new(mockSavedViewId1),
+				}),
+			},
+			fields: fields{
+				db: persistencetest.NewInMemoryDB(t, types, []persistence.CustomJoinTable{}),
+			},
+			want: assert.Nil[*connect.Response[orchestrator.ListEvaluationResultsResponse]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeNotFound)
+			},
+		},
 		{
 			name: "happy path",
 			args: args{
@@ -509,12 +1097,154 @@ func TestService_ListEvaluationResults(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			svc := &Service{
-				db: tt.fields.db,
+				db:    tt.fields.db,
+				authz: tt.fields.authz,
+			}
+			ctx := tt.args.ctx
+			if ctx == nil {
+				ctx = context.Background()
 			}
-			got, gotErr := svc.ListEvaluationResults(context.Background(), tt.args.req)
+			got, gotErr := svc.ListEvaluationResults(ctx, tt.args.req)
 
 			tt.want(t, got)
 			tt.wantErr(t, gotErr)
 		})
 	}
 }
+
+func Test_nonCompliantSince(t *testing.T) {
+	var (
+		since   = timestamppb.New(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+		now     = timestamppb.New(time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC))
+		failing = &evaluation.EvaluationResult{Status: evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT, NonCompliantSince: since}
+		passing = &evaluation.EvaluationResult{Status: evaluation.EvaluationStatus_EVALUATION_STATUS_COMPLIANT}
+		newEval = func(status evaluation.EvaluationStatus) *evaluation.EvaluationResult {
+			return &evaluation.EvaluationResult{Status: status, Timestamp: now}
+		}
+	)
+
+	tests := []struct {
+		name     string
+		eval     *evaluation.EvaluationResult
+		previous *evaluation.EvaluationResult
+		want     *timestamppb.Timestamp
+	}{
+		{
+			name: "first result not compliant",
+			eval: newEval(evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT),
+			want: now,
+		},
+		{
+			name:     "not compliant after compliant",
+			eval:     newEval(evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT_MANUALLY),
+			previous: passing,
+			want:     now,
+		},
+		{
+			name:     "still not compliant",
+			eval:     newEval(evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT),
+			previous: failing,
+			want:     since,
+		},
+		{
+			name:     "compliant again",
+			eval:     newEval(evaluation.EvaluationStatus_EVALUATION_STATUS_COMPLIANT_MANUALLY),
+			previous: failing,
+		},
+		{
+			name:     "pending after not compliant",
+			eval:     newEval(evaluation.EvaluationStatus_EVALUATION_STATUS_PENDING),
+			previous: failing,
+			want:     since,
+		},
+		{
+			name: "first result erroneous",
+			eval: newEval(evaluation.EvaluationStatus_EVALUATION_STATUS_ERROR),
+		},
+		{
+			name: "not compliant during maintenance",
+			eval: &evaluation.EvaluationResult{
+				Status:            evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT,
+				Timestamp:         now,
+				DuringMaintenance: true,
+			},
+			previous: passing,
+		},
+		{
+			name: "still not compliant during maintenance",
+			eval: &evaluation.EvaluationResult{
+				Status:            evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT,
+				Timestamp:         now,
+				DuringMaintenance: true,
+			},
+			previous: failing,
+			want:     since,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, nonCompliantSince(tt.eval, tt.previous))
+		})
+	}
+}
+
+// windowFunctionDB is a [persistence.DB] that claims to support window functions and records the raw queries, since
+// the in-memory database cannot execute them.
+type windowFunctionDB struct {
+	persistence.DB
+	queries []string
+}
+
+func (db *windowFunctionDB) Capabilities() persistence.Capabilities {
+	return persistence.Capabilities{WindowFunctions: true}
+}
+
+func (db *windowFunctionDB) Raw(r any, query string, args ...any) error {
+	db.queries = append(db.queries, query)
+	return nil
+}
+
+func Test_latestEvaluationResults(t *testing.T) {
+	var (
+		key = func(r *evaluation.EvaluationResult) string {
+			return r.GetControlId()
+		}
+		result = func(id string, controlId string, day int) *evaluation.EvaluationResult {
+			return &evaluation.EvaluationResult{
+				Id:                   id,
+				TargetOfEvaluationId: evaluationtest.MockToeId1,
+				AuditScopeId:         evaluationtest.MockAuditScopeId1,
+				ControlCatalogId:     evaluationtest.MockCatalogId1,
+				ControlId:            controlId,
+				Status:               evaluation.EvaluationStatus_EVALUATION_STATUS_COMPLIANT,
+				Timestamp:            timestamppb.New(time.Date(2024, 1, day, 0, 0, 0, 0, time.UTC)),
+			}
+		}
+	)
+
+	t.Run("without window functions", func(t *testing.T) {
+		db := persistencetest.NewInMemoryDB(t, types, joinTables, func(d persistence.DB) {
+			assert.NoError(t, d.Create(result("00000000-0000-0000-0000-000000000001", "control-1", 1)))
+			assert.NoError(t, d.Create(result("00000000-0000-0000-0000-000000000002", "control-1", 2)))
+			assert.NoError(t, d.Create(result("00000000-0000-0000-0000-000000000003", "control-2", 1)))
+		})
+
+		got, err := latestEvaluationResults(db, []string{"control_id"}, key, "")
+		assert.NoError(t, err)
+		if assert.Equal(t, 2, len(got)) {
+			assert.Equal(t, "00000000-0000-0000-0000-000000000002", got[0].GetId())
+			assert.Equal(t, "00000000-0000-0000-0000-000000000003", got[1].GetId())
+		}
+	})
+
+	t.Run("with window functions", func(t *testing.T) {
+		db := &windowFunctionDB{DB: persistencetest.NewInMemoryDB(t, types, joinTables)}
+
+		_, err := latestEvaluationResults(db, []string{"audit_scope_id", "control_id"}, key, "WHERE status = ?", 1)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(db.queries))
+		assert.Contains(t, db.queries[0], "ROW_NUMBER() OVER (PARTITION BY audit_scope_id, control_id ORDER BY timestamp DESC)")
+		assert.Contains(t, db.queries[0], "WHERE status = ?")
+	})
+}
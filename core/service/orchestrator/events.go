@@ -104,16 +104,25 @@ func (svc *Service) UnregisterSubscriber(id int64) {
 	}
 }
 
-// publishEvent publishes a [orchestrator.ChangeEvent] to all subscribers.
+// publishEvent publishes a [orchestrator.ChangeEvent] to all subscribers and delivers it to all matching webhooks.
 func (svc *Service) publishEvent(event *orchestrator.ChangeEvent) {
-	svc.subscribersMutex.RLock()
-	defer svc.subscribersMutex.RUnlock()
-
 	if err := service.ValidateEvent(event); err != nil {
 		slog.Error("Attempted to publish invalid event", "event", event, log.Err(err))
 		return
 	}
 
+	svc.notifySubscribers(event)
+
+	// Webhooks are delivered after the subscribers, since their delivery might take a while
+	svc.deliverWebhooks(context.Background(), event)
+}
+
+// notifySubscribers sends the [orchestrator.ChangeEvent] to all subscribers whose filter matches the event. Subscribers
+// whose channel is full are skipped.
+func (svc *Service) notifySubscribers(event *orchestrator.ChangeEvent) {
+	svc.subscribersMutex.RLock()
+	defer svc.subscribersMutex.RUnlock()
+
 	for _, sub := range svc.subscribers {
 		// Check category filter
 		if sub.filter != nil && len(sub.filter.Categories) > 0 {
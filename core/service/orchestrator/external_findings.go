@@ -0,0 +1,434 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package orchestrator
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strconv"
+	"strings"
+
+	"confirmate.io/core/api/assessment"
+	"confirmate.io/core/api/orchestrator"
+	"confirmate.io/core/persistence"
+	"confirmate.io/core/service"
+	"confirmate.io/core/util/spreadsheet"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Column names of imported external findings.
+const (
+	findingColumnControl     = "control_id"
+	findingColumnMetric      = "metric_id"
+	findingColumnCompliant   = "compliant"
+	findingColumnComment     = "comment"
+	findingColumnResource    = "resource_id"
+	findingColumnReference   = "reference"
+	findingColumnSeverity    = "severity"
+	findingColumnEnvironment = "environment"
+)
+
+// externalFindingResourceType is the resource type of externally sourced assessment results, since they are not based
+// on the evidence of a resource.
+const externalFindingResourceType = "ExternalFinding"
+
+// findingColumns contains all columns of an imported external finding. They are the keys of the objects of a JSON file.
+var findingColumns = []string{
+	findingColumnControl,
+	findingColumnMetric,
+	findingColumnCompliant,
+	findingColumnComment,
+	findingColumnResource,
+	findingColumnReference,
+	findingColumnSeverity,
+	findingColumnEnvironment,
+}
+
+// importedFinding is a valid finding of an import of external findings together with the metrics it is assessed for.
+type importedFinding struct {
+	metricIds   []string
+	compliant   bool
+	comment     string
+	resourceId  string
+	environment *string
+	details     *assessment.ExternalFinding
+}
+
+// ImportExternalFindings imports the findings of an external audit, e.g., a manual penetration test, as assessment
+// results of a target of evaluation. Each finding is mapped to a control of the given catalog and results in an
+// externally sourced assessment result for each metric of the control (or only the given one), so that the evaluation
+// treats it like an automated assessment result. All findings are validated first and the resulting report is returned
+// to the caller. The findings are only imported if there are no issues and the request is not a dry run.
+func (svc *Service) ImportExternalFindings(
+	ctx context.Context,
+	req *connect.Request[orchestrator.ImportExternalFindingsRequest],
+) (res *connect.Response[orchestrator.ImportExternalFindingsResponse], err error) {
+	var (
+		rows    [][]string
+		results []*assessment.AssessmentResult
+		report  *orchestrator.ImportExternalFindingsResponse
+		allowed bool
+		userId  string
+		now     = timestamppb.Now()
+	)
+
+	// Validate the request
+	if err = service.Validate(req); err != nil {
+		return nil, err
+	}
+
+	// Check access via the configured auth strategy. Importing findings is the same as storing assessment results.
+	allowed, _, err = CheckAccess(ctx, svc.authz, svc, orchestrator.RequestType_REQUEST_TYPE_CREATED, req.Msg.GetTargetOfEvaluationId(), orchestrator.ObjectType_OBJECT_TYPE_ASSESSMENT_RESULT)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if !allowed || !service.AllowsTargetOfEvaluation(ctx, svc.authz, req.Msg.GetTargetOfEvaluationId()) {
+		return nil, service.ErrPermissionDenied
+	}
+
+	err = svc.db.Get(&orchestrator.TargetOfEvaluation{}, persistence.WithoutPreload(), "id = ?", req.Msg.GetTargetOfEvaluationId())
+	if err = service.HandleDatabaseError(err, service.ErrNotFound("target of evaluation")); err != nil {
+		return nil, err
+	}
+
+	err = svc.db.Get(&orchestrator.Catalog{}, persistence.WithoutPreload(), "id = ?", req.Msg.GetCatalogId())
+	if err = service.HandleDatabaseError(err, service.ErrNotFound("catalog")); err != nil {
+		return nil, err
+	}
+
+	switch req.Msg.GetFormat() {
+	case orchestrator.ExternalFindingsFileFormat_EXTERNAL_FINDINGS_FILE_FORMAT_JSON:
+		rows, err = readFindingsJSON(req.Msg.GetData())
+	default:
+		rows, err = spreadsheet.ReadCSV(req.Msg.GetData())
+	}
+	if err != nil {
+		return nil, service.NewInvalidFieldError("data", err)
+	}
+
+	userId, err = provisionCurrentUser(ctx, svc)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	results, report, err = svc.parseExternalFindings(ctx, req.Msg, rows, userId, now)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(report.Issues) == 0 && !req.Msg.GetDryRun() {
+		err = svc.db.Transaction(func(tx persistence.DB) error {
+			for _, result := range results {
+				if err := tx.Create(result); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		if err = service.HandleDatabaseError(err); err != nil {
+			return nil, err
+		}
+
+		svc.meterAssessmentResults(results, now.AsTime())
+
+		// Notify subscribers
+		for _, result := range results {
+			report.AssessmentResultIds = append(report.AssessmentResultIds, result.GetId())
+
+			go svc.publishEvent(&orchestrator.ChangeEvent{
+				Timestamp:   timestamppb.Now(),
+				Category:    orchestrator.EventCategory_EVENT_CATEGORY_ASSESSMENT_RESULT,
+				RequestType: orchestrator.RequestType_REQUEST_TYPE_CREATED,
+				EntityId:    result.Id,
+				Entity: &orchestrator.ChangeEvent_AssessmentResult{
+					AssessmentResult: result,
+				},
+			})
+		}
+
+		report.Applied = true
+
+		slog.Info("Imported external findings",
+			slog.String("target_of_evaluation_id", req.Msg.GetTargetOfEvaluationId()),
+			slog.String("source", req.Msg.GetSource()),
+			slog.Int("findings", int(report.Findings)),
+			slog.Int("assessment_results", len(results)),
+		)
+	}
+
+	res = connect.NewResponse(report)
+	return
+}
+
+// parseExternalFindings validates the rows of imported external findings against the controls of the catalog of the
+// request and their metrics. It returns the assessment results of the valid findings as well as a report containing an
+// issue for each invalid row. Only errors of the database are returned as error.
+func (svc *Service) parseExternalFindings(
+	ctx context.Context,
+	req *orchestrator.ImportExternalFindingsRequest,
+	rows [][]string,
+	userId string,
+	now *timestamppb.Timestamp,
+) (
+	results []*assessment.AssessmentResult,
+	report *orchestrator.ImportExternalFindingsResponse,
+	err error,
+) {
+	var (
+		controls   []*orchestrator.Control
+		mappings   []*orchestrator.ControlMetric
+		controlIds []string
+		byRef      = make(map[string]string)
+		ambiguous  = make(map[string]bool)
+		metrics    = make(map[string][]string)
+		cols       map[string]int
+	)
+
+	report = &orchestrator.ImportExternalFindingsResponse{}
+
+	if len(rows) == 0 {
+		return nil, nil, service.NewInvalidFieldError("data", errors.New("file is empty"))
+	}
+
+	cols = make(map[string]int)
+	for i, name := range rows[0] {
+		cols[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	for _, name := range []string{findingColumnControl, findingColumnCompliant, findingColumnComment} {
+		if _, ok := cols[name]; !ok {
+			return nil, nil, service.NewInvalidFieldError("data", fmt.Errorf("header does not contain the column %q", name))
+		}
+	}
+
+	// Controls can be referenced both by their ID and their catalog-local short name
+	err = svc.db.List(&controls, "id", true, 0, -1, persistence.WithoutPreload(), "catalog_id = ?", req.GetCatalogId())
+	if err = service.HandleDatabaseError(err); err != nil {
+		return nil, nil, err
+	}
+	for _, control := range controls {
+		if _, ok := byRef[control.GetShortName()]; ok {
+			ambiguous[control.GetShortName()] = true
+		}
+		byRef[control.GetShortName()] = control.GetId()
+		controlIds = append(controlIds, control.GetId())
+	}
+	for _, control := range controls {
+		byRef[control.GetId()] = control.GetId()
+	}
+
+	// A finding can only be evaluated through the metrics of its control
+	if len(controlIds) > 0 {
+		err = svc.db.List(&mappings, "control_id", true, 0, -1, persistence.WithoutPreload(), "control_id IN ?", controlIds)
+		if err = service.HandleDatabaseError(err); err != nil {
+			return nil, nil, err
+		}
+	}
+	for _, mapping := range mappings {
+		metrics[mapping.GetControlId()] = append(metrics[mapping.GetControlId()], mapping.GetMetricId())
+	}
+
+	for i, row := range rows[1:] {
+		var (
+			rowNum    = int32(i + 2)
+			ref       = cell(row, cols, findingColumnControl)
+			metricId  = cell(row, cols, findingColumnMetric)
+			compliant = cell(row, cols, findingColumnCompliant)
+			finding   = &importedFinding{
+				comment:    cell(row, cols, findingColumnComment),
+				resourceId: cell(row, cols, findingColumnResource),
+				details:    &assessment.ExternalFinding{Source: req.GetSource(), ImportedBy: userId},
+			}
+			controlId string
+			issues    []string
+			ok        bool
+			berr      error
+		)
+
+		// Skip empty rows, which spreadsheet applications often leave at the end of a file
+		if !slices.ContainsFunc(row, func(c string) bool { return strings.TrimSpace(c) != "" }) {
+			continue
+		}
+		report.Findings++
+
+		if controlId, ok = byRef[ref]; !ok {
+			issues = append(issues, fmt.Sprintf("control %q does not exist in the catalog", ref))
+		} else if ambiguous[ref] {
+			issues = append(issues, fmt.Sprintf("short name %q refers to multiple controls, use the control ID instead", ref))
+		} else if metricId == "" {
+			if finding.metricIds = metrics[controlId]; len(finding.metricIds) == 0 {
+				issues = append(issues, fmt.Sprintf("control %q is not mapped to any metric", ref))
+			}
+		} else if !slices.Contains(metrics[controlId], metricId) {
+			issues = append(issues, fmt.Sprintf("metric %q is not mapped to control %q", metricId, ref))
+		} else {
+			finding.metricIds = []string{metricId}
+		}
+		if finding.compliant, berr = strconv.ParseBool(compliant); berr != nil {
+			issues = append(issues, fmt.Sprintf("compliant %q is not a boolean", compliant))
+		}
+		if finding.comment == "" {
+			issues = append(issues, "comment must not be empty")
+		}
+
+		for _, msg := range issues {
+			report.Issues = append(report.Issues, &orchestrator.ImportIssue{Row: rowNum, Message: msg})
+		}
+		if len(issues) > 0 {
+			continue
+		}
+
+		if v := cell(row, cols, findingColumnReference); v != "" {
+			finding.details.Reference = &v
+		}
+		if v := cell(row, cols, findingColumnSeverity); v != "" {
+			finding.details.Severity = &v
+		}
+		if v := cell(row, cols, findingColumnEnvironment); v != "" {
+			finding.environment = &v
+		}
+
+		results, err = svc.appendFindingResults(ctx, results, req, finding, now)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return results, report, nil
+}
+
+// appendFindingResults appends an externally sourced assessment result of the finding for each of its metrics to
+// results. All results of a finding share an evidence ID, which identifies the finding.
+func (svc *Service) appendFindingResults(
+	ctx context.Context,
+	results []*assessment.AssessmentResult,
+	req *orchestrator.ImportExternalFindingsRequest,
+	finding *importedFinding,
+	now *timestamppb.Timestamp,
+) ([]*assessment.AssessmentResult, error) {
+	var (
+		evidenceId = uuid.NewString()
+		resourceId = finding.resourceId
+	)
+
+	// Without a resource, a finding is identified by its reference, so that the latest import of it supersedes the
+	// previous ones
+	if resourceId == "" {
+		resourceId = finding.details.GetReference()
+	}
+	if resourceId == "" {
+		resourceId = evidenceId
+	}
+
+	for _, metricId := range finding.metricIds {
+		config, err := svc.findingMetricConfiguration(ctx, req, metricId)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, &assessment.AssessmentResult{
+			Id:                   uuid.NewString(),
+			CreatedAt:            now,
+			MetricId:             metricId,
+			MetricConfiguration:  config,
+			Compliant:            finding.compliant,
+			EvidenceId:           evidenceId,
+			ResourceId:           resourceId,
+			ResourceTypes:        []string{externalFindingResourceType},
+			ComplianceComment:    finding.comment,
+			TargetOfEvaluationId: req.GetTargetOfEvaluationId(),
+			ToolId:               new(req.GetSource()),
+			HistoryUpdatedAt:     now,
+			History: []*assessment.Record{{
+				EvidenceId:         evidenceId,
+				EvidenceRecordedAt: now,
+			}},
+			Environment:       finding.environment,
+			ExternallySourced: true,
+			ExternalFinding:   finding.details,
+		})
+	}
+
+	return results, nil
+}
+
+// findingMetricConfiguration returns the configuration of the metric for the target of evaluation of the request, in
+// the same way as [Service.GetMetricConfiguration]. Since a finding is not compared against a target value, a metric
+// without any configuration is not an error. Instead, the finding is described as compliant if it equals true.
+func (svc *Service) findingMetricConfiguration(ctx context.Context, req *orchestrator.ImportExternalFindingsRequest, metricId string) (config *assessment.MetricConfiguration, err error) {
+	var res *connect.Response[assessment.MetricConfiguration]
+
+	res, err = svc.GetMetricConfiguration(ctx, connect.NewRequest(&orchestrator.GetMetricConfigurationRequest{
+		TargetOfEvaluationId: req.GetTargetOfEvaluationId(),
+		MetricId:             metricId,
+		CatalogId:            new(req.GetCatalogId()),
+	}))
+	if connect.CodeOf(err) == connect.CodeNotFound {
+		return &assessment.MetricConfiguration{
+			Operator:             "==",
+			TargetValue:          structpb.NewBoolValue(true),
+			MetricId:             metricId,
+			TargetOfEvaluationId: req.GetTargetOfEvaluationId(),
+		}, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	return res.Msg, nil
+}
+
+// readFindingsJSON reads a JSON array of external findings into rows, whose first row is a header containing
+// [findingColumns], so that they can be parsed in the same way as a CSV file. Keys that are not a column are ignored.
+func readFindingsJSON(data []byte) (rows [][]string, err error) {
+	var (
+		findings []map[string]any
+		dec      = json.NewDecoder(bytes.NewReader(data))
+	)
+
+	dec.UseNumber()
+	if err = dec.Decode(&findings); err != nil {
+		return nil, fmt.Errorf("invalid JSON: %w", err)
+	}
+
+	rows = append(rows, findingColumns)
+	for i, finding := range findings {
+		row := make([]string, len(findingColumns))
+		for j, name := range findingColumns {
+			switch v := finding[name].(type) {
+			case nil:
+			case string:
+				row[j] = v
+			case bool:
+				row[j] = strconv.FormatBool(v)
+			case json.Number:
+				row[j] = v.String()
+			default:
+				return nil, fmt.Errorf("value of %q of finding %d is not a string, number or boolean", name, i+1)
+			}
+		}
+		rows = append(rows, row)
+	}
+
+	return rows, nil
+}
@@ -0,0 +1,285 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package orchestrator
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"confirmate.io/core/api/assessment"
+	"confirmate.io/core/api/orchestrator"
+	"confirmate.io/core/persistence"
+	"confirmate.io/core/persistence/persistencetest"
+	"confirmate.io/core/service"
+	"confirmate.io/core/service/orchestrator/orchestratortest"
+	"confirmate.io/core/util/assert"
+
+	"connectrpc.com/connect"
+)
+
+// newFindingsCSV returns a CSV file with the given header and rows of external findings.
+func newFindingsCSV(header string, rows ...string) []byte {
+	return []byte(strings.Join(append([]string{header}, rows...), "\n"))
+}
+
+// newFindingsRequest returns a request importing the given data of external findings into MockCatalog1 for
+// MockToeId1.
+func newFindingsRequest(format orchestrator.ExternalFindingsFileFormat, data []byte) *orchestrator.ImportExternalFindingsRequest {
+	return &orchestrator.ImportExternalFindingsRequest{
+		TargetOfEvaluationId: orchestratortest.MockToeId1,
+		CatalogId:            orchestratortest.MockCatalogId1,
+		Source:               "Pentest 2026",
+		Format:               format,
+		Data:                 data,
+	}
+}
+
+func TestService_ImportExternalFindings(t *testing.T) {
+	var (
+		seed = func(d persistence.DB) {
+			assert.NoError(t, d.Create(orchestratortest.MockTargetOfEvaluation1))
+			assert.NoError(t, d.Create(orchestratortest.MockCatalog1))
+		}
+		header = "control_id,metric_id,compliant,comment,reference,severity"
+	)
+
+	type fields struct {
+		db    persistence.DB
+		authz service.AuthorizationStrategy
+	}
+	tests := []struct {
+		name    string
+		req     *orchestrator.ImportExternalFindingsRequest
+		fields  fields
+		want    assert.Want[*connect.Response[orchestrator.ImportExternalFindingsResponse]]
+		wantDB  assert.Want[persistence.DB]
+		wantErr assert.WantErr
+	}{
+		{
+			name: "validation error",
+			req:  newFindingsRequest(orchestrator.ExternalFindingsFileFormat_EXTERNAL_FINDINGS_FILE_FORMAT_UNSPECIFIED, []byte(header)),
+			fields: fields{
+				db:    persistencetest.NewInMemoryDB(t, types, joinTables),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			want:   assert.Nil[*connect.Response[orchestrator.ImportExternalFindingsResponse]],
+			wantDB: assert.NotNil[persistence.DB],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeInvalidArgument)
+			},
+		},
+		{
+			name: "authorization error",
+			req:  newFindingsRequest(orchestrator.ExternalFindingsFileFormat_EXTERNAL_FINDINGS_FILE_FORMAT_CSV, []byte(header)),
+			fields: fields{
+				db:    persistencetest.NewInMemoryDB(t, types, joinTables, seed),
+				authz: &service.AuthorizationStrategyPermissionStore{},
+			},
+			want:   assert.Nil[*connect.Response[orchestrator.ImportExternalFindingsResponse]],
+			wantDB: assert.NotNil[persistence.DB],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodePermissionDenied)
+			},
+		},
+		{
+			name: "target of evaluation not found",
+			req:  newFindingsRequest(orchestrator.ExternalFindingsFileFormat_EXTERNAL_FINDINGS_FILE_FORMAT_CSV, []byte(header)),
+			fields: fields{
+				db: persistencetest.NewInMemoryDB(t, types, joinTables, func(d persistence.DB) {
+					assert.NoError(t, d.Create(orchestratortest.MockCatalog1))
+				}),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			want:   assert.Nil[*connect.Response[orchestrator.ImportExternalFindingsResponse]],
+			wantDB: assert.NotNil[persistence.DB],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeNotFound)
+			},
+		},
+		{
+			name: "catalog not found",
+			req:  newFindingsRequest(orchestrator.ExternalFindingsFileFormat_EXTERNAL_FINDINGS_FILE_FORMAT_CSV, []byte(header)),
+			fields: fields{
+				db: persistencetest.NewInMemoryDB(t, types, joinTables, func(d persistence.DB) {
+					assert.NoError(t, d.Create(orchestratortest.MockTargetOfEvaluation1))
+				}),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			want:   assert.Nil[*connect.Response[orchestrator.ImportExternalFindingsResponse]],
+			wantDB: assert.NotNil[persistence.DB],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeNotFound)
+			},
+		},
+		{
+			name: "missing column",
+			req:  newFindingsRequest(orchestrator.ExternalFindingsFileFormat_EXTERNAL_FINDINGS_FILE_FORMAT_CSV, []byte("control_id,compliant\ncontrol-1,true")),
+			fields: fields{
+				db:    persistencetest.NewInMemoryDB(t, types, joinTables, seed),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			want:   assert.Nil[*connect.Response[orchestrator.ImportExternalFindingsResponse]],
+			wantDB: assert.NotNil[persistence.DB],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeInvalidArgument) &&
+					assert.HasFieldViolation(t, err, "data")
+			},
+		},
+		{
+			name: "invalid JSON",
+			req:  newFindingsRequest(orchestrator.ExternalFindingsFileFormat_EXTERNAL_FINDINGS_FILE_FORMAT_JSON, []byte(`[{"control_id": ["control-1"]}]`)),
+			fields: fields{
+				db:    persistencetest.NewInMemoryDB(t, types, joinTables, seed),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			want:   assert.Nil[*connect.Response[orchestrator.ImportExternalFindingsResponse]],
+			wantDB: assert.NotNil[persistence.DB],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeInvalidArgument) &&
+					assert.HasFieldViolation(t, err, "data")
+			},
+		},
+		{
+			name: "invalid rows",
+			req: newFindingsRequest(orchestrator.ExternalFindingsFileFormat_EXTERNAL_FINDINGS_FILE_FORMAT_CSV, newFindingsCSV(header,
+				"unknown,,false,Finding,,",
+				orchestratortest.MockSubControlShortName1+",,false,Finding,,",
+				orchestratortest.MockControlShortName1+",,false,Finding,,",
+				orchestratortest.MockSubControlShortName2+","+orchestratortest.MockMetricId1+",false,Finding,,",
+				orchestratortest.MockSubControlShortName2+",,maybe,,,",
+				orchestratortest.MockSubControlShortName2+",,false,Finding,,",
+			)),
+			fields: fields{
+				db:    persistencetest.NewInMemoryDB(t, types, joinTables, seed),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			want: func(t *testing.T, got *connect.Response[orchestrator.ImportExternalFindingsResponse], args ...any) bool {
+				return assert.Equal(t, int32(6), got.Msg.Findings) &&
+					assert.False(t, got.Msg.Applied) &&
+					assert.Empty(t, got.Msg.AssessmentResultIds) &&
+					assert.Equal(t, 6, len(got.Msg.Issues)) &&
+					assert.Equal(t, int32(2), got.Msg.Issues[0].Row) &&
+					assert.Equal(t, int32(6), got.Msg.Issues[5].Row)
+			},
+			wantDB: func(t *testing.T, db persistence.DB, args ...any) bool {
+				n, err := db.Count(&assessment.AssessmentResult{})
+				assert.NoError(t, err)
+
+				return assert.Equal(t, int64(0), n)
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "dry run",
+			req: func() *orchestrator.ImportExternalFindingsRequest {
+				req := newFindingsRequest(orchestrator.ExternalFindingsFileFormat_EXTERNAL_FINDINGS_FILE_FORMAT_CSV, newFindingsCSV(header,
+					orchestratortest.MockSubControlShortName2+",,false,Finding,,",
+				))
+				req.DryRun = true
+				return req
+			}(),
+			fields: fields{
+				db:    persistencetest.NewInMemoryDB(t, types, joinTables, seed),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			want: func(t *testing.T, got *connect.Response[orchestrator.ImportExternalFindingsResponse], args ...any) bool {
+				return assert.Equal(t, int32(1), got.Msg.Findings) &&
+					assert.Empty(t, got.Msg.Issues) &&
+					assert.False(t, got.Msg.Applied)
+			},
+			wantDB: func(t *testing.T, db persistence.DB, args ...any) bool {
+				n, err := db.Count(&assessment.AssessmentResult{})
+				assert.NoError(t, err)
+
+				return assert.Equal(t, int64(0), n)
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "happy path: CSV",
+			req: newFindingsRequest(orchestrator.ExternalFindingsFileFormat_EXTERNAL_FINDINGS_FILE_FORMAT_CSV, newFindingsCSV(header,
+				orchestratortest.MockSubControlShortName2+",,false,SQL injection in login form,PT-1,high",
+				"",
+				orchestratortest.MockControl1SubControlId1+","+orchestratortest.MockMetricId1+",true,No weak ciphers found,,",
+			)),
+			fields: fields{
+				db:    persistencetest.NewInMemoryDB(t, types, joinTables, seed),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			want: func(t *testing.T, got *connect.Response[orchestrator.ImportExternalFindingsResponse], args ...any) bool {
+				return assert.Equal(t, int32(2), got.Msg.Findings) &&
+					assert.Empty(t, got.Msg.Issues) &&
+					assert.Equal(t, 2, len(got.Msg.AssessmentResultIds)) &&
+					assert.True(t, got.Msg.Applied)
+			},
+			wantDB: func(t *testing.T, db persistence.DB, args ...any) bool {
+				var result assessment.AssessmentResult
+
+				assert.NoError(t, db.Get(&result, "metric_id = ?", orchestratortest.MockMetricId2))
+
+				return assert.True(t, result.GetExternallySourced()) &&
+					assert.False(t, result.GetCompliant()) &&
+					assert.Equal(t, "PT-1", result.GetResourceId()) &&
+					assert.Equal(t, "Pentest 2026", result.GetToolId()) &&
+					assert.Equal(t, "SQL injection in login form", result.GetComplianceComment()) &&
+					assert.Equal(t, []string{externalFindingResourceType}, result.GetResourceTypes()) &&
+					assert.Equal(t, "high", result.GetExternalFinding().GetSeverity()) &&
+					assert.Equal(t, orchestratortest.MockMetricId2, result.GetMetricConfiguration().GetMetricId())
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "happy path: JSON",
+			req: newFindingsRequest(orchestrator.ExternalFindingsFileFormat_EXTERNAL_FINDINGS_FILE_FORMAT_JSON, []byte(`[
+				{"control_id": "`+orchestratortest.MockControl1SubControlId1+`", "compliant": true, "comment": "Passed", "environment": "prod", "unknown": 1}
+			]`)),
+			fields: fields{
+				db:    persistencetest.NewInMemoryDB(t, types, joinTables, seed),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			want: func(t *testing.T, got *connect.Response[orchestrator.ImportExternalFindingsResponse], args ...any) bool {
+				return assert.Equal(t, int32(1), got.Msg.Findings) &&
+					assert.True(t, got.Msg.Applied)
+			},
+			wantDB: func(t *testing.T, db persistence.DB, args ...any) bool {
+				var result assessment.AssessmentResult
+
+				assert.NoError(t, db.Get(&result, "metric_id = ?", orchestratortest.MockMetricId1))
+
+				// Without a resource and a reference, the finding is its own resource
+				return assert.True(t, result.GetExternallySourced()) &&
+					assert.True(t, result.GetCompliant()) &&
+					assert.Equal(t, "prod", result.GetEnvironment()) &&
+					assert.Equal(t, result.GetEvidenceId(), result.GetResourceId())
+			},
+			wantErr: assert.NoError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &Service{
+				db:    tt.fields.db,
+				authz: tt.fields.authz,
+			}
+			got, gotErr := svc.ImportExternalFindings(context.Background(), connect.NewRequest(tt.req))
+
+			tt.want(t, got)
+			tt.wantDB(t, tt.fields.db)
+			tt.wantErr(t, gotErr)
+		})
+	}
+}
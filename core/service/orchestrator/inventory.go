@@ -0,0 +1,106 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package orchestrator
+
+import (
+	"context"
+
+	"confirmate.io/core/api/orchestrator"
+	"confirmate.io/core/persistence"
+	"confirmate.io/core/service"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// StoreResourceInventory stores the resource inventory of a collector for a target of evaluation, replacing the
+// previous inventory of the same collector. Since collectors report to the target of evaluation they collect evidences
+// for, this requires update access to it.
+func (svc *Service) StoreResourceInventory(
+	ctx context.Context,
+	req *connect.Request[orchestrator.StoreResourceInventoryRequest],
+) (res *connect.Response[orchestrator.ResourceInventory], err error) {
+	var (
+		inventory *orchestrator.ResourceInventory
+	)
+
+	// Validate the request
+	if err = service.Validate(req); err != nil {
+		return nil, err
+	}
+
+	// Check access via the configured auth strategy
+	if err = svc.checkTargetOfEvaluationAccess(ctx, orchestrator.RequestType_REQUEST_TYPE_UPDATED, req.Msg.Inventory.GetTargetOfEvaluationId()); err != nil {
+		return nil, err
+	}
+
+	// The target of evaluation must exist
+	err = svc.db.Get(&orchestrator.TargetOfEvaluation{}, persistence.WithoutPreload(), "id = ?", req.Msg.Inventory.GetTargetOfEvaluationId())
+	if err = service.HandleDatabaseError(err, service.ErrNotFound("target of evaluation")); err != nil {
+		return nil, err
+	}
+
+	inventory = req.Msg.GetInventory()
+	inventory.UpdatedAt = timestamppb.Now()
+
+	err = svc.db.Save(inventory, "target_of_evaluation_id = ? AND collector_id = ?", inventory.GetTargetOfEvaluationId(), inventory.GetCollectorId())
+	if err = service.HandleDatabaseError(err); err != nil {
+		return nil, err
+	}
+
+	res = connect.NewResponse(inventory)
+	return
+}
+
+// ListResourceInventories lists the resource inventories of the collectors of a target of evaluation. Unless
+// requested otherwise, the inventories are ordered by the ID of their collector.
+func (svc *Service) ListResourceInventories(
+	ctx context.Context,
+	req *connect.Request[orchestrator.ListResourceInventoriesRequest],
+) (res *connect.Response[orchestrator.ListResourceInventoriesResponse], err error) {
+	var (
+		inventories []*orchestrator.ResourceInventory
+		npt         string
+	)
+
+	// Validate the request
+	if err = service.Validate(req); err != nil {
+		return nil, err
+	}
+
+	// Check access via the configured auth strategy
+	if err = svc.checkTargetOfEvaluationAccess(ctx, orchestrator.RequestType_REQUEST_TYPE_GET, req.Msg.GetTargetOfEvaluationId()); err != nil {
+		return nil, err
+	}
+
+	// Set default ordering
+	if req.Msg.OrderBy == "" {
+		req.Msg.OrderBy = "collector_id"
+		req.Msg.Asc = true
+	}
+
+	inventories, npt, err = service.PaginateStorage[*orchestrator.ResourceInventory](req.Msg, svc.db, service.DefaultPaginationOpts,
+		"target_of_evaluation_id = ?", req.Msg.GetTargetOfEvaluationId())
+	if err = service.HandleDatabaseError(err); err != nil {
+		return nil, err
+	}
+
+	res = connect.NewResponse(&orchestrator.ListResourceInventoriesResponse{
+		Inventories:   inventories,
+		NextPageToken: npt,
+	})
+	return
+}
@@ -0,0 +1,286 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package orchestrator
+
+import (
+	"context"
+	"testing"
+
+	"confirmate.io/core/api/orchestrator"
+	"confirmate.io/core/persistence"
+	"confirmate.io/core/persistence/persistencetest"
+	"confirmate.io/core/service"
+	"confirmate.io/core/service/orchestrator/orchestratortest"
+	"confirmate.io/core/util/assert"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// mockInventoryRecords returns a target of evaluation with the inventories of two collectors.
+func mockInventoryRecords() (records []any) {
+	records = []any{orchestratortest.MockTargetOfEvaluation1}
+
+	for _, collectorId := range []string{"cloud-azure", "cloud-aws"} {
+		records = append(records, &orchestrator.ResourceInventory{
+			TargetOfEvaluationId: orchestratortest.MockToeId1,
+			CollectorId:          collectorId,
+			ToolId:               "confirmate-collector-cloud",
+			ResourceTypes: []*orchestrator.ResourceTypeCount{
+				{ResourceType: "VirtualMachine", Count: 3},
+			},
+			Regions:     []string{"eu-central-1"},
+			CollectedAt: timestamppb.Now(),
+		})
+	}
+
+	return
+}
+
+func TestService_StoreResourceInventory(t *testing.T) {
+	type fields struct {
+		db    persistence.DB
+		authz service.AuthorizationStrategy
+	}
+	tests := []struct {
+		name    string
+		req     *orchestrator.StoreResourceInventoryRequest
+		fields  fields
+		want    assert.Want[*connect.Response[orchestrator.ResourceInventory]]
+		wantDB  assert.Want[persistence.DB]
+		wantErr assert.WantErr
+	}{
+		{
+			name: "happy path: new collector",
+			req: &orchestrator.StoreResourceInventoryRequest{
+				Inventory: &orchestrator.ResourceInventory{
+					TargetOfEvaluationId: orchestratortest.MockToeId1,
+					CollectorId:          "cloud-openstack",
+					ToolId:               "confirmate-collector-cloud",
+					ResourceTypes: []*orchestrator.ResourceTypeCount{
+						{ResourceType: "BlockStorage", Count: 2},
+					},
+					CollectedAt: timestamppb.Now(),
+				},
+			},
+			fields: fields{
+				db:    persistencetest.NewInMemoryDB(t, types, joinTables, createRecords(t, mockInventoryRecords()...)),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			want: func(t *testing.T, got *connect.Response[orchestrator.ResourceInventory], args ...any) bool {
+				return assert.NotNil(t, got.Msg.GetUpdatedAt())
+			},
+			wantDB: func(t *testing.T, db persistence.DB, args ...any) bool {
+				n, err := db.Count(&orchestrator.ResourceInventory{}, "target_of_evaluation_id = ?", orchestratortest.MockToeId1)
+				assert.NoError(t, err)
+
+				return assert.Equal(t, int64(3), n)
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "happy path: replace inventory of collector",
+			req: &orchestrator.StoreResourceInventoryRequest{
+				Inventory: &orchestrator.ResourceInventory{
+					TargetOfEvaluationId: orchestratortest.MockToeId1,
+					CollectorId:          "cloud-azure",
+					ToolId:               "confirmate-collector-cloud",
+					ResourceTypes: []*orchestrator.ResourceTypeCount{
+						{ResourceType: "VirtualMachine", Count: 5},
+						{ResourceType: "ObjectStorage", Count: 1},
+					},
+					Regions:     []string{"westeurope"},
+					CollectedAt: timestamppb.Now(),
+				},
+			},
+			fields: fields{
+				db:    persistencetest.NewInMemoryDB(t, types, joinTables, createRecords(t, mockInventoryRecords()...)),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			want: func(t *testing.T, got *connect.Response[orchestrator.ResourceInventory], args ...any) bool {
+				return assert.Equal(t, "cloud-azure", got.Msg.GetCollectorId())
+			},
+			wantDB: func(t *testing.T, db persistence.DB, args ...any) bool {
+				var inventory orchestrator.ResourceInventory
+				err := db.Get(&inventory, "target_of_evaluation_id = ? AND collector_id = ?", orchestratortest.MockToeId1, "cloud-azure")
+				assert.NoError(t, err)
+
+				n, err := db.Count(&orchestrator.ResourceInventory{}, "target_of_evaluation_id = ?", orchestratortest.MockToeId1)
+				assert.NoError(t, err)
+
+				return assert.Equal(t, int64(2), n) &&
+					assert.Equal(t, 2, len(inventory.ResourceTypes)) &&
+					assert.Equal(t, int64(5), inventory.ResourceTypes[0].GetCount()) &&
+					assert.Equal(t, []string{"westeurope"}, inventory.Regions)
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "validation error: missing collector",
+			req: &orchestrator.StoreResourceInventoryRequest{
+				Inventory: &orchestrator.ResourceInventory{
+					TargetOfEvaluationId: orchestratortest.MockToeId1,
+					ToolId:               "confirmate-collector-cloud",
+					CollectedAt:          timestamppb.Now(),
+				},
+			},
+			fields: fields{
+				db:    persistencetest.NewInMemoryDB(t, types, joinTables, createRecords(t, mockInventoryRecords()...)),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			want:   assert.Nil[*connect.Response[orchestrator.ResourceInventory]],
+			wantDB: assert.AnyValue[persistence.DB],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeInvalidArgument)
+			},
+		},
+		{
+			name: "target of evaluation not found",
+			req: &orchestrator.StoreResourceInventoryRequest{
+				Inventory: &orchestrator.ResourceInventory{
+					TargetOfEvaluationId: "00000000-0000-0000-0000-000000000099",
+					CollectorId:          "cloud-azure",
+					ToolId:               "confirmate-collector-cloud",
+					CollectedAt:          timestamppb.Now(),
+				},
+			},
+			fields: fields{
+				db:    persistencetest.NewInMemoryDB(t, types, joinTables, createRecords(t, mockInventoryRecords()...)),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			want: assert.Nil[*connect.Response[orchestrator.ResourceInventory]],
+			wantDB: func(t *testing.T, db persistence.DB, args ...any) bool {
+				n, err := db.Count(&orchestrator.ResourceInventory{}, "target_of_evaluation_id = ?", "00000000-0000-0000-0000-000000000099")
+				assert.NoError(t, err)
+
+				return assert.Equal(t, int64(0), n)
+			},
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeNotFound)
+			},
+		},
+		{
+			name: "permission denied",
+			req: &orchestrator.StoreResourceInventoryRequest{
+				Inventory: &orchestrator.ResourceInventory{
+					TargetOfEvaluationId: orchestratortest.MockToeId1,
+					CollectorId:          "cloud-openstack",
+					ToolId:               "confirmate-collector-cloud",
+					CollectedAt:          timestamppb.Now(),
+				},
+			},
+			fields: fields{
+				db:    persistencetest.NewInMemoryDB(t, types, joinTables, createRecords(t, mockInventoryRecords()...)),
+				authz: &denyAuthorizationStrategy{},
+			},
+			want: assert.Nil[*connect.Response[orchestrator.ResourceInventory]],
+			wantDB: func(t *testing.T, db persistence.DB, args ...any) bool {
+				n, err := db.Count(&orchestrator.ResourceInventory{}, "target_of_evaluation_id = ?", orchestratortest.MockToeId1)
+				assert.NoError(t, err)
+
+				return assert.Equal(t, int64(2), n)
+			},
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodePermissionDenied)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &Service{
+				db:    tt.fields.db,
+				authz: tt.fields.authz,
+			}
+			got, gotErr := svc.StoreResourceInventory(context.Background(), connect.NewRequest(tt.req))
+
+			tt.want(t, got)
+			tt.wantDB(t, tt.fields.db)
+			tt.wantErr(t, gotErr)
+		})
+	}
+}
+
+func TestService_ListResourceInventories(t *testing.T) {
+	type fields struct {
+		db    persistence.DB
+		authz service.AuthorizationStrategy
+	}
+	tests := []struct {
+		name    string
+		req     *orchestrator.ListResourceInventoriesRequest
+		fields  fields
+		want    assert.Want[*connect.Response[orchestrator.ListResourceInventoriesResponse]]
+		wantErr assert.WantErr
+	}{
+		{
+			name: "happy path",
+			req: &orchestrator.ListResourceInventoriesRequest{
+				TargetOfEvaluationId: orchestratortest.MockToeId1,
+			},
+			fields: fields{
+				db:    persistencetest.NewInMemoryDB(t, types, joinTables, createRecords(t, mockInventoryRecords()...)),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			want: func(t *testing.T, got *connect.Response[orchestrator.ListResourceInventoriesResponse], args ...any) bool {
+				return assert.Equal(t, 2, len(got.Msg.Inventories)) &&
+					assert.Equal(t, "cloud-aws", got.Msg.Inventories[0].GetCollectorId()) &&
+					assert.Equal(t, "cloud-azure", got.Msg.Inventories[1].GetCollectorId()) &&
+					assert.Equal(t, 1, len(got.Msg.Inventories[0].ResourceTypes))
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "other target of evaluation",
+			req: &orchestrator.ListResourceInventoriesRequest{
+				TargetOfEvaluationId: orchestratortest.MockToeId2,
+			},
+			fields: fields{
+				db:    persistencetest.NewInMemoryDB(t, types, joinTables, createRecords(t, mockInventoryRecords()...)),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			want: func(t *testing.T, got *connect.Response[orchestrator.ListResourceInventoriesResponse], args ...any) bool {
+				return assert.Empty(t, got.Msg.Inventories)
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "permission denied",
+			req: &orchestrator.ListResourceInventoriesRequest{
+				TargetOfEvaluationId: orchestratortest.MockToeId1,
+			},
+			fields: fields{
+				db:    persistencetest.NewInMemoryDB(t, types, joinTables, createRecords(t, mockInventoryRecords()...)),
+				authz: &denyAuthorizationStrategy{},
+			},
+			want: assert.Nil[*connect.Response[orchestrator.ListResourceInventoriesResponse]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodePermissionDenied)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &Service{
+				db:    tt.fields.db,
+				authz: tt.fields.authz,
+			}
+			got, gotErr := svc.ListResourceInventories(context.Background(), connect.NewRequest(tt.req))
+
+			tt.want(t, got)
+			tt.wantErr(t, gotErr)
+		})
+	}
+}
@@ -0,0 +1,188 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package orchestrator
+
+import (
+	"context"
+	"time"
+
+	"confirmate.io/core/api/orchestrator"
+	"confirmate.io/core/persistence"
+	"confirmate.io/core/service"
+
+	"buf.build/go/protovalidate"
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// CreateMaintenanceWindow creates a maintenance window for an audit scope. Since maintenance windows change how the
+// audit scope is evaluated, this requires update access to the audit scope.
+func (svc *Service) CreateMaintenanceWindow(
+	ctx context.Context,
+	req *connect.Request[orchestrator.CreateMaintenanceWindowRequest],
+) (res *connect.Response[orchestrator.MaintenanceWindow], err error) {
+	var (
+		window *orchestrator.MaintenanceWindow
+	)
+
+	// Validate the request. The ID of the maintenance window is generated by us.
+	if err = service.Validate(req, protovalidate.WithFilter(service.IgnoreIDFilter)); err != nil {
+		return nil, err
+	}
+
+	// Check access via the configured auth strategy
+	if _, err = svc.checkAuditScopeAccess(ctx, orchestrator.RequestType_REQUEST_TYPE_UPDATED, req.Msg.MaintenanceWindow.GetAuditScopeId()); err != nil {
+		return nil, err
+	}
+
+	window = &orchestrator.MaintenanceWindow{
+		Id:           uuid.NewString(),
+		AuditScopeId: req.Msg.MaintenanceWindow.GetAuditScopeId(),
+		StartsAt:     req.Msg.MaintenanceWindow.GetStartsAt(),
+		EndsAt:       req.Msg.MaintenanceWindow.GetEndsAt(),
+		Mode:         req.Msg.MaintenanceWindow.GetMode(),
+		Description:  req.Msg.MaintenanceWindow.Description,
+		CreatedBy:    actorFromContext(ctx),
+		CreatedAt:    timestamppb.Now(),
+	}
+
+	err = svc.db.Create(window)
+	if err = service.HandleDatabaseError(err); err != nil {
+		return nil, err
+	}
+
+	res = connect.NewResponse(window)
+	return
+}
+
+// ListMaintenanceWindows lists the maintenance windows of an audit scope. Unless requested otherwise, the windows are
+// ordered by their start.
+func (svc *Service) ListMaintenanceWindows(
+	ctx context.Context,
+	req *connect.Request[orchestrator.ListMaintenanceWindowsRequest],
+) (res *connect.Response[orchestrator.ListMaintenanceWindowsResponse], err error) {
+	var (
+		windows []*orchestrator.MaintenanceWindow
+		npt     string
+		conds   = []any{"audit_scope_id = ?", req.Msg.GetAuditScopeId()}
+	)
+
+	// Validate the request
+	if err = service.Validate(req); err != nil {
+		return nil, err
+	}
+
+	// Check access via the configured auth strategy
+	if _, err = svc.checkAuditScopeAccess(ctx, orchestrator.RequestType_REQUEST_TYPE_GET, req.Msg.GetAuditScopeId()); err != nil {
+		return nil, err
+	}
+
+	// Set default ordering
+	if req.Msg.OrderBy == "" {
+		req.Msg.OrderBy = "starts_at"
+		req.Msg.Asc = true
+	}
+
+	if req.Msg.ActiveAt != nil {
+		conds = []any{"audit_scope_id = ? AND starts_at <= ? AND ends_at > ?",
+			req.Msg.GetAuditScopeId(), req.Msg.ActiveAt.AsTime(), req.Msg.ActiveAt.AsTime()}
+	}
+
+	windows, npt, err = service.PaginateStorage[*orchestrator.MaintenanceWindow](req.Msg, svc.db, service.DefaultPaginationOpts, conds...)
+	if err = service.HandleDatabaseError(err); err != nil {
+		return nil, err
+	}
+
+	res = connect.NewResponse(&orchestrator.ListMaintenanceWindowsResponse{
+		MaintenanceWindows: windows,
+		NextPageToken:      npt,
+	})
+	return
+}
+
+// RemoveMaintenanceWindow removes a maintenance window. This requires update access to its audit scope.
+func (svc *Service) RemoveMaintenanceWindow(
+	ctx context.Context,
+	req *connect.Request[orchestrator.RemoveMaintenanceWindowRequest],
+) (res *connect.Response[emptypb.Empty], err error) {
+	var (
+		window orchestrator.MaintenanceWindow
+	)
+
+	// Validate the request
+	if err = service.Validate(req); err != nil {
+		return nil, err
+	}
+
+	err = svc.db.Get(&window, "id = ?", req.Msg.GetMaintenanceWindowId())
+	if err = service.HandleDatabaseError(err, service.ErrNotFound("maintenance window")); err != nil {
+		return nil, err
+	}
+
+	// Check access via the configured auth strategy
+	if _, err = svc.checkAuditScopeAccess(ctx, orchestrator.RequestType_REQUEST_TYPE_UPDATED, window.GetAuditScopeId()); err != nil {
+		return nil, err
+	}
+
+	err = svc.db.Delete(&orchestrator.MaintenanceWindow{}, "id = ?", window.GetId())
+	if err = service.HandleDatabaseError(err); err != nil {
+		return nil, err
+	}
+
+	res = connect.NewResponse(&emptypb.Empty{})
+	return
+}
+
+// activeMaintenanceWindows returns the maintenance windows that are active at the given time. If audit scope IDs are
+// given, only the windows of these audit scopes are returned.
+func (svc *Service) activeMaintenanceWindows(at time.Time, auditScopeIds ...string) (windows []*orchestrator.MaintenanceWindow, err error) {
+	var (
+		conds = []any{persistence.WithoutPreload(), "starts_at <= ? AND ends_at > ?", at, at}
+	)
+
+	if len(auditScopeIds) > 0 {
+		conds[1] = "starts_at <= ? AND ends_at > ? AND audit_scope_id IN ?"
+		conds = append(conds, auditScopeIds)
+	}
+
+	err = svc.db.List(&windows, "starts_at", true, 0, -1, conds...)
+	return
+}
+
+// checkAuditScopeAccess checks whether the current user may perform the given request type on the audit scope with
+// the given ID and returns the audit scope. It returns a [connect.Error] if the audit scope does not exist or the access
+// is denied.
+func (svc *Service) checkAuditScopeAccess(ctx context.Context, reqType orchestrator.RequestType, auditScopeId string) (scope *orchestrator.AuditScope, err error) {
+	var allowed bool
+
+	allowed, _, err = CheckAccess(ctx, svc.authz, svc, reqType, auditScopeId, orchestrator.ObjectType_OBJECT_TYPE_AUDIT_SCOPE)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if !allowed {
+		return nil, service.ErrPermissionDenied
+	}
+
+	scope = new(orchestrator.AuditScope)
+	err = svc.db.Get(scope, persistence.WithoutPreload(), "id = ?", auditScopeId)
+	if err = service.HandleDatabaseError(err, service.ErrNotFound("audit scope")); err != nil {
+		return nil, err
+	}
+
+	return scope, nil
+}
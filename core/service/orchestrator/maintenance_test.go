@@ -0,0 +1,324 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package orchestrator
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"confirmate.io/core/api/orchestrator"
+	"confirmate.io/core/persistence"
+	"confirmate.io/core/persistence/persistencetest"
+	"confirmate.io/core/service"
+	"confirmate.io/core/service/orchestrator/orchestratortest"
+	"confirmate.io/core/util/assert"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	mockMaintenanceWindowId1 = "00000000-0000-0000-0007-000000000001"
+	mockMaintenanceWindowId2 = "00000000-0000-0000-0007-000000000002"
+)
+
+var (
+	mockMaintenanceStart = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	mockMaintenanceEnd   = time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC)
+)
+
+// mockMaintenanceRecords returns an audit scope with a past and an upcoming maintenance window.
+func mockMaintenanceRecords() []any {
+	return []any{
+		orchestratortest.MockAuditScope1,
+		&orchestrator.MaintenanceWindow{
+			Id:           mockMaintenanceWindowId1,
+			AuditScopeId: orchestratortest.MockAuditScope1.Id,
+			StartsAt:     timestamppb.New(mockMaintenanceStart),
+			EndsAt:       timestamppb.New(mockMaintenanceEnd),
+			Mode:         orchestrator.MaintenanceMode_MAINTENANCE_MODE_FLAG,
+		},
+		&orchestrator.MaintenanceWindow{
+			Id:           mockMaintenanceWindowId2,
+			AuditScopeId: orchestratortest.MockAuditScope1.Id,
+			StartsAt:     timestamppb.New(mockMaintenanceStart.AddDate(0, 1, 0)),
+			EndsAt:       timestamppb.New(mockMaintenanceEnd.AddDate(0, 1, 0)),
+			Mode:         orchestrator.MaintenanceMode_MAINTENANCE_MODE_SUPPRESS,
+		},
+	}
+}
+
+func TestService_CreateMaintenanceWindow(t *testing.T) {
+	type fields struct {
+		db    persistence.DB
+		authz service.AuthorizationStrategy
+	}
+	tests := []struct {
+		name    string
+		req     *orchestrator.CreateMaintenanceWindowRequest
+		fields  fields
+		want    assert.Want[*connect.Response[orchestrator.MaintenanceWindow]]
+		wantErr assert.WantErr
+	}{
+		{
+			name: "happy path",
+			req: &orchestrator.CreateMaintenanceWindowRequest{
+				MaintenanceWindow: &orchestrator.MaintenanceWindow{
+					AuditScopeId: orchestratortest.MockAuditScope1.Id,
+					StartsAt:     timestamppb.New(mockMaintenanceStart),
+					EndsAt:       timestamppb.New(mockMaintenanceEnd),
+					Mode:         orchestrator.MaintenanceMode_MAINTENANCE_MODE_SUPPRESS,
+					Description:  new("Database migration"),
+				},
+			},
+			fields: fields{
+				db:    persistencetest.NewInMemoryDB(t, types, joinTables, createRecords(t, mockMaintenanceRecords()...)),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			want: func(t *testing.T, got *connect.Response[orchestrator.MaintenanceWindow], args ...any) bool {
+				return assert.NotEmpty(t, got.Msg.GetId()) &&
+					assert.NotNil(t, got.Msg.GetCreatedAt()) &&
+					assert.Equal(t, "Database migration", got.Msg.GetDescription())
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "end before start",
+			req: &orchestrator.CreateMaintenanceWindowRequest{
+				MaintenanceWindow: &orchestrator.MaintenanceWindow{
+					AuditScopeId: orchestratortest.MockAuditScope1.Id,
+					StartsAt:     timestamppb.New(mockMaintenanceEnd),
+					EndsAt:       timestamppb.New(mockMaintenanceStart),
+					Mode:         orchestrator.MaintenanceMode_MAINTENANCE_MODE_SUPPRESS,
+				},
+			},
+			fields: fields{
+				db:    persistencetest.NewInMemoryDB(t, types, joinTables, createRecords(t, mockMaintenanceRecords()...)),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			want: assert.Nil[*connect.Response[orchestrator.MaintenanceWindow]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeInvalidArgument)
+			},
+		},
+		{
+			name: "audit scope not found",
+			req: &orchestrator.CreateMaintenanceWindowRequest{
+				MaintenanceWindow: &orchestrator.MaintenanceWindow{
+					AuditScopeId: "00000000-0000-0000-0000-000000000099",
+					StartsAt:     timestamppb.New(mockMaintenanceStart),
+					EndsAt:       timestamppb.New(mockMaintenanceEnd),
+					Mode:         orchestrator.MaintenanceMode_MAINTENANCE_MODE_FLAG,
+				},
+			},
+			fields: fields{
+				db:    persistencetest.NewInMemoryDB(t, types, joinTables, createRecords(t, mockMaintenanceRecords()...)),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			want: assert.Nil[*connect.Response[orchestrator.MaintenanceWindow]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeNotFound)
+			},
+		},
+		{
+			name: "permission denied",
+			req: &orchestrator.CreateMaintenanceWindowRequest{
+				MaintenanceWindow: &orchestrator.MaintenanceWindow{
+					AuditScopeId: orchestratortest.MockAuditScope1.Id,
+					StartsAt:     timestamppb.New(mockMaintenanceStart),
+					EndsAt:       timestamppb.New(mockMaintenanceEnd),
+					Mode:         orchestrator.MaintenanceMode_MAINTENANCE_MODE_FLAG,
+				},
+			},
+			fields: fields{
+				db:    persistencetest.NewInMemoryDB(t, types, joinTables, createRecords(t, mockMaintenanceRecords()...)),
+				authz: &denyAuthorizationStrategy{},
+			},
+			want: assert.Nil[*connect.Response[orchestrator.MaintenanceWindow]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodePermissionDenied)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &Service{
+				db:    tt.fields.db,
+				authz: tt.fields.authz,
+			}
+			got, gotErr := svc.CreateMaintenanceWindow(context.Background(), connect.NewRequest(tt.req))
+
+			tt.want(t, got)
+			tt.wantErr(t, gotErr)
+		})
+	}
+}
+
+func TestService_ListMaintenanceWindows(t *testing.T) {
+	type fields struct {
+		db    persistence.DB
+		authz service.AuthorizationStrategy
+	}
+	tests := []struct {
+		name    string
+		req     *orchestrator.ListMaintenanceWindowsRequest
+		fields  fields
+		want    assert.Want[*connect.Response[orchestrator.ListMaintenanceWindowsResponse]]
+		wantErr assert.WantErr
+	}{
+		{
+			name: "happy path",
+			req: &orchestrator.ListMaintenanceWindowsRequest{
+				AuditScopeId: orchestratortest.MockAuditScope1.Id,
+			},
+			fields: fields{
+				db:    persistencetest.NewInMemoryDB(t, types, joinTables, createRecords(t, mockMaintenanceRecords()...)),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			want: func(t *testing.T, got *connect.Response[orchestrator.ListMaintenanceWindowsResponse], args ...any) bool {
+				return assert.Equal(t, 2, len(got.Msg.MaintenanceWindows)) &&
+					assert.Equal(t, mockMaintenanceWindowId1, got.Msg.MaintenanceWindows[0].GetId())
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "active windows only",
+			req: &orchestrator.ListMaintenanceWindowsRequest{
+				AuditScopeId: orchestratortest.MockAuditScope1.Id,
+				ActiveAt:     timestamppb.New(mockMaintenanceStart.AddDate(0, 1, 0).Add(time.Hour)),
+			},
+			fields: fields{
+				db:    persistencetest.NewInMemoryDB(t, types, joinTables, createRecords(t, mockMaintenanceRecords()...)),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			want: func(t *testing.T, got *connect.Response[orchestrator.ListMaintenanceWindowsResponse], args ...any) bool {
+				return assert.Equal(t, 1, len(got.Msg.MaintenanceWindows)) &&
+					assert.Equal(t, mockMaintenanceWindowId2, got.Msg.MaintenanceWindows[0].GetId())
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "permission denied",
+			req: &orchestrator.ListMaintenanceWindowsRequest{
+				AuditScopeId: orchestratortest.MockAuditScope1.Id,
+			},
+			fields: fields{
+				db:    persistencetest.NewInMemoryDB(t, types, joinTables, createRecords(t, mockMaintenanceRecords()...)),
+				authz: &denyAuthorizationStrategy{},
+			},
+			want: assert.Nil[*connect.Response[orchestrator.ListMaintenanceWindowsResponse]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodePermissionDenied)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &Service{
+				db:    tt.fields.db,
+				authz: tt.fields.authz,
+			}
+			got, gotErr := svc.ListMaintenanceWindows(context.Background(), connect.NewRequest(tt.req))
+
+			tt.want(t, got)
+			tt.wantErr(t, gotErr)
+		})
+	}
+}
+
+func TestService_RemoveMaintenanceWindow(t *testing.T) {
+	type fields struct {
+		db    persistence.DB
+		authz service.AuthorizationStrategy
+	}
+	tests := []struct {
+		name    string
+		req     *orchestrator.RemoveMaintenanceWindowRequest
+		fields  fields
+		want    assert.Want[*connect.Response[emptypb.Empty]]
+		wantDB  assert.Want[persistence.DB]
+		wantErr assert.WantErr
+	}{
+		{
+			name: "happy path",
+			req: &orchestrator.RemoveMaintenanceWindowRequest{
+				MaintenanceWindowId: mockMaintenanceWindowId1,
+			},
+			fields: fields{
+				db:    persistencetest.NewInMemoryDB(t, types, joinTables, createRecords(t, mockMaintenanceRecords()...)),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			want: assert.NotNil[*connect.Response[emptypb.Empty]],
+			wantDB: func(t *testing.T, db persistence.DB, args ...any) bool {
+				n, err := db.Count(&orchestrator.MaintenanceWindow{}, "id = ?", mockMaintenanceWindowId1)
+				assert.NoError(t, err)
+
+				return assert.Equal(t, int64(0), n)
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "maintenance window not found",
+			req: &orchestrator.RemoveMaintenanceWindowRequest{
+				MaintenanceWindowId: "00000000-0000-0000-0007-000000000099",
+			},
+			fields: fields{
+				db:    persistencetest.NewInMemoryDB(t, types, joinTables, createRecords(t, mockMaintenanceRecords()...)),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			want:   assert.Nil[*connect.Response[emptypb.Empty]],
+			wantDB: assert.AnyValue[persistence.DB],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeNotFound)
+			},
+		},
+		{
+			name: "permission denied",
+			req: &orchestrator.RemoveMaintenanceWindowRequest{
+				MaintenanceWindowId: mockMaintenanceWindowId1,
+			},
+			fields: fields{
+				db:    persistencetest.NewInMemoryDB(t, types, joinTables, createRecords(t, mockMaintenanceRecords()...)),
+				authz: &denyAuthorizationStrategy{},
+			},
+			want: assert.Nil[*connect.Response[emptypb.Empty]],
+			wantDB: func(t *testing.T, db persistence.DB, args ...any) bool {
+				n, err := db.Count(&orchestrator.MaintenanceWindow{}, "id = ?", mockMaintenanceWindowId1)
+				assert.NoError(t, err)
+
+				return assert.Equal(t, int64(1), n)
+			},
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodePermissionDenied)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &Service{
+				db:    tt.fields.db,
+				authz: tt.fields.authz,
+			}
+			got, gotErr := svc.RemoveMaintenanceWindow(context.Background(), connect.NewRequest(tt.req))
+
+			tt.want(t, got)
+			tt.wantDB(t, tt.fields.db)
+			tt.wantErr(t, gotErr)
+		})
+	}
+}
@@ -0,0 +1,270 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package orchestrator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"time"
+
+	"confirmate.io/core/api/orchestrator"
+	"confirmate.io/core/persistence"
+	"confirmate.io/core/service"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// UpdateMetadataField creates or updates a custom metadata field of targets of evaluation. Values that are already
+// set on targets of evaluation are not re-validated; they are validated on the next update of the target of
+// evaluation.
+func (svc *Service) UpdateMetadataField(
+	ctx context.Context,
+	req *connect.Request[orchestrator.UpdateMetadataFieldRequest],
+) (res *connect.Response[orchestrator.MetadataField], err error) {
+	var (
+		field   *orchestrator.MetadataField
+		allowed bool
+	)
+
+	// Validate the request
+	if err = service.Validate(req); err != nil {
+		return nil, err
+	}
+
+	// Check access via the configured auth strategy
+	allowed, _, err = CheckAccess(ctx, svc.authz, svc, orchestrator.RequestType_REQUEST_TYPE_UPDATED, "", orchestrator.ObjectType_OBJECT_TYPE_METADATA_FIELD)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if !allowed {
+		return nil, service.ErrPermissionDenied
+	}
+
+	field = &orchestrator.MetadataField{
+		Key:           req.Msg.GetField().GetKey(),
+		DisplayName:   req.Msg.GetField().GetDisplayName(),
+		Description:   req.Msg.GetField().GetDescription(),
+		Type:          req.Msg.GetField().GetType(),
+		Required:      req.Msg.GetField().GetRequired(),
+		AllowedValues: req.Msg.GetField().GetAllowedValues(),
+		Pattern:       req.Msg.GetField().Pattern,
+		UpdatedAt:     timestamppb.Now(),
+	}
+
+	// Check the type specific settings of the field
+	if field.Type == orchestrator.MetadataFieldType_METADATA_FIELD_TYPE_ENUM && len(field.AllowedValues) == 0 {
+		return nil, service.NewInvalidFieldError("field.allowed_values", errors.New("allowed values are required for enum fields"))
+	}
+	if field.Pattern != nil {
+		if _, err = regexp.Compile(field.GetPattern()); err != nil {
+			return nil, service.NewInvalidFieldError("field.pattern", fmt.Errorf("invalid pattern: %w", err))
+		}
+	}
+
+	// Save the metadata field
+	err = svc.db.Save(field)
+	if err = service.HandleDatabaseError(err); err != nil {
+		return nil, err
+	}
+
+	res = connect.NewResponse(field)
+	return
+}
+
+// ListMetadataFields lists all custom metadata fields of targets of evaluation.
+func (svc *Service) ListMetadataFields(
+	ctx context.Context,
+	req *connect.Request[orchestrator.ListMetadataFieldsRequest],
+) (res *connect.Response[orchestrator.ListMetadataFieldsResponse], err error) {
+	var (
+		fields []*orchestrator.MetadataField
+		npt    string
+	)
+
+	// Validate the request
+	if err = service.Validate(req); err != nil {
+		return nil, err
+	}
+
+	// Set default ordering
+	if req.Msg.OrderBy == "" {
+		req.Msg.OrderBy = "key"
+		req.Msg.Asc = true
+	}
+
+	fields, npt, err = service.PaginateStorage[*orchestrator.MetadataField](req.Msg, svc.db, service.DefaultPaginationOpts)
+	if err = service.HandleDatabaseError(err); err != nil {
+		return nil, err
+	}
+
+	res = connect.NewResponse(&orchestrator.ListMetadataFieldsResponse{
+		Fields:        fields,
+		NextPageToken: npt,
+	})
+	return
+}
+
+// RemoveMetadataField removes a custom metadata field and its values from all targets of evaluation.
+func (svc *Service) RemoveMetadataField(
+	ctx context.Context,
+	req *connect.Request[orchestrator.RemoveMetadataFieldRequest],
+) (res *connect.Response[emptypb.Empty], err error) {
+	var (
+		toes    []*orchestrator.TargetOfEvaluation
+		allowed bool
+	)
+
+	// Validate the request
+	if err = service.Validate(req); err != nil {
+		return nil, err
+	}
+
+	// Check access via the configured auth strategy
+	allowed, _, err = CheckAccess(ctx, svc.authz, svc, orchestrator.RequestType_REQUEST_TYPE_DELETED, "", orchestrator.ObjectType_OBJECT_TYPE_METADATA_FIELD)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if !allowed {
+		return nil, service.ErrPermissionDenied
+	}
+
+	err = svc.db.Transaction(func(tx persistence.DB) error {
+		if err = tx.Delete(&orchestrator.MetadataField{}, `"key" = ?`, req.Msg.Key); err != nil {
+			return err
+		}
+
+		// Remove the values of the field from all targets of evaluation
+		if err = tx.List(&toes, "id", true, 0, -1, persistence.WithoutPreload()); err != nil {
+			return err
+		}
+
+		for _, toe := range toes {
+			if _, ok := toe.GetMetadata().GetCustomFields()[req.Msg.Key]; !ok {
+				continue
+			}
+
+			delete(toe.Metadata.CustomFields, req.Msg.Key)
+			if err = tx.Update(&orchestrator.TargetOfEvaluation{Metadata: toe.Metadata}, "id = ?", toe.Id); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err = service.HandleDatabaseError(err, service.ErrNotFound("metadata field")); err != nil {
+		return nil, err
+	}
+
+	res = connect.NewResponse(&emptypb.Empty{})
+	return
+}
+
+// validateCustomFields validates the custom fields of the target of evaluation metadata against the defined metadata
+// fields. Unknown keys, values that do not match the type of their field and missing required fields are rejected with
+// [connect.CodeInvalidArgument].
+func (svc *Service) validateCustomFields(metadata *orchestrator.TargetOfEvaluation_Metadata) (err error) {
+	var (
+		fields []*orchestrator.MetadataField
+		known  map[string]*orchestrator.MetadataField
+		values map[string]string
+	)
+
+	err = svc.db.List(&fields, "key", true, 0, -1)
+	if err != nil {
+		return service.HandleDatabaseError(err)
+	}
+
+	values = metadata.GetCustomFields()
+	known = make(map[string]*orchestrator.MetadataField, len(fields))
+	for _, field := range fields {
+		known[field.Key] = field
+
+		if _, ok := values[field.Key]; field.Required && !ok {
+			return service.NewInvalidFieldError(customFieldPath(field.Key), fmt.Errorf("custom field %q is required", field.Key))
+		}
+	}
+
+	for key, value := range values {
+		field, ok := known[key]
+		if !ok {
+			return service.NewInvalidFieldError(customFieldPath(key), fmt.Errorf("unknown custom field %q", key))
+		}
+
+		if err = validateCustomFieldValue(field, value); err != nil {
+			return service.NewInvalidFieldError(customFieldPath(key), fmt.Errorf("invalid value of custom field %q: %w", key, err))
+		}
+	}
+
+	return nil
+}
+
+// customFieldPath returns the path of a custom field within the request of a target of evaluation, which is used in
+// field violations.
+func customFieldPath(key string) string {
+	return fmt.Sprintf("target_of_evaluation.metadata.custom_fields[%q]", key)
+}
+
+// validateCustomFieldValue checks whether the value matches the type of the metadata field.
+func validateCustomFieldValue(field *orchestrator.MetadataField, value string) (err error) {
+	switch field.Type {
+	case orchestrator.MetadataFieldType_METADATA_FIELD_TYPE_STRING:
+		if field.Pattern != nil {
+			var ok bool
+
+			ok, err = regexp.MatchString(field.GetPattern(), value)
+			if err != nil {
+				return fmt.Errorf("invalid pattern: %w", err)
+			} else if !ok {
+				return fmt.Errorf("value does not match pattern %q", field.GetPattern())
+			}
+		}
+	case orchestrator.MetadataFieldType_METADATA_FIELD_TYPE_INTEGER:
+		_, err = strconv.ParseInt(value, 10, 64)
+	case orchestrator.MetadataFieldType_METADATA_FIELD_TYPE_NUMBER:
+		_, err = strconv.ParseFloat(value, 64)
+	case orchestrator.MetadataFieldType_METADATA_FIELD_TYPE_BOOLEAN:
+		if value != "true" && value != "false" {
+			err = fmt.Errorf("value must be either true or false")
+		}
+	case orchestrator.MetadataFieldType_METADATA_FIELD_TYPE_ENUM:
+		if !slices.Contains(field.AllowedValues, value) {
+			err = fmt.Errorf("value must be one of %v", field.AllowedValues)
+		}
+	case orchestrator.MetadataFieldType_METADATA_FIELD_TYPE_DATE:
+		_, err = time.Parse(time.DateOnly, value)
+	default:
+		err = fmt.Errorf("unsupported field type %s", field.Type)
+	}
+
+	return err
+}
+
+// matchesCustomFields checks whether the target of evaluation has all of the given custom field values.
+func matchesCustomFields(toe *orchestrator.TargetOfEvaluation, filter map[string]string) bool {
+	for key, value := range filter {
+		if v, ok := toe.GetMetadata().GetCustomFields()[key]; !ok || v != value {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,434 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package orchestrator
+
+import (
+	"context"
+	"testing"
+
+	"confirmate.io/core/api/orchestrator"
+	"confirmate.io/core/auth"
+	"confirmate.io/core/persistence"
+	"confirmate.io/core/persistence/persistencetest"
+	"confirmate.io/core/service"
+	"confirmate.io/core/service/orchestrator/orchestratortest"
+	"confirmate.io/core/util/assert"
+
+	"connectrpc.com/connect"
+	"github.com/golang-jwt/jwt/v5"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+var (
+	mockMetadataFieldCostCenter = &orchestrator.MetadataField{
+		Key:         "cost_center",
+		DisplayName: "Cost Center",
+		Type:        orchestrator.MetadataFieldType_METADATA_FIELD_TYPE_STRING,
+		Required:    true,
+		Pattern:     new("^CC-[0-9]+$"),
+	}
+	mockMetadataFieldCriticality = &orchestrator.MetadataField{
+		Key:           "criticality",
+		DisplayName:   "Criticality",
+		Type:          orchestrator.MetadataFieldType_METADATA_FIELD_TYPE_ENUM,
+		AllowedValues: []string{"low", "high"},
+	}
+)
+
+func TestService_UpdateMetadataField(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		req *orchestrator.UpdateMetadataFieldRequest
+	}
+	type fields struct {
+		db    persistence.DB
+		authz service.AuthorizationStrategy
+	}
+	tests := []struct {
+		name    string
+		args    args
+		fields  fields
+		want    assert.Want[*connect.Response[orchestrator.MetadataField]]
+		wantErr assert.WantErr
+		wantDB  assert.Want[persistence.DB]
+	}{
+		{
+			name: "validation error - invalid key",
+			args: args{
+				req: &orchestrator.UpdateMetadataFieldRequest{
+					Field: &orchestrator.MetadataField{
+						Key:  "Cost Center",
+						Type: orchestrator.MetadataFieldType_METADATA_FIELD_TYPE_STRING,
+					},
+				},
+			},
+			fields: fields{
+				db:    persistencetest.NewInMemoryDB(t, types, joinTables),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			want: assert.Nil[*connect.Response[orchestrator.MetadataField]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeInvalidArgument)
+			},
+			wantDB: assert.NotNil[persistence.DB],
+		},
+		{
+			name: "permission denied - non-admin user",
+			args: args{
+				ctx: auth.WithClaims(context.Background(), &auth.OAuthClaims{
+					RegisteredClaims: jwt.RegisteredClaims{
+						Subject: orchestratortest.MockUserId1,
+						Issuer:  orchestratortest.MockUserIssuer1,
+					},
+				}),
+				req: &orchestrator.UpdateMetadataFieldRequest{
+					Field: mockMetadataFieldCriticality,
+				},
+			},
+			fields: fields{
+				db: persistencetest.NewInMemoryDB(t, types, joinTables),
+				authz: &service.AuthorizationStrategyPermissionStore{
+					Permissions: service.DBPermissionStore{
+						DB: persistencetest.NewInMemoryDB(t, types, joinTables),
+					},
+				},
+			},
+			want: assert.Nil[*connect.Response[orchestrator.MetadataField]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodePermissionDenied)
+			},
+			wantDB: assert.NotNil[persistence.DB],
+		},
+		{
+			name: "invalid argument - enum without allowed values",
+			args: args{
+				req: &orchestrator.UpdateMetadataFieldRequest{
+					Field: &orchestrator.MetadataField{
+						Key:  "criticality",
+						Type: orchestrator.MetadataFieldType_METADATA_FIELD_TYPE_ENUM,
+					},
+				},
+			},
+			fields: fields{
+				db:    persistencetest.NewInMemoryDB(t, types, joinTables),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			want: assert.Nil[*connect.Response[orchestrator.MetadataField]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeInvalidArgument) &&
+					assert.ErrorContains(t, err, "allowed values are required") &&
+					assert.HasFieldViolation(t, err, "field.allowed_values")
+			},
+			wantDB: assert.NotNil[persistence.DB],
+		},
+		{
+			name: "invalid argument - invalid pattern",
+			args: args{
+				req: &orchestrator.UpdateMetadataFieldRequest{
+					Field: &orchestrator.MetadataField{
+						Key:     "cost_center",
+						Type:    orchestrator.MetadataFieldType_METADATA_FIELD_TYPE_STRING,
+						Pattern: new("[a-z"),
+					},
+				},
+			},
+			fields: fields{
+				db:    persistencetest.NewInMemoryDB(t, types, joinTables),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			want: assert.Nil[*connect.Response[orchestrator.MetadataField]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeInvalidArgument) &&
+					assert.ErrorContains(t, err, "invalid pattern") &&
+					assert.HasFieldViolation(t, err, "field.pattern")
+			},
+			wantDB: assert.NotNil[persistence.DB],
+		},
+		{
+			name: "db error",
+			args: args{
+				req: &orchestrator.UpdateMetadataFieldRequest{
+					Field: mockMetadataFieldCriticality,
+				},
+			},
+			fields: fields{
+				db:    persistencetest.SaveErrorDB(t, persistence.ErrDatabase, types, joinTables),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			want: assert.Nil[*connect.Response[orchestrator.MetadataField]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeInternal)
+			},
+			wantDB: assert.NotNil[persistence.DB],
+		},
+		{
+			name: "happy path",
+			args: args{
+				req: &orchestrator.UpdateMetadataFieldRequest{
+					Field: mockMetadataFieldCriticality,
+				},
+			},
+			fields: fields{
+				db:    persistencetest.NewInMemoryDB(t, types, joinTables),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			want: func(t *testing.T, got *connect.Response[orchestrator.MetadataField], msgAndArgs ...any) bool {
+				return assert.Equal(t, "criticality", got.Msg.Key) &&
+					assert.Equal(t, []string{"low", "high"}, got.Msg.AllowedValues) &&
+					assert.NotNil(t, got.Msg.UpdatedAt)
+			},
+			wantErr: assert.NoError,
+			wantDB: func(t *testing.T, db persistence.DB, msgAndArgs ...any) bool {
+				var field orchestrator.MetadataField
+
+				err := db.Get(&field, `"key" = ?`, "criticality")
+				return assert.NoError(t, err) &&
+					assert.Equal(t, orchestrator.MetadataFieldType_METADATA_FIELD_TYPE_ENUM, field.Type)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &Service{
+				db:    tt.fields.db,
+				authz: tt.fields.authz,
+			}
+			res, err := svc.UpdateMetadataField(tt.args.ctx, connect.NewRequest(tt.args.req))
+			tt.want(t, res)
+			tt.wantErr(t, err)
+			tt.wantDB(t, tt.fields.db)
+		})
+	}
+}
+
+func TestService_ListMetadataFields(t *testing.T) {
+	type args struct {
+		req *orchestrator.ListMetadataFieldsRequest
+	}
+	type fields struct {
+		db persistence.DB
+	}
+	tests := []struct {
+		name    string
+		args    args
+		fields  fields
+		want    assert.Want[*connect.Response[orchestrator.ListMetadataFieldsResponse]]
+		wantErr assert.WantErr
+	}{
+		{
+			name: "db error",
+			args: args{
+				req: &orchestrator.ListMetadataFieldsRequest{},
+			},
+			fields: fields{
+				db: persistencetest.ListErrorDB(t, persistence.ErrDatabase, types, joinTables),
+			},
+			want: assert.Nil[*connect.Response[orchestrator.ListMetadataFieldsResponse]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeInternal)
+			},
+		},
+		{
+			name: "happy path - ordered by key",
+			args: args{
+				req: &orchestrator.ListMetadataFieldsRequest{},
+			},
+			fields: fields{
+				db: persistencetest.NewInMemoryDB(t, types, joinTables, func(d persistence.DB) {
+					assert.NoError(t, d.Create(mockMetadataFieldCriticality))
+					assert.NoError(t, d.Create(mockMetadataFieldCostCenter))
+				}),
+			},
+			want: func(t *testing.T, got *connect.Response[orchestrator.ListMetadataFieldsResponse], msgAndArgs ...any) bool {
+				return assert.Equal(t, 2, len(got.Msg.Fields)) &&
+					assert.Equal(t, "cost_center", got.Msg.Fields[0].Key) &&
+					assert.Equal(t, "criticality", got.Msg.Fields[1].Key)
+			},
+			wantErr: assert.NoError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &Service{
+				db: tt.fields.db,
+			}
+			res, err := svc.ListMetadataFields(context.Background(), connect.NewRequest(tt.args.req))
+			tt.want(t, res)
+			tt.wantErr(t, err)
+		})
+	}
+}
+
+func TestService_RemoveMetadataField(t *testing.T) {
+	type args struct {
+		req *orchestrator.RemoveMetadataFieldRequest
+	}
+	type fields struct {
+		db    persistence.DB
+		authz service.AuthorizationStrategy
+	}
+	tests := []struct {
+		name    string
+		args    args
+		fields  fields
+		want    assert.Want[*connect.Response[emptypb.Empty]]
+		wantErr assert.WantErr
+		wantDB  assert.Want[persistence.DB]
+	}{
+		{
+			name: "not found",
+			args: args{
+				req: &orchestrator.RemoveMetadataFieldRequest{Key: "criticality"},
+			},
+			fields: fields{
+				db:    persistencetest.NewInMemoryDB(t, types, joinTables),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			want: assert.Nil[*connect.Response[emptypb.Empty]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeNotFound)
+			},
+			wantDB: assert.NotNil[persistence.DB],
+		},
+		{
+			name: "happy path - removes values from targets of evaluation",
+			args: args{
+				req: &orchestrator.RemoveMetadataFieldRequest{Key: "criticality"},
+			},
+			fields: fields{
+				db: persistencetest.NewInMemoryDB(t, types, joinTables, func(d persistence.DB) {
+					assert.NoError(t, d.Create(mockMetadataFieldCriticality))
+					assert.NoError(t, d.Create(&orchestrator.TargetOfEvaluation{
+						Id:         orchestratortest.MockToeId1,
+						Name:       "Mock TOE 1",
+						TargetType: orchestrator.TargetOfEvaluation_TARGET_TYPE_CLOUD,
+						Metadata: &orchestrator.TargetOfEvaluation_Metadata{
+							Labels:       map[string]string{"env": "prod"},
+							CustomFields: map[string]string{"criticality": "high"},
+						},
+					}))
+				}),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			want:    assert.NotNil[*connect.Response[emptypb.Empty]],
+			wantErr: assert.NoError,
+			wantDB: func(t *testing.T, db persistence.DB, msgAndArgs ...any) bool {
+				toe := assert.InDB[orchestrator.TargetOfEvaluation](t, db, orchestratortest.MockToeId1)
+				count, err := db.Count(&orchestrator.MetadataField{})
+				return assert.NoError(t, err) &&
+					assert.Equal(t, int64(0), count) &&
+					assert.Equal(t, 0, len(toe.GetMetadata().GetCustomFields())) &&
+					assert.Equal(t, "prod", toe.GetMetadata().GetLabels()["env"])
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &Service{
+				db:    tt.fields.db,
+				authz: tt.fields.authz,
+			}
+			res, err := svc.RemoveMetadataField(context.Background(), connect.NewRequest(tt.args.req))
+			tt.want(t, res)
+			tt.wantErr(t, err)
+			tt.wantDB(t, tt.fields.db)
+		})
+	}
+}
+
+func Test_validateCustomFieldValue(t *testing.T) {
+	tests := []struct {
+		name    string
+		field   *orchestrator.MetadataField
+		value   string
+		wantErr assert.WantErr
+	}{
+		{
+			name:    "string matching pattern",
+			field:   mockMetadataFieldCostCenter,
+			value:   "CC-1234",
+			wantErr: assert.NoError,
+		},
+		{
+			name:  "string not matching pattern",
+			field: mockMetadataFieldCostCenter,
+			value: "1234",
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.ErrorContains(t, err, "does not match pattern")
+			},
+		},
+		{
+			name:    "integer",
+			field:   &orchestrator.MetadataField{Type: orchestrator.MetadataFieldType_METADATA_FIELD_TYPE_INTEGER},
+			value:   "42",
+			wantErr: assert.NoError,
+		},
+		{
+			name:  "invalid integer",
+			field: &orchestrator.MetadataField{Type: orchestrator.MetadataFieldType_METADATA_FIELD_TYPE_INTEGER},
+			value: "4.2",
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.Error(t, err)
+			},
+		},
+		{
+			name:    "number",
+			field:   &orchestrator.MetadataField{Type: orchestrator.MetadataFieldType_METADATA_FIELD_TYPE_NUMBER},
+			value:   "4.2",
+			wantErr: assert.NoError,
+		},
+		{
+			name:  "invalid boolean",
+			field: &orchestrator.MetadataField{Type: orchestrator.MetadataFieldType_METADATA_FIELD_TYPE_BOOLEAN},
+			value: "yes",
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.Error(t, err)
+			},
+		},
+		{
+			name:  "enum value not allowed",
+			field: mockMetadataFieldCriticality,
+			value: "medium",
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.Error(t, err)
+			},
+		},
+		{
+			name:    "date",
+			field:   &orchestrator.MetadataField{Type: orchestrator.MetadataFieldType_METADATA_FIELD_TYPE_DATE},
+			value:   "2026-03-31",
+			wantErr: assert.NoError,
+		},
+		{
+			name:  "invalid date",
+			field: &orchestrator.MetadataField{Type: orchestrator.MetadataFieldType_METADATA_FIELD_TYPE_DATE},
+			value: "31.03.2026",
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.Error(t, err)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateCustomFieldValue(tt.field, tt.value)
+			tt.wantErr(t, err)
+		})
+	}
+}
@@ -0,0 +1,165 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package orchestrator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"confirmate.io/core/api/assessment"
+	"confirmate.io/core/api/orchestrator"
+	"confirmate.io/core/persistence"
+	"confirmate.io/core/service"
+	"confirmate.io/core/util/entity"
+
+	"connectrpc.com/connect"
+)
+
+// ResolveMetricConfiguration resolves the effective configuration of a metric for a target of evaluation in the same
+// way as [Service.GetMetricConfiguration] and explains the resolution. The chain contains a step for the global default
+// configuration of the metric, for each considered catalog and for the explicit configuration of the target of
+// evaluation. The defined layer with the highest precedence is applied, unless it is a catalog default that is ignored
+// because several catalogs define one.
+func (svc *Service) ResolveMetricConfiguration(
+	ctx context.Context,
+	req *connect.Request[orchestrator.ResolveMetricConfigurationRequest],
+) (res *connect.Response[orchestrator.ResolveMetricConfigurationResponse], err error) {
+	var (
+		toeId          = req.Msg.GetTargetOfEvaluationId()
+		metricId       = req.Msg.GetMetricId()
+		toeConfig      assessment.MetricConfiguration
+		defaultConfig  *assessment.MetricConfiguration
+		catalogIds     []string
+		catalogConfigs []*assessment.CatalogMetricConfiguration
+		effective      *assessment.MetricConfiguration
+		chain          []*orchestrator.MetricConfigurationResolutionStep
+		candidates     []*orchestrator.MetricConfigurationResolutionStep
+		step           *orchestrator.MetricConfigurationResolutionStep
+		ok             bool
+	)
+
+	// Validate the request
+	if err = service.Validate(req); err != nil {
+		return nil, err
+	}
+
+	// Check access via the configured auth strategy
+	if err = svc.checkTargetOfEvaluationAccess(ctx, orchestrator.RequestType_REQUEST_TYPE_GET, toeId); err != nil {
+		return nil, err
+	}
+
+	// The global default configuration of the metric has the lowest precedence
+	step = &orchestrator.MetricConfigurationResolutionStep{
+		Source: assessment.MetricConfigurationSource_METRIC_CONFIGURATION_SOURCE_DEFAULT,
+		Reason: "The metric has no global default configuration",
+	}
+	defaultConfig, ok = globalDefaultConfiguration(toeId, metricId)
+	if ok {
+		setResolutionStepConfiguration(step, defaultConfig)
+		effective = defaultConfig
+		candidates = append(candidates, step)
+	}
+	chain = append(chain, step)
+
+	// Followed by the default configurations of the catalogs, which are only used if exactly one catalog defines one
+	catalogIds, catalogConfigs, err = svc.catalogMetricConfigurations(entity.ToEID(toeId), entity.MetricID(metricId), (*entity.CatalogID)(req.Msg.CatalogId))
+	if err != nil {
+		return nil, err
+	}
+	for _, catalogId := range catalogIds {
+		step = &orchestrator.MetricConfigurationResolutionStep{
+			Source:    assessment.MetricConfigurationSource_METRIC_CONFIGURATION_SOURCE_CATALOG_DEFAULT,
+			CatalogId: new(catalogId),
+			Reason:    "The catalog has no default configuration of the metric",
+		}
+		chain = append(chain, step)
+
+		i := slices.IndexFunc(catalogConfigs, func(c *assessment.CatalogMetricConfiguration) bool {
+			return c.CatalogId == catalogId
+		})
+		if i == -1 {
+			continue
+		}
+
+		setResolutionStepConfiguration(step, catalogDefaultConfiguration(toeId, catalogConfigs[i]))
+		if len(catalogConfigs) > 1 {
+			step.Reason = fmt.Sprintf("Ignored, since %d catalogs of the target of evaluation define a default configuration of the metric. Select one with the catalog ID", len(catalogConfigs))
+			continue
+		}
+
+		effective = catalogDefaultConfiguration(toeId, catalogConfigs[i])
+		candidates = append(candidates, step)
+	}
+
+	// An explicit configuration of the target of evaluation has the highest precedence. Use WithoutPreload because
+	// MetricConfiguration contains structpb.Value which has unexported fields
+	step = &orchestrator.MetricConfigurationResolutionStep{
+		Source: assessment.MetricConfigurationSource_METRIC_CONFIGURATION_SOURCE_TARGET_OF_EVALUATION,
+		Reason: "The target of evaluation has no explicit configuration of the metric",
+	}
+	err = svc.db.Get(&toeConfig, persistence.WithoutPreload(), "target_of_evaluation_id = ? AND metric_id = ?", toeId, metricId)
+	if err == nil {
+		toeConfig.Source = assessment.MetricConfigurationSource_METRIC_CONFIGURATION_SOURCE_TARGET_OF_EVALUATION
+		setResolutionStepConfiguration(step, &toeConfig)
+		effective = &toeConfig
+		candidates = append(candidates, step)
+	} else if !errors.Is(err, persistence.ErrRecordNotFound) {
+		return nil, service.HandleDatabaseError(err)
+	}
+	chain = append(chain, step)
+
+	if effective == nil {
+		return nil, connect.NewError(connect.CodeNotFound, service.ErrNotFound("metric configuration"))
+	}
+
+	// The last candidate is applied, all others are overridden by it
+	for i, candidate := range candidates {
+		if i == len(candidates)-1 {
+			candidate.Applied = true
+			candidate.Reason = "Applied, since no layer with a higher precedence defines a configuration of the metric"
+		} else {
+			candidate.Reason = fmt.Sprintf("Overridden by %s", describeResolutionStep(candidates[len(candidates)-1]))
+		}
+	}
+
+	res = connect.NewResponse(&orchestrator.ResolveMetricConfigurationResponse{
+		Configuration: effective,
+		Chain:         chain,
+	})
+	return res, nil
+}
+
+// setResolutionStepConfiguration marks the step as defined by the given configuration.
+func setResolutionStepConfiguration(step *orchestrator.MetricConfigurationResolutionStep, config *assessment.MetricConfiguration) {
+	step.Defined = true
+	step.Operator = new(config.Operator)
+	step.TargetValue = config.TargetValue
+	step.UpdatedAt = config.UpdatedAt
+}
+
+// describeResolutionStep returns a human readable description of the layer of the step.
+func describeResolutionStep(step *orchestrator.MetricConfigurationResolutionStep) string {
+	switch step.Source {
+	case assessment.MetricConfigurationSource_METRIC_CONFIGURATION_SOURCE_CATALOG_DEFAULT:
+		return fmt.Sprintf("the default configuration of catalog %s", step.GetCatalogId())
+	case assessment.MetricConfigurationSource_METRIC_CONFIGURATION_SOURCE_TARGET_OF_EVALUATION:
+		return "the explicit configuration of the target of evaluation"
+	default:
+		return "the global default configuration of the metric"
+	}
+}
@@ -0,0 +1,225 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package orchestrator
+
+import (
+	"context"
+	"testing"
+
+	"confirmate.io/core/api/assessment"
+	"confirmate.io/core/api/orchestrator"
+	"confirmate.io/core/persistence"
+	"confirmate.io/core/persistence/persistencetest"
+	"confirmate.io/core/service"
+	"confirmate.io/core/service/orchestrator/orchestratortest"
+	"confirmate.io/core/util/assert"
+
+	"connectrpc.com/connect"
+)
+
+// resolutionSummary summarizes the chain of a resolved metric configuration as the sources of its steps and whether
+// they are defined and applied, so that the chains can be compared in tests.
+type resolutionSummary struct {
+	Source    assessment.MetricConfigurationSource
+	CatalogId string
+	Defined   bool
+	Applied   bool
+}
+
+func summarizeResolution(chain []*orchestrator.MetricConfigurationResolutionStep) (summary []resolutionSummary) {
+	for _, step := range chain {
+		summary = append(summary, resolutionSummary{
+			Source:    step.GetSource(),
+			CatalogId: step.GetCatalogId(),
+			Defined:   step.GetDefined(),
+			Applied:   step.GetApplied(),
+		})
+	}
+
+	return summary
+}
+
+func TestService_ResolveMetricConfiguration(t *testing.T) {
+	var (
+		metricId = orchestratortest.MockMetricWithDefault.Id
+		toeId    = orchestratortest.MockToeId1
+	)
+
+	type fields struct {
+		db    persistence.DB
+		authz service.AuthorizationStrategy
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		req     *orchestrator.ResolveMetricConfigurationRequest
+		want    assert.Want[*connect.Response[orchestrator.ResolveMetricConfigurationResponse]]
+		wantErr assert.WantErr
+	}{
+		{
+			name: "validation error",
+			fields: fields{
+				db:    persistencetest.NewInMemoryDB(t, types, joinTables),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			req:  &orchestrator.ResolveMetricConfigurationRequest{TargetOfEvaluationId: toeId},
+			want: assert.Nil[*connect.Response[orchestrator.ResolveMetricConfigurationResponse]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsValidationError(t, err, "metric_id")
+			},
+		},
+		{
+			name: "permission denied",
+			fields: fields{
+				db:    persistencetest.NewInMemoryDB(t, types, joinTables),
+				authz: &denyAuthorizationStrategy{},
+			},
+			req:  &orchestrator.ResolveMetricConfigurationRequest{TargetOfEvaluationId: toeId, MetricId: metricId},
+			want: assert.Nil[*connect.Response[orchestrator.ResolveMetricConfigurationResponse]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodePermissionDenied)
+			},
+		},
+		{
+			name: "not found",
+			fields: fields{
+				db:    persistencetest.NewInMemoryDB(t, types, joinTables),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			req:  &orchestrator.ResolveMetricConfigurationRequest{TargetOfEvaluationId: toeId, MetricId: orchestratortest.MockNonExistentId},
+			want: assert.Nil[*connect.Response[orchestrator.ResolveMetricConfigurationResponse]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeNotFound)
+			},
+		},
+		{
+			name: "global default configuration",
+			fields: fields{
+				db:    persistencetest.NewInMemoryDB(t, types, joinTables),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			req: &orchestrator.ResolveMetricConfigurationRequest{TargetOfEvaluationId: toeId, MetricId: metricId},
+			want: func(t *testing.T, got *connect.Response[orchestrator.ResolveMetricConfigurationResponse], args ...any) bool {
+				return assert.Equal(t, assessment.MetricConfigurationSource_METRIC_CONFIGURATION_SOURCE_DEFAULT, got.Msg.GetConfiguration().GetSource()) &&
+					assert.Equal(t, []resolutionSummary{
+						{Source: assessment.MetricConfigurationSource_METRIC_CONFIGURATION_SOURCE_DEFAULT, Defined: true, Applied: true},
+						{Source: assessment.MetricConfigurationSource_METRIC_CONFIGURATION_SOURCE_TARGET_OF_EVALUATION},
+					}, summarizeResolution(got.Msg.GetChain()))
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "catalog default configuration overrides global default",
+			fields: fields{
+				db: persistencetest.NewInMemoryDB(t, types, joinTables, func(d persistence.DB) {
+					assert.NoError(t, d.Create(orchestratortest.MockTargetOfEvaluation1))
+					assert.NoError(t, d.Create(orchestratortest.MockAuditScope1))
+					assert.NoError(t, d.Create(orchestratortest.MockCatalogMetricConfiguration1))
+				}),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			req: &orchestrator.ResolveMetricConfigurationRequest{TargetOfEvaluationId: toeId, MetricId: metricId},
+			want: func(t *testing.T, got *connect.Response[orchestrator.ResolveMetricConfigurationResponse], args ...any) bool {
+				return assert.Equal(t, float64(256), got.Msg.GetConfiguration().GetTargetValue().GetNumberValue()) &&
+					assert.Equal(t, orchestratortest.MockCatalogId1, got.Msg.GetConfiguration().GetCatalogId()) &&
+					assert.Equal(t, []resolutionSummary{
+						{Source: assessment.MetricConfigurationSource_METRIC_CONFIGURATION_SOURCE_DEFAULT, Defined: true},
+						{Source: assessment.MetricConfigurationSource_METRIC_CONFIGURATION_SOURCE_CATALOG_DEFAULT, CatalogId: orchestratortest.MockCatalogId1, Defined: true, Applied: true},
+						{Source: assessment.MetricConfigurationSource_METRIC_CONFIGURATION_SOURCE_TARGET_OF_EVALUATION},
+					}, summarizeResolution(got.Msg.GetChain())) &&
+					assert.Equal(t, "Overridden by the default configuration of catalog "+orchestratortest.MockCatalogId1, got.Msg.GetChain()[0].GetReason())
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "ambiguous catalog default configurations are ignored",
+			fields: fields{
+				db: persistencetest.NewInMemoryDB(t, types, joinTables, func(d persistence.DB) {
+					assert.NoError(t, d.Create(orchestratortest.MockTargetOfEvaluation1))
+					assert.NoError(t, d.Create(orchestratortest.MockAuditScope1))
+					assert.NoError(t, d.Create(&orchestrator.AuditScope{
+						Id:                   orchestratortest.MockScopeId2,
+						Name:                 orchestratortest.MockScopeName2,
+						TargetOfEvaluationId: toeId,
+						CatalogId:            orchestratortest.MockCatalogId2,
+					}))
+					assert.NoError(t, d.Create(orchestratortest.MockCatalogMetricConfiguration1))
+					assert.NoError(t, d.Create(orchestratortest.MockCatalogMetricConfiguration2))
+				}),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			req: &orchestrator.ResolveMetricConfigurationRequest{TargetOfEvaluationId: toeId, MetricId: metricId},
+			want: func(t *testing.T, got *connect.Response[orchestrator.ResolveMetricConfigurationResponse], args ...any) bool {
+				return assert.Equal(t, assessment.MetricConfigurationSource_METRIC_CONFIGURATION_SOURCE_DEFAULT, got.Msg.GetConfiguration().GetSource()) &&
+					assert.Equal(t, []resolutionSummary{
+						{Source: assessment.MetricConfigurationSource_METRIC_CONFIGURATION_SOURCE_DEFAULT, Defined: true, Applied: true},
+						{Source: assessment.MetricConfigurationSource_METRIC_CONFIGURATION_SOURCE_CATALOG_DEFAULT, CatalogId: orchestratortest.MockCatalogId1, Defined: true},
+						{Source: assessment.MetricConfigurationSource_METRIC_CONFIGURATION_SOURCE_CATALOG_DEFAULT, CatalogId: orchestratortest.MockCatalogId2, Defined: true},
+						{Source: assessment.MetricConfigurationSource_METRIC_CONFIGURATION_SOURCE_TARGET_OF_EVALUATION},
+					}, summarizeResolution(got.Msg.GetChain()))
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "explicit configuration of the target of evaluation overrides catalog default",
+			fields: fields{
+				db: persistencetest.NewInMemoryDB(t, types, joinTables, func(d persistence.DB) {
+					assert.NoError(t, d.Create(orchestratortest.MockTargetOfEvaluation1))
+					assert.NoError(t, d.Create(orchestratortest.MockMetric1))
+					assert.NoError(t, d.Create(orchestratortest.MockMetricConfiguration1))
+					assert.NoError(t, d.Create(&assessment.CatalogMetricConfiguration{
+						CatalogId:   orchestratortest.MockCatalogId1,
+						MetricId:    orchestratortest.MockMetricConfiguration1.MetricId,
+						Operator:    "!=",
+						TargetValue: orchestratortest.MockMetricConfiguration1.TargetValue,
+					}))
+				}),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			req: &orchestrator.ResolveMetricConfigurationRequest{
+				TargetOfEvaluationId: orchestratortest.MockMetricConfiguration1.TargetOfEvaluationId,
+				MetricId:             orchestratortest.MockMetricConfiguration1.MetricId,
+				CatalogId:            new(orchestratortest.MockCatalogId1),
+			},
+			want: func(t *testing.T, got *connect.Response[orchestrator.ResolveMetricConfigurationResponse], args ...any) bool {
+				return assert.Equal(t, orchestratortest.MockMetricConfiguration1.Operator, got.Msg.GetConfiguration().GetOperator()) &&
+					assert.Equal(t, []resolutionSummary{
+						{Source: assessment.MetricConfigurationSource_METRIC_CONFIGURATION_SOURCE_DEFAULT},
+						{Source: assessment.MetricConfigurationSource_METRIC_CONFIGURATION_SOURCE_CATALOG_DEFAULT, CatalogId: orchestratortest.MockCatalogId1, Defined: true},
+						{Source: assessment.MetricConfigurationSource_METRIC_CONFIGURATION_SOURCE_TARGET_OF_EVALUATION, Defined: true, Applied: true},
+					}, summarizeResolution(got.Msg.GetChain())) &&
+					assert.Equal(t, "Overridden by the explicit configuration of the target of evaluation", got.Msg.GetChain()[1].GetReason())
+			},
+			wantErr: assert.NoError,
+		},
+	}
+
+	// Set up a global default configuration
+	defaultMetricConfigurations[orchestratortest.MockMetricWithDefault.Id] = orchestratortest.MockMetricConfigurationDefault
+	defer delete(defaultMetricConfigurations, orchestratortest.MockMetricWithDefault.Id)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &Service{
+				db:    tt.fields.db,
+				authz: tt.fields.authz,
+			}
+			res, err := svc.ResolveMetricConfiguration(context.Background(), connect.NewRequest(tt.req))
+			tt.want(t, res)
+			tt.wantErr(t, err)
+		})
+	}
+}
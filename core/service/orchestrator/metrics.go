@@ -24,6 +24,7 @@ import (
 	"log/slog"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
 
 	"confirmate.io/core/api/assessment"
@@ -31,6 +32,7 @@ import (
 	"confirmate.io/core/log"
 	"confirmate.io/core/persistence"
 	"confirmate.io/core/service"
+	"confirmate.io/core/util/entity"
 	"github.com/google/uuid"
 
 	"connectrpc.com/connect"
@@ -61,6 +63,11 @@ func (svc *Service) CreateMetric(
 		return nil, err
 	}
 
+	// Invalid message templates would otherwise only show up once the metric is assessed
+	if err = checkMessageTemplates(req.Msg.GetMetric()); err != nil {
+		return nil, err
+	}
+
 	metricID = uuid.NewString()
 	if req.Msg.GetMetric().GetImplementation() != nil {
 		impl = &assessment.MetricImplementation{
@@ -72,13 +79,20 @@ func (svc *Service) CreateMetric(
 	}
 
 	metric = &assessment.Metric{
-		Id:             metricID,
-		Name:           req.Msg.GetMetric().GetName(),
-		Description:    req.Msg.GetMetric().GetDescription(),
-		Version:        req.Msg.GetMetric().GetVersion(),
-		Comments:       req.Msg.GetMetric().Comments,
-		Category:       req.Msg.GetMetric().GetCategory(),
-		Implementation: impl,
+		Id:                          metricID,
+		Name:                        req.Msg.GetMetric().GetName(),
+		Description:                 req.Msg.GetMetric().GetDescription(),
+		Version:                     req.Msg.GetMetric().GetVersion(),
+		Comments:                    req.Msg.GetMetric().Comments,
+		Category:                    req.Msg.GetMetric().GetCategory(),
+		ResourceTypes:               req.Msg.GetMetric().GetResourceTypes(),
+		EvidenceFields:              req.Msg.GetMetric().GetEvidenceFields(),
+		CompliantMessageTemplate:    req.Msg.GetMetric().CompliantMessageTemplate,
+		NonCompliantMessageTemplate: req.Msg.GetMetric().NonCompliantMessageTemplate,
+		Composite:                   req.Msg.GetMetric().GetComposite(),
+		Correlation:                 req.Msg.GetMetric().GetCorrelation(),
+		Remediation:                 req.Msg.GetMetric().GetRemediation(),
+		Implementation:              impl,
 	}
 
 	// Check access via the configured auth strategy
@@ -90,6 +104,10 @@ func (svc *Service) CreateMetric(
 		return nil, service.ErrPermissionDenied
 	}
 
+	if err = svc.checkComposite(metric); err != nil {
+		return nil, err
+	}
+
 	// Persist the new metric in the database
 	err = svc.db.Create(metric)
 	if err = service.HandleDatabaseError(err); err != nil {
@@ -142,6 +160,9 @@ func (svc *Service) ListMetrics(
 	var (
 		metrics []*assessment.Metric
 		npt     string
+		query   []string
+		args    []any
+		conds   []any
 	)
 
 	// Validate the request
@@ -155,12 +176,35 @@ func (svc *Service) ListMetrics(
 		req.Msg.Asc = true
 	}
 
-	// Filter metrics with empty DeprecatedSince field
+	// Filter metrics with empty DeprecatedSince field, unless deprecated metrics are requested
+	filter := req.Msg.GetFilter()
+	if !filter.GetIncludeDeprecated() {
+		query = append(query, "deprecated_since IS NULL")
+	}
+	if filter.GetCategory() != "" {
+		query = append(query, "category = ?")
+		args = append(args, filter.GetCategory())
+	}
+	// Since the resource types and evidence fields are stored as a JSON array, we look for the quoted value within it.
+	if filter.GetResourceType() != "" {
+		query = append(query, "resource_types LIKE ?")
+		args = append(args, fmt.Sprintf("%%%q%%", filter.GetResourceType()))
+	}
+	if filter.GetEvidenceField() != "" {
+		query = append(query, "evidence_fields LIKE ?")
+		args = append(args, fmt.Sprintf("%%%q%%", filter.GetEvidenceField()))
+	}
+
+	if len(query) > 0 {
+		conds = append(conds, strings.Join(query, " AND "))
+		conds = append(conds, args...)
+	}
+
 	metrics, npt, err = service.PaginateStorage[*assessment.Metric](
 		req.Msg,
 		svc.db,
 		service.DefaultPaginationOpts,
-		"deprecated_since IS NULL",
+		conds...,
 	)
 	if err = service.HandleDatabaseError(err); err != nil {
 		return nil, err
@@ -188,13 +232,25 @@ func (svc *Service) UpdateMetric(
 		return nil, err
 	}
 
+	// Invalid message templates would otherwise only show up once the metric is assessed
+	if err = checkMessageTemplates(req.Msg.GetMetric()); err != nil {
+		return nil, err
+	}
+
 	metric = &assessment.Metric{
-		Id:          req.Msg.GetMetric().GetId(),
-		Name:        req.Msg.GetMetric().GetName(),
-		Description: req.Msg.GetMetric().GetDescription(),
-		Version:     req.Msg.GetMetric().GetVersion(),
-		Comments:    req.Msg.GetMetric().Comments,
-		Category:    req.Msg.GetMetric().GetCategory(),
+		Id:                          req.Msg.GetMetric().GetId(),
+		Name:                        req.Msg.GetMetric().GetName(),
+		Description:                 req.Msg.GetMetric().GetDescription(),
+		Version:                     req.Msg.GetMetric().GetVersion(),
+		Comments:                    req.Msg.GetMetric().Comments,
+		Category:                    req.Msg.GetMetric().GetCategory(),
+		ResourceTypes:               req.Msg.GetMetric().GetResourceTypes(),
+		EvidenceFields:              req.Msg.GetMetric().GetEvidenceFields(),
+		CompliantMessageTemplate:    req.Msg.GetMetric().CompliantMessageTemplate,
+		NonCompliantMessageTemplate: req.Msg.GetMetric().NonCompliantMessageTemplate,
+		Composite:                   req.Msg.GetMetric().GetComposite(),
+		Correlation:                 req.Msg.GetMetric().GetCorrelation(),
+		Remediation:                 req.Msg.GetMetric().GetRemediation(),
 	}
 
 	// Check access via the configured auth strategy
@@ -206,6 +262,10 @@ func (svc *Service) UpdateMetric(
 		return nil, service.ErrPermissionDenied
 	}
 
+	if err = svc.checkComposite(metric); err != nil {
+		return nil, err
+	}
+
 	// Update the metric
 	err = svc.db.Update(metric, "id = ?", metric.Id)
 	if err = service.HandleDatabaseError(err, service.ErrNotFound("metric")); err != nil {
@@ -356,13 +416,17 @@ func (svc *Service) UpdateMetricImplementation(
 	return
 }
 
-// GetMetricConfiguration retrieves a metric configuration for a specific TOE and metric.
+// GetMetricConfiguration retrieves a metric configuration for a specific TOE and metric. If the TOE has no explicit
+// configuration, it falls back to the default configuration of the catalog and finally to the global default
+// configuration of the metric.
 func (svc *Service) GetMetricConfiguration(
 	ctx context.Context,
 	req *connect.Request[orchestrator.GetMetricConfigurationRequest],
 ) (res *connect.Response[assessment.MetricConfiguration], err error) {
 	var (
-		config assessment.MetricConfiguration
+		config        assessment.MetricConfiguration
+		catalogConfig *assessment.CatalogMetricConfiguration
+		dbErr         error
 	)
 
 	// Validate the request
@@ -371,30 +435,128 @@ func (svc *Service) GetMetricConfiguration(
 	}
 
 	// Use WithoutPreload because MetricConfiguration contains structpb.Value which has unexported fields
-	err = svc.db.Get(&config, persistence.WithoutPreload(), "target_of_evaluation_id = ? AND metric_id = ?",
+	dbErr = svc.db.Get(&config, persistence.WithoutPreload(), "target_of_evaluation_id = ? AND metric_id = ?",
 		req.Msg.TargetOfEvaluationId, req.Msg.MetricId)
+	if dbErr == nil {
+		config.Source = assessment.MetricConfigurationSource_METRIC_CONFIGURATION_SOURCE_TARGET_OF_EVALUATION
+		res = connect.NewResponse(&config)
+		return
+	} else if !errors.Is(dbErr, persistence.ErrRecordNotFound) {
+		return nil, service.HandleDatabaseError(dbErr)
+	}
+
+	// If not found in DB, fall back to the default configuration of the catalog
+	catalogConfig, err = svc.resolveCatalogMetricConfiguration(entity.ToEID(req.Msg.TargetOfEvaluationId), entity.MetricID(req.Msg.MetricId), (*entity.CatalogID)(req.Msg.CatalogId))
 	if err != nil {
-		// If not found in DB, fall back to default configuration
-		if errors.Is(err, persistence.ErrRecordNotFound) {
-			if defaultConfig, ok := defaultMetricConfigurations[req.Msg.MetricId]; ok {
-				// Copy the default configuration and set the target of evaluation ID
-				config = assessment.MetricConfiguration{
-					Operator:             defaultConfig.Operator,
-					TargetValue:          defaultConfig.TargetValue,
-					IsDefault:            true,
-					MetricId:             req.Msg.MetricId,
-					TargetOfEvaluationId: req.Msg.TargetOfEvaluationId,
-				}
-				res = connect.NewResponse(&config)
-				return res, nil
-			}
+		return nil, err
+	}
+	if catalogConfig != nil {
+		res = connect.NewResponse(catalogDefaultConfiguration(req.Msg.TargetOfEvaluationId, catalogConfig))
+		return res, nil
+	}
+
+	// Otherwise, fall back to the global default configuration
+	if defaultConfig, ok := globalDefaultConfiguration(req.Msg.TargetOfEvaluationId, req.Msg.MetricId); ok {
+		res = connect.NewResponse(defaultConfig)
+		return res, nil
+	}
+
+	return nil, service.HandleDatabaseError(dbErr, service.ErrNotFound("metric configuration"))
+}
+
+// resolveCatalogMetricConfiguration returns the default configuration of a metric for a catalog. If no catalog ID is
+// given, the catalogs of the audit scopes of the target of evaluation are considered; their default is only used if
+// exactly one of them defines one, since we cannot decide between conflicting catalogs. It returns nil if no catalog
+// default applies.
+func (svc *Service) resolveCatalogMetricConfiguration(toeId entity.ToEID, metricId entity.MetricID, catalogId *entity.CatalogID) (config *assessment.CatalogMetricConfiguration, err error) {
+	var (
+		configs []*assessment.CatalogMetricConfiguration
+	)
+
+	_, configs, err = svc.catalogMetricConfigurations(toeId, metricId, catalogId)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(configs) != 1 {
+		if len(configs) > 1 {
+			slog.Debug("Ignoring ambiguous catalog default configurations of metric",
+				slog.String("metric", metricId.String()),
+				slog.String("target of evaluation", toeId.String()),
+				slog.Int("catalogs", len(configs)))
 		}
 
-		return nil, service.HandleDatabaseError(err, service.ErrNotFound("metric configuration"))
+		return nil, nil
 	}
 
-	res = connect.NewResponse(&config)
-	return
+	return configs[0], nil
+}
+
+// catalogMetricConfigurations returns the IDs of the catalogs whose default configurations are considered for the
+// target of evaluation, i.e., the given catalog or otherwise the catalogs of the audit scopes of the target of
+// evaluation, as well as the default configurations of the metric these catalogs define.
+func (svc *Service) catalogMetricConfigurations(toeId entity.ToEID, metricId entity.MetricID, catalogId *entity.CatalogID) (catalogIds []string, configs []*assessment.CatalogMetricConfiguration, err error) {
+	if catalogId != nil {
+		catalogIds = []string{catalogId.String()}
+	} else {
+		err = svc.db.Pluck(&orchestrator.AuditScope{}, "catalog_id", &catalogIds, "target_of_evaluation_id = ?", toeId.String())
+		if err = service.HandleDatabaseError(err); err != nil {
+			return nil, nil, err
+		}
+
+		// A catalog might be part of several audit scopes of the target of evaluation
+		slices.Sort(catalogIds)
+		catalogIds = slices.Compact(catalogIds)
+	}
+
+	if len(catalogIds) == 0 {
+		return nil, nil, nil
+	}
+
+	// Use WithoutPreload because CatalogMetricConfiguration contains structpb.Value which has unexported fields
+	err = svc.db.List(&configs, "catalog_id", true, 0, -1, persistence.WithoutPreload(),
+		"metric_id = ? AND catalog_id IN ?", metricId.String(), catalogIds)
+	if err = service.HandleDatabaseError(err); err != nil {
+		return nil, nil, err
+	}
+
+	return catalogIds, configs, nil
+}
+
+// catalogDefaultConfiguration returns the metric configuration of the target of evaluation that is based on the default
+// configuration of a catalog.
+func catalogDefaultConfiguration(toeId string, catalogConfig *assessment.CatalogMetricConfiguration) *assessment.MetricConfiguration {
+	return &assessment.MetricConfiguration{
+		Operator:             catalogConfig.Operator,
+		TargetValue:          catalogConfig.TargetValue,
+		IsDefault:            true,
+		UpdatedAt:            catalogConfig.UpdatedAt,
+		MetricId:             catalogConfig.MetricId,
+		TargetOfEvaluationId: toeId,
+		Source:               assessment.MetricConfigurationSource_METRIC_CONFIGURATION_SOURCE_CATALOG_DEFAULT,
+		CatalogId:            &catalogConfig.CatalogId,
+	}
+}
+
+// globalDefaultConfiguration returns the metric configuration of the target of evaluation that is based on the global
+// default configuration of the metric, if the metric has one.
+func globalDefaultConfiguration(toeId string, metricId string) (config *assessment.MetricConfiguration, ok bool) {
+	var defaultConfig *assessment.MetricConfiguration
+
+	defaultConfig, ok = defaultMetricConfigurations[metricId]
+	if !ok {
+		return nil, false
+	}
+
+	// Copy the default configuration and set the target of evaluation ID
+	return &assessment.MetricConfiguration{
+		Operator:             defaultConfig.Operator,
+		TargetValue:          defaultConfig.TargetValue,
+		IsDefault:            true,
+		MetricId:             metricId,
+		TargetOfEvaluationId: toeId,
+		Source:               assessment.MetricConfigurationSource_METRIC_CONFIGURATION_SOURCE_DEFAULT,
+	}, true
 }
 
 // ListMetricConfigurations lists all metric configurations for a specific TOE.
@@ -460,6 +622,7 @@ func (svc *Service) UpdateMetricConfiguration(
 		TargetValue:          req.Msg.GetConfiguration().GetTargetValue(),
 		IsDefault:            false,
 		UpdatedAt:            timestamppb.Now(),
+		MaxEvidenceAgeHours:  req.Msg.GetConfiguration().MaxEvidenceAgeHours,
 	}
 
 	// Check access via the configured auth strategy
@@ -493,6 +656,185 @@ func (svc *Service) UpdateMetricConfiguration(
 	return
 }
 
+// UpdateCatalogMetricConfiguration creates or updates the default configuration of a metric for a specific catalog.
+func (svc *Service) UpdateCatalogMetricConfiguration(
+	ctx context.Context,
+	req *connect.Request[orchestrator.UpdateCatalogMetricConfigurationRequest],
+) (res *connect.Response[assessment.CatalogMetricConfiguration], err error) {
+	var (
+		config  *assessment.CatalogMetricConfiguration
+		allowed bool
+	)
+
+	// Validate the request
+	if err = service.Validate(req); err != nil {
+		return nil, err
+	}
+
+	// Check access via the configured auth strategy
+	allowed, _, err = CheckAccess(ctx, svc.authz, svc, orchestrator.RequestType_REQUEST_TYPE_UPDATED, "", orchestrator.ObjectType_OBJECT_TYPE_CATALOG)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if !allowed {
+		return nil, service.ErrPermissionDenied
+	}
+
+	// Make sure that the catalog exists and is not a published (and therefore immutable) catalog version
+	if _, err = editableCatalog(svc.db, req.Msg.GetConfiguration().GetCatalogId()); err != nil {
+		return nil, err
+	}
+
+	// Make sure that the metric exists, otherwise the configuration would never apply
+	err = svc.db.Get(&assessment.Metric{}, persistence.WithoutPreload(), "id = ?", req.Msg.GetConfiguration().GetMetricId())
+	if err = service.HandleDatabaseError(err, service.ErrNotFound("metric")); err != nil {
+		return nil, err
+	}
+
+	config = &assessment.CatalogMetricConfiguration{
+		CatalogId:   req.Msg.GetConfiguration().GetCatalogId(),
+		MetricId:    req.Msg.GetConfiguration().GetMetricId(),
+		Operator:    req.Msg.GetConfiguration().GetOperator(),
+		TargetValue: req.Msg.GetConfiguration().GetTargetValue(),
+		UpdatedAt:   timestamppb.Now(),
+	}
+
+	// Save the catalog metric configuration
+	err = svc.db.Save(config)
+	if err = service.HandleDatabaseError(err); err != nil {
+		return nil, err
+	}
+
+	res = connect.NewResponse(config)
+	return
+}
+
+// ListCatalogMetricConfigurations lists all default metric configurations of a specific catalog.
+func (svc *Service) ListCatalogMetricConfigurations(
+	ctx context.Context,
+	req *connect.Request[orchestrator.ListCatalogMetricConfigurationsRequest],
+) (res *connect.Response[orchestrator.ListCatalogMetricConfigurationsResponse], err error) {
+	var (
+		configs []*assessment.CatalogMetricConfiguration
+		npt     string
+	)
+
+	// Validate the request
+	if err = service.Validate(req); err != nil {
+		return nil, err
+	}
+
+	// Set default ordering
+	if req.Msg.OrderBy == "" {
+		req.Msg.OrderBy = "metric_id"
+		req.Msg.Asc = true
+	}
+
+	// Use WithoutPreload because CatalogMetricConfiguration contains structpb.Value which has unexported fields
+	configs, npt, err = service.PaginateStorage[*assessment.CatalogMetricConfiguration](req.Msg, svc.db, service.DefaultPaginationOpts,
+		persistence.WithoutPreload(), "catalog_id = ?", req.Msg.CatalogId)
+	if err = service.HandleDatabaseError(err); err != nil {
+		return nil, err
+	}
+
+	res = connect.NewResponse(&orchestrator.ListCatalogMetricConfigurationsResponse{
+		Configurations: configs,
+		NextPageToken:  npt,
+	})
+	return
+}
+
+// RemoveCatalogMetricConfiguration removes the default configuration of a metric for a specific catalog.
+func (svc *Service) RemoveCatalogMetricConfiguration(
+	ctx context.Context,
+	req *connect.Request[orchestrator.RemoveCatalogMetricConfigurationRequest],
+) (res *connect.Response[emptypb.Empty], err error) {
+	var (
+		allowed bool
+	)
+
+	// Validate the request
+	if err = service.Validate(req); err != nil {
+		return nil, err
+	}
+
+	// Check access via the configured auth strategy
+	allowed, _, err = CheckAccess(ctx, svc.authz, svc, orchestrator.RequestType_REQUEST_TYPE_UPDATED, "", orchestrator.ObjectType_OBJECT_TYPE_CATALOG)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if !allowed {
+		return nil, service.ErrPermissionDenied
+	}
+
+	// Published catalog versions are immutable, including their default metric configurations
+	if _, err = editableCatalog(svc.db, req.Msg.GetCatalogId()); err != nil {
+		return nil, err
+	}
+
+	err = svc.db.Delete(&assessment.CatalogMetricConfiguration{}, "catalog_id = ? AND metric_id = ?", req.Msg.CatalogId, req.Msg.MetricId)
+	if err = service.HandleDatabaseError(err, service.ErrNotFound("catalog metric configuration")); err != nil {
+		return nil, err
+	}
+
+	res = connect.NewResponse(&emptypb.Empty{})
+	return
+}
+
+// checkMessageTemplates checks that the message templates of the metric can be parsed.
+func checkMessageTemplates(metric *assessment.Metric) (err error) {
+	if _, err = assessment.ParseMessageTemplate(metric.GetCompliantMessageTemplate()); err != nil {
+		return service.NewInvalidFieldError("metric.compliant_message_template",
+			fmt.Errorf("invalid compliant message template: %w", err))
+	}
+
+	if _, err = assessment.ParseMessageTemplate(metric.GetNonCompliantMessageTemplate()); err != nil {
+		return service.NewInvalidFieldError("metric.non_compliant_message_template",
+			fmt.Errorf("invalid non-compliant message template: %w", err))
+	}
+
+	return nil
+}
+
+// checkComposite checks that the metrics a composite metric combines exist and are not composite metrics themselves,
+// which also rules out cycles.
+func (svc *Service) checkComposite(metric *assessment.Metric) (err error) {
+	var composite = metric.GetComposite()
+
+	if composite == nil {
+		return nil
+	}
+
+	if composite.GetOperator() == assessment.CompositeOperator_COMPOSITE_OPERATOR_THRESHOLD &&
+		(composite.Threshold == nil || int(composite.GetThreshold()) > len(composite.GetMetricIds())) {
+		return service.NewInvalidFieldError("metric.composite.threshold",
+			errors.New("threshold must be between 1 and the number of metrics"))
+	}
+
+	for _, id := range composite.GetMetricIds() {
+		var component assessment.Metric
+
+		if id == metric.GetId() {
+			return service.NewInvalidFieldError("metric.composite.metric_ids",
+				errors.New("composite metric cannot combine itself"))
+		}
+
+		err = svc.db.Get(&component, persistence.WithoutPreload(), "id = ?", id)
+		if errors.Is(err, persistence.ErrRecordNotFound) {
+			return service.NewInvalidFieldError("metric.composite.metric_ids", fmt.Errorf("metric %s not found", id))
+		} else if err = service.HandleDatabaseError(err); err != nil {
+			return err
+		}
+
+		if component.GetComposite() != nil {
+			return service.NewInvalidFieldError("metric.composite.metric_ids",
+				fmt.Errorf("metric %s is a composite metric itself", id))
+		}
+	}
+
+	return nil
+}
+
 // loadMetrics loads metric definitions from configured sources.
 // It loads metrics from:
 // 1. DefaultMetricsPath (if LoadDefaultMetrics is true) - typically the security-metrics repository
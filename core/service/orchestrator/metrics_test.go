@@ -34,6 +34,7 @@ import (
 	"github.com/google/go-cmp/cmp"
 
 	"connectrpc.com/connect"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/testing/protocmp"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/structpb"
@@ -102,6 +103,148 @@ func TestService_CreateMetric(t *testing.T) {
 					assert.IsValidationError(t, err, "metric.id")
 			},
 		},
+		{
+			name: "validation error - invalid message template",
+			args: args{
+				req: &orchestrator.CreateMetricRequest{
+					Metric: func() *assessment.Metric {
+						m := proto.CloneOf(orchestratortest.MockMetric1)
+						m.NonCompliantMessageTemplate = new("{{.Resource.name")
+						return m
+					}(),
+				},
+			},
+			fields: fields{
+				db: persistencetest.NewInMemoryDB(t, types, joinTables),
+			},
+			want: assert.Nil[*connect.Response[assessment.Metric]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeInvalidArgument) &&
+					assert.ErrorContains(t, err, "invalid non-compliant message template")
+			},
+		},
+		{
+			name: "happy path: with message templates",
+			args: args{
+				req: &orchestrator.CreateMetricRequest{
+					Metric: func() *assessment.Metric {
+						m := proto.CloneOf(orchestratortest.MockMetric1)
+						m.CompliantMessageTemplate = new("{{.Resource.name}} is compliant.")
+						m.NonCompliantMessageTemplate = new("{{.Resource.name}} must be {{.Operator}} {{.TargetValue}}.")
+						return m
+					}(),
+				},
+			},
+			fields: fields{
+				db:    persistencetest.NewInMemoryDB(t, types, joinTables),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			want: func(t *testing.T, got *connect.Response[assessment.Metric], args ...any) bool {
+				return assert.Equal(t, "{{.Resource.name}} is compliant.", got.Msg.GetCompliantMessageTemplate()) &&
+					assert.Equal(t, "{{.Resource.name}} must be {{.Operator}} {{.TargetValue}}.", got.Msg.GetNonCompliantMessageTemplate())
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "happy path: with evidence correlation",
+			args: args{
+				req: &orchestrator.CreateMetricRequest{
+					Metric: func() *assessment.Metric {
+						m := proto.CloneOf(orchestratortest.MockMetric1)
+						m.Correlation = &assessment.EvidenceCorrelation{ToolIds: []string{"log-collector", "config-collector"}}
+						return m
+					}(),
+				},
+			},
+			fields: fields{
+				db:    persistencetest.NewInMemoryDB(t, types, joinTables),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			want: func(t *testing.T, got *connect.Response[assessment.Metric], args ...any) bool {
+				return assert.Equal(t, []string{"log-collector", "config-collector"}, got.Msg.GetCorrelation().GetToolIds())
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "validation error - composite of unknown metric",
+			args: args{
+				req: &orchestrator.CreateMetricRequest{
+					Metric: func() *assessment.Metric {
+						m := proto.CloneOf(orchestratortest.MockMetric2)
+						m.Composite = &assessment.CompositeMetric{
+							MetricIds: []string{orchestratortest.MockMetricId1},
+							Operator:  assessment.CompositeOperator_COMPOSITE_OPERATOR_AND,
+						}
+						return m
+					}(),
+				},
+			},
+			fields: fields{
+				db:    persistencetest.NewInMemoryDB(t, types, joinTables),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			want: assert.Nil[*connect.Response[assessment.Metric]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				assert.IsConnectError(t, err, connect.CodeInvalidArgument)
+				return assert.ErrorContains(t, err, "metric "+orchestratortest.MockMetricId1+" not found")
+			},
+		},
+		{
+			name: "validation error - threshold exceeds the number of metrics",
+			args: args{
+				req: &orchestrator.CreateMetricRequest{
+					Metric: func() *assessment.Metric {
+						m := proto.CloneOf(orchestratortest.MockMetric2)
+						m.Composite = &assessment.CompositeMetric{
+							MetricIds: []string{orchestratortest.MockMetricId1},
+							Operator:  assessment.CompositeOperator_COMPOSITE_OPERATOR_THRESHOLD,
+							Threshold: new(int32(2)),
+						}
+						return m
+					}(),
+				},
+			},
+			fields: fields{
+				db: persistencetest.NewInMemoryDB(t, types, joinTables, func(d persistence.DB) {
+					assert.NoError(t, d.Create(orchestratortest.MockMetric1))
+				}),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			want: assert.Nil[*connect.Response[assessment.Metric]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				assert.IsConnectError(t, err, connect.CodeInvalidArgument)
+				return assert.ErrorContains(t, err, "threshold must be between 1 and the number of metrics")
+			},
+		},
+		{
+			name: "happy path: composite metric",
+			args: args{
+				req: &orchestrator.CreateMetricRequest{
+					Metric: func() *assessment.Metric {
+						m := proto.CloneOf(orchestratortest.MockMetric2)
+						m.Composite = &assessment.CompositeMetric{
+							MetricIds: []string{orchestratortest.MockMetricId1},
+							Operator:  assessment.CompositeOperator_COMPOSITE_OPERATOR_AND,
+						}
+						return m
+					}(),
+				},
+			},
+			fields: fields{
+				db: persistencetest.NewInMemoryDB(t, types, joinTables, func(d persistence.DB) {
+					assert.NoError(t, d.Create(orchestratortest.MockMetric1))
+				}),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			want: func(t *testing.T, got *connect.Response[assessment.Metric], args ...any) bool {
+				want := &assessment.CompositeMetric{
+					MetricIds: []string{orchestratortest.MockMetricId1},
+					Operator:  assessment.CompositeOperator_COMPOSITE_OPERATOR_AND,
+				}
+				return assert.Equal(t, want, got.Msg.GetComposite())
+			},
+			wantErr: assert.NoError,
+		},
 		{
 			name: "db error - unique constraint",
 			args: args{
@@ -328,6 +471,68 @@ func TestService_ListMetrics(t *testing.T) {
 			},
 			wantErr: assert.NoError,
 		},
+		{
+			name: "happy path: include deprecated metrics",
+			args: args{
+				req: &orchestrator.ListMetricsRequest{
+					Filter: &orchestrator.ListMetricsRequest_Filter{
+						IncludeDeprecated: new(true),
+					},
+				},
+			},
+			fields: fields{
+				db: persistencetest.NewInMemoryDB(t, types, joinTables, func(d persistence.DB) {
+					assert.NoError(t, d.Create(orchestratortest.MockMetric1))
+					assert.NoError(t, d.Create(orchestratortest.MockMetricDeprecated))
+				}),
+			},
+			want: func(t *testing.T, got *connect.Response[orchestrator.ListMetricsResponse], args ...any) bool {
+				return assert.Equal(t, 2, len(got.Msg.Metrics))
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "happy path: filter by category, resource type and evidence field",
+			args: args{
+				req: &orchestrator.ListMetricsRequest{
+					Filter: &orchestrator.ListMetricsRequest_Filter{
+						Category:      new(orchestratortest.MockTestCategory),
+						ResourceType:  new("VirtualMachine"),
+						EvidenceField: new("bootLogging.enabled"),
+					},
+				},
+			},
+			fields: fields{
+				db: persistencetest.NewInMemoryDB(t, types, joinTables, func(d persistence.DB) {
+					assert.NoError(t, d.Create(orchestratortest.MockMetric1))
+					assert.NoError(t, d.Create(orchestratortest.MockMetric2))
+				}),
+			},
+			want: func(t *testing.T, got *connect.Response[orchestrator.ListMetricsResponse], args ...any) bool {
+				return assert.Equal(t, []*assessment.Metric{orchestratortest.MockMetric1}, got.Msg.Metrics)
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "happy path: filter by resource type without matches",
+			args: args{
+				req: &orchestrator.ListMetricsRequest{
+					Filter: &orchestrator.ListMetricsRequest_Filter{
+						ResourceType: new("ObjectStorage"),
+					},
+				},
+			},
+			fields: fields{
+				db: persistencetest.NewInMemoryDB(t, types, joinTables, func(d persistence.DB) {
+					assert.NoError(t, d.Create(orchestratortest.MockMetric1))
+					assert.NoError(t, d.Create(orchestratortest.MockMetric2))
+				}),
+			},
+			want: func(t *testing.T, got *connect.Response[orchestrator.ListMetricsResponse], args ...any) bool {
+				return assert.Equal(t, 0, len(got.Msg.Metrics))
+			},
+			wantErr: assert.NoError,
+		},
 		{
 			name: "happy path: empty list",
 			args: args{
@@ -367,6 +572,12 @@ func TestService_UpdateMetric(t *testing.T) {
 		authz service.AuthorizationStrategy
 	}
 
+	// The database of the remediation test case, which is checked after the update
+	remediationDB := persistencetest.NewInMemoryDB(t, types, joinTables, func(d persistence.DB) {
+		err := d.Create(orchestratortest.MockMetric1)
+		assert.NoError(t, err)
+	})
+
 	tests := []struct {
 		name    string
 		args    args
@@ -379,11 +590,13 @@ func TestService_UpdateMetric(t *testing.T) {
 			args: args{
 				req: &orchestrator.UpdateMetricRequest{
 					Metric: &assessment.Metric{
-						Id:          orchestratortest.MockMetric1.Id,
-						Name:        orchestratortest.MockMetricName1,
-						Description: "Updated description",
-						Version:     "v1",
-						Category:    "test-category",
+						Id:             orchestratortest.MockMetric1.Id,
+						Name:           orchestratortest.MockMetricName1,
+						Description:    "Updated description",
+						Version:        "v1",
+						Category:       "test-category",
+						ResourceTypes:  orchestratortest.MockMetric1.ResourceTypes,
+						EvidenceFields: orchestratortest.MockMetric1.EvidenceFields,
 					},
 				},
 			},
@@ -408,11 +621,13 @@ func TestService_UpdateMetric(t *testing.T) {
 			args: args{
 				req: &orchestrator.UpdateMetricRequest{
 					Metric: &assessment.Metric{
-						Id:          orchestratortest.MockMetric1.Id,
-						Name:        orchestratortest.MockMetricName1,
-						Description: "Updated description",
-						Version:     "v1",
-						Category:    "test-category",
+						Id:             orchestratortest.MockMetric1.Id,
+						Name:           orchestratortest.MockMetricName1,
+						Description:    "Updated description",
+						Version:        "v1",
+						Category:       "test-category",
+						ResourceTypes:  orchestratortest.MockMetric1.ResourceTypes,
+						EvidenceFields: orchestratortest.MockMetric1.EvidenceFields,
 					},
 				},
 				ctx: auth.WithClaims(context.Background(), &auth.OAuthClaims{
@@ -435,6 +650,37 @@ func TestService_UpdateMetric(t *testing.T) {
 			},
 			wantErr: assert.NoError,
 		},
+		{
+			name: "happy path: with remediation",
+			args: args{
+				req: &orchestrator.UpdateMetricRequest{
+					Metric: &assessment.Metric{
+						Id:          orchestratortest.MockMetric1.Id,
+						Name:        orchestratortest.MockMetricName1,
+						Description: orchestratortest.MockMetric1.Description,
+						Version:     "v1",
+						Category:    "test-category",
+						Remediation: &assessment.Remediation{
+							Text:       "Enable boot logging of the virtual machine.",
+							Links:      []*assessment.RemediationLink{{Title: "Boot diagnostics", Url: "https://example.com/boot-diagnostics"}},
+							IacSnippet: &assessment.IacSnippet{Language: "terraform", Code: "boot_diagnostics {}"},
+						},
+					},
+				},
+			},
+			fields: fields{
+				db:    remediationDB,
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			want: func(t *testing.T, got *connect.Response[assessment.Metric], args ...any) bool {
+				var metric assessment.Metric
+
+				err := remediationDB.Get(&metric, "id = ?", orchestratortest.MockMetric1.Id)
+				return assert.NoError(t, err) &&
+					assert.Equal(t, got.Msg.Remediation, metric.Remediation)
+			},
+			wantErr: assert.NoError,
+		},
 		{
 			name: "authorization error",
 			args: args{
@@ -511,11 +757,13 @@ func TestService_UpdateMetric(t *testing.T) {
 			args: args{
 				req: &orchestrator.UpdateMetricRequest{
 					Metric: &assessment.Metric{
-						Id:          orchestratortest.MockMetric1.Id,
-						Name:        orchestratortest.MockMetricName1,
-						Description: "Updated description",
-						Version:     "v1",
-						Category:    "test-category",
+						Id:             orchestratortest.MockMetric1.Id,
+						Name:           orchestratortest.MockMetricName1,
+						Description:    "Updated description",
+						Version:        "v1",
+						Category:       "test-category",
+						ResourceTypes:  orchestratortest.MockMetric1.ResourceTypes,
+						EvidenceFields: orchestratortest.MockMetric1.EvidenceFields,
 					},
 				},
 			},
@@ -932,8 +1180,11 @@ func TestService_GetMetricConfiguration(t *testing.T) {
 				}),
 			},
 			want: func(t *testing.T, got *connect.Response[assessment.MetricConfiguration], args ...any) bool {
+				want := proto.Clone(orchestratortest.MockMetricConfiguration1).(*assessment.MetricConfiguration)
+				want.Source = assessment.MetricConfigurationSource_METRIC_CONFIGURATION_SOURCE_TARGET_OF_EVALUATION
+
 				assert.NotNil(t, got.Msg)
-				return assert.Equal(t, orchestratortest.MockMetricConfiguration1, got.Msg)
+				return assert.Equal(t, want, got.Msg)
 			},
 			wantErr: assert.NoError,
 		},
@@ -1002,10 +1253,95 @@ func TestService_GetMetricConfiguration(t *testing.T) {
 				assert.Equal(t, orchestratortest.MockToeId1, got.Msg.TargetOfEvaluationId)
 				assert.Equal(t, "==", got.Msg.Operator)
 				assert.True(t, got.Msg.IsDefault)
+				assert.Equal(t, assessment.MetricConfigurationSource_METRIC_CONFIGURATION_SOURCE_DEFAULT, got.Msg.Source)
 				return assert.True(t, got.Msg.TargetValue.GetBoolValue())
 			},
 			wantErr: assert.NoError,
 		},
+		{
+			name: "fallback to catalog default configuration of given catalog",
+			args: args{
+				req: &orchestrator.GetMetricConfigurationRequest{
+					TargetOfEvaluationId: orchestratortest.MockToeId1,
+					MetricId:             orchestratortest.MockMetricWithDefault.Id,
+					CatalogId:            new(orchestratortest.MockCatalogId2),
+				},
+			},
+			fields: fields{
+				db: persistencetest.NewInMemoryDB(t, types, joinTables, func(d persistence.DB) {
+					assert.NoError(t, d.Create(orchestratortest.MockCatalogMetricConfiguration1))
+					assert.NoError(t, d.Create(orchestratortest.MockCatalogMetricConfiguration2))
+				}),
+			},
+			want: func(t *testing.T, got *connect.Response[assessment.MetricConfiguration], args ...any) bool {
+				if !assert.NotNil(t, got) {
+					return false
+				}
+				assert.Equal(t, ">=", got.Msg.Operator)
+				assert.True(t, got.Msg.IsDefault)
+				assert.Equal(t, assessment.MetricConfigurationSource_METRIC_CONFIGURATION_SOURCE_CATALOG_DEFAULT, got.Msg.Source)
+				assert.Equal(t, orchestratortest.MockCatalogId2, got.Msg.GetCatalogId())
+				return assert.Equal(t, float64(128), got.Msg.TargetValue.GetNumberValue())
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "fallback to catalog default configuration of audit scope",
+			args: args{
+				req: &orchestrator.GetMetricConfigurationRequest{
+					TargetOfEvaluationId: orchestratortest.MockToeId1,
+					MetricId:             orchestratortest.MockMetricWithDefault.Id,
+				},
+			},
+			fields: fields{
+				db: persistencetest.NewInMemoryDB(t, types, joinTables, func(d persistence.DB) {
+					assert.NoError(t, d.Create(orchestratortest.MockTargetOfEvaluation1))
+					assert.NoError(t, d.Create(orchestratortest.MockAuditScope1))
+					assert.NoError(t, d.Create(orchestratortest.MockCatalogMetricConfiguration1))
+					assert.NoError(t, d.Create(orchestratortest.MockCatalogMetricConfiguration2))
+				}),
+			},
+			want: func(t *testing.T, got *connect.Response[assessment.MetricConfiguration], args ...any) bool {
+				if !assert.NotNil(t, got) {
+					return false
+				}
+				assert.Equal(t, assessment.MetricConfigurationSource_METRIC_CONFIGURATION_SOURCE_CATALOG_DEFAULT, got.Msg.Source)
+				assert.Equal(t, orchestratortest.MockCatalogId1, got.Msg.GetCatalogId())
+				return assert.Equal(t, float64(256), got.Msg.TargetValue.GetNumberValue())
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "explicit configuration takes precedence over catalog default configuration",
+			args: args{
+				req: &orchestrator.GetMetricConfigurationRequest{
+					TargetOfEvaluationId: orchestratortest.MockMetricConfiguration1.TargetOfEvaluationId,
+					MetricId:             orchestratortest.MockMetricConfiguration1.MetricId,
+					CatalogId:            new(orchestratortest.MockCatalogId1),
+				},
+			},
+			fields: fields{
+				db: persistencetest.NewInMemoryDB(t, types, joinTables, func(d persistence.DB) {
+					assert.NoError(t, d.Create(orchestratortest.MockTargetOfEvaluation1))
+					assert.NoError(t, d.Create(orchestratortest.MockMetric1))
+					assert.NoError(t, d.Create(orchestratortest.MockMetricConfiguration1))
+					assert.NoError(t, d.Create(&assessment.CatalogMetricConfiguration{
+						CatalogId:   orchestratortest.MockCatalogId1,
+						MetricId:    orchestratortest.MockMetricConfiguration1.MetricId,
+						Operator:    "!=",
+						TargetValue: structpb.NewBoolValue(false),
+					}))
+				}),
+			},
+			want: func(t *testing.T, got *connect.Response[assessment.MetricConfiguration], args ...any) bool {
+				if !assert.NotNil(t, got) {
+					return false
+				}
+				assert.Equal(t, orchestratortest.MockMetricConfiguration1.Operator, got.Msg.Operator)
+				return assert.Equal(t, assessment.MetricConfigurationSource_METRIC_CONFIGURATION_SOURCE_TARGET_OF_EVALUATION, got.Msg.Source)
+			},
+			wantErr: assert.NoError,
+		},
 	}
 
 	// Set up a default configuration for the fallback test
@@ -1149,6 +1485,7 @@ func TestService_UpdateMetricConfiguration(t *testing.T) {
 						Operator:             "!=", // updates the operator from "==" to "!="
 						TargetValue:          structpb.NewBoolValue(false),
 						IsDefault:            false,
+						MaxEvidenceAgeHours:  new(uint32(72)),
 					},
 				},
 			},
@@ -1170,7 +1507,8 @@ func TestService_UpdateMetricConfiguration(t *testing.T) {
 				return assert.Equal(t, orchestratortest.MockToeId1, got.Msg.TargetOfEvaluationId) &&
 					assert.Equal(t, orchestratortest.MockMetricId1, got.Msg.MetricId) &&
 					assert.Equal(t, "!=", got.Msg.Operator) &&
-					assert.False(t, got.Msg.IsDefault)
+					assert.False(t, got.Msg.IsDefault) &&
+					assert.Equal(t, uint32(72), got.Msg.GetMaxEvidenceAgeHours())
 			},
 			wantErr: assert.NoError,
 		},
@@ -1333,6 +1671,313 @@ func TestService_UpdateMetricConfiguration(t *testing.T) {
 	}
 }
 
+func TestService_UpdateCatalogMetricConfiguration(t *testing.T) {
+	type args struct {
+		req *orchestrator.UpdateCatalogMetricConfigurationRequest
+	}
+	type fields struct {
+		db    persistence.DB
+		authz service.AuthorizationStrategy
+	}
+	tests := []struct {
+		name    string
+		args    args
+		fields  fields
+		want    assert.Want[*connect.Response[assessment.CatalogMetricConfiguration]]
+		wantErr assert.WantErr
+	}{
+		{
+			name: "validation error - missing configuration",
+			args: args{
+				req: &orchestrator.UpdateCatalogMetricConfigurationRequest{},
+			},
+			fields: fields{
+				db:    persistencetest.NewInMemoryDB(t, types, joinTables),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			want: assert.Nil[*connect.Response[assessment.CatalogMetricConfiguration]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsValidationError(t, err, "configuration")
+			},
+		},
+		{
+			name: "permission denied",
+			args: args{
+				req: &orchestrator.UpdateCatalogMetricConfigurationRequest{
+					Configuration: orchestratortest.MockCatalogMetricConfiguration1,
+				},
+			},
+			fields: fields{
+				db:    persistencetest.NewInMemoryDB(t, types, joinTables),
+				authz: &denyAuthorizationStrategy{},
+			},
+			want: assert.Nil[*connect.Response[assessment.CatalogMetricConfiguration]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodePermissionDenied)
+			},
+		},
+		{
+			name: "catalog not found",
+			args: args{
+				req: &orchestrator.UpdateCatalogMetricConfigurationRequest{
+					Configuration: orchestratortest.MockCatalogMetricConfiguration1,
+				},
+			},
+			fields: fields{
+				db:    persistencetest.NewInMemoryDB(t, types, joinTables),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			want: assert.Nil[*connect.Response[assessment.CatalogMetricConfiguration]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeNotFound)
+			},
+		},
+		{
+			name: "published catalog",
+			args: args{
+				req: &orchestrator.UpdateCatalogMetricConfigurationRequest{
+					Configuration: orchestratortest.MockCatalogMetricConfiguration1,
+				},
+			},
+			fields: fields{
+				db: persistencetest.NewInMemoryDB(t, types, joinTables, func(d persistence.DB) {
+					assert.NoError(t, d.Create(&orchestrator.Catalog{
+						Id:     orchestratortest.MockCatalogId1,
+						Name:   orchestratortest.MockCatalogName1,
+						Status: orchestrator.CatalogStatus_CATALOG_STATUS_PUBLISHED,
+					}))
+				}),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			want: assert.Nil[*connect.Response[assessment.CatalogMetricConfiguration]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeFailedPrecondition)
+			},
+		},
+		{
+			name: "metric not found",
+			args: args{
+				req: &orchestrator.UpdateCatalogMetricConfigurationRequest{
+					Configuration: orchestratortest.MockCatalogMetricConfiguration1,
+				},
+			},
+			fields: fields{
+				db: persistencetest.NewInMemoryDB(t, types, joinTables, func(d persistence.DB) {
+					assert.NoError(t, d.Create(orchestratortest.MockCatalog1))
+				}),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			want: assert.Nil[*connect.Response[assessment.CatalogMetricConfiguration]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeNotFound) &&
+					assert.ErrorContains(t, err, "metric")
+			},
+		},
+		{
+			name: "happy path",
+			args: args{
+				req: &orchestrator.UpdateCatalogMetricConfigurationRequest{
+					Configuration: orchestratortest.MockCatalogMetricConfiguration1,
+				},
+			},
+			fields: fields{
+				db: persistencetest.NewInMemoryDB(t, types, joinTables, func(d persistence.DB) {
+					assert.NoError(t, d.Create(orchestratortest.MockCatalog1))
+					assert.NoError(t, d.Create(orchestratortest.MockMetricWithDefault))
+				}),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			want: func(t *testing.T, got *connect.Response[assessment.CatalogMetricConfiguration], args ...any) bool {
+				assert.NotNil(t, got.Msg.UpdatedAt)
+				return assert.Equal(t, orchestratortest.MockCatalogMetricConfiguration1, got.Msg, protocmp.IgnoreFields(&assessment.CatalogMetricConfiguration{}, "updated_at"))
+			},
+			wantErr: assert.NoError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &Service{
+				db:    tt.fields.db,
+				authz: tt.fields.authz,
+			}
+			res, err := svc.UpdateCatalogMetricConfiguration(context.Background(), connect.NewRequest(tt.args.req))
+			tt.want(t, res)
+			tt.wantErr(t, err)
+		})
+	}
+}
+
+func TestService_ListCatalogMetricConfigurations(t *testing.T) {
+	type args struct {
+		req *orchestrator.ListCatalogMetricConfigurationsRequest
+	}
+	type fields struct {
+		db persistence.DB
+	}
+	tests := []struct {
+		name    string
+		args    args
+		fields  fields
+		want    assert.Want[*connect.Response[orchestrator.ListCatalogMetricConfigurationsResponse]]
+		wantErr assert.WantErr
+	}{
+		{
+			name: "validation error - missing catalog id",
+			args: args{
+				req: &orchestrator.ListCatalogMetricConfigurationsRequest{},
+			},
+			fields: fields{
+				db: persistencetest.NewInMemoryDB(t, types, joinTables),
+			},
+			want: assert.Nil[*connect.Response[orchestrator.ListCatalogMetricConfigurationsResponse]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsValidationError(t, err, "catalog_id")
+			},
+		},
+		{
+			name: "happy path",
+			args: args{
+				req: &orchestrator.ListCatalogMetricConfigurationsRequest{
+					CatalogId: orchestratortest.MockCatalogId1,
+				},
+			},
+			fields: fields{
+				db: persistencetest.NewInMemoryDB(t, types, joinTables, func(d persistence.DB) {
+					assert.NoError(t, d.Create(orchestratortest.MockCatalogMetricConfiguration1))
+					assert.NoError(t, d.Create(orchestratortest.MockCatalogMetricConfiguration2))
+				}),
+			},
+			want: func(t *testing.T, got *connect.Response[orchestrator.ListCatalogMetricConfigurationsResponse], args ...any) bool {
+				if !assert.Equal(t, 1, len(got.Msg.Configurations)) {
+					return false
+				}
+				return assert.Equal(t, orchestratortest.MockCatalogMetricConfiguration1, got.Msg.Configurations[0])
+			},
+			wantErr: assert.NoError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &Service{
+				db: tt.fields.db,
+			}
+			res, err := svc.ListCatalogMetricConfigurations(context.Background(), connect.NewRequest(tt.args.req))
+			tt.want(t, res)
+			tt.wantErr(t, err)
+		})
+	}
+}
+
+func TestService_RemoveCatalogMetricConfiguration(t *testing.T) {
+	type args struct {
+		req *orchestrator.RemoveCatalogMetricConfigurationRequest
+	}
+	type fields struct {
+		db    persistence.DB
+		authz service.AuthorizationStrategy
+	}
+	tests := []struct {
+		name    string
+		args    args
+		fields  fields
+		want    assert.Want[*connect.Response[emptypb.Empty]]
+		wantErr assert.WantErr
+	}{
+		{
+			name: "permission denied",
+			args: args{
+				req: &orchestrator.RemoveCatalogMetricConfigurationRequest{
+					CatalogId: orchestratortest.MockCatalogId1,
+					MetricId:  orchestratortest.MockMetricIdDefault,
+				},
+			},
+			fields: fields{
+				db:    persistencetest.NewInMemoryDB(t, types, joinTables),
+				authz: &denyAuthorizationStrategy{},
+			},
+			want: assert.Nil[*connect.Response[emptypb.Empty]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodePermissionDenied)
+			},
+		},
+		{
+			name: "not found",
+			args: args{
+				req: &orchestrator.RemoveCatalogMetricConfigurationRequest{
+					CatalogId: orchestratortest.MockCatalogId1,
+					MetricId:  orchestratortest.MockMetricIdDefault,
+				},
+			},
+			fields: fields{
+				db:    persistencetest.NewInMemoryDB(t, types, joinTables),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			want: assert.Nil[*connect.Response[emptypb.Empty]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeNotFound)
+			},
+		},
+		{
+			name: "published catalog",
+			args: args{
+				req: &orchestrator.RemoveCatalogMetricConfigurationRequest{
+					CatalogId: orchestratortest.MockCatalogId1,
+					MetricId:  orchestratortest.MockMetricIdDefault,
+				},
+			},
+			fields: fields{
+				db: persistencetest.NewInMemoryDB(t, types, joinTables, func(d persistence.DB) {
+					assert.NoError(t, d.Create(&orchestrator.Catalog{
+						Id:     orchestratortest.MockCatalogId1,
+						Name:   orchestratortest.MockCatalogName1,
+						Status: orchestrator.CatalogStatus_CATALOG_STATUS_PUBLISHED,
+					}))
+					assert.NoError(t, d.Create(orchestratortest.MockCatalogMetricConfiguration1))
+				}),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			want: assert.Nil[*connect.Response[emptypb.Empty]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeFailedPrecondition)
+			},
+		},
+		{
+			name: "happy path",
+			args: args{
+				req: &orchestrator.RemoveCatalogMetricConfigurationRequest{
+					CatalogId: orchestratortest.MockCatalogId1,
+					MetricId:  orchestratortest.MockMetricIdDefault,
+				},
+			},
+			fields: fields{
+				db: persistencetest.NewInMemoryDB(t, types, joinTables, func(d persistence.DB) {
+					assert.NoError(t, d.Create(orchestratortest.MockCatalog1))
+					assert.NoError(t, d.Create(orchestratortest.MockCatalogMetricConfiguration1))
+				}),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			want: func(t *testing.T, got *connect.Response[emptypb.Empty], args ...any) bool {
+				return assert.NotNil(t, got.Msg)
+			},
+			wantErr: assert.NoError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &Service{
+				db:    tt.fields.db,
+				authz: tt.fields.authz,
+			}
+			res, err := svc.RemoveCatalogMetricConfiguration(context.Background(), connect.NewRequest(tt.args.req))
+			tt.want(t, res)
+			tt.wantErr(t, err)
+		})
+	}
+}
+
 func TestService_loadMetrics(t *testing.T) {
 	type fields struct {
 		db  persistence.DB
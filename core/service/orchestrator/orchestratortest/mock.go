@@ -73,6 +73,9 @@ const (
 	MockControl31SubControlShortName1 = "subcontrol-3-1-1"
 	MockCompliantComment              = "Resource is compliant"
 	MockNotCompliantComment           = "Resource is not compliant"
+	MockOwner1                        = "alice@example.com"
+	MockTeam1                         = "team-storage"
+	MockEnvironment1                  = "prod"
 	MockControlId1                    = "00000000-0000-0000-0005-000000000001"
 	MockControlId2                    = "00000000-0000-0000-0005-000000000002"
 	MockControlName1                  = "Mock Control 1"
@@ -129,11 +132,13 @@ const (
 var (
 	// Mock Metrics
 	MockMetric1 = &assessment.Metric{
-		Id:          MockMetricId1,
-		Name:        MockMetricName1,
-		Description: MockMetricDescription1,
-		Version:     MockDefaultVersion,
-		Category:    MockTestCategory,
+		Id:             MockMetricId1,
+		Name:           MockMetricName1,
+		Description:    MockMetricDescription1,
+		Version:        MockDefaultVersion,
+		Category:       MockTestCategory,
+		ResourceTypes:  []string{"VirtualMachine"},
+		EvidenceFields: []string{"bootLogging.enabled"},
 	}
 	MockMetric2 = &assessment.Metric{
 		Id:          MockMetricId2,
@@ -212,6 +217,18 @@ var (
 		TargetValue: structpb.NewBoolValue(true),
 		IsDefault:   true,
 	}
+	MockCatalogMetricConfiguration1 = &assessment.CatalogMetricConfiguration{
+		CatalogId:   MockCatalogId1,
+		MetricId:    MockMetricIdDefault,
+		Operator:    ">=",
+		TargetValue: structpb.NewNumberValue(256),
+	}
+	MockCatalogMetricConfiguration2 = &assessment.CatalogMetricConfiguration{
+		CatalogId:   MockCatalogId2,
+		MetricId:    MockMetricIdDefault,
+		Operator:    ">=",
+		TargetValue: structpb.NewNumberValue(128),
+	}
 
 	// Mock Targets of Evaluation
 	MockTargetOfEvaluation1 = &orchestrator.TargetOfEvaluation{
@@ -524,6 +541,9 @@ var (
 				EvidenceRecordedAt: timestamppb.Now(),
 			},
 		},
+		Owner:       new(MockOwner1),
+		Team:        new(MockTeam1),
+		Environment: new(MockEnvironment1),
 	}
 
 	// Mock Assessment Results for Store tests
@@ -0,0 +1,375 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package orchestrator
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"confirmate.io/core/api/assessment"
+	"confirmate.io/core/api/evaluation"
+	"confirmate.io/core/api/orchestrator"
+	"confirmate.io/core/persistence"
+	"confirmate.io/core/service"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+)
+
+const (
+	// OSCALVersion is the version of the OSCAL model the exported assessment results conform to.
+	OSCALVersion = "1.1.2"
+
+	// oscalNamespace is the namespace of Confirmate specific OSCAL properties.
+	oscalNamespace = "https://confirmate.io/ns/oscal"
+)
+
+// ExportOSCAL exports the latest evaluation results of an audit scope together with the assessment results they are
+// based on in the OSCAL Assessment Results model. Each evaluation result is mapped to an OSCAL finding, each assessment
+// result to an OSCAL observation.
+func (svc *Service) ExportOSCAL(
+	ctx context.Context,
+	req *connect.Request[orchestrator.ExportOSCALRequest],
+) (res *connect.Response[orchestrator.ExportOSCALResponse], err error) {
+	var (
+		allowed     bool
+		scope       orchestrator.AuditScope
+		catalog     orchestrator.Catalog
+		evals       []*evaluation.EvaluationResult
+		controls    []*orchestrator.Control
+		assessments []*assessment.AssessmentResult
+		controlIds  []string
+		resultIds   []string
+		b           []byte
+	)
+
+	// Validate the request
+	if err = service.Validate(req); err != nil {
+		return nil, err
+	}
+
+	// Check access via the configured auth strategy
+	allowed, _, err = CheckAccess(ctx, svc.authz, svc, orchestrator.RequestType_REQUEST_TYPE_GET, req.Msg.GetAuditScopeId(), orchestrator.ObjectType_OBJECT_TYPE_AUDIT_SCOPE)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if !allowed {
+		return nil, service.ErrPermissionDenied
+	}
+
+	err = svc.db.Get(&scope, persistence.WithoutPreload(), "id = ?", req.Msg.GetAuditScopeId())
+	if err = service.HandleDatabaseError(err, service.ErrNotFound("audit scope")); err != nil {
+		return nil, err
+	}
+
+	err = svc.db.Get(&catalog, persistence.WithoutPreload(), "id = ?", scope.GetCatalogId())
+	if err = service.HandleDatabaseError(err, service.ErrNotFound("catalog")); err != nil {
+		return nil, err
+	}
+
+	// Retrieve only the latest evaluation result per control of the audit scope
+	evals, err = latestEvaluationResults(svc.db, []string{"control_catalog_id", "control_id"},
+		func(r *evaluation.EvaluationResult) string {
+			return r.GetControlCatalogId() + "/" + r.GetControlId()
+		}, "WHERE audit_scope_id = ?", scope.GetId())
+	if err = service.HandleDatabaseError(err); err != nil {
+		return nil, err
+	}
+
+	for _, eval := range evals {
+		controlIds = append(controlIds, eval.GetControlId())
+		resultIds = append(resultIds, eval.GetAssessmentResultIds()...)
+	}
+	slices.Sort(resultIds)
+	resultIds = slices.Compact(resultIds)
+
+	// Retrieve the controls, so that we can refer to them by their catalog-local identifier
+	if len(controlIds) > 0 {
+		err = svc.db.List(&controls, "", true, 0, -1, persistence.WithoutPreload(), "id IN ?", controlIds)
+		if err = service.HandleDatabaseError(err); err != nil {
+			return nil, err
+		}
+	}
+
+	// Retrieve the assessment results the evaluation results are based on
+	if len(resultIds) > 0 {
+		err = svc.db.List(&assessments, "created_at", true, 0, -1, "id IN ?", resultIds)
+		if err = service.HandleDatabaseError(err); err != nil {
+			return nil, err
+		}
+	}
+
+	b, err = json.MarshalIndent(newOSCALAssessmentResults(&scope, &catalog, evals, controls, assessments, time.Now()), "", "  ")
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("could not marshal OSCAL assessment results: %w", err))
+	}
+
+	res = connect.NewResponse(&orchestrator.ExportOSCALResponse{
+		AssessmentResults: b,
+	})
+	return
+}
+
+// oscalDocument is the root of an OSCAL Assessment Results document.
+type oscalDocument struct {
+	AssessmentResults oscalAssessmentResults `json:"assessment-results"`
+}
+
+// oscalAssessmentResults represents the OSCAL assessment-results model. We only populate the parts that can be
+// derived from the data of the orchestrator.
+type oscalAssessmentResults struct {
+	UUID     string        `json:"uuid"`
+	Metadata oscalMetadata `json:"metadata"`
+	ImportAP oscalImportAP `json:"import-ap"`
+	Results  []oscalResult `json:"results"`
+}
+
+type oscalMetadata struct {
+	Title        string    `json:"title"`
+	LastModified time.Time `json:"last-modified"`
+	Version      string    `json:"version"`
+	OSCALVersion string    `json:"oscal-version"`
+}
+
+type oscalImportAP struct {
+	Href string `json:"href"`
+}
+
+type oscalResult struct {
+	UUID             string                `json:"uuid"`
+	Title            string                `json:"title"`
+	Description      string                `json:"description"`
+	Start            time.Time             `json:"start"`
+	Props            []oscalProperty       `json:"props,omitempty"`
+	ReviewedControls oscalReviewedControls `json:"reviewed-controls"`
+	Observations     []oscalObservation    `json:"observations,omitempty"`
+	Findings         []oscalFinding        `json:"findings,omitempty"`
+}
+
+type oscalProperty struct {
+	Name  string `json:"name"`
+	NS    string `json:"ns,omitempty"`
+	Value string `json:"value"`
+}
+
+type oscalReviewedControls struct {
+	ControlSelections []oscalControlSelection `json:"control-selections"`
+}
+
+type oscalControlSelection struct {
+	IncludeAll      *struct{}            `json:"include-all,omitempty"`
+	IncludeControls []oscalSelectControl `json:"include-controls,omitempty"`
+}
+
+type oscalSelectControl struct {
+	ControlID string `json:"control-id"`
+}
+
+type oscalObservation struct {
+	UUID        string          `json:"uuid"`
+	Title       string          `json:"title,omitempty"`
+	Description string          `json:"description"`
+	Props       []oscalProperty `json:"props,omitempty"`
+	Methods     []string        `json:"methods"`
+	Subjects    []oscalSubject  `json:"subjects,omitempty"`
+	Collected   time.Time       `json:"collected"`
+}
+
+type oscalSubject struct {
+	SubjectUUID string `json:"subject-uuid"`
+	Type        string `json:"type"`
+	Title       string `json:"title,omitempty"`
+}
+
+type oscalFinding struct {
+	UUID                string                    `json:"uuid"`
+	Title               string                    `json:"title"`
+	Description         string                    `json:"description"`
+	Props               []oscalProperty           `json:"props,omitempty"`
+	Target              oscalFindingTarget        `json:"target"`
+	RelatedObservations []oscalRelatedObservation `json:"related-observations,omitempty"`
+}
+
+type oscalFindingTarget struct {
+	Type     string            `json:"type"`
+	TargetID string            `json:"target-id"`
+	Status   oscalTargetStatus `json:"status"`
+}
+
+type oscalTargetStatus struct {
+	State  string `json:"state"`
+	Reason string `json:"reason,omitempty"`
+}
+
+type oscalRelatedObservation struct {
+	ObservationUUID string `json:"observation-uuid"`
+}
+
+// newOSCALAssessmentResults converts the given evaluation and assessment results of an audit scope into an OSCAL
+// assessment results document.
+func newOSCALAssessmentResults(
+	scope *orchestrator.AuditScope,
+	catalog *orchestrator.Catalog,
+	evals []*evaluation.EvaluationResult,
+	controls []*orchestrator.Control,
+	assessments []*assessment.AssessmentResult,
+	now time.Time,
+) (doc *oscalDocument) {
+	var (
+		controlIds   = make(map[string]string, len(controls))
+		observations = make(map[string]bool, len(assessments))
+		result       oscalResult
+		selection    oscalControlSelection
+	)
+
+	// Map our internal control IDs to their catalog-local identifiers
+	for _, control := range controls {
+		controlIds[control.GetId()] = oscalControlId(control)
+	}
+
+	result = oscalResult{
+		UUID:        uuid.NewString(),
+		Title:       fmt.Sprintf("Results of audit scope %s", scope.GetName()),
+		Description: fmt.Sprintf("Automated and manual evaluation results of the catalog %s for the target of evaluation %s.", catalog.GetName(), scope.GetTargetOfEvaluationId()),
+		Start:       now.UTC(),
+		Props: []oscalProperty{
+			{Name: "audit-scope-id", NS: oscalNamespace, Value: scope.GetId()},
+			{Name: "target-of-evaluation-id", NS: oscalNamespace, Value: scope.GetTargetOfEvaluationId()},
+			{Name: "catalog-id", NS: oscalNamespace, Value: catalog.GetId()},
+		},
+	}
+
+	for _, a := range assessments {
+		observations[a.GetId()] = true
+		result.Observations = append(result.Observations, oscalObservation{
+			UUID:        a.GetId(),
+			Title:       a.GetMetricId(),
+			Description: a.GetComplianceComment(),
+			Props: []oscalProperty{
+				{Name: "metric-id", NS: oscalNamespace, Value: a.GetMetricId()},
+				{Name: "compliant", NS: oscalNamespace, Value: fmt.Sprintf("%t", a.GetCompliant())},
+			},
+			Methods: []string{"TEST"},
+			Subjects: []oscalSubject{
+				{
+					// OSCAL requires a UUID, so we derive a stable one from the resource ID
+					SubjectUUID: uuid.NewSHA1(uuid.NameSpaceURL, []byte(a.GetResourceId())).String(),
+					Type:        "resource",
+					Title:       a.GetResourceId(),
+				},
+			},
+			Collected: a.GetCreatedAt().AsTime().UTC(),
+		})
+	}
+
+	for _, eval := range evals {
+		var (
+			controlId = controlIds[eval.GetControlId()]
+			finding   oscalFinding
+		)
+
+		if controlId == "" {
+			controlId = eval.GetControlId()
+		}
+
+		// The narrative explains automatic results, manual results are only explained by their comment
+		finding = oscalFinding{
+			UUID:        eval.GetId(),
+			Title:       fmt.Sprintf("Evaluation of control %s", controlId),
+			Description: cmp.Or(eval.GetNarrative(), eval.GetComment()),
+			Props: []oscalProperty{
+				{Name: "evaluation-status", NS: oscalNamespace, Value: eval.GetStatus().String()},
+			},
+			Target: oscalFindingTarget{
+				Type:     "objective-id",
+				TargetID: controlId,
+				Status:   oscalStatus(eval.GetStatus()),
+			},
+		}
+
+		if eval.SubStatus != nil {
+			finding.Props = append(finding.Props, oscalProperty{Name: "evaluation-sub-status", NS: oscalNamespace, Value: eval.GetSubStatus()})
+		}
+
+		// Assessment results that no longer exist, e.g., because of retention, have no observation we could refer to
+		for _, id := range eval.GetAssessmentResultIds() {
+			if !observations[id] {
+				continue
+			}
+			finding.RelatedObservations = append(finding.RelatedObservations, oscalRelatedObservation{
+				ObservationUUID: id,
+			})
+		}
+
+		result.Findings = append(result.Findings, finding)
+		selection.IncludeControls = append(selection.IncludeControls, oscalSelectControl{ControlID: controlId})
+	}
+
+	// OSCAL requires at least one control selection, we fall back to all controls if nothing was evaluated yet
+	if len(selection.IncludeControls) == 0 {
+		selection.IncludeAll = &struct{}{}
+	}
+	result.ReviewedControls.ControlSelections = []oscalControlSelection{selection}
+
+	doc = &oscalDocument{
+		AssessmentResults: oscalAssessmentResults{
+			UUID: uuid.NewString(),
+			Metadata: oscalMetadata{
+				Title:        fmt.Sprintf("Assessment results of %s", scope.GetName()),
+				LastModified: now.UTC(),
+				Version:      "1.0.0",
+				OSCALVersion: OSCALVersion,
+			},
+			ImportAP: oscalImportAP{
+				Href: fmt.Sprintf("#%s", scope.GetId()),
+			},
+			Results: []oscalResult{result},
+		},
+	}
+
+	return
+}
+
+// oscalControlId returns the OSCAL control identifier of the given control. OSCAL uses lower-case catalog-local
+// identifiers, e.g., "ops-01". If the control has no short name, the internal ID is used.
+func oscalControlId(control *orchestrator.Control) string {
+	if control.GetShortName() == "" {
+		return control.GetId()
+	}
+
+	return strings.ToLower(control.GetShortName())
+}
+
+// oscalStatus maps an [evaluation.EvaluationStatus] to the status of an OSCAL finding target. OSCAL only knows the
+// states "satisfied" and "not-satisfied", pending and erroneous evaluations are therefore reported as not satisfied
+// with the reason "other".
+func oscalStatus(status evaluation.EvaluationStatus) oscalTargetStatus {
+	switch status {
+	case evaluation.EvaluationStatus_EVALUATION_STATUS_COMPLIANT,
+		evaluation.EvaluationStatus_EVALUATION_STATUS_COMPLIANT_MANUALLY:
+		return oscalTargetStatus{State: "satisfied", Reason: "pass"}
+	case evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT,
+		evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT_MANUALLY:
+		return oscalTargetStatus{State: "not-satisfied", Reason: "fail"}
+	default:
+		return oscalTargetStatus{State: "not-satisfied", Reason: "other"}
+	}
+}
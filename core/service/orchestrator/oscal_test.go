@@ -0,0 +1,282 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package orchestrator
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"confirmate.io/core/api/evaluation"
+	"confirmate.io/core/api/orchestrator"
+	"confirmate.io/core/auth"
+	"confirmate.io/core/persistence"
+	"confirmate.io/core/persistence/persistencetest"
+	"confirmate.io/core/service"
+	"confirmate.io/core/service/orchestrator/orchestratortest"
+	"confirmate.io/core/util/assert"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestService_ExportOSCAL(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		req *orchestrator.ExportOSCALRequest
+	}
+	type fields struct {
+		db    persistence.DB
+		authz service.AuthorizationStrategy
+	}
+	tests := []struct {
+		name    string
+		args    args
+		fields  fields
+		want    assert.Want[*connect.Response[orchestrator.ExportOSCALResponse]]
+		wantErr assert.WantErr
+	}{
+		{
+			name: "validation error - empty request",
+			args: args{
+				req: &orchestrator.ExportOSCALRequest{},
+			},
+			fields: fields{
+				db:    persistencetest.NewInMemoryDB(t, types, joinTables),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			want: assert.Nil[*connect.Response[orchestrator.ExportOSCALResponse]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeInvalidArgument)
+			},
+		},
+		{
+			name: "authorization failure",
+			args: args{
+				req: &orchestrator.ExportOSCALRequest{
+					AuditScopeId: orchestratortest.MockAuditScope1.Id,
+				},
+			},
+			fields: fields{
+				db:    persistencetest.NewInMemoryDB(t, types, joinTables),
+				authz: &denyAuthorizationStrategy{},
+			},
+			want: assert.Nil[*connect.Response[orchestrator.ExportOSCALResponse]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodePermissionDenied)
+			},
+		},
+		{
+			name: "permission denied: token restricted to other target of evaluation",
+			args: args{
+				ctx: auth.WithClaims(context.Background(), &auth.OAuthClaims{
+					IsAdminToken:          true,
+					TargetOfEvaluationIds: []string{orchestratortest.MockToeId2},
+				}),
+				req: &orchestrator.ExportOSCALRequest{
+					AuditScopeId: orchestratortest.MockAuditScope1.Id,
+				},
+			},
+			fields: fields{
+				db: persistencetest.NewInMemoryDB(t, types, joinTables, func(d persistence.DB) {
+					assert.NoError(t, d.Create(orchestratortest.MockAuditScope1))
+				}),
+				authz: &service.AuthorizationStrategyPermissionStore{},
+			},
+			want: assert.Nil[*connect.Response[orchestrator.ExportOSCALResponse]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodePermissionDenied)
+			},
+		},
+		{
+			name: "db error - audit scope not found",
+			args: args{
+				req: &orchestrator.ExportOSCALRequest{
+					AuditScopeId: orchestratortest.MockAuditScope1.Id,
+				},
+			},
+			fields: fields{
+				db:    persistencetest.NewInMemoryDB(t, types, joinTables),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			want: assert.Nil[*connect.Response[orchestrator.ExportOSCALResponse]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeNotFound) &&
+					assert.ErrorContains(t, err, "audit scope not found")
+			},
+		},
+		{
+			name: "happy path",
+			args: args{
+				req: &orchestrator.ExportOSCALRequest{
+					AuditScopeId: orchestratortest.MockAuditScope1.Id,
+				},
+			},
+			fields: fields{
+				db: persistencetest.NewInMemoryDB(t, types, joinTables, func(d persistence.DB) {
+					assert.NoError(t, d.Create(orchestratortest.MockCatalog1))
+					assert.NoError(t, d.Create(orchestratortest.MockAuditScope1))
+					assert.NoError(t, d.Create(orchestratortest.MockAssessmentResult1))
+					assert.NoError(t, d.Create(&evaluation.EvaluationResult{
+						Id:                   orchestratortest.MockResultId3,
+						TargetOfEvaluationId: orchestratortest.MockToeId1,
+						AuditScopeId:         orchestratortest.MockScopeId1,
+						ControlId:            orchestratortest.MockControlId1,
+						ControlCatalogId:     orchestratortest.MockCatalogId1,
+						Status:               evaluation.EvaluationStatus_EVALUATION_STATUS_COMPLIANT,
+						Timestamp:            timestamppb.Now(),
+						AssessmentResultIds:  []string{orchestratortest.MockResultId1},
+					}))
+				}),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			want: func(t *testing.T, got *connect.Response[orchestrator.ExportOSCALResponse], args ...any) bool {
+				var doc oscalDocument
+
+				if !assert.NoError(t, json.Unmarshal(got.Msg.GetAssessmentResults(), &doc)) {
+					return false
+				}
+
+				assert.Equal(t, OSCALVersion, doc.AssessmentResults.Metadata.OSCALVersion)
+				if !assert.Equal(t, 1, len(doc.AssessmentResults.Results)) {
+					return false
+				}
+
+				result := doc.AssessmentResults.Results[0]
+				assert.Equal(t, 1, len(result.Observations))
+				assert.Equal(t, orchestratortest.MockResultId1, result.Observations[0].UUID)
+				if !assert.Equal(t, 1, len(result.Findings)) {
+					return false
+				}
+
+				assert.Equal(t, orchestratortest.MockControlShortName1, result.Findings[0].Target.TargetID)
+				assert.Equal(t, "satisfied", result.Findings[0].Target.Status.State)
+				return assert.Equal(t, orchestratortest.MockResultId1, result.Findings[0].RelatedObservations[0].ObservationUUID)
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "latest result per control only, without missing observations",
+			args: args{
+				req: &orchestrator.ExportOSCALRequest{
+					AuditScopeId: orchestratortest.MockAuditScope1.Id,
+				},
+			},
+			fields: fields{
+				db: persistencetest.NewInMemoryDB(t, types, joinTables, func(d persistence.DB) {
+					assert.NoError(t, d.Create(orchestratortest.MockCatalog1))
+					assert.NoError(t, d.Create(orchestratortest.MockAuditScope1))
+					assert.NoError(t, d.Create(orchestratortest.MockAssessmentResult1))
+					assert.NoError(t, d.Create(&evaluation.EvaluationResult{
+						Id:                   orchestratortest.MockResultId2,
+						TargetOfEvaluationId: orchestratortest.MockToeId1,
+						AuditScopeId:         orchestratortest.MockScopeId1,
+						ControlId:            orchestratortest.MockControlId1,
+						ControlCatalogId:     orchestratortest.MockCatalogId1,
+						Status:               evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT,
+						Timestamp:            timestamppb.New(time.Now().Add(-time.Hour)),
+						AssessmentResultIds:  []string{orchestratortest.MockResultId1},
+					}))
+					assert.NoError(t, d.Create(&evaluation.EvaluationResult{
+						Id:                   orchestratortest.MockResultId3,
+						TargetOfEvaluationId: orchestratortest.MockToeId1,
+						AuditScopeId:         orchestratortest.MockScopeId1,
+						ControlId:            orchestratortest.MockControlId1,
+						ControlCatalogId:     orchestratortest.MockCatalogId1,
+						Status:               evaluation.EvaluationStatus_EVALUATION_STATUS_COMPLIANT,
+						Timestamp:            timestamppb.Now(),
+						AssessmentResultIds:  []string{orchestratortest.MockResultId1, orchestratortest.MockResultId2},
+					}))
+				}),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			want: func(t *testing.T, got *connect.Response[orchestrator.ExportOSCALResponse], args ...any) bool {
+				var doc oscalDocument
+
+				if !assert.NoError(t, json.Unmarshal(got.Msg.GetAssessmentResults(), &doc)) {
+					return false
+				}
+
+				result := doc.AssessmentResults.Results[0]
+				if !assert.Equal(t, 1, len(result.Findings)) {
+					return false
+				}
+
+				assert.Equal(t, orchestratortest.MockResultId3, result.Findings[0].UUID)
+				return assert.Equal(t, []oscalRelatedObservation{
+					{ObservationUUID: orchestratortest.MockResultId1},
+				}, result.Findings[0].RelatedObservations)
+			},
+			wantErr: assert.NoError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &Service{
+				db:    tt.fields.db,
+				authz: tt.fields.authz,
+			}
+			ctx := tt.args.ctx
+			if ctx == nil {
+				ctx = context.Background()
+			}
+			res, err := svc.ExportOSCAL(ctx, connect.NewRequest(tt.args.req))
+			tt.want(t, res)
+			tt.wantErr(t, err)
+		})
+	}
+}
+
+func Test_oscalStatus(t *testing.T) {
+	type args struct {
+		status evaluation.EvaluationStatus
+	}
+	tests := []struct {
+		name string
+		args args
+		want oscalTargetStatus
+	}{
+		{
+			name: "compliant manually",
+			args: args{
+				status: evaluation.EvaluationStatus_EVALUATION_STATUS_COMPLIANT_MANUALLY,
+			},
+			want: oscalTargetStatus{State: "satisfied", Reason: "pass"},
+		},
+		{
+			name: "not compliant",
+			args: args{
+				status: evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT,
+			},
+			want: oscalTargetStatus{State: "not-satisfied", Reason: "fail"},
+		},
+		{
+			name: "pending",
+			args: args{
+				status: evaluation.EvaluationStatus_EVALUATION_STATUS_PENDING,
+			},
+			want: oscalTargetStatus{State: "not-satisfied", Reason: "other"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := oscalStatus(tt.args.status)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
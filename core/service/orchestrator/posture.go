@@ -0,0 +1,279 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package orchestrator
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"confirmate.io/core/api/evaluation"
+	"confirmate.io/core/api/orchestrator"
+	"confirmate.io/core/persistence"
+	"confirmate.io/core/service"
+
+	"connectrpc.com/connect"
+)
+
+// postureGroup is the number of the latest evaluation results of the top-level controls of a target of evaluation
+// that share a catalog, a category and a status.
+type postureGroup struct {
+	ControlCatalogId     string
+	CategoryName         *string
+	TargetOfEvaluationId string
+	Status               evaluation.EvaluationStatus
+	Count                int64
+}
+
+// postureKey identifies a [postureGroup] when the groups are counted in Go.
+type postureKey struct {
+	catalogId            string
+	categoryName         string
+	targetOfEvaluationId string
+	status               evaluation.EvaluationStatus
+}
+
+// GetCompliancePosture aggregates the compliance posture of all targets of evaluation the user has access to per
+// catalog and per control family. The evaluation results are aggregated by the database, so that the posture of large
+// portfolios does not have to be computed by clients.
+func (svc *Service) GetCompliancePosture(
+	ctx context.Context,
+	req *connect.Request[orchestrator.GetCompliancePostureRequest],
+) (res *connect.Response[orchestrator.CompliancePosture], err error) {
+	var (
+		query      = []string{"parent_control_id IS NULL"}
+		args       []any
+		all        bool
+		toeIds     []string
+		groups     []*postureGroup
+		catalogIds []string
+		catalogs   []*orchestrator.Catalog
+		names      = make(map[string]string)
+	)
+
+	// Validate the request
+	if err = service.Validate(req); err != nil {
+		return nil, err
+	}
+
+	if req.Msg.CatalogId != nil {
+		query = append(query, "control_catalog_id = ?")
+		args = append(args, req.Msg.GetCatalogId())
+	}
+	if req.Msg.Environment != nil {
+		query = append(query, "environment = ?")
+		args = append(args, req.Msg.GetEnvironment())
+	}
+
+	// Retrieve list of all allowed ToE IDs for the user to restrict the posture to them
+	all, toeIds = svc.authz.AllowedTargetOfEvaluations(ctx)
+	if !all && len(toeIds) == 0 {
+		return connect.NewResponse(newCompliancePosture(nil, nil)), nil
+	}
+	if !all {
+		query = append(query, "target_of_evaluation_id IN ?")
+		args = append(args, toeIds)
+	}
+
+	groups, err = compliancePostureGroups(svc.db, "WHERE "+strings.Join(query, " AND "), args...)
+	if err = service.HandleDatabaseError(err); err != nil {
+		return nil, err
+	}
+
+	// Retrieve the names of the catalogs
+	for _, g := range groups {
+		catalogIds = append(catalogIds, g.ControlCatalogId)
+	}
+	if len(catalogIds) > 0 {
+		err = svc.db.List(&catalogs, "", true, 0, -1, persistence.WithoutPreload(), "id IN ?", slices.Compact(slices.Sorted(slices.Values(catalogIds))))
+		if err = service.HandleDatabaseError(err); err != nil {
+			return nil, err
+		}
+		for _, c := range catalogs {
+			names[c.GetId()] = c.GetName()
+		}
+	}
+
+	res = connect.NewResponse(newCompliancePosture(groups, names))
+	return
+}
+
+// compliancePostureGroups counts the latest evaluation result of each control of an audit scope per catalog,
+// category, target of evaluation and status. The where clause (including the WHERE keyword) and its args filter the
+// evaluation results beforehand. If the database does not support window functions, the latest evaluation results are
+// counted in Go instead.
+func compliancePostureGroups(db persistence.DB, where string, args ...any) (groups []*postureGroup, err error) {
+	var (
+		results []*evaluation.EvaluationResult
+		index   = make(map[postureKey]*postureGroup)
+	)
+
+	if db.Capabilities().WindowFunctions {
+		err = db.Raw(&groups, fmt.Sprintf(`
+			SELECT control_catalog_id, category_name, target_of_evaluation_id, status, COUNT(*) AS count
+			FROM (
+				SELECT control_catalog_id, category_name, target_of_evaluation_id, status,
+					ROW_NUMBER() OVER (PARTITION BY audit_scope_id, control_catalog_id, control_id ORDER BY timestamp DESC) AS latest_rank
+				FROM evaluation_results
+				%s
+			) AS ranked
+			WHERE latest_rank = 1
+			GROUP BY control_catalog_id, category_name, target_of_evaluation_id, status;
+		`, where), args...)
+
+		return groups, err
+	}
+
+	results, err = latestEvaluationResults(db, []string{"audit_scope_id", "control_catalog_id", "control_id"},
+		func(r *evaluation.EvaluationResult) string {
+			return r.GetAuditScopeId() + "/" + r.GetControlCatalogId() + "/" + r.GetControlId()
+		}, where, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, r := range results {
+		key := postureKey{
+			catalogId:            r.GetControlCatalogId(),
+			categoryName:         r.GetCategoryName(),
+			targetOfEvaluationId: r.GetTargetOfEvaluationId(),
+			status:               r.GetStatus(),
+		}
+
+		group, ok := index[key]
+		if !ok {
+			group = &postureGroup{
+				ControlCatalogId:     key.catalogId,
+				CategoryName:         r.CategoryName,
+				TargetOfEvaluationId: key.targetOfEvaluationId,
+				Status:               key.status,
+			}
+			index[key] = group
+			groups = append(groups, group)
+		}
+
+		group.Count++
+	}
+
+	return groups, nil
+}
+
+// newCompliancePosture sums up the groups of evaluation results to the compliance posture of all targets of
+// evaluation, of each catalog and of each control family. Catalogs are named by names, which is keyed by the catalog
+// ID.
+func newCompliancePosture(groups []*postureGroup, names map[string]string) (posture *orchestrator.CompliancePosture) {
+	var (
+		toes        = make(map[string]struct{})
+		catalogs    = make(map[string]*orchestrator.CatalogPosture)
+		catalogToes = make(map[string]map[string]struct{})
+		families    = make(map[[2]string]*orchestrator.ControlFamilyPosture)
+		familyToes  = make(map[[2]string]map[string]struct{})
+	)
+
+	posture = &orchestrator.CompliancePosture{
+		Counts:   &orchestrator.ComplianceCounts{},
+		Catalogs: []*orchestrator.CatalogPosture{},
+	}
+
+	for _, g := range groups {
+		var category string
+		if g.CategoryName != nil {
+			category = *g.CategoryName
+		}
+
+		catalog, ok := catalogs[g.ControlCatalogId]
+		if !ok {
+			catalog = &orchestrator.CatalogPosture{
+				CatalogId:   g.ControlCatalogId,
+				CatalogName: names[g.ControlCatalogId],
+				Counts:      &orchestrator.ComplianceCounts{},
+				Families:    []*orchestrator.ControlFamilyPosture{},
+			}
+			catalogs[g.ControlCatalogId] = catalog
+			catalogToes[g.ControlCatalogId] = make(map[string]struct{})
+			posture.Catalogs = append(posture.Catalogs, catalog)
+		}
+
+		key := [2]string{g.ControlCatalogId, category}
+		family, ok := families[key]
+		if !ok {
+			family = &orchestrator.ControlFamilyPosture{
+				CategoryName: category,
+				Counts:       &orchestrator.ComplianceCounts{},
+			}
+			families[key] = family
+			familyToes[key] = make(map[string]struct{})
+			catalog.Families = append(catalog.Families, family)
+		}
+
+		toes[g.TargetOfEvaluationId] = struct{}{}
+		catalogToes[g.ControlCatalogId][g.TargetOfEvaluationId] = struct{}{}
+		familyToes[key][g.TargetOfEvaluationId] = struct{}{}
+
+		addComplianceCount(posture.Counts, g.Status, g.Count)
+		addComplianceCount(catalog.Counts, g.Status, g.Count)
+		addComplianceCount(family.Counts, g.Status, g.Count)
+	}
+
+	posture.NumberOfTargetsOfEvaluation = int64(len(toes))
+	setComplianceRate(posture.Counts)
+
+	slices.SortFunc(posture.Catalogs, func(a, b *orchestrator.CatalogPosture) int {
+		return cmp.Compare(a.GetCatalogId(), b.GetCatalogId())
+	})
+	for _, catalog := range posture.Catalogs {
+		catalog.NumberOfTargetsOfEvaluation = int64(len(catalogToes[catalog.GetCatalogId()]))
+		setComplianceRate(catalog.Counts)
+
+		slices.SortFunc(catalog.Families, func(a, b *orchestrator.ControlFamilyPosture) int {
+			return cmp.Compare(a.GetCategoryName(), b.GetCategoryName())
+		})
+		for _, family := range catalog.Families {
+			family.NumberOfTargetsOfEvaluation = int64(len(familyToes[[2]string{catalog.GetCatalogId(), family.GetCategoryName()}]))
+			setComplianceRate(family.Counts)
+		}
+	}
+
+	return posture
+}
+
+// addComplianceCount adds n controls of the given status to the counts. Manual results are counted along with the
+// automatic ones.
+func addComplianceCount(counts *orchestrator.ComplianceCounts, status evaluation.EvaluationStatus, n int64) {
+	switch status {
+	case evaluation.EvaluationStatus_EVALUATION_STATUS_COMPLIANT,
+		evaluation.EvaluationStatus_EVALUATION_STATUS_COMPLIANT_MANUALLY:
+		counts.Compliant += n
+	case evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT,
+		evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT_MANUALLY:
+		counts.NotCompliant += n
+	case evaluation.EvaluationStatus_EVALUATION_STATUS_PENDING:
+		counts.Pending += n
+	default:
+		counts.Other += n
+	}
+}
+
+// setComplianceRate sets the share of compliant controls among all counted controls.
+func setComplianceRate(counts *orchestrator.ComplianceCounts) {
+	var total = counts.GetCompliant() + counts.GetNotCompliant() + counts.GetPending() + counts.GetOther()
+
+	if total > 0 {
+		counts.ComplianceRate = float64(counts.GetCompliant()) / float64(total)
+	}
+}
@@ -0,0 +1,223 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package orchestrator
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"confirmate.io/core/api/evaluation"
+	"confirmate.io/core/api/orchestrator"
+	"confirmate.io/core/auth"
+	"confirmate.io/core/persistence"
+	"confirmate.io/core/persistence/persistencetest"
+	"confirmate.io/core/service"
+	"confirmate.io/core/service/orchestrator/orchestratortest"
+	"confirmate.io/core/util/assert"
+
+	"connectrpc.com/connect"
+	"github.com/golang-jwt/jwt/v5"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	mockPostureCatalogId1 = "catalog-posture-1"
+	mockPostureCatalogId2 = "catalog-posture-2"
+)
+
+// mockPostureRecords returns the evaluation results of two catalogs. In the first catalog, the "OPS" family is
+// compliant in audit scope 1 and not compliant in audit scope 2, while the "IAM" family is pending in audit scope 1.
+// The second catalog only has a compliant, uncategorized control in audit scope 2.
+func mockPostureRecords() (records []any) {
+	var now = time.Now()
+
+	records = []any{
+		&orchestrator.Catalog{
+			Id:   mockPostureCatalogId1,
+			Name: "Posture Catalog",
+		},
+	}
+
+	for i, r := range []*evaluation.EvaluationResult{
+		{AuditScopeId: orchestratortest.MockScopeId1, TargetOfEvaluationId: orchestratortest.MockToeId1,
+			ControlCatalogId: mockPostureCatalogId1, ControlId: "OPS-01", CategoryName: new("OPS"),
+			Status: evaluation.EvaluationStatus_EVALUATION_STATUS_COMPLIANT},
+		// Superseded by the compliant result above
+		{AuditScopeId: orchestratortest.MockScopeId1, TargetOfEvaluationId: orchestratortest.MockToeId1,
+			ControlCatalogId: mockPostureCatalogId1, ControlId: "OPS-01", CategoryName: new("OPS"),
+			Status: evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT},
+		// Sub-controls are not counted
+		{AuditScopeId: orchestratortest.MockScopeId1, TargetOfEvaluationId: orchestratortest.MockToeId1,
+			ControlCatalogId: mockPostureCatalogId1, ControlId: "OPS-01.1", ParentControlId: new("OPS-01"),
+			CategoryName: new("OPS"), Status: evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT},
+		{AuditScopeId: orchestratortest.MockScopeId1, TargetOfEvaluationId: orchestratortest.MockToeId1,
+			ControlCatalogId: mockPostureCatalogId1, ControlId: "IAM-01", CategoryName: new("IAM"),
+			Status: evaluation.EvaluationStatus_EVALUATION_STATUS_PENDING},
+		{AuditScopeId: orchestratortest.MockScopeId2, TargetOfEvaluationId: orchestratortest.MockToeId2,
+			ControlCatalogId: mockPostureCatalogId1, ControlId: "OPS-01", CategoryName: new("OPS"),
+			Status: evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT_MANUALLY},
+		{AuditScopeId: orchestratortest.MockScopeId2, TargetOfEvaluationId: orchestratortest.MockToeId2,
+			ControlCatalogId: mockPostureCatalogId2, ControlId: "CTRL-01",
+			Status: evaluation.EvaluationStatus_EVALUATION_STATUS_COMPLIANT},
+	} {
+		r.Id = fmt.Sprintf("00000000-0000-0000-0007-%012d", i+1)
+		r.Timestamp = timestamppb.New(now.Add(-time.Duration(i) * time.Hour))
+		records = append(records, r)
+	}
+
+	return
+}
+
+func TestService_GetCompliancePosture(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		req *orchestrator.GetCompliancePostureRequest
+	}
+	type fields struct {
+		db    persistence.DB
+		authz service.AuthorizationStrategy
+	}
+	tests := []struct {
+		name    string
+		args    args
+		fields  fields
+		want    assert.Want[*connect.Response[orchestrator.CompliancePosture]]
+		wantErr assert.WantErr
+	}{
+		{
+			name: "happy path: all catalogs",
+			args: args{
+				ctx: context.Background(),
+				req: &orchestrator.GetCompliancePostureRequest{},
+			},
+			fields: fields{
+				db:    persistencetest.NewInMemoryDB(t, types, joinTables, createRecords(t, mockPostureRecords()...)),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			want: func(t *testing.T, got *connect.Response[orchestrator.CompliancePosture], msgAndArgs ...any) bool {
+				return assert.Equal(t, &orchestrator.CompliancePosture{
+					NumberOfTargetsOfEvaluation: 2,
+					Counts:                      &orchestrator.ComplianceCounts{Compliant: 2, NotCompliant: 1, Pending: 1, ComplianceRate: 0.5},
+					Catalogs: []*orchestrator.CatalogPosture{
+						{
+							CatalogId:                   mockPostureCatalogId1,
+							CatalogName:                 "Posture Catalog",
+							NumberOfTargetsOfEvaluation: 2,
+							Counts:                      &orchestrator.ComplianceCounts{Compliant: 1, NotCompliant: 1, Pending: 1, ComplianceRate: 1.0 / 3},
+							Families: []*orchestrator.ControlFamilyPosture{
+								{
+									CategoryName:                "IAM",
+									NumberOfTargetsOfEvaluation: 1,
+									Counts:                      &orchestrator.ComplianceCounts{Pending: 1},
+								},
+								{
+									CategoryName:                "OPS",
+									NumberOfTargetsOfEvaluation: 2,
+									Counts:                      &orchestrator.ComplianceCounts{Compliant: 1, NotCompliant: 1, ComplianceRate: 0.5},
+								},
+							},
+						},
+						{
+							CatalogId:                   mockPostureCatalogId2,
+							NumberOfTargetsOfEvaluation: 1,
+							Counts:                      &orchestrator.ComplianceCounts{Compliant: 1, ComplianceRate: 1},
+							Families: []*orchestrator.ControlFamilyPosture{
+								{
+									NumberOfTargetsOfEvaluation: 1,
+									Counts:                      &orchestrator.ComplianceCounts{Compliant: 1, ComplianceRate: 1},
+								},
+							},
+						},
+					},
+				}, got.Msg)
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "happy path: filtered by catalog",
+			args: args{
+				ctx: context.Background(),
+				req: &orchestrator.GetCompliancePostureRequest{CatalogId: new(mockPostureCatalogId2)},
+			},
+			fields: fields{
+				db:    persistencetest.NewInMemoryDB(t, types, joinTables, createRecords(t, mockPostureRecords()...)),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			want: func(t *testing.T, got *connect.Response[orchestrator.CompliancePosture], msgAndArgs ...any) bool {
+				return assert.Equal(t, int64(1), got.Msg.GetNumberOfTargetsOfEvaluation()) &&
+					assert.Equal(t, 1, len(got.Msg.Catalogs)) &&
+					assert.Equal(t, mockPostureCatalogId2, got.Msg.Catalogs[0].GetCatalogId())
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "happy path: no access to any target of evaluation",
+			args: args{
+				ctx: auth.WithClaims(context.Background(), &auth.OAuthClaims{
+					RegisteredClaims: jwt.RegisteredClaims{
+						Subject: orchestratortest.MockUserId1,
+						Issuer:  orchestratortest.MockUserIssuer1,
+					},
+				}),
+				req: &orchestrator.GetCompliancePostureRequest{},
+			},
+			fields: fields{
+				db: persistencetest.NewInMemoryDB(t, types, joinTables, createRecords(t, mockPostureRecords()...)),
+				authz: &service.AuthorizationStrategyPermissionStore{
+					Permissions: service.DBPermissionStore{
+						DB: persistencetest.NewInMemoryDB(t, types, joinTables),
+					},
+				},
+			},
+			want: func(t *testing.T, got *connect.Response[orchestrator.CompliancePosture], msgAndArgs ...any) bool {
+				return assert.Equal(t, int64(0), got.Msg.GetNumberOfTargetsOfEvaluation()) &&
+					assert.Empty(t, got.Msg.Catalogs)
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "validation error",
+			args: args{
+				ctx: context.Background(),
+				req: &orchestrator.GetCompliancePostureRequest{CatalogId: new("")},
+			},
+			fields: fields{
+				db:    persistencetest.NewInMemoryDB(t, types, joinTables, createRecords(t, mockPostureRecords()...)),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			want: assert.Nil[*connect.Response[orchestrator.CompliancePosture]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeInvalidArgument) &&
+					assert.HasFieldViolation(t, err, "catalog_id")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &Service{
+				db:    tt.fields.db,
+				authz: tt.fields.authz,
+			}
+
+			got, err := svc.GetCompliancePosture(tt.args.ctx, connect.NewRequest(tt.args.req))
+			tt.want(t, got)
+			tt.wantErr(t, err)
+		})
+	}
+}
@@ -0,0 +1,132 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package orchestrator
+
+import (
+	"context"
+
+	"confirmate.io/core/api/orchestrator"
+	"confirmate.io/core/service"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// UpdateRedactionRule creates or updates the redaction rule of a resource type. The assessment picks up the rule once
+// its cached rules expire.
+func (svc *Service) UpdateRedactionRule(
+	ctx context.Context,
+	req *connect.Request[orchestrator.UpdateRedactionRuleRequest],
+) (res *connect.Response[orchestrator.RedactionRule], err error) {
+	var (
+		rule    *orchestrator.RedactionRule
+		allowed bool
+	)
+
+	// Validate the request
+	if err = service.Validate(req); err != nil {
+		return nil, err
+	}
+
+	// Check access via the configured auth strategy
+	allowed, _, err = CheckAccess(ctx, svc.authz, svc, orchestrator.RequestType_REQUEST_TYPE_UPDATED, "", orchestrator.ObjectType_OBJECT_TYPE_REDACTION_RULE)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if !allowed {
+		return nil, service.ErrPermissionDenied
+	}
+
+	rule = &orchestrator.RedactionRule{
+		ResourceType: req.Msg.GetRule().GetResourceType(),
+		Fields:       req.Msg.GetRule().GetFields(),
+		Mode:         req.Msg.GetRule().GetMode(),
+		UpdatedAt:    timestamppb.Now(),
+	}
+
+	// Save the redaction rule
+	err = svc.db.Save(rule)
+	if err = service.HandleDatabaseError(err); err != nil {
+		return nil, err
+	}
+
+	res = connect.NewResponse(rule)
+	return
+}
+
+// ListRedactionRules lists all redaction rules.
+func (svc *Service) ListRedactionRules(
+	ctx context.Context,
+	req *connect.Request[orchestrator.ListRedactionRulesRequest],
+) (res *connect.Response[orchestrator.ListRedactionRulesResponse], err error) {
+	var (
+		rules []*orchestrator.RedactionRule
+		npt   string
+	)
+
+	// Validate the request
+	if err = service.Validate(req); err != nil {
+		return nil, err
+	}
+
+	// Set default ordering
+	if req.Msg.OrderBy == "" {
+		req.Msg.OrderBy = "resource_type"
+		req.Msg.Asc = true
+	}
+
+	rules, npt, err = service.PaginateStorage[*orchestrator.RedactionRule](req.Msg, svc.db, service.DefaultPaginationOpts)
+	if err = service.HandleDatabaseError(err); err != nil {
+		return nil, err
+	}
+
+	res = connect.NewResponse(&orchestrator.ListRedactionRulesResponse{
+		Rules:         rules,
+		NextPageToken: npt,
+	})
+	return
+}
+
+// RemoveRedactionRule removes the redaction rule of a resource type.
+func (svc *Service) RemoveRedactionRule(
+	ctx context.Context,
+	req *connect.Request[orchestrator.RemoveRedactionRuleRequest],
+) (res *connect.Response[emptypb.Empty], err error) {
+	var allowed bool
+
+	// Validate the request
+	if err = service.Validate(req); err != nil {
+		return nil, err
+	}
+
+	// Check access via the configured auth strategy
+	allowed, _, err = CheckAccess(ctx, svc.authz, svc, orchestrator.RequestType_REQUEST_TYPE_DELETED, "", orchestrator.ObjectType_OBJECT_TYPE_REDACTION_RULE)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if !allowed {
+		return nil, service.ErrPermissionDenied
+	}
+
+	err = svc.db.Delete(&orchestrator.RedactionRule{}, "resource_type = ?", req.Msg.ResourceType)
+	if err = service.HandleDatabaseError(err, service.ErrNotFound("redaction rule")); err != nil {
+		return nil, err
+	}
+
+	res = connect.NewResponse(&emptypb.Empty{})
+	return
+}
@@ -0,0 +1,321 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package orchestrator
+
+import (
+	"context"
+	"testing"
+
+	"confirmate.io/core/api/orchestrator"
+	"confirmate.io/core/auth"
+	"confirmate.io/core/persistence"
+	"confirmate.io/core/persistence/persistencetest"
+	"confirmate.io/core/service"
+	"confirmate.io/core/service/orchestrator/orchestratortest"
+	"confirmate.io/core/util/assert"
+
+	"connectrpc.com/connect"
+	"github.com/golang-jwt/jwt/v5"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+var (
+	mockRedactionRuleVirtualMachine = &orchestrator.RedactionRule{
+		ResourceType: "VirtualMachine",
+		Fields:       []string{"raw", "labels"},
+		Mode:         orchestrator.RedactionMode_REDACTION_MODE_STRIP,
+	}
+	mockRedactionRuleIdentity = &orchestrator.RedactionRule{
+		ResourceType: "Identity",
+		Fields:       []string{"name"},
+		Mode:         orchestrator.RedactionMode_REDACTION_MODE_MASK,
+	}
+)
+
+func TestService_UpdateRedactionRule(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		req *orchestrator.UpdateRedactionRuleRequest
+	}
+	type fields struct {
+		db    persistence.DB
+		authz service.AuthorizationStrategy
+	}
+	tests := []struct {
+		name    string
+		args    args
+		fields  fields
+		want    assert.Want[*connect.Response[orchestrator.RedactionRule]]
+		wantErr assert.WantErr
+		wantDB  assert.Want[persistence.DB]
+	}{
+		{
+			name: "validation error - no fields",
+			args: args{
+				req: &orchestrator.UpdateRedactionRuleRequest{
+					Rule: &orchestrator.RedactionRule{
+						ResourceType: "VirtualMachine",
+						Mode:         orchestrator.RedactionMode_REDACTION_MODE_STRIP,
+					},
+				},
+			},
+			fields: fields{
+				db:    persistencetest.NewInMemoryDB(t, types, joinTables),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			want: assert.Nil[*connect.Response[orchestrator.RedactionRule]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeInvalidArgument)
+			},
+			wantDB: assert.NotNil[persistence.DB],
+		},
+		{
+			name: "validation error - unspecified mode",
+			args: args{
+				req: &orchestrator.UpdateRedactionRuleRequest{
+					Rule: &orchestrator.RedactionRule{
+						ResourceType: "VirtualMachine",
+						Fields:       []string{"raw"},
+					},
+				},
+			},
+			fields: fields{
+				db:    persistencetest.NewInMemoryDB(t, types, joinTables),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			want: assert.Nil[*connect.Response[orchestrator.RedactionRule]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeInvalidArgument)
+			},
+			wantDB: assert.NotNil[persistence.DB],
+		},
+		{
+			name: "permission denied - non-admin user",
+			args: args{
+				ctx: auth.WithClaims(context.Background(), &auth.OAuthClaims{
+					RegisteredClaims: jwt.RegisteredClaims{
+						Subject: orchestratortest.MockUserId1,
+						Issuer:  orchestratortest.MockUserIssuer1,
+					},
+				}),
+				req: &orchestrator.UpdateRedactionRuleRequest{
+					Rule: mockRedactionRuleVirtualMachine,
+				},
+			},
+			fields: fields{
+				db: persistencetest.NewInMemoryDB(t, types, joinTables),
+				authz: &service.AuthorizationStrategyPermissionStore{
+					Permissions: service.DBPermissionStore{
+						DB: persistencetest.NewInMemoryDB(t, types, joinTables),
+					},
+				},
+			},
+			want: assert.Nil[*connect.Response[orchestrator.RedactionRule]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodePermissionDenied)
+			},
+			wantDB: assert.NotNil[persistence.DB],
+		},
+		{
+			name: "db error",
+			args: args{
+				req: &orchestrator.UpdateRedactionRuleRequest{
+					Rule: mockRedactionRuleVirtualMachine,
+				},
+			},
+			fields: fields{
+				db:    persistencetest.SaveErrorDB(t, persistence.ErrDatabase, types, joinTables),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			want: assert.Nil[*connect.Response[orchestrator.RedactionRule]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeInternal)
+			},
+			wantDB: assert.NotNil[persistence.DB],
+		},
+		{
+			name: "happy path - replaces existing rule",
+			args: args{
+				req: &orchestrator.UpdateRedactionRuleRequest{
+					Rule: &orchestrator.RedactionRule{
+						ResourceType: "VirtualMachine",
+						Fields:       []string{"bootLogging.loggingServiceIds"},
+						Mode:         orchestrator.RedactionMode_REDACTION_MODE_MASK,
+					},
+				},
+			},
+			fields: fields{
+				db: persistencetest.NewInMemoryDB(t, types, joinTables, func(d persistence.DB) {
+					assert.NoError(t, d.Create(mockRedactionRuleVirtualMachine))
+				}),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			want: func(t *testing.T, got *connect.Response[orchestrator.RedactionRule], msgAndArgs ...any) bool {
+				return assert.Equal(t, "VirtualMachine", got.Msg.ResourceType) &&
+					assert.NotNil(t, got.Msg.UpdatedAt)
+			},
+			wantErr: assert.NoError,
+			wantDB: func(t *testing.T, db persistence.DB, msgAndArgs ...any) bool {
+				var rule orchestrator.RedactionRule
+
+				err := db.Get(&rule, "resource_type = ?", "VirtualMachine")
+				return assert.NoError(t, err) &&
+					assert.Equal(t, []string{"bootLogging.loggingServiceIds"}, rule.Fields) &&
+					assert.Equal(t, orchestrator.RedactionMode_REDACTION_MODE_MASK, rule.Mode)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &Service{
+				db:    tt.fields.db,
+				authz: tt.fields.authz,
+			}
+			res, err := svc.UpdateRedactionRule(tt.args.ctx, connect.NewRequest(tt.args.req))
+			tt.want(t, res)
+			tt.wantErr(t, err)
+			tt.wantDB(t, tt.fields.db)
+		})
+	}
+}
+
+func TestService_ListRedactionRules(t *testing.T) {
+	type args struct {
+		req *orchestrator.ListRedactionRulesRequest
+	}
+	type fields struct {
+		db persistence.DB
+	}
+	tests := []struct {
+		name    string
+		args    args
+		fields  fields
+		want    assert.Want[*connect.Response[orchestrator.ListRedactionRulesResponse]]
+		wantErr assert.WantErr
+	}{
+		{
+			name: "db error",
+			args: args{
+				req: &orchestrator.ListRedactionRulesRequest{},
+			},
+			fields: fields{
+				db: persistencetest.ListErrorDB(t, persistence.ErrDatabase, types, joinTables),
+			},
+			want: assert.Nil[*connect.Response[orchestrator.ListRedactionRulesResponse]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeInternal)
+			},
+		},
+		{
+			name: "happy path - ordered by resource type",
+			args: args{
+				req: &orchestrator.ListRedactionRulesRequest{},
+			},
+			fields: fields{
+				db: persistencetest.NewInMemoryDB(t, types, joinTables, func(d persistence.DB) {
+					assert.NoError(t, d.Create(mockRedactionRuleVirtualMachine))
+					assert.NoError(t, d.Create(mockRedactionRuleIdentity))
+				}),
+			},
+			want: func(t *testing.T, got *connect.Response[orchestrator.ListRedactionRulesResponse], msgAndArgs ...any) bool {
+				return assert.Equal(t, 2, len(got.Msg.Rules)) &&
+					assert.Equal(t, "Identity", got.Msg.Rules[0].ResourceType) &&
+					assert.Equal(t, "VirtualMachine", got.Msg.Rules[1].ResourceType) &&
+					assert.Equal(t, []string{"raw", "labels"}, got.Msg.Rules[1].Fields)
+			},
+			wantErr: assert.NoError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &Service{
+				db: tt.fields.db,
+			}
+			res, err := svc.ListRedactionRules(context.Background(), connect.NewRequest(tt.args.req))
+			tt.want(t, res)
+			tt.wantErr(t, err)
+		})
+	}
+}
+
+func TestService_RemoveRedactionRule(t *testing.T) {
+	type args struct {
+		req *orchestrator.RemoveRedactionRuleRequest
+	}
+	type fields struct {
+		db    persistence.DB
+		authz service.AuthorizationStrategy
+	}
+	tests := []struct {
+		name    string
+		args    args
+		fields  fields
+		want    assert.Want[*connect.Response[emptypb.Empty]]
+		wantErr assert.WantErr
+		wantDB  assert.Want[persistence.DB]
+	}{
+		{
+			name: "not found",
+			args: args{
+				req: &orchestrator.RemoveRedactionRuleRequest{ResourceType: "VirtualMachine"},
+			},
+			fields: fields{
+				db:    persistencetest.NewInMemoryDB(t, types, joinTables),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			want: assert.Nil[*connect.Response[emptypb.Empty]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeNotFound)
+			},
+			wantDB: assert.NotNil[persistence.DB],
+		},
+		{
+			name: "happy path",
+			args: args{
+				req: &orchestrator.RemoveRedactionRuleRequest{ResourceType: "VirtualMachine"},
+			},
+			fields: fields{
+				db: persistencetest.NewInMemoryDB(t, types, joinTables, func(d persistence.DB) {
+					assert.NoError(t, d.Create(mockRedactionRuleVirtualMachine))
+					assert.NoError(t, d.Create(mockRedactionRuleIdentity))
+				}),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			want:    assert.NotNil[*connect.Response[emptypb.Empty]],
+			wantErr: assert.NoError,
+			wantDB: func(t *testing.T, db persistence.DB, msgAndArgs ...any) bool {
+				count, err := db.Count(&orchestrator.RedactionRule{})
+				return assert.NoError(t, err) &&
+					assert.Equal(t, int64(1), count)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &Service{
+				db:    tt.fields.db,
+				authz: tt.fields.authz,
+			}
+			res, err := svc.RemoveRedactionRule(context.Background(), connect.NewRequest(tt.args.req))
+			tt.want(t, res)
+			tt.wantErr(t, err)
+			tt.wantDB(t, tt.fields.db)
+		})
+	}
+}
@@ -0,0 +1,122 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package orchestrator
+
+import (
+	"cmp"
+	"crypto/sha256"
+	"encoding/binary"
+	"slices"
+
+	"confirmate.io/core/api/assessment"
+	"confirmate.io/core/api/evaluation"
+	"confirmate.io/core/api/orchestrator"
+)
+
+// sampleAssessmentResults draws a sample of at most sampling.sample_size results according to sampling.strategy. The
+// sample is deterministic: each result is ranked by a hash of the seed, its resource ID and its metric ID, so that the
+// same seed yields the same sample of the same results, independent of their order.
+func sampleAssessmentResults(results []*assessment.AssessmentResult, sampling *orchestrator.AssessmentResultSampling) (sample []*assessment.AssessmentResult) {
+	var (
+		n      = int(sampling.GetSampleSize())
+		ranked []*assessment.AssessmentResult
+	)
+
+	if len(results) <= n {
+		return results
+	}
+
+	ranked = slices.Clone(results)
+	slices.SortStableFunc(ranked, func(a, b *assessment.AssessmentResult) int {
+		return cmp.Compare(sampleRank(sampling.GetSeed(), a), sampleRank(sampling.GetSeed(), b))
+	})
+
+	switch sampling.GetStrategy() {
+	case evaluation.SamplingStrategy_SAMPLING_STRATEGY_STRATIFIED:
+		sample = sampleStratified(ranked, n)
+	case evaluation.SamplingStrategy_SAMPLING_STRATEGY_NON_COMPLIANT_FIRST:
+		// The ranked results are partitioned stably, so that both the non-compliant and the compliant ones keep their
+		// random order
+		slices.SortStableFunc(ranked, func(a, b *assessment.AssessmentResult) int {
+			return compareBool(a.GetCompliant(), b.GetCompliant())
+		})
+		sample = ranked[:n]
+	default:
+		sample = ranked[:n]
+	}
+
+	return sample
+}
+
+// sampleStratified takes the first results of each metric of the ranked results, in proportion to the share of the
+// metric, but at least one of each metric. The remainder of n is filled with the highest ranked results that are not
+// yet part of the sample.
+func sampleStratified(ranked []*assessment.AssessmentResult, n int) (sample []*assessment.AssessmentResult) {
+	var (
+		byMetric = make(map[string][]*assessment.AssessmentResult)
+		metrics  []string
+		taken    = make(map[*assessment.AssessmentResult]bool)
+	)
+
+	for _, r := range ranked {
+		if _, ok := byMetric[r.MetricId]; !ok {
+			metrics = append(metrics, r.MetricId)
+		}
+		byMetric[r.MetricId] = append(byMetric[r.MetricId], r)
+	}
+
+	for _, metricId := range metrics {
+		var quota = max(1, len(byMetric[metricId])*n/len(ranked))
+
+		for _, r := range byMetric[metricId][:min(quota, len(byMetric[metricId]))] {
+			if len(sample) == n {
+				break
+			}
+			sample = append(sample, r)
+			taken[r] = true
+		}
+	}
+
+	for _, r := range ranked {
+		if len(sample) == n {
+			break
+		}
+		if !taken[r] {
+			sample = append(sample, r)
+		}
+	}
+
+	return sample
+}
+
+// sampleRank returns the rank of a result within a sample drawn with the given seed.
+func sampleRank(seed string, r *assessment.AssessmentResult) uint64 {
+	var sum = sha256.Sum256([]byte(seed + "\x00" + r.ResourceId + "\x00" + r.MetricId))
+
+	return binary.BigEndian.Uint64(sum[:8])
+}
+
+// compareBool compares two bool values for sorting, ordering false before true.
+func compareBool(a, b bool) int {
+	switch {
+	case a == b:
+		return 0
+	case !a:
+		return -1
+	default:
+		return 1
+	}
+}
@@ -0,0 +1,121 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package orchestrator
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+
+	"confirmate.io/core/api/assessment"
+	"confirmate.io/core/api/evaluation"
+	"confirmate.io/core/api/orchestrator"
+	"confirmate.io/core/util/assert"
+)
+
+func Test_sampleAssessmentResults(t *testing.T) {
+	var results []*assessment.AssessmentResult
+
+	// 90 results of metric-1 and 10 results of metric-2, of which every tenth is not compliant
+	for i := range 100 {
+		var metricId = "metric-1"
+		if i >= 90 {
+			metricId = "metric-2"
+		}
+		results = append(results, &assessment.AssessmentResult{
+			Id:         fmt.Sprintf("result-%d", i),
+			ResourceId: fmt.Sprintf("resource-%d", i),
+			MetricId:   metricId,
+			Compliant:  i%10 != 0,
+		})
+	}
+
+	type args struct {
+		results  []*assessment.AssessmentResult
+		sampling *orchestrator.AssessmentResultSampling
+	}
+	tests := []struct {
+		name string
+		args args
+		want assert.Want[[]*assessment.AssessmentResult]
+	}{
+		{
+			name: "fewer results than sample size",
+			args: args{
+				results:  results[:5],
+				sampling: &orchestrator.AssessmentResultSampling{Strategy: evaluation.SamplingStrategy_SAMPLING_STRATEGY_RANDOM, SampleSize: 10},
+			},
+			want: func(t *testing.T, got []*assessment.AssessmentResult, args ...any) bool {
+				return assert.Equal(t, results[:5], got)
+			},
+		},
+		{
+			name: "random",
+			args: args{
+				results:  results,
+				sampling: &orchestrator.AssessmentResultSampling{Strategy: evaluation.SamplingStrategy_SAMPLING_STRATEGY_RANDOM, SampleSize: 10, Seed: "seed"},
+			},
+			want: func(t *testing.T, got []*assessment.AssessmentResult, args ...any) bool {
+				// The same seed yields the same sample, independent of the order of the results
+				var reversed = slices.Clone(results)
+				slices.Reverse(reversed)
+
+				return assert.Equal(t, 10, len(got)) &&
+					assert.Equal(t, sampleAssessmentResults(reversed, args[0].(*orchestrator.AssessmentResultSampling)), got)
+			},
+		},
+		{
+			name: "stratified",
+			args: args{
+				results:  results,
+				sampling: &orchestrator.AssessmentResultSampling{Strategy: evaluation.SamplingStrategy_SAMPLING_STRATEGY_STRATIFIED, SampleSize: 5, Seed: "seed"},
+			},
+			want: func(t *testing.T, got []*assessment.AssessmentResult, args ...any) bool {
+				var metrics = make(map[string]int)
+				for _, r := range got {
+					metrics[r.MetricId]++
+				}
+
+				// metric-2 would get less than one result in proportion, but is represented at least once
+				return assert.Equal(t, 5, len(got)) &&
+					assert.Equal(t, map[string]int{"metric-1": 4, "metric-2": 1}, metrics)
+			},
+		},
+		{
+			name: "non-compliant first",
+			args: args{
+				results:  results,
+				sampling: &orchestrator.AssessmentResultSampling{Strategy: evaluation.SamplingStrategy_SAMPLING_STRATEGY_NON_COMPLIANT_FIRST, SampleSize: 15, Seed: "seed"},
+			},
+			want: func(t *testing.T, got []*assessment.AssessmentResult, args ...any) bool {
+				var nonCompliant int
+				for _, r := range got {
+					if !r.Compliant {
+						nonCompliant++
+					}
+				}
+
+				return assert.Equal(t, 15, len(got)) && assert.Equal(t, 10, nonCompliant)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.want(t, sampleAssessmentResults(tt.args.results, tt.args.sampling), tt.args.sampling)
+		})
+	}
+}
@@ -0,0 +1,240 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package orchestrator
+
+import (
+	"context"
+
+	"confirmate.io/core/api/orchestrator"
+	"confirmate.io/core/service"
+
+	"buf.build/go/protovalidate"
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// CreateSavedView creates a saved view owned by the calling user. Since a view only stores a filter and the evaluation
+// results are still subject to the access checks of [Service.ListEvaluationResults], every authenticated user may
+// create views.
+func (svc *Service) CreateSavedView(
+	ctx context.Context,
+	req *connect.Request[orchestrator.CreateSavedViewRequest],
+) (res *connect.Response[orchestrator.SavedView], err error) {
+	var (
+		view *orchestrator.SavedView
+		now  = timestamppb.Now()
+	)
+
+	// Validate the request. The ID of the view is generated by us.
+	if err = service.Validate(req, protovalidate.WithFilter(service.IgnoreIDFilter)); err != nil {
+		return nil, err
+	}
+
+	view = &orchestrator.SavedView{
+		Id:          uuid.NewString(),
+		Name:        req.Msg.SavedView.GetName(),
+		Description: req.Msg.SavedView.Description,
+		Filter:      req.Msg.SavedView.GetFilter(),
+		Shared:      req.Msg.SavedView.GetShared(),
+		OwnerId:     actorFromContext(ctx),
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+
+	err = svc.db.Create(view)
+	if err = service.HandleDatabaseError(err); err != nil {
+		return nil, err
+	}
+
+	res = connect.NewResponse(view)
+	return
+}
+
+// GetSavedView retrieves a saved view of the calling user or a view shared by another user.
+func (svc *Service) GetSavedView(
+	ctx context.Context,
+	req *connect.Request[orchestrator.GetSavedViewRequest],
+) (res *connect.Response[orchestrator.SavedView], err error) {
+	var view *orchestrator.SavedView
+
+	// Validate the request
+	if err = service.Validate(req); err != nil {
+		return nil, err
+	}
+
+	view, err = svc.visibleSavedView(ctx, req.Msg.GetSavedViewId())
+	if err != nil {
+		return nil, err
+	}
+
+	res = connect.NewResponse(view)
+	return
+}
+
+// ListSavedViews lists the saved views of the calling user and, unless requested otherwise, the views shared by other
+// users. Unless requested otherwise, the views are ordered by their name.
+func (svc *Service) ListSavedViews(
+	ctx context.Context,
+	req *connect.Request[orchestrator.ListSavedViewsRequest],
+) (res *connect.Response[orchestrator.ListSavedViewsResponse], err error) {
+	var (
+		views []*orchestrator.SavedView
+		npt   string
+		owner = actorFromContext(ctx)
+		conds = []any{"owner_id = ? OR shared = ?", owner, true}
+	)
+
+	// Validate the request
+	if err = service.Validate(req); err != nil {
+		return nil, err
+	}
+
+	if req.Msg.GetOwnedOnly() {
+		conds = []any{"owner_id = ?", owner}
+	}
+
+	// Set default ordering
+	if req.Msg.OrderBy == "" {
+		req.Msg.OrderBy = "name"
+		req.Msg.Asc = true
+	}
+
+	views, npt, err = service.PaginateStorage[*orchestrator.SavedView](req.Msg, svc.db, service.DefaultPaginationOpts,
+		conds...)
+	if err = service.HandleDatabaseError(err); err != nil {
+		return nil, err
+	}
+
+	res = connect.NewResponse(&orchestrator.ListSavedViewsResponse{
+		SavedViews:    views,
+		NextPageToken: npt,
+	})
+	return
+}
+
+// UpdateSavedView updates a saved view of the calling user. The owner and creation time of the view are kept.
+func (svc *Service) UpdateSavedView(
+	ctx context.Context,
+	req *connect.Request[orchestrator.UpdateSavedViewRequest],
+) (res *connect.Response[orchestrator.SavedView], err error) {
+	var view *orchestrator.SavedView
+
+	// Validate the request
+	if err = service.Validate(req); err != nil {
+		return nil, err
+	}
+
+	view, err = svc.ownedSavedView(ctx, req.Msg.SavedView.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	view = &orchestrator.SavedView{
+		Id:          view.GetId(),
+		Name:        req.Msg.SavedView.GetName(),
+		Description: req.Msg.SavedView.Description,
+		Filter:      req.Msg.SavedView.GetFilter(),
+		Shared:      req.Msg.SavedView.GetShared(),
+		OwnerId:     view.GetOwnerId(),
+		CreatedAt:   view.GetCreatedAt(),
+		UpdatedAt:   timestamppb.Now(),
+	}
+
+	// Save the whole view, so that the description can be removed and the view can be unshared
+	err = svc.db.Save(view, "id = ?", view.Id)
+	if err = service.HandleDatabaseError(err); err != nil {
+		return nil, err
+	}
+
+	res = connect.NewResponse(view)
+	return
+}
+
+// RemoveSavedView removes a saved view of the calling user.
+func (svc *Service) RemoveSavedView(
+	ctx context.Context,
+	req *connect.Request[orchestrator.RemoveSavedViewRequest],
+) (res *connect.Response[emptypb.Empty], err error) {
+	// Validate the request
+	if err = service.Validate(req); err != nil {
+		return nil, err
+	}
+
+	if _, err = svc.ownedSavedView(ctx, req.Msg.GetSavedViewId()); err != nil {
+		return nil, err
+	}
+
+	err = svc.db.Delete(&orchestrator.SavedView{}, "id = ?", req.Msg.GetSavedViewId())
+	if err = service.HandleDatabaseError(err, service.ErrNotFound("saved view")); err != nil {
+		return nil, err
+	}
+
+	res = connect.NewResponse(&emptypb.Empty{})
+	return
+}
+
+// visibleSavedView retrieves a saved view of the calling user or a view shared by another user. Views of other users
+// that are not shared are reported as not found, so that their existence is not revealed.
+func (svc *Service) visibleSavedView(ctx context.Context, id string) (view *orchestrator.SavedView, err error) {
+	view = new(orchestrator.SavedView)
+
+	err = svc.db.Get(view, "id = ? AND (owner_id = ? OR shared = ?)", id, actorFromContext(ctx), true)
+	if err = service.HandleDatabaseError(err, service.ErrNotFound("saved view")); err != nil {
+		return nil, err
+	}
+
+	return view, nil
+}
+
+// ownedSavedView retrieves a saved view that may be modified by the calling user, i.e., one of the views owned by the
+// user. Shared views of other users can be read but not modified.
+func (svc *Service) ownedSavedView(ctx context.Context, id string) (view *orchestrator.SavedView, err error) {
+	view, err = svc.visibleSavedView(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if view.GetOwnerId() != actorFromContext(ctx) {
+		return nil, service.ErrPermissionDenied
+	}
+
+	return view, nil
+}
+
+// applySavedView applies the filter of the saved view with the given ID to the request. The fields that are set in the
+// filter of the request take precedence over the ones of the view.
+func (svc *Service) applySavedView(ctx context.Context, req *orchestrator.ListEvaluationResultsRequest) (err error) {
+	var (
+		view   *orchestrator.SavedView
+		filter *orchestrator.ListEvaluationResultsRequest_Filter
+	)
+
+	view, err = svc.visibleSavedView(ctx, req.GetSavedViewId())
+	if err != nil {
+		return err
+	}
+
+	filter = proto.Clone(view.GetFilter()).(*orchestrator.ListEvaluationResultsRequest_Filter)
+	if req.Filter != nil {
+		proto.Merge(filter, req.Filter)
+	}
+
+	req.Filter = filter
+	return nil
+}
@@ -0,0 +1,307 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package orchestrator
+
+import (
+	"context"
+	"testing"
+
+	"confirmate.io/core/api/evaluation"
+	"confirmate.io/core/api/orchestrator"
+	"confirmate.io/core/auth"
+	"confirmate.io/core/persistence/persistencetest"
+	"confirmate.io/core/service/evaluation/evaluationtest"
+	"confirmate.io/core/service/orchestrator/orchestratortest"
+	"confirmate.io/core/util/assert"
+
+	"connectrpc.com/connect"
+	"github.com/golang-jwt/jwt/v5"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	mockSavedViewId1 = "00000000-0000-0000-0010-000000000001"
+	mockSavedViewId2 = "00000000-0000-0000-0010-000000000002"
+	mockSavedViewId3 = "00000000-0000-0000-0010-000000000003"
+)
+
+var (
+	// mockSavedViewOwner is the ID of the user that owns the saved views of [mockSavedViewRecords].
+	mockSavedViewOwner = auth.ConfirmateUserID(orchestratortest.MockUserIssuer1, orchestratortest.MockUserId1)
+	// mockSavedViewOther is the ID of another user.
+	mockSavedViewOther = auth.ConfirmateUserID(orchestratortest.MockUserIssuer1, orchestratortest.MockUserId2)
+)
+
+// contextOfUser returns a context with the claims of the given user of the mock issuer.
+func contextOfUser(subject string) context.Context {
+	return auth.WithClaims(context.Background(), &auth.OAuthClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject: subject,
+			Issuer:  orchestratortest.MockUserIssuer1,
+		},
+	})
+}
+
+// mockSavedView returns a saved view with the given ID, owner and filter.
+func mockSavedView(id string, owner string, shared bool, filter *orchestrator.ListEvaluationResultsRequest_Filter) *orchestrator.SavedView {
+	return &orchestrator.SavedView{
+		Id:        id,
+		Name:      "view " + id,
+		Filter:    filter,
+		Shared:    shared,
+		OwnerId:   owner,
+		CreatedAt: timestamppb.Now(),
+		UpdatedAt: timestamppb.Now(),
+	}
+}
+
+// mockSavedViewRecords returns a private and a shared view of the owner and a private view of another user.
+func mockSavedViewRecords() []any {
+	var filter = &orchestrator.ListEvaluationResultsRequest_Filter{
+		AuditScopeId: new(evaluationtest.MockAuditScopeId1),
+	}
+
+	return []any{
+		mockSavedView(mockSavedViewId1, mockSavedViewOwner, false, filter),
+		mockSavedView(mockSavedViewId2, mockSavedViewOwner, true, filter),
+		mockSavedView(mockSavedViewId3, mockSavedViewOther, false, filter),
+	}
+}
+
+func TestService_CreateSavedView(t *testing.T) {
+	tests := []struct {
+		name    string
+		req     *orchestrator.CreateSavedViewRequest
+		want    assert.Want[*connect.Response[orchestrator.SavedView]]
+		wantErr assert.WantErr
+	}{
+		{
+			name: "happy path",
+			req: &orchestrator.CreateSavedViewRequest{
+				SavedView: &orchestrator.SavedView{
+					Name: "Open findings",
+					Filter: &orchestrator.ListEvaluationResultsRequest_Filter{
+						Status:      new(evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT),
+						SubControls: new("OPS-"),
+					},
+					Shared: true,
+					// The owner is set by the server
+					OwnerId: mockSavedViewOther,
+				},
+			},
+			want: func(t *testing.T, got *connect.Response[orchestrator.SavedView], args ...any) bool {
+				return assert.NotEmpty(t, got.Msg.GetId()) &&
+					assert.Equal(t, mockSavedViewOwner, got.Msg.GetOwnerId()) &&
+					assert.Equal(t, "OPS-", got.Msg.GetFilter().GetSubControls()) &&
+					assert.NotNil(t, got.Msg.GetCreatedAt())
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "missing filter",
+			req: &orchestrator.CreateSavedViewRequest{
+				SavedView: &orchestrator.SavedView{
+					Name: "Open findings",
+				},
+			},
+			want: assert.Nil[*connect.Response[orchestrator.SavedView]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeInvalidArgument)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var db = persistencetest.NewInMemoryDB(t, types, joinTables, createRecords(t, mockSavedViewRecords()...))
+
+			svc := &Service{db: db}
+			got, gotErr := svc.CreateSavedView(contextOfUser(orchestratortest.MockUserId1), connect.NewRequest(tt.req))
+
+			tt.want(t, got)
+			tt.wantErr(t, gotErr)
+
+			// The filter is persisted together with the view
+			if got != nil {
+				stored, err := svc.GetSavedView(contextOfUser(orchestratortest.MockUserId1),
+					connect.NewRequest(&orchestrator.GetSavedViewRequest{SavedViewId: got.Msg.GetId()}))
+				assert.NoError(t, err)
+				assert.Equal(t, got.Msg.GetFilter(), stored.Msg.GetFilter())
+			}
+		})
+	}
+}
+
+func TestService_GetSavedView(t *testing.T) {
+	tests := []struct {
+		name    string
+		id      string
+		want    assert.Want[*connect.Response[orchestrator.SavedView]]
+		wantErr assert.WantErr
+	}{
+		{
+			name: "own view",
+			id:   mockSavedViewId3,
+			want: func(t *testing.T, got *connect.Response[orchestrator.SavedView], args ...any) bool {
+				return assert.Equal(t, mockSavedViewId3, got.Msg.GetId()) &&
+					assert.Equal(t, evaluationtest.MockAuditScopeId1, got.Msg.GetFilter().GetAuditScopeId())
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "shared view of another user",
+			id:   mockSavedViewId2,
+			want: func(t *testing.T, got *connect.Response[orchestrator.SavedView], args ...any) bool {
+				return assert.Equal(t, mockSavedViewOwner, got.Msg.GetOwnerId())
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "private view of another user",
+			id:   mockSavedViewId1,
+			want: assert.Nil[*connect.Response[orchestrator.SavedView]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeNotFound)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &Service{db: persistencetest.NewInMemoryDB(t, types, joinTables, createRecords(t, mockSavedViewRecords()...))}
+			got, gotErr := svc.GetSavedView(contextOfUser(orchestratortest.MockUserId2),
+				connect.NewRequest(&orchestrator.GetSavedViewRequest{SavedViewId: tt.id}))
+
+			tt.want(t, got)
+			tt.wantErr(t, gotErr)
+		})
+	}
+}
+
+func TestService_ListSavedViews(t *testing.T) {
+	tests := []struct {
+		name string
+		req  *orchestrator.ListSavedViewsRequest
+		want []string
+	}{
+		{
+			name: "own and shared views",
+			req:  &orchestrator.ListSavedViewsRequest{},
+			want: []string{mockSavedViewId2, mockSavedViewId3},
+		},
+		{
+			name: "owned only",
+			req:  &orchestrator.ListSavedViewsRequest{OwnedOnly: new(true)},
+			want: []string{mockSavedViewId3},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var ids []string
+
+			svc := &Service{db: persistencetest.NewInMemoryDB(t, types, joinTables, createRecords(t, mockSavedViewRecords()...))}
+			res, err := svc.ListSavedViews(contextOfUser(orchestratortest.MockUserId2), connect.NewRequest(tt.req))
+			assert.NoError(t, err)
+
+			for _, view := range res.Msg.SavedViews {
+				ids = append(ids, view.GetId())
+			}
+			assert.Equal(t, tt.want, ids)
+		})
+	}
+}
+
+func TestService_UpdateSavedView(t *testing.T) {
+	tests := []struct {
+		name    string
+		user    string
+		view    *orchestrator.SavedView
+		want    assert.Want[*connect.Response[orchestrator.SavedView]]
+		wantErr assert.WantErr
+	}{
+		{
+			name: "happy path",
+			user: orchestratortest.MockUserId1,
+			view: &orchestrator.SavedView{
+				Id:   mockSavedViewId2,
+				Name: "renamed",
+				Filter: &orchestrator.ListEvaluationResultsRequest_Filter{
+					CatalogId: new(evaluationtest.MockCatalogId1),
+				},
+				// The view is no longer shared
+				Shared: false,
+			},
+			want: func(t *testing.T, got *connect.Response[orchestrator.SavedView], args ...any) bool {
+				return assert.Equal(t, "renamed", got.Msg.GetName()) &&
+					assert.False(t, got.Msg.GetShared()) &&
+					assert.Equal(t, mockSavedViewOwner, got.Msg.GetOwnerId()) &&
+					assert.Nil(t, got.Msg.GetFilter().AuditScopeId)
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "shared view of another user",
+			user: orchestratortest.MockUserId2,
+			view: mockSavedView(mockSavedViewId2, "", true, &orchestrator.ListEvaluationResultsRequest_Filter{}),
+			want: assert.Nil[*connect.Response[orchestrator.SavedView]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodePermissionDenied)
+			},
+		},
+		{
+			name: "private view of another user",
+			user: orchestratortest.MockUserId2,
+			view: mockSavedView(mockSavedViewId1, "", true, &orchestrator.ListEvaluationResultsRequest_Filter{}),
+			want: assert.Nil[*connect.Response[orchestrator.SavedView]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeNotFound)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &Service{db: persistencetest.NewInMemoryDB(t, types, joinTables, createRecords(t, mockSavedViewRecords()...))}
+			got, gotErr := svc.UpdateSavedView(contextOfUser(tt.user),
+				connect.NewRequest(&orchestrator.UpdateSavedViewRequest{SavedView: tt.view}))
+
+			tt.want(t, got)
+			tt.wantErr(t, gotErr)
+
+			// The update is persisted, including the fields that were reset
+			if got != nil {
+				stored, err := svc.GetSavedView(contextOfUser(tt.user),
+					connect.NewRequest(&orchestrator.GetSavedViewRequest{SavedViewId: got.Msg.GetId()}))
+				assert.NoError(t, err)
+				assert.Equal(t, got.Msg, stored.Msg)
+			}
+		})
+	}
+}
+
+func TestService_RemoveSavedView(t *testing.T) {
+	svc := &Service{db: persistencetest.NewInMemoryDB(t, types, joinTables, createRecords(t, mockSavedViewRecords()...))}
+
+	// Shared views can only be removed by their owner
+	_, err := svc.RemoveSavedView(contextOfUser(orchestratortest.MockUserId2),
+		connect.NewRequest(&orchestrator.RemoveSavedViewRequest{SavedViewId: mockSavedViewId2}))
+	assert.IsConnectError(t, err, connect.CodePermissionDenied)
+
+	_, err = svc.RemoveSavedView(contextOfUser(orchestratortest.MockUserId1),
+		connect.NewRequest(&orchestrator.RemoveSavedViewRequest{SavedViewId: mockSavedViewId2}))
+	assert.NoError(t, err)
+
+	_, err = svc.RemoveSavedView(contextOfUser(orchestratortest.MockUserId1),
+		connect.NewRequest(&orchestrator.RemoveSavedViewRequest{SavedViewId: mockSavedViewId2}))
+	assert.IsConnectError(t, err, connect.CodeNotFound)
+}
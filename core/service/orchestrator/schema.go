@@ -0,0 +1,141 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package orchestrator
+
+import (
+	"context"
+	"fmt"
+
+	"confirmate.io/core/api/assessment"
+	"confirmate.io/core/api/evaluation"
+	"confirmate.io/core/api/orchestrator"
+	"confirmate.io/core/persistence"
+	"confirmate.io/core/service"
+
+	"connectrpc.com/connect"
+)
+
+// references contains the relationships between the orchestrator types that are not modeled as associations, but
+// only by a column that holds the ID of the related type. They are part of the exported schema, so that reporting
+// tools can follow, e.g., a target of evaluation to its audit scopes and their results.
+var references = []persistence.ColumnReference{
+	{Model: &orchestrator.UserPermission{}, Field: "UserId", References: &orchestrator.User{}},
+	{Model: &assessment.CatalogMetricConfiguration{}, Field: "CatalogId", References: &orchestrator.Catalog{}},
+	{Model: &assessment.CatalogMetricConfiguration{}, Field: "MetricId", References: &assessment.Metric{}},
+	{Model: &orchestrator.Certificate{}, Field: "TargetOfEvaluationId", References: &orchestrator.TargetOfEvaluation{}},
+	{Model: &orchestrator.Control{}, Field: "CatalogId", References: &orchestrator.Catalog{}},
+	{Model: &orchestrator.AuditScope{}, Field: "TargetOfEvaluationId", References: &orchestrator.TargetOfEvaluation{}},
+	{Model: &orchestrator.AuditScope{}, Field: "CatalogId", References: &orchestrator.Catalog{}},
+	{Model: &orchestrator.ControlInScope{}, Field: "TargetOfEvaluationId", References: &orchestrator.TargetOfEvaluation{}},
+	{Model: &orchestrator.AuditTrailEvent{}, Field: "ControlInScopeId", References: &orchestrator.ControlInScope{}},
+	{Model: &assessment.AssessmentResult{}, Field: "TargetOfEvaluationId", References: &orchestrator.TargetOfEvaluation{}},
+	{Model: &assessment.AssessmentResult{}, Field: "MetricId", References: &assessment.Metric{}},
+	{Model: &assessment.AssessmentResult{}, Field: "ToolId", References: &orchestrator.AssessmentTool{}},
+	{Model: &evaluation.EvaluationResult{}, Field: "TargetOfEvaluationId", References: &orchestrator.TargetOfEvaluation{}},
+	{Model: &evaluation.EvaluationResult{}, Field: "AuditScopeId", References: &orchestrator.AuditScope{}},
+	{Model: &evaluation.EvaluationResult{}, Field: "ControlId", References: &orchestrator.Control{}},
+	{Model: &evaluation.EvaluationResult{}, Field: "ControlCatalogId", References: &orchestrator.Catalog{}},
+	{Model: &attachmentContent{}, Field: "AttachmentId", References: &evaluation.Attachment{}},
+	{Model: &evaluation.Comment{}, Field: "ParentId", References: &evaluation.Comment{}},
+}
+
+// relationshipTypes maps the relationship types of the persistence layer to the API.
+var relationshipTypes = map[string]orchestrator.SchemaRelationshipType{
+	persistence.RelationshipHasOne:     orchestrator.SchemaRelationshipType_SCHEMA_RELATIONSHIP_TYPE_HAS_ONE,
+	persistence.RelationshipHasMany:    orchestrator.SchemaRelationshipType_SCHEMA_RELATIONSHIP_TYPE_HAS_MANY,
+	persistence.RelationshipBelongsTo:  orchestrator.SchemaRelationshipType_SCHEMA_RELATIONSHIP_TYPE_BELONGS_TO,
+	persistence.RelationshipManyToMany: orchestrator.SchemaRelationshipType_SCHEMA_RELATIONSHIP_TYPE_MANY_TO_MANY,
+	persistence.RelationshipReferences: orchestrator.SchemaRelationshipType_SCHEMA_RELATIONSHIP_TYPE_REFERENCES,
+}
+
+// GetSchema exports the persisted entities of the orchestrator and their relationships. The schema does not contain
+// any data, therefore it is available to all authenticated users.
+func (svc *Service) GetSchema(
+	ctx context.Context,
+	req *connect.Request[orchestrator.GetSchemaRequest],
+) (res *connect.Response[orchestrator.Schema], err error) {
+	var (
+		entities []persistence.EntitySchema
+		schema   *orchestrator.Schema
+	)
+
+	// Validate the request
+	if err = service.Validate(req); err != nil {
+		return nil, err
+	}
+
+	entities, err = persistence.DescribeSchema(persistence.Config{
+		Types:            types,
+		CustomJoinTables: joinTables,
+	}, references...)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("could not describe schema: %w", err))
+	}
+
+	schema = &orchestrator.Schema{}
+	for _, e := range entities {
+		schema.Entities = append(schema.Entities, toSchemaEntity(e))
+	}
+
+	res = connect.NewResponse(schema)
+	return
+}
+
+// toSchemaEntity converts the description of an entity of the persistence layer into its API representation.
+func toSchemaEntity(e persistence.EntitySchema) (entity *orchestrator.SchemaEntity) {
+	entity = &orchestrator.SchemaEntity{
+		Name:  e.Name,
+		Table: e.Table,
+	}
+
+	for _, f := range e.Fields {
+		field := &orchestrator.SchemaField{
+			Name:       f.Name,
+			Column:     f.Column,
+			DataType:   f.DataType,
+			PrimaryKey: f.PrimaryKey,
+		}
+		if f.Serializer != "" {
+			field.Serializer = new(f.Serializer)
+		}
+
+		entity.Fields = append(entity.Fields, field)
+	}
+
+	for _, r := range e.Relationships {
+		rel := &orchestrator.SchemaRelationship{
+			Name:   r.Name,
+			Type:   relationshipTypes[r.Type],
+			Entity: r.Entity,
+		}
+		if r.JoinTable != "" {
+			rel.JoinTable = new(r.JoinTable)
+		}
+
+		for _, fk := range r.ForeignKeys {
+			rel.ForeignKeys = append(rel.ForeignKeys, &orchestrator.SchemaForeignKey{
+				Table:            fk.Table,
+				Column:           fk.Column,
+				ReferencedTable:  fk.ReferencedTable,
+				ReferencedColumn: fk.ReferencedColumn,
+			})
+		}
+
+		entity.Relationships = append(entity.Relationships, rel)
+	}
+
+	return entity
+}
@@ -0,0 +1,97 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package orchestrator
+
+import (
+	"context"
+	"testing"
+
+	"confirmate.io/core/api/orchestrator"
+	"confirmate.io/core/util/assert"
+
+	"connectrpc.com/connect"
+)
+
+func TestService_GetSchema(t *testing.T) {
+	var (
+		svc = &Service{}
+		res *connect.Response[orchestrator.Schema]
+		err error
+	)
+
+	res, err = svc.GetSchema(context.Background(), connect.NewRequest(&orchestrator.GetSchemaRequest{}))
+	assert.NoError(t, err)
+	assert.Equal(t, len(types), len(res.Msg.Entities))
+
+	entities := make(map[string]*orchestrator.SchemaEntity)
+	for _, e := range res.Msg.Entities {
+		entities[e.Name] = e
+
+		for _, rel := range e.Relationships {
+			assert.NotEqual(t, orchestrator.SchemaRelationshipType_SCHEMA_RELATIONSHIP_TYPE_UNSPECIFIED, rel.Type)
+		}
+	}
+
+	// catalog → category → control → metric
+	assert.Equal(t, &orchestrator.SchemaRelationship{
+		Name:   "Categories",
+		Type:   orchestrator.SchemaRelationshipType_SCHEMA_RELATIONSHIP_TYPE_HAS_MANY,
+		Entity: "Category",
+		ForeignKeys: []*orchestrator.SchemaForeignKey{
+			{Table: "categories", Column: "catalog_id", ReferencedTable: "catalogs", ReferencedColumn: "id"},
+		},
+	}, relationship(entities["Catalog"], "Categories"))
+	assert.Equal(t, "category_controls", relationship(entities["Category"], "Controls").GetJoinTable())
+	assert.Equal(t, &orchestrator.SchemaRelationship{
+		Name:      "Metrics",
+		Type:      orchestrator.SchemaRelationshipType_SCHEMA_RELATIONSHIP_TYPE_MANY_TO_MANY,
+		Entity:    "Metric",
+		JoinTable: new("control_metrics"),
+		ForeignKeys: []*orchestrator.SchemaForeignKey{
+			{Table: "control_metrics", Column: "control_id", ReferencedTable: "controls", ReferencedColumn: "id"},
+			{Table: "control_metrics", Column: "metric_id", ReferencedTable: "metrics", ReferencedColumn: "id"},
+		},
+	}, relationship(entities["Control"], "Metrics"))
+
+	// target of evaluation → audit scope → evaluation results
+	assert.Equal(t, &orchestrator.SchemaRelationship{
+		Name:   "TargetOfEvaluationId",
+		Type:   orchestrator.SchemaRelationshipType_SCHEMA_RELATIONSHIP_TYPE_REFERENCES,
+		Entity: "TargetOfEvaluation",
+		ForeignKeys: []*orchestrator.SchemaForeignKey{
+			{Table: "audit_scopes", Column: "target_of_evaluation_id", ReferencedTable: "target_of_evaluations", ReferencedColumn: "id"},
+		},
+	}, relationship(entities["AuditScope"], "TargetOfEvaluationId"))
+	assert.Equal(t, &orchestrator.SchemaRelationship{
+		Name:   "AuditScopeId",
+		Type:   orchestrator.SchemaRelationshipType_SCHEMA_RELATIONSHIP_TYPE_REFERENCES,
+		Entity: "AuditScope",
+		ForeignKeys: []*orchestrator.SchemaForeignKey{
+			{Table: "evaluation_results", Column: "audit_scope_id", ReferencedTable: "audit_scopes", ReferencedColumn: "id"},
+		},
+	}, relationship(entities["EvaluationResult"], "AuditScopeId"))
+}
+
+// relationship returns the relationship of the entity with the given name or nil, if it does not exist.
+func relationship(e *orchestrator.SchemaEntity, name string) *orchestrator.SchemaRelationship {
+	for _, rel := range e.GetRelationships() {
+		if rel.Name == name {
+			return rel
+		}
+	}
+
+	return nil
+}
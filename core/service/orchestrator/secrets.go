@@ -0,0 +1,351 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package orchestrator
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+
+	"confirmate.io/core/api/orchestrator"
+	"confirmate.io/core/persistence"
+	"confirmate.io/core/service"
+
+	"buf.build/go/protovalidate"
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// SecretKeySize is the size of the key used to encrypt secrets (see [Config.SecretKey]).
+const SecretKeySize = 32
+
+var (
+	// ErrSecretKeyNotConfigured is returned if secrets should be encrypted or decrypted, but no [Config.SecretKey] is
+	// configured.
+	ErrSecretKeyNotConfigured = errors.New("no secret key is configured")
+)
+
+// CreateSecret creates a secret of a target of evaluation. The value is encrypted before it is stored and is never
+// returned. Since secrets are part of the configuration of the target of evaluation, this requires update access to
+// it.
+func (svc *Service) CreateSecret(
+	ctx context.Context,
+	req *connect.Request[orchestrator.CreateSecretRequest],
+) (res *connect.Response[orchestrator.Secret], err error) {
+	var (
+		secret *orchestrator.Secret
+	)
+
+	// Validate the request. The ID of the secret is generated by us.
+	if err = service.Validate(req, protovalidate.WithFilter(service.IgnoreIDFilter)); err != nil {
+		return nil, err
+	}
+
+	// Check access via the configured auth strategy
+	if err = svc.checkTargetOfEvaluationAccess(ctx, orchestrator.RequestType_REQUEST_TYPE_UPDATED, req.Msg.Secret.GetTargetOfEvaluationId()); err != nil {
+		return nil, err
+	}
+
+	// The target of evaluation must exist
+	err = svc.db.Get(&orchestrator.TargetOfEvaluation{}, persistence.WithoutPreload(), "id = ?", req.Msg.Secret.GetTargetOfEvaluationId())
+	if err = service.HandleDatabaseError(err, service.ErrNotFound("target of evaluation")); err != nil {
+		return nil, err
+	}
+
+	secret = &orchestrator.Secret{
+		Id:                   uuid.NewString(),
+		TargetOfEvaluationId: req.Msg.Secret.GetTargetOfEvaluationId(),
+		Name:                 req.Msg.Secret.GetName(),
+		Description:          req.Msg.Secret.Description,
+		CreatedBy:            actorFromContext(ctx),
+		CreatedAt:            timestamppb.Now(),
+	}
+	secret.UpdatedAt = secret.CreatedAt
+
+	secret.Value, err = encryptSecret(svc.cfg.SecretKey, secret.Id, req.Msg.Secret.GetValue())
+	if err != nil {
+		return nil, secretError(err)
+	}
+
+	err = svc.db.Create(secret)
+	if err = service.HandleDatabaseError(err); err != nil {
+		return nil, err
+	}
+
+	// The value is never returned
+	secret.Value = ""
+
+	res = connect.NewResponse(secret)
+	return
+}
+
+// ListSecrets lists the secrets of a target of evaluation without their values. Unless requested otherwise, the
+// secrets are ordered by their name.
+func (svc *Service) ListSecrets(
+	ctx context.Context,
+	req *connect.Request[orchestrator.ListSecretsRequest],
+) (res *connect.Response[orchestrator.ListSecretsResponse], err error) {
+	var (
+		secrets []*orchestrator.Secret
+		npt     string
+	)
+
+	// Validate the request
+	if err = service.Validate(req); err != nil {
+		return nil, err
+	}
+
+	// Check access via the configured auth strategy
+	if err = svc.checkTargetOfEvaluationAccess(ctx, orchestrator.RequestType_REQUEST_TYPE_GET, req.Msg.GetTargetOfEvaluationId()); err != nil {
+		return nil, err
+	}
+
+	// Set default ordering
+	if req.Msg.OrderBy == "" {
+		req.Msg.OrderBy = "name"
+		req.Msg.Asc = true
+	}
+
+	secrets, npt, err = service.PaginateStorage[*orchestrator.Secret](req.Msg, svc.db, service.DefaultPaginationOpts,
+		"target_of_evaluation_id = ?", req.Msg.GetTargetOfEvaluationId())
+	if err = service.HandleDatabaseError(err); err != nil {
+		return nil, err
+	}
+
+	for _, secret := range secrets {
+		secret.Value = ""
+	}
+
+	res = connect.NewResponse(&orchestrator.ListSecretsResponse{
+		Secrets:       secrets,
+		NextPageToken: npt,
+	})
+	return
+}
+
+// UpdateSecret updates the name, description and value of a secret. If no value is given, the stored value is kept.
+// Secrets cannot be moved to another target of evaluation.
+func (svc *Service) UpdateSecret(
+	ctx context.Context,
+	req *connect.Request[orchestrator.UpdateSecretRequest],
+) (res *connect.Response[orchestrator.Secret], err error) {
+	var (
+		secret *orchestrator.Secret
+	)
+
+	// Validate the request
+	if err = service.Validate(req); err != nil {
+		return nil, err
+	}
+
+	secret, err = svc.secret(ctx, orchestrator.RequestType_REQUEST_TYPE_UPDATED, req.Msg.Secret.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	secret = &orchestrator.Secret{
+		Id:                   secret.GetId(),
+		TargetOfEvaluationId: secret.GetTargetOfEvaluationId(),
+		Name:                 req.Msg.Secret.GetName(),
+		Value:                secret.GetValue(),
+		Description:          req.Msg.Secret.Description,
+		CreatedBy:            secret.GetCreatedBy(),
+		CreatedAt:            secret.GetCreatedAt(),
+		UpdatedAt:            timestamppb.Now(),
+	}
+
+	if req.Msg.Secret.GetValue() != "" {
+		secret.Value, err = encryptSecret(svc.cfg.SecretKey, secret.Id, req.Msg.Secret.GetValue())
+		if err != nil {
+			return nil, secretError(err)
+		}
+	}
+
+	// Save the whole secret, so that the description can be removed
+	err = svc.db.Save(secret, "id = ?", secret.Id)
+	if err = service.HandleDatabaseError(err); err != nil {
+		return nil, err
+	}
+
+	// The value is never returned
+	secret.Value = ""
+
+	res = connect.NewResponse(secret)
+	return
+}
+
+// RemoveSecret removes a secret. This requires update access to its target of evaluation.
+func (svc *Service) RemoveSecret(
+	ctx context.Context,
+	req *connect.Request[orchestrator.RemoveSecretRequest],
+) (res *connect.Response[emptypb.Empty], err error) {
+	// Validate the request
+	if err = service.Validate(req); err != nil {
+		return nil, err
+	}
+
+	if _, err = svc.secret(ctx, orchestrator.RequestType_REQUEST_TYPE_UPDATED, req.Msg.GetSecretId()); err != nil {
+		return nil, err
+	}
+
+	err = svc.db.Delete(&orchestrator.Secret{}, "id = ?", req.Msg.GetSecretId())
+	if err = service.HandleDatabaseError(err, service.ErrNotFound("secret")); err != nil {
+		return nil, err
+	}
+
+	res = connect.NewResponse(&emptypb.Empty{})
+	return
+}
+
+// AccessSecret returns the decrypted value of a secret of a target of evaluation by its name. Since the values are
+// credentials, this requires update access (contributor permission) to the target of evaluation instead of the read
+// access that suffices for [Service.ListSecrets]. Collectors should use tokens that are restricted to the targets of
+// evaluation they collect evidences for.
+func (svc *Service) AccessSecret(
+	ctx context.Context,
+	req *connect.Request[orchestrator.AccessSecretRequest],
+) (res *connect.Response[orchestrator.SecretValue], err error) {
+	var (
+		secret orchestrator.Secret
+		value  string
+	)
+
+	// Validate the request
+	if err = service.Validate(req); err != nil {
+		return nil, err
+	}
+
+	// Check access via the configured auth strategy. Readers may list the secrets, but not access their values.
+	if err = svc.checkTargetOfEvaluationAccess(ctx, orchestrator.RequestType_REQUEST_TYPE_UPDATED, req.Msg.GetTargetOfEvaluationId()); err != nil {
+		return nil, err
+	}
+
+	err = svc.db.Get(&secret, "target_of_evaluation_id = ? AND name = ?", req.Msg.GetTargetOfEvaluationId(), req.Msg.GetName())
+	if err = service.HandleDatabaseError(err, service.ErrNotFound("secret")); err != nil {
+		return nil, err
+	}
+
+	value, err = decryptSecret(svc.cfg.SecretKey, secret.Id, secret.Value)
+	if err != nil {
+		return nil, secretError(err)
+	}
+
+	res = connect.NewResponse(&orchestrator.SecretValue{
+		SecretId: secret.Id,
+		Name:     secret.Name,
+		Value:    value,
+	})
+	return
+}
+
+// secret retrieves a secret and checks whether the current user may perform the given request type on its target of
+// evaluation.
+func (svc *Service) secret(ctx context.Context, reqType orchestrator.RequestType, id string) (secret *orchestrator.Secret, err error) {
+	secret = new(orchestrator.Secret)
+
+	err = svc.db.Get(secret, "id = ?", id)
+	if err = service.HandleDatabaseError(err, service.ErrNotFound("secret")); err != nil {
+		return nil, err
+	}
+
+	if err = svc.checkTargetOfEvaluationAccess(ctx, reqType, secret.GetTargetOfEvaluationId()); err != nil {
+		return nil, err
+	}
+
+	return secret, nil
+}
+
+// encryptSecret encrypts the value of the secret with the given ID using AES-256-GCM and returns the base64-encoded
+// nonce and ciphertext. The ID is used as additional data, so that an encrypted value cannot be copied to another
+// secret.
+func encryptSecret(key []byte, id string, value string) (encrypted string, err error) {
+	var (
+		aead  cipher.AEAD
+		nonce []byte
+	)
+
+	aead, err = newSecretCipher(key)
+	if err != nil {
+		return "", err
+	}
+
+	nonce = make([]byte, aead.NonceSize())
+	if _, err = rand.Read(nonce); err != nil {
+		return "", err
+	}
+
+	return base64.StdEncoding.EncodeToString(aead.Seal(nonce, nonce, []byte(value), []byte(id))), nil
+}
+
+// decryptSecret decrypts a value of the secret with the given ID that was encrypted with [encryptSecret].
+func decryptSecret(key []byte, id string, encrypted string) (value string, err error) {
+	var (
+		aead      cipher.AEAD
+		data      []byte
+		plaintext []byte
+	)
+
+	aead, err = newSecretCipher(key)
+	if err != nil {
+		return "", err
+	}
+
+	data, err = base64.StdEncoding.DecodeString(encrypted)
+	if err != nil {
+		return "", err
+	}
+	if len(data) < aead.NonceSize() {
+		return "", errors.New("encrypted secret is too short")
+	}
+
+	plaintext, err = aead.Open(nil, data[:aead.NonceSize()], data[aead.NonceSize():], []byte(id))
+	if err != nil {
+		return "", err
+	}
+
+	return string(plaintext), nil
+}
+
+// newSecretCipher returns the AES-256-GCM cipher for the given key.
+func newSecretCipher(key []byte) (aead cipher.AEAD, err error) {
+	var block cipher.Block
+
+	if len(key) == 0 {
+		return nil, ErrSecretKeyNotConfigured
+	}
+
+	block, err = aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
+
+// secretError converts an error of [encryptSecret] or [decryptSecret] into a [connect.Error]. A missing key is a
+// precondition of the deployment, while all other errors, e.g., a value encrypted with a rotated key, are internal.
+func secretError(err error) error {
+	if errors.Is(err, ErrSecretKeyNotConfigured) {
+		return connect.NewError(connect.CodeFailedPrecondition, err)
+	}
+
+	return connect.NewError(connect.CodeInternal, errors.New("could not encrypt or decrypt secret"))
+}
@@ -0,0 +1,675 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package orchestrator
+
+import (
+	"context"
+	"testing"
+
+	"confirmate.io/core/api/orchestrator"
+	"confirmate.io/core/auth"
+	"confirmate.io/core/persistence"
+	"confirmate.io/core/persistence/persistencetest"
+	"confirmate.io/core/service"
+	"confirmate.io/core/service/orchestrator/orchestratortest"
+	"confirmate.io/core/util/assert"
+
+	"connectrpc.com/connect"
+	"github.com/golang-jwt/jwt/v5"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+const (
+	mockSecretId1 = "00000000-0000-0000-0011-000000000001"
+	mockSecretId2 = "00000000-0000-0000-0011-000000000002"
+)
+
+var mockSecretKey = []byte("0123456789abcdef0123456789abcdef")
+
+// mockSecretRecords returns a target of evaluation with two secrets.
+func mockSecretRecords(t *testing.T) (records []any) {
+	records = []any{orchestratortest.MockTargetOfEvaluation1}
+
+	for id, name := range map[string]string{mockSecretId1: "aws-read-only", mockSecretId2: "github-token"} {
+		value, err := encryptSecret(mockSecretKey, id, "value of "+name)
+		assert.NoError(t, err)
+
+		records = append(records, &orchestrator.Secret{
+			Id:                   id,
+			TargetOfEvaluationId: orchestratortest.MockToeId1,
+			Name:                 name,
+			Value:                value,
+		})
+	}
+
+	return
+}
+
+func Test_encryptSecret(t *testing.T) {
+	encrypted, err := encryptSecret(mockSecretKey, mockSecretId1, "my-secret")
+	assert.NoError(t, err)
+	assert.NotEqual(t, "my-secret", encrypted)
+
+	// Encrypting the same value twice uses a different nonce
+	other, err := encryptSecret(mockSecretKey, mockSecretId1, "my-secret")
+	assert.NoError(t, err)
+	assert.NotEqual(t, encrypted, other)
+
+	value, err := decryptSecret(mockSecretKey, mockSecretId1, encrypted)
+	assert.NoError(t, err)
+	assert.Equal(t, "my-secret", value)
+
+	// The value is bound to the secret
+	_, err = decryptSecret(mockSecretKey, mockSecretId2, encrypted)
+	assert.Error(t, err)
+
+	// The value cannot be decrypted with another key
+	_, err = decryptSecret([]byte("fedcba9876543210fedcba9876543210"), mockSecretId1, encrypted)
+	assert.Error(t, err)
+
+	_, err = encryptSecret(nil, mockSecretId1, "my-secret")
+	assert.ErrorIs(t, err, ErrSecretKeyNotConfigured)
+}
+
+func TestService_CreateSecret(t *testing.T) {
+	type fields struct {
+		db    persistence.DB
+		authz service.AuthorizationStrategy
+		key   []byte
+	}
+	tests := []struct {
+		name    string
+		req     *orchestrator.CreateSecretRequest
+		fields  fields
+		want    assert.Want[*connect.Response[orchestrator.Secret]]
+		wantDB  assert.Want[persistence.DB]
+		wantErr assert.WantErr
+	}{
+		{
+			name: "happy path",
+			req: &orchestrator.CreateSecretRequest{
+				Secret: &orchestrator.Secret{
+					TargetOfEvaluationId: orchestratortest.MockToeId1,
+					Name:                 "azure-read-only",
+					Value:                "my-secret",
+					Description:          new("Used by the Azure collector"),
+				},
+			},
+			fields: fields{
+				db:    persistencetest.NewInMemoryDB(t, types, joinTables, createRecords(t, mockSecretRecords(t)...)),
+				authz: &service.AuthorizationStrategyAllowAll{},
+				key:   mockSecretKey,
+			},
+			want: func(t *testing.T, got *connect.Response[orchestrator.Secret], args ...any) bool {
+				return assert.NotEmpty(t, got.Msg.GetId()) &&
+					assert.Empty(t, got.Msg.GetValue()) &&
+					assert.NotNil(t, got.Msg.GetCreatedAt()) &&
+					assert.Equal(t, "Used by the Azure collector", got.Msg.GetDescription())
+			},
+			wantDB: func(t *testing.T, db persistence.DB, args ...any) bool {
+				var secret orchestrator.Secret
+				err := db.Get(&secret, "name = ?", "azure-read-only")
+				assert.NoError(t, err)
+
+				value, err := decryptSecret(mockSecretKey, secret.Id, secret.Value)
+				assert.NoError(t, err)
+
+				return assert.NotEqual(t, "my-secret", secret.Value) &&
+					assert.Equal(t, "my-secret", value)
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "value missing",
+			req: &orchestrator.CreateSecretRequest{
+				Secret: &orchestrator.Secret{
+					TargetOfEvaluationId: orchestratortest.MockToeId1,
+					Name:                 "azure-read-only",
+				},
+			},
+			fields: fields{
+				db:    persistencetest.NewInMemoryDB(t, types, joinTables, createRecords(t, mockSecretRecords(t)...)),
+				authz: &service.AuthorizationStrategyAllowAll{},
+				key:   mockSecretKey,
+			},
+			want:   assert.Nil[*connect.Response[orchestrator.Secret]],
+			wantDB: assert.AnyValue[persistence.DB],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeInvalidArgument)
+			},
+		},
+		{
+			name: "target of evaluation not found",
+			req: &orchestrator.CreateSecretRequest{
+				Secret: &orchestrator.Secret{
+					TargetOfEvaluationId: "00000000-0000-0000-0000-000000000099",
+					Name:                 "azure-read-only",
+					Value:                "my-secret",
+				},
+			},
+			fields: fields{
+				db:    persistencetest.NewInMemoryDB(t, types, joinTables, createRecords(t, mockSecretRecords(t)...)),
+				authz: &service.AuthorizationStrategyAllowAll{},
+				key:   mockSecretKey,
+			},
+			want:   assert.Nil[*connect.Response[orchestrator.Secret]],
+			wantDB: assert.AnyValue[persistence.DB],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeNotFound)
+			},
+		},
+		{
+			name: "no secret key configured",
+			req: &orchestrator.CreateSecretRequest{
+				Secret: &orchestrator.Secret{
+					TargetOfEvaluationId: orchestratortest.MockToeId1,
+					Name:                 "azure-read-only",
+					Value:                "my-secret",
+				},
+			},
+			fields: fields{
+				db:    persistencetest.NewInMemoryDB(t, types, joinTables, createRecords(t, mockSecretRecords(t)...)),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			want: assert.Nil[*connect.Response[orchestrator.Secret]],
+			wantDB: func(t *testing.T, db persistence.DB, args ...any) bool {
+				n, err := db.Count(&orchestrator.Secret{}, "name = ?", "azure-read-only")
+				assert.NoError(t, err)
+
+				return assert.Equal(t, int64(0), n)
+			},
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeFailedPrecondition)
+			},
+		},
+		{
+			name: "permission denied",
+			req: &orchestrator.CreateSecretRequest{
+				Secret: &orchestrator.Secret{
+					TargetOfEvaluationId: orchestratortest.MockToeId1,
+					Name:                 "azure-read-only",
+					Value:                "my-secret",
+				},
+			},
+			fields: fields{
+				db:    persistencetest.NewInMemoryDB(t, types, joinTables, createRecords(t, mockSecretRecords(t)...)),
+				authz: &denyAuthorizationStrategy{},
+				key:   mockSecretKey,
+			},
+			want:   assert.Nil[*connect.Response[orchestrator.Secret]],
+			wantDB: assert.AnyValue[persistence.DB],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodePermissionDenied)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &Service{
+				db:    tt.fields.db,
+				authz: tt.fields.authz,
+				cfg:   Config{SecretKey: tt.fields.key},
+			}
+			got, gotErr := svc.CreateSecret(context.Background(), connect.NewRequest(tt.req))
+
+			tt.want(t, got)
+			tt.wantDB(t, tt.fields.db)
+			tt.wantErr(t, gotErr)
+		})
+	}
+}
+
+func TestService_ListSecrets(t *testing.T) {
+	type fields struct {
+		db    persistence.DB
+		authz service.AuthorizationStrategy
+	}
+	tests := []struct {
+		name    string
+		req     *orchestrator.ListSecretsRequest
+		fields  fields
+		want    assert.Want[*connect.Response[orchestrator.ListSecretsResponse]]
+		wantErr assert.WantErr
+	}{
+		{
+			name: "happy path",
+			req: &orchestrator.ListSecretsRequest{
+				TargetOfEvaluationId: orchestratortest.MockToeId1,
+			},
+			fields: fields{
+				db:    persistencetest.NewInMemoryDB(t, types, joinTables, createRecords(t, mockSecretRecords(t)...)),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			want: func(t *testing.T, got *connect.Response[orchestrator.ListSecretsResponse], args ...any) bool {
+				return assert.Equal(t, 2, len(got.Msg.Secrets)) &&
+					assert.Equal(t, "aws-read-only", got.Msg.Secrets[0].GetName()) &&
+					assert.Empty(t, got.Msg.Secrets[0].GetValue()) &&
+					assert.Equal(t, "github-token", got.Msg.Secrets[1].GetName()) &&
+					assert.Empty(t, got.Msg.Secrets[1].GetValue())
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "permission denied",
+			req: &orchestrator.ListSecretsRequest{
+				TargetOfEvaluationId: orchestratortest.MockToeId1,
+			},
+			fields: fields{
+				db:    persistencetest.NewInMemoryDB(t, types, joinTables, createRecords(t, mockSecretRecords(t)...)),
+				authz: &denyAuthorizationStrategy{},
+			},
+			want: assert.Nil[*connect.Response[orchestrator.ListSecretsResponse]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodePermissionDenied)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &Service{
+				db:    tt.fields.db,
+				authz: tt.fields.authz,
+			}
+			got, gotErr := svc.ListSecrets(context.Background(), connect.NewRequest(tt.req))
+
+			tt.want(t, got)
+			tt.wantErr(t, gotErr)
+		})
+	}
+}
+
+func TestService_UpdateSecret(t *testing.T) {
+	type fields struct {
+		db    persistence.DB
+		authz service.AuthorizationStrategy
+	}
+	tests := []struct {
+		name    string
+		req     *orchestrator.UpdateSecretRequest
+		fields  fields
+		want    assert.Want[*connect.Response[orchestrator.Secret]]
+		wantDB  assert.Want[persistence.DB]
+		wantErr assert.WantErr
+	}{
+		{
+			name: "happy path: new value",
+			req: &orchestrator.UpdateSecretRequest{
+				Secret: &orchestrator.Secret{
+					Id:                   mockSecretId1,
+					TargetOfEvaluationId: orchestratortest.MockToeId1,
+					Name:                 "aws-read-only",
+					Value:                "rotated",
+				},
+			},
+			fields: fields{
+				db:    persistencetest.NewInMemoryDB(t, types, joinTables, createRecords(t, mockSecretRecords(t)...)),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			want: func(t *testing.T, got *connect.Response[orchestrator.Secret], args ...any) bool {
+				return assert.Empty(t, got.Msg.GetValue()) &&
+					assert.NotNil(t, got.Msg.GetUpdatedAt())
+			},
+			wantDB: func(t *testing.T, db persistence.DB, args ...any) bool {
+				var secret orchestrator.Secret
+				err := db.Get(&secret, "id = ?", mockSecretId1)
+				assert.NoError(t, err)
+
+				value, err := decryptSecret(mockSecretKey, secret.Id, secret.Value)
+				assert.NoError(t, err)
+
+				return assert.Equal(t, "rotated", value)
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "happy path: keep value",
+			req: &orchestrator.UpdateSecretRequest{
+				Secret: &orchestrator.Secret{
+					Id:                   mockSecretId1,
+					TargetOfEvaluationId: orchestratortest.MockToeId1,
+					Name:                 "aws-production",
+					Description:          new("Read-only access to production"),
+				},
+			},
+			fields: fields{
+				db:    persistencetest.NewInMemoryDB(t, types, joinTables, createRecords(t, mockSecretRecords(t)...)),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			want: func(t *testing.T, got *connect.Response[orchestrator.Secret], args ...any) bool {
+				return assert.Equal(t, "aws-production", got.Msg.GetName()) &&
+					assert.Equal(t, "Read-only access to production", got.Msg.GetDescription()) &&
+					assert.Empty(t, got.Msg.GetValue())
+			},
+			wantDB: func(t *testing.T, db persistence.DB, args ...any) bool {
+				var secret orchestrator.Secret
+				err := db.Get(&secret, "id = ?", mockSecretId1)
+				assert.NoError(t, err)
+
+				value, err := decryptSecret(mockSecretKey, secret.Id, secret.Value)
+				assert.NoError(t, err)
+
+				return assert.Equal(t, "value of aws-read-only", value)
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "secret not found",
+			req: &orchestrator.UpdateSecretRequest{
+				Secret: &orchestrator.Secret{
+					Id:                   "00000000-0000-0000-0011-000000000099",
+					TargetOfEvaluationId: orchestratortest.MockToeId1,
+					Name:                 "aws-read-only",
+				},
+			},
+			fields: fields{
+				db:    persistencetest.NewInMemoryDB(t, types, joinTables, createRecords(t, mockSecretRecords(t)...)),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			want:   assert.Nil[*connect.Response[orchestrator.Secret]],
+			wantDB: assert.AnyValue[persistence.DB],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeNotFound)
+			},
+		},
+		{
+			name: "permission denied",
+			req: &orchestrator.UpdateSecretRequest{
+				Secret: &orchestrator.Secret{
+					Id:                   mockSecretId1,
+					TargetOfEvaluationId: orchestratortest.MockToeId1,
+					Name:                 "aws-read-only",
+					Value:                "rotated",
+				},
+			},
+			fields: fields{
+				db:    persistencetest.NewInMemoryDB(t, types, joinTables, createRecords(t, mockSecretRecords(t)...)),
+				authz: &denyAuthorizationStrategy{},
+			},
+			want:   assert.Nil[*connect.Response[orchestrator.Secret]],
+			wantDB: assert.AnyValue[persistence.DB],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodePermissionDenied)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &Service{
+				db:    tt.fields.db,
+				authz: tt.fields.authz,
+				cfg:   Config{SecretKey: mockSecretKey},
+			}
+			got, gotErr := svc.UpdateSecret(context.Background(), connect.NewRequest(tt.req))
+
+			tt.want(t, got)
+			tt.wantDB(t, tt.fields.db)
+			tt.wantErr(t, gotErr)
+		})
+	}
+}
+
+func TestService_RemoveSecret(t *testing.T) {
+	type fields struct {
+		db    persistence.DB
+		authz service.AuthorizationStrategy
+	}
+	tests := []struct {
+		name    string
+		req     *orchestrator.RemoveSecretRequest
+		fields  fields
+		want    assert.Want[*connect.Response[emptypb.Empty]]
+		wantDB  assert.Want[persistence.DB]
+		wantErr assert.WantErr
+	}{
+		{
+			name: "happy path",
+			req: &orchestrator.RemoveSecretRequest{
+				SecretId: mockSecretId1,
+			},
+			fields: fields{
+				db:    persistencetest.NewInMemoryDB(t, types, joinTables, createRecords(t, mockSecretRecords(t)...)),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			want: assert.NotNil[*connect.Response[emptypb.Empty]],
+			wantDB: func(t *testing.T, db persistence.DB, args ...any) bool {
+				n, err := db.Count(&orchestrator.Secret{}, "id = ?", mockSecretId1)
+				assert.NoError(t, err)
+
+				return assert.Equal(t, int64(0), n)
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "secret not found",
+			req: &orchestrator.RemoveSecretRequest{
+				SecretId: "00000000-0000-0000-0011-000000000099",
+			},
+			fields: fields{
+				db:    persistencetest.NewInMemoryDB(t, types, joinTables, createRecords(t, mockSecretRecords(t)...)),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			want:   assert.Nil[*connect.Response[emptypb.Empty]],
+			wantDB: assert.AnyValue[persistence.DB],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeNotFound)
+			},
+		},
+		{
+			name: "permission denied",
+			req: &orchestrator.RemoveSecretRequest{
+				SecretId: mockSecretId1,
+			},
+			fields: fields{
+				db:    persistencetest.NewInMemoryDB(t, types, joinTables, createRecords(t, mockSecretRecords(t)...)),
+				authz: &denyAuthorizationStrategy{},
+			},
+			want: assert.Nil[*connect.Response[emptypb.Empty]],
+			wantDB: func(t *testing.T, db persistence.DB, args ...any) bool {
+				n, err := db.Count(&orchestrator.Secret{}, "id = ?", mockSecretId1)
+				assert.NoError(t, err)
+
+				return assert.Equal(t, int64(1), n)
+			},
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodePermissionDenied)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &Service{
+				db:    tt.fields.db,
+				authz: tt.fields.authz,
+			}
+			got, gotErr := svc.RemoveSecret(context.Background(), connect.NewRequest(tt.req))
+
+			tt.want(t, got)
+			tt.wantDB(t, tt.fields.db)
+			tt.wantErr(t, gotErr)
+		})
+	}
+}
+
+func TestService_AccessSecret(t *testing.T) {
+	type fields struct {
+		db    persistence.DB
+		authz service.AuthorizationStrategy
+		key   []byte
+	}
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		req     *orchestrator.AccessSecretRequest
+		fields  fields
+		want    assert.Want[*connect.Response[orchestrator.SecretValue]]
+		wantErr assert.WantErr
+	}{
+		{
+			name: "happy path",
+			req: &orchestrator.AccessSecretRequest{
+				TargetOfEvaluationId: orchestratortest.MockToeId1,
+				Name:                 "github-token",
+			},
+			fields: fields{
+				db:    persistencetest.NewInMemoryDB(t, types, joinTables, createRecords(t, mockSecretRecords(t)...)),
+				authz: &service.AuthorizationStrategyAllowAll{},
+				key:   mockSecretKey,
+			},
+			want: func(t *testing.T, got *connect.Response[orchestrator.SecretValue], args ...any) bool {
+				want := &orchestrator.SecretValue{
+					SecretId: mockSecretId2,
+					Name:     "github-token",
+					Value:    "value of github-token",
+				}
+				return assert.Equal(t, want, got.Msg)
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "secret not found",
+			req: &orchestrator.AccessSecretRequest{
+				TargetOfEvaluationId: orchestratortest.MockToeId1,
+				Name:                 "unknown",
+			},
+			fields: fields{
+				db:    persistencetest.NewInMemoryDB(t, types, joinTables, createRecords(t, mockSecretRecords(t)...)),
+				authz: &service.AuthorizationStrategyAllowAll{},
+				key:   mockSecretKey,
+			},
+			want: assert.Nil[*connect.Response[orchestrator.SecretValue]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeNotFound)
+			},
+		},
+		{
+			name: "wrong secret key",
+			req: &orchestrator.AccessSecretRequest{
+				TargetOfEvaluationId: orchestratortest.MockToeId1,
+				Name:                 "github-token",
+			},
+			fields: fields{
+				db:    persistencetest.NewInMemoryDB(t, types, joinTables, createRecords(t, mockSecretRecords(t)...)),
+				authz: &service.AuthorizationStrategyAllowAll{},
+				key:   []byte("fedcba9876543210fedcba9876543210"),
+			},
+			want: assert.Nil[*connect.Response[orchestrator.SecretValue]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeInternal)
+			},
+		},
+		{
+			name: "permission denied",
+			req: &orchestrator.AccessSecretRequest{
+				TargetOfEvaluationId: orchestratortest.MockToeId1,
+				Name:                 "github-token",
+			},
+			fields: fields{
+				db:    persistencetest.NewInMemoryDB(t, types, joinTables, createRecords(t, mockSecretRecords(t)...)),
+				authz: &denyAuthorizationStrategy{},
+				key:   mockSecretKey,
+			},
+			want: assert.Nil[*connect.Response[orchestrator.SecretValue]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodePermissionDenied)
+			},
+		},
+		{
+			name: "happy path: contributor of the target of evaluation",
+			ctx: auth.WithClaims(context.Background(), &auth.OAuthClaims{
+				RegisteredClaims: jwt.RegisteredClaims{
+					Subject: orchestratortest.MockUserId1,
+					Issuer:  orchestratortest.MockUserIssuer1,
+				},
+			}),
+			req: &orchestrator.AccessSecretRequest{
+				TargetOfEvaluationId: orchestratortest.MockToeId1,
+				Name:                 "github-token",
+			},
+			fields: fields{
+				db: persistencetest.NewInMemoryDB(t, types, joinTables, createRecords(t, mockSecretRecords(t)...)),
+				authz: &service.AuthorizationStrategyPermissionStore{
+					Permissions: service.DBPermissionStore{
+						DB: persistencetest.NewInMemoryDB(t, types, joinTables, func(d persistence.DB) {
+							err := d.Create(&orchestrator.UserPermission{
+								UserId:     orchestratortest.MockUser1.Id,
+								ObjectId:   orchestratortest.MockToeId1,
+								ObjectType: orchestrator.ObjectType_OBJECT_TYPE_TARGET_OF_EVALUATION,
+								Permission: orchestrator.UserPermission_PERMISSION_CONTRIBUTOR,
+							})
+							assert.NoError(t, err)
+						}),
+					},
+				},
+				key: mockSecretKey,
+			},
+			want: func(t *testing.T, got *connect.Response[orchestrator.SecretValue], args ...any) bool {
+				return assert.Equal(t, "value of github-token", got.Msg.GetValue())
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "permission denied: reader of the target of evaluation",
+			ctx: auth.WithClaims(context.Background(), &auth.OAuthClaims{
+				RegisteredClaims: jwt.RegisteredClaims{
+					Subject: orchestratortest.MockUserId1,
+					Issuer:  orchestratortest.MockUserIssuer1,
+				},
+			}),
+			req: &orchestrator.AccessSecretRequest{
+				TargetOfEvaluationId: orchestratortest.MockToeId1,
+				Name:                 "github-token",
+			},
+			fields: fields{
+				db: persistencetest.NewInMemoryDB(t, types, joinTables, createRecords(t, mockSecretRecords(t)...)),
+				authz: &service.AuthorizationStrategyPermissionStore{
+					Permissions: service.DBPermissionStore{
+						DB: persistencetest.NewInMemoryDB(t, types, joinTables, func(d persistence.DB) {
+							err := d.Create(&orchestrator.UserPermission{
+								UserId:     orchestratortest.MockUser1.Id,
+								ObjectId:   orchestratortest.MockToeId1,
+								ObjectType: orchestrator.ObjectType_OBJECT_TYPE_TARGET_OF_EVALUATION,
+								Permission: orchestrator.UserPermission_PERMISSION_READER,
+							})
+							assert.NoError(t, err)
+						}),
+					},
+				},
+				key: mockSecretKey,
+			},
+			want: assert.Nil[*connect.Response[orchestrator.SecretValue]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodePermissionDenied)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &Service{
+				db:    tt.fields.db,
+				authz: tt.fields.authz,
+				cfg:   Config{SecretKey: tt.fields.key},
+			}
+			ctx := tt.ctx
+			if ctx == nil {
+				ctx = context.Background()
+			}
+			got, gotErr := svc.AccessSecret(ctx, connect.NewRequest(tt.req))
+
+			tt.want(t, got)
+			tt.wantErr(t, gotErr)
+		})
+	}
+}
@@ -19,6 +19,7 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"net/http"
 	"runtime/debug"
 	"sync"
 	"time"
@@ -50,8 +51,16 @@ type Service struct {
 	subscribersMutex sync.RWMutex
 
 	nextSubscriberId int64
+
+	// usageMutex serializes the metering of usage, see [Service.meterUsage].
+	usageMutex sync.Mutex
+
+	// workers runs the periodic jobs of the service between [Service.Start] and [Service.Shutdown].
+	workers service.Workers
 }
 
+var _ service.Lifecycle = (*Service)(nil)
+
 type subscriber struct {
 	ch     chan *orchestrator.ChangeEvent
 	filter *orchestrator.SubscribeRequest_Filter
@@ -64,6 +73,14 @@ var DefaultConfig = Config{
 	CreateDefaultTargetOfEvaluation: true,
 	LoadDefaultCatalogs:             true,
 	LoadDefaultMetrics:              true,
+	MaxAttachmentSize:               DefaultMaxAttachmentSize,
+	SLACheckInterval:                DefaultSLACheckInterval,
+	CatalogSourceCheckInterval:      DefaultCatalogSourceCheckInterval,
+	WebhookDeliveryRetention:        DefaultWebhookDeliveryRetention,
+	AnomalyCheckInterval:            DefaultAnomalyCheckInterval,
+	AnomalyBaselinePeriod:           DefaultAnomalyBaselinePeriod,
+	AnomalyVolumeDropThreshold:      DefaultAnomalyVolumeDropThreshold,
+	AnomalyFlappingThreshold:        DefaultAnomalyFlappingThreshold,
 }
 
 // Config represents the configuration for the orchestrator [Service].
@@ -91,6 +108,67 @@ type Config struct {
 
 	// PersistenceConfig is the configuration for the persistence layer. If not set, defaults will be used.
 	PersistenceConfig persistence.Config
+
+	// AttachmentStore stores the content of attachments. If not set, the content is stored in the database (see
+	// [DBAttachmentStore]).
+	AttachmentStore AttachmentStore
+	// MaxAttachmentSize is the maximum size of an attachment in bytes. If not set, [DefaultMaxAttachmentSize] is used.
+	MaxAttachmentSize int64
+
+	// UserDirectory is an optional external directory of users, such as a SCIM provider (see [SCIMUserDirectory]),
+	// which is synchronized with the local users.
+	UserDirectory UserDirectory
+	// UserDirectorySyncInterval is the interval in which the users of [Config.UserDirectory] are synchronized. If not
+	// set, users are only synchronized on request.
+	UserDirectorySyncInterval time.Duration
+
+	// SLAWebhookURLs are the URLs a JSON-encoded [orchestrator.SlaBreach] is posted to, whenever a control exceeds an
+	// SLA threshold of its catalog.
+	SLAWebhookURLs []string
+	// SLACheckInterval is the interval in which SLA breaches are checked. SLA breaches are only checked, if
+	// [Config.SLAWebhookURLs] are configured.
+	SLACheckInterval time.Duration
+	// SLAWebhookHTTPClient is the HTTP client used to call the [Config.SLAWebhookURLs]. If not set, an HTTP/1.1
+	// capable client with a timeout of [DefaultWebhookTimeout] is used.
+	SLAWebhookHTTPClient *http.Client
+
+	// CatalogSourceCheckInterval is the interval in which the catalog sources are checked for a due sync (see
+	// [orchestrator.CatalogSource]). If not set, catalog sources are only synced on request.
+	CatalogSourceCheckInterval time.Duration
+	// CatalogSourceHTTPClient is the HTTP client used to retrieve the content of catalog sources. If not set,
+	// [service.DefaultHTTPClient] is used.
+	CatalogSourceHTTPClient *http.Client
+
+	// WebhookHTTPClient is the HTTP client used to deliver change events to webhooks (see [orchestrator.Webhook]). If
+	// not set, an HTTP/1.1 capable client with a timeout of [DefaultWebhookTimeout] is used.
+	WebhookHTTPClient *http.Client
+	// WebhookDeliveryRetention is the time for which webhook deliveries are kept in the delivery log. If not set,
+	// deliveries are kept forever.
+	WebhookDeliveryRetention time.Duration
+
+	// AnomalyCheckInterval is the interval in which the incoming assessment results are checked for anomalies (see
+	// [orchestrator.Alert]). It is also the window whose results are checked. If not set, no anomalies are detected.
+	AnomalyCheckInterval time.Duration
+	// AnomalyBaselinePeriod is the period before the detection window that the result volume of a target of evaluation
+	// is compared against. If not set, [DefaultAnomalyBaselinePeriod] is used.
+	AnomalyBaselinePeriod time.Duration
+	// AnomalyVolumeDropThreshold is the relative drop of the result volume compared to the baseline, e.g., 0.5 for 50%,
+	// at which an alert is raised. If not set, [DefaultAnomalyVolumeDropThreshold] is used.
+	AnomalyVolumeDropThreshold float64
+	// AnomalyFlappingThreshold is the number of changes between compliant and non-compliant of a metric for a resource
+	// within the detection window at which an alert is raised. If not set, [DefaultAnomalyFlappingThreshold] is used.
+	AnomalyFlappingThreshold int
+
+	// SecretKey is the 32-byte key used to encrypt the secrets of targets of evaluation with AES-256-GCM (see
+	// [orchestrator.Secret]). If not set, no secrets can be created or accessed.
+	SecretKey []byte
+
+	// ImmutableRetention enables the append-only (write once, read many) mode for assessment results, if positive.
+	// Stored assessment results cannot be updated or deleted for this period after they were stored (see
+	// [assessment.AssessmentResult.CreatedAt]), which is enforced by the persistence layer (see
+	// [persistence.Config.Immutable]). This includes moving them to another target of evaluation. Waivers are stored
+	// in their own table (see [assessment.Waiver]), so that assessment results can still be waived.
+	ImmutableRetention time.Duration
 }
 
 // WithConfig sets the service configuration, overriding the default configuration.
@@ -136,10 +214,17 @@ func NewService(opts ...service.Option[Service]) (handler orchestratorconnect.Or
 		svc.authz = &service.AuthorizationStrategyAllowAll{}
 	}
 
+	if len(svc.cfg.SecretKey) > 0 && len(svc.cfg.SecretKey) != SecretKeySize {
+		return nil, fmt.Errorf("secret key must be %d bytes long", SecretKeySize)
+	}
+
 	// Initialize the database with the defined auto-migration types and join tables
 	pcfg := svc.cfg.PersistenceConfig
 	pcfg.Types = types
 	pcfg.CustomJoinTables = joinTables
+	if svc.cfg.ImmutableRetention > 0 {
+		pcfg.Immutable = append(pcfg.Immutable, immutableTypes(svc.cfg.ImmutableRetention)...)
+	}
 	svc.db, err = persistence.NewDB(persistence.WithConfig(pcfg))
 	if err != nil {
 		return nil, fmt.Errorf("could not create db: %w", err)
@@ -153,6 +238,23 @@ func NewService(opts ...service.Option[Service]) (handler orchestratorconnect.Or
 		}
 	}
 
+	// Store the content of attachments in the database, unless another store is configured
+	if svc.cfg.AttachmentStore == nil {
+		svc.cfg.AttachmentStore = DBAttachmentStore{DB: svc.db}
+	}
+	if svc.cfg.MaxAttachmentSize <= 0 {
+		svc.cfg.MaxAttachmentSize = DefaultMaxAttachmentSize
+	}
+	if svc.cfg.AnomalyBaselinePeriod <= 0 {
+		svc.cfg.AnomalyBaselinePeriod = DefaultAnomalyBaselinePeriod
+	}
+	if svc.cfg.AnomalyVolumeDropThreshold <= 0 {
+		svc.cfg.AnomalyVolumeDropThreshold = DefaultAnomalyVolumeDropThreshold
+	}
+	if svc.cfg.AnomalyFlappingThreshold <= 0 {
+		svc.cfg.AnomalyFlappingThreshold = DefaultAnomalyFlappingThreshold
+	}
+
 	// Initialize subscribers map
 	svc.subscribers = make(map[int64]*subscriber)
 
@@ -165,6 +267,13 @@ func NewService(opts ...service.Option[Service]) (handler orchestratorconnect.Or
 		slog.Warn("Could not load metrics, continuing with empty metric list", log.Err(err))
 	}
 
+	if svc.cfg.SLAWebhookHTTPClient == nil {
+		svc.cfg.SLAWebhookHTTPClient = defaultWebhookHTTPClient
+	}
+	if svc.cfg.CatalogSourceHTTPClient == nil {
+		svc.cfg.CatalogSourceHTTPClient = service.DefaultHTTPClient
+	}
+
 	// Create default target of evaluation if enabled and none exists
 	if svc.cfg.CreateDefaultTargetOfEvaluation {
 		if _, err = svc.CreateDefaultTargetOfEvaluation(); err != nil {
@@ -176,6 +285,39 @@ func NewService(opts ...service.Option[Service]) (handler orchestratorconnect.Or
 	return
 }
 
+// Start starts the periodic jobs of the service, i.e., the synchronization of the [Config.UserDirectory], the
+// notification of SLA breaches, the sync of the catalog sources and the anomaly detection, if they are configured.
+// This implements [service.Lifecycle].
+func (svc *Service) Start(_ context.Context) (err error) {
+	// Periodically synchronize the users of the user directory, if configured
+	if svc.cfg.UserDirectory != nil && svc.cfg.UserDirectorySyncInterval > 0 {
+		svc.workers.Go(svc.syncUsersPeriodically)
+	}
+
+	// Periodically notify SLA breaches, if any webhook is configured
+	if len(svc.cfg.SLAWebhookURLs) > 0 && svc.cfg.SLACheckInterval > 0 {
+		svc.workers.Go(svc.checkSLAsPeriodically)
+	}
+
+	// Periodically sync the catalog sources
+	if svc.cfg.CatalogSourceCheckInterval > 0 {
+		svc.workers.Go(svc.syncCatalogSourcesPeriodically)
+	}
+
+	// Periodically check the incoming assessment results for anomalies
+	if svc.cfg.AnomalyCheckInterval > 0 {
+		svc.workers.Go(svc.detectAnomaliesPeriodically)
+	}
+
+	return nil
+}
+
+// Shutdown stops the periodic jobs of the service and waits until they returned or ctx is done. This implements
+// [service.Lifecycle].
+func (svc *Service) Shutdown(ctx context.Context) (err error) {
+	return svc.workers.Stop(ctx)
+}
+
 // func (svc *Service) allowedTargetOfEvaluations(ctx context.Context) (all bool, allowed []string) {
 // 	if svc == nil || svc.authz == nil {
 // 		return true, nil
@@ -14,3 +14,85 @@
 // This file is part of Confirmate Core.
 
 package orchestrator
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"confirmate.io/core/api/assessment"
+	"confirmate.io/core/api/orchestrator"
+	"confirmate.io/core/persistence"
+	"confirmate.io/core/util/assert"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestService_StartAndShutdown(t *testing.T) {
+	var svc = &Service{
+		cfg: Config{
+			SLAWebhookURLs:             []string{"http://localhost/sla"},
+			SLACheckInterval:           time.Hour,
+			CatalogSourceCheckInterval: time.Hour,
+			AnomalyCheckInterval:       time.Hour,
+		},
+	}
+
+	assert.NoError(t, svc.Start(context.Background()))
+
+	// All periodic jobs return on shutdown
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	assert.NoError(t, svc.Shutdown(ctx))
+}
+
+func TestNewService_appendOnly(t *testing.T) {
+	handler, err := NewService(WithConfig(Config{
+		PersistenceConfig:  persistence.Config{InMemoryDB: true},
+		ImmutableRetention: 24 * time.Hour,
+	}))
+	assert.NoError(t, err)
+
+	var (
+		svc    = handler.(*Service)
+		result = &assessment.AssessmentResult{Id: uuid.NewString(), CreatedAt: timestamppb.Now(), MetricId: "metric"}
+	)
+	assert.NoError(t, svc.db.Create(result))
+
+	// Stored assessment results can neither be updated nor deleted within the retention period
+	result.Compliant = true
+	assert.ErrorIs(t, svc.db.Save(result), persistence.ErrImmutable)
+	assert.ErrorIs(t, svc.db.Delete(&assessment.AssessmentResult{}, "id = ?", result.Id), persistence.ErrImmutable)
+
+	// Waivers are stored in their own table, so that immutable results can still be waived and their waivers revoked
+	waived, err := svc.WaiveAssessmentResult(context.Background(), connect.NewRequest(&orchestrator.WaiveAssessmentResultRequest{
+		AssessmentResultId: result.Id,
+		Justification:      "Accepted risk",
+	}))
+	assert.NoError(t, err)
+	assert.True(t, waived.Msg.IsWaived(time.Now()))
+
+	revoked, err := svc.RevokeAssessmentResultWaiver(context.Background(), connect.NewRequest(&orchestrator.RevokeAssessmentResultWaiverRequest{
+		AssessmentResultId: result.Id,
+	}))
+	assert.NoError(t, err)
+	assert.False(t, revoked.Msg.IsWaived(time.Now()))
+}
+
+// createRecords returns an initializer for [persistencetest.NewInMemoryDB] that creates the given records in order.
+// Catalogs are created with [createCatalog], so that their assurance levels and control text versions are stored as
+// well.
+func createRecords(t *testing.T, records ...any) func(persistence.DB) {
+	return func(d persistence.DB) {
+		for _, r := range records {
+			if catalog, ok := r.(*orchestrator.Catalog); ok {
+				assert.NoError(t, createCatalog(d, catalog))
+			} else {
+				assert.NoError(t, d.Create(r))
+			}
+		}
+	}
+}
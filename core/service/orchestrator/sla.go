@@ -0,0 +1,330 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package orchestrator
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"confirmate.io/core/api"
+	"confirmate.io/core/api/evaluation"
+	"confirmate.io/core/api/orchestrator"
+	"confirmate.io/core/log"
+	"confirmate.io/core/persistence"
+	"confirmate.io/core/service"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// DefaultSLACheckInterval is the default interval in which SLA breaches are checked.
+const DefaultSLACheckInterval = time.Hour
+
+// slaNotification records that an SLA breach was sent to the [Config.SLAWebhookURLs], so that each breach is only
+// notified once.
+type slaNotification struct {
+	Id         string `gorm:"primaryKey"`
+	NotifiedAt time.Time
+}
+
+// ListSlaBreaches lists the controls that are not compliant for longer than allowed by the SLA thresholds of their
+// catalog. Only breaches of targets of evaluation the user has access to are returned.
+func (svc *Service) ListSlaBreaches(
+	ctx context.Context,
+	req *connect.Request[orchestrator.ListSlaBreachesRequest],
+) (res *connect.Response[orchestrator.ListSlaBreachesResponse], err error) {
+	var (
+		query    []string
+		args     []any
+		all      bool
+		toeIds   []string
+		breaches []*orchestrator.SlaBreach
+	)
+
+	// Validate the request
+	if err = service.Validate(req); err != nil {
+		return nil, err
+	}
+
+	if req.Msg.TargetOfEvaluationId != nil {
+		query = append(query, "target_of_evaluation_id = ?")
+		args = append(args, req.Msg.GetTargetOfEvaluationId())
+	}
+	if req.Msg.AuditScopeId != nil {
+		query = append(query, "audit_scope_id = ?")
+		args = append(args, req.Msg.GetAuditScopeId())
+	}
+	if req.Msg.CatalogId != nil {
+		query = append(query, "control_catalog_id = ?")
+		args = append(args, req.Msg.GetCatalogId())
+	}
+
+	// Retrieve list of all allowed ToE IDs for the user to filter breaches by access permissions
+	all, toeIds = svc.authz.AllowedTargetOfEvaluations(ctx)
+	if !all && len(toeIds) == 0 {
+		return connect.NewResponse(&orchestrator.ListSlaBreachesResponse{
+			Breaches: []*orchestrator.SlaBreach{},
+		}), nil
+	}
+	if !all {
+		query = append(query, "target_of_evaluation_id IN ?")
+		args = append(args, toeIds)
+	}
+
+	breaches, err = svc.slaBreaches(time.Now(), query, args)
+	if err = service.HandleDatabaseError(err); err != nil {
+		return nil, err
+	}
+
+	res = connect.NewResponse(&orchestrator.ListSlaBreachesResponse{
+		Breaches: breaches,
+	})
+	return
+}
+
+// slaBreaches returns the SLA breaches at the given time among the evaluation results matching the query, ordered by
+// their deadline.
+func (svc *Service) slaBreaches(now time.Time, query []string, args []any) (breaches []*orchestrator.SlaBreach, err error) {
+	var (
+		where      string
+		results    []*evaluation.EvaluationResult
+		latest     []*evaluation.EvaluationResult
+		catalogIds []string
+		controlIds []string
+		catalogs   []*orchestrator.Catalog
+		controls   []*orchestrator.Control
+		thresholds = make(map[string][]*orchestrator.SlaThreshold)
+		levels     = make(map[string]string)
+	)
+
+	if len(query) > 0 {
+		where = "WHERE " + strings.Join(query, " AND ")
+	}
+
+	// Only the latest result per control and audit scope determines whether a control is (still) not compliant
+	results, err = latestEvaluationResults(svc.db, []string{"audit_scope_id", "control_catalog_id", "control_id"},
+		func(r *evaluation.EvaluationResult) string {
+			return r.GetAuditScopeId() + "/" + r.GetControlCatalogId() + "/" + r.GetControlId()
+		}, where, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, r := range results {
+		if r.GetNonCompliantSince() != nil {
+			latest = append(latest, r)
+			catalogIds = append(catalogIds, r.GetControlCatalogId())
+			controlIds = append(controlIds, r.GetControlId())
+		}
+	}
+
+	if len(latest) == 0 {
+		return []*orchestrator.SlaBreach{}, nil
+	}
+
+	// Retrieve the thresholds of the catalogs and the assurance levels of the controls
+	err = svc.db.List(&catalogs, "", true, 0, -1, persistence.WithoutPreload(), "id IN ?", slices.Compact(slices.Sorted(slices.Values(catalogIds))))
+	if err != nil {
+		return nil, err
+	}
+	for _, c := range catalogs {
+		thresholds[c.GetId()] = c.GetSlaThresholds()
+	}
+
+	err = svc.db.List(&controls, "", true, 0, -1, persistence.WithoutPreload(), "id IN ?", slices.Compact(slices.Sorted(slices.Values(controlIds))))
+	if err != nil {
+		return nil, err
+	}
+	for _, c := range controls {
+		levels[c.GetId()] = c.GetAssuranceLevel()
+	}
+
+	breaches = []*orchestrator.SlaBreach{}
+	for _, r := range latest {
+		threshold := slaThreshold(thresholds[r.GetControlCatalogId()], levels[r.GetControlId()])
+		if threshold == nil {
+			continue
+		}
+
+		deadline := r.GetNonCompliantSince().AsTime().Add(time.Duration(threshold.GetMaxDays()) * 24 * time.Hour)
+		if !now.After(deadline) {
+			continue
+		}
+
+		breaches = append(breaches, &orchestrator.SlaBreach{
+			EvaluationResultId:   r.GetId(),
+			TargetOfEvaluationId: r.GetTargetOfEvaluationId(),
+			AuditScopeId:         r.GetAuditScopeId(),
+			ControlId:            r.GetControlId(),
+			ControlCatalogId:     r.GetControlCatalogId(),
+			NonCompliantSince:    r.GetNonCompliantSince(),
+			Deadline:             timestamppb.New(deadline),
+			Threshold:            threshold,
+		})
+	}
+
+	slices.SortStableFunc(breaches, func(a, b *orchestrator.SlaBreach) int {
+		return a.GetDeadline().AsTime().Compare(b.GetDeadline().AsTime())
+	})
+
+	return breaches, nil
+}
+
+// slaThreshold returns the threshold that applies to a control with the given assurance level, or nil if none applies.
+// A threshold for the assurance level takes precedence over a threshold without one.
+func slaThreshold(thresholds []*orchestrator.SlaThreshold, level string) (threshold *orchestrator.SlaThreshold) {
+	for _, t := range thresholds {
+		if t.AssuranceLevel == nil && threshold == nil {
+			threshold = t
+		} else if level != "" && t.GetAssuranceLevel() == level {
+			return t
+		}
+	}
+
+	return threshold
+}
+
+// checkSLAsPeriodically notifies the [Config.SLAWebhookURLs] about new SLA breaches every [Config.SLACheckInterval]
+// until ctx is done.
+func (svc *Service) checkSLAsPeriodically(ctx context.Context) {
+	var ticker = time.NewTicker(max(svc.cfg.SLACheckInterval, time.Second))
+
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := svc.notifySLABreaches(ctx, time.Now()); err != nil {
+				slog.Warn("Could not notify all SLA breaches, retrying later", log.Err(err))
+			}
+		}
+	}
+}
+
+// notifySLABreaches sends each SLA breach at the given time, that was not notified yet, to the [Config.SLAWebhookURLs].
+// A breach is identified by its control and the time since which the control is not compliant, so a control that
+// becomes non-compliant again is notified again. If a webhook fails, the breach is notified again in the next check.
+// Breaches of audit scopes in maintenance are held back until the maintenance window ends.
+func (svc *Service) notifySLABreaches(ctx context.Context, now time.Time) (err error) {
+	var (
+		breaches []*orchestrator.SlaBreach
+		notified []string
+		windows  []*orchestrator.MaintenanceWindow
+		errs     []error
+	)
+
+	breaches, err = svc.slaBreaches(now, nil, nil)
+	if err != nil {
+		return fmt.Errorf("could not retrieve SLA breaches: %w", err)
+	}
+
+	err = svc.db.Pluck(&slaNotification{}, "id", &notified)
+	if err != nil {
+		return fmt.Errorf("could not retrieve SLA notifications: %w", err)
+	}
+
+	windows, err = svc.activeMaintenanceWindows(now)
+	if err != nil {
+		return fmt.Errorf("could not retrieve maintenance windows: %w", err)
+	}
+
+	for _, breach := range breaches {
+		id := slaBreachId(breach)
+		if slices.Contains(notified, id) {
+			continue
+		}
+
+		if slices.ContainsFunc(windows, func(w *orchestrator.MaintenanceWindow) bool {
+			return w.GetAuditScopeId() == breach.GetAuditScopeId()
+		}) {
+			continue
+		}
+
+		if err = svc.sendSLAWebhooks(ctx, breach); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		slog.Info("Notified SLA breach",
+			slog.String("control", breach.GetControlId()),
+			slog.String("audit_scope", breach.GetAuditScopeId()),
+			slog.Time("deadline", breach.GetDeadline().AsTime()),
+		)
+
+		if err = svc.db.Create(&slaNotification{Id: id, NotifiedAt: now}); err != nil {
+			errs = append(errs, fmt.Errorf("could not store SLA notification: %w", err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// sendSLAWebhooks posts the breach in its canonical JSON representation (see [api.MarshalCanonicalJSON]) to all
+// [Config.SLAWebhookURLs].
+func (svc *Service) sendSLAWebhooks(ctx context.Context, breach *orchestrator.SlaBreach) (err error) {
+	var (
+		body []byte
+		req  *http.Request
+		res  *http.Response
+		errs []error
+	)
+
+	body, err = api.MarshalCanonicalJSON(breach)
+	if err != nil {
+		return err
+	}
+
+	for _, url := range svc.cfg.SLAWebhookURLs {
+		req, err = http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		req.Header.Set("Content-Type", "application/json")
+
+		res, err = svc.cfg.SLAWebhookHTTPClient.Do(req)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("could not call SLA webhook: %w", err))
+			continue
+		}
+		res.Body.Close()
+
+		if res.StatusCode >= http.StatusBadRequest {
+			errs = append(errs, fmt.Errorf("SLA webhook %s returned %s", url, res.Status))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// slaBreachId returns an ID identifying the breach across checks.
+func slaBreachId(breach *orchestrator.SlaBreach) string {
+	return fmt.Sprintf("%s/%s/%s/%d",
+		breach.GetAuditScopeId(),
+		breach.GetControlCatalogId(),
+		breach.GetControlId(),
+		breach.GetNonCompliantSince().AsTime().UnixNano(),
+	)
+}
@@ -0,0 +1,350 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package orchestrator
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"confirmate.io/core/api/evaluation"
+	"confirmate.io/core/api/orchestrator"
+	"confirmate.io/core/auth"
+	"confirmate.io/core/persistence"
+	"confirmate.io/core/persistence/persistencetest"
+	"confirmate.io/core/service"
+	"confirmate.io/core/service/orchestrator/orchestratortest"
+	"confirmate.io/core/util/assert"
+
+	"connectrpc.com/connect"
+	"github.com/golang-jwt/jwt/v5"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	mockSLACatalogId       = "catalog-sla"
+	mockSLAControlIdHigh   = "00000000-0000-0000-0005-000000000101"
+	mockSLAControlIdBasic  = "00000000-0000-0000-0005-000000000102"
+	mockSLAResultIdHigh    = "00000000-0000-0000-0006-000000000001"
+	mockSLAResultIdBasic   = "00000000-0000-0000-0006-000000000002"
+	mockSLAResultIdScope2  = "00000000-0000-0000-0006-000000000003"
+	mockSLAResultIdOld     = "00000000-0000-0000-0006-000000000004"
+	mockSLAResultIdHealed  = "00000000-0000-0000-0006-000000000005"
+	mockSLAResultIdPending = "00000000-0000-0000-0006-000000000006"
+)
+
+// mockSLARecords returns a catalog with SLA thresholds of 14 days for controls of the "high" assurance level and 30
+// days for all other controls, as well as evaluation results relative to now. The "high" control in audit scope 1 and
+// the "basic" control in audit scope 2 exceed their SLA.
+func mockSLARecords(now time.Time) (records []any) {
+	days := func(n int) *timestamppb.Timestamp {
+		return timestamppb.New(now.Add(-time.Duration(n) * 24 * time.Hour))
+	}
+
+	records = []any{
+		&orchestrator.Catalog{
+			Id:        mockSLACatalogId,
+			Name:      "SLA Catalog",
+			ShortName: "SLA",
+			SlaThresholds: []*orchestrator.SlaThreshold{
+				{MaxDays: 30},
+				{AssuranceLevel: new("high"), MaxDays: 14},
+			},
+		},
+		&orchestrator.Control{
+			Id:             mockSLAControlIdHigh,
+			Name:           "High Control",
+			ShortName:      "HIGH-01",
+			CatalogId:      mockSLACatalogId,
+			AssuranceLevel: new("high"),
+		},
+		&orchestrator.Control{
+			Id:             mockSLAControlIdBasic,
+			Name:           "Basic Control",
+			ShortName:      "BASIC-01",
+			CatalogId:      mockSLACatalogId,
+			AssuranceLevel: new("basic"),
+		},
+	}
+
+	for _, r := range []*evaluation.EvaluationResult{
+		// Not compliant for 20 days, exceeding the 14 days of the "high" threshold
+		{Id: mockSLAResultIdHigh, AuditScopeId: orchestratortest.MockScopeId1, ControlId: mockSLAControlIdHigh,
+			Status: evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT, Timestamp: days(1), NonCompliantSince: days(20)},
+		// Not compliant for 20 days, within the 30 days of the default threshold
+		{Id: mockSLAResultIdBasic, AuditScopeId: orchestratortest.MockScopeId1, ControlId: mockSLAControlIdBasic,
+			Status: evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT, Timestamp: days(1), NonCompliantSince: days(20)},
+		// Pending for 40 days after being not compliant, exceeding the 30 days of the default threshold
+		{Id: mockSLAResultIdPending, AuditScopeId: orchestratortest.MockScopeId2, ControlId: mockSLAControlIdBasic,
+			TargetOfEvaluationId: orchestratortest.MockToeId2,
+			Status:               evaluation.EvaluationStatus_EVALUATION_STATUS_PENDING, Timestamp: days(1), NonCompliantSince: days(40)},
+		{Id: mockSLAResultIdScope2, AuditScopeId: orchestratortest.MockScopeId2, ControlId: mockSLAControlIdBasic,
+			TargetOfEvaluationId: orchestratortest.MockToeId2,
+			Status:               evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT, Timestamp: days(40), NonCompliantSince: days(40)},
+		// Was not compliant for long, but has returned to compliant since
+		{Id: mockSLAResultIdOld, AuditScopeId: orchestratortest.MockScopeId2, ControlId: mockSLAControlIdHigh,
+			TargetOfEvaluationId: orchestratortest.MockToeId2,
+			Status:               evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT, Timestamp: days(10), NonCompliantSince: days(50)},
+		{Id: mockSLAResultIdHealed, AuditScopeId: orchestratortest.MockScopeId2, ControlId: mockSLAControlIdHigh,
+			TargetOfEvaluationId: orchestratortest.MockToeId2,
+			Status:               evaluation.EvaluationStatus_EVALUATION_STATUS_COMPLIANT, Timestamp: days(1)},
+	} {
+		r.ControlCatalogId = mockSLACatalogId
+		if r.TargetOfEvaluationId == "" {
+			r.TargetOfEvaluationId = orchestratortest.MockToeId1
+		}
+		records = append(records, r)
+	}
+
+	return
+}
+
+func TestService_ListSlaBreaches(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		req *orchestrator.ListSlaBreachesRequest
+	}
+	type fields struct {
+		db    persistence.DB
+		authz service.AuthorizationStrategy
+	}
+	tests := []struct {
+		name    string
+		args    args
+		fields  fields
+		want    assert.Want[*connect.Response[orchestrator.ListSlaBreachesResponse]]
+		wantErr assert.WantErr
+	}{
+		{
+			name: "happy path: all breaches ordered by deadline",
+			args: args{
+				ctx: context.Background(),
+				req: &orchestrator.ListSlaBreachesRequest{},
+			},
+			fields: fields{
+				db:    persistencetest.NewInMemoryDB(t, types, joinTables, createRecords(t, mockSLARecords(time.Now())...)),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			want: func(t *testing.T, got *connect.Response[orchestrator.ListSlaBreachesResponse], msgAndArgs ...any) bool {
+				if !assert.Equal(t, 2, len(got.Msg.Breaches)) {
+					return false
+				}
+
+				assert.Equal(t, mockSLAResultIdPending, got.Msg.Breaches[0].EvaluationResultId)
+				assert.Equal(t, uint32(30), got.Msg.Breaches[0].GetThreshold().GetMaxDays())
+				assert.Equal(t, mockSLAResultIdHigh, got.Msg.Breaches[1].EvaluationResultId)
+				assert.Equal(t, uint32(14), got.Msg.Breaches[1].GetThreshold().GetMaxDays())
+				return assert.Equal(t, 14*24*time.Hour,
+					got.Msg.Breaches[1].GetDeadline().AsTime().Sub(got.Msg.Breaches[1].GetNonCompliantSince().AsTime()))
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "happy path: filtered by audit scope",
+			args: args{
+				ctx: context.Background(),
+				req: &orchestrator.ListSlaBreachesRequest{AuditScopeId: new(orchestratortest.MockScopeId1)},
+			},
+			fields: fields{
+				db:    persistencetest.NewInMemoryDB(t, types, joinTables, createRecords(t, mockSLARecords(time.Now())...)),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			want: func(t *testing.T, got *connect.Response[orchestrator.ListSlaBreachesResponse], msgAndArgs ...any) bool {
+				return assert.Equal(t, 1, len(got.Msg.Breaches)) &&
+					assert.Equal(t, mockSLAControlIdHigh, got.Msg.Breaches[0].ControlId)
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "happy path: no access to any target of evaluation",
+			args: args{
+				ctx: auth.WithClaims(context.Background(), &auth.OAuthClaims{
+					RegisteredClaims: jwt.RegisteredClaims{
+						Subject: orchestratortest.MockUserId1,
+						Issuer:  orchestratortest.MockUserIssuer1,
+					},
+				}),
+				req: &orchestrator.ListSlaBreachesRequest{},
+			},
+			fields: fields{
+				db: persistencetest.NewInMemoryDB(t, types, joinTables, createRecords(t, mockSLARecords(time.Now())...)),
+				authz: &service.AuthorizationStrategyPermissionStore{
+					Permissions: service.DBPermissionStore{
+						DB: persistencetest.NewInMemoryDB(t, types, joinTables),
+					},
+				},
+			},
+			want: func(t *testing.T, got *connect.Response[orchestrator.ListSlaBreachesResponse], msgAndArgs ...any) bool {
+				return assert.Empty(t, got.Msg.Breaches)
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "validation error",
+			args: args{
+				ctx: context.Background(),
+				req: &orchestrator.ListSlaBreachesRequest{TargetOfEvaluationId: new("not-a-uuid")},
+			},
+			fields: fields{
+				db:    persistencetest.NewInMemoryDB(t, types, joinTables, createRecords(t, mockSLARecords(time.Now())...)),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			want: assert.Nil[*connect.Response[orchestrator.ListSlaBreachesResponse]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeInvalidArgument) &&
+					assert.HasFieldViolation(t, err, "target_of_evaluation_id")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &Service{
+				db:    tt.fields.db,
+				authz: tt.fields.authz,
+			}
+
+			got, err := svc.ListSlaBreaches(tt.args.ctx, connect.NewRequest(tt.args.req))
+			tt.want(t, got)
+			tt.wantErr(t, err)
+		})
+	}
+}
+
+func TestService_notifySLABreaches(t *testing.T) {
+	var (
+		now      = time.Now()
+		received atomic.Int32
+		status   atomic.Int32
+	)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		received.Add(1)
+		w.WriteHeader(int(status.Load()))
+	}))
+	defer srv.Close()
+
+	// The default client of the SLA webhooks has to talk to the plain HTTP/1.1 test server
+	svc := &Service{
+		db: persistencetest.NewInMemoryDB(t, types, joinTables, createRecords(t, mockSLARecords(now)...)),
+		cfg: Config{
+			SLAWebhookURLs:       []string{srv.URL},
+			SLAWebhookHTTPClient: defaultWebhookHTTPClient,
+		},
+	}
+
+	// A failing webhook is retried in the next check
+	status.Store(http.StatusInternalServerError)
+	err := svc.notifySLABreaches(context.Background(), now)
+	assert.ErrorContains(t, err, "500 Internal Server Error")
+	assert.Equal(t, int32(2), received.Load())
+
+	status.Store(http.StatusNoContent)
+	err = svc.notifySLABreaches(context.Background(), now)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(4), received.Load())
+
+	// Breaches are only notified once
+	err = svc.notifySLABreaches(context.Background(), now.Add(time.Hour))
+	assert.NoError(t, err)
+	assert.Equal(t, int32(4), received.Load())
+
+	// Later on, the "basic" control of audit scope 1 exceeds its SLA as well
+	err = svc.notifySLABreaches(context.Background(), now.Add(11*24*time.Hour))
+	assert.NoError(t, err)
+	assert.Equal(t, int32(5), received.Load())
+}
+
+// TestService_notifySLABreaches_maintenance covers that breaches of audit scopes in maintenance are held back until the
+// maintenance window ends.
+func TestService_notifySLABreaches_maintenance(t *testing.T) {
+	var (
+		now      = time.Now()
+		received atomic.Int32
+	)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received.Add(1)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	db := persistencetest.NewInMemoryDB(t, types, joinTables, createRecords(t, mockSLARecords(now)...))
+	err := db.Create(orchestratortest.MockAuditScope1)
+	assert.NoError(t, err)
+	err = db.Create(orchestratortest.MockAuditScope2)
+	assert.NoError(t, err)
+
+	for id, scopeId := range map[string]string{
+		mockMaintenanceWindowId1: orchestratortest.MockScopeId1,
+		mockMaintenanceWindowId2: orchestratortest.MockScopeId2,
+	} {
+		err = db.Create(&orchestrator.MaintenanceWindow{
+			Id:           id,
+			AuditScopeId: scopeId,
+			StartsAt:     timestamppb.New(now.Add(-time.Hour)),
+			EndsAt:       timestamppb.New(now.Add(time.Hour)),
+			Mode:         orchestrator.MaintenanceMode_MAINTENANCE_MODE_FLAG,
+		})
+		assert.NoError(t, err)
+	}
+
+	svc := &Service{
+		db: db,
+		cfg: Config{
+			SLAWebhookURLs:       []string{srv.URL},
+			SLAWebhookHTTPClient: srv.Client(),
+		},
+	}
+
+	err = svc.notifySLABreaches(context.Background(), now)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(0), received.Load())
+
+	err = svc.notifySLABreaches(context.Background(), now.Add(2*time.Hour))
+	assert.NoError(t, err)
+	assert.Equal(t, int32(2), received.Load())
+}
+
+func Test_slaThreshold(t *testing.T) {
+	var (
+		fallback = &orchestrator.SlaThreshold{MaxDays: 30}
+		high     = &orchestrator.SlaThreshold{AssuranceLevel: new("high"), MaxDays: 14}
+	)
+
+	tests := []struct {
+		name       string
+		thresholds []*orchestrator.SlaThreshold
+		level      string
+		want       *orchestrator.SlaThreshold
+	}{
+		{name: "matching assurance level", thresholds: []*orchestrator.SlaThreshold{fallback, high}, level: "high", want: high},
+		{name: "fallback", thresholds: []*orchestrator.SlaThreshold{high, fallback}, level: "basic", want: fallback},
+		{name: "control without assurance level", thresholds: []*orchestrator.SlaThreshold{high, fallback}, want: fallback},
+		{name: "no applicable threshold", thresholds: []*orchestrator.SlaThreshold{high}, level: "basic"},
+		{name: "no thresholds", level: "high"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, slaThreshold(tt.thresholds, tt.level))
+		})
+	}
+}
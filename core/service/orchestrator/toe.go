@@ -17,6 +17,7 @@ package orchestrator
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"confirmate.io/core/api/assessment"
@@ -38,6 +39,7 @@ func (svc *Service) CreateTargetOfEvaluation(
 ) (res *connect.Response[orchestrator.TargetOfEvaluation], err error) {
 	var (
 		toe     *orchestrator.TargetOfEvaluation
+		scopes  []*orchestrator.AuditScope
 		now     = timestamppb.Now()
 		allowed bool
 	)
@@ -68,7 +70,13 @@ func (svc *Service) CreateTargetOfEvaluation(
 		return nil, service.ErrPermissionDenied
 	}
 
-	// Persist the target of evaluation in the database and grant the creator admin access.
+	// Validate the custom metadata fields
+	if err = svc.validateCustomFields(toe.GetMetadata()); err != nil {
+		return nil, err
+	}
+
+	// Persist the target of evaluation in the database, grant the creator admin access, and auto-create audit scopes
+	// for all catalogs that are configured to do so.
 	err = svc.db.Transaction(func(tx persistence.DB) error {
 		if err = tx.Create(toe); err != nil {
 			return service.HandleDatabaseError(err)
@@ -78,6 +86,10 @@ func (svc *Service) CreateTargetOfEvaluation(
 			return err
 		}
 
+		if scopes, err = autoCreateAuditScopes(ctx, tx, toe); err != nil {
+			return err
+		}
+
 		return nil
 	})
 	if err = service.HandleDatabaseError(err); err != nil {
@@ -94,6 +106,17 @@ func (svc *Service) CreateTargetOfEvaluation(
 			TargetOfEvaluation: toe,
 		},
 	})
+	for _, scope := range scopes {
+		go svc.publishEvent(&orchestrator.ChangeEvent{
+			Timestamp:   timestamppb.Now(),
+			Category:    orchestrator.EventCategory_EVENT_CATEGORY_AUDIT_SCOPE,
+			RequestType: orchestrator.RequestType_REQUEST_TYPE_CREATED,
+			EntityId:    scope.Id,
+			Entity: &orchestrator.ChangeEvent_AuditScope{
+				AuditScope: scope,
+			},
+		})
+	}
 
 	res = connect.NewResponse(toe)
 	return
@@ -172,7 +195,12 @@ func (svc *Service) ListTargetsOfEvaluation(
 		conds = append(conds, "id IN ?", toeIds)
 	}
 
-	toes, npt, err = service.PaginateStorage[*orchestrator.TargetOfEvaluation](req.Msg, svc.db, service.DefaultPaginationOpts, conds...)
+	// Custom fields are stored as part of the serialized metadata, so we need to filter them in memory
+	if len(req.Msg.GetFilter().GetCustomFields()) > 0 {
+		toes, npt, err = svc.listTargetsOfEvaluationByCustomFields(req.Msg, conds...)
+	} else {
+		toes, npt, err = service.PaginateStorage[*orchestrator.TargetOfEvaluation](req.Msg, svc.db, service.DefaultPaginationOpts, conds...)
+	}
 	if err = service.HandleDatabaseError(err); err != nil {
 		return nil, err
 	}
@@ -184,6 +212,37 @@ func (svc *Service) ListTargetsOfEvaluation(
 	return
 }
 
+// listTargetsOfEvaluationByCustomFields retrieves all targets of evaluation matching conds in the requested order and
+// returns the requested page of the ones that have all custom field values of the request filter.
+func (svc *Service) listTargetsOfEvaluationByCustomFields(
+	req *orchestrator.ListTargetsOfEvaluationRequest,
+	conds ...any,
+) (page []*orchestrator.TargetOfEvaluation, npt string, err error) {
+	var (
+		all      []*orchestrator.TargetOfEvaluation
+		filtered []*orchestrator.TargetOfEvaluation
+		position map[string]int
+	)
+
+	err = svc.db.List(&all, req.GetOrderBy(), req.GetAsc(), 0, -1, conds...)
+	if err != nil {
+		return nil, "", err
+	}
+
+	position = make(map[string]int, len(all))
+	for i, toe := range all {
+		if matchesCustomFields(toe, req.GetFilter().GetCustomFields()) {
+			position[toe.Id] = i
+			filtered = append(filtered, toe)
+		}
+	}
+
+	// Keep the database order when paginating
+	return service.PaginateSlice(req, filtered, func(a *orchestrator.TargetOfEvaluation, b *orchestrator.TargetOfEvaluation) bool {
+		return position[a.Id] < position[b.Id]
+	}, service.DefaultPaginationOpts)
+}
+
 // UpdateTargetOfEvaluation updates an existing target of evaluation.
 func (svc *Service) UpdateTargetOfEvaluation(
 	ctx context.Context,
@@ -219,6 +278,11 @@ func (svc *Service) UpdateTargetOfEvaluation(
 		return nil, service.ErrPermissionDenied
 	}
 
+	// Validate the custom metadata fields
+	if err = svc.validateCustomFields(toe.GetMetadata()); err != nil {
+		return nil, err
+	}
+
 	// Update the target of evaluation
 	err = svc.db.Update(toe, "id = ?", toe.Id)
 	if err = service.HandleDatabaseError(err, service.ErrNotFound("target of evaluation")); err != nil {
@@ -264,8 +328,20 @@ func (svc *Service) RemoveTargetOfEvaluation(
 		return nil, service.ErrPermissionDenied
 	}
 
-	// Delete the target of evaluation
-	err = svc.db.Delete(&toe, "id = ?", req.Msg.TargetOfEvaluationId)
+	// Delete the target of evaluation together with its secrets and resource inventories
+	err = svc.db.Transaction(func(tx persistence.DB) (err error) {
+		err = tx.Delete(&orchestrator.Secret{}, "target_of_evaluation_id = ?", req.Msg.TargetOfEvaluationId)
+		if err != nil && !errors.Is(err, persistence.ErrRecordNotFound) {
+			return err
+		}
+
+		err = tx.Delete(&orchestrator.ResourceInventory{}, "target_of_evaluation_id = ?", req.Msg.TargetOfEvaluationId)
+		if err != nil && !errors.Is(err, persistence.ErrRecordNotFound) {
+			return err
+		}
+
+		return tx.Delete(&toe, "id = ?", req.Msg.TargetOfEvaluationId)
+	})
 	if err = service.HandleDatabaseError(err); err != nil {
 		return nil, err
 	}
@@ -367,3 +443,21 @@ func (svc *Service) CreateDefaultTargetOfEvaluation() (target *orchestrator.Targ
 
 	return
 }
+
+// checkTargetOfEvaluationAccess checks whether the current user may perform the given request type on the target of
+// evaluation with the given ID, e.g., on its secrets or resource inventories.
+func (svc *Service) checkTargetOfEvaluationAccess(ctx context.Context, reqType orchestrator.RequestType, toeId string) (err error) {
+	var allowed bool
+
+	allowed, _, err = CheckAccess(ctx, svc.authz, svc, reqType, toeId, orchestrator.ObjectType_OBJECT_TYPE_TARGET_OF_EVALUATION)
+	if err != nil {
+		return connect.NewError(connect.CodeInternal, err)
+	}
+
+	// The token might be restricted to other targets of evaluation
+	if !allowed || !service.AllowsTargetOfEvaluation(ctx, svc.authz, toeId) {
+		return service.ErrPermissionDenied
+	}
+
+	return nil
+}
@@ -0,0 +1,308 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package orchestrator
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"slices"
+
+	"confirmate.io/core/api/assessment"
+	"confirmate.io/core/api/evaluation"
+	"confirmate.io/core/api/orchestrator"
+	"confirmate.io/core/persistence"
+	"confirmate.io/core/service"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// MergeTargetsOfEvaluation merges a duplicate target of evaluation into another one. Duplicates usually arise when
+// collectors are misconfigured with different target of evaluation IDs for the same system. All entities of the
+// orchestrator that belong to the duplicate are re-pointed to the remaining target of evaluation and the duplicate is
+// removed afterwards. Assessment results keep their references to the evidences they are based on. In a dry run,
+// only the number of affected entities is returned.
+//
+// Note: Evidences held by the evidence store are not modified; collectors should be reconfigured to use the ID of the
+// remaining target of evaluation.
+func (svc *Service) MergeTargetsOfEvaluation(
+	ctx context.Context,
+	req *connect.Request[orchestrator.MergeTargetsOfEvaluationRequest],
+) (res *connect.Response[orchestrator.MergeTargetsOfEvaluationResponse], err error) {
+	var (
+		toeId       = req.Msg.GetTargetOfEvaluationId()
+		duplicateId = req.Msg.GetDuplicateTargetOfEvaluationId()
+		report      *orchestrator.MergeTargetsOfEvaluationResponse
+		allowed     bool
+	)
+
+	// Validate the request
+	if err = service.Validate(req); err != nil {
+		return nil, err
+	}
+
+	// Check access via the configured auth strategy. The remaining target of evaluation is updated, while the
+	// duplicate is removed.
+	allowed, _, err = CheckAccess(ctx, svc.authz, svc, orchestrator.RequestType_REQUEST_TYPE_UPDATED, toeId, orchestrator.ObjectType_OBJECT_TYPE_TARGET_OF_EVALUATION)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if !allowed {
+		return nil, service.ErrPermissionDenied
+	}
+
+	allowed, _, err = CheckAccess(ctx, svc.authz, svc, orchestrator.RequestType_REQUEST_TYPE_DELETED, duplicateId, orchestrator.ObjectType_OBJECT_TYPE_TARGET_OF_EVALUATION)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if !allowed {
+		return nil, service.ErrPermissionDenied
+	}
+
+	// Both targets of evaluation must exist
+	for _, id := range []string{toeId, duplicateId} {
+		err = svc.db.Get(&orchestrator.TargetOfEvaluation{}, persistence.WithoutPreload(), "id = ?", id)
+		if err = service.HandleDatabaseError(err, service.ErrNotFound("target of evaluation")); err != nil {
+			return nil, err
+		}
+	}
+
+	if req.Msg.GetDryRun() {
+		report, err = mergeTargetsOfEvaluation(svc.db, toeId, duplicateId, false)
+	} else {
+		err = svc.db.Transaction(func(tx persistence.DB) (err error) {
+			report, err = mergeTargetsOfEvaluation(tx, toeId, duplicateId, true)
+			return
+		})
+	}
+	if err = service.HandleDatabaseError(err); err != nil {
+		return nil, err
+	}
+
+	if report.Applied {
+		slog.Info("Merged targets of evaluation",
+			slog.String("target_of_evaluation_id", toeId),
+			slog.String("duplicate_target_of_evaluation_id", duplicateId),
+			slog.Int64("audit_scopes", report.NumberOfAuditScopes),
+			slog.Int64("assessment_results", report.NumberOfAssessmentResults),
+			slog.Int64("evaluation_results", report.NumberOfEvaluationResults),
+		)
+
+		// Notify subscribers
+		go svc.publishEvent(&orchestrator.ChangeEvent{
+			Timestamp:   timestamppb.Now(),
+			Category:    orchestrator.EventCategory_EVENT_CATEGORY_TARGET_OF_EVALUATION,
+			RequestType: orchestrator.RequestType_REQUEST_TYPE_UPDATED,
+			EntityId:    toeId,
+		})
+		go svc.publishEvent(&orchestrator.ChangeEvent{
+			Timestamp:   timestamppb.Now(),
+			Category:    orchestrator.EventCategory_EVENT_CATEGORY_TARGET_OF_EVALUATION,
+			RequestType: orchestrator.RequestType_REQUEST_TYPE_DELETED,
+			EntityId:    duplicateId,
+		})
+	}
+
+	res = connect.NewResponse(report)
+	return
+}
+
+// mergedEntities describes entities that are moved by setting their target of evaluation ID.
+type mergedEntities struct {
+	// model is the model of the entities, already pointing to the remaining target of evaluation
+	model any
+	// count receives the number of moved entities
+	count *int64
+}
+
+// mergeTargetsOfEvaluation counts the entities of the duplicate target of evaluation that are moved to the target of
+// evaluation with the given ID. If apply is set, the entities are moved and the duplicate is removed.
+func mergeTargetsOfEvaluation(db persistence.DB, toeId string, duplicateId string, apply bool) (
+	report *orchestrator.MergeTargetsOfEvaluationResponse,
+	err error,
+) {
+	report = &orchestrator.MergeTargetsOfEvaluationResponse{Applied: apply}
+
+	moves := []mergedEntities{
+		{&orchestrator.AuditScope{TargetOfEvaluationId: toeId}, &report.NumberOfAuditScopes},
+		{&orchestrator.ControlInScope{TargetOfEvaluationId: toeId}, &report.NumberOfControlsInScope},
+		{&assessment.AssessmentResult{TargetOfEvaluationId: toeId}, &report.NumberOfAssessmentResults},
+		{&evaluation.EvaluationResult{TargetOfEvaluationId: toeId}, &report.NumberOfEvaluationResults},
+		{&orchestrator.Certificate{TargetOfEvaluationId: toeId}, &report.NumberOfCertificates},
+	}
+
+	for _, m := range moves {
+		*m.count, err = db.Count(m.model, "target_of_evaluation_id = ?", duplicateId)
+		if err != nil {
+			return nil, err
+		}
+
+		if apply && *m.count > 0 {
+			if err = db.Update(m.model, "target_of_evaluation_id = ?", duplicateId); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if err = mergeMetricConfigurations(db, toeId, duplicateId, apply, report); err != nil {
+		return nil, err
+	}
+
+	if err = mergeUserPermissions(db, toeId, duplicateId, apply, report); err != nil {
+		return nil, err
+	}
+
+	if err = mergeSecrets(db, toeId, duplicateId, apply, report); err != nil {
+		return nil, err
+	}
+
+	// The resource inventories of the duplicate are not moved, since its collectors report to the remaining target of
+	// evaluation from now on
+	if apply {
+		err = db.Delete(&orchestrator.ResourceInventory{}, "target_of_evaluation_id = ?", duplicateId)
+		if err != nil && !errors.Is(err, persistence.ErrRecordNotFound) {
+			return nil, err
+		}
+
+		err = db.Delete(&orchestrator.TargetOfEvaluation{}, "id = ?", duplicateId)
+	}
+
+	return
+}
+
+// mergeMetricConfigurations moves the metric configurations of the duplicate target of evaluation to the target of
+// evaluation with the given ID. Since the target of evaluation and the metric form the primary key of a configuration,
+// configurations of metrics that are already configured for the remaining target of evaluation are dropped.
+func mergeMetricConfigurations(db persistence.DB, toeId string, duplicateId string, apply bool, report *orchestrator.MergeTargetsOfEvaluationResponse) (err error) {
+	var (
+		configs  []*assessment.MetricConfiguration
+		existing []string
+	)
+
+	err = db.List(&configs, "metric_id", true, 0, -1, persistence.WithoutPreload(), "target_of_evaluation_id = ?", duplicateId)
+	if err != nil || len(configs) == 0 {
+		return err
+	}
+
+	err = db.Pluck(&assessment.MetricConfiguration{}, "metric_id", &existing, "target_of_evaluation_id = ?", toeId)
+	if err != nil {
+		return err
+	}
+
+	for _, config := range configs {
+		if slices.Contains(existing, config.GetMetricId()) {
+			continue
+		}
+
+		report.NumberOfMetricConfigurations++
+
+		if apply {
+			config.TargetOfEvaluationId = toeId
+			if err = db.Create(config); err != nil {
+				return err
+			}
+		}
+	}
+
+	if apply {
+		err = db.Delete(&assessment.MetricConfiguration{}, "target_of_evaluation_id = ?", duplicateId)
+	}
+
+	return
+}
+
+// mergeUserPermissions moves the user permissions of the duplicate target of evaluation to the target of evaluation
+// with the given ID. Permissions of users that already have a permission for the remaining target of evaluation are
+// dropped, so that existing permissions are never widened.
+func mergeUserPermissions(db persistence.DB, toeId string, duplicateId string, apply bool, report *orchestrator.MergeTargetsOfEvaluationResponse) (err error) {
+	var (
+		permissions []*orchestrator.UserPermission
+		existing    []string
+		objectType  = orchestrator.ObjectType_OBJECT_TYPE_TARGET_OF_EVALUATION
+	)
+
+	err = db.List(&permissions, "user_id", true, 0, -1, persistence.WithoutPreload(), "object_id = ? AND object_type = ?", duplicateId, objectType)
+	if err != nil || len(permissions) == 0 {
+		return err
+	}
+
+	err = db.Pluck(&orchestrator.UserPermission{}, "user_id", &existing, "object_id = ? AND object_type = ?", toeId, objectType)
+	if err != nil {
+		return err
+	}
+
+	for _, permission := range permissions {
+		if slices.Contains(existing, permission.GetUserId()) {
+			continue
+		}
+
+		report.NumberOfUserPermissions++
+
+		if apply {
+			permission.ObjectId = toeId
+			if err = db.Create(permission); err != nil {
+				return err
+			}
+		}
+	}
+
+	if apply {
+		err = db.Delete(&orchestrator.UserPermission{}, "object_id = ? AND object_type = ?", duplicateId, objectType)
+	}
+
+	return
+}
+
+// mergeSecrets moves the secrets of the duplicate target of evaluation to the target of evaluation with the given ID.
+// Since the name of a secret is unique per target of evaluation, secrets whose name is already used by the remaining
+// target of evaluation are dropped. The encrypted values are bound to the ID of the secret and therefore stay valid.
+func mergeSecrets(db persistence.DB, toeId string, duplicateId string, apply bool, report *orchestrator.MergeTargetsOfEvaluationResponse) (err error) {
+	var (
+		secrets  []*orchestrator.Secret
+		existing []string
+	)
+
+	err = db.List(&secrets, "name", true, 0, -1, "target_of_evaluation_id = ?", duplicateId)
+	if err != nil || len(secrets) == 0 {
+		return err
+	}
+
+	err = db.Pluck(&orchestrator.Secret{}, "name", &existing, "target_of_evaluation_id = ?", toeId)
+	if err != nil {
+		return err
+	}
+
+	for _, secret := range secrets {
+		switch {
+		case slices.Contains(existing, secret.GetName()):
+			if apply {
+				err = db.Delete(&orchestrator.Secret{}, "id = ?", secret.GetId())
+			}
+		default:
+			report.NumberOfSecrets++
+
+			if apply {
+				err = db.Update(&orchestrator.Secret{TargetOfEvaluationId: toeId}, "id = ?", secret.GetId())
+			}
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return
+}
@@ -0,0 +1,297 @@
+// Copyright 2016-2025 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package orchestrator
+
+import (
+	"context"
+	"testing"
+
+	"confirmate.io/core/api/assessment"
+	"confirmate.io/core/api/orchestrator"
+	"confirmate.io/core/persistence"
+	"confirmate.io/core/persistence/persistencetest"
+	"confirmate.io/core/service"
+	"confirmate.io/core/service/orchestrator/orchestratortest"
+	"confirmate.io/core/util/assert"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/proto"
+)
+
+// mockMergeRecords returns two targets of evaluation, where the second one is a duplicate of the first one.
+func mockMergeRecords() []any {
+	var result = proto.CloneOf(orchestratortest.MockAssessmentResult1)
+
+	result.TargetOfEvaluationId = orchestratortest.MockToeId2
+
+	return []any{
+		orchestratortest.MockMetric1,
+		orchestratortest.MockMetric2,
+		orchestratortest.MockTargetOfEvaluation1,
+		orchestratortest.MockTargetOfEvaluation2,
+		orchestratortest.MockAuditScope1,
+		orchestratortest.MockAuditScope2,
+		orchestratortest.MockCertificate2,
+		result,
+		&assessment.MetricConfiguration{
+			TargetOfEvaluationId: orchestratortest.MockToeId1,
+			MetricId:             orchestratortest.MockMetricId1,
+			Operator:             "==",
+		},
+		// The configuration of the first metric conflicts with the existing one and is dropped
+		&assessment.MetricConfiguration{
+			TargetOfEvaluationId: orchestratortest.MockToeId2,
+			MetricId:             orchestratortest.MockMetricId1,
+			Operator:             "<",
+		},
+		&assessment.MetricConfiguration{
+			TargetOfEvaluationId: orchestratortest.MockToeId2,
+			MetricId:             orchestratortest.MockMetricId2,
+			Operator:             ">",
+		},
+		orchestratortest.MockUserPermissionsToEAdmin,
+		// The permission of the first user is dropped, since the user already has access to the first target
+		&orchestrator.UserPermission{
+			UserId:     orchestratortest.MockUserPermissionsToEAdmin.UserId,
+			ObjectId:   orchestratortest.MockToeId2,
+			ObjectType: orchestrator.ObjectType_OBJECT_TYPE_TARGET_OF_EVALUATION,
+			Permission: orchestrator.UserPermission_PERMISSION_READER,
+		},
+		&orchestrator.UserPermission{
+			UserId:     orchestratortest.MockUser2.Id,
+			ObjectId:   orchestratortest.MockToeId2,
+			ObjectType: orchestrator.ObjectType_OBJECT_TYPE_TARGET_OF_EVALUATION,
+			Permission: orchestrator.UserPermission_PERMISSION_CONTRIBUTOR,
+		},
+		&orchestrator.Secret{
+			Id:                   "00000000-0000-0000-0011-000000000011",
+			TargetOfEvaluationId: orchestratortest.MockToeId1,
+			Name:                 "aws-read-only",
+		},
+		// The secret with the same name conflicts with the existing one and is dropped
+		&orchestrator.Secret{
+			Id:                   "00000000-0000-0000-0011-000000000012",
+			TargetOfEvaluationId: orchestratortest.MockToeId2,
+			Name:                 "aws-read-only",
+		},
+		&orchestrator.Secret{
+			Id:                   "00000000-0000-0000-0011-000000000013",
+			TargetOfEvaluationId: orchestratortest.MockToeId2,
+			Name:                 "github-token",
+		},
+	}
+}
+
+func TestService_MergeTargetsOfEvaluation(t *testing.T) {
+	type args struct {
+		req *orchestrator.MergeTargetsOfEvaluationRequest
+	}
+	type fields struct {
+		db    persistence.DB
+		authz service.AuthorizationStrategy
+	}
+	tests := []struct {
+		name    string
+		args    args
+		fields  fields
+		want    assert.Want[*connect.Response[orchestrator.MergeTargetsOfEvaluationResponse]]
+		wantErr assert.WantErr
+		wantDB  assert.Want[persistence.DB]
+	}{
+		{
+			name: "happy path: merge",
+			args: args{
+				req: &orchestrator.MergeTargetsOfEvaluationRequest{
+					TargetOfEvaluationId:          orchestratortest.MockToeId1,
+					DuplicateTargetOfEvaluationId: orchestratortest.MockToeId2,
+				},
+			},
+			fields: fields{
+				db:    persistencetest.NewInMemoryDB(t, types, joinTables, createRecords(t, mockMergeRecords()...)),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			want: func(t *testing.T, got *connect.Response[orchestrator.MergeTargetsOfEvaluationResponse], args ...any) bool {
+				return assert.Equal(t, &orchestrator.MergeTargetsOfEvaluationResponse{
+					NumberOfAuditScopes:          1,
+					NumberOfAssessmentResults:    1,
+					NumberOfCertificates:         1,
+					NumberOfMetricConfigurations: 1,
+					NumberOfUserPermissions:      1,
+					NumberOfSecrets:              1,
+					Applied:                      true,
+				}, got.Msg)
+			},
+			wantErr: assert.NoError,
+			wantDB: func(t *testing.T, db persistence.DB, msgAndArgs ...any) bool {
+				var (
+					scopes      []*orchestrator.AuditScope
+					configs     []*assessment.MetricConfiguration
+					permissions []*orchestrator.UserPermission
+					result      assessment.AssessmentResult
+					count       int64
+					err         error
+				)
+
+				err = db.Get(&orchestrator.TargetOfEvaluation{}, "id = ?", orchestratortest.MockToeId2)
+				assert.ErrorIs(t, err, persistence.ErrRecordNotFound)
+
+				err = db.List(&scopes, "id", true, 0, -1, "target_of_evaluation_id = ?", orchestratortest.MockToeId1)
+				assert.NoError(t, err)
+				assert.Equal(t, 2, len(scopes))
+
+				err = db.Get(&result, "id = ?", orchestratortest.MockResultId1)
+				assert.NoError(t, err)
+				assert.Equal(t, orchestratortest.MockToeId1, result.TargetOfEvaluationId)
+				assert.Equal(t, orchestratortest.MockEvidenceId1, result.EvidenceId)
+
+				count, err = db.Count(&orchestrator.Certificate{}, "target_of_evaluation_id = ?", orchestratortest.MockToeId1)
+				assert.NoError(t, err)
+				assert.Equal(t, int64(1), count)
+
+				err = db.List(&configs, "metric_id", true, 0, -1, persistence.WithoutPreload(), "target_of_evaluation_id = ?", orchestratortest.MockToeId1)
+				assert.NoError(t, err)
+				assert.Equal(t, 2, len(configs))
+				assert.Equal(t, "==", configs[0].Operator)
+				assert.Equal(t, ">", configs[1].Operator)
+
+				count, err = db.Count(&assessment.MetricConfiguration{}, "target_of_evaluation_id = ?", orchestratortest.MockToeId2)
+				assert.NoError(t, err)
+				assert.Equal(t, int64(0), count)
+
+				err = db.List(&permissions, "user_id", true, 0, -1, "object_id = ?", orchestratortest.MockToeId1)
+				assert.NoError(t, err)
+				assert.Equal(t, 2, len(permissions))
+
+				count, err = db.Count(&orchestrator.UserPermission{}, "object_id = ?", orchestratortest.MockToeId2)
+				assert.NoError(t, err)
+				assert.Equal(t, int64(0), count)
+
+				count, err = db.Count(&orchestrator.Secret{}, "target_of_evaluation_id = ?", orchestratortest.MockToeId1)
+				assert.NoError(t, err)
+				assert.Equal(t, int64(2), count)
+
+				count, err = db.Count(&orchestrator.Secret{}, "target_of_evaluation_id = ?", orchestratortest.MockToeId2)
+				assert.NoError(t, err)
+				return assert.Equal(t, int64(0), count)
+			},
+		},
+		{
+			name: "happy path: dry run",
+			args: args{
+				req: &orchestrator.MergeTargetsOfEvaluationRequest{
+					TargetOfEvaluationId:          orchestratortest.MockToeId1,
+					DuplicateTargetOfEvaluationId: orchestratortest.MockToeId2,
+					DryRun:                        true,
+				},
+			},
+			fields: fields{
+				db:    persistencetest.NewInMemoryDB(t, types, joinTables, createRecords(t, mockMergeRecords()...)),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			want: func(t *testing.T, got *connect.Response[orchestrator.MergeTargetsOfEvaluationResponse], args ...any) bool {
+				return assert.Equal(t, &orchestrator.MergeTargetsOfEvaluationResponse{
+					NumberOfAuditScopes:          1,
+					NumberOfAssessmentResults:    1,
+					NumberOfCertificates:         1,
+					NumberOfMetricConfigurations: 1,
+					NumberOfUserPermissions:      1,
+					NumberOfSecrets:              1,
+				}, got.Msg)
+			},
+			wantErr: assert.NoError,
+			wantDB: func(t *testing.T, db persistence.DB, msgAndArgs ...any) bool {
+				err := db.Get(&orchestrator.TargetOfEvaluation{}, "id = ?", orchestratortest.MockToeId2)
+				assert.NoError(t, err)
+
+				count, err := db.Count(&orchestrator.AuditScope{}, "target_of_evaluation_id = ?", orchestratortest.MockToeId2)
+				assert.NoError(t, err)
+				return assert.Equal(t, int64(1), count)
+			},
+		},
+		{
+			name: "validation error: same target of evaluation",
+			args: args{
+				req: &orchestrator.MergeTargetsOfEvaluationRequest{
+					TargetOfEvaluationId:          orchestratortest.MockToeId1,
+					DuplicateTargetOfEvaluationId: orchestratortest.MockToeId1,
+				},
+			},
+			fields: fields{
+				db: persistencetest.NewInMemoryDB(t, types, joinTables),
+			},
+			want: assert.Nil[*connect.Response[orchestrator.MergeTargetsOfEvaluationResponse]],
+			wantErr: func(t *testing.T, err error, args ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeInvalidArgument) &&
+					assert.ErrorContains(t, err, "cannot be merged into itself")
+			},
+			wantDB: assert.AnyValue[persistence.DB],
+		},
+		{
+			name: "authorization failure",
+			args: args{
+				req: &orchestrator.MergeTargetsOfEvaluationRequest{
+					TargetOfEvaluationId:          orchestratortest.MockToeId1,
+					DuplicateTargetOfEvaluationId: orchestratortest.MockToeId2,
+				},
+			},
+			fields: fields{
+				db:    persistencetest.NewInMemoryDB(t, types, joinTables, createRecords(t, mockMergeRecords()...)),
+				authz: &denyAuthorizationStrategy{},
+			},
+			want: assert.Nil[*connect.Response[orchestrator.MergeTargetsOfEvaluationResponse]],
+			wantErr: func(t *testing.T, err error, args ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodePermissionDenied)
+			},
+			wantDB: func(t *testing.T, db persistence.DB, msgAndArgs ...any) bool {
+				err := db.Get(&orchestrator.TargetOfEvaluation{}, "id = ?", orchestratortest.MockToeId2)
+				return assert.NoError(t, err)
+			},
+		},
+		{
+			name: "not found: duplicate target of evaluation",
+			args: args{
+				req: &orchestrator.MergeTargetsOfEvaluationRequest{
+					TargetOfEvaluationId:          orchestratortest.MockToeId1,
+					DuplicateTargetOfEvaluationId: orchestratortest.MockToeId2,
+				},
+			},
+			fields: fields{
+				db: persistencetest.NewInMemoryDB(t, types, joinTables, func(d persistence.DB) {
+					assert.NoError(t, d.Create(orchestratortest.MockTargetOfEvaluation1))
+				}),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			want: assert.Nil[*connect.Response[orchestrator.MergeTargetsOfEvaluationResponse]],
+			wantErr: func(t *testing.T, err error, args ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeNotFound)
+			},
+			wantDB: assert.AnyValue[persistence.DB],
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &Service{
+				db:    tt.fields.db,
+				authz: tt.fields.authz,
+			}
+			res, err := svc.MergeTargetsOfEvaluation(context.Background(), connect.NewRequest(tt.args.req))
+			tt.want(t, res)
+			tt.wantErr(t, err)
+			tt.wantDB(t, tt.fields.db, res)
+		})
+	}
+}
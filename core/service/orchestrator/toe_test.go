@@ -126,6 +126,74 @@ func TestService_CreateTargetOfEvaluation(t *testing.T) {
 					assert.Equal(t, int64(1), count)
 			},
 		},
+		{
+			name: "happy path: auto-creates audit scopes",
+			args: args{
+				req: &orchestrator.CreateTargetOfEvaluationRequest{
+					TargetOfEvaluation: orchestratortest.MockTargetOfEvaluation1,
+				},
+			},
+			fields: fields{
+				db: persistencetest.NewInMemoryDB(t, types, joinTables, func(d persistence.DB) {
+					// The draft binds its latest published version with the configured assurance level
+					assert.NoError(t, d.Create(&orchestrator.Catalog{
+						Id:                           orchestratortest.MockCatalogId1,
+						Name:                         "Catalog 1",
+						ShortName:                    "C1",
+						AssuranceLevels:              []string{"basic", "high"},
+						Status:                       orchestrator.CatalogStatus_CATALOG_STATUS_DRAFT,
+						Version:                      1,
+						AutoCreateAuditScopes:        new(true),
+						AutoAuditScopeAssuranceLevel: new("high"),
+					}))
+					assert.NoError(t, d.Create(&orchestrator.Catalog{
+						Id:                           catalogVersionId(orchestratortest.MockCatalogId1, 1),
+						Name:                         "Catalog 1",
+						ShortName:                    "C1",
+						AssuranceLevels:              []string{"basic", "high"},
+						Status:                       orchestrator.CatalogStatus_CATALOG_STATUS_PUBLISHED,
+						Version:                      1,
+						DraftId:                      new(orchestratortest.MockCatalogId1),
+						AutoCreateAuditScopes:        new(true),
+						AutoAuditScopeAssuranceLevel: new("high"),
+					}))
+					// A draft that was never published is skipped
+					assert.NoError(t, d.Create(&orchestrator.Catalog{
+						Id:                    orchestratortest.MockCatalogId2,
+						Name:                  "Catalog 2",
+						ShortName:             "C2",
+						Status:                orchestrator.CatalogStatus_CATALOG_STATUS_DRAFT,
+						AutoCreateAuditScopes: new(true),
+					}))
+					// A catalog that does not auto-create audit scopes is skipped
+					assert.NoError(t, d.Create(&orchestrator.Catalog{
+						Id:        orchestratortest.MockCatalogId3,
+						Name:      "Catalog 3",
+						ShortName: "C3",
+					}))
+				}),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			want: func(t *testing.T, got *connect.Response[orchestrator.TargetOfEvaluation], args ...any) bool {
+				return assert.NotEmpty(t, got.Msg.Id)
+			},
+			wantErr: assert.NoError,
+			wantDB: func(t *testing.T, db persistence.DB, msgAndArgs ...any) bool {
+				var scopes []*orchestrator.AuditScope
+
+				res := assert.Is[*connect.Response[orchestrator.TargetOfEvaluation]](t, msgAndArgs[0])
+				assert.NotNil(t, res)
+
+				err := db.List(&scopes, "", true, 0, -1, persistence.WithoutPreload(), "target_of_evaluation_id = ?", res.Msg.Id)
+				assert.NoError(t, err)
+
+				return assert.Equal(t, 1, len(scopes)) &&
+					assert.Equal(t, catalogVersionId(orchestratortest.MockCatalogId1, 1), scopes[0].CatalogId) &&
+					assert.Equal(t, "high", scopes[0].GetAssuranceLevel()) &&
+					assert.Equal(t, orchestrator.AuditScopeStatus_AUDIT_SCOPE_STATUS_SETUP, scopes[0].Status) &&
+					assert.Equal(t, orchestratortest.MockTargetOfEvaluation1.Name+" (C1)", scopes[0].Name)
+			},
+		},
 		{
 			name: "happy path: with organization details",
 			args: args{
@@ -298,6 +366,51 @@ func TestService_CreateTargetOfEvaluation(t *testing.T) {
 			},
 			wantDB: assert.NotNil[persistence.DB],
 		},
+		{
+			name: "invalid argument - unknown custom field",
+			args: args{
+				req: &orchestrator.CreateTargetOfEvaluationRequest{
+					TargetOfEvaluation: &orchestrator.TargetOfEvaluation{
+						Name: "test-toe",
+						Metadata: &orchestrator.TargetOfEvaluation_Metadata{
+							CustomFields: map[string]string{"criticality": "high"},
+						},
+					},
+				},
+			},
+			fields: fields{
+				db:    persistencetest.NewInMemoryDB(t, types, joinTables),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			want: assert.Nil[*connect.Response[orchestrator.TargetOfEvaluation]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeInvalidArgument) &&
+					assert.ErrorContains(t, err, "unknown custom field")
+			},
+			wantDB: assert.NotNil[persistence.DB],
+		},
+		{
+			name: "invalid argument - missing required custom field",
+			args: args{
+				req: &orchestrator.CreateTargetOfEvaluationRequest{
+					TargetOfEvaluation: &orchestrator.TargetOfEvaluation{
+						Name: "test-toe",
+					},
+				},
+			},
+			fields: fields{
+				db: persistencetest.NewInMemoryDB(t, types, joinTables, func(d persistence.DB) {
+					assert.NoError(t, d.Create(mockMetadataFieldCostCenter))
+				}),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			want: assert.Nil[*connect.Response[orchestrator.TargetOfEvaluation]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeInvalidArgument) &&
+					assert.ErrorContains(t, err, "is required")
+			},
+			wantDB: assert.NotNil[persistence.DB],
+		},
 		{
 			name: "db error - unique constraint",
 			args: args{
@@ -588,6 +701,37 @@ func TestService_ListTargetsOfEvaluation(t *testing.T) {
 			},
 			wantErr: assert.NoError,
 		},
+		{
+			name: "happy path: filter by custom fields",
+			args: args{
+				req: &orchestrator.ListTargetsOfEvaluationRequest{
+					Filter: &orchestrator.ListTargetsOfEvaluationRequest_Filter{
+						CustomFields: map[string]string{"criticality": "high"},
+					},
+				},
+			},
+			fields: fields{
+				db: persistencetest.NewInMemoryDB(t, types, joinTables, func(d persistence.DB) {
+					err := d.Create(orchestratortest.MockTargetOfEvaluation1)
+					assert.NoError(t, err)
+					err = d.Create(&orchestrator.TargetOfEvaluation{
+						Id:         orchestratortest.MockToeId2,
+						Name:       "Mock TOE 2",
+						TargetType: orchestrator.TargetOfEvaluation_TARGET_TYPE_CLOUD,
+						Metadata: &orchestrator.TargetOfEvaluation_Metadata{
+							CustomFields: map[string]string{"criticality": "high"},
+						},
+					})
+					assert.NoError(t, err)
+				}),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			want: func(t *testing.T, got *connect.Response[orchestrator.ListTargetsOfEvaluationResponse], args ...any) bool {
+				return assert.Equal(t, 1, len(got.Msg.TargetsOfEvaluation)) &&
+					assert.Equal(t, orchestratortest.MockToeId2, got.Msg.TargetsOfEvaluation[0].Id)
+			},
+			wantErr: assert.NoError,
+		},
 		{
 			name: "happy path: with authorization strategy with permission store and admin token",
 			args: args{
@@ -897,6 +1041,27 @@ func TestService_RemoveTargetOfEvaluation(t *testing.T) {
 				return true
 			},
 		},
+		{
+			name: "happy path: removes secrets",
+			args: args{
+				req: &orchestrator.RemoveTargetOfEvaluationRequest{
+					TargetOfEvaluationId: orchestratortest.MockTargetOfEvaluation1.Id,
+				},
+			},
+			fields: fields{
+				db:    persistencetest.NewInMemoryDB(t, types, joinTables, createRecords(t, mockSecretRecords(t)...)),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			want: func(t *testing.T, got *connect.Response[emptypb.Empty], args ...any) bool {
+				return assert.Empty(t, got.Msg)
+			},
+			wantErr: assert.NoError,
+			wantDB: func(t *testing.T, db persistence.DB, msgAndArgs ...any) bool {
+				count, err := db.Count(&orchestrator.Secret{}, "target_of_evaluation_id = ?", orchestratortest.MockTargetOfEvaluation1.Id)
+				assert.NoError(t, err)
+				return assert.Equal(t, int64(0), count)
+			},
+		},
 		{
 			name: "happy path: with authorization strategy with permission store and admin token",
 			args: args{
@@ -0,0 +1,251 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package orchestrator
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	"confirmate.io/core/api/assessment"
+	"confirmate.io/core/api/evaluation"
+	"confirmate.io/core/api/orchestrator"
+	"confirmate.io/core/log"
+	"confirmate.io/core/persistence"
+	"confirmate.io/core/service"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/proto"
+)
+
+// usageDayFormat is the format of the day of a [orchestrator.UsageRecord].
+const usageDayFormat = time.DateOnly
+
+// GetUsageReport reports the metered usage of all targets of evaluation the user has access to, summed up per target
+// of evaluation and broken down per day.
+func (svc *Service) GetUsageReport(
+	ctx context.Context,
+	req *connect.Request[orchestrator.GetUsageReportRequest],
+) (res *connect.Response[orchestrator.UsageReport], err error) {
+	var (
+		query   []string
+		args    []any
+		all     bool
+		toeIds  []string
+		records []*orchestrator.UsageRecord
+	)
+
+	// Validate the request
+	if err = service.Validate(req); err != nil {
+		return nil, err
+	}
+
+	if req.Msg.TargetOfEvaluationId != nil {
+		// Check access via the configured auth strategy
+		err = svc.checkTargetOfEvaluationAccess(ctx, orchestrator.RequestType_REQUEST_TYPE_GET, req.Msg.GetTargetOfEvaluationId())
+		if err != nil {
+			return nil, err
+		}
+
+		query = append(query, "target_of_evaluation_id = ?")
+		args = append(args, req.Msg.GetTargetOfEvaluationId())
+	} else {
+		// Retrieve list of all allowed ToE IDs for the user to restrict the report to them
+		all, toeIds = svc.authz.AllowedTargetOfEvaluations(ctx)
+		if !all && len(toeIds) == 0 {
+			return connect.NewResponse(newUsageReport(nil)), nil
+		}
+		if !all {
+			query = append(query, "target_of_evaluation_id IN ?")
+			args = append(args, toeIds)
+		}
+	}
+
+	// Days in the format YYYY-MM-DD can be compared as strings
+	if req.Msg.StartDay != nil {
+		query = append(query, "day >= ?")
+		args = append(args, req.Msg.GetStartDay())
+	}
+	if req.Msg.EndDay != nil {
+		query = append(query, "day <= ?")
+		args = append(args, req.Msg.GetEndDay())
+	}
+
+	if len(query) > 0 {
+		err = svc.db.List(&records, "day", true, 0, -1, append([]any{strings.Join(query, " AND ")}, args...)...)
+	} else {
+		err = svc.db.List(&records, "day", true, 0, -1)
+	}
+	if err = service.HandleDatabaseError(err); err != nil {
+		return nil, err
+	}
+
+	res = connect.NewResponse(newUsageReport(records))
+	return
+}
+
+// newUsageReport sums up the usage records, which must be sorted by their day, per target of evaluation.
+func newUsageReport(records []*orchestrator.UsageRecord) (report *orchestrator.UsageReport) {
+	var index = make(map[string]*orchestrator.TargetOfEvaluationUsage)
+
+	report = &orchestrator.UsageReport{
+		TargetsOfEvaluation: []*orchestrator.TargetOfEvaluationUsage{},
+	}
+
+	for _, r := range records {
+		usage, ok := index[r.GetTargetOfEvaluationId()]
+		if !ok {
+			usage = &orchestrator.TargetOfEvaluationUsage{
+				TargetOfEvaluationId: r.GetTargetOfEvaluationId(),
+				Days:                 []*orchestrator.UsageRecord{},
+			}
+			index[r.GetTargetOfEvaluationId()] = usage
+			report.TargetsOfEvaluation = append(report.TargetsOfEvaluation, usage)
+		}
+
+		usage.Evidences += r.GetEvidences()
+		usage.AssessmentResults += r.GetAssessmentResults()
+		usage.EvaluationRuns += r.GetEvaluationRuns()
+		usage.StorageBytes += r.GetStorageBytes()
+		usage.Days = append(usage.Days, r)
+	}
+
+	slices.SortFunc(report.TargetsOfEvaluation, func(a *orchestrator.TargetOfEvaluationUsage, b *orchestrator.TargetOfEvaluationUsage) int {
+		return cmp.Compare(a.GetTargetOfEvaluationId(), b.GetTargetOfEvaluationId())
+	})
+
+	return report
+}
+
+// meterUsage adds the usage to the record of the target of evaluation on the day of now (UTC), creating the record if
+// necessary. Metering must not fail the request that produced the usage, so errors are only logged.
+func (svc *Service) meterUsage(toeId string, now time.Time, usage *orchestrator.UsageRecord) {
+	// Serialize the metering, so that concurrent requests do not create the same record twice
+	svc.usageMutex.Lock()
+	defer svc.usageMutex.Unlock()
+
+	err := svc.db.Transaction(func(tx persistence.DB) error {
+		var (
+			record orchestrator.UsageRecord
+			day    = now.UTC().Format(usageDayFormat)
+		)
+
+		err := tx.Get(&record, "target_of_evaluation_id = ? AND day = ?", toeId, day)
+		if errors.Is(err, persistence.ErrRecordNotFound) {
+			record = orchestrator.UsageRecord{TargetOfEvaluationId: toeId, Day: day}
+		} else if err != nil {
+			return err
+		}
+
+		record.Evidences += usage.GetEvidences()
+		record.AssessmentResults += usage.GetAssessmentResults()
+		record.EvaluationRuns += usage.GetEvaluationRuns()
+		record.StorageBytes += usage.GetStorageBytes()
+
+		return tx.Save(&record)
+	})
+	if err != nil {
+		slog.Warn("Could not meter usage", slog.String("target_of_evaluation_id", toeId), log.Err(err))
+	}
+}
+
+// meterAssessmentResults meters the usage of stored assessment results. An evidence is metered with its first stored
+// assessment results, i.e., if no other results of the evidence exist than the given ones. The results must already be
+// stored.
+func (svc *Service) meterAssessmentResults(results []*assessment.AssessmentResult, now time.Time) {
+	var (
+		usage     = make(map[string]*orchestrator.UsageRecord)
+		evidences = make(map[string]int64)
+		toeIds    []string
+		count     int64
+		err       error
+	)
+
+	for _, result := range results {
+		evidences[result.GetEvidenceId()]++
+	}
+
+	for _, result := range results {
+		u, ok := usage[result.GetTargetOfEvaluationId()]
+		if !ok {
+			u = &orchestrator.UsageRecord{}
+			usage[result.GetTargetOfEvaluationId()] = u
+			toeIds = append(toeIds, result.GetTargetOfEvaluationId())
+		}
+
+		u.AssessmentResults++
+		u.StorageBytes += int64(proto.Size(result))
+
+		// Check each evidence only once
+		n, ok := evidences[result.GetEvidenceId()]
+		if !ok {
+			continue
+		}
+		delete(evidences, result.GetEvidenceId())
+
+		count, err = svc.db.Count(&assessment.AssessmentResult{}, "evidence_id = ?", result.GetEvidenceId())
+		if err != nil {
+			slog.Warn("Could not meter evidence", slog.String("evidence_id", result.GetEvidenceId()), log.Err(err))
+		} else if count == n {
+			u.Evidences++
+		}
+	}
+
+	for _, toeId := range toeIds {
+		svc.meterUsage(toeId, now, usage[toeId])
+	}
+}
+
+// meterEvaluationResult meters the usage of a stored evaluation result. An evaluation run is metered with its first
+// stored result. The result must already be stored.
+func (svc *Service) meterEvaluationResult(eval *evaluation.EvaluationResult) {
+	var (
+		usage = &orchestrator.UsageRecord{StorageBytes: int64(proto.Size(eval))}
+		count int64
+		err   error
+	)
+
+	if eval.RunId != nil {
+		count, err = svc.db.Count(&evaluation.EvaluationResult{}, "run_id = ?", eval.GetRunId())
+		if err != nil {
+			slog.Warn("Could not meter evaluation run", slog.String("run_id", eval.GetRunId()), log.Err(err))
+		} else if count == 1 {
+			usage.EvaluationRuns = 1
+		}
+	}
+
+	svc.meterUsage(eval.GetTargetOfEvaluationId(), eval.GetTimestamp().AsTime(), usage)
+}
+
+// meterAttachment meters the usage of a stored attachment, which is attributed to the target of evaluation of its
+// evaluation result.
+func (svc *Service) meterAttachment(att *evaluation.Attachment) {
+	var eval evaluation.EvaluationResult
+
+	err := svc.db.Get(&eval, persistence.WithoutPreload(), "id = ?", att.GetEvaluationResultId())
+	if err != nil {
+		slog.Warn("Could not meter attachment", slog.String("attachment_id", att.GetId()), log.Err(err))
+		return
+	}
+
+	svc.meterUsage(eval.GetTargetOfEvaluationId(), att.GetCreatedAt().AsTime(), &orchestrator.UsageRecord{
+		StorageBytes: att.GetSize(),
+	})
+}
@@ -0,0 +1,250 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package orchestrator
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"confirmate.io/core/api/assessment"
+	"confirmate.io/core/api/evaluation"
+	"confirmate.io/core/api/orchestrator"
+	"confirmate.io/core/auth"
+	"confirmate.io/core/persistence"
+	"confirmate.io/core/persistence/persistencetest"
+	"confirmate.io/core/service"
+	"confirmate.io/core/service/orchestrator/orchestratortest"
+	"confirmate.io/core/util/assert"
+
+	"connectrpc.com/connect"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// mockUsageRecords returns the usage of two days of the first target of evaluation and of one day of the second target
+// of evaluation.
+func mockUsageRecords() []any {
+	return []any{
+		&orchestrator.UsageRecord{TargetOfEvaluationId: orchestratortest.MockToeId1, Day: "2026-01-01",
+			Evidences: 1, AssessmentResults: 2, EvaluationRuns: 1, StorageBytes: 100},
+		&orchestrator.UsageRecord{TargetOfEvaluationId: orchestratortest.MockToeId1, Day: "2026-01-02",
+			Evidences: 2, AssessmentResults: 4, StorageBytes: 200},
+		&orchestrator.UsageRecord{TargetOfEvaluationId: orchestratortest.MockToeId2, Day: "2026-01-01",
+			EvaluationRuns: 3, StorageBytes: 50},
+	}
+}
+
+func TestService_GetUsageReport(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		req *orchestrator.GetUsageReportRequest
+	}
+	type fields struct {
+		db    persistence.DB
+		authz service.AuthorizationStrategy
+	}
+	tests := []struct {
+		name    string
+		args    args
+		fields  fields
+		want    assert.Want[*connect.Response[orchestrator.UsageReport]]
+		wantErr assert.WantErr
+	}{
+		{
+			name: "happy path: all targets of evaluation",
+			args: args{
+				ctx: context.Background(),
+				req: &orchestrator.GetUsageReportRequest{},
+			},
+			fields: fields{
+				db:    persistencetest.NewInMemoryDB(t, types, joinTables, createRecords(t, mockUsageRecords()...)),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			want: func(t *testing.T, got *connect.Response[orchestrator.UsageReport], msgAndArgs ...any) bool {
+				return assert.Equal(t, 2, len(got.Msg.TargetsOfEvaluation)) &&
+					assert.Equal(t, orchestratortest.MockToeId1, got.Msg.TargetsOfEvaluation[0].GetTargetOfEvaluationId()) &&
+					assert.Equal(t, int64(3), got.Msg.TargetsOfEvaluation[0].GetEvidences()) &&
+					assert.Equal(t, int64(6), got.Msg.TargetsOfEvaluation[0].GetAssessmentResults()) &&
+					assert.Equal(t, int64(1), got.Msg.TargetsOfEvaluation[0].GetEvaluationRuns()) &&
+					assert.Equal(t, int64(300), got.Msg.TargetsOfEvaluation[0].GetStorageBytes()) &&
+					assert.Equal(t, 2, len(got.Msg.TargetsOfEvaluation[0].Days)) &&
+					assert.Equal(t, "2026-01-01", got.Msg.TargetsOfEvaluation[0].Days[0].GetDay()) &&
+					assert.Equal(t, orchestratortest.MockToeId2, got.Msg.TargetsOfEvaluation[1].GetTargetOfEvaluationId()) &&
+					assert.Equal(t, int64(3), got.Msg.TargetsOfEvaluation[1].GetEvaluationRuns())
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "happy path: filtered by target of evaluation and period",
+			args: args{
+				ctx: context.Background(),
+				req: &orchestrator.GetUsageReportRequest{
+					TargetOfEvaluationId: new(orchestratortest.MockToeId1),
+					StartDay:             new("2026-01-02"),
+					EndDay:               new("2026-01-31"),
+				},
+			},
+			fields: fields{
+				db:    persistencetest.NewInMemoryDB(t, types, joinTables, createRecords(t, mockUsageRecords()...)),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			want: func(t *testing.T, got *connect.Response[orchestrator.UsageReport], msgAndArgs ...any) bool {
+				return assert.Equal(t, 1, len(got.Msg.TargetsOfEvaluation)) &&
+					assert.Equal(t, int64(2), got.Msg.TargetsOfEvaluation[0].GetEvidences()) &&
+					assert.Equal(t, int64(200), got.Msg.TargetsOfEvaluation[0].GetStorageBytes()) &&
+					assert.Equal(t, 1, len(got.Msg.TargetsOfEvaluation[0].Days))
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "happy path: no access to any target of evaluation",
+			args: args{
+				ctx: auth.WithClaims(context.Background(), &auth.OAuthClaims{
+					RegisteredClaims: jwt.RegisteredClaims{
+						Subject: orchestratortest.MockUserId1,
+						Issuer:  orchestratortest.MockUserIssuer1,
+					},
+				}),
+				req: &orchestrator.GetUsageReportRequest{},
+			},
+			fields: fields{
+				db: persistencetest.NewInMemoryDB(t, types, joinTables, createRecords(t, mockUsageRecords()...)),
+				authz: &service.AuthorizationStrategyPermissionStore{
+					Permissions: service.DBPermissionStore{
+						DB: persistencetest.NewInMemoryDB(t, types, joinTables),
+					},
+				},
+			},
+			want: func(t *testing.T, got *connect.Response[orchestrator.UsageReport], msgAndArgs ...any) bool {
+				return assert.Empty(t, got.Msg.TargetsOfEvaluation)
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "error: permission denied for target of evaluation",
+			args: args{
+				ctx: auth.WithClaims(context.Background(), &auth.OAuthClaims{
+					RegisteredClaims: jwt.RegisteredClaims{
+						Subject: orchestratortest.MockUserId1,
+						Issuer:  orchestratortest.MockUserIssuer1,
+					},
+				}),
+				req: &orchestrator.GetUsageReportRequest{
+					TargetOfEvaluationId: new(orchestratortest.MockToeId1),
+				},
+			},
+			fields: fields{
+				db: persistencetest.NewInMemoryDB(t, types, joinTables, createRecords(t, mockUsageRecords()...)),
+				authz: &service.AuthorizationStrategyPermissionStore{
+					Permissions: service.DBPermissionStore{
+						DB: persistencetest.NewInMemoryDB(t, types, joinTables),
+					},
+				},
+			},
+			want: assert.Nil[*connect.Response[orchestrator.UsageReport]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodePermissionDenied)
+			},
+		},
+		{
+			name: "validation error",
+			args: args{
+				ctx: context.Background(),
+				req: &orchestrator.GetUsageReportRequest{StartDay: new("01.01.2026")},
+			},
+			fields: fields{
+				db:    persistencetest.NewInMemoryDB(t, types, joinTables, createRecords(t, mockUsageRecords()...)),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			want: assert.Nil[*connect.Response[orchestrator.UsageReport]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeInvalidArgument) &&
+					assert.HasFieldViolation(t, err, "start_day")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &Service{
+				db:    tt.fields.db,
+				authz: tt.fields.authz,
+			}
+
+			got, err := svc.GetUsageReport(tt.args.ctx, connect.NewRequest(tt.args.req))
+			tt.want(t, got)
+			tt.wantErr(t, err)
+		})
+	}
+}
+
+func TestService_meterUsage(t *testing.T) {
+	var (
+		svc = &Service{
+			db:    persistencetest.NewInMemoryDB(t, types, joinTables),
+			authz: &service.AuthorizationStrategyAllowAll{},
+		}
+		ctx     = context.Background()
+		results []*assessment.AssessmentResult
+		records []*orchestrator.UsageRecord
+		size    int64
+	)
+
+	// Two results of the first evidence and one result of the second evidence
+	for i, evidenceId := range []string{orchestratortest.MockEvidenceId1, orchestratortest.MockEvidenceId1, orchestratortest.MockEvidenceId2} {
+		r := proto.Clone(orchestratortest.MockAssessmentResult1).(*assessment.AssessmentResult)
+		r.Id = []string{orchestratortest.MockResultId1, orchestratortest.MockResultId2, orchestratortest.MockResultId3}[i]
+		r.EvidenceId = evidenceId
+		results = append(results, r)
+	}
+
+	_, err := svc.StoreAssessmentResult(ctx, connect.NewRequest(&orchestrator.StoreAssessmentResultRequest{Result: results[0]}))
+	assert.NoError(t, err)
+	_, err = svc.BatchStoreAssessmentResults(ctx, connect.NewRequest(&orchestrator.BatchStoreAssessmentResultsRequest{Results: results[1:]}))
+	assert.NoError(t, err)
+	for _, r := range results {
+		size += int64(proto.Size(r))
+	}
+
+	// Two results of the same evaluation run
+	for range 2 {
+		eval := &evaluation.EvaluationResult{
+			Id:                   uuid.NewString(),
+			TargetOfEvaluationId: orchestratortest.MockToeId1,
+			AuditScopeId:         orchestratortest.MockScopeId1,
+			ControlCatalogId:     orchestratortest.MockCatalogId1,
+			ControlId:            orchestratortest.MockControlId1,
+			Status:               evaluation.EvaluationStatus_EVALUATION_STATUS_COMPLIANT,
+			Timestamp:            timestamppb.Now(),
+			RunId:                new(orchestratortest.MockScopeId2),
+		}
+		assert.NoError(t, svc.db.Create(eval))
+		svc.meterEvaluationResult(eval)
+		size += int64(proto.Size(eval))
+	}
+
+	assert.NoError(t, svc.db.List(&records, "day", true, 0, -1))
+	assert.Equal(t, 1, len(records))
+	assert.Equal(t, orchestratortest.MockToeId1, records[0].GetTargetOfEvaluationId())
+	assert.Equal(t, time.Now().UTC().Format(time.DateOnly), records[0].GetDay())
+	assert.Equal(t, int64(2), records[0].GetEvidences())
+	assert.Equal(t, int64(3), records[0].GetAssessmentResults())
+	assert.Equal(t, int64(1), records[0].GetEvaluationRuns())
+	assert.Equal(t, size, records[0].GetStorageBytes())
+}
@@ -19,6 +19,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"log/slog"
 
 	"confirmate.io/core/api/orchestrator"
 	"confirmate.io/core/auth"
@@ -161,6 +162,8 @@ func (svc *Service) ListUsers(
 		users []*orchestrator.User
 		conds []any
 		npt   string
+		query []string
+		args  []any
 	)
 
 	// Validate request
@@ -180,6 +183,26 @@ func (svc *Service) ListUsers(
 		req.Msg.Asc = true
 	}
 
+	// Build filter conditions
+	if filter := req.Msg.GetFilter(); filter != nil {
+		if filter.Enabled != nil {
+			query = append(query, "enabled = ?")
+			args = append(args, filter.GetEnabled())
+		}
+		if search := filter.GetSearch(); search != "" {
+			query = append(query, "(username LIKE ? OR email LIKE ? OR first_name LIKE ? OR last_name LIKE ?)")
+			search = "%" + search + "%"
+			args = append(args, search, search, search, search)
+		}
+		if filter.Source != nil {
+			query = append(query, "source = ?")
+			args = append(args, filter.GetSource())
+		}
+	}
+	if len(query) > 0 {
+		conds = persistence.BuildConds(query, args)
+	}
+
 	users, npt, err = service.PaginateStorage[*orchestrator.User](req.Msg, svc.db, service.DefaultPaginationOpts, conds...)
 	if err = service.HandleDatabaseError(err); err != nil {
 		return nil, err
@@ -192,6 +215,95 @@ func (svc *Service) ListUsers(
 	return
 }
 
+// ResolveUser resolves the local user that belongs to an external identity. If an issuer is given, the user is
+// looked up by the ID derived from issuer and subject, which is the same ID used for users provisioned from OIDC
+// tokens. Otherwise, or if no such user exists, the subject is matched against the external ID of the users
+// synchronized from the user directory.
+func (svc *Service) ResolveUser(
+	ctx context.Context,
+	req *connect.Request[orchestrator.ResolveUserRequest],
+) (res *connect.Response[orchestrator.User], err error) {
+	var (
+		user orchestrator.User
+	)
+
+	// Validate the request
+	if err = service.Validate(req); err != nil {
+		return nil, err
+	}
+
+	// JIT-provision the caller without enforcing authorization, analogous to GetUser.
+	if _, err = provisionCurrentUser(ctx, svc); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	if req.Msg.Issuer != nil {
+		err = svc.db.Get(&user, "id = ?", auth.ConfirmateUserID(req.Msg.GetIssuer(), req.Msg.GetSubject()))
+		if err == nil {
+			return connect.NewResponse(&user), nil
+		} else if !errors.Is(err, persistence.ErrRecordNotFound) {
+			return nil, service.HandleDatabaseError(err)
+		}
+	}
+
+	err = svc.db.Get(&user, "external_id = ?", req.Msg.GetSubject())
+	if err = service.HandleDatabaseError(err, service.ErrNotFound("user")); err != nil {
+		return nil, err
+	}
+
+	res = connect.NewResponse(&user)
+	return
+}
+
+// SyncUsers synchronizes the users of the configured [UserDirectory] with the local users.
+func (svc *Service) SyncUsers(
+	ctx context.Context,
+	req *connect.Request[orchestrator.SyncUsersRequest],
+) (res *connect.Response[orchestrator.SyncUsersResponse], err error) {
+	var (
+		allowed bool
+		sync    *orchestrator.SyncUsersResponse
+		cerr    *connect.Error
+	)
+
+	// Validate the request
+	if err = service.Validate(req); err != nil {
+		return nil, err
+	}
+
+	// Only admins may synchronize users.
+	allowed, _, err = CheckAccess(ctx, svc.authz, svc, orchestrator.RequestType_REQUEST_TYPE_UPDATED, "", orchestrator.ObjectType_OBJECT_TYPE_USER)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if !allowed {
+		return nil, service.ErrPermissionDenied
+	}
+
+	if svc.cfg.UserDirectory == nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("no user directory configured"))
+	}
+
+	sync, err = svc.syncUsers(ctx)
+	if err != nil {
+		// Database errors are already converted into connect errors
+		if errors.As(err, &cerr) {
+			return nil, err
+		}
+
+		return nil, connect.NewError(connect.CodeUnavailable, err)
+	}
+
+	slog.Info("Synchronized users from user directory",
+		slog.Int("created", int(sync.Created)),
+		slog.Int("updated", int(sync.Updated)),
+		slog.Int("disabled", int(sync.Disabled)),
+	)
+
+	res = connect.NewResponse(sync)
+	return
+}
+
 // ListUserPermissions lists all user permissions, optionally filtered by user ID, object ID and/or object type.
 func (svc *Service) ListUserPermissions(
 	ctx context.Context,
@@ -376,6 +488,9 @@ func provisionCurrentUser(ctx context.Context, svc *Service) (string, error) {
 			Email:      new(claims.Email),
 			Roles:      claims.Roles,
 			LastAccess: timestamppb.Now(),
+			Issuer:     new(claims.Issuer),
+			Subject:    new(claims.Subject),
+			Source:     orchestrator.UserSource_USER_SOURCE_OIDC,
 		}
 		err = svc.db.Create(user)
 		if err != nil {
@@ -392,6 +507,11 @@ func provisionCurrentUser(ctx context.Context, svc *Service) (string, error) {
 		user.Email = new(claims.Email)
 		user.Roles = claims.Roles
 		user.LastAccess = timestamppb.Now()
+		user.Issuer = new(claims.Issuer)
+		user.Subject = new(claims.Subject)
+		if user.Source == orchestrator.UserSource_USER_SOURCE_UNSPECIFIED {
+			user.Source = orchestrator.UserSource_USER_SOURCE_OIDC
+		}
 		err = svc.db.Save(user)
 		if err != nil {
 			return "", fmt.Errorf("failed to update user: %w", err)
@@ -420,9 +540,60 @@ func CheckAccess(ctx context.Context, authz service.AuthorizationStrategy, svc *
 
 	allowed, objectIds = authz.CheckAccess(ctx, userId, reqType, orchestrator.UserPermission_PERMISSION_READER, objectId, objectType)
 
+	// Tokens can be restricted to particular targets of evaluation. The permissions of objects that belong to an audit
+	// scope are checked on the audit scope, so we need to resolve its target of evaluation.
+	if allowed && belongsToAuditScope(reqType, objectType) {
+		allowed, err = svc.allowsAuditScope(ctx, authz, objectId)
+		if err != nil {
+			return false, nil, err
+		}
+	}
+
 	return allowed, objectIds, nil
 }
 
+// belongsToAuditScope returns whether the permissions of an object of the given type are checked on the audit scope it
+// belongs to, i.e., the object ID of the request is the ID of an audit scope. This is not the case for listing objects
+// and for creating audit scopes, which are checked on their target of evaluation.
+func belongsToAuditScope(reqType orchestrator.RequestType, objectType orchestrator.ObjectType) bool {
+	if reqType == orchestrator.RequestType_REQUEST_TYPE_LIST {
+		return false
+	}
+
+	switch objectType {
+	case orchestrator.ObjectType_OBJECT_TYPE_AUDIT_SCOPE:
+		return reqType != orchestrator.RequestType_REQUEST_TYPE_CREATED
+	case orchestrator.ObjectType_OBJECT_TYPE_EVALUATION_RESULT,
+		orchestrator.ObjectType_OBJECT_TYPE_CONTROL_IN_SCOPE:
+		return true
+	default:
+		return false
+	}
+}
+
+// allowsAuditScope checks whether the targets of evaluation the claims of the request are restricted to (see
+// [service.ClaimedTargetOfEvaluations]) contain the target of evaluation of the audit scope with the given ID. A
+// missing audit scope is allowed, so that the handler can report it as not found.
+func (svc *Service) allowsAuditScope(ctx context.Context, authz service.AuthorizationStrategy, auditScopeId string) (allowed bool, err error) {
+	var (
+		scope orchestrator.AuditScope
+		all   bool
+	)
+
+	if all, _ = service.ClaimedTargetOfEvaluations(ctx, authz); all {
+		return true, nil
+	}
+
+	err = svc.db.Get(&scope, persistence.WithoutPreload(), "id = ?", auditScopeId)
+	if errors.Is(err, persistence.ErrRecordNotFound) {
+		return true, nil
+	} else if err != nil {
+		return false, err
+	}
+
+	return service.AllowsTargetOfEvaluation(ctx, authz, scope.GetTargetOfEvaluationId()), nil
+}
+
 // grantCreatorAdminPermission persists an ADMIN [orchestrator.UserPermission] for the user who is
 // making the current request (derived from JWT claims in ctx). It is called after a new object
 // has been created so that the creator immediately has full administrative access to that object
@@ -0,0 +1,328 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package orchestrator
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"confirmate.io/core/api/orchestrator"
+	"confirmate.io/core/auth"
+	"confirmate.io/core/log"
+	"confirmate.io/core/persistence"
+	"confirmate.io/core/service"
+)
+
+// DefaultSCIMPageSize is the number of users requested per page from a SCIM provider if
+// [SCIMUserDirectory.PageSize] is not set.
+const DefaultSCIMPageSize = 100
+
+// UserDirectory is an external directory of users, such as a SCIM provider, that is synchronized
+// with the local users of the orchestrator. It can be configured as [Config.UserDirectory].
+type UserDirectory interface {
+	// ListUsers returns all users of the directory. The returned users must have their ID, issuer
+	// and subject set, so that they match the users provisioned from OIDC tokens of the same
+	// identity provider.
+	ListUsers(ctx context.Context) (users []*orchestrator.User, err error)
+}
+
+// SCIMUserDirectory is a [UserDirectory] that retrieves users from the /Users endpoint of a SCIM
+// 2.0 provider (see RFC 7644).
+type SCIMUserDirectory struct {
+	// Endpoint is the base URL of the SCIM API, e.g., https://idp.example.com/scim/v2.
+	Endpoint string
+	// Token is the bearer token used to authenticate against the SCIM API.
+	Token string
+	// Issuer is the OIDC issuer of the identity provider. Together with the SCIM ID of a user, which
+	// is expected to be the subject of the user's tokens, it forms the local user ID.
+	Issuer string
+	// PageSize is the number of users requested per page. If not set, [DefaultSCIMPageSize] is used.
+	PageSize int
+	// HTTPClient is the HTTP client used for requests. If not set, [http.DefaultClient] is used.
+	HTTPClient *http.Client
+}
+
+// scimListResponse is a list response of a SCIM provider.
+type scimListResponse struct {
+	TotalResults int        `json:"totalResults"`
+	StartIndex   int        `json:"startIndex"`
+	ItemsPerPage int        `json:"itemsPerPage"`
+	Resources    []scimUser `json:"Resources"`
+}
+
+// scimUser is a user resource of a SCIM provider. Only the attributes used by Confirmate are
+// contained.
+type scimUser struct {
+	Id         string `json:"id"`
+	ExternalId string `json:"externalId"`
+	UserName   string `json:"userName"`
+	Name       struct {
+		GivenName  string `json:"givenName"`
+		FamilyName string `json:"familyName"`
+	} `json:"name"`
+	Emails []struct {
+		Value   string `json:"value"`
+		Primary bool   `json:"primary"`
+	} `json:"emails"`
+	Active *bool `json:"active"`
+}
+
+// ListUsers implements [UserDirectory]. It follows the pagination of the SCIM provider until all
+// users are retrieved.
+func (d *SCIMUserDirectory) ListUsers(ctx context.Context) (users []*orchestrator.User, err error) {
+	var (
+		page       *scimListResponse
+		startIndex = 1
+		pageSize   = d.PageSize
+	)
+
+	if d.Issuer == "" {
+		return nil, errors.New("issuer of the SCIM user directory is not configured")
+	}
+
+	if pageSize <= 0 {
+		pageSize = DefaultSCIMPageSize
+	}
+
+	for {
+		page, err = d.fetchPage(ctx, startIndex, pageSize)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, u := range page.Resources {
+			if u.Id == "" {
+				continue
+			}
+
+			users = append(users, d.toUser(&u))
+		}
+
+		// Stop if the provider has no further results or returns an empty page, which would
+		// otherwise result in an endless loop
+		startIndex += len(page.Resources)
+		if len(page.Resources) == 0 || startIndex > page.TotalResults {
+			break
+		}
+	}
+
+	return users, nil
+}
+
+// fetchPage retrieves a single page of users from the SCIM provider.
+func (d *SCIMUserDirectory) fetchPage(ctx context.Context, startIndex int, count int) (page *scimListResponse, err error) {
+	var (
+		req    *http.Request
+		res    *http.Response
+		client = d.HTTPClient
+		query  = url.Values{}
+	)
+
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	query.Set("startIndex", strconv.Itoa(startIndex))
+	query.Set("count", strconv.Itoa(count))
+
+	req, err = http.NewRequestWithContext(ctx, http.MethodGet, strings.TrimSuffix(d.Endpoint, "/")+"/Users?"+query.Encode(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("could not create SCIM request: %w", err)
+	}
+
+	req.Header.Set("Accept", "application/scim+json")
+	if d.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+d.Token)
+	}
+
+	res, err = client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve users from SCIM provider: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("could not retrieve users from SCIM provider: unexpected status %s", res.Status)
+	}
+
+	page = new(scimListResponse)
+	err = json.NewDecoder(res.Body).Decode(page)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode SCIM list response: %w", err)
+	}
+
+	return page, nil
+}
+
+// toUser converts a SCIM user into an [orchestrator.User].
+func (d *SCIMUserDirectory) toUser(u *scimUser) (user *orchestrator.User) {
+	user = &orchestrator.User{
+		Id:      auth.ConfirmateUserID(d.Issuer, u.Id),
+		Issuer:  new(d.Issuer),
+		Subject: new(u.Id),
+		Source:  orchestrator.UserSource_USER_SOURCE_SCIM,
+		// SCIM defines users as active unless stated otherwise
+		Enabled: u.Active == nil || *u.Active,
+	}
+
+	if u.ExternalId != "" {
+		user.ExternalId = new(u.ExternalId)
+	}
+	if u.UserName != "" {
+		user.Username = new(u.UserName)
+	}
+	if u.Name.GivenName != "" {
+		user.FirstName = new(u.Name.GivenName)
+	}
+	if u.Name.FamilyName != "" {
+		user.LastName = new(u.Name.FamilyName)
+	}
+
+	// Prefer the primary email address, otherwise use the first one
+	for i, email := range u.Emails {
+		if email.Primary || i == 0 {
+			user.Email = new(email.Value)
+		}
+		if email.Primary {
+			break
+		}
+	}
+
+	return user
+}
+
+// syncUsersPeriodically synchronizes the users of the [Config.UserDirectory] every
+// [Config.UserDirectorySyncInterval] until ctx is done.
+func (svc *Service) syncUsersPeriodically(ctx context.Context) {
+	var (
+		ticker = time.NewTicker(max(svc.cfg.UserDirectorySyncInterval, time.Second))
+		res    *orchestrator.SyncUsersResponse
+		err    error
+	)
+
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			res, err = svc.syncUsers(ctx)
+			if err != nil {
+				slog.Warn("Could not synchronize users from user directory, retrying later", log.Err(err))
+				continue
+			}
+
+			slog.Debug("Synchronized users from user directory",
+				slog.Int("created", int(res.Created)),
+				slog.Int("updated", int(res.Updated)),
+				slog.Int("disabled", int(res.Disabled)),
+			)
+		}
+	}
+}
+
+// syncUsers synchronizes the users of the [Config.UserDirectory] with the local users. New users
+// are created, existing users are updated with the identity fields of the directory while their
+// roles, attributes and last access are retained. Users previously synchronized from the directory
+// that no longer exist in it are disabled.
+func (svc *Service) syncUsers(ctx context.Context) (res *orchestrator.SyncUsersResponse, err error) {
+	var (
+		users  []*orchestrator.User
+		synced []*orchestrator.User
+		seen   = make(map[string]bool)
+	)
+
+	users, err = svc.cfg.UserDirectory.ListUsers(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res = &orchestrator.SyncUsersResponse{}
+
+	err = svc.db.Transaction(func(tx persistence.DB) (err error) {
+		for _, u := range users {
+			var existing orchestrator.User
+
+			seen[u.Id] = true
+
+			err = tx.Get(&existing, "id = ?", u.Id)
+			if errors.Is(err, persistence.ErrRecordNotFound) {
+				err = tx.Create(u)
+				if err != nil {
+					return fmt.Errorf("could not create user: %w", err)
+				}
+
+				res.Created++
+				continue
+			} else if err != nil {
+				return fmt.Errorf("could not look up user: %w", err)
+			}
+
+			existing.Username = u.Username
+			existing.Email = u.Email
+			existing.FirstName = u.FirstName
+			existing.LastName = u.LastName
+			existing.Enabled = u.Enabled
+			existing.Issuer = u.Issuer
+			existing.Subject = u.Subject
+			existing.ExternalId = u.ExternalId
+			existing.Source = orchestrator.UserSource_USER_SOURCE_SCIM
+
+			err = tx.Save(&existing)
+			if err != nil {
+				return fmt.Errorf("could not update user: %w", err)
+			}
+
+			res.Updated++
+		}
+
+		// Disable users that were removed from the directory
+		err = tx.List(&synced, "id", true, 0, -1, "source = ? AND enabled = ?", orchestrator.UserSource_USER_SOURCE_SCIM, true)
+		if err != nil {
+			return fmt.Errorf("could not list synchronized users: %w", err)
+		}
+
+		for _, u := range synced {
+			if seen[u.Id] {
+				continue
+			}
+
+			u.Enabled = false
+			err = tx.Save(u)
+			if err != nil {
+				return fmt.Errorf("could not disable user: %w", err)
+			}
+
+			res.Disabled++
+		}
+
+		return nil
+	})
+	if err = service.HandleDatabaseError(err); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
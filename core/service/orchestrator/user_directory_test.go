@@ -0,0 +1,267 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package orchestrator
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"confirmate.io/core/api/orchestrator"
+	"confirmate.io/core/persistence"
+	"confirmate.io/core/persistence/persistencetest"
+	"confirmate.io/core/service/orchestrator/orchestratortest"
+	"confirmate.io/core/util/assert"
+)
+
+// mockUserDirectory is a [UserDirectory] returning a fixed list of users or an error.
+type mockUserDirectory struct {
+	users []*orchestrator.User
+	err   error
+}
+
+func (d *mockUserDirectory) ListUsers(_ context.Context) ([]*orchestrator.User, error) {
+	return d.users, d.err
+}
+
+// newMockSCIMServer returns a SCIM provider serving the given list responses, one per page,
+// selected by the startIndex parameter.
+func newMockSCIMServer(t *testing.T, pages map[int]string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/scim/v2/Users" || r.Header.Get("Authorization") != "Bearer token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		startIndex, err := strconv.Atoi(r.URL.Query().Get("startIndex"))
+		assert.NoError(t, err)
+
+		w.Header().Set("Content-Type", "application/scim+json")
+		_, _ = w.Write([]byte(pages[startIndex]))
+	}))
+}
+
+func TestSCIMUserDirectory_ListUsers(t *testing.T) {
+	type fields struct {
+		pages    map[int]string
+		token    string
+		issuer   string
+		pageSize int
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		want    assert.Want[[]*orchestrator.User]
+		wantErr assert.WantErr
+	}{
+		{
+			name:   "err: issuer not configured",
+			fields: fields{token: "token"},
+			want:   assert.Nil[[]*orchestrator.User],
+			wantErr: func(t *testing.T, err error, _ ...any) bool {
+				return assert.ErrorContains(t, err, "issuer")
+			},
+		},
+		{
+			name:   "err: unauthorized",
+			fields: fields{token: "wrong", issuer: orchestratortest.MockUserIssuer1},
+			want:   assert.Nil[[]*orchestrator.User],
+			wantErr: func(t *testing.T, err error, _ ...any) bool {
+				return assert.ErrorContains(t, err, "unexpected status 401")
+			},
+		},
+		{
+			name: "err: invalid response",
+			fields: fields{
+				token:  "token",
+				issuer: orchestratortest.MockUserIssuer1,
+				pages:  map[int]string{1: "{"},
+			},
+			want: assert.Nil[[]*orchestrator.User],
+			wantErr: func(t *testing.T, err error, _ ...any) bool {
+				return assert.ErrorContains(t, err, "could not decode SCIM list response")
+			},
+		},
+		{
+			name: "happy path: multiple pages",
+			fields: fields{
+				token:    "token",
+				issuer:   orchestratortest.MockUserIssuer1,
+				pageSize: 1,
+				pages: map[int]string{
+					1: `{"totalResults":2,"startIndex":1,"itemsPerPage":1,"Resources":[{"id":"user-1","externalId":"ext-1","userName":"testuser","name":{"givenName":"Test","familyName":"User"},"emails":[{"value":"other"},{"value":"email-1","primary":true}]}]}`,
+					2: `{"totalResults":2,"startIndex":2,"itemsPerPage":1,"Resources":[{"id":"user-2","userName":"testuser 2","active":false}]}`,
+				},
+			},
+			want: func(t *testing.T, got []*orchestrator.User, _ ...any) bool {
+				return assert.Equal(t, []*orchestrator.User{
+					{
+						Id:         orchestratortest.GetConfirmateUserID(orchestratortest.MockUserIssuer1, "user-1"),
+						Username:   new("testuser"),
+						Email:      new("email-1"),
+						FirstName:  new("Test"),
+						LastName:   new("User"),
+						Enabled:    true,
+						Issuer:     new(orchestratortest.MockUserIssuer1),
+						Subject:    new("user-1"),
+						ExternalId: new("ext-1"),
+						Source:     orchestrator.UserSource_USER_SOURCE_SCIM,
+					},
+					{
+						Id:       orchestratortest.GetConfirmateUserID(orchestratortest.MockUserIssuer1, "user-2"),
+						Username: new("testuser 2"),
+						Enabled:  false,
+						Issuer:   new(orchestratortest.MockUserIssuer1),
+						Subject:  new("user-2"),
+						Source:   orchestrator.UserSource_USER_SOURCE_SCIM,
+					},
+				}, got)
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "happy path: stops on empty page",
+			fields: fields{
+				token:  "token",
+				issuer: orchestratortest.MockUserIssuer1,
+				pages: map[int]string{
+					1: `{"totalResults":5,"startIndex":1,"itemsPerPage":0,"Resources":[]}`,
+				},
+			},
+			want:    assert.Nil[[]*orchestrator.User],
+			wantErr: assert.NoError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := newMockSCIMServer(t, tt.fields.pages)
+			defer srv.Close()
+
+			d := &SCIMUserDirectory{
+				Endpoint: srv.URL + "/scim/v2/",
+				Token:    tt.fields.token,
+				Issuer:   tt.fields.issuer,
+				PageSize: tt.fields.pageSize,
+			}
+
+			got, err := d.ListUsers(context.Background())
+			assert.True(t, tt.wantErr(t, err))
+			assert.True(t, tt.want(t, got))
+		})
+	}
+}
+
+func TestService_syncUsers(t *testing.T) {
+	var (
+		scimUser1 = &orchestrator.User{
+			Id:       orchestratortest.MockUser1.Id,
+			Username: new("renamed"),
+			Enabled:  true,
+			Issuer:   new(orchestratortest.MockUserIssuer1),
+			Subject:  new(orchestratortest.MockUserId1),
+			Source:   orchestrator.UserSource_USER_SOURCE_SCIM,
+		}
+		scimUser3 = &orchestrator.User{
+			Id:      orchestratortest.GetConfirmateUserID(orchestratortest.MockUserIssuer1, "user-3"),
+			Enabled: true,
+			Issuer:  new(orchestratortest.MockUserIssuer1),
+			Subject: new("user-3"),
+			Source:  orchestrator.UserSource_USER_SOURCE_SCIM,
+		}
+	)
+
+	type fields struct {
+		db  persistence.DB
+		dir UserDirectory
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		want    assert.Want[*orchestrator.SyncUsersResponse]
+		wantDB  assert.Want[persistence.DB]
+		wantErr assert.WantErr
+	}{
+		{
+			name: "err: user directory error",
+			fields: fields{
+				db:  persistencetest.NewInMemoryDB(t, types, joinTables),
+				dir: &mockUserDirectory{err: errors.New("some error")},
+			},
+			want: assert.Nil[*orchestrator.SyncUsersResponse],
+			wantDB: func(t *testing.T, db persistence.DB, _ ...any) bool {
+				return true
+			},
+			wantErr: func(t *testing.T, err error, _ ...any) bool {
+				return assert.ErrorContains(t, err, "some error")
+			},
+		},
+		{
+			name: "happy path",
+			fields: fields{
+				db: persistencetest.NewInMemoryDB(t, types, joinTables, func(d persistence.DB) {
+					// User 1 was provisioned from a token and has roles
+					assert.NoError(t, d.Create(&orchestrator.User{
+						Id:       orchestratortest.MockUser1.Id,
+						Username: new("testuser"),
+						Enabled:  true,
+						Roles:    []orchestrator.Role{orchestrator.Role_ROLE_ADMIN},
+						Source:   orchestrator.UserSource_USER_SOURCE_OIDC,
+					}))
+					// User 2 was synchronized before, but no longer exists in the directory
+					assert.NoError(t, d.Create(&orchestrator.User{
+						Id:      orchestratortest.MockUser2.Id,
+						Enabled: true,
+						Source:  orchestrator.UserSource_USER_SOURCE_SCIM,
+					}))
+				}),
+				dir: &mockUserDirectory{users: []*orchestrator.User{scimUser1, scimUser3}},
+			},
+			want: func(t *testing.T, got *orchestrator.SyncUsersResponse, _ ...any) bool {
+				return assert.Equal(t, &orchestrator.SyncUsersResponse{Created: 1, Updated: 1, Disabled: 1}, got)
+			},
+			wantDB: func(t *testing.T, db persistence.DB, _ ...any) bool {
+				var user1, user2, user3 orchestrator.User
+
+				assert.NoError(t, db.Get(&user1, "id = ?", orchestratortest.MockUser1.Id))
+				assert.NoError(t, db.Get(&user2, "id = ?", orchestratortest.MockUser2.Id))
+				assert.NoError(t, db.Get(&user3, "id = ?", scimUser3.Id))
+
+				return assert.Equal(t, "renamed", user1.GetUsername()) &&
+					assert.Equal(t, []orchestrator.Role{orchestrator.Role_ROLE_ADMIN}, user1.Roles) &&
+					assert.Equal(t, orchestrator.UserSource_USER_SOURCE_SCIM, user1.Source) &&
+					assert.False(t, user2.Enabled) &&
+					assert.True(t, user3.Enabled)
+			},
+			wantErr: assert.NoError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &Service{
+				db:  tt.fields.db,
+				cfg: Config{UserDirectory: tt.fields.dir},
+			}
+
+			got, err := svc.syncUsers(context.Background())
+			assert.True(t, tt.wantErr(t, err))
+			assert.True(t, tt.want(t, got))
+			assert.True(t, tt.wantDB(t, tt.fields.db))
+		})
+	}
+}
@@ -17,6 +17,7 @@ package orchestrator
 
 import (
 	"context"
+	"errors"
 	"sort"
 	"testing"
 
@@ -493,6 +494,34 @@ func TestService_ListUsers(t *testing.T) {
 			},
 			wantErr: assert.NoError,
 		},
+		{
+			name: "happy path: with filter",
+			args: args{
+				req: connect.NewRequest(&orchestrator.ListUsersRequest{
+					PageSize: -1,
+					Filter: &orchestrator.ListUsersRequest_Filter{
+						Enabled: new(true),
+						Search:  new("User 2"),
+						Source:  new(orchestrator.UserSource_USER_SOURCE_SCIM),
+					},
+				}),
+			},
+			fields: fields{
+				db: persistencetest.NewInMemoryDB(t, types, joinTables, func(d persistence.DB) {
+					assert.NoError(t, d.Create(&orchestrator.User{Id: "user-1", LastName: new("User 2"), Enabled: false, Source: orchestrator.UserSource_USER_SOURCE_SCIM}))
+					assert.NoError(t, d.Create(&orchestrator.User{Id: "user-2", LastName: new("User 2"), Enabled: true, Source: orchestrator.UserSource_USER_SOURCE_OIDC}))
+					assert.NoError(t, d.Create(&orchestrator.User{Id: "user-3", LastName: new("User 2"), Enabled: true, Source: orchestrator.UserSource_USER_SOURCE_SCIM}))
+					assert.NoError(t, d.Create(&orchestrator.User{Id: "user-4", LastName: new("User 1"), Enabled: true, Source: orchestrator.UserSource_USER_SOURCE_SCIM}))
+				}),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			want: func(t *testing.T, got *connect.Response[orchestrator.ListUsersResponse], _ ...any) bool {
+				return assert.NotNil(t, got) &&
+					assert.Equal(t, 1, len(got.Msg.Users)) &&
+					assert.Equal(t, "user-3", got.Msg.Users[0].Id)
+			},
+			wantErr: assert.NoError,
+		},
 		{
 			name: "happy path: with authorization strategy with permission store and admin token",
 			args: args{
@@ -1190,3 +1219,220 @@ func TestService_GetUser(t *testing.T) {
 		})
 	}
 }
+
+func TestService_ResolveUser(t *testing.T) {
+	var (
+		scimUser = &orchestrator.User{
+			Id:         orchestratortest.GetConfirmateUserID(orchestratortest.MockUserIssuer1, "user-3"),
+			Enabled:    true,
+			ExternalId: new("ext-3"),
+			Source:     orchestrator.UserSource_USER_SOURCE_SCIM,
+		}
+	)
+
+	type args struct {
+		ctx context.Context
+		req *connect.Request[orchestrator.ResolveUserRequest]
+	}
+	type fields struct {
+		db persistence.DB
+	}
+	tests := []struct {
+		name    string
+		args    args
+		fields  fields
+		want    assert.Want[*connect.Response[orchestrator.User]]
+		wantErr assert.WantErr
+	}{
+		{
+			name: "err: invalid request - missing subject",
+			args: args{
+				req: connect.NewRequest(&orchestrator.ResolveUserRequest{}),
+			},
+			want: assert.Nil[*connect.Response[orchestrator.User]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeInvalidArgument) &&
+					assert.ErrorContains(t, err, "subject")
+			},
+		},
+		{
+			name: "err: not found",
+			args: args{
+				req: connect.NewRequest(&orchestrator.ResolveUserRequest{
+					Issuer:  new(orchestratortest.MockUserIssuer1),
+					Subject: "unknown",
+				}),
+			},
+			fields: fields{
+				db: persistencetest.NewInMemoryDB(t, types, joinTables, func(d persistence.DB) {
+					assert.NoError(t, d.Create(orchestratortest.MockUser1))
+				}),
+			},
+			want: assert.Nil[*connect.Response[orchestrator.User]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeNotFound)
+			},
+		},
+		{
+			name: "err: database error",
+			args: args{
+				req: connect.NewRequest(&orchestrator.ResolveUserRequest{
+					Issuer:  new(orchestratortest.MockUserIssuer1),
+					Subject: orchestratortest.MockUserId1,
+				}),
+			},
+			fields: fields{
+				db: persistencetest.GetErrorDB(t, persistence.ErrDatabase, types, joinTables),
+			},
+			want: assert.Nil[*connect.Response[orchestrator.User]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeInternal)
+			},
+		},
+		{
+			name: "happy path: issuer and subject",
+			args: args{
+				req: connect.NewRequest(&orchestrator.ResolveUserRequest{
+					Issuer:  new(orchestratortest.MockUserIssuer1),
+					Subject: orchestratortest.MockUserId1,
+				}),
+			},
+			fields: fields{
+				db: persistencetest.NewInMemoryDB(t, types, joinTables, func(d persistence.DB) {
+					assert.NoError(t, d.Create(orchestratortest.MockUser1))
+				}),
+			},
+			want: func(t *testing.T, got *connect.Response[orchestrator.User], _ ...any) bool {
+				return assert.Equal(t, orchestratortest.MockUser1, got.Msg)
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "happy path: external ID",
+			args: args{
+				req: connect.NewRequest(&orchestrator.ResolveUserRequest{
+					Subject: "ext-3",
+				}),
+			},
+			fields: fields{
+				db: persistencetest.NewInMemoryDB(t, types, joinTables, func(d persistence.DB) {
+					assert.NoError(t, d.Create(orchestratortest.MockUser1))
+					assert.NoError(t, d.Create(scimUser))
+				}),
+			},
+			want: func(t *testing.T, got *connect.Response[orchestrator.User], _ ...any) bool {
+				return assert.Equal(t, scimUser, got.Msg)
+			},
+			wantErr: assert.NoError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &Service{
+				db: tt.fields.db,
+			}
+
+			res, err := svc.ResolveUser(tt.args.ctx, tt.args.req)
+			assert.True(t, tt.wantErr(t, err))
+			assert.True(t, tt.want(t, res))
+		})
+	}
+}
+
+func TestService_SyncUsers(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		req *connect.Request[orchestrator.SyncUsersRequest]
+	}
+	type fields struct {
+		db    persistence.DB
+		authz service.AuthorizationStrategy
+		dir   UserDirectory
+	}
+	tests := []struct {
+		name    string
+		args    args
+		fields  fields
+		want    assert.Want[*connect.Response[orchestrator.SyncUsersResponse]]
+		wantErr assert.WantErr
+	}{
+		{
+			name: "err: permission denied - non-admin",
+			args: args{
+				ctx: context.Background(),
+				req: connect.NewRequest(&orchestrator.SyncUsersRequest{}),
+			},
+			fields: fields{
+				db:    persistencetest.NewInMemoryDB(t, types, joinTables),
+				authz: &service.AuthorizationStrategyPermissionStore{},
+				dir:   &mockUserDirectory{},
+			},
+			want: assert.Nil[*connect.Response[orchestrator.SyncUsersResponse]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodePermissionDenied)
+			},
+		},
+		{
+			name: "err: no user directory configured",
+			args: args{
+				ctx: context.Background(),
+				req: connect.NewRequest(&orchestrator.SyncUsersRequest{}),
+			},
+			fields: fields{
+				db:    persistencetest.NewInMemoryDB(t, types, joinTables),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			want: assert.Nil[*connect.Response[orchestrator.SyncUsersResponse]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeFailedPrecondition)
+			},
+		},
+		{
+			name: "err: user directory unavailable",
+			args: args{
+				ctx: context.Background(),
+				req: connect.NewRequest(&orchestrator.SyncUsersRequest{}),
+			},
+			fields: fields{
+				db:    persistencetest.NewInMemoryDB(t, types, joinTables),
+				authz: &service.AuthorizationStrategyAllowAll{},
+				dir:   &mockUserDirectory{err: errors.New("some error")},
+			},
+			want: assert.Nil[*connect.Response[orchestrator.SyncUsersResponse]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeUnavailable)
+			},
+		},
+		{
+			name: "happy path: with authorization strategy with permission store and admin token",
+			args: args{
+				ctx: auth.WithClaims(context.Background(), &auth.OAuthClaims{IsAdminToken: true}),
+				req: connect.NewRequest(&orchestrator.SyncUsersRequest{}),
+			},
+			fields: fields{
+				db:    persistencetest.NewInMemoryDB(t, types, joinTables),
+				authz: &service.AuthorizationStrategyPermissionStore{},
+				dir:   &mockUserDirectory{users: []*orchestrator.User{orchestratortest.MockUser1, orchestratortest.MockUser2}},
+			},
+			want: func(t *testing.T, got *connect.Response[orchestrator.SyncUsersResponse], _ ...any) bool {
+				return assert.Equal(t, &orchestrator.SyncUsersResponse{Created: 2}, got.Msg)
+			},
+			wantErr: assert.NoError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &Service{
+				db:    tt.fields.db,
+				authz: tt.fields.authz,
+				cfg:   Config{UserDirectory: tt.fields.dir},
+			}
+
+			res, err := svc.SyncUsers(tt.args.ctx, tt.args.req)
+			assert.True(t, tt.wantErr(t, err))
+			assert.True(t, tt.want(t, res))
+		})
+	}
+}
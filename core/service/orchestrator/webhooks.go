@@ -0,0 +1,406 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package orchestrator
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"log/slog"
+	"net/http"
+	"slices"
+	"time"
+
+	"confirmate.io/core/api"
+	"confirmate.io/core/api/orchestrator"
+	"confirmate.io/core/log"
+	"confirmate.io/core/persistence"
+	"confirmate.io/core/service"
+
+	"buf.build/go/protovalidate"
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// WebhookSignatureHeader is the header containing the signature of a webhook delivery, i.e., "sha256=" followed by
+	// the hex-encoded HMAC-SHA256 of the body using the secret of the webhook (see [SignWebhookPayload]). The body is the
+	// canonical JSON representation of the change event (see [api.MarshalCanonicalJSON]).
+	WebhookSignatureHeader = "X-Confirmate-Signature"
+	// WebhookDeliveryHeader is the header containing the ID of a webhook delivery.
+	WebhookDeliveryHeader = "X-Confirmate-Delivery"
+	// WebhookEventHeader is the header containing the category of the change event of a webhook delivery.
+	WebhookEventHeader = "X-Confirmate-Event"
+
+	// DefaultWebhookDeliveryRetention is the default time for which webhook deliveries are kept in the delivery log.
+	DefaultWebhookDeliveryRetention = 30 * 24 * time.Hour
+
+	// DefaultWebhookTimeout is the time after which a webhook call is aborted, if no HTTP client is configured.
+	DefaultWebhookTimeout = 10 * time.Second
+)
+
+// defaultWebhookHTTPClient is the HTTP client used to call webhooks, if no HTTP client is configured. Unlike
+// [service.DefaultHTTPClient], which only speaks HTTP/2 to our own services, it also speaks HTTP/1.1, which most webhook
+// receivers expect. Its timeout prevents a slow receiver from blocking the deliveries.
+var defaultWebhookHTTPClient = &http.Client{Timeout: DefaultWebhookTimeout}
+
+// CreateWebhook creates a webhook that receives the change events matching its filter. Since webhooks receive the
+// events of all targets of evaluation, this requires the same access as subscribing to change events.
+func (svc *Service) CreateWebhook(
+	ctx context.Context,
+	req *connect.Request[orchestrator.CreateWebhookRequest],
+) (res *connect.Response[orchestrator.Webhook], err error) {
+	var (
+		webhook *orchestrator.Webhook
+	)
+
+	// Validate the request. The ID of the webhook is generated by us.
+	if err = service.Validate(req, protovalidate.WithFilter(service.IgnoreIDFilter)); err != nil {
+		return nil, err
+	}
+
+	// Check access via the configured auth strategy
+	if err = svc.checkWebhookAccess(ctx); err != nil {
+		return nil, err
+	}
+
+	webhook = &orchestrator.Webhook{
+		Id:                    uuid.NewString(),
+		Url:                   req.Msg.Webhook.GetUrl(),
+		Secret:                req.Msg.Webhook.GetSecret(),
+		Categories:            req.Msg.Webhook.GetCategories(),
+		RequestTypes:          req.Msg.Webhook.GetRequestTypes(),
+		TargetOfEvaluationIds: req.Msg.Webhook.GetTargetOfEvaluationIds(),
+		Description:           req.Msg.Webhook.Description,
+		CreatedBy:             actorFromContext(ctx),
+		CreatedAt:             timestamppb.Now(),
+	}
+
+	err = svc.db.Create(webhook)
+	if err = service.HandleDatabaseError(err); err != nil {
+		return nil, err
+	}
+
+	// The secret is never returned
+	webhook.Secret = ""
+
+	res = connect.NewResponse(webhook)
+	return
+}
+
+// ListWebhooks lists all webhooks without their secrets. Unless requested otherwise, the webhooks are ordered by their
+// creation.
+func (svc *Service) ListWebhooks(
+	ctx context.Context,
+	req *connect.Request[orchestrator.ListWebhooksRequest],
+) (res *connect.Response[orchestrator.ListWebhooksResponse], err error) {
+	var (
+		webhooks []*orchestrator.Webhook
+		npt      string
+	)
+
+	// Validate the request
+	if err = service.Validate(req); err != nil {
+		return nil, err
+	}
+
+	// Check access via the configured auth strategy
+	if err = svc.checkWebhookAccess(ctx); err != nil {
+		return nil, err
+	}
+
+	// Set default ordering
+	if req.Msg.OrderBy == "" {
+		req.Msg.OrderBy = "created_at"
+		req.Msg.Asc = true
+	}
+
+	webhooks, npt, err = service.PaginateStorage[*orchestrator.Webhook](req.Msg, svc.db, service.DefaultPaginationOpts,
+		persistence.WithoutPreload())
+	if err = service.HandleDatabaseError(err); err != nil {
+		return nil, err
+	}
+
+	for _, webhook := range webhooks {
+		webhook.Secret = ""
+	}
+
+	res = connect.NewResponse(&orchestrator.ListWebhooksResponse{
+		Webhooks:      webhooks,
+		NextPageToken: npt,
+	})
+	return
+}
+
+// RemoveWebhook removes a webhook together with its delivery log.
+func (svc *Service) RemoveWebhook(
+	ctx context.Context,
+	req *connect.Request[orchestrator.RemoveWebhookRequest],
+) (res *connect.Response[emptypb.Empty], err error) {
+	// Validate the request
+	if err = service.Validate(req); err != nil {
+		return nil, err
+	}
+
+	// Check access via the configured auth strategy
+	if err = svc.checkWebhookAccess(ctx); err != nil {
+		return nil, err
+	}
+
+	err = svc.db.Get(&orchestrator.Webhook{}, persistence.WithoutPreload(), "id = ?", req.Msg.GetWebhookId())
+	if err = service.HandleDatabaseError(err, service.ErrNotFound("webhook")); err != nil {
+		return nil, err
+	}
+
+	err = svc.db.Transaction(func(tx persistence.DB) error {
+		if err := tx.Delete(&orchestrator.WebhookDelivery{}, "webhook_id = ?", req.Msg.GetWebhookId()); err != nil {
+			return err
+		}
+
+		return tx.Delete(&orchestrator.Webhook{}, "id = ?", req.Msg.GetWebhookId())
+	})
+	if err = service.HandleDatabaseError(err); err != nil {
+		return nil, err
+	}
+
+	res = connect.NewResponse(&emptypb.Empty{})
+	return
+}
+
+// ListWebhookDeliveries lists the delivery log of a webhook. Unless requested otherwise, the latest delivery comes
+// first.
+func (svc *Service) ListWebhookDeliveries(
+	ctx context.Context,
+	req *connect.Request[orchestrator.ListWebhookDeliveriesRequest],
+) (res *connect.Response[orchestrator.ListWebhookDeliveriesResponse], err error) {
+	var (
+		deliveries []*orchestrator.WebhookDelivery
+		npt        string
+		conds      = []any{"webhook_id = ?", req.Msg.GetWebhookId()}
+	)
+
+	// Validate the request
+	if err = service.Validate(req); err != nil {
+		return nil, err
+	}
+
+	// Check access via the configured auth strategy
+	if err = svc.checkWebhookAccess(ctx); err != nil {
+		return nil, err
+	}
+
+	err = svc.db.Get(&orchestrator.Webhook{}, persistence.WithoutPreload(), "id = ?", req.Msg.GetWebhookId())
+	if err = service.HandleDatabaseError(err, service.ErrNotFound("webhook")); err != nil {
+		return nil, err
+	}
+
+	// Set default ordering
+	if req.Msg.OrderBy == "" {
+		req.Msg.OrderBy = "delivered_at"
+		req.Msg.Asc = false
+	}
+
+	if req.Msg.GetFailedOnly() {
+		conds = []any{"webhook_id = ? AND success = ?", req.Msg.GetWebhookId(), false}
+	}
+
+	deliveries, npt, err = service.PaginateStorage[*orchestrator.WebhookDelivery](req.Msg, svc.db, service.DefaultPaginationOpts, conds...)
+	if err = service.HandleDatabaseError(err); err != nil {
+		return nil, err
+	}
+
+	res = connect.NewResponse(&orchestrator.ListWebhookDeliveriesResponse{
+		Deliveries:    deliveries,
+		NextPageToken: npt,
+	})
+	return
+}
+
+// checkWebhookAccess checks whether the current user may manage webhooks. It returns a [connect.Error] if the access
+// is denied.
+func (svc *Service) checkWebhookAccess(ctx context.Context) (err error) {
+	var allowed bool
+
+	allowed, _, err = CheckAccess(ctx, svc.authz, svc, orchestrator.RequestType_REQUEST_TYPE_UPDATED, "", orchestrator.ObjectType_OBJECT_TYPE_USER_PERMISSION)
+	if err != nil {
+		return connect.NewError(connect.CodeInternal, err)
+	}
+	if !allowed {
+		return service.ErrPermissionDenied
+	}
+
+	return nil
+}
+
+// deliverWebhooks delivers the change event to all webhooks whose filter matches the event and records the deliveries
+// in their delivery logs. Deliveries older than [Config.WebhookDeliveryRetention] are pruned afterwards.
+func (svc *Service) deliverWebhooks(ctx context.Context, event *orchestrator.ChangeEvent) {
+	var (
+		webhooks  []*orchestrator.Webhook
+		body      []byte
+		delivered bool
+		err       error
+	)
+
+	err = svc.db.List(&webhooks, "created_at", true, 0, -1, persistence.WithoutPreload())
+	if err != nil {
+		slog.Error("Could not retrieve webhooks", log.Err(err))
+		return
+	}
+
+	for _, webhook := range webhooks {
+		if !webhookMatches(webhook, event) {
+			continue
+		}
+
+		// Encode the event only once we know that it is delivered at all
+		if body == nil {
+			body, err = api.MarshalCanonicalJSON(event)
+			if err != nil {
+				slog.Error("Could not encode change event for webhooks", log.Err(err))
+				return
+			}
+		}
+
+		delivery := svc.deliverWebhook(ctx, webhook, event, body)
+		if !delivery.GetSuccess() {
+			slog.Warn("Could not deliver change event to webhook",
+				slog.String("webhook_id", webhook.GetId()),
+				slog.String("delivery_id", delivery.GetId()),
+				slog.String("error", delivery.GetError()))
+		}
+
+		if err = svc.db.Create(delivery); err != nil {
+			slog.Error("Could not record webhook delivery", slog.String("webhook_id", webhook.GetId()), log.Err(err))
+		}
+		delivered = true
+	}
+
+	if delivered && svc.cfg.WebhookDeliveryRetention > 0 {
+		err = svc.db.Delete(&orchestrator.WebhookDelivery{}, "delivered_at < ?", time.Now().Add(-svc.cfg.WebhookDeliveryRetention))
+		if err != nil {
+			slog.Error("Could not prune webhook deliveries", log.Err(err))
+		}
+	}
+}
+
+// deliverWebhook posts the encoded change event to the webhook and returns the delivery. Failed deliveries are not
+// retried, but can be identified in the delivery log.
+func (svc *Service) deliverWebhook(ctx context.Context, webhook *orchestrator.Webhook, event *orchestrator.ChangeEvent, body []byte) (delivery *orchestrator.WebhookDelivery) {
+	var (
+		client = svc.cfg.WebhookHTTPClient
+		req    *http.Request
+		res    *http.Response
+		err    error
+	)
+
+	if client == nil {
+		client = defaultWebhookHTTPClient
+	}
+
+	delivery = &orchestrator.WebhookDelivery{
+		Id:          uuid.NewString(),
+		WebhookId:   webhook.GetId(),
+		Category:    event.GetCategory(),
+		RequestType: event.GetRequestType(),
+		EntityId:    event.GetEntityId(),
+		DeliveredAt: timestamppb.Now(),
+	}
+
+	req, err = http.NewRequestWithContext(ctx, http.MethodPost, webhook.GetUrl(), bytes.NewReader(body))
+	if err != nil {
+		delivery.Error = new(err.Error())
+		return delivery
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(WebhookSignatureHeader, SignWebhookPayload(webhook.GetSecret(), body))
+	req.Header.Set(WebhookDeliveryHeader, delivery.Id)
+	req.Header.Set(WebhookEventHeader, event.GetCategory().String())
+
+	res, err = client.Do(req)
+	if err != nil {
+		delivery.Error = new(err.Error())
+		return delivery
+	}
+	res.Body.Close()
+
+	delivery.StatusCode = new(int32(res.StatusCode))
+	delivery.Success = res.StatusCode >= 200 && res.StatusCode < 300
+	if !delivery.Success {
+		delivery.Error = new("webhook returned " + res.Status)
+	}
+
+	return delivery
+}
+
+// SignWebhookPayload returns the signature of a webhook delivery with the given body, as sent in the
+// [WebhookSignatureHeader]. Receivers can use it to verify that a delivery originates from the orchestrator.
+func SignWebhookPayload(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// webhookMatches returns whether the change event matches the filter of the webhook.
+func webhookMatches(webhook *orchestrator.Webhook, event *orchestrator.ChangeEvent) bool {
+	if len(webhook.GetCategories()) > 0 && !slices.Contains(webhook.GetCategories(), event.GetCategory()) {
+		return false
+	}
+
+	if len(webhook.GetRequestTypes()) > 0 && !slices.Contains(webhook.GetRequestTypes(), event.GetRequestType()) {
+		return false
+	}
+
+	if len(webhook.GetTargetOfEvaluationIds()) > 0 {
+		toeId := eventTargetOfEvaluationId(event)
+		if toeId == "" || !slices.Contains(webhook.GetTargetOfEvaluationIds(), toeId) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// eventTargetOfEvaluationId returns the ID of the target of evaluation the change event concerns, either as set in
+// the event or as derived from its entity. It returns an empty string, if the event does not concern a target of
+// evaluation.
+func eventTargetOfEvaluationId(event *orchestrator.ChangeEvent) string {
+	if event.TargetOfEvaluationId != nil {
+		return event.GetTargetOfEvaluationId()
+	}
+
+	switch entity := event.GetEntity().(type) {
+	case *orchestrator.ChangeEvent_TargetOfEvaluation:
+		return entity.TargetOfEvaluation.GetId()
+	case *orchestrator.ChangeEvent_AuditScope:
+		return entity.AuditScope.GetTargetOfEvaluationId()
+	case *orchestrator.ChangeEvent_AssessmentResult:
+		return entity.AssessmentResult.GetTargetOfEvaluationId()
+	case *orchestrator.ChangeEvent_MetricConfiguration:
+		return entity.MetricConfiguration.GetTargetOfEvaluationId()
+	}
+
+	if event.GetCategory() == orchestrator.EventCategory_EVENT_CATEGORY_TARGET_OF_EVALUATION {
+		return event.GetEntityId()
+	}
+
+	return ""
+}
@@ -0,0 +1,428 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package orchestrator
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"confirmate.io/core/api/orchestrator"
+	"confirmate.io/core/persistence"
+	"confirmate.io/core/persistence/persistencetest"
+	"confirmate.io/core/service"
+	"confirmate.io/core/service/orchestrator/orchestratortest"
+	"confirmate.io/core/util/assert"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	mockWebhookId1       = "00000000-0000-0000-0008-000000000001"
+	mockWebhookId2       = "00000000-0000-0000-0008-000000000002"
+	mockWebhookSecret    = "0123456789abcdef"
+	mockWebhookUrl       = "https://example.com/hook"
+	mockWebhookDelivery1 = "00000000-0000-0000-0009-000000000001"
+	mockWebhookDelivery2 = "00000000-0000-0000-0009-000000000002"
+)
+
+// mockWebhookRecords returns the given webhooks, of which the first one has a successful and a failed delivery.
+func mockWebhookRecords(first *orchestrator.Webhook, others ...*orchestrator.Webhook) (records []any) {
+	records = []any{
+		first,
+		&orchestrator.WebhookDelivery{
+			Id:          mockWebhookDelivery1,
+			WebhookId:   first.GetId(),
+			Category:    orchestrator.EventCategory_EVENT_CATEGORY_METRIC,
+			RequestType: orchestrator.RequestType_REQUEST_TYPE_CREATED,
+			EntityId:    "metric",
+			DeliveredAt: timestamppb.New(time.Now().Add(-time.Hour)),
+			Success:     true,
+			StatusCode:  new(int32(http.StatusNoContent)),
+		},
+		&orchestrator.WebhookDelivery{
+			Id:          mockWebhookDelivery2,
+			WebhookId:   first.GetId(),
+			Category:    orchestrator.EventCategory_EVENT_CATEGORY_METRIC,
+			RequestType: orchestrator.RequestType_REQUEST_TYPE_UPDATED,
+			EntityId:    "metric",
+			DeliveredAt: timestamppb.Now(),
+			StatusCode:  new(int32(http.StatusInternalServerError)),
+			Error:       new("webhook returned 500 Internal Server Error"),
+		},
+	}
+
+	for _, webhook := range others {
+		records = append(records, webhook)
+	}
+
+	return
+}
+
+// mockWebhook returns a webhook with the given ID and filter delivering to the given URL.
+func mockWebhook(id string, url string, categories ...orchestrator.EventCategory) *orchestrator.Webhook {
+	return &orchestrator.Webhook{
+		Id:         id,
+		Url:        url,
+		Secret:     mockWebhookSecret,
+		Categories: categories,
+		CreatedAt:  timestamppb.Now(),
+	}
+}
+
+func TestService_CreateWebhook(t *testing.T) {
+	type fields struct {
+		db    persistence.DB
+		authz service.AuthorizationStrategy
+	}
+	tests := []struct {
+		name    string
+		req     *orchestrator.CreateWebhookRequest
+		fields  fields
+		want    assert.Want[*connect.Response[orchestrator.Webhook]]
+		wantErr assert.WantErr
+	}{
+		{
+			name: "happy path",
+			req: &orchestrator.CreateWebhookRequest{
+				Webhook: &orchestrator.Webhook{
+					Url:        mockWebhookUrl,
+					Secret:     mockWebhookSecret,
+					Categories: []orchestrator.EventCategory{orchestrator.EventCategory_EVENT_CATEGORY_CATALOG},
+				},
+			},
+			fields: fields{
+				db:    persistencetest.NewInMemoryDB(t, types, joinTables),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			want: func(t *testing.T, got *connect.Response[orchestrator.Webhook], args ...any) bool {
+				return assert.NotEmpty(t, got.Msg.GetId()) &&
+					assert.NotNil(t, got.Msg.GetCreatedAt()) &&
+					assert.Equal(t, "", got.Msg.GetSecret())
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "secret too short",
+			req: &orchestrator.CreateWebhookRequest{
+				Webhook: &orchestrator.Webhook{
+					Url:    mockWebhookUrl,
+					Secret: "secret",
+				},
+			},
+			fields: fields{
+				db:    persistencetest.NewInMemoryDB(t, types, joinTables),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			want: assert.Nil[*connect.Response[orchestrator.Webhook]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeInvalidArgument)
+			},
+		},
+		{
+			name: "permission denied",
+			req: &orchestrator.CreateWebhookRequest{
+				Webhook: &orchestrator.Webhook{
+					Url:    mockWebhookUrl,
+					Secret: mockWebhookSecret,
+				},
+			},
+			fields: fields{
+				db:    persistencetest.NewInMemoryDB(t, types, joinTables),
+				authz: &denyAuthorizationStrategy{},
+			},
+			want: assert.Nil[*connect.Response[orchestrator.Webhook]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodePermissionDenied)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &Service{
+				db:    tt.fields.db,
+				authz: tt.fields.authz,
+			}
+			got, gotErr := svc.CreateWebhook(context.Background(), connect.NewRequest(tt.req))
+
+			tt.want(t, got)
+			tt.wantErr(t, gotErr)
+		})
+	}
+}
+
+func TestService_ListWebhooks(t *testing.T) {
+	svc := &Service{
+		db:    persistencetest.NewInMemoryDB(t, types, joinTables, createRecords(t, mockWebhookRecords(mockWebhook(mockWebhookId1, mockWebhookUrl))...)),
+		authz: &service.AuthorizationStrategyAllowAll{},
+	}
+
+	res, err := svc.ListWebhooks(context.Background(), connect.NewRequest(&orchestrator.ListWebhooksRequest{}))
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(res.Msg.Webhooks))
+	assert.Equal(t, mockWebhookId1, res.Msg.Webhooks[0].GetId())
+	assert.Equal(t, "", res.Msg.Webhooks[0].GetSecret())
+}
+
+func TestService_RemoveWebhook(t *testing.T) {
+	var db = persistencetest.NewInMemoryDB(t, types, joinTables, createRecords(t, mockWebhookRecords(mockWebhook(mockWebhookId1, mockWebhookUrl))...))
+
+	svc := &Service{
+		db:    db,
+		authz: &service.AuthorizationStrategyAllowAll{},
+	}
+
+	_, err := svc.RemoveWebhook(context.Background(), connect.NewRequest(&orchestrator.RemoveWebhookRequest{
+		WebhookId: mockWebhookId1,
+	}))
+	assert.NoError(t, err)
+
+	// The delivery log is removed together with the webhook
+	count, err := db.Count(&orchestrator.WebhookDelivery{})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), count)
+
+	_, err = svc.RemoveWebhook(context.Background(), connect.NewRequest(&orchestrator.RemoveWebhookRequest{
+		WebhookId: mockWebhookId1,
+	}))
+	assert.IsConnectError(t, err, connect.CodeNotFound)
+}
+
+func TestService_ListWebhookDeliveries(t *testing.T) {
+	type fields struct {
+		db    persistence.DB
+		authz service.AuthorizationStrategy
+	}
+	tests := []struct {
+		name    string
+		req     *orchestrator.ListWebhookDeliveriesRequest
+		fields  fields
+		want    assert.Want[*connect.Response[orchestrator.ListWebhookDeliveriesResponse]]
+		wantErr assert.WantErr
+	}{
+		{
+			name: "latest delivery first",
+			req: &orchestrator.ListWebhookDeliveriesRequest{
+				WebhookId: mockWebhookId1,
+			},
+			fields: fields{
+				db:    persistencetest.NewInMemoryDB(t, types, joinTables, createRecords(t, mockWebhookRecords(mockWebhook(mockWebhookId1, mockWebhookUrl))...)),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			want: func(t *testing.T, got *connect.Response[orchestrator.ListWebhookDeliveriesResponse], args ...any) bool {
+				return assert.Equal(t, 2, len(got.Msg.Deliveries)) &&
+					assert.Equal(t, mockWebhookDelivery2, got.Msg.Deliveries[0].GetId())
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "failed only",
+			req: &orchestrator.ListWebhookDeliveriesRequest{
+				WebhookId:  mockWebhookId1,
+				FailedOnly: new(true),
+			},
+			fields: fields{
+				db:    persistencetest.NewInMemoryDB(t, types, joinTables, createRecords(t, mockWebhookRecords(mockWebhook(mockWebhookId1, mockWebhookUrl))...)),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			want: func(t *testing.T, got *connect.Response[orchestrator.ListWebhookDeliveriesResponse], args ...any) bool {
+				return assert.Equal(t, 1, len(got.Msg.Deliveries)) &&
+					assert.Equal(t, mockWebhookDelivery2, got.Msg.Deliveries[0].GetId())
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "webhook not found",
+			req: &orchestrator.ListWebhookDeliveriesRequest{
+				WebhookId: mockWebhookId2,
+			},
+			fields: fields{
+				db:    persistencetest.NewInMemoryDB(t, types, joinTables, createRecords(t, mockWebhookRecords(mockWebhook(mockWebhookId1, mockWebhookUrl))...)),
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			want: assert.Nil[*connect.Response[orchestrator.ListWebhookDeliveriesResponse]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeNotFound)
+			},
+		},
+		{
+			name: "permission denied",
+			req: &orchestrator.ListWebhookDeliveriesRequest{
+				WebhookId: mockWebhookId1,
+			},
+			fields: fields{
+				db:    persistencetest.NewInMemoryDB(t, types, joinTables, createRecords(t, mockWebhookRecords(mockWebhook(mockWebhookId1, mockWebhookUrl))...)),
+				authz: &denyAuthorizationStrategy{},
+			},
+			want: assert.Nil[*connect.Response[orchestrator.ListWebhookDeliveriesResponse]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodePermissionDenied)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &Service{
+				db:    tt.fields.db,
+				authz: tt.fields.authz,
+			}
+			got, gotErr := svc.ListWebhookDeliveries(context.Background(), connect.NewRequest(tt.req))
+
+			tt.want(t, got)
+			tt.wantErr(t, gotErr)
+		})
+	}
+}
+
+func TestService_deliverWebhooks(t *testing.T) {
+	var (
+		received atomic.Int32
+		status   atomic.Int32
+	)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+
+		// Receivers verify the signature with the shared secret
+		assert.Equal(t, SignWebhookPayload(mockWebhookSecret, body), r.Header.Get(WebhookSignatureHeader))
+		assert.Equal(t, "EVENT_CATEGORY_CATALOG", r.Header.Get(WebhookEventHeader))
+		assert.NotEmpty(t, r.Header.Get(WebhookDeliveryHeader))
+
+		var event orchestrator.ChangeEvent
+		assert.NoError(t, protojson.Unmarshal(body, &event))
+		assert.Equal(t, orchestratortest.MockCatalogId1, event.GetEntityId())
+
+		received.Add(1)
+		w.WriteHeader(int(status.Load()))
+	}))
+	defer srv.Close()
+
+	db := persistencetest.NewInMemoryDB(t, types, joinTables, createRecords(t, mockWebhookRecords(
+		mockWebhook(mockWebhookId1, srv.URL, orchestrator.EventCategory_EVENT_CATEGORY_CATALOG),
+		mockWebhook(mockWebhookId2, srv.URL, orchestrator.EventCategory_EVENT_CATEGORY_METRIC),
+	)...))
+	// No HTTP client is configured, so that the default client has to talk to the plain HTTP/1.1 test server
+	svc := &Service{
+		db: db,
+		cfg: Config{
+			WebhookDeliveryRetention: 30 * time.Minute,
+		},
+	}
+
+	event := &orchestrator.ChangeEvent{
+		Timestamp:   timestamppb.Now(),
+		Category:    orchestrator.EventCategory_EVENT_CATEGORY_CATALOG,
+		RequestType: orchestrator.RequestType_REQUEST_TYPE_PUBLISHED,
+		EntityId:    orchestratortest.MockCatalogId1,
+	}
+
+	status.Store(http.StatusInternalServerError)
+	svc.deliverWebhooks(context.Background(), event)
+	status.Store(http.StatusOK)
+	svc.deliverWebhooks(context.Background(), event)
+
+	// Only the webhook for catalogs receives the event
+	assert.Equal(t, int32(2), received.Load())
+
+	var deliveries []*orchestrator.WebhookDelivery
+	err := db.List(&deliveries, "delivered_at", true, 0, -1, "webhook_id = ?", mockWebhookId1)
+	assert.NoError(t, err)
+
+	// The delivery from an hour ago exceeds the retention and is pruned
+	assert.Equal(t, 3, len(deliveries))
+	assert.Equal(t, mockWebhookDelivery2, deliveries[0].GetId())
+	assert.False(t, deliveries[1].GetSuccess())
+	assert.Equal(t, int32(http.StatusInternalServerError), deliveries[1].GetStatusCode())
+	assert.True(t, deliveries[2].GetSuccess())
+	assert.Equal(t, orchestrator.RequestType_REQUEST_TYPE_PUBLISHED, deliveries[2].GetRequestType())
+}
+
+func Test_webhookMatches(t *testing.T) {
+	type args struct {
+		webhook *orchestrator.Webhook
+		event   *orchestrator.ChangeEvent
+	}
+	tests := []struct {
+		name string
+		args args
+		want bool
+	}{
+		{
+			name: "no filter",
+			args: args{
+				webhook: &orchestrator.Webhook{},
+				event: &orchestrator.ChangeEvent{
+					Category: orchestrator.EventCategory_EVENT_CATEGORY_METRIC,
+				},
+			},
+			want: true,
+		},
+		{
+			name: "request type does not match",
+			args: args{
+				webhook: &orchestrator.Webhook{
+					RequestTypes: []orchestrator.RequestType{orchestrator.RequestType_REQUEST_TYPE_CREATED},
+				},
+				event: &orchestrator.ChangeEvent{
+					Category:    orchestrator.EventCategory_EVENT_CATEGORY_METRIC,
+					RequestType: orchestrator.RequestType_REQUEST_TYPE_DELETED,
+				},
+			},
+			want: false,
+		},
+		{
+			name: "target of evaluation derived from audit scope",
+			args: args{
+				webhook: &orchestrator.Webhook{
+					TargetOfEvaluationIds: []string{orchestratortest.MockToeId1},
+				},
+				event: &orchestrator.ChangeEvent{
+					Category:    orchestrator.EventCategory_EVENT_CATEGORY_AUDIT_SCOPE,
+					RequestType: orchestrator.RequestType_REQUEST_TYPE_CREATED,
+					Entity: &orchestrator.ChangeEvent_AuditScope{
+						AuditScope: orchestratortest.MockAuditScope1,
+					},
+				},
+			},
+			want: true,
+		},
+		{
+			name: "event without target of evaluation",
+			args: args{
+				webhook: &orchestrator.Webhook{
+					TargetOfEvaluationIds: []string{orchestratortest.MockToeId1},
+				},
+				event: &orchestrator.ChangeEvent{
+					Category: orchestrator.EventCategory_EVENT_CATEGORY_CATALOG,
+				},
+			},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := webhookMatches(tt.args.webhook, tt.args.event)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -359,7 +359,7 @@ func (svc *Service) TransitionControlInScopeState(
 
 	toState := req.Msg.GetToState()
 	if !isValidTransition(cis.State, toState) {
-		return nil, connect.NewError(connect.CodeInvalidArgument,
+		return nil, service.NewInvalidFieldError("to_state",
 			fmt.Errorf("invalid state transition: %s → %s",
 				cis.State.String(), toState.String()))
 	}
@@ -17,7 +17,10 @@
 
 package service
 
-import "net/http"
+import (
+	"crypto/tls"
+	"net/http"
+)
 
 // DefaultHTTPClient is an [http.Client] configured for HTTP/2 (TLS and unencrypted), which is
 // required for Connect bidi streaming to services. Use this instead of [http.DefaultClient] as the
@@ -26,6 +29,13 @@ var DefaultHTTPClient = NewHTTPClient()
 
 // NewHTTPClient returns a new [http.Client] configured for HTTP/2.
 func NewHTTPClient() *http.Client {
+	return NewTLSHTTPClient(nil)
+}
+
+// NewTLSHTTPClient returns a new [http.Client] configured for HTTP/2, which uses the given [tls.Config] for TLS
+// connections, e.g., the one of [TLSCertificates.ClientConfig] for mutual TLS. A nil config uses the default TLS
+// configuration.
+func NewTLSHTTPClient(tlsConfig *tls.Config) *http.Client {
 	var (
 		p         *http.Protocols
 		transport *http.Transport
@@ -37,6 +47,9 @@ func NewHTTPClient() *http.Client {
 
 	transport = http.DefaultTransport.(*http.Transport).Clone()
 	transport.Protocols = p
+	if tlsConfig != nil {
+		transport.TLSClientConfig = tlsConfig
+	}
 
 	return &http.Client{Transport: transport}
 }
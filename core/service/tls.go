@@ -0,0 +1,271 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package service
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"sync"
+	"time"
+
+	"confirmate.io/core/log"
+)
+
+// DefaultTLSReloadInterval is the default interval in which the certificate, key and CA files are checked for
+// changes.
+const DefaultTLSReloadInterval = time.Minute
+
+// TLSConfig configures (mutual) TLS between the services. The zero value disables TLS.
+type TLSConfig struct {
+	// CertFile is the path of the PEM encoded certificate (chain) of the service. It is presented to clients by the
+	// server and to servers by the clients of the service.
+	CertFile string
+
+	// KeyFile is the path of the PEM encoded private key of the certificate.
+	KeyFile string
+
+	// CAFile is the optional path of the PEM encoded CA certificates that are used to verify peers. If it is set,
+	// servers require clients to present a certificate signed by one of the CAs (mutual TLS) and clients only accept
+	// servers with a certificate signed by one of the CAs. Otherwise, clients use the system's root CAs and servers
+	// do not request client certificates.
+	CAFile string
+
+	// ReloadInterval is the interval in which the files are checked for changes. Changed files are reloaded without
+	// restarting the service, so that certificates can be rotated. Zero disables reloading.
+	ReloadInterval time.Duration
+}
+
+// Enabled returns whether TLS is configured.
+func (cfg TLSConfig) Enabled() bool {
+	return cfg.CertFile != ""
+}
+
+// TLSCertificates holds the certificate and the CA certificates of a [TLSConfig] and reloads them, if their files
+// change. It provides [tls.Config]s for servers and clients that always use the current certificates.
+type TLSCertificates struct {
+	cfg TLSConfig
+
+	mu        sync.Mutex
+	cert      *tls.Certificate
+	pool      *x509.CertPool
+	modTimes  map[string]time.Time
+	lastCheck time.Time
+	now       func() time.Time
+}
+
+// NewTLSCertificates loads the certificates of the given configuration. It returns an error if TLS is not enabled or
+// the files cannot be loaded.
+func NewTLSCertificates(cfg TLSConfig) (c *TLSCertificates, err error) {
+	if !cfg.Enabled() {
+		return nil, errors.New("tls is not configured")
+	}
+
+	c = &TLSCertificates{
+		cfg: cfg,
+		now: time.Now,
+	}
+
+	err = c.load()
+	if err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// ServerConfig returns a [tls.Config] for a server. If a CA file is configured, clients need to present a certificate
+// signed by one of the CAs.
+func (c *TLSCertificates) ServerConfig() *tls.Config {
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			cert, pool := c.current()
+
+			cfg := &tls.Config{
+				MinVersion:   tls.VersionTLS12,
+				Certificates: []tls.Certificate{*cert},
+				NextProtos:   []string{"h2", "http/1.1"},
+			}
+			if pool != nil {
+				cfg.ClientCAs = pool
+				cfg.ClientAuth = tls.RequireAndVerifyClientCert
+			}
+
+			return cfg, nil
+		},
+	}
+}
+
+// ClientConfig returns a [tls.Config] for a client, which presents the certificate of the service to servers that
+// request one. If a CA file is configured, servers are verified against the current CAs instead of the system's root
+// CAs.
+func (c *TLSCertificates) ClientConfig() (cfg *tls.Config) {
+	cfg = &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		GetClientCertificate: func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			cert, _ := c.current()
+			return cert, nil
+		},
+	}
+
+	if c.cfg.CAFile != "" {
+		// The root CAs of a tls.Config cannot change, so we skip the default verification and verify the server
+		// against the current CAs ourselves. This is the approach described in the documentation of
+		// [tls.Config.VerifyConnection].
+		cfg.InsecureSkipVerify = true
+		cfg.VerifyConnection = func(cs tls.ConnectionState) error {
+			_, pool := c.current()
+			return verifyPeer(cs, pool)
+		}
+	}
+
+	return
+}
+
+// verifyPeer verifies the certificate chain of the server of the connection against the CAs in pool.
+func verifyPeer(cs tls.ConnectionState, pool *x509.CertPool) (err error) {
+	var opts x509.VerifyOptions
+
+	if len(cs.PeerCertificates) == 0 {
+		return errors.New("server did not present a certificate")
+	}
+
+	opts = x509.VerifyOptions{
+		DNSName:       cs.ServerName,
+		Roots:         pool,
+		Intermediates: x509.NewCertPool(),
+	}
+	for _, cert := range cs.PeerCertificates[1:] {
+		opts.Intermediates.AddCert(cert)
+	}
+
+	_, err = cs.PeerCertificates[0].Verify(opts)
+	return err
+}
+
+// current returns the current certificate and CA pool. If the reload interval has passed since the last check, the
+// files are reloaded if they changed. If reloading fails, the previous certificates are kept.
+func (c *TLSCertificates) current() (cert *tls.Certificate, pool *x509.CertPool) {
+	var (
+		now = c.now()
+		err error
+	)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.cfg.ReloadInterval > 0 && now.Sub(c.lastCheck) >= c.cfg.ReloadInterval {
+		c.lastCheck = now
+		if c.changed() {
+			err = c.loadLocked()
+			if err != nil {
+				slog.Error("Could not reload TLS certificates, keeping the previous ones", log.Err(err))
+			} else {
+				slog.Info("Reloaded TLS certificates", slog.String("cert_file", c.cfg.CertFile))
+			}
+		}
+	}
+
+	return c.cert, c.pool
+}
+
+// load loads the certificate, the key and the CA certificates.
+func (c *TLSCertificates) load() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.lastCheck = c.now()
+	return c.loadLocked()
+}
+
+// loadLocked loads the certificate, the key and the CA certificates. The caller needs to hold the lock.
+func (c *TLSCertificates) loadLocked() (err error) {
+	var (
+		cert     tls.Certificate
+		pool     *x509.CertPool
+		b        []byte
+		modTimes map[string]time.Time
+	)
+
+	modTimes, err = c.modTimesOfFiles()
+	if err != nil {
+		return err
+	}
+
+	cert, err = tls.LoadX509KeyPair(c.cfg.CertFile, c.cfg.KeyFile)
+	if err != nil {
+		return fmt.Errorf("could not load certificate: %w", err)
+	}
+
+	if c.cfg.CAFile != "" {
+		b, err = os.ReadFile(c.cfg.CAFile)
+		if err != nil {
+			return fmt.Errorf("could not read CA certificates: %w", err)
+		}
+
+		pool = x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(b) {
+			return fmt.Errorf("no CA certificates found in %s", c.cfg.CAFile)
+		}
+	}
+
+	c.cert = &cert
+	c.pool = pool
+	c.modTimes = modTimes
+
+	return nil
+}
+
+// changed returns whether any of the files changed since they were loaded. The caller needs to hold the lock.
+func (c *TLSCertificates) changed() bool {
+	modTimes, err := c.modTimesOfFiles()
+	if err != nil {
+		slog.Warn("Could not check TLS certificates for changes", log.Err(err))
+		return false
+	}
+
+	for file, t := range modTimes {
+		if !t.Equal(c.modTimes[file]) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// modTimesOfFiles returns the modification times of the configured files.
+func (c *TLSCertificates) modTimesOfFiles() (modTimes map[string]time.Time, err error) {
+	var fi os.FileInfo
+
+	modTimes = make(map[string]time.Time)
+	for _, file := range []string{c.cfg.CertFile, c.cfg.KeyFile, c.cfg.CAFile} {
+		if file == "" {
+			continue
+		}
+
+		fi, err = os.Stat(file)
+		if err != nil {
+			return nil, err
+		}
+		modTimes[file] = fi.ModTime()
+	}
+
+	return modTimes, nil
+}
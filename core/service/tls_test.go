@@ -0,0 +1,281 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package service
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"confirmate.io/core/util/assert"
+)
+
+// testCA is a certificate authority that issues certificates for the tests.
+type testCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	pem  []byte
+}
+
+// newTestCA creates a new self-signed [testCA] with the given common name.
+func newTestCA(t *testing.T, cn string) *testCA {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: cn},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign,
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	assert.NoError(t, err)
+
+	cert, err := x509.ParseCertificate(der)
+	assert.NoError(t, err)
+
+	return &testCA{
+		cert: cert,
+		key:  key,
+		pem:  pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+	}
+}
+
+// issue issues a certificate for localhost with the given serial number, which can be used by servers and clients.
+// It returns the PEM encoded certificate and key.
+func (ca *testCA) issue(t *testing.T, serial int64) (certPEM []byte, keyPEM []byte) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		Subject:      pkix.Name{CommonName: "localhost"},
+		DNSNames:     []string{"localhost"},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, ca.cert, &key.PublicKey, ca.key)
+	assert.NoError(t, err)
+
+	b, err := x509.MarshalECPrivateKey(key)
+	assert.NoError(t, err)
+
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: b})
+}
+
+// writeTLSFiles writes a certificate issued by ca and the CA certificate to dir and returns the matching [TLSConfig].
+func writeTLSFiles(t *testing.T, dir string, ca *testCA, serial int64) (cfg TLSConfig) {
+	t.Helper()
+
+	certPEM, keyPEM := ca.issue(t, serial)
+
+	cfg = TLSConfig{
+		CertFile: filepath.Join(dir, "tls.crt"),
+		KeyFile:  filepath.Join(dir, "tls.key"),
+		CAFile:   filepath.Join(dir, "ca.crt"),
+	}
+	assert.NoError(t, os.WriteFile(cfg.CertFile, certPEM, 0600))
+	assert.NoError(t, os.WriteFile(cfg.KeyFile, keyPEM, 0600))
+	assert.NoError(t, os.WriteFile(cfg.CAFile, ca.pem, 0600))
+
+	return cfg
+}
+
+func TestNewTLSCertificates(t *testing.T) {
+	var (
+		dir = t.TempDir()
+		ca  = newTestCA(t, "ca")
+		cfg = writeTLSFiles(t, dir, ca, 2)
+	)
+
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "empty.crt"), []byte("no certificates"), 0600))
+
+	tests := []struct {
+		name    string
+		cfg     TLSConfig
+		want    assert.Want[*TLSCertificates]
+		wantErr assert.WantErr
+	}{
+		{
+			name: "not configured",
+			cfg:  TLSConfig{},
+			want: assert.Nil[*TLSCertificates],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.ErrorContains(t, err, "not configured")
+			},
+		},
+		{
+			name: "missing key",
+			cfg:  TLSConfig{CertFile: cfg.CertFile, KeyFile: filepath.Join(dir, "missing.key")},
+			want: assert.Nil[*TLSCertificates],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.ErrorContains(t, err, "missing.key")
+			},
+		},
+		{
+			name: "invalid CA file",
+			cfg:  TLSConfig{CertFile: cfg.CertFile, KeyFile: cfg.KeyFile, CAFile: filepath.Join(dir, "empty.crt")},
+			want: assert.Nil[*TLSCertificates],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.ErrorContains(t, err, "no CA certificates")
+			},
+		},
+		{
+			name: "happy path: without CA",
+			cfg:  TLSConfig{CertFile: cfg.CertFile, KeyFile: cfg.KeyFile},
+			want: func(t *testing.T, got *TLSCertificates, msgAndArgs ...any) bool {
+				return assert.NotNil(t, got.cert) && assert.Nil(t, got.pool)
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "happy path: with CA",
+			cfg:  cfg,
+			want: func(t *testing.T, got *TLSCertificates, msgAndArgs ...any) bool {
+				return assert.NotNil(t, got.cert) && assert.NotNil(t, got.pool)
+			},
+			wantErr: assert.NoError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewTLSCertificates(tt.cfg)
+			tt.want(t, got)
+			tt.wantErr(t, err)
+		})
+	}
+}
+
+func TestTLSCertificates_mutualTLS(t *testing.T) {
+	var (
+		ca    = newTestCA(t, "ca")
+		other = newTestCA(t, "other")
+	)
+
+	serverCerts, err := NewTLSCertificates(writeTLSFiles(t, t.TempDir(), ca, 2))
+	assert.NoError(t, err)
+
+	srv := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(r.TLS.PeerCertificates[0].SerialNumber.String()))
+	}))
+	srv.TLS = serverCerts.ServerConfig()
+	srv.StartTLS()
+	defer srv.Close()
+
+	tests := []struct {
+		name    string
+		client  func(t *testing.T) *http.Client
+		wantErr assert.WantErr
+	}{
+		{
+			name: "happy path: client certificate of the same CA",
+			client: func(t *testing.T) *http.Client {
+				certs, err := NewTLSCertificates(writeTLSFiles(t, t.TempDir(), ca, 3))
+				assert.NoError(t, err)
+				return NewTLSHTTPClient(certs.ClientConfig())
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "client without certificate",
+			client: func(t *testing.T) *http.Client {
+				return NewTLSHTTPClient(&tls.Config{RootCAs: srv.Client().Transport.(*http.Transport).TLSClientConfig.RootCAs})
+			},
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool { return assert.Error(t, err) },
+		},
+		{
+			name: "server of another CA",
+			client: func(t *testing.T) *http.Client {
+				certs, err := NewTLSCertificates(writeTLSFiles(t, t.TempDir(), other, 3))
+				assert.NoError(t, err)
+				return NewTLSHTTPClient(certs.ClientConfig())
+			},
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.ErrorContains(t, err, "certificate signed by unknown authority")
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := tt.client(t).Get(srv.URL)
+			if err == nil {
+				_ = res.Body.Close()
+				assert.Equal(t, http.StatusOK, res.StatusCode)
+			}
+			tt.wantErr(t, err)
+		})
+	}
+}
+
+func TestTLSCertificates_current(t *testing.T) {
+	var (
+		dir = t.TempDir()
+		ca  = newTestCA(t, "ca")
+		cfg = writeTLSFiles(t, dir, ca, 2)
+		now = time.Now()
+	)
+
+	cfg.ReloadInterval = time.Minute
+	certs, err := NewTLSCertificates(cfg)
+	assert.NoError(t, err)
+	certs.now = func() time.Time { return now }
+	certs.lastCheck = now
+
+	// Rotate the certificate and make sure that the modification time changes
+	writeTLSFiles(t, dir, ca, 3)
+	for _, file := range []string{cfg.CertFile, cfg.KeyFile} {
+		assert.NoError(t, os.Chtimes(file, now.Add(time.Second), now.Add(time.Second)))
+	}
+
+	// Before the reload interval passed, the previous certificate is used
+	cert, _ := certs.current()
+	assert.Equal(t, int64(2), cert.Leaf.SerialNumber.Int64())
+
+	// Afterwards, the rotated certificate is used
+	now = now.Add(time.Minute)
+	cert, _ = certs.current()
+	assert.Equal(t, int64(3), cert.Leaf.SerialNumber.Int64())
+
+	// An invalid certificate is not used
+	assert.NoError(t, os.WriteFile(cfg.CertFile, []byte("invalid"), 0600))
+	assert.NoError(t, os.Chtimes(cfg.CertFile, now.Add(2*time.Second), now.Add(2*time.Second)))
+	now = now.Add(time.Minute)
+	cert, _ = certs.current()
+	assert.Equal(t, int64(3), cert.Leaf.SerialNumber.Int64())
+}
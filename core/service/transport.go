@@ -0,0 +1,126 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package service
+
+import (
+	"fmt"
+	"io"
+	"slices"
+
+	"connectrpc.com/connect"
+	"github.com/klauspost/compress/zstd"
+)
+
+const (
+	// CompressionGzip is the name of the gzip compression, which is supported by all Connect clients and handlers.
+	CompressionGzip = "gzip"
+
+	// CompressionZstd is the name of the zstd compression. It is registered by [TransportConfig.HandlerOptions] and
+	// [TransportConfig.ClientOptions].
+	CompressionZstd = "zstd"
+
+	// DefaultMaxMessageBytes is the default maximum size of a single (uncompressed) message.
+	DefaultMaxMessageBytes = 32 << 20
+
+	// DefaultCompressMinBytes is the default minimum size of a message before it is compressed. Compressing smaller
+	// messages is usually not worth the overhead.
+	DefaultCompressMinBytes = 1 << 10
+)
+
+// DefaultTransportConfig is the default [TransportConfig] for handlers and clients.
+var DefaultTransportConfig = TransportConfig{
+	ReadMaxBytes:     DefaultMaxMessageBytes,
+	SendMaxBytes:     DefaultMaxMessageBytes,
+	Compression:      CompressionGzip,
+	CompressMinBytes: DefaultCompressMinBytes,
+}
+
+// TransportConfig configures the size limits and the compression of messages exchanged via Connect. The zero value
+// does not limit messages and does not compress requests.
+type TransportConfig struct {
+	// ReadMaxBytes is the maximum size of a received message after decompression. Larger messages are rejected with
+	// [connect.CodeResourceExhausted]. Zero means no limit.
+	ReadMaxBytes int
+	// SendMaxBytes is the maximum size of a sent message. Zero means no limit.
+	SendMaxBytes int
+	// Compression is the compression that clients use for requests, either [CompressionGzip] or [CompressionZstd].
+	// An empty string disables the compression of requests. Responses are compressed with the compression the client
+	// negotiated.
+	Compression string
+	// CompressMinBytes is the minimum size of a message before it is compressed.
+	CompressMinBytes int
+}
+
+// Validate checks whether the compression of the configuration is supported.
+func (cfg TransportConfig) Validate() error {
+	if cfg.Compression != "" && !slices.Contains([]string{CompressionGzip, CompressionZstd}, cfg.Compression) {
+		return fmt.Errorf("unsupported compression %q", cfg.Compression)
+	}
+
+	return nil
+}
+
+// HandlerOptions returns the [connect.HandlerOption]s that apply the configuration to a Connect handler. The handler
+// accepts gzip and zstd compressed requests in any case.
+func (cfg TransportConfig) HandlerOptions() []connect.HandlerOption {
+	return []connect.HandlerOption{
+		connect.WithReadMaxBytes(cfg.ReadMaxBytes),
+		connect.WithSendMaxBytes(cfg.SendMaxBytes),
+		connect.WithCompressMinBytes(cfg.CompressMinBytes),
+		connect.WithCompression(CompressionZstd, newZstdDecompressor, newZstdCompressor),
+	}
+}
+
+// ClientOptions returns the [connect.ClientOption]s that apply the configuration to a Connect client.
+func (cfg TransportConfig) ClientOptions() (opts []connect.ClientOption) {
+	opts = []connect.ClientOption{
+		connect.WithReadMaxBytes(cfg.ReadMaxBytes),
+		connect.WithSendMaxBytes(cfg.SendMaxBytes),
+		connect.WithCompressMinBytes(cfg.CompressMinBytes),
+		connect.WithAcceptCompression(CompressionZstd, newZstdDecompressor, newZstdCompressor),
+	}
+
+	if cfg.Compression != "" {
+		opts = append(opts, connect.WithSendCompression(cfg.Compression))
+	}
+
+	return
+}
+
+// zstdDecompressor adapts a [zstd.Decoder] to the [connect.Decompressor] interface.
+type zstdDecompressor struct {
+	*zstd.Decoder
+}
+
+// Close implements [connect.Decompressor]. A closed [zstd.Decoder] cannot be reset anymore, so we only release the
+// reference to the underlying reader and keep the decoder for re-use.
+func (d *zstdDecompressor) Close() error {
+	return d.Decoder.Reset(nil)
+}
+
+// newZstdDecompressor creates a new zstd [connect.Decompressor].
+func newZstdDecompressor() connect.Decompressor {
+	// An error is only returned for invalid options
+	d, _ := zstd.NewReader(nil, zstd.WithDecoderConcurrency(1))
+	return &zstdDecompressor{Decoder: d}
+}
+
+// newZstdCompressor creates a new zstd [connect.Compressor].
+func newZstdCompressor() connect.Compressor {
+	// An error is only returned for invalid options
+	e, _ := zstd.NewWriter(io.Discard, zstd.WithEncoderConcurrency(1))
+	return e
+}
@@ -0,0 +1,157 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package service_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"confirmate.io/core/service"
+	"confirmate.io/core/util/assert"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestTransportConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     service.TransportConfig
+		wantErr assert.WantErr
+	}{
+		{
+			name:    "happy path: default",
+			cfg:     service.DefaultTransportConfig,
+			wantErr: assert.NoError,
+		},
+		{
+			name:    "happy path: zstd",
+			cfg:     service.TransportConfig{Compression: service.CompressionZstd},
+			wantErr: assert.NoError,
+		},
+		{
+			name:    "happy path: no compression",
+			cfg:     service.TransportConfig{},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "unsupported compression",
+			cfg:  service.TransportConfig{Compression: "br"},
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.ErrorContains(t, err, `unsupported compression "br"`)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.wantErr(t, tt.cfg.Validate())
+		})
+	}
+}
+
+func TestTransportConfig_RoundTrip(t *testing.T) {
+	const procedure = "/test.Echo/Echo"
+
+	type args struct {
+		handlerCfg service.TransportConfig
+		clientCfg  service.TransportConfig
+		msg        string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    assert.Want[*connect.Response[wrapperspb.StringValue]]
+		wantErr assert.WantErr
+	}{
+		{
+			name: "happy path: gzip",
+			args: args{
+				handlerCfg: service.DefaultTransportConfig,
+				clientCfg:  service.DefaultTransportConfig,
+				msg:        strings.Repeat("a", 4<<10),
+			},
+			want: func(t *testing.T, got *connect.Response[wrapperspb.StringValue], msgAndArgs ...any) bool {
+				return assert.Equal(t, strings.Repeat("a", 4<<10), got.Msg.GetValue())
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "happy path: zstd",
+			args: args{
+				handlerCfg: service.DefaultTransportConfig,
+				clientCfg: service.TransportConfig{
+					Compression: service.CompressionZstd,
+				},
+				msg: strings.Repeat("a", 4<<10),
+			},
+			want: func(t *testing.T, got *connect.Response[wrapperspb.StringValue], msgAndArgs ...any) bool {
+				return assert.Equal(t, strings.Repeat("a", 4<<10), got.Msg.GetValue())
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "message too large",
+			args: args{
+				handlerCfg: service.TransportConfig{
+					ReadMaxBytes: 1 << 10,
+				},
+				clientCfg: service.TransportConfig{
+					Compression: service.CompressionZstd,
+				},
+				msg: strings.Repeat("a", 4<<10),
+			},
+			want: assert.Nil[*connect.Response[wrapperspb.StringValue]],
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.IsConnectError(t, err, connect.CodeResourceExhausted)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				mux    *http.ServeMux
+				srv    *httptest.Server
+				client *connect.Client[wrapperspb.StringValue, wrapperspb.StringValue]
+			)
+
+			mux = http.NewServeMux()
+			mux.Handle(procedure, connect.NewUnaryHandler(procedure,
+				func(_ context.Context, req *connect.Request[wrapperspb.StringValue]) (*connect.Response[wrapperspb.StringValue], error) {
+					return connect.NewResponse(req.Msg), nil
+				},
+				tt.args.handlerCfg.HandlerOptions()...,
+			))
+
+			srv = httptest.NewServer(mux)
+			defer srv.Close()
+
+			client = connect.NewClient[wrapperspb.StringValue, wrapperspb.StringValue](
+				srv.Client(),
+				srv.URL+procedure,
+				tt.args.clientCfg.ClientOptions()...,
+			)
+
+			got, err := client.CallUnary(context.Background(), connect.NewRequest(wrapperspb.String(tt.args.msg)))
+			tt.want(t, got)
+			tt.wantErr(t, err)
+		})
+	}
+}
@@ -2,7 +2,7 @@
 
 VERSION=$(git describe --tags)
 
-for file in api/*/openapi.yaml; do
+for file in api/*/openapi.yaml api/*/v*/openapi.yaml; do
   if [[ -f "$file" ]]; then
     if [[ "$OSTYPE" == "darwin"* ]]; then
       sed -i '' "s|version: .*|version: $VERSION|" "$file"
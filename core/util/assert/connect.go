@@ -22,6 +22,7 @@ import (
 
 	"buf.build/go/protovalidate"
 	"connectrpc.com/connect"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 )
 
 // IsConnectError asserts that err is a *[connect.Error] and has the specified code.
@@ -71,3 +72,44 @@ func IsValidationError(t TestingT, err error, field string) bool {
 
 	return Fail(t, "Validation error does not include expected field", "Expected field: %s\nAvailable fields: %v", field, availableFields)
 }
+
+// HasFieldViolation asserts that err is a *[connect.Error] with an [errdetails.BadRequest] detail that contains a field
+// violation for the specified field path (e.g., "metric.id").
+//
+// In contrast to [IsValidationError], this checks the error details that are sent to API consumers and therefore also
+// works for errors received by a client.
+// Returns true if the assertion passes.
+func HasFieldViolation(t TestingT, err error, field string) bool {
+	tt, ok := t.(*testing.T)
+	if ok {
+		tt.Helper()
+	}
+
+	var cErr *connect.Error
+	if !errors.As(err, &cErr) {
+		return Fail(t, "Error is not a connect.Error", "Expected: *connect.Error\nActual: %T", err)
+	}
+
+	// Collect the fields of all field violations in the error details
+	var availableFields []string
+	for _, detail := range cErr.Details() {
+		value, err := detail.Value()
+		if err != nil {
+			continue
+		}
+
+		badRequest, ok := value.(*errdetails.BadRequest)
+		if !ok {
+			continue
+		}
+
+		for _, violation := range badRequest.GetFieldViolations() {
+			if violation.GetField() == field {
+				return true
+			}
+			availableFields = append(availableFields, violation.GetField())
+		}
+	}
+
+	return Fail(t, "Error does not include a field violation for the expected field", "Expected field: %s\nAvailable fields: %v", field, availableFields)
+}
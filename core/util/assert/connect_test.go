@@ -22,6 +22,7 @@ import (
 	"buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
 	"buf.build/go/protovalidate"
 	"connectrpc.com/connect"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 )
 
 func TestIsConnectError(t *testing.T) {
@@ -183,3 +184,77 @@ func TestIsValidationError(t *testing.T) {
 func stringPtr(s string) *string {
 	return &s
 }
+
+func TestHasFieldViolation(t *testing.T) {
+	type args struct {
+		err   error
+		field string
+	}
+	tests := []struct {
+		name string
+		args args
+		want bool
+	}{
+		{
+			name: "field violation with correct field",
+			args: args{
+				err:   newBadRequestError(t, "metric.name", "metric.id"),
+				field: "metric.id",
+			},
+			want: true,
+		},
+		{
+			name: "field violation with different field",
+			args: args{
+				err:   newBadRequestError(t, "metric.name"),
+				field: "metric.id",
+			},
+			want: false,
+		},
+		{
+			name: "not a connect error",
+			args: args{
+				err:   errors.New("regular error"),
+				field: "metric.id",
+			},
+			want: false,
+		},
+		{
+			name: "connect error without details",
+			args: args{
+				err:   connect.NewError(connect.CodeInvalidArgument, errors.New("some other error")),
+				field: "metric.id",
+			},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := HasFieldViolation(&fakeT{}, tt.args.err, tt.args.field)
+			if got != tt.want {
+				t.Errorf("HasFieldViolation() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// newBadRequestError returns a connect error with a BadRequest detail containing a field violation for each field.
+func newBadRequestError(t *testing.T, fields ...string) error {
+	var badRequest errdetails.BadRequest
+
+	for _, field := range fields {
+		badRequest.FieldViolations = append(badRequest.FieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       field,
+			Description: "value is required",
+		})
+	}
+
+	detail, err := connect.NewErrorDetail(&badRequest)
+	if err != nil {
+		t.Fatalf("could not create error detail: %v", err)
+	}
+
+	cErr := connect.NewError(connect.CodeInvalidArgument, errors.New("invalid request"))
+	cErr.AddDetail(detail)
+	return cErr
+}
@@ -0,0 +1,170 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+// Package entity contains typed identifiers of the entities of Confirmate, such as controls, catalogs, targets of
+// evaluation and metrics. They are distinct string types, so that the compiler catches swapped arguments, e.g., a
+// control ID that is passed as catalog ID.
+//
+// The protobuf messages of the API still use plain strings. Identifiers taken from validated requests can be converted
+// directly, e.g., entity.ToEID(req.Msg.GetTargetOfEvaluationId()). Identifiers from other sources, such as
+// configuration or command-line arguments, should be parsed with the Parse functions instead.
+package entity
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+// ErrInvalidID is returned (wrapped) by the Parse functions if an identifier is invalid.
+var ErrInvalidID = errors.New("invalid id")
+
+// ControlID identifies a control. It is a UUID.
+type ControlID string
+
+// CatalogID identifies a catalog, e.g., "EUCS". Unlike the other identifiers, it is not necessarily a UUID.
+type CatalogID string
+
+// ToEID identifies a target of evaluation. It is a UUID.
+type ToEID string
+
+// MetricID identifies a metric. It is a UUID.
+type MetricID string
+
+// ParseControlID parses s as [ControlID]. It returns an error wrapping [ErrInvalidID] if s is not a valid identifier.
+func ParseControlID(s string) (id ControlID, err error) {
+	id = ControlID(s)
+	if err = id.Validate(); err != nil {
+		return "", err
+	}
+
+	return id, nil
+}
+
+// ParseCatalogID parses s as [CatalogID]. It returns an error wrapping [ErrInvalidID] if s is not a valid identifier.
+func ParseCatalogID(s string) (id CatalogID, err error) {
+	id = CatalogID(s)
+	if err = id.Validate(); err != nil {
+		return "", err
+	}
+
+	return id, nil
+}
+
+// ParseToEID parses s as [ToEID]. It returns an error wrapping [ErrInvalidID] if s is not a valid identifier.
+func ParseToEID(s string) (id ToEID, err error) {
+	id = ToEID(s)
+	if err = id.Validate(); err != nil {
+		return "", err
+	}
+
+	return id, nil
+}
+
+// ParseMetricID parses s as [MetricID]. It returns an error wrapping [ErrInvalidID] if s is not a valid identifier.
+func ParseMetricID(s string) (id MetricID, err error) {
+	id = MetricID(s)
+	if err = id.Validate(); err != nil {
+		return "", err
+	}
+
+	return id, nil
+}
+
+// Validate checks whether the control ID is a UUID.
+func (id ControlID) Validate() error {
+	return validateUUID("control", string(id))
+}
+
+// Validate checks whether the catalog ID is not empty.
+func (id CatalogID) Validate() error {
+	if id == "" {
+		return fmt.Errorf("%w: catalog id is empty", ErrInvalidID)
+	}
+
+	return nil
+}
+
+// Validate checks whether the target of evaluation ID is a UUID.
+func (id ToEID) Validate() error {
+	return validateUUID("target of evaluation", string(id))
+}
+
+// Validate checks whether the metric ID is a UUID.
+func (id MetricID) Validate() error {
+	return validateUUID("metric", string(id))
+}
+
+// String returns the control ID as plain string.
+func (id ControlID) String() string {
+	return string(id)
+}
+
+// String returns the catalog ID as plain string.
+func (id CatalogID) String() string {
+	return string(id)
+}
+
+// String returns the target of evaluation ID as plain string.
+func (id ToEID) String() string {
+	return string(id)
+}
+
+// String returns the metric ID as plain string.
+func (id MetricID) String() string {
+	return string(id)
+}
+
+// IDs converts plain strings into identifiers of type T, e.g., the repeated metric IDs of a request.
+func IDs[T ~string](s []string) (ids []T) {
+	if s == nil {
+		return nil
+	}
+
+	ids = make([]T, 0, len(s))
+	for _, v := range s {
+		ids = append(ids, T(v))
+	}
+
+	return ids
+}
+
+// Strings converts identifiers of type T into plain strings, e.g., to set the repeated metric IDs of a request.
+func Strings[T ~string](ids []T) (s []string) {
+	if ids == nil {
+		return nil
+	}
+
+	s = make([]string, 0, len(ids))
+	for _, id := range ids {
+		s = append(s, string(id))
+	}
+
+	return s
+}
+
+// validateUUID checks whether the ID of the given kind of entity is a UUID.
+func validateUUID(kind string, id string) error {
+	if id == "" {
+		return fmt.Errorf("%w: %s id is empty", ErrInvalidID, kind)
+	}
+
+	if err := uuid.Validate(id); err != nil {
+		return fmt.Errorf("%w: %s id %q is not a UUID: %w", ErrInvalidID, kind, id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,117 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package entity
+
+import (
+	"testing"
+
+	"confirmate.io/core/util/assert"
+)
+
+func TestParseToEID(t *testing.T) {
+	tests := []struct {
+		name    string
+		s       string
+		want    ToEID
+		wantErr assert.WantErr
+	}{
+		{
+			name:    "happy path",
+			s:       "00000000-0000-0000-0000-000000000001",
+			want:    "00000000-0000-0000-0000-000000000001",
+			wantErr: assert.NoError,
+		},
+		{
+			name: "empty",
+			s:    "",
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.ErrorIs(t, err, ErrInvalidID) && assert.ErrorContains(t, err, "target of evaluation id is empty")
+			},
+		},
+		{
+			name: "not a UUID",
+			s:    "my-target",
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.ErrorIs(t, err, ErrInvalidID) && assert.ErrorContains(t, err, `"my-target" is not a UUID`)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseToEID(tt.s)
+			tt.wantErr(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestParseCatalogID(t *testing.T) {
+	tests := []struct {
+		name    string
+		s       string
+		want    CatalogID
+		wantErr assert.WantErr
+	}{
+		{
+			name:    "happy path",
+			s:       "EUCS",
+			want:    "EUCS",
+			wantErr: assert.NoError,
+		},
+		{
+			name: "empty",
+			s:    "",
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.ErrorIs(t, err, ErrInvalidID)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseCatalogID(tt.s)
+			tt.wantErr(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestIDs(t *testing.T) {
+	tests := []struct {
+		name string
+		s    []string
+		want []MetricID
+	}{
+		{
+			name: "nil",
+			s:    nil,
+			want: nil,
+		},
+		{
+			name: "happy path",
+			s:    []string{"m1", "m2"},
+			want: []MetricID{"m1", "m2"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := IDs[MetricID](tt.s)
+			assert.Equal(t, tt.want, got)
+
+			// Converting back must yield the original strings
+			assert.Equal(t, tt.s, Strings(got))
+		})
+	}
+}
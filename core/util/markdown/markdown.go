@@ -0,0 +1,268 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+// Package markdown renders a safe subset of Markdown to HTML. It supports ATX headings, paragraphs, unordered and
+// ordered lists, block quotes, fenced code blocks as well as inline code, emphasis with asterisks and links. Raw HTML
+// is escaped and links are only rendered for http, https and mailto URLs, so that the output can be embedded into web
+// pages without further sanitization.
+package markdown
+
+import (
+	"html"
+	"net/url"
+	"strings"
+)
+
+// safeSchemes contains the URL schemes that are rendered as links.
+var safeSchemes = []string{"http", "https", "mailto"}
+
+// ToHTML renders the Markdown source to HTML.
+func ToHTML(src string) string {
+	var (
+		r     renderer
+		lines = strings.Split(strings.ReplaceAll(src, "\r\n", "\n"), "\n")
+	)
+
+	for i := 0; i < len(lines); i++ {
+		line := strings.TrimSpace(lines[i])
+
+		if strings.HasPrefix(line, "```") {
+			var code []string
+
+			r.flush()
+			for i++; i < len(lines) && !strings.HasPrefix(strings.TrimSpace(lines[i]), "```"); i++ {
+				code = append(code, lines[i])
+			}
+
+			r.b.WriteString("<pre><code>")
+			r.b.WriteString(html.EscapeString(strings.Join(code, "\n")))
+			r.b.WriteString("</code></pre>\n")
+			continue
+		}
+
+		if line == "" {
+			r.flush()
+			continue
+		}
+
+		if level, text, ok := heading(line); ok {
+			r.flush()
+			r.b.WriteString("<h" + level + ">")
+			writeInline(&r.b, text)
+			r.b.WriteString("</h" + level + ">\n")
+			continue
+		}
+
+		if kind, text, ok := listItem(line); ok {
+			r.flushParagraph()
+			r.flushQuote()
+			if r.list != kind {
+				r.closeList()
+				r.list = kind
+				r.b.WriteString("<" + kind + ">\n")
+			}
+
+			r.b.WriteString("<li>")
+			writeInline(&r.b, text)
+			r.b.WriteString("</li>\n")
+			continue
+		}
+
+		if text, ok := strings.CutPrefix(line, ">"); ok {
+			r.flushParagraph()
+			r.closeList()
+			r.quote = append(r.quote, strings.TrimSpace(text))
+			continue
+		}
+
+		r.closeList()
+		r.flushQuote()
+		r.paragraph = append(r.paragraph, line)
+	}
+
+	r.flush()
+
+	return r.b.String()
+}
+
+// renderer holds the blocks that are currently open while rendering.
+type renderer struct {
+	b         strings.Builder
+	paragraph []string
+	quote     []string
+	list      string
+}
+
+// flush closes all open blocks.
+func (r *renderer) flush() {
+	r.flushParagraph()
+	r.flushQuote()
+	r.closeList()
+}
+
+// flushParagraph writes the open paragraph, if any.
+func (r *renderer) flushParagraph() {
+	if len(r.paragraph) == 0 {
+		return
+	}
+
+	r.b.WriteString("<p>")
+	writeInline(&r.b, strings.Join(r.paragraph, "\n"))
+	r.b.WriteString("</p>\n")
+	r.paragraph = nil
+}
+
+// flushQuote writes the open block quote, if any.
+func (r *renderer) flushQuote() {
+	if len(r.quote) == 0 {
+		return
+	}
+
+	r.b.WriteString("<blockquote><p>")
+	writeInline(&r.b, strings.Join(r.quote, "\n"))
+	r.b.WriteString("</p></blockquote>\n")
+	r.quote = nil
+}
+
+// closeList closes the open list, if any.
+func (r *renderer) closeList() {
+	if r.list == "" {
+		return
+	}
+
+	r.b.WriteString("</" + r.list + ">\n")
+	r.list = ""
+}
+
+// heading returns the level and text of an ATX heading, e.g., "## Scope".
+func heading(line string) (level string, text string, ok bool) {
+	n := len(line) - len(strings.TrimLeft(line, "#"))
+	if n == 0 || n > 6 || (len(line) > n && line[n] != ' ') {
+		return "", "", false
+	}
+
+	return string(rune('0' + n)), strings.TrimSpace(line[n:]), true
+}
+
+// listItem returns the kind of list ("ul" or "ol") and the text of a list item, e.g., "- item" or "1. item".
+func listItem(line string) (kind string, text string, ok bool) {
+	if len(line) > 1 && strings.ContainsRune("-*+", rune(line[0])) && line[1] == ' ' {
+		return "ul", strings.TrimSpace(line[2:]), true
+	}
+
+	n := len(line) - len(strings.TrimLeft(line, "0123456789"))
+	if n > 0 && len(line) > n+1 && (line[n] == '.' || line[n] == ')') && line[n+1] == ' ' {
+		return "ol", strings.TrimSpace(line[n+2:]), true
+	}
+
+	return "", "", false
+}
+
+// writeInline writes the text with its inline elements, i.e., code spans, emphasis and links, to b.
+func writeInline(b *strings.Builder, s string) {
+	for len(s) > 0 {
+		switch {
+		case s[0] == '\\' && len(s) > 1 && strings.IndexByte("\\`*_[]()#+-.!>", s[1]) >= 0:
+			b.WriteString(html.EscapeString(s[1:2]))
+			s = s[2:]
+			continue
+		case s[0] == '`':
+			if end := strings.IndexByte(s[1:], '`'); end >= 0 {
+				b.WriteString("<code>")
+				b.WriteString(html.EscapeString(s[1 : end+1]))
+				b.WriteString("</code>")
+				s = s[end+2:]
+				continue
+			}
+		case strings.HasPrefix(s, "**"):
+			if end := strings.Index(s[2:], "**"); end > 0 {
+				b.WriteString("<strong>")
+				writeInline(b, s[2:end+2])
+				b.WriteString("</strong>")
+				s = s[end+4:]
+				continue
+			}
+		case s[0] == '*':
+			if end := strings.IndexByte(s[1:], '*'); end > 0 {
+				b.WriteString("<em>")
+				writeInline(b, s[1:end+1])
+				b.WriteString("</em>")
+				s = s[end+2:]
+				continue
+			}
+		case s[0] == '[':
+			if text, href, n, ok := link(s); ok {
+				if safeURL(href) {
+					b.WriteString(`<a href="` + html.EscapeString(href) + `">`)
+					writeInline(b, text)
+					b.WriteString("</a>")
+				} else {
+					writeInline(b, text)
+				}
+				s = s[n:]
+				continue
+			}
+		}
+
+		// Write the plain text up to the next character that might start an inline element
+		n := strings.IndexAny(s[1:], "\\`*[")
+		if n < 0 {
+			n = len(s) - 1
+		}
+		b.WriteString(html.EscapeString(s[:n+1]))
+		s = s[n+1:]
+	}
+}
+
+// link parses a link of the form [text](href) at the start of s and returns its text, its target and its length.
+func link(s string) (text string, href string, n int, ok bool) {
+	closing := strings.IndexByte(s, ']')
+	if closing < 0 || len(s) <= closing+1 || s[closing+1] != '(' {
+		return "", "", 0, false
+	}
+
+	end := strings.IndexByte(s[closing+2:], ')')
+	if end < 0 {
+		return "", "", 0, false
+	}
+
+	return s[1:closing], strings.TrimSpace(s[closing+2 : closing+2+end]), closing + end + 3, true
+}
+
+// safeURL returns whether the URL is absolute and uses one of the [safeSchemes].
+func safeURL(href string) bool {
+	u, err := url.Parse(href)
+	if err != nil {
+		return false
+	}
+
+	for _, scheme := range safeSchemes {
+		if strings.EqualFold(u.Scheme, scheme) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Link renders a link with the given text to href. If href does not use one of the [safeSchemes], only the escaped
+// text is returned.
+func Link(text string, href string) string {
+	if !safeURL(href) {
+		return html.EscapeString(text)
+	}
+
+	return `<a href="` + html.EscapeString(href) + `">` + html.EscapeString(text) + "</a>"
+}
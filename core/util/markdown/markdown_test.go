@@ -0,0 +1,92 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package markdown
+
+import (
+	"testing"
+
+	"confirmate.io/core/util/assert"
+)
+
+func TestToHTML(t *testing.T) {
+	tests := []struct {
+		name string
+		src  string
+		want string
+	}{
+		{
+			name: "empty",
+			src:  "",
+			want: "",
+		},
+		{
+			name: "paragraphs",
+			src:  "The provider shall\nencrypt data.\n\nKeys are rotated.",
+			want: "<p>The provider shall\nencrypt data.</p>\n<p>Keys are rotated.</p>\n",
+		},
+		{
+			name: "heading",
+			src:  "## Scope\n#hashtag",
+			want: "<h2>Scope</h2>\n<p>#hashtag</p>\n",
+		},
+		{
+			name: "lists",
+			src:  "- first\n* second\n1. one\n2) two\nafter",
+			want: "<ul>\n<li>first</li>\n<li>second</li>\n</ul>\n<ol>\n<li>one</li>\n<li>two</li>\n</ol>\n<p>after</p>\n",
+		},
+		{
+			name: "block quote",
+			src:  "> Quoted\n> text",
+			want: "<blockquote><p>Quoted\ntext</p></blockquote>\n",
+		},
+		{
+			name: "code block",
+			src:  "```\n<b>bold</b>\n```",
+			want: "<pre><code>&lt;b&gt;bold&lt;/b&gt;</code></pre>\n",
+		},
+		{
+			name: "inline elements",
+			src:  "**Must** use *strong* `AES_256` ciphers",
+			want: "<p><strong>Must</strong> use <em>strong</em> <code>AES_256</code> ciphers</p>\n",
+		},
+		{
+			name: "link",
+			src:  "See [BSI C5](https://www.bsi.bund.de/c5) for details",
+			want: `<p>See <a href="https://www.bsi.bund.de/c5">BSI C5</a> for details</p>` + "\n",
+		},
+		{
+			name: "unsafe link",
+			src:  "[click](javascript:void)",
+			want: "<p>click</p>\n",
+		},
+		{
+			name: "raw HTML is escaped",
+			src:  `<script>alert("x")</script>`,
+			want: "<p>&lt;script&gt;alert(&#34;x&#34;)&lt;/script&gt;</p>\n",
+		},
+		{
+			name: "escaped characters",
+			src:  `2 \* 3 and snake_case`,
+			want: "<p>2 * 3 and snake_case</p>\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, ToHTML(tt.src))
+		})
+	}
+}
@@ -0,0 +1,280 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+// Package spreadsheet reads the rows of simple spreadsheets, i.e., CSV files and the first worksheet of XLSX files, as
+// strings. It does not evaluate formulas, but returns their cached values.
+package spreadsheet
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/csv"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"path"
+	"strconv"
+	"strings"
+)
+
+// utf8BOM is the byte order mark that spreadsheet applications prepend to CSV exports.
+var utf8BOM = []byte{0xEF, 0xBB, 0xBF}
+
+// ErrNoWorksheet is returned by [ReadXLSX] if the workbook does not contain any worksheet.
+var ErrNoWorksheet = errors.New("workbook does not contain a worksheet")
+
+// ReadCSV returns the rows of a CSV file. Rows may have different numbers of cells.
+func ReadCSV(data []byte) (rows [][]string, err error) {
+	var r *csv.Reader
+
+	r = csv.NewReader(bytes.NewReader(bytes.TrimPrefix(data, utf8BOM)))
+	r.FieldsPerRecord = -1
+	r.TrimLeadingSpace = true
+
+	rows, err = r.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("could not parse CSV: %w", err)
+	}
+
+	return rows, nil
+}
+
+// ReadXLSX returns the rows of the first worksheet of an XLSX file. Empty rows are returned as empty slices, so that
+// the index of a row always corresponds to its row number in the worksheet minus one.
+func ReadXLSX(data []byte) (rows [][]string, err error) {
+	var (
+		zr      *zip.Reader
+		strs    []string
+		sheet   string
+		ws      xlsxWorksheet
+		rowNum  int
+		colNum  int
+		current []string
+	)
+
+	zr, err = zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, fmt.Errorf("could not open XLSX: %w", err)
+	}
+
+	sheet, err = firstWorksheet(zr)
+	if err != nil {
+		return nil, err
+	}
+
+	strs, err = sharedStrings(zr)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = decodeXML(zr, sheet, &ws); err != nil {
+		return nil, err
+	}
+
+	for _, row := range ws.Rows {
+		rowNum++
+		if row.R > 0 {
+			rowNum = row.R
+		}
+
+		current = nil
+		for _, c := range row.Cells {
+			colNum = len(current) + 1
+			if c.R != "" {
+				colNum = columnNumber(c.R)
+			}
+			if colNum <= 0 {
+				return nil, fmt.Errorf("invalid cell reference %q", c.R)
+			}
+
+			for len(current) < colNum {
+				current = append(current, "")
+			}
+
+			current[colNum-1], err = c.value(strs)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		for len(rows) < rowNum-1 {
+			rows = append(rows, []string{})
+		}
+		rows = append(rows, current)
+	}
+
+	return rows, nil
+}
+
+// xlsxWorkbook is the part of xl/workbook.xml listing the worksheets.
+type xlsxWorkbook struct {
+	Sheets []struct {
+		RelationshipID string `xml:"http://schemas.openxmlformats.org/officeDocument/2006/relationships id,attr"`
+	} `xml:"sheets>sheet"`
+}
+
+// xlsxRelationships is the content of xl/_rels/workbook.xml.rels.
+type xlsxRelationships struct {
+	Relationships []struct {
+		ID     string `xml:"Id,attr"`
+		Target string `xml:"Target,attr"`
+	} `xml:"Relationship"`
+}
+
+// xlsxText is a (rich) text, either of a shared string or of an inline string.
+type xlsxText struct {
+	T    string `xml:"t"`
+	Runs []struct {
+		T string `xml:"t"`
+	} `xml:"r"`
+}
+
+// String returns the plain text.
+func (t xlsxText) String() string {
+	var sb strings.Builder
+
+	sb.WriteString(t.T)
+	for _, r := range t.Runs {
+		sb.WriteString(r.T)
+	}
+
+	return sb.String()
+}
+
+// xlsxWorksheet is the data of a worksheet.
+type xlsxWorksheet struct {
+	Rows []struct {
+		R     int        `xml:"r,attr"`
+		Cells []xlsxCell `xml:"c"`
+	} `xml:"sheetData>row"`
+}
+
+// xlsxCell is a cell of a worksheet.
+type xlsxCell struct {
+	R  string    `xml:"r,attr"`
+	T  string    `xml:"t,attr"`
+	V  string    `xml:"v"`
+	Is *xlsxText `xml:"is"`
+}
+
+// value returns the value of the cell as string, resolving shared strings.
+func (c xlsxCell) value(strs []string) (v string, err error) {
+	var i int
+
+	switch c.T {
+	case "s":
+		i, err = strconv.Atoi(c.V)
+		if err != nil || i < 0 || i >= len(strs) {
+			return "", fmt.Errorf("invalid shared string reference in cell %s", c.R)
+		}
+		return strs[i], nil
+	case "inlineStr":
+		if c.Is == nil {
+			return "", nil
+		}
+		return c.Is.String(), nil
+	case "b":
+		return strings.ToUpper(strconv.FormatBool(c.V == "1")), nil
+	default:
+		return c.V, nil
+	}
+}
+
+// firstWorksheet returns the name of the file containing the first worksheet of the workbook.
+func firstWorksheet(zr *zip.Reader) (name string, err error) {
+	var (
+		wb   xlsxWorkbook
+		rels xlsxRelationships
+	)
+
+	if err = decodeXML(zr, "xl/workbook.xml", &wb); err != nil {
+		return "", err
+	}
+	if len(wb.Sheets) == 0 {
+		return "", ErrNoWorksheet
+	}
+
+	if err = decodeXML(zr, "xl/_rels/workbook.xml.rels", &rels); err != nil {
+		return "", err
+	}
+
+	for _, rel := range rels.Relationships {
+		if rel.ID != wb.Sheets[0].RelationshipID {
+			continue
+		}
+
+		// Targets are either relative to the workbook or absolute within the package
+		if strings.HasPrefix(rel.Target, "/") {
+			return strings.TrimPrefix(rel.Target, "/"), nil
+		}
+		return path.Join("xl", rel.Target), nil
+	}
+
+	return "", ErrNoWorksheet
+}
+
+// sharedStrings returns the shared strings of the workbook. Workbooks without any strings do not contain a shared
+// strings table.
+func sharedStrings(zr *zip.Reader) (strs []string, err error) {
+	var sst struct {
+		Items []xlsxText `xml:"si"`
+	}
+
+	err = decodeXML(zr, "xl/sharedStrings.xml", &sst)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	strs = make([]string, 0, len(sst.Items))
+	for _, item := range sst.Items {
+		strs = append(strs, item.String())
+	}
+
+	return strs, nil
+}
+
+// decodeXML decodes the XML file with the given name of the package into v.
+func decodeXML(zr *zip.Reader, name string, v any) (err error) {
+	var rc io.ReadCloser
+
+	rc, err = zr.Open(name)
+	if err != nil {
+		return fmt.Errorf("could not open %s: %w", name, err)
+	}
+	defer rc.Close()
+
+	if err = xml.NewDecoder(rc).Decode(v); err != nil {
+		return fmt.Errorf("could not parse %s: %w", name, err)
+	}
+
+	return nil
+}
+
+// columnNumber returns the 1-based column number of a cell reference, such as 3 for "C7". It returns 0 if the
+// reference does not start with a column.
+func columnNumber(ref string) (n int) {
+	for _, r := range ref {
+		if r < 'A' || r > 'Z' {
+			break
+		}
+		n = n*26 + int(r-'A'+1)
+	}
+
+	return n
+}
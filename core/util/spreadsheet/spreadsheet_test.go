@@ -0,0 +1,138 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package spreadsheet
+
+import (
+	"archive/zip"
+	"bytes"
+	"testing"
+
+	"confirmate.io/core/util/assert"
+)
+
+// newXLSX returns a minimal XLSX file consisting of the given files in addition to the workbook and its relationships.
+func newXLSX(t *testing.T, files map[string]string) []byte {
+	var (
+		buf bytes.Buffer
+		zw  = zip.NewWriter(&buf)
+	)
+
+	files["xl/workbook.xml"] = `<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" ` +
+		`xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">` +
+		`<sheets><sheet name="Mapping" sheetId="1" r:id="rId1"/></sheets></workbook>`
+	files["xl/_rels/workbook.xml.rels"] = `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+		`<Relationship Id="rId1" Target="worksheets/sheet1.xml"/></Relationships>`
+
+	for name, content := range files {
+		w, err := zw.Create(name)
+		assert.NoError(t, err)
+		_, err = w.Write([]byte(content))
+		assert.NoError(t, err)
+	}
+	assert.NoError(t, zw.Close())
+
+	return buf.Bytes()
+}
+
+func TestReadCSV(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    []byte
+		want    [][]string
+		wantErr assert.WantErr
+	}{
+		{
+			name: "happy path",
+			data: []byte("\xEF\xBB\xBFcontrol_id,metric_id,weight\nOPS-01, m1\n"),
+			want: [][]string{
+				{"control_id", "metric_id", "weight"},
+				{"OPS-01", "m1"},
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "invalid quoting",
+			data: []byte("a,\"b\n"),
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.ErrorContains(t, err, "could not parse CSV")
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ReadCSV(tt.data)
+			tt.wantErr(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestReadXLSX(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    []byte
+		want    [][]string
+		wantErr assert.WantErr
+	}{
+		{
+			name: "happy path",
+			data: newXLSX(t, map[string]string{
+				"xl/sharedStrings.xml": `<sst><si><t>control_id</t></si><si><r><t>OPS</t></r><r><t>-01</t></r></si></sst>`,
+				"xl/worksheets/sheet1.xml": `<worksheet><sheetData>` +
+					`<row r="1"><c r="A1" t="s"><v>0</v></c><c r="B1" t="inlineStr"><is><t>metric_id</t></is></c></row>` +
+					`<row r="3"><c r="A3" t="s"><v>1</v></c><c r="C3"><v>0.5</v></c></row>` +
+					`</sheetData></worksheet>`,
+			}),
+			want: [][]string{
+				{"control_id", "metric_id"},
+				{},
+				{"OPS-01", "", "0.5"},
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "without shared strings",
+			data: newXLSX(t, map[string]string{
+				"xl/worksheets/sheet1.xml": `<worksheet><sheetData><row><c t="b"><v>1</v></c><c><v>2</v></c></row></sheetData></worksheet>`,
+			}),
+			want:    [][]string{{"TRUE", "2"}},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "invalid shared string reference",
+			data: newXLSX(t, map[string]string{
+				"xl/worksheets/sheet1.xml": `<worksheet><sheetData><row r="1"><c r="A1" t="s"><v>7</v></c></row></sheetData></worksheet>`,
+			}),
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.ErrorContains(t, err, "invalid shared string reference")
+			},
+		},
+		{
+			name: "not a zip file",
+			data: []byte("control_id,metric_id"),
+			wantErr: func(t *testing.T, err error, msgAndArgs ...any) bool {
+				return assert.ErrorContains(t, err, "could not open XLSX")
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ReadXLSX(tt.data)
+			tt.wantErr(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
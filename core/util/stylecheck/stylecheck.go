@@ -0,0 +1,194 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+// Package stylecheck contains a [analysis.Analyzer] that detects misuse patterns of the project's style
+// conventions, which are not covered by go vet.
+//
+// It currently reports
+//   - slog calls whose message contains printf-style formatting verbs; use typed attributes instead,
+//   - calls to [fmt.Errorf] that format an error without the %w verb, thereby dropping the error chain and
+//   - calls to connect.NewError without a meaningful error code, i.e., the zero code or CodeUnknown.
+package stylecheck
+
+import (
+	"go/ast"
+	"go/constant"
+	"go/types"
+	"regexp"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+	"golang.org/x/tools/go/types/typeutil"
+)
+
+// Analyzer is the stylecheck analyzer.
+var Analyzer = &analysis.Analyzer{
+	Name:     "stylecheck",
+	Doc:      "checks for misuse of slog, error wrapping and connect errors",
+	Requires: []*analysis.Analyzer{inspect.Analyzer},
+	Run:      run,
+}
+
+const (
+	connectPkgPath = "connectrpc.com/connect"
+
+	// connectCodeUnknown is the value of connect.CodeUnknown.
+	connectCodeUnknown = 2
+)
+
+// formatVerb matches a printf-style formatting verb, such as %s, %v or %-10d.
+var formatVerb = regexp.MustCompile(`%[-+# 0]*[0-9]*(\.[0-9]+)?[a-zA-Z]`)
+
+// slogFuncs contains the slog functions (and [slog.Logger] methods) that take a message, mapped to the index of the
+// message argument.
+var slogFuncs = map[string]int{
+	"Debug":        0,
+	"Info":         0,
+	"Warn":         0,
+	"Error":        0,
+	"DebugContext": 1,
+	"InfoContext":  1,
+	"WarnContext":  1,
+	"ErrorContext": 1,
+	"Log":          2,
+}
+
+func run(pass *analysis.Pass) (any, error) {
+	var (
+		insp = pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+	)
+
+	insp.Preorder([]ast.Node{(*ast.CallExpr)(nil)}, func(n ast.Node) {
+		var (
+			call = n.(*ast.CallExpr)
+			fn   *types.Func
+			ok   bool
+		)
+
+		fn, ok = typeutil.Callee(pass.TypesInfo, call).(*types.Func)
+		if !ok || fn.Pkg() == nil {
+			return
+		}
+
+		switch fn.Pkg().Path() {
+		case "log/slog":
+			checkSlog(pass, call, fn)
+		case "fmt":
+			if fn.Name() == "Errorf" {
+				checkErrorf(pass, call)
+			}
+		case connectPkgPath:
+			if fn.Name() == "NewError" {
+				checkConnectError(pass, call)
+			}
+		}
+	})
+
+	return nil, nil
+}
+
+// checkSlog reports slog calls with a printf-style message.
+func checkSlog(pass *analysis.Pass, call *ast.CallExpr, fn *types.Func) {
+	var (
+		idx int
+		ok  bool
+		msg string
+	)
+
+	idx, ok = slogFuncs[fn.Name()]
+	if !ok || len(call.Args) <= idx {
+		return
+	}
+
+	msg, ok = stringConstant(pass, call.Args[idx])
+	if !ok {
+		return
+	}
+
+	if formatVerb.MatchString(msg) {
+		pass.Reportf(call.Args[idx].Pos(), "slog message must not contain formatting verbs, use attributes instead")
+	}
+}
+
+// checkErrorf reports fmt.Errorf calls that format an error without the %w verb.
+func checkErrorf(pass *analysis.Pass, call *ast.CallExpr) {
+	var (
+		format string
+		ok     bool
+	)
+
+	if len(call.Args) < 2 {
+		return
+	}
+
+	format, ok = stringConstant(pass, call.Args[0])
+	if !ok || strings.Contains(format, "%w") {
+		return
+	}
+
+	for _, arg := range call.Args[1:] {
+		if isError(pass.TypesInfo.TypeOf(arg)) {
+			pass.Reportf(arg.Pos(), "error is formatted without %%w, use %%w to wrap it")
+			return
+		}
+	}
+}
+
+// checkConnectError reports connect.NewError calls without a meaningful code.
+func checkConnectError(pass *analysis.Pass, call *ast.CallExpr) {
+	var (
+		tv    types.TypeAndValue
+		code  int64
+		exact bool
+	)
+
+	if len(call.Args) == 0 {
+		return
+	}
+
+	tv = pass.TypesInfo.Types[call.Args[0]]
+	if tv.Value == nil || tv.Value.Kind() != constant.Int {
+		return
+	}
+
+	code, exact = constant.Int64Val(tv.Value)
+	if exact && (code == 0 || code == connectCodeUnknown) {
+		pass.Reportf(call.Args[0].Pos(), "connect error must be created with a meaningful code")
+	}
+}
+
+// stringConstant returns the value of expr, if it is a constant string.
+func stringConstant(pass *analysis.Pass, expr ast.Expr) (s string, ok bool) {
+	var (
+		tv = pass.TypesInfo.Types[expr]
+	)
+
+	if tv.Value == nil || tv.Value.Kind() != constant.String {
+		return "", false
+	}
+
+	return constant.StringVal(tv.Value), true
+}
+
+// isError checks whether t implements the error interface.
+func isError(t types.Type) bool {
+	if t == nil {
+		return false
+	}
+
+	return types.Implements(t, types.Universe.Lookup("error").Type().Underlying().(*types.Interface))
+}
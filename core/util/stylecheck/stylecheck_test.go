@@ -0,0 +1,104 @@
+// Copyright 2016-2026 Fraunhofer AISEC
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+//                                 /$$$$$$  /$$                                     /$$
+//                               /$$__  $$|__/                                    | $$
+//   /$$$$$$$  /$$$$$$  /$$$$$$$ | $$  \__/ /$$  /$$$$$$  /$$$$$$/$$$$   /$$$$$$  /$$$$$$    /$$$$$$
+//  /$$_____/ /$$__  $$| $$__  $$| $$$$    | $$ /$$__  $$| $$_  $$_  $$ |____  $$|_  $$_/   /$$__  $$
+// | $$      | $$  \ $$| $$  \ $$| $$_/    | $$| $$  \__/| $$ \ $$ \ $$  /$$$$$$$  | $$    | $$$$$$$$
+// | $$      | $$  | $$| $$  | $$| $$      | $$| $$      | $$ | $$ | $$ /$$__  $$  | $$ /$$| $$_____/
+// |  $$$$$$$|  $$$$$$/| $$  | $$| $$      | $$| $$      | $$ | $$ | $$|  $$$$$$$  |  $$$$/|  $$$$$$$
+// \_______/ \______/ |__/  |__/|__/      |__/|__/      |__/ |__/ |__/ \_______/   \___/   \_______/
+//
+// This file is part of Confirmate Core.
+
+package stylecheck
+
+import (
+	"testing"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/analysistest"
+	"golang.org/x/tools/go/analysis/checker"
+	"golang.org/x/tools/go/packages"
+)
+
+func TestAnalyzer(t *testing.T) {
+	analysistest.Run(t, analysistest.TestData(), Analyzer, "a")
+}
+
+// modules contains the directories of all Go modules of the project, relative to this package.
+var modules = map[string]string{
+	"core":             "../..",
+	"collectors/cloud": "../../../collectors/cloud",
+	"collectors/host":  "../../../collectors/host",
+}
+
+// TestProject runs the analyzer on all packages of all modules of the project, so that the project-wide check is part
+// of the regular test run. Packages that cannot be loaded or do not type-check fail the test, since they could
+// otherwise hide violations.
+func TestProject(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping project-wide analysis in short mode")
+	}
+
+	for name, dir := range modules {
+		t.Run(name, func(t *testing.T) {
+			analyzeModule(t, dir)
+		})
+	}
+}
+
+// analyzeModule runs the analyzer on all packages of the module in dir, including their tests.
+func analyzeModule(t *testing.T, dir string) {
+	var (
+		pkgs  []*packages.Package
+		graph *checker.Graph
+		seen  = make(map[string]bool)
+		pos   string
+		err   error
+	)
+
+	pkgs, err = packages.Load(&packages.Config{
+		Mode:  packages.LoadAllSyntax,
+		Dir:   dir,
+		Tests: true,
+	}, "./...")
+	if err != nil {
+		t.Fatalf("could not load packages: %v", err)
+	}
+
+	// A package that cannot be loaded usually causes lots of errors in the packages that import it, we therefore only
+	// report the first error of each package, and each package only once
+	packages.Visit(pkgs, nil, func(pkg *packages.Package) {
+		if len(pkg.Errors) > 0 && !seen[pkg.PkgPath] {
+			seen[pkg.PkgPath] = true
+			t.Errorf("could not load %s: %v", pkg.PkgPath, pkg.Errors[0])
+		}
+	})
+	if t.Failed() {
+		return
+	}
+
+	graph, err = checker.Analyze([]*analysis.Analyzer{Analyzer}, pkgs, nil)
+	if err != nil {
+		t.Fatalf("could not analyze packages: %v", err)
+	}
+
+	for _, act := range graph.Roots {
+		if act.Err != nil {
+			t.Errorf("could not analyze %s: %v", act.Package.ID, act.Err)
+			continue
+		}
+
+		// Test variants of a package contain its files again, so we only report each position once
+		for _, d := range act.Diagnostics {
+			pos = act.Package.Fset.Position(d.Pos).String()
+			if !seen[pos] {
+				seen[pos] = true
+				t.Errorf("%s: %s", pos, d.Message)
+			}
+		}
+	}
+}
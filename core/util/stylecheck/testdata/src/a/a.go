@@ -0,0 +1,31 @@
+package a
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"connectrpc.com/connect"
+)
+
+func slogCalls(ctx context.Context, id string) {
+	slog.Info("Could not find control", slog.String("id", id))
+	slog.Info("Could not find control %s", id)                   // want "slog message must not contain formatting verbs"
+	slog.ErrorContext(ctx, "Evaluation failed: %v", "err")       // want "slog message must not contain formatting verbs"
+	slog.Default().Warn("Progress is at 100%", slog.Int("n", 1)) // a trailing percent sign is not a verb
+	slog.Default().Debug("Value is %d", 1)                       // want "slog message must not contain formatting verbs"
+}
+
+func errorfCalls(err error) error {
+	_ = fmt.Errorf("could not store result: %w", err)
+	_ = fmt.Errorf("could not store result: %v", err) // want "error is formatted without %w"
+	_ = fmt.Errorf("invalid id %s", "x")
+	return errors.New("failed")
+}
+
+func connectErrors(err error) {
+	_ = connect.NewError(connect.CodeInternal, err)
+	_ = connect.NewError(connect.CodeUnknown, err) // want "connect error must be created with a meaningful code"
+	_ = connect.NewError(0, err)                   // want "connect error must be created with a meaningful code"
+}
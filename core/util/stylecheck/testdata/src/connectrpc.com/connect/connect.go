@@ -0,0 +1,16 @@
+// Package connect is a minimal stub of connectrpc.com/connect for the analyzer tests.
+package connect
+
+type Code uint32
+
+const (
+	CodeCanceled Code = 1
+	CodeUnknown  Code = 2
+	CodeInternal Code = 13
+)
+
+type Error struct{}
+
+func (*Error) Error() string { return "" }
+
+func NewError(c Code, underlying error) *Error { return &Error{} }